@@ -23,17 +23,27 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"fmt"
+	"net/http"
 
 	"github.com/coder-lulu/newbee-common/v2/middleware/integration"
+	"github.com/coder-lulu/newbee-core/api/internal/abac"
 	"github.com/coder-lulu/newbee-core/api/internal/config"
 	"github.com/coder-lulu/newbee-core/api/internal/handler"
+	"github.com/coder-lulu/newbee-core/api/internal/jwks"
+	"github.com/coder-lulu/newbee-core/api/internal/oauth2"
+	"github.com/coder-lulu/newbee-core/api/internal/scim"
+	"github.com/coder-lulu/newbee-core/api/internal/session"
 	"github.com/coder-lulu/newbee-core/api/internal/svc"
+	"github.com/coder-lulu/newbee-core/rpc/apicatalog"
 
 	"github.com/zeromicro/go-zero/core/conf"
 	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/threading"
 	"github.com/zeromicro/go-zero/rest"
+	"github.com/zeromicro/go-zero/rest/router"
 )
 
 var configFile = flag.String("f", "etc/core.yaml", "the config file")
@@ -44,9 +54,6 @@ func main() {
 	var c config.Config
 	conf.MustLoad(*configFile, &c, conf.UseEnv())
 
-	server := rest.MustNewServer(c.RestConf, rest.WithCors(c.CROSConf.Address))
-	defer server.Stop()
-
 	ctx := svc.NewServiceContext(c)
 	defer func() {
 		if ctx.IntegrationResult != nil && ctx.IntegrationResult.Manager != nil {
@@ -56,11 +63,51 @@ func main() {
 		}
 	}()
 
+	// SCIM 接口使用租户的 SCIM 令牌认证，OAuth 2.0 协议接口使用客户端凭证认证，
+	// 都在路由层分流，不经过下面的中间件链
+	r := oauth2.NewRouter(router.NewRouter(), oauth2.NewHandler(ctx))
+	server := rest.MustNewServer(c.RestConf,
+		rest.WithRouter(scim.NewRouter(r, scim.NewHandler(ctx))),
+		rest.WithCors(c.CROSConf.Address))
+	defer server.Stop()
+
+	// 记录客户端信息，需要读取原始令牌，放在最前面
+	ipResolver, err := abac.NewClientIPResolver(c.AbacConf.TrustedProxies)
+	logx.Must(err)
+	server.Use(session.NewMiddleware(ctx.Redis, ipResolver.Resolve))
+
+	// OAuth 客户端的令牌只能访问其作用域授权的接口，需要读取原始令牌
+	server.Use(oauth2.NewScopeMiddleware(ctx))
+
+	// 非对称签名的令牌需要先转换，必须在统一中间件链之前注册
+	server.Use(jwks.NewAuthMiddleware(ctx.JwtKeys, ctx.Redis))
+
 	// 🎉 使用统一的集成API应用中间件链
 	integration.ApplyToServer(server, ctx.IntegrationResult)
 
+	// 认证通过后才刷新会话的活跃时间
+	server.Use(session.NewTouchMiddleware(ctx.CoreRpc, ctx.Redis))
+
+	// 带ABAC条件的规则需要请求属性，在统一中间件链完成认证后重新检查
+	server.Use(abac.NewConditionMiddleware(ctx))
+
 	handler.RegisterHandlers(server, ctx)
 
+	// 公钥集合，供其它服务离线验证 core 签发的令牌
+	server.AddRoute(rest.Route{
+		Method:  http.MethodGet,
+		Path:    "/.well-known/jwks.json",
+		Handler: ctx.JwtKeys.JWKSHandler(),
+	})
+
+	// 登记全部路由到接口目录，新路由无需手动添加即可在权限管理中分配
+	routes := server.Routes()
+	threading.GoSafe(func() {
+		if _, err := apicatalog.Register(context.Background(), ctx.CoreRpc, "Core", apicatalog.FromRoutes(routes)); err != nil {
+			logx.Errorw("failed to register the api catalog", logx.Field("error", err.Error()))
+		}
+	})
+
 	fmt.Printf("Starting server at %s:%d...\n", c.Host, c.Port)
 	server.Start()
 }
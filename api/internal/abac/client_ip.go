@@ -0,0 +1,103 @@
+package abac
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"strings"
+)
+
+// ClientIPResolver resolves the client IP used by the ABAC conditions.
+// X-Forwarded-For is only honoured when the connection comes from a trusted proxy, and the list is walked from
+// the right, so the first hop not added by a trusted proxy is used. Entries prepended by the client are never reached.
+type ClientIPResolver struct {
+	trusted []netip.Prefix
+}
+
+// NewClientIPResolver creates a resolver with the CIDRs or single IPs of the trusted reverse proxies.
+func NewClientIPResolver(trustedProxies []string) (*ClientIPResolver, error) {
+	r := &ClientIPResolver{}
+	for _, v := range trustedProxies {
+		prefix, err := parsePrefix(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted proxy %q: %w", v, err)
+		}
+		r.trusted = append(r.trusted, prefix)
+	}
+	return r, nil
+}
+
+// Resolve returns the client IP of the request, or an empty string when the connection address cannot be parsed.
+func (r *ClientIPResolver) Resolve(req *http.Request) string {
+	remote, ok := parseAddr(req.RemoteAddr)
+	if !ok {
+		return ""
+	}
+	if !r.isTrusted(remote) {
+		return remote.String()
+	}
+
+	client := remote
+	hops := forwardedHops(req.Header.Values("X-Forwarded-For"))
+	for i := len(hops) - 1; i >= 0; i-- {
+		addr, ok := parseAddr(hops[i])
+		if !ok {
+			// a malformed hop cannot be attributed, stop at the last proxy that was trusted
+			break
+		}
+		client = addr
+		if !r.isTrusted(addr) {
+			break
+		}
+	}
+
+	return client.String()
+}
+
+func (r *ClientIPResolver) isTrusted(addr netip.Addr) bool {
+	for _, p := range r.trusted {
+		if p.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// forwardedHops joins repeated X-Forwarded-For headers in order
+func forwardedHops(headers []string) []string {
+	var hops []string
+	for _, header := range headers {
+		for _, v := range strings.Split(header, ",") {
+			if v = strings.TrimSpace(v); v != "" {
+				hops = append(hops, v)
+			}
+		}
+	}
+	return hops
+}
+
+// parseAddr parses an IP with or without a port
+func parseAddr(v string) (netip.Addr, bool) {
+	if host, _, err := net.SplitHostPort(v); err == nil {
+		v = host
+	}
+	addr, err := netip.ParseAddr(v)
+	if err != nil {
+		return netip.Addr{}, false
+	}
+	return addr.Unmap(), true
+}
+
+func parsePrefix(v string) (netip.Prefix, error) {
+	if strings.Contains(v, "/") {
+		prefix, err := netip.ParsePrefix(v)
+		return prefix.Masked(), err
+	}
+	addr, err := netip.ParseAddr(v)
+	if err != nil {
+		return netip.Prefix{}, err
+	}
+	addr = addr.Unmap()
+	return netip.PrefixFrom(addr, addr.BitLen()), nil
+}
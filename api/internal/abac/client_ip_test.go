@@ -0,0 +1,84 @@
+package abac
+
+import (
+	"net/http/httptest"
+	"testing"
+)
+
+func TestClientIPResolver(t *testing.T) {
+	resolver, err := NewClientIPResolver([]string{"10.0.0.0/8", "192.168.1.1"})
+	if err != nil {
+		t.Fatalf("new resolver: %v", err)
+	}
+
+	tests := []struct {
+		name         string
+		remoteAddr   string
+		forwardedFor []string
+		want         string
+	}{
+		{name: "direct connection", remoteAddr: "203.0.113.9:51234", want: "203.0.113.9"},
+		{
+			// 直连的客户端伪造 X-Forwarded-For 冒充内网地址
+			name:         "spoofed header from untrusted peer",
+			remoteAddr:   "203.0.113.9:51234",
+			forwardedFor: []string{"10.8.3.4"},
+			want:         "203.0.113.9",
+		},
+		{
+			// 客户端在代理之前添加的地址位于列表左侧，取最右侧不可信的地址
+			name:         "spoofed entry before trusted proxy",
+			remoteAddr:   "10.0.0.2:443",
+			forwardedFor: []string{"10.8.3.4, 198.51.100.7"},
+			want:         "198.51.100.7",
+		},
+		{
+			name:         "chain of trusted proxies",
+			remoteAddr:   "10.0.0.2:443",
+			forwardedFor: []string{"198.51.100.7, 192.168.1.1", "10.0.0.3"},
+			want:         "198.51.100.7",
+		},
+		{
+			name:         "malformed hop",
+			remoteAddr:   "10.0.0.2:443",
+			forwardedFor: []string{"198.51.100.7, unknown"},
+			want:         "10.0.0.2",
+		},
+		{name: "trusted proxy without header", remoteAddr: "10.0.0.2:443", want: "10.0.0.2"},
+		{name: "all hops trusted", remoteAddr: "10.0.0.2:443", forwardedFor: []string{"10.0.0.9"}, want: "10.0.0.9"},
+		{name: "ipv6 peer", remoteAddr: "[2001:db8::1]:443", want: "2001:db8::1"},
+		{name: "invalid remote address", remoteAddr: "pipe", want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest("GET", "/user/list", nil)
+			req.RemoteAddr = tt.remoteAddr
+			for _, v := range tt.forwardedFor {
+				req.Header.Add("X-Forwarded-For", v)
+			}
+			if got := resolver.Resolve(req); got != tt.want {
+				t.Errorf("Resolve() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClientIPResolverWithoutTrustedProxies(t *testing.T) {
+	resolver, err := NewClientIPResolver(nil)
+	if err != nil {
+		t.Fatalf("new resolver: %v", err)
+	}
+
+	req := httptest.NewRequest("GET", "/user/list", nil)
+	req.RemoteAddr = "10.0.0.2:443"
+	req.Header.Set("X-Forwarded-For", "192.168.1.20")
+	if got := resolver.Resolve(req); got != "10.0.0.2" {
+		t.Errorf("Resolve() = %q, want the connection address", got)
+	}
+}
+
+func TestNewClientIPResolverInvalid(t *testing.T) {
+	if _, err := NewClientIPResolver([]string{"10.0.0.0/33"}); err == nil {
+		t.Error("expected an error for an invalid cidr")
+	}
+}
@@ -0,0 +1,64 @@
+package abac
+
+import (
+	"net/http"
+
+	"github.com/coder-lulu/newbee-common/v2/orm/ent/entctx/tenantctx"
+	"github.com/coder-lulu/newbee-common/v2/orm/ent/entctx/userctx"
+	"github.com/zeromicro/go-zero/core/errorx"
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/rest/httpx"
+
+	"github.com/coder-lulu/newbee-core/api/internal/svc"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+)
+
+// contextKeyIP is the key of the client IP in the permission check context of the core RPC.
+const contextKeyIP = "ip"
+
+// NewConditionMiddleware re-checks the APIs covered by Casbin rules with ABAC conditions.
+// The local enforcer cannot evaluate the conditions, it treats the conditional allow rules as candidates and
+// ignores the conditional deny rules, so these requests are checked again by the core RPC with the client IP.
+// It reads the user from the context, so it must be registered after the unified middleware chain.
+// The client IP is the connection address, X-Forwarded-For is only used behind the proxies in AbacConf.TrustedProxies.
+func NewConditionMiddleware(svcCtx *svc.ServiceContext) func(next http.HandlerFunc) http.HandlerFunc {
+	resolver, err := NewClientIPResolver(svcCtx.Config.AbacConf.TrustedProxies)
+	logx.Must(err)
+
+	return func(next http.HandlerFunc) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			ctx := r.Context()
+
+			userID, err := userctx.GetUserIDFromCtx(ctx)
+			if err != nil || userID == "" ||
+				!svcCtx.CasbinQuerier.HasConditions(tenantctx.GetTenantIDFromCtx(ctx), r.URL.Path, r.Method) {
+				next(w, r)
+				return
+			}
+
+			resp, err := svcCtx.CoreRpc.CheckPermission(ctx, &core.PermissionCheckReq{
+				ServiceName: "core",
+				Subject:     userID,
+				Object:      r.URL.Path,
+				Action:      r.Method,
+				Context:     map[string]string{contextKeyIP: resolver.Resolve(r)},
+			})
+			if err != nil {
+				logx.WithContext(ctx).Errorw("failed to check conditional permission", logx.Field("detail", err.Error()))
+				httpx.ErrorCtx(ctx, w, err)
+				return
+			}
+
+			if !resp.Allowed {
+				logx.WithContext(ctx).Infow("conditional permission denied",
+					logx.Field("userId", userID), logx.Field("method", r.Method),
+					logx.Field("path", r.URL.Path), logx.Field("reason", resp.Reason))
+				httpx.ErrorCtx(ctx, w, errorx.NewCodeError(http.StatusForbidden,
+					svcCtx.Trans.Trans(ctx, "casbin.conditionDenied")))
+				return
+			}
+
+			next(w, r)
+		}
+	}
+}
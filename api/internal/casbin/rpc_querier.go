@@ -4,7 +4,10 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"strings"
+	"sync/atomic"
 
+	"github.com/casbin/casbin/v2/util"
 	commontypes "github.com/coder-lulu/newbee-common/v2/casbin/types"
 	"github.com/coder-lulu/newbee-common/v2/middleware/dataperm"
 	"github.com/coder-lulu/newbee-common/v2/utils/pointy"
@@ -16,6 +19,15 @@ import (
 // 通过RPC调用从Core RPC服务查询规则
 type RpcCasbinRuleQuerier struct {
 	coreRpc coreclient.Core
+
+	// conditionalRoutes 带ABAC条件的规则覆盖的接口，按租户ID分组，每次加载策略时更新
+	conditionalRoutes atomic.Pointer[map[uint64][]conditionalRoute]
+}
+
+// conditionalRoute 条件规则的资源和操作，支持 keyMatch2 通配
+type conditionalRoute struct {
+	path   string
+	method string
 }
 
 // NewRpcCasbinRuleQuerier 创建RPC查询器
@@ -49,14 +61,41 @@ func (q *RpcCasbinRuleQuerier) QueryCasbinRules(ctx context.Context, tenantID ui
 	}
 
 	// 转换RPC响应为通用接口
+	// 🔥 条件规则需要请求属性才能判断，本地执行器只保留 allow 规则作为候选，
+	// 由条件中间件通过 CheckPermission 对覆盖的接口重新检查
 	result := make([]commontypes.CasbinRuleEntity, 0, len(resp.Data))
+	routes := make(map[uint64][]conditionalRoute)
 	for _, rule := range resp.Data {
+		if rule.Ptype == "p" && rule.GetConditions() != "" {
+			tenantID := rule.GetTenantId()
+			routes[tenantID] = append(routes[tenantID], conditionalRoute{path: rule.GetV2(), method: rule.GetV3()})
+			if !strings.EqualFold(rule.GetV4(), "allow") {
+				continue
+			}
+		}
 		result = append(result, &RpcCasbinRuleWrapper{rule: rule})
 	}
+	q.conditionalRoutes.Store(&routes)
 
 	return result, nil
 }
 
+// HasConditions 判断租户内是否有条件规则覆盖该接口
+func (q *RpcCasbinRuleQuerier) HasConditions(tenantID uint64, path, method string) bool {
+	routes := q.conditionalRoutes.Load()
+	if routes == nil {
+		return false
+	}
+
+	for _, r := range (*routes)[tenantID] {
+		if util.KeyMatch2(path, r.path) && util.KeyMatch2(method, r.method) {
+			return true
+		}
+	}
+
+	return false
+}
+
 // RpcCasbinRuleWrapper 包装RPC响应数据以实现CasbinRuleEntity接口
 type RpcCasbinRuleWrapper struct {
 	rule *core.CasbinRuleInfo
@@ -24,6 +24,9 @@ type Config struct {
 	I18nConf     i18n.Conf
 	ProjectConf  ProjectConf
 	CROSConf     config.CROSConf
+	JwtKeyConf   JwtKeyConf `json:",optional"`
+	OAuth2Conf   OAuth2Conf `json:",optional"`
+	AbacConf     AbacConf   `json:",optional"`
 }
 
 // MiddlewareCompatConfig for go-zero compatibility
@@ -56,3 +59,37 @@ type ProjectConf struct {
 	RefreshTokenPeriod      int    `json:",optional,default=24"` // refresh token valid period, unit: hour | 刷新 token 的有效期，单位：小时
 	AccessTokenPeriod       int    `json:",optional,default=1"`  // access token valid period, unit: hour | 短期 token 的有效期，单位：小时
 }
+
+// JwtKeyConf 令牌签名密钥配置
+// Algorithm 为 HS256 时沿用 Middleware.Auth.AccessSecret 签名；
+// 为 RS256/ES256/EdDSA 时使用 ActiveKid 指定的私钥签名，其余密钥仅用于验签并通过 /.well-known/jwks.json 发布，
+// 直到各自的 ExpiredAt 之后才下线，从而实现平滑轮换。
+type JwtKeyConf struct {
+	Algorithm string          `json:",default=HS256,options=[HS256,RS256,ES256,EdDSA]"`
+	ActiveKid string          `json:",optional"`
+	Keys      []JwtSigningKey `json:",optional"`
+}
+
+// JwtSigningKey 单个签名密钥
+type JwtSigningKey struct {
+	Kid            string `json:""`
+	Algorithm      string `json:",optional,options=[RS256,ES256,EdDSA]"` // 为空时继承 JwtKeyConf.Algorithm
+	PrivateKeyFile string `json:",optional"`                             // PEM 私钥文件，已退役的密钥可以不配置
+	PublicKeyFile  string `json:",optional"`                             // PEM 公钥文件，配置了私钥时可以省略
+	ExpiredAt      int64  `json:",optional"`                             // 退役时间（Unix 秒），超过后不再验签也不再发布，0 表示永久有效
+}
+
+// OAuth2Conf core 作为 OAuth 2.0 / OIDC 授权服务器时的配置
+type OAuth2Conf struct {
+	Issuer             string `json:",optional"`                 // 令牌签发者，为空时按请求地址生成
+	ConsentURL         string `json:",optional"`                 // 前端授权确认页，/oauth2/authorize 携带原始参数跳转到这里
+	AccessTokenExpire  int64  `json:",optional,default=3600"`    // 访问令牌默认有效期，单位：秒，客户端可以单独配置
+	RefreshTokenExpire int64  `json:",optional,default=2592000"` // 刷新令牌默认有效期，单位：秒，客户端可以单独配置
+}
+
+// AbacConf ABAC 条件校验的配置
+type AbacConf struct {
+	// TrustedProxies 可信反向代理的网段或IP，只有连接来自这些地址时才读取 X-Forwarded-For，
+	// 并从右向左取第一个不可信的地址作为客户端IP；为空时只使用连接地址
+	TrustedProxies []string `json:",optional"`
+}
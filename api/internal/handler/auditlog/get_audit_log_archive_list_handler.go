@@ -0,0 +1,45 @@
+package auditlog
+
+import (
+	"net/http"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+
+	"github.com/coder-lulu/newbee-core/api/internal/logic/auditlog"
+	"github.com/coder-lulu/newbee-core/api/internal/svc"
+	"github.com/coder-lulu/newbee-core/api/internal/types"
+)
+
+// swagger:route post /audit-log/archive/list auditlog GetAuditLogArchiveList
+//
+// Get audit log archive list | 获取审计日志归档列表
+//
+// Get audit log archive list | 获取审计日志归档列表
+//
+// Parameters:
+//  + name: body
+//    require: true
+//    in: body
+//    type: AuditLogArchiveListReq
+//
+// Responses:
+//  200: AuditLogArchiveListResp
+
+func GetAuditLogArchiveListHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.AuditLogArchiveListReq
+		if err := httpx.Parse(r, &req, true); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := auditlog.NewGetAuditLogArchiveListLogic(r.Context(), svcCtx)
+		resp, err := l.GetAuditLogArchiveList(&req)
+		if err != nil {
+			err = svcCtx.Trans.TransError(r.Context(), err)
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
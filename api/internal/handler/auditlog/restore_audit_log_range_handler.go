@@ -0,0 +1,45 @@
+package auditlog
+
+import (
+	"net/http"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+
+	"github.com/coder-lulu/newbee-core/api/internal/logic/auditlog"
+	"github.com/coder-lulu/newbee-core/api/internal/svc"
+	"github.com/coder-lulu/newbee-core/api/internal/types"
+)
+
+// swagger:route post /audit-log/restore auditlog RestoreAuditLogRange
+//
+// Search archived audit logs in a time range | 查询时间范围内的归档审计日志
+//
+// Search archived audit logs in a time range | 查询时间范围内的归档审计日志
+//
+// Parameters:
+//  + name: body
+//    require: true
+//    in: body
+//    type: AuditLogListReq
+//
+// Responses:
+//  200: AuditLogListResp
+
+func RestoreAuditLogRangeHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.AuditLogListReq
+		if err := httpx.Parse(r, &req, true); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := auditlog.NewRestoreAuditLogRangeLogic(r.Context(), svcCtx)
+		resp, err := l.RestoreAuditLogRange(&req)
+		if err != nil {
+			err = svcCtx.Trans.TransError(r.Context(), err)
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
@@ -0,0 +1,32 @@
+package auditlog
+
+import (
+	"net/http"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+
+	"github.com/coder-lulu/newbee-core/api/internal/logic/auditlog"
+	"github.com/coder-lulu/newbee-core/api/internal/svc"
+)
+
+// swagger:route post /audit-log/verify auditlog VerifyAuditLogChain
+//
+// Verify audit log hash chain | 校验审计日志哈希链
+//
+// Verify audit log hash chain | 校验审计日志哈希链
+//
+// Responses:
+//  200: AuditLogVerifyResp
+
+func VerifyAuditLogChainHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		l := auditlog.NewVerifyAuditLogChainLogic(r.Context(), svcCtx)
+		resp, err := l.VerifyAuditLogChain()
+		if err != nil {
+			err = svcCtx.Trans.TransError(r.Context(), err)
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
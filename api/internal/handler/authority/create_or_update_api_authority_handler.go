@@ -23,7 +23,7 @@ import (
 //    type: CreateOrUpdateApiAuthorityReq
 //
 // Responses:
-//  200: BaseMsgResp
+//  200: ApiAuthorityChangeResp
 
 func CreateOrUpdateApiAuthorityHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
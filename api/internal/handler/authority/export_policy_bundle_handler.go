@@ -0,0 +1,45 @@
+package authority
+
+import (
+	"net/http"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+
+	"github.com/coder-lulu/newbee-core/api/internal/logic/authority"
+	"github.com/coder-lulu/newbee-core/api/internal/svc"
+	"github.com/coder-lulu/newbee-core/api/internal/types"
+)
+
+// swagger:route post /authority/policy_bundle/export authority ExportPolicyBundle
+//
+// Export the tenant's roles and permissions as a policy bundle | 导出租户角色和权限包
+//
+// Export the tenant's roles and permissions as a policy bundle | 导出租户角色和权限包
+//
+// Parameters:
+//  + name: body
+//    require: true
+//    in: body
+//    type: PolicyBundleExportReq
+//
+// Responses:
+//  200: PolicyBundleResp
+
+func ExportPolicyBundleHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.PolicyBundleExportReq
+		if err := httpx.Parse(r, &req, true); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := authority.NewExportPolicyBundleLogic(r.Context(), svcCtx)
+		resp, err := l.ExportPolicyBundle(&req)
+		if err != nil {
+			err = svcCtx.Trans.TransError(r.Context(), err)
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
@@ -0,0 +1,45 @@
+package authority
+
+import (
+	"net/http"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+
+	"github.com/coder-lulu/newbee-core/api/internal/logic/authority"
+	"github.com/coder-lulu/newbee-core/api/internal/svc"
+	"github.com/coder-lulu/newbee-core/api/internal/types"
+)
+
+// swagger:route post /authority/policy_bundle/import authority ImportPolicyBundle
+//
+// Import a policy bundle into the tenant | 导入权限包
+//
+// Import a policy bundle into the tenant | 导入权限包
+//
+// Parameters:
+//  + name: body
+//    require: true
+//    in: body
+//    type: PolicyBundleImportReq
+//
+// Responses:
+//  200: PolicyBundleImportResp
+
+func ImportPolicyBundleHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.PolicyBundleImportReq
+		if err := httpx.Parse(r, &req, true); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := authority.NewImportPolicyBundleLogic(r.Context(), svcCtx)
+		resp, err := l.ImportPolicyBundle(&req)
+		if err != nil {
+			err = svcCtx.Trans.TransError(r.Context(), err)
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
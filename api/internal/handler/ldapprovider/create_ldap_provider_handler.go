@@ -0,0 +1,45 @@
+package ldapprovider
+
+import (
+	"net/http"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+
+	"github.com/coder-lulu/newbee-core/api/internal/logic/ldapprovider"
+	"github.com/coder-lulu/newbee-core/api/internal/svc"
+	"github.com/coder-lulu/newbee-core/api/internal/types"
+)
+
+// swagger:route post /ldap_provider/create ldapprovider CreateLdapProvider
+//
+// Create LDAP provider information | 创建LDAP目录
+//
+// Create LDAP provider information | 创建LDAP目录
+//
+// Parameters:
+//  + name: body
+//    require: true
+//    in: body
+//    type: LdapProviderInfo
+//
+// Responses:
+//  200: BaseMsgResp
+
+func CreateLdapProviderHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.LdapProviderInfo
+		if err := httpx.Parse(r, &req, true); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := ldapprovider.NewCreateLdapProviderLogic(r.Context(), svcCtx)
+		resp, err := l.CreateLdapProvider(&req)
+		if err != nil {
+			err = svcCtx.Trans.TransError(r.Context(), err)
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
@@ -0,0 +1,45 @@
+package ldapprovider
+
+import (
+	"net/http"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+
+	"github.com/coder-lulu/newbee-core/api/internal/logic/ldapprovider"
+	"github.com/coder-lulu/newbee-core/api/internal/svc"
+	"github.com/coder-lulu/newbee-core/api/internal/types"
+)
+
+// swagger:route post /ldap_provider/list ldapprovider GetLdapProviderList
+//
+// Get LDAP provider list | 获取LDAP目录列表
+//
+// Get LDAP provider list | 获取LDAP目录列表
+//
+// Parameters:
+//  + name: body
+//    require: true
+//    in: body
+//    type: LdapProviderListReq
+//
+// Responses:
+//  200: LdapProviderListResp
+
+func GetLdapProviderListHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.LdapProviderListReq
+		if err := httpx.Parse(r, &req, true); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := ldapprovider.NewGetLdapProviderListLogic(r.Context(), svcCtx)
+		resp, err := l.GetLdapProviderList(&req)
+		if err != nil {
+			err = svcCtx.Trans.TransError(r.Context(), err)
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
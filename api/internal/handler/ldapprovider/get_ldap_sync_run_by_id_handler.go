@@ -0,0 +1,45 @@
+package ldapprovider
+
+import (
+	"net/http"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+
+	"github.com/coder-lulu/newbee-core/api/internal/logic/ldapprovider"
+	"github.com/coder-lulu/newbee-core/api/internal/svc"
+	"github.com/coder-lulu/newbee-core/api/internal/types"
+)
+
+// swagger:route post /ldap_provider/sync_run ldapprovider GetLdapSyncRunById
+//
+// Get sync run by ID | 通过ID获取同步记录
+//
+// Get sync run by ID | 通过ID获取同步记录
+//
+// Parameters:
+//  + name: body
+//    require: true
+//    in: body
+//    type: IDReq
+//
+// Responses:
+//  200: LdapSyncRunInfoResp
+
+func GetLdapSyncRunByIdHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.IDReq
+		if err := httpx.Parse(r, &req, true); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := ldapprovider.NewGetLdapSyncRunByIdLogic(r.Context(), svcCtx)
+		resp, err := l.GetLdapSyncRunById(&req)
+		if err != nil {
+			err = svcCtx.Trans.TransError(r.Context(), err)
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
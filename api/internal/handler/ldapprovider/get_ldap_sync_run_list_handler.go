@@ -0,0 +1,45 @@
+package ldapprovider
+
+import (
+	"net/http"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+
+	"github.com/coder-lulu/newbee-core/api/internal/logic/ldapprovider"
+	"github.com/coder-lulu/newbee-core/api/internal/svc"
+	"github.com/coder-lulu/newbee-core/api/internal/types"
+)
+
+// swagger:route post /ldap_provider/sync_run/list ldapprovider GetLdapSyncRunList
+//
+// Get sync run list | 获取同步记录列表
+//
+// Get sync run list | 获取同步记录列表
+//
+// Parameters:
+//  + name: body
+//    require: true
+//    in: body
+//    type: LdapSyncRunListReq
+//
+// Responses:
+//  200: LdapSyncRunListResp
+
+func GetLdapSyncRunListHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.LdapSyncRunListReq
+		if err := httpx.Parse(r, &req, true); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := ldapprovider.NewGetLdapSyncRunListLogic(r.Context(), svcCtx)
+		resp, err := l.GetLdapSyncRunList(&req)
+		if err != nil {
+			err = svcCtx.Trans.TransError(r.Context(), err)
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
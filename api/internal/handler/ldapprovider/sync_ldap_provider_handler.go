@@ -0,0 +1,45 @@
+package ldapprovider
+
+import (
+	"net/http"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+
+	"github.com/coder-lulu/newbee-core/api/internal/logic/ldapprovider"
+	"github.com/coder-lulu/newbee-core/api/internal/svc"
+	"github.com/coder-lulu/newbee-core/api/internal/types"
+)
+
+// swagger:route post /ldap_provider/sync ldapprovider SyncLdapProvider
+//
+// Sync the directory now, or list the changes in a dry run | 立即同步目录，或试运行列出变更
+//
+// Sync the directory now, or list the changes in a dry run | 立即同步目录，或试运行列出变更
+//
+// Parameters:
+//  + name: body
+//    require: true
+//    in: body
+//    type: LdapSyncReq
+//
+// Responses:
+//  200: LdapSyncRunInfoResp
+
+func SyncLdapProviderHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.LdapSyncReq
+		if err := httpx.Parse(r, &req, true); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := ldapprovider.NewSyncLdapProviderLogic(r.Context(), svcCtx)
+		resp, err := l.SyncLdapProvider(&req)
+		if err != nil {
+			err = svcCtx.Trans.TransError(r.Context(), err)
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
@@ -0,0 +1,45 @@
+package oauthclient
+
+import (
+	"net/http"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+
+	"github.com/coder-lulu/newbee-core/api/internal/logic/oauthclient"
+	"github.com/coder-lulu/newbee-core/api/internal/svc"
+	"github.com/coder-lulu/newbee-core/api/internal/types"
+)
+
+// swagger:route post /oauth_client/create oauthclient CreateOauthClient
+//
+// Create OAuth client, the secret is only returned once | 创建OAuth客户端，密钥只返回一次
+//
+// Create OAuth client, the secret is only returned once | 创建OAuth客户端，密钥只返回一次
+//
+// Parameters:
+//  + name: body
+//    require: true
+//    in: body
+//    type: OauthClientInfo
+//
+// Responses:
+//  200: OauthClientSecretResp
+
+func CreateOauthClientHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.OauthClientInfo
+		if err := httpx.Parse(r, &req, true); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := oauthclient.NewCreateOauthClientLogic(r.Context(), svcCtx)
+		resp, err := l.CreateOauthClient(&req)
+		if err != nil {
+			err = svcCtx.Trans.TransError(r.Context(), err)
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
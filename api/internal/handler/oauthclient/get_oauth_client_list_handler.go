@@ -0,0 +1,45 @@
+package oauthclient
+
+import (
+	"net/http"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+
+	"github.com/coder-lulu/newbee-core/api/internal/logic/oauthclient"
+	"github.com/coder-lulu/newbee-core/api/internal/svc"
+	"github.com/coder-lulu/newbee-core/api/internal/types"
+)
+
+// swagger:route post /oauth_client/list oauthclient GetOauthClientList
+//
+// Get OAuth client list | 获取OAuth客户端列表
+//
+// Get OAuth client list | 获取OAuth客户端列表
+//
+// Parameters:
+//  + name: body
+//    require: true
+//    in: body
+//    type: OauthClientListReq
+//
+// Responses:
+//  200: OauthClientListResp
+
+func GetOauthClientListHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.OauthClientListReq
+		if err := httpx.Parse(r, &req, true); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := oauthclient.NewGetOauthClientListLogic(r.Context(), svcCtx)
+		resp, err := l.GetOauthClientList(&req)
+		if err != nil {
+			err = svcCtx.Trans.TransError(r.Context(), err)
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
@@ -0,0 +1,45 @@
+package oauthclient
+
+import (
+	"net/http"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+
+	"github.com/coder-lulu/newbee-core/api/internal/logic/oauthclient"
+	"github.com/coder-lulu/newbee-core/api/internal/svc"
+	"github.com/coder-lulu/newbee-core/api/internal/types"
+)
+
+// swagger:route post /oauth_consent/list oauthclient GetOauthConsentList
+//
+// Get OAuth consent list | 获取OAuth授权记录列表
+//
+// Get OAuth consent list | 获取OAuth授权记录列表
+//
+// Parameters:
+//  + name: body
+//    require: true
+//    in: body
+//    type: OauthConsentListReq
+//
+// Responses:
+//  200: OauthConsentListResp
+
+func GetOauthConsentListHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.OauthConsentListReq
+		if err := httpx.Parse(r, &req, true); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := oauthclient.NewGetOauthConsentListLogic(r.Context(), svcCtx)
+		resp, err := l.GetOauthConsentList(&req)
+		if err != nil {
+			err = svcCtx.Trans.TransError(r.Context(), err)
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
@@ -0,0 +1,45 @@
+package oauthclient
+
+import (
+	"net/http"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+
+	"github.com/coder-lulu/newbee-core/api/internal/logic/oauthclient"
+	"github.com/coder-lulu/newbee-core/api/internal/svc"
+	"github.com/coder-lulu/newbee-core/api/internal/types"
+)
+
+// swagger:route post /oauth2/consent oauthclient OauthConsent
+//
+// Authorize the OAuth client for the current user | 为当前用户授权OAuth客户端
+//
+// Authorize the OAuth client for the current user | 为当前用户授权OAuth客户端
+//
+// Parameters:
+//  + name: body
+//    require: true
+//    in: body
+//    type: OauthConsentReq
+//
+// Responses:
+//  200: OauthConsentResp
+
+func OauthConsentHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.OauthConsentReq
+		if err := httpx.Parse(r, &req, true); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := oauthclient.NewOauthConsentLogic(r.Context(), svcCtx)
+		resp, err := l.OauthConsent(&req)
+		if err != nil {
+			err = svcCtx.Trans.TransError(r.Context(), err)
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
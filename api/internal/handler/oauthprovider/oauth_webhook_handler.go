@@ -0,0 +1,32 @@
+package oauthprovider
+
+import (
+	"net/http"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+
+	"github.com/coder-lulu/newbee-core/api/internal/logic/oauthprovider"
+	"github.com/coder-lulu/newbee-core/api/internal/svc"
+)
+
+// swagger:route post /oauth/webhook oauthprovider OauthWebhook
+//
+// Account events pushed by the provider, the provider ID is passed in the id query parameter | 第三方平台账号事件推送，提供商ID通过 id 查询参数传递
+//
+// Account events pushed by the provider, the provider ID is passed in the id query parameter | 第三方平台账号事件推送，提供商ID通过 id 查询参数传递
+//
+// Responses:
+//  200: OauthWebhookResp
+
+func OauthWebhookHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		l := oauthprovider.NewOauthWebhookLogic(r, svcCtx)
+		resp, err := l.OauthWebhook()
+		if err != nil {
+			err = svcCtx.Trans.TransError(r.Context(), err)
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
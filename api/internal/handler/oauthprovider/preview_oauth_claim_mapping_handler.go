@@ -0,0 +1,45 @@
+package oauthprovider
+
+import (
+	"net/http"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+
+	"github.com/coder-lulu/newbee-core/api/internal/logic/oauthprovider"
+	"github.com/coder-lulu/newbee-core/api/internal/svc"
+	"github.com/coder-lulu/newbee-core/api/internal/types"
+)
+
+// swagger:route post /oauth_provider/claim_mapping/preview oauthprovider PreviewOauthClaimMapping
+//
+// Preview oauth claim mapping with a sample userinfo | 使用用户信息样例预览声明映射
+//
+// Preview oauth claim mapping with a sample userinfo | 使用用户信息样例预览声明映射
+//
+// Parameters:
+//  + name: body
+//    require: true
+//    in: body
+//    type: OauthClaimMappingPreviewReq
+//
+// Responses:
+//  200: OauthClaimMappingPreviewResp
+
+func PreviewOauthClaimMappingHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.OauthClaimMappingPreviewReq
+		if err := httpx.Parse(r, &req, true); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := oauthprovider.NewPreviewOauthClaimMappingLogic(r.Context(), svcCtx)
+		resp, err := l.PreviewOauthClaimMapping(&req)
+		if err != nil {
+			err = svcCtx.Trans.TransError(r.Context(), err)
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
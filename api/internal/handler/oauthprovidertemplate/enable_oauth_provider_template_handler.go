@@ -0,0 +1,45 @@
+package oauthprovidertemplate
+
+import (
+	"net/http"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+
+	"github.com/coder-lulu/newbee-core/api/internal/logic/oauthprovidertemplate"
+	"github.com/coder-lulu/newbee-core/api/internal/svc"
+	"github.com/coder-lulu/newbee-core/api/internal/types"
+)
+
+// swagger:route post /oauth_provider_template/enable oauthprovidertemplate EnableOauthProviderTemplate
+//
+// Enable the template for the current tenant with its own credentials | 使用租户自己的凭证启用第三方登录模板
+//
+// Enable the template for the current tenant with its own credentials | 使用租户自己的凭证启用第三方登录模板
+//
+// Parameters:
+//  + name: body
+//    require: true
+//    in: body
+//    type: EnableOauthProviderTemplateReq
+//
+// Responses:
+//  200: BaseMsgResp
+
+func EnableOauthProviderTemplateHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.EnableOauthProviderTemplateReq
+		if err := httpx.Parse(r, &req, true); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := oauthprovidertemplate.NewEnableOauthProviderTemplateLogic(r.Context(), svcCtx)
+		resp, err := l.EnableOauthProviderTemplate(&req)
+		if err != nil {
+			err = svcCtx.Trans.TransError(r.Context(), err)
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
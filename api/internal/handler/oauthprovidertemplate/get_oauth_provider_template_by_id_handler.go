@@ -0,0 +1,45 @@
+package oauthprovidertemplate
+
+import (
+	"net/http"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+
+	"github.com/coder-lulu/newbee-core/api/internal/logic/oauthprovidertemplate"
+	"github.com/coder-lulu/newbee-core/api/internal/svc"
+	"github.com/coder-lulu/newbee-core/api/internal/types"
+)
+
+// swagger:route post /oauth_provider_template oauthprovidertemplate GetOauthProviderTemplateById
+//
+// Get oauth provider template by ID | 通过ID获取第三方登录模板
+//
+// Get oauth provider template by ID | 通过ID获取第三方登录模板
+//
+// Parameters:
+//  + name: body
+//    require: true
+//    in: body
+//    type: IDReq
+//
+// Responses:
+//  200: OauthProviderTemplateInfoResp
+
+func GetOauthProviderTemplateByIdHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.IDReq
+		if err := httpx.Parse(r, &req, true); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := oauthprovidertemplate.NewGetOauthProviderTemplateByIdLogic(r.Context(), svcCtx)
+		resp, err := l.GetOauthProviderTemplateById(&req)
+		if err != nil {
+			err = svcCtx.Trans.TransError(r.Context(), err)
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
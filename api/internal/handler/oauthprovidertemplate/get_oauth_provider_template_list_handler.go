@@ -0,0 +1,45 @@
+package oauthprovidertemplate
+
+import (
+	"net/http"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+
+	"github.com/coder-lulu/newbee-core/api/internal/logic/oauthprovidertemplate"
+	"github.com/coder-lulu/newbee-core/api/internal/svc"
+	"github.com/coder-lulu/newbee-core/api/internal/types"
+)
+
+// swagger:route post /oauth_provider_template/list oauthprovidertemplate GetOauthProviderTemplateList
+//
+// Get oauth provider template list | 获取第三方登录模板列表
+//
+// Get oauth provider template list | 获取第三方登录模板列表
+//
+// Parameters:
+//  + name: body
+//    require: true
+//    in: body
+//    type: OauthProviderTemplateListReq
+//
+// Responses:
+//  200: OauthProviderTemplateListResp
+
+func GetOauthProviderTemplateListHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.OauthProviderTemplateListReq
+		if err := httpx.Parse(r, &req, true); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := oauthprovidertemplate.NewGetOauthProviderTemplateListLogic(r.Context(), svcCtx)
+		resp, err := l.GetOauthProviderTemplateList(&req)
+		if err != nil {
+			err = svcCtx.Trans.TransError(r.Context(), err)
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
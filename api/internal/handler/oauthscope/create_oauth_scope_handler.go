@@ -0,0 +1,45 @@
+package oauthscope
+
+import (
+	"net/http"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+
+	"github.com/coder-lulu/newbee-core/api/internal/logic/oauthscope"
+	"github.com/coder-lulu/newbee-core/api/internal/svc"
+	"github.com/coder-lulu/newbee-core/api/internal/types"
+)
+
+// swagger:route post /oauth_scope/create oauthscope CreateOauthScope
+//
+// Create OAuth scope information | 创建OAuth作用域
+//
+// Create OAuth scope information | 创建OAuth作用域
+//
+// Parameters:
+//  + name: body
+//    require: true
+//    in: body
+//    type: OauthScopeInfo
+//
+// Responses:
+//  200: BaseMsgResp
+
+func CreateOauthScopeHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.OauthScopeInfo
+		if err := httpx.Parse(r, &req, true); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := oauthscope.NewCreateOauthScopeLogic(r.Context(), svcCtx)
+		resp, err := l.CreateOauthScope(&req)
+		if err != nil {
+			err = svcCtx.Trans.TransError(r.Context(), err)
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
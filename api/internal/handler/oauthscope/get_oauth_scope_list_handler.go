@@ -0,0 +1,45 @@
+package oauthscope
+
+import (
+	"net/http"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+
+	"github.com/coder-lulu/newbee-core/api/internal/logic/oauthscope"
+	"github.com/coder-lulu/newbee-core/api/internal/svc"
+	"github.com/coder-lulu/newbee-core/api/internal/types"
+)
+
+// swagger:route post /oauth_scope/list oauthscope GetOauthScopeList
+//
+// Get OAuth scope list | 获取OAuth作用域列表
+//
+// Get OAuth scope list | 获取OAuth作用域列表
+//
+// Parameters:
+//  + name: body
+//    require: true
+//    in: body
+//    type: OauthScopeListReq
+//
+// Responses:
+//  200: OauthScopeListResp
+
+func GetOauthScopeListHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.OauthScopeListReq
+		if err := httpx.Parse(r, &req, true); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := oauthscope.NewGetOauthScopeListLogic(r.Context(), svcCtx)
+		resp, err := l.GetOauthScopeList(&req)
+		if err != nil {
+			err = svcCtx.Trans.TransError(r.Context(), err)
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
@@ -0,0 +1,45 @@
+package oauthscope
+
+import (
+	"net/http"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+
+	"github.com/coder-lulu/newbee-core/api/internal/logic/oauthscope"
+	"github.com/coder-lulu/newbee-core/api/internal/svc"
+	"github.com/coder-lulu/newbee-core/api/internal/types"
+)
+
+// swagger:route post /oauth_scope/update oauthscope UpdateOauthScope
+//
+// Update OAuth scope information | 更新OAuth作用域
+//
+// Update OAuth scope information | 更新OAuth作用域
+//
+// Parameters:
+//  + name: body
+//    require: true
+//    in: body
+//    type: OauthScopeInfo
+//
+// Responses:
+//  200: BaseMsgResp
+
+func UpdateOauthScopeHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.OauthScopeInfo
+		if err := httpx.Parse(r, &req, true); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := oauthscope.NewUpdateOauthScopeLogic(r.Context(), svcCtx)
+		resp, err := l.UpdateOauthScope(&req)
+		if err != nil {
+			err = svcCtx.Trans.TransError(r.Context(), err)
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
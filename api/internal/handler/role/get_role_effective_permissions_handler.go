@@ -0,0 +1,45 @@
+package role
+
+import (
+	"net/http"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+
+	"github.com/coder-lulu/newbee-core/api/internal/logic/role"
+	"github.com/coder-lulu/newbee-core/api/internal/svc"
+	"github.com/coder-lulu/newbee-core/api/internal/types"
+)
+
+// swagger:route post /role/effectivePermissions role GetRoleEffectivePermissions
+//
+// Get the effective permissions with inheritance flattened | 获取展开继承后的有效权限
+//
+// Get the effective permissions with inheritance flattened | 获取展开继承后的有效权限
+//
+// Parameters:
+//  + name: body
+//    require: true
+//    in: body
+//    type: IDReq
+//
+// Responses:
+//  200: RoleEffectivePermissionsResp
+
+func GetRoleEffectivePermissionsHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.IDReq
+		if err := httpx.Parse(r, &req, true); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := role.NewGetRoleEffectivePermissionsLogic(r.Context(), svcCtx)
+		resp, err := l.GetRoleEffectivePermissions(&req)
+		if err != nil {
+			err = svcCtx.Trans.TransError(r.Context(), err)
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
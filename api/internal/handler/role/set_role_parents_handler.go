@@ -0,0 +1,45 @@
+package role
+
+import (
+	"net/http"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+
+	"github.com/coder-lulu/newbee-core/api/internal/logic/role"
+	"github.com/coder-lulu/newbee-core/api/internal/svc"
+	"github.com/coder-lulu/newbee-core/api/internal/types"
+)
+
+// swagger:route post /role/setParents role SetRoleParents
+//
+// Set the parent roles to inherit from | 设置继承的父角色
+//
+// Set the parent roles to inherit from | 设置继承的父角色
+//
+// Parameters:
+//  + name: body
+//    require: true
+//    in: body
+//    type: RoleParentsReq
+//
+// Responses:
+//  200: BaseMsgResp
+
+func SetRoleParentsHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.RoleParentsReq
+		if err := httpx.Parse(r, &req, true); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := role.NewSetRoleParentsLogic(r.Context(), svcCtx)
+		resp, err := l.SetRoleParents(&req)
+		if err != nil {
+			err = svcCtx.Trans.TransError(r.Context(), err)
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
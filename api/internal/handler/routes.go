@@ -19,19 +19,27 @@ import (
 	dictionarydetail "github.com/coder-lulu/newbee-core/api/internal/handler/dictionarydetail"
 	emaillog "github.com/coder-lulu/newbee-core/api/internal/handler/emaillog"
 	emailprovider "github.com/coder-lulu/newbee-core/api/internal/handler/emailprovider"
+	ldapprovider "github.com/coder-lulu/newbee-core/api/internal/handler/ldapprovider"
 	menu "github.com/coder-lulu/newbee-core/api/internal/handler/menu"
 	messagesender "github.com/coder-lulu/newbee-core/api/internal/handler/messagesender"
 	oauthaccount "github.com/coder-lulu/newbee-core/api/internal/handler/oauthaccount"
+	oauthclient "github.com/coder-lulu/newbee-core/api/internal/handler/oauthclient"
 	oauthprovider "github.com/coder-lulu/newbee-core/api/internal/handler/oauthprovider"
+	oauthprovidertemplate "github.com/coder-lulu/newbee-core/api/internal/handler/oauthprovidertemplate"
+	oauthscope "github.com/coder-lulu/newbee-core/api/internal/handler/oauthscope"
 	position "github.com/coder-lulu/newbee-core/api/internal/handler/position"
 	publicapi "github.com/coder-lulu/newbee-core/api/internal/handler/publicapi"
 	publicuser "github.com/coder-lulu/newbee-core/api/internal/handler/publicuser"
 	role "github.com/coder-lulu/newbee-core/api/internal/handler/role"
+	samlprovider "github.com/coder-lulu/newbee-core/api/internal/handler/samlprovider"
+	scimtoken "github.com/coder-lulu/newbee-core/api/internal/handler/scimtoken"
 	smslog "github.com/coder-lulu/newbee-core/api/internal/handler/smslog"
 	smsprovider "github.com/coder-lulu/newbee-core/api/internal/handler/smsprovider"
 	task "github.com/coder-lulu/newbee-core/api/internal/handler/task"
 	tasklog "github.com/coder-lulu/newbee-core/api/internal/handler/tasklog"
 	tenant "github.com/coder-lulu/newbee-core/api/internal/handler/tenant"
+	tenantinitplugin "github.com/coder-lulu/newbee-core/api/internal/handler/tenantinitplugin"
+	tenantinittemplate "github.com/coder-lulu/newbee-core/api/internal/handler/tenantinittemplate"
 	token "github.com/coder-lulu/newbee-core/api/internal/handler/token"
 	user "github.com/coder-lulu/newbee-core/api/internal/handler/user"
 	"github.com/coder-lulu/newbee-core/api/internal/svc"
@@ -107,6 +115,21 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 				Path:    "/role/changeRoleStatus",
 				Handler: role.ChangeRoleStatusHandler(serverCtx),
 			},
+			{
+				Method:  http.MethodPost,
+				Path:    "/role/setParents",
+				Handler: role.SetRoleParentsHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodPost,
+				Path:    "/role/parents",
+				Handler: role.GetRoleParentsHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodPost,
+				Path:    "/role/effectivePermissions",
+				Handler: role.GetRoleEffectivePermissionsHandler(serverCtx),
+			},
 		},
 	)
 
@@ -347,6 +370,16 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 				Path:    "/authority/menu/role",
 				Handler: authority.GetMenuAuthorityHandler(serverCtx),
 			},
+			{
+				Method:  http.MethodPost,
+				Path:    "/authority/policy_bundle/export",
+				Handler: authority.ExportPolicyBundleHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodPost,
+				Path:    "/authority/policy_bundle/import",
+				Handler: authority.ImportPolicyBundleHandler(serverCtx),
+			},
 		},
 	)
 
@@ -402,6 +435,11 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 				Path:    "/oauth/providers",
 				Handler: oauthprovider.GetUserOauthProvidersHandler(serverCtx),
 			},
+			{
+				Method:  http.MethodPost,
+				Path:    "/oauth/webhook",
+				Handler: oauthprovider.OauthWebhookHandler(serverCtx),
+			},
 		},
 	)
 
@@ -437,6 +475,11 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 				Path:    "/oauth_provider/test",
 				Handler: oauthprovider.TestOauthProviderHandler(serverCtx),
 			},
+			{
+				Method:  http.MethodPost,
+				Path:    "/oauth_provider/claim_mapping/preview",
+				Handler: oauthprovider.PreviewOauthClaimMappingHandler(serverCtx),
+			},
 			{
 				Method:  http.MethodPost,
 				Path:    "/oauth_account/create",
@@ -527,6 +570,31 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 				Path:    "/token/logout",
 				Handler: token.LogoutHandler(serverCtx),
 			},
+			{
+				Method:  http.MethodPost,
+				Path:    "/session/list",
+				Handler: token.GetUserSessionListHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodPost,
+				Path:    "/session/revoke",
+				Handler: token.RevokeUserSessionHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodPost,
+				Path:    "/session/my/list",
+				Handler: token.GetMySessionListHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodPost,
+				Path:    "/session/my/revoke",
+				Handler: token.RevokeMySessionHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodPost,
+				Path:    "/session/my/revoke_others",
+				Handler: token.RevokeMyOtherSessionsHandler(serverCtx),
+			},
 		},
 	)
 
@@ -884,13 +952,23 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 			},
 			{
 				Method:  http.MethodPost,
-				Path:    "/audit-log/delete",
-				Handler: auditlog.DeleteAuditLogHandler(serverCtx),
+				Path:    "/audit-log/stats",
+				Handler: auditlog.GetAuditLogStatsHandler(serverCtx),
 			},
 			{
 				Method:  http.MethodPost,
-				Path:    "/audit-log/stats",
-				Handler: auditlog.GetAuditLogStatsHandler(serverCtx),
+				Path:    "/audit-log/verify",
+				Handler: auditlog.VerifyAuditLogChainHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodPost,
+				Path:    "/audit-log/archive/list",
+				Handler: auditlog.GetAuditLogArchiveListHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodPost,
+				Path:    "/audit-log/restore",
+				Handler: auditlog.RestoreAuditLogRangeHandler(serverCtx),
 			},
 		},
 	)
@@ -937,6 +1015,31 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 				Path:    "/tenant/init",
 				Handler: tenant.InitTenantHandler(serverCtx),
 			},
+			{
+				Method:  http.MethodPost,
+				Path:    "/tenant/init_job",
+				Handler: tenant.GetTenantInitJobByIdHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodPost,
+				Path:    "/tenant/init_job/list",
+				Handler: tenant.GetTenantInitJobListHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodPost,
+				Path:    "/tenant/init_job/retry",
+				Handler: tenant.RetryTenantInitJobHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodPost,
+				Path:    "/tenant/doctor",
+				Handler: tenant.DiagnoseTenantsHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodPost,
+				Path:    "/tenant/repair",
+				Handler: tenant.RepairTenantHandler(serverCtx),
+			},
 		},
 	)
 
@@ -957,6 +1060,16 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 				Path:    "/tenant/current",
 				Handler: tenant.GetCurrentTenantHandler(serverCtx),
 			},
+			{
+				Method:  http.MethodPost,
+				Path:    "/tenant/impersonation/review",
+				Handler: tenant.ReviewImpersonationHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodPost,
+				Path:    "/tenant/impersonation/list",
+				Handler: tenant.GetImpersonationSessionListHandler(serverCtx),
+			},
 		},
 	)
 
@@ -1044,4 +1157,309 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 			},
 		},
 	)
+
+	server.AddRoutes(
+		[]rest.Route{
+			{
+				Method:  http.MethodGet,
+				Path:    "/saml/metadata",
+				Handler: samlprovider.GetSamlSpMetadataHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodPost,
+				Path:    "/saml/login",
+				Handler: samlprovider.SamlLoginHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodPost,
+				Path:    "/saml/acs",
+				Handler: samlprovider.SamlAcsHandler(serverCtx),
+			},
+		},
+	)
+
+	server.AddRoutes(
+		[]rest.Route{
+			{
+				Method:  http.MethodPost,
+				Path:    "/saml_provider/create",
+				Handler: samlprovider.CreateSamlProviderHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodPost,
+				Path:    "/saml_provider/update",
+				Handler: samlprovider.UpdateSamlProviderHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodPost,
+				Path:    "/saml_provider/delete",
+				Handler: samlprovider.DeleteSamlProviderHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodPost,
+				Path:    "/saml_provider/list",
+				Handler: samlprovider.GetSamlProviderListHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodPost,
+				Path:    "/saml_provider",
+				Handler: samlprovider.GetSamlProviderByIdHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodPost,
+				Path:    "/saml_provider/import_metadata",
+				Handler: samlprovider.ImportSamlIdpMetadataHandler(serverCtx),
+			},
+		},
+	)
+
+	server.AddRoutes(
+		[]rest.Route{
+			{
+				Method:  http.MethodPost,
+				Path:    "/ldap_provider/create",
+				Handler: ldapprovider.CreateLdapProviderHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodPost,
+				Path:    "/ldap_provider/update",
+				Handler: ldapprovider.UpdateLdapProviderHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodPost,
+				Path:    "/ldap_provider/delete",
+				Handler: ldapprovider.DeleteLdapProviderHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodPost,
+				Path:    "/ldap_provider/list",
+				Handler: ldapprovider.GetLdapProviderListHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodPost,
+				Path:    "/ldap_provider",
+				Handler: ldapprovider.GetLdapProviderByIdHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodPost,
+				Path:    "/ldap_provider/sync",
+				Handler: ldapprovider.SyncLdapProviderHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodPost,
+				Path:    "/ldap_provider/sync_run/list",
+				Handler: ldapprovider.GetLdapSyncRunListHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodPost,
+				Path:    "/ldap_provider/sync_run",
+				Handler: ldapprovider.GetLdapSyncRunByIdHandler(serverCtx),
+			},
+		},
+	)
+
+	server.AddRoutes(
+		[]rest.Route{
+			{
+				Method:  http.MethodPost,
+				Path:    "/scim_token/create",
+				Handler: scimtoken.CreateScimTokenHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodPost,
+				Path:    "/scim_token/update",
+				Handler: scimtoken.UpdateScimTokenHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodPost,
+				Path:    "/scim_token/delete",
+				Handler: scimtoken.DeleteScimTokenHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodPost,
+				Path:    "/scim_token/list",
+				Handler: scimtoken.GetScimTokenListHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodPost,
+				Path:    "/scim_token",
+				Handler: scimtoken.GetScimTokenByIdHandler(serverCtx),
+			},
+		},
+	)
+
+	server.AddRoutes(
+		[]rest.Route{
+			{
+				Method:  http.MethodPost,
+				Path:    "/oauth_client/create",
+				Handler: oauthclient.CreateOauthClientHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodPost,
+				Path:    "/oauth_client/update",
+				Handler: oauthclient.UpdateOauthClientHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodPost,
+				Path:    "/oauth_client/delete",
+				Handler: oauthclient.DeleteOauthClientHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodPost,
+				Path:    "/oauth_client/list",
+				Handler: oauthclient.GetOauthClientListHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodPost,
+				Path:    "/oauth_client",
+				Handler: oauthclient.GetOauthClientByIdHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodPost,
+				Path:    "/oauth_client/reset_secret",
+				Handler: oauthclient.ResetOauthClientSecretHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodPost,
+				Path:    "/oauth_consent/list",
+				Handler: oauthclient.GetOauthConsentListHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodPost,
+				Path:    "/oauth_consent/delete",
+				Handler: oauthclient.DeleteOauthConsentHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodPost,
+				Path:    "/oauth2/consent",
+				Handler: oauthclient.OauthConsentHandler(serverCtx),
+			},
+		},
+	)
+
+	server.AddRoutes(
+		[]rest.Route{
+			{
+				Method:  http.MethodPost,
+				Path:    "/oauth_scope/create",
+				Handler: oauthscope.CreateOauthScopeHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodPost,
+				Path:    "/oauth_scope/update",
+				Handler: oauthscope.UpdateOauthScopeHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodPost,
+				Path:    "/oauth_scope/delete",
+				Handler: oauthscope.DeleteOauthScopeHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodPost,
+				Path:    "/oauth_scope/list",
+				Handler: oauthscope.GetOauthScopeListHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodPost,
+				Path:    "/oauth_scope",
+				Handler: oauthscope.GetOauthScopeByIdHandler(serverCtx),
+			},
+		},
+	)
+
+	server.AddRoutes(
+		[]rest.Route{
+			{
+				Method:  http.MethodPost,
+				Path:    "/oauth_provider_template/create",
+				Handler: oauthprovidertemplate.CreateOauthProviderTemplateHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodPost,
+				Path:    "/oauth_provider_template/update",
+				Handler: oauthprovidertemplate.UpdateOauthProviderTemplateHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodPost,
+				Path:    "/oauth_provider_template/delete",
+				Handler: oauthprovidertemplate.DeleteOauthProviderTemplateHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodPost,
+				Path:    "/oauth_provider_template/list",
+				Handler: oauthprovidertemplate.GetOauthProviderTemplateListHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodPost,
+				Path:    "/oauth_provider_template",
+				Handler: oauthprovidertemplate.GetOauthProviderTemplateByIdHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodPost,
+				Path:    "/oauth_provider_template/enable",
+				Handler: oauthprovidertemplate.EnableOauthProviderTemplateHandler(serverCtx),
+			},
+		},
+	)
+
+	server.AddRoutes(
+		[]rest.Route{
+			{
+				Method:  http.MethodPost,
+				Path:    "/tenant_init_template/create",
+				Handler: tenantinittemplate.CreateTenantInitTemplateHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodPost,
+				Path:    "/tenant_init_template/update",
+				Handler: tenantinittemplate.UpdateTenantInitTemplateHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodPost,
+				Path:    "/tenant_init_template/delete",
+				Handler: tenantinittemplate.DeleteTenantInitTemplateHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodPost,
+				Path:    "/tenant_init_template/list",
+				Handler: tenantinittemplate.GetTenantInitTemplateListHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodPost,
+				Path:    "/tenant_init_template",
+				Handler: tenantinittemplate.GetTenantInitTemplateByIdHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodPost,
+				Path:    "/tenant_init_template/preview",
+				Handler: tenantinittemplate.PreviewTenantInitTemplateHandler(serverCtx),
+			},
+		},
+	)
+
+	server.AddRoutes(
+		[]rest.Route{
+			{
+				Method:  http.MethodPost,
+				Path:    "/tenant_init_plugin/update",
+				Handler: tenantinitplugin.UpdateTenantInitPluginHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodPost,
+				Path:    "/tenant_init_plugin/delete",
+				Handler: tenantinitplugin.DeleteTenantInitPluginHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodPost,
+				Path:    "/tenant_init_plugin/list",
+				Handler: tenantinitplugin.GetTenantInitPluginListHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodPost,
+				Path:    "/tenant_init_plugin",
+				Handler: tenantinitplugin.GetTenantInitPluginByIdHandler(serverCtx),
+			},
+		},
+	)
 }
@@ -0,0 +1,45 @@
+package samlprovider
+
+import (
+	"net/http"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+
+	"github.com/coder-lulu/newbee-core/api/internal/logic/samlprovider"
+	"github.com/coder-lulu/newbee-core/api/internal/svc"
+	"github.com/coder-lulu/newbee-core/api/internal/types"
+)
+
+// swagger:route post /saml_provider/create samlprovider CreateSamlProvider
+//
+// Create SAML provider information | 创建SAML身份源
+//
+// Create SAML provider information | 创建SAML身份源
+//
+// Parameters:
+//  + name: body
+//    require: true
+//    in: body
+//    type: SamlProviderInfo
+//
+// Responses:
+//  200: BaseMsgResp
+
+func CreateSamlProviderHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.SamlProviderInfo
+		if err := httpx.Parse(r, &req, true); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := samlprovider.NewCreateSamlProviderLogic(r.Context(), svcCtx)
+		resp, err := l.CreateSamlProvider(&req)
+		if err != nil {
+			err = svcCtx.Trans.TransError(r.Context(), err)
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
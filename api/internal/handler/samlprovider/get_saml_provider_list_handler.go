@@ -0,0 +1,45 @@
+package samlprovider
+
+import (
+	"net/http"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+
+	"github.com/coder-lulu/newbee-core/api/internal/logic/samlprovider"
+	"github.com/coder-lulu/newbee-core/api/internal/svc"
+	"github.com/coder-lulu/newbee-core/api/internal/types"
+)
+
+// swagger:route post /saml_provider/list samlprovider GetSamlProviderList
+//
+// Get SAML provider list | 获取SAML身份源列表
+//
+// Get SAML provider list | 获取SAML身份源列表
+//
+// Parameters:
+//  + name: body
+//    require: true
+//    in: body
+//    type: SamlProviderListReq
+//
+// Responses:
+//  200: SamlProviderListResp
+
+func GetSamlProviderListHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.SamlProviderListReq
+		if err := httpx.Parse(r, &req, true); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := samlprovider.NewGetSamlProviderListLogic(r.Context(), svcCtx)
+		resp, err := l.GetSamlProviderList(&req)
+		if err != nil {
+			err = svcCtx.Trans.TransError(r.Context(), err)
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
@@ -0,0 +1,46 @@
+package samlprovider
+
+import (
+	"net/http"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+
+	"github.com/coder-lulu/newbee-core/api/internal/logic/samlprovider"
+	"github.com/coder-lulu/newbee-core/api/internal/svc"
+	"github.com/coder-lulu/newbee-core/api/internal/types"
+)
+
+// swagger:route get /saml/metadata samlprovider GetSamlSpMetadata
+//
+// Get the SP metadata | 获取SP元数据
+//
+// Get the SP metadata | 获取SP元数据
+//
+// Produces:
+//  - application/samlmetadata+xml
+//
+// Responses:
+//  200: description: SP metadata document
+
+func GetSamlSpMetadataHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.SamlSpMetadataReq
+		if err := httpx.Parse(r, &req, true); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := samlprovider.NewGetSamlSpMetadataLogic(r.Context(), svcCtx)
+		metadata, err := l.GetSamlSpMetadata(&req)
+		if err != nil {
+			err = svcCtx.Trans.TransError(r.Context(), err)
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		// 元数据直接输出 XML，供 IdP 通过地址导入
+		w.Header().Set("Content-Type", "application/samlmetadata+xml")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write(metadata)
+	}
+}
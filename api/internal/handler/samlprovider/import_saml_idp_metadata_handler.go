@@ -0,0 +1,45 @@
+package samlprovider
+
+import (
+	"net/http"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+
+	"github.com/coder-lulu/newbee-core/api/internal/logic/samlprovider"
+	"github.com/coder-lulu/newbee-core/api/internal/svc"
+	"github.com/coder-lulu/newbee-core/api/internal/types"
+)
+
+// swagger:route post /saml_provider/import_metadata samlprovider ImportSamlIdpMetadata
+//
+// Import the IdP metadata | 导入IdP元数据
+//
+// Import the IdP metadata | 导入IdP元数据
+//
+// Parameters:
+//  + name: body
+//    require: true
+//    in: body
+//    type: SamlMetadataImportReq
+//
+// Responses:
+//  200: BaseMsgResp
+
+func ImportSamlIdpMetadataHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.SamlMetadataImportReq
+		if err := httpx.Parse(r, &req, true); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := samlprovider.NewImportSamlIdpMetadataLogic(r.Context(), svcCtx)
+		resp, err := l.ImportSamlIdpMetadata(&req)
+		if err != nil {
+			err = svcCtx.Trans.TransError(r.Context(), err)
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
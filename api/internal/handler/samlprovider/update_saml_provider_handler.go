@@ -0,0 +1,45 @@
+package samlprovider
+
+import (
+	"net/http"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+
+	"github.com/coder-lulu/newbee-core/api/internal/logic/samlprovider"
+	"github.com/coder-lulu/newbee-core/api/internal/svc"
+	"github.com/coder-lulu/newbee-core/api/internal/types"
+)
+
+// swagger:route post /saml_provider/update samlprovider UpdateSamlProvider
+//
+// Update SAML provider information | 更新SAML身份源
+//
+// Update SAML provider information | 更新SAML身份源
+//
+// Parameters:
+//  + name: body
+//    require: true
+//    in: body
+//    type: SamlProviderInfo
+//
+// Responses:
+//  200: BaseMsgResp
+
+func UpdateSamlProviderHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.SamlProviderInfo
+		if err := httpx.Parse(r, &req, true); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := samlprovider.NewUpdateSamlProviderLogic(r.Context(), svcCtx)
+		resp, err := l.UpdateSamlProvider(&req)
+		if err != nil {
+			err = svcCtx.Trans.TransError(r.Context(), err)
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
@@ -0,0 +1,45 @@
+package scimtoken
+
+import (
+	"net/http"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+
+	"github.com/coder-lulu/newbee-core/api/internal/logic/scimtoken"
+	"github.com/coder-lulu/newbee-core/api/internal/svc"
+	"github.com/coder-lulu/newbee-core/api/internal/types"
+)
+
+// swagger:route post /scim_token/create scimtoken CreateScimToken
+//
+// Create SCIM token, the token is only returned once | 创建SCIM令牌，令牌只返回一次
+//
+// Create SCIM token, the token is only returned once | 创建SCIM令牌，令牌只返回一次
+//
+// Parameters:
+//  + name: body
+//    require: true
+//    in: body
+//    type: ScimTokenInfo
+//
+// Responses:
+//  200: ScimTokenCreateResp
+
+func CreateScimTokenHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.ScimTokenInfo
+		if err := httpx.Parse(r, &req, true); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := scimtoken.NewCreateScimTokenLogic(r.Context(), svcCtx)
+		resp, err := l.CreateScimToken(&req)
+		if err != nil {
+			err = svcCtx.Trans.TransError(r.Context(), err)
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
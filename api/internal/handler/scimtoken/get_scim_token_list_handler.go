@@ -0,0 +1,45 @@
+package scimtoken
+
+import (
+	"net/http"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+
+	"github.com/coder-lulu/newbee-core/api/internal/logic/scimtoken"
+	"github.com/coder-lulu/newbee-core/api/internal/svc"
+	"github.com/coder-lulu/newbee-core/api/internal/types"
+)
+
+// swagger:route post /scim_token/list scimtoken GetScimTokenList
+//
+// Get SCIM token list | 获取SCIM令牌列表
+//
+// Get SCIM token list | 获取SCIM令牌列表
+//
+// Parameters:
+//  + name: body
+//    require: true
+//    in: body
+//    type: ScimTokenListReq
+//
+// Responses:
+//  200: ScimTokenListResp
+
+func GetScimTokenListHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.ScimTokenListReq
+		if err := httpx.Parse(r, &req, true); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := scimtoken.NewGetScimTokenListLogic(r.Context(), svcCtx)
+		resp, err := l.GetScimTokenList(&req)
+		if err != nil {
+			err = svcCtx.Trans.TransError(r.Context(), err)
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
@@ -0,0 +1,45 @@
+package scimtoken
+
+import (
+	"net/http"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+
+	"github.com/coder-lulu/newbee-core/api/internal/logic/scimtoken"
+	"github.com/coder-lulu/newbee-core/api/internal/svc"
+	"github.com/coder-lulu/newbee-core/api/internal/types"
+)
+
+// swagger:route post /scim_token/update scimtoken UpdateScimToken
+//
+// Update SCIM token information | 更新SCIM令牌
+//
+// Update SCIM token information | 更新SCIM令牌
+//
+// Parameters:
+//  + name: body
+//    require: true
+//    in: body
+//    type: ScimTokenInfo
+//
+// Responses:
+//  200: BaseMsgResp
+
+func UpdateScimTokenHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.ScimTokenInfo
+		if err := httpx.Parse(r, &req, true); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := scimtoken.NewUpdateScimTokenLogic(r.Context(), svcCtx)
+		resp, err := l.UpdateScimToken(&req)
+		if err != nil {
+			err = svcCtx.Trans.TransError(r.Context(), err)
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
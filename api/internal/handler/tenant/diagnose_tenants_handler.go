@@ -0,0 +1,45 @@
+package tenant
+
+import (
+	"net/http"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+
+	"github.com/coder-lulu/newbee-core/api/internal/logic/tenant"
+	"github.com/coder-lulu/newbee-core/api/internal/svc"
+	"github.com/coder-lulu/newbee-core/api/internal/types"
+)
+
+// swagger:route post /tenant/doctor tenant DiagnoseTenants
+//
+// Check tenant data integrity | 诊断租户数据完整性
+//
+// Check tenant data integrity | 诊断租户数据完整性
+//
+// Parameters:
+//  + name: body
+//    require: true
+//    in: body
+//    type: TenantDoctorReq
+//
+// Responses:
+//  200: TenantDoctorResp
+
+func DiagnoseTenantsHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.TenantDoctorReq
+		if err := httpx.Parse(r, &req, true); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := tenant.NewDiagnoseTenantsLogic(r.Context(), svcCtx)
+		resp, err := l.DiagnoseTenants(&req)
+		if err != nil {
+			err = svcCtx.Trans.TransError(r.Context(), err)
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
@@ -0,0 +1,45 @@
+package tenant
+
+import (
+	"net/http"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+
+	"github.com/coder-lulu/newbee-core/api/internal/logic/tenant"
+	"github.com/coder-lulu/newbee-core/api/internal/svc"
+	"github.com/coder-lulu/newbee-core/api/internal/types"
+)
+
+// swagger:route post /tenant/impersonation/list tenant GetImpersonationSessionList
+//
+// Get impersonation session list | 获取租户模拟访问会话列表
+//
+// Get impersonation session list | 获取租户模拟访问会话列表
+//
+// Parameters:
+//  + name: body
+//    require: true
+//    in: body
+//    type: ImpersonationSessionListReq
+//
+// Responses:
+//  200: ImpersonationSessionListResp
+
+func GetImpersonationSessionListHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.ImpersonationSessionListReq
+		if err := httpx.Parse(r, &req, true); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := tenant.NewGetImpersonationSessionListLogic(r.Context(), svcCtx)
+		resp, err := l.GetImpersonationSessionList(&req)
+		if err != nil {
+			err = svcCtx.Trans.TransError(r.Context(), err)
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
@@ -0,0 +1,45 @@
+package tenant
+
+import (
+	"net/http"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+
+	"github.com/coder-lulu/newbee-core/api/internal/logic/tenant"
+	"github.com/coder-lulu/newbee-core/api/internal/svc"
+	"github.com/coder-lulu/newbee-core/api/internal/types"
+)
+
+// swagger:route post /tenant/init_job/list tenant GetTenantInitJobList
+//
+// Get tenant initialization job list | 获取租户初始化任务列表
+//
+// Get tenant initialization job list | 获取租户初始化任务列表
+//
+// Parameters:
+//  + name: body
+//    require: true
+//    in: body
+//    type: TenantInitJobListReq
+//
+// Responses:
+//  200: TenantInitJobListResp
+
+func GetTenantInitJobListHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.TenantInitJobListReq
+		if err := httpx.Parse(r, &req, true); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := tenant.NewGetTenantInitJobListLogic(r.Context(), svcCtx)
+		resp, err := l.GetTenantInitJobList(&req)
+		if err != nil {
+			err = svcCtx.Trans.TransError(r.Context(), err)
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
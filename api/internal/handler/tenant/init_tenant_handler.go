@@ -23,7 +23,7 @@ import (
 //    type: TenantInitReq
 //
 // Responses:
-//  200: BaseMsgResp
+//  200: TenantInitResp
 
 func InitTenantHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
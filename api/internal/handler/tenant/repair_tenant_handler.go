@@ -0,0 +1,45 @@
+package tenant
+
+import (
+	"net/http"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+
+	"github.com/coder-lulu/newbee-core/api/internal/logic/tenant"
+	"github.com/coder-lulu/newbee-core/api/internal/svc"
+	"github.com/coder-lulu/newbee-core/api/internal/types"
+)
+
+// swagger:route post /tenant/repair tenant RepairTenant
+//
+// Apply the repair plans of the tenant findings | 执行租户问题的修复计划
+//
+// Apply the repair plans of the tenant findings | 执行租户问题的修复计划
+//
+// Parameters:
+//  + name: body
+//    require: true
+//    in: body
+//    type: TenantRepairReq
+//
+// Responses:
+//  200: TenantDoctorResp
+
+func RepairTenantHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.TenantRepairReq
+		if err := httpx.Parse(r, &req, true); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := tenant.NewRepairTenantLogic(r.Context(), svcCtx)
+		resp, err := l.RepairTenant(&req)
+		if err != nil {
+			err = svcCtx.Trans.TransError(r.Context(), err)
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
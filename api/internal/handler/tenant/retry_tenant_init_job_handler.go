@@ -0,0 +1,45 @@
+package tenant
+
+import (
+	"net/http"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+
+	"github.com/coder-lulu/newbee-core/api/internal/logic/tenant"
+	"github.com/coder-lulu/newbee-core/api/internal/svc"
+	"github.com/coder-lulu/newbee-core/api/internal/types"
+)
+
+// swagger:route post /tenant/init_job/retry tenant RetryTenantInitJob
+//
+// Retry the plugins of the job which did not succeed | 重试初始化任务中未成功的插件
+//
+// Retry the plugins of the job which did not succeed | 重试初始化任务中未成功的插件
+//
+// Parameters:
+//  + name: body
+//    require: true
+//    in: body
+//    type: TenantInitJobRetryReq
+//
+// Responses:
+//  200: TenantInitResp
+
+func RetryTenantInitJobHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.TenantInitJobRetryReq
+		if err := httpx.Parse(r, &req, true); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := tenant.NewRetryTenantInitJobLogic(r.Context(), svcCtx)
+		resp, err := l.RetryTenantInitJob(&req)
+		if err != nil {
+			err = svcCtx.Trans.TransError(r.Context(), err)
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
@@ -0,0 +1,45 @@
+package tenant
+
+import (
+	"net/http"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+
+	"github.com/coder-lulu/newbee-core/api/internal/logic/tenant"
+	"github.com/coder-lulu/newbee-core/api/internal/svc"
+	"github.com/coder-lulu/newbee-core/api/internal/types"
+)
+
+// swagger:route post /tenant/impersonation/review tenant ReviewImpersonation
+//
+// Review the impersonation request | 审批租户模拟访问申请
+//
+// Review the impersonation request | 审批租户模拟访问申请
+//
+// Parameters:
+//  + name: body
+//    require: true
+//    in: body
+//    type: ImpersonationReviewReq
+//
+// Responses:
+//  200: ImpersonationSessionResp
+
+func ReviewImpersonationHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.ImpersonationReviewReq
+		if err := httpx.Parse(r, &req, true); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := tenant.NewReviewImpersonationLogic(r.Context(), svcCtx)
+		resp, err := l.ReviewImpersonation(&req)
+		if err != nil {
+			err = svcCtx.Trans.TransError(r.Context(), err)
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
@@ -12,9 +12,9 @@ import (
 
 // swagger:route post /tenant/switch tenant SwitchTenant
 //
-// Switch tenant for super admin | 超级管理员切换租户
+// Switch tenant for super admin, starts a time-boxed impersonation session | 超级管理员切换租户，开始限时模拟访问会话
 //
-// Switch tenant for super admin | 超级管理员切换租户
+// Switch tenant for super admin, starts a time-boxed impersonation session | 超级管理员切换租户，开始限时模拟访问会话
 //
 // Parameters:
 //  + name: body
@@ -23,7 +23,7 @@ import (
 //    type: TenantSwitchReq
 //
 // Responses:
-//  200: BaseMsgResp
+//  200: ImpersonationSessionResp
 
 func SwitchTenantHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
@@ -0,0 +1,45 @@
+package tenantinitplugin
+
+import (
+	"net/http"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+
+	"github.com/coder-lulu/newbee-core/api/internal/logic/tenantinitplugin"
+	"github.com/coder-lulu/newbee-core/api/internal/svc"
+	"github.com/coder-lulu/newbee-core/api/internal/types"
+)
+
+// swagger:route post /tenant_init_plugin tenantinitplugin GetTenantInitPluginById
+//
+// Get tenant initialization plugin by ID | 通过ID获取租户初始化远程插件
+//
+// Get tenant initialization plugin by ID | 通过ID获取租户初始化远程插件
+//
+// Parameters:
+//  + name: body
+//    require: true
+//    in: body
+//    type: IDReq
+//
+// Responses:
+//  200: TenantInitPluginInfoResp
+
+func GetTenantInitPluginByIdHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.IDReq
+		if err := httpx.Parse(r, &req, true); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := tenantinitplugin.NewGetTenantInitPluginByIdLogic(r.Context(), svcCtx)
+		resp, err := l.GetTenantInitPluginById(&req)
+		if err != nil {
+			err = svcCtx.Trans.TransError(r.Context(), err)
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
@@ -0,0 +1,45 @@
+package tenantinitplugin
+
+import (
+	"net/http"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+
+	"github.com/coder-lulu/newbee-core/api/internal/logic/tenantinitplugin"
+	"github.com/coder-lulu/newbee-core/api/internal/svc"
+	"github.com/coder-lulu/newbee-core/api/internal/types"
+)
+
+// swagger:route post /tenant_init_plugin/list tenantinitplugin GetTenantInitPluginList
+//
+// Get tenant initialization plugin list | 获取租户初始化远程插件列表
+//
+// Get tenant initialization plugin list | 获取租户初始化远程插件列表
+//
+// Parameters:
+//  + name: body
+//    require: true
+//    in: body
+//    type: TenantInitPluginListReq
+//
+// Responses:
+//  200: TenantInitPluginListResp
+
+func GetTenantInitPluginListHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.TenantInitPluginListReq
+		if err := httpx.Parse(r, &req, true); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := tenantinitplugin.NewGetTenantInitPluginListLogic(r.Context(), svcCtx)
+		resp, err := l.GetTenantInitPluginList(&req)
+		if err != nil {
+			err = svcCtx.Trans.TransError(r.Context(), err)
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
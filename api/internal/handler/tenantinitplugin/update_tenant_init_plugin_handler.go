@@ -0,0 +1,45 @@
+package tenantinitplugin
+
+import (
+	"net/http"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+
+	"github.com/coder-lulu/newbee-core/api/internal/logic/tenantinitplugin"
+	"github.com/coder-lulu/newbee-core/api/internal/svc"
+	"github.com/coder-lulu/newbee-core/api/internal/types"
+)
+
+// swagger:route post /tenant_init_plugin/update tenantinitplugin UpdateTenantInitPlugin
+//
+// Update tenant initialization plugin information | 更新租户初始化远程插件
+//
+// Update tenant initialization plugin information | 更新租户初始化远程插件
+//
+// Parameters:
+//  + name: body
+//    require: true
+//    in: body
+//    type: TenantInitPluginInfo
+//
+// Responses:
+//  200: BaseMsgResp
+
+func UpdateTenantInitPluginHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.TenantInitPluginInfo
+		if err := httpx.Parse(r, &req, true); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := tenantinitplugin.NewUpdateTenantInitPluginLogic(r.Context(), svcCtx)
+		resp, err := l.UpdateTenantInitPlugin(&req)
+		if err != nil {
+			err = svcCtx.Trans.TransError(r.Context(), err)
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
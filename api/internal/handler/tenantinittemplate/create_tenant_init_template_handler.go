@@ -0,0 +1,45 @@
+package tenantinittemplate
+
+import (
+	"net/http"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+
+	"github.com/coder-lulu/newbee-core/api/internal/logic/tenantinittemplate"
+	"github.com/coder-lulu/newbee-core/api/internal/svc"
+	"github.com/coder-lulu/newbee-core/api/internal/types"
+)
+
+// swagger:route post /tenant_init_template/create tenantinittemplate CreateTenantInitTemplate
+//
+// Create tenant initialization template information | 创建租户初始化模板
+//
+// Create tenant initialization template information | 创建租户初始化模板
+//
+// Parameters:
+//  + name: body
+//    require: true
+//    in: body
+//    type: TenantInitTemplateInfo
+//
+// Responses:
+//  200: BaseMsgResp
+
+func CreateTenantInitTemplateHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.TenantInitTemplateInfo
+		if err := httpx.Parse(r, &req, true); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := tenantinittemplate.NewCreateTenantInitTemplateLogic(r.Context(), svcCtx)
+		resp, err := l.CreateTenantInitTemplate(&req)
+		if err != nil {
+			err = svcCtx.Trans.TransError(r.Context(), err)
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
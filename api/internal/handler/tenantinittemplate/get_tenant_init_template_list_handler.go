@@ -0,0 +1,45 @@
+package tenantinittemplate
+
+import (
+	"net/http"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+
+	"github.com/coder-lulu/newbee-core/api/internal/logic/tenantinittemplate"
+	"github.com/coder-lulu/newbee-core/api/internal/svc"
+	"github.com/coder-lulu/newbee-core/api/internal/types"
+)
+
+// swagger:route post /tenant_init_template/list tenantinittemplate GetTenantInitTemplateList
+//
+// Get tenant initialization template list | 获取租户初始化模板列表
+//
+// Get tenant initialization template list | 获取租户初始化模板列表
+//
+// Parameters:
+//  + name: body
+//    require: true
+//    in: body
+//    type: TenantInitTemplateListReq
+//
+// Responses:
+//  200: TenantInitTemplateListResp
+
+func GetTenantInitTemplateListHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.TenantInitTemplateListReq
+		if err := httpx.Parse(r, &req, true); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := tenantinittemplate.NewGetTenantInitTemplateListLogic(r.Context(), svcCtx)
+		resp, err := l.GetTenantInitTemplateList(&req)
+		if err != nil {
+			err = svcCtx.Trans.TransError(r.Context(), err)
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
@@ -0,0 +1,45 @@
+package tenantinittemplate
+
+import (
+	"net/http"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+
+	"github.com/coder-lulu/newbee-core/api/internal/logic/tenantinittemplate"
+	"github.com/coder-lulu/newbee-core/api/internal/svc"
+	"github.com/coder-lulu/newbee-core/api/internal/types"
+)
+
+// swagger:route post /tenant_init_template/preview tenantinittemplate PreviewTenantInitTemplate
+//
+// Preview the template merged onto its base templates | 预览与基础模板合并后的模板
+//
+// Preview the template merged onto its base templates | 预览与基础模板合并后的模板
+//
+// Parameters:
+//  + name: body
+//    require: true
+//    in: body
+//    type: TenantInitTemplatePreviewReq
+//
+// Responses:
+//  200: TenantInitTemplatePreviewResp
+
+func PreviewTenantInitTemplateHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.TenantInitTemplatePreviewReq
+		if err := httpx.Parse(r, &req, true); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := tenantinittemplate.NewPreviewTenantInitTemplateLogic(r.Context(), svcCtx)
+		resp, err := l.PreviewTenantInitTemplate(&req)
+		if err != nil {
+			err = svcCtx.Trans.TransError(r.Context(), err)
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
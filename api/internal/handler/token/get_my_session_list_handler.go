@@ -0,0 +1,45 @@
+package token
+
+import (
+	"net/http"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+
+	"github.com/coder-lulu/newbee-core/api/internal/logic/token"
+	"github.com/coder-lulu/newbee-core/api/internal/svc"
+	"github.com/coder-lulu/newbee-core/api/internal/types"
+)
+
+// swagger:route post /session/my/list token GetMySessionList
+//
+// Get my session list | 获取当前用户的会话列表
+//
+// Get my session list | 获取当前用户的会话列表
+//
+// Parameters:
+//  + name: body
+//    require: true
+//    in: body
+//    type: PageInfo
+//
+// Responses:
+//  200: SessionListResp
+
+func GetMySessionListHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.PageInfo
+		if err := httpx.Parse(r, &req, true); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := token.NewGetMySessionListLogic(r.Context(), svcCtx)
+		resp, err := l.GetMySessionList(&req)
+		if err != nil {
+			err = svcCtx.Trans.TransError(r.Context(), err)
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
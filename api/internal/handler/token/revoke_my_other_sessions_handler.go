@@ -0,0 +1,32 @@
+package token
+
+import (
+	"net/http"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+
+	"github.com/coder-lulu/newbee-core/api/internal/logic/token"
+	"github.com/coder-lulu/newbee-core/api/internal/svc"
+)
+
+// swagger:route post /session/my/revoke_others token RevokeMyOtherSessions
+//
+// Revoke all my other sessions | 注销当前用户的其他全部会话
+//
+// Revoke all my other sessions | 注销当前用户的其他全部会话
+//
+// Responses:
+//  200: BaseMsgResp
+
+func RevokeMyOtherSessionsHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		l := token.NewRevokeMyOtherSessionsLogic(r.Context(), svcCtx)
+		resp, err := l.RevokeMyOtherSessions()
+		if err != nil {
+			err = svcCtx.Trans.TransError(r.Context(), err)
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
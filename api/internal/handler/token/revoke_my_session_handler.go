@@ -1,20 +1,20 @@
-package auditlog
+package token
 
 import (
 	"net/http"
 
 	"github.com/zeromicro/go-zero/rest/httpx"
 
-	"github.com/coder-lulu/newbee-core/api/internal/logic/auditlog"
+	"github.com/coder-lulu/newbee-core/api/internal/logic/token"
 	"github.com/coder-lulu/newbee-core/api/internal/svc"
 	"github.com/coder-lulu/newbee-core/api/internal/types"
 )
 
-// swagger:route post /audit-log/delete auditlog DeleteAuditLog
+// swagger:route post /session/my/revoke token RevokeMySession
 //
-// Delete audit logs | 删除审计日志
+// Revoke my sessions by ID | 注销当前用户的指定会话
 //
-// Delete audit logs | 删除审计日志
+// Revoke my sessions by ID | 注销当前用户的指定会话
 //
 // Parameters:
 //  + name: body
@@ -25,7 +25,7 @@ import (
 // Responses:
 //  200: BaseMsgResp
 
-func DeleteAuditLogHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+func RevokeMySessionHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		var req types.UUIDsReq
 		if err := httpx.Parse(r, &req, true); err != nil {
@@ -33,8 +33,8 @@ func DeleteAuditLogHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
 			return
 		}
 
-		l := auditlog.NewDeleteAuditLogLogic(r.Context(), svcCtx)
-		resp, err := l.DeleteAuditLog(&req)
+		l := token.NewRevokeMySessionLogic(r.Context(), svcCtx)
+		resp, err := l.RevokeMySession(&req)
 		if err != nil {
 			err = svcCtx.Trans.TransError(r.Context(), err)
 			httpx.ErrorCtx(r.Context(), w, err)
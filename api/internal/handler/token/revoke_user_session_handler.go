@@ -0,0 +1,45 @@
+package token
+
+import (
+	"net/http"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+
+	"github.com/coder-lulu/newbee-core/api/internal/logic/token"
+	"github.com/coder-lulu/newbee-core/api/internal/svc"
+	"github.com/coder-lulu/newbee-core/api/internal/types"
+)
+
+// swagger:route post /session/revoke token RevokeUserSession
+//
+// Revoke user's sessions | 注销用户的会话
+//
+// Revoke user's sessions | 注销用户的会话
+//
+// Parameters:
+//  + name: body
+//    require: true
+//    in: body
+//    type: UserSessionRevokeReq
+//
+// Responses:
+//  200: BaseMsgResp
+
+func RevokeUserSessionHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.UserSessionRevokeReq
+		if err := httpx.Parse(r, &req, true); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := token.NewRevokeUserSessionLogic(r.Context(), svcCtx)
+		resp, err := l.RevokeUserSession(&req)
+		if err != nil {
+			err = svcCtx.Trans.TransError(r.Context(), err)
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
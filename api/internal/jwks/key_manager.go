@@ -0,0 +1,296 @@
+package jwks
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"sort"
+	"time"
+
+	commonjwt "github.com/coder-lulu/newbee-common/v2/utils/jwt"
+	"github.com/golang-jwt/jwt/v5"
+
+	"github.com/coder-lulu/newbee-core/api/internal/config"
+	"github.com/coder-lulu/newbee-core/api/internal/session"
+)
+
+const AlgorithmHS256 = "HS256"
+
+// signingKey 已加载的签名/验签密钥
+type signingKey struct {
+	kid       string
+	method    jwt.SigningMethod
+	private   crypto.Signer
+	public    crypto.PublicKey
+	expiredAt int64
+}
+
+func (k *signingKey) expired(now time.Time) bool {
+	return k.expiredAt > 0 && now.Unix() > k.expiredAt
+}
+
+// KeyManager 管理令牌签名密钥，负责签发令牌、验证非对称令牌以及输出 JWKS
+type KeyManager struct {
+	algorithm string
+	secret    string
+	active    *signingKey
+	keys      map[string]*signingKey
+}
+
+// NewKeyManager 根据配置加载密钥，secret 为 HS256 模式以及内部中间件使用的共享密钥
+func NewKeyManager(c config.JwtKeyConf, secret string) (*KeyManager, error) {
+	m := &KeyManager{
+		algorithm: c.Algorithm,
+		secret:    secret,
+		keys:      make(map[string]*signingKey),
+	}
+	if m.algorithm == "" {
+		m.algorithm = AlgorithmHS256
+	}
+
+	for _, kc := range c.Keys {
+		if kc.Kid == "" {
+			return nil, errors.New("jwt key kid cannot be empty")
+		}
+		if _, exist := m.keys[kc.Kid]; exist {
+			return nil, fmt.Errorf("duplicate jwt key kid: %s", kc.Kid)
+		}
+		alg := kc.Algorithm
+		if alg == "" {
+			alg = m.algorithm
+		}
+		key, err := loadKey(kc, alg)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load jwt key %s: %w", kc.Kid, err)
+		}
+		m.keys[kc.Kid] = key
+	}
+
+	if m.algorithm == AlgorithmHS256 {
+		return m, nil
+	}
+
+	active, ok := m.keys[c.ActiveKid]
+	if !ok {
+		return nil, fmt.Errorf("active jwt key %q not found", c.ActiveKid)
+	}
+	if active.private == nil {
+		return nil, fmt.Errorf("active jwt key %q has no private key", c.ActiveKid)
+	}
+	if active.expired(time.Now()) {
+		return nil, fmt.Errorf("active jwt key %q has expired", c.ActiveKid)
+	}
+	m.active = active
+
+	return m, nil
+}
+
+// MustNewKeyManager 同 NewKeyManager，失败时 panic
+func MustNewKeyManager(c config.JwtKeyConf, secret string) *KeyManager {
+	m, err := NewKeyManager(c, secret)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// Asymmetric 是否启用非对称签名
+func (m *KeyManager) Asymmetric() bool {
+	return m.active != nil
+}
+
+// NewJwtToken 签发令牌，参数与 jwt.NewJwtToken 保持一致。
+// 非对称模式下在 header 中写入 kid，便于其它服务通过 JWKS 离线验签。
+// 令牌同时带有毫秒签发时间，吊销水位线据此区分吊销前后签发的令牌。
+func (m *KeyManager) NewJwtToken(iat, seconds int64, opt ...commonjwt.Option) (string, error) {
+	opt = append(opt, commonjwt.WithOption(session.ClaimIssuedAtMs, time.Now().UnixMilli()))
+	if !m.Asymmetric() {
+		return commonjwt.NewJwtToken(m.secret, iat, seconds, opt...)
+	}
+
+	claims := make(jwt.MapClaims)
+	claims["exp"] = iat + seconds
+	claims["iat"] = iat
+	for _, v := range opt {
+		claims[v.Key] = v.Val
+	}
+
+	token := jwt.NewWithClaims(m.active.method, claims)
+	token.Header["kid"] = m.active.kid
+	return token.SignedString(m.active.private)
+}
+
+// Parse 验证非对称签名的令牌，未过期的已退役密钥仍然可以验签
+func (m *KeyManager) Parse(tokenString string) (jwt.MapClaims, error) {
+	claims := make(jwt.MapClaims)
+	_, err := jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (any, error) {
+		kid, _ := token.Header["kid"].(string)
+		key, ok := m.keys[kid]
+		if !ok || key.expired(time.Now()) {
+			return nil, fmt.Errorf("unknown jwt key: %s", kid)
+		}
+		if token.Method.Alg() != key.method.Alg() {
+			return nil, fmt.Errorf("unexpected signing method: %s", token.Method.Alg())
+		}
+		return key.public, nil
+	}, jwt.WithValidMethods([]string{
+		jwt.SigningMethodRS256.Alg(),
+		jwt.SigningMethodES256.Alg(),
+		jwt.SigningMethodEdDSA.Alg(),
+	}), jwt.WithExpirationRequired())
+	if err != nil {
+		return nil, err
+	}
+	return claims, nil
+}
+
+// Verify 验证 core 签发的令牌，HS256 模式下使用共享密钥，否则同 Parse
+func (m *KeyManager) Verify(tokenString string) (jwt.MapClaims, error) {
+	if m.Asymmetric() {
+		return m.Parse(tokenString)
+	}
+
+	claims := make(jwt.MapClaims)
+	_, err := jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (any, error) {
+		return []byte(m.secret), nil
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}), jwt.WithExpirationRequired())
+	if err != nil {
+		return nil, err
+	}
+	return claims, nil
+}
+
+// Algorithm 当前签发令牌使用的算法
+func (m *KeyManager) Algorithm() string {
+	if m.Asymmetric() {
+		return m.active.method.Alg()
+	}
+	return AlgorithmHS256
+}
+
+// JSONWebKey RFC 7517 公钥
+type JSONWebKey struct {
+	Kty string `json:"kty"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	Kid string `json:"kid"`
+	N   string `json:"n,omitempty"`
+	E   string `json:"e,omitempty"`
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+	Y   string `json:"y,omitempty"`
+}
+
+// JSONWebKeySet RFC 7517 密钥集合
+type JSONWebKeySet struct {
+	Keys []JSONWebKey `json:"keys"`
+}
+
+// JWKS 返回当前可用于验签的全部公钥
+func (m *KeyManager) JWKS() JSONWebKeySet {
+	now := time.Now()
+	set := JSONWebKeySet{Keys: []JSONWebKey{}}
+	for _, k := range m.keys {
+		if k.expired(now) {
+			continue
+		}
+		set.Keys = append(set.Keys, toJSONWebKey(k))
+	}
+	sort.Slice(set.Keys, func(i, j int) bool {
+		return set.Keys[i].Kid < set.Keys[j].Kid
+	})
+	return set
+}
+
+func toJSONWebKey(k *signingKey) JSONWebKey {
+	jwk := JSONWebKey{Use: "sig", Alg: k.method.Alg(), Kid: k.kid}
+	enc := base64.RawURLEncoding
+	switch pub := k.public.(type) {
+	case *rsa.PublicKey:
+		jwk.Kty = "RSA"
+		jwk.N = enc.EncodeToString(pub.N.Bytes())
+		jwk.E = enc.EncodeToString(big.NewInt(int64(pub.E)).Bytes())
+	case *ecdsa.PublicKey:
+		size := (pub.Curve.Params().BitSize + 7) / 8
+		jwk.Kty = "EC"
+		jwk.Crv = pub.Curve.Params().Name
+		jwk.X = enc.EncodeToString(pub.X.FillBytes(make([]byte, size)))
+		jwk.Y = enc.EncodeToString(pub.Y.FillBytes(make([]byte, size)))
+	case ed25519.PublicKey:
+		jwk.Kty = "OKP"
+		jwk.Crv = "Ed25519"
+		jwk.X = enc.EncodeToString(pub)
+	}
+	return jwk
+}
+
+func loadKey(c config.JwtSigningKey, alg string) (*signingKey, error) {
+	key := &signingKey{kid: c.Kid, expiredAt: c.ExpiredAt}
+
+	var privatePEM, publicPEM []byte
+	var err error
+	if c.PrivateKeyFile != "" {
+		if privatePEM, err = os.ReadFile(c.PrivateKeyFile); err != nil {
+			return nil, err
+		}
+	}
+	if c.PublicKeyFile != "" {
+		if publicPEM, err = os.ReadFile(c.PublicKeyFile); err != nil {
+			return nil, err
+		}
+	}
+	if privatePEM == nil && publicPEM == nil {
+		return nil, errors.New("either private key or public key is required")
+	}
+
+	switch alg {
+	case "RS256":
+		key.method = jwt.SigningMethodRS256
+		if privatePEM != nil {
+			if key.private, err = jwt.ParseRSAPrivateKeyFromPEM(privatePEM); err != nil {
+				return nil, err
+			}
+		} else if key.public, err = jwt.ParseRSAPublicKeyFromPEM(publicPEM); err != nil {
+			return nil, err
+		}
+	case "ES256":
+		key.method = jwt.SigningMethodES256
+		if privatePEM != nil {
+			var ecKey *ecdsa.PrivateKey
+			if ecKey, err = jwt.ParseECPrivateKeyFromPEM(privatePEM); err != nil {
+				return nil, err
+			}
+			if ecKey.Curve.Params().Name != "P-256" {
+				return nil, errors.New("ES256 requires a P-256 key")
+			}
+			key.private = ecKey
+		} else if key.public, err = jwt.ParseECPublicKeyFromPEM(publicPEM); err != nil {
+			return nil, err
+		}
+	case "EdDSA":
+		key.method = jwt.SigningMethodEdDSA
+		if privatePEM != nil {
+			var edKey crypto.PrivateKey
+			if edKey, err = jwt.ParseEdPrivateKeyFromPEM(privatePEM); err != nil {
+				return nil, err
+			}
+			key.private = edKey.(ed25519.PrivateKey)
+		} else if key.public, err = jwt.ParseEdPublicKeyFromPEM(publicPEM); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("unsupported jwt algorithm: %s", alg)
+	}
+
+	if key.private != nil {
+		key.public = key.private.Public()
+	}
+
+	return key, nil
+}
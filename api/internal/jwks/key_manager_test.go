@@ -0,0 +1,316 @@
+package jwks
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	commonjwt "github.com/coder-lulu/newbee-common/v2/utils/jwt"
+	"github.com/golang-jwt/jwt/v5"
+
+	"github.com/coder-lulu/newbee-core/api/internal/config"
+	"github.com/coder-lulu/newbee-core/api/internal/session"
+)
+
+const testSecret = "internal-secret"
+
+// newKey generates a private key of the algorithm
+func newKey(t *testing.T, alg string) crypto.Signer {
+	t.Helper()
+
+	var key crypto.Signer
+	var err error
+	switch alg {
+	case "RS256":
+		key, err = rsa.GenerateKey(rand.Reader, 2048)
+	case "ES256":
+		key, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	case "EdDSA":
+		_, key, err = ed25519.GenerateKey(rand.Reader)
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	return key
+}
+
+// writeKey writes the key as PEM files and returns its configuration, a retired key only has the public key
+func writeKey(t *testing.T, kid, alg string, key crypto.Signer, retired bool, expiredAt int64) config.JwtSigningKey {
+	t.Helper()
+
+	c := config.JwtSigningKey{Kid: kid, Algorithm: alg, ExpiredAt: expiredAt}
+	dir := t.TempDir()
+	if retired {
+		der, err := x509.MarshalPKIXPublicKey(key.Public())
+		if err != nil {
+			t.Fatal(err)
+		}
+		c.PublicKeyFile = filepath.Join(dir, kid+".pub")
+		writePEM(t, c.PublicKeyFile, "PUBLIC KEY", der)
+		return c
+	}
+
+	var der []byte
+	var err error
+	blockType := "PRIVATE KEY"
+	switch k := key.(type) {
+	case *rsa.PrivateKey:
+		der, blockType = x509.MarshalPKCS1PrivateKey(k), "RSA PRIVATE KEY"
+	case *ecdsa.PrivateKey:
+		der, err = x509.MarshalECPrivateKey(k)
+		blockType = "EC PRIVATE KEY"
+	default:
+		der, err = x509.MarshalPKCS8PrivateKey(k)
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.PrivateKeyFile = filepath.Join(dir, kid+".key")
+	writePEM(t, c.PrivateKeyFile, blockType, der)
+	return c
+}
+
+func writePEM(t *testing.T, file, blockType string, der []byte) {
+	t.Helper()
+	if err := os.WriteFile(file, pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der}), 0o600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func newTestKeyManager(t *testing.T, alg, activeKid string, keys ...config.JwtSigningKey) *KeyManager {
+	t.Helper()
+
+	m, err := NewKeyManager(config.JwtKeyConf{Algorithm: alg, ActiveKid: activeKid, Keys: keys}, testSecret)
+	if err != nil {
+		t.Fatalf("NewKeyManager: %v", err)
+	}
+	return m
+}
+
+// sign signs the claims with the key and kid, bypassing the key manager
+func sign(t *testing.T, method jwt.SigningMethod, key any, kid string, claims jwt.MapClaims) string {
+	t.Helper()
+
+	token := jwt.NewWithClaims(method, claims)
+	if kid != "" {
+		token.Header["kid"] = kid
+	}
+	raw, err := token.SignedString(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return raw
+}
+
+func validClaims() jwt.MapClaims {
+	now := time.Now()
+	return jwt.MapClaims{"userId": "u1", "iat": now.Unix(), "exp": now.Add(time.Hour).Unix()}
+}
+
+func decode(t *testing.T, s string) []byte {
+	t.Helper()
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		t.Fatalf("decode %q: %v", s, err)
+	}
+	return b
+}
+
+func TestJWKSEncoding(t *testing.T) {
+	tests := []struct {
+		alg string
+		kty string
+		crv string
+		// publicKey 由 JWK 重建公钥，其它服务按同样的方式离线验签
+		publicKey func(t *testing.T, jwk JSONWebKey) any
+	}{
+		{alg: "RS256", kty: "RSA", publicKey: func(t *testing.T, jwk JSONWebKey) any {
+			return &rsa.PublicKey{
+				N: new(big.Int).SetBytes(decode(t, jwk.N)),
+				E: int(new(big.Int).SetBytes(decode(t, jwk.E)).Int64()),
+			}
+		}},
+		{alg: "ES256", kty: "EC", crv: "P-256", publicKey: func(t *testing.T, jwk JSONWebKey) any {
+			x, y := decode(t, jwk.X), decode(t, jwk.Y)
+			// 坐标按曲线长度补齐前导零
+			if len(x) != 32 || len(y) != 32 {
+				t.Errorf("coordinate lengths = %d, %d, want 32", len(x), len(y))
+			}
+			return &ecdsa.PublicKey{Curve: elliptic.P256(), X: new(big.Int).SetBytes(x), Y: new(big.Int).SetBytes(y)}
+		}},
+		{alg: "EdDSA", kty: "OKP", crv: "Ed25519", publicKey: func(t *testing.T, jwk JSONWebKey) any {
+			return ed25519.PublicKey(decode(t, jwk.X))
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.alg, func(t *testing.T) {
+			m := newTestKeyManager(t, tt.alg, "k1", writeKey(t, "k1", "", newKey(t, tt.alg), false, 0))
+
+			set := m.JWKS()
+			if len(set.Keys) != 1 {
+				t.Fatalf("JWKS = %+v, want one key", set)
+			}
+			jwk := set.Keys[0]
+			if jwk.Kty != tt.kty || jwk.Crv != tt.crv || jwk.Alg != tt.alg || jwk.Kid != "k1" || jwk.Use != "sig" {
+				t.Errorf("JWK = %+v", jwk)
+			}
+
+			raw, err := m.NewJwtToken(time.Now().Unix(), 3600, commonjwt.WithOption("userId", "u1"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			_, err = jwt.Parse(raw, func(token *jwt.Token) (any, error) {
+				return tt.publicKey(t, jwk), nil
+			}, jwt.WithValidMethods([]string{tt.alg}))
+			if err != nil {
+				t.Errorf("token does not verify with the published key: %v", err)
+			}
+		})
+	}
+}
+
+func TestKeyRotation(t *testing.T) {
+	now := time.Now()
+	oldKey, retiredKey := newKey(t, "RS256"), newKey(t, "RS256")
+	m := newTestKeyManager(t, "RS256", "new",
+		writeKey(t, "new", "", newKey(t, "RS256"), false, 0),
+		// 轮换后旧密钥只保留公钥，在 ExpiredAt 之前仍可验签
+		writeKey(t, "old", "", oldKey, true, now.Add(time.Hour).Unix()),
+		writeKey(t, "retired", "", retiredKey, true, now.Add(-time.Minute).Unix()),
+		writeKey(t, "ed", "EdDSA", newKey(t, "EdDSA"), true, 0),
+	)
+
+	var kids []string
+	for _, k := range m.JWKS().Keys {
+		kids = append(kids, k.Kid)
+	}
+	if len(kids) != 3 || kids[0] != "ed" || kids[1] != "new" || kids[2] != "old" {
+		t.Errorf("JWKS kids = %v, want [ed new old]", kids)
+	}
+
+	raw, err := m.NewJwtToken(now.Unix(), 3600, commonjwt.WithOption("userId", "u1"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	token, _, err := jwt.NewParser().ParseUnverified(raw, jwt.MapClaims{})
+	if err != nil || token.Header["kid"] != "new" {
+		t.Fatalf("new tokens are not signed by the active key: %v", token.Header)
+	}
+	claims, err := m.Parse(raw)
+	if err != nil {
+		t.Fatalf("Parse(active): %v", err)
+	}
+	if _, ok := claims[session.ClaimIssuedAtMs]; !ok {
+		t.Error("the token has no millisecond issue time")
+	}
+
+	if _, err = m.Parse(sign(t, jwt.SigningMethodRS256, oldKey, "old", validClaims())); err != nil {
+		t.Errorf("Parse(old): %v", err)
+	}
+	if _, err = m.Parse(sign(t, jwt.SigningMethodRS256, retiredKey, "retired", validClaims())); err == nil {
+		t.Error("Parse accepted a token of an expired key")
+	}
+}
+
+func TestParseRejected(t *testing.T) {
+	rsaKey, ecKey := newKey(t, "RS256"), newKey(t, "ES256")
+	m := newTestKeyManager(t, "RS256", "rsa",
+		writeKey(t, "rsa", "", rsaKey, false, 0),
+		writeKey(t, "ec", "ES256", ecKey, false, 0),
+	)
+
+	publicDER, err := x509.MarshalPKIXPublicKey(rsaKey.Public())
+	if err != nil {
+		t.Fatal(err)
+	}
+	publicPEM := pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicDER})
+	without := func(key string) jwt.MapClaims {
+		claims := validClaims()
+		delete(claims, key)
+		return claims
+	}
+	expired := validClaims()
+	expired["exp"] = time.Now().Add(-time.Minute).Unix()
+
+	tests := []struct {
+		name  string
+		token string
+	}{
+		{name: "alg of another key", token: sign(t, jwt.SigningMethodES256, ecKey, "rsa", validClaims())},
+		{name: "RS256 with the kid of an ES256 key", token: sign(t, jwt.SigningMethodRS256, rsaKey, "ec", validClaims())},
+		{name: "HS256 with the internal secret", token: sign(t, jwt.SigningMethodHS256, []byte(testSecret), "rsa", validClaims())},
+		{name: "HS256 with the public key", token: sign(t, jwt.SigningMethodHS256, publicPEM, "rsa", validClaims())},
+		{name: "alg none", token: sign(t, jwt.SigningMethodNone, jwt.UnsafeAllowNoneSignatureType, "rsa", validClaims())},
+		{name: "unknown kid", token: sign(t, jwt.SigningMethodRS256, rsaKey, "other", validClaims())},
+		{name: "missing kid", token: sign(t, jwt.SigningMethodRS256, rsaKey, "", validClaims())},
+		{name: "bad signature", token: sign(t, jwt.SigningMethodRS256, newKey(t, "RS256"), "rsa", validClaims())},
+		{name: "expired", token: sign(t, jwt.SigningMethodRS256, rsaKey, "rsa", expired)},
+		{name: "missing expiry", token: sign(t, jwt.SigningMethodRS256, rsaKey, "rsa", without("exp"))},
+		{name: "malformed", token: "not-a-jwt"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := m.Parse(tt.token); err == nil {
+				t.Error("Parse accepted the token")
+			}
+		})
+	}
+
+	if _, err = m.Parse(sign(t, jwt.SigningMethodES256, ecKey, "ec", validClaims())); err != nil {
+		t.Errorf("Parse(ec): %v", err)
+	}
+}
+
+func TestNewKeyManagerInvalid(t *testing.T) {
+	rsaKey := newKey(t, "RS256")
+	p384, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		conf func(t *testing.T) config.JwtKeyConf
+	}{
+		{name: "unknown active key", conf: func(t *testing.T) config.JwtKeyConf {
+			return config.JwtKeyConf{Algorithm: "RS256", ActiveKid: "k2", Keys: []config.JwtSigningKey{writeKey(t, "k1", "", rsaKey, false, 0)}}
+		}},
+		{name: "active key without private key", conf: func(t *testing.T) config.JwtKeyConf {
+			return config.JwtKeyConf{Algorithm: "RS256", ActiveKid: "k1", Keys: []config.JwtSigningKey{writeKey(t, "k1", "", rsaKey, true, 0)}}
+		}},
+		{name: "expired active key", conf: func(t *testing.T) config.JwtKeyConf {
+			return config.JwtKeyConf{Algorithm: "RS256", ActiveKid: "k1", Keys: []config.JwtSigningKey{writeKey(t, "k1", "", rsaKey, false, 1)}}
+		}},
+		{name: "duplicate kid", conf: func(t *testing.T) config.JwtKeyConf {
+			k := writeKey(t, "k1", "", rsaKey, false, 0)
+			return config.JwtKeyConf{Algorithm: "RS256", ActiveKid: "k1", Keys: []config.JwtSigningKey{k, k}}
+		}},
+		{name: "ES256 with a P-384 key", conf: func(t *testing.T) config.JwtKeyConf {
+			return config.JwtKeyConf{Algorithm: "ES256", ActiveKid: "k1", Keys: []config.JwtSigningKey{writeKey(t, "k1", "", p384, false, 0)}}
+		}},
+		{name: "key of another algorithm", conf: func(t *testing.T) config.JwtKeyConf {
+			return config.JwtKeyConf{Algorithm: "EdDSA", ActiveKid: "k1", Keys: []config.JwtSigningKey{writeKey(t, "k1", "", rsaKey, false, 0)}}
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := NewKeyManager(tt.conf(t), testSecret); err == nil {
+				t.Error("NewKeyManager accepted the configuration")
+			}
+		})
+	}
+}
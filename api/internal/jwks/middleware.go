@@ -0,0 +1,69 @@
+package jwks
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/redis/go-redis/v9"
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/rest/httpx"
+
+	"github.com/coder-lulu/newbee-core/api/internal/session"
+)
+
+// NewAuthMiddleware 将非对称签名的令牌转换为内部 HS256 令牌后交给统一中间件链处理。
+// 统一认证中间件只支持共享密钥验签，core 自身签发的 RS256/ES256/EdDSA 令牌在这里先用公钥校验，
+// 校验失败或令牌已被拉黑时保持原样，由后续认证中间件拒绝请求。
+func NewAuthMiddleware(m *KeyManager, rds redis.UniversalClient) func(next http.HandlerFunc) http.HandlerFunc {
+	return func(next http.HandlerFunc) http.HandlerFunc {
+		return m.handle(rds, next)
+	}
+}
+
+func (m *KeyManager) handle(rds redis.UniversalClient, next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !m.Asymmetric() {
+			next(w, r)
+			return
+		}
+
+		auth := r.Header.Get("Authorization")
+		tokenString, found := strings.CutPrefix(auth, "Bearer ")
+		if !found || tokenString == "" {
+			next(w, r)
+			return
+		}
+
+		claims, err := m.Parse(tokenString)
+		if err != nil {
+			logx.WithContext(r.Context()).Debugw("failed to verify asymmetric jwt", logx.Field("detail", err.Error()))
+			next(w, r)
+			return
+		}
+
+		// 黑名单以原始令牌为键，转换后的内部令牌无法命中，需要在这里先检查黑名单和吊销水位线
+		if session.Revoked(r.Context(), rds, tokenString, claims) {
+			next(w, r)
+			return
+		}
+
+		internal, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(m.secret))
+		if err != nil {
+			logx.WithContext(r.Context()).Errorw("failed to sign internal jwt", logx.Field("detail", err.Error()))
+			next(w, r)
+			return
+		}
+		r.Header.Set("Authorization", "Bearer "+internal)
+
+		next(w, r)
+	}
+}
+
+// JWKSHandler 输出 /.well-known/jwks.json
+func (m *KeyManager) JWKSHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Cache-Control", "public, max-age=300")
+		httpx.OkJsonCtx(r.Context(), w, m.JWKS())
+	}
+}
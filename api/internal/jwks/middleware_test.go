@@ -0,0 +1,132 @@
+package jwks
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	commonconfig "github.com/coder-lulu/newbee-common/v2/config"
+	commonjwt "github.com/coder-lulu/newbee-common/v2/utils/jwt"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/redis/go-redis/v9"
+
+	"github.com/coder-lulu/newbee-core/api/internal/session"
+)
+
+func TestAuthMiddleware(t *testing.T) {
+	mr := miniredis.RunT(t)
+	rds := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { _ = rds.Close() })
+
+	m := newTestKeyManager(t, "RS256", "k1", writeKey(t, "k1", "", newKey(t, "RS256"), false, 0))
+	issue := func() string {
+		raw, err := m.NewJwtToken(time.Now().Unix(), 3600, commonjwt.WithOption("userId", "u1"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return raw
+	}
+
+	blacklisted := issue()
+	mr.Set(commonconfig.RedisTokenPrefix+blacklisted, "1")
+	revokedUser := issue()
+	forged := sign(t, jwt.SigningMethodRS256, newKey(t, "RS256"), "k1", validClaims())
+	valid := issue()
+
+	tests := []struct {
+		name  string
+		token string
+		// resigned 为 true 时转换为内部 HS256 令牌，否则保持原样交给后续中间件拒绝
+		resigned bool
+	}{
+		{name: "valid", token: valid, resigned: true},
+		{name: "forged", token: forged},
+		{name: "blacklisted", token: blacklisted},
+		{name: "internal HS256", token: sign(t, jwt.SigningMethodHS256, []byte(testSecret), "", validClaims())},
+		{name: "no token"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got string
+			handler := NewAuthMiddleware(m, rds)(func(w http.ResponseWriter, r *http.Request) {
+				got = r.Header.Get("Authorization")
+			})
+
+			r := httptest.NewRequest(http.MethodGet, "/", nil)
+			if tt.token != "" {
+				r.Header.Set("Authorization", "Bearer "+tt.token)
+			}
+			handler(httptest.NewRecorder(), r)
+
+			if !tt.resigned {
+				want := ""
+				if tt.token != "" {
+					want = "Bearer " + tt.token
+				}
+				if got != want {
+					t.Errorf("Authorization = %q, want the original header", got)
+				}
+				return
+			}
+
+			// 内部令牌用共享密钥签名，声明与原始令牌一致
+			claims := jwt.MapClaims{}
+			_, err := jwt.ParseWithClaims(got[len("Bearer "):], claims, func(token *jwt.Token) (any, error) {
+				return []byte(testSecret), nil
+			}, jwt.WithValidMethods([]string{AlgorithmHS256}))
+			if err != nil {
+				t.Fatalf("internal token: %v", err)
+			}
+			original, err := m.Parse(tt.token)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, key := range []string{"userId", "exp", "iat", session.ClaimIssuedAtMs} {
+				if claims[key] != original[key] {
+					t.Errorf("claim %s = %v, want %v", key, claims[key], original[key])
+				}
+			}
+		})
+	}
+
+	// 吊销水位线之前签发的令牌不转换
+	mr.Set(session.RedisTokenRevokedBeforePrefix+"u1", strconv.FormatInt(time.Now().Add(time.Second).UnixMilli(), 10))
+	var got string
+	handler := NewAuthMiddleware(m, rds)(func(w http.ResponseWriter, r *http.Request) {
+		got = r.Header.Get("Authorization")
+	})
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	r.Header.Set("Authorization", "Bearer "+revokedUser)
+	handler(httptest.NewRecorder(), r)
+	if got != "Bearer "+revokedUser {
+		t.Error("a token issued before the revocation watermark was re-signed")
+	}
+}
+
+func TestAuthMiddlewareSymmetric(t *testing.T) {
+	m := newTestKeyManager(t, AlgorithmHS256, "")
+	raw, err := m.NewJwtToken(time.Now().Unix(), 3600, commonjwt.WithOption("userId", "u1"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// HS256 模式下令牌原样交给统一认证中间件，不访问 Redis
+	var got string
+	handler := NewAuthMiddleware(m, nil)(func(w http.ResponseWriter, r *http.Request) {
+		got = r.Header.Get("Authorization")
+	})
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	r.Header.Set("Authorization", "Bearer "+raw)
+	handler(httptest.NewRecorder(), r)
+
+	if got != "Bearer "+raw {
+		t.Errorf("Authorization = %q, want the original token", got)
+	}
+	if _, err = m.Verify(raw); err != nil {
+		t.Errorf("Verify: %v", err)
+	}
+}
@@ -38,14 +38,16 @@ func (l *GetApiByIdLogic) GetApiById(req *types.IDReq) (resp *types.ApiInfoResp,
 			Msg:  l.svcCtx.Trans.Trans(l.ctx, i18n.Success),
 		},
 		Data: types.ApiInfo{
-			BaseIDInfo:  types.BaseIDInfo{Id: data.Id, CreatedAt: data.CreatedAt, UpdatedAt: data.UpdatedAt},
-			Trans:       l.svcCtx.Trans.Trans(l.ctx, *data.Description),
-			Path:        data.Path,
-			Description: data.Description,
-			Group:       data.ApiGroup,
-			Method:      data.Method,
-			IsRequired:  data.IsRequired,
-			ServiceName: data.ServiceName,
+			BaseIDInfo:   types.BaseIDInfo{Id: data.Id, CreatedAt: data.CreatedAt, UpdatedAt: data.UpdatedAt},
+			Trans:        l.svcCtx.Trans.Trans(l.ctx, *data.Description),
+			Path:         data.Path,
+			Description:  data.Description,
+			Group:        data.ApiGroup,
+			Method:       data.Method,
+			IsRequired:   data.IsRequired,
+			ServiceName:  data.ServiceName,
+			IsDeprecated: data.IsDeprecated,
+			DeprecatedAt: data.DeprecatedAt,
 		},
 	}, nil
 }
@@ -29,13 +29,14 @@ func NewGetApiListLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetApi
 func (l *GetApiListLogic) GetApiList(req *types.ApiListReq) (resp *types.ApiListResp, err error) {
 	data, err := l.svcCtx.CoreRpc.GetApiList(l.ctx,
 		&core.ApiListReq{
-			Page:        req.Page,
-			PageSize:    req.PageSize,
-			Path:        req.Path,
-			Description: req.Description,
-			Method:      req.Method,
-			ApiGroup:    req.Group,
-			ServiceName: req.ServiceName,
+			Page:         req.Page,
+			PageSize:     req.PageSize,
+			Path:         req.Path,
+			Description:  req.Description,
+			Method:       req.Method,
+			ApiGroup:     req.Group,
+			ServiceName:  req.ServiceName,
+			IsDeprecated: req.IsDeprecated,
 		})
 	if err != nil {
 		return nil, err
@@ -78,6 +79,8 @@ func (l *GetApiListLogic) GetApiList(req *types.ApiListReq) (resp *types.ApiList
 				Method:      v.Method,
 				IsRequired:  v.IsRequired,
 				ServiceName: v.ServiceName,
+				IsDeprecated: v.IsDeprecated,
+				DeprecatedAt: v.DeprecatedAt,
 			})
 	}
 	return resp, nil
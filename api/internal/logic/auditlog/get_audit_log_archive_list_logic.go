@@ -0,0 +1,61 @@
+package auditlog
+
+import (
+	"context"
+
+	"github.com/coder-lulu/newbee-common/v2/i18n"
+	"github.com/coder-lulu/newbee-core/api/internal/svc"
+	"github.com/coder-lulu/newbee-core/api/internal/types"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetAuditLogArchiveListLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewGetAuditLogArchiveListLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetAuditLogArchiveListLogic {
+	return &GetAuditLogArchiveListLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetAuditLogArchiveListLogic) GetAuditLogArchiveList(req *types.AuditLogArchiveListReq) (resp *types.AuditLogArchiveListResp, err error) {
+	data, err := l.svcCtx.CoreRpc.GetAuditLogArchiveList(l.ctx, &core.AuditLogArchiveListReq{
+		Page:      req.Page,
+		PageSize:  req.PageSize,
+		StartTime: req.StartTime,
+		EndTime:   req.EndTime,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp = &types.AuditLogArchiveListResp{}
+	resp.Msg = l.svcCtx.Trans.Trans(l.ctx, i18n.Success)
+	resp.Data.Total = data.GetTotal()
+	resp.Data.Data = make([]types.AuditLogArchiveInfo, 0, len(data.Data))
+
+	for _, v := range data.Data {
+		resp.Data.Data = append(resp.Data.Data, types.AuditLogArchiveInfo{
+			Id:        v.Id,
+			CreatedAt: v.CreatedAt,
+			FromSeq:   v.FromSeq,
+			ToSeq:     v.ToSeq,
+			Count:     v.Count,
+			StartAt:   v.StartAt,
+			EndAt:     v.EndAt,
+			Store:     v.Store,
+			ObjectKey: v.ObjectKey,
+			Size:      v.Size,
+			Checksum:  v.Checksum,
+		})
+	}
+
+	return resp, nil
+}
@@ -78,6 +78,9 @@ func (l *GetAuditLogByIdLogic) GetAuditLogById(req *types.AuditLogReq) (resp *ty
 			DurationMs:   data.DurationMs,
 			ErrorMessage: data.ErrorMessage,
 			Metadata:     metadata,
+			Seq:          data.Seq,
+			PrevHash:     data.PrevHash,
+			Hash:         data.Hash,
 		},
 	}
 
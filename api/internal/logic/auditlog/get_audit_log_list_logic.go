@@ -27,7 +27,25 @@ func NewGetAuditLogListLogic(ctx context.Context, svcCtx *svc.ServiceContext) *G
 }
 
 func (l *GetAuditLogListLogic) GetAuditLogList(req *types.AuditLogListReq) (resp *types.AuditLogListResp, err error) {
-	data, err := l.svcCtx.CoreRpc.GetAuditLogList(l.ctx, &core.AuditLogListReq{
+	data, err := l.svcCtx.CoreRpc.GetAuditLogList(l.ctx, toAuditLogListReq(req))
+	if err != nil {
+		return nil, err
+	}
+
+	resp = &types.AuditLogListResp{
+		BaseDataInfo: types.BaseDataInfo{
+			Code: 0,
+			Msg:  l.svcCtx.Trans.Trans(l.ctx, i18n.Success),
+		},
+		Data: convertAuditLogList(data),
+	}
+
+	return resp, nil
+}
+
+// toAuditLogListReq converts the list request into the RPC request
+func toAuditLogListReq(req *types.AuditLogListReq) *core.AuditLogListReq {
+	return &core.AuditLogListReq{
 		Page:          uint64(req.Page),
 		PageSize:      uint64(req.PageSize),
 		UserId:        req.UserId,
@@ -49,22 +67,16 @@ func (l *GetAuditLogListLogic) GetAuditLogList(req *types.AuditLogListReq) (resp
 		EndTime:     req.EndTime,
 		MinDuration: req.MinDuration,
 		MaxDuration: req.MaxDuration,
-	})
-	if err != nil {
-		return nil, err
 	}
+}
 
-	resp = &types.AuditLogListResp{
-		BaseDataInfo: types.BaseDataInfo{
-			Code: 0,
-			Msg:  l.svcCtx.Trans.Trans(l.ctx, i18n.Success),
-		},
-		Data: types.AuditLogListInfo{
-			BaseListInfo: types.BaseListInfo{
-				Total: data.Total,
-			},
-			Data: []types.AuditLogInfo{},
+// convertAuditLogList converts the RPC list response, it is shared by the archive restore API
+func convertAuditLogList(data *core.AuditLogListResp) types.AuditLogListInfo {
+	result := types.AuditLogListInfo{
+		BaseListInfo: types.BaseListInfo{
+			Total: data.Total,
 		},
+		Data: []types.AuditLogInfo{},
 	}
 
 	for _, v := range data.Data {
@@ -78,7 +90,7 @@ func (l *GetAuditLogListLogic) GetAuditLogList(req *types.AuditLogListReq) (resp
 			metadata = make(map[string]interface{})
 		}
 
-		resp.Data.Data = append(resp.Data.Data, types.AuditLogInfo{
+		result.Data = append(result.Data, types.AuditLogInfo{
 			BaseUUIDInfo: types.BaseUUIDInfo{
 				Id:        v.Id,
 				CreatedAt: v.CreatedAt,
@@ -107,8 +119,11 @@ func (l *GetAuditLogListLogic) GetAuditLogList(req *types.AuditLogListReq) (resp
 			DurationMs:   v.DurationMs,
 			ErrorMessage: v.ErrorMessage,
 			Metadata:     metadata,
+			Seq:          v.Seq,
+			PrevHash:     v.PrevHash,
+			Hash:         v.Hash,
 		})
 	}
 
-	return resp, nil
+	return result
 }
@@ -0,0 +1,42 @@
+package auditlog
+
+import (
+	"context"
+
+	"github.com/coder-lulu/newbee-common/v2/i18n"
+	"github.com/coder-lulu/newbee-core/api/internal/svc"
+	"github.com/coder-lulu/newbee-core/api/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type RestoreAuditLogRangeLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewRestoreAuditLogRangeLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RestoreAuditLogRangeLogic {
+	return &RestoreAuditLogRangeLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *RestoreAuditLogRangeLogic) RestoreAuditLogRange(req *types.AuditLogListReq) (resp *types.AuditLogListResp, err error) {
+	data, err := l.svcCtx.CoreRpc.RestoreAuditLogRange(l.ctx, toAuditLogListReq(req))
+	if err != nil {
+		return nil, err
+	}
+
+	resp = &types.AuditLogListResp{
+		BaseDataInfo: types.BaseDataInfo{
+			Code: 0,
+			Msg:  l.svcCtx.Trans.Trans(l.ctx, i18n.Success),
+		},
+		Data: convertAuditLogList(data),
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,54 @@
+package auditlog
+
+import (
+	"context"
+
+	"github.com/coder-lulu/newbee-common/v2/i18n"
+	"github.com/coder-lulu/newbee-core/api/internal/svc"
+	"github.com/coder-lulu/newbee-core/api/internal/types"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type VerifyAuditLogChainLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewVerifyAuditLogChainLogic(ctx context.Context, svcCtx *svc.ServiceContext) *VerifyAuditLogChainLogic {
+	return &VerifyAuditLogChainLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *VerifyAuditLogChainLogic) VerifyAuditLogChain() (resp *types.AuditLogVerifyResp, err error) {
+	data, err := l.svcCtx.CoreRpc.VerifyAuditLogChain(l.ctx, &core.Empty{})
+	if err != nil {
+		return nil, err
+	}
+
+	resp = &types.AuditLogVerifyResp{}
+	resp.Msg = l.svcCtx.Trans.Trans(l.ctx, i18n.Success)
+	resp.Data = types.AuditLogVerifyInfo{
+		Valid:       data.Valid,
+		Checked:     data.Checked,
+		HeadSeq:     data.HeadSeq,
+		HeadHash:    data.HeadHash,
+		PrunedToSeq: data.PrunedToSeq,
+		Issues:      make([]types.AuditLogChainIssue, 0, len(data.Issues)),
+	}
+
+	for _, v := range data.Issues {
+		resp.Data.Issues = append(resp.Data.Issues, types.AuditLogChainIssue{
+			Seq:    v.Seq,
+			Type:   v.Type,
+			Detail: v.Detail,
+		})
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,43 @@
+package authority
+
+import (
+	"context"
+
+	"github.com/coder-lulu/newbee-common/v2/i18n"
+
+	"github.com/coder-lulu/newbee-core/api/internal/svc"
+	"github.com/coder-lulu/newbee-core/api/internal/types"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ExportPolicyBundleLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewExportPolicyBundleLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ExportPolicyBundleLogic {
+	return &ExportPolicyBundleLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ExportPolicyBundleLogic) ExportPolicyBundle(req *types.PolicyBundleExportReq) (resp *types.PolicyBundleResp, err error) {
+	data, err := l.svcCtx.CoreRpc.ExportPolicyBundle(l.ctx, &core.PolicyBundleExportReq{
+		Format:    req.Format,
+		RoleCodes: req.RoleCodes,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp = &types.PolicyBundleResp{}
+	resp.Msg = l.svcCtx.Trans.Trans(l.ctx, i18n.Success)
+	resp.Data.Format = data.Format
+	resp.Data.Content = data.Content
+	return resp, nil
+}
@@ -0,0 +1,63 @@
+package authority
+
+import (
+	"context"
+
+	"github.com/coder-lulu/newbee-common/v2/i18n"
+
+	"github.com/coder-lulu/newbee-core/api/internal/svc"
+	"github.com/coder-lulu/newbee-core/api/internal/types"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ImportPolicyBundleLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewImportPolicyBundleLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ImportPolicyBundleLogic {
+	return &ImportPolicyBundleLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ImportPolicyBundleLogic) ImportPolicyBundle(req *types.PolicyBundleImportReq) (resp *types.PolicyBundleImportResp, err error) {
+	data, err := l.svcCtx.CoreRpc.ImportPolicyBundle(l.ctx, &core.PolicyBundleImportReq{
+		Format:  req.Format,
+		Content: req.Content,
+		DryRun:  req.DryRun,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// 应用后主动刷新本地策略缓存，避免等待Redis事件
+	if data.Applied {
+		if err := l.svcCtx.Casbin.LoadPolicy(); err != nil {
+			l.Logger.Errorw("failed to reload casbin policy after importing policy bundle", logx.Field("error", err.Error()))
+		}
+	}
+
+	resp = &types.PolicyBundleImportResp{}
+	if data.Applied {
+		resp.Msg = l.svcCtx.Trans.Trans(l.ctx, i18n.UpdateSuccess)
+	} else {
+		resp.Msg = l.svcCtx.Trans.Trans(l.ctx, i18n.Success)
+	}
+	resp.Data.Applied = data.Applied
+	resp.Data.Changes = make([]types.PolicyBundleChangeInfo, 0, len(data.Data))
+	for _, v := range data.Data {
+		resp.Data.Changes = append(resp.Data.Changes, types.PolicyBundleChangeInfo{
+			Kind:   v.Kind,
+			Action: v.Action,
+			Role:   v.Role,
+			Target: v.Target,
+		})
+	}
+	return resp, nil
+}
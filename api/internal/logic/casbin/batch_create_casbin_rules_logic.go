@@ -67,6 +67,7 @@ func (l *BatchCreateCasbinRulesLogic) BatchCreateCasbinRules(req *types.BatchCre
 			Tags:       apiRule.Tags,
 			UsageCount: apiRule.UsageCount,
 			LastUsedAt: apiRule.LastUsedAt,
+			Conditions: apiRule.Conditions,
 		}
 		rpcRules = append(rpcRules, rpcRule)
 	}
@@ -67,6 +67,7 @@ func (l *BatchUpdateCasbinRulesLogic) BatchUpdateCasbinRules(req *types.BatchUpd
 			Tags:       apiRule.Tags,
 			UsageCount: apiRule.UsageCount,
 			LastUsedAt: apiRule.LastUsedAt,
+			Conditions: apiRule.Conditions,
 		}
 	}
 
@@ -84,6 +84,7 @@ func (l *GetCasbinRuleByIdLogic) GetCasbinRuleById(req *types.IDReq) (resp *type
 		Tags:       rpcResp.Tags,
 		UsageCount: rpcResp.UsageCount,
 		LastUsedAt: rpcResp.LastUsedAt,
+		Conditions: rpcResp.Conditions,
 	}
 
 	// 构建成功响应
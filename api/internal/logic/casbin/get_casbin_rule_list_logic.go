@@ -98,6 +98,7 @@ func (l *GetCasbinRuleListLogic) GetCasbinRuleList(req *types.CasbinRuleListReq)
 			Tags:       rpcItem.Tags,
 			UsageCount: rpcItem.UsageCount,
 			LastUsedAt: rpcItem.LastUsedAt,
+			Conditions: rpcItem.Conditions,
 		}
 		apiList = append(apiList, apiItem)
 	}
@@ -65,6 +65,7 @@ func (l *UpdateCasbinRuleLogic) UpdateCasbinRule(req *types.CasbinRuleInfo) (res
 		Tags:       req.Tags,
 		UsageCount: req.UsageCount,
 		LastUsedAt: req.LastUsedAt,
+		Conditions: req.Conditions,
 	}
 
 	// 调用RPC服务
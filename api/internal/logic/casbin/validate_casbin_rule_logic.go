@@ -65,6 +65,7 @@ func (l *ValidateCasbinRuleLogic) ValidateCasbinRule(req *types.ValidateCasbinRu
 		Tags:       req.Rule.Tags,
 		UsageCount: req.Rule.UsageCount,
 		LastUsedAt: req.Rule.LastUsedAt,
+		Conditions: req.Rule.Conditions,
 	}
 
 	rpcReq := &core.ValidateCasbinRuleReq{
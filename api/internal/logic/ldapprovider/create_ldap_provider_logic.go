@@ -0,0 +1,71 @@
+package ldapprovider
+
+import (
+	"context"
+
+	"github.com/coder-lulu/newbee-core/api/internal/svc"
+	"github.com/coder-lulu/newbee-core/api/internal/types"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CreateLdapProviderLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewCreateLdapProviderLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CreateLdapProviderLogic {
+	return &CreateLdapProviderLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *CreateLdapProviderLogic) CreateLdapProvider(req *types.LdapProviderInfo) (resp *types.BaseMsgResp, err error) {
+	data, err := l.svcCtx.CoreRpc.CreateLdapProvider(l.ctx, convertLdapProviderReq(req))
+	if err != nil {
+		return nil, err
+	}
+	return &types.BaseMsgResp{Msg: l.svcCtx.Trans.Trans(l.ctx, data.Msg)}, nil
+}
+
+// convertLdapProviderReq converts the create and update requests
+func convertLdapProviderReq(req *types.LdapProviderInfo) *core.LdapProviderInfo {
+	return &core.LdapProviderInfo{
+		Id:                   req.Id,
+		Status:               req.Status,
+		Name:                 req.Name,
+		DisplayName:          req.DisplayName,
+		DirectoryType:        req.DirectoryType,
+		Url:                  req.Url,
+		StartTls:             req.StartTls,
+		InsecureSkipVerify:   req.InsecureSkipVerify,
+		BindDn:               req.BindDn,
+		BindPassword:         req.BindPassword,
+		BaseDn:               req.BaseDn,
+		AuthMode:             req.AuthMode,
+		UserDnTemplate:       req.UserDnTemplate,
+		UserFilter:           req.UserFilter,
+		OuBaseDn:             req.OuBaseDn,
+		OuFilter:             req.OuFilter,
+		GroupBaseDn:          req.GroupBaseDn,
+		GroupFilter:          req.GroupFilter,
+		GroupMemberAttribute: req.GroupMemberAttribute,
+		AttributeMapping:     req.AttributeMapping,
+		LoginEnabled:         req.LoginEnabled,
+		JitProvision:         req.JitProvision,
+		LinkExisting:         req.LinkExisting,
+		SyncEnabled:          req.SyncEnabled,
+		SyncInterval:         req.SyncInterval,
+		DeletionPolicy:       req.DeletionPolicy,
+		RootDepartmentId:     req.RootDepartmentId,
+		DefaultDepartmentId:  req.DefaultDepartmentId,
+		DefaultRoleIds:       req.DefaultRoleIds,
+		Enabled:              req.Enabled,
+		Sort:                 req.Sort,
+		Remark:               req.Remark,
+	}
+}
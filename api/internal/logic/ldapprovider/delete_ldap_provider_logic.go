@@ -0,0 +1,36 @@
+package ldapprovider
+
+import (
+	"context"
+
+	"github.com/coder-lulu/newbee-core/api/internal/svc"
+	"github.com/coder-lulu/newbee-core/api/internal/types"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type DeleteLdapProviderLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewDeleteLdapProviderLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DeleteLdapProviderLogic {
+	return &DeleteLdapProviderLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *DeleteLdapProviderLogic) DeleteLdapProvider(req *types.IDsReq) (resp *types.BaseMsgResp, err error) {
+	result, err := l.svcCtx.CoreRpc.DeleteLdapProvider(l.ctx, &core.IDsReq{
+		Ids: req.Ids,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.BaseMsgResp{Msg: l.svcCtx.Trans.Trans(l.ctx, result.Msg)}, nil
+}
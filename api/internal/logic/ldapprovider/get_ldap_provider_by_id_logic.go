@@ -0,0 +1,83 @@
+package ldapprovider
+
+import (
+	"context"
+
+	"github.com/coder-lulu/newbee-core/api/internal/svc"
+	"github.com/coder-lulu/newbee-core/api/internal/types"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+
+	"github.com/coder-lulu/newbee-common/v2/i18n"
+)
+
+type GetLdapProviderByIdLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewGetLdapProviderByIdLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetLdapProviderByIdLogic {
+	return &GetLdapProviderByIdLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetLdapProviderByIdLogic) GetLdapProviderById(req *types.IDReq) (resp *types.LdapProviderInfoResp, err error) {
+	data, err := l.svcCtx.CoreRpc.GetLdapProviderById(l.ctx, &core.IDReq{Id: req.Id})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.LdapProviderInfoResp{
+		BaseDataInfo: types.BaseDataInfo{
+			Code: 0,
+			Msg:  l.svcCtx.Trans.Trans(l.ctx, i18n.Success),
+		},
+		Data: convertLdapProviderInfo(data),
+	}, nil
+}
+
+func convertLdapProviderInfo(data *core.LdapProviderInfo) types.LdapProviderInfo {
+	return types.LdapProviderInfo{
+		BaseIDInfo: types.BaseIDInfo{
+			Id:        data.Id,
+			CreatedAt: data.CreatedAt,
+			UpdatedAt: data.UpdatedAt,
+		},
+		Status:               data.Status,
+		Name:                 data.Name,
+		DisplayName:          data.DisplayName,
+		DirectoryType:        data.DirectoryType,
+		Url:                  data.Url,
+		StartTls:             data.StartTls,
+		InsecureSkipVerify:   data.InsecureSkipVerify,
+		BindDn:               data.BindDn,
+		BaseDn:               data.BaseDn,
+		AuthMode:             data.AuthMode,
+		UserDnTemplate:       data.UserDnTemplate,
+		UserFilter:           data.UserFilter,
+		OuBaseDn:             data.OuBaseDn,
+		OuFilter:             data.OuFilter,
+		GroupBaseDn:          data.GroupBaseDn,
+		GroupFilter:          data.GroupFilter,
+		GroupMemberAttribute: data.GroupMemberAttribute,
+		AttributeMapping:     data.AttributeMapping,
+		LoginEnabled:         data.LoginEnabled,
+		JitProvision:         data.JitProvision,
+		LinkExisting:         data.LinkExisting,
+		SyncEnabled:          data.SyncEnabled,
+		SyncInterval:         data.SyncInterval,
+		DeletionPolicy:       data.DeletionPolicy,
+		RootDepartmentId:     data.RootDepartmentId,
+		DefaultDepartmentId:  data.DefaultDepartmentId,
+		DefaultRoleIds:       data.DefaultRoleIds,
+		LastSyncAt:           data.LastSyncAt,
+		Enabled:              data.Enabled,
+		Sort:                 data.Sort,
+		Remark:               data.Remark,
+	}
+}
@@ -0,0 +1,47 @@
+package ldapprovider
+
+import (
+	"context"
+
+	"github.com/coder-lulu/newbee-core/api/internal/svc"
+	"github.com/coder-lulu/newbee-core/api/internal/types"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+
+	"github.com/coder-lulu/newbee-common/v2/i18n"
+)
+
+type GetLdapProviderListLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewGetLdapProviderListLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetLdapProviderListLogic {
+	return &GetLdapProviderListLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetLdapProviderListLogic) GetLdapProviderList(req *types.LdapProviderListReq) (resp *types.LdapProviderListResp, err error) {
+	data, err := l.svcCtx.CoreRpc.GetLdapProviderList(l.ctx,
+		&core.LdapProviderListReq{
+			Page:     req.Page,
+			PageSize: req.PageSize,
+			Name:     req.Name,
+		})
+	if err != nil {
+		return nil, err
+	}
+	resp = &types.LdapProviderListResp{}
+	resp.Msg = l.svcCtx.Trans.Trans(l.ctx, i18n.Success)
+	resp.Data.Total = data.GetTotal()
+
+	for _, v := range data.Data {
+		resp.Data.Data = append(resp.Data.Data, convertLdapProviderInfo(v))
+	}
+	return resp, nil
+}
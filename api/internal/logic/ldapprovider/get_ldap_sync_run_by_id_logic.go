@@ -0,0 +1,98 @@
+package ldapprovider
+
+import (
+	"context"
+
+	"github.com/coder-lulu/newbee-core/api/internal/svc"
+	"github.com/coder-lulu/newbee-core/api/internal/types"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+
+	"github.com/coder-lulu/newbee-common/v2/i18n"
+)
+
+type GetLdapSyncRunByIdLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewGetLdapSyncRunByIdLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetLdapSyncRunByIdLogic {
+	return &GetLdapSyncRunByIdLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetLdapSyncRunByIdLogic) GetLdapSyncRunById(req *types.IDReq) (resp *types.LdapSyncRunInfoResp, err error) {
+	data, err := l.svcCtx.CoreRpc.GetLdapSyncRunById(l.ctx, &core.IDReq{Id: req.Id})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.LdapSyncRunInfoResp{
+		BaseDataInfo: types.BaseDataInfo{
+			Code: 0,
+			Msg:  l.svcCtx.Trans.Trans(l.ctx, i18n.Success),
+		},
+		Data: convertLdapSyncRunInfo(data),
+	}, nil
+}
+
+func convertLdapSyncRunInfo(data *core.LdapSyncRunInfo) types.LdapSyncRunInfo {
+	info := types.LdapSyncRunInfo{
+		Id:         data.Id,
+		CreatedAt:  data.CreatedAt,
+		ProviderId: data.ProviderId,
+		Trigger:    data.Trigger,
+		DryRun:     data.DryRun,
+		Result:     data.Result,
+		Error:      data.Error,
+		StartedAt:  data.StartedAt,
+		FinishedAt: data.FinishedAt,
+		Truncated:  data.Truncated,
+	}
+	if data.Stats != nil {
+		info.Stats = &types.LdapSyncStats{
+			Departments: convertLdapSyncCounter(data.Stats.Departments),
+			Users:       convertLdapSyncCounter(data.Stats.Users),
+			Granted:     data.Stats.Granted,
+			Revoked:     data.Stats.Revoked,
+			Conflicts:   data.Stats.Conflicts,
+		}
+	}
+	for _, v := range data.Changes {
+		info.Changes = append(info.Changes, types.LdapSyncChange{
+			Kind:   v.Kind,
+			Action: v.Action,
+			Dn:     v.Dn,
+			Name:   v.Name,
+			Detail: v.Detail,
+		})
+	}
+	for _, v := range data.Conflicts {
+		info.Conflicts = append(info.Conflicts, types.LdapSyncConflict{
+			Kind:   v.Kind,
+			Dn:     v.Dn,
+			Name:   v.Name,
+			Reason: v.Reason,
+			Detail: v.Detail,
+		})
+	}
+	return info
+}
+
+func convertLdapSyncCounter(c *core.LdapSyncCounter) types.LdapSyncCounter {
+	if c == nil {
+		return types.LdapSyncCounter{}
+	}
+	return types.LdapSyncCounter{
+		Created:   c.Created,
+		Updated:   c.Updated,
+		Disabled:  c.Disabled,
+		Deleted:   c.Deleted,
+		Unchanged: c.Unchanged,
+	}
+}
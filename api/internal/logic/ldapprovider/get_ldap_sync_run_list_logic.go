@@ -0,0 +1,48 @@
+package ldapprovider
+
+import (
+	"context"
+
+	"github.com/coder-lulu/newbee-core/api/internal/svc"
+	"github.com/coder-lulu/newbee-core/api/internal/types"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+
+	"github.com/coder-lulu/newbee-common/v2/i18n"
+)
+
+type GetLdapSyncRunListLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewGetLdapSyncRunListLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetLdapSyncRunListLogic {
+	return &GetLdapSyncRunListLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetLdapSyncRunListLogic) GetLdapSyncRunList(req *types.LdapSyncRunListReq) (resp *types.LdapSyncRunListResp, err error) {
+	data, err := l.svcCtx.CoreRpc.GetLdapSyncRunList(l.ctx,
+		&core.LdapSyncRunListReq{
+			Page:       req.Page,
+			PageSize:   req.PageSize,
+			ProviderId: req.ProviderId,
+			Result:     req.Result,
+		})
+	if err != nil {
+		return nil, err
+	}
+	resp = &types.LdapSyncRunListResp{}
+	resp.Msg = l.svcCtx.Trans.Trans(l.ctx, i18n.Success)
+	resp.Data.Total = data.GetTotal()
+
+	for _, v := range data.Data {
+		resp.Data.Data = append(resp.Data.Data, convertLdapSyncRunInfo(v))
+	}
+	return resp, nil
+}
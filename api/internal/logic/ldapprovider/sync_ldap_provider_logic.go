@@ -0,0 +1,45 @@
+package ldapprovider
+
+import (
+	"context"
+
+	"github.com/coder-lulu/newbee-core/api/internal/svc"
+	"github.com/coder-lulu/newbee-core/api/internal/types"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+
+	"github.com/coder-lulu/newbee-common/v2/i18n"
+)
+
+type SyncLdapProviderLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewSyncLdapProviderLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SyncLdapProviderLogic {
+	return &SyncLdapProviderLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *SyncLdapProviderLogic) SyncLdapProvider(req *types.LdapSyncReq) (resp *types.LdapSyncRunInfoResp, err error) {
+	data, err := l.svcCtx.CoreRpc.SyncLdapProvider(l.ctx, &core.LdapSyncReq{
+		Id:     req.Id,
+		DryRun: req.DryRun,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.LdapSyncRunInfoResp{
+		BaseDataInfo: types.BaseDataInfo{
+			Code: 0,
+			Msg:  l.svcCtx.Trans.Trans(l.ctx, i18n.Success),
+		},
+		Data: convertLdapSyncRunInfo(data),
+	}, nil
+}
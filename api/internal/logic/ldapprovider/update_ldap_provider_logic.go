@@ -0,0 +1,32 @@
+package ldapprovider
+
+import (
+	"context"
+
+	"github.com/coder-lulu/newbee-core/api/internal/svc"
+	"github.com/coder-lulu/newbee-core/api/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type UpdateLdapProviderLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewUpdateLdapProviderLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UpdateLdapProviderLogic {
+	return &UpdateLdapProviderLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *UpdateLdapProviderLogic) UpdateLdapProvider(req *types.LdapProviderInfo) (resp *types.BaseMsgResp, err error) {
+	data, err := l.svcCtx.CoreRpc.UpdateLdapProvider(l.ctx, convertLdapProviderReq(req))
+	if err != nil {
+		return nil, err
+	}
+	return &types.BaseMsgResp{Msg: l.svcCtx.Trans.Trans(l.ctx, data.Msg)}, nil
+}
@@ -0,0 +1,62 @@
+package oauthclient
+
+import (
+	"context"
+
+	"github.com/coder-lulu/newbee-core/api/internal/svc"
+	"github.com/coder-lulu/newbee-core/api/internal/types"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+
+	"github.com/coder-lulu/newbee-common/v2/i18n"
+)
+
+type CreateOauthClientLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewCreateOauthClientLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CreateOauthClientLogic {
+	return &CreateOauthClientLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *CreateOauthClientLogic) CreateOauthClient(req *types.OauthClientInfo) (resp *types.OauthClientSecretResp, err error) {
+	data, err := l.svcCtx.CoreRpc.CreateOauthClient(l.ctx, convertOauthClientReq(req))
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.OauthClientSecretResp{
+		BaseDataInfo: types.BaseDataInfo{
+			Code: 0,
+			Msg:  l.svcCtx.Trans.Trans(l.ctx, i18n.CreateSuccess),
+		},
+		Data: types.OauthClientSecretInfo{
+			Id:           data.Id,
+			ClientId:     data.ClientId,
+			ClientSecret: data.ClientSecret,
+		},
+	}, nil
+}
+
+func convertOauthClientReq(req *types.OauthClientInfo) *core.OauthClientInfo {
+	return &core.OauthClientInfo{
+		Id:              req.Id,
+		Status:          req.Status,
+		Name:            req.Name,
+		ClientType:      req.ClientType,
+		RedirectUris:    req.RedirectUris,
+		GrantTypes:      req.GrantTypes,
+		Scopes:          req.Scopes,
+		Trusted:         req.Trusted,
+		AccessTokenTtl:  req.AccessTokenTtl,
+		RefreshTokenTtl: req.RefreshTokenTtl,
+		Remark:          req.Remark,
+	}
+}
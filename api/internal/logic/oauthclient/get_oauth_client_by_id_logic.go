@@ -0,0 +1,63 @@
+package oauthclient
+
+import (
+	"context"
+
+	"github.com/coder-lulu/newbee-core/api/internal/svc"
+	"github.com/coder-lulu/newbee-core/api/internal/types"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+
+	"github.com/coder-lulu/newbee-common/v2/i18n"
+)
+
+type GetOauthClientByIdLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewGetOauthClientByIdLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetOauthClientByIdLogic {
+	return &GetOauthClientByIdLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetOauthClientByIdLogic) GetOauthClientById(req *types.IDReq) (resp *types.OauthClientInfoResp, err error) {
+	data, err := l.svcCtx.CoreRpc.GetOauthClientById(l.ctx, &core.IDReq{Id: req.Id})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.OauthClientInfoResp{
+		BaseDataInfo: types.BaseDataInfo{
+			Code: 0,
+			Msg:  l.svcCtx.Trans.Trans(l.ctx, i18n.Success),
+		},
+		Data: convertOauthClientInfo(data),
+	}, nil
+}
+
+func convertOauthClientInfo(data *core.OauthClientInfo) types.OauthClientInfo {
+	return types.OauthClientInfo{
+		BaseIDInfo: types.BaseIDInfo{
+			Id:        data.Id,
+			CreatedAt: data.CreatedAt,
+			UpdatedAt: data.UpdatedAt,
+		},
+		Status:          data.Status,
+		Name:            data.Name,
+		ClientId:        data.ClientId,
+		ClientType:      data.ClientType,
+		RedirectUris:    data.RedirectUris,
+		GrantTypes:      data.GrantTypes,
+		Scopes:          data.Scopes,
+		Trusted:         data.Trusted,
+		AccessTokenTtl:  data.AccessTokenTtl,
+		RefreshTokenTtl: data.RefreshTokenTtl,
+		Remark:          data.Remark,
+	}
+}
@@ -0,0 +1,47 @@
+package oauthclient
+
+import (
+	"context"
+
+	"github.com/coder-lulu/newbee-core/api/internal/svc"
+	"github.com/coder-lulu/newbee-core/api/internal/types"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+
+	"github.com/coder-lulu/newbee-common/v2/i18n"
+)
+
+type GetOauthClientListLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewGetOauthClientListLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetOauthClientListLogic {
+	return &GetOauthClientListLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetOauthClientListLogic) GetOauthClientList(req *types.OauthClientListReq) (resp *types.OauthClientListResp, err error) {
+	data, err := l.svcCtx.CoreRpc.GetOauthClientList(l.ctx,
+		&core.OauthClientListReq{
+			Page:     req.Page,
+			PageSize: req.PageSize,
+			Name:     req.Name,
+		})
+	if err != nil {
+		return nil, err
+	}
+	resp = &types.OauthClientListResp{}
+	resp.Msg = l.svcCtx.Trans.Trans(l.ctx, i18n.Success)
+	resp.Data.Total = data.GetTotal()
+
+	for _, v := range data.Data {
+		resp.Data.Data = append(resp.Data.Data, convertOauthClientInfo(v))
+	}
+	return resp, nil
+}
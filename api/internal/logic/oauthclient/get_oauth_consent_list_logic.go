@@ -0,0 +1,58 @@
+package oauthclient
+
+import (
+	"context"
+
+	"github.com/coder-lulu/newbee-core/api/internal/svc"
+	"github.com/coder-lulu/newbee-core/api/internal/types"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+
+	"github.com/coder-lulu/newbee-common/v2/i18n"
+)
+
+type GetOauthConsentListLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewGetOauthConsentListLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetOauthConsentListLogic {
+	return &GetOauthConsentListLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetOauthConsentListLogic) GetOauthConsentList(req *types.OauthConsentListReq) (resp *types.OauthConsentListResp, err error) {
+	data, err := l.svcCtx.CoreRpc.GetOauthConsentList(l.ctx,
+		&core.OauthConsentListReq{
+			Page:     req.Page,
+			PageSize: req.PageSize,
+			UserId:   req.UserId,
+			ClientId: req.ClientId,
+		})
+	if err != nil {
+		return nil, err
+	}
+	resp = &types.OauthConsentListResp{}
+	resp.Msg = l.svcCtx.Trans.Trans(l.ctx, i18n.Success)
+	resp.Data.Total = data.GetTotal()
+
+	for _, v := range data.Data {
+		resp.Data.Data = append(resp.Data.Data, types.OauthConsentInfo{
+			BaseIDInfo: types.BaseIDInfo{
+				Id:        v.Id,
+				CreatedAt: v.CreatedAt,
+				UpdatedAt: v.UpdatedAt,
+			},
+			UserId:     v.UserId,
+			ClientId:   v.ClientId,
+			ClientName: v.ClientName,
+			Scopes:     v.Scopes,
+		})
+	}
+	return resp, nil
+}
@@ -0,0 +1,72 @@
+package oauthclient
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/coder-lulu/newbee-common/v2/i18n"
+	"google.golang.org/grpc/status"
+
+	"github.com/coder-lulu/newbee-core/api/internal/oauth2"
+	"github.com/coder-lulu/newbee-core/api/internal/svc"
+	"github.com/coder-lulu/newbee-core/api/internal/types"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type OauthConsentLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewOauthConsentLogic(ctx context.Context, svcCtx *svc.ServiceContext) *OauthConsentLogic {
+	return &OauthConsentLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// OauthConsent is called by the consent page, which /oauth2/authorize redirects to with the original query.
+// Without approve it returns the scopes to ask for, or the redirect URL directly when the user has consented
+// before. With approve it returns the redirect URL with the authorization code or the access_denied error.
+func (l *OauthConsentLogic) OauthConsent(req *types.OauthConsentReq) (resp *types.OauthConsentResp, err error) {
+	data, err := l.svcCtx.CoreRpc.AuthorizeOauthClient(l.ctx, &core.OauthAuthorizeReq{
+		ClientId:            req.ClientId,
+		RedirectUri:         req.RedirectUri,
+		Scope:               req.Scope,
+		CodeChallenge:       req.CodeChallenge,
+		CodeChallengeMethod: req.CodeChallengeMethod,
+		Nonce:               req.Nonce,
+		UserId:              l.svcCtx.ContextManager.GetUserID(l.ctx),
+		Approve:             req.Approve,
+	})
+
+	result := types.OauthConsentInfoData{}
+	switch {
+	case err == nil && data.ConsentRequired:
+		result.ConsentRequired, result.ClientName = true, data.ClientName
+		for _, v := range data.Scopes {
+			result.Scopes = append(result.Scopes, types.OauthScopeInfo{
+				BaseIDInfo:  types.BaseIDInfo{Id: v.Id},
+				Name:        v.Name,
+				Description: v.Description,
+			})
+		}
+	case err == nil:
+		result.ClientName = data.ClientName
+		result.RedirectUrl = oauth2.RedirectURL(req.RedirectUri, url.Values{"code": {data.Code}}, req.State)
+	case status.Convert(err).Message() == "oauth2.accessDenied":
+		// 用户拒绝授权时按 RFC 6749 4.1.2.1 回调客户端
+		result.RedirectUrl = oauth2.RedirectURL(req.RedirectUri, url.Values{"error": {"access_denied"}}, req.State)
+	default:
+		return nil, err
+	}
+
+	return &types.OauthConsentResp{
+		BaseDataInfo: types.BaseDataInfo{Msg: l.svcCtx.Trans.Trans(l.ctx, i18n.Success)},
+		Data:         result,
+	}, nil
+}
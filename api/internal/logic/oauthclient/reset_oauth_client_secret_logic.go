@@ -0,0 +1,44 @@
+package oauthclient
+
+import (
+	"context"
+
+	"github.com/coder-lulu/newbee-core/api/internal/svc"
+	"github.com/coder-lulu/newbee-core/api/internal/types"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ResetOauthClientSecretLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewResetOauthClientSecretLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ResetOauthClientSecretLogic {
+	return &ResetOauthClientSecretLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ResetOauthClientSecretLogic) ResetOauthClientSecret(req *types.IDReq) (resp *types.OauthClientSecretResp, err error) {
+	data, err := l.svcCtx.CoreRpc.ResetOauthClientSecret(l.ctx, &core.IDReq{Id: req.Id})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.OauthClientSecretResp{
+		BaseDataInfo: types.BaseDataInfo{
+			Code: 0,
+			Msg:  l.svcCtx.Trans.Trans(l.ctx, data.Msg),
+		},
+		Data: types.OauthClientSecretInfo{
+			Id:           data.Id,
+			ClientId:     data.ClientId,
+			ClientSecret: data.ClientSecret,
+		},
+	}, nil
+}
@@ -0,0 +1,32 @@
+package oauthclient
+
+import (
+	"context"
+
+	"github.com/coder-lulu/newbee-core/api/internal/svc"
+	"github.com/coder-lulu/newbee-core/api/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type UpdateOauthClientLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewUpdateOauthClientLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UpdateOauthClientLogic {
+	return &UpdateOauthClientLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *UpdateOauthClientLogic) UpdateOauthClient(req *types.OauthClientInfo) (resp *types.BaseMsgResp, err error) {
+	data, err := l.svcCtx.CoreRpc.UpdateOauthClient(l.ctx, convertOauthClientReq(req))
+	if err != nil {
+		return nil, err
+	}
+	return &types.BaseMsgResp{Msg: l.svcCtx.Trans.Trans(l.ctx, data.Msg)}, nil
+}
@@ -52,6 +52,7 @@ func (l *GetOauthProviderByIdLogic) GetOauthProviderById(req *types.IDReq) (resp
 			TokenUrl:     data.TokenUrl,
 			AuthStyle:    data.AuthStyle,
 			InfoUrl:      data.InfoUrl,
+			TemplateId:   data.TemplateId,
 		},
 	}, nil
 }
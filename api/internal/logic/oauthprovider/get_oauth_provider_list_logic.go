@@ -57,6 +57,7 @@ func (l *GetOauthProviderListLogic) GetOauthProviderList(req *types.OauthProvide
 				TokenUrl:     v.TokenUrl,
 				AuthStyle:    v.AuthStyle,
 				InfoUrl:      v.InfoUrl,
+				TemplateId:   v.TemplateId,
 			})
 	}
 	return resp, nil
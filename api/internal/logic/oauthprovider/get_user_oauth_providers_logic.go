@@ -3,7 +3,6 @@ package oauthprovider
 import (
 	"context"
 
-	"github.com/coder-lulu/newbee-common/v2/orm/ent/entctx/tenantctx"
 	"github.com/coder-lulu/newbee-core/api/internal/svc"
 	"github.com/coder-lulu/newbee-core/api/internal/types"
 	"github.com/coder-lulu/newbee-core/rpc/coreclient"
@@ -45,9 +44,13 @@ func (l *GetUserOauthProvidersLogic) GetUserOauthProviders(req *types.UserOauthP
 			PageSize: 100,
 		}
 	}
-	adminCtx := tenantctx.PublicCtx(l.ctx)
+	// 只列出请求租户自己的提供商
+	tenantCtx, err := tenantContext(l.ctx, l.svcCtx, req.TenantId)
+	if err != nil {
+		return nil, err
+	}
 
-	providerResult, err := l.svcCtx.CoreRpc.GetOauthProviderList(adminCtx, providerListReq)
+	providerResult, err := l.svcCtx.CoreRpc.GetOauthProviderList(tenantCtx, providerListReq)
 	if err != nil {
 		return nil, err
 	}
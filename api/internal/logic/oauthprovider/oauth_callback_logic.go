@@ -11,6 +11,7 @@ import (
 	"github.com/coder-lulu/newbee-common/v2/utils/jwt"
 	"github.com/coder-lulu/newbee-common/v2/utils/pointy"
 
+	"github.com/coder-lulu/newbee-core/api/internal/session"
 	"github.com/coder-lulu/newbee-core/api/internal/svc"
 	"github.com/coder-lulu/newbee-core/api/internal/types"
 	"github.com/coder-lulu/newbee-core/rpc/types/core"
@@ -35,13 +36,20 @@ func NewOauthCallbackLogic(r *http.Request, svcCtx *svc.ServiceContext) *OauthCa
 }
 
 func (l *OauthCallbackLogic) OauthCallback() (resp *types.CallbackResp, err error) {
-	result, err := l.svcCtx.CoreRpc.OauthCallback(l.ctx, &core.CallbackReq{
+	req := &core.CallbackReq{
 		State: l.r.FormValue("state"),
 		Code:  l.r.FormValue("code"),
-	})
+	}
+	if v := l.r.FormValue("error"); v != "" {
+		req.Error = pointy.GetPointer(v)
+		req.ErrorDescription = pointy.GetPointer(l.r.FormValue("error_description"))
+	}
+
+	data, err := l.svcCtx.CoreRpc.OauthCallback(l.ctx, req)
 	if err != nil {
 		return nil, err
 	}
+	result := data.User
 
 	// Convert roleIds to string slice
 	roleIdsStr := make([]string, len(result.RoleIds))
@@ -49,10 +57,14 @@ func (l *OauthCallbackLogic) OauthCallback() (resp *types.CallbackResp, err erro
 		roleIdsStr[i] = strconv.FormatUint(id, 10)
 	}
 
-	token, err := jwt.NewJwtToken(l.svcCtx.Config.Middleware.Auth.AccessSecret, time.Now().Unix(),
+	// 登录时创建新会话
+	sessionID := session.NewID()
+
+	token, err := l.svcCtx.JwtKeys.NewJwtToken(time.Now().Unix(),
 		l.svcCtx.Config.Middleware.Auth.AccessExpire,
 		// 使用优化的短字段名
 		jwt.WithOption(keys.JWTUserID, *result.Id),              // "uid"
+		jwt.WithOption(session.ClaimSessionID, sessionID),       // "sid"
 		jwt.WithOption(keys.JWTTenantID, *result.TenantId),      // "tid"
 		jwt.WithOption(keys.JWTUsername, *result.Username),      // "un"
 		jwt.WithOption(keys.JWTDeptID, *result.DepartmentId),    // "did"
@@ -74,13 +86,18 @@ func (l *OauthCallbackLogic) OauthCallback() (resp *types.CallbackResp, err erro
 
 	// add token into database
 	expiredAt := time.Now().Add(time.Second * time.Duration(l.svcCtx.Config.Middleware.Auth.AccessExpire)).UnixMilli()
+	clientInfo := session.FromContext(l.ctx)
 	_, err = l.svcCtx.CoreRpc.CreateToken(l.ctx, &core.TokenInfo{
 		Uuid:      result.Id,
 		Token:     pointy.GetPointer(token),
-		Source:    pointy.GetPointer(strings.Split(l.r.FormValue("state"), "-")[1]),
+		Source:    pointy.GetPointer(data.Provider),
 		Status:    pointy.GetPointer(uint32(1)),
 		ExpiredAt: pointy.GetPointer(expiredAt),
 		TenantId:  result.TenantId,
+		Device:    &clientInfo.Device,
+		UserAgent: &clientInfo.UserAgent,
+		Ip:        &clientInfo.IP,
+		SessionId: &sessionID,
 	})
 
 	if err != nil {
@@ -5,6 +5,7 @@ import (
 
 	"github.com/coder-lulu/newbee-common/v2/i18n"
 
+	"github.com/coder-lulu/newbee-core/api/internal/session"
 	"github.com/coder-lulu/newbee-core/api/internal/svc"
 	"github.com/coder-lulu/newbee-core/api/internal/types"
 	"github.com/coder-lulu/newbee-core/rpc/types/core"
@@ -27,9 +28,17 @@ func NewOauthLoginLogic(ctx context.Context, svcCtx *svc.ServiceContext) *OauthL
 }
 
 func (l *OauthLoginLogic) OauthLogin(req *types.OauthLoginReq) (resp *types.RedirectResp, err error) {
-	result, err := l.svcCtx.CoreRpc.OauthLogin(l.ctx, &core.OauthLoginReq{
-		State:    req.State,
-		Provider: req.Provider,
+	tenantCtx, err := tenantContext(l.ctx, l.svcCtx, req.TenantId)
+	if err != nil {
+		return nil, err
+	}
+
+	clientInfo := session.FromContext(l.ctx)
+	result, err := l.svcCtx.CoreRpc.OauthLogin(tenantCtx, &core.OauthLoginReq{
+		State:     req.State,
+		Provider:  req.Provider,
+		ClientIp:  &clientInfo.IP,
+		UserAgent: &clientInfo.UserAgent,
 	})
 	if err != nil {
 		return nil, err
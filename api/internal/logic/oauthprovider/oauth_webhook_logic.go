@@ -0,0 +1,64 @@
+package oauthprovider
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/zeromicro/go-zero/core/errorx"
+	"github.com/zeromicro/go-zero/core/logx"
+
+	"github.com/coder-lulu/newbee-core/api/internal/svc"
+	"github.com/coder-lulu/newbee-core/api/internal/types"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+)
+
+// maxWebhookBodyBytes 第三方平台事件推送的请求体大小上限
+const maxWebhookBodyBytes = 1 << 20
+
+type OauthWebhookLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	r      *http.Request
+}
+
+func NewOauthWebhookLogic(r *http.Request, svcCtx *svc.ServiceContext) *OauthWebhookLogic {
+	return &OauthWebhookLogic{
+		Logger: logx.WithContext(r.Context()),
+		ctx:    r.Context(),
+		svcCtx: svcCtx,
+		r:      r,
+	}
+}
+
+// OauthWebhook passes the raw request to core, the signature is computed over the raw body by the adapter
+func (l *OauthWebhookLogic) OauthWebhook() (resp *types.OauthWebhookResp, err error) {
+	providerID, err := strconv.ParseUint(l.r.URL.Query().Get("id"), 10, 64)
+	if err != nil || providerID == 0 {
+		return nil, errorx.NewCodeInvalidArgumentError("oauth.invalidWebhook")
+	}
+
+	body, err := io.ReadAll(io.LimitReader(l.r.Body, maxWebhookBodyBytes+1))
+	if err != nil || len(body) > maxWebhookBodyBytes {
+		return nil, errorx.NewCodeInvalidArgumentError("oauth.invalidWebhook")
+	}
+
+	headers := make(map[string]string, len(l.r.Header))
+	for k := range l.r.Header {
+		headers[strings.ToLower(k)] = l.r.Header.Get(k)
+	}
+
+	result, err := l.svcCtx.CoreRpc.OauthWebhook(l.ctx, &core.OauthWebhookReq{
+		ProviderId: providerID,
+		Headers:    headers,
+		Body:       body,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.OauthWebhookResp{Challenge: result.Challenge}, nil
+}
@@ -0,0 +1,51 @@
+package oauthprovider
+
+import (
+	"context"
+
+	"github.com/coder-lulu/newbee-common/v2/i18n"
+
+	"github.com/coder-lulu/newbee-core/api/internal/svc"
+	"github.com/coder-lulu/newbee-core/api/internal/types"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type PreviewOauthClaimMappingLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewPreviewOauthClaimMappingLogic(ctx context.Context, svcCtx *svc.ServiceContext) *PreviewOauthClaimMappingLogic {
+	return &PreviewOauthClaimMappingLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *PreviewOauthClaimMappingLogic) PreviewOauthClaimMapping(req *types.OauthClaimMappingPreviewReq) (resp *types.OauthClaimMappingPreviewResp, err error) {
+	data, err := l.svcCtx.CoreRpc.PreviewOauthClaimMapping(l.ctx, &core.OauthClaimMappingPreviewReq{
+		ProviderId:  req.ProviderId,
+		ExtraConfig: req.ExtraConfig,
+		Sample:      req.Sample,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.OauthClaimMappingPreviewResp{
+		BaseDataInfo: types.BaseDataInfo{Msg: l.svcCtx.Trans.Trans(l.ctx, i18n.Success)},
+		Data: types.OauthClaimMappingPreviewInfo{
+			Id:       data.Id,
+			Username: data.Username,
+			Nickname: data.Nickname,
+			Email:    data.Email,
+			Phone:    data.Phone,
+			Avatar:   data.Avatar,
+			Groups:   data.Groups,
+		},
+	}, nil
+}
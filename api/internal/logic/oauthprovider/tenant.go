@@ -0,0 +1,42 @@
+package oauthprovider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/coder-lulu/newbee-common/v2/enum/common"
+	"github.com/coder-lulu/newbee-common/v2/i18n"
+	"github.com/coder-lulu/newbee-common/v2/middleware/keys"
+	"github.com/zeromicro/go-zero/core/errorx"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/coder-lulu/newbee-core/api/internal/svc"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+)
+
+// tenantContext checks the tenant of a public OAuth request and returns the context carrying the tenant to core,
+// OAuth providers are configured per tenant
+func tenantContext(ctx context.Context, svcCtx *svc.ServiceContext, tenantId string) (context.Context, error) {
+	tenantID, err := strconv.ParseUint(tenantId, 10, 64)
+	if err != nil || tenantID == 0 {
+		return nil, errorx.NewCodeInvalidArgumentError("login.invalidTenant")
+	}
+
+	tenantInfo, err := svcCtx.CoreRpc.GetTenantById(ctx, &core.IDReq{Id: tenantID})
+	if err != nil {
+		if e, ok := status.FromError(err); ok && e.Message() == i18n.TargetNotFound {
+			return nil, errorx.NewCodeInvalidArgumentError("login.invalidTenant")
+		}
+		return nil, err
+	}
+
+	if tenantInfo.Status != nil && *tenantInfo.Status != uint32(common.StatusNormal) {
+		return nil, errorx.NewCodeInvalidArgumentError("login.tenantDisabled")
+	}
+
+	tenantIDStr := strconv.FormatUint(tenantID, 10)
+	tenantCtx := svcCtx.ContextManager.SetTenantID(ctx, tenantIDStr)
+
+	return metadata.AppendToOutgoingContext(tenantCtx, keys.TenantIDKey.String(), tenantIDStr), nil
+}
@@ -0,0 +1,55 @@
+package oauthprovidertemplate
+
+import (
+	"context"
+
+	"github.com/coder-lulu/newbee-core/api/internal/svc"
+	"github.com/coder-lulu/newbee-core/api/internal/types"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CreateOauthProviderTemplateLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewCreateOauthProviderTemplateLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CreateOauthProviderTemplateLogic {
+	return &CreateOauthProviderTemplateLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *CreateOauthProviderTemplateLogic) CreateOauthProviderTemplate(req *types.OauthProviderTemplateInfo) (resp *types.BaseMsgResp, err error) {
+	data, err := l.svcCtx.CoreRpc.CreateOauthProviderTemplate(l.ctx, convertOauthProviderTemplateReq(req))
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.BaseMsgResp{Msg: l.svcCtx.Trans.Trans(l.ctx, data.Msg)}, nil
+}
+
+func convertOauthProviderTemplateReq(req *types.OauthProviderTemplateInfo) *core.OauthProviderTemplateInfo {
+	return &core.OauthProviderTemplateInfo{
+		Id:           req.Id,
+		Status:       req.Status,
+		Name:         req.Name,
+		DisplayName:  req.DisplayName,
+		Type:         req.Type,
+		ProviderType: req.ProviderType,
+		Scopes:       req.Scopes,
+		AuthUrl:      req.AuthUrl,
+		TokenUrl:     req.TokenUrl,
+		InfoUrl:      req.InfoUrl,
+		AuthStyle:    req.AuthStyle,
+		ExtraConfig:  req.ExtraConfig,
+		SupportPkce:  req.SupportPkce,
+		IconUrl:      req.IconUrl,
+		Sort:         req.Sort,
+		Remark:       req.Remark,
+	}
+}
@@ -0,0 +1,43 @@
+package oauthprovidertemplate
+
+import (
+	"context"
+
+	"github.com/coder-lulu/newbee-core/api/internal/svc"
+	"github.com/coder-lulu/newbee-core/api/internal/types"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type EnableOauthProviderTemplateLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewEnableOauthProviderTemplateLogic(ctx context.Context, svcCtx *svc.ServiceContext) *EnableOauthProviderTemplateLogic {
+	return &EnableOauthProviderTemplateLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *EnableOauthProviderTemplateLogic) EnableOauthProviderTemplate(req *types.EnableOauthProviderTemplateReq) (resp *types.BaseMsgResp, err error) {
+	data, err := l.svcCtx.CoreRpc.EnableOauthProviderTemplate(l.ctx, &core.EnableOauthProviderTemplateReq{
+		TemplateId:   req.TemplateId,
+		ClientId:     req.ClientId,
+		ClientSecret: req.ClientSecret,
+		RedirectUrl:  req.RedirectUrl,
+		Name:         req.Name,
+		DisplayName:  req.DisplayName,
+		Scopes:       req.Scopes,
+		Enabled:      req.Enabled,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.BaseMsgResp{Msg: l.svcCtx.Trans.Trans(l.ctx, data.Msg)}, nil
+}
@@ -0,0 +1,67 @@
+package oauthprovidertemplate
+
+import (
+	"context"
+
+	"github.com/coder-lulu/newbee-common/v2/i18n"
+
+	"github.com/coder-lulu/newbee-core/api/internal/svc"
+	"github.com/coder-lulu/newbee-core/api/internal/types"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetOauthProviderTemplateByIdLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewGetOauthProviderTemplateByIdLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetOauthProviderTemplateByIdLogic {
+	return &GetOauthProviderTemplateByIdLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetOauthProviderTemplateByIdLogic) GetOauthProviderTemplateById(req *types.IDReq) (resp *types.OauthProviderTemplateInfoResp, err error) {
+	data, err := l.svcCtx.CoreRpc.GetOauthProviderTemplateById(l.ctx, &core.IDReq{Id: req.Id})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.OauthProviderTemplateInfoResp{
+		BaseDataInfo: types.BaseDataInfo{
+			Code: 0,
+			Msg:  l.svcCtx.Trans.Trans(l.ctx, i18n.Success),
+		},
+		Data: convertOauthProviderTemplateInfo(data),
+	}, nil
+}
+
+func convertOauthProviderTemplateInfo(data *core.OauthProviderTemplateInfo) types.OauthProviderTemplateInfo {
+	return types.OauthProviderTemplateInfo{
+		BaseIDInfo: types.BaseIDInfo{
+			Id:        data.Id,
+			CreatedAt: data.CreatedAt,
+			UpdatedAt: data.UpdatedAt,
+		},
+		Status:       data.Status,
+		Name:         data.Name,
+		DisplayName:  data.DisplayName,
+		Type:         data.Type,
+		ProviderType: data.ProviderType,
+		Scopes:       data.Scopes,
+		AuthUrl:      data.AuthUrl,
+		TokenUrl:     data.TokenUrl,
+		InfoUrl:      data.InfoUrl,
+		AuthStyle:    data.AuthStyle,
+		ExtraConfig:  data.ExtraConfig,
+		SupportPkce:  data.SupportPkce,
+		IconUrl:      data.IconUrl,
+		Sort:         data.Sort,
+		Remark:       data.Remark,
+	}
+}
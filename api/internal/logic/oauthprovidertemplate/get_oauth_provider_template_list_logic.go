@@ -0,0 +1,48 @@
+package oauthprovidertemplate
+
+import (
+	"context"
+
+	"github.com/coder-lulu/newbee-common/v2/i18n"
+
+	"github.com/coder-lulu/newbee-core/api/internal/svc"
+	"github.com/coder-lulu/newbee-core/api/internal/types"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetOauthProviderTemplateListLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewGetOauthProviderTemplateListLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetOauthProviderTemplateListLogic {
+	return &GetOauthProviderTemplateListLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetOauthProviderTemplateListLogic) GetOauthProviderTemplateList(req *types.OauthProviderTemplateListReq) (resp *types.OauthProviderTemplateListResp, err error) {
+	data, err := l.svcCtx.CoreRpc.GetOauthProviderTemplateList(l.ctx,
+		&core.OauthProviderTemplateListReq{
+			Page:     req.Page,
+			PageSize: req.PageSize,
+			Name:     req.Name,
+			Type:     req.Type,
+		})
+	if err != nil {
+		return nil, err
+	}
+	resp = &types.OauthProviderTemplateListResp{}
+	resp.Msg = l.svcCtx.Trans.Trans(l.ctx, i18n.Success)
+	resp.Data.Total = data.GetTotal()
+
+	for _, v := range data.Data {
+		resp.Data.Data = append(resp.Data.Data, convertOauthProviderTemplateInfo(v))
+	}
+	return resp, nil
+}
@@ -0,0 +1,33 @@
+package oauthprovidertemplate
+
+import (
+	"context"
+
+	"github.com/coder-lulu/newbee-core/api/internal/svc"
+	"github.com/coder-lulu/newbee-core/api/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type UpdateOauthProviderTemplateLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewUpdateOauthProviderTemplateLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UpdateOauthProviderTemplateLogic {
+	return &UpdateOauthProviderTemplateLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *UpdateOauthProviderTemplateLogic) UpdateOauthProviderTemplate(req *types.OauthProviderTemplateInfo) (resp *types.BaseMsgResp, err error) {
+	data, err := l.svcCtx.CoreRpc.UpdateOauthProviderTemplate(l.ctx, convertOauthProviderTemplateReq(req))
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.BaseMsgResp{Msg: l.svcCtx.Trans.Trans(l.ctx, data.Msg)}, nil
+}
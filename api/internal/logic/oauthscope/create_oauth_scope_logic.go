@@ -0,0 +1,53 @@
+package oauthscope
+
+import (
+	"context"
+
+	"github.com/coder-lulu/newbee-core/api/internal/svc"
+	"github.com/coder-lulu/newbee-core/api/internal/types"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CreateOauthScopeLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewCreateOauthScopeLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CreateOauthScopeLogic {
+	return &CreateOauthScopeLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *CreateOauthScopeLogic) CreateOauthScope(req *types.OauthScopeInfo) (resp *types.BaseMsgResp, err error) {
+	data, err := l.svcCtx.CoreRpc.CreateOauthScope(l.ctx, convertOauthScopeReq(req))
+	if err != nil {
+		return nil, err
+	}
+
+	reloadPolicy(l.Logger, l.svcCtx)
+
+	return &types.BaseMsgResp{Msg: l.svcCtx.Trans.Trans(l.ctx, data.Msg)}, nil
+}
+
+func convertOauthScopeReq(req *types.OauthScopeInfo) *core.OauthScopeInfo {
+	return &core.OauthScopeInfo{
+		Id:          req.Id,
+		Status:      req.Status,
+		Name:        req.Name,
+		Description: req.Description,
+		ApiIds:      req.ApiIds,
+	}
+}
+
+// reloadPolicy 作用域规则已由 RPC 写入并通过 Redis 通知，这里立即重新加载，保证当前实例马上生效
+func reloadPolicy(logger logx.Logger, svcCtx *svc.ServiceContext) {
+	if err := svcCtx.Casbin.LoadPolicy(); err != nil {
+		logger.Errorw("failed to reload casbin policy", logx.Field("detail", err.Error()))
+	}
+}
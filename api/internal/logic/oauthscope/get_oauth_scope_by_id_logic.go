@@ -0,0 +1,56 @@
+package oauthscope
+
+import (
+	"context"
+
+	"github.com/coder-lulu/newbee-core/api/internal/svc"
+	"github.com/coder-lulu/newbee-core/api/internal/types"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+
+	"github.com/coder-lulu/newbee-common/v2/i18n"
+)
+
+type GetOauthScopeByIdLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewGetOauthScopeByIdLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetOauthScopeByIdLogic {
+	return &GetOauthScopeByIdLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetOauthScopeByIdLogic) GetOauthScopeById(req *types.IDReq) (resp *types.OauthScopeInfoResp, err error) {
+	data, err := l.svcCtx.CoreRpc.GetOauthScopeById(l.ctx, &core.IDReq{Id: req.Id})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.OauthScopeInfoResp{
+		BaseDataInfo: types.BaseDataInfo{
+			Code: 0,
+			Msg:  l.svcCtx.Trans.Trans(l.ctx, i18n.Success),
+		},
+		Data: convertOauthScopeInfo(data),
+	}, nil
+}
+
+func convertOauthScopeInfo(data *core.OauthScopeInfo) types.OauthScopeInfo {
+	return types.OauthScopeInfo{
+		BaseIDInfo: types.BaseIDInfo{
+			Id:        data.Id,
+			CreatedAt: data.CreatedAt,
+			UpdatedAt: data.UpdatedAt,
+		},
+		Status:      data.Status,
+		Name:        data.Name,
+		Description: data.Description,
+		ApiIds:      data.ApiIds,
+	}
+}
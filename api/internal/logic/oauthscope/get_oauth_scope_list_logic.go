@@ -0,0 +1,47 @@
+package oauthscope
+
+import (
+	"context"
+
+	"github.com/coder-lulu/newbee-core/api/internal/svc"
+	"github.com/coder-lulu/newbee-core/api/internal/types"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+
+	"github.com/coder-lulu/newbee-common/v2/i18n"
+)
+
+type GetOauthScopeListLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewGetOauthScopeListLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetOauthScopeListLogic {
+	return &GetOauthScopeListLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetOauthScopeListLogic) GetOauthScopeList(req *types.OauthScopeListReq) (resp *types.OauthScopeListResp, err error) {
+	data, err := l.svcCtx.CoreRpc.GetOauthScopeList(l.ctx,
+		&core.OauthScopeListReq{
+			Page:     req.Page,
+			PageSize: req.PageSize,
+			Name:     req.Name,
+		})
+	if err != nil {
+		return nil, err
+	}
+	resp = &types.OauthScopeListResp{}
+	resp.Msg = l.svcCtx.Trans.Trans(l.ctx, i18n.Success)
+	resp.Data.Total = data.GetTotal()
+
+	for _, v := range data.Data {
+		resp.Data.Data = append(resp.Data.Data, convertOauthScopeInfo(v))
+	}
+	return resp, nil
+}
@@ -0,0 +1,35 @@
+package oauthscope
+
+import (
+	"context"
+
+	"github.com/coder-lulu/newbee-core/api/internal/svc"
+	"github.com/coder-lulu/newbee-core/api/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type UpdateOauthScopeLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewUpdateOauthScopeLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UpdateOauthScopeLogic {
+	return &UpdateOauthScopeLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *UpdateOauthScopeLogic) UpdateOauthScope(req *types.OauthScopeInfo) (resp *types.BaseMsgResp, err error) {
+	data, err := l.svcCtx.CoreRpc.UpdateOauthScope(l.ctx, convertOauthScopeReq(req))
+	if err != nil {
+		return nil, err
+	}
+
+	reloadPolicy(l.Logger, l.svcCtx)
+
+	return &types.BaseMsgResp{Msg: l.svcCtx.Trans.Trans(l.ctx, data.Msg)}, nil
+}
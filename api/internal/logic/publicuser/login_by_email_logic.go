@@ -18,6 +18,7 @@ import (
 
 	"github.com/coder-lulu/newbee-core/rpc/types/core"
 
+	"github.com/coder-lulu/newbee-core/api/internal/session"
 	"github.com/coder-lulu/newbee-core/api/internal/svc"
 	"github.com/coder-lulu/newbee-core/api/internal/types"
 
@@ -76,10 +77,14 @@ func (l *LoginByEmailLogic) LoginByEmail(req *types.LoginByEmailReq) (resp *type
 			roleIdsStr[i] = strconv.FormatUint(id, 10)
 		}
 
-		token, err := jwt.NewJwtToken(l.svcCtx.Config.Middleware.Auth.AccessSecret, time.Now().Unix(),
+		// 登录时创建新会话
+		sessionID := session.NewID()
+
+		token, err := l.svcCtx.JwtKeys.NewJwtToken(time.Now().Unix(),
 			l.svcCtx.Config.Middleware.Auth.AccessExpire,
 			// 使用优化的短字段名
 			jwt.WithOption(keys.JWTUserID, *userData.Data[0].Id),           // "uid"
+			jwt.WithOption(session.ClaimSessionID, sessionID),              // "sid"
 			jwt.WithOption(keys.JWTTenantID, *userData.Data[0].TenantId),   // "tid"
 			jwt.WithOption(keys.JWTUsername, *userData.Data[0].Username),   // "un"
 			jwt.WithOption(keys.JWTDeptID, *userData.Data[0].DepartmentId), // "did"
@@ -103,6 +108,7 @@ func (l *LoginByEmailLogic) LoginByEmail(req *types.LoginByEmailReq) (resp *type
 
 		// add token into database
 		expiredAt := time.Now().Add(time.Second * time.Duration(l.svcCtx.Config.Middleware.Auth.AccessExpire)).UnixMilli()
+		clientInfo := session.FromContext(l.ctx)
 		_, err = l.svcCtx.CoreRpc.CreateToken(l.ctx, &core.TokenInfo{
 			Uuid:      userData.Data[0].Id,
 			Token:     pointy.GetPointer(token),
@@ -111,6 +117,10 @@ func (l *LoginByEmailLogic) LoginByEmail(req *types.LoginByEmailReq) (resp *type
 			Username:  userData.Data[0].Username,
 			ExpiredAt: pointy.GetPointer(expiredAt),
 			TenantId:  userData.Data[0].TenantId,
+			Device:    &clientInfo.Device,
+			UserAgent: &clientInfo.UserAgent,
+			Ip:        &clientInfo.IP,
+			SessionId: &sessionID,
 		})
 
 		if err != nil {
@@ -20,6 +20,7 @@ import (
 	"github.com/coder-lulu/newbee-common/v2/utils/pointy"
 	"github.com/zeromicro/go-zero/core/errorx"
 
+	"github.com/coder-lulu/newbee-core/api/internal/session"
 	"github.com/coder-lulu/newbee-core/api/internal/svc"
 	"github.com/coder-lulu/newbee-core/api/internal/types"
 	"github.com/coder-lulu/newbee-core/rpc/types/core"
@@ -99,20 +100,24 @@ func (l *LoginLogic) Login(req *types.LoginReq) (resp *types.LoginResp, err erro
 				Username: req.Username,
 			})
 		if err != nil {
-			if e, ok := status.FromError(err); ok {
-				if e.Message() == i18n.TargetNotFound {
-					return nil, errorx.NewCodeInvalidArgumentError("login.wrongUsernameOrPassword")
-				}
+			if e, ok := status.FromError(err); !ok || e.Message() != i18n.TargetNotFound {
+				return nil, err
 			}
-
-			return nil, err
+			user = nil
 		}
 
-		if user.Status != nil && *user.Status != uint32(common.StatusNormal) {
+		if user != nil && user.Status != nil && *user.Status != uint32(common.StatusNormal) {
 			return nil, errorx.NewCodeInvalidArgumentError("login.userBanned")
 		}
 
-		if !encrypt.BcryptCheck(req.Password, *user.Password) {
+		// 本地用户不存在或密码错误时使用 LDAP 目录认证
+		if user == nil || !encrypt.BcryptCheck(req.Password, *user.Password) {
+			user, err = l.ldapLogin(req.Username, req.Password)
+			if err != nil {
+				return nil, err
+			}
+		}
+		if user == nil {
 			if err = l.svcCtx.Redis.Set(l.ctx, "USER:WRONG_PASSWORD:"+req.Username, checkWrongTimes+1, 5*time.Minute).Err(); err != nil {
 				return nil, err
 			}
@@ -126,10 +131,14 @@ func (l *LoginLogic) Login(req *types.LoginReq) (resp *types.LoginResp, err erro
 			roleIdsStr[i] = strconv.FormatUint(id, 10)
 		}
 
-		token, err := jwt.NewJwtToken(l.svcCtx.Config.Middleware.Auth.AccessSecret, time.Now().Unix(),
+		// 登录时创建新会话
+		sessionID := session.NewID()
+
+		token, err := l.svcCtx.JwtKeys.NewJwtToken(time.Now().Unix(),
 			l.svcCtx.Config.Middleware.Auth.AccessExpire,
 			// 使用优化的短字段名，减少token长度
 			jwt.WithOption(keys.JWTUserID, *user.Id),                             // "uid"
+			jwt.WithOption(session.ClaimSessionID, sessionID),                    // "sid"
 			jwt.WithOption(keys.JWTTenantID, *user.TenantId),                     // "tid"
 			jwt.WithOption(keys.JWTUsername, *user.Username),                     // "un"
 			jwt.WithOption(keys.JWTDeptID, *user.DepartmentId),                   // "did"
@@ -153,6 +162,7 @@ func (l *LoginLogic) Login(req *types.LoginReq) (resp *types.LoginResp, err erro
 
 		// add token into database
 		expiredAt := time.Now().Add(time.Second * time.Duration(l.svcCtx.Config.Middleware.Auth.AccessExpire)).UnixMilli()
+		clientInfo := session.FromContext(l.ctx)
 		_, err = l.svcCtx.CoreRpc.CreateToken(l.ctx, &core.TokenInfo{
 			Uuid:      user.Id,
 			Token:     pointy.GetPointer(token),
@@ -161,6 +171,10 @@ func (l *LoginLogic) Login(req *types.LoginReq) (resp *types.LoginResp, err erro
 			Username:  user.Username,
 			ExpiredAt: pointy.GetPointer(expiredAt),
 			TenantId:  user.TenantId,
+			Device:    &clientInfo.Device,
+			UserAgent: &clientInfo.UserAgent,
+			Ip:        &clientInfo.IP,
+			SessionId: &sessionID,
 		})
 
 		if err != nil {
@@ -185,3 +199,22 @@ func (l *LoginLogic) Login(req *types.LoginReq) (resp *types.LoginResp, err erro
 		return nil, errorx.NewCodeInvalidArgumentError("login.wrongCaptcha")
 	}
 }
+
+// ldapLogin authenticates the user with the LDAP directories of the tenant. It returns nil when
+// no directory is configured or the directories reject the password.
+func (l *LoginLogic) ldapLogin(username, password string) (*core.UserInfo, error) {
+	clientInfo := session.FromContext(l.ctx)
+	user, err := l.svcCtx.CoreRpc.LdapLogin(l.ctx, &core.LdapLoginReq{
+		Username: username,
+		Password: password,
+		Ip:       &clientInfo.IP,
+	})
+	if err != nil {
+		if e, ok := status.FromError(err); ok && (e.Message() == "ldap.notConfigured" || e.Message() == "login.wrongUsernameOrPassword") {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return user, nil
+}
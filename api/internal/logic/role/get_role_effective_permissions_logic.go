@@ -0,0 +1,47 @@
+package role
+
+import (
+	"context"
+
+	"github.com/coder-lulu/newbee-common/v2/i18n"
+
+	"github.com/coder-lulu/newbee-core/api/internal/svc"
+	"github.com/coder-lulu/newbee-core/api/internal/types"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetRoleEffectivePermissionsLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewGetRoleEffectivePermissionsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetRoleEffectivePermissionsLogic {
+	return &GetRoleEffectivePermissionsLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetRoleEffectivePermissionsLogic) GetRoleEffectivePermissions(req *types.IDReq) (resp *types.RoleEffectivePermissionsResp, err error) {
+	data, err := l.svcCtx.CoreRpc.GetRoleEffectivePermissions(l.ctx, &core.IDReq{Id: req.Id})
+	if err != nil {
+		return nil, err
+	}
+	resp = &types.RoleEffectivePermissionsResp{}
+	resp.Msg = l.svcCtx.Trans.Trans(l.ctx, i18n.Success)
+	resp.Data.RoleCodes = data.RoleCodes
+	resp.Data.MenuIds = data.MenuIds
+
+	for _, v := range data.Apis {
+		resp.Data.Apis = append(resp.Data.Apis, types.RoleEffectiveApi{
+			Path:       v.Path,
+			Method:     v.Method,
+			SourceRole: v.SourceRole,
+		})
+	}
+	return resp, nil
+}
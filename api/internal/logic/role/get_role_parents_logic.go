@@ -0,0 +1,56 @@
+package role
+
+import (
+	"context"
+
+	"github.com/coder-lulu/newbee-common/v2/i18n"
+
+	"github.com/coder-lulu/newbee-core/api/internal/svc"
+	"github.com/coder-lulu/newbee-core/api/internal/types"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetRoleParentsLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewGetRoleParentsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetRoleParentsLogic {
+	return &GetRoleParentsLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetRoleParentsLogic) GetRoleParents(req *types.IDReq) (resp *types.RoleListResp, err error) {
+	data, err := l.svcCtx.CoreRpc.GetRoleParents(l.ctx, &core.IDReq{Id: req.Id})
+	if err != nil {
+		return nil, err
+	}
+	resp = &types.RoleListResp{}
+	resp.Msg = l.svcCtx.Trans.Trans(l.ctx, i18n.Success)
+	resp.Data.Total = uint64(len(data.Data))
+
+	for _, v := range data.Data {
+		resp.Data.Data = append(resp.Data.Data,
+			types.RoleInfo{
+				BaseIDInfo: types.BaseIDInfo{
+					Id:        v.Id,
+					CreatedAt: v.CreatedAt,
+					UpdatedAt: v.UpdatedAt,
+				},
+				Trans:         l.svcCtx.Trans.Trans(l.ctx, *v.Name),
+				Status:        v.Status,
+				Name:          v.Name,
+				Code:          v.Code,
+				DefaultRouter: v.DefaultRouter,
+				Remark:        v.Remark,
+				Sort:          v.Sort,
+			})
+	}
+	return resp, nil
+}
@@ -0,0 +1,38 @@
+package role
+
+import (
+	"context"
+
+	"github.com/coder-lulu/newbee-core/api/internal/svc"
+	"github.com/coder-lulu/newbee-core/api/internal/types"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type SetRoleParentsLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewSetRoleParentsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SetRoleParentsLogic {
+	return &SetRoleParentsLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *SetRoleParentsLogic) SetRoleParents(req *types.RoleParentsReq) (resp *types.BaseMsgResp, err error) {
+	data, err := l.svcCtx.CoreRpc.SetRoleParents(l.ctx,
+		&core.RoleParentsReq{
+			RoleId:    req.RoleId,
+			ParentIds: req.ParentIds,
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.BaseMsgResp{Msg: l.svcCtx.Trans.Trans(l.ctx, data.Msg)}, nil
+}
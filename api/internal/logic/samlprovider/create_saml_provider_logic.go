@@ -0,0 +1,62 @@
+package samlprovider
+
+import (
+	"context"
+
+	"github.com/coder-lulu/newbee-core/api/internal/svc"
+	"github.com/coder-lulu/newbee-core/api/internal/types"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CreateSamlProviderLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewCreateSamlProviderLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CreateSamlProviderLogic {
+	return &CreateSamlProviderLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *CreateSamlProviderLogic) CreateSamlProvider(req *types.SamlProviderInfo) (resp *types.BaseMsgResp, err error) {
+	data, err := l.svcCtx.CoreRpc.CreateSamlProvider(l.ctx, convertSamlProviderReq(req))
+	if err != nil {
+		return nil, err
+	}
+	return &types.BaseMsgResp{Msg: l.svcCtx.Trans.Trans(l.ctx, data.Msg)}, nil
+}
+
+// convertSamlProviderReq converts the create and update requests
+func convertSamlProviderReq(req *types.SamlProviderInfo) *core.SamlProviderInfo {
+	return &core.SamlProviderInfo{
+		Id:                   req.Id,
+		Status:               req.Status,
+		Name:                 req.Name,
+		DisplayName:          req.DisplayName,
+		IdpEntityId:          req.IdpEntityId,
+		IdpSsoUrl:            req.IdpSsoUrl,
+		IdpSloUrl:            req.IdpSloUrl,
+		IdpCertificates:      req.IdpCertificates,
+		IdpMetadataUrl:       req.IdpMetadataUrl,
+		SpEntityId:           req.SpEntityId,
+		AcsUrl:               req.AcsUrl,
+		NameIdFormat:         req.NameIdFormat,
+		SignAuthnRequest:     req.SignAuthnRequest,
+		WantAssertionsSigned: req.WantAssertionsSigned,
+		AttributeMapping:     req.AttributeMapping,
+		JitProvision:         req.JitProvision,
+		SyncAttributes:       req.SyncAttributes,
+		LinkByEmail:          req.LinkByEmail,
+		DefaultDepartmentId:  req.DefaultDepartmentId,
+		DefaultRoleIds:       req.DefaultRoleIds,
+		Enabled:              req.Enabled,
+		Sort:                 req.Sort,
+		Remark:               req.Remark,
+	}
+}
@@ -0,0 +1,74 @@
+package samlprovider
+
+import (
+	"context"
+
+	"github.com/coder-lulu/newbee-core/api/internal/svc"
+	"github.com/coder-lulu/newbee-core/api/internal/types"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+
+	"github.com/coder-lulu/newbee-common/v2/i18n"
+)
+
+type GetSamlProviderByIdLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewGetSamlProviderByIdLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetSamlProviderByIdLogic {
+	return &GetSamlProviderByIdLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetSamlProviderByIdLogic) GetSamlProviderById(req *types.IDReq) (resp *types.SamlProviderInfoResp, err error) {
+	data, err := l.svcCtx.CoreRpc.GetSamlProviderById(l.ctx, &core.IDReq{Id: req.Id})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.SamlProviderInfoResp{
+		BaseDataInfo: types.BaseDataInfo{
+			Code: 0,
+			Msg:  l.svcCtx.Trans.Trans(l.ctx, i18n.Success),
+		},
+		Data: convertSamlProviderInfo(data),
+	}, nil
+}
+
+func convertSamlProviderInfo(data *core.SamlProviderInfo) types.SamlProviderInfo {
+	return types.SamlProviderInfo{
+		BaseIDInfo: types.BaseIDInfo{
+			Id:        data.Id,
+			CreatedAt: data.CreatedAt,
+			UpdatedAt: data.UpdatedAt,
+		},
+		Status:               data.Status,
+		Name:                 data.Name,
+		DisplayName:          data.DisplayName,
+		IdpEntityId:          data.IdpEntityId,
+		IdpSsoUrl:            data.IdpSsoUrl,
+		IdpSloUrl:            data.IdpSloUrl,
+		IdpCertificates:      data.IdpCertificates,
+		IdpMetadataUrl:       data.IdpMetadataUrl,
+		SpEntityId:           data.SpEntityId,
+		AcsUrl:               data.AcsUrl,
+		NameIdFormat:         data.NameIdFormat,
+		SignAuthnRequest:     data.SignAuthnRequest,
+		WantAssertionsSigned: data.WantAssertionsSigned,
+		AttributeMapping:     data.AttributeMapping,
+		JitProvision:         data.JitProvision,
+		SyncAttributes:       data.SyncAttributes,
+		LinkByEmail:          data.LinkByEmail,
+		DefaultDepartmentId:  data.DefaultDepartmentId,
+		DefaultRoleIds:       data.DefaultRoleIds,
+		Enabled:              data.Enabled,
+		Sort:                 data.Sort,
+		Remark:               data.Remark,
+	}
+}
@@ -0,0 +1,47 @@
+package samlprovider
+
+import (
+	"context"
+
+	"github.com/coder-lulu/newbee-core/api/internal/svc"
+	"github.com/coder-lulu/newbee-core/api/internal/types"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+
+	"github.com/coder-lulu/newbee-common/v2/i18n"
+)
+
+type GetSamlProviderListLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewGetSamlProviderListLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetSamlProviderListLogic {
+	return &GetSamlProviderListLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetSamlProviderListLogic) GetSamlProviderList(req *types.SamlProviderListReq) (resp *types.SamlProviderListResp, err error) {
+	data, err := l.svcCtx.CoreRpc.GetSamlProviderList(l.ctx,
+		&core.SamlProviderListReq{
+			Page:     req.Page,
+			PageSize: req.PageSize,
+			Name:     req.Name,
+		})
+	if err != nil {
+		return nil, err
+	}
+	resp = &types.SamlProviderListResp{}
+	resp.Msg = l.svcCtx.Trans.Trans(l.ctx, i18n.Success)
+	resp.Data.Total = data.GetTotal()
+
+	for _, v := range data.Data {
+		resp.Data.Data = append(resp.Data.Data, convertSamlProviderInfo(v))
+	}
+	return resp, nil
+}
@@ -0,0 +1,35 @@
+package samlprovider
+
+import (
+	"context"
+
+	"github.com/coder-lulu/newbee-core/api/internal/svc"
+	"github.com/coder-lulu/newbee-core/api/internal/types"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetSamlSpMetadataLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewGetSamlSpMetadataLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetSamlSpMetadataLogic {
+	return &GetSamlSpMetadataLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// GetSamlSpMetadata returns the SP metadata document of the provider
+func (l *GetSamlSpMetadataLogic) GetSamlSpMetadata(req *types.SamlSpMetadataReq) ([]byte, error) {
+	data, err := l.svcCtx.CoreRpc.GetSamlSpMetadata(l.ctx, &core.SamlSpMetadataReq{ProviderId: req.Provider})
+	if err != nil {
+		return nil, err
+	}
+
+	return []byte(data.Metadata), nil
+}
@@ -0,0 +1,38 @@
+package samlprovider
+
+import (
+	"context"
+
+	"github.com/coder-lulu/newbee-core/api/internal/svc"
+	"github.com/coder-lulu/newbee-core/api/internal/types"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ImportSamlIdpMetadataLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewImportSamlIdpMetadataLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ImportSamlIdpMetadataLogic {
+	return &ImportSamlIdpMetadataLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ImportSamlIdpMetadataLogic) ImportSamlIdpMetadata(req *types.SamlMetadataImportReq) (resp *types.BaseMsgResp, err error) {
+	data, err := l.svcCtx.CoreRpc.ImportSamlIdpMetadata(l.ctx, &core.SamlMetadataImportReq{
+		Id:          req.Id,
+		MetadataXml: req.MetadataXml,
+		MetadataUrl: req.MetadataUrl,
+		EntityId:    req.EntityId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.BaseMsgResp{Msg: l.svcCtx.Trans.Trans(l.ctx, data.Msg)}, nil
+}
@@ -0,0 +1,90 @@
+package samlprovider
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/coder-lulu/newbee-common/v2/middleware/keys"
+	"github.com/coder-lulu/newbee-common/v2/utils/jwt"
+	"github.com/coder-lulu/newbee-common/v2/utils/pointy"
+
+	"github.com/coder-lulu/newbee-core/api/internal/session"
+	"github.com/coder-lulu/newbee-core/api/internal/svc"
+	"github.com/coder-lulu/newbee-core/api/internal/types"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type SamlAcsLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewSamlAcsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SamlAcsLogic {
+	return &SamlAcsLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// SamlAcs validates the SAML response posted by the IdP and issues a token like the OAuth callback
+func (l *SamlAcsLogic) SamlAcs(req *types.SamlAcsReq) (resp *types.CallbackResp, err error) {
+	clientInfo := session.FromContext(l.ctx)
+
+	data, err := l.svcCtx.CoreRpc.SamlAcs(l.ctx, &core.SamlAcsReq{
+		ProviderId:   req.Provider,
+		SamlResponse: req.SAMLResponse,
+		RelayState:   req.RelayState,
+		Ip:           &clientInfo.IP,
+	})
+	if err != nil {
+		return nil, err
+	}
+	result := data.User
+
+	// 登录时创建新会话
+	sessionID := session.NewID()
+
+	token, err := l.svcCtx.JwtKeys.NewJwtToken(time.Now().Unix(),
+		l.svcCtx.Config.Middleware.Auth.AccessExpire,
+		jwt.WithOption(keys.JWTUserID, *result.Id),
+		jwt.WithOption(session.ClaimSessionID, sessionID),
+		jwt.WithOption(keys.JWTTenantID, *result.TenantId),
+		jwt.WithOption(keys.JWTUsername, *result.Username),
+		jwt.WithOption(keys.JWTDeptID, *result.DepartmentId),
+		jwt.WithOption(keys.JWTRoleCodes, strings.Join(result.RoleCodes, ",")),
+		jwt.WithOption(keys.JWTNickname, *result.Nickname),
+		jwt.WithOption(keys.JWTAvatar, *result.Avatar),
+		jwt.WithOption("tenantId", result.TenantId))
+	if err != nil {
+		return nil, err
+	}
+
+	// add token into database
+	expiredAt := time.Now().Add(time.Second * time.Duration(l.svcCtx.Config.Middleware.Auth.AccessExpire)).UnixMilli()
+	_, err = l.svcCtx.CoreRpc.CreateToken(l.ctx, &core.TokenInfo{
+		Uuid:      result.Id,
+		Token:     pointy.GetPointer(token),
+		Source:    pointy.GetPointer("saml"),
+		Status:    pointy.GetPointer(uint32(1)),
+		ExpiredAt: pointy.GetPointer(expiredAt),
+		TenantId:  result.TenantId,
+		Device:    &clientInfo.Device,
+		UserAgent: &clientInfo.UserAgent,
+		Ip:        &clientInfo.IP,
+		SessionId: &sessionID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.CallbackResp{
+		UserId: *result.Id,
+		Token:  token,
+		Expire: uint64(expiredAt),
+	}, nil
+}
@@ -0,0 +1,42 @@
+package samlprovider
+
+import (
+	"context"
+
+	"github.com/coder-lulu/newbee-core/api/internal/svc"
+	"github.com/coder-lulu/newbee-core/api/internal/types"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+
+	"github.com/coder-lulu/newbee-common/v2/i18n"
+)
+
+type SamlLoginLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewSamlLoginLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SamlLoginLogic {
+	return &SamlLoginLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *SamlLoginLogic) SamlLogin(req *types.SamlLoginReq) (resp *types.RedirectResp, err error) {
+	result, err := l.svcCtx.CoreRpc.SamlLogin(l.ctx, &core.SamlLoginReq{
+		ProviderId: req.ProviderId,
+		RelayState: req.RelayState,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.RedirectResp{
+		BaseDataInfo: types.BaseDataInfo{Msg: l.svcCtx.Trans.Trans(l.ctx, i18n.Success)},
+		Data:         types.RedirectInfo{URL: result.Url},
+	}, nil
+}
@@ -0,0 +1,56 @@
+package scimtoken
+
+import (
+	"context"
+
+	"github.com/coder-lulu/newbee-core/api/internal/svc"
+	"github.com/coder-lulu/newbee-core/api/internal/types"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+
+	"github.com/coder-lulu/newbee-common/v2/i18n"
+)
+
+type CreateScimTokenLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewCreateScimTokenLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CreateScimTokenLogic {
+	return &CreateScimTokenLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *CreateScimTokenLogic) CreateScimToken(req *types.ScimTokenInfo) (resp *types.ScimTokenCreateResp, err error) {
+	data, err := l.svcCtx.CoreRpc.CreateScimToken(l.ctx, convertScimTokenReq(req))
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.ScimTokenCreateResp{
+		BaseDataInfo: types.BaseDataInfo{
+			Code: 0,
+			Msg:  l.svcCtx.Trans.Trans(l.ctx, i18n.CreateSuccess),
+		},
+		Data: types.ScimTokenCreateInfo{
+			Id:    data.Id,
+			Token: data.Token,
+		},
+	}, nil
+}
+
+func convertScimTokenReq(req *types.ScimTokenInfo) *core.ScimTokenInfo {
+	return &core.ScimTokenInfo{
+		Id:        req.Id,
+		Status:    req.Status,
+		Name:      req.Name,
+		ExpiredAt: req.ExpiredAt,
+		Remark:    req.Remark,
+		RoleCodes: req.RoleCodes,
+	}
+}
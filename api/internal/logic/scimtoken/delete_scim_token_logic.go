@@ -1,9 +1,8 @@
-package auditlog
+package scimtoken
 
 import (
 	"context"
 
-	"github.com/coder-lulu/newbee-common/v2/i18n"
 	"github.com/coder-lulu/newbee-core/api/internal/svc"
 	"github.com/coder-lulu/newbee-core/api/internal/types"
 	"github.com/coder-lulu/newbee-core/rpc/types/core"
@@ -11,30 +10,27 @@ import (
 	"github.com/zeromicro/go-zero/core/logx"
 )
 
-type DeleteAuditLogLogic struct {
+type DeleteScimTokenLogic struct {
 	logx.Logger
 	ctx    context.Context
 	svcCtx *svc.ServiceContext
 }
 
-func NewDeleteAuditLogLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DeleteAuditLogLogic {
-	return &DeleteAuditLogLogic{
+func NewDeleteScimTokenLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DeleteScimTokenLogic {
+	return &DeleteScimTokenLogic{
 		Logger: logx.WithContext(ctx),
 		ctx:    ctx,
 		svcCtx: svcCtx,
 	}
 }
 
-func (l *DeleteAuditLogLogic) DeleteAuditLog(req *types.UUIDsReq) (resp *types.BaseMsgResp, err error) {
-	_, err = l.svcCtx.CoreRpc.DeleteAuditLog(l.ctx, &core.UUIDsReq{
+func (l *DeleteScimTokenLogic) DeleteScimToken(req *types.IDsReq) (resp *types.BaseMsgResp, err error) {
+	result, err := l.svcCtx.CoreRpc.DeleteScimToken(l.ctx, &core.IDsReq{
 		Ids: req.Ids,
 	})
 	if err != nil {
 		return nil, err
 	}
 
-	return &types.BaseMsgResp{
-		Code: 0,
-		Msg:  l.svcCtx.Trans.Trans(l.ctx, i18n.DeleteSuccess),
-	}, nil
+	return &types.BaseMsgResp{Msg: l.svcCtx.Trans.Trans(l.ctx, result.Msg)}, nil
 }
@@ -0,0 +1,60 @@
+package scimtoken
+
+import (
+	"context"
+
+	"github.com/coder-lulu/newbee-core/api/internal/svc"
+	"github.com/coder-lulu/newbee-core/api/internal/types"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+
+	"github.com/coder-lulu/newbee-common/v2/i18n"
+)
+
+type GetScimTokenByIdLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewGetScimTokenByIdLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetScimTokenByIdLogic {
+	return &GetScimTokenByIdLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetScimTokenByIdLogic) GetScimTokenById(req *types.IDReq) (resp *types.ScimTokenInfoResp, err error) {
+	data, err := l.svcCtx.CoreRpc.GetScimTokenById(l.ctx, &core.IDReq{Id: req.Id})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.ScimTokenInfoResp{
+		BaseDataInfo: types.BaseDataInfo{
+			Code: 0,
+			Msg:  l.svcCtx.Trans.Trans(l.ctx, i18n.Success),
+		},
+		Data: convertScimTokenInfo(data),
+	}, nil
+}
+
+func convertScimTokenInfo(data *core.ScimTokenInfo) types.ScimTokenInfo {
+	return types.ScimTokenInfo{
+		BaseIDInfo: types.BaseIDInfo{
+			Id:        data.Id,
+			CreatedAt: data.CreatedAt,
+			UpdatedAt: data.UpdatedAt,
+		},
+		Status:      data.Status,
+		Name:        data.Name,
+		TokenPrefix: data.TokenPrefix,
+		ExpiredAt:   data.ExpiredAt,
+		LastUsedAt:  data.LastUsedAt,
+		LastUsedIp:  data.LastUsedIp,
+		Remark:      data.Remark,
+		RoleCodes:   data.RoleCodes,
+	}
+}
@@ -0,0 +1,47 @@
+package scimtoken
+
+import (
+	"context"
+
+	"github.com/coder-lulu/newbee-core/api/internal/svc"
+	"github.com/coder-lulu/newbee-core/api/internal/types"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+
+	"github.com/coder-lulu/newbee-common/v2/i18n"
+)
+
+type GetScimTokenListLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewGetScimTokenListLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetScimTokenListLogic {
+	return &GetScimTokenListLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetScimTokenListLogic) GetScimTokenList(req *types.ScimTokenListReq) (resp *types.ScimTokenListResp, err error) {
+	data, err := l.svcCtx.CoreRpc.GetScimTokenList(l.ctx,
+		&core.ScimTokenListReq{
+			Page:     req.Page,
+			PageSize: req.PageSize,
+			Name:     req.Name,
+		})
+	if err != nil {
+		return nil, err
+	}
+	resp = &types.ScimTokenListResp{}
+	resp.Msg = l.svcCtx.Trans.Trans(l.ctx, i18n.Success)
+	resp.Data.Total = data.GetTotal()
+
+	for _, v := range data.Data {
+		resp.Data.Data = append(resp.Data.Data, convertScimTokenInfo(v))
+	}
+	return resp, nil
+}
@@ -2,11 +2,11 @@ package tenant
 
 import (
 	"context"
-	"fmt"
 	"net/http"
 
 	"github.com/coder-lulu/newbee-core/api/internal/svc"
 	"github.com/coder-lulu/newbee-core/api/internal/types"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
 
 	"github.com/zeromicro/go-zero/core/errorx"
 	"github.com/zeromicro/go-zero/core/logx"
@@ -33,12 +33,11 @@ func (l *ClearTenantSwitchLogic) ClearTenantSwitch() (resp *types.BaseMsgResp, e
 		return nil, errorx.NewApiError(http.StatusUnauthorized, "User not authenticated")
 	}
 
-	// 2. 清除Redis中的租户切换状态
-	sessionKey := fmt.Sprintf("admin:tenant:%s", userIdStr)
-	err = l.svcCtx.Redis.Del(l.ctx, sessionKey).Err()
+	// 2. 结束模拟访问会话并清除Redis中的租户切换状态
+	_, err = l.svcCtx.CoreRpc.EndImpersonation(l.ctx, &core.UUIDReq{Id: userIdStr})
 	if err != nil {
-		logx.Errorw("Failed to clear tenant switch from Redis", logx.Field("sessionKey", sessionKey), logx.Field("error", err))
-		return nil, errorx.NewInternalError("Failed to clear tenant switch")
+		logx.Errorw("Failed to end the impersonation session", logx.Field("userId", userIdStr), logx.Field("error", err))
+		return nil, err
 	}
 
 	logx.Infow("Tenant switch cleared successfully", logx.Field("userId", userIdStr))
@@ -0,0 +1,53 @@
+package tenant
+
+import (
+	"context"
+
+	"github.com/coder-lulu/newbee-common/v2/i18n"
+
+	"github.com/coder-lulu/newbee-core/api/internal/svc"
+	"github.com/coder-lulu/newbee-core/api/internal/types"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type DiagnoseTenantsLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewDiagnoseTenantsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DiagnoseTenantsLogic {
+	return &DiagnoseTenantsLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *DiagnoseTenantsLogic) DiagnoseTenants(req *types.TenantDoctorReq) (resp *types.TenantDoctorResp, err error) {
+	data, err := l.svcCtx.CoreRpc.DiagnoseTenants(l.ctx, &core.TenantDoctorReq{TenantId: req.TenantId})
+	if err != nil {
+		return nil, err
+	}
+
+	return convertTenantDoctorResp(l.svcCtx.Trans.Trans(l.ctx, i18n.Success), data), nil
+}
+
+func convertTenantDoctorResp(msg string, data *core.TenantDoctorResp) *types.TenantDoctorResp {
+	resp := &types.TenantDoctorResp{}
+	resp.Msg = msg
+	resp.Data.Total = data.GetTotal()
+
+	for _, v := range data.Data {
+		resp.Data.Data = append(resp.Data.Data, types.TenantDoctorFinding{
+			TenantId: v.TenantId,
+			Code:     v.Code,
+			Message:  v.Message,
+			Subjects: v.Subjects,
+			Repair:   v.Repair,
+		})
+	}
+	return resp
+}
@@ -76,6 +76,18 @@ func (l *GetCurrentTenantLogic) GetCurrentTenant() (resp *types.CurrentTenantRes
 		}
 	}
 
+	// 6. 获取生效的模拟访问会话，切换期间前端显示提示横幅
+	var session *types.ImpersonationSessionInfo
+	if isSwitched {
+		active, rpcErr := l.svcCtx.CoreRpc.GetActiveImpersonation(l.ctx, &core.UUIDReq{Id: userId})
+		if rpcErr != nil {
+			logx.Errorw("Failed to get the active impersonation session", logx.Field("userId", userId), logx.Field("error", rpcErr))
+		} else if active.Id != 0 {
+			info := convertImpersonationSession(active)
+			session = &info
+		}
+	}
+
 	return &types.CurrentTenantResp{
 		BaseDataInfo: types.BaseDataInfo{
 			Code: 0,
@@ -85,5 +97,7 @@ func (l *GetCurrentTenantLogic) GetCurrentTenant() (resp *types.CurrentTenantRes
 		ActiveTenantId:   activeTenantIdStr,
 		ActiveTenantInfo: activeTenantInfo,
 		IsSwitched:       isSwitched,
+		Impersonation:    session,
+		ShowBanner:       isSwitched,
 	}, nil
 }
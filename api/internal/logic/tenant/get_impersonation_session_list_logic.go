@@ -0,0 +1,76 @@
+package tenant
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/coder-lulu/newbee-core/api/internal/svc"
+	"github.com/coder-lulu/newbee-core/api/internal/types"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/coder-lulu/newbee-common/v2/i18n"
+
+	"github.com/zeromicro/go-zero/core/errorx"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetImpersonationSessionListLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewGetImpersonationSessionListLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetImpersonationSessionListLogic {
+	return &GetImpersonationSessionListLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetImpersonationSessionListLogic) GetImpersonationSessionList(req *types.ImpersonationSessionListReq) (resp *types.ImpersonationSessionListResp, err error) {
+	userId := l.svcCtx.ContextManager.GetUserID(l.ctx)
+	if userId == "" {
+		return nil, errorx.NewApiError(http.StatusUnauthorized, "User not authenticated")
+	}
+
+	in := &core.ImpersonationSessionListReq{
+		Page:     req.Page,
+		PageSize: req.PageSize,
+		UserId:   req.UserId,
+		State:    req.State,
+	}
+
+	// 超级管理员在所属租户中可以查看全部会话，其他情况只能查看进入当前租户的会话
+	userInfo, err := getSuperAdmin(l.ctx, l.svcCtx, userId)
+	if err != nil {
+		return nil, err
+	}
+	originalTenantId := l.svcCtx.ContextManager.GetOriginalTenantID(l.ctx)
+	activeTenantId := l.svcCtx.ContextManager.GetTenantID(l.ctx)
+	if userInfo != nil && (originalTenantId == "" || originalTenantId == activeTenantId) {
+		in.TargetTenantId = req.TargetTenantId
+	} else {
+		tenantId, err := strconv.ParseUint(activeTenantId, 10, 64)
+		if err != nil {
+			return nil, errorx.NewInternalError("Tenant ID not found in context")
+		}
+		in.TargetTenantId = &tenantId
+	}
+
+	data, err := l.svcCtx.CoreRpc.GetImpersonationSessionList(l.ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	resp = &types.ImpersonationSessionListResp{}
+	resp.Msg = l.svcCtx.Trans.Trans(l.ctx, i18n.Success)
+	resp.Data.Total = data.GetTotal()
+
+	for _, v := range data.Data {
+		resp.Data.Data = append(resp.Data.Data, convertImpersonationSession(v))
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,83 @@
+package tenant
+
+import (
+	"context"
+
+	"github.com/coder-lulu/newbee-common/v2/i18n"
+
+	"github.com/coder-lulu/newbee-core/api/internal/svc"
+	"github.com/coder-lulu/newbee-core/api/internal/types"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetTenantInitJobByIdLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewGetTenantInitJobByIdLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetTenantInitJobByIdLogic {
+	return &GetTenantInitJobByIdLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetTenantInitJobByIdLogic) GetTenantInitJobById(req *types.IDReq) (resp *types.TenantInitJobInfoResp, err error) {
+	data, err := l.svcCtx.CoreRpc.GetTenantInitJobById(l.ctx, &core.IDReq{Id: req.Id})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.TenantInitJobInfoResp{
+		BaseDataInfo: types.BaseDataInfo{
+			Code: 0,
+			Msg:  l.svcCtx.Trans.Trans(l.ctx, i18n.Success),
+		},
+		Data: convertTenantInitJobInfo(data),
+	}, nil
+}
+
+func convertTenantInitJobInfo(data *core.TenantInitJobInfo) types.TenantInitJobInfo {
+	info := types.TenantInitJobInfo{
+		Id:         data.Id,
+		CreatedAt:  data.CreatedAt,
+		UpdatedAt:  data.UpdatedAt,
+		TenantId:   data.TenantId,
+		Template:   data.Template,
+		Mode:       data.Mode,
+		DryRun:     data.DryRun,
+		Status:     data.Status,
+		Attempts:   data.Attempts,
+		Error:      data.Error,
+		StartedAt:  data.StartedAt,
+		FinishedAt: data.FinishedAt,
+		Plugins:    make([]types.TenantInitPluginProgress, 0, len(data.Plugins)),
+	}
+
+	for _, v := range data.Plugins {
+		info.Plugins = append(info.Plugins, types.TenantInitPluginProgress{
+			Name:       v.Name,
+			Status:     v.Status,
+			Error:      v.Error,
+			Attempts:   v.Attempts,
+			StartedAt:  v.StartedAt,
+			FinishedAt: v.FinishedAt,
+		})
+	}
+
+	for _, v := range data.Plan {
+		info.Plan = append(info.Plan, types.TenantInitPlanItem{
+			Plugin:    v.Plugin,
+			Component: v.Component,
+			Action:    v.Action,
+			Count:     v.Count,
+			Items:     v.Items,
+		})
+	}
+
+	return info
+}
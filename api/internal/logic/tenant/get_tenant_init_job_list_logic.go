@@ -0,0 +1,48 @@
+package tenant
+
+import (
+	"context"
+
+	"github.com/coder-lulu/newbee-common/v2/i18n"
+
+	"github.com/coder-lulu/newbee-core/api/internal/svc"
+	"github.com/coder-lulu/newbee-core/api/internal/types"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetTenantInitJobListLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewGetTenantInitJobListLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetTenantInitJobListLogic {
+	return &GetTenantInitJobListLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetTenantInitJobListLogic) GetTenantInitJobList(req *types.TenantInitJobListReq) (resp *types.TenantInitJobListResp, err error) {
+	data, err := l.svcCtx.CoreRpc.GetTenantInitJobList(l.ctx,
+		&core.TenantInitJobListReq{
+			Page:     req.Page,
+			PageSize: req.PageSize,
+			TenantId: req.TenantId,
+			Status:   req.Status,
+		})
+	if err != nil {
+		return nil, err
+	}
+	resp = &types.TenantInitJobListResp{}
+	resp.Msg = l.svcCtx.Trans.Trans(l.ctx, i18n.Success)
+	resp.Data.Total = data.GetTotal()
+
+	for _, v := range data.Data {
+		resp.Data.Data = append(resp.Data.Data, convertTenantInitJobInfo(v))
+	}
+	return resp, nil
+}
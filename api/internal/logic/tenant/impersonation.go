@@ -0,0 +1,54 @@
+package tenant
+
+import (
+	"context"
+	"strings"
+
+	"github.com/coder-lulu/newbee-core/api/internal/svc"
+	"github.com/coder-lulu/newbee-core/api/internal/types"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/errorx"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// getSuperAdmin 获取超级管理员的用户信息，用户不是超级管理员时返回 nil
+func getSuperAdmin(ctx context.Context, svcCtx *svc.ServiceContext, userId string) (*core.UserInfo, error) {
+	// 通过RPC获取用户信息
+	userInfo, err := svcCtx.CoreRpc.GetUserById(ctx, &core.UUIDReq{
+		Id: userId,
+	})
+	if err != nil {
+		logx.Errorw("Failed to get user info", logx.Field("userId", userId), logx.Field("error", err))
+		return nil, errorx.NewInternalError("Failed to get user information")
+	}
+
+	// 检查角色码中是否包含superadmin
+	for _, roleCode := range userInfo.RoleCodes {
+		if strings.TrimSpace(roleCode) == "superadmin" {
+			return userInfo, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func convertImpersonationSession(v *core.ImpersonationSessionInfo) types.ImpersonationSessionInfo {
+	return types.ImpersonationSessionInfo{
+		Id:              v.Id,
+		CreatedAt:       v.CreatedAt,
+		UserId:          v.UserId,
+		UserName:        v.UserName,
+		OriginTenantId:  v.OriginTenantId,
+		TargetTenantId:  v.TargetTenantId,
+		Reason:          v.Reason,
+		Ticket:          v.Ticket,
+		State:           v.State,
+		DurationSeconds: v.DurationSeconds,
+		ApprovedBy:      v.ApprovedBy,
+		ApprovedAt:      v.ApprovedAt,
+		StartedAt:       v.StartedAt,
+		ExpiresAt:       v.ExpiresAt,
+		EndedAt:         v.EndedAt,
+	}
+}
@@ -24,20 +24,25 @@ func NewInitTenantLogic(ctx context.Context, svcCtx *svc.ServiceContext) *InitTe
 	}
 }
 
-func (l *InitTenantLogic) InitTenant(req *types.TenantInitReq) (resp *types.BaseMsgResp, err error) {
+func (l *InitTenantLogic) InitTenant(req *types.TenantInitReq) (resp *types.TenantInitResp, err error) {
 	data, err := l.svcCtx.CoreRpc.InitTenant(l.ctx, &core.TenantInitReq{
 		TenantId:      req.TenantId,
 		AdminUsername: req.AdminUsername,
 		AdminPassword: req.AdminPassword,
 		AdminEmail:    req.AdminEmail,
+		Template:      req.Template,
+		DryRun:        req.DryRun,
 	})
 
 	if err != nil {
 		return nil, err
 	}
 
-	return &types.BaseMsgResp{
-		Code: 0,
-		Msg:  l.svcCtx.Trans.Trans(l.ctx, data.Msg),
+	return &types.TenantInitResp{
+		BaseDataInfo: types.BaseDataInfo{
+			Code: 0,
+			Msg:  l.svcCtx.Trans.Trans(l.ctx, data.Msg),
+		},
+		Data: types.TenantInitJobIdInfo{JobId: data.Id},
 	}, nil
 }
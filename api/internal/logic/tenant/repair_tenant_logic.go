@@ -0,0 +1,41 @@
+package tenant
+
+import (
+	"context"
+
+	"github.com/coder-lulu/newbee-common/v2/i18n"
+
+	"github.com/coder-lulu/newbee-core/api/internal/svc"
+	"github.com/coder-lulu/newbee-core/api/internal/types"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type RepairTenantLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewRepairTenantLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RepairTenantLogic {
+	return &RepairTenantLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *RepairTenantLogic) RepairTenant(req *types.TenantRepairReq) (resp *types.TenantDoctorResp, err error) {
+	data, err := l.svcCtx.CoreRpc.RepairTenant(l.ctx,
+		&core.TenantRepairReq{
+			TenantId:      req.TenantId,
+			Codes:         req.Codes,
+			AdminPassword: req.AdminPassword,
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return convertTenantDoctorResp(l.svcCtx.Trans.Trans(l.ctx, i18n.UpdateSuccess), data), nil
+}
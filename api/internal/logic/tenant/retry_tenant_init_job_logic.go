@@ -0,0 +1,43 @@
+package tenant
+
+import (
+	"context"
+
+	"github.com/coder-lulu/newbee-core/api/internal/svc"
+	"github.com/coder-lulu/newbee-core/api/internal/types"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type RetryTenantInitJobLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewRetryTenantInitJobLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RetryTenantInitJobLogic {
+	return &RetryTenantInitJobLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *RetryTenantInitJobLogic) RetryTenantInitJob(req *types.TenantInitJobRetryReq) (resp *types.TenantInitResp, err error) {
+	data, err := l.svcCtx.CoreRpc.RetryTenantInitJob(l.ctx, &core.TenantInitJobRetryReq{
+		Id:            req.Id,
+		AdminPassword: req.AdminPassword,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.TenantInitResp{
+		BaseDataInfo: types.BaseDataInfo{
+			Code: 0,
+			Msg:  l.svcCtx.Trans.Trans(l.ctx, data.Msg),
+		},
+		Data: types.TenantInitJobIdInfo{JobId: data.Id},
+	}, nil
+}
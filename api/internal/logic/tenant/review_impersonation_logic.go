@@ -0,0 +1,59 @@
+package tenant
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/coder-lulu/newbee-core/api/internal/svc"
+	"github.com/coder-lulu/newbee-core/api/internal/types"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/coder-lulu/newbee-common/v2/i18n"
+
+	"github.com/zeromicro/go-zero/core/errorx"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ReviewImpersonationLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewReviewImpersonationLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ReviewImpersonationLogic {
+	return &ReviewImpersonationLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ReviewImpersonationLogic) ReviewImpersonation(req *types.ImpersonationReviewReq) (resp *types.ImpersonationSessionResp, err error) {
+	userId := l.svcCtx.ContextManager.GetUserID(l.ctx)
+	if userId == "" {
+		return nil, errorx.NewApiError(http.StatusUnauthorized, "User not authenticated")
+	}
+
+	// 只有其他超级管理员可以审批
+	userInfo, err := getSuperAdmin(l.ctx, l.svcCtx, userId)
+	if err != nil {
+		return nil, err
+	}
+	if userInfo == nil {
+		return nil, errorx.NewApiError(http.StatusForbidden, "Only super admin can review the impersonation request")
+	}
+
+	data, err := l.svcCtx.CoreRpc.ReviewImpersonation(l.ctx, &core.ImpersonationReviewReq{
+		Id:         req.Id,
+		ReviewerId: userId,
+		Approve:    req.Approve,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.ImpersonationSessionResp{
+		BaseDataInfo: types.BaseDataInfo{Msg: l.svcCtx.Trans.Trans(l.ctx, i18n.UpdateSuccess)},
+		Data:         convertImpersonationSession(data),
+	}, nil
+}
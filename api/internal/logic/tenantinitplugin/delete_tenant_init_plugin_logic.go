@@ -0,0 +1,34 @@
+package tenantinitplugin
+
+import (
+	"context"
+
+	"github.com/coder-lulu/newbee-core/api/internal/svc"
+	"github.com/coder-lulu/newbee-core/api/internal/types"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type DeleteTenantInitPluginLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewDeleteTenantInitPluginLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DeleteTenantInitPluginLogic {
+	return &DeleteTenantInitPluginLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *DeleteTenantInitPluginLogic) DeleteTenantInitPlugin(req *types.IDsReq) (resp *types.BaseMsgResp, err error) {
+	data, err := l.svcCtx.CoreRpc.DeleteTenantInitPlugin(l.ctx, &core.IDsReq{Ids: req.Ids})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.BaseMsgResp{Msg: l.svcCtx.Trans.Trans(l.ctx, data.Msg)}, nil
+}
@@ -0,0 +1,61 @@
+package tenantinitplugin
+
+import (
+	"context"
+
+	"github.com/coder-lulu/newbee-common/v2/i18n"
+
+	"github.com/coder-lulu/newbee-core/api/internal/svc"
+	"github.com/coder-lulu/newbee-core/api/internal/types"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetTenantInitPluginByIdLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewGetTenantInitPluginByIdLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetTenantInitPluginByIdLogic {
+	return &GetTenantInitPluginByIdLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetTenantInitPluginByIdLogic) GetTenantInitPluginById(req *types.IDReq) (resp *types.TenantInitPluginInfoResp, err error) {
+	data, err := l.svcCtx.CoreRpc.GetTenantInitPluginById(l.ctx, &core.IDReq{Id: req.Id})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.TenantInitPluginInfoResp{
+		BaseDataInfo: types.BaseDataInfo{
+			Code: 0,
+			Msg:  l.svcCtx.Trans.Trans(l.ctx, i18n.Success),
+		},
+		Data: convertTenantInitPluginInfo(data),
+	}, nil
+}
+
+func convertTenantInitPluginInfo(data *core.TenantInitPluginInfo) types.TenantInitPluginInfo {
+	return types.TenantInitPluginInfo{
+		BaseIDInfo: types.BaseIDInfo{
+			Id:        data.Id,
+			CreatedAt: data.CreatedAt,
+			UpdatedAt: data.UpdatedAt,
+		},
+		Status:          data.Status,
+		Name:            data.Name,
+		Version:         data.Version,
+		Description:     data.Description,
+		Target:          data.Target,
+		Dependencies:    data.Dependencies,
+		Priority:        data.Priority,
+		Timeout:         data.Timeout,
+		SupportRollback: data.SupportRollback,
+	}
+}
@@ -0,0 +1,47 @@
+package tenantinitplugin
+
+import (
+	"context"
+
+	"github.com/coder-lulu/newbee-common/v2/i18n"
+
+	"github.com/coder-lulu/newbee-core/api/internal/svc"
+	"github.com/coder-lulu/newbee-core/api/internal/types"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetTenantInitPluginListLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewGetTenantInitPluginListLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetTenantInitPluginListLogic {
+	return &GetTenantInitPluginListLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetTenantInitPluginListLogic) GetTenantInitPluginList(req *types.TenantInitPluginListReq) (resp *types.TenantInitPluginListResp, err error) {
+	data, err := l.svcCtx.CoreRpc.GetTenantInitPluginList(l.ctx,
+		&core.TenantInitPluginListReq{
+			Page:     req.Page,
+			PageSize: req.PageSize,
+			Name:     req.Name,
+		})
+	if err != nil {
+		return nil, err
+	}
+	resp = &types.TenantInitPluginListResp{}
+	resp.Msg = l.svcCtx.Trans.Trans(l.ctx, i18n.Success)
+	resp.Data.Total = data.GetTotal()
+
+	for _, v := range data.Data {
+		resp.Data.Data = append(resp.Data.Data, convertTenantInitPluginInfo(v))
+	}
+	return resp, nil
+}
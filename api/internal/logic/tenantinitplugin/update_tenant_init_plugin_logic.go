@@ -0,0 +1,46 @@
+package tenantinitplugin
+
+import (
+	"context"
+
+	"github.com/coder-lulu/newbee-core/api/internal/svc"
+	"github.com/coder-lulu/newbee-core/api/internal/types"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type UpdateTenantInitPluginLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewUpdateTenantInitPluginLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UpdateTenantInitPluginLogic {
+	return &UpdateTenantInitPluginLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *UpdateTenantInitPluginLogic) UpdateTenantInitPlugin(req *types.TenantInitPluginInfo) (resp *types.BaseMsgResp, err error) {
+	data, err := l.svcCtx.CoreRpc.UpdateTenantInitPlugin(l.ctx,
+		&core.TenantInitPluginInfo{
+			Id:              req.Id,
+			Status:          req.Status,
+			Name:            req.Name,
+			Version:         req.Version,
+			Description:     req.Description,
+			Target:          req.Target,
+			Dependencies:    req.Dependencies,
+			Priority:        req.Priority,
+			Timeout:         req.Timeout,
+			SupportRollback: req.SupportRollback,
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.BaseMsgResp{Msg: l.svcCtx.Trans.Trans(l.ctx, data.Msg)}, nil
+}
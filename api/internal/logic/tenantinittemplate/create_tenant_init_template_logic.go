@@ -0,0 +1,47 @@
+package tenantinittemplate
+
+import (
+	"context"
+
+	"github.com/coder-lulu/newbee-core/api/internal/svc"
+	"github.com/coder-lulu/newbee-core/api/internal/types"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CreateTenantInitTemplateLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewCreateTenantInitTemplateLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CreateTenantInitTemplateLogic {
+	return &CreateTenantInitTemplateLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *CreateTenantInitTemplateLogic) CreateTenantInitTemplate(req *types.TenantInitTemplateInfo) (resp *types.BaseMsgResp, err error) {
+	data, err := l.svcCtx.CoreRpc.CreateTenantInitTemplate(l.ctx, convertTenantInitTemplateReq(req))
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.BaseMsgResp{Msg: l.svcCtx.Trans.Trans(l.ctx, data.Msg)}, nil
+}
+
+func convertTenantInitTemplateReq(req *types.TenantInitTemplateInfo) *core.TenantInitTemplateInfo {
+	return &core.TenantInitTemplateInfo{
+		Id:           req.Id,
+		Status:       req.Status,
+		Name:         req.Name,
+		Version:      req.Version,
+		Description:  req.Description,
+		BaseTemplate: req.BaseTemplate,
+		Components:   req.Components,
+		Sort:         req.Sort,
+	}
+}
@@ -0,0 +1,59 @@
+package tenantinittemplate
+
+import (
+	"context"
+
+	"github.com/coder-lulu/newbee-common/v2/i18n"
+
+	"github.com/coder-lulu/newbee-core/api/internal/svc"
+	"github.com/coder-lulu/newbee-core/api/internal/types"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetTenantInitTemplateByIdLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewGetTenantInitTemplateByIdLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetTenantInitTemplateByIdLogic {
+	return &GetTenantInitTemplateByIdLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetTenantInitTemplateByIdLogic) GetTenantInitTemplateById(req *types.IDReq) (resp *types.TenantInitTemplateInfoResp, err error) {
+	data, err := l.svcCtx.CoreRpc.GetTenantInitTemplateById(l.ctx, &core.IDReq{Id: req.Id})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.TenantInitTemplateInfoResp{
+		BaseDataInfo: types.BaseDataInfo{
+			Code: 0,
+			Msg:  l.svcCtx.Trans.Trans(l.ctx, i18n.Success),
+		},
+		Data: convertTenantInitTemplateInfo(data),
+	}, nil
+}
+
+func convertTenantInitTemplateInfo(data *core.TenantInitTemplateInfo) types.TenantInitTemplateInfo {
+	return types.TenantInitTemplateInfo{
+		BaseIDInfo: types.BaseIDInfo{
+			Id:        data.Id,
+			CreatedAt: data.CreatedAt,
+			UpdatedAt: data.UpdatedAt,
+		},
+		Status:       data.Status,
+		Name:         data.Name,
+		Version:      data.Version,
+		Description:  data.Description,
+		BaseTemplate: data.BaseTemplate,
+		Components:   data.Components,
+		Sort:         data.Sort,
+	}
+}
@@ -0,0 +1,48 @@
+package tenantinittemplate
+
+import (
+	"context"
+
+	"github.com/coder-lulu/newbee-common/v2/i18n"
+
+	"github.com/coder-lulu/newbee-core/api/internal/svc"
+	"github.com/coder-lulu/newbee-core/api/internal/types"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetTenantInitTemplateListLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewGetTenantInitTemplateListLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetTenantInitTemplateListLogic {
+	return &GetTenantInitTemplateListLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetTenantInitTemplateListLogic) GetTenantInitTemplateList(req *types.TenantInitTemplateListReq) (resp *types.TenantInitTemplateListResp, err error) {
+	data, err := l.svcCtx.CoreRpc.GetTenantInitTemplateList(l.ctx,
+		&core.TenantInitTemplateListReq{
+			Page:         req.Page,
+			PageSize:     req.PageSize,
+			Name:         req.Name,
+			BaseTemplate: req.BaseTemplate,
+		})
+	if err != nil {
+		return nil, err
+	}
+	resp = &types.TenantInitTemplateListResp{}
+	resp.Msg = l.svcCtx.Trans.Trans(l.ctx, i18n.Success)
+	resp.Data.Total = data.GetTotal()
+
+	for _, v := range data.Data {
+		resp.Data.Data = append(resp.Data.Data, convertTenantInitTemplateInfo(v))
+	}
+	return resp, nil
+}
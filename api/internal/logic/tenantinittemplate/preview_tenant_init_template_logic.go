@@ -0,0 +1,42 @@
+package tenantinittemplate
+
+import (
+	"context"
+
+	"github.com/coder-lulu/newbee-common/v2/i18n"
+
+	"github.com/coder-lulu/newbee-core/api/internal/svc"
+	"github.com/coder-lulu/newbee-core/api/internal/types"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type PreviewTenantInitTemplateLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewPreviewTenantInitTemplateLogic(ctx context.Context, svcCtx *svc.ServiceContext) *PreviewTenantInitTemplateLogic {
+	return &PreviewTenantInitTemplateLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *PreviewTenantInitTemplateLogic) PreviewTenantInitTemplate(req *types.TenantInitTemplatePreviewReq) (resp *types.TenantInitTemplatePreviewResp, err error) {
+	data, err := l.svcCtx.CoreRpc.PreviewTenantInitTemplate(l.ctx, &core.TenantInitTemplatePreviewReq{Name: req.Name})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.TenantInitTemplatePreviewResp{
+		BaseDataInfo: types.BaseDataInfo{
+			Code: 0,
+			Msg:  l.svcCtx.Trans.Trans(l.ctx, i18n.Success),
+		},
+		Data: data.Content,
+	}, nil
+}
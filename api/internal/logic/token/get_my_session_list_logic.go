@@ -0,0 +1,50 @@
+package token
+
+import (
+	"context"
+
+	"github.com/coder-lulu/newbee-common/v2/i18n"
+	"github.com/coder-lulu/newbee-common/v2/orm/ent/entctx/userctx"
+
+	"github.com/coder-lulu/newbee-core/api/internal/session"
+	"github.com/coder-lulu/newbee-core/api/internal/svc"
+	"github.com/coder-lulu/newbee-core/api/internal/types"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetMySessionListLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewGetMySessionListLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetMySessionListLogic {
+	return &GetMySessionListLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetMySessionListLogic) GetMySessionList(req *types.PageInfo) (resp *types.SessionListResp, err error) {
+	userId, err := userctx.GetUserIDFromCtx(l.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := l.svcCtx.CoreRpc.GetUserSessionList(l.ctx, &core.UserSessionListReq{
+		Page:     req.Page,
+		PageSize: req.PageSize,
+		Uuid:     userId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.SessionListResp{
+		BaseDataInfo: types.BaseDataInfo{Msg: l.svcCtx.Trans.Trans(l.ctx, i18n.Success)},
+		Data:         convertSessionList(data, session.FromContext(l.ctx)),
+	}, nil
+}
@@ -0,0 +1,69 @@
+package token
+
+import (
+	"context"
+
+	"github.com/coder-lulu/newbee-common/v2/i18n"
+
+	"github.com/coder-lulu/newbee-core/api/internal/session"
+	"github.com/coder-lulu/newbee-core/api/internal/svc"
+	"github.com/coder-lulu/newbee-core/api/internal/types"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetUserSessionListLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewGetUserSessionListLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetUserSessionListLogic {
+	return &GetUserSessionListLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetUserSessionListLogic) GetUserSessionList(req *types.UserSessionListReq) (resp *types.SessionListResp, err error) {
+	data, err := l.svcCtx.CoreRpc.GetUserSessionList(l.ctx, &core.UserSessionListReq{
+		Page:     req.Page,
+		PageSize: req.PageSize,
+		Uuid:     req.Uuid,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.SessionListResp{
+		BaseDataInfo: types.BaseDataInfo{Msg: l.svcCtx.Trans.Trans(l.ctx, i18n.Success)},
+		Data:         convertSessionList(data, session.ClientInfo{}),
+	}, nil
+}
+
+// convertSessionList 转换会话列表，不向前端暴露令牌本身，current 用于标记当前会话
+func convertSessionList(data *core.TokenListResp, current session.ClientInfo) types.SessionListInfo {
+	result := types.SessionListInfo{}
+	result.Total = data.GetTotal()
+
+	for _, v := range data.Data {
+		// 刷新后的令牌与会话列表中的令牌不同，按会话ID匹配
+		isCurrent := (current.SessionID != "" && v.GetSessionId() == current.SessionID) ||
+			(current.Token != "" && v.GetToken() == current.Token)
+		result.Data = append(result.Data, types.SessionInfo{
+			Id:         v.GetId(),
+			CreatedAt:  v.GetCreatedAt(),
+			Source:     v.GetSource(),
+			Device:     v.GetDevice(),
+			UserAgent:  v.GetUserAgent(),
+			Ip:         v.GetIp(),
+			LastSeenAt: v.GetLastSeenAt(),
+			ExpiredAt:  v.GetExpiredAt(),
+			Current:    isCurrent,
+		})
+	}
+
+	return result
+}
@@ -0,0 +1,46 @@
+package token
+
+import (
+	"context"
+
+	"github.com/coder-lulu/newbee-common/v2/orm/ent/entctx/userctx"
+
+	"github.com/coder-lulu/newbee-core/api/internal/session"
+	"github.com/coder-lulu/newbee-core/api/internal/svc"
+	"github.com/coder-lulu/newbee-core/api/internal/types"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type RevokeMyOtherSessionsLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewRevokeMyOtherSessionsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RevokeMyOtherSessionsLogic {
+	return &RevokeMyOtherSessionsLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *RevokeMyOtherSessionsLogic) RevokeMyOtherSessions() (resp *types.BaseMsgResp, err error) {
+	userId, err := userctx.GetUserIDFromCtx(l.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	currentToken := session.FromContext(l.ctx).Token
+	result, err := l.svcCtx.CoreRpc.RevokeUserSession(l.ctx, &core.UserSessionRevokeReq{
+		Uuid:      userId,
+		KeepToken: &currentToken,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.BaseMsgResp{Msg: l.svcCtx.Trans.Trans(l.ctx, result.Msg)}, nil
+}
@@ -0,0 +1,45 @@
+package token
+
+import (
+	"context"
+
+	"github.com/coder-lulu/newbee-common/v2/orm/ent/entctx/userctx"
+
+	"github.com/coder-lulu/newbee-core/api/internal/svc"
+	"github.com/coder-lulu/newbee-core/api/internal/types"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type RevokeMySessionLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewRevokeMySessionLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RevokeMySessionLogic {
+	return &RevokeMySessionLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *RevokeMySessionLogic) RevokeMySession(req *types.UUIDsReq) (resp *types.BaseMsgResp, err error) {
+	userId, err := userctx.GetUserIDFromCtx(l.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// RPC 会限制只注销该用户自己的会话，避免越权注销他人会话
+	result, err := l.svcCtx.CoreRpc.RevokeUserSession(l.ctx, &core.UserSessionRevokeReq{
+		Uuid: userId,
+		Ids:  req.Ids,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.BaseMsgResp{Msg: l.svcCtx.Trans.Trans(l.ctx, result.Msg)}, nil
+}
@@ -0,0 +1,37 @@
+package token
+
+import (
+	"context"
+
+	"github.com/coder-lulu/newbee-core/api/internal/svc"
+	"github.com/coder-lulu/newbee-core/api/internal/types"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type RevokeUserSessionLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewRevokeUserSessionLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RevokeUserSessionLogic {
+	return &RevokeUserSessionLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *RevokeUserSessionLogic) RevokeUserSession(req *types.UserSessionRevokeReq) (resp *types.BaseMsgResp, err error) {
+	result, err := l.svcCtx.CoreRpc.RevokeUserSession(l.ctx, &core.UserSessionRevokeReq{
+		Uuid: req.Uuid,
+		Ids:  req.Ids,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.BaseMsgResp{Msg: l.svcCtx.Trans.Trans(l.ctx, result.Msg)}, nil
+}
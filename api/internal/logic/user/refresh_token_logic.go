@@ -17,6 +17,7 @@ import (
 
 	"github.com/coder-lulu/newbee-core/rpc/types/core"
 
+	"github.com/coder-lulu/newbee-core/api/internal/session"
 	"github.com/coder-lulu/newbee-core/api/internal/svc"
 	"github.com/coder-lulu/newbee-core/api/internal/types"
 
@@ -62,10 +63,14 @@ func (l *RefreshTokenLogic) RefreshToken() (resp *types.RefreshTokenResp, err er
 		roleIdsStr[i] = strconv.FormatUint(id, 10)
 	}
 
-	token, err := jwt.NewJwtToken(l.svcCtx.Config.Middleware.Auth.AccessSecret, time.Now().Unix(),
+	// 沿用当前令牌所属的会话
+	sessionID := session.IDFromContext(l.ctx)
+
+	token, err := l.svcCtx.JwtKeys.NewJwtToken(time.Now().Unix(),
 		int64(l.svcCtx.Config.ProjectConf.RefreshTokenPeriod)*60*60,
 		// 使用优化的短字段名
 		jwt.WithOption(keys.JWTUserID, userId),                      // "uid"
+		jwt.WithOption(session.ClaimSessionID, sessionID),           // "sid"
 		jwt.WithOption(keys.JWTTenantID, *userData.TenantId),         // "tid"
 		jwt.WithOption(keys.JWTUsername, *userData.Username),         // "un"
 		jwt.WithOption(keys.JWTDeptID, *userData.DepartmentId),       // "did"
@@ -89,6 +94,7 @@ func (l *RefreshTokenLogic) RefreshToken() (resp *types.RefreshTokenResp, err er
 
 	// add token into database
 	expiredAt := time.Now().Add(time.Hour * time.Duration(l.svcCtx.Config.ProjectConf.RefreshTokenPeriod)).UnixMilli()
+	clientInfo := session.FromContext(l.ctx)
 	_, err = l.svcCtx.CoreRpc.CreateToken(l.ctx, &core.TokenInfo{
 		Uuid:      &userId,
 		Token:     pointy.GetPointer(token),
@@ -96,6 +102,10 @@ func (l *RefreshTokenLogic) RefreshToken() (resp *types.RefreshTokenResp, err er
 		Status:    pointy.GetPointer(uint32(common.StatusNormal)),
 		Username:  userData.Username,
 		ExpiredAt: pointy.GetPointer(expiredAt),
+		Device:    &clientInfo.Device,
+		UserAgent: &clientInfo.UserAgent,
+		Ip:        &clientInfo.IP,
+		SessionId: &sessionID,
 	})
 
 	return &types.RefreshTokenResp{
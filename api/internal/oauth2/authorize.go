@@ -0,0 +1,62 @@
+package oauth2
+
+import (
+	"net/http"
+	"net/url"
+	"slices"
+
+	"github.com/coder-lulu/newbee-common/v2/enum/common"
+	"github.com/coder-lulu/newbee-common/v2/orm/ent/hooks"
+	"github.com/zeromicro/go-zero/core/logx"
+
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+)
+
+// authorize is the authorization endpoint of RFC 6749 4.1.1. It checks the client and the redirect URI,
+// then redirects the browser to the consent page with the original query, where the user logs in
+// and calls /oauth2/consent. Errors about the client or the redirect URI are not redirected to the client.
+func (s *Server) authorize(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	clientID, redirectURI := query.Get("client_id"), query.Get("redirect_uri")
+	if clientID == "" || redirectURI == "" {
+		writeError(w, newError("invalid_request", "client_id and redirect_uri are required"))
+		return
+	}
+
+	client, err := s.svcCtx.CoreRpc.GetOauthClientByClientId(hooks.NewSystemContext(r.Context()), &core.OauthClientIdReq{ClientId: clientID})
+	if err != nil {
+		logx.WithContext(r.Context()).Infow("OAuth client not found", logx.Field("clientId", clientID), logx.Field("detail", err.Error()))
+		writeError(w, newError("invalid_client", "unknown client"))
+		return
+	}
+	if client.GetStatus() != uint32(common.StatusNormal) {
+		writeError(w, newError("invalid_client", "client is disabled"))
+		return
+	}
+	if !slices.Contains(client.RedirectUris, redirectURI) {
+		writeError(w, newError("invalid_request", "redirect_uri is not registered"))
+		return
+	}
+
+	// 客户端和回调地址有效后，其余错误按 RFC 6749 4.1.2.1 回调客户端
+	state := query.Get("state")
+	redirectError := func(code, description string) {
+		params := url.Values{"error": {code}, "error_description": {description}}
+		http.Redirect(w, r, RedirectURL(redirectURI, params, state), http.StatusFound)
+	}
+	switch {
+	case query.Get("response_type") != "code":
+		redirectError("unsupported_response_type", "only the authorization code flow is supported")
+	case !slices.Contains(client.GrantTypes, grantAuthCode):
+		redirectError("unauthorized_client", "the client is not allowed to use the authorization code flow")
+	case query.Get("code_challenge") == "" && client.GetClientType() == "public":
+		redirectError("invalid_request", "code_challenge is required for public clients")
+	case query.Get("code_challenge") != "" && query.Get("code_challenge_method") != "S256":
+		redirectError("invalid_request", "code_challenge_method must be S256")
+	case s.svcCtx.Config.OAuth2Conf.ConsentURL == "":
+		logx.WithContext(r.Context()).Error("OAuth2Conf.ConsentURL is not configured")
+		redirectError("temporarily_unavailable", "the consent page is not configured")
+	default:
+		http.Redirect(w, r, s.svcCtx.Config.OAuth2Conf.ConsentURL+"?"+r.URL.RawQuery, http.StatusFound)
+	}
+}
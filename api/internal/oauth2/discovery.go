@@ -0,0 +1,37 @@
+package oauth2
+
+import (
+	"net/http"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+)
+
+// discovery writes the OpenID Provider Metadata, OpenID Connect Discovery 1.0 section 3
+func (s *Server) discovery(w http.ResponseWriter, r *http.Request) {
+	issuer := s.issuer(r)
+
+	w.Header().Set("Cache-Control", "public, max-age=300")
+	httpx.OkJsonCtx(r.Context(), w, map[string]any{
+		"issuer":                                        issuer,
+		"authorization_endpoint":                        issuer + PathAuthorize,
+		"token_endpoint":                                issuer + PathToken,
+		"userinfo_endpoint":                             issuer + PathUserInfo,
+		"jwks_uri":                                      issuer + pathJWKS,
+		"introspection_endpoint":                        issuer + PathIntrospect,
+		"revocation_endpoint":                           issuer + PathRevoke,
+		"response_types_supported":                      []string{"code"},
+		"response_modes_supported":                      []string{"query"},
+		"grant_types_supported":                         []string{grantAuthCode, grantRefresh, grantCredentials},
+		"subject_types_supported":                       []string{"public"},
+		"id_token_signing_alg_values_supported":         []string{s.svcCtx.JwtKeys.Algorithm()},
+		"scopes_supported":                              openIDScopes,
+		"token_endpoint_auth_methods_supported":         []string{"client_secret_basic", "client_secret_post", "none"},
+		"introspection_endpoint_auth_methods_supported": []string{"client_secret_basic", "client_secret_post"},
+		"revocation_endpoint_auth_methods_supported":    []string{"client_secret_basic", "client_secret_post", "none"},
+		"code_challenge_methods_supported":              []string{"S256"},
+		"claims_supported": []string{
+			"iss", "sub", "aud", "exp", "iat", "auth_time", "nonce", "azp",
+			"name", "preferred_username", "picture", "email",
+		},
+	})
+}
@@ -0,0 +1,176 @@
+package oauth2
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/coder-lulu/newbee-common/v2/config"
+	"github.com/coder-lulu/newbee-common/v2/middleware/keys"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/zeromicro/go-zero/core/logx"
+
+	"github.com/coder-lulu/newbee-core/api/internal/session"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+)
+
+// introspectResponse is the response of RFC 7662 2.2
+type introspectResponse struct {
+	Active    bool   `json:"active"`
+	Scope     string `json:"scope,omitempty"`
+	ClientID  string `json:"client_id,omitempty"`
+	Username  string `json:"username,omitempty"`
+	TokenType string `json:"token_type,omitempty"`
+	Exp       int64  `json:"exp,omitempty"`
+	Iat       int64  `json:"iat,omitempty"`
+	Sub       string `json:"sub,omitempty"`
+	Aud       string `json:"aud,omitempty"`
+	Iss       string `json:"iss,omitempty"`
+}
+
+// verifyAccessToken verifies the signature, the expiry, the blacklist and the revocation watermark of the access token
+func (s *Server) verifyAccessToken(ctx context.Context, token string) (jwt.MapClaims, bool) {
+	claims, err := s.svcCtx.JwtKeys.Verify(token)
+	if err != nil {
+		return nil, false
+	}
+
+	return claims, !session.Revoked(ctx, s.svcCtx.Redis, token, claims)
+}
+
+// introspect is the introspection endpoint of RFC 7662. Only the tokens of the tenant of the client are active,
+// so that resource servers of other tenants can not learn anything about them.
+func (s *Server) introspect(w http.ResponseWriter, r *http.Request) {
+	if e := parseForm(r); e != nil {
+		writeError(w, e)
+		return
+	}
+	client, _, e := s.authenticateClient(r)
+	if e != nil {
+		writeError(w, e)
+		return
+	}
+	// 公共客户端无法保管密钥，不允许内省令牌
+	if client.GetClientType() == "public" {
+		writeError(w, &Error{Code: "invalid_client", Description: "public clients can not introspect tokens", status: http.StatusUnauthorized})
+		return
+	}
+
+	token := r.PostForm.Get("token")
+	if token == "" {
+		writeError(w, newError("invalid_request", "token is required"))
+		return
+	}
+
+	resp := s.introspectAccessToken(r, client, token)
+	if !resp.Active {
+		resp = s.introspectRefreshToken(r, client, token)
+	}
+	writeJSON(w, http.StatusOK, resp)
+}
+
+func (s *Server) introspectAccessToken(r *http.Request, client *core.OauthClientInfo, token string) *introspectResponse {
+	claims, ok := s.verifyAccessToken(r.Context(), token)
+	if !ok || claimUint64(claims, keys.JWTTenantID) != client.GetTenantId() {
+		return &introspectResponse{}
+	}
+
+	resp := &introspectResponse{
+		Active:    true,
+		Scope:     claimString(claims, ClaimScope),
+		ClientID:  claimString(claims, ClaimClientID),
+		Username:  claimString(claims, keys.JWTUsername),
+		TokenType: "Bearer",
+		Sub:       claimString(claims, "sub"),
+		Aud:       claimString(claims, "aud"),
+		Iss:       claimString(claims, "iss"),
+	}
+	if resp.Sub == "" {
+		resp.Sub = claimString(claims, keys.JWTUserID)
+	}
+	if exp, err := claims.GetExpirationTime(); err == nil && exp != nil {
+		resp.Exp = exp.Unix()
+	}
+	if iat, err := claims.GetIssuedAt(); err == nil && iat != nil {
+		resp.Iat = iat.Unix()
+	}
+	return resp
+}
+
+func (s *Server) introspectRefreshToken(r *http.Request, client *core.OauthClientInfo, token string) *introspectResponse {
+	g, err := s.refresh.get(r.Context(), token)
+	if err != nil {
+		logx.WithContext(r.Context()).Errorw("failed to load refresh token", logx.Field("detail", err.Error()))
+	}
+	if g == nil || g.TenantID != client.GetTenantId() {
+		return &introspectResponse{}
+	}
+
+	return &introspectResponse{
+		Active:    true,
+		Scope:     strings.Join(g.Scopes, " "),
+		ClientID:  g.ClientID,
+		TokenType: "refresh_token",
+		Sub:       g.UserID,
+		Aud:       g.ClientID,
+		Iat:       g.AuthTime,
+	}
+}
+
+// revoke is the revocation endpoint of RFC 7009. Clients can only revoke their own tokens, and the response
+// is the same whether the token is valid or not.
+func (s *Server) revoke(w http.ResponseWriter, r *http.Request) {
+	if e := parseForm(r); e != nil {
+		writeError(w, e)
+		return
+	}
+	client, _, e := s.authenticateClient(r)
+	if e != nil {
+		writeError(w, e)
+		return
+	}
+
+	token := r.PostForm.Get("token")
+	if token == "" {
+		writeError(w, newError("invalid_request", "token is required"))
+		return
+	}
+
+	if g, err := s.refresh.get(r.Context(), token); err == nil && g != nil {
+		if g.ClientID == client.GetClientId() {
+			if err = s.refresh.revoke(r.Context(), token); err != nil {
+				logx.WithContext(r.Context()).Errorw("failed to revoke refresh token", logx.Field("detail", err.Error()))
+				writeError(w, &Error{Code: "server_error", status: http.StatusInternalServerError})
+				return
+			}
+		}
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	if claims, ok := s.verifyAccessToken(r.Context(), token); ok && claimString(claims, ClaimClientID) == client.GetClientId() {
+		// 与令牌管理相同，以原始令牌为键拉黑到过期为止
+		ttl := time.Minute
+		if exp, err := claims.GetExpirationTime(); err == nil && exp != nil {
+			ttl = time.Until(exp.Time)
+		}
+		if err := s.svcCtx.Redis.Set(r.Context(), config.RedisTokenPrefix+token, "1", ttl).Err(); err != nil {
+			logx.WithContext(r.Context()).Errorw("failed to revoke access token", logx.Field("detail", err.Error()))
+			writeError(w, &Error{Code: "server_error", status: http.StatusInternalServerError})
+			return
+		}
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func claimString(claims jwt.MapClaims, key string) string {
+	v, _ := claims[key].(string)
+	return v
+}
+
+func claimUint64(claims jwt.MapClaims, key string) uint64 {
+	v, _ := claims[key].(float64)
+	return uint64(v)
+}
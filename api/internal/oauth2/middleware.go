@@ -0,0 +1,60 @@
+package oauth2
+
+import (
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/coder-lulu/newbee-common/v2/middleware/keys"
+	"github.com/zeromicro/go-zero/core/errorx"
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/rest/httpx"
+
+	"github.com/coder-lulu/newbee-core/api/internal/svc"
+)
+
+// NewScopeMiddleware limits the tokens issued to the OAuth clients to the APIs granted by their scopes.
+// The scopes are Casbin subjects, a request passes when any scope of the token allows it. The role permission
+// of the user is still checked by the permission middleware, so a client never gets more than the user has.
+// It reads the original token, so it must be registered before the jwks middleware.
+func NewScopeMiddleware(svcCtx *svc.ServiceContext) func(next http.HandlerFunc) http.HandlerFunc {
+	return func(next http.HandlerFunc) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			token, found := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+			if !found || token == "" {
+				next(w, r)
+				return
+			}
+
+			// 无效令牌交给认证中间件拒绝，普通登录令牌没有 cid 不受作用域限制
+			claims, err := svcCtx.JwtKeys.Verify(token)
+			if err != nil || claimString(claims, ClaimClientID) == "" {
+				next(w, r)
+				return
+			}
+
+			tenantID := strconv.FormatUint(claimUint64(claims, keys.JWTTenantID), 10)
+			for _, scope := range strings.Fields(claimString(claims, ClaimScope)) {
+				if slices.Contains(openIDScopes, scope) {
+					continue
+				}
+				allowed, err := svcCtx.Casbin.Enforce(ScopeSubjectPrefix+scope, tenantID, r.URL.Path, r.Method)
+				if err != nil {
+					logx.WithContext(r.Context()).Errorw("failed to check OAuth scope", logx.Field("detail", err.Error()))
+					continue
+				}
+				if allowed {
+					next(w, r)
+					return
+				}
+			}
+
+			logx.WithContext(r.Context()).Infow("OAuth scope denied",
+				logx.Field("clientId", claimString(claims, ClaimClientID)),
+				logx.Field("method", r.Method), logx.Field("path", r.URL.Path))
+			httpx.ErrorCtx(r.Context(), w, errorx.NewCodeError(http.StatusForbidden,
+				svcCtx.Trans.Trans(r.Context(), "oauth2.insufficientScope")))
+		}
+	}
+}
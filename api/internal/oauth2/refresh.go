@@ -0,0 +1,89 @@
+package oauth2
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// refreshKeyPrefix 刷新令牌只保存摘要，泄露 Redis 数据也无法直接使用
+const refreshKeyPrefix = "oauth2:refresh:"
+
+// grant is the authorization bound to a refresh token
+type grant struct {
+	UserID   string   `json:"uid"`
+	TenantID uint64   `json:"tid"`
+	ClientID string   `json:"cid"`
+	Scopes   []string `json:"scope"`
+	AuthTime int64    `json:"auth_time"`
+}
+
+// refreshStore keeps the opaque refresh tokens in Redis, a refresh token can only be used once
+type refreshStore struct {
+	redis redis.UniversalClient
+}
+
+func newRefreshStore(rds redis.UniversalClient) *refreshStore {
+	return &refreshStore{redis: rds}
+}
+
+func refreshKey(token string) string {
+	digest := sha256.Sum256([]byte(token))
+	return refreshKeyPrefix + hex.EncodeToString(digest[:])
+}
+
+// issue creates a refresh token for the grant
+func (s *refreshStore) issue(ctx context.Context, g *grant, ttl time.Duration) (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	token := base64.RawURLEncoding.EncodeToString(b)
+
+	data, err := json.Marshal(g)
+	if err != nil {
+		return "", err
+	}
+	if err = s.redis.Set(ctx, refreshKey(token), data, ttl).Err(); err != nil {
+		return "", err
+	}
+
+	return token, nil
+}
+
+// get returns the grant of the refresh token, or nil when the token does not exist
+func (s *refreshStore) get(ctx context.Context, token string) (*grant, error) {
+	return s.load(s.redis.Get(ctx, refreshKey(token)))
+}
+
+// consume returns and deletes the grant of the refresh token atomically, the token is rotated on use
+func (s *refreshStore) consume(ctx context.Context, token string) (*grant, error) {
+	return s.load(s.redis.GetDel(ctx, refreshKey(token)))
+}
+
+func (s *refreshStore) revoke(ctx context.Context, token string) error {
+	return s.redis.Del(ctx, refreshKey(token)).Err()
+}
+
+func (s *refreshStore) load(cmd *redis.StringCmd) (*grant, error) {
+	data, err := cmd.Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	g := &grant{}
+	if err = json.Unmarshal(data, g); err != nil {
+		return nil, err
+	}
+	return g, nil
+}
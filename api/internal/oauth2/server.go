@@ -0,0 +1,253 @@
+package oauth2
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/coder-lulu/newbee-common/v2/orm/ent/hooks"
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/rest/handler"
+	"github.com/zeromicro/go-zero/rest/httpx"
+	"github.com/zeromicro/go-zero/rest/router"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/coder-lulu/newbee-core/api/internal/svc"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+)
+
+const (
+	PathAuthorize    = "/oauth2/authorize"
+	PathToken        = "/oauth2/token"
+	PathIntrospect   = "/oauth2/introspect"
+	PathRevoke       = "/oauth2/revoke"
+	PathUserInfo     = "/oauth2/userinfo"
+	PathDiscovery    = "/.well-known/openid-configuration"
+	pathJWKS         = "/.well-known/jwks.json"
+	maxBodyBytes     = 1 << 16
+	grantAuthCode    = "authorization_code"
+	grantRefresh     = "refresh_token"
+	grantCredentials = "client_credentials"
+)
+
+// openIDScopes are the standard scopes of OpenID Connect, the others are mapped to Casbin rules
+var openIDScopes = []string{"openid", "profile", "email", "offline_access"}
+
+// NewRouter routes the OAuth 2.0 protocol requests to the OAuth handler and the others to the go-zero router.
+// The clients authenticate with their own credentials at these endpoints instead of user JWTs, so the requests
+// must not reach the unified middleware chain. The consent endpoint used by the logged in user is a normal route.
+func NewRouter(next httpx.Router, oauth http.Handler) httpx.Router {
+	return &oauthRouter{Router: next, oauth: oauth}
+}
+
+type oauthRouter struct {
+	httpx.Router
+	oauth http.Handler
+}
+
+func (r *oauthRouter) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	switch req.URL.Path {
+	case PathAuthorize, PathToken, PathIntrospect, PathRevoke, PathUserInfo, PathDiscovery:
+		r.oauth.ServeHTTP(w, req)
+	default:
+		r.Router.ServeHTTP(w, req)
+	}
+}
+
+// Server serves the OAuth 2.0 / OpenID Connect endpoints with the core RPC
+type Server struct {
+	svcCtx  *svc.ServiceContext
+	router  httpx.Router
+	refresh *refreshStore
+}
+
+// NewHandler creates the handler of the OAuth 2.0 endpoints
+func NewHandler(svcCtx *svc.ServiceContext) http.Handler {
+	s := &Server{
+		svcCtx:  svcCtx,
+		router:  router.NewRouter(),
+		refresh: newRefreshStore(svcCtx.Redis),
+	}
+
+	routes := []struct {
+		method  string
+		path    string
+		handler http.HandlerFunc
+	}{
+		{http.MethodGet, PathAuthorize, s.authorize},
+		{http.MethodPost, PathToken, s.token},
+		{http.MethodPost, PathIntrospect, s.introspect},
+		{http.MethodPost, PathRevoke, s.revoke},
+		{http.MethodGet, PathUserInfo, s.userInfo},
+		{http.MethodPost, PathUserInfo, s.userInfo},
+		{http.MethodGet, PathDiscovery, s.discovery},
+	}
+	for _, r := range routes {
+		if err := s.router.Handle(r.method, r.path, http.MaxBytesHandler(r.handler, maxBodyBytes)); err != nil {
+			logx.Must(err)
+		}
+	}
+	s.router.SetNotAllowedHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeError(w, &Error{Code: "invalid_request", Description: "method not allowed", status: http.StatusMethodNotAllowed})
+	}))
+
+	return handler.RecoverHandler(s.router)
+}
+
+// Error is the error response of RFC 6749 5.2
+type Error struct {
+	Code        string `json:"error"`
+	Description string `json:"error_description,omitempty"`
+	status      int
+}
+
+func (e *Error) Error() string {
+	return e.Code + ": " + e.Description
+}
+
+func newError(code, description string) *Error {
+	return &Error{Code: code, Description: description, status: http.StatusBadRequest}
+}
+
+// rpcErrors maps the i18n keys returned by the core RPC to the OAuth error codes
+var rpcErrors = map[string]string{
+	"oauth2.invalidClient":      "invalid_client",
+	"oauth2.invalidGrant":       "invalid_grant",
+	"oauth2.unauthorizedClient": "unauthorized_client",
+	"oauth2.invalidScope":       "invalid_scope",
+	"oauth2.invalidRequest":     "invalid_request",
+	"oauth2.invalidRedirectUri": "invalid_request",
+	"oauth2.accessDenied":       "access_denied",
+}
+
+// fromRPC converts the error of the core RPC to the OAuth error
+func (s *Server) fromRPC(r *http.Request, err error) *Error {
+	st, ok := status.FromError(err)
+	if !ok {
+		logx.WithContext(r.Context()).Errorw("OAuth request failed", logx.Field("detail", err.Error()))
+		return &Error{Code: "server_error", status: http.StatusInternalServerError}
+	}
+
+	description := s.svcCtx.Trans.Trans(r.Context(), st.Message())
+	if code, ok := rpcErrors[st.Message()]; ok {
+		e := newError(code, description)
+		if code == "invalid_client" {
+			e.status = http.StatusUnauthorized
+		}
+		return e
+	}
+	if st.Code() == codes.Unauthenticated {
+		return &Error{Code: "invalid_client", Description: description, status: http.StatusUnauthorized}
+	}
+
+	logx.WithContext(r.Context()).Errorw("OAuth request failed", logx.Field("detail", err.Error()))
+	return &Error{Code: "server_error", Description: description, status: http.StatusInternalServerError}
+}
+
+func writeError(w http.ResponseWriter, e *Error) {
+	if e.status == http.StatusUnauthorized && e.Code == "invalid_client" {
+		w.Header().Set("WWW-Authenticate", `Basic realm="oauth2"`)
+	}
+	writeJSON(w, e.status, e)
+}
+
+// writeJSON writes the response, the responses of the token endpoints must not be cached
+func writeJSON(w http.ResponseWriter, code int, v any) {
+	body, err := json.Marshal(v)
+	if err != nil {
+		logx.Errorw("failed to marshal OAuth response", logx.Field("detail", err.Error()))
+		code, body = http.StatusInternalServerError, []byte(`{"error":"server_error"}`)
+	}
+
+	w.Header().Set("Content-Type", "application/json;charset=UTF-8")
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("Pragma", "no-cache")
+	w.WriteHeader(code)
+	if _, err = w.Write(body); err != nil {
+		logx.Errorw("failed to write OAuth response", logx.Field("detail", err.Error()))
+	}
+}
+
+// RedirectURL appends the parameters and the state to the redirect URI of the client
+func RedirectURL(redirectURI string, params url.Values, state string) string {
+	if state != "" {
+		params.Set("state", state)
+	}
+	sep := "?"
+	if strings.Contains(redirectURI, "?") {
+		sep = "&"
+	}
+	return redirectURI + sep + params.Encode()
+}
+
+// issuer returns the configured issuer, or the address of the request
+func (s *Server) issuer(r *http.Request) string {
+	if s.svcCtx.Config.OAuth2Conf.Issuer != "" {
+		return strings.TrimSuffix(s.svcCtx.Config.OAuth2Conf.Issuer, "/")
+	}
+
+	scheme := "http"
+	if r.TLS != nil {
+		scheme = "https"
+	}
+	if v := r.Header.Get("X-Forwarded-Proto"); v != "" {
+		scheme, _, _ = strings.Cut(v, ",")
+	}
+	host := r.Host
+	if v := r.Header.Get("X-Forwarded-Host"); v != "" {
+		host, _, _ = strings.Cut(v, ",")
+	}
+	return scheme + "://" + strings.TrimSpace(host)
+}
+
+// authenticateClient authenticates the client with HTTP Basic or the form parameters, RFC 6749 2.3.1
+func (s *Server) authenticateClient(r *http.Request) (*core.OauthClientInfo, string, *Error) {
+	clientID, secret, basic := r.BasicAuth()
+	if basic {
+		// Basic 认证中的客户端ID和密钥需要先经过 form 编码
+		var err1, err2 error
+		clientID, err1 = url.QueryUnescape(clientID)
+		secret, err2 = url.QueryUnescape(secret)
+		if err1 != nil || err2 != nil || r.PostForm.Has("client_secret") {
+			return nil, "", newError("invalid_request", "invalid client authentication")
+		}
+	} else {
+		clientID, secret = r.PostForm.Get("client_id"), r.PostForm.Get("client_secret")
+	}
+	if clientID == "" {
+		return nil, "", &Error{Code: "invalid_client", Description: "client authentication required", status: http.StatusUnauthorized}
+	}
+
+	req := &core.OauthClientAuthReq{ClientId: clientID}
+	if secret != "" {
+		req.ClientSecret = &secret
+	}
+	client, err := s.svcCtx.CoreRpc.AuthenticateOauthClient(hooks.NewSystemContext(r.Context()), req)
+	if err != nil {
+		return nil, "", s.fromRPC(r, err)
+	}
+
+	return client, secret, nil
+}
+
+// parseForm parses the form of the POST endpoints, the parameters must not be repeated
+func parseForm(r *http.Request) *Error {
+	if err := r.ParseForm(); err != nil {
+		return newError("invalid_request", "invalid form")
+	}
+	for k, v := range r.Form {
+		if len(v) > 1 {
+			return newError("invalid_request", "repeated parameter "+k)
+		}
+	}
+	return nil
+}
+
+// tenantContext returns the context of the tenant for the RPC calls
+func (s *Server) tenantContext(r *http.Request, tenantID uint64) *http.Request {
+	ctx := s.svcCtx.ContextManager.SetTenantID(r.Context(), strconv.FormatUint(tenantID, 10))
+	return r.WithContext(hooks.SetTenantIDToContext(ctx, tenantID))
+}
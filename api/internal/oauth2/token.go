@@ -0,0 +1,297 @@
+package oauth2
+
+import (
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/coder-lulu/newbee-common/v2/enum/common"
+	"github.com/coder-lulu/newbee-common/v2/middleware/keys"
+	"github.com/coder-lulu/newbee-common/v2/orm/ent/hooks"
+	"github.com/coder-lulu/newbee-common/v2/utils/jwt"
+	"github.com/coder-lulu/newbee-common/v2/utils/pointy"
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/rest/httpx"
+
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+)
+
+// ScopeSubjectPrefix 作用域在 Casbin 中的主体前缀，与 RPC 写入的规则一致
+const ScopeSubjectPrefix = "oauth_scope:"
+
+// JWT claims of the tokens issued to the OAuth clients
+const (
+	ClaimScope    = "scope"
+	ClaimClientID = "cid"
+)
+
+// tokenResponse is the successful response of RFC 6749 5.1
+type tokenResponse struct {
+	AccessToken  string `json:"access_token"`
+	TokenType    string `json:"token_type"`
+	ExpiresIn    int64  `json:"expires_in"`
+	RefreshToken string `json:"refresh_token,omitempty"`
+	IDToken      string `json:"id_token,omitempty"`
+	Scope        string `json:"scope,omitempty"`
+}
+
+// token is the token endpoint of RFC 6749 3.2
+func (s *Server) token(w http.ResponseWriter, r *http.Request) {
+	if e := parseForm(r); e != nil {
+		writeError(w, e)
+		return
+	}
+
+	client, secret, e := s.authenticateClient(r)
+	if e != nil {
+		writeError(w, e)
+		return
+	}
+
+	grantType := r.PostForm.Get("grant_type")
+	if grantType != grantAuthCode && grantType != grantRefresh && grantType != grantCredentials {
+		writeError(w, newError("unsupported_grant_type", "unsupported grant_type"))
+		return
+	}
+	if !slices.Contains(client.GrantTypes, grantType) {
+		writeError(w, newError("unauthorized_client", "the client is not allowed to use "+grantType))
+		return
+	}
+
+	var resp *tokenResponse
+	switch grantType {
+	case grantAuthCode:
+		resp, e = s.exchangeCode(r, client, secret)
+	case grantRefresh:
+		resp, e = s.refreshToken(r, client, secret)
+	default:
+		resp, e = s.clientCredentials(r, client)
+	}
+	if e != nil {
+		writeError(w, e)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// exchangeCode exchanges the authorization code, RFC 6749 4.1.3 and RFC 7636 4.5
+func (s *Server) exchangeCode(r *http.Request, client *core.OauthClientInfo, secret string) (*tokenResponse, *Error) {
+	code, redirectURI := r.PostForm.Get("code"), r.PostForm.Get("redirect_uri")
+	if code == "" || redirectURI == "" {
+		return nil, newError("invalid_request", "code and redirect_uri are required")
+	}
+
+	req := &core.OauthCodeExchangeReq{Code: code, ClientId: client.GetClientId(), RedirectUri: redirectURI}
+	if verifier := r.PostForm.Get("code_verifier"); verifier != "" {
+		req.CodeVerifier = &verifier
+	}
+	result, err := s.svcCtx.CoreRpc.ExchangeOauthAuthorizationCode(hooks.NewSystemContext(r.Context()), req)
+	if err != nil {
+		return nil, s.fromRPC(r, err)
+	}
+	if result.TenantId != client.GetTenantId() {
+		return nil, newError("invalid_grant", "invalid authorization code")
+	}
+
+	return s.issueUserTokens(r, client, secret, &grant{
+		UserID:   result.UserId,
+		TenantID: result.TenantId,
+		ClientID: result.ClientId,
+		Scopes:   result.Scopes,
+		AuthTime: result.AuthTime,
+	}, result.Nonce)
+}
+
+// refreshToken rotates the refresh token, RFC 6749 6. The scopes can be narrowed but not extended.
+func (s *Server) refreshToken(r *http.Request, client *core.OauthClientInfo, secret string) (*tokenResponse, *Error) {
+	token := r.PostForm.Get("refresh_token")
+	if token == "" {
+		return nil, newError("invalid_request", "refresh_token is required")
+	}
+
+	g, err := s.refresh.consume(r.Context(), token)
+	if err != nil {
+		logx.WithContext(r.Context()).Errorw("failed to load refresh token", logx.Field("detail", err.Error()))
+		return nil, &Error{Code: "server_error", status: http.StatusInternalServerError}
+	}
+	if g == nil || g.ClientID != client.GetClientId() || g.TenantID != client.GetTenantId() {
+		return nil, newError("invalid_grant", "invalid refresh token")
+	}
+
+	if scope := strings.Fields(r.PostForm.Get("scope")); len(scope) > 0 {
+		for _, v := range scope {
+			if !slices.Contains(g.Scopes, v) {
+				return nil, newError("invalid_scope", "the scope exceeds the original grant")
+			}
+		}
+		g.Scopes = scope
+	}
+
+	return s.issueUserTokens(r, client, secret, g, "")
+}
+
+// issueUserTokens issues the access token, the refresh token and the ID token for the user of the grant.
+// The user is loaded again so that disabled users and changed roles take effect.
+func (s *Server) issueUserTokens(r *http.Request, client *core.OauthClientInfo, secret string, g *grant, nonce string) (*tokenResponse, *Error) {
+	r = s.tenantContext(r, g.TenantID)
+	ctx := r.Context()
+
+	user, err := s.svcCtx.CoreRpc.GetUserById(ctx, &core.UUIDReq{Id: g.UserID})
+	if err != nil || user.GetStatus() != uint32(common.StatusNormal) {
+		if err != nil {
+			logx.WithContext(ctx).Infow("failed to load OAuth user", logx.Field("userId", g.UserID), logx.Field("detail", err.Error()))
+		}
+		return nil, newError("invalid_grant", "the user is not available")
+	}
+
+	issuer := s.issuer(r)
+	now := time.Now()
+	expire := s.svcCtx.Config.OAuth2Conf.AccessTokenExpire
+	if client.GetAccessTokenTtl() > 0 {
+		expire = int64(client.GetAccessTokenTtl())
+	}
+
+	accessToken, err := s.svcCtx.JwtKeys.NewJwtToken(now.Unix(), expire,
+		jwt.WithOption(keys.JWTUserID, user.GetId()),
+		jwt.WithOption(keys.JWTTenantID, user.GetTenantId()),
+		jwt.WithOption(keys.JWTUsername, user.GetUsername()),
+		jwt.WithOption(keys.JWTDeptID, user.GetDepartmentId()),
+		jwt.WithOption(keys.JWTRoleCodes, strings.Join(user.RoleCodes, ",")),
+		jwt.WithOption(keys.JWTNickname, user.GetNickname()),
+		jwt.WithOption(keys.JWTAvatar, user.GetAvatar()),
+		jwt.WithOption(ClaimScope, strings.Join(g.Scopes, " ")),
+		jwt.WithOption(ClaimClientID, g.ClientID),
+		jwt.WithOption("sub", user.GetId()),
+		jwt.WithOption("aud", g.ClientID),
+		jwt.WithOption("iss", issuer))
+	if err != nil {
+		logx.WithContext(ctx).Errorw("failed to sign OAuth access token", logx.Field("detail", err.Error()))
+		return nil, &Error{Code: "server_error", status: http.StatusInternalServerError}
+	}
+
+	// 记录令牌，便于在令牌管理中查看和拉黑
+	_, err = s.svcCtx.CoreRpc.CreateToken(ctx, &core.TokenInfo{
+		Uuid:      user.Id,
+		Token:     pointy.GetPointer(accessToken),
+		Source:    pointy.GetPointer("oauth2:" + g.ClientID),
+		Status:    pointy.GetPointer(uint32(common.StatusNormal)),
+		ExpiredAt: pointy.GetPointer(now.Add(time.Duration(expire) * time.Second).UnixMilli()),
+		TenantId:  user.TenantId,
+		UserAgent: pointy.GetPointer(r.UserAgent()),
+		Ip:        pointy.GetPointer(httpx.GetRemoteAddr(r)),
+	})
+	if err != nil {
+		return nil, s.fromRPC(r, err)
+	}
+
+	resp := &tokenResponse{
+		AccessToken: accessToken,
+		TokenType:   "Bearer",
+		ExpiresIn:   expire,
+		Scope:       strings.Join(g.Scopes, " "),
+	}
+
+	if slices.Contains(client.GrantTypes, grantRefresh) {
+		refreshExpire := s.svcCtx.Config.OAuth2Conf.RefreshTokenExpire
+		if client.GetRefreshTokenTtl() > 0 {
+			refreshExpire = int64(client.GetRefreshTokenTtl())
+		}
+		if resp.RefreshToken, err = s.refresh.issue(ctx, g, time.Duration(refreshExpire)*time.Second); err != nil {
+			logx.WithContext(ctx).Errorw("failed to store refresh token", logx.Field("detail", err.Error()))
+			return nil, &Error{Code: "server_error", status: http.StatusInternalServerError}
+		}
+	}
+
+	if slices.Contains(g.Scopes, "openid") {
+		if resp.IDToken, err = s.idToken(issuer, now, expire, user, g, nonce, secret); err != nil {
+			logx.WithContext(ctx).Errorw("failed to sign ID token", logx.Field("detail", err.Error()))
+			return nil, &Error{Code: "server_error", status: http.StatusInternalServerError}
+		}
+	}
+
+	return resp, nil
+}
+
+// idToken signs the ID token of OpenID Connect Core 1.0 section 2. With asymmetric keys it is signed like
+// the access tokens and verified with the JWKS. In HS256 mode the shared secret must not leave core, so it is
+// signed with the client secret as section 10.1 specifies, and public clients do not get an ID token.
+func (s *Server) idToken(issuer string, now time.Time, expire int64, user *core.UserInfo, g *grant, nonce, secret string) (string, error) {
+	opts := []jwt.Option{
+		jwt.WithOption("iss", issuer),
+		jwt.WithOption("sub", user.GetId()),
+		jwt.WithOption("aud", g.ClientID),
+		jwt.WithOption("azp", g.ClientID),
+		jwt.WithOption("auth_time", g.AuthTime),
+	}
+	if nonce != "" {
+		opts = append(opts, jwt.WithOption("nonce", nonce))
+	}
+	if slices.Contains(g.Scopes, "profile") {
+		opts = append(opts,
+			jwt.WithOption("name", user.GetNickname()),
+			jwt.WithOption("preferred_username", user.GetUsername()),
+			jwt.WithOption("picture", user.GetAvatar()))
+	}
+	if slices.Contains(g.Scopes, "email") && user.GetEmail() != "" {
+		opts = append(opts, jwt.WithOption("email", user.GetEmail()))
+	}
+
+	if s.svcCtx.JwtKeys.Asymmetric() {
+		return s.svcCtx.JwtKeys.NewJwtToken(now.Unix(), expire, opts...)
+	}
+	if secret == "" {
+		return "", nil
+	}
+	return jwt.NewJwtToken(secret, now.Unix(), expire, opts...)
+}
+
+// clientCredentials issues an access token to the client itself, RFC 6749 4.4. The token has no user,
+// its role codes are the Casbin subjects of the scopes, so the permission middleware checks the scopes.
+func (s *Server) clientCredentials(r *http.Request, client *core.OauthClientInfo) (*tokenResponse, *Error) {
+	var scopes []string
+	if requested := strings.Fields(r.PostForm.Get("scope")); len(requested) > 0 {
+		for _, v := range requested {
+			if !slices.Contains(client.Scopes, v) || slices.Contains(openIDScopes, v) {
+				return nil, newError("invalid_scope", "invalid scope "+v)
+			}
+		}
+		scopes = requested
+	} else {
+		for _, v := range client.Scopes {
+			if !slices.Contains(openIDScopes, v) {
+				scopes = append(scopes, v)
+			}
+		}
+	}
+
+	subjects := make([]string, 0, len(scopes))
+	for _, v := range scopes {
+		subjects = append(subjects, ScopeSubjectPrefix+v)
+	}
+
+	expire := s.svcCtx.Config.OAuth2Conf.AccessTokenExpire
+	if client.GetAccessTokenTtl() > 0 {
+		expire = int64(client.GetAccessTokenTtl())
+	}
+	token, err := s.svcCtx.JwtKeys.NewJwtToken(time.Now().Unix(), expire,
+		jwt.WithOption(keys.JWTTenantID, client.GetTenantId()),
+		jwt.WithOption(keys.JWTRoleCodes, strings.Join(subjects, ",")),
+		jwt.WithOption(ClaimScope, strings.Join(scopes, " ")),
+		jwt.WithOption(ClaimClientID, client.GetClientId()),
+		jwt.WithOption("sub", client.GetClientId()),
+		jwt.WithOption("aud", client.GetClientId()),
+		jwt.WithOption("iss", s.issuer(r)))
+	if err != nil {
+		logx.WithContext(r.Context()).Errorw("failed to sign OAuth access token", logx.Field("detail", err.Error()))
+		return nil, &Error{Code: "server_error", status: http.StatusInternalServerError}
+	}
+
+	return &tokenResponse{
+		AccessToken: token,
+		TokenType:   "Bearer",
+		ExpiresIn:   expire,
+		Scope:       strings.Join(scopes, " "),
+	}, nil
+}
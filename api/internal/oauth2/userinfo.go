@@ -0,0 +1,55 @@
+package oauth2
+
+import (
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/coder-lulu/newbee-common/v2/middleware/keys"
+	"github.com/zeromicro/go-zero/core/logx"
+
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+)
+
+// userInfo is the UserInfo endpoint of OpenID Connect Core 1.0 section 5.3, the claims depend on the scopes of the token
+func (s *Server) userInfo(w http.ResponseWriter, r *http.Request) {
+	token, found := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !found || token == "" {
+		w.Header().Set("WWW-Authenticate", `Bearer realm="oauth2"`)
+		writeError(w, &Error{Code: "invalid_request", Description: "bearer token required", status: http.StatusUnauthorized})
+		return
+	}
+
+	claims, ok := s.verifyAccessToken(r.Context(), token)
+	if !ok || claimString(claims, ClaimClientID) == "" || claimString(claims, keys.JWTUserID) == "" {
+		w.Header().Set("WWW-Authenticate", `Bearer realm="oauth2", error="invalid_token"`)
+		writeError(w, &Error{Code: "invalid_token", status: http.StatusUnauthorized})
+		return
+	}
+	scopes := strings.Fields(claimString(claims, ClaimScope))
+	if !slices.Contains(scopes, "openid") {
+		w.Header().Set("WWW-Authenticate", `Bearer realm="oauth2", error="insufficient_scope", scope="openid"`)
+		writeError(w, &Error{Code: "insufficient_scope", status: http.StatusForbidden})
+		return
+	}
+
+	r = s.tenantContext(r, claimUint64(claims, keys.JWTTenantID))
+	user, err := s.svcCtx.CoreRpc.GetUserById(r.Context(), &core.UUIDReq{Id: claimString(claims, keys.JWTUserID)})
+	if err != nil {
+		logx.WithContext(r.Context()).Infow("failed to load OAuth user", logx.Field("detail", err.Error()))
+		w.Header().Set("WWW-Authenticate", `Bearer realm="oauth2", error="invalid_token"`)
+		writeError(w, &Error{Code: "invalid_token", status: http.StatusUnauthorized})
+		return
+	}
+
+	resp := map[string]any{"sub": user.GetId()}
+	if slices.Contains(scopes, "profile") {
+		resp["name"] = user.GetNickname()
+		resp["preferred_username"] = user.GetUsername()
+		resp["picture"] = user.GetAvatar()
+	}
+	if slices.Contains(scopes, "email") && user.GetEmail() != "" {
+		resp["email"] = user.GetEmail()
+	}
+	writeJSON(w, http.StatusOK, resp)
+}
@@ -0,0 +1,183 @@
+package scim
+
+import (
+	"net/http"
+	"strings"
+)
+
+// attribute is the definition of an attribute in the Schemas endpoint
+type attribute struct {
+	Name          string      `json:"name"`
+	Type          string      `json:"type"`
+	MultiValued   bool        `json:"multiValued"`
+	Required      bool        `json:"required"`
+	CaseExact     bool        `json:"caseExact"`
+	Mutability    string      `json:"mutability"`
+	Returned      string      `json:"returned"`
+	Uniqueness    string      `json:"uniqueness"`
+	SubAttributes []attribute `json:"subAttributes,omitempty"`
+}
+
+func schemaAttribute(name, typ, mutability string, sub ...attribute) attribute {
+	return attribute{
+		Name:          name,
+		Type:          typ,
+		Mutability:    mutability,
+		Returned:      "default",
+		Uniqueness:    "none",
+		SubAttributes: sub,
+	}
+}
+
+func multiValued(a attribute) attribute {
+	a.MultiValued = true
+	return a
+}
+
+// multiValueAttributes are the sub-attributes of emails and phoneNumbers
+var multiValueAttributes = []attribute{
+	schemaAttribute("value", "string", "readWrite"),
+	schemaAttribute("type", "string", "readWrite"),
+	schemaAttribute("primary", "boolean", "readWrite"),
+}
+
+func schemaDefinitions(base string) []map[string]any {
+	userName := schemaAttribute("userName", "string", "readWrite")
+	userName.Required, userName.Uniqueness = true, "server"
+	password := schemaAttribute("password", "string", "writeOnly")
+	password.Returned = "never"
+	displayName := schemaAttribute("displayName", "string", "readWrite")
+	displayName.Required = true
+
+	definitions := []struct {
+		id, name, description string
+		attributes            []attribute
+	}{
+		{SchemaUser, "User", "User Account", []attribute{
+			userName,
+			schemaAttribute("name", "complex", "readWrite",
+				schemaAttribute("formatted", "string", "readWrite"),
+				schemaAttribute("familyName", "string", "readWrite"),
+				schemaAttribute("givenName", "string", "readWrite"),
+			),
+			schemaAttribute("displayName", "string", "readWrite"),
+			schemaAttribute("active", "boolean", "readWrite"),
+			password,
+			multiValued(schemaAttribute("emails", "complex", "readWrite", multiValueAttributes...)),
+			multiValued(schemaAttribute("phoneNumbers", "complex", "readWrite", multiValueAttributes...)),
+			multiValued(schemaAttribute("groups", "complex", "readOnly",
+				schemaAttribute("value", "string", "readOnly"),
+				schemaAttribute("display", "string", "readOnly"),
+				schemaAttribute("$ref", "reference", "readOnly"),
+			)),
+		}},
+		{SchemaGroup, "Group", "Group", []attribute{
+			displayName,
+			multiValued(schemaAttribute("members", "complex", "readWrite",
+				schemaAttribute("value", "string", "immutable"),
+				schemaAttribute("display", "string", "readOnly"),
+				schemaAttribute("$ref", "reference", "immutable"),
+			)),
+		}},
+		{SchemaEnterpriseUser, "EnterpriseUser", "Enterprise User", []attribute{
+			schemaAttribute("department", "string", "readWrite"),
+		}},
+	}
+
+	result := make([]map[string]any, 0, len(definitions))
+	for _, d := range definitions {
+		result = append(result, map[string]any{
+			"schemas":     []string{SchemaSchema},
+			"id":          d.id,
+			"name":        d.name,
+			"description": d.description,
+			"attributes":  d.attributes,
+			"meta": &Meta{
+				ResourceType: "Schema",
+				Location:     base + "/Schemas/" + d.id,
+			},
+		})
+	}
+	return result
+}
+
+func resourceTypeDefinitions(base string) []map[string]any {
+	return []map[string]any{
+		{
+			"schemas":     []string{SchemaResourceType},
+			"id":          "User",
+			"name":        "User",
+			"endpoint":    "/Users",
+			"description": "User Account",
+			"schema":      SchemaUser,
+			"schemaExtensions": []map[string]any{
+				{"schema": SchemaEnterpriseUser, "required": false},
+			},
+			"meta": &Meta{ResourceType: "ResourceType", Location: base + "/ResourceTypes/User"},
+		},
+		{
+			"schemas":     []string{SchemaResourceType},
+			"id":          "Group",
+			"name":        "Group",
+			"endpoint":    "/Groups",
+			"description": "Group",
+			"schema":      SchemaGroup,
+			"meta":        &Meta{ResourceType: "ResourceType", Location: base + "/ResourceTypes/Group"},
+		},
+	}
+}
+
+func (s *Server) serviceProviderConfig(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]any{
+		"schemas":        []string{SchemaServiceProviderConfig},
+		"patch":          map[string]any{"supported": true},
+		"bulk":           map[string]any{"supported": false, "maxOperations": 0, "maxPayloadSize": 0},
+		"filter":         map[string]any{"supported": true, "maxResults": maxCount},
+		"changePassword": map[string]any{"supported": true},
+		"sort":           map[string]any{"supported": false},
+		"etag":           map[string]any{"supported": true},
+		"authenticationSchemes": []map[string]any{
+			{
+				"type":        "oauthbearertoken",
+				"name":        "OAuth Bearer Token",
+				"description": "Authentication with the SCIM token of the tenant",
+				"primary":     true,
+			},
+		},
+		"meta": &Meta{ResourceType: "ServiceProviderConfig", Location: baseURL(r) + "/ServiceProviderConfig"},
+	})
+}
+
+func (s *Server) resourceTypes(w http.ResponseWriter, r *http.Request) {
+	s.writeDefinitions(w, resourceTypeDefinitions(baseURL(r)))
+}
+
+func (s *Server) resourceType(w http.ResponseWriter, r *http.Request) {
+	s.writeDefinition(w, resourceTypeDefinitions(baseURL(r)), pathID(r), "resource type not found")
+}
+
+func (s *Server) schemas(w http.ResponseWriter, r *http.Request) {
+	s.writeDefinitions(w, schemaDefinitions(baseURL(r)))
+}
+
+func (s *Server) schema(w http.ResponseWriter, r *http.Request) {
+	s.writeDefinition(w, schemaDefinitions(baseURL(r)), pathID(r), "schema not found")
+}
+
+func (s *Server) writeDefinitions(w http.ResponseWriter, definitions []map[string]any) {
+	resources := make([]any, 0, len(definitions))
+	for _, v := range definitions {
+		resources = append(resources, v)
+	}
+	writeJSON(w, http.StatusOK, listResponse(resources, uint64(len(resources)), 1))
+}
+
+func (s *Server) writeDefinition(w http.ResponseWriter, definitions []map[string]any, id, notFound string) {
+	for _, v := range definitions {
+		if strings.EqualFold(v["id"].(string), id) {
+			writeJSON(w, http.StatusOK, v)
+			return
+		}
+	}
+	writeError(w, http.StatusNotFound, "", notFound)
+}
@@ -0,0 +1,330 @@
+package scim
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// errInvalidFilter 过滤条件或属性路径不合法
+var errInvalidFilter = errors.New("invalid filter")
+
+// condition is an attribute expression such as userName eq "bjensen", or a value path such as
+// members[value eq "2819c223"] when Sub is set
+type condition struct {
+	Path  string
+	Op    string
+	Value any
+	Sub   filter
+}
+
+// filter only supports attribute expressions joined by "and", which is what identity providers send.
+// "or", "not" and grouping are rejected.
+type filter []condition
+
+// parseFilter parses the filter query parameter and the filters of patch paths
+func parseFilter(s string) (filter, error) {
+	tokens, err := tokenize(s)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &filterParser{tokens: tokens}
+	f, err := p.parse()
+	if err != nil {
+		return nil, err
+	}
+	if p.pos != len(p.tokens) {
+		return nil, fmt.Errorf("%w: unexpected %q", errInvalidFilter, p.tokens[p.pos])
+	}
+
+	return f, nil
+}
+
+type filterParser struct {
+	tokens []string
+	pos    int
+}
+
+func (p *filterParser) next() string {
+	if p.pos >= len(p.tokens) {
+		return ""
+	}
+	t := p.tokens[p.pos]
+	p.pos++
+	return t
+}
+
+func (p *filterParser) peek() string {
+	if p.pos >= len(p.tokens) {
+		return ""
+	}
+	return p.tokens[p.pos]
+}
+
+func (p *filterParser) parse() (filter, error) {
+	var f filter
+	for {
+		c, err := p.condition()
+		if err != nil {
+			return nil, err
+		}
+		f = append(f, c)
+
+		if !strings.EqualFold(p.peek(), "and") {
+			return f, nil
+		}
+		p.next()
+	}
+}
+
+func (p *filterParser) condition() (condition, error) {
+	path := p.next()
+	if path == "" || path == "[" || path == "]" || strings.HasPrefix(path, `"`) {
+		return condition{}, fmt.Errorf("%w: attribute expected", errInvalidFilter)
+	}
+
+	if p.peek() == "[" {
+		p.next()
+		sub, err := p.parse()
+		if err != nil {
+			return condition{}, err
+		}
+		if p.next() != "]" {
+			return condition{}, fmt.Errorf("%w: missing ]", errInvalidFilter)
+		}
+		return condition{Path: path, Sub: sub}, nil
+	}
+
+	op := strings.ToLower(p.next())
+	switch op {
+	case "pr":
+		return condition{Path: path, Op: op}, nil
+	case "eq", "ne", "co", "sw", "ew":
+	default:
+		return condition{}, fmt.Errorf("%w: unsupported operator %q", errInvalidFilter, op)
+	}
+
+	raw := p.next()
+	if raw == "" {
+		return condition{}, fmt.Errorf("%w: value expected", errInvalidFilter)
+	}
+	var value any
+	if err := json.Unmarshal([]byte(raw), &value); err != nil {
+		return condition{}, fmt.Errorf("%w: invalid value %s", errInvalidFilter, raw)
+	}
+
+	return condition{Path: path, Op: op, Value: value}, nil
+}
+
+// tokenize splits the filter into attribute paths, operators, JSON values and brackets
+func tokenize(s string) ([]string, error) {
+	var tokens []string
+	for i := 0; i < len(s); {
+		switch c := s[i]; {
+		case c == ' ' || c == '\t':
+			i++
+		case c == '[' || c == ']':
+			tokens = append(tokens, string(c))
+			i++
+		case c == '(' || c == ')':
+			return nil, fmt.Errorf("%w: grouping is not supported", errInvalidFilter)
+		case c == '"':
+			j := i + 1
+			for ; j < len(s) && s[j] != '"'; j++ {
+				if s[j] == '\\' {
+					j++
+				}
+			}
+			if j >= len(s) {
+				return nil, fmt.Errorf("%w: unterminated string", errInvalidFilter)
+			}
+			tokens = append(tokens, s[i:j+1])
+			i = j + 1
+		default:
+			j := i
+			for j < len(s) && !strings.ContainsRune(" \t[]\"()", rune(s[j])) {
+				j++
+			}
+			tokens = append(tokens, s[i:j])
+			i = j
+		}
+	}
+
+	for _, t := range tokens {
+		if strings.EqualFold(t, "or") || strings.EqualFold(t, "not") {
+			return nil, fmt.Errorf("%w: %q is not supported", errInvalidFilter, t)
+		}
+	}
+
+	return tokens, nil
+}
+
+// equalsValue returns the value compared by eq with the attribute, or false when the filter has
+// no such condition. It is used to pick the query run by the RPC before matching the whole filter.
+func (f filter) equalsValue(path string) (string, bool) {
+	for _, c := range f {
+		if c.Op == "eq" && strings.EqualFold(trimSchema(c.Path), path) {
+			if v, ok := c.Value.(string); ok {
+				return v, true
+			}
+		}
+	}
+	return "", false
+}
+
+// match evaluates the filter against the JSON form of a resource
+func (f filter) match(resource map[string]any) bool {
+	for _, c := range f {
+		if !c.match(resource) {
+			return false
+		}
+	}
+	return true
+}
+
+func (c condition) match(resource map[string]any) bool {
+	if c.Sub != nil {
+		for _, v := range toSlice(lookup(resource, c.Path)) {
+			if m, ok := v.(map[string]any); ok && c.Sub.match(m) {
+				return true
+			}
+		}
+		return false
+	}
+
+	values := attributeValues(resource, c.Path)
+	if c.Op == "pr" {
+		for _, v := range values {
+			if v != nil && v != "" {
+				return true
+			}
+		}
+		return false
+	}
+
+	for _, v := range values {
+		if compare(c.Op, v, c.Value) {
+			return true
+		}
+	}
+	// ne 在属性不存在时也成立
+	return c.Op == "ne" && len(values) == 0
+}
+
+// compare 字符串比较不区分大小写，SCIM 核心属性中只有 id 等少数属性区分大小写
+func compare(op string, actual, expected any) bool {
+	a, aok := actual.(string)
+	e, eok := expected.(string)
+	if !aok || !eok {
+		equal := fmt.Sprint(actual) == fmt.Sprint(expected)
+		if op == "ne" {
+			return !equal
+		}
+		return op == "eq" && equal
+	}
+
+	a, e = strings.ToLower(a), strings.ToLower(e)
+	switch op {
+	case "eq":
+		return a == e
+	case "ne":
+		return a != e
+	case "co":
+		return strings.Contains(a, e)
+	case "sw":
+		return strings.HasPrefix(a, e)
+	case "ew":
+		return strings.HasSuffix(a, e)
+	}
+	return false
+}
+
+// attributeValues returns the values of an attribute path such as emails.value, the values of
+// multi-valued attributes are flattened
+func attributeValues(resource map[string]any, path string) []any {
+	container, attr := splitSchema(path)
+	var root any = resource
+	if container != "" {
+		root = lookup(resource, container)
+	}
+
+	values := []any{root}
+	for _, name := range strings.Split(attr, ".") {
+		var next []any
+		for _, v := range values {
+			for _, item := range toSlice(v) {
+				if m, ok := item.(map[string]any); ok {
+					if found := lookup(m, name); found != nil {
+						next = append(next, found)
+					}
+				}
+			}
+		}
+		values = next
+	}
+
+	var result []any
+	for _, v := range values {
+		result = append(result, toSlice(v)...)
+	}
+	return result
+}
+
+// lookup returns the attribute of the object, attribute names are case-insensitive
+func lookup(m map[string]any, name string) any {
+	if key, ok := findKey(m, name); ok {
+		return m[key]
+	}
+	return nil
+}
+
+func findKey(m map[string]any, name string) (string, bool) {
+	if _, ok := m[name]; ok {
+		return name, true
+	}
+	for k := range m {
+		if strings.EqualFold(k, name) {
+			return k, true
+		}
+	}
+	return "", false
+}
+
+func toSlice(v any) []any {
+	switch v := v.(type) {
+	case nil:
+		return nil
+	case []any:
+		return v
+	default:
+		return []any{v}
+	}
+}
+
+// splitSchema splits the schema URN from the attribute path. Attributes of the core schemas are
+// returned without container, attributes of the enterprise extension are in the extension object.
+func splitSchema(path string) (string, string) {
+	for _, urn := range []string{SchemaUser, SchemaGroup} {
+		if len(path) > len(urn) && strings.EqualFold(path[:len(urn)+1], urn+":") {
+			return "", path[len(urn)+1:]
+		}
+	}
+	if strings.EqualFold(path, SchemaEnterpriseUser) {
+		return SchemaEnterpriseUser, ""
+	}
+	if len(path) > len(SchemaEnterpriseUser) && strings.EqualFold(path[:len(SchemaEnterpriseUser)+1], SchemaEnterpriseUser+":") {
+		return SchemaEnterpriseUser, path[len(SchemaEnterpriseUser)+1:]
+	}
+	return "", path
+}
+
+// trimSchema returns the attribute path without the core schema URN
+func trimSchema(path string) string {
+	container, attr := splitSchema(path)
+	if container != "" {
+		return container + ":" + attr
+	}
+	return attr
+}
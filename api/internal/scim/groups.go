@@ -0,0 +1,491 @@
+package scim
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/coder-lulu/newbee-common/v2/enum/common"
+	"github.com/coder-lulu/newbee-common/v2/i18n"
+	"github.com/coder-lulu/newbee-common/v2/utils/pointy"
+	"github.com/gofrs/uuid/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+)
+
+const (
+	// groupCodePrefix SCIM 创建的角色编码前缀，编码由组名生成
+	groupCodePrefix = "scim_"
+	// memberPageSize 分页读取组成员时每页的数量
+	memberPageSize = 1000
+)
+
+var errInvalidGroup = errors.New("displayName is required")
+
+// groupScope is the roles a SCIM token manages as groups: the roles created through SCIM and the
+// existing roles allowed on the token. Other roles are not visible to SCIM clients.
+type groupScope struct {
+	codes []string
+}
+
+type groupScopeKey struct{}
+
+func withGroupScope(ctx context.Context, codes []string) context.Context {
+	return context.WithValue(ctx, groupScopeKey{}, groupScope{codes: codes})
+}
+
+func groupScopeFrom(ctx context.Context) groupScope {
+	scope, _ := ctx.Value(groupScopeKey{}).(groupScope)
+	return scope
+}
+
+func (g groupScope) allows(code string) bool {
+	return strings.HasPrefix(code, groupCodePrefix) || slices.Contains(g.codes, code)
+}
+
+// roleListReq limits the role query to the roles of the scope
+func (g groupScope) roleListReq(page, pageSize uint64, name *string) *core.RoleListReq {
+	return &core.RoleListReq{
+		Page:         page,
+		PageSize:     pageSize,
+		Name:         name,
+		CodePrefixes: []string{groupCodePrefix},
+		Codes:        g.codes,
+	}
+}
+
+// errGroupNotFound is returned for roles out of the scope, like the roles that do not exist
+var errGroupNotFound = status.Error(codes.NotFound, i18n.TargetNotFound)
+
+func (s *Server) listGroups(w http.ResponseWriter, r *http.Request) {
+	startIndex, count := pageParams(r)
+	base := baseURL(r)
+	withMembers := !excluded(r, "members")
+
+	if r.URL.Query().Get("filter") == "" {
+		roles, total, err := fetchWindow(startIndex, count, func(page, pageSize uint64) ([]*core.RoleInfo, uint64, error) {
+			resp, err := s.svcCtx.CoreRpc.GetRoleList(r.Context(), groupScopeFrom(r.Context()).roleListReq(page, pageSize, nil))
+			if err != nil {
+				return nil, 0, err
+			}
+			return resp.Data, resp.Total, nil
+		})
+		if err != nil {
+			s.rpcError(w, r, err)
+			return
+		}
+
+		resources := make([]any, 0, len(roles))
+		for _, role := range roles {
+			res, err := s.groupResource(r.Context(), base, role, withMembers)
+			if err != nil {
+				s.rpcError(w, r, err)
+				return
+			}
+			resources = append(resources, project(r, res))
+		}
+		writeJSON(w, http.StatusOK, listResponse(resources, total, startIndex))
+		return
+	}
+
+	f, err := parseFilter(r.URL.Query().Get("filter"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalidFilter", err.Error())
+		return
+	}
+	roles, err := s.findGroups(r.Context(), f)
+	if err != nil {
+		if errors.Is(err, errInvalidFilter) {
+			writeError(w, http.StatusBadRequest, "invalidFilter", err.Error())
+			return
+		}
+		s.rpcError(w, r, err)
+		return
+	}
+
+	// 过滤条件可能包含成员，例如 Azure AD 的 members[value eq "..."]，匹配时总是读取成员
+	var matched []any
+	for _, role := range roles {
+		res, err := s.groupResource(r.Context(), base, role, true)
+		if err != nil {
+			s.rpcError(w, r, err)
+			return
+		}
+		if !f.match(toMap(res)) {
+			continue
+		}
+		if !withMembers {
+			res.Members = nil
+		}
+		matched = append(matched, project(r, res))
+	}
+	writeJSON(w, http.StatusOK, listResponse(window(matched, startIndex, count), uint64(len(matched)), startIndex))
+}
+
+// findGroups runs the query of the filter that the RPC supports, see findUsers
+func (s *Server) findGroups(ctx context.Context, f filter) ([]*core.RoleInfo, error) {
+	scope := groupScopeFrom(ctx)
+	if v, ok := f.equalsValue("id"); ok {
+		id, err := strconv.ParseUint(v, 10, 64)
+		if err != nil {
+			return nil, nil
+		}
+		role, err := s.svcCtx.CoreRpc.GetRoleById(ctx, &core.IDReq{Id: id})
+		if err != nil {
+			if isNotFound(err) {
+				return nil, nil
+			}
+			return nil, err
+		}
+		if !scope.allows(role.GetCode()) {
+			return nil, nil
+		}
+		return []*core.RoleInfo{role}, nil
+	}
+
+	if v, ok := f.equalsValue("displayName"); ok {
+		// getRoleList 按名称模糊查询，精确匹配由调用方完成
+		resp, err := s.svcCtx.CoreRpc.GetRoleList(ctx, scope.roleListReq(1, maxCount, &v))
+		if err != nil {
+			return nil, err
+		}
+		return resp.Data, nil
+	}
+
+	return nil, errors.Join(errInvalidFilter, errors.New("groups can only be filtered by id or displayName"))
+}
+
+func (s *Server) getGroup(w http.ResponseWriter, r *http.Request) {
+	res, ok := s.loadGroup(w, r)
+	if !ok {
+		return
+	}
+	if notModified(w, r, res.Meta.Version) {
+		return
+	}
+	writeResource(w, r, http.StatusOK, res, res.Meta)
+}
+
+func (s *Server) createGroup(w http.ResponseWriter, r *http.Request) {
+	var g Group
+	if !decode(w, r, &g) {
+		return
+	}
+	if !s.checkDisplayName(w, r, g.DisplayName, "") {
+		return
+	}
+	members, err := memberIDs(g.Members)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalidValue", err.Error())
+		return
+	}
+
+	resp, err := s.svcCtx.CoreRpc.CreateRole(r.Context(), &core.RoleInfo{
+		Name:   &g.DisplayName,
+		Code:   pointy.GetPointer(groupCode(g.DisplayName)),
+		Status: pointy.GetPointer(uint32(common.StatusNormal)),
+	})
+	if err != nil {
+		s.rpcError(w, r, err)
+		return
+	}
+	if err = s.syncMembers(r.Context(), resp.Id, nil, members); err != nil {
+		s.rpcError(w, r, err)
+		return
+	}
+
+	s.writeGroup(w, r, http.StatusCreated, resp.Id)
+}
+
+func (s *Server) replaceGroup(w http.ResponseWriter, r *http.Request) {
+	prev, ok := s.loadGroup(w, r)
+	if !ok {
+		return
+	}
+	if !checkIfMatch(w, r, prev.Meta.Version) {
+		return
+	}
+
+	var g Group
+	if !decode(w, r, &g) {
+		return
+	}
+	s.updateGroup(w, r, &g, prev)
+}
+
+func (s *Server) patchGroup(w http.ResponseWriter, r *http.Request) {
+	prev, ok := s.loadGroup(w, r)
+	if !ok {
+		return
+	}
+	if !checkIfMatch(w, r, prev.Meta.Version) {
+		return
+	}
+
+	var req PatchRequest
+	if !decode(w, r, &req) {
+		return
+	}
+
+	m := toMap(prev)
+	if err := applyPatch(m, req.Operations); err != nil {
+		writePatchError(w, err)
+		return
+	}
+	var g Group
+	if err := fromMap(m, &g); err != nil {
+		writeError(w, http.StatusBadRequest, "invalidValue", err.Error())
+		return
+	}
+	s.updateGroup(w, r, &g, prev)
+}
+
+// updateGroup renames the role and assigns the role to the members, members are compared by ID
+func (s *Server) updateGroup(w http.ResponseWriter, r *http.Request, g *Group, prev *Group) {
+	id, _ := strconv.ParseUint(prev.ID, 10, 64)
+	members, err := memberIDs(g.Members)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalidValue", err.Error())
+		return
+	}
+
+	if g.DisplayName != prev.DisplayName {
+		if !s.checkDisplayName(w, r, g.DisplayName, prev.ID) {
+			return
+		}
+		if err = s.renameRole(r.Context(), id, g.DisplayName); err != nil {
+			s.rpcError(w, r, err)
+			return
+		}
+	}
+
+	current, _ := memberIDs(prev.Members)
+	if err = s.syncMembers(r.Context(), id, current, members); err != nil {
+		s.rpcError(w, r, err)
+		return
+	}
+
+	s.writeGroup(w, r, http.StatusOK, id)
+}
+
+// deleteGroup deletes the role together with its members in one RPC, since deleteRole rejects
+// roles assigned to users
+func (s *Server) deleteGroup(w http.ResponseWriter, r *http.Request) {
+	prev, ok := s.loadGroup(w, r)
+	if !ok {
+		return
+	}
+	if !checkIfMatch(w, r, prev.Meta.Version) {
+		return
+	}
+
+	id, _ := strconv.ParseUint(prev.ID, 10, 64)
+	if _, err := s.svcCtx.CoreRpc.DeleteRoleWithUsers(r.Context(), &core.IDReq{Id: id}); err != nil {
+		s.rpcError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// loadGroup gets the group of the path with its members, writing the error when it is not found
+// or out of the scope of the token
+func (s *Server) loadGroup(w http.ResponseWriter, r *http.Request) (*Group, bool) {
+	id, err := strconv.ParseUint(pathID(r), 10, 64)
+	if err != nil {
+		writeError(w, http.StatusNotFound, "", "group not found")
+		return nil, false
+	}
+
+	res, err := s.getGroupResource(r.Context(), baseURL(r), id)
+	if err != nil {
+		if isNotFound(err) {
+			writeError(w, http.StatusNotFound, "", "group not found")
+		} else {
+			s.rpcError(w, r, err)
+		}
+		return nil, false
+	}
+	return res, true
+}
+
+func (s *Server) getGroupResource(ctx context.Context, base string, id uint64) (*Group, error) {
+	role, err := s.svcCtx.CoreRpc.GetRoleById(ctx, &core.IDReq{Id: id})
+	if err != nil {
+		return nil, err
+	}
+	if !groupScopeFrom(ctx).allows(role.GetCode()) {
+		return nil, errGroupNotFound
+	}
+	return s.groupResource(ctx, base, role, true)
+}
+
+func (s *Server) writeGroup(w http.ResponseWriter, r *http.Request, code int, id uint64) {
+	res, err := s.getGroupResource(r.Context(), baseURL(r), id)
+	if err != nil {
+		s.rpcError(w, r, err)
+		return
+	}
+	if excluded(r, "members") {
+		res.Members = nil
+	}
+	writeResource(w, r, code, res, res.Meta)
+}
+
+// groupResource converts the role into the SCIM resource. Membership changes do not touch the
+// role, so the version covers the members and is left out when the members are not loaded.
+func (s *Server) groupResource(ctx context.Context, base string, role *core.RoleInfo, withMembers bool) (*Group, error) {
+	id := strconv.FormatUint(role.GetId(), 10)
+	res := &Group{
+		Schemas:     []string{SchemaGroup},
+		ID:          id,
+		DisplayName: role.GetName(),
+		Meta: &Meta{
+			ResourceType: "Group",
+			Created:      time.UnixMilli(role.GetCreatedAt()).UTC().Format(time.RFC3339),
+			LastModified: time.UnixMilli(role.GetUpdatedAt()).UTC().Format(time.RFC3339),
+			Location:     base + "/Groups/" + id,
+		},
+	}
+	if !withMembers {
+		return res, nil
+	}
+
+	members, err := s.members(ctx, role.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	digest := sha256.New()
+	_, _ = fmt.Fprintf(digest, "%d:%s", role.GetUpdatedAt(), role.GetName())
+	for _, u := range members {
+		res.Members = append(res.Members, MultiValue{
+			Value:   u.GetId(),
+			Display: u.GetUsername(),
+			Ref:     base + "/Users/" + u.GetId(),
+		})
+		_, _ = fmt.Fprintf(digest, ":%s", u.GetId())
+	}
+	res.Meta.Version = version(hex.EncodeToString(digest.Sum(nil))[:16])
+
+	return res, nil
+}
+
+// members returns the users with the role sorted by ID
+func (s *Server) members(ctx context.Context, roleID uint64) ([]*core.UserInfo, error) {
+	var users []*core.UserInfo
+	for page := uint64(1); ; page++ {
+		resp, err := s.svcCtx.CoreRpc.GetUserList(ctx, &core.UserListReq{
+			Page:     page,
+			PageSize: memberPageSize,
+			RoleIds:  []uint64{roleID},
+		})
+		if err != nil {
+			return nil, err
+		}
+		users = append(users, resp.Data...)
+		if len(resp.Data) < memberPageSize || uint64(len(users)) >= resp.Total {
+			break
+		}
+	}
+
+	slices.SortFunc(users, func(a, b *core.UserInfo) int {
+		return strings.Compare(a.GetId(), b.GetId())
+	})
+	return users, nil
+}
+
+// syncMembers assigns the role to the added members and removes it from the others
+func (s *Server) syncMembers(ctx context.Context, roleID uint64, current, desired []string) error {
+	var added, removed []string
+	for _, id := range desired {
+		if !slices.Contains(current, id) {
+			added = append(added, id)
+		}
+	}
+	for _, id := range current {
+		if !slices.Contains(desired, id) {
+			removed = append(removed, id)
+		}
+	}
+
+	if len(added) > 0 {
+		if _, err := s.svcCtx.CoreRpc.AddAuth(ctx, &core.RoleAuthReq{RoleId: roleID, UserIds: added}); err != nil {
+			return err
+		}
+	}
+	if len(removed) > 0 {
+		if _, err := s.svcCtx.CoreRpc.CancelAuth(ctx, &core.RoleAuthReq{RoleId: roleID, UserIds: removed}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// renameRole keeps the menus of the role, which updateRole replaces with the menus of the request
+func (s *Server) renameRole(ctx context.Context, id uint64, name string) error {
+	menus, err := s.svcCtx.CoreRpc.GetMenuAuthority(ctx, &core.IDReq{Id: id})
+	if err != nil {
+		return err
+	}
+
+	_, err = s.svcCtx.CoreRpc.UpdateRole(ctx, &core.RoleInfo{
+		Id:      &id,
+		Name:    &name,
+		MenuIds: menus.MenuIds,
+	})
+	return err
+}
+
+// checkDisplayName rejects names of other roles, the names are matched by identity providers
+func (s *Server) checkDisplayName(w http.ResponseWriter, r *http.Request, name, id string) bool {
+	if name == "" {
+		writeError(w, http.StatusBadRequest, "invalidValue", errInvalidGroup.Error())
+		return false
+	}
+
+	resp, err := s.svcCtx.CoreRpc.GetRoleList(r.Context(), &core.RoleListReq{Page: 1, PageSize: maxCount, Name: &name})
+	if err != nil {
+		s.rpcError(w, r, err)
+		return false
+	}
+	for _, v := range resp.Data {
+		if v.GetName() == name && strconv.FormatUint(v.GetId(), 10) != id {
+			writeError(w, http.StatusConflict, "uniqueness", "displayName is already taken")
+			return false
+		}
+	}
+
+	return true
+}
+
+// memberIDs returns the user IDs of the members without duplicates
+func memberIDs(members []MultiValue) ([]string, error) {
+	var ids []string
+	for _, v := range members {
+		id, err := uuid.FromString(v.Value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid member %q", v.Value)
+		}
+		if !slices.Contains(ids, id.String()) {
+			ids = append(ids, id.String())
+		}
+	}
+	return ids, nil
+}
+
+// groupCode 角色编码在租户内唯一，由组名的摘要生成
+func groupCode(name string) string {
+	digest := sha256.Sum256([]byte(name))
+	return groupCodePrefix + hex.EncodeToString(digest[:])[:16]
+}
@@ -0,0 +1,48 @@
+package scim
+
+import (
+	"context"
+	"testing"
+
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+)
+
+func TestGroupScope(t *testing.T) {
+	scope := groupScopeFrom(withGroupScope(context.Background(), []string{"sales"}))
+
+	tests := []struct {
+		code string
+		want bool
+	}{
+		{code: groupCode("Engineering"), want: true},
+		{code: "sales", want: true},
+		{code: "admin", want: false},
+		{code: "superadmin", want: false},
+		{code: "SCIM_admin", want: false},
+		{code: "", want: false},
+	}
+	for _, tt := range tests {
+		if got := scope.allows(tt.code); got != tt.want {
+			t.Errorf("allows(%q) = %v, want %v", tt.code, got, tt.want)
+		}
+	}
+
+	// 未认证的请求没有额外授权的角色
+	if groupScopeFrom(context.Background()).allows("sales") {
+		t.Error("an empty scope allows an existing role")
+	}
+}
+
+func TestUserResourceGroups(t *testing.T) {
+	scope := groupScopeFrom(withGroupScope(context.Background(), []string{"sales"}))
+	u := &core.UserInfo{
+		RoleIds:   []uint64{1, 2, 3},
+		RoleCodes: []string{"admin", groupCode("Engineering"), "sales"},
+		RoleNames: []string{"Administrator", "Engineering", "Sales"},
+	}
+
+	res := userResource("https://example.com/scim/v2", scope, u)
+	if len(res.Groups) != 2 || res.Groups[0].Value != "2" || res.Groups[1].Display != "Sales" {
+		t.Errorf("groups = %+v, want the groups 2 and 3 only", res.Groups)
+	}
+}
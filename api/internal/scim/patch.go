@@ -0,0 +1,279 @@
+package scim
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// errNoTarget 删除操作没有指定属性
+var errNoTarget = errors.New("no target")
+
+// patchPath is a parsed patch path such as emails[type eq "work"].value
+type patchPath struct {
+	// Container is the extension object holding the attribute, empty for core attributes
+	Container string
+	Attr      string
+	Filter    filter
+	Sub       string
+}
+
+func parsePatchPath(path string) (*patchPath, error) {
+	container, attr := splitSchema(strings.TrimSpace(path))
+	p := &patchPath{Container: container}
+	if attr == "" {
+		if container == "" {
+			return nil, fmt.Errorf("%w: empty path", errInvalidFilter)
+		}
+		// 整个扩展对象作为属性
+		return &patchPath{Attr: container}, nil
+	}
+
+	if i := strings.IndexByte(attr, '['); i >= 0 {
+		j := strings.LastIndexByte(attr, ']')
+		if j < i {
+			return nil, fmt.Errorf("%w: missing ]", errInvalidFilter)
+		}
+		f, err := parseFilter(attr[i+1 : j])
+		if err != nil {
+			return nil, err
+		}
+		p.Attr, p.Filter = attr[:i], f
+		if rest := attr[j+1:]; rest != "" {
+			sub, ok := strings.CutPrefix(rest, ".")
+			if !ok || sub == "" {
+				return nil, fmt.Errorf("%w: invalid path %q", errInvalidFilter, path)
+			}
+			p.Sub = sub
+		}
+	} else if name, sub, ok := strings.Cut(attr, "."); ok {
+		p.Attr, p.Sub = name, sub
+	} else {
+		p.Attr = attr
+	}
+
+	if p.Attr == "" {
+		return nil, fmt.Errorf("%w: invalid path %q", errInvalidFilter, path)
+	}
+
+	return p, nil
+}
+
+// applyPatch applies the operations to the JSON form of a resource
+func applyPatch(resource map[string]any, ops []PatchOperation) error {
+	for _, op := range ops {
+		name := strings.ToLower(op.Op)
+		if name != "add" && name != "replace" && name != "remove" {
+			return fmt.Errorf("%w: unsupported op %q", errInvalidFilter, op.Op)
+		}
+
+		if op.Path == "" {
+			if name == "remove" {
+				return errNoTarget
+			}
+			// 没有路径时 value 是属性到值的映射，键本身也可以是带 schema 的路径
+			values, ok := op.Value.(map[string]any)
+			if !ok {
+				return fmt.Errorf("%w: value must be an object without path", errInvalidFilter)
+			}
+			for k, v := range values {
+				p, err := parsePatchPath(k)
+				if err != nil {
+					return err
+				}
+				if err := applyOperation(resource, name, p, v); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+
+		p, err := parsePatchPath(op.Path)
+		if err != nil {
+			return err
+		}
+		if err := applyOperation(resource, name, p, op.Value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func applyOperation(resource map[string]any, op string, p *patchPath, value any) error {
+	parent := resource
+	if p.Container != "" {
+		container, ok := lookup(resource, p.Container).(map[string]any)
+		if !ok {
+			if op == "remove" {
+				return nil
+			}
+			container = map[string]any{}
+			resource[p.Container] = container
+		}
+		parent = container
+	}
+
+	key, found := findKey(parent, p.Attr)
+	if !found {
+		key = p.Attr
+	}
+	current := parent[key]
+
+	switch {
+	case p.Filter == nil && p.Sub == "":
+		parent[key] = applyValue(op, current, value)
+		if op == "remove" && (value == nil || parent[key] == nil) {
+			delete(parent, key)
+		}
+	case p.Filter == nil:
+		// name.givenName 等复杂属性的子属性，多值属性则作用于所有元素
+		switch v := current.(type) {
+		case []any:
+			if len(v) == 0 && op != "remove" {
+				parent[key] = []any{map[string]any{p.Sub: value}}
+				return nil
+			}
+			for _, item := range v {
+				if m, ok := item.(map[string]any); ok {
+					setSub(m, op, p.Sub, value)
+				}
+			}
+		case map[string]any:
+			setSub(v, op, p.Sub, value)
+		default:
+			if op != "remove" {
+				parent[key] = map[string]any{p.Sub: value}
+			}
+		}
+	default:
+		items := toSlice(current)
+		var result []any
+		matched := false
+		for _, item := range items {
+			m, ok := item.(map[string]any)
+			if !ok || !p.Filter.match(m) {
+				result = append(result, item)
+				continue
+			}
+			matched = true
+
+			switch {
+			case op == "remove" && p.Sub == "":
+				continue
+			case p.Sub != "":
+				setSub(m, op, p.Sub, value)
+			case op == "replace":
+				if v, ok := value.(map[string]any); ok {
+					m = v
+				}
+			default:
+				if v, ok := value.(map[string]any); ok {
+					for k, val := range v {
+						m[k] = val
+					}
+				}
+			}
+			result = append(result, m)
+		}
+
+		// 没有匹配的元素时按过滤条件新建，例如 emails[type eq "work"].value
+		if !matched && op != "remove" {
+			item := map[string]any{}
+			for _, c := range p.Filter {
+				if c.Op == "eq" && c.Sub == nil {
+					item[c.Path] = c.Value
+				}
+			}
+			if p.Sub != "" {
+				item[p.Sub] = value
+			} else if v, ok := value.(map[string]any); ok {
+				for k, val := range v {
+					item[k] = val
+				}
+			}
+			result = append(result, item)
+		}
+		parent[key] = result
+	}
+
+	return nil
+}
+
+// applyValue applies the operation to an attribute without filter
+func applyValue(op string, current, value any) any {
+	switch op {
+	case "add":
+		switch cur := current.(type) {
+		case []any:
+			// 多值属性追加元素，已存在的 value 不重复添加
+			for _, v := range toSlice(value) {
+				if !containsValue(cur, v) {
+					cur = append(cur, v)
+				}
+			}
+			return cur
+		case map[string]any:
+			if v, ok := value.(map[string]any); ok {
+				for k, val := range v {
+					cur[k] = val
+				}
+				return cur
+			}
+		}
+		return value
+	case "replace":
+		if cur, ok := current.(map[string]any); ok {
+			if v, ok := value.(map[string]any); ok {
+				for k, val := range v {
+					cur[k] = val
+				}
+				return cur
+			}
+		}
+		return value
+	default:
+		// Azure AD 删除成员时在 value 中给出要删除的元素
+		cur, ok := current.([]any)
+		if !ok || value == nil {
+			return nil
+		}
+		var result []any
+		for _, item := range cur {
+			if !containsValue(toSlice(value), item) {
+				result = append(result, item)
+			}
+		}
+		return result
+	}
+}
+
+func setSub(m map[string]any, op, sub string, value any) {
+	key, found := findKey(m, sub)
+	if !found {
+		key = sub
+	}
+	if op == "remove" {
+		delete(m, key)
+		return
+	}
+	m[key] = value
+}
+
+// containsValue compares elements of multi-valued attributes by their value sub-attribute
+func containsValue(items []any, v any) bool {
+	target := elementValue(v)
+	for _, item := range items {
+		if elementValue(item) == target {
+			return true
+		}
+	}
+	return false
+}
+
+func elementValue(v any) string {
+	if m, ok := v.(map[string]any); ok {
+		return fmt.Sprint(lookup(m, "value"))
+	}
+	return fmt.Sprint(v)
+}
@@ -0,0 +1,147 @@
+package scim
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+const (
+	SchemaUser                  = "urn:ietf:params:scim:schemas:core:2.0:User"
+	SchemaEnterpriseUser        = "urn:ietf:params:scim:schemas:extension:enterprise:2.0:User"
+	SchemaGroup                 = "urn:ietf:params:scim:schemas:core:2.0:Group"
+	SchemaServiceProviderConfig = "urn:ietf:params:scim:schemas:core:2.0:ServiceProviderConfig"
+	SchemaResourceType          = "urn:ietf:params:scim:schemas:core:2.0:ResourceType"
+	SchemaSchema                = "urn:ietf:params:scim:schemas:core:2.0:Schema"
+	SchemaListResponse          = "urn:ietf:params:scim:api:messages:2.0:ListResponse"
+	SchemaPatchOp               = "urn:ietf:params:scim:api:messages:2.0:PatchOp"
+	SchemaError                 = "urn:ietf:params:scim:api:messages:2.0:Error"
+)
+
+// Bool 兼容以字符串传递的布尔值，Azure AD 的 PATCH 请求中 active 的值为 "False"
+type Bool bool
+
+func (b *Bool) UnmarshalJSON(data []byte) error {
+	var v any
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+
+	switch v := v.(type) {
+	case bool:
+		*b = Bool(v)
+	case string:
+		parsed, err := strconv.ParseBool(v)
+		if err != nil {
+			return fmt.Errorf("invalid boolean %q", v)
+		}
+		*b = Bool(parsed)
+	case nil:
+		*b = false
+	default:
+		return fmt.Errorf("invalid boolean %v", v)
+	}
+
+	return nil
+}
+
+// Meta is the metadata of a resource
+type Meta struct {
+	ResourceType string `json:"resourceType"`
+	Created      string `json:"created,omitempty"`
+	LastModified string `json:"lastModified,omitempty"`
+	Location     string `json:"location,omitempty"`
+	Version      string `json:"version,omitempty"`
+}
+
+// Name is the name of a user
+type Name struct {
+	Formatted  string `json:"formatted,omitempty"`
+	FamilyName string `json:"familyName,omitempty"`
+	GivenName  string `json:"givenName,omitempty"`
+}
+
+// MultiValue is an element of multi-valued attributes such as emails and members
+type MultiValue struct {
+	Value   string `json:"value"`
+	Display string `json:"display,omitempty"`
+	Type    string `json:"type,omitempty"`
+	Primary Bool   `json:"primary,omitempty"`
+	Ref     string `json:"$ref,omitempty"`
+}
+
+// EnterpriseUser is the enterprise extension of a user, only the department is mapped
+type EnterpriseUser struct {
+	Department string `json:"department,omitempty"`
+}
+
+// User is the SCIM user resource.
+// userName 对应用户名，displayName 对应昵称，active 对应用户状态，groups 对应角色且只读
+type User struct {
+	Schemas      []string        `json:"schemas"`
+	ID           string          `json:"id,omitempty"`
+	ExternalID   string          `json:"externalId,omitempty"`
+	UserName     string          `json:"userName"`
+	Name         *Name           `json:"name,omitempty"`
+	DisplayName  string          `json:"displayName,omitempty"`
+	Active       *Bool           `json:"active,omitempty"`
+	Password     string          `json:"password,omitempty"`
+	Emails       []MultiValue    `json:"emails,omitempty"`
+	PhoneNumbers []MultiValue    `json:"phoneNumbers,omitempty"`
+	Groups       []MultiValue    `json:"groups,omitempty"`
+	Enterprise   *EnterpriseUser `json:"urn:ietf:params:scim:schemas:extension:enterprise:2.0:User,omitempty"`
+	Meta         *Meta           `json:"meta,omitempty"`
+}
+
+// Group is the SCIM group resource, which is a role
+type Group struct {
+	Schemas     []string     `json:"schemas"`
+	ID          string       `json:"id,omitempty"`
+	ExternalID  string       `json:"externalId,omitempty"`
+	DisplayName string       `json:"displayName"`
+	Members     []MultiValue `json:"members,omitempty"`
+	Meta        *Meta        `json:"meta,omitempty"`
+}
+
+// ListResponse is the response of queries
+type ListResponse struct {
+	Schemas      []string `json:"schemas"`
+	TotalResults uint64   `json:"totalResults"`
+	StartIndex   uint64   `json:"startIndex"`
+	ItemsPerPage uint64   `json:"itemsPerPage"`
+	Resources    []any    `json:"Resources"`
+}
+
+// PatchRequest is the body of PATCH requests
+type PatchRequest struct {
+	Schemas    []string         `json:"schemas"`
+	Operations []PatchOperation `json:"Operations"`
+}
+
+// PatchOperation is one operation of a PATCH request
+type PatchOperation struct {
+	Op    string `json:"op"`
+	Path  string `json:"path,omitempty"`
+	Value any    `json:"value,omitempty"`
+}
+
+// Error is the error response
+type Error struct {
+	Schemas  []string `json:"schemas"`
+	Status   string   `json:"status"`
+	ScimType string   `json:"scimType,omitempty"`
+	Detail   string   `json:"detail,omitempty"`
+}
+
+// primaryValue returns the value of the primary element, or the first one
+func primaryValue(values []MultiValue) string {
+	for _, v := range values {
+		if v.Primary {
+			return v.Value
+		}
+	}
+	if len(values) > 0 {
+		return values[0].Value
+	}
+	return ""
+}
@@ -0,0 +1,421 @@
+package scim
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/coder-lulu/newbee-common/v2/i18n"
+	"github.com/coder-lulu/newbee-common/v2/orm/ent/hooks"
+	"github.com/coder-lulu/newbee-common/v2/utils/pointy"
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/rest/handler"
+	"github.com/zeromicro/go-zero/rest/httpx"
+	"github.com/zeromicro/go-zero/rest/pathvar"
+	"github.com/zeromicro/go-zero/rest/router"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/coder-lulu/newbee-core/api/internal/svc"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+)
+
+const (
+	// PathPrefix SCIM 2.0 接口的路径前缀
+	PathPrefix = "/scim/v2"
+	// ContentType SCIM 响应的媒体类型
+	ContentType = "application/scim+json"
+
+	// defaultCount 未指定 count 时每页返回的资源数
+	defaultCount = 100
+	// maxCount 每页最多返回的资源数，与 ServiceProviderConfig 中的 filter.maxResults 一致
+	maxCount = 200
+	// maxBodyBytes 请求体大小上限
+	maxBodyBytes = 1 << 20
+)
+
+// NewRouter routes the SCIM requests to the SCIM handler and the others to the go-zero router.
+// SCIM clients authenticate with the SCIM tokens of the tenant instead of user JWTs, so the requests
+// must not reach the unified middleware chain, which is bound to the routes of the go-zero router.
+func NewRouter(next httpx.Router, scim http.Handler) httpx.Router {
+	return &scimRouter{Router: next, scim: scim}
+}
+
+type scimRouter struct {
+	httpx.Router
+	scim http.Handler
+}
+
+func (r *scimRouter) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if req.URL.Path == PathPrefix || strings.HasPrefix(req.URL.Path, PathPrefix+"/") {
+		r.scim.ServeHTTP(w, req)
+		return
+	}
+	r.Router.ServeHTTP(w, req)
+}
+
+// Server serves the SCIM 2.0 endpoints with the core RPC
+type Server struct {
+	svcCtx *svc.ServiceContext
+	router httpx.Router
+}
+
+// NewHandler creates the handler of the SCIM endpoints
+func NewHandler(svcCtx *svc.ServiceContext) http.Handler {
+	s := &Server{
+		svcCtx: svcCtx,
+		router: router.NewRouter(),
+	}
+
+	routes := []struct {
+		method  string
+		path    string
+		handler http.HandlerFunc
+		public  bool
+	}{
+		// 发现接口不包含租户数据，不需要认证
+		{http.MethodGet, "/ServiceProviderConfig", s.serviceProviderConfig, true},
+		{http.MethodGet, "/ResourceTypes", s.resourceTypes, true},
+		{http.MethodGet, "/ResourceTypes/:id", s.resourceType, true},
+		{http.MethodGet, "/Schemas", s.schemas, true},
+		{http.MethodGet, "/Schemas/:id", s.schema, true},
+
+		{http.MethodGet, "/Users", s.listUsers, false},
+		{http.MethodPost, "/Users", s.createUser, false},
+		{http.MethodGet, "/Users/:id", s.getUser, false},
+		{http.MethodPut, "/Users/:id", s.replaceUser, false},
+		{http.MethodPatch, "/Users/:id", s.patchUser, false},
+		{http.MethodDelete, "/Users/:id", s.deleteUser, false},
+
+		{http.MethodGet, "/Groups", s.listGroups, false},
+		{http.MethodPost, "/Groups", s.createGroup, false},
+		{http.MethodGet, "/Groups/:id", s.getGroup, false},
+		{http.MethodPut, "/Groups/:id", s.replaceGroup, false},
+		{http.MethodPatch, "/Groups/:id", s.patchGroup, false},
+		{http.MethodDelete, "/Groups/:id", s.deleteGroup, false},
+	}
+	for _, r := range routes {
+		h := r.handler
+		if !r.public {
+			h = s.authenticate(h)
+		}
+		if err := s.router.Handle(r.method, PathPrefix+r.path, http.MaxBytesHandler(h, maxBodyBytes)); err != nil {
+			logx.Must(err)
+		}
+	}
+	s.router.SetNotFoundHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeError(w, http.StatusNotFound, "", "resource not found")
+	}))
+	s.router.SetNotAllowedHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeError(w, http.StatusMethodNotAllowed, "", "method not allowed")
+	}))
+
+	return handler.RecoverHandler(s.router)
+}
+
+// authenticate validates the SCIM token and binds the request to the tenant of the token
+func (s *Server) authenticate(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		token, found := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if !found || token == "" {
+			w.Header().Set("WWW-Authenticate", `Bearer realm="scim"`)
+			writeError(w, http.StatusUnauthorized, "", "bearer token required")
+			return
+		}
+
+		info, err := s.svcCtx.CoreRpc.AuthenticateScimToken(hooks.NewSystemContext(r.Context()), &core.ScimTokenAuthReq{
+			Token: token,
+			Ip:    pointy.GetPointer(httpx.GetRemoteAddr(r)),
+		})
+		if err != nil {
+			if st, ok := status.FromError(err); ok && st.Code() == codes.Unauthenticated {
+				w.Header().Set("WWW-Authenticate", `Bearer realm="scim", error="invalid_token"`)
+				writeError(w, http.StatusUnauthorized, "", "invalid token")
+				return
+			}
+			logx.WithContext(r.Context()).Errorw("failed to authenticate SCIM token", logx.Field("detail", err.Error()))
+			writeError(w, http.StatusInternalServerError, "", "failed to authenticate")
+			return
+		}
+
+		ctx := s.svcCtx.ContextManager.SetTenantID(r.Context(), strconv.FormatUint(info.GetTenantId(), 10))
+		ctx = hooks.SetTenantIDToContext(ctx, info.GetTenantId())
+		ctx = withGroupScope(ctx, info.GetRoleCodes())
+		logx.WithContext(ctx).Infow("SCIM request", logx.Field("method", r.Method), logx.Field("path", r.URL.Path),
+			logx.Field("token", info.GetName()), logx.Field("tenantId", info.GetTenantId()))
+
+		next(w, r.WithContext(ctx))
+	}
+}
+
+// rpcError writes the error of the core RPC. The RPC returns i18n keys, most of which are
+// invalid arguments, so the well-known keys are mapped to the SCIM errors.
+func (s *Server) rpcError(w http.ResponseWriter, r *http.Request, err error) {
+	st, ok := status.FromError(err)
+	if !ok {
+		logx.WithContext(r.Context()).Errorw("SCIM request failed", logx.Field("detail", err.Error()))
+		writeError(w, http.StatusInternalServerError, "", err.Error())
+		return
+	}
+
+	detail := s.svcCtx.Trans.Trans(r.Context(), st.Message())
+	switch {
+	case st.Message() == i18n.TargetNotFound, st.Code() == codes.NotFound:
+		writeError(w, http.StatusNotFound, "", detail)
+	case st.Message() == i18n.ConstraintError, st.Message() == "login.signupUserExist", st.Message() == "login.mobileExist",
+		st.Code() == codes.AlreadyExists:
+		writeError(w, http.StatusConflict, "uniqueness", detail)
+	case st.Code() == codes.InvalidArgument:
+		writeError(w, http.StatusBadRequest, "invalidValue", detail)
+	default:
+		logx.WithContext(r.Context()).Errorw("SCIM request failed", logx.Field("detail", err.Error()))
+		writeError(w, http.StatusInternalServerError, "", detail)
+	}
+}
+
+func writeError(w http.ResponseWriter, code int, scimType, detail string) {
+	writeJSON(w, code, &Error{
+		Schemas:  []string{SchemaError},
+		Status:   strconv.Itoa(code),
+		ScimType: scimType,
+		Detail:   detail,
+	})
+}
+
+func writeJSON(w http.ResponseWriter, code int, v any) {
+	body, err := json.Marshal(v)
+	if err != nil {
+		logx.Errorw("failed to marshal SCIM response", logx.Field("detail", err.Error()))
+		code, body = http.StatusInternalServerError, []byte(`{"schemas":["`+SchemaError+`"],"status":"500"}`)
+	}
+
+	w.Header().Set("Content-Type", ContentType)
+	w.WriteHeader(code)
+	if _, err = w.Write(body); err != nil {
+		logx.Errorw("failed to write SCIM response", logx.Field("detail", err.Error()))
+	}
+}
+
+// writeResource writes the resource with its version as the ETag, and the location for new resources
+func writeResource(w http.ResponseWriter, r *http.Request, code int, resource any, meta *Meta) {
+	if meta.Version != "" {
+		w.Header().Set("ETag", meta.Version)
+	}
+	if code == http.StatusCreated {
+		w.Header().Set("Location", meta.Location)
+	}
+	writeJSON(w, code, project(r, resource))
+}
+
+// decode reads the request body, both application/scim+json and application/json are accepted
+func decode(w http.ResponseWriter, r *http.Request, v any) bool {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			writeError(w, http.StatusRequestEntityTooLarge, "", "request body too large")
+			return false
+		}
+		writeError(w, http.StatusBadRequest, "invalidSyntax", err.Error())
+		return false
+	}
+	return true
+}
+
+// toMap converts a resource into its JSON form for filtering and patching
+func toMap(v any) map[string]any {
+	body, _ := json.Marshal(v)
+	var m map[string]any
+	_ = json.Unmarshal(body, &m)
+	return m
+}
+
+// fromMap converts the JSON form back into the resource
+func fromMap(m map[string]any, v any) error {
+	body, err := json.Marshal(m)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(body, v)
+}
+
+// project applies the attributes and excludedAttributes parameters to the top-level attributes,
+// id, schemas and meta are always returned
+func project(r *http.Request, resource any) any {
+	attributes := splitParam(r.URL.Query().Get("attributes"))
+	excluded := splitParam(r.URL.Query().Get("excludedAttributes"))
+	if len(attributes) == 0 && len(excluded) == 0 {
+		return resource
+	}
+
+	m := toMap(resource)
+	for k := range m {
+		switch {
+		case k == "id" || k == "schemas" || k == "meta":
+		case len(attributes) > 0 && !attributes[strings.ToLower(k)]:
+			delete(m, k)
+		case excluded[strings.ToLower(k)]:
+			delete(m, k)
+		}
+	}
+	return m
+}
+
+func splitParam(v string) map[string]bool {
+	if v == "" {
+		return nil
+	}
+	result := map[string]bool{}
+	for _, name := range strings.Split(v, ",") {
+		container, attr := splitSchema(strings.TrimSpace(name))
+		if container != "" {
+			attr = container
+		}
+		name, _, _ = strings.Cut(attr, ".")
+		result[strings.ToLower(name)] = true
+	}
+	return result
+}
+
+// excluded reports whether the attribute is left out of the response, used to skip loading members
+func excluded(r *http.Request, attr string) bool {
+	if attributes := splitParam(r.URL.Query().Get("attributes")); len(attributes) > 0 {
+		return !attributes[strings.ToLower(attr)]
+	}
+	return splitParam(r.URL.Query().Get("excludedAttributes"))[strings.ToLower(attr)]
+}
+
+// pageParams parses startIndex and count, startIndex starts from 1
+func pageParams(r *http.Request) (uint64, uint64) {
+	startIndex, err := strconv.ParseUint(r.URL.Query().Get("startIndex"), 10, 64)
+	if err != nil || startIndex < 1 {
+		startIndex = 1
+	}
+
+	count := uint64(defaultCount)
+	if v := r.URL.Query().Get("count"); v != "" {
+		if n, err := strconv.ParseInt(v, 10, 64); err == nil {
+			count = uint64(max(n, 0))
+		}
+	}
+
+	return startIndex, min(count, maxCount)
+}
+
+// fetchWindow fetches the resources from startIndex with the paged RPC. The window is fetched
+// with two pages when it does not start at the beginning of a page.
+func fetchWindow[T any](startIndex, count uint64, fetch func(page, pageSize uint64) ([]T, uint64, error)) ([]T, uint64, error) {
+	if count == 0 {
+		_, total, err := fetch(1, 1)
+		return nil, total, err
+	}
+
+	offset := startIndex - 1
+	page, skip := offset/count+1, offset%count
+
+	items, total, err := fetch(page, count)
+	if err != nil {
+		return nil, 0, err
+	}
+	if skip > 0 && page*count < total {
+		more, _, err := fetch(page+1, count)
+		if err != nil {
+			return nil, 0, err
+		}
+		items = append(items, more...)
+	}
+
+	if skip >= uint64(len(items)) {
+		return nil, total, nil
+	}
+	items = items[skip:]
+	if uint64(len(items)) > count {
+		items = items[:count]
+	}
+
+	return items, total, nil
+}
+
+// window applies startIndex and count to resources matched in memory
+func window[T any](items []T, startIndex, count uint64) []T {
+	if startIndex-1 >= uint64(len(items)) {
+		return nil
+	}
+	items = items[startIndex-1:]
+	if uint64(len(items)) > count {
+		items = items[:count]
+	}
+	return items
+}
+
+func listResponse(resources []any, total, startIndex uint64) *ListResponse {
+	if resources == nil {
+		resources = []any{}
+	}
+	return &ListResponse{
+		Schemas:      []string{SchemaListResponse},
+		TotalResults: total,
+		StartIndex:   startIndex,
+		ItemsPerPage: uint64(len(resources)),
+		Resources:    resources,
+	}
+}
+
+// version is the weak ETag of a resource
+func version(v string) string {
+	return fmt.Sprintf(`W/"%s"`, v)
+}
+
+// checkIfMatch checks the If-Match header of modifications against the current version
+func checkIfMatch(w http.ResponseWriter, r *http.Request, current string) bool {
+	header := r.Header.Get("If-Match")
+	if header == "" || header == "*" || matchETag(header, current) {
+		return true
+	}
+	writeError(w, http.StatusPreconditionFailed, "", "resource has been modified")
+	return false
+}
+
+// notModified answers GET requests whose If-None-Match contains the current version
+func notModified(w http.ResponseWriter, r *http.Request, current string) bool {
+	header := r.Header.Get("If-None-Match")
+	if header == "" || !matchETag(header, current) {
+		return false
+	}
+	w.Header().Set("ETag", current)
+	w.WriteHeader(http.StatusNotModified)
+	return true
+}
+
+// matchETag 弱比较，忽略 W/ 前缀
+func matchETag(header, current string) bool {
+	current = strings.TrimPrefix(current, "W/")
+	for _, v := range strings.Split(header, ",") {
+		if strings.TrimPrefix(strings.TrimSpace(v), "W/") == current {
+			return true
+		}
+	}
+	return false
+}
+
+// baseURL is the URL of the SCIM endpoints used in resource locations
+func baseURL(r *http.Request) string {
+	scheme := "http"
+	if r.TLS != nil {
+		scheme = "https"
+	}
+	if v := r.Header.Get("X-Forwarded-Proto"); v != "" {
+		scheme, _, _ = strings.Cut(v, ",")
+	}
+	host := r.Host
+	if v := r.Header.Get("X-Forwarded-Host"); v != "" {
+		host, _, _ = strings.Cut(v, ",")
+	}
+	return scheme + "://" + strings.TrimSpace(host) + PathPrefix
+}
+
+func pathID(r *http.Request) string {
+	return pathvar.Vars(r)["id"]
+}
@@ -0,0 +1,484 @@
+package scim
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/coder-lulu/newbee-common/v2/enum/common"
+	"github.com/coder-lulu/newbee-common/v2/i18n"
+	"github.com/coder-lulu/newbee-common/v2/utils/pointy"
+	"github.com/gofrs/uuid/v5"
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/status"
+
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+)
+
+// noDepartment 用户列表中部门不存在时返回的占位名称
+const noDepartment = "无部门"
+
+var (
+	errNotFound    = errors.New("resource not found")
+	errInvalidUser = errors.New("userName is required")
+)
+
+func (s *Server) listUsers(w http.ResponseWriter, r *http.Request) {
+	startIndex, count := pageParams(r)
+	base := baseURL(r)
+
+	if r.URL.Query().Get("filter") == "" {
+		users, total, err := fetchWindow(startIndex, count, func(page, pageSize uint64) ([]*core.UserInfo, uint64, error) {
+			resp, err := s.svcCtx.CoreRpc.GetUserList(r.Context(), &core.UserListReq{Page: page, PageSize: pageSize})
+			if err != nil {
+				return nil, 0, err
+			}
+			return resp.Data, resp.Total, nil
+		})
+		if err != nil {
+			s.rpcError(w, r, err)
+			return
+		}
+
+		resources := make([]any, 0, len(users))
+		for _, u := range users {
+			resources = append(resources, project(r, userResource(base, groupScopeFrom(r.Context()), u)))
+		}
+		writeJSON(w, http.StatusOK, listResponse(resources, total, startIndex))
+		return
+	}
+
+	f, err := parseFilter(r.URL.Query().Get("filter"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalidFilter", err.Error())
+		return
+	}
+	users, err := s.findUsers(r.Context(), f)
+	if err != nil {
+		if errors.Is(err, errInvalidFilter) {
+			writeError(w, http.StatusBadRequest, "invalidFilter", err.Error())
+			return
+		}
+		s.rpcError(w, r, err)
+		return
+	}
+
+	var matched []any
+	for _, u := range users {
+		res := userResource(base, groupScopeFrom(r.Context()), u)
+		if f.match(toMap(res)) {
+			matched = append(matched, project(r, res))
+		}
+	}
+	writeJSON(w, http.StatusOK, listResponse(window(matched, startIndex, count), uint64(len(matched)), startIndex))
+}
+
+// findUsers runs the query of the filter that the RPC supports, the caller matches the whole
+// filter on the results. Filters without userName, id or email are rejected rather than
+// matching every user of the tenant in memory.
+func (s *Server) findUsers(ctx context.Context, f filter) ([]*core.UserInfo, error) {
+	if v, ok := f.equalsValue("id"); ok {
+		u, err := s.getUserInfo(ctx, v)
+		if errors.Is(err, errNotFound) {
+			return nil, nil
+		}
+		return []*core.UserInfo{u}, err
+	}
+
+	if v, ok := f.equalsValue("userName"); ok {
+		u, err := s.svcCtx.CoreRpc.GetUserByUsername(ctx, &core.UsernameReq{Username: v})
+		if err != nil {
+			if isNotFound(err) {
+				return nil, nil
+			}
+			return nil, err
+		}
+		u, err = s.getUserInfo(ctx, u.GetId())
+		if errors.Is(err, errNotFound) {
+			return nil, nil
+		}
+		return []*core.UserInfo{u}, err
+	}
+
+	for _, path := range []string{"emails.value", "emails"} {
+		if v, ok := f.equalsValue(path); ok {
+			resp, err := s.svcCtx.CoreRpc.GetUserList(ctx, &core.UserListReq{Page: 1, PageSize: maxCount, Email: &v})
+			if err != nil {
+				return nil, err
+			}
+			return resp.Data, nil
+		}
+	}
+
+	return nil, errors.Join(errInvalidFilter, errors.New("users can only be filtered by id, userName or emails"))
+}
+
+func (s *Server) getUser(w http.ResponseWriter, r *http.Request) {
+	u, ok := s.loadUser(w, r)
+	if !ok {
+		return
+	}
+
+	res := userResource(baseURL(r), groupScopeFrom(r.Context()), u)
+	if notModified(w, r, res.Meta.Version) {
+		return
+	}
+	writeResource(w, r, http.StatusOK, res, res.Meta)
+}
+
+func (s *Server) createUser(w http.ResponseWriter, r *http.Request) {
+	var u User
+	if !decode(w, r, &u) {
+		return
+	}
+	if !s.checkUserName(w, r, u.UserName, "") {
+		return
+	}
+
+	info, err := s.userInfo(r.Context(), &u, nil)
+	if err != nil {
+		s.writeUserError(w, r, err)
+		return
+	}
+
+	resp, err := s.svcCtx.CoreRpc.CreateUser(r.Context(), info)
+	if err != nil {
+		s.rpcError(w, r, err)
+		return
+	}
+
+	s.writeUser(w, r, http.StatusCreated, resp.Id)
+}
+
+// replaceUser replaces the attributes of the user, attributes left out of the request are cleared.
+// Groups are read-only and changed through the group endpoints.
+func (s *Server) replaceUser(w http.ResponseWriter, r *http.Request) {
+	current, ok := s.loadUser(w, r)
+	if !ok {
+		return
+	}
+	prev := userResource(baseURL(r), groupScopeFrom(r.Context()), current)
+	if !checkIfMatch(w, r, prev.Meta.Version) {
+		return
+	}
+
+	var u User
+	if !decode(w, r, &u) {
+		return
+	}
+	s.updateUser(w, r, &u, prev)
+}
+
+func (s *Server) patchUser(w http.ResponseWriter, r *http.Request) {
+	current, ok := s.loadUser(w, r)
+	if !ok {
+		return
+	}
+	prev := userResource(baseURL(r), groupScopeFrom(r.Context()), current)
+	if !checkIfMatch(w, r, prev.Meta.Version) {
+		return
+	}
+
+	var req PatchRequest
+	if !decode(w, r, &req) {
+		return
+	}
+
+	m := toMap(prev)
+	if err := applyPatch(m, req.Operations); err != nil {
+		writePatchError(w, err)
+		return
+	}
+	var u User
+	if err := fromMap(m, &u); err != nil {
+		writeError(w, http.StatusBadRequest, "invalidValue", err.Error())
+		return
+	}
+	s.updateUser(w, r, &u, prev)
+}
+
+// updateUser 停用用户时 updateUser 会同时吊销该用户的所有令牌
+func (s *Server) updateUser(w http.ResponseWriter, r *http.Request, u *User, prev *User) {
+	if !strings.EqualFold(u.UserName, prev.UserName) && !s.checkUserName(w, r, u.UserName, prev.ID) {
+		return
+	}
+
+	info, err := s.userInfo(r.Context(), u, prev)
+	if err != nil {
+		s.writeUserError(w, r, err)
+		return
+	}
+	info.Id = &prev.ID
+
+	if _, err = s.svcCtx.CoreRpc.UpdateUser(r.Context(), info); err != nil {
+		s.rpcError(w, r, err)
+		return
+	}
+
+	s.writeUser(w, r, http.StatusOK, prev.ID)
+}
+
+// deleteUser revokes the sessions of the user before deleting it
+func (s *Server) deleteUser(w http.ResponseWriter, r *http.Request) {
+	current, ok := s.loadUser(w, r)
+	if !ok {
+		return
+	}
+	if !checkIfMatch(w, r, userResource(baseURL(r), groupScopeFrom(r.Context()), current).Meta.Version) {
+		return
+	}
+
+	if _, err := s.svcCtx.CoreRpc.BlockUserAllToken(r.Context(), &core.UUIDReq{Id: current.GetId()}); err != nil {
+		s.rpcError(w, r, err)
+		return
+	}
+	if _, err := s.svcCtx.CoreRpc.DeleteUser(r.Context(), &core.UUIDsReq{Ids: []string{current.GetId()}}); err != nil {
+		s.rpcError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// loadUser gets the user of the path, writing the error when it is not found
+func (s *Server) loadUser(w http.ResponseWriter, r *http.Request) (*core.UserInfo, bool) {
+	u, err := s.getUserInfo(r.Context(), pathID(r))
+	if err != nil {
+		if errors.Is(err, errNotFound) {
+			writeError(w, http.StatusNotFound, "", "user not found")
+		} else {
+			s.rpcError(w, r, err)
+		}
+		return nil, false
+	}
+	return u, true
+}
+
+// getUserInfo returns errNotFound for unknown users. getUserById answers unknown users as an
+// invalid token since it serves the current user, so the errors are checked here.
+func (s *Server) getUserInfo(ctx context.Context, id string) (*core.UserInfo, error) {
+	if _, err := uuid.FromString(id); err != nil {
+		return nil, errNotFound
+	}
+
+	u, err := s.svcCtx.CoreRpc.GetUserById(ctx, &core.UUIDReq{Id: id})
+	if err != nil {
+		if isNotFound(err) {
+			return nil, errNotFound
+		}
+		if st, ok := status.FromError(err); ok && st.Message() == "Token is invalid" {
+			return nil, errNotFound
+		}
+		return nil, err
+	}
+	return u, nil
+}
+
+func (s *Server) writeUser(w http.ResponseWriter, r *http.Request, code int, id string) {
+	u, err := s.getUserInfo(r.Context(), id)
+	if err != nil {
+		s.rpcError(w, r, err)
+		return
+	}
+
+	res := userResource(baseURL(r), groupScopeFrom(r.Context()), u)
+	writeResource(w, r, code, res, res.Meta)
+}
+
+// checkUserName rejects user names taken by other users, the user names are unique in the tenant
+func (s *Server) checkUserName(w http.ResponseWriter, r *http.Request, userName, id string) bool {
+	if userName == "" {
+		writeError(w, http.StatusBadRequest, "invalidValue", errInvalidUser.Error())
+		return false
+	}
+
+	u, err := s.svcCtx.CoreRpc.GetUserByUsername(r.Context(), &core.UsernameReq{Username: userName})
+	if err != nil {
+		if isNotFound(err) {
+			return true
+		}
+		s.rpcError(w, r, err)
+		return false
+	}
+	if u.GetId() == id {
+		return true
+	}
+
+	writeError(w, http.StatusConflict, "uniqueness", "userName is already taken")
+	return false
+}
+
+func (s *Server) writeUserError(w http.ResponseWriter, r *http.Request, err error) {
+	if errors.Is(err, errInvalidUser) {
+		writeError(w, http.StatusBadRequest, "invalidValue", err.Error())
+		return
+	}
+	s.rpcError(w, r, err)
+}
+
+// userResource converts the user into the SCIM resource, only the groups in the scope of the token are listed
+func userResource(base string, scope groupScope, u *core.UserInfo) *User {
+	res := &User{
+		Schemas:     []string{SchemaUser, SchemaEnterpriseUser},
+		ID:          u.GetId(),
+		UserName:    u.GetUsername(),
+		DisplayName: u.GetNickname(),
+		Active:      pointy.GetPointer(Bool(u.GetStatus() == uint32(common.StatusNormal))),
+		Meta: &Meta{
+			ResourceType: "User",
+			Created:      time.UnixMilli(u.GetCreatedAt()).UTC().Format(time.RFC3339),
+			LastModified: time.UnixMilli(u.GetUpdatedAt()).UTC().Format(time.RFC3339),
+			Location:     base + "/Users/" + u.GetId(),
+			Version:      version(strconv.FormatInt(u.GetUpdatedAt(), 10)),
+		},
+	}
+	if u.GetNickname() != "" {
+		res.Name = &Name{Formatted: u.GetNickname()}
+	}
+	if u.GetEmail() != "" {
+		res.Emails = []MultiValue{{Value: u.GetEmail(), Type: "work", Primary: true}}
+	}
+	if u.GetMobile() != "" {
+		res.PhoneNumbers = []MultiValue{{Value: u.GetMobile(), Type: "mobile", Primary: true}}
+	}
+	if name := u.GetDepartmentName(); name != "" && name != noDepartment {
+		res.Enterprise = &EnterpriseUser{Department: name}
+	}
+	for i, id := range u.RoleIds {
+		if i >= len(u.RoleCodes) || !scope.allows(u.RoleCodes[i]) {
+			continue
+		}
+		group := MultiValue{
+			Value: strconv.FormatUint(id, 10),
+			Ref:   base + "/Groups/" + strconv.FormatUint(id, 10),
+		}
+		if i < len(u.RoleNames) {
+			group.Display = u.RoleNames[i]
+		}
+		res.Groups = append(res.Groups, group)
+	}
+
+	return res
+}
+
+// userInfo converts the user into the request of the RPC, prev is the user before the modification
+// and nil when creating
+func (s *Server) userInfo(ctx context.Context, u *User, prev *User) (*core.UserInfo, error) {
+	if u.UserName == "" {
+		return nil, errInvalidUser
+	}
+
+	info := &core.UserInfo{
+		Username: &u.UserName,
+		Nickname: pointy.GetPointer(nickname(u, prev)),
+	}
+
+	email, mobile := primaryValue(u.Emails), primaryValue(u.PhoneNumbers)
+	if prev == nil {
+		// 新建时空值不传，createUser 会把空邮箱当作已存在的邮箱
+		if email != "" {
+			info.Email = &email
+		}
+		if mobile != "" {
+			info.Mobile = &mobile
+		}
+	} else {
+		info.Email, info.Mobile = &email, &mobile
+	}
+	if u.Active != nil {
+		info.Status = pointy.GetPointer(uint32(common.StatusBanned))
+		if *u.Active {
+			info.Status = pointy.GetPointer(uint32(common.StatusNormal))
+		}
+	}
+
+	switch {
+	case u.Password != "":
+		info.Password = &u.Password
+	case prev == nil:
+		// 由身份提供方管理的用户通过 SSO 登录，本地密码不告知任何人
+		password := make([]byte, 24)
+		if _, err := rand.Read(password); err != nil {
+			return nil, err
+		}
+		info.Password = pointy.GetPointer(hex.EncodeToString(password))
+	}
+
+	var department, prevDepartment string
+	if u.Enterprise != nil {
+		department = u.Enterprise.Department
+	}
+	if prev != nil && prev.Enterprise != nil {
+		prevDepartment = prev.Enterprise.Department
+	}
+	if department != "" && department != prevDepartment {
+		id, err := s.departmentID(ctx, department)
+		if err != nil {
+			return nil, err
+		}
+		info.DepartmentId = id
+	}
+
+	return info, nil
+}
+
+// nickname 优先使用 displayName，只修改了 name 时使用 name
+func nickname(u *User, prev *User) string {
+	name := u.Name.fullName()
+	if prev != nil && u.DisplayName == prev.DisplayName && name != prev.Name.fullName() && name != "" {
+		return name
+	}
+	if u.DisplayName != "" {
+		return u.DisplayName
+	}
+	if name != "" {
+		return name
+	}
+	return u.UserName
+}
+
+func (n *Name) fullName() string {
+	if n == nil {
+		return ""
+	}
+	if n.Formatted != "" {
+		return n.Formatted
+	}
+	return strings.TrimSpace(n.GivenName + " " + n.FamilyName)
+}
+
+// departmentID returns the department with the name, departments are not created by SCIM so
+// unknown departments leave the department of the user unchanged
+func (s *Server) departmentID(ctx context.Context, name string) (*uint64, error) {
+	resp, err := s.svcCtx.CoreRpc.GetDepartmentList(ctx, &core.DepartmentListReq{Page: 1, PageSize: maxCount, Name: &name})
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range resp.Data {
+		if v.GetName() == name {
+			return v.Id, nil
+		}
+	}
+
+	logx.WithContext(ctx).Infow("SCIM department not found", logx.Field("department", name))
+	return nil, nil
+}
+
+func isNotFound(err error) bool {
+	st, ok := status.FromError(err)
+	return ok && st.Message() == i18n.TargetNotFound
+}
+
+func writePatchError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, errNoTarget):
+		writeError(w, http.StatusBadRequest, "noTarget", "path is required")
+	default:
+		writeError(w, http.StatusBadRequest, "invalidPath", err.Error())
+	}
+}
@@ -0,0 +1,276 @@
+package session
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/coder-lulu/newbee-common/v2/config"
+	"github.com/coder-lulu/newbee-common/v2/middleware/keys"
+	"github.com/coder-lulu/newbee-common/v2/orm/ent/entctx/userctx"
+	"github.com/coder-lulu/newbee-common/v2/orm/ent/hooks"
+	"github.com/coder-lulu/newbee-common/v2/utils/uuidx"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/redis/go-redis/v9"
+	"github.com/zeromicro/go-zero/core/errorx"
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/threading"
+	"github.com/zeromicro/go-zero/rest/httpx"
+
+	"github.com/coder-lulu/newbee-core/rpc/coreclient"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+)
+
+const (
+	// RedisSessionTouchPrefix 会话活跃时间刷新的节流键前缀
+	RedisSessionTouchPrefix = "SESSION_TOUCH:"
+	// TouchInterval 同一会话两次刷新活跃时间的最小间隔
+	TouchInterval = time.Minute
+	// RedisTokenRevokedBeforePrefix 用户令牌吊销水位线，值为 Unix 毫秒，签发时间早于该值的令牌均失效。
+	// 由 RPC 的 blockUserAllToken 写入，需与 rpc/internal/utils/dbfunc 中的定义保持一致
+	RedisTokenRevokedBeforePrefix = "TOKEN_REVOKED_BEFORE:"
+	// ClaimIssuedAtMs 令牌的毫秒签发时间。iat 只精确到秒，吊销后同一秒内重新登录的令牌需要用它区分
+	ClaimIssuedAtMs = "iatMs"
+	// ClaimSessionID 登录会话ID，登录时生成，刷新令牌和访问令牌沿用同一会话
+	ClaimSessionID = "sid"
+)
+
+type clientInfoKey struct{}
+
+// ClientInfo 当前请求的客户端信息
+type ClientInfo struct {
+	IP        string
+	UserAgent string
+	Device    string
+	// Token 请求携带的原始令牌，未登录时为空
+	Token string
+	// SessionID 令牌所属的登录会话，认证通过后才会设置
+	SessionID string
+}
+
+// NewID 生成新的登录会话ID
+func NewID() string {
+	return uuidx.NewUUID().String()
+}
+
+// IDFromContext 返回当前令牌所属的会话，没有会话的旧令牌返回新的会话ID
+func IDFromContext(ctx context.Context) string {
+	if id := FromContext(ctx).SessionID; id != "" {
+		return id
+	}
+	return NewID()
+}
+
+// FromContext 获取客户端信息，不存在时返回空值
+func FromContext(ctx context.Context) ClientInfo {
+	if info, ok := ctx.Value(clientInfoKey{}).(ClientInfo); ok {
+		return info
+	}
+	return ClientInfo{}
+}
+
+// NewMiddleware 记录客户端信息并拒绝早于吊销水位线的令牌，resolveIP 解析客户端 IP，只信任可信代理添加的 X-Forwarded-For。
+// 需要在令牌转换中间件之前注册，以便拿到客户端提交的原始令牌。
+func NewMiddleware(rds redis.UniversalClient, resolveIP func(r *http.Request) string) func(next http.HandlerFunc) http.HandlerFunc {
+	return func(next http.HandlerFunc) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			userAgent := r.UserAgent()
+			info := ClientInfo{
+				IP:        resolveIP(r),
+				UserAgent: userAgent,
+				Device:    ParseDevice(userAgent),
+			}
+			info.Token, _ = strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+
+			if info.Token != "" && revoked(r.Context(), rds, info.Token) {
+				httpx.ErrorCtx(r.Context(), w, errorx.NewCodeError(http.StatusUnauthorized, "Token is invalid"))
+				return
+			}
+
+			next(w, r.WithContext(context.WithValue(r.Context(), clientInfoKey{}, info)))
+		}
+	}
+}
+
+// NewTouchMiddleware 记录令牌所属的会话并节流刷新会话的最后活跃时间和访问 IP。
+// 需要在统一中间件链之后注册，只有认证通过的令牌才会刷新，伪造的令牌无法改写其它会话的记录。
+func NewTouchMiddleware(coreRpc coreclient.Core, rds redis.UniversalClient) func(next http.HandlerFunc) http.HandlerFunc {
+	return func(next http.HandlerFunc) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			info := FromContext(r.Context())
+			if info.Token == "" {
+				next(w, r)
+				return
+			}
+
+			claims, ok := authenticated(r.Context(), info.Token)
+			if !ok {
+				next(w, r)
+				return
+			}
+
+			touch(coreRpc, rds, info)
+
+			info.SessionID, _ = claims[ClaimSessionID].(string)
+			next(w, r.WithContext(context.WithValue(r.Context(), clientInfoKey{}, info)))
+		}
+	}
+}
+
+// authenticated 检查原始令牌是否就是认证中间件验签通过的令牌，返回令牌的声明
+func authenticated(ctx context.Context, tokenString string) (jwt.MapClaims, bool) {
+	userId, err := userctx.GetUserIDFromCtx(ctx)
+	if err != nil || userId == "" {
+		return nil, false
+	}
+
+	claims := jwt.MapClaims{}
+	if _, _, err = jwt.NewParser().ParseUnverified(tokenString, claims); err != nil {
+		return nil, false
+	}
+
+	return claims, claims[keys.JWTUserID] == userId
+}
+
+// revoked 检查令牌是否早于用户的吊销水位线。这里只读取声明，签名由后续认证中间件校验
+func revoked(ctx context.Context, rds redis.UniversalClient, tokenString string) bool {
+	claims := jwt.MapClaims{}
+	if _, _, err := jwt.NewParser().ParseUnverified(tokenString, claims); err != nil {
+		return false
+	}
+
+	return RevokedBefore(ctx, rds, claims)
+}
+
+// Revoked 检查已验签的令牌是否在黑名单中或早于用户的吊销水位线，所有验证令牌的入口都需要检查。
+// 读取 Redis 失败时按已吊销处理
+func Revoked(ctx context.Context, rds redis.UniversalClient, tokenString string, claims jwt.MapClaims) bool {
+	blocked, err := rds.Exists(ctx, config.RedisTokenPrefix+tokenString).Result()
+	if err != nil {
+		logx.WithContext(ctx).Errorw("failed to check token blacklist", logx.Field("detail", err.Error()))
+		return true
+	}
+
+	return blocked > 0 || RevokedBefore(ctx, rds, claims)
+}
+
+// RevokedBefore 检查令牌是否早于用户的吊销水位线
+func RevokedBefore(ctx context.Context, rds redis.UniversalClient, claims jwt.MapClaims) bool {
+	userId, _ := claims[keys.JWTUserID].(string)
+	if userId == "" {
+		return false
+	}
+
+	val, err := rds.Get(ctx, RedisTokenRevokedBeforePrefix+userId).Result()
+	if err != nil {
+		if err != redis.Nil {
+			logx.WithContext(ctx).Errorw("failed to get token revocation watermark", logx.Field("detail", err.Error()))
+		}
+		return false
+	}
+
+	revokedAt, err := strconv.ParseInt(val, 10, 64)
+	if err != nil {
+		return false
+	}
+
+	return issuedBefore(claims, revokedAt)
+}
+
+// issuedBefore 判断令牌是否在 revokedAt（Unix 毫秒）之前签发，吊销之后签发的令牌不受影响。
+// 没有毫秒签发时间的令牌只在整秒 iat 确定早于吊销时间时才算在之前签发，同一秒内签发的旧令牌由黑名单拦截
+func issuedBefore(claims jwt.MapClaims, revokedAt int64) bool {
+	switch v := claims[ClaimIssuedAtMs].(type) {
+	case float64:
+		return int64(v) < revokedAt
+	case json.Number:
+		if ms, err := v.Int64(); err == nil {
+			return ms < revokedAt
+		}
+	}
+
+	iat, err := claims.GetIssuedAt()
+	if err != nil || iat == nil {
+		return false
+	}
+	return (iat.Unix()+1)*1000 <= revokedAt
+}
+
+func touch(coreRpc coreclient.Core, rds redis.UniversalClient, info ClientInfo) {
+	digest := sha256.Sum256([]byte(info.Token))
+	key := RedisSessionTouchPrefix + hex.EncodeToString(digest[:])
+
+	threading.GoSafe(func() {
+		ctx := hooks.NewSystemContext(context.Background())
+		ok, err := rds.SetNX(ctx, key, "1", TouchInterval).Result()
+		if err != nil || !ok {
+			return
+		}
+
+		_, err = coreRpc.TouchToken(ctx, &core.TokenTouchReq{
+			Token:     info.Token,
+			Ip:        &info.IP,
+			UserAgent: &info.UserAgent,
+		})
+		if err != nil {
+			logx.Errorw("failed to touch session", logx.Field("detail", err.Error()))
+		}
+	})
+}
+
+// ParseDevice 从 User-Agent 中解析出简要的设备描述，例如 "Chrome on Windows"
+func ParseDevice(userAgent string) string {
+	if userAgent == "" {
+		return ""
+	}
+
+	ua := strings.ToLower(userAgent)
+
+	var platform string
+	switch {
+	case strings.Contains(ua, "android"):
+		platform = "Android"
+	case strings.Contains(ua, "iphone"), strings.Contains(ua, "ipad"):
+		platform = "iOS"
+	case strings.Contains(ua, "windows"):
+		platform = "Windows"
+	case strings.Contains(ua, "mac os"), strings.Contains(ua, "macintosh"):
+		platform = "macOS"
+	case strings.Contains(ua, "linux"):
+		platform = "Linux"
+	}
+
+	var browser string
+	switch {
+	case strings.Contains(ua, "micromessenger"):
+		browser = "WeChat"
+	case strings.Contains(ua, "edg/"):
+		browser = "Edge"
+	case strings.Contains(ua, "opr/"), strings.Contains(ua, "opera"):
+		browser = "Opera"
+	case strings.Contains(ua, "firefox/"):
+		browser = "Firefox"
+	case strings.Contains(ua, "chrome/"):
+		browser = "Chrome"
+	case strings.Contains(ua, "safari/"):
+		browser = "Safari"
+	case strings.Contains(ua, "curl/"), strings.Contains(ua, "postman"), strings.Contains(ua, "okhttp"):
+		browser = "API Client"
+	}
+
+	switch {
+	case browser != "" && platform != "":
+		return browser + " on " + platform
+	case browser != "":
+		return browser
+	case platform != "":
+		return platform
+	default:
+		return "Unknown"
+	}
+}
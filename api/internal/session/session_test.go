@@ -0,0 +1,104 @@
+package session
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// parseClaims 签发并解析令牌，声明的数值类型与中间件读到的一致
+func parseClaims(t *testing.T, claims jwt.MapClaims) jwt.MapClaims {
+	t.Helper()
+	signed, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte("secret"))
+	if err != nil {
+		t.Fatalf("sign token: %v", err)
+	}
+	parsed := jwt.MapClaims{}
+	if _, _, err = jwt.NewParser().ParseUnverified(signed, parsed); err != nil {
+		t.Fatalf("parse token: %v", err)
+	}
+	return parsed
+}
+
+func TestIssuedBefore(t *testing.T) {
+	logout := time.Date(2025, 3, 1, 8, 0, 0, 400*int(time.Millisecond), time.UTC)
+	revokedAt := logout.UnixMilli()
+
+	tests := []struct {
+		name     string
+		issuedAt time.Time
+		legacy   bool
+		want     bool
+	}{
+		{name: "issued in an earlier second", issuedAt: logout.Add(-2 * time.Second), want: true},
+		{name: "issued in the same second before logout", issuedAt: logout.Add(-300 * time.Millisecond), want: true},
+		// 退出登录后同一秒内重新登录，新令牌不能被吊销
+		{name: "re-login in the same second", issuedAt: logout.Add(200 * time.Millisecond), want: false},
+		{name: "issued at the revocation instant", issuedAt: logout, want: false},
+		{name: "issued in a later second", issuedAt: logout.Add(time.Second), want: false},
+		{name: "legacy token of an earlier second", issuedAt: logout.Add(-time.Second), legacy: true, want: true},
+		{name: "legacy token of the same second", issuedAt: logout.Add(-300 * time.Millisecond), legacy: true, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			claims := jwt.MapClaims{"iat": tt.issuedAt.Unix()}
+			if !tt.legacy {
+				claims[ClaimIssuedAtMs] = tt.issuedAt.UnixMilli()
+			}
+			if got := issuedBefore(parseClaims(t, claims), revokedAt); got != tt.want {
+				t.Errorf("issuedBefore() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIssuedBeforeWithoutIssueTime(t *testing.T) {
+	if issuedBefore(parseClaims(t, jwt.MapClaims{"userId": "u"}), time.Now().UnixMilli()) {
+		t.Error("a token without issue time must be left to the blacklist")
+	}
+}
+
+func TestParseDevice(t *testing.T) {
+	tests := map[string]string{
+		"": "",
+		"Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0 Safari/537.36":  "Chrome on Windows",
+		"Mozilla/5.0 (iPhone; CPU iPhone OS 17_0 like Mac OS X) AppleWebKit/605.1.15 Version/17.0 Mobile Safari/604.1": "Safari on iOS",
+		"curl/8.4.0": "API Client",
+	}
+	for ua, want := range tests {
+		if got := ParseDevice(ua); got != want {
+			t.Errorf("ParseDevice(%q) = %q, want %q", ua, got, want)
+		}
+	}
+}
+
+func TestMiddlewareClientIP(t *testing.T) {
+	var got ClientInfo
+	handler := NewMiddleware(nil, func(r *http.Request) string { return "203.0.113.7" })(func(w http.ResponseWriter, r *http.Request) {
+		got = FromContext(r.Context())
+	})
+
+	// 客户端伪造的 X-Forwarded-For 不作为会话 IP
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	r.Header.Set("X-Forwarded-For", "198.51.100.1")
+	r.Header.Set("User-Agent", "Mozilla/5.0 (Windows NT 10.0; Win64; x64) Chrome/120.0")
+	handler(httptest.NewRecorder(), r)
+
+	if got.IP != "203.0.113.7" || got.Device != "Chrome on Windows" || got.Token != "" {
+		t.Errorf("client info = %+v", got)
+	}
+}
+
+func TestAuthenticatedWithoutUser(t *testing.T) {
+	forged := parseClaims(t, jwt.MapClaims{"uid": "u"})
+	signed, _ := jwt.NewWithClaims(jwt.SigningMethodHS256, forged).SignedString([]byte("forged"))
+
+	// 认证中间件没有写入用户时不刷新会话
+	if _, ok := authenticated(context.Background(), signed); ok {
+		t.Error("an unauthenticated token was treated as authenticated")
+	}
+}
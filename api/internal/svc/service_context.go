@@ -24,6 +24,7 @@ import (
 	apicasbin "github.com/coder-lulu/newbee-core/api/internal/casbin"
 	"github.com/coder-lulu/newbee-core/api/internal/config"
 	i18n2 "github.com/coder-lulu/newbee-core/api/internal/i18n"
+	"github.com/coder-lulu/newbee-core/api/internal/jwks"
 	"github.com/coder-lulu/newbee-core/rpc/coreclient"
 	"github.com/coder-lulu/newbee-core/rpc/types/core"
 	"github.com/mojocn/base64Captcha"
@@ -47,8 +48,10 @@ type ServiceContext struct {
 	McmsRpc        mcmsclient.Mcms
 	Redis          redis.UniversalClient
 	Casbin         *casbin.Enforcer
+	CasbinQuerier  *apicasbin.RpcCasbinRuleQuerier
 	Trans          *i18n.Translator
 	Captcha        *base64Captcha.Captcha
+	JwtKeys        *jwks.KeyManager
 
 	// 统一中间件链
 	ManagedMiddlewareChain []rest.Middleware
@@ -453,6 +456,7 @@ func NewServiceContext(c config.Config) *ServiceContext {
 		JobRpc:         jobclient.NewJob(zrpc.NewClientIfEnable(c.JobRpc)),
 		Redis:          rds,
 		Casbin:         cbn,
+		CasbinQuerier:  rpcQuerier,
 		Trans:          trans,
 		Captcha:        captchaInstance,
 		coreRpcHealthy: 1, // 默认假设健康
@@ -462,6 +466,8 @@ func NewServiceContext(c config.Config) *ServiceContext {
 	jwtSecret := c.Middleware.Auth.AccessSecret
 	// 确保routes.go能正常工作
 	svcCtx.Config.Auth.AccessSecret = jwtSecret
+	// 令牌签名密钥，非对称模式下共享密钥只在服务内部使用
+	svcCtx.JwtKeys = jwks.MustNewKeyManager(c.JwtKeyConf, jwtSecret)
 
 	// 4. 准备审计配置 - 核心服务需要自己处理审计日志
 	middlewareConfig := c.Middleware
@@ -202,6 +202,45 @@ type RoleAuthReq struct {
 	UserIds []string `json:"userIds"`
 }
 
+// Set role parents request | 设置父角色请求
+// swagger:model RoleParentsReq
+type RoleParentsReq struct {
+	// Role ID | 角色ID
+	RoleId uint64 `json:"roleId" validate:"number"`
+	// Parent role IDs, replaces all parents and clears them when empty | 父角色ID，替换全部父角色，为空时清除
+	ParentIds []uint64 `json:"parentIds,optional"`
+}
+
+// The API granted to the role | 角色获得的接口
+// swagger:model RoleEffectiveApi
+type RoleEffectiveApi struct {
+	// API path | 接口路径
+	Path string `json:"path"`
+	// API method | 请求方法
+	Method string `json:"method"`
+	// The role granting the API, itself or an ancestor | 授予接口的角色，自身或祖先角色
+	SourceRole string `json:"sourceRole"`
+}
+
+// The effective permissions of the role | 角色的有效权限
+// swagger:model RoleEffectivePermissionsInfo
+type RoleEffectivePermissionsInfo struct {
+	// The role and all ancestor role codes | 角色及全部祖先角色代码
+	RoleCodes []string `json:"roleCodes"`
+	// The APIs granted to the role | 角色获得的接口
+	Apis []RoleEffectiveApi `json:"apis"`
+	// The menu IDs granted to the role | 角色获得的菜单ID
+	MenuIds []uint64 `json:"menuIds"`
+}
+
+// The effective permissions response | 角色有效权限返回
+// swagger:model RoleEffectivePermissionsResp
+type RoleEffectivePermissionsResp struct {
+	BaseDataInfo
+	// The effective permissions | 有效权限
+	Data RoleEffectivePermissionsInfo `json:"data"`
+}
+
 // The response data of user information | 用户信息
 // swagger:model UserInfo
 type UserInfo struct {
@@ -824,6 +863,10 @@ type ApiInfo struct {
 	IsRequired *bool `json:"isRequired,optional"`
 	// Service name | 服务名称
 	ServiceName *string `json:"serviceName,optional"`
+	// The service no longer registers the API | 服务已不再登记该接口
+	IsDeprecated *bool `json:"isDeprecated,optional"`
+	// Deprecated time | 废弃时间
+	DeprecatedAt *int64 `json:"deprecatedAt,optional"`
 }
 
 // The response data of API list | API列表数据
@@ -863,6 +906,8 @@ type ApiListReq struct {
 	IsRequired *bool `json:"isRequired,optional"`
 	// Service name | 服务名称
 	ServiceName *string `json:"serviceName,optional"`
+	// Whether the API is deprecated | 是否已废弃
+	IsDeprecated *bool `json:"isDeprecated,optional"`
 }
 
 // API information response | API信息返回体
@@ -897,6 +942,27 @@ type CreateOrUpdateApiAuthorityReq struct {
 	// API authorization list | API授权列表数据
 	// Required: true
 	Data []ApiAuthorityInfo `json:"data"`
+	// The version returned by the role's API authorization list, the update is rejected if the authorization has been changed since | 获取角色API权限时返回的版本号，权限已被他人修改时拒绝更新
+	Version *string `json:"version,optional"`
+}
+
+// The response data of api authorization changes | API授权变更返回数据
+// swagger:model ApiAuthorityChangeResp
+type ApiAuthorityChangeResp struct {
+	BaseDataInfo
+	// The api authorization changes | API授权变更数据
+	Data ApiAuthorityChangeInfo `json:"data"`
+}
+
+// The data of api authorization changes | API授权变更数据
+// swagger:model ApiAuthorityChangeInfo
+type ApiAuthorityChangeInfo struct {
+	// The new version of the role's API authorization | 角色API权限的新版本号
+	Version string `json:"version"`
+	// The added api authorization | 新增的API权限
+	Added []ApiAuthorityInfo `json:"added"`
+	// The removed api authorization | 移除的API权限
+	Removed []ApiAuthorityInfo `json:"removed"`
 }
 
 // The response data of api authorization list | API授权列表返回数据
@@ -913,6 +979,76 @@ type ApiAuthorityListInfo struct {
 	BaseListInfo
 	// The api authorization list data | API授权列表数据
 	Data []ApiAuthorityInfo `json:"data"`
+	// The version of the role's API authorization | 角色API权限的版本号
+	Version string `json:"version"`
+}
+
+// Export policy bundle request | 导出权限包请求
+// swagger:model PolicyBundleExportReq
+type PolicyBundleExportReq struct {
+	// Bundle format, yaml or json, default yaml | 权限包格式，yaml 或 json，默认 yaml
+	Format *string `json:"format,optional" validate:"omitempty,oneof=yaml json"`
+	// Role codes to export, empty to export all roles | 导出的角色代码，为空时导出全部角色
+	RoleCodes []string `json:"roleCodes,optional"`
+}
+
+// The response data of policy bundle | 权限包返回数据
+// swagger:model PolicyBundleResp
+type PolicyBundleResp struct {
+	BaseDataInfo
+	// The policy bundle | 权限包
+	Data PolicyBundleInfo `json:"data"`
+}
+
+// The data of policy bundle | 权限包数据
+// swagger:model PolicyBundleInfo
+type PolicyBundleInfo struct {
+	// Bundle format | 权限包格式
+	Format string `json:"format"`
+	// Bundle content | 权限包内容
+	Content string `json:"content"`
+}
+
+// Import policy bundle request | 导入权限包请求
+// swagger:model PolicyBundleImportReq
+type PolicyBundleImportReq struct {
+	// Bundle format, yaml or json, default yaml | 权限包格式，yaml 或 json，默认 yaml
+	Format *string `json:"format,optional" validate:"omitempty,oneof=yaml json"`
+	// Bundle content | 权限包内容
+	// required : true
+	Content string `json:"content" validate:"required"`
+	// Only return the changes without applying them | 只返回变更计划，不应用
+	DryRun bool `json:"dryRun,optional"`
+}
+
+// The response data of policy bundle import | 导入权限包返回数据
+// swagger:model PolicyBundleImportResp
+type PolicyBundleImportResp struct {
+	BaseDataInfo
+	// The import result | 导入结果
+	Data PolicyBundleImportInfo `json:"data"`
+}
+
+// The data of policy bundle import | 导入权限包结果
+// swagger:model PolicyBundleImportInfo
+type PolicyBundleImportInfo struct {
+	// Whether the changes are applied | 变更是否已应用
+	Applied bool `json:"applied"`
+	// The changes | 变更列表
+	Changes []PolicyBundleChangeInfo `json:"changes"`
+}
+
+// The change of policy bundle import | 导入权限包的变更
+// swagger:model PolicyBundleChangeInfo
+type PolicyBundleChangeInfo struct {
+	// Change kind: role, menu, parent, data_scope, policy | 变更类型
+	Kind string `json:"kind"`
+	// Change action: create, update, delete | 变更操作
+	Action string `json:"action"`
+	// Role code | 角色代码
+	Role string `json:"role"`
+	// Change target: menu name, parent role code, data scope or "METHOD path" | 变更对象
+	Target string `json:"target"`
 }
 
 // Create or update menu authorization information request params | 创建或更新菜单授权信息参数
@@ -1059,6 +1195,8 @@ type OauthProviderInfo struct {
 	FailureCount *int32 `json:"failureCount,optional"`
 	// Last used at | 最后使用时间
 	LastUsedAt *int64 `json:"lastUsedAt,optional"`
+	// The system template the provider was enabled from | 来源的系统模板ID
+	TemplateId *uint64 `json:"templateId,optional"`
 }
 
 // The response data of oauth provider list | 第三方列表数据
@@ -1097,15 +1235,17 @@ type OauthProviderInfoResp struct {
 // Oauth log in request | Oauth 登录请求
 // swagger:model OauthLoginReq
 type OauthLoginReq struct {
-	// State code to avoid hack | 状态码，请求前后相同避免安全问题
-	// required : true
+	// Deprecated: the state is issued and signed by core, the value is ignored | 已废弃：state 由 core 生成并签名，该值不再使用
 	// max length : 30
-	State string `json:"state" validate:"required,max=30"`
+	State string `json:"state,optional" validate:"omitempty,max=30"`
 	// Provider name | 提供商名字
 	// Example: google
 	// required : true
 	// max length : 40
 	Provider string `json:"provider" validate:"required,max=40"`
+	// Tenant ID, providers are configured per tenant | 租户ID，提供商按租户配置
+	// required : true
+	TenantId string `json:"tenantId" validate:"required"`
 	// User ID for binding (optional) | 绑定的用户ID（可选）
 	UserId *string `json:"userId,optional"`
 	// Client IP address | 客户端IP地址
@@ -1145,6 +1285,9 @@ type OauthCallbackReq struct {
 type UserOauthProviderListReq struct {
 	// Whether only enabled providers | 是否只获取启用的提供商
 	EnabledOnly *bool `json:"enabledOnly,optional"`
+	// Tenant ID, only the providers of the tenant are listed | 租户ID，只返回该租户的提供商
+	// required : true
+	TenantId string `json:"tenantId" validate:"required"`
 }
 
 // OAuth provider list for user response | 用户OAuth提供商列表响应
@@ -1373,6 +1516,51 @@ type OauthProviderTestResult struct {
 	ResponseTime int64 `json:"responseTime"`
 }
 
+// OAuth claim mapping preview request | OAuth声明映射预览请求
+// swagger:model OauthClaimMappingPreviewReq
+type OauthClaimMappingPreviewReq struct {
+	// Provider ID, the saved extra config is used when extraConfig is empty | 提供商ID，extraConfig 为空时使用已保存的扩展配置
+	ProviderId *uint64 `json:"providerId,optional"`
+	// Extra config JSON containing claim_mapping | 包含 claim_mapping 的扩展配置JSON
+	ExtraConfig *string `json:"extraConfig,optional"`
+	// Sample userinfo JSON | 用户信息样例JSON
+	// required : true
+	Sample string `json:"sample" validate:"required"`
+}
+
+// OAuth claim mapping preview response | OAuth声明映射预览响应
+// swagger:model OauthClaimMappingPreviewResp
+type OauthClaimMappingPreviewResp struct {
+	BaseDataInfo
+	// Mapped user info | 映射后的用户信息
+	Data OauthClaimMappingPreviewInfo `json:"data"`
+}
+
+// OAuth claim mapping preview result | OAuth声明映射预览结果
+type OauthClaimMappingPreviewInfo struct {
+	// User ID at the provider | 第三方用户ID
+	Id string `json:"id"`
+	// Username | 用户名
+	Username string `json:"username"`
+	// Nickname | 昵称
+	Nickname string `json:"nickname"`
+	// Email | 邮箱
+	Email string `json:"email"`
+	// Phone number | 手机号
+	Phone string `json:"phone"`
+	// Avatar | 头像
+	Avatar string `json:"avatar"`
+	// Groups | 用户组
+	Groups []string `json:"groups"`
+}
+
+// The response of the provider webhook | 第三方平台事件推送返回体
+// swagger:model OauthWebhookResp
+type OauthWebhookResp struct {
+	// Challenge of url verification requests | 回调地址校验返回的 challenge
+	Challenge string `json:"challenge,omitempty"`
+}
+
 // OAuth statistics request | OAuth统计请求
 // swagger:model OauthStatisticsReq
 type OauthStatisticsReq struct {
@@ -1468,6 +1656,14 @@ type TokenInfo struct {
 	Username *string `json:"username,optional"`
 	// ExpiredAt | 过期时间
 	ExpiredAt *int64 `json:"expiredAt,optional"`
+	// Device | 登录设备
+	Device *string `json:"device,optional"`
+	// User agent | 客户端 User-Agent
+	UserAgent *string `json:"userAgent,optional"`
+	// IP | 最后访问 IP
+	Ip *string `json:"ip,optional"`
+	// Last seen time | 最后活跃时间
+	LastSeenAt *int64 `json:"lastSeenAt,optional"`
 }
 
 // The response data of token list | 令牌列表数据
@@ -1508,6 +1704,64 @@ type TokenInfoResp struct {
 	Data TokenInfo `json:"data"`
 }
 
+// The session information | 会话信息
+// swagger:model SessionInfo
+type SessionInfo struct {
+	// ID
+	Id string `json:"id"`
+	// Create date | 登录时间
+	CreatedAt int64 `json:"createdAt,optional"`
+	// Source | 登录来源
+	Source string `json:"source,optional"`
+	// Device | 登录设备
+	Device string `json:"device,optional"`
+	// User agent | 客户端 User-Agent
+	UserAgent string `json:"userAgent,optional"`
+	// IP | 最后访问 IP
+	Ip string `json:"ip,optional"`
+	// Last seen time | 最后活跃时间
+	LastSeenAt int64 `json:"lastSeenAt,optional"`
+	// ExpiredAt | 过期时间
+	ExpiredAt int64 `json:"expiredAt,optional"`
+	// Whether it is the current session | 是否为当前会话
+	Current bool `json:"current"`
+}
+
+// The response data of session list | 会话列表数据
+// swagger:model SessionListResp
+type SessionListResp struct {
+	BaseDataInfo
+	// Session list data | 会话列表数据
+	Data SessionListInfo `json:"data"`
+}
+
+// Session list data | 会话列表数据
+// swagger:model SessionListInfo
+type SessionListInfo struct {
+	BaseListInfo
+	// The session list data | 会话列表数据
+	Data []SessionInfo `json:"data"`
+}
+
+// Get user session list request params | 用户会话列表请求参数
+// swagger:model UserSessionListReq
+type UserSessionListReq struct {
+	PageInfo
+	// User's UUID | 用户的UUID
+	// required : true
+	Uuid string `json:"uuid" validate:"required,uuid"`
+}
+
+// Revoke user sessions request params | 注销用户会话请求参数
+// swagger:model UserSessionRevokeReq
+type UserSessionRevokeReq struct {
+	// User's UUID | 用户的UUID
+	// required : true
+	Uuid string `json:"uuid" validate:"required,uuid"`
+	// Session IDs, revoke all sessions if empty | 会话ID，为空时注销全部会话
+	Ids []string `json:"ids,optional"`
+}
+
 // The response data of department information | 部门信息
 // swagger:model DepartmentInfo
 type DepartmentInfo struct {
@@ -2171,6 +2425,12 @@ type AuditLogInfo struct {
 	ErrorMessage *string `json:"errorMessage,optional"`
 	// Metadata | 元数据
 	Metadata map[string]interface{} `json:"metadata,optional"`
+	// Sequence number in the hash chain | 哈希链序号
+	Seq *uint64 `json:"seq,optional"`
+	// Hash of the previous record | 上一条记录的哈希
+	PrevHash *string `json:"prevHash,optional"`
+	// Hash of this record | 本条记录的哈希
+	Hash *string `json:"hash,optional"`
 }
 
 // The response data of audit log list | 审计日志列表数据
@@ -2271,6 +2531,95 @@ type AuditLogStatsItem struct {
 	Percentage float64 `json:"percentage"`
 }
 
+// Audit log chain verification response | 审计日志哈希链校验返回值
+// swagger:model AuditLogVerifyResp
+type AuditLogVerifyResp struct {
+	BaseDataInfo
+	// Verification result | 校验结果
+	Data AuditLogVerifyInfo `json:"data"`
+}
+
+// Audit log chain verification result | 审计日志哈希链校验结果
+// swagger:model AuditLogVerifyInfo
+type AuditLogVerifyInfo struct {
+	// Whether the chain is intact | 哈希链是否完整
+	Valid bool `json:"valid"`
+	// Number of checked records | 校验的记录数
+	Checked uint64 `json:"checked"`
+	// Sequence number of the chain head | 链头序号
+	HeadSeq uint64 `json:"headSeq"`
+	// Hash of the chain head | 链头哈希
+	HeadHash string `json:"headHash"`
+	// Records up to this sequence number were pruned by retention policy | 该序号及之前的记录已按保留策略清理
+	PrunedToSeq uint64 `json:"prunedToSeq"`
+	// Issues found | 发现的问题
+	Issues []AuditLogChainIssue `json:"issues"`
+}
+
+// Audit log archive list request params | 审计日志归档列表请求参数
+// swagger:model AuditLogArchiveListReq
+type AuditLogArchiveListReq struct {
+	PageInfo
+	// Start time | 开始时间
+	StartTime *int64 `json:"startTime,optional"`
+	// End time | 结束时间
+	EndTime *int64 `json:"endTime,optional"`
+}
+
+// Audit log archive list response | 审计日志归档列表返回值
+// swagger:model AuditLogArchiveListResp
+type AuditLogArchiveListResp struct {
+	BaseDataInfo
+	// Archive list data | 归档列表数据
+	Data AuditLogArchiveListInfo `json:"data"`
+}
+
+// Audit log archive list data | 审计日志归档列表数据
+// swagger:model AuditLogArchiveListInfo
+type AuditLogArchiveListInfo struct {
+	BaseListInfo
+	// Archive list | 归档列表
+	Data []AuditLogArchiveInfo `json:"data"`
+}
+
+// Audit log archive manifest entry | 审计日志归档清单项
+// swagger:model AuditLogArchiveInfo
+type AuditLogArchiveInfo struct {
+	// ID
+	Id string `json:"id"`
+	// Archived time | 归档时间
+	CreatedAt int64 `json:"createdAt"`
+	// First sequence number | 起始序号
+	FromSeq uint64 `json:"fromSeq"`
+	// Last sequence number | 结束序号
+	ToSeq uint64 `json:"toSeq"`
+	// Record count | 记录数
+	Count int64 `json:"count"`
+	// Creation time of the earliest record | 最早记录时间
+	StartAt int64 `json:"startAt"`
+	// Creation time of the latest record | 最晚记录时间
+	EndAt int64 `json:"endAt"`
+	// Blob store | 存储类型
+	Store string `json:"store"`
+	// Object key | 对象键
+	ObjectKey string `json:"objectKey"`
+	// Object size in bytes | 对象大小(字节)
+	Size int64 `json:"size"`
+	// SHA-256 checksum | SHA-256 校验和
+	Checksum string `json:"checksum"`
+}
+
+// Audit log chain issue | 审计日志哈希链问题
+// swagger:model AuditLogChainIssue
+type AuditLogChainIssue struct {
+	// Sequence number | 序号
+	Seq uint64 `json:"seq"`
+	// Issue type | 问题类型
+	Type string `json:"type"`
+	// Detail | 详情
+	Detail string `json:"detail"`
+}
+
 // The response data of tenant information | 租户信息
 // swagger:model TenantInfo
 type TenantInfo struct {
@@ -2368,6 +2717,188 @@ type TenantInitReq struct {
 	AdminPassword *string `json:"adminPassword,optional" validate:"omitempty,min=6,max=30"`
 	// Admin Email | 管理员邮箱
 	AdminEmail *string `json:"adminEmail,optional" validate:"omitempty,email,max=100"`
+	// Initialization template name, empty means the base template | 初始化模板名称，为空时使用基础模板
+	// max length : 50
+	Template *string `json:"template,optional" validate:"omitempty,max=50"`
+	// Only plan what would be created | 试运行，仅列出将要创建的数据
+	DryRun *bool `json:"dryRun,optional"`
+}
+
+// Tenant initialization response | 租户初始化返回体
+// swagger:model TenantInitResp
+type TenantInitResp struct {
+	BaseDataInfo
+	// The queued job | 初始化任务
+	Data TenantInitJobIdInfo `json:"data"`
+}
+
+// The queued job | 初始化任务
+// swagger:model TenantInitJobIdInfo
+type TenantInitJobIdInfo struct {
+	// Job ID, 0 when the tenant was initialized synchronously | 任务ID，同步初始化时为0
+	JobId uint64 `json:"jobId"`
+}
+
+// The progress of a plugin | 插件执行进度
+// swagger:model TenantInitPluginProgress
+type TenantInitPluginProgress struct {
+	// Plugin name | 插件名称
+	Name string `json:"name"`
+	// Status: pending, running, success, failed, skipped, rolledback | 状态
+	Status string `json:"status"`
+	// Error message | 错误信息
+	Error string `json:"error,optional"`
+	// Number of runs | 执行次数
+	Attempts uint32 `json:"attempts"`
+	// Start time | 开始时间
+	StartedAt *int64 `json:"startedAt,optional"`
+	// Finish time | 结束时间
+	FinishedAt *int64 `json:"finishedAt,optional"`
+}
+
+// What a dry run would create | 试运行计划项
+// swagger:model TenantInitPlanItem
+type TenantInitPlanItem struct {
+	// Plugin name | 插件名称
+	Plugin string `json:"plugin"`
+	// Component | 组件
+	Component string `json:"component"`
+	// Action: create, skip | 操作
+	Action string `json:"action"`
+	// Number of records | 记录数量
+	Count uint64 `json:"count"`
+	// Record names | 记录名称
+	Items []string `json:"items,optional"`
+}
+
+// Tenant initialization job information | 租户初始化任务信息
+// swagger:model TenantInitJobInfo
+type TenantInitJobInfo struct {
+	// Job ID | 任务ID
+	Id uint64 `json:"id"`
+	// Create date | 创建日期
+	CreatedAt int64 `json:"createdAt"`
+	// Update date | 更新日期
+	UpdatedAt int64 `json:"updatedAt"`
+	// Tenant ID | 租户ID
+	TenantId uint64 `json:"tenantId"`
+	// Initialization template | 初始化模板
+	Template string `json:"template"`
+	// Mode: full, repair | 初始化模式
+	Mode string `json:"mode"`
+	// Whether it is a dry run | 是否为试运行
+	DryRun bool `json:"dryRun"`
+	// Status: pending, running, success, failed | 任务状态
+	Status string `json:"status"`
+	// Number of runs | 执行次数
+	Attempts uint32 `json:"attempts"`
+	// Error message of the last run | 最近一次执行的错误信息
+	Error string `json:"error,optional"`
+	// Start time of the last run | 最近一次开始时间
+	StartedAt *int64 `json:"startedAt,optional"`
+	// Finish time of the last run | 最近一次结束时间
+	FinishedAt *int64 `json:"finishedAt,optional"`
+	// Progress of the plugins | 插件执行进度
+	Plugins []TenantInitPluginProgress `json:"plugins"`
+	// The plan of a dry run | 试运行计划
+	Plan []TenantInitPlanItem `json:"plan,optional"`
+}
+
+// Tenant initialization job information response | 租户初始化任务信息返回体
+// swagger:model TenantInitJobInfoResp
+type TenantInitJobInfoResp struct {
+	BaseDataInfo
+	// Tenant initialization job information | 租户初始化任务数据
+	Data TenantInitJobInfo `json:"data"`
+}
+
+// Tenant initialization job list request | 租户初始化任务列表请求参数
+// swagger:model TenantInitJobListReq
+type TenantInitJobListReq struct {
+	PageInfo
+	// Tenant ID | 租户ID
+	TenantId *uint64 `json:"tenantId,optional"`
+	// Status | 任务状态
+	// max length : 20
+	Status *string `json:"status,optional" validate:"omitempty,max=20"`
+}
+
+// Tenant initialization job list response | 租户初始化任务列表返回体
+// swagger:model TenantInitJobListResp
+type TenantInitJobListResp struct {
+	BaseDataInfo
+	// Tenant initialization job list data | 租户初始化任务列表数据
+	Data TenantInitJobListInfo `json:"data"`
+}
+
+// Tenant initialization job list data | 租户初始化任务列表数据
+// swagger:model TenantInitJobListInfo
+type TenantInitJobListInfo struct {
+	BaseListInfo
+	// The job list data | 任务列表数据
+	Data []TenantInitJobInfo `json:"data"`
+}
+
+// Retry the job request | 重试初始化任务请求参数
+// swagger:model TenantInitJobRetryReq
+type TenantInitJobRetryReq struct {
+	// Job ID | 任务ID
+	Id uint64 `json:"id" validate:"number"`
+	// Admin password, needed when the admin user was not created | 管理员密码，管理员未创建时需重新传入
+	// min length : 6
+	// max length : 30
+	AdminPassword *string `json:"adminPassword,optional" validate:"omitempty,min=6,max=30"`
+}
+
+// Tenant doctor request | 租户诊断请求
+// swagger:model TenantDoctorReq
+type TenantDoctorReq struct {
+	// Tenant ID, check all tenants when empty | 租户ID，为空时检查全部租户
+	TenantId *uint64 `json:"tenantId,optional"`
+}
+
+// Tenant data integrity finding | 租户数据完整性问题
+// swagger:model TenantDoctorFinding
+type TenantDoctorFinding struct {
+	// Tenant ID | 租户ID
+	TenantId uint64 `json:"tenantId"`
+	// Finding code: missing_admin_role, missing_admin_user, user_without_department, user_without_role, dangling_role_menu, stale_api_rule, stale_user_grouping | 问题代码
+	Code string `json:"code"`
+	// Message | 问题说明
+	Message string `json:"message"`
+	// Affected data | 涉及的数据
+	Subjects []string `json:"subjects"`
+	// Repair plan | 修复计划
+	Repair string `json:"repair"`
+}
+
+// Tenant doctor response | 租户诊断返回
+// swagger:model TenantDoctorResp
+type TenantDoctorResp struct {
+	BaseDataInfo
+	// Finding list data | 问题列表数据
+	Data TenantDoctorListInfo `json:"data"`
+}
+
+// Finding list data | 问题列表数据
+// swagger:model TenantDoctorListInfo
+type TenantDoctorListInfo struct {
+	BaseListInfo
+	// The finding list data | 问题列表数据
+	Data []TenantDoctorFinding `json:"data"`
+}
+
+// Tenant repair request | 租户修复请求
+// swagger:model TenantRepairReq
+type TenantRepairReq struct {
+	// Tenant ID | 租户ID
+	TenantId uint64 `json:"tenantId" validate:"number"`
+	// Finding codes to repair, all when empty | 需要修复的问题代码，为空时修复全部
+	Codes []string `json:"codes,optional"`
+	// Admin password, needed when the admin user has to be recreated | 管理员密码，需要重建管理员用户时必填
+	// min length : 6
+	// max length : 30
+	AdminPassword *string `json:"adminPassword,optional" validate:"omitempty,min=6,max=30"`
 }
 
 // Public tenant information | 公开租户信息
@@ -2397,6 +2928,98 @@ type TenantSwitchReq struct {
 	// Target Tenant ID | 目标租户ID
 	// required : true
 	TenantId string `json:"tenantId" validate:"required"`
+	// Reason of the access | 访问原因
+	// required : true
+	// max length : 500
+	Reason string `json:"reason" validate:"required,max=500"`
+	// Support ticket | 工单号
+	// max length : 100
+	Ticket *string `json:"ticket,optional" validate:"omitempty,max=100"`
+	// Duration in minutes, the default duration is used when empty | 会话时长(分钟)，为空时使用默认时长
+	// min length : 1
+	DurationMinutes *uint32 `json:"durationMinutes,optional" validate:"omitempty,number,min=1"`
+}
+
+// Impersonation session information | 租户模拟访问会话信息
+// swagger:model ImpersonationSessionInfo
+type ImpersonationSessionInfo struct {
+	// ID
+	Id uint64 `json:"id"`
+	// Create date | 创建日期
+	CreatedAt int64 `json:"createdAt"`
+	// The super admin's user ID | 超级管理员用户ID
+	UserId string `json:"userId"`
+	// The super admin's username | 超级管理员用户名
+	UserName string `json:"userName"`
+	// The super admin's own tenant | 超级管理员所属租户ID
+	OriginTenantId uint64 `json:"originTenantId"`
+	// The impersonated tenant | 进入的租户ID
+	TargetTenantId uint64 `json:"targetTenantId"`
+	// Reason of the access | 访问原因
+	Reason string `json:"reason"`
+	// Support ticket | 工单号
+	Ticket string `json:"ticket"`
+	// State: pending, active, ended, rejected, expired | 会话状态
+	State string `json:"state"`
+	// Duration in seconds | 会话时长(秒)
+	DurationSeconds int64 `json:"durationSeconds"`
+	// The user who approved or rejected the request | 审批人用户ID
+	ApprovedBy string `json:"approvedBy"`
+	// Approval time | 审批时间
+	ApprovedAt *int64 `json:"approvedAt,optional"`
+	// Start time | 开始时间
+	StartedAt *int64 `json:"startedAt,optional"`
+	// Expiry time | 到期时间
+	ExpiresAt *int64 `json:"expiresAt,optional"`
+	// End time | 结束时间
+	EndedAt *int64 `json:"endedAt,optional"`
+}
+
+// Impersonation session information response | 租户模拟访问会话信息返回体
+// swagger:model ImpersonationSessionResp
+type ImpersonationSessionResp struct {
+	BaseDataInfo
+	// Impersonation session information | 会话信息
+	Data ImpersonationSessionInfo `json:"data"`
+}
+
+// Review the impersonation request | 审批租户模拟访问申请
+// swagger:model ImpersonationReviewReq
+type ImpersonationReviewReq struct {
+	// Session ID | 会话ID
+	Id uint64 `json:"id" validate:"number"`
+	// Approve or reject | 是否通过
+	Approve bool `json:"approve"`
+}
+
+// Impersonation session list request | 租户模拟访问会话列表请求参数
+// swagger:model ImpersonationSessionListReq
+type ImpersonationSessionListReq struct {
+	PageInfo
+	// Target tenant ID, only used by super admins | 进入的租户ID，仅超级管理员可用
+	TargetTenantId *uint64 `json:"targetTenantId,optional"`
+	// The super admin's user ID | 超级管理员用户ID
+	// max length : 36
+	UserId *string `json:"userId,optional" validate:"omitempty,max=36"`
+	// State | 会话状态
+	// max length : 20
+	State *string `json:"state,optional" validate:"omitempty,max=20"`
+}
+
+// Impersonation session list response | 租户模拟访问会话列表返回体
+// swagger:model ImpersonationSessionListResp
+type ImpersonationSessionListResp struct {
+	BaseDataInfo
+	// Impersonation session list data | 会话列表数据
+	Data ImpersonationSessionListInfo `json:"data"`
+}
+
+// Impersonation session list data | 租户模拟访问会话列表数据
+// swagger:model ImpersonationSessionListInfo
+type ImpersonationSessionListInfo struct {
+	BaseListInfo
+	// The session list data | 会话列表数据
+	Data []ImpersonationSessionInfo `json:"data"`
 }
 
 // Current active tenant response | 当前激活租户响应
@@ -2411,6 +3034,10 @@ type CurrentTenantResp struct {
 	ActiveTenantInfo *TenantInfo `json:"activeTenantInfo,optional"`
 	// Is Switched | 是否已切换
 	IsSwitched bool `json:"isSwitched"`
+	// The active impersonation session | 当前生效的模拟访问会话
+	Impersonation *ImpersonationSessionInfo `json:"impersonation,optional"`
+	// Show the impersonation banner | 是否显示模拟访问提示
+	ShowBanner bool `json:"showBanner"`
 }
 
 // The response data of Casbin rule information | Casbin权限规则信息
@@ -2426,16 +3053,16 @@ type CasbinRuleInfo struct {
 	// Subject: user ID, role code | 主体: 用户ID、角色代码
 	// max length : 100
 	V0 *string `json:"v0,optional" validate:"omitempty,max=100"`
-	// Object: resource path, API endpoint | 资源: 资源路径、API端点
+	// Domain: tenant ID, role code for g rules | 域: 租户ID，g 规则为角色代码
 	// max length : 100
 	V1 *string `json:"v1,optional" validate:"omitempty,max=100"`
-	// Action: read, write, delete, etc. | 操作: read, write, delete等
+	// Object: resource path, API endpoint | 资源: 资源路径、API端点
 	// max length : 50
 	V2 *string `json:"v2,optional" validate:"omitempty,max=50"`
-	// Effect: allow, deny | 效果: allow, deny
+	// Action: HTTP method, read, write, etc. | 操作: HTTP方法、read、write等
 	// max length : 50
 	V3 *string `json:"v3,optional" validate:"omitempty,max=50"`
-	// Condition expression: JSON format | 条件表达式: JSON格式
+	// Effect: allow, deny | 效果: allow, deny
 	// max length : 500
 	V4 *string `json:"v4,optional" validate:"omitempty,max=500"`
 	// Priority: numeric string | 优先级: 数值字符串
@@ -2484,6 +3111,9 @@ type CasbinRuleInfo struct {
 	UsageCount *int64 `json:"usageCount,optional"`
 	// Last used time | 最后使用时间
 	LastUsedAt *int64 `json:"lastUsedAt,optional"`
+	// ABAC conditions: JSON format, p rules only | ABAC条件: JSON格式，仅用于 p 规则
+	// max length : 4096
+	Conditions *string `json:"conditions,optional" validate:"omitempty,max=4096"`
 }
 
 // Get Casbin rule list request params | Casbin规则列表请求参数
@@ -2777,3 +3407,958 @@ type RefreshCacheResult struct {
 	// Cleared entries | 清理的缓存条目数
 	ClearedEntries int32 `json:"clearedEntries"`
 }
+
+// The response data of SAML provider information | SAML身份源信息
+// swagger:model SamlProviderInfo
+type SamlProviderInfo struct {
+	BaseIDInfo
+	// Provider name | 身份源名称
+	// max length : 50
+	Name *string `json:"name,optional" validate:"omitempty,max=50"`
+	// Display name | 显示名称
+	// max length : 100
+	DisplayName *string `json:"displayName,optional" validate:"omitempty,max=100"`
+	// IdP entity ID | IdP实体ID
+	// max length : 500
+	IdpEntityId *string `json:"idpEntityId,optional" validate:"omitempty,max=500"`
+	// IdP single sign-on URL (HTTP-Redirect binding) | IdP单点登录地址
+	// max length : 1000
+	IdpSsoUrl *string `json:"idpSsoUrl,optional" validate:"omitempty,max=1000"`
+	// IdP single logout URL | IdP单点登出地址
+	// max length : 1000
+	IdpSloUrl *string `json:"idpSloUrl,optional" validate:"omitempty,max=1000"`
+	// IdP signing certificates in PEM | IdP签名证书(PEM)
+	IdpCertificates *string `json:"idpCertificates,optional"`
+	// IdP metadata URL, used to fill the IdP fields on creation | IdP元数据地址，创建时可据此填充IdP信息
+	// max length : 1000
+	IdpMetadataUrl *string `json:"idpMetadataUrl,optional" validate:"omitempty,max=1000"`
+	// SP entity ID, generated when empty | SP实体ID，为空时自动生成
+	// max length : 500
+	SpEntityId *string `json:"spEntityId,optional" validate:"omitempty,max=500"`
+	// Assertion consumer service URL, generated when empty | 断言消费地址，为空时自动生成
+	// max length : 1000
+	AcsUrl *string `json:"acsUrl,optional" validate:"omitempty,max=1000"`
+	// Requested NameID format | 请求的NameID格式
+	// max length : 255
+	NameIdFormat *string `json:"nameIdFormat,optional" validate:"omitempty,max=255"`
+	// Whether to sign AuthnRequests | 是否签名认证请求
+	SignAuthnRequest *bool `json:"signAuthnRequest,optional"`
+	// Whether assertions must be signed | 是否要求断言签名
+	WantAssertionsSigned *bool `json:"wantAssertionsSigned,optional"`
+	// Attribute mapping as JSON | 属性映射规则JSON
+	AttributeMapping *string `json:"attributeMapping,optional"`
+	// Whether to create users on first login | 是否在首次登录时自动创建用户
+	JitProvision *bool `json:"jitProvision,optional"`
+	// Whether to update department and roles on every login | 是否在每次登录时同步部门和角色
+	SyncAttributes *bool `json:"syncAttributes,optional"`
+	// Whether to link the first login to an existing user with the same email | 首次登录时是否按邮箱关联已有用户
+	LinkByEmail *bool `json:"linkByEmail,optional"`
+	// Department of provisioned users | 自动创建用户的默认部门
+	DefaultDepartmentId *uint64 `json:"defaultDepartmentId,optional"`
+	// Roles of provisioned users | 自动创建用户的默认角色
+	DefaultRoleIds []uint64 `json:"defaultRoleIds,optional"`
+	// Whether enabled | 是否启用
+	Enabled *bool `json:"enabled,optional"`
+	// Sort order | 排序
+	Sort *uint32 `json:"sort,optional"`
+	// Remark | 备注
+	// max length : 200
+	Remark *string `json:"remark,optional" validate:"omitempty,max=200"`
+	// Status | 状态
+	// max : 20
+	Status *uint32 `json:"status,optional" validate:"omitempty,lt=20"`
+}
+
+// The response data of SAML provider list | SAML身份源列表数据
+// swagger:model SamlProviderListResp
+type SamlProviderListResp struct {
+	BaseDataInfo
+	// SAML provider list data | SAML身份源列表数据
+	Data SamlProviderListInfo `json:"data"`
+}
+
+// SAML provider list data | SAML身份源列表数据
+// swagger:model SamlProviderListInfo
+type SamlProviderListInfo struct {
+	BaseListInfo
+	// The SAML provider list data | SAML身份源列表数据
+	Data []SamlProviderInfo `json:"data"`
+}
+
+// Get SAML provider list request params | SAML身份源列表请求参数
+// swagger:model SamlProviderListReq
+type SamlProviderListReq struct {
+	PageInfo
+	// Name | 身份源名称
+	// max length : 50
+	Name *string `json:"name,optional" validate:"omitempty,max=50"`
+}
+
+// SAML provider information response | SAML身份源信息返回体
+// swagger:model SamlProviderInfoResp
+type SamlProviderInfoResp struct {
+	BaseDataInfo
+	// SAML provider information | SAML身份源数据
+	Data SamlProviderInfo `json:"data"`
+}
+
+// Import IdP metadata request | 导入IdP元数据请求
+// swagger:model SamlMetadataImportReq
+type SamlMetadataImportReq struct {
+	// Provider ID | 身份源ID
+	// required : true
+	Id uint64 `json:"id" validate:"required"`
+	// Metadata XML | 元数据XML
+	MetadataXml *string `json:"metadataXml,optional"`
+	// Metadata URL, the saved URL is used when both are empty | 元数据地址，均为空时使用已保存的地址
+	// max length : 1000
+	MetadataUrl *string `json:"metadataUrl,optional" validate:"omitempty,max=1000"`
+	// IdP entity ID, required when the metadata describes several IdPs | IdP实体ID，元数据包含多个IdP时必填
+	// max length : 500
+	EntityId *string `json:"entityId,optional" validate:"omitempty,max=500"`
+}
+
+// SP metadata request | SP元数据请求
+// swagger:parameters GetSamlSpMetadata
+type SamlSpMetadataReq struct {
+	// Provider ID | 身份源ID
+	// in:query
+	Provider uint64 `form:"provider"`
+}
+
+// SAML log in request | SAML 登录请求
+// swagger:model SamlLoginReq
+type SamlLoginReq struct {
+	// Provider ID | 身份源ID
+	// required : true
+	ProviderId uint64 `json:"providerId" validate:"required"`
+	// Relay state returned after log in | 登录后原样返回的状态
+	// max length : 80
+	RelayState *string `json:"relayState,optional" validate:"omitempty,max=80"`
+}
+
+// SAML response posted by the IdP | IdP 提交的 SAML 响应
+// swagger:parameters SamlAcs
+type SamlAcsReq struct {
+	// Provider ID | 身份源ID
+	// in:query
+	Provider uint64 `form:"provider"`
+	// Base64 encoded SAML response | Base64编码的SAML响应
+	// in:formData
+	SAMLResponse string `form:"SAMLResponse"`
+	// Relay state | 状态
+	// in:formData
+	RelayState *string `form:"RelayState,optional"`
+}
+
+// The response data of LDAP provider information | LDAP目录信息
+// swagger:model LdapProviderInfo
+type LdapProviderInfo struct {
+	BaseIDInfo
+	// Provider name | 目录名称
+	// max length : 50
+	Name *string `json:"name,optional" validate:"omitempty,max=50"`
+	// Display name | 显示名称
+	// max length : 100
+	DisplayName *string `json:"displayName,optional" validate:"omitempty,max=100"`
+	// Directory type: openldap, ad | 目录类型
+	DirectoryType *string `json:"directoryType,optional" validate:"omitempty,oneof=openldap ad"`
+	// Server URL, ldap:// or ldaps:// | 服务器地址
+	// max length : 500
+	Url *string `json:"url,optional" validate:"omitempty,max=500"`
+	// Whether to upgrade the connection with StartTLS | 是否使用StartTLS
+	StartTls *bool `json:"startTls,optional"`
+	// Whether to skip the verification of the server certificate | 是否跳过证书校验
+	InsecureSkipVerify *bool `json:"insecureSkipVerify,optional"`
+	// Service account DN | 服务账号DN
+	// max length : 500
+	BindDn *string `json:"bindDn,optional" validate:"omitempty,max=500"`
+	// Service account password, never returned, empty keeps the saved password | 服务账号密码，不会返回，为空时保留原密码
+	// max length : 200
+	BindPassword *string `json:"bindPassword,optional" validate:"omitempty,max=200"`
+	// Base DN of users | 用户查询的基础DN
+	// max length : 500
+	BaseDn *string `json:"baseDn,optional" validate:"omitempty,max=500"`
+	// Authentication mode: template, search | 认证方式：DN模板或先查询后绑定
+	AuthMode *string `json:"authMode,optional" validate:"omitempty,oneof=template search"`
+	// User DN template containing {username} | 用户DN模板
+	// max length : 500
+	UserDnTemplate *string `json:"userDnTemplate,optional" validate:"omitempty,max=500"`
+	// User filter containing {username} | 用户过滤条件
+	// max length : 1000
+	UserFilter *string `json:"userFilter,optional" validate:"omitempty,max=1000"`
+	// Base DN of organizational units | 组织单位的基础DN
+	// max length : 500
+	OuBaseDn *string `json:"ouBaseDn,optional" validate:"omitempty,max=500"`
+	// Organizational unit filter | 组织单位过滤条件
+	// max length : 500
+	OuFilter *string `json:"ouFilter,optional" validate:"omitempty,max=500"`
+	// Base DN of groups | 组的基础DN
+	// max length : 500
+	GroupBaseDn *string `json:"groupBaseDn,optional" validate:"omitempty,max=500"`
+	// Group filter | 组过滤条件
+	// max length : 500
+	GroupFilter *string `json:"groupFilter,optional" validate:"omitempty,max=500"`
+	// Member attribute of groups | 组成员属性
+	// max length : 50
+	GroupMemberAttribute *string `json:"groupMemberAttribute,optional" validate:"omitempty,max=50"`
+	// Attribute mapping as JSON | 属性映射规则JSON
+	AttributeMapping *string `json:"attributeMapping,optional"`
+	// Whether users can log in with the directory password | 是否允许目录密码登录
+	LoginEnabled *bool `json:"loginEnabled,optional"`
+	// Whether to create users on first login | 是否在首次登录时自动创建用户
+	JitProvision *bool `json:"jitProvision,optional"`
+	// Whether to link local users with the same username | 是否绑定同名的本地用户
+	LinkExisting *bool `json:"linkExisting,optional"`
+	// Whether to sync the directory periodically | 是否定时同步目录
+	SyncEnabled *bool `json:"syncEnabled,optional"`
+	// Sync interval in minutes | 同步间隔（分钟）
+	// min : 1
+	SyncInterval *uint32 `json:"syncInterval,optional" validate:"omitempty,gte=1"`
+	// Policy for entries removed from the directory: keep, disable, delete | 目录中删除的条目处理策略
+	DeletionPolicy *string `json:"deletionPolicy,optional" validate:"omitempty,oneof=keep disable delete"`
+	// Department under which organizational units are mirrored | 组织单位同步到的上级部门
+	RootDepartmentId *uint64 `json:"rootDepartmentId,optional"`
+	// Department of users outside any organizational unit | 不属于任何组织单位的用户的默认部门
+	DefaultDepartmentId *uint64 `json:"defaultDepartmentId,optional"`
+	// Roles of provisioned users | 自动创建用户的默认角色
+	DefaultRoleIds []uint64 `json:"defaultRoleIds,optional"`
+	// Last sync time | 最近同步时间
+	LastSyncAt *int64 `json:"lastSyncAt,optional"`
+	// Whether enabled | 是否启用
+	Enabled *bool `json:"enabled,optional"`
+	// Sort order | 排序
+	Sort *uint32 `json:"sort,optional"`
+	// Remark | 备注
+	// max length : 200
+	Remark *string `json:"remark,optional" validate:"omitempty,max=200"`
+	// Status | 状态
+	// max : 20
+	Status *uint32 `json:"status,optional" validate:"omitempty,lt=20"`
+}
+
+// The response data of LDAP provider list | LDAP目录列表数据
+// swagger:model LdapProviderListResp
+type LdapProviderListResp struct {
+	BaseDataInfo
+	// LDAP provider list data | LDAP目录列表数据
+	Data LdapProviderListInfo `json:"data"`
+}
+
+// LDAP provider list data | LDAP目录列表数据
+// swagger:model LdapProviderListInfo
+type LdapProviderListInfo struct {
+	BaseListInfo
+	// The LDAP provider list data | LDAP目录列表数据
+	Data []LdapProviderInfo `json:"data"`
+}
+
+// Get LDAP provider list request params | LDAP目录列表请求参数
+// swagger:model LdapProviderListReq
+type LdapProviderListReq struct {
+	PageInfo
+	// Name | 目录名称
+	// max length : 50
+	Name *string `json:"name,optional" validate:"omitempty,max=50"`
+}
+
+// LDAP provider information response | LDAP目录信息返回体
+// swagger:model LdapProviderInfoResp
+type LdapProviderInfoResp struct {
+	BaseDataInfo
+	// LDAP provider information | LDAP目录数据
+	Data LdapProviderInfo `json:"data"`
+}
+
+// Sync the directory request | 同步目录请求
+// swagger:model LdapSyncReq
+type LdapSyncReq struct {
+	// Provider ID | 目录ID
+	// required : true
+	Id uint64 `json:"id" validate:"required"`
+	// Plan the changes without applying them | 试运行，只列出变更不执行
+	DryRun bool `json:"dryRun,optional"`
+}
+
+// Change counters of one kind of objects | 单类对象的变更统计
+// swagger:model LdapSyncCounter
+type LdapSyncCounter struct {
+	// Created | 新建数
+	Created uint32 `json:"created"`
+	// Updated | 更新数
+	Updated uint32 `json:"updated"`
+	// Disabled | 禁用数
+	Disabled uint32 `json:"disabled"`
+	// Deleted | 删除数
+	Deleted uint32 `json:"deleted"`
+	// Unchanged | 未变化数
+	Unchanged uint32 `json:"unchanged"`
+}
+
+// Change counters of a sync run | 同步变更统计
+// swagger:model LdapSyncStats
+type LdapSyncStats struct {
+	// Departments | 部门
+	Departments LdapSyncCounter `json:"departments"`
+	// Users | 用户
+	Users LdapSyncCounter `json:"users"`
+	// Granted roles | 授予的角色数
+	Granted uint32 `json:"granted"`
+	// Revoked roles | 撤销的角色数
+	Revoked uint32 `json:"revoked"`
+	// Conflicts | 冲突数
+	Conflicts uint32 `json:"conflicts"`
+}
+
+// A change made or planned by the sync | 同步执行或计划的变更
+// swagger:model LdapSyncChange
+type LdapSyncChange struct {
+	// Kind: department, user, role | 对象类型
+	Kind string `json:"kind"`
+	// Action: create, update, disable, delete, grant, revoke | 操作
+	Action string `json:"action"`
+	// DN of the entry | 目录条目DN
+	Dn string `json:"dn"`
+	// Name | 名称
+	Name string `json:"name"`
+	// Detail | 详情
+	Detail string `json:"detail"`
+}
+
+// An entry skipped by the sync | 同步跳过的条目
+// swagger:model LdapSyncConflict
+type LdapSyncConflict struct {
+	// Kind: department, user, role | 对象类型
+	Kind string `json:"kind"`
+	// DN of the entry | 目录条目DN
+	Dn string `json:"dn"`
+	// Name | 名称
+	Name string `json:"name"`
+	// Reason | 原因
+	Reason string `json:"reason"`
+	// Detail | 详情
+	Detail string `json:"detail"`
+}
+
+// The data of a sync run | 同步记录
+// swagger:model LdapSyncRunInfo
+type LdapSyncRunInfo struct {
+	// ID
+	Id *uint64 `json:"id,optional"`
+	// Create date | 创建日期
+	CreatedAt *int64 `json:"createdAt,optional"`
+	// Provider ID | 目录ID
+	ProviderId *uint64 `json:"providerId,optional"`
+	// Trigger: manual, schedule | 触发方式
+	Trigger *string `json:"trigger,optional"`
+	// Whether changes were only planned | 是否为试运行
+	DryRun *bool `json:"dryRun,optional"`
+	// Result: success, failed | 执行结果
+	Result *string `json:"result,optional"`
+	// Error message | 错误信息
+	Error *string `json:"error,optional"`
+	// Start time | 开始时间
+	StartedAt *int64 `json:"startedAt,optional"`
+	// Finish time | 结束时间
+	FinishedAt *int64 `json:"finishedAt,optional"`
+	// Change counters | 变更统计
+	Stats *LdapSyncStats `json:"stats,optional"`
+	// Changes, omitted in the list | 变更明细，列表中不返回
+	Changes []LdapSyncChange `json:"changes,optional"`
+	// Conflicts, omitted in the list | 冲突明细，列表中不返回
+	Conflicts []LdapSyncConflict `json:"conflicts,optional"`
+	// Whether changes or conflicts beyond the limit were dropped | 变更或冲突是否被截断
+	Truncated *bool `json:"truncated,optional"`
+}
+
+// Sync run information response | 同步记录返回体
+// swagger:model LdapSyncRunInfoResp
+type LdapSyncRunInfoResp struct {
+	BaseDataInfo
+	// Sync run information | 同步记录数据
+	Data LdapSyncRunInfo `json:"data"`
+}
+
+// The response data of sync run list | 同步记录列表数据
+// swagger:model LdapSyncRunListResp
+type LdapSyncRunListResp struct {
+	BaseDataInfo
+	// Sync run list data | 同步记录列表数据
+	Data LdapSyncRunListInfo `json:"data"`
+}
+
+// Sync run list data | 同步记录列表数据
+// swagger:model LdapSyncRunListInfo
+type LdapSyncRunListInfo struct {
+	BaseListInfo
+	// The sync run list data | 同步记录列表数据
+	Data []LdapSyncRunInfo `json:"data"`
+}
+
+// Get sync run list request params | 同步记录列表请求参数
+// swagger:model LdapSyncRunListReq
+type LdapSyncRunListReq struct {
+	PageInfo
+	// Provider ID | 目录ID
+	// required : true
+	ProviderId uint64 `json:"providerId" validate:"required"`
+	// Result: success, failed | 执行结果
+	Result *string `json:"result,optional" validate:"omitempty,oneof=success failed"`
+}
+
+// The response data of SCIM token information | SCIM令牌信息
+// swagger:model ScimTokenInfo
+type ScimTokenInfo struct {
+	BaseIDInfo
+	// Status 1: normal 2: ban | 状态 1 正常 2 禁用
+	// max : 20
+	Status *uint32 `json:"status,optional" validate:"omitempty,lt=20"`
+	// Token name, usually the identity provider | 令牌名称
+	// max length : 100
+	Name *string `json:"name,optional" validate:"omitempty,max=100"`
+	// Leading characters of the token | 令牌前缀
+	TokenPrefix *string `json:"tokenPrefix,optional"`
+	// Expire time, never expires when empty or 0 | 过期时间，为空或 0 时永不过期
+	ExpiredAt *int64 `json:"expiredAt,optional"`
+	// Last used time | 最后使用时间
+	LastUsedAt *int64 `json:"lastUsedAt,optional"`
+	// Last used IP | 最后使用 IP
+	LastUsedIp *string `json:"lastUsedIp,optional"`
+	// Remark | 备注
+	// max length : 200
+	Remark *string `json:"remark,optional" validate:"omitempty,max=200"`
+	// Existing roles managed as groups, roles created by SCIM are always managed | 可作为组管理的已有角色编码，SCIM 创建的角色总是可以管理
+	// max length : 50
+	RoleCodes []string `json:"roleCodes,optional" validate:"omitempty,dive,max=50"`
+}
+
+// The response data of SCIM token list | SCIM令牌列表数据
+// swagger:model ScimTokenListResp
+type ScimTokenListResp struct {
+	BaseDataInfo
+	// SCIM token list data | SCIM令牌列表数据
+	Data ScimTokenListInfo `json:"data"`
+}
+
+// SCIM token list data | SCIM令牌列表数据
+// swagger:model ScimTokenListInfo
+type ScimTokenListInfo struct {
+	BaseListInfo
+	// The SCIM token list data | SCIM令牌列表数据
+	Data []ScimTokenInfo `json:"data"`
+}
+
+// Get SCIM token list request params | SCIM令牌列表请求参数
+// swagger:model ScimTokenListReq
+type ScimTokenListReq struct {
+	PageInfo
+	// Name | 令牌名称
+	// max length : 100
+	Name *string `json:"name,optional" validate:"omitempty,max=100"`
+}
+
+// SCIM token information response | SCIM令牌信息返回体
+// swagger:model ScimTokenInfoResp
+type ScimTokenInfoResp struct {
+	BaseDataInfo
+	// SCIM token information | SCIM令牌数据
+	Data ScimTokenInfo `json:"data"`
+}
+
+// The created SCIM token | 新建的SCIM令牌
+// swagger:model ScimTokenCreateInfo
+type ScimTokenCreateInfo struct {
+	// Token ID | 令牌ID
+	Id uint64 `json:"id"`
+	// The token, only returned once | 令牌，只返回一次
+	Token string `json:"token"`
+}
+
+// Create SCIM token response | 创建SCIM令牌返回体
+// swagger:model ScimTokenCreateResp
+type ScimTokenCreateResp struct {
+	BaseDataInfo
+	// The created SCIM token | 新建的SCIM令牌
+	Data ScimTokenCreateInfo `json:"data"`
+}
+
+// The response data of OAuth client information | OAuth客户端信息
+// swagger:model OauthClientInfo
+type OauthClientInfo struct {
+	BaseIDInfo
+	// Status 1: normal 2: ban | 状态 1 正常 2 禁用
+	// max : 20
+	Status *uint32 `json:"status,optional" validate:"omitempty,lt=20"`
+	// Client name | 客户端名称
+	// max length : 100
+	Name *string `json:"name,optional" validate:"omitempty,max=100"`
+	// Client ID, generated when created | 客户端ID，创建时生成
+	ClientId *string `json:"clientId,optional"`
+	// Client type, confidential or public | 客户端类型，confidential 或 public
+	ClientType *string `json:"clientType,optional" validate:"omitempty,oneof=confidential public"`
+	// Redirect URIs | 回调地址
+	// max length : 512
+	RedirectUris []string `json:"redirectUris,optional" validate:"omitempty,dive,max=512"`
+	// Grant types | 授权类型
+	GrantTypes []string `json:"grantTypes,optional"`
+	// Scopes | 作用域
+	Scopes []string `json:"scopes,optional"`
+	// Trusted clients skip the consent page | 受信任的客户端跳过授权确认
+	Trusted *bool `json:"trusted,optional"`
+	// Access token TTL in seconds, 0 means the default | 访问令牌有效期（秒），0 表示使用默认值
+	AccessTokenTtl *uint32 `json:"accessTokenTtl,optional"`
+	// Refresh token TTL in seconds, 0 means the default | 刷新令牌有效期（秒），0 表示使用默认值
+	RefreshTokenTtl *uint32 `json:"refreshTokenTtl,optional"`
+	// Remark | 备注
+	// max length : 200
+	Remark *string `json:"remark,optional" validate:"omitempty,max=200"`
+}
+
+// The response data of OAuth client list | OAuth客户端列表数据
+// swagger:model OauthClientListResp
+type OauthClientListResp struct {
+	BaseDataInfo
+	// OAuth client list data | OAuth客户端列表数据
+	Data OauthClientListInfo `json:"data"`
+}
+
+// OAuth client list data | OAuth客户端列表数据
+// swagger:model OauthClientListInfo
+type OauthClientListInfo struct {
+	BaseListInfo
+	// The OAuth client list data | OAuth客户端列表数据
+	Data []OauthClientInfo `json:"data"`
+}
+
+// Get OAuth client list request params | OAuth客户端列表请求参数
+// swagger:model OauthClientListReq
+type OauthClientListReq struct {
+	PageInfo
+	// Name | 客户端名称
+	// max length : 100
+	Name *string `json:"name,optional" validate:"omitempty,max=100"`
+}
+
+// OAuth client information response | OAuth客户端信息返回体
+// swagger:model OauthClientInfoResp
+type OauthClientInfoResp struct {
+	BaseDataInfo
+	// OAuth client information | OAuth客户端数据
+	Data OauthClientInfo `json:"data"`
+}
+
+// The client secret | 客户端密钥
+// swagger:model OauthClientSecretInfo
+type OauthClientSecretInfo struct {
+	// ID | 客户端主键
+	Id uint64 `json:"id"`
+	// Client ID | 客户端ID
+	ClientId string `json:"clientId"`
+	// Client secret, only returned once, empty for public clients | 客户端密钥，只返回一次，公共客户端为空
+	ClientSecret string `json:"clientSecret"`
+}
+
+// OAuth client secret response | OAuth客户端密钥返回体
+// swagger:model OauthClientSecretResp
+type OauthClientSecretResp struct {
+	BaseDataInfo
+	// The client secret | 客户端密钥
+	Data OauthClientSecretInfo `json:"data"`
+}
+
+// The response data of OAuth consent information | OAuth授权记录信息
+// swagger:model OauthConsentInfo
+type OauthConsentInfo struct {
+	BaseIDInfo
+	// User ID | 用户ID
+	UserId *string `json:"userId,optional"`
+	// Client ID | 客户端ID
+	ClientId *string `json:"clientId,optional"`
+	// Client name | 客户端名称
+	ClientName *string `json:"clientName,optional"`
+	// Granted scopes | 已授权的作用域
+	Scopes []string `json:"scopes,optional"`
+}
+
+// The response data of OAuth consent list | OAuth授权记录列表数据
+// swagger:model OauthConsentListResp
+type OauthConsentListResp struct {
+	BaseDataInfo
+	// OAuth consent list data | OAuth授权记录列表数据
+	Data OauthConsentListInfo `json:"data"`
+}
+
+// OAuth consent list data | OAuth授权记录列表数据
+// swagger:model OauthConsentListInfo
+type OauthConsentListInfo struct {
+	BaseListInfo
+	// The OAuth consent list data | OAuth授权记录列表数据
+	Data []OauthConsentInfo `json:"data"`
+}
+
+// Get OAuth consent list request params | OAuth授权记录列表请求参数
+// swagger:model OauthConsentListReq
+type OauthConsentListReq struct {
+	PageInfo
+	// User ID | 用户ID
+	UserId *string `json:"userId,optional" validate:"omitempty,len=36"`
+	// Client ID | 客户端ID
+	// max length : 64
+	ClientId *string `json:"clientId,optional" validate:"omitempty,max=64"`
+}
+
+// OAuth authorization request of the logged in user | 当前用户的OAuth授权请求
+// swagger:model OauthConsentReq
+type OauthConsentReq struct {
+	// Client ID | 客户端ID
+	// required : true
+	// max length : 64
+	ClientId string `json:"clientId" validate:"required,max=64"`
+	// Redirect URI | 回调地址
+	// required : true
+	// max length : 512
+	RedirectUri string `json:"redirectUri" validate:"required,max=512"`
+	// Space-delimited scopes | 作用域，以空格分隔
+	// max length : 1000
+	Scope string `json:"scope,optional" validate:"omitempty,max=1000"`
+	// State of the client | 客户端状态参数
+	// max length : 512
+	State string `json:"state,optional" validate:"omitempty,max=512"`
+	// PKCE code challenge | PKCE 挑战码
+	// max length : 128
+	CodeChallenge *string `json:"codeChallenge,optional" validate:"omitempty,max=128"`
+	// PKCE code challenge method | PKCE 挑战方法
+	CodeChallengeMethod *string `json:"codeChallengeMethod,optional"`
+	// OpenID Connect nonce | OpenID Connect 随机数
+	// max length : 256
+	Nonce *string `json:"nonce,optional" validate:"omitempty,max=256"`
+	// Decision of the user, empty to get the scopes to consent | 用户的决定，为空时返回待确认的作用域
+	Approve *bool `json:"approve,optional"`
+}
+
+// OAuth authorization result | OAuth授权结果
+// swagger:model OauthConsentInfoData
+type OauthConsentInfoData struct {
+	// Whether the user has to consent | 是否需要用户确认
+	ConsentRequired bool `json:"consentRequired"`
+	// Client name | 客户端名称
+	ClientName string `json:"clientName"`
+	// Scopes to consent | 待确认的作用域
+	Scopes []OauthScopeInfo `json:"scopes,optional"`
+	// The redirect URL with the authorization code or the error | 携带授权码或错误的回调地址
+	RedirectUrl string `json:"redirectUrl,optional"`
+}
+
+// OAuth authorization response | OAuth授权返回体
+// swagger:model OauthConsentResp
+type OauthConsentResp struct {
+	BaseDataInfo
+	// OAuth authorization result | OAuth授权结果
+	Data OauthConsentInfoData `json:"data"`
+}
+
+// The response data of OAuth scope information | OAuth作用域信息
+// swagger:model OauthScopeInfo
+type OauthScopeInfo struct {
+	BaseIDInfo
+	// Status 1: normal 2: ban | 状态 1 正常 2 禁用
+	// max : 20
+	Status *uint32 `json:"status,optional" validate:"omitempty,lt=20"`
+	// Scope name, lowercase letters, digits and _.:- | 作用域名称，由小写字母、数字和 _.:- 组成
+	// max length : 64
+	Name *string `json:"name,optional" validate:"omitempty,max=64"`
+	// Description shown on the consent page | 描述，展示在授权确认页
+	// max length : 200
+	Description *string `json:"description,optional" validate:"omitempty,max=200"`
+	// IDs of the APIs the scope grants | 作用域授权的接口ID
+	ApiIds []uint64 `json:"apiIds,optional"`
+}
+
+// The response data of OAuth scope list | OAuth作用域列表数据
+// swagger:model OauthScopeListResp
+type OauthScopeListResp struct {
+	BaseDataInfo
+	// OAuth scope list data | OAuth作用域列表数据
+	Data OauthScopeListInfo `json:"data"`
+}
+
+// OAuth scope list data | OAuth作用域列表数据
+// swagger:model OauthScopeListInfo
+type OauthScopeListInfo struct {
+	BaseListInfo
+	// The OAuth scope list data | OAuth作用域列表数据
+	Data []OauthScopeInfo `json:"data"`
+}
+
+// Get OAuth scope list request params | OAuth作用域列表请求参数
+// swagger:model OauthScopeListReq
+type OauthScopeListReq struct {
+	PageInfo
+	// Name | 作用域名称
+	// max length : 64
+	Name *string `json:"name,optional" validate:"omitempty,max=64"`
+}
+
+// OAuth scope information response | OAuth作用域信息返回体
+// swagger:model OauthScopeInfoResp
+type OauthScopeInfoResp struct {
+	BaseDataInfo
+	// OAuth scope information | OAuth作用域数据
+	Data OauthScopeInfo `json:"data"`
+}
+
+// The response data of oauth provider template information | 第三方登录模板信息
+// swagger:model OauthProviderTemplateInfo
+type OauthProviderTemplateInfo struct {
+	BaseIDInfo
+	// Status 1: normal 2: ban | 状态 1 正常 2 禁用
+	// max : 20
+	Status *uint32 `json:"status,optional" validate:"omitempty,lt=20"`
+	// Template name, used as the default provider name | 模板名称，默认作为提供商名称
+	// max length : 30
+	Name *string `json:"name,optional" validate:"omitempty,max=30"`
+	// Display name | 显示名称
+	// max length : 50
+	DisplayName *string `json:"displayName,optional" validate:"omitempty,max=50"`
+	// Provider type | 提供商类型
+	// max length : 20
+	Type *string `json:"type,optional" validate:"omitempty,max=20"`
+	// Provider specific type | 提供商具体类型
+	// max length : 30
+	ProviderType *string `json:"providerType,optional" validate:"omitempty,max=30"`
+	// Default scopes | 默认授权范围
+	// max length : 100
+	Scopes *string `json:"scopes,optional" validate:"omitempty,max=100"`
+	// Authority URL | 授权地址
+	// max length : 300
+	AuthUrl *string `json:"authUrl,optional" validate:"omitempty,max=300"`
+	// The URL to get token | 获取Token的地址
+	// max length : 300
+	TokenUrl *string `json:"tokenUrl,optional" validate:"omitempty,max=300"`
+	// The URL to get user information | 获取信息地址
+	// max length : 300
+	InfoUrl *string `json:"infoUrl,optional" validate:"omitempty,max=300"`
+	// The type of auth | 鉴权方式
+	// max : 20
+	AuthStyle *uint64 `json:"authStyle,optional" validate:"omitempty,lt=20"`
+	// Extra configuration as JSON | 额外配置信息
+	ExtraConfig *string `json:"extraConfig,optional"`
+	// Support PKCE | 是否支持PKCE
+	SupportPkce *bool `json:"supportPkce,optional"`
+	// Icon URL | 图标地址
+	// max length : 500
+	IconUrl *string `json:"iconUrl,optional" validate:"omitempty,max=500"`
+	// Sort order | 排序
+	Sort *uint32 `json:"sort,optional"`
+	// Remark | 备注
+	// max length : 200
+	Remark *string `json:"remark,optional" validate:"omitempty,max=200"`
+}
+
+// The response data of oauth provider template list | 第三方登录模板列表数据
+// swagger:model OauthProviderTemplateListResp
+type OauthProviderTemplateListResp struct {
+	BaseDataInfo
+	// Oauth provider template list data | 第三方登录模板列表数据
+	Data OauthProviderTemplateListInfo `json:"data"`
+}
+
+// Oauth provider template list data | 第三方登录模板列表数据
+// swagger:model OauthProviderTemplateListInfo
+type OauthProviderTemplateListInfo struct {
+	BaseListInfo
+	// The oauth provider template list data | 第三方登录模板列表数据
+	Data []OauthProviderTemplateInfo `json:"data"`
+}
+
+// Get oauth provider template list request params | 第三方登录模板列表请求参数
+// swagger:model OauthProviderTemplateListReq
+type OauthProviderTemplateListReq struct {
+	PageInfo
+	// Name | 模板名称
+	// max length : 30
+	Name *string `json:"name,optional" validate:"omitempty,max=30"`
+	// Provider type | 提供商类型
+	// max length : 20
+	Type *string `json:"type,optional" validate:"omitempty,max=20"`
+}
+
+// Oauth provider template information response | 第三方登录模板信息返回体
+// swagger:model OauthProviderTemplateInfoResp
+type OauthProviderTemplateInfoResp struct {
+	BaseDataInfo
+	// Oauth provider template information | 第三方登录模板数据
+	Data OauthProviderTemplateInfo `json:"data"`
+}
+
+// Enable a template for the current tenant | 为当前租户启用第三方登录模板
+// swagger:model EnableOauthProviderTemplateReq
+type EnableOauthProviderTemplateReq struct {
+	// Template ID | 模板ID
+	// required : true
+	TemplateId uint64 `json:"templateId" validate:"required"`
+	// ClientId of the tenant | 租户自己的客户端ID
+	// required : true
+	// max length : 80
+	ClientId string `json:"clientId" validate:"required,max=80"`
+	// ClientSecret of the tenant | 租户自己的客户端密钥
+	// required : true
+	// max length : 100
+	ClientSecret string `json:"clientSecret" validate:"required,max=100"`
+	// Redirect URL of the tenant | 租户自己的回调地址
+	// required : true
+	// max length : 300
+	RedirectUrl string `json:"redirectUrl" validate:"required,max=300"`
+	// Provider name, defaults to the template name | 提供商名称，默认为模板名称
+	// max length : 30
+	Name *string `json:"name,optional" validate:"omitempty,max=30"`
+	// Display name, defaults to the template display name | 显示名称，默认为模板显示名称
+	// max length : 50
+	DisplayName *string `json:"displayName,optional" validate:"omitempty,max=50"`
+	// Scopes, defaults to the template scopes | 授权范围，默认为模板授权范围
+	// max length : 100
+	Scopes *string `json:"scopes,optional" validate:"omitempty,max=100"`
+	// Whether enabled | 是否启用
+	Enabled *bool `json:"enabled,optional"`
+}
+
+// The response data of tenant initialization template information | 租户初始化模板信息
+// swagger:model TenantInitTemplateInfo
+type TenantInitTemplateInfo struct {
+	BaseIDInfo
+	// Status 1: normal 2: ban | 状态 1 正常 2 禁用
+	// max : 20
+	Status *uint32 `json:"status,optional" validate:"omitempty,lt=20"`
+	// Template name | 模板名称
+	// max length : 50
+	Name *string `json:"name,optional" validate:"omitempty,max=50"`
+	// Template version | 模板版本
+	// max length : 20
+	Version *string `json:"version,optional" validate:"omitempty,max=20"`
+	// Description | 描述
+	// max length : 200
+	Description *string `json:"description,optional" validate:"omitempty,max=200"`
+	// The base template it overlays | 基础模板名称
+	// max length : 50
+	BaseTemplate *string `json:"baseTemplate,optional" validate:"omitempty,max=50"`
+	// Components as JSON | 模板组件 JSON
+	Components *string `json:"components,optional"`
+	// Sort order | 排序
+	Sort *uint32 `json:"sort,optional"`
+}
+
+// The response data of tenant initialization template list | 租户初始化模板列表数据
+// swagger:model TenantInitTemplateListResp
+type TenantInitTemplateListResp struct {
+	BaseDataInfo
+	// Tenant initialization template list data | 租户初始化模板列表数据
+	Data TenantInitTemplateListInfo `json:"data"`
+}
+
+// Tenant initialization template list data | 租户初始化模板列表数据
+// swagger:model TenantInitTemplateListInfo
+type TenantInitTemplateListInfo struct {
+	BaseListInfo
+	// The tenant initialization template list data | 租户初始化模板列表数据
+	Data []TenantInitTemplateInfo `json:"data"`
+}
+
+// Get tenant initialization template list request params | 租户初始化模板列表请求参数
+// swagger:model TenantInitTemplateListReq
+type TenantInitTemplateListReq struct {
+	PageInfo
+	// Name | 模板名称
+	// max length : 50
+	Name *string `json:"name,optional" validate:"omitempty,max=50"`
+	// Base template | 基础模板名称
+	// max length : 50
+	BaseTemplate *string `json:"baseTemplate,optional" validate:"omitempty,max=50"`
+}
+
+// Tenant initialization template information response | 租户初始化模板信息返回体
+// swagger:model TenantInitTemplateInfoResp
+type TenantInitTemplateInfoResp struct {
+	BaseDataInfo
+	// Tenant initialization template information | 租户初始化模板数据
+	Data TenantInitTemplateInfo `json:"data"`
+}
+
+// Preview the merged template request | 预览合并后的模板请求参数
+// swagger:model TenantInitTemplatePreviewReq
+type TenantInitTemplatePreviewReq struct {
+	// Template name, empty means the base template | 模板名称，为空时为基础模板
+	// max length : 50
+	Name string `json:"name,optional" validate:"omitempty,max=50"`
+}
+
+// The merged template response | 合并后的模板返回体
+// swagger:model TenantInitTemplatePreviewResp
+type TenantInitTemplatePreviewResp struct {
+	BaseDataInfo
+	// The merged template as JSON | 合并后的模板 JSON
+	Data string `json:"data"`
+}
+
+// The response data of tenant initialization plugin information | 租户初始化远程插件信息
+// swagger:model TenantInitPluginInfo
+type TenantInitPluginInfo struct {
+	BaseIDInfo
+	// Status 1: normal 2: ban | 状态 1 正常 2 禁用
+	// max : 20
+	Status *uint32 `json:"status,optional" validate:"omitempty,lt=20"`
+	// Plugin name | 插件名称
+	// max length : 50
+	Name *string `json:"name,optional" validate:"omitempty,max=50"`
+	// Plugin version | 插件版本
+	// max length : 20
+	Version *string `json:"version,optional" validate:"omitempty,max=20"`
+	// Description | 描述
+	// max length : 500
+	Description *string `json:"description,optional" validate:"omitempty,max=500"`
+	// gRPC target of the service | 服务的 gRPC 地址
+	// max length : 255
+	Target *string `json:"target,optional" validate:"omitempty,max=255"`
+	// Names of the plugins it depends on, the core plugin is always a dependency | 依赖的插件名称，总是依赖核心插件
+	Dependencies []string `json:"dependencies,optional"`
+	// Order among the plugins without dependencies between them | 无依赖关系的插件间的执行顺序
+	Priority *int32 `json:"priority,optional"`
+	// Timeout of each call in seconds | 每次调用的超时时间（秒）
+	// min length : 1
+	// max length : 3600
+	Timeout *uint32 `json:"timeout,optional" validate:"omitempty,min=1,max=3600"`
+	// Whether it can roll back | 是否支持回滚
+	SupportRollback *bool `json:"supportRollback,optional"`
+}
+
+// The response data of tenant initialization plugin list | 租户初始化远程插件列表数据
+// swagger:model TenantInitPluginListResp
+type TenantInitPluginListResp struct {
+	BaseDataInfo
+	// Tenant initialization plugin list data | 租户初始化远程插件列表数据
+	Data TenantInitPluginListInfo `json:"data"`
+}
+
+// Tenant initialization plugin list data | 租户初始化远程插件列表数据
+// swagger:model TenantInitPluginListInfo
+type TenantInitPluginListInfo struct {
+	BaseListInfo
+	// The tenant initialization plugin list data | 租户初始化远程插件列表数据
+	Data []TenantInitPluginInfo `json:"data"`
+}
+
+// Get tenant initialization plugin list request params | 租户初始化远程插件列表请求参数
+// swagger:model TenantInitPluginListReq
+type TenantInitPluginListReq struct {
+	PageInfo
+	// Name | 插件名称
+	// max length : 50
+	Name *string `json:"name,optional" validate:"omitempty,max=50"`
+}
+
+// Tenant initialization plugin information response | 租户初始化远程插件信息返回体
+// swagger:model TenantInitPluginInfoResp
+type TenantInitPluginInfoResp struct {
+	BaseDataInfo
+	// Tenant initialization plugin information | 租户初始化远程插件数据
+	Data TenantInitPluginInfo `json:"data"`
+}
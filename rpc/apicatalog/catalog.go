@@ -0,0 +1,121 @@
+// Package apicatalog 供各服务在启动时向 core 登记接口目录，代替手动调用 createApi。
+// core 按 服务、方法、路径 新增或更新接口，服务不再登记的接口标记为废弃
+package apicatalog
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/rest"
+	"google.golang.org/grpc"
+
+	"github.com/coder-lulu/newbee-core/rpc/coreclient"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+)
+
+// MethodGRPC RPC 方法登记的请求类型
+const MethodGRPC = "GRPC"
+
+// ServiceInfoProvider gRPC 服务信息，与 gRPC reflection 使用的来源相同，*grpc.Server 实现了该接口
+type ServiceInfoProvider interface {
+	GetServiceInfo() map[string]grpc.ServiceInfo
+}
+
+// FromRoutes 将 go-zero 路由转换为登记的接口，可直接传入 server.Routes()
+func FromRoutes(routes []rest.Route) []*core.ApiRegistrationItem {
+	items := make([]*core.ApiRegistrationItem, 0, len(routes))
+	for _, r := range routes {
+		items = append(items, &core.ApiRegistrationItem{
+			Path:   r.Path,
+			Method: strings.ToUpper(r.Method),
+		})
+	}
+	return items
+}
+
+// FromServiceDesc 将生成代码中的 gRPC 服务描述转换为登记的接口，路径为完整方法名，如 /core.Core/getUserList
+func FromServiceDesc(descs ...*grpc.ServiceDesc) []*core.ApiRegistrationItem {
+	var items []*core.ApiRegistrationItem
+	for _, desc := range descs {
+		for _, m := range desc.Methods {
+			items = append(items, grpcItem(desc.ServiceName, m.MethodName))
+		}
+		for _, m := range desc.Streams {
+			items = append(items, grpcItem(desc.ServiceName, m.StreamName))
+		}
+	}
+	return items
+}
+
+// FromGRPC 通过 gRPC 服务信息（与 gRPC reflection 相同）获取全部方法，grpc. 开头的 reflection 和健康检查服务不登记。
+// GetServiceInfo 与服务注册没有加锁保护，必须在全部服务注册完成后调用，如服务启动之后，
+// 不能在 zrpc 的注册回调中并发调用
+func FromGRPC(server ServiceInfoProvider) []*core.ApiRegistrationItem {
+	services := server.GetServiceInfo()
+	names := make([]string, 0, len(services))
+	for name := range services {
+		if !strings.HasPrefix(name, "grpc.") {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	var items []*core.ApiRegistrationItem
+	for _, name := range names {
+		for _, m := range services[name].Methods {
+			items = append(items, grpcItem(name, m.Name))
+		}
+	}
+	return items
+}
+
+// Register 登记服务的全部接口。同一服务名的接口目录整体替换，
+// 同时提供 HTTP 和 RPC 的服务需要使用不同的服务名分别登记，如 Core 和 CoreRpc
+func Register(ctx context.Context, cli coreclient.Core, serviceName string, items ...[]*core.ApiRegistrationItem) (*core.ApiRegistrationResp, error) {
+	req := &core.ApiRegistrationReq{ServiceName: serviceName}
+	for _, v := range items {
+		req.Apis = append(req.Apis, v...)
+	}
+
+	resp, err := cli.ApiRegistration(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	Log(serviceName, resp)
+
+	return resp, nil
+}
+
+// Log 记录登记结果，指向已废弃或不存在接口的权限规则需要管理员处理
+func Log(serviceName string, resp *core.ApiRegistrationResp) {
+	logx.Infow("api catalog registered",
+		logx.Field("serviceName", serviceName),
+		logx.Field("created", resp.Created),
+		logx.Field("updated", resp.Updated),
+		logx.Field("restored", resp.Restored),
+		logx.Field("deprecated", resp.Deprecated))
+
+	if len(resp.StaleRules) == 0 {
+		return
+	}
+	rules := make([]string, 0, len(resp.StaleRules))
+	for _, r := range resp.StaleRules {
+		rules = append(rules, fmt.Sprintf("%d %s %s %s", r.TenantId, r.Role, r.Method, r.Path))
+	}
+	logx.Errorw("api permission rules point at deprecated or unknown apis",
+		logx.Field("serviceName", serviceName),
+		logx.Field("rules", rules))
+}
+
+// grpcItem RPC 方法按服务分组
+func grpcItem(service, method string) *core.ApiRegistrationItem {
+	return &core.ApiRegistrationItem{
+		Path:     "/" + service + "/" + method,
+		Method:   MethodGRPC,
+		ApiGroup: &service,
+	}
+}
@@ -1,17 +1,28 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"fmt"
+	"os"
+	"strings"
 
 	"github.com/coder-lulu/newbee-common/v2/orm/ent/hooks"
+	"github.com/coder-lulu/newbee-core/rpc/apicatalog"
 	"github.com/coder-lulu/newbee-core/rpc/internal/config"
+	"github.com/coder-lulu/newbee-core/rpc/internal/janitor"
+	"github.com/coder-lulu/newbee-core/rpc/internal/ldapsync"
+	"github.com/coder-lulu/newbee-core/rpc/internal/logic/api"
+	"github.com/coder-lulu/newbee-core/rpc/internal/oauth"
 	"github.com/coder-lulu/newbee-core/rpc/internal/server"
 	"github.com/coder-lulu/newbee-core/rpc/internal/svc"
+	"github.com/coder-lulu/newbee-core/rpc/internal/tenantdoctor"
 	"github.com/coder-lulu/newbee-core/rpc/types/core"
 
 	"github.com/zeromicro/go-zero/core/conf"
+	"github.com/zeromicro/go-zero/core/logx"
 	"github.com/zeromicro/go-zero/core/service"
+	"github.com/zeromicro/go-zero/core/threading"
 	"github.com/zeromicro/go-zero/zrpc"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
@@ -19,6 +30,15 @@ import (
 
 var configFile = flag.String("f", "etc/core.yaml", "the config file")
 
+// 租户诊断命令行模式：检查后直接退出，不启动 RPC 服务
+var (
+	doctor        = flag.Bool("doctor", false, "check tenant data integrity and exit")
+	doctorTenant  = flag.Uint64("tenant", 0, "the tenant to check or repair, all tenants when 0")
+	doctorRepair  = flag.Bool("repair", false, "apply the repair plans of the findings, requires -tenant")
+	doctorCodes   = flag.String("codes", "", "comma separated finding codes to repair, all when empty")
+	adminPassword = flag.String("admin-password", "", "password used when the admin user has to be recreated")
+)
+
 func main() {
 	flag.Parse()
 
@@ -27,6 +47,10 @@ func main() {
 
 	ctx := svc.NewServiceContext(c)
 
+	if *doctor {
+		os.Exit(runDoctor(ctx))
+	}
+
 	s := zrpc.MustNewServer(c.RpcServerConf, func(grpcServer *grpc.Server) {
 		core.RegisterCoreServer(grpcServer, server.NewCoreServer(ctx))
 
@@ -42,6 +66,101 @@ func main() {
 
 	defer s.Stop()
 
+	// 登记 RPC 方法到接口目录，HTTP 接口由 api 服务登记
+	threading.GoSafe(func() {
+		registerApiCatalog(ctx)
+	})
+
+	// 定期清理过期令牌
+	tokenJanitor := janitor.NewTokenJanitor(c.TokenJanitor, ctx.DB, ctx.Redis)
+	tokenJanitor.Start()
+	defer tokenJanitor.Stop()
+
+	// 定期写入审计日志签名检查点，按保留策略归档清理并维护分区
+	auditJanitor := janitor.NewAuditJanitor(c.AuditLog, c.DatabaseConf.Type, ctx.DB, ctx.Redis, ctx.AuditSigner, ctx.AuditArchiveStore)
+	auditJanitor.Start()
+	defer auditJanitor.Stop()
+
+	// 按各 LDAP 提供商的同步间隔定时同步目录
+	ldapScheduler := ldapsync.NewScheduler(c.Ldap, ctx.DB, ctx.LdapSync)
+	ldapScheduler.Start()
+	defer ldapScheduler.Stop()
+
+	// 在过期前刷新第三方账号令牌
+	tokenRefresher := oauth.NewTokenRefresher(c.OauthToken, ctx.DB, ctx.OauthTokens)
+	tokenRefresher.Start()
+	defer tokenRefresher.Stop()
+
 	fmt.Printf("Starting rpc server at %s...\n", c.ListenOn)
 	s.Start()
 }
+
+// registerApiCatalog 以 CoreRpc 服务名登记 core 的 RPC 方法，与 api 服务登记的 Core 接口分开。
+// 方法列表取自服务描述，不读取运行中的 gRPC 服务
+func registerApiCatalog(svcCtx *svc.ServiceContext) {
+	resp, err := api.NewApiRegistrationLogic(context.Background(), svcCtx).ApiRegistration(&core.ApiRegistrationReq{
+		ServiceName: "CoreRpc",
+		Apis:        apicatalog.FromServiceDesc(&core.Core_ServiceDesc),
+	})
+	if err != nil {
+		logx.Errorw("failed to register the api catalog", logx.Field("error", err.Error()))
+		return
+	}
+	apicatalog.Log("CoreRpc", resp)
+}
+
+// runDoctor 执行租户诊断或修复并打印结果，返回进程退出码
+func runDoctor(svcCtx *svc.ServiceContext) int {
+	d := tenantdoctor.NewDoctor(svcCtx, logx.WithContext(context.Background()))
+
+	var (
+		findings []tenantdoctor.Finding
+		err      error
+	)
+	if *doctorRepair {
+		if *doctorTenant == 0 {
+			fmt.Println("-repair requires -tenant")
+			return 2
+		}
+
+		var codes []string
+		if *doctorCodes != "" {
+			codes = strings.Split(*doctorCodes, ",")
+		}
+
+		var password *string
+		if *adminPassword != "" {
+			password = adminPassword
+		}
+
+		findings, err = d.Repair(context.Background(), *doctorTenant, codes, password)
+	} else if *doctorTenant != 0 {
+		findings, err = d.Diagnose(context.Background(), *doctorTenant)
+	} else {
+		findings, err = d.Diagnose(context.Background())
+	}
+	if err != nil {
+		fmt.Printf("tenant doctor failed: %v\n", err)
+		return 1
+	}
+
+	for _, f := range findings {
+		fmt.Printf("[tenant %d] %s: %s\n", f.TenantID, f.Code, f.Message)
+		for _, subject := range f.Subjects {
+			fmt.Printf("    - %s\n", subject)
+		}
+		fmt.Printf("    repair: %s\n", f.Repair)
+	}
+
+	if *doctorRepair {
+		fmt.Printf("%d finding(s) repaired\n", len(findings))
+		return 0
+	}
+
+	fmt.Printf("%d finding(s)\n", len(findings))
+	if len(findings) > 0 {
+		return 1
+	}
+
+	return 0
+}
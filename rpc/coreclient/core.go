@@ -13,107 +13,212 @@ import (
 )
 
 type (
-	ApiInfo                      = core.ApiInfo
-	ApiListReq                   = core.ApiListReq
-	ApiListResp                  = core.ApiListResp
-	AuditLogInfo                 = core.AuditLogInfo
-	AuditLogListReq              = core.AuditLogListReq
-	AuditLogListResp             = core.AuditLogListResp
-	AuditLogStatsReq             = core.AuditLogStatsReq
-	AuditLogStatsResp            = core.AuditLogStatsResp
-	BaseIDResp                   = core.BaseIDResp
-	BaseMsg                      = core.BaseMsg
-	BaseResp                     = core.BaseResp
-	BaseUUIDResp                 = core.BaseUUIDResp
-	BatchCreateCasbinRulesReq    = core.BatchCreateCasbinRulesReq
-	BatchPermissionCheckReq      = core.BatchPermissionCheckReq
-	BatchPermissionCheckResp     = core.BatchPermissionCheckResp
-	BatchUpdateCasbinRulesReq    = core.BatchUpdateCasbinRulesReq
-	BindOauthAccountReq          = core.BindOauthAccountReq
-	CallbackReq                  = core.CallbackReq
-	CasbinRuleInfo               = core.CasbinRuleInfo
-	CasbinRuleListReq            = core.CasbinRuleListReq
-	CasbinRuleListResp           = core.CasbinRuleListResp
-	ConfigurationInfo            = core.ConfigurationInfo
-	ConfigurationListReq         = core.ConfigurationListReq
-	ConfigurationListResp        = core.ConfigurationListResp
-	CreateOauthSessionReq        = core.CreateOauthSessionReq
-	DepartmentInfo               = core.DepartmentInfo
-	DepartmentListReq            = core.DepartmentListReq
-	DepartmentListResp           = core.DepartmentListResp
-	DictionaryDetailInfo         = core.DictionaryDetailInfo
-	DictionaryDetailListReq      = core.DictionaryDetailListReq
-	DictionaryDetailListResp     = core.DictionaryDetailListResp
-	DictionaryInfo               = core.DictionaryInfo
-	DictionaryListReq            = core.DictionaryListReq
-	DictionaryListResp           = core.DictionaryListResp
-	DurationStats                = core.DurationStats
-	Empty                        = core.Empty
-	GetOauthSessionByStateReq    = core.GetOauthSessionByStateReq
-	GetUserOauthAccountsReq      = core.GetUserOauthAccountsReq
-	GetUserOauthAccountsResp     = core.GetUserOauthAccountsResp
-	GetUserPermissionSummaryReq  = core.GetUserPermissionSummaryReq
-	GetUserPermissionSummaryResp = core.GetUserPermissionSummaryResp
-	IDReq                        = core.IDReq
-	IDsReq                       = core.IDsReq
-	MenuInfo                     = core.MenuInfo
-	MenuInfoList                 = core.MenuInfoList
-	MenuRoleInfo                 = core.MenuRoleInfo
-	MenuRoleListResp             = core.MenuRoleListResp
-	Meta                         = core.Meta
-	OauthAccountInfo             = core.OauthAccountInfo
-	OauthAccountListReq          = core.OauthAccountListReq
-	OauthAccountListResp         = core.OauthAccountListResp
-	OauthLoginReq                = core.OauthLoginReq
-	OauthProviderInfo            = core.OauthProviderInfo
-	OauthProviderListReq         = core.OauthProviderListReq
-	OauthProviderListResp        = core.OauthProviderListResp
-	OauthRedirectResp            = core.OauthRedirectResp
-	OauthSessionInfo             = core.OauthSessionInfo
-	OperationTypeStats           = core.OperationTypeStats
-	PageInfoReq                  = core.PageInfoReq
-	PermissionCheckReq           = core.PermissionCheckReq
-	PermissionCheckResp          = core.PermissionCheckResp
-	PermissionSummary            = core.PermissionSummary
-	PositionInfo                 = core.PositionInfo
-	PositionListReq              = core.PositionListReq
-	PositionListResp             = core.PositionListResp
-	PublicTenantInfo             = core.PublicTenantInfo
-	PublicTenantListResp         = core.PublicTenantListResp
-	RefreshCasbinCacheReq        = core.RefreshCasbinCacheReq
-	RefreshCasbinCacheResp       = core.RefreshCasbinCacheResp
-	ResetPwdReq                  = core.ResetPwdReq
-	ResourceTypeStats            = core.ResourceTypeStats
-	RoleAuthReq                  = core.RoleAuthReq
-	RoleDataScopeReq             = core.RoleDataScopeReq
-	RoleInfo                     = core.RoleInfo
-	RoleListReq                  = core.RoleListReq
-	RoleListResp                 = core.RoleListResp
-	RoleMenuAuthorityReq         = core.RoleMenuAuthorityReq
-	RoleMenuAuthorityResp        = core.RoleMenuAuthorityResp
-	RoleStatusChangeParam        = core.RoleStatusChangeParam
-	RoleUnallocatedListReq       = core.RoleUnallocatedListReq
-	SyncCasbinRulesReq           = core.SyncCasbinRulesReq
-	SyncCasbinRulesResp          = core.SyncCasbinRulesResp
-	TenantCodeReq                = core.TenantCodeReq
-	TenantInfo                   = core.TenantInfo
-	TenantInitReq                = core.TenantInitReq
-	TenantListReq                = core.TenantListReq
-	TenantListResp               = core.TenantListResp
-	TenantStatusReq              = core.TenantStatusReq
-	TokenInfo                    = core.TokenInfo
-	TokenListReq                 = core.TokenListReq
-	TokenListResp                = core.TokenListResp
-	UUIDReq                      = core.UUIDReq
-	UUIDsReq                     = core.UUIDsReq
-	UnbindOauthAccountReq        = core.UnbindOauthAccountReq
-	UpdateOauthSessionReq        = core.UpdateOauthSessionReq
-	UserInfo                     = core.UserInfo
-	UserListReq                  = core.UserListReq
-	UserListResp                 = core.UserListResp
-	UsernameReq                  = core.UsernameReq
-	ValidateCasbinRuleReq        = core.ValidateCasbinRuleReq
-	ValidateCasbinRuleResp       = core.ValidateCasbinRuleResp
+	ApiInfo                        = core.ApiInfo
+	ApiListReq                     = core.ApiListReq
+	ApiListResp                    = core.ApiListResp
+	ApiRegistrationItem            = core.ApiRegistrationItem
+	ApiRegistrationReq             = core.ApiRegistrationReq
+	ApiRegistrationResp            = core.ApiRegistrationResp
+	ApiStaleRule                   = core.ApiStaleRule
+	AuditLogArchiveInfo            = core.AuditLogArchiveInfo
+	AuditLogArchiveListReq         = core.AuditLogArchiveListReq
+	AuditLogArchiveListResp        = core.AuditLogArchiveListResp
+	AuditLogChainIssue             = core.AuditLogChainIssue
+	AuditLogInfo                   = core.AuditLogInfo
+	AuditLogListReq                = core.AuditLogListReq
+	AuditLogListResp               = core.AuditLogListResp
+	AuditLogStatsReq               = core.AuditLogStatsReq
+	AuditLogStatsResp              = core.AuditLogStatsResp
+	AuditLogVerifyResp             = core.AuditLogVerifyResp
+	BaseIDResp                     = core.BaseIDResp
+	BaseMsg                        = core.BaseMsg
+	BaseResp                       = core.BaseResp
+	BaseUUIDResp                   = core.BaseUUIDResp
+	BatchCreateCasbinRulesReq      = core.BatchCreateCasbinRulesReq
+	BatchPermissionCheckReq        = core.BatchPermissionCheckReq
+	BatchPermissionCheckResp       = core.BatchPermissionCheckResp
+	BatchUpdateCasbinRulesReq      = core.BatchUpdateCasbinRulesReq
+	BindOauthAccountReq            = core.BindOauthAccountReq
+	CallbackReq                    = core.CallbackReq
+	CasbinRuleInfo                 = core.CasbinRuleInfo
+	CasbinRuleListReq              = core.CasbinRuleListReq
+	CasbinRuleListResp             = core.CasbinRuleListResp
+	ConfigurationInfo              = core.ConfigurationInfo
+	ConfigurationListReq           = core.ConfigurationListReq
+	ConfigurationListResp          = core.ConfigurationListResp
+	CreateOauthSessionReq          = core.CreateOauthSessionReq
+	DepartmentInfo                 = core.DepartmentInfo
+	DepartmentListReq              = core.DepartmentListReq
+	DepartmentListResp             = core.DepartmentListResp
+	DictionaryDetailInfo           = core.DictionaryDetailInfo
+	DictionaryDetailListReq        = core.DictionaryDetailListReq
+	DictionaryDetailListResp       = core.DictionaryDetailListResp
+	DictionaryInfo                 = core.DictionaryInfo
+	DictionaryListReq              = core.DictionaryListReq
+	DictionaryListResp             = core.DictionaryListResp
+	DurationStats                  = core.DurationStats
+	Empty                          = core.Empty
+	EnableOauthProviderTemplateReq = core.EnableOauthProviderTemplateReq
+	GetOauthSessionByStateReq      = core.GetOauthSessionByStateReq
+	GetUserOauthAccountsReq        = core.GetUserOauthAccountsReq
+	GetUserOauthAccountsResp       = core.GetUserOauthAccountsResp
+	GetUserPermissionSummaryReq    = core.GetUserPermissionSummaryReq
+	GetUserPermissionSummaryResp   = core.GetUserPermissionSummaryResp
+	IDReq                          = core.IDReq
+	IDsReq                         = core.IDsReq
+	ImpersonationReviewReq         = core.ImpersonationReviewReq
+	ImpersonationSessionInfo       = core.ImpersonationSessionInfo
+	ImpersonationSessionListReq    = core.ImpersonationSessionListReq
+	ImpersonationSessionListResp   = core.ImpersonationSessionListResp
+	ImpersonationStartReq          = core.ImpersonationStartReq
+	LdapLoginReq                   = core.LdapLoginReq
+	LdapProviderInfo               = core.LdapProviderInfo
+	LdapProviderListReq            = core.LdapProviderListReq
+	LdapProviderListResp           = core.LdapProviderListResp
+	LdapSyncChange                 = core.LdapSyncChange
+	LdapSyncConflict               = core.LdapSyncConflict
+	LdapSyncCounter                = core.LdapSyncCounter
+	LdapSyncReq                    = core.LdapSyncReq
+	LdapSyncRunInfo                = core.LdapSyncRunInfo
+	LdapSyncRunListReq             = core.LdapSyncRunListReq
+	LdapSyncRunListResp            = core.LdapSyncRunListResp
+	LdapSyncStats                  = core.LdapSyncStats
+	MenuInfo                       = core.MenuInfo
+	MenuInfoList                   = core.MenuInfoList
+	MenuRoleInfo                   = core.MenuRoleInfo
+	MenuRoleListResp               = core.MenuRoleListResp
+	Meta                           = core.Meta
+	OauthAccessTokenReq            = core.OauthAccessTokenReq
+	OauthAccessTokenResp           = core.OauthAccessTokenResp
+	OauthAccountInfo               = core.OauthAccountInfo
+	OauthAccountListReq            = core.OauthAccountListReq
+	OauthAccountListResp           = core.OauthAccountListResp
+	OauthAuthorizeReq              = core.OauthAuthorizeReq
+	OauthAuthorizeResp             = core.OauthAuthorizeResp
+	OauthCallbackResp              = core.OauthCallbackResp
+	OauthClaimMappingPreviewReq    = core.OauthClaimMappingPreviewReq
+	OauthClaimMappingPreviewResp   = core.OauthClaimMappingPreviewResp
+	OauthClientAuthReq             = core.OauthClientAuthReq
+	OauthClientIdReq               = core.OauthClientIdReq
+	OauthClientInfo                = core.OauthClientInfo
+	OauthClientListReq             = core.OauthClientListReq
+	OauthClientListResp            = core.OauthClientListResp
+	OauthClientSecretResp          = core.OauthClientSecretResp
+	OauthCodeExchangeReq           = core.OauthCodeExchangeReq
+	OauthConsentInfo               = core.OauthConsentInfo
+	OauthConsentListReq            = core.OauthConsentListReq
+	OauthConsentListResp           = core.OauthConsentListResp
+	OauthGrantInfo                 = core.OauthGrantInfo
+	OauthLoginReq                  = core.OauthLoginReq
+	OauthProviderInfo              = core.OauthProviderInfo
+	OauthProviderListReq           = core.OauthProviderListReq
+	OauthProviderListResp          = core.OauthProviderListResp
+	OauthProviderTemplateInfo      = core.OauthProviderTemplateInfo
+	OauthProviderTemplateListReq   = core.OauthProviderTemplateListReq
+	OauthProviderTemplateListResp  = core.OauthProviderTemplateListResp
+	OauthRedirectResp              = core.OauthRedirectResp
+	OauthScopeInfo                 = core.OauthScopeInfo
+	OauthScopeListReq              = core.OauthScopeListReq
+	OauthScopeListResp             = core.OauthScopeListResp
+	OauthSessionInfo               = core.OauthSessionInfo
+	OauthWebhookReq                = core.OauthWebhookReq
+	OauthWebhookResp               = core.OauthWebhookResp
+	OperationTypeStats             = core.OperationTypeStats
+	PageInfoReq                    = core.PageInfoReq
+	PermissionCheckReq             = core.PermissionCheckReq
+	PermissionCheckResp            = core.PermissionCheckResp
+	PermissionSummary              = core.PermissionSummary
+	PolicyBundleChange             = core.PolicyBundleChange
+	PolicyBundleExportReq          = core.PolicyBundleExportReq
+	PolicyBundleImportReq          = core.PolicyBundleImportReq
+	PolicyBundleImportResp         = core.PolicyBundleImportResp
+	PolicyBundleResp               = core.PolicyBundleResp
+	PositionInfo                   = core.PositionInfo
+	PositionListReq                = core.PositionListReq
+	PositionListResp               = core.PositionListResp
+	PublicTenantInfo               = core.PublicTenantInfo
+	PublicTenantListResp           = core.PublicTenantListResp
+	RefreshCasbinCacheReq          = core.RefreshCasbinCacheReq
+	RefreshCasbinCacheResp         = core.RefreshCasbinCacheResp
+	ReplaceRolePoliciesReq         = core.ReplaceRolePoliciesReq
+	ReplaceRolePoliciesResp        = core.ReplaceRolePoliciesResp
+	ResetPwdReq                    = core.ResetPwdReq
+	ResourceTypeStats              = core.ResourceTypeStats
+	RoleAuthReq                    = core.RoleAuthReq
+	RoleDataScopeReq               = core.RoleDataScopeReq
+	RoleEffectiveApi               = core.RoleEffectiveApi
+	RoleEffectivePermissionsResp   = core.RoleEffectivePermissionsResp
+	RoleInfo                       = core.RoleInfo
+	RoleListReq                    = core.RoleListReq
+	RoleListResp                   = core.RoleListResp
+	RoleMenuAuthorityReq           = core.RoleMenuAuthorityReq
+	RoleMenuAuthorityResp          = core.RoleMenuAuthorityResp
+	RoleParentsReq                 = core.RoleParentsReq
+	RoleParentsResp                = core.RoleParentsResp
+	RolePolicyInfo                 = core.RolePolicyInfo
+	RolePolicyListResp             = core.RolePolicyListResp
+	RoleStatusChangeParam          = core.RoleStatusChangeParam
+	RoleUnallocatedListReq         = core.RoleUnallocatedListReq
+	SamlAcsReq                     = core.SamlAcsReq
+	SamlAcsResp                    = core.SamlAcsResp
+	SamlLoginReq                   = core.SamlLoginReq
+	SamlLoginResp                  = core.SamlLoginResp
+	SamlMetadataImportReq          = core.SamlMetadataImportReq
+	SamlProviderInfo               = core.SamlProviderInfo
+	SamlProviderListReq            = core.SamlProviderListReq
+	SamlProviderListResp           = core.SamlProviderListResp
+	SamlSpMetadataReq              = core.SamlSpMetadataReq
+	SamlSpMetadataResp             = core.SamlSpMetadataResp
+	ScimTokenAuthReq               = core.ScimTokenAuthReq
+	ScimTokenCreateResp            = core.ScimTokenCreateResp
+	ScimTokenInfo                  = core.ScimTokenInfo
+	ScimTokenListReq               = core.ScimTokenListReq
+	ScimTokenListResp              = core.ScimTokenListResp
+	SyncCasbinRulesReq             = core.SyncCasbinRulesReq
+	SyncCasbinRulesResp            = core.SyncCasbinRulesResp
+	TenantCodeReq                  = core.TenantCodeReq
+	TenantDoctorFinding            = core.TenantDoctorFinding
+	TenantDoctorReq                = core.TenantDoctorReq
+	TenantDoctorResp               = core.TenantDoctorResp
+	TenantInfo                     = core.TenantInfo
+	TenantInitJobInfo              = core.TenantInitJobInfo
+	TenantInitJobListReq           = core.TenantInitJobListReq
+	TenantInitJobListResp          = core.TenantInitJobListResp
+	TenantInitJobRetryReq          = core.TenantInitJobRetryReq
+	TenantInitPlanItem             = core.TenantInitPlanItem
+	TenantInitPluginInfo           = core.TenantInitPluginInfo
+	TenantInitPluginListReq        = core.TenantInitPluginListReq
+	TenantInitPluginListResp       = core.TenantInitPluginListResp
+	TenantInitPluginProgress       = core.TenantInitPluginProgress
+	TenantInitReq                  = core.TenantInitReq
+	TenantInitTemplateInfo         = core.TenantInitTemplateInfo
+	TenantInitTemplateListReq      = core.TenantInitTemplateListReq
+	TenantInitTemplateListResp     = core.TenantInitTemplateListResp
+	TenantInitTemplatePreviewReq   = core.TenantInitTemplatePreviewReq
+	TenantInitTemplatePreviewResp  = core.TenantInitTemplatePreviewResp
+	TenantListReq                  = core.TenantListReq
+	TenantListResp                 = core.TenantListResp
+	TenantPluginInitReq            = core.TenantPluginInitReq
+	TenantPluginStatusResp         = core.TenantPluginStatusResp
+	TenantPluginTenantReq          = core.TenantPluginTenantReq
+	TenantRepairReq                = core.TenantRepairReq
+	TenantStatusReq                = core.TenantStatusReq
+	TokenInfo                      = core.TokenInfo
+	TokenListReq                   = core.TokenListReq
+	TokenListResp                  = core.TokenListResp
+	TokenTouchReq                  = core.TokenTouchReq
+	UUIDReq                        = core.UUIDReq
+	UUIDsReq                       = core.UUIDsReq
+	UnbindOauthAccountReq          = core.UnbindOauthAccountReq
+	UpdateOauthSessionReq          = core.UpdateOauthSessionReq
+	UserInfo                       = core.UserInfo
+	UserListReq                    = core.UserListReq
+	UserListResp                   = core.UserListResp
+	UserSessionListReq             = core.UserSessionListReq
+	UserSessionRevokeReq           = core.UserSessionRevokeReq
+	UsernameReq                    = core.UsernameReq
+	ValidateCasbinRuleReq          = core.ValidateCasbinRuleReq
+	ValidateCasbinRuleResp         = core.ValidateCasbinRuleResp
 
 	Core interface {
 		// API management
@@ -122,14 +227,21 @@ type (
 		GetApiList(ctx context.Context, in *ApiListReq, opts ...grpc.CallOption) (*ApiListResp, error)
 		GetApiById(ctx context.Context, in *IDReq, opts ...grpc.CallOption) (*ApiInfo, error)
 		DeleteApi(ctx context.Context, in *IDsReq, opts ...grpc.CallOption) (*BaseResp, error)
+		ApiRegistration(ctx context.Context, in *ApiRegistrationReq, opts ...grpc.CallOption) (*ApiRegistrationResp, error)
 		// AuditLog management
 		CreateAuditLog(ctx context.Context, in *AuditLogInfo, opts ...grpc.CallOption) (*BaseUUIDResp, error)
 		GetAuditLogList(ctx context.Context, in *AuditLogListReq, opts ...grpc.CallOption) (*AuditLogListResp, error)
 		GetAuditLogById(ctx context.Context, in *UUIDReq, opts ...grpc.CallOption) (*AuditLogInfo, error)
-		DeleteAuditLog(ctx context.Context, in *UUIDsReq, opts ...grpc.CallOption) (*BaseResp, error)
 		GetAuditLogStats(ctx context.Context, in *AuditLogStatsReq, opts ...grpc.CallOption) (*AuditLogStatsResp, error)
+		VerifyAuditLogChain(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*AuditLogVerifyResp, error)
+		GetAuditLogArchiveList(ctx context.Context, in *AuditLogArchiveListReq, opts ...grpc.CallOption) (*AuditLogArchiveListResp, error)
+		RestoreAuditLogRange(ctx context.Context, in *AuditLogListReq, opts ...grpc.CallOption) (*AuditLogListResp, error)
 		GetMenuAuthority(ctx context.Context, in *IDReq, opts ...grpc.CallOption) (*RoleMenuAuthorityResp, error)
 		CreateOrUpdateMenuAuthority(ctx context.Context, in *RoleMenuAuthorityReq, opts ...grpc.CallOption) (*BaseResp, error)
+		GetRolePolicies(ctx context.Context, in *IDReq, opts ...grpc.CallOption) (*RolePolicyListResp, error)
+		ReplaceRolePolicies(ctx context.Context, in *ReplaceRolePoliciesReq, opts ...grpc.CallOption) (*ReplaceRolePoliciesResp, error)
+		ExportPolicyBundle(ctx context.Context, in *PolicyBundleExportReq, opts ...grpc.CallOption) (*PolicyBundleResp, error)
+		ImportPolicyBundle(ctx context.Context, in *PolicyBundleImportReq, opts ...grpc.CallOption) (*PolicyBundleImportResp, error)
 		InitDatabase(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*BaseResp, error)
 		// 权限规则管理
 		CreateCasbinRule(ctx context.Context, in *CasbinRuleInfo, opts ...grpc.CallOption) (*BaseIDResp, error)
@@ -178,12 +290,35 @@ type (
 		GetDictionaryDetailById(ctx context.Context, in *IDReq, opts ...grpc.CallOption) (*DictionaryDetailInfo, error)
 		DeleteDictionaryDetail(ctx context.Context, in *IDsReq, opts ...grpc.CallOption) (*BaseResp, error)
 		GetDictionaryDetailByDictionaryName(ctx context.Context, in *BaseMsg, opts ...grpc.CallOption) (*DictionaryDetailListResp, error)
+		// LdapProvider management
+		CreateLdapProvider(ctx context.Context, in *LdapProviderInfo, opts ...grpc.CallOption) (*BaseIDResp, error)
+		UpdateLdapProvider(ctx context.Context, in *LdapProviderInfo, opts ...grpc.CallOption) (*BaseResp, error)
+		GetLdapProviderList(ctx context.Context, in *LdapProviderListReq, opts ...grpc.CallOption) (*LdapProviderListResp, error)
+		GetLdapProviderById(ctx context.Context, in *IDReq, opts ...grpc.CallOption) (*LdapProviderInfo, error)
+		DeleteLdapProvider(ctx context.Context, in *IDsReq, opts ...grpc.CallOption) (*BaseResp, error)
+		LdapLogin(ctx context.Context, in *LdapLoginReq, opts ...grpc.CallOption) (*UserInfo, error)
+		SyncLdapProvider(ctx context.Context, in *LdapSyncReq, opts ...grpc.CallOption) (*LdapSyncRunInfo, error)
+		GetLdapSyncRunList(ctx context.Context, in *LdapSyncRunListReq, opts ...grpc.CallOption) (*LdapSyncRunListResp, error)
+		GetLdapSyncRunById(ctx context.Context, in *IDReq, opts ...grpc.CallOption) (*LdapSyncRunInfo, error)
 		CreateMenu(ctx context.Context, in *MenuInfo, opts ...grpc.CallOption) (*BaseIDResp, error)
 		UpdateMenu(ctx context.Context, in *MenuInfo, opts ...grpc.CallOption) (*BaseResp, error)
 		DeleteMenu(ctx context.Context, in *IDReq, opts ...grpc.CallOption) (*BaseResp, error)
 		GetMenu(ctx context.Context, in *IDReq, opts ...grpc.CallOption) (*MenuInfo, error)
 		GetMenuListByRole(ctx context.Context, in *BaseMsg, opts ...grpc.CallOption) (*MenuInfoList, error)
 		GetMenuList(ctx context.Context, in *PageInfoReq, opts ...grpc.CallOption) (*MenuInfoList, error)
+		// OauthClient management
+		CreateOauthClient(ctx context.Context, in *OauthClientInfo, opts ...grpc.CallOption) (*OauthClientSecretResp, error)
+		UpdateOauthClient(ctx context.Context, in *OauthClientInfo, opts ...grpc.CallOption) (*BaseResp, error)
+		GetOauthClientList(ctx context.Context, in *OauthClientListReq, opts ...grpc.CallOption) (*OauthClientListResp, error)
+		GetOauthClientById(ctx context.Context, in *IDReq, opts ...grpc.CallOption) (*OauthClientInfo, error)
+		DeleteOauthClient(ctx context.Context, in *IDsReq, opts ...grpc.CallOption) (*BaseResp, error)
+		ResetOauthClientSecret(ctx context.Context, in *IDReq, opts ...grpc.CallOption) (*OauthClientSecretResp, error)
+		GetOauthClientByClientId(ctx context.Context, in *OauthClientIdReq, opts ...grpc.CallOption) (*OauthClientInfo, error)
+		AuthenticateOauthClient(ctx context.Context, in *OauthClientAuthReq, opts ...grpc.CallOption) (*OauthClientInfo, error)
+		AuthorizeOauthClient(ctx context.Context, in *OauthAuthorizeReq, opts ...grpc.CallOption) (*OauthAuthorizeResp, error)
+		ExchangeOauthAuthorizationCode(ctx context.Context, in *OauthCodeExchangeReq, opts ...grpc.CallOption) (*OauthGrantInfo, error)
+		GetOauthConsentList(ctx context.Context, in *OauthConsentListReq, opts ...grpc.CallOption) (*OauthConsentListResp, error)
+		DeleteOauthConsent(ctx context.Context, in *IDsReq, opts ...grpc.CallOption) (*BaseResp, error)
 		// OauthProvider management
 		CreateOauthProvider(ctx context.Context, in *OauthProviderInfo, opts ...grpc.CallOption) (*BaseIDResp, error)
 		UpdateOauthProvider(ctx context.Context, in *OauthProviderInfo, opts ...grpc.CallOption) (*BaseResp, error)
@@ -191,7 +326,9 @@ type (
 		GetOauthProviderById(ctx context.Context, in *IDReq, opts ...grpc.CallOption) (*OauthProviderInfo, error)
 		DeleteOauthProvider(ctx context.Context, in *IDsReq, opts ...grpc.CallOption) (*BaseResp, error)
 		OauthLogin(ctx context.Context, in *OauthLoginReq, opts ...grpc.CallOption) (*OauthRedirectResp, error)
-		OauthCallback(ctx context.Context, in *CallbackReq, opts ...grpc.CallOption) (*UserInfo, error)
+		OauthCallback(ctx context.Context, in *CallbackReq, opts ...grpc.CallOption) (*OauthCallbackResp, error)
+		PreviewOauthClaimMapping(ctx context.Context, in *OauthClaimMappingPreviewReq, opts ...grpc.CallOption) (*OauthClaimMappingPreviewResp, error)
+		OauthWebhook(ctx context.Context, in *OauthWebhookReq, opts ...grpc.CallOption) (*OauthWebhookResp, error)
 		// OAuth Account Binding management
 		CreateOauthAccount(ctx context.Context, in *OauthAccountInfo, opts ...grpc.CallOption) (*BaseIDResp, error)
 		UpdateOauthAccount(ctx context.Context, in *OauthAccountInfo, opts ...grpc.CallOption) (*BaseResp, error)
@@ -201,11 +338,25 @@ type (
 		BindOauthAccount(ctx context.Context, in *BindOauthAccountReq, opts ...grpc.CallOption) (*BaseResp, error)
 		UnbindOauthAccount(ctx context.Context, in *UnbindOauthAccountReq, opts ...grpc.CallOption) (*BaseResp, error)
 		GetUserOauthAccounts(ctx context.Context, in *GetUserOauthAccountsReq, opts ...grpc.CallOption) (*GetUserOauthAccountsResp, error)
+		GetOauthAccessToken(ctx context.Context, in *OauthAccessTokenReq, opts ...grpc.CallOption) (*OauthAccessTokenResp, error)
 		// OAuth Session management
 		CreateOauthSession(ctx context.Context, in *CreateOauthSessionReq, opts ...grpc.CallOption) (*BaseIDResp, error)
 		UpdateOauthSession(ctx context.Context, in *UpdateOauthSessionReq, opts ...grpc.CallOption) (*BaseResp, error)
 		GetOauthSessionByState(ctx context.Context, in *GetOauthSessionByStateReq, opts ...grpc.CallOption) (*OauthSessionInfo, error)
 		DeleteOauthSession(ctx context.Context, in *IDReq, opts ...grpc.CallOption) (*BaseResp, error)
+		// OauthProviderTemplate management
+		CreateOauthProviderTemplate(ctx context.Context, in *OauthProviderTemplateInfo, opts ...grpc.CallOption) (*BaseIDResp, error)
+		UpdateOauthProviderTemplate(ctx context.Context, in *OauthProviderTemplateInfo, opts ...grpc.CallOption) (*BaseResp, error)
+		GetOauthProviderTemplateList(ctx context.Context, in *OauthProviderTemplateListReq, opts ...grpc.CallOption) (*OauthProviderTemplateListResp, error)
+		GetOauthProviderTemplateById(ctx context.Context, in *IDReq, opts ...grpc.CallOption) (*OauthProviderTemplateInfo, error)
+		DeleteOauthProviderTemplate(ctx context.Context, in *IDsReq, opts ...grpc.CallOption) (*BaseResp, error)
+		EnableOauthProviderTemplate(ctx context.Context, in *EnableOauthProviderTemplateReq, opts ...grpc.CallOption) (*BaseIDResp, error)
+		// OauthScope management
+		CreateOauthScope(ctx context.Context, in *OauthScopeInfo, opts ...grpc.CallOption) (*BaseIDResp, error)
+		UpdateOauthScope(ctx context.Context, in *OauthScopeInfo, opts ...grpc.CallOption) (*BaseResp, error)
+		GetOauthScopeList(ctx context.Context, in *OauthScopeListReq, opts ...grpc.CallOption) (*OauthScopeListResp, error)
+		GetOauthScopeById(ctx context.Context, in *IDReq, opts ...grpc.CallOption) (*OauthScopeInfo, error)
+		DeleteOauthScope(ctx context.Context, in *IDsReq, opts ...grpc.CallOption) (*BaseResp, error)
 		// Position management
 		CreatePosition(ctx context.Context, in *PositionInfo, opts ...grpc.CallOption) (*BaseIDResp, error)
 		UpdatePosition(ctx context.Context, in *PositionInfo, opts ...grpc.CallOption) (*BaseResp, error)
@@ -218,11 +369,33 @@ type (
 		GetRoleList(ctx context.Context, in *RoleListReq, opts ...grpc.CallOption) (*RoleListResp, error)
 		GetRoleById(ctx context.Context, in *IDReq, opts ...grpc.CallOption) (*RoleInfo, error)
 		DeleteRole(ctx context.Context, in *IDsReq, opts ...grpc.CallOption) (*BaseResp, error)
+		// Delete the role after removing it from its users
+		DeleteRoleWithUsers(ctx context.Context, in *IDReq, opts ...grpc.CallOption) (*BaseResp, error)
 		InitRoleDataPermToRedis(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*BaseResp, error)
 		AssignRoleDataScope(ctx context.Context, in *RoleDataScopeReq, opts ...grpc.CallOption) (*BaseResp, error)
 		CancelAuth(ctx context.Context, in *RoleAuthReq, opts ...grpc.CallOption) (*BaseResp, error)
 		AddAuth(ctx context.Context, in *RoleAuthReq, opts ...grpc.CallOption) (*BaseResp, error)
 		ChangeRoleStatus(ctx context.Context, in *RoleStatusChangeParam, opts ...grpc.CallOption) (*BaseResp, error)
+		SetRoleParents(ctx context.Context, in *RoleParentsReq, opts ...grpc.CallOption) (*BaseResp, error)
+		GetRoleParents(ctx context.Context, in *IDReq, opts ...grpc.CallOption) (*RoleParentsResp, error)
+		GetRoleEffectivePermissions(ctx context.Context, in *IDReq, opts ...grpc.CallOption) (*RoleEffectivePermissionsResp, error)
+		// SamlProvider management
+		CreateSamlProvider(ctx context.Context, in *SamlProviderInfo, opts ...grpc.CallOption) (*BaseIDResp, error)
+		UpdateSamlProvider(ctx context.Context, in *SamlProviderInfo, opts ...grpc.CallOption) (*BaseResp, error)
+		GetSamlProviderList(ctx context.Context, in *SamlProviderListReq, opts ...grpc.CallOption) (*SamlProviderListResp, error)
+		GetSamlProviderById(ctx context.Context, in *IDReq, opts ...grpc.CallOption) (*SamlProviderInfo, error)
+		DeleteSamlProvider(ctx context.Context, in *IDsReq, opts ...grpc.CallOption) (*BaseResp, error)
+		ImportSamlIdpMetadata(ctx context.Context, in *SamlMetadataImportReq, opts ...grpc.CallOption) (*BaseResp, error)
+		GetSamlSpMetadata(ctx context.Context, in *SamlSpMetadataReq, opts ...grpc.CallOption) (*SamlSpMetadataResp, error)
+		SamlLogin(ctx context.Context, in *SamlLoginReq, opts ...grpc.CallOption) (*SamlLoginResp, error)
+		SamlAcs(ctx context.Context, in *SamlAcsReq, opts ...grpc.CallOption) (*SamlAcsResp, error)
+		// ScimToken management
+		CreateScimToken(ctx context.Context, in *ScimTokenInfo, opts ...grpc.CallOption) (*ScimTokenCreateResp, error)
+		UpdateScimToken(ctx context.Context, in *ScimTokenInfo, opts ...grpc.CallOption) (*BaseResp, error)
+		GetScimTokenList(ctx context.Context, in *ScimTokenListReq, opts ...grpc.CallOption) (*ScimTokenListResp, error)
+		GetScimTokenById(ctx context.Context, in *IDReq, opts ...grpc.CallOption) (*ScimTokenInfo, error)
+		DeleteScimToken(ctx context.Context, in *IDsReq, opts ...grpc.CallOption) (*BaseResp, error)
+		AuthenticateScimToken(ctx context.Context, in *ScimTokenAuthReq, opts ...grpc.CallOption) (*ScimTokenInfo, error)
 		// Tenant management
 		CreateTenant(ctx context.Context, in *TenantInfo, opts ...grpc.CallOption) (*BaseIDResp, error)
 		UpdateTenant(ctx context.Context, in *TenantInfo, opts ...grpc.CallOption) (*BaseResp, error)
@@ -231,8 +404,31 @@ type (
 		GetTenantByCode(ctx context.Context, in *TenantCodeReq, opts ...grpc.CallOption) (*TenantInfo, error)
 		DeleteTenant(ctx context.Context, in *IDsReq, opts ...grpc.CallOption) (*BaseResp, error)
 		UpdateTenantStatus(ctx context.Context, in *TenantStatusReq, opts ...grpc.CallOption) (*BaseResp, error)
-		InitTenant(ctx context.Context, in *TenantInitReq, opts ...grpc.CallOption) (*BaseResp, error)
+		InitTenant(ctx context.Context, in *TenantInitReq, opts ...grpc.CallOption) (*BaseIDResp, error)
+		GetTenantInitJobById(ctx context.Context, in *IDReq, opts ...grpc.CallOption) (*TenantInitJobInfo, error)
+		GetTenantInitJobList(ctx context.Context, in *TenantInitJobListReq, opts ...grpc.CallOption) (*TenantInitJobListResp, error)
+		RetryTenantInitJob(ctx context.Context, in *TenantInitJobRetryReq, opts ...grpc.CallOption) (*BaseIDResp, error)
+		DiagnoseTenants(ctx context.Context, in *TenantDoctorReq, opts ...grpc.CallOption) (*TenantDoctorResp, error)
+		RepairTenant(ctx context.Context, in *TenantRepairReq, opts ...grpc.CallOption) (*TenantDoctorResp, error)
+		StartImpersonation(ctx context.Context, in *ImpersonationStartReq, opts ...grpc.CallOption) (*ImpersonationSessionInfo, error)
+		ReviewImpersonation(ctx context.Context, in *ImpersonationReviewReq, opts ...grpc.CallOption) (*ImpersonationSessionInfo, error)
+		EndImpersonation(ctx context.Context, in *UUIDReq, opts ...grpc.CallOption) (*BaseResp, error)
+		GetActiveImpersonation(ctx context.Context, in *UUIDReq, opts ...grpc.CallOption) (*ImpersonationSessionInfo, error)
+		GetImpersonationSessionList(ctx context.Context, in *ImpersonationSessionListReq, opts ...grpc.CallOption) (*ImpersonationSessionListResp, error)
 		GetPublicTenantList(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*PublicTenantListResp, error)
+		// TenantInitPlugin management
+		RegisterTenantInitPlugin(ctx context.Context, in *TenantInitPluginInfo, opts ...grpc.CallOption) (*BaseIDResp, error)
+		UpdateTenantInitPlugin(ctx context.Context, in *TenantInitPluginInfo, opts ...grpc.CallOption) (*BaseResp, error)
+		GetTenantInitPluginList(ctx context.Context, in *TenantInitPluginListReq, opts ...grpc.CallOption) (*TenantInitPluginListResp, error)
+		GetTenantInitPluginById(ctx context.Context, in *IDReq, opts ...grpc.CallOption) (*TenantInitPluginInfo, error)
+		DeleteTenantInitPlugin(ctx context.Context, in *IDsReq, opts ...grpc.CallOption) (*BaseResp, error)
+		// TenantInitTemplate management
+		CreateTenantInitTemplate(ctx context.Context, in *TenantInitTemplateInfo, opts ...grpc.CallOption) (*BaseIDResp, error)
+		UpdateTenantInitTemplate(ctx context.Context, in *TenantInitTemplateInfo, opts ...grpc.CallOption) (*BaseResp, error)
+		GetTenantInitTemplateList(ctx context.Context, in *TenantInitTemplateListReq, opts ...grpc.CallOption) (*TenantInitTemplateListResp, error)
+		GetTenantInitTemplateById(ctx context.Context, in *IDReq, opts ...grpc.CallOption) (*TenantInitTemplateInfo, error)
+		DeleteTenantInitTemplate(ctx context.Context, in *IDsReq, opts ...grpc.CallOption) (*BaseResp, error)
+		PreviewTenantInitTemplate(ctx context.Context, in *TenantInitTemplatePreviewReq, opts ...grpc.CallOption) (*TenantInitTemplatePreviewResp, error)
 		// Token management
 		CreateToken(ctx context.Context, in *TokenInfo, opts ...grpc.CallOption) (*BaseUUIDResp, error)
 		DeleteToken(ctx context.Context, in *UUIDsReq, opts ...grpc.CallOption) (*BaseResp, error)
@@ -240,6 +436,9 @@ type (
 		GetTokenById(ctx context.Context, in *UUIDReq, opts ...grpc.CallOption) (*TokenInfo, error)
 		BlockUserAllToken(ctx context.Context, in *UUIDReq, opts ...grpc.CallOption) (*BaseResp, error)
 		UpdateToken(ctx context.Context, in *TokenInfo, opts ...grpc.CallOption) (*BaseResp, error)
+		GetUserSessionList(ctx context.Context, in *UserSessionListReq, opts ...grpc.CallOption) (*TokenListResp, error)
+		RevokeUserSession(ctx context.Context, in *UserSessionRevokeReq, opts ...grpc.CallOption) (*BaseResp, error)
+		TouchToken(ctx context.Context, in *TokenTouchReq, opts ...grpc.CallOption) (*BaseResp, error)
 		// User management
 		CreateUser(ctx context.Context, in *UserInfo, opts ...grpc.CallOption) (*BaseUUIDResp, error)
 		UpdateUser(ctx context.Context, in *UserInfo, opts ...grpc.CallOption) (*BaseResp, error)
@@ -288,6 +487,11 @@ func (m *defaultCore) DeleteApi(ctx context.Context, in *IDsReq, opts ...grpc.Ca
 	return client.DeleteApi(ctx, in, opts...)
 }
 
+func (m *defaultCore) ApiRegistration(ctx context.Context, in *ApiRegistrationReq, opts ...grpc.CallOption) (*ApiRegistrationResp, error) {
+	client := core.NewCoreClient(m.cli.Conn())
+	return client.ApiRegistration(ctx, in, opts...)
+}
+
 // AuditLog management
 func (m *defaultCore) CreateAuditLog(ctx context.Context, in *AuditLogInfo, opts ...grpc.CallOption) (*BaseUUIDResp, error) {
 	client := core.NewCoreClient(m.cli.Conn())
@@ -304,14 +508,24 @@ func (m *defaultCore) GetAuditLogById(ctx context.Context, in *UUIDReq, opts ...
 	return client.GetAuditLogById(ctx, in, opts...)
 }
 
-func (m *defaultCore) DeleteAuditLog(ctx context.Context, in *UUIDsReq, opts ...grpc.CallOption) (*BaseResp, error) {
+func (m *defaultCore) GetAuditLogStats(ctx context.Context, in *AuditLogStatsReq, opts ...grpc.CallOption) (*AuditLogStatsResp, error) {
 	client := core.NewCoreClient(m.cli.Conn())
-	return client.DeleteAuditLog(ctx, in, opts...)
+	return client.GetAuditLogStats(ctx, in, opts...)
 }
 
-func (m *defaultCore) GetAuditLogStats(ctx context.Context, in *AuditLogStatsReq, opts ...grpc.CallOption) (*AuditLogStatsResp, error) {
+func (m *defaultCore) VerifyAuditLogChain(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*AuditLogVerifyResp, error) {
 	client := core.NewCoreClient(m.cli.Conn())
-	return client.GetAuditLogStats(ctx, in, opts...)
+	return client.VerifyAuditLogChain(ctx, in, opts...)
+}
+
+func (m *defaultCore) GetAuditLogArchiveList(ctx context.Context, in *AuditLogArchiveListReq, opts ...grpc.CallOption) (*AuditLogArchiveListResp, error) {
+	client := core.NewCoreClient(m.cli.Conn())
+	return client.GetAuditLogArchiveList(ctx, in, opts...)
+}
+
+func (m *defaultCore) RestoreAuditLogRange(ctx context.Context, in *AuditLogListReq, opts ...grpc.CallOption) (*AuditLogListResp, error) {
+	client := core.NewCoreClient(m.cli.Conn())
+	return client.RestoreAuditLogRange(ctx, in, opts...)
 }
 
 func (m *defaultCore) GetMenuAuthority(ctx context.Context, in *IDReq, opts ...grpc.CallOption) (*RoleMenuAuthorityResp, error) {
@@ -324,6 +538,26 @@ func (m *defaultCore) CreateOrUpdateMenuAuthority(ctx context.Context, in *RoleM
 	return client.CreateOrUpdateMenuAuthority(ctx, in, opts...)
 }
 
+func (m *defaultCore) GetRolePolicies(ctx context.Context, in *IDReq, opts ...grpc.CallOption) (*RolePolicyListResp, error) {
+	client := core.NewCoreClient(m.cli.Conn())
+	return client.GetRolePolicies(ctx, in, opts...)
+}
+
+func (m *defaultCore) ReplaceRolePolicies(ctx context.Context, in *ReplaceRolePoliciesReq, opts ...grpc.CallOption) (*ReplaceRolePoliciesResp, error) {
+	client := core.NewCoreClient(m.cli.Conn())
+	return client.ReplaceRolePolicies(ctx, in, opts...)
+}
+
+func (m *defaultCore) ExportPolicyBundle(ctx context.Context, in *PolicyBundleExportReq, opts ...grpc.CallOption) (*PolicyBundleResp, error) {
+	client := core.NewCoreClient(m.cli.Conn())
+	return client.ExportPolicyBundle(ctx, in, opts...)
+}
+
+func (m *defaultCore) ImportPolicyBundle(ctx context.Context, in *PolicyBundleImportReq, opts ...grpc.CallOption) (*PolicyBundleImportResp, error) {
+	client := core.NewCoreClient(m.cli.Conn())
+	return client.ImportPolicyBundle(ctx, in, opts...)
+}
+
 func (m *defaultCore) InitDatabase(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*BaseResp, error) {
 	client := core.NewCoreClient(m.cli.Conn())
 	return client.InitDatabase(ctx, in, opts...)
@@ -524,6 +758,52 @@ func (m *defaultCore) GetDictionaryDetailByDictionaryName(ctx context.Context, i
 	return client.GetDictionaryDetailByDictionaryName(ctx, in, opts...)
 }
 
+// LdapProvider management
+func (m *defaultCore) CreateLdapProvider(ctx context.Context, in *LdapProviderInfo, opts ...grpc.CallOption) (*BaseIDResp, error) {
+	client := core.NewCoreClient(m.cli.Conn())
+	return client.CreateLdapProvider(ctx, in, opts...)
+}
+
+func (m *defaultCore) UpdateLdapProvider(ctx context.Context, in *LdapProviderInfo, opts ...grpc.CallOption) (*BaseResp, error) {
+	client := core.NewCoreClient(m.cli.Conn())
+	return client.UpdateLdapProvider(ctx, in, opts...)
+}
+
+func (m *defaultCore) GetLdapProviderList(ctx context.Context, in *LdapProviderListReq, opts ...grpc.CallOption) (*LdapProviderListResp, error) {
+	client := core.NewCoreClient(m.cli.Conn())
+	return client.GetLdapProviderList(ctx, in, opts...)
+}
+
+func (m *defaultCore) GetLdapProviderById(ctx context.Context, in *IDReq, opts ...grpc.CallOption) (*LdapProviderInfo, error) {
+	client := core.NewCoreClient(m.cli.Conn())
+	return client.GetLdapProviderById(ctx, in, opts...)
+}
+
+func (m *defaultCore) DeleteLdapProvider(ctx context.Context, in *IDsReq, opts ...grpc.CallOption) (*BaseResp, error) {
+	client := core.NewCoreClient(m.cli.Conn())
+	return client.DeleteLdapProvider(ctx, in, opts...)
+}
+
+func (m *defaultCore) LdapLogin(ctx context.Context, in *LdapLoginReq, opts ...grpc.CallOption) (*UserInfo, error) {
+	client := core.NewCoreClient(m.cli.Conn())
+	return client.LdapLogin(ctx, in, opts...)
+}
+
+func (m *defaultCore) SyncLdapProvider(ctx context.Context, in *LdapSyncReq, opts ...grpc.CallOption) (*LdapSyncRunInfo, error) {
+	client := core.NewCoreClient(m.cli.Conn())
+	return client.SyncLdapProvider(ctx, in, opts...)
+}
+
+func (m *defaultCore) GetLdapSyncRunList(ctx context.Context, in *LdapSyncRunListReq, opts ...grpc.CallOption) (*LdapSyncRunListResp, error) {
+	client := core.NewCoreClient(m.cli.Conn())
+	return client.GetLdapSyncRunList(ctx, in, opts...)
+}
+
+func (m *defaultCore) GetLdapSyncRunById(ctx context.Context, in *IDReq, opts ...grpc.CallOption) (*LdapSyncRunInfo, error) {
+	client := core.NewCoreClient(m.cli.Conn())
+	return client.GetLdapSyncRunById(ctx, in, opts...)
+}
+
 func (m *defaultCore) CreateMenu(ctx context.Context, in *MenuInfo, opts ...grpc.CallOption) (*BaseIDResp, error) {
 	client := core.NewCoreClient(m.cli.Conn())
 	return client.CreateMenu(ctx, in, opts...)
@@ -554,6 +834,67 @@ func (m *defaultCore) GetMenuList(ctx context.Context, in *PageInfoReq, opts ...
 	return client.GetMenuList(ctx, in, opts...)
 }
 
+// OauthClient management
+func (m *defaultCore) CreateOauthClient(ctx context.Context, in *OauthClientInfo, opts ...grpc.CallOption) (*OauthClientSecretResp, error) {
+	client := core.NewCoreClient(m.cli.Conn())
+	return client.CreateOauthClient(ctx, in, opts...)
+}
+
+func (m *defaultCore) UpdateOauthClient(ctx context.Context, in *OauthClientInfo, opts ...grpc.CallOption) (*BaseResp, error) {
+	client := core.NewCoreClient(m.cli.Conn())
+	return client.UpdateOauthClient(ctx, in, opts...)
+}
+
+func (m *defaultCore) GetOauthClientList(ctx context.Context, in *OauthClientListReq, opts ...grpc.CallOption) (*OauthClientListResp, error) {
+	client := core.NewCoreClient(m.cli.Conn())
+	return client.GetOauthClientList(ctx, in, opts...)
+}
+
+func (m *defaultCore) GetOauthClientById(ctx context.Context, in *IDReq, opts ...grpc.CallOption) (*OauthClientInfo, error) {
+	client := core.NewCoreClient(m.cli.Conn())
+	return client.GetOauthClientById(ctx, in, opts...)
+}
+
+func (m *defaultCore) DeleteOauthClient(ctx context.Context, in *IDsReq, opts ...grpc.CallOption) (*BaseResp, error) {
+	client := core.NewCoreClient(m.cli.Conn())
+	return client.DeleteOauthClient(ctx, in, opts...)
+}
+
+func (m *defaultCore) ResetOauthClientSecret(ctx context.Context, in *IDReq, opts ...grpc.CallOption) (*OauthClientSecretResp, error) {
+	client := core.NewCoreClient(m.cli.Conn())
+	return client.ResetOauthClientSecret(ctx, in, opts...)
+}
+
+func (m *defaultCore) GetOauthClientByClientId(ctx context.Context, in *OauthClientIdReq, opts ...grpc.CallOption) (*OauthClientInfo, error) {
+	client := core.NewCoreClient(m.cli.Conn())
+	return client.GetOauthClientByClientId(ctx, in, opts...)
+}
+
+func (m *defaultCore) AuthenticateOauthClient(ctx context.Context, in *OauthClientAuthReq, opts ...grpc.CallOption) (*OauthClientInfo, error) {
+	client := core.NewCoreClient(m.cli.Conn())
+	return client.AuthenticateOauthClient(ctx, in, opts...)
+}
+
+func (m *defaultCore) AuthorizeOauthClient(ctx context.Context, in *OauthAuthorizeReq, opts ...grpc.CallOption) (*OauthAuthorizeResp, error) {
+	client := core.NewCoreClient(m.cli.Conn())
+	return client.AuthorizeOauthClient(ctx, in, opts...)
+}
+
+func (m *defaultCore) ExchangeOauthAuthorizationCode(ctx context.Context, in *OauthCodeExchangeReq, opts ...grpc.CallOption) (*OauthGrantInfo, error) {
+	client := core.NewCoreClient(m.cli.Conn())
+	return client.ExchangeOauthAuthorizationCode(ctx, in, opts...)
+}
+
+func (m *defaultCore) GetOauthConsentList(ctx context.Context, in *OauthConsentListReq, opts ...grpc.CallOption) (*OauthConsentListResp, error) {
+	client := core.NewCoreClient(m.cli.Conn())
+	return client.GetOauthConsentList(ctx, in, opts...)
+}
+
+func (m *defaultCore) DeleteOauthConsent(ctx context.Context, in *IDsReq, opts ...grpc.CallOption) (*BaseResp, error) {
+	client := core.NewCoreClient(m.cli.Conn())
+	return client.DeleteOauthConsent(ctx, in, opts...)
+}
+
 // OauthProvider management
 func (m *defaultCore) CreateOauthProvider(ctx context.Context, in *OauthProviderInfo, opts ...grpc.CallOption) (*BaseIDResp, error) {
 	client := core.NewCoreClient(m.cli.Conn())
@@ -585,11 +926,21 @@ func (m *defaultCore) OauthLogin(ctx context.Context, in *OauthLoginReq, opts ..
 	return client.OauthLogin(ctx, in, opts...)
 }
 
-func (m *defaultCore) OauthCallback(ctx context.Context, in *CallbackReq, opts ...grpc.CallOption) (*UserInfo, error) {
+func (m *defaultCore) OauthCallback(ctx context.Context, in *CallbackReq, opts ...grpc.CallOption) (*OauthCallbackResp, error) {
 	client := core.NewCoreClient(m.cli.Conn())
 	return client.OauthCallback(ctx, in, opts...)
 }
 
+func (m *defaultCore) PreviewOauthClaimMapping(ctx context.Context, in *OauthClaimMappingPreviewReq, opts ...grpc.CallOption) (*OauthClaimMappingPreviewResp, error) {
+	client := core.NewCoreClient(m.cli.Conn())
+	return client.PreviewOauthClaimMapping(ctx, in, opts...)
+}
+
+func (m *defaultCore) OauthWebhook(ctx context.Context, in *OauthWebhookReq, opts ...grpc.CallOption) (*OauthWebhookResp, error) {
+	client := core.NewCoreClient(m.cli.Conn())
+	return client.OauthWebhook(ctx, in, opts...)
+}
+
 // OAuth Account Binding management
 func (m *defaultCore) CreateOauthAccount(ctx context.Context, in *OauthAccountInfo, opts ...grpc.CallOption) (*BaseIDResp, error) {
 	client := core.NewCoreClient(m.cli.Conn())
@@ -631,6 +982,11 @@ func (m *defaultCore) GetUserOauthAccounts(ctx context.Context, in *GetUserOauth
 	return client.GetUserOauthAccounts(ctx, in, opts...)
 }
 
+func (m *defaultCore) GetOauthAccessToken(ctx context.Context, in *OauthAccessTokenReq, opts ...grpc.CallOption) (*OauthAccessTokenResp, error) {
+	client := core.NewCoreClient(m.cli.Conn())
+	return client.GetOauthAccessToken(ctx, in, opts...)
+}
+
 // OAuth Session management
 func (m *defaultCore) CreateOauthSession(ctx context.Context, in *CreateOauthSessionReq, opts ...grpc.CallOption) (*BaseIDResp, error) {
 	client := core.NewCoreClient(m.cli.Conn())
@@ -652,6 +1008,63 @@ func (m *defaultCore) DeleteOauthSession(ctx context.Context, in *IDReq, opts ..
 	return client.DeleteOauthSession(ctx, in, opts...)
 }
 
+// OauthProviderTemplate management
+func (m *defaultCore) CreateOauthProviderTemplate(ctx context.Context, in *OauthProviderTemplateInfo, opts ...grpc.CallOption) (*BaseIDResp, error) {
+	client := core.NewCoreClient(m.cli.Conn())
+	return client.CreateOauthProviderTemplate(ctx, in, opts...)
+}
+
+func (m *defaultCore) UpdateOauthProviderTemplate(ctx context.Context, in *OauthProviderTemplateInfo, opts ...grpc.CallOption) (*BaseResp, error) {
+	client := core.NewCoreClient(m.cli.Conn())
+	return client.UpdateOauthProviderTemplate(ctx, in, opts...)
+}
+
+func (m *defaultCore) GetOauthProviderTemplateList(ctx context.Context, in *OauthProviderTemplateListReq, opts ...grpc.CallOption) (*OauthProviderTemplateListResp, error) {
+	client := core.NewCoreClient(m.cli.Conn())
+	return client.GetOauthProviderTemplateList(ctx, in, opts...)
+}
+
+func (m *defaultCore) GetOauthProviderTemplateById(ctx context.Context, in *IDReq, opts ...grpc.CallOption) (*OauthProviderTemplateInfo, error) {
+	client := core.NewCoreClient(m.cli.Conn())
+	return client.GetOauthProviderTemplateById(ctx, in, opts...)
+}
+
+func (m *defaultCore) DeleteOauthProviderTemplate(ctx context.Context, in *IDsReq, opts ...grpc.CallOption) (*BaseResp, error) {
+	client := core.NewCoreClient(m.cli.Conn())
+	return client.DeleteOauthProviderTemplate(ctx, in, opts...)
+}
+
+func (m *defaultCore) EnableOauthProviderTemplate(ctx context.Context, in *EnableOauthProviderTemplateReq, opts ...grpc.CallOption) (*BaseIDResp, error) {
+	client := core.NewCoreClient(m.cli.Conn())
+	return client.EnableOauthProviderTemplate(ctx, in, opts...)
+}
+
+// OauthScope management
+func (m *defaultCore) CreateOauthScope(ctx context.Context, in *OauthScopeInfo, opts ...grpc.CallOption) (*BaseIDResp, error) {
+	client := core.NewCoreClient(m.cli.Conn())
+	return client.CreateOauthScope(ctx, in, opts...)
+}
+
+func (m *defaultCore) UpdateOauthScope(ctx context.Context, in *OauthScopeInfo, opts ...grpc.CallOption) (*BaseResp, error) {
+	client := core.NewCoreClient(m.cli.Conn())
+	return client.UpdateOauthScope(ctx, in, opts...)
+}
+
+func (m *defaultCore) GetOauthScopeList(ctx context.Context, in *OauthScopeListReq, opts ...grpc.CallOption) (*OauthScopeListResp, error) {
+	client := core.NewCoreClient(m.cli.Conn())
+	return client.GetOauthScopeList(ctx, in, opts...)
+}
+
+func (m *defaultCore) GetOauthScopeById(ctx context.Context, in *IDReq, opts ...grpc.CallOption) (*OauthScopeInfo, error) {
+	client := core.NewCoreClient(m.cli.Conn())
+	return client.GetOauthScopeById(ctx, in, opts...)
+}
+
+func (m *defaultCore) DeleteOauthScope(ctx context.Context, in *IDsReq, opts ...grpc.CallOption) (*BaseResp, error) {
+	client := core.NewCoreClient(m.cli.Conn())
+	return client.DeleteOauthScope(ctx, in, opts...)
+}
+
 // Position management
 func (m *defaultCore) CreatePosition(ctx context.Context, in *PositionInfo, opts ...grpc.CallOption) (*BaseIDResp, error) {
 	client := core.NewCoreClient(m.cli.Conn())
@@ -704,6 +1117,12 @@ func (m *defaultCore) DeleteRole(ctx context.Context, in *IDsReq, opts ...grpc.C
 	return client.DeleteRole(ctx, in, opts...)
 }
 
+// Delete the role after removing it from its users
+func (m *defaultCore) DeleteRoleWithUsers(ctx context.Context, in *IDReq, opts ...grpc.CallOption) (*BaseResp, error) {
+	client := core.NewCoreClient(m.cli.Conn())
+	return client.DeleteRoleWithUsers(ctx, in, opts...)
+}
+
 func (m *defaultCore) InitRoleDataPermToRedis(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*BaseResp, error) {
 	client := core.NewCoreClient(m.cli.Conn())
 	return client.InitRoleDataPermToRedis(ctx, in, opts...)
@@ -729,6 +1148,98 @@ func (m *defaultCore) ChangeRoleStatus(ctx context.Context, in *RoleStatusChange
 	return client.ChangeRoleStatus(ctx, in, opts...)
 }
 
+func (m *defaultCore) SetRoleParents(ctx context.Context, in *RoleParentsReq, opts ...grpc.CallOption) (*BaseResp, error) {
+	client := core.NewCoreClient(m.cli.Conn())
+	return client.SetRoleParents(ctx, in, opts...)
+}
+
+func (m *defaultCore) GetRoleParents(ctx context.Context, in *IDReq, opts ...grpc.CallOption) (*RoleParentsResp, error) {
+	client := core.NewCoreClient(m.cli.Conn())
+	return client.GetRoleParents(ctx, in, opts...)
+}
+
+func (m *defaultCore) GetRoleEffectivePermissions(ctx context.Context, in *IDReq, opts ...grpc.CallOption) (*RoleEffectivePermissionsResp, error) {
+	client := core.NewCoreClient(m.cli.Conn())
+	return client.GetRoleEffectivePermissions(ctx, in, opts...)
+}
+
+// SamlProvider management
+func (m *defaultCore) CreateSamlProvider(ctx context.Context, in *SamlProviderInfo, opts ...grpc.CallOption) (*BaseIDResp, error) {
+	client := core.NewCoreClient(m.cli.Conn())
+	return client.CreateSamlProvider(ctx, in, opts...)
+}
+
+func (m *defaultCore) UpdateSamlProvider(ctx context.Context, in *SamlProviderInfo, opts ...grpc.CallOption) (*BaseResp, error) {
+	client := core.NewCoreClient(m.cli.Conn())
+	return client.UpdateSamlProvider(ctx, in, opts...)
+}
+
+func (m *defaultCore) GetSamlProviderList(ctx context.Context, in *SamlProviderListReq, opts ...grpc.CallOption) (*SamlProviderListResp, error) {
+	client := core.NewCoreClient(m.cli.Conn())
+	return client.GetSamlProviderList(ctx, in, opts...)
+}
+
+func (m *defaultCore) GetSamlProviderById(ctx context.Context, in *IDReq, opts ...grpc.CallOption) (*SamlProviderInfo, error) {
+	client := core.NewCoreClient(m.cli.Conn())
+	return client.GetSamlProviderById(ctx, in, opts...)
+}
+
+func (m *defaultCore) DeleteSamlProvider(ctx context.Context, in *IDsReq, opts ...grpc.CallOption) (*BaseResp, error) {
+	client := core.NewCoreClient(m.cli.Conn())
+	return client.DeleteSamlProvider(ctx, in, opts...)
+}
+
+func (m *defaultCore) ImportSamlIdpMetadata(ctx context.Context, in *SamlMetadataImportReq, opts ...grpc.CallOption) (*BaseResp, error) {
+	client := core.NewCoreClient(m.cli.Conn())
+	return client.ImportSamlIdpMetadata(ctx, in, opts...)
+}
+
+func (m *defaultCore) GetSamlSpMetadata(ctx context.Context, in *SamlSpMetadataReq, opts ...grpc.CallOption) (*SamlSpMetadataResp, error) {
+	client := core.NewCoreClient(m.cli.Conn())
+	return client.GetSamlSpMetadata(ctx, in, opts...)
+}
+
+func (m *defaultCore) SamlLogin(ctx context.Context, in *SamlLoginReq, opts ...grpc.CallOption) (*SamlLoginResp, error) {
+	client := core.NewCoreClient(m.cli.Conn())
+	return client.SamlLogin(ctx, in, opts...)
+}
+
+func (m *defaultCore) SamlAcs(ctx context.Context, in *SamlAcsReq, opts ...grpc.CallOption) (*SamlAcsResp, error) {
+	client := core.NewCoreClient(m.cli.Conn())
+	return client.SamlAcs(ctx, in, opts...)
+}
+
+// ScimToken management
+func (m *defaultCore) CreateScimToken(ctx context.Context, in *ScimTokenInfo, opts ...grpc.CallOption) (*ScimTokenCreateResp, error) {
+	client := core.NewCoreClient(m.cli.Conn())
+	return client.CreateScimToken(ctx, in, opts...)
+}
+
+func (m *defaultCore) UpdateScimToken(ctx context.Context, in *ScimTokenInfo, opts ...grpc.CallOption) (*BaseResp, error) {
+	client := core.NewCoreClient(m.cli.Conn())
+	return client.UpdateScimToken(ctx, in, opts...)
+}
+
+func (m *defaultCore) GetScimTokenList(ctx context.Context, in *ScimTokenListReq, opts ...grpc.CallOption) (*ScimTokenListResp, error) {
+	client := core.NewCoreClient(m.cli.Conn())
+	return client.GetScimTokenList(ctx, in, opts...)
+}
+
+func (m *defaultCore) GetScimTokenById(ctx context.Context, in *IDReq, opts ...grpc.CallOption) (*ScimTokenInfo, error) {
+	client := core.NewCoreClient(m.cli.Conn())
+	return client.GetScimTokenById(ctx, in, opts...)
+}
+
+func (m *defaultCore) DeleteScimToken(ctx context.Context, in *IDsReq, opts ...grpc.CallOption) (*BaseResp, error) {
+	client := core.NewCoreClient(m.cli.Conn())
+	return client.DeleteScimToken(ctx, in, opts...)
+}
+
+func (m *defaultCore) AuthenticateScimToken(ctx context.Context, in *ScimTokenAuthReq, opts ...grpc.CallOption) (*ScimTokenInfo, error) {
+	client := core.NewCoreClient(m.cli.Conn())
+	return client.AuthenticateScimToken(ctx, in, opts...)
+}
+
 // Tenant management
 func (m *defaultCore) CreateTenant(ctx context.Context, in *TenantInfo, opts ...grpc.CallOption) (*BaseIDResp, error) {
 	client := core.NewCoreClient(m.cli.Conn())
@@ -765,16 +1276,123 @@ func (m *defaultCore) UpdateTenantStatus(ctx context.Context, in *TenantStatusRe
 	return client.UpdateTenantStatus(ctx, in, opts...)
 }
 
-func (m *defaultCore) InitTenant(ctx context.Context, in *TenantInitReq, opts ...grpc.CallOption) (*BaseResp, error) {
+func (m *defaultCore) InitTenant(ctx context.Context, in *TenantInitReq, opts ...grpc.CallOption) (*BaseIDResp, error) {
 	client := core.NewCoreClient(m.cli.Conn())
 	return client.InitTenant(ctx, in, opts...)
 }
 
+func (m *defaultCore) GetTenantInitJobById(ctx context.Context, in *IDReq, opts ...grpc.CallOption) (*TenantInitJobInfo, error) {
+	client := core.NewCoreClient(m.cli.Conn())
+	return client.GetTenantInitJobById(ctx, in, opts...)
+}
+
+func (m *defaultCore) GetTenantInitJobList(ctx context.Context, in *TenantInitJobListReq, opts ...grpc.CallOption) (*TenantInitJobListResp, error) {
+	client := core.NewCoreClient(m.cli.Conn())
+	return client.GetTenantInitJobList(ctx, in, opts...)
+}
+
+func (m *defaultCore) RetryTenantInitJob(ctx context.Context, in *TenantInitJobRetryReq, opts ...grpc.CallOption) (*BaseIDResp, error) {
+	client := core.NewCoreClient(m.cli.Conn())
+	return client.RetryTenantInitJob(ctx, in, opts...)
+}
+
+func (m *defaultCore) DiagnoseTenants(ctx context.Context, in *TenantDoctorReq, opts ...grpc.CallOption) (*TenantDoctorResp, error) {
+	client := core.NewCoreClient(m.cli.Conn())
+	return client.DiagnoseTenants(ctx, in, opts...)
+}
+
+func (m *defaultCore) RepairTenant(ctx context.Context, in *TenantRepairReq, opts ...grpc.CallOption) (*TenantDoctorResp, error) {
+	client := core.NewCoreClient(m.cli.Conn())
+	return client.RepairTenant(ctx, in, opts...)
+}
+
+func (m *defaultCore) StartImpersonation(ctx context.Context, in *ImpersonationStartReq, opts ...grpc.CallOption) (*ImpersonationSessionInfo, error) {
+	client := core.NewCoreClient(m.cli.Conn())
+	return client.StartImpersonation(ctx, in, opts...)
+}
+
+func (m *defaultCore) ReviewImpersonation(ctx context.Context, in *ImpersonationReviewReq, opts ...grpc.CallOption) (*ImpersonationSessionInfo, error) {
+	client := core.NewCoreClient(m.cli.Conn())
+	return client.ReviewImpersonation(ctx, in, opts...)
+}
+
+func (m *defaultCore) EndImpersonation(ctx context.Context, in *UUIDReq, opts ...grpc.CallOption) (*BaseResp, error) {
+	client := core.NewCoreClient(m.cli.Conn())
+	return client.EndImpersonation(ctx, in, opts...)
+}
+
+func (m *defaultCore) GetActiveImpersonation(ctx context.Context, in *UUIDReq, opts ...grpc.CallOption) (*ImpersonationSessionInfo, error) {
+	client := core.NewCoreClient(m.cli.Conn())
+	return client.GetActiveImpersonation(ctx, in, opts...)
+}
+
+func (m *defaultCore) GetImpersonationSessionList(ctx context.Context, in *ImpersonationSessionListReq, opts ...grpc.CallOption) (*ImpersonationSessionListResp, error) {
+	client := core.NewCoreClient(m.cli.Conn())
+	return client.GetImpersonationSessionList(ctx, in, opts...)
+}
+
 func (m *defaultCore) GetPublicTenantList(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*PublicTenantListResp, error) {
 	client := core.NewCoreClient(m.cli.Conn())
 	return client.GetPublicTenantList(ctx, in, opts...)
 }
 
+// TenantInitPlugin management
+func (m *defaultCore) RegisterTenantInitPlugin(ctx context.Context, in *TenantInitPluginInfo, opts ...grpc.CallOption) (*BaseIDResp, error) {
+	client := core.NewCoreClient(m.cli.Conn())
+	return client.RegisterTenantInitPlugin(ctx, in, opts...)
+}
+
+func (m *defaultCore) UpdateTenantInitPlugin(ctx context.Context, in *TenantInitPluginInfo, opts ...grpc.CallOption) (*BaseResp, error) {
+	client := core.NewCoreClient(m.cli.Conn())
+	return client.UpdateTenantInitPlugin(ctx, in, opts...)
+}
+
+func (m *defaultCore) GetTenantInitPluginList(ctx context.Context, in *TenantInitPluginListReq, opts ...grpc.CallOption) (*TenantInitPluginListResp, error) {
+	client := core.NewCoreClient(m.cli.Conn())
+	return client.GetTenantInitPluginList(ctx, in, opts...)
+}
+
+func (m *defaultCore) GetTenantInitPluginById(ctx context.Context, in *IDReq, opts ...grpc.CallOption) (*TenantInitPluginInfo, error) {
+	client := core.NewCoreClient(m.cli.Conn())
+	return client.GetTenantInitPluginById(ctx, in, opts...)
+}
+
+func (m *defaultCore) DeleteTenantInitPlugin(ctx context.Context, in *IDsReq, opts ...grpc.CallOption) (*BaseResp, error) {
+	client := core.NewCoreClient(m.cli.Conn())
+	return client.DeleteTenantInitPlugin(ctx, in, opts...)
+}
+
+// TenantInitTemplate management
+func (m *defaultCore) CreateTenantInitTemplate(ctx context.Context, in *TenantInitTemplateInfo, opts ...grpc.CallOption) (*BaseIDResp, error) {
+	client := core.NewCoreClient(m.cli.Conn())
+	return client.CreateTenantInitTemplate(ctx, in, opts...)
+}
+
+func (m *defaultCore) UpdateTenantInitTemplate(ctx context.Context, in *TenantInitTemplateInfo, opts ...grpc.CallOption) (*BaseResp, error) {
+	client := core.NewCoreClient(m.cli.Conn())
+	return client.UpdateTenantInitTemplate(ctx, in, opts...)
+}
+
+func (m *defaultCore) GetTenantInitTemplateList(ctx context.Context, in *TenantInitTemplateListReq, opts ...grpc.CallOption) (*TenantInitTemplateListResp, error) {
+	client := core.NewCoreClient(m.cli.Conn())
+	return client.GetTenantInitTemplateList(ctx, in, opts...)
+}
+
+func (m *defaultCore) GetTenantInitTemplateById(ctx context.Context, in *IDReq, opts ...grpc.CallOption) (*TenantInitTemplateInfo, error) {
+	client := core.NewCoreClient(m.cli.Conn())
+	return client.GetTenantInitTemplateById(ctx, in, opts...)
+}
+
+func (m *defaultCore) DeleteTenantInitTemplate(ctx context.Context, in *IDsReq, opts ...grpc.CallOption) (*BaseResp, error) {
+	client := core.NewCoreClient(m.cli.Conn())
+	return client.DeleteTenantInitTemplate(ctx, in, opts...)
+}
+
+func (m *defaultCore) PreviewTenantInitTemplate(ctx context.Context, in *TenantInitTemplatePreviewReq, opts ...grpc.CallOption) (*TenantInitTemplatePreviewResp, error) {
+	client := core.NewCoreClient(m.cli.Conn())
+	return client.PreviewTenantInitTemplate(ctx, in, opts...)
+}
+
 // Token management
 func (m *defaultCore) CreateToken(ctx context.Context, in *TokenInfo, opts ...grpc.CallOption) (*BaseUUIDResp, error) {
 	client := core.NewCoreClient(m.cli.Conn())
@@ -806,6 +1424,21 @@ func (m *defaultCore) UpdateToken(ctx context.Context, in *TokenInfo, opts ...gr
 	return client.UpdateToken(ctx, in, opts...)
 }
 
+func (m *defaultCore) GetUserSessionList(ctx context.Context, in *UserSessionListReq, opts ...grpc.CallOption) (*TokenListResp, error) {
+	client := core.NewCoreClient(m.cli.Conn())
+	return client.GetUserSessionList(ctx, in, opts...)
+}
+
+func (m *defaultCore) RevokeUserSession(ctx context.Context, in *UserSessionRevokeReq, opts ...grpc.CallOption) (*BaseResp, error) {
+	client := core.NewCoreClient(m.cli.Conn())
+	return client.RevokeUserSession(ctx, in, opts...)
+}
+
+func (m *defaultCore) TouchToken(ctx context.Context, in *TokenTouchReq, opts ...grpc.CallOption) (*BaseResp, error) {
+	client := core.NewCoreClient(m.cli.Conn())
+	return client.TouchToken(ctx, in, opts...)
+}
+
 // User management
 func (m *defaultCore) CreateUser(ctx context.Context, in *UserInfo, opts ...grpc.CallOption) (*BaseUUIDResp, error) {
 	client := core.NewCoreClient(m.cli.Conn())
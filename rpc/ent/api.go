@@ -34,7 +34,11 @@ type API struct {
 	// HTTP method | HTTP 请求类型
 	Method string `json:"method,omitempty"`
 	// Whether is required | 是否必选
-	IsRequired   bool `json:"is_required,omitempty"`
+	IsRequired bool `json:"is_required,omitempty"`
+	// The service no longer registers the API | 服务已不再登记该接口
+	IsDeprecated bool `json:"is_deprecated,omitempty"`
+	// Deprecated time | 废弃时间
+	DeprecatedAt *time.Time `json:"deprecated_at,omitempty"`
 	selectValues sql.SelectValues
 }
 
@@ -43,13 +47,13 @@ func (*API) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case api.FieldIsRequired:
+		case api.FieldIsRequired, api.FieldIsDeprecated:
 			values[i] = new(sql.NullBool)
 		case api.FieldID, api.FieldTenantID:
 			values[i] = new(sql.NullInt64)
 		case api.FieldPath, api.FieldDescription, api.FieldAPIGroup, api.FieldServiceName, api.FieldMethod:
 			values[i] = new(sql.NullString)
-		case api.FieldCreatedAt, api.FieldUpdatedAt:
+		case api.FieldCreatedAt, api.FieldUpdatedAt, api.FieldDeprecatedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -126,6 +130,19 @@ func (_m *API) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.IsRequired = value.Bool
 			}
+		case api.FieldIsDeprecated:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_deprecated", values[i])
+			} else if value.Valid {
+				_m.IsDeprecated = value.Bool
+			}
+		case api.FieldDeprecatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deprecated_at", values[i])
+			} else if value.Valid {
+				_m.DeprecatedAt = new(time.Time)
+				*_m.DeprecatedAt = value.Time
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -188,6 +205,14 @@ func (_m *API) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("is_required=")
 	builder.WriteString(fmt.Sprintf("%v", _m.IsRequired))
+	builder.WriteString(", ")
+	builder.WriteString("is_deprecated=")
+	builder.WriteString(fmt.Sprintf("%v", _m.IsDeprecated))
+	builder.WriteString(", ")
+	if v := _m.DeprecatedAt; v != nil {
+		builder.WriteString("deprecated_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
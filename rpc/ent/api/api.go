@@ -31,6 +31,10 @@ const (
 	FieldMethod = "method"
 	// FieldIsRequired holds the string denoting the is_required field in the database.
 	FieldIsRequired = "is_required"
+	// FieldIsDeprecated holds the string denoting the is_deprecated field in the database.
+	FieldIsDeprecated = "is_deprecated"
+	// FieldDeprecatedAt holds the string denoting the deprecated_at field in the database.
+	FieldDeprecatedAt = "deprecated_at"
 	// Table holds the table name of the api in the database.
 	Table = "sys_apis"
 )
@@ -47,6 +51,8 @@ var Columns = []string{
 	FieldServiceName,
 	FieldMethod,
 	FieldIsRequired,
+	FieldIsDeprecated,
+	FieldDeprecatedAt,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -74,6 +80,8 @@ var (
 	DefaultMethod string
 	// DefaultIsRequired holds the default value on creation for the "is_required" field.
 	DefaultIsRequired bool
+	// DefaultIsDeprecated holds the default value on creation for the "is_deprecated" field.
+	DefaultIsDeprecated bool
 )
 
 // OrderOption defines the ordering options for the API queries.
@@ -128,3 +136,13 @@ func ByMethod(opts ...sql.OrderTermOption) OrderOption {
 func ByIsRequired(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldIsRequired, opts...).ToFunc()
 }
+
+// ByIsDeprecated orders the results by the is_deprecated field.
+func ByIsDeprecated(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIsDeprecated, opts...).ToFunc()
+}
+
+// ByDeprecatedAt orders the results by the deprecated_at field.
+func ByDeprecatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeprecatedAt, opts...).ToFunc()
+}
@@ -99,6 +99,16 @@ func IsRequired(v bool) predicate.API {
 	return predicate.API(sql.FieldEQ(FieldIsRequired, v))
 }
 
+// IsDeprecated applies equality check predicate on the "is_deprecated" field. It's identical to IsDeprecatedEQ.
+func IsDeprecated(v bool) predicate.API {
+	return predicate.API(sql.FieldEQ(FieldIsDeprecated, v))
+}
+
+// DeprecatedAt applies equality check predicate on the "deprecated_at" field. It's identical to DeprecatedAtEQ.
+func DeprecatedAt(v time.Time) predicate.API {
+	return predicate.API(sql.FieldEQ(FieldDeprecatedAt, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.API {
 	return predicate.API(sql.FieldEQ(FieldCreatedAt, v))
@@ -554,6 +564,66 @@ func IsRequiredNEQ(v bool) predicate.API {
 	return predicate.API(sql.FieldNEQ(FieldIsRequired, v))
 }
 
+// IsDeprecatedEQ applies the EQ predicate on the "is_deprecated" field.
+func IsDeprecatedEQ(v bool) predicate.API {
+	return predicate.API(sql.FieldEQ(FieldIsDeprecated, v))
+}
+
+// IsDeprecatedNEQ applies the NEQ predicate on the "is_deprecated" field.
+func IsDeprecatedNEQ(v bool) predicate.API {
+	return predicate.API(sql.FieldNEQ(FieldIsDeprecated, v))
+}
+
+// DeprecatedAtEQ applies the EQ predicate on the "deprecated_at" field.
+func DeprecatedAtEQ(v time.Time) predicate.API {
+	return predicate.API(sql.FieldEQ(FieldDeprecatedAt, v))
+}
+
+// DeprecatedAtNEQ applies the NEQ predicate on the "deprecated_at" field.
+func DeprecatedAtNEQ(v time.Time) predicate.API {
+	return predicate.API(sql.FieldNEQ(FieldDeprecatedAt, v))
+}
+
+// DeprecatedAtIn applies the In predicate on the "deprecated_at" field.
+func DeprecatedAtIn(vs ...time.Time) predicate.API {
+	return predicate.API(sql.FieldIn(FieldDeprecatedAt, vs...))
+}
+
+// DeprecatedAtNotIn applies the NotIn predicate on the "deprecated_at" field.
+func DeprecatedAtNotIn(vs ...time.Time) predicate.API {
+	return predicate.API(sql.FieldNotIn(FieldDeprecatedAt, vs...))
+}
+
+// DeprecatedAtGT applies the GT predicate on the "deprecated_at" field.
+func DeprecatedAtGT(v time.Time) predicate.API {
+	return predicate.API(sql.FieldGT(FieldDeprecatedAt, v))
+}
+
+// DeprecatedAtGTE applies the GTE predicate on the "deprecated_at" field.
+func DeprecatedAtGTE(v time.Time) predicate.API {
+	return predicate.API(sql.FieldGTE(FieldDeprecatedAt, v))
+}
+
+// DeprecatedAtLT applies the LT predicate on the "deprecated_at" field.
+func DeprecatedAtLT(v time.Time) predicate.API {
+	return predicate.API(sql.FieldLT(FieldDeprecatedAt, v))
+}
+
+// DeprecatedAtLTE applies the LTE predicate on the "deprecated_at" field.
+func DeprecatedAtLTE(v time.Time) predicate.API {
+	return predicate.API(sql.FieldLTE(FieldDeprecatedAt, v))
+}
+
+// DeprecatedAtIsNil applies the IsNil predicate on the "deprecated_at" field.
+func DeprecatedAtIsNil() predicate.API {
+	return predicate.API(sql.FieldIsNull(FieldDeprecatedAt))
+}
+
+// DeprecatedAtNotNil applies the NotNil predicate on the "deprecated_at" field.
+func DeprecatedAtNotNil() predicate.API {
+	return predicate.API(sql.FieldNotNull(FieldDeprecatedAt))
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.API) predicate.API {
 	return predicate.API(sql.AndPredicates(predicates...))
@@ -122,6 +122,34 @@ func (_c *APICreate) SetNillableIsRequired(v *bool) *APICreate {
 	return _c
 }
 
+// SetIsDeprecated sets the "is_deprecated" field.
+func (_c *APICreate) SetIsDeprecated(v bool) *APICreate {
+	_c.mutation.SetIsDeprecated(v)
+	return _c
+}
+
+// SetNillableIsDeprecated sets the "is_deprecated" field if the given value is not nil.
+func (_c *APICreate) SetNillableIsDeprecated(v *bool) *APICreate {
+	if v != nil {
+		_c.SetIsDeprecated(*v)
+	}
+	return _c
+}
+
+// SetDeprecatedAt sets the "deprecated_at" field.
+func (_c *APICreate) SetDeprecatedAt(v time.Time) *APICreate {
+	_c.mutation.SetDeprecatedAt(v)
+	return _c
+}
+
+// SetNillableDeprecatedAt sets the "deprecated_at" field if the given value is not nil.
+func (_c *APICreate) SetNillableDeprecatedAt(v *time.Time) *APICreate {
+	if v != nil {
+		_c.SetDeprecatedAt(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *APICreate) SetID(v uint64) *APICreate {
 	_c.mutation.SetID(v)
@@ -187,6 +215,10 @@ func (_c *APICreate) defaults() {
 		v := api.DefaultIsRequired
 		_c.mutation.SetIsRequired(v)
 	}
+	if _, ok := _c.mutation.IsDeprecated(); !ok {
+		v := api.DefaultIsDeprecated
+		_c.mutation.SetIsDeprecated(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -218,6 +250,9 @@ func (_c *APICreate) check() error {
 	if _, ok := _c.mutation.IsRequired(); !ok {
 		return &ValidationError{Name: "is_required", err: errors.New(`ent: missing required field "API.is_required"`)}
 	}
+	if _, ok := _c.mutation.IsDeprecated(); !ok {
+		return &ValidationError{Name: "is_deprecated", err: errors.New(`ent: missing required field "API.is_deprecated"`)}
+	}
 	return nil
 }
 
@@ -286,6 +321,14 @@ func (_c *APICreate) createSpec() (*API, *sqlgraph.CreateSpec) {
 		_spec.SetField(api.FieldIsRequired, field.TypeBool, value)
 		_node.IsRequired = value
 	}
+	if value, ok := _c.mutation.IsDeprecated(); ok {
+		_spec.SetField(api.FieldIsDeprecated, field.TypeBool, value)
+		_node.IsDeprecated = value
+	}
+	if value, ok := _c.mutation.DeprecatedAt(); ok {
+		_spec.SetField(api.FieldDeprecatedAt, field.TypeTime, value)
+		_node.DeprecatedAt = &value
+	}
 	return _node, _spec
 }
 
@@ -119,6 +119,40 @@ func (_u *APIUpdate) SetNillableIsRequired(v *bool) *APIUpdate {
 	return _u
 }
 
+// SetIsDeprecated sets the "is_deprecated" field.
+func (_u *APIUpdate) SetIsDeprecated(v bool) *APIUpdate {
+	_u.mutation.SetIsDeprecated(v)
+	return _u
+}
+
+// SetNillableIsDeprecated sets the "is_deprecated" field if the given value is not nil.
+func (_u *APIUpdate) SetNillableIsDeprecated(v *bool) *APIUpdate {
+	if v != nil {
+		_u.SetIsDeprecated(*v)
+	}
+	return _u
+}
+
+// SetDeprecatedAt sets the "deprecated_at" field.
+func (_u *APIUpdate) SetDeprecatedAt(v time.Time) *APIUpdate {
+	_u.mutation.SetDeprecatedAt(v)
+	return _u
+}
+
+// SetNillableDeprecatedAt sets the "deprecated_at" field if the given value is not nil.
+func (_u *APIUpdate) SetNillableDeprecatedAt(v *time.Time) *APIUpdate {
+	if v != nil {
+		_u.SetDeprecatedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeprecatedAt clears the value of the "deprecated_at" field.
+func (_u *APIUpdate) ClearDeprecatedAt() *APIUpdate {
+	_u.mutation.ClearDeprecatedAt()
+	return _u
+}
+
 // Mutation returns the APIMutation object of the builder.
 func (_u *APIUpdate) Mutation() *APIMutation {
 	return _u.mutation
@@ -196,6 +230,15 @@ func (_u *APIUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.IsRequired(); ok {
 		_spec.SetField(api.FieldIsRequired, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.IsDeprecated(); ok {
+		_spec.SetField(api.FieldIsDeprecated, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.DeprecatedAt(); ok {
+		_spec.SetField(api.FieldDeprecatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeprecatedAtCleared() {
+		_spec.ClearField(api.FieldDeprecatedAt, field.TypeTime)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
@@ -308,6 +351,40 @@ func (_u *APIUpdateOne) SetNillableIsRequired(v *bool) *APIUpdateOne {
 	return _u
 }
 
+// SetIsDeprecated sets the "is_deprecated" field.
+func (_u *APIUpdateOne) SetIsDeprecated(v bool) *APIUpdateOne {
+	_u.mutation.SetIsDeprecated(v)
+	return _u
+}
+
+// SetNillableIsDeprecated sets the "is_deprecated" field if the given value is not nil.
+func (_u *APIUpdateOne) SetNillableIsDeprecated(v *bool) *APIUpdateOne {
+	if v != nil {
+		_u.SetIsDeprecated(*v)
+	}
+	return _u
+}
+
+// SetDeprecatedAt sets the "deprecated_at" field.
+func (_u *APIUpdateOne) SetDeprecatedAt(v time.Time) *APIUpdateOne {
+	_u.mutation.SetDeprecatedAt(v)
+	return _u
+}
+
+// SetNillableDeprecatedAt sets the "deprecated_at" field if the given value is not nil.
+func (_u *APIUpdateOne) SetNillableDeprecatedAt(v *time.Time) *APIUpdateOne {
+	if v != nil {
+		_u.SetDeprecatedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeprecatedAt clears the value of the "deprecated_at" field.
+func (_u *APIUpdateOne) ClearDeprecatedAt() *APIUpdateOne {
+	_u.mutation.ClearDeprecatedAt()
+	return _u
+}
+
 // Mutation returns the APIMutation object of the builder.
 func (_u *APIUpdateOne) Mutation() *APIMutation {
 	return _u.mutation
@@ -415,6 +492,15 @@ func (_u *APIUpdateOne) sqlSave(ctx context.Context) (_node *API, err error) {
 	if value, ok := _u.mutation.IsRequired(); ok {
 		_spec.SetField(api.FieldIsRequired, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.IsDeprecated(); ok {
+		_spec.SetField(api.FieldIsDeprecated, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.DeprecatedAt(); ok {
+		_spec.SetField(api.FieldDeprecatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeprecatedAtCleared() {
+		_spec.ClearField(api.FieldDeprecatedAt, field.TypeTime)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	_node = &API{config: _u.config}
 	_spec.Assign = _node.assignValues
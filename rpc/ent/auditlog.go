@@ -57,7 +57,13 @@ type AuditLog struct {
 	// Error message if operation failed | 操作失败时的错误信息
 	ErrorMessage string `json:"error_message,omitempty"`
 	// Additional metadata in JSON format | 额外的元数据(JSON格式)
-	Metadata     map[string]interface{} `json:"metadata,omitempty"`
+	Metadata map[string]interface{} `json:"metadata,omitempty"`
+	// Sequence number in the tenant hash chain, null for legacy records | 租户哈希链中的序号，历史记录为空
+	Seq *uint64 `json:"seq,omitempty"`
+	// Hash of the previous record in the chain | 链中上一条记录的哈希
+	PrevHash string `json:"prev_hash,omitempty"`
+	// SHA-256 hash of this record and prev_hash | 本条记录与 prev_hash 的 SHA-256 哈希
+	Hash         string `json:"hash,omitempty"`
 	selectValues sql.SelectValues
 }
 
@@ -68,9 +74,9 @@ func (*AuditLog) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case auditlog.FieldMetadata:
 			values[i] = new([]byte)
-		case auditlog.FieldStatus, auditlog.FieldResponseStatus, auditlog.FieldDurationMs:
+		case auditlog.FieldStatus, auditlog.FieldResponseStatus, auditlog.FieldDurationMs, auditlog.FieldSeq:
 			values[i] = new(sql.NullInt64)
-		case auditlog.FieldTenantID, auditlog.FieldUserID, auditlog.FieldUserName, auditlog.FieldOperationType, auditlog.FieldResourceType, auditlog.FieldResourceID, auditlog.FieldRequestMethod, auditlog.FieldRequestPath, auditlog.FieldRequestData, auditlog.FieldResponseData, auditlog.FieldIPAddress, auditlog.FieldUserAgent, auditlog.FieldErrorMessage:
+		case auditlog.FieldTenantID, auditlog.FieldUserID, auditlog.FieldUserName, auditlog.FieldOperationType, auditlog.FieldResourceType, auditlog.FieldResourceID, auditlog.FieldRequestMethod, auditlog.FieldRequestPath, auditlog.FieldRequestData, auditlog.FieldResponseData, auditlog.FieldIPAddress, auditlog.FieldUserAgent, auditlog.FieldErrorMessage, auditlog.FieldPrevHash, auditlog.FieldHash:
 			values[i] = new(sql.NullString)
 		case auditlog.FieldCreatedAt, auditlog.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
@@ -213,6 +219,25 @@ func (_m *AuditLog) assignValues(columns []string, values []any) error {
 					return fmt.Errorf("unmarshal field metadata: %w", err)
 				}
 			}
+		case auditlog.FieldSeq:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field seq", values[i])
+			} else if value.Valid {
+				_m.Seq = new(uint64)
+				*_m.Seq = uint64(value.Int64)
+			}
+		case auditlog.FieldPrevHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field prev_hash", values[i])
+			} else if value.Valid {
+				_m.PrevHash = value.String
+			}
+		case auditlog.FieldHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field hash", values[i])
+			} else if value.Valid {
+				_m.Hash = value.String
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -305,6 +330,17 @@ func (_m *AuditLog) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("metadata=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Metadata))
+	builder.WriteString(", ")
+	if v := _m.Seq; v != nil {
+		builder.WriteString("seq=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("prev_hash=")
+	builder.WriteString(_m.PrevHash)
+	builder.WriteString(", ")
+	builder.WriteString("hash=")
+	builder.WriteString(_m.Hash)
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -215,6 +215,48 @@ func (_c *AuditLogCreate) SetMetadata(v map[string]interface{}) *AuditLogCreate
 	return _c
 }
 
+// SetSeq sets the "seq" field.
+func (_c *AuditLogCreate) SetSeq(v uint64) *AuditLogCreate {
+	_c.mutation.SetSeq(v)
+	return _c
+}
+
+// SetNillableSeq sets the "seq" field if the given value is not nil.
+func (_c *AuditLogCreate) SetNillableSeq(v *uint64) *AuditLogCreate {
+	if v != nil {
+		_c.SetSeq(*v)
+	}
+	return _c
+}
+
+// SetPrevHash sets the "prev_hash" field.
+func (_c *AuditLogCreate) SetPrevHash(v string) *AuditLogCreate {
+	_c.mutation.SetPrevHash(v)
+	return _c
+}
+
+// SetNillablePrevHash sets the "prev_hash" field if the given value is not nil.
+func (_c *AuditLogCreate) SetNillablePrevHash(v *string) *AuditLogCreate {
+	if v != nil {
+		_c.SetPrevHash(*v)
+	}
+	return _c
+}
+
+// SetHash sets the "hash" field.
+func (_c *AuditLogCreate) SetHash(v string) *AuditLogCreate {
+	_c.mutation.SetHash(v)
+	return _c
+}
+
+// SetNillableHash sets the "hash" field if the given value is not nil.
+func (_c *AuditLogCreate) SetNillableHash(v *string) *AuditLogCreate {
+	if v != nil {
+		_c.SetHash(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *AuditLogCreate) SetID(v uuid.UUID) *AuditLogCreate {
 	_c.mutation.SetID(v)
@@ -437,6 +479,18 @@ func (_c *AuditLogCreate) createSpec() (*AuditLog, *sqlgraph.CreateSpec) {
 		_spec.SetField(auditlog.FieldMetadata, field.TypeJSON, value)
 		_node.Metadata = value
 	}
+	if value, ok := _c.mutation.Seq(); ok {
+		_spec.SetField(auditlog.FieldSeq, field.TypeUint64, value)
+		_node.Seq = &value
+	}
+	if value, ok := _c.mutation.PrevHash(); ok {
+		_spec.SetField(auditlog.FieldPrevHash, field.TypeString, value)
+		_node.PrevHash = value
+	}
+	if value, ok := _c.mutation.Hash(); ok {
+		_spec.SetField(auditlog.FieldHash, field.TypeString, value)
+		_node.Hash = value
+	}
 	return _node, _spec
 }
 
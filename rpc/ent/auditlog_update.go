@@ -490,6 +490,15 @@ func (_u *AuditLogUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.MetadataCleared() {
 		_spec.ClearField(auditlog.FieldMetadata, field.TypeJSON)
 	}
+	if _u.mutation.SeqCleared() {
+		_spec.ClearField(auditlog.FieldSeq, field.TypeUint64)
+	}
+	if _u.mutation.PrevHashCleared() {
+		_spec.ClearField(auditlog.FieldPrevHash, field.TypeString)
+	}
+	if _u.mutation.HashCleared() {
+		_spec.ClearField(auditlog.FieldHash, field.TypeString)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
@@ -1003,6 +1012,15 @@ func (_u *AuditLogUpdateOne) sqlSave(ctx context.Context) (_node *AuditLog, err
 	if _u.mutation.MetadataCleared() {
 		_spec.ClearField(auditlog.FieldMetadata, field.TypeJSON)
 	}
+	if _u.mutation.SeqCleared() {
+		_spec.ClearField(auditlog.FieldSeq, field.TypeUint64)
+	}
+	if _u.mutation.PrevHashCleared() {
+		_spec.ClearField(auditlog.FieldPrevHash, field.TypeString)
+	}
+	if _u.mutation.HashCleared() {
+		_spec.ClearField(auditlog.FieldHash, field.TypeString)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	_node = &AuditLog{config: _u.config}
 	_spec.Assign = _node.assignValues
@@ -0,0 +1,231 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/coder-lulu/newbee-core/rpc/ent/auditlogarchive"
+	uuid "github.com/gofrs/uuid/v5"
+)
+
+// Audit Log Archive Manifest Table | 审计日志归档清单表
+type AuditLogArchive struct {
+	config `json:"-"`
+	// ID of the ent.
+	// UUID
+	ID uuid.UUID `json:"id,omitempty"`
+	// Create Time | 创建日期
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Update Time | 修改日期
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Tenant ID | 租户ID
+	TenantID string `json:"tenant_id,omitempty"`
+	// First archived sequence number | 归档的起始序号
+	FromSeq uint64 `json:"from_seq,omitempty"`
+	// Last archived sequence number | 归档的结束序号
+	ToSeq uint64 `json:"to_seq,omitempty"`
+	// Number of archived records | 归档的记录数
+	Count int `json:"count,omitempty"`
+	// Creation time of the earliest record | 最早记录的创建时间
+	StartAt time.Time `json:"start_at,omitempty"`
+	// Creation time of the latest record | 最晚记录的创建时间
+	EndAt time.Time `json:"end_at,omitempty"`
+	// Blob store name | 存储类型
+	Store string `json:"store,omitempty"`
+	// Object key in the blob store | 存储中的对象键
+	ObjectKey string `json:"object_key,omitempty"`
+	// Object size in bytes | 对象大小(字节)
+	Size int64 `json:"size,omitempty"`
+	// SHA-256 checksum of the object | 对象的 SHA-256 校验和
+	Checksum     string `json:"checksum,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AuditLogArchive) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case auditlogarchive.FieldFromSeq, auditlogarchive.FieldToSeq, auditlogarchive.FieldCount, auditlogarchive.FieldSize:
+			values[i] = new(sql.NullInt64)
+		case auditlogarchive.FieldTenantID, auditlogarchive.FieldStore, auditlogarchive.FieldObjectKey, auditlogarchive.FieldChecksum:
+			values[i] = new(sql.NullString)
+		case auditlogarchive.FieldCreatedAt, auditlogarchive.FieldUpdatedAt, auditlogarchive.FieldStartAt, auditlogarchive.FieldEndAt:
+			values[i] = new(sql.NullTime)
+		case auditlogarchive.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AuditLogArchive fields.
+func (_m *AuditLogArchive) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case auditlogarchive.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case auditlogarchive.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case auditlogarchive.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case auditlogarchive.FieldTenantID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				_m.TenantID = value.String
+			}
+		case auditlogarchive.FieldFromSeq:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field from_seq", values[i])
+			} else if value.Valid {
+				_m.FromSeq = uint64(value.Int64)
+			}
+		case auditlogarchive.FieldToSeq:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field to_seq", values[i])
+			} else if value.Valid {
+				_m.ToSeq = uint64(value.Int64)
+			}
+		case auditlogarchive.FieldCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field count", values[i])
+			} else if value.Valid {
+				_m.Count = int(value.Int64)
+			}
+		case auditlogarchive.FieldStartAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field start_at", values[i])
+			} else if value.Valid {
+				_m.StartAt = value.Time
+			}
+		case auditlogarchive.FieldEndAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field end_at", values[i])
+			} else if value.Valid {
+				_m.EndAt = value.Time
+			}
+		case auditlogarchive.FieldStore:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field store", values[i])
+			} else if value.Valid {
+				_m.Store = value.String
+			}
+		case auditlogarchive.FieldObjectKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field object_key", values[i])
+			} else if value.Valid {
+				_m.ObjectKey = value.String
+			}
+		case auditlogarchive.FieldSize:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field size", values[i])
+			} else if value.Valid {
+				_m.Size = value.Int64
+			}
+		case auditlogarchive.FieldChecksum:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field checksum", values[i])
+			} else if value.Valid {
+				_m.Checksum = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the AuditLogArchive.
+// This includes values selected through modifiers, order, etc.
+func (_m *AuditLogArchive) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this AuditLogArchive.
+// Note that you need to call AuditLogArchive.Unwrap() before calling this method if this AuditLogArchive
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *AuditLogArchive) Update() *AuditLogArchiveUpdateOne {
+	return NewAuditLogArchiveClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the AuditLogArchive entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *AuditLogArchive) Unwrap() *AuditLogArchive {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: AuditLogArchive is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *AuditLogArchive) String() string {
+	var builder strings.Builder
+	builder.WriteString("AuditLogArchive(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("tenant_id=")
+	builder.WriteString(_m.TenantID)
+	builder.WriteString(", ")
+	builder.WriteString("from_seq=")
+	builder.WriteString(fmt.Sprintf("%v", _m.FromSeq))
+	builder.WriteString(", ")
+	builder.WriteString("to_seq=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ToSeq))
+	builder.WriteString(", ")
+	builder.WriteString("count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Count))
+	builder.WriteString(", ")
+	builder.WriteString("start_at=")
+	builder.WriteString(_m.StartAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("end_at=")
+	builder.WriteString(_m.EndAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("store=")
+	builder.WriteString(_m.Store)
+	builder.WriteString(", ")
+	builder.WriteString("object_key=")
+	builder.WriteString(_m.ObjectKey)
+	builder.WriteString(", ")
+	builder.WriteString("size=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Size))
+	builder.WriteString(", ")
+	builder.WriteString("checksum=")
+	builder.WriteString(_m.Checksum)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// AuditLogArchives is a parsable slice of AuditLogArchive.
+type AuditLogArchives []*AuditLogArchive
@@ -0,0 +1,149 @@
+// Code generated by ent, DO NOT EDIT.
+
+package auditlogarchive
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	uuid "github.com/gofrs/uuid/v5"
+)
+
+const (
+	// Label holds the string label denoting the auditlogarchive type in the database.
+	Label = "audit_log_archive"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
+	// FieldFromSeq holds the string denoting the from_seq field in the database.
+	FieldFromSeq = "from_seq"
+	// FieldToSeq holds the string denoting the to_seq field in the database.
+	FieldToSeq = "to_seq"
+	// FieldCount holds the string denoting the count field in the database.
+	FieldCount = "count"
+	// FieldStartAt holds the string denoting the start_at field in the database.
+	FieldStartAt = "start_at"
+	// FieldEndAt holds the string denoting the end_at field in the database.
+	FieldEndAt = "end_at"
+	// FieldStore holds the string denoting the store field in the database.
+	FieldStore = "store"
+	// FieldObjectKey holds the string denoting the object_key field in the database.
+	FieldObjectKey = "object_key"
+	// FieldSize holds the string denoting the size field in the database.
+	FieldSize = "size"
+	// FieldChecksum holds the string denoting the checksum field in the database.
+	FieldChecksum = "checksum"
+	// Table holds the table name of the auditlogarchive in the database.
+	Table = "sys_audit_log_archives"
+)
+
+// Columns holds all SQL columns for auditlogarchive fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldTenantID,
+	FieldFromSeq,
+	FieldToSeq,
+	FieldCount,
+	FieldStartAt,
+	FieldEndAt,
+	FieldStore,
+	FieldObjectKey,
+	FieldSize,
+	FieldChecksum,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the AuditLogArchive queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByTenantID orders the results by the tenant_id field.
+func ByTenantID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenantID, opts...).ToFunc()
+}
+
+// ByFromSeq orders the results by the from_seq field.
+func ByFromSeq(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFromSeq, opts...).ToFunc()
+}
+
+// ByToSeq orders the results by the to_seq field.
+func ByToSeq(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldToSeq, opts...).ToFunc()
+}
+
+// ByCount orders the results by the count field.
+func ByCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCount, opts...).ToFunc()
+}
+
+// ByStartAt orders the results by the start_at field.
+func ByStartAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStartAt, opts...).ToFunc()
+}
+
+// ByEndAt orders the results by the end_at field.
+func ByEndAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEndAt, opts...).ToFunc()
+}
+
+// ByStore orders the results by the store field.
+func ByStore(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStore, opts...).ToFunc()
+}
+
+// ByObjectKey orders the results by the object_key field.
+func ByObjectKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldObjectKey, opts...).ToFunc()
+}
+
+// BySize orders the results by the size field.
+func BySize(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSize, opts...).ToFunc()
+}
+
+// ByChecksum orders the results by the checksum field.
+func ByChecksum(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldChecksum, opts...).ToFunc()
+}
@@ -0,0 +1,711 @@
+// Code generated by ent, DO NOT EDIT.
+
+package auditlogarchive
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/coder-lulu/newbee-core/rpc/ent/predicate"
+	uuid "github.com/gofrs/uuid/v5"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v string) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldEQ(FieldTenantID, v))
+}
+
+// FromSeq applies equality check predicate on the "from_seq" field. It's identical to FromSeqEQ.
+func FromSeq(v uint64) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldEQ(FieldFromSeq, v))
+}
+
+// ToSeq applies equality check predicate on the "to_seq" field. It's identical to ToSeqEQ.
+func ToSeq(v uint64) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldEQ(FieldToSeq, v))
+}
+
+// Count applies equality check predicate on the "count" field. It's identical to CountEQ.
+func Count(v int) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldEQ(FieldCount, v))
+}
+
+// StartAt applies equality check predicate on the "start_at" field. It's identical to StartAtEQ.
+func StartAt(v time.Time) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldEQ(FieldStartAt, v))
+}
+
+// EndAt applies equality check predicate on the "end_at" field. It's identical to EndAtEQ.
+func EndAt(v time.Time) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldEQ(FieldEndAt, v))
+}
+
+// Store applies equality check predicate on the "store" field. It's identical to StoreEQ.
+func Store(v string) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldEQ(FieldStore, v))
+}
+
+// ObjectKey applies equality check predicate on the "object_key" field. It's identical to ObjectKeyEQ.
+func ObjectKey(v string) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldEQ(FieldObjectKey, v))
+}
+
+// Size applies equality check predicate on the "size" field. It's identical to SizeEQ.
+func Size(v int64) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldEQ(FieldSize, v))
+}
+
+// Checksum applies equality check predicate on the "checksum" field. It's identical to ChecksumEQ.
+func Checksum(v string) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldEQ(FieldChecksum, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v string) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v string) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...string) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...string) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v string) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v string) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v string) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v string) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldLTE(FieldTenantID, v))
+}
+
+// TenantIDContains applies the Contains predicate on the "tenant_id" field.
+func TenantIDContains(v string) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldContains(FieldTenantID, v))
+}
+
+// TenantIDHasPrefix applies the HasPrefix predicate on the "tenant_id" field.
+func TenantIDHasPrefix(v string) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldHasPrefix(FieldTenantID, v))
+}
+
+// TenantIDHasSuffix applies the HasSuffix predicate on the "tenant_id" field.
+func TenantIDHasSuffix(v string) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldHasSuffix(FieldTenantID, v))
+}
+
+// TenantIDEqualFold applies the EqualFold predicate on the "tenant_id" field.
+func TenantIDEqualFold(v string) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldEqualFold(FieldTenantID, v))
+}
+
+// TenantIDContainsFold applies the ContainsFold predicate on the "tenant_id" field.
+func TenantIDContainsFold(v string) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldContainsFold(FieldTenantID, v))
+}
+
+// FromSeqEQ applies the EQ predicate on the "from_seq" field.
+func FromSeqEQ(v uint64) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldEQ(FieldFromSeq, v))
+}
+
+// FromSeqNEQ applies the NEQ predicate on the "from_seq" field.
+func FromSeqNEQ(v uint64) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldNEQ(FieldFromSeq, v))
+}
+
+// FromSeqIn applies the In predicate on the "from_seq" field.
+func FromSeqIn(vs ...uint64) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldIn(FieldFromSeq, vs...))
+}
+
+// FromSeqNotIn applies the NotIn predicate on the "from_seq" field.
+func FromSeqNotIn(vs ...uint64) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldNotIn(FieldFromSeq, vs...))
+}
+
+// FromSeqGT applies the GT predicate on the "from_seq" field.
+func FromSeqGT(v uint64) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldGT(FieldFromSeq, v))
+}
+
+// FromSeqGTE applies the GTE predicate on the "from_seq" field.
+func FromSeqGTE(v uint64) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldGTE(FieldFromSeq, v))
+}
+
+// FromSeqLT applies the LT predicate on the "from_seq" field.
+func FromSeqLT(v uint64) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldLT(FieldFromSeq, v))
+}
+
+// FromSeqLTE applies the LTE predicate on the "from_seq" field.
+func FromSeqLTE(v uint64) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldLTE(FieldFromSeq, v))
+}
+
+// ToSeqEQ applies the EQ predicate on the "to_seq" field.
+func ToSeqEQ(v uint64) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldEQ(FieldToSeq, v))
+}
+
+// ToSeqNEQ applies the NEQ predicate on the "to_seq" field.
+func ToSeqNEQ(v uint64) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldNEQ(FieldToSeq, v))
+}
+
+// ToSeqIn applies the In predicate on the "to_seq" field.
+func ToSeqIn(vs ...uint64) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldIn(FieldToSeq, vs...))
+}
+
+// ToSeqNotIn applies the NotIn predicate on the "to_seq" field.
+func ToSeqNotIn(vs ...uint64) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldNotIn(FieldToSeq, vs...))
+}
+
+// ToSeqGT applies the GT predicate on the "to_seq" field.
+func ToSeqGT(v uint64) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldGT(FieldToSeq, v))
+}
+
+// ToSeqGTE applies the GTE predicate on the "to_seq" field.
+func ToSeqGTE(v uint64) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldGTE(FieldToSeq, v))
+}
+
+// ToSeqLT applies the LT predicate on the "to_seq" field.
+func ToSeqLT(v uint64) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldLT(FieldToSeq, v))
+}
+
+// ToSeqLTE applies the LTE predicate on the "to_seq" field.
+func ToSeqLTE(v uint64) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldLTE(FieldToSeq, v))
+}
+
+// CountEQ applies the EQ predicate on the "count" field.
+func CountEQ(v int) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldEQ(FieldCount, v))
+}
+
+// CountNEQ applies the NEQ predicate on the "count" field.
+func CountNEQ(v int) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldNEQ(FieldCount, v))
+}
+
+// CountIn applies the In predicate on the "count" field.
+func CountIn(vs ...int) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldIn(FieldCount, vs...))
+}
+
+// CountNotIn applies the NotIn predicate on the "count" field.
+func CountNotIn(vs ...int) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldNotIn(FieldCount, vs...))
+}
+
+// CountGT applies the GT predicate on the "count" field.
+func CountGT(v int) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldGT(FieldCount, v))
+}
+
+// CountGTE applies the GTE predicate on the "count" field.
+func CountGTE(v int) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldGTE(FieldCount, v))
+}
+
+// CountLT applies the LT predicate on the "count" field.
+func CountLT(v int) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldLT(FieldCount, v))
+}
+
+// CountLTE applies the LTE predicate on the "count" field.
+func CountLTE(v int) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldLTE(FieldCount, v))
+}
+
+// StartAtEQ applies the EQ predicate on the "start_at" field.
+func StartAtEQ(v time.Time) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldEQ(FieldStartAt, v))
+}
+
+// StartAtNEQ applies the NEQ predicate on the "start_at" field.
+func StartAtNEQ(v time.Time) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldNEQ(FieldStartAt, v))
+}
+
+// StartAtIn applies the In predicate on the "start_at" field.
+func StartAtIn(vs ...time.Time) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldIn(FieldStartAt, vs...))
+}
+
+// StartAtNotIn applies the NotIn predicate on the "start_at" field.
+func StartAtNotIn(vs ...time.Time) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldNotIn(FieldStartAt, vs...))
+}
+
+// StartAtGT applies the GT predicate on the "start_at" field.
+func StartAtGT(v time.Time) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldGT(FieldStartAt, v))
+}
+
+// StartAtGTE applies the GTE predicate on the "start_at" field.
+func StartAtGTE(v time.Time) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldGTE(FieldStartAt, v))
+}
+
+// StartAtLT applies the LT predicate on the "start_at" field.
+func StartAtLT(v time.Time) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldLT(FieldStartAt, v))
+}
+
+// StartAtLTE applies the LTE predicate on the "start_at" field.
+func StartAtLTE(v time.Time) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldLTE(FieldStartAt, v))
+}
+
+// EndAtEQ applies the EQ predicate on the "end_at" field.
+func EndAtEQ(v time.Time) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldEQ(FieldEndAt, v))
+}
+
+// EndAtNEQ applies the NEQ predicate on the "end_at" field.
+func EndAtNEQ(v time.Time) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldNEQ(FieldEndAt, v))
+}
+
+// EndAtIn applies the In predicate on the "end_at" field.
+func EndAtIn(vs ...time.Time) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldIn(FieldEndAt, vs...))
+}
+
+// EndAtNotIn applies the NotIn predicate on the "end_at" field.
+func EndAtNotIn(vs ...time.Time) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldNotIn(FieldEndAt, vs...))
+}
+
+// EndAtGT applies the GT predicate on the "end_at" field.
+func EndAtGT(v time.Time) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldGT(FieldEndAt, v))
+}
+
+// EndAtGTE applies the GTE predicate on the "end_at" field.
+func EndAtGTE(v time.Time) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldGTE(FieldEndAt, v))
+}
+
+// EndAtLT applies the LT predicate on the "end_at" field.
+func EndAtLT(v time.Time) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldLT(FieldEndAt, v))
+}
+
+// EndAtLTE applies the LTE predicate on the "end_at" field.
+func EndAtLTE(v time.Time) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldLTE(FieldEndAt, v))
+}
+
+// StoreEQ applies the EQ predicate on the "store" field.
+func StoreEQ(v string) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldEQ(FieldStore, v))
+}
+
+// StoreNEQ applies the NEQ predicate on the "store" field.
+func StoreNEQ(v string) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldNEQ(FieldStore, v))
+}
+
+// StoreIn applies the In predicate on the "store" field.
+func StoreIn(vs ...string) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldIn(FieldStore, vs...))
+}
+
+// StoreNotIn applies the NotIn predicate on the "store" field.
+func StoreNotIn(vs ...string) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldNotIn(FieldStore, vs...))
+}
+
+// StoreGT applies the GT predicate on the "store" field.
+func StoreGT(v string) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldGT(FieldStore, v))
+}
+
+// StoreGTE applies the GTE predicate on the "store" field.
+func StoreGTE(v string) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldGTE(FieldStore, v))
+}
+
+// StoreLT applies the LT predicate on the "store" field.
+func StoreLT(v string) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldLT(FieldStore, v))
+}
+
+// StoreLTE applies the LTE predicate on the "store" field.
+func StoreLTE(v string) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldLTE(FieldStore, v))
+}
+
+// StoreContains applies the Contains predicate on the "store" field.
+func StoreContains(v string) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldContains(FieldStore, v))
+}
+
+// StoreHasPrefix applies the HasPrefix predicate on the "store" field.
+func StoreHasPrefix(v string) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldHasPrefix(FieldStore, v))
+}
+
+// StoreHasSuffix applies the HasSuffix predicate on the "store" field.
+func StoreHasSuffix(v string) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldHasSuffix(FieldStore, v))
+}
+
+// StoreEqualFold applies the EqualFold predicate on the "store" field.
+func StoreEqualFold(v string) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldEqualFold(FieldStore, v))
+}
+
+// StoreContainsFold applies the ContainsFold predicate on the "store" field.
+func StoreContainsFold(v string) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldContainsFold(FieldStore, v))
+}
+
+// ObjectKeyEQ applies the EQ predicate on the "object_key" field.
+func ObjectKeyEQ(v string) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldEQ(FieldObjectKey, v))
+}
+
+// ObjectKeyNEQ applies the NEQ predicate on the "object_key" field.
+func ObjectKeyNEQ(v string) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldNEQ(FieldObjectKey, v))
+}
+
+// ObjectKeyIn applies the In predicate on the "object_key" field.
+func ObjectKeyIn(vs ...string) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldIn(FieldObjectKey, vs...))
+}
+
+// ObjectKeyNotIn applies the NotIn predicate on the "object_key" field.
+func ObjectKeyNotIn(vs ...string) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldNotIn(FieldObjectKey, vs...))
+}
+
+// ObjectKeyGT applies the GT predicate on the "object_key" field.
+func ObjectKeyGT(v string) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldGT(FieldObjectKey, v))
+}
+
+// ObjectKeyGTE applies the GTE predicate on the "object_key" field.
+func ObjectKeyGTE(v string) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldGTE(FieldObjectKey, v))
+}
+
+// ObjectKeyLT applies the LT predicate on the "object_key" field.
+func ObjectKeyLT(v string) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldLT(FieldObjectKey, v))
+}
+
+// ObjectKeyLTE applies the LTE predicate on the "object_key" field.
+func ObjectKeyLTE(v string) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldLTE(FieldObjectKey, v))
+}
+
+// ObjectKeyContains applies the Contains predicate on the "object_key" field.
+func ObjectKeyContains(v string) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldContains(FieldObjectKey, v))
+}
+
+// ObjectKeyHasPrefix applies the HasPrefix predicate on the "object_key" field.
+func ObjectKeyHasPrefix(v string) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldHasPrefix(FieldObjectKey, v))
+}
+
+// ObjectKeyHasSuffix applies the HasSuffix predicate on the "object_key" field.
+func ObjectKeyHasSuffix(v string) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldHasSuffix(FieldObjectKey, v))
+}
+
+// ObjectKeyEqualFold applies the EqualFold predicate on the "object_key" field.
+func ObjectKeyEqualFold(v string) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldEqualFold(FieldObjectKey, v))
+}
+
+// ObjectKeyContainsFold applies the ContainsFold predicate on the "object_key" field.
+func ObjectKeyContainsFold(v string) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldContainsFold(FieldObjectKey, v))
+}
+
+// SizeEQ applies the EQ predicate on the "size" field.
+func SizeEQ(v int64) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldEQ(FieldSize, v))
+}
+
+// SizeNEQ applies the NEQ predicate on the "size" field.
+func SizeNEQ(v int64) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldNEQ(FieldSize, v))
+}
+
+// SizeIn applies the In predicate on the "size" field.
+func SizeIn(vs ...int64) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldIn(FieldSize, vs...))
+}
+
+// SizeNotIn applies the NotIn predicate on the "size" field.
+func SizeNotIn(vs ...int64) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldNotIn(FieldSize, vs...))
+}
+
+// SizeGT applies the GT predicate on the "size" field.
+func SizeGT(v int64) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldGT(FieldSize, v))
+}
+
+// SizeGTE applies the GTE predicate on the "size" field.
+func SizeGTE(v int64) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldGTE(FieldSize, v))
+}
+
+// SizeLT applies the LT predicate on the "size" field.
+func SizeLT(v int64) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldLT(FieldSize, v))
+}
+
+// SizeLTE applies the LTE predicate on the "size" field.
+func SizeLTE(v int64) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldLTE(FieldSize, v))
+}
+
+// ChecksumEQ applies the EQ predicate on the "checksum" field.
+func ChecksumEQ(v string) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldEQ(FieldChecksum, v))
+}
+
+// ChecksumNEQ applies the NEQ predicate on the "checksum" field.
+func ChecksumNEQ(v string) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldNEQ(FieldChecksum, v))
+}
+
+// ChecksumIn applies the In predicate on the "checksum" field.
+func ChecksumIn(vs ...string) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldIn(FieldChecksum, vs...))
+}
+
+// ChecksumNotIn applies the NotIn predicate on the "checksum" field.
+func ChecksumNotIn(vs ...string) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldNotIn(FieldChecksum, vs...))
+}
+
+// ChecksumGT applies the GT predicate on the "checksum" field.
+func ChecksumGT(v string) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldGT(FieldChecksum, v))
+}
+
+// ChecksumGTE applies the GTE predicate on the "checksum" field.
+func ChecksumGTE(v string) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldGTE(FieldChecksum, v))
+}
+
+// ChecksumLT applies the LT predicate on the "checksum" field.
+func ChecksumLT(v string) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldLT(FieldChecksum, v))
+}
+
+// ChecksumLTE applies the LTE predicate on the "checksum" field.
+func ChecksumLTE(v string) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldLTE(FieldChecksum, v))
+}
+
+// ChecksumContains applies the Contains predicate on the "checksum" field.
+func ChecksumContains(v string) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldContains(FieldChecksum, v))
+}
+
+// ChecksumHasPrefix applies the HasPrefix predicate on the "checksum" field.
+func ChecksumHasPrefix(v string) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldHasPrefix(FieldChecksum, v))
+}
+
+// ChecksumHasSuffix applies the HasSuffix predicate on the "checksum" field.
+func ChecksumHasSuffix(v string) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldHasSuffix(FieldChecksum, v))
+}
+
+// ChecksumEqualFold applies the EqualFold predicate on the "checksum" field.
+func ChecksumEqualFold(v string) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldEqualFold(FieldChecksum, v))
+}
+
+// ChecksumContainsFold applies the ContainsFold predicate on the "checksum" field.
+func ChecksumContainsFold(v string) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.FieldContainsFold(FieldChecksum, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.AuditLogArchive) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.AuditLogArchive) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.AuditLogArchive) predicate.AuditLogArchive {
+	return predicate.AuditLogArchive(sql.NotPredicates(p))
+}
@@ -0,0 +1,381 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/coder-lulu/newbee-core/rpc/ent/auditlogarchive"
+	uuid "github.com/gofrs/uuid/v5"
+)
+
+// AuditLogArchiveCreate is the builder for creating a AuditLogArchive entity.
+type AuditLogArchiveCreate struct {
+	config
+	mutation *AuditLogArchiveMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *AuditLogArchiveCreate) SetCreatedAt(v time.Time) *AuditLogArchiveCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *AuditLogArchiveCreate) SetNillableCreatedAt(v *time.Time) *AuditLogArchiveCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *AuditLogArchiveCreate) SetUpdatedAt(v time.Time) *AuditLogArchiveCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *AuditLogArchiveCreate) SetNillableUpdatedAt(v *time.Time) *AuditLogArchiveCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *AuditLogArchiveCreate) SetTenantID(v string) *AuditLogArchiveCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetFromSeq sets the "from_seq" field.
+func (_c *AuditLogArchiveCreate) SetFromSeq(v uint64) *AuditLogArchiveCreate {
+	_c.mutation.SetFromSeq(v)
+	return _c
+}
+
+// SetToSeq sets the "to_seq" field.
+func (_c *AuditLogArchiveCreate) SetToSeq(v uint64) *AuditLogArchiveCreate {
+	_c.mutation.SetToSeq(v)
+	return _c
+}
+
+// SetCount sets the "count" field.
+func (_c *AuditLogArchiveCreate) SetCount(v int) *AuditLogArchiveCreate {
+	_c.mutation.SetCount(v)
+	return _c
+}
+
+// SetStartAt sets the "start_at" field.
+func (_c *AuditLogArchiveCreate) SetStartAt(v time.Time) *AuditLogArchiveCreate {
+	_c.mutation.SetStartAt(v)
+	return _c
+}
+
+// SetEndAt sets the "end_at" field.
+func (_c *AuditLogArchiveCreate) SetEndAt(v time.Time) *AuditLogArchiveCreate {
+	_c.mutation.SetEndAt(v)
+	return _c
+}
+
+// SetStore sets the "store" field.
+func (_c *AuditLogArchiveCreate) SetStore(v string) *AuditLogArchiveCreate {
+	_c.mutation.SetStore(v)
+	return _c
+}
+
+// SetObjectKey sets the "object_key" field.
+func (_c *AuditLogArchiveCreate) SetObjectKey(v string) *AuditLogArchiveCreate {
+	_c.mutation.SetObjectKey(v)
+	return _c
+}
+
+// SetSize sets the "size" field.
+func (_c *AuditLogArchiveCreate) SetSize(v int64) *AuditLogArchiveCreate {
+	_c.mutation.SetSize(v)
+	return _c
+}
+
+// SetChecksum sets the "checksum" field.
+func (_c *AuditLogArchiveCreate) SetChecksum(v string) *AuditLogArchiveCreate {
+	_c.mutation.SetChecksum(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *AuditLogArchiveCreate) SetID(v uuid.UUID) *AuditLogArchiveCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *AuditLogArchiveCreate) SetNillableID(v *uuid.UUID) *AuditLogArchiveCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the AuditLogArchiveMutation object of the builder.
+func (_c *AuditLogArchiveCreate) Mutation() *AuditLogArchiveMutation {
+	return _c.mutation
+}
+
+// Save creates the AuditLogArchive in the database.
+func (_c *AuditLogArchiveCreate) Save(ctx context.Context) (*AuditLogArchive, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *AuditLogArchiveCreate) SaveX(ctx context.Context) *AuditLogArchive {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AuditLogArchiveCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AuditLogArchiveCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *AuditLogArchiveCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := auditlogarchive.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := auditlogarchive.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := auditlogarchive.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *AuditLogArchiveCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "AuditLogArchive.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "AuditLogArchive.updated_at"`)}
+	}
+	if _, ok := _c.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "AuditLogArchive.tenant_id"`)}
+	}
+	if _, ok := _c.mutation.FromSeq(); !ok {
+		return &ValidationError{Name: "from_seq", err: errors.New(`ent: missing required field "AuditLogArchive.from_seq"`)}
+	}
+	if _, ok := _c.mutation.ToSeq(); !ok {
+		return &ValidationError{Name: "to_seq", err: errors.New(`ent: missing required field "AuditLogArchive.to_seq"`)}
+	}
+	if _, ok := _c.mutation.Count(); !ok {
+		return &ValidationError{Name: "count", err: errors.New(`ent: missing required field "AuditLogArchive.count"`)}
+	}
+	if _, ok := _c.mutation.StartAt(); !ok {
+		return &ValidationError{Name: "start_at", err: errors.New(`ent: missing required field "AuditLogArchive.start_at"`)}
+	}
+	if _, ok := _c.mutation.EndAt(); !ok {
+		return &ValidationError{Name: "end_at", err: errors.New(`ent: missing required field "AuditLogArchive.end_at"`)}
+	}
+	if _, ok := _c.mutation.Store(); !ok {
+		return &ValidationError{Name: "store", err: errors.New(`ent: missing required field "AuditLogArchive.store"`)}
+	}
+	if _, ok := _c.mutation.ObjectKey(); !ok {
+		return &ValidationError{Name: "object_key", err: errors.New(`ent: missing required field "AuditLogArchive.object_key"`)}
+	}
+	if _, ok := _c.mutation.Size(); !ok {
+		return &ValidationError{Name: "size", err: errors.New(`ent: missing required field "AuditLogArchive.size"`)}
+	}
+	if _, ok := _c.mutation.Checksum(); !ok {
+		return &ValidationError{Name: "checksum", err: errors.New(`ent: missing required field "AuditLogArchive.checksum"`)}
+	}
+	return nil
+}
+
+func (_c *AuditLogArchiveCreate) sqlSave(ctx context.Context) (*AuditLogArchive, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *AuditLogArchiveCreate) createSpec() (*AuditLogArchive, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AuditLogArchive{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(auditlogarchive.Table, sqlgraph.NewFieldSpec(auditlogarchive.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(auditlogarchive.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(auditlogarchive.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(auditlogarchive.FieldTenantID, field.TypeString, value)
+		_node.TenantID = value
+	}
+	if value, ok := _c.mutation.FromSeq(); ok {
+		_spec.SetField(auditlogarchive.FieldFromSeq, field.TypeUint64, value)
+		_node.FromSeq = value
+	}
+	if value, ok := _c.mutation.ToSeq(); ok {
+		_spec.SetField(auditlogarchive.FieldToSeq, field.TypeUint64, value)
+		_node.ToSeq = value
+	}
+	if value, ok := _c.mutation.Count(); ok {
+		_spec.SetField(auditlogarchive.FieldCount, field.TypeInt, value)
+		_node.Count = value
+	}
+	if value, ok := _c.mutation.StartAt(); ok {
+		_spec.SetField(auditlogarchive.FieldStartAt, field.TypeTime, value)
+		_node.StartAt = value
+	}
+	if value, ok := _c.mutation.EndAt(); ok {
+		_spec.SetField(auditlogarchive.FieldEndAt, field.TypeTime, value)
+		_node.EndAt = value
+	}
+	if value, ok := _c.mutation.Store(); ok {
+		_spec.SetField(auditlogarchive.FieldStore, field.TypeString, value)
+		_node.Store = value
+	}
+	if value, ok := _c.mutation.ObjectKey(); ok {
+		_spec.SetField(auditlogarchive.FieldObjectKey, field.TypeString, value)
+		_node.ObjectKey = value
+	}
+	if value, ok := _c.mutation.Size(); ok {
+		_spec.SetField(auditlogarchive.FieldSize, field.TypeInt64, value)
+		_node.Size = value
+	}
+	if value, ok := _c.mutation.Checksum(); ok {
+		_spec.SetField(auditlogarchive.FieldChecksum, field.TypeString, value)
+		_node.Checksum = value
+	}
+	return _node, _spec
+}
+
+// AuditLogArchiveCreateBulk is the builder for creating many AuditLogArchive entities in bulk.
+type AuditLogArchiveCreateBulk struct {
+	config
+	err      error
+	builders []*AuditLogArchiveCreate
+}
+
+// Save creates the AuditLogArchive entities in the database.
+func (_c *AuditLogArchiveCreateBulk) Save(ctx context.Context) ([]*AuditLogArchive, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*AuditLogArchive, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AuditLogArchiveMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *AuditLogArchiveCreateBulk) SaveX(ctx context.Context) []*AuditLogArchive {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AuditLogArchiveCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AuditLogArchiveCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,228 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/coder-lulu/newbee-core/rpc/ent/auditlogarchive"
+	"github.com/coder-lulu/newbee-core/rpc/ent/predicate"
+)
+
+// AuditLogArchiveUpdate is the builder for updating AuditLogArchive entities.
+type AuditLogArchiveUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *AuditLogArchiveMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the AuditLogArchiveUpdate builder.
+func (_u *AuditLogArchiveUpdate) Where(ps ...predicate.AuditLogArchive) *AuditLogArchiveUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *AuditLogArchiveUpdate) SetUpdatedAt(v time.Time) *AuditLogArchiveUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the AuditLogArchiveMutation object of the builder.
+func (_u *AuditLogArchiveUpdate) Mutation() *AuditLogArchiveMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *AuditLogArchiveUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AuditLogArchiveUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *AuditLogArchiveUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AuditLogArchiveUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *AuditLogArchiveUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := auditlogarchive.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *AuditLogArchiveUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AuditLogArchiveUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *AuditLogArchiveUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(auditlogarchive.Table, auditlogarchive.Columns, sqlgraph.NewFieldSpec(auditlogarchive.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(auditlogarchive.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{auditlogarchive.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// AuditLogArchiveUpdateOne is the builder for updating a single AuditLogArchive entity.
+type AuditLogArchiveUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *AuditLogArchiveMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *AuditLogArchiveUpdateOne) SetUpdatedAt(v time.Time) *AuditLogArchiveUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the AuditLogArchiveMutation object of the builder.
+func (_u *AuditLogArchiveUpdateOne) Mutation() *AuditLogArchiveMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the AuditLogArchiveUpdate builder.
+func (_u *AuditLogArchiveUpdateOne) Where(ps ...predicate.AuditLogArchive) *AuditLogArchiveUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *AuditLogArchiveUpdateOne) Select(field string, fields ...string) *AuditLogArchiveUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated AuditLogArchive entity.
+func (_u *AuditLogArchiveUpdateOne) Save(ctx context.Context) (*AuditLogArchive, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AuditLogArchiveUpdateOne) SaveX(ctx context.Context) *AuditLogArchive {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *AuditLogArchiveUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AuditLogArchiveUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *AuditLogArchiveUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := auditlogarchive.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *AuditLogArchiveUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AuditLogArchiveUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *AuditLogArchiveUpdateOne) sqlSave(ctx context.Context) (_node *AuditLogArchive, err error) {
+	_spec := sqlgraph.NewUpdateSpec(auditlogarchive.Table, auditlogarchive.Columns, sqlgraph.NewFieldSpec(auditlogarchive.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AuditLogArchive.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, auditlogarchive.FieldID)
+		for _, f := range fields {
+			if !auditlogarchive.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != auditlogarchive.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(auditlogarchive.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &AuditLogArchive{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{auditlogarchive.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
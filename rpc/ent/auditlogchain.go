@@ -0,0 +1,154 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/coder-lulu/newbee-core/rpc/ent/auditlogchain"
+	uuid "github.com/gofrs/uuid/v5"
+)
+
+// Audit Log Chain Head Table | 审计日志哈希链链头表
+type AuditLogChain struct {
+	config `json:"-"`
+	// ID of the ent.
+	// UUID
+	ID uuid.UUID `json:"id,omitempty"`
+	// Create Time | 创建日期
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Update Time | 修改日期
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Tenant ID | 租户ID
+	TenantID string `json:"tenant_id,omitempty"`
+	// Sequence number of the chain head | 链头序号
+	Seq uint64 `json:"seq,omitempty"`
+	// Hash of the chain head | 链头哈希
+	Hash         string `json:"hash,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AuditLogChain) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case auditlogchain.FieldSeq:
+			values[i] = new(sql.NullInt64)
+		case auditlogchain.FieldTenantID, auditlogchain.FieldHash:
+			values[i] = new(sql.NullString)
+		case auditlogchain.FieldCreatedAt, auditlogchain.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case auditlogchain.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AuditLogChain fields.
+func (_m *AuditLogChain) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case auditlogchain.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case auditlogchain.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case auditlogchain.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case auditlogchain.FieldTenantID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				_m.TenantID = value.String
+			}
+		case auditlogchain.FieldSeq:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field seq", values[i])
+			} else if value.Valid {
+				_m.Seq = uint64(value.Int64)
+			}
+		case auditlogchain.FieldHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field hash", values[i])
+			} else if value.Valid {
+				_m.Hash = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the AuditLogChain.
+// This includes values selected through modifiers, order, etc.
+func (_m *AuditLogChain) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this AuditLogChain.
+// Note that you need to call AuditLogChain.Unwrap() before calling this method if this AuditLogChain
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *AuditLogChain) Update() *AuditLogChainUpdateOne {
+	return NewAuditLogChainClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the AuditLogChain entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *AuditLogChain) Unwrap() *AuditLogChain {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: AuditLogChain is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *AuditLogChain) String() string {
+	var builder strings.Builder
+	builder.WriteString("AuditLogChain(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("tenant_id=")
+	builder.WriteString(_m.TenantID)
+	builder.WriteString(", ")
+	builder.WriteString("seq=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Seq))
+	builder.WriteString(", ")
+	builder.WriteString("hash=")
+	builder.WriteString(_m.Hash)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// AuditLogChains is a parsable slice of AuditLogChain.
+type AuditLogChains []*AuditLogChain
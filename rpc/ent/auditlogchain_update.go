@@ -0,0 +1,316 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/coder-lulu/newbee-core/rpc/ent/auditlogchain"
+	"github.com/coder-lulu/newbee-core/rpc/ent/predicate"
+)
+
+// AuditLogChainUpdate is the builder for updating AuditLogChain entities.
+type AuditLogChainUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *AuditLogChainMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the AuditLogChainUpdate builder.
+func (_u *AuditLogChainUpdate) Where(ps ...predicate.AuditLogChain) *AuditLogChainUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *AuditLogChainUpdate) SetUpdatedAt(v time.Time) *AuditLogChainUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetSeq sets the "seq" field.
+func (_u *AuditLogChainUpdate) SetSeq(v uint64) *AuditLogChainUpdate {
+	_u.mutation.ResetSeq()
+	_u.mutation.SetSeq(v)
+	return _u
+}
+
+// SetNillableSeq sets the "seq" field if the given value is not nil.
+func (_u *AuditLogChainUpdate) SetNillableSeq(v *uint64) *AuditLogChainUpdate {
+	if v != nil {
+		_u.SetSeq(*v)
+	}
+	return _u
+}
+
+// AddSeq adds value to the "seq" field.
+func (_u *AuditLogChainUpdate) AddSeq(v int64) *AuditLogChainUpdate {
+	_u.mutation.AddSeq(v)
+	return _u
+}
+
+// SetHash sets the "hash" field.
+func (_u *AuditLogChainUpdate) SetHash(v string) *AuditLogChainUpdate {
+	_u.mutation.SetHash(v)
+	return _u
+}
+
+// SetNillableHash sets the "hash" field if the given value is not nil.
+func (_u *AuditLogChainUpdate) SetNillableHash(v *string) *AuditLogChainUpdate {
+	if v != nil {
+		_u.SetHash(*v)
+	}
+	return _u
+}
+
+// Mutation returns the AuditLogChainMutation object of the builder.
+func (_u *AuditLogChainUpdate) Mutation() *AuditLogChainMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *AuditLogChainUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AuditLogChainUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *AuditLogChainUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AuditLogChainUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *AuditLogChainUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := auditlogchain.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *AuditLogChainUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AuditLogChainUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *AuditLogChainUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(auditlogchain.Table, auditlogchain.Columns, sqlgraph.NewFieldSpec(auditlogchain.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(auditlogchain.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Seq(); ok {
+		_spec.SetField(auditlogchain.FieldSeq, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.AddedSeq(); ok {
+		_spec.AddField(auditlogchain.FieldSeq, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.Hash(); ok {
+		_spec.SetField(auditlogchain.FieldHash, field.TypeString, value)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{auditlogchain.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// AuditLogChainUpdateOne is the builder for updating a single AuditLogChain entity.
+type AuditLogChainUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *AuditLogChainMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *AuditLogChainUpdateOne) SetUpdatedAt(v time.Time) *AuditLogChainUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetSeq sets the "seq" field.
+func (_u *AuditLogChainUpdateOne) SetSeq(v uint64) *AuditLogChainUpdateOne {
+	_u.mutation.ResetSeq()
+	_u.mutation.SetSeq(v)
+	return _u
+}
+
+// SetNillableSeq sets the "seq" field if the given value is not nil.
+func (_u *AuditLogChainUpdateOne) SetNillableSeq(v *uint64) *AuditLogChainUpdateOne {
+	if v != nil {
+		_u.SetSeq(*v)
+	}
+	return _u
+}
+
+// AddSeq adds value to the "seq" field.
+func (_u *AuditLogChainUpdateOne) AddSeq(v int64) *AuditLogChainUpdateOne {
+	_u.mutation.AddSeq(v)
+	return _u
+}
+
+// SetHash sets the "hash" field.
+func (_u *AuditLogChainUpdateOne) SetHash(v string) *AuditLogChainUpdateOne {
+	_u.mutation.SetHash(v)
+	return _u
+}
+
+// SetNillableHash sets the "hash" field if the given value is not nil.
+func (_u *AuditLogChainUpdateOne) SetNillableHash(v *string) *AuditLogChainUpdateOne {
+	if v != nil {
+		_u.SetHash(*v)
+	}
+	return _u
+}
+
+// Mutation returns the AuditLogChainMutation object of the builder.
+func (_u *AuditLogChainUpdateOne) Mutation() *AuditLogChainMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the AuditLogChainUpdate builder.
+func (_u *AuditLogChainUpdateOne) Where(ps ...predicate.AuditLogChain) *AuditLogChainUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *AuditLogChainUpdateOne) Select(field string, fields ...string) *AuditLogChainUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated AuditLogChain entity.
+func (_u *AuditLogChainUpdateOne) Save(ctx context.Context) (*AuditLogChain, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AuditLogChainUpdateOne) SaveX(ctx context.Context) *AuditLogChain {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *AuditLogChainUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AuditLogChainUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *AuditLogChainUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := auditlogchain.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *AuditLogChainUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AuditLogChainUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *AuditLogChainUpdateOne) sqlSave(ctx context.Context) (_node *AuditLogChain, err error) {
+	_spec := sqlgraph.NewUpdateSpec(auditlogchain.Table, auditlogchain.Columns, sqlgraph.NewFieldSpec(auditlogchain.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AuditLogChain.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, auditlogchain.FieldID)
+		for _, f := range fields {
+			if !auditlogchain.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != auditlogchain.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(auditlogchain.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Seq(); ok {
+		_spec.SetField(auditlogchain.FieldSeq, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.AddedSeq(); ok {
+		_spec.AddField(auditlogchain.FieldSeq, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.Hash(); ok {
+		_spec.SetField(auditlogchain.FieldHash, field.TypeString, value)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &AuditLogChain{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{auditlogchain.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
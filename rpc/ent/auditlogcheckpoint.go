@@ -0,0 +1,176 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/coder-lulu/newbee-core/rpc/ent/auditlogcheckpoint"
+	uuid "github.com/gofrs/uuid/v5"
+)
+
+// Audit Log Checkpoint Table | 审计日志签名检查点表
+type AuditLogCheckpoint struct {
+	config `json:"-"`
+	// ID of the ent.
+	// UUID
+	ID uuid.UUID `json:"id,omitempty"`
+	// Create Time | 创建日期
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Update Time | 修改日期
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Tenant ID | 租户ID
+	TenantID string `json:"tenant_id,omitempty"`
+	// Sequence number of the chain head when signed | 签名时链头的序号
+	Seq uint64 `json:"seq,omitempty"`
+	// Hash of the chain head | 链头记录的哈希
+	Hash string `json:"hash,omitempty"`
+	// ID of the signing key | 签名密钥ID
+	KeyID string `json:"key_id,omitempty"`
+	// HMAC-SHA256 signature of the checkpoint | 检查点的 HMAC-SHA256 签名
+	Signature    string `json:"signature,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AuditLogCheckpoint) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case auditlogcheckpoint.FieldSeq:
+			values[i] = new(sql.NullInt64)
+		case auditlogcheckpoint.FieldTenantID, auditlogcheckpoint.FieldHash, auditlogcheckpoint.FieldKeyID, auditlogcheckpoint.FieldSignature:
+			values[i] = new(sql.NullString)
+		case auditlogcheckpoint.FieldCreatedAt, auditlogcheckpoint.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case auditlogcheckpoint.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AuditLogCheckpoint fields.
+func (_m *AuditLogCheckpoint) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case auditlogcheckpoint.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case auditlogcheckpoint.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case auditlogcheckpoint.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case auditlogcheckpoint.FieldTenantID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				_m.TenantID = value.String
+			}
+		case auditlogcheckpoint.FieldSeq:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field seq", values[i])
+			} else if value.Valid {
+				_m.Seq = uint64(value.Int64)
+			}
+		case auditlogcheckpoint.FieldHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field hash", values[i])
+			} else if value.Valid {
+				_m.Hash = value.String
+			}
+		case auditlogcheckpoint.FieldKeyID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field key_id", values[i])
+			} else if value.Valid {
+				_m.KeyID = value.String
+			}
+		case auditlogcheckpoint.FieldSignature:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field signature", values[i])
+			} else if value.Valid {
+				_m.Signature = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the AuditLogCheckpoint.
+// This includes values selected through modifiers, order, etc.
+func (_m *AuditLogCheckpoint) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this AuditLogCheckpoint.
+// Note that you need to call AuditLogCheckpoint.Unwrap() before calling this method if this AuditLogCheckpoint
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *AuditLogCheckpoint) Update() *AuditLogCheckpointUpdateOne {
+	return NewAuditLogCheckpointClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the AuditLogCheckpoint entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *AuditLogCheckpoint) Unwrap() *AuditLogCheckpoint {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: AuditLogCheckpoint is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *AuditLogCheckpoint) String() string {
+	var builder strings.Builder
+	builder.WriteString("AuditLogCheckpoint(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("tenant_id=")
+	builder.WriteString(_m.TenantID)
+	builder.WriteString(", ")
+	builder.WriteString("seq=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Seq))
+	builder.WriteString(", ")
+	builder.WriteString("hash=")
+	builder.WriteString(_m.Hash)
+	builder.WriteString(", ")
+	builder.WriteString("key_id=")
+	builder.WriteString(_m.KeyID)
+	builder.WriteString(", ")
+	builder.WriteString("signature=")
+	builder.WriteString(_m.Signature)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// AuditLogCheckpoints is a parsable slice of AuditLogCheckpoint.
+type AuditLogCheckpoints []*AuditLogCheckpoint
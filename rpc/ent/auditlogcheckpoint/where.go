@@ -0,0 +1,486 @@
+// Code generated by ent, DO NOT EDIT.
+
+package auditlogcheckpoint
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/coder-lulu/newbee-core/rpc/ent/predicate"
+	uuid "github.com/gofrs/uuid/v5"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.AuditLogCheckpoint {
+	return predicate.AuditLogCheckpoint(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.AuditLogCheckpoint {
+	return predicate.AuditLogCheckpoint(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.AuditLogCheckpoint {
+	return predicate.AuditLogCheckpoint(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.AuditLogCheckpoint {
+	return predicate.AuditLogCheckpoint(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.AuditLogCheckpoint {
+	return predicate.AuditLogCheckpoint(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.AuditLogCheckpoint {
+	return predicate.AuditLogCheckpoint(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.AuditLogCheckpoint {
+	return predicate.AuditLogCheckpoint(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.AuditLogCheckpoint {
+	return predicate.AuditLogCheckpoint(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.AuditLogCheckpoint {
+	return predicate.AuditLogCheckpoint(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.AuditLogCheckpoint {
+	return predicate.AuditLogCheckpoint(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.AuditLogCheckpoint {
+	return predicate.AuditLogCheckpoint(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v string) predicate.AuditLogCheckpoint {
+	return predicate.AuditLogCheckpoint(sql.FieldEQ(FieldTenantID, v))
+}
+
+// Seq applies equality check predicate on the "seq" field. It's identical to SeqEQ.
+func Seq(v uint64) predicate.AuditLogCheckpoint {
+	return predicate.AuditLogCheckpoint(sql.FieldEQ(FieldSeq, v))
+}
+
+// Hash applies equality check predicate on the "hash" field. It's identical to HashEQ.
+func Hash(v string) predicate.AuditLogCheckpoint {
+	return predicate.AuditLogCheckpoint(sql.FieldEQ(FieldHash, v))
+}
+
+// KeyID applies equality check predicate on the "key_id" field. It's identical to KeyIDEQ.
+func KeyID(v string) predicate.AuditLogCheckpoint {
+	return predicate.AuditLogCheckpoint(sql.FieldEQ(FieldKeyID, v))
+}
+
+// Signature applies equality check predicate on the "signature" field. It's identical to SignatureEQ.
+func Signature(v string) predicate.AuditLogCheckpoint {
+	return predicate.AuditLogCheckpoint(sql.FieldEQ(FieldSignature, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.AuditLogCheckpoint {
+	return predicate.AuditLogCheckpoint(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.AuditLogCheckpoint {
+	return predicate.AuditLogCheckpoint(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.AuditLogCheckpoint {
+	return predicate.AuditLogCheckpoint(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.AuditLogCheckpoint {
+	return predicate.AuditLogCheckpoint(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.AuditLogCheckpoint {
+	return predicate.AuditLogCheckpoint(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.AuditLogCheckpoint {
+	return predicate.AuditLogCheckpoint(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.AuditLogCheckpoint {
+	return predicate.AuditLogCheckpoint(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.AuditLogCheckpoint {
+	return predicate.AuditLogCheckpoint(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.AuditLogCheckpoint {
+	return predicate.AuditLogCheckpoint(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.AuditLogCheckpoint {
+	return predicate.AuditLogCheckpoint(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.AuditLogCheckpoint {
+	return predicate.AuditLogCheckpoint(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.AuditLogCheckpoint {
+	return predicate.AuditLogCheckpoint(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.AuditLogCheckpoint {
+	return predicate.AuditLogCheckpoint(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.AuditLogCheckpoint {
+	return predicate.AuditLogCheckpoint(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.AuditLogCheckpoint {
+	return predicate.AuditLogCheckpoint(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.AuditLogCheckpoint {
+	return predicate.AuditLogCheckpoint(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v string) predicate.AuditLogCheckpoint {
+	return predicate.AuditLogCheckpoint(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v string) predicate.AuditLogCheckpoint {
+	return predicate.AuditLogCheckpoint(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...string) predicate.AuditLogCheckpoint {
+	return predicate.AuditLogCheckpoint(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...string) predicate.AuditLogCheckpoint {
+	return predicate.AuditLogCheckpoint(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v string) predicate.AuditLogCheckpoint {
+	return predicate.AuditLogCheckpoint(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v string) predicate.AuditLogCheckpoint {
+	return predicate.AuditLogCheckpoint(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v string) predicate.AuditLogCheckpoint {
+	return predicate.AuditLogCheckpoint(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v string) predicate.AuditLogCheckpoint {
+	return predicate.AuditLogCheckpoint(sql.FieldLTE(FieldTenantID, v))
+}
+
+// TenantIDContains applies the Contains predicate on the "tenant_id" field.
+func TenantIDContains(v string) predicate.AuditLogCheckpoint {
+	return predicate.AuditLogCheckpoint(sql.FieldContains(FieldTenantID, v))
+}
+
+// TenantIDHasPrefix applies the HasPrefix predicate on the "tenant_id" field.
+func TenantIDHasPrefix(v string) predicate.AuditLogCheckpoint {
+	return predicate.AuditLogCheckpoint(sql.FieldHasPrefix(FieldTenantID, v))
+}
+
+// TenantIDHasSuffix applies the HasSuffix predicate on the "tenant_id" field.
+func TenantIDHasSuffix(v string) predicate.AuditLogCheckpoint {
+	return predicate.AuditLogCheckpoint(sql.FieldHasSuffix(FieldTenantID, v))
+}
+
+// TenantIDEqualFold applies the EqualFold predicate on the "tenant_id" field.
+func TenantIDEqualFold(v string) predicate.AuditLogCheckpoint {
+	return predicate.AuditLogCheckpoint(sql.FieldEqualFold(FieldTenantID, v))
+}
+
+// TenantIDContainsFold applies the ContainsFold predicate on the "tenant_id" field.
+func TenantIDContainsFold(v string) predicate.AuditLogCheckpoint {
+	return predicate.AuditLogCheckpoint(sql.FieldContainsFold(FieldTenantID, v))
+}
+
+// SeqEQ applies the EQ predicate on the "seq" field.
+func SeqEQ(v uint64) predicate.AuditLogCheckpoint {
+	return predicate.AuditLogCheckpoint(sql.FieldEQ(FieldSeq, v))
+}
+
+// SeqNEQ applies the NEQ predicate on the "seq" field.
+func SeqNEQ(v uint64) predicate.AuditLogCheckpoint {
+	return predicate.AuditLogCheckpoint(sql.FieldNEQ(FieldSeq, v))
+}
+
+// SeqIn applies the In predicate on the "seq" field.
+func SeqIn(vs ...uint64) predicate.AuditLogCheckpoint {
+	return predicate.AuditLogCheckpoint(sql.FieldIn(FieldSeq, vs...))
+}
+
+// SeqNotIn applies the NotIn predicate on the "seq" field.
+func SeqNotIn(vs ...uint64) predicate.AuditLogCheckpoint {
+	return predicate.AuditLogCheckpoint(sql.FieldNotIn(FieldSeq, vs...))
+}
+
+// SeqGT applies the GT predicate on the "seq" field.
+func SeqGT(v uint64) predicate.AuditLogCheckpoint {
+	return predicate.AuditLogCheckpoint(sql.FieldGT(FieldSeq, v))
+}
+
+// SeqGTE applies the GTE predicate on the "seq" field.
+func SeqGTE(v uint64) predicate.AuditLogCheckpoint {
+	return predicate.AuditLogCheckpoint(sql.FieldGTE(FieldSeq, v))
+}
+
+// SeqLT applies the LT predicate on the "seq" field.
+func SeqLT(v uint64) predicate.AuditLogCheckpoint {
+	return predicate.AuditLogCheckpoint(sql.FieldLT(FieldSeq, v))
+}
+
+// SeqLTE applies the LTE predicate on the "seq" field.
+func SeqLTE(v uint64) predicate.AuditLogCheckpoint {
+	return predicate.AuditLogCheckpoint(sql.FieldLTE(FieldSeq, v))
+}
+
+// HashEQ applies the EQ predicate on the "hash" field.
+func HashEQ(v string) predicate.AuditLogCheckpoint {
+	return predicate.AuditLogCheckpoint(sql.FieldEQ(FieldHash, v))
+}
+
+// HashNEQ applies the NEQ predicate on the "hash" field.
+func HashNEQ(v string) predicate.AuditLogCheckpoint {
+	return predicate.AuditLogCheckpoint(sql.FieldNEQ(FieldHash, v))
+}
+
+// HashIn applies the In predicate on the "hash" field.
+func HashIn(vs ...string) predicate.AuditLogCheckpoint {
+	return predicate.AuditLogCheckpoint(sql.FieldIn(FieldHash, vs...))
+}
+
+// HashNotIn applies the NotIn predicate on the "hash" field.
+func HashNotIn(vs ...string) predicate.AuditLogCheckpoint {
+	return predicate.AuditLogCheckpoint(sql.FieldNotIn(FieldHash, vs...))
+}
+
+// HashGT applies the GT predicate on the "hash" field.
+func HashGT(v string) predicate.AuditLogCheckpoint {
+	return predicate.AuditLogCheckpoint(sql.FieldGT(FieldHash, v))
+}
+
+// HashGTE applies the GTE predicate on the "hash" field.
+func HashGTE(v string) predicate.AuditLogCheckpoint {
+	return predicate.AuditLogCheckpoint(sql.FieldGTE(FieldHash, v))
+}
+
+// HashLT applies the LT predicate on the "hash" field.
+func HashLT(v string) predicate.AuditLogCheckpoint {
+	return predicate.AuditLogCheckpoint(sql.FieldLT(FieldHash, v))
+}
+
+// HashLTE applies the LTE predicate on the "hash" field.
+func HashLTE(v string) predicate.AuditLogCheckpoint {
+	return predicate.AuditLogCheckpoint(sql.FieldLTE(FieldHash, v))
+}
+
+// HashContains applies the Contains predicate on the "hash" field.
+func HashContains(v string) predicate.AuditLogCheckpoint {
+	return predicate.AuditLogCheckpoint(sql.FieldContains(FieldHash, v))
+}
+
+// HashHasPrefix applies the HasPrefix predicate on the "hash" field.
+func HashHasPrefix(v string) predicate.AuditLogCheckpoint {
+	return predicate.AuditLogCheckpoint(sql.FieldHasPrefix(FieldHash, v))
+}
+
+// HashHasSuffix applies the HasSuffix predicate on the "hash" field.
+func HashHasSuffix(v string) predicate.AuditLogCheckpoint {
+	return predicate.AuditLogCheckpoint(sql.FieldHasSuffix(FieldHash, v))
+}
+
+// HashEqualFold applies the EqualFold predicate on the "hash" field.
+func HashEqualFold(v string) predicate.AuditLogCheckpoint {
+	return predicate.AuditLogCheckpoint(sql.FieldEqualFold(FieldHash, v))
+}
+
+// HashContainsFold applies the ContainsFold predicate on the "hash" field.
+func HashContainsFold(v string) predicate.AuditLogCheckpoint {
+	return predicate.AuditLogCheckpoint(sql.FieldContainsFold(FieldHash, v))
+}
+
+// KeyIDEQ applies the EQ predicate on the "key_id" field.
+func KeyIDEQ(v string) predicate.AuditLogCheckpoint {
+	return predicate.AuditLogCheckpoint(sql.FieldEQ(FieldKeyID, v))
+}
+
+// KeyIDNEQ applies the NEQ predicate on the "key_id" field.
+func KeyIDNEQ(v string) predicate.AuditLogCheckpoint {
+	return predicate.AuditLogCheckpoint(sql.FieldNEQ(FieldKeyID, v))
+}
+
+// KeyIDIn applies the In predicate on the "key_id" field.
+func KeyIDIn(vs ...string) predicate.AuditLogCheckpoint {
+	return predicate.AuditLogCheckpoint(sql.FieldIn(FieldKeyID, vs...))
+}
+
+// KeyIDNotIn applies the NotIn predicate on the "key_id" field.
+func KeyIDNotIn(vs ...string) predicate.AuditLogCheckpoint {
+	return predicate.AuditLogCheckpoint(sql.FieldNotIn(FieldKeyID, vs...))
+}
+
+// KeyIDGT applies the GT predicate on the "key_id" field.
+func KeyIDGT(v string) predicate.AuditLogCheckpoint {
+	return predicate.AuditLogCheckpoint(sql.FieldGT(FieldKeyID, v))
+}
+
+// KeyIDGTE applies the GTE predicate on the "key_id" field.
+func KeyIDGTE(v string) predicate.AuditLogCheckpoint {
+	return predicate.AuditLogCheckpoint(sql.FieldGTE(FieldKeyID, v))
+}
+
+// KeyIDLT applies the LT predicate on the "key_id" field.
+func KeyIDLT(v string) predicate.AuditLogCheckpoint {
+	return predicate.AuditLogCheckpoint(sql.FieldLT(FieldKeyID, v))
+}
+
+// KeyIDLTE applies the LTE predicate on the "key_id" field.
+func KeyIDLTE(v string) predicate.AuditLogCheckpoint {
+	return predicate.AuditLogCheckpoint(sql.FieldLTE(FieldKeyID, v))
+}
+
+// KeyIDContains applies the Contains predicate on the "key_id" field.
+func KeyIDContains(v string) predicate.AuditLogCheckpoint {
+	return predicate.AuditLogCheckpoint(sql.FieldContains(FieldKeyID, v))
+}
+
+// KeyIDHasPrefix applies the HasPrefix predicate on the "key_id" field.
+func KeyIDHasPrefix(v string) predicate.AuditLogCheckpoint {
+	return predicate.AuditLogCheckpoint(sql.FieldHasPrefix(FieldKeyID, v))
+}
+
+// KeyIDHasSuffix applies the HasSuffix predicate on the "key_id" field.
+func KeyIDHasSuffix(v string) predicate.AuditLogCheckpoint {
+	return predicate.AuditLogCheckpoint(sql.FieldHasSuffix(FieldKeyID, v))
+}
+
+// KeyIDEqualFold applies the EqualFold predicate on the "key_id" field.
+func KeyIDEqualFold(v string) predicate.AuditLogCheckpoint {
+	return predicate.AuditLogCheckpoint(sql.FieldEqualFold(FieldKeyID, v))
+}
+
+// KeyIDContainsFold applies the ContainsFold predicate on the "key_id" field.
+func KeyIDContainsFold(v string) predicate.AuditLogCheckpoint {
+	return predicate.AuditLogCheckpoint(sql.FieldContainsFold(FieldKeyID, v))
+}
+
+// SignatureEQ applies the EQ predicate on the "signature" field.
+func SignatureEQ(v string) predicate.AuditLogCheckpoint {
+	return predicate.AuditLogCheckpoint(sql.FieldEQ(FieldSignature, v))
+}
+
+// SignatureNEQ applies the NEQ predicate on the "signature" field.
+func SignatureNEQ(v string) predicate.AuditLogCheckpoint {
+	return predicate.AuditLogCheckpoint(sql.FieldNEQ(FieldSignature, v))
+}
+
+// SignatureIn applies the In predicate on the "signature" field.
+func SignatureIn(vs ...string) predicate.AuditLogCheckpoint {
+	return predicate.AuditLogCheckpoint(sql.FieldIn(FieldSignature, vs...))
+}
+
+// SignatureNotIn applies the NotIn predicate on the "signature" field.
+func SignatureNotIn(vs ...string) predicate.AuditLogCheckpoint {
+	return predicate.AuditLogCheckpoint(sql.FieldNotIn(FieldSignature, vs...))
+}
+
+// SignatureGT applies the GT predicate on the "signature" field.
+func SignatureGT(v string) predicate.AuditLogCheckpoint {
+	return predicate.AuditLogCheckpoint(sql.FieldGT(FieldSignature, v))
+}
+
+// SignatureGTE applies the GTE predicate on the "signature" field.
+func SignatureGTE(v string) predicate.AuditLogCheckpoint {
+	return predicate.AuditLogCheckpoint(sql.FieldGTE(FieldSignature, v))
+}
+
+// SignatureLT applies the LT predicate on the "signature" field.
+func SignatureLT(v string) predicate.AuditLogCheckpoint {
+	return predicate.AuditLogCheckpoint(sql.FieldLT(FieldSignature, v))
+}
+
+// SignatureLTE applies the LTE predicate on the "signature" field.
+func SignatureLTE(v string) predicate.AuditLogCheckpoint {
+	return predicate.AuditLogCheckpoint(sql.FieldLTE(FieldSignature, v))
+}
+
+// SignatureContains applies the Contains predicate on the "signature" field.
+func SignatureContains(v string) predicate.AuditLogCheckpoint {
+	return predicate.AuditLogCheckpoint(sql.FieldContains(FieldSignature, v))
+}
+
+// SignatureHasPrefix applies the HasPrefix predicate on the "signature" field.
+func SignatureHasPrefix(v string) predicate.AuditLogCheckpoint {
+	return predicate.AuditLogCheckpoint(sql.FieldHasPrefix(FieldSignature, v))
+}
+
+// SignatureHasSuffix applies the HasSuffix predicate on the "signature" field.
+func SignatureHasSuffix(v string) predicate.AuditLogCheckpoint {
+	return predicate.AuditLogCheckpoint(sql.FieldHasSuffix(FieldSignature, v))
+}
+
+// SignatureEqualFold applies the EqualFold predicate on the "signature" field.
+func SignatureEqualFold(v string) predicate.AuditLogCheckpoint {
+	return predicate.AuditLogCheckpoint(sql.FieldEqualFold(FieldSignature, v))
+}
+
+// SignatureContainsFold applies the ContainsFold predicate on the "signature" field.
+func SignatureContainsFold(v string) predicate.AuditLogCheckpoint {
+	return predicate.AuditLogCheckpoint(sql.FieldContainsFold(FieldSignature, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.AuditLogCheckpoint) predicate.AuditLogCheckpoint {
+	return predicate.AuditLogCheckpoint(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.AuditLogCheckpoint) predicate.AuditLogCheckpoint {
+	return predicate.AuditLogCheckpoint(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.AuditLogCheckpoint) predicate.AuditLogCheckpoint {
+	return predicate.AuditLogCheckpoint(sql.NotPredicates(p))
+}
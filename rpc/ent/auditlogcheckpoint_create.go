@@ -0,0 +1,316 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/coder-lulu/newbee-core/rpc/ent/auditlogcheckpoint"
+	uuid "github.com/gofrs/uuid/v5"
+)
+
+// AuditLogCheckpointCreate is the builder for creating a AuditLogCheckpoint entity.
+type AuditLogCheckpointCreate struct {
+	config
+	mutation *AuditLogCheckpointMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *AuditLogCheckpointCreate) SetCreatedAt(v time.Time) *AuditLogCheckpointCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *AuditLogCheckpointCreate) SetNillableCreatedAt(v *time.Time) *AuditLogCheckpointCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *AuditLogCheckpointCreate) SetUpdatedAt(v time.Time) *AuditLogCheckpointCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *AuditLogCheckpointCreate) SetNillableUpdatedAt(v *time.Time) *AuditLogCheckpointCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *AuditLogCheckpointCreate) SetTenantID(v string) *AuditLogCheckpointCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetSeq sets the "seq" field.
+func (_c *AuditLogCheckpointCreate) SetSeq(v uint64) *AuditLogCheckpointCreate {
+	_c.mutation.SetSeq(v)
+	return _c
+}
+
+// SetHash sets the "hash" field.
+func (_c *AuditLogCheckpointCreate) SetHash(v string) *AuditLogCheckpointCreate {
+	_c.mutation.SetHash(v)
+	return _c
+}
+
+// SetKeyID sets the "key_id" field.
+func (_c *AuditLogCheckpointCreate) SetKeyID(v string) *AuditLogCheckpointCreate {
+	_c.mutation.SetKeyID(v)
+	return _c
+}
+
+// SetSignature sets the "signature" field.
+func (_c *AuditLogCheckpointCreate) SetSignature(v string) *AuditLogCheckpointCreate {
+	_c.mutation.SetSignature(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *AuditLogCheckpointCreate) SetID(v uuid.UUID) *AuditLogCheckpointCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *AuditLogCheckpointCreate) SetNillableID(v *uuid.UUID) *AuditLogCheckpointCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the AuditLogCheckpointMutation object of the builder.
+func (_c *AuditLogCheckpointCreate) Mutation() *AuditLogCheckpointMutation {
+	return _c.mutation
+}
+
+// Save creates the AuditLogCheckpoint in the database.
+func (_c *AuditLogCheckpointCreate) Save(ctx context.Context) (*AuditLogCheckpoint, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *AuditLogCheckpointCreate) SaveX(ctx context.Context) *AuditLogCheckpoint {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AuditLogCheckpointCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AuditLogCheckpointCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *AuditLogCheckpointCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := auditlogcheckpoint.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := auditlogcheckpoint.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := auditlogcheckpoint.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *AuditLogCheckpointCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "AuditLogCheckpoint.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "AuditLogCheckpoint.updated_at"`)}
+	}
+	if _, ok := _c.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "AuditLogCheckpoint.tenant_id"`)}
+	}
+	if _, ok := _c.mutation.Seq(); !ok {
+		return &ValidationError{Name: "seq", err: errors.New(`ent: missing required field "AuditLogCheckpoint.seq"`)}
+	}
+	if _, ok := _c.mutation.Hash(); !ok {
+		return &ValidationError{Name: "hash", err: errors.New(`ent: missing required field "AuditLogCheckpoint.hash"`)}
+	}
+	if _, ok := _c.mutation.KeyID(); !ok {
+		return &ValidationError{Name: "key_id", err: errors.New(`ent: missing required field "AuditLogCheckpoint.key_id"`)}
+	}
+	if _, ok := _c.mutation.Signature(); !ok {
+		return &ValidationError{Name: "signature", err: errors.New(`ent: missing required field "AuditLogCheckpoint.signature"`)}
+	}
+	return nil
+}
+
+func (_c *AuditLogCheckpointCreate) sqlSave(ctx context.Context) (*AuditLogCheckpoint, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *AuditLogCheckpointCreate) createSpec() (*AuditLogCheckpoint, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AuditLogCheckpoint{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(auditlogcheckpoint.Table, sqlgraph.NewFieldSpec(auditlogcheckpoint.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(auditlogcheckpoint.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(auditlogcheckpoint.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(auditlogcheckpoint.FieldTenantID, field.TypeString, value)
+		_node.TenantID = value
+	}
+	if value, ok := _c.mutation.Seq(); ok {
+		_spec.SetField(auditlogcheckpoint.FieldSeq, field.TypeUint64, value)
+		_node.Seq = value
+	}
+	if value, ok := _c.mutation.Hash(); ok {
+		_spec.SetField(auditlogcheckpoint.FieldHash, field.TypeString, value)
+		_node.Hash = value
+	}
+	if value, ok := _c.mutation.KeyID(); ok {
+		_spec.SetField(auditlogcheckpoint.FieldKeyID, field.TypeString, value)
+		_node.KeyID = value
+	}
+	if value, ok := _c.mutation.Signature(); ok {
+		_spec.SetField(auditlogcheckpoint.FieldSignature, field.TypeString, value)
+		_node.Signature = value
+	}
+	return _node, _spec
+}
+
+// AuditLogCheckpointCreateBulk is the builder for creating many AuditLogCheckpoint entities in bulk.
+type AuditLogCheckpointCreateBulk struct {
+	config
+	err      error
+	builders []*AuditLogCheckpointCreate
+}
+
+// Save creates the AuditLogCheckpoint entities in the database.
+func (_c *AuditLogCheckpointCreateBulk) Save(ctx context.Context) ([]*AuditLogCheckpoint, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*AuditLogCheckpoint, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AuditLogCheckpointMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *AuditLogCheckpointCreateBulk) SaveX(ctx context.Context) []*AuditLogCheckpoint {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AuditLogCheckpointCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AuditLogCheckpointCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
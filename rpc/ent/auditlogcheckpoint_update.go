@@ -0,0 +1,228 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/coder-lulu/newbee-core/rpc/ent/auditlogcheckpoint"
+	"github.com/coder-lulu/newbee-core/rpc/ent/predicate"
+)
+
+// AuditLogCheckpointUpdate is the builder for updating AuditLogCheckpoint entities.
+type AuditLogCheckpointUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *AuditLogCheckpointMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the AuditLogCheckpointUpdate builder.
+func (_u *AuditLogCheckpointUpdate) Where(ps ...predicate.AuditLogCheckpoint) *AuditLogCheckpointUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *AuditLogCheckpointUpdate) SetUpdatedAt(v time.Time) *AuditLogCheckpointUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the AuditLogCheckpointMutation object of the builder.
+func (_u *AuditLogCheckpointUpdate) Mutation() *AuditLogCheckpointMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *AuditLogCheckpointUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AuditLogCheckpointUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *AuditLogCheckpointUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AuditLogCheckpointUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *AuditLogCheckpointUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := auditlogcheckpoint.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *AuditLogCheckpointUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AuditLogCheckpointUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *AuditLogCheckpointUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(auditlogcheckpoint.Table, auditlogcheckpoint.Columns, sqlgraph.NewFieldSpec(auditlogcheckpoint.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(auditlogcheckpoint.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{auditlogcheckpoint.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// AuditLogCheckpointUpdateOne is the builder for updating a single AuditLogCheckpoint entity.
+type AuditLogCheckpointUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *AuditLogCheckpointMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *AuditLogCheckpointUpdateOne) SetUpdatedAt(v time.Time) *AuditLogCheckpointUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the AuditLogCheckpointMutation object of the builder.
+func (_u *AuditLogCheckpointUpdateOne) Mutation() *AuditLogCheckpointMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the AuditLogCheckpointUpdate builder.
+func (_u *AuditLogCheckpointUpdateOne) Where(ps ...predicate.AuditLogCheckpoint) *AuditLogCheckpointUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *AuditLogCheckpointUpdateOne) Select(field string, fields ...string) *AuditLogCheckpointUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated AuditLogCheckpoint entity.
+func (_u *AuditLogCheckpointUpdateOne) Save(ctx context.Context) (*AuditLogCheckpoint, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AuditLogCheckpointUpdateOne) SaveX(ctx context.Context) *AuditLogCheckpoint {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *AuditLogCheckpointUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AuditLogCheckpointUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *AuditLogCheckpointUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := auditlogcheckpoint.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *AuditLogCheckpointUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AuditLogCheckpointUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *AuditLogCheckpointUpdateOne) sqlSave(ctx context.Context) (_node *AuditLogCheckpoint, err error) {
+	_spec := sqlgraph.NewUpdateSpec(auditlogcheckpoint.Table, auditlogcheckpoint.Columns, sqlgraph.NewFieldSpec(auditlogcheckpoint.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AuditLogCheckpoint.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, auditlogcheckpoint.FieldID)
+		for _, f := range fields {
+			if !auditlogcheckpoint.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != auditlogcheckpoint.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(auditlogcheckpoint.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &AuditLogCheckpoint{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{auditlogcheckpoint.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
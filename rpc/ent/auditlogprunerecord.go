@@ -0,0 +1,220 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/coder-lulu/newbee-core/rpc/ent/auditlogprunerecord"
+	uuid "github.com/gofrs/uuid/v5"
+)
+
+// Audit Log Prune Record Table | 审计日志清理记录表
+type AuditLogPruneRecord struct {
+	config `json:"-"`
+	// ID of the ent.
+	// UUID
+	ID uuid.UUID `json:"id,omitempty"`
+	// Create Time | 创建日期
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Update Time | 修改日期
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Tenant ID | 租户ID
+	TenantID string `json:"tenant_id,omitempty"`
+	// First pruned sequence number | 清理的起始序号
+	FromSeq uint64 `json:"from_seq,omitempty"`
+	// Last pruned sequence number | 清理的结束序号
+	ToSeq uint64 `json:"to_seq,omitempty"`
+	// Number of pruned records | 清理的记录数
+	Count int `json:"count,omitempty"`
+	// Hash of the last pruned record, the new chain anchor | 最后一条被清理记录的哈希，作为新的链起点
+	LastHash string `json:"last_hash,omitempty"`
+	// Records created before this time were pruned | 清理该时间之前创建的记录
+	CutoffAt time.Time `json:"cutoff_at,omitempty"`
+	// Retention policy that triggered the pruning | 触发清理的保留策略
+	Policy string `json:"policy,omitempty"`
+	// ID of the signing key | 签名密钥ID
+	KeyID string `json:"key_id,omitempty"`
+	// HMAC-SHA256 signature of the record | 记录的 HMAC-SHA256 签名
+	Signature    string `json:"signature,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AuditLogPruneRecord) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case auditlogprunerecord.FieldFromSeq, auditlogprunerecord.FieldToSeq, auditlogprunerecord.FieldCount:
+			values[i] = new(sql.NullInt64)
+		case auditlogprunerecord.FieldTenantID, auditlogprunerecord.FieldLastHash, auditlogprunerecord.FieldPolicy, auditlogprunerecord.FieldKeyID, auditlogprunerecord.FieldSignature:
+			values[i] = new(sql.NullString)
+		case auditlogprunerecord.FieldCreatedAt, auditlogprunerecord.FieldUpdatedAt, auditlogprunerecord.FieldCutoffAt:
+			values[i] = new(sql.NullTime)
+		case auditlogprunerecord.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AuditLogPruneRecord fields.
+func (_m *AuditLogPruneRecord) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case auditlogprunerecord.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case auditlogprunerecord.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case auditlogprunerecord.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case auditlogprunerecord.FieldTenantID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				_m.TenantID = value.String
+			}
+		case auditlogprunerecord.FieldFromSeq:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field from_seq", values[i])
+			} else if value.Valid {
+				_m.FromSeq = uint64(value.Int64)
+			}
+		case auditlogprunerecord.FieldToSeq:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field to_seq", values[i])
+			} else if value.Valid {
+				_m.ToSeq = uint64(value.Int64)
+			}
+		case auditlogprunerecord.FieldCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field count", values[i])
+			} else if value.Valid {
+				_m.Count = int(value.Int64)
+			}
+		case auditlogprunerecord.FieldLastHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field last_hash", values[i])
+			} else if value.Valid {
+				_m.LastHash = value.String
+			}
+		case auditlogprunerecord.FieldCutoffAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field cutoff_at", values[i])
+			} else if value.Valid {
+				_m.CutoffAt = value.Time
+			}
+		case auditlogprunerecord.FieldPolicy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field policy", values[i])
+			} else if value.Valid {
+				_m.Policy = value.String
+			}
+		case auditlogprunerecord.FieldKeyID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field key_id", values[i])
+			} else if value.Valid {
+				_m.KeyID = value.String
+			}
+		case auditlogprunerecord.FieldSignature:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field signature", values[i])
+			} else if value.Valid {
+				_m.Signature = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the AuditLogPruneRecord.
+// This includes values selected through modifiers, order, etc.
+func (_m *AuditLogPruneRecord) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this AuditLogPruneRecord.
+// Note that you need to call AuditLogPruneRecord.Unwrap() before calling this method if this AuditLogPruneRecord
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *AuditLogPruneRecord) Update() *AuditLogPruneRecordUpdateOne {
+	return NewAuditLogPruneRecordClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the AuditLogPruneRecord entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *AuditLogPruneRecord) Unwrap() *AuditLogPruneRecord {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: AuditLogPruneRecord is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *AuditLogPruneRecord) String() string {
+	var builder strings.Builder
+	builder.WriteString("AuditLogPruneRecord(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("tenant_id=")
+	builder.WriteString(_m.TenantID)
+	builder.WriteString(", ")
+	builder.WriteString("from_seq=")
+	builder.WriteString(fmt.Sprintf("%v", _m.FromSeq))
+	builder.WriteString(", ")
+	builder.WriteString("to_seq=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ToSeq))
+	builder.WriteString(", ")
+	builder.WriteString("count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Count))
+	builder.WriteString(", ")
+	builder.WriteString("last_hash=")
+	builder.WriteString(_m.LastHash)
+	builder.WriteString(", ")
+	builder.WriteString("cutoff_at=")
+	builder.WriteString(_m.CutoffAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("policy=")
+	builder.WriteString(_m.Policy)
+	builder.WriteString(", ")
+	builder.WriteString("key_id=")
+	builder.WriteString(_m.KeyID)
+	builder.WriteString(", ")
+	builder.WriteString("signature=")
+	builder.WriteString(_m.Signature)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// AuditLogPruneRecords is a parsable slice of AuditLogPruneRecord.
+type AuditLogPruneRecords []*AuditLogPruneRecord
@@ -0,0 +1,141 @@
+// Code generated by ent, DO NOT EDIT.
+
+package auditlogprunerecord
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	uuid "github.com/gofrs/uuid/v5"
+)
+
+const (
+	// Label holds the string label denoting the auditlogprunerecord type in the database.
+	Label = "audit_log_prune_record"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
+	// FieldFromSeq holds the string denoting the from_seq field in the database.
+	FieldFromSeq = "from_seq"
+	// FieldToSeq holds the string denoting the to_seq field in the database.
+	FieldToSeq = "to_seq"
+	// FieldCount holds the string denoting the count field in the database.
+	FieldCount = "count"
+	// FieldLastHash holds the string denoting the last_hash field in the database.
+	FieldLastHash = "last_hash"
+	// FieldCutoffAt holds the string denoting the cutoff_at field in the database.
+	FieldCutoffAt = "cutoff_at"
+	// FieldPolicy holds the string denoting the policy field in the database.
+	FieldPolicy = "policy"
+	// FieldKeyID holds the string denoting the key_id field in the database.
+	FieldKeyID = "key_id"
+	// FieldSignature holds the string denoting the signature field in the database.
+	FieldSignature = "signature"
+	// Table holds the table name of the auditlogprunerecord in the database.
+	Table = "sys_audit_log_prune_records"
+)
+
+// Columns holds all SQL columns for auditlogprunerecord fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldTenantID,
+	FieldFromSeq,
+	FieldToSeq,
+	FieldCount,
+	FieldLastHash,
+	FieldCutoffAt,
+	FieldPolicy,
+	FieldKeyID,
+	FieldSignature,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the AuditLogPruneRecord queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByTenantID orders the results by the tenant_id field.
+func ByTenantID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenantID, opts...).ToFunc()
+}
+
+// ByFromSeq orders the results by the from_seq field.
+func ByFromSeq(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFromSeq, opts...).ToFunc()
+}
+
+// ByToSeq orders the results by the to_seq field.
+func ByToSeq(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldToSeq, opts...).ToFunc()
+}
+
+// ByCount orders the results by the count field.
+func ByCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCount, opts...).ToFunc()
+}
+
+// ByLastHash orders the results by the last_hash field.
+func ByLastHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastHash, opts...).ToFunc()
+}
+
+// ByCutoffAt orders the results by the cutoff_at field.
+func ByCutoffAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCutoffAt, opts...).ToFunc()
+}
+
+// ByPolicy orders the results by the policy field.
+func ByPolicy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPolicy, opts...).ToFunc()
+}
+
+// ByKeyID orders the results by the key_id field.
+func ByKeyID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKeyID, opts...).ToFunc()
+}
+
+// BySignature orders the results by the signature field.
+func BySignature(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSignature, opts...).ToFunc()
+}
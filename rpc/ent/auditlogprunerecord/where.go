@@ -0,0 +1,686 @@
+// Code generated by ent, DO NOT EDIT.
+
+package auditlogprunerecord
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/coder-lulu/newbee-core/rpc/ent/predicate"
+	uuid "github.com/gofrs/uuid/v5"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v string) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.FieldEQ(FieldTenantID, v))
+}
+
+// FromSeq applies equality check predicate on the "from_seq" field. It's identical to FromSeqEQ.
+func FromSeq(v uint64) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.FieldEQ(FieldFromSeq, v))
+}
+
+// ToSeq applies equality check predicate on the "to_seq" field. It's identical to ToSeqEQ.
+func ToSeq(v uint64) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.FieldEQ(FieldToSeq, v))
+}
+
+// Count applies equality check predicate on the "count" field. It's identical to CountEQ.
+func Count(v int) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.FieldEQ(FieldCount, v))
+}
+
+// LastHash applies equality check predicate on the "last_hash" field. It's identical to LastHashEQ.
+func LastHash(v string) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.FieldEQ(FieldLastHash, v))
+}
+
+// CutoffAt applies equality check predicate on the "cutoff_at" field. It's identical to CutoffAtEQ.
+func CutoffAt(v time.Time) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.FieldEQ(FieldCutoffAt, v))
+}
+
+// KeyID applies equality check predicate on the "key_id" field. It's identical to KeyIDEQ.
+func KeyID(v string) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.FieldEQ(FieldKeyID, v))
+}
+
+// Signature applies equality check predicate on the "signature" field. It's identical to SignatureEQ.
+func Signature(v string) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.FieldEQ(FieldSignature, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v string) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v string) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...string) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...string) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v string) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v string) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v string) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v string) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.FieldLTE(FieldTenantID, v))
+}
+
+// TenantIDContains applies the Contains predicate on the "tenant_id" field.
+func TenantIDContains(v string) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.FieldContains(FieldTenantID, v))
+}
+
+// TenantIDHasPrefix applies the HasPrefix predicate on the "tenant_id" field.
+func TenantIDHasPrefix(v string) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.FieldHasPrefix(FieldTenantID, v))
+}
+
+// TenantIDHasSuffix applies the HasSuffix predicate on the "tenant_id" field.
+func TenantIDHasSuffix(v string) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.FieldHasSuffix(FieldTenantID, v))
+}
+
+// TenantIDEqualFold applies the EqualFold predicate on the "tenant_id" field.
+func TenantIDEqualFold(v string) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.FieldEqualFold(FieldTenantID, v))
+}
+
+// TenantIDContainsFold applies the ContainsFold predicate on the "tenant_id" field.
+func TenantIDContainsFold(v string) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.FieldContainsFold(FieldTenantID, v))
+}
+
+// FromSeqEQ applies the EQ predicate on the "from_seq" field.
+func FromSeqEQ(v uint64) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.FieldEQ(FieldFromSeq, v))
+}
+
+// FromSeqNEQ applies the NEQ predicate on the "from_seq" field.
+func FromSeqNEQ(v uint64) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.FieldNEQ(FieldFromSeq, v))
+}
+
+// FromSeqIn applies the In predicate on the "from_seq" field.
+func FromSeqIn(vs ...uint64) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.FieldIn(FieldFromSeq, vs...))
+}
+
+// FromSeqNotIn applies the NotIn predicate on the "from_seq" field.
+func FromSeqNotIn(vs ...uint64) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.FieldNotIn(FieldFromSeq, vs...))
+}
+
+// FromSeqGT applies the GT predicate on the "from_seq" field.
+func FromSeqGT(v uint64) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.FieldGT(FieldFromSeq, v))
+}
+
+// FromSeqGTE applies the GTE predicate on the "from_seq" field.
+func FromSeqGTE(v uint64) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.FieldGTE(FieldFromSeq, v))
+}
+
+// FromSeqLT applies the LT predicate on the "from_seq" field.
+func FromSeqLT(v uint64) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.FieldLT(FieldFromSeq, v))
+}
+
+// FromSeqLTE applies the LTE predicate on the "from_seq" field.
+func FromSeqLTE(v uint64) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.FieldLTE(FieldFromSeq, v))
+}
+
+// ToSeqEQ applies the EQ predicate on the "to_seq" field.
+func ToSeqEQ(v uint64) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.FieldEQ(FieldToSeq, v))
+}
+
+// ToSeqNEQ applies the NEQ predicate on the "to_seq" field.
+func ToSeqNEQ(v uint64) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.FieldNEQ(FieldToSeq, v))
+}
+
+// ToSeqIn applies the In predicate on the "to_seq" field.
+func ToSeqIn(vs ...uint64) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.FieldIn(FieldToSeq, vs...))
+}
+
+// ToSeqNotIn applies the NotIn predicate on the "to_seq" field.
+func ToSeqNotIn(vs ...uint64) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.FieldNotIn(FieldToSeq, vs...))
+}
+
+// ToSeqGT applies the GT predicate on the "to_seq" field.
+func ToSeqGT(v uint64) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.FieldGT(FieldToSeq, v))
+}
+
+// ToSeqGTE applies the GTE predicate on the "to_seq" field.
+func ToSeqGTE(v uint64) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.FieldGTE(FieldToSeq, v))
+}
+
+// ToSeqLT applies the LT predicate on the "to_seq" field.
+func ToSeqLT(v uint64) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.FieldLT(FieldToSeq, v))
+}
+
+// ToSeqLTE applies the LTE predicate on the "to_seq" field.
+func ToSeqLTE(v uint64) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.FieldLTE(FieldToSeq, v))
+}
+
+// CountEQ applies the EQ predicate on the "count" field.
+func CountEQ(v int) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.FieldEQ(FieldCount, v))
+}
+
+// CountNEQ applies the NEQ predicate on the "count" field.
+func CountNEQ(v int) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.FieldNEQ(FieldCount, v))
+}
+
+// CountIn applies the In predicate on the "count" field.
+func CountIn(vs ...int) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.FieldIn(FieldCount, vs...))
+}
+
+// CountNotIn applies the NotIn predicate on the "count" field.
+func CountNotIn(vs ...int) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.FieldNotIn(FieldCount, vs...))
+}
+
+// CountGT applies the GT predicate on the "count" field.
+func CountGT(v int) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.FieldGT(FieldCount, v))
+}
+
+// CountGTE applies the GTE predicate on the "count" field.
+func CountGTE(v int) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.FieldGTE(FieldCount, v))
+}
+
+// CountLT applies the LT predicate on the "count" field.
+func CountLT(v int) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.FieldLT(FieldCount, v))
+}
+
+// CountLTE applies the LTE predicate on the "count" field.
+func CountLTE(v int) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.FieldLTE(FieldCount, v))
+}
+
+// LastHashEQ applies the EQ predicate on the "last_hash" field.
+func LastHashEQ(v string) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.FieldEQ(FieldLastHash, v))
+}
+
+// LastHashNEQ applies the NEQ predicate on the "last_hash" field.
+func LastHashNEQ(v string) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.FieldNEQ(FieldLastHash, v))
+}
+
+// LastHashIn applies the In predicate on the "last_hash" field.
+func LastHashIn(vs ...string) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.FieldIn(FieldLastHash, vs...))
+}
+
+// LastHashNotIn applies the NotIn predicate on the "last_hash" field.
+func LastHashNotIn(vs ...string) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.FieldNotIn(FieldLastHash, vs...))
+}
+
+// LastHashGT applies the GT predicate on the "last_hash" field.
+func LastHashGT(v string) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.FieldGT(FieldLastHash, v))
+}
+
+// LastHashGTE applies the GTE predicate on the "last_hash" field.
+func LastHashGTE(v string) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.FieldGTE(FieldLastHash, v))
+}
+
+// LastHashLT applies the LT predicate on the "last_hash" field.
+func LastHashLT(v string) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.FieldLT(FieldLastHash, v))
+}
+
+// LastHashLTE applies the LTE predicate on the "last_hash" field.
+func LastHashLTE(v string) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.FieldLTE(FieldLastHash, v))
+}
+
+// LastHashContains applies the Contains predicate on the "last_hash" field.
+func LastHashContains(v string) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.FieldContains(FieldLastHash, v))
+}
+
+// LastHashHasPrefix applies the HasPrefix predicate on the "last_hash" field.
+func LastHashHasPrefix(v string) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.FieldHasPrefix(FieldLastHash, v))
+}
+
+// LastHashHasSuffix applies the HasSuffix predicate on the "last_hash" field.
+func LastHashHasSuffix(v string) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.FieldHasSuffix(FieldLastHash, v))
+}
+
+// LastHashEqualFold applies the EqualFold predicate on the "last_hash" field.
+func LastHashEqualFold(v string) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.FieldEqualFold(FieldLastHash, v))
+}
+
+// LastHashContainsFold applies the ContainsFold predicate on the "last_hash" field.
+func LastHashContainsFold(v string) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.FieldContainsFold(FieldLastHash, v))
+}
+
+// CutoffAtEQ applies the EQ predicate on the "cutoff_at" field.
+func CutoffAtEQ(v time.Time) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.FieldEQ(FieldCutoffAt, v))
+}
+
+// CutoffAtNEQ applies the NEQ predicate on the "cutoff_at" field.
+func CutoffAtNEQ(v time.Time) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.FieldNEQ(FieldCutoffAt, v))
+}
+
+// CutoffAtIn applies the In predicate on the "cutoff_at" field.
+func CutoffAtIn(vs ...time.Time) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.FieldIn(FieldCutoffAt, vs...))
+}
+
+// CutoffAtNotIn applies the NotIn predicate on the "cutoff_at" field.
+func CutoffAtNotIn(vs ...time.Time) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.FieldNotIn(FieldCutoffAt, vs...))
+}
+
+// CutoffAtGT applies the GT predicate on the "cutoff_at" field.
+func CutoffAtGT(v time.Time) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.FieldGT(FieldCutoffAt, v))
+}
+
+// CutoffAtGTE applies the GTE predicate on the "cutoff_at" field.
+func CutoffAtGTE(v time.Time) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.FieldGTE(FieldCutoffAt, v))
+}
+
+// CutoffAtLT applies the LT predicate on the "cutoff_at" field.
+func CutoffAtLT(v time.Time) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.FieldLT(FieldCutoffAt, v))
+}
+
+// CutoffAtLTE applies the LTE predicate on the "cutoff_at" field.
+func CutoffAtLTE(v time.Time) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.FieldLTE(FieldCutoffAt, v))
+}
+
+// PolicyEQ applies the EQ predicate on the "policy" field.
+func PolicyEQ(v string) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.FieldEQ(FieldPolicy, v))
+}
+
+// PolicyNEQ applies the NEQ predicate on the "policy" field.
+func PolicyNEQ(v string) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.FieldNEQ(FieldPolicy, v))
+}
+
+// PolicyIn applies the In predicate on the "policy" field.
+func PolicyIn(vs ...string) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.FieldIn(FieldPolicy, vs...))
+}
+
+// PolicyNotIn applies the NotIn predicate on the "policy" field.
+func PolicyNotIn(vs ...string) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.FieldNotIn(FieldPolicy, vs...))
+}
+
+// PolicyGT applies the GT predicate on the "policy" field.
+func PolicyGT(v string) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.FieldGT(FieldPolicy, v))
+}
+
+// PolicyGTE applies the GTE predicate on the "policy" field.
+func PolicyGTE(v string) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.FieldGTE(FieldPolicy, v))
+}
+
+// PolicyLT applies the LT predicate on the "policy" field.
+func PolicyLT(v string) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.FieldLT(FieldPolicy, v))
+}
+
+// PolicyLTE applies the LTE predicate on the "policy" field.
+func PolicyLTE(v string) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.FieldLTE(FieldPolicy, v))
+}
+
+// PolicyContains applies the Contains predicate on the "policy" field.
+func PolicyContains(v string) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.FieldContains(FieldPolicy, v))
+}
+
+// PolicyHasPrefix applies the HasPrefix predicate on the "policy" field.
+func PolicyHasPrefix(v string) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.FieldHasPrefix(FieldPolicy, v))
+}
+
+// PolicyHasSuffix applies the HasSuffix predicate on the "policy" field.
+func PolicyHasSuffix(v string) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.FieldHasSuffix(FieldPolicy, v))
+}
+
+// PolicyEqualFold applies the EqualFold predicate on the "policy" field.
+func PolicyEqualFold(v string) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.FieldEqualFold(FieldPolicy, v))
+}
+
+// PolicyContainsFold applies the ContainsFold predicate on the "policy" field.
+func PolicyContainsFold(v string) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.FieldContainsFold(FieldPolicy, v))
+}
+
+// KeyIDEQ applies the EQ predicate on the "key_id" field.
+func KeyIDEQ(v string) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.FieldEQ(FieldKeyID, v))
+}
+
+// KeyIDNEQ applies the NEQ predicate on the "key_id" field.
+func KeyIDNEQ(v string) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.FieldNEQ(FieldKeyID, v))
+}
+
+// KeyIDIn applies the In predicate on the "key_id" field.
+func KeyIDIn(vs ...string) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.FieldIn(FieldKeyID, vs...))
+}
+
+// KeyIDNotIn applies the NotIn predicate on the "key_id" field.
+func KeyIDNotIn(vs ...string) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.FieldNotIn(FieldKeyID, vs...))
+}
+
+// KeyIDGT applies the GT predicate on the "key_id" field.
+func KeyIDGT(v string) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.FieldGT(FieldKeyID, v))
+}
+
+// KeyIDGTE applies the GTE predicate on the "key_id" field.
+func KeyIDGTE(v string) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.FieldGTE(FieldKeyID, v))
+}
+
+// KeyIDLT applies the LT predicate on the "key_id" field.
+func KeyIDLT(v string) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.FieldLT(FieldKeyID, v))
+}
+
+// KeyIDLTE applies the LTE predicate on the "key_id" field.
+func KeyIDLTE(v string) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.FieldLTE(FieldKeyID, v))
+}
+
+// KeyIDContains applies the Contains predicate on the "key_id" field.
+func KeyIDContains(v string) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.FieldContains(FieldKeyID, v))
+}
+
+// KeyIDHasPrefix applies the HasPrefix predicate on the "key_id" field.
+func KeyIDHasPrefix(v string) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.FieldHasPrefix(FieldKeyID, v))
+}
+
+// KeyIDHasSuffix applies the HasSuffix predicate on the "key_id" field.
+func KeyIDHasSuffix(v string) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.FieldHasSuffix(FieldKeyID, v))
+}
+
+// KeyIDEqualFold applies the EqualFold predicate on the "key_id" field.
+func KeyIDEqualFold(v string) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.FieldEqualFold(FieldKeyID, v))
+}
+
+// KeyIDContainsFold applies the ContainsFold predicate on the "key_id" field.
+func KeyIDContainsFold(v string) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.FieldContainsFold(FieldKeyID, v))
+}
+
+// SignatureEQ applies the EQ predicate on the "signature" field.
+func SignatureEQ(v string) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.FieldEQ(FieldSignature, v))
+}
+
+// SignatureNEQ applies the NEQ predicate on the "signature" field.
+func SignatureNEQ(v string) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.FieldNEQ(FieldSignature, v))
+}
+
+// SignatureIn applies the In predicate on the "signature" field.
+func SignatureIn(vs ...string) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.FieldIn(FieldSignature, vs...))
+}
+
+// SignatureNotIn applies the NotIn predicate on the "signature" field.
+func SignatureNotIn(vs ...string) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.FieldNotIn(FieldSignature, vs...))
+}
+
+// SignatureGT applies the GT predicate on the "signature" field.
+func SignatureGT(v string) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.FieldGT(FieldSignature, v))
+}
+
+// SignatureGTE applies the GTE predicate on the "signature" field.
+func SignatureGTE(v string) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.FieldGTE(FieldSignature, v))
+}
+
+// SignatureLT applies the LT predicate on the "signature" field.
+func SignatureLT(v string) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.FieldLT(FieldSignature, v))
+}
+
+// SignatureLTE applies the LTE predicate on the "signature" field.
+func SignatureLTE(v string) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.FieldLTE(FieldSignature, v))
+}
+
+// SignatureContains applies the Contains predicate on the "signature" field.
+func SignatureContains(v string) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.FieldContains(FieldSignature, v))
+}
+
+// SignatureHasPrefix applies the HasPrefix predicate on the "signature" field.
+func SignatureHasPrefix(v string) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.FieldHasPrefix(FieldSignature, v))
+}
+
+// SignatureHasSuffix applies the HasSuffix predicate on the "signature" field.
+func SignatureHasSuffix(v string) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.FieldHasSuffix(FieldSignature, v))
+}
+
+// SignatureEqualFold applies the EqualFold predicate on the "signature" field.
+func SignatureEqualFold(v string) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.FieldEqualFold(FieldSignature, v))
+}
+
+// SignatureContainsFold applies the ContainsFold predicate on the "signature" field.
+func SignatureContainsFold(v string) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.FieldContainsFold(FieldSignature, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.AuditLogPruneRecord) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.AuditLogPruneRecord) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.AuditLogPruneRecord) predicate.AuditLogPruneRecord {
+	return predicate.AuditLogPruneRecord(sql.NotPredicates(p))
+}
@@ -0,0 +1,368 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/coder-lulu/newbee-core/rpc/ent/auditlogprunerecord"
+	uuid "github.com/gofrs/uuid/v5"
+)
+
+// AuditLogPruneRecordCreate is the builder for creating a AuditLogPruneRecord entity.
+type AuditLogPruneRecordCreate struct {
+	config
+	mutation *AuditLogPruneRecordMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *AuditLogPruneRecordCreate) SetCreatedAt(v time.Time) *AuditLogPruneRecordCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *AuditLogPruneRecordCreate) SetNillableCreatedAt(v *time.Time) *AuditLogPruneRecordCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *AuditLogPruneRecordCreate) SetUpdatedAt(v time.Time) *AuditLogPruneRecordCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *AuditLogPruneRecordCreate) SetNillableUpdatedAt(v *time.Time) *AuditLogPruneRecordCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *AuditLogPruneRecordCreate) SetTenantID(v string) *AuditLogPruneRecordCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetFromSeq sets the "from_seq" field.
+func (_c *AuditLogPruneRecordCreate) SetFromSeq(v uint64) *AuditLogPruneRecordCreate {
+	_c.mutation.SetFromSeq(v)
+	return _c
+}
+
+// SetToSeq sets the "to_seq" field.
+func (_c *AuditLogPruneRecordCreate) SetToSeq(v uint64) *AuditLogPruneRecordCreate {
+	_c.mutation.SetToSeq(v)
+	return _c
+}
+
+// SetCount sets the "count" field.
+func (_c *AuditLogPruneRecordCreate) SetCount(v int) *AuditLogPruneRecordCreate {
+	_c.mutation.SetCount(v)
+	return _c
+}
+
+// SetLastHash sets the "last_hash" field.
+func (_c *AuditLogPruneRecordCreate) SetLastHash(v string) *AuditLogPruneRecordCreate {
+	_c.mutation.SetLastHash(v)
+	return _c
+}
+
+// SetCutoffAt sets the "cutoff_at" field.
+func (_c *AuditLogPruneRecordCreate) SetCutoffAt(v time.Time) *AuditLogPruneRecordCreate {
+	_c.mutation.SetCutoffAt(v)
+	return _c
+}
+
+// SetPolicy sets the "policy" field.
+func (_c *AuditLogPruneRecordCreate) SetPolicy(v string) *AuditLogPruneRecordCreate {
+	_c.mutation.SetPolicy(v)
+	return _c
+}
+
+// SetKeyID sets the "key_id" field.
+func (_c *AuditLogPruneRecordCreate) SetKeyID(v string) *AuditLogPruneRecordCreate {
+	_c.mutation.SetKeyID(v)
+	return _c
+}
+
+// SetSignature sets the "signature" field.
+func (_c *AuditLogPruneRecordCreate) SetSignature(v string) *AuditLogPruneRecordCreate {
+	_c.mutation.SetSignature(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *AuditLogPruneRecordCreate) SetID(v uuid.UUID) *AuditLogPruneRecordCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *AuditLogPruneRecordCreate) SetNillableID(v *uuid.UUID) *AuditLogPruneRecordCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the AuditLogPruneRecordMutation object of the builder.
+func (_c *AuditLogPruneRecordCreate) Mutation() *AuditLogPruneRecordMutation {
+	return _c.mutation
+}
+
+// Save creates the AuditLogPruneRecord in the database.
+func (_c *AuditLogPruneRecordCreate) Save(ctx context.Context) (*AuditLogPruneRecord, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *AuditLogPruneRecordCreate) SaveX(ctx context.Context) *AuditLogPruneRecord {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AuditLogPruneRecordCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AuditLogPruneRecordCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *AuditLogPruneRecordCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := auditlogprunerecord.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := auditlogprunerecord.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := auditlogprunerecord.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *AuditLogPruneRecordCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "AuditLogPruneRecord.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "AuditLogPruneRecord.updated_at"`)}
+	}
+	if _, ok := _c.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "AuditLogPruneRecord.tenant_id"`)}
+	}
+	if _, ok := _c.mutation.FromSeq(); !ok {
+		return &ValidationError{Name: "from_seq", err: errors.New(`ent: missing required field "AuditLogPruneRecord.from_seq"`)}
+	}
+	if _, ok := _c.mutation.ToSeq(); !ok {
+		return &ValidationError{Name: "to_seq", err: errors.New(`ent: missing required field "AuditLogPruneRecord.to_seq"`)}
+	}
+	if _, ok := _c.mutation.Count(); !ok {
+		return &ValidationError{Name: "count", err: errors.New(`ent: missing required field "AuditLogPruneRecord.count"`)}
+	}
+	if _, ok := _c.mutation.LastHash(); !ok {
+		return &ValidationError{Name: "last_hash", err: errors.New(`ent: missing required field "AuditLogPruneRecord.last_hash"`)}
+	}
+	if _, ok := _c.mutation.CutoffAt(); !ok {
+		return &ValidationError{Name: "cutoff_at", err: errors.New(`ent: missing required field "AuditLogPruneRecord.cutoff_at"`)}
+	}
+	if _, ok := _c.mutation.Policy(); !ok {
+		return &ValidationError{Name: "policy", err: errors.New(`ent: missing required field "AuditLogPruneRecord.policy"`)}
+	}
+	if _, ok := _c.mutation.KeyID(); !ok {
+		return &ValidationError{Name: "key_id", err: errors.New(`ent: missing required field "AuditLogPruneRecord.key_id"`)}
+	}
+	if _, ok := _c.mutation.Signature(); !ok {
+		return &ValidationError{Name: "signature", err: errors.New(`ent: missing required field "AuditLogPruneRecord.signature"`)}
+	}
+	return nil
+}
+
+func (_c *AuditLogPruneRecordCreate) sqlSave(ctx context.Context) (*AuditLogPruneRecord, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *AuditLogPruneRecordCreate) createSpec() (*AuditLogPruneRecord, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AuditLogPruneRecord{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(auditlogprunerecord.Table, sqlgraph.NewFieldSpec(auditlogprunerecord.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(auditlogprunerecord.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(auditlogprunerecord.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(auditlogprunerecord.FieldTenantID, field.TypeString, value)
+		_node.TenantID = value
+	}
+	if value, ok := _c.mutation.FromSeq(); ok {
+		_spec.SetField(auditlogprunerecord.FieldFromSeq, field.TypeUint64, value)
+		_node.FromSeq = value
+	}
+	if value, ok := _c.mutation.ToSeq(); ok {
+		_spec.SetField(auditlogprunerecord.FieldToSeq, field.TypeUint64, value)
+		_node.ToSeq = value
+	}
+	if value, ok := _c.mutation.Count(); ok {
+		_spec.SetField(auditlogprunerecord.FieldCount, field.TypeInt, value)
+		_node.Count = value
+	}
+	if value, ok := _c.mutation.LastHash(); ok {
+		_spec.SetField(auditlogprunerecord.FieldLastHash, field.TypeString, value)
+		_node.LastHash = value
+	}
+	if value, ok := _c.mutation.CutoffAt(); ok {
+		_spec.SetField(auditlogprunerecord.FieldCutoffAt, field.TypeTime, value)
+		_node.CutoffAt = value
+	}
+	if value, ok := _c.mutation.Policy(); ok {
+		_spec.SetField(auditlogprunerecord.FieldPolicy, field.TypeString, value)
+		_node.Policy = value
+	}
+	if value, ok := _c.mutation.KeyID(); ok {
+		_spec.SetField(auditlogprunerecord.FieldKeyID, field.TypeString, value)
+		_node.KeyID = value
+	}
+	if value, ok := _c.mutation.Signature(); ok {
+		_spec.SetField(auditlogprunerecord.FieldSignature, field.TypeString, value)
+		_node.Signature = value
+	}
+	return _node, _spec
+}
+
+// AuditLogPruneRecordCreateBulk is the builder for creating many AuditLogPruneRecord entities in bulk.
+type AuditLogPruneRecordCreateBulk struct {
+	config
+	err      error
+	builders []*AuditLogPruneRecordCreate
+}
+
+// Save creates the AuditLogPruneRecord entities in the database.
+func (_c *AuditLogPruneRecordCreateBulk) Save(ctx context.Context) ([]*AuditLogPruneRecord, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*AuditLogPruneRecord, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AuditLogPruneRecordMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *AuditLogPruneRecordCreateBulk) SaveX(ctx context.Context) []*AuditLogPruneRecord {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AuditLogPruneRecordCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AuditLogPruneRecordCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
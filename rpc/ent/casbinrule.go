@@ -29,16 +29,18 @@ type CasbinRule struct {
 	Ptype string `json:"ptype,omitempty"`
 	// 主体: 用户ID、角色代码等
 	V0 string `json:"v0,omitempty"`
-	// 资源: 资源路径、API端点等
+	// 域: 租户ID；g 规则为角色代码
 	V1 string `json:"v1,omitempty"`
-	// 操作: read, write, delete, create等
+	// 资源: 资源路径、API端点等；g 规则为租户ID
 	V2 string `json:"v2,omitempty"`
-	// 效果: allow, deny
+	// 操作: HTTP方法、read、write等
 	V3 string `json:"v3,omitempty"`
-	// 条件表达式: JSON格式的复杂条件
+	// 效果: allow, deny
 	V4 string `json:"v4,omitempty"`
 	// 优先级: 数值越大优先级越高
 	V5 string `json:"v5,omitempty"`
+	// ABAC条件: JSON格式，仅用于 p 规则，为空表示无条件
+	Conditions string `json:"conditions,omitempty"`
 	// 服务名称: core, cmdb, workflow等
 	ServiceName string `json:"service_name,omitempty"`
 	// 规则名称: 便于管理和识别
@@ -83,7 +85,7 @@ func (*CasbinRule) scanValues(columns []string) ([]any, error) {
 			values[i] = new(sql.NullBool)
 		case casbinrule.FieldID, casbinrule.FieldStatus, casbinrule.FieldTenantID, casbinrule.FieldApprovedBy, casbinrule.FieldUsageCount:
 			values[i] = new(sql.NullInt64)
-		case casbinrule.FieldPtype, casbinrule.FieldV0, casbinrule.FieldV1, casbinrule.FieldV2, casbinrule.FieldV3, casbinrule.FieldV4, casbinrule.FieldV5, casbinrule.FieldServiceName, casbinrule.FieldRuleName, casbinrule.FieldDescription, casbinrule.FieldCategory, casbinrule.FieldVersion, casbinrule.FieldApprovalStatus, casbinrule.FieldMetadata, casbinrule.FieldTags:
+		case casbinrule.FieldPtype, casbinrule.FieldV0, casbinrule.FieldV1, casbinrule.FieldV2, casbinrule.FieldV3, casbinrule.FieldV4, casbinrule.FieldV5, casbinrule.FieldConditions, casbinrule.FieldServiceName, casbinrule.FieldRuleName, casbinrule.FieldDescription, casbinrule.FieldCategory, casbinrule.FieldVersion, casbinrule.FieldApprovalStatus, casbinrule.FieldMetadata, casbinrule.FieldTags:
 			values[i] = new(sql.NullString)
 		case casbinrule.FieldCreatedAt, casbinrule.FieldUpdatedAt, casbinrule.FieldApprovedAt, casbinrule.FieldEffectiveFrom, casbinrule.FieldEffectiveTo, casbinrule.FieldLastUsedAt:
 			values[i] = new(sql.NullTime)
@@ -174,6 +176,12 @@ func (_m *CasbinRule) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.V5 = value.String
 			}
+		case casbinrule.FieldConditions:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field conditions", values[i])
+			} else if value.Valid {
+				_m.Conditions = value.String
+			}
 		case casbinrule.FieldServiceName:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field service_name", values[i])
@@ -339,6 +347,9 @@ func (_m *CasbinRule) String() string {
 	builder.WriteString("v5=")
 	builder.WriteString(_m.V5)
 	builder.WriteString(", ")
+	builder.WriteString("conditions=")
+	builder.WriteString(_m.Conditions)
+	builder.WriteString(", ")
 	builder.WriteString("service_name=")
 	builder.WriteString(_m.ServiceName)
 	builder.WriteString(", ")
@@ -36,6 +36,8 @@ const (
 	FieldV4 = "v4"
 	// FieldV5 holds the string denoting the v5 field in the database.
 	FieldV5 = "v5"
+	// FieldConditions holds the string denoting the conditions field in the database.
+	FieldConditions = "conditions"
 	// FieldServiceName holds the string denoting the service_name field in the database.
 	FieldServiceName = "service_name"
 	// FieldRuleName holds the string denoting the rule_name field in the database.
@@ -86,6 +88,7 @@ var Columns = []string{
 	FieldV3,
 	FieldV4,
 	FieldV5,
+	FieldConditions,
 	FieldServiceName,
 	FieldRuleName,
 	FieldDescription,
@@ -227,6 +230,11 @@ func ByV5(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldV5, opts...).ToFunc()
 }
 
+// ByConditions orders the results by the conditions field.
+func ByConditions(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldConditions, opts...).ToFunc()
+}
+
 // ByServiceName orders the results by the service_name field.
 func ByServiceName(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldServiceName, opts...).ToFunc()
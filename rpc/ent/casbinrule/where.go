@@ -109,6 +109,11 @@ func V5(v string) predicate.CasbinRule {
 	return predicate.CasbinRule(sql.FieldEQ(FieldV5, v))
 }
 
+// Conditions applies equality check predicate on the "conditions" field. It's identical to ConditionsEQ.
+func Conditions(v string) predicate.CasbinRule {
+	return predicate.CasbinRule(sql.FieldEQ(FieldConditions, v))
+}
+
 // ServiceName applies equality check predicate on the "service_name" field. It's identical to ServiceNameEQ.
 func ServiceName(v string) predicate.CasbinRule {
 	return predicate.CasbinRule(sql.FieldEQ(FieldServiceName, v))
@@ -869,6 +874,81 @@ func V5ContainsFold(v string) predicate.CasbinRule {
 	return predicate.CasbinRule(sql.FieldContainsFold(FieldV5, v))
 }
 
+// ConditionsEQ applies the EQ predicate on the "conditions" field.
+func ConditionsEQ(v string) predicate.CasbinRule {
+	return predicate.CasbinRule(sql.FieldEQ(FieldConditions, v))
+}
+
+// ConditionsNEQ applies the NEQ predicate on the "conditions" field.
+func ConditionsNEQ(v string) predicate.CasbinRule {
+	return predicate.CasbinRule(sql.FieldNEQ(FieldConditions, v))
+}
+
+// ConditionsIn applies the In predicate on the "conditions" field.
+func ConditionsIn(vs ...string) predicate.CasbinRule {
+	return predicate.CasbinRule(sql.FieldIn(FieldConditions, vs...))
+}
+
+// ConditionsNotIn applies the NotIn predicate on the "conditions" field.
+func ConditionsNotIn(vs ...string) predicate.CasbinRule {
+	return predicate.CasbinRule(sql.FieldNotIn(FieldConditions, vs...))
+}
+
+// ConditionsGT applies the GT predicate on the "conditions" field.
+func ConditionsGT(v string) predicate.CasbinRule {
+	return predicate.CasbinRule(sql.FieldGT(FieldConditions, v))
+}
+
+// ConditionsGTE applies the GTE predicate on the "conditions" field.
+func ConditionsGTE(v string) predicate.CasbinRule {
+	return predicate.CasbinRule(sql.FieldGTE(FieldConditions, v))
+}
+
+// ConditionsLT applies the LT predicate on the "conditions" field.
+func ConditionsLT(v string) predicate.CasbinRule {
+	return predicate.CasbinRule(sql.FieldLT(FieldConditions, v))
+}
+
+// ConditionsLTE applies the LTE predicate on the "conditions" field.
+func ConditionsLTE(v string) predicate.CasbinRule {
+	return predicate.CasbinRule(sql.FieldLTE(FieldConditions, v))
+}
+
+// ConditionsContains applies the Contains predicate on the "conditions" field.
+func ConditionsContains(v string) predicate.CasbinRule {
+	return predicate.CasbinRule(sql.FieldContains(FieldConditions, v))
+}
+
+// ConditionsHasPrefix applies the HasPrefix predicate on the "conditions" field.
+func ConditionsHasPrefix(v string) predicate.CasbinRule {
+	return predicate.CasbinRule(sql.FieldHasPrefix(FieldConditions, v))
+}
+
+// ConditionsHasSuffix applies the HasSuffix predicate on the "conditions" field.
+func ConditionsHasSuffix(v string) predicate.CasbinRule {
+	return predicate.CasbinRule(sql.FieldHasSuffix(FieldConditions, v))
+}
+
+// ConditionsIsNil applies the IsNil predicate on the "conditions" field.
+func ConditionsIsNil() predicate.CasbinRule {
+	return predicate.CasbinRule(sql.FieldIsNull(FieldConditions))
+}
+
+// ConditionsNotNil applies the NotNil predicate on the "conditions" field.
+func ConditionsNotNil() predicate.CasbinRule {
+	return predicate.CasbinRule(sql.FieldNotNull(FieldConditions))
+}
+
+// ConditionsEqualFold applies the EqualFold predicate on the "conditions" field.
+func ConditionsEqualFold(v string) predicate.CasbinRule {
+	return predicate.CasbinRule(sql.FieldEqualFold(FieldConditions, v))
+}
+
+// ConditionsContainsFold applies the ContainsFold predicate on the "conditions" field.
+func ConditionsContainsFold(v string) predicate.CasbinRule {
+	return predicate.CasbinRule(sql.FieldContainsFold(FieldConditions, v))
+}
+
 // ServiceNameEQ applies the EQ predicate on the "service_name" field.
 func ServiceNameEQ(v string) predicate.CasbinRule {
 	return predicate.CasbinRule(sql.FieldEQ(FieldServiceName, v))
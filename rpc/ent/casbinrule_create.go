@@ -166,6 +166,20 @@ func (_c *CasbinRuleCreate) SetNillableV5(v *string) *CasbinRuleCreate {
 	return _c
 }
 
+// SetConditions sets the "conditions" field.
+func (_c *CasbinRuleCreate) SetConditions(v string) *CasbinRuleCreate {
+	_c.mutation.SetConditions(v)
+	return _c
+}
+
+// SetNillableConditions sets the "conditions" field if the given value is not nil.
+func (_c *CasbinRuleCreate) SetNillableConditions(v *string) *CasbinRuleCreate {
+	if v != nil {
+		_c.SetConditions(*v)
+	}
+	return _c
+}
+
 // SetServiceName sets the "service_name" field.
 func (_c *CasbinRuleCreate) SetServiceName(v string) *CasbinRuleCreate {
 	_c.mutation.SetServiceName(v)
@@ -581,6 +595,10 @@ func (_c *CasbinRuleCreate) createSpec() (*CasbinRule, *sqlgraph.CreateSpec) {
 		_spec.SetField(casbinrule.FieldV5, field.TypeString, value)
 		_node.V5 = value
 	}
+	if value, ok := _c.mutation.Conditions(); ok {
+		_spec.SetField(casbinrule.FieldConditions, field.TypeString, value)
+		_node.Conditions = value
+	}
 	if value, ok := _c.mutation.ServiceName(); ok {
 		_spec.SetField(casbinrule.FieldServiceName, field.TypeString, value)
 		_node.ServiceName = value
@@ -196,6 +196,26 @@ func (_u *CasbinRuleUpdate) ClearV5() *CasbinRuleUpdate {
 	return _u
 }
 
+// SetConditions sets the "conditions" field.
+func (_u *CasbinRuleUpdate) SetConditions(v string) *CasbinRuleUpdate {
+	_u.mutation.SetConditions(v)
+	return _u
+}
+
+// SetNillableConditions sets the "conditions" field if the given value is not nil.
+func (_u *CasbinRuleUpdate) SetNillableConditions(v *string) *CasbinRuleUpdate {
+	if v != nil {
+		_u.SetConditions(*v)
+	}
+	return _u
+}
+
+// ClearConditions clears the value of the "conditions" field.
+func (_u *CasbinRuleUpdate) ClearConditions() *CasbinRuleUpdate {
+	_u.mutation.ClearConditions()
+	return _u
+}
+
 // SetServiceName sets the "service_name" field.
 func (_u *CasbinRuleUpdate) SetServiceName(v string) *CasbinRuleUpdate {
 	_u.mutation.SetServiceName(v)
@@ -608,6 +628,12 @@ func (_u *CasbinRuleUpdate) sqlSave(ctx context.Context) (_node int, err error)
 	if _u.mutation.V5Cleared() {
 		_spec.ClearField(casbinrule.FieldV5, field.TypeString)
 	}
+	if value, ok := _u.mutation.Conditions(); ok {
+		_spec.SetField(casbinrule.FieldConditions, field.TypeString, value)
+	}
+	if _u.mutation.ConditionsCleared() {
+		_spec.ClearField(casbinrule.FieldConditions, field.TypeString)
+	}
 	if value, ok := _u.mutation.ServiceName(); ok {
 		_spec.SetField(casbinrule.FieldServiceName, field.TypeString, value)
 	}
@@ -878,6 +904,26 @@ func (_u *CasbinRuleUpdateOne) ClearV5() *CasbinRuleUpdateOne {
 	return _u
 }
 
+// SetConditions sets the "conditions" field.
+func (_u *CasbinRuleUpdateOne) SetConditions(v string) *CasbinRuleUpdateOne {
+	_u.mutation.SetConditions(v)
+	return _u
+}
+
+// SetNillableConditions sets the "conditions" field if the given value is not nil.
+func (_u *CasbinRuleUpdateOne) SetNillableConditions(v *string) *CasbinRuleUpdateOne {
+	if v != nil {
+		_u.SetConditions(*v)
+	}
+	return _u
+}
+
+// ClearConditions clears the value of the "conditions" field.
+func (_u *CasbinRuleUpdateOne) ClearConditions() *CasbinRuleUpdateOne {
+	_u.mutation.ClearConditions()
+	return _u
+}
+
 // SetServiceName sets the "service_name" field.
 func (_u *CasbinRuleUpdateOne) SetServiceName(v string) *CasbinRuleUpdateOne {
 	_u.mutation.SetServiceName(v)
@@ -1320,6 +1366,12 @@ func (_u *CasbinRuleUpdateOne) sqlSave(ctx context.Context) (_node *CasbinRule,
 	if _u.mutation.V5Cleared() {
 		_spec.ClearField(casbinrule.FieldV5, field.TypeString)
 	}
+	if value, ok := _u.mutation.Conditions(); ok {
+		_spec.SetField(casbinrule.FieldConditions, field.TypeString, value)
+	}
+	if _u.mutation.ConditionsCleared() {
+		_spec.ClearField(casbinrule.FieldConditions, field.TypeString)
+	}
 	if value, ok := _u.mutation.ServiceName(); ok {
 		_spec.SetField(casbinrule.FieldServiceName, field.TypeString, value)
 	}
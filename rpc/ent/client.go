@@ -18,18 +18,37 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/coder-lulu/newbee-core/rpc/ent/api"
 	"github.com/coder-lulu/newbee-core/rpc/ent/auditlog"
+	"github.com/coder-lulu/newbee-core/rpc/ent/auditlogarchive"
+	"github.com/coder-lulu/newbee-core/rpc/ent/auditlogchain"
+	"github.com/coder-lulu/newbee-core/rpc/ent/auditlogcheckpoint"
+	"github.com/coder-lulu/newbee-core/rpc/ent/auditlogprunerecord"
 	"github.com/coder-lulu/newbee-core/rpc/ent/casbinrule"
 	"github.com/coder-lulu/newbee-core/rpc/ent/configuration"
 	"github.com/coder-lulu/newbee-core/rpc/ent/department"
 	"github.com/coder-lulu/newbee-core/rpc/ent/dictionary"
 	"github.com/coder-lulu/newbee-core/rpc/ent/dictionarydetail"
+	"github.com/coder-lulu/newbee-core/rpc/ent/impersonationsession"
+	"github.com/coder-lulu/newbee-core/rpc/ent/ldapaccount"
+	"github.com/coder-lulu/newbee-core/rpc/ent/ldapdepartment"
+	"github.com/coder-lulu/newbee-core/rpc/ent/ldapprovider"
+	"github.com/coder-lulu/newbee-core/rpc/ent/ldapsyncrun"
 	"github.com/coder-lulu/newbee-core/rpc/ent/menu"
 	"github.com/coder-lulu/newbee-core/rpc/ent/oauthaccount"
+	"github.com/coder-lulu/newbee-core/rpc/ent/oauthclient"
+	"github.com/coder-lulu/newbee-core/rpc/ent/oauthconsent"
 	"github.com/coder-lulu/newbee-core/rpc/ent/oauthprovider"
+	"github.com/coder-lulu/newbee-core/rpc/ent/oauthprovidertemplate"
+	"github.com/coder-lulu/newbee-core/rpc/ent/oauthscope"
 	"github.com/coder-lulu/newbee-core/rpc/ent/oauthsession"
 	"github.com/coder-lulu/newbee-core/rpc/ent/position"
 	"github.com/coder-lulu/newbee-core/rpc/ent/role"
+	"github.com/coder-lulu/newbee-core/rpc/ent/samlaccount"
+	"github.com/coder-lulu/newbee-core/rpc/ent/samlprovider"
+	"github.com/coder-lulu/newbee-core/rpc/ent/scimtoken"
 	"github.com/coder-lulu/newbee-core/rpc/ent/tenant"
+	"github.com/coder-lulu/newbee-core/rpc/ent/tenantinitjob"
+	"github.com/coder-lulu/newbee-core/rpc/ent/tenantinitplugin"
+	"github.com/coder-lulu/newbee-core/rpc/ent/tenantinittemplate"
 	"github.com/coder-lulu/newbee-core/rpc/ent/token"
 	"github.com/coder-lulu/newbee-core/rpc/ent/user"
 
@@ -45,6 +64,14 @@ type Client struct {
 	API *APIClient
 	// AuditLog is the client for interacting with the AuditLog builders.
 	AuditLog *AuditLogClient
+	// AuditLogArchive is the client for interacting with the AuditLogArchive builders.
+	AuditLogArchive *AuditLogArchiveClient
+	// AuditLogChain is the client for interacting with the AuditLogChain builders.
+	AuditLogChain *AuditLogChainClient
+	// AuditLogCheckpoint is the client for interacting with the AuditLogCheckpoint builders.
+	AuditLogCheckpoint *AuditLogCheckpointClient
+	// AuditLogPruneRecord is the client for interacting with the AuditLogPruneRecord builders.
+	AuditLogPruneRecord *AuditLogPruneRecordClient
 	// CasbinRule is the client for interacting with the CasbinRule builders.
 	CasbinRule *CasbinRuleClient
 	// Configuration is the client for interacting with the Configuration builders.
@@ -55,20 +82,50 @@ type Client struct {
 	Dictionary *DictionaryClient
 	// DictionaryDetail is the client for interacting with the DictionaryDetail builders.
 	DictionaryDetail *DictionaryDetailClient
+	// ImpersonationSession is the client for interacting with the ImpersonationSession builders.
+	ImpersonationSession *ImpersonationSessionClient
+	// LdapAccount is the client for interacting with the LdapAccount builders.
+	LdapAccount *LdapAccountClient
+	// LdapDepartment is the client for interacting with the LdapDepartment builders.
+	LdapDepartment *LdapDepartmentClient
+	// LdapProvider is the client for interacting with the LdapProvider builders.
+	LdapProvider *LdapProviderClient
+	// LdapSyncRun is the client for interacting with the LdapSyncRun builders.
+	LdapSyncRun *LdapSyncRunClient
 	// Menu is the client for interacting with the Menu builders.
 	Menu *MenuClient
 	// OauthAccount is the client for interacting with the OauthAccount builders.
 	OauthAccount *OauthAccountClient
+	// OauthClient is the client for interacting with the OauthClient builders.
+	OauthClient *OauthClientClient
+	// OauthConsent is the client for interacting with the OauthConsent builders.
+	OauthConsent *OauthConsentClient
 	// OauthProvider is the client for interacting with the OauthProvider builders.
 	OauthProvider *OauthProviderClient
+	// OauthProviderTemplate is the client for interacting with the OauthProviderTemplate builders.
+	OauthProviderTemplate *OauthProviderTemplateClient
+	// OauthScope is the client for interacting with the OauthScope builders.
+	OauthScope *OauthScopeClient
 	// OauthSession is the client for interacting with the OauthSession builders.
 	OauthSession *OauthSessionClient
 	// Position is the client for interacting with the Position builders.
 	Position *PositionClient
 	// Role is the client for interacting with the Role builders.
 	Role *RoleClient
+	// SamlAccount is the client for interacting with the SamlAccount builders.
+	SamlAccount *SamlAccountClient
+	// SamlProvider is the client for interacting with the SamlProvider builders.
+	SamlProvider *SamlProviderClient
+	// ScimToken is the client for interacting with the ScimToken builders.
+	ScimToken *ScimTokenClient
 	// Tenant is the client for interacting with the Tenant builders.
 	Tenant *TenantClient
+	// TenantInitJob is the client for interacting with the TenantInitJob builders.
+	TenantInitJob *TenantInitJobClient
+	// TenantInitPlugin is the client for interacting with the TenantInitPlugin builders.
+	TenantInitPlugin *TenantInitPluginClient
+	// TenantInitTemplate is the client for interacting with the TenantInitTemplate builders.
+	TenantInitTemplate *TenantInitTemplateClient
 	// Token is the client for interacting with the Token builders.
 	Token *TokenClient
 	// User is the client for interacting with the User builders.
@@ -86,18 +143,37 @@ func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
 	c.API = NewAPIClient(c.config)
 	c.AuditLog = NewAuditLogClient(c.config)
+	c.AuditLogArchive = NewAuditLogArchiveClient(c.config)
+	c.AuditLogChain = NewAuditLogChainClient(c.config)
+	c.AuditLogCheckpoint = NewAuditLogCheckpointClient(c.config)
+	c.AuditLogPruneRecord = NewAuditLogPruneRecordClient(c.config)
 	c.CasbinRule = NewCasbinRuleClient(c.config)
 	c.Configuration = NewConfigurationClient(c.config)
 	c.Department = NewDepartmentClient(c.config)
 	c.Dictionary = NewDictionaryClient(c.config)
 	c.DictionaryDetail = NewDictionaryDetailClient(c.config)
+	c.ImpersonationSession = NewImpersonationSessionClient(c.config)
+	c.LdapAccount = NewLdapAccountClient(c.config)
+	c.LdapDepartment = NewLdapDepartmentClient(c.config)
+	c.LdapProvider = NewLdapProviderClient(c.config)
+	c.LdapSyncRun = NewLdapSyncRunClient(c.config)
 	c.Menu = NewMenuClient(c.config)
 	c.OauthAccount = NewOauthAccountClient(c.config)
+	c.OauthClient = NewOauthClientClient(c.config)
+	c.OauthConsent = NewOauthConsentClient(c.config)
 	c.OauthProvider = NewOauthProviderClient(c.config)
+	c.OauthProviderTemplate = NewOauthProviderTemplateClient(c.config)
+	c.OauthScope = NewOauthScopeClient(c.config)
 	c.OauthSession = NewOauthSessionClient(c.config)
 	c.Position = NewPositionClient(c.config)
 	c.Role = NewRoleClient(c.config)
+	c.SamlAccount = NewSamlAccountClient(c.config)
+	c.SamlProvider = NewSamlProviderClient(c.config)
+	c.ScimToken = NewScimTokenClient(c.config)
 	c.Tenant = NewTenantClient(c.config)
+	c.TenantInitJob = NewTenantInitJobClient(c.config)
+	c.TenantInitPlugin = NewTenantInitPluginClient(c.config)
+	c.TenantInitTemplate = NewTenantInitTemplateClient(c.config)
 	c.Token = NewTokenClient(c.config)
 	c.User = NewUserClient(c.config)
 }
@@ -190,24 +266,43 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:              ctx,
-		config:           cfg,
-		API:              NewAPIClient(cfg),
-		AuditLog:         NewAuditLogClient(cfg),
-		CasbinRule:       NewCasbinRuleClient(cfg),
-		Configuration:    NewConfigurationClient(cfg),
-		Department:       NewDepartmentClient(cfg),
-		Dictionary:       NewDictionaryClient(cfg),
-		DictionaryDetail: NewDictionaryDetailClient(cfg),
-		Menu:             NewMenuClient(cfg),
-		OauthAccount:     NewOauthAccountClient(cfg),
-		OauthProvider:    NewOauthProviderClient(cfg),
-		OauthSession:     NewOauthSessionClient(cfg),
-		Position:         NewPositionClient(cfg),
-		Role:             NewRoleClient(cfg),
-		Tenant:           NewTenantClient(cfg),
-		Token:            NewTokenClient(cfg),
-		User:             NewUserClient(cfg),
+		ctx:                   ctx,
+		config:                cfg,
+		API:                   NewAPIClient(cfg),
+		AuditLog:              NewAuditLogClient(cfg),
+		AuditLogArchive:       NewAuditLogArchiveClient(cfg),
+		AuditLogChain:         NewAuditLogChainClient(cfg),
+		AuditLogCheckpoint:    NewAuditLogCheckpointClient(cfg),
+		AuditLogPruneRecord:   NewAuditLogPruneRecordClient(cfg),
+		CasbinRule:            NewCasbinRuleClient(cfg),
+		Configuration:         NewConfigurationClient(cfg),
+		Department:            NewDepartmentClient(cfg),
+		Dictionary:            NewDictionaryClient(cfg),
+		DictionaryDetail:      NewDictionaryDetailClient(cfg),
+		ImpersonationSession:  NewImpersonationSessionClient(cfg),
+		LdapAccount:           NewLdapAccountClient(cfg),
+		LdapDepartment:        NewLdapDepartmentClient(cfg),
+		LdapProvider:          NewLdapProviderClient(cfg),
+		LdapSyncRun:           NewLdapSyncRunClient(cfg),
+		Menu:                  NewMenuClient(cfg),
+		OauthAccount:          NewOauthAccountClient(cfg),
+		OauthClient:           NewOauthClientClient(cfg),
+		OauthConsent:          NewOauthConsentClient(cfg),
+		OauthProvider:         NewOauthProviderClient(cfg),
+		OauthProviderTemplate: NewOauthProviderTemplateClient(cfg),
+		OauthScope:            NewOauthScopeClient(cfg),
+		OauthSession:          NewOauthSessionClient(cfg),
+		Position:              NewPositionClient(cfg),
+		Role:                  NewRoleClient(cfg),
+		SamlAccount:           NewSamlAccountClient(cfg),
+		SamlProvider:          NewSamlProviderClient(cfg),
+		ScimToken:             NewScimTokenClient(cfg),
+		Tenant:                NewTenantClient(cfg),
+		TenantInitJob:         NewTenantInitJobClient(cfg),
+		TenantInitPlugin:      NewTenantInitPluginClient(cfg),
+		TenantInitTemplate:    NewTenantInitTemplateClient(cfg),
+		Token:                 NewTokenClient(cfg),
+		User:                  NewUserClient(cfg),
 	}, nil
 }
 
@@ -225,24 +320,43 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:              ctx,
-		config:           cfg,
-		API:              NewAPIClient(cfg),
-		AuditLog:         NewAuditLogClient(cfg),
-		CasbinRule:       NewCasbinRuleClient(cfg),
-		Configuration:    NewConfigurationClient(cfg),
-		Department:       NewDepartmentClient(cfg),
-		Dictionary:       NewDictionaryClient(cfg),
-		DictionaryDetail: NewDictionaryDetailClient(cfg),
-		Menu:             NewMenuClient(cfg),
-		OauthAccount:     NewOauthAccountClient(cfg),
-		OauthProvider:    NewOauthProviderClient(cfg),
-		OauthSession:     NewOauthSessionClient(cfg),
-		Position:         NewPositionClient(cfg),
-		Role:             NewRoleClient(cfg),
-		Tenant:           NewTenantClient(cfg),
-		Token:            NewTokenClient(cfg),
-		User:             NewUserClient(cfg),
+		ctx:                   ctx,
+		config:                cfg,
+		API:                   NewAPIClient(cfg),
+		AuditLog:              NewAuditLogClient(cfg),
+		AuditLogArchive:       NewAuditLogArchiveClient(cfg),
+		AuditLogChain:         NewAuditLogChainClient(cfg),
+		AuditLogCheckpoint:    NewAuditLogCheckpointClient(cfg),
+		AuditLogPruneRecord:   NewAuditLogPruneRecordClient(cfg),
+		CasbinRule:            NewCasbinRuleClient(cfg),
+		Configuration:         NewConfigurationClient(cfg),
+		Department:            NewDepartmentClient(cfg),
+		Dictionary:            NewDictionaryClient(cfg),
+		DictionaryDetail:      NewDictionaryDetailClient(cfg),
+		ImpersonationSession:  NewImpersonationSessionClient(cfg),
+		LdapAccount:           NewLdapAccountClient(cfg),
+		LdapDepartment:        NewLdapDepartmentClient(cfg),
+		LdapProvider:          NewLdapProviderClient(cfg),
+		LdapSyncRun:           NewLdapSyncRunClient(cfg),
+		Menu:                  NewMenuClient(cfg),
+		OauthAccount:          NewOauthAccountClient(cfg),
+		OauthClient:           NewOauthClientClient(cfg),
+		OauthConsent:          NewOauthConsentClient(cfg),
+		OauthProvider:         NewOauthProviderClient(cfg),
+		OauthProviderTemplate: NewOauthProviderTemplateClient(cfg),
+		OauthScope:            NewOauthScopeClient(cfg),
+		OauthSession:          NewOauthSessionClient(cfg),
+		Position:              NewPositionClient(cfg),
+		Role:                  NewRoleClient(cfg),
+		SamlAccount:           NewSamlAccountClient(cfg),
+		SamlProvider:          NewSamlProviderClient(cfg),
+		ScimToken:             NewScimTokenClient(cfg),
+		Tenant:                NewTenantClient(cfg),
+		TenantInitJob:         NewTenantInitJobClient(cfg),
+		TenantInitPlugin:      NewTenantInitPluginClient(cfg),
+		TenantInitTemplate:    NewTenantInitTemplateClient(cfg),
+		Token:                 NewTokenClient(cfg),
+		User:                  NewUserClient(cfg),
 	}, nil
 }
 
@@ -272,9 +386,14 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
-		c.API, c.AuditLog, c.CasbinRule, c.Configuration, c.Department, c.Dictionary,
-		c.DictionaryDetail, c.Menu, c.OauthAccount, c.OauthProvider, c.OauthSession,
-		c.Position, c.Role, c.Tenant, c.Token, c.User,
+		c.API, c.AuditLog, c.AuditLogArchive, c.AuditLogChain, c.AuditLogCheckpoint,
+		c.AuditLogPruneRecord, c.CasbinRule, c.Configuration, c.Department,
+		c.Dictionary, c.DictionaryDetail, c.ImpersonationSession, c.LdapAccount,
+		c.LdapDepartment, c.LdapProvider, c.LdapSyncRun, c.Menu, c.OauthAccount,
+		c.OauthClient, c.OauthConsent, c.OauthProvider, c.OauthProviderTemplate,
+		c.OauthScope, c.OauthSession, c.Position, c.Role, c.SamlAccount,
+		c.SamlProvider, c.ScimToken, c.Tenant, c.TenantInitJob, c.TenantInitPlugin,
+		c.TenantInitTemplate, c.Token, c.User,
 	} {
 		n.Use(hooks...)
 	}
@@ -284,9 +403,14 @@ func (c *Client) Use(hooks ...Hook) {
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
-		c.API, c.AuditLog, c.CasbinRule, c.Configuration, c.Department, c.Dictionary,
-		c.DictionaryDetail, c.Menu, c.OauthAccount, c.OauthProvider, c.OauthSession,
-		c.Position, c.Role, c.Tenant, c.Token, c.User,
+		c.API, c.AuditLog, c.AuditLogArchive, c.AuditLogChain, c.AuditLogCheckpoint,
+		c.AuditLogPruneRecord, c.CasbinRule, c.Configuration, c.Department,
+		c.Dictionary, c.DictionaryDetail, c.ImpersonationSession, c.LdapAccount,
+		c.LdapDepartment, c.LdapProvider, c.LdapSyncRun, c.Menu, c.OauthAccount,
+		c.OauthClient, c.OauthConsent, c.OauthProvider, c.OauthProviderTemplate,
+		c.OauthScope, c.OauthSession, c.Position, c.Role, c.SamlAccount,
+		c.SamlProvider, c.ScimToken, c.Tenant, c.TenantInitJob, c.TenantInitPlugin,
+		c.TenantInitTemplate, c.Token, c.User,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -299,6 +423,14 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.API.mutate(ctx, m)
 	case *AuditLogMutation:
 		return c.AuditLog.mutate(ctx, m)
+	case *AuditLogArchiveMutation:
+		return c.AuditLogArchive.mutate(ctx, m)
+	case *AuditLogChainMutation:
+		return c.AuditLogChain.mutate(ctx, m)
+	case *AuditLogCheckpointMutation:
+		return c.AuditLogCheckpoint.mutate(ctx, m)
+	case *AuditLogPruneRecordMutation:
+		return c.AuditLogPruneRecord.mutate(ctx, m)
 	case *CasbinRuleMutation:
 		return c.CasbinRule.mutate(ctx, m)
 	case *ConfigurationMutation:
@@ -309,20 +441,50 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.Dictionary.mutate(ctx, m)
 	case *DictionaryDetailMutation:
 		return c.DictionaryDetail.mutate(ctx, m)
+	case *ImpersonationSessionMutation:
+		return c.ImpersonationSession.mutate(ctx, m)
+	case *LdapAccountMutation:
+		return c.LdapAccount.mutate(ctx, m)
+	case *LdapDepartmentMutation:
+		return c.LdapDepartment.mutate(ctx, m)
+	case *LdapProviderMutation:
+		return c.LdapProvider.mutate(ctx, m)
+	case *LdapSyncRunMutation:
+		return c.LdapSyncRun.mutate(ctx, m)
 	case *MenuMutation:
 		return c.Menu.mutate(ctx, m)
 	case *OauthAccountMutation:
 		return c.OauthAccount.mutate(ctx, m)
+	case *OauthClientMutation:
+		return c.OauthClient.mutate(ctx, m)
+	case *OauthConsentMutation:
+		return c.OauthConsent.mutate(ctx, m)
 	case *OauthProviderMutation:
 		return c.OauthProvider.mutate(ctx, m)
+	case *OauthProviderTemplateMutation:
+		return c.OauthProviderTemplate.mutate(ctx, m)
+	case *OauthScopeMutation:
+		return c.OauthScope.mutate(ctx, m)
 	case *OauthSessionMutation:
 		return c.OauthSession.mutate(ctx, m)
 	case *PositionMutation:
 		return c.Position.mutate(ctx, m)
 	case *RoleMutation:
 		return c.Role.mutate(ctx, m)
+	case *SamlAccountMutation:
+		return c.SamlAccount.mutate(ctx, m)
+	case *SamlProviderMutation:
+		return c.SamlProvider.mutate(ctx, m)
+	case *ScimTokenMutation:
+		return c.ScimToken.mutate(ctx, m)
 	case *TenantMutation:
 		return c.Tenant.mutate(ctx, m)
+	case *TenantInitJobMutation:
+		return c.TenantInitJob.mutate(ctx, m)
+	case *TenantInitPluginMutation:
+		return c.TenantInitPlugin.mutate(ctx, m)
+	case *TenantInitTemplateMutation:
+		return c.TenantInitTemplate.mutate(ctx, m)
 	case *TokenMutation:
 		return c.Token.mutate(ctx, m)
 	case *UserMutation:
@@ -598,107 +760,107 @@ func (c *AuditLogClient) mutate(ctx context.Context, m *AuditLogMutation) (Value
 	}
 }
 
-// CasbinRuleClient is a client for the CasbinRule schema.
-type CasbinRuleClient struct {
+// AuditLogArchiveClient is a client for the AuditLogArchive schema.
+type AuditLogArchiveClient struct {
 	config
 }
 
-// NewCasbinRuleClient returns a client for the CasbinRule from the given config.
-func NewCasbinRuleClient(c config) *CasbinRuleClient {
-	return &CasbinRuleClient{config: c}
+// NewAuditLogArchiveClient returns a client for the AuditLogArchive from the given config.
+func NewAuditLogArchiveClient(c config) *AuditLogArchiveClient {
+	return &AuditLogArchiveClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `casbinrule.Hooks(f(g(h())))`.
-func (c *CasbinRuleClient) Use(hooks ...Hook) {
-	c.hooks.CasbinRule = append(c.hooks.CasbinRule, hooks...)
+// A call to `Use(f, g, h)` equals to `auditlogarchive.Hooks(f(g(h())))`.
+func (c *AuditLogArchiveClient) Use(hooks ...Hook) {
+	c.hooks.AuditLogArchive = append(c.hooks.AuditLogArchive, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `casbinrule.Intercept(f(g(h())))`.
-func (c *CasbinRuleClient) Intercept(interceptors ...Interceptor) {
-	c.inters.CasbinRule = append(c.inters.CasbinRule, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `auditlogarchive.Intercept(f(g(h())))`.
+func (c *AuditLogArchiveClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AuditLogArchive = append(c.inters.AuditLogArchive, interceptors...)
 }
 
-// Create returns a builder for creating a CasbinRule entity.
-func (c *CasbinRuleClient) Create() *CasbinRuleCreate {
-	mutation := newCasbinRuleMutation(c.config, OpCreate)
-	return &CasbinRuleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a AuditLogArchive entity.
+func (c *AuditLogArchiveClient) Create() *AuditLogArchiveCreate {
+	mutation := newAuditLogArchiveMutation(c.config, OpCreate)
+	return &AuditLogArchiveCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of CasbinRule entities.
-func (c *CasbinRuleClient) CreateBulk(builders ...*CasbinRuleCreate) *CasbinRuleCreateBulk {
-	return &CasbinRuleCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of AuditLogArchive entities.
+func (c *AuditLogArchiveClient) CreateBulk(builders ...*AuditLogArchiveCreate) *AuditLogArchiveCreateBulk {
+	return &AuditLogArchiveCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *CasbinRuleClient) MapCreateBulk(slice any, setFunc func(*CasbinRuleCreate, int)) *CasbinRuleCreateBulk {
+func (c *AuditLogArchiveClient) MapCreateBulk(slice any, setFunc func(*AuditLogArchiveCreate, int)) *AuditLogArchiveCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &CasbinRuleCreateBulk{err: fmt.Errorf("calling to CasbinRuleClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &AuditLogArchiveCreateBulk{err: fmt.Errorf("calling to AuditLogArchiveClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*CasbinRuleCreate, rv.Len())
+	builders := make([]*AuditLogArchiveCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &CasbinRuleCreateBulk{config: c.config, builders: builders}
+	return &AuditLogArchiveCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for CasbinRule.
-func (c *CasbinRuleClient) Update() *CasbinRuleUpdate {
-	mutation := newCasbinRuleMutation(c.config, OpUpdate)
-	return &CasbinRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for AuditLogArchive.
+func (c *AuditLogArchiveClient) Update() *AuditLogArchiveUpdate {
+	mutation := newAuditLogArchiveMutation(c.config, OpUpdate)
+	return &AuditLogArchiveUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *CasbinRuleClient) UpdateOne(_m *CasbinRule) *CasbinRuleUpdateOne {
-	mutation := newCasbinRuleMutation(c.config, OpUpdateOne, withCasbinRule(_m))
-	return &CasbinRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *AuditLogArchiveClient) UpdateOne(_m *AuditLogArchive) *AuditLogArchiveUpdateOne {
+	mutation := newAuditLogArchiveMutation(c.config, OpUpdateOne, withAuditLogArchive(_m))
+	return &AuditLogArchiveUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *CasbinRuleClient) UpdateOneID(id uint64) *CasbinRuleUpdateOne {
-	mutation := newCasbinRuleMutation(c.config, OpUpdateOne, withCasbinRuleID(id))
-	return &CasbinRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *AuditLogArchiveClient) UpdateOneID(id uuid.UUID) *AuditLogArchiveUpdateOne {
+	mutation := newAuditLogArchiveMutation(c.config, OpUpdateOne, withAuditLogArchiveID(id))
+	return &AuditLogArchiveUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for CasbinRule.
-func (c *CasbinRuleClient) Delete() *CasbinRuleDelete {
-	mutation := newCasbinRuleMutation(c.config, OpDelete)
-	return &CasbinRuleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for AuditLogArchive.
+func (c *AuditLogArchiveClient) Delete() *AuditLogArchiveDelete {
+	mutation := newAuditLogArchiveMutation(c.config, OpDelete)
+	return &AuditLogArchiveDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *CasbinRuleClient) DeleteOne(_m *CasbinRule) *CasbinRuleDeleteOne {
+func (c *AuditLogArchiveClient) DeleteOne(_m *AuditLogArchive) *AuditLogArchiveDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *CasbinRuleClient) DeleteOneID(id uint64) *CasbinRuleDeleteOne {
-	builder := c.Delete().Where(casbinrule.ID(id))
+func (c *AuditLogArchiveClient) DeleteOneID(id uuid.UUID) *AuditLogArchiveDeleteOne {
+	builder := c.Delete().Where(auditlogarchive.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &CasbinRuleDeleteOne{builder}
+	return &AuditLogArchiveDeleteOne{builder}
 }
 
-// Query returns a query builder for CasbinRule.
-func (c *CasbinRuleClient) Query() *CasbinRuleQuery {
-	return &CasbinRuleQuery{
+// Query returns a query builder for AuditLogArchive.
+func (c *AuditLogArchiveClient) Query() *AuditLogArchiveQuery {
+	return &AuditLogArchiveQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeCasbinRule},
+		ctx:    &QueryContext{Type: TypeAuditLogArchive},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a CasbinRule entity by its id.
-func (c *CasbinRuleClient) Get(ctx context.Context, id uint64) (*CasbinRule, error) {
-	return c.Query().Where(casbinrule.ID(id)).Only(ctx)
+// Get returns a AuditLogArchive entity by its id.
+func (c *AuditLogArchiveClient) Get(ctx context.Context, id uuid.UUID) (*AuditLogArchive, error) {
+	return c.Query().Where(auditlogarchive.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *CasbinRuleClient) GetX(ctx context.Context, id uint64) *CasbinRule {
+func (c *AuditLogArchiveClient) GetX(ctx context.Context, id uuid.UUID) *AuditLogArchive {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -707,131 +869,131 @@ func (c *CasbinRuleClient) GetX(ctx context.Context, id uint64) *CasbinRule {
 }
 
 // Hooks returns the client hooks.
-func (c *CasbinRuleClient) Hooks() []Hook {
-	return c.hooks.CasbinRule
+func (c *AuditLogArchiveClient) Hooks() []Hook {
+	return c.hooks.AuditLogArchive
 }
 
 // Interceptors returns the client interceptors.
-func (c *CasbinRuleClient) Interceptors() []Interceptor {
-	return c.inters.CasbinRule
+func (c *AuditLogArchiveClient) Interceptors() []Interceptor {
+	return c.inters.AuditLogArchive
 }
 
-func (c *CasbinRuleClient) mutate(ctx context.Context, m *CasbinRuleMutation) (Value, error) {
+func (c *AuditLogArchiveClient) mutate(ctx context.Context, m *AuditLogArchiveMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&CasbinRuleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AuditLogArchiveCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&CasbinRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AuditLogArchiveUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&CasbinRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AuditLogArchiveUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&CasbinRuleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&AuditLogArchiveDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown CasbinRule mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown AuditLogArchive mutation op: %q", m.Op())
 	}
 }
 
-// ConfigurationClient is a client for the Configuration schema.
-type ConfigurationClient struct {
+// AuditLogChainClient is a client for the AuditLogChain schema.
+type AuditLogChainClient struct {
 	config
 }
 
-// NewConfigurationClient returns a client for the Configuration from the given config.
-func NewConfigurationClient(c config) *ConfigurationClient {
-	return &ConfigurationClient{config: c}
+// NewAuditLogChainClient returns a client for the AuditLogChain from the given config.
+func NewAuditLogChainClient(c config) *AuditLogChainClient {
+	return &AuditLogChainClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `configuration.Hooks(f(g(h())))`.
-func (c *ConfigurationClient) Use(hooks ...Hook) {
-	c.hooks.Configuration = append(c.hooks.Configuration, hooks...)
+// A call to `Use(f, g, h)` equals to `auditlogchain.Hooks(f(g(h())))`.
+func (c *AuditLogChainClient) Use(hooks ...Hook) {
+	c.hooks.AuditLogChain = append(c.hooks.AuditLogChain, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `configuration.Intercept(f(g(h())))`.
-func (c *ConfigurationClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Configuration = append(c.inters.Configuration, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `auditlogchain.Intercept(f(g(h())))`.
+func (c *AuditLogChainClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AuditLogChain = append(c.inters.AuditLogChain, interceptors...)
 }
 
-// Create returns a builder for creating a Configuration entity.
-func (c *ConfigurationClient) Create() *ConfigurationCreate {
-	mutation := newConfigurationMutation(c.config, OpCreate)
-	return &ConfigurationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a AuditLogChain entity.
+func (c *AuditLogChainClient) Create() *AuditLogChainCreate {
+	mutation := newAuditLogChainMutation(c.config, OpCreate)
+	return &AuditLogChainCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Configuration entities.
-func (c *ConfigurationClient) CreateBulk(builders ...*ConfigurationCreate) *ConfigurationCreateBulk {
-	return &ConfigurationCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of AuditLogChain entities.
+func (c *AuditLogChainClient) CreateBulk(builders ...*AuditLogChainCreate) *AuditLogChainCreateBulk {
+	return &AuditLogChainCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *ConfigurationClient) MapCreateBulk(slice any, setFunc func(*ConfigurationCreate, int)) *ConfigurationCreateBulk {
+func (c *AuditLogChainClient) MapCreateBulk(slice any, setFunc func(*AuditLogChainCreate, int)) *AuditLogChainCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &ConfigurationCreateBulk{err: fmt.Errorf("calling to ConfigurationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &AuditLogChainCreateBulk{err: fmt.Errorf("calling to AuditLogChainClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*ConfigurationCreate, rv.Len())
+	builders := make([]*AuditLogChainCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &ConfigurationCreateBulk{config: c.config, builders: builders}
+	return &AuditLogChainCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Configuration.
-func (c *ConfigurationClient) Update() *ConfigurationUpdate {
-	mutation := newConfigurationMutation(c.config, OpUpdate)
-	return &ConfigurationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for AuditLogChain.
+func (c *AuditLogChainClient) Update() *AuditLogChainUpdate {
+	mutation := newAuditLogChainMutation(c.config, OpUpdate)
+	return &AuditLogChainUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *ConfigurationClient) UpdateOne(_m *Configuration) *ConfigurationUpdateOne {
-	mutation := newConfigurationMutation(c.config, OpUpdateOne, withConfiguration(_m))
-	return &ConfigurationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *AuditLogChainClient) UpdateOne(_m *AuditLogChain) *AuditLogChainUpdateOne {
+	mutation := newAuditLogChainMutation(c.config, OpUpdateOne, withAuditLogChain(_m))
+	return &AuditLogChainUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *ConfigurationClient) UpdateOneID(id uint64) *ConfigurationUpdateOne {
-	mutation := newConfigurationMutation(c.config, OpUpdateOne, withConfigurationID(id))
-	return &ConfigurationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *AuditLogChainClient) UpdateOneID(id uuid.UUID) *AuditLogChainUpdateOne {
+	mutation := newAuditLogChainMutation(c.config, OpUpdateOne, withAuditLogChainID(id))
+	return &AuditLogChainUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Configuration.
-func (c *ConfigurationClient) Delete() *ConfigurationDelete {
-	mutation := newConfigurationMutation(c.config, OpDelete)
-	return &ConfigurationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for AuditLogChain.
+func (c *AuditLogChainClient) Delete() *AuditLogChainDelete {
+	mutation := newAuditLogChainMutation(c.config, OpDelete)
+	return &AuditLogChainDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *ConfigurationClient) DeleteOne(_m *Configuration) *ConfigurationDeleteOne {
+func (c *AuditLogChainClient) DeleteOne(_m *AuditLogChain) *AuditLogChainDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *ConfigurationClient) DeleteOneID(id uint64) *ConfigurationDeleteOne {
-	builder := c.Delete().Where(configuration.ID(id))
+func (c *AuditLogChainClient) DeleteOneID(id uuid.UUID) *AuditLogChainDeleteOne {
+	builder := c.Delete().Where(auditlogchain.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &ConfigurationDeleteOne{builder}
+	return &AuditLogChainDeleteOne{builder}
 }
 
-// Query returns a query builder for Configuration.
-func (c *ConfigurationClient) Query() *ConfigurationQuery {
-	return &ConfigurationQuery{
+// Query returns a query builder for AuditLogChain.
+func (c *AuditLogChainClient) Query() *AuditLogChainQuery {
+	return &AuditLogChainQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeConfiguration},
+		ctx:    &QueryContext{Type: TypeAuditLogChain},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Configuration entity by its id.
-func (c *ConfigurationClient) Get(ctx context.Context, id uint64) (*Configuration, error) {
-	return c.Query().Where(configuration.ID(id)).Only(ctx)
+// Get returns a AuditLogChain entity by its id.
+func (c *AuditLogChainClient) Get(ctx context.Context, id uuid.UUID) (*AuditLogChain, error) {
+	return c.Query().Where(auditlogchain.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *ConfigurationClient) GetX(ctx context.Context, id uint64) *Configuration {
+func (c *AuditLogChainClient) GetX(ctx context.Context, id uuid.UUID) *AuditLogChain {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -840,131 +1002,131 @@ func (c *ConfigurationClient) GetX(ctx context.Context, id uint64) *Configuratio
 }
 
 // Hooks returns the client hooks.
-func (c *ConfigurationClient) Hooks() []Hook {
-	return c.hooks.Configuration
+func (c *AuditLogChainClient) Hooks() []Hook {
+	return c.hooks.AuditLogChain
 }
 
 // Interceptors returns the client interceptors.
-func (c *ConfigurationClient) Interceptors() []Interceptor {
-	return c.inters.Configuration
+func (c *AuditLogChainClient) Interceptors() []Interceptor {
+	return c.inters.AuditLogChain
 }
 
-func (c *ConfigurationClient) mutate(ctx context.Context, m *ConfigurationMutation) (Value, error) {
+func (c *AuditLogChainClient) mutate(ctx context.Context, m *AuditLogChainMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&ConfigurationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AuditLogChainCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&ConfigurationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AuditLogChainUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&ConfigurationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AuditLogChainUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&ConfigurationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&AuditLogChainDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Configuration mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown AuditLogChain mutation op: %q", m.Op())
 	}
 }
 
-// DepartmentClient is a client for the Department schema.
-type DepartmentClient struct {
+// AuditLogCheckpointClient is a client for the AuditLogCheckpoint schema.
+type AuditLogCheckpointClient struct {
 	config
 }
 
-// NewDepartmentClient returns a client for the Department from the given config.
-func NewDepartmentClient(c config) *DepartmentClient {
-	return &DepartmentClient{config: c}
+// NewAuditLogCheckpointClient returns a client for the AuditLogCheckpoint from the given config.
+func NewAuditLogCheckpointClient(c config) *AuditLogCheckpointClient {
+	return &AuditLogCheckpointClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `department.Hooks(f(g(h())))`.
-func (c *DepartmentClient) Use(hooks ...Hook) {
-	c.hooks.Department = append(c.hooks.Department, hooks...)
+// A call to `Use(f, g, h)` equals to `auditlogcheckpoint.Hooks(f(g(h())))`.
+func (c *AuditLogCheckpointClient) Use(hooks ...Hook) {
+	c.hooks.AuditLogCheckpoint = append(c.hooks.AuditLogCheckpoint, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `department.Intercept(f(g(h())))`.
-func (c *DepartmentClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Department = append(c.inters.Department, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `auditlogcheckpoint.Intercept(f(g(h())))`.
+func (c *AuditLogCheckpointClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AuditLogCheckpoint = append(c.inters.AuditLogCheckpoint, interceptors...)
 }
 
-// Create returns a builder for creating a Department entity.
-func (c *DepartmentClient) Create() *DepartmentCreate {
-	mutation := newDepartmentMutation(c.config, OpCreate)
-	return &DepartmentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a AuditLogCheckpoint entity.
+func (c *AuditLogCheckpointClient) Create() *AuditLogCheckpointCreate {
+	mutation := newAuditLogCheckpointMutation(c.config, OpCreate)
+	return &AuditLogCheckpointCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Department entities.
-func (c *DepartmentClient) CreateBulk(builders ...*DepartmentCreate) *DepartmentCreateBulk {
-	return &DepartmentCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of AuditLogCheckpoint entities.
+func (c *AuditLogCheckpointClient) CreateBulk(builders ...*AuditLogCheckpointCreate) *AuditLogCheckpointCreateBulk {
+	return &AuditLogCheckpointCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *DepartmentClient) MapCreateBulk(slice any, setFunc func(*DepartmentCreate, int)) *DepartmentCreateBulk {
+func (c *AuditLogCheckpointClient) MapCreateBulk(slice any, setFunc func(*AuditLogCheckpointCreate, int)) *AuditLogCheckpointCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &DepartmentCreateBulk{err: fmt.Errorf("calling to DepartmentClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &AuditLogCheckpointCreateBulk{err: fmt.Errorf("calling to AuditLogCheckpointClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*DepartmentCreate, rv.Len())
+	builders := make([]*AuditLogCheckpointCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &DepartmentCreateBulk{config: c.config, builders: builders}
+	return &AuditLogCheckpointCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Department.
-func (c *DepartmentClient) Update() *DepartmentUpdate {
-	mutation := newDepartmentMutation(c.config, OpUpdate)
-	return &DepartmentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for AuditLogCheckpoint.
+func (c *AuditLogCheckpointClient) Update() *AuditLogCheckpointUpdate {
+	mutation := newAuditLogCheckpointMutation(c.config, OpUpdate)
+	return &AuditLogCheckpointUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *DepartmentClient) UpdateOne(_m *Department) *DepartmentUpdateOne {
-	mutation := newDepartmentMutation(c.config, OpUpdateOne, withDepartment(_m))
-	return &DepartmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *AuditLogCheckpointClient) UpdateOne(_m *AuditLogCheckpoint) *AuditLogCheckpointUpdateOne {
+	mutation := newAuditLogCheckpointMutation(c.config, OpUpdateOne, withAuditLogCheckpoint(_m))
+	return &AuditLogCheckpointUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *DepartmentClient) UpdateOneID(id uint64) *DepartmentUpdateOne {
-	mutation := newDepartmentMutation(c.config, OpUpdateOne, withDepartmentID(id))
-	return &DepartmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *AuditLogCheckpointClient) UpdateOneID(id uuid.UUID) *AuditLogCheckpointUpdateOne {
+	mutation := newAuditLogCheckpointMutation(c.config, OpUpdateOne, withAuditLogCheckpointID(id))
+	return &AuditLogCheckpointUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Department.
-func (c *DepartmentClient) Delete() *DepartmentDelete {
-	mutation := newDepartmentMutation(c.config, OpDelete)
-	return &DepartmentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for AuditLogCheckpoint.
+func (c *AuditLogCheckpointClient) Delete() *AuditLogCheckpointDelete {
+	mutation := newAuditLogCheckpointMutation(c.config, OpDelete)
+	return &AuditLogCheckpointDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *DepartmentClient) DeleteOne(_m *Department) *DepartmentDeleteOne {
+func (c *AuditLogCheckpointClient) DeleteOne(_m *AuditLogCheckpoint) *AuditLogCheckpointDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *DepartmentClient) DeleteOneID(id uint64) *DepartmentDeleteOne {
-	builder := c.Delete().Where(department.ID(id))
+func (c *AuditLogCheckpointClient) DeleteOneID(id uuid.UUID) *AuditLogCheckpointDeleteOne {
+	builder := c.Delete().Where(auditlogcheckpoint.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &DepartmentDeleteOne{builder}
+	return &AuditLogCheckpointDeleteOne{builder}
 }
 
-// Query returns a query builder for Department.
-func (c *DepartmentClient) Query() *DepartmentQuery {
-	return &DepartmentQuery{
+// Query returns a query builder for AuditLogCheckpoint.
+func (c *AuditLogCheckpointClient) Query() *AuditLogCheckpointQuery {
+	return &AuditLogCheckpointQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeDepartment},
+		ctx:    &QueryContext{Type: TypeAuditLogCheckpoint},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Department entity by its id.
-func (c *DepartmentClient) Get(ctx context.Context, id uint64) (*Department, error) {
-	return c.Query().Where(department.ID(id)).Only(ctx)
+// Get returns a AuditLogCheckpoint entity by its id.
+func (c *AuditLogCheckpointClient) Get(ctx context.Context, id uuid.UUID) (*AuditLogCheckpoint, error) {
+	return c.Query().Where(auditlogcheckpoint.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *DepartmentClient) GetX(ctx context.Context, id uint64) *Department {
+func (c *AuditLogCheckpointClient) GetX(ctx context.Context, id uuid.UUID) *AuditLogCheckpoint {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -972,126 +1134,658 @@ func (c *DepartmentClient) GetX(ctx context.Context, id uint64) *Department {
 	return obj
 }
 
-// QueryParent queries the parent edge of a Department.
-func (c *DepartmentClient) QueryParent(_m *Department) *DepartmentQuery {
-	query := (&DepartmentClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(department.Table, department.FieldID, id),
-			sqlgraph.To(department.Table, department.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, department.ParentTable, department.ParentColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryChildren queries the children edge of a Department.
-func (c *DepartmentClient) QueryChildren(_m *Department) *DepartmentQuery {
-	query := (&DepartmentClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(department.Table, department.FieldID, id),
-			sqlgraph.To(department.Table, department.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, department.ChildrenTable, department.ChildrenColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryUsers queries the users edge of a Department.
-func (c *DepartmentClient) QueryUsers(_m *Department) *UserQuery {
-	query := (&UserClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(department.Table, department.FieldID, id),
-			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, true, department.UsersTable, department.UsersColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryPosts queries the posts edge of a Department.
-func (c *DepartmentClient) QueryPosts(_m *Department) *PositionQuery {
-	query := (&PositionClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(department.Table, department.FieldID, id),
-			sqlgraph.To(position.Table, position.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, department.PostsTable, department.PostsColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
 // Hooks returns the client hooks.
-func (c *DepartmentClient) Hooks() []Hook {
-	return c.hooks.Department
+func (c *AuditLogCheckpointClient) Hooks() []Hook {
+	return c.hooks.AuditLogCheckpoint
 }
 
 // Interceptors returns the client interceptors.
-func (c *DepartmentClient) Interceptors() []Interceptor {
-	return c.inters.Department
+func (c *AuditLogCheckpointClient) Interceptors() []Interceptor {
+	return c.inters.AuditLogCheckpoint
 }
 
-func (c *DepartmentClient) mutate(ctx context.Context, m *DepartmentMutation) (Value, error) {
+func (c *AuditLogCheckpointClient) mutate(ctx context.Context, m *AuditLogCheckpointMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&DepartmentCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AuditLogCheckpointCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&DepartmentUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AuditLogCheckpointUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&DepartmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AuditLogCheckpointUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&DepartmentDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&AuditLogCheckpointDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Department mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown AuditLogCheckpoint mutation op: %q", m.Op())
 	}
 }
 
-// DictionaryClient is a client for the Dictionary schema.
-type DictionaryClient struct {
+// AuditLogPruneRecordClient is a client for the AuditLogPruneRecord schema.
+type AuditLogPruneRecordClient struct {
 	config
 }
 
-// NewDictionaryClient returns a client for the Dictionary from the given config.
-func NewDictionaryClient(c config) *DictionaryClient {
-	return &DictionaryClient{config: c}
+// NewAuditLogPruneRecordClient returns a client for the AuditLogPruneRecord from the given config.
+func NewAuditLogPruneRecordClient(c config) *AuditLogPruneRecordClient {
+	return &AuditLogPruneRecordClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `dictionary.Hooks(f(g(h())))`.
-func (c *DictionaryClient) Use(hooks ...Hook) {
-	c.hooks.Dictionary = append(c.hooks.Dictionary, hooks...)
+// A call to `Use(f, g, h)` equals to `auditlogprunerecord.Hooks(f(g(h())))`.
+func (c *AuditLogPruneRecordClient) Use(hooks ...Hook) {
+	c.hooks.AuditLogPruneRecord = append(c.hooks.AuditLogPruneRecord, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `dictionary.Intercept(f(g(h())))`.
-func (c *DictionaryClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Dictionary = append(c.inters.Dictionary, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `auditlogprunerecord.Intercept(f(g(h())))`.
+func (c *AuditLogPruneRecordClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AuditLogPruneRecord = append(c.inters.AuditLogPruneRecord, interceptors...)
 }
 
-// Create returns a builder for creating a Dictionary entity.
-func (c *DictionaryClient) Create() *DictionaryCreate {
-	mutation := newDictionaryMutation(c.config, OpCreate)
-	return &DictionaryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a AuditLogPruneRecord entity.
+func (c *AuditLogPruneRecordClient) Create() *AuditLogPruneRecordCreate {
+	mutation := newAuditLogPruneRecordMutation(c.config, OpCreate)
+	return &AuditLogPruneRecordCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Dictionary entities.
-func (c *DictionaryClient) CreateBulk(builders ...*DictionaryCreate) *DictionaryCreateBulk {
-	return &DictionaryCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of AuditLogPruneRecord entities.
+func (c *AuditLogPruneRecordClient) CreateBulk(builders ...*AuditLogPruneRecordCreate) *AuditLogPruneRecordCreateBulk {
+	return &AuditLogPruneRecordCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *AuditLogPruneRecordClient) MapCreateBulk(slice any, setFunc func(*AuditLogPruneRecordCreate, int)) *AuditLogPruneRecordCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &AuditLogPruneRecordCreateBulk{err: fmt.Errorf("calling to AuditLogPruneRecordClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*AuditLogPruneRecordCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &AuditLogPruneRecordCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for AuditLogPruneRecord.
+func (c *AuditLogPruneRecordClient) Update() *AuditLogPruneRecordUpdate {
+	mutation := newAuditLogPruneRecordMutation(c.config, OpUpdate)
+	return &AuditLogPruneRecordUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AuditLogPruneRecordClient) UpdateOne(_m *AuditLogPruneRecord) *AuditLogPruneRecordUpdateOne {
+	mutation := newAuditLogPruneRecordMutation(c.config, OpUpdateOne, withAuditLogPruneRecord(_m))
+	return &AuditLogPruneRecordUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AuditLogPruneRecordClient) UpdateOneID(id uuid.UUID) *AuditLogPruneRecordUpdateOne {
+	mutation := newAuditLogPruneRecordMutation(c.config, OpUpdateOne, withAuditLogPruneRecordID(id))
+	return &AuditLogPruneRecordUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for AuditLogPruneRecord.
+func (c *AuditLogPruneRecordClient) Delete() *AuditLogPruneRecordDelete {
+	mutation := newAuditLogPruneRecordMutation(c.config, OpDelete)
+	return &AuditLogPruneRecordDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *AuditLogPruneRecordClient) DeleteOne(_m *AuditLogPruneRecord) *AuditLogPruneRecordDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *AuditLogPruneRecordClient) DeleteOneID(id uuid.UUID) *AuditLogPruneRecordDeleteOne {
+	builder := c.Delete().Where(auditlogprunerecord.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AuditLogPruneRecordDeleteOne{builder}
+}
+
+// Query returns a query builder for AuditLogPruneRecord.
+func (c *AuditLogPruneRecordClient) Query() *AuditLogPruneRecordQuery {
+	return &AuditLogPruneRecordQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAuditLogPruneRecord},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a AuditLogPruneRecord entity by its id.
+func (c *AuditLogPruneRecordClient) Get(ctx context.Context, id uuid.UUID) (*AuditLogPruneRecord, error) {
+	return c.Query().Where(auditlogprunerecord.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AuditLogPruneRecordClient) GetX(ctx context.Context, id uuid.UUID) *AuditLogPruneRecord {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *AuditLogPruneRecordClient) Hooks() []Hook {
+	return c.hooks.AuditLogPruneRecord
+}
+
+// Interceptors returns the client interceptors.
+func (c *AuditLogPruneRecordClient) Interceptors() []Interceptor {
+	return c.inters.AuditLogPruneRecord
+}
+
+func (c *AuditLogPruneRecordClient) mutate(ctx context.Context, m *AuditLogPruneRecordMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&AuditLogPruneRecordCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&AuditLogPruneRecordUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&AuditLogPruneRecordUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&AuditLogPruneRecordDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown AuditLogPruneRecord mutation op: %q", m.Op())
+	}
+}
+
+// CasbinRuleClient is a client for the CasbinRule schema.
+type CasbinRuleClient struct {
+	config
+}
+
+// NewCasbinRuleClient returns a client for the CasbinRule from the given config.
+func NewCasbinRuleClient(c config) *CasbinRuleClient {
+	return &CasbinRuleClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `casbinrule.Hooks(f(g(h())))`.
+func (c *CasbinRuleClient) Use(hooks ...Hook) {
+	c.hooks.CasbinRule = append(c.hooks.CasbinRule, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `casbinrule.Intercept(f(g(h())))`.
+func (c *CasbinRuleClient) Intercept(interceptors ...Interceptor) {
+	c.inters.CasbinRule = append(c.inters.CasbinRule, interceptors...)
+}
+
+// Create returns a builder for creating a CasbinRule entity.
+func (c *CasbinRuleClient) Create() *CasbinRuleCreate {
+	mutation := newCasbinRuleMutation(c.config, OpCreate)
+	return &CasbinRuleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of CasbinRule entities.
+func (c *CasbinRuleClient) CreateBulk(builders ...*CasbinRuleCreate) *CasbinRuleCreateBulk {
+	return &CasbinRuleCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *CasbinRuleClient) MapCreateBulk(slice any, setFunc func(*CasbinRuleCreate, int)) *CasbinRuleCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &CasbinRuleCreateBulk{err: fmt.Errorf("calling to CasbinRuleClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*CasbinRuleCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &CasbinRuleCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for CasbinRule.
+func (c *CasbinRuleClient) Update() *CasbinRuleUpdate {
+	mutation := newCasbinRuleMutation(c.config, OpUpdate)
+	return &CasbinRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *CasbinRuleClient) UpdateOne(_m *CasbinRule) *CasbinRuleUpdateOne {
+	mutation := newCasbinRuleMutation(c.config, OpUpdateOne, withCasbinRule(_m))
+	return &CasbinRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *CasbinRuleClient) UpdateOneID(id uint64) *CasbinRuleUpdateOne {
+	mutation := newCasbinRuleMutation(c.config, OpUpdateOne, withCasbinRuleID(id))
+	return &CasbinRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for CasbinRule.
+func (c *CasbinRuleClient) Delete() *CasbinRuleDelete {
+	mutation := newCasbinRuleMutation(c.config, OpDelete)
+	return &CasbinRuleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *CasbinRuleClient) DeleteOne(_m *CasbinRule) *CasbinRuleDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *CasbinRuleClient) DeleteOneID(id uint64) *CasbinRuleDeleteOne {
+	builder := c.Delete().Where(casbinrule.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &CasbinRuleDeleteOne{builder}
+}
+
+// Query returns a query builder for CasbinRule.
+func (c *CasbinRuleClient) Query() *CasbinRuleQuery {
+	return &CasbinRuleQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeCasbinRule},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a CasbinRule entity by its id.
+func (c *CasbinRuleClient) Get(ctx context.Context, id uint64) (*CasbinRule, error) {
+	return c.Query().Where(casbinrule.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *CasbinRuleClient) GetX(ctx context.Context, id uint64) *CasbinRule {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *CasbinRuleClient) Hooks() []Hook {
+	return c.hooks.CasbinRule
+}
+
+// Interceptors returns the client interceptors.
+func (c *CasbinRuleClient) Interceptors() []Interceptor {
+	return c.inters.CasbinRule
+}
+
+func (c *CasbinRuleClient) mutate(ctx context.Context, m *CasbinRuleMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&CasbinRuleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&CasbinRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&CasbinRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&CasbinRuleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown CasbinRule mutation op: %q", m.Op())
+	}
+}
+
+// ConfigurationClient is a client for the Configuration schema.
+type ConfigurationClient struct {
+	config
+}
+
+// NewConfigurationClient returns a client for the Configuration from the given config.
+func NewConfigurationClient(c config) *ConfigurationClient {
+	return &ConfigurationClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `configuration.Hooks(f(g(h())))`.
+func (c *ConfigurationClient) Use(hooks ...Hook) {
+	c.hooks.Configuration = append(c.hooks.Configuration, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `configuration.Intercept(f(g(h())))`.
+func (c *ConfigurationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Configuration = append(c.inters.Configuration, interceptors...)
+}
+
+// Create returns a builder for creating a Configuration entity.
+func (c *ConfigurationClient) Create() *ConfigurationCreate {
+	mutation := newConfigurationMutation(c.config, OpCreate)
+	return &ConfigurationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Configuration entities.
+func (c *ConfigurationClient) CreateBulk(builders ...*ConfigurationCreate) *ConfigurationCreateBulk {
+	return &ConfigurationCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ConfigurationClient) MapCreateBulk(slice any, setFunc func(*ConfigurationCreate, int)) *ConfigurationCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ConfigurationCreateBulk{err: fmt.Errorf("calling to ConfigurationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ConfigurationCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ConfigurationCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Configuration.
+func (c *ConfigurationClient) Update() *ConfigurationUpdate {
+	mutation := newConfigurationMutation(c.config, OpUpdate)
+	return &ConfigurationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ConfigurationClient) UpdateOne(_m *Configuration) *ConfigurationUpdateOne {
+	mutation := newConfigurationMutation(c.config, OpUpdateOne, withConfiguration(_m))
+	return &ConfigurationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ConfigurationClient) UpdateOneID(id uint64) *ConfigurationUpdateOne {
+	mutation := newConfigurationMutation(c.config, OpUpdateOne, withConfigurationID(id))
+	return &ConfigurationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Configuration.
+func (c *ConfigurationClient) Delete() *ConfigurationDelete {
+	mutation := newConfigurationMutation(c.config, OpDelete)
+	return &ConfigurationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ConfigurationClient) DeleteOne(_m *Configuration) *ConfigurationDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ConfigurationClient) DeleteOneID(id uint64) *ConfigurationDeleteOne {
+	builder := c.Delete().Where(configuration.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ConfigurationDeleteOne{builder}
+}
+
+// Query returns a query builder for Configuration.
+func (c *ConfigurationClient) Query() *ConfigurationQuery {
+	return &ConfigurationQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeConfiguration},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Configuration entity by its id.
+func (c *ConfigurationClient) Get(ctx context.Context, id uint64) (*Configuration, error) {
+	return c.Query().Where(configuration.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ConfigurationClient) GetX(ctx context.Context, id uint64) *Configuration {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ConfigurationClient) Hooks() []Hook {
+	return c.hooks.Configuration
+}
+
+// Interceptors returns the client interceptors.
+func (c *ConfigurationClient) Interceptors() []Interceptor {
+	return c.inters.Configuration
+}
+
+func (c *ConfigurationClient) mutate(ctx context.Context, m *ConfigurationMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ConfigurationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ConfigurationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ConfigurationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ConfigurationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Configuration mutation op: %q", m.Op())
+	}
+}
+
+// DepartmentClient is a client for the Department schema.
+type DepartmentClient struct {
+	config
+}
+
+// NewDepartmentClient returns a client for the Department from the given config.
+func NewDepartmentClient(c config) *DepartmentClient {
+	return &DepartmentClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `department.Hooks(f(g(h())))`.
+func (c *DepartmentClient) Use(hooks ...Hook) {
+	c.hooks.Department = append(c.hooks.Department, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `department.Intercept(f(g(h())))`.
+func (c *DepartmentClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Department = append(c.inters.Department, interceptors...)
+}
+
+// Create returns a builder for creating a Department entity.
+func (c *DepartmentClient) Create() *DepartmentCreate {
+	mutation := newDepartmentMutation(c.config, OpCreate)
+	return &DepartmentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Department entities.
+func (c *DepartmentClient) CreateBulk(builders ...*DepartmentCreate) *DepartmentCreateBulk {
+	return &DepartmentCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *DepartmentClient) MapCreateBulk(slice any, setFunc func(*DepartmentCreate, int)) *DepartmentCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &DepartmentCreateBulk{err: fmt.Errorf("calling to DepartmentClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*DepartmentCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &DepartmentCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Department.
+func (c *DepartmentClient) Update() *DepartmentUpdate {
+	mutation := newDepartmentMutation(c.config, OpUpdate)
+	return &DepartmentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *DepartmentClient) UpdateOne(_m *Department) *DepartmentUpdateOne {
+	mutation := newDepartmentMutation(c.config, OpUpdateOne, withDepartment(_m))
+	return &DepartmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *DepartmentClient) UpdateOneID(id uint64) *DepartmentUpdateOne {
+	mutation := newDepartmentMutation(c.config, OpUpdateOne, withDepartmentID(id))
+	return &DepartmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Department.
+func (c *DepartmentClient) Delete() *DepartmentDelete {
+	mutation := newDepartmentMutation(c.config, OpDelete)
+	return &DepartmentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *DepartmentClient) DeleteOne(_m *Department) *DepartmentDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *DepartmentClient) DeleteOneID(id uint64) *DepartmentDeleteOne {
+	builder := c.Delete().Where(department.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &DepartmentDeleteOne{builder}
+}
+
+// Query returns a query builder for Department.
+func (c *DepartmentClient) Query() *DepartmentQuery {
+	return &DepartmentQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeDepartment},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Department entity by its id.
+func (c *DepartmentClient) Get(ctx context.Context, id uint64) (*Department, error) {
+	return c.Query().Where(department.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *DepartmentClient) GetX(ctx context.Context, id uint64) *Department {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryParent queries the parent edge of a Department.
+func (c *DepartmentClient) QueryParent(_m *Department) *DepartmentQuery {
+	query := (&DepartmentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(department.Table, department.FieldID, id),
+			sqlgraph.To(department.Table, department.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, department.ParentTable, department.ParentColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryChildren queries the children edge of a Department.
+func (c *DepartmentClient) QueryChildren(_m *Department) *DepartmentQuery {
+	query := (&DepartmentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(department.Table, department.FieldID, id),
+			sqlgraph.To(department.Table, department.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, department.ChildrenTable, department.ChildrenColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryUsers queries the users edge of a Department.
+func (c *DepartmentClient) QueryUsers(_m *Department) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(department.Table, department.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, department.UsersTable, department.UsersColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPosts queries the posts edge of a Department.
+func (c *DepartmentClient) QueryPosts(_m *Department) *PositionQuery {
+	query := (&PositionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(department.Table, department.FieldID, id),
+			sqlgraph.To(position.Table, position.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, department.PostsTable, department.PostsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *DepartmentClient) Hooks() []Hook {
+	return c.hooks.Department
+}
+
+// Interceptors returns the client interceptors.
+func (c *DepartmentClient) Interceptors() []Interceptor {
+	return c.inters.Department
+}
+
+func (c *DepartmentClient) mutate(ctx context.Context, m *DepartmentMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&DepartmentCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&DepartmentUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&DepartmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&DepartmentDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Department mutation op: %q", m.Op())
+	}
+}
+
+// DictionaryClient is a client for the Dictionary schema.
+type DictionaryClient struct {
+	config
+}
+
+// NewDictionaryClient returns a client for the Dictionary from the given config.
+func NewDictionaryClient(c config) *DictionaryClient {
+	return &DictionaryClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `dictionary.Hooks(f(g(h())))`.
+func (c *DictionaryClient) Use(hooks ...Hook) {
+	c.hooks.Dictionary = append(c.hooks.Dictionary, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `dictionary.Intercept(f(g(h())))`.
+func (c *DictionaryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Dictionary = append(c.inters.Dictionary, interceptors...)
+}
+
+// Create returns a builder for creating a Dictionary entity.
+func (c *DictionaryClient) Create() *DictionaryCreate {
+	mutation := newDictionaryMutation(c.config, OpCreate)
+	return &DictionaryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Dictionary entities.
+func (c *DictionaryClient) CreateBulk(builders ...*DictionaryCreate) *DictionaryCreateBulk {
+	return &DictionaryCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
@@ -1099,69 +1793,2384 @@ func (c *DictionaryClient) CreateBulk(builders ...*DictionaryCreate) *Dictionary
 func (c *DictionaryClient) MapCreateBulk(slice any, setFunc func(*DictionaryCreate, int)) *DictionaryCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &DictionaryCreateBulk{err: fmt.Errorf("calling to DictionaryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &DictionaryCreateBulk{err: fmt.Errorf("calling to DictionaryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*DictionaryCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &DictionaryCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Dictionary.
+func (c *DictionaryClient) Update() *DictionaryUpdate {
+	mutation := newDictionaryMutation(c.config, OpUpdate)
+	return &DictionaryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *DictionaryClient) UpdateOne(_m *Dictionary) *DictionaryUpdateOne {
+	mutation := newDictionaryMutation(c.config, OpUpdateOne, withDictionary(_m))
+	return &DictionaryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *DictionaryClient) UpdateOneID(id uint64) *DictionaryUpdateOne {
+	mutation := newDictionaryMutation(c.config, OpUpdateOne, withDictionaryID(id))
+	return &DictionaryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Dictionary.
+func (c *DictionaryClient) Delete() *DictionaryDelete {
+	mutation := newDictionaryMutation(c.config, OpDelete)
+	return &DictionaryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *DictionaryClient) DeleteOne(_m *Dictionary) *DictionaryDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *DictionaryClient) DeleteOneID(id uint64) *DictionaryDeleteOne {
+	builder := c.Delete().Where(dictionary.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &DictionaryDeleteOne{builder}
+}
+
+// Query returns a query builder for Dictionary.
+func (c *DictionaryClient) Query() *DictionaryQuery {
+	return &DictionaryQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeDictionary},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Dictionary entity by its id.
+func (c *DictionaryClient) Get(ctx context.Context, id uint64) (*Dictionary, error) {
+	return c.Query().Where(dictionary.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *DictionaryClient) GetX(ctx context.Context, id uint64) *Dictionary {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryDictionaryDetails queries the dictionary_details edge of a Dictionary.
+func (c *DictionaryClient) QueryDictionaryDetails(_m *Dictionary) *DictionaryDetailQuery {
+	query := (&DictionaryDetailClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(dictionary.Table, dictionary.FieldID, id),
+			sqlgraph.To(dictionarydetail.Table, dictionarydetail.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, dictionary.DictionaryDetailsTable, dictionary.DictionaryDetailsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *DictionaryClient) Hooks() []Hook {
+	return c.hooks.Dictionary
+}
+
+// Interceptors returns the client interceptors.
+func (c *DictionaryClient) Interceptors() []Interceptor {
+	return c.inters.Dictionary
+}
+
+func (c *DictionaryClient) mutate(ctx context.Context, m *DictionaryMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&DictionaryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&DictionaryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&DictionaryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&DictionaryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Dictionary mutation op: %q", m.Op())
+	}
+}
+
+// DictionaryDetailClient is a client for the DictionaryDetail schema.
+type DictionaryDetailClient struct {
+	config
+}
+
+// NewDictionaryDetailClient returns a client for the DictionaryDetail from the given config.
+func NewDictionaryDetailClient(c config) *DictionaryDetailClient {
+	return &DictionaryDetailClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `dictionarydetail.Hooks(f(g(h())))`.
+func (c *DictionaryDetailClient) Use(hooks ...Hook) {
+	c.hooks.DictionaryDetail = append(c.hooks.DictionaryDetail, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `dictionarydetail.Intercept(f(g(h())))`.
+func (c *DictionaryDetailClient) Intercept(interceptors ...Interceptor) {
+	c.inters.DictionaryDetail = append(c.inters.DictionaryDetail, interceptors...)
+}
+
+// Create returns a builder for creating a DictionaryDetail entity.
+func (c *DictionaryDetailClient) Create() *DictionaryDetailCreate {
+	mutation := newDictionaryDetailMutation(c.config, OpCreate)
+	return &DictionaryDetailCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of DictionaryDetail entities.
+func (c *DictionaryDetailClient) CreateBulk(builders ...*DictionaryDetailCreate) *DictionaryDetailCreateBulk {
+	return &DictionaryDetailCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *DictionaryDetailClient) MapCreateBulk(slice any, setFunc func(*DictionaryDetailCreate, int)) *DictionaryDetailCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &DictionaryDetailCreateBulk{err: fmt.Errorf("calling to DictionaryDetailClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*DictionaryDetailCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &DictionaryDetailCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for DictionaryDetail.
+func (c *DictionaryDetailClient) Update() *DictionaryDetailUpdate {
+	mutation := newDictionaryDetailMutation(c.config, OpUpdate)
+	return &DictionaryDetailUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *DictionaryDetailClient) UpdateOne(_m *DictionaryDetail) *DictionaryDetailUpdateOne {
+	mutation := newDictionaryDetailMutation(c.config, OpUpdateOne, withDictionaryDetail(_m))
+	return &DictionaryDetailUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *DictionaryDetailClient) UpdateOneID(id uint64) *DictionaryDetailUpdateOne {
+	mutation := newDictionaryDetailMutation(c.config, OpUpdateOne, withDictionaryDetailID(id))
+	return &DictionaryDetailUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for DictionaryDetail.
+func (c *DictionaryDetailClient) Delete() *DictionaryDetailDelete {
+	mutation := newDictionaryDetailMutation(c.config, OpDelete)
+	return &DictionaryDetailDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *DictionaryDetailClient) DeleteOne(_m *DictionaryDetail) *DictionaryDetailDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *DictionaryDetailClient) DeleteOneID(id uint64) *DictionaryDetailDeleteOne {
+	builder := c.Delete().Where(dictionarydetail.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &DictionaryDetailDeleteOne{builder}
+}
+
+// Query returns a query builder for DictionaryDetail.
+func (c *DictionaryDetailClient) Query() *DictionaryDetailQuery {
+	return &DictionaryDetailQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeDictionaryDetail},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a DictionaryDetail entity by its id.
+func (c *DictionaryDetailClient) Get(ctx context.Context, id uint64) (*DictionaryDetail, error) {
+	return c.Query().Where(dictionarydetail.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *DictionaryDetailClient) GetX(ctx context.Context, id uint64) *DictionaryDetail {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryDictionaries queries the dictionaries edge of a DictionaryDetail.
+func (c *DictionaryDetailClient) QueryDictionaries(_m *DictionaryDetail) *DictionaryQuery {
+	query := (&DictionaryClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(dictionarydetail.Table, dictionarydetail.FieldID, id),
+			sqlgraph.To(dictionary.Table, dictionary.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, dictionarydetail.DictionariesTable, dictionarydetail.DictionariesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *DictionaryDetailClient) Hooks() []Hook {
+	return c.hooks.DictionaryDetail
+}
+
+// Interceptors returns the client interceptors.
+func (c *DictionaryDetailClient) Interceptors() []Interceptor {
+	return c.inters.DictionaryDetail
+}
+
+func (c *DictionaryDetailClient) mutate(ctx context.Context, m *DictionaryDetailMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&DictionaryDetailCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&DictionaryDetailUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&DictionaryDetailUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&DictionaryDetailDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown DictionaryDetail mutation op: %q", m.Op())
+	}
+}
+
+// ImpersonationSessionClient is a client for the ImpersonationSession schema.
+type ImpersonationSessionClient struct {
+	config
+}
+
+// NewImpersonationSessionClient returns a client for the ImpersonationSession from the given config.
+func NewImpersonationSessionClient(c config) *ImpersonationSessionClient {
+	return &ImpersonationSessionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `impersonationsession.Hooks(f(g(h())))`.
+func (c *ImpersonationSessionClient) Use(hooks ...Hook) {
+	c.hooks.ImpersonationSession = append(c.hooks.ImpersonationSession, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `impersonationsession.Intercept(f(g(h())))`.
+func (c *ImpersonationSessionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ImpersonationSession = append(c.inters.ImpersonationSession, interceptors...)
+}
+
+// Create returns a builder for creating a ImpersonationSession entity.
+func (c *ImpersonationSessionClient) Create() *ImpersonationSessionCreate {
+	mutation := newImpersonationSessionMutation(c.config, OpCreate)
+	return &ImpersonationSessionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ImpersonationSession entities.
+func (c *ImpersonationSessionClient) CreateBulk(builders ...*ImpersonationSessionCreate) *ImpersonationSessionCreateBulk {
+	return &ImpersonationSessionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ImpersonationSessionClient) MapCreateBulk(slice any, setFunc func(*ImpersonationSessionCreate, int)) *ImpersonationSessionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ImpersonationSessionCreateBulk{err: fmt.Errorf("calling to ImpersonationSessionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ImpersonationSessionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ImpersonationSessionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ImpersonationSession.
+func (c *ImpersonationSessionClient) Update() *ImpersonationSessionUpdate {
+	mutation := newImpersonationSessionMutation(c.config, OpUpdate)
+	return &ImpersonationSessionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ImpersonationSessionClient) UpdateOne(_m *ImpersonationSession) *ImpersonationSessionUpdateOne {
+	mutation := newImpersonationSessionMutation(c.config, OpUpdateOne, withImpersonationSession(_m))
+	return &ImpersonationSessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ImpersonationSessionClient) UpdateOneID(id uint64) *ImpersonationSessionUpdateOne {
+	mutation := newImpersonationSessionMutation(c.config, OpUpdateOne, withImpersonationSessionID(id))
+	return &ImpersonationSessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ImpersonationSession.
+func (c *ImpersonationSessionClient) Delete() *ImpersonationSessionDelete {
+	mutation := newImpersonationSessionMutation(c.config, OpDelete)
+	return &ImpersonationSessionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ImpersonationSessionClient) DeleteOne(_m *ImpersonationSession) *ImpersonationSessionDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ImpersonationSessionClient) DeleteOneID(id uint64) *ImpersonationSessionDeleteOne {
+	builder := c.Delete().Where(impersonationsession.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ImpersonationSessionDeleteOne{builder}
+}
+
+// Query returns a query builder for ImpersonationSession.
+func (c *ImpersonationSessionClient) Query() *ImpersonationSessionQuery {
+	return &ImpersonationSessionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeImpersonationSession},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ImpersonationSession entity by its id.
+func (c *ImpersonationSessionClient) Get(ctx context.Context, id uint64) (*ImpersonationSession, error) {
+	return c.Query().Where(impersonationsession.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ImpersonationSessionClient) GetX(ctx context.Context, id uint64) *ImpersonationSession {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ImpersonationSessionClient) Hooks() []Hook {
+	return c.hooks.ImpersonationSession
+}
+
+// Interceptors returns the client interceptors.
+func (c *ImpersonationSessionClient) Interceptors() []Interceptor {
+	return c.inters.ImpersonationSession
+}
+
+func (c *ImpersonationSessionClient) mutate(ctx context.Context, m *ImpersonationSessionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ImpersonationSessionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ImpersonationSessionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ImpersonationSessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ImpersonationSessionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ImpersonationSession mutation op: %q", m.Op())
+	}
+}
+
+// LdapAccountClient is a client for the LdapAccount schema.
+type LdapAccountClient struct {
+	config
+}
+
+// NewLdapAccountClient returns a client for the LdapAccount from the given config.
+func NewLdapAccountClient(c config) *LdapAccountClient {
+	return &LdapAccountClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `ldapaccount.Hooks(f(g(h())))`.
+func (c *LdapAccountClient) Use(hooks ...Hook) {
+	c.hooks.LdapAccount = append(c.hooks.LdapAccount, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `ldapaccount.Intercept(f(g(h())))`.
+func (c *LdapAccountClient) Intercept(interceptors ...Interceptor) {
+	c.inters.LdapAccount = append(c.inters.LdapAccount, interceptors...)
+}
+
+// Create returns a builder for creating a LdapAccount entity.
+func (c *LdapAccountClient) Create() *LdapAccountCreate {
+	mutation := newLdapAccountMutation(c.config, OpCreate)
+	return &LdapAccountCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of LdapAccount entities.
+func (c *LdapAccountClient) CreateBulk(builders ...*LdapAccountCreate) *LdapAccountCreateBulk {
+	return &LdapAccountCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *LdapAccountClient) MapCreateBulk(slice any, setFunc func(*LdapAccountCreate, int)) *LdapAccountCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &LdapAccountCreateBulk{err: fmt.Errorf("calling to LdapAccountClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*LdapAccountCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &LdapAccountCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for LdapAccount.
+func (c *LdapAccountClient) Update() *LdapAccountUpdate {
+	mutation := newLdapAccountMutation(c.config, OpUpdate)
+	return &LdapAccountUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *LdapAccountClient) UpdateOne(_m *LdapAccount) *LdapAccountUpdateOne {
+	mutation := newLdapAccountMutation(c.config, OpUpdateOne, withLdapAccount(_m))
+	return &LdapAccountUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *LdapAccountClient) UpdateOneID(id uint64) *LdapAccountUpdateOne {
+	mutation := newLdapAccountMutation(c.config, OpUpdateOne, withLdapAccountID(id))
+	return &LdapAccountUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for LdapAccount.
+func (c *LdapAccountClient) Delete() *LdapAccountDelete {
+	mutation := newLdapAccountMutation(c.config, OpDelete)
+	return &LdapAccountDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *LdapAccountClient) DeleteOne(_m *LdapAccount) *LdapAccountDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *LdapAccountClient) DeleteOneID(id uint64) *LdapAccountDeleteOne {
+	builder := c.Delete().Where(ldapaccount.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &LdapAccountDeleteOne{builder}
+}
+
+// Query returns a query builder for LdapAccount.
+func (c *LdapAccountClient) Query() *LdapAccountQuery {
+	return &LdapAccountQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeLdapAccount},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a LdapAccount entity by its id.
+func (c *LdapAccountClient) Get(ctx context.Context, id uint64) (*LdapAccount, error) {
+	return c.Query().Where(ldapaccount.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *LdapAccountClient) GetX(ctx context.Context, id uint64) *LdapAccount {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a LdapAccount.
+func (c *LdapAccountClient) QueryUser(_m *LdapAccount) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(ldapaccount.Table, ldapaccount.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ldapaccount.UserTable, ldapaccount.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryProvider queries the provider edge of a LdapAccount.
+func (c *LdapAccountClient) QueryProvider(_m *LdapAccount) *LdapProviderQuery {
+	query := (&LdapProviderClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(ldapaccount.Table, ldapaccount.FieldID, id),
+			sqlgraph.To(ldapprovider.Table, ldapprovider.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ldapaccount.ProviderTable, ldapaccount.ProviderColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *LdapAccountClient) Hooks() []Hook {
+	return c.hooks.LdapAccount
+}
+
+// Interceptors returns the client interceptors.
+func (c *LdapAccountClient) Interceptors() []Interceptor {
+	return c.inters.LdapAccount
+}
+
+func (c *LdapAccountClient) mutate(ctx context.Context, m *LdapAccountMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&LdapAccountCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&LdapAccountUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&LdapAccountUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&LdapAccountDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown LdapAccount mutation op: %q", m.Op())
+	}
+}
+
+// LdapDepartmentClient is a client for the LdapDepartment schema.
+type LdapDepartmentClient struct {
+	config
+}
+
+// NewLdapDepartmentClient returns a client for the LdapDepartment from the given config.
+func NewLdapDepartmentClient(c config) *LdapDepartmentClient {
+	return &LdapDepartmentClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `ldapdepartment.Hooks(f(g(h())))`.
+func (c *LdapDepartmentClient) Use(hooks ...Hook) {
+	c.hooks.LdapDepartment = append(c.hooks.LdapDepartment, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `ldapdepartment.Intercept(f(g(h())))`.
+func (c *LdapDepartmentClient) Intercept(interceptors ...Interceptor) {
+	c.inters.LdapDepartment = append(c.inters.LdapDepartment, interceptors...)
+}
+
+// Create returns a builder for creating a LdapDepartment entity.
+func (c *LdapDepartmentClient) Create() *LdapDepartmentCreate {
+	mutation := newLdapDepartmentMutation(c.config, OpCreate)
+	return &LdapDepartmentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of LdapDepartment entities.
+func (c *LdapDepartmentClient) CreateBulk(builders ...*LdapDepartmentCreate) *LdapDepartmentCreateBulk {
+	return &LdapDepartmentCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *LdapDepartmentClient) MapCreateBulk(slice any, setFunc func(*LdapDepartmentCreate, int)) *LdapDepartmentCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &LdapDepartmentCreateBulk{err: fmt.Errorf("calling to LdapDepartmentClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*LdapDepartmentCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &LdapDepartmentCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for LdapDepartment.
+func (c *LdapDepartmentClient) Update() *LdapDepartmentUpdate {
+	mutation := newLdapDepartmentMutation(c.config, OpUpdate)
+	return &LdapDepartmentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *LdapDepartmentClient) UpdateOne(_m *LdapDepartment) *LdapDepartmentUpdateOne {
+	mutation := newLdapDepartmentMutation(c.config, OpUpdateOne, withLdapDepartment(_m))
+	return &LdapDepartmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *LdapDepartmentClient) UpdateOneID(id uint64) *LdapDepartmentUpdateOne {
+	mutation := newLdapDepartmentMutation(c.config, OpUpdateOne, withLdapDepartmentID(id))
+	return &LdapDepartmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for LdapDepartment.
+func (c *LdapDepartmentClient) Delete() *LdapDepartmentDelete {
+	mutation := newLdapDepartmentMutation(c.config, OpDelete)
+	return &LdapDepartmentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *LdapDepartmentClient) DeleteOne(_m *LdapDepartment) *LdapDepartmentDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *LdapDepartmentClient) DeleteOneID(id uint64) *LdapDepartmentDeleteOne {
+	builder := c.Delete().Where(ldapdepartment.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &LdapDepartmentDeleteOne{builder}
+}
+
+// Query returns a query builder for LdapDepartment.
+func (c *LdapDepartmentClient) Query() *LdapDepartmentQuery {
+	return &LdapDepartmentQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeLdapDepartment},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a LdapDepartment entity by its id.
+func (c *LdapDepartmentClient) Get(ctx context.Context, id uint64) (*LdapDepartment, error) {
+	return c.Query().Where(ldapdepartment.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *LdapDepartmentClient) GetX(ctx context.Context, id uint64) *LdapDepartment {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryProvider queries the provider edge of a LdapDepartment.
+func (c *LdapDepartmentClient) QueryProvider(_m *LdapDepartment) *LdapProviderQuery {
+	query := (&LdapProviderClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(ldapdepartment.Table, ldapdepartment.FieldID, id),
+			sqlgraph.To(ldapprovider.Table, ldapprovider.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ldapdepartment.ProviderTable, ldapdepartment.ProviderColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *LdapDepartmentClient) Hooks() []Hook {
+	return c.hooks.LdapDepartment
+}
+
+// Interceptors returns the client interceptors.
+func (c *LdapDepartmentClient) Interceptors() []Interceptor {
+	return c.inters.LdapDepartment
+}
+
+func (c *LdapDepartmentClient) mutate(ctx context.Context, m *LdapDepartmentMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&LdapDepartmentCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&LdapDepartmentUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&LdapDepartmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&LdapDepartmentDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown LdapDepartment mutation op: %q", m.Op())
+	}
+}
+
+// LdapProviderClient is a client for the LdapProvider schema.
+type LdapProviderClient struct {
+	config
+}
+
+// NewLdapProviderClient returns a client for the LdapProvider from the given config.
+func NewLdapProviderClient(c config) *LdapProviderClient {
+	return &LdapProviderClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `ldapprovider.Hooks(f(g(h())))`.
+func (c *LdapProviderClient) Use(hooks ...Hook) {
+	c.hooks.LdapProvider = append(c.hooks.LdapProvider, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `ldapprovider.Intercept(f(g(h())))`.
+func (c *LdapProviderClient) Intercept(interceptors ...Interceptor) {
+	c.inters.LdapProvider = append(c.inters.LdapProvider, interceptors...)
+}
+
+// Create returns a builder for creating a LdapProvider entity.
+func (c *LdapProviderClient) Create() *LdapProviderCreate {
+	mutation := newLdapProviderMutation(c.config, OpCreate)
+	return &LdapProviderCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of LdapProvider entities.
+func (c *LdapProviderClient) CreateBulk(builders ...*LdapProviderCreate) *LdapProviderCreateBulk {
+	return &LdapProviderCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *LdapProviderClient) MapCreateBulk(slice any, setFunc func(*LdapProviderCreate, int)) *LdapProviderCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &LdapProviderCreateBulk{err: fmt.Errorf("calling to LdapProviderClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*LdapProviderCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &LdapProviderCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for LdapProvider.
+func (c *LdapProviderClient) Update() *LdapProviderUpdate {
+	mutation := newLdapProviderMutation(c.config, OpUpdate)
+	return &LdapProviderUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *LdapProviderClient) UpdateOne(_m *LdapProvider) *LdapProviderUpdateOne {
+	mutation := newLdapProviderMutation(c.config, OpUpdateOne, withLdapProvider(_m))
+	return &LdapProviderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *LdapProviderClient) UpdateOneID(id uint64) *LdapProviderUpdateOne {
+	mutation := newLdapProviderMutation(c.config, OpUpdateOne, withLdapProviderID(id))
+	return &LdapProviderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for LdapProvider.
+func (c *LdapProviderClient) Delete() *LdapProviderDelete {
+	mutation := newLdapProviderMutation(c.config, OpDelete)
+	return &LdapProviderDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *LdapProviderClient) DeleteOne(_m *LdapProvider) *LdapProviderDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *LdapProviderClient) DeleteOneID(id uint64) *LdapProviderDeleteOne {
+	builder := c.Delete().Where(ldapprovider.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &LdapProviderDeleteOne{builder}
+}
+
+// Query returns a query builder for LdapProvider.
+func (c *LdapProviderClient) Query() *LdapProviderQuery {
+	return &LdapProviderQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeLdapProvider},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a LdapProvider entity by its id.
+func (c *LdapProviderClient) Get(ctx context.Context, id uint64) (*LdapProvider, error) {
+	return c.Query().Where(ldapprovider.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *LdapProviderClient) GetX(ctx context.Context, id uint64) *LdapProvider {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryLdapAccounts queries the ldap_accounts edge of a LdapProvider.
+func (c *LdapProviderClient) QueryLdapAccounts(_m *LdapProvider) *LdapAccountQuery {
+	query := (&LdapAccountClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(ldapprovider.Table, ldapprovider.FieldID, id),
+			sqlgraph.To(ldapaccount.Table, ldapaccount.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ldapprovider.LdapAccountsTable, ldapprovider.LdapAccountsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryLdapDepartments queries the ldap_departments edge of a LdapProvider.
+func (c *LdapProviderClient) QueryLdapDepartments(_m *LdapProvider) *LdapDepartmentQuery {
+	query := (&LdapDepartmentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(ldapprovider.Table, ldapprovider.FieldID, id),
+			sqlgraph.To(ldapdepartment.Table, ldapdepartment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ldapprovider.LdapDepartmentsTable, ldapprovider.LdapDepartmentsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySyncRuns queries the sync_runs edge of a LdapProvider.
+func (c *LdapProviderClient) QuerySyncRuns(_m *LdapProvider) *LdapSyncRunQuery {
+	query := (&LdapSyncRunClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(ldapprovider.Table, ldapprovider.FieldID, id),
+			sqlgraph.To(ldapsyncrun.Table, ldapsyncrun.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ldapprovider.SyncRunsTable, ldapprovider.SyncRunsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *LdapProviderClient) Hooks() []Hook {
+	return c.hooks.LdapProvider
+}
+
+// Interceptors returns the client interceptors.
+func (c *LdapProviderClient) Interceptors() []Interceptor {
+	return c.inters.LdapProvider
+}
+
+func (c *LdapProviderClient) mutate(ctx context.Context, m *LdapProviderMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&LdapProviderCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&LdapProviderUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&LdapProviderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&LdapProviderDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown LdapProvider mutation op: %q", m.Op())
+	}
+}
+
+// LdapSyncRunClient is a client for the LdapSyncRun schema.
+type LdapSyncRunClient struct {
+	config
+}
+
+// NewLdapSyncRunClient returns a client for the LdapSyncRun from the given config.
+func NewLdapSyncRunClient(c config) *LdapSyncRunClient {
+	return &LdapSyncRunClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `ldapsyncrun.Hooks(f(g(h())))`.
+func (c *LdapSyncRunClient) Use(hooks ...Hook) {
+	c.hooks.LdapSyncRun = append(c.hooks.LdapSyncRun, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `ldapsyncrun.Intercept(f(g(h())))`.
+func (c *LdapSyncRunClient) Intercept(interceptors ...Interceptor) {
+	c.inters.LdapSyncRun = append(c.inters.LdapSyncRun, interceptors...)
+}
+
+// Create returns a builder for creating a LdapSyncRun entity.
+func (c *LdapSyncRunClient) Create() *LdapSyncRunCreate {
+	mutation := newLdapSyncRunMutation(c.config, OpCreate)
+	return &LdapSyncRunCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of LdapSyncRun entities.
+func (c *LdapSyncRunClient) CreateBulk(builders ...*LdapSyncRunCreate) *LdapSyncRunCreateBulk {
+	return &LdapSyncRunCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *LdapSyncRunClient) MapCreateBulk(slice any, setFunc func(*LdapSyncRunCreate, int)) *LdapSyncRunCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &LdapSyncRunCreateBulk{err: fmt.Errorf("calling to LdapSyncRunClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*LdapSyncRunCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &LdapSyncRunCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for LdapSyncRun.
+func (c *LdapSyncRunClient) Update() *LdapSyncRunUpdate {
+	mutation := newLdapSyncRunMutation(c.config, OpUpdate)
+	return &LdapSyncRunUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *LdapSyncRunClient) UpdateOne(_m *LdapSyncRun) *LdapSyncRunUpdateOne {
+	mutation := newLdapSyncRunMutation(c.config, OpUpdateOne, withLdapSyncRun(_m))
+	return &LdapSyncRunUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *LdapSyncRunClient) UpdateOneID(id uint64) *LdapSyncRunUpdateOne {
+	mutation := newLdapSyncRunMutation(c.config, OpUpdateOne, withLdapSyncRunID(id))
+	return &LdapSyncRunUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for LdapSyncRun.
+func (c *LdapSyncRunClient) Delete() *LdapSyncRunDelete {
+	mutation := newLdapSyncRunMutation(c.config, OpDelete)
+	return &LdapSyncRunDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *LdapSyncRunClient) DeleteOne(_m *LdapSyncRun) *LdapSyncRunDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *LdapSyncRunClient) DeleteOneID(id uint64) *LdapSyncRunDeleteOne {
+	builder := c.Delete().Where(ldapsyncrun.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &LdapSyncRunDeleteOne{builder}
+}
+
+// Query returns a query builder for LdapSyncRun.
+func (c *LdapSyncRunClient) Query() *LdapSyncRunQuery {
+	return &LdapSyncRunQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeLdapSyncRun},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a LdapSyncRun entity by its id.
+func (c *LdapSyncRunClient) Get(ctx context.Context, id uint64) (*LdapSyncRun, error) {
+	return c.Query().Where(ldapsyncrun.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *LdapSyncRunClient) GetX(ctx context.Context, id uint64) *LdapSyncRun {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryProvider queries the provider edge of a LdapSyncRun.
+func (c *LdapSyncRunClient) QueryProvider(_m *LdapSyncRun) *LdapProviderQuery {
+	query := (&LdapProviderClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(ldapsyncrun.Table, ldapsyncrun.FieldID, id),
+			sqlgraph.To(ldapprovider.Table, ldapprovider.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ldapsyncrun.ProviderTable, ldapsyncrun.ProviderColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *LdapSyncRunClient) Hooks() []Hook {
+	return c.hooks.LdapSyncRun
+}
+
+// Interceptors returns the client interceptors.
+func (c *LdapSyncRunClient) Interceptors() []Interceptor {
+	return c.inters.LdapSyncRun
+}
+
+func (c *LdapSyncRunClient) mutate(ctx context.Context, m *LdapSyncRunMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&LdapSyncRunCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&LdapSyncRunUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&LdapSyncRunUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&LdapSyncRunDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown LdapSyncRun mutation op: %q", m.Op())
+	}
+}
+
+// MenuClient is a client for the Menu schema.
+type MenuClient struct {
+	config
+}
+
+// NewMenuClient returns a client for the Menu from the given config.
+func NewMenuClient(c config) *MenuClient {
+	return &MenuClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `menu.Hooks(f(g(h())))`.
+func (c *MenuClient) Use(hooks ...Hook) {
+	c.hooks.Menu = append(c.hooks.Menu, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `menu.Intercept(f(g(h())))`.
+func (c *MenuClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Menu = append(c.inters.Menu, interceptors...)
+}
+
+// Create returns a builder for creating a Menu entity.
+func (c *MenuClient) Create() *MenuCreate {
+	mutation := newMenuMutation(c.config, OpCreate)
+	return &MenuCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Menu entities.
+func (c *MenuClient) CreateBulk(builders ...*MenuCreate) *MenuCreateBulk {
+	return &MenuCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *MenuClient) MapCreateBulk(slice any, setFunc func(*MenuCreate, int)) *MenuCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &MenuCreateBulk{err: fmt.Errorf("calling to MenuClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*MenuCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &MenuCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Menu.
+func (c *MenuClient) Update() *MenuUpdate {
+	mutation := newMenuMutation(c.config, OpUpdate)
+	return &MenuUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *MenuClient) UpdateOne(_m *Menu) *MenuUpdateOne {
+	mutation := newMenuMutation(c.config, OpUpdateOne, withMenu(_m))
+	return &MenuUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *MenuClient) UpdateOneID(id uint64) *MenuUpdateOne {
+	mutation := newMenuMutation(c.config, OpUpdateOne, withMenuID(id))
+	return &MenuUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Menu.
+func (c *MenuClient) Delete() *MenuDelete {
+	mutation := newMenuMutation(c.config, OpDelete)
+	return &MenuDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *MenuClient) DeleteOne(_m *Menu) *MenuDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *MenuClient) DeleteOneID(id uint64) *MenuDeleteOne {
+	builder := c.Delete().Where(menu.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &MenuDeleteOne{builder}
+}
+
+// Query returns a query builder for Menu.
+func (c *MenuClient) Query() *MenuQuery {
+	return &MenuQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeMenu},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Menu entity by its id.
+func (c *MenuClient) Get(ctx context.Context, id uint64) (*Menu, error) {
+	return c.Query().Where(menu.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *MenuClient) GetX(ctx context.Context, id uint64) *Menu {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryRoles queries the roles edge of a Menu.
+func (c *MenuClient) QueryRoles(_m *Menu) *RoleQuery {
+	query := (&RoleClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(menu.Table, menu.FieldID, id),
+			sqlgraph.To(role.Table, role.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, menu.RolesTable, menu.RolesPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryParent queries the parent edge of a Menu.
+func (c *MenuClient) QueryParent(_m *Menu) *MenuQuery {
+	query := (&MenuClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(menu.Table, menu.FieldID, id),
+			sqlgraph.To(menu.Table, menu.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, menu.ParentTable, menu.ParentColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryChildren queries the children edge of a Menu.
+func (c *MenuClient) QueryChildren(_m *Menu) *MenuQuery {
+	query := (&MenuClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(menu.Table, menu.FieldID, id),
+			sqlgraph.To(menu.Table, menu.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, menu.ChildrenTable, menu.ChildrenColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *MenuClient) Hooks() []Hook {
+	return c.hooks.Menu
+}
+
+// Interceptors returns the client interceptors.
+func (c *MenuClient) Interceptors() []Interceptor {
+	return c.inters.Menu
+}
+
+func (c *MenuClient) mutate(ctx context.Context, m *MenuMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&MenuCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&MenuUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&MenuUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&MenuDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Menu mutation op: %q", m.Op())
+	}
+}
+
+// OauthAccountClient is a client for the OauthAccount schema.
+type OauthAccountClient struct {
+	config
+}
+
+// NewOauthAccountClient returns a client for the OauthAccount from the given config.
+func NewOauthAccountClient(c config) *OauthAccountClient {
+	return &OauthAccountClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `oauthaccount.Hooks(f(g(h())))`.
+func (c *OauthAccountClient) Use(hooks ...Hook) {
+	c.hooks.OauthAccount = append(c.hooks.OauthAccount, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `oauthaccount.Intercept(f(g(h())))`.
+func (c *OauthAccountClient) Intercept(interceptors ...Interceptor) {
+	c.inters.OauthAccount = append(c.inters.OauthAccount, interceptors...)
+}
+
+// Create returns a builder for creating a OauthAccount entity.
+func (c *OauthAccountClient) Create() *OauthAccountCreate {
+	mutation := newOauthAccountMutation(c.config, OpCreate)
+	return &OauthAccountCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of OauthAccount entities.
+func (c *OauthAccountClient) CreateBulk(builders ...*OauthAccountCreate) *OauthAccountCreateBulk {
+	return &OauthAccountCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *OauthAccountClient) MapCreateBulk(slice any, setFunc func(*OauthAccountCreate, int)) *OauthAccountCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &OauthAccountCreateBulk{err: fmt.Errorf("calling to OauthAccountClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*OauthAccountCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &OauthAccountCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for OauthAccount.
+func (c *OauthAccountClient) Update() *OauthAccountUpdate {
+	mutation := newOauthAccountMutation(c.config, OpUpdate)
+	return &OauthAccountUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *OauthAccountClient) UpdateOne(_m *OauthAccount) *OauthAccountUpdateOne {
+	mutation := newOauthAccountMutation(c.config, OpUpdateOne, withOauthAccount(_m))
+	return &OauthAccountUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *OauthAccountClient) UpdateOneID(id uint64) *OauthAccountUpdateOne {
+	mutation := newOauthAccountMutation(c.config, OpUpdateOne, withOauthAccountID(id))
+	return &OauthAccountUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for OauthAccount.
+func (c *OauthAccountClient) Delete() *OauthAccountDelete {
+	mutation := newOauthAccountMutation(c.config, OpDelete)
+	return &OauthAccountDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *OauthAccountClient) DeleteOne(_m *OauthAccount) *OauthAccountDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *OauthAccountClient) DeleteOneID(id uint64) *OauthAccountDeleteOne {
+	builder := c.Delete().Where(oauthaccount.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &OauthAccountDeleteOne{builder}
+}
+
+// Query returns a query builder for OauthAccount.
+func (c *OauthAccountClient) Query() *OauthAccountQuery {
+	return &OauthAccountQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeOauthAccount},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a OauthAccount entity by its id.
+func (c *OauthAccountClient) Get(ctx context.Context, id uint64) (*OauthAccount, error) {
+	return c.Query().Where(oauthaccount.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *OauthAccountClient) GetX(ctx context.Context, id uint64) *OauthAccount {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a OauthAccount.
+func (c *OauthAccountClient) QueryUser(_m *OauthAccount) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(oauthaccount.Table, oauthaccount.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, oauthaccount.UserTable, oauthaccount.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryProvider queries the provider edge of a OauthAccount.
+func (c *OauthAccountClient) QueryProvider(_m *OauthAccount) *OauthProviderQuery {
+	query := (&OauthProviderClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(oauthaccount.Table, oauthaccount.FieldID, id),
+			sqlgraph.To(oauthprovider.Table, oauthprovider.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, oauthaccount.ProviderTable, oauthaccount.ProviderColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *OauthAccountClient) Hooks() []Hook {
+	return c.hooks.OauthAccount
+}
+
+// Interceptors returns the client interceptors.
+func (c *OauthAccountClient) Interceptors() []Interceptor {
+	return c.inters.OauthAccount
+}
+
+func (c *OauthAccountClient) mutate(ctx context.Context, m *OauthAccountMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&OauthAccountCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&OauthAccountUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&OauthAccountUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&OauthAccountDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown OauthAccount mutation op: %q", m.Op())
+	}
+}
+
+// OauthClientClient is a client for the OauthClient schema.
+type OauthClientClient struct {
+	config
+}
+
+// NewOauthClientClient returns a client for the OauthClient from the given config.
+func NewOauthClientClient(c config) *OauthClientClient {
+	return &OauthClientClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `oauthclient.Hooks(f(g(h())))`.
+func (c *OauthClientClient) Use(hooks ...Hook) {
+	c.hooks.OauthClient = append(c.hooks.OauthClient, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `oauthclient.Intercept(f(g(h())))`.
+func (c *OauthClientClient) Intercept(interceptors ...Interceptor) {
+	c.inters.OauthClient = append(c.inters.OauthClient, interceptors...)
+}
+
+// Create returns a builder for creating a OauthClient entity.
+func (c *OauthClientClient) Create() *OauthClientCreate {
+	mutation := newOauthClientMutation(c.config, OpCreate)
+	return &OauthClientCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of OauthClient entities.
+func (c *OauthClientClient) CreateBulk(builders ...*OauthClientCreate) *OauthClientCreateBulk {
+	return &OauthClientCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *OauthClientClient) MapCreateBulk(slice any, setFunc func(*OauthClientCreate, int)) *OauthClientCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &OauthClientCreateBulk{err: fmt.Errorf("calling to OauthClientClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*OauthClientCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &OauthClientCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for OauthClient.
+func (c *OauthClientClient) Update() *OauthClientUpdate {
+	mutation := newOauthClientMutation(c.config, OpUpdate)
+	return &OauthClientUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *OauthClientClient) UpdateOne(_m *OauthClient) *OauthClientUpdateOne {
+	mutation := newOauthClientMutation(c.config, OpUpdateOne, withOauthClient(_m))
+	return &OauthClientUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *OauthClientClient) UpdateOneID(id uint64) *OauthClientUpdateOne {
+	mutation := newOauthClientMutation(c.config, OpUpdateOne, withOauthClientID(id))
+	return &OauthClientUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for OauthClient.
+func (c *OauthClientClient) Delete() *OauthClientDelete {
+	mutation := newOauthClientMutation(c.config, OpDelete)
+	return &OauthClientDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *OauthClientClient) DeleteOne(_m *OauthClient) *OauthClientDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *OauthClientClient) DeleteOneID(id uint64) *OauthClientDeleteOne {
+	builder := c.Delete().Where(oauthclient.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &OauthClientDeleteOne{builder}
+}
+
+// Query returns a query builder for OauthClient.
+func (c *OauthClientClient) Query() *OauthClientQuery {
+	return &OauthClientQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeOauthClient},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a OauthClient entity by its id.
+func (c *OauthClientClient) Get(ctx context.Context, id uint64) (*OauthClient, error) {
+	return c.Query().Where(oauthclient.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *OauthClientClient) GetX(ctx context.Context, id uint64) *OauthClient {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *OauthClientClient) Hooks() []Hook {
+	return c.hooks.OauthClient
+}
+
+// Interceptors returns the client interceptors.
+func (c *OauthClientClient) Interceptors() []Interceptor {
+	return c.inters.OauthClient
+}
+
+func (c *OauthClientClient) mutate(ctx context.Context, m *OauthClientMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&OauthClientCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&OauthClientUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&OauthClientUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&OauthClientDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown OauthClient mutation op: %q", m.Op())
+	}
+}
+
+// OauthConsentClient is a client for the OauthConsent schema.
+type OauthConsentClient struct {
+	config
+}
+
+// NewOauthConsentClient returns a client for the OauthConsent from the given config.
+func NewOauthConsentClient(c config) *OauthConsentClient {
+	return &OauthConsentClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `oauthconsent.Hooks(f(g(h())))`.
+func (c *OauthConsentClient) Use(hooks ...Hook) {
+	c.hooks.OauthConsent = append(c.hooks.OauthConsent, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `oauthconsent.Intercept(f(g(h())))`.
+func (c *OauthConsentClient) Intercept(interceptors ...Interceptor) {
+	c.inters.OauthConsent = append(c.inters.OauthConsent, interceptors...)
+}
+
+// Create returns a builder for creating a OauthConsent entity.
+func (c *OauthConsentClient) Create() *OauthConsentCreate {
+	mutation := newOauthConsentMutation(c.config, OpCreate)
+	return &OauthConsentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of OauthConsent entities.
+func (c *OauthConsentClient) CreateBulk(builders ...*OauthConsentCreate) *OauthConsentCreateBulk {
+	return &OauthConsentCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *OauthConsentClient) MapCreateBulk(slice any, setFunc func(*OauthConsentCreate, int)) *OauthConsentCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &OauthConsentCreateBulk{err: fmt.Errorf("calling to OauthConsentClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*OauthConsentCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &OauthConsentCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for OauthConsent.
+func (c *OauthConsentClient) Update() *OauthConsentUpdate {
+	mutation := newOauthConsentMutation(c.config, OpUpdate)
+	return &OauthConsentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *OauthConsentClient) UpdateOne(_m *OauthConsent) *OauthConsentUpdateOne {
+	mutation := newOauthConsentMutation(c.config, OpUpdateOne, withOauthConsent(_m))
+	return &OauthConsentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *OauthConsentClient) UpdateOneID(id uint64) *OauthConsentUpdateOne {
+	mutation := newOauthConsentMutation(c.config, OpUpdateOne, withOauthConsentID(id))
+	return &OauthConsentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for OauthConsent.
+func (c *OauthConsentClient) Delete() *OauthConsentDelete {
+	mutation := newOauthConsentMutation(c.config, OpDelete)
+	return &OauthConsentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *OauthConsentClient) DeleteOne(_m *OauthConsent) *OauthConsentDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *OauthConsentClient) DeleteOneID(id uint64) *OauthConsentDeleteOne {
+	builder := c.Delete().Where(oauthconsent.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &OauthConsentDeleteOne{builder}
+}
+
+// Query returns a query builder for OauthConsent.
+func (c *OauthConsentClient) Query() *OauthConsentQuery {
+	return &OauthConsentQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeOauthConsent},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a OauthConsent entity by its id.
+func (c *OauthConsentClient) Get(ctx context.Context, id uint64) (*OauthConsent, error) {
+	return c.Query().Where(oauthconsent.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *OauthConsentClient) GetX(ctx context.Context, id uint64) *OauthConsent {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *OauthConsentClient) Hooks() []Hook {
+	return c.hooks.OauthConsent
+}
+
+// Interceptors returns the client interceptors.
+func (c *OauthConsentClient) Interceptors() []Interceptor {
+	return c.inters.OauthConsent
+}
+
+func (c *OauthConsentClient) mutate(ctx context.Context, m *OauthConsentMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&OauthConsentCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&OauthConsentUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&OauthConsentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&OauthConsentDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown OauthConsent mutation op: %q", m.Op())
+	}
+}
+
+// OauthProviderClient is a client for the OauthProvider schema.
+type OauthProviderClient struct {
+	config
+}
+
+// NewOauthProviderClient returns a client for the OauthProvider from the given config.
+func NewOauthProviderClient(c config) *OauthProviderClient {
+	return &OauthProviderClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `oauthprovider.Hooks(f(g(h())))`.
+func (c *OauthProviderClient) Use(hooks ...Hook) {
+	c.hooks.OauthProvider = append(c.hooks.OauthProvider, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `oauthprovider.Intercept(f(g(h())))`.
+func (c *OauthProviderClient) Intercept(interceptors ...Interceptor) {
+	c.inters.OauthProvider = append(c.inters.OauthProvider, interceptors...)
+}
+
+// Create returns a builder for creating a OauthProvider entity.
+func (c *OauthProviderClient) Create() *OauthProviderCreate {
+	mutation := newOauthProviderMutation(c.config, OpCreate)
+	return &OauthProviderCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of OauthProvider entities.
+func (c *OauthProviderClient) CreateBulk(builders ...*OauthProviderCreate) *OauthProviderCreateBulk {
+	return &OauthProviderCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *OauthProviderClient) MapCreateBulk(slice any, setFunc func(*OauthProviderCreate, int)) *OauthProviderCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &OauthProviderCreateBulk{err: fmt.Errorf("calling to OauthProviderClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*OauthProviderCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &OauthProviderCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for OauthProvider.
+func (c *OauthProviderClient) Update() *OauthProviderUpdate {
+	mutation := newOauthProviderMutation(c.config, OpUpdate)
+	return &OauthProviderUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *OauthProviderClient) UpdateOne(_m *OauthProvider) *OauthProviderUpdateOne {
+	mutation := newOauthProviderMutation(c.config, OpUpdateOne, withOauthProvider(_m))
+	return &OauthProviderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *OauthProviderClient) UpdateOneID(id uint64) *OauthProviderUpdateOne {
+	mutation := newOauthProviderMutation(c.config, OpUpdateOne, withOauthProviderID(id))
+	return &OauthProviderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for OauthProvider.
+func (c *OauthProviderClient) Delete() *OauthProviderDelete {
+	mutation := newOauthProviderMutation(c.config, OpDelete)
+	return &OauthProviderDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *OauthProviderClient) DeleteOne(_m *OauthProvider) *OauthProviderDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *OauthProviderClient) DeleteOneID(id uint64) *OauthProviderDeleteOne {
+	builder := c.Delete().Where(oauthprovider.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &OauthProviderDeleteOne{builder}
+}
+
+// Query returns a query builder for OauthProvider.
+func (c *OauthProviderClient) Query() *OauthProviderQuery {
+	return &OauthProviderQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeOauthProvider},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a OauthProvider entity by its id.
+func (c *OauthProviderClient) Get(ctx context.Context, id uint64) (*OauthProvider, error) {
+	return c.Query().Where(oauthprovider.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *OauthProviderClient) GetX(ctx context.Context, id uint64) *OauthProvider {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryOauthAccounts queries the oauth_accounts edge of a OauthProvider.
+func (c *OauthProviderClient) QueryOauthAccounts(_m *OauthProvider) *OauthAccountQuery {
+	query := (&OauthAccountClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(oauthprovider.Table, oauthprovider.FieldID, id),
+			sqlgraph.To(oauthaccount.Table, oauthaccount.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, oauthprovider.OauthAccountsTable, oauthprovider.OauthAccountsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryOauthSessions queries the oauth_sessions edge of a OauthProvider.
+func (c *OauthProviderClient) QueryOauthSessions(_m *OauthProvider) *OauthSessionQuery {
+	query := (&OauthSessionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(oauthprovider.Table, oauthprovider.FieldID, id),
+			sqlgraph.To(oauthsession.Table, oauthsession.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, oauthprovider.OauthSessionsTable, oauthprovider.OauthSessionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTemplate queries the template edge of a OauthProvider.
+func (c *OauthProviderClient) QueryTemplate(_m *OauthProvider) *OauthProviderTemplateQuery {
+	query := (&OauthProviderTemplateClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(oauthprovider.Table, oauthprovider.FieldID, id),
+			sqlgraph.To(oauthprovidertemplate.Table, oauthprovidertemplate.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, oauthprovider.TemplateTable, oauthprovider.TemplateColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *OauthProviderClient) Hooks() []Hook {
+	return c.hooks.OauthProvider
+}
+
+// Interceptors returns the client interceptors.
+func (c *OauthProviderClient) Interceptors() []Interceptor {
+	return c.inters.OauthProvider
+}
+
+func (c *OauthProviderClient) mutate(ctx context.Context, m *OauthProviderMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&OauthProviderCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&OauthProviderUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&OauthProviderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&OauthProviderDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown OauthProvider mutation op: %q", m.Op())
+	}
+}
+
+// OauthProviderTemplateClient is a client for the OauthProviderTemplate schema.
+type OauthProviderTemplateClient struct {
+	config
+}
+
+// NewOauthProviderTemplateClient returns a client for the OauthProviderTemplate from the given config.
+func NewOauthProviderTemplateClient(c config) *OauthProviderTemplateClient {
+	return &OauthProviderTemplateClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `oauthprovidertemplate.Hooks(f(g(h())))`.
+func (c *OauthProviderTemplateClient) Use(hooks ...Hook) {
+	c.hooks.OauthProviderTemplate = append(c.hooks.OauthProviderTemplate, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `oauthprovidertemplate.Intercept(f(g(h())))`.
+func (c *OauthProviderTemplateClient) Intercept(interceptors ...Interceptor) {
+	c.inters.OauthProviderTemplate = append(c.inters.OauthProviderTemplate, interceptors...)
+}
+
+// Create returns a builder for creating a OauthProviderTemplate entity.
+func (c *OauthProviderTemplateClient) Create() *OauthProviderTemplateCreate {
+	mutation := newOauthProviderTemplateMutation(c.config, OpCreate)
+	return &OauthProviderTemplateCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of OauthProviderTemplate entities.
+func (c *OauthProviderTemplateClient) CreateBulk(builders ...*OauthProviderTemplateCreate) *OauthProviderTemplateCreateBulk {
+	return &OauthProviderTemplateCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *OauthProviderTemplateClient) MapCreateBulk(slice any, setFunc func(*OauthProviderTemplateCreate, int)) *OauthProviderTemplateCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &OauthProviderTemplateCreateBulk{err: fmt.Errorf("calling to OauthProviderTemplateClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*OauthProviderTemplateCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &OauthProviderTemplateCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for OauthProviderTemplate.
+func (c *OauthProviderTemplateClient) Update() *OauthProviderTemplateUpdate {
+	mutation := newOauthProviderTemplateMutation(c.config, OpUpdate)
+	return &OauthProviderTemplateUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *OauthProviderTemplateClient) UpdateOne(_m *OauthProviderTemplate) *OauthProviderTemplateUpdateOne {
+	mutation := newOauthProviderTemplateMutation(c.config, OpUpdateOne, withOauthProviderTemplate(_m))
+	return &OauthProviderTemplateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *OauthProviderTemplateClient) UpdateOneID(id uint64) *OauthProviderTemplateUpdateOne {
+	mutation := newOauthProviderTemplateMutation(c.config, OpUpdateOne, withOauthProviderTemplateID(id))
+	return &OauthProviderTemplateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for OauthProviderTemplate.
+func (c *OauthProviderTemplateClient) Delete() *OauthProviderTemplateDelete {
+	mutation := newOauthProviderTemplateMutation(c.config, OpDelete)
+	return &OauthProviderTemplateDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *OauthProviderTemplateClient) DeleteOne(_m *OauthProviderTemplate) *OauthProviderTemplateDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *OauthProviderTemplateClient) DeleteOneID(id uint64) *OauthProviderTemplateDeleteOne {
+	builder := c.Delete().Where(oauthprovidertemplate.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &OauthProviderTemplateDeleteOne{builder}
+}
+
+// Query returns a query builder for OauthProviderTemplate.
+func (c *OauthProviderTemplateClient) Query() *OauthProviderTemplateQuery {
+	return &OauthProviderTemplateQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeOauthProviderTemplate},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a OauthProviderTemplate entity by its id.
+func (c *OauthProviderTemplateClient) Get(ctx context.Context, id uint64) (*OauthProviderTemplate, error) {
+	return c.Query().Where(oauthprovidertemplate.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *OauthProviderTemplateClient) GetX(ctx context.Context, id uint64) *OauthProviderTemplate {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryOauthProviders queries the oauth_providers edge of a OauthProviderTemplate.
+func (c *OauthProviderTemplateClient) QueryOauthProviders(_m *OauthProviderTemplate) *OauthProviderQuery {
+	query := (&OauthProviderClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(oauthprovidertemplate.Table, oauthprovidertemplate.FieldID, id),
+			sqlgraph.To(oauthprovider.Table, oauthprovider.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, oauthprovidertemplate.OauthProvidersTable, oauthprovidertemplate.OauthProvidersColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *OauthProviderTemplateClient) Hooks() []Hook {
+	return c.hooks.OauthProviderTemplate
+}
+
+// Interceptors returns the client interceptors.
+func (c *OauthProviderTemplateClient) Interceptors() []Interceptor {
+	return c.inters.OauthProviderTemplate
+}
+
+func (c *OauthProviderTemplateClient) mutate(ctx context.Context, m *OauthProviderTemplateMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&OauthProviderTemplateCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&OauthProviderTemplateUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&OauthProviderTemplateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&OauthProviderTemplateDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown OauthProviderTemplate mutation op: %q", m.Op())
+	}
+}
+
+// OauthScopeClient is a client for the OauthScope schema.
+type OauthScopeClient struct {
+	config
+}
+
+// NewOauthScopeClient returns a client for the OauthScope from the given config.
+func NewOauthScopeClient(c config) *OauthScopeClient {
+	return &OauthScopeClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `oauthscope.Hooks(f(g(h())))`.
+func (c *OauthScopeClient) Use(hooks ...Hook) {
+	c.hooks.OauthScope = append(c.hooks.OauthScope, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `oauthscope.Intercept(f(g(h())))`.
+func (c *OauthScopeClient) Intercept(interceptors ...Interceptor) {
+	c.inters.OauthScope = append(c.inters.OauthScope, interceptors...)
+}
+
+// Create returns a builder for creating a OauthScope entity.
+func (c *OauthScopeClient) Create() *OauthScopeCreate {
+	mutation := newOauthScopeMutation(c.config, OpCreate)
+	return &OauthScopeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of OauthScope entities.
+func (c *OauthScopeClient) CreateBulk(builders ...*OauthScopeCreate) *OauthScopeCreateBulk {
+	return &OauthScopeCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *OauthScopeClient) MapCreateBulk(slice any, setFunc func(*OauthScopeCreate, int)) *OauthScopeCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &OauthScopeCreateBulk{err: fmt.Errorf("calling to OauthScopeClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*OauthScopeCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &OauthScopeCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for OauthScope.
+func (c *OauthScopeClient) Update() *OauthScopeUpdate {
+	mutation := newOauthScopeMutation(c.config, OpUpdate)
+	return &OauthScopeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *OauthScopeClient) UpdateOne(_m *OauthScope) *OauthScopeUpdateOne {
+	mutation := newOauthScopeMutation(c.config, OpUpdateOne, withOauthScope(_m))
+	return &OauthScopeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *OauthScopeClient) UpdateOneID(id uint64) *OauthScopeUpdateOne {
+	mutation := newOauthScopeMutation(c.config, OpUpdateOne, withOauthScopeID(id))
+	return &OauthScopeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for OauthScope.
+func (c *OauthScopeClient) Delete() *OauthScopeDelete {
+	mutation := newOauthScopeMutation(c.config, OpDelete)
+	return &OauthScopeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *OauthScopeClient) DeleteOne(_m *OauthScope) *OauthScopeDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *OauthScopeClient) DeleteOneID(id uint64) *OauthScopeDeleteOne {
+	builder := c.Delete().Where(oauthscope.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &OauthScopeDeleteOne{builder}
+}
+
+// Query returns a query builder for OauthScope.
+func (c *OauthScopeClient) Query() *OauthScopeQuery {
+	return &OauthScopeQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeOauthScope},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a OauthScope entity by its id.
+func (c *OauthScopeClient) Get(ctx context.Context, id uint64) (*OauthScope, error) {
+	return c.Query().Where(oauthscope.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *OauthScopeClient) GetX(ctx context.Context, id uint64) *OauthScope {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *OauthScopeClient) Hooks() []Hook {
+	return c.hooks.OauthScope
+}
+
+// Interceptors returns the client interceptors.
+func (c *OauthScopeClient) Interceptors() []Interceptor {
+	return c.inters.OauthScope
+}
+
+func (c *OauthScopeClient) mutate(ctx context.Context, m *OauthScopeMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&OauthScopeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&OauthScopeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&OauthScopeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&OauthScopeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown OauthScope mutation op: %q", m.Op())
+	}
+}
+
+// OauthSessionClient is a client for the OauthSession schema.
+type OauthSessionClient struct {
+	config
+}
+
+// NewOauthSessionClient returns a client for the OauthSession from the given config.
+func NewOauthSessionClient(c config) *OauthSessionClient {
+	return &OauthSessionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `oauthsession.Hooks(f(g(h())))`.
+func (c *OauthSessionClient) Use(hooks ...Hook) {
+	c.hooks.OauthSession = append(c.hooks.OauthSession, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `oauthsession.Intercept(f(g(h())))`.
+func (c *OauthSessionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.OauthSession = append(c.inters.OauthSession, interceptors...)
+}
+
+// Create returns a builder for creating a OauthSession entity.
+func (c *OauthSessionClient) Create() *OauthSessionCreate {
+	mutation := newOauthSessionMutation(c.config, OpCreate)
+	return &OauthSessionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of OauthSession entities.
+func (c *OauthSessionClient) CreateBulk(builders ...*OauthSessionCreate) *OauthSessionCreateBulk {
+	return &OauthSessionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *OauthSessionClient) MapCreateBulk(slice any, setFunc func(*OauthSessionCreate, int)) *OauthSessionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &OauthSessionCreateBulk{err: fmt.Errorf("calling to OauthSessionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*OauthSessionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &OauthSessionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for OauthSession.
+func (c *OauthSessionClient) Update() *OauthSessionUpdate {
+	mutation := newOauthSessionMutation(c.config, OpUpdate)
+	return &OauthSessionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *OauthSessionClient) UpdateOne(_m *OauthSession) *OauthSessionUpdateOne {
+	mutation := newOauthSessionMutation(c.config, OpUpdateOne, withOauthSession(_m))
+	return &OauthSessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *OauthSessionClient) UpdateOneID(id uint64) *OauthSessionUpdateOne {
+	mutation := newOauthSessionMutation(c.config, OpUpdateOne, withOauthSessionID(id))
+	return &OauthSessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for OauthSession.
+func (c *OauthSessionClient) Delete() *OauthSessionDelete {
+	mutation := newOauthSessionMutation(c.config, OpDelete)
+	return &OauthSessionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *OauthSessionClient) DeleteOne(_m *OauthSession) *OauthSessionDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *OauthSessionClient) DeleteOneID(id uint64) *OauthSessionDeleteOne {
+	builder := c.Delete().Where(oauthsession.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &OauthSessionDeleteOne{builder}
+}
+
+// Query returns a query builder for OauthSession.
+func (c *OauthSessionClient) Query() *OauthSessionQuery {
+	return &OauthSessionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeOauthSession},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a OauthSession entity by its id.
+func (c *OauthSessionClient) Get(ctx context.Context, id uint64) (*OauthSession, error) {
+	return c.Query().Where(oauthsession.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *OauthSessionClient) GetX(ctx context.Context, id uint64) *OauthSession {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryProvider queries the provider edge of a OauthSession.
+func (c *OauthSessionClient) QueryProvider(_m *OauthSession) *OauthProviderQuery {
+	query := (&OauthProviderClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(oauthsession.Table, oauthsession.FieldID, id),
+			sqlgraph.To(oauthprovider.Table, oauthprovider.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, oauthsession.ProviderTable, oauthsession.ProviderColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryUser queries the user edge of a OauthSession.
+func (c *OauthSessionClient) QueryUser(_m *OauthSession) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(oauthsession.Table, oauthsession.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, oauthsession.UserTable, oauthsession.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *OauthSessionClient) Hooks() []Hook {
+	return c.hooks.OauthSession
+}
+
+// Interceptors returns the client interceptors.
+func (c *OauthSessionClient) Interceptors() []Interceptor {
+	return c.inters.OauthSession
+}
+
+func (c *OauthSessionClient) mutate(ctx context.Context, m *OauthSessionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&OauthSessionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&OauthSessionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&OauthSessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&OauthSessionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown OauthSession mutation op: %q", m.Op())
+	}
+}
+
+// PositionClient is a client for the Position schema.
+type PositionClient struct {
+	config
+}
+
+// NewPositionClient returns a client for the Position from the given config.
+func NewPositionClient(c config) *PositionClient {
+	return &PositionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `position.Hooks(f(g(h())))`.
+func (c *PositionClient) Use(hooks ...Hook) {
+	c.hooks.Position = append(c.hooks.Position, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `position.Intercept(f(g(h())))`.
+func (c *PositionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Position = append(c.inters.Position, interceptors...)
+}
+
+// Create returns a builder for creating a Position entity.
+func (c *PositionClient) Create() *PositionCreate {
+	mutation := newPositionMutation(c.config, OpCreate)
+	return &PositionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Position entities.
+func (c *PositionClient) CreateBulk(builders ...*PositionCreate) *PositionCreateBulk {
+	return &PositionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PositionClient) MapCreateBulk(slice any, setFunc func(*PositionCreate, int)) *PositionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PositionCreateBulk{err: fmt.Errorf("calling to PositionClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*DictionaryCreate, rv.Len())
+	builders := make([]*PositionCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &DictionaryCreateBulk{config: c.config, builders: builders}
+	return &PositionCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Dictionary.
-func (c *DictionaryClient) Update() *DictionaryUpdate {
-	mutation := newDictionaryMutation(c.config, OpUpdate)
-	return &DictionaryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Position.
+func (c *PositionClient) Update() *PositionUpdate {
+	mutation := newPositionMutation(c.config, OpUpdate)
+	return &PositionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *DictionaryClient) UpdateOne(_m *Dictionary) *DictionaryUpdateOne {
-	mutation := newDictionaryMutation(c.config, OpUpdateOne, withDictionary(_m))
-	return &DictionaryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *PositionClient) UpdateOne(_m *Position) *PositionUpdateOne {
+	mutation := newPositionMutation(c.config, OpUpdateOne, withPosition(_m))
+	return &PositionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *DictionaryClient) UpdateOneID(id uint64) *DictionaryUpdateOne {
-	mutation := newDictionaryMutation(c.config, OpUpdateOne, withDictionaryID(id))
-	return &DictionaryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *PositionClient) UpdateOneID(id uint64) *PositionUpdateOne {
+	mutation := newPositionMutation(c.config, OpUpdateOne, withPositionID(id))
+	return &PositionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Dictionary.
-func (c *DictionaryClient) Delete() *DictionaryDelete {
-	mutation := newDictionaryMutation(c.config, OpDelete)
-	return &DictionaryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Position.
+func (c *PositionClient) Delete() *PositionDelete {
+	mutation := newPositionMutation(c.config, OpDelete)
+	return &PositionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *DictionaryClient) DeleteOne(_m *Dictionary) *DictionaryDeleteOne {
+func (c *PositionClient) DeleteOne(_m *Position) *PositionDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *DictionaryClient) DeleteOneID(id uint64) *DictionaryDeleteOne {
-	builder := c.Delete().Where(dictionary.ID(id))
+func (c *PositionClient) DeleteOneID(id uint64) *PositionDeleteOne {
+	builder := c.Delete().Where(position.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &DictionaryDeleteOne{builder}
+	return &PositionDeleteOne{builder}
 }
 
-// Query returns a query builder for Dictionary.
-func (c *DictionaryClient) Query() *DictionaryQuery {
-	return &DictionaryQuery{
+// Query returns a query builder for Position.
+func (c *PositionClient) Query() *PositionQuery {
+	return &PositionQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeDictionary},
+		ctx:    &QueryContext{Type: TypePosition},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Dictionary entity by its id.
-func (c *DictionaryClient) Get(ctx context.Context, id uint64) (*Dictionary, error) {
-	return c.Query().Where(dictionary.ID(id)).Only(ctx)
+// Get returns a Position entity by its id.
+func (c *PositionClient) Get(ctx context.Context, id uint64) (*Position, error) {
+	return c.Query().Where(position.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *DictionaryClient) GetX(ctx context.Context, id uint64) *Dictionary {
+func (c *PositionClient) GetX(ctx context.Context, id uint64) *Position {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1169,15 +4178,31 @@ func (c *DictionaryClient) GetX(ctx context.Context, id uint64) *Dictionary {
 	return obj
 }
 
-// QueryDictionaryDetails queries the dictionary_details edge of a Dictionary.
-func (c *DictionaryClient) QueryDictionaryDetails(_m *Dictionary) *DictionaryDetailQuery {
-	query := (&DictionaryDetailClient{config: c.config}).Query()
+// QueryUsers queries the users edge of a Position.
+func (c *PositionClient) QueryUsers(_m *Position) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(dictionary.Table, dictionary.FieldID, id),
-			sqlgraph.To(dictionarydetail.Table, dictionarydetail.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, dictionary.DictionaryDetailsTable, dictionary.DictionaryDetailsColumn),
+			sqlgraph.From(position.Table, position.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, position.UsersTable, position.UsersPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryDepartments queries the departments edge of a Position.
+func (c *PositionClient) QueryDepartments(_m *Position) *DepartmentQuery {
+	query := (&DepartmentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(position.Table, position.FieldID, id),
+			sqlgraph.To(department.Table, department.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, position.DepartmentsTable, position.DepartmentsColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -1186,131 +4211,131 @@ func (c *DictionaryClient) QueryDictionaryDetails(_m *Dictionary) *DictionaryDet
 }
 
 // Hooks returns the client hooks.
-func (c *DictionaryClient) Hooks() []Hook {
-	return c.hooks.Dictionary
+func (c *PositionClient) Hooks() []Hook {
+	return c.hooks.Position
 }
 
 // Interceptors returns the client interceptors.
-func (c *DictionaryClient) Interceptors() []Interceptor {
-	return c.inters.Dictionary
+func (c *PositionClient) Interceptors() []Interceptor {
+	return c.inters.Position
 }
 
-func (c *DictionaryClient) mutate(ctx context.Context, m *DictionaryMutation) (Value, error) {
+func (c *PositionClient) mutate(ctx context.Context, m *PositionMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&DictionaryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&PositionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&DictionaryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&PositionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&DictionaryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&PositionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&DictionaryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&PositionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Dictionary mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown Position mutation op: %q", m.Op())
 	}
 }
 
-// DictionaryDetailClient is a client for the DictionaryDetail schema.
-type DictionaryDetailClient struct {
+// RoleClient is a client for the Role schema.
+type RoleClient struct {
 	config
 }
 
-// NewDictionaryDetailClient returns a client for the DictionaryDetail from the given config.
-func NewDictionaryDetailClient(c config) *DictionaryDetailClient {
-	return &DictionaryDetailClient{config: c}
+// NewRoleClient returns a client for the Role from the given config.
+func NewRoleClient(c config) *RoleClient {
+	return &RoleClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `dictionarydetail.Hooks(f(g(h())))`.
-func (c *DictionaryDetailClient) Use(hooks ...Hook) {
-	c.hooks.DictionaryDetail = append(c.hooks.DictionaryDetail, hooks...)
+// A call to `Use(f, g, h)` equals to `role.Hooks(f(g(h())))`.
+func (c *RoleClient) Use(hooks ...Hook) {
+	c.hooks.Role = append(c.hooks.Role, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `dictionarydetail.Intercept(f(g(h())))`.
-func (c *DictionaryDetailClient) Intercept(interceptors ...Interceptor) {
-	c.inters.DictionaryDetail = append(c.inters.DictionaryDetail, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `role.Intercept(f(g(h())))`.
+func (c *RoleClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Role = append(c.inters.Role, interceptors...)
 }
 
-// Create returns a builder for creating a DictionaryDetail entity.
-func (c *DictionaryDetailClient) Create() *DictionaryDetailCreate {
-	mutation := newDictionaryDetailMutation(c.config, OpCreate)
-	return &DictionaryDetailCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Role entity.
+func (c *RoleClient) Create() *RoleCreate {
+	mutation := newRoleMutation(c.config, OpCreate)
+	return &RoleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of DictionaryDetail entities.
-func (c *DictionaryDetailClient) CreateBulk(builders ...*DictionaryDetailCreate) *DictionaryDetailCreateBulk {
-	return &DictionaryDetailCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Role entities.
+func (c *RoleClient) CreateBulk(builders ...*RoleCreate) *RoleCreateBulk {
+	return &RoleCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *DictionaryDetailClient) MapCreateBulk(slice any, setFunc func(*DictionaryDetailCreate, int)) *DictionaryDetailCreateBulk {
+func (c *RoleClient) MapCreateBulk(slice any, setFunc func(*RoleCreate, int)) *RoleCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &DictionaryDetailCreateBulk{err: fmt.Errorf("calling to DictionaryDetailClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &RoleCreateBulk{err: fmt.Errorf("calling to RoleClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*DictionaryDetailCreate, rv.Len())
+	builders := make([]*RoleCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &DictionaryDetailCreateBulk{config: c.config, builders: builders}
+	return &RoleCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for DictionaryDetail.
-func (c *DictionaryDetailClient) Update() *DictionaryDetailUpdate {
-	mutation := newDictionaryDetailMutation(c.config, OpUpdate)
-	return &DictionaryDetailUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Role.
+func (c *RoleClient) Update() *RoleUpdate {
+	mutation := newRoleMutation(c.config, OpUpdate)
+	return &RoleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *DictionaryDetailClient) UpdateOne(_m *DictionaryDetail) *DictionaryDetailUpdateOne {
-	mutation := newDictionaryDetailMutation(c.config, OpUpdateOne, withDictionaryDetail(_m))
-	return &DictionaryDetailUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *RoleClient) UpdateOne(_m *Role) *RoleUpdateOne {
+	mutation := newRoleMutation(c.config, OpUpdateOne, withRole(_m))
+	return &RoleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *DictionaryDetailClient) UpdateOneID(id uint64) *DictionaryDetailUpdateOne {
-	mutation := newDictionaryDetailMutation(c.config, OpUpdateOne, withDictionaryDetailID(id))
-	return &DictionaryDetailUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *RoleClient) UpdateOneID(id uint64) *RoleUpdateOne {
+	mutation := newRoleMutation(c.config, OpUpdateOne, withRoleID(id))
+	return &RoleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for DictionaryDetail.
-func (c *DictionaryDetailClient) Delete() *DictionaryDetailDelete {
-	mutation := newDictionaryDetailMutation(c.config, OpDelete)
-	return &DictionaryDetailDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Role.
+func (c *RoleClient) Delete() *RoleDelete {
+	mutation := newRoleMutation(c.config, OpDelete)
+	return &RoleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *DictionaryDetailClient) DeleteOne(_m *DictionaryDetail) *DictionaryDetailDeleteOne {
+func (c *RoleClient) DeleteOne(_m *Role) *RoleDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *DictionaryDetailClient) DeleteOneID(id uint64) *DictionaryDetailDeleteOne {
-	builder := c.Delete().Where(dictionarydetail.ID(id))
+func (c *RoleClient) DeleteOneID(id uint64) *RoleDeleteOne {
+	builder := c.Delete().Where(role.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &DictionaryDetailDeleteOne{builder}
+	return &RoleDeleteOne{builder}
 }
 
-// Query returns a query builder for DictionaryDetail.
-func (c *DictionaryDetailClient) Query() *DictionaryDetailQuery {
-	return &DictionaryDetailQuery{
+// Query returns a query builder for Role.
+func (c *RoleClient) Query() *RoleQuery {
+	return &RoleQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeDictionaryDetail},
+		ctx:    &QueryContext{Type: TypeRole},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a DictionaryDetail entity by its id.
-func (c *DictionaryDetailClient) Get(ctx context.Context, id uint64) (*DictionaryDetail, error) {
-	return c.Query().Where(dictionarydetail.ID(id)).Only(ctx)
+// Get returns a Role entity by its id.
+func (c *RoleClient) Get(ctx context.Context, id uint64) (*Role, error) {
+	return c.Query().Where(role.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *DictionaryDetailClient) GetX(ctx context.Context, id uint64) *DictionaryDetail {
+func (c *RoleClient) GetX(ctx context.Context, id uint64) *Role {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1318,15 +4343,31 @@ func (c *DictionaryDetailClient) GetX(ctx context.Context, id uint64) *Dictionar
 	return obj
 }
 
-// QueryDictionaries queries the dictionaries edge of a DictionaryDetail.
-func (c *DictionaryDetailClient) QueryDictionaries(_m *DictionaryDetail) *DictionaryQuery {
-	query := (&DictionaryClient{config: c.config}).Query()
+// QueryMenus queries the menus edge of a Role.
+func (c *RoleClient) QueryMenus(_m *Role) *MenuQuery {
+	query := (&MenuClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(dictionarydetail.Table, dictionarydetail.FieldID, id),
-			sqlgraph.To(dictionary.Table, dictionary.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, dictionarydetail.DictionariesTable, dictionarydetail.DictionariesColumn),
+			sqlgraph.From(role.Table, role.FieldID, id),
+			sqlgraph.To(menu.Table, menu.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, role.MenusTable, role.MenusPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryUsers queries the users edge of a Role.
+func (c *RoleClient) QueryUsers(_m *Role) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(role.Table, role.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, role.UsersTable, role.UsersPrimaryKey...),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -1335,131 +4376,131 @@ func (c *DictionaryDetailClient) QueryDictionaries(_m *DictionaryDetail) *Dictio
 }
 
 // Hooks returns the client hooks.
-func (c *DictionaryDetailClient) Hooks() []Hook {
-	return c.hooks.DictionaryDetail
+func (c *RoleClient) Hooks() []Hook {
+	return c.hooks.Role
 }
 
 // Interceptors returns the client interceptors.
-func (c *DictionaryDetailClient) Interceptors() []Interceptor {
-	return c.inters.DictionaryDetail
+func (c *RoleClient) Interceptors() []Interceptor {
+	return c.inters.Role
 }
 
-func (c *DictionaryDetailClient) mutate(ctx context.Context, m *DictionaryDetailMutation) (Value, error) {
+func (c *RoleClient) mutate(ctx context.Context, m *RoleMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&DictionaryDetailCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&RoleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&DictionaryDetailUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&RoleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&DictionaryDetailUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&RoleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&DictionaryDetailDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&RoleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown DictionaryDetail mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown Role mutation op: %q", m.Op())
 	}
 }
 
-// MenuClient is a client for the Menu schema.
-type MenuClient struct {
+// SamlAccountClient is a client for the SamlAccount schema.
+type SamlAccountClient struct {
 	config
 }
 
-// NewMenuClient returns a client for the Menu from the given config.
-func NewMenuClient(c config) *MenuClient {
-	return &MenuClient{config: c}
+// NewSamlAccountClient returns a client for the SamlAccount from the given config.
+func NewSamlAccountClient(c config) *SamlAccountClient {
+	return &SamlAccountClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `menu.Hooks(f(g(h())))`.
-func (c *MenuClient) Use(hooks ...Hook) {
-	c.hooks.Menu = append(c.hooks.Menu, hooks...)
+// A call to `Use(f, g, h)` equals to `samlaccount.Hooks(f(g(h())))`.
+func (c *SamlAccountClient) Use(hooks ...Hook) {
+	c.hooks.SamlAccount = append(c.hooks.SamlAccount, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `menu.Intercept(f(g(h())))`.
-func (c *MenuClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Menu = append(c.inters.Menu, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `samlaccount.Intercept(f(g(h())))`.
+func (c *SamlAccountClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SamlAccount = append(c.inters.SamlAccount, interceptors...)
 }
 
-// Create returns a builder for creating a Menu entity.
-func (c *MenuClient) Create() *MenuCreate {
-	mutation := newMenuMutation(c.config, OpCreate)
-	return &MenuCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a SamlAccount entity.
+func (c *SamlAccountClient) Create() *SamlAccountCreate {
+	mutation := newSamlAccountMutation(c.config, OpCreate)
+	return &SamlAccountCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Menu entities.
-func (c *MenuClient) CreateBulk(builders ...*MenuCreate) *MenuCreateBulk {
-	return &MenuCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of SamlAccount entities.
+func (c *SamlAccountClient) CreateBulk(builders ...*SamlAccountCreate) *SamlAccountCreateBulk {
+	return &SamlAccountCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *MenuClient) MapCreateBulk(slice any, setFunc func(*MenuCreate, int)) *MenuCreateBulk {
+func (c *SamlAccountClient) MapCreateBulk(slice any, setFunc func(*SamlAccountCreate, int)) *SamlAccountCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &MenuCreateBulk{err: fmt.Errorf("calling to MenuClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &SamlAccountCreateBulk{err: fmt.Errorf("calling to SamlAccountClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*MenuCreate, rv.Len())
+	builders := make([]*SamlAccountCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &MenuCreateBulk{config: c.config, builders: builders}
+	return &SamlAccountCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Menu.
-func (c *MenuClient) Update() *MenuUpdate {
-	mutation := newMenuMutation(c.config, OpUpdate)
-	return &MenuUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for SamlAccount.
+func (c *SamlAccountClient) Update() *SamlAccountUpdate {
+	mutation := newSamlAccountMutation(c.config, OpUpdate)
+	return &SamlAccountUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *MenuClient) UpdateOne(_m *Menu) *MenuUpdateOne {
-	mutation := newMenuMutation(c.config, OpUpdateOne, withMenu(_m))
-	return &MenuUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *SamlAccountClient) UpdateOne(_m *SamlAccount) *SamlAccountUpdateOne {
+	mutation := newSamlAccountMutation(c.config, OpUpdateOne, withSamlAccount(_m))
+	return &SamlAccountUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *MenuClient) UpdateOneID(id uint64) *MenuUpdateOne {
-	mutation := newMenuMutation(c.config, OpUpdateOne, withMenuID(id))
-	return &MenuUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *SamlAccountClient) UpdateOneID(id uint64) *SamlAccountUpdateOne {
+	mutation := newSamlAccountMutation(c.config, OpUpdateOne, withSamlAccountID(id))
+	return &SamlAccountUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Menu.
-func (c *MenuClient) Delete() *MenuDelete {
-	mutation := newMenuMutation(c.config, OpDelete)
-	return &MenuDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for SamlAccount.
+func (c *SamlAccountClient) Delete() *SamlAccountDelete {
+	mutation := newSamlAccountMutation(c.config, OpDelete)
+	return &SamlAccountDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *MenuClient) DeleteOne(_m *Menu) *MenuDeleteOne {
+func (c *SamlAccountClient) DeleteOne(_m *SamlAccount) *SamlAccountDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *MenuClient) DeleteOneID(id uint64) *MenuDeleteOne {
-	builder := c.Delete().Where(menu.ID(id))
+func (c *SamlAccountClient) DeleteOneID(id uint64) *SamlAccountDeleteOne {
+	builder := c.Delete().Where(samlaccount.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &MenuDeleteOne{builder}
+	return &SamlAccountDeleteOne{builder}
 }
 
-// Query returns a query builder for Menu.
-func (c *MenuClient) Query() *MenuQuery {
-	return &MenuQuery{
+// Query returns a query builder for SamlAccount.
+func (c *SamlAccountClient) Query() *SamlAccountQuery {
+	return &SamlAccountQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeMenu},
+		ctx:    &QueryContext{Type: TypeSamlAccount},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Menu entity by its id.
-func (c *MenuClient) Get(ctx context.Context, id uint64) (*Menu, error) {
-	return c.Query().Where(menu.ID(id)).Only(ctx)
+// Get returns a SamlAccount entity by its id.
+func (c *SamlAccountClient) Get(ctx context.Context, id uint64) (*SamlAccount, error) {
+	return c.Query().Where(samlaccount.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *MenuClient) GetX(ctx context.Context, id uint64) *Menu {
+func (c *SamlAccountClient) GetX(ctx context.Context, id uint64) *SamlAccount {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1467,31 +4508,15 @@ func (c *MenuClient) GetX(ctx context.Context, id uint64) *Menu {
 	return obj
 }
 
-// QueryRoles queries the roles edge of a Menu.
-func (c *MenuClient) QueryRoles(_m *Menu) *RoleQuery {
-	query := (&RoleClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(menu.Table, menu.FieldID, id),
-			sqlgraph.To(role.Table, role.FieldID),
-			sqlgraph.Edge(sqlgraph.M2M, true, menu.RolesTable, menu.RolesPrimaryKey...),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryParent queries the parent edge of a Menu.
-func (c *MenuClient) QueryParent(_m *Menu) *MenuQuery {
-	query := (&MenuClient{config: c.config}).Query()
+// QueryUser queries the user edge of a SamlAccount.
+func (c *SamlAccountClient) QueryUser(_m *SamlAccount) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(menu.Table, menu.FieldID, id),
-			sqlgraph.To(menu.Table, menu.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, menu.ParentTable, menu.ParentColumn),
+			sqlgraph.From(samlaccount.Table, samlaccount.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, samlaccount.UserTable, samlaccount.UserColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -1499,15 +4524,15 @@ func (c *MenuClient) QueryParent(_m *Menu) *MenuQuery {
 	return query
 }
 
-// QueryChildren queries the children edge of a Menu.
-func (c *MenuClient) QueryChildren(_m *Menu) *MenuQuery {
-	query := (&MenuClient{config: c.config}).Query()
+// QueryProvider queries the provider edge of a SamlAccount.
+func (c *SamlAccountClient) QueryProvider(_m *SamlAccount) *SamlProviderQuery {
+	query := (&SamlProviderClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(menu.Table, menu.FieldID, id),
-			sqlgraph.To(menu.Table, menu.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, menu.ChildrenTable, menu.ChildrenColumn),
+			sqlgraph.From(samlaccount.Table, samlaccount.FieldID, id),
+			sqlgraph.To(samlprovider.Table, samlprovider.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, samlaccount.ProviderTable, samlaccount.ProviderColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -1516,131 +4541,131 @@ func (c *MenuClient) QueryChildren(_m *Menu) *MenuQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *MenuClient) Hooks() []Hook {
-	return c.hooks.Menu
+func (c *SamlAccountClient) Hooks() []Hook {
+	return c.hooks.SamlAccount
 }
 
 // Interceptors returns the client interceptors.
-func (c *MenuClient) Interceptors() []Interceptor {
-	return c.inters.Menu
+func (c *SamlAccountClient) Interceptors() []Interceptor {
+	return c.inters.SamlAccount
 }
 
-func (c *MenuClient) mutate(ctx context.Context, m *MenuMutation) (Value, error) {
+func (c *SamlAccountClient) mutate(ctx context.Context, m *SamlAccountMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&MenuCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SamlAccountCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&MenuUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SamlAccountUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&MenuUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SamlAccountUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&MenuDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&SamlAccountDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Menu mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown SamlAccount mutation op: %q", m.Op())
 	}
 }
 
-// OauthAccountClient is a client for the OauthAccount schema.
-type OauthAccountClient struct {
+// SamlProviderClient is a client for the SamlProvider schema.
+type SamlProviderClient struct {
 	config
 }
 
-// NewOauthAccountClient returns a client for the OauthAccount from the given config.
-func NewOauthAccountClient(c config) *OauthAccountClient {
-	return &OauthAccountClient{config: c}
+// NewSamlProviderClient returns a client for the SamlProvider from the given config.
+func NewSamlProviderClient(c config) *SamlProviderClient {
+	return &SamlProviderClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `oauthaccount.Hooks(f(g(h())))`.
-func (c *OauthAccountClient) Use(hooks ...Hook) {
-	c.hooks.OauthAccount = append(c.hooks.OauthAccount, hooks...)
+// A call to `Use(f, g, h)` equals to `samlprovider.Hooks(f(g(h())))`.
+func (c *SamlProviderClient) Use(hooks ...Hook) {
+	c.hooks.SamlProvider = append(c.hooks.SamlProvider, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `oauthaccount.Intercept(f(g(h())))`.
-func (c *OauthAccountClient) Intercept(interceptors ...Interceptor) {
-	c.inters.OauthAccount = append(c.inters.OauthAccount, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `samlprovider.Intercept(f(g(h())))`.
+func (c *SamlProviderClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SamlProvider = append(c.inters.SamlProvider, interceptors...)
 }
 
-// Create returns a builder for creating a OauthAccount entity.
-func (c *OauthAccountClient) Create() *OauthAccountCreate {
-	mutation := newOauthAccountMutation(c.config, OpCreate)
-	return &OauthAccountCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a SamlProvider entity.
+func (c *SamlProviderClient) Create() *SamlProviderCreate {
+	mutation := newSamlProviderMutation(c.config, OpCreate)
+	return &SamlProviderCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of OauthAccount entities.
-func (c *OauthAccountClient) CreateBulk(builders ...*OauthAccountCreate) *OauthAccountCreateBulk {
-	return &OauthAccountCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of SamlProvider entities.
+func (c *SamlProviderClient) CreateBulk(builders ...*SamlProviderCreate) *SamlProviderCreateBulk {
+	return &SamlProviderCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *OauthAccountClient) MapCreateBulk(slice any, setFunc func(*OauthAccountCreate, int)) *OauthAccountCreateBulk {
+func (c *SamlProviderClient) MapCreateBulk(slice any, setFunc func(*SamlProviderCreate, int)) *SamlProviderCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &OauthAccountCreateBulk{err: fmt.Errorf("calling to OauthAccountClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &SamlProviderCreateBulk{err: fmt.Errorf("calling to SamlProviderClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*OauthAccountCreate, rv.Len())
+	builders := make([]*SamlProviderCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &OauthAccountCreateBulk{config: c.config, builders: builders}
+	return &SamlProviderCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for OauthAccount.
-func (c *OauthAccountClient) Update() *OauthAccountUpdate {
-	mutation := newOauthAccountMutation(c.config, OpUpdate)
-	return &OauthAccountUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for SamlProvider.
+func (c *SamlProviderClient) Update() *SamlProviderUpdate {
+	mutation := newSamlProviderMutation(c.config, OpUpdate)
+	return &SamlProviderUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *OauthAccountClient) UpdateOne(_m *OauthAccount) *OauthAccountUpdateOne {
-	mutation := newOauthAccountMutation(c.config, OpUpdateOne, withOauthAccount(_m))
-	return &OauthAccountUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *SamlProviderClient) UpdateOne(_m *SamlProvider) *SamlProviderUpdateOne {
+	mutation := newSamlProviderMutation(c.config, OpUpdateOne, withSamlProvider(_m))
+	return &SamlProviderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *OauthAccountClient) UpdateOneID(id uint64) *OauthAccountUpdateOne {
-	mutation := newOauthAccountMutation(c.config, OpUpdateOne, withOauthAccountID(id))
-	return &OauthAccountUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *SamlProviderClient) UpdateOneID(id uint64) *SamlProviderUpdateOne {
+	mutation := newSamlProviderMutation(c.config, OpUpdateOne, withSamlProviderID(id))
+	return &SamlProviderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for OauthAccount.
-func (c *OauthAccountClient) Delete() *OauthAccountDelete {
-	mutation := newOauthAccountMutation(c.config, OpDelete)
-	return &OauthAccountDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for SamlProvider.
+func (c *SamlProviderClient) Delete() *SamlProviderDelete {
+	mutation := newSamlProviderMutation(c.config, OpDelete)
+	return &SamlProviderDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *OauthAccountClient) DeleteOne(_m *OauthAccount) *OauthAccountDeleteOne {
+func (c *SamlProviderClient) DeleteOne(_m *SamlProvider) *SamlProviderDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *OauthAccountClient) DeleteOneID(id uint64) *OauthAccountDeleteOne {
-	builder := c.Delete().Where(oauthaccount.ID(id))
+func (c *SamlProviderClient) DeleteOneID(id uint64) *SamlProviderDeleteOne {
+	builder := c.Delete().Where(samlprovider.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &OauthAccountDeleteOne{builder}
+	return &SamlProviderDeleteOne{builder}
 }
 
-// Query returns a query builder for OauthAccount.
-func (c *OauthAccountClient) Query() *OauthAccountQuery {
-	return &OauthAccountQuery{
+// Query returns a query builder for SamlProvider.
+func (c *SamlProviderClient) Query() *SamlProviderQuery {
+	return &SamlProviderQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeOauthAccount},
+		ctx:    &QueryContext{Type: TypeSamlProvider},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a OauthAccount entity by its id.
-func (c *OauthAccountClient) Get(ctx context.Context, id uint64) (*OauthAccount, error) {
-	return c.Query().Where(oauthaccount.ID(id)).Only(ctx)
+// Get returns a SamlProvider entity by its id.
+func (c *SamlProviderClient) Get(ctx context.Context, id uint64) (*SamlProvider, error) {
+	return c.Query().Where(samlprovider.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *OauthAccountClient) GetX(ctx context.Context, id uint64) *OauthAccount {
+func (c *SamlProviderClient) GetX(ctx context.Context, id uint64) *SamlProvider {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1648,31 +4673,15 @@ func (c *OauthAccountClient) GetX(ctx context.Context, id uint64) *OauthAccount
 	return obj
 }
 
-// QueryUser queries the user edge of a OauthAccount.
-func (c *OauthAccountClient) QueryUser(_m *OauthAccount) *UserQuery {
-	query := (&UserClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(oauthaccount.Table, oauthaccount.FieldID, id),
-			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, oauthaccount.UserTable, oauthaccount.UserColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryProvider queries the provider edge of a OauthAccount.
-func (c *OauthAccountClient) QueryProvider(_m *OauthAccount) *OauthProviderQuery {
-	query := (&OauthProviderClient{config: c.config}).Query()
+// QuerySamlAccounts queries the saml_accounts edge of a SamlProvider.
+func (c *SamlProviderClient) QuerySamlAccounts(_m *SamlProvider) *SamlAccountQuery {
+	query := (&SamlAccountClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(oauthaccount.Table, oauthaccount.FieldID, id),
-			sqlgraph.To(oauthprovider.Table, oauthprovider.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, oauthaccount.ProviderTable, oauthaccount.ProviderColumn),
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(samlprovider.Table, samlprovider.FieldID, id),
+			sqlgraph.To(samlaccount.Table, samlaccount.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, samlprovider.SamlAccountsTable, samlprovider.SamlAccountsColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -1681,131 +4690,131 @@ func (c *OauthAccountClient) QueryProvider(_m *OauthAccount) *OauthProviderQuery
 }
 
 // Hooks returns the client hooks.
-func (c *OauthAccountClient) Hooks() []Hook {
-	return c.hooks.OauthAccount
+func (c *SamlProviderClient) Hooks() []Hook {
+	return c.hooks.SamlProvider
 }
 
 // Interceptors returns the client interceptors.
-func (c *OauthAccountClient) Interceptors() []Interceptor {
-	return c.inters.OauthAccount
+func (c *SamlProviderClient) Interceptors() []Interceptor {
+	return c.inters.SamlProvider
 }
 
-func (c *OauthAccountClient) mutate(ctx context.Context, m *OauthAccountMutation) (Value, error) {
+func (c *SamlProviderClient) mutate(ctx context.Context, m *SamlProviderMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&OauthAccountCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SamlProviderCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&OauthAccountUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SamlProviderUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&OauthAccountUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SamlProviderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&OauthAccountDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&SamlProviderDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown OauthAccount mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown SamlProvider mutation op: %q", m.Op())
 	}
 }
 
-// OauthProviderClient is a client for the OauthProvider schema.
-type OauthProviderClient struct {
+// ScimTokenClient is a client for the ScimToken schema.
+type ScimTokenClient struct {
 	config
 }
 
-// NewOauthProviderClient returns a client for the OauthProvider from the given config.
-func NewOauthProviderClient(c config) *OauthProviderClient {
-	return &OauthProviderClient{config: c}
+// NewScimTokenClient returns a client for the ScimToken from the given config.
+func NewScimTokenClient(c config) *ScimTokenClient {
+	return &ScimTokenClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `oauthprovider.Hooks(f(g(h())))`.
-func (c *OauthProviderClient) Use(hooks ...Hook) {
-	c.hooks.OauthProvider = append(c.hooks.OauthProvider, hooks...)
+// A call to `Use(f, g, h)` equals to `scimtoken.Hooks(f(g(h())))`.
+func (c *ScimTokenClient) Use(hooks ...Hook) {
+	c.hooks.ScimToken = append(c.hooks.ScimToken, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `oauthprovider.Intercept(f(g(h())))`.
-func (c *OauthProviderClient) Intercept(interceptors ...Interceptor) {
-	c.inters.OauthProvider = append(c.inters.OauthProvider, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `scimtoken.Intercept(f(g(h())))`.
+func (c *ScimTokenClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ScimToken = append(c.inters.ScimToken, interceptors...)
 }
 
-// Create returns a builder for creating a OauthProvider entity.
-func (c *OauthProviderClient) Create() *OauthProviderCreate {
-	mutation := newOauthProviderMutation(c.config, OpCreate)
-	return &OauthProviderCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a ScimToken entity.
+func (c *ScimTokenClient) Create() *ScimTokenCreate {
+	mutation := newScimTokenMutation(c.config, OpCreate)
+	return &ScimTokenCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of OauthProvider entities.
-func (c *OauthProviderClient) CreateBulk(builders ...*OauthProviderCreate) *OauthProviderCreateBulk {
-	return &OauthProviderCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of ScimToken entities.
+func (c *ScimTokenClient) CreateBulk(builders ...*ScimTokenCreate) *ScimTokenCreateBulk {
+	return &ScimTokenCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *OauthProviderClient) MapCreateBulk(slice any, setFunc func(*OauthProviderCreate, int)) *OauthProviderCreateBulk {
+func (c *ScimTokenClient) MapCreateBulk(slice any, setFunc func(*ScimTokenCreate, int)) *ScimTokenCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &OauthProviderCreateBulk{err: fmt.Errorf("calling to OauthProviderClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &ScimTokenCreateBulk{err: fmt.Errorf("calling to ScimTokenClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*OauthProviderCreate, rv.Len())
+	builders := make([]*ScimTokenCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &OauthProviderCreateBulk{config: c.config, builders: builders}
+	return &ScimTokenCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for OauthProvider.
-func (c *OauthProviderClient) Update() *OauthProviderUpdate {
-	mutation := newOauthProviderMutation(c.config, OpUpdate)
-	return &OauthProviderUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for ScimToken.
+func (c *ScimTokenClient) Update() *ScimTokenUpdate {
+	mutation := newScimTokenMutation(c.config, OpUpdate)
+	return &ScimTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *OauthProviderClient) UpdateOne(_m *OauthProvider) *OauthProviderUpdateOne {
-	mutation := newOauthProviderMutation(c.config, OpUpdateOne, withOauthProvider(_m))
-	return &OauthProviderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ScimTokenClient) UpdateOne(_m *ScimToken) *ScimTokenUpdateOne {
+	mutation := newScimTokenMutation(c.config, OpUpdateOne, withScimToken(_m))
+	return &ScimTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *OauthProviderClient) UpdateOneID(id uint64) *OauthProviderUpdateOne {
-	mutation := newOauthProviderMutation(c.config, OpUpdateOne, withOauthProviderID(id))
-	return &OauthProviderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ScimTokenClient) UpdateOneID(id uint64) *ScimTokenUpdateOne {
+	mutation := newScimTokenMutation(c.config, OpUpdateOne, withScimTokenID(id))
+	return &ScimTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for OauthProvider.
-func (c *OauthProviderClient) Delete() *OauthProviderDelete {
-	mutation := newOauthProviderMutation(c.config, OpDelete)
-	return &OauthProviderDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for ScimToken.
+func (c *ScimTokenClient) Delete() *ScimTokenDelete {
+	mutation := newScimTokenMutation(c.config, OpDelete)
+	return &ScimTokenDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *OauthProviderClient) DeleteOne(_m *OauthProvider) *OauthProviderDeleteOne {
+func (c *ScimTokenClient) DeleteOne(_m *ScimToken) *ScimTokenDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *OauthProviderClient) DeleteOneID(id uint64) *OauthProviderDeleteOne {
-	builder := c.Delete().Where(oauthprovider.ID(id))
+func (c *ScimTokenClient) DeleteOneID(id uint64) *ScimTokenDeleteOne {
+	builder := c.Delete().Where(scimtoken.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &OauthProviderDeleteOne{builder}
+	return &ScimTokenDeleteOne{builder}
 }
 
-// Query returns a query builder for OauthProvider.
-func (c *OauthProviderClient) Query() *OauthProviderQuery {
-	return &OauthProviderQuery{
+// Query returns a query builder for ScimToken.
+func (c *ScimTokenClient) Query() *ScimTokenQuery {
+	return &ScimTokenQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeOauthProvider},
+		ctx:    &QueryContext{Type: TypeScimToken},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a OauthProvider entity by its id.
-func (c *OauthProviderClient) Get(ctx context.Context, id uint64) (*OauthProvider, error) {
-	return c.Query().Where(oauthprovider.ID(id)).Only(ctx)
+// Get returns a ScimToken entity by its id.
+func (c *ScimTokenClient) Get(ctx context.Context, id uint64) (*ScimToken, error) {
+	return c.Query().Where(scimtoken.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *OauthProviderClient) GetX(ctx context.Context, id uint64) *OauthProvider {
+func (c *ScimTokenClient) GetX(ctx context.Context, id uint64) *ScimToken {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1813,164 +4822,132 @@ func (c *OauthProviderClient) GetX(ctx context.Context, id uint64) *OauthProvide
 	return obj
 }
 
-// QueryOauthAccounts queries the oauth_accounts edge of a OauthProvider.
-func (c *OauthProviderClient) QueryOauthAccounts(_m *OauthProvider) *OauthAccountQuery {
-	query := (&OauthAccountClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(oauthprovider.Table, oauthprovider.FieldID, id),
-			sqlgraph.To(oauthaccount.Table, oauthaccount.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, oauthprovider.OauthAccountsTable, oauthprovider.OauthAccountsColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryOauthSessions queries the oauth_sessions edge of a OauthProvider.
-func (c *OauthProviderClient) QueryOauthSessions(_m *OauthProvider) *OauthSessionQuery {
-	query := (&OauthSessionClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(oauthprovider.Table, oauthprovider.FieldID, id),
-			sqlgraph.To(oauthsession.Table, oauthsession.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, oauthprovider.OauthSessionsTable, oauthprovider.OauthSessionsColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
 // Hooks returns the client hooks.
-func (c *OauthProviderClient) Hooks() []Hook {
-	return c.hooks.OauthProvider
+func (c *ScimTokenClient) Hooks() []Hook {
+	return c.hooks.ScimToken
 }
 
 // Interceptors returns the client interceptors.
-func (c *OauthProviderClient) Interceptors() []Interceptor {
-	return c.inters.OauthProvider
+func (c *ScimTokenClient) Interceptors() []Interceptor {
+	return c.inters.ScimToken
 }
 
-func (c *OauthProviderClient) mutate(ctx context.Context, m *OauthProviderMutation) (Value, error) {
+func (c *ScimTokenClient) mutate(ctx context.Context, m *ScimTokenMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&OauthProviderCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ScimTokenCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&OauthProviderUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ScimTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&OauthProviderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ScimTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&OauthProviderDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&ScimTokenDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown OauthProvider mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown ScimToken mutation op: %q", m.Op())
 	}
 }
 
-// OauthSessionClient is a client for the OauthSession schema.
-type OauthSessionClient struct {
+// TenantClient is a client for the Tenant schema.
+type TenantClient struct {
 	config
 }
 
-// NewOauthSessionClient returns a client for the OauthSession from the given config.
-func NewOauthSessionClient(c config) *OauthSessionClient {
-	return &OauthSessionClient{config: c}
+// NewTenantClient returns a client for the Tenant from the given config.
+func NewTenantClient(c config) *TenantClient {
+	return &TenantClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `oauthsession.Hooks(f(g(h())))`.
-func (c *OauthSessionClient) Use(hooks ...Hook) {
-	c.hooks.OauthSession = append(c.hooks.OauthSession, hooks...)
+// A call to `Use(f, g, h)` equals to `tenant.Hooks(f(g(h())))`.
+func (c *TenantClient) Use(hooks ...Hook) {
+	c.hooks.Tenant = append(c.hooks.Tenant, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `oauthsession.Intercept(f(g(h())))`.
-func (c *OauthSessionClient) Intercept(interceptors ...Interceptor) {
-	c.inters.OauthSession = append(c.inters.OauthSession, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `tenant.Intercept(f(g(h())))`.
+func (c *TenantClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Tenant = append(c.inters.Tenant, interceptors...)
 }
 
-// Create returns a builder for creating a OauthSession entity.
-func (c *OauthSessionClient) Create() *OauthSessionCreate {
-	mutation := newOauthSessionMutation(c.config, OpCreate)
-	return &OauthSessionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Tenant entity.
+func (c *TenantClient) Create() *TenantCreate {
+	mutation := newTenantMutation(c.config, OpCreate)
+	return &TenantCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of OauthSession entities.
-func (c *OauthSessionClient) CreateBulk(builders ...*OauthSessionCreate) *OauthSessionCreateBulk {
-	return &OauthSessionCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Tenant entities.
+func (c *TenantClient) CreateBulk(builders ...*TenantCreate) *TenantCreateBulk {
+	return &TenantCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *OauthSessionClient) MapCreateBulk(slice any, setFunc func(*OauthSessionCreate, int)) *OauthSessionCreateBulk {
+func (c *TenantClient) MapCreateBulk(slice any, setFunc func(*TenantCreate, int)) *TenantCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &OauthSessionCreateBulk{err: fmt.Errorf("calling to OauthSessionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &TenantCreateBulk{err: fmt.Errorf("calling to TenantClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*OauthSessionCreate, rv.Len())
+	builders := make([]*TenantCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &OauthSessionCreateBulk{config: c.config, builders: builders}
+	return &TenantCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for OauthSession.
-func (c *OauthSessionClient) Update() *OauthSessionUpdate {
-	mutation := newOauthSessionMutation(c.config, OpUpdate)
-	return &OauthSessionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Tenant.
+func (c *TenantClient) Update() *TenantUpdate {
+	mutation := newTenantMutation(c.config, OpUpdate)
+	return &TenantUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *OauthSessionClient) UpdateOne(_m *OauthSession) *OauthSessionUpdateOne {
-	mutation := newOauthSessionMutation(c.config, OpUpdateOne, withOauthSession(_m))
-	return &OauthSessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *TenantClient) UpdateOne(_m *Tenant) *TenantUpdateOne {
+	mutation := newTenantMutation(c.config, OpUpdateOne, withTenant(_m))
+	return &TenantUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *OauthSessionClient) UpdateOneID(id uint64) *OauthSessionUpdateOne {
-	mutation := newOauthSessionMutation(c.config, OpUpdateOne, withOauthSessionID(id))
-	return &OauthSessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *TenantClient) UpdateOneID(id uint64) *TenantUpdateOne {
+	mutation := newTenantMutation(c.config, OpUpdateOne, withTenantID(id))
+	return &TenantUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for OauthSession.
-func (c *OauthSessionClient) Delete() *OauthSessionDelete {
-	mutation := newOauthSessionMutation(c.config, OpDelete)
-	return &OauthSessionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Tenant.
+func (c *TenantClient) Delete() *TenantDelete {
+	mutation := newTenantMutation(c.config, OpDelete)
+	return &TenantDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *OauthSessionClient) DeleteOne(_m *OauthSession) *OauthSessionDeleteOne {
+func (c *TenantClient) DeleteOne(_m *Tenant) *TenantDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *OauthSessionClient) DeleteOneID(id uint64) *OauthSessionDeleteOne {
-	builder := c.Delete().Where(oauthsession.ID(id))
+func (c *TenantClient) DeleteOneID(id uint64) *TenantDeleteOne {
+	builder := c.Delete().Where(tenant.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &OauthSessionDeleteOne{builder}
+	return &TenantDeleteOne{builder}
 }
 
-// Query returns a query builder for OauthSession.
-func (c *OauthSessionClient) Query() *OauthSessionQuery {
-	return &OauthSessionQuery{
+// Query returns a query builder for Tenant.
+func (c *TenantClient) Query() *TenantQuery {
+	return &TenantQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeOauthSession},
+		ctx:    &QueryContext{Type: TypeTenant},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a OauthSession entity by its id.
-func (c *OauthSessionClient) Get(ctx context.Context, id uint64) (*OauthSession, error) {
-	return c.Query().Where(oauthsession.ID(id)).Only(ctx)
+// Get returns a Tenant entity by its id.
+func (c *TenantClient) Get(ctx context.Context, id uint64) (*Tenant, error) {
+	return c.Query().Where(tenant.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *OauthSessionClient) GetX(ctx context.Context, id uint64) *OauthSession {
+func (c *TenantClient) GetX(ctx context.Context, id uint64) *Tenant {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1978,164 +4955,132 @@ func (c *OauthSessionClient) GetX(ctx context.Context, id uint64) *OauthSession
 	return obj
 }
 
-// QueryProvider queries the provider edge of a OauthSession.
-func (c *OauthSessionClient) QueryProvider(_m *OauthSession) *OauthProviderQuery {
-	query := (&OauthProviderClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(oauthsession.Table, oauthsession.FieldID, id),
-			sqlgraph.To(oauthprovider.Table, oauthprovider.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, oauthsession.ProviderTable, oauthsession.ProviderColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryUser queries the user edge of a OauthSession.
-func (c *OauthSessionClient) QueryUser(_m *OauthSession) *UserQuery {
-	query := (&UserClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(oauthsession.Table, oauthsession.FieldID, id),
-			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, oauthsession.UserTable, oauthsession.UserColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
 // Hooks returns the client hooks.
-func (c *OauthSessionClient) Hooks() []Hook {
-	return c.hooks.OauthSession
+func (c *TenantClient) Hooks() []Hook {
+	return c.hooks.Tenant
 }
 
 // Interceptors returns the client interceptors.
-func (c *OauthSessionClient) Interceptors() []Interceptor {
-	return c.inters.OauthSession
+func (c *TenantClient) Interceptors() []Interceptor {
+	return c.inters.Tenant
 }
 
-func (c *OauthSessionClient) mutate(ctx context.Context, m *OauthSessionMutation) (Value, error) {
+func (c *TenantClient) mutate(ctx context.Context, m *TenantMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&OauthSessionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&TenantCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&OauthSessionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&TenantUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&OauthSessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&TenantUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&OauthSessionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&TenantDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown OauthSession mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown Tenant mutation op: %q", m.Op())
 	}
 }
 
-// PositionClient is a client for the Position schema.
-type PositionClient struct {
+// TenantInitJobClient is a client for the TenantInitJob schema.
+type TenantInitJobClient struct {
 	config
 }
-
-// NewPositionClient returns a client for the Position from the given config.
-func NewPositionClient(c config) *PositionClient {
-	return &PositionClient{config: c}
+
+// NewTenantInitJobClient returns a client for the TenantInitJob from the given config.
+func NewTenantInitJobClient(c config) *TenantInitJobClient {
+	return &TenantInitJobClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `position.Hooks(f(g(h())))`.
-func (c *PositionClient) Use(hooks ...Hook) {
-	c.hooks.Position = append(c.hooks.Position, hooks...)
+// A call to `Use(f, g, h)` equals to `tenantinitjob.Hooks(f(g(h())))`.
+func (c *TenantInitJobClient) Use(hooks ...Hook) {
+	c.hooks.TenantInitJob = append(c.hooks.TenantInitJob, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `position.Intercept(f(g(h())))`.
-func (c *PositionClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Position = append(c.inters.Position, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `tenantinitjob.Intercept(f(g(h())))`.
+func (c *TenantInitJobClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TenantInitJob = append(c.inters.TenantInitJob, interceptors...)
 }
 
-// Create returns a builder for creating a Position entity.
-func (c *PositionClient) Create() *PositionCreate {
-	mutation := newPositionMutation(c.config, OpCreate)
-	return &PositionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a TenantInitJob entity.
+func (c *TenantInitJobClient) Create() *TenantInitJobCreate {
+	mutation := newTenantInitJobMutation(c.config, OpCreate)
+	return &TenantInitJobCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Position entities.
-func (c *PositionClient) CreateBulk(builders ...*PositionCreate) *PositionCreateBulk {
-	return &PositionCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of TenantInitJob entities.
+func (c *TenantInitJobClient) CreateBulk(builders ...*TenantInitJobCreate) *TenantInitJobCreateBulk {
+	return &TenantInitJobCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *PositionClient) MapCreateBulk(slice any, setFunc func(*PositionCreate, int)) *PositionCreateBulk {
+func (c *TenantInitJobClient) MapCreateBulk(slice any, setFunc func(*TenantInitJobCreate, int)) *TenantInitJobCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &PositionCreateBulk{err: fmt.Errorf("calling to PositionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &TenantInitJobCreateBulk{err: fmt.Errorf("calling to TenantInitJobClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*PositionCreate, rv.Len())
+	builders := make([]*TenantInitJobCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &PositionCreateBulk{config: c.config, builders: builders}
+	return &TenantInitJobCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Position.
-func (c *PositionClient) Update() *PositionUpdate {
-	mutation := newPositionMutation(c.config, OpUpdate)
-	return &PositionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for TenantInitJob.
+func (c *TenantInitJobClient) Update() *TenantInitJobUpdate {
+	mutation := newTenantInitJobMutation(c.config, OpUpdate)
+	return &TenantInitJobUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *PositionClient) UpdateOne(_m *Position) *PositionUpdateOne {
-	mutation := newPositionMutation(c.config, OpUpdateOne, withPosition(_m))
-	return &PositionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *TenantInitJobClient) UpdateOne(_m *TenantInitJob) *TenantInitJobUpdateOne {
+	mutation := newTenantInitJobMutation(c.config, OpUpdateOne, withTenantInitJob(_m))
+	return &TenantInitJobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *PositionClient) UpdateOneID(id uint64) *PositionUpdateOne {
-	mutation := newPositionMutation(c.config, OpUpdateOne, withPositionID(id))
-	return &PositionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *TenantInitJobClient) UpdateOneID(id uint64) *TenantInitJobUpdateOne {
+	mutation := newTenantInitJobMutation(c.config, OpUpdateOne, withTenantInitJobID(id))
+	return &TenantInitJobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Position.
-func (c *PositionClient) Delete() *PositionDelete {
-	mutation := newPositionMutation(c.config, OpDelete)
-	return &PositionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for TenantInitJob.
+func (c *TenantInitJobClient) Delete() *TenantInitJobDelete {
+	mutation := newTenantInitJobMutation(c.config, OpDelete)
+	return &TenantInitJobDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *PositionClient) DeleteOne(_m *Position) *PositionDeleteOne {
+func (c *TenantInitJobClient) DeleteOne(_m *TenantInitJob) *TenantInitJobDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *PositionClient) DeleteOneID(id uint64) *PositionDeleteOne {
-	builder := c.Delete().Where(position.ID(id))
+func (c *TenantInitJobClient) DeleteOneID(id uint64) *TenantInitJobDeleteOne {
+	builder := c.Delete().Where(tenantinitjob.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &PositionDeleteOne{builder}
+	return &TenantInitJobDeleteOne{builder}
 }
 
-// Query returns a query builder for Position.
-func (c *PositionClient) Query() *PositionQuery {
-	return &PositionQuery{
+// Query returns a query builder for TenantInitJob.
+func (c *TenantInitJobClient) Query() *TenantInitJobQuery {
+	return &TenantInitJobQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypePosition},
+		ctx:    &QueryContext{Type: TypeTenantInitJob},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Position entity by its id.
-func (c *PositionClient) Get(ctx context.Context, id uint64) (*Position, error) {
-	return c.Query().Where(position.ID(id)).Only(ctx)
+// Get returns a TenantInitJob entity by its id.
+func (c *TenantInitJobClient) Get(ctx context.Context, id uint64) (*TenantInitJob, error) {
+	return c.Query().Where(tenantinitjob.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *PositionClient) GetX(ctx context.Context, id uint64) *Position {
+func (c *TenantInitJobClient) GetX(ctx context.Context, id uint64) *TenantInitJob {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -2143,164 +5088,132 @@ func (c *PositionClient) GetX(ctx context.Context, id uint64) *Position {
 	return obj
 }
 
-// QueryUsers queries the users edge of a Position.
-func (c *PositionClient) QueryUsers(_m *Position) *UserQuery {
-	query := (&UserClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(position.Table, position.FieldID, id),
-			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2M, true, position.UsersTable, position.UsersPrimaryKey...),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryDepartments queries the departments edge of a Position.
-func (c *PositionClient) QueryDepartments(_m *Position) *DepartmentQuery {
-	query := (&DepartmentClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(position.Table, position.FieldID, id),
-			sqlgraph.To(department.Table, department.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, position.DepartmentsTable, position.DepartmentsColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
 // Hooks returns the client hooks.
-func (c *PositionClient) Hooks() []Hook {
-	return c.hooks.Position
+func (c *TenantInitJobClient) Hooks() []Hook {
+	return c.hooks.TenantInitJob
 }
 
 // Interceptors returns the client interceptors.
-func (c *PositionClient) Interceptors() []Interceptor {
-	return c.inters.Position
+func (c *TenantInitJobClient) Interceptors() []Interceptor {
+	return c.inters.TenantInitJob
 }
 
-func (c *PositionClient) mutate(ctx context.Context, m *PositionMutation) (Value, error) {
+func (c *TenantInitJobClient) mutate(ctx context.Context, m *TenantInitJobMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&PositionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&TenantInitJobCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&PositionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&TenantInitJobUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&PositionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&TenantInitJobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&PositionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&TenantInitJobDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Position mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown TenantInitJob mutation op: %q", m.Op())
 	}
 }
 
-// RoleClient is a client for the Role schema.
-type RoleClient struct {
+// TenantInitPluginClient is a client for the TenantInitPlugin schema.
+type TenantInitPluginClient struct {
 	config
 }
 
-// NewRoleClient returns a client for the Role from the given config.
-func NewRoleClient(c config) *RoleClient {
-	return &RoleClient{config: c}
+// NewTenantInitPluginClient returns a client for the TenantInitPlugin from the given config.
+func NewTenantInitPluginClient(c config) *TenantInitPluginClient {
+	return &TenantInitPluginClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `role.Hooks(f(g(h())))`.
-func (c *RoleClient) Use(hooks ...Hook) {
-	c.hooks.Role = append(c.hooks.Role, hooks...)
+// A call to `Use(f, g, h)` equals to `tenantinitplugin.Hooks(f(g(h())))`.
+func (c *TenantInitPluginClient) Use(hooks ...Hook) {
+	c.hooks.TenantInitPlugin = append(c.hooks.TenantInitPlugin, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `role.Intercept(f(g(h())))`.
-func (c *RoleClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Role = append(c.inters.Role, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `tenantinitplugin.Intercept(f(g(h())))`.
+func (c *TenantInitPluginClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TenantInitPlugin = append(c.inters.TenantInitPlugin, interceptors...)
 }
 
-// Create returns a builder for creating a Role entity.
-func (c *RoleClient) Create() *RoleCreate {
-	mutation := newRoleMutation(c.config, OpCreate)
-	return &RoleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a TenantInitPlugin entity.
+func (c *TenantInitPluginClient) Create() *TenantInitPluginCreate {
+	mutation := newTenantInitPluginMutation(c.config, OpCreate)
+	return &TenantInitPluginCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Role entities.
-func (c *RoleClient) CreateBulk(builders ...*RoleCreate) *RoleCreateBulk {
-	return &RoleCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of TenantInitPlugin entities.
+func (c *TenantInitPluginClient) CreateBulk(builders ...*TenantInitPluginCreate) *TenantInitPluginCreateBulk {
+	return &TenantInitPluginCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *RoleClient) MapCreateBulk(slice any, setFunc func(*RoleCreate, int)) *RoleCreateBulk {
+func (c *TenantInitPluginClient) MapCreateBulk(slice any, setFunc func(*TenantInitPluginCreate, int)) *TenantInitPluginCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &RoleCreateBulk{err: fmt.Errorf("calling to RoleClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &TenantInitPluginCreateBulk{err: fmt.Errorf("calling to TenantInitPluginClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*RoleCreate, rv.Len())
+	builders := make([]*TenantInitPluginCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &RoleCreateBulk{config: c.config, builders: builders}
+	return &TenantInitPluginCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Role.
-func (c *RoleClient) Update() *RoleUpdate {
-	mutation := newRoleMutation(c.config, OpUpdate)
-	return &RoleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for TenantInitPlugin.
+func (c *TenantInitPluginClient) Update() *TenantInitPluginUpdate {
+	mutation := newTenantInitPluginMutation(c.config, OpUpdate)
+	return &TenantInitPluginUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *RoleClient) UpdateOne(_m *Role) *RoleUpdateOne {
-	mutation := newRoleMutation(c.config, OpUpdateOne, withRole(_m))
-	return &RoleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *TenantInitPluginClient) UpdateOne(_m *TenantInitPlugin) *TenantInitPluginUpdateOne {
+	mutation := newTenantInitPluginMutation(c.config, OpUpdateOne, withTenantInitPlugin(_m))
+	return &TenantInitPluginUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *RoleClient) UpdateOneID(id uint64) *RoleUpdateOne {
-	mutation := newRoleMutation(c.config, OpUpdateOne, withRoleID(id))
-	return &RoleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *TenantInitPluginClient) UpdateOneID(id uint64) *TenantInitPluginUpdateOne {
+	mutation := newTenantInitPluginMutation(c.config, OpUpdateOne, withTenantInitPluginID(id))
+	return &TenantInitPluginUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Role.
-func (c *RoleClient) Delete() *RoleDelete {
-	mutation := newRoleMutation(c.config, OpDelete)
-	return &RoleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for TenantInitPlugin.
+func (c *TenantInitPluginClient) Delete() *TenantInitPluginDelete {
+	mutation := newTenantInitPluginMutation(c.config, OpDelete)
+	return &TenantInitPluginDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *RoleClient) DeleteOne(_m *Role) *RoleDeleteOne {
+func (c *TenantInitPluginClient) DeleteOne(_m *TenantInitPlugin) *TenantInitPluginDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *RoleClient) DeleteOneID(id uint64) *RoleDeleteOne {
-	builder := c.Delete().Where(role.ID(id))
+func (c *TenantInitPluginClient) DeleteOneID(id uint64) *TenantInitPluginDeleteOne {
+	builder := c.Delete().Where(tenantinitplugin.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &RoleDeleteOne{builder}
+	return &TenantInitPluginDeleteOne{builder}
 }
 
-// Query returns a query builder for Role.
-func (c *RoleClient) Query() *RoleQuery {
-	return &RoleQuery{
+// Query returns a query builder for TenantInitPlugin.
+func (c *TenantInitPluginClient) Query() *TenantInitPluginQuery {
+	return &TenantInitPluginQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeRole},
+		ctx:    &QueryContext{Type: TypeTenantInitPlugin},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Role entity by its id.
-func (c *RoleClient) Get(ctx context.Context, id uint64) (*Role, error) {
-	return c.Query().Where(role.ID(id)).Only(ctx)
+// Get returns a TenantInitPlugin entity by its id.
+func (c *TenantInitPluginClient) Get(ctx context.Context, id uint64) (*TenantInitPlugin, error) {
+	return c.Query().Where(tenantinitplugin.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *RoleClient) GetX(ctx context.Context, id uint64) *Role {
+func (c *TenantInitPluginClient) GetX(ctx context.Context, id uint64) *TenantInitPlugin {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -2308,164 +5221,132 @@ func (c *RoleClient) GetX(ctx context.Context, id uint64) *Role {
 	return obj
 }
 
-// QueryMenus queries the menus edge of a Role.
-func (c *RoleClient) QueryMenus(_m *Role) *MenuQuery {
-	query := (&MenuClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(role.Table, role.FieldID, id),
-			sqlgraph.To(menu.Table, menu.FieldID),
-			sqlgraph.Edge(sqlgraph.M2M, false, role.MenusTable, role.MenusPrimaryKey...),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryUsers queries the users edge of a Role.
-func (c *RoleClient) QueryUsers(_m *Role) *UserQuery {
-	query := (&UserClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(role.Table, role.FieldID, id),
-			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2M, true, role.UsersTable, role.UsersPrimaryKey...),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
 // Hooks returns the client hooks.
-func (c *RoleClient) Hooks() []Hook {
-	return c.hooks.Role
+func (c *TenantInitPluginClient) Hooks() []Hook {
+	return c.hooks.TenantInitPlugin
 }
 
 // Interceptors returns the client interceptors.
-func (c *RoleClient) Interceptors() []Interceptor {
-	return c.inters.Role
+func (c *TenantInitPluginClient) Interceptors() []Interceptor {
+	return c.inters.TenantInitPlugin
 }
 
-func (c *RoleClient) mutate(ctx context.Context, m *RoleMutation) (Value, error) {
+func (c *TenantInitPluginClient) mutate(ctx context.Context, m *TenantInitPluginMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&RoleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&TenantInitPluginCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&RoleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&TenantInitPluginUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&RoleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&TenantInitPluginUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&RoleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&TenantInitPluginDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Role mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown TenantInitPlugin mutation op: %q", m.Op())
 	}
 }
 
-// TenantClient is a client for the Tenant schema.
-type TenantClient struct {
+// TenantInitTemplateClient is a client for the TenantInitTemplate schema.
+type TenantInitTemplateClient struct {
 	config
 }
 
-// NewTenantClient returns a client for the Tenant from the given config.
-func NewTenantClient(c config) *TenantClient {
-	return &TenantClient{config: c}
+// NewTenantInitTemplateClient returns a client for the TenantInitTemplate from the given config.
+func NewTenantInitTemplateClient(c config) *TenantInitTemplateClient {
+	return &TenantInitTemplateClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `tenant.Hooks(f(g(h())))`.
-func (c *TenantClient) Use(hooks ...Hook) {
-	c.hooks.Tenant = append(c.hooks.Tenant, hooks...)
+// A call to `Use(f, g, h)` equals to `tenantinittemplate.Hooks(f(g(h())))`.
+func (c *TenantInitTemplateClient) Use(hooks ...Hook) {
+	c.hooks.TenantInitTemplate = append(c.hooks.TenantInitTemplate, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `tenant.Intercept(f(g(h())))`.
-func (c *TenantClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Tenant = append(c.inters.Tenant, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `tenantinittemplate.Intercept(f(g(h())))`.
+func (c *TenantInitTemplateClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TenantInitTemplate = append(c.inters.TenantInitTemplate, interceptors...)
 }
 
-// Create returns a builder for creating a Tenant entity.
-func (c *TenantClient) Create() *TenantCreate {
-	mutation := newTenantMutation(c.config, OpCreate)
-	return &TenantCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a TenantInitTemplate entity.
+func (c *TenantInitTemplateClient) Create() *TenantInitTemplateCreate {
+	mutation := newTenantInitTemplateMutation(c.config, OpCreate)
+	return &TenantInitTemplateCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Tenant entities.
-func (c *TenantClient) CreateBulk(builders ...*TenantCreate) *TenantCreateBulk {
-	return &TenantCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of TenantInitTemplate entities.
+func (c *TenantInitTemplateClient) CreateBulk(builders ...*TenantInitTemplateCreate) *TenantInitTemplateCreateBulk {
+	return &TenantInitTemplateCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *TenantClient) MapCreateBulk(slice any, setFunc func(*TenantCreate, int)) *TenantCreateBulk {
+func (c *TenantInitTemplateClient) MapCreateBulk(slice any, setFunc func(*TenantInitTemplateCreate, int)) *TenantInitTemplateCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &TenantCreateBulk{err: fmt.Errorf("calling to TenantClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &TenantInitTemplateCreateBulk{err: fmt.Errorf("calling to TenantInitTemplateClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*TenantCreate, rv.Len())
+	builders := make([]*TenantInitTemplateCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &TenantCreateBulk{config: c.config, builders: builders}
+	return &TenantInitTemplateCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Tenant.
-func (c *TenantClient) Update() *TenantUpdate {
-	mutation := newTenantMutation(c.config, OpUpdate)
-	return &TenantUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for TenantInitTemplate.
+func (c *TenantInitTemplateClient) Update() *TenantInitTemplateUpdate {
+	mutation := newTenantInitTemplateMutation(c.config, OpUpdate)
+	return &TenantInitTemplateUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *TenantClient) UpdateOne(_m *Tenant) *TenantUpdateOne {
-	mutation := newTenantMutation(c.config, OpUpdateOne, withTenant(_m))
-	return &TenantUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *TenantInitTemplateClient) UpdateOne(_m *TenantInitTemplate) *TenantInitTemplateUpdateOne {
+	mutation := newTenantInitTemplateMutation(c.config, OpUpdateOne, withTenantInitTemplate(_m))
+	return &TenantInitTemplateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *TenantClient) UpdateOneID(id uint64) *TenantUpdateOne {
-	mutation := newTenantMutation(c.config, OpUpdateOne, withTenantID(id))
-	return &TenantUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *TenantInitTemplateClient) UpdateOneID(id uint64) *TenantInitTemplateUpdateOne {
+	mutation := newTenantInitTemplateMutation(c.config, OpUpdateOne, withTenantInitTemplateID(id))
+	return &TenantInitTemplateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Tenant.
-func (c *TenantClient) Delete() *TenantDelete {
-	mutation := newTenantMutation(c.config, OpDelete)
-	return &TenantDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for TenantInitTemplate.
+func (c *TenantInitTemplateClient) Delete() *TenantInitTemplateDelete {
+	mutation := newTenantInitTemplateMutation(c.config, OpDelete)
+	return &TenantInitTemplateDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *TenantClient) DeleteOne(_m *Tenant) *TenantDeleteOne {
+func (c *TenantInitTemplateClient) DeleteOne(_m *TenantInitTemplate) *TenantInitTemplateDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *TenantClient) DeleteOneID(id uint64) *TenantDeleteOne {
-	builder := c.Delete().Where(tenant.ID(id))
+func (c *TenantInitTemplateClient) DeleteOneID(id uint64) *TenantInitTemplateDeleteOne {
+	builder := c.Delete().Where(tenantinittemplate.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &TenantDeleteOne{builder}
+	return &TenantInitTemplateDeleteOne{builder}
 }
 
-// Query returns a query builder for Tenant.
-func (c *TenantClient) Query() *TenantQuery {
-	return &TenantQuery{
+// Query returns a query builder for TenantInitTemplate.
+func (c *TenantInitTemplateClient) Query() *TenantInitTemplateQuery {
+	return &TenantInitTemplateQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeTenant},
+		ctx:    &QueryContext{Type: TypeTenantInitTemplate},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Tenant entity by its id.
-func (c *TenantClient) Get(ctx context.Context, id uint64) (*Tenant, error) {
-	return c.Query().Where(tenant.ID(id)).Only(ctx)
+// Get returns a TenantInitTemplate entity by its id.
+func (c *TenantInitTemplateClient) Get(ctx context.Context, id uint64) (*TenantInitTemplate, error) {
+	return c.Query().Where(tenantinittemplate.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *TenantClient) GetX(ctx context.Context, id uint64) *Tenant {
+func (c *TenantInitTemplateClient) GetX(ctx context.Context, id uint64) *TenantInitTemplate {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -2474,27 +5355,27 @@ func (c *TenantClient) GetX(ctx context.Context, id uint64) *Tenant {
 }
 
 // Hooks returns the client hooks.
-func (c *TenantClient) Hooks() []Hook {
-	return c.hooks.Tenant
+func (c *TenantInitTemplateClient) Hooks() []Hook {
+	return c.hooks.TenantInitTemplate
 }
 
 // Interceptors returns the client interceptors.
-func (c *TenantClient) Interceptors() []Interceptor {
-	return c.inters.Tenant
+func (c *TenantInitTemplateClient) Interceptors() []Interceptor {
+	return c.inters.TenantInitTemplate
 }
 
-func (c *TenantClient) mutate(ctx context.Context, m *TenantMutation) (Value, error) {
+func (c *TenantInitTemplateClient) mutate(ctx context.Context, m *TenantInitTemplateMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&TenantCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&TenantInitTemplateCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&TenantUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&TenantInitTemplateUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&TenantUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&TenantInitTemplateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&TenantDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&TenantInitTemplateDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Tenant mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown TenantInitTemplate mutation op: %q", m.Op())
 	}
 }
 
@@ -2819,6 +5700,38 @@ func (c *UserClient) QueryOauthSessions(_m *User) *OauthSessionQuery {
 	return query
 }
 
+// QuerySamlAccounts queries the saml_accounts edge of a User.
+func (c *UserClient) QuerySamlAccounts(_m *User) *SamlAccountQuery {
+	query := (&SamlAccountClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(samlaccount.Table, samlaccount.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.SamlAccountsTable, user.SamlAccountsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryLdapAccounts queries the ldap_accounts edge of a User.
+func (c *UserClient) QueryLdapAccounts(_m *User) *LdapAccountQuery {
+	query := (&LdapAccountClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(ldapaccount.Table, ldapaccount.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.LdapAccountsTable, user.LdapAccountsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *UserClient) Hooks() []Hook {
 	return c.hooks.User
@@ -2847,14 +5760,22 @@ func (c *UserClient) mutate(ctx context.Context, m *UserMutation) (Value, error)
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		API, AuditLog, CasbinRule, Configuration, Department, Dictionary,
-		DictionaryDetail, Menu, OauthAccount, OauthProvider, OauthSession, Position,
-		Role, Tenant, Token, User []ent.Hook
+		API, AuditLog, AuditLogArchive, AuditLogChain, AuditLogCheckpoint,
+		AuditLogPruneRecord, CasbinRule, Configuration, Department, Dictionary,
+		DictionaryDetail, ImpersonationSession, LdapAccount, LdapDepartment,
+		LdapProvider, LdapSyncRun, Menu, OauthAccount, OauthClient, OauthConsent,
+		OauthProvider, OauthProviderTemplate, OauthScope, OauthSession, Position, Role,
+		SamlAccount, SamlProvider, ScimToken, Tenant, TenantInitJob, TenantInitPlugin,
+		TenantInitTemplate, Token, User []ent.Hook
 	}
 	inters struct {
-		API, AuditLog, CasbinRule, Configuration, Department, Dictionary,
-		DictionaryDetail, Menu, OauthAccount, OauthProvider, OauthSession, Position,
-		Role, Tenant, Token, User []ent.Interceptor
+		API, AuditLog, AuditLogArchive, AuditLogChain, AuditLogCheckpoint,
+		AuditLogPruneRecord, CasbinRule, Configuration, Department, Dictionary,
+		DictionaryDetail, ImpersonationSession, LdapAccount, LdapDepartment,
+		LdapProvider, LdapSyncRun, Menu, OauthAccount, OauthClient, OauthConsent,
+		OauthProvider, OauthProviderTemplate, OauthScope, OauthSession, Position, Role,
+		SamlAccount, SamlProvider, ScimToken, Tenant, TenantInitJob, TenantInitPlugin,
+		TenantInitTemplate, Token, User []ent.Interceptor
 	}
 )
 
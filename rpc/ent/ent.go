@@ -14,18 +14,37 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/coder-lulu/newbee-core/rpc/ent/api"
 	"github.com/coder-lulu/newbee-core/rpc/ent/auditlog"
+	"github.com/coder-lulu/newbee-core/rpc/ent/auditlogarchive"
+	"github.com/coder-lulu/newbee-core/rpc/ent/auditlogchain"
+	"github.com/coder-lulu/newbee-core/rpc/ent/auditlogcheckpoint"
+	"github.com/coder-lulu/newbee-core/rpc/ent/auditlogprunerecord"
 	"github.com/coder-lulu/newbee-core/rpc/ent/casbinrule"
 	"github.com/coder-lulu/newbee-core/rpc/ent/configuration"
 	"github.com/coder-lulu/newbee-core/rpc/ent/department"
 	"github.com/coder-lulu/newbee-core/rpc/ent/dictionary"
 	"github.com/coder-lulu/newbee-core/rpc/ent/dictionarydetail"
+	"github.com/coder-lulu/newbee-core/rpc/ent/impersonationsession"
+	"github.com/coder-lulu/newbee-core/rpc/ent/ldapaccount"
+	"github.com/coder-lulu/newbee-core/rpc/ent/ldapdepartment"
+	"github.com/coder-lulu/newbee-core/rpc/ent/ldapprovider"
+	"github.com/coder-lulu/newbee-core/rpc/ent/ldapsyncrun"
 	"github.com/coder-lulu/newbee-core/rpc/ent/menu"
 	"github.com/coder-lulu/newbee-core/rpc/ent/oauthaccount"
+	"github.com/coder-lulu/newbee-core/rpc/ent/oauthclient"
+	"github.com/coder-lulu/newbee-core/rpc/ent/oauthconsent"
 	"github.com/coder-lulu/newbee-core/rpc/ent/oauthprovider"
+	"github.com/coder-lulu/newbee-core/rpc/ent/oauthprovidertemplate"
+	"github.com/coder-lulu/newbee-core/rpc/ent/oauthscope"
 	"github.com/coder-lulu/newbee-core/rpc/ent/oauthsession"
 	"github.com/coder-lulu/newbee-core/rpc/ent/position"
 	"github.com/coder-lulu/newbee-core/rpc/ent/role"
+	"github.com/coder-lulu/newbee-core/rpc/ent/samlaccount"
+	"github.com/coder-lulu/newbee-core/rpc/ent/samlprovider"
+	"github.com/coder-lulu/newbee-core/rpc/ent/scimtoken"
 	"github.com/coder-lulu/newbee-core/rpc/ent/tenant"
+	"github.com/coder-lulu/newbee-core/rpc/ent/tenantinitjob"
+	"github.com/coder-lulu/newbee-core/rpc/ent/tenantinitplugin"
+	"github.com/coder-lulu/newbee-core/rpc/ent/tenantinittemplate"
 	"github.com/coder-lulu/newbee-core/rpc/ent/token"
 	"github.com/coder-lulu/newbee-core/rpc/ent/user"
 )
@@ -88,22 +107,41 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			api.Table:              api.ValidColumn,
-			auditlog.Table:         auditlog.ValidColumn,
-			casbinrule.Table:       casbinrule.ValidColumn,
-			configuration.Table:    configuration.ValidColumn,
-			department.Table:       department.ValidColumn,
-			dictionary.Table:       dictionary.ValidColumn,
-			dictionarydetail.Table: dictionarydetail.ValidColumn,
-			menu.Table:             menu.ValidColumn,
-			oauthaccount.Table:     oauthaccount.ValidColumn,
-			oauthprovider.Table:    oauthprovider.ValidColumn,
-			oauthsession.Table:     oauthsession.ValidColumn,
-			position.Table:         position.ValidColumn,
-			role.Table:             role.ValidColumn,
-			tenant.Table:           tenant.ValidColumn,
-			token.Table:            token.ValidColumn,
-			user.Table:             user.ValidColumn,
+			api.Table:                   api.ValidColumn,
+			auditlog.Table:              auditlog.ValidColumn,
+			auditlogarchive.Table:       auditlogarchive.ValidColumn,
+			auditlogchain.Table:         auditlogchain.ValidColumn,
+			auditlogcheckpoint.Table:    auditlogcheckpoint.ValidColumn,
+			auditlogprunerecord.Table:   auditlogprunerecord.ValidColumn,
+			casbinrule.Table:            casbinrule.ValidColumn,
+			configuration.Table:         configuration.ValidColumn,
+			department.Table:            department.ValidColumn,
+			dictionary.Table:            dictionary.ValidColumn,
+			dictionarydetail.Table:      dictionarydetail.ValidColumn,
+			impersonationsession.Table:  impersonationsession.ValidColumn,
+			ldapaccount.Table:           ldapaccount.ValidColumn,
+			ldapdepartment.Table:        ldapdepartment.ValidColumn,
+			ldapprovider.Table:          ldapprovider.ValidColumn,
+			ldapsyncrun.Table:           ldapsyncrun.ValidColumn,
+			menu.Table:                  menu.ValidColumn,
+			oauthaccount.Table:          oauthaccount.ValidColumn,
+			oauthclient.Table:           oauthclient.ValidColumn,
+			oauthconsent.Table:          oauthconsent.ValidColumn,
+			oauthprovider.Table:         oauthprovider.ValidColumn,
+			oauthprovidertemplate.Table: oauthprovidertemplate.ValidColumn,
+			oauthscope.Table:            oauthscope.ValidColumn,
+			oauthsession.Table:          oauthsession.ValidColumn,
+			position.Table:              position.ValidColumn,
+			role.Table:                  role.ValidColumn,
+			samlaccount.Table:           samlaccount.ValidColumn,
+			samlprovider.Table:          samlprovider.ValidColumn,
+			scimtoken.Table:             scimtoken.ValidColumn,
+			tenant.Table:                tenant.ValidColumn,
+			tenantinitjob.Table:         tenantinitjob.ValidColumn,
+			tenantinitplugin.Table:      tenantinitplugin.ValidColumn,
+			tenantinittemplate.Table:    tenantinittemplate.ValidColumn,
+			token.Table:                 token.ValidColumn,
+			user.Table:                  user.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
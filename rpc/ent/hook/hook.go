@@ -33,6 +33,54 @@ func (f AuditLogFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, er
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AuditLogMutation", m)
 }
 
+// The AuditLogArchiveFunc type is an adapter to allow the use of ordinary
+// function as AuditLogArchive mutator.
+type AuditLogArchiveFunc func(context.Context, *ent.AuditLogArchiveMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AuditLogArchiveFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.AuditLogArchiveMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AuditLogArchiveMutation", m)
+}
+
+// The AuditLogChainFunc type is an adapter to allow the use of ordinary
+// function as AuditLogChain mutator.
+type AuditLogChainFunc func(context.Context, *ent.AuditLogChainMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AuditLogChainFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.AuditLogChainMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AuditLogChainMutation", m)
+}
+
+// The AuditLogCheckpointFunc type is an adapter to allow the use of ordinary
+// function as AuditLogCheckpoint mutator.
+type AuditLogCheckpointFunc func(context.Context, *ent.AuditLogCheckpointMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AuditLogCheckpointFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.AuditLogCheckpointMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AuditLogCheckpointMutation", m)
+}
+
+// The AuditLogPruneRecordFunc type is an adapter to allow the use of ordinary
+// function as AuditLogPruneRecord mutator.
+type AuditLogPruneRecordFunc func(context.Context, *ent.AuditLogPruneRecordMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AuditLogPruneRecordFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.AuditLogPruneRecordMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AuditLogPruneRecordMutation", m)
+}
+
 // The CasbinRuleFunc type is an adapter to allow the use of ordinary
 // function as CasbinRule mutator.
 type CasbinRuleFunc func(context.Context, *ent.CasbinRuleMutation) (ent.Value, error)
@@ -93,6 +141,66 @@ func (f DictionaryDetailFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.V
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.DictionaryDetailMutation", m)
 }
 
+// The ImpersonationSessionFunc type is an adapter to allow the use of ordinary
+// function as ImpersonationSession mutator.
+type ImpersonationSessionFunc func(context.Context, *ent.ImpersonationSessionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ImpersonationSessionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ImpersonationSessionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ImpersonationSessionMutation", m)
+}
+
+// The LdapAccountFunc type is an adapter to allow the use of ordinary
+// function as LdapAccount mutator.
+type LdapAccountFunc func(context.Context, *ent.LdapAccountMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f LdapAccountFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.LdapAccountMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.LdapAccountMutation", m)
+}
+
+// The LdapDepartmentFunc type is an adapter to allow the use of ordinary
+// function as LdapDepartment mutator.
+type LdapDepartmentFunc func(context.Context, *ent.LdapDepartmentMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f LdapDepartmentFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.LdapDepartmentMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.LdapDepartmentMutation", m)
+}
+
+// The LdapProviderFunc type is an adapter to allow the use of ordinary
+// function as LdapProvider mutator.
+type LdapProviderFunc func(context.Context, *ent.LdapProviderMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f LdapProviderFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.LdapProviderMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.LdapProviderMutation", m)
+}
+
+// The LdapSyncRunFunc type is an adapter to allow the use of ordinary
+// function as LdapSyncRun mutator.
+type LdapSyncRunFunc func(context.Context, *ent.LdapSyncRunMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f LdapSyncRunFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.LdapSyncRunMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.LdapSyncRunMutation", m)
+}
+
 // The MenuFunc type is an adapter to allow the use of ordinary
 // function as Menu mutator.
 type MenuFunc func(context.Context, *ent.MenuMutation) (ent.Value, error)
@@ -117,6 +225,30 @@ func (f OauthAccountFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.OauthAccountMutation", m)
 }
 
+// The OauthClientFunc type is an adapter to allow the use of ordinary
+// function as OauthClient mutator.
+type OauthClientFunc func(context.Context, *ent.OauthClientMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f OauthClientFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.OauthClientMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.OauthClientMutation", m)
+}
+
+// The OauthConsentFunc type is an adapter to allow the use of ordinary
+// function as OauthConsent mutator.
+type OauthConsentFunc func(context.Context, *ent.OauthConsentMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f OauthConsentFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.OauthConsentMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.OauthConsentMutation", m)
+}
+
 // The OauthProviderFunc type is an adapter to allow the use of ordinary
 // function as OauthProvider mutator.
 type OauthProviderFunc func(context.Context, *ent.OauthProviderMutation) (ent.Value, error)
@@ -129,6 +261,30 @@ func (f OauthProviderFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Valu
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.OauthProviderMutation", m)
 }
 
+// The OauthProviderTemplateFunc type is an adapter to allow the use of ordinary
+// function as OauthProviderTemplate mutator.
+type OauthProviderTemplateFunc func(context.Context, *ent.OauthProviderTemplateMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f OauthProviderTemplateFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.OauthProviderTemplateMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.OauthProviderTemplateMutation", m)
+}
+
+// The OauthScopeFunc type is an adapter to allow the use of ordinary
+// function as OauthScope mutator.
+type OauthScopeFunc func(context.Context, *ent.OauthScopeMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f OauthScopeFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.OauthScopeMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.OauthScopeMutation", m)
+}
+
 // The OauthSessionFunc type is an adapter to allow the use of ordinary
 // function as OauthSession mutator.
 type OauthSessionFunc func(context.Context, *ent.OauthSessionMutation) (ent.Value, error)
@@ -165,6 +321,42 @@ func (f RoleFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error)
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.RoleMutation", m)
 }
 
+// The SamlAccountFunc type is an adapter to allow the use of ordinary
+// function as SamlAccount mutator.
+type SamlAccountFunc func(context.Context, *ent.SamlAccountMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SamlAccountFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SamlAccountMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SamlAccountMutation", m)
+}
+
+// The SamlProviderFunc type is an adapter to allow the use of ordinary
+// function as SamlProvider mutator.
+type SamlProviderFunc func(context.Context, *ent.SamlProviderMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SamlProviderFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SamlProviderMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SamlProviderMutation", m)
+}
+
+// The ScimTokenFunc type is an adapter to allow the use of ordinary
+// function as ScimToken mutator.
+type ScimTokenFunc func(context.Context, *ent.ScimTokenMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ScimTokenFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ScimTokenMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ScimTokenMutation", m)
+}
+
 // The TenantFunc type is an adapter to allow the use of ordinary
 // function as Tenant mutator.
 type TenantFunc func(context.Context, *ent.TenantMutation) (ent.Value, error)
@@ -177,6 +369,42 @@ func (f TenantFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, erro
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TenantMutation", m)
 }
 
+// The TenantInitJobFunc type is an adapter to allow the use of ordinary
+// function as TenantInitJob mutator.
+type TenantInitJobFunc func(context.Context, *ent.TenantInitJobMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TenantInitJobFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.TenantInitJobMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TenantInitJobMutation", m)
+}
+
+// The TenantInitPluginFunc type is an adapter to allow the use of ordinary
+// function as TenantInitPlugin mutator.
+type TenantInitPluginFunc func(context.Context, *ent.TenantInitPluginMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TenantInitPluginFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.TenantInitPluginMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TenantInitPluginMutation", m)
+}
+
+// The TenantInitTemplateFunc type is an adapter to allow the use of ordinary
+// function as TenantInitTemplate mutator.
+type TenantInitTemplateFunc func(context.Context, *ent.TenantInitTemplateMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TenantInitTemplateFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.TenantInitTemplateMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TenantInitTemplateMutation", m)
+}
+
 // The TokenFunc type is an adapter to allow the use of ordinary
 // function as Token mutator.
 type TokenFunc func(context.Context, *ent.TokenMutation) (ent.Value, error)
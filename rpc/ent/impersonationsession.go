@@ -0,0 +1,272 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/coder-lulu/newbee-core/rpc/ent/impersonationsession"
+)
+
+// Tenant Impersonation Session Table | 租户模拟访问会话表
+type ImpersonationSession struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uint64 `json:"id,omitempty"`
+	// Create Time | 创建日期
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Update Time | 修改日期
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// The super admin's user ID | 超级管理员用户ID
+	UserID string `json:"user_id,omitempty"`
+	// The super admin's username | 超级管理员用户名
+	UserName string `json:"user_name,omitempty"`
+	// The super admin's own tenant | 超级管理员所属租户ID
+	OriginTenantID uint64 `json:"origin_tenant_id,omitempty"`
+	// The impersonated tenant | 进入的租户ID
+	TargetTenantID uint64 `json:"target_tenant_id,omitempty"`
+	// Reason of the access | 访问原因
+	Reason string `json:"reason,omitempty"`
+	// Support ticket | 工单号
+	Ticket string `json:"ticket,omitempty"`
+	// State: pending, active, ended, rejected | 会话状态
+	State string `json:"state,omitempty"`
+	// Requested duration in seconds | 申请的会话时长(秒)
+	DurationSeconds int64 `json:"duration_seconds,omitempty"`
+	// The user who approved or rejected the request | 审批人用户ID
+	ApprovedBy string `json:"approved_by,omitempty"`
+	// Approval time | 审批时间
+	ApprovedAt *time.Time `json:"approved_at,omitempty"`
+	// Start time | 开始时间
+	StartedAt *time.Time `json:"started_at,omitempty"`
+	// Expiry time, the session ends automatically | 到期时间，到期后自动结束
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	// End time when ended manually | 手动结束时间
+	EndedAt      *time.Time `json:"ended_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ImpersonationSession) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case impersonationsession.FieldID, impersonationsession.FieldOriginTenantID, impersonationsession.FieldTargetTenantID, impersonationsession.FieldDurationSeconds:
+			values[i] = new(sql.NullInt64)
+		case impersonationsession.FieldUserID, impersonationsession.FieldUserName, impersonationsession.FieldReason, impersonationsession.FieldTicket, impersonationsession.FieldState, impersonationsession.FieldApprovedBy:
+			values[i] = new(sql.NullString)
+		case impersonationsession.FieldCreatedAt, impersonationsession.FieldUpdatedAt, impersonationsession.FieldApprovedAt, impersonationsession.FieldStartedAt, impersonationsession.FieldExpiresAt, impersonationsession.FieldEndedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ImpersonationSession fields.
+func (_m *ImpersonationSession) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case impersonationsession.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = uint64(value.Int64)
+		case impersonationsession.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case impersonationsession.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case impersonationsession.FieldUserID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				_m.UserID = value.String
+			}
+		case impersonationsession.FieldUserName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_name", values[i])
+			} else if value.Valid {
+				_m.UserName = value.String
+			}
+		case impersonationsession.FieldOriginTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field origin_tenant_id", values[i])
+			} else if value.Valid {
+				_m.OriginTenantID = uint64(value.Int64)
+			}
+		case impersonationsession.FieldTargetTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field target_tenant_id", values[i])
+			} else if value.Valid {
+				_m.TargetTenantID = uint64(value.Int64)
+			}
+		case impersonationsession.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				_m.Reason = value.String
+			}
+		case impersonationsession.FieldTicket:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ticket", values[i])
+			} else if value.Valid {
+				_m.Ticket = value.String
+			}
+		case impersonationsession.FieldState:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field state", values[i])
+			} else if value.Valid {
+				_m.State = value.String
+			}
+		case impersonationsession.FieldDurationSeconds:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field duration_seconds", values[i])
+			} else if value.Valid {
+				_m.DurationSeconds = value.Int64
+			}
+		case impersonationsession.FieldApprovedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field approved_by", values[i])
+			} else if value.Valid {
+				_m.ApprovedBy = value.String
+			}
+		case impersonationsession.FieldApprovedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field approved_at", values[i])
+			} else if value.Valid {
+				_m.ApprovedAt = new(time.Time)
+				*_m.ApprovedAt = value.Time
+			}
+		case impersonationsession.FieldStartedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field started_at", values[i])
+			} else if value.Valid {
+				_m.StartedAt = new(time.Time)
+				*_m.StartedAt = value.Time
+			}
+		case impersonationsession.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				_m.ExpiresAt = new(time.Time)
+				*_m.ExpiresAt = value.Time
+			}
+		case impersonationsession.FieldEndedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field ended_at", values[i])
+			} else if value.Valid {
+				_m.EndedAt = new(time.Time)
+				*_m.EndedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ImpersonationSession.
+// This includes values selected through modifiers, order, etc.
+func (_m *ImpersonationSession) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this ImpersonationSession.
+// Note that you need to call ImpersonationSession.Unwrap() before calling this method if this ImpersonationSession
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ImpersonationSession) Update() *ImpersonationSessionUpdateOne {
+	return NewImpersonationSessionClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ImpersonationSession entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ImpersonationSession) Unwrap() *ImpersonationSession {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ImpersonationSession is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ImpersonationSession) String() string {
+	var builder strings.Builder
+	builder.WriteString("ImpersonationSession(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(_m.UserID)
+	builder.WriteString(", ")
+	builder.WriteString("user_name=")
+	builder.WriteString(_m.UserName)
+	builder.WriteString(", ")
+	builder.WriteString("origin_tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.OriginTenantID))
+	builder.WriteString(", ")
+	builder.WriteString("target_tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TargetTenantID))
+	builder.WriteString(", ")
+	builder.WriteString("reason=")
+	builder.WriteString(_m.Reason)
+	builder.WriteString(", ")
+	builder.WriteString("ticket=")
+	builder.WriteString(_m.Ticket)
+	builder.WriteString(", ")
+	builder.WriteString("state=")
+	builder.WriteString(_m.State)
+	builder.WriteString(", ")
+	builder.WriteString("duration_seconds=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DurationSeconds))
+	builder.WriteString(", ")
+	builder.WriteString("approved_by=")
+	builder.WriteString(_m.ApprovedBy)
+	builder.WriteString(", ")
+	if v := _m.ApprovedAt; v != nil {
+		builder.WriteString("approved_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.StartedAt; v != nil {
+		builder.WriteString("started_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.ExpiresAt; v != nil {
+		builder.WriteString("expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.EndedAt; v != nil {
+		builder.WriteString("ended_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ImpersonationSessions is a parsable slice of ImpersonationSession.
+type ImpersonationSessions []*ImpersonationSession
@@ -0,0 +1,965 @@
+// Code generated by ent, DO NOT EDIT.
+
+package impersonationsession
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/coder-lulu/newbee-core/rpc/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uint64) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uint64) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uint64) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uint64) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uint64) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uint64) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uint64) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uint64) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uint64) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserName applies equality check predicate on the "user_name" field. It's identical to UserNameEQ.
+func UserName(v string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldEQ(FieldUserName, v))
+}
+
+// OriginTenantID applies equality check predicate on the "origin_tenant_id" field. It's identical to OriginTenantIDEQ.
+func OriginTenantID(v uint64) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldEQ(FieldOriginTenantID, v))
+}
+
+// TargetTenantID applies equality check predicate on the "target_tenant_id" field. It's identical to TargetTenantIDEQ.
+func TargetTenantID(v uint64) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldEQ(FieldTargetTenantID, v))
+}
+
+// Reason applies equality check predicate on the "reason" field. It's identical to ReasonEQ.
+func Reason(v string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldEQ(FieldReason, v))
+}
+
+// Ticket applies equality check predicate on the "ticket" field. It's identical to TicketEQ.
+func Ticket(v string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldEQ(FieldTicket, v))
+}
+
+// State applies equality check predicate on the "state" field. It's identical to StateEQ.
+func State(v string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldEQ(FieldState, v))
+}
+
+// DurationSeconds applies equality check predicate on the "duration_seconds" field. It's identical to DurationSecondsEQ.
+func DurationSeconds(v int64) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldEQ(FieldDurationSeconds, v))
+}
+
+// ApprovedBy applies equality check predicate on the "approved_by" field. It's identical to ApprovedByEQ.
+func ApprovedBy(v string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldEQ(FieldApprovedBy, v))
+}
+
+// ApprovedAt applies equality check predicate on the "approved_at" field. It's identical to ApprovedAtEQ.
+func ApprovedAt(v time.Time) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldEQ(FieldApprovedAt, v))
+}
+
+// StartedAt applies equality check predicate on the "started_at" field. It's identical to StartedAtEQ.
+func StartedAt(v time.Time) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldEQ(FieldStartedAt, v))
+}
+
+// ExpiresAt applies equality check predicate on the "expires_at" field. It's identical to ExpiresAtEQ.
+func ExpiresAt(v time.Time) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// EndedAt applies equality check predicate on the "ended_at" field. It's identical to EndedAtEQ.
+func EndedAt(v time.Time) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldEQ(FieldEndedAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldLTE(FieldUserID, v))
+}
+
+// UserIDContains applies the Contains predicate on the "user_id" field.
+func UserIDContains(v string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldContains(FieldUserID, v))
+}
+
+// UserIDHasPrefix applies the HasPrefix predicate on the "user_id" field.
+func UserIDHasPrefix(v string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldHasPrefix(FieldUserID, v))
+}
+
+// UserIDHasSuffix applies the HasSuffix predicate on the "user_id" field.
+func UserIDHasSuffix(v string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldHasSuffix(FieldUserID, v))
+}
+
+// UserIDEqualFold applies the EqualFold predicate on the "user_id" field.
+func UserIDEqualFold(v string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldEqualFold(FieldUserID, v))
+}
+
+// UserIDContainsFold applies the ContainsFold predicate on the "user_id" field.
+func UserIDContainsFold(v string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldContainsFold(FieldUserID, v))
+}
+
+// UserNameEQ applies the EQ predicate on the "user_name" field.
+func UserNameEQ(v string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldEQ(FieldUserName, v))
+}
+
+// UserNameNEQ applies the NEQ predicate on the "user_name" field.
+func UserNameNEQ(v string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldNEQ(FieldUserName, v))
+}
+
+// UserNameIn applies the In predicate on the "user_name" field.
+func UserNameIn(vs ...string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldIn(FieldUserName, vs...))
+}
+
+// UserNameNotIn applies the NotIn predicate on the "user_name" field.
+func UserNameNotIn(vs ...string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldNotIn(FieldUserName, vs...))
+}
+
+// UserNameGT applies the GT predicate on the "user_name" field.
+func UserNameGT(v string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldGT(FieldUserName, v))
+}
+
+// UserNameGTE applies the GTE predicate on the "user_name" field.
+func UserNameGTE(v string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldGTE(FieldUserName, v))
+}
+
+// UserNameLT applies the LT predicate on the "user_name" field.
+func UserNameLT(v string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldLT(FieldUserName, v))
+}
+
+// UserNameLTE applies the LTE predicate on the "user_name" field.
+func UserNameLTE(v string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldLTE(FieldUserName, v))
+}
+
+// UserNameContains applies the Contains predicate on the "user_name" field.
+func UserNameContains(v string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldContains(FieldUserName, v))
+}
+
+// UserNameHasPrefix applies the HasPrefix predicate on the "user_name" field.
+func UserNameHasPrefix(v string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldHasPrefix(FieldUserName, v))
+}
+
+// UserNameHasSuffix applies the HasSuffix predicate on the "user_name" field.
+func UserNameHasSuffix(v string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldHasSuffix(FieldUserName, v))
+}
+
+// UserNameIsNil applies the IsNil predicate on the "user_name" field.
+func UserNameIsNil() predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldIsNull(FieldUserName))
+}
+
+// UserNameNotNil applies the NotNil predicate on the "user_name" field.
+func UserNameNotNil() predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldNotNull(FieldUserName))
+}
+
+// UserNameEqualFold applies the EqualFold predicate on the "user_name" field.
+func UserNameEqualFold(v string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldEqualFold(FieldUserName, v))
+}
+
+// UserNameContainsFold applies the ContainsFold predicate on the "user_name" field.
+func UserNameContainsFold(v string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldContainsFold(FieldUserName, v))
+}
+
+// OriginTenantIDEQ applies the EQ predicate on the "origin_tenant_id" field.
+func OriginTenantIDEQ(v uint64) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldEQ(FieldOriginTenantID, v))
+}
+
+// OriginTenantIDNEQ applies the NEQ predicate on the "origin_tenant_id" field.
+func OriginTenantIDNEQ(v uint64) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldNEQ(FieldOriginTenantID, v))
+}
+
+// OriginTenantIDIn applies the In predicate on the "origin_tenant_id" field.
+func OriginTenantIDIn(vs ...uint64) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldIn(FieldOriginTenantID, vs...))
+}
+
+// OriginTenantIDNotIn applies the NotIn predicate on the "origin_tenant_id" field.
+func OriginTenantIDNotIn(vs ...uint64) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldNotIn(FieldOriginTenantID, vs...))
+}
+
+// OriginTenantIDGT applies the GT predicate on the "origin_tenant_id" field.
+func OriginTenantIDGT(v uint64) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldGT(FieldOriginTenantID, v))
+}
+
+// OriginTenantIDGTE applies the GTE predicate on the "origin_tenant_id" field.
+func OriginTenantIDGTE(v uint64) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldGTE(FieldOriginTenantID, v))
+}
+
+// OriginTenantIDLT applies the LT predicate on the "origin_tenant_id" field.
+func OriginTenantIDLT(v uint64) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldLT(FieldOriginTenantID, v))
+}
+
+// OriginTenantIDLTE applies the LTE predicate on the "origin_tenant_id" field.
+func OriginTenantIDLTE(v uint64) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldLTE(FieldOriginTenantID, v))
+}
+
+// TargetTenantIDEQ applies the EQ predicate on the "target_tenant_id" field.
+func TargetTenantIDEQ(v uint64) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldEQ(FieldTargetTenantID, v))
+}
+
+// TargetTenantIDNEQ applies the NEQ predicate on the "target_tenant_id" field.
+func TargetTenantIDNEQ(v uint64) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldNEQ(FieldTargetTenantID, v))
+}
+
+// TargetTenantIDIn applies the In predicate on the "target_tenant_id" field.
+func TargetTenantIDIn(vs ...uint64) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldIn(FieldTargetTenantID, vs...))
+}
+
+// TargetTenantIDNotIn applies the NotIn predicate on the "target_tenant_id" field.
+func TargetTenantIDNotIn(vs ...uint64) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldNotIn(FieldTargetTenantID, vs...))
+}
+
+// TargetTenantIDGT applies the GT predicate on the "target_tenant_id" field.
+func TargetTenantIDGT(v uint64) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldGT(FieldTargetTenantID, v))
+}
+
+// TargetTenantIDGTE applies the GTE predicate on the "target_tenant_id" field.
+func TargetTenantIDGTE(v uint64) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldGTE(FieldTargetTenantID, v))
+}
+
+// TargetTenantIDLT applies the LT predicate on the "target_tenant_id" field.
+func TargetTenantIDLT(v uint64) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldLT(FieldTargetTenantID, v))
+}
+
+// TargetTenantIDLTE applies the LTE predicate on the "target_tenant_id" field.
+func TargetTenantIDLTE(v uint64) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldLTE(FieldTargetTenantID, v))
+}
+
+// ReasonEQ applies the EQ predicate on the "reason" field.
+func ReasonEQ(v string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldEQ(FieldReason, v))
+}
+
+// ReasonNEQ applies the NEQ predicate on the "reason" field.
+func ReasonNEQ(v string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldNEQ(FieldReason, v))
+}
+
+// ReasonIn applies the In predicate on the "reason" field.
+func ReasonIn(vs ...string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldIn(FieldReason, vs...))
+}
+
+// ReasonNotIn applies the NotIn predicate on the "reason" field.
+func ReasonNotIn(vs ...string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldNotIn(FieldReason, vs...))
+}
+
+// ReasonGT applies the GT predicate on the "reason" field.
+func ReasonGT(v string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldGT(FieldReason, v))
+}
+
+// ReasonGTE applies the GTE predicate on the "reason" field.
+func ReasonGTE(v string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldGTE(FieldReason, v))
+}
+
+// ReasonLT applies the LT predicate on the "reason" field.
+func ReasonLT(v string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldLT(FieldReason, v))
+}
+
+// ReasonLTE applies the LTE predicate on the "reason" field.
+func ReasonLTE(v string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldLTE(FieldReason, v))
+}
+
+// ReasonContains applies the Contains predicate on the "reason" field.
+func ReasonContains(v string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldContains(FieldReason, v))
+}
+
+// ReasonHasPrefix applies the HasPrefix predicate on the "reason" field.
+func ReasonHasPrefix(v string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldHasPrefix(FieldReason, v))
+}
+
+// ReasonHasSuffix applies the HasSuffix predicate on the "reason" field.
+func ReasonHasSuffix(v string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldHasSuffix(FieldReason, v))
+}
+
+// ReasonEqualFold applies the EqualFold predicate on the "reason" field.
+func ReasonEqualFold(v string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldEqualFold(FieldReason, v))
+}
+
+// ReasonContainsFold applies the ContainsFold predicate on the "reason" field.
+func ReasonContainsFold(v string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldContainsFold(FieldReason, v))
+}
+
+// TicketEQ applies the EQ predicate on the "ticket" field.
+func TicketEQ(v string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldEQ(FieldTicket, v))
+}
+
+// TicketNEQ applies the NEQ predicate on the "ticket" field.
+func TicketNEQ(v string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldNEQ(FieldTicket, v))
+}
+
+// TicketIn applies the In predicate on the "ticket" field.
+func TicketIn(vs ...string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldIn(FieldTicket, vs...))
+}
+
+// TicketNotIn applies the NotIn predicate on the "ticket" field.
+func TicketNotIn(vs ...string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldNotIn(FieldTicket, vs...))
+}
+
+// TicketGT applies the GT predicate on the "ticket" field.
+func TicketGT(v string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldGT(FieldTicket, v))
+}
+
+// TicketGTE applies the GTE predicate on the "ticket" field.
+func TicketGTE(v string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldGTE(FieldTicket, v))
+}
+
+// TicketLT applies the LT predicate on the "ticket" field.
+func TicketLT(v string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldLT(FieldTicket, v))
+}
+
+// TicketLTE applies the LTE predicate on the "ticket" field.
+func TicketLTE(v string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldLTE(FieldTicket, v))
+}
+
+// TicketContains applies the Contains predicate on the "ticket" field.
+func TicketContains(v string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldContains(FieldTicket, v))
+}
+
+// TicketHasPrefix applies the HasPrefix predicate on the "ticket" field.
+func TicketHasPrefix(v string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldHasPrefix(FieldTicket, v))
+}
+
+// TicketHasSuffix applies the HasSuffix predicate on the "ticket" field.
+func TicketHasSuffix(v string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldHasSuffix(FieldTicket, v))
+}
+
+// TicketIsNil applies the IsNil predicate on the "ticket" field.
+func TicketIsNil() predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldIsNull(FieldTicket))
+}
+
+// TicketNotNil applies the NotNil predicate on the "ticket" field.
+func TicketNotNil() predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldNotNull(FieldTicket))
+}
+
+// TicketEqualFold applies the EqualFold predicate on the "ticket" field.
+func TicketEqualFold(v string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldEqualFold(FieldTicket, v))
+}
+
+// TicketContainsFold applies the ContainsFold predicate on the "ticket" field.
+func TicketContainsFold(v string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldContainsFold(FieldTicket, v))
+}
+
+// StateEQ applies the EQ predicate on the "state" field.
+func StateEQ(v string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldEQ(FieldState, v))
+}
+
+// StateNEQ applies the NEQ predicate on the "state" field.
+func StateNEQ(v string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldNEQ(FieldState, v))
+}
+
+// StateIn applies the In predicate on the "state" field.
+func StateIn(vs ...string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldIn(FieldState, vs...))
+}
+
+// StateNotIn applies the NotIn predicate on the "state" field.
+func StateNotIn(vs ...string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldNotIn(FieldState, vs...))
+}
+
+// StateGT applies the GT predicate on the "state" field.
+func StateGT(v string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldGT(FieldState, v))
+}
+
+// StateGTE applies the GTE predicate on the "state" field.
+func StateGTE(v string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldGTE(FieldState, v))
+}
+
+// StateLT applies the LT predicate on the "state" field.
+func StateLT(v string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldLT(FieldState, v))
+}
+
+// StateLTE applies the LTE predicate on the "state" field.
+func StateLTE(v string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldLTE(FieldState, v))
+}
+
+// StateContains applies the Contains predicate on the "state" field.
+func StateContains(v string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldContains(FieldState, v))
+}
+
+// StateHasPrefix applies the HasPrefix predicate on the "state" field.
+func StateHasPrefix(v string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldHasPrefix(FieldState, v))
+}
+
+// StateHasSuffix applies the HasSuffix predicate on the "state" field.
+func StateHasSuffix(v string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldHasSuffix(FieldState, v))
+}
+
+// StateEqualFold applies the EqualFold predicate on the "state" field.
+func StateEqualFold(v string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldEqualFold(FieldState, v))
+}
+
+// StateContainsFold applies the ContainsFold predicate on the "state" field.
+func StateContainsFold(v string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldContainsFold(FieldState, v))
+}
+
+// DurationSecondsEQ applies the EQ predicate on the "duration_seconds" field.
+func DurationSecondsEQ(v int64) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldEQ(FieldDurationSeconds, v))
+}
+
+// DurationSecondsNEQ applies the NEQ predicate on the "duration_seconds" field.
+func DurationSecondsNEQ(v int64) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldNEQ(FieldDurationSeconds, v))
+}
+
+// DurationSecondsIn applies the In predicate on the "duration_seconds" field.
+func DurationSecondsIn(vs ...int64) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldIn(FieldDurationSeconds, vs...))
+}
+
+// DurationSecondsNotIn applies the NotIn predicate on the "duration_seconds" field.
+func DurationSecondsNotIn(vs ...int64) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldNotIn(FieldDurationSeconds, vs...))
+}
+
+// DurationSecondsGT applies the GT predicate on the "duration_seconds" field.
+func DurationSecondsGT(v int64) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldGT(FieldDurationSeconds, v))
+}
+
+// DurationSecondsGTE applies the GTE predicate on the "duration_seconds" field.
+func DurationSecondsGTE(v int64) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldGTE(FieldDurationSeconds, v))
+}
+
+// DurationSecondsLT applies the LT predicate on the "duration_seconds" field.
+func DurationSecondsLT(v int64) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldLT(FieldDurationSeconds, v))
+}
+
+// DurationSecondsLTE applies the LTE predicate on the "duration_seconds" field.
+func DurationSecondsLTE(v int64) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldLTE(FieldDurationSeconds, v))
+}
+
+// ApprovedByEQ applies the EQ predicate on the "approved_by" field.
+func ApprovedByEQ(v string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldEQ(FieldApprovedBy, v))
+}
+
+// ApprovedByNEQ applies the NEQ predicate on the "approved_by" field.
+func ApprovedByNEQ(v string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldNEQ(FieldApprovedBy, v))
+}
+
+// ApprovedByIn applies the In predicate on the "approved_by" field.
+func ApprovedByIn(vs ...string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldIn(FieldApprovedBy, vs...))
+}
+
+// ApprovedByNotIn applies the NotIn predicate on the "approved_by" field.
+func ApprovedByNotIn(vs ...string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldNotIn(FieldApprovedBy, vs...))
+}
+
+// ApprovedByGT applies the GT predicate on the "approved_by" field.
+func ApprovedByGT(v string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldGT(FieldApprovedBy, v))
+}
+
+// ApprovedByGTE applies the GTE predicate on the "approved_by" field.
+func ApprovedByGTE(v string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldGTE(FieldApprovedBy, v))
+}
+
+// ApprovedByLT applies the LT predicate on the "approved_by" field.
+func ApprovedByLT(v string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldLT(FieldApprovedBy, v))
+}
+
+// ApprovedByLTE applies the LTE predicate on the "approved_by" field.
+func ApprovedByLTE(v string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldLTE(FieldApprovedBy, v))
+}
+
+// ApprovedByContains applies the Contains predicate on the "approved_by" field.
+func ApprovedByContains(v string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldContains(FieldApprovedBy, v))
+}
+
+// ApprovedByHasPrefix applies the HasPrefix predicate on the "approved_by" field.
+func ApprovedByHasPrefix(v string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldHasPrefix(FieldApprovedBy, v))
+}
+
+// ApprovedByHasSuffix applies the HasSuffix predicate on the "approved_by" field.
+func ApprovedByHasSuffix(v string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldHasSuffix(FieldApprovedBy, v))
+}
+
+// ApprovedByIsNil applies the IsNil predicate on the "approved_by" field.
+func ApprovedByIsNil() predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldIsNull(FieldApprovedBy))
+}
+
+// ApprovedByNotNil applies the NotNil predicate on the "approved_by" field.
+func ApprovedByNotNil() predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldNotNull(FieldApprovedBy))
+}
+
+// ApprovedByEqualFold applies the EqualFold predicate on the "approved_by" field.
+func ApprovedByEqualFold(v string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldEqualFold(FieldApprovedBy, v))
+}
+
+// ApprovedByContainsFold applies the ContainsFold predicate on the "approved_by" field.
+func ApprovedByContainsFold(v string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldContainsFold(FieldApprovedBy, v))
+}
+
+// ApprovedAtEQ applies the EQ predicate on the "approved_at" field.
+func ApprovedAtEQ(v time.Time) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldEQ(FieldApprovedAt, v))
+}
+
+// ApprovedAtNEQ applies the NEQ predicate on the "approved_at" field.
+func ApprovedAtNEQ(v time.Time) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldNEQ(FieldApprovedAt, v))
+}
+
+// ApprovedAtIn applies the In predicate on the "approved_at" field.
+func ApprovedAtIn(vs ...time.Time) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldIn(FieldApprovedAt, vs...))
+}
+
+// ApprovedAtNotIn applies the NotIn predicate on the "approved_at" field.
+func ApprovedAtNotIn(vs ...time.Time) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldNotIn(FieldApprovedAt, vs...))
+}
+
+// ApprovedAtGT applies the GT predicate on the "approved_at" field.
+func ApprovedAtGT(v time.Time) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldGT(FieldApprovedAt, v))
+}
+
+// ApprovedAtGTE applies the GTE predicate on the "approved_at" field.
+func ApprovedAtGTE(v time.Time) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldGTE(FieldApprovedAt, v))
+}
+
+// ApprovedAtLT applies the LT predicate on the "approved_at" field.
+func ApprovedAtLT(v time.Time) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldLT(FieldApprovedAt, v))
+}
+
+// ApprovedAtLTE applies the LTE predicate on the "approved_at" field.
+func ApprovedAtLTE(v time.Time) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldLTE(FieldApprovedAt, v))
+}
+
+// ApprovedAtIsNil applies the IsNil predicate on the "approved_at" field.
+func ApprovedAtIsNil() predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldIsNull(FieldApprovedAt))
+}
+
+// ApprovedAtNotNil applies the NotNil predicate on the "approved_at" field.
+func ApprovedAtNotNil() predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldNotNull(FieldApprovedAt))
+}
+
+// StartedAtEQ applies the EQ predicate on the "started_at" field.
+func StartedAtEQ(v time.Time) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldEQ(FieldStartedAt, v))
+}
+
+// StartedAtNEQ applies the NEQ predicate on the "started_at" field.
+func StartedAtNEQ(v time.Time) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldNEQ(FieldStartedAt, v))
+}
+
+// StartedAtIn applies the In predicate on the "started_at" field.
+func StartedAtIn(vs ...time.Time) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldIn(FieldStartedAt, vs...))
+}
+
+// StartedAtNotIn applies the NotIn predicate on the "started_at" field.
+func StartedAtNotIn(vs ...time.Time) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldNotIn(FieldStartedAt, vs...))
+}
+
+// StartedAtGT applies the GT predicate on the "started_at" field.
+func StartedAtGT(v time.Time) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldGT(FieldStartedAt, v))
+}
+
+// StartedAtGTE applies the GTE predicate on the "started_at" field.
+func StartedAtGTE(v time.Time) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldGTE(FieldStartedAt, v))
+}
+
+// StartedAtLT applies the LT predicate on the "started_at" field.
+func StartedAtLT(v time.Time) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldLT(FieldStartedAt, v))
+}
+
+// StartedAtLTE applies the LTE predicate on the "started_at" field.
+func StartedAtLTE(v time.Time) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldLTE(FieldStartedAt, v))
+}
+
+// StartedAtIsNil applies the IsNil predicate on the "started_at" field.
+func StartedAtIsNil() predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldIsNull(FieldStartedAt))
+}
+
+// StartedAtNotNil applies the NotNil predicate on the "started_at" field.
+func StartedAtNotNil() predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldNotNull(FieldStartedAt))
+}
+
+// ExpiresAtEQ applies the EQ predicate on the "expires_at" field.
+func ExpiresAtEQ(v time.Time) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtNEQ applies the NEQ predicate on the "expires_at" field.
+func ExpiresAtNEQ(v time.Time) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldNEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtIn applies the In predicate on the "expires_at" field.
+func ExpiresAtIn(vs ...time.Time) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtNotIn applies the NotIn predicate on the "expires_at" field.
+func ExpiresAtNotIn(vs ...time.Time) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldNotIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtGT applies the GT predicate on the "expires_at" field.
+func ExpiresAtGT(v time.Time) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldGT(FieldExpiresAt, v))
+}
+
+// ExpiresAtGTE applies the GTE predicate on the "expires_at" field.
+func ExpiresAtGTE(v time.Time) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldGTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtLT applies the LT predicate on the "expires_at" field.
+func ExpiresAtLT(v time.Time) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldLT(FieldExpiresAt, v))
+}
+
+// ExpiresAtLTE applies the LTE predicate on the "expires_at" field.
+func ExpiresAtLTE(v time.Time) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldLTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtIsNil applies the IsNil predicate on the "expires_at" field.
+func ExpiresAtIsNil() predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldIsNull(FieldExpiresAt))
+}
+
+// ExpiresAtNotNil applies the NotNil predicate on the "expires_at" field.
+func ExpiresAtNotNil() predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldNotNull(FieldExpiresAt))
+}
+
+// EndedAtEQ applies the EQ predicate on the "ended_at" field.
+func EndedAtEQ(v time.Time) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldEQ(FieldEndedAt, v))
+}
+
+// EndedAtNEQ applies the NEQ predicate on the "ended_at" field.
+func EndedAtNEQ(v time.Time) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldNEQ(FieldEndedAt, v))
+}
+
+// EndedAtIn applies the In predicate on the "ended_at" field.
+func EndedAtIn(vs ...time.Time) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldIn(FieldEndedAt, vs...))
+}
+
+// EndedAtNotIn applies the NotIn predicate on the "ended_at" field.
+func EndedAtNotIn(vs ...time.Time) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldNotIn(FieldEndedAt, vs...))
+}
+
+// EndedAtGT applies the GT predicate on the "ended_at" field.
+func EndedAtGT(v time.Time) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldGT(FieldEndedAt, v))
+}
+
+// EndedAtGTE applies the GTE predicate on the "ended_at" field.
+func EndedAtGTE(v time.Time) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldGTE(FieldEndedAt, v))
+}
+
+// EndedAtLT applies the LT predicate on the "ended_at" field.
+func EndedAtLT(v time.Time) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldLT(FieldEndedAt, v))
+}
+
+// EndedAtLTE applies the LTE predicate on the "ended_at" field.
+func EndedAtLTE(v time.Time) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldLTE(FieldEndedAt, v))
+}
+
+// EndedAtIsNil applies the IsNil predicate on the "ended_at" field.
+func EndedAtIsNil() predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldIsNull(FieldEndedAt))
+}
+
+// EndedAtNotNil applies the NotNil predicate on the "ended_at" field.
+func EndedAtNotNil() predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldNotNull(FieldEndedAt))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ImpersonationSession) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ImpersonationSession) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ImpersonationSession) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.NotPredicates(p))
+}
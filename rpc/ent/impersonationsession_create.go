@@ -0,0 +1,473 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/coder-lulu/newbee-core/rpc/ent/impersonationsession"
+)
+
+// ImpersonationSessionCreate is the builder for creating a ImpersonationSession entity.
+type ImpersonationSessionCreate struct {
+	config
+	mutation *ImpersonationSessionMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ImpersonationSessionCreate) SetCreatedAt(v time.Time) *ImpersonationSessionCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ImpersonationSessionCreate) SetNillableCreatedAt(v *time.Time) *ImpersonationSessionCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *ImpersonationSessionCreate) SetUpdatedAt(v time.Time) *ImpersonationSessionCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *ImpersonationSessionCreate) SetNillableUpdatedAt(v *time.Time) *ImpersonationSessionCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *ImpersonationSessionCreate) SetUserID(v string) *ImpersonationSessionCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetUserName sets the "user_name" field.
+func (_c *ImpersonationSessionCreate) SetUserName(v string) *ImpersonationSessionCreate {
+	_c.mutation.SetUserName(v)
+	return _c
+}
+
+// SetNillableUserName sets the "user_name" field if the given value is not nil.
+func (_c *ImpersonationSessionCreate) SetNillableUserName(v *string) *ImpersonationSessionCreate {
+	if v != nil {
+		_c.SetUserName(*v)
+	}
+	return _c
+}
+
+// SetOriginTenantID sets the "origin_tenant_id" field.
+func (_c *ImpersonationSessionCreate) SetOriginTenantID(v uint64) *ImpersonationSessionCreate {
+	_c.mutation.SetOriginTenantID(v)
+	return _c
+}
+
+// SetTargetTenantID sets the "target_tenant_id" field.
+func (_c *ImpersonationSessionCreate) SetTargetTenantID(v uint64) *ImpersonationSessionCreate {
+	_c.mutation.SetTargetTenantID(v)
+	return _c
+}
+
+// SetReason sets the "reason" field.
+func (_c *ImpersonationSessionCreate) SetReason(v string) *ImpersonationSessionCreate {
+	_c.mutation.SetReason(v)
+	return _c
+}
+
+// SetTicket sets the "ticket" field.
+func (_c *ImpersonationSessionCreate) SetTicket(v string) *ImpersonationSessionCreate {
+	_c.mutation.SetTicket(v)
+	return _c
+}
+
+// SetNillableTicket sets the "ticket" field if the given value is not nil.
+func (_c *ImpersonationSessionCreate) SetNillableTicket(v *string) *ImpersonationSessionCreate {
+	if v != nil {
+		_c.SetTicket(*v)
+	}
+	return _c
+}
+
+// SetState sets the "state" field.
+func (_c *ImpersonationSessionCreate) SetState(v string) *ImpersonationSessionCreate {
+	_c.mutation.SetState(v)
+	return _c
+}
+
+// SetDurationSeconds sets the "duration_seconds" field.
+func (_c *ImpersonationSessionCreate) SetDurationSeconds(v int64) *ImpersonationSessionCreate {
+	_c.mutation.SetDurationSeconds(v)
+	return _c
+}
+
+// SetApprovedBy sets the "approved_by" field.
+func (_c *ImpersonationSessionCreate) SetApprovedBy(v string) *ImpersonationSessionCreate {
+	_c.mutation.SetApprovedBy(v)
+	return _c
+}
+
+// SetNillableApprovedBy sets the "approved_by" field if the given value is not nil.
+func (_c *ImpersonationSessionCreate) SetNillableApprovedBy(v *string) *ImpersonationSessionCreate {
+	if v != nil {
+		_c.SetApprovedBy(*v)
+	}
+	return _c
+}
+
+// SetApprovedAt sets the "approved_at" field.
+func (_c *ImpersonationSessionCreate) SetApprovedAt(v time.Time) *ImpersonationSessionCreate {
+	_c.mutation.SetApprovedAt(v)
+	return _c
+}
+
+// SetNillableApprovedAt sets the "approved_at" field if the given value is not nil.
+func (_c *ImpersonationSessionCreate) SetNillableApprovedAt(v *time.Time) *ImpersonationSessionCreate {
+	if v != nil {
+		_c.SetApprovedAt(*v)
+	}
+	return _c
+}
+
+// SetStartedAt sets the "started_at" field.
+func (_c *ImpersonationSessionCreate) SetStartedAt(v time.Time) *ImpersonationSessionCreate {
+	_c.mutation.SetStartedAt(v)
+	return _c
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (_c *ImpersonationSessionCreate) SetNillableStartedAt(v *time.Time) *ImpersonationSessionCreate {
+	if v != nil {
+		_c.SetStartedAt(*v)
+	}
+	return _c
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_c *ImpersonationSessionCreate) SetExpiresAt(v time.Time) *ImpersonationSessionCreate {
+	_c.mutation.SetExpiresAt(v)
+	return _c
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_c *ImpersonationSessionCreate) SetNillableExpiresAt(v *time.Time) *ImpersonationSessionCreate {
+	if v != nil {
+		_c.SetExpiresAt(*v)
+	}
+	return _c
+}
+
+// SetEndedAt sets the "ended_at" field.
+func (_c *ImpersonationSessionCreate) SetEndedAt(v time.Time) *ImpersonationSessionCreate {
+	_c.mutation.SetEndedAt(v)
+	return _c
+}
+
+// SetNillableEndedAt sets the "ended_at" field if the given value is not nil.
+func (_c *ImpersonationSessionCreate) SetNillableEndedAt(v *time.Time) *ImpersonationSessionCreate {
+	if v != nil {
+		_c.SetEndedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ImpersonationSessionCreate) SetID(v uint64) *ImpersonationSessionCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the ImpersonationSessionMutation object of the builder.
+func (_c *ImpersonationSessionCreate) Mutation() *ImpersonationSessionMutation {
+	return _c.mutation
+}
+
+// Save creates the ImpersonationSession in the database.
+func (_c *ImpersonationSessionCreate) Save(ctx context.Context) (*ImpersonationSession, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ImpersonationSessionCreate) SaveX(ctx context.Context) *ImpersonationSession {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ImpersonationSessionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ImpersonationSessionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ImpersonationSessionCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := impersonationsession.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := impersonationsession.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ImpersonationSessionCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ImpersonationSession.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ImpersonationSession.updated_at"`)}
+	}
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "ImpersonationSession.user_id"`)}
+	}
+	if v, ok := _c.mutation.UserID(); ok {
+		if err := impersonationsession.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "ImpersonationSession.user_id": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.UserName(); ok {
+		if err := impersonationsession.UserNameValidator(v); err != nil {
+			return &ValidationError{Name: "user_name", err: fmt.Errorf(`ent: validator failed for field "ImpersonationSession.user_name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.OriginTenantID(); !ok {
+		return &ValidationError{Name: "origin_tenant_id", err: errors.New(`ent: missing required field "ImpersonationSession.origin_tenant_id"`)}
+	}
+	if _, ok := _c.mutation.TargetTenantID(); !ok {
+		return &ValidationError{Name: "target_tenant_id", err: errors.New(`ent: missing required field "ImpersonationSession.target_tenant_id"`)}
+	}
+	if _, ok := _c.mutation.Reason(); !ok {
+		return &ValidationError{Name: "reason", err: errors.New(`ent: missing required field "ImpersonationSession.reason"`)}
+	}
+	if v, ok := _c.mutation.Reason(); ok {
+		if err := impersonationsession.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "ImpersonationSession.reason": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Ticket(); ok {
+		if err := impersonationsession.TicketValidator(v); err != nil {
+			return &ValidationError{Name: "ticket", err: fmt.Errorf(`ent: validator failed for field "ImpersonationSession.ticket": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.State(); !ok {
+		return &ValidationError{Name: "state", err: errors.New(`ent: missing required field "ImpersonationSession.state"`)}
+	}
+	if v, ok := _c.mutation.State(); ok {
+		if err := impersonationsession.StateValidator(v); err != nil {
+			return &ValidationError{Name: "state", err: fmt.Errorf(`ent: validator failed for field "ImpersonationSession.state": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.DurationSeconds(); !ok {
+		return &ValidationError{Name: "duration_seconds", err: errors.New(`ent: missing required field "ImpersonationSession.duration_seconds"`)}
+	}
+	if v, ok := _c.mutation.ApprovedBy(); ok {
+		if err := impersonationsession.ApprovedByValidator(v); err != nil {
+			return &ValidationError{Name: "approved_by", err: fmt.Errorf(`ent: validator failed for field "ImpersonationSession.approved_by": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *ImpersonationSessionCreate) sqlSave(ctx context.Context) (*ImpersonationSession, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint64(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ImpersonationSessionCreate) createSpec() (*ImpersonationSession, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ImpersonationSession{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(impersonationsession.Table, sqlgraph.NewFieldSpec(impersonationsession.FieldID, field.TypeUint64))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(impersonationsession.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(impersonationsession.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(impersonationsession.FieldUserID, field.TypeString, value)
+		_node.UserID = value
+	}
+	if value, ok := _c.mutation.UserName(); ok {
+		_spec.SetField(impersonationsession.FieldUserName, field.TypeString, value)
+		_node.UserName = value
+	}
+	if value, ok := _c.mutation.OriginTenantID(); ok {
+		_spec.SetField(impersonationsession.FieldOriginTenantID, field.TypeUint64, value)
+		_node.OriginTenantID = value
+	}
+	if value, ok := _c.mutation.TargetTenantID(); ok {
+		_spec.SetField(impersonationsession.FieldTargetTenantID, field.TypeUint64, value)
+		_node.TargetTenantID = value
+	}
+	if value, ok := _c.mutation.Reason(); ok {
+		_spec.SetField(impersonationsession.FieldReason, field.TypeString, value)
+		_node.Reason = value
+	}
+	if value, ok := _c.mutation.Ticket(); ok {
+		_spec.SetField(impersonationsession.FieldTicket, field.TypeString, value)
+		_node.Ticket = value
+	}
+	if value, ok := _c.mutation.State(); ok {
+		_spec.SetField(impersonationsession.FieldState, field.TypeString, value)
+		_node.State = value
+	}
+	if value, ok := _c.mutation.DurationSeconds(); ok {
+		_spec.SetField(impersonationsession.FieldDurationSeconds, field.TypeInt64, value)
+		_node.DurationSeconds = value
+	}
+	if value, ok := _c.mutation.ApprovedBy(); ok {
+		_spec.SetField(impersonationsession.FieldApprovedBy, field.TypeString, value)
+		_node.ApprovedBy = value
+	}
+	if value, ok := _c.mutation.ApprovedAt(); ok {
+		_spec.SetField(impersonationsession.FieldApprovedAt, field.TypeTime, value)
+		_node.ApprovedAt = &value
+	}
+	if value, ok := _c.mutation.StartedAt(); ok {
+		_spec.SetField(impersonationsession.FieldStartedAt, field.TypeTime, value)
+		_node.StartedAt = &value
+	}
+	if value, ok := _c.mutation.ExpiresAt(); ok {
+		_spec.SetField(impersonationsession.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = &value
+	}
+	if value, ok := _c.mutation.EndedAt(); ok {
+		_spec.SetField(impersonationsession.FieldEndedAt, field.TypeTime, value)
+		_node.EndedAt = &value
+	}
+	return _node, _spec
+}
+
+// ImpersonationSessionCreateBulk is the builder for creating many ImpersonationSession entities in bulk.
+type ImpersonationSessionCreateBulk struct {
+	config
+	err      error
+	builders []*ImpersonationSessionCreate
+}
+
+// Save creates the ImpersonationSession entities in the database.
+func (_c *ImpersonationSessionCreateBulk) Save(ctx context.Context) ([]*ImpersonationSession, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ImpersonationSession, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ImpersonationSessionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ImpersonationSessionCreateBulk) SaveX(ctx context.Context) []*ImpersonationSession {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ImpersonationSessionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ImpersonationSessionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
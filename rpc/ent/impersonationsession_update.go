@@ -0,0 +1,932 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/coder-lulu/newbee-core/rpc/ent/impersonationsession"
+	"github.com/coder-lulu/newbee-core/rpc/ent/predicate"
+)
+
+// ImpersonationSessionUpdate is the builder for updating ImpersonationSession entities.
+type ImpersonationSessionUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *ImpersonationSessionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the ImpersonationSessionUpdate builder.
+func (_u *ImpersonationSessionUpdate) Where(ps ...predicate.ImpersonationSession) *ImpersonationSessionUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ImpersonationSessionUpdate) SetUpdatedAt(v time.Time) *ImpersonationSessionUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *ImpersonationSessionUpdate) SetUserID(v string) *ImpersonationSessionUpdate {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *ImpersonationSessionUpdate) SetNillableUserID(v *string) *ImpersonationSessionUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetUserName sets the "user_name" field.
+func (_u *ImpersonationSessionUpdate) SetUserName(v string) *ImpersonationSessionUpdate {
+	_u.mutation.SetUserName(v)
+	return _u
+}
+
+// SetNillableUserName sets the "user_name" field if the given value is not nil.
+func (_u *ImpersonationSessionUpdate) SetNillableUserName(v *string) *ImpersonationSessionUpdate {
+	if v != nil {
+		_u.SetUserName(*v)
+	}
+	return _u
+}
+
+// ClearUserName clears the value of the "user_name" field.
+func (_u *ImpersonationSessionUpdate) ClearUserName() *ImpersonationSessionUpdate {
+	_u.mutation.ClearUserName()
+	return _u
+}
+
+// SetOriginTenantID sets the "origin_tenant_id" field.
+func (_u *ImpersonationSessionUpdate) SetOriginTenantID(v uint64) *ImpersonationSessionUpdate {
+	_u.mutation.ResetOriginTenantID()
+	_u.mutation.SetOriginTenantID(v)
+	return _u
+}
+
+// SetNillableOriginTenantID sets the "origin_tenant_id" field if the given value is not nil.
+func (_u *ImpersonationSessionUpdate) SetNillableOriginTenantID(v *uint64) *ImpersonationSessionUpdate {
+	if v != nil {
+		_u.SetOriginTenantID(*v)
+	}
+	return _u
+}
+
+// AddOriginTenantID adds value to the "origin_tenant_id" field.
+func (_u *ImpersonationSessionUpdate) AddOriginTenantID(v int64) *ImpersonationSessionUpdate {
+	_u.mutation.AddOriginTenantID(v)
+	return _u
+}
+
+// SetTargetTenantID sets the "target_tenant_id" field.
+func (_u *ImpersonationSessionUpdate) SetTargetTenantID(v uint64) *ImpersonationSessionUpdate {
+	_u.mutation.ResetTargetTenantID()
+	_u.mutation.SetTargetTenantID(v)
+	return _u
+}
+
+// SetNillableTargetTenantID sets the "target_tenant_id" field if the given value is not nil.
+func (_u *ImpersonationSessionUpdate) SetNillableTargetTenantID(v *uint64) *ImpersonationSessionUpdate {
+	if v != nil {
+		_u.SetTargetTenantID(*v)
+	}
+	return _u
+}
+
+// AddTargetTenantID adds value to the "target_tenant_id" field.
+func (_u *ImpersonationSessionUpdate) AddTargetTenantID(v int64) *ImpersonationSessionUpdate {
+	_u.mutation.AddTargetTenantID(v)
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *ImpersonationSessionUpdate) SetReason(v string) *ImpersonationSessionUpdate {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *ImpersonationSessionUpdate) SetNillableReason(v *string) *ImpersonationSessionUpdate {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// SetTicket sets the "ticket" field.
+func (_u *ImpersonationSessionUpdate) SetTicket(v string) *ImpersonationSessionUpdate {
+	_u.mutation.SetTicket(v)
+	return _u
+}
+
+// SetNillableTicket sets the "ticket" field if the given value is not nil.
+func (_u *ImpersonationSessionUpdate) SetNillableTicket(v *string) *ImpersonationSessionUpdate {
+	if v != nil {
+		_u.SetTicket(*v)
+	}
+	return _u
+}
+
+// ClearTicket clears the value of the "ticket" field.
+func (_u *ImpersonationSessionUpdate) ClearTicket() *ImpersonationSessionUpdate {
+	_u.mutation.ClearTicket()
+	return _u
+}
+
+// SetState sets the "state" field.
+func (_u *ImpersonationSessionUpdate) SetState(v string) *ImpersonationSessionUpdate {
+	_u.mutation.SetState(v)
+	return _u
+}
+
+// SetNillableState sets the "state" field if the given value is not nil.
+func (_u *ImpersonationSessionUpdate) SetNillableState(v *string) *ImpersonationSessionUpdate {
+	if v != nil {
+		_u.SetState(*v)
+	}
+	return _u
+}
+
+// SetDurationSeconds sets the "duration_seconds" field.
+func (_u *ImpersonationSessionUpdate) SetDurationSeconds(v int64) *ImpersonationSessionUpdate {
+	_u.mutation.ResetDurationSeconds()
+	_u.mutation.SetDurationSeconds(v)
+	return _u
+}
+
+// SetNillableDurationSeconds sets the "duration_seconds" field if the given value is not nil.
+func (_u *ImpersonationSessionUpdate) SetNillableDurationSeconds(v *int64) *ImpersonationSessionUpdate {
+	if v != nil {
+		_u.SetDurationSeconds(*v)
+	}
+	return _u
+}
+
+// AddDurationSeconds adds value to the "duration_seconds" field.
+func (_u *ImpersonationSessionUpdate) AddDurationSeconds(v int64) *ImpersonationSessionUpdate {
+	_u.mutation.AddDurationSeconds(v)
+	return _u
+}
+
+// SetApprovedBy sets the "approved_by" field.
+func (_u *ImpersonationSessionUpdate) SetApprovedBy(v string) *ImpersonationSessionUpdate {
+	_u.mutation.SetApprovedBy(v)
+	return _u
+}
+
+// SetNillableApprovedBy sets the "approved_by" field if the given value is not nil.
+func (_u *ImpersonationSessionUpdate) SetNillableApprovedBy(v *string) *ImpersonationSessionUpdate {
+	if v != nil {
+		_u.SetApprovedBy(*v)
+	}
+	return _u
+}
+
+// ClearApprovedBy clears the value of the "approved_by" field.
+func (_u *ImpersonationSessionUpdate) ClearApprovedBy() *ImpersonationSessionUpdate {
+	_u.mutation.ClearApprovedBy()
+	return _u
+}
+
+// SetApprovedAt sets the "approved_at" field.
+func (_u *ImpersonationSessionUpdate) SetApprovedAt(v time.Time) *ImpersonationSessionUpdate {
+	_u.mutation.SetApprovedAt(v)
+	return _u
+}
+
+// SetNillableApprovedAt sets the "approved_at" field if the given value is not nil.
+func (_u *ImpersonationSessionUpdate) SetNillableApprovedAt(v *time.Time) *ImpersonationSessionUpdate {
+	if v != nil {
+		_u.SetApprovedAt(*v)
+	}
+	return _u
+}
+
+// ClearApprovedAt clears the value of the "approved_at" field.
+func (_u *ImpersonationSessionUpdate) ClearApprovedAt() *ImpersonationSessionUpdate {
+	_u.mutation.ClearApprovedAt()
+	return _u
+}
+
+// SetStartedAt sets the "started_at" field.
+func (_u *ImpersonationSessionUpdate) SetStartedAt(v time.Time) *ImpersonationSessionUpdate {
+	_u.mutation.SetStartedAt(v)
+	return _u
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (_u *ImpersonationSessionUpdate) SetNillableStartedAt(v *time.Time) *ImpersonationSessionUpdate {
+	if v != nil {
+		_u.SetStartedAt(*v)
+	}
+	return _u
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (_u *ImpersonationSessionUpdate) ClearStartedAt() *ImpersonationSessionUpdate {
+	_u.mutation.ClearStartedAt()
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *ImpersonationSessionUpdate) SetExpiresAt(v time.Time) *ImpersonationSessionUpdate {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *ImpersonationSessionUpdate) SetNillableExpiresAt(v *time.Time) *ImpersonationSessionUpdate {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (_u *ImpersonationSessionUpdate) ClearExpiresAt() *ImpersonationSessionUpdate {
+	_u.mutation.ClearExpiresAt()
+	return _u
+}
+
+// SetEndedAt sets the "ended_at" field.
+func (_u *ImpersonationSessionUpdate) SetEndedAt(v time.Time) *ImpersonationSessionUpdate {
+	_u.mutation.SetEndedAt(v)
+	return _u
+}
+
+// SetNillableEndedAt sets the "ended_at" field if the given value is not nil.
+func (_u *ImpersonationSessionUpdate) SetNillableEndedAt(v *time.Time) *ImpersonationSessionUpdate {
+	if v != nil {
+		_u.SetEndedAt(*v)
+	}
+	return _u
+}
+
+// ClearEndedAt clears the value of the "ended_at" field.
+func (_u *ImpersonationSessionUpdate) ClearEndedAt() *ImpersonationSessionUpdate {
+	_u.mutation.ClearEndedAt()
+	return _u
+}
+
+// Mutation returns the ImpersonationSessionMutation object of the builder.
+func (_u *ImpersonationSessionUpdate) Mutation() *ImpersonationSessionMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ImpersonationSessionUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ImpersonationSessionUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ImpersonationSessionUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ImpersonationSessionUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ImpersonationSessionUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := impersonationsession.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ImpersonationSessionUpdate) check() error {
+	if v, ok := _u.mutation.UserID(); ok {
+		if err := impersonationsession.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "ImpersonationSession.user_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.UserName(); ok {
+		if err := impersonationsession.UserNameValidator(v); err != nil {
+			return &ValidationError{Name: "user_name", err: fmt.Errorf(`ent: validator failed for field "ImpersonationSession.user_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Reason(); ok {
+		if err := impersonationsession.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "ImpersonationSession.reason": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Ticket(); ok {
+		if err := impersonationsession.TicketValidator(v); err != nil {
+			return &ValidationError{Name: "ticket", err: fmt.Errorf(`ent: validator failed for field "ImpersonationSession.ticket": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.State(); ok {
+		if err := impersonationsession.StateValidator(v); err != nil {
+			return &ValidationError{Name: "state", err: fmt.Errorf(`ent: validator failed for field "ImpersonationSession.state": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ApprovedBy(); ok {
+		if err := impersonationsession.ApprovedByValidator(v); err != nil {
+			return &ValidationError{Name: "approved_by", err: fmt.Errorf(`ent: validator failed for field "ImpersonationSession.approved_by": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ImpersonationSessionUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ImpersonationSessionUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *ImpersonationSessionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(impersonationsession.Table, impersonationsession.Columns, sqlgraph.NewFieldSpec(impersonationsession.FieldID, field.TypeUint64))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(impersonationsession.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(impersonationsession.FieldUserID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UserName(); ok {
+		_spec.SetField(impersonationsession.FieldUserName, field.TypeString, value)
+	}
+	if _u.mutation.UserNameCleared() {
+		_spec.ClearField(impersonationsession.FieldUserName, field.TypeString)
+	}
+	if value, ok := _u.mutation.OriginTenantID(); ok {
+		_spec.SetField(impersonationsession.FieldOriginTenantID, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.AddedOriginTenantID(); ok {
+		_spec.AddField(impersonationsession.FieldOriginTenantID, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.TargetTenantID(); ok {
+		_spec.SetField(impersonationsession.FieldTargetTenantID, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.AddedTargetTenantID(); ok {
+		_spec.AddField(impersonationsession.FieldTargetTenantID, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(impersonationsession.FieldReason, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Ticket(); ok {
+		_spec.SetField(impersonationsession.FieldTicket, field.TypeString, value)
+	}
+	if _u.mutation.TicketCleared() {
+		_spec.ClearField(impersonationsession.FieldTicket, field.TypeString)
+	}
+	if value, ok := _u.mutation.State(); ok {
+		_spec.SetField(impersonationsession.FieldState, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.DurationSeconds(); ok {
+		_spec.SetField(impersonationsession.FieldDurationSeconds, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedDurationSeconds(); ok {
+		_spec.AddField(impersonationsession.FieldDurationSeconds, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.ApprovedBy(); ok {
+		_spec.SetField(impersonationsession.FieldApprovedBy, field.TypeString, value)
+	}
+	if _u.mutation.ApprovedByCleared() {
+		_spec.ClearField(impersonationsession.FieldApprovedBy, field.TypeString)
+	}
+	if value, ok := _u.mutation.ApprovedAt(); ok {
+		_spec.SetField(impersonationsession.FieldApprovedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ApprovedAtCleared() {
+		_spec.ClearField(impersonationsession.FieldApprovedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.StartedAt(); ok {
+		_spec.SetField(impersonationsession.FieldStartedAt, field.TypeTime, value)
+	}
+	if _u.mutation.StartedAtCleared() {
+		_spec.ClearField(impersonationsession.FieldStartedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(impersonationsession.FieldExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.ExpiresAtCleared() {
+		_spec.ClearField(impersonationsession.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.EndedAt(); ok {
+		_spec.SetField(impersonationsession.FieldEndedAt, field.TypeTime, value)
+	}
+	if _u.mutation.EndedAtCleared() {
+		_spec.ClearField(impersonationsession.FieldEndedAt, field.TypeTime)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{impersonationsession.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ImpersonationSessionUpdateOne is the builder for updating a single ImpersonationSession entity.
+type ImpersonationSessionUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *ImpersonationSessionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ImpersonationSessionUpdateOne) SetUpdatedAt(v time.Time) *ImpersonationSessionUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *ImpersonationSessionUpdateOne) SetUserID(v string) *ImpersonationSessionUpdateOne {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *ImpersonationSessionUpdateOne) SetNillableUserID(v *string) *ImpersonationSessionUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetUserName sets the "user_name" field.
+func (_u *ImpersonationSessionUpdateOne) SetUserName(v string) *ImpersonationSessionUpdateOne {
+	_u.mutation.SetUserName(v)
+	return _u
+}
+
+// SetNillableUserName sets the "user_name" field if the given value is not nil.
+func (_u *ImpersonationSessionUpdateOne) SetNillableUserName(v *string) *ImpersonationSessionUpdateOne {
+	if v != nil {
+		_u.SetUserName(*v)
+	}
+	return _u
+}
+
+// ClearUserName clears the value of the "user_name" field.
+func (_u *ImpersonationSessionUpdateOne) ClearUserName() *ImpersonationSessionUpdateOne {
+	_u.mutation.ClearUserName()
+	return _u
+}
+
+// SetOriginTenantID sets the "origin_tenant_id" field.
+func (_u *ImpersonationSessionUpdateOne) SetOriginTenantID(v uint64) *ImpersonationSessionUpdateOne {
+	_u.mutation.ResetOriginTenantID()
+	_u.mutation.SetOriginTenantID(v)
+	return _u
+}
+
+// SetNillableOriginTenantID sets the "origin_tenant_id" field if the given value is not nil.
+func (_u *ImpersonationSessionUpdateOne) SetNillableOriginTenantID(v *uint64) *ImpersonationSessionUpdateOne {
+	if v != nil {
+		_u.SetOriginTenantID(*v)
+	}
+	return _u
+}
+
+// AddOriginTenantID adds value to the "origin_tenant_id" field.
+func (_u *ImpersonationSessionUpdateOne) AddOriginTenantID(v int64) *ImpersonationSessionUpdateOne {
+	_u.mutation.AddOriginTenantID(v)
+	return _u
+}
+
+// SetTargetTenantID sets the "target_tenant_id" field.
+func (_u *ImpersonationSessionUpdateOne) SetTargetTenantID(v uint64) *ImpersonationSessionUpdateOne {
+	_u.mutation.ResetTargetTenantID()
+	_u.mutation.SetTargetTenantID(v)
+	return _u
+}
+
+// SetNillableTargetTenantID sets the "target_tenant_id" field if the given value is not nil.
+func (_u *ImpersonationSessionUpdateOne) SetNillableTargetTenantID(v *uint64) *ImpersonationSessionUpdateOne {
+	if v != nil {
+		_u.SetTargetTenantID(*v)
+	}
+	return _u
+}
+
+// AddTargetTenantID adds value to the "target_tenant_id" field.
+func (_u *ImpersonationSessionUpdateOne) AddTargetTenantID(v int64) *ImpersonationSessionUpdateOne {
+	_u.mutation.AddTargetTenantID(v)
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *ImpersonationSessionUpdateOne) SetReason(v string) *ImpersonationSessionUpdateOne {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *ImpersonationSessionUpdateOne) SetNillableReason(v *string) *ImpersonationSessionUpdateOne {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// SetTicket sets the "ticket" field.
+func (_u *ImpersonationSessionUpdateOne) SetTicket(v string) *ImpersonationSessionUpdateOne {
+	_u.mutation.SetTicket(v)
+	return _u
+}
+
+// SetNillableTicket sets the "ticket" field if the given value is not nil.
+func (_u *ImpersonationSessionUpdateOne) SetNillableTicket(v *string) *ImpersonationSessionUpdateOne {
+	if v != nil {
+		_u.SetTicket(*v)
+	}
+	return _u
+}
+
+// ClearTicket clears the value of the "ticket" field.
+func (_u *ImpersonationSessionUpdateOne) ClearTicket() *ImpersonationSessionUpdateOne {
+	_u.mutation.ClearTicket()
+	return _u
+}
+
+// SetState sets the "state" field.
+func (_u *ImpersonationSessionUpdateOne) SetState(v string) *ImpersonationSessionUpdateOne {
+	_u.mutation.SetState(v)
+	return _u
+}
+
+// SetNillableState sets the "state" field if the given value is not nil.
+func (_u *ImpersonationSessionUpdateOne) SetNillableState(v *string) *ImpersonationSessionUpdateOne {
+	if v != nil {
+		_u.SetState(*v)
+	}
+	return _u
+}
+
+// SetDurationSeconds sets the "duration_seconds" field.
+func (_u *ImpersonationSessionUpdateOne) SetDurationSeconds(v int64) *ImpersonationSessionUpdateOne {
+	_u.mutation.ResetDurationSeconds()
+	_u.mutation.SetDurationSeconds(v)
+	return _u
+}
+
+// SetNillableDurationSeconds sets the "duration_seconds" field if the given value is not nil.
+func (_u *ImpersonationSessionUpdateOne) SetNillableDurationSeconds(v *int64) *ImpersonationSessionUpdateOne {
+	if v != nil {
+		_u.SetDurationSeconds(*v)
+	}
+	return _u
+}
+
+// AddDurationSeconds adds value to the "duration_seconds" field.
+func (_u *ImpersonationSessionUpdateOne) AddDurationSeconds(v int64) *ImpersonationSessionUpdateOne {
+	_u.mutation.AddDurationSeconds(v)
+	return _u
+}
+
+// SetApprovedBy sets the "approved_by" field.
+func (_u *ImpersonationSessionUpdateOne) SetApprovedBy(v string) *ImpersonationSessionUpdateOne {
+	_u.mutation.SetApprovedBy(v)
+	return _u
+}
+
+// SetNillableApprovedBy sets the "approved_by" field if the given value is not nil.
+func (_u *ImpersonationSessionUpdateOne) SetNillableApprovedBy(v *string) *ImpersonationSessionUpdateOne {
+	if v != nil {
+		_u.SetApprovedBy(*v)
+	}
+	return _u
+}
+
+// ClearApprovedBy clears the value of the "approved_by" field.
+func (_u *ImpersonationSessionUpdateOne) ClearApprovedBy() *ImpersonationSessionUpdateOne {
+	_u.mutation.ClearApprovedBy()
+	return _u
+}
+
+// SetApprovedAt sets the "approved_at" field.
+func (_u *ImpersonationSessionUpdateOne) SetApprovedAt(v time.Time) *ImpersonationSessionUpdateOne {
+	_u.mutation.SetApprovedAt(v)
+	return _u
+}
+
+// SetNillableApprovedAt sets the "approved_at" field if the given value is not nil.
+func (_u *ImpersonationSessionUpdateOne) SetNillableApprovedAt(v *time.Time) *ImpersonationSessionUpdateOne {
+	if v != nil {
+		_u.SetApprovedAt(*v)
+	}
+	return _u
+}
+
+// ClearApprovedAt clears the value of the "approved_at" field.
+func (_u *ImpersonationSessionUpdateOne) ClearApprovedAt() *ImpersonationSessionUpdateOne {
+	_u.mutation.ClearApprovedAt()
+	return _u
+}
+
+// SetStartedAt sets the "started_at" field.
+func (_u *ImpersonationSessionUpdateOne) SetStartedAt(v time.Time) *ImpersonationSessionUpdateOne {
+	_u.mutation.SetStartedAt(v)
+	return _u
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (_u *ImpersonationSessionUpdateOne) SetNillableStartedAt(v *time.Time) *ImpersonationSessionUpdateOne {
+	if v != nil {
+		_u.SetStartedAt(*v)
+	}
+	return _u
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (_u *ImpersonationSessionUpdateOne) ClearStartedAt() *ImpersonationSessionUpdateOne {
+	_u.mutation.ClearStartedAt()
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *ImpersonationSessionUpdateOne) SetExpiresAt(v time.Time) *ImpersonationSessionUpdateOne {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *ImpersonationSessionUpdateOne) SetNillableExpiresAt(v *time.Time) *ImpersonationSessionUpdateOne {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (_u *ImpersonationSessionUpdateOne) ClearExpiresAt() *ImpersonationSessionUpdateOne {
+	_u.mutation.ClearExpiresAt()
+	return _u
+}
+
+// SetEndedAt sets the "ended_at" field.
+func (_u *ImpersonationSessionUpdateOne) SetEndedAt(v time.Time) *ImpersonationSessionUpdateOne {
+	_u.mutation.SetEndedAt(v)
+	return _u
+}
+
+// SetNillableEndedAt sets the "ended_at" field if the given value is not nil.
+func (_u *ImpersonationSessionUpdateOne) SetNillableEndedAt(v *time.Time) *ImpersonationSessionUpdateOne {
+	if v != nil {
+		_u.SetEndedAt(*v)
+	}
+	return _u
+}
+
+// ClearEndedAt clears the value of the "ended_at" field.
+func (_u *ImpersonationSessionUpdateOne) ClearEndedAt() *ImpersonationSessionUpdateOne {
+	_u.mutation.ClearEndedAt()
+	return _u
+}
+
+// Mutation returns the ImpersonationSessionMutation object of the builder.
+func (_u *ImpersonationSessionUpdateOne) Mutation() *ImpersonationSessionMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the ImpersonationSessionUpdate builder.
+func (_u *ImpersonationSessionUpdateOne) Where(ps ...predicate.ImpersonationSession) *ImpersonationSessionUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ImpersonationSessionUpdateOne) Select(field string, fields ...string) *ImpersonationSessionUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ImpersonationSession entity.
+func (_u *ImpersonationSessionUpdateOne) Save(ctx context.Context) (*ImpersonationSession, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ImpersonationSessionUpdateOne) SaveX(ctx context.Context) *ImpersonationSession {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ImpersonationSessionUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ImpersonationSessionUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ImpersonationSessionUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := impersonationsession.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ImpersonationSessionUpdateOne) check() error {
+	if v, ok := _u.mutation.UserID(); ok {
+		if err := impersonationsession.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "ImpersonationSession.user_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.UserName(); ok {
+		if err := impersonationsession.UserNameValidator(v); err != nil {
+			return &ValidationError{Name: "user_name", err: fmt.Errorf(`ent: validator failed for field "ImpersonationSession.user_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Reason(); ok {
+		if err := impersonationsession.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "ImpersonationSession.reason": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Ticket(); ok {
+		if err := impersonationsession.TicketValidator(v); err != nil {
+			return &ValidationError{Name: "ticket", err: fmt.Errorf(`ent: validator failed for field "ImpersonationSession.ticket": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.State(); ok {
+		if err := impersonationsession.StateValidator(v); err != nil {
+			return &ValidationError{Name: "state", err: fmt.Errorf(`ent: validator failed for field "ImpersonationSession.state": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ApprovedBy(); ok {
+		if err := impersonationsession.ApprovedByValidator(v); err != nil {
+			return &ValidationError{Name: "approved_by", err: fmt.Errorf(`ent: validator failed for field "ImpersonationSession.approved_by": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ImpersonationSessionUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ImpersonationSessionUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *ImpersonationSessionUpdateOne) sqlSave(ctx context.Context) (_node *ImpersonationSession, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(impersonationsession.Table, impersonationsession.Columns, sqlgraph.NewFieldSpec(impersonationsession.FieldID, field.TypeUint64))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ImpersonationSession.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, impersonationsession.FieldID)
+		for _, f := range fields {
+			if !impersonationsession.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != impersonationsession.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(impersonationsession.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(impersonationsession.FieldUserID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UserName(); ok {
+		_spec.SetField(impersonationsession.FieldUserName, field.TypeString, value)
+	}
+	if _u.mutation.UserNameCleared() {
+		_spec.ClearField(impersonationsession.FieldUserName, field.TypeString)
+	}
+	if value, ok := _u.mutation.OriginTenantID(); ok {
+		_spec.SetField(impersonationsession.FieldOriginTenantID, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.AddedOriginTenantID(); ok {
+		_spec.AddField(impersonationsession.FieldOriginTenantID, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.TargetTenantID(); ok {
+		_spec.SetField(impersonationsession.FieldTargetTenantID, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.AddedTargetTenantID(); ok {
+		_spec.AddField(impersonationsession.FieldTargetTenantID, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(impersonationsession.FieldReason, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Ticket(); ok {
+		_spec.SetField(impersonationsession.FieldTicket, field.TypeString, value)
+	}
+	if _u.mutation.TicketCleared() {
+		_spec.ClearField(impersonationsession.FieldTicket, field.TypeString)
+	}
+	if value, ok := _u.mutation.State(); ok {
+		_spec.SetField(impersonationsession.FieldState, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.DurationSeconds(); ok {
+		_spec.SetField(impersonationsession.FieldDurationSeconds, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedDurationSeconds(); ok {
+		_spec.AddField(impersonationsession.FieldDurationSeconds, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.ApprovedBy(); ok {
+		_spec.SetField(impersonationsession.FieldApprovedBy, field.TypeString, value)
+	}
+	if _u.mutation.ApprovedByCleared() {
+		_spec.ClearField(impersonationsession.FieldApprovedBy, field.TypeString)
+	}
+	if value, ok := _u.mutation.ApprovedAt(); ok {
+		_spec.SetField(impersonationsession.FieldApprovedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ApprovedAtCleared() {
+		_spec.ClearField(impersonationsession.FieldApprovedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.StartedAt(); ok {
+		_spec.SetField(impersonationsession.FieldStartedAt, field.TypeTime, value)
+	}
+	if _u.mutation.StartedAtCleared() {
+		_spec.ClearField(impersonationsession.FieldStartedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(impersonationsession.FieldExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.ExpiresAtCleared() {
+		_spec.ClearField(impersonationsession.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.EndedAt(); ok {
+		_spec.SetField(impersonationsession.FieldEndedAt, field.TypeTime, value)
+	}
+	if _u.mutation.EndedAtCleared() {
+		_spec.ClearField(impersonationsession.FieldEndedAt, field.TypeTime)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &ImpersonationSession{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{impersonationsession.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
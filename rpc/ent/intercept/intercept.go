@@ -10,19 +10,38 @@ import (
 	"github.com/coder-lulu/newbee-core/rpc/ent"
 	"github.com/coder-lulu/newbee-core/rpc/ent/api"
 	"github.com/coder-lulu/newbee-core/rpc/ent/auditlog"
+	"github.com/coder-lulu/newbee-core/rpc/ent/auditlogarchive"
+	"github.com/coder-lulu/newbee-core/rpc/ent/auditlogchain"
+	"github.com/coder-lulu/newbee-core/rpc/ent/auditlogcheckpoint"
+	"github.com/coder-lulu/newbee-core/rpc/ent/auditlogprunerecord"
 	"github.com/coder-lulu/newbee-core/rpc/ent/casbinrule"
 	"github.com/coder-lulu/newbee-core/rpc/ent/configuration"
 	"github.com/coder-lulu/newbee-core/rpc/ent/department"
 	"github.com/coder-lulu/newbee-core/rpc/ent/dictionary"
 	"github.com/coder-lulu/newbee-core/rpc/ent/dictionarydetail"
+	"github.com/coder-lulu/newbee-core/rpc/ent/impersonationsession"
+	"github.com/coder-lulu/newbee-core/rpc/ent/ldapaccount"
+	"github.com/coder-lulu/newbee-core/rpc/ent/ldapdepartment"
+	"github.com/coder-lulu/newbee-core/rpc/ent/ldapprovider"
+	"github.com/coder-lulu/newbee-core/rpc/ent/ldapsyncrun"
 	"github.com/coder-lulu/newbee-core/rpc/ent/menu"
 	"github.com/coder-lulu/newbee-core/rpc/ent/oauthaccount"
+	"github.com/coder-lulu/newbee-core/rpc/ent/oauthclient"
+	"github.com/coder-lulu/newbee-core/rpc/ent/oauthconsent"
 	"github.com/coder-lulu/newbee-core/rpc/ent/oauthprovider"
+	"github.com/coder-lulu/newbee-core/rpc/ent/oauthprovidertemplate"
+	"github.com/coder-lulu/newbee-core/rpc/ent/oauthscope"
 	"github.com/coder-lulu/newbee-core/rpc/ent/oauthsession"
 	"github.com/coder-lulu/newbee-core/rpc/ent/position"
 	"github.com/coder-lulu/newbee-core/rpc/ent/predicate"
 	"github.com/coder-lulu/newbee-core/rpc/ent/role"
+	"github.com/coder-lulu/newbee-core/rpc/ent/samlaccount"
+	"github.com/coder-lulu/newbee-core/rpc/ent/samlprovider"
+	"github.com/coder-lulu/newbee-core/rpc/ent/scimtoken"
 	"github.com/coder-lulu/newbee-core/rpc/ent/tenant"
+	"github.com/coder-lulu/newbee-core/rpc/ent/tenantinitjob"
+	"github.com/coder-lulu/newbee-core/rpc/ent/tenantinitplugin"
+	"github.com/coder-lulu/newbee-core/rpc/ent/tenantinittemplate"
 	"github.com/coder-lulu/newbee-core/rpc/ent/token"
 	"github.com/coder-lulu/newbee-core/rpc/ent/user"
 )
@@ -137,6 +156,114 @@ func (f TraverseAuditLog) Traverse(ctx context.Context, q ent.Query) error {
 	return fmt.Errorf("unexpected query type %T. expect *ent.AuditLogQuery", q)
 }
 
+// The AuditLogArchiveFunc type is an adapter to allow the use of ordinary function as a Querier.
+type AuditLogArchiveFunc func(context.Context, *ent.AuditLogArchiveQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f AuditLogArchiveFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.AuditLogArchiveQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.AuditLogArchiveQuery", q)
+}
+
+// The TraverseAuditLogArchive type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseAuditLogArchive func(context.Context, *ent.AuditLogArchiveQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseAuditLogArchive) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseAuditLogArchive) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.AuditLogArchiveQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.AuditLogArchiveQuery", q)
+}
+
+// The AuditLogChainFunc type is an adapter to allow the use of ordinary function as a Querier.
+type AuditLogChainFunc func(context.Context, *ent.AuditLogChainQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f AuditLogChainFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.AuditLogChainQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.AuditLogChainQuery", q)
+}
+
+// The TraverseAuditLogChain type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseAuditLogChain func(context.Context, *ent.AuditLogChainQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseAuditLogChain) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseAuditLogChain) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.AuditLogChainQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.AuditLogChainQuery", q)
+}
+
+// The AuditLogCheckpointFunc type is an adapter to allow the use of ordinary function as a Querier.
+type AuditLogCheckpointFunc func(context.Context, *ent.AuditLogCheckpointQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f AuditLogCheckpointFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.AuditLogCheckpointQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.AuditLogCheckpointQuery", q)
+}
+
+// The TraverseAuditLogCheckpoint type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseAuditLogCheckpoint func(context.Context, *ent.AuditLogCheckpointQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseAuditLogCheckpoint) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseAuditLogCheckpoint) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.AuditLogCheckpointQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.AuditLogCheckpointQuery", q)
+}
+
+// The AuditLogPruneRecordFunc type is an adapter to allow the use of ordinary function as a Querier.
+type AuditLogPruneRecordFunc func(context.Context, *ent.AuditLogPruneRecordQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f AuditLogPruneRecordFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.AuditLogPruneRecordQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.AuditLogPruneRecordQuery", q)
+}
+
+// The TraverseAuditLogPruneRecord type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseAuditLogPruneRecord func(context.Context, *ent.AuditLogPruneRecordQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseAuditLogPruneRecord) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseAuditLogPruneRecord) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.AuditLogPruneRecordQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.AuditLogPruneRecordQuery", q)
+}
+
 // The CasbinRuleFunc type is an adapter to allow the use of ordinary function as a Querier.
 type CasbinRuleFunc func(context.Context, *ent.CasbinRuleQuery) (ent.Value, error)
 
@@ -272,6 +399,141 @@ func (f TraverseDictionaryDetail) Traverse(ctx context.Context, q ent.Query) err
 	return fmt.Errorf("unexpected query type %T. expect *ent.DictionaryDetailQuery", q)
 }
 
+// The ImpersonationSessionFunc type is an adapter to allow the use of ordinary function as a Querier.
+type ImpersonationSessionFunc func(context.Context, *ent.ImpersonationSessionQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f ImpersonationSessionFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.ImpersonationSessionQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.ImpersonationSessionQuery", q)
+}
+
+// The TraverseImpersonationSession type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseImpersonationSession func(context.Context, *ent.ImpersonationSessionQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseImpersonationSession) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseImpersonationSession) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.ImpersonationSessionQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.ImpersonationSessionQuery", q)
+}
+
+// The LdapAccountFunc type is an adapter to allow the use of ordinary function as a Querier.
+type LdapAccountFunc func(context.Context, *ent.LdapAccountQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f LdapAccountFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.LdapAccountQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.LdapAccountQuery", q)
+}
+
+// The TraverseLdapAccount type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseLdapAccount func(context.Context, *ent.LdapAccountQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseLdapAccount) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseLdapAccount) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.LdapAccountQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.LdapAccountQuery", q)
+}
+
+// The LdapDepartmentFunc type is an adapter to allow the use of ordinary function as a Querier.
+type LdapDepartmentFunc func(context.Context, *ent.LdapDepartmentQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f LdapDepartmentFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.LdapDepartmentQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.LdapDepartmentQuery", q)
+}
+
+// The TraverseLdapDepartment type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseLdapDepartment func(context.Context, *ent.LdapDepartmentQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseLdapDepartment) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseLdapDepartment) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.LdapDepartmentQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.LdapDepartmentQuery", q)
+}
+
+// The LdapProviderFunc type is an adapter to allow the use of ordinary function as a Querier.
+type LdapProviderFunc func(context.Context, *ent.LdapProviderQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f LdapProviderFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.LdapProviderQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.LdapProviderQuery", q)
+}
+
+// The TraverseLdapProvider type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseLdapProvider func(context.Context, *ent.LdapProviderQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseLdapProvider) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseLdapProvider) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.LdapProviderQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.LdapProviderQuery", q)
+}
+
+// The LdapSyncRunFunc type is an adapter to allow the use of ordinary function as a Querier.
+type LdapSyncRunFunc func(context.Context, *ent.LdapSyncRunQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f LdapSyncRunFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.LdapSyncRunQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.LdapSyncRunQuery", q)
+}
+
+// The TraverseLdapSyncRun type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseLdapSyncRun func(context.Context, *ent.LdapSyncRunQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseLdapSyncRun) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseLdapSyncRun) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.LdapSyncRunQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.LdapSyncRunQuery", q)
+}
+
 // The MenuFunc type is an adapter to allow the use of ordinary function as a Querier.
 type MenuFunc func(context.Context, *ent.MenuQuery) (ent.Value, error)
 
@@ -326,6 +588,60 @@ func (f TraverseOauthAccount) Traverse(ctx context.Context, q ent.Query) error {
 	return fmt.Errorf("unexpected query type %T. expect *ent.OauthAccountQuery", q)
 }
 
+// The OauthClientFunc type is an adapter to allow the use of ordinary function as a Querier.
+type OauthClientFunc func(context.Context, *ent.OauthClientQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f OauthClientFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.OauthClientQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.OauthClientQuery", q)
+}
+
+// The TraverseOauthClient type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseOauthClient func(context.Context, *ent.OauthClientQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseOauthClient) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseOauthClient) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.OauthClientQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.OauthClientQuery", q)
+}
+
+// The OauthConsentFunc type is an adapter to allow the use of ordinary function as a Querier.
+type OauthConsentFunc func(context.Context, *ent.OauthConsentQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f OauthConsentFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.OauthConsentQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.OauthConsentQuery", q)
+}
+
+// The TraverseOauthConsent type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseOauthConsent func(context.Context, *ent.OauthConsentQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseOauthConsent) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseOauthConsent) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.OauthConsentQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.OauthConsentQuery", q)
+}
+
 // The OauthProviderFunc type is an adapter to allow the use of ordinary function as a Querier.
 type OauthProviderFunc func(context.Context, *ent.OauthProviderQuery) (ent.Value, error)
 
@@ -353,6 +669,60 @@ func (f TraverseOauthProvider) Traverse(ctx context.Context, q ent.Query) error
 	return fmt.Errorf("unexpected query type %T. expect *ent.OauthProviderQuery", q)
 }
 
+// The OauthProviderTemplateFunc type is an adapter to allow the use of ordinary function as a Querier.
+type OauthProviderTemplateFunc func(context.Context, *ent.OauthProviderTemplateQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f OauthProviderTemplateFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.OauthProviderTemplateQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.OauthProviderTemplateQuery", q)
+}
+
+// The TraverseOauthProviderTemplate type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseOauthProviderTemplate func(context.Context, *ent.OauthProviderTemplateQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseOauthProviderTemplate) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseOauthProviderTemplate) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.OauthProviderTemplateQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.OauthProviderTemplateQuery", q)
+}
+
+// The OauthScopeFunc type is an adapter to allow the use of ordinary function as a Querier.
+type OauthScopeFunc func(context.Context, *ent.OauthScopeQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f OauthScopeFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.OauthScopeQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.OauthScopeQuery", q)
+}
+
+// The TraverseOauthScope type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseOauthScope func(context.Context, *ent.OauthScopeQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseOauthScope) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseOauthScope) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.OauthScopeQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.OauthScopeQuery", q)
+}
+
 // The OauthSessionFunc type is an adapter to allow the use of ordinary function as a Querier.
 type OauthSessionFunc func(context.Context, *ent.OauthSessionQuery) (ent.Value, error)
 
@@ -434,6 +804,87 @@ func (f TraverseRole) Traverse(ctx context.Context, q ent.Query) error {
 	return fmt.Errorf("unexpected query type %T. expect *ent.RoleQuery", q)
 }
 
+// The SamlAccountFunc type is an adapter to allow the use of ordinary function as a Querier.
+type SamlAccountFunc func(context.Context, *ent.SamlAccountQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f SamlAccountFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.SamlAccountQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.SamlAccountQuery", q)
+}
+
+// The TraverseSamlAccount type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseSamlAccount func(context.Context, *ent.SamlAccountQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseSamlAccount) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseSamlAccount) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.SamlAccountQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.SamlAccountQuery", q)
+}
+
+// The SamlProviderFunc type is an adapter to allow the use of ordinary function as a Querier.
+type SamlProviderFunc func(context.Context, *ent.SamlProviderQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f SamlProviderFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.SamlProviderQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.SamlProviderQuery", q)
+}
+
+// The TraverseSamlProvider type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseSamlProvider func(context.Context, *ent.SamlProviderQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseSamlProvider) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseSamlProvider) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.SamlProviderQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.SamlProviderQuery", q)
+}
+
+// The ScimTokenFunc type is an adapter to allow the use of ordinary function as a Querier.
+type ScimTokenFunc func(context.Context, *ent.ScimTokenQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f ScimTokenFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.ScimTokenQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.ScimTokenQuery", q)
+}
+
+// The TraverseScimToken type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseScimToken func(context.Context, *ent.ScimTokenQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseScimToken) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseScimToken) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.ScimTokenQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.ScimTokenQuery", q)
+}
+
 // The TenantFunc type is an adapter to allow the use of ordinary function as a Querier.
 type TenantFunc func(context.Context, *ent.TenantQuery) (ent.Value, error)
 
@@ -461,6 +912,87 @@ func (f TraverseTenant) Traverse(ctx context.Context, q ent.Query) error {
 	return fmt.Errorf("unexpected query type %T. expect *ent.TenantQuery", q)
 }
 
+// The TenantInitJobFunc type is an adapter to allow the use of ordinary function as a Querier.
+type TenantInitJobFunc func(context.Context, *ent.TenantInitJobQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f TenantInitJobFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.TenantInitJobQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.TenantInitJobQuery", q)
+}
+
+// The TraverseTenantInitJob type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseTenantInitJob func(context.Context, *ent.TenantInitJobQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseTenantInitJob) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseTenantInitJob) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.TenantInitJobQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.TenantInitJobQuery", q)
+}
+
+// The TenantInitPluginFunc type is an adapter to allow the use of ordinary function as a Querier.
+type TenantInitPluginFunc func(context.Context, *ent.TenantInitPluginQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f TenantInitPluginFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.TenantInitPluginQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.TenantInitPluginQuery", q)
+}
+
+// The TraverseTenantInitPlugin type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseTenantInitPlugin func(context.Context, *ent.TenantInitPluginQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseTenantInitPlugin) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseTenantInitPlugin) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.TenantInitPluginQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.TenantInitPluginQuery", q)
+}
+
+// The TenantInitTemplateFunc type is an adapter to allow the use of ordinary function as a Querier.
+type TenantInitTemplateFunc func(context.Context, *ent.TenantInitTemplateQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f TenantInitTemplateFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.TenantInitTemplateQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.TenantInitTemplateQuery", q)
+}
+
+// The TraverseTenantInitTemplate type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseTenantInitTemplate func(context.Context, *ent.TenantInitTemplateQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseTenantInitTemplate) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseTenantInitTemplate) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.TenantInitTemplateQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.TenantInitTemplateQuery", q)
+}
+
 // The TokenFunc type is an adapter to allow the use of ordinary function as a Querier.
 type TokenFunc func(context.Context, *ent.TokenQuery) (ent.Value, error)
 
@@ -522,6 +1054,14 @@ func NewQuery(q ent.Query) (Query, error) {
 		return &query[*ent.APIQuery, predicate.API, api.OrderOption]{typ: ent.TypeAPI, tq: q}, nil
 	case *ent.AuditLogQuery:
 		return &query[*ent.AuditLogQuery, predicate.AuditLog, auditlog.OrderOption]{typ: ent.TypeAuditLog, tq: q}, nil
+	case *ent.AuditLogArchiveQuery:
+		return &query[*ent.AuditLogArchiveQuery, predicate.AuditLogArchive, auditlogarchive.OrderOption]{typ: ent.TypeAuditLogArchive, tq: q}, nil
+	case *ent.AuditLogChainQuery:
+		return &query[*ent.AuditLogChainQuery, predicate.AuditLogChain, auditlogchain.OrderOption]{typ: ent.TypeAuditLogChain, tq: q}, nil
+	case *ent.AuditLogCheckpointQuery:
+		return &query[*ent.AuditLogCheckpointQuery, predicate.AuditLogCheckpoint, auditlogcheckpoint.OrderOption]{typ: ent.TypeAuditLogCheckpoint, tq: q}, nil
+	case *ent.AuditLogPruneRecordQuery:
+		return &query[*ent.AuditLogPruneRecordQuery, predicate.AuditLogPruneRecord, auditlogprunerecord.OrderOption]{typ: ent.TypeAuditLogPruneRecord, tq: q}, nil
 	case *ent.CasbinRuleQuery:
 		return &query[*ent.CasbinRuleQuery, predicate.CasbinRule, casbinrule.OrderOption]{typ: ent.TypeCasbinRule, tq: q}, nil
 	case *ent.ConfigurationQuery:
@@ -532,20 +1072,50 @@ func NewQuery(q ent.Query) (Query, error) {
 		return &query[*ent.DictionaryQuery, predicate.Dictionary, dictionary.OrderOption]{typ: ent.TypeDictionary, tq: q}, nil
 	case *ent.DictionaryDetailQuery:
 		return &query[*ent.DictionaryDetailQuery, predicate.DictionaryDetail, dictionarydetail.OrderOption]{typ: ent.TypeDictionaryDetail, tq: q}, nil
+	case *ent.ImpersonationSessionQuery:
+		return &query[*ent.ImpersonationSessionQuery, predicate.ImpersonationSession, impersonationsession.OrderOption]{typ: ent.TypeImpersonationSession, tq: q}, nil
+	case *ent.LdapAccountQuery:
+		return &query[*ent.LdapAccountQuery, predicate.LdapAccount, ldapaccount.OrderOption]{typ: ent.TypeLdapAccount, tq: q}, nil
+	case *ent.LdapDepartmentQuery:
+		return &query[*ent.LdapDepartmentQuery, predicate.LdapDepartment, ldapdepartment.OrderOption]{typ: ent.TypeLdapDepartment, tq: q}, nil
+	case *ent.LdapProviderQuery:
+		return &query[*ent.LdapProviderQuery, predicate.LdapProvider, ldapprovider.OrderOption]{typ: ent.TypeLdapProvider, tq: q}, nil
+	case *ent.LdapSyncRunQuery:
+		return &query[*ent.LdapSyncRunQuery, predicate.LdapSyncRun, ldapsyncrun.OrderOption]{typ: ent.TypeLdapSyncRun, tq: q}, nil
 	case *ent.MenuQuery:
 		return &query[*ent.MenuQuery, predicate.Menu, menu.OrderOption]{typ: ent.TypeMenu, tq: q}, nil
 	case *ent.OauthAccountQuery:
 		return &query[*ent.OauthAccountQuery, predicate.OauthAccount, oauthaccount.OrderOption]{typ: ent.TypeOauthAccount, tq: q}, nil
+	case *ent.OauthClientQuery:
+		return &query[*ent.OauthClientQuery, predicate.OauthClient, oauthclient.OrderOption]{typ: ent.TypeOauthClient, tq: q}, nil
+	case *ent.OauthConsentQuery:
+		return &query[*ent.OauthConsentQuery, predicate.OauthConsent, oauthconsent.OrderOption]{typ: ent.TypeOauthConsent, tq: q}, nil
 	case *ent.OauthProviderQuery:
 		return &query[*ent.OauthProviderQuery, predicate.OauthProvider, oauthprovider.OrderOption]{typ: ent.TypeOauthProvider, tq: q}, nil
+	case *ent.OauthProviderTemplateQuery:
+		return &query[*ent.OauthProviderTemplateQuery, predicate.OauthProviderTemplate, oauthprovidertemplate.OrderOption]{typ: ent.TypeOauthProviderTemplate, tq: q}, nil
+	case *ent.OauthScopeQuery:
+		return &query[*ent.OauthScopeQuery, predicate.OauthScope, oauthscope.OrderOption]{typ: ent.TypeOauthScope, tq: q}, nil
 	case *ent.OauthSessionQuery:
 		return &query[*ent.OauthSessionQuery, predicate.OauthSession, oauthsession.OrderOption]{typ: ent.TypeOauthSession, tq: q}, nil
 	case *ent.PositionQuery:
 		return &query[*ent.PositionQuery, predicate.Position, position.OrderOption]{typ: ent.TypePosition, tq: q}, nil
 	case *ent.RoleQuery:
 		return &query[*ent.RoleQuery, predicate.Role, role.OrderOption]{typ: ent.TypeRole, tq: q}, nil
+	case *ent.SamlAccountQuery:
+		return &query[*ent.SamlAccountQuery, predicate.SamlAccount, samlaccount.OrderOption]{typ: ent.TypeSamlAccount, tq: q}, nil
+	case *ent.SamlProviderQuery:
+		return &query[*ent.SamlProviderQuery, predicate.SamlProvider, samlprovider.OrderOption]{typ: ent.TypeSamlProvider, tq: q}, nil
+	case *ent.ScimTokenQuery:
+		return &query[*ent.ScimTokenQuery, predicate.ScimToken, scimtoken.OrderOption]{typ: ent.TypeScimToken, tq: q}, nil
 	case *ent.TenantQuery:
 		return &query[*ent.TenantQuery, predicate.Tenant, tenant.OrderOption]{typ: ent.TypeTenant, tq: q}, nil
+	case *ent.TenantInitJobQuery:
+		return &query[*ent.TenantInitJobQuery, predicate.TenantInitJob, tenantinitjob.OrderOption]{typ: ent.TypeTenantInitJob, tq: q}, nil
+	case *ent.TenantInitPluginQuery:
+		return &query[*ent.TenantInitPluginQuery, predicate.TenantInitPlugin, tenantinitplugin.OrderOption]{typ: ent.TypeTenantInitPlugin, tq: q}, nil
+	case *ent.TenantInitTemplateQuery:
+		return &query[*ent.TenantInitTemplateQuery, predicate.TenantInitTemplate, tenantinittemplate.OrderOption]{typ: ent.TypeTenantInitTemplate, tq: q}, nil
 	case *ent.TokenQuery:
 		return &query[*ent.TokenQuery, predicate.Token, token.OrderOption]{typ: ent.TypeToken, tq: q}, nil
 	case *ent.UserQuery:
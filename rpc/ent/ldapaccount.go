@@ -0,0 +1,278 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/coder-lulu/newbee-core/rpc/ent/ldapaccount"
+	"github.com/coder-lulu/newbee-core/rpc/ent/ldapprovider"
+	"github.com/coder-lulu/newbee-core/rpc/ent/user"
+	uuid "github.com/gofrs/uuid/v5"
+)
+
+// LDAP Account Binding Table | LDAP账户绑定表
+type LdapAccount struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uint64 `json:"id,omitempty"`
+	// Create Time | 创建日期
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Update Time | 修改日期
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Status 1: normal 2: ban | 状态 1 正常 2 禁用
+	Status uint8 `json:"status,omitempty"`
+	// Tenant ID | 租户 ID
+	TenantID uint64 `json:"tenant_id,omitempty"`
+	// Associated user ID | 关联的用户ID
+	UserID uuid.UUID `json:"user_id,omitempty"`
+	// LDAP provider ID | LDAP目录ID
+	ProviderID uint64 `json:"provider_id,omitempty"`
+	// Unique ID of the entry, e.g. entryUUID or objectGUID | 目录条目的唯一标识
+	ExternalID string `json:"external_id,omitempty"`
+	// DN of the entry | 目录条目DN
+	Dn string `json:"dn,omitempty"`
+	// Last sync time | 最近同步时间
+	SyncedAt time.Time `json:"synced_at,omitempty"`
+	// Last login time | 最后登录时间
+	LastLoginAt time.Time `json:"last_login_at,omitempty"`
+	// Last login IP address | 最后登录IP地址
+	LastLoginIP string `json:"last_login_ip,omitempty"`
+	// Login count | 登录次数
+	LoginCount uint32 `json:"login_count,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the LdapAccountQuery when eager-loading is set.
+	Edges        LdapAccountEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// LdapAccountEdges holds the relations/edges for other nodes in the graph.
+type LdapAccountEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// Provider holds the value of the provider edge.
+	Provider *LdapProvider `json:"provider,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e LdapAccountEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// ProviderOrErr returns the Provider value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e LdapAccountEdges) ProviderOrErr() (*LdapProvider, error) {
+	if e.Provider != nil {
+		return e.Provider, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: ldapprovider.Label}
+	}
+	return nil, &NotLoadedError{edge: "provider"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*LdapAccount) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case ldapaccount.FieldID, ldapaccount.FieldStatus, ldapaccount.FieldTenantID, ldapaccount.FieldProviderID, ldapaccount.FieldLoginCount:
+			values[i] = new(sql.NullInt64)
+		case ldapaccount.FieldExternalID, ldapaccount.FieldDn, ldapaccount.FieldLastLoginIP:
+			values[i] = new(sql.NullString)
+		case ldapaccount.FieldCreatedAt, ldapaccount.FieldUpdatedAt, ldapaccount.FieldSyncedAt, ldapaccount.FieldLastLoginAt:
+			values[i] = new(sql.NullTime)
+		case ldapaccount.FieldUserID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the LdapAccount fields.
+func (_m *LdapAccount) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case ldapaccount.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = uint64(value.Int64)
+		case ldapaccount.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case ldapaccount.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case ldapaccount.FieldStatus:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = uint8(value.Int64)
+			}
+		case ldapaccount.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				_m.TenantID = uint64(value.Int64)
+			}
+		case ldapaccount.FieldUserID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value != nil {
+				_m.UserID = *value
+			}
+		case ldapaccount.FieldProviderID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field provider_id", values[i])
+			} else if value.Valid {
+				_m.ProviderID = uint64(value.Int64)
+			}
+		case ldapaccount.FieldExternalID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field external_id", values[i])
+			} else if value.Valid {
+				_m.ExternalID = value.String
+			}
+		case ldapaccount.FieldDn:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field dn", values[i])
+			} else if value.Valid {
+				_m.Dn = value.String
+			}
+		case ldapaccount.FieldSyncedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field synced_at", values[i])
+			} else if value.Valid {
+				_m.SyncedAt = value.Time
+			}
+		case ldapaccount.FieldLastLoginAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_login_at", values[i])
+			} else if value.Valid {
+				_m.LastLoginAt = value.Time
+			}
+		case ldapaccount.FieldLastLoginIP:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field last_login_ip", values[i])
+			} else if value.Valid {
+				_m.LastLoginIP = value.String
+			}
+		case ldapaccount.FieldLoginCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field login_count", values[i])
+			} else if value.Valid {
+				_m.LoginCount = uint32(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the LdapAccount.
+// This includes values selected through modifiers, order, etc.
+func (_m *LdapAccount) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the LdapAccount entity.
+func (_m *LdapAccount) QueryUser() *UserQuery {
+	return NewLdapAccountClient(_m.config).QueryUser(_m)
+}
+
+// QueryProvider queries the "provider" edge of the LdapAccount entity.
+func (_m *LdapAccount) QueryProvider() *LdapProviderQuery {
+	return NewLdapAccountClient(_m.config).QueryProvider(_m)
+}
+
+// Update returns a builder for updating this LdapAccount.
+// Note that you need to call LdapAccount.Unwrap() before calling this method if this LdapAccount
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *LdapAccount) Update() *LdapAccountUpdateOne {
+	return NewLdapAccountClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the LdapAccount entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *LdapAccount) Unwrap() *LdapAccount {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: LdapAccount is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *LdapAccount) String() string {
+	var builder strings.Builder
+	builder.WriteString("LdapAccount(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("provider_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ProviderID))
+	builder.WriteString(", ")
+	builder.WriteString("external_id=")
+	builder.WriteString(_m.ExternalID)
+	builder.WriteString(", ")
+	builder.WriteString("dn=")
+	builder.WriteString(_m.Dn)
+	builder.WriteString(", ")
+	builder.WriteString("synced_at=")
+	builder.WriteString(_m.SyncedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("last_login_at=")
+	builder.WriteString(_m.LastLoginAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("last_login_ip=")
+	builder.WriteString(_m.LastLoginIP)
+	builder.WriteString(", ")
+	builder.WriteString("login_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LoginCount))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// LdapAccounts is a parsable slice of LdapAccount.
+type LdapAccounts []*LdapAccount
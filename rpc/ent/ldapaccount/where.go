@@ -0,0 +1,733 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ldapaccount
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/coder-lulu/newbee-core/rpc/ent/predicate"
+	uuid "github.com/gofrs/uuid/v5"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uint64) predicate.LdapAccount {
+	return predicate.LdapAccount(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uint64) predicate.LdapAccount {
+	return predicate.LdapAccount(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uint64) predicate.LdapAccount {
+	return predicate.LdapAccount(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uint64) predicate.LdapAccount {
+	return predicate.LdapAccount(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uint64) predicate.LdapAccount {
+	return predicate.LdapAccount(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uint64) predicate.LdapAccount {
+	return predicate.LdapAccount(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uint64) predicate.LdapAccount {
+	return predicate.LdapAccount(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uint64) predicate.LdapAccount {
+	return predicate.LdapAccount(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uint64) predicate.LdapAccount {
+	return predicate.LdapAccount(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.LdapAccount {
+	return predicate.LdapAccount(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.LdapAccount {
+	return predicate.LdapAccount(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v uint8) predicate.LdapAccount {
+	return predicate.LdapAccount(sql.FieldEQ(FieldStatus, v))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v uint64) predicate.LdapAccount {
+	return predicate.LdapAccount(sql.FieldEQ(FieldTenantID, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v uuid.UUID) predicate.LdapAccount {
+	return predicate.LdapAccount(sql.FieldEQ(FieldUserID, v))
+}
+
+// ProviderID applies equality check predicate on the "provider_id" field. It's identical to ProviderIDEQ.
+func ProviderID(v uint64) predicate.LdapAccount {
+	return predicate.LdapAccount(sql.FieldEQ(FieldProviderID, v))
+}
+
+// ExternalID applies equality check predicate on the "external_id" field. It's identical to ExternalIDEQ.
+func ExternalID(v string) predicate.LdapAccount {
+	return predicate.LdapAccount(sql.FieldEQ(FieldExternalID, v))
+}
+
+// Dn applies equality check predicate on the "dn" field. It's identical to DnEQ.
+func Dn(v string) predicate.LdapAccount {
+	return predicate.LdapAccount(sql.FieldEQ(FieldDn, v))
+}
+
+// SyncedAt applies equality check predicate on the "synced_at" field. It's identical to SyncedAtEQ.
+func SyncedAt(v time.Time) predicate.LdapAccount {
+	return predicate.LdapAccount(sql.FieldEQ(FieldSyncedAt, v))
+}
+
+// LastLoginAt applies equality check predicate on the "last_login_at" field. It's identical to LastLoginAtEQ.
+func LastLoginAt(v time.Time) predicate.LdapAccount {
+	return predicate.LdapAccount(sql.FieldEQ(FieldLastLoginAt, v))
+}
+
+// LastLoginIP applies equality check predicate on the "last_login_ip" field. It's identical to LastLoginIPEQ.
+func LastLoginIP(v string) predicate.LdapAccount {
+	return predicate.LdapAccount(sql.FieldEQ(FieldLastLoginIP, v))
+}
+
+// LoginCount applies equality check predicate on the "login_count" field. It's identical to LoginCountEQ.
+func LoginCount(v uint32) predicate.LdapAccount {
+	return predicate.LdapAccount(sql.FieldEQ(FieldLoginCount, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.LdapAccount {
+	return predicate.LdapAccount(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.LdapAccount {
+	return predicate.LdapAccount(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.LdapAccount {
+	return predicate.LdapAccount(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.LdapAccount {
+	return predicate.LdapAccount(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.LdapAccount {
+	return predicate.LdapAccount(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.LdapAccount {
+	return predicate.LdapAccount(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.LdapAccount {
+	return predicate.LdapAccount(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.LdapAccount {
+	return predicate.LdapAccount(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.LdapAccount {
+	return predicate.LdapAccount(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.LdapAccount {
+	return predicate.LdapAccount(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.LdapAccount {
+	return predicate.LdapAccount(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.LdapAccount {
+	return predicate.LdapAccount(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.LdapAccount {
+	return predicate.LdapAccount(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.LdapAccount {
+	return predicate.LdapAccount(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.LdapAccount {
+	return predicate.LdapAccount(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.LdapAccount {
+	return predicate.LdapAccount(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v uint8) predicate.LdapAccount {
+	return predicate.LdapAccount(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v uint8) predicate.LdapAccount {
+	return predicate.LdapAccount(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...uint8) predicate.LdapAccount {
+	return predicate.LdapAccount(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...uint8) predicate.LdapAccount {
+	return predicate.LdapAccount(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v uint8) predicate.LdapAccount {
+	return predicate.LdapAccount(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v uint8) predicate.LdapAccount {
+	return predicate.LdapAccount(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v uint8) predicate.LdapAccount {
+	return predicate.LdapAccount(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v uint8) predicate.LdapAccount {
+	return predicate.LdapAccount(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusIsNil applies the IsNil predicate on the "status" field.
+func StatusIsNil() predicate.LdapAccount {
+	return predicate.LdapAccount(sql.FieldIsNull(FieldStatus))
+}
+
+// StatusNotNil applies the NotNil predicate on the "status" field.
+func StatusNotNil() predicate.LdapAccount {
+	return predicate.LdapAccount(sql.FieldNotNull(FieldStatus))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v uint64) predicate.LdapAccount {
+	return predicate.LdapAccount(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v uint64) predicate.LdapAccount {
+	return predicate.LdapAccount(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...uint64) predicate.LdapAccount {
+	return predicate.LdapAccount(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...uint64) predicate.LdapAccount {
+	return predicate.LdapAccount(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v uint64) predicate.LdapAccount {
+	return predicate.LdapAccount(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v uint64) predicate.LdapAccount {
+	return predicate.LdapAccount(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v uint64) predicate.LdapAccount {
+	return predicate.LdapAccount(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v uint64) predicate.LdapAccount {
+	return predicate.LdapAccount(sql.FieldLTE(FieldTenantID, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v uuid.UUID) predicate.LdapAccount {
+	return predicate.LdapAccount(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v uuid.UUID) predicate.LdapAccount {
+	return predicate.LdapAccount(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...uuid.UUID) predicate.LdapAccount {
+	return predicate.LdapAccount(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...uuid.UUID) predicate.LdapAccount {
+	return predicate.LdapAccount(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// ProviderIDEQ applies the EQ predicate on the "provider_id" field.
+func ProviderIDEQ(v uint64) predicate.LdapAccount {
+	return predicate.LdapAccount(sql.FieldEQ(FieldProviderID, v))
+}
+
+// ProviderIDNEQ applies the NEQ predicate on the "provider_id" field.
+func ProviderIDNEQ(v uint64) predicate.LdapAccount {
+	return predicate.LdapAccount(sql.FieldNEQ(FieldProviderID, v))
+}
+
+// ProviderIDIn applies the In predicate on the "provider_id" field.
+func ProviderIDIn(vs ...uint64) predicate.LdapAccount {
+	return predicate.LdapAccount(sql.FieldIn(FieldProviderID, vs...))
+}
+
+// ProviderIDNotIn applies the NotIn predicate on the "provider_id" field.
+func ProviderIDNotIn(vs ...uint64) predicate.LdapAccount {
+	return predicate.LdapAccount(sql.FieldNotIn(FieldProviderID, vs...))
+}
+
+// ExternalIDEQ applies the EQ predicate on the "external_id" field.
+func ExternalIDEQ(v string) predicate.LdapAccount {
+	return predicate.LdapAccount(sql.FieldEQ(FieldExternalID, v))
+}
+
+// ExternalIDNEQ applies the NEQ predicate on the "external_id" field.
+func ExternalIDNEQ(v string) predicate.LdapAccount {
+	return predicate.LdapAccount(sql.FieldNEQ(FieldExternalID, v))
+}
+
+// ExternalIDIn applies the In predicate on the "external_id" field.
+func ExternalIDIn(vs ...string) predicate.LdapAccount {
+	return predicate.LdapAccount(sql.FieldIn(FieldExternalID, vs...))
+}
+
+// ExternalIDNotIn applies the NotIn predicate on the "external_id" field.
+func ExternalIDNotIn(vs ...string) predicate.LdapAccount {
+	return predicate.LdapAccount(sql.FieldNotIn(FieldExternalID, vs...))
+}
+
+// ExternalIDGT applies the GT predicate on the "external_id" field.
+func ExternalIDGT(v string) predicate.LdapAccount {
+	return predicate.LdapAccount(sql.FieldGT(FieldExternalID, v))
+}
+
+// ExternalIDGTE applies the GTE predicate on the "external_id" field.
+func ExternalIDGTE(v string) predicate.LdapAccount {
+	return predicate.LdapAccount(sql.FieldGTE(FieldExternalID, v))
+}
+
+// ExternalIDLT applies the LT predicate on the "external_id" field.
+func ExternalIDLT(v string) predicate.LdapAccount {
+	return predicate.LdapAccount(sql.FieldLT(FieldExternalID, v))
+}
+
+// ExternalIDLTE applies the LTE predicate on the "external_id" field.
+func ExternalIDLTE(v string) predicate.LdapAccount {
+	return predicate.LdapAccount(sql.FieldLTE(FieldExternalID, v))
+}
+
+// ExternalIDContains applies the Contains predicate on the "external_id" field.
+func ExternalIDContains(v string) predicate.LdapAccount {
+	return predicate.LdapAccount(sql.FieldContains(FieldExternalID, v))
+}
+
+// ExternalIDHasPrefix applies the HasPrefix predicate on the "external_id" field.
+func ExternalIDHasPrefix(v string) predicate.LdapAccount {
+	return predicate.LdapAccount(sql.FieldHasPrefix(FieldExternalID, v))
+}
+
+// ExternalIDHasSuffix applies the HasSuffix predicate on the "external_id" field.
+func ExternalIDHasSuffix(v string) predicate.LdapAccount {
+	return predicate.LdapAccount(sql.FieldHasSuffix(FieldExternalID, v))
+}
+
+// ExternalIDEqualFold applies the EqualFold predicate on the "external_id" field.
+func ExternalIDEqualFold(v string) predicate.LdapAccount {
+	return predicate.LdapAccount(sql.FieldEqualFold(FieldExternalID, v))
+}
+
+// ExternalIDContainsFold applies the ContainsFold predicate on the "external_id" field.
+func ExternalIDContainsFold(v string) predicate.LdapAccount {
+	return predicate.LdapAccount(sql.FieldContainsFold(FieldExternalID, v))
+}
+
+// DnEQ applies the EQ predicate on the "dn" field.
+func DnEQ(v string) predicate.LdapAccount {
+	return predicate.LdapAccount(sql.FieldEQ(FieldDn, v))
+}
+
+// DnNEQ applies the NEQ predicate on the "dn" field.
+func DnNEQ(v string) predicate.LdapAccount {
+	return predicate.LdapAccount(sql.FieldNEQ(FieldDn, v))
+}
+
+// DnIn applies the In predicate on the "dn" field.
+func DnIn(vs ...string) predicate.LdapAccount {
+	return predicate.LdapAccount(sql.FieldIn(FieldDn, vs...))
+}
+
+// DnNotIn applies the NotIn predicate on the "dn" field.
+func DnNotIn(vs ...string) predicate.LdapAccount {
+	return predicate.LdapAccount(sql.FieldNotIn(FieldDn, vs...))
+}
+
+// DnGT applies the GT predicate on the "dn" field.
+func DnGT(v string) predicate.LdapAccount {
+	return predicate.LdapAccount(sql.FieldGT(FieldDn, v))
+}
+
+// DnGTE applies the GTE predicate on the "dn" field.
+func DnGTE(v string) predicate.LdapAccount {
+	return predicate.LdapAccount(sql.FieldGTE(FieldDn, v))
+}
+
+// DnLT applies the LT predicate on the "dn" field.
+func DnLT(v string) predicate.LdapAccount {
+	return predicate.LdapAccount(sql.FieldLT(FieldDn, v))
+}
+
+// DnLTE applies the LTE predicate on the "dn" field.
+func DnLTE(v string) predicate.LdapAccount {
+	return predicate.LdapAccount(sql.FieldLTE(FieldDn, v))
+}
+
+// DnContains applies the Contains predicate on the "dn" field.
+func DnContains(v string) predicate.LdapAccount {
+	return predicate.LdapAccount(sql.FieldContains(FieldDn, v))
+}
+
+// DnHasPrefix applies the HasPrefix predicate on the "dn" field.
+func DnHasPrefix(v string) predicate.LdapAccount {
+	return predicate.LdapAccount(sql.FieldHasPrefix(FieldDn, v))
+}
+
+// DnHasSuffix applies the HasSuffix predicate on the "dn" field.
+func DnHasSuffix(v string) predicate.LdapAccount {
+	return predicate.LdapAccount(sql.FieldHasSuffix(FieldDn, v))
+}
+
+// DnEqualFold applies the EqualFold predicate on the "dn" field.
+func DnEqualFold(v string) predicate.LdapAccount {
+	return predicate.LdapAccount(sql.FieldEqualFold(FieldDn, v))
+}
+
+// DnContainsFold applies the ContainsFold predicate on the "dn" field.
+func DnContainsFold(v string) predicate.LdapAccount {
+	return predicate.LdapAccount(sql.FieldContainsFold(FieldDn, v))
+}
+
+// SyncedAtEQ applies the EQ predicate on the "synced_at" field.
+func SyncedAtEQ(v time.Time) predicate.LdapAccount {
+	return predicate.LdapAccount(sql.FieldEQ(FieldSyncedAt, v))
+}
+
+// SyncedAtNEQ applies the NEQ predicate on the "synced_at" field.
+func SyncedAtNEQ(v time.Time) predicate.LdapAccount {
+	return predicate.LdapAccount(sql.FieldNEQ(FieldSyncedAt, v))
+}
+
+// SyncedAtIn applies the In predicate on the "synced_at" field.
+func SyncedAtIn(vs ...time.Time) predicate.LdapAccount {
+	return predicate.LdapAccount(sql.FieldIn(FieldSyncedAt, vs...))
+}
+
+// SyncedAtNotIn applies the NotIn predicate on the "synced_at" field.
+func SyncedAtNotIn(vs ...time.Time) predicate.LdapAccount {
+	return predicate.LdapAccount(sql.FieldNotIn(FieldSyncedAt, vs...))
+}
+
+// SyncedAtGT applies the GT predicate on the "synced_at" field.
+func SyncedAtGT(v time.Time) predicate.LdapAccount {
+	return predicate.LdapAccount(sql.FieldGT(FieldSyncedAt, v))
+}
+
+// SyncedAtGTE applies the GTE predicate on the "synced_at" field.
+func SyncedAtGTE(v time.Time) predicate.LdapAccount {
+	return predicate.LdapAccount(sql.FieldGTE(FieldSyncedAt, v))
+}
+
+// SyncedAtLT applies the LT predicate on the "synced_at" field.
+func SyncedAtLT(v time.Time) predicate.LdapAccount {
+	return predicate.LdapAccount(sql.FieldLT(FieldSyncedAt, v))
+}
+
+// SyncedAtLTE applies the LTE predicate on the "synced_at" field.
+func SyncedAtLTE(v time.Time) predicate.LdapAccount {
+	return predicate.LdapAccount(sql.FieldLTE(FieldSyncedAt, v))
+}
+
+// SyncedAtIsNil applies the IsNil predicate on the "synced_at" field.
+func SyncedAtIsNil() predicate.LdapAccount {
+	return predicate.LdapAccount(sql.FieldIsNull(FieldSyncedAt))
+}
+
+// SyncedAtNotNil applies the NotNil predicate on the "synced_at" field.
+func SyncedAtNotNil() predicate.LdapAccount {
+	return predicate.LdapAccount(sql.FieldNotNull(FieldSyncedAt))
+}
+
+// LastLoginAtEQ applies the EQ predicate on the "last_login_at" field.
+func LastLoginAtEQ(v time.Time) predicate.LdapAccount {
+	return predicate.LdapAccount(sql.FieldEQ(FieldLastLoginAt, v))
+}
+
+// LastLoginAtNEQ applies the NEQ predicate on the "last_login_at" field.
+func LastLoginAtNEQ(v time.Time) predicate.LdapAccount {
+	return predicate.LdapAccount(sql.FieldNEQ(FieldLastLoginAt, v))
+}
+
+// LastLoginAtIn applies the In predicate on the "last_login_at" field.
+func LastLoginAtIn(vs ...time.Time) predicate.LdapAccount {
+	return predicate.LdapAccount(sql.FieldIn(FieldLastLoginAt, vs...))
+}
+
+// LastLoginAtNotIn applies the NotIn predicate on the "last_login_at" field.
+func LastLoginAtNotIn(vs ...time.Time) predicate.LdapAccount {
+	return predicate.LdapAccount(sql.FieldNotIn(FieldLastLoginAt, vs...))
+}
+
+// LastLoginAtGT applies the GT predicate on the "last_login_at" field.
+func LastLoginAtGT(v time.Time) predicate.LdapAccount {
+	return predicate.LdapAccount(sql.FieldGT(FieldLastLoginAt, v))
+}
+
+// LastLoginAtGTE applies the GTE predicate on the "last_login_at" field.
+func LastLoginAtGTE(v time.Time) predicate.LdapAccount {
+	return predicate.LdapAccount(sql.FieldGTE(FieldLastLoginAt, v))
+}
+
+// LastLoginAtLT applies the LT predicate on the "last_login_at" field.
+func LastLoginAtLT(v time.Time) predicate.LdapAccount {
+	return predicate.LdapAccount(sql.FieldLT(FieldLastLoginAt, v))
+}
+
+// LastLoginAtLTE applies the LTE predicate on the "last_login_at" field.
+func LastLoginAtLTE(v time.Time) predicate.LdapAccount {
+	return predicate.LdapAccount(sql.FieldLTE(FieldLastLoginAt, v))
+}
+
+// LastLoginAtIsNil applies the IsNil predicate on the "last_login_at" field.
+func LastLoginAtIsNil() predicate.LdapAccount {
+	return predicate.LdapAccount(sql.FieldIsNull(FieldLastLoginAt))
+}
+
+// LastLoginAtNotNil applies the NotNil predicate on the "last_login_at" field.
+func LastLoginAtNotNil() predicate.LdapAccount {
+	return predicate.LdapAccount(sql.FieldNotNull(FieldLastLoginAt))
+}
+
+// LastLoginIPEQ applies the EQ predicate on the "last_login_ip" field.
+func LastLoginIPEQ(v string) predicate.LdapAccount {
+	return predicate.LdapAccount(sql.FieldEQ(FieldLastLoginIP, v))
+}
+
+// LastLoginIPNEQ applies the NEQ predicate on the "last_login_ip" field.
+func LastLoginIPNEQ(v string) predicate.LdapAccount {
+	return predicate.LdapAccount(sql.FieldNEQ(FieldLastLoginIP, v))
+}
+
+// LastLoginIPIn applies the In predicate on the "last_login_ip" field.
+func LastLoginIPIn(vs ...string) predicate.LdapAccount {
+	return predicate.LdapAccount(sql.FieldIn(FieldLastLoginIP, vs...))
+}
+
+// LastLoginIPNotIn applies the NotIn predicate on the "last_login_ip" field.
+func LastLoginIPNotIn(vs ...string) predicate.LdapAccount {
+	return predicate.LdapAccount(sql.FieldNotIn(FieldLastLoginIP, vs...))
+}
+
+// LastLoginIPGT applies the GT predicate on the "last_login_ip" field.
+func LastLoginIPGT(v string) predicate.LdapAccount {
+	return predicate.LdapAccount(sql.FieldGT(FieldLastLoginIP, v))
+}
+
+// LastLoginIPGTE applies the GTE predicate on the "last_login_ip" field.
+func LastLoginIPGTE(v string) predicate.LdapAccount {
+	return predicate.LdapAccount(sql.FieldGTE(FieldLastLoginIP, v))
+}
+
+// LastLoginIPLT applies the LT predicate on the "last_login_ip" field.
+func LastLoginIPLT(v string) predicate.LdapAccount {
+	return predicate.LdapAccount(sql.FieldLT(FieldLastLoginIP, v))
+}
+
+// LastLoginIPLTE applies the LTE predicate on the "last_login_ip" field.
+func LastLoginIPLTE(v string) predicate.LdapAccount {
+	return predicate.LdapAccount(sql.FieldLTE(FieldLastLoginIP, v))
+}
+
+// LastLoginIPContains applies the Contains predicate on the "last_login_ip" field.
+func LastLoginIPContains(v string) predicate.LdapAccount {
+	return predicate.LdapAccount(sql.FieldContains(FieldLastLoginIP, v))
+}
+
+// LastLoginIPHasPrefix applies the HasPrefix predicate on the "last_login_ip" field.
+func LastLoginIPHasPrefix(v string) predicate.LdapAccount {
+	return predicate.LdapAccount(sql.FieldHasPrefix(FieldLastLoginIP, v))
+}
+
+// LastLoginIPHasSuffix applies the HasSuffix predicate on the "last_login_ip" field.
+func LastLoginIPHasSuffix(v string) predicate.LdapAccount {
+	return predicate.LdapAccount(sql.FieldHasSuffix(FieldLastLoginIP, v))
+}
+
+// LastLoginIPIsNil applies the IsNil predicate on the "last_login_ip" field.
+func LastLoginIPIsNil() predicate.LdapAccount {
+	return predicate.LdapAccount(sql.FieldIsNull(FieldLastLoginIP))
+}
+
+// LastLoginIPNotNil applies the NotNil predicate on the "last_login_ip" field.
+func LastLoginIPNotNil() predicate.LdapAccount {
+	return predicate.LdapAccount(sql.FieldNotNull(FieldLastLoginIP))
+}
+
+// LastLoginIPEqualFold applies the EqualFold predicate on the "last_login_ip" field.
+func LastLoginIPEqualFold(v string) predicate.LdapAccount {
+	return predicate.LdapAccount(sql.FieldEqualFold(FieldLastLoginIP, v))
+}
+
+// LastLoginIPContainsFold applies the ContainsFold predicate on the "last_login_ip" field.
+func LastLoginIPContainsFold(v string) predicate.LdapAccount {
+	return predicate.LdapAccount(sql.FieldContainsFold(FieldLastLoginIP, v))
+}
+
+// LoginCountEQ applies the EQ predicate on the "login_count" field.
+func LoginCountEQ(v uint32) predicate.LdapAccount {
+	return predicate.LdapAccount(sql.FieldEQ(FieldLoginCount, v))
+}
+
+// LoginCountNEQ applies the NEQ predicate on the "login_count" field.
+func LoginCountNEQ(v uint32) predicate.LdapAccount {
+	return predicate.LdapAccount(sql.FieldNEQ(FieldLoginCount, v))
+}
+
+// LoginCountIn applies the In predicate on the "login_count" field.
+func LoginCountIn(vs ...uint32) predicate.LdapAccount {
+	return predicate.LdapAccount(sql.FieldIn(FieldLoginCount, vs...))
+}
+
+// LoginCountNotIn applies the NotIn predicate on the "login_count" field.
+func LoginCountNotIn(vs ...uint32) predicate.LdapAccount {
+	return predicate.LdapAccount(sql.FieldNotIn(FieldLoginCount, vs...))
+}
+
+// LoginCountGT applies the GT predicate on the "login_count" field.
+func LoginCountGT(v uint32) predicate.LdapAccount {
+	return predicate.LdapAccount(sql.FieldGT(FieldLoginCount, v))
+}
+
+// LoginCountGTE applies the GTE predicate on the "login_count" field.
+func LoginCountGTE(v uint32) predicate.LdapAccount {
+	return predicate.LdapAccount(sql.FieldGTE(FieldLoginCount, v))
+}
+
+// LoginCountLT applies the LT predicate on the "login_count" field.
+func LoginCountLT(v uint32) predicate.LdapAccount {
+	return predicate.LdapAccount(sql.FieldLT(FieldLoginCount, v))
+}
+
+// LoginCountLTE applies the LTE predicate on the "login_count" field.
+func LoginCountLTE(v uint32) predicate.LdapAccount {
+	return predicate.LdapAccount(sql.FieldLTE(FieldLoginCount, v))
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.LdapAccount {
+	return predicate.LdapAccount(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.LdapAccount {
+	return predicate.LdapAccount(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasProvider applies the HasEdge predicate on the "provider" edge.
+func HasProvider() predicate.LdapAccount {
+	return predicate.LdapAccount(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ProviderTable, ProviderColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasProviderWith applies the HasEdge predicate on the "provider" edge with a given conditions (other predicates).
+func HasProviderWith(preds ...predicate.LdapProvider) predicate.LdapAccount {
+	return predicate.LdapAccount(func(s *sql.Selector) {
+		step := newProviderStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.LdapAccount) predicate.LdapAccount {
+	return predicate.LdapAccount(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.LdapAccount) predicate.LdapAccount {
+	return predicate.LdapAccount(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.LdapAccount) predicate.LdapAccount {
+	return predicate.LdapAccount(sql.NotPredicates(p))
+}
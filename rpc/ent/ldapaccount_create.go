@@ -0,0 +1,477 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/coder-lulu/newbee-core/rpc/ent/ldapaccount"
+	"github.com/coder-lulu/newbee-core/rpc/ent/ldapprovider"
+	"github.com/coder-lulu/newbee-core/rpc/ent/user"
+	uuid "github.com/gofrs/uuid/v5"
+)
+
+// LdapAccountCreate is the builder for creating a LdapAccount entity.
+type LdapAccountCreate struct {
+	config
+	mutation *LdapAccountMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *LdapAccountCreate) SetCreatedAt(v time.Time) *LdapAccountCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *LdapAccountCreate) SetNillableCreatedAt(v *time.Time) *LdapAccountCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *LdapAccountCreate) SetUpdatedAt(v time.Time) *LdapAccountCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *LdapAccountCreate) SetNillableUpdatedAt(v *time.Time) *LdapAccountCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *LdapAccountCreate) SetStatus(v uint8) *LdapAccountCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *LdapAccountCreate) SetNillableStatus(v *uint8) *LdapAccountCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *LdapAccountCreate) SetTenantID(v uint64) *LdapAccountCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_c *LdapAccountCreate) SetNillableTenantID(v *uint64) *LdapAccountCreate {
+	if v != nil {
+		_c.SetTenantID(*v)
+	}
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *LdapAccountCreate) SetUserID(v uuid.UUID) *LdapAccountCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetProviderID sets the "provider_id" field.
+func (_c *LdapAccountCreate) SetProviderID(v uint64) *LdapAccountCreate {
+	_c.mutation.SetProviderID(v)
+	return _c
+}
+
+// SetExternalID sets the "external_id" field.
+func (_c *LdapAccountCreate) SetExternalID(v string) *LdapAccountCreate {
+	_c.mutation.SetExternalID(v)
+	return _c
+}
+
+// SetDn sets the "dn" field.
+func (_c *LdapAccountCreate) SetDn(v string) *LdapAccountCreate {
+	_c.mutation.SetDn(v)
+	return _c
+}
+
+// SetSyncedAt sets the "synced_at" field.
+func (_c *LdapAccountCreate) SetSyncedAt(v time.Time) *LdapAccountCreate {
+	_c.mutation.SetSyncedAt(v)
+	return _c
+}
+
+// SetNillableSyncedAt sets the "synced_at" field if the given value is not nil.
+func (_c *LdapAccountCreate) SetNillableSyncedAt(v *time.Time) *LdapAccountCreate {
+	if v != nil {
+		_c.SetSyncedAt(*v)
+	}
+	return _c
+}
+
+// SetLastLoginAt sets the "last_login_at" field.
+func (_c *LdapAccountCreate) SetLastLoginAt(v time.Time) *LdapAccountCreate {
+	_c.mutation.SetLastLoginAt(v)
+	return _c
+}
+
+// SetNillableLastLoginAt sets the "last_login_at" field if the given value is not nil.
+func (_c *LdapAccountCreate) SetNillableLastLoginAt(v *time.Time) *LdapAccountCreate {
+	if v != nil {
+		_c.SetLastLoginAt(*v)
+	}
+	return _c
+}
+
+// SetLastLoginIP sets the "last_login_ip" field.
+func (_c *LdapAccountCreate) SetLastLoginIP(v string) *LdapAccountCreate {
+	_c.mutation.SetLastLoginIP(v)
+	return _c
+}
+
+// SetNillableLastLoginIP sets the "last_login_ip" field if the given value is not nil.
+func (_c *LdapAccountCreate) SetNillableLastLoginIP(v *string) *LdapAccountCreate {
+	if v != nil {
+		_c.SetLastLoginIP(*v)
+	}
+	return _c
+}
+
+// SetLoginCount sets the "login_count" field.
+func (_c *LdapAccountCreate) SetLoginCount(v uint32) *LdapAccountCreate {
+	_c.mutation.SetLoginCount(v)
+	return _c
+}
+
+// SetNillableLoginCount sets the "login_count" field if the given value is not nil.
+func (_c *LdapAccountCreate) SetNillableLoginCount(v *uint32) *LdapAccountCreate {
+	if v != nil {
+		_c.SetLoginCount(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *LdapAccountCreate) SetID(v uint64) *LdapAccountCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_c *LdapAccountCreate) SetUser(v *User) *LdapAccountCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// SetProvider sets the "provider" edge to the LdapProvider entity.
+func (_c *LdapAccountCreate) SetProvider(v *LdapProvider) *LdapAccountCreate {
+	return _c.SetProviderID(v.ID)
+}
+
+// Mutation returns the LdapAccountMutation object of the builder.
+func (_c *LdapAccountCreate) Mutation() *LdapAccountMutation {
+	return _c.mutation
+}
+
+// Save creates the LdapAccount in the database.
+func (_c *LdapAccountCreate) Save(ctx context.Context) (*LdapAccount, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *LdapAccountCreate) SaveX(ctx context.Context) *LdapAccount {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LdapAccountCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LdapAccountCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *LdapAccountCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := ldapaccount.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := ldapaccount.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := ldapaccount.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.TenantID(); !ok {
+		v := ldapaccount.DefaultTenantID
+		_c.mutation.SetTenantID(v)
+	}
+	if _, ok := _c.mutation.LoginCount(); !ok {
+		v := ldapaccount.DefaultLoginCount
+		_c.mutation.SetLoginCount(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *LdapAccountCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "LdapAccount.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "LdapAccount.updated_at"`)}
+	}
+	if _, ok := _c.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "LdapAccount.tenant_id"`)}
+	}
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "LdapAccount.user_id"`)}
+	}
+	if _, ok := _c.mutation.ProviderID(); !ok {
+		return &ValidationError{Name: "provider_id", err: errors.New(`ent: missing required field "LdapAccount.provider_id"`)}
+	}
+	if _, ok := _c.mutation.ExternalID(); !ok {
+		return &ValidationError{Name: "external_id", err: errors.New(`ent: missing required field "LdapAccount.external_id"`)}
+	}
+	if v, ok := _c.mutation.ExternalID(); ok {
+		if err := ldapaccount.ExternalIDValidator(v); err != nil {
+			return &ValidationError{Name: "external_id", err: fmt.Errorf(`ent: validator failed for field "LdapAccount.external_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Dn(); !ok {
+		return &ValidationError{Name: "dn", err: errors.New(`ent: missing required field "LdapAccount.dn"`)}
+	}
+	if v, ok := _c.mutation.Dn(); ok {
+		if err := ldapaccount.DnValidator(v); err != nil {
+			return &ValidationError{Name: "dn", err: fmt.Errorf(`ent: validator failed for field "LdapAccount.dn": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.LastLoginIP(); ok {
+		if err := ldapaccount.LastLoginIPValidator(v); err != nil {
+			return &ValidationError{Name: "last_login_ip", err: fmt.Errorf(`ent: validator failed for field "LdapAccount.last_login_ip": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.LoginCount(); !ok {
+		return &ValidationError{Name: "login_count", err: errors.New(`ent: missing required field "LdapAccount.login_count"`)}
+	}
+	if len(_c.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "LdapAccount.user"`)}
+	}
+	if len(_c.mutation.ProviderIDs()) == 0 {
+		return &ValidationError{Name: "provider", err: errors.New(`ent: missing required edge "LdapAccount.provider"`)}
+	}
+	return nil
+}
+
+func (_c *LdapAccountCreate) sqlSave(ctx context.Context) (*LdapAccount, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint64(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *LdapAccountCreate) createSpec() (*LdapAccount, *sqlgraph.CreateSpec) {
+	var (
+		_node = &LdapAccount{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(ldapaccount.Table, sqlgraph.NewFieldSpec(ldapaccount.FieldID, field.TypeUint64))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(ldapaccount.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(ldapaccount.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(ldapaccount.FieldStatus, field.TypeUint8, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(ldapaccount.FieldTenantID, field.TypeUint64, value)
+		_node.TenantID = value
+	}
+	if value, ok := _c.mutation.ExternalID(); ok {
+		_spec.SetField(ldapaccount.FieldExternalID, field.TypeString, value)
+		_node.ExternalID = value
+	}
+	if value, ok := _c.mutation.Dn(); ok {
+		_spec.SetField(ldapaccount.FieldDn, field.TypeString, value)
+		_node.Dn = value
+	}
+	if value, ok := _c.mutation.SyncedAt(); ok {
+		_spec.SetField(ldapaccount.FieldSyncedAt, field.TypeTime, value)
+		_node.SyncedAt = value
+	}
+	if value, ok := _c.mutation.LastLoginAt(); ok {
+		_spec.SetField(ldapaccount.FieldLastLoginAt, field.TypeTime, value)
+		_node.LastLoginAt = value
+	}
+	if value, ok := _c.mutation.LastLoginIP(); ok {
+		_spec.SetField(ldapaccount.FieldLastLoginIP, field.TypeString, value)
+		_node.LastLoginIP = value
+	}
+	if value, ok := _c.mutation.LoginCount(); ok {
+		_spec.SetField(ldapaccount.FieldLoginCount, field.TypeUint32, value)
+		_node.LoginCount = value
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   ldapaccount.UserTable,
+			Columns: []string{ldapaccount.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ProviderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   ldapaccount.ProviderTable,
+			Columns: []string{ldapaccount.ProviderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ldapprovider.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ProviderID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// LdapAccountCreateBulk is the builder for creating many LdapAccount entities in bulk.
+type LdapAccountCreateBulk struct {
+	config
+	err      error
+	builders []*LdapAccountCreate
+}
+
+// Save creates the LdapAccount entities in the database.
+func (_c *LdapAccountCreateBulk) Save(ctx context.Context) ([]*LdapAccount, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*LdapAccount, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*LdapAccountMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *LdapAccountCreateBulk) SaveX(ctx context.Context) []*LdapAccount {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LdapAccountCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LdapAccountCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,855 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/coder-lulu/newbee-core/rpc/ent/ldapaccount"
+	"github.com/coder-lulu/newbee-core/rpc/ent/ldapprovider"
+	"github.com/coder-lulu/newbee-core/rpc/ent/predicate"
+	"github.com/coder-lulu/newbee-core/rpc/ent/user"
+	uuid "github.com/gofrs/uuid/v5"
+)
+
+// LdapAccountUpdate is the builder for updating LdapAccount entities.
+type LdapAccountUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *LdapAccountMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the LdapAccountUpdate builder.
+func (_u *LdapAccountUpdate) Where(ps ...predicate.LdapAccount) *LdapAccountUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *LdapAccountUpdate) SetUpdatedAt(v time.Time) *LdapAccountUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *LdapAccountUpdate) SetStatus(v uint8) *LdapAccountUpdate {
+	_u.mutation.ResetStatus()
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *LdapAccountUpdate) SetNillableStatus(v *uint8) *LdapAccountUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// AddStatus adds value to the "status" field.
+func (_u *LdapAccountUpdate) AddStatus(v int8) *LdapAccountUpdate {
+	_u.mutation.AddStatus(v)
+	return _u
+}
+
+// ClearStatus clears the value of the "status" field.
+func (_u *LdapAccountUpdate) ClearStatus() *LdapAccountUpdate {
+	_u.mutation.ClearStatus()
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *LdapAccountUpdate) SetUserID(v uuid.UUID) *LdapAccountUpdate {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *LdapAccountUpdate) SetNillableUserID(v *uuid.UUID) *LdapAccountUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetProviderID sets the "provider_id" field.
+func (_u *LdapAccountUpdate) SetProviderID(v uint64) *LdapAccountUpdate {
+	_u.mutation.SetProviderID(v)
+	return _u
+}
+
+// SetNillableProviderID sets the "provider_id" field if the given value is not nil.
+func (_u *LdapAccountUpdate) SetNillableProviderID(v *uint64) *LdapAccountUpdate {
+	if v != nil {
+		_u.SetProviderID(*v)
+	}
+	return _u
+}
+
+// SetExternalID sets the "external_id" field.
+func (_u *LdapAccountUpdate) SetExternalID(v string) *LdapAccountUpdate {
+	_u.mutation.SetExternalID(v)
+	return _u
+}
+
+// SetNillableExternalID sets the "external_id" field if the given value is not nil.
+func (_u *LdapAccountUpdate) SetNillableExternalID(v *string) *LdapAccountUpdate {
+	if v != nil {
+		_u.SetExternalID(*v)
+	}
+	return _u
+}
+
+// SetDn sets the "dn" field.
+func (_u *LdapAccountUpdate) SetDn(v string) *LdapAccountUpdate {
+	_u.mutation.SetDn(v)
+	return _u
+}
+
+// SetNillableDn sets the "dn" field if the given value is not nil.
+func (_u *LdapAccountUpdate) SetNillableDn(v *string) *LdapAccountUpdate {
+	if v != nil {
+		_u.SetDn(*v)
+	}
+	return _u
+}
+
+// SetSyncedAt sets the "synced_at" field.
+func (_u *LdapAccountUpdate) SetSyncedAt(v time.Time) *LdapAccountUpdate {
+	_u.mutation.SetSyncedAt(v)
+	return _u
+}
+
+// SetNillableSyncedAt sets the "synced_at" field if the given value is not nil.
+func (_u *LdapAccountUpdate) SetNillableSyncedAt(v *time.Time) *LdapAccountUpdate {
+	if v != nil {
+		_u.SetSyncedAt(*v)
+	}
+	return _u
+}
+
+// ClearSyncedAt clears the value of the "synced_at" field.
+func (_u *LdapAccountUpdate) ClearSyncedAt() *LdapAccountUpdate {
+	_u.mutation.ClearSyncedAt()
+	return _u
+}
+
+// SetLastLoginAt sets the "last_login_at" field.
+func (_u *LdapAccountUpdate) SetLastLoginAt(v time.Time) *LdapAccountUpdate {
+	_u.mutation.SetLastLoginAt(v)
+	return _u
+}
+
+// SetNillableLastLoginAt sets the "last_login_at" field if the given value is not nil.
+func (_u *LdapAccountUpdate) SetNillableLastLoginAt(v *time.Time) *LdapAccountUpdate {
+	if v != nil {
+		_u.SetLastLoginAt(*v)
+	}
+	return _u
+}
+
+// ClearLastLoginAt clears the value of the "last_login_at" field.
+func (_u *LdapAccountUpdate) ClearLastLoginAt() *LdapAccountUpdate {
+	_u.mutation.ClearLastLoginAt()
+	return _u
+}
+
+// SetLastLoginIP sets the "last_login_ip" field.
+func (_u *LdapAccountUpdate) SetLastLoginIP(v string) *LdapAccountUpdate {
+	_u.mutation.SetLastLoginIP(v)
+	return _u
+}
+
+// SetNillableLastLoginIP sets the "last_login_ip" field if the given value is not nil.
+func (_u *LdapAccountUpdate) SetNillableLastLoginIP(v *string) *LdapAccountUpdate {
+	if v != nil {
+		_u.SetLastLoginIP(*v)
+	}
+	return _u
+}
+
+// ClearLastLoginIP clears the value of the "last_login_ip" field.
+func (_u *LdapAccountUpdate) ClearLastLoginIP() *LdapAccountUpdate {
+	_u.mutation.ClearLastLoginIP()
+	return _u
+}
+
+// SetLoginCount sets the "login_count" field.
+func (_u *LdapAccountUpdate) SetLoginCount(v uint32) *LdapAccountUpdate {
+	_u.mutation.ResetLoginCount()
+	_u.mutation.SetLoginCount(v)
+	return _u
+}
+
+// SetNillableLoginCount sets the "login_count" field if the given value is not nil.
+func (_u *LdapAccountUpdate) SetNillableLoginCount(v *uint32) *LdapAccountUpdate {
+	if v != nil {
+		_u.SetLoginCount(*v)
+	}
+	return _u
+}
+
+// AddLoginCount adds value to the "login_count" field.
+func (_u *LdapAccountUpdate) AddLoginCount(v int32) *LdapAccountUpdate {
+	_u.mutation.AddLoginCount(v)
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *LdapAccountUpdate) SetUser(v *User) *LdapAccountUpdate {
+	return _u.SetUserID(v.ID)
+}
+
+// SetProvider sets the "provider" edge to the LdapProvider entity.
+func (_u *LdapAccountUpdate) SetProvider(v *LdapProvider) *LdapAccountUpdate {
+	return _u.SetProviderID(v.ID)
+}
+
+// Mutation returns the LdapAccountMutation object of the builder.
+func (_u *LdapAccountUpdate) Mutation() *LdapAccountMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *LdapAccountUpdate) ClearUser() *LdapAccountUpdate {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// ClearProvider clears the "provider" edge to the LdapProvider entity.
+func (_u *LdapAccountUpdate) ClearProvider() *LdapAccountUpdate {
+	_u.mutation.ClearProvider()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *LdapAccountUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *LdapAccountUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *LdapAccountUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *LdapAccountUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *LdapAccountUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := ldapaccount.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *LdapAccountUpdate) check() error {
+	if v, ok := _u.mutation.ExternalID(); ok {
+		if err := ldapaccount.ExternalIDValidator(v); err != nil {
+			return &ValidationError{Name: "external_id", err: fmt.Errorf(`ent: validator failed for field "LdapAccount.external_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Dn(); ok {
+		if err := ldapaccount.DnValidator(v); err != nil {
+			return &ValidationError{Name: "dn", err: fmt.Errorf(`ent: validator failed for field "LdapAccount.dn": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.LastLoginIP(); ok {
+		if err := ldapaccount.LastLoginIPValidator(v); err != nil {
+			return &ValidationError{Name: "last_login_ip", err: fmt.Errorf(`ent: validator failed for field "LdapAccount.last_login_ip": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "LdapAccount.user"`)
+	}
+	if _u.mutation.ProviderCleared() && len(_u.mutation.ProviderIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "LdapAccount.provider"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *LdapAccountUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *LdapAccountUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *LdapAccountUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(ldapaccount.Table, ldapaccount.Columns, sqlgraph.NewFieldSpec(ldapaccount.FieldID, field.TypeUint64))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(ldapaccount.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(ldapaccount.FieldStatus, field.TypeUint8, value)
+	}
+	if value, ok := _u.mutation.AddedStatus(); ok {
+		_spec.AddField(ldapaccount.FieldStatus, field.TypeUint8, value)
+	}
+	if _u.mutation.StatusCleared() {
+		_spec.ClearField(ldapaccount.FieldStatus, field.TypeUint8)
+	}
+	if value, ok := _u.mutation.ExternalID(); ok {
+		_spec.SetField(ldapaccount.FieldExternalID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Dn(); ok {
+		_spec.SetField(ldapaccount.FieldDn, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.SyncedAt(); ok {
+		_spec.SetField(ldapaccount.FieldSyncedAt, field.TypeTime, value)
+	}
+	if _u.mutation.SyncedAtCleared() {
+		_spec.ClearField(ldapaccount.FieldSyncedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastLoginAt(); ok {
+		_spec.SetField(ldapaccount.FieldLastLoginAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastLoginAtCleared() {
+		_spec.ClearField(ldapaccount.FieldLastLoginAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastLoginIP(); ok {
+		_spec.SetField(ldapaccount.FieldLastLoginIP, field.TypeString, value)
+	}
+	if _u.mutation.LastLoginIPCleared() {
+		_spec.ClearField(ldapaccount.FieldLastLoginIP, field.TypeString)
+	}
+	if value, ok := _u.mutation.LoginCount(); ok {
+		_spec.SetField(ldapaccount.FieldLoginCount, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedLoginCount(); ok {
+		_spec.AddField(ldapaccount.FieldLoginCount, field.TypeUint32, value)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   ldapaccount.UserTable,
+			Columns: []string{ldapaccount.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   ldapaccount.UserTable,
+			Columns: []string{ldapaccount.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ProviderCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   ldapaccount.ProviderTable,
+			Columns: []string{ldapaccount.ProviderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ldapprovider.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ProviderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   ldapaccount.ProviderTable,
+			Columns: []string{ldapaccount.ProviderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ldapprovider.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{ldapaccount.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// LdapAccountUpdateOne is the builder for updating a single LdapAccount entity.
+type LdapAccountUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *LdapAccountMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *LdapAccountUpdateOne) SetUpdatedAt(v time.Time) *LdapAccountUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *LdapAccountUpdateOne) SetStatus(v uint8) *LdapAccountUpdateOne {
+	_u.mutation.ResetStatus()
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *LdapAccountUpdateOne) SetNillableStatus(v *uint8) *LdapAccountUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// AddStatus adds value to the "status" field.
+func (_u *LdapAccountUpdateOne) AddStatus(v int8) *LdapAccountUpdateOne {
+	_u.mutation.AddStatus(v)
+	return _u
+}
+
+// ClearStatus clears the value of the "status" field.
+func (_u *LdapAccountUpdateOne) ClearStatus() *LdapAccountUpdateOne {
+	_u.mutation.ClearStatus()
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *LdapAccountUpdateOne) SetUserID(v uuid.UUID) *LdapAccountUpdateOne {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *LdapAccountUpdateOne) SetNillableUserID(v *uuid.UUID) *LdapAccountUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetProviderID sets the "provider_id" field.
+func (_u *LdapAccountUpdateOne) SetProviderID(v uint64) *LdapAccountUpdateOne {
+	_u.mutation.SetProviderID(v)
+	return _u
+}
+
+// SetNillableProviderID sets the "provider_id" field if the given value is not nil.
+func (_u *LdapAccountUpdateOne) SetNillableProviderID(v *uint64) *LdapAccountUpdateOne {
+	if v != nil {
+		_u.SetProviderID(*v)
+	}
+	return _u
+}
+
+// SetExternalID sets the "external_id" field.
+func (_u *LdapAccountUpdateOne) SetExternalID(v string) *LdapAccountUpdateOne {
+	_u.mutation.SetExternalID(v)
+	return _u
+}
+
+// SetNillableExternalID sets the "external_id" field if the given value is not nil.
+func (_u *LdapAccountUpdateOne) SetNillableExternalID(v *string) *LdapAccountUpdateOne {
+	if v != nil {
+		_u.SetExternalID(*v)
+	}
+	return _u
+}
+
+// SetDn sets the "dn" field.
+func (_u *LdapAccountUpdateOne) SetDn(v string) *LdapAccountUpdateOne {
+	_u.mutation.SetDn(v)
+	return _u
+}
+
+// SetNillableDn sets the "dn" field if the given value is not nil.
+func (_u *LdapAccountUpdateOne) SetNillableDn(v *string) *LdapAccountUpdateOne {
+	if v != nil {
+		_u.SetDn(*v)
+	}
+	return _u
+}
+
+// SetSyncedAt sets the "synced_at" field.
+func (_u *LdapAccountUpdateOne) SetSyncedAt(v time.Time) *LdapAccountUpdateOne {
+	_u.mutation.SetSyncedAt(v)
+	return _u
+}
+
+// SetNillableSyncedAt sets the "synced_at" field if the given value is not nil.
+func (_u *LdapAccountUpdateOne) SetNillableSyncedAt(v *time.Time) *LdapAccountUpdateOne {
+	if v != nil {
+		_u.SetSyncedAt(*v)
+	}
+	return _u
+}
+
+// ClearSyncedAt clears the value of the "synced_at" field.
+func (_u *LdapAccountUpdateOne) ClearSyncedAt() *LdapAccountUpdateOne {
+	_u.mutation.ClearSyncedAt()
+	return _u
+}
+
+// SetLastLoginAt sets the "last_login_at" field.
+func (_u *LdapAccountUpdateOne) SetLastLoginAt(v time.Time) *LdapAccountUpdateOne {
+	_u.mutation.SetLastLoginAt(v)
+	return _u
+}
+
+// SetNillableLastLoginAt sets the "last_login_at" field if the given value is not nil.
+func (_u *LdapAccountUpdateOne) SetNillableLastLoginAt(v *time.Time) *LdapAccountUpdateOne {
+	if v != nil {
+		_u.SetLastLoginAt(*v)
+	}
+	return _u
+}
+
+// ClearLastLoginAt clears the value of the "last_login_at" field.
+func (_u *LdapAccountUpdateOne) ClearLastLoginAt() *LdapAccountUpdateOne {
+	_u.mutation.ClearLastLoginAt()
+	return _u
+}
+
+// SetLastLoginIP sets the "last_login_ip" field.
+func (_u *LdapAccountUpdateOne) SetLastLoginIP(v string) *LdapAccountUpdateOne {
+	_u.mutation.SetLastLoginIP(v)
+	return _u
+}
+
+// SetNillableLastLoginIP sets the "last_login_ip" field if the given value is not nil.
+func (_u *LdapAccountUpdateOne) SetNillableLastLoginIP(v *string) *LdapAccountUpdateOne {
+	if v != nil {
+		_u.SetLastLoginIP(*v)
+	}
+	return _u
+}
+
+// ClearLastLoginIP clears the value of the "last_login_ip" field.
+func (_u *LdapAccountUpdateOne) ClearLastLoginIP() *LdapAccountUpdateOne {
+	_u.mutation.ClearLastLoginIP()
+	return _u
+}
+
+// SetLoginCount sets the "login_count" field.
+func (_u *LdapAccountUpdateOne) SetLoginCount(v uint32) *LdapAccountUpdateOne {
+	_u.mutation.ResetLoginCount()
+	_u.mutation.SetLoginCount(v)
+	return _u
+}
+
+// SetNillableLoginCount sets the "login_count" field if the given value is not nil.
+func (_u *LdapAccountUpdateOne) SetNillableLoginCount(v *uint32) *LdapAccountUpdateOne {
+	if v != nil {
+		_u.SetLoginCount(*v)
+	}
+	return _u
+}
+
+// AddLoginCount adds value to the "login_count" field.
+func (_u *LdapAccountUpdateOne) AddLoginCount(v int32) *LdapAccountUpdateOne {
+	_u.mutation.AddLoginCount(v)
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *LdapAccountUpdateOne) SetUser(v *User) *LdapAccountUpdateOne {
+	return _u.SetUserID(v.ID)
+}
+
+// SetProvider sets the "provider" edge to the LdapProvider entity.
+func (_u *LdapAccountUpdateOne) SetProvider(v *LdapProvider) *LdapAccountUpdateOne {
+	return _u.SetProviderID(v.ID)
+}
+
+// Mutation returns the LdapAccountMutation object of the builder.
+func (_u *LdapAccountUpdateOne) Mutation() *LdapAccountMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *LdapAccountUpdateOne) ClearUser() *LdapAccountUpdateOne {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// ClearProvider clears the "provider" edge to the LdapProvider entity.
+func (_u *LdapAccountUpdateOne) ClearProvider() *LdapAccountUpdateOne {
+	_u.mutation.ClearProvider()
+	return _u
+}
+
+// Where appends a list predicates to the LdapAccountUpdate builder.
+func (_u *LdapAccountUpdateOne) Where(ps ...predicate.LdapAccount) *LdapAccountUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *LdapAccountUpdateOne) Select(field string, fields ...string) *LdapAccountUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated LdapAccount entity.
+func (_u *LdapAccountUpdateOne) Save(ctx context.Context) (*LdapAccount, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *LdapAccountUpdateOne) SaveX(ctx context.Context) *LdapAccount {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *LdapAccountUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *LdapAccountUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *LdapAccountUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := ldapaccount.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *LdapAccountUpdateOne) check() error {
+	if v, ok := _u.mutation.ExternalID(); ok {
+		if err := ldapaccount.ExternalIDValidator(v); err != nil {
+			return &ValidationError{Name: "external_id", err: fmt.Errorf(`ent: validator failed for field "LdapAccount.external_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Dn(); ok {
+		if err := ldapaccount.DnValidator(v); err != nil {
+			return &ValidationError{Name: "dn", err: fmt.Errorf(`ent: validator failed for field "LdapAccount.dn": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.LastLoginIP(); ok {
+		if err := ldapaccount.LastLoginIPValidator(v); err != nil {
+			return &ValidationError{Name: "last_login_ip", err: fmt.Errorf(`ent: validator failed for field "LdapAccount.last_login_ip": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "LdapAccount.user"`)
+	}
+	if _u.mutation.ProviderCleared() && len(_u.mutation.ProviderIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "LdapAccount.provider"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *LdapAccountUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *LdapAccountUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *LdapAccountUpdateOne) sqlSave(ctx context.Context) (_node *LdapAccount, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(ldapaccount.Table, ldapaccount.Columns, sqlgraph.NewFieldSpec(ldapaccount.FieldID, field.TypeUint64))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "LdapAccount.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, ldapaccount.FieldID)
+		for _, f := range fields {
+			if !ldapaccount.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != ldapaccount.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(ldapaccount.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(ldapaccount.FieldStatus, field.TypeUint8, value)
+	}
+	if value, ok := _u.mutation.AddedStatus(); ok {
+		_spec.AddField(ldapaccount.FieldStatus, field.TypeUint8, value)
+	}
+	if _u.mutation.StatusCleared() {
+		_spec.ClearField(ldapaccount.FieldStatus, field.TypeUint8)
+	}
+	if value, ok := _u.mutation.ExternalID(); ok {
+		_spec.SetField(ldapaccount.FieldExternalID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Dn(); ok {
+		_spec.SetField(ldapaccount.FieldDn, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.SyncedAt(); ok {
+		_spec.SetField(ldapaccount.FieldSyncedAt, field.TypeTime, value)
+	}
+	if _u.mutation.SyncedAtCleared() {
+		_spec.ClearField(ldapaccount.FieldSyncedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastLoginAt(); ok {
+		_spec.SetField(ldapaccount.FieldLastLoginAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastLoginAtCleared() {
+		_spec.ClearField(ldapaccount.FieldLastLoginAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastLoginIP(); ok {
+		_spec.SetField(ldapaccount.FieldLastLoginIP, field.TypeString, value)
+	}
+	if _u.mutation.LastLoginIPCleared() {
+		_spec.ClearField(ldapaccount.FieldLastLoginIP, field.TypeString)
+	}
+	if value, ok := _u.mutation.LoginCount(); ok {
+		_spec.SetField(ldapaccount.FieldLoginCount, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedLoginCount(); ok {
+		_spec.AddField(ldapaccount.FieldLoginCount, field.TypeUint32, value)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   ldapaccount.UserTable,
+			Columns: []string{ldapaccount.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   ldapaccount.UserTable,
+			Columns: []string{ldapaccount.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ProviderCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   ldapaccount.ProviderTable,
+			Columns: []string{ldapaccount.ProviderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ldapprovider.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ProviderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   ldapaccount.ProviderTable,
+			Columns: []string{ldapaccount.ProviderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ldapprovider.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &LdapAccount{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{ldapaccount.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,212 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/coder-lulu/newbee-core/rpc/ent/ldapdepartment"
+	"github.com/coder-lulu/newbee-core/rpc/ent/ldapprovider"
+)
+
+// LDAP Organizational Unit Binding Table | LDAP组织单位与部门的绑定表
+type LdapDepartment struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uint64 `json:"id,omitempty"`
+	// Create Time | 创建日期
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Update Time | 修改日期
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Tenant ID | 租户 ID
+	TenantID uint64 `json:"tenant_id,omitempty"`
+	// LDAP provider ID | LDAP目录ID
+	ProviderID uint64 `json:"provider_id,omitempty"`
+	// Mirrored department ID | 同步生成的部门ID
+	DepartmentID uint64 `json:"department_id,omitempty"`
+	// Unique ID of the organizational unit | 组织单位的唯一标识
+	ExternalID string `json:"external_id,omitempty"`
+	// DN of the organizational unit | 组织单位DN
+	Dn string `json:"dn,omitempty"`
+	// Last sync time | 最近同步时间
+	SyncedAt time.Time `json:"synced_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the LdapDepartmentQuery when eager-loading is set.
+	Edges        LdapDepartmentEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// LdapDepartmentEdges holds the relations/edges for other nodes in the graph.
+type LdapDepartmentEdges struct {
+	// Provider holds the value of the provider edge.
+	Provider *LdapProvider `json:"provider,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// ProviderOrErr returns the Provider value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e LdapDepartmentEdges) ProviderOrErr() (*LdapProvider, error) {
+	if e.Provider != nil {
+		return e.Provider, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: ldapprovider.Label}
+	}
+	return nil, &NotLoadedError{edge: "provider"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*LdapDepartment) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case ldapdepartment.FieldID, ldapdepartment.FieldTenantID, ldapdepartment.FieldProviderID, ldapdepartment.FieldDepartmentID:
+			values[i] = new(sql.NullInt64)
+		case ldapdepartment.FieldExternalID, ldapdepartment.FieldDn:
+			values[i] = new(sql.NullString)
+		case ldapdepartment.FieldCreatedAt, ldapdepartment.FieldUpdatedAt, ldapdepartment.FieldSyncedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the LdapDepartment fields.
+func (_m *LdapDepartment) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case ldapdepartment.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = uint64(value.Int64)
+		case ldapdepartment.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case ldapdepartment.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case ldapdepartment.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				_m.TenantID = uint64(value.Int64)
+			}
+		case ldapdepartment.FieldProviderID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field provider_id", values[i])
+			} else if value.Valid {
+				_m.ProviderID = uint64(value.Int64)
+			}
+		case ldapdepartment.FieldDepartmentID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field department_id", values[i])
+			} else if value.Valid {
+				_m.DepartmentID = uint64(value.Int64)
+			}
+		case ldapdepartment.FieldExternalID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field external_id", values[i])
+			} else if value.Valid {
+				_m.ExternalID = value.String
+			}
+		case ldapdepartment.FieldDn:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field dn", values[i])
+			} else if value.Valid {
+				_m.Dn = value.String
+			}
+		case ldapdepartment.FieldSyncedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field synced_at", values[i])
+			} else if value.Valid {
+				_m.SyncedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the LdapDepartment.
+// This includes values selected through modifiers, order, etc.
+func (_m *LdapDepartment) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryProvider queries the "provider" edge of the LdapDepartment entity.
+func (_m *LdapDepartment) QueryProvider() *LdapProviderQuery {
+	return NewLdapDepartmentClient(_m.config).QueryProvider(_m)
+}
+
+// Update returns a builder for updating this LdapDepartment.
+// Note that you need to call LdapDepartment.Unwrap() before calling this method if this LdapDepartment
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *LdapDepartment) Update() *LdapDepartmentUpdateOne {
+	return NewLdapDepartmentClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the LdapDepartment entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *LdapDepartment) Unwrap() *LdapDepartment {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: LdapDepartment is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *LdapDepartment) String() string {
+	var builder strings.Builder
+	builder.WriteString("LdapDepartment(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("provider_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ProviderID))
+	builder.WriteString(", ")
+	builder.WriteString("department_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DepartmentID))
+	builder.WriteString(", ")
+	builder.WriteString("external_id=")
+	builder.WriteString(_m.ExternalID)
+	builder.WriteString(", ")
+	builder.WriteString("dn=")
+	builder.WriteString(_m.Dn)
+	builder.WriteString(", ")
+	builder.WriteString("synced_at=")
+	builder.WriteString(_m.SyncedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// LdapDepartments is a parsable slice of LdapDepartment.
+type LdapDepartments []*LdapDepartment
@@ -0,0 +1,494 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ldapdepartment
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/coder-lulu/newbee-core/rpc/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uint64) predicate.LdapDepartment {
+	return predicate.LdapDepartment(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uint64) predicate.LdapDepartment {
+	return predicate.LdapDepartment(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uint64) predicate.LdapDepartment {
+	return predicate.LdapDepartment(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uint64) predicate.LdapDepartment {
+	return predicate.LdapDepartment(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uint64) predicate.LdapDepartment {
+	return predicate.LdapDepartment(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uint64) predicate.LdapDepartment {
+	return predicate.LdapDepartment(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uint64) predicate.LdapDepartment {
+	return predicate.LdapDepartment(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uint64) predicate.LdapDepartment {
+	return predicate.LdapDepartment(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uint64) predicate.LdapDepartment {
+	return predicate.LdapDepartment(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.LdapDepartment {
+	return predicate.LdapDepartment(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.LdapDepartment {
+	return predicate.LdapDepartment(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v uint64) predicate.LdapDepartment {
+	return predicate.LdapDepartment(sql.FieldEQ(FieldTenantID, v))
+}
+
+// ProviderID applies equality check predicate on the "provider_id" field. It's identical to ProviderIDEQ.
+func ProviderID(v uint64) predicate.LdapDepartment {
+	return predicate.LdapDepartment(sql.FieldEQ(FieldProviderID, v))
+}
+
+// DepartmentID applies equality check predicate on the "department_id" field. It's identical to DepartmentIDEQ.
+func DepartmentID(v uint64) predicate.LdapDepartment {
+	return predicate.LdapDepartment(sql.FieldEQ(FieldDepartmentID, v))
+}
+
+// ExternalID applies equality check predicate on the "external_id" field. It's identical to ExternalIDEQ.
+func ExternalID(v string) predicate.LdapDepartment {
+	return predicate.LdapDepartment(sql.FieldEQ(FieldExternalID, v))
+}
+
+// Dn applies equality check predicate on the "dn" field. It's identical to DnEQ.
+func Dn(v string) predicate.LdapDepartment {
+	return predicate.LdapDepartment(sql.FieldEQ(FieldDn, v))
+}
+
+// SyncedAt applies equality check predicate on the "synced_at" field. It's identical to SyncedAtEQ.
+func SyncedAt(v time.Time) predicate.LdapDepartment {
+	return predicate.LdapDepartment(sql.FieldEQ(FieldSyncedAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.LdapDepartment {
+	return predicate.LdapDepartment(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.LdapDepartment {
+	return predicate.LdapDepartment(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.LdapDepartment {
+	return predicate.LdapDepartment(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.LdapDepartment {
+	return predicate.LdapDepartment(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.LdapDepartment {
+	return predicate.LdapDepartment(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.LdapDepartment {
+	return predicate.LdapDepartment(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.LdapDepartment {
+	return predicate.LdapDepartment(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.LdapDepartment {
+	return predicate.LdapDepartment(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.LdapDepartment {
+	return predicate.LdapDepartment(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.LdapDepartment {
+	return predicate.LdapDepartment(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.LdapDepartment {
+	return predicate.LdapDepartment(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.LdapDepartment {
+	return predicate.LdapDepartment(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.LdapDepartment {
+	return predicate.LdapDepartment(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.LdapDepartment {
+	return predicate.LdapDepartment(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.LdapDepartment {
+	return predicate.LdapDepartment(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.LdapDepartment {
+	return predicate.LdapDepartment(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v uint64) predicate.LdapDepartment {
+	return predicate.LdapDepartment(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v uint64) predicate.LdapDepartment {
+	return predicate.LdapDepartment(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...uint64) predicate.LdapDepartment {
+	return predicate.LdapDepartment(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...uint64) predicate.LdapDepartment {
+	return predicate.LdapDepartment(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v uint64) predicate.LdapDepartment {
+	return predicate.LdapDepartment(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v uint64) predicate.LdapDepartment {
+	return predicate.LdapDepartment(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v uint64) predicate.LdapDepartment {
+	return predicate.LdapDepartment(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v uint64) predicate.LdapDepartment {
+	return predicate.LdapDepartment(sql.FieldLTE(FieldTenantID, v))
+}
+
+// ProviderIDEQ applies the EQ predicate on the "provider_id" field.
+func ProviderIDEQ(v uint64) predicate.LdapDepartment {
+	return predicate.LdapDepartment(sql.FieldEQ(FieldProviderID, v))
+}
+
+// ProviderIDNEQ applies the NEQ predicate on the "provider_id" field.
+func ProviderIDNEQ(v uint64) predicate.LdapDepartment {
+	return predicate.LdapDepartment(sql.FieldNEQ(FieldProviderID, v))
+}
+
+// ProviderIDIn applies the In predicate on the "provider_id" field.
+func ProviderIDIn(vs ...uint64) predicate.LdapDepartment {
+	return predicate.LdapDepartment(sql.FieldIn(FieldProviderID, vs...))
+}
+
+// ProviderIDNotIn applies the NotIn predicate on the "provider_id" field.
+func ProviderIDNotIn(vs ...uint64) predicate.LdapDepartment {
+	return predicate.LdapDepartment(sql.FieldNotIn(FieldProviderID, vs...))
+}
+
+// DepartmentIDEQ applies the EQ predicate on the "department_id" field.
+func DepartmentIDEQ(v uint64) predicate.LdapDepartment {
+	return predicate.LdapDepartment(sql.FieldEQ(FieldDepartmentID, v))
+}
+
+// DepartmentIDNEQ applies the NEQ predicate on the "department_id" field.
+func DepartmentIDNEQ(v uint64) predicate.LdapDepartment {
+	return predicate.LdapDepartment(sql.FieldNEQ(FieldDepartmentID, v))
+}
+
+// DepartmentIDIn applies the In predicate on the "department_id" field.
+func DepartmentIDIn(vs ...uint64) predicate.LdapDepartment {
+	return predicate.LdapDepartment(sql.FieldIn(FieldDepartmentID, vs...))
+}
+
+// DepartmentIDNotIn applies the NotIn predicate on the "department_id" field.
+func DepartmentIDNotIn(vs ...uint64) predicate.LdapDepartment {
+	return predicate.LdapDepartment(sql.FieldNotIn(FieldDepartmentID, vs...))
+}
+
+// DepartmentIDGT applies the GT predicate on the "department_id" field.
+func DepartmentIDGT(v uint64) predicate.LdapDepartment {
+	return predicate.LdapDepartment(sql.FieldGT(FieldDepartmentID, v))
+}
+
+// DepartmentIDGTE applies the GTE predicate on the "department_id" field.
+func DepartmentIDGTE(v uint64) predicate.LdapDepartment {
+	return predicate.LdapDepartment(sql.FieldGTE(FieldDepartmentID, v))
+}
+
+// DepartmentIDLT applies the LT predicate on the "department_id" field.
+func DepartmentIDLT(v uint64) predicate.LdapDepartment {
+	return predicate.LdapDepartment(sql.FieldLT(FieldDepartmentID, v))
+}
+
+// DepartmentIDLTE applies the LTE predicate on the "department_id" field.
+func DepartmentIDLTE(v uint64) predicate.LdapDepartment {
+	return predicate.LdapDepartment(sql.FieldLTE(FieldDepartmentID, v))
+}
+
+// ExternalIDEQ applies the EQ predicate on the "external_id" field.
+func ExternalIDEQ(v string) predicate.LdapDepartment {
+	return predicate.LdapDepartment(sql.FieldEQ(FieldExternalID, v))
+}
+
+// ExternalIDNEQ applies the NEQ predicate on the "external_id" field.
+func ExternalIDNEQ(v string) predicate.LdapDepartment {
+	return predicate.LdapDepartment(sql.FieldNEQ(FieldExternalID, v))
+}
+
+// ExternalIDIn applies the In predicate on the "external_id" field.
+func ExternalIDIn(vs ...string) predicate.LdapDepartment {
+	return predicate.LdapDepartment(sql.FieldIn(FieldExternalID, vs...))
+}
+
+// ExternalIDNotIn applies the NotIn predicate on the "external_id" field.
+func ExternalIDNotIn(vs ...string) predicate.LdapDepartment {
+	return predicate.LdapDepartment(sql.FieldNotIn(FieldExternalID, vs...))
+}
+
+// ExternalIDGT applies the GT predicate on the "external_id" field.
+func ExternalIDGT(v string) predicate.LdapDepartment {
+	return predicate.LdapDepartment(sql.FieldGT(FieldExternalID, v))
+}
+
+// ExternalIDGTE applies the GTE predicate on the "external_id" field.
+func ExternalIDGTE(v string) predicate.LdapDepartment {
+	return predicate.LdapDepartment(sql.FieldGTE(FieldExternalID, v))
+}
+
+// ExternalIDLT applies the LT predicate on the "external_id" field.
+func ExternalIDLT(v string) predicate.LdapDepartment {
+	return predicate.LdapDepartment(sql.FieldLT(FieldExternalID, v))
+}
+
+// ExternalIDLTE applies the LTE predicate on the "external_id" field.
+func ExternalIDLTE(v string) predicate.LdapDepartment {
+	return predicate.LdapDepartment(sql.FieldLTE(FieldExternalID, v))
+}
+
+// ExternalIDContains applies the Contains predicate on the "external_id" field.
+func ExternalIDContains(v string) predicate.LdapDepartment {
+	return predicate.LdapDepartment(sql.FieldContains(FieldExternalID, v))
+}
+
+// ExternalIDHasPrefix applies the HasPrefix predicate on the "external_id" field.
+func ExternalIDHasPrefix(v string) predicate.LdapDepartment {
+	return predicate.LdapDepartment(sql.FieldHasPrefix(FieldExternalID, v))
+}
+
+// ExternalIDHasSuffix applies the HasSuffix predicate on the "external_id" field.
+func ExternalIDHasSuffix(v string) predicate.LdapDepartment {
+	return predicate.LdapDepartment(sql.FieldHasSuffix(FieldExternalID, v))
+}
+
+// ExternalIDEqualFold applies the EqualFold predicate on the "external_id" field.
+func ExternalIDEqualFold(v string) predicate.LdapDepartment {
+	return predicate.LdapDepartment(sql.FieldEqualFold(FieldExternalID, v))
+}
+
+// ExternalIDContainsFold applies the ContainsFold predicate on the "external_id" field.
+func ExternalIDContainsFold(v string) predicate.LdapDepartment {
+	return predicate.LdapDepartment(sql.FieldContainsFold(FieldExternalID, v))
+}
+
+// DnEQ applies the EQ predicate on the "dn" field.
+func DnEQ(v string) predicate.LdapDepartment {
+	return predicate.LdapDepartment(sql.FieldEQ(FieldDn, v))
+}
+
+// DnNEQ applies the NEQ predicate on the "dn" field.
+func DnNEQ(v string) predicate.LdapDepartment {
+	return predicate.LdapDepartment(sql.FieldNEQ(FieldDn, v))
+}
+
+// DnIn applies the In predicate on the "dn" field.
+func DnIn(vs ...string) predicate.LdapDepartment {
+	return predicate.LdapDepartment(sql.FieldIn(FieldDn, vs...))
+}
+
+// DnNotIn applies the NotIn predicate on the "dn" field.
+func DnNotIn(vs ...string) predicate.LdapDepartment {
+	return predicate.LdapDepartment(sql.FieldNotIn(FieldDn, vs...))
+}
+
+// DnGT applies the GT predicate on the "dn" field.
+func DnGT(v string) predicate.LdapDepartment {
+	return predicate.LdapDepartment(sql.FieldGT(FieldDn, v))
+}
+
+// DnGTE applies the GTE predicate on the "dn" field.
+func DnGTE(v string) predicate.LdapDepartment {
+	return predicate.LdapDepartment(sql.FieldGTE(FieldDn, v))
+}
+
+// DnLT applies the LT predicate on the "dn" field.
+func DnLT(v string) predicate.LdapDepartment {
+	return predicate.LdapDepartment(sql.FieldLT(FieldDn, v))
+}
+
+// DnLTE applies the LTE predicate on the "dn" field.
+func DnLTE(v string) predicate.LdapDepartment {
+	return predicate.LdapDepartment(sql.FieldLTE(FieldDn, v))
+}
+
+// DnContains applies the Contains predicate on the "dn" field.
+func DnContains(v string) predicate.LdapDepartment {
+	return predicate.LdapDepartment(sql.FieldContains(FieldDn, v))
+}
+
+// DnHasPrefix applies the HasPrefix predicate on the "dn" field.
+func DnHasPrefix(v string) predicate.LdapDepartment {
+	return predicate.LdapDepartment(sql.FieldHasPrefix(FieldDn, v))
+}
+
+// DnHasSuffix applies the HasSuffix predicate on the "dn" field.
+func DnHasSuffix(v string) predicate.LdapDepartment {
+	return predicate.LdapDepartment(sql.FieldHasSuffix(FieldDn, v))
+}
+
+// DnEqualFold applies the EqualFold predicate on the "dn" field.
+func DnEqualFold(v string) predicate.LdapDepartment {
+	return predicate.LdapDepartment(sql.FieldEqualFold(FieldDn, v))
+}
+
+// DnContainsFold applies the ContainsFold predicate on the "dn" field.
+func DnContainsFold(v string) predicate.LdapDepartment {
+	return predicate.LdapDepartment(sql.FieldContainsFold(FieldDn, v))
+}
+
+// SyncedAtEQ applies the EQ predicate on the "synced_at" field.
+func SyncedAtEQ(v time.Time) predicate.LdapDepartment {
+	return predicate.LdapDepartment(sql.FieldEQ(FieldSyncedAt, v))
+}
+
+// SyncedAtNEQ applies the NEQ predicate on the "synced_at" field.
+func SyncedAtNEQ(v time.Time) predicate.LdapDepartment {
+	return predicate.LdapDepartment(sql.FieldNEQ(FieldSyncedAt, v))
+}
+
+// SyncedAtIn applies the In predicate on the "synced_at" field.
+func SyncedAtIn(vs ...time.Time) predicate.LdapDepartment {
+	return predicate.LdapDepartment(sql.FieldIn(FieldSyncedAt, vs...))
+}
+
+// SyncedAtNotIn applies the NotIn predicate on the "synced_at" field.
+func SyncedAtNotIn(vs ...time.Time) predicate.LdapDepartment {
+	return predicate.LdapDepartment(sql.FieldNotIn(FieldSyncedAt, vs...))
+}
+
+// SyncedAtGT applies the GT predicate on the "synced_at" field.
+func SyncedAtGT(v time.Time) predicate.LdapDepartment {
+	return predicate.LdapDepartment(sql.FieldGT(FieldSyncedAt, v))
+}
+
+// SyncedAtGTE applies the GTE predicate on the "synced_at" field.
+func SyncedAtGTE(v time.Time) predicate.LdapDepartment {
+	return predicate.LdapDepartment(sql.FieldGTE(FieldSyncedAt, v))
+}
+
+// SyncedAtLT applies the LT predicate on the "synced_at" field.
+func SyncedAtLT(v time.Time) predicate.LdapDepartment {
+	return predicate.LdapDepartment(sql.FieldLT(FieldSyncedAt, v))
+}
+
+// SyncedAtLTE applies the LTE predicate on the "synced_at" field.
+func SyncedAtLTE(v time.Time) predicate.LdapDepartment {
+	return predicate.LdapDepartment(sql.FieldLTE(FieldSyncedAt, v))
+}
+
+// SyncedAtIsNil applies the IsNil predicate on the "synced_at" field.
+func SyncedAtIsNil() predicate.LdapDepartment {
+	return predicate.LdapDepartment(sql.FieldIsNull(FieldSyncedAt))
+}
+
+// SyncedAtNotNil applies the NotNil predicate on the "synced_at" field.
+func SyncedAtNotNil() predicate.LdapDepartment {
+	return predicate.LdapDepartment(sql.FieldNotNull(FieldSyncedAt))
+}
+
+// HasProvider applies the HasEdge predicate on the "provider" edge.
+func HasProvider() predicate.LdapDepartment {
+	return predicate.LdapDepartment(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ProviderTable, ProviderColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasProviderWith applies the HasEdge predicate on the "provider" edge with a given conditions (other predicates).
+func HasProviderWith(preds ...predicate.LdapProvider) predicate.LdapDepartment {
+	return predicate.LdapDepartment(func(s *sql.Selector) {
+		step := newProviderStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.LdapDepartment) predicate.LdapDepartment {
+	return predicate.LdapDepartment(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.LdapDepartment) predicate.LdapDepartment {
+	return predicate.LdapDepartment(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.LdapDepartment) predicate.LdapDepartment {
+	return predicate.LdapDepartment(sql.NotPredicates(p))
+}
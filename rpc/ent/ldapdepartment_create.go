@@ -0,0 +1,366 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/coder-lulu/newbee-core/rpc/ent/ldapdepartment"
+	"github.com/coder-lulu/newbee-core/rpc/ent/ldapprovider"
+)
+
+// LdapDepartmentCreate is the builder for creating a LdapDepartment entity.
+type LdapDepartmentCreate struct {
+	config
+	mutation *LdapDepartmentMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *LdapDepartmentCreate) SetCreatedAt(v time.Time) *LdapDepartmentCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *LdapDepartmentCreate) SetNillableCreatedAt(v *time.Time) *LdapDepartmentCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *LdapDepartmentCreate) SetUpdatedAt(v time.Time) *LdapDepartmentCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *LdapDepartmentCreate) SetNillableUpdatedAt(v *time.Time) *LdapDepartmentCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *LdapDepartmentCreate) SetTenantID(v uint64) *LdapDepartmentCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_c *LdapDepartmentCreate) SetNillableTenantID(v *uint64) *LdapDepartmentCreate {
+	if v != nil {
+		_c.SetTenantID(*v)
+	}
+	return _c
+}
+
+// SetProviderID sets the "provider_id" field.
+func (_c *LdapDepartmentCreate) SetProviderID(v uint64) *LdapDepartmentCreate {
+	_c.mutation.SetProviderID(v)
+	return _c
+}
+
+// SetDepartmentID sets the "department_id" field.
+func (_c *LdapDepartmentCreate) SetDepartmentID(v uint64) *LdapDepartmentCreate {
+	_c.mutation.SetDepartmentID(v)
+	return _c
+}
+
+// SetExternalID sets the "external_id" field.
+func (_c *LdapDepartmentCreate) SetExternalID(v string) *LdapDepartmentCreate {
+	_c.mutation.SetExternalID(v)
+	return _c
+}
+
+// SetDn sets the "dn" field.
+func (_c *LdapDepartmentCreate) SetDn(v string) *LdapDepartmentCreate {
+	_c.mutation.SetDn(v)
+	return _c
+}
+
+// SetSyncedAt sets the "synced_at" field.
+func (_c *LdapDepartmentCreate) SetSyncedAt(v time.Time) *LdapDepartmentCreate {
+	_c.mutation.SetSyncedAt(v)
+	return _c
+}
+
+// SetNillableSyncedAt sets the "synced_at" field if the given value is not nil.
+func (_c *LdapDepartmentCreate) SetNillableSyncedAt(v *time.Time) *LdapDepartmentCreate {
+	if v != nil {
+		_c.SetSyncedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *LdapDepartmentCreate) SetID(v uint64) *LdapDepartmentCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetProvider sets the "provider" edge to the LdapProvider entity.
+func (_c *LdapDepartmentCreate) SetProvider(v *LdapProvider) *LdapDepartmentCreate {
+	return _c.SetProviderID(v.ID)
+}
+
+// Mutation returns the LdapDepartmentMutation object of the builder.
+func (_c *LdapDepartmentCreate) Mutation() *LdapDepartmentMutation {
+	return _c.mutation
+}
+
+// Save creates the LdapDepartment in the database.
+func (_c *LdapDepartmentCreate) Save(ctx context.Context) (*LdapDepartment, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *LdapDepartmentCreate) SaveX(ctx context.Context) *LdapDepartment {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LdapDepartmentCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LdapDepartmentCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *LdapDepartmentCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := ldapdepartment.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := ldapdepartment.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.TenantID(); !ok {
+		v := ldapdepartment.DefaultTenantID
+		_c.mutation.SetTenantID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *LdapDepartmentCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "LdapDepartment.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "LdapDepartment.updated_at"`)}
+	}
+	if _, ok := _c.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "LdapDepartment.tenant_id"`)}
+	}
+	if _, ok := _c.mutation.ProviderID(); !ok {
+		return &ValidationError{Name: "provider_id", err: errors.New(`ent: missing required field "LdapDepartment.provider_id"`)}
+	}
+	if _, ok := _c.mutation.DepartmentID(); !ok {
+		return &ValidationError{Name: "department_id", err: errors.New(`ent: missing required field "LdapDepartment.department_id"`)}
+	}
+	if _, ok := _c.mutation.ExternalID(); !ok {
+		return &ValidationError{Name: "external_id", err: errors.New(`ent: missing required field "LdapDepartment.external_id"`)}
+	}
+	if v, ok := _c.mutation.ExternalID(); ok {
+		if err := ldapdepartment.ExternalIDValidator(v); err != nil {
+			return &ValidationError{Name: "external_id", err: fmt.Errorf(`ent: validator failed for field "LdapDepartment.external_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Dn(); !ok {
+		return &ValidationError{Name: "dn", err: errors.New(`ent: missing required field "LdapDepartment.dn"`)}
+	}
+	if v, ok := _c.mutation.Dn(); ok {
+		if err := ldapdepartment.DnValidator(v); err != nil {
+			return &ValidationError{Name: "dn", err: fmt.Errorf(`ent: validator failed for field "LdapDepartment.dn": %w`, err)}
+		}
+	}
+	if len(_c.mutation.ProviderIDs()) == 0 {
+		return &ValidationError{Name: "provider", err: errors.New(`ent: missing required edge "LdapDepartment.provider"`)}
+	}
+	return nil
+}
+
+func (_c *LdapDepartmentCreate) sqlSave(ctx context.Context) (*LdapDepartment, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint64(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *LdapDepartmentCreate) createSpec() (*LdapDepartment, *sqlgraph.CreateSpec) {
+	var (
+		_node = &LdapDepartment{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(ldapdepartment.Table, sqlgraph.NewFieldSpec(ldapdepartment.FieldID, field.TypeUint64))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(ldapdepartment.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(ldapdepartment.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(ldapdepartment.FieldTenantID, field.TypeUint64, value)
+		_node.TenantID = value
+	}
+	if value, ok := _c.mutation.DepartmentID(); ok {
+		_spec.SetField(ldapdepartment.FieldDepartmentID, field.TypeUint64, value)
+		_node.DepartmentID = value
+	}
+	if value, ok := _c.mutation.ExternalID(); ok {
+		_spec.SetField(ldapdepartment.FieldExternalID, field.TypeString, value)
+		_node.ExternalID = value
+	}
+	if value, ok := _c.mutation.Dn(); ok {
+		_spec.SetField(ldapdepartment.FieldDn, field.TypeString, value)
+		_node.Dn = value
+	}
+	if value, ok := _c.mutation.SyncedAt(); ok {
+		_spec.SetField(ldapdepartment.FieldSyncedAt, field.TypeTime, value)
+		_node.SyncedAt = value
+	}
+	if nodes := _c.mutation.ProviderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   ldapdepartment.ProviderTable,
+			Columns: []string{ldapdepartment.ProviderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ldapprovider.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ProviderID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// LdapDepartmentCreateBulk is the builder for creating many LdapDepartment entities in bulk.
+type LdapDepartmentCreateBulk struct {
+	config
+	err      error
+	builders []*LdapDepartmentCreate
+}
+
+// Save creates the LdapDepartment entities in the database.
+func (_c *LdapDepartmentCreateBulk) Save(ctx context.Context) ([]*LdapDepartment, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*LdapDepartment, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*LdapDepartmentMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *LdapDepartmentCreateBulk) SaveX(ctx context.Context) []*LdapDepartment {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LdapDepartmentCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LdapDepartmentCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
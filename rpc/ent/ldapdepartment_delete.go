@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/coder-lulu/newbee-core/rpc/ent/ldapdepartment"
+	"github.com/coder-lulu/newbee-core/rpc/ent/predicate"
+)
+
+// LdapDepartmentDelete is the builder for deleting a LdapDepartment entity.
+type LdapDepartmentDelete struct {
+	config
+	hooks    []Hook
+	mutation *LdapDepartmentMutation
+}
+
+// Where appends a list predicates to the LdapDepartmentDelete builder.
+func (_d *LdapDepartmentDelete) Where(ps ...predicate.LdapDepartment) *LdapDepartmentDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *LdapDepartmentDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *LdapDepartmentDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *LdapDepartmentDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(ldapdepartment.Table, sqlgraph.NewFieldSpec(ldapdepartment.FieldID, field.TypeUint64))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// LdapDepartmentDeleteOne is the builder for deleting a single LdapDepartment entity.
+type LdapDepartmentDeleteOne struct {
+	_d *LdapDepartmentDelete
+}
+
+// Where appends a list predicates to the LdapDepartmentDelete builder.
+func (_d *LdapDepartmentDeleteOne) Where(ps ...predicate.LdapDepartment) *LdapDepartmentDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *LdapDepartmentDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{ldapdepartment.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *LdapDepartmentDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
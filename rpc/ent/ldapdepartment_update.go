@@ -0,0 +1,553 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/coder-lulu/newbee-core/rpc/ent/ldapdepartment"
+	"github.com/coder-lulu/newbee-core/rpc/ent/ldapprovider"
+	"github.com/coder-lulu/newbee-core/rpc/ent/predicate"
+)
+
+// LdapDepartmentUpdate is the builder for updating LdapDepartment entities.
+type LdapDepartmentUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *LdapDepartmentMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the LdapDepartmentUpdate builder.
+func (_u *LdapDepartmentUpdate) Where(ps ...predicate.LdapDepartment) *LdapDepartmentUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *LdapDepartmentUpdate) SetUpdatedAt(v time.Time) *LdapDepartmentUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetProviderID sets the "provider_id" field.
+func (_u *LdapDepartmentUpdate) SetProviderID(v uint64) *LdapDepartmentUpdate {
+	_u.mutation.SetProviderID(v)
+	return _u
+}
+
+// SetNillableProviderID sets the "provider_id" field if the given value is not nil.
+func (_u *LdapDepartmentUpdate) SetNillableProviderID(v *uint64) *LdapDepartmentUpdate {
+	if v != nil {
+		_u.SetProviderID(*v)
+	}
+	return _u
+}
+
+// SetDepartmentID sets the "department_id" field.
+func (_u *LdapDepartmentUpdate) SetDepartmentID(v uint64) *LdapDepartmentUpdate {
+	_u.mutation.ResetDepartmentID()
+	_u.mutation.SetDepartmentID(v)
+	return _u
+}
+
+// SetNillableDepartmentID sets the "department_id" field if the given value is not nil.
+func (_u *LdapDepartmentUpdate) SetNillableDepartmentID(v *uint64) *LdapDepartmentUpdate {
+	if v != nil {
+		_u.SetDepartmentID(*v)
+	}
+	return _u
+}
+
+// AddDepartmentID adds value to the "department_id" field.
+func (_u *LdapDepartmentUpdate) AddDepartmentID(v int64) *LdapDepartmentUpdate {
+	_u.mutation.AddDepartmentID(v)
+	return _u
+}
+
+// SetExternalID sets the "external_id" field.
+func (_u *LdapDepartmentUpdate) SetExternalID(v string) *LdapDepartmentUpdate {
+	_u.mutation.SetExternalID(v)
+	return _u
+}
+
+// SetNillableExternalID sets the "external_id" field if the given value is not nil.
+func (_u *LdapDepartmentUpdate) SetNillableExternalID(v *string) *LdapDepartmentUpdate {
+	if v != nil {
+		_u.SetExternalID(*v)
+	}
+	return _u
+}
+
+// SetDn sets the "dn" field.
+func (_u *LdapDepartmentUpdate) SetDn(v string) *LdapDepartmentUpdate {
+	_u.mutation.SetDn(v)
+	return _u
+}
+
+// SetNillableDn sets the "dn" field if the given value is not nil.
+func (_u *LdapDepartmentUpdate) SetNillableDn(v *string) *LdapDepartmentUpdate {
+	if v != nil {
+		_u.SetDn(*v)
+	}
+	return _u
+}
+
+// SetSyncedAt sets the "synced_at" field.
+func (_u *LdapDepartmentUpdate) SetSyncedAt(v time.Time) *LdapDepartmentUpdate {
+	_u.mutation.SetSyncedAt(v)
+	return _u
+}
+
+// SetNillableSyncedAt sets the "synced_at" field if the given value is not nil.
+func (_u *LdapDepartmentUpdate) SetNillableSyncedAt(v *time.Time) *LdapDepartmentUpdate {
+	if v != nil {
+		_u.SetSyncedAt(*v)
+	}
+	return _u
+}
+
+// ClearSyncedAt clears the value of the "synced_at" field.
+func (_u *LdapDepartmentUpdate) ClearSyncedAt() *LdapDepartmentUpdate {
+	_u.mutation.ClearSyncedAt()
+	return _u
+}
+
+// SetProvider sets the "provider" edge to the LdapProvider entity.
+func (_u *LdapDepartmentUpdate) SetProvider(v *LdapProvider) *LdapDepartmentUpdate {
+	return _u.SetProviderID(v.ID)
+}
+
+// Mutation returns the LdapDepartmentMutation object of the builder.
+func (_u *LdapDepartmentUpdate) Mutation() *LdapDepartmentMutation {
+	return _u.mutation
+}
+
+// ClearProvider clears the "provider" edge to the LdapProvider entity.
+func (_u *LdapDepartmentUpdate) ClearProvider() *LdapDepartmentUpdate {
+	_u.mutation.ClearProvider()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *LdapDepartmentUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *LdapDepartmentUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *LdapDepartmentUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *LdapDepartmentUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *LdapDepartmentUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := ldapdepartment.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *LdapDepartmentUpdate) check() error {
+	if v, ok := _u.mutation.ExternalID(); ok {
+		if err := ldapdepartment.ExternalIDValidator(v); err != nil {
+			return &ValidationError{Name: "external_id", err: fmt.Errorf(`ent: validator failed for field "LdapDepartment.external_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Dn(); ok {
+		if err := ldapdepartment.DnValidator(v); err != nil {
+			return &ValidationError{Name: "dn", err: fmt.Errorf(`ent: validator failed for field "LdapDepartment.dn": %w`, err)}
+		}
+	}
+	if _u.mutation.ProviderCleared() && len(_u.mutation.ProviderIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "LdapDepartment.provider"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *LdapDepartmentUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *LdapDepartmentUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *LdapDepartmentUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(ldapdepartment.Table, ldapdepartment.Columns, sqlgraph.NewFieldSpec(ldapdepartment.FieldID, field.TypeUint64))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(ldapdepartment.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.DepartmentID(); ok {
+		_spec.SetField(ldapdepartment.FieldDepartmentID, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.AddedDepartmentID(); ok {
+		_spec.AddField(ldapdepartment.FieldDepartmentID, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.ExternalID(); ok {
+		_spec.SetField(ldapdepartment.FieldExternalID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Dn(); ok {
+		_spec.SetField(ldapdepartment.FieldDn, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.SyncedAt(); ok {
+		_spec.SetField(ldapdepartment.FieldSyncedAt, field.TypeTime, value)
+	}
+	if _u.mutation.SyncedAtCleared() {
+		_spec.ClearField(ldapdepartment.FieldSyncedAt, field.TypeTime)
+	}
+	if _u.mutation.ProviderCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   ldapdepartment.ProviderTable,
+			Columns: []string{ldapdepartment.ProviderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ldapprovider.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ProviderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   ldapdepartment.ProviderTable,
+			Columns: []string{ldapdepartment.ProviderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ldapprovider.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{ldapdepartment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// LdapDepartmentUpdateOne is the builder for updating a single LdapDepartment entity.
+type LdapDepartmentUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *LdapDepartmentMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *LdapDepartmentUpdateOne) SetUpdatedAt(v time.Time) *LdapDepartmentUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetProviderID sets the "provider_id" field.
+func (_u *LdapDepartmentUpdateOne) SetProviderID(v uint64) *LdapDepartmentUpdateOne {
+	_u.mutation.SetProviderID(v)
+	return _u
+}
+
+// SetNillableProviderID sets the "provider_id" field if the given value is not nil.
+func (_u *LdapDepartmentUpdateOne) SetNillableProviderID(v *uint64) *LdapDepartmentUpdateOne {
+	if v != nil {
+		_u.SetProviderID(*v)
+	}
+	return _u
+}
+
+// SetDepartmentID sets the "department_id" field.
+func (_u *LdapDepartmentUpdateOne) SetDepartmentID(v uint64) *LdapDepartmentUpdateOne {
+	_u.mutation.ResetDepartmentID()
+	_u.mutation.SetDepartmentID(v)
+	return _u
+}
+
+// SetNillableDepartmentID sets the "department_id" field if the given value is not nil.
+func (_u *LdapDepartmentUpdateOne) SetNillableDepartmentID(v *uint64) *LdapDepartmentUpdateOne {
+	if v != nil {
+		_u.SetDepartmentID(*v)
+	}
+	return _u
+}
+
+// AddDepartmentID adds value to the "department_id" field.
+func (_u *LdapDepartmentUpdateOne) AddDepartmentID(v int64) *LdapDepartmentUpdateOne {
+	_u.mutation.AddDepartmentID(v)
+	return _u
+}
+
+// SetExternalID sets the "external_id" field.
+func (_u *LdapDepartmentUpdateOne) SetExternalID(v string) *LdapDepartmentUpdateOne {
+	_u.mutation.SetExternalID(v)
+	return _u
+}
+
+// SetNillableExternalID sets the "external_id" field if the given value is not nil.
+func (_u *LdapDepartmentUpdateOne) SetNillableExternalID(v *string) *LdapDepartmentUpdateOne {
+	if v != nil {
+		_u.SetExternalID(*v)
+	}
+	return _u
+}
+
+// SetDn sets the "dn" field.
+func (_u *LdapDepartmentUpdateOne) SetDn(v string) *LdapDepartmentUpdateOne {
+	_u.mutation.SetDn(v)
+	return _u
+}
+
+// SetNillableDn sets the "dn" field if the given value is not nil.
+func (_u *LdapDepartmentUpdateOne) SetNillableDn(v *string) *LdapDepartmentUpdateOne {
+	if v != nil {
+		_u.SetDn(*v)
+	}
+	return _u
+}
+
+// SetSyncedAt sets the "synced_at" field.
+func (_u *LdapDepartmentUpdateOne) SetSyncedAt(v time.Time) *LdapDepartmentUpdateOne {
+	_u.mutation.SetSyncedAt(v)
+	return _u
+}
+
+// SetNillableSyncedAt sets the "synced_at" field if the given value is not nil.
+func (_u *LdapDepartmentUpdateOne) SetNillableSyncedAt(v *time.Time) *LdapDepartmentUpdateOne {
+	if v != nil {
+		_u.SetSyncedAt(*v)
+	}
+	return _u
+}
+
+// ClearSyncedAt clears the value of the "synced_at" field.
+func (_u *LdapDepartmentUpdateOne) ClearSyncedAt() *LdapDepartmentUpdateOne {
+	_u.mutation.ClearSyncedAt()
+	return _u
+}
+
+// SetProvider sets the "provider" edge to the LdapProvider entity.
+func (_u *LdapDepartmentUpdateOne) SetProvider(v *LdapProvider) *LdapDepartmentUpdateOne {
+	return _u.SetProviderID(v.ID)
+}
+
+// Mutation returns the LdapDepartmentMutation object of the builder.
+func (_u *LdapDepartmentUpdateOne) Mutation() *LdapDepartmentMutation {
+	return _u.mutation
+}
+
+// ClearProvider clears the "provider" edge to the LdapProvider entity.
+func (_u *LdapDepartmentUpdateOne) ClearProvider() *LdapDepartmentUpdateOne {
+	_u.mutation.ClearProvider()
+	return _u
+}
+
+// Where appends a list predicates to the LdapDepartmentUpdate builder.
+func (_u *LdapDepartmentUpdateOne) Where(ps ...predicate.LdapDepartment) *LdapDepartmentUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *LdapDepartmentUpdateOne) Select(field string, fields ...string) *LdapDepartmentUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated LdapDepartment entity.
+func (_u *LdapDepartmentUpdateOne) Save(ctx context.Context) (*LdapDepartment, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *LdapDepartmentUpdateOne) SaveX(ctx context.Context) *LdapDepartment {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *LdapDepartmentUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *LdapDepartmentUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *LdapDepartmentUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := ldapdepartment.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *LdapDepartmentUpdateOne) check() error {
+	if v, ok := _u.mutation.ExternalID(); ok {
+		if err := ldapdepartment.ExternalIDValidator(v); err != nil {
+			return &ValidationError{Name: "external_id", err: fmt.Errorf(`ent: validator failed for field "LdapDepartment.external_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Dn(); ok {
+		if err := ldapdepartment.DnValidator(v); err != nil {
+			return &ValidationError{Name: "dn", err: fmt.Errorf(`ent: validator failed for field "LdapDepartment.dn": %w`, err)}
+		}
+	}
+	if _u.mutation.ProviderCleared() && len(_u.mutation.ProviderIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "LdapDepartment.provider"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *LdapDepartmentUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *LdapDepartmentUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *LdapDepartmentUpdateOne) sqlSave(ctx context.Context) (_node *LdapDepartment, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(ldapdepartment.Table, ldapdepartment.Columns, sqlgraph.NewFieldSpec(ldapdepartment.FieldID, field.TypeUint64))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "LdapDepartment.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, ldapdepartment.FieldID)
+		for _, f := range fields {
+			if !ldapdepartment.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != ldapdepartment.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(ldapdepartment.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.DepartmentID(); ok {
+		_spec.SetField(ldapdepartment.FieldDepartmentID, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.AddedDepartmentID(); ok {
+		_spec.AddField(ldapdepartment.FieldDepartmentID, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.ExternalID(); ok {
+		_spec.SetField(ldapdepartment.FieldExternalID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Dn(); ok {
+		_spec.SetField(ldapdepartment.FieldDn, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.SyncedAt(); ok {
+		_spec.SetField(ldapdepartment.FieldSyncedAt, field.TypeTime, value)
+	}
+	if _u.mutation.SyncedAtCleared() {
+		_spec.ClearField(ldapdepartment.FieldSyncedAt, field.TypeTime)
+	}
+	if _u.mutation.ProviderCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   ldapdepartment.ProviderTable,
+			Columns: []string{ldapdepartment.ProviderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ldapprovider.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ProviderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   ldapdepartment.ProviderTable,
+			Columns: []string{ldapdepartment.ProviderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ldapprovider.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &LdapDepartment{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{ldapdepartment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,560 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/coder-lulu/newbee-core/rpc/ent/ldapprovider"
+)
+
+// LDAP Directory Table | LDAP目录配置表
+type LdapProvider struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uint64 `json:"id,omitempty"`
+	// Create Time | 创建日期
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Update Time | 修改日期
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Status 1: normal 2: ban | 状态 1 正常 2 禁用
+	Status uint8 `json:"status,omitempty"`
+	// Tenant ID | 租户 ID
+	TenantID uint64 `json:"tenant_id,omitempty"`
+	// The provider's name | 目录名称
+	Name string `json:"name,omitempty"`
+	// Display name for UI | UI显示名称
+	DisplayName string `json:"display_name,omitempty"`
+	// Directory type: openldap, ad | 目录类型
+	DirectoryType string `json:"directory_type,omitempty"`
+	// Server URL, ldap:// or ldaps:// | 服务器地址
+	URL string `json:"url,omitempty"`
+	// Whether to upgrade the connection with StartTLS | 是否使用StartTLS
+	StartTLS bool `json:"start_tls,omitempty"`
+	// Whether to skip the verification of the server certificate | 是否跳过证书校验
+	InsecureSkipVerify bool `json:"insecure_skip_verify,omitempty"`
+	// Service account DN, empty for anonymous searches | 服务账号DN
+	BindDn string `json:"bind_dn,omitempty"`
+	// Encrypted service account password | 加密的服务账号密码
+	EncryptedBindPassword string `json:"-"`
+	// Encryption key ID | 加密密钥ID
+	EncryptionKeyID string `json:"encryption_key_id,omitempty"`
+	// Base DN of users | 用户查询的基础DN
+	BaseDn string `json:"base_dn,omitempty"`
+	// Authentication mode: template, search | 认证方式：DN模板或先查询后绑定
+	AuthMode string `json:"auth_mode,omitempty"`
+	// User DN template containing {username} | 用户DN模板
+	UserDnTemplate string `json:"user_dn_template,omitempty"`
+	// User filter containing {username} | 用户过滤条件
+	UserFilter string `json:"user_filter,omitempty"`
+	// Base DN of organizational units | 组织单位的基础DN
+	OuBaseDn string `json:"ou_base_dn,omitempty"`
+	// Organizational unit filter | 组织单位过滤条件
+	OuFilter string `json:"ou_filter,omitempty"`
+	// Base DN of groups | 组的基础DN
+	GroupBaseDn string `json:"group_base_dn,omitempty"`
+	// Group filter | 组过滤条件
+	GroupFilter string `json:"group_filter,omitempty"`
+	// Member attribute of groups | 组成员属性
+	GroupMemberAttribute string `json:"group_member_attribute,omitempty"`
+	// Attribute mapping to users and roles | 属性到用户和角色的映射
+	AttributeMapping map[string]interface{} `json:"attribute_mapping,omitempty"`
+	// Whether users can log in with the directory password | 是否允许目录密码登录
+	LoginEnabled bool `json:"login_enabled,omitempty"`
+	// Whether to create users on first login | 是否在首次登录时自动创建用户
+	JitProvision bool `json:"jit_provision,omitempty"`
+	// Whether to link local users with the same username | 是否绑定同名的本地用户
+	LinkExisting bool `json:"link_existing,omitempty"`
+	// Whether to sync the directory periodically | 是否定时同步目录
+	SyncEnabled bool `json:"sync_enabled,omitempty"`
+	// Sync interval in minutes | 同步间隔（分钟）
+	SyncInterval uint32 `json:"sync_interval,omitempty"`
+	// Policy for entries removed from the directory: keep, disable, delete | 目录中删除的条目处理策略
+	DeletionPolicy string `json:"deletion_policy,omitempty"`
+	// Department under which organizational units are mirrored | 组织单位同步到的上级部门
+	RootDepartmentID uint64 `json:"root_department_id,omitempty"`
+	// Department of users outside any organizational unit | 不属于任何组织单位的用户的默认部门
+	DefaultDepartmentID uint64 `json:"default_department_id,omitempty"`
+	// Roles granted to provisioned users | 自动创建用户的默认角色
+	DefaultRoleIds []uint64 `json:"default_role_ids,omitempty"`
+	// Last sync time | 最近同步时间
+	LastSyncAt *time.Time `json:"last_sync_at,omitempty"`
+	// Whether the provider is enabled | 是否启用
+	Enabled bool `json:"enabled,omitempty"`
+	// Sort order | 排序
+	Sort uint32 `json:"sort,omitempty"`
+	// Remark | 备注
+	Remark string `json:"remark,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the LdapProviderQuery when eager-loading is set.
+	Edges        LdapProviderEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// LdapProviderEdges holds the relations/edges for other nodes in the graph.
+type LdapProviderEdges struct {
+	// LdapAccounts holds the value of the ldap_accounts edge.
+	LdapAccounts []*LdapAccount `json:"ldap_accounts,omitempty"`
+	// LdapDepartments holds the value of the ldap_departments edge.
+	LdapDepartments []*LdapDepartment `json:"ldap_departments,omitempty"`
+	// SyncRuns holds the value of the sync_runs edge.
+	SyncRuns []*LdapSyncRun `json:"sync_runs,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// LdapAccountsOrErr returns the LdapAccounts value or an error if the edge
+// was not loaded in eager-loading.
+func (e LdapProviderEdges) LdapAccountsOrErr() ([]*LdapAccount, error) {
+	if e.loadedTypes[0] {
+		return e.LdapAccounts, nil
+	}
+	return nil, &NotLoadedError{edge: "ldap_accounts"}
+}
+
+// LdapDepartmentsOrErr returns the LdapDepartments value or an error if the edge
+// was not loaded in eager-loading.
+func (e LdapProviderEdges) LdapDepartmentsOrErr() ([]*LdapDepartment, error) {
+	if e.loadedTypes[1] {
+		return e.LdapDepartments, nil
+	}
+	return nil, &NotLoadedError{edge: "ldap_departments"}
+}
+
+// SyncRunsOrErr returns the SyncRuns value or an error if the edge
+// was not loaded in eager-loading.
+func (e LdapProviderEdges) SyncRunsOrErr() ([]*LdapSyncRun, error) {
+	if e.loadedTypes[2] {
+		return e.SyncRuns, nil
+	}
+	return nil, &NotLoadedError{edge: "sync_runs"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*LdapProvider) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case ldapprovider.FieldAttributeMapping, ldapprovider.FieldDefaultRoleIds:
+			values[i] = new([]byte)
+		case ldapprovider.FieldStartTLS, ldapprovider.FieldInsecureSkipVerify, ldapprovider.FieldLoginEnabled, ldapprovider.FieldJitProvision, ldapprovider.FieldLinkExisting, ldapprovider.FieldSyncEnabled, ldapprovider.FieldEnabled:
+			values[i] = new(sql.NullBool)
+		case ldapprovider.FieldID, ldapprovider.FieldStatus, ldapprovider.FieldTenantID, ldapprovider.FieldSyncInterval, ldapprovider.FieldRootDepartmentID, ldapprovider.FieldDefaultDepartmentID, ldapprovider.FieldSort:
+			values[i] = new(sql.NullInt64)
+		case ldapprovider.FieldName, ldapprovider.FieldDisplayName, ldapprovider.FieldDirectoryType, ldapprovider.FieldURL, ldapprovider.FieldBindDn, ldapprovider.FieldEncryptedBindPassword, ldapprovider.FieldEncryptionKeyID, ldapprovider.FieldBaseDn, ldapprovider.FieldAuthMode, ldapprovider.FieldUserDnTemplate, ldapprovider.FieldUserFilter, ldapprovider.FieldOuBaseDn, ldapprovider.FieldOuFilter, ldapprovider.FieldGroupBaseDn, ldapprovider.FieldGroupFilter, ldapprovider.FieldGroupMemberAttribute, ldapprovider.FieldDeletionPolicy, ldapprovider.FieldRemark:
+			values[i] = new(sql.NullString)
+		case ldapprovider.FieldCreatedAt, ldapprovider.FieldUpdatedAt, ldapprovider.FieldLastSyncAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the LdapProvider fields.
+func (_m *LdapProvider) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case ldapprovider.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = uint64(value.Int64)
+		case ldapprovider.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case ldapprovider.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case ldapprovider.FieldStatus:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = uint8(value.Int64)
+			}
+		case ldapprovider.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				_m.TenantID = uint64(value.Int64)
+			}
+		case ldapprovider.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case ldapprovider.FieldDisplayName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field display_name", values[i])
+			} else if value.Valid {
+				_m.DisplayName = value.String
+			}
+		case ldapprovider.FieldDirectoryType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field directory_type", values[i])
+			} else if value.Valid {
+				_m.DirectoryType = value.String
+			}
+		case ldapprovider.FieldURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field url", values[i])
+			} else if value.Valid {
+				_m.URL = value.String
+			}
+		case ldapprovider.FieldStartTLS:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field start_tls", values[i])
+			} else if value.Valid {
+				_m.StartTLS = value.Bool
+			}
+		case ldapprovider.FieldInsecureSkipVerify:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field insecure_skip_verify", values[i])
+			} else if value.Valid {
+				_m.InsecureSkipVerify = value.Bool
+			}
+		case ldapprovider.FieldBindDn:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field bind_dn", values[i])
+			} else if value.Valid {
+				_m.BindDn = value.String
+			}
+		case ldapprovider.FieldEncryptedBindPassword:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field encrypted_bind_password", values[i])
+			} else if value.Valid {
+				_m.EncryptedBindPassword = value.String
+			}
+		case ldapprovider.FieldEncryptionKeyID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field encryption_key_id", values[i])
+			} else if value.Valid {
+				_m.EncryptionKeyID = value.String
+			}
+		case ldapprovider.FieldBaseDn:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field base_dn", values[i])
+			} else if value.Valid {
+				_m.BaseDn = value.String
+			}
+		case ldapprovider.FieldAuthMode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field auth_mode", values[i])
+			} else if value.Valid {
+				_m.AuthMode = value.String
+			}
+		case ldapprovider.FieldUserDnTemplate:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_dn_template", values[i])
+			} else if value.Valid {
+				_m.UserDnTemplate = value.String
+			}
+		case ldapprovider.FieldUserFilter:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_filter", values[i])
+			} else if value.Valid {
+				_m.UserFilter = value.String
+			}
+		case ldapprovider.FieldOuBaseDn:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ou_base_dn", values[i])
+			} else if value.Valid {
+				_m.OuBaseDn = value.String
+			}
+		case ldapprovider.FieldOuFilter:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ou_filter", values[i])
+			} else if value.Valid {
+				_m.OuFilter = value.String
+			}
+		case ldapprovider.FieldGroupBaseDn:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field group_base_dn", values[i])
+			} else if value.Valid {
+				_m.GroupBaseDn = value.String
+			}
+		case ldapprovider.FieldGroupFilter:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field group_filter", values[i])
+			} else if value.Valid {
+				_m.GroupFilter = value.String
+			}
+		case ldapprovider.FieldGroupMemberAttribute:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field group_member_attribute", values[i])
+			} else if value.Valid {
+				_m.GroupMemberAttribute = value.String
+			}
+		case ldapprovider.FieldAttributeMapping:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field attribute_mapping", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.AttributeMapping); err != nil {
+					return fmt.Errorf("unmarshal field attribute_mapping: %w", err)
+				}
+			}
+		case ldapprovider.FieldLoginEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field login_enabled", values[i])
+			} else if value.Valid {
+				_m.LoginEnabled = value.Bool
+			}
+		case ldapprovider.FieldJitProvision:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field jit_provision", values[i])
+			} else if value.Valid {
+				_m.JitProvision = value.Bool
+			}
+		case ldapprovider.FieldLinkExisting:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field link_existing", values[i])
+			} else if value.Valid {
+				_m.LinkExisting = value.Bool
+			}
+		case ldapprovider.FieldSyncEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field sync_enabled", values[i])
+			} else if value.Valid {
+				_m.SyncEnabled = value.Bool
+			}
+		case ldapprovider.FieldSyncInterval:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field sync_interval", values[i])
+			} else if value.Valid {
+				_m.SyncInterval = uint32(value.Int64)
+			}
+		case ldapprovider.FieldDeletionPolicy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field deletion_policy", values[i])
+			} else if value.Valid {
+				_m.DeletionPolicy = value.String
+			}
+		case ldapprovider.FieldRootDepartmentID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field root_department_id", values[i])
+			} else if value.Valid {
+				_m.RootDepartmentID = uint64(value.Int64)
+			}
+		case ldapprovider.FieldDefaultDepartmentID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field default_department_id", values[i])
+			} else if value.Valid {
+				_m.DefaultDepartmentID = uint64(value.Int64)
+			}
+		case ldapprovider.FieldDefaultRoleIds:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field default_role_ids", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.DefaultRoleIds); err != nil {
+					return fmt.Errorf("unmarshal field default_role_ids: %w", err)
+				}
+			}
+		case ldapprovider.FieldLastSyncAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_sync_at", values[i])
+			} else if value.Valid {
+				_m.LastSyncAt = new(time.Time)
+				*_m.LastSyncAt = value.Time
+			}
+		case ldapprovider.FieldEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field enabled", values[i])
+			} else if value.Valid {
+				_m.Enabled = value.Bool
+			}
+		case ldapprovider.FieldSort:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field sort", values[i])
+			} else if value.Valid {
+				_m.Sort = uint32(value.Int64)
+			}
+		case ldapprovider.FieldRemark:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field remark", values[i])
+			} else if value.Valid {
+				_m.Remark = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the LdapProvider.
+// This includes values selected through modifiers, order, etc.
+func (_m *LdapProvider) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryLdapAccounts queries the "ldap_accounts" edge of the LdapProvider entity.
+func (_m *LdapProvider) QueryLdapAccounts() *LdapAccountQuery {
+	return NewLdapProviderClient(_m.config).QueryLdapAccounts(_m)
+}
+
+// QueryLdapDepartments queries the "ldap_departments" edge of the LdapProvider entity.
+func (_m *LdapProvider) QueryLdapDepartments() *LdapDepartmentQuery {
+	return NewLdapProviderClient(_m.config).QueryLdapDepartments(_m)
+}
+
+// QuerySyncRuns queries the "sync_runs" edge of the LdapProvider entity.
+func (_m *LdapProvider) QuerySyncRuns() *LdapSyncRunQuery {
+	return NewLdapProviderClient(_m.config).QuerySyncRuns(_m)
+}
+
+// Update returns a builder for updating this LdapProvider.
+// Note that you need to call LdapProvider.Unwrap() before calling this method if this LdapProvider
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *LdapProvider) Update() *LdapProviderUpdateOne {
+	return NewLdapProviderClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the LdapProvider entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *LdapProvider) Unwrap() *LdapProvider {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: LdapProvider is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *LdapProvider) String() string {
+	var builder strings.Builder
+	builder.WriteString("LdapProvider(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("display_name=")
+	builder.WriteString(_m.DisplayName)
+	builder.WriteString(", ")
+	builder.WriteString("directory_type=")
+	builder.WriteString(_m.DirectoryType)
+	builder.WriteString(", ")
+	builder.WriteString("url=")
+	builder.WriteString(_m.URL)
+	builder.WriteString(", ")
+	builder.WriteString("start_tls=")
+	builder.WriteString(fmt.Sprintf("%v", _m.StartTLS))
+	builder.WriteString(", ")
+	builder.WriteString("insecure_skip_verify=")
+	builder.WriteString(fmt.Sprintf("%v", _m.InsecureSkipVerify))
+	builder.WriteString(", ")
+	builder.WriteString("bind_dn=")
+	builder.WriteString(_m.BindDn)
+	builder.WriteString(", ")
+	builder.WriteString("encrypted_bind_password=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("encryption_key_id=")
+	builder.WriteString(_m.EncryptionKeyID)
+	builder.WriteString(", ")
+	builder.WriteString("base_dn=")
+	builder.WriteString(_m.BaseDn)
+	builder.WriteString(", ")
+	builder.WriteString("auth_mode=")
+	builder.WriteString(_m.AuthMode)
+	builder.WriteString(", ")
+	builder.WriteString("user_dn_template=")
+	builder.WriteString(_m.UserDnTemplate)
+	builder.WriteString(", ")
+	builder.WriteString("user_filter=")
+	builder.WriteString(_m.UserFilter)
+	builder.WriteString(", ")
+	builder.WriteString("ou_base_dn=")
+	builder.WriteString(_m.OuBaseDn)
+	builder.WriteString(", ")
+	builder.WriteString("ou_filter=")
+	builder.WriteString(_m.OuFilter)
+	builder.WriteString(", ")
+	builder.WriteString("group_base_dn=")
+	builder.WriteString(_m.GroupBaseDn)
+	builder.WriteString(", ")
+	builder.WriteString("group_filter=")
+	builder.WriteString(_m.GroupFilter)
+	builder.WriteString(", ")
+	builder.WriteString("group_member_attribute=")
+	builder.WriteString(_m.GroupMemberAttribute)
+	builder.WriteString(", ")
+	builder.WriteString("attribute_mapping=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AttributeMapping))
+	builder.WriteString(", ")
+	builder.WriteString("login_enabled=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LoginEnabled))
+	builder.WriteString(", ")
+	builder.WriteString("jit_provision=")
+	builder.WriteString(fmt.Sprintf("%v", _m.JitProvision))
+	builder.WriteString(", ")
+	builder.WriteString("link_existing=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LinkExisting))
+	builder.WriteString(", ")
+	builder.WriteString("sync_enabled=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SyncEnabled))
+	builder.WriteString(", ")
+	builder.WriteString("sync_interval=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SyncInterval))
+	builder.WriteString(", ")
+	builder.WriteString("deletion_policy=")
+	builder.WriteString(_m.DeletionPolicy)
+	builder.WriteString(", ")
+	builder.WriteString("root_department_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RootDepartmentID))
+	builder.WriteString(", ")
+	builder.WriteString("default_department_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DefaultDepartmentID))
+	builder.WriteString(", ")
+	builder.WriteString("default_role_ids=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DefaultRoleIds))
+	builder.WriteString(", ")
+	if v := _m.LastSyncAt; v != nil {
+		builder.WriteString("last_sync_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("enabled=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Enabled))
+	builder.WriteString(", ")
+	builder.WriteString("sort=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Sort))
+	builder.WriteString(", ")
+	builder.WriteString("remark=")
+	builder.WriteString(_m.Remark)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// LdapProviders is a parsable slice of LdapProvider.
+type LdapProviders []*LdapProvider
@@ -0,0 +1,485 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ldapprovider
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the ldapprovider type in the database.
+	Label = "ldap_provider"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldDisplayName holds the string denoting the display_name field in the database.
+	FieldDisplayName = "display_name"
+	// FieldDirectoryType holds the string denoting the directory_type field in the database.
+	FieldDirectoryType = "directory_type"
+	// FieldURL holds the string denoting the url field in the database.
+	FieldURL = "url"
+	// FieldStartTLS holds the string denoting the start_tls field in the database.
+	FieldStartTLS = "start_tls"
+	// FieldInsecureSkipVerify holds the string denoting the insecure_skip_verify field in the database.
+	FieldInsecureSkipVerify = "insecure_skip_verify"
+	// FieldBindDn holds the string denoting the bind_dn field in the database.
+	FieldBindDn = "bind_dn"
+	// FieldEncryptedBindPassword holds the string denoting the encrypted_bind_password field in the database.
+	FieldEncryptedBindPassword = "encrypted_bind_password"
+	// FieldEncryptionKeyID holds the string denoting the encryption_key_id field in the database.
+	FieldEncryptionKeyID = "encryption_key_id"
+	// FieldBaseDn holds the string denoting the base_dn field in the database.
+	FieldBaseDn = "base_dn"
+	// FieldAuthMode holds the string denoting the auth_mode field in the database.
+	FieldAuthMode = "auth_mode"
+	// FieldUserDnTemplate holds the string denoting the user_dn_template field in the database.
+	FieldUserDnTemplate = "user_dn_template"
+	// FieldUserFilter holds the string denoting the user_filter field in the database.
+	FieldUserFilter = "user_filter"
+	// FieldOuBaseDn holds the string denoting the ou_base_dn field in the database.
+	FieldOuBaseDn = "ou_base_dn"
+	// FieldOuFilter holds the string denoting the ou_filter field in the database.
+	FieldOuFilter = "ou_filter"
+	// FieldGroupBaseDn holds the string denoting the group_base_dn field in the database.
+	FieldGroupBaseDn = "group_base_dn"
+	// FieldGroupFilter holds the string denoting the group_filter field in the database.
+	FieldGroupFilter = "group_filter"
+	// FieldGroupMemberAttribute holds the string denoting the group_member_attribute field in the database.
+	FieldGroupMemberAttribute = "group_member_attribute"
+	// FieldAttributeMapping holds the string denoting the attribute_mapping field in the database.
+	FieldAttributeMapping = "attribute_mapping"
+	// FieldLoginEnabled holds the string denoting the login_enabled field in the database.
+	FieldLoginEnabled = "login_enabled"
+	// FieldJitProvision holds the string denoting the jit_provision field in the database.
+	FieldJitProvision = "jit_provision"
+	// FieldLinkExisting holds the string denoting the link_existing field in the database.
+	FieldLinkExisting = "link_existing"
+	// FieldSyncEnabled holds the string denoting the sync_enabled field in the database.
+	FieldSyncEnabled = "sync_enabled"
+	// FieldSyncInterval holds the string denoting the sync_interval field in the database.
+	FieldSyncInterval = "sync_interval"
+	// FieldDeletionPolicy holds the string denoting the deletion_policy field in the database.
+	FieldDeletionPolicy = "deletion_policy"
+	// FieldRootDepartmentID holds the string denoting the root_department_id field in the database.
+	FieldRootDepartmentID = "root_department_id"
+	// FieldDefaultDepartmentID holds the string denoting the default_department_id field in the database.
+	FieldDefaultDepartmentID = "default_department_id"
+	// FieldDefaultRoleIds holds the string denoting the default_role_ids field in the database.
+	FieldDefaultRoleIds = "default_role_ids"
+	// FieldLastSyncAt holds the string denoting the last_sync_at field in the database.
+	FieldLastSyncAt = "last_sync_at"
+	// FieldEnabled holds the string denoting the enabled field in the database.
+	FieldEnabled = "enabled"
+	// FieldSort holds the string denoting the sort field in the database.
+	FieldSort = "sort"
+	// FieldRemark holds the string denoting the remark field in the database.
+	FieldRemark = "remark"
+	// EdgeLdapAccounts holds the string denoting the ldap_accounts edge name in mutations.
+	EdgeLdapAccounts = "ldap_accounts"
+	// EdgeLdapDepartments holds the string denoting the ldap_departments edge name in mutations.
+	EdgeLdapDepartments = "ldap_departments"
+	// EdgeSyncRuns holds the string denoting the sync_runs edge name in mutations.
+	EdgeSyncRuns = "sync_runs"
+	// Table holds the table name of the ldapprovider in the database.
+	Table = "sys_ldap_providers"
+	// LdapAccountsTable is the table that holds the ldap_accounts relation/edge.
+	LdapAccountsTable = "sys_ldap_accounts"
+	// LdapAccountsInverseTable is the table name for the LdapAccount entity.
+	// It exists in this package in order to avoid circular dependency with the "ldapaccount" package.
+	LdapAccountsInverseTable = "sys_ldap_accounts"
+	// LdapAccountsColumn is the table column denoting the ldap_accounts relation/edge.
+	LdapAccountsColumn = "provider_id"
+	// LdapDepartmentsTable is the table that holds the ldap_departments relation/edge.
+	LdapDepartmentsTable = "sys_ldap_departments"
+	// LdapDepartmentsInverseTable is the table name for the LdapDepartment entity.
+	// It exists in this package in order to avoid circular dependency with the "ldapdepartment" package.
+	LdapDepartmentsInverseTable = "sys_ldap_departments"
+	// LdapDepartmentsColumn is the table column denoting the ldap_departments relation/edge.
+	LdapDepartmentsColumn = "provider_id"
+	// SyncRunsTable is the table that holds the sync_runs relation/edge.
+	SyncRunsTable = "sys_ldap_sync_runs"
+	// SyncRunsInverseTable is the table name for the LdapSyncRun entity.
+	// It exists in this package in order to avoid circular dependency with the "ldapsyncrun" package.
+	SyncRunsInverseTable = "sys_ldap_sync_runs"
+	// SyncRunsColumn is the table column denoting the sync_runs relation/edge.
+	SyncRunsColumn = "provider_id"
+)
+
+// Columns holds all SQL columns for ldapprovider fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldStatus,
+	FieldTenantID,
+	FieldName,
+	FieldDisplayName,
+	FieldDirectoryType,
+	FieldURL,
+	FieldStartTLS,
+	FieldInsecureSkipVerify,
+	FieldBindDn,
+	FieldEncryptedBindPassword,
+	FieldEncryptionKeyID,
+	FieldBaseDn,
+	FieldAuthMode,
+	FieldUserDnTemplate,
+	FieldUserFilter,
+	FieldOuBaseDn,
+	FieldOuFilter,
+	FieldGroupBaseDn,
+	FieldGroupFilter,
+	FieldGroupMemberAttribute,
+	FieldAttributeMapping,
+	FieldLoginEnabled,
+	FieldJitProvision,
+	FieldLinkExisting,
+	FieldSyncEnabled,
+	FieldSyncInterval,
+	FieldDeletionPolicy,
+	FieldRootDepartmentID,
+	FieldDefaultDepartmentID,
+	FieldDefaultRoleIds,
+	FieldLastSyncAt,
+	FieldEnabled,
+	FieldSort,
+	FieldRemark,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultStatus holds the default value on creation for the "status" field.
+	DefaultStatus uint8
+	// DefaultTenantID holds the default value on creation for the "tenant_id" field.
+	DefaultTenantID uint64
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+	// DisplayNameValidator is a validator for the "display_name" field. It is called by the builders before save.
+	DisplayNameValidator func(string) error
+	// DefaultDirectoryType holds the default value on creation for the "directory_type" field.
+	DefaultDirectoryType string
+	// DirectoryTypeValidator is a validator for the "directory_type" field. It is called by the builders before save.
+	DirectoryTypeValidator func(string) error
+	// URLValidator is a validator for the "url" field. It is called by the builders before save.
+	URLValidator func(string) error
+	// DefaultStartTLS holds the default value on creation for the "start_tls" field.
+	DefaultStartTLS bool
+	// DefaultInsecureSkipVerify holds the default value on creation for the "insecure_skip_verify" field.
+	DefaultInsecureSkipVerify bool
+	// BindDnValidator is a validator for the "bind_dn" field. It is called by the builders before save.
+	BindDnValidator func(string) error
+	// EncryptionKeyIDValidator is a validator for the "encryption_key_id" field. It is called by the builders before save.
+	EncryptionKeyIDValidator func(string) error
+	// BaseDnValidator is a validator for the "base_dn" field. It is called by the builders before save.
+	BaseDnValidator func(string) error
+	// DefaultAuthMode holds the default value on creation for the "auth_mode" field.
+	DefaultAuthMode string
+	// AuthModeValidator is a validator for the "auth_mode" field. It is called by the builders before save.
+	AuthModeValidator func(string) error
+	// UserDnTemplateValidator is a validator for the "user_dn_template" field. It is called by the builders before save.
+	UserDnTemplateValidator func(string) error
+	// UserFilterValidator is a validator for the "user_filter" field. It is called by the builders before save.
+	UserFilterValidator func(string) error
+	// OuBaseDnValidator is a validator for the "ou_base_dn" field. It is called by the builders before save.
+	OuBaseDnValidator func(string) error
+	// OuFilterValidator is a validator for the "ou_filter" field. It is called by the builders before save.
+	OuFilterValidator func(string) error
+	// GroupBaseDnValidator is a validator for the "group_base_dn" field. It is called by the builders before save.
+	GroupBaseDnValidator func(string) error
+	// GroupFilterValidator is a validator for the "group_filter" field. It is called by the builders before save.
+	GroupFilterValidator func(string) error
+	// GroupMemberAttributeValidator is a validator for the "group_member_attribute" field. It is called by the builders before save.
+	GroupMemberAttributeValidator func(string) error
+	// DefaultLoginEnabled holds the default value on creation for the "login_enabled" field.
+	DefaultLoginEnabled bool
+	// DefaultJitProvision holds the default value on creation for the "jit_provision" field.
+	DefaultJitProvision bool
+	// DefaultLinkExisting holds the default value on creation for the "link_existing" field.
+	DefaultLinkExisting bool
+	// DefaultSyncEnabled holds the default value on creation for the "sync_enabled" field.
+	DefaultSyncEnabled bool
+	// DefaultSyncInterval holds the default value on creation for the "sync_interval" field.
+	DefaultSyncInterval uint32
+	// DefaultDeletionPolicy holds the default value on creation for the "deletion_policy" field.
+	DefaultDeletionPolicy string
+	// DeletionPolicyValidator is a validator for the "deletion_policy" field. It is called by the builders before save.
+	DeletionPolicyValidator func(string) error
+	// DefaultRootDepartmentID holds the default value on creation for the "root_department_id" field.
+	DefaultRootDepartmentID uint64
+	// DefaultDefaultDepartmentID holds the default value on creation for the "default_department_id" field.
+	DefaultDefaultDepartmentID uint64
+	// DefaultEnabled holds the default value on creation for the "enabled" field.
+	DefaultEnabled bool
+	// DefaultSort holds the default value on creation for the "sort" field.
+	DefaultSort uint32
+	// RemarkValidator is a validator for the "remark" field. It is called by the builders before save.
+	RemarkValidator func(string) error
+)
+
+// OrderOption defines the ordering options for the LdapProvider queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByTenantID orders the results by the tenant_id field.
+func ByTenantID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenantID, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByDisplayName orders the results by the display_name field.
+func ByDisplayName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDisplayName, opts...).ToFunc()
+}
+
+// ByDirectoryType orders the results by the directory_type field.
+func ByDirectoryType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDirectoryType, opts...).ToFunc()
+}
+
+// ByURL orders the results by the url field.
+func ByURL(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldURL, opts...).ToFunc()
+}
+
+// ByStartTLS orders the results by the start_tls field.
+func ByStartTLS(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStartTLS, opts...).ToFunc()
+}
+
+// ByInsecureSkipVerify orders the results by the insecure_skip_verify field.
+func ByInsecureSkipVerify(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldInsecureSkipVerify, opts...).ToFunc()
+}
+
+// ByBindDn orders the results by the bind_dn field.
+func ByBindDn(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBindDn, opts...).ToFunc()
+}
+
+// ByEncryptedBindPassword orders the results by the encrypted_bind_password field.
+func ByEncryptedBindPassword(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEncryptedBindPassword, opts...).ToFunc()
+}
+
+// ByEncryptionKeyID orders the results by the encryption_key_id field.
+func ByEncryptionKeyID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEncryptionKeyID, opts...).ToFunc()
+}
+
+// ByBaseDn orders the results by the base_dn field.
+func ByBaseDn(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBaseDn, opts...).ToFunc()
+}
+
+// ByAuthMode orders the results by the auth_mode field.
+func ByAuthMode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAuthMode, opts...).ToFunc()
+}
+
+// ByUserDnTemplate orders the results by the user_dn_template field.
+func ByUserDnTemplate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserDnTemplate, opts...).ToFunc()
+}
+
+// ByUserFilter orders the results by the user_filter field.
+func ByUserFilter(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserFilter, opts...).ToFunc()
+}
+
+// ByOuBaseDn orders the results by the ou_base_dn field.
+func ByOuBaseDn(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOuBaseDn, opts...).ToFunc()
+}
+
+// ByOuFilter orders the results by the ou_filter field.
+func ByOuFilter(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOuFilter, opts...).ToFunc()
+}
+
+// ByGroupBaseDn orders the results by the group_base_dn field.
+func ByGroupBaseDn(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGroupBaseDn, opts...).ToFunc()
+}
+
+// ByGroupFilter orders the results by the group_filter field.
+func ByGroupFilter(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGroupFilter, opts...).ToFunc()
+}
+
+// ByGroupMemberAttribute orders the results by the group_member_attribute field.
+func ByGroupMemberAttribute(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGroupMemberAttribute, opts...).ToFunc()
+}
+
+// ByLoginEnabled orders the results by the login_enabled field.
+func ByLoginEnabled(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLoginEnabled, opts...).ToFunc()
+}
+
+// ByJitProvision orders the results by the jit_provision field.
+func ByJitProvision(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldJitProvision, opts...).ToFunc()
+}
+
+// ByLinkExisting orders the results by the link_existing field.
+func ByLinkExisting(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLinkExisting, opts...).ToFunc()
+}
+
+// BySyncEnabled orders the results by the sync_enabled field.
+func BySyncEnabled(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSyncEnabled, opts...).ToFunc()
+}
+
+// BySyncInterval orders the results by the sync_interval field.
+func BySyncInterval(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSyncInterval, opts...).ToFunc()
+}
+
+// ByDeletionPolicy orders the results by the deletion_policy field.
+func ByDeletionPolicy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletionPolicy, opts...).ToFunc()
+}
+
+// ByRootDepartmentID orders the results by the root_department_id field.
+func ByRootDepartmentID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRootDepartmentID, opts...).ToFunc()
+}
+
+// ByDefaultDepartmentID orders the results by the default_department_id field.
+func ByDefaultDepartmentID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDefaultDepartmentID, opts...).ToFunc()
+}
+
+// ByLastSyncAt orders the results by the last_sync_at field.
+func ByLastSyncAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastSyncAt, opts...).ToFunc()
+}
+
+// ByEnabled orders the results by the enabled field.
+func ByEnabled(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEnabled, opts...).ToFunc()
+}
+
+// BySort orders the results by the sort field.
+func BySort(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSort, opts...).ToFunc()
+}
+
+// ByRemark orders the results by the remark field.
+func ByRemark(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRemark, opts...).ToFunc()
+}
+
+// ByLdapAccountsCount orders the results by ldap_accounts count.
+func ByLdapAccountsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newLdapAccountsStep(), opts...)
+	}
+}
+
+// ByLdapAccounts orders the results by ldap_accounts terms.
+func ByLdapAccounts(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newLdapAccountsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByLdapDepartmentsCount orders the results by ldap_departments count.
+func ByLdapDepartmentsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newLdapDepartmentsStep(), opts...)
+	}
+}
+
+// ByLdapDepartments orders the results by ldap_departments terms.
+func ByLdapDepartments(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newLdapDepartmentsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// BySyncRunsCount orders the results by sync_runs count.
+func BySyncRunsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSyncRunsStep(), opts...)
+	}
+}
+
+// BySyncRuns orders the results by sync_runs terms.
+func BySyncRuns(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSyncRunsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newLdapAccountsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(LdapAccountsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, LdapAccountsTable, LdapAccountsColumn),
+	)
+}
+func newLdapDepartmentsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(LdapDepartmentsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, LdapDepartmentsTable, LdapDepartmentsColumn),
+	)
+}
+func newSyncRunsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SyncRunsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, SyncRunsTable, SyncRunsColumn),
+	)
+}
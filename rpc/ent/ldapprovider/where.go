@@ -0,0 +1,2090 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ldapprovider
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/coder-lulu/newbee-core/rpc/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uint64) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uint64) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uint64) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uint64) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uint64) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uint64) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uint64) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uint64) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uint64) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v uint8) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldEQ(FieldStatus, v))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v uint64) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldEQ(FieldTenantID, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldEQ(FieldName, v))
+}
+
+// DisplayName applies equality check predicate on the "display_name" field. It's identical to DisplayNameEQ.
+func DisplayName(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldEQ(FieldDisplayName, v))
+}
+
+// DirectoryType applies equality check predicate on the "directory_type" field. It's identical to DirectoryTypeEQ.
+func DirectoryType(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldEQ(FieldDirectoryType, v))
+}
+
+// URL applies equality check predicate on the "url" field. It's identical to URLEQ.
+func URL(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldEQ(FieldURL, v))
+}
+
+// StartTLS applies equality check predicate on the "start_tls" field. It's identical to StartTLSEQ.
+func StartTLS(v bool) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldEQ(FieldStartTLS, v))
+}
+
+// InsecureSkipVerify applies equality check predicate on the "insecure_skip_verify" field. It's identical to InsecureSkipVerifyEQ.
+func InsecureSkipVerify(v bool) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldEQ(FieldInsecureSkipVerify, v))
+}
+
+// BindDn applies equality check predicate on the "bind_dn" field. It's identical to BindDnEQ.
+func BindDn(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldEQ(FieldBindDn, v))
+}
+
+// EncryptedBindPassword applies equality check predicate on the "encrypted_bind_password" field. It's identical to EncryptedBindPasswordEQ.
+func EncryptedBindPassword(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldEQ(FieldEncryptedBindPassword, v))
+}
+
+// EncryptionKeyID applies equality check predicate on the "encryption_key_id" field. It's identical to EncryptionKeyIDEQ.
+func EncryptionKeyID(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldEQ(FieldEncryptionKeyID, v))
+}
+
+// BaseDn applies equality check predicate on the "base_dn" field. It's identical to BaseDnEQ.
+func BaseDn(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldEQ(FieldBaseDn, v))
+}
+
+// AuthMode applies equality check predicate on the "auth_mode" field. It's identical to AuthModeEQ.
+func AuthMode(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldEQ(FieldAuthMode, v))
+}
+
+// UserDnTemplate applies equality check predicate on the "user_dn_template" field. It's identical to UserDnTemplateEQ.
+func UserDnTemplate(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldEQ(FieldUserDnTemplate, v))
+}
+
+// UserFilter applies equality check predicate on the "user_filter" field. It's identical to UserFilterEQ.
+func UserFilter(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldEQ(FieldUserFilter, v))
+}
+
+// OuBaseDn applies equality check predicate on the "ou_base_dn" field. It's identical to OuBaseDnEQ.
+func OuBaseDn(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldEQ(FieldOuBaseDn, v))
+}
+
+// OuFilter applies equality check predicate on the "ou_filter" field. It's identical to OuFilterEQ.
+func OuFilter(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldEQ(FieldOuFilter, v))
+}
+
+// GroupBaseDn applies equality check predicate on the "group_base_dn" field. It's identical to GroupBaseDnEQ.
+func GroupBaseDn(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldEQ(FieldGroupBaseDn, v))
+}
+
+// GroupFilter applies equality check predicate on the "group_filter" field. It's identical to GroupFilterEQ.
+func GroupFilter(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldEQ(FieldGroupFilter, v))
+}
+
+// GroupMemberAttribute applies equality check predicate on the "group_member_attribute" field. It's identical to GroupMemberAttributeEQ.
+func GroupMemberAttribute(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldEQ(FieldGroupMemberAttribute, v))
+}
+
+// LoginEnabled applies equality check predicate on the "login_enabled" field. It's identical to LoginEnabledEQ.
+func LoginEnabled(v bool) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldEQ(FieldLoginEnabled, v))
+}
+
+// JitProvision applies equality check predicate on the "jit_provision" field. It's identical to JitProvisionEQ.
+func JitProvision(v bool) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldEQ(FieldJitProvision, v))
+}
+
+// LinkExisting applies equality check predicate on the "link_existing" field. It's identical to LinkExistingEQ.
+func LinkExisting(v bool) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldEQ(FieldLinkExisting, v))
+}
+
+// SyncEnabled applies equality check predicate on the "sync_enabled" field. It's identical to SyncEnabledEQ.
+func SyncEnabled(v bool) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldEQ(FieldSyncEnabled, v))
+}
+
+// SyncInterval applies equality check predicate on the "sync_interval" field. It's identical to SyncIntervalEQ.
+func SyncInterval(v uint32) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldEQ(FieldSyncInterval, v))
+}
+
+// DeletionPolicy applies equality check predicate on the "deletion_policy" field. It's identical to DeletionPolicyEQ.
+func DeletionPolicy(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldEQ(FieldDeletionPolicy, v))
+}
+
+// RootDepartmentID applies equality check predicate on the "root_department_id" field. It's identical to RootDepartmentIDEQ.
+func RootDepartmentID(v uint64) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldEQ(FieldRootDepartmentID, v))
+}
+
+// DefaultDepartmentID applies equality check predicate on the "default_department_id" field. It's identical to DefaultDepartmentIDEQ.
+func DefaultDepartmentID(v uint64) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldEQ(FieldDefaultDepartmentID, v))
+}
+
+// LastSyncAt applies equality check predicate on the "last_sync_at" field. It's identical to LastSyncAtEQ.
+func LastSyncAt(v time.Time) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldEQ(FieldLastSyncAt, v))
+}
+
+// Enabled applies equality check predicate on the "enabled" field. It's identical to EnabledEQ.
+func Enabled(v bool) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldEQ(FieldEnabled, v))
+}
+
+// Sort applies equality check predicate on the "sort" field. It's identical to SortEQ.
+func Sort(v uint32) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldEQ(FieldSort, v))
+}
+
+// Remark applies equality check predicate on the "remark" field. It's identical to RemarkEQ.
+func Remark(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldEQ(FieldRemark, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v uint8) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v uint8) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...uint8) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...uint8) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v uint8) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v uint8) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v uint8) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v uint8) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusIsNil applies the IsNil predicate on the "status" field.
+func StatusIsNil() predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldIsNull(FieldStatus))
+}
+
+// StatusNotNil applies the NotNil predicate on the "status" field.
+func StatusNotNil() predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldNotNull(FieldStatus))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v uint64) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v uint64) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...uint64) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...uint64) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v uint64) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v uint64) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v uint64) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v uint64) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldLTE(FieldTenantID, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldContainsFold(FieldName, v))
+}
+
+// DisplayNameEQ applies the EQ predicate on the "display_name" field.
+func DisplayNameEQ(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldEQ(FieldDisplayName, v))
+}
+
+// DisplayNameNEQ applies the NEQ predicate on the "display_name" field.
+func DisplayNameNEQ(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldNEQ(FieldDisplayName, v))
+}
+
+// DisplayNameIn applies the In predicate on the "display_name" field.
+func DisplayNameIn(vs ...string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldIn(FieldDisplayName, vs...))
+}
+
+// DisplayNameNotIn applies the NotIn predicate on the "display_name" field.
+func DisplayNameNotIn(vs ...string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldNotIn(FieldDisplayName, vs...))
+}
+
+// DisplayNameGT applies the GT predicate on the "display_name" field.
+func DisplayNameGT(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldGT(FieldDisplayName, v))
+}
+
+// DisplayNameGTE applies the GTE predicate on the "display_name" field.
+func DisplayNameGTE(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldGTE(FieldDisplayName, v))
+}
+
+// DisplayNameLT applies the LT predicate on the "display_name" field.
+func DisplayNameLT(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldLT(FieldDisplayName, v))
+}
+
+// DisplayNameLTE applies the LTE predicate on the "display_name" field.
+func DisplayNameLTE(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldLTE(FieldDisplayName, v))
+}
+
+// DisplayNameContains applies the Contains predicate on the "display_name" field.
+func DisplayNameContains(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldContains(FieldDisplayName, v))
+}
+
+// DisplayNameHasPrefix applies the HasPrefix predicate on the "display_name" field.
+func DisplayNameHasPrefix(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldHasPrefix(FieldDisplayName, v))
+}
+
+// DisplayNameHasSuffix applies the HasSuffix predicate on the "display_name" field.
+func DisplayNameHasSuffix(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldHasSuffix(FieldDisplayName, v))
+}
+
+// DisplayNameIsNil applies the IsNil predicate on the "display_name" field.
+func DisplayNameIsNil() predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldIsNull(FieldDisplayName))
+}
+
+// DisplayNameNotNil applies the NotNil predicate on the "display_name" field.
+func DisplayNameNotNil() predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldNotNull(FieldDisplayName))
+}
+
+// DisplayNameEqualFold applies the EqualFold predicate on the "display_name" field.
+func DisplayNameEqualFold(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldEqualFold(FieldDisplayName, v))
+}
+
+// DisplayNameContainsFold applies the ContainsFold predicate on the "display_name" field.
+func DisplayNameContainsFold(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldContainsFold(FieldDisplayName, v))
+}
+
+// DirectoryTypeEQ applies the EQ predicate on the "directory_type" field.
+func DirectoryTypeEQ(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldEQ(FieldDirectoryType, v))
+}
+
+// DirectoryTypeNEQ applies the NEQ predicate on the "directory_type" field.
+func DirectoryTypeNEQ(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldNEQ(FieldDirectoryType, v))
+}
+
+// DirectoryTypeIn applies the In predicate on the "directory_type" field.
+func DirectoryTypeIn(vs ...string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldIn(FieldDirectoryType, vs...))
+}
+
+// DirectoryTypeNotIn applies the NotIn predicate on the "directory_type" field.
+func DirectoryTypeNotIn(vs ...string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldNotIn(FieldDirectoryType, vs...))
+}
+
+// DirectoryTypeGT applies the GT predicate on the "directory_type" field.
+func DirectoryTypeGT(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldGT(FieldDirectoryType, v))
+}
+
+// DirectoryTypeGTE applies the GTE predicate on the "directory_type" field.
+func DirectoryTypeGTE(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldGTE(FieldDirectoryType, v))
+}
+
+// DirectoryTypeLT applies the LT predicate on the "directory_type" field.
+func DirectoryTypeLT(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldLT(FieldDirectoryType, v))
+}
+
+// DirectoryTypeLTE applies the LTE predicate on the "directory_type" field.
+func DirectoryTypeLTE(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldLTE(FieldDirectoryType, v))
+}
+
+// DirectoryTypeContains applies the Contains predicate on the "directory_type" field.
+func DirectoryTypeContains(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldContains(FieldDirectoryType, v))
+}
+
+// DirectoryTypeHasPrefix applies the HasPrefix predicate on the "directory_type" field.
+func DirectoryTypeHasPrefix(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldHasPrefix(FieldDirectoryType, v))
+}
+
+// DirectoryTypeHasSuffix applies the HasSuffix predicate on the "directory_type" field.
+func DirectoryTypeHasSuffix(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldHasSuffix(FieldDirectoryType, v))
+}
+
+// DirectoryTypeEqualFold applies the EqualFold predicate on the "directory_type" field.
+func DirectoryTypeEqualFold(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldEqualFold(FieldDirectoryType, v))
+}
+
+// DirectoryTypeContainsFold applies the ContainsFold predicate on the "directory_type" field.
+func DirectoryTypeContainsFold(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldContainsFold(FieldDirectoryType, v))
+}
+
+// URLEQ applies the EQ predicate on the "url" field.
+func URLEQ(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldEQ(FieldURL, v))
+}
+
+// URLNEQ applies the NEQ predicate on the "url" field.
+func URLNEQ(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldNEQ(FieldURL, v))
+}
+
+// URLIn applies the In predicate on the "url" field.
+func URLIn(vs ...string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldIn(FieldURL, vs...))
+}
+
+// URLNotIn applies the NotIn predicate on the "url" field.
+func URLNotIn(vs ...string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldNotIn(FieldURL, vs...))
+}
+
+// URLGT applies the GT predicate on the "url" field.
+func URLGT(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldGT(FieldURL, v))
+}
+
+// URLGTE applies the GTE predicate on the "url" field.
+func URLGTE(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldGTE(FieldURL, v))
+}
+
+// URLLT applies the LT predicate on the "url" field.
+func URLLT(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldLT(FieldURL, v))
+}
+
+// URLLTE applies the LTE predicate on the "url" field.
+func URLLTE(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldLTE(FieldURL, v))
+}
+
+// URLContains applies the Contains predicate on the "url" field.
+func URLContains(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldContains(FieldURL, v))
+}
+
+// URLHasPrefix applies the HasPrefix predicate on the "url" field.
+func URLHasPrefix(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldHasPrefix(FieldURL, v))
+}
+
+// URLHasSuffix applies the HasSuffix predicate on the "url" field.
+func URLHasSuffix(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldHasSuffix(FieldURL, v))
+}
+
+// URLEqualFold applies the EqualFold predicate on the "url" field.
+func URLEqualFold(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldEqualFold(FieldURL, v))
+}
+
+// URLContainsFold applies the ContainsFold predicate on the "url" field.
+func URLContainsFold(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldContainsFold(FieldURL, v))
+}
+
+// StartTLSEQ applies the EQ predicate on the "start_tls" field.
+func StartTLSEQ(v bool) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldEQ(FieldStartTLS, v))
+}
+
+// StartTLSNEQ applies the NEQ predicate on the "start_tls" field.
+func StartTLSNEQ(v bool) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldNEQ(FieldStartTLS, v))
+}
+
+// InsecureSkipVerifyEQ applies the EQ predicate on the "insecure_skip_verify" field.
+func InsecureSkipVerifyEQ(v bool) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldEQ(FieldInsecureSkipVerify, v))
+}
+
+// InsecureSkipVerifyNEQ applies the NEQ predicate on the "insecure_skip_verify" field.
+func InsecureSkipVerifyNEQ(v bool) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldNEQ(FieldInsecureSkipVerify, v))
+}
+
+// BindDnEQ applies the EQ predicate on the "bind_dn" field.
+func BindDnEQ(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldEQ(FieldBindDn, v))
+}
+
+// BindDnNEQ applies the NEQ predicate on the "bind_dn" field.
+func BindDnNEQ(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldNEQ(FieldBindDn, v))
+}
+
+// BindDnIn applies the In predicate on the "bind_dn" field.
+func BindDnIn(vs ...string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldIn(FieldBindDn, vs...))
+}
+
+// BindDnNotIn applies the NotIn predicate on the "bind_dn" field.
+func BindDnNotIn(vs ...string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldNotIn(FieldBindDn, vs...))
+}
+
+// BindDnGT applies the GT predicate on the "bind_dn" field.
+func BindDnGT(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldGT(FieldBindDn, v))
+}
+
+// BindDnGTE applies the GTE predicate on the "bind_dn" field.
+func BindDnGTE(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldGTE(FieldBindDn, v))
+}
+
+// BindDnLT applies the LT predicate on the "bind_dn" field.
+func BindDnLT(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldLT(FieldBindDn, v))
+}
+
+// BindDnLTE applies the LTE predicate on the "bind_dn" field.
+func BindDnLTE(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldLTE(FieldBindDn, v))
+}
+
+// BindDnContains applies the Contains predicate on the "bind_dn" field.
+func BindDnContains(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldContains(FieldBindDn, v))
+}
+
+// BindDnHasPrefix applies the HasPrefix predicate on the "bind_dn" field.
+func BindDnHasPrefix(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldHasPrefix(FieldBindDn, v))
+}
+
+// BindDnHasSuffix applies the HasSuffix predicate on the "bind_dn" field.
+func BindDnHasSuffix(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldHasSuffix(FieldBindDn, v))
+}
+
+// BindDnIsNil applies the IsNil predicate on the "bind_dn" field.
+func BindDnIsNil() predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldIsNull(FieldBindDn))
+}
+
+// BindDnNotNil applies the NotNil predicate on the "bind_dn" field.
+func BindDnNotNil() predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldNotNull(FieldBindDn))
+}
+
+// BindDnEqualFold applies the EqualFold predicate on the "bind_dn" field.
+func BindDnEqualFold(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldEqualFold(FieldBindDn, v))
+}
+
+// BindDnContainsFold applies the ContainsFold predicate on the "bind_dn" field.
+func BindDnContainsFold(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldContainsFold(FieldBindDn, v))
+}
+
+// EncryptedBindPasswordEQ applies the EQ predicate on the "encrypted_bind_password" field.
+func EncryptedBindPasswordEQ(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldEQ(FieldEncryptedBindPassword, v))
+}
+
+// EncryptedBindPasswordNEQ applies the NEQ predicate on the "encrypted_bind_password" field.
+func EncryptedBindPasswordNEQ(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldNEQ(FieldEncryptedBindPassword, v))
+}
+
+// EncryptedBindPasswordIn applies the In predicate on the "encrypted_bind_password" field.
+func EncryptedBindPasswordIn(vs ...string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldIn(FieldEncryptedBindPassword, vs...))
+}
+
+// EncryptedBindPasswordNotIn applies the NotIn predicate on the "encrypted_bind_password" field.
+func EncryptedBindPasswordNotIn(vs ...string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldNotIn(FieldEncryptedBindPassword, vs...))
+}
+
+// EncryptedBindPasswordGT applies the GT predicate on the "encrypted_bind_password" field.
+func EncryptedBindPasswordGT(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldGT(FieldEncryptedBindPassword, v))
+}
+
+// EncryptedBindPasswordGTE applies the GTE predicate on the "encrypted_bind_password" field.
+func EncryptedBindPasswordGTE(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldGTE(FieldEncryptedBindPassword, v))
+}
+
+// EncryptedBindPasswordLT applies the LT predicate on the "encrypted_bind_password" field.
+func EncryptedBindPasswordLT(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldLT(FieldEncryptedBindPassword, v))
+}
+
+// EncryptedBindPasswordLTE applies the LTE predicate on the "encrypted_bind_password" field.
+func EncryptedBindPasswordLTE(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldLTE(FieldEncryptedBindPassword, v))
+}
+
+// EncryptedBindPasswordContains applies the Contains predicate on the "encrypted_bind_password" field.
+func EncryptedBindPasswordContains(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldContains(FieldEncryptedBindPassword, v))
+}
+
+// EncryptedBindPasswordHasPrefix applies the HasPrefix predicate on the "encrypted_bind_password" field.
+func EncryptedBindPasswordHasPrefix(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldHasPrefix(FieldEncryptedBindPassword, v))
+}
+
+// EncryptedBindPasswordHasSuffix applies the HasSuffix predicate on the "encrypted_bind_password" field.
+func EncryptedBindPasswordHasSuffix(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldHasSuffix(FieldEncryptedBindPassword, v))
+}
+
+// EncryptedBindPasswordIsNil applies the IsNil predicate on the "encrypted_bind_password" field.
+func EncryptedBindPasswordIsNil() predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldIsNull(FieldEncryptedBindPassword))
+}
+
+// EncryptedBindPasswordNotNil applies the NotNil predicate on the "encrypted_bind_password" field.
+func EncryptedBindPasswordNotNil() predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldNotNull(FieldEncryptedBindPassword))
+}
+
+// EncryptedBindPasswordEqualFold applies the EqualFold predicate on the "encrypted_bind_password" field.
+func EncryptedBindPasswordEqualFold(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldEqualFold(FieldEncryptedBindPassword, v))
+}
+
+// EncryptedBindPasswordContainsFold applies the ContainsFold predicate on the "encrypted_bind_password" field.
+func EncryptedBindPasswordContainsFold(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldContainsFold(FieldEncryptedBindPassword, v))
+}
+
+// EncryptionKeyIDEQ applies the EQ predicate on the "encryption_key_id" field.
+func EncryptionKeyIDEQ(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldEQ(FieldEncryptionKeyID, v))
+}
+
+// EncryptionKeyIDNEQ applies the NEQ predicate on the "encryption_key_id" field.
+func EncryptionKeyIDNEQ(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldNEQ(FieldEncryptionKeyID, v))
+}
+
+// EncryptionKeyIDIn applies the In predicate on the "encryption_key_id" field.
+func EncryptionKeyIDIn(vs ...string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldIn(FieldEncryptionKeyID, vs...))
+}
+
+// EncryptionKeyIDNotIn applies the NotIn predicate on the "encryption_key_id" field.
+func EncryptionKeyIDNotIn(vs ...string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldNotIn(FieldEncryptionKeyID, vs...))
+}
+
+// EncryptionKeyIDGT applies the GT predicate on the "encryption_key_id" field.
+func EncryptionKeyIDGT(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldGT(FieldEncryptionKeyID, v))
+}
+
+// EncryptionKeyIDGTE applies the GTE predicate on the "encryption_key_id" field.
+func EncryptionKeyIDGTE(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldGTE(FieldEncryptionKeyID, v))
+}
+
+// EncryptionKeyIDLT applies the LT predicate on the "encryption_key_id" field.
+func EncryptionKeyIDLT(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldLT(FieldEncryptionKeyID, v))
+}
+
+// EncryptionKeyIDLTE applies the LTE predicate on the "encryption_key_id" field.
+func EncryptionKeyIDLTE(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldLTE(FieldEncryptionKeyID, v))
+}
+
+// EncryptionKeyIDContains applies the Contains predicate on the "encryption_key_id" field.
+func EncryptionKeyIDContains(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldContains(FieldEncryptionKeyID, v))
+}
+
+// EncryptionKeyIDHasPrefix applies the HasPrefix predicate on the "encryption_key_id" field.
+func EncryptionKeyIDHasPrefix(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldHasPrefix(FieldEncryptionKeyID, v))
+}
+
+// EncryptionKeyIDHasSuffix applies the HasSuffix predicate on the "encryption_key_id" field.
+func EncryptionKeyIDHasSuffix(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldHasSuffix(FieldEncryptionKeyID, v))
+}
+
+// EncryptionKeyIDIsNil applies the IsNil predicate on the "encryption_key_id" field.
+func EncryptionKeyIDIsNil() predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldIsNull(FieldEncryptionKeyID))
+}
+
+// EncryptionKeyIDNotNil applies the NotNil predicate on the "encryption_key_id" field.
+func EncryptionKeyIDNotNil() predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldNotNull(FieldEncryptionKeyID))
+}
+
+// EncryptionKeyIDEqualFold applies the EqualFold predicate on the "encryption_key_id" field.
+func EncryptionKeyIDEqualFold(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldEqualFold(FieldEncryptionKeyID, v))
+}
+
+// EncryptionKeyIDContainsFold applies the ContainsFold predicate on the "encryption_key_id" field.
+func EncryptionKeyIDContainsFold(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldContainsFold(FieldEncryptionKeyID, v))
+}
+
+// BaseDnEQ applies the EQ predicate on the "base_dn" field.
+func BaseDnEQ(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldEQ(FieldBaseDn, v))
+}
+
+// BaseDnNEQ applies the NEQ predicate on the "base_dn" field.
+func BaseDnNEQ(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldNEQ(FieldBaseDn, v))
+}
+
+// BaseDnIn applies the In predicate on the "base_dn" field.
+func BaseDnIn(vs ...string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldIn(FieldBaseDn, vs...))
+}
+
+// BaseDnNotIn applies the NotIn predicate on the "base_dn" field.
+func BaseDnNotIn(vs ...string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldNotIn(FieldBaseDn, vs...))
+}
+
+// BaseDnGT applies the GT predicate on the "base_dn" field.
+func BaseDnGT(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldGT(FieldBaseDn, v))
+}
+
+// BaseDnGTE applies the GTE predicate on the "base_dn" field.
+func BaseDnGTE(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldGTE(FieldBaseDn, v))
+}
+
+// BaseDnLT applies the LT predicate on the "base_dn" field.
+func BaseDnLT(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldLT(FieldBaseDn, v))
+}
+
+// BaseDnLTE applies the LTE predicate on the "base_dn" field.
+func BaseDnLTE(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldLTE(FieldBaseDn, v))
+}
+
+// BaseDnContains applies the Contains predicate on the "base_dn" field.
+func BaseDnContains(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldContains(FieldBaseDn, v))
+}
+
+// BaseDnHasPrefix applies the HasPrefix predicate on the "base_dn" field.
+func BaseDnHasPrefix(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldHasPrefix(FieldBaseDn, v))
+}
+
+// BaseDnHasSuffix applies the HasSuffix predicate on the "base_dn" field.
+func BaseDnHasSuffix(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldHasSuffix(FieldBaseDn, v))
+}
+
+// BaseDnEqualFold applies the EqualFold predicate on the "base_dn" field.
+func BaseDnEqualFold(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldEqualFold(FieldBaseDn, v))
+}
+
+// BaseDnContainsFold applies the ContainsFold predicate on the "base_dn" field.
+func BaseDnContainsFold(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldContainsFold(FieldBaseDn, v))
+}
+
+// AuthModeEQ applies the EQ predicate on the "auth_mode" field.
+func AuthModeEQ(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldEQ(FieldAuthMode, v))
+}
+
+// AuthModeNEQ applies the NEQ predicate on the "auth_mode" field.
+func AuthModeNEQ(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldNEQ(FieldAuthMode, v))
+}
+
+// AuthModeIn applies the In predicate on the "auth_mode" field.
+func AuthModeIn(vs ...string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldIn(FieldAuthMode, vs...))
+}
+
+// AuthModeNotIn applies the NotIn predicate on the "auth_mode" field.
+func AuthModeNotIn(vs ...string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldNotIn(FieldAuthMode, vs...))
+}
+
+// AuthModeGT applies the GT predicate on the "auth_mode" field.
+func AuthModeGT(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldGT(FieldAuthMode, v))
+}
+
+// AuthModeGTE applies the GTE predicate on the "auth_mode" field.
+func AuthModeGTE(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldGTE(FieldAuthMode, v))
+}
+
+// AuthModeLT applies the LT predicate on the "auth_mode" field.
+func AuthModeLT(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldLT(FieldAuthMode, v))
+}
+
+// AuthModeLTE applies the LTE predicate on the "auth_mode" field.
+func AuthModeLTE(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldLTE(FieldAuthMode, v))
+}
+
+// AuthModeContains applies the Contains predicate on the "auth_mode" field.
+func AuthModeContains(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldContains(FieldAuthMode, v))
+}
+
+// AuthModeHasPrefix applies the HasPrefix predicate on the "auth_mode" field.
+func AuthModeHasPrefix(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldHasPrefix(FieldAuthMode, v))
+}
+
+// AuthModeHasSuffix applies the HasSuffix predicate on the "auth_mode" field.
+func AuthModeHasSuffix(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldHasSuffix(FieldAuthMode, v))
+}
+
+// AuthModeEqualFold applies the EqualFold predicate on the "auth_mode" field.
+func AuthModeEqualFold(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldEqualFold(FieldAuthMode, v))
+}
+
+// AuthModeContainsFold applies the ContainsFold predicate on the "auth_mode" field.
+func AuthModeContainsFold(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldContainsFold(FieldAuthMode, v))
+}
+
+// UserDnTemplateEQ applies the EQ predicate on the "user_dn_template" field.
+func UserDnTemplateEQ(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldEQ(FieldUserDnTemplate, v))
+}
+
+// UserDnTemplateNEQ applies the NEQ predicate on the "user_dn_template" field.
+func UserDnTemplateNEQ(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldNEQ(FieldUserDnTemplate, v))
+}
+
+// UserDnTemplateIn applies the In predicate on the "user_dn_template" field.
+func UserDnTemplateIn(vs ...string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldIn(FieldUserDnTemplate, vs...))
+}
+
+// UserDnTemplateNotIn applies the NotIn predicate on the "user_dn_template" field.
+func UserDnTemplateNotIn(vs ...string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldNotIn(FieldUserDnTemplate, vs...))
+}
+
+// UserDnTemplateGT applies the GT predicate on the "user_dn_template" field.
+func UserDnTemplateGT(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldGT(FieldUserDnTemplate, v))
+}
+
+// UserDnTemplateGTE applies the GTE predicate on the "user_dn_template" field.
+func UserDnTemplateGTE(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldGTE(FieldUserDnTemplate, v))
+}
+
+// UserDnTemplateLT applies the LT predicate on the "user_dn_template" field.
+func UserDnTemplateLT(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldLT(FieldUserDnTemplate, v))
+}
+
+// UserDnTemplateLTE applies the LTE predicate on the "user_dn_template" field.
+func UserDnTemplateLTE(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldLTE(FieldUserDnTemplate, v))
+}
+
+// UserDnTemplateContains applies the Contains predicate on the "user_dn_template" field.
+func UserDnTemplateContains(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldContains(FieldUserDnTemplate, v))
+}
+
+// UserDnTemplateHasPrefix applies the HasPrefix predicate on the "user_dn_template" field.
+func UserDnTemplateHasPrefix(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldHasPrefix(FieldUserDnTemplate, v))
+}
+
+// UserDnTemplateHasSuffix applies the HasSuffix predicate on the "user_dn_template" field.
+func UserDnTemplateHasSuffix(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldHasSuffix(FieldUserDnTemplate, v))
+}
+
+// UserDnTemplateIsNil applies the IsNil predicate on the "user_dn_template" field.
+func UserDnTemplateIsNil() predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldIsNull(FieldUserDnTemplate))
+}
+
+// UserDnTemplateNotNil applies the NotNil predicate on the "user_dn_template" field.
+func UserDnTemplateNotNil() predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldNotNull(FieldUserDnTemplate))
+}
+
+// UserDnTemplateEqualFold applies the EqualFold predicate on the "user_dn_template" field.
+func UserDnTemplateEqualFold(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldEqualFold(FieldUserDnTemplate, v))
+}
+
+// UserDnTemplateContainsFold applies the ContainsFold predicate on the "user_dn_template" field.
+func UserDnTemplateContainsFold(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldContainsFold(FieldUserDnTemplate, v))
+}
+
+// UserFilterEQ applies the EQ predicate on the "user_filter" field.
+func UserFilterEQ(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldEQ(FieldUserFilter, v))
+}
+
+// UserFilterNEQ applies the NEQ predicate on the "user_filter" field.
+func UserFilterNEQ(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldNEQ(FieldUserFilter, v))
+}
+
+// UserFilterIn applies the In predicate on the "user_filter" field.
+func UserFilterIn(vs ...string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldIn(FieldUserFilter, vs...))
+}
+
+// UserFilterNotIn applies the NotIn predicate on the "user_filter" field.
+func UserFilterNotIn(vs ...string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldNotIn(FieldUserFilter, vs...))
+}
+
+// UserFilterGT applies the GT predicate on the "user_filter" field.
+func UserFilterGT(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldGT(FieldUserFilter, v))
+}
+
+// UserFilterGTE applies the GTE predicate on the "user_filter" field.
+func UserFilterGTE(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldGTE(FieldUserFilter, v))
+}
+
+// UserFilterLT applies the LT predicate on the "user_filter" field.
+func UserFilterLT(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldLT(FieldUserFilter, v))
+}
+
+// UserFilterLTE applies the LTE predicate on the "user_filter" field.
+func UserFilterLTE(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldLTE(FieldUserFilter, v))
+}
+
+// UserFilterContains applies the Contains predicate on the "user_filter" field.
+func UserFilterContains(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldContains(FieldUserFilter, v))
+}
+
+// UserFilterHasPrefix applies the HasPrefix predicate on the "user_filter" field.
+func UserFilterHasPrefix(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldHasPrefix(FieldUserFilter, v))
+}
+
+// UserFilterHasSuffix applies the HasSuffix predicate on the "user_filter" field.
+func UserFilterHasSuffix(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldHasSuffix(FieldUserFilter, v))
+}
+
+// UserFilterIsNil applies the IsNil predicate on the "user_filter" field.
+func UserFilterIsNil() predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldIsNull(FieldUserFilter))
+}
+
+// UserFilterNotNil applies the NotNil predicate on the "user_filter" field.
+func UserFilterNotNil() predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldNotNull(FieldUserFilter))
+}
+
+// UserFilterEqualFold applies the EqualFold predicate on the "user_filter" field.
+func UserFilterEqualFold(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldEqualFold(FieldUserFilter, v))
+}
+
+// UserFilterContainsFold applies the ContainsFold predicate on the "user_filter" field.
+func UserFilterContainsFold(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldContainsFold(FieldUserFilter, v))
+}
+
+// OuBaseDnEQ applies the EQ predicate on the "ou_base_dn" field.
+func OuBaseDnEQ(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldEQ(FieldOuBaseDn, v))
+}
+
+// OuBaseDnNEQ applies the NEQ predicate on the "ou_base_dn" field.
+func OuBaseDnNEQ(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldNEQ(FieldOuBaseDn, v))
+}
+
+// OuBaseDnIn applies the In predicate on the "ou_base_dn" field.
+func OuBaseDnIn(vs ...string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldIn(FieldOuBaseDn, vs...))
+}
+
+// OuBaseDnNotIn applies the NotIn predicate on the "ou_base_dn" field.
+func OuBaseDnNotIn(vs ...string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldNotIn(FieldOuBaseDn, vs...))
+}
+
+// OuBaseDnGT applies the GT predicate on the "ou_base_dn" field.
+func OuBaseDnGT(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldGT(FieldOuBaseDn, v))
+}
+
+// OuBaseDnGTE applies the GTE predicate on the "ou_base_dn" field.
+func OuBaseDnGTE(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldGTE(FieldOuBaseDn, v))
+}
+
+// OuBaseDnLT applies the LT predicate on the "ou_base_dn" field.
+func OuBaseDnLT(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldLT(FieldOuBaseDn, v))
+}
+
+// OuBaseDnLTE applies the LTE predicate on the "ou_base_dn" field.
+func OuBaseDnLTE(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldLTE(FieldOuBaseDn, v))
+}
+
+// OuBaseDnContains applies the Contains predicate on the "ou_base_dn" field.
+func OuBaseDnContains(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldContains(FieldOuBaseDn, v))
+}
+
+// OuBaseDnHasPrefix applies the HasPrefix predicate on the "ou_base_dn" field.
+func OuBaseDnHasPrefix(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldHasPrefix(FieldOuBaseDn, v))
+}
+
+// OuBaseDnHasSuffix applies the HasSuffix predicate on the "ou_base_dn" field.
+func OuBaseDnHasSuffix(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldHasSuffix(FieldOuBaseDn, v))
+}
+
+// OuBaseDnIsNil applies the IsNil predicate on the "ou_base_dn" field.
+func OuBaseDnIsNil() predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldIsNull(FieldOuBaseDn))
+}
+
+// OuBaseDnNotNil applies the NotNil predicate on the "ou_base_dn" field.
+func OuBaseDnNotNil() predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldNotNull(FieldOuBaseDn))
+}
+
+// OuBaseDnEqualFold applies the EqualFold predicate on the "ou_base_dn" field.
+func OuBaseDnEqualFold(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldEqualFold(FieldOuBaseDn, v))
+}
+
+// OuBaseDnContainsFold applies the ContainsFold predicate on the "ou_base_dn" field.
+func OuBaseDnContainsFold(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldContainsFold(FieldOuBaseDn, v))
+}
+
+// OuFilterEQ applies the EQ predicate on the "ou_filter" field.
+func OuFilterEQ(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldEQ(FieldOuFilter, v))
+}
+
+// OuFilterNEQ applies the NEQ predicate on the "ou_filter" field.
+func OuFilterNEQ(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldNEQ(FieldOuFilter, v))
+}
+
+// OuFilterIn applies the In predicate on the "ou_filter" field.
+func OuFilterIn(vs ...string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldIn(FieldOuFilter, vs...))
+}
+
+// OuFilterNotIn applies the NotIn predicate on the "ou_filter" field.
+func OuFilterNotIn(vs ...string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldNotIn(FieldOuFilter, vs...))
+}
+
+// OuFilterGT applies the GT predicate on the "ou_filter" field.
+func OuFilterGT(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldGT(FieldOuFilter, v))
+}
+
+// OuFilterGTE applies the GTE predicate on the "ou_filter" field.
+func OuFilterGTE(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldGTE(FieldOuFilter, v))
+}
+
+// OuFilterLT applies the LT predicate on the "ou_filter" field.
+func OuFilterLT(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldLT(FieldOuFilter, v))
+}
+
+// OuFilterLTE applies the LTE predicate on the "ou_filter" field.
+func OuFilterLTE(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldLTE(FieldOuFilter, v))
+}
+
+// OuFilterContains applies the Contains predicate on the "ou_filter" field.
+func OuFilterContains(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldContains(FieldOuFilter, v))
+}
+
+// OuFilterHasPrefix applies the HasPrefix predicate on the "ou_filter" field.
+func OuFilterHasPrefix(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldHasPrefix(FieldOuFilter, v))
+}
+
+// OuFilterHasSuffix applies the HasSuffix predicate on the "ou_filter" field.
+func OuFilterHasSuffix(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldHasSuffix(FieldOuFilter, v))
+}
+
+// OuFilterIsNil applies the IsNil predicate on the "ou_filter" field.
+func OuFilterIsNil() predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldIsNull(FieldOuFilter))
+}
+
+// OuFilterNotNil applies the NotNil predicate on the "ou_filter" field.
+func OuFilterNotNil() predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldNotNull(FieldOuFilter))
+}
+
+// OuFilterEqualFold applies the EqualFold predicate on the "ou_filter" field.
+func OuFilterEqualFold(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldEqualFold(FieldOuFilter, v))
+}
+
+// OuFilterContainsFold applies the ContainsFold predicate on the "ou_filter" field.
+func OuFilterContainsFold(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldContainsFold(FieldOuFilter, v))
+}
+
+// GroupBaseDnEQ applies the EQ predicate on the "group_base_dn" field.
+func GroupBaseDnEQ(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldEQ(FieldGroupBaseDn, v))
+}
+
+// GroupBaseDnNEQ applies the NEQ predicate on the "group_base_dn" field.
+func GroupBaseDnNEQ(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldNEQ(FieldGroupBaseDn, v))
+}
+
+// GroupBaseDnIn applies the In predicate on the "group_base_dn" field.
+func GroupBaseDnIn(vs ...string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldIn(FieldGroupBaseDn, vs...))
+}
+
+// GroupBaseDnNotIn applies the NotIn predicate on the "group_base_dn" field.
+func GroupBaseDnNotIn(vs ...string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldNotIn(FieldGroupBaseDn, vs...))
+}
+
+// GroupBaseDnGT applies the GT predicate on the "group_base_dn" field.
+func GroupBaseDnGT(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldGT(FieldGroupBaseDn, v))
+}
+
+// GroupBaseDnGTE applies the GTE predicate on the "group_base_dn" field.
+func GroupBaseDnGTE(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldGTE(FieldGroupBaseDn, v))
+}
+
+// GroupBaseDnLT applies the LT predicate on the "group_base_dn" field.
+func GroupBaseDnLT(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldLT(FieldGroupBaseDn, v))
+}
+
+// GroupBaseDnLTE applies the LTE predicate on the "group_base_dn" field.
+func GroupBaseDnLTE(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldLTE(FieldGroupBaseDn, v))
+}
+
+// GroupBaseDnContains applies the Contains predicate on the "group_base_dn" field.
+func GroupBaseDnContains(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldContains(FieldGroupBaseDn, v))
+}
+
+// GroupBaseDnHasPrefix applies the HasPrefix predicate on the "group_base_dn" field.
+func GroupBaseDnHasPrefix(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldHasPrefix(FieldGroupBaseDn, v))
+}
+
+// GroupBaseDnHasSuffix applies the HasSuffix predicate on the "group_base_dn" field.
+func GroupBaseDnHasSuffix(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldHasSuffix(FieldGroupBaseDn, v))
+}
+
+// GroupBaseDnIsNil applies the IsNil predicate on the "group_base_dn" field.
+func GroupBaseDnIsNil() predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldIsNull(FieldGroupBaseDn))
+}
+
+// GroupBaseDnNotNil applies the NotNil predicate on the "group_base_dn" field.
+func GroupBaseDnNotNil() predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldNotNull(FieldGroupBaseDn))
+}
+
+// GroupBaseDnEqualFold applies the EqualFold predicate on the "group_base_dn" field.
+func GroupBaseDnEqualFold(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldEqualFold(FieldGroupBaseDn, v))
+}
+
+// GroupBaseDnContainsFold applies the ContainsFold predicate on the "group_base_dn" field.
+func GroupBaseDnContainsFold(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldContainsFold(FieldGroupBaseDn, v))
+}
+
+// GroupFilterEQ applies the EQ predicate on the "group_filter" field.
+func GroupFilterEQ(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldEQ(FieldGroupFilter, v))
+}
+
+// GroupFilterNEQ applies the NEQ predicate on the "group_filter" field.
+func GroupFilterNEQ(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldNEQ(FieldGroupFilter, v))
+}
+
+// GroupFilterIn applies the In predicate on the "group_filter" field.
+func GroupFilterIn(vs ...string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldIn(FieldGroupFilter, vs...))
+}
+
+// GroupFilterNotIn applies the NotIn predicate on the "group_filter" field.
+func GroupFilterNotIn(vs ...string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldNotIn(FieldGroupFilter, vs...))
+}
+
+// GroupFilterGT applies the GT predicate on the "group_filter" field.
+func GroupFilterGT(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldGT(FieldGroupFilter, v))
+}
+
+// GroupFilterGTE applies the GTE predicate on the "group_filter" field.
+func GroupFilterGTE(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldGTE(FieldGroupFilter, v))
+}
+
+// GroupFilterLT applies the LT predicate on the "group_filter" field.
+func GroupFilterLT(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldLT(FieldGroupFilter, v))
+}
+
+// GroupFilterLTE applies the LTE predicate on the "group_filter" field.
+func GroupFilterLTE(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldLTE(FieldGroupFilter, v))
+}
+
+// GroupFilterContains applies the Contains predicate on the "group_filter" field.
+func GroupFilterContains(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldContains(FieldGroupFilter, v))
+}
+
+// GroupFilterHasPrefix applies the HasPrefix predicate on the "group_filter" field.
+func GroupFilterHasPrefix(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldHasPrefix(FieldGroupFilter, v))
+}
+
+// GroupFilterHasSuffix applies the HasSuffix predicate on the "group_filter" field.
+func GroupFilterHasSuffix(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldHasSuffix(FieldGroupFilter, v))
+}
+
+// GroupFilterIsNil applies the IsNil predicate on the "group_filter" field.
+func GroupFilterIsNil() predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldIsNull(FieldGroupFilter))
+}
+
+// GroupFilterNotNil applies the NotNil predicate on the "group_filter" field.
+func GroupFilterNotNil() predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldNotNull(FieldGroupFilter))
+}
+
+// GroupFilterEqualFold applies the EqualFold predicate on the "group_filter" field.
+func GroupFilterEqualFold(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldEqualFold(FieldGroupFilter, v))
+}
+
+// GroupFilterContainsFold applies the ContainsFold predicate on the "group_filter" field.
+func GroupFilterContainsFold(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldContainsFold(FieldGroupFilter, v))
+}
+
+// GroupMemberAttributeEQ applies the EQ predicate on the "group_member_attribute" field.
+func GroupMemberAttributeEQ(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldEQ(FieldGroupMemberAttribute, v))
+}
+
+// GroupMemberAttributeNEQ applies the NEQ predicate on the "group_member_attribute" field.
+func GroupMemberAttributeNEQ(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldNEQ(FieldGroupMemberAttribute, v))
+}
+
+// GroupMemberAttributeIn applies the In predicate on the "group_member_attribute" field.
+func GroupMemberAttributeIn(vs ...string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldIn(FieldGroupMemberAttribute, vs...))
+}
+
+// GroupMemberAttributeNotIn applies the NotIn predicate on the "group_member_attribute" field.
+func GroupMemberAttributeNotIn(vs ...string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldNotIn(FieldGroupMemberAttribute, vs...))
+}
+
+// GroupMemberAttributeGT applies the GT predicate on the "group_member_attribute" field.
+func GroupMemberAttributeGT(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldGT(FieldGroupMemberAttribute, v))
+}
+
+// GroupMemberAttributeGTE applies the GTE predicate on the "group_member_attribute" field.
+func GroupMemberAttributeGTE(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldGTE(FieldGroupMemberAttribute, v))
+}
+
+// GroupMemberAttributeLT applies the LT predicate on the "group_member_attribute" field.
+func GroupMemberAttributeLT(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldLT(FieldGroupMemberAttribute, v))
+}
+
+// GroupMemberAttributeLTE applies the LTE predicate on the "group_member_attribute" field.
+func GroupMemberAttributeLTE(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldLTE(FieldGroupMemberAttribute, v))
+}
+
+// GroupMemberAttributeContains applies the Contains predicate on the "group_member_attribute" field.
+func GroupMemberAttributeContains(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldContains(FieldGroupMemberAttribute, v))
+}
+
+// GroupMemberAttributeHasPrefix applies the HasPrefix predicate on the "group_member_attribute" field.
+func GroupMemberAttributeHasPrefix(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldHasPrefix(FieldGroupMemberAttribute, v))
+}
+
+// GroupMemberAttributeHasSuffix applies the HasSuffix predicate on the "group_member_attribute" field.
+func GroupMemberAttributeHasSuffix(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldHasSuffix(FieldGroupMemberAttribute, v))
+}
+
+// GroupMemberAttributeIsNil applies the IsNil predicate on the "group_member_attribute" field.
+func GroupMemberAttributeIsNil() predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldIsNull(FieldGroupMemberAttribute))
+}
+
+// GroupMemberAttributeNotNil applies the NotNil predicate on the "group_member_attribute" field.
+func GroupMemberAttributeNotNil() predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldNotNull(FieldGroupMemberAttribute))
+}
+
+// GroupMemberAttributeEqualFold applies the EqualFold predicate on the "group_member_attribute" field.
+func GroupMemberAttributeEqualFold(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldEqualFold(FieldGroupMemberAttribute, v))
+}
+
+// GroupMemberAttributeContainsFold applies the ContainsFold predicate on the "group_member_attribute" field.
+func GroupMemberAttributeContainsFold(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldContainsFold(FieldGroupMemberAttribute, v))
+}
+
+// AttributeMappingIsNil applies the IsNil predicate on the "attribute_mapping" field.
+func AttributeMappingIsNil() predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldIsNull(FieldAttributeMapping))
+}
+
+// AttributeMappingNotNil applies the NotNil predicate on the "attribute_mapping" field.
+func AttributeMappingNotNil() predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldNotNull(FieldAttributeMapping))
+}
+
+// LoginEnabledEQ applies the EQ predicate on the "login_enabled" field.
+func LoginEnabledEQ(v bool) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldEQ(FieldLoginEnabled, v))
+}
+
+// LoginEnabledNEQ applies the NEQ predicate on the "login_enabled" field.
+func LoginEnabledNEQ(v bool) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldNEQ(FieldLoginEnabled, v))
+}
+
+// JitProvisionEQ applies the EQ predicate on the "jit_provision" field.
+func JitProvisionEQ(v bool) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldEQ(FieldJitProvision, v))
+}
+
+// JitProvisionNEQ applies the NEQ predicate on the "jit_provision" field.
+func JitProvisionNEQ(v bool) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldNEQ(FieldJitProvision, v))
+}
+
+// LinkExistingEQ applies the EQ predicate on the "link_existing" field.
+func LinkExistingEQ(v bool) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldEQ(FieldLinkExisting, v))
+}
+
+// LinkExistingNEQ applies the NEQ predicate on the "link_existing" field.
+func LinkExistingNEQ(v bool) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldNEQ(FieldLinkExisting, v))
+}
+
+// SyncEnabledEQ applies the EQ predicate on the "sync_enabled" field.
+func SyncEnabledEQ(v bool) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldEQ(FieldSyncEnabled, v))
+}
+
+// SyncEnabledNEQ applies the NEQ predicate on the "sync_enabled" field.
+func SyncEnabledNEQ(v bool) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldNEQ(FieldSyncEnabled, v))
+}
+
+// SyncIntervalEQ applies the EQ predicate on the "sync_interval" field.
+func SyncIntervalEQ(v uint32) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldEQ(FieldSyncInterval, v))
+}
+
+// SyncIntervalNEQ applies the NEQ predicate on the "sync_interval" field.
+func SyncIntervalNEQ(v uint32) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldNEQ(FieldSyncInterval, v))
+}
+
+// SyncIntervalIn applies the In predicate on the "sync_interval" field.
+func SyncIntervalIn(vs ...uint32) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldIn(FieldSyncInterval, vs...))
+}
+
+// SyncIntervalNotIn applies the NotIn predicate on the "sync_interval" field.
+func SyncIntervalNotIn(vs ...uint32) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldNotIn(FieldSyncInterval, vs...))
+}
+
+// SyncIntervalGT applies the GT predicate on the "sync_interval" field.
+func SyncIntervalGT(v uint32) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldGT(FieldSyncInterval, v))
+}
+
+// SyncIntervalGTE applies the GTE predicate on the "sync_interval" field.
+func SyncIntervalGTE(v uint32) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldGTE(FieldSyncInterval, v))
+}
+
+// SyncIntervalLT applies the LT predicate on the "sync_interval" field.
+func SyncIntervalLT(v uint32) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldLT(FieldSyncInterval, v))
+}
+
+// SyncIntervalLTE applies the LTE predicate on the "sync_interval" field.
+func SyncIntervalLTE(v uint32) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldLTE(FieldSyncInterval, v))
+}
+
+// DeletionPolicyEQ applies the EQ predicate on the "deletion_policy" field.
+func DeletionPolicyEQ(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldEQ(FieldDeletionPolicy, v))
+}
+
+// DeletionPolicyNEQ applies the NEQ predicate on the "deletion_policy" field.
+func DeletionPolicyNEQ(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldNEQ(FieldDeletionPolicy, v))
+}
+
+// DeletionPolicyIn applies the In predicate on the "deletion_policy" field.
+func DeletionPolicyIn(vs ...string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldIn(FieldDeletionPolicy, vs...))
+}
+
+// DeletionPolicyNotIn applies the NotIn predicate on the "deletion_policy" field.
+func DeletionPolicyNotIn(vs ...string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldNotIn(FieldDeletionPolicy, vs...))
+}
+
+// DeletionPolicyGT applies the GT predicate on the "deletion_policy" field.
+func DeletionPolicyGT(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldGT(FieldDeletionPolicy, v))
+}
+
+// DeletionPolicyGTE applies the GTE predicate on the "deletion_policy" field.
+func DeletionPolicyGTE(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldGTE(FieldDeletionPolicy, v))
+}
+
+// DeletionPolicyLT applies the LT predicate on the "deletion_policy" field.
+func DeletionPolicyLT(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldLT(FieldDeletionPolicy, v))
+}
+
+// DeletionPolicyLTE applies the LTE predicate on the "deletion_policy" field.
+func DeletionPolicyLTE(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldLTE(FieldDeletionPolicy, v))
+}
+
+// DeletionPolicyContains applies the Contains predicate on the "deletion_policy" field.
+func DeletionPolicyContains(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldContains(FieldDeletionPolicy, v))
+}
+
+// DeletionPolicyHasPrefix applies the HasPrefix predicate on the "deletion_policy" field.
+func DeletionPolicyHasPrefix(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldHasPrefix(FieldDeletionPolicy, v))
+}
+
+// DeletionPolicyHasSuffix applies the HasSuffix predicate on the "deletion_policy" field.
+func DeletionPolicyHasSuffix(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldHasSuffix(FieldDeletionPolicy, v))
+}
+
+// DeletionPolicyEqualFold applies the EqualFold predicate on the "deletion_policy" field.
+func DeletionPolicyEqualFold(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldEqualFold(FieldDeletionPolicy, v))
+}
+
+// DeletionPolicyContainsFold applies the ContainsFold predicate on the "deletion_policy" field.
+func DeletionPolicyContainsFold(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldContainsFold(FieldDeletionPolicy, v))
+}
+
+// RootDepartmentIDEQ applies the EQ predicate on the "root_department_id" field.
+func RootDepartmentIDEQ(v uint64) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldEQ(FieldRootDepartmentID, v))
+}
+
+// RootDepartmentIDNEQ applies the NEQ predicate on the "root_department_id" field.
+func RootDepartmentIDNEQ(v uint64) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldNEQ(FieldRootDepartmentID, v))
+}
+
+// RootDepartmentIDIn applies the In predicate on the "root_department_id" field.
+func RootDepartmentIDIn(vs ...uint64) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldIn(FieldRootDepartmentID, vs...))
+}
+
+// RootDepartmentIDNotIn applies the NotIn predicate on the "root_department_id" field.
+func RootDepartmentIDNotIn(vs ...uint64) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldNotIn(FieldRootDepartmentID, vs...))
+}
+
+// RootDepartmentIDGT applies the GT predicate on the "root_department_id" field.
+func RootDepartmentIDGT(v uint64) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldGT(FieldRootDepartmentID, v))
+}
+
+// RootDepartmentIDGTE applies the GTE predicate on the "root_department_id" field.
+func RootDepartmentIDGTE(v uint64) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldGTE(FieldRootDepartmentID, v))
+}
+
+// RootDepartmentIDLT applies the LT predicate on the "root_department_id" field.
+func RootDepartmentIDLT(v uint64) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldLT(FieldRootDepartmentID, v))
+}
+
+// RootDepartmentIDLTE applies the LTE predicate on the "root_department_id" field.
+func RootDepartmentIDLTE(v uint64) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldLTE(FieldRootDepartmentID, v))
+}
+
+// RootDepartmentIDIsNil applies the IsNil predicate on the "root_department_id" field.
+func RootDepartmentIDIsNil() predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldIsNull(FieldRootDepartmentID))
+}
+
+// RootDepartmentIDNotNil applies the NotNil predicate on the "root_department_id" field.
+func RootDepartmentIDNotNil() predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldNotNull(FieldRootDepartmentID))
+}
+
+// DefaultDepartmentIDEQ applies the EQ predicate on the "default_department_id" field.
+func DefaultDepartmentIDEQ(v uint64) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldEQ(FieldDefaultDepartmentID, v))
+}
+
+// DefaultDepartmentIDNEQ applies the NEQ predicate on the "default_department_id" field.
+func DefaultDepartmentIDNEQ(v uint64) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldNEQ(FieldDefaultDepartmentID, v))
+}
+
+// DefaultDepartmentIDIn applies the In predicate on the "default_department_id" field.
+func DefaultDepartmentIDIn(vs ...uint64) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldIn(FieldDefaultDepartmentID, vs...))
+}
+
+// DefaultDepartmentIDNotIn applies the NotIn predicate on the "default_department_id" field.
+func DefaultDepartmentIDNotIn(vs ...uint64) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldNotIn(FieldDefaultDepartmentID, vs...))
+}
+
+// DefaultDepartmentIDGT applies the GT predicate on the "default_department_id" field.
+func DefaultDepartmentIDGT(v uint64) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldGT(FieldDefaultDepartmentID, v))
+}
+
+// DefaultDepartmentIDGTE applies the GTE predicate on the "default_department_id" field.
+func DefaultDepartmentIDGTE(v uint64) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldGTE(FieldDefaultDepartmentID, v))
+}
+
+// DefaultDepartmentIDLT applies the LT predicate on the "default_department_id" field.
+func DefaultDepartmentIDLT(v uint64) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldLT(FieldDefaultDepartmentID, v))
+}
+
+// DefaultDepartmentIDLTE applies the LTE predicate on the "default_department_id" field.
+func DefaultDepartmentIDLTE(v uint64) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldLTE(FieldDefaultDepartmentID, v))
+}
+
+// DefaultDepartmentIDIsNil applies the IsNil predicate on the "default_department_id" field.
+func DefaultDepartmentIDIsNil() predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldIsNull(FieldDefaultDepartmentID))
+}
+
+// DefaultDepartmentIDNotNil applies the NotNil predicate on the "default_department_id" field.
+func DefaultDepartmentIDNotNil() predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldNotNull(FieldDefaultDepartmentID))
+}
+
+// DefaultRoleIdsIsNil applies the IsNil predicate on the "default_role_ids" field.
+func DefaultRoleIdsIsNil() predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldIsNull(FieldDefaultRoleIds))
+}
+
+// DefaultRoleIdsNotNil applies the NotNil predicate on the "default_role_ids" field.
+func DefaultRoleIdsNotNil() predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldNotNull(FieldDefaultRoleIds))
+}
+
+// LastSyncAtEQ applies the EQ predicate on the "last_sync_at" field.
+func LastSyncAtEQ(v time.Time) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldEQ(FieldLastSyncAt, v))
+}
+
+// LastSyncAtNEQ applies the NEQ predicate on the "last_sync_at" field.
+func LastSyncAtNEQ(v time.Time) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldNEQ(FieldLastSyncAt, v))
+}
+
+// LastSyncAtIn applies the In predicate on the "last_sync_at" field.
+func LastSyncAtIn(vs ...time.Time) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldIn(FieldLastSyncAt, vs...))
+}
+
+// LastSyncAtNotIn applies the NotIn predicate on the "last_sync_at" field.
+func LastSyncAtNotIn(vs ...time.Time) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldNotIn(FieldLastSyncAt, vs...))
+}
+
+// LastSyncAtGT applies the GT predicate on the "last_sync_at" field.
+func LastSyncAtGT(v time.Time) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldGT(FieldLastSyncAt, v))
+}
+
+// LastSyncAtGTE applies the GTE predicate on the "last_sync_at" field.
+func LastSyncAtGTE(v time.Time) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldGTE(FieldLastSyncAt, v))
+}
+
+// LastSyncAtLT applies the LT predicate on the "last_sync_at" field.
+func LastSyncAtLT(v time.Time) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldLT(FieldLastSyncAt, v))
+}
+
+// LastSyncAtLTE applies the LTE predicate on the "last_sync_at" field.
+func LastSyncAtLTE(v time.Time) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldLTE(FieldLastSyncAt, v))
+}
+
+// LastSyncAtIsNil applies the IsNil predicate on the "last_sync_at" field.
+func LastSyncAtIsNil() predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldIsNull(FieldLastSyncAt))
+}
+
+// LastSyncAtNotNil applies the NotNil predicate on the "last_sync_at" field.
+func LastSyncAtNotNil() predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldNotNull(FieldLastSyncAt))
+}
+
+// EnabledEQ applies the EQ predicate on the "enabled" field.
+func EnabledEQ(v bool) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldEQ(FieldEnabled, v))
+}
+
+// EnabledNEQ applies the NEQ predicate on the "enabled" field.
+func EnabledNEQ(v bool) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldNEQ(FieldEnabled, v))
+}
+
+// SortEQ applies the EQ predicate on the "sort" field.
+func SortEQ(v uint32) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldEQ(FieldSort, v))
+}
+
+// SortNEQ applies the NEQ predicate on the "sort" field.
+func SortNEQ(v uint32) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldNEQ(FieldSort, v))
+}
+
+// SortIn applies the In predicate on the "sort" field.
+func SortIn(vs ...uint32) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldIn(FieldSort, vs...))
+}
+
+// SortNotIn applies the NotIn predicate on the "sort" field.
+func SortNotIn(vs ...uint32) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldNotIn(FieldSort, vs...))
+}
+
+// SortGT applies the GT predicate on the "sort" field.
+func SortGT(v uint32) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldGT(FieldSort, v))
+}
+
+// SortGTE applies the GTE predicate on the "sort" field.
+func SortGTE(v uint32) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldGTE(FieldSort, v))
+}
+
+// SortLT applies the LT predicate on the "sort" field.
+func SortLT(v uint32) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldLT(FieldSort, v))
+}
+
+// SortLTE applies the LTE predicate on the "sort" field.
+func SortLTE(v uint32) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldLTE(FieldSort, v))
+}
+
+// RemarkEQ applies the EQ predicate on the "remark" field.
+func RemarkEQ(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldEQ(FieldRemark, v))
+}
+
+// RemarkNEQ applies the NEQ predicate on the "remark" field.
+func RemarkNEQ(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldNEQ(FieldRemark, v))
+}
+
+// RemarkIn applies the In predicate on the "remark" field.
+func RemarkIn(vs ...string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldIn(FieldRemark, vs...))
+}
+
+// RemarkNotIn applies the NotIn predicate on the "remark" field.
+func RemarkNotIn(vs ...string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldNotIn(FieldRemark, vs...))
+}
+
+// RemarkGT applies the GT predicate on the "remark" field.
+func RemarkGT(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldGT(FieldRemark, v))
+}
+
+// RemarkGTE applies the GTE predicate on the "remark" field.
+func RemarkGTE(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldGTE(FieldRemark, v))
+}
+
+// RemarkLT applies the LT predicate on the "remark" field.
+func RemarkLT(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldLT(FieldRemark, v))
+}
+
+// RemarkLTE applies the LTE predicate on the "remark" field.
+func RemarkLTE(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldLTE(FieldRemark, v))
+}
+
+// RemarkContains applies the Contains predicate on the "remark" field.
+func RemarkContains(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldContains(FieldRemark, v))
+}
+
+// RemarkHasPrefix applies the HasPrefix predicate on the "remark" field.
+func RemarkHasPrefix(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldHasPrefix(FieldRemark, v))
+}
+
+// RemarkHasSuffix applies the HasSuffix predicate on the "remark" field.
+func RemarkHasSuffix(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldHasSuffix(FieldRemark, v))
+}
+
+// RemarkIsNil applies the IsNil predicate on the "remark" field.
+func RemarkIsNil() predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldIsNull(FieldRemark))
+}
+
+// RemarkNotNil applies the NotNil predicate on the "remark" field.
+func RemarkNotNil() predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldNotNull(FieldRemark))
+}
+
+// RemarkEqualFold applies the EqualFold predicate on the "remark" field.
+func RemarkEqualFold(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldEqualFold(FieldRemark, v))
+}
+
+// RemarkContainsFold applies the ContainsFold predicate on the "remark" field.
+func RemarkContainsFold(v string) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.FieldContainsFold(FieldRemark, v))
+}
+
+// HasLdapAccounts applies the HasEdge predicate on the "ldap_accounts" edge.
+func HasLdapAccounts() predicate.LdapProvider {
+	return predicate.LdapProvider(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, LdapAccountsTable, LdapAccountsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasLdapAccountsWith applies the HasEdge predicate on the "ldap_accounts" edge with a given conditions (other predicates).
+func HasLdapAccountsWith(preds ...predicate.LdapAccount) predicate.LdapProvider {
+	return predicate.LdapProvider(func(s *sql.Selector) {
+		step := newLdapAccountsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasLdapDepartments applies the HasEdge predicate on the "ldap_departments" edge.
+func HasLdapDepartments() predicate.LdapProvider {
+	return predicate.LdapProvider(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, LdapDepartmentsTable, LdapDepartmentsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasLdapDepartmentsWith applies the HasEdge predicate on the "ldap_departments" edge with a given conditions (other predicates).
+func HasLdapDepartmentsWith(preds ...predicate.LdapDepartment) predicate.LdapProvider {
+	return predicate.LdapProvider(func(s *sql.Selector) {
+		step := newLdapDepartmentsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasSyncRuns applies the HasEdge predicate on the "sync_runs" edge.
+func HasSyncRuns() predicate.LdapProvider {
+	return predicate.LdapProvider(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, SyncRunsTable, SyncRunsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSyncRunsWith applies the HasEdge predicate on the "sync_runs" edge with a given conditions (other predicates).
+func HasSyncRunsWith(preds ...predicate.LdapSyncRun) predicate.LdapProvider {
+	return predicate.LdapProvider(func(s *sql.Selector) {
+		step := newSyncRunsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.LdapProvider) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.LdapProvider) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.LdapProvider) predicate.LdapProvider {
+	return predicate.LdapProvider(sql.NotPredicates(p))
+}
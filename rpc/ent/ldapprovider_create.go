@@ -0,0 +1,1104 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/coder-lulu/newbee-core/rpc/ent/ldapaccount"
+	"github.com/coder-lulu/newbee-core/rpc/ent/ldapdepartment"
+	"github.com/coder-lulu/newbee-core/rpc/ent/ldapprovider"
+	"github.com/coder-lulu/newbee-core/rpc/ent/ldapsyncrun"
+)
+
+// LdapProviderCreate is the builder for creating a LdapProvider entity.
+type LdapProviderCreate struct {
+	config
+	mutation *LdapProviderMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *LdapProviderCreate) SetCreatedAt(v time.Time) *LdapProviderCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *LdapProviderCreate) SetNillableCreatedAt(v *time.Time) *LdapProviderCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *LdapProviderCreate) SetUpdatedAt(v time.Time) *LdapProviderCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *LdapProviderCreate) SetNillableUpdatedAt(v *time.Time) *LdapProviderCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *LdapProviderCreate) SetStatus(v uint8) *LdapProviderCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *LdapProviderCreate) SetNillableStatus(v *uint8) *LdapProviderCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *LdapProviderCreate) SetTenantID(v uint64) *LdapProviderCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_c *LdapProviderCreate) SetNillableTenantID(v *uint64) *LdapProviderCreate {
+	if v != nil {
+		_c.SetTenantID(*v)
+	}
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *LdapProviderCreate) SetName(v string) *LdapProviderCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetDisplayName sets the "display_name" field.
+func (_c *LdapProviderCreate) SetDisplayName(v string) *LdapProviderCreate {
+	_c.mutation.SetDisplayName(v)
+	return _c
+}
+
+// SetNillableDisplayName sets the "display_name" field if the given value is not nil.
+func (_c *LdapProviderCreate) SetNillableDisplayName(v *string) *LdapProviderCreate {
+	if v != nil {
+		_c.SetDisplayName(*v)
+	}
+	return _c
+}
+
+// SetDirectoryType sets the "directory_type" field.
+func (_c *LdapProviderCreate) SetDirectoryType(v string) *LdapProviderCreate {
+	_c.mutation.SetDirectoryType(v)
+	return _c
+}
+
+// SetNillableDirectoryType sets the "directory_type" field if the given value is not nil.
+func (_c *LdapProviderCreate) SetNillableDirectoryType(v *string) *LdapProviderCreate {
+	if v != nil {
+		_c.SetDirectoryType(*v)
+	}
+	return _c
+}
+
+// SetURL sets the "url" field.
+func (_c *LdapProviderCreate) SetURL(v string) *LdapProviderCreate {
+	_c.mutation.SetURL(v)
+	return _c
+}
+
+// SetStartTLS sets the "start_tls" field.
+func (_c *LdapProviderCreate) SetStartTLS(v bool) *LdapProviderCreate {
+	_c.mutation.SetStartTLS(v)
+	return _c
+}
+
+// SetNillableStartTLS sets the "start_tls" field if the given value is not nil.
+func (_c *LdapProviderCreate) SetNillableStartTLS(v *bool) *LdapProviderCreate {
+	if v != nil {
+		_c.SetStartTLS(*v)
+	}
+	return _c
+}
+
+// SetInsecureSkipVerify sets the "insecure_skip_verify" field.
+func (_c *LdapProviderCreate) SetInsecureSkipVerify(v bool) *LdapProviderCreate {
+	_c.mutation.SetInsecureSkipVerify(v)
+	return _c
+}
+
+// SetNillableInsecureSkipVerify sets the "insecure_skip_verify" field if the given value is not nil.
+func (_c *LdapProviderCreate) SetNillableInsecureSkipVerify(v *bool) *LdapProviderCreate {
+	if v != nil {
+		_c.SetInsecureSkipVerify(*v)
+	}
+	return _c
+}
+
+// SetBindDn sets the "bind_dn" field.
+func (_c *LdapProviderCreate) SetBindDn(v string) *LdapProviderCreate {
+	_c.mutation.SetBindDn(v)
+	return _c
+}
+
+// SetNillableBindDn sets the "bind_dn" field if the given value is not nil.
+func (_c *LdapProviderCreate) SetNillableBindDn(v *string) *LdapProviderCreate {
+	if v != nil {
+		_c.SetBindDn(*v)
+	}
+	return _c
+}
+
+// SetEncryptedBindPassword sets the "encrypted_bind_password" field.
+func (_c *LdapProviderCreate) SetEncryptedBindPassword(v string) *LdapProviderCreate {
+	_c.mutation.SetEncryptedBindPassword(v)
+	return _c
+}
+
+// SetNillableEncryptedBindPassword sets the "encrypted_bind_password" field if the given value is not nil.
+func (_c *LdapProviderCreate) SetNillableEncryptedBindPassword(v *string) *LdapProviderCreate {
+	if v != nil {
+		_c.SetEncryptedBindPassword(*v)
+	}
+	return _c
+}
+
+// SetEncryptionKeyID sets the "encryption_key_id" field.
+func (_c *LdapProviderCreate) SetEncryptionKeyID(v string) *LdapProviderCreate {
+	_c.mutation.SetEncryptionKeyID(v)
+	return _c
+}
+
+// SetNillableEncryptionKeyID sets the "encryption_key_id" field if the given value is not nil.
+func (_c *LdapProviderCreate) SetNillableEncryptionKeyID(v *string) *LdapProviderCreate {
+	if v != nil {
+		_c.SetEncryptionKeyID(*v)
+	}
+	return _c
+}
+
+// SetBaseDn sets the "base_dn" field.
+func (_c *LdapProviderCreate) SetBaseDn(v string) *LdapProviderCreate {
+	_c.mutation.SetBaseDn(v)
+	return _c
+}
+
+// SetAuthMode sets the "auth_mode" field.
+func (_c *LdapProviderCreate) SetAuthMode(v string) *LdapProviderCreate {
+	_c.mutation.SetAuthMode(v)
+	return _c
+}
+
+// SetNillableAuthMode sets the "auth_mode" field if the given value is not nil.
+func (_c *LdapProviderCreate) SetNillableAuthMode(v *string) *LdapProviderCreate {
+	if v != nil {
+		_c.SetAuthMode(*v)
+	}
+	return _c
+}
+
+// SetUserDnTemplate sets the "user_dn_template" field.
+func (_c *LdapProviderCreate) SetUserDnTemplate(v string) *LdapProviderCreate {
+	_c.mutation.SetUserDnTemplate(v)
+	return _c
+}
+
+// SetNillableUserDnTemplate sets the "user_dn_template" field if the given value is not nil.
+func (_c *LdapProviderCreate) SetNillableUserDnTemplate(v *string) *LdapProviderCreate {
+	if v != nil {
+		_c.SetUserDnTemplate(*v)
+	}
+	return _c
+}
+
+// SetUserFilter sets the "user_filter" field.
+func (_c *LdapProviderCreate) SetUserFilter(v string) *LdapProviderCreate {
+	_c.mutation.SetUserFilter(v)
+	return _c
+}
+
+// SetNillableUserFilter sets the "user_filter" field if the given value is not nil.
+func (_c *LdapProviderCreate) SetNillableUserFilter(v *string) *LdapProviderCreate {
+	if v != nil {
+		_c.SetUserFilter(*v)
+	}
+	return _c
+}
+
+// SetOuBaseDn sets the "ou_base_dn" field.
+func (_c *LdapProviderCreate) SetOuBaseDn(v string) *LdapProviderCreate {
+	_c.mutation.SetOuBaseDn(v)
+	return _c
+}
+
+// SetNillableOuBaseDn sets the "ou_base_dn" field if the given value is not nil.
+func (_c *LdapProviderCreate) SetNillableOuBaseDn(v *string) *LdapProviderCreate {
+	if v != nil {
+		_c.SetOuBaseDn(*v)
+	}
+	return _c
+}
+
+// SetOuFilter sets the "ou_filter" field.
+func (_c *LdapProviderCreate) SetOuFilter(v string) *LdapProviderCreate {
+	_c.mutation.SetOuFilter(v)
+	return _c
+}
+
+// SetNillableOuFilter sets the "ou_filter" field if the given value is not nil.
+func (_c *LdapProviderCreate) SetNillableOuFilter(v *string) *LdapProviderCreate {
+	if v != nil {
+		_c.SetOuFilter(*v)
+	}
+	return _c
+}
+
+// SetGroupBaseDn sets the "group_base_dn" field.
+func (_c *LdapProviderCreate) SetGroupBaseDn(v string) *LdapProviderCreate {
+	_c.mutation.SetGroupBaseDn(v)
+	return _c
+}
+
+// SetNillableGroupBaseDn sets the "group_base_dn" field if the given value is not nil.
+func (_c *LdapProviderCreate) SetNillableGroupBaseDn(v *string) *LdapProviderCreate {
+	if v != nil {
+		_c.SetGroupBaseDn(*v)
+	}
+	return _c
+}
+
+// SetGroupFilter sets the "group_filter" field.
+func (_c *LdapProviderCreate) SetGroupFilter(v string) *LdapProviderCreate {
+	_c.mutation.SetGroupFilter(v)
+	return _c
+}
+
+// SetNillableGroupFilter sets the "group_filter" field if the given value is not nil.
+func (_c *LdapProviderCreate) SetNillableGroupFilter(v *string) *LdapProviderCreate {
+	if v != nil {
+		_c.SetGroupFilter(*v)
+	}
+	return _c
+}
+
+// SetGroupMemberAttribute sets the "group_member_attribute" field.
+func (_c *LdapProviderCreate) SetGroupMemberAttribute(v string) *LdapProviderCreate {
+	_c.mutation.SetGroupMemberAttribute(v)
+	return _c
+}
+
+// SetNillableGroupMemberAttribute sets the "group_member_attribute" field if the given value is not nil.
+func (_c *LdapProviderCreate) SetNillableGroupMemberAttribute(v *string) *LdapProviderCreate {
+	if v != nil {
+		_c.SetGroupMemberAttribute(*v)
+	}
+	return _c
+}
+
+// SetAttributeMapping sets the "attribute_mapping" field.
+func (_c *LdapProviderCreate) SetAttributeMapping(v map[string]interface{}) *LdapProviderCreate {
+	_c.mutation.SetAttributeMapping(v)
+	return _c
+}
+
+// SetLoginEnabled sets the "login_enabled" field.
+func (_c *LdapProviderCreate) SetLoginEnabled(v bool) *LdapProviderCreate {
+	_c.mutation.SetLoginEnabled(v)
+	return _c
+}
+
+// SetNillableLoginEnabled sets the "login_enabled" field if the given value is not nil.
+func (_c *LdapProviderCreate) SetNillableLoginEnabled(v *bool) *LdapProviderCreate {
+	if v != nil {
+		_c.SetLoginEnabled(*v)
+	}
+	return _c
+}
+
+// SetJitProvision sets the "jit_provision" field.
+func (_c *LdapProviderCreate) SetJitProvision(v bool) *LdapProviderCreate {
+	_c.mutation.SetJitProvision(v)
+	return _c
+}
+
+// SetNillableJitProvision sets the "jit_provision" field if the given value is not nil.
+func (_c *LdapProviderCreate) SetNillableJitProvision(v *bool) *LdapProviderCreate {
+	if v != nil {
+		_c.SetJitProvision(*v)
+	}
+	return _c
+}
+
+// SetLinkExisting sets the "link_existing" field.
+func (_c *LdapProviderCreate) SetLinkExisting(v bool) *LdapProviderCreate {
+	_c.mutation.SetLinkExisting(v)
+	return _c
+}
+
+// SetNillableLinkExisting sets the "link_existing" field if the given value is not nil.
+func (_c *LdapProviderCreate) SetNillableLinkExisting(v *bool) *LdapProviderCreate {
+	if v != nil {
+		_c.SetLinkExisting(*v)
+	}
+	return _c
+}
+
+// SetSyncEnabled sets the "sync_enabled" field.
+func (_c *LdapProviderCreate) SetSyncEnabled(v bool) *LdapProviderCreate {
+	_c.mutation.SetSyncEnabled(v)
+	return _c
+}
+
+// SetNillableSyncEnabled sets the "sync_enabled" field if the given value is not nil.
+func (_c *LdapProviderCreate) SetNillableSyncEnabled(v *bool) *LdapProviderCreate {
+	if v != nil {
+		_c.SetSyncEnabled(*v)
+	}
+	return _c
+}
+
+// SetSyncInterval sets the "sync_interval" field.
+func (_c *LdapProviderCreate) SetSyncInterval(v uint32) *LdapProviderCreate {
+	_c.mutation.SetSyncInterval(v)
+	return _c
+}
+
+// SetNillableSyncInterval sets the "sync_interval" field if the given value is not nil.
+func (_c *LdapProviderCreate) SetNillableSyncInterval(v *uint32) *LdapProviderCreate {
+	if v != nil {
+		_c.SetSyncInterval(*v)
+	}
+	return _c
+}
+
+// SetDeletionPolicy sets the "deletion_policy" field.
+func (_c *LdapProviderCreate) SetDeletionPolicy(v string) *LdapProviderCreate {
+	_c.mutation.SetDeletionPolicy(v)
+	return _c
+}
+
+// SetNillableDeletionPolicy sets the "deletion_policy" field if the given value is not nil.
+func (_c *LdapProviderCreate) SetNillableDeletionPolicy(v *string) *LdapProviderCreate {
+	if v != nil {
+		_c.SetDeletionPolicy(*v)
+	}
+	return _c
+}
+
+// SetRootDepartmentID sets the "root_department_id" field.
+func (_c *LdapProviderCreate) SetRootDepartmentID(v uint64) *LdapProviderCreate {
+	_c.mutation.SetRootDepartmentID(v)
+	return _c
+}
+
+// SetNillableRootDepartmentID sets the "root_department_id" field if the given value is not nil.
+func (_c *LdapProviderCreate) SetNillableRootDepartmentID(v *uint64) *LdapProviderCreate {
+	if v != nil {
+		_c.SetRootDepartmentID(*v)
+	}
+	return _c
+}
+
+// SetDefaultDepartmentID sets the "default_department_id" field.
+func (_c *LdapProviderCreate) SetDefaultDepartmentID(v uint64) *LdapProviderCreate {
+	_c.mutation.SetDefaultDepartmentID(v)
+	return _c
+}
+
+// SetNillableDefaultDepartmentID sets the "default_department_id" field if the given value is not nil.
+func (_c *LdapProviderCreate) SetNillableDefaultDepartmentID(v *uint64) *LdapProviderCreate {
+	if v != nil {
+		_c.SetDefaultDepartmentID(*v)
+	}
+	return _c
+}
+
+// SetDefaultRoleIds sets the "default_role_ids" field.
+func (_c *LdapProviderCreate) SetDefaultRoleIds(v []uint64) *LdapProviderCreate {
+	_c.mutation.SetDefaultRoleIds(v)
+	return _c
+}
+
+// SetLastSyncAt sets the "last_sync_at" field.
+func (_c *LdapProviderCreate) SetLastSyncAt(v time.Time) *LdapProviderCreate {
+	_c.mutation.SetLastSyncAt(v)
+	return _c
+}
+
+// SetNillableLastSyncAt sets the "last_sync_at" field if the given value is not nil.
+func (_c *LdapProviderCreate) SetNillableLastSyncAt(v *time.Time) *LdapProviderCreate {
+	if v != nil {
+		_c.SetLastSyncAt(*v)
+	}
+	return _c
+}
+
+// SetEnabled sets the "enabled" field.
+func (_c *LdapProviderCreate) SetEnabled(v bool) *LdapProviderCreate {
+	_c.mutation.SetEnabled(v)
+	return _c
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_c *LdapProviderCreate) SetNillableEnabled(v *bool) *LdapProviderCreate {
+	if v != nil {
+		_c.SetEnabled(*v)
+	}
+	return _c
+}
+
+// SetSort sets the "sort" field.
+func (_c *LdapProviderCreate) SetSort(v uint32) *LdapProviderCreate {
+	_c.mutation.SetSort(v)
+	return _c
+}
+
+// SetNillableSort sets the "sort" field if the given value is not nil.
+func (_c *LdapProviderCreate) SetNillableSort(v *uint32) *LdapProviderCreate {
+	if v != nil {
+		_c.SetSort(*v)
+	}
+	return _c
+}
+
+// SetRemark sets the "remark" field.
+func (_c *LdapProviderCreate) SetRemark(v string) *LdapProviderCreate {
+	_c.mutation.SetRemark(v)
+	return _c
+}
+
+// SetNillableRemark sets the "remark" field if the given value is not nil.
+func (_c *LdapProviderCreate) SetNillableRemark(v *string) *LdapProviderCreate {
+	if v != nil {
+		_c.SetRemark(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *LdapProviderCreate) SetID(v uint64) *LdapProviderCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// AddLdapAccountIDs adds the "ldap_accounts" edge to the LdapAccount entity by IDs.
+func (_c *LdapProviderCreate) AddLdapAccountIDs(ids ...uint64) *LdapProviderCreate {
+	_c.mutation.AddLdapAccountIDs(ids...)
+	return _c
+}
+
+// AddLdapAccounts adds the "ldap_accounts" edges to the LdapAccount entity.
+func (_c *LdapProviderCreate) AddLdapAccounts(v ...*LdapAccount) *LdapProviderCreate {
+	ids := make([]uint64, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddLdapAccountIDs(ids...)
+}
+
+// AddLdapDepartmentIDs adds the "ldap_departments" edge to the LdapDepartment entity by IDs.
+func (_c *LdapProviderCreate) AddLdapDepartmentIDs(ids ...uint64) *LdapProviderCreate {
+	_c.mutation.AddLdapDepartmentIDs(ids...)
+	return _c
+}
+
+// AddLdapDepartments adds the "ldap_departments" edges to the LdapDepartment entity.
+func (_c *LdapProviderCreate) AddLdapDepartments(v ...*LdapDepartment) *LdapProviderCreate {
+	ids := make([]uint64, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddLdapDepartmentIDs(ids...)
+}
+
+// AddSyncRunIDs adds the "sync_runs" edge to the LdapSyncRun entity by IDs.
+func (_c *LdapProviderCreate) AddSyncRunIDs(ids ...uint64) *LdapProviderCreate {
+	_c.mutation.AddSyncRunIDs(ids...)
+	return _c
+}
+
+// AddSyncRuns adds the "sync_runs" edges to the LdapSyncRun entity.
+func (_c *LdapProviderCreate) AddSyncRuns(v ...*LdapSyncRun) *LdapProviderCreate {
+	ids := make([]uint64, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddSyncRunIDs(ids...)
+}
+
+// Mutation returns the LdapProviderMutation object of the builder.
+func (_c *LdapProviderCreate) Mutation() *LdapProviderMutation {
+	return _c.mutation
+}
+
+// Save creates the LdapProvider in the database.
+func (_c *LdapProviderCreate) Save(ctx context.Context) (*LdapProvider, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *LdapProviderCreate) SaveX(ctx context.Context) *LdapProvider {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LdapProviderCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LdapProviderCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *LdapProviderCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := ldapprovider.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := ldapprovider.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := ldapprovider.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.TenantID(); !ok {
+		v := ldapprovider.DefaultTenantID
+		_c.mutation.SetTenantID(v)
+	}
+	if _, ok := _c.mutation.DirectoryType(); !ok {
+		v := ldapprovider.DefaultDirectoryType
+		_c.mutation.SetDirectoryType(v)
+	}
+	if _, ok := _c.mutation.StartTLS(); !ok {
+		v := ldapprovider.DefaultStartTLS
+		_c.mutation.SetStartTLS(v)
+	}
+	if _, ok := _c.mutation.InsecureSkipVerify(); !ok {
+		v := ldapprovider.DefaultInsecureSkipVerify
+		_c.mutation.SetInsecureSkipVerify(v)
+	}
+	if _, ok := _c.mutation.AuthMode(); !ok {
+		v := ldapprovider.DefaultAuthMode
+		_c.mutation.SetAuthMode(v)
+	}
+	if _, ok := _c.mutation.LoginEnabled(); !ok {
+		v := ldapprovider.DefaultLoginEnabled
+		_c.mutation.SetLoginEnabled(v)
+	}
+	if _, ok := _c.mutation.JitProvision(); !ok {
+		v := ldapprovider.DefaultJitProvision
+		_c.mutation.SetJitProvision(v)
+	}
+	if _, ok := _c.mutation.LinkExisting(); !ok {
+		v := ldapprovider.DefaultLinkExisting
+		_c.mutation.SetLinkExisting(v)
+	}
+	if _, ok := _c.mutation.SyncEnabled(); !ok {
+		v := ldapprovider.DefaultSyncEnabled
+		_c.mutation.SetSyncEnabled(v)
+	}
+	if _, ok := _c.mutation.SyncInterval(); !ok {
+		v := ldapprovider.DefaultSyncInterval
+		_c.mutation.SetSyncInterval(v)
+	}
+	if _, ok := _c.mutation.DeletionPolicy(); !ok {
+		v := ldapprovider.DefaultDeletionPolicy
+		_c.mutation.SetDeletionPolicy(v)
+	}
+	if _, ok := _c.mutation.RootDepartmentID(); !ok {
+		v := ldapprovider.DefaultRootDepartmentID
+		_c.mutation.SetRootDepartmentID(v)
+	}
+	if _, ok := _c.mutation.DefaultDepartmentID(); !ok {
+		v := ldapprovider.DefaultDefaultDepartmentID
+		_c.mutation.SetDefaultDepartmentID(v)
+	}
+	if _, ok := _c.mutation.Enabled(); !ok {
+		v := ldapprovider.DefaultEnabled
+		_c.mutation.SetEnabled(v)
+	}
+	if _, ok := _c.mutation.Sort(); !ok {
+		v := ldapprovider.DefaultSort
+		_c.mutation.SetSort(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *LdapProviderCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "LdapProvider.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "LdapProvider.updated_at"`)}
+	}
+	if _, ok := _c.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "LdapProvider.tenant_id"`)}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "LdapProvider.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := ldapprovider.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "LdapProvider.name": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.DisplayName(); ok {
+		if err := ldapprovider.DisplayNameValidator(v); err != nil {
+			return &ValidationError{Name: "display_name", err: fmt.Errorf(`ent: validator failed for field "LdapProvider.display_name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.DirectoryType(); !ok {
+		return &ValidationError{Name: "directory_type", err: errors.New(`ent: missing required field "LdapProvider.directory_type"`)}
+	}
+	if v, ok := _c.mutation.DirectoryType(); ok {
+		if err := ldapprovider.DirectoryTypeValidator(v); err != nil {
+			return &ValidationError{Name: "directory_type", err: fmt.Errorf(`ent: validator failed for field "LdapProvider.directory_type": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.URL(); !ok {
+		return &ValidationError{Name: "url", err: errors.New(`ent: missing required field "LdapProvider.url"`)}
+	}
+	if v, ok := _c.mutation.URL(); ok {
+		if err := ldapprovider.URLValidator(v); err != nil {
+			return &ValidationError{Name: "url", err: fmt.Errorf(`ent: validator failed for field "LdapProvider.url": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.StartTLS(); !ok {
+		return &ValidationError{Name: "start_tls", err: errors.New(`ent: missing required field "LdapProvider.start_tls"`)}
+	}
+	if _, ok := _c.mutation.InsecureSkipVerify(); !ok {
+		return &ValidationError{Name: "insecure_skip_verify", err: errors.New(`ent: missing required field "LdapProvider.insecure_skip_verify"`)}
+	}
+	if v, ok := _c.mutation.BindDn(); ok {
+		if err := ldapprovider.BindDnValidator(v); err != nil {
+			return &ValidationError{Name: "bind_dn", err: fmt.Errorf(`ent: validator failed for field "LdapProvider.bind_dn": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.EncryptionKeyID(); ok {
+		if err := ldapprovider.EncryptionKeyIDValidator(v); err != nil {
+			return &ValidationError{Name: "encryption_key_id", err: fmt.Errorf(`ent: validator failed for field "LdapProvider.encryption_key_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.BaseDn(); !ok {
+		return &ValidationError{Name: "base_dn", err: errors.New(`ent: missing required field "LdapProvider.base_dn"`)}
+	}
+	if v, ok := _c.mutation.BaseDn(); ok {
+		if err := ldapprovider.BaseDnValidator(v); err != nil {
+			return &ValidationError{Name: "base_dn", err: fmt.Errorf(`ent: validator failed for field "LdapProvider.base_dn": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.AuthMode(); !ok {
+		return &ValidationError{Name: "auth_mode", err: errors.New(`ent: missing required field "LdapProvider.auth_mode"`)}
+	}
+	if v, ok := _c.mutation.AuthMode(); ok {
+		if err := ldapprovider.AuthModeValidator(v); err != nil {
+			return &ValidationError{Name: "auth_mode", err: fmt.Errorf(`ent: validator failed for field "LdapProvider.auth_mode": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.UserDnTemplate(); ok {
+		if err := ldapprovider.UserDnTemplateValidator(v); err != nil {
+			return &ValidationError{Name: "user_dn_template", err: fmt.Errorf(`ent: validator failed for field "LdapProvider.user_dn_template": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.UserFilter(); ok {
+		if err := ldapprovider.UserFilterValidator(v); err != nil {
+			return &ValidationError{Name: "user_filter", err: fmt.Errorf(`ent: validator failed for field "LdapProvider.user_filter": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.OuBaseDn(); ok {
+		if err := ldapprovider.OuBaseDnValidator(v); err != nil {
+			return &ValidationError{Name: "ou_base_dn", err: fmt.Errorf(`ent: validator failed for field "LdapProvider.ou_base_dn": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.OuFilter(); ok {
+		if err := ldapprovider.OuFilterValidator(v); err != nil {
+			return &ValidationError{Name: "ou_filter", err: fmt.Errorf(`ent: validator failed for field "LdapProvider.ou_filter": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.GroupBaseDn(); ok {
+		if err := ldapprovider.GroupBaseDnValidator(v); err != nil {
+			return &ValidationError{Name: "group_base_dn", err: fmt.Errorf(`ent: validator failed for field "LdapProvider.group_base_dn": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.GroupFilter(); ok {
+		if err := ldapprovider.GroupFilterValidator(v); err != nil {
+			return &ValidationError{Name: "group_filter", err: fmt.Errorf(`ent: validator failed for field "LdapProvider.group_filter": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.GroupMemberAttribute(); ok {
+		if err := ldapprovider.GroupMemberAttributeValidator(v); err != nil {
+			return &ValidationError{Name: "group_member_attribute", err: fmt.Errorf(`ent: validator failed for field "LdapProvider.group_member_attribute": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.LoginEnabled(); !ok {
+		return &ValidationError{Name: "login_enabled", err: errors.New(`ent: missing required field "LdapProvider.login_enabled"`)}
+	}
+	if _, ok := _c.mutation.JitProvision(); !ok {
+		return &ValidationError{Name: "jit_provision", err: errors.New(`ent: missing required field "LdapProvider.jit_provision"`)}
+	}
+	if _, ok := _c.mutation.LinkExisting(); !ok {
+		return &ValidationError{Name: "link_existing", err: errors.New(`ent: missing required field "LdapProvider.link_existing"`)}
+	}
+	if _, ok := _c.mutation.SyncEnabled(); !ok {
+		return &ValidationError{Name: "sync_enabled", err: errors.New(`ent: missing required field "LdapProvider.sync_enabled"`)}
+	}
+	if _, ok := _c.mutation.SyncInterval(); !ok {
+		return &ValidationError{Name: "sync_interval", err: errors.New(`ent: missing required field "LdapProvider.sync_interval"`)}
+	}
+	if _, ok := _c.mutation.DeletionPolicy(); !ok {
+		return &ValidationError{Name: "deletion_policy", err: errors.New(`ent: missing required field "LdapProvider.deletion_policy"`)}
+	}
+	if v, ok := _c.mutation.DeletionPolicy(); ok {
+		if err := ldapprovider.DeletionPolicyValidator(v); err != nil {
+			return &ValidationError{Name: "deletion_policy", err: fmt.Errorf(`ent: validator failed for field "LdapProvider.deletion_policy": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Enabled(); !ok {
+		return &ValidationError{Name: "enabled", err: errors.New(`ent: missing required field "LdapProvider.enabled"`)}
+	}
+	if _, ok := _c.mutation.Sort(); !ok {
+		return &ValidationError{Name: "sort", err: errors.New(`ent: missing required field "LdapProvider.sort"`)}
+	}
+	if v, ok := _c.mutation.Remark(); ok {
+		if err := ldapprovider.RemarkValidator(v); err != nil {
+			return &ValidationError{Name: "remark", err: fmt.Errorf(`ent: validator failed for field "LdapProvider.remark": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *LdapProviderCreate) sqlSave(ctx context.Context) (*LdapProvider, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint64(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *LdapProviderCreate) createSpec() (*LdapProvider, *sqlgraph.CreateSpec) {
+	var (
+		_node = &LdapProvider{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(ldapprovider.Table, sqlgraph.NewFieldSpec(ldapprovider.FieldID, field.TypeUint64))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(ldapprovider.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(ldapprovider.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(ldapprovider.FieldStatus, field.TypeUint8, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(ldapprovider.FieldTenantID, field.TypeUint64, value)
+		_node.TenantID = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(ldapprovider.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.DisplayName(); ok {
+		_spec.SetField(ldapprovider.FieldDisplayName, field.TypeString, value)
+		_node.DisplayName = value
+	}
+	if value, ok := _c.mutation.DirectoryType(); ok {
+		_spec.SetField(ldapprovider.FieldDirectoryType, field.TypeString, value)
+		_node.DirectoryType = value
+	}
+	if value, ok := _c.mutation.URL(); ok {
+		_spec.SetField(ldapprovider.FieldURL, field.TypeString, value)
+		_node.URL = value
+	}
+	if value, ok := _c.mutation.StartTLS(); ok {
+		_spec.SetField(ldapprovider.FieldStartTLS, field.TypeBool, value)
+		_node.StartTLS = value
+	}
+	if value, ok := _c.mutation.InsecureSkipVerify(); ok {
+		_spec.SetField(ldapprovider.FieldInsecureSkipVerify, field.TypeBool, value)
+		_node.InsecureSkipVerify = value
+	}
+	if value, ok := _c.mutation.BindDn(); ok {
+		_spec.SetField(ldapprovider.FieldBindDn, field.TypeString, value)
+		_node.BindDn = value
+	}
+	if value, ok := _c.mutation.EncryptedBindPassword(); ok {
+		_spec.SetField(ldapprovider.FieldEncryptedBindPassword, field.TypeString, value)
+		_node.EncryptedBindPassword = value
+	}
+	if value, ok := _c.mutation.EncryptionKeyID(); ok {
+		_spec.SetField(ldapprovider.FieldEncryptionKeyID, field.TypeString, value)
+		_node.EncryptionKeyID = value
+	}
+	if value, ok := _c.mutation.BaseDn(); ok {
+		_spec.SetField(ldapprovider.FieldBaseDn, field.TypeString, value)
+		_node.BaseDn = value
+	}
+	if value, ok := _c.mutation.AuthMode(); ok {
+		_spec.SetField(ldapprovider.FieldAuthMode, field.TypeString, value)
+		_node.AuthMode = value
+	}
+	if value, ok := _c.mutation.UserDnTemplate(); ok {
+		_spec.SetField(ldapprovider.FieldUserDnTemplate, field.TypeString, value)
+		_node.UserDnTemplate = value
+	}
+	if value, ok := _c.mutation.UserFilter(); ok {
+		_spec.SetField(ldapprovider.FieldUserFilter, field.TypeString, value)
+		_node.UserFilter = value
+	}
+	if value, ok := _c.mutation.OuBaseDn(); ok {
+		_spec.SetField(ldapprovider.FieldOuBaseDn, field.TypeString, value)
+		_node.OuBaseDn = value
+	}
+	if value, ok := _c.mutation.OuFilter(); ok {
+		_spec.SetField(ldapprovider.FieldOuFilter, field.TypeString, value)
+		_node.OuFilter = value
+	}
+	if value, ok := _c.mutation.GroupBaseDn(); ok {
+		_spec.SetField(ldapprovider.FieldGroupBaseDn, field.TypeString, value)
+		_node.GroupBaseDn = value
+	}
+	if value, ok := _c.mutation.GroupFilter(); ok {
+		_spec.SetField(ldapprovider.FieldGroupFilter, field.TypeString, value)
+		_node.GroupFilter = value
+	}
+	if value, ok := _c.mutation.GroupMemberAttribute(); ok {
+		_spec.SetField(ldapprovider.FieldGroupMemberAttribute, field.TypeString, value)
+		_node.GroupMemberAttribute = value
+	}
+	if value, ok := _c.mutation.AttributeMapping(); ok {
+		_spec.SetField(ldapprovider.FieldAttributeMapping, field.TypeJSON, value)
+		_node.AttributeMapping = value
+	}
+	if value, ok := _c.mutation.LoginEnabled(); ok {
+		_spec.SetField(ldapprovider.FieldLoginEnabled, field.TypeBool, value)
+		_node.LoginEnabled = value
+	}
+	if value, ok := _c.mutation.JitProvision(); ok {
+		_spec.SetField(ldapprovider.FieldJitProvision, field.TypeBool, value)
+		_node.JitProvision = value
+	}
+	if value, ok := _c.mutation.LinkExisting(); ok {
+		_spec.SetField(ldapprovider.FieldLinkExisting, field.TypeBool, value)
+		_node.LinkExisting = value
+	}
+	if value, ok := _c.mutation.SyncEnabled(); ok {
+		_spec.SetField(ldapprovider.FieldSyncEnabled, field.TypeBool, value)
+		_node.SyncEnabled = value
+	}
+	if value, ok := _c.mutation.SyncInterval(); ok {
+		_spec.SetField(ldapprovider.FieldSyncInterval, field.TypeUint32, value)
+		_node.SyncInterval = value
+	}
+	if value, ok := _c.mutation.DeletionPolicy(); ok {
+		_spec.SetField(ldapprovider.FieldDeletionPolicy, field.TypeString, value)
+		_node.DeletionPolicy = value
+	}
+	if value, ok := _c.mutation.RootDepartmentID(); ok {
+		_spec.SetField(ldapprovider.FieldRootDepartmentID, field.TypeUint64, value)
+		_node.RootDepartmentID = value
+	}
+	if value, ok := _c.mutation.DefaultDepartmentID(); ok {
+		_spec.SetField(ldapprovider.FieldDefaultDepartmentID, field.TypeUint64, value)
+		_node.DefaultDepartmentID = value
+	}
+	if value, ok := _c.mutation.DefaultRoleIds(); ok {
+		_spec.SetField(ldapprovider.FieldDefaultRoleIds, field.TypeJSON, value)
+		_node.DefaultRoleIds = value
+	}
+	if value, ok := _c.mutation.LastSyncAt(); ok {
+		_spec.SetField(ldapprovider.FieldLastSyncAt, field.TypeTime, value)
+		_node.LastSyncAt = &value
+	}
+	if value, ok := _c.mutation.Enabled(); ok {
+		_spec.SetField(ldapprovider.FieldEnabled, field.TypeBool, value)
+		_node.Enabled = value
+	}
+	if value, ok := _c.mutation.Sort(); ok {
+		_spec.SetField(ldapprovider.FieldSort, field.TypeUint32, value)
+		_node.Sort = value
+	}
+	if value, ok := _c.mutation.Remark(); ok {
+		_spec.SetField(ldapprovider.FieldRemark, field.TypeString, value)
+		_node.Remark = value
+	}
+	if nodes := _c.mutation.LdapAccountsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   ldapprovider.LdapAccountsTable,
+			Columns: []string{ldapprovider.LdapAccountsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ldapaccount.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.LdapDepartmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   ldapprovider.LdapDepartmentsTable,
+			Columns: []string{ldapprovider.LdapDepartmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ldapdepartment.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.SyncRunsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   ldapprovider.SyncRunsTable,
+			Columns: []string{ldapprovider.SyncRunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ldapsyncrun.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// LdapProviderCreateBulk is the builder for creating many LdapProvider entities in bulk.
+type LdapProviderCreateBulk struct {
+	config
+	err      error
+	builders []*LdapProviderCreate
+}
+
+// Save creates the LdapProvider entities in the database.
+func (_c *LdapProviderCreateBulk) Save(ctx context.Context) ([]*LdapProvider, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*LdapProvider, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*LdapProviderMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *LdapProviderCreateBulk) SaveX(ctx context.Context) []*LdapProvider {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LdapProviderCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LdapProviderCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
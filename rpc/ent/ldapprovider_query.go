@@ -0,0 +1,777 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/coder-lulu/newbee-core/rpc/ent/ldapaccount"
+	"github.com/coder-lulu/newbee-core/rpc/ent/ldapdepartment"
+	"github.com/coder-lulu/newbee-core/rpc/ent/ldapprovider"
+	"github.com/coder-lulu/newbee-core/rpc/ent/ldapsyncrun"
+	"github.com/coder-lulu/newbee-core/rpc/ent/predicate"
+)
+
+// LdapProviderQuery is the builder for querying LdapProvider entities.
+type LdapProviderQuery struct {
+	config
+	ctx                 *QueryContext
+	order               []ldapprovider.OrderOption
+	inters              []Interceptor
+	predicates          []predicate.LdapProvider
+	withLdapAccounts    *LdapAccountQuery
+	withLdapDepartments *LdapDepartmentQuery
+	withSyncRuns        *LdapSyncRunQuery
+	modifiers           []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the LdapProviderQuery builder.
+func (_q *LdapProviderQuery) Where(ps ...predicate.LdapProvider) *LdapProviderQuery {
+	_q.predicates = append(_q.predicates, ps...)
+	return _q
+}
+
+// Limit the number of records to be returned by this query.
+func (_q *LdapProviderQuery) Limit(limit int) *LdapProviderQuery {
+	_q.ctx.Limit = &limit
+	return _q
+}
+
+// Offset to start from.
+func (_q *LdapProviderQuery) Offset(offset int) *LdapProviderQuery {
+	_q.ctx.Offset = &offset
+	return _q
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (_q *LdapProviderQuery) Unique(unique bool) *LdapProviderQuery {
+	_q.ctx.Unique = &unique
+	return _q
+}
+
+// Order specifies how the records should be ordered.
+func (_q *LdapProviderQuery) Order(o ...ldapprovider.OrderOption) *LdapProviderQuery {
+	_q.order = append(_q.order, o...)
+	return _q
+}
+
+// QueryLdapAccounts chains the current query on the "ldap_accounts" edge.
+func (_q *LdapProviderQuery) QueryLdapAccounts() *LdapAccountQuery {
+	query := (&LdapAccountClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(ldapprovider.Table, ldapprovider.FieldID, selector),
+			sqlgraph.To(ldapaccount.Table, ldapaccount.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ldapprovider.LdapAccountsTable, ldapprovider.LdapAccountsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryLdapDepartments chains the current query on the "ldap_departments" edge.
+func (_q *LdapProviderQuery) QueryLdapDepartments() *LdapDepartmentQuery {
+	query := (&LdapDepartmentClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(ldapprovider.Table, ldapprovider.FieldID, selector),
+			sqlgraph.To(ldapdepartment.Table, ldapdepartment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ldapprovider.LdapDepartmentsTable, ldapprovider.LdapDepartmentsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QuerySyncRuns chains the current query on the "sync_runs" edge.
+func (_q *LdapProviderQuery) QuerySyncRuns() *LdapSyncRunQuery {
+	query := (&LdapSyncRunClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(ldapprovider.Table, ldapprovider.FieldID, selector),
+			sqlgraph.To(ldapsyncrun.Table, ldapsyncrun.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ldapprovider.SyncRunsTable, ldapprovider.SyncRunsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first LdapProvider entity from the query.
+// Returns a *NotFoundError when no LdapProvider was found.
+func (_q *LdapProviderQuery) First(ctx context.Context) (*LdapProvider, error) {
+	nodes, err := _q.Limit(1).All(setContextOp(ctx, _q.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{ldapprovider.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (_q *LdapProviderQuery) FirstX(ctx context.Context) *LdapProvider {
+	node, err := _q.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first LdapProvider ID from the query.
+// Returns a *NotFoundError when no LdapProvider ID was found.
+func (_q *LdapProviderQuery) FirstID(ctx context.Context) (id uint64, err error) {
+	var ids []uint64
+	if ids, err = _q.Limit(1).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{ldapprovider.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (_q *LdapProviderQuery) FirstIDX(ctx context.Context) uint64 {
+	id, err := _q.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single LdapProvider entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one LdapProvider entity is found.
+// Returns a *NotFoundError when no LdapProvider entities are found.
+func (_q *LdapProviderQuery) Only(ctx context.Context) (*LdapProvider, error) {
+	nodes, err := _q.Limit(2).All(setContextOp(ctx, _q.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{ldapprovider.Label}
+	default:
+		return nil, &NotSingularError{ldapprovider.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (_q *LdapProviderQuery) OnlyX(ctx context.Context) *LdapProvider {
+	node, err := _q.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only LdapProvider ID in the query.
+// Returns a *NotSingularError when more than one LdapProvider ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (_q *LdapProviderQuery) OnlyID(ctx context.Context) (id uint64, err error) {
+	var ids []uint64
+	if ids, err = _q.Limit(2).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{ldapprovider.Label}
+	default:
+		err = &NotSingularError{ldapprovider.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (_q *LdapProviderQuery) OnlyIDX(ctx context.Context) uint64 {
+	id, err := _q.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of LdapProviders.
+func (_q *LdapProviderQuery) All(ctx context.Context) ([]*LdapProvider, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryAll)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*LdapProvider, *LdapProviderQuery]()
+	return withInterceptors[[]*LdapProvider](ctx, _q, qr, _q.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (_q *LdapProviderQuery) AllX(ctx context.Context) []*LdapProvider {
+	nodes, err := _q.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of LdapProvider IDs.
+func (_q *LdapProviderQuery) IDs(ctx context.Context) (ids []uint64, err error) {
+	if _q.ctx.Unique == nil && _q.path != nil {
+		_q.Unique(true)
+	}
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryIDs)
+	if err = _q.Select(ldapprovider.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (_q *LdapProviderQuery) IDsX(ctx context.Context) []uint64 {
+	ids, err := _q.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (_q *LdapProviderQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryCount)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, _q, querierCount[*LdapProviderQuery](), _q.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (_q *LdapProviderQuery) CountX(ctx context.Context) int {
+	count, err := _q.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (_q *LdapProviderQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryExist)
+	switch _, err := _q.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (_q *LdapProviderQuery) ExistX(ctx context.Context) bool {
+	exist, err := _q.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the LdapProviderQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (_q *LdapProviderQuery) Clone() *LdapProviderQuery {
+	if _q == nil {
+		return nil
+	}
+	return &LdapProviderQuery{
+		config:              _q.config,
+		ctx:                 _q.ctx.Clone(),
+		order:               append([]ldapprovider.OrderOption{}, _q.order...),
+		inters:              append([]Interceptor{}, _q.inters...),
+		predicates:          append([]predicate.LdapProvider{}, _q.predicates...),
+		withLdapAccounts:    _q.withLdapAccounts.Clone(),
+		withLdapDepartments: _q.withLdapDepartments.Clone(),
+		withSyncRuns:        _q.withSyncRuns.Clone(),
+		// clone intermediate query.
+		sql:       _q.sql.Clone(),
+		path:      _q.path,
+		modifiers: append([]func(*sql.Selector){}, _q.modifiers...),
+	}
+}
+
+// WithLdapAccounts tells the query-builder to eager-load the nodes that are connected to
+// the "ldap_accounts" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *LdapProviderQuery) WithLdapAccounts(opts ...func(*LdapAccountQuery)) *LdapProviderQuery {
+	query := (&LdapAccountClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withLdapAccounts = query
+	return _q
+}
+
+// WithLdapDepartments tells the query-builder to eager-load the nodes that are connected to
+// the "ldap_departments" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *LdapProviderQuery) WithLdapDepartments(opts ...func(*LdapDepartmentQuery)) *LdapProviderQuery {
+	query := (&LdapDepartmentClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withLdapDepartments = query
+	return _q
+}
+
+// WithSyncRuns tells the query-builder to eager-load the nodes that are connected to
+// the "sync_runs" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *LdapProviderQuery) WithSyncRuns(opts ...func(*LdapSyncRunQuery)) *LdapProviderQuery {
+	query := (&LdapSyncRunClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withSyncRuns = query
+	return _q
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.LdapProvider.Query().
+//		GroupBy(ldapprovider.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (_q *LdapProviderQuery) GroupBy(field string, fields ...string) *LdapProviderGroupBy {
+	_q.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &LdapProviderGroupBy{build: _q}
+	grbuild.flds = &_q.ctx.Fields
+	grbuild.label = ldapprovider.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.LdapProvider.Query().
+//		Select(ldapprovider.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (_q *LdapProviderQuery) Select(fields ...string) *LdapProviderSelect {
+	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
+	sbuild := &LdapProviderSelect{LdapProviderQuery: _q}
+	sbuild.label = ldapprovider.Label
+	sbuild.flds, sbuild.scan = &_q.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a LdapProviderSelect configured with the given aggregations.
+func (_q *LdapProviderQuery) Aggregate(fns ...AggregateFunc) *LdapProviderSelect {
+	return _q.Select().Aggregate(fns...)
+}
+
+func (_q *LdapProviderQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range _q.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, _q); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range _q.ctx.Fields {
+		if !ldapprovider.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if _q.path != nil {
+		prev, err := _q.path(ctx)
+		if err != nil {
+			return err
+		}
+		_q.sql = prev
+	}
+	return nil
+}
+
+func (_q *LdapProviderQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*LdapProvider, error) {
+	var (
+		nodes       = []*LdapProvider{}
+		_spec       = _q.querySpec()
+		loadedTypes = [3]bool{
+			_q.withLdapAccounts != nil,
+			_q.withLdapDepartments != nil,
+			_q.withSyncRuns != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*LdapProvider).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &LdapProvider{config: _q.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, _q.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := _q.withLdapAccounts; query != nil {
+		if err := _q.loadLdapAccounts(ctx, query, nodes,
+			func(n *LdapProvider) { n.Edges.LdapAccounts = []*LdapAccount{} },
+			func(n *LdapProvider, e *LdapAccount) { n.Edges.LdapAccounts = append(n.Edges.LdapAccounts, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withLdapDepartments; query != nil {
+		if err := _q.loadLdapDepartments(ctx, query, nodes,
+			func(n *LdapProvider) { n.Edges.LdapDepartments = []*LdapDepartment{} },
+			func(n *LdapProvider, e *LdapDepartment) { n.Edges.LdapDepartments = append(n.Edges.LdapDepartments, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withSyncRuns; query != nil {
+		if err := _q.loadSyncRuns(ctx, query, nodes,
+			func(n *LdapProvider) { n.Edges.SyncRuns = []*LdapSyncRun{} },
+			func(n *LdapProvider, e *LdapSyncRun) { n.Edges.SyncRuns = append(n.Edges.SyncRuns, e) }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (_q *LdapProviderQuery) loadLdapAccounts(ctx context.Context, query *LdapAccountQuery, nodes []*LdapProvider, init func(*LdapProvider), assign func(*LdapProvider, *LdapAccount)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uint64]*LdapProvider)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(ldapaccount.FieldProviderID)
+	}
+	query.Where(predicate.LdapAccount(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(ldapprovider.LdapAccountsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ProviderID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "provider_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *LdapProviderQuery) loadLdapDepartments(ctx context.Context, query *LdapDepartmentQuery, nodes []*LdapProvider, init func(*LdapProvider), assign func(*LdapProvider, *LdapDepartment)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uint64]*LdapProvider)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(ldapdepartment.FieldProviderID)
+	}
+	query.Where(predicate.LdapDepartment(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(ldapprovider.LdapDepartmentsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ProviderID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "provider_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *LdapProviderQuery) loadSyncRuns(ctx context.Context, query *LdapSyncRunQuery, nodes []*LdapProvider, init func(*LdapProvider), assign func(*LdapProvider, *LdapSyncRun)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uint64]*LdapProvider)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(ldapsyncrun.FieldProviderID)
+	}
+	query.Where(predicate.LdapSyncRun(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(ldapprovider.SyncRunsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ProviderID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "provider_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
+func (_q *LdapProviderQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := _q.querySpec()
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
+	_spec.Node.Columns = _q.ctx.Fields
+	if len(_q.ctx.Fields) > 0 {
+		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, _q.driver, _spec)
+}
+
+func (_q *LdapProviderQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(ldapprovider.Table, ldapprovider.Columns, sqlgraph.NewFieldSpec(ldapprovider.FieldID, field.TypeUint64))
+	_spec.From = _q.sql
+	if unique := _q.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if _q.path != nil {
+		_spec.Unique = true
+	}
+	if fields := _q.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, ldapprovider.FieldID)
+		for i := range fields {
+			if fields[i] != ldapprovider.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := _q.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := _q.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (_q *LdapProviderQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(_q.driver.Dialect())
+	t1 := builder.Table(ldapprovider.Table)
+	columns := _q.ctx.Fields
+	if len(columns) == 0 {
+		columns = ldapprovider.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if _q.sql != nil {
+		selector = _q.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if _q.ctx.Unique != nil && *_q.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range _q.modifiers {
+		m(selector)
+	}
+	for _, p := range _q.predicates {
+		p(selector)
+	}
+	for _, p := range _q.order {
+		p(selector)
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_q *LdapProviderQuery) Modify(modifiers ...func(s *sql.Selector)) *LdapProviderSelect {
+	_q.modifiers = append(_q.modifiers, modifiers...)
+	return _q.Select()
+}
+
+// LdapProviderGroupBy is the group-by builder for LdapProvider entities.
+type LdapProviderGroupBy struct {
+	selector
+	build *LdapProviderQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (_g *LdapProviderGroupBy) Aggregate(fns ...AggregateFunc) *LdapProviderGroupBy {
+	_g.fns = append(_g.fns, fns...)
+	return _g
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_g *LdapProviderGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _g.build.ctx, ent.OpQueryGroupBy)
+	if err := _g.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*LdapProviderQuery, *LdapProviderGroupBy](ctx, _g.build, _g, _g.build.inters, v)
+}
+
+func (_g *LdapProviderGroupBy) sqlScan(ctx context.Context, root *LdapProviderQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(_g.fns))
+	for _, fn := range _g.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*_g.flds)+len(_g.fns))
+		for _, f := range *_g.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// LdapProviderSelect is the builder for selecting fields of LdapProvider entities.
+type LdapProviderSelect struct {
+	*LdapProviderQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (_s *LdapProviderSelect) Aggregate(fns ...AggregateFunc) *LdapProviderSelect {
+	_s.fns = append(_s.fns, fns...)
+	return _s
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_s *LdapProviderSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _s.ctx, ent.OpQuerySelect)
+	if err := _s.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*LdapProviderQuery, *LdapProviderSelect](ctx, _s.LdapProviderQuery, _s, _s.inters, v)
+}
+
+func (_s *LdapProviderSelect) sqlScan(ctx context.Context, root *LdapProviderQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(_s.fns))
+	for _, fn := range _s.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*_s.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_s *LdapProviderSelect) Modify(modifiers ...func(s *sql.Selector)) *LdapProviderSelect {
+	_s.modifiers = append(_s.modifiers, modifiers...)
+	return _s
+}
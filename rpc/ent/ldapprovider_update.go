@@ -0,0 +1,2440 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/coder-lulu/newbee-core/rpc/ent/ldapaccount"
+	"github.com/coder-lulu/newbee-core/rpc/ent/ldapdepartment"
+	"github.com/coder-lulu/newbee-core/rpc/ent/ldapprovider"
+	"github.com/coder-lulu/newbee-core/rpc/ent/ldapsyncrun"
+	"github.com/coder-lulu/newbee-core/rpc/ent/predicate"
+)
+
+// LdapProviderUpdate is the builder for updating LdapProvider entities.
+type LdapProviderUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *LdapProviderMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the LdapProviderUpdate builder.
+func (_u *LdapProviderUpdate) Where(ps ...predicate.LdapProvider) *LdapProviderUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *LdapProviderUpdate) SetUpdatedAt(v time.Time) *LdapProviderUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *LdapProviderUpdate) SetStatus(v uint8) *LdapProviderUpdate {
+	_u.mutation.ResetStatus()
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *LdapProviderUpdate) SetNillableStatus(v *uint8) *LdapProviderUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// AddStatus adds value to the "status" field.
+func (_u *LdapProviderUpdate) AddStatus(v int8) *LdapProviderUpdate {
+	_u.mutation.AddStatus(v)
+	return _u
+}
+
+// ClearStatus clears the value of the "status" field.
+func (_u *LdapProviderUpdate) ClearStatus() *LdapProviderUpdate {
+	_u.mutation.ClearStatus()
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *LdapProviderUpdate) SetName(v string) *LdapProviderUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *LdapProviderUpdate) SetNillableName(v *string) *LdapProviderUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetDisplayName sets the "display_name" field.
+func (_u *LdapProviderUpdate) SetDisplayName(v string) *LdapProviderUpdate {
+	_u.mutation.SetDisplayName(v)
+	return _u
+}
+
+// SetNillableDisplayName sets the "display_name" field if the given value is not nil.
+func (_u *LdapProviderUpdate) SetNillableDisplayName(v *string) *LdapProviderUpdate {
+	if v != nil {
+		_u.SetDisplayName(*v)
+	}
+	return _u
+}
+
+// ClearDisplayName clears the value of the "display_name" field.
+func (_u *LdapProviderUpdate) ClearDisplayName() *LdapProviderUpdate {
+	_u.mutation.ClearDisplayName()
+	return _u
+}
+
+// SetDirectoryType sets the "directory_type" field.
+func (_u *LdapProviderUpdate) SetDirectoryType(v string) *LdapProviderUpdate {
+	_u.mutation.SetDirectoryType(v)
+	return _u
+}
+
+// SetNillableDirectoryType sets the "directory_type" field if the given value is not nil.
+func (_u *LdapProviderUpdate) SetNillableDirectoryType(v *string) *LdapProviderUpdate {
+	if v != nil {
+		_u.SetDirectoryType(*v)
+	}
+	return _u
+}
+
+// SetURL sets the "url" field.
+func (_u *LdapProviderUpdate) SetURL(v string) *LdapProviderUpdate {
+	_u.mutation.SetURL(v)
+	return _u
+}
+
+// SetNillableURL sets the "url" field if the given value is not nil.
+func (_u *LdapProviderUpdate) SetNillableURL(v *string) *LdapProviderUpdate {
+	if v != nil {
+		_u.SetURL(*v)
+	}
+	return _u
+}
+
+// SetStartTLS sets the "start_tls" field.
+func (_u *LdapProviderUpdate) SetStartTLS(v bool) *LdapProviderUpdate {
+	_u.mutation.SetStartTLS(v)
+	return _u
+}
+
+// SetNillableStartTLS sets the "start_tls" field if the given value is not nil.
+func (_u *LdapProviderUpdate) SetNillableStartTLS(v *bool) *LdapProviderUpdate {
+	if v != nil {
+		_u.SetStartTLS(*v)
+	}
+	return _u
+}
+
+// SetInsecureSkipVerify sets the "insecure_skip_verify" field.
+func (_u *LdapProviderUpdate) SetInsecureSkipVerify(v bool) *LdapProviderUpdate {
+	_u.mutation.SetInsecureSkipVerify(v)
+	return _u
+}
+
+// SetNillableInsecureSkipVerify sets the "insecure_skip_verify" field if the given value is not nil.
+func (_u *LdapProviderUpdate) SetNillableInsecureSkipVerify(v *bool) *LdapProviderUpdate {
+	if v != nil {
+		_u.SetInsecureSkipVerify(*v)
+	}
+	return _u
+}
+
+// SetBindDn sets the "bind_dn" field.
+func (_u *LdapProviderUpdate) SetBindDn(v string) *LdapProviderUpdate {
+	_u.mutation.SetBindDn(v)
+	return _u
+}
+
+// SetNillableBindDn sets the "bind_dn" field if the given value is not nil.
+func (_u *LdapProviderUpdate) SetNillableBindDn(v *string) *LdapProviderUpdate {
+	if v != nil {
+		_u.SetBindDn(*v)
+	}
+	return _u
+}
+
+// ClearBindDn clears the value of the "bind_dn" field.
+func (_u *LdapProviderUpdate) ClearBindDn() *LdapProviderUpdate {
+	_u.mutation.ClearBindDn()
+	return _u
+}
+
+// SetEncryptedBindPassword sets the "encrypted_bind_password" field.
+func (_u *LdapProviderUpdate) SetEncryptedBindPassword(v string) *LdapProviderUpdate {
+	_u.mutation.SetEncryptedBindPassword(v)
+	return _u
+}
+
+// SetNillableEncryptedBindPassword sets the "encrypted_bind_password" field if the given value is not nil.
+func (_u *LdapProviderUpdate) SetNillableEncryptedBindPassword(v *string) *LdapProviderUpdate {
+	if v != nil {
+		_u.SetEncryptedBindPassword(*v)
+	}
+	return _u
+}
+
+// ClearEncryptedBindPassword clears the value of the "encrypted_bind_password" field.
+func (_u *LdapProviderUpdate) ClearEncryptedBindPassword() *LdapProviderUpdate {
+	_u.mutation.ClearEncryptedBindPassword()
+	return _u
+}
+
+// SetEncryptionKeyID sets the "encryption_key_id" field.
+func (_u *LdapProviderUpdate) SetEncryptionKeyID(v string) *LdapProviderUpdate {
+	_u.mutation.SetEncryptionKeyID(v)
+	return _u
+}
+
+// SetNillableEncryptionKeyID sets the "encryption_key_id" field if the given value is not nil.
+func (_u *LdapProviderUpdate) SetNillableEncryptionKeyID(v *string) *LdapProviderUpdate {
+	if v != nil {
+		_u.SetEncryptionKeyID(*v)
+	}
+	return _u
+}
+
+// ClearEncryptionKeyID clears the value of the "encryption_key_id" field.
+func (_u *LdapProviderUpdate) ClearEncryptionKeyID() *LdapProviderUpdate {
+	_u.mutation.ClearEncryptionKeyID()
+	return _u
+}
+
+// SetBaseDn sets the "base_dn" field.
+func (_u *LdapProviderUpdate) SetBaseDn(v string) *LdapProviderUpdate {
+	_u.mutation.SetBaseDn(v)
+	return _u
+}
+
+// SetNillableBaseDn sets the "base_dn" field if the given value is not nil.
+func (_u *LdapProviderUpdate) SetNillableBaseDn(v *string) *LdapProviderUpdate {
+	if v != nil {
+		_u.SetBaseDn(*v)
+	}
+	return _u
+}
+
+// SetAuthMode sets the "auth_mode" field.
+func (_u *LdapProviderUpdate) SetAuthMode(v string) *LdapProviderUpdate {
+	_u.mutation.SetAuthMode(v)
+	return _u
+}
+
+// SetNillableAuthMode sets the "auth_mode" field if the given value is not nil.
+func (_u *LdapProviderUpdate) SetNillableAuthMode(v *string) *LdapProviderUpdate {
+	if v != nil {
+		_u.SetAuthMode(*v)
+	}
+	return _u
+}
+
+// SetUserDnTemplate sets the "user_dn_template" field.
+func (_u *LdapProviderUpdate) SetUserDnTemplate(v string) *LdapProviderUpdate {
+	_u.mutation.SetUserDnTemplate(v)
+	return _u
+}
+
+// SetNillableUserDnTemplate sets the "user_dn_template" field if the given value is not nil.
+func (_u *LdapProviderUpdate) SetNillableUserDnTemplate(v *string) *LdapProviderUpdate {
+	if v != nil {
+		_u.SetUserDnTemplate(*v)
+	}
+	return _u
+}
+
+// ClearUserDnTemplate clears the value of the "user_dn_template" field.
+func (_u *LdapProviderUpdate) ClearUserDnTemplate() *LdapProviderUpdate {
+	_u.mutation.ClearUserDnTemplate()
+	return _u
+}
+
+// SetUserFilter sets the "user_filter" field.
+func (_u *LdapProviderUpdate) SetUserFilter(v string) *LdapProviderUpdate {
+	_u.mutation.SetUserFilter(v)
+	return _u
+}
+
+// SetNillableUserFilter sets the "user_filter" field if the given value is not nil.
+func (_u *LdapProviderUpdate) SetNillableUserFilter(v *string) *LdapProviderUpdate {
+	if v != nil {
+		_u.SetUserFilter(*v)
+	}
+	return _u
+}
+
+// ClearUserFilter clears the value of the "user_filter" field.
+func (_u *LdapProviderUpdate) ClearUserFilter() *LdapProviderUpdate {
+	_u.mutation.ClearUserFilter()
+	return _u
+}
+
+// SetOuBaseDn sets the "ou_base_dn" field.
+func (_u *LdapProviderUpdate) SetOuBaseDn(v string) *LdapProviderUpdate {
+	_u.mutation.SetOuBaseDn(v)
+	return _u
+}
+
+// SetNillableOuBaseDn sets the "ou_base_dn" field if the given value is not nil.
+func (_u *LdapProviderUpdate) SetNillableOuBaseDn(v *string) *LdapProviderUpdate {
+	if v != nil {
+		_u.SetOuBaseDn(*v)
+	}
+	return _u
+}
+
+// ClearOuBaseDn clears the value of the "ou_base_dn" field.
+func (_u *LdapProviderUpdate) ClearOuBaseDn() *LdapProviderUpdate {
+	_u.mutation.ClearOuBaseDn()
+	return _u
+}
+
+// SetOuFilter sets the "ou_filter" field.
+func (_u *LdapProviderUpdate) SetOuFilter(v string) *LdapProviderUpdate {
+	_u.mutation.SetOuFilter(v)
+	return _u
+}
+
+// SetNillableOuFilter sets the "ou_filter" field if the given value is not nil.
+func (_u *LdapProviderUpdate) SetNillableOuFilter(v *string) *LdapProviderUpdate {
+	if v != nil {
+		_u.SetOuFilter(*v)
+	}
+	return _u
+}
+
+// ClearOuFilter clears the value of the "ou_filter" field.
+func (_u *LdapProviderUpdate) ClearOuFilter() *LdapProviderUpdate {
+	_u.mutation.ClearOuFilter()
+	return _u
+}
+
+// SetGroupBaseDn sets the "group_base_dn" field.
+func (_u *LdapProviderUpdate) SetGroupBaseDn(v string) *LdapProviderUpdate {
+	_u.mutation.SetGroupBaseDn(v)
+	return _u
+}
+
+// SetNillableGroupBaseDn sets the "group_base_dn" field if the given value is not nil.
+func (_u *LdapProviderUpdate) SetNillableGroupBaseDn(v *string) *LdapProviderUpdate {
+	if v != nil {
+		_u.SetGroupBaseDn(*v)
+	}
+	return _u
+}
+
+// ClearGroupBaseDn clears the value of the "group_base_dn" field.
+func (_u *LdapProviderUpdate) ClearGroupBaseDn() *LdapProviderUpdate {
+	_u.mutation.ClearGroupBaseDn()
+	return _u
+}
+
+// SetGroupFilter sets the "group_filter" field.
+func (_u *LdapProviderUpdate) SetGroupFilter(v string) *LdapProviderUpdate {
+	_u.mutation.SetGroupFilter(v)
+	return _u
+}
+
+// SetNillableGroupFilter sets the "group_filter" field if the given value is not nil.
+func (_u *LdapProviderUpdate) SetNillableGroupFilter(v *string) *LdapProviderUpdate {
+	if v != nil {
+		_u.SetGroupFilter(*v)
+	}
+	return _u
+}
+
+// ClearGroupFilter clears the value of the "group_filter" field.
+func (_u *LdapProviderUpdate) ClearGroupFilter() *LdapProviderUpdate {
+	_u.mutation.ClearGroupFilter()
+	return _u
+}
+
+// SetGroupMemberAttribute sets the "group_member_attribute" field.
+func (_u *LdapProviderUpdate) SetGroupMemberAttribute(v string) *LdapProviderUpdate {
+	_u.mutation.SetGroupMemberAttribute(v)
+	return _u
+}
+
+// SetNillableGroupMemberAttribute sets the "group_member_attribute" field if the given value is not nil.
+func (_u *LdapProviderUpdate) SetNillableGroupMemberAttribute(v *string) *LdapProviderUpdate {
+	if v != nil {
+		_u.SetGroupMemberAttribute(*v)
+	}
+	return _u
+}
+
+// ClearGroupMemberAttribute clears the value of the "group_member_attribute" field.
+func (_u *LdapProviderUpdate) ClearGroupMemberAttribute() *LdapProviderUpdate {
+	_u.mutation.ClearGroupMemberAttribute()
+	return _u
+}
+
+// SetAttributeMapping sets the "attribute_mapping" field.
+func (_u *LdapProviderUpdate) SetAttributeMapping(v map[string]interface{}) *LdapProviderUpdate {
+	_u.mutation.SetAttributeMapping(v)
+	return _u
+}
+
+// ClearAttributeMapping clears the value of the "attribute_mapping" field.
+func (_u *LdapProviderUpdate) ClearAttributeMapping() *LdapProviderUpdate {
+	_u.mutation.ClearAttributeMapping()
+	return _u
+}
+
+// SetLoginEnabled sets the "login_enabled" field.
+func (_u *LdapProviderUpdate) SetLoginEnabled(v bool) *LdapProviderUpdate {
+	_u.mutation.SetLoginEnabled(v)
+	return _u
+}
+
+// SetNillableLoginEnabled sets the "login_enabled" field if the given value is not nil.
+func (_u *LdapProviderUpdate) SetNillableLoginEnabled(v *bool) *LdapProviderUpdate {
+	if v != nil {
+		_u.SetLoginEnabled(*v)
+	}
+	return _u
+}
+
+// SetJitProvision sets the "jit_provision" field.
+func (_u *LdapProviderUpdate) SetJitProvision(v bool) *LdapProviderUpdate {
+	_u.mutation.SetJitProvision(v)
+	return _u
+}
+
+// SetNillableJitProvision sets the "jit_provision" field if the given value is not nil.
+func (_u *LdapProviderUpdate) SetNillableJitProvision(v *bool) *LdapProviderUpdate {
+	if v != nil {
+		_u.SetJitProvision(*v)
+	}
+	return _u
+}
+
+// SetLinkExisting sets the "link_existing" field.
+func (_u *LdapProviderUpdate) SetLinkExisting(v bool) *LdapProviderUpdate {
+	_u.mutation.SetLinkExisting(v)
+	return _u
+}
+
+// SetNillableLinkExisting sets the "link_existing" field if the given value is not nil.
+func (_u *LdapProviderUpdate) SetNillableLinkExisting(v *bool) *LdapProviderUpdate {
+	if v != nil {
+		_u.SetLinkExisting(*v)
+	}
+	return _u
+}
+
+// SetSyncEnabled sets the "sync_enabled" field.
+func (_u *LdapProviderUpdate) SetSyncEnabled(v bool) *LdapProviderUpdate {
+	_u.mutation.SetSyncEnabled(v)
+	return _u
+}
+
+// SetNillableSyncEnabled sets the "sync_enabled" field if the given value is not nil.
+func (_u *LdapProviderUpdate) SetNillableSyncEnabled(v *bool) *LdapProviderUpdate {
+	if v != nil {
+		_u.SetSyncEnabled(*v)
+	}
+	return _u
+}
+
+// SetSyncInterval sets the "sync_interval" field.
+func (_u *LdapProviderUpdate) SetSyncInterval(v uint32) *LdapProviderUpdate {
+	_u.mutation.ResetSyncInterval()
+	_u.mutation.SetSyncInterval(v)
+	return _u
+}
+
+// SetNillableSyncInterval sets the "sync_interval" field if the given value is not nil.
+func (_u *LdapProviderUpdate) SetNillableSyncInterval(v *uint32) *LdapProviderUpdate {
+	if v != nil {
+		_u.SetSyncInterval(*v)
+	}
+	return _u
+}
+
+// AddSyncInterval adds value to the "sync_interval" field.
+func (_u *LdapProviderUpdate) AddSyncInterval(v int32) *LdapProviderUpdate {
+	_u.mutation.AddSyncInterval(v)
+	return _u
+}
+
+// SetDeletionPolicy sets the "deletion_policy" field.
+func (_u *LdapProviderUpdate) SetDeletionPolicy(v string) *LdapProviderUpdate {
+	_u.mutation.SetDeletionPolicy(v)
+	return _u
+}
+
+// SetNillableDeletionPolicy sets the "deletion_policy" field if the given value is not nil.
+func (_u *LdapProviderUpdate) SetNillableDeletionPolicy(v *string) *LdapProviderUpdate {
+	if v != nil {
+		_u.SetDeletionPolicy(*v)
+	}
+	return _u
+}
+
+// SetRootDepartmentID sets the "root_department_id" field.
+func (_u *LdapProviderUpdate) SetRootDepartmentID(v uint64) *LdapProviderUpdate {
+	_u.mutation.ResetRootDepartmentID()
+	_u.mutation.SetRootDepartmentID(v)
+	return _u
+}
+
+// SetNillableRootDepartmentID sets the "root_department_id" field if the given value is not nil.
+func (_u *LdapProviderUpdate) SetNillableRootDepartmentID(v *uint64) *LdapProviderUpdate {
+	if v != nil {
+		_u.SetRootDepartmentID(*v)
+	}
+	return _u
+}
+
+// AddRootDepartmentID adds value to the "root_department_id" field.
+func (_u *LdapProviderUpdate) AddRootDepartmentID(v int64) *LdapProviderUpdate {
+	_u.mutation.AddRootDepartmentID(v)
+	return _u
+}
+
+// ClearRootDepartmentID clears the value of the "root_department_id" field.
+func (_u *LdapProviderUpdate) ClearRootDepartmentID() *LdapProviderUpdate {
+	_u.mutation.ClearRootDepartmentID()
+	return _u
+}
+
+// SetDefaultDepartmentID sets the "default_department_id" field.
+func (_u *LdapProviderUpdate) SetDefaultDepartmentID(v uint64) *LdapProviderUpdate {
+	_u.mutation.ResetDefaultDepartmentID()
+	_u.mutation.SetDefaultDepartmentID(v)
+	return _u
+}
+
+// SetNillableDefaultDepartmentID sets the "default_department_id" field if the given value is not nil.
+func (_u *LdapProviderUpdate) SetNillableDefaultDepartmentID(v *uint64) *LdapProviderUpdate {
+	if v != nil {
+		_u.SetDefaultDepartmentID(*v)
+	}
+	return _u
+}
+
+// AddDefaultDepartmentID adds value to the "default_department_id" field.
+func (_u *LdapProviderUpdate) AddDefaultDepartmentID(v int64) *LdapProviderUpdate {
+	_u.mutation.AddDefaultDepartmentID(v)
+	return _u
+}
+
+// ClearDefaultDepartmentID clears the value of the "default_department_id" field.
+func (_u *LdapProviderUpdate) ClearDefaultDepartmentID() *LdapProviderUpdate {
+	_u.mutation.ClearDefaultDepartmentID()
+	return _u
+}
+
+// SetDefaultRoleIds sets the "default_role_ids" field.
+func (_u *LdapProviderUpdate) SetDefaultRoleIds(v []uint64) *LdapProviderUpdate {
+	_u.mutation.SetDefaultRoleIds(v)
+	return _u
+}
+
+// AppendDefaultRoleIds appends value to the "default_role_ids" field.
+func (_u *LdapProviderUpdate) AppendDefaultRoleIds(v []uint64) *LdapProviderUpdate {
+	_u.mutation.AppendDefaultRoleIds(v)
+	return _u
+}
+
+// ClearDefaultRoleIds clears the value of the "default_role_ids" field.
+func (_u *LdapProviderUpdate) ClearDefaultRoleIds() *LdapProviderUpdate {
+	_u.mutation.ClearDefaultRoleIds()
+	return _u
+}
+
+// SetLastSyncAt sets the "last_sync_at" field.
+func (_u *LdapProviderUpdate) SetLastSyncAt(v time.Time) *LdapProviderUpdate {
+	_u.mutation.SetLastSyncAt(v)
+	return _u
+}
+
+// SetNillableLastSyncAt sets the "last_sync_at" field if the given value is not nil.
+func (_u *LdapProviderUpdate) SetNillableLastSyncAt(v *time.Time) *LdapProviderUpdate {
+	if v != nil {
+		_u.SetLastSyncAt(*v)
+	}
+	return _u
+}
+
+// ClearLastSyncAt clears the value of the "last_sync_at" field.
+func (_u *LdapProviderUpdate) ClearLastSyncAt() *LdapProviderUpdate {
+	_u.mutation.ClearLastSyncAt()
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *LdapProviderUpdate) SetEnabled(v bool) *LdapProviderUpdate {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *LdapProviderUpdate) SetNillableEnabled(v *bool) *LdapProviderUpdate {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// SetSort sets the "sort" field.
+func (_u *LdapProviderUpdate) SetSort(v uint32) *LdapProviderUpdate {
+	_u.mutation.ResetSort()
+	_u.mutation.SetSort(v)
+	return _u
+}
+
+// SetNillableSort sets the "sort" field if the given value is not nil.
+func (_u *LdapProviderUpdate) SetNillableSort(v *uint32) *LdapProviderUpdate {
+	if v != nil {
+		_u.SetSort(*v)
+	}
+	return _u
+}
+
+// AddSort adds value to the "sort" field.
+func (_u *LdapProviderUpdate) AddSort(v int32) *LdapProviderUpdate {
+	_u.mutation.AddSort(v)
+	return _u
+}
+
+// SetRemark sets the "remark" field.
+func (_u *LdapProviderUpdate) SetRemark(v string) *LdapProviderUpdate {
+	_u.mutation.SetRemark(v)
+	return _u
+}
+
+// SetNillableRemark sets the "remark" field if the given value is not nil.
+func (_u *LdapProviderUpdate) SetNillableRemark(v *string) *LdapProviderUpdate {
+	if v != nil {
+		_u.SetRemark(*v)
+	}
+	return _u
+}
+
+// ClearRemark clears the value of the "remark" field.
+func (_u *LdapProviderUpdate) ClearRemark() *LdapProviderUpdate {
+	_u.mutation.ClearRemark()
+	return _u
+}
+
+// AddLdapAccountIDs adds the "ldap_accounts" edge to the LdapAccount entity by IDs.
+func (_u *LdapProviderUpdate) AddLdapAccountIDs(ids ...uint64) *LdapProviderUpdate {
+	_u.mutation.AddLdapAccountIDs(ids...)
+	return _u
+}
+
+// AddLdapAccounts adds the "ldap_accounts" edges to the LdapAccount entity.
+func (_u *LdapProviderUpdate) AddLdapAccounts(v ...*LdapAccount) *LdapProviderUpdate {
+	ids := make([]uint64, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddLdapAccountIDs(ids...)
+}
+
+// AddLdapDepartmentIDs adds the "ldap_departments" edge to the LdapDepartment entity by IDs.
+func (_u *LdapProviderUpdate) AddLdapDepartmentIDs(ids ...uint64) *LdapProviderUpdate {
+	_u.mutation.AddLdapDepartmentIDs(ids...)
+	return _u
+}
+
+// AddLdapDepartments adds the "ldap_departments" edges to the LdapDepartment entity.
+func (_u *LdapProviderUpdate) AddLdapDepartments(v ...*LdapDepartment) *LdapProviderUpdate {
+	ids := make([]uint64, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddLdapDepartmentIDs(ids...)
+}
+
+// AddSyncRunIDs adds the "sync_runs" edge to the LdapSyncRun entity by IDs.
+func (_u *LdapProviderUpdate) AddSyncRunIDs(ids ...uint64) *LdapProviderUpdate {
+	_u.mutation.AddSyncRunIDs(ids...)
+	return _u
+}
+
+// AddSyncRuns adds the "sync_runs" edges to the LdapSyncRun entity.
+func (_u *LdapProviderUpdate) AddSyncRuns(v ...*LdapSyncRun) *LdapProviderUpdate {
+	ids := make([]uint64, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSyncRunIDs(ids...)
+}
+
+// Mutation returns the LdapProviderMutation object of the builder.
+func (_u *LdapProviderUpdate) Mutation() *LdapProviderMutation {
+	return _u.mutation
+}
+
+// ClearLdapAccounts clears all "ldap_accounts" edges to the LdapAccount entity.
+func (_u *LdapProviderUpdate) ClearLdapAccounts() *LdapProviderUpdate {
+	_u.mutation.ClearLdapAccounts()
+	return _u
+}
+
+// RemoveLdapAccountIDs removes the "ldap_accounts" edge to LdapAccount entities by IDs.
+func (_u *LdapProviderUpdate) RemoveLdapAccountIDs(ids ...uint64) *LdapProviderUpdate {
+	_u.mutation.RemoveLdapAccountIDs(ids...)
+	return _u
+}
+
+// RemoveLdapAccounts removes "ldap_accounts" edges to LdapAccount entities.
+func (_u *LdapProviderUpdate) RemoveLdapAccounts(v ...*LdapAccount) *LdapProviderUpdate {
+	ids := make([]uint64, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveLdapAccountIDs(ids...)
+}
+
+// ClearLdapDepartments clears all "ldap_departments" edges to the LdapDepartment entity.
+func (_u *LdapProviderUpdate) ClearLdapDepartments() *LdapProviderUpdate {
+	_u.mutation.ClearLdapDepartments()
+	return _u
+}
+
+// RemoveLdapDepartmentIDs removes the "ldap_departments" edge to LdapDepartment entities by IDs.
+func (_u *LdapProviderUpdate) RemoveLdapDepartmentIDs(ids ...uint64) *LdapProviderUpdate {
+	_u.mutation.RemoveLdapDepartmentIDs(ids...)
+	return _u
+}
+
+// RemoveLdapDepartments removes "ldap_departments" edges to LdapDepartment entities.
+func (_u *LdapProviderUpdate) RemoveLdapDepartments(v ...*LdapDepartment) *LdapProviderUpdate {
+	ids := make([]uint64, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveLdapDepartmentIDs(ids...)
+}
+
+// ClearSyncRuns clears all "sync_runs" edges to the LdapSyncRun entity.
+func (_u *LdapProviderUpdate) ClearSyncRuns() *LdapProviderUpdate {
+	_u.mutation.ClearSyncRuns()
+	return _u
+}
+
+// RemoveSyncRunIDs removes the "sync_runs" edge to LdapSyncRun entities by IDs.
+func (_u *LdapProviderUpdate) RemoveSyncRunIDs(ids ...uint64) *LdapProviderUpdate {
+	_u.mutation.RemoveSyncRunIDs(ids...)
+	return _u
+}
+
+// RemoveSyncRuns removes "sync_runs" edges to LdapSyncRun entities.
+func (_u *LdapProviderUpdate) RemoveSyncRuns(v ...*LdapSyncRun) *LdapProviderUpdate {
+	ids := make([]uint64, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSyncRunIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *LdapProviderUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *LdapProviderUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *LdapProviderUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *LdapProviderUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *LdapProviderUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := ldapprovider.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *LdapProviderUpdate) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := ldapprovider.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "LdapProvider.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DisplayName(); ok {
+		if err := ldapprovider.DisplayNameValidator(v); err != nil {
+			return &ValidationError{Name: "display_name", err: fmt.Errorf(`ent: validator failed for field "LdapProvider.display_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DirectoryType(); ok {
+		if err := ldapprovider.DirectoryTypeValidator(v); err != nil {
+			return &ValidationError{Name: "directory_type", err: fmt.Errorf(`ent: validator failed for field "LdapProvider.directory_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.URL(); ok {
+		if err := ldapprovider.URLValidator(v); err != nil {
+			return &ValidationError{Name: "url", err: fmt.Errorf(`ent: validator failed for field "LdapProvider.url": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.BindDn(); ok {
+		if err := ldapprovider.BindDnValidator(v); err != nil {
+			return &ValidationError{Name: "bind_dn", err: fmt.Errorf(`ent: validator failed for field "LdapProvider.bind_dn": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.EncryptionKeyID(); ok {
+		if err := ldapprovider.EncryptionKeyIDValidator(v); err != nil {
+			return &ValidationError{Name: "encryption_key_id", err: fmt.Errorf(`ent: validator failed for field "LdapProvider.encryption_key_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.BaseDn(); ok {
+		if err := ldapprovider.BaseDnValidator(v); err != nil {
+			return &ValidationError{Name: "base_dn", err: fmt.Errorf(`ent: validator failed for field "LdapProvider.base_dn": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AuthMode(); ok {
+		if err := ldapprovider.AuthModeValidator(v); err != nil {
+			return &ValidationError{Name: "auth_mode", err: fmt.Errorf(`ent: validator failed for field "LdapProvider.auth_mode": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.UserDnTemplate(); ok {
+		if err := ldapprovider.UserDnTemplateValidator(v); err != nil {
+			return &ValidationError{Name: "user_dn_template", err: fmt.Errorf(`ent: validator failed for field "LdapProvider.user_dn_template": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.UserFilter(); ok {
+		if err := ldapprovider.UserFilterValidator(v); err != nil {
+			return &ValidationError{Name: "user_filter", err: fmt.Errorf(`ent: validator failed for field "LdapProvider.user_filter": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.OuBaseDn(); ok {
+		if err := ldapprovider.OuBaseDnValidator(v); err != nil {
+			return &ValidationError{Name: "ou_base_dn", err: fmt.Errorf(`ent: validator failed for field "LdapProvider.ou_base_dn": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.OuFilter(); ok {
+		if err := ldapprovider.OuFilterValidator(v); err != nil {
+			return &ValidationError{Name: "ou_filter", err: fmt.Errorf(`ent: validator failed for field "LdapProvider.ou_filter": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.GroupBaseDn(); ok {
+		if err := ldapprovider.GroupBaseDnValidator(v); err != nil {
+			return &ValidationError{Name: "group_base_dn", err: fmt.Errorf(`ent: validator failed for field "LdapProvider.group_base_dn": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.GroupFilter(); ok {
+		if err := ldapprovider.GroupFilterValidator(v); err != nil {
+			return &ValidationError{Name: "group_filter", err: fmt.Errorf(`ent: validator failed for field "LdapProvider.group_filter": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.GroupMemberAttribute(); ok {
+		if err := ldapprovider.GroupMemberAttributeValidator(v); err != nil {
+			return &ValidationError{Name: "group_member_attribute", err: fmt.Errorf(`ent: validator failed for field "LdapProvider.group_member_attribute": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DeletionPolicy(); ok {
+		if err := ldapprovider.DeletionPolicyValidator(v); err != nil {
+			return &ValidationError{Name: "deletion_policy", err: fmt.Errorf(`ent: validator failed for field "LdapProvider.deletion_policy": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Remark(); ok {
+		if err := ldapprovider.RemarkValidator(v); err != nil {
+			return &ValidationError{Name: "remark", err: fmt.Errorf(`ent: validator failed for field "LdapProvider.remark": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *LdapProviderUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *LdapProviderUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *LdapProviderUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(ldapprovider.Table, ldapprovider.Columns, sqlgraph.NewFieldSpec(ldapprovider.FieldID, field.TypeUint64))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(ldapprovider.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(ldapprovider.FieldStatus, field.TypeUint8, value)
+	}
+	if value, ok := _u.mutation.AddedStatus(); ok {
+		_spec.AddField(ldapprovider.FieldStatus, field.TypeUint8, value)
+	}
+	if _u.mutation.StatusCleared() {
+		_spec.ClearField(ldapprovider.FieldStatus, field.TypeUint8)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(ldapprovider.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.DisplayName(); ok {
+		_spec.SetField(ldapprovider.FieldDisplayName, field.TypeString, value)
+	}
+	if _u.mutation.DisplayNameCleared() {
+		_spec.ClearField(ldapprovider.FieldDisplayName, field.TypeString)
+	}
+	if value, ok := _u.mutation.DirectoryType(); ok {
+		_spec.SetField(ldapprovider.FieldDirectoryType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.URL(); ok {
+		_spec.SetField(ldapprovider.FieldURL, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.StartTLS(); ok {
+		_spec.SetField(ldapprovider.FieldStartTLS, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.InsecureSkipVerify(); ok {
+		_spec.SetField(ldapprovider.FieldInsecureSkipVerify, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.BindDn(); ok {
+		_spec.SetField(ldapprovider.FieldBindDn, field.TypeString, value)
+	}
+	if _u.mutation.BindDnCleared() {
+		_spec.ClearField(ldapprovider.FieldBindDn, field.TypeString)
+	}
+	if value, ok := _u.mutation.EncryptedBindPassword(); ok {
+		_spec.SetField(ldapprovider.FieldEncryptedBindPassword, field.TypeString, value)
+	}
+	if _u.mutation.EncryptedBindPasswordCleared() {
+		_spec.ClearField(ldapprovider.FieldEncryptedBindPassword, field.TypeString)
+	}
+	if value, ok := _u.mutation.EncryptionKeyID(); ok {
+		_spec.SetField(ldapprovider.FieldEncryptionKeyID, field.TypeString, value)
+	}
+	if _u.mutation.EncryptionKeyIDCleared() {
+		_spec.ClearField(ldapprovider.FieldEncryptionKeyID, field.TypeString)
+	}
+	if value, ok := _u.mutation.BaseDn(); ok {
+		_spec.SetField(ldapprovider.FieldBaseDn, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AuthMode(); ok {
+		_spec.SetField(ldapprovider.FieldAuthMode, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UserDnTemplate(); ok {
+		_spec.SetField(ldapprovider.FieldUserDnTemplate, field.TypeString, value)
+	}
+	if _u.mutation.UserDnTemplateCleared() {
+		_spec.ClearField(ldapprovider.FieldUserDnTemplate, field.TypeString)
+	}
+	if value, ok := _u.mutation.UserFilter(); ok {
+		_spec.SetField(ldapprovider.FieldUserFilter, field.TypeString, value)
+	}
+	if _u.mutation.UserFilterCleared() {
+		_spec.ClearField(ldapprovider.FieldUserFilter, field.TypeString)
+	}
+	if value, ok := _u.mutation.OuBaseDn(); ok {
+		_spec.SetField(ldapprovider.FieldOuBaseDn, field.TypeString, value)
+	}
+	if _u.mutation.OuBaseDnCleared() {
+		_spec.ClearField(ldapprovider.FieldOuBaseDn, field.TypeString)
+	}
+	if value, ok := _u.mutation.OuFilter(); ok {
+		_spec.SetField(ldapprovider.FieldOuFilter, field.TypeString, value)
+	}
+	if _u.mutation.OuFilterCleared() {
+		_spec.ClearField(ldapprovider.FieldOuFilter, field.TypeString)
+	}
+	if value, ok := _u.mutation.GroupBaseDn(); ok {
+		_spec.SetField(ldapprovider.FieldGroupBaseDn, field.TypeString, value)
+	}
+	if _u.mutation.GroupBaseDnCleared() {
+		_spec.ClearField(ldapprovider.FieldGroupBaseDn, field.TypeString)
+	}
+	if value, ok := _u.mutation.GroupFilter(); ok {
+		_spec.SetField(ldapprovider.FieldGroupFilter, field.TypeString, value)
+	}
+	if _u.mutation.GroupFilterCleared() {
+		_spec.ClearField(ldapprovider.FieldGroupFilter, field.TypeString)
+	}
+	if value, ok := _u.mutation.GroupMemberAttribute(); ok {
+		_spec.SetField(ldapprovider.FieldGroupMemberAttribute, field.TypeString, value)
+	}
+	if _u.mutation.GroupMemberAttributeCleared() {
+		_spec.ClearField(ldapprovider.FieldGroupMemberAttribute, field.TypeString)
+	}
+	if value, ok := _u.mutation.AttributeMapping(); ok {
+		_spec.SetField(ldapprovider.FieldAttributeMapping, field.TypeJSON, value)
+	}
+	if _u.mutation.AttributeMappingCleared() {
+		_spec.ClearField(ldapprovider.FieldAttributeMapping, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.LoginEnabled(); ok {
+		_spec.SetField(ldapprovider.FieldLoginEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.JitProvision(); ok {
+		_spec.SetField(ldapprovider.FieldJitProvision, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.LinkExisting(); ok {
+		_spec.SetField(ldapprovider.FieldLinkExisting, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.SyncEnabled(); ok {
+		_spec.SetField(ldapprovider.FieldSyncEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.SyncInterval(); ok {
+		_spec.SetField(ldapprovider.FieldSyncInterval, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedSyncInterval(); ok {
+		_spec.AddField(ldapprovider.FieldSyncInterval, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.DeletionPolicy(); ok {
+		_spec.SetField(ldapprovider.FieldDeletionPolicy, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.RootDepartmentID(); ok {
+		_spec.SetField(ldapprovider.FieldRootDepartmentID, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.AddedRootDepartmentID(); ok {
+		_spec.AddField(ldapprovider.FieldRootDepartmentID, field.TypeUint64, value)
+	}
+	if _u.mutation.RootDepartmentIDCleared() {
+		_spec.ClearField(ldapprovider.FieldRootDepartmentID, field.TypeUint64)
+	}
+	if value, ok := _u.mutation.DefaultDepartmentID(); ok {
+		_spec.SetField(ldapprovider.FieldDefaultDepartmentID, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.AddedDefaultDepartmentID(); ok {
+		_spec.AddField(ldapprovider.FieldDefaultDepartmentID, field.TypeUint64, value)
+	}
+	if _u.mutation.DefaultDepartmentIDCleared() {
+		_spec.ClearField(ldapprovider.FieldDefaultDepartmentID, field.TypeUint64)
+	}
+	if value, ok := _u.mutation.DefaultRoleIds(); ok {
+		_spec.SetField(ldapprovider.FieldDefaultRoleIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedDefaultRoleIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, ldapprovider.FieldDefaultRoleIds, value)
+		})
+	}
+	if _u.mutation.DefaultRoleIdsCleared() {
+		_spec.ClearField(ldapprovider.FieldDefaultRoleIds, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.LastSyncAt(); ok {
+		_spec.SetField(ldapprovider.FieldLastSyncAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastSyncAtCleared() {
+		_spec.ClearField(ldapprovider.FieldLastSyncAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(ldapprovider.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Sort(); ok {
+		_spec.SetField(ldapprovider.FieldSort, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedSort(); ok {
+		_spec.AddField(ldapprovider.FieldSort, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.Remark(); ok {
+		_spec.SetField(ldapprovider.FieldRemark, field.TypeString, value)
+	}
+	if _u.mutation.RemarkCleared() {
+		_spec.ClearField(ldapprovider.FieldRemark, field.TypeString)
+	}
+	if _u.mutation.LdapAccountsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   ldapprovider.LdapAccountsTable,
+			Columns: []string{ldapprovider.LdapAccountsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ldapaccount.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedLdapAccountsIDs(); len(nodes) > 0 && !_u.mutation.LdapAccountsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   ldapprovider.LdapAccountsTable,
+			Columns: []string{ldapprovider.LdapAccountsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ldapaccount.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LdapAccountsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   ldapprovider.LdapAccountsTable,
+			Columns: []string{ldapprovider.LdapAccountsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ldapaccount.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.LdapDepartmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   ldapprovider.LdapDepartmentsTable,
+			Columns: []string{ldapprovider.LdapDepartmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ldapdepartment.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedLdapDepartmentsIDs(); len(nodes) > 0 && !_u.mutation.LdapDepartmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   ldapprovider.LdapDepartmentsTable,
+			Columns: []string{ldapprovider.LdapDepartmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ldapdepartment.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LdapDepartmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   ldapprovider.LdapDepartmentsTable,
+			Columns: []string{ldapprovider.LdapDepartmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ldapdepartment.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.SyncRunsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   ldapprovider.SyncRunsTable,
+			Columns: []string{ldapprovider.SyncRunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ldapsyncrun.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSyncRunsIDs(); len(nodes) > 0 && !_u.mutation.SyncRunsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   ldapprovider.SyncRunsTable,
+			Columns: []string{ldapprovider.SyncRunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ldapsyncrun.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SyncRunsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   ldapprovider.SyncRunsTable,
+			Columns: []string{ldapprovider.SyncRunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ldapsyncrun.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{ldapprovider.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// LdapProviderUpdateOne is the builder for updating a single LdapProvider entity.
+type LdapProviderUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *LdapProviderMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *LdapProviderUpdateOne) SetUpdatedAt(v time.Time) *LdapProviderUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *LdapProviderUpdateOne) SetStatus(v uint8) *LdapProviderUpdateOne {
+	_u.mutation.ResetStatus()
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *LdapProviderUpdateOne) SetNillableStatus(v *uint8) *LdapProviderUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// AddStatus adds value to the "status" field.
+func (_u *LdapProviderUpdateOne) AddStatus(v int8) *LdapProviderUpdateOne {
+	_u.mutation.AddStatus(v)
+	return _u
+}
+
+// ClearStatus clears the value of the "status" field.
+func (_u *LdapProviderUpdateOne) ClearStatus() *LdapProviderUpdateOne {
+	_u.mutation.ClearStatus()
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *LdapProviderUpdateOne) SetName(v string) *LdapProviderUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *LdapProviderUpdateOne) SetNillableName(v *string) *LdapProviderUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetDisplayName sets the "display_name" field.
+func (_u *LdapProviderUpdateOne) SetDisplayName(v string) *LdapProviderUpdateOne {
+	_u.mutation.SetDisplayName(v)
+	return _u
+}
+
+// SetNillableDisplayName sets the "display_name" field if the given value is not nil.
+func (_u *LdapProviderUpdateOne) SetNillableDisplayName(v *string) *LdapProviderUpdateOne {
+	if v != nil {
+		_u.SetDisplayName(*v)
+	}
+	return _u
+}
+
+// ClearDisplayName clears the value of the "display_name" field.
+func (_u *LdapProviderUpdateOne) ClearDisplayName() *LdapProviderUpdateOne {
+	_u.mutation.ClearDisplayName()
+	return _u
+}
+
+// SetDirectoryType sets the "directory_type" field.
+func (_u *LdapProviderUpdateOne) SetDirectoryType(v string) *LdapProviderUpdateOne {
+	_u.mutation.SetDirectoryType(v)
+	return _u
+}
+
+// SetNillableDirectoryType sets the "directory_type" field if the given value is not nil.
+func (_u *LdapProviderUpdateOne) SetNillableDirectoryType(v *string) *LdapProviderUpdateOne {
+	if v != nil {
+		_u.SetDirectoryType(*v)
+	}
+	return _u
+}
+
+// SetURL sets the "url" field.
+func (_u *LdapProviderUpdateOne) SetURL(v string) *LdapProviderUpdateOne {
+	_u.mutation.SetURL(v)
+	return _u
+}
+
+// SetNillableURL sets the "url" field if the given value is not nil.
+func (_u *LdapProviderUpdateOne) SetNillableURL(v *string) *LdapProviderUpdateOne {
+	if v != nil {
+		_u.SetURL(*v)
+	}
+	return _u
+}
+
+// SetStartTLS sets the "start_tls" field.
+func (_u *LdapProviderUpdateOne) SetStartTLS(v bool) *LdapProviderUpdateOne {
+	_u.mutation.SetStartTLS(v)
+	return _u
+}
+
+// SetNillableStartTLS sets the "start_tls" field if the given value is not nil.
+func (_u *LdapProviderUpdateOne) SetNillableStartTLS(v *bool) *LdapProviderUpdateOne {
+	if v != nil {
+		_u.SetStartTLS(*v)
+	}
+	return _u
+}
+
+// SetInsecureSkipVerify sets the "insecure_skip_verify" field.
+func (_u *LdapProviderUpdateOne) SetInsecureSkipVerify(v bool) *LdapProviderUpdateOne {
+	_u.mutation.SetInsecureSkipVerify(v)
+	return _u
+}
+
+// SetNillableInsecureSkipVerify sets the "insecure_skip_verify" field if the given value is not nil.
+func (_u *LdapProviderUpdateOne) SetNillableInsecureSkipVerify(v *bool) *LdapProviderUpdateOne {
+	if v != nil {
+		_u.SetInsecureSkipVerify(*v)
+	}
+	return _u
+}
+
+// SetBindDn sets the "bind_dn" field.
+func (_u *LdapProviderUpdateOne) SetBindDn(v string) *LdapProviderUpdateOne {
+	_u.mutation.SetBindDn(v)
+	return _u
+}
+
+// SetNillableBindDn sets the "bind_dn" field if the given value is not nil.
+func (_u *LdapProviderUpdateOne) SetNillableBindDn(v *string) *LdapProviderUpdateOne {
+	if v != nil {
+		_u.SetBindDn(*v)
+	}
+	return _u
+}
+
+// ClearBindDn clears the value of the "bind_dn" field.
+func (_u *LdapProviderUpdateOne) ClearBindDn() *LdapProviderUpdateOne {
+	_u.mutation.ClearBindDn()
+	return _u
+}
+
+// SetEncryptedBindPassword sets the "encrypted_bind_password" field.
+func (_u *LdapProviderUpdateOne) SetEncryptedBindPassword(v string) *LdapProviderUpdateOne {
+	_u.mutation.SetEncryptedBindPassword(v)
+	return _u
+}
+
+// SetNillableEncryptedBindPassword sets the "encrypted_bind_password" field if the given value is not nil.
+func (_u *LdapProviderUpdateOne) SetNillableEncryptedBindPassword(v *string) *LdapProviderUpdateOne {
+	if v != nil {
+		_u.SetEncryptedBindPassword(*v)
+	}
+	return _u
+}
+
+// ClearEncryptedBindPassword clears the value of the "encrypted_bind_password" field.
+func (_u *LdapProviderUpdateOne) ClearEncryptedBindPassword() *LdapProviderUpdateOne {
+	_u.mutation.ClearEncryptedBindPassword()
+	return _u
+}
+
+// SetEncryptionKeyID sets the "encryption_key_id" field.
+func (_u *LdapProviderUpdateOne) SetEncryptionKeyID(v string) *LdapProviderUpdateOne {
+	_u.mutation.SetEncryptionKeyID(v)
+	return _u
+}
+
+// SetNillableEncryptionKeyID sets the "encryption_key_id" field if the given value is not nil.
+func (_u *LdapProviderUpdateOne) SetNillableEncryptionKeyID(v *string) *LdapProviderUpdateOne {
+	if v != nil {
+		_u.SetEncryptionKeyID(*v)
+	}
+	return _u
+}
+
+// ClearEncryptionKeyID clears the value of the "encryption_key_id" field.
+func (_u *LdapProviderUpdateOne) ClearEncryptionKeyID() *LdapProviderUpdateOne {
+	_u.mutation.ClearEncryptionKeyID()
+	return _u
+}
+
+// SetBaseDn sets the "base_dn" field.
+func (_u *LdapProviderUpdateOne) SetBaseDn(v string) *LdapProviderUpdateOne {
+	_u.mutation.SetBaseDn(v)
+	return _u
+}
+
+// SetNillableBaseDn sets the "base_dn" field if the given value is not nil.
+func (_u *LdapProviderUpdateOne) SetNillableBaseDn(v *string) *LdapProviderUpdateOne {
+	if v != nil {
+		_u.SetBaseDn(*v)
+	}
+	return _u
+}
+
+// SetAuthMode sets the "auth_mode" field.
+func (_u *LdapProviderUpdateOne) SetAuthMode(v string) *LdapProviderUpdateOne {
+	_u.mutation.SetAuthMode(v)
+	return _u
+}
+
+// SetNillableAuthMode sets the "auth_mode" field if the given value is not nil.
+func (_u *LdapProviderUpdateOne) SetNillableAuthMode(v *string) *LdapProviderUpdateOne {
+	if v != nil {
+		_u.SetAuthMode(*v)
+	}
+	return _u
+}
+
+// SetUserDnTemplate sets the "user_dn_template" field.
+func (_u *LdapProviderUpdateOne) SetUserDnTemplate(v string) *LdapProviderUpdateOne {
+	_u.mutation.SetUserDnTemplate(v)
+	return _u
+}
+
+// SetNillableUserDnTemplate sets the "user_dn_template" field if the given value is not nil.
+func (_u *LdapProviderUpdateOne) SetNillableUserDnTemplate(v *string) *LdapProviderUpdateOne {
+	if v != nil {
+		_u.SetUserDnTemplate(*v)
+	}
+	return _u
+}
+
+// ClearUserDnTemplate clears the value of the "user_dn_template" field.
+func (_u *LdapProviderUpdateOne) ClearUserDnTemplate() *LdapProviderUpdateOne {
+	_u.mutation.ClearUserDnTemplate()
+	return _u
+}
+
+// SetUserFilter sets the "user_filter" field.
+func (_u *LdapProviderUpdateOne) SetUserFilter(v string) *LdapProviderUpdateOne {
+	_u.mutation.SetUserFilter(v)
+	return _u
+}
+
+// SetNillableUserFilter sets the "user_filter" field if the given value is not nil.
+func (_u *LdapProviderUpdateOne) SetNillableUserFilter(v *string) *LdapProviderUpdateOne {
+	if v != nil {
+		_u.SetUserFilter(*v)
+	}
+	return _u
+}
+
+// ClearUserFilter clears the value of the "user_filter" field.
+func (_u *LdapProviderUpdateOne) ClearUserFilter() *LdapProviderUpdateOne {
+	_u.mutation.ClearUserFilter()
+	return _u
+}
+
+// SetOuBaseDn sets the "ou_base_dn" field.
+func (_u *LdapProviderUpdateOne) SetOuBaseDn(v string) *LdapProviderUpdateOne {
+	_u.mutation.SetOuBaseDn(v)
+	return _u
+}
+
+// SetNillableOuBaseDn sets the "ou_base_dn" field if the given value is not nil.
+func (_u *LdapProviderUpdateOne) SetNillableOuBaseDn(v *string) *LdapProviderUpdateOne {
+	if v != nil {
+		_u.SetOuBaseDn(*v)
+	}
+	return _u
+}
+
+// ClearOuBaseDn clears the value of the "ou_base_dn" field.
+func (_u *LdapProviderUpdateOne) ClearOuBaseDn() *LdapProviderUpdateOne {
+	_u.mutation.ClearOuBaseDn()
+	return _u
+}
+
+// SetOuFilter sets the "ou_filter" field.
+func (_u *LdapProviderUpdateOne) SetOuFilter(v string) *LdapProviderUpdateOne {
+	_u.mutation.SetOuFilter(v)
+	return _u
+}
+
+// SetNillableOuFilter sets the "ou_filter" field if the given value is not nil.
+func (_u *LdapProviderUpdateOne) SetNillableOuFilter(v *string) *LdapProviderUpdateOne {
+	if v != nil {
+		_u.SetOuFilter(*v)
+	}
+	return _u
+}
+
+// ClearOuFilter clears the value of the "ou_filter" field.
+func (_u *LdapProviderUpdateOne) ClearOuFilter() *LdapProviderUpdateOne {
+	_u.mutation.ClearOuFilter()
+	return _u
+}
+
+// SetGroupBaseDn sets the "group_base_dn" field.
+func (_u *LdapProviderUpdateOne) SetGroupBaseDn(v string) *LdapProviderUpdateOne {
+	_u.mutation.SetGroupBaseDn(v)
+	return _u
+}
+
+// SetNillableGroupBaseDn sets the "group_base_dn" field if the given value is not nil.
+func (_u *LdapProviderUpdateOne) SetNillableGroupBaseDn(v *string) *LdapProviderUpdateOne {
+	if v != nil {
+		_u.SetGroupBaseDn(*v)
+	}
+	return _u
+}
+
+// ClearGroupBaseDn clears the value of the "group_base_dn" field.
+func (_u *LdapProviderUpdateOne) ClearGroupBaseDn() *LdapProviderUpdateOne {
+	_u.mutation.ClearGroupBaseDn()
+	return _u
+}
+
+// SetGroupFilter sets the "group_filter" field.
+func (_u *LdapProviderUpdateOne) SetGroupFilter(v string) *LdapProviderUpdateOne {
+	_u.mutation.SetGroupFilter(v)
+	return _u
+}
+
+// SetNillableGroupFilter sets the "group_filter" field if the given value is not nil.
+func (_u *LdapProviderUpdateOne) SetNillableGroupFilter(v *string) *LdapProviderUpdateOne {
+	if v != nil {
+		_u.SetGroupFilter(*v)
+	}
+	return _u
+}
+
+// ClearGroupFilter clears the value of the "group_filter" field.
+func (_u *LdapProviderUpdateOne) ClearGroupFilter() *LdapProviderUpdateOne {
+	_u.mutation.ClearGroupFilter()
+	return _u
+}
+
+// SetGroupMemberAttribute sets the "group_member_attribute" field.
+func (_u *LdapProviderUpdateOne) SetGroupMemberAttribute(v string) *LdapProviderUpdateOne {
+	_u.mutation.SetGroupMemberAttribute(v)
+	return _u
+}
+
+// SetNillableGroupMemberAttribute sets the "group_member_attribute" field if the given value is not nil.
+func (_u *LdapProviderUpdateOne) SetNillableGroupMemberAttribute(v *string) *LdapProviderUpdateOne {
+	if v != nil {
+		_u.SetGroupMemberAttribute(*v)
+	}
+	return _u
+}
+
+// ClearGroupMemberAttribute clears the value of the "group_member_attribute" field.
+func (_u *LdapProviderUpdateOne) ClearGroupMemberAttribute() *LdapProviderUpdateOne {
+	_u.mutation.ClearGroupMemberAttribute()
+	return _u
+}
+
+// SetAttributeMapping sets the "attribute_mapping" field.
+func (_u *LdapProviderUpdateOne) SetAttributeMapping(v map[string]interface{}) *LdapProviderUpdateOne {
+	_u.mutation.SetAttributeMapping(v)
+	return _u
+}
+
+// ClearAttributeMapping clears the value of the "attribute_mapping" field.
+func (_u *LdapProviderUpdateOne) ClearAttributeMapping() *LdapProviderUpdateOne {
+	_u.mutation.ClearAttributeMapping()
+	return _u
+}
+
+// SetLoginEnabled sets the "login_enabled" field.
+func (_u *LdapProviderUpdateOne) SetLoginEnabled(v bool) *LdapProviderUpdateOne {
+	_u.mutation.SetLoginEnabled(v)
+	return _u
+}
+
+// SetNillableLoginEnabled sets the "login_enabled" field if the given value is not nil.
+func (_u *LdapProviderUpdateOne) SetNillableLoginEnabled(v *bool) *LdapProviderUpdateOne {
+	if v != nil {
+		_u.SetLoginEnabled(*v)
+	}
+	return _u
+}
+
+// SetJitProvision sets the "jit_provision" field.
+func (_u *LdapProviderUpdateOne) SetJitProvision(v bool) *LdapProviderUpdateOne {
+	_u.mutation.SetJitProvision(v)
+	return _u
+}
+
+// SetNillableJitProvision sets the "jit_provision" field if the given value is not nil.
+func (_u *LdapProviderUpdateOne) SetNillableJitProvision(v *bool) *LdapProviderUpdateOne {
+	if v != nil {
+		_u.SetJitProvision(*v)
+	}
+	return _u
+}
+
+// SetLinkExisting sets the "link_existing" field.
+func (_u *LdapProviderUpdateOne) SetLinkExisting(v bool) *LdapProviderUpdateOne {
+	_u.mutation.SetLinkExisting(v)
+	return _u
+}
+
+// SetNillableLinkExisting sets the "link_existing" field if the given value is not nil.
+func (_u *LdapProviderUpdateOne) SetNillableLinkExisting(v *bool) *LdapProviderUpdateOne {
+	if v != nil {
+		_u.SetLinkExisting(*v)
+	}
+	return _u
+}
+
+// SetSyncEnabled sets the "sync_enabled" field.
+func (_u *LdapProviderUpdateOne) SetSyncEnabled(v bool) *LdapProviderUpdateOne {
+	_u.mutation.SetSyncEnabled(v)
+	return _u
+}
+
+// SetNillableSyncEnabled sets the "sync_enabled" field if the given value is not nil.
+func (_u *LdapProviderUpdateOne) SetNillableSyncEnabled(v *bool) *LdapProviderUpdateOne {
+	if v != nil {
+		_u.SetSyncEnabled(*v)
+	}
+	return _u
+}
+
+// SetSyncInterval sets the "sync_interval" field.
+func (_u *LdapProviderUpdateOne) SetSyncInterval(v uint32) *LdapProviderUpdateOne {
+	_u.mutation.ResetSyncInterval()
+	_u.mutation.SetSyncInterval(v)
+	return _u
+}
+
+// SetNillableSyncInterval sets the "sync_interval" field if the given value is not nil.
+func (_u *LdapProviderUpdateOne) SetNillableSyncInterval(v *uint32) *LdapProviderUpdateOne {
+	if v != nil {
+		_u.SetSyncInterval(*v)
+	}
+	return _u
+}
+
+// AddSyncInterval adds value to the "sync_interval" field.
+func (_u *LdapProviderUpdateOne) AddSyncInterval(v int32) *LdapProviderUpdateOne {
+	_u.mutation.AddSyncInterval(v)
+	return _u
+}
+
+// SetDeletionPolicy sets the "deletion_policy" field.
+func (_u *LdapProviderUpdateOne) SetDeletionPolicy(v string) *LdapProviderUpdateOne {
+	_u.mutation.SetDeletionPolicy(v)
+	return _u
+}
+
+// SetNillableDeletionPolicy sets the "deletion_policy" field if the given value is not nil.
+func (_u *LdapProviderUpdateOne) SetNillableDeletionPolicy(v *string) *LdapProviderUpdateOne {
+	if v != nil {
+		_u.SetDeletionPolicy(*v)
+	}
+	return _u
+}
+
+// SetRootDepartmentID sets the "root_department_id" field.
+func (_u *LdapProviderUpdateOne) SetRootDepartmentID(v uint64) *LdapProviderUpdateOne {
+	_u.mutation.ResetRootDepartmentID()
+	_u.mutation.SetRootDepartmentID(v)
+	return _u
+}
+
+// SetNillableRootDepartmentID sets the "root_department_id" field if the given value is not nil.
+func (_u *LdapProviderUpdateOne) SetNillableRootDepartmentID(v *uint64) *LdapProviderUpdateOne {
+	if v != nil {
+		_u.SetRootDepartmentID(*v)
+	}
+	return _u
+}
+
+// AddRootDepartmentID adds value to the "root_department_id" field.
+func (_u *LdapProviderUpdateOne) AddRootDepartmentID(v int64) *LdapProviderUpdateOne {
+	_u.mutation.AddRootDepartmentID(v)
+	return _u
+}
+
+// ClearRootDepartmentID clears the value of the "root_department_id" field.
+func (_u *LdapProviderUpdateOne) ClearRootDepartmentID() *LdapProviderUpdateOne {
+	_u.mutation.ClearRootDepartmentID()
+	return _u
+}
+
+// SetDefaultDepartmentID sets the "default_department_id" field.
+func (_u *LdapProviderUpdateOne) SetDefaultDepartmentID(v uint64) *LdapProviderUpdateOne {
+	_u.mutation.ResetDefaultDepartmentID()
+	_u.mutation.SetDefaultDepartmentID(v)
+	return _u
+}
+
+// SetNillableDefaultDepartmentID sets the "default_department_id" field if the given value is not nil.
+func (_u *LdapProviderUpdateOne) SetNillableDefaultDepartmentID(v *uint64) *LdapProviderUpdateOne {
+	if v != nil {
+		_u.SetDefaultDepartmentID(*v)
+	}
+	return _u
+}
+
+// AddDefaultDepartmentID adds value to the "default_department_id" field.
+func (_u *LdapProviderUpdateOne) AddDefaultDepartmentID(v int64) *LdapProviderUpdateOne {
+	_u.mutation.AddDefaultDepartmentID(v)
+	return _u
+}
+
+// ClearDefaultDepartmentID clears the value of the "default_department_id" field.
+func (_u *LdapProviderUpdateOne) ClearDefaultDepartmentID() *LdapProviderUpdateOne {
+	_u.mutation.ClearDefaultDepartmentID()
+	return _u
+}
+
+// SetDefaultRoleIds sets the "default_role_ids" field.
+func (_u *LdapProviderUpdateOne) SetDefaultRoleIds(v []uint64) *LdapProviderUpdateOne {
+	_u.mutation.SetDefaultRoleIds(v)
+	return _u
+}
+
+// AppendDefaultRoleIds appends value to the "default_role_ids" field.
+func (_u *LdapProviderUpdateOne) AppendDefaultRoleIds(v []uint64) *LdapProviderUpdateOne {
+	_u.mutation.AppendDefaultRoleIds(v)
+	return _u
+}
+
+// ClearDefaultRoleIds clears the value of the "default_role_ids" field.
+func (_u *LdapProviderUpdateOne) ClearDefaultRoleIds() *LdapProviderUpdateOne {
+	_u.mutation.ClearDefaultRoleIds()
+	return _u
+}
+
+// SetLastSyncAt sets the "last_sync_at" field.
+func (_u *LdapProviderUpdateOne) SetLastSyncAt(v time.Time) *LdapProviderUpdateOne {
+	_u.mutation.SetLastSyncAt(v)
+	return _u
+}
+
+// SetNillableLastSyncAt sets the "last_sync_at" field if the given value is not nil.
+func (_u *LdapProviderUpdateOne) SetNillableLastSyncAt(v *time.Time) *LdapProviderUpdateOne {
+	if v != nil {
+		_u.SetLastSyncAt(*v)
+	}
+	return _u
+}
+
+// ClearLastSyncAt clears the value of the "last_sync_at" field.
+func (_u *LdapProviderUpdateOne) ClearLastSyncAt() *LdapProviderUpdateOne {
+	_u.mutation.ClearLastSyncAt()
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *LdapProviderUpdateOne) SetEnabled(v bool) *LdapProviderUpdateOne {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *LdapProviderUpdateOne) SetNillableEnabled(v *bool) *LdapProviderUpdateOne {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// SetSort sets the "sort" field.
+func (_u *LdapProviderUpdateOne) SetSort(v uint32) *LdapProviderUpdateOne {
+	_u.mutation.ResetSort()
+	_u.mutation.SetSort(v)
+	return _u
+}
+
+// SetNillableSort sets the "sort" field if the given value is not nil.
+func (_u *LdapProviderUpdateOne) SetNillableSort(v *uint32) *LdapProviderUpdateOne {
+	if v != nil {
+		_u.SetSort(*v)
+	}
+	return _u
+}
+
+// AddSort adds value to the "sort" field.
+func (_u *LdapProviderUpdateOne) AddSort(v int32) *LdapProviderUpdateOne {
+	_u.mutation.AddSort(v)
+	return _u
+}
+
+// SetRemark sets the "remark" field.
+func (_u *LdapProviderUpdateOne) SetRemark(v string) *LdapProviderUpdateOne {
+	_u.mutation.SetRemark(v)
+	return _u
+}
+
+// SetNillableRemark sets the "remark" field if the given value is not nil.
+func (_u *LdapProviderUpdateOne) SetNillableRemark(v *string) *LdapProviderUpdateOne {
+	if v != nil {
+		_u.SetRemark(*v)
+	}
+	return _u
+}
+
+// ClearRemark clears the value of the "remark" field.
+func (_u *LdapProviderUpdateOne) ClearRemark() *LdapProviderUpdateOne {
+	_u.mutation.ClearRemark()
+	return _u
+}
+
+// AddLdapAccountIDs adds the "ldap_accounts" edge to the LdapAccount entity by IDs.
+func (_u *LdapProviderUpdateOne) AddLdapAccountIDs(ids ...uint64) *LdapProviderUpdateOne {
+	_u.mutation.AddLdapAccountIDs(ids...)
+	return _u
+}
+
+// AddLdapAccounts adds the "ldap_accounts" edges to the LdapAccount entity.
+func (_u *LdapProviderUpdateOne) AddLdapAccounts(v ...*LdapAccount) *LdapProviderUpdateOne {
+	ids := make([]uint64, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddLdapAccountIDs(ids...)
+}
+
+// AddLdapDepartmentIDs adds the "ldap_departments" edge to the LdapDepartment entity by IDs.
+func (_u *LdapProviderUpdateOne) AddLdapDepartmentIDs(ids ...uint64) *LdapProviderUpdateOne {
+	_u.mutation.AddLdapDepartmentIDs(ids...)
+	return _u
+}
+
+// AddLdapDepartments adds the "ldap_departments" edges to the LdapDepartment entity.
+func (_u *LdapProviderUpdateOne) AddLdapDepartments(v ...*LdapDepartment) *LdapProviderUpdateOne {
+	ids := make([]uint64, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddLdapDepartmentIDs(ids...)
+}
+
+// AddSyncRunIDs adds the "sync_runs" edge to the LdapSyncRun entity by IDs.
+func (_u *LdapProviderUpdateOne) AddSyncRunIDs(ids ...uint64) *LdapProviderUpdateOne {
+	_u.mutation.AddSyncRunIDs(ids...)
+	return _u
+}
+
+// AddSyncRuns adds the "sync_runs" edges to the LdapSyncRun entity.
+func (_u *LdapProviderUpdateOne) AddSyncRuns(v ...*LdapSyncRun) *LdapProviderUpdateOne {
+	ids := make([]uint64, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSyncRunIDs(ids...)
+}
+
+// Mutation returns the LdapProviderMutation object of the builder.
+func (_u *LdapProviderUpdateOne) Mutation() *LdapProviderMutation {
+	return _u.mutation
+}
+
+// ClearLdapAccounts clears all "ldap_accounts" edges to the LdapAccount entity.
+func (_u *LdapProviderUpdateOne) ClearLdapAccounts() *LdapProviderUpdateOne {
+	_u.mutation.ClearLdapAccounts()
+	return _u
+}
+
+// RemoveLdapAccountIDs removes the "ldap_accounts" edge to LdapAccount entities by IDs.
+func (_u *LdapProviderUpdateOne) RemoveLdapAccountIDs(ids ...uint64) *LdapProviderUpdateOne {
+	_u.mutation.RemoveLdapAccountIDs(ids...)
+	return _u
+}
+
+// RemoveLdapAccounts removes "ldap_accounts" edges to LdapAccount entities.
+func (_u *LdapProviderUpdateOne) RemoveLdapAccounts(v ...*LdapAccount) *LdapProviderUpdateOne {
+	ids := make([]uint64, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveLdapAccountIDs(ids...)
+}
+
+// ClearLdapDepartments clears all "ldap_departments" edges to the LdapDepartment entity.
+func (_u *LdapProviderUpdateOne) ClearLdapDepartments() *LdapProviderUpdateOne {
+	_u.mutation.ClearLdapDepartments()
+	return _u
+}
+
+// RemoveLdapDepartmentIDs removes the "ldap_departments" edge to LdapDepartment entities by IDs.
+func (_u *LdapProviderUpdateOne) RemoveLdapDepartmentIDs(ids ...uint64) *LdapProviderUpdateOne {
+	_u.mutation.RemoveLdapDepartmentIDs(ids...)
+	return _u
+}
+
+// RemoveLdapDepartments removes "ldap_departments" edges to LdapDepartment entities.
+func (_u *LdapProviderUpdateOne) RemoveLdapDepartments(v ...*LdapDepartment) *LdapProviderUpdateOne {
+	ids := make([]uint64, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveLdapDepartmentIDs(ids...)
+}
+
+// ClearSyncRuns clears all "sync_runs" edges to the LdapSyncRun entity.
+func (_u *LdapProviderUpdateOne) ClearSyncRuns() *LdapProviderUpdateOne {
+	_u.mutation.ClearSyncRuns()
+	return _u
+}
+
+// RemoveSyncRunIDs removes the "sync_runs" edge to LdapSyncRun entities by IDs.
+func (_u *LdapProviderUpdateOne) RemoveSyncRunIDs(ids ...uint64) *LdapProviderUpdateOne {
+	_u.mutation.RemoveSyncRunIDs(ids...)
+	return _u
+}
+
+// RemoveSyncRuns removes "sync_runs" edges to LdapSyncRun entities.
+func (_u *LdapProviderUpdateOne) RemoveSyncRuns(v ...*LdapSyncRun) *LdapProviderUpdateOne {
+	ids := make([]uint64, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSyncRunIDs(ids...)
+}
+
+// Where appends a list predicates to the LdapProviderUpdate builder.
+func (_u *LdapProviderUpdateOne) Where(ps ...predicate.LdapProvider) *LdapProviderUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *LdapProviderUpdateOne) Select(field string, fields ...string) *LdapProviderUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated LdapProvider entity.
+func (_u *LdapProviderUpdateOne) Save(ctx context.Context) (*LdapProvider, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *LdapProviderUpdateOne) SaveX(ctx context.Context) *LdapProvider {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *LdapProviderUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *LdapProviderUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *LdapProviderUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := ldapprovider.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *LdapProviderUpdateOne) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := ldapprovider.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "LdapProvider.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DisplayName(); ok {
+		if err := ldapprovider.DisplayNameValidator(v); err != nil {
+			return &ValidationError{Name: "display_name", err: fmt.Errorf(`ent: validator failed for field "LdapProvider.display_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DirectoryType(); ok {
+		if err := ldapprovider.DirectoryTypeValidator(v); err != nil {
+			return &ValidationError{Name: "directory_type", err: fmt.Errorf(`ent: validator failed for field "LdapProvider.directory_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.URL(); ok {
+		if err := ldapprovider.URLValidator(v); err != nil {
+			return &ValidationError{Name: "url", err: fmt.Errorf(`ent: validator failed for field "LdapProvider.url": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.BindDn(); ok {
+		if err := ldapprovider.BindDnValidator(v); err != nil {
+			return &ValidationError{Name: "bind_dn", err: fmt.Errorf(`ent: validator failed for field "LdapProvider.bind_dn": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.EncryptionKeyID(); ok {
+		if err := ldapprovider.EncryptionKeyIDValidator(v); err != nil {
+			return &ValidationError{Name: "encryption_key_id", err: fmt.Errorf(`ent: validator failed for field "LdapProvider.encryption_key_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.BaseDn(); ok {
+		if err := ldapprovider.BaseDnValidator(v); err != nil {
+			return &ValidationError{Name: "base_dn", err: fmt.Errorf(`ent: validator failed for field "LdapProvider.base_dn": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AuthMode(); ok {
+		if err := ldapprovider.AuthModeValidator(v); err != nil {
+			return &ValidationError{Name: "auth_mode", err: fmt.Errorf(`ent: validator failed for field "LdapProvider.auth_mode": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.UserDnTemplate(); ok {
+		if err := ldapprovider.UserDnTemplateValidator(v); err != nil {
+			return &ValidationError{Name: "user_dn_template", err: fmt.Errorf(`ent: validator failed for field "LdapProvider.user_dn_template": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.UserFilter(); ok {
+		if err := ldapprovider.UserFilterValidator(v); err != nil {
+			return &ValidationError{Name: "user_filter", err: fmt.Errorf(`ent: validator failed for field "LdapProvider.user_filter": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.OuBaseDn(); ok {
+		if err := ldapprovider.OuBaseDnValidator(v); err != nil {
+			return &ValidationError{Name: "ou_base_dn", err: fmt.Errorf(`ent: validator failed for field "LdapProvider.ou_base_dn": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.OuFilter(); ok {
+		if err := ldapprovider.OuFilterValidator(v); err != nil {
+			return &ValidationError{Name: "ou_filter", err: fmt.Errorf(`ent: validator failed for field "LdapProvider.ou_filter": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.GroupBaseDn(); ok {
+		if err := ldapprovider.GroupBaseDnValidator(v); err != nil {
+			return &ValidationError{Name: "group_base_dn", err: fmt.Errorf(`ent: validator failed for field "LdapProvider.group_base_dn": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.GroupFilter(); ok {
+		if err := ldapprovider.GroupFilterValidator(v); err != nil {
+			return &ValidationError{Name: "group_filter", err: fmt.Errorf(`ent: validator failed for field "LdapProvider.group_filter": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.GroupMemberAttribute(); ok {
+		if err := ldapprovider.GroupMemberAttributeValidator(v); err != nil {
+			return &ValidationError{Name: "group_member_attribute", err: fmt.Errorf(`ent: validator failed for field "LdapProvider.group_member_attribute": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DeletionPolicy(); ok {
+		if err := ldapprovider.DeletionPolicyValidator(v); err != nil {
+			return &ValidationError{Name: "deletion_policy", err: fmt.Errorf(`ent: validator failed for field "LdapProvider.deletion_policy": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Remark(); ok {
+		if err := ldapprovider.RemarkValidator(v); err != nil {
+			return &ValidationError{Name: "remark", err: fmt.Errorf(`ent: validator failed for field "LdapProvider.remark": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *LdapProviderUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *LdapProviderUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *LdapProviderUpdateOne) sqlSave(ctx context.Context) (_node *LdapProvider, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(ldapprovider.Table, ldapprovider.Columns, sqlgraph.NewFieldSpec(ldapprovider.FieldID, field.TypeUint64))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "LdapProvider.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, ldapprovider.FieldID)
+		for _, f := range fields {
+			if !ldapprovider.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != ldapprovider.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(ldapprovider.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(ldapprovider.FieldStatus, field.TypeUint8, value)
+	}
+	if value, ok := _u.mutation.AddedStatus(); ok {
+		_spec.AddField(ldapprovider.FieldStatus, field.TypeUint8, value)
+	}
+	if _u.mutation.StatusCleared() {
+		_spec.ClearField(ldapprovider.FieldStatus, field.TypeUint8)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(ldapprovider.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.DisplayName(); ok {
+		_spec.SetField(ldapprovider.FieldDisplayName, field.TypeString, value)
+	}
+	if _u.mutation.DisplayNameCleared() {
+		_spec.ClearField(ldapprovider.FieldDisplayName, field.TypeString)
+	}
+	if value, ok := _u.mutation.DirectoryType(); ok {
+		_spec.SetField(ldapprovider.FieldDirectoryType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.URL(); ok {
+		_spec.SetField(ldapprovider.FieldURL, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.StartTLS(); ok {
+		_spec.SetField(ldapprovider.FieldStartTLS, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.InsecureSkipVerify(); ok {
+		_spec.SetField(ldapprovider.FieldInsecureSkipVerify, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.BindDn(); ok {
+		_spec.SetField(ldapprovider.FieldBindDn, field.TypeString, value)
+	}
+	if _u.mutation.BindDnCleared() {
+		_spec.ClearField(ldapprovider.FieldBindDn, field.TypeString)
+	}
+	if value, ok := _u.mutation.EncryptedBindPassword(); ok {
+		_spec.SetField(ldapprovider.FieldEncryptedBindPassword, field.TypeString, value)
+	}
+	if _u.mutation.EncryptedBindPasswordCleared() {
+		_spec.ClearField(ldapprovider.FieldEncryptedBindPassword, field.TypeString)
+	}
+	if value, ok := _u.mutation.EncryptionKeyID(); ok {
+		_spec.SetField(ldapprovider.FieldEncryptionKeyID, field.TypeString, value)
+	}
+	if _u.mutation.EncryptionKeyIDCleared() {
+		_spec.ClearField(ldapprovider.FieldEncryptionKeyID, field.TypeString)
+	}
+	if value, ok := _u.mutation.BaseDn(); ok {
+		_spec.SetField(ldapprovider.FieldBaseDn, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AuthMode(); ok {
+		_spec.SetField(ldapprovider.FieldAuthMode, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UserDnTemplate(); ok {
+		_spec.SetField(ldapprovider.FieldUserDnTemplate, field.TypeString, value)
+	}
+	if _u.mutation.UserDnTemplateCleared() {
+		_spec.ClearField(ldapprovider.FieldUserDnTemplate, field.TypeString)
+	}
+	if value, ok := _u.mutation.UserFilter(); ok {
+		_spec.SetField(ldapprovider.FieldUserFilter, field.TypeString, value)
+	}
+	if _u.mutation.UserFilterCleared() {
+		_spec.ClearField(ldapprovider.FieldUserFilter, field.TypeString)
+	}
+	if value, ok := _u.mutation.OuBaseDn(); ok {
+		_spec.SetField(ldapprovider.FieldOuBaseDn, field.TypeString, value)
+	}
+	if _u.mutation.OuBaseDnCleared() {
+		_spec.ClearField(ldapprovider.FieldOuBaseDn, field.TypeString)
+	}
+	if value, ok := _u.mutation.OuFilter(); ok {
+		_spec.SetField(ldapprovider.FieldOuFilter, field.TypeString, value)
+	}
+	if _u.mutation.OuFilterCleared() {
+		_spec.ClearField(ldapprovider.FieldOuFilter, field.TypeString)
+	}
+	if value, ok := _u.mutation.GroupBaseDn(); ok {
+		_spec.SetField(ldapprovider.FieldGroupBaseDn, field.TypeString, value)
+	}
+	if _u.mutation.GroupBaseDnCleared() {
+		_spec.ClearField(ldapprovider.FieldGroupBaseDn, field.TypeString)
+	}
+	if value, ok := _u.mutation.GroupFilter(); ok {
+		_spec.SetField(ldapprovider.FieldGroupFilter, field.TypeString, value)
+	}
+	if _u.mutation.GroupFilterCleared() {
+		_spec.ClearField(ldapprovider.FieldGroupFilter, field.TypeString)
+	}
+	if value, ok := _u.mutation.GroupMemberAttribute(); ok {
+		_spec.SetField(ldapprovider.FieldGroupMemberAttribute, field.TypeString, value)
+	}
+	if _u.mutation.GroupMemberAttributeCleared() {
+		_spec.ClearField(ldapprovider.FieldGroupMemberAttribute, field.TypeString)
+	}
+	if value, ok := _u.mutation.AttributeMapping(); ok {
+		_spec.SetField(ldapprovider.FieldAttributeMapping, field.TypeJSON, value)
+	}
+	if _u.mutation.AttributeMappingCleared() {
+		_spec.ClearField(ldapprovider.FieldAttributeMapping, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.LoginEnabled(); ok {
+		_spec.SetField(ldapprovider.FieldLoginEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.JitProvision(); ok {
+		_spec.SetField(ldapprovider.FieldJitProvision, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.LinkExisting(); ok {
+		_spec.SetField(ldapprovider.FieldLinkExisting, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.SyncEnabled(); ok {
+		_spec.SetField(ldapprovider.FieldSyncEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.SyncInterval(); ok {
+		_spec.SetField(ldapprovider.FieldSyncInterval, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedSyncInterval(); ok {
+		_spec.AddField(ldapprovider.FieldSyncInterval, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.DeletionPolicy(); ok {
+		_spec.SetField(ldapprovider.FieldDeletionPolicy, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.RootDepartmentID(); ok {
+		_spec.SetField(ldapprovider.FieldRootDepartmentID, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.AddedRootDepartmentID(); ok {
+		_spec.AddField(ldapprovider.FieldRootDepartmentID, field.TypeUint64, value)
+	}
+	if _u.mutation.RootDepartmentIDCleared() {
+		_spec.ClearField(ldapprovider.FieldRootDepartmentID, field.TypeUint64)
+	}
+	if value, ok := _u.mutation.DefaultDepartmentID(); ok {
+		_spec.SetField(ldapprovider.FieldDefaultDepartmentID, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.AddedDefaultDepartmentID(); ok {
+		_spec.AddField(ldapprovider.FieldDefaultDepartmentID, field.TypeUint64, value)
+	}
+	if _u.mutation.DefaultDepartmentIDCleared() {
+		_spec.ClearField(ldapprovider.FieldDefaultDepartmentID, field.TypeUint64)
+	}
+	if value, ok := _u.mutation.DefaultRoleIds(); ok {
+		_spec.SetField(ldapprovider.FieldDefaultRoleIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedDefaultRoleIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, ldapprovider.FieldDefaultRoleIds, value)
+		})
+	}
+	if _u.mutation.DefaultRoleIdsCleared() {
+		_spec.ClearField(ldapprovider.FieldDefaultRoleIds, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.LastSyncAt(); ok {
+		_spec.SetField(ldapprovider.FieldLastSyncAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastSyncAtCleared() {
+		_spec.ClearField(ldapprovider.FieldLastSyncAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(ldapprovider.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Sort(); ok {
+		_spec.SetField(ldapprovider.FieldSort, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedSort(); ok {
+		_spec.AddField(ldapprovider.FieldSort, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.Remark(); ok {
+		_spec.SetField(ldapprovider.FieldRemark, field.TypeString, value)
+	}
+	if _u.mutation.RemarkCleared() {
+		_spec.ClearField(ldapprovider.FieldRemark, field.TypeString)
+	}
+	if _u.mutation.LdapAccountsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   ldapprovider.LdapAccountsTable,
+			Columns: []string{ldapprovider.LdapAccountsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ldapaccount.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedLdapAccountsIDs(); len(nodes) > 0 && !_u.mutation.LdapAccountsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   ldapprovider.LdapAccountsTable,
+			Columns: []string{ldapprovider.LdapAccountsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ldapaccount.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LdapAccountsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   ldapprovider.LdapAccountsTable,
+			Columns: []string{ldapprovider.LdapAccountsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ldapaccount.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.LdapDepartmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   ldapprovider.LdapDepartmentsTable,
+			Columns: []string{ldapprovider.LdapDepartmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ldapdepartment.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedLdapDepartmentsIDs(); len(nodes) > 0 && !_u.mutation.LdapDepartmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   ldapprovider.LdapDepartmentsTable,
+			Columns: []string{ldapprovider.LdapDepartmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ldapdepartment.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LdapDepartmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   ldapprovider.LdapDepartmentsTable,
+			Columns: []string{ldapprovider.LdapDepartmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ldapdepartment.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.SyncRunsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   ldapprovider.SyncRunsTable,
+			Columns: []string{ldapprovider.SyncRunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ldapsyncrun.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSyncRunsIDs(); len(nodes) > 0 && !_u.mutation.SyncRunsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   ldapprovider.SyncRunsTable,
+			Columns: []string{ldapprovider.SyncRunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ldapsyncrun.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SyncRunsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   ldapprovider.SyncRunsTable,
+			Columns: []string{ldapprovider.SyncRunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ldapsyncrun.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &LdapProvider{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{ldapprovider.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,290 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/coder-lulu/newbee-core/rpc/ent/ldapprovider"
+	"github.com/coder-lulu/newbee-core/rpc/ent/ldapsyncrun"
+	"github.com/coder-lulu/newbee-core/rpc/internal/ldap"
+)
+
+// LDAP Sync Run Table | LDAP同步记录表
+type LdapSyncRun struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uint64 `json:"id,omitempty"`
+	// Create Time | 创建日期
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Update Time | 修改日期
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Tenant ID | 租户 ID
+	TenantID uint64 `json:"tenant_id,omitempty"`
+	// LDAP provider ID | LDAP目录ID
+	ProviderID uint64 `json:"provider_id,omitempty"`
+	// Trigger: manual, schedule | 触发方式
+	Trigger string `json:"trigger,omitempty"`
+	// Whether changes were only planned | 是否为试运行
+	DryRun bool `json:"dry_run,omitempty"`
+	// Result: success, failed | 执行结果
+	Result string `json:"result,omitempty"`
+	// Error message | 错误信息
+	Error string `json:"error,omitempty"`
+	// Start time | 开始时间
+	StartedAt time.Time `json:"started_at,omitempty"`
+	// Finish time | 结束时间
+	FinishedAt time.Time `json:"finished_at,omitempty"`
+	// Change counters | 变更统计
+	Stats ldap.SyncStats `json:"stats,omitempty"`
+	// Changes made or planned | 已执行或计划的变更
+	Changes []ldap.SyncChange `json:"changes,omitempty"`
+	// Skipped entries | 冲突的条目
+	Conflicts []ldap.SyncConflict `json:"conflicts,omitempty"`
+	// Whether changes or conflicts beyond the limit were dropped | 变更或冲突是否被截断
+	Truncated bool `json:"truncated,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the LdapSyncRunQuery when eager-loading is set.
+	Edges        LdapSyncRunEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// LdapSyncRunEdges holds the relations/edges for other nodes in the graph.
+type LdapSyncRunEdges struct {
+	// Provider holds the value of the provider edge.
+	Provider *LdapProvider `json:"provider,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// ProviderOrErr returns the Provider value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e LdapSyncRunEdges) ProviderOrErr() (*LdapProvider, error) {
+	if e.Provider != nil {
+		return e.Provider, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: ldapprovider.Label}
+	}
+	return nil, &NotLoadedError{edge: "provider"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*LdapSyncRun) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case ldapsyncrun.FieldStats, ldapsyncrun.FieldChanges, ldapsyncrun.FieldConflicts:
+			values[i] = new([]byte)
+		case ldapsyncrun.FieldDryRun, ldapsyncrun.FieldTruncated:
+			values[i] = new(sql.NullBool)
+		case ldapsyncrun.FieldID, ldapsyncrun.FieldTenantID, ldapsyncrun.FieldProviderID:
+			values[i] = new(sql.NullInt64)
+		case ldapsyncrun.FieldTrigger, ldapsyncrun.FieldResult, ldapsyncrun.FieldError:
+			values[i] = new(sql.NullString)
+		case ldapsyncrun.FieldCreatedAt, ldapsyncrun.FieldUpdatedAt, ldapsyncrun.FieldStartedAt, ldapsyncrun.FieldFinishedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the LdapSyncRun fields.
+func (_m *LdapSyncRun) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case ldapsyncrun.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = uint64(value.Int64)
+		case ldapsyncrun.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case ldapsyncrun.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case ldapsyncrun.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				_m.TenantID = uint64(value.Int64)
+			}
+		case ldapsyncrun.FieldProviderID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field provider_id", values[i])
+			} else if value.Valid {
+				_m.ProviderID = uint64(value.Int64)
+			}
+		case ldapsyncrun.FieldTrigger:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field trigger", values[i])
+			} else if value.Valid {
+				_m.Trigger = value.String
+			}
+		case ldapsyncrun.FieldDryRun:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field dry_run", values[i])
+			} else if value.Valid {
+				_m.DryRun = value.Bool
+			}
+		case ldapsyncrun.FieldResult:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field result", values[i])
+			} else if value.Valid {
+				_m.Result = value.String
+			}
+		case ldapsyncrun.FieldError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field error", values[i])
+			} else if value.Valid {
+				_m.Error = value.String
+			}
+		case ldapsyncrun.FieldStartedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field started_at", values[i])
+			} else if value.Valid {
+				_m.StartedAt = value.Time
+			}
+		case ldapsyncrun.FieldFinishedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field finished_at", values[i])
+			} else if value.Valid {
+				_m.FinishedAt = value.Time
+			}
+		case ldapsyncrun.FieldStats:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field stats", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Stats); err != nil {
+					return fmt.Errorf("unmarshal field stats: %w", err)
+				}
+			}
+		case ldapsyncrun.FieldChanges:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field changes", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Changes); err != nil {
+					return fmt.Errorf("unmarshal field changes: %w", err)
+				}
+			}
+		case ldapsyncrun.FieldConflicts:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field conflicts", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Conflicts); err != nil {
+					return fmt.Errorf("unmarshal field conflicts: %w", err)
+				}
+			}
+		case ldapsyncrun.FieldTruncated:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field truncated", values[i])
+			} else if value.Valid {
+				_m.Truncated = value.Bool
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the LdapSyncRun.
+// This includes values selected through modifiers, order, etc.
+func (_m *LdapSyncRun) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryProvider queries the "provider" edge of the LdapSyncRun entity.
+func (_m *LdapSyncRun) QueryProvider() *LdapProviderQuery {
+	return NewLdapSyncRunClient(_m.config).QueryProvider(_m)
+}
+
+// Update returns a builder for updating this LdapSyncRun.
+// Note that you need to call LdapSyncRun.Unwrap() before calling this method if this LdapSyncRun
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *LdapSyncRun) Update() *LdapSyncRunUpdateOne {
+	return NewLdapSyncRunClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the LdapSyncRun entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *LdapSyncRun) Unwrap() *LdapSyncRun {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: LdapSyncRun is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *LdapSyncRun) String() string {
+	var builder strings.Builder
+	builder.WriteString("LdapSyncRun(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("provider_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ProviderID))
+	builder.WriteString(", ")
+	builder.WriteString("trigger=")
+	builder.WriteString(_m.Trigger)
+	builder.WriteString(", ")
+	builder.WriteString("dry_run=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DryRun))
+	builder.WriteString(", ")
+	builder.WriteString("result=")
+	builder.WriteString(_m.Result)
+	builder.WriteString(", ")
+	builder.WriteString("error=")
+	builder.WriteString(_m.Error)
+	builder.WriteString(", ")
+	builder.WriteString("started_at=")
+	builder.WriteString(_m.StartedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("finished_at=")
+	builder.WriteString(_m.FinishedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("stats=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Stats))
+	builder.WriteString(", ")
+	builder.WriteString("changes=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Changes))
+	builder.WriteString(", ")
+	builder.WriteString("conflicts=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Conflicts))
+	builder.WriteString(", ")
+	builder.WriteString("truncated=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Truncated))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// LdapSyncRuns is a parsable slice of LdapSyncRun.
+type LdapSyncRuns []*LdapSyncRun
@@ -0,0 +1,624 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ldapsyncrun
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/coder-lulu/newbee-core/rpc/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uint64) predicate.LdapSyncRun {
+	return predicate.LdapSyncRun(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uint64) predicate.LdapSyncRun {
+	return predicate.LdapSyncRun(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uint64) predicate.LdapSyncRun {
+	return predicate.LdapSyncRun(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uint64) predicate.LdapSyncRun {
+	return predicate.LdapSyncRun(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uint64) predicate.LdapSyncRun {
+	return predicate.LdapSyncRun(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uint64) predicate.LdapSyncRun {
+	return predicate.LdapSyncRun(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uint64) predicate.LdapSyncRun {
+	return predicate.LdapSyncRun(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uint64) predicate.LdapSyncRun {
+	return predicate.LdapSyncRun(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uint64) predicate.LdapSyncRun {
+	return predicate.LdapSyncRun(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.LdapSyncRun {
+	return predicate.LdapSyncRun(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.LdapSyncRun {
+	return predicate.LdapSyncRun(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v uint64) predicate.LdapSyncRun {
+	return predicate.LdapSyncRun(sql.FieldEQ(FieldTenantID, v))
+}
+
+// ProviderID applies equality check predicate on the "provider_id" field. It's identical to ProviderIDEQ.
+func ProviderID(v uint64) predicate.LdapSyncRun {
+	return predicate.LdapSyncRun(sql.FieldEQ(FieldProviderID, v))
+}
+
+// Trigger applies equality check predicate on the "trigger" field. It's identical to TriggerEQ.
+func Trigger(v string) predicate.LdapSyncRun {
+	return predicate.LdapSyncRun(sql.FieldEQ(FieldTrigger, v))
+}
+
+// DryRun applies equality check predicate on the "dry_run" field. It's identical to DryRunEQ.
+func DryRun(v bool) predicate.LdapSyncRun {
+	return predicate.LdapSyncRun(sql.FieldEQ(FieldDryRun, v))
+}
+
+// Result applies equality check predicate on the "result" field. It's identical to ResultEQ.
+func Result(v string) predicate.LdapSyncRun {
+	return predicate.LdapSyncRun(sql.FieldEQ(FieldResult, v))
+}
+
+// Error applies equality check predicate on the "error" field. It's identical to ErrorEQ.
+func Error(v string) predicate.LdapSyncRun {
+	return predicate.LdapSyncRun(sql.FieldEQ(FieldError, v))
+}
+
+// StartedAt applies equality check predicate on the "started_at" field. It's identical to StartedAtEQ.
+func StartedAt(v time.Time) predicate.LdapSyncRun {
+	return predicate.LdapSyncRun(sql.FieldEQ(FieldStartedAt, v))
+}
+
+// FinishedAt applies equality check predicate on the "finished_at" field. It's identical to FinishedAtEQ.
+func FinishedAt(v time.Time) predicate.LdapSyncRun {
+	return predicate.LdapSyncRun(sql.FieldEQ(FieldFinishedAt, v))
+}
+
+// Truncated applies equality check predicate on the "truncated" field. It's identical to TruncatedEQ.
+func Truncated(v bool) predicate.LdapSyncRun {
+	return predicate.LdapSyncRun(sql.FieldEQ(FieldTruncated, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.LdapSyncRun {
+	return predicate.LdapSyncRun(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.LdapSyncRun {
+	return predicate.LdapSyncRun(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.LdapSyncRun {
+	return predicate.LdapSyncRun(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.LdapSyncRun {
+	return predicate.LdapSyncRun(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.LdapSyncRun {
+	return predicate.LdapSyncRun(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.LdapSyncRun {
+	return predicate.LdapSyncRun(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.LdapSyncRun {
+	return predicate.LdapSyncRun(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.LdapSyncRun {
+	return predicate.LdapSyncRun(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.LdapSyncRun {
+	return predicate.LdapSyncRun(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.LdapSyncRun {
+	return predicate.LdapSyncRun(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.LdapSyncRun {
+	return predicate.LdapSyncRun(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.LdapSyncRun {
+	return predicate.LdapSyncRun(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.LdapSyncRun {
+	return predicate.LdapSyncRun(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.LdapSyncRun {
+	return predicate.LdapSyncRun(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.LdapSyncRun {
+	return predicate.LdapSyncRun(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.LdapSyncRun {
+	return predicate.LdapSyncRun(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v uint64) predicate.LdapSyncRun {
+	return predicate.LdapSyncRun(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v uint64) predicate.LdapSyncRun {
+	return predicate.LdapSyncRun(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...uint64) predicate.LdapSyncRun {
+	return predicate.LdapSyncRun(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...uint64) predicate.LdapSyncRun {
+	return predicate.LdapSyncRun(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v uint64) predicate.LdapSyncRun {
+	return predicate.LdapSyncRun(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v uint64) predicate.LdapSyncRun {
+	return predicate.LdapSyncRun(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v uint64) predicate.LdapSyncRun {
+	return predicate.LdapSyncRun(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v uint64) predicate.LdapSyncRun {
+	return predicate.LdapSyncRun(sql.FieldLTE(FieldTenantID, v))
+}
+
+// ProviderIDEQ applies the EQ predicate on the "provider_id" field.
+func ProviderIDEQ(v uint64) predicate.LdapSyncRun {
+	return predicate.LdapSyncRun(sql.FieldEQ(FieldProviderID, v))
+}
+
+// ProviderIDNEQ applies the NEQ predicate on the "provider_id" field.
+func ProviderIDNEQ(v uint64) predicate.LdapSyncRun {
+	return predicate.LdapSyncRun(sql.FieldNEQ(FieldProviderID, v))
+}
+
+// ProviderIDIn applies the In predicate on the "provider_id" field.
+func ProviderIDIn(vs ...uint64) predicate.LdapSyncRun {
+	return predicate.LdapSyncRun(sql.FieldIn(FieldProviderID, vs...))
+}
+
+// ProviderIDNotIn applies the NotIn predicate on the "provider_id" field.
+func ProviderIDNotIn(vs ...uint64) predicate.LdapSyncRun {
+	return predicate.LdapSyncRun(sql.FieldNotIn(FieldProviderID, vs...))
+}
+
+// TriggerEQ applies the EQ predicate on the "trigger" field.
+func TriggerEQ(v string) predicate.LdapSyncRun {
+	return predicate.LdapSyncRun(sql.FieldEQ(FieldTrigger, v))
+}
+
+// TriggerNEQ applies the NEQ predicate on the "trigger" field.
+func TriggerNEQ(v string) predicate.LdapSyncRun {
+	return predicate.LdapSyncRun(sql.FieldNEQ(FieldTrigger, v))
+}
+
+// TriggerIn applies the In predicate on the "trigger" field.
+func TriggerIn(vs ...string) predicate.LdapSyncRun {
+	return predicate.LdapSyncRun(sql.FieldIn(FieldTrigger, vs...))
+}
+
+// TriggerNotIn applies the NotIn predicate on the "trigger" field.
+func TriggerNotIn(vs ...string) predicate.LdapSyncRun {
+	return predicate.LdapSyncRun(sql.FieldNotIn(FieldTrigger, vs...))
+}
+
+// TriggerGT applies the GT predicate on the "trigger" field.
+func TriggerGT(v string) predicate.LdapSyncRun {
+	return predicate.LdapSyncRun(sql.FieldGT(FieldTrigger, v))
+}
+
+// TriggerGTE applies the GTE predicate on the "trigger" field.
+func TriggerGTE(v string) predicate.LdapSyncRun {
+	return predicate.LdapSyncRun(sql.FieldGTE(FieldTrigger, v))
+}
+
+// TriggerLT applies the LT predicate on the "trigger" field.
+func TriggerLT(v string) predicate.LdapSyncRun {
+	return predicate.LdapSyncRun(sql.FieldLT(FieldTrigger, v))
+}
+
+// TriggerLTE applies the LTE predicate on the "trigger" field.
+func TriggerLTE(v string) predicate.LdapSyncRun {
+	return predicate.LdapSyncRun(sql.FieldLTE(FieldTrigger, v))
+}
+
+// TriggerContains applies the Contains predicate on the "trigger" field.
+func TriggerContains(v string) predicate.LdapSyncRun {
+	return predicate.LdapSyncRun(sql.FieldContains(FieldTrigger, v))
+}
+
+// TriggerHasPrefix applies the HasPrefix predicate on the "trigger" field.
+func TriggerHasPrefix(v string) predicate.LdapSyncRun {
+	return predicate.LdapSyncRun(sql.FieldHasPrefix(FieldTrigger, v))
+}
+
+// TriggerHasSuffix applies the HasSuffix predicate on the "trigger" field.
+func TriggerHasSuffix(v string) predicate.LdapSyncRun {
+	return predicate.LdapSyncRun(sql.FieldHasSuffix(FieldTrigger, v))
+}
+
+// TriggerEqualFold applies the EqualFold predicate on the "trigger" field.
+func TriggerEqualFold(v string) predicate.LdapSyncRun {
+	return predicate.LdapSyncRun(sql.FieldEqualFold(FieldTrigger, v))
+}
+
+// TriggerContainsFold applies the ContainsFold predicate on the "trigger" field.
+func TriggerContainsFold(v string) predicate.LdapSyncRun {
+	return predicate.LdapSyncRun(sql.FieldContainsFold(FieldTrigger, v))
+}
+
+// DryRunEQ applies the EQ predicate on the "dry_run" field.
+func DryRunEQ(v bool) predicate.LdapSyncRun {
+	return predicate.LdapSyncRun(sql.FieldEQ(FieldDryRun, v))
+}
+
+// DryRunNEQ applies the NEQ predicate on the "dry_run" field.
+func DryRunNEQ(v bool) predicate.LdapSyncRun {
+	return predicate.LdapSyncRun(sql.FieldNEQ(FieldDryRun, v))
+}
+
+// ResultEQ applies the EQ predicate on the "result" field.
+func ResultEQ(v string) predicate.LdapSyncRun {
+	return predicate.LdapSyncRun(sql.FieldEQ(FieldResult, v))
+}
+
+// ResultNEQ applies the NEQ predicate on the "result" field.
+func ResultNEQ(v string) predicate.LdapSyncRun {
+	return predicate.LdapSyncRun(sql.FieldNEQ(FieldResult, v))
+}
+
+// ResultIn applies the In predicate on the "result" field.
+func ResultIn(vs ...string) predicate.LdapSyncRun {
+	return predicate.LdapSyncRun(sql.FieldIn(FieldResult, vs...))
+}
+
+// ResultNotIn applies the NotIn predicate on the "result" field.
+func ResultNotIn(vs ...string) predicate.LdapSyncRun {
+	return predicate.LdapSyncRun(sql.FieldNotIn(FieldResult, vs...))
+}
+
+// ResultGT applies the GT predicate on the "result" field.
+func ResultGT(v string) predicate.LdapSyncRun {
+	return predicate.LdapSyncRun(sql.FieldGT(FieldResult, v))
+}
+
+// ResultGTE applies the GTE predicate on the "result" field.
+func ResultGTE(v string) predicate.LdapSyncRun {
+	return predicate.LdapSyncRun(sql.FieldGTE(FieldResult, v))
+}
+
+// ResultLT applies the LT predicate on the "result" field.
+func ResultLT(v string) predicate.LdapSyncRun {
+	return predicate.LdapSyncRun(sql.FieldLT(FieldResult, v))
+}
+
+// ResultLTE applies the LTE predicate on the "result" field.
+func ResultLTE(v string) predicate.LdapSyncRun {
+	return predicate.LdapSyncRun(sql.FieldLTE(FieldResult, v))
+}
+
+// ResultContains applies the Contains predicate on the "result" field.
+func ResultContains(v string) predicate.LdapSyncRun {
+	return predicate.LdapSyncRun(sql.FieldContains(FieldResult, v))
+}
+
+// ResultHasPrefix applies the HasPrefix predicate on the "result" field.
+func ResultHasPrefix(v string) predicate.LdapSyncRun {
+	return predicate.LdapSyncRun(sql.FieldHasPrefix(FieldResult, v))
+}
+
+// ResultHasSuffix applies the HasSuffix predicate on the "result" field.
+func ResultHasSuffix(v string) predicate.LdapSyncRun {
+	return predicate.LdapSyncRun(sql.FieldHasSuffix(FieldResult, v))
+}
+
+// ResultEqualFold applies the EqualFold predicate on the "result" field.
+func ResultEqualFold(v string) predicate.LdapSyncRun {
+	return predicate.LdapSyncRun(sql.FieldEqualFold(FieldResult, v))
+}
+
+// ResultContainsFold applies the ContainsFold predicate on the "result" field.
+func ResultContainsFold(v string) predicate.LdapSyncRun {
+	return predicate.LdapSyncRun(sql.FieldContainsFold(FieldResult, v))
+}
+
+// ErrorEQ applies the EQ predicate on the "error" field.
+func ErrorEQ(v string) predicate.LdapSyncRun {
+	return predicate.LdapSyncRun(sql.FieldEQ(FieldError, v))
+}
+
+// ErrorNEQ applies the NEQ predicate on the "error" field.
+func ErrorNEQ(v string) predicate.LdapSyncRun {
+	return predicate.LdapSyncRun(sql.FieldNEQ(FieldError, v))
+}
+
+// ErrorIn applies the In predicate on the "error" field.
+func ErrorIn(vs ...string) predicate.LdapSyncRun {
+	return predicate.LdapSyncRun(sql.FieldIn(FieldError, vs...))
+}
+
+// ErrorNotIn applies the NotIn predicate on the "error" field.
+func ErrorNotIn(vs ...string) predicate.LdapSyncRun {
+	return predicate.LdapSyncRun(sql.FieldNotIn(FieldError, vs...))
+}
+
+// ErrorGT applies the GT predicate on the "error" field.
+func ErrorGT(v string) predicate.LdapSyncRun {
+	return predicate.LdapSyncRun(sql.FieldGT(FieldError, v))
+}
+
+// ErrorGTE applies the GTE predicate on the "error" field.
+func ErrorGTE(v string) predicate.LdapSyncRun {
+	return predicate.LdapSyncRun(sql.FieldGTE(FieldError, v))
+}
+
+// ErrorLT applies the LT predicate on the "error" field.
+func ErrorLT(v string) predicate.LdapSyncRun {
+	return predicate.LdapSyncRun(sql.FieldLT(FieldError, v))
+}
+
+// ErrorLTE applies the LTE predicate on the "error" field.
+func ErrorLTE(v string) predicate.LdapSyncRun {
+	return predicate.LdapSyncRun(sql.FieldLTE(FieldError, v))
+}
+
+// ErrorContains applies the Contains predicate on the "error" field.
+func ErrorContains(v string) predicate.LdapSyncRun {
+	return predicate.LdapSyncRun(sql.FieldContains(FieldError, v))
+}
+
+// ErrorHasPrefix applies the HasPrefix predicate on the "error" field.
+func ErrorHasPrefix(v string) predicate.LdapSyncRun {
+	return predicate.LdapSyncRun(sql.FieldHasPrefix(FieldError, v))
+}
+
+// ErrorHasSuffix applies the HasSuffix predicate on the "error" field.
+func ErrorHasSuffix(v string) predicate.LdapSyncRun {
+	return predicate.LdapSyncRun(sql.FieldHasSuffix(FieldError, v))
+}
+
+// ErrorIsNil applies the IsNil predicate on the "error" field.
+func ErrorIsNil() predicate.LdapSyncRun {
+	return predicate.LdapSyncRun(sql.FieldIsNull(FieldError))
+}
+
+// ErrorNotNil applies the NotNil predicate on the "error" field.
+func ErrorNotNil() predicate.LdapSyncRun {
+	return predicate.LdapSyncRun(sql.FieldNotNull(FieldError))
+}
+
+// ErrorEqualFold applies the EqualFold predicate on the "error" field.
+func ErrorEqualFold(v string) predicate.LdapSyncRun {
+	return predicate.LdapSyncRun(sql.FieldEqualFold(FieldError, v))
+}
+
+// ErrorContainsFold applies the ContainsFold predicate on the "error" field.
+func ErrorContainsFold(v string) predicate.LdapSyncRun {
+	return predicate.LdapSyncRun(sql.FieldContainsFold(FieldError, v))
+}
+
+// StartedAtEQ applies the EQ predicate on the "started_at" field.
+func StartedAtEQ(v time.Time) predicate.LdapSyncRun {
+	return predicate.LdapSyncRun(sql.FieldEQ(FieldStartedAt, v))
+}
+
+// StartedAtNEQ applies the NEQ predicate on the "started_at" field.
+func StartedAtNEQ(v time.Time) predicate.LdapSyncRun {
+	return predicate.LdapSyncRun(sql.FieldNEQ(FieldStartedAt, v))
+}
+
+// StartedAtIn applies the In predicate on the "started_at" field.
+func StartedAtIn(vs ...time.Time) predicate.LdapSyncRun {
+	return predicate.LdapSyncRun(sql.FieldIn(FieldStartedAt, vs...))
+}
+
+// StartedAtNotIn applies the NotIn predicate on the "started_at" field.
+func StartedAtNotIn(vs ...time.Time) predicate.LdapSyncRun {
+	return predicate.LdapSyncRun(sql.FieldNotIn(FieldStartedAt, vs...))
+}
+
+// StartedAtGT applies the GT predicate on the "started_at" field.
+func StartedAtGT(v time.Time) predicate.LdapSyncRun {
+	return predicate.LdapSyncRun(sql.FieldGT(FieldStartedAt, v))
+}
+
+// StartedAtGTE applies the GTE predicate on the "started_at" field.
+func StartedAtGTE(v time.Time) predicate.LdapSyncRun {
+	return predicate.LdapSyncRun(sql.FieldGTE(FieldStartedAt, v))
+}
+
+// StartedAtLT applies the LT predicate on the "started_at" field.
+func StartedAtLT(v time.Time) predicate.LdapSyncRun {
+	return predicate.LdapSyncRun(sql.FieldLT(FieldStartedAt, v))
+}
+
+// StartedAtLTE applies the LTE predicate on the "started_at" field.
+func StartedAtLTE(v time.Time) predicate.LdapSyncRun {
+	return predicate.LdapSyncRun(sql.FieldLTE(FieldStartedAt, v))
+}
+
+// FinishedAtEQ applies the EQ predicate on the "finished_at" field.
+func FinishedAtEQ(v time.Time) predicate.LdapSyncRun {
+	return predicate.LdapSyncRun(sql.FieldEQ(FieldFinishedAt, v))
+}
+
+// FinishedAtNEQ applies the NEQ predicate on the "finished_at" field.
+func FinishedAtNEQ(v time.Time) predicate.LdapSyncRun {
+	return predicate.LdapSyncRun(sql.FieldNEQ(FieldFinishedAt, v))
+}
+
+// FinishedAtIn applies the In predicate on the "finished_at" field.
+func FinishedAtIn(vs ...time.Time) predicate.LdapSyncRun {
+	return predicate.LdapSyncRun(sql.FieldIn(FieldFinishedAt, vs...))
+}
+
+// FinishedAtNotIn applies the NotIn predicate on the "finished_at" field.
+func FinishedAtNotIn(vs ...time.Time) predicate.LdapSyncRun {
+	return predicate.LdapSyncRun(sql.FieldNotIn(FieldFinishedAt, vs...))
+}
+
+// FinishedAtGT applies the GT predicate on the "finished_at" field.
+func FinishedAtGT(v time.Time) predicate.LdapSyncRun {
+	return predicate.LdapSyncRun(sql.FieldGT(FieldFinishedAt, v))
+}
+
+// FinishedAtGTE applies the GTE predicate on the "finished_at" field.
+func FinishedAtGTE(v time.Time) predicate.LdapSyncRun {
+	return predicate.LdapSyncRun(sql.FieldGTE(FieldFinishedAt, v))
+}
+
+// FinishedAtLT applies the LT predicate on the "finished_at" field.
+func FinishedAtLT(v time.Time) predicate.LdapSyncRun {
+	return predicate.LdapSyncRun(sql.FieldLT(FieldFinishedAt, v))
+}
+
+// FinishedAtLTE applies the LTE predicate on the "finished_at" field.
+func FinishedAtLTE(v time.Time) predicate.LdapSyncRun {
+	return predicate.LdapSyncRun(sql.FieldLTE(FieldFinishedAt, v))
+}
+
+// StatsIsNil applies the IsNil predicate on the "stats" field.
+func StatsIsNil() predicate.LdapSyncRun {
+	return predicate.LdapSyncRun(sql.FieldIsNull(FieldStats))
+}
+
+// StatsNotNil applies the NotNil predicate on the "stats" field.
+func StatsNotNil() predicate.LdapSyncRun {
+	return predicate.LdapSyncRun(sql.FieldNotNull(FieldStats))
+}
+
+// ChangesIsNil applies the IsNil predicate on the "changes" field.
+func ChangesIsNil() predicate.LdapSyncRun {
+	return predicate.LdapSyncRun(sql.FieldIsNull(FieldChanges))
+}
+
+// ChangesNotNil applies the NotNil predicate on the "changes" field.
+func ChangesNotNil() predicate.LdapSyncRun {
+	return predicate.LdapSyncRun(sql.FieldNotNull(FieldChanges))
+}
+
+// ConflictsIsNil applies the IsNil predicate on the "conflicts" field.
+func ConflictsIsNil() predicate.LdapSyncRun {
+	return predicate.LdapSyncRun(sql.FieldIsNull(FieldConflicts))
+}
+
+// ConflictsNotNil applies the NotNil predicate on the "conflicts" field.
+func ConflictsNotNil() predicate.LdapSyncRun {
+	return predicate.LdapSyncRun(sql.FieldNotNull(FieldConflicts))
+}
+
+// TruncatedEQ applies the EQ predicate on the "truncated" field.
+func TruncatedEQ(v bool) predicate.LdapSyncRun {
+	return predicate.LdapSyncRun(sql.FieldEQ(FieldTruncated, v))
+}
+
+// TruncatedNEQ applies the NEQ predicate on the "truncated" field.
+func TruncatedNEQ(v bool) predicate.LdapSyncRun {
+	return predicate.LdapSyncRun(sql.FieldNEQ(FieldTruncated, v))
+}
+
+// HasProvider applies the HasEdge predicate on the "provider" edge.
+func HasProvider() predicate.LdapSyncRun {
+	return predicate.LdapSyncRun(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ProviderTable, ProviderColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasProviderWith applies the HasEdge predicate on the "provider" edge with a given conditions (other predicates).
+func HasProviderWith(preds ...predicate.LdapProvider) predicate.LdapSyncRun {
+	return predicate.LdapSyncRun(func(s *sql.Selector) {
+		step := newProviderStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.LdapSyncRun) predicate.LdapSyncRun {
+	return predicate.LdapSyncRun(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.LdapSyncRun) predicate.LdapSyncRun {
+	return predicate.LdapSyncRun(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.LdapSyncRun) predicate.LdapSyncRun {
+	return predicate.LdapSyncRun(sql.NotPredicates(p))
+}
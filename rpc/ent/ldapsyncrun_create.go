@@ -0,0 +1,468 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/coder-lulu/newbee-core/rpc/ent/ldapprovider"
+	"github.com/coder-lulu/newbee-core/rpc/ent/ldapsyncrun"
+	"github.com/coder-lulu/newbee-core/rpc/internal/ldap"
+)
+
+// LdapSyncRunCreate is the builder for creating a LdapSyncRun entity.
+type LdapSyncRunCreate struct {
+	config
+	mutation *LdapSyncRunMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *LdapSyncRunCreate) SetCreatedAt(v time.Time) *LdapSyncRunCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *LdapSyncRunCreate) SetNillableCreatedAt(v *time.Time) *LdapSyncRunCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *LdapSyncRunCreate) SetUpdatedAt(v time.Time) *LdapSyncRunCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *LdapSyncRunCreate) SetNillableUpdatedAt(v *time.Time) *LdapSyncRunCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *LdapSyncRunCreate) SetTenantID(v uint64) *LdapSyncRunCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_c *LdapSyncRunCreate) SetNillableTenantID(v *uint64) *LdapSyncRunCreate {
+	if v != nil {
+		_c.SetTenantID(*v)
+	}
+	return _c
+}
+
+// SetProviderID sets the "provider_id" field.
+func (_c *LdapSyncRunCreate) SetProviderID(v uint64) *LdapSyncRunCreate {
+	_c.mutation.SetProviderID(v)
+	return _c
+}
+
+// SetTrigger sets the "trigger" field.
+func (_c *LdapSyncRunCreate) SetTrigger(v string) *LdapSyncRunCreate {
+	_c.mutation.SetTrigger(v)
+	return _c
+}
+
+// SetDryRun sets the "dry_run" field.
+func (_c *LdapSyncRunCreate) SetDryRun(v bool) *LdapSyncRunCreate {
+	_c.mutation.SetDryRun(v)
+	return _c
+}
+
+// SetNillableDryRun sets the "dry_run" field if the given value is not nil.
+func (_c *LdapSyncRunCreate) SetNillableDryRun(v *bool) *LdapSyncRunCreate {
+	if v != nil {
+		_c.SetDryRun(*v)
+	}
+	return _c
+}
+
+// SetResult sets the "result" field.
+func (_c *LdapSyncRunCreate) SetResult(v string) *LdapSyncRunCreate {
+	_c.mutation.SetResult(v)
+	return _c
+}
+
+// SetError sets the "error" field.
+func (_c *LdapSyncRunCreate) SetError(v string) *LdapSyncRunCreate {
+	_c.mutation.SetError(v)
+	return _c
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_c *LdapSyncRunCreate) SetNillableError(v *string) *LdapSyncRunCreate {
+	if v != nil {
+		_c.SetError(*v)
+	}
+	return _c
+}
+
+// SetStartedAt sets the "started_at" field.
+func (_c *LdapSyncRunCreate) SetStartedAt(v time.Time) *LdapSyncRunCreate {
+	_c.mutation.SetStartedAt(v)
+	return _c
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (_c *LdapSyncRunCreate) SetFinishedAt(v time.Time) *LdapSyncRunCreate {
+	_c.mutation.SetFinishedAt(v)
+	return _c
+}
+
+// SetStats sets the "stats" field.
+func (_c *LdapSyncRunCreate) SetStats(v ldap.SyncStats) *LdapSyncRunCreate {
+	_c.mutation.SetStats(v)
+	return _c
+}
+
+// SetNillableStats sets the "stats" field if the given value is not nil.
+func (_c *LdapSyncRunCreate) SetNillableStats(v *ldap.SyncStats) *LdapSyncRunCreate {
+	if v != nil {
+		_c.SetStats(*v)
+	}
+	return _c
+}
+
+// SetChanges sets the "changes" field.
+func (_c *LdapSyncRunCreate) SetChanges(v []ldap.SyncChange) *LdapSyncRunCreate {
+	_c.mutation.SetChanges(v)
+	return _c
+}
+
+// SetConflicts sets the "conflicts" field.
+func (_c *LdapSyncRunCreate) SetConflicts(v []ldap.SyncConflict) *LdapSyncRunCreate {
+	_c.mutation.SetConflicts(v)
+	return _c
+}
+
+// SetTruncated sets the "truncated" field.
+func (_c *LdapSyncRunCreate) SetTruncated(v bool) *LdapSyncRunCreate {
+	_c.mutation.SetTruncated(v)
+	return _c
+}
+
+// SetNillableTruncated sets the "truncated" field if the given value is not nil.
+func (_c *LdapSyncRunCreate) SetNillableTruncated(v *bool) *LdapSyncRunCreate {
+	if v != nil {
+		_c.SetTruncated(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *LdapSyncRunCreate) SetID(v uint64) *LdapSyncRunCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetProvider sets the "provider" edge to the LdapProvider entity.
+func (_c *LdapSyncRunCreate) SetProvider(v *LdapProvider) *LdapSyncRunCreate {
+	return _c.SetProviderID(v.ID)
+}
+
+// Mutation returns the LdapSyncRunMutation object of the builder.
+func (_c *LdapSyncRunCreate) Mutation() *LdapSyncRunMutation {
+	return _c.mutation
+}
+
+// Save creates the LdapSyncRun in the database.
+func (_c *LdapSyncRunCreate) Save(ctx context.Context) (*LdapSyncRun, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *LdapSyncRunCreate) SaveX(ctx context.Context) *LdapSyncRun {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LdapSyncRunCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LdapSyncRunCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *LdapSyncRunCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := ldapsyncrun.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := ldapsyncrun.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.TenantID(); !ok {
+		v := ldapsyncrun.DefaultTenantID
+		_c.mutation.SetTenantID(v)
+	}
+	if _, ok := _c.mutation.DryRun(); !ok {
+		v := ldapsyncrun.DefaultDryRun
+		_c.mutation.SetDryRun(v)
+	}
+	if _, ok := _c.mutation.Truncated(); !ok {
+		v := ldapsyncrun.DefaultTruncated
+		_c.mutation.SetTruncated(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *LdapSyncRunCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "LdapSyncRun.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "LdapSyncRun.updated_at"`)}
+	}
+	if _, ok := _c.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "LdapSyncRun.tenant_id"`)}
+	}
+	if _, ok := _c.mutation.ProviderID(); !ok {
+		return &ValidationError{Name: "provider_id", err: errors.New(`ent: missing required field "LdapSyncRun.provider_id"`)}
+	}
+	if _, ok := _c.mutation.Trigger(); !ok {
+		return &ValidationError{Name: "trigger", err: errors.New(`ent: missing required field "LdapSyncRun.trigger"`)}
+	}
+	if v, ok := _c.mutation.Trigger(); ok {
+		if err := ldapsyncrun.TriggerValidator(v); err != nil {
+			return &ValidationError{Name: "trigger", err: fmt.Errorf(`ent: validator failed for field "LdapSyncRun.trigger": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.DryRun(); !ok {
+		return &ValidationError{Name: "dry_run", err: errors.New(`ent: missing required field "LdapSyncRun.dry_run"`)}
+	}
+	if _, ok := _c.mutation.Result(); !ok {
+		return &ValidationError{Name: "result", err: errors.New(`ent: missing required field "LdapSyncRun.result"`)}
+	}
+	if v, ok := _c.mutation.Result(); ok {
+		if err := ldapsyncrun.ResultValidator(v); err != nil {
+			return &ValidationError{Name: "result", err: fmt.Errorf(`ent: validator failed for field "LdapSyncRun.result": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.StartedAt(); !ok {
+		return &ValidationError{Name: "started_at", err: errors.New(`ent: missing required field "LdapSyncRun.started_at"`)}
+	}
+	if _, ok := _c.mutation.FinishedAt(); !ok {
+		return &ValidationError{Name: "finished_at", err: errors.New(`ent: missing required field "LdapSyncRun.finished_at"`)}
+	}
+	if _, ok := _c.mutation.Truncated(); !ok {
+		return &ValidationError{Name: "truncated", err: errors.New(`ent: missing required field "LdapSyncRun.truncated"`)}
+	}
+	if len(_c.mutation.ProviderIDs()) == 0 {
+		return &ValidationError{Name: "provider", err: errors.New(`ent: missing required edge "LdapSyncRun.provider"`)}
+	}
+	return nil
+}
+
+func (_c *LdapSyncRunCreate) sqlSave(ctx context.Context) (*LdapSyncRun, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint64(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *LdapSyncRunCreate) createSpec() (*LdapSyncRun, *sqlgraph.CreateSpec) {
+	var (
+		_node = &LdapSyncRun{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(ldapsyncrun.Table, sqlgraph.NewFieldSpec(ldapsyncrun.FieldID, field.TypeUint64))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(ldapsyncrun.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(ldapsyncrun.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(ldapsyncrun.FieldTenantID, field.TypeUint64, value)
+		_node.TenantID = value
+	}
+	if value, ok := _c.mutation.Trigger(); ok {
+		_spec.SetField(ldapsyncrun.FieldTrigger, field.TypeString, value)
+		_node.Trigger = value
+	}
+	if value, ok := _c.mutation.DryRun(); ok {
+		_spec.SetField(ldapsyncrun.FieldDryRun, field.TypeBool, value)
+		_node.DryRun = value
+	}
+	if value, ok := _c.mutation.Result(); ok {
+		_spec.SetField(ldapsyncrun.FieldResult, field.TypeString, value)
+		_node.Result = value
+	}
+	if value, ok := _c.mutation.Error(); ok {
+		_spec.SetField(ldapsyncrun.FieldError, field.TypeString, value)
+		_node.Error = value
+	}
+	if value, ok := _c.mutation.StartedAt(); ok {
+		_spec.SetField(ldapsyncrun.FieldStartedAt, field.TypeTime, value)
+		_node.StartedAt = value
+	}
+	if value, ok := _c.mutation.FinishedAt(); ok {
+		_spec.SetField(ldapsyncrun.FieldFinishedAt, field.TypeTime, value)
+		_node.FinishedAt = value
+	}
+	if value, ok := _c.mutation.Stats(); ok {
+		_spec.SetField(ldapsyncrun.FieldStats, field.TypeJSON, value)
+		_node.Stats = value
+	}
+	if value, ok := _c.mutation.Changes(); ok {
+		_spec.SetField(ldapsyncrun.FieldChanges, field.TypeJSON, value)
+		_node.Changes = value
+	}
+	if value, ok := _c.mutation.Conflicts(); ok {
+		_spec.SetField(ldapsyncrun.FieldConflicts, field.TypeJSON, value)
+		_node.Conflicts = value
+	}
+	if value, ok := _c.mutation.Truncated(); ok {
+		_spec.SetField(ldapsyncrun.FieldTruncated, field.TypeBool, value)
+		_node.Truncated = value
+	}
+	if nodes := _c.mutation.ProviderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   ldapsyncrun.ProviderTable,
+			Columns: []string{ldapsyncrun.ProviderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ldapprovider.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ProviderID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// LdapSyncRunCreateBulk is the builder for creating many LdapSyncRun entities in bulk.
+type LdapSyncRunCreateBulk struct {
+	config
+	err      error
+	builders []*LdapSyncRunCreate
+}
+
+// Save creates the LdapSyncRun entities in the database.
+func (_c *LdapSyncRunCreateBulk) Save(ctx context.Context) ([]*LdapSyncRun, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*LdapSyncRun, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*LdapSyncRunMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *LdapSyncRunCreateBulk) SaveX(ctx context.Context) []*LdapSyncRun {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LdapSyncRunCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LdapSyncRunCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/coder-lulu/newbee-core/rpc/ent/ldapsyncrun"
+	"github.com/coder-lulu/newbee-core/rpc/ent/predicate"
+)
+
+// LdapSyncRunDelete is the builder for deleting a LdapSyncRun entity.
+type LdapSyncRunDelete struct {
+	config
+	hooks    []Hook
+	mutation *LdapSyncRunMutation
+}
+
+// Where appends a list predicates to the LdapSyncRunDelete builder.
+func (_d *LdapSyncRunDelete) Where(ps ...predicate.LdapSyncRun) *LdapSyncRunDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *LdapSyncRunDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *LdapSyncRunDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *LdapSyncRunDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(ldapsyncrun.Table, sqlgraph.NewFieldSpec(ldapsyncrun.FieldID, field.TypeUint64))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// LdapSyncRunDeleteOne is the builder for deleting a single LdapSyncRun entity.
+type LdapSyncRunDeleteOne struct {
+	_d *LdapSyncRunDelete
+}
+
+// Where appends a list predicates to the LdapSyncRunDelete builder.
+func (_d *LdapSyncRunDeleteOne) Where(ps ...predicate.LdapSyncRun) *LdapSyncRunDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *LdapSyncRunDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{ldapsyncrun.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *LdapSyncRunDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,805 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/coder-lulu/newbee-core/rpc/ent/ldapprovider"
+	"github.com/coder-lulu/newbee-core/rpc/ent/ldapsyncrun"
+	"github.com/coder-lulu/newbee-core/rpc/ent/predicate"
+	"github.com/coder-lulu/newbee-core/rpc/internal/ldap"
+)
+
+// LdapSyncRunUpdate is the builder for updating LdapSyncRun entities.
+type LdapSyncRunUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *LdapSyncRunMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the LdapSyncRunUpdate builder.
+func (_u *LdapSyncRunUpdate) Where(ps ...predicate.LdapSyncRun) *LdapSyncRunUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *LdapSyncRunUpdate) SetUpdatedAt(v time.Time) *LdapSyncRunUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetProviderID sets the "provider_id" field.
+func (_u *LdapSyncRunUpdate) SetProviderID(v uint64) *LdapSyncRunUpdate {
+	_u.mutation.SetProviderID(v)
+	return _u
+}
+
+// SetNillableProviderID sets the "provider_id" field if the given value is not nil.
+func (_u *LdapSyncRunUpdate) SetNillableProviderID(v *uint64) *LdapSyncRunUpdate {
+	if v != nil {
+		_u.SetProviderID(*v)
+	}
+	return _u
+}
+
+// SetTrigger sets the "trigger" field.
+func (_u *LdapSyncRunUpdate) SetTrigger(v string) *LdapSyncRunUpdate {
+	_u.mutation.SetTrigger(v)
+	return _u
+}
+
+// SetNillableTrigger sets the "trigger" field if the given value is not nil.
+func (_u *LdapSyncRunUpdate) SetNillableTrigger(v *string) *LdapSyncRunUpdate {
+	if v != nil {
+		_u.SetTrigger(*v)
+	}
+	return _u
+}
+
+// SetDryRun sets the "dry_run" field.
+func (_u *LdapSyncRunUpdate) SetDryRun(v bool) *LdapSyncRunUpdate {
+	_u.mutation.SetDryRun(v)
+	return _u
+}
+
+// SetNillableDryRun sets the "dry_run" field if the given value is not nil.
+func (_u *LdapSyncRunUpdate) SetNillableDryRun(v *bool) *LdapSyncRunUpdate {
+	if v != nil {
+		_u.SetDryRun(*v)
+	}
+	return _u
+}
+
+// SetResult sets the "result" field.
+func (_u *LdapSyncRunUpdate) SetResult(v string) *LdapSyncRunUpdate {
+	_u.mutation.SetResult(v)
+	return _u
+}
+
+// SetNillableResult sets the "result" field if the given value is not nil.
+func (_u *LdapSyncRunUpdate) SetNillableResult(v *string) *LdapSyncRunUpdate {
+	if v != nil {
+		_u.SetResult(*v)
+	}
+	return _u
+}
+
+// SetError sets the "error" field.
+func (_u *LdapSyncRunUpdate) SetError(v string) *LdapSyncRunUpdate {
+	_u.mutation.SetError(v)
+	return _u
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_u *LdapSyncRunUpdate) SetNillableError(v *string) *LdapSyncRunUpdate {
+	if v != nil {
+		_u.SetError(*v)
+	}
+	return _u
+}
+
+// ClearError clears the value of the "error" field.
+func (_u *LdapSyncRunUpdate) ClearError() *LdapSyncRunUpdate {
+	_u.mutation.ClearError()
+	return _u
+}
+
+// SetStartedAt sets the "started_at" field.
+func (_u *LdapSyncRunUpdate) SetStartedAt(v time.Time) *LdapSyncRunUpdate {
+	_u.mutation.SetStartedAt(v)
+	return _u
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (_u *LdapSyncRunUpdate) SetNillableStartedAt(v *time.Time) *LdapSyncRunUpdate {
+	if v != nil {
+		_u.SetStartedAt(*v)
+	}
+	return _u
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (_u *LdapSyncRunUpdate) SetFinishedAt(v time.Time) *LdapSyncRunUpdate {
+	_u.mutation.SetFinishedAt(v)
+	return _u
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (_u *LdapSyncRunUpdate) SetNillableFinishedAt(v *time.Time) *LdapSyncRunUpdate {
+	if v != nil {
+		_u.SetFinishedAt(*v)
+	}
+	return _u
+}
+
+// SetStats sets the "stats" field.
+func (_u *LdapSyncRunUpdate) SetStats(v ldap.SyncStats) *LdapSyncRunUpdate {
+	_u.mutation.SetStats(v)
+	return _u
+}
+
+// SetNillableStats sets the "stats" field if the given value is not nil.
+func (_u *LdapSyncRunUpdate) SetNillableStats(v *ldap.SyncStats) *LdapSyncRunUpdate {
+	if v != nil {
+		_u.SetStats(*v)
+	}
+	return _u
+}
+
+// ClearStats clears the value of the "stats" field.
+func (_u *LdapSyncRunUpdate) ClearStats() *LdapSyncRunUpdate {
+	_u.mutation.ClearStats()
+	return _u
+}
+
+// SetChanges sets the "changes" field.
+func (_u *LdapSyncRunUpdate) SetChanges(v []ldap.SyncChange) *LdapSyncRunUpdate {
+	_u.mutation.SetChanges(v)
+	return _u
+}
+
+// AppendChanges appends value to the "changes" field.
+func (_u *LdapSyncRunUpdate) AppendChanges(v []ldap.SyncChange) *LdapSyncRunUpdate {
+	_u.mutation.AppendChanges(v)
+	return _u
+}
+
+// ClearChanges clears the value of the "changes" field.
+func (_u *LdapSyncRunUpdate) ClearChanges() *LdapSyncRunUpdate {
+	_u.mutation.ClearChanges()
+	return _u
+}
+
+// SetConflicts sets the "conflicts" field.
+func (_u *LdapSyncRunUpdate) SetConflicts(v []ldap.SyncConflict) *LdapSyncRunUpdate {
+	_u.mutation.SetConflicts(v)
+	return _u
+}
+
+// AppendConflicts appends value to the "conflicts" field.
+func (_u *LdapSyncRunUpdate) AppendConflicts(v []ldap.SyncConflict) *LdapSyncRunUpdate {
+	_u.mutation.AppendConflicts(v)
+	return _u
+}
+
+// ClearConflicts clears the value of the "conflicts" field.
+func (_u *LdapSyncRunUpdate) ClearConflicts() *LdapSyncRunUpdate {
+	_u.mutation.ClearConflicts()
+	return _u
+}
+
+// SetTruncated sets the "truncated" field.
+func (_u *LdapSyncRunUpdate) SetTruncated(v bool) *LdapSyncRunUpdate {
+	_u.mutation.SetTruncated(v)
+	return _u
+}
+
+// SetNillableTruncated sets the "truncated" field if the given value is not nil.
+func (_u *LdapSyncRunUpdate) SetNillableTruncated(v *bool) *LdapSyncRunUpdate {
+	if v != nil {
+		_u.SetTruncated(*v)
+	}
+	return _u
+}
+
+// SetProvider sets the "provider" edge to the LdapProvider entity.
+func (_u *LdapSyncRunUpdate) SetProvider(v *LdapProvider) *LdapSyncRunUpdate {
+	return _u.SetProviderID(v.ID)
+}
+
+// Mutation returns the LdapSyncRunMutation object of the builder.
+func (_u *LdapSyncRunUpdate) Mutation() *LdapSyncRunMutation {
+	return _u.mutation
+}
+
+// ClearProvider clears the "provider" edge to the LdapProvider entity.
+func (_u *LdapSyncRunUpdate) ClearProvider() *LdapSyncRunUpdate {
+	_u.mutation.ClearProvider()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *LdapSyncRunUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *LdapSyncRunUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *LdapSyncRunUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *LdapSyncRunUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *LdapSyncRunUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := ldapsyncrun.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *LdapSyncRunUpdate) check() error {
+	if v, ok := _u.mutation.Trigger(); ok {
+		if err := ldapsyncrun.TriggerValidator(v); err != nil {
+			return &ValidationError{Name: "trigger", err: fmt.Errorf(`ent: validator failed for field "LdapSyncRun.trigger": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Result(); ok {
+		if err := ldapsyncrun.ResultValidator(v); err != nil {
+			return &ValidationError{Name: "result", err: fmt.Errorf(`ent: validator failed for field "LdapSyncRun.result": %w`, err)}
+		}
+	}
+	if _u.mutation.ProviderCleared() && len(_u.mutation.ProviderIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "LdapSyncRun.provider"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *LdapSyncRunUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *LdapSyncRunUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *LdapSyncRunUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(ldapsyncrun.Table, ldapsyncrun.Columns, sqlgraph.NewFieldSpec(ldapsyncrun.FieldID, field.TypeUint64))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(ldapsyncrun.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Trigger(); ok {
+		_spec.SetField(ldapsyncrun.FieldTrigger, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.DryRun(); ok {
+		_spec.SetField(ldapsyncrun.FieldDryRun, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Result(); ok {
+		_spec.SetField(ldapsyncrun.FieldResult, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Error(); ok {
+		_spec.SetField(ldapsyncrun.FieldError, field.TypeString, value)
+	}
+	if _u.mutation.ErrorCleared() {
+		_spec.ClearField(ldapsyncrun.FieldError, field.TypeString)
+	}
+	if value, ok := _u.mutation.StartedAt(); ok {
+		_spec.SetField(ldapsyncrun.FieldStartedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.FinishedAt(); ok {
+		_spec.SetField(ldapsyncrun.FieldFinishedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Stats(); ok {
+		_spec.SetField(ldapsyncrun.FieldStats, field.TypeJSON, value)
+	}
+	if _u.mutation.StatsCleared() {
+		_spec.ClearField(ldapsyncrun.FieldStats, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Changes(); ok {
+		_spec.SetField(ldapsyncrun.FieldChanges, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedChanges(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, ldapsyncrun.FieldChanges, value)
+		})
+	}
+	if _u.mutation.ChangesCleared() {
+		_spec.ClearField(ldapsyncrun.FieldChanges, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Conflicts(); ok {
+		_spec.SetField(ldapsyncrun.FieldConflicts, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedConflicts(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, ldapsyncrun.FieldConflicts, value)
+		})
+	}
+	if _u.mutation.ConflictsCleared() {
+		_spec.ClearField(ldapsyncrun.FieldConflicts, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Truncated(); ok {
+		_spec.SetField(ldapsyncrun.FieldTruncated, field.TypeBool, value)
+	}
+	if _u.mutation.ProviderCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   ldapsyncrun.ProviderTable,
+			Columns: []string{ldapsyncrun.ProviderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ldapprovider.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ProviderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   ldapsyncrun.ProviderTable,
+			Columns: []string{ldapsyncrun.ProviderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ldapprovider.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{ldapsyncrun.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// LdapSyncRunUpdateOne is the builder for updating a single LdapSyncRun entity.
+type LdapSyncRunUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *LdapSyncRunMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *LdapSyncRunUpdateOne) SetUpdatedAt(v time.Time) *LdapSyncRunUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetProviderID sets the "provider_id" field.
+func (_u *LdapSyncRunUpdateOne) SetProviderID(v uint64) *LdapSyncRunUpdateOne {
+	_u.mutation.SetProviderID(v)
+	return _u
+}
+
+// SetNillableProviderID sets the "provider_id" field if the given value is not nil.
+func (_u *LdapSyncRunUpdateOne) SetNillableProviderID(v *uint64) *LdapSyncRunUpdateOne {
+	if v != nil {
+		_u.SetProviderID(*v)
+	}
+	return _u
+}
+
+// SetTrigger sets the "trigger" field.
+func (_u *LdapSyncRunUpdateOne) SetTrigger(v string) *LdapSyncRunUpdateOne {
+	_u.mutation.SetTrigger(v)
+	return _u
+}
+
+// SetNillableTrigger sets the "trigger" field if the given value is not nil.
+func (_u *LdapSyncRunUpdateOne) SetNillableTrigger(v *string) *LdapSyncRunUpdateOne {
+	if v != nil {
+		_u.SetTrigger(*v)
+	}
+	return _u
+}
+
+// SetDryRun sets the "dry_run" field.
+func (_u *LdapSyncRunUpdateOne) SetDryRun(v bool) *LdapSyncRunUpdateOne {
+	_u.mutation.SetDryRun(v)
+	return _u
+}
+
+// SetNillableDryRun sets the "dry_run" field if the given value is not nil.
+func (_u *LdapSyncRunUpdateOne) SetNillableDryRun(v *bool) *LdapSyncRunUpdateOne {
+	if v != nil {
+		_u.SetDryRun(*v)
+	}
+	return _u
+}
+
+// SetResult sets the "result" field.
+func (_u *LdapSyncRunUpdateOne) SetResult(v string) *LdapSyncRunUpdateOne {
+	_u.mutation.SetResult(v)
+	return _u
+}
+
+// SetNillableResult sets the "result" field if the given value is not nil.
+func (_u *LdapSyncRunUpdateOne) SetNillableResult(v *string) *LdapSyncRunUpdateOne {
+	if v != nil {
+		_u.SetResult(*v)
+	}
+	return _u
+}
+
+// SetError sets the "error" field.
+func (_u *LdapSyncRunUpdateOne) SetError(v string) *LdapSyncRunUpdateOne {
+	_u.mutation.SetError(v)
+	return _u
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_u *LdapSyncRunUpdateOne) SetNillableError(v *string) *LdapSyncRunUpdateOne {
+	if v != nil {
+		_u.SetError(*v)
+	}
+	return _u
+}
+
+// ClearError clears the value of the "error" field.
+func (_u *LdapSyncRunUpdateOne) ClearError() *LdapSyncRunUpdateOne {
+	_u.mutation.ClearError()
+	return _u
+}
+
+// SetStartedAt sets the "started_at" field.
+func (_u *LdapSyncRunUpdateOne) SetStartedAt(v time.Time) *LdapSyncRunUpdateOne {
+	_u.mutation.SetStartedAt(v)
+	return _u
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (_u *LdapSyncRunUpdateOne) SetNillableStartedAt(v *time.Time) *LdapSyncRunUpdateOne {
+	if v != nil {
+		_u.SetStartedAt(*v)
+	}
+	return _u
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (_u *LdapSyncRunUpdateOne) SetFinishedAt(v time.Time) *LdapSyncRunUpdateOne {
+	_u.mutation.SetFinishedAt(v)
+	return _u
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (_u *LdapSyncRunUpdateOne) SetNillableFinishedAt(v *time.Time) *LdapSyncRunUpdateOne {
+	if v != nil {
+		_u.SetFinishedAt(*v)
+	}
+	return _u
+}
+
+// SetStats sets the "stats" field.
+func (_u *LdapSyncRunUpdateOne) SetStats(v ldap.SyncStats) *LdapSyncRunUpdateOne {
+	_u.mutation.SetStats(v)
+	return _u
+}
+
+// SetNillableStats sets the "stats" field if the given value is not nil.
+func (_u *LdapSyncRunUpdateOne) SetNillableStats(v *ldap.SyncStats) *LdapSyncRunUpdateOne {
+	if v != nil {
+		_u.SetStats(*v)
+	}
+	return _u
+}
+
+// ClearStats clears the value of the "stats" field.
+func (_u *LdapSyncRunUpdateOne) ClearStats() *LdapSyncRunUpdateOne {
+	_u.mutation.ClearStats()
+	return _u
+}
+
+// SetChanges sets the "changes" field.
+func (_u *LdapSyncRunUpdateOne) SetChanges(v []ldap.SyncChange) *LdapSyncRunUpdateOne {
+	_u.mutation.SetChanges(v)
+	return _u
+}
+
+// AppendChanges appends value to the "changes" field.
+func (_u *LdapSyncRunUpdateOne) AppendChanges(v []ldap.SyncChange) *LdapSyncRunUpdateOne {
+	_u.mutation.AppendChanges(v)
+	return _u
+}
+
+// ClearChanges clears the value of the "changes" field.
+func (_u *LdapSyncRunUpdateOne) ClearChanges() *LdapSyncRunUpdateOne {
+	_u.mutation.ClearChanges()
+	return _u
+}
+
+// SetConflicts sets the "conflicts" field.
+func (_u *LdapSyncRunUpdateOne) SetConflicts(v []ldap.SyncConflict) *LdapSyncRunUpdateOne {
+	_u.mutation.SetConflicts(v)
+	return _u
+}
+
+// AppendConflicts appends value to the "conflicts" field.
+func (_u *LdapSyncRunUpdateOne) AppendConflicts(v []ldap.SyncConflict) *LdapSyncRunUpdateOne {
+	_u.mutation.AppendConflicts(v)
+	return _u
+}
+
+// ClearConflicts clears the value of the "conflicts" field.
+func (_u *LdapSyncRunUpdateOne) ClearConflicts() *LdapSyncRunUpdateOne {
+	_u.mutation.ClearConflicts()
+	return _u
+}
+
+// SetTruncated sets the "truncated" field.
+func (_u *LdapSyncRunUpdateOne) SetTruncated(v bool) *LdapSyncRunUpdateOne {
+	_u.mutation.SetTruncated(v)
+	return _u
+}
+
+// SetNillableTruncated sets the "truncated" field if the given value is not nil.
+func (_u *LdapSyncRunUpdateOne) SetNillableTruncated(v *bool) *LdapSyncRunUpdateOne {
+	if v != nil {
+		_u.SetTruncated(*v)
+	}
+	return _u
+}
+
+// SetProvider sets the "provider" edge to the LdapProvider entity.
+func (_u *LdapSyncRunUpdateOne) SetProvider(v *LdapProvider) *LdapSyncRunUpdateOne {
+	return _u.SetProviderID(v.ID)
+}
+
+// Mutation returns the LdapSyncRunMutation object of the builder.
+func (_u *LdapSyncRunUpdateOne) Mutation() *LdapSyncRunMutation {
+	return _u.mutation
+}
+
+// ClearProvider clears the "provider" edge to the LdapProvider entity.
+func (_u *LdapSyncRunUpdateOne) ClearProvider() *LdapSyncRunUpdateOne {
+	_u.mutation.ClearProvider()
+	return _u
+}
+
+// Where appends a list predicates to the LdapSyncRunUpdate builder.
+func (_u *LdapSyncRunUpdateOne) Where(ps ...predicate.LdapSyncRun) *LdapSyncRunUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *LdapSyncRunUpdateOne) Select(field string, fields ...string) *LdapSyncRunUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated LdapSyncRun entity.
+func (_u *LdapSyncRunUpdateOne) Save(ctx context.Context) (*LdapSyncRun, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *LdapSyncRunUpdateOne) SaveX(ctx context.Context) *LdapSyncRun {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *LdapSyncRunUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *LdapSyncRunUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *LdapSyncRunUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := ldapsyncrun.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *LdapSyncRunUpdateOne) check() error {
+	if v, ok := _u.mutation.Trigger(); ok {
+		if err := ldapsyncrun.TriggerValidator(v); err != nil {
+			return &ValidationError{Name: "trigger", err: fmt.Errorf(`ent: validator failed for field "LdapSyncRun.trigger": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Result(); ok {
+		if err := ldapsyncrun.ResultValidator(v); err != nil {
+			return &ValidationError{Name: "result", err: fmt.Errorf(`ent: validator failed for field "LdapSyncRun.result": %w`, err)}
+		}
+	}
+	if _u.mutation.ProviderCleared() && len(_u.mutation.ProviderIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "LdapSyncRun.provider"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *LdapSyncRunUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *LdapSyncRunUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *LdapSyncRunUpdateOne) sqlSave(ctx context.Context) (_node *LdapSyncRun, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(ldapsyncrun.Table, ldapsyncrun.Columns, sqlgraph.NewFieldSpec(ldapsyncrun.FieldID, field.TypeUint64))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "LdapSyncRun.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, ldapsyncrun.FieldID)
+		for _, f := range fields {
+			if !ldapsyncrun.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != ldapsyncrun.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(ldapsyncrun.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Trigger(); ok {
+		_spec.SetField(ldapsyncrun.FieldTrigger, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.DryRun(); ok {
+		_spec.SetField(ldapsyncrun.FieldDryRun, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Result(); ok {
+		_spec.SetField(ldapsyncrun.FieldResult, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Error(); ok {
+		_spec.SetField(ldapsyncrun.FieldError, field.TypeString, value)
+	}
+	if _u.mutation.ErrorCleared() {
+		_spec.ClearField(ldapsyncrun.FieldError, field.TypeString)
+	}
+	if value, ok := _u.mutation.StartedAt(); ok {
+		_spec.SetField(ldapsyncrun.FieldStartedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.FinishedAt(); ok {
+		_spec.SetField(ldapsyncrun.FieldFinishedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Stats(); ok {
+		_spec.SetField(ldapsyncrun.FieldStats, field.TypeJSON, value)
+	}
+	if _u.mutation.StatsCleared() {
+		_spec.ClearField(ldapsyncrun.FieldStats, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Changes(); ok {
+		_spec.SetField(ldapsyncrun.FieldChanges, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedChanges(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, ldapsyncrun.FieldChanges, value)
+		})
+	}
+	if _u.mutation.ChangesCleared() {
+		_spec.ClearField(ldapsyncrun.FieldChanges, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Conflicts(); ok {
+		_spec.SetField(ldapsyncrun.FieldConflicts, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedConflicts(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, ldapsyncrun.FieldConflicts, value)
+		})
+	}
+	if _u.mutation.ConflictsCleared() {
+		_spec.ClearField(ldapsyncrun.FieldConflicts, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Truncated(); ok {
+		_spec.SetField(ldapsyncrun.FieldTruncated, field.TypeBool, value)
+	}
+	if _u.mutation.ProviderCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   ldapsyncrun.ProviderTable,
+			Columns: []string{ldapsyncrun.ProviderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ldapprovider.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ProviderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   ldapsyncrun.ProviderTable,
+			Columns: []string{ldapsyncrun.ProviderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ldapprovider.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &LdapSyncRun{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{ldapsyncrun.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
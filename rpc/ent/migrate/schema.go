@@ -21,6 +21,8 @@ var (
 		{Name: "service_name", Type: field.TypeString, Comment: "Service name | 服务名称", Default: "Other"},
 		{Name: "method", Type: field.TypeString, Comment: "HTTP method | HTTP 请求类型", Default: "POST"},
 		{Name: "is_required", Type: field.TypeBool, Comment: "Whether is required | 是否必选", Default: false},
+		{Name: "is_deprecated", Type: field.TypeBool, Comment: "The service no longer registers the API | 服务已不再登记该接口", Default: false},
+		{Name: "deprecated_at", Type: field.TypeTime, Nullable: true, Comment: "Deprecated time | 废弃时间"},
 	}
 	// SysApisTable holds the schema information for the "sys_apis" table.
 	SysApisTable = &schema.Table{
@@ -30,9 +32,9 @@ var (
 		PrimaryKey: []*schema.Column{SysApisColumns[0]},
 		Indexes: []*schema.Index{
 			{
-				Name:    "api_path_method",
+				Name:    "api_service_name_path_method",
 				Unique:  true,
-				Columns: []*schema.Column{SysApisColumns[4], SysApisColumns[8]},
+				Columns: []*schema.Column{SysApisColumns[7], SysApisColumns[4], SysApisColumns[8]},
 			},
 		},
 	}
@@ -58,6 +60,9 @@ var (
 		{Name: "duration_ms", Type: field.TypeInt64, Comment: "Request processing duration in milliseconds | 请求处理耗时(毫秒)", Default: 0},
 		{Name: "error_message", Type: field.TypeString, Nullable: true, Size: 2147483647, Comment: "Error message if operation failed | 操作失败时的错误信息"},
 		{Name: "metadata", Type: field.TypeJSON, Nullable: true, Comment: "Additional metadata in JSON format | 额外的元数据(JSON格式)"},
+		{Name: "seq", Type: field.TypeUint64, Nullable: true, Comment: "Sequence number in the tenant hash chain, null for legacy records | 租户哈希链中的序号，历史记录为空"},
+		{Name: "prev_hash", Type: field.TypeString, Nullable: true, Comment: "Hash of the previous record in the chain | 链中上一条记录的哈希", SchemaType: map[string]string{"mysql": "char(64)"}},
+		{Name: "hash", Type: field.TypeString, Nullable: true, Comment: "SHA-256 hash of this record and prev_hash | 本条记录与 prev_hash 的 SHA-256 哈希", SchemaType: map[string]string{"mysql": "char(64)"}},
 	}
 	// SysAuditLogsTable holds the schema information for the "sys_audit_logs" table.
 	SysAuditLogsTable = &schema.Table{
@@ -121,6 +126,11 @@ var (
 					},
 				},
 			},
+			{
+				Name:    "auditlog_tenant_id_seq",
+				Unique:  false,
+				Columns: []*schema.Column{SysAuditLogsColumns[4], SysAuditLogsColumns[20]},
+			},
 			{
 				Name:    "auditlog_tenant_id_created_at",
 				Unique:  false,
@@ -133,6 +143,111 @@ var (
 			},
 		},
 	}
+	// SysAuditLogArchivesColumns holds the columns for the "sys_audit_log_archives" table.
+	SysAuditLogArchivesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Comment: "UUID"},
+		{Name: "created_at", Type: field.TypeTime, Comment: "Create Time | 创建日期"},
+		{Name: "updated_at", Type: field.TypeTime, Comment: "Update Time | 修改日期"},
+		{Name: "tenant_id", Type: field.TypeString, Comment: "Tenant ID | 租户ID"},
+		{Name: "from_seq", Type: field.TypeUint64, Comment: "First archived sequence number | 归档的起始序号"},
+		{Name: "to_seq", Type: field.TypeUint64, Comment: "Last archived sequence number | 归档的结束序号"},
+		{Name: "count", Type: field.TypeInt, Comment: "Number of archived records | 归档的记录数"},
+		{Name: "start_at", Type: field.TypeTime, Comment: "Creation time of the earliest record | 最早记录的创建时间"},
+		{Name: "end_at", Type: field.TypeTime, Comment: "Creation time of the latest record | 最晚记录的创建时间"},
+		{Name: "store", Type: field.TypeString, Comment: "Blob store name | 存储类型"},
+		{Name: "object_key", Type: field.TypeString, Comment: "Object key in the blob store | 存储中的对象键"},
+		{Name: "size", Type: field.TypeInt64, Comment: "Object size in bytes | 对象大小(字节)"},
+		{Name: "checksum", Type: field.TypeString, Comment: "SHA-256 checksum of the object | 对象的 SHA-256 校验和", SchemaType: map[string]string{"mysql": "char(64)"}},
+	}
+	// SysAuditLogArchivesTable holds the schema information for the "sys_audit_log_archives" table.
+	SysAuditLogArchivesTable = &schema.Table{
+		Name:       "sys_audit_log_archives",
+		Comment:    "Audit Log Archive Manifest Table | 审计日志归档清单表",
+		Columns:    SysAuditLogArchivesColumns,
+		PrimaryKey: []*schema.Column{SysAuditLogArchivesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "auditlogarchive_tenant_id_to_seq",
+				Unique:  true,
+				Columns: []*schema.Column{SysAuditLogArchivesColumns[3], SysAuditLogArchivesColumns[5]},
+			},
+			{
+				Name:    "auditlogarchive_tenant_id_start_at_end_at",
+				Unique:  false,
+				Columns: []*schema.Column{SysAuditLogArchivesColumns[3], SysAuditLogArchivesColumns[7], SysAuditLogArchivesColumns[8]},
+			},
+		},
+	}
+	// SysAuditLogChainsColumns holds the columns for the "sys_audit_log_chains" table.
+	SysAuditLogChainsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Comment: "UUID"},
+		{Name: "created_at", Type: field.TypeTime, Comment: "Create Time | 创建日期"},
+		{Name: "updated_at", Type: field.TypeTime, Comment: "Update Time | 修改日期"},
+		{Name: "tenant_id", Type: field.TypeString, Unique: true, Comment: "Tenant ID | 租户ID"},
+		{Name: "seq", Type: field.TypeUint64, Comment: "Sequence number of the chain head | 链头序号"},
+		{Name: "hash", Type: field.TypeString, Comment: "Hash of the chain head | 链头哈希", SchemaType: map[string]string{"mysql": "char(64)"}},
+	}
+	// SysAuditLogChainsTable holds the schema information for the "sys_audit_log_chains" table.
+	SysAuditLogChainsTable = &schema.Table{
+		Name:       "sys_audit_log_chains",
+		Comment:    "Audit Log Chain Head Table | 审计日志哈希链链头表",
+		Columns:    SysAuditLogChainsColumns,
+		PrimaryKey: []*schema.Column{SysAuditLogChainsColumns[0]},
+	}
+	// SysAuditLogCheckpointsColumns holds the columns for the "sys_audit_log_checkpoints" table.
+	SysAuditLogCheckpointsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Comment: "UUID"},
+		{Name: "created_at", Type: field.TypeTime, Comment: "Create Time | 创建日期"},
+		{Name: "updated_at", Type: field.TypeTime, Comment: "Update Time | 修改日期"},
+		{Name: "tenant_id", Type: field.TypeString, Comment: "Tenant ID | 租户ID"},
+		{Name: "seq", Type: field.TypeUint64, Comment: "Sequence number of the chain head when signed | 签名时链头的序号"},
+		{Name: "hash", Type: field.TypeString, Comment: "Hash of the chain head | 链头记录的哈希", SchemaType: map[string]string{"mysql": "char(64)"}},
+		{Name: "key_id", Type: field.TypeString, Comment: "ID of the signing key | 签名密钥ID"},
+		{Name: "signature", Type: field.TypeString, Comment: "HMAC-SHA256 signature of the checkpoint | 检查点的 HMAC-SHA256 签名"},
+	}
+	// SysAuditLogCheckpointsTable holds the schema information for the "sys_audit_log_checkpoints" table.
+	SysAuditLogCheckpointsTable = &schema.Table{
+		Name:       "sys_audit_log_checkpoints",
+		Comment:    "Audit Log Checkpoint Table | 审计日志签名检查点表",
+		Columns:    SysAuditLogCheckpointsColumns,
+		PrimaryKey: []*schema.Column{SysAuditLogCheckpointsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "auditlogcheckpoint_tenant_id_seq",
+				Unique:  true,
+				Columns: []*schema.Column{SysAuditLogCheckpointsColumns[3], SysAuditLogCheckpointsColumns[4]},
+			},
+		},
+	}
+	// SysAuditLogPruneRecordsColumns holds the columns for the "sys_audit_log_prune_records" table.
+	SysAuditLogPruneRecordsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Comment: "UUID"},
+		{Name: "created_at", Type: field.TypeTime, Comment: "Create Time | 创建日期"},
+		{Name: "updated_at", Type: field.TypeTime, Comment: "Update Time | 修改日期"},
+		{Name: "tenant_id", Type: field.TypeString, Comment: "Tenant ID | 租户ID"},
+		{Name: "from_seq", Type: field.TypeUint64, Comment: "First pruned sequence number | 清理的起始序号"},
+		{Name: "to_seq", Type: field.TypeUint64, Comment: "Last pruned sequence number | 清理的结束序号"},
+		{Name: "count", Type: field.TypeInt, Comment: "Number of pruned records | 清理的记录数"},
+		{Name: "last_hash", Type: field.TypeString, Comment: "Hash of the last pruned record, the new chain anchor | 最后一条被清理记录的哈希，作为新的链起点", SchemaType: map[string]string{"mysql": "char(64)"}},
+		{Name: "cutoff_at", Type: field.TypeTime, Comment: "Records created before this time were pruned | 清理该时间之前创建的记录"},
+		{Name: "policy", Type: field.TypeString, Comment: "Retention policy that triggered the pruning | 触发清理的保留策略"},
+		{Name: "key_id", Type: field.TypeString, Comment: "ID of the signing key | 签名密钥ID"},
+		{Name: "signature", Type: field.TypeString, Comment: "HMAC-SHA256 signature of the record | 记录的 HMAC-SHA256 签名"},
+	}
+	// SysAuditLogPruneRecordsTable holds the schema information for the "sys_audit_log_prune_records" table.
+	SysAuditLogPruneRecordsTable = &schema.Table{
+		Name:       "sys_audit_log_prune_records",
+		Comment:    "Audit Log Prune Record Table | 审计日志清理记录表",
+		Columns:    SysAuditLogPruneRecordsColumns,
+		PrimaryKey: []*schema.Column{SysAuditLogPruneRecordsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "auditlogprunerecord_tenant_id_to_seq",
+				Unique:  true,
+				Columns: []*schema.Column{SysAuditLogPruneRecordsColumns[3], SysAuditLogPruneRecordsColumns[5]},
+			},
+		},
+	}
 	// SysCasbinRulesColumns holds the columns for the "sys_casbin_rules" table.
 	SysCasbinRulesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUint64, Increment: true},
@@ -147,6 +262,7 @@ var (
 		{Name: "v3", Type: field.TypeString, Nullable: true},
 		{Name: "v4", Type: field.TypeString, Nullable: true, Size: 2147483647},
 		{Name: "v5", Type: field.TypeString, Nullable: true},
+		{Name: "conditions", Type: field.TypeString, Nullable: true, Size: 2147483647},
 		{Name: "service_name", Type: field.TypeString},
 		{Name: "rule_name", Type: field.TypeString, Nullable: true},
 		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2147483647},
@@ -173,7 +289,7 @@ var (
 			{
 				Name:    "casbinrule_tenant_id_service_name_status",
 				Unique:  false,
-				Columns: []*schema.Column{SysCasbinRulesColumns[4], SysCasbinRulesColumns[12], SysCasbinRulesColumns[3]},
+				Columns: []*schema.Column{SysCasbinRulesColumns[4], SysCasbinRulesColumns[13], SysCasbinRulesColumns[3]},
 			},
 			{
 				Name:    "casbinrule_ptype_v0_v1",
@@ -188,17 +304,17 @@ var (
 			{
 				Name:    "casbinrule_approval_status_require_approval",
 				Unique:  false,
-				Columns: []*schema.Column{SysCasbinRulesColumns[18], SysCasbinRulesColumns[17]},
+				Columns: []*schema.Column{SysCasbinRulesColumns[19], SysCasbinRulesColumns[18]},
 			},
 			{
 				Name:    "casbinrule_effective_from_effective_to",
 				Unique:  false,
-				Columns: []*schema.Column{SysCasbinRulesColumns[21], SysCasbinRulesColumns[22]},
+				Columns: []*schema.Column{SysCasbinRulesColumns[22], SysCasbinRulesColumns[23]},
 			},
 			{
 				Name:    "casbinrule_category_service_name",
 				Unique:  false,
-				Columns: []*schema.Column{SysCasbinRulesColumns[15], SysCasbinRulesColumns[12]},
+				Columns: []*schema.Column{SysCasbinRulesColumns[16], SysCasbinRulesColumns[13]},
 			},
 			{
 				Name:    "casbinrule_tenant_id_ptype_v0_v1_status",
@@ -328,6 +444,236 @@ var (
 			},
 		},
 	}
+	// SysImpersonationSessionsColumns holds the columns for the "sys_impersonation_sessions" table.
+	SysImpersonationSessionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint64, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, Comment: "Create Time | 创建日期"},
+		{Name: "updated_at", Type: field.TypeTime, Comment: "Update Time | 修改日期"},
+		{Name: "user_id", Type: field.TypeString, Size: 36, Comment: "The super admin's user ID | 超级管理员用户ID"},
+		{Name: "user_name", Type: field.TypeString, Nullable: true, Size: 100, Comment: "The super admin's username | 超级管理员用户名"},
+		{Name: "origin_tenant_id", Type: field.TypeUint64, Comment: "The super admin's own tenant | 超级管理员所属租户ID"},
+		{Name: "target_tenant_id", Type: field.TypeUint64, Comment: "The impersonated tenant | 进入的租户ID"},
+		{Name: "reason", Type: field.TypeString, Size: 500, Comment: "Reason of the access | 访问原因"},
+		{Name: "ticket", Type: field.TypeString, Nullable: true, Size: 100, Comment: "Support ticket | 工单号"},
+		{Name: "state", Type: field.TypeString, Size: 20, Comment: "State: pending, active, ended, rejected | 会话状态"},
+		{Name: "duration_seconds", Type: field.TypeInt64, Comment: "Requested duration in seconds | 申请的会话时长(秒)"},
+		{Name: "approved_by", Type: field.TypeString, Nullable: true, Size: 36, Comment: "The user who approved or rejected the request | 审批人用户ID"},
+		{Name: "approved_at", Type: field.TypeTime, Nullable: true, Comment: "Approval time | 审批时间"},
+		{Name: "started_at", Type: field.TypeTime, Nullable: true, Comment: "Start time | 开始时间"},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true, Comment: "Expiry time, the session ends automatically | 到期时间，到期后自动结束"},
+		{Name: "ended_at", Type: field.TypeTime, Nullable: true, Comment: "End time when ended manually | 手动结束时间"},
+	}
+	// SysImpersonationSessionsTable holds the schema information for the "sys_impersonation_sessions" table.
+	SysImpersonationSessionsTable = &schema.Table{
+		Name:       "sys_impersonation_sessions",
+		Comment:    "Tenant Impersonation Session Table | 租户模拟访问会话表",
+		Columns:    SysImpersonationSessionsColumns,
+		PrimaryKey: []*schema.Column{SysImpersonationSessionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "impersonationsession_target_tenant_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{SysImpersonationSessionsColumns[6], SysImpersonationSessionsColumns[1]},
+			},
+			{
+				Name:    "impersonationsession_user_id_state",
+				Unique:  false,
+				Columns: []*schema.Column{SysImpersonationSessionsColumns[3], SysImpersonationSessionsColumns[9]},
+			},
+		},
+	}
+	// SysLdapAccountsColumns holds the columns for the "sys_ldap_accounts" table.
+	SysLdapAccountsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint64, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, Comment: "Create Time | 创建日期"},
+		{Name: "updated_at", Type: field.TypeTime, Comment: "Update Time | 修改日期"},
+		{Name: "status", Type: field.TypeUint8, Nullable: true, Comment: "Status 1: normal 2: ban | 状态 1 正常 2 禁用", Default: 1},
+		{Name: "tenant_id", Type: field.TypeUint64, Comment: "Tenant ID | 租户 ID", Default: 1},
+		{Name: "external_id", Type: field.TypeString, Size: 255, Comment: "Unique ID of the entry, e.g. entryUUID or objectGUID | 目录条目的唯一标识"},
+		{Name: "dn", Type: field.TypeString, Size: 1000, Comment: "DN of the entry | 目录条目DN"},
+		{Name: "synced_at", Type: field.TypeTime, Nullable: true, Comment: "Last sync time | 最近同步时间"},
+		{Name: "last_login_at", Type: field.TypeTime, Nullable: true, Comment: "Last login time | 最后登录时间"},
+		{Name: "last_login_ip", Type: field.TypeString, Nullable: true, Size: 45, Comment: "Last login IP address | 最后登录IP地址"},
+		{Name: "login_count", Type: field.TypeUint32, Comment: "Login count | 登录次数", Default: 0},
+		{Name: "provider_id", Type: field.TypeUint64, Comment: "LDAP provider ID | LDAP目录ID"},
+		{Name: "user_id", Type: field.TypeUUID, Comment: "Associated user ID | 关联的用户ID"},
+	}
+	// SysLdapAccountsTable holds the schema information for the "sys_ldap_accounts" table.
+	SysLdapAccountsTable = &schema.Table{
+		Name:       "sys_ldap_accounts",
+		Comment:    "LDAP Account Binding Table | LDAP账户绑定表",
+		Columns:    SysLdapAccountsColumns,
+		PrimaryKey: []*schema.Column{SysLdapAccountsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "sys_ldap_accounts_sys_ldap_providers_ldap_accounts",
+				Columns:    []*schema.Column{SysLdapAccountsColumns[11]},
+				RefColumns: []*schema.Column{SysLdapProvidersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "sys_ldap_accounts_sys_users_ldap_accounts",
+				Columns:    []*schema.Column{SysLdapAccountsColumns[12]},
+				RefColumns: []*schema.Column{SysUsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "ldapaccount_provider_id_external_id_tenant_id",
+				Unique:  true,
+				Columns: []*schema.Column{SysLdapAccountsColumns[11], SysLdapAccountsColumns[5], SysLdapAccountsColumns[4]},
+			},
+			{
+				Name:    "ldapaccount_user_id_provider_id_tenant_id",
+				Unique:  true,
+				Columns: []*schema.Column{SysLdapAccountsColumns[12], SysLdapAccountsColumns[11], SysLdapAccountsColumns[4]},
+			},
+			{
+				Name:    "ldapaccount_user_id_tenant_id",
+				Unique:  false,
+				Columns: []*schema.Column{SysLdapAccountsColumns[12], SysLdapAccountsColumns[4]},
+			},
+		},
+	}
+	// SysLdapDepartmentsColumns holds the columns for the "sys_ldap_departments" table.
+	SysLdapDepartmentsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint64, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, Comment: "Create Time | 创建日期"},
+		{Name: "updated_at", Type: field.TypeTime, Comment: "Update Time | 修改日期"},
+		{Name: "tenant_id", Type: field.TypeUint64, Comment: "Tenant ID | 租户 ID", Default: 1},
+		{Name: "department_id", Type: field.TypeUint64, Comment: "Mirrored department ID | 同步生成的部门ID"},
+		{Name: "external_id", Type: field.TypeString, Size: 255, Comment: "Unique ID of the organizational unit | 组织单位的唯一标识"},
+		{Name: "dn", Type: field.TypeString, Size: 1000, Comment: "DN of the organizational unit | 组织单位DN"},
+		{Name: "synced_at", Type: field.TypeTime, Nullable: true, Comment: "Last sync time | 最近同步时间"},
+		{Name: "provider_id", Type: field.TypeUint64, Comment: "LDAP provider ID | LDAP目录ID"},
+	}
+	// SysLdapDepartmentsTable holds the schema information for the "sys_ldap_departments" table.
+	SysLdapDepartmentsTable = &schema.Table{
+		Name:       "sys_ldap_departments",
+		Comment:    "LDAP Organizational Unit Binding Table | LDAP组织单位与部门的绑定表",
+		Columns:    SysLdapDepartmentsColumns,
+		PrimaryKey: []*schema.Column{SysLdapDepartmentsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "sys_ldap_departments_sys_ldap_providers_ldap_departments",
+				Columns:    []*schema.Column{SysLdapDepartmentsColumns[8]},
+				RefColumns: []*schema.Column{SysLdapProvidersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "ldapdepartment_provider_id_external_id_tenant_id",
+				Unique:  true,
+				Columns: []*schema.Column{SysLdapDepartmentsColumns[8], SysLdapDepartmentsColumns[5], SysLdapDepartmentsColumns[3]},
+			},
+			{
+				Name:    "ldapdepartment_department_id_tenant_id",
+				Unique:  false,
+				Columns: []*schema.Column{SysLdapDepartmentsColumns[4], SysLdapDepartmentsColumns[3]},
+			},
+		},
+	}
+	// SysLdapProvidersColumns holds the columns for the "sys_ldap_providers" table.
+	SysLdapProvidersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint64, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, Comment: "Create Time | 创建日期"},
+		{Name: "updated_at", Type: field.TypeTime, Comment: "Update Time | 修改日期"},
+		{Name: "status", Type: field.TypeUint8, Nullable: true, Comment: "Status 1: normal 2: ban | 状态 1 正常 2 禁用", Default: 1},
+		{Name: "tenant_id", Type: field.TypeUint64, Comment: "Tenant ID | 租户 ID", Default: 1},
+		{Name: "name", Type: field.TypeString, Size: 50, Comment: "The provider's name | 目录名称"},
+		{Name: "display_name", Type: field.TypeString, Nullable: true, Size: 100, Comment: "Display name for UI | UI显示名称"},
+		{Name: "directory_type", Type: field.TypeString, Size: 20, Comment: "Directory type: openldap, ad | 目录类型", Default: "openldap"},
+		{Name: "url", Type: field.TypeString, Size: 500, Comment: "Server URL, ldap:// or ldaps:// | 服务器地址"},
+		{Name: "start_tls", Type: field.TypeBool, Comment: "Whether to upgrade the connection with StartTLS | 是否使用StartTLS", Default: false},
+		{Name: "insecure_skip_verify", Type: field.TypeBool, Comment: "Whether to skip the verification of the server certificate | 是否跳过证书校验", Default: false},
+		{Name: "bind_dn", Type: field.TypeString, Nullable: true, Size: 500, Comment: "Service account DN, empty for anonymous searches | 服务账号DN"},
+		{Name: "encrypted_bind_password", Type: field.TypeString, Nullable: true, Size: 2147483647, Comment: "Encrypted service account password | 加密的服务账号密码"},
+		{Name: "encryption_key_id", Type: field.TypeString, Nullable: true, Size: 100, Comment: "Encryption key ID | 加密密钥ID"},
+		{Name: "base_dn", Type: field.TypeString, Size: 500, Comment: "Base DN of users | 用户查询的基础DN"},
+		{Name: "auth_mode", Type: field.TypeString, Size: 20, Comment: "Authentication mode: template, search | 认证方式：DN模板或先查询后绑定", Default: "search"},
+		{Name: "user_dn_template", Type: field.TypeString, Nullable: true, Size: 500, Comment: "User DN template containing {username} | 用户DN模板"},
+		{Name: "user_filter", Type: field.TypeString, Nullable: true, Size: 1000, Comment: "User filter containing {username} | 用户过滤条件"},
+		{Name: "ou_base_dn", Type: field.TypeString, Nullable: true, Size: 500, Comment: "Base DN of organizational units | 组织单位的基础DN"},
+		{Name: "ou_filter", Type: field.TypeString, Nullable: true, Size: 500, Comment: "Organizational unit filter | 组织单位过滤条件"},
+		{Name: "group_base_dn", Type: field.TypeString, Nullable: true, Size: 500, Comment: "Base DN of groups | 组的基础DN"},
+		{Name: "group_filter", Type: field.TypeString, Nullable: true, Size: 500, Comment: "Group filter | 组过滤条件"},
+		{Name: "group_member_attribute", Type: field.TypeString, Nullable: true, Size: 50, Comment: "Member attribute of groups | 组成员属性"},
+		{Name: "attribute_mapping", Type: field.TypeJSON, Nullable: true, Comment: "Attribute mapping to users and roles | 属性到用户和角色的映射"},
+		{Name: "login_enabled", Type: field.TypeBool, Comment: "Whether users can log in with the directory password | 是否允许目录密码登录", Default: true},
+		{Name: "jit_provision", Type: field.TypeBool, Comment: "Whether to create users on first login | 是否在首次登录时自动创建用户", Default: false},
+		{Name: "link_existing", Type: field.TypeBool, Comment: "Whether to link local users with the same username | 是否绑定同名的本地用户", Default: false},
+		{Name: "sync_enabled", Type: field.TypeBool, Comment: "Whether to sync the directory periodically | 是否定时同步目录", Default: false},
+		{Name: "sync_interval", Type: field.TypeUint32, Comment: "Sync interval in minutes | 同步间隔（分钟）", Default: 60},
+		{Name: "deletion_policy", Type: field.TypeString, Size: 20, Comment: "Policy for entries removed from the directory: keep, disable, delete | 目录中删除的条目处理策略", Default: "disable"},
+		{Name: "root_department_id", Type: field.TypeUint64, Nullable: true, Comment: "Department under which organizational units are mirrored | 组织单位同步到的上级部门", Default: 0},
+		{Name: "default_department_id", Type: field.TypeUint64, Nullable: true, Comment: "Department of users outside any organizational unit | 不属于任何组织单位的用户的默认部门", Default: 0},
+		{Name: "default_role_ids", Type: field.TypeJSON, Nullable: true, Comment: "Roles granted to provisioned users | 自动创建用户的默认角色"},
+		{Name: "last_sync_at", Type: field.TypeTime, Nullable: true, Comment: "Last sync time | 最近同步时间"},
+		{Name: "enabled", Type: field.TypeBool, Comment: "Whether the provider is enabled | 是否启用", Default: true},
+		{Name: "sort", Type: field.TypeUint32, Comment: "Sort order | 排序", Default: 0},
+		{Name: "remark", Type: field.TypeString, Nullable: true, Size: 200, Comment: "Remark | 备注"},
+	}
+	// SysLdapProvidersTable holds the schema information for the "sys_ldap_providers" table.
+	SysLdapProvidersTable = &schema.Table{
+		Name:       "sys_ldap_providers",
+		Comment:    "LDAP Directory Table | LDAP目录配置表",
+		Columns:    SysLdapProvidersColumns,
+		PrimaryKey: []*schema.Column{SysLdapProvidersColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "ldapprovider_name_tenant_id",
+				Unique:  true,
+				Columns: []*schema.Column{SysLdapProvidersColumns[5], SysLdapProvidersColumns[4]},
+			},
+			{
+				Name:    "ldapprovider_enabled_status_tenant_id",
+				Unique:  false,
+				Columns: []*schema.Column{SysLdapProvidersColumns[34], SysLdapProvidersColumns[3], SysLdapProvidersColumns[4]},
+			},
+		},
+	}
+	// SysLdapSyncRunsColumns holds the columns for the "sys_ldap_sync_runs" table.
+	SysLdapSyncRunsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint64, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, Comment: "Create Time | 创建日期"},
+		{Name: "updated_at", Type: field.TypeTime, Comment: "Update Time | 修改日期"},
+		{Name: "tenant_id", Type: field.TypeUint64, Comment: "Tenant ID | 租户 ID", Default: 1},
+		{Name: "trigger", Type: field.TypeString, Size: 20, Comment: "Trigger: manual, schedule | 触发方式"},
+		{Name: "dry_run", Type: field.TypeBool, Comment: "Whether changes were only planned | 是否为试运行", Default: false},
+		{Name: "result", Type: field.TypeString, Size: 20, Comment: "Result: success, failed | 执行结果"},
+		{Name: "error", Type: field.TypeString, Nullable: true, Size: 2147483647, Comment: "Error message | 错误信息"},
+		{Name: "started_at", Type: field.TypeTime, Comment: "Start time | 开始时间"},
+		{Name: "finished_at", Type: field.TypeTime, Comment: "Finish time | 结束时间"},
+		{Name: "stats", Type: field.TypeJSON, Nullable: true, Comment: "Change counters | 变更统计"},
+		{Name: "changes", Type: field.TypeJSON, Nullable: true, Comment: "Changes made or planned | 已执行或计划的变更"},
+		{Name: "conflicts", Type: field.TypeJSON, Nullable: true, Comment: "Skipped entries | 冲突的条目"},
+		{Name: "truncated", Type: field.TypeBool, Comment: "Whether changes or conflicts beyond the limit were dropped | 变更或冲突是否被截断", Default: false},
+		{Name: "provider_id", Type: field.TypeUint64, Comment: "LDAP provider ID | LDAP目录ID"},
+	}
+	// SysLdapSyncRunsTable holds the schema information for the "sys_ldap_sync_runs" table.
+	SysLdapSyncRunsTable = &schema.Table{
+		Name:       "sys_ldap_sync_runs",
+		Comment:    "LDAP Sync Run Table | LDAP同步记录表",
+		Columns:    SysLdapSyncRunsColumns,
+		PrimaryKey: []*schema.Column{SysLdapSyncRunsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "sys_ldap_sync_runs_sys_ldap_providers_sync_runs",
+				Columns:    []*schema.Column{SysLdapSyncRunsColumns[14]},
+				RefColumns: []*schema.Column{SysLdapProvidersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "ldapsyncrun_provider_id_tenant_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{SysLdapSyncRunsColumns[14], SysLdapSyncRunsColumns[3], SysLdapSyncRunsColumns[1]},
+			},
+		},
+	}
 	// SysMenusColumns holds the columns for the "sys_menus" table.
 	SysMenusColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUint64, Increment: true},
@@ -399,9 +745,10 @@ var (
 		{Name: "provider_nickname", Type: field.TypeString, Nullable: true, Size: 100, Comment: "Nickname from OAuth provider | 第三方平台的昵称"},
 		{Name: "provider_email", Type: field.TypeString, Nullable: true, Size: 255, Comment: "Email from OAuth provider | 第三方平台的邮箱"},
 		{Name: "provider_avatar", Type: field.TypeString, Nullable: true, Size: 500, Comment: "Avatar URL from OAuth provider | 第三方平台的头像URL"},
-		{Name: "access_token", Type: field.TypeString, Size: 2000, Comment: "Access token (encrypted) | 访问令牌（加密存储）"},
-		{Name: "refresh_token", Type: field.TypeString, Nullable: true, Size: 2000, Comment: "Refresh token (encrypted) | 刷新令牌（加密存储）"},
+		{Name: "access_token", Type: field.TypeString, Size: 2147483647, Comment: "Access token (encrypted) | 访问令牌（加密存储）"},
+		{Name: "refresh_token", Type: field.TypeString, Nullable: true, Size: 2147483647, Comment: "Refresh token (encrypted) | 刷新令牌（加密存储）"},
 		{Name: "token_expires_at", Type: field.TypeTime, Nullable: true, Comment: "Token expiration time | 令牌过期时间"},
+		{Name: "encryption_key_id", Type: field.TypeString, Nullable: true, Size: 100, Comment: "Encryption key ID of the tokens | 令牌的加密密钥ID"},
 		{Name: "extra_data", Type: field.TypeJSON, Nullable: true, Comment: "Extra data from provider | 第三方平台的额外数据"},
 		{Name: "last_login_at", Type: field.TypeTime, Nullable: true, Comment: "Last login time | 最后登录时间"},
 		{Name: "last_login_ip", Type: field.TypeString, Nullable: true, Size: 45, Comment: "Last login IP address | 最后登录IP地址"},
@@ -419,13 +766,13 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "sys_oauth_accounts_sys_oauth_providers_oauth_accounts",
-				Columns:    []*schema.Column{SysOauthAccountsColumns[19]},
+				Columns:    []*schema.Column{SysOauthAccountsColumns[20]},
 				RefColumns: []*schema.Column{SysOauthProvidersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "sys_oauth_accounts_sys_users_oauth_accounts",
-				Columns:    []*schema.Column{SysOauthAccountsColumns[20]},
+				Columns:    []*schema.Column{SysOauthAccountsColumns[21]},
 				RefColumns: []*schema.Column{SysUsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -434,12 +781,12 @@ var (
 			{
 				Name:    "oauthaccount_user_id_provider_id_tenant_id",
 				Unique:  true,
-				Columns: []*schema.Column{SysOauthAccountsColumns[20], SysOauthAccountsColumns[19], SysOauthAccountsColumns[4]},
+				Columns: []*schema.Column{SysOauthAccountsColumns[21], SysOauthAccountsColumns[20], SysOauthAccountsColumns[4]},
 			},
 			{
 				Name:    "oauthaccount_provider_id_provider_user_id_tenant_id",
 				Unique:  true,
-				Columns: []*schema.Column{SysOauthAccountsColumns[19], SysOauthAccountsColumns[6], SysOauthAccountsColumns[4]},
+				Columns: []*schema.Column{SysOauthAccountsColumns[20], SysOauthAccountsColumns[6], SysOauthAccountsColumns[4]},
 			},
 			{
 				Name:    "oauthaccount_provider_type_provider_user_id_tenant_id",
@@ -449,7 +796,7 @@ var (
 			{
 				Name:    "oauthaccount_user_id_tenant_id",
 				Unique:  false,
-				Columns: []*schema.Column{SysOauthAccountsColumns[20], SysOauthAccountsColumns[4]},
+				Columns: []*schema.Column{SysOauthAccountsColumns[21], SysOauthAccountsColumns[4]},
 			},
 			{
 				Name:    "oauthaccount_status_tenant_id",
@@ -459,12 +806,79 @@ var (
 			{
 				Name:    "oauthaccount_last_login_at_tenant_id",
 				Unique:  false,
-				Columns: []*schema.Column{SysOauthAccountsColumns[15], SysOauthAccountsColumns[4]},
+				Columns: []*schema.Column{SysOauthAccountsColumns[16], SysOauthAccountsColumns[4]},
+			},
+			{
+				Name:    "oauthaccount_token_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{SysOauthAccountsColumns[13]},
 			},
 			{
 				Name:    "oauthaccount_department_id_tenant_id_status",
 				Unique:  false,
-				Columns: []*schema.Column{SysOauthAccountsColumns[18], SysOauthAccountsColumns[4], SysOauthAccountsColumns[3]},
+				Columns: []*schema.Column{SysOauthAccountsColumns[19], SysOauthAccountsColumns[4], SysOauthAccountsColumns[3]},
+			},
+		},
+	}
+	// SysOauthClientsColumns holds the columns for the "sys_oauth_clients" table.
+	SysOauthClientsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint64, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, Comment: "Create Time | 创建日期"},
+		{Name: "updated_at", Type: field.TypeTime, Comment: "Update Time | 修改日期"},
+		{Name: "status", Type: field.TypeUint8, Nullable: true, Comment: "Status 1: normal 2: ban | 状态 1 正常 2 禁用", Default: 1},
+		{Name: "tenant_id", Type: field.TypeUint64, Comment: "Tenant ID | 租户 ID", Default: 1},
+		{Name: "name", Type: field.TypeString, Size: 100, Comment: "Client name shown on the consent page | 客户端名称"},
+		{Name: "client_id", Type: field.TypeString, Unique: true, Size: 64, Comment: "Client ID, unique across tenants | 客户端ID"},
+		{Name: "client_secret_hash", Type: field.TypeString, Nullable: true, Size: 64, Comment: "SHA-256 of the client secret, empty for public clients | 客户端密钥的 SHA-256 摘要", Default: ""},
+		{Name: "client_type", Type: field.TypeString, Size: 20, Comment: "Client type: confidential, public | 客户端类型", Default: "confidential"},
+		{Name: "redirect_uris", Type: field.TypeJSON, Nullable: true, Comment: "Allowed redirect URIs, matched exactly | 允许的回调地址"},
+		{Name: "grant_types", Type: field.TypeJSON, Nullable: true, Comment: "Allowed grant types | 允许的授权类型"},
+		{Name: "scopes", Type: field.TypeJSON, Nullable: true, Comment: "Allowed scopes | 允许的授权范围"},
+		{Name: "trusted", Type: field.TypeBool, Comment: "First-party client which skips the consent | 是否为受信任的客户端，受信任的客户端无需用户同意", Default: false},
+		{Name: "access_token_ttl", Type: field.TypeUint32, Comment: "Access token lifetime in seconds, 0 uses the default | 访问令牌有效期（秒）", Default: 0},
+		{Name: "refresh_token_ttl", Type: field.TypeUint32, Comment: "Refresh token lifetime in seconds, 0 uses the default | 刷新令牌有效期（秒）", Default: 0},
+		{Name: "remark", Type: field.TypeString, Nullable: true, Size: 200, Comment: "Remark | 备注", Default: ""},
+	}
+	// SysOauthClientsTable holds the schema information for the "sys_oauth_clients" table.
+	SysOauthClientsTable = &schema.Table{
+		Name:       "sys_oauth_clients",
+		Comment:    "OAuth Client Table | OAuth 客户端表",
+		Columns:    SysOauthClientsColumns,
+		PrimaryKey: []*schema.Column{SysOauthClientsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "oauthclient_tenant_id_status",
+				Unique:  false,
+				Columns: []*schema.Column{SysOauthClientsColumns[4], SysOauthClientsColumns[3]},
+			},
+		},
+	}
+	// SysOauthConsentsColumns holds the columns for the "sys_oauth_consents" table.
+	SysOauthConsentsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint64, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, Comment: "Create Time | 创建日期"},
+		{Name: "updated_at", Type: field.TypeTime, Comment: "Update Time | 修改日期"},
+		{Name: "tenant_id", Type: field.TypeUint64, Comment: "Tenant ID | 租户 ID", Default: 1},
+		{Name: "user_id", Type: field.TypeUUID, Comment: "User ID | 用户ID"},
+		{Name: "client_id", Type: field.TypeString, Size: 64, Comment: "Client ID | 客户端ID"},
+		{Name: "scopes", Type: field.TypeJSON, Nullable: true, Comment: "Granted scopes | 已同意的授权范围"},
+	}
+	// SysOauthConsentsTable holds the schema information for the "sys_oauth_consents" table.
+	SysOauthConsentsTable = &schema.Table{
+		Name:       "sys_oauth_consents",
+		Comment:    "OAuth Consent Table | OAuth 用户授权记录表",
+		Columns:    SysOauthConsentsColumns,
+		PrimaryKey: []*schema.Column{SysOauthConsentsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "oauthconsent_user_id_client_id_tenant_id",
+				Unique:  true,
+				Columns: []*schema.Column{SysOauthConsentsColumns[4], SysOauthConsentsColumns[5], SysOauthConsentsColumns[3]},
+			},
+			{
+				Name:    "oauthconsent_client_id_tenant_id",
+				Unique:  false,
+				Columns: []*schema.Column{SysOauthConsentsColumns[5], SysOauthConsentsColumns[3]},
 			},
 		},
 	}
@@ -500,6 +914,7 @@ var (
 		{Name: "success_count", Type: field.TypeInt, Comment: "Successful OAuth attempts count | 成功登录次数", Default: 0},
 		{Name: "failure_count", Type: field.TypeInt, Comment: "Failed OAuth attempts count | 失败登录次数", Default: 0},
 		{Name: "last_used_at", Type: field.TypeTime, Nullable: true, Comment: "Last used timestamp | 最后使用时间"},
+		{Name: "template_id", Type: field.TypeUint64, Nullable: true, Comment: "The system template the provider was enabled from | 来源的系统模板ID"},
 	}
 	// SysOauthProvidersTable holds the schema information for the "sys_oauth_providers" table.
 	SysOauthProvidersTable = &schema.Table{
@@ -507,11 +922,24 @@ var (
 		Comment:    "OAuth Provider Configuration Table | OAuth第三方登录提供商配置表",
 		Columns:    SysOauthProvidersColumns,
 		PrimaryKey: []*schema.Column{SysOauthProvidersColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "sys_oauth_providers_sys_oauth_provider_templates_oauth_providers",
+				Columns:    []*schema.Column{SysOauthProvidersColumns[30]},
+				RefColumns: []*schema.Column{SysOauthProviderTemplatesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
 		Indexes: []*schema.Index{
 			{
-				Name:    "oauthprovider_name",
+				Name:    "oauthprovider_name_tenant_id",
 				Unique:  true,
-				Columns: []*schema.Column{SysOauthProvidersColumns[5]},
+				Columns: []*schema.Column{SysOauthProvidersColumns[5], SysOauthProvidersColumns[4]},
+			},
+			{
+				Name:    "oauthprovider_template_id_tenant_id",
+				Unique:  true,
+				Columns: []*schema.Column{SysOauthProvidersColumns[30], SysOauthProvidersColumns[4]},
 			},
 			{
 				Name:    "oauthprovider_type_tenant_id",
@@ -550,6 +978,71 @@ var (
 			},
 		},
 	}
+	// SysOauthProviderTemplatesColumns holds the columns for the "sys_oauth_provider_templates" table.
+	SysOauthProviderTemplatesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint64, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, Comment: "Create Time | 创建日期"},
+		{Name: "updated_at", Type: field.TypeTime, Comment: "Update Time | 修改日期"},
+		{Name: "status", Type: field.TypeUint8, Nullable: true, Comment: "Status 1: normal 2: ban | 状态 1 正常 2 禁用", Default: 1},
+		{Name: "name", Type: field.TypeString, Size: 50, Comment: "The template's name, used as the default provider name | 模板名称，默认作为提供商名称"},
+		{Name: "display_name", Type: field.TypeString, Nullable: true, Size: 100, Comment: "Display name for UI | UI显示名称"},
+		{Name: "type", Type: field.TypeString, Size: 20, Comment: "The provider type (wechat, qq, github, google, facebook) | 提供商类型"},
+		{Name: "provider_type", Type: field.TypeString, Size: 20, Comment: "Provider type: oauth2, oidc, saml | 认证协议类型", Default: "oauth2"},
+		{Name: "scopes", Type: field.TypeString, Nullable: true, Size: 500, Comment: "The default scopes | 默认权限范围"},
+		{Name: "auth_url", Type: field.TypeString, Size: 500, Comment: "OAuth authorization URL | OAuth授权URL"},
+		{Name: "token_url", Type: field.TypeString, Size: 500, Comment: "OAuth token exchange URL | OAuth令牌交换URL"},
+		{Name: "info_url", Type: field.TypeString, Size: 500, Comment: "User info URL | 用户信息获取URL"},
+		{Name: "auth_style", Type: field.TypeInt, Comment: "OAuth auth style (1=params, 2=header) | OAuth认证方式", Default: 2},
+		{Name: "extra_config", Type: field.TypeJSON, Nullable: true, Comment: "Provider specific configuration | 提供商特定配置"},
+		{Name: "support_pkce", Type: field.TypeBool, Comment: "Whether support PKCE | 是否支持PKCE", Default: false},
+		{Name: "icon_url", Type: field.TypeString, Nullable: true, Size: 500, Comment: "Provider icon URL | 提供商图标URL"},
+		{Name: "sort", Type: field.TypeUint32, Comment: "Sort order | 排序", Default: 0},
+		{Name: "remark", Type: field.TypeString, Nullable: true, Size: 200, Comment: "Remark | 备注"},
+	}
+	// SysOauthProviderTemplatesTable holds the schema information for the "sys_oauth_provider_templates" table.
+	SysOauthProviderTemplatesTable = &schema.Table{
+		Name:       "sys_oauth_provider_templates",
+		Comment:    "OAuth Provider Template Table | OAuth提供商模板表",
+		Columns:    SysOauthProviderTemplatesColumns,
+		PrimaryKey: []*schema.Column{SysOauthProviderTemplatesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "oauthprovidertemplate_name",
+				Unique:  true,
+				Columns: []*schema.Column{SysOauthProviderTemplatesColumns[4]},
+			},
+			{
+				Name:    "oauthprovidertemplate_type",
+				Unique:  false,
+				Columns: []*schema.Column{SysOauthProviderTemplatesColumns[6]},
+			},
+		},
+	}
+	// SysOauthScopesColumns holds the columns for the "sys_oauth_scopes" table.
+	SysOauthScopesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint64, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, Comment: "Create Time | 创建日期"},
+		{Name: "updated_at", Type: field.TypeTime, Comment: "Update Time | 修改日期"},
+		{Name: "status", Type: field.TypeUint8, Nullable: true, Comment: "Status 1: normal 2: ban | 状态 1 正常 2 禁用", Default: 1},
+		{Name: "tenant_id", Type: field.TypeUint64, Comment: "Tenant ID | 租户 ID", Default: 1},
+		{Name: "name", Type: field.TypeString, Size: 100, Comment: "Scope name, e.g. cmdb.read | 授权范围名称"},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 200, Comment: "Description shown on the consent page | 描述", Default: ""},
+		{Name: "api_ids", Type: field.TypeJSON, Nullable: true, Comment: "APIs granted by the scope | 授权范围包含的API"},
+	}
+	// SysOauthScopesTable holds the schema information for the "sys_oauth_scopes" table.
+	SysOauthScopesTable = &schema.Table{
+		Name:       "sys_oauth_scopes",
+		Comment:    "OAuth Scope Table | OAuth 授权范围表",
+		Columns:    SysOauthScopesColumns,
+		PrimaryKey: []*schema.Column{SysOauthScopesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "oauthscope_name_tenant_id",
+				Unique:  true,
+				Columns: []*schema.Column{SysOauthScopesColumns[5], SysOauthScopesColumns[4]},
+			},
+		},
+	}
 	// SysOauthSessionsColumns holds the columns for the "sys_oauth_sessions" table.
 	SysOauthSessionsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUint64, Increment: true},
@@ -718,6 +1211,140 @@ var (
 			},
 		},
 	}
+	// SysSamlAccountsColumns holds the columns for the "sys_saml_accounts" table.
+	SysSamlAccountsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint64, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, Comment: "Create Time | 创建日期"},
+		{Name: "updated_at", Type: field.TypeTime, Comment: "Update Time | 修改日期"},
+		{Name: "status", Type: field.TypeUint8, Nullable: true, Comment: "Status 1: normal 2: ban | 状态 1 正常 2 禁用", Default: 1},
+		{Name: "tenant_id", Type: field.TypeUint64, Comment: "Tenant ID | 租户 ID", Default: 1},
+		{Name: "name_id", Type: field.TypeString, Size: 255, Comment: "NameID asserted by the IdP | IdP断言的NameID"},
+		{Name: "name_id_format", Type: field.TypeString, Nullable: true, Size: 255, Comment: "NameID format | NameID格式"},
+		{Name: "session_index", Type: field.TypeString, Nullable: true, Size: 255, Comment: "Session index of the last login | 最近一次登录的会话索引"},
+		{Name: "email", Type: field.TypeString, Nullable: true, Size: 255, Comment: "Email from the IdP | IdP提供的邮箱"},
+		{Name: "attributes", Type: field.TypeJSON, Nullable: true, Comment: "Attributes of the last assertion | 最近一次断言的属性"},
+		{Name: "last_login_at", Type: field.TypeTime, Nullable: true, Comment: "Last login time | 最后登录时间"},
+		{Name: "last_login_ip", Type: field.TypeString, Nullable: true, Size: 45, Comment: "Last login IP address | 最后登录IP地址"},
+		{Name: "login_count", Type: field.TypeUint32, Comment: "Login count | 登录次数", Default: 0},
+		{Name: "provider_id", Type: field.TypeUint64, Comment: "SAML provider ID | SAML身份源ID"},
+		{Name: "user_id", Type: field.TypeUUID, Comment: "Associated user ID | 关联的用户ID"},
+	}
+	// SysSamlAccountsTable holds the schema information for the "sys_saml_accounts" table.
+	SysSamlAccountsTable = &schema.Table{
+		Name:       "sys_saml_accounts",
+		Comment:    "SAML Account Binding Table | SAML账户绑定表",
+		Columns:    SysSamlAccountsColumns,
+		PrimaryKey: []*schema.Column{SysSamlAccountsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "sys_saml_accounts_sys_saml_providers_saml_accounts",
+				Columns:    []*schema.Column{SysSamlAccountsColumns[13]},
+				RefColumns: []*schema.Column{SysSamlProvidersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "sys_saml_accounts_sys_users_saml_accounts",
+				Columns:    []*schema.Column{SysSamlAccountsColumns[14]},
+				RefColumns: []*schema.Column{SysUsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "samlaccount_provider_id_name_id_tenant_id",
+				Unique:  true,
+				Columns: []*schema.Column{SysSamlAccountsColumns[13], SysSamlAccountsColumns[5], SysSamlAccountsColumns[4]},
+			},
+			{
+				Name:    "samlaccount_user_id_provider_id_tenant_id",
+				Unique:  true,
+				Columns: []*schema.Column{SysSamlAccountsColumns[14], SysSamlAccountsColumns[13], SysSamlAccountsColumns[4]},
+			},
+			{
+				Name:    "samlaccount_user_id_tenant_id",
+				Unique:  false,
+				Columns: []*schema.Column{SysSamlAccountsColumns[14], SysSamlAccountsColumns[4]},
+			},
+		},
+	}
+	// SysSamlProvidersColumns holds the columns for the "sys_saml_providers" table.
+	SysSamlProvidersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint64, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, Comment: "Create Time | 创建日期"},
+		{Name: "updated_at", Type: field.TypeTime, Comment: "Update Time | 修改日期"},
+		{Name: "status", Type: field.TypeUint8, Nullable: true, Comment: "Status 1: normal 2: ban | 状态 1 正常 2 禁用", Default: 1},
+		{Name: "tenant_id", Type: field.TypeUint64, Comment: "Tenant ID | 租户 ID", Default: 1},
+		{Name: "name", Type: field.TypeString, Size: 50, Comment: "The provider's name | 身份源名称"},
+		{Name: "display_name", Type: field.TypeString, Nullable: true, Size: 100, Comment: "Display name for UI | UI显示名称"},
+		{Name: "idp_entity_id", Type: field.TypeString, Size: 500, Comment: "IdP entity ID | IdP实体ID"},
+		{Name: "idp_sso_url", Type: field.TypeString, Size: 1000, Comment: "IdP single sign-on URL (HTTP-Redirect binding) | IdP单点登录地址"},
+		{Name: "idp_slo_url", Type: field.TypeString, Nullable: true, Size: 1000, Comment: "IdP single logout URL | IdP单点登出地址"},
+		{Name: "idp_certificates", Type: field.TypeString, Size: 2147483647, Comment: "IdP signing certificates in PEM | IdP签名证书(PEM)"},
+		{Name: "idp_metadata_url", Type: field.TypeString, Nullable: true, Size: 1000, Comment: "IdP metadata URL | IdP元数据地址"},
+		{Name: "sp_entity_id", Type: field.TypeString, Nullable: true, Size: 500, Comment: "SP entity ID | SP实体ID"},
+		{Name: "acs_url", Type: field.TypeString, Nullable: true, Size: 1000, Comment: "Assertion consumer service URL | 断言消费地址"},
+		{Name: "name_id_format", Type: field.TypeString, Nullable: true, Size: 255, Comment: "Requested NameID format | 请求的NameID格式"},
+		{Name: "sign_authn_request", Type: field.TypeBool, Comment: "Whether to sign AuthnRequests | 是否签名认证请求", Default: true},
+		{Name: "want_assertions_signed", Type: field.TypeBool, Comment: "Whether assertions must be signed | 是否要求断言签名", Default: true},
+		{Name: "attribute_mapping", Type: field.TypeJSON, Nullable: true, Comment: "Attribute mapping to users, departments and roles | 属性到用户、部门和角色的映射"},
+		{Name: "jit_provision", Type: field.TypeBool, Comment: "Whether to create users on first login | 是否在首次登录时自动创建用户", Default: false},
+		{Name: "sync_attributes", Type: field.TypeBool, Comment: "Whether to update department and roles on every login | 是否在每次登录时同步部门和角色", Default: false},
+		{Name: "link_by_email", Type: field.TypeBool, Comment: "Whether to link the first login to an existing user with the same email | 首次登录时是否按邮箱关联已有用户", Default: false},
+		{Name: "default_department_id", Type: field.TypeUint64, Nullable: true, Comment: "Department of provisioned users without a mapped department | 自动创建用户的默认部门", Default: 0},
+		{Name: "default_role_ids", Type: field.TypeJSON, Nullable: true, Comment: "Roles granted to provisioned users | 自动创建用户的默认角色"},
+		{Name: "enabled", Type: field.TypeBool, Comment: "Whether the provider is enabled | 是否启用", Default: true},
+		{Name: "sort", Type: field.TypeUint32, Comment: "Sort order | 排序", Default: 0},
+		{Name: "remark", Type: field.TypeString, Nullable: true, Size: 200, Comment: "Remark | 备注"},
+	}
+	// SysSamlProvidersTable holds the schema information for the "sys_saml_providers" table.
+	SysSamlProvidersTable = &schema.Table{
+		Name:       "sys_saml_providers",
+		Comment:    "SAML Identity Provider Table | SAML身份源配置表",
+		Columns:    SysSamlProvidersColumns,
+		PrimaryKey: []*schema.Column{SysSamlProvidersColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "samlprovider_name_tenant_id",
+				Unique:  true,
+				Columns: []*schema.Column{SysSamlProvidersColumns[5], SysSamlProvidersColumns[4]},
+			},
+			{
+				Name:    "samlprovider_enabled_status_tenant_id",
+				Unique:  false,
+				Columns: []*schema.Column{SysSamlProvidersColumns[23], SysSamlProvidersColumns[3], SysSamlProvidersColumns[4]},
+			},
+		},
+	}
+	// SysScimTokensColumns holds the columns for the "sys_scim_tokens" table.
+	SysScimTokensColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint64, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, Comment: "Create Time | 创建日期"},
+		{Name: "updated_at", Type: field.TypeTime, Comment: "Update Time | 修改日期"},
+		{Name: "status", Type: field.TypeUint8, Nullable: true, Comment: "Status 1: normal 2: ban | 状态 1 正常 2 禁用", Default: 1},
+		{Name: "tenant_id", Type: field.TypeUint64, Comment: "Tenant ID | 租户 ID", Default: 1},
+		{Name: "name", Type: field.TypeString, Size: 100, Comment: "Token name, usually the identity provider | 令牌名称"},
+		{Name: "token_hash", Type: field.TypeString, Unique: true, Size: 64, Comment: "SHA-256 of the token, the token itself is never stored | 令牌的 SHA-256 摘要"},
+		{Name: "token_prefix", Type: field.TypeString, Size: 16, Comment: "Leading characters of the token for identification | 令牌前缀"},
+		{Name: "expired_at", Type: field.TypeTime, Nullable: true, Comment: "Expire time, never expires when empty | 过期时间"},
+		{Name: "last_used_at", Type: field.TypeTime, Nullable: true, Comment: "Last used time | 最后使用时间"},
+		{Name: "last_used_ip", Type: field.TypeString, Nullable: true, Comment: "Last used IP | 最后使用 IP", Default: ""},
+		{Name: "role_codes", Type: field.TypeJSON, Nullable: true, Comment: "Existing roles managed as groups besides the roles created by SCIM | 可作为组管理的已有角色编码"},
+		{Name: "remark", Type: field.TypeString, Nullable: true, Size: 200, Comment: "Remark | 备注", Default: ""},
+	}
+	// SysScimTokensTable holds the schema information for the "sys_scim_tokens" table.
+	SysScimTokensTable = &schema.Table{
+		Name:       "sys_scim_tokens",
+		Comment:    "SCIM Token Table | SCIM 令牌表",
+		Columns:    SysScimTokensColumns,
+		PrimaryKey: []*schema.Column{SysScimTokensColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "scimtoken_tenant_id_status",
+				Unique:  false,
+				Columns: []*schema.Column{SysScimTokensColumns[4], SysScimTokensColumns[3]},
+			},
+		},
+	}
 	// SysTenantsColumns holds the columns for the "sys_tenants" table.
 	SysTenantsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUint64, Increment: true},
@@ -755,6 +1382,105 @@ var (
 			},
 		},
 	}
+	// SysTenantInitJobsColumns holds the columns for the "sys_tenant_init_jobs" table.
+	SysTenantInitJobsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint64, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, Comment: "Create Time | 创建日期"},
+		{Name: "updated_at", Type: field.TypeTime, Comment: "Update Time | 修改日期"},
+		{Name: "tenant_id", Type: field.TypeUint64, Comment: "The tenant to initialize | 初始化的租户ID"},
+		{Name: "template", Type: field.TypeString, Nullable: true, Size: 50, Comment: "Initialization template name | 初始化模板名称"},
+		{Name: "mode", Type: field.TypeString, Size: 20, Comment: "Mode: full, repair | 初始化模式"},
+		{Name: "dry_run", Type: field.TypeBool, Comment: "Whether only the plan is made | 是否为试运行", Default: false},
+		{Name: "status", Type: field.TypeString, Size: 20, Comment: "Status: pending, running, success, failed | 任务状态"},
+		{Name: "admin_username", Type: field.TypeString, Nullable: true, Size: 50, Comment: "Admin username, the password is not stored | 管理员用户名，不保存密码"},
+		{Name: "admin_email", Type: field.TypeString, Nullable: true, Size: 100, Comment: "Admin email | 管理员邮箱"},
+		{Name: "attempts", Type: field.TypeUint32, Comment: "Number of runs | 执行次数", Default: 0},
+		{Name: "error", Type: field.TypeString, Nullable: true, Size: 2147483647, Comment: "Error message of the last run | 最近一次执行的错误信息"},
+		{Name: "plugins", Type: field.TypeJSON, Nullable: true, Comment: "Progress of the plugins | 插件执行进度"},
+		{Name: "plan", Type: field.TypeJSON, Nullable: true, Comment: "The plan of a dry run | 试运行计划"},
+		{Name: "started_at", Type: field.TypeTime, Nullable: true, Comment: "Start time of the last run | 最近一次开始时间"},
+		{Name: "finished_at", Type: field.TypeTime, Nullable: true, Comment: "Finish time of the last run | 最近一次结束时间"},
+	}
+	// SysTenantInitJobsTable holds the schema information for the "sys_tenant_init_jobs" table.
+	SysTenantInitJobsTable = &schema.Table{
+		Name:       "sys_tenant_init_jobs",
+		Comment:    "Tenant Initialization Job Table | 租户初始化任务表",
+		Columns:    SysTenantInitJobsColumns,
+		PrimaryKey: []*schema.Column{SysTenantInitJobsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "tenantinitjob_tenant_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{SysTenantInitJobsColumns[3], SysTenantInitJobsColumns[1]},
+			},
+			{
+				Name:    "tenantinitjob_status",
+				Unique:  false,
+				Columns: []*schema.Column{SysTenantInitJobsColumns[7]},
+			},
+		},
+	}
+	// SysTenantInitPluginsColumns holds the columns for the "sys_tenant_init_plugins" table.
+	SysTenantInitPluginsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint64, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, Comment: "Create Time | 创建日期"},
+		{Name: "updated_at", Type: field.TypeTime, Comment: "Update Time | 修改日期"},
+		{Name: "status", Type: field.TypeUint8, Nullable: true, Comment: "Status 1: normal 2: ban | 状态 1 正常 2 禁用", Default: 1},
+		{Name: "name", Type: field.TypeString, Size: 50, Comment: "Plugin name, usually the service name | 插件名称，一般为服务名"},
+		{Name: "version", Type: field.TypeString, Size: 20, Comment: "Plugin version | 插件版本", Default: "1.0.0"},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 500, Comment: "Description | 插件描述"},
+		{Name: "target", Type: field.TypeString, Size: 255, Comment: "gRPC target of the service, e.g. cmdb-rpc:9105 or etcd://127.0.0.1:2379/cmdb.rpc | 服务的 gRPC 地址"},
+		{Name: "dependencies", Type: field.TypeJSON, Nullable: true, Comment: "Names of the plugins it depends on, the core plugin is always a dependency | 依赖的插件名称，总是依赖核心插件"},
+		{Name: "priority", Type: field.TypeInt32, Comment: "Order among the plugins without dependencies between them, the smaller the earlier | 无依赖关系的插件间的执行顺序，越小越先执行", Default: 100},
+		{Name: "timeout", Type: field.TypeUint32, Comment: "Timeout of each call in seconds | 每次调用的超时时间（秒）", Default: 60},
+		{Name: "support_rollback", Type: field.TypeBool, Comment: "Whether it can roll back, i.e. compensate when a later plugin fails | 是否支持回滚，用于后续插件失败时补偿", Default: false},
+	}
+	// SysTenantInitPluginsTable holds the schema information for the "sys_tenant_init_plugins" table.
+	SysTenantInitPluginsTable = &schema.Table{
+		Name:       "sys_tenant_init_plugins",
+		Comment:    "Tenant Initialization Plugin Table | 租户初始化远程插件表",
+		Columns:    SysTenantInitPluginsColumns,
+		PrimaryKey: []*schema.Column{SysTenantInitPluginsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "tenantinitplugin_name",
+				Unique:  true,
+				Columns: []*schema.Column{SysTenantInitPluginsColumns[4]},
+			},
+		},
+	}
+	// SysTenantInitTemplatesColumns holds the columns for the "sys_tenant_init_templates" table.
+	SysTenantInitTemplatesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint64, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, Comment: "Create Time | 创建日期"},
+		{Name: "updated_at", Type: field.TypeTime, Comment: "Update Time | 修改日期"},
+		{Name: "status", Type: field.TypeUint8, Nullable: true, Comment: "Status 1: normal 2: ban | 状态 1 正常 2 禁用", Default: 1},
+		{Name: "name", Type: field.TypeString, Size: 50, Comment: "The template's name, passed to tenant initialization | 模板名称，初始化租户时指定"},
+		{Name: "version", Type: field.TypeString, Size: 20, Comment: "Template version | 模板版本", Default: "1.0.0"},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 500, Comment: "Description | 模板描述"},
+		{Name: "base_template", Type: field.TypeString, Nullable: true, Size: 50, Comment: "The base template it overlays, empty means a standalone template | 基础模板名称，为空表示独立模板"},
+		{Name: "components", Type: field.TypeString, Size: 2147483647, Comment: "Components as JSON | 模板组件 JSON"},
+		{Name: "sort", Type: field.TypeUint32, Comment: "Sort order | 排序", Default: 0},
+	}
+	// SysTenantInitTemplatesTable holds the schema information for the "sys_tenant_init_templates" table.
+	SysTenantInitTemplatesTable = &schema.Table{
+		Name:       "sys_tenant_init_templates",
+		Comment:    "Tenant Initialization Template Table | 租户初始化模板表",
+		Columns:    SysTenantInitTemplatesColumns,
+		PrimaryKey: []*schema.Column{SysTenantInitTemplatesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "tenantinittemplate_name",
+				Unique:  true,
+				Columns: []*schema.Column{SysTenantInitTemplatesColumns[4]},
+			},
+			{
+				Name:    "tenantinittemplate_base_template",
+				Unique:  false,
+				Columns: []*schema.Column{SysTenantInitTemplatesColumns[7]},
+			},
+		},
+	}
 	// SysTokensColumns holds the columns for the "sys_tokens" table.
 	SysTokensColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Comment: "UUID"},
@@ -768,6 +1494,11 @@ var (
 		{Name: "source", Type: field.TypeString, Comment: "Log in source such as GitHub | Token 来源 （本地为core, 第三方如github等）"},
 		{Name: "expired_at", Type: field.TypeTime, Comment: " Expire time | 过期时间"},
 		{Name: "department_id", Type: field.TypeUint64, Nullable: true, Comment: "Department ID when token was issued | Token签发时的部门ID", Default: 0},
+		{Name: "device", Type: field.TypeString, Nullable: true, Comment: "Device description parsed from user agent | 登录设备", Default: ""},
+		{Name: "user_agent", Type: field.TypeString, Nullable: true, Comment: "User agent | 客户端 User-Agent", Default: "", SchemaType: map[string]string{"mysql": "varchar(512)"}},
+		{Name: "ip", Type: field.TypeString, Nullable: true, Comment: "Last access IP | 最后访问 IP", Default: ""},
+		{Name: "last_seen_at", Type: field.TypeTime, Nullable: true, Comment: "Last seen time | 最后活跃时间"},
+		{Name: "session_id", Type: field.TypeString, Nullable: true, Comment: "Login session ID shared by the refreshed tokens | 登录会话ID，刷新的令牌沿用登录时的会话", Default: ""},
 	}
 	// SysTokensTable holds the schema information for the "sys_tokens" table.
 	SysTokensTable = &schema.Table{
@@ -791,11 +1522,24 @@ var (
 				Unique:  false,
 				Columns: []*schema.Column{SysTokensColumns[9], SysTokensColumns[4]},
 			},
+			{
+				Name:    "token_uuid_session_id",
+				Unique:  false,
+				Columns: []*schema.Column{SysTokensColumns[5], SysTokensColumns[15]},
+			},
 			{
 				Name:    "token_department_id_tenant_id_status",
 				Unique:  false,
 				Columns: []*schema.Column{SysTokensColumns[10], SysTokensColumns[4], SysTokensColumns[3]},
 			},
+			{
+				Name:    "token_token",
+				Unique:  false,
+				Columns: []*schema.Column{SysTokensColumns[7]},
+				Annotation: &entsql.IndexAnnotation{
+					Prefix: 128,
+				},
+			},
 		},
 	}
 	// SysUsersColumns holds the columns for the "sys_users" table.
@@ -916,18 +1660,37 @@ var (
 	Tables = []*schema.Table{
 		SysApisTable,
 		SysAuditLogsTable,
+		SysAuditLogArchivesTable,
+		SysAuditLogChainsTable,
+		SysAuditLogCheckpointsTable,
+		SysAuditLogPruneRecordsTable,
 		SysCasbinRulesTable,
 		SysConfigurationTable,
 		SysDepartmentsTable,
 		SysDictionariesTable,
 		SysDictionaryDetailsTable,
+		SysImpersonationSessionsTable,
+		SysLdapAccountsTable,
+		SysLdapDepartmentsTable,
+		SysLdapProvidersTable,
+		SysLdapSyncRunsTable,
 		SysMenusTable,
 		SysOauthAccountsTable,
+		SysOauthClientsTable,
+		SysOauthConsentsTable,
 		SysOauthProvidersTable,
+		SysOauthProviderTemplatesTable,
+		SysOauthScopesTable,
 		SysOauthSessionsTable,
 		SysPositionsTable,
 		SysRolesTable,
+		SysSamlAccountsTable,
+		SysSamlProvidersTable,
+		SysScimTokensTable,
 		SysTenantsTable,
+		SysTenantInitJobsTable,
+		SysTenantInitPluginsTable,
+		SysTenantInitTemplatesTable,
 		SysTokensTable,
 		SysUsersTable,
 		RoleMenusTable,
@@ -943,6 +1706,18 @@ func init() {
 	SysAuditLogsTable.Annotation = &entsql.Annotation{
 		Table: "sys_audit_logs",
 	}
+	SysAuditLogArchivesTable.Annotation = &entsql.Annotation{
+		Table: "sys_audit_log_archives",
+	}
+	SysAuditLogChainsTable.Annotation = &entsql.Annotation{
+		Table: "sys_audit_log_chains",
+	}
+	SysAuditLogCheckpointsTable.Annotation = &entsql.Annotation{
+		Table: "sys_audit_log_checkpoints",
+	}
+	SysAuditLogPruneRecordsTable.Annotation = &entsql.Annotation{
+		Table: "sys_audit_log_prune_records",
+	}
 	SysCasbinRulesTable.Annotation = &entsql.Annotation{
 		Table:   "sys_casbin_rules",
 		Options: "ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='Casbin权限规则表'",
@@ -961,6 +1736,25 @@ func init() {
 	SysDictionaryDetailsTable.Annotation = &entsql.Annotation{
 		Table: "sys_dictionary_details",
 	}
+	SysImpersonationSessionsTable.Annotation = &entsql.Annotation{
+		Table: "sys_impersonation_sessions",
+	}
+	SysLdapAccountsTable.ForeignKeys[0].RefTable = SysLdapProvidersTable
+	SysLdapAccountsTable.ForeignKeys[1].RefTable = SysUsersTable
+	SysLdapAccountsTable.Annotation = &entsql.Annotation{
+		Table: "sys_ldap_accounts",
+	}
+	SysLdapDepartmentsTable.ForeignKeys[0].RefTable = SysLdapProvidersTable
+	SysLdapDepartmentsTable.Annotation = &entsql.Annotation{
+		Table: "sys_ldap_departments",
+	}
+	SysLdapProvidersTable.Annotation = &entsql.Annotation{
+		Table: "sys_ldap_providers",
+	}
+	SysLdapSyncRunsTable.ForeignKeys[0].RefTable = SysLdapProvidersTable
+	SysLdapSyncRunsTable.Annotation = &entsql.Annotation{
+		Table: "sys_ldap_sync_runs",
+	}
 	SysMenusTable.ForeignKeys[0].RefTable = SysMenusTable
 	SysMenusTable.Annotation = &entsql.Annotation{
 		Table: "sys_menus",
@@ -970,9 +1764,22 @@ func init() {
 	SysOauthAccountsTable.Annotation = &entsql.Annotation{
 		Table: "sys_oauth_accounts",
 	}
+	SysOauthClientsTable.Annotation = &entsql.Annotation{
+		Table: "sys_oauth_clients",
+	}
+	SysOauthConsentsTable.Annotation = &entsql.Annotation{
+		Table: "sys_oauth_consents",
+	}
+	SysOauthProvidersTable.ForeignKeys[0].RefTable = SysOauthProviderTemplatesTable
 	SysOauthProvidersTable.Annotation = &entsql.Annotation{
 		Table: "sys_oauth_providers",
 	}
+	SysOauthProviderTemplatesTable.Annotation = &entsql.Annotation{
+		Table: "sys_oauth_provider_templates",
+	}
+	SysOauthScopesTable.Annotation = &entsql.Annotation{
+		Table: "sys_oauth_scopes",
+	}
 	SysOauthSessionsTable.ForeignKeys[0].RefTable = SysOauthProvidersTable
 	SysOauthSessionsTable.ForeignKeys[1].RefTable = SysUsersTable
 	SysOauthSessionsTable.Annotation = &entsql.Annotation{
@@ -985,9 +1792,29 @@ func init() {
 	SysRolesTable.Annotation = &entsql.Annotation{
 		Table: "sys_roles",
 	}
+	SysSamlAccountsTable.ForeignKeys[0].RefTable = SysSamlProvidersTable
+	SysSamlAccountsTable.ForeignKeys[1].RefTable = SysUsersTable
+	SysSamlAccountsTable.Annotation = &entsql.Annotation{
+		Table: "sys_saml_accounts",
+	}
+	SysSamlProvidersTable.Annotation = &entsql.Annotation{
+		Table: "sys_saml_providers",
+	}
+	SysScimTokensTable.Annotation = &entsql.Annotation{
+		Table: "sys_scim_tokens",
+	}
 	SysTenantsTable.Annotation = &entsql.Annotation{
 		Table: "sys_tenants",
 	}
+	SysTenantInitJobsTable.Annotation = &entsql.Annotation{
+		Table: "sys_tenant_init_jobs",
+	}
+	SysTenantInitPluginsTable.Annotation = &entsql.Annotation{
+		Table: "sys_tenant_init_plugins",
+	}
+	SysTenantInitTemplatesTable.Annotation = &entsql.Annotation{
+		Table: "sys_tenant_init_templates",
+	}
 	SysTokensTable.Annotation = &entsql.Annotation{
 		Table: "sys_tokens",
 	}
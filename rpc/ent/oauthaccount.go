@@ -46,11 +46,13 @@ type OauthAccount struct {
 	// Avatar URL from OAuth provider | 第三方平台的头像URL
 	ProviderAvatar string `json:"provider_avatar,omitempty"`
 	// Access token (encrypted) | 访问令牌（加密存储）
-	AccessToken string `json:"access_token,omitempty"`
+	AccessToken string `json:"-"`
 	// Refresh token (encrypted) | 刷新令牌（加密存储）
-	RefreshToken string `json:"refresh_token,omitempty"`
+	RefreshToken string `json:"-"`
 	// Token expiration time | 令牌过期时间
 	TokenExpiresAt time.Time `json:"token_expires_at,omitempty"`
+	// Encryption key ID of the tokens | 令牌的加密密钥ID
+	EncryptionKeyID string `json:"encryption_key_id,omitempty"`
 	// Extra data from provider | 第三方平台的额外数据
 	ExtraData map[string]interface{} `json:"extra_data,omitempty"`
 	// Last login time | 最后登录时间
@@ -109,7 +111,7 @@ func (*OauthAccount) scanValues(columns []string) ([]any, error) {
 			values[i] = new([]byte)
 		case oauthaccount.FieldID, oauthaccount.FieldStatus, oauthaccount.FieldTenantID, oauthaccount.FieldProviderID, oauthaccount.FieldLoginCount, oauthaccount.FieldDepartmentID:
 			values[i] = new(sql.NullInt64)
-		case oauthaccount.FieldProviderType, oauthaccount.FieldProviderUserID, oauthaccount.FieldProviderUsername, oauthaccount.FieldProviderNickname, oauthaccount.FieldProviderEmail, oauthaccount.FieldProviderAvatar, oauthaccount.FieldAccessToken, oauthaccount.FieldRefreshToken, oauthaccount.FieldLastLoginIP:
+		case oauthaccount.FieldProviderType, oauthaccount.FieldProviderUserID, oauthaccount.FieldProviderUsername, oauthaccount.FieldProviderNickname, oauthaccount.FieldProviderEmail, oauthaccount.FieldProviderAvatar, oauthaccount.FieldAccessToken, oauthaccount.FieldRefreshToken, oauthaccount.FieldEncryptionKeyID, oauthaccount.FieldLastLoginIP:
 			values[i] = new(sql.NullString)
 		case oauthaccount.FieldCreatedAt, oauthaccount.FieldUpdatedAt, oauthaccount.FieldTokenExpiresAt, oauthaccount.FieldLastLoginAt:
 			values[i] = new(sql.NullTime)
@@ -226,6 +228,12 @@ func (_m *OauthAccount) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.TokenExpiresAt = value.Time
 			}
+		case oauthaccount.FieldEncryptionKeyID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field encryption_key_id", values[i])
+			} else if value.Valid {
+				_m.EncryptionKeyID = value.String
+			}
 		case oauthaccount.FieldExtraData:
 			if value, ok := values[i].(*[]byte); !ok {
 				return fmt.Errorf("unexpected type %T for field extra_data", values[i])
@@ -340,15 +348,16 @@ func (_m *OauthAccount) String() string {
 	builder.WriteString("provider_avatar=")
 	builder.WriteString(_m.ProviderAvatar)
 	builder.WriteString(", ")
-	builder.WriteString("access_token=")
-	builder.WriteString(_m.AccessToken)
+	builder.WriteString("access_token=<sensitive>")
 	builder.WriteString(", ")
-	builder.WriteString("refresh_token=")
-	builder.WriteString(_m.RefreshToken)
+	builder.WriteString("refresh_token=<sensitive>")
 	builder.WriteString(", ")
 	builder.WriteString("token_expires_at=")
 	builder.WriteString(_m.TokenExpiresAt.Format(time.ANSIC))
 	builder.WriteString(", ")
+	builder.WriteString("encryption_key_id=")
+	builder.WriteString(_m.EncryptionKeyID)
+	builder.WriteString(", ")
 	builder.WriteString("extra_data=")
 	builder.WriteString(fmt.Sprintf("%v", _m.ExtraData))
 	builder.WriteString(", ")
@@ -44,6 +44,8 @@ const (
 	FieldRefreshToken = "refresh_token"
 	// FieldTokenExpiresAt holds the string denoting the token_expires_at field in the database.
 	FieldTokenExpiresAt = "token_expires_at"
+	// FieldEncryptionKeyID holds the string denoting the encryption_key_id field in the database.
+	FieldEncryptionKeyID = "encryption_key_id"
 	// FieldExtraData holds the string denoting the extra_data field in the database.
 	FieldExtraData = "extra_data"
 	// FieldLastLoginAt holds the string denoting the last_login_at field in the database.
@@ -94,6 +96,7 @@ var Columns = []string{
 	FieldAccessToken,
 	FieldRefreshToken,
 	FieldTokenExpiresAt,
+	FieldEncryptionKeyID,
 	FieldExtraData,
 	FieldLastLoginAt,
 	FieldLastLoginIP,
@@ -134,10 +137,8 @@ var (
 	ProviderEmailValidator func(string) error
 	// ProviderAvatarValidator is a validator for the "provider_avatar" field. It is called by the builders before save.
 	ProviderAvatarValidator func(string) error
-	// AccessTokenValidator is a validator for the "access_token" field. It is called by the builders before save.
-	AccessTokenValidator func(string) error
-	// RefreshTokenValidator is a validator for the "refresh_token" field. It is called by the builders before save.
-	RefreshTokenValidator func(string) error
+	// EncryptionKeyIDValidator is a validator for the "encryption_key_id" field. It is called by the builders before save.
+	EncryptionKeyIDValidator func(string) error
 	// LastLoginIPValidator is a validator for the "last_login_ip" field. It is called by the builders before save.
 	LastLoginIPValidator func(string) error
 	// DefaultLoginCount holds the default value on creation for the "login_count" field.
@@ -229,6 +230,11 @@ func ByTokenExpiresAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldTokenExpiresAt, opts...).ToFunc()
 }
 
+// ByEncryptionKeyID orders the results by the encryption_key_id field.
+func ByEncryptionKeyID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEncryptionKeyID, opts...).ToFunc()
+}
+
 // ByLastLoginAt orders the results by the last_login_at field.
 func ByLastLoginAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldLastLoginAt, opts...).ToFunc()
@@ -131,6 +131,11 @@ func TokenExpiresAt(v time.Time) predicate.OauthAccount {
 	return predicate.OauthAccount(sql.FieldEQ(FieldTokenExpiresAt, v))
 }
 
+// EncryptionKeyID applies equality check predicate on the "encryption_key_id" field. It's identical to EncryptionKeyIDEQ.
+func EncryptionKeyID(v string) predicate.OauthAccount {
+	return predicate.OauthAccount(sql.FieldEQ(FieldEncryptionKeyID, v))
+}
+
 // LastLoginAt applies equality check predicate on the "last_login_at" field. It's identical to LastLoginAtEQ.
 func LastLoginAt(v time.Time) predicate.OauthAccount {
 	return predicate.OauthAccount(sql.FieldEQ(FieldLastLoginAt, v))
@@ -981,6 +986,81 @@ func TokenExpiresAtNotNil() predicate.OauthAccount {
 	return predicate.OauthAccount(sql.FieldNotNull(FieldTokenExpiresAt))
 }
 
+// EncryptionKeyIDEQ applies the EQ predicate on the "encryption_key_id" field.
+func EncryptionKeyIDEQ(v string) predicate.OauthAccount {
+	return predicate.OauthAccount(sql.FieldEQ(FieldEncryptionKeyID, v))
+}
+
+// EncryptionKeyIDNEQ applies the NEQ predicate on the "encryption_key_id" field.
+func EncryptionKeyIDNEQ(v string) predicate.OauthAccount {
+	return predicate.OauthAccount(sql.FieldNEQ(FieldEncryptionKeyID, v))
+}
+
+// EncryptionKeyIDIn applies the In predicate on the "encryption_key_id" field.
+func EncryptionKeyIDIn(vs ...string) predicate.OauthAccount {
+	return predicate.OauthAccount(sql.FieldIn(FieldEncryptionKeyID, vs...))
+}
+
+// EncryptionKeyIDNotIn applies the NotIn predicate on the "encryption_key_id" field.
+func EncryptionKeyIDNotIn(vs ...string) predicate.OauthAccount {
+	return predicate.OauthAccount(sql.FieldNotIn(FieldEncryptionKeyID, vs...))
+}
+
+// EncryptionKeyIDGT applies the GT predicate on the "encryption_key_id" field.
+func EncryptionKeyIDGT(v string) predicate.OauthAccount {
+	return predicate.OauthAccount(sql.FieldGT(FieldEncryptionKeyID, v))
+}
+
+// EncryptionKeyIDGTE applies the GTE predicate on the "encryption_key_id" field.
+func EncryptionKeyIDGTE(v string) predicate.OauthAccount {
+	return predicate.OauthAccount(sql.FieldGTE(FieldEncryptionKeyID, v))
+}
+
+// EncryptionKeyIDLT applies the LT predicate on the "encryption_key_id" field.
+func EncryptionKeyIDLT(v string) predicate.OauthAccount {
+	return predicate.OauthAccount(sql.FieldLT(FieldEncryptionKeyID, v))
+}
+
+// EncryptionKeyIDLTE applies the LTE predicate on the "encryption_key_id" field.
+func EncryptionKeyIDLTE(v string) predicate.OauthAccount {
+	return predicate.OauthAccount(sql.FieldLTE(FieldEncryptionKeyID, v))
+}
+
+// EncryptionKeyIDContains applies the Contains predicate on the "encryption_key_id" field.
+func EncryptionKeyIDContains(v string) predicate.OauthAccount {
+	return predicate.OauthAccount(sql.FieldContains(FieldEncryptionKeyID, v))
+}
+
+// EncryptionKeyIDHasPrefix applies the HasPrefix predicate on the "encryption_key_id" field.
+func EncryptionKeyIDHasPrefix(v string) predicate.OauthAccount {
+	return predicate.OauthAccount(sql.FieldHasPrefix(FieldEncryptionKeyID, v))
+}
+
+// EncryptionKeyIDHasSuffix applies the HasSuffix predicate on the "encryption_key_id" field.
+func EncryptionKeyIDHasSuffix(v string) predicate.OauthAccount {
+	return predicate.OauthAccount(sql.FieldHasSuffix(FieldEncryptionKeyID, v))
+}
+
+// EncryptionKeyIDIsNil applies the IsNil predicate on the "encryption_key_id" field.
+func EncryptionKeyIDIsNil() predicate.OauthAccount {
+	return predicate.OauthAccount(sql.FieldIsNull(FieldEncryptionKeyID))
+}
+
+// EncryptionKeyIDNotNil applies the NotNil predicate on the "encryption_key_id" field.
+func EncryptionKeyIDNotNil() predicate.OauthAccount {
+	return predicate.OauthAccount(sql.FieldNotNull(FieldEncryptionKeyID))
+}
+
+// EncryptionKeyIDEqualFold applies the EqualFold predicate on the "encryption_key_id" field.
+func EncryptionKeyIDEqualFold(v string) predicate.OauthAccount {
+	return predicate.OauthAccount(sql.FieldEqualFold(FieldEncryptionKeyID, v))
+}
+
+// EncryptionKeyIDContainsFold applies the ContainsFold predicate on the "encryption_key_id" field.
+func EncryptionKeyIDContainsFold(v string) predicate.OauthAccount {
+	return predicate.OauthAccount(sql.FieldContainsFold(FieldEncryptionKeyID, v))
+}
+
 // ExtraDataIsNil applies the IsNil predicate on the "extra_data" field.
 func ExtraDataIsNil() predicate.OauthAccount {
 	return predicate.OauthAccount(sql.FieldIsNull(FieldExtraData))
@@ -193,6 +193,20 @@ func (_c *OauthAccountCreate) SetNillableTokenExpiresAt(v *time.Time) *OauthAcco
 	return _c
 }
 
+// SetEncryptionKeyID sets the "encryption_key_id" field.
+func (_c *OauthAccountCreate) SetEncryptionKeyID(v string) *OauthAccountCreate {
+	_c.mutation.SetEncryptionKeyID(v)
+	return _c
+}
+
+// SetNillableEncryptionKeyID sets the "encryption_key_id" field if the given value is not nil.
+func (_c *OauthAccountCreate) SetNillableEncryptionKeyID(v *string) *OauthAccountCreate {
+	if v != nil {
+		_c.SetEncryptionKeyID(*v)
+	}
+	return _c
+}
+
 // SetExtraData sets the "extra_data" field.
 func (_c *OauthAccountCreate) SetExtraData(v map[string]interface{}) *OauthAccountCreate {
 	_c.mutation.SetExtraData(v)
@@ -388,14 +402,9 @@ func (_c *OauthAccountCreate) check() error {
 	if _, ok := _c.mutation.AccessToken(); !ok {
 		return &ValidationError{Name: "access_token", err: errors.New(`ent: missing required field "OauthAccount.access_token"`)}
 	}
-	if v, ok := _c.mutation.AccessToken(); ok {
-		if err := oauthaccount.AccessTokenValidator(v); err != nil {
-			return &ValidationError{Name: "access_token", err: fmt.Errorf(`ent: validator failed for field "OauthAccount.access_token": %w`, err)}
-		}
-	}
-	if v, ok := _c.mutation.RefreshToken(); ok {
-		if err := oauthaccount.RefreshTokenValidator(v); err != nil {
-			return &ValidationError{Name: "refresh_token", err: fmt.Errorf(`ent: validator failed for field "OauthAccount.refresh_token": %w`, err)}
+	if v, ok := _c.mutation.EncryptionKeyID(); ok {
+		if err := oauthaccount.EncryptionKeyIDValidator(v); err != nil {
+			return &ValidationError{Name: "encryption_key_id", err: fmt.Errorf(`ent: validator failed for field "OauthAccount.encryption_key_id": %w`, err)}
 		}
 	}
 	if v, ok := _c.mutation.LastLoginIP(); ok {
@@ -496,6 +505,10 @@ func (_c *OauthAccountCreate) createSpec() (*OauthAccount, *sqlgraph.CreateSpec)
 		_spec.SetField(oauthaccount.FieldTokenExpiresAt, field.TypeTime, value)
 		_node.TokenExpiresAt = value
 	}
+	if value, ok := _c.mutation.EncryptionKeyID(); ok {
+		_spec.SetField(oauthaccount.FieldEncryptionKeyID, field.TypeString, value)
+		_node.EncryptionKeyID = value
+	}
 	if value, ok := _c.mutation.ExtraData(); ok {
 		_spec.SetField(oauthaccount.FieldExtraData, field.TypeJSON, value)
 		_node.ExtraData = value
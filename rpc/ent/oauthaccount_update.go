@@ -255,6 +255,26 @@ func (_u *OauthAccountUpdate) ClearTokenExpiresAt() *OauthAccountUpdate {
 	return _u
 }
 
+// SetEncryptionKeyID sets the "encryption_key_id" field.
+func (_u *OauthAccountUpdate) SetEncryptionKeyID(v string) *OauthAccountUpdate {
+	_u.mutation.SetEncryptionKeyID(v)
+	return _u
+}
+
+// SetNillableEncryptionKeyID sets the "encryption_key_id" field if the given value is not nil.
+func (_u *OauthAccountUpdate) SetNillableEncryptionKeyID(v *string) *OauthAccountUpdate {
+	if v != nil {
+		_u.SetEncryptionKeyID(*v)
+	}
+	return _u
+}
+
+// ClearEncryptionKeyID clears the value of the "encryption_key_id" field.
+func (_u *OauthAccountUpdate) ClearEncryptionKeyID() *OauthAccountUpdate {
+	_u.mutation.ClearEncryptionKeyID()
+	return _u
+}
+
 // SetExtraData sets the "extra_data" field.
 func (_u *OauthAccountUpdate) SetExtraData(v map[string]interface{}) *OauthAccountUpdate {
 	_u.mutation.SetExtraData(v)
@@ -450,14 +470,9 @@ func (_u *OauthAccountUpdate) check() error {
 			return &ValidationError{Name: "provider_avatar", err: fmt.Errorf(`ent: validator failed for field "OauthAccount.provider_avatar": %w`, err)}
 		}
 	}
-	if v, ok := _u.mutation.AccessToken(); ok {
-		if err := oauthaccount.AccessTokenValidator(v); err != nil {
-			return &ValidationError{Name: "access_token", err: fmt.Errorf(`ent: validator failed for field "OauthAccount.access_token": %w`, err)}
-		}
-	}
-	if v, ok := _u.mutation.RefreshToken(); ok {
-		if err := oauthaccount.RefreshTokenValidator(v); err != nil {
-			return &ValidationError{Name: "refresh_token", err: fmt.Errorf(`ent: validator failed for field "OauthAccount.refresh_token": %w`, err)}
+	if v, ok := _u.mutation.EncryptionKeyID(); ok {
+		if err := oauthaccount.EncryptionKeyIDValidator(v); err != nil {
+			return &ValidationError{Name: "encryption_key_id", err: fmt.Errorf(`ent: validator failed for field "OauthAccount.encryption_key_id": %w`, err)}
 		}
 	}
 	if v, ok := _u.mutation.LastLoginIP(); ok {
@@ -549,6 +564,12 @@ func (_u *OauthAccountUpdate) sqlSave(ctx context.Context) (_node int, err error
 	if _u.mutation.TokenExpiresAtCleared() {
 		_spec.ClearField(oauthaccount.FieldTokenExpiresAt, field.TypeTime)
 	}
+	if value, ok := _u.mutation.EncryptionKeyID(); ok {
+		_spec.SetField(oauthaccount.FieldEncryptionKeyID, field.TypeString, value)
+	}
+	if _u.mutation.EncryptionKeyIDCleared() {
+		_spec.ClearField(oauthaccount.FieldEncryptionKeyID, field.TypeString)
+	}
 	if value, ok := _u.mutation.ExtraData(); ok {
 		_spec.SetField(oauthaccount.FieldExtraData, field.TypeJSON, value)
 	}
@@ -885,6 +906,26 @@ func (_u *OauthAccountUpdateOne) ClearTokenExpiresAt() *OauthAccountUpdateOne {
 	return _u
 }
 
+// SetEncryptionKeyID sets the "encryption_key_id" field.
+func (_u *OauthAccountUpdateOne) SetEncryptionKeyID(v string) *OauthAccountUpdateOne {
+	_u.mutation.SetEncryptionKeyID(v)
+	return _u
+}
+
+// SetNillableEncryptionKeyID sets the "encryption_key_id" field if the given value is not nil.
+func (_u *OauthAccountUpdateOne) SetNillableEncryptionKeyID(v *string) *OauthAccountUpdateOne {
+	if v != nil {
+		_u.SetEncryptionKeyID(*v)
+	}
+	return _u
+}
+
+// ClearEncryptionKeyID clears the value of the "encryption_key_id" field.
+func (_u *OauthAccountUpdateOne) ClearEncryptionKeyID() *OauthAccountUpdateOne {
+	_u.mutation.ClearEncryptionKeyID()
+	return _u
+}
+
 // SetExtraData sets the "extra_data" field.
 func (_u *OauthAccountUpdateOne) SetExtraData(v map[string]interface{}) *OauthAccountUpdateOne {
 	_u.mutation.SetExtraData(v)
@@ -1093,14 +1134,9 @@ func (_u *OauthAccountUpdateOne) check() error {
 			return &ValidationError{Name: "provider_avatar", err: fmt.Errorf(`ent: validator failed for field "OauthAccount.provider_avatar": %w`, err)}
 		}
 	}
-	if v, ok := _u.mutation.AccessToken(); ok {
-		if err := oauthaccount.AccessTokenValidator(v); err != nil {
-			return &ValidationError{Name: "access_token", err: fmt.Errorf(`ent: validator failed for field "OauthAccount.access_token": %w`, err)}
-		}
-	}
-	if v, ok := _u.mutation.RefreshToken(); ok {
-		if err := oauthaccount.RefreshTokenValidator(v); err != nil {
-			return &ValidationError{Name: "refresh_token", err: fmt.Errorf(`ent: validator failed for field "OauthAccount.refresh_token": %w`, err)}
+	if v, ok := _u.mutation.EncryptionKeyID(); ok {
+		if err := oauthaccount.EncryptionKeyIDValidator(v); err != nil {
+			return &ValidationError{Name: "encryption_key_id", err: fmt.Errorf(`ent: validator failed for field "OauthAccount.encryption_key_id": %w`, err)}
 		}
 	}
 	if v, ok := _u.mutation.LastLoginIP(); ok {
@@ -1209,6 +1245,12 @@ func (_u *OauthAccountUpdateOne) sqlSave(ctx context.Context) (_node *OauthAccou
 	if _u.mutation.TokenExpiresAtCleared() {
 		_spec.ClearField(oauthaccount.FieldTokenExpiresAt, field.TypeTime)
 	}
+	if value, ok := _u.mutation.EncryptionKeyID(); ok {
+		_spec.SetField(oauthaccount.FieldEncryptionKeyID, field.TypeString, value)
+	}
+	if _u.mutation.EncryptionKeyIDCleared() {
+		_spec.ClearField(oauthaccount.FieldEncryptionKeyID, field.TypeString)
+	}
 	if value, ok := _u.mutation.ExtraData(); ok {
 		_spec.SetField(oauthaccount.FieldExtraData, field.TypeJSON, value)
 	}
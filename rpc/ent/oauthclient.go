@@ -0,0 +1,270 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/coder-lulu/newbee-core/rpc/ent/oauthclient"
+)
+
+// OAuth Client Table | OAuth 客户端表
+type OauthClient struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uint64 `json:"id,omitempty"`
+	// Create Time | 创建日期
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Update Time | 修改日期
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Status 1: normal 2: ban | 状态 1 正常 2 禁用
+	Status uint8 `json:"status,omitempty"`
+	// Tenant ID | 租户 ID
+	TenantID uint64 `json:"tenant_id,omitempty"`
+	// Client name shown on the consent page | 客户端名称
+	Name string `json:"name,omitempty"`
+	// Client ID, unique across tenants | 客户端ID
+	ClientID string `json:"client_id,omitempty"`
+	// SHA-256 of the client secret, empty for public clients | 客户端密钥的 SHA-256 摘要
+	ClientSecretHash string `json:"-"`
+	// Client type: confidential, public | 客户端类型
+	ClientType string `json:"client_type,omitempty"`
+	// Allowed redirect URIs, matched exactly | 允许的回调地址
+	RedirectUris []string `json:"redirect_uris,omitempty"`
+	// Allowed grant types | 允许的授权类型
+	GrantTypes []string `json:"grant_types,omitempty"`
+	// Allowed scopes | 允许的授权范围
+	Scopes []string `json:"scopes,omitempty"`
+	// First-party client which skips the consent | 是否为受信任的客户端，受信任的客户端无需用户同意
+	Trusted bool `json:"trusted,omitempty"`
+	// Access token lifetime in seconds, 0 uses the default | 访问令牌有效期（秒）
+	AccessTokenTTL uint32 `json:"access_token_ttl,omitempty"`
+	// Refresh token lifetime in seconds, 0 uses the default | 刷新令牌有效期（秒）
+	RefreshTokenTTL uint32 `json:"refresh_token_ttl,omitempty"`
+	// Remark | 备注
+	Remark       string `json:"remark,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*OauthClient) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case oauthclient.FieldRedirectUris, oauthclient.FieldGrantTypes, oauthclient.FieldScopes:
+			values[i] = new([]byte)
+		case oauthclient.FieldTrusted:
+			values[i] = new(sql.NullBool)
+		case oauthclient.FieldID, oauthclient.FieldStatus, oauthclient.FieldTenantID, oauthclient.FieldAccessTokenTTL, oauthclient.FieldRefreshTokenTTL:
+			values[i] = new(sql.NullInt64)
+		case oauthclient.FieldName, oauthclient.FieldClientID, oauthclient.FieldClientSecretHash, oauthclient.FieldClientType, oauthclient.FieldRemark:
+			values[i] = new(sql.NullString)
+		case oauthclient.FieldCreatedAt, oauthclient.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the OauthClient fields.
+func (_m *OauthClient) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case oauthclient.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = uint64(value.Int64)
+		case oauthclient.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case oauthclient.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case oauthclient.FieldStatus:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = uint8(value.Int64)
+			}
+		case oauthclient.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				_m.TenantID = uint64(value.Int64)
+			}
+		case oauthclient.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case oauthclient.FieldClientID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field client_id", values[i])
+			} else if value.Valid {
+				_m.ClientID = value.String
+			}
+		case oauthclient.FieldClientSecretHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field client_secret_hash", values[i])
+			} else if value.Valid {
+				_m.ClientSecretHash = value.String
+			}
+		case oauthclient.FieldClientType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field client_type", values[i])
+			} else if value.Valid {
+				_m.ClientType = value.String
+			}
+		case oauthclient.FieldRedirectUris:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field redirect_uris", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.RedirectUris); err != nil {
+					return fmt.Errorf("unmarshal field redirect_uris: %w", err)
+				}
+			}
+		case oauthclient.FieldGrantTypes:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field grant_types", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.GrantTypes); err != nil {
+					return fmt.Errorf("unmarshal field grant_types: %w", err)
+				}
+			}
+		case oauthclient.FieldScopes:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field scopes", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Scopes); err != nil {
+					return fmt.Errorf("unmarshal field scopes: %w", err)
+				}
+			}
+		case oauthclient.FieldTrusted:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field trusted", values[i])
+			} else if value.Valid {
+				_m.Trusted = value.Bool
+			}
+		case oauthclient.FieldAccessTokenTTL:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field access_token_ttl", values[i])
+			} else if value.Valid {
+				_m.AccessTokenTTL = uint32(value.Int64)
+			}
+		case oauthclient.FieldRefreshTokenTTL:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field refresh_token_ttl", values[i])
+			} else if value.Valid {
+				_m.RefreshTokenTTL = uint32(value.Int64)
+			}
+		case oauthclient.FieldRemark:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field remark", values[i])
+			} else if value.Valid {
+				_m.Remark = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the OauthClient.
+// This includes values selected through modifiers, order, etc.
+func (_m *OauthClient) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this OauthClient.
+// Note that you need to call OauthClient.Unwrap() before calling this method if this OauthClient
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *OauthClient) Update() *OauthClientUpdateOne {
+	return NewOauthClientClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the OauthClient entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *OauthClient) Unwrap() *OauthClient {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: OauthClient is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *OauthClient) String() string {
+	var builder strings.Builder
+	builder.WriteString("OauthClient(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("client_id=")
+	builder.WriteString(_m.ClientID)
+	builder.WriteString(", ")
+	builder.WriteString("client_secret_hash=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("client_type=")
+	builder.WriteString(_m.ClientType)
+	builder.WriteString(", ")
+	builder.WriteString("redirect_uris=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RedirectUris))
+	builder.WriteString(", ")
+	builder.WriteString("grant_types=")
+	builder.WriteString(fmt.Sprintf("%v", _m.GrantTypes))
+	builder.WriteString(", ")
+	builder.WriteString("scopes=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Scopes))
+	builder.WriteString(", ")
+	builder.WriteString("trusted=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Trusted))
+	builder.WriteString(", ")
+	builder.WriteString("access_token_ttl=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AccessTokenTTL))
+	builder.WriteString(", ")
+	builder.WriteString("refresh_token_ttl=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RefreshTokenTTL))
+	builder.WriteString(", ")
+	builder.WriteString("remark=")
+	builder.WriteString(_m.Remark)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// OauthClients is a parsable slice of OauthClient.
+type OauthClients []*OauthClient
@@ -0,0 +1,181 @@
+// Code generated by ent, DO NOT EDIT.
+
+package oauthclient
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the oauthclient type in the database.
+	Label = "oauth_client"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldClientID holds the string denoting the client_id field in the database.
+	FieldClientID = "client_id"
+	// FieldClientSecretHash holds the string denoting the client_secret_hash field in the database.
+	FieldClientSecretHash = "client_secret_hash"
+	// FieldClientType holds the string denoting the client_type field in the database.
+	FieldClientType = "client_type"
+	// FieldRedirectUris holds the string denoting the redirect_uris field in the database.
+	FieldRedirectUris = "redirect_uris"
+	// FieldGrantTypes holds the string denoting the grant_types field in the database.
+	FieldGrantTypes = "grant_types"
+	// FieldScopes holds the string denoting the scopes field in the database.
+	FieldScopes = "scopes"
+	// FieldTrusted holds the string denoting the trusted field in the database.
+	FieldTrusted = "trusted"
+	// FieldAccessTokenTTL holds the string denoting the access_token_ttl field in the database.
+	FieldAccessTokenTTL = "access_token_ttl"
+	// FieldRefreshTokenTTL holds the string denoting the refresh_token_ttl field in the database.
+	FieldRefreshTokenTTL = "refresh_token_ttl"
+	// FieldRemark holds the string denoting the remark field in the database.
+	FieldRemark = "remark"
+	// Table holds the table name of the oauthclient in the database.
+	Table = "sys_oauth_clients"
+)
+
+// Columns holds all SQL columns for oauthclient fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldStatus,
+	FieldTenantID,
+	FieldName,
+	FieldClientID,
+	FieldClientSecretHash,
+	FieldClientType,
+	FieldRedirectUris,
+	FieldGrantTypes,
+	FieldScopes,
+	FieldTrusted,
+	FieldAccessTokenTTL,
+	FieldRefreshTokenTTL,
+	FieldRemark,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultStatus holds the default value on creation for the "status" field.
+	DefaultStatus uint8
+	// DefaultTenantID holds the default value on creation for the "tenant_id" field.
+	DefaultTenantID uint64
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+	// ClientIDValidator is a validator for the "client_id" field. It is called by the builders before save.
+	ClientIDValidator func(string) error
+	// DefaultClientSecretHash holds the default value on creation for the "client_secret_hash" field.
+	DefaultClientSecretHash string
+	// ClientSecretHashValidator is a validator for the "client_secret_hash" field. It is called by the builders before save.
+	ClientSecretHashValidator func(string) error
+	// DefaultClientType holds the default value on creation for the "client_type" field.
+	DefaultClientType string
+	// ClientTypeValidator is a validator for the "client_type" field. It is called by the builders before save.
+	ClientTypeValidator func(string) error
+	// DefaultTrusted holds the default value on creation for the "trusted" field.
+	DefaultTrusted bool
+	// DefaultAccessTokenTTL holds the default value on creation for the "access_token_ttl" field.
+	DefaultAccessTokenTTL uint32
+	// DefaultRefreshTokenTTL holds the default value on creation for the "refresh_token_ttl" field.
+	DefaultRefreshTokenTTL uint32
+	// DefaultRemark holds the default value on creation for the "remark" field.
+	DefaultRemark string
+	// RemarkValidator is a validator for the "remark" field. It is called by the builders before save.
+	RemarkValidator func(string) error
+)
+
+// OrderOption defines the ordering options for the OauthClient queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByTenantID orders the results by the tenant_id field.
+func ByTenantID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenantID, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByClientID orders the results by the client_id field.
+func ByClientID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClientID, opts...).ToFunc()
+}
+
+// ByClientSecretHash orders the results by the client_secret_hash field.
+func ByClientSecretHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClientSecretHash, opts...).ToFunc()
+}
+
+// ByClientType orders the results by the client_type field.
+func ByClientType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClientType, opts...).ToFunc()
+}
+
+// ByTrusted orders the results by the trusted field.
+func ByTrusted(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTrusted, opts...).ToFunc()
+}
+
+// ByAccessTokenTTL orders the results by the access_token_ttl field.
+func ByAccessTokenTTL(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAccessTokenTTL, opts...).ToFunc()
+}
+
+// ByRefreshTokenTTL orders the results by the refresh_token_ttl field.
+func ByRefreshTokenTTL(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRefreshTokenTTL, opts...).ToFunc()
+}
+
+// ByRemark orders the results by the remark field.
+func ByRemark(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRemark, opts...).ToFunc()
+}
@@ -0,0 +1,765 @@
+// Code generated by ent, DO NOT EDIT.
+
+package oauthclient
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/coder-lulu/newbee-core/rpc/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uint64) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uint64) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uint64) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uint64) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uint64) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uint64) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uint64) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uint64) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uint64) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v uint8) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldEQ(FieldStatus, v))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v uint64) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldEQ(FieldTenantID, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldEQ(FieldName, v))
+}
+
+// ClientID applies equality check predicate on the "client_id" field. It's identical to ClientIDEQ.
+func ClientID(v string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldEQ(FieldClientID, v))
+}
+
+// ClientSecretHash applies equality check predicate on the "client_secret_hash" field. It's identical to ClientSecretHashEQ.
+func ClientSecretHash(v string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldEQ(FieldClientSecretHash, v))
+}
+
+// ClientType applies equality check predicate on the "client_type" field. It's identical to ClientTypeEQ.
+func ClientType(v string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldEQ(FieldClientType, v))
+}
+
+// Trusted applies equality check predicate on the "trusted" field. It's identical to TrustedEQ.
+func Trusted(v bool) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldEQ(FieldTrusted, v))
+}
+
+// AccessTokenTTL applies equality check predicate on the "access_token_ttl" field. It's identical to AccessTokenTTLEQ.
+func AccessTokenTTL(v uint32) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldEQ(FieldAccessTokenTTL, v))
+}
+
+// RefreshTokenTTL applies equality check predicate on the "refresh_token_ttl" field. It's identical to RefreshTokenTTLEQ.
+func RefreshTokenTTL(v uint32) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldEQ(FieldRefreshTokenTTL, v))
+}
+
+// Remark applies equality check predicate on the "remark" field. It's identical to RemarkEQ.
+func Remark(v string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldEQ(FieldRemark, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v uint8) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v uint8) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...uint8) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...uint8) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v uint8) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v uint8) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v uint8) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v uint8) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusIsNil applies the IsNil predicate on the "status" field.
+func StatusIsNil() predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldIsNull(FieldStatus))
+}
+
+// StatusNotNil applies the NotNil predicate on the "status" field.
+func StatusNotNil() predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldNotNull(FieldStatus))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v uint64) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v uint64) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...uint64) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...uint64) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v uint64) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v uint64) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v uint64) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v uint64) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldLTE(FieldTenantID, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldContainsFold(FieldName, v))
+}
+
+// ClientIDEQ applies the EQ predicate on the "client_id" field.
+func ClientIDEQ(v string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldEQ(FieldClientID, v))
+}
+
+// ClientIDNEQ applies the NEQ predicate on the "client_id" field.
+func ClientIDNEQ(v string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldNEQ(FieldClientID, v))
+}
+
+// ClientIDIn applies the In predicate on the "client_id" field.
+func ClientIDIn(vs ...string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldIn(FieldClientID, vs...))
+}
+
+// ClientIDNotIn applies the NotIn predicate on the "client_id" field.
+func ClientIDNotIn(vs ...string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldNotIn(FieldClientID, vs...))
+}
+
+// ClientIDGT applies the GT predicate on the "client_id" field.
+func ClientIDGT(v string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldGT(FieldClientID, v))
+}
+
+// ClientIDGTE applies the GTE predicate on the "client_id" field.
+func ClientIDGTE(v string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldGTE(FieldClientID, v))
+}
+
+// ClientIDLT applies the LT predicate on the "client_id" field.
+func ClientIDLT(v string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldLT(FieldClientID, v))
+}
+
+// ClientIDLTE applies the LTE predicate on the "client_id" field.
+func ClientIDLTE(v string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldLTE(FieldClientID, v))
+}
+
+// ClientIDContains applies the Contains predicate on the "client_id" field.
+func ClientIDContains(v string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldContains(FieldClientID, v))
+}
+
+// ClientIDHasPrefix applies the HasPrefix predicate on the "client_id" field.
+func ClientIDHasPrefix(v string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldHasPrefix(FieldClientID, v))
+}
+
+// ClientIDHasSuffix applies the HasSuffix predicate on the "client_id" field.
+func ClientIDHasSuffix(v string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldHasSuffix(FieldClientID, v))
+}
+
+// ClientIDEqualFold applies the EqualFold predicate on the "client_id" field.
+func ClientIDEqualFold(v string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldEqualFold(FieldClientID, v))
+}
+
+// ClientIDContainsFold applies the ContainsFold predicate on the "client_id" field.
+func ClientIDContainsFold(v string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldContainsFold(FieldClientID, v))
+}
+
+// ClientSecretHashEQ applies the EQ predicate on the "client_secret_hash" field.
+func ClientSecretHashEQ(v string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldEQ(FieldClientSecretHash, v))
+}
+
+// ClientSecretHashNEQ applies the NEQ predicate on the "client_secret_hash" field.
+func ClientSecretHashNEQ(v string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldNEQ(FieldClientSecretHash, v))
+}
+
+// ClientSecretHashIn applies the In predicate on the "client_secret_hash" field.
+func ClientSecretHashIn(vs ...string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldIn(FieldClientSecretHash, vs...))
+}
+
+// ClientSecretHashNotIn applies the NotIn predicate on the "client_secret_hash" field.
+func ClientSecretHashNotIn(vs ...string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldNotIn(FieldClientSecretHash, vs...))
+}
+
+// ClientSecretHashGT applies the GT predicate on the "client_secret_hash" field.
+func ClientSecretHashGT(v string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldGT(FieldClientSecretHash, v))
+}
+
+// ClientSecretHashGTE applies the GTE predicate on the "client_secret_hash" field.
+func ClientSecretHashGTE(v string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldGTE(FieldClientSecretHash, v))
+}
+
+// ClientSecretHashLT applies the LT predicate on the "client_secret_hash" field.
+func ClientSecretHashLT(v string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldLT(FieldClientSecretHash, v))
+}
+
+// ClientSecretHashLTE applies the LTE predicate on the "client_secret_hash" field.
+func ClientSecretHashLTE(v string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldLTE(FieldClientSecretHash, v))
+}
+
+// ClientSecretHashContains applies the Contains predicate on the "client_secret_hash" field.
+func ClientSecretHashContains(v string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldContains(FieldClientSecretHash, v))
+}
+
+// ClientSecretHashHasPrefix applies the HasPrefix predicate on the "client_secret_hash" field.
+func ClientSecretHashHasPrefix(v string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldHasPrefix(FieldClientSecretHash, v))
+}
+
+// ClientSecretHashHasSuffix applies the HasSuffix predicate on the "client_secret_hash" field.
+func ClientSecretHashHasSuffix(v string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldHasSuffix(FieldClientSecretHash, v))
+}
+
+// ClientSecretHashIsNil applies the IsNil predicate on the "client_secret_hash" field.
+func ClientSecretHashIsNil() predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldIsNull(FieldClientSecretHash))
+}
+
+// ClientSecretHashNotNil applies the NotNil predicate on the "client_secret_hash" field.
+func ClientSecretHashNotNil() predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldNotNull(FieldClientSecretHash))
+}
+
+// ClientSecretHashEqualFold applies the EqualFold predicate on the "client_secret_hash" field.
+func ClientSecretHashEqualFold(v string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldEqualFold(FieldClientSecretHash, v))
+}
+
+// ClientSecretHashContainsFold applies the ContainsFold predicate on the "client_secret_hash" field.
+func ClientSecretHashContainsFold(v string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldContainsFold(FieldClientSecretHash, v))
+}
+
+// ClientTypeEQ applies the EQ predicate on the "client_type" field.
+func ClientTypeEQ(v string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldEQ(FieldClientType, v))
+}
+
+// ClientTypeNEQ applies the NEQ predicate on the "client_type" field.
+func ClientTypeNEQ(v string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldNEQ(FieldClientType, v))
+}
+
+// ClientTypeIn applies the In predicate on the "client_type" field.
+func ClientTypeIn(vs ...string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldIn(FieldClientType, vs...))
+}
+
+// ClientTypeNotIn applies the NotIn predicate on the "client_type" field.
+func ClientTypeNotIn(vs ...string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldNotIn(FieldClientType, vs...))
+}
+
+// ClientTypeGT applies the GT predicate on the "client_type" field.
+func ClientTypeGT(v string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldGT(FieldClientType, v))
+}
+
+// ClientTypeGTE applies the GTE predicate on the "client_type" field.
+func ClientTypeGTE(v string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldGTE(FieldClientType, v))
+}
+
+// ClientTypeLT applies the LT predicate on the "client_type" field.
+func ClientTypeLT(v string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldLT(FieldClientType, v))
+}
+
+// ClientTypeLTE applies the LTE predicate on the "client_type" field.
+func ClientTypeLTE(v string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldLTE(FieldClientType, v))
+}
+
+// ClientTypeContains applies the Contains predicate on the "client_type" field.
+func ClientTypeContains(v string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldContains(FieldClientType, v))
+}
+
+// ClientTypeHasPrefix applies the HasPrefix predicate on the "client_type" field.
+func ClientTypeHasPrefix(v string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldHasPrefix(FieldClientType, v))
+}
+
+// ClientTypeHasSuffix applies the HasSuffix predicate on the "client_type" field.
+func ClientTypeHasSuffix(v string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldHasSuffix(FieldClientType, v))
+}
+
+// ClientTypeEqualFold applies the EqualFold predicate on the "client_type" field.
+func ClientTypeEqualFold(v string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldEqualFold(FieldClientType, v))
+}
+
+// ClientTypeContainsFold applies the ContainsFold predicate on the "client_type" field.
+func ClientTypeContainsFold(v string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldContainsFold(FieldClientType, v))
+}
+
+// RedirectUrisIsNil applies the IsNil predicate on the "redirect_uris" field.
+func RedirectUrisIsNil() predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldIsNull(FieldRedirectUris))
+}
+
+// RedirectUrisNotNil applies the NotNil predicate on the "redirect_uris" field.
+func RedirectUrisNotNil() predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldNotNull(FieldRedirectUris))
+}
+
+// GrantTypesIsNil applies the IsNil predicate on the "grant_types" field.
+func GrantTypesIsNil() predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldIsNull(FieldGrantTypes))
+}
+
+// GrantTypesNotNil applies the NotNil predicate on the "grant_types" field.
+func GrantTypesNotNil() predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldNotNull(FieldGrantTypes))
+}
+
+// ScopesIsNil applies the IsNil predicate on the "scopes" field.
+func ScopesIsNil() predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldIsNull(FieldScopes))
+}
+
+// ScopesNotNil applies the NotNil predicate on the "scopes" field.
+func ScopesNotNil() predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldNotNull(FieldScopes))
+}
+
+// TrustedEQ applies the EQ predicate on the "trusted" field.
+func TrustedEQ(v bool) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldEQ(FieldTrusted, v))
+}
+
+// TrustedNEQ applies the NEQ predicate on the "trusted" field.
+func TrustedNEQ(v bool) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldNEQ(FieldTrusted, v))
+}
+
+// AccessTokenTTLEQ applies the EQ predicate on the "access_token_ttl" field.
+func AccessTokenTTLEQ(v uint32) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldEQ(FieldAccessTokenTTL, v))
+}
+
+// AccessTokenTTLNEQ applies the NEQ predicate on the "access_token_ttl" field.
+func AccessTokenTTLNEQ(v uint32) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldNEQ(FieldAccessTokenTTL, v))
+}
+
+// AccessTokenTTLIn applies the In predicate on the "access_token_ttl" field.
+func AccessTokenTTLIn(vs ...uint32) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldIn(FieldAccessTokenTTL, vs...))
+}
+
+// AccessTokenTTLNotIn applies the NotIn predicate on the "access_token_ttl" field.
+func AccessTokenTTLNotIn(vs ...uint32) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldNotIn(FieldAccessTokenTTL, vs...))
+}
+
+// AccessTokenTTLGT applies the GT predicate on the "access_token_ttl" field.
+func AccessTokenTTLGT(v uint32) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldGT(FieldAccessTokenTTL, v))
+}
+
+// AccessTokenTTLGTE applies the GTE predicate on the "access_token_ttl" field.
+func AccessTokenTTLGTE(v uint32) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldGTE(FieldAccessTokenTTL, v))
+}
+
+// AccessTokenTTLLT applies the LT predicate on the "access_token_ttl" field.
+func AccessTokenTTLLT(v uint32) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldLT(FieldAccessTokenTTL, v))
+}
+
+// AccessTokenTTLLTE applies the LTE predicate on the "access_token_ttl" field.
+func AccessTokenTTLLTE(v uint32) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldLTE(FieldAccessTokenTTL, v))
+}
+
+// RefreshTokenTTLEQ applies the EQ predicate on the "refresh_token_ttl" field.
+func RefreshTokenTTLEQ(v uint32) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldEQ(FieldRefreshTokenTTL, v))
+}
+
+// RefreshTokenTTLNEQ applies the NEQ predicate on the "refresh_token_ttl" field.
+func RefreshTokenTTLNEQ(v uint32) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldNEQ(FieldRefreshTokenTTL, v))
+}
+
+// RefreshTokenTTLIn applies the In predicate on the "refresh_token_ttl" field.
+func RefreshTokenTTLIn(vs ...uint32) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldIn(FieldRefreshTokenTTL, vs...))
+}
+
+// RefreshTokenTTLNotIn applies the NotIn predicate on the "refresh_token_ttl" field.
+func RefreshTokenTTLNotIn(vs ...uint32) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldNotIn(FieldRefreshTokenTTL, vs...))
+}
+
+// RefreshTokenTTLGT applies the GT predicate on the "refresh_token_ttl" field.
+func RefreshTokenTTLGT(v uint32) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldGT(FieldRefreshTokenTTL, v))
+}
+
+// RefreshTokenTTLGTE applies the GTE predicate on the "refresh_token_ttl" field.
+func RefreshTokenTTLGTE(v uint32) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldGTE(FieldRefreshTokenTTL, v))
+}
+
+// RefreshTokenTTLLT applies the LT predicate on the "refresh_token_ttl" field.
+func RefreshTokenTTLLT(v uint32) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldLT(FieldRefreshTokenTTL, v))
+}
+
+// RefreshTokenTTLLTE applies the LTE predicate on the "refresh_token_ttl" field.
+func RefreshTokenTTLLTE(v uint32) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldLTE(FieldRefreshTokenTTL, v))
+}
+
+// RemarkEQ applies the EQ predicate on the "remark" field.
+func RemarkEQ(v string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldEQ(FieldRemark, v))
+}
+
+// RemarkNEQ applies the NEQ predicate on the "remark" field.
+func RemarkNEQ(v string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldNEQ(FieldRemark, v))
+}
+
+// RemarkIn applies the In predicate on the "remark" field.
+func RemarkIn(vs ...string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldIn(FieldRemark, vs...))
+}
+
+// RemarkNotIn applies the NotIn predicate on the "remark" field.
+func RemarkNotIn(vs ...string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldNotIn(FieldRemark, vs...))
+}
+
+// RemarkGT applies the GT predicate on the "remark" field.
+func RemarkGT(v string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldGT(FieldRemark, v))
+}
+
+// RemarkGTE applies the GTE predicate on the "remark" field.
+func RemarkGTE(v string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldGTE(FieldRemark, v))
+}
+
+// RemarkLT applies the LT predicate on the "remark" field.
+func RemarkLT(v string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldLT(FieldRemark, v))
+}
+
+// RemarkLTE applies the LTE predicate on the "remark" field.
+func RemarkLTE(v string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldLTE(FieldRemark, v))
+}
+
+// RemarkContains applies the Contains predicate on the "remark" field.
+func RemarkContains(v string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldContains(FieldRemark, v))
+}
+
+// RemarkHasPrefix applies the HasPrefix predicate on the "remark" field.
+func RemarkHasPrefix(v string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldHasPrefix(FieldRemark, v))
+}
+
+// RemarkHasSuffix applies the HasSuffix predicate on the "remark" field.
+func RemarkHasSuffix(v string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldHasSuffix(FieldRemark, v))
+}
+
+// RemarkIsNil applies the IsNil predicate on the "remark" field.
+func RemarkIsNil() predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldIsNull(FieldRemark))
+}
+
+// RemarkNotNil applies the NotNil predicate on the "remark" field.
+func RemarkNotNil() predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldNotNull(FieldRemark))
+}
+
+// RemarkEqualFold applies the EqualFold predicate on the "remark" field.
+func RemarkEqualFold(v string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldEqualFold(FieldRemark, v))
+}
+
+// RemarkContainsFold applies the ContainsFold predicate on the "remark" field.
+func RemarkContainsFold(v string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldContainsFold(FieldRemark, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.OauthClient) predicate.OauthClient {
+	return predicate.OauthClient(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.OauthClient) predicate.OauthClient {
+	return predicate.OauthClient(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.OauthClient) predicate.OauthClient {
+	return predicate.OauthClient(sql.NotPredicates(p))
+}
@@ -0,0 +1,511 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/coder-lulu/newbee-core/rpc/ent/oauthclient"
+)
+
+// OauthClientCreate is the builder for creating a OauthClient entity.
+type OauthClientCreate struct {
+	config
+	mutation *OauthClientMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *OauthClientCreate) SetCreatedAt(v time.Time) *OauthClientCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *OauthClientCreate) SetNillableCreatedAt(v *time.Time) *OauthClientCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *OauthClientCreate) SetUpdatedAt(v time.Time) *OauthClientCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *OauthClientCreate) SetNillableUpdatedAt(v *time.Time) *OauthClientCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *OauthClientCreate) SetStatus(v uint8) *OauthClientCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *OauthClientCreate) SetNillableStatus(v *uint8) *OauthClientCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *OauthClientCreate) SetTenantID(v uint64) *OauthClientCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_c *OauthClientCreate) SetNillableTenantID(v *uint64) *OauthClientCreate {
+	if v != nil {
+		_c.SetTenantID(*v)
+	}
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *OauthClientCreate) SetName(v string) *OauthClientCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetClientID sets the "client_id" field.
+func (_c *OauthClientCreate) SetClientID(v string) *OauthClientCreate {
+	_c.mutation.SetClientID(v)
+	return _c
+}
+
+// SetClientSecretHash sets the "client_secret_hash" field.
+func (_c *OauthClientCreate) SetClientSecretHash(v string) *OauthClientCreate {
+	_c.mutation.SetClientSecretHash(v)
+	return _c
+}
+
+// SetNillableClientSecretHash sets the "client_secret_hash" field if the given value is not nil.
+func (_c *OauthClientCreate) SetNillableClientSecretHash(v *string) *OauthClientCreate {
+	if v != nil {
+		_c.SetClientSecretHash(*v)
+	}
+	return _c
+}
+
+// SetClientType sets the "client_type" field.
+func (_c *OauthClientCreate) SetClientType(v string) *OauthClientCreate {
+	_c.mutation.SetClientType(v)
+	return _c
+}
+
+// SetNillableClientType sets the "client_type" field if the given value is not nil.
+func (_c *OauthClientCreate) SetNillableClientType(v *string) *OauthClientCreate {
+	if v != nil {
+		_c.SetClientType(*v)
+	}
+	return _c
+}
+
+// SetRedirectUris sets the "redirect_uris" field.
+func (_c *OauthClientCreate) SetRedirectUris(v []string) *OauthClientCreate {
+	_c.mutation.SetRedirectUris(v)
+	return _c
+}
+
+// SetGrantTypes sets the "grant_types" field.
+func (_c *OauthClientCreate) SetGrantTypes(v []string) *OauthClientCreate {
+	_c.mutation.SetGrantTypes(v)
+	return _c
+}
+
+// SetScopes sets the "scopes" field.
+func (_c *OauthClientCreate) SetScopes(v []string) *OauthClientCreate {
+	_c.mutation.SetScopes(v)
+	return _c
+}
+
+// SetTrusted sets the "trusted" field.
+func (_c *OauthClientCreate) SetTrusted(v bool) *OauthClientCreate {
+	_c.mutation.SetTrusted(v)
+	return _c
+}
+
+// SetNillableTrusted sets the "trusted" field if the given value is not nil.
+func (_c *OauthClientCreate) SetNillableTrusted(v *bool) *OauthClientCreate {
+	if v != nil {
+		_c.SetTrusted(*v)
+	}
+	return _c
+}
+
+// SetAccessTokenTTL sets the "access_token_ttl" field.
+func (_c *OauthClientCreate) SetAccessTokenTTL(v uint32) *OauthClientCreate {
+	_c.mutation.SetAccessTokenTTL(v)
+	return _c
+}
+
+// SetNillableAccessTokenTTL sets the "access_token_ttl" field if the given value is not nil.
+func (_c *OauthClientCreate) SetNillableAccessTokenTTL(v *uint32) *OauthClientCreate {
+	if v != nil {
+		_c.SetAccessTokenTTL(*v)
+	}
+	return _c
+}
+
+// SetRefreshTokenTTL sets the "refresh_token_ttl" field.
+func (_c *OauthClientCreate) SetRefreshTokenTTL(v uint32) *OauthClientCreate {
+	_c.mutation.SetRefreshTokenTTL(v)
+	return _c
+}
+
+// SetNillableRefreshTokenTTL sets the "refresh_token_ttl" field if the given value is not nil.
+func (_c *OauthClientCreate) SetNillableRefreshTokenTTL(v *uint32) *OauthClientCreate {
+	if v != nil {
+		_c.SetRefreshTokenTTL(*v)
+	}
+	return _c
+}
+
+// SetRemark sets the "remark" field.
+func (_c *OauthClientCreate) SetRemark(v string) *OauthClientCreate {
+	_c.mutation.SetRemark(v)
+	return _c
+}
+
+// SetNillableRemark sets the "remark" field if the given value is not nil.
+func (_c *OauthClientCreate) SetNillableRemark(v *string) *OauthClientCreate {
+	if v != nil {
+		_c.SetRemark(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *OauthClientCreate) SetID(v uint64) *OauthClientCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the OauthClientMutation object of the builder.
+func (_c *OauthClientCreate) Mutation() *OauthClientMutation {
+	return _c.mutation
+}
+
+// Save creates the OauthClient in the database.
+func (_c *OauthClientCreate) Save(ctx context.Context) (*OauthClient, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *OauthClientCreate) SaveX(ctx context.Context) *OauthClient {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *OauthClientCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *OauthClientCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *OauthClientCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := oauthclient.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := oauthclient.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := oauthclient.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.TenantID(); !ok {
+		v := oauthclient.DefaultTenantID
+		_c.mutation.SetTenantID(v)
+	}
+	if _, ok := _c.mutation.ClientSecretHash(); !ok {
+		v := oauthclient.DefaultClientSecretHash
+		_c.mutation.SetClientSecretHash(v)
+	}
+	if _, ok := _c.mutation.ClientType(); !ok {
+		v := oauthclient.DefaultClientType
+		_c.mutation.SetClientType(v)
+	}
+	if _, ok := _c.mutation.Trusted(); !ok {
+		v := oauthclient.DefaultTrusted
+		_c.mutation.SetTrusted(v)
+	}
+	if _, ok := _c.mutation.AccessTokenTTL(); !ok {
+		v := oauthclient.DefaultAccessTokenTTL
+		_c.mutation.SetAccessTokenTTL(v)
+	}
+	if _, ok := _c.mutation.RefreshTokenTTL(); !ok {
+		v := oauthclient.DefaultRefreshTokenTTL
+		_c.mutation.SetRefreshTokenTTL(v)
+	}
+	if _, ok := _c.mutation.Remark(); !ok {
+		v := oauthclient.DefaultRemark
+		_c.mutation.SetRemark(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *OauthClientCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "OauthClient.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "OauthClient.updated_at"`)}
+	}
+	if _, ok := _c.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "OauthClient.tenant_id"`)}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "OauthClient.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := oauthclient.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "OauthClient.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ClientID(); !ok {
+		return &ValidationError{Name: "client_id", err: errors.New(`ent: missing required field "OauthClient.client_id"`)}
+	}
+	if v, ok := _c.mutation.ClientID(); ok {
+		if err := oauthclient.ClientIDValidator(v); err != nil {
+			return &ValidationError{Name: "client_id", err: fmt.Errorf(`ent: validator failed for field "OauthClient.client_id": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ClientSecretHash(); ok {
+		if err := oauthclient.ClientSecretHashValidator(v); err != nil {
+			return &ValidationError{Name: "client_secret_hash", err: fmt.Errorf(`ent: validator failed for field "OauthClient.client_secret_hash": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ClientType(); !ok {
+		return &ValidationError{Name: "client_type", err: errors.New(`ent: missing required field "OauthClient.client_type"`)}
+	}
+	if v, ok := _c.mutation.ClientType(); ok {
+		if err := oauthclient.ClientTypeValidator(v); err != nil {
+			return &ValidationError{Name: "client_type", err: fmt.Errorf(`ent: validator failed for field "OauthClient.client_type": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Trusted(); !ok {
+		return &ValidationError{Name: "trusted", err: errors.New(`ent: missing required field "OauthClient.trusted"`)}
+	}
+	if _, ok := _c.mutation.AccessTokenTTL(); !ok {
+		return &ValidationError{Name: "access_token_ttl", err: errors.New(`ent: missing required field "OauthClient.access_token_ttl"`)}
+	}
+	if _, ok := _c.mutation.RefreshTokenTTL(); !ok {
+		return &ValidationError{Name: "refresh_token_ttl", err: errors.New(`ent: missing required field "OauthClient.refresh_token_ttl"`)}
+	}
+	if v, ok := _c.mutation.Remark(); ok {
+		if err := oauthclient.RemarkValidator(v); err != nil {
+			return &ValidationError{Name: "remark", err: fmt.Errorf(`ent: validator failed for field "OauthClient.remark": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *OauthClientCreate) sqlSave(ctx context.Context) (*OauthClient, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint64(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *OauthClientCreate) createSpec() (*OauthClient, *sqlgraph.CreateSpec) {
+	var (
+		_node = &OauthClient{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(oauthclient.Table, sqlgraph.NewFieldSpec(oauthclient.FieldID, field.TypeUint64))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(oauthclient.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(oauthclient.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(oauthclient.FieldStatus, field.TypeUint8, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(oauthclient.FieldTenantID, field.TypeUint64, value)
+		_node.TenantID = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(oauthclient.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.ClientID(); ok {
+		_spec.SetField(oauthclient.FieldClientID, field.TypeString, value)
+		_node.ClientID = value
+	}
+	if value, ok := _c.mutation.ClientSecretHash(); ok {
+		_spec.SetField(oauthclient.FieldClientSecretHash, field.TypeString, value)
+		_node.ClientSecretHash = value
+	}
+	if value, ok := _c.mutation.ClientType(); ok {
+		_spec.SetField(oauthclient.FieldClientType, field.TypeString, value)
+		_node.ClientType = value
+	}
+	if value, ok := _c.mutation.RedirectUris(); ok {
+		_spec.SetField(oauthclient.FieldRedirectUris, field.TypeJSON, value)
+		_node.RedirectUris = value
+	}
+	if value, ok := _c.mutation.GrantTypes(); ok {
+		_spec.SetField(oauthclient.FieldGrantTypes, field.TypeJSON, value)
+		_node.GrantTypes = value
+	}
+	if value, ok := _c.mutation.Scopes(); ok {
+		_spec.SetField(oauthclient.FieldScopes, field.TypeJSON, value)
+		_node.Scopes = value
+	}
+	if value, ok := _c.mutation.Trusted(); ok {
+		_spec.SetField(oauthclient.FieldTrusted, field.TypeBool, value)
+		_node.Trusted = value
+	}
+	if value, ok := _c.mutation.AccessTokenTTL(); ok {
+		_spec.SetField(oauthclient.FieldAccessTokenTTL, field.TypeUint32, value)
+		_node.AccessTokenTTL = value
+	}
+	if value, ok := _c.mutation.RefreshTokenTTL(); ok {
+		_spec.SetField(oauthclient.FieldRefreshTokenTTL, field.TypeUint32, value)
+		_node.RefreshTokenTTL = value
+	}
+	if value, ok := _c.mutation.Remark(); ok {
+		_spec.SetField(oauthclient.FieldRemark, field.TypeString, value)
+		_node.Remark = value
+	}
+	return _node, _spec
+}
+
+// OauthClientCreateBulk is the builder for creating many OauthClient entities in bulk.
+type OauthClientCreateBulk struct {
+	config
+	err      error
+	builders []*OauthClientCreate
+}
+
+// Save creates the OauthClient entities in the database.
+func (_c *OauthClientCreateBulk) Save(ctx context.Context) ([]*OauthClient, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*OauthClient, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*OauthClientMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *OauthClientCreateBulk) SaveX(ctx context.Context) []*OauthClient {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *OauthClientCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *OauthClientCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,845 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/coder-lulu/newbee-core/rpc/ent/oauthclient"
+	"github.com/coder-lulu/newbee-core/rpc/ent/predicate"
+)
+
+// OauthClientUpdate is the builder for updating OauthClient entities.
+type OauthClientUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *OauthClientMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the OauthClientUpdate builder.
+func (_u *OauthClientUpdate) Where(ps ...predicate.OauthClient) *OauthClientUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *OauthClientUpdate) SetUpdatedAt(v time.Time) *OauthClientUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *OauthClientUpdate) SetStatus(v uint8) *OauthClientUpdate {
+	_u.mutation.ResetStatus()
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *OauthClientUpdate) SetNillableStatus(v *uint8) *OauthClientUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// AddStatus adds value to the "status" field.
+func (_u *OauthClientUpdate) AddStatus(v int8) *OauthClientUpdate {
+	_u.mutation.AddStatus(v)
+	return _u
+}
+
+// ClearStatus clears the value of the "status" field.
+func (_u *OauthClientUpdate) ClearStatus() *OauthClientUpdate {
+	_u.mutation.ClearStatus()
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *OauthClientUpdate) SetName(v string) *OauthClientUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *OauthClientUpdate) SetNillableName(v *string) *OauthClientUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetClientSecretHash sets the "client_secret_hash" field.
+func (_u *OauthClientUpdate) SetClientSecretHash(v string) *OauthClientUpdate {
+	_u.mutation.SetClientSecretHash(v)
+	return _u
+}
+
+// SetNillableClientSecretHash sets the "client_secret_hash" field if the given value is not nil.
+func (_u *OauthClientUpdate) SetNillableClientSecretHash(v *string) *OauthClientUpdate {
+	if v != nil {
+		_u.SetClientSecretHash(*v)
+	}
+	return _u
+}
+
+// ClearClientSecretHash clears the value of the "client_secret_hash" field.
+func (_u *OauthClientUpdate) ClearClientSecretHash() *OauthClientUpdate {
+	_u.mutation.ClearClientSecretHash()
+	return _u
+}
+
+// SetClientType sets the "client_type" field.
+func (_u *OauthClientUpdate) SetClientType(v string) *OauthClientUpdate {
+	_u.mutation.SetClientType(v)
+	return _u
+}
+
+// SetNillableClientType sets the "client_type" field if the given value is not nil.
+func (_u *OauthClientUpdate) SetNillableClientType(v *string) *OauthClientUpdate {
+	if v != nil {
+		_u.SetClientType(*v)
+	}
+	return _u
+}
+
+// SetRedirectUris sets the "redirect_uris" field.
+func (_u *OauthClientUpdate) SetRedirectUris(v []string) *OauthClientUpdate {
+	_u.mutation.SetRedirectUris(v)
+	return _u
+}
+
+// AppendRedirectUris appends value to the "redirect_uris" field.
+func (_u *OauthClientUpdate) AppendRedirectUris(v []string) *OauthClientUpdate {
+	_u.mutation.AppendRedirectUris(v)
+	return _u
+}
+
+// ClearRedirectUris clears the value of the "redirect_uris" field.
+func (_u *OauthClientUpdate) ClearRedirectUris() *OauthClientUpdate {
+	_u.mutation.ClearRedirectUris()
+	return _u
+}
+
+// SetGrantTypes sets the "grant_types" field.
+func (_u *OauthClientUpdate) SetGrantTypes(v []string) *OauthClientUpdate {
+	_u.mutation.SetGrantTypes(v)
+	return _u
+}
+
+// AppendGrantTypes appends value to the "grant_types" field.
+func (_u *OauthClientUpdate) AppendGrantTypes(v []string) *OauthClientUpdate {
+	_u.mutation.AppendGrantTypes(v)
+	return _u
+}
+
+// ClearGrantTypes clears the value of the "grant_types" field.
+func (_u *OauthClientUpdate) ClearGrantTypes() *OauthClientUpdate {
+	_u.mutation.ClearGrantTypes()
+	return _u
+}
+
+// SetScopes sets the "scopes" field.
+func (_u *OauthClientUpdate) SetScopes(v []string) *OauthClientUpdate {
+	_u.mutation.SetScopes(v)
+	return _u
+}
+
+// AppendScopes appends value to the "scopes" field.
+func (_u *OauthClientUpdate) AppendScopes(v []string) *OauthClientUpdate {
+	_u.mutation.AppendScopes(v)
+	return _u
+}
+
+// ClearScopes clears the value of the "scopes" field.
+func (_u *OauthClientUpdate) ClearScopes() *OauthClientUpdate {
+	_u.mutation.ClearScopes()
+	return _u
+}
+
+// SetTrusted sets the "trusted" field.
+func (_u *OauthClientUpdate) SetTrusted(v bool) *OauthClientUpdate {
+	_u.mutation.SetTrusted(v)
+	return _u
+}
+
+// SetNillableTrusted sets the "trusted" field if the given value is not nil.
+func (_u *OauthClientUpdate) SetNillableTrusted(v *bool) *OauthClientUpdate {
+	if v != nil {
+		_u.SetTrusted(*v)
+	}
+	return _u
+}
+
+// SetAccessTokenTTL sets the "access_token_ttl" field.
+func (_u *OauthClientUpdate) SetAccessTokenTTL(v uint32) *OauthClientUpdate {
+	_u.mutation.ResetAccessTokenTTL()
+	_u.mutation.SetAccessTokenTTL(v)
+	return _u
+}
+
+// SetNillableAccessTokenTTL sets the "access_token_ttl" field if the given value is not nil.
+func (_u *OauthClientUpdate) SetNillableAccessTokenTTL(v *uint32) *OauthClientUpdate {
+	if v != nil {
+		_u.SetAccessTokenTTL(*v)
+	}
+	return _u
+}
+
+// AddAccessTokenTTL adds value to the "access_token_ttl" field.
+func (_u *OauthClientUpdate) AddAccessTokenTTL(v int32) *OauthClientUpdate {
+	_u.mutation.AddAccessTokenTTL(v)
+	return _u
+}
+
+// SetRefreshTokenTTL sets the "refresh_token_ttl" field.
+func (_u *OauthClientUpdate) SetRefreshTokenTTL(v uint32) *OauthClientUpdate {
+	_u.mutation.ResetRefreshTokenTTL()
+	_u.mutation.SetRefreshTokenTTL(v)
+	return _u
+}
+
+// SetNillableRefreshTokenTTL sets the "refresh_token_ttl" field if the given value is not nil.
+func (_u *OauthClientUpdate) SetNillableRefreshTokenTTL(v *uint32) *OauthClientUpdate {
+	if v != nil {
+		_u.SetRefreshTokenTTL(*v)
+	}
+	return _u
+}
+
+// AddRefreshTokenTTL adds value to the "refresh_token_ttl" field.
+func (_u *OauthClientUpdate) AddRefreshTokenTTL(v int32) *OauthClientUpdate {
+	_u.mutation.AddRefreshTokenTTL(v)
+	return _u
+}
+
+// SetRemark sets the "remark" field.
+func (_u *OauthClientUpdate) SetRemark(v string) *OauthClientUpdate {
+	_u.mutation.SetRemark(v)
+	return _u
+}
+
+// SetNillableRemark sets the "remark" field if the given value is not nil.
+func (_u *OauthClientUpdate) SetNillableRemark(v *string) *OauthClientUpdate {
+	if v != nil {
+		_u.SetRemark(*v)
+	}
+	return _u
+}
+
+// ClearRemark clears the value of the "remark" field.
+func (_u *OauthClientUpdate) ClearRemark() *OauthClientUpdate {
+	_u.mutation.ClearRemark()
+	return _u
+}
+
+// Mutation returns the OauthClientMutation object of the builder.
+func (_u *OauthClientUpdate) Mutation() *OauthClientMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *OauthClientUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *OauthClientUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *OauthClientUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *OauthClientUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *OauthClientUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := oauthclient.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *OauthClientUpdate) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := oauthclient.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "OauthClient.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ClientSecretHash(); ok {
+		if err := oauthclient.ClientSecretHashValidator(v); err != nil {
+			return &ValidationError{Name: "client_secret_hash", err: fmt.Errorf(`ent: validator failed for field "OauthClient.client_secret_hash": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ClientType(); ok {
+		if err := oauthclient.ClientTypeValidator(v); err != nil {
+			return &ValidationError{Name: "client_type", err: fmt.Errorf(`ent: validator failed for field "OauthClient.client_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Remark(); ok {
+		if err := oauthclient.RemarkValidator(v); err != nil {
+			return &ValidationError{Name: "remark", err: fmt.Errorf(`ent: validator failed for field "OauthClient.remark": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *OauthClientUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *OauthClientUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *OauthClientUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(oauthclient.Table, oauthclient.Columns, sqlgraph.NewFieldSpec(oauthclient.FieldID, field.TypeUint64))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(oauthclient.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(oauthclient.FieldStatus, field.TypeUint8, value)
+	}
+	if value, ok := _u.mutation.AddedStatus(); ok {
+		_spec.AddField(oauthclient.FieldStatus, field.TypeUint8, value)
+	}
+	if _u.mutation.StatusCleared() {
+		_spec.ClearField(oauthclient.FieldStatus, field.TypeUint8)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(oauthclient.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ClientSecretHash(); ok {
+		_spec.SetField(oauthclient.FieldClientSecretHash, field.TypeString, value)
+	}
+	if _u.mutation.ClientSecretHashCleared() {
+		_spec.ClearField(oauthclient.FieldClientSecretHash, field.TypeString)
+	}
+	if value, ok := _u.mutation.ClientType(); ok {
+		_spec.SetField(oauthclient.FieldClientType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.RedirectUris(); ok {
+		_spec.SetField(oauthclient.FieldRedirectUris, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedRedirectUris(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, oauthclient.FieldRedirectUris, value)
+		})
+	}
+	if _u.mutation.RedirectUrisCleared() {
+		_spec.ClearField(oauthclient.FieldRedirectUris, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.GrantTypes(); ok {
+		_spec.SetField(oauthclient.FieldGrantTypes, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedGrantTypes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, oauthclient.FieldGrantTypes, value)
+		})
+	}
+	if _u.mutation.GrantTypesCleared() {
+		_spec.ClearField(oauthclient.FieldGrantTypes, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Scopes(); ok {
+		_spec.SetField(oauthclient.FieldScopes, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedScopes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, oauthclient.FieldScopes, value)
+		})
+	}
+	if _u.mutation.ScopesCleared() {
+		_spec.ClearField(oauthclient.FieldScopes, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Trusted(); ok {
+		_spec.SetField(oauthclient.FieldTrusted, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.AccessTokenTTL(); ok {
+		_spec.SetField(oauthclient.FieldAccessTokenTTL, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedAccessTokenTTL(); ok {
+		_spec.AddField(oauthclient.FieldAccessTokenTTL, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.RefreshTokenTTL(); ok {
+		_spec.SetField(oauthclient.FieldRefreshTokenTTL, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedRefreshTokenTTL(); ok {
+		_spec.AddField(oauthclient.FieldRefreshTokenTTL, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.Remark(); ok {
+		_spec.SetField(oauthclient.FieldRemark, field.TypeString, value)
+	}
+	if _u.mutation.RemarkCleared() {
+		_spec.ClearField(oauthclient.FieldRemark, field.TypeString)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{oauthclient.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// OauthClientUpdateOne is the builder for updating a single OauthClient entity.
+type OauthClientUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *OauthClientMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *OauthClientUpdateOne) SetUpdatedAt(v time.Time) *OauthClientUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *OauthClientUpdateOne) SetStatus(v uint8) *OauthClientUpdateOne {
+	_u.mutation.ResetStatus()
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *OauthClientUpdateOne) SetNillableStatus(v *uint8) *OauthClientUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// AddStatus adds value to the "status" field.
+func (_u *OauthClientUpdateOne) AddStatus(v int8) *OauthClientUpdateOne {
+	_u.mutation.AddStatus(v)
+	return _u
+}
+
+// ClearStatus clears the value of the "status" field.
+func (_u *OauthClientUpdateOne) ClearStatus() *OauthClientUpdateOne {
+	_u.mutation.ClearStatus()
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *OauthClientUpdateOne) SetName(v string) *OauthClientUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *OauthClientUpdateOne) SetNillableName(v *string) *OauthClientUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetClientSecretHash sets the "client_secret_hash" field.
+func (_u *OauthClientUpdateOne) SetClientSecretHash(v string) *OauthClientUpdateOne {
+	_u.mutation.SetClientSecretHash(v)
+	return _u
+}
+
+// SetNillableClientSecretHash sets the "client_secret_hash" field if the given value is not nil.
+func (_u *OauthClientUpdateOne) SetNillableClientSecretHash(v *string) *OauthClientUpdateOne {
+	if v != nil {
+		_u.SetClientSecretHash(*v)
+	}
+	return _u
+}
+
+// ClearClientSecretHash clears the value of the "client_secret_hash" field.
+func (_u *OauthClientUpdateOne) ClearClientSecretHash() *OauthClientUpdateOne {
+	_u.mutation.ClearClientSecretHash()
+	return _u
+}
+
+// SetClientType sets the "client_type" field.
+func (_u *OauthClientUpdateOne) SetClientType(v string) *OauthClientUpdateOne {
+	_u.mutation.SetClientType(v)
+	return _u
+}
+
+// SetNillableClientType sets the "client_type" field if the given value is not nil.
+func (_u *OauthClientUpdateOne) SetNillableClientType(v *string) *OauthClientUpdateOne {
+	if v != nil {
+		_u.SetClientType(*v)
+	}
+	return _u
+}
+
+// SetRedirectUris sets the "redirect_uris" field.
+func (_u *OauthClientUpdateOne) SetRedirectUris(v []string) *OauthClientUpdateOne {
+	_u.mutation.SetRedirectUris(v)
+	return _u
+}
+
+// AppendRedirectUris appends value to the "redirect_uris" field.
+func (_u *OauthClientUpdateOne) AppendRedirectUris(v []string) *OauthClientUpdateOne {
+	_u.mutation.AppendRedirectUris(v)
+	return _u
+}
+
+// ClearRedirectUris clears the value of the "redirect_uris" field.
+func (_u *OauthClientUpdateOne) ClearRedirectUris() *OauthClientUpdateOne {
+	_u.mutation.ClearRedirectUris()
+	return _u
+}
+
+// SetGrantTypes sets the "grant_types" field.
+func (_u *OauthClientUpdateOne) SetGrantTypes(v []string) *OauthClientUpdateOne {
+	_u.mutation.SetGrantTypes(v)
+	return _u
+}
+
+// AppendGrantTypes appends value to the "grant_types" field.
+func (_u *OauthClientUpdateOne) AppendGrantTypes(v []string) *OauthClientUpdateOne {
+	_u.mutation.AppendGrantTypes(v)
+	return _u
+}
+
+// ClearGrantTypes clears the value of the "grant_types" field.
+func (_u *OauthClientUpdateOne) ClearGrantTypes() *OauthClientUpdateOne {
+	_u.mutation.ClearGrantTypes()
+	return _u
+}
+
+// SetScopes sets the "scopes" field.
+func (_u *OauthClientUpdateOne) SetScopes(v []string) *OauthClientUpdateOne {
+	_u.mutation.SetScopes(v)
+	return _u
+}
+
+// AppendScopes appends value to the "scopes" field.
+func (_u *OauthClientUpdateOne) AppendScopes(v []string) *OauthClientUpdateOne {
+	_u.mutation.AppendScopes(v)
+	return _u
+}
+
+// ClearScopes clears the value of the "scopes" field.
+func (_u *OauthClientUpdateOne) ClearScopes() *OauthClientUpdateOne {
+	_u.mutation.ClearScopes()
+	return _u
+}
+
+// SetTrusted sets the "trusted" field.
+func (_u *OauthClientUpdateOne) SetTrusted(v bool) *OauthClientUpdateOne {
+	_u.mutation.SetTrusted(v)
+	return _u
+}
+
+// SetNillableTrusted sets the "trusted" field if the given value is not nil.
+func (_u *OauthClientUpdateOne) SetNillableTrusted(v *bool) *OauthClientUpdateOne {
+	if v != nil {
+		_u.SetTrusted(*v)
+	}
+	return _u
+}
+
+// SetAccessTokenTTL sets the "access_token_ttl" field.
+func (_u *OauthClientUpdateOne) SetAccessTokenTTL(v uint32) *OauthClientUpdateOne {
+	_u.mutation.ResetAccessTokenTTL()
+	_u.mutation.SetAccessTokenTTL(v)
+	return _u
+}
+
+// SetNillableAccessTokenTTL sets the "access_token_ttl" field if the given value is not nil.
+func (_u *OauthClientUpdateOne) SetNillableAccessTokenTTL(v *uint32) *OauthClientUpdateOne {
+	if v != nil {
+		_u.SetAccessTokenTTL(*v)
+	}
+	return _u
+}
+
+// AddAccessTokenTTL adds value to the "access_token_ttl" field.
+func (_u *OauthClientUpdateOne) AddAccessTokenTTL(v int32) *OauthClientUpdateOne {
+	_u.mutation.AddAccessTokenTTL(v)
+	return _u
+}
+
+// SetRefreshTokenTTL sets the "refresh_token_ttl" field.
+func (_u *OauthClientUpdateOne) SetRefreshTokenTTL(v uint32) *OauthClientUpdateOne {
+	_u.mutation.ResetRefreshTokenTTL()
+	_u.mutation.SetRefreshTokenTTL(v)
+	return _u
+}
+
+// SetNillableRefreshTokenTTL sets the "refresh_token_ttl" field if the given value is not nil.
+func (_u *OauthClientUpdateOne) SetNillableRefreshTokenTTL(v *uint32) *OauthClientUpdateOne {
+	if v != nil {
+		_u.SetRefreshTokenTTL(*v)
+	}
+	return _u
+}
+
+// AddRefreshTokenTTL adds value to the "refresh_token_ttl" field.
+func (_u *OauthClientUpdateOne) AddRefreshTokenTTL(v int32) *OauthClientUpdateOne {
+	_u.mutation.AddRefreshTokenTTL(v)
+	return _u
+}
+
+// SetRemark sets the "remark" field.
+func (_u *OauthClientUpdateOne) SetRemark(v string) *OauthClientUpdateOne {
+	_u.mutation.SetRemark(v)
+	return _u
+}
+
+// SetNillableRemark sets the "remark" field if the given value is not nil.
+func (_u *OauthClientUpdateOne) SetNillableRemark(v *string) *OauthClientUpdateOne {
+	if v != nil {
+		_u.SetRemark(*v)
+	}
+	return _u
+}
+
+// ClearRemark clears the value of the "remark" field.
+func (_u *OauthClientUpdateOne) ClearRemark() *OauthClientUpdateOne {
+	_u.mutation.ClearRemark()
+	return _u
+}
+
+// Mutation returns the OauthClientMutation object of the builder.
+func (_u *OauthClientUpdateOne) Mutation() *OauthClientMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the OauthClientUpdate builder.
+func (_u *OauthClientUpdateOne) Where(ps ...predicate.OauthClient) *OauthClientUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *OauthClientUpdateOne) Select(field string, fields ...string) *OauthClientUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated OauthClient entity.
+func (_u *OauthClientUpdateOne) Save(ctx context.Context) (*OauthClient, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *OauthClientUpdateOne) SaveX(ctx context.Context) *OauthClient {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *OauthClientUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *OauthClientUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *OauthClientUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := oauthclient.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *OauthClientUpdateOne) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := oauthclient.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "OauthClient.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ClientSecretHash(); ok {
+		if err := oauthclient.ClientSecretHashValidator(v); err != nil {
+			return &ValidationError{Name: "client_secret_hash", err: fmt.Errorf(`ent: validator failed for field "OauthClient.client_secret_hash": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ClientType(); ok {
+		if err := oauthclient.ClientTypeValidator(v); err != nil {
+			return &ValidationError{Name: "client_type", err: fmt.Errorf(`ent: validator failed for field "OauthClient.client_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Remark(); ok {
+		if err := oauthclient.RemarkValidator(v); err != nil {
+			return &ValidationError{Name: "remark", err: fmt.Errorf(`ent: validator failed for field "OauthClient.remark": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *OauthClientUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *OauthClientUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *OauthClientUpdateOne) sqlSave(ctx context.Context) (_node *OauthClient, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(oauthclient.Table, oauthclient.Columns, sqlgraph.NewFieldSpec(oauthclient.FieldID, field.TypeUint64))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "OauthClient.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, oauthclient.FieldID)
+		for _, f := range fields {
+			if !oauthclient.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != oauthclient.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(oauthclient.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(oauthclient.FieldStatus, field.TypeUint8, value)
+	}
+	if value, ok := _u.mutation.AddedStatus(); ok {
+		_spec.AddField(oauthclient.FieldStatus, field.TypeUint8, value)
+	}
+	if _u.mutation.StatusCleared() {
+		_spec.ClearField(oauthclient.FieldStatus, field.TypeUint8)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(oauthclient.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ClientSecretHash(); ok {
+		_spec.SetField(oauthclient.FieldClientSecretHash, field.TypeString, value)
+	}
+	if _u.mutation.ClientSecretHashCleared() {
+		_spec.ClearField(oauthclient.FieldClientSecretHash, field.TypeString)
+	}
+	if value, ok := _u.mutation.ClientType(); ok {
+		_spec.SetField(oauthclient.FieldClientType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.RedirectUris(); ok {
+		_spec.SetField(oauthclient.FieldRedirectUris, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedRedirectUris(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, oauthclient.FieldRedirectUris, value)
+		})
+	}
+	if _u.mutation.RedirectUrisCleared() {
+		_spec.ClearField(oauthclient.FieldRedirectUris, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.GrantTypes(); ok {
+		_spec.SetField(oauthclient.FieldGrantTypes, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedGrantTypes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, oauthclient.FieldGrantTypes, value)
+		})
+	}
+	if _u.mutation.GrantTypesCleared() {
+		_spec.ClearField(oauthclient.FieldGrantTypes, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Scopes(); ok {
+		_spec.SetField(oauthclient.FieldScopes, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedScopes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, oauthclient.FieldScopes, value)
+		})
+	}
+	if _u.mutation.ScopesCleared() {
+		_spec.ClearField(oauthclient.FieldScopes, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Trusted(); ok {
+		_spec.SetField(oauthclient.FieldTrusted, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.AccessTokenTTL(); ok {
+		_spec.SetField(oauthclient.FieldAccessTokenTTL, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedAccessTokenTTL(); ok {
+		_spec.AddField(oauthclient.FieldAccessTokenTTL, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.RefreshTokenTTL(); ok {
+		_spec.SetField(oauthclient.FieldRefreshTokenTTL, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedRefreshTokenTTL(); ok {
+		_spec.AddField(oauthclient.FieldRefreshTokenTTL, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.Remark(); ok {
+		_spec.SetField(oauthclient.FieldRemark, field.TypeString, value)
+	}
+	if _u.mutation.RemarkCleared() {
+		_spec.ClearField(oauthclient.FieldRemark, field.TypeString)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &OauthClient{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{oauthclient.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,306 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/coder-lulu/newbee-core/rpc/ent/oauthconsent"
+	uuid "github.com/gofrs/uuid/v5"
+)
+
+// OauthConsentCreate is the builder for creating a OauthConsent entity.
+type OauthConsentCreate struct {
+	config
+	mutation *OauthConsentMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *OauthConsentCreate) SetCreatedAt(v time.Time) *OauthConsentCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *OauthConsentCreate) SetNillableCreatedAt(v *time.Time) *OauthConsentCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *OauthConsentCreate) SetUpdatedAt(v time.Time) *OauthConsentCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *OauthConsentCreate) SetNillableUpdatedAt(v *time.Time) *OauthConsentCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *OauthConsentCreate) SetTenantID(v uint64) *OauthConsentCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_c *OauthConsentCreate) SetNillableTenantID(v *uint64) *OauthConsentCreate {
+	if v != nil {
+		_c.SetTenantID(*v)
+	}
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *OauthConsentCreate) SetUserID(v uuid.UUID) *OauthConsentCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetClientID sets the "client_id" field.
+func (_c *OauthConsentCreate) SetClientID(v string) *OauthConsentCreate {
+	_c.mutation.SetClientID(v)
+	return _c
+}
+
+// SetScopes sets the "scopes" field.
+func (_c *OauthConsentCreate) SetScopes(v []string) *OauthConsentCreate {
+	_c.mutation.SetScopes(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *OauthConsentCreate) SetID(v uint64) *OauthConsentCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the OauthConsentMutation object of the builder.
+func (_c *OauthConsentCreate) Mutation() *OauthConsentMutation {
+	return _c.mutation
+}
+
+// Save creates the OauthConsent in the database.
+func (_c *OauthConsentCreate) Save(ctx context.Context) (*OauthConsent, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *OauthConsentCreate) SaveX(ctx context.Context) *OauthConsent {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *OauthConsentCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *OauthConsentCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *OauthConsentCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := oauthconsent.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := oauthconsent.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.TenantID(); !ok {
+		v := oauthconsent.DefaultTenantID
+		_c.mutation.SetTenantID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *OauthConsentCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "OauthConsent.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "OauthConsent.updated_at"`)}
+	}
+	if _, ok := _c.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "OauthConsent.tenant_id"`)}
+	}
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "OauthConsent.user_id"`)}
+	}
+	if _, ok := _c.mutation.ClientID(); !ok {
+		return &ValidationError{Name: "client_id", err: errors.New(`ent: missing required field "OauthConsent.client_id"`)}
+	}
+	if v, ok := _c.mutation.ClientID(); ok {
+		if err := oauthconsent.ClientIDValidator(v); err != nil {
+			return &ValidationError{Name: "client_id", err: fmt.Errorf(`ent: validator failed for field "OauthConsent.client_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *OauthConsentCreate) sqlSave(ctx context.Context) (*OauthConsent, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint64(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *OauthConsentCreate) createSpec() (*OauthConsent, *sqlgraph.CreateSpec) {
+	var (
+		_node = &OauthConsent{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(oauthconsent.Table, sqlgraph.NewFieldSpec(oauthconsent.FieldID, field.TypeUint64))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(oauthconsent.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(oauthconsent.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(oauthconsent.FieldTenantID, field.TypeUint64, value)
+		_node.TenantID = value
+	}
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(oauthconsent.FieldUserID, field.TypeUUID, value)
+		_node.UserID = value
+	}
+	if value, ok := _c.mutation.ClientID(); ok {
+		_spec.SetField(oauthconsent.FieldClientID, field.TypeString, value)
+		_node.ClientID = value
+	}
+	if value, ok := _c.mutation.Scopes(); ok {
+		_spec.SetField(oauthconsent.FieldScopes, field.TypeJSON, value)
+		_node.Scopes = value
+	}
+	return _node, _spec
+}
+
+// OauthConsentCreateBulk is the builder for creating many OauthConsent entities in bulk.
+type OauthConsentCreateBulk struct {
+	config
+	err      error
+	builders []*OauthConsentCreate
+}
+
+// Save creates the OauthConsent entities in the database.
+func (_c *OauthConsentCreateBulk) Save(ctx context.Context) ([]*OauthConsent, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*OauthConsent, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*OauthConsentMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *OauthConsentCreateBulk) SaveX(ctx context.Context) []*OauthConsent {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *OauthConsentCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *OauthConsentCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,382 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/coder-lulu/newbee-core/rpc/ent/oauthconsent"
+	"github.com/coder-lulu/newbee-core/rpc/ent/predicate"
+	uuid "github.com/gofrs/uuid/v5"
+)
+
+// OauthConsentUpdate is the builder for updating OauthConsent entities.
+type OauthConsentUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *OauthConsentMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the OauthConsentUpdate builder.
+func (_u *OauthConsentUpdate) Where(ps ...predicate.OauthConsent) *OauthConsentUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *OauthConsentUpdate) SetUpdatedAt(v time.Time) *OauthConsentUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *OauthConsentUpdate) SetUserID(v uuid.UUID) *OauthConsentUpdate {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *OauthConsentUpdate) SetNillableUserID(v *uuid.UUID) *OauthConsentUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetClientID sets the "client_id" field.
+func (_u *OauthConsentUpdate) SetClientID(v string) *OauthConsentUpdate {
+	_u.mutation.SetClientID(v)
+	return _u
+}
+
+// SetNillableClientID sets the "client_id" field if the given value is not nil.
+func (_u *OauthConsentUpdate) SetNillableClientID(v *string) *OauthConsentUpdate {
+	if v != nil {
+		_u.SetClientID(*v)
+	}
+	return _u
+}
+
+// SetScopes sets the "scopes" field.
+func (_u *OauthConsentUpdate) SetScopes(v []string) *OauthConsentUpdate {
+	_u.mutation.SetScopes(v)
+	return _u
+}
+
+// AppendScopes appends value to the "scopes" field.
+func (_u *OauthConsentUpdate) AppendScopes(v []string) *OauthConsentUpdate {
+	_u.mutation.AppendScopes(v)
+	return _u
+}
+
+// ClearScopes clears the value of the "scopes" field.
+func (_u *OauthConsentUpdate) ClearScopes() *OauthConsentUpdate {
+	_u.mutation.ClearScopes()
+	return _u
+}
+
+// Mutation returns the OauthConsentMutation object of the builder.
+func (_u *OauthConsentUpdate) Mutation() *OauthConsentMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *OauthConsentUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *OauthConsentUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *OauthConsentUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *OauthConsentUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *OauthConsentUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := oauthconsent.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *OauthConsentUpdate) check() error {
+	if v, ok := _u.mutation.ClientID(); ok {
+		if err := oauthconsent.ClientIDValidator(v); err != nil {
+			return &ValidationError{Name: "client_id", err: fmt.Errorf(`ent: validator failed for field "OauthConsent.client_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *OauthConsentUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *OauthConsentUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *OauthConsentUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(oauthconsent.Table, oauthconsent.Columns, sqlgraph.NewFieldSpec(oauthconsent.FieldID, field.TypeUint64))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(oauthconsent.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(oauthconsent.FieldUserID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.ClientID(); ok {
+		_spec.SetField(oauthconsent.FieldClientID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Scopes(); ok {
+		_spec.SetField(oauthconsent.FieldScopes, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedScopes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, oauthconsent.FieldScopes, value)
+		})
+	}
+	if _u.mutation.ScopesCleared() {
+		_spec.ClearField(oauthconsent.FieldScopes, field.TypeJSON)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{oauthconsent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// OauthConsentUpdateOne is the builder for updating a single OauthConsent entity.
+type OauthConsentUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *OauthConsentMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *OauthConsentUpdateOne) SetUpdatedAt(v time.Time) *OauthConsentUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *OauthConsentUpdateOne) SetUserID(v uuid.UUID) *OauthConsentUpdateOne {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *OauthConsentUpdateOne) SetNillableUserID(v *uuid.UUID) *OauthConsentUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetClientID sets the "client_id" field.
+func (_u *OauthConsentUpdateOne) SetClientID(v string) *OauthConsentUpdateOne {
+	_u.mutation.SetClientID(v)
+	return _u
+}
+
+// SetNillableClientID sets the "client_id" field if the given value is not nil.
+func (_u *OauthConsentUpdateOne) SetNillableClientID(v *string) *OauthConsentUpdateOne {
+	if v != nil {
+		_u.SetClientID(*v)
+	}
+	return _u
+}
+
+// SetScopes sets the "scopes" field.
+func (_u *OauthConsentUpdateOne) SetScopes(v []string) *OauthConsentUpdateOne {
+	_u.mutation.SetScopes(v)
+	return _u
+}
+
+// AppendScopes appends value to the "scopes" field.
+func (_u *OauthConsentUpdateOne) AppendScopes(v []string) *OauthConsentUpdateOne {
+	_u.mutation.AppendScopes(v)
+	return _u
+}
+
+// ClearScopes clears the value of the "scopes" field.
+func (_u *OauthConsentUpdateOne) ClearScopes() *OauthConsentUpdateOne {
+	_u.mutation.ClearScopes()
+	return _u
+}
+
+// Mutation returns the OauthConsentMutation object of the builder.
+func (_u *OauthConsentUpdateOne) Mutation() *OauthConsentMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the OauthConsentUpdate builder.
+func (_u *OauthConsentUpdateOne) Where(ps ...predicate.OauthConsent) *OauthConsentUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *OauthConsentUpdateOne) Select(field string, fields ...string) *OauthConsentUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated OauthConsent entity.
+func (_u *OauthConsentUpdateOne) Save(ctx context.Context) (*OauthConsent, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *OauthConsentUpdateOne) SaveX(ctx context.Context) *OauthConsent {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *OauthConsentUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *OauthConsentUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *OauthConsentUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := oauthconsent.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *OauthConsentUpdateOne) check() error {
+	if v, ok := _u.mutation.ClientID(); ok {
+		if err := oauthconsent.ClientIDValidator(v); err != nil {
+			return &ValidationError{Name: "client_id", err: fmt.Errorf(`ent: validator failed for field "OauthConsent.client_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *OauthConsentUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *OauthConsentUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *OauthConsentUpdateOne) sqlSave(ctx context.Context) (_node *OauthConsent, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(oauthconsent.Table, oauthconsent.Columns, sqlgraph.NewFieldSpec(oauthconsent.FieldID, field.TypeUint64))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "OauthConsent.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, oauthconsent.FieldID)
+		for _, f := range fields {
+			if !oauthconsent.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != oauthconsent.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(oauthconsent.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(oauthconsent.FieldUserID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.ClientID(); ok {
+		_spec.SetField(oauthconsent.FieldClientID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Scopes(); ok {
+		_spec.SetField(oauthconsent.FieldScopes, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedScopes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, oauthconsent.FieldScopes, value)
+		})
+	}
+	if _u.mutation.ScopesCleared() {
+		_spec.ClearField(oauthconsent.FieldScopes, field.TypeJSON)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &OauthConsent{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{oauthconsent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
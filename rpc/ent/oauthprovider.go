@@ -11,6 +11,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"github.com/coder-lulu/newbee-core/rpc/ent/oauthprovider"
+	"github.com/coder-lulu/newbee-core/rpc/ent/oauthprovidertemplate"
 )
 
 // OAuth Provider Configuration Table | OAuth第三方登录提供商配置表
@@ -76,6 +77,8 @@ type OauthProvider struct {
 	FailureCount int `json:"failure_count,omitempty"`
 	// Last used timestamp | 最后使用时间
 	LastUsedAt time.Time `json:"last_used_at,omitempty"`
+	// The system template the provider was enabled from | 来源的系统模板ID
+	TemplateID uint64 `json:"template_id,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the OauthProviderQuery when eager-loading is set.
 	Edges        OauthProviderEdges `json:"edges"`
@@ -88,9 +91,11 @@ type OauthProviderEdges struct {
 	OauthAccounts []*OauthAccount `json:"oauth_accounts,omitempty"`
 	// OauthSessions holds the value of the oauth_sessions edge.
 	OauthSessions []*OauthSession `json:"oauth_sessions,omitempty"`
+	// Template holds the value of the template edge.
+	Template *OauthProviderTemplate `json:"template,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [3]bool
 }
 
 // OauthAccountsOrErr returns the OauthAccounts value or an error if the edge
@@ -111,6 +116,17 @@ func (e OauthProviderEdges) OauthSessionsOrErr() ([]*OauthSession, error) {
 	return nil, &NotLoadedError{edge: "oauth_sessions"}
 }
 
+// TemplateOrErr returns the Template value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e OauthProviderEdges) TemplateOrErr() (*OauthProviderTemplate, error) {
+	if e.Template != nil {
+		return e.Template, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: oauthprovidertemplate.Label}
+	}
+	return nil, &NotLoadedError{edge: "template"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*OauthProvider) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -120,7 +136,7 @@ func (*OauthProvider) scanValues(columns []string) ([]any, error) {
 			values[i] = new([]byte)
 		case oauthprovider.FieldEnabled, oauthprovider.FieldSupportPkce:
 			values[i] = new(sql.NullBool)
-		case oauthprovider.FieldID, oauthprovider.FieldStatus, oauthprovider.FieldTenantID, oauthprovider.FieldAuthStyle, oauthprovider.FieldSort, oauthprovider.FieldCacheTTL, oauthprovider.FieldSuccessCount, oauthprovider.FieldFailureCount:
+		case oauthprovider.FieldID, oauthprovider.FieldStatus, oauthprovider.FieldTenantID, oauthprovider.FieldAuthStyle, oauthprovider.FieldSort, oauthprovider.FieldCacheTTL, oauthprovider.FieldSuccessCount, oauthprovider.FieldFailureCount, oauthprovider.FieldTemplateID:
 			values[i] = new(sql.NullInt64)
 		case oauthprovider.FieldName, oauthprovider.FieldDisplayName, oauthprovider.FieldType, oauthprovider.FieldProviderType, oauthprovider.FieldClientID, oauthprovider.FieldClientSecret, oauthprovider.FieldEncryptedSecret, oauthprovider.FieldEncryptionKeyID, oauthprovider.FieldRedirectURL, oauthprovider.FieldScopes, oauthprovider.FieldAuthURL, oauthprovider.FieldTokenURL, oauthprovider.FieldInfoURL, oauthprovider.FieldRemark, oauthprovider.FieldIconURL, oauthprovider.FieldWebhookURL:
 			values[i] = new(sql.NullString)
@@ -323,6 +339,12 @@ func (_m *OauthProvider) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.LastUsedAt = value.Time
 			}
+		case oauthprovider.FieldTemplateID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field template_id", values[i])
+			} else if value.Valid {
+				_m.TemplateID = uint64(value.Int64)
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -346,6 +368,11 @@ func (_m *OauthProvider) QueryOauthSessions() *OauthSessionQuery {
 	return NewOauthProviderClient(_m.config).QueryOauthSessions(_m)
 }
 
+// QueryTemplate queries the "template" edge of the OauthProvider entity.
+func (_m *OauthProvider) QueryTemplate() *OauthProviderTemplateQuery {
+	return NewOauthProviderClient(_m.config).QueryTemplate(_m)
+}
+
 // Update returns a builder for updating this OauthProvider.
 // Note that you need to call OauthProvider.Unwrap() before calling this method if this OauthProvider
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -455,6 +482,9 @@ func (_m *OauthProvider) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("last_used_at=")
 	builder.WriteString(_m.LastUsedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("template_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TemplateID))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -72,10 +72,14 @@ const (
 	FieldFailureCount = "failure_count"
 	// FieldLastUsedAt holds the string denoting the last_used_at field in the database.
 	FieldLastUsedAt = "last_used_at"
+	// FieldTemplateID holds the string denoting the template_id field in the database.
+	FieldTemplateID = "template_id"
 	// EdgeOauthAccounts holds the string denoting the oauth_accounts edge name in mutations.
 	EdgeOauthAccounts = "oauth_accounts"
 	// EdgeOauthSessions holds the string denoting the oauth_sessions edge name in mutations.
 	EdgeOauthSessions = "oauth_sessions"
+	// EdgeTemplate holds the string denoting the template edge name in mutations.
+	EdgeTemplate = "template"
 	// Table holds the table name of the oauthprovider in the database.
 	Table = "sys_oauth_providers"
 	// OauthAccountsTable is the table that holds the oauth_accounts relation/edge.
@@ -92,6 +96,13 @@ const (
 	OauthSessionsInverseTable = "sys_oauth_sessions"
 	// OauthSessionsColumn is the table column denoting the oauth_sessions relation/edge.
 	OauthSessionsColumn = "provider_id"
+	// TemplateTable is the table that holds the template relation/edge.
+	TemplateTable = "sys_oauth_providers"
+	// TemplateInverseTable is the table name for the OauthProviderTemplate entity.
+	// It exists in this package in order to avoid circular dependency with the "oauthprovidertemplate" package.
+	TemplateInverseTable = "sys_oauth_provider_templates"
+	// TemplateColumn is the table column denoting the template relation/edge.
+	TemplateColumn = "template_id"
 )
 
 // Columns holds all SQL columns for oauthprovider fields.
@@ -126,6 +137,7 @@ var Columns = []string{
 	FieldSuccessCount,
 	FieldFailureCount,
 	FieldLastUsedAt,
+	FieldTemplateID,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -347,6 +359,11 @@ func ByLastUsedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldLastUsedAt, opts...).ToFunc()
 }
 
+// ByTemplateID orders the results by the template_id field.
+func ByTemplateID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTemplateID, opts...).ToFunc()
+}
+
 // ByOauthAccountsCount orders the results by oauth_accounts count.
 func ByOauthAccountsCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -374,6 +391,13 @@ func ByOauthSessions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newOauthSessionsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByTemplateField orders the results by template field.
+func ByTemplateField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTemplateStep(), sql.OrderByField(field, opts...))
+	}
+}
 func newOauthAccountsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -388,3 +412,10 @@ func newOauthSessionsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, OauthSessionsTable, OauthSessionsColumn),
 	)
 }
+func newTemplateStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TemplateInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, TemplateTable, TemplateColumn),
+	)
+}
@@ -195,6 +195,11 @@ func LastUsedAt(v time.Time) predicate.OauthProvider {
 	return predicate.OauthProvider(sql.FieldEQ(FieldLastUsedAt, v))
 }
 
+// TemplateID applies equality check predicate on the "template_id" field. It's identical to TemplateIDEQ.
+func TemplateID(v uint64) predicate.OauthProvider {
+	return predicate.OauthProvider(sql.FieldEQ(FieldTemplateID, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.OauthProvider {
 	return predicate.OauthProvider(sql.FieldEQ(FieldCreatedAt, v))
@@ -1755,6 +1760,36 @@ func LastUsedAtNotNil() predicate.OauthProvider {
 	return predicate.OauthProvider(sql.FieldNotNull(FieldLastUsedAt))
 }
 
+// TemplateIDEQ applies the EQ predicate on the "template_id" field.
+func TemplateIDEQ(v uint64) predicate.OauthProvider {
+	return predicate.OauthProvider(sql.FieldEQ(FieldTemplateID, v))
+}
+
+// TemplateIDNEQ applies the NEQ predicate on the "template_id" field.
+func TemplateIDNEQ(v uint64) predicate.OauthProvider {
+	return predicate.OauthProvider(sql.FieldNEQ(FieldTemplateID, v))
+}
+
+// TemplateIDIn applies the In predicate on the "template_id" field.
+func TemplateIDIn(vs ...uint64) predicate.OauthProvider {
+	return predicate.OauthProvider(sql.FieldIn(FieldTemplateID, vs...))
+}
+
+// TemplateIDNotIn applies the NotIn predicate on the "template_id" field.
+func TemplateIDNotIn(vs ...uint64) predicate.OauthProvider {
+	return predicate.OauthProvider(sql.FieldNotIn(FieldTemplateID, vs...))
+}
+
+// TemplateIDIsNil applies the IsNil predicate on the "template_id" field.
+func TemplateIDIsNil() predicate.OauthProvider {
+	return predicate.OauthProvider(sql.FieldIsNull(FieldTemplateID))
+}
+
+// TemplateIDNotNil applies the NotNil predicate on the "template_id" field.
+func TemplateIDNotNil() predicate.OauthProvider {
+	return predicate.OauthProvider(sql.FieldNotNull(FieldTemplateID))
+}
+
 // HasOauthAccounts applies the HasEdge predicate on the "oauth_accounts" edge.
 func HasOauthAccounts() predicate.OauthProvider {
 	return predicate.OauthProvider(func(s *sql.Selector) {
@@ -1801,6 +1836,29 @@ func HasOauthSessionsWith(preds ...predicate.OauthSession) predicate.OauthProvid
 	})
 }
 
+// HasTemplate applies the HasEdge predicate on the "template" edge.
+func HasTemplate() predicate.OauthProvider {
+	return predicate.OauthProvider(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, TemplateTable, TemplateColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTemplateWith applies the HasEdge predicate on the "template" edge with a given conditions (other predicates).
+func HasTemplateWith(preds ...predicate.OauthProviderTemplate) predicate.OauthProvider {
+	return predicate.OauthProvider(func(s *sql.Selector) {
+		step := newTemplateStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.OauthProvider) predicate.OauthProvider {
 	return predicate.OauthProvider(sql.AndPredicates(predicates...))
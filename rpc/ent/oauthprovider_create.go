@@ -12,6 +12,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/coder-lulu/newbee-core/rpc/ent/oauthaccount"
 	"github.com/coder-lulu/newbee-core/rpc/ent/oauthprovider"
+	"github.com/coder-lulu/newbee-core/rpc/ent/oauthprovidertemplate"
 	"github.com/coder-lulu/newbee-core/rpc/ent/oauthsession"
 )
 
@@ -356,6 +357,20 @@ func (_c *OauthProviderCreate) SetNillableLastUsedAt(v *time.Time) *OauthProvide
 	return _c
 }
 
+// SetTemplateID sets the "template_id" field.
+func (_c *OauthProviderCreate) SetTemplateID(v uint64) *OauthProviderCreate {
+	_c.mutation.SetTemplateID(v)
+	return _c
+}
+
+// SetNillableTemplateID sets the "template_id" field if the given value is not nil.
+func (_c *OauthProviderCreate) SetNillableTemplateID(v *uint64) *OauthProviderCreate {
+	if v != nil {
+		_c.SetTemplateID(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *OauthProviderCreate) SetID(v uint64) *OauthProviderCreate {
 	_c.mutation.SetID(v)
@@ -392,6 +407,11 @@ func (_c *OauthProviderCreate) AddOauthSessions(v ...*OauthSession) *OauthProvid
 	return _c.AddOauthSessionIDs(ids...)
 }
 
+// SetTemplate sets the "template" edge to the OauthProviderTemplate entity.
+func (_c *OauthProviderCreate) SetTemplate(v *OauthProviderTemplate) *OauthProviderCreate {
+	return _c.SetTemplateID(v.ID)
+}
+
 // Mutation returns the OauthProviderMutation object of the builder.
 func (_c *OauthProviderCreate) Mutation() *OauthProviderMutation {
 	return _c.mutation
@@ -796,6 +816,23 @@ func (_c *OauthProviderCreate) createSpec() (*OauthProvider, *sqlgraph.CreateSpe
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.TemplateIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   oauthprovider.TemplateTable,
+			Columns: []string{oauthprovider.TemplateColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(oauthprovidertemplate.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TemplateID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
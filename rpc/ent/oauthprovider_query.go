@@ -14,6 +14,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/coder-lulu/newbee-core/rpc/ent/oauthaccount"
 	"github.com/coder-lulu/newbee-core/rpc/ent/oauthprovider"
+	"github.com/coder-lulu/newbee-core/rpc/ent/oauthprovidertemplate"
 	"github.com/coder-lulu/newbee-core/rpc/ent/oauthsession"
 	"github.com/coder-lulu/newbee-core/rpc/ent/predicate"
 )
@@ -27,6 +28,7 @@ type OauthProviderQuery struct {
 	predicates        []predicate.OauthProvider
 	withOauthAccounts *OauthAccountQuery
 	withOauthSessions *OauthSessionQuery
+	withTemplate      *OauthProviderTemplateQuery
 	modifiers         []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
@@ -108,6 +110,28 @@ func (_q *OauthProviderQuery) QueryOauthSessions() *OauthSessionQuery {
 	return query
 }
 
+// QueryTemplate chains the current query on the "template" edge.
+func (_q *OauthProviderQuery) QueryTemplate() *OauthProviderTemplateQuery {
+	query := (&OauthProviderTemplateClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(oauthprovider.Table, oauthprovider.FieldID, selector),
+			sqlgraph.To(oauthprovidertemplate.Table, oauthprovidertemplate.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, oauthprovider.TemplateTable, oauthprovider.TemplateColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first OauthProvider entity from the query.
 // Returns a *NotFoundError when no OauthProvider was found.
 func (_q *OauthProviderQuery) First(ctx context.Context) (*OauthProvider, error) {
@@ -302,6 +326,7 @@ func (_q *OauthProviderQuery) Clone() *OauthProviderQuery {
 		predicates:        append([]predicate.OauthProvider{}, _q.predicates...),
 		withOauthAccounts: _q.withOauthAccounts.Clone(),
 		withOauthSessions: _q.withOauthSessions.Clone(),
+		withTemplate:      _q.withTemplate.Clone(),
 		// clone intermediate query.
 		sql:       _q.sql.Clone(),
 		path:      _q.path,
@@ -331,6 +356,17 @@ func (_q *OauthProviderQuery) WithOauthSessions(opts ...func(*OauthSessionQuery)
 	return _q
 }
 
+// WithTemplate tells the query-builder to eager-load the nodes that are connected to
+// the "template" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *OauthProviderQuery) WithTemplate(opts ...func(*OauthProviderTemplateQuery)) *OauthProviderQuery {
+	query := (&OauthProviderTemplateClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withTemplate = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -409,9 +445,10 @@ func (_q *OauthProviderQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([
 	var (
 		nodes       = []*OauthProvider{}
 		_spec       = _q.querySpec()
-		loadedTypes = [2]bool{
+		loadedTypes = [3]bool{
 			_q.withOauthAccounts != nil,
 			_q.withOauthSessions != nil,
+			_q.withTemplate != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -449,6 +486,12 @@ func (_q *OauthProviderQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([
 			return nil, err
 		}
 	}
+	if query := _q.withTemplate; query != nil {
+		if err := _q.loadTemplate(ctx, query, nodes, nil,
+			func(n *OauthProvider, e *OauthProviderTemplate) { n.Edges.Template = e }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -512,6 +555,35 @@ func (_q *OauthProviderQuery) loadOauthSessions(ctx context.Context, query *Oaut
 	}
 	return nil
 }
+func (_q *OauthProviderQuery) loadTemplate(ctx context.Context, query *OauthProviderTemplateQuery, nodes []*OauthProvider, init func(*OauthProvider), assign func(*OauthProvider, *OauthProviderTemplate)) error {
+	ids := make([]uint64, 0, len(nodes))
+	nodeids := make(map[uint64][]*OauthProvider)
+	for i := range nodes {
+		fk := nodes[i].TemplateID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(oauthprovidertemplate.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "template_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
 
 func (_q *OauthProviderQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
@@ -541,6 +613,9 @@ func (_q *OauthProviderQuery) querySpec() *sqlgraph.QuerySpec {
 				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
 			}
 		}
+		if _q.withTemplate != nil {
+			_spec.Node.AddColumnOnce(oauthprovider.FieldTemplateID)
+		}
 	}
 	if ps := _q.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
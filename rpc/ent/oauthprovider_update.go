@@ -13,6 +13,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/coder-lulu/newbee-core/rpc/ent/oauthaccount"
 	"github.com/coder-lulu/newbee-core/rpc/ent/oauthprovider"
+	"github.com/coder-lulu/newbee-core/rpc/ent/oauthprovidertemplate"
 	"github.com/coder-lulu/newbee-core/rpc/ent/oauthsession"
 	"github.com/coder-lulu/newbee-core/rpc/ent/predicate"
 )
@@ -495,6 +496,26 @@ func (_u *OauthProviderUpdate) ClearLastUsedAt() *OauthProviderUpdate {
 	return _u
 }
 
+// SetTemplateID sets the "template_id" field.
+func (_u *OauthProviderUpdate) SetTemplateID(v uint64) *OauthProviderUpdate {
+	_u.mutation.SetTemplateID(v)
+	return _u
+}
+
+// SetNillableTemplateID sets the "template_id" field if the given value is not nil.
+func (_u *OauthProviderUpdate) SetNillableTemplateID(v *uint64) *OauthProviderUpdate {
+	if v != nil {
+		_u.SetTemplateID(*v)
+	}
+	return _u
+}
+
+// ClearTemplateID clears the value of the "template_id" field.
+func (_u *OauthProviderUpdate) ClearTemplateID() *OauthProviderUpdate {
+	_u.mutation.ClearTemplateID()
+	return _u
+}
+
 // AddOauthAccountIDs adds the "oauth_accounts" edge to the OauthAccount entity by IDs.
 func (_u *OauthProviderUpdate) AddOauthAccountIDs(ids ...uint64) *OauthProviderUpdate {
 	_u.mutation.AddOauthAccountIDs(ids...)
@@ -525,6 +546,11 @@ func (_u *OauthProviderUpdate) AddOauthSessions(v ...*OauthSession) *OauthProvid
 	return _u.AddOauthSessionIDs(ids...)
 }
 
+// SetTemplate sets the "template" edge to the OauthProviderTemplate entity.
+func (_u *OauthProviderUpdate) SetTemplate(v *OauthProviderTemplate) *OauthProviderUpdate {
+	return _u.SetTemplateID(v.ID)
+}
+
 // Mutation returns the OauthProviderMutation object of the builder.
 func (_u *OauthProviderUpdate) Mutation() *OauthProviderMutation {
 	return _u.mutation
@@ -572,6 +598,12 @@ func (_u *OauthProviderUpdate) RemoveOauthSessions(v ...*OauthSession) *OauthPro
 	return _u.RemoveOauthSessionIDs(ids...)
 }
 
+// ClearTemplate clears the "template" edge to the OauthProviderTemplate entity.
+func (_u *OauthProviderUpdate) ClearTemplate() *OauthProviderUpdate {
+	_u.mutation.ClearTemplate()
+	return _u
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *OauthProviderUpdate) Save(ctx context.Context) (int, error) {
 	_u.defaults()
@@ -930,6 +962,35 @@ func (_u *OauthProviderUpdate) sqlSave(ctx context.Context) (_node int, err erro
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.TemplateCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   oauthprovider.TemplateTable,
+			Columns: []string{oauthprovider.TemplateColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(oauthprovidertemplate.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TemplateIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   oauthprovider.TemplateTable,
+			Columns: []string{oauthprovider.TemplateColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(oauthprovidertemplate.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
@@ -1416,6 +1477,26 @@ func (_u *OauthProviderUpdateOne) ClearLastUsedAt() *OauthProviderUpdateOne {
 	return _u
 }
 
+// SetTemplateID sets the "template_id" field.
+func (_u *OauthProviderUpdateOne) SetTemplateID(v uint64) *OauthProviderUpdateOne {
+	_u.mutation.SetTemplateID(v)
+	return _u
+}
+
+// SetNillableTemplateID sets the "template_id" field if the given value is not nil.
+func (_u *OauthProviderUpdateOne) SetNillableTemplateID(v *uint64) *OauthProviderUpdateOne {
+	if v != nil {
+		_u.SetTemplateID(*v)
+	}
+	return _u
+}
+
+// ClearTemplateID clears the value of the "template_id" field.
+func (_u *OauthProviderUpdateOne) ClearTemplateID() *OauthProviderUpdateOne {
+	_u.mutation.ClearTemplateID()
+	return _u
+}
+
 // AddOauthAccountIDs adds the "oauth_accounts" edge to the OauthAccount entity by IDs.
 func (_u *OauthProviderUpdateOne) AddOauthAccountIDs(ids ...uint64) *OauthProviderUpdateOne {
 	_u.mutation.AddOauthAccountIDs(ids...)
@@ -1446,6 +1527,11 @@ func (_u *OauthProviderUpdateOne) AddOauthSessions(v ...*OauthSession) *OauthPro
 	return _u.AddOauthSessionIDs(ids...)
 }
 
+// SetTemplate sets the "template" edge to the OauthProviderTemplate entity.
+func (_u *OauthProviderUpdateOne) SetTemplate(v *OauthProviderTemplate) *OauthProviderUpdateOne {
+	return _u.SetTemplateID(v.ID)
+}
+
 // Mutation returns the OauthProviderMutation object of the builder.
 func (_u *OauthProviderUpdateOne) Mutation() *OauthProviderMutation {
 	return _u.mutation
@@ -1493,6 +1579,12 @@ func (_u *OauthProviderUpdateOne) RemoveOauthSessions(v ...*OauthSession) *Oauth
 	return _u.RemoveOauthSessionIDs(ids...)
 }
 
+// ClearTemplate clears the "template" edge to the OauthProviderTemplate entity.
+func (_u *OauthProviderUpdateOne) ClearTemplate() *OauthProviderUpdateOne {
+	_u.mutation.ClearTemplate()
+	return _u
+}
+
 // Where appends a list predicates to the OauthProviderUpdate builder.
 func (_u *OauthProviderUpdateOne) Where(ps ...predicate.OauthProvider) *OauthProviderUpdateOne {
 	_u.mutation.Where(ps...)
@@ -1881,6 +1973,35 @@ func (_u *OauthProviderUpdateOne) sqlSave(ctx context.Context) (_node *OauthProv
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.TemplateCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   oauthprovider.TemplateTable,
+			Columns: []string{oauthprovider.TemplateColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(oauthprovidertemplate.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TemplateIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   oauthprovider.TemplateTable,
+			Columns: []string{oauthprovider.TemplateColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(oauthprovidertemplate.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	_node = &OauthProvider{config: _u.config}
 	_spec.Assign = _node.assignValues
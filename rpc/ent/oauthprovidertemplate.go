@@ -0,0 +1,315 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/coder-lulu/newbee-core/rpc/ent/oauthprovidertemplate"
+)
+
+// OAuth Provider Template Table | OAuth提供商模板表
+type OauthProviderTemplate struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uint64 `json:"id,omitempty"`
+	// Create Time | 创建日期
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Update Time | 修改日期
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Status 1: normal 2: ban | 状态 1 正常 2 禁用
+	Status uint8 `json:"status,omitempty"`
+	// The template's name, used as the default provider name | 模板名称，默认作为提供商名称
+	Name string `json:"name,omitempty"`
+	// Display name for UI | UI显示名称
+	DisplayName string `json:"display_name,omitempty"`
+	// The provider type (wechat, qq, github, google, facebook) | 提供商类型
+	Type string `json:"type,omitempty"`
+	// Provider type: oauth2, oidc, saml | 认证协议类型
+	ProviderType string `json:"provider_type,omitempty"`
+	// The default scopes | 默认权限范围
+	Scopes string `json:"scopes,omitempty"`
+	// OAuth authorization URL | OAuth授权URL
+	AuthURL string `json:"auth_url,omitempty"`
+	// OAuth token exchange URL | OAuth令牌交换URL
+	TokenURL string `json:"token_url,omitempty"`
+	// User info URL | 用户信息获取URL
+	InfoURL string `json:"info_url,omitempty"`
+	// OAuth auth style (1=params, 2=header) | OAuth认证方式
+	AuthStyle int `json:"auth_style,omitempty"`
+	// Provider specific configuration | 提供商特定配置
+	ExtraConfig map[string]interface{} `json:"extra_config,omitempty"`
+	// Whether support PKCE | 是否支持PKCE
+	SupportPkce bool `json:"support_pkce,omitempty"`
+	// Provider icon URL | 提供商图标URL
+	IconURL string `json:"icon_url,omitempty"`
+	// Sort order | 排序
+	Sort uint32 `json:"sort,omitempty"`
+	// Remark | 备注
+	Remark string `json:"remark,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the OauthProviderTemplateQuery when eager-loading is set.
+	Edges        OauthProviderTemplateEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// OauthProviderTemplateEdges holds the relations/edges for other nodes in the graph.
+type OauthProviderTemplateEdges struct {
+	// OauthProviders holds the value of the oauth_providers edge.
+	OauthProviders []*OauthProvider `json:"oauth_providers,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// OauthProvidersOrErr returns the OauthProviders value or an error if the edge
+// was not loaded in eager-loading.
+func (e OauthProviderTemplateEdges) OauthProvidersOrErr() ([]*OauthProvider, error) {
+	if e.loadedTypes[0] {
+		return e.OauthProviders, nil
+	}
+	return nil, &NotLoadedError{edge: "oauth_providers"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*OauthProviderTemplate) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case oauthprovidertemplate.FieldExtraConfig:
+			values[i] = new([]byte)
+		case oauthprovidertemplate.FieldSupportPkce:
+			values[i] = new(sql.NullBool)
+		case oauthprovidertemplate.FieldID, oauthprovidertemplate.FieldStatus, oauthprovidertemplate.FieldAuthStyle, oauthprovidertemplate.FieldSort:
+			values[i] = new(sql.NullInt64)
+		case oauthprovidertemplate.FieldName, oauthprovidertemplate.FieldDisplayName, oauthprovidertemplate.FieldType, oauthprovidertemplate.FieldProviderType, oauthprovidertemplate.FieldScopes, oauthprovidertemplate.FieldAuthURL, oauthprovidertemplate.FieldTokenURL, oauthprovidertemplate.FieldInfoURL, oauthprovidertemplate.FieldIconURL, oauthprovidertemplate.FieldRemark:
+			values[i] = new(sql.NullString)
+		case oauthprovidertemplate.FieldCreatedAt, oauthprovidertemplate.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the OauthProviderTemplate fields.
+func (_m *OauthProviderTemplate) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case oauthprovidertemplate.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = uint64(value.Int64)
+		case oauthprovidertemplate.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case oauthprovidertemplate.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case oauthprovidertemplate.FieldStatus:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = uint8(value.Int64)
+			}
+		case oauthprovidertemplate.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case oauthprovidertemplate.FieldDisplayName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field display_name", values[i])
+			} else if value.Valid {
+				_m.DisplayName = value.String
+			}
+		case oauthprovidertemplate.FieldType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field type", values[i])
+			} else if value.Valid {
+				_m.Type = value.String
+			}
+		case oauthprovidertemplate.FieldProviderType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field provider_type", values[i])
+			} else if value.Valid {
+				_m.ProviderType = value.String
+			}
+		case oauthprovidertemplate.FieldScopes:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field scopes", values[i])
+			} else if value.Valid {
+				_m.Scopes = value.String
+			}
+		case oauthprovidertemplate.FieldAuthURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field auth_url", values[i])
+			} else if value.Valid {
+				_m.AuthURL = value.String
+			}
+		case oauthprovidertemplate.FieldTokenURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field token_url", values[i])
+			} else if value.Valid {
+				_m.TokenURL = value.String
+			}
+		case oauthprovidertemplate.FieldInfoURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field info_url", values[i])
+			} else if value.Valid {
+				_m.InfoURL = value.String
+			}
+		case oauthprovidertemplate.FieldAuthStyle:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field auth_style", values[i])
+			} else if value.Valid {
+				_m.AuthStyle = int(value.Int64)
+			}
+		case oauthprovidertemplate.FieldExtraConfig:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field extra_config", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.ExtraConfig); err != nil {
+					return fmt.Errorf("unmarshal field extra_config: %w", err)
+				}
+			}
+		case oauthprovidertemplate.FieldSupportPkce:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field support_pkce", values[i])
+			} else if value.Valid {
+				_m.SupportPkce = value.Bool
+			}
+		case oauthprovidertemplate.FieldIconURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field icon_url", values[i])
+			} else if value.Valid {
+				_m.IconURL = value.String
+			}
+		case oauthprovidertemplate.FieldSort:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field sort", values[i])
+			} else if value.Valid {
+				_m.Sort = uint32(value.Int64)
+			}
+		case oauthprovidertemplate.FieldRemark:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field remark", values[i])
+			} else if value.Valid {
+				_m.Remark = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the OauthProviderTemplate.
+// This includes values selected through modifiers, order, etc.
+func (_m *OauthProviderTemplate) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryOauthProviders queries the "oauth_providers" edge of the OauthProviderTemplate entity.
+func (_m *OauthProviderTemplate) QueryOauthProviders() *OauthProviderQuery {
+	return NewOauthProviderTemplateClient(_m.config).QueryOauthProviders(_m)
+}
+
+// Update returns a builder for updating this OauthProviderTemplate.
+// Note that you need to call OauthProviderTemplate.Unwrap() before calling this method if this OauthProviderTemplate
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *OauthProviderTemplate) Update() *OauthProviderTemplateUpdateOne {
+	return NewOauthProviderTemplateClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the OauthProviderTemplate entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *OauthProviderTemplate) Unwrap() *OauthProviderTemplate {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: OauthProviderTemplate is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *OauthProviderTemplate) String() string {
+	var builder strings.Builder
+	builder.WriteString("OauthProviderTemplate(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("display_name=")
+	builder.WriteString(_m.DisplayName)
+	builder.WriteString(", ")
+	builder.WriteString("type=")
+	builder.WriteString(_m.Type)
+	builder.WriteString(", ")
+	builder.WriteString("provider_type=")
+	builder.WriteString(_m.ProviderType)
+	builder.WriteString(", ")
+	builder.WriteString("scopes=")
+	builder.WriteString(_m.Scopes)
+	builder.WriteString(", ")
+	builder.WriteString("auth_url=")
+	builder.WriteString(_m.AuthURL)
+	builder.WriteString(", ")
+	builder.WriteString("token_url=")
+	builder.WriteString(_m.TokenURL)
+	builder.WriteString(", ")
+	builder.WriteString("info_url=")
+	builder.WriteString(_m.InfoURL)
+	builder.WriteString(", ")
+	builder.WriteString("auth_style=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AuthStyle))
+	builder.WriteString(", ")
+	builder.WriteString("extra_config=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ExtraConfig))
+	builder.WriteString(", ")
+	builder.WriteString("support_pkce=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SupportPkce))
+	builder.WriteString(", ")
+	builder.WriteString("icon_url=")
+	builder.WriteString(_m.IconURL)
+	builder.WriteString(", ")
+	builder.WriteString("sort=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Sort))
+	builder.WriteString(", ")
+	builder.WriteString("remark=")
+	builder.WriteString(_m.Remark)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// OauthProviderTemplates is a parsable slice of OauthProviderTemplate.
+type OauthProviderTemplates []*OauthProviderTemplate
@@ -0,0 +1,242 @@
+// Code generated by ent, DO NOT EDIT.
+
+package oauthprovidertemplate
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the oauthprovidertemplate type in the database.
+	Label = "oauth_provider_template"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldDisplayName holds the string denoting the display_name field in the database.
+	FieldDisplayName = "display_name"
+	// FieldType holds the string denoting the type field in the database.
+	FieldType = "type"
+	// FieldProviderType holds the string denoting the provider_type field in the database.
+	FieldProviderType = "provider_type"
+	// FieldScopes holds the string denoting the scopes field in the database.
+	FieldScopes = "scopes"
+	// FieldAuthURL holds the string denoting the auth_url field in the database.
+	FieldAuthURL = "auth_url"
+	// FieldTokenURL holds the string denoting the token_url field in the database.
+	FieldTokenURL = "token_url"
+	// FieldInfoURL holds the string denoting the info_url field in the database.
+	FieldInfoURL = "info_url"
+	// FieldAuthStyle holds the string denoting the auth_style field in the database.
+	FieldAuthStyle = "auth_style"
+	// FieldExtraConfig holds the string denoting the extra_config field in the database.
+	FieldExtraConfig = "extra_config"
+	// FieldSupportPkce holds the string denoting the support_pkce field in the database.
+	FieldSupportPkce = "support_pkce"
+	// FieldIconURL holds the string denoting the icon_url field in the database.
+	FieldIconURL = "icon_url"
+	// FieldSort holds the string denoting the sort field in the database.
+	FieldSort = "sort"
+	// FieldRemark holds the string denoting the remark field in the database.
+	FieldRemark = "remark"
+	// EdgeOauthProviders holds the string denoting the oauth_providers edge name in mutations.
+	EdgeOauthProviders = "oauth_providers"
+	// Table holds the table name of the oauthprovidertemplate in the database.
+	Table = "sys_oauth_provider_templates"
+	// OauthProvidersTable is the table that holds the oauth_providers relation/edge.
+	OauthProvidersTable = "sys_oauth_providers"
+	// OauthProvidersInverseTable is the table name for the OauthProvider entity.
+	// It exists in this package in order to avoid circular dependency with the "oauthprovider" package.
+	OauthProvidersInverseTable = "sys_oauth_providers"
+	// OauthProvidersColumn is the table column denoting the oauth_providers relation/edge.
+	OauthProvidersColumn = "template_id"
+)
+
+// Columns holds all SQL columns for oauthprovidertemplate fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldStatus,
+	FieldName,
+	FieldDisplayName,
+	FieldType,
+	FieldProviderType,
+	FieldScopes,
+	FieldAuthURL,
+	FieldTokenURL,
+	FieldInfoURL,
+	FieldAuthStyle,
+	FieldExtraConfig,
+	FieldSupportPkce,
+	FieldIconURL,
+	FieldSort,
+	FieldRemark,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultStatus holds the default value on creation for the "status" field.
+	DefaultStatus uint8
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+	// DisplayNameValidator is a validator for the "display_name" field. It is called by the builders before save.
+	DisplayNameValidator func(string) error
+	// TypeValidator is a validator for the "type" field. It is called by the builders before save.
+	TypeValidator func(string) error
+	// DefaultProviderType holds the default value on creation for the "provider_type" field.
+	DefaultProviderType string
+	// ProviderTypeValidator is a validator for the "provider_type" field. It is called by the builders before save.
+	ProviderTypeValidator func(string) error
+	// ScopesValidator is a validator for the "scopes" field. It is called by the builders before save.
+	ScopesValidator func(string) error
+	// AuthURLValidator is a validator for the "auth_url" field. It is called by the builders before save.
+	AuthURLValidator func(string) error
+	// TokenURLValidator is a validator for the "token_url" field. It is called by the builders before save.
+	TokenURLValidator func(string) error
+	// InfoURLValidator is a validator for the "info_url" field. It is called by the builders before save.
+	InfoURLValidator func(string) error
+	// DefaultAuthStyle holds the default value on creation for the "auth_style" field.
+	DefaultAuthStyle int
+	// DefaultSupportPkce holds the default value on creation for the "support_pkce" field.
+	DefaultSupportPkce bool
+	// IconURLValidator is a validator for the "icon_url" field. It is called by the builders before save.
+	IconURLValidator func(string) error
+	// DefaultSort holds the default value on creation for the "sort" field.
+	DefaultSort uint32
+	// RemarkValidator is a validator for the "remark" field. It is called by the builders before save.
+	RemarkValidator func(string) error
+)
+
+// OrderOption defines the ordering options for the OauthProviderTemplate queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByDisplayName orders the results by the display_name field.
+func ByDisplayName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDisplayName, opts...).ToFunc()
+}
+
+// ByType orders the results by the type field.
+func ByType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldType, opts...).ToFunc()
+}
+
+// ByProviderType orders the results by the provider_type field.
+func ByProviderType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProviderType, opts...).ToFunc()
+}
+
+// ByScopes orders the results by the scopes field.
+func ByScopes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldScopes, opts...).ToFunc()
+}
+
+// ByAuthURL orders the results by the auth_url field.
+func ByAuthURL(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAuthURL, opts...).ToFunc()
+}
+
+// ByTokenURL orders the results by the token_url field.
+func ByTokenURL(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTokenURL, opts...).ToFunc()
+}
+
+// ByInfoURL orders the results by the info_url field.
+func ByInfoURL(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldInfoURL, opts...).ToFunc()
+}
+
+// ByAuthStyle orders the results by the auth_style field.
+func ByAuthStyle(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAuthStyle, opts...).ToFunc()
+}
+
+// BySupportPkce orders the results by the support_pkce field.
+func BySupportPkce(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSupportPkce, opts...).ToFunc()
+}
+
+// ByIconURL orders the results by the icon_url field.
+func ByIconURL(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIconURL, opts...).ToFunc()
+}
+
+// BySort orders the results by the sort field.
+func BySort(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSort, opts...).ToFunc()
+}
+
+// ByRemark orders the results by the remark field.
+func ByRemark(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRemark, opts...).ToFunc()
+}
+
+// ByOauthProvidersCount orders the results by oauth_providers count.
+func ByOauthProvidersCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newOauthProvidersStep(), opts...)
+	}
+}
+
+// ByOauthProviders orders the results by oauth_providers terms.
+func ByOauthProviders(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newOauthProvidersStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newOauthProvidersStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(OauthProvidersInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, OauthProvidersTable, OauthProvidersColumn),
+	)
+}
@@ -0,0 +1,1094 @@
+// Code generated by ent, DO NOT EDIT.
+
+package oauthprovidertemplate
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/coder-lulu/newbee-core/rpc/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uint64) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uint64) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uint64) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uint64) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uint64) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uint64) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uint64) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uint64) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uint64) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v uint8) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldEQ(FieldStatus, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldEQ(FieldName, v))
+}
+
+// DisplayName applies equality check predicate on the "display_name" field. It's identical to DisplayNameEQ.
+func DisplayName(v string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldEQ(FieldDisplayName, v))
+}
+
+// Type applies equality check predicate on the "type" field. It's identical to TypeEQ.
+func Type(v string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldEQ(FieldType, v))
+}
+
+// ProviderType applies equality check predicate on the "provider_type" field. It's identical to ProviderTypeEQ.
+func ProviderType(v string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldEQ(FieldProviderType, v))
+}
+
+// Scopes applies equality check predicate on the "scopes" field. It's identical to ScopesEQ.
+func Scopes(v string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldEQ(FieldScopes, v))
+}
+
+// AuthURL applies equality check predicate on the "auth_url" field. It's identical to AuthURLEQ.
+func AuthURL(v string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldEQ(FieldAuthURL, v))
+}
+
+// TokenURL applies equality check predicate on the "token_url" field. It's identical to TokenURLEQ.
+func TokenURL(v string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldEQ(FieldTokenURL, v))
+}
+
+// InfoURL applies equality check predicate on the "info_url" field. It's identical to InfoURLEQ.
+func InfoURL(v string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldEQ(FieldInfoURL, v))
+}
+
+// AuthStyle applies equality check predicate on the "auth_style" field. It's identical to AuthStyleEQ.
+func AuthStyle(v int) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldEQ(FieldAuthStyle, v))
+}
+
+// SupportPkce applies equality check predicate on the "support_pkce" field. It's identical to SupportPkceEQ.
+func SupportPkce(v bool) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldEQ(FieldSupportPkce, v))
+}
+
+// IconURL applies equality check predicate on the "icon_url" field. It's identical to IconURLEQ.
+func IconURL(v string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldEQ(FieldIconURL, v))
+}
+
+// Sort applies equality check predicate on the "sort" field. It's identical to SortEQ.
+func Sort(v uint32) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldEQ(FieldSort, v))
+}
+
+// Remark applies equality check predicate on the "remark" field. It's identical to RemarkEQ.
+func Remark(v string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldEQ(FieldRemark, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v uint8) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v uint8) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...uint8) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...uint8) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v uint8) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v uint8) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v uint8) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v uint8) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusIsNil applies the IsNil predicate on the "status" field.
+func StatusIsNil() predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldIsNull(FieldStatus))
+}
+
+// StatusNotNil applies the NotNil predicate on the "status" field.
+func StatusNotNil() predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldNotNull(FieldStatus))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldContainsFold(FieldName, v))
+}
+
+// DisplayNameEQ applies the EQ predicate on the "display_name" field.
+func DisplayNameEQ(v string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldEQ(FieldDisplayName, v))
+}
+
+// DisplayNameNEQ applies the NEQ predicate on the "display_name" field.
+func DisplayNameNEQ(v string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldNEQ(FieldDisplayName, v))
+}
+
+// DisplayNameIn applies the In predicate on the "display_name" field.
+func DisplayNameIn(vs ...string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldIn(FieldDisplayName, vs...))
+}
+
+// DisplayNameNotIn applies the NotIn predicate on the "display_name" field.
+func DisplayNameNotIn(vs ...string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldNotIn(FieldDisplayName, vs...))
+}
+
+// DisplayNameGT applies the GT predicate on the "display_name" field.
+func DisplayNameGT(v string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldGT(FieldDisplayName, v))
+}
+
+// DisplayNameGTE applies the GTE predicate on the "display_name" field.
+func DisplayNameGTE(v string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldGTE(FieldDisplayName, v))
+}
+
+// DisplayNameLT applies the LT predicate on the "display_name" field.
+func DisplayNameLT(v string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldLT(FieldDisplayName, v))
+}
+
+// DisplayNameLTE applies the LTE predicate on the "display_name" field.
+func DisplayNameLTE(v string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldLTE(FieldDisplayName, v))
+}
+
+// DisplayNameContains applies the Contains predicate on the "display_name" field.
+func DisplayNameContains(v string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldContains(FieldDisplayName, v))
+}
+
+// DisplayNameHasPrefix applies the HasPrefix predicate on the "display_name" field.
+func DisplayNameHasPrefix(v string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldHasPrefix(FieldDisplayName, v))
+}
+
+// DisplayNameHasSuffix applies the HasSuffix predicate on the "display_name" field.
+func DisplayNameHasSuffix(v string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldHasSuffix(FieldDisplayName, v))
+}
+
+// DisplayNameIsNil applies the IsNil predicate on the "display_name" field.
+func DisplayNameIsNil() predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldIsNull(FieldDisplayName))
+}
+
+// DisplayNameNotNil applies the NotNil predicate on the "display_name" field.
+func DisplayNameNotNil() predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldNotNull(FieldDisplayName))
+}
+
+// DisplayNameEqualFold applies the EqualFold predicate on the "display_name" field.
+func DisplayNameEqualFold(v string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldEqualFold(FieldDisplayName, v))
+}
+
+// DisplayNameContainsFold applies the ContainsFold predicate on the "display_name" field.
+func DisplayNameContainsFold(v string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldContainsFold(FieldDisplayName, v))
+}
+
+// TypeEQ applies the EQ predicate on the "type" field.
+func TypeEQ(v string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldEQ(FieldType, v))
+}
+
+// TypeNEQ applies the NEQ predicate on the "type" field.
+func TypeNEQ(v string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldNEQ(FieldType, v))
+}
+
+// TypeIn applies the In predicate on the "type" field.
+func TypeIn(vs ...string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldIn(FieldType, vs...))
+}
+
+// TypeNotIn applies the NotIn predicate on the "type" field.
+func TypeNotIn(vs ...string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldNotIn(FieldType, vs...))
+}
+
+// TypeGT applies the GT predicate on the "type" field.
+func TypeGT(v string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldGT(FieldType, v))
+}
+
+// TypeGTE applies the GTE predicate on the "type" field.
+func TypeGTE(v string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldGTE(FieldType, v))
+}
+
+// TypeLT applies the LT predicate on the "type" field.
+func TypeLT(v string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldLT(FieldType, v))
+}
+
+// TypeLTE applies the LTE predicate on the "type" field.
+func TypeLTE(v string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldLTE(FieldType, v))
+}
+
+// TypeContains applies the Contains predicate on the "type" field.
+func TypeContains(v string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldContains(FieldType, v))
+}
+
+// TypeHasPrefix applies the HasPrefix predicate on the "type" field.
+func TypeHasPrefix(v string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldHasPrefix(FieldType, v))
+}
+
+// TypeHasSuffix applies the HasSuffix predicate on the "type" field.
+func TypeHasSuffix(v string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldHasSuffix(FieldType, v))
+}
+
+// TypeEqualFold applies the EqualFold predicate on the "type" field.
+func TypeEqualFold(v string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldEqualFold(FieldType, v))
+}
+
+// TypeContainsFold applies the ContainsFold predicate on the "type" field.
+func TypeContainsFold(v string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldContainsFold(FieldType, v))
+}
+
+// ProviderTypeEQ applies the EQ predicate on the "provider_type" field.
+func ProviderTypeEQ(v string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldEQ(FieldProviderType, v))
+}
+
+// ProviderTypeNEQ applies the NEQ predicate on the "provider_type" field.
+func ProviderTypeNEQ(v string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldNEQ(FieldProviderType, v))
+}
+
+// ProviderTypeIn applies the In predicate on the "provider_type" field.
+func ProviderTypeIn(vs ...string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldIn(FieldProviderType, vs...))
+}
+
+// ProviderTypeNotIn applies the NotIn predicate on the "provider_type" field.
+func ProviderTypeNotIn(vs ...string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldNotIn(FieldProviderType, vs...))
+}
+
+// ProviderTypeGT applies the GT predicate on the "provider_type" field.
+func ProviderTypeGT(v string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldGT(FieldProviderType, v))
+}
+
+// ProviderTypeGTE applies the GTE predicate on the "provider_type" field.
+func ProviderTypeGTE(v string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldGTE(FieldProviderType, v))
+}
+
+// ProviderTypeLT applies the LT predicate on the "provider_type" field.
+func ProviderTypeLT(v string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldLT(FieldProviderType, v))
+}
+
+// ProviderTypeLTE applies the LTE predicate on the "provider_type" field.
+func ProviderTypeLTE(v string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldLTE(FieldProviderType, v))
+}
+
+// ProviderTypeContains applies the Contains predicate on the "provider_type" field.
+func ProviderTypeContains(v string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldContains(FieldProviderType, v))
+}
+
+// ProviderTypeHasPrefix applies the HasPrefix predicate on the "provider_type" field.
+func ProviderTypeHasPrefix(v string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldHasPrefix(FieldProviderType, v))
+}
+
+// ProviderTypeHasSuffix applies the HasSuffix predicate on the "provider_type" field.
+func ProviderTypeHasSuffix(v string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldHasSuffix(FieldProviderType, v))
+}
+
+// ProviderTypeEqualFold applies the EqualFold predicate on the "provider_type" field.
+func ProviderTypeEqualFold(v string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldEqualFold(FieldProviderType, v))
+}
+
+// ProviderTypeContainsFold applies the ContainsFold predicate on the "provider_type" field.
+func ProviderTypeContainsFold(v string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldContainsFold(FieldProviderType, v))
+}
+
+// ScopesEQ applies the EQ predicate on the "scopes" field.
+func ScopesEQ(v string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldEQ(FieldScopes, v))
+}
+
+// ScopesNEQ applies the NEQ predicate on the "scopes" field.
+func ScopesNEQ(v string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldNEQ(FieldScopes, v))
+}
+
+// ScopesIn applies the In predicate on the "scopes" field.
+func ScopesIn(vs ...string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldIn(FieldScopes, vs...))
+}
+
+// ScopesNotIn applies the NotIn predicate on the "scopes" field.
+func ScopesNotIn(vs ...string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldNotIn(FieldScopes, vs...))
+}
+
+// ScopesGT applies the GT predicate on the "scopes" field.
+func ScopesGT(v string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldGT(FieldScopes, v))
+}
+
+// ScopesGTE applies the GTE predicate on the "scopes" field.
+func ScopesGTE(v string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldGTE(FieldScopes, v))
+}
+
+// ScopesLT applies the LT predicate on the "scopes" field.
+func ScopesLT(v string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldLT(FieldScopes, v))
+}
+
+// ScopesLTE applies the LTE predicate on the "scopes" field.
+func ScopesLTE(v string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldLTE(FieldScopes, v))
+}
+
+// ScopesContains applies the Contains predicate on the "scopes" field.
+func ScopesContains(v string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldContains(FieldScopes, v))
+}
+
+// ScopesHasPrefix applies the HasPrefix predicate on the "scopes" field.
+func ScopesHasPrefix(v string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldHasPrefix(FieldScopes, v))
+}
+
+// ScopesHasSuffix applies the HasSuffix predicate on the "scopes" field.
+func ScopesHasSuffix(v string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldHasSuffix(FieldScopes, v))
+}
+
+// ScopesIsNil applies the IsNil predicate on the "scopes" field.
+func ScopesIsNil() predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldIsNull(FieldScopes))
+}
+
+// ScopesNotNil applies the NotNil predicate on the "scopes" field.
+func ScopesNotNil() predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldNotNull(FieldScopes))
+}
+
+// ScopesEqualFold applies the EqualFold predicate on the "scopes" field.
+func ScopesEqualFold(v string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldEqualFold(FieldScopes, v))
+}
+
+// ScopesContainsFold applies the ContainsFold predicate on the "scopes" field.
+func ScopesContainsFold(v string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldContainsFold(FieldScopes, v))
+}
+
+// AuthURLEQ applies the EQ predicate on the "auth_url" field.
+func AuthURLEQ(v string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldEQ(FieldAuthURL, v))
+}
+
+// AuthURLNEQ applies the NEQ predicate on the "auth_url" field.
+func AuthURLNEQ(v string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldNEQ(FieldAuthURL, v))
+}
+
+// AuthURLIn applies the In predicate on the "auth_url" field.
+func AuthURLIn(vs ...string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldIn(FieldAuthURL, vs...))
+}
+
+// AuthURLNotIn applies the NotIn predicate on the "auth_url" field.
+func AuthURLNotIn(vs ...string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldNotIn(FieldAuthURL, vs...))
+}
+
+// AuthURLGT applies the GT predicate on the "auth_url" field.
+func AuthURLGT(v string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldGT(FieldAuthURL, v))
+}
+
+// AuthURLGTE applies the GTE predicate on the "auth_url" field.
+func AuthURLGTE(v string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldGTE(FieldAuthURL, v))
+}
+
+// AuthURLLT applies the LT predicate on the "auth_url" field.
+func AuthURLLT(v string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldLT(FieldAuthURL, v))
+}
+
+// AuthURLLTE applies the LTE predicate on the "auth_url" field.
+func AuthURLLTE(v string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldLTE(FieldAuthURL, v))
+}
+
+// AuthURLContains applies the Contains predicate on the "auth_url" field.
+func AuthURLContains(v string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldContains(FieldAuthURL, v))
+}
+
+// AuthURLHasPrefix applies the HasPrefix predicate on the "auth_url" field.
+func AuthURLHasPrefix(v string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldHasPrefix(FieldAuthURL, v))
+}
+
+// AuthURLHasSuffix applies the HasSuffix predicate on the "auth_url" field.
+func AuthURLHasSuffix(v string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldHasSuffix(FieldAuthURL, v))
+}
+
+// AuthURLEqualFold applies the EqualFold predicate on the "auth_url" field.
+func AuthURLEqualFold(v string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldEqualFold(FieldAuthURL, v))
+}
+
+// AuthURLContainsFold applies the ContainsFold predicate on the "auth_url" field.
+func AuthURLContainsFold(v string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldContainsFold(FieldAuthURL, v))
+}
+
+// TokenURLEQ applies the EQ predicate on the "token_url" field.
+func TokenURLEQ(v string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldEQ(FieldTokenURL, v))
+}
+
+// TokenURLNEQ applies the NEQ predicate on the "token_url" field.
+func TokenURLNEQ(v string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldNEQ(FieldTokenURL, v))
+}
+
+// TokenURLIn applies the In predicate on the "token_url" field.
+func TokenURLIn(vs ...string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldIn(FieldTokenURL, vs...))
+}
+
+// TokenURLNotIn applies the NotIn predicate on the "token_url" field.
+func TokenURLNotIn(vs ...string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldNotIn(FieldTokenURL, vs...))
+}
+
+// TokenURLGT applies the GT predicate on the "token_url" field.
+func TokenURLGT(v string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldGT(FieldTokenURL, v))
+}
+
+// TokenURLGTE applies the GTE predicate on the "token_url" field.
+func TokenURLGTE(v string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldGTE(FieldTokenURL, v))
+}
+
+// TokenURLLT applies the LT predicate on the "token_url" field.
+func TokenURLLT(v string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldLT(FieldTokenURL, v))
+}
+
+// TokenURLLTE applies the LTE predicate on the "token_url" field.
+func TokenURLLTE(v string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldLTE(FieldTokenURL, v))
+}
+
+// TokenURLContains applies the Contains predicate on the "token_url" field.
+func TokenURLContains(v string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldContains(FieldTokenURL, v))
+}
+
+// TokenURLHasPrefix applies the HasPrefix predicate on the "token_url" field.
+func TokenURLHasPrefix(v string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldHasPrefix(FieldTokenURL, v))
+}
+
+// TokenURLHasSuffix applies the HasSuffix predicate on the "token_url" field.
+func TokenURLHasSuffix(v string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldHasSuffix(FieldTokenURL, v))
+}
+
+// TokenURLEqualFold applies the EqualFold predicate on the "token_url" field.
+func TokenURLEqualFold(v string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldEqualFold(FieldTokenURL, v))
+}
+
+// TokenURLContainsFold applies the ContainsFold predicate on the "token_url" field.
+func TokenURLContainsFold(v string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldContainsFold(FieldTokenURL, v))
+}
+
+// InfoURLEQ applies the EQ predicate on the "info_url" field.
+func InfoURLEQ(v string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldEQ(FieldInfoURL, v))
+}
+
+// InfoURLNEQ applies the NEQ predicate on the "info_url" field.
+func InfoURLNEQ(v string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldNEQ(FieldInfoURL, v))
+}
+
+// InfoURLIn applies the In predicate on the "info_url" field.
+func InfoURLIn(vs ...string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldIn(FieldInfoURL, vs...))
+}
+
+// InfoURLNotIn applies the NotIn predicate on the "info_url" field.
+func InfoURLNotIn(vs ...string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldNotIn(FieldInfoURL, vs...))
+}
+
+// InfoURLGT applies the GT predicate on the "info_url" field.
+func InfoURLGT(v string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldGT(FieldInfoURL, v))
+}
+
+// InfoURLGTE applies the GTE predicate on the "info_url" field.
+func InfoURLGTE(v string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldGTE(FieldInfoURL, v))
+}
+
+// InfoURLLT applies the LT predicate on the "info_url" field.
+func InfoURLLT(v string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldLT(FieldInfoURL, v))
+}
+
+// InfoURLLTE applies the LTE predicate on the "info_url" field.
+func InfoURLLTE(v string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldLTE(FieldInfoURL, v))
+}
+
+// InfoURLContains applies the Contains predicate on the "info_url" field.
+func InfoURLContains(v string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldContains(FieldInfoURL, v))
+}
+
+// InfoURLHasPrefix applies the HasPrefix predicate on the "info_url" field.
+func InfoURLHasPrefix(v string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldHasPrefix(FieldInfoURL, v))
+}
+
+// InfoURLHasSuffix applies the HasSuffix predicate on the "info_url" field.
+func InfoURLHasSuffix(v string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldHasSuffix(FieldInfoURL, v))
+}
+
+// InfoURLEqualFold applies the EqualFold predicate on the "info_url" field.
+func InfoURLEqualFold(v string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldEqualFold(FieldInfoURL, v))
+}
+
+// InfoURLContainsFold applies the ContainsFold predicate on the "info_url" field.
+func InfoURLContainsFold(v string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldContainsFold(FieldInfoURL, v))
+}
+
+// AuthStyleEQ applies the EQ predicate on the "auth_style" field.
+func AuthStyleEQ(v int) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldEQ(FieldAuthStyle, v))
+}
+
+// AuthStyleNEQ applies the NEQ predicate on the "auth_style" field.
+func AuthStyleNEQ(v int) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldNEQ(FieldAuthStyle, v))
+}
+
+// AuthStyleIn applies the In predicate on the "auth_style" field.
+func AuthStyleIn(vs ...int) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldIn(FieldAuthStyle, vs...))
+}
+
+// AuthStyleNotIn applies the NotIn predicate on the "auth_style" field.
+func AuthStyleNotIn(vs ...int) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldNotIn(FieldAuthStyle, vs...))
+}
+
+// AuthStyleGT applies the GT predicate on the "auth_style" field.
+func AuthStyleGT(v int) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldGT(FieldAuthStyle, v))
+}
+
+// AuthStyleGTE applies the GTE predicate on the "auth_style" field.
+func AuthStyleGTE(v int) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldGTE(FieldAuthStyle, v))
+}
+
+// AuthStyleLT applies the LT predicate on the "auth_style" field.
+func AuthStyleLT(v int) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldLT(FieldAuthStyle, v))
+}
+
+// AuthStyleLTE applies the LTE predicate on the "auth_style" field.
+func AuthStyleLTE(v int) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldLTE(FieldAuthStyle, v))
+}
+
+// ExtraConfigIsNil applies the IsNil predicate on the "extra_config" field.
+func ExtraConfigIsNil() predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldIsNull(FieldExtraConfig))
+}
+
+// ExtraConfigNotNil applies the NotNil predicate on the "extra_config" field.
+func ExtraConfigNotNil() predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldNotNull(FieldExtraConfig))
+}
+
+// SupportPkceEQ applies the EQ predicate on the "support_pkce" field.
+func SupportPkceEQ(v bool) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldEQ(FieldSupportPkce, v))
+}
+
+// SupportPkceNEQ applies the NEQ predicate on the "support_pkce" field.
+func SupportPkceNEQ(v bool) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldNEQ(FieldSupportPkce, v))
+}
+
+// IconURLEQ applies the EQ predicate on the "icon_url" field.
+func IconURLEQ(v string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldEQ(FieldIconURL, v))
+}
+
+// IconURLNEQ applies the NEQ predicate on the "icon_url" field.
+func IconURLNEQ(v string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldNEQ(FieldIconURL, v))
+}
+
+// IconURLIn applies the In predicate on the "icon_url" field.
+func IconURLIn(vs ...string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldIn(FieldIconURL, vs...))
+}
+
+// IconURLNotIn applies the NotIn predicate on the "icon_url" field.
+func IconURLNotIn(vs ...string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldNotIn(FieldIconURL, vs...))
+}
+
+// IconURLGT applies the GT predicate on the "icon_url" field.
+func IconURLGT(v string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldGT(FieldIconURL, v))
+}
+
+// IconURLGTE applies the GTE predicate on the "icon_url" field.
+func IconURLGTE(v string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldGTE(FieldIconURL, v))
+}
+
+// IconURLLT applies the LT predicate on the "icon_url" field.
+func IconURLLT(v string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldLT(FieldIconURL, v))
+}
+
+// IconURLLTE applies the LTE predicate on the "icon_url" field.
+func IconURLLTE(v string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldLTE(FieldIconURL, v))
+}
+
+// IconURLContains applies the Contains predicate on the "icon_url" field.
+func IconURLContains(v string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldContains(FieldIconURL, v))
+}
+
+// IconURLHasPrefix applies the HasPrefix predicate on the "icon_url" field.
+func IconURLHasPrefix(v string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldHasPrefix(FieldIconURL, v))
+}
+
+// IconURLHasSuffix applies the HasSuffix predicate on the "icon_url" field.
+func IconURLHasSuffix(v string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldHasSuffix(FieldIconURL, v))
+}
+
+// IconURLIsNil applies the IsNil predicate on the "icon_url" field.
+func IconURLIsNil() predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldIsNull(FieldIconURL))
+}
+
+// IconURLNotNil applies the NotNil predicate on the "icon_url" field.
+func IconURLNotNil() predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldNotNull(FieldIconURL))
+}
+
+// IconURLEqualFold applies the EqualFold predicate on the "icon_url" field.
+func IconURLEqualFold(v string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldEqualFold(FieldIconURL, v))
+}
+
+// IconURLContainsFold applies the ContainsFold predicate on the "icon_url" field.
+func IconURLContainsFold(v string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldContainsFold(FieldIconURL, v))
+}
+
+// SortEQ applies the EQ predicate on the "sort" field.
+func SortEQ(v uint32) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldEQ(FieldSort, v))
+}
+
+// SortNEQ applies the NEQ predicate on the "sort" field.
+func SortNEQ(v uint32) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldNEQ(FieldSort, v))
+}
+
+// SortIn applies the In predicate on the "sort" field.
+func SortIn(vs ...uint32) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldIn(FieldSort, vs...))
+}
+
+// SortNotIn applies the NotIn predicate on the "sort" field.
+func SortNotIn(vs ...uint32) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldNotIn(FieldSort, vs...))
+}
+
+// SortGT applies the GT predicate on the "sort" field.
+func SortGT(v uint32) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldGT(FieldSort, v))
+}
+
+// SortGTE applies the GTE predicate on the "sort" field.
+func SortGTE(v uint32) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldGTE(FieldSort, v))
+}
+
+// SortLT applies the LT predicate on the "sort" field.
+func SortLT(v uint32) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldLT(FieldSort, v))
+}
+
+// SortLTE applies the LTE predicate on the "sort" field.
+func SortLTE(v uint32) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldLTE(FieldSort, v))
+}
+
+// RemarkEQ applies the EQ predicate on the "remark" field.
+func RemarkEQ(v string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldEQ(FieldRemark, v))
+}
+
+// RemarkNEQ applies the NEQ predicate on the "remark" field.
+func RemarkNEQ(v string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldNEQ(FieldRemark, v))
+}
+
+// RemarkIn applies the In predicate on the "remark" field.
+func RemarkIn(vs ...string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldIn(FieldRemark, vs...))
+}
+
+// RemarkNotIn applies the NotIn predicate on the "remark" field.
+func RemarkNotIn(vs ...string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldNotIn(FieldRemark, vs...))
+}
+
+// RemarkGT applies the GT predicate on the "remark" field.
+func RemarkGT(v string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldGT(FieldRemark, v))
+}
+
+// RemarkGTE applies the GTE predicate on the "remark" field.
+func RemarkGTE(v string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldGTE(FieldRemark, v))
+}
+
+// RemarkLT applies the LT predicate on the "remark" field.
+func RemarkLT(v string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldLT(FieldRemark, v))
+}
+
+// RemarkLTE applies the LTE predicate on the "remark" field.
+func RemarkLTE(v string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldLTE(FieldRemark, v))
+}
+
+// RemarkContains applies the Contains predicate on the "remark" field.
+func RemarkContains(v string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldContains(FieldRemark, v))
+}
+
+// RemarkHasPrefix applies the HasPrefix predicate on the "remark" field.
+func RemarkHasPrefix(v string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldHasPrefix(FieldRemark, v))
+}
+
+// RemarkHasSuffix applies the HasSuffix predicate on the "remark" field.
+func RemarkHasSuffix(v string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldHasSuffix(FieldRemark, v))
+}
+
+// RemarkIsNil applies the IsNil predicate on the "remark" field.
+func RemarkIsNil() predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldIsNull(FieldRemark))
+}
+
+// RemarkNotNil applies the NotNil predicate on the "remark" field.
+func RemarkNotNil() predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldNotNull(FieldRemark))
+}
+
+// RemarkEqualFold applies the EqualFold predicate on the "remark" field.
+func RemarkEqualFold(v string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldEqualFold(FieldRemark, v))
+}
+
+// RemarkContainsFold applies the ContainsFold predicate on the "remark" field.
+func RemarkContainsFold(v string) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.FieldContainsFold(FieldRemark, v))
+}
+
+// HasOauthProviders applies the HasEdge predicate on the "oauth_providers" edge.
+func HasOauthProviders() predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, OauthProvidersTable, OauthProvidersColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOauthProvidersWith applies the HasEdge predicate on the "oauth_providers" edge with a given conditions (other predicates).
+func HasOauthProvidersWith(preds ...predicate.OauthProvider) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(func(s *sql.Selector) {
+		step := newOauthProvidersStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.OauthProviderTemplate) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.OauthProviderTemplate) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.OauthProviderTemplate) predicate.OauthProviderTemplate {
+	return predicate.OauthProviderTemplate(sql.NotPredicates(p))
+}
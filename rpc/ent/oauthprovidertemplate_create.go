@@ -0,0 +1,590 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/coder-lulu/newbee-core/rpc/ent/oauthprovider"
+	"github.com/coder-lulu/newbee-core/rpc/ent/oauthprovidertemplate"
+)
+
+// OauthProviderTemplateCreate is the builder for creating a OauthProviderTemplate entity.
+type OauthProviderTemplateCreate struct {
+	config
+	mutation *OauthProviderTemplateMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *OauthProviderTemplateCreate) SetCreatedAt(v time.Time) *OauthProviderTemplateCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *OauthProviderTemplateCreate) SetNillableCreatedAt(v *time.Time) *OauthProviderTemplateCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *OauthProviderTemplateCreate) SetUpdatedAt(v time.Time) *OauthProviderTemplateCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *OauthProviderTemplateCreate) SetNillableUpdatedAt(v *time.Time) *OauthProviderTemplateCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *OauthProviderTemplateCreate) SetStatus(v uint8) *OauthProviderTemplateCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *OauthProviderTemplateCreate) SetNillableStatus(v *uint8) *OauthProviderTemplateCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *OauthProviderTemplateCreate) SetName(v string) *OauthProviderTemplateCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetDisplayName sets the "display_name" field.
+func (_c *OauthProviderTemplateCreate) SetDisplayName(v string) *OauthProviderTemplateCreate {
+	_c.mutation.SetDisplayName(v)
+	return _c
+}
+
+// SetNillableDisplayName sets the "display_name" field if the given value is not nil.
+func (_c *OauthProviderTemplateCreate) SetNillableDisplayName(v *string) *OauthProviderTemplateCreate {
+	if v != nil {
+		_c.SetDisplayName(*v)
+	}
+	return _c
+}
+
+// SetType sets the "type" field.
+func (_c *OauthProviderTemplateCreate) SetType(v string) *OauthProviderTemplateCreate {
+	_c.mutation.SetType(v)
+	return _c
+}
+
+// SetProviderType sets the "provider_type" field.
+func (_c *OauthProviderTemplateCreate) SetProviderType(v string) *OauthProviderTemplateCreate {
+	_c.mutation.SetProviderType(v)
+	return _c
+}
+
+// SetNillableProviderType sets the "provider_type" field if the given value is not nil.
+func (_c *OauthProviderTemplateCreate) SetNillableProviderType(v *string) *OauthProviderTemplateCreate {
+	if v != nil {
+		_c.SetProviderType(*v)
+	}
+	return _c
+}
+
+// SetScopes sets the "scopes" field.
+func (_c *OauthProviderTemplateCreate) SetScopes(v string) *OauthProviderTemplateCreate {
+	_c.mutation.SetScopes(v)
+	return _c
+}
+
+// SetNillableScopes sets the "scopes" field if the given value is not nil.
+func (_c *OauthProviderTemplateCreate) SetNillableScopes(v *string) *OauthProviderTemplateCreate {
+	if v != nil {
+		_c.SetScopes(*v)
+	}
+	return _c
+}
+
+// SetAuthURL sets the "auth_url" field.
+func (_c *OauthProviderTemplateCreate) SetAuthURL(v string) *OauthProviderTemplateCreate {
+	_c.mutation.SetAuthURL(v)
+	return _c
+}
+
+// SetTokenURL sets the "token_url" field.
+func (_c *OauthProviderTemplateCreate) SetTokenURL(v string) *OauthProviderTemplateCreate {
+	_c.mutation.SetTokenURL(v)
+	return _c
+}
+
+// SetInfoURL sets the "info_url" field.
+func (_c *OauthProviderTemplateCreate) SetInfoURL(v string) *OauthProviderTemplateCreate {
+	_c.mutation.SetInfoURL(v)
+	return _c
+}
+
+// SetAuthStyle sets the "auth_style" field.
+func (_c *OauthProviderTemplateCreate) SetAuthStyle(v int) *OauthProviderTemplateCreate {
+	_c.mutation.SetAuthStyle(v)
+	return _c
+}
+
+// SetNillableAuthStyle sets the "auth_style" field if the given value is not nil.
+func (_c *OauthProviderTemplateCreate) SetNillableAuthStyle(v *int) *OauthProviderTemplateCreate {
+	if v != nil {
+		_c.SetAuthStyle(*v)
+	}
+	return _c
+}
+
+// SetExtraConfig sets the "extra_config" field.
+func (_c *OauthProviderTemplateCreate) SetExtraConfig(v map[string]interface{}) *OauthProviderTemplateCreate {
+	_c.mutation.SetExtraConfig(v)
+	return _c
+}
+
+// SetSupportPkce sets the "support_pkce" field.
+func (_c *OauthProviderTemplateCreate) SetSupportPkce(v bool) *OauthProviderTemplateCreate {
+	_c.mutation.SetSupportPkce(v)
+	return _c
+}
+
+// SetNillableSupportPkce sets the "support_pkce" field if the given value is not nil.
+func (_c *OauthProviderTemplateCreate) SetNillableSupportPkce(v *bool) *OauthProviderTemplateCreate {
+	if v != nil {
+		_c.SetSupportPkce(*v)
+	}
+	return _c
+}
+
+// SetIconURL sets the "icon_url" field.
+func (_c *OauthProviderTemplateCreate) SetIconURL(v string) *OauthProviderTemplateCreate {
+	_c.mutation.SetIconURL(v)
+	return _c
+}
+
+// SetNillableIconURL sets the "icon_url" field if the given value is not nil.
+func (_c *OauthProviderTemplateCreate) SetNillableIconURL(v *string) *OauthProviderTemplateCreate {
+	if v != nil {
+		_c.SetIconURL(*v)
+	}
+	return _c
+}
+
+// SetSort sets the "sort" field.
+func (_c *OauthProviderTemplateCreate) SetSort(v uint32) *OauthProviderTemplateCreate {
+	_c.mutation.SetSort(v)
+	return _c
+}
+
+// SetNillableSort sets the "sort" field if the given value is not nil.
+func (_c *OauthProviderTemplateCreate) SetNillableSort(v *uint32) *OauthProviderTemplateCreate {
+	if v != nil {
+		_c.SetSort(*v)
+	}
+	return _c
+}
+
+// SetRemark sets the "remark" field.
+func (_c *OauthProviderTemplateCreate) SetRemark(v string) *OauthProviderTemplateCreate {
+	_c.mutation.SetRemark(v)
+	return _c
+}
+
+// SetNillableRemark sets the "remark" field if the given value is not nil.
+func (_c *OauthProviderTemplateCreate) SetNillableRemark(v *string) *OauthProviderTemplateCreate {
+	if v != nil {
+		_c.SetRemark(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *OauthProviderTemplateCreate) SetID(v uint64) *OauthProviderTemplateCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// AddOauthProviderIDs adds the "oauth_providers" edge to the OauthProvider entity by IDs.
+func (_c *OauthProviderTemplateCreate) AddOauthProviderIDs(ids ...uint64) *OauthProviderTemplateCreate {
+	_c.mutation.AddOauthProviderIDs(ids...)
+	return _c
+}
+
+// AddOauthProviders adds the "oauth_providers" edges to the OauthProvider entity.
+func (_c *OauthProviderTemplateCreate) AddOauthProviders(v ...*OauthProvider) *OauthProviderTemplateCreate {
+	ids := make([]uint64, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddOauthProviderIDs(ids...)
+}
+
+// Mutation returns the OauthProviderTemplateMutation object of the builder.
+func (_c *OauthProviderTemplateCreate) Mutation() *OauthProviderTemplateMutation {
+	return _c.mutation
+}
+
+// Save creates the OauthProviderTemplate in the database.
+func (_c *OauthProviderTemplateCreate) Save(ctx context.Context) (*OauthProviderTemplate, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *OauthProviderTemplateCreate) SaveX(ctx context.Context) *OauthProviderTemplate {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *OauthProviderTemplateCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *OauthProviderTemplateCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *OauthProviderTemplateCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := oauthprovidertemplate.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := oauthprovidertemplate.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := oauthprovidertemplate.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.ProviderType(); !ok {
+		v := oauthprovidertemplate.DefaultProviderType
+		_c.mutation.SetProviderType(v)
+	}
+	if _, ok := _c.mutation.AuthStyle(); !ok {
+		v := oauthprovidertemplate.DefaultAuthStyle
+		_c.mutation.SetAuthStyle(v)
+	}
+	if _, ok := _c.mutation.SupportPkce(); !ok {
+		v := oauthprovidertemplate.DefaultSupportPkce
+		_c.mutation.SetSupportPkce(v)
+	}
+	if _, ok := _c.mutation.Sort(); !ok {
+		v := oauthprovidertemplate.DefaultSort
+		_c.mutation.SetSort(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *OauthProviderTemplateCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "OauthProviderTemplate.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "OauthProviderTemplate.updated_at"`)}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "OauthProviderTemplate.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := oauthprovidertemplate.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "OauthProviderTemplate.name": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.DisplayName(); ok {
+		if err := oauthprovidertemplate.DisplayNameValidator(v); err != nil {
+			return &ValidationError{Name: "display_name", err: fmt.Errorf(`ent: validator failed for field "OauthProviderTemplate.display_name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "OauthProviderTemplate.type"`)}
+	}
+	if v, ok := _c.mutation.GetType(); ok {
+		if err := oauthprovidertemplate.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "OauthProviderTemplate.type": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ProviderType(); !ok {
+		return &ValidationError{Name: "provider_type", err: errors.New(`ent: missing required field "OauthProviderTemplate.provider_type"`)}
+	}
+	if v, ok := _c.mutation.ProviderType(); ok {
+		if err := oauthprovidertemplate.ProviderTypeValidator(v); err != nil {
+			return &ValidationError{Name: "provider_type", err: fmt.Errorf(`ent: validator failed for field "OauthProviderTemplate.provider_type": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Scopes(); ok {
+		if err := oauthprovidertemplate.ScopesValidator(v); err != nil {
+			return &ValidationError{Name: "scopes", err: fmt.Errorf(`ent: validator failed for field "OauthProviderTemplate.scopes": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.AuthURL(); !ok {
+		return &ValidationError{Name: "auth_url", err: errors.New(`ent: missing required field "OauthProviderTemplate.auth_url"`)}
+	}
+	if v, ok := _c.mutation.AuthURL(); ok {
+		if err := oauthprovidertemplate.AuthURLValidator(v); err != nil {
+			return &ValidationError{Name: "auth_url", err: fmt.Errorf(`ent: validator failed for field "OauthProviderTemplate.auth_url": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.TokenURL(); !ok {
+		return &ValidationError{Name: "token_url", err: errors.New(`ent: missing required field "OauthProviderTemplate.token_url"`)}
+	}
+	if v, ok := _c.mutation.TokenURL(); ok {
+		if err := oauthprovidertemplate.TokenURLValidator(v); err != nil {
+			return &ValidationError{Name: "token_url", err: fmt.Errorf(`ent: validator failed for field "OauthProviderTemplate.token_url": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.InfoURL(); !ok {
+		return &ValidationError{Name: "info_url", err: errors.New(`ent: missing required field "OauthProviderTemplate.info_url"`)}
+	}
+	if v, ok := _c.mutation.InfoURL(); ok {
+		if err := oauthprovidertemplate.InfoURLValidator(v); err != nil {
+			return &ValidationError{Name: "info_url", err: fmt.Errorf(`ent: validator failed for field "OauthProviderTemplate.info_url": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.AuthStyle(); !ok {
+		return &ValidationError{Name: "auth_style", err: errors.New(`ent: missing required field "OauthProviderTemplate.auth_style"`)}
+	}
+	if _, ok := _c.mutation.SupportPkce(); !ok {
+		return &ValidationError{Name: "support_pkce", err: errors.New(`ent: missing required field "OauthProviderTemplate.support_pkce"`)}
+	}
+	if v, ok := _c.mutation.IconURL(); ok {
+		if err := oauthprovidertemplate.IconURLValidator(v); err != nil {
+			return &ValidationError{Name: "icon_url", err: fmt.Errorf(`ent: validator failed for field "OauthProviderTemplate.icon_url": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Sort(); !ok {
+		return &ValidationError{Name: "sort", err: errors.New(`ent: missing required field "OauthProviderTemplate.sort"`)}
+	}
+	if v, ok := _c.mutation.Remark(); ok {
+		if err := oauthprovidertemplate.RemarkValidator(v); err != nil {
+			return &ValidationError{Name: "remark", err: fmt.Errorf(`ent: validator failed for field "OauthProviderTemplate.remark": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *OauthProviderTemplateCreate) sqlSave(ctx context.Context) (*OauthProviderTemplate, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint64(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *OauthProviderTemplateCreate) createSpec() (*OauthProviderTemplate, *sqlgraph.CreateSpec) {
+	var (
+		_node = &OauthProviderTemplate{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(oauthprovidertemplate.Table, sqlgraph.NewFieldSpec(oauthprovidertemplate.FieldID, field.TypeUint64))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(oauthprovidertemplate.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(oauthprovidertemplate.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(oauthprovidertemplate.FieldStatus, field.TypeUint8, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(oauthprovidertemplate.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.DisplayName(); ok {
+		_spec.SetField(oauthprovidertemplate.FieldDisplayName, field.TypeString, value)
+		_node.DisplayName = value
+	}
+	if value, ok := _c.mutation.GetType(); ok {
+		_spec.SetField(oauthprovidertemplate.FieldType, field.TypeString, value)
+		_node.Type = value
+	}
+	if value, ok := _c.mutation.ProviderType(); ok {
+		_spec.SetField(oauthprovidertemplate.FieldProviderType, field.TypeString, value)
+		_node.ProviderType = value
+	}
+	if value, ok := _c.mutation.Scopes(); ok {
+		_spec.SetField(oauthprovidertemplate.FieldScopes, field.TypeString, value)
+		_node.Scopes = value
+	}
+	if value, ok := _c.mutation.AuthURL(); ok {
+		_spec.SetField(oauthprovidertemplate.FieldAuthURL, field.TypeString, value)
+		_node.AuthURL = value
+	}
+	if value, ok := _c.mutation.TokenURL(); ok {
+		_spec.SetField(oauthprovidertemplate.FieldTokenURL, field.TypeString, value)
+		_node.TokenURL = value
+	}
+	if value, ok := _c.mutation.InfoURL(); ok {
+		_spec.SetField(oauthprovidertemplate.FieldInfoURL, field.TypeString, value)
+		_node.InfoURL = value
+	}
+	if value, ok := _c.mutation.AuthStyle(); ok {
+		_spec.SetField(oauthprovidertemplate.FieldAuthStyle, field.TypeInt, value)
+		_node.AuthStyle = value
+	}
+	if value, ok := _c.mutation.ExtraConfig(); ok {
+		_spec.SetField(oauthprovidertemplate.FieldExtraConfig, field.TypeJSON, value)
+		_node.ExtraConfig = value
+	}
+	if value, ok := _c.mutation.SupportPkce(); ok {
+		_spec.SetField(oauthprovidertemplate.FieldSupportPkce, field.TypeBool, value)
+		_node.SupportPkce = value
+	}
+	if value, ok := _c.mutation.IconURL(); ok {
+		_spec.SetField(oauthprovidertemplate.FieldIconURL, field.TypeString, value)
+		_node.IconURL = value
+	}
+	if value, ok := _c.mutation.Sort(); ok {
+		_spec.SetField(oauthprovidertemplate.FieldSort, field.TypeUint32, value)
+		_node.Sort = value
+	}
+	if value, ok := _c.mutation.Remark(); ok {
+		_spec.SetField(oauthprovidertemplate.FieldRemark, field.TypeString, value)
+		_node.Remark = value
+	}
+	if nodes := _c.mutation.OauthProvidersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   oauthprovidertemplate.OauthProvidersTable,
+			Columns: []string{oauthprovidertemplate.OauthProvidersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(oauthprovider.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OauthProviderTemplateCreateBulk is the builder for creating many OauthProviderTemplate entities in bulk.
+type OauthProviderTemplateCreateBulk struct {
+	config
+	err      error
+	builders []*OauthProviderTemplateCreate
+}
+
+// Save creates the OauthProviderTemplate entities in the database.
+func (_c *OauthProviderTemplateCreateBulk) Save(ctx context.Context) ([]*OauthProviderTemplate, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*OauthProviderTemplate, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*OauthProviderTemplateMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *OauthProviderTemplateCreateBulk) SaveX(ctx context.Context) []*OauthProviderTemplate {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *OauthProviderTemplateCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *OauthProviderTemplateCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,631 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/coder-lulu/newbee-core/rpc/ent/oauthprovider"
+	"github.com/coder-lulu/newbee-core/rpc/ent/oauthprovidertemplate"
+	"github.com/coder-lulu/newbee-core/rpc/ent/predicate"
+)
+
+// OauthProviderTemplateQuery is the builder for querying OauthProviderTemplate entities.
+type OauthProviderTemplateQuery struct {
+	config
+	ctx                *QueryContext
+	order              []oauthprovidertemplate.OrderOption
+	inters             []Interceptor
+	predicates         []predicate.OauthProviderTemplate
+	withOauthProviders *OauthProviderQuery
+	modifiers          []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the OauthProviderTemplateQuery builder.
+func (_q *OauthProviderTemplateQuery) Where(ps ...predicate.OauthProviderTemplate) *OauthProviderTemplateQuery {
+	_q.predicates = append(_q.predicates, ps...)
+	return _q
+}
+
+// Limit the number of records to be returned by this query.
+func (_q *OauthProviderTemplateQuery) Limit(limit int) *OauthProviderTemplateQuery {
+	_q.ctx.Limit = &limit
+	return _q
+}
+
+// Offset to start from.
+func (_q *OauthProviderTemplateQuery) Offset(offset int) *OauthProviderTemplateQuery {
+	_q.ctx.Offset = &offset
+	return _q
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (_q *OauthProviderTemplateQuery) Unique(unique bool) *OauthProviderTemplateQuery {
+	_q.ctx.Unique = &unique
+	return _q
+}
+
+// Order specifies how the records should be ordered.
+func (_q *OauthProviderTemplateQuery) Order(o ...oauthprovidertemplate.OrderOption) *OauthProviderTemplateQuery {
+	_q.order = append(_q.order, o...)
+	return _q
+}
+
+// QueryOauthProviders chains the current query on the "oauth_providers" edge.
+func (_q *OauthProviderTemplateQuery) QueryOauthProviders() *OauthProviderQuery {
+	query := (&OauthProviderClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(oauthprovidertemplate.Table, oauthprovidertemplate.FieldID, selector),
+			sqlgraph.To(oauthprovider.Table, oauthprovider.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, oauthprovidertemplate.OauthProvidersTable, oauthprovidertemplate.OauthProvidersColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first OauthProviderTemplate entity from the query.
+// Returns a *NotFoundError when no OauthProviderTemplate was found.
+func (_q *OauthProviderTemplateQuery) First(ctx context.Context) (*OauthProviderTemplate, error) {
+	nodes, err := _q.Limit(1).All(setContextOp(ctx, _q.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{oauthprovidertemplate.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (_q *OauthProviderTemplateQuery) FirstX(ctx context.Context) *OauthProviderTemplate {
+	node, err := _q.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first OauthProviderTemplate ID from the query.
+// Returns a *NotFoundError when no OauthProviderTemplate ID was found.
+func (_q *OauthProviderTemplateQuery) FirstID(ctx context.Context) (id uint64, err error) {
+	var ids []uint64
+	if ids, err = _q.Limit(1).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{oauthprovidertemplate.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (_q *OauthProviderTemplateQuery) FirstIDX(ctx context.Context) uint64 {
+	id, err := _q.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single OauthProviderTemplate entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one OauthProviderTemplate entity is found.
+// Returns a *NotFoundError when no OauthProviderTemplate entities are found.
+func (_q *OauthProviderTemplateQuery) Only(ctx context.Context) (*OauthProviderTemplate, error) {
+	nodes, err := _q.Limit(2).All(setContextOp(ctx, _q.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{oauthprovidertemplate.Label}
+	default:
+		return nil, &NotSingularError{oauthprovidertemplate.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (_q *OauthProviderTemplateQuery) OnlyX(ctx context.Context) *OauthProviderTemplate {
+	node, err := _q.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only OauthProviderTemplate ID in the query.
+// Returns a *NotSingularError when more than one OauthProviderTemplate ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (_q *OauthProviderTemplateQuery) OnlyID(ctx context.Context) (id uint64, err error) {
+	var ids []uint64
+	if ids, err = _q.Limit(2).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{oauthprovidertemplate.Label}
+	default:
+		err = &NotSingularError{oauthprovidertemplate.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (_q *OauthProviderTemplateQuery) OnlyIDX(ctx context.Context) uint64 {
+	id, err := _q.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of OauthProviderTemplates.
+func (_q *OauthProviderTemplateQuery) All(ctx context.Context) ([]*OauthProviderTemplate, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryAll)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*OauthProviderTemplate, *OauthProviderTemplateQuery]()
+	return withInterceptors[[]*OauthProviderTemplate](ctx, _q, qr, _q.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (_q *OauthProviderTemplateQuery) AllX(ctx context.Context) []*OauthProviderTemplate {
+	nodes, err := _q.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of OauthProviderTemplate IDs.
+func (_q *OauthProviderTemplateQuery) IDs(ctx context.Context) (ids []uint64, err error) {
+	if _q.ctx.Unique == nil && _q.path != nil {
+		_q.Unique(true)
+	}
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryIDs)
+	if err = _q.Select(oauthprovidertemplate.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (_q *OauthProviderTemplateQuery) IDsX(ctx context.Context) []uint64 {
+	ids, err := _q.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (_q *OauthProviderTemplateQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryCount)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, _q, querierCount[*OauthProviderTemplateQuery](), _q.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (_q *OauthProviderTemplateQuery) CountX(ctx context.Context) int {
+	count, err := _q.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (_q *OauthProviderTemplateQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryExist)
+	switch _, err := _q.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (_q *OauthProviderTemplateQuery) ExistX(ctx context.Context) bool {
+	exist, err := _q.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the OauthProviderTemplateQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (_q *OauthProviderTemplateQuery) Clone() *OauthProviderTemplateQuery {
+	if _q == nil {
+		return nil
+	}
+	return &OauthProviderTemplateQuery{
+		config:             _q.config,
+		ctx:                _q.ctx.Clone(),
+		order:              append([]oauthprovidertemplate.OrderOption{}, _q.order...),
+		inters:             append([]Interceptor{}, _q.inters...),
+		predicates:         append([]predicate.OauthProviderTemplate{}, _q.predicates...),
+		withOauthProviders: _q.withOauthProviders.Clone(),
+		// clone intermediate query.
+		sql:       _q.sql.Clone(),
+		path:      _q.path,
+		modifiers: append([]func(*sql.Selector){}, _q.modifiers...),
+	}
+}
+
+// WithOauthProviders tells the query-builder to eager-load the nodes that are connected to
+// the "oauth_providers" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *OauthProviderTemplateQuery) WithOauthProviders(opts ...func(*OauthProviderQuery)) *OauthProviderTemplateQuery {
+	query := (&OauthProviderClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withOauthProviders = query
+	return _q
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.OauthProviderTemplate.Query().
+//		GroupBy(oauthprovidertemplate.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (_q *OauthProviderTemplateQuery) GroupBy(field string, fields ...string) *OauthProviderTemplateGroupBy {
+	_q.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &OauthProviderTemplateGroupBy{build: _q}
+	grbuild.flds = &_q.ctx.Fields
+	grbuild.label = oauthprovidertemplate.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.OauthProviderTemplate.Query().
+//		Select(oauthprovidertemplate.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (_q *OauthProviderTemplateQuery) Select(fields ...string) *OauthProviderTemplateSelect {
+	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
+	sbuild := &OauthProviderTemplateSelect{OauthProviderTemplateQuery: _q}
+	sbuild.label = oauthprovidertemplate.Label
+	sbuild.flds, sbuild.scan = &_q.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a OauthProviderTemplateSelect configured with the given aggregations.
+func (_q *OauthProviderTemplateQuery) Aggregate(fns ...AggregateFunc) *OauthProviderTemplateSelect {
+	return _q.Select().Aggregate(fns...)
+}
+
+func (_q *OauthProviderTemplateQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range _q.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, _q); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range _q.ctx.Fields {
+		if !oauthprovidertemplate.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if _q.path != nil {
+		prev, err := _q.path(ctx)
+		if err != nil {
+			return err
+		}
+		_q.sql = prev
+	}
+	return nil
+}
+
+func (_q *OauthProviderTemplateQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*OauthProviderTemplate, error) {
+	var (
+		nodes       = []*OauthProviderTemplate{}
+		_spec       = _q.querySpec()
+		loadedTypes = [1]bool{
+			_q.withOauthProviders != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*OauthProviderTemplate).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &OauthProviderTemplate{config: _q.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, _q.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := _q.withOauthProviders; query != nil {
+		if err := _q.loadOauthProviders(ctx, query, nodes,
+			func(n *OauthProviderTemplate) { n.Edges.OauthProviders = []*OauthProvider{} },
+			func(n *OauthProviderTemplate, e *OauthProvider) {
+				n.Edges.OauthProviders = append(n.Edges.OauthProviders, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (_q *OauthProviderTemplateQuery) loadOauthProviders(ctx context.Context, query *OauthProviderQuery, nodes []*OauthProviderTemplate, init func(*OauthProviderTemplate), assign func(*OauthProviderTemplate, *OauthProvider)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uint64]*OauthProviderTemplate)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(oauthprovider.FieldTemplateID)
+	}
+	query.Where(predicate.OauthProvider(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(oauthprovidertemplate.OauthProvidersColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.TemplateID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "template_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
+func (_q *OauthProviderTemplateQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := _q.querySpec()
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
+	_spec.Node.Columns = _q.ctx.Fields
+	if len(_q.ctx.Fields) > 0 {
+		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, _q.driver, _spec)
+}
+
+func (_q *OauthProviderTemplateQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(oauthprovidertemplate.Table, oauthprovidertemplate.Columns, sqlgraph.NewFieldSpec(oauthprovidertemplate.FieldID, field.TypeUint64))
+	_spec.From = _q.sql
+	if unique := _q.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if _q.path != nil {
+		_spec.Unique = true
+	}
+	if fields := _q.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, oauthprovidertemplate.FieldID)
+		for i := range fields {
+			if fields[i] != oauthprovidertemplate.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := _q.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := _q.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (_q *OauthProviderTemplateQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(_q.driver.Dialect())
+	t1 := builder.Table(oauthprovidertemplate.Table)
+	columns := _q.ctx.Fields
+	if len(columns) == 0 {
+		columns = oauthprovidertemplate.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if _q.sql != nil {
+		selector = _q.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if _q.ctx.Unique != nil && *_q.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range _q.modifiers {
+		m(selector)
+	}
+	for _, p := range _q.predicates {
+		p(selector)
+	}
+	for _, p := range _q.order {
+		p(selector)
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_q *OauthProviderTemplateQuery) Modify(modifiers ...func(s *sql.Selector)) *OauthProviderTemplateSelect {
+	_q.modifiers = append(_q.modifiers, modifiers...)
+	return _q.Select()
+}
+
+// OauthProviderTemplateGroupBy is the group-by builder for OauthProviderTemplate entities.
+type OauthProviderTemplateGroupBy struct {
+	selector
+	build *OauthProviderTemplateQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (_g *OauthProviderTemplateGroupBy) Aggregate(fns ...AggregateFunc) *OauthProviderTemplateGroupBy {
+	_g.fns = append(_g.fns, fns...)
+	return _g
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_g *OauthProviderTemplateGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _g.build.ctx, ent.OpQueryGroupBy)
+	if err := _g.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*OauthProviderTemplateQuery, *OauthProviderTemplateGroupBy](ctx, _g.build, _g, _g.build.inters, v)
+}
+
+func (_g *OauthProviderTemplateGroupBy) sqlScan(ctx context.Context, root *OauthProviderTemplateQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(_g.fns))
+	for _, fn := range _g.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*_g.flds)+len(_g.fns))
+		for _, f := range *_g.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// OauthProviderTemplateSelect is the builder for selecting fields of OauthProviderTemplate entities.
+type OauthProviderTemplateSelect struct {
+	*OauthProviderTemplateQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (_s *OauthProviderTemplateSelect) Aggregate(fns ...AggregateFunc) *OauthProviderTemplateSelect {
+	_s.fns = append(_s.fns, fns...)
+	return _s
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_s *OauthProviderTemplateSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _s.ctx, ent.OpQuerySelect)
+	if err := _s.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*OauthProviderTemplateQuery, *OauthProviderTemplateSelect](ctx, _s.OauthProviderTemplateQuery, _s, _s.inters, v)
+}
+
+func (_s *OauthProviderTemplateSelect) sqlScan(ctx context.Context, root *OauthProviderTemplateQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(_s.fns))
+	for _, fn := range _s.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*_s.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_s *OauthProviderTemplateSelect) Modify(modifiers ...func(s *sql.Selector)) *OauthProviderTemplateSelect {
+	_s.modifiers = append(_s.modifiers, modifiers...)
+	return _s
+}
@@ -0,0 +1,1169 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/coder-lulu/newbee-core/rpc/ent/oauthprovider"
+	"github.com/coder-lulu/newbee-core/rpc/ent/oauthprovidertemplate"
+	"github.com/coder-lulu/newbee-core/rpc/ent/predicate"
+)
+
+// OauthProviderTemplateUpdate is the builder for updating OauthProviderTemplate entities.
+type OauthProviderTemplateUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *OauthProviderTemplateMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the OauthProviderTemplateUpdate builder.
+func (_u *OauthProviderTemplateUpdate) Where(ps ...predicate.OauthProviderTemplate) *OauthProviderTemplateUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *OauthProviderTemplateUpdate) SetUpdatedAt(v time.Time) *OauthProviderTemplateUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *OauthProviderTemplateUpdate) SetStatus(v uint8) *OauthProviderTemplateUpdate {
+	_u.mutation.ResetStatus()
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *OauthProviderTemplateUpdate) SetNillableStatus(v *uint8) *OauthProviderTemplateUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// AddStatus adds value to the "status" field.
+func (_u *OauthProviderTemplateUpdate) AddStatus(v int8) *OauthProviderTemplateUpdate {
+	_u.mutation.AddStatus(v)
+	return _u
+}
+
+// ClearStatus clears the value of the "status" field.
+func (_u *OauthProviderTemplateUpdate) ClearStatus() *OauthProviderTemplateUpdate {
+	_u.mutation.ClearStatus()
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *OauthProviderTemplateUpdate) SetName(v string) *OauthProviderTemplateUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *OauthProviderTemplateUpdate) SetNillableName(v *string) *OauthProviderTemplateUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetDisplayName sets the "display_name" field.
+func (_u *OauthProviderTemplateUpdate) SetDisplayName(v string) *OauthProviderTemplateUpdate {
+	_u.mutation.SetDisplayName(v)
+	return _u
+}
+
+// SetNillableDisplayName sets the "display_name" field if the given value is not nil.
+func (_u *OauthProviderTemplateUpdate) SetNillableDisplayName(v *string) *OauthProviderTemplateUpdate {
+	if v != nil {
+		_u.SetDisplayName(*v)
+	}
+	return _u
+}
+
+// ClearDisplayName clears the value of the "display_name" field.
+func (_u *OauthProviderTemplateUpdate) ClearDisplayName() *OauthProviderTemplateUpdate {
+	_u.mutation.ClearDisplayName()
+	return _u
+}
+
+// SetType sets the "type" field.
+func (_u *OauthProviderTemplateUpdate) SetType(v string) *OauthProviderTemplateUpdate {
+	_u.mutation.SetType(v)
+	return _u
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (_u *OauthProviderTemplateUpdate) SetNillableType(v *string) *OauthProviderTemplateUpdate {
+	if v != nil {
+		_u.SetType(*v)
+	}
+	return _u
+}
+
+// SetProviderType sets the "provider_type" field.
+func (_u *OauthProviderTemplateUpdate) SetProviderType(v string) *OauthProviderTemplateUpdate {
+	_u.mutation.SetProviderType(v)
+	return _u
+}
+
+// SetNillableProviderType sets the "provider_type" field if the given value is not nil.
+func (_u *OauthProviderTemplateUpdate) SetNillableProviderType(v *string) *OauthProviderTemplateUpdate {
+	if v != nil {
+		_u.SetProviderType(*v)
+	}
+	return _u
+}
+
+// SetScopes sets the "scopes" field.
+func (_u *OauthProviderTemplateUpdate) SetScopes(v string) *OauthProviderTemplateUpdate {
+	_u.mutation.SetScopes(v)
+	return _u
+}
+
+// SetNillableScopes sets the "scopes" field if the given value is not nil.
+func (_u *OauthProviderTemplateUpdate) SetNillableScopes(v *string) *OauthProviderTemplateUpdate {
+	if v != nil {
+		_u.SetScopes(*v)
+	}
+	return _u
+}
+
+// ClearScopes clears the value of the "scopes" field.
+func (_u *OauthProviderTemplateUpdate) ClearScopes() *OauthProviderTemplateUpdate {
+	_u.mutation.ClearScopes()
+	return _u
+}
+
+// SetAuthURL sets the "auth_url" field.
+func (_u *OauthProviderTemplateUpdate) SetAuthURL(v string) *OauthProviderTemplateUpdate {
+	_u.mutation.SetAuthURL(v)
+	return _u
+}
+
+// SetNillableAuthURL sets the "auth_url" field if the given value is not nil.
+func (_u *OauthProviderTemplateUpdate) SetNillableAuthURL(v *string) *OauthProviderTemplateUpdate {
+	if v != nil {
+		_u.SetAuthURL(*v)
+	}
+	return _u
+}
+
+// SetTokenURL sets the "token_url" field.
+func (_u *OauthProviderTemplateUpdate) SetTokenURL(v string) *OauthProviderTemplateUpdate {
+	_u.mutation.SetTokenURL(v)
+	return _u
+}
+
+// SetNillableTokenURL sets the "token_url" field if the given value is not nil.
+func (_u *OauthProviderTemplateUpdate) SetNillableTokenURL(v *string) *OauthProviderTemplateUpdate {
+	if v != nil {
+		_u.SetTokenURL(*v)
+	}
+	return _u
+}
+
+// SetInfoURL sets the "info_url" field.
+func (_u *OauthProviderTemplateUpdate) SetInfoURL(v string) *OauthProviderTemplateUpdate {
+	_u.mutation.SetInfoURL(v)
+	return _u
+}
+
+// SetNillableInfoURL sets the "info_url" field if the given value is not nil.
+func (_u *OauthProviderTemplateUpdate) SetNillableInfoURL(v *string) *OauthProviderTemplateUpdate {
+	if v != nil {
+		_u.SetInfoURL(*v)
+	}
+	return _u
+}
+
+// SetAuthStyle sets the "auth_style" field.
+func (_u *OauthProviderTemplateUpdate) SetAuthStyle(v int) *OauthProviderTemplateUpdate {
+	_u.mutation.ResetAuthStyle()
+	_u.mutation.SetAuthStyle(v)
+	return _u
+}
+
+// SetNillableAuthStyle sets the "auth_style" field if the given value is not nil.
+func (_u *OauthProviderTemplateUpdate) SetNillableAuthStyle(v *int) *OauthProviderTemplateUpdate {
+	if v != nil {
+		_u.SetAuthStyle(*v)
+	}
+	return _u
+}
+
+// AddAuthStyle adds value to the "auth_style" field.
+func (_u *OauthProviderTemplateUpdate) AddAuthStyle(v int) *OauthProviderTemplateUpdate {
+	_u.mutation.AddAuthStyle(v)
+	return _u
+}
+
+// SetExtraConfig sets the "extra_config" field.
+func (_u *OauthProviderTemplateUpdate) SetExtraConfig(v map[string]interface{}) *OauthProviderTemplateUpdate {
+	_u.mutation.SetExtraConfig(v)
+	return _u
+}
+
+// ClearExtraConfig clears the value of the "extra_config" field.
+func (_u *OauthProviderTemplateUpdate) ClearExtraConfig() *OauthProviderTemplateUpdate {
+	_u.mutation.ClearExtraConfig()
+	return _u
+}
+
+// SetSupportPkce sets the "support_pkce" field.
+func (_u *OauthProviderTemplateUpdate) SetSupportPkce(v bool) *OauthProviderTemplateUpdate {
+	_u.mutation.SetSupportPkce(v)
+	return _u
+}
+
+// SetNillableSupportPkce sets the "support_pkce" field if the given value is not nil.
+func (_u *OauthProviderTemplateUpdate) SetNillableSupportPkce(v *bool) *OauthProviderTemplateUpdate {
+	if v != nil {
+		_u.SetSupportPkce(*v)
+	}
+	return _u
+}
+
+// SetIconURL sets the "icon_url" field.
+func (_u *OauthProviderTemplateUpdate) SetIconURL(v string) *OauthProviderTemplateUpdate {
+	_u.mutation.SetIconURL(v)
+	return _u
+}
+
+// SetNillableIconURL sets the "icon_url" field if the given value is not nil.
+func (_u *OauthProviderTemplateUpdate) SetNillableIconURL(v *string) *OauthProviderTemplateUpdate {
+	if v != nil {
+		_u.SetIconURL(*v)
+	}
+	return _u
+}
+
+// ClearIconURL clears the value of the "icon_url" field.
+func (_u *OauthProviderTemplateUpdate) ClearIconURL() *OauthProviderTemplateUpdate {
+	_u.mutation.ClearIconURL()
+	return _u
+}
+
+// SetSort sets the "sort" field.
+func (_u *OauthProviderTemplateUpdate) SetSort(v uint32) *OauthProviderTemplateUpdate {
+	_u.mutation.ResetSort()
+	_u.mutation.SetSort(v)
+	return _u
+}
+
+// SetNillableSort sets the "sort" field if the given value is not nil.
+func (_u *OauthProviderTemplateUpdate) SetNillableSort(v *uint32) *OauthProviderTemplateUpdate {
+	if v != nil {
+		_u.SetSort(*v)
+	}
+	return _u
+}
+
+// AddSort adds value to the "sort" field.
+func (_u *OauthProviderTemplateUpdate) AddSort(v int32) *OauthProviderTemplateUpdate {
+	_u.mutation.AddSort(v)
+	return _u
+}
+
+// SetRemark sets the "remark" field.
+func (_u *OauthProviderTemplateUpdate) SetRemark(v string) *OauthProviderTemplateUpdate {
+	_u.mutation.SetRemark(v)
+	return _u
+}
+
+// SetNillableRemark sets the "remark" field if the given value is not nil.
+func (_u *OauthProviderTemplateUpdate) SetNillableRemark(v *string) *OauthProviderTemplateUpdate {
+	if v != nil {
+		_u.SetRemark(*v)
+	}
+	return _u
+}
+
+// ClearRemark clears the value of the "remark" field.
+func (_u *OauthProviderTemplateUpdate) ClearRemark() *OauthProviderTemplateUpdate {
+	_u.mutation.ClearRemark()
+	return _u
+}
+
+// AddOauthProviderIDs adds the "oauth_providers" edge to the OauthProvider entity by IDs.
+func (_u *OauthProviderTemplateUpdate) AddOauthProviderIDs(ids ...uint64) *OauthProviderTemplateUpdate {
+	_u.mutation.AddOauthProviderIDs(ids...)
+	return _u
+}
+
+// AddOauthProviders adds the "oauth_providers" edges to the OauthProvider entity.
+func (_u *OauthProviderTemplateUpdate) AddOauthProviders(v ...*OauthProvider) *OauthProviderTemplateUpdate {
+	ids := make([]uint64, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddOauthProviderIDs(ids...)
+}
+
+// Mutation returns the OauthProviderTemplateMutation object of the builder.
+func (_u *OauthProviderTemplateUpdate) Mutation() *OauthProviderTemplateMutation {
+	return _u.mutation
+}
+
+// ClearOauthProviders clears all "oauth_providers" edges to the OauthProvider entity.
+func (_u *OauthProviderTemplateUpdate) ClearOauthProviders() *OauthProviderTemplateUpdate {
+	_u.mutation.ClearOauthProviders()
+	return _u
+}
+
+// RemoveOauthProviderIDs removes the "oauth_providers" edge to OauthProvider entities by IDs.
+func (_u *OauthProviderTemplateUpdate) RemoveOauthProviderIDs(ids ...uint64) *OauthProviderTemplateUpdate {
+	_u.mutation.RemoveOauthProviderIDs(ids...)
+	return _u
+}
+
+// RemoveOauthProviders removes "oauth_providers" edges to OauthProvider entities.
+func (_u *OauthProviderTemplateUpdate) RemoveOauthProviders(v ...*OauthProvider) *OauthProviderTemplateUpdate {
+	ids := make([]uint64, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveOauthProviderIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *OauthProviderTemplateUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *OauthProviderTemplateUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *OauthProviderTemplateUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *OauthProviderTemplateUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *OauthProviderTemplateUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := oauthprovidertemplate.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *OauthProviderTemplateUpdate) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := oauthprovidertemplate.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "OauthProviderTemplate.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DisplayName(); ok {
+		if err := oauthprovidertemplate.DisplayNameValidator(v); err != nil {
+			return &ValidationError{Name: "display_name", err: fmt.Errorf(`ent: validator failed for field "OauthProviderTemplate.display_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.GetType(); ok {
+		if err := oauthprovidertemplate.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "OauthProviderTemplate.type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ProviderType(); ok {
+		if err := oauthprovidertemplate.ProviderTypeValidator(v); err != nil {
+			return &ValidationError{Name: "provider_type", err: fmt.Errorf(`ent: validator failed for field "OauthProviderTemplate.provider_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Scopes(); ok {
+		if err := oauthprovidertemplate.ScopesValidator(v); err != nil {
+			return &ValidationError{Name: "scopes", err: fmt.Errorf(`ent: validator failed for field "OauthProviderTemplate.scopes": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AuthURL(); ok {
+		if err := oauthprovidertemplate.AuthURLValidator(v); err != nil {
+			return &ValidationError{Name: "auth_url", err: fmt.Errorf(`ent: validator failed for field "OauthProviderTemplate.auth_url": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TokenURL(); ok {
+		if err := oauthprovidertemplate.TokenURLValidator(v); err != nil {
+			return &ValidationError{Name: "token_url", err: fmt.Errorf(`ent: validator failed for field "OauthProviderTemplate.token_url": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.InfoURL(); ok {
+		if err := oauthprovidertemplate.InfoURLValidator(v); err != nil {
+			return &ValidationError{Name: "info_url", err: fmt.Errorf(`ent: validator failed for field "OauthProviderTemplate.info_url": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.IconURL(); ok {
+		if err := oauthprovidertemplate.IconURLValidator(v); err != nil {
+			return &ValidationError{Name: "icon_url", err: fmt.Errorf(`ent: validator failed for field "OauthProviderTemplate.icon_url": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Remark(); ok {
+		if err := oauthprovidertemplate.RemarkValidator(v); err != nil {
+			return &ValidationError{Name: "remark", err: fmt.Errorf(`ent: validator failed for field "OauthProviderTemplate.remark": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *OauthProviderTemplateUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *OauthProviderTemplateUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *OauthProviderTemplateUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(oauthprovidertemplate.Table, oauthprovidertemplate.Columns, sqlgraph.NewFieldSpec(oauthprovidertemplate.FieldID, field.TypeUint64))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(oauthprovidertemplate.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(oauthprovidertemplate.FieldStatus, field.TypeUint8, value)
+	}
+	if value, ok := _u.mutation.AddedStatus(); ok {
+		_spec.AddField(oauthprovidertemplate.FieldStatus, field.TypeUint8, value)
+	}
+	if _u.mutation.StatusCleared() {
+		_spec.ClearField(oauthprovidertemplate.FieldStatus, field.TypeUint8)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(oauthprovidertemplate.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.DisplayName(); ok {
+		_spec.SetField(oauthprovidertemplate.FieldDisplayName, field.TypeString, value)
+	}
+	if _u.mutation.DisplayNameCleared() {
+		_spec.ClearField(oauthprovidertemplate.FieldDisplayName, field.TypeString)
+	}
+	if value, ok := _u.mutation.GetType(); ok {
+		_spec.SetField(oauthprovidertemplate.FieldType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ProviderType(); ok {
+		_spec.SetField(oauthprovidertemplate.FieldProviderType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Scopes(); ok {
+		_spec.SetField(oauthprovidertemplate.FieldScopes, field.TypeString, value)
+	}
+	if _u.mutation.ScopesCleared() {
+		_spec.ClearField(oauthprovidertemplate.FieldScopes, field.TypeString)
+	}
+	if value, ok := _u.mutation.AuthURL(); ok {
+		_spec.SetField(oauthprovidertemplate.FieldAuthURL, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TokenURL(); ok {
+		_spec.SetField(oauthprovidertemplate.FieldTokenURL, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.InfoURL(); ok {
+		_spec.SetField(oauthprovidertemplate.FieldInfoURL, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AuthStyle(); ok {
+		_spec.SetField(oauthprovidertemplate.FieldAuthStyle, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAuthStyle(); ok {
+		_spec.AddField(oauthprovidertemplate.FieldAuthStyle, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ExtraConfig(); ok {
+		_spec.SetField(oauthprovidertemplate.FieldExtraConfig, field.TypeJSON, value)
+	}
+	if _u.mutation.ExtraConfigCleared() {
+		_spec.ClearField(oauthprovidertemplate.FieldExtraConfig, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.SupportPkce(); ok {
+		_spec.SetField(oauthprovidertemplate.FieldSupportPkce, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.IconURL(); ok {
+		_spec.SetField(oauthprovidertemplate.FieldIconURL, field.TypeString, value)
+	}
+	if _u.mutation.IconURLCleared() {
+		_spec.ClearField(oauthprovidertemplate.FieldIconURL, field.TypeString)
+	}
+	if value, ok := _u.mutation.Sort(); ok {
+		_spec.SetField(oauthprovidertemplate.FieldSort, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedSort(); ok {
+		_spec.AddField(oauthprovidertemplate.FieldSort, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.Remark(); ok {
+		_spec.SetField(oauthprovidertemplate.FieldRemark, field.TypeString, value)
+	}
+	if _u.mutation.RemarkCleared() {
+		_spec.ClearField(oauthprovidertemplate.FieldRemark, field.TypeString)
+	}
+	if _u.mutation.OauthProvidersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   oauthprovidertemplate.OauthProvidersTable,
+			Columns: []string{oauthprovidertemplate.OauthProvidersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(oauthprovider.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedOauthProvidersIDs(); len(nodes) > 0 && !_u.mutation.OauthProvidersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   oauthprovidertemplate.OauthProvidersTable,
+			Columns: []string{oauthprovidertemplate.OauthProvidersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(oauthprovider.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OauthProvidersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   oauthprovidertemplate.OauthProvidersTable,
+			Columns: []string{oauthprovidertemplate.OauthProvidersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(oauthprovider.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{oauthprovidertemplate.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// OauthProviderTemplateUpdateOne is the builder for updating a single OauthProviderTemplate entity.
+type OauthProviderTemplateUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *OauthProviderTemplateMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *OauthProviderTemplateUpdateOne) SetUpdatedAt(v time.Time) *OauthProviderTemplateUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *OauthProviderTemplateUpdateOne) SetStatus(v uint8) *OauthProviderTemplateUpdateOne {
+	_u.mutation.ResetStatus()
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *OauthProviderTemplateUpdateOne) SetNillableStatus(v *uint8) *OauthProviderTemplateUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// AddStatus adds value to the "status" field.
+func (_u *OauthProviderTemplateUpdateOne) AddStatus(v int8) *OauthProviderTemplateUpdateOne {
+	_u.mutation.AddStatus(v)
+	return _u
+}
+
+// ClearStatus clears the value of the "status" field.
+func (_u *OauthProviderTemplateUpdateOne) ClearStatus() *OauthProviderTemplateUpdateOne {
+	_u.mutation.ClearStatus()
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *OauthProviderTemplateUpdateOne) SetName(v string) *OauthProviderTemplateUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *OauthProviderTemplateUpdateOne) SetNillableName(v *string) *OauthProviderTemplateUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetDisplayName sets the "display_name" field.
+func (_u *OauthProviderTemplateUpdateOne) SetDisplayName(v string) *OauthProviderTemplateUpdateOne {
+	_u.mutation.SetDisplayName(v)
+	return _u
+}
+
+// SetNillableDisplayName sets the "display_name" field if the given value is not nil.
+func (_u *OauthProviderTemplateUpdateOne) SetNillableDisplayName(v *string) *OauthProviderTemplateUpdateOne {
+	if v != nil {
+		_u.SetDisplayName(*v)
+	}
+	return _u
+}
+
+// ClearDisplayName clears the value of the "display_name" field.
+func (_u *OauthProviderTemplateUpdateOne) ClearDisplayName() *OauthProviderTemplateUpdateOne {
+	_u.mutation.ClearDisplayName()
+	return _u
+}
+
+// SetType sets the "type" field.
+func (_u *OauthProviderTemplateUpdateOne) SetType(v string) *OauthProviderTemplateUpdateOne {
+	_u.mutation.SetType(v)
+	return _u
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (_u *OauthProviderTemplateUpdateOne) SetNillableType(v *string) *OauthProviderTemplateUpdateOne {
+	if v != nil {
+		_u.SetType(*v)
+	}
+	return _u
+}
+
+// SetProviderType sets the "provider_type" field.
+func (_u *OauthProviderTemplateUpdateOne) SetProviderType(v string) *OauthProviderTemplateUpdateOne {
+	_u.mutation.SetProviderType(v)
+	return _u
+}
+
+// SetNillableProviderType sets the "provider_type" field if the given value is not nil.
+func (_u *OauthProviderTemplateUpdateOne) SetNillableProviderType(v *string) *OauthProviderTemplateUpdateOne {
+	if v != nil {
+		_u.SetProviderType(*v)
+	}
+	return _u
+}
+
+// SetScopes sets the "scopes" field.
+func (_u *OauthProviderTemplateUpdateOne) SetScopes(v string) *OauthProviderTemplateUpdateOne {
+	_u.mutation.SetScopes(v)
+	return _u
+}
+
+// SetNillableScopes sets the "scopes" field if the given value is not nil.
+func (_u *OauthProviderTemplateUpdateOne) SetNillableScopes(v *string) *OauthProviderTemplateUpdateOne {
+	if v != nil {
+		_u.SetScopes(*v)
+	}
+	return _u
+}
+
+// ClearScopes clears the value of the "scopes" field.
+func (_u *OauthProviderTemplateUpdateOne) ClearScopes() *OauthProviderTemplateUpdateOne {
+	_u.mutation.ClearScopes()
+	return _u
+}
+
+// SetAuthURL sets the "auth_url" field.
+func (_u *OauthProviderTemplateUpdateOne) SetAuthURL(v string) *OauthProviderTemplateUpdateOne {
+	_u.mutation.SetAuthURL(v)
+	return _u
+}
+
+// SetNillableAuthURL sets the "auth_url" field if the given value is not nil.
+func (_u *OauthProviderTemplateUpdateOne) SetNillableAuthURL(v *string) *OauthProviderTemplateUpdateOne {
+	if v != nil {
+		_u.SetAuthURL(*v)
+	}
+	return _u
+}
+
+// SetTokenURL sets the "token_url" field.
+func (_u *OauthProviderTemplateUpdateOne) SetTokenURL(v string) *OauthProviderTemplateUpdateOne {
+	_u.mutation.SetTokenURL(v)
+	return _u
+}
+
+// SetNillableTokenURL sets the "token_url" field if the given value is not nil.
+func (_u *OauthProviderTemplateUpdateOne) SetNillableTokenURL(v *string) *OauthProviderTemplateUpdateOne {
+	if v != nil {
+		_u.SetTokenURL(*v)
+	}
+	return _u
+}
+
+// SetInfoURL sets the "info_url" field.
+func (_u *OauthProviderTemplateUpdateOne) SetInfoURL(v string) *OauthProviderTemplateUpdateOne {
+	_u.mutation.SetInfoURL(v)
+	return _u
+}
+
+// SetNillableInfoURL sets the "info_url" field if the given value is not nil.
+func (_u *OauthProviderTemplateUpdateOne) SetNillableInfoURL(v *string) *OauthProviderTemplateUpdateOne {
+	if v != nil {
+		_u.SetInfoURL(*v)
+	}
+	return _u
+}
+
+// SetAuthStyle sets the "auth_style" field.
+func (_u *OauthProviderTemplateUpdateOne) SetAuthStyle(v int) *OauthProviderTemplateUpdateOne {
+	_u.mutation.ResetAuthStyle()
+	_u.mutation.SetAuthStyle(v)
+	return _u
+}
+
+// SetNillableAuthStyle sets the "auth_style" field if the given value is not nil.
+func (_u *OauthProviderTemplateUpdateOne) SetNillableAuthStyle(v *int) *OauthProviderTemplateUpdateOne {
+	if v != nil {
+		_u.SetAuthStyle(*v)
+	}
+	return _u
+}
+
+// AddAuthStyle adds value to the "auth_style" field.
+func (_u *OauthProviderTemplateUpdateOne) AddAuthStyle(v int) *OauthProviderTemplateUpdateOne {
+	_u.mutation.AddAuthStyle(v)
+	return _u
+}
+
+// SetExtraConfig sets the "extra_config" field.
+func (_u *OauthProviderTemplateUpdateOne) SetExtraConfig(v map[string]interface{}) *OauthProviderTemplateUpdateOne {
+	_u.mutation.SetExtraConfig(v)
+	return _u
+}
+
+// ClearExtraConfig clears the value of the "extra_config" field.
+func (_u *OauthProviderTemplateUpdateOne) ClearExtraConfig() *OauthProviderTemplateUpdateOne {
+	_u.mutation.ClearExtraConfig()
+	return _u
+}
+
+// SetSupportPkce sets the "support_pkce" field.
+func (_u *OauthProviderTemplateUpdateOne) SetSupportPkce(v bool) *OauthProviderTemplateUpdateOne {
+	_u.mutation.SetSupportPkce(v)
+	return _u
+}
+
+// SetNillableSupportPkce sets the "support_pkce" field if the given value is not nil.
+func (_u *OauthProviderTemplateUpdateOne) SetNillableSupportPkce(v *bool) *OauthProviderTemplateUpdateOne {
+	if v != nil {
+		_u.SetSupportPkce(*v)
+	}
+	return _u
+}
+
+// SetIconURL sets the "icon_url" field.
+func (_u *OauthProviderTemplateUpdateOne) SetIconURL(v string) *OauthProviderTemplateUpdateOne {
+	_u.mutation.SetIconURL(v)
+	return _u
+}
+
+// SetNillableIconURL sets the "icon_url" field if the given value is not nil.
+func (_u *OauthProviderTemplateUpdateOne) SetNillableIconURL(v *string) *OauthProviderTemplateUpdateOne {
+	if v != nil {
+		_u.SetIconURL(*v)
+	}
+	return _u
+}
+
+// ClearIconURL clears the value of the "icon_url" field.
+func (_u *OauthProviderTemplateUpdateOne) ClearIconURL() *OauthProviderTemplateUpdateOne {
+	_u.mutation.ClearIconURL()
+	return _u
+}
+
+// SetSort sets the "sort" field.
+func (_u *OauthProviderTemplateUpdateOne) SetSort(v uint32) *OauthProviderTemplateUpdateOne {
+	_u.mutation.ResetSort()
+	_u.mutation.SetSort(v)
+	return _u
+}
+
+// SetNillableSort sets the "sort" field if the given value is not nil.
+func (_u *OauthProviderTemplateUpdateOne) SetNillableSort(v *uint32) *OauthProviderTemplateUpdateOne {
+	if v != nil {
+		_u.SetSort(*v)
+	}
+	return _u
+}
+
+// AddSort adds value to the "sort" field.
+func (_u *OauthProviderTemplateUpdateOne) AddSort(v int32) *OauthProviderTemplateUpdateOne {
+	_u.mutation.AddSort(v)
+	return _u
+}
+
+// SetRemark sets the "remark" field.
+func (_u *OauthProviderTemplateUpdateOne) SetRemark(v string) *OauthProviderTemplateUpdateOne {
+	_u.mutation.SetRemark(v)
+	return _u
+}
+
+// SetNillableRemark sets the "remark" field if the given value is not nil.
+func (_u *OauthProviderTemplateUpdateOne) SetNillableRemark(v *string) *OauthProviderTemplateUpdateOne {
+	if v != nil {
+		_u.SetRemark(*v)
+	}
+	return _u
+}
+
+// ClearRemark clears the value of the "remark" field.
+func (_u *OauthProviderTemplateUpdateOne) ClearRemark() *OauthProviderTemplateUpdateOne {
+	_u.mutation.ClearRemark()
+	return _u
+}
+
+// AddOauthProviderIDs adds the "oauth_providers" edge to the OauthProvider entity by IDs.
+func (_u *OauthProviderTemplateUpdateOne) AddOauthProviderIDs(ids ...uint64) *OauthProviderTemplateUpdateOne {
+	_u.mutation.AddOauthProviderIDs(ids...)
+	return _u
+}
+
+// AddOauthProviders adds the "oauth_providers" edges to the OauthProvider entity.
+func (_u *OauthProviderTemplateUpdateOne) AddOauthProviders(v ...*OauthProvider) *OauthProviderTemplateUpdateOne {
+	ids := make([]uint64, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddOauthProviderIDs(ids...)
+}
+
+// Mutation returns the OauthProviderTemplateMutation object of the builder.
+func (_u *OauthProviderTemplateUpdateOne) Mutation() *OauthProviderTemplateMutation {
+	return _u.mutation
+}
+
+// ClearOauthProviders clears all "oauth_providers" edges to the OauthProvider entity.
+func (_u *OauthProviderTemplateUpdateOne) ClearOauthProviders() *OauthProviderTemplateUpdateOne {
+	_u.mutation.ClearOauthProviders()
+	return _u
+}
+
+// RemoveOauthProviderIDs removes the "oauth_providers" edge to OauthProvider entities by IDs.
+func (_u *OauthProviderTemplateUpdateOne) RemoveOauthProviderIDs(ids ...uint64) *OauthProviderTemplateUpdateOne {
+	_u.mutation.RemoveOauthProviderIDs(ids...)
+	return _u
+}
+
+// RemoveOauthProviders removes "oauth_providers" edges to OauthProvider entities.
+func (_u *OauthProviderTemplateUpdateOne) RemoveOauthProviders(v ...*OauthProvider) *OauthProviderTemplateUpdateOne {
+	ids := make([]uint64, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveOauthProviderIDs(ids...)
+}
+
+// Where appends a list predicates to the OauthProviderTemplateUpdate builder.
+func (_u *OauthProviderTemplateUpdateOne) Where(ps ...predicate.OauthProviderTemplate) *OauthProviderTemplateUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *OauthProviderTemplateUpdateOne) Select(field string, fields ...string) *OauthProviderTemplateUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated OauthProviderTemplate entity.
+func (_u *OauthProviderTemplateUpdateOne) Save(ctx context.Context) (*OauthProviderTemplate, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *OauthProviderTemplateUpdateOne) SaveX(ctx context.Context) *OauthProviderTemplate {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *OauthProviderTemplateUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *OauthProviderTemplateUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *OauthProviderTemplateUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := oauthprovidertemplate.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *OauthProviderTemplateUpdateOne) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := oauthprovidertemplate.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "OauthProviderTemplate.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DisplayName(); ok {
+		if err := oauthprovidertemplate.DisplayNameValidator(v); err != nil {
+			return &ValidationError{Name: "display_name", err: fmt.Errorf(`ent: validator failed for field "OauthProviderTemplate.display_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.GetType(); ok {
+		if err := oauthprovidertemplate.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "OauthProviderTemplate.type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ProviderType(); ok {
+		if err := oauthprovidertemplate.ProviderTypeValidator(v); err != nil {
+			return &ValidationError{Name: "provider_type", err: fmt.Errorf(`ent: validator failed for field "OauthProviderTemplate.provider_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Scopes(); ok {
+		if err := oauthprovidertemplate.ScopesValidator(v); err != nil {
+			return &ValidationError{Name: "scopes", err: fmt.Errorf(`ent: validator failed for field "OauthProviderTemplate.scopes": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AuthURL(); ok {
+		if err := oauthprovidertemplate.AuthURLValidator(v); err != nil {
+			return &ValidationError{Name: "auth_url", err: fmt.Errorf(`ent: validator failed for field "OauthProviderTemplate.auth_url": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TokenURL(); ok {
+		if err := oauthprovidertemplate.TokenURLValidator(v); err != nil {
+			return &ValidationError{Name: "token_url", err: fmt.Errorf(`ent: validator failed for field "OauthProviderTemplate.token_url": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.InfoURL(); ok {
+		if err := oauthprovidertemplate.InfoURLValidator(v); err != nil {
+			return &ValidationError{Name: "info_url", err: fmt.Errorf(`ent: validator failed for field "OauthProviderTemplate.info_url": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.IconURL(); ok {
+		if err := oauthprovidertemplate.IconURLValidator(v); err != nil {
+			return &ValidationError{Name: "icon_url", err: fmt.Errorf(`ent: validator failed for field "OauthProviderTemplate.icon_url": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Remark(); ok {
+		if err := oauthprovidertemplate.RemarkValidator(v); err != nil {
+			return &ValidationError{Name: "remark", err: fmt.Errorf(`ent: validator failed for field "OauthProviderTemplate.remark": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *OauthProviderTemplateUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *OauthProviderTemplateUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *OauthProviderTemplateUpdateOne) sqlSave(ctx context.Context) (_node *OauthProviderTemplate, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(oauthprovidertemplate.Table, oauthprovidertemplate.Columns, sqlgraph.NewFieldSpec(oauthprovidertemplate.FieldID, field.TypeUint64))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "OauthProviderTemplate.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, oauthprovidertemplate.FieldID)
+		for _, f := range fields {
+			if !oauthprovidertemplate.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != oauthprovidertemplate.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(oauthprovidertemplate.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(oauthprovidertemplate.FieldStatus, field.TypeUint8, value)
+	}
+	if value, ok := _u.mutation.AddedStatus(); ok {
+		_spec.AddField(oauthprovidertemplate.FieldStatus, field.TypeUint8, value)
+	}
+	if _u.mutation.StatusCleared() {
+		_spec.ClearField(oauthprovidertemplate.FieldStatus, field.TypeUint8)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(oauthprovidertemplate.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.DisplayName(); ok {
+		_spec.SetField(oauthprovidertemplate.FieldDisplayName, field.TypeString, value)
+	}
+	if _u.mutation.DisplayNameCleared() {
+		_spec.ClearField(oauthprovidertemplate.FieldDisplayName, field.TypeString)
+	}
+	if value, ok := _u.mutation.GetType(); ok {
+		_spec.SetField(oauthprovidertemplate.FieldType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ProviderType(); ok {
+		_spec.SetField(oauthprovidertemplate.FieldProviderType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Scopes(); ok {
+		_spec.SetField(oauthprovidertemplate.FieldScopes, field.TypeString, value)
+	}
+	if _u.mutation.ScopesCleared() {
+		_spec.ClearField(oauthprovidertemplate.FieldScopes, field.TypeString)
+	}
+	if value, ok := _u.mutation.AuthURL(); ok {
+		_spec.SetField(oauthprovidertemplate.FieldAuthURL, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TokenURL(); ok {
+		_spec.SetField(oauthprovidertemplate.FieldTokenURL, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.InfoURL(); ok {
+		_spec.SetField(oauthprovidertemplate.FieldInfoURL, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AuthStyle(); ok {
+		_spec.SetField(oauthprovidertemplate.FieldAuthStyle, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAuthStyle(); ok {
+		_spec.AddField(oauthprovidertemplate.FieldAuthStyle, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ExtraConfig(); ok {
+		_spec.SetField(oauthprovidertemplate.FieldExtraConfig, field.TypeJSON, value)
+	}
+	if _u.mutation.ExtraConfigCleared() {
+		_spec.ClearField(oauthprovidertemplate.FieldExtraConfig, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.SupportPkce(); ok {
+		_spec.SetField(oauthprovidertemplate.FieldSupportPkce, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.IconURL(); ok {
+		_spec.SetField(oauthprovidertemplate.FieldIconURL, field.TypeString, value)
+	}
+	if _u.mutation.IconURLCleared() {
+		_spec.ClearField(oauthprovidertemplate.FieldIconURL, field.TypeString)
+	}
+	if value, ok := _u.mutation.Sort(); ok {
+		_spec.SetField(oauthprovidertemplate.FieldSort, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedSort(); ok {
+		_spec.AddField(oauthprovidertemplate.FieldSort, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.Remark(); ok {
+		_spec.SetField(oauthprovidertemplate.FieldRemark, field.TypeString, value)
+	}
+	if _u.mutation.RemarkCleared() {
+		_spec.ClearField(oauthprovidertemplate.FieldRemark, field.TypeString)
+	}
+	if _u.mutation.OauthProvidersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   oauthprovidertemplate.OauthProvidersTable,
+			Columns: []string{oauthprovidertemplate.OauthProvidersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(oauthprovider.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedOauthProvidersIDs(); len(nodes) > 0 && !_u.mutation.OauthProvidersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   oauthprovidertemplate.OauthProvidersTable,
+			Columns: []string{oauthprovidertemplate.OauthProvidersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(oauthprovider.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OauthProvidersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   oauthprovidertemplate.OauthProvidersTable,
+			Columns: []string{oauthprovidertemplate.OauthProvidersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(oauthprovider.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &OauthProviderTemplate{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{oauthprovidertemplate.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,481 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/coder-lulu/newbee-core/rpc/ent/oauthscope"
+	"github.com/coder-lulu/newbee-core/rpc/ent/predicate"
+)
+
+// OauthScopeUpdate is the builder for updating OauthScope entities.
+type OauthScopeUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *OauthScopeMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the OauthScopeUpdate builder.
+func (_u *OauthScopeUpdate) Where(ps ...predicate.OauthScope) *OauthScopeUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *OauthScopeUpdate) SetUpdatedAt(v time.Time) *OauthScopeUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *OauthScopeUpdate) SetStatus(v uint8) *OauthScopeUpdate {
+	_u.mutation.ResetStatus()
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *OauthScopeUpdate) SetNillableStatus(v *uint8) *OauthScopeUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// AddStatus adds value to the "status" field.
+func (_u *OauthScopeUpdate) AddStatus(v int8) *OauthScopeUpdate {
+	_u.mutation.AddStatus(v)
+	return _u
+}
+
+// ClearStatus clears the value of the "status" field.
+func (_u *OauthScopeUpdate) ClearStatus() *OauthScopeUpdate {
+	_u.mutation.ClearStatus()
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *OauthScopeUpdate) SetName(v string) *OauthScopeUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *OauthScopeUpdate) SetNillableName(v *string) *OauthScopeUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *OauthScopeUpdate) SetDescription(v string) *OauthScopeUpdate {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *OauthScopeUpdate) SetNillableDescription(v *string) *OauthScopeUpdate {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *OauthScopeUpdate) ClearDescription() *OauthScopeUpdate {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetAPIIds sets the "api_ids" field.
+func (_u *OauthScopeUpdate) SetAPIIds(v []uint64) *OauthScopeUpdate {
+	_u.mutation.SetAPIIds(v)
+	return _u
+}
+
+// AppendAPIIds appends value to the "api_ids" field.
+func (_u *OauthScopeUpdate) AppendAPIIds(v []uint64) *OauthScopeUpdate {
+	_u.mutation.AppendAPIIds(v)
+	return _u
+}
+
+// ClearAPIIds clears the value of the "api_ids" field.
+func (_u *OauthScopeUpdate) ClearAPIIds() *OauthScopeUpdate {
+	_u.mutation.ClearAPIIds()
+	return _u
+}
+
+// Mutation returns the OauthScopeMutation object of the builder.
+func (_u *OauthScopeUpdate) Mutation() *OauthScopeMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *OauthScopeUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *OauthScopeUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *OauthScopeUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *OauthScopeUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *OauthScopeUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := oauthscope.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *OauthScopeUpdate) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := oauthscope.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "OauthScope.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Description(); ok {
+		if err := oauthscope.DescriptionValidator(v); err != nil {
+			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "OauthScope.description": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *OauthScopeUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *OauthScopeUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *OauthScopeUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(oauthscope.Table, oauthscope.Columns, sqlgraph.NewFieldSpec(oauthscope.FieldID, field.TypeUint64))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(oauthscope.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(oauthscope.FieldStatus, field.TypeUint8, value)
+	}
+	if value, ok := _u.mutation.AddedStatus(); ok {
+		_spec.AddField(oauthscope.FieldStatus, field.TypeUint8, value)
+	}
+	if _u.mutation.StatusCleared() {
+		_spec.ClearField(oauthscope.FieldStatus, field.TypeUint8)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(oauthscope.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(oauthscope.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(oauthscope.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.APIIds(); ok {
+		_spec.SetField(oauthscope.FieldAPIIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAPIIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, oauthscope.FieldAPIIds, value)
+		})
+	}
+	if _u.mutation.APIIdsCleared() {
+		_spec.ClearField(oauthscope.FieldAPIIds, field.TypeJSON)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{oauthscope.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// OauthScopeUpdateOne is the builder for updating a single OauthScope entity.
+type OauthScopeUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *OauthScopeMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *OauthScopeUpdateOne) SetUpdatedAt(v time.Time) *OauthScopeUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *OauthScopeUpdateOne) SetStatus(v uint8) *OauthScopeUpdateOne {
+	_u.mutation.ResetStatus()
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *OauthScopeUpdateOne) SetNillableStatus(v *uint8) *OauthScopeUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// AddStatus adds value to the "status" field.
+func (_u *OauthScopeUpdateOne) AddStatus(v int8) *OauthScopeUpdateOne {
+	_u.mutation.AddStatus(v)
+	return _u
+}
+
+// ClearStatus clears the value of the "status" field.
+func (_u *OauthScopeUpdateOne) ClearStatus() *OauthScopeUpdateOne {
+	_u.mutation.ClearStatus()
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *OauthScopeUpdateOne) SetName(v string) *OauthScopeUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *OauthScopeUpdateOne) SetNillableName(v *string) *OauthScopeUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *OauthScopeUpdateOne) SetDescription(v string) *OauthScopeUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *OauthScopeUpdateOne) SetNillableDescription(v *string) *OauthScopeUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *OauthScopeUpdateOne) ClearDescription() *OauthScopeUpdateOne {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetAPIIds sets the "api_ids" field.
+func (_u *OauthScopeUpdateOne) SetAPIIds(v []uint64) *OauthScopeUpdateOne {
+	_u.mutation.SetAPIIds(v)
+	return _u
+}
+
+// AppendAPIIds appends value to the "api_ids" field.
+func (_u *OauthScopeUpdateOne) AppendAPIIds(v []uint64) *OauthScopeUpdateOne {
+	_u.mutation.AppendAPIIds(v)
+	return _u
+}
+
+// ClearAPIIds clears the value of the "api_ids" field.
+func (_u *OauthScopeUpdateOne) ClearAPIIds() *OauthScopeUpdateOne {
+	_u.mutation.ClearAPIIds()
+	return _u
+}
+
+// Mutation returns the OauthScopeMutation object of the builder.
+func (_u *OauthScopeUpdateOne) Mutation() *OauthScopeMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the OauthScopeUpdate builder.
+func (_u *OauthScopeUpdateOne) Where(ps ...predicate.OauthScope) *OauthScopeUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *OauthScopeUpdateOne) Select(field string, fields ...string) *OauthScopeUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated OauthScope entity.
+func (_u *OauthScopeUpdateOne) Save(ctx context.Context) (*OauthScope, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *OauthScopeUpdateOne) SaveX(ctx context.Context) *OauthScope {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *OauthScopeUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *OauthScopeUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *OauthScopeUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := oauthscope.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *OauthScopeUpdateOne) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := oauthscope.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "OauthScope.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Description(); ok {
+		if err := oauthscope.DescriptionValidator(v); err != nil {
+			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "OauthScope.description": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *OauthScopeUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *OauthScopeUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *OauthScopeUpdateOne) sqlSave(ctx context.Context) (_node *OauthScope, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(oauthscope.Table, oauthscope.Columns, sqlgraph.NewFieldSpec(oauthscope.FieldID, field.TypeUint64))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "OauthScope.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, oauthscope.FieldID)
+		for _, f := range fields {
+			if !oauthscope.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != oauthscope.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(oauthscope.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(oauthscope.FieldStatus, field.TypeUint8, value)
+	}
+	if value, ok := _u.mutation.AddedStatus(); ok {
+		_spec.AddField(oauthscope.FieldStatus, field.TypeUint8, value)
+	}
+	if _u.mutation.StatusCleared() {
+		_spec.ClearField(oauthscope.FieldStatus, field.TypeUint8)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(oauthscope.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(oauthscope.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(oauthscope.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.APIIds(); ok {
+		_spec.SetField(oauthscope.FieldAPIIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAPIIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, oauthscope.FieldAPIIds, value)
+		})
+	}
+	if _u.mutation.APIIdsCleared() {
+		_spec.ClearField(oauthscope.FieldAPIIds, field.TypeJSON)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &OauthScope{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{oauthscope.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -8,17 +8,36 @@ import (
 
 	"github.com/coder-lulu/newbee-core/rpc/ent/api"
 	"github.com/coder-lulu/newbee-core/rpc/ent/auditlog"
+	"github.com/coder-lulu/newbee-core/rpc/ent/auditlogarchive"
+	"github.com/coder-lulu/newbee-core/rpc/ent/auditlogchain"
+	"github.com/coder-lulu/newbee-core/rpc/ent/auditlogcheckpoint"
+	"github.com/coder-lulu/newbee-core/rpc/ent/auditlogprunerecord"
 	"github.com/coder-lulu/newbee-core/rpc/ent/configuration"
 	"github.com/coder-lulu/newbee-core/rpc/ent/department"
 	"github.com/coder-lulu/newbee-core/rpc/ent/dictionary"
 	"github.com/coder-lulu/newbee-core/rpc/ent/dictionarydetail"
+	"github.com/coder-lulu/newbee-core/rpc/ent/impersonationsession"
+	"github.com/coder-lulu/newbee-core/rpc/ent/ldapaccount"
+	"github.com/coder-lulu/newbee-core/rpc/ent/ldapdepartment"
+	"github.com/coder-lulu/newbee-core/rpc/ent/ldapprovider"
+	"github.com/coder-lulu/newbee-core/rpc/ent/ldapsyncrun"
 	"github.com/coder-lulu/newbee-core/rpc/ent/menu"
 	"github.com/coder-lulu/newbee-core/rpc/ent/oauthaccount"
+	"github.com/coder-lulu/newbee-core/rpc/ent/oauthclient"
+	"github.com/coder-lulu/newbee-core/rpc/ent/oauthconsent"
 	"github.com/coder-lulu/newbee-core/rpc/ent/oauthprovider"
+	"github.com/coder-lulu/newbee-core/rpc/ent/oauthprovidertemplate"
+	"github.com/coder-lulu/newbee-core/rpc/ent/oauthscope"
 	"github.com/coder-lulu/newbee-core/rpc/ent/oauthsession"
 	"github.com/coder-lulu/newbee-core/rpc/ent/position"
 	"github.com/coder-lulu/newbee-core/rpc/ent/role"
+	"github.com/coder-lulu/newbee-core/rpc/ent/samlaccount"
+	"github.com/coder-lulu/newbee-core/rpc/ent/samlprovider"
+	"github.com/coder-lulu/newbee-core/rpc/ent/scimtoken"
 	"github.com/coder-lulu/newbee-core/rpc/ent/tenant"
+	"github.com/coder-lulu/newbee-core/rpc/ent/tenantinitjob"
+	"github.com/coder-lulu/newbee-core/rpc/ent/tenantinitplugin"
+	"github.com/coder-lulu/newbee-core/rpc/ent/tenantinittemplate"
 	"github.com/coder-lulu/newbee-core/rpc/ent/token"
 	"github.com/coder-lulu/newbee-core/rpc/ent/user"
 )
@@ -231,6 +250,330 @@ func (_m *AuditLogQuery) Page(
 	return ret, nil
 }
 
+type AuditLogArchivePager struct {
+	Order  auditlogarchive.OrderOption
+	Filter func(*AuditLogArchiveQuery) (*AuditLogArchiveQuery, error)
+}
+
+// AuditLogArchivePaginateOption enables pagination customization.
+type AuditLogArchivePaginateOption func(*AuditLogArchivePager)
+
+// DefaultAuditLogArchiveOrder is the default ordering of AuditLogArchive.
+var DefaultAuditLogArchiveOrder = Desc(auditlogarchive.FieldID)
+
+func newAuditLogArchivePager(opts []AuditLogArchivePaginateOption) (*AuditLogArchivePager, error) {
+	pager := &AuditLogArchivePager{}
+	for _, opt := range opts {
+		opt(pager)
+	}
+	if pager.Order == nil {
+		pager.Order = DefaultAuditLogArchiveOrder
+	}
+	return pager, nil
+}
+
+func (p *AuditLogArchivePager) ApplyFilter(query *AuditLogArchiveQuery) (*AuditLogArchiveQuery, error) {
+	if p.Filter != nil {
+		return p.Filter(query)
+	}
+	return query, nil
+}
+
+// AuditLogArchivePageList is AuditLogArchive PageList result.
+type AuditLogArchivePageList struct {
+	List        []*AuditLogArchive `json:"list"`
+	PageDetails *PageDetails       `json:"pageDetails"`
+}
+
+func (_m *AuditLogArchiveQuery) Page(
+	ctx context.Context, pageNum uint64, pageSize uint64, opts ...AuditLogArchivePaginateOption,
+) (*AuditLogArchivePageList, error) {
+
+	pager, err := newAuditLogArchivePager(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	if _m, err = pager.ApplyFilter(_m); err != nil {
+		return nil, err
+	}
+
+	ret := &AuditLogArchivePageList{}
+
+	ret.PageDetails = &PageDetails{
+		Page: pageNum,
+		Size: pageSize,
+	}
+
+	query := _m.Clone()
+	query.ctx.Fields = nil
+	count, err := query.Count(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	ret.PageDetails.Total = uint64(count)
+
+	if pager.Order != nil {
+		_m = _m.Order(pager.Order)
+	} else {
+		_m = _m.Order(DefaultAuditLogArchiveOrder)
+	}
+
+	_m = _m.Offset(int((pageNum - 1) * pageSize)).Limit(int(pageSize))
+	list, err := _m.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ret.List = list
+
+	return ret, nil
+}
+
+type AuditLogChainPager struct {
+	Order  auditlogchain.OrderOption
+	Filter func(*AuditLogChainQuery) (*AuditLogChainQuery, error)
+}
+
+// AuditLogChainPaginateOption enables pagination customization.
+type AuditLogChainPaginateOption func(*AuditLogChainPager)
+
+// DefaultAuditLogChainOrder is the default ordering of AuditLogChain.
+var DefaultAuditLogChainOrder = Desc(auditlogchain.FieldID)
+
+func newAuditLogChainPager(opts []AuditLogChainPaginateOption) (*AuditLogChainPager, error) {
+	pager := &AuditLogChainPager{}
+	for _, opt := range opts {
+		opt(pager)
+	}
+	if pager.Order == nil {
+		pager.Order = DefaultAuditLogChainOrder
+	}
+	return pager, nil
+}
+
+func (p *AuditLogChainPager) ApplyFilter(query *AuditLogChainQuery) (*AuditLogChainQuery, error) {
+	if p.Filter != nil {
+		return p.Filter(query)
+	}
+	return query, nil
+}
+
+// AuditLogChainPageList is AuditLogChain PageList result.
+type AuditLogChainPageList struct {
+	List        []*AuditLogChain `json:"list"`
+	PageDetails *PageDetails     `json:"pageDetails"`
+}
+
+func (_m *AuditLogChainQuery) Page(
+	ctx context.Context, pageNum uint64, pageSize uint64, opts ...AuditLogChainPaginateOption,
+) (*AuditLogChainPageList, error) {
+
+	pager, err := newAuditLogChainPager(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	if _m, err = pager.ApplyFilter(_m); err != nil {
+		return nil, err
+	}
+
+	ret := &AuditLogChainPageList{}
+
+	ret.PageDetails = &PageDetails{
+		Page: pageNum,
+		Size: pageSize,
+	}
+
+	query := _m.Clone()
+	query.ctx.Fields = nil
+	count, err := query.Count(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	ret.PageDetails.Total = uint64(count)
+
+	if pager.Order != nil {
+		_m = _m.Order(pager.Order)
+	} else {
+		_m = _m.Order(DefaultAuditLogChainOrder)
+	}
+
+	_m = _m.Offset(int((pageNum - 1) * pageSize)).Limit(int(pageSize))
+	list, err := _m.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ret.List = list
+
+	return ret, nil
+}
+
+type AuditLogCheckpointPager struct {
+	Order  auditlogcheckpoint.OrderOption
+	Filter func(*AuditLogCheckpointQuery) (*AuditLogCheckpointQuery, error)
+}
+
+// AuditLogCheckpointPaginateOption enables pagination customization.
+type AuditLogCheckpointPaginateOption func(*AuditLogCheckpointPager)
+
+// DefaultAuditLogCheckpointOrder is the default ordering of AuditLogCheckpoint.
+var DefaultAuditLogCheckpointOrder = Desc(auditlogcheckpoint.FieldID)
+
+func newAuditLogCheckpointPager(opts []AuditLogCheckpointPaginateOption) (*AuditLogCheckpointPager, error) {
+	pager := &AuditLogCheckpointPager{}
+	for _, opt := range opts {
+		opt(pager)
+	}
+	if pager.Order == nil {
+		pager.Order = DefaultAuditLogCheckpointOrder
+	}
+	return pager, nil
+}
+
+func (p *AuditLogCheckpointPager) ApplyFilter(query *AuditLogCheckpointQuery) (*AuditLogCheckpointQuery, error) {
+	if p.Filter != nil {
+		return p.Filter(query)
+	}
+	return query, nil
+}
+
+// AuditLogCheckpointPageList is AuditLogCheckpoint PageList result.
+type AuditLogCheckpointPageList struct {
+	List        []*AuditLogCheckpoint `json:"list"`
+	PageDetails *PageDetails          `json:"pageDetails"`
+}
+
+func (_m *AuditLogCheckpointQuery) Page(
+	ctx context.Context, pageNum uint64, pageSize uint64, opts ...AuditLogCheckpointPaginateOption,
+) (*AuditLogCheckpointPageList, error) {
+
+	pager, err := newAuditLogCheckpointPager(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	if _m, err = pager.ApplyFilter(_m); err != nil {
+		return nil, err
+	}
+
+	ret := &AuditLogCheckpointPageList{}
+
+	ret.PageDetails = &PageDetails{
+		Page: pageNum,
+		Size: pageSize,
+	}
+
+	query := _m.Clone()
+	query.ctx.Fields = nil
+	count, err := query.Count(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	ret.PageDetails.Total = uint64(count)
+
+	if pager.Order != nil {
+		_m = _m.Order(pager.Order)
+	} else {
+		_m = _m.Order(DefaultAuditLogCheckpointOrder)
+	}
+
+	_m = _m.Offset(int((pageNum - 1) * pageSize)).Limit(int(pageSize))
+	list, err := _m.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ret.List = list
+
+	return ret, nil
+}
+
+type AuditLogPruneRecordPager struct {
+	Order  auditlogprunerecord.OrderOption
+	Filter func(*AuditLogPruneRecordQuery) (*AuditLogPruneRecordQuery, error)
+}
+
+// AuditLogPruneRecordPaginateOption enables pagination customization.
+type AuditLogPruneRecordPaginateOption func(*AuditLogPruneRecordPager)
+
+// DefaultAuditLogPruneRecordOrder is the default ordering of AuditLogPruneRecord.
+var DefaultAuditLogPruneRecordOrder = Desc(auditlogprunerecord.FieldID)
+
+func newAuditLogPruneRecordPager(opts []AuditLogPruneRecordPaginateOption) (*AuditLogPruneRecordPager, error) {
+	pager := &AuditLogPruneRecordPager{}
+	for _, opt := range opts {
+		opt(pager)
+	}
+	if pager.Order == nil {
+		pager.Order = DefaultAuditLogPruneRecordOrder
+	}
+	return pager, nil
+}
+
+func (p *AuditLogPruneRecordPager) ApplyFilter(query *AuditLogPruneRecordQuery) (*AuditLogPruneRecordQuery, error) {
+	if p.Filter != nil {
+		return p.Filter(query)
+	}
+	return query, nil
+}
+
+// AuditLogPruneRecordPageList is AuditLogPruneRecord PageList result.
+type AuditLogPruneRecordPageList struct {
+	List        []*AuditLogPruneRecord `json:"list"`
+	PageDetails *PageDetails           `json:"pageDetails"`
+}
+
+func (_m *AuditLogPruneRecordQuery) Page(
+	ctx context.Context, pageNum uint64, pageSize uint64, opts ...AuditLogPruneRecordPaginateOption,
+) (*AuditLogPruneRecordPageList, error) {
+
+	pager, err := newAuditLogPruneRecordPager(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	if _m, err = pager.ApplyFilter(_m); err != nil {
+		return nil, err
+	}
+
+	ret := &AuditLogPruneRecordPageList{}
+
+	ret.PageDetails = &PageDetails{
+		Page: pageNum,
+		Size: pageSize,
+	}
+
+	query := _m.Clone()
+	query.ctx.Fields = nil
+	count, err := query.Count(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	ret.PageDetails.Total = uint64(count)
+
+	if pager.Order != nil {
+		_m = _m.Order(pager.Order)
+	} else {
+		_m = _m.Order(DefaultAuditLogPruneRecordOrder)
+	}
+
+	_m = _m.Offset(int((pageNum - 1) * pageSize)).Limit(int(pageSize))
+	list, err := _m.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ret.List = list
+
+	return ret, nil
+}
+
 type ConfigurationPager struct {
 	Order  configuration.OrderOption
 	Filter func(*ConfigurationQuery) (*ConfigurationQuery, error)
@@ -380,7 +723,1222 @@ func (_m *DepartmentQuery) Page(
 	if pager.Order != nil {
 		_m = _m.Order(pager.Order)
 	} else {
-		_m = _m.Order(DefaultDepartmentOrder)
+		_m = _m.Order(DefaultDepartmentOrder)
+	}
+
+	_m = _m.Offset(int((pageNum - 1) * pageSize)).Limit(int(pageSize))
+	list, err := _m.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ret.List = list
+
+	return ret, nil
+}
+
+type DictionaryPager struct {
+	Order  dictionary.OrderOption
+	Filter func(*DictionaryQuery) (*DictionaryQuery, error)
+}
+
+// DictionaryPaginateOption enables pagination customization.
+type DictionaryPaginateOption func(*DictionaryPager)
+
+// DefaultDictionaryOrder is the default ordering of Dictionary.
+var DefaultDictionaryOrder = Desc(dictionary.FieldID)
+
+func newDictionaryPager(opts []DictionaryPaginateOption) (*DictionaryPager, error) {
+	pager := &DictionaryPager{}
+	for _, opt := range opts {
+		opt(pager)
+	}
+	if pager.Order == nil {
+		pager.Order = DefaultDictionaryOrder
+	}
+	return pager, nil
+}
+
+func (p *DictionaryPager) ApplyFilter(query *DictionaryQuery) (*DictionaryQuery, error) {
+	if p.Filter != nil {
+		return p.Filter(query)
+	}
+	return query, nil
+}
+
+// DictionaryPageList is Dictionary PageList result.
+type DictionaryPageList struct {
+	List        []*Dictionary `json:"list"`
+	PageDetails *PageDetails  `json:"pageDetails"`
+}
+
+func (_m *DictionaryQuery) Page(
+	ctx context.Context, pageNum uint64, pageSize uint64, opts ...DictionaryPaginateOption,
+) (*DictionaryPageList, error) {
+
+	pager, err := newDictionaryPager(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	if _m, err = pager.ApplyFilter(_m); err != nil {
+		return nil, err
+	}
+
+	ret := &DictionaryPageList{}
+
+	ret.PageDetails = &PageDetails{
+		Page: pageNum,
+		Size: pageSize,
+	}
+
+	query := _m.Clone()
+	query.ctx.Fields = nil
+	count, err := query.Count(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	ret.PageDetails.Total = uint64(count)
+
+	if pager.Order != nil {
+		_m = _m.Order(pager.Order)
+	} else {
+		_m = _m.Order(DefaultDictionaryOrder)
+	}
+
+	_m = _m.Offset(int((pageNum - 1) * pageSize)).Limit(int(pageSize))
+	list, err := _m.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ret.List = list
+
+	return ret, nil
+}
+
+type DictionaryDetailPager struct {
+	Order  dictionarydetail.OrderOption
+	Filter func(*DictionaryDetailQuery) (*DictionaryDetailQuery, error)
+}
+
+// DictionaryDetailPaginateOption enables pagination customization.
+type DictionaryDetailPaginateOption func(*DictionaryDetailPager)
+
+// DefaultDictionaryDetailOrder is the default ordering of DictionaryDetail.
+var DefaultDictionaryDetailOrder = Desc(dictionarydetail.FieldID)
+
+func newDictionaryDetailPager(opts []DictionaryDetailPaginateOption) (*DictionaryDetailPager, error) {
+	pager := &DictionaryDetailPager{}
+	for _, opt := range opts {
+		opt(pager)
+	}
+	if pager.Order == nil {
+		pager.Order = DefaultDictionaryDetailOrder
+	}
+	return pager, nil
+}
+
+func (p *DictionaryDetailPager) ApplyFilter(query *DictionaryDetailQuery) (*DictionaryDetailQuery, error) {
+	if p.Filter != nil {
+		return p.Filter(query)
+	}
+	return query, nil
+}
+
+// DictionaryDetailPageList is DictionaryDetail PageList result.
+type DictionaryDetailPageList struct {
+	List        []*DictionaryDetail `json:"list"`
+	PageDetails *PageDetails        `json:"pageDetails"`
+}
+
+func (_m *DictionaryDetailQuery) Page(
+	ctx context.Context, pageNum uint64, pageSize uint64, opts ...DictionaryDetailPaginateOption,
+) (*DictionaryDetailPageList, error) {
+
+	pager, err := newDictionaryDetailPager(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	if _m, err = pager.ApplyFilter(_m); err != nil {
+		return nil, err
+	}
+
+	ret := &DictionaryDetailPageList{}
+
+	ret.PageDetails = &PageDetails{
+		Page: pageNum,
+		Size: pageSize,
+	}
+
+	query := _m.Clone()
+	query.ctx.Fields = nil
+	count, err := query.Count(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	ret.PageDetails.Total = uint64(count)
+
+	if pager.Order != nil {
+		_m = _m.Order(pager.Order)
+	} else {
+		_m = _m.Order(DefaultDictionaryDetailOrder)
+	}
+
+	_m = _m.Offset(int((pageNum - 1) * pageSize)).Limit(int(pageSize))
+	list, err := _m.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ret.List = list
+
+	return ret, nil
+}
+
+type ImpersonationSessionPager struct {
+	Order  impersonationsession.OrderOption
+	Filter func(*ImpersonationSessionQuery) (*ImpersonationSessionQuery, error)
+}
+
+// ImpersonationSessionPaginateOption enables pagination customization.
+type ImpersonationSessionPaginateOption func(*ImpersonationSessionPager)
+
+// DefaultImpersonationSessionOrder is the default ordering of ImpersonationSession.
+var DefaultImpersonationSessionOrder = Desc(impersonationsession.FieldID)
+
+func newImpersonationSessionPager(opts []ImpersonationSessionPaginateOption) (*ImpersonationSessionPager, error) {
+	pager := &ImpersonationSessionPager{}
+	for _, opt := range opts {
+		opt(pager)
+	}
+	if pager.Order == nil {
+		pager.Order = DefaultImpersonationSessionOrder
+	}
+	return pager, nil
+}
+
+func (p *ImpersonationSessionPager) ApplyFilter(query *ImpersonationSessionQuery) (*ImpersonationSessionQuery, error) {
+	if p.Filter != nil {
+		return p.Filter(query)
+	}
+	return query, nil
+}
+
+// ImpersonationSessionPageList is ImpersonationSession PageList result.
+type ImpersonationSessionPageList struct {
+	List        []*ImpersonationSession `json:"list"`
+	PageDetails *PageDetails            `json:"pageDetails"`
+}
+
+func (_m *ImpersonationSessionQuery) Page(
+	ctx context.Context, pageNum uint64, pageSize uint64, opts ...ImpersonationSessionPaginateOption,
+) (*ImpersonationSessionPageList, error) {
+
+	pager, err := newImpersonationSessionPager(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	if _m, err = pager.ApplyFilter(_m); err != nil {
+		return nil, err
+	}
+
+	ret := &ImpersonationSessionPageList{}
+
+	ret.PageDetails = &PageDetails{
+		Page: pageNum,
+		Size: pageSize,
+	}
+
+	query := _m.Clone()
+	query.ctx.Fields = nil
+	count, err := query.Count(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	ret.PageDetails.Total = uint64(count)
+
+	if pager.Order != nil {
+		_m = _m.Order(pager.Order)
+	} else {
+		_m = _m.Order(DefaultImpersonationSessionOrder)
+	}
+
+	_m = _m.Offset(int((pageNum - 1) * pageSize)).Limit(int(pageSize))
+	list, err := _m.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ret.List = list
+
+	return ret, nil
+}
+
+type LdapAccountPager struct {
+	Order  ldapaccount.OrderOption
+	Filter func(*LdapAccountQuery) (*LdapAccountQuery, error)
+}
+
+// LdapAccountPaginateOption enables pagination customization.
+type LdapAccountPaginateOption func(*LdapAccountPager)
+
+// DefaultLdapAccountOrder is the default ordering of LdapAccount.
+var DefaultLdapAccountOrder = Desc(ldapaccount.FieldID)
+
+func newLdapAccountPager(opts []LdapAccountPaginateOption) (*LdapAccountPager, error) {
+	pager := &LdapAccountPager{}
+	for _, opt := range opts {
+		opt(pager)
+	}
+	if pager.Order == nil {
+		pager.Order = DefaultLdapAccountOrder
+	}
+	return pager, nil
+}
+
+func (p *LdapAccountPager) ApplyFilter(query *LdapAccountQuery) (*LdapAccountQuery, error) {
+	if p.Filter != nil {
+		return p.Filter(query)
+	}
+	return query, nil
+}
+
+// LdapAccountPageList is LdapAccount PageList result.
+type LdapAccountPageList struct {
+	List        []*LdapAccount `json:"list"`
+	PageDetails *PageDetails   `json:"pageDetails"`
+}
+
+func (_m *LdapAccountQuery) Page(
+	ctx context.Context, pageNum uint64, pageSize uint64, opts ...LdapAccountPaginateOption,
+) (*LdapAccountPageList, error) {
+
+	pager, err := newLdapAccountPager(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	if _m, err = pager.ApplyFilter(_m); err != nil {
+		return nil, err
+	}
+
+	ret := &LdapAccountPageList{}
+
+	ret.PageDetails = &PageDetails{
+		Page: pageNum,
+		Size: pageSize,
+	}
+
+	query := _m.Clone()
+	query.ctx.Fields = nil
+	count, err := query.Count(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	ret.PageDetails.Total = uint64(count)
+
+	if pager.Order != nil {
+		_m = _m.Order(pager.Order)
+	} else {
+		_m = _m.Order(DefaultLdapAccountOrder)
+	}
+
+	_m = _m.Offset(int((pageNum - 1) * pageSize)).Limit(int(pageSize))
+	list, err := _m.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ret.List = list
+
+	return ret, nil
+}
+
+type LdapDepartmentPager struct {
+	Order  ldapdepartment.OrderOption
+	Filter func(*LdapDepartmentQuery) (*LdapDepartmentQuery, error)
+}
+
+// LdapDepartmentPaginateOption enables pagination customization.
+type LdapDepartmentPaginateOption func(*LdapDepartmentPager)
+
+// DefaultLdapDepartmentOrder is the default ordering of LdapDepartment.
+var DefaultLdapDepartmentOrder = Desc(ldapdepartment.FieldID)
+
+func newLdapDepartmentPager(opts []LdapDepartmentPaginateOption) (*LdapDepartmentPager, error) {
+	pager := &LdapDepartmentPager{}
+	for _, opt := range opts {
+		opt(pager)
+	}
+	if pager.Order == nil {
+		pager.Order = DefaultLdapDepartmentOrder
+	}
+	return pager, nil
+}
+
+func (p *LdapDepartmentPager) ApplyFilter(query *LdapDepartmentQuery) (*LdapDepartmentQuery, error) {
+	if p.Filter != nil {
+		return p.Filter(query)
+	}
+	return query, nil
+}
+
+// LdapDepartmentPageList is LdapDepartment PageList result.
+type LdapDepartmentPageList struct {
+	List        []*LdapDepartment `json:"list"`
+	PageDetails *PageDetails      `json:"pageDetails"`
+}
+
+func (_m *LdapDepartmentQuery) Page(
+	ctx context.Context, pageNum uint64, pageSize uint64, opts ...LdapDepartmentPaginateOption,
+) (*LdapDepartmentPageList, error) {
+
+	pager, err := newLdapDepartmentPager(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	if _m, err = pager.ApplyFilter(_m); err != nil {
+		return nil, err
+	}
+
+	ret := &LdapDepartmentPageList{}
+
+	ret.PageDetails = &PageDetails{
+		Page: pageNum,
+		Size: pageSize,
+	}
+
+	query := _m.Clone()
+	query.ctx.Fields = nil
+	count, err := query.Count(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	ret.PageDetails.Total = uint64(count)
+
+	if pager.Order != nil {
+		_m = _m.Order(pager.Order)
+	} else {
+		_m = _m.Order(DefaultLdapDepartmentOrder)
+	}
+
+	_m = _m.Offset(int((pageNum - 1) * pageSize)).Limit(int(pageSize))
+	list, err := _m.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ret.List = list
+
+	return ret, nil
+}
+
+type LdapProviderPager struct {
+	Order  ldapprovider.OrderOption
+	Filter func(*LdapProviderQuery) (*LdapProviderQuery, error)
+}
+
+// LdapProviderPaginateOption enables pagination customization.
+type LdapProviderPaginateOption func(*LdapProviderPager)
+
+// DefaultLdapProviderOrder is the default ordering of LdapProvider.
+var DefaultLdapProviderOrder = Desc(ldapprovider.FieldID)
+
+func newLdapProviderPager(opts []LdapProviderPaginateOption) (*LdapProviderPager, error) {
+	pager := &LdapProviderPager{}
+	for _, opt := range opts {
+		opt(pager)
+	}
+	if pager.Order == nil {
+		pager.Order = DefaultLdapProviderOrder
+	}
+	return pager, nil
+}
+
+func (p *LdapProviderPager) ApplyFilter(query *LdapProviderQuery) (*LdapProviderQuery, error) {
+	if p.Filter != nil {
+		return p.Filter(query)
+	}
+	return query, nil
+}
+
+// LdapProviderPageList is LdapProvider PageList result.
+type LdapProviderPageList struct {
+	List        []*LdapProvider `json:"list"`
+	PageDetails *PageDetails    `json:"pageDetails"`
+}
+
+func (_m *LdapProviderQuery) Page(
+	ctx context.Context, pageNum uint64, pageSize uint64, opts ...LdapProviderPaginateOption,
+) (*LdapProviderPageList, error) {
+
+	pager, err := newLdapProviderPager(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	if _m, err = pager.ApplyFilter(_m); err != nil {
+		return nil, err
+	}
+
+	ret := &LdapProviderPageList{}
+
+	ret.PageDetails = &PageDetails{
+		Page: pageNum,
+		Size: pageSize,
+	}
+
+	query := _m.Clone()
+	query.ctx.Fields = nil
+	count, err := query.Count(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	ret.PageDetails.Total = uint64(count)
+
+	if pager.Order != nil {
+		_m = _m.Order(pager.Order)
+	} else {
+		_m = _m.Order(DefaultLdapProviderOrder)
+	}
+
+	_m = _m.Offset(int((pageNum - 1) * pageSize)).Limit(int(pageSize))
+	list, err := _m.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ret.List = list
+
+	return ret, nil
+}
+
+type LdapSyncRunPager struct {
+	Order  ldapsyncrun.OrderOption
+	Filter func(*LdapSyncRunQuery) (*LdapSyncRunQuery, error)
+}
+
+// LdapSyncRunPaginateOption enables pagination customization.
+type LdapSyncRunPaginateOption func(*LdapSyncRunPager)
+
+// DefaultLdapSyncRunOrder is the default ordering of LdapSyncRun.
+var DefaultLdapSyncRunOrder = Desc(ldapsyncrun.FieldID)
+
+func newLdapSyncRunPager(opts []LdapSyncRunPaginateOption) (*LdapSyncRunPager, error) {
+	pager := &LdapSyncRunPager{}
+	for _, opt := range opts {
+		opt(pager)
+	}
+	if pager.Order == nil {
+		pager.Order = DefaultLdapSyncRunOrder
+	}
+	return pager, nil
+}
+
+func (p *LdapSyncRunPager) ApplyFilter(query *LdapSyncRunQuery) (*LdapSyncRunQuery, error) {
+	if p.Filter != nil {
+		return p.Filter(query)
+	}
+	return query, nil
+}
+
+// LdapSyncRunPageList is LdapSyncRun PageList result.
+type LdapSyncRunPageList struct {
+	List        []*LdapSyncRun `json:"list"`
+	PageDetails *PageDetails   `json:"pageDetails"`
+}
+
+func (_m *LdapSyncRunQuery) Page(
+	ctx context.Context, pageNum uint64, pageSize uint64, opts ...LdapSyncRunPaginateOption,
+) (*LdapSyncRunPageList, error) {
+
+	pager, err := newLdapSyncRunPager(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	if _m, err = pager.ApplyFilter(_m); err != nil {
+		return nil, err
+	}
+
+	ret := &LdapSyncRunPageList{}
+
+	ret.PageDetails = &PageDetails{
+		Page: pageNum,
+		Size: pageSize,
+	}
+
+	query := _m.Clone()
+	query.ctx.Fields = nil
+	count, err := query.Count(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	ret.PageDetails.Total = uint64(count)
+
+	if pager.Order != nil {
+		_m = _m.Order(pager.Order)
+	} else {
+		_m = _m.Order(DefaultLdapSyncRunOrder)
+	}
+
+	_m = _m.Offset(int((pageNum - 1) * pageSize)).Limit(int(pageSize))
+	list, err := _m.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ret.List = list
+
+	return ret, nil
+}
+
+type MenuPager struct {
+	Order  menu.OrderOption
+	Filter func(*MenuQuery) (*MenuQuery, error)
+}
+
+// MenuPaginateOption enables pagination customization.
+type MenuPaginateOption func(*MenuPager)
+
+// DefaultMenuOrder is the default ordering of Menu.
+var DefaultMenuOrder = Desc(menu.FieldID)
+
+func newMenuPager(opts []MenuPaginateOption) (*MenuPager, error) {
+	pager := &MenuPager{}
+	for _, opt := range opts {
+		opt(pager)
+	}
+	if pager.Order == nil {
+		pager.Order = DefaultMenuOrder
+	}
+	return pager, nil
+}
+
+func (p *MenuPager) ApplyFilter(query *MenuQuery) (*MenuQuery, error) {
+	if p.Filter != nil {
+		return p.Filter(query)
+	}
+	return query, nil
+}
+
+// MenuPageList is Menu PageList result.
+type MenuPageList struct {
+	List        []*Menu      `json:"list"`
+	PageDetails *PageDetails `json:"pageDetails"`
+}
+
+func (_m *MenuQuery) Page(
+	ctx context.Context, pageNum uint64, pageSize uint64, opts ...MenuPaginateOption,
+) (*MenuPageList, error) {
+
+	pager, err := newMenuPager(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	if _m, err = pager.ApplyFilter(_m); err != nil {
+		return nil, err
+	}
+
+	ret := &MenuPageList{}
+
+	ret.PageDetails = &PageDetails{
+		Page: pageNum,
+		Size: pageSize,
+	}
+
+	query := _m.Clone()
+	query.ctx.Fields = nil
+	count, err := query.Count(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	ret.PageDetails.Total = uint64(count)
+
+	if pager.Order != nil {
+		_m = _m.Order(pager.Order)
+	} else {
+		_m = _m.Order(DefaultMenuOrder)
+	}
+
+	_m = _m.Offset(int((pageNum - 1) * pageSize)).Limit(int(pageSize))
+	list, err := _m.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ret.List = list
+
+	return ret, nil
+}
+
+type OauthAccountPager struct {
+	Order  oauthaccount.OrderOption
+	Filter func(*OauthAccountQuery) (*OauthAccountQuery, error)
+}
+
+// OauthAccountPaginateOption enables pagination customization.
+type OauthAccountPaginateOption func(*OauthAccountPager)
+
+// DefaultOauthAccountOrder is the default ordering of OauthAccount.
+var DefaultOauthAccountOrder = Desc(oauthaccount.FieldID)
+
+func newOauthAccountPager(opts []OauthAccountPaginateOption) (*OauthAccountPager, error) {
+	pager := &OauthAccountPager{}
+	for _, opt := range opts {
+		opt(pager)
+	}
+	if pager.Order == nil {
+		pager.Order = DefaultOauthAccountOrder
+	}
+	return pager, nil
+}
+
+func (p *OauthAccountPager) ApplyFilter(query *OauthAccountQuery) (*OauthAccountQuery, error) {
+	if p.Filter != nil {
+		return p.Filter(query)
+	}
+	return query, nil
+}
+
+// OauthAccountPageList is OauthAccount PageList result.
+type OauthAccountPageList struct {
+	List        []*OauthAccount `json:"list"`
+	PageDetails *PageDetails    `json:"pageDetails"`
+}
+
+func (_m *OauthAccountQuery) Page(
+	ctx context.Context, pageNum uint64, pageSize uint64, opts ...OauthAccountPaginateOption,
+) (*OauthAccountPageList, error) {
+
+	pager, err := newOauthAccountPager(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	if _m, err = pager.ApplyFilter(_m); err != nil {
+		return nil, err
+	}
+
+	ret := &OauthAccountPageList{}
+
+	ret.PageDetails = &PageDetails{
+		Page: pageNum,
+		Size: pageSize,
+	}
+
+	query := _m.Clone()
+	query.ctx.Fields = nil
+	count, err := query.Count(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	ret.PageDetails.Total = uint64(count)
+
+	if pager.Order != nil {
+		_m = _m.Order(pager.Order)
+	} else {
+		_m = _m.Order(DefaultOauthAccountOrder)
+	}
+
+	_m = _m.Offset(int((pageNum - 1) * pageSize)).Limit(int(pageSize))
+	list, err := _m.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ret.List = list
+
+	return ret, nil
+}
+
+type OauthClientPager struct {
+	Order  oauthclient.OrderOption
+	Filter func(*OauthClientQuery) (*OauthClientQuery, error)
+}
+
+// OauthClientPaginateOption enables pagination customization.
+type OauthClientPaginateOption func(*OauthClientPager)
+
+// DefaultOauthClientOrder is the default ordering of OauthClient.
+var DefaultOauthClientOrder = Desc(oauthclient.FieldID)
+
+func newOauthClientPager(opts []OauthClientPaginateOption) (*OauthClientPager, error) {
+	pager := &OauthClientPager{}
+	for _, opt := range opts {
+		opt(pager)
+	}
+	if pager.Order == nil {
+		pager.Order = DefaultOauthClientOrder
+	}
+	return pager, nil
+}
+
+func (p *OauthClientPager) ApplyFilter(query *OauthClientQuery) (*OauthClientQuery, error) {
+	if p.Filter != nil {
+		return p.Filter(query)
+	}
+	return query, nil
+}
+
+// OauthClientPageList is OauthClient PageList result.
+type OauthClientPageList struct {
+	List        []*OauthClient `json:"list"`
+	PageDetails *PageDetails   `json:"pageDetails"`
+}
+
+func (_m *OauthClientQuery) Page(
+	ctx context.Context, pageNum uint64, pageSize uint64, opts ...OauthClientPaginateOption,
+) (*OauthClientPageList, error) {
+
+	pager, err := newOauthClientPager(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	if _m, err = pager.ApplyFilter(_m); err != nil {
+		return nil, err
+	}
+
+	ret := &OauthClientPageList{}
+
+	ret.PageDetails = &PageDetails{
+		Page: pageNum,
+		Size: pageSize,
+	}
+
+	query := _m.Clone()
+	query.ctx.Fields = nil
+	count, err := query.Count(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	ret.PageDetails.Total = uint64(count)
+
+	if pager.Order != nil {
+		_m = _m.Order(pager.Order)
+	} else {
+		_m = _m.Order(DefaultOauthClientOrder)
+	}
+
+	_m = _m.Offset(int((pageNum - 1) * pageSize)).Limit(int(pageSize))
+	list, err := _m.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ret.List = list
+
+	return ret, nil
+}
+
+type OauthConsentPager struct {
+	Order  oauthconsent.OrderOption
+	Filter func(*OauthConsentQuery) (*OauthConsentQuery, error)
+}
+
+// OauthConsentPaginateOption enables pagination customization.
+type OauthConsentPaginateOption func(*OauthConsentPager)
+
+// DefaultOauthConsentOrder is the default ordering of OauthConsent.
+var DefaultOauthConsentOrder = Desc(oauthconsent.FieldID)
+
+func newOauthConsentPager(opts []OauthConsentPaginateOption) (*OauthConsentPager, error) {
+	pager := &OauthConsentPager{}
+	for _, opt := range opts {
+		opt(pager)
+	}
+	if pager.Order == nil {
+		pager.Order = DefaultOauthConsentOrder
+	}
+	return pager, nil
+}
+
+func (p *OauthConsentPager) ApplyFilter(query *OauthConsentQuery) (*OauthConsentQuery, error) {
+	if p.Filter != nil {
+		return p.Filter(query)
+	}
+	return query, nil
+}
+
+// OauthConsentPageList is OauthConsent PageList result.
+type OauthConsentPageList struct {
+	List        []*OauthConsent `json:"list"`
+	PageDetails *PageDetails    `json:"pageDetails"`
+}
+
+func (_m *OauthConsentQuery) Page(
+	ctx context.Context, pageNum uint64, pageSize uint64, opts ...OauthConsentPaginateOption,
+) (*OauthConsentPageList, error) {
+
+	pager, err := newOauthConsentPager(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	if _m, err = pager.ApplyFilter(_m); err != nil {
+		return nil, err
+	}
+
+	ret := &OauthConsentPageList{}
+
+	ret.PageDetails = &PageDetails{
+		Page: pageNum,
+		Size: pageSize,
+	}
+
+	query := _m.Clone()
+	query.ctx.Fields = nil
+	count, err := query.Count(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	ret.PageDetails.Total = uint64(count)
+
+	if pager.Order != nil {
+		_m = _m.Order(pager.Order)
+	} else {
+		_m = _m.Order(DefaultOauthConsentOrder)
+	}
+
+	_m = _m.Offset(int((pageNum - 1) * pageSize)).Limit(int(pageSize))
+	list, err := _m.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ret.List = list
+
+	return ret, nil
+}
+
+type OauthProviderPager struct {
+	Order  oauthprovider.OrderOption
+	Filter func(*OauthProviderQuery) (*OauthProviderQuery, error)
+}
+
+// OauthProviderPaginateOption enables pagination customization.
+type OauthProviderPaginateOption func(*OauthProviderPager)
+
+// DefaultOauthProviderOrder is the default ordering of OauthProvider.
+var DefaultOauthProviderOrder = Desc(oauthprovider.FieldID)
+
+func newOauthProviderPager(opts []OauthProviderPaginateOption) (*OauthProviderPager, error) {
+	pager := &OauthProviderPager{}
+	for _, opt := range opts {
+		opt(pager)
+	}
+	if pager.Order == nil {
+		pager.Order = DefaultOauthProviderOrder
+	}
+	return pager, nil
+}
+
+func (p *OauthProviderPager) ApplyFilter(query *OauthProviderQuery) (*OauthProviderQuery, error) {
+	if p.Filter != nil {
+		return p.Filter(query)
+	}
+	return query, nil
+}
+
+// OauthProviderPageList is OauthProvider PageList result.
+type OauthProviderPageList struct {
+	List        []*OauthProvider `json:"list"`
+	PageDetails *PageDetails     `json:"pageDetails"`
+}
+
+func (_m *OauthProviderQuery) Page(
+	ctx context.Context, pageNum uint64, pageSize uint64, opts ...OauthProviderPaginateOption,
+) (*OauthProviderPageList, error) {
+
+	pager, err := newOauthProviderPager(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	if _m, err = pager.ApplyFilter(_m); err != nil {
+		return nil, err
+	}
+
+	ret := &OauthProviderPageList{}
+
+	ret.PageDetails = &PageDetails{
+		Page: pageNum,
+		Size: pageSize,
+	}
+
+	query := _m.Clone()
+	query.ctx.Fields = nil
+	count, err := query.Count(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	ret.PageDetails.Total = uint64(count)
+
+	if pager.Order != nil {
+		_m = _m.Order(pager.Order)
+	} else {
+		_m = _m.Order(DefaultOauthProviderOrder)
+	}
+
+	_m = _m.Offset(int((pageNum - 1) * pageSize)).Limit(int(pageSize))
+	list, err := _m.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ret.List = list
+
+	return ret, nil
+}
+
+type OauthProviderTemplatePager struct {
+	Order  oauthprovidertemplate.OrderOption
+	Filter func(*OauthProviderTemplateQuery) (*OauthProviderTemplateQuery, error)
+}
+
+// OauthProviderTemplatePaginateOption enables pagination customization.
+type OauthProviderTemplatePaginateOption func(*OauthProviderTemplatePager)
+
+// DefaultOauthProviderTemplateOrder is the default ordering of OauthProviderTemplate.
+var DefaultOauthProviderTemplateOrder = Desc(oauthprovidertemplate.FieldID)
+
+func newOauthProviderTemplatePager(opts []OauthProviderTemplatePaginateOption) (*OauthProviderTemplatePager, error) {
+	pager := &OauthProviderTemplatePager{}
+	for _, opt := range opts {
+		opt(pager)
+	}
+	if pager.Order == nil {
+		pager.Order = DefaultOauthProviderTemplateOrder
+	}
+	return pager, nil
+}
+
+func (p *OauthProviderTemplatePager) ApplyFilter(query *OauthProviderTemplateQuery) (*OauthProviderTemplateQuery, error) {
+	if p.Filter != nil {
+		return p.Filter(query)
+	}
+	return query, nil
+}
+
+// OauthProviderTemplatePageList is OauthProviderTemplate PageList result.
+type OauthProviderTemplatePageList struct {
+	List        []*OauthProviderTemplate `json:"list"`
+	PageDetails *PageDetails             `json:"pageDetails"`
+}
+
+func (_m *OauthProviderTemplateQuery) Page(
+	ctx context.Context, pageNum uint64, pageSize uint64, opts ...OauthProviderTemplatePaginateOption,
+) (*OauthProviderTemplatePageList, error) {
+
+	pager, err := newOauthProviderTemplatePager(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	if _m, err = pager.ApplyFilter(_m); err != nil {
+		return nil, err
+	}
+
+	ret := &OauthProviderTemplatePageList{}
+
+	ret.PageDetails = &PageDetails{
+		Page: pageNum,
+		Size: pageSize,
+	}
+
+	query := _m.Clone()
+	query.ctx.Fields = nil
+	count, err := query.Count(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	ret.PageDetails.Total = uint64(count)
+
+	if pager.Order != nil {
+		_m = _m.Order(pager.Order)
+	} else {
+		_m = _m.Order(DefaultOauthProviderTemplateOrder)
+	}
+
+	_m = _m.Offset(int((pageNum - 1) * pageSize)).Limit(int(pageSize))
+	list, err := _m.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ret.List = list
+
+	return ret, nil
+}
+
+type OauthScopePager struct {
+	Order  oauthscope.OrderOption
+	Filter func(*OauthScopeQuery) (*OauthScopeQuery, error)
+}
+
+// OauthScopePaginateOption enables pagination customization.
+type OauthScopePaginateOption func(*OauthScopePager)
+
+// DefaultOauthScopeOrder is the default ordering of OauthScope.
+var DefaultOauthScopeOrder = Desc(oauthscope.FieldID)
+
+func newOauthScopePager(opts []OauthScopePaginateOption) (*OauthScopePager, error) {
+	pager := &OauthScopePager{}
+	for _, opt := range opts {
+		opt(pager)
+	}
+	if pager.Order == nil {
+		pager.Order = DefaultOauthScopeOrder
+	}
+	return pager, nil
+}
+
+func (p *OauthScopePager) ApplyFilter(query *OauthScopeQuery) (*OauthScopeQuery, error) {
+	if p.Filter != nil {
+		return p.Filter(query)
+	}
+	return query, nil
+}
+
+// OauthScopePageList is OauthScope PageList result.
+type OauthScopePageList struct {
+	List        []*OauthScope `json:"list"`
+	PageDetails *PageDetails  `json:"pageDetails"`
+}
+
+func (_m *OauthScopeQuery) Page(
+	ctx context.Context, pageNum uint64, pageSize uint64, opts ...OauthScopePaginateOption,
+) (*OauthScopePageList, error) {
+
+	pager, err := newOauthScopePager(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	if _m, err = pager.ApplyFilter(_m); err != nil {
+		return nil, err
+	}
+
+	ret := &OauthScopePageList{}
+
+	ret.PageDetails = &PageDetails{
+		Page: pageNum,
+		Size: pageSize,
+	}
+
+	query := _m.Clone()
+	query.ctx.Fields = nil
+	count, err := query.Count(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	ret.PageDetails.Total = uint64(count)
+
+	if pager.Order != nil {
+		_m = _m.Order(pager.Order)
+	} else {
+		_m = _m.Order(DefaultOauthScopeOrder)
+	}
+
+	_m = _m.Offset(int((pageNum - 1) * pageSize)).Limit(int(pageSize))
+	list, err := _m.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ret.List = list
+
+	return ret, nil
+}
+
+type OauthSessionPager struct {
+	Order  oauthsession.OrderOption
+	Filter func(*OauthSessionQuery) (*OauthSessionQuery, error)
+}
+
+// OauthSessionPaginateOption enables pagination customization.
+type OauthSessionPaginateOption func(*OauthSessionPager)
+
+// DefaultOauthSessionOrder is the default ordering of OauthSession.
+var DefaultOauthSessionOrder = Desc(oauthsession.FieldID)
+
+func newOauthSessionPager(opts []OauthSessionPaginateOption) (*OauthSessionPager, error) {
+	pager := &OauthSessionPager{}
+	for _, opt := range opts {
+		opt(pager)
+	}
+	if pager.Order == nil {
+		pager.Order = DefaultOauthSessionOrder
+	}
+	return pager, nil
+}
+
+func (p *OauthSessionPager) ApplyFilter(query *OauthSessionQuery) (*OauthSessionQuery, error) {
+	if p.Filter != nil {
+		return p.Filter(query)
+	}
+	return query, nil
+}
+
+// OauthSessionPageList is OauthSession PageList result.
+type OauthSessionPageList struct {
+	List        []*OauthSession `json:"list"`
+	PageDetails *PageDetails    `json:"pageDetails"`
+}
+
+func (_m *OauthSessionQuery) Page(
+	ctx context.Context, pageNum uint64, pageSize uint64, opts ...OauthSessionPaginateOption,
+) (*OauthSessionPageList, error) {
+
+	pager, err := newOauthSessionPager(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	if _m, err = pager.ApplyFilter(_m); err != nil {
+		return nil, err
+	}
+
+	ret := &OauthSessionPageList{}
+
+	ret.PageDetails = &PageDetails{
+		Page: pageNum,
+		Size: pageSize,
+	}
+
+	query := _m.Clone()
+	query.ctx.Fields = nil
+	count, err := query.Count(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	ret.PageDetails.Total = uint64(count)
+
+	if pager.Order != nil {
+		_m = _m.Order(pager.Order)
+	} else {
+		_m = _m.Order(DefaultOauthSessionOrder)
 	}
 
 	_m = _m.Offset(int((pageNum - 1) * pageSize)).Limit(int(pageSize))
@@ -393,46 +1951,46 @@ func (_m *DepartmentQuery) Page(
 	return ret, nil
 }
 
-type DictionaryPager struct {
-	Order  dictionary.OrderOption
-	Filter func(*DictionaryQuery) (*DictionaryQuery, error)
+type PositionPager struct {
+	Order  position.OrderOption
+	Filter func(*PositionQuery) (*PositionQuery, error)
 }
 
-// DictionaryPaginateOption enables pagination customization.
-type DictionaryPaginateOption func(*DictionaryPager)
+// PositionPaginateOption enables pagination customization.
+type PositionPaginateOption func(*PositionPager)
 
-// DefaultDictionaryOrder is the default ordering of Dictionary.
-var DefaultDictionaryOrder = Desc(dictionary.FieldID)
+// DefaultPositionOrder is the default ordering of Position.
+var DefaultPositionOrder = Desc(position.FieldID)
 
-func newDictionaryPager(opts []DictionaryPaginateOption) (*DictionaryPager, error) {
-	pager := &DictionaryPager{}
+func newPositionPager(opts []PositionPaginateOption) (*PositionPager, error) {
+	pager := &PositionPager{}
 	for _, opt := range opts {
 		opt(pager)
 	}
 	if pager.Order == nil {
-		pager.Order = DefaultDictionaryOrder
+		pager.Order = DefaultPositionOrder
 	}
 	return pager, nil
 }
 
-func (p *DictionaryPager) ApplyFilter(query *DictionaryQuery) (*DictionaryQuery, error) {
+func (p *PositionPager) ApplyFilter(query *PositionQuery) (*PositionQuery, error) {
 	if p.Filter != nil {
 		return p.Filter(query)
 	}
 	return query, nil
 }
 
-// DictionaryPageList is Dictionary PageList result.
-type DictionaryPageList struct {
-	List        []*Dictionary `json:"list"`
-	PageDetails *PageDetails  `json:"pageDetails"`
+// PositionPageList is Position PageList result.
+type PositionPageList struct {
+	List        []*Position  `json:"list"`
+	PageDetails *PageDetails `json:"pageDetails"`
 }
 
-func (_m *DictionaryQuery) Page(
-	ctx context.Context, pageNum uint64, pageSize uint64, opts ...DictionaryPaginateOption,
-) (*DictionaryPageList, error) {
+func (_m *PositionQuery) Page(
+	ctx context.Context, pageNum uint64, pageSize uint64, opts ...PositionPaginateOption,
+) (*PositionPageList, error) {
 
-	pager, err := newDictionaryPager(opts)
+	pager, err := newPositionPager(opts)
 	if err != nil {
 		return nil, err
 	}
@@ -441,7 +1999,7 @@ func (_m *DictionaryQuery) Page(
 		return nil, err
 	}
 
-	ret := &DictionaryPageList{}
+	ret := &PositionPageList{}
 
 	ret.PageDetails = &PageDetails{
 		Page: pageNum,
@@ -461,7 +2019,7 @@ func (_m *DictionaryQuery) Page(
 	if pager.Order != nil {
 		_m = _m.Order(pager.Order)
 	} else {
-		_m = _m.Order(DefaultDictionaryOrder)
+		_m = _m.Order(DefaultPositionOrder)
 	}
 
 	_m = _m.Offset(int((pageNum - 1) * pageSize)).Limit(int(pageSize))
@@ -474,46 +2032,46 @@ func (_m *DictionaryQuery) Page(
 	return ret, nil
 }
 
-type DictionaryDetailPager struct {
-	Order  dictionarydetail.OrderOption
-	Filter func(*DictionaryDetailQuery) (*DictionaryDetailQuery, error)
+type RolePager struct {
+	Order  role.OrderOption
+	Filter func(*RoleQuery) (*RoleQuery, error)
 }
 
-// DictionaryDetailPaginateOption enables pagination customization.
-type DictionaryDetailPaginateOption func(*DictionaryDetailPager)
+// RolePaginateOption enables pagination customization.
+type RolePaginateOption func(*RolePager)
 
-// DefaultDictionaryDetailOrder is the default ordering of DictionaryDetail.
-var DefaultDictionaryDetailOrder = Desc(dictionarydetail.FieldID)
+// DefaultRoleOrder is the default ordering of Role.
+var DefaultRoleOrder = Desc(role.FieldID)
 
-func newDictionaryDetailPager(opts []DictionaryDetailPaginateOption) (*DictionaryDetailPager, error) {
-	pager := &DictionaryDetailPager{}
+func newRolePager(opts []RolePaginateOption) (*RolePager, error) {
+	pager := &RolePager{}
 	for _, opt := range opts {
 		opt(pager)
 	}
 	if pager.Order == nil {
-		pager.Order = DefaultDictionaryDetailOrder
+		pager.Order = DefaultRoleOrder
 	}
 	return pager, nil
 }
 
-func (p *DictionaryDetailPager) ApplyFilter(query *DictionaryDetailQuery) (*DictionaryDetailQuery, error) {
+func (p *RolePager) ApplyFilter(query *RoleQuery) (*RoleQuery, error) {
 	if p.Filter != nil {
 		return p.Filter(query)
 	}
 	return query, nil
 }
 
-// DictionaryDetailPageList is DictionaryDetail PageList result.
-type DictionaryDetailPageList struct {
-	List        []*DictionaryDetail `json:"list"`
-	PageDetails *PageDetails        `json:"pageDetails"`
+// RolePageList is Role PageList result.
+type RolePageList struct {
+	List        []*Role      `json:"list"`
+	PageDetails *PageDetails `json:"pageDetails"`
 }
 
-func (_m *DictionaryDetailQuery) Page(
-	ctx context.Context, pageNum uint64, pageSize uint64, opts ...DictionaryDetailPaginateOption,
-) (*DictionaryDetailPageList, error) {
+func (_m *RoleQuery) Page(
+	ctx context.Context, pageNum uint64, pageSize uint64, opts ...RolePaginateOption,
+) (*RolePageList, error) {
 
-	pager, err := newDictionaryDetailPager(opts)
+	pager, err := newRolePager(opts)
 	if err != nil {
 		return nil, err
 	}
@@ -522,7 +2080,7 @@ func (_m *DictionaryDetailQuery) Page(
 		return nil, err
 	}
 
-	ret := &DictionaryDetailPageList{}
+	ret := &RolePageList{}
 
 	ret.PageDetails = &PageDetails{
 		Page: pageNum,
@@ -542,7 +2100,7 @@ func (_m *DictionaryDetailQuery) Page(
 	if pager.Order != nil {
 		_m = _m.Order(pager.Order)
 	} else {
-		_m = _m.Order(DefaultDictionaryDetailOrder)
+		_m = _m.Order(DefaultRoleOrder)
 	}
 
 	_m = _m.Offset(int((pageNum - 1) * pageSize)).Limit(int(pageSize))
@@ -555,46 +2113,46 @@ func (_m *DictionaryDetailQuery) Page(
 	return ret, nil
 }
 
-type MenuPager struct {
-	Order  menu.OrderOption
-	Filter func(*MenuQuery) (*MenuQuery, error)
+type SamlAccountPager struct {
+	Order  samlaccount.OrderOption
+	Filter func(*SamlAccountQuery) (*SamlAccountQuery, error)
 }
 
-// MenuPaginateOption enables pagination customization.
-type MenuPaginateOption func(*MenuPager)
+// SamlAccountPaginateOption enables pagination customization.
+type SamlAccountPaginateOption func(*SamlAccountPager)
 
-// DefaultMenuOrder is the default ordering of Menu.
-var DefaultMenuOrder = Desc(menu.FieldID)
+// DefaultSamlAccountOrder is the default ordering of SamlAccount.
+var DefaultSamlAccountOrder = Desc(samlaccount.FieldID)
 
-func newMenuPager(opts []MenuPaginateOption) (*MenuPager, error) {
-	pager := &MenuPager{}
+func newSamlAccountPager(opts []SamlAccountPaginateOption) (*SamlAccountPager, error) {
+	pager := &SamlAccountPager{}
 	for _, opt := range opts {
 		opt(pager)
 	}
 	if pager.Order == nil {
-		pager.Order = DefaultMenuOrder
+		pager.Order = DefaultSamlAccountOrder
 	}
 	return pager, nil
 }
 
-func (p *MenuPager) ApplyFilter(query *MenuQuery) (*MenuQuery, error) {
+func (p *SamlAccountPager) ApplyFilter(query *SamlAccountQuery) (*SamlAccountQuery, error) {
 	if p.Filter != nil {
 		return p.Filter(query)
 	}
 	return query, nil
 }
 
-// MenuPageList is Menu PageList result.
-type MenuPageList struct {
-	List        []*Menu      `json:"list"`
-	PageDetails *PageDetails `json:"pageDetails"`
+// SamlAccountPageList is SamlAccount PageList result.
+type SamlAccountPageList struct {
+	List        []*SamlAccount `json:"list"`
+	PageDetails *PageDetails   `json:"pageDetails"`
 }
 
-func (_m *MenuQuery) Page(
-	ctx context.Context, pageNum uint64, pageSize uint64, opts ...MenuPaginateOption,
-) (*MenuPageList, error) {
+func (_m *SamlAccountQuery) Page(
+	ctx context.Context, pageNum uint64, pageSize uint64, opts ...SamlAccountPaginateOption,
+) (*SamlAccountPageList, error) {
 
-	pager, err := newMenuPager(opts)
+	pager, err := newSamlAccountPager(opts)
 	if err != nil {
 		return nil, err
 	}
@@ -603,7 +2161,7 @@ func (_m *MenuQuery) Page(
 		return nil, err
 	}
 
-	ret := &MenuPageList{}
+	ret := &SamlAccountPageList{}
 
 	ret.PageDetails = &PageDetails{
 		Page: pageNum,
@@ -623,7 +2181,7 @@ func (_m *MenuQuery) Page(
 	if pager.Order != nil {
 		_m = _m.Order(pager.Order)
 	} else {
-		_m = _m.Order(DefaultMenuOrder)
+		_m = _m.Order(DefaultSamlAccountOrder)
 	}
 
 	_m = _m.Offset(int((pageNum - 1) * pageSize)).Limit(int(pageSize))
@@ -636,46 +2194,46 @@ func (_m *MenuQuery) Page(
 	return ret, nil
 }
 
-type OauthAccountPager struct {
-	Order  oauthaccount.OrderOption
-	Filter func(*OauthAccountQuery) (*OauthAccountQuery, error)
+type SamlProviderPager struct {
+	Order  samlprovider.OrderOption
+	Filter func(*SamlProviderQuery) (*SamlProviderQuery, error)
 }
 
-// OauthAccountPaginateOption enables pagination customization.
-type OauthAccountPaginateOption func(*OauthAccountPager)
+// SamlProviderPaginateOption enables pagination customization.
+type SamlProviderPaginateOption func(*SamlProviderPager)
 
-// DefaultOauthAccountOrder is the default ordering of OauthAccount.
-var DefaultOauthAccountOrder = Desc(oauthaccount.FieldID)
+// DefaultSamlProviderOrder is the default ordering of SamlProvider.
+var DefaultSamlProviderOrder = Desc(samlprovider.FieldID)
 
-func newOauthAccountPager(opts []OauthAccountPaginateOption) (*OauthAccountPager, error) {
-	pager := &OauthAccountPager{}
+func newSamlProviderPager(opts []SamlProviderPaginateOption) (*SamlProviderPager, error) {
+	pager := &SamlProviderPager{}
 	for _, opt := range opts {
 		opt(pager)
 	}
 	if pager.Order == nil {
-		pager.Order = DefaultOauthAccountOrder
+		pager.Order = DefaultSamlProviderOrder
 	}
 	return pager, nil
 }
 
-func (p *OauthAccountPager) ApplyFilter(query *OauthAccountQuery) (*OauthAccountQuery, error) {
+func (p *SamlProviderPager) ApplyFilter(query *SamlProviderQuery) (*SamlProviderQuery, error) {
 	if p.Filter != nil {
 		return p.Filter(query)
 	}
 	return query, nil
 }
 
-// OauthAccountPageList is OauthAccount PageList result.
-type OauthAccountPageList struct {
-	List        []*OauthAccount `json:"list"`
+// SamlProviderPageList is SamlProvider PageList result.
+type SamlProviderPageList struct {
+	List        []*SamlProvider `json:"list"`
 	PageDetails *PageDetails    `json:"pageDetails"`
 }
 
-func (_m *OauthAccountQuery) Page(
-	ctx context.Context, pageNum uint64, pageSize uint64, opts ...OauthAccountPaginateOption,
-) (*OauthAccountPageList, error) {
+func (_m *SamlProviderQuery) Page(
+	ctx context.Context, pageNum uint64, pageSize uint64, opts ...SamlProviderPaginateOption,
+) (*SamlProviderPageList, error) {
 
-	pager, err := newOauthAccountPager(opts)
+	pager, err := newSamlProviderPager(opts)
 	if err != nil {
 		return nil, err
 	}
@@ -684,7 +2242,7 @@ func (_m *OauthAccountQuery) Page(
 		return nil, err
 	}
 
-	ret := &OauthAccountPageList{}
+	ret := &SamlProviderPageList{}
 
 	ret.PageDetails = &PageDetails{
 		Page: pageNum,
@@ -704,7 +2262,7 @@ func (_m *OauthAccountQuery) Page(
 	if pager.Order != nil {
 		_m = _m.Order(pager.Order)
 	} else {
-		_m = _m.Order(DefaultOauthAccountOrder)
+		_m = _m.Order(DefaultSamlProviderOrder)
 	}
 
 	_m = _m.Offset(int((pageNum - 1) * pageSize)).Limit(int(pageSize))
@@ -717,46 +2275,46 @@ func (_m *OauthAccountQuery) Page(
 	return ret, nil
 }
 
-type OauthProviderPager struct {
-	Order  oauthprovider.OrderOption
-	Filter func(*OauthProviderQuery) (*OauthProviderQuery, error)
+type ScimTokenPager struct {
+	Order  scimtoken.OrderOption
+	Filter func(*ScimTokenQuery) (*ScimTokenQuery, error)
 }
 
-// OauthProviderPaginateOption enables pagination customization.
-type OauthProviderPaginateOption func(*OauthProviderPager)
+// ScimTokenPaginateOption enables pagination customization.
+type ScimTokenPaginateOption func(*ScimTokenPager)
 
-// DefaultOauthProviderOrder is the default ordering of OauthProvider.
-var DefaultOauthProviderOrder = Desc(oauthprovider.FieldID)
+// DefaultScimTokenOrder is the default ordering of ScimToken.
+var DefaultScimTokenOrder = Desc(scimtoken.FieldID)
 
-func newOauthProviderPager(opts []OauthProviderPaginateOption) (*OauthProviderPager, error) {
-	pager := &OauthProviderPager{}
+func newScimTokenPager(opts []ScimTokenPaginateOption) (*ScimTokenPager, error) {
+	pager := &ScimTokenPager{}
 	for _, opt := range opts {
 		opt(pager)
 	}
 	if pager.Order == nil {
-		pager.Order = DefaultOauthProviderOrder
+		pager.Order = DefaultScimTokenOrder
 	}
 	return pager, nil
 }
 
-func (p *OauthProviderPager) ApplyFilter(query *OauthProviderQuery) (*OauthProviderQuery, error) {
+func (p *ScimTokenPager) ApplyFilter(query *ScimTokenQuery) (*ScimTokenQuery, error) {
 	if p.Filter != nil {
 		return p.Filter(query)
 	}
 	return query, nil
 }
 
-// OauthProviderPageList is OauthProvider PageList result.
-type OauthProviderPageList struct {
-	List        []*OauthProvider `json:"list"`
-	PageDetails *PageDetails     `json:"pageDetails"`
+// ScimTokenPageList is ScimToken PageList result.
+type ScimTokenPageList struct {
+	List        []*ScimToken `json:"list"`
+	PageDetails *PageDetails `json:"pageDetails"`
 }
 
-func (_m *OauthProviderQuery) Page(
-	ctx context.Context, pageNum uint64, pageSize uint64, opts ...OauthProviderPaginateOption,
-) (*OauthProviderPageList, error) {
+func (_m *ScimTokenQuery) Page(
+	ctx context.Context, pageNum uint64, pageSize uint64, opts ...ScimTokenPaginateOption,
+) (*ScimTokenPageList, error) {
 
-	pager, err := newOauthProviderPager(opts)
+	pager, err := newScimTokenPager(opts)
 	if err != nil {
 		return nil, err
 	}
@@ -765,7 +2323,7 @@ func (_m *OauthProviderQuery) Page(
 		return nil, err
 	}
 
-	ret := &OauthProviderPageList{}
+	ret := &ScimTokenPageList{}
 
 	ret.PageDetails = &PageDetails{
 		Page: pageNum,
@@ -785,7 +2343,7 @@ func (_m *OauthProviderQuery) Page(
 	if pager.Order != nil {
 		_m = _m.Order(pager.Order)
 	} else {
-		_m = _m.Order(DefaultOauthProviderOrder)
+		_m = _m.Order(DefaultScimTokenOrder)
 	}
 
 	_m = _m.Offset(int((pageNum - 1) * pageSize)).Limit(int(pageSize))
@@ -798,46 +2356,46 @@ func (_m *OauthProviderQuery) Page(
 	return ret, nil
 }
 
-type OauthSessionPager struct {
-	Order  oauthsession.OrderOption
-	Filter func(*OauthSessionQuery) (*OauthSessionQuery, error)
+type TenantPager struct {
+	Order  tenant.OrderOption
+	Filter func(*TenantQuery) (*TenantQuery, error)
 }
 
-// OauthSessionPaginateOption enables pagination customization.
-type OauthSessionPaginateOption func(*OauthSessionPager)
+// TenantPaginateOption enables pagination customization.
+type TenantPaginateOption func(*TenantPager)
 
-// DefaultOauthSessionOrder is the default ordering of OauthSession.
-var DefaultOauthSessionOrder = Desc(oauthsession.FieldID)
+// DefaultTenantOrder is the default ordering of Tenant.
+var DefaultTenantOrder = Desc(tenant.FieldID)
 
-func newOauthSessionPager(opts []OauthSessionPaginateOption) (*OauthSessionPager, error) {
-	pager := &OauthSessionPager{}
+func newTenantPager(opts []TenantPaginateOption) (*TenantPager, error) {
+	pager := &TenantPager{}
 	for _, opt := range opts {
 		opt(pager)
 	}
 	if pager.Order == nil {
-		pager.Order = DefaultOauthSessionOrder
+		pager.Order = DefaultTenantOrder
 	}
 	return pager, nil
 }
 
-func (p *OauthSessionPager) ApplyFilter(query *OauthSessionQuery) (*OauthSessionQuery, error) {
+func (p *TenantPager) ApplyFilter(query *TenantQuery) (*TenantQuery, error) {
 	if p.Filter != nil {
 		return p.Filter(query)
 	}
 	return query, nil
 }
 
-// OauthSessionPageList is OauthSession PageList result.
-type OauthSessionPageList struct {
-	List        []*OauthSession `json:"list"`
-	PageDetails *PageDetails    `json:"pageDetails"`
+// TenantPageList is Tenant PageList result.
+type TenantPageList struct {
+	List        []*Tenant    `json:"list"`
+	PageDetails *PageDetails `json:"pageDetails"`
 }
 
-func (_m *OauthSessionQuery) Page(
-	ctx context.Context, pageNum uint64, pageSize uint64, opts ...OauthSessionPaginateOption,
-) (*OauthSessionPageList, error) {
+func (_m *TenantQuery) Page(
+	ctx context.Context, pageNum uint64, pageSize uint64, opts ...TenantPaginateOption,
+) (*TenantPageList, error) {
 
-	pager, err := newOauthSessionPager(opts)
+	pager, err := newTenantPager(opts)
 	if err != nil {
 		return nil, err
 	}
@@ -846,7 +2404,7 @@ func (_m *OauthSessionQuery) Page(
 		return nil, err
 	}
 
-	ret := &OauthSessionPageList{}
+	ret := &TenantPageList{}
 
 	ret.PageDetails = &PageDetails{
 		Page: pageNum,
@@ -866,7 +2424,7 @@ func (_m *OauthSessionQuery) Page(
 	if pager.Order != nil {
 		_m = _m.Order(pager.Order)
 	} else {
-		_m = _m.Order(DefaultOauthSessionOrder)
+		_m = _m.Order(DefaultTenantOrder)
 	}
 
 	_m = _m.Offset(int((pageNum - 1) * pageSize)).Limit(int(pageSize))
@@ -879,46 +2437,46 @@ func (_m *OauthSessionQuery) Page(
 	return ret, nil
 }
 
-type PositionPager struct {
-	Order  position.OrderOption
-	Filter func(*PositionQuery) (*PositionQuery, error)
+type TenantInitJobPager struct {
+	Order  tenantinitjob.OrderOption
+	Filter func(*TenantInitJobQuery) (*TenantInitJobQuery, error)
 }
 
-// PositionPaginateOption enables pagination customization.
-type PositionPaginateOption func(*PositionPager)
+// TenantInitJobPaginateOption enables pagination customization.
+type TenantInitJobPaginateOption func(*TenantInitJobPager)
 
-// DefaultPositionOrder is the default ordering of Position.
-var DefaultPositionOrder = Desc(position.FieldID)
+// DefaultTenantInitJobOrder is the default ordering of TenantInitJob.
+var DefaultTenantInitJobOrder = Desc(tenantinitjob.FieldID)
 
-func newPositionPager(opts []PositionPaginateOption) (*PositionPager, error) {
-	pager := &PositionPager{}
+func newTenantInitJobPager(opts []TenantInitJobPaginateOption) (*TenantInitJobPager, error) {
+	pager := &TenantInitJobPager{}
 	for _, opt := range opts {
 		opt(pager)
 	}
 	if pager.Order == nil {
-		pager.Order = DefaultPositionOrder
+		pager.Order = DefaultTenantInitJobOrder
 	}
 	return pager, nil
 }
 
-func (p *PositionPager) ApplyFilter(query *PositionQuery) (*PositionQuery, error) {
+func (p *TenantInitJobPager) ApplyFilter(query *TenantInitJobQuery) (*TenantInitJobQuery, error) {
 	if p.Filter != nil {
 		return p.Filter(query)
 	}
 	return query, nil
 }
 
-// PositionPageList is Position PageList result.
-type PositionPageList struct {
-	List        []*Position  `json:"list"`
-	PageDetails *PageDetails `json:"pageDetails"`
+// TenantInitJobPageList is TenantInitJob PageList result.
+type TenantInitJobPageList struct {
+	List        []*TenantInitJob `json:"list"`
+	PageDetails *PageDetails     `json:"pageDetails"`
 }
 
-func (_m *PositionQuery) Page(
-	ctx context.Context, pageNum uint64, pageSize uint64, opts ...PositionPaginateOption,
-) (*PositionPageList, error) {
+func (_m *TenantInitJobQuery) Page(
+	ctx context.Context, pageNum uint64, pageSize uint64, opts ...TenantInitJobPaginateOption,
+) (*TenantInitJobPageList, error) {
 
-	pager, err := newPositionPager(opts)
+	pager, err := newTenantInitJobPager(opts)
 	if err != nil {
 		return nil, err
 	}
@@ -927,7 +2485,7 @@ func (_m *PositionQuery) Page(
 		return nil, err
 	}
 
-	ret := &PositionPageList{}
+	ret := &TenantInitJobPageList{}
 
 	ret.PageDetails = &PageDetails{
 		Page: pageNum,
@@ -947,7 +2505,7 @@ func (_m *PositionQuery) Page(
 	if pager.Order != nil {
 		_m = _m.Order(pager.Order)
 	} else {
-		_m = _m.Order(DefaultPositionOrder)
+		_m = _m.Order(DefaultTenantInitJobOrder)
 	}
 
 	_m = _m.Offset(int((pageNum - 1) * pageSize)).Limit(int(pageSize))
@@ -960,46 +2518,46 @@ func (_m *PositionQuery) Page(
 	return ret, nil
 }
 
-type RolePager struct {
-	Order  role.OrderOption
-	Filter func(*RoleQuery) (*RoleQuery, error)
+type TenantInitPluginPager struct {
+	Order  tenantinitplugin.OrderOption
+	Filter func(*TenantInitPluginQuery) (*TenantInitPluginQuery, error)
 }
 
-// RolePaginateOption enables pagination customization.
-type RolePaginateOption func(*RolePager)
+// TenantInitPluginPaginateOption enables pagination customization.
+type TenantInitPluginPaginateOption func(*TenantInitPluginPager)
 
-// DefaultRoleOrder is the default ordering of Role.
-var DefaultRoleOrder = Desc(role.FieldID)
+// DefaultTenantInitPluginOrder is the default ordering of TenantInitPlugin.
+var DefaultTenantInitPluginOrder = Desc(tenantinitplugin.FieldID)
 
-func newRolePager(opts []RolePaginateOption) (*RolePager, error) {
-	pager := &RolePager{}
+func newTenantInitPluginPager(opts []TenantInitPluginPaginateOption) (*TenantInitPluginPager, error) {
+	pager := &TenantInitPluginPager{}
 	for _, opt := range opts {
 		opt(pager)
 	}
 	if pager.Order == nil {
-		pager.Order = DefaultRoleOrder
+		pager.Order = DefaultTenantInitPluginOrder
 	}
 	return pager, nil
 }
 
-func (p *RolePager) ApplyFilter(query *RoleQuery) (*RoleQuery, error) {
+func (p *TenantInitPluginPager) ApplyFilter(query *TenantInitPluginQuery) (*TenantInitPluginQuery, error) {
 	if p.Filter != nil {
 		return p.Filter(query)
 	}
 	return query, nil
 }
 
-// RolePageList is Role PageList result.
-type RolePageList struct {
-	List        []*Role      `json:"list"`
-	PageDetails *PageDetails `json:"pageDetails"`
+// TenantInitPluginPageList is TenantInitPlugin PageList result.
+type TenantInitPluginPageList struct {
+	List        []*TenantInitPlugin `json:"list"`
+	PageDetails *PageDetails        `json:"pageDetails"`
 }
 
-func (_m *RoleQuery) Page(
-	ctx context.Context, pageNum uint64, pageSize uint64, opts ...RolePaginateOption,
-) (*RolePageList, error) {
+func (_m *TenantInitPluginQuery) Page(
+	ctx context.Context, pageNum uint64, pageSize uint64, opts ...TenantInitPluginPaginateOption,
+) (*TenantInitPluginPageList, error) {
 
-	pager, err := newRolePager(opts)
+	pager, err := newTenantInitPluginPager(opts)
 	if err != nil {
 		return nil, err
 	}
@@ -1008,7 +2566,7 @@ func (_m *RoleQuery) Page(
 		return nil, err
 	}
 
-	ret := &RolePageList{}
+	ret := &TenantInitPluginPageList{}
 
 	ret.PageDetails = &PageDetails{
 		Page: pageNum,
@@ -1028,7 +2586,7 @@ func (_m *RoleQuery) Page(
 	if pager.Order != nil {
 		_m = _m.Order(pager.Order)
 	} else {
-		_m = _m.Order(DefaultRoleOrder)
+		_m = _m.Order(DefaultTenantInitPluginOrder)
 	}
 
 	_m = _m.Offset(int((pageNum - 1) * pageSize)).Limit(int(pageSize))
@@ -1041,46 +2599,46 @@ func (_m *RoleQuery) Page(
 	return ret, nil
 }
 
-type TenantPager struct {
-	Order  tenant.OrderOption
-	Filter func(*TenantQuery) (*TenantQuery, error)
+type TenantInitTemplatePager struct {
+	Order  tenantinittemplate.OrderOption
+	Filter func(*TenantInitTemplateQuery) (*TenantInitTemplateQuery, error)
 }
 
-// TenantPaginateOption enables pagination customization.
-type TenantPaginateOption func(*TenantPager)
+// TenantInitTemplatePaginateOption enables pagination customization.
+type TenantInitTemplatePaginateOption func(*TenantInitTemplatePager)
 
-// DefaultTenantOrder is the default ordering of Tenant.
-var DefaultTenantOrder = Desc(tenant.FieldID)
+// DefaultTenantInitTemplateOrder is the default ordering of TenantInitTemplate.
+var DefaultTenantInitTemplateOrder = Desc(tenantinittemplate.FieldID)
 
-func newTenantPager(opts []TenantPaginateOption) (*TenantPager, error) {
-	pager := &TenantPager{}
+func newTenantInitTemplatePager(opts []TenantInitTemplatePaginateOption) (*TenantInitTemplatePager, error) {
+	pager := &TenantInitTemplatePager{}
 	for _, opt := range opts {
 		opt(pager)
 	}
 	if pager.Order == nil {
-		pager.Order = DefaultTenantOrder
+		pager.Order = DefaultTenantInitTemplateOrder
 	}
 	return pager, nil
 }
 
-func (p *TenantPager) ApplyFilter(query *TenantQuery) (*TenantQuery, error) {
+func (p *TenantInitTemplatePager) ApplyFilter(query *TenantInitTemplateQuery) (*TenantInitTemplateQuery, error) {
 	if p.Filter != nil {
 		return p.Filter(query)
 	}
 	return query, nil
 }
 
-// TenantPageList is Tenant PageList result.
-type TenantPageList struct {
-	List        []*Tenant    `json:"list"`
-	PageDetails *PageDetails `json:"pageDetails"`
+// TenantInitTemplatePageList is TenantInitTemplate PageList result.
+type TenantInitTemplatePageList struct {
+	List        []*TenantInitTemplate `json:"list"`
+	PageDetails *PageDetails          `json:"pageDetails"`
 }
 
-func (_m *TenantQuery) Page(
-	ctx context.Context, pageNum uint64, pageSize uint64, opts ...TenantPaginateOption,
-) (*TenantPageList, error) {
+func (_m *TenantInitTemplateQuery) Page(
+	ctx context.Context, pageNum uint64, pageSize uint64, opts ...TenantInitTemplatePaginateOption,
+) (*TenantInitTemplatePageList, error) {
 
-	pager, err := newTenantPager(opts)
+	pager, err := newTenantInitTemplatePager(opts)
 	if err != nil {
 		return nil, err
 	}
@@ -1089,7 +2647,7 @@ func (_m *TenantQuery) Page(
 		return nil, err
 	}
 
-	ret := &TenantPageList{}
+	ret := &TenantInitTemplatePageList{}
 
 	ret.PageDetails = &PageDetails{
 		Page: pageNum,
@@ -1109,7 +2667,7 @@ func (_m *TenantQuery) Page(
 	if pager.Order != nil {
 		_m = _m.Order(pager.Order)
 	} else {
-		_m = _m.Order(DefaultTenantOrder)
+		_m = _m.Order(DefaultTenantInitTemplateOrder)
 	}
 
 	_m = _m.Offset(int((pageNum - 1) * pageSize)).Limit(int(pageSize))
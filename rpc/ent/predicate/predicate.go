@@ -12,6 +12,18 @@ type API func(*sql.Selector)
 // AuditLog is the predicate function for auditlog builders.
 type AuditLog func(*sql.Selector)
 
+// AuditLogArchive is the predicate function for auditlogarchive builders.
+type AuditLogArchive func(*sql.Selector)
+
+// AuditLogChain is the predicate function for auditlogchain builders.
+type AuditLogChain func(*sql.Selector)
+
+// AuditLogCheckpoint is the predicate function for auditlogcheckpoint builders.
+type AuditLogCheckpoint func(*sql.Selector)
+
+// AuditLogPruneRecord is the predicate function for auditlogprunerecord builders.
+type AuditLogPruneRecord func(*sql.Selector)
+
 // CasbinRule is the predicate function for casbinrule builders.
 type CasbinRule func(*sql.Selector)
 
@@ -27,15 +39,42 @@ type Dictionary func(*sql.Selector)
 // DictionaryDetail is the predicate function for dictionarydetail builders.
 type DictionaryDetail func(*sql.Selector)
 
+// ImpersonationSession is the predicate function for impersonationsession builders.
+type ImpersonationSession func(*sql.Selector)
+
+// LdapAccount is the predicate function for ldapaccount builders.
+type LdapAccount func(*sql.Selector)
+
+// LdapDepartment is the predicate function for ldapdepartment builders.
+type LdapDepartment func(*sql.Selector)
+
+// LdapProvider is the predicate function for ldapprovider builders.
+type LdapProvider func(*sql.Selector)
+
+// LdapSyncRun is the predicate function for ldapsyncrun builders.
+type LdapSyncRun func(*sql.Selector)
+
 // Menu is the predicate function for menu builders.
 type Menu func(*sql.Selector)
 
 // OauthAccount is the predicate function for oauthaccount builders.
 type OauthAccount func(*sql.Selector)
 
+// OauthClient is the predicate function for oauthclient builders.
+type OauthClient func(*sql.Selector)
+
+// OauthConsent is the predicate function for oauthconsent builders.
+type OauthConsent func(*sql.Selector)
+
 // OauthProvider is the predicate function for oauthprovider builders.
 type OauthProvider func(*sql.Selector)
 
+// OauthProviderTemplate is the predicate function for oauthprovidertemplate builders.
+type OauthProviderTemplate func(*sql.Selector)
+
+// OauthScope is the predicate function for oauthscope builders.
+type OauthScope func(*sql.Selector)
+
 // OauthSession is the predicate function for oauthsession builders.
 type OauthSession func(*sql.Selector)
 
@@ -45,9 +84,27 @@ type Position func(*sql.Selector)
 // Role is the predicate function for role builders.
 type Role func(*sql.Selector)
 
+// SamlAccount is the predicate function for samlaccount builders.
+type SamlAccount func(*sql.Selector)
+
+// SamlProvider is the predicate function for samlprovider builders.
+type SamlProvider func(*sql.Selector)
+
+// ScimToken is the predicate function for scimtoken builders.
+type ScimToken func(*sql.Selector)
+
 // Tenant is the predicate function for tenant builders.
 type Tenant func(*sql.Selector)
 
+// TenantInitJob is the predicate function for tenantinitjob builders.
+type TenantInitJob func(*sql.Selector)
+
+// TenantInitPlugin is the predicate function for tenantinitplugin builders.
+type TenantInitPlugin func(*sql.Selector)
+
+// TenantInitTemplate is the predicate function for tenantinittemplate builders.
+type TenantInitTemplate func(*sql.Selector)
+
 // Token is the predicate function for token builders.
 type Token func(*sql.Selector)
 
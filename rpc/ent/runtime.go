@@ -7,19 +7,38 @@ import (
 
 	"github.com/coder-lulu/newbee-core/rpc/ent/api"
 	"github.com/coder-lulu/newbee-core/rpc/ent/auditlog"
+	"github.com/coder-lulu/newbee-core/rpc/ent/auditlogarchive"
+	"github.com/coder-lulu/newbee-core/rpc/ent/auditlogchain"
+	"github.com/coder-lulu/newbee-core/rpc/ent/auditlogcheckpoint"
+	"github.com/coder-lulu/newbee-core/rpc/ent/auditlogprunerecord"
 	"github.com/coder-lulu/newbee-core/rpc/ent/casbinrule"
 	"github.com/coder-lulu/newbee-core/rpc/ent/configuration"
 	"github.com/coder-lulu/newbee-core/rpc/ent/department"
 	"github.com/coder-lulu/newbee-core/rpc/ent/dictionary"
 	"github.com/coder-lulu/newbee-core/rpc/ent/dictionarydetail"
+	"github.com/coder-lulu/newbee-core/rpc/ent/impersonationsession"
+	"github.com/coder-lulu/newbee-core/rpc/ent/ldapaccount"
+	"github.com/coder-lulu/newbee-core/rpc/ent/ldapdepartment"
+	"github.com/coder-lulu/newbee-core/rpc/ent/ldapprovider"
+	"github.com/coder-lulu/newbee-core/rpc/ent/ldapsyncrun"
 	"github.com/coder-lulu/newbee-core/rpc/ent/menu"
 	"github.com/coder-lulu/newbee-core/rpc/ent/oauthaccount"
+	"github.com/coder-lulu/newbee-core/rpc/ent/oauthclient"
+	"github.com/coder-lulu/newbee-core/rpc/ent/oauthconsent"
 	"github.com/coder-lulu/newbee-core/rpc/ent/oauthprovider"
+	"github.com/coder-lulu/newbee-core/rpc/ent/oauthprovidertemplate"
+	"github.com/coder-lulu/newbee-core/rpc/ent/oauthscope"
 	"github.com/coder-lulu/newbee-core/rpc/ent/oauthsession"
 	"github.com/coder-lulu/newbee-core/rpc/ent/position"
 	"github.com/coder-lulu/newbee-core/rpc/ent/role"
+	"github.com/coder-lulu/newbee-core/rpc/ent/samlaccount"
+	"github.com/coder-lulu/newbee-core/rpc/ent/samlprovider"
 	"github.com/coder-lulu/newbee-core/rpc/ent/schema"
+	"github.com/coder-lulu/newbee-core/rpc/ent/scimtoken"
 	"github.com/coder-lulu/newbee-core/rpc/ent/tenant"
+	"github.com/coder-lulu/newbee-core/rpc/ent/tenantinitjob"
+	"github.com/coder-lulu/newbee-core/rpc/ent/tenantinitplugin"
+	"github.com/coder-lulu/newbee-core/rpc/ent/tenantinittemplate"
 	"github.com/coder-lulu/newbee-core/rpc/ent/token"
 	"github.com/coder-lulu/newbee-core/rpc/ent/user"
 	uuid "github.com/gofrs/uuid/v5"
@@ -62,6 +81,10 @@ func init() {
 	apiDescIsRequired := apiFields[5].Descriptor()
 	// api.DefaultIsRequired holds the default value on creation for the is_required field.
 	api.DefaultIsRequired = apiDescIsRequired.Default.(bool)
+	// apiDescIsDeprecated is the schema descriptor for is_deprecated field.
+	apiDescIsDeprecated := apiFields[6].Descriptor()
+	// api.DefaultIsDeprecated holds the default value on creation for the is_deprecated field.
+	api.DefaultIsDeprecated = apiDescIsDeprecated.Default.(bool)
 	auditlogMixin := schema.AuditLog{}.Mixin()
 	auditlogMixinFields0 := auditlogMixin[0].Fields()
 	_ = auditlogMixinFields0
@@ -91,6 +114,82 @@ func init() {
 	auditlogDescID := auditlogMixinFields0[0].Descriptor()
 	// auditlog.DefaultID holds the default value on creation for the id field.
 	auditlog.DefaultID = auditlogDescID.Default.(func() uuid.UUID)
+	auditlogarchiveMixin := schema.AuditLogArchive{}.Mixin()
+	auditlogarchiveMixinFields0 := auditlogarchiveMixin[0].Fields()
+	_ = auditlogarchiveMixinFields0
+	auditlogarchiveFields := schema.AuditLogArchive{}.Fields()
+	_ = auditlogarchiveFields
+	// auditlogarchiveDescCreatedAt is the schema descriptor for created_at field.
+	auditlogarchiveDescCreatedAt := auditlogarchiveMixinFields0[1].Descriptor()
+	// auditlogarchive.DefaultCreatedAt holds the default value on creation for the created_at field.
+	auditlogarchive.DefaultCreatedAt = auditlogarchiveDescCreatedAt.Default.(func() time.Time)
+	// auditlogarchiveDescUpdatedAt is the schema descriptor for updated_at field.
+	auditlogarchiveDescUpdatedAt := auditlogarchiveMixinFields0[2].Descriptor()
+	// auditlogarchive.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	auditlogarchive.DefaultUpdatedAt = auditlogarchiveDescUpdatedAt.Default.(func() time.Time)
+	// auditlogarchive.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	auditlogarchive.UpdateDefaultUpdatedAt = auditlogarchiveDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// auditlogarchiveDescID is the schema descriptor for id field.
+	auditlogarchiveDescID := auditlogarchiveMixinFields0[0].Descriptor()
+	// auditlogarchive.DefaultID holds the default value on creation for the id field.
+	auditlogarchive.DefaultID = auditlogarchiveDescID.Default.(func() uuid.UUID)
+	auditlogchainMixin := schema.AuditLogChain{}.Mixin()
+	auditlogchainMixinFields0 := auditlogchainMixin[0].Fields()
+	_ = auditlogchainMixinFields0
+	auditlogchainFields := schema.AuditLogChain{}.Fields()
+	_ = auditlogchainFields
+	// auditlogchainDescCreatedAt is the schema descriptor for created_at field.
+	auditlogchainDescCreatedAt := auditlogchainMixinFields0[1].Descriptor()
+	// auditlogchain.DefaultCreatedAt holds the default value on creation for the created_at field.
+	auditlogchain.DefaultCreatedAt = auditlogchainDescCreatedAt.Default.(func() time.Time)
+	// auditlogchainDescUpdatedAt is the schema descriptor for updated_at field.
+	auditlogchainDescUpdatedAt := auditlogchainMixinFields0[2].Descriptor()
+	// auditlogchain.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	auditlogchain.DefaultUpdatedAt = auditlogchainDescUpdatedAt.Default.(func() time.Time)
+	// auditlogchain.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	auditlogchain.UpdateDefaultUpdatedAt = auditlogchainDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// auditlogchainDescID is the schema descriptor for id field.
+	auditlogchainDescID := auditlogchainMixinFields0[0].Descriptor()
+	// auditlogchain.DefaultID holds the default value on creation for the id field.
+	auditlogchain.DefaultID = auditlogchainDescID.Default.(func() uuid.UUID)
+	auditlogcheckpointMixin := schema.AuditLogCheckpoint{}.Mixin()
+	auditlogcheckpointMixinFields0 := auditlogcheckpointMixin[0].Fields()
+	_ = auditlogcheckpointMixinFields0
+	auditlogcheckpointFields := schema.AuditLogCheckpoint{}.Fields()
+	_ = auditlogcheckpointFields
+	// auditlogcheckpointDescCreatedAt is the schema descriptor for created_at field.
+	auditlogcheckpointDescCreatedAt := auditlogcheckpointMixinFields0[1].Descriptor()
+	// auditlogcheckpoint.DefaultCreatedAt holds the default value on creation for the created_at field.
+	auditlogcheckpoint.DefaultCreatedAt = auditlogcheckpointDescCreatedAt.Default.(func() time.Time)
+	// auditlogcheckpointDescUpdatedAt is the schema descriptor for updated_at field.
+	auditlogcheckpointDescUpdatedAt := auditlogcheckpointMixinFields0[2].Descriptor()
+	// auditlogcheckpoint.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	auditlogcheckpoint.DefaultUpdatedAt = auditlogcheckpointDescUpdatedAt.Default.(func() time.Time)
+	// auditlogcheckpoint.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	auditlogcheckpoint.UpdateDefaultUpdatedAt = auditlogcheckpointDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// auditlogcheckpointDescID is the schema descriptor for id field.
+	auditlogcheckpointDescID := auditlogcheckpointMixinFields0[0].Descriptor()
+	// auditlogcheckpoint.DefaultID holds the default value on creation for the id field.
+	auditlogcheckpoint.DefaultID = auditlogcheckpointDescID.Default.(func() uuid.UUID)
+	auditlogprunerecordMixin := schema.AuditLogPruneRecord{}.Mixin()
+	auditlogprunerecordMixinFields0 := auditlogprunerecordMixin[0].Fields()
+	_ = auditlogprunerecordMixinFields0
+	auditlogprunerecordFields := schema.AuditLogPruneRecord{}.Fields()
+	_ = auditlogprunerecordFields
+	// auditlogprunerecordDescCreatedAt is the schema descriptor for created_at field.
+	auditlogprunerecordDescCreatedAt := auditlogprunerecordMixinFields0[1].Descriptor()
+	// auditlogprunerecord.DefaultCreatedAt holds the default value on creation for the created_at field.
+	auditlogprunerecord.DefaultCreatedAt = auditlogprunerecordDescCreatedAt.Default.(func() time.Time)
+	// auditlogprunerecordDescUpdatedAt is the schema descriptor for updated_at field.
+	auditlogprunerecordDescUpdatedAt := auditlogprunerecordMixinFields0[2].Descriptor()
+	// auditlogprunerecord.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	auditlogprunerecord.DefaultUpdatedAt = auditlogprunerecordDescUpdatedAt.Default.(func() time.Time)
+	// auditlogprunerecord.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	auditlogprunerecord.UpdateDefaultUpdatedAt = auditlogprunerecordDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// auditlogprunerecordDescID is the schema descriptor for id field.
+	auditlogprunerecordDescID := auditlogprunerecordMixinFields0[0].Descriptor()
+	// auditlogprunerecord.DefaultID holds the default value on creation for the id field.
+	auditlogprunerecord.DefaultID = auditlogprunerecordDescID.Default.(func() uuid.UUID)
 	casbinruleMixin := schema.CasbinRule{}.Mixin()
 	casbinruleMixinFields0 := casbinruleMixin[0].Fields()
 	_ = casbinruleMixinFields0
@@ -119,23 +218,23 @@ func init() {
 	// casbinrule.DefaultTenantID holds the default value on creation for the tenant_id field.
 	casbinrule.DefaultTenantID = casbinruleDescTenantID.Default.(uint64)
 	// casbinruleDescCategory is the schema descriptor for category field.
-	casbinruleDescCategory := casbinruleFields[10].Descriptor()
+	casbinruleDescCategory := casbinruleFields[11].Descriptor()
 	// casbinrule.DefaultCategory holds the default value on creation for the category field.
 	casbinrule.DefaultCategory = casbinruleDescCategory.Default.(string)
 	// casbinruleDescVersion is the schema descriptor for version field.
-	casbinruleDescVersion := casbinruleFields[11].Descriptor()
+	casbinruleDescVersion := casbinruleFields[12].Descriptor()
 	// casbinrule.DefaultVersion holds the default value on creation for the version field.
 	casbinrule.DefaultVersion = casbinruleDescVersion.Default.(string)
 	// casbinruleDescRequireApproval is the schema descriptor for require_approval field.
-	casbinruleDescRequireApproval := casbinruleFields[12].Descriptor()
+	casbinruleDescRequireApproval := casbinruleFields[13].Descriptor()
 	// casbinrule.DefaultRequireApproval holds the default value on creation for the require_approval field.
 	casbinrule.DefaultRequireApproval = casbinruleDescRequireApproval.Default.(bool)
 	// casbinruleDescIsTemporary is the schema descriptor for is_temporary field.
-	casbinruleDescIsTemporary := casbinruleFields[18].Descriptor()
+	casbinruleDescIsTemporary := casbinruleFields[19].Descriptor()
 	// casbinrule.DefaultIsTemporary holds the default value on creation for the is_temporary field.
 	casbinrule.DefaultIsTemporary = casbinruleDescIsTemporary.Default.(bool)
 	// casbinruleDescUsageCount is the schema descriptor for usage_count field.
-	casbinruleDescUsageCount := casbinruleFields[21].Descriptor()
+	casbinruleDescUsageCount := casbinruleFields[22].Descriptor()
 	// casbinrule.DefaultUsageCount holds the default value on creation for the usage_count field.
 	casbinrule.DefaultUsageCount = casbinruleDescUsageCount.Default.(int64)
 	configurationMixin := schema.Configuration{}.Mixin()
@@ -280,6 +379,299 @@ func init() {
 	dictionarydetailDescIsDefault := dictionarydetailFields[4].Descriptor()
 	// dictionarydetail.DefaultIsDefault holds the default value on creation for the is_default field.
 	dictionarydetail.DefaultIsDefault = dictionarydetailDescIsDefault.Default.(uint32)
+	impersonationsessionMixin := schema.ImpersonationSession{}.Mixin()
+	impersonationsessionMixinFields0 := impersonationsessionMixin[0].Fields()
+	_ = impersonationsessionMixinFields0
+	impersonationsessionFields := schema.ImpersonationSession{}.Fields()
+	_ = impersonationsessionFields
+	// impersonationsessionDescCreatedAt is the schema descriptor for created_at field.
+	impersonationsessionDescCreatedAt := impersonationsessionMixinFields0[1].Descriptor()
+	// impersonationsession.DefaultCreatedAt holds the default value on creation for the created_at field.
+	impersonationsession.DefaultCreatedAt = impersonationsessionDescCreatedAt.Default.(func() time.Time)
+	// impersonationsessionDescUpdatedAt is the schema descriptor for updated_at field.
+	impersonationsessionDescUpdatedAt := impersonationsessionMixinFields0[2].Descriptor()
+	// impersonationsession.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	impersonationsession.DefaultUpdatedAt = impersonationsessionDescUpdatedAt.Default.(func() time.Time)
+	// impersonationsession.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	impersonationsession.UpdateDefaultUpdatedAt = impersonationsessionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// impersonationsessionDescUserID is the schema descriptor for user_id field.
+	impersonationsessionDescUserID := impersonationsessionFields[0].Descriptor()
+	// impersonationsession.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	impersonationsession.UserIDValidator = impersonationsessionDescUserID.Validators[0].(func(string) error)
+	// impersonationsessionDescUserName is the schema descriptor for user_name field.
+	impersonationsessionDescUserName := impersonationsessionFields[1].Descriptor()
+	// impersonationsession.UserNameValidator is a validator for the "user_name" field. It is called by the builders before save.
+	impersonationsession.UserNameValidator = impersonationsessionDescUserName.Validators[0].(func(string) error)
+	// impersonationsessionDescReason is the schema descriptor for reason field.
+	impersonationsessionDescReason := impersonationsessionFields[4].Descriptor()
+	// impersonationsession.ReasonValidator is a validator for the "reason" field. It is called by the builders before save.
+	impersonationsession.ReasonValidator = impersonationsessionDescReason.Validators[0].(func(string) error)
+	// impersonationsessionDescTicket is the schema descriptor for ticket field.
+	impersonationsessionDescTicket := impersonationsessionFields[5].Descriptor()
+	// impersonationsession.TicketValidator is a validator for the "ticket" field. It is called by the builders before save.
+	impersonationsession.TicketValidator = impersonationsessionDescTicket.Validators[0].(func(string) error)
+	// impersonationsessionDescState is the schema descriptor for state field.
+	impersonationsessionDescState := impersonationsessionFields[6].Descriptor()
+	// impersonationsession.StateValidator is a validator for the "state" field. It is called by the builders before save.
+	impersonationsession.StateValidator = impersonationsessionDescState.Validators[0].(func(string) error)
+	// impersonationsessionDescApprovedBy is the schema descriptor for approved_by field.
+	impersonationsessionDescApprovedBy := impersonationsessionFields[8].Descriptor()
+	// impersonationsession.ApprovedByValidator is a validator for the "approved_by" field. It is called by the builders before save.
+	impersonationsession.ApprovedByValidator = impersonationsessionDescApprovedBy.Validators[0].(func(string) error)
+	ldapaccountMixin := schema.LdapAccount{}.Mixin()
+	ldapaccountMixinFields0 := ldapaccountMixin[0].Fields()
+	_ = ldapaccountMixinFields0
+	ldapaccountMixinFields1 := ldapaccountMixin[1].Fields()
+	_ = ldapaccountMixinFields1
+	ldapaccountMixinFields2 := ldapaccountMixin[2].Fields()
+	_ = ldapaccountMixinFields2
+	ldapaccountFields := schema.LdapAccount{}.Fields()
+	_ = ldapaccountFields
+	// ldapaccountDescCreatedAt is the schema descriptor for created_at field.
+	ldapaccountDescCreatedAt := ldapaccountMixinFields0[1].Descriptor()
+	// ldapaccount.DefaultCreatedAt holds the default value on creation for the created_at field.
+	ldapaccount.DefaultCreatedAt = ldapaccountDescCreatedAt.Default.(func() time.Time)
+	// ldapaccountDescUpdatedAt is the schema descriptor for updated_at field.
+	ldapaccountDescUpdatedAt := ldapaccountMixinFields0[2].Descriptor()
+	// ldapaccount.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	ldapaccount.DefaultUpdatedAt = ldapaccountDescUpdatedAt.Default.(func() time.Time)
+	// ldapaccount.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	ldapaccount.UpdateDefaultUpdatedAt = ldapaccountDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// ldapaccountDescStatus is the schema descriptor for status field.
+	ldapaccountDescStatus := ldapaccountMixinFields1[0].Descriptor()
+	// ldapaccount.DefaultStatus holds the default value on creation for the status field.
+	ldapaccount.DefaultStatus = ldapaccountDescStatus.Default.(uint8)
+	// ldapaccountDescTenantID is the schema descriptor for tenant_id field.
+	ldapaccountDescTenantID := ldapaccountMixinFields2[0].Descriptor()
+	// ldapaccount.DefaultTenantID holds the default value on creation for the tenant_id field.
+	ldapaccount.DefaultTenantID = ldapaccountDescTenantID.Default.(uint64)
+	// ldapaccountDescExternalID is the schema descriptor for external_id field.
+	ldapaccountDescExternalID := ldapaccountFields[2].Descriptor()
+	// ldapaccount.ExternalIDValidator is a validator for the "external_id" field. It is called by the builders before save.
+	ldapaccount.ExternalIDValidator = ldapaccountDescExternalID.Validators[0].(func(string) error)
+	// ldapaccountDescDn is the schema descriptor for dn field.
+	ldapaccountDescDn := ldapaccountFields[3].Descriptor()
+	// ldapaccount.DnValidator is a validator for the "dn" field. It is called by the builders before save.
+	ldapaccount.DnValidator = ldapaccountDescDn.Validators[0].(func(string) error)
+	// ldapaccountDescLastLoginIP is the schema descriptor for last_login_ip field.
+	ldapaccountDescLastLoginIP := ldapaccountFields[6].Descriptor()
+	// ldapaccount.LastLoginIPValidator is a validator for the "last_login_ip" field. It is called by the builders before save.
+	ldapaccount.LastLoginIPValidator = ldapaccountDescLastLoginIP.Validators[0].(func(string) error)
+	// ldapaccountDescLoginCount is the schema descriptor for login_count field.
+	ldapaccountDescLoginCount := ldapaccountFields[7].Descriptor()
+	// ldapaccount.DefaultLoginCount holds the default value on creation for the login_count field.
+	ldapaccount.DefaultLoginCount = ldapaccountDescLoginCount.Default.(uint32)
+	ldapdepartmentMixin := schema.LdapDepartment{}.Mixin()
+	ldapdepartmentMixinFields0 := ldapdepartmentMixin[0].Fields()
+	_ = ldapdepartmentMixinFields0
+	ldapdepartmentMixinFields1 := ldapdepartmentMixin[1].Fields()
+	_ = ldapdepartmentMixinFields1
+	ldapdepartmentFields := schema.LdapDepartment{}.Fields()
+	_ = ldapdepartmentFields
+	// ldapdepartmentDescCreatedAt is the schema descriptor for created_at field.
+	ldapdepartmentDescCreatedAt := ldapdepartmentMixinFields0[1].Descriptor()
+	// ldapdepartment.DefaultCreatedAt holds the default value on creation for the created_at field.
+	ldapdepartment.DefaultCreatedAt = ldapdepartmentDescCreatedAt.Default.(func() time.Time)
+	// ldapdepartmentDescUpdatedAt is the schema descriptor for updated_at field.
+	ldapdepartmentDescUpdatedAt := ldapdepartmentMixinFields0[2].Descriptor()
+	// ldapdepartment.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	ldapdepartment.DefaultUpdatedAt = ldapdepartmentDescUpdatedAt.Default.(func() time.Time)
+	// ldapdepartment.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	ldapdepartment.UpdateDefaultUpdatedAt = ldapdepartmentDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// ldapdepartmentDescTenantID is the schema descriptor for tenant_id field.
+	ldapdepartmentDescTenantID := ldapdepartmentMixinFields1[0].Descriptor()
+	// ldapdepartment.DefaultTenantID holds the default value on creation for the tenant_id field.
+	ldapdepartment.DefaultTenantID = ldapdepartmentDescTenantID.Default.(uint64)
+	// ldapdepartmentDescExternalID is the schema descriptor for external_id field.
+	ldapdepartmentDescExternalID := ldapdepartmentFields[2].Descriptor()
+	// ldapdepartment.ExternalIDValidator is a validator for the "external_id" field. It is called by the builders before save.
+	ldapdepartment.ExternalIDValidator = ldapdepartmentDescExternalID.Validators[0].(func(string) error)
+	// ldapdepartmentDescDn is the schema descriptor for dn field.
+	ldapdepartmentDescDn := ldapdepartmentFields[3].Descriptor()
+	// ldapdepartment.DnValidator is a validator for the "dn" field. It is called by the builders before save.
+	ldapdepartment.DnValidator = ldapdepartmentDescDn.Validators[0].(func(string) error)
+	ldapproviderMixin := schema.LdapProvider{}.Mixin()
+	ldapproviderMixinFields0 := ldapproviderMixin[0].Fields()
+	_ = ldapproviderMixinFields0
+	ldapproviderMixinFields1 := ldapproviderMixin[1].Fields()
+	_ = ldapproviderMixinFields1
+	ldapproviderMixinFields2 := ldapproviderMixin[2].Fields()
+	_ = ldapproviderMixinFields2
+	ldapproviderFields := schema.LdapProvider{}.Fields()
+	_ = ldapproviderFields
+	// ldapproviderDescCreatedAt is the schema descriptor for created_at field.
+	ldapproviderDescCreatedAt := ldapproviderMixinFields0[1].Descriptor()
+	// ldapprovider.DefaultCreatedAt holds the default value on creation for the created_at field.
+	ldapprovider.DefaultCreatedAt = ldapproviderDescCreatedAt.Default.(func() time.Time)
+	// ldapproviderDescUpdatedAt is the schema descriptor for updated_at field.
+	ldapproviderDescUpdatedAt := ldapproviderMixinFields0[2].Descriptor()
+	// ldapprovider.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	ldapprovider.DefaultUpdatedAt = ldapproviderDescUpdatedAt.Default.(func() time.Time)
+	// ldapprovider.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	ldapprovider.UpdateDefaultUpdatedAt = ldapproviderDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// ldapproviderDescStatus is the schema descriptor for status field.
+	ldapproviderDescStatus := ldapproviderMixinFields1[0].Descriptor()
+	// ldapprovider.DefaultStatus holds the default value on creation for the status field.
+	ldapprovider.DefaultStatus = ldapproviderDescStatus.Default.(uint8)
+	// ldapproviderDescTenantID is the schema descriptor for tenant_id field.
+	ldapproviderDescTenantID := ldapproviderMixinFields2[0].Descriptor()
+	// ldapprovider.DefaultTenantID holds the default value on creation for the tenant_id field.
+	ldapprovider.DefaultTenantID = ldapproviderDescTenantID.Default.(uint64)
+	// ldapproviderDescName is the schema descriptor for name field.
+	ldapproviderDescName := ldapproviderFields[0].Descriptor()
+	// ldapprovider.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	ldapprovider.NameValidator = ldapproviderDescName.Validators[0].(func(string) error)
+	// ldapproviderDescDisplayName is the schema descriptor for display_name field.
+	ldapproviderDescDisplayName := ldapproviderFields[1].Descriptor()
+	// ldapprovider.DisplayNameValidator is a validator for the "display_name" field. It is called by the builders before save.
+	ldapprovider.DisplayNameValidator = ldapproviderDescDisplayName.Validators[0].(func(string) error)
+	// ldapproviderDescDirectoryType is the schema descriptor for directory_type field.
+	ldapproviderDescDirectoryType := ldapproviderFields[2].Descriptor()
+	// ldapprovider.DefaultDirectoryType holds the default value on creation for the directory_type field.
+	ldapprovider.DefaultDirectoryType = ldapproviderDescDirectoryType.Default.(string)
+	// ldapprovider.DirectoryTypeValidator is a validator for the "directory_type" field. It is called by the builders before save.
+	ldapprovider.DirectoryTypeValidator = ldapproviderDescDirectoryType.Validators[0].(func(string) error)
+	// ldapproviderDescURL is the schema descriptor for url field.
+	ldapproviderDescURL := ldapproviderFields[3].Descriptor()
+	// ldapprovider.URLValidator is a validator for the "url" field. It is called by the builders before save.
+	ldapprovider.URLValidator = ldapproviderDescURL.Validators[0].(func(string) error)
+	// ldapproviderDescStartTLS is the schema descriptor for start_tls field.
+	ldapproviderDescStartTLS := ldapproviderFields[4].Descriptor()
+	// ldapprovider.DefaultStartTLS holds the default value on creation for the start_tls field.
+	ldapprovider.DefaultStartTLS = ldapproviderDescStartTLS.Default.(bool)
+	// ldapproviderDescInsecureSkipVerify is the schema descriptor for insecure_skip_verify field.
+	ldapproviderDescInsecureSkipVerify := ldapproviderFields[5].Descriptor()
+	// ldapprovider.DefaultInsecureSkipVerify holds the default value on creation for the insecure_skip_verify field.
+	ldapprovider.DefaultInsecureSkipVerify = ldapproviderDescInsecureSkipVerify.Default.(bool)
+	// ldapproviderDescBindDn is the schema descriptor for bind_dn field.
+	ldapproviderDescBindDn := ldapproviderFields[6].Descriptor()
+	// ldapprovider.BindDnValidator is a validator for the "bind_dn" field. It is called by the builders before save.
+	ldapprovider.BindDnValidator = ldapproviderDescBindDn.Validators[0].(func(string) error)
+	// ldapproviderDescEncryptionKeyID is the schema descriptor for encryption_key_id field.
+	ldapproviderDescEncryptionKeyID := ldapproviderFields[8].Descriptor()
+	// ldapprovider.EncryptionKeyIDValidator is a validator for the "encryption_key_id" field. It is called by the builders before save.
+	ldapprovider.EncryptionKeyIDValidator = ldapproviderDescEncryptionKeyID.Validators[0].(func(string) error)
+	// ldapproviderDescBaseDn is the schema descriptor for base_dn field.
+	ldapproviderDescBaseDn := ldapproviderFields[9].Descriptor()
+	// ldapprovider.BaseDnValidator is a validator for the "base_dn" field. It is called by the builders before save.
+	ldapprovider.BaseDnValidator = ldapproviderDescBaseDn.Validators[0].(func(string) error)
+	// ldapproviderDescAuthMode is the schema descriptor for auth_mode field.
+	ldapproviderDescAuthMode := ldapproviderFields[10].Descriptor()
+	// ldapprovider.DefaultAuthMode holds the default value on creation for the auth_mode field.
+	ldapprovider.DefaultAuthMode = ldapproviderDescAuthMode.Default.(string)
+	// ldapprovider.AuthModeValidator is a validator for the "auth_mode" field. It is called by the builders before save.
+	ldapprovider.AuthModeValidator = ldapproviderDescAuthMode.Validators[0].(func(string) error)
+	// ldapproviderDescUserDnTemplate is the schema descriptor for user_dn_template field.
+	ldapproviderDescUserDnTemplate := ldapproviderFields[11].Descriptor()
+	// ldapprovider.UserDnTemplateValidator is a validator for the "user_dn_template" field. It is called by the builders before save.
+	ldapprovider.UserDnTemplateValidator = ldapproviderDescUserDnTemplate.Validators[0].(func(string) error)
+	// ldapproviderDescUserFilter is the schema descriptor for user_filter field.
+	ldapproviderDescUserFilter := ldapproviderFields[12].Descriptor()
+	// ldapprovider.UserFilterValidator is a validator for the "user_filter" field. It is called by the builders before save.
+	ldapprovider.UserFilterValidator = ldapproviderDescUserFilter.Validators[0].(func(string) error)
+	// ldapproviderDescOuBaseDn is the schema descriptor for ou_base_dn field.
+	ldapproviderDescOuBaseDn := ldapproviderFields[13].Descriptor()
+	// ldapprovider.OuBaseDnValidator is a validator for the "ou_base_dn" field. It is called by the builders before save.
+	ldapprovider.OuBaseDnValidator = ldapproviderDescOuBaseDn.Validators[0].(func(string) error)
+	// ldapproviderDescOuFilter is the schema descriptor for ou_filter field.
+	ldapproviderDescOuFilter := ldapproviderFields[14].Descriptor()
+	// ldapprovider.OuFilterValidator is a validator for the "ou_filter" field. It is called by the builders before save.
+	ldapprovider.OuFilterValidator = ldapproviderDescOuFilter.Validators[0].(func(string) error)
+	// ldapproviderDescGroupBaseDn is the schema descriptor for group_base_dn field.
+	ldapproviderDescGroupBaseDn := ldapproviderFields[15].Descriptor()
+	// ldapprovider.GroupBaseDnValidator is a validator for the "group_base_dn" field. It is called by the builders before save.
+	ldapprovider.GroupBaseDnValidator = ldapproviderDescGroupBaseDn.Validators[0].(func(string) error)
+	// ldapproviderDescGroupFilter is the schema descriptor for group_filter field.
+	ldapproviderDescGroupFilter := ldapproviderFields[16].Descriptor()
+	// ldapprovider.GroupFilterValidator is a validator for the "group_filter" field. It is called by the builders before save.
+	ldapprovider.GroupFilterValidator = ldapproviderDescGroupFilter.Validators[0].(func(string) error)
+	// ldapproviderDescGroupMemberAttribute is the schema descriptor for group_member_attribute field.
+	ldapproviderDescGroupMemberAttribute := ldapproviderFields[17].Descriptor()
+	// ldapprovider.GroupMemberAttributeValidator is a validator for the "group_member_attribute" field. It is called by the builders before save.
+	ldapprovider.GroupMemberAttributeValidator = ldapproviderDescGroupMemberAttribute.Validators[0].(func(string) error)
+	// ldapproviderDescLoginEnabled is the schema descriptor for login_enabled field.
+	ldapproviderDescLoginEnabled := ldapproviderFields[19].Descriptor()
+	// ldapprovider.DefaultLoginEnabled holds the default value on creation for the login_enabled field.
+	ldapprovider.DefaultLoginEnabled = ldapproviderDescLoginEnabled.Default.(bool)
+	// ldapproviderDescJitProvision is the schema descriptor for jit_provision field.
+	ldapproviderDescJitProvision := ldapproviderFields[20].Descriptor()
+	// ldapprovider.DefaultJitProvision holds the default value on creation for the jit_provision field.
+	ldapprovider.DefaultJitProvision = ldapproviderDescJitProvision.Default.(bool)
+	// ldapproviderDescLinkExisting is the schema descriptor for link_existing field.
+	ldapproviderDescLinkExisting := ldapproviderFields[21].Descriptor()
+	// ldapprovider.DefaultLinkExisting holds the default value on creation for the link_existing field.
+	ldapprovider.DefaultLinkExisting = ldapproviderDescLinkExisting.Default.(bool)
+	// ldapproviderDescSyncEnabled is the schema descriptor for sync_enabled field.
+	ldapproviderDescSyncEnabled := ldapproviderFields[22].Descriptor()
+	// ldapprovider.DefaultSyncEnabled holds the default value on creation for the sync_enabled field.
+	ldapprovider.DefaultSyncEnabled = ldapproviderDescSyncEnabled.Default.(bool)
+	// ldapproviderDescSyncInterval is the schema descriptor for sync_interval field.
+	ldapproviderDescSyncInterval := ldapproviderFields[23].Descriptor()
+	// ldapprovider.DefaultSyncInterval holds the default value on creation for the sync_interval field.
+	ldapprovider.DefaultSyncInterval = ldapproviderDescSyncInterval.Default.(uint32)
+	// ldapproviderDescDeletionPolicy is the schema descriptor for deletion_policy field.
+	ldapproviderDescDeletionPolicy := ldapproviderFields[24].Descriptor()
+	// ldapprovider.DefaultDeletionPolicy holds the default value on creation for the deletion_policy field.
+	ldapprovider.DefaultDeletionPolicy = ldapproviderDescDeletionPolicy.Default.(string)
+	// ldapprovider.DeletionPolicyValidator is a validator for the "deletion_policy" field. It is called by the builders before save.
+	ldapprovider.DeletionPolicyValidator = ldapproviderDescDeletionPolicy.Validators[0].(func(string) error)
+	// ldapproviderDescRootDepartmentID is the schema descriptor for root_department_id field.
+	ldapproviderDescRootDepartmentID := ldapproviderFields[25].Descriptor()
+	// ldapprovider.DefaultRootDepartmentID holds the default value on creation for the root_department_id field.
+	ldapprovider.DefaultRootDepartmentID = ldapproviderDescRootDepartmentID.Default.(uint64)
+	// ldapproviderDescDefaultDepartmentID is the schema descriptor for default_department_id field.
+	ldapproviderDescDefaultDepartmentID := ldapproviderFields[26].Descriptor()
+	// ldapprovider.DefaultDefaultDepartmentID holds the default value on creation for the default_department_id field.
+	ldapprovider.DefaultDefaultDepartmentID = ldapproviderDescDefaultDepartmentID.Default.(uint64)
+	// ldapproviderDescEnabled is the schema descriptor for enabled field.
+	ldapproviderDescEnabled := ldapproviderFields[29].Descriptor()
+	// ldapprovider.DefaultEnabled holds the default value on creation for the enabled field.
+	ldapprovider.DefaultEnabled = ldapproviderDescEnabled.Default.(bool)
+	// ldapproviderDescSort is the schema descriptor for sort field.
+	ldapproviderDescSort := ldapproviderFields[30].Descriptor()
+	// ldapprovider.DefaultSort holds the default value on creation for the sort field.
+	ldapprovider.DefaultSort = ldapproviderDescSort.Default.(uint32)
+	// ldapproviderDescRemark is the schema descriptor for remark field.
+	ldapproviderDescRemark := ldapproviderFields[31].Descriptor()
+	// ldapprovider.RemarkValidator is a validator for the "remark" field. It is called by the builders before save.
+	ldapprovider.RemarkValidator = ldapproviderDescRemark.Validators[0].(func(string) error)
+	ldapsyncrunMixin := schema.LdapSyncRun{}.Mixin()
+	ldapsyncrunMixinFields0 := ldapsyncrunMixin[0].Fields()
+	_ = ldapsyncrunMixinFields0
+	ldapsyncrunMixinFields1 := ldapsyncrunMixin[1].Fields()
+	_ = ldapsyncrunMixinFields1
+	ldapsyncrunFields := schema.LdapSyncRun{}.Fields()
+	_ = ldapsyncrunFields
+	// ldapsyncrunDescCreatedAt is the schema descriptor for created_at field.
+	ldapsyncrunDescCreatedAt := ldapsyncrunMixinFields0[1].Descriptor()
+	// ldapsyncrun.DefaultCreatedAt holds the default value on creation for the created_at field.
+	ldapsyncrun.DefaultCreatedAt = ldapsyncrunDescCreatedAt.Default.(func() time.Time)
+	// ldapsyncrunDescUpdatedAt is the schema descriptor for updated_at field.
+	ldapsyncrunDescUpdatedAt := ldapsyncrunMixinFields0[2].Descriptor()
+	// ldapsyncrun.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	ldapsyncrun.DefaultUpdatedAt = ldapsyncrunDescUpdatedAt.Default.(func() time.Time)
+	// ldapsyncrun.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	ldapsyncrun.UpdateDefaultUpdatedAt = ldapsyncrunDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// ldapsyncrunDescTenantID is the schema descriptor for tenant_id field.
+	ldapsyncrunDescTenantID := ldapsyncrunMixinFields1[0].Descriptor()
+	// ldapsyncrun.DefaultTenantID holds the default value on creation for the tenant_id field.
+	ldapsyncrun.DefaultTenantID = ldapsyncrunDescTenantID.Default.(uint64)
+	// ldapsyncrunDescTrigger is the schema descriptor for trigger field.
+	ldapsyncrunDescTrigger := ldapsyncrunFields[1].Descriptor()
+	// ldapsyncrun.TriggerValidator is a validator for the "trigger" field. It is called by the builders before save.
+	ldapsyncrun.TriggerValidator = ldapsyncrunDescTrigger.Validators[0].(func(string) error)
+	// ldapsyncrunDescDryRun is the schema descriptor for dry_run field.
+	ldapsyncrunDescDryRun := ldapsyncrunFields[2].Descriptor()
+	// ldapsyncrun.DefaultDryRun holds the default value on creation for the dry_run field.
+	ldapsyncrun.DefaultDryRun = ldapsyncrunDescDryRun.Default.(bool)
+	// ldapsyncrunDescResult is the schema descriptor for result field.
+	ldapsyncrunDescResult := ldapsyncrunFields[3].Descriptor()
+	// ldapsyncrun.ResultValidator is a validator for the "result" field. It is called by the builders before save.
+	ldapsyncrun.ResultValidator = ldapsyncrunDescResult.Validators[0].(func(string) error)
+	// ldapsyncrunDescTruncated is the schema descriptor for truncated field.
+	ldapsyncrunDescTruncated := ldapsyncrunFields[10].Descriptor()
+	// ldapsyncrun.DefaultTruncated holds the default value on creation for the truncated field.
+	ldapsyncrun.DefaultTruncated = ldapsyncrunDescTruncated.Default.(bool)
 	menuMixin := schema.Menu{}.Mixin()
 	menuMixinFields0 := menuMixin[0].Fields()
 	_ = menuMixinFields0
@@ -426,26 +818,112 @@ func init() {
 	oauthaccountDescProviderAvatar := oauthaccountFields[7].Descriptor()
 	// oauthaccount.ProviderAvatarValidator is a validator for the "provider_avatar" field. It is called by the builders before save.
 	oauthaccount.ProviderAvatarValidator = oauthaccountDescProviderAvatar.Validators[0].(func(string) error)
-	// oauthaccountDescAccessToken is the schema descriptor for access_token field.
-	oauthaccountDescAccessToken := oauthaccountFields[8].Descriptor()
-	// oauthaccount.AccessTokenValidator is a validator for the "access_token" field. It is called by the builders before save.
-	oauthaccount.AccessTokenValidator = oauthaccountDescAccessToken.Validators[0].(func(string) error)
-	// oauthaccountDescRefreshToken is the schema descriptor for refresh_token field.
-	oauthaccountDescRefreshToken := oauthaccountFields[9].Descriptor()
-	// oauthaccount.RefreshTokenValidator is a validator for the "refresh_token" field. It is called by the builders before save.
-	oauthaccount.RefreshTokenValidator = oauthaccountDescRefreshToken.Validators[0].(func(string) error)
+	// oauthaccountDescEncryptionKeyID is the schema descriptor for encryption_key_id field.
+	oauthaccountDescEncryptionKeyID := oauthaccountFields[11].Descriptor()
+	// oauthaccount.EncryptionKeyIDValidator is a validator for the "encryption_key_id" field. It is called by the builders before save.
+	oauthaccount.EncryptionKeyIDValidator = oauthaccountDescEncryptionKeyID.Validators[0].(func(string) error)
 	// oauthaccountDescLastLoginIP is the schema descriptor for last_login_ip field.
-	oauthaccountDescLastLoginIP := oauthaccountFields[13].Descriptor()
+	oauthaccountDescLastLoginIP := oauthaccountFields[14].Descriptor()
 	// oauthaccount.LastLoginIPValidator is a validator for the "last_login_ip" field. It is called by the builders before save.
 	oauthaccount.LastLoginIPValidator = oauthaccountDescLastLoginIP.Validators[0].(func(string) error)
 	// oauthaccountDescLoginCount is the schema descriptor for login_count field.
-	oauthaccountDescLoginCount := oauthaccountFields[14].Descriptor()
+	oauthaccountDescLoginCount := oauthaccountFields[15].Descriptor()
 	// oauthaccount.DefaultLoginCount holds the default value on creation for the login_count field.
 	oauthaccount.DefaultLoginCount = oauthaccountDescLoginCount.Default.(uint32)
 	// oauthaccountDescDepartmentID is the schema descriptor for department_id field.
-	oauthaccountDescDepartmentID := oauthaccountFields[15].Descriptor()
+	oauthaccountDescDepartmentID := oauthaccountFields[16].Descriptor()
 	// oauthaccount.DefaultDepartmentID holds the default value on creation for the department_id field.
 	oauthaccount.DefaultDepartmentID = oauthaccountDescDepartmentID.Default.(uint64)
+	oauthclientMixin := schema.OauthClient{}.Mixin()
+	oauthclientMixinFields0 := oauthclientMixin[0].Fields()
+	_ = oauthclientMixinFields0
+	oauthclientMixinFields1 := oauthclientMixin[1].Fields()
+	_ = oauthclientMixinFields1
+	oauthclientMixinFields2 := oauthclientMixin[2].Fields()
+	_ = oauthclientMixinFields2
+	oauthclientFields := schema.OauthClient{}.Fields()
+	_ = oauthclientFields
+	// oauthclientDescCreatedAt is the schema descriptor for created_at field.
+	oauthclientDescCreatedAt := oauthclientMixinFields0[1].Descriptor()
+	// oauthclient.DefaultCreatedAt holds the default value on creation for the created_at field.
+	oauthclient.DefaultCreatedAt = oauthclientDescCreatedAt.Default.(func() time.Time)
+	// oauthclientDescUpdatedAt is the schema descriptor for updated_at field.
+	oauthclientDescUpdatedAt := oauthclientMixinFields0[2].Descriptor()
+	// oauthclient.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	oauthclient.DefaultUpdatedAt = oauthclientDescUpdatedAt.Default.(func() time.Time)
+	// oauthclient.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	oauthclient.UpdateDefaultUpdatedAt = oauthclientDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// oauthclientDescStatus is the schema descriptor for status field.
+	oauthclientDescStatus := oauthclientMixinFields1[0].Descriptor()
+	// oauthclient.DefaultStatus holds the default value on creation for the status field.
+	oauthclient.DefaultStatus = oauthclientDescStatus.Default.(uint8)
+	// oauthclientDescTenantID is the schema descriptor for tenant_id field.
+	oauthclientDescTenantID := oauthclientMixinFields2[0].Descriptor()
+	// oauthclient.DefaultTenantID holds the default value on creation for the tenant_id field.
+	oauthclient.DefaultTenantID = oauthclientDescTenantID.Default.(uint64)
+	// oauthclientDescName is the schema descriptor for name field.
+	oauthclientDescName := oauthclientFields[0].Descriptor()
+	// oauthclient.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	oauthclient.NameValidator = oauthclientDescName.Validators[0].(func(string) error)
+	// oauthclientDescClientID is the schema descriptor for client_id field.
+	oauthclientDescClientID := oauthclientFields[1].Descriptor()
+	// oauthclient.ClientIDValidator is a validator for the "client_id" field. It is called by the builders before save.
+	oauthclient.ClientIDValidator = oauthclientDescClientID.Validators[0].(func(string) error)
+	// oauthclientDescClientSecretHash is the schema descriptor for client_secret_hash field.
+	oauthclientDescClientSecretHash := oauthclientFields[2].Descriptor()
+	// oauthclient.DefaultClientSecretHash holds the default value on creation for the client_secret_hash field.
+	oauthclient.DefaultClientSecretHash = oauthclientDescClientSecretHash.Default.(string)
+	// oauthclient.ClientSecretHashValidator is a validator for the "client_secret_hash" field. It is called by the builders before save.
+	oauthclient.ClientSecretHashValidator = oauthclientDescClientSecretHash.Validators[0].(func(string) error)
+	// oauthclientDescClientType is the schema descriptor for client_type field.
+	oauthclientDescClientType := oauthclientFields[3].Descriptor()
+	// oauthclient.DefaultClientType holds the default value on creation for the client_type field.
+	oauthclient.DefaultClientType = oauthclientDescClientType.Default.(string)
+	// oauthclient.ClientTypeValidator is a validator for the "client_type" field. It is called by the builders before save.
+	oauthclient.ClientTypeValidator = oauthclientDescClientType.Validators[0].(func(string) error)
+	// oauthclientDescTrusted is the schema descriptor for trusted field.
+	oauthclientDescTrusted := oauthclientFields[7].Descriptor()
+	// oauthclient.DefaultTrusted holds the default value on creation for the trusted field.
+	oauthclient.DefaultTrusted = oauthclientDescTrusted.Default.(bool)
+	// oauthclientDescAccessTokenTTL is the schema descriptor for access_token_ttl field.
+	oauthclientDescAccessTokenTTL := oauthclientFields[8].Descriptor()
+	// oauthclient.DefaultAccessTokenTTL holds the default value on creation for the access_token_ttl field.
+	oauthclient.DefaultAccessTokenTTL = oauthclientDescAccessTokenTTL.Default.(uint32)
+	// oauthclientDescRefreshTokenTTL is the schema descriptor for refresh_token_ttl field.
+	oauthclientDescRefreshTokenTTL := oauthclientFields[9].Descriptor()
+	// oauthclient.DefaultRefreshTokenTTL holds the default value on creation for the refresh_token_ttl field.
+	oauthclient.DefaultRefreshTokenTTL = oauthclientDescRefreshTokenTTL.Default.(uint32)
+	// oauthclientDescRemark is the schema descriptor for remark field.
+	oauthclientDescRemark := oauthclientFields[10].Descriptor()
+	// oauthclient.DefaultRemark holds the default value on creation for the remark field.
+	oauthclient.DefaultRemark = oauthclientDescRemark.Default.(string)
+	// oauthclient.RemarkValidator is a validator for the "remark" field. It is called by the builders before save.
+	oauthclient.RemarkValidator = oauthclientDescRemark.Validators[0].(func(string) error)
+	oauthconsentMixin := schema.OauthConsent{}.Mixin()
+	oauthconsentMixinFields0 := oauthconsentMixin[0].Fields()
+	_ = oauthconsentMixinFields0
+	oauthconsentMixinFields1 := oauthconsentMixin[1].Fields()
+	_ = oauthconsentMixinFields1
+	oauthconsentFields := schema.OauthConsent{}.Fields()
+	_ = oauthconsentFields
+	// oauthconsentDescCreatedAt is the schema descriptor for created_at field.
+	oauthconsentDescCreatedAt := oauthconsentMixinFields0[1].Descriptor()
+	// oauthconsent.DefaultCreatedAt holds the default value on creation for the created_at field.
+	oauthconsent.DefaultCreatedAt = oauthconsentDescCreatedAt.Default.(func() time.Time)
+	// oauthconsentDescUpdatedAt is the schema descriptor for updated_at field.
+	oauthconsentDescUpdatedAt := oauthconsentMixinFields0[2].Descriptor()
+	// oauthconsent.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	oauthconsent.DefaultUpdatedAt = oauthconsentDescUpdatedAt.Default.(func() time.Time)
+	// oauthconsent.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	oauthconsent.UpdateDefaultUpdatedAt = oauthconsentDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// oauthconsentDescTenantID is the schema descriptor for tenant_id field.
+	oauthconsentDescTenantID := oauthconsentMixinFields1[0].Descriptor()
+	// oauthconsent.DefaultTenantID holds the default value on creation for the tenant_id field.
+	oauthconsent.DefaultTenantID = oauthconsentDescTenantID.Default.(uint64)
+	// oauthconsentDescClientID is the schema descriptor for client_id field.
+	oauthconsentDescClientID := oauthconsentFields[1].Descriptor()
+	// oauthconsent.ClientIDValidator is a validator for the "client_id" field. It is called by the builders before save.
+	oauthconsent.ClientIDValidator = oauthconsentDescClientID.Validators[0].(func(string) error)
 	oauthproviderMixin := schema.OauthProvider{}.Mixin()
 	oauthproviderMixinFields0 := oauthproviderMixin[0].Fields()
 	_ = oauthproviderMixinFields0
@@ -567,6 +1045,118 @@ func init() {
 	oauthproviderDescFailureCount := oauthproviderFields[23].Descriptor()
 	// oauthprovider.DefaultFailureCount holds the default value on creation for the failure_count field.
 	oauthprovider.DefaultFailureCount = oauthproviderDescFailureCount.Default.(int)
+	oauthprovidertemplateMixin := schema.OauthProviderTemplate{}.Mixin()
+	oauthprovidertemplateMixinFields0 := oauthprovidertemplateMixin[0].Fields()
+	_ = oauthprovidertemplateMixinFields0
+	oauthprovidertemplateMixinFields1 := oauthprovidertemplateMixin[1].Fields()
+	_ = oauthprovidertemplateMixinFields1
+	oauthprovidertemplateFields := schema.OauthProviderTemplate{}.Fields()
+	_ = oauthprovidertemplateFields
+	// oauthprovidertemplateDescCreatedAt is the schema descriptor for created_at field.
+	oauthprovidertemplateDescCreatedAt := oauthprovidertemplateMixinFields0[1].Descriptor()
+	// oauthprovidertemplate.DefaultCreatedAt holds the default value on creation for the created_at field.
+	oauthprovidertemplate.DefaultCreatedAt = oauthprovidertemplateDescCreatedAt.Default.(func() time.Time)
+	// oauthprovidertemplateDescUpdatedAt is the schema descriptor for updated_at field.
+	oauthprovidertemplateDescUpdatedAt := oauthprovidertemplateMixinFields0[2].Descriptor()
+	// oauthprovidertemplate.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	oauthprovidertemplate.DefaultUpdatedAt = oauthprovidertemplateDescUpdatedAt.Default.(func() time.Time)
+	// oauthprovidertemplate.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	oauthprovidertemplate.UpdateDefaultUpdatedAt = oauthprovidertemplateDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// oauthprovidertemplateDescStatus is the schema descriptor for status field.
+	oauthprovidertemplateDescStatus := oauthprovidertemplateMixinFields1[0].Descriptor()
+	// oauthprovidertemplate.DefaultStatus holds the default value on creation for the status field.
+	oauthprovidertemplate.DefaultStatus = oauthprovidertemplateDescStatus.Default.(uint8)
+	// oauthprovidertemplateDescName is the schema descriptor for name field.
+	oauthprovidertemplateDescName := oauthprovidertemplateFields[0].Descriptor()
+	// oauthprovidertemplate.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	oauthprovidertemplate.NameValidator = oauthprovidertemplateDescName.Validators[0].(func(string) error)
+	// oauthprovidertemplateDescDisplayName is the schema descriptor for display_name field.
+	oauthprovidertemplateDescDisplayName := oauthprovidertemplateFields[1].Descriptor()
+	// oauthprovidertemplate.DisplayNameValidator is a validator for the "display_name" field. It is called by the builders before save.
+	oauthprovidertemplate.DisplayNameValidator = oauthprovidertemplateDescDisplayName.Validators[0].(func(string) error)
+	// oauthprovidertemplateDescType is the schema descriptor for type field.
+	oauthprovidertemplateDescType := oauthprovidertemplateFields[2].Descriptor()
+	// oauthprovidertemplate.TypeValidator is a validator for the "type" field. It is called by the builders before save.
+	oauthprovidertemplate.TypeValidator = oauthprovidertemplateDescType.Validators[0].(func(string) error)
+	// oauthprovidertemplateDescProviderType is the schema descriptor for provider_type field.
+	oauthprovidertemplateDescProviderType := oauthprovidertemplateFields[3].Descriptor()
+	// oauthprovidertemplate.DefaultProviderType holds the default value on creation for the provider_type field.
+	oauthprovidertemplate.DefaultProviderType = oauthprovidertemplateDescProviderType.Default.(string)
+	// oauthprovidertemplate.ProviderTypeValidator is a validator for the "provider_type" field. It is called by the builders before save.
+	oauthprovidertemplate.ProviderTypeValidator = oauthprovidertemplateDescProviderType.Validators[0].(func(string) error)
+	// oauthprovidertemplateDescScopes is the schema descriptor for scopes field.
+	oauthprovidertemplateDescScopes := oauthprovidertemplateFields[4].Descriptor()
+	// oauthprovidertemplate.ScopesValidator is a validator for the "scopes" field. It is called by the builders before save.
+	oauthprovidertemplate.ScopesValidator = oauthprovidertemplateDescScopes.Validators[0].(func(string) error)
+	// oauthprovidertemplateDescAuthURL is the schema descriptor for auth_url field.
+	oauthprovidertemplateDescAuthURL := oauthprovidertemplateFields[5].Descriptor()
+	// oauthprovidertemplate.AuthURLValidator is a validator for the "auth_url" field. It is called by the builders before save.
+	oauthprovidertemplate.AuthURLValidator = oauthprovidertemplateDescAuthURL.Validators[0].(func(string) error)
+	// oauthprovidertemplateDescTokenURL is the schema descriptor for token_url field.
+	oauthprovidertemplateDescTokenURL := oauthprovidertemplateFields[6].Descriptor()
+	// oauthprovidertemplate.TokenURLValidator is a validator for the "token_url" field. It is called by the builders before save.
+	oauthprovidertemplate.TokenURLValidator = oauthprovidertemplateDescTokenURL.Validators[0].(func(string) error)
+	// oauthprovidertemplateDescInfoURL is the schema descriptor for info_url field.
+	oauthprovidertemplateDescInfoURL := oauthprovidertemplateFields[7].Descriptor()
+	// oauthprovidertemplate.InfoURLValidator is a validator for the "info_url" field. It is called by the builders before save.
+	oauthprovidertemplate.InfoURLValidator = oauthprovidertemplateDescInfoURL.Validators[0].(func(string) error)
+	// oauthprovidertemplateDescAuthStyle is the schema descriptor for auth_style field.
+	oauthprovidertemplateDescAuthStyle := oauthprovidertemplateFields[8].Descriptor()
+	// oauthprovidertemplate.DefaultAuthStyle holds the default value on creation for the auth_style field.
+	oauthprovidertemplate.DefaultAuthStyle = oauthprovidertemplateDescAuthStyle.Default.(int)
+	// oauthprovidertemplateDescSupportPkce is the schema descriptor for support_pkce field.
+	oauthprovidertemplateDescSupportPkce := oauthprovidertemplateFields[10].Descriptor()
+	// oauthprovidertemplate.DefaultSupportPkce holds the default value on creation for the support_pkce field.
+	oauthprovidertemplate.DefaultSupportPkce = oauthprovidertemplateDescSupportPkce.Default.(bool)
+	// oauthprovidertemplateDescIconURL is the schema descriptor for icon_url field.
+	oauthprovidertemplateDescIconURL := oauthprovidertemplateFields[11].Descriptor()
+	// oauthprovidertemplate.IconURLValidator is a validator for the "icon_url" field. It is called by the builders before save.
+	oauthprovidertemplate.IconURLValidator = oauthprovidertemplateDescIconURL.Validators[0].(func(string) error)
+	// oauthprovidertemplateDescSort is the schema descriptor for sort field.
+	oauthprovidertemplateDescSort := oauthprovidertemplateFields[12].Descriptor()
+	// oauthprovidertemplate.DefaultSort holds the default value on creation for the sort field.
+	oauthprovidertemplate.DefaultSort = oauthprovidertemplateDescSort.Default.(uint32)
+	// oauthprovidertemplateDescRemark is the schema descriptor for remark field.
+	oauthprovidertemplateDescRemark := oauthprovidertemplateFields[13].Descriptor()
+	// oauthprovidertemplate.RemarkValidator is a validator for the "remark" field. It is called by the builders before save.
+	oauthprovidertemplate.RemarkValidator = oauthprovidertemplateDescRemark.Validators[0].(func(string) error)
+	oauthscopeMixin := schema.OauthScope{}.Mixin()
+	oauthscopeMixinFields0 := oauthscopeMixin[0].Fields()
+	_ = oauthscopeMixinFields0
+	oauthscopeMixinFields1 := oauthscopeMixin[1].Fields()
+	_ = oauthscopeMixinFields1
+	oauthscopeMixinFields2 := oauthscopeMixin[2].Fields()
+	_ = oauthscopeMixinFields2
+	oauthscopeFields := schema.OauthScope{}.Fields()
+	_ = oauthscopeFields
+	// oauthscopeDescCreatedAt is the schema descriptor for created_at field.
+	oauthscopeDescCreatedAt := oauthscopeMixinFields0[1].Descriptor()
+	// oauthscope.DefaultCreatedAt holds the default value on creation for the created_at field.
+	oauthscope.DefaultCreatedAt = oauthscopeDescCreatedAt.Default.(func() time.Time)
+	// oauthscopeDescUpdatedAt is the schema descriptor for updated_at field.
+	oauthscopeDescUpdatedAt := oauthscopeMixinFields0[2].Descriptor()
+	// oauthscope.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	oauthscope.DefaultUpdatedAt = oauthscopeDescUpdatedAt.Default.(func() time.Time)
+	// oauthscope.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	oauthscope.UpdateDefaultUpdatedAt = oauthscopeDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// oauthscopeDescStatus is the schema descriptor for status field.
+	oauthscopeDescStatus := oauthscopeMixinFields1[0].Descriptor()
+	// oauthscope.DefaultStatus holds the default value on creation for the status field.
+	oauthscope.DefaultStatus = oauthscopeDescStatus.Default.(uint8)
+	// oauthscopeDescTenantID is the schema descriptor for tenant_id field.
+	oauthscopeDescTenantID := oauthscopeMixinFields2[0].Descriptor()
+	// oauthscope.DefaultTenantID holds the default value on creation for the tenant_id field.
+	oauthscope.DefaultTenantID = oauthscopeDescTenantID.Default.(uint64)
+	// oauthscopeDescName is the schema descriptor for name field.
+	oauthscopeDescName := oauthscopeFields[0].Descriptor()
+	// oauthscope.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	oauthscope.NameValidator = oauthscopeDescName.Validators[0].(func(string) error)
+	// oauthscopeDescDescription is the schema descriptor for description field.
+	oauthscopeDescDescription := oauthscopeFields[1].Descriptor()
+	// oauthscope.DefaultDescription holds the default value on creation for the description field.
+	oauthscope.DefaultDescription = oauthscopeDescDescription.Default.(string)
+	// oauthscope.DescriptionValidator is a validator for the "description" field. It is called by the builders before save.
+	oauthscope.DescriptionValidator = oauthscopeDescDescription.Validators[0].(func(string) error)
 	oauthsessionMixin := schema.OauthSession{}.Mixin()
 	oauthsessionMixinFields0 := oauthsessionMixin[0].Fields()
 	_ = oauthsessionMixinFields0
@@ -726,6 +1316,205 @@ func init() {
 	roleDescSort := roleFields[4].Descriptor()
 	// role.DefaultSort holds the default value on creation for the sort field.
 	role.DefaultSort = roleDescSort.Default.(uint32)
+	samlaccountMixin := schema.SamlAccount{}.Mixin()
+	samlaccountMixinFields0 := samlaccountMixin[0].Fields()
+	_ = samlaccountMixinFields0
+	samlaccountMixinFields1 := samlaccountMixin[1].Fields()
+	_ = samlaccountMixinFields1
+	samlaccountMixinFields2 := samlaccountMixin[2].Fields()
+	_ = samlaccountMixinFields2
+	samlaccountFields := schema.SamlAccount{}.Fields()
+	_ = samlaccountFields
+	// samlaccountDescCreatedAt is the schema descriptor for created_at field.
+	samlaccountDescCreatedAt := samlaccountMixinFields0[1].Descriptor()
+	// samlaccount.DefaultCreatedAt holds the default value on creation for the created_at field.
+	samlaccount.DefaultCreatedAt = samlaccountDescCreatedAt.Default.(func() time.Time)
+	// samlaccountDescUpdatedAt is the schema descriptor for updated_at field.
+	samlaccountDescUpdatedAt := samlaccountMixinFields0[2].Descriptor()
+	// samlaccount.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	samlaccount.DefaultUpdatedAt = samlaccountDescUpdatedAt.Default.(func() time.Time)
+	// samlaccount.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	samlaccount.UpdateDefaultUpdatedAt = samlaccountDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// samlaccountDescStatus is the schema descriptor for status field.
+	samlaccountDescStatus := samlaccountMixinFields1[0].Descriptor()
+	// samlaccount.DefaultStatus holds the default value on creation for the status field.
+	samlaccount.DefaultStatus = samlaccountDescStatus.Default.(uint8)
+	// samlaccountDescTenantID is the schema descriptor for tenant_id field.
+	samlaccountDescTenantID := samlaccountMixinFields2[0].Descriptor()
+	// samlaccount.DefaultTenantID holds the default value on creation for the tenant_id field.
+	samlaccount.DefaultTenantID = samlaccountDescTenantID.Default.(uint64)
+	// samlaccountDescNameID is the schema descriptor for name_id field.
+	samlaccountDescNameID := samlaccountFields[2].Descriptor()
+	// samlaccount.NameIDValidator is a validator for the "name_id" field. It is called by the builders before save.
+	samlaccount.NameIDValidator = samlaccountDescNameID.Validators[0].(func(string) error)
+	// samlaccountDescNameIDFormat is the schema descriptor for name_id_format field.
+	samlaccountDescNameIDFormat := samlaccountFields[3].Descriptor()
+	// samlaccount.NameIDFormatValidator is a validator for the "name_id_format" field. It is called by the builders before save.
+	samlaccount.NameIDFormatValidator = samlaccountDescNameIDFormat.Validators[0].(func(string) error)
+	// samlaccountDescSessionIndex is the schema descriptor for session_index field.
+	samlaccountDescSessionIndex := samlaccountFields[4].Descriptor()
+	// samlaccount.SessionIndexValidator is a validator for the "session_index" field. It is called by the builders before save.
+	samlaccount.SessionIndexValidator = samlaccountDescSessionIndex.Validators[0].(func(string) error)
+	// samlaccountDescEmail is the schema descriptor for email field.
+	samlaccountDescEmail := samlaccountFields[5].Descriptor()
+	// samlaccount.EmailValidator is a validator for the "email" field. It is called by the builders before save.
+	samlaccount.EmailValidator = samlaccountDescEmail.Validators[0].(func(string) error)
+	// samlaccountDescLastLoginIP is the schema descriptor for last_login_ip field.
+	samlaccountDescLastLoginIP := samlaccountFields[8].Descriptor()
+	// samlaccount.LastLoginIPValidator is a validator for the "last_login_ip" field. It is called by the builders before save.
+	samlaccount.LastLoginIPValidator = samlaccountDescLastLoginIP.Validators[0].(func(string) error)
+	// samlaccountDescLoginCount is the schema descriptor for login_count field.
+	samlaccountDescLoginCount := samlaccountFields[9].Descriptor()
+	// samlaccount.DefaultLoginCount holds the default value on creation for the login_count field.
+	samlaccount.DefaultLoginCount = samlaccountDescLoginCount.Default.(uint32)
+	samlproviderMixin := schema.SamlProvider{}.Mixin()
+	samlproviderMixinFields0 := samlproviderMixin[0].Fields()
+	_ = samlproviderMixinFields0
+	samlproviderMixinFields1 := samlproviderMixin[1].Fields()
+	_ = samlproviderMixinFields1
+	samlproviderMixinFields2 := samlproviderMixin[2].Fields()
+	_ = samlproviderMixinFields2
+	samlproviderFields := schema.SamlProvider{}.Fields()
+	_ = samlproviderFields
+	// samlproviderDescCreatedAt is the schema descriptor for created_at field.
+	samlproviderDescCreatedAt := samlproviderMixinFields0[1].Descriptor()
+	// samlprovider.DefaultCreatedAt holds the default value on creation for the created_at field.
+	samlprovider.DefaultCreatedAt = samlproviderDescCreatedAt.Default.(func() time.Time)
+	// samlproviderDescUpdatedAt is the schema descriptor for updated_at field.
+	samlproviderDescUpdatedAt := samlproviderMixinFields0[2].Descriptor()
+	// samlprovider.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	samlprovider.DefaultUpdatedAt = samlproviderDescUpdatedAt.Default.(func() time.Time)
+	// samlprovider.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	samlprovider.UpdateDefaultUpdatedAt = samlproviderDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// samlproviderDescStatus is the schema descriptor for status field.
+	samlproviderDescStatus := samlproviderMixinFields1[0].Descriptor()
+	// samlprovider.DefaultStatus holds the default value on creation for the status field.
+	samlprovider.DefaultStatus = samlproviderDescStatus.Default.(uint8)
+	// samlproviderDescTenantID is the schema descriptor for tenant_id field.
+	samlproviderDescTenantID := samlproviderMixinFields2[0].Descriptor()
+	// samlprovider.DefaultTenantID holds the default value on creation for the tenant_id field.
+	samlprovider.DefaultTenantID = samlproviderDescTenantID.Default.(uint64)
+	// samlproviderDescName is the schema descriptor for name field.
+	samlproviderDescName := samlproviderFields[0].Descriptor()
+	// samlprovider.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	samlprovider.NameValidator = samlproviderDescName.Validators[0].(func(string) error)
+	// samlproviderDescDisplayName is the schema descriptor for display_name field.
+	samlproviderDescDisplayName := samlproviderFields[1].Descriptor()
+	// samlprovider.DisplayNameValidator is a validator for the "display_name" field. It is called by the builders before save.
+	samlprovider.DisplayNameValidator = samlproviderDescDisplayName.Validators[0].(func(string) error)
+	// samlproviderDescIdpEntityID is the schema descriptor for idp_entity_id field.
+	samlproviderDescIdpEntityID := samlproviderFields[2].Descriptor()
+	// samlprovider.IdpEntityIDValidator is a validator for the "idp_entity_id" field. It is called by the builders before save.
+	samlprovider.IdpEntityIDValidator = samlproviderDescIdpEntityID.Validators[0].(func(string) error)
+	// samlproviderDescIdpSSOURL is the schema descriptor for idp_sso_url field.
+	samlproviderDescIdpSSOURL := samlproviderFields[3].Descriptor()
+	// samlprovider.IdpSSOURLValidator is a validator for the "idp_sso_url" field. It is called by the builders before save.
+	samlprovider.IdpSSOURLValidator = samlproviderDescIdpSSOURL.Validators[0].(func(string) error)
+	// samlproviderDescIdpSloURL is the schema descriptor for idp_slo_url field.
+	samlproviderDescIdpSloURL := samlproviderFields[4].Descriptor()
+	// samlprovider.IdpSloURLValidator is a validator for the "idp_slo_url" field. It is called by the builders before save.
+	samlprovider.IdpSloURLValidator = samlproviderDescIdpSloURL.Validators[0].(func(string) error)
+	// samlproviderDescIdpMetadataURL is the schema descriptor for idp_metadata_url field.
+	samlproviderDescIdpMetadataURL := samlproviderFields[6].Descriptor()
+	// samlprovider.IdpMetadataURLValidator is a validator for the "idp_metadata_url" field. It is called by the builders before save.
+	samlprovider.IdpMetadataURLValidator = samlproviderDescIdpMetadataURL.Validators[0].(func(string) error)
+	// samlproviderDescSpEntityID is the schema descriptor for sp_entity_id field.
+	samlproviderDescSpEntityID := samlproviderFields[7].Descriptor()
+	// samlprovider.SpEntityIDValidator is a validator for the "sp_entity_id" field. It is called by the builders before save.
+	samlprovider.SpEntityIDValidator = samlproviderDescSpEntityID.Validators[0].(func(string) error)
+	// samlproviderDescAcsURL is the schema descriptor for acs_url field.
+	samlproviderDescAcsURL := samlproviderFields[8].Descriptor()
+	// samlprovider.AcsURLValidator is a validator for the "acs_url" field. It is called by the builders before save.
+	samlprovider.AcsURLValidator = samlproviderDescAcsURL.Validators[0].(func(string) error)
+	// samlproviderDescNameIDFormat is the schema descriptor for name_id_format field.
+	samlproviderDescNameIDFormat := samlproviderFields[9].Descriptor()
+	// samlprovider.NameIDFormatValidator is a validator for the "name_id_format" field. It is called by the builders before save.
+	samlprovider.NameIDFormatValidator = samlproviderDescNameIDFormat.Validators[0].(func(string) error)
+	// samlproviderDescSignAuthnRequest is the schema descriptor for sign_authn_request field.
+	samlproviderDescSignAuthnRequest := samlproviderFields[10].Descriptor()
+	// samlprovider.DefaultSignAuthnRequest holds the default value on creation for the sign_authn_request field.
+	samlprovider.DefaultSignAuthnRequest = samlproviderDescSignAuthnRequest.Default.(bool)
+	// samlproviderDescWantAssertionsSigned is the schema descriptor for want_assertions_signed field.
+	samlproviderDescWantAssertionsSigned := samlproviderFields[11].Descriptor()
+	// samlprovider.DefaultWantAssertionsSigned holds the default value on creation for the want_assertions_signed field.
+	samlprovider.DefaultWantAssertionsSigned = samlproviderDescWantAssertionsSigned.Default.(bool)
+	// samlproviderDescJitProvision is the schema descriptor for jit_provision field.
+	samlproviderDescJitProvision := samlproviderFields[13].Descriptor()
+	// samlprovider.DefaultJitProvision holds the default value on creation for the jit_provision field.
+	samlprovider.DefaultJitProvision = samlproviderDescJitProvision.Default.(bool)
+	// samlproviderDescSyncAttributes is the schema descriptor for sync_attributes field.
+	samlproviderDescSyncAttributes := samlproviderFields[14].Descriptor()
+	// samlprovider.DefaultSyncAttributes holds the default value on creation for the sync_attributes field.
+	samlprovider.DefaultSyncAttributes = samlproviderDescSyncAttributes.Default.(bool)
+	// samlproviderDescLinkByEmail is the schema descriptor for link_by_email field.
+	samlproviderDescLinkByEmail := samlproviderFields[15].Descriptor()
+	// samlprovider.DefaultLinkByEmail holds the default value on creation for the link_by_email field.
+	samlprovider.DefaultLinkByEmail = samlproviderDescLinkByEmail.Default.(bool)
+	// samlproviderDescDefaultDepartmentID is the schema descriptor for default_department_id field.
+	samlproviderDescDefaultDepartmentID := samlproviderFields[16].Descriptor()
+	// samlprovider.DefaultDefaultDepartmentID holds the default value on creation for the default_department_id field.
+	samlprovider.DefaultDefaultDepartmentID = samlproviderDescDefaultDepartmentID.Default.(uint64)
+	// samlproviderDescEnabled is the schema descriptor for enabled field.
+	samlproviderDescEnabled := samlproviderFields[18].Descriptor()
+	// samlprovider.DefaultEnabled holds the default value on creation for the enabled field.
+	samlprovider.DefaultEnabled = samlproviderDescEnabled.Default.(bool)
+	// samlproviderDescSort is the schema descriptor for sort field.
+	samlproviderDescSort := samlproviderFields[19].Descriptor()
+	// samlprovider.DefaultSort holds the default value on creation for the sort field.
+	samlprovider.DefaultSort = samlproviderDescSort.Default.(uint32)
+	// samlproviderDescRemark is the schema descriptor for remark field.
+	samlproviderDescRemark := samlproviderFields[20].Descriptor()
+	// samlprovider.RemarkValidator is a validator for the "remark" field. It is called by the builders before save.
+	samlprovider.RemarkValidator = samlproviderDescRemark.Validators[0].(func(string) error)
+	scimtokenMixin := schema.ScimToken{}.Mixin()
+	scimtokenMixinFields0 := scimtokenMixin[0].Fields()
+	_ = scimtokenMixinFields0
+	scimtokenMixinFields1 := scimtokenMixin[1].Fields()
+	_ = scimtokenMixinFields1
+	scimtokenMixinFields2 := scimtokenMixin[2].Fields()
+	_ = scimtokenMixinFields2
+	scimtokenFields := schema.ScimToken{}.Fields()
+	_ = scimtokenFields
+	// scimtokenDescCreatedAt is the schema descriptor for created_at field.
+	scimtokenDescCreatedAt := scimtokenMixinFields0[1].Descriptor()
+	// scimtoken.DefaultCreatedAt holds the default value on creation for the created_at field.
+	scimtoken.DefaultCreatedAt = scimtokenDescCreatedAt.Default.(func() time.Time)
+	// scimtokenDescUpdatedAt is the schema descriptor for updated_at field.
+	scimtokenDescUpdatedAt := scimtokenMixinFields0[2].Descriptor()
+	// scimtoken.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	scimtoken.DefaultUpdatedAt = scimtokenDescUpdatedAt.Default.(func() time.Time)
+	// scimtoken.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	scimtoken.UpdateDefaultUpdatedAt = scimtokenDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// scimtokenDescStatus is the schema descriptor for status field.
+	scimtokenDescStatus := scimtokenMixinFields1[0].Descriptor()
+	// scimtoken.DefaultStatus holds the default value on creation for the status field.
+	scimtoken.DefaultStatus = scimtokenDescStatus.Default.(uint8)
+	// scimtokenDescTenantID is the schema descriptor for tenant_id field.
+	scimtokenDescTenantID := scimtokenMixinFields2[0].Descriptor()
+	// scimtoken.DefaultTenantID holds the default value on creation for the tenant_id field.
+	scimtoken.DefaultTenantID = scimtokenDescTenantID.Default.(uint64)
+	// scimtokenDescName is the schema descriptor for name field.
+	scimtokenDescName := scimtokenFields[0].Descriptor()
+	// scimtoken.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	scimtoken.NameValidator = scimtokenDescName.Validators[0].(func(string) error)
+	// scimtokenDescTokenHash is the schema descriptor for token_hash field.
+	scimtokenDescTokenHash := scimtokenFields[1].Descriptor()
+	// scimtoken.TokenHashValidator is a validator for the "token_hash" field. It is called by the builders before save.
+	scimtoken.TokenHashValidator = scimtokenDescTokenHash.Validators[0].(func(string) error)
+	// scimtokenDescTokenPrefix is the schema descriptor for token_prefix field.
+	scimtokenDescTokenPrefix := scimtokenFields[2].Descriptor()
+	// scimtoken.TokenPrefixValidator is a validator for the "token_prefix" field. It is called by the builders before save.
+	scimtoken.TokenPrefixValidator = scimtokenDescTokenPrefix.Validators[0].(func(string) error)
+	// scimtokenDescLastUsedIP is the schema descriptor for last_used_ip field.
+	scimtokenDescLastUsedIP := scimtokenFields[5].Descriptor()
+	// scimtoken.DefaultLastUsedIP holds the default value on creation for the last_used_ip field.
+	scimtoken.DefaultLastUsedIP = scimtokenDescLastUsedIP.Default.(string)
+	// scimtokenDescRemark is the schema descriptor for remark field.
+	scimtokenDescRemark := scimtokenFields[7].Descriptor()
+	// scimtoken.DefaultRemark holds the default value on creation for the remark field.
+	scimtoken.DefaultRemark = scimtokenDescRemark.Default.(string)
+	// scimtoken.RemarkValidator is a validator for the "remark" field. It is called by the builders before save.
+	scimtoken.RemarkValidator = scimtokenDescRemark.Validators[0].(func(string) error)
 	tenantMixin := schema.Tenant{}.Mixin()
 	tenantMixinFields0 := tenantMixin[0].Fields()
 	_ = tenantMixinFields0
@@ -755,6 +1544,143 @@ func init() {
 	tenantDescCode := tenantFields[1].Descriptor()
 	// tenant.CodeValidator is a validator for the "code" field. It is called by the builders before save.
 	tenant.CodeValidator = tenantDescCode.Validators[0].(func(string) error)
+	tenantinitjobMixin := schema.TenantInitJob{}.Mixin()
+	tenantinitjobMixinFields0 := tenantinitjobMixin[0].Fields()
+	_ = tenantinitjobMixinFields0
+	tenantinitjobFields := schema.TenantInitJob{}.Fields()
+	_ = tenantinitjobFields
+	// tenantinitjobDescCreatedAt is the schema descriptor for created_at field.
+	tenantinitjobDescCreatedAt := tenantinitjobMixinFields0[1].Descriptor()
+	// tenantinitjob.DefaultCreatedAt holds the default value on creation for the created_at field.
+	tenantinitjob.DefaultCreatedAt = tenantinitjobDescCreatedAt.Default.(func() time.Time)
+	// tenantinitjobDescUpdatedAt is the schema descriptor for updated_at field.
+	tenantinitjobDescUpdatedAt := tenantinitjobMixinFields0[2].Descriptor()
+	// tenantinitjob.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	tenantinitjob.DefaultUpdatedAt = tenantinitjobDescUpdatedAt.Default.(func() time.Time)
+	// tenantinitjob.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	tenantinitjob.UpdateDefaultUpdatedAt = tenantinitjobDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// tenantinitjobDescTemplate is the schema descriptor for template field.
+	tenantinitjobDescTemplate := tenantinitjobFields[1].Descriptor()
+	// tenantinitjob.TemplateValidator is a validator for the "template" field. It is called by the builders before save.
+	tenantinitjob.TemplateValidator = tenantinitjobDescTemplate.Validators[0].(func(string) error)
+	// tenantinitjobDescMode is the schema descriptor for mode field.
+	tenantinitjobDescMode := tenantinitjobFields[2].Descriptor()
+	// tenantinitjob.ModeValidator is a validator for the "mode" field. It is called by the builders before save.
+	tenantinitjob.ModeValidator = tenantinitjobDescMode.Validators[0].(func(string) error)
+	// tenantinitjobDescDryRun is the schema descriptor for dry_run field.
+	tenantinitjobDescDryRun := tenantinitjobFields[3].Descriptor()
+	// tenantinitjob.DefaultDryRun holds the default value on creation for the dry_run field.
+	tenantinitjob.DefaultDryRun = tenantinitjobDescDryRun.Default.(bool)
+	// tenantinitjobDescStatus is the schema descriptor for status field.
+	tenantinitjobDescStatus := tenantinitjobFields[4].Descriptor()
+	// tenantinitjob.StatusValidator is a validator for the "status" field. It is called by the builders before save.
+	tenantinitjob.StatusValidator = tenantinitjobDescStatus.Validators[0].(func(string) error)
+	// tenantinitjobDescAdminUsername is the schema descriptor for admin_username field.
+	tenantinitjobDescAdminUsername := tenantinitjobFields[5].Descriptor()
+	// tenantinitjob.AdminUsernameValidator is a validator for the "admin_username" field. It is called by the builders before save.
+	tenantinitjob.AdminUsernameValidator = tenantinitjobDescAdminUsername.Validators[0].(func(string) error)
+	// tenantinitjobDescAdminEmail is the schema descriptor for admin_email field.
+	tenantinitjobDescAdminEmail := tenantinitjobFields[6].Descriptor()
+	// tenantinitjob.AdminEmailValidator is a validator for the "admin_email" field. It is called by the builders before save.
+	tenantinitjob.AdminEmailValidator = tenantinitjobDescAdminEmail.Validators[0].(func(string) error)
+	// tenantinitjobDescAttempts is the schema descriptor for attempts field.
+	tenantinitjobDescAttempts := tenantinitjobFields[7].Descriptor()
+	// tenantinitjob.DefaultAttempts holds the default value on creation for the attempts field.
+	tenantinitjob.DefaultAttempts = tenantinitjobDescAttempts.Default.(uint32)
+	tenantinitpluginMixin := schema.TenantInitPlugin{}.Mixin()
+	tenantinitpluginMixinFields0 := tenantinitpluginMixin[0].Fields()
+	_ = tenantinitpluginMixinFields0
+	tenantinitpluginMixinFields1 := tenantinitpluginMixin[1].Fields()
+	_ = tenantinitpluginMixinFields1
+	tenantinitpluginFields := schema.TenantInitPlugin{}.Fields()
+	_ = tenantinitpluginFields
+	// tenantinitpluginDescCreatedAt is the schema descriptor for created_at field.
+	tenantinitpluginDescCreatedAt := tenantinitpluginMixinFields0[1].Descriptor()
+	// tenantinitplugin.DefaultCreatedAt holds the default value on creation for the created_at field.
+	tenantinitplugin.DefaultCreatedAt = tenantinitpluginDescCreatedAt.Default.(func() time.Time)
+	// tenantinitpluginDescUpdatedAt is the schema descriptor for updated_at field.
+	tenantinitpluginDescUpdatedAt := tenantinitpluginMixinFields0[2].Descriptor()
+	// tenantinitplugin.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	tenantinitplugin.DefaultUpdatedAt = tenantinitpluginDescUpdatedAt.Default.(func() time.Time)
+	// tenantinitplugin.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	tenantinitplugin.UpdateDefaultUpdatedAt = tenantinitpluginDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// tenantinitpluginDescStatus is the schema descriptor for status field.
+	tenantinitpluginDescStatus := tenantinitpluginMixinFields1[0].Descriptor()
+	// tenantinitplugin.DefaultStatus holds the default value on creation for the status field.
+	tenantinitplugin.DefaultStatus = tenantinitpluginDescStatus.Default.(uint8)
+	// tenantinitpluginDescName is the schema descriptor for name field.
+	tenantinitpluginDescName := tenantinitpluginFields[0].Descriptor()
+	// tenantinitplugin.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	tenantinitplugin.NameValidator = tenantinitpluginDescName.Validators[0].(func(string) error)
+	// tenantinitpluginDescVersion is the schema descriptor for version field.
+	tenantinitpluginDescVersion := tenantinitpluginFields[1].Descriptor()
+	// tenantinitplugin.DefaultVersion holds the default value on creation for the version field.
+	tenantinitplugin.DefaultVersion = tenantinitpluginDescVersion.Default.(string)
+	// tenantinitplugin.VersionValidator is a validator for the "version" field. It is called by the builders before save.
+	tenantinitplugin.VersionValidator = tenantinitpluginDescVersion.Validators[0].(func(string) error)
+	// tenantinitpluginDescDescription is the schema descriptor for description field.
+	tenantinitpluginDescDescription := tenantinitpluginFields[2].Descriptor()
+	// tenantinitplugin.DescriptionValidator is a validator for the "description" field. It is called by the builders before save.
+	tenantinitplugin.DescriptionValidator = tenantinitpluginDescDescription.Validators[0].(func(string) error)
+	// tenantinitpluginDescTarget is the schema descriptor for target field.
+	tenantinitpluginDescTarget := tenantinitpluginFields[3].Descriptor()
+	// tenantinitplugin.TargetValidator is a validator for the "target" field. It is called by the builders before save.
+	tenantinitplugin.TargetValidator = tenantinitpluginDescTarget.Validators[0].(func(string) error)
+	// tenantinitpluginDescPriority is the schema descriptor for priority field.
+	tenantinitpluginDescPriority := tenantinitpluginFields[5].Descriptor()
+	// tenantinitplugin.DefaultPriority holds the default value on creation for the priority field.
+	tenantinitplugin.DefaultPriority = tenantinitpluginDescPriority.Default.(int32)
+	// tenantinitpluginDescTimeout is the schema descriptor for timeout field.
+	tenantinitpluginDescTimeout := tenantinitpluginFields[6].Descriptor()
+	// tenantinitplugin.DefaultTimeout holds the default value on creation for the timeout field.
+	tenantinitplugin.DefaultTimeout = tenantinitpluginDescTimeout.Default.(uint32)
+	// tenantinitpluginDescSupportRollback is the schema descriptor for support_rollback field.
+	tenantinitpluginDescSupportRollback := tenantinitpluginFields[7].Descriptor()
+	// tenantinitplugin.DefaultSupportRollback holds the default value on creation for the support_rollback field.
+	tenantinitplugin.DefaultSupportRollback = tenantinitpluginDescSupportRollback.Default.(bool)
+	tenantinittemplateMixin := schema.TenantInitTemplate{}.Mixin()
+	tenantinittemplateMixinFields0 := tenantinittemplateMixin[0].Fields()
+	_ = tenantinittemplateMixinFields0
+	tenantinittemplateMixinFields1 := tenantinittemplateMixin[1].Fields()
+	_ = tenantinittemplateMixinFields1
+	tenantinittemplateFields := schema.TenantInitTemplate{}.Fields()
+	_ = tenantinittemplateFields
+	// tenantinittemplateDescCreatedAt is the schema descriptor for created_at field.
+	tenantinittemplateDescCreatedAt := tenantinittemplateMixinFields0[1].Descriptor()
+	// tenantinittemplate.DefaultCreatedAt holds the default value on creation for the created_at field.
+	tenantinittemplate.DefaultCreatedAt = tenantinittemplateDescCreatedAt.Default.(func() time.Time)
+	// tenantinittemplateDescUpdatedAt is the schema descriptor for updated_at field.
+	tenantinittemplateDescUpdatedAt := tenantinittemplateMixinFields0[2].Descriptor()
+	// tenantinittemplate.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	tenantinittemplate.DefaultUpdatedAt = tenantinittemplateDescUpdatedAt.Default.(func() time.Time)
+	// tenantinittemplate.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	tenantinittemplate.UpdateDefaultUpdatedAt = tenantinittemplateDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// tenantinittemplateDescStatus is the schema descriptor for status field.
+	tenantinittemplateDescStatus := tenantinittemplateMixinFields1[0].Descriptor()
+	// tenantinittemplate.DefaultStatus holds the default value on creation for the status field.
+	tenantinittemplate.DefaultStatus = tenantinittemplateDescStatus.Default.(uint8)
+	// tenantinittemplateDescName is the schema descriptor for name field.
+	tenantinittemplateDescName := tenantinittemplateFields[0].Descriptor()
+	// tenantinittemplate.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	tenantinittemplate.NameValidator = tenantinittemplateDescName.Validators[0].(func(string) error)
+	// tenantinittemplateDescVersion is the schema descriptor for version field.
+	tenantinittemplateDescVersion := tenantinittemplateFields[1].Descriptor()
+	// tenantinittemplate.DefaultVersion holds the default value on creation for the version field.
+	tenantinittemplate.DefaultVersion = tenantinittemplateDescVersion.Default.(string)
+	// tenantinittemplate.VersionValidator is a validator for the "version" field. It is called by the builders before save.
+	tenantinittemplate.VersionValidator = tenantinittemplateDescVersion.Validators[0].(func(string) error)
+	// tenantinittemplateDescDescription is the schema descriptor for description field.
+	tenantinittemplateDescDescription := tenantinittemplateFields[2].Descriptor()
+	// tenantinittemplate.DescriptionValidator is a validator for the "description" field. It is called by the builders before save.
+	tenantinittemplate.DescriptionValidator = tenantinittemplateDescDescription.Validators[0].(func(string) error)
+	// tenantinittemplateDescBaseTemplate is the schema descriptor for base_template field.
+	tenantinittemplateDescBaseTemplate := tenantinittemplateFields[3].Descriptor()
+	// tenantinittemplate.BaseTemplateValidator is a validator for the "base_template" field. It is called by the builders before save.
+	tenantinittemplate.BaseTemplateValidator = tenantinittemplateDescBaseTemplate.Validators[0].(func(string) error)
+	// tenantinittemplateDescSort is the schema descriptor for sort field.
+	tenantinittemplateDescSort := tenantinittemplateFields[5].Descriptor()
+	// tenantinittemplate.DefaultSort holds the default value on creation for the sort field.
+	tenantinittemplate.DefaultSort = tenantinittemplateDescSort.Default.(uint32)
 	tokenMixin := schema.Token{}.Mixin()
 	tokenMixinFields0 := tokenMixin[0].Fields()
 	_ = tokenMixinFields0
@@ -790,6 +1716,22 @@ func init() {
 	tokenDescDepartmentID := tokenFields[5].Descriptor()
 	// token.DefaultDepartmentID holds the default value on creation for the department_id field.
 	token.DefaultDepartmentID = tokenDescDepartmentID.Default.(uint64)
+	// tokenDescDevice is the schema descriptor for device field.
+	tokenDescDevice := tokenFields[6].Descriptor()
+	// token.DefaultDevice holds the default value on creation for the device field.
+	token.DefaultDevice = tokenDescDevice.Default.(string)
+	// tokenDescUserAgent is the schema descriptor for user_agent field.
+	tokenDescUserAgent := tokenFields[7].Descriptor()
+	// token.DefaultUserAgent holds the default value on creation for the user_agent field.
+	token.DefaultUserAgent = tokenDescUserAgent.Default.(string)
+	// tokenDescIP is the schema descriptor for ip field.
+	tokenDescIP := tokenFields[8].Descriptor()
+	// token.DefaultIP holds the default value on creation for the ip field.
+	token.DefaultIP = tokenDescIP.Default.(string)
+	// tokenDescSessionID is the schema descriptor for session_id field.
+	tokenDescSessionID := tokenFields[10].Descriptor()
+	// token.DefaultSessionID holds the default value on creation for the session_id field.
+	token.DefaultSessionID = tokenDescSessionID.Default.(string)
 	// tokenDescID is the schema descriptor for id field.
 	tokenDescID := tokenMixinFields0[0].Descriptor()
 	// token.DefaultID holds the default value on creation for the id field.
@@ -0,0 +1,305 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/coder-lulu/newbee-core/rpc/ent/samlaccount"
+	"github.com/coder-lulu/newbee-core/rpc/ent/samlprovider"
+	"github.com/coder-lulu/newbee-core/rpc/ent/user"
+	uuid "github.com/gofrs/uuid/v5"
+)
+
+// SAML Account Binding Table | SAML账户绑定表
+type SamlAccount struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uint64 `json:"id,omitempty"`
+	// Create Time | 创建日期
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Update Time | 修改日期
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Status 1: normal 2: ban | 状态 1 正常 2 禁用
+	Status uint8 `json:"status,omitempty"`
+	// Tenant ID | 租户 ID
+	TenantID uint64 `json:"tenant_id,omitempty"`
+	// Associated user ID | 关联的用户ID
+	UserID uuid.UUID `json:"user_id,omitempty"`
+	// SAML provider ID | SAML身份源ID
+	ProviderID uint64 `json:"provider_id,omitempty"`
+	// NameID asserted by the IdP | IdP断言的NameID
+	NameID string `json:"name_id,omitempty"`
+	// NameID format | NameID格式
+	NameIDFormat string `json:"name_id_format,omitempty"`
+	// Session index of the last login | 最近一次登录的会话索引
+	SessionIndex string `json:"session_index,omitempty"`
+	// Email from the IdP | IdP提供的邮箱
+	Email string `json:"email,omitempty"`
+	// Attributes of the last assertion | 最近一次断言的属性
+	Attributes map[string][]string `json:"attributes,omitempty"`
+	// Last login time | 最后登录时间
+	LastLoginAt time.Time `json:"last_login_at,omitempty"`
+	// Last login IP address | 最后登录IP地址
+	LastLoginIP string `json:"last_login_ip,omitempty"`
+	// Login count | 登录次数
+	LoginCount uint32 `json:"login_count,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the SamlAccountQuery when eager-loading is set.
+	Edges        SamlAccountEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// SamlAccountEdges holds the relations/edges for other nodes in the graph.
+type SamlAccountEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// Provider holds the value of the provider edge.
+	Provider *SamlProvider `json:"provider,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SamlAccountEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// ProviderOrErr returns the Provider value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SamlAccountEdges) ProviderOrErr() (*SamlProvider, error) {
+	if e.Provider != nil {
+		return e.Provider, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: samlprovider.Label}
+	}
+	return nil, &NotLoadedError{edge: "provider"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SamlAccount) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case samlaccount.FieldAttributes:
+			values[i] = new([]byte)
+		case samlaccount.FieldID, samlaccount.FieldStatus, samlaccount.FieldTenantID, samlaccount.FieldProviderID, samlaccount.FieldLoginCount:
+			values[i] = new(sql.NullInt64)
+		case samlaccount.FieldNameID, samlaccount.FieldNameIDFormat, samlaccount.FieldSessionIndex, samlaccount.FieldEmail, samlaccount.FieldLastLoginIP:
+			values[i] = new(sql.NullString)
+		case samlaccount.FieldCreatedAt, samlaccount.FieldUpdatedAt, samlaccount.FieldLastLoginAt:
+			values[i] = new(sql.NullTime)
+		case samlaccount.FieldUserID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SamlAccount fields.
+func (_m *SamlAccount) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case samlaccount.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = uint64(value.Int64)
+		case samlaccount.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case samlaccount.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case samlaccount.FieldStatus:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = uint8(value.Int64)
+			}
+		case samlaccount.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				_m.TenantID = uint64(value.Int64)
+			}
+		case samlaccount.FieldUserID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value != nil {
+				_m.UserID = *value
+			}
+		case samlaccount.FieldProviderID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field provider_id", values[i])
+			} else if value.Valid {
+				_m.ProviderID = uint64(value.Int64)
+			}
+		case samlaccount.FieldNameID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name_id", values[i])
+			} else if value.Valid {
+				_m.NameID = value.String
+			}
+		case samlaccount.FieldNameIDFormat:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name_id_format", values[i])
+			} else if value.Valid {
+				_m.NameIDFormat = value.String
+			}
+		case samlaccount.FieldSessionIndex:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field session_index", values[i])
+			} else if value.Valid {
+				_m.SessionIndex = value.String
+			}
+		case samlaccount.FieldEmail:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field email", values[i])
+			} else if value.Valid {
+				_m.Email = value.String
+			}
+		case samlaccount.FieldAttributes:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field attributes", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Attributes); err != nil {
+					return fmt.Errorf("unmarshal field attributes: %w", err)
+				}
+			}
+		case samlaccount.FieldLastLoginAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_login_at", values[i])
+			} else if value.Valid {
+				_m.LastLoginAt = value.Time
+			}
+		case samlaccount.FieldLastLoginIP:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field last_login_ip", values[i])
+			} else if value.Valid {
+				_m.LastLoginIP = value.String
+			}
+		case samlaccount.FieldLoginCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field login_count", values[i])
+			} else if value.Valid {
+				_m.LoginCount = uint32(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the SamlAccount.
+// This includes values selected through modifiers, order, etc.
+func (_m *SamlAccount) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the SamlAccount entity.
+func (_m *SamlAccount) QueryUser() *UserQuery {
+	return NewSamlAccountClient(_m.config).QueryUser(_m)
+}
+
+// QueryProvider queries the "provider" edge of the SamlAccount entity.
+func (_m *SamlAccount) QueryProvider() *SamlProviderQuery {
+	return NewSamlAccountClient(_m.config).QueryProvider(_m)
+}
+
+// Update returns a builder for updating this SamlAccount.
+// Note that you need to call SamlAccount.Unwrap() before calling this method if this SamlAccount
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *SamlAccount) Update() *SamlAccountUpdateOne {
+	return NewSamlAccountClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the SamlAccount entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *SamlAccount) Unwrap() *SamlAccount {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: SamlAccount is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *SamlAccount) String() string {
+	var builder strings.Builder
+	builder.WriteString("SamlAccount(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("provider_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ProviderID))
+	builder.WriteString(", ")
+	builder.WriteString("name_id=")
+	builder.WriteString(_m.NameID)
+	builder.WriteString(", ")
+	builder.WriteString("name_id_format=")
+	builder.WriteString(_m.NameIDFormat)
+	builder.WriteString(", ")
+	builder.WriteString("session_index=")
+	builder.WriteString(_m.SessionIndex)
+	builder.WriteString(", ")
+	builder.WriteString("email=")
+	builder.WriteString(_m.Email)
+	builder.WriteString(", ")
+	builder.WriteString("attributes=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Attributes))
+	builder.WriteString(", ")
+	builder.WriteString("last_login_at=")
+	builder.WriteString(_m.LastLoginAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("last_login_ip=")
+	builder.WriteString(_m.LastLoginIP)
+	builder.WriteString(", ")
+	builder.WriteString("login_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LoginCount))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SamlAccounts is a parsable slice of SamlAccount.
+type SamlAccounts []*SamlAccount
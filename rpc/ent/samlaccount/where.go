@@ -0,0 +1,858 @@
+// Code generated by ent, DO NOT EDIT.
+
+package samlaccount
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/coder-lulu/newbee-core/rpc/ent/predicate"
+	uuid "github.com/gofrs/uuid/v5"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uint64) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uint64) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uint64) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uint64) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uint64) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uint64) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uint64) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uint64) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uint64) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v uint8) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldEQ(FieldStatus, v))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v uint64) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldEQ(FieldTenantID, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v uuid.UUID) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldEQ(FieldUserID, v))
+}
+
+// ProviderID applies equality check predicate on the "provider_id" field. It's identical to ProviderIDEQ.
+func ProviderID(v uint64) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldEQ(FieldProviderID, v))
+}
+
+// NameID applies equality check predicate on the "name_id" field. It's identical to NameIDEQ.
+func NameID(v string) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldEQ(FieldNameID, v))
+}
+
+// NameIDFormat applies equality check predicate on the "name_id_format" field. It's identical to NameIDFormatEQ.
+func NameIDFormat(v string) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldEQ(FieldNameIDFormat, v))
+}
+
+// SessionIndex applies equality check predicate on the "session_index" field. It's identical to SessionIndexEQ.
+func SessionIndex(v string) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldEQ(FieldSessionIndex, v))
+}
+
+// Email applies equality check predicate on the "email" field. It's identical to EmailEQ.
+func Email(v string) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldEQ(FieldEmail, v))
+}
+
+// LastLoginAt applies equality check predicate on the "last_login_at" field. It's identical to LastLoginAtEQ.
+func LastLoginAt(v time.Time) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldEQ(FieldLastLoginAt, v))
+}
+
+// LastLoginIP applies equality check predicate on the "last_login_ip" field. It's identical to LastLoginIPEQ.
+func LastLoginIP(v string) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldEQ(FieldLastLoginIP, v))
+}
+
+// LoginCount applies equality check predicate on the "login_count" field. It's identical to LoginCountEQ.
+func LoginCount(v uint32) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldEQ(FieldLoginCount, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v uint8) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v uint8) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...uint8) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...uint8) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v uint8) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v uint8) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v uint8) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v uint8) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusIsNil applies the IsNil predicate on the "status" field.
+func StatusIsNil() predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldIsNull(FieldStatus))
+}
+
+// StatusNotNil applies the NotNil predicate on the "status" field.
+func StatusNotNil() predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldNotNull(FieldStatus))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v uint64) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v uint64) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...uint64) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...uint64) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v uint64) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v uint64) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v uint64) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v uint64) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldLTE(FieldTenantID, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v uuid.UUID) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v uuid.UUID) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...uuid.UUID) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...uuid.UUID) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// ProviderIDEQ applies the EQ predicate on the "provider_id" field.
+func ProviderIDEQ(v uint64) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldEQ(FieldProviderID, v))
+}
+
+// ProviderIDNEQ applies the NEQ predicate on the "provider_id" field.
+func ProviderIDNEQ(v uint64) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldNEQ(FieldProviderID, v))
+}
+
+// ProviderIDIn applies the In predicate on the "provider_id" field.
+func ProviderIDIn(vs ...uint64) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldIn(FieldProviderID, vs...))
+}
+
+// ProviderIDNotIn applies the NotIn predicate on the "provider_id" field.
+func ProviderIDNotIn(vs ...uint64) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldNotIn(FieldProviderID, vs...))
+}
+
+// NameIDEQ applies the EQ predicate on the "name_id" field.
+func NameIDEQ(v string) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldEQ(FieldNameID, v))
+}
+
+// NameIDNEQ applies the NEQ predicate on the "name_id" field.
+func NameIDNEQ(v string) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldNEQ(FieldNameID, v))
+}
+
+// NameIDIn applies the In predicate on the "name_id" field.
+func NameIDIn(vs ...string) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldIn(FieldNameID, vs...))
+}
+
+// NameIDNotIn applies the NotIn predicate on the "name_id" field.
+func NameIDNotIn(vs ...string) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldNotIn(FieldNameID, vs...))
+}
+
+// NameIDGT applies the GT predicate on the "name_id" field.
+func NameIDGT(v string) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldGT(FieldNameID, v))
+}
+
+// NameIDGTE applies the GTE predicate on the "name_id" field.
+func NameIDGTE(v string) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldGTE(FieldNameID, v))
+}
+
+// NameIDLT applies the LT predicate on the "name_id" field.
+func NameIDLT(v string) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldLT(FieldNameID, v))
+}
+
+// NameIDLTE applies the LTE predicate on the "name_id" field.
+func NameIDLTE(v string) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldLTE(FieldNameID, v))
+}
+
+// NameIDContains applies the Contains predicate on the "name_id" field.
+func NameIDContains(v string) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldContains(FieldNameID, v))
+}
+
+// NameIDHasPrefix applies the HasPrefix predicate on the "name_id" field.
+func NameIDHasPrefix(v string) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldHasPrefix(FieldNameID, v))
+}
+
+// NameIDHasSuffix applies the HasSuffix predicate on the "name_id" field.
+func NameIDHasSuffix(v string) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldHasSuffix(FieldNameID, v))
+}
+
+// NameIDEqualFold applies the EqualFold predicate on the "name_id" field.
+func NameIDEqualFold(v string) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldEqualFold(FieldNameID, v))
+}
+
+// NameIDContainsFold applies the ContainsFold predicate on the "name_id" field.
+func NameIDContainsFold(v string) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldContainsFold(FieldNameID, v))
+}
+
+// NameIDFormatEQ applies the EQ predicate on the "name_id_format" field.
+func NameIDFormatEQ(v string) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldEQ(FieldNameIDFormat, v))
+}
+
+// NameIDFormatNEQ applies the NEQ predicate on the "name_id_format" field.
+func NameIDFormatNEQ(v string) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldNEQ(FieldNameIDFormat, v))
+}
+
+// NameIDFormatIn applies the In predicate on the "name_id_format" field.
+func NameIDFormatIn(vs ...string) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldIn(FieldNameIDFormat, vs...))
+}
+
+// NameIDFormatNotIn applies the NotIn predicate on the "name_id_format" field.
+func NameIDFormatNotIn(vs ...string) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldNotIn(FieldNameIDFormat, vs...))
+}
+
+// NameIDFormatGT applies the GT predicate on the "name_id_format" field.
+func NameIDFormatGT(v string) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldGT(FieldNameIDFormat, v))
+}
+
+// NameIDFormatGTE applies the GTE predicate on the "name_id_format" field.
+func NameIDFormatGTE(v string) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldGTE(FieldNameIDFormat, v))
+}
+
+// NameIDFormatLT applies the LT predicate on the "name_id_format" field.
+func NameIDFormatLT(v string) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldLT(FieldNameIDFormat, v))
+}
+
+// NameIDFormatLTE applies the LTE predicate on the "name_id_format" field.
+func NameIDFormatLTE(v string) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldLTE(FieldNameIDFormat, v))
+}
+
+// NameIDFormatContains applies the Contains predicate on the "name_id_format" field.
+func NameIDFormatContains(v string) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldContains(FieldNameIDFormat, v))
+}
+
+// NameIDFormatHasPrefix applies the HasPrefix predicate on the "name_id_format" field.
+func NameIDFormatHasPrefix(v string) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldHasPrefix(FieldNameIDFormat, v))
+}
+
+// NameIDFormatHasSuffix applies the HasSuffix predicate on the "name_id_format" field.
+func NameIDFormatHasSuffix(v string) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldHasSuffix(FieldNameIDFormat, v))
+}
+
+// NameIDFormatIsNil applies the IsNil predicate on the "name_id_format" field.
+func NameIDFormatIsNil() predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldIsNull(FieldNameIDFormat))
+}
+
+// NameIDFormatNotNil applies the NotNil predicate on the "name_id_format" field.
+func NameIDFormatNotNil() predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldNotNull(FieldNameIDFormat))
+}
+
+// NameIDFormatEqualFold applies the EqualFold predicate on the "name_id_format" field.
+func NameIDFormatEqualFold(v string) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldEqualFold(FieldNameIDFormat, v))
+}
+
+// NameIDFormatContainsFold applies the ContainsFold predicate on the "name_id_format" field.
+func NameIDFormatContainsFold(v string) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldContainsFold(FieldNameIDFormat, v))
+}
+
+// SessionIndexEQ applies the EQ predicate on the "session_index" field.
+func SessionIndexEQ(v string) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldEQ(FieldSessionIndex, v))
+}
+
+// SessionIndexNEQ applies the NEQ predicate on the "session_index" field.
+func SessionIndexNEQ(v string) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldNEQ(FieldSessionIndex, v))
+}
+
+// SessionIndexIn applies the In predicate on the "session_index" field.
+func SessionIndexIn(vs ...string) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldIn(FieldSessionIndex, vs...))
+}
+
+// SessionIndexNotIn applies the NotIn predicate on the "session_index" field.
+func SessionIndexNotIn(vs ...string) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldNotIn(FieldSessionIndex, vs...))
+}
+
+// SessionIndexGT applies the GT predicate on the "session_index" field.
+func SessionIndexGT(v string) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldGT(FieldSessionIndex, v))
+}
+
+// SessionIndexGTE applies the GTE predicate on the "session_index" field.
+func SessionIndexGTE(v string) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldGTE(FieldSessionIndex, v))
+}
+
+// SessionIndexLT applies the LT predicate on the "session_index" field.
+func SessionIndexLT(v string) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldLT(FieldSessionIndex, v))
+}
+
+// SessionIndexLTE applies the LTE predicate on the "session_index" field.
+func SessionIndexLTE(v string) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldLTE(FieldSessionIndex, v))
+}
+
+// SessionIndexContains applies the Contains predicate on the "session_index" field.
+func SessionIndexContains(v string) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldContains(FieldSessionIndex, v))
+}
+
+// SessionIndexHasPrefix applies the HasPrefix predicate on the "session_index" field.
+func SessionIndexHasPrefix(v string) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldHasPrefix(FieldSessionIndex, v))
+}
+
+// SessionIndexHasSuffix applies the HasSuffix predicate on the "session_index" field.
+func SessionIndexHasSuffix(v string) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldHasSuffix(FieldSessionIndex, v))
+}
+
+// SessionIndexIsNil applies the IsNil predicate on the "session_index" field.
+func SessionIndexIsNil() predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldIsNull(FieldSessionIndex))
+}
+
+// SessionIndexNotNil applies the NotNil predicate on the "session_index" field.
+func SessionIndexNotNil() predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldNotNull(FieldSessionIndex))
+}
+
+// SessionIndexEqualFold applies the EqualFold predicate on the "session_index" field.
+func SessionIndexEqualFold(v string) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldEqualFold(FieldSessionIndex, v))
+}
+
+// SessionIndexContainsFold applies the ContainsFold predicate on the "session_index" field.
+func SessionIndexContainsFold(v string) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldContainsFold(FieldSessionIndex, v))
+}
+
+// EmailEQ applies the EQ predicate on the "email" field.
+func EmailEQ(v string) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldEQ(FieldEmail, v))
+}
+
+// EmailNEQ applies the NEQ predicate on the "email" field.
+func EmailNEQ(v string) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldNEQ(FieldEmail, v))
+}
+
+// EmailIn applies the In predicate on the "email" field.
+func EmailIn(vs ...string) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldIn(FieldEmail, vs...))
+}
+
+// EmailNotIn applies the NotIn predicate on the "email" field.
+func EmailNotIn(vs ...string) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldNotIn(FieldEmail, vs...))
+}
+
+// EmailGT applies the GT predicate on the "email" field.
+func EmailGT(v string) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldGT(FieldEmail, v))
+}
+
+// EmailGTE applies the GTE predicate on the "email" field.
+func EmailGTE(v string) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldGTE(FieldEmail, v))
+}
+
+// EmailLT applies the LT predicate on the "email" field.
+func EmailLT(v string) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldLT(FieldEmail, v))
+}
+
+// EmailLTE applies the LTE predicate on the "email" field.
+func EmailLTE(v string) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldLTE(FieldEmail, v))
+}
+
+// EmailContains applies the Contains predicate on the "email" field.
+func EmailContains(v string) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldContains(FieldEmail, v))
+}
+
+// EmailHasPrefix applies the HasPrefix predicate on the "email" field.
+func EmailHasPrefix(v string) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldHasPrefix(FieldEmail, v))
+}
+
+// EmailHasSuffix applies the HasSuffix predicate on the "email" field.
+func EmailHasSuffix(v string) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldHasSuffix(FieldEmail, v))
+}
+
+// EmailIsNil applies the IsNil predicate on the "email" field.
+func EmailIsNil() predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldIsNull(FieldEmail))
+}
+
+// EmailNotNil applies the NotNil predicate on the "email" field.
+func EmailNotNil() predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldNotNull(FieldEmail))
+}
+
+// EmailEqualFold applies the EqualFold predicate on the "email" field.
+func EmailEqualFold(v string) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldEqualFold(FieldEmail, v))
+}
+
+// EmailContainsFold applies the ContainsFold predicate on the "email" field.
+func EmailContainsFold(v string) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldContainsFold(FieldEmail, v))
+}
+
+// AttributesIsNil applies the IsNil predicate on the "attributes" field.
+func AttributesIsNil() predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldIsNull(FieldAttributes))
+}
+
+// AttributesNotNil applies the NotNil predicate on the "attributes" field.
+func AttributesNotNil() predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldNotNull(FieldAttributes))
+}
+
+// LastLoginAtEQ applies the EQ predicate on the "last_login_at" field.
+func LastLoginAtEQ(v time.Time) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldEQ(FieldLastLoginAt, v))
+}
+
+// LastLoginAtNEQ applies the NEQ predicate on the "last_login_at" field.
+func LastLoginAtNEQ(v time.Time) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldNEQ(FieldLastLoginAt, v))
+}
+
+// LastLoginAtIn applies the In predicate on the "last_login_at" field.
+func LastLoginAtIn(vs ...time.Time) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldIn(FieldLastLoginAt, vs...))
+}
+
+// LastLoginAtNotIn applies the NotIn predicate on the "last_login_at" field.
+func LastLoginAtNotIn(vs ...time.Time) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldNotIn(FieldLastLoginAt, vs...))
+}
+
+// LastLoginAtGT applies the GT predicate on the "last_login_at" field.
+func LastLoginAtGT(v time.Time) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldGT(FieldLastLoginAt, v))
+}
+
+// LastLoginAtGTE applies the GTE predicate on the "last_login_at" field.
+func LastLoginAtGTE(v time.Time) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldGTE(FieldLastLoginAt, v))
+}
+
+// LastLoginAtLT applies the LT predicate on the "last_login_at" field.
+func LastLoginAtLT(v time.Time) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldLT(FieldLastLoginAt, v))
+}
+
+// LastLoginAtLTE applies the LTE predicate on the "last_login_at" field.
+func LastLoginAtLTE(v time.Time) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldLTE(FieldLastLoginAt, v))
+}
+
+// LastLoginAtIsNil applies the IsNil predicate on the "last_login_at" field.
+func LastLoginAtIsNil() predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldIsNull(FieldLastLoginAt))
+}
+
+// LastLoginAtNotNil applies the NotNil predicate on the "last_login_at" field.
+func LastLoginAtNotNil() predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldNotNull(FieldLastLoginAt))
+}
+
+// LastLoginIPEQ applies the EQ predicate on the "last_login_ip" field.
+func LastLoginIPEQ(v string) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldEQ(FieldLastLoginIP, v))
+}
+
+// LastLoginIPNEQ applies the NEQ predicate on the "last_login_ip" field.
+func LastLoginIPNEQ(v string) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldNEQ(FieldLastLoginIP, v))
+}
+
+// LastLoginIPIn applies the In predicate on the "last_login_ip" field.
+func LastLoginIPIn(vs ...string) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldIn(FieldLastLoginIP, vs...))
+}
+
+// LastLoginIPNotIn applies the NotIn predicate on the "last_login_ip" field.
+func LastLoginIPNotIn(vs ...string) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldNotIn(FieldLastLoginIP, vs...))
+}
+
+// LastLoginIPGT applies the GT predicate on the "last_login_ip" field.
+func LastLoginIPGT(v string) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldGT(FieldLastLoginIP, v))
+}
+
+// LastLoginIPGTE applies the GTE predicate on the "last_login_ip" field.
+func LastLoginIPGTE(v string) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldGTE(FieldLastLoginIP, v))
+}
+
+// LastLoginIPLT applies the LT predicate on the "last_login_ip" field.
+func LastLoginIPLT(v string) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldLT(FieldLastLoginIP, v))
+}
+
+// LastLoginIPLTE applies the LTE predicate on the "last_login_ip" field.
+func LastLoginIPLTE(v string) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldLTE(FieldLastLoginIP, v))
+}
+
+// LastLoginIPContains applies the Contains predicate on the "last_login_ip" field.
+func LastLoginIPContains(v string) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldContains(FieldLastLoginIP, v))
+}
+
+// LastLoginIPHasPrefix applies the HasPrefix predicate on the "last_login_ip" field.
+func LastLoginIPHasPrefix(v string) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldHasPrefix(FieldLastLoginIP, v))
+}
+
+// LastLoginIPHasSuffix applies the HasSuffix predicate on the "last_login_ip" field.
+func LastLoginIPHasSuffix(v string) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldHasSuffix(FieldLastLoginIP, v))
+}
+
+// LastLoginIPIsNil applies the IsNil predicate on the "last_login_ip" field.
+func LastLoginIPIsNil() predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldIsNull(FieldLastLoginIP))
+}
+
+// LastLoginIPNotNil applies the NotNil predicate on the "last_login_ip" field.
+func LastLoginIPNotNil() predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldNotNull(FieldLastLoginIP))
+}
+
+// LastLoginIPEqualFold applies the EqualFold predicate on the "last_login_ip" field.
+func LastLoginIPEqualFold(v string) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldEqualFold(FieldLastLoginIP, v))
+}
+
+// LastLoginIPContainsFold applies the ContainsFold predicate on the "last_login_ip" field.
+func LastLoginIPContainsFold(v string) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldContainsFold(FieldLastLoginIP, v))
+}
+
+// LoginCountEQ applies the EQ predicate on the "login_count" field.
+func LoginCountEQ(v uint32) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldEQ(FieldLoginCount, v))
+}
+
+// LoginCountNEQ applies the NEQ predicate on the "login_count" field.
+func LoginCountNEQ(v uint32) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldNEQ(FieldLoginCount, v))
+}
+
+// LoginCountIn applies the In predicate on the "login_count" field.
+func LoginCountIn(vs ...uint32) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldIn(FieldLoginCount, vs...))
+}
+
+// LoginCountNotIn applies the NotIn predicate on the "login_count" field.
+func LoginCountNotIn(vs ...uint32) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldNotIn(FieldLoginCount, vs...))
+}
+
+// LoginCountGT applies the GT predicate on the "login_count" field.
+func LoginCountGT(v uint32) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldGT(FieldLoginCount, v))
+}
+
+// LoginCountGTE applies the GTE predicate on the "login_count" field.
+func LoginCountGTE(v uint32) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldGTE(FieldLoginCount, v))
+}
+
+// LoginCountLT applies the LT predicate on the "login_count" field.
+func LoginCountLT(v uint32) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldLT(FieldLoginCount, v))
+}
+
+// LoginCountLTE applies the LTE predicate on the "login_count" field.
+func LoginCountLTE(v uint32) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.FieldLTE(FieldLoginCount, v))
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.SamlAccount {
+	return predicate.SamlAccount(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.SamlAccount {
+	return predicate.SamlAccount(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasProvider applies the HasEdge predicate on the "provider" edge.
+func HasProvider() predicate.SamlAccount {
+	return predicate.SamlAccount(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ProviderTable, ProviderColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasProviderWith applies the HasEdge predicate on the "provider" edge with a given conditions (other predicates).
+func HasProviderWith(preds ...predicate.SamlProvider) predicate.SamlAccount {
+	return predicate.SamlAccount(func(s *sql.Selector) {
+		step := newProviderStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.SamlAccount) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.SamlAccount) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.SamlAccount) predicate.SamlAccount {
+	return predicate.SamlAccount(sql.NotPredicates(p))
+}
@@ -0,0 +1,520 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/coder-lulu/newbee-core/rpc/ent/samlaccount"
+	"github.com/coder-lulu/newbee-core/rpc/ent/samlprovider"
+	"github.com/coder-lulu/newbee-core/rpc/ent/user"
+	uuid "github.com/gofrs/uuid/v5"
+)
+
+// SamlAccountCreate is the builder for creating a SamlAccount entity.
+type SamlAccountCreate struct {
+	config
+	mutation *SamlAccountMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *SamlAccountCreate) SetCreatedAt(v time.Time) *SamlAccountCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *SamlAccountCreate) SetNillableCreatedAt(v *time.Time) *SamlAccountCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *SamlAccountCreate) SetUpdatedAt(v time.Time) *SamlAccountCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *SamlAccountCreate) SetNillableUpdatedAt(v *time.Time) *SamlAccountCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *SamlAccountCreate) SetStatus(v uint8) *SamlAccountCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *SamlAccountCreate) SetNillableStatus(v *uint8) *SamlAccountCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *SamlAccountCreate) SetTenantID(v uint64) *SamlAccountCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_c *SamlAccountCreate) SetNillableTenantID(v *uint64) *SamlAccountCreate {
+	if v != nil {
+		_c.SetTenantID(*v)
+	}
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *SamlAccountCreate) SetUserID(v uuid.UUID) *SamlAccountCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetProviderID sets the "provider_id" field.
+func (_c *SamlAccountCreate) SetProviderID(v uint64) *SamlAccountCreate {
+	_c.mutation.SetProviderID(v)
+	return _c
+}
+
+// SetNameID sets the "name_id" field.
+func (_c *SamlAccountCreate) SetNameID(v string) *SamlAccountCreate {
+	_c.mutation.SetNameID(v)
+	return _c
+}
+
+// SetNameIDFormat sets the "name_id_format" field.
+func (_c *SamlAccountCreate) SetNameIDFormat(v string) *SamlAccountCreate {
+	_c.mutation.SetNameIDFormat(v)
+	return _c
+}
+
+// SetNillableNameIDFormat sets the "name_id_format" field if the given value is not nil.
+func (_c *SamlAccountCreate) SetNillableNameIDFormat(v *string) *SamlAccountCreate {
+	if v != nil {
+		_c.SetNameIDFormat(*v)
+	}
+	return _c
+}
+
+// SetSessionIndex sets the "session_index" field.
+func (_c *SamlAccountCreate) SetSessionIndex(v string) *SamlAccountCreate {
+	_c.mutation.SetSessionIndex(v)
+	return _c
+}
+
+// SetNillableSessionIndex sets the "session_index" field if the given value is not nil.
+func (_c *SamlAccountCreate) SetNillableSessionIndex(v *string) *SamlAccountCreate {
+	if v != nil {
+		_c.SetSessionIndex(*v)
+	}
+	return _c
+}
+
+// SetEmail sets the "email" field.
+func (_c *SamlAccountCreate) SetEmail(v string) *SamlAccountCreate {
+	_c.mutation.SetEmail(v)
+	return _c
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (_c *SamlAccountCreate) SetNillableEmail(v *string) *SamlAccountCreate {
+	if v != nil {
+		_c.SetEmail(*v)
+	}
+	return _c
+}
+
+// SetAttributes sets the "attributes" field.
+func (_c *SamlAccountCreate) SetAttributes(v map[string][]string) *SamlAccountCreate {
+	_c.mutation.SetAttributes(v)
+	return _c
+}
+
+// SetLastLoginAt sets the "last_login_at" field.
+func (_c *SamlAccountCreate) SetLastLoginAt(v time.Time) *SamlAccountCreate {
+	_c.mutation.SetLastLoginAt(v)
+	return _c
+}
+
+// SetNillableLastLoginAt sets the "last_login_at" field if the given value is not nil.
+func (_c *SamlAccountCreate) SetNillableLastLoginAt(v *time.Time) *SamlAccountCreate {
+	if v != nil {
+		_c.SetLastLoginAt(*v)
+	}
+	return _c
+}
+
+// SetLastLoginIP sets the "last_login_ip" field.
+func (_c *SamlAccountCreate) SetLastLoginIP(v string) *SamlAccountCreate {
+	_c.mutation.SetLastLoginIP(v)
+	return _c
+}
+
+// SetNillableLastLoginIP sets the "last_login_ip" field if the given value is not nil.
+func (_c *SamlAccountCreate) SetNillableLastLoginIP(v *string) *SamlAccountCreate {
+	if v != nil {
+		_c.SetLastLoginIP(*v)
+	}
+	return _c
+}
+
+// SetLoginCount sets the "login_count" field.
+func (_c *SamlAccountCreate) SetLoginCount(v uint32) *SamlAccountCreate {
+	_c.mutation.SetLoginCount(v)
+	return _c
+}
+
+// SetNillableLoginCount sets the "login_count" field if the given value is not nil.
+func (_c *SamlAccountCreate) SetNillableLoginCount(v *uint32) *SamlAccountCreate {
+	if v != nil {
+		_c.SetLoginCount(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *SamlAccountCreate) SetID(v uint64) *SamlAccountCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_c *SamlAccountCreate) SetUser(v *User) *SamlAccountCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// SetProvider sets the "provider" edge to the SamlProvider entity.
+func (_c *SamlAccountCreate) SetProvider(v *SamlProvider) *SamlAccountCreate {
+	return _c.SetProviderID(v.ID)
+}
+
+// Mutation returns the SamlAccountMutation object of the builder.
+func (_c *SamlAccountCreate) Mutation() *SamlAccountMutation {
+	return _c.mutation
+}
+
+// Save creates the SamlAccount in the database.
+func (_c *SamlAccountCreate) Save(ctx context.Context) (*SamlAccount, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *SamlAccountCreate) SaveX(ctx context.Context) *SamlAccount {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SamlAccountCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SamlAccountCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *SamlAccountCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := samlaccount.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := samlaccount.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := samlaccount.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.TenantID(); !ok {
+		v := samlaccount.DefaultTenantID
+		_c.mutation.SetTenantID(v)
+	}
+	if _, ok := _c.mutation.LoginCount(); !ok {
+		v := samlaccount.DefaultLoginCount
+		_c.mutation.SetLoginCount(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *SamlAccountCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "SamlAccount.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "SamlAccount.updated_at"`)}
+	}
+	if _, ok := _c.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "SamlAccount.tenant_id"`)}
+	}
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "SamlAccount.user_id"`)}
+	}
+	if _, ok := _c.mutation.ProviderID(); !ok {
+		return &ValidationError{Name: "provider_id", err: errors.New(`ent: missing required field "SamlAccount.provider_id"`)}
+	}
+	if _, ok := _c.mutation.NameID(); !ok {
+		return &ValidationError{Name: "name_id", err: errors.New(`ent: missing required field "SamlAccount.name_id"`)}
+	}
+	if v, ok := _c.mutation.NameID(); ok {
+		if err := samlaccount.NameIDValidator(v); err != nil {
+			return &ValidationError{Name: "name_id", err: fmt.Errorf(`ent: validator failed for field "SamlAccount.name_id": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.NameIDFormat(); ok {
+		if err := samlaccount.NameIDFormatValidator(v); err != nil {
+			return &ValidationError{Name: "name_id_format", err: fmt.Errorf(`ent: validator failed for field "SamlAccount.name_id_format": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.SessionIndex(); ok {
+		if err := samlaccount.SessionIndexValidator(v); err != nil {
+			return &ValidationError{Name: "session_index", err: fmt.Errorf(`ent: validator failed for field "SamlAccount.session_index": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Email(); ok {
+		if err := samlaccount.EmailValidator(v); err != nil {
+			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "SamlAccount.email": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.LastLoginIP(); ok {
+		if err := samlaccount.LastLoginIPValidator(v); err != nil {
+			return &ValidationError{Name: "last_login_ip", err: fmt.Errorf(`ent: validator failed for field "SamlAccount.last_login_ip": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.LoginCount(); !ok {
+		return &ValidationError{Name: "login_count", err: errors.New(`ent: missing required field "SamlAccount.login_count"`)}
+	}
+	if len(_c.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "SamlAccount.user"`)}
+	}
+	if len(_c.mutation.ProviderIDs()) == 0 {
+		return &ValidationError{Name: "provider", err: errors.New(`ent: missing required edge "SamlAccount.provider"`)}
+	}
+	return nil
+}
+
+func (_c *SamlAccountCreate) sqlSave(ctx context.Context) (*SamlAccount, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint64(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *SamlAccountCreate) createSpec() (*SamlAccount, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SamlAccount{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(samlaccount.Table, sqlgraph.NewFieldSpec(samlaccount.FieldID, field.TypeUint64))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(samlaccount.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(samlaccount.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(samlaccount.FieldStatus, field.TypeUint8, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(samlaccount.FieldTenantID, field.TypeUint64, value)
+		_node.TenantID = value
+	}
+	if value, ok := _c.mutation.NameID(); ok {
+		_spec.SetField(samlaccount.FieldNameID, field.TypeString, value)
+		_node.NameID = value
+	}
+	if value, ok := _c.mutation.NameIDFormat(); ok {
+		_spec.SetField(samlaccount.FieldNameIDFormat, field.TypeString, value)
+		_node.NameIDFormat = value
+	}
+	if value, ok := _c.mutation.SessionIndex(); ok {
+		_spec.SetField(samlaccount.FieldSessionIndex, field.TypeString, value)
+		_node.SessionIndex = value
+	}
+	if value, ok := _c.mutation.Email(); ok {
+		_spec.SetField(samlaccount.FieldEmail, field.TypeString, value)
+		_node.Email = value
+	}
+	if value, ok := _c.mutation.Attributes(); ok {
+		_spec.SetField(samlaccount.FieldAttributes, field.TypeJSON, value)
+		_node.Attributes = value
+	}
+	if value, ok := _c.mutation.LastLoginAt(); ok {
+		_spec.SetField(samlaccount.FieldLastLoginAt, field.TypeTime, value)
+		_node.LastLoginAt = value
+	}
+	if value, ok := _c.mutation.LastLoginIP(); ok {
+		_spec.SetField(samlaccount.FieldLastLoginIP, field.TypeString, value)
+		_node.LastLoginIP = value
+	}
+	if value, ok := _c.mutation.LoginCount(); ok {
+		_spec.SetField(samlaccount.FieldLoginCount, field.TypeUint32, value)
+		_node.LoginCount = value
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   samlaccount.UserTable,
+			Columns: []string{samlaccount.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ProviderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   samlaccount.ProviderTable,
+			Columns: []string{samlaccount.ProviderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(samlprovider.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ProviderID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// SamlAccountCreateBulk is the builder for creating many SamlAccount entities in bulk.
+type SamlAccountCreateBulk struct {
+	config
+	err      error
+	builders []*SamlAccountCreate
+}
+
+// Save creates the SamlAccount entities in the database.
+func (_c *SamlAccountCreateBulk) Save(ctx context.Context) ([]*SamlAccount, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*SamlAccount, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SamlAccountMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *SamlAccountCreateBulk) SaveX(ctx context.Context) []*SamlAccount {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SamlAccountCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SamlAccountCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
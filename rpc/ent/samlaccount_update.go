@@ -0,0 +1,981 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/coder-lulu/newbee-core/rpc/ent/predicate"
+	"github.com/coder-lulu/newbee-core/rpc/ent/samlaccount"
+	"github.com/coder-lulu/newbee-core/rpc/ent/samlprovider"
+	"github.com/coder-lulu/newbee-core/rpc/ent/user"
+	uuid "github.com/gofrs/uuid/v5"
+)
+
+// SamlAccountUpdate is the builder for updating SamlAccount entities.
+type SamlAccountUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *SamlAccountMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the SamlAccountUpdate builder.
+func (_u *SamlAccountUpdate) Where(ps ...predicate.SamlAccount) *SamlAccountUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *SamlAccountUpdate) SetUpdatedAt(v time.Time) *SamlAccountUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *SamlAccountUpdate) SetStatus(v uint8) *SamlAccountUpdate {
+	_u.mutation.ResetStatus()
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *SamlAccountUpdate) SetNillableStatus(v *uint8) *SamlAccountUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// AddStatus adds value to the "status" field.
+func (_u *SamlAccountUpdate) AddStatus(v int8) *SamlAccountUpdate {
+	_u.mutation.AddStatus(v)
+	return _u
+}
+
+// ClearStatus clears the value of the "status" field.
+func (_u *SamlAccountUpdate) ClearStatus() *SamlAccountUpdate {
+	_u.mutation.ClearStatus()
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *SamlAccountUpdate) SetUserID(v uuid.UUID) *SamlAccountUpdate {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *SamlAccountUpdate) SetNillableUserID(v *uuid.UUID) *SamlAccountUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetProviderID sets the "provider_id" field.
+func (_u *SamlAccountUpdate) SetProviderID(v uint64) *SamlAccountUpdate {
+	_u.mutation.SetProviderID(v)
+	return _u
+}
+
+// SetNillableProviderID sets the "provider_id" field if the given value is not nil.
+func (_u *SamlAccountUpdate) SetNillableProviderID(v *uint64) *SamlAccountUpdate {
+	if v != nil {
+		_u.SetProviderID(*v)
+	}
+	return _u
+}
+
+// SetNameID sets the "name_id" field.
+func (_u *SamlAccountUpdate) SetNameID(v string) *SamlAccountUpdate {
+	_u.mutation.SetNameID(v)
+	return _u
+}
+
+// SetNillableNameID sets the "name_id" field if the given value is not nil.
+func (_u *SamlAccountUpdate) SetNillableNameID(v *string) *SamlAccountUpdate {
+	if v != nil {
+		_u.SetNameID(*v)
+	}
+	return _u
+}
+
+// SetNameIDFormat sets the "name_id_format" field.
+func (_u *SamlAccountUpdate) SetNameIDFormat(v string) *SamlAccountUpdate {
+	_u.mutation.SetNameIDFormat(v)
+	return _u
+}
+
+// SetNillableNameIDFormat sets the "name_id_format" field if the given value is not nil.
+func (_u *SamlAccountUpdate) SetNillableNameIDFormat(v *string) *SamlAccountUpdate {
+	if v != nil {
+		_u.SetNameIDFormat(*v)
+	}
+	return _u
+}
+
+// ClearNameIDFormat clears the value of the "name_id_format" field.
+func (_u *SamlAccountUpdate) ClearNameIDFormat() *SamlAccountUpdate {
+	_u.mutation.ClearNameIDFormat()
+	return _u
+}
+
+// SetSessionIndex sets the "session_index" field.
+func (_u *SamlAccountUpdate) SetSessionIndex(v string) *SamlAccountUpdate {
+	_u.mutation.SetSessionIndex(v)
+	return _u
+}
+
+// SetNillableSessionIndex sets the "session_index" field if the given value is not nil.
+func (_u *SamlAccountUpdate) SetNillableSessionIndex(v *string) *SamlAccountUpdate {
+	if v != nil {
+		_u.SetSessionIndex(*v)
+	}
+	return _u
+}
+
+// ClearSessionIndex clears the value of the "session_index" field.
+func (_u *SamlAccountUpdate) ClearSessionIndex() *SamlAccountUpdate {
+	_u.mutation.ClearSessionIndex()
+	return _u
+}
+
+// SetEmail sets the "email" field.
+func (_u *SamlAccountUpdate) SetEmail(v string) *SamlAccountUpdate {
+	_u.mutation.SetEmail(v)
+	return _u
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (_u *SamlAccountUpdate) SetNillableEmail(v *string) *SamlAccountUpdate {
+	if v != nil {
+		_u.SetEmail(*v)
+	}
+	return _u
+}
+
+// ClearEmail clears the value of the "email" field.
+func (_u *SamlAccountUpdate) ClearEmail() *SamlAccountUpdate {
+	_u.mutation.ClearEmail()
+	return _u
+}
+
+// SetAttributes sets the "attributes" field.
+func (_u *SamlAccountUpdate) SetAttributes(v map[string][]string) *SamlAccountUpdate {
+	_u.mutation.SetAttributes(v)
+	return _u
+}
+
+// ClearAttributes clears the value of the "attributes" field.
+func (_u *SamlAccountUpdate) ClearAttributes() *SamlAccountUpdate {
+	_u.mutation.ClearAttributes()
+	return _u
+}
+
+// SetLastLoginAt sets the "last_login_at" field.
+func (_u *SamlAccountUpdate) SetLastLoginAt(v time.Time) *SamlAccountUpdate {
+	_u.mutation.SetLastLoginAt(v)
+	return _u
+}
+
+// SetNillableLastLoginAt sets the "last_login_at" field if the given value is not nil.
+func (_u *SamlAccountUpdate) SetNillableLastLoginAt(v *time.Time) *SamlAccountUpdate {
+	if v != nil {
+		_u.SetLastLoginAt(*v)
+	}
+	return _u
+}
+
+// ClearLastLoginAt clears the value of the "last_login_at" field.
+func (_u *SamlAccountUpdate) ClearLastLoginAt() *SamlAccountUpdate {
+	_u.mutation.ClearLastLoginAt()
+	return _u
+}
+
+// SetLastLoginIP sets the "last_login_ip" field.
+func (_u *SamlAccountUpdate) SetLastLoginIP(v string) *SamlAccountUpdate {
+	_u.mutation.SetLastLoginIP(v)
+	return _u
+}
+
+// SetNillableLastLoginIP sets the "last_login_ip" field if the given value is not nil.
+func (_u *SamlAccountUpdate) SetNillableLastLoginIP(v *string) *SamlAccountUpdate {
+	if v != nil {
+		_u.SetLastLoginIP(*v)
+	}
+	return _u
+}
+
+// ClearLastLoginIP clears the value of the "last_login_ip" field.
+func (_u *SamlAccountUpdate) ClearLastLoginIP() *SamlAccountUpdate {
+	_u.mutation.ClearLastLoginIP()
+	return _u
+}
+
+// SetLoginCount sets the "login_count" field.
+func (_u *SamlAccountUpdate) SetLoginCount(v uint32) *SamlAccountUpdate {
+	_u.mutation.ResetLoginCount()
+	_u.mutation.SetLoginCount(v)
+	return _u
+}
+
+// SetNillableLoginCount sets the "login_count" field if the given value is not nil.
+func (_u *SamlAccountUpdate) SetNillableLoginCount(v *uint32) *SamlAccountUpdate {
+	if v != nil {
+		_u.SetLoginCount(*v)
+	}
+	return _u
+}
+
+// AddLoginCount adds value to the "login_count" field.
+func (_u *SamlAccountUpdate) AddLoginCount(v int32) *SamlAccountUpdate {
+	_u.mutation.AddLoginCount(v)
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *SamlAccountUpdate) SetUser(v *User) *SamlAccountUpdate {
+	return _u.SetUserID(v.ID)
+}
+
+// SetProvider sets the "provider" edge to the SamlProvider entity.
+func (_u *SamlAccountUpdate) SetProvider(v *SamlProvider) *SamlAccountUpdate {
+	return _u.SetProviderID(v.ID)
+}
+
+// Mutation returns the SamlAccountMutation object of the builder.
+func (_u *SamlAccountUpdate) Mutation() *SamlAccountMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *SamlAccountUpdate) ClearUser() *SamlAccountUpdate {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// ClearProvider clears the "provider" edge to the SamlProvider entity.
+func (_u *SamlAccountUpdate) ClearProvider() *SamlAccountUpdate {
+	_u.mutation.ClearProvider()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *SamlAccountUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SamlAccountUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *SamlAccountUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SamlAccountUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *SamlAccountUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := samlaccount.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *SamlAccountUpdate) check() error {
+	if v, ok := _u.mutation.NameID(); ok {
+		if err := samlaccount.NameIDValidator(v); err != nil {
+			return &ValidationError{Name: "name_id", err: fmt.Errorf(`ent: validator failed for field "SamlAccount.name_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.NameIDFormat(); ok {
+		if err := samlaccount.NameIDFormatValidator(v); err != nil {
+			return &ValidationError{Name: "name_id_format", err: fmt.Errorf(`ent: validator failed for field "SamlAccount.name_id_format": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.SessionIndex(); ok {
+		if err := samlaccount.SessionIndexValidator(v); err != nil {
+			return &ValidationError{Name: "session_index", err: fmt.Errorf(`ent: validator failed for field "SamlAccount.session_index": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Email(); ok {
+		if err := samlaccount.EmailValidator(v); err != nil {
+			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "SamlAccount.email": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.LastLoginIP(); ok {
+		if err := samlaccount.LastLoginIPValidator(v); err != nil {
+			return &ValidationError{Name: "last_login_ip", err: fmt.Errorf(`ent: validator failed for field "SamlAccount.last_login_ip": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "SamlAccount.user"`)
+	}
+	if _u.mutation.ProviderCleared() && len(_u.mutation.ProviderIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "SamlAccount.provider"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *SamlAccountUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *SamlAccountUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *SamlAccountUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(samlaccount.Table, samlaccount.Columns, sqlgraph.NewFieldSpec(samlaccount.FieldID, field.TypeUint64))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(samlaccount.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(samlaccount.FieldStatus, field.TypeUint8, value)
+	}
+	if value, ok := _u.mutation.AddedStatus(); ok {
+		_spec.AddField(samlaccount.FieldStatus, field.TypeUint8, value)
+	}
+	if _u.mutation.StatusCleared() {
+		_spec.ClearField(samlaccount.FieldStatus, field.TypeUint8)
+	}
+	if value, ok := _u.mutation.NameID(); ok {
+		_spec.SetField(samlaccount.FieldNameID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.NameIDFormat(); ok {
+		_spec.SetField(samlaccount.FieldNameIDFormat, field.TypeString, value)
+	}
+	if _u.mutation.NameIDFormatCleared() {
+		_spec.ClearField(samlaccount.FieldNameIDFormat, field.TypeString)
+	}
+	if value, ok := _u.mutation.SessionIndex(); ok {
+		_spec.SetField(samlaccount.FieldSessionIndex, field.TypeString, value)
+	}
+	if _u.mutation.SessionIndexCleared() {
+		_spec.ClearField(samlaccount.FieldSessionIndex, field.TypeString)
+	}
+	if value, ok := _u.mutation.Email(); ok {
+		_spec.SetField(samlaccount.FieldEmail, field.TypeString, value)
+	}
+	if _u.mutation.EmailCleared() {
+		_spec.ClearField(samlaccount.FieldEmail, field.TypeString)
+	}
+	if value, ok := _u.mutation.Attributes(); ok {
+		_spec.SetField(samlaccount.FieldAttributes, field.TypeJSON, value)
+	}
+	if _u.mutation.AttributesCleared() {
+		_spec.ClearField(samlaccount.FieldAttributes, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.LastLoginAt(); ok {
+		_spec.SetField(samlaccount.FieldLastLoginAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastLoginAtCleared() {
+		_spec.ClearField(samlaccount.FieldLastLoginAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastLoginIP(); ok {
+		_spec.SetField(samlaccount.FieldLastLoginIP, field.TypeString, value)
+	}
+	if _u.mutation.LastLoginIPCleared() {
+		_spec.ClearField(samlaccount.FieldLastLoginIP, field.TypeString)
+	}
+	if value, ok := _u.mutation.LoginCount(); ok {
+		_spec.SetField(samlaccount.FieldLoginCount, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedLoginCount(); ok {
+		_spec.AddField(samlaccount.FieldLoginCount, field.TypeUint32, value)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   samlaccount.UserTable,
+			Columns: []string{samlaccount.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   samlaccount.UserTable,
+			Columns: []string{samlaccount.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ProviderCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   samlaccount.ProviderTable,
+			Columns: []string{samlaccount.ProviderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(samlprovider.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ProviderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   samlaccount.ProviderTable,
+			Columns: []string{samlaccount.ProviderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(samlprovider.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{samlaccount.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// SamlAccountUpdateOne is the builder for updating a single SamlAccount entity.
+type SamlAccountUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *SamlAccountMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *SamlAccountUpdateOne) SetUpdatedAt(v time.Time) *SamlAccountUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *SamlAccountUpdateOne) SetStatus(v uint8) *SamlAccountUpdateOne {
+	_u.mutation.ResetStatus()
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *SamlAccountUpdateOne) SetNillableStatus(v *uint8) *SamlAccountUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// AddStatus adds value to the "status" field.
+func (_u *SamlAccountUpdateOne) AddStatus(v int8) *SamlAccountUpdateOne {
+	_u.mutation.AddStatus(v)
+	return _u
+}
+
+// ClearStatus clears the value of the "status" field.
+func (_u *SamlAccountUpdateOne) ClearStatus() *SamlAccountUpdateOne {
+	_u.mutation.ClearStatus()
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *SamlAccountUpdateOne) SetUserID(v uuid.UUID) *SamlAccountUpdateOne {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *SamlAccountUpdateOne) SetNillableUserID(v *uuid.UUID) *SamlAccountUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetProviderID sets the "provider_id" field.
+func (_u *SamlAccountUpdateOne) SetProviderID(v uint64) *SamlAccountUpdateOne {
+	_u.mutation.SetProviderID(v)
+	return _u
+}
+
+// SetNillableProviderID sets the "provider_id" field if the given value is not nil.
+func (_u *SamlAccountUpdateOne) SetNillableProviderID(v *uint64) *SamlAccountUpdateOne {
+	if v != nil {
+		_u.SetProviderID(*v)
+	}
+	return _u
+}
+
+// SetNameID sets the "name_id" field.
+func (_u *SamlAccountUpdateOne) SetNameID(v string) *SamlAccountUpdateOne {
+	_u.mutation.SetNameID(v)
+	return _u
+}
+
+// SetNillableNameID sets the "name_id" field if the given value is not nil.
+func (_u *SamlAccountUpdateOne) SetNillableNameID(v *string) *SamlAccountUpdateOne {
+	if v != nil {
+		_u.SetNameID(*v)
+	}
+	return _u
+}
+
+// SetNameIDFormat sets the "name_id_format" field.
+func (_u *SamlAccountUpdateOne) SetNameIDFormat(v string) *SamlAccountUpdateOne {
+	_u.mutation.SetNameIDFormat(v)
+	return _u
+}
+
+// SetNillableNameIDFormat sets the "name_id_format" field if the given value is not nil.
+func (_u *SamlAccountUpdateOne) SetNillableNameIDFormat(v *string) *SamlAccountUpdateOne {
+	if v != nil {
+		_u.SetNameIDFormat(*v)
+	}
+	return _u
+}
+
+// ClearNameIDFormat clears the value of the "name_id_format" field.
+func (_u *SamlAccountUpdateOne) ClearNameIDFormat() *SamlAccountUpdateOne {
+	_u.mutation.ClearNameIDFormat()
+	return _u
+}
+
+// SetSessionIndex sets the "session_index" field.
+func (_u *SamlAccountUpdateOne) SetSessionIndex(v string) *SamlAccountUpdateOne {
+	_u.mutation.SetSessionIndex(v)
+	return _u
+}
+
+// SetNillableSessionIndex sets the "session_index" field if the given value is not nil.
+func (_u *SamlAccountUpdateOne) SetNillableSessionIndex(v *string) *SamlAccountUpdateOne {
+	if v != nil {
+		_u.SetSessionIndex(*v)
+	}
+	return _u
+}
+
+// ClearSessionIndex clears the value of the "session_index" field.
+func (_u *SamlAccountUpdateOne) ClearSessionIndex() *SamlAccountUpdateOne {
+	_u.mutation.ClearSessionIndex()
+	return _u
+}
+
+// SetEmail sets the "email" field.
+func (_u *SamlAccountUpdateOne) SetEmail(v string) *SamlAccountUpdateOne {
+	_u.mutation.SetEmail(v)
+	return _u
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (_u *SamlAccountUpdateOne) SetNillableEmail(v *string) *SamlAccountUpdateOne {
+	if v != nil {
+		_u.SetEmail(*v)
+	}
+	return _u
+}
+
+// ClearEmail clears the value of the "email" field.
+func (_u *SamlAccountUpdateOne) ClearEmail() *SamlAccountUpdateOne {
+	_u.mutation.ClearEmail()
+	return _u
+}
+
+// SetAttributes sets the "attributes" field.
+func (_u *SamlAccountUpdateOne) SetAttributes(v map[string][]string) *SamlAccountUpdateOne {
+	_u.mutation.SetAttributes(v)
+	return _u
+}
+
+// ClearAttributes clears the value of the "attributes" field.
+func (_u *SamlAccountUpdateOne) ClearAttributes() *SamlAccountUpdateOne {
+	_u.mutation.ClearAttributes()
+	return _u
+}
+
+// SetLastLoginAt sets the "last_login_at" field.
+func (_u *SamlAccountUpdateOne) SetLastLoginAt(v time.Time) *SamlAccountUpdateOne {
+	_u.mutation.SetLastLoginAt(v)
+	return _u
+}
+
+// SetNillableLastLoginAt sets the "last_login_at" field if the given value is not nil.
+func (_u *SamlAccountUpdateOne) SetNillableLastLoginAt(v *time.Time) *SamlAccountUpdateOne {
+	if v != nil {
+		_u.SetLastLoginAt(*v)
+	}
+	return _u
+}
+
+// ClearLastLoginAt clears the value of the "last_login_at" field.
+func (_u *SamlAccountUpdateOne) ClearLastLoginAt() *SamlAccountUpdateOne {
+	_u.mutation.ClearLastLoginAt()
+	return _u
+}
+
+// SetLastLoginIP sets the "last_login_ip" field.
+func (_u *SamlAccountUpdateOne) SetLastLoginIP(v string) *SamlAccountUpdateOne {
+	_u.mutation.SetLastLoginIP(v)
+	return _u
+}
+
+// SetNillableLastLoginIP sets the "last_login_ip" field if the given value is not nil.
+func (_u *SamlAccountUpdateOne) SetNillableLastLoginIP(v *string) *SamlAccountUpdateOne {
+	if v != nil {
+		_u.SetLastLoginIP(*v)
+	}
+	return _u
+}
+
+// ClearLastLoginIP clears the value of the "last_login_ip" field.
+func (_u *SamlAccountUpdateOne) ClearLastLoginIP() *SamlAccountUpdateOne {
+	_u.mutation.ClearLastLoginIP()
+	return _u
+}
+
+// SetLoginCount sets the "login_count" field.
+func (_u *SamlAccountUpdateOne) SetLoginCount(v uint32) *SamlAccountUpdateOne {
+	_u.mutation.ResetLoginCount()
+	_u.mutation.SetLoginCount(v)
+	return _u
+}
+
+// SetNillableLoginCount sets the "login_count" field if the given value is not nil.
+func (_u *SamlAccountUpdateOne) SetNillableLoginCount(v *uint32) *SamlAccountUpdateOne {
+	if v != nil {
+		_u.SetLoginCount(*v)
+	}
+	return _u
+}
+
+// AddLoginCount adds value to the "login_count" field.
+func (_u *SamlAccountUpdateOne) AddLoginCount(v int32) *SamlAccountUpdateOne {
+	_u.mutation.AddLoginCount(v)
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *SamlAccountUpdateOne) SetUser(v *User) *SamlAccountUpdateOne {
+	return _u.SetUserID(v.ID)
+}
+
+// SetProvider sets the "provider" edge to the SamlProvider entity.
+func (_u *SamlAccountUpdateOne) SetProvider(v *SamlProvider) *SamlAccountUpdateOne {
+	return _u.SetProviderID(v.ID)
+}
+
+// Mutation returns the SamlAccountMutation object of the builder.
+func (_u *SamlAccountUpdateOne) Mutation() *SamlAccountMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *SamlAccountUpdateOne) ClearUser() *SamlAccountUpdateOne {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// ClearProvider clears the "provider" edge to the SamlProvider entity.
+func (_u *SamlAccountUpdateOne) ClearProvider() *SamlAccountUpdateOne {
+	_u.mutation.ClearProvider()
+	return _u
+}
+
+// Where appends a list predicates to the SamlAccountUpdate builder.
+func (_u *SamlAccountUpdateOne) Where(ps ...predicate.SamlAccount) *SamlAccountUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *SamlAccountUpdateOne) Select(field string, fields ...string) *SamlAccountUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated SamlAccount entity.
+func (_u *SamlAccountUpdateOne) Save(ctx context.Context) (*SamlAccount, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SamlAccountUpdateOne) SaveX(ctx context.Context) *SamlAccount {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *SamlAccountUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SamlAccountUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *SamlAccountUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := samlaccount.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *SamlAccountUpdateOne) check() error {
+	if v, ok := _u.mutation.NameID(); ok {
+		if err := samlaccount.NameIDValidator(v); err != nil {
+			return &ValidationError{Name: "name_id", err: fmt.Errorf(`ent: validator failed for field "SamlAccount.name_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.NameIDFormat(); ok {
+		if err := samlaccount.NameIDFormatValidator(v); err != nil {
+			return &ValidationError{Name: "name_id_format", err: fmt.Errorf(`ent: validator failed for field "SamlAccount.name_id_format": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.SessionIndex(); ok {
+		if err := samlaccount.SessionIndexValidator(v); err != nil {
+			return &ValidationError{Name: "session_index", err: fmt.Errorf(`ent: validator failed for field "SamlAccount.session_index": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Email(); ok {
+		if err := samlaccount.EmailValidator(v); err != nil {
+			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "SamlAccount.email": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.LastLoginIP(); ok {
+		if err := samlaccount.LastLoginIPValidator(v); err != nil {
+			return &ValidationError{Name: "last_login_ip", err: fmt.Errorf(`ent: validator failed for field "SamlAccount.last_login_ip": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "SamlAccount.user"`)
+	}
+	if _u.mutation.ProviderCleared() && len(_u.mutation.ProviderIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "SamlAccount.provider"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *SamlAccountUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *SamlAccountUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *SamlAccountUpdateOne) sqlSave(ctx context.Context) (_node *SamlAccount, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(samlaccount.Table, samlaccount.Columns, sqlgraph.NewFieldSpec(samlaccount.FieldID, field.TypeUint64))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SamlAccount.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, samlaccount.FieldID)
+		for _, f := range fields {
+			if !samlaccount.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != samlaccount.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(samlaccount.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(samlaccount.FieldStatus, field.TypeUint8, value)
+	}
+	if value, ok := _u.mutation.AddedStatus(); ok {
+		_spec.AddField(samlaccount.FieldStatus, field.TypeUint8, value)
+	}
+	if _u.mutation.StatusCleared() {
+		_spec.ClearField(samlaccount.FieldStatus, field.TypeUint8)
+	}
+	if value, ok := _u.mutation.NameID(); ok {
+		_spec.SetField(samlaccount.FieldNameID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.NameIDFormat(); ok {
+		_spec.SetField(samlaccount.FieldNameIDFormat, field.TypeString, value)
+	}
+	if _u.mutation.NameIDFormatCleared() {
+		_spec.ClearField(samlaccount.FieldNameIDFormat, field.TypeString)
+	}
+	if value, ok := _u.mutation.SessionIndex(); ok {
+		_spec.SetField(samlaccount.FieldSessionIndex, field.TypeString, value)
+	}
+	if _u.mutation.SessionIndexCleared() {
+		_spec.ClearField(samlaccount.FieldSessionIndex, field.TypeString)
+	}
+	if value, ok := _u.mutation.Email(); ok {
+		_spec.SetField(samlaccount.FieldEmail, field.TypeString, value)
+	}
+	if _u.mutation.EmailCleared() {
+		_spec.ClearField(samlaccount.FieldEmail, field.TypeString)
+	}
+	if value, ok := _u.mutation.Attributes(); ok {
+		_spec.SetField(samlaccount.FieldAttributes, field.TypeJSON, value)
+	}
+	if _u.mutation.AttributesCleared() {
+		_spec.ClearField(samlaccount.FieldAttributes, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.LastLoginAt(); ok {
+		_spec.SetField(samlaccount.FieldLastLoginAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastLoginAtCleared() {
+		_spec.ClearField(samlaccount.FieldLastLoginAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastLoginIP(); ok {
+		_spec.SetField(samlaccount.FieldLastLoginIP, field.TypeString, value)
+	}
+	if _u.mutation.LastLoginIPCleared() {
+		_spec.ClearField(samlaccount.FieldLastLoginIP, field.TypeString)
+	}
+	if value, ok := _u.mutation.LoginCount(); ok {
+		_spec.SetField(samlaccount.FieldLoginCount, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedLoginCount(); ok {
+		_spec.AddField(samlaccount.FieldLoginCount, field.TypeUint32, value)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   samlaccount.UserTable,
+			Columns: []string{samlaccount.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   samlaccount.UserTable,
+			Columns: []string{samlaccount.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ProviderCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   samlaccount.ProviderTable,
+			Columns: []string{samlaccount.ProviderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(samlprovider.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ProviderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   samlaccount.ProviderTable,
+			Columns: []string{samlaccount.ProviderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(samlprovider.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &SamlAccount{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{samlaccount.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,405 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/coder-lulu/newbee-core/rpc/ent/samlprovider"
+)
+
+// SAML Identity Provider Table | SAML身份源配置表
+type SamlProvider struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uint64 `json:"id,omitempty"`
+	// Create Time | 创建日期
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Update Time | 修改日期
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Status 1: normal 2: ban | 状态 1 正常 2 禁用
+	Status uint8 `json:"status,omitempty"`
+	// Tenant ID | 租户 ID
+	TenantID uint64 `json:"tenant_id,omitempty"`
+	// The provider's name | 身份源名称
+	Name string `json:"name,omitempty"`
+	// Display name for UI | UI显示名称
+	DisplayName string `json:"display_name,omitempty"`
+	// IdP entity ID | IdP实体ID
+	IdpEntityID string `json:"idp_entity_id,omitempty"`
+	// IdP single sign-on URL (HTTP-Redirect binding) | IdP单点登录地址
+	IdpSSOURL string `json:"idp_sso_url,omitempty"`
+	// IdP single logout URL | IdP单点登出地址
+	IdpSloURL string `json:"idp_slo_url,omitempty"`
+	// IdP signing certificates in PEM | IdP签名证书(PEM)
+	IdpCertificates string `json:"idp_certificates,omitempty"`
+	// IdP metadata URL | IdP元数据地址
+	IdpMetadataURL string `json:"idp_metadata_url,omitempty"`
+	// SP entity ID | SP实体ID
+	SpEntityID string `json:"sp_entity_id,omitempty"`
+	// Assertion consumer service URL | 断言消费地址
+	AcsURL string `json:"acs_url,omitempty"`
+	// Requested NameID format | 请求的NameID格式
+	NameIDFormat string `json:"name_id_format,omitempty"`
+	// Whether to sign AuthnRequests | 是否签名认证请求
+	SignAuthnRequest bool `json:"sign_authn_request,omitempty"`
+	// Whether assertions must be signed | 是否要求断言签名
+	WantAssertionsSigned bool `json:"want_assertions_signed,omitempty"`
+	// Attribute mapping to users, departments and roles | 属性到用户、部门和角色的映射
+	AttributeMapping map[string]interface{} `json:"attribute_mapping,omitempty"`
+	// Whether to create users on first login | 是否在首次登录时自动创建用户
+	JitProvision bool `json:"jit_provision,omitempty"`
+	// Whether to update department and roles on every login | 是否在每次登录时同步部门和角色
+	SyncAttributes bool `json:"sync_attributes,omitempty"`
+	// Whether to link the first login to an existing user with the same email | 首次登录时是否按邮箱关联已有用户
+	LinkByEmail bool `json:"link_by_email,omitempty"`
+	// Department of provisioned users without a mapped department | 自动创建用户的默认部门
+	DefaultDepartmentID uint64 `json:"default_department_id,omitempty"`
+	// Roles granted to provisioned users | 自动创建用户的默认角色
+	DefaultRoleIds []uint64 `json:"default_role_ids,omitempty"`
+	// Whether the provider is enabled | 是否启用
+	Enabled bool `json:"enabled,omitempty"`
+	// Sort order | 排序
+	Sort uint32 `json:"sort,omitempty"`
+	// Remark | 备注
+	Remark string `json:"remark,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the SamlProviderQuery when eager-loading is set.
+	Edges        SamlProviderEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// SamlProviderEdges holds the relations/edges for other nodes in the graph.
+type SamlProviderEdges struct {
+	// SamlAccounts holds the value of the saml_accounts edge.
+	SamlAccounts []*SamlAccount `json:"saml_accounts,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// SamlAccountsOrErr returns the SamlAccounts value or an error if the edge
+// was not loaded in eager-loading.
+func (e SamlProviderEdges) SamlAccountsOrErr() ([]*SamlAccount, error) {
+	if e.loadedTypes[0] {
+		return e.SamlAccounts, nil
+	}
+	return nil, &NotLoadedError{edge: "saml_accounts"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SamlProvider) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case samlprovider.FieldAttributeMapping, samlprovider.FieldDefaultRoleIds:
+			values[i] = new([]byte)
+		case samlprovider.FieldSignAuthnRequest, samlprovider.FieldWantAssertionsSigned, samlprovider.FieldJitProvision, samlprovider.FieldSyncAttributes, samlprovider.FieldLinkByEmail, samlprovider.FieldEnabled:
+			values[i] = new(sql.NullBool)
+		case samlprovider.FieldID, samlprovider.FieldStatus, samlprovider.FieldTenantID, samlprovider.FieldDefaultDepartmentID, samlprovider.FieldSort:
+			values[i] = new(sql.NullInt64)
+		case samlprovider.FieldName, samlprovider.FieldDisplayName, samlprovider.FieldIdpEntityID, samlprovider.FieldIdpSSOURL, samlprovider.FieldIdpSloURL, samlprovider.FieldIdpCertificates, samlprovider.FieldIdpMetadataURL, samlprovider.FieldSpEntityID, samlprovider.FieldAcsURL, samlprovider.FieldNameIDFormat, samlprovider.FieldRemark:
+			values[i] = new(sql.NullString)
+		case samlprovider.FieldCreatedAt, samlprovider.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SamlProvider fields.
+func (_m *SamlProvider) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case samlprovider.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = uint64(value.Int64)
+		case samlprovider.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case samlprovider.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case samlprovider.FieldStatus:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = uint8(value.Int64)
+			}
+		case samlprovider.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				_m.TenantID = uint64(value.Int64)
+			}
+		case samlprovider.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case samlprovider.FieldDisplayName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field display_name", values[i])
+			} else if value.Valid {
+				_m.DisplayName = value.String
+			}
+		case samlprovider.FieldIdpEntityID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field idp_entity_id", values[i])
+			} else if value.Valid {
+				_m.IdpEntityID = value.String
+			}
+		case samlprovider.FieldIdpSSOURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field idp_sso_url", values[i])
+			} else if value.Valid {
+				_m.IdpSSOURL = value.String
+			}
+		case samlprovider.FieldIdpSloURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field idp_slo_url", values[i])
+			} else if value.Valid {
+				_m.IdpSloURL = value.String
+			}
+		case samlprovider.FieldIdpCertificates:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field idp_certificates", values[i])
+			} else if value.Valid {
+				_m.IdpCertificates = value.String
+			}
+		case samlprovider.FieldIdpMetadataURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field idp_metadata_url", values[i])
+			} else if value.Valid {
+				_m.IdpMetadataURL = value.String
+			}
+		case samlprovider.FieldSpEntityID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field sp_entity_id", values[i])
+			} else if value.Valid {
+				_m.SpEntityID = value.String
+			}
+		case samlprovider.FieldAcsURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field acs_url", values[i])
+			} else if value.Valid {
+				_m.AcsURL = value.String
+			}
+		case samlprovider.FieldNameIDFormat:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name_id_format", values[i])
+			} else if value.Valid {
+				_m.NameIDFormat = value.String
+			}
+		case samlprovider.FieldSignAuthnRequest:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field sign_authn_request", values[i])
+			} else if value.Valid {
+				_m.SignAuthnRequest = value.Bool
+			}
+		case samlprovider.FieldWantAssertionsSigned:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field want_assertions_signed", values[i])
+			} else if value.Valid {
+				_m.WantAssertionsSigned = value.Bool
+			}
+		case samlprovider.FieldAttributeMapping:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field attribute_mapping", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.AttributeMapping); err != nil {
+					return fmt.Errorf("unmarshal field attribute_mapping: %w", err)
+				}
+			}
+		case samlprovider.FieldJitProvision:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field jit_provision", values[i])
+			} else if value.Valid {
+				_m.JitProvision = value.Bool
+			}
+		case samlprovider.FieldSyncAttributes:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field sync_attributes", values[i])
+			} else if value.Valid {
+				_m.SyncAttributes = value.Bool
+			}
+		case samlprovider.FieldLinkByEmail:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field link_by_email", values[i])
+			} else if value.Valid {
+				_m.LinkByEmail = value.Bool
+			}
+		case samlprovider.FieldDefaultDepartmentID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field default_department_id", values[i])
+			} else if value.Valid {
+				_m.DefaultDepartmentID = uint64(value.Int64)
+			}
+		case samlprovider.FieldDefaultRoleIds:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field default_role_ids", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.DefaultRoleIds); err != nil {
+					return fmt.Errorf("unmarshal field default_role_ids: %w", err)
+				}
+			}
+		case samlprovider.FieldEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field enabled", values[i])
+			} else if value.Valid {
+				_m.Enabled = value.Bool
+			}
+		case samlprovider.FieldSort:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field sort", values[i])
+			} else if value.Valid {
+				_m.Sort = uint32(value.Int64)
+			}
+		case samlprovider.FieldRemark:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field remark", values[i])
+			} else if value.Valid {
+				_m.Remark = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the SamlProvider.
+// This includes values selected through modifiers, order, etc.
+func (_m *SamlProvider) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QuerySamlAccounts queries the "saml_accounts" edge of the SamlProvider entity.
+func (_m *SamlProvider) QuerySamlAccounts() *SamlAccountQuery {
+	return NewSamlProviderClient(_m.config).QuerySamlAccounts(_m)
+}
+
+// Update returns a builder for updating this SamlProvider.
+// Note that you need to call SamlProvider.Unwrap() before calling this method if this SamlProvider
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *SamlProvider) Update() *SamlProviderUpdateOne {
+	return NewSamlProviderClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the SamlProvider entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *SamlProvider) Unwrap() *SamlProvider {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: SamlProvider is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *SamlProvider) String() string {
+	var builder strings.Builder
+	builder.WriteString("SamlProvider(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("display_name=")
+	builder.WriteString(_m.DisplayName)
+	builder.WriteString(", ")
+	builder.WriteString("idp_entity_id=")
+	builder.WriteString(_m.IdpEntityID)
+	builder.WriteString(", ")
+	builder.WriteString("idp_sso_url=")
+	builder.WriteString(_m.IdpSSOURL)
+	builder.WriteString(", ")
+	builder.WriteString("idp_slo_url=")
+	builder.WriteString(_m.IdpSloURL)
+	builder.WriteString(", ")
+	builder.WriteString("idp_certificates=")
+	builder.WriteString(_m.IdpCertificates)
+	builder.WriteString(", ")
+	builder.WriteString("idp_metadata_url=")
+	builder.WriteString(_m.IdpMetadataURL)
+	builder.WriteString(", ")
+	builder.WriteString("sp_entity_id=")
+	builder.WriteString(_m.SpEntityID)
+	builder.WriteString(", ")
+	builder.WriteString("acs_url=")
+	builder.WriteString(_m.AcsURL)
+	builder.WriteString(", ")
+	builder.WriteString("name_id_format=")
+	builder.WriteString(_m.NameIDFormat)
+	builder.WriteString(", ")
+	builder.WriteString("sign_authn_request=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SignAuthnRequest))
+	builder.WriteString(", ")
+	builder.WriteString("want_assertions_signed=")
+	builder.WriteString(fmt.Sprintf("%v", _m.WantAssertionsSigned))
+	builder.WriteString(", ")
+	builder.WriteString("attribute_mapping=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AttributeMapping))
+	builder.WriteString(", ")
+	builder.WriteString("jit_provision=")
+	builder.WriteString(fmt.Sprintf("%v", _m.JitProvision))
+	builder.WriteString(", ")
+	builder.WriteString("sync_attributes=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SyncAttributes))
+	builder.WriteString(", ")
+	builder.WriteString("link_by_email=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LinkByEmail))
+	builder.WriteString(", ")
+	builder.WriteString("default_department_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DefaultDepartmentID))
+	builder.WriteString(", ")
+	builder.WriteString("default_role_ids=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DefaultRoleIds))
+	builder.WriteString(", ")
+	builder.WriteString("enabled=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Enabled))
+	builder.WriteString(", ")
+	builder.WriteString("sort=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Sort))
+	builder.WriteString(", ")
+	builder.WriteString("remark=")
+	builder.WriteString(_m.Remark)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SamlProviders is a parsable slice of SamlProvider.
+type SamlProviders []*SamlProvider
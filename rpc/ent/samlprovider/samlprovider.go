@@ -0,0 +1,311 @@
+// Code generated by ent, DO NOT EDIT.
+
+package samlprovider
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the samlprovider type in the database.
+	Label = "saml_provider"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldDisplayName holds the string denoting the display_name field in the database.
+	FieldDisplayName = "display_name"
+	// FieldIdpEntityID holds the string denoting the idp_entity_id field in the database.
+	FieldIdpEntityID = "idp_entity_id"
+	// FieldIdpSSOURL holds the string denoting the idp_sso_url field in the database.
+	FieldIdpSSOURL = "idp_sso_url"
+	// FieldIdpSloURL holds the string denoting the idp_slo_url field in the database.
+	FieldIdpSloURL = "idp_slo_url"
+	// FieldIdpCertificates holds the string denoting the idp_certificates field in the database.
+	FieldIdpCertificates = "idp_certificates"
+	// FieldIdpMetadataURL holds the string denoting the idp_metadata_url field in the database.
+	FieldIdpMetadataURL = "idp_metadata_url"
+	// FieldSpEntityID holds the string denoting the sp_entity_id field in the database.
+	FieldSpEntityID = "sp_entity_id"
+	// FieldAcsURL holds the string denoting the acs_url field in the database.
+	FieldAcsURL = "acs_url"
+	// FieldNameIDFormat holds the string denoting the name_id_format field in the database.
+	FieldNameIDFormat = "name_id_format"
+	// FieldSignAuthnRequest holds the string denoting the sign_authn_request field in the database.
+	FieldSignAuthnRequest = "sign_authn_request"
+	// FieldWantAssertionsSigned holds the string denoting the want_assertions_signed field in the database.
+	FieldWantAssertionsSigned = "want_assertions_signed"
+	// FieldAttributeMapping holds the string denoting the attribute_mapping field in the database.
+	FieldAttributeMapping = "attribute_mapping"
+	// FieldJitProvision holds the string denoting the jit_provision field in the database.
+	FieldJitProvision = "jit_provision"
+	// FieldSyncAttributes holds the string denoting the sync_attributes field in the database.
+	FieldSyncAttributes = "sync_attributes"
+	// FieldLinkByEmail holds the string denoting the link_by_email field in the database.
+	FieldLinkByEmail = "link_by_email"
+	// FieldDefaultDepartmentID holds the string denoting the default_department_id field in the database.
+	FieldDefaultDepartmentID = "default_department_id"
+	// FieldDefaultRoleIds holds the string denoting the default_role_ids field in the database.
+	FieldDefaultRoleIds = "default_role_ids"
+	// FieldEnabled holds the string denoting the enabled field in the database.
+	FieldEnabled = "enabled"
+	// FieldSort holds the string denoting the sort field in the database.
+	FieldSort = "sort"
+	// FieldRemark holds the string denoting the remark field in the database.
+	FieldRemark = "remark"
+	// EdgeSamlAccounts holds the string denoting the saml_accounts edge name in mutations.
+	EdgeSamlAccounts = "saml_accounts"
+	// Table holds the table name of the samlprovider in the database.
+	Table = "sys_saml_providers"
+	// SamlAccountsTable is the table that holds the saml_accounts relation/edge.
+	SamlAccountsTable = "sys_saml_accounts"
+	// SamlAccountsInverseTable is the table name for the SamlAccount entity.
+	// It exists in this package in order to avoid circular dependency with the "samlaccount" package.
+	SamlAccountsInverseTable = "sys_saml_accounts"
+	// SamlAccountsColumn is the table column denoting the saml_accounts relation/edge.
+	SamlAccountsColumn = "provider_id"
+)
+
+// Columns holds all SQL columns for samlprovider fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldStatus,
+	FieldTenantID,
+	FieldName,
+	FieldDisplayName,
+	FieldIdpEntityID,
+	FieldIdpSSOURL,
+	FieldIdpSloURL,
+	FieldIdpCertificates,
+	FieldIdpMetadataURL,
+	FieldSpEntityID,
+	FieldAcsURL,
+	FieldNameIDFormat,
+	FieldSignAuthnRequest,
+	FieldWantAssertionsSigned,
+	FieldAttributeMapping,
+	FieldJitProvision,
+	FieldSyncAttributes,
+	FieldLinkByEmail,
+	FieldDefaultDepartmentID,
+	FieldDefaultRoleIds,
+	FieldEnabled,
+	FieldSort,
+	FieldRemark,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultStatus holds the default value on creation for the "status" field.
+	DefaultStatus uint8
+	// DefaultTenantID holds the default value on creation for the "tenant_id" field.
+	DefaultTenantID uint64
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+	// DisplayNameValidator is a validator for the "display_name" field. It is called by the builders before save.
+	DisplayNameValidator func(string) error
+	// IdpEntityIDValidator is a validator for the "idp_entity_id" field. It is called by the builders before save.
+	IdpEntityIDValidator func(string) error
+	// IdpSSOURLValidator is a validator for the "idp_sso_url" field. It is called by the builders before save.
+	IdpSSOURLValidator func(string) error
+	// IdpSloURLValidator is a validator for the "idp_slo_url" field. It is called by the builders before save.
+	IdpSloURLValidator func(string) error
+	// IdpMetadataURLValidator is a validator for the "idp_metadata_url" field. It is called by the builders before save.
+	IdpMetadataURLValidator func(string) error
+	// SpEntityIDValidator is a validator for the "sp_entity_id" field. It is called by the builders before save.
+	SpEntityIDValidator func(string) error
+	// AcsURLValidator is a validator for the "acs_url" field. It is called by the builders before save.
+	AcsURLValidator func(string) error
+	// NameIDFormatValidator is a validator for the "name_id_format" field. It is called by the builders before save.
+	NameIDFormatValidator func(string) error
+	// DefaultSignAuthnRequest holds the default value on creation for the "sign_authn_request" field.
+	DefaultSignAuthnRequest bool
+	// DefaultWantAssertionsSigned holds the default value on creation for the "want_assertions_signed" field.
+	DefaultWantAssertionsSigned bool
+	// DefaultJitProvision holds the default value on creation for the "jit_provision" field.
+	DefaultJitProvision bool
+	// DefaultSyncAttributes holds the default value on creation for the "sync_attributes" field.
+	DefaultSyncAttributes bool
+	// DefaultLinkByEmail holds the default value on creation for the "link_by_email" field.
+	DefaultLinkByEmail bool
+	// DefaultDefaultDepartmentID holds the default value on creation for the "default_department_id" field.
+	DefaultDefaultDepartmentID uint64
+	// DefaultEnabled holds the default value on creation for the "enabled" field.
+	DefaultEnabled bool
+	// DefaultSort holds the default value on creation for the "sort" field.
+	DefaultSort uint32
+	// RemarkValidator is a validator for the "remark" field. It is called by the builders before save.
+	RemarkValidator func(string) error
+)
+
+// OrderOption defines the ordering options for the SamlProvider queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByTenantID orders the results by the tenant_id field.
+func ByTenantID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenantID, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByDisplayName orders the results by the display_name field.
+func ByDisplayName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDisplayName, opts...).ToFunc()
+}
+
+// ByIdpEntityID orders the results by the idp_entity_id field.
+func ByIdpEntityID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIdpEntityID, opts...).ToFunc()
+}
+
+// ByIdpSSOURL orders the results by the idp_sso_url field.
+func ByIdpSSOURL(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIdpSSOURL, opts...).ToFunc()
+}
+
+// ByIdpSloURL orders the results by the idp_slo_url field.
+func ByIdpSloURL(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIdpSloURL, opts...).ToFunc()
+}
+
+// ByIdpCertificates orders the results by the idp_certificates field.
+func ByIdpCertificates(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIdpCertificates, opts...).ToFunc()
+}
+
+// ByIdpMetadataURL orders the results by the idp_metadata_url field.
+func ByIdpMetadataURL(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIdpMetadataURL, opts...).ToFunc()
+}
+
+// BySpEntityID orders the results by the sp_entity_id field.
+func BySpEntityID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSpEntityID, opts...).ToFunc()
+}
+
+// ByAcsURL orders the results by the acs_url field.
+func ByAcsURL(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAcsURL, opts...).ToFunc()
+}
+
+// ByNameIDFormat orders the results by the name_id_format field.
+func ByNameIDFormat(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNameIDFormat, opts...).ToFunc()
+}
+
+// BySignAuthnRequest orders the results by the sign_authn_request field.
+func BySignAuthnRequest(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSignAuthnRequest, opts...).ToFunc()
+}
+
+// ByWantAssertionsSigned orders the results by the want_assertions_signed field.
+func ByWantAssertionsSigned(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWantAssertionsSigned, opts...).ToFunc()
+}
+
+// ByJitProvision orders the results by the jit_provision field.
+func ByJitProvision(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldJitProvision, opts...).ToFunc()
+}
+
+// BySyncAttributes orders the results by the sync_attributes field.
+func BySyncAttributes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSyncAttributes, opts...).ToFunc()
+}
+
+// ByLinkByEmail orders the results by the link_by_email field.
+func ByLinkByEmail(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLinkByEmail, opts...).ToFunc()
+}
+
+// ByDefaultDepartmentID orders the results by the default_department_id field.
+func ByDefaultDepartmentID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDefaultDepartmentID, opts...).ToFunc()
+}
+
+// ByEnabled orders the results by the enabled field.
+func ByEnabled(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEnabled, opts...).ToFunc()
+}
+
+// BySort orders the results by the sort field.
+func BySort(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSort, opts...).ToFunc()
+}
+
+// ByRemark orders the results by the remark field.
+func ByRemark(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRemark, opts...).ToFunc()
+}
+
+// BySamlAccountsCount orders the results by saml_accounts count.
+func BySamlAccountsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSamlAccountsStep(), opts...)
+	}
+}
+
+// BySamlAccounts orders the results by saml_accounts terms.
+func BySamlAccounts(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSamlAccountsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newSamlAccountsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SamlAccountsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, SamlAccountsTable, SamlAccountsColumn),
+	)
+}
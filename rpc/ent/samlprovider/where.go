@@ -0,0 +1,1334 @@
+// Code generated by ent, DO NOT EDIT.
+
+package samlprovider
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/coder-lulu/newbee-core/rpc/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uint64) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uint64) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uint64) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uint64) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uint64) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uint64) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uint64) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uint64) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uint64) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v uint8) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldEQ(FieldStatus, v))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v uint64) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldEQ(FieldTenantID, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldEQ(FieldName, v))
+}
+
+// DisplayName applies equality check predicate on the "display_name" field. It's identical to DisplayNameEQ.
+func DisplayName(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldEQ(FieldDisplayName, v))
+}
+
+// IdpEntityID applies equality check predicate on the "idp_entity_id" field. It's identical to IdpEntityIDEQ.
+func IdpEntityID(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldEQ(FieldIdpEntityID, v))
+}
+
+// IdpSSOURL applies equality check predicate on the "idp_sso_url" field. It's identical to IdpSSOURLEQ.
+func IdpSSOURL(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldEQ(FieldIdpSSOURL, v))
+}
+
+// IdpSloURL applies equality check predicate on the "idp_slo_url" field. It's identical to IdpSloURLEQ.
+func IdpSloURL(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldEQ(FieldIdpSloURL, v))
+}
+
+// IdpCertificates applies equality check predicate on the "idp_certificates" field. It's identical to IdpCertificatesEQ.
+func IdpCertificates(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldEQ(FieldIdpCertificates, v))
+}
+
+// IdpMetadataURL applies equality check predicate on the "idp_metadata_url" field. It's identical to IdpMetadataURLEQ.
+func IdpMetadataURL(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldEQ(FieldIdpMetadataURL, v))
+}
+
+// SpEntityID applies equality check predicate on the "sp_entity_id" field. It's identical to SpEntityIDEQ.
+func SpEntityID(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldEQ(FieldSpEntityID, v))
+}
+
+// AcsURL applies equality check predicate on the "acs_url" field. It's identical to AcsURLEQ.
+func AcsURL(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldEQ(FieldAcsURL, v))
+}
+
+// NameIDFormat applies equality check predicate on the "name_id_format" field. It's identical to NameIDFormatEQ.
+func NameIDFormat(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldEQ(FieldNameIDFormat, v))
+}
+
+// SignAuthnRequest applies equality check predicate on the "sign_authn_request" field. It's identical to SignAuthnRequestEQ.
+func SignAuthnRequest(v bool) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldEQ(FieldSignAuthnRequest, v))
+}
+
+// WantAssertionsSigned applies equality check predicate on the "want_assertions_signed" field. It's identical to WantAssertionsSignedEQ.
+func WantAssertionsSigned(v bool) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldEQ(FieldWantAssertionsSigned, v))
+}
+
+// JitProvision applies equality check predicate on the "jit_provision" field. It's identical to JitProvisionEQ.
+func JitProvision(v bool) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldEQ(FieldJitProvision, v))
+}
+
+// SyncAttributes applies equality check predicate on the "sync_attributes" field. It's identical to SyncAttributesEQ.
+func SyncAttributes(v bool) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldEQ(FieldSyncAttributes, v))
+}
+
+// LinkByEmail applies equality check predicate on the "link_by_email" field. It's identical to LinkByEmailEQ.
+func LinkByEmail(v bool) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldEQ(FieldLinkByEmail, v))
+}
+
+// DefaultDepartmentID applies equality check predicate on the "default_department_id" field. It's identical to DefaultDepartmentIDEQ.
+func DefaultDepartmentID(v uint64) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldEQ(FieldDefaultDepartmentID, v))
+}
+
+// Enabled applies equality check predicate on the "enabled" field. It's identical to EnabledEQ.
+func Enabled(v bool) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldEQ(FieldEnabled, v))
+}
+
+// Sort applies equality check predicate on the "sort" field. It's identical to SortEQ.
+func Sort(v uint32) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldEQ(FieldSort, v))
+}
+
+// Remark applies equality check predicate on the "remark" field. It's identical to RemarkEQ.
+func Remark(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldEQ(FieldRemark, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v uint8) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v uint8) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...uint8) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...uint8) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v uint8) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v uint8) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v uint8) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v uint8) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusIsNil applies the IsNil predicate on the "status" field.
+func StatusIsNil() predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldIsNull(FieldStatus))
+}
+
+// StatusNotNil applies the NotNil predicate on the "status" field.
+func StatusNotNil() predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldNotNull(FieldStatus))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v uint64) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v uint64) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...uint64) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...uint64) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v uint64) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v uint64) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v uint64) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v uint64) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldLTE(FieldTenantID, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldContainsFold(FieldName, v))
+}
+
+// DisplayNameEQ applies the EQ predicate on the "display_name" field.
+func DisplayNameEQ(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldEQ(FieldDisplayName, v))
+}
+
+// DisplayNameNEQ applies the NEQ predicate on the "display_name" field.
+func DisplayNameNEQ(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldNEQ(FieldDisplayName, v))
+}
+
+// DisplayNameIn applies the In predicate on the "display_name" field.
+func DisplayNameIn(vs ...string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldIn(FieldDisplayName, vs...))
+}
+
+// DisplayNameNotIn applies the NotIn predicate on the "display_name" field.
+func DisplayNameNotIn(vs ...string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldNotIn(FieldDisplayName, vs...))
+}
+
+// DisplayNameGT applies the GT predicate on the "display_name" field.
+func DisplayNameGT(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldGT(FieldDisplayName, v))
+}
+
+// DisplayNameGTE applies the GTE predicate on the "display_name" field.
+func DisplayNameGTE(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldGTE(FieldDisplayName, v))
+}
+
+// DisplayNameLT applies the LT predicate on the "display_name" field.
+func DisplayNameLT(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldLT(FieldDisplayName, v))
+}
+
+// DisplayNameLTE applies the LTE predicate on the "display_name" field.
+func DisplayNameLTE(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldLTE(FieldDisplayName, v))
+}
+
+// DisplayNameContains applies the Contains predicate on the "display_name" field.
+func DisplayNameContains(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldContains(FieldDisplayName, v))
+}
+
+// DisplayNameHasPrefix applies the HasPrefix predicate on the "display_name" field.
+func DisplayNameHasPrefix(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldHasPrefix(FieldDisplayName, v))
+}
+
+// DisplayNameHasSuffix applies the HasSuffix predicate on the "display_name" field.
+func DisplayNameHasSuffix(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldHasSuffix(FieldDisplayName, v))
+}
+
+// DisplayNameIsNil applies the IsNil predicate on the "display_name" field.
+func DisplayNameIsNil() predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldIsNull(FieldDisplayName))
+}
+
+// DisplayNameNotNil applies the NotNil predicate on the "display_name" field.
+func DisplayNameNotNil() predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldNotNull(FieldDisplayName))
+}
+
+// DisplayNameEqualFold applies the EqualFold predicate on the "display_name" field.
+func DisplayNameEqualFold(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldEqualFold(FieldDisplayName, v))
+}
+
+// DisplayNameContainsFold applies the ContainsFold predicate on the "display_name" field.
+func DisplayNameContainsFold(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldContainsFold(FieldDisplayName, v))
+}
+
+// IdpEntityIDEQ applies the EQ predicate on the "idp_entity_id" field.
+func IdpEntityIDEQ(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldEQ(FieldIdpEntityID, v))
+}
+
+// IdpEntityIDNEQ applies the NEQ predicate on the "idp_entity_id" field.
+func IdpEntityIDNEQ(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldNEQ(FieldIdpEntityID, v))
+}
+
+// IdpEntityIDIn applies the In predicate on the "idp_entity_id" field.
+func IdpEntityIDIn(vs ...string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldIn(FieldIdpEntityID, vs...))
+}
+
+// IdpEntityIDNotIn applies the NotIn predicate on the "idp_entity_id" field.
+func IdpEntityIDNotIn(vs ...string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldNotIn(FieldIdpEntityID, vs...))
+}
+
+// IdpEntityIDGT applies the GT predicate on the "idp_entity_id" field.
+func IdpEntityIDGT(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldGT(FieldIdpEntityID, v))
+}
+
+// IdpEntityIDGTE applies the GTE predicate on the "idp_entity_id" field.
+func IdpEntityIDGTE(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldGTE(FieldIdpEntityID, v))
+}
+
+// IdpEntityIDLT applies the LT predicate on the "idp_entity_id" field.
+func IdpEntityIDLT(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldLT(FieldIdpEntityID, v))
+}
+
+// IdpEntityIDLTE applies the LTE predicate on the "idp_entity_id" field.
+func IdpEntityIDLTE(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldLTE(FieldIdpEntityID, v))
+}
+
+// IdpEntityIDContains applies the Contains predicate on the "idp_entity_id" field.
+func IdpEntityIDContains(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldContains(FieldIdpEntityID, v))
+}
+
+// IdpEntityIDHasPrefix applies the HasPrefix predicate on the "idp_entity_id" field.
+func IdpEntityIDHasPrefix(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldHasPrefix(FieldIdpEntityID, v))
+}
+
+// IdpEntityIDHasSuffix applies the HasSuffix predicate on the "idp_entity_id" field.
+func IdpEntityIDHasSuffix(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldHasSuffix(FieldIdpEntityID, v))
+}
+
+// IdpEntityIDEqualFold applies the EqualFold predicate on the "idp_entity_id" field.
+func IdpEntityIDEqualFold(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldEqualFold(FieldIdpEntityID, v))
+}
+
+// IdpEntityIDContainsFold applies the ContainsFold predicate on the "idp_entity_id" field.
+func IdpEntityIDContainsFold(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldContainsFold(FieldIdpEntityID, v))
+}
+
+// IdpSSOURLEQ applies the EQ predicate on the "idp_sso_url" field.
+func IdpSSOURLEQ(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldEQ(FieldIdpSSOURL, v))
+}
+
+// IdpSSOURLNEQ applies the NEQ predicate on the "idp_sso_url" field.
+func IdpSSOURLNEQ(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldNEQ(FieldIdpSSOURL, v))
+}
+
+// IdpSSOURLIn applies the In predicate on the "idp_sso_url" field.
+func IdpSSOURLIn(vs ...string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldIn(FieldIdpSSOURL, vs...))
+}
+
+// IdpSSOURLNotIn applies the NotIn predicate on the "idp_sso_url" field.
+func IdpSSOURLNotIn(vs ...string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldNotIn(FieldIdpSSOURL, vs...))
+}
+
+// IdpSSOURLGT applies the GT predicate on the "idp_sso_url" field.
+func IdpSSOURLGT(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldGT(FieldIdpSSOURL, v))
+}
+
+// IdpSSOURLGTE applies the GTE predicate on the "idp_sso_url" field.
+func IdpSSOURLGTE(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldGTE(FieldIdpSSOURL, v))
+}
+
+// IdpSSOURLLT applies the LT predicate on the "idp_sso_url" field.
+func IdpSSOURLLT(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldLT(FieldIdpSSOURL, v))
+}
+
+// IdpSSOURLLTE applies the LTE predicate on the "idp_sso_url" field.
+func IdpSSOURLLTE(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldLTE(FieldIdpSSOURL, v))
+}
+
+// IdpSSOURLContains applies the Contains predicate on the "idp_sso_url" field.
+func IdpSSOURLContains(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldContains(FieldIdpSSOURL, v))
+}
+
+// IdpSSOURLHasPrefix applies the HasPrefix predicate on the "idp_sso_url" field.
+func IdpSSOURLHasPrefix(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldHasPrefix(FieldIdpSSOURL, v))
+}
+
+// IdpSSOURLHasSuffix applies the HasSuffix predicate on the "idp_sso_url" field.
+func IdpSSOURLHasSuffix(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldHasSuffix(FieldIdpSSOURL, v))
+}
+
+// IdpSSOURLEqualFold applies the EqualFold predicate on the "idp_sso_url" field.
+func IdpSSOURLEqualFold(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldEqualFold(FieldIdpSSOURL, v))
+}
+
+// IdpSSOURLContainsFold applies the ContainsFold predicate on the "idp_sso_url" field.
+func IdpSSOURLContainsFold(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldContainsFold(FieldIdpSSOURL, v))
+}
+
+// IdpSloURLEQ applies the EQ predicate on the "idp_slo_url" field.
+func IdpSloURLEQ(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldEQ(FieldIdpSloURL, v))
+}
+
+// IdpSloURLNEQ applies the NEQ predicate on the "idp_slo_url" field.
+func IdpSloURLNEQ(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldNEQ(FieldIdpSloURL, v))
+}
+
+// IdpSloURLIn applies the In predicate on the "idp_slo_url" field.
+func IdpSloURLIn(vs ...string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldIn(FieldIdpSloURL, vs...))
+}
+
+// IdpSloURLNotIn applies the NotIn predicate on the "idp_slo_url" field.
+func IdpSloURLNotIn(vs ...string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldNotIn(FieldIdpSloURL, vs...))
+}
+
+// IdpSloURLGT applies the GT predicate on the "idp_slo_url" field.
+func IdpSloURLGT(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldGT(FieldIdpSloURL, v))
+}
+
+// IdpSloURLGTE applies the GTE predicate on the "idp_slo_url" field.
+func IdpSloURLGTE(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldGTE(FieldIdpSloURL, v))
+}
+
+// IdpSloURLLT applies the LT predicate on the "idp_slo_url" field.
+func IdpSloURLLT(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldLT(FieldIdpSloURL, v))
+}
+
+// IdpSloURLLTE applies the LTE predicate on the "idp_slo_url" field.
+func IdpSloURLLTE(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldLTE(FieldIdpSloURL, v))
+}
+
+// IdpSloURLContains applies the Contains predicate on the "idp_slo_url" field.
+func IdpSloURLContains(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldContains(FieldIdpSloURL, v))
+}
+
+// IdpSloURLHasPrefix applies the HasPrefix predicate on the "idp_slo_url" field.
+func IdpSloURLHasPrefix(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldHasPrefix(FieldIdpSloURL, v))
+}
+
+// IdpSloURLHasSuffix applies the HasSuffix predicate on the "idp_slo_url" field.
+func IdpSloURLHasSuffix(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldHasSuffix(FieldIdpSloURL, v))
+}
+
+// IdpSloURLIsNil applies the IsNil predicate on the "idp_slo_url" field.
+func IdpSloURLIsNil() predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldIsNull(FieldIdpSloURL))
+}
+
+// IdpSloURLNotNil applies the NotNil predicate on the "idp_slo_url" field.
+func IdpSloURLNotNil() predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldNotNull(FieldIdpSloURL))
+}
+
+// IdpSloURLEqualFold applies the EqualFold predicate on the "idp_slo_url" field.
+func IdpSloURLEqualFold(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldEqualFold(FieldIdpSloURL, v))
+}
+
+// IdpSloURLContainsFold applies the ContainsFold predicate on the "idp_slo_url" field.
+func IdpSloURLContainsFold(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldContainsFold(FieldIdpSloURL, v))
+}
+
+// IdpCertificatesEQ applies the EQ predicate on the "idp_certificates" field.
+func IdpCertificatesEQ(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldEQ(FieldIdpCertificates, v))
+}
+
+// IdpCertificatesNEQ applies the NEQ predicate on the "idp_certificates" field.
+func IdpCertificatesNEQ(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldNEQ(FieldIdpCertificates, v))
+}
+
+// IdpCertificatesIn applies the In predicate on the "idp_certificates" field.
+func IdpCertificatesIn(vs ...string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldIn(FieldIdpCertificates, vs...))
+}
+
+// IdpCertificatesNotIn applies the NotIn predicate on the "idp_certificates" field.
+func IdpCertificatesNotIn(vs ...string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldNotIn(FieldIdpCertificates, vs...))
+}
+
+// IdpCertificatesGT applies the GT predicate on the "idp_certificates" field.
+func IdpCertificatesGT(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldGT(FieldIdpCertificates, v))
+}
+
+// IdpCertificatesGTE applies the GTE predicate on the "idp_certificates" field.
+func IdpCertificatesGTE(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldGTE(FieldIdpCertificates, v))
+}
+
+// IdpCertificatesLT applies the LT predicate on the "idp_certificates" field.
+func IdpCertificatesLT(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldLT(FieldIdpCertificates, v))
+}
+
+// IdpCertificatesLTE applies the LTE predicate on the "idp_certificates" field.
+func IdpCertificatesLTE(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldLTE(FieldIdpCertificates, v))
+}
+
+// IdpCertificatesContains applies the Contains predicate on the "idp_certificates" field.
+func IdpCertificatesContains(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldContains(FieldIdpCertificates, v))
+}
+
+// IdpCertificatesHasPrefix applies the HasPrefix predicate on the "idp_certificates" field.
+func IdpCertificatesHasPrefix(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldHasPrefix(FieldIdpCertificates, v))
+}
+
+// IdpCertificatesHasSuffix applies the HasSuffix predicate on the "idp_certificates" field.
+func IdpCertificatesHasSuffix(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldHasSuffix(FieldIdpCertificates, v))
+}
+
+// IdpCertificatesEqualFold applies the EqualFold predicate on the "idp_certificates" field.
+func IdpCertificatesEqualFold(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldEqualFold(FieldIdpCertificates, v))
+}
+
+// IdpCertificatesContainsFold applies the ContainsFold predicate on the "idp_certificates" field.
+func IdpCertificatesContainsFold(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldContainsFold(FieldIdpCertificates, v))
+}
+
+// IdpMetadataURLEQ applies the EQ predicate on the "idp_metadata_url" field.
+func IdpMetadataURLEQ(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldEQ(FieldIdpMetadataURL, v))
+}
+
+// IdpMetadataURLNEQ applies the NEQ predicate on the "idp_metadata_url" field.
+func IdpMetadataURLNEQ(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldNEQ(FieldIdpMetadataURL, v))
+}
+
+// IdpMetadataURLIn applies the In predicate on the "idp_metadata_url" field.
+func IdpMetadataURLIn(vs ...string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldIn(FieldIdpMetadataURL, vs...))
+}
+
+// IdpMetadataURLNotIn applies the NotIn predicate on the "idp_metadata_url" field.
+func IdpMetadataURLNotIn(vs ...string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldNotIn(FieldIdpMetadataURL, vs...))
+}
+
+// IdpMetadataURLGT applies the GT predicate on the "idp_metadata_url" field.
+func IdpMetadataURLGT(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldGT(FieldIdpMetadataURL, v))
+}
+
+// IdpMetadataURLGTE applies the GTE predicate on the "idp_metadata_url" field.
+func IdpMetadataURLGTE(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldGTE(FieldIdpMetadataURL, v))
+}
+
+// IdpMetadataURLLT applies the LT predicate on the "idp_metadata_url" field.
+func IdpMetadataURLLT(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldLT(FieldIdpMetadataURL, v))
+}
+
+// IdpMetadataURLLTE applies the LTE predicate on the "idp_metadata_url" field.
+func IdpMetadataURLLTE(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldLTE(FieldIdpMetadataURL, v))
+}
+
+// IdpMetadataURLContains applies the Contains predicate on the "idp_metadata_url" field.
+func IdpMetadataURLContains(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldContains(FieldIdpMetadataURL, v))
+}
+
+// IdpMetadataURLHasPrefix applies the HasPrefix predicate on the "idp_metadata_url" field.
+func IdpMetadataURLHasPrefix(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldHasPrefix(FieldIdpMetadataURL, v))
+}
+
+// IdpMetadataURLHasSuffix applies the HasSuffix predicate on the "idp_metadata_url" field.
+func IdpMetadataURLHasSuffix(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldHasSuffix(FieldIdpMetadataURL, v))
+}
+
+// IdpMetadataURLIsNil applies the IsNil predicate on the "idp_metadata_url" field.
+func IdpMetadataURLIsNil() predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldIsNull(FieldIdpMetadataURL))
+}
+
+// IdpMetadataURLNotNil applies the NotNil predicate on the "idp_metadata_url" field.
+func IdpMetadataURLNotNil() predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldNotNull(FieldIdpMetadataURL))
+}
+
+// IdpMetadataURLEqualFold applies the EqualFold predicate on the "idp_metadata_url" field.
+func IdpMetadataURLEqualFold(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldEqualFold(FieldIdpMetadataURL, v))
+}
+
+// IdpMetadataURLContainsFold applies the ContainsFold predicate on the "idp_metadata_url" field.
+func IdpMetadataURLContainsFold(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldContainsFold(FieldIdpMetadataURL, v))
+}
+
+// SpEntityIDEQ applies the EQ predicate on the "sp_entity_id" field.
+func SpEntityIDEQ(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldEQ(FieldSpEntityID, v))
+}
+
+// SpEntityIDNEQ applies the NEQ predicate on the "sp_entity_id" field.
+func SpEntityIDNEQ(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldNEQ(FieldSpEntityID, v))
+}
+
+// SpEntityIDIn applies the In predicate on the "sp_entity_id" field.
+func SpEntityIDIn(vs ...string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldIn(FieldSpEntityID, vs...))
+}
+
+// SpEntityIDNotIn applies the NotIn predicate on the "sp_entity_id" field.
+func SpEntityIDNotIn(vs ...string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldNotIn(FieldSpEntityID, vs...))
+}
+
+// SpEntityIDGT applies the GT predicate on the "sp_entity_id" field.
+func SpEntityIDGT(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldGT(FieldSpEntityID, v))
+}
+
+// SpEntityIDGTE applies the GTE predicate on the "sp_entity_id" field.
+func SpEntityIDGTE(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldGTE(FieldSpEntityID, v))
+}
+
+// SpEntityIDLT applies the LT predicate on the "sp_entity_id" field.
+func SpEntityIDLT(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldLT(FieldSpEntityID, v))
+}
+
+// SpEntityIDLTE applies the LTE predicate on the "sp_entity_id" field.
+func SpEntityIDLTE(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldLTE(FieldSpEntityID, v))
+}
+
+// SpEntityIDContains applies the Contains predicate on the "sp_entity_id" field.
+func SpEntityIDContains(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldContains(FieldSpEntityID, v))
+}
+
+// SpEntityIDHasPrefix applies the HasPrefix predicate on the "sp_entity_id" field.
+func SpEntityIDHasPrefix(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldHasPrefix(FieldSpEntityID, v))
+}
+
+// SpEntityIDHasSuffix applies the HasSuffix predicate on the "sp_entity_id" field.
+func SpEntityIDHasSuffix(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldHasSuffix(FieldSpEntityID, v))
+}
+
+// SpEntityIDIsNil applies the IsNil predicate on the "sp_entity_id" field.
+func SpEntityIDIsNil() predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldIsNull(FieldSpEntityID))
+}
+
+// SpEntityIDNotNil applies the NotNil predicate on the "sp_entity_id" field.
+func SpEntityIDNotNil() predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldNotNull(FieldSpEntityID))
+}
+
+// SpEntityIDEqualFold applies the EqualFold predicate on the "sp_entity_id" field.
+func SpEntityIDEqualFold(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldEqualFold(FieldSpEntityID, v))
+}
+
+// SpEntityIDContainsFold applies the ContainsFold predicate on the "sp_entity_id" field.
+func SpEntityIDContainsFold(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldContainsFold(FieldSpEntityID, v))
+}
+
+// AcsURLEQ applies the EQ predicate on the "acs_url" field.
+func AcsURLEQ(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldEQ(FieldAcsURL, v))
+}
+
+// AcsURLNEQ applies the NEQ predicate on the "acs_url" field.
+func AcsURLNEQ(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldNEQ(FieldAcsURL, v))
+}
+
+// AcsURLIn applies the In predicate on the "acs_url" field.
+func AcsURLIn(vs ...string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldIn(FieldAcsURL, vs...))
+}
+
+// AcsURLNotIn applies the NotIn predicate on the "acs_url" field.
+func AcsURLNotIn(vs ...string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldNotIn(FieldAcsURL, vs...))
+}
+
+// AcsURLGT applies the GT predicate on the "acs_url" field.
+func AcsURLGT(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldGT(FieldAcsURL, v))
+}
+
+// AcsURLGTE applies the GTE predicate on the "acs_url" field.
+func AcsURLGTE(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldGTE(FieldAcsURL, v))
+}
+
+// AcsURLLT applies the LT predicate on the "acs_url" field.
+func AcsURLLT(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldLT(FieldAcsURL, v))
+}
+
+// AcsURLLTE applies the LTE predicate on the "acs_url" field.
+func AcsURLLTE(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldLTE(FieldAcsURL, v))
+}
+
+// AcsURLContains applies the Contains predicate on the "acs_url" field.
+func AcsURLContains(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldContains(FieldAcsURL, v))
+}
+
+// AcsURLHasPrefix applies the HasPrefix predicate on the "acs_url" field.
+func AcsURLHasPrefix(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldHasPrefix(FieldAcsURL, v))
+}
+
+// AcsURLHasSuffix applies the HasSuffix predicate on the "acs_url" field.
+func AcsURLHasSuffix(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldHasSuffix(FieldAcsURL, v))
+}
+
+// AcsURLIsNil applies the IsNil predicate on the "acs_url" field.
+func AcsURLIsNil() predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldIsNull(FieldAcsURL))
+}
+
+// AcsURLNotNil applies the NotNil predicate on the "acs_url" field.
+func AcsURLNotNil() predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldNotNull(FieldAcsURL))
+}
+
+// AcsURLEqualFold applies the EqualFold predicate on the "acs_url" field.
+func AcsURLEqualFold(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldEqualFold(FieldAcsURL, v))
+}
+
+// AcsURLContainsFold applies the ContainsFold predicate on the "acs_url" field.
+func AcsURLContainsFold(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldContainsFold(FieldAcsURL, v))
+}
+
+// NameIDFormatEQ applies the EQ predicate on the "name_id_format" field.
+func NameIDFormatEQ(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldEQ(FieldNameIDFormat, v))
+}
+
+// NameIDFormatNEQ applies the NEQ predicate on the "name_id_format" field.
+func NameIDFormatNEQ(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldNEQ(FieldNameIDFormat, v))
+}
+
+// NameIDFormatIn applies the In predicate on the "name_id_format" field.
+func NameIDFormatIn(vs ...string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldIn(FieldNameIDFormat, vs...))
+}
+
+// NameIDFormatNotIn applies the NotIn predicate on the "name_id_format" field.
+func NameIDFormatNotIn(vs ...string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldNotIn(FieldNameIDFormat, vs...))
+}
+
+// NameIDFormatGT applies the GT predicate on the "name_id_format" field.
+func NameIDFormatGT(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldGT(FieldNameIDFormat, v))
+}
+
+// NameIDFormatGTE applies the GTE predicate on the "name_id_format" field.
+func NameIDFormatGTE(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldGTE(FieldNameIDFormat, v))
+}
+
+// NameIDFormatLT applies the LT predicate on the "name_id_format" field.
+func NameIDFormatLT(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldLT(FieldNameIDFormat, v))
+}
+
+// NameIDFormatLTE applies the LTE predicate on the "name_id_format" field.
+func NameIDFormatLTE(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldLTE(FieldNameIDFormat, v))
+}
+
+// NameIDFormatContains applies the Contains predicate on the "name_id_format" field.
+func NameIDFormatContains(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldContains(FieldNameIDFormat, v))
+}
+
+// NameIDFormatHasPrefix applies the HasPrefix predicate on the "name_id_format" field.
+func NameIDFormatHasPrefix(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldHasPrefix(FieldNameIDFormat, v))
+}
+
+// NameIDFormatHasSuffix applies the HasSuffix predicate on the "name_id_format" field.
+func NameIDFormatHasSuffix(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldHasSuffix(FieldNameIDFormat, v))
+}
+
+// NameIDFormatIsNil applies the IsNil predicate on the "name_id_format" field.
+func NameIDFormatIsNil() predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldIsNull(FieldNameIDFormat))
+}
+
+// NameIDFormatNotNil applies the NotNil predicate on the "name_id_format" field.
+func NameIDFormatNotNil() predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldNotNull(FieldNameIDFormat))
+}
+
+// NameIDFormatEqualFold applies the EqualFold predicate on the "name_id_format" field.
+func NameIDFormatEqualFold(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldEqualFold(FieldNameIDFormat, v))
+}
+
+// NameIDFormatContainsFold applies the ContainsFold predicate on the "name_id_format" field.
+func NameIDFormatContainsFold(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldContainsFold(FieldNameIDFormat, v))
+}
+
+// SignAuthnRequestEQ applies the EQ predicate on the "sign_authn_request" field.
+func SignAuthnRequestEQ(v bool) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldEQ(FieldSignAuthnRequest, v))
+}
+
+// SignAuthnRequestNEQ applies the NEQ predicate on the "sign_authn_request" field.
+func SignAuthnRequestNEQ(v bool) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldNEQ(FieldSignAuthnRequest, v))
+}
+
+// WantAssertionsSignedEQ applies the EQ predicate on the "want_assertions_signed" field.
+func WantAssertionsSignedEQ(v bool) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldEQ(FieldWantAssertionsSigned, v))
+}
+
+// WantAssertionsSignedNEQ applies the NEQ predicate on the "want_assertions_signed" field.
+func WantAssertionsSignedNEQ(v bool) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldNEQ(FieldWantAssertionsSigned, v))
+}
+
+// AttributeMappingIsNil applies the IsNil predicate on the "attribute_mapping" field.
+func AttributeMappingIsNil() predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldIsNull(FieldAttributeMapping))
+}
+
+// AttributeMappingNotNil applies the NotNil predicate on the "attribute_mapping" field.
+func AttributeMappingNotNil() predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldNotNull(FieldAttributeMapping))
+}
+
+// JitProvisionEQ applies the EQ predicate on the "jit_provision" field.
+func JitProvisionEQ(v bool) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldEQ(FieldJitProvision, v))
+}
+
+// JitProvisionNEQ applies the NEQ predicate on the "jit_provision" field.
+func JitProvisionNEQ(v bool) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldNEQ(FieldJitProvision, v))
+}
+
+// SyncAttributesEQ applies the EQ predicate on the "sync_attributes" field.
+func SyncAttributesEQ(v bool) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldEQ(FieldSyncAttributes, v))
+}
+
+// SyncAttributesNEQ applies the NEQ predicate on the "sync_attributes" field.
+func SyncAttributesNEQ(v bool) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldNEQ(FieldSyncAttributes, v))
+}
+
+// LinkByEmailEQ applies the EQ predicate on the "link_by_email" field.
+func LinkByEmailEQ(v bool) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldEQ(FieldLinkByEmail, v))
+}
+
+// LinkByEmailNEQ applies the NEQ predicate on the "link_by_email" field.
+func LinkByEmailNEQ(v bool) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldNEQ(FieldLinkByEmail, v))
+}
+
+// DefaultDepartmentIDEQ applies the EQ predicate on the "default_department_id" field.
+func DefaultDepartmentIDEQ(v uint64) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldEQ(FieldDefaultDepartmentID, v))
+}
+
+// DefaultDepartmentIDNEQ applies the NEQ predicate on the "default_department_id" field.
+func DefaultDepartmentIDNEQ(v uint64) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldNEQ(FieldDefaultDepartmentID, v))
+}
+
+// DefaultDepartmentIDIn applies the In predicate on the "default_department_id" field.
+func DefaultDepartmentIDIn(vs ...uint64) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldIn(FieldDefaultDepartmentID, vs...))
+}
+
+// DefaultDepartmentIDNotIn applies the NotIn predicate on the "default_department_id" field.
+func DefaultDepartmentIDNotIn(vs ...uint64) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldNotIn(FieldDefaultDepartmentID, vs...))
+}
+
+// DefaultDepartmentIDGT applies the GT predicate on the "default_department_id" field.
+func DefaultDepartmentIDGT(v uint64) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldGT(FieldDefaultDepartmentID, v))
+}
+
+// DefaultDepartmentIDGTE applies the GTE predicate on the "default_department_id" field.
+func DefaultDepartmentIDGTE(v uint64) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldGTE(FieldDefaultDepartmentID, v))
+}
+
+// DefaultDepartmentIDLT applies the LT predicate on the "default_department_id" field.
+func DefaultDepartmentIDLT(v uint64) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldLT(FieldDefaultDepartmentID, v))
+}
+
+// DefaultDepartmentIDLTE applies the LTE predicate on the "default_department_id" field.
+func DefaultDepartmentIDLTE(v uint64) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldLTE(FieldDefaultDepartmentID, v))
+}
+
+// DefaultDepartmentIDIsNil applies the IsNil predicate on the "default_department_id" field.
+func DefaultDepartmentIDIsNil() predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldIsNull(FieldDefaultDepartmentID))
+}
+
+// DefaultDepartmentIDNotNil applies the NotNil predicate on the "default_department_id" field.
+func DefaultDepartmentIDNotNil() predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldNotNull(FieldDefaultDepartmentID))
+}
+
+// DefaultRoleIdsIsNil applies the IsNil predicate on the "default_role_ids" field.
+func DefaultRoleIdsIsNil() predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldIsNull(FieldDefaultRoleIds))
+}
+
+// DefaultRoleIdsNotNil applies the NotNil predicate on the "default_role_ids" field.
+func DefaultRoleIdsNotNil() predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldNotNull(FieldDefaultRoleIds))
+}
+
+// EnabledEQ applies the EQ predicate on the "enabled" field.
+func EnabledEQ(v bool) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldEQ(FieldEnabled, v))
+}
+
+// EnabledNEQ applies the NEQ predicate on the "enabled" field.
+func EnabledNEQ(v bool) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldNEQ(FieldEnabled, v))
+}
+
+// SortEQ applies the EQ predicate on the "sort" field.
+func SortEQ(v uint32) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldEQ(FieldSort, v))
+}
+
+// SortNEQ applies the NEQ predicate on the "sort" field.
+func SortNEQ(v uint32) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldNEQ(FieldSort, v))
+}
+
+// SortIn applies the In predicate on the "sort" field.
+func SortIn(vs ...uint32) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldIn(FieldSort, vs...))
+}
+
+// SortNotIn applies the NotIn predicate on the "sort" field.
+func SortNotIn(vs ...uint32) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldNotIn(FieldSort, vs...))
+}
+
+// SortGT applies the GT predicate on the "sort" field.
+func SortGT(v uint32) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldGT(FieldSort, v))
+}
+
+// SortGTE applies the GTE predicate on the "sort" field.
+func SortGTE(v uint32) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldGTE(FieldSort, v))
+}
+
+// SortLT applies the LT predicate on the "sort" field.
+func SortLT(v uint32) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldLT(FieldSort, v))
+}
+
+// SortLTE applies the LTE predicate on the "sort" field.
+func SortLTE(v uint32) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldLTE(FieldSort, v))
+}
+
+// RemarkEQ applies the EQ predicate on the "remark" field.
+func RemarkEQ(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldEQ(FieldRemark, v))
+}
+
+// RemarkNEQ applies the NEQ predicate on the "remark" field.
+func RemarkNEQ(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldNEQ(FieldRemark, v))
+}
+
+// RemarkIn applies the In predicate on the "remark" field.
+func RemarkIn(vs ...string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldIn(FieldRemark, vs...))
+}
+
+// RemarkNotIn applies the NotIn predicate on the "remark" field.
+func RemarkNotIn(vs ...string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldNotIn(FieldRemark, vs...))
+}
+
+// RemarkGT applies the GT predicate on the "remark" field.
+func RemarkGT(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldGT(FieldRemark, v))
+}
+
+// RemarkGTE applies the GTE predicate on the "remark" field.
+func RemarkGTE(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldGTE(FieldRemark, v))
+}
+
+// RemarkLT applies the LT predicate on the "remark" field.
+func RemarkLT(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldLT(FieldRemark, v))
+}
+
+// RemarkLTE applies the LTE predicate on the "remark" field.
+func RemarkLTE(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldLTE(FieldRemark, v))
+}
+
+// RemarkContains applies the Contains predicate on the "remark" field.
+func RemarkContains(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldContains(FieldRemark, v))
+}
+
+// RemarkHasPrefix applies the HasPrefix predicate on the "remark" field.
+func RemarkHasPrefix(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldHasPrefix(FieldRemark, v))
+}
+
+// RemarkHasSuffix applies the HasSuffix predicate on the "remark" field.
+func RemarkHasSuffix(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldHasSuffix(FieldRemark, v))
+}
+
+// RemarkIsNil applies the IsNil predicate on the "remark" field.
+func RemarkIsNil() predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldIsNull(FieldRemark))
+}
+
+// RemarkNotNil applies the NotNil predicate on the "remark" field.
+func RemarkNotNil() predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldNotNull(FieldRemark))
+}
+
+// RemarkEqualFold applies the EqualFold predicate on the "remark" field.
+func RemarkEqualFold(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldEqualFold(FieldRemark, v))
+}
+
+// RemarkContainsFold applies the ContainsFold predicate on the "remark" field.
+func RemarkContainsFold(v string) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.FieldContainsFold(FieldRemark, v))
+}
+
+// HasSamlAccounts applies the HasEdge predicate on the "saml_accounts" edge.
+func HasSamlAccounts() predicate.SamlProvider {
+	return predicate.SamlProvider(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, SamlAccountsTable, SamlAccountsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSamlAccountsWith applies the HasEdge predicate on the "saml_accounts" edge with a given conditions (other predicates).
+func HasSamlAccountsWith(preds ...predicate.SamlAccount) predicate.SamlProvider {
+	return predicate.SamlProvider(func(s *sql.Selector) {
+		step := newSamlAccountsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.SamlProvider) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.SamlProvider) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.SamlProvider) predicate.SamlProvider {
+	return predicate.SamlProvider(sql.NotPredicates(p))
+}
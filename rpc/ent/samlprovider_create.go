@@ -0,0 +1,763 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/coder-lulu/newbee-core/rpc/ent/samlaccount"
+	"github.com/coder-lulu/newbee-core/rpc/ent/samlprovider"
+)
+
+// SamlProviderCreate is the builder for creating a SamlProvider entity.
+type SamlProviderCreate struct {
+	config
+	mutation *SamlProviderMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *SamlProviderCreate) SetCreatedAt(v time.Time) *SamlProviderCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *SamlProviderCreate) SetNillableCreatedAt(v *time.Time) *SamlProviderCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *SamlProviderCreate) SetUpdatedAt(v time.Time) *SamlProviderCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *SamlProviderCreate) SetNillableUpdatedAt(v *time.Time) *SamlProviderCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *SamlProviderCreate) SetStatus(v uint8) *SamlProviderCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *SamlProviderCreate) SetNillableStatus(v *uint8) *SamlProviderCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *SamlProviderCreate) SetTenantID(v uint64) *SamlProviderCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_c *SamlProviderCreate) SetNillableTenantID(v *uint64) *SamlProviderCreate {
+	if v != nil {
+		_c.SetTenantID(*v)
+	}
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *SamlProviderCreate) SetName(v string) *SamlProviderCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetDisplayName sets the "display_name" field.
+func (_c *SamlProviderCreate) SetDisplayName(v string) *SamlProviderCreate {
+	_c.mutation.SetDisplayName(v)
+	return _c
+}
+
+// SetNillableDisplayName sets the "display_name" field if the given value is not nil.
+func (_c *SamlProviderCreate) SetNillableDisplayName(v *string) *SamlProviderCreate {
+	if v != nil {
+		_c.SetDisplayName(*v)
+	}
+	return _c
+}
+
+// SetIdpEntityID sets the "idp_entity_id" field.
+func (_c *SamlProviderCreate) SetIdpEntityID(v string) *SamlProviderCreate {
+	_c.mutation.SetIdpEntityID(v)
+	return _c
+}
+
+// SetIdpSSOURL sets the "idp_sso_url" field.
+func (_c *SamlProviderCreate) SetIdpSSOURL(v string) *SamlProviderCreate {
+	_c.mutation.SetIdpSSOURL(v)
+	return _c
+}
+
+// SetIdpSloURL sets the "idp_slo_url" field.
+func (_c *SamlProviderCreate) SetIdpSloURL(v string) *SamlProviderCreate {
+	_c.mutation.SetIdpSloURL(v)
+	return _c
+}
+
+// SetNillableIdpSloURL sets the "idp_slo_url" field if the given value is not nil.
+func (_c *SamlProviderCreate) SetNillableIdpSloURL(v *string) *SamlProviderCreate {
+	if v != nil {
+		_c.SetIdpSloURL(*v)
+	}
+	return _c
+}
+
+// SetIdpCertificates sets the "idp_certificates" field.
+func (_c *SamlProviderCreate) SetIdpCertificates(v string) *SamlProviderCreate {
+	_c.mutation.SetIdpCertificates(v)
+	return _c
+}
+
+// SetIdpMetadataURL sets the "idp_metadata_url" field.
+func (_c *SamlProviderCreate) SetIdpMetadataURL(v string) *SamlProviderCreate {
+	_c.mutation.SetIdpMetadataURL(v)
+	return _c
+}
+
+// SetNillableIdpMetadataURL sets the "idp_metadata_url" field if the given value is not nil.
+func (_c *SamlProviderCreate) SetNillableIdpMetadataURL(v *string) *SamlProviderCreate {
+	if v != nil {
+		_c.SetIdpMetadataURL(*v)
+	}
+	return _c
+}
+
+// SetSpEntityID sets the "sp_entity_id" field.
+func (_c *SamlProviderCreate) SetSpEntityID(v string) *SamlProviderCreate {
+	_c.mutation.SetSpEntityID(v)
+	return _c
+}
+
+// SetNillableSpEntityID sets the "sp_entity_id" field if the given value is not nil.
+func (_c *SamlProviderCreate) SetNillableSpEntityID(v *string) *SamlProviderCreate {
+	if v != nil {
+		_c.SetSpEntityID(*v)
+	}
+	return _c
+}
+
+// SetAcsURL sets the "acs_url" field.
+func (_c *SamlProviderCreate) SetAcsURL(v string) *SamlProviderCreate {
+	_c.mutation.SetAcsURL(v)
+	return _c
+}
+
+// SetNillableAcsURL sets the "acs_url" field if the given value is not nil.
+func (_c *SamlProviderCreate) SetNillableAcsURL(v *string) *SamlProviderCreate {
+	if v != nil {
+		_c.SetAcsURL(*v)
+	}
+	return _c
+}
+
+// SetNameIDFormat sets the "name_id_format" field.
+func (_c *SamlProviderCreate) SetNameIDFormat(v string) *SamlProviderCreate {
+	_c.mutation.SetNameIDFormat(v)
+	return _c
+}
+
+// SetNillableNameIDFormat sets the "name_id_format" field if the given value is not nil.
+func (_c *SamlProviderCreate) SetNillableNameIDFormat(v *string) *SamlProviderCreate {
+	if v != nil {
+		_c.SetNameIDFormat(*v)
+	}
+	return _c
+}
+
+// SetSignAuthnRequest sets the "sign_authn_request" field.
+func (_c *SamlProviderCreate) SetSignAuthnRequest(v bool) *SamlProviderCreate {
+	_c.mutation.SetSignAuthnRequest(v)
+	return _c
+}
+
+// SetNillableSignAuthnRequest sets the "sign_authn_request" field if the given value is not nil.
+func (_c *SamlProviderCreate) SetNillableSignAuthnRequest(v *bool) *SamlProviderCreate {
+	if v != nil {
+		_c.SetSignAuthnRequest(*v)
+	}
+	return _c
+}
+
+// SetWantAssertionsSigned sets the "want_assertions_signed" field.
+func (_c *SamlProviderCreate) SetWantAssertionsSigned(v bool) *SamlProviderCreate {
+	_c.mutation.SetWantAssertionsSigned(v)
+	return _c
+}
+
+// SetNillableWantAssertionsSigned sets the "want_assertions_signed" field if the given value is not nil.
+func (_c *SamlProviderCreate) SetNillableWantAssertionsSigned(v *bool) *SamlProviderCreate {
+	if v != nil {
+		_c.SetWantAssertionsSigned(*v)
+	}
+	return _c
+}
+
+// SetAttributeMapping sets the "attribute_mapping" field.
+func (_c *SamlProviderCreate) SetAttributeMapping(v map[string]interface{}) *SamlProviderCreate {
+	_c.mutation.SetAttributeMapping(v)
+	return _c
+}
+
+// SetJitProvision sets the "jit_provision" field.
+func (_c *SamlProviderCreate) SetJitProvision(v bool) *SamlProviderCreate {
+	_c.mutation.SetJitProvision(v)
+	return _c
+}
+
+// SetNillableJitProvision sets the "jit_provision" field if the given value is not nil.
+func (_c *SamlProviderCreate) SetNillableJitProvision(v *bool) *SamlProviderCreate {
+	if v != nil {
+		_c.SetJitProvision(*v)
+	}
+	return _c
+}
+
+// SetSyncAttributes sets the "sync_attributes" field.
+func (_c *SamlProviderCreate) SetSyncAttributes(v bool) *SamlProviderCreate {
+	_c.mutation.SetSyncAttributes(v)
+	return _c
+}
+
+// SetNillableSyncAttributes sets the "sync_attributes" field if the given value is not nil.
+func (_c *SamlProviderCreate) SetNillableSyncAttributes(v *bool) *SamlProviderCreate {
+	if v != nil {
+		_c.SetSyncAttributes(*v)
+	}
+	return _c
+}
+
+// SetLinkByEmail sets the "link_by_email" field.
+func (_c *SamlProviderCreate) SetLinkByEmail(v bool) *SamlProviderCreate {
+	_c.mutation.SetLinkByEmail(v)
+	return _c
+}
+
+// SetNillableLinkByEmail sets the "link_by_email" field if the given value is not nil.
+func (_c *SamlProviderCreate) SetNillableLinkByEmail(v *bool) *SamlProviderCreate {
+	if v != nil {
+		_c.SetLinkByEmail(*v)
+	}
+	return _c
+}
+
+// SetDefaultDepartmentID sets the "default_department_id" field.
+func (_c *SamlProviderCreate) SetDefaultDepartmentID(v uint64) *SamlProviderCreate {
+	_c.mutation.SetDefaultDepartmentID(v)
+	return _c
+}
+
+// SetNillableDefaultDepartmentID sets the "default_department_id" field if the given value is not nil.
+func (_c *SamlProviderCreate) SetNillableDefaultDepartmentID(v *uint64) *SamlProviderCreate {
+	if v != nil {
+		_c.SetDefaultDepartmentID(*v)
+	}
+	return _c
+}
+
+// SetDefaultRoleIds sets the "default_role_ids" field.
+func (_c *SamlProviderCreate) SetDefaultRoleIds(v []uint64) *SamlProviderCreate {
+	_c.mutation.SetDefaultRoleIds(v)
+	return _c
+}
+
+// SetEnabled sets the "enabled" field.
+func (_c *SamlProviderCreate) SetEnabled(v bool) *SamlProviderCreate {
+	_c.mutation.SetEnabled(v)
+	return _c
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_c *SamlProviderCreate) SetNillableEnabled(v *bool) *SamlProviderCreate {
+	if v != nil {
+		_c.SetEnabled(*v)
+	}
+	return _c
+}
+
+// SetSort sets the "sort" field.
+func (_c *SamlProviderCreate) SetSort(v uint32) *SamlProviderCreate {
+	_c.mutation.SetSort(v)
+	return _c
+}
+
+// SetNillableSort sets the "sort" field if the given value is not nil.
+func (_c *SamlProviderCreate) SetNillableSort(v *uint32) *SamlProviderCreate {
+	if v != nil {
+		_c.SetSort(*v)
+	}
+	return _c
+}
+
+// SetRemark sets the "remark" field.
+func (_c *SamlProviderCreate) SetRemark(v string) *SamlProviderCreate {
+	_c.mutation.SetRemark(v)
+	return _c
+}
+
+// SetNillableRemark sets the "remark" field if the given value is not nil.
+func (_c *SamlProviderCreate) SetNillableRemark(v *string) *SamlProviderCreate {
+	if v != nil {
+		_c.SetRemark(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *SamlProviderCreate) SetID(v uint64) *SamlProviderCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// AddSamlAccountIDs adds the "saml_accounts" edge to the SamlAccount entity by IDs.
+func (_c *SamlProviderCreate) AddSamlAccountIDs(ids ...uint64) *SamlProviderCreate {
+	_c.mutation.AddSamlAccountIDs(ids...)
+	return _c
+}
+
+// AddSamlAccounts adds the "saml_accounts" edges to the SamlAccount entity.
+func (_c *SamlProviderCreate) AddSamlAccounts(v ...*SamlAccount) *SamlProviderCreate {
+	ids := make([]uint64, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddSamlAccountIDs(ids...)
+}
+
+// Mutation returns the SamlProviderMutation object of the builder.
+func (_c *SamlProviderCreate) Mutation() *SamlProviderMutation {
+	return _c.mutation
+}
+
+// Save creates the SamlProvider in the database.
+func (_c *SamlProviderCreate) Save(ctx context.Context) (*SamlProvider, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *SamlProviderCreate) SaveX(ctx context.Context) *SamlProvider {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SamlProviderCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SamlProviderCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *SamlProviderCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := samlprovider.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := samlprovider.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := samlprovider.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.TenantID(); !ok {
+		v := samlprovider.DefaultTenantID
+		_c.mutation.SetTenantID(v)
+	}
+	if _, ok := _c.mutation.SignAuthnRequest(); !ok {
+		v := samlprovider.DefaultSignAuthnRequest
+		_c.mutation.SetSignAuthnRequest(v)
+	}
+	if _, ok := _c.mutation.WantAssertionsSigned(); !ok {
+		v := samlprovider.DefaultWantAssertionsSigned
+		_c.mutation.SetWantAssertionsSigned(v)
+	}
+	if _, ok := _c.mutation.JitProvision(); !ok {
+		v := samlprovider.DefaultJitProvision
+		_c.mutation.SetJitProvision(v)
+	}
+	if _, ok := _c.mutation.SyncAttributes(); !ok {
+		v := samlprovider.DefaultSyncAttributes
+		_c.mutation.SetSyncAttributes(v)
+	}
+	if _, ok := _c.mutation.LinkByEmail(); !ok {
+		v := samlprovider.DefaultLinkByEmail
+		_c.mutation.SetLinkByEmail(v)
+	}
+	if _, ok := _c.mutation.DefaultDepartmentID(); !ok {
+		v := samlprovider.DefaultDefaultDepartmentID
+		_c.mutation.SetDefaultDepartmentID(v)
+	}
+	if _, ok := _c.mutation.Enabled(); !ok {
+		v := samlprovider.DefaultEnabled
+		_c.mutation.SetEnabled(v)
+	}
+	if _, ok := _c.mutation.Sort(); !ok {
+		v := samlprovider.DefaultSort
+		_c.mutation.SetSort(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *SamlProviderCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "SamlProvider.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "SamlProvider.updated_at"`)}
+	}
+	if _, ok := _c.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "SamlProvider.tenant_id"`)}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "SamlProvider.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := samlprovider.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "SamlProvider.name": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.DisplayName(); ok {
+		if err := samlprovider.DisplayNameValidator(v); err != nil {
+			return &ValidationError{Name: "display_name", err: fmt.Errorf(`ent: validator failed for field "SamlProvider.display_name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.IdpEntityID(); !ok {
+		return &ValidationError{Name: "idp_entity_id", err: errors.New(`ent: missing required field "SamlProvider.idp_entity_id"`)}
+	}
+	if v, ok := _c.mutation.IdpEntityID(); ok {
+		if err := samlprovider.IdpEntityIDValidator(v); err != nil {
+			return &ValidationError{Name: "idp_entity_id", err: fmt.Errorf(`ent: validator failed for field "SamlProvider.idp_entity_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.IdpSSOURL(); !ok {
+		return &ValidationError{Name: "idp_sso_url", err: errors.New(`ent: missing required field "SamlProvider.idp_sso_url"`)}
+	}
+	if v, ok := _c.mutation.IdpSSOURL(); ok {
+		if err := samlprovider.IdpSSOURLValidator(v); err != nil {
+			return &ValidationError{Name: "idp_sso_url", err: fmt.Errorf(`ent: validator failed for field "SamlProvider.idp_sso_url": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.IdpSloURL(); ok {
+		if err := samlprovider.IdpSloURLValidator(v); err != nil {
+			return &ValidationError{Name: "idp_slo_url", err: fmt.Errorf(`ent: validator failed for field "SamlProvider.idp_slo_url": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.IdpCertificates(); !ok {
+		return &ValidationError{Name: "idp_certificates", err: errors.New(`ent: missing required field "SamlProvider.idp_certificates"`)}
+	}
+	if v, ok := _c.mutation.IdpMetadataURL(); ok {
+		if err := samlprovider.IdpMetadataURLValidator(v); err != nil {
+			return &ValidationError{Name: "idp_metadata_url", err: fmt.Errorf(`ent: validator failed for field "SamlProvider.idp_metadata_url": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.SpEntityID(); ok {
+		if err := samlprovider.SpEntityIDValidator(v); err != nil {
+			return &ValidationError{Name: "sp_entity_id", err: fmt.Errorf(`ent: validator failed for field "SamlProvider.sp_entity_id": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.AcsURL(); ok {
+		if err := samlprovider.AcsURLValidator(v); err != nil {
+			return &ValidationError{Name: "acs_url", err: fmt.Errorf(`ent: validator failed for field "SamlProvider.acs_url": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.NameIDFormat(); ok {
+		if err := samlprovider.NameIDFormatValidator(v); err != nil {
+			return &ValidationError{Name: "name_id_format", err: fmt.Errorf(`ent: validator failed for field "SamlProvider.name_id_format": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.SignAuthnRequest(); !ok {
+		return &ValidationError{Name: "sign_authn_request", err: errors.New(`ent: missing required field "SamlProvider.sign_authn_request"`)}
+	}
+	if _, ok := _c.mutation.WantAssertionsSigned(); !ok {
+		return &ValidationError{Name: "want_assertions_signed", err: errors.New(`ent: missing required field "SamlProvider.want_assertions_signed"`)}
+	}
+	if _, ok := _c.mutation.JitProvision(); !ok {
+		return &ValidationError{Name: "jit_provision", err: errors.New(`ent: missing required field "SamlProvider.jit_provision"`)}
+	}
+	if _, ok := _c.mutation.SyncAttributes(); !ok {
+		return &ValidationError{Name: "sync_attributes", err: errors.New(`ent: missing required field "SamlProvider.sync_attributes"`)}
+	}
+	if _, ok := _c.mutation.LinkByEmail(); !ok {
+		return &ValidationError{Name: "link_by_email", err: errors.New(`ent: missing required field "SamlProvider.link_by_email"`)}
+	}
+	if _, ok := _c.mutation.Enabled(); !ok {
+		return &ValidationError{Name: "enabled", err: errors.New(`ent: missing required field "SamlProvider.enabled"`)}
+	}
+	if _, ok := _c.mutation.Sort(); !ok {
+		return &ValidationError{Name: "sort", err: errors.New(`ent: missing required field "SamlProvider.sort"`)}
+	}
+	if v, ok := _c.mutation.Remark(); ok {
+		if err := samlprovider.RemarkValidator(v); err != nil {
+			return &ValidationError{Name: "remark", err: fmt.Errorf(`ent: validator failed for field "SamlProvider.remark": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *SamlProviderCreate) sqlSave(ctx context.Context) (*SamlProvider, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint64(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *SamlProviderCreate) createSpec() (*SamlProvider, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SamlProvider{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(samlprovider.Table, sqlgraph.NewFieldSpec(samlprovider.FieldID, field.TypeUint64))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(samlprovider.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(samlprovider.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(samlprovider.FieldStatus, field.TypeUint8, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(samlprovider.FieldTenantID, field.TypeUint64, value)
+		_node.TenantID = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(samlprovider.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.DisplayName(); ok {
+		_spec.SetField(samlprovider.FieldDisplayName, field.TypeString, value)
+		_node.DisplayName = value
+	}
+	if value, ok := _c.mutation.IdpEntityID(); ok {
+		_spec.SetField(samlprovider.FieldIdpEntityID, field.TypeString, value)
+		_node.IdpEntityID = value
+	}
+	if value, ok := _c.mutation.IdpSSOURL(); ok {
+		_spec.SetField(samlprovider.FieldIdpSSOURL, field.TypeString, value)
+		_node.IdpSSOURL = value
+	}
+	if value, ok := _c.mutation.IdpSloURL(); ok {
+		_spec.SetField(samlprovider.FieldIdpSloURL, field.TypeString, value)
+		_node.IdpSloURL = value
+	}
+	if value, ok := _c.mutation.IdpCertificates(); ok {
+		_spec.SetField(samlprovider.FieldIdpCertificates, field.TypeString, value)
+		_node.IdpCertificates = value
+	}
+	if value, ok := _c.mutation.IdpMetadataURL(); ok {
+		_spec.SetField(samlprovider.FieldIdpMetadataURL, field.TypeString, value)
+		_node.IdpMetadataURL = value
+	}
+	if value, ok := _c.mutation.SpEntityID(); ok {
+		_spec.SetField(samlprovider.FieldSpEntityID, field.TypeString, value)
+		_node.SpEntityID = value
+	}
+	if value, ok := _c.mutation.AcsURL(); ok {
+		_spec.SetField(samlprovider.FieldAcsURL, field.TypeString, value)
+		_node.AcsURL = value
+	}
+	if value, ok := _c.mutation.NameIDFormat(); ok {
+		_spec.SetField(samlprovider.FieldNameIDFormat, field.TypeString, value)
+		_node.NameIDFormat = value
+	}
+	if value, ok := _c.mutation.SignAuthnRequest(); ok {
+		_spec.SetField(samlprovider.FieldSignAuthnRequest, field.TypeBool, value)
+		_node.SignAuthnRequest = value
+	}
+	if value, ok := _c.mutation.WantAssertionsSigned(); ok {
+		_spec.SetField(samlprovider.FieldWantAssertionsSigned, field.TypeBool, value)
+		_node.WantAssertionsSigned = value
+	}
+	if value, ok := _c.mutation.AttributeMapping(); ok {
+		_spec.SetField(samlprovider.FieldAttributeMapping, field.TypeJSON, value)
+		_node.AttributeMapping = value
+	}
+	if value, ok := _c.mutation.JitProvision(); ok {
+		_spec.SetField(samlprovider.FieldJitProvision, field.TypeBool, value)
+		_node.JitProvision = value
+	}
+	if value, ok := _c.mutation.SyncAttributes(); ok {
+		_spec.SetField(samlprovider.FieldSyncAttributes, field.TypeBool, value)
+		_node.SyncAttributes = value
+	}
+	if value, ok := _c.mutation.LinkByEmail(); ok {
+		_spec.SetField(samlprovider.FieldLinkByEmail, field.TypeBool, value)
+		_node.LinkByEmail = value
+	}
+	if value, ok := _c.mutation.DefaultDepartmentID(); ok {
+		_spec.SetField(samlprovider.FieldDefaultDepartmentID, field.TypeUint64, value)
+		_node.DefaultDepartmentID = value
+	}
+	if value, ok := _c.mutation.DefaultRoleIds(); ok {
+		_spec.SetField(samlprovider.FieldDefaultRoleIds, field.TypeJSON, value)
+		_node.DefaultRoleIds = value
+	}
+	if value, ok := _c.mutation.Enabled(); ok {
+		_spec.SetField(samlprovider.FieldEnabled, field.TypeBool, value)
+		_node.Enabled = value
+	}
+	if value, ok := _c.mutation.Sort(); ok {
+		_spec.SetField(samlprovider.FieldSort, field.TypeUint32, value)
+		_node.Sort = value
+	}
+	if value, ok := _c.mutation.Remark(); ok {
+		_spec.SetField(samlprovider.FieldRemark, field.TypeString, value)
+		_node.Remark = value
+	}
+	if nodes := _c.mutation.SamlAccountsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   samlprovider.SamlAccountsTable,
+			Columns: []string{samlprovider.SamlAccountsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(samlaccount.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// SamlProviderCreateBulk is the builder for creating many SamlProvider entities in bulk.
+type SamlProviderCreateBulk struct {
+	config
+	err      error
+	builders []*SamlProviderCreate
+}
+
+// Save creates the SamlProvider entities in the database.
+func (_c *SamlProviderCreateBulk) Save(ctx context.Context) ([]*SamlProvider, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*SamlProvider, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SamlProviderMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *SamlProviderCreateBulk) SaveX(ctx context.Context) []*SamlProvider {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SamlProviderCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SamlProviderCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
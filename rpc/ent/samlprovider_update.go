@@ -0,0 +1,1504 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/coder-lulu/newbee-core/rpc/ent/predicate"
+	"github.com/coder-lulu/newbee-core/rpc/ent/samlaccount"
+	"github.com/coder-lulu/newbee-core/rpc/ent/samlprovider"
+)
+
+// SamlProviderUpdate is the builder for updating SamlProvider entities.
+type SamlProviderUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *SamlProviderMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the SamlProviderUpdate builder.
+func (_u *SamlProviderUpdate) Where(ps ...predicate.SamlProvider) *SamlProviderUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *SamlProviderUpdate) SetUpdatedAt(v time.Time) *SamlProviderUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *SamlProviderUpdate) SetStatus(v uint8) *SamlProviderUpdate {
+	_u.mutation.ResetStatus()
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *SamlProviderUpdate) SetNillableStatus(v *uint8) *SamlProviderUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// AddStatus adds value to the "status" field.
+func (_u *SamlProviderUpdate) AddStatus(v int8) *SamlProviderUpdate {
+	_u.mutation.AddStatus(v)
+	return _u
+}
+
+// ClearStatus clears the value of the "status" field.
+func (_u *SamlProviderUpdate) ClearStatus() *SamlProviderUpdate {
+	_u.mutation.ClearStatus()
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *SamlProviderUpdate) SetName(v string) *SamlProviderUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *SamlProviderUpdate) SetNillableName(v *string) *SamlProviderUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetDisplayName sets the "display_name" field.
+func (_u *SamlProviderUpdate) SetDisplayName(v string) *SamlProviderUpdate {
+	_u.mutation.SetDisplayName(v)
+	return _u
+}
+
+// SetNillableDisplayName sets the "display_name" field if the given value is not nil.
+func (_u *SamlProviderUpdate) SetNillableDisplayName(v *string) *SamlProviderUpdate {
+	if v != nil {
+		_u.SetDisplayName(*v)
+	}
+	return _u
+}
+
+// ClearDisplayName clears the value of the "display_name" field.
+func (_u *SamlProviderUpdate) ClearDisplayName() *SamlProviderUpdate {
+	_u.mutation.ClearDisplayName()
+	return _u
+}
+
+// SetIdpEntityID sets the "idp_entity_id" field.
+func (_u *SamlProviderUpdate) SetIdpEntityID(v string) *SamlProviderUpdate {
+	_u.mutation.SetIdpEntityID(v)
+	return _u
+}
+
+// SetNillableIdpEntityID sets the "idp_entity_id" field if the given value is not nil.
+func (_u *SamlProviderUpdate) SetNillableIdpEntityID(v *string) *SamlProviderUpdate {
+	if v != nil {
+		_u.SetIdpEntityID(*v)
+	}
+	return _u
+}
+
+// SetIdpSSOURL sets the "idp_sso_url" field.
+func (_u *SamlProviderUpdate) SetIdpSSOURL(v string) *SamlProviderUpdate {
+	_u.mutation.SetIdpSSOURL(v)
+	return _u
+}
+
+// SetNillableIdpSSOURL sets the "idp_sso_url" field if the given value is not nil.
+func (_u *SamlProviderUpdate) SetNillableIdpSSOURL(v *string) *SamlProviderUpdate {
+	if v != nil {
+		_u.SetIdpSSOURL(*v)
+	}
+	return _u
+}
+
+// SetIdpSloURL sets the "idp_slo_url" field.
+func (_u *SamlProviderUpdate) SetIdpSloURL(v string) *SamlProviderUpdate {
+	_u.mutation.SetIdpSloURL(v)
+	return _u
+}
+
+// SetNillableIdpSloURL sets the "idp_slo_url" field if the given value is not nil.
+func (_u *SamlProviderUpdate) SetNillableIdpSloURL(v *string) *SamlProviderUpdate {
+	if v != nil {
+		_u.SetIdpSloURL(*v)
+	}
+	return _u
+}
+
+// ClearIdpSloURL clears the value of the "idp_slo_url" field.
+func (_u *SamlProviderUpdate) ClearIdpSloURL() *SamlProviderUpdate {
+	_u.mutation.ClearIdpSloURL()
+	return _u
+}
+
+// SetIdpCertificates sets the "idp_certificates" field.
+func (_u *SamlProviderUpdate) SetIdpCertificates(v string) *SamlProviderUpdate {
+	_u.mutation.SetIdpCertificates(v)
+	return _u
+}
+
+// SetNillableIdpCertificates sets the "idp_certificates" field if the given value is not nil.
+func (_u *SamlProviderUpdate) SetNillableIdpCertificates(v *string) *SamlProviderUpdate {
+	if v != nil {
+		_u.SetIdpCertificates(*v)
+	}
+	return _u
+}
+
+// SetIdpMetadataURL sets the "idp_metadata_url" field.
+func (_u *SamlProviderUpdate) SetIdpMetadataURL(v string) *SamlProviderUpdate {
+	_u.mutation.SetIdpMetadataURL(v)
+	return _u
+}
+
+// SetNillableIdpMetadataURL sets the "idp_metadata_url" field if the given value is not nil.
+func (_u *SamlProviderUpdate) SetNillableIdpMetadataURL(v *string) *SamlProviderUpdate {
+	if v != nil {
+		_u.SetIdpMetadataURL(*v)
+	}
+	return _u
+}
+
+// ClearIdpMetadataURL clears the value of the "idp_metadata_url" field.
+func (_u *SamlProviderUpdate) ClearIdpMetadataURL() *SamlProviderUpdate {
+	_u.mutation.ClearIdpMetadataURL()
+	return _u
+}
+
+// SetSpEntityID sets the "sp_entity_id" field.
+func (_u *SamlProviderUpdate) SetSpEntityID(v string) *SamlProviderUpdate {
+	_u.mutation.SetSpEntityID(v)
+	return _u
+}
+
+// SetNillableSpEntityID sets the "sp_entity_id" field if the given value is not nil.
+func (_u *SamlProviderUpdate) SetNillableSpEntityID(v *string) *SamlProviderUpdate {
+	if v != nil {
+		_u.SetSpEntityID(*v)
+	}
+	return _u
+}
+
+// ClearSpEntityID clears the value of the "sp_entity_id" field.
+func (_u *SamlProviderUpdate) ClearSpEntityID() *SamlProviderUpdate {
+	_u.mutation.ClearSpEntityID()
+	return _u
+}
+
+// SetAcsURL sets the "acs_url" field.
+func (_u *SamlProviderUpdate) SetAcsURL(v string) *SamlProviderUpdate {
+	_u.mutation.SetAcsURL(v)
+	return _u
+}
+
+// SetNillableAcsURL sets the "acs_url" field if the given value is not nil.
+func (_u *SamlProviderUpdate) SetNillableAcsURL(v *string) *SamlProviderUpdate {
+	if v != nil {
+		_u.SetAcsURL(*v)
+	}
+	return _u
+}
+
+// ClearAcsURL clears the value of the "acs_url" field.
+func (_u *SamlProviderUpdate) ClearAcsURL() *SamlProviderUpdate {
+	_u.mutation.ClearAcsURL()
+	return _u
+}
+
+// SetNameIDFormat sets the "name_id_format" field.
+func (_u *SamlProviderUpdate) SetNameIDFormat(v string) *SamlProviderUpdate {
+	_u.mutation.SetNameIDFormat(v)
+	return _u
+}
+
+// SetNillableNameIDFormat sets the "name_id_format" field if the given value is not nil.
+func (_u *SamlProviderUpdate) SetNillableNameIDFormat(v *string) *SamlProviderUpdate {
+	if v != nil {
+		_u.SetNameIDFormat(*v)
+	}
+	return _u
+}
+
+// ClearNameIDFormat clears the value of the "name_id_format" field.
+func (_u *SamlProviderUpdate) ClearNameIDFormat() *SamlProviderUpdate {
+	_u.mutation.ClearNameIDFormat()
+	return _u
+}
+
+// SetSignAuthnRequest sets the "sign_authn_request" field.
+func (_u *SamlProviderUpdate) SetSignAuthnRequest(v bool) *SamlProviderUpdate {
+	_u.mutation.SetSignAuthnRequest(v)
+	return _u
+}
+
+// SetNillableSignAuthnRequest sets the "sign_authn_request" field if the given value is not nil.
+func (_u *SamlProviderUpdate) SetNillableSignAuthnRequest(v *bool) *SamlProviderUpdate {
+	if v != nil {
+		_u.SetSignAuthnRequest(*v)
+	}
+	return _u
+}
+
+// SetWantAssertionsSigned sets the "want_assertions_signed" field.
+func (_u *SamlProviderUpdate) SetWantAssertionsSigned(v bool) *SamlProviderUpdate {
+	_u.mutation.SetWantAssertionsSigned(v)
+	return _u
+}
+
+// SetNillableWantAssertionsSigned sets the "want_assertions_signed" field if the given value is not nil.
+func (_u *SamlProviderUpdate) SetNillableWantAssertionsSigned(v *bool) *SamlProviderUpdate {
+	if v != nil {
+		_u.SetWantAssertionsSigned(*v)
+	}
+	return _u
+}
+
+// SetAttributeMapping sets the "attribute_mapping" field.
+func (_u *SamlProviderUpdate) SetAttributeMapping(v map[string]interface{}) *SamlProviderUpdate {
+	_u.mutation.SetAttributeMapping(v)
+	return _u
+}
+
+// ClearAttributeMapping clears the value of the "attribute_mapping" field.
+func (_u *SamlProviderUpdate) ClearAttributeMapping() *SamlProviderUpdate {
+	_u.mutation.ClearAttributeMapping()
+	return _u
+}
+
+// SetJitProvision sets the "jit_provision" field.
+func (_u *SamlProviderUpdate) SetJitProvision(v bool) *SamlProviderUpdate {
+	_u.mutation.SetJitProvision(v)
+	return _u
+}
+
+// SetNillableJitProvision sets the "jit_provision" field if the given value is not nil.
+func (_u *SamlProviderUpdate) SetNillableJitProvision(v *bool) *SamlProviderUpdate {
+	if v != nil {
+		_u.SetJitProvision(*v)
+	}
+	return _u
+}
+
+// SetSyncAttributes sets the "sync_attributes" field.
+func (_u *SamlProviderUpdate) SetSyncAttributes(v bool) *SamlProviderUpdate {
+	_u.mutation.SetSyncAttributes(v)
+	return _u
+}
+
+// SetNillableSyncAttributes sets the "sync_attributes" field if the given value is not nil.
+func (_u *SamlProviderUpdate) SetNillableSyncAttributes(v *bool) *SamlProviderUpdate {
+	if v != nil {
+		_u.SetSyncAttributes(*v)
+	}
+	return _u
+}
+
+// SetLinkByEmail sets the "link_by_email" field.
+func (_u *SamlProviderUpdate) SetLinkByEmail(v bool) *SamlProviderUpdate {
+	_u.mutation.SetLinkByEmail(v)
+	return _u
+}
+
+// SetNillableLinkByEmail sets the "link_by_email" field if the given value is not nil.
+func (_u *SamlProviderUpdate) SetNillableLinkByEmail(v *bool) *SamlProviderUpdate {
+	if v != nil {
+		_u.SetLinkByEmail(*v)
+	}
+	return _u
+}
+
+// SetDefaultDepartmentID sets the "default_department_id" field.
+func (_u *SamlProviderUpdate) SetDefaultDepartmentID(v uint64) *SamlProviderUpdate {
+	_u.mutation.ResetDefaultDepartmentID()
+	_u.mutation.SetDefaultDepartmentID(v)
+	return _u
+}
+
+// SetNillableDefaultDepartmentID sets the "default_department_id" field if the given value is not nil.
+func (_u *SamlProviderUpdate) SetNillableDefaultDepartmentID(v *uint64) *SamlProviderUpdate {
+	if v != nil {
+		_u.SetDefaultDepartmentID(*v)
+	}
+	return _u
+}
+
+// AddDefaultDepartmentID adds value to the "default_department_id" field.
+func (_u *SamlProviderUpdate) AddDefaultDepartmentID(v int64) *SamlProviderUpdate {
+	_u.mutation.AddDefaultDepartmentID(v)
+	return _u
+}
+
+// ClearDefaultDepartmentID clears the value of the "default_department_id" field.
+func (_u *SamlProviderUpdate) ClearDefaultDepartmentID() *SamlProviderUpdate {
+	_u.mutation.ClearDefaultDepartmentID()
+	return _u
+}
+
+// SetDefaultRoleIds sets the "default_role_ids" field.
+func (_u *SamlProviderUpdate) SetDefaultRoleIds(v []uint64) *SamlProviderUpdate {
+	_u.mutation.SetDefaultRoleIds(v)
+	return _u
+}
+
+// AppendDefaultRoleIds appends value to the "default_role_ids" field.
+func (_u *SamlProviderUpdate) AppendDefaultRoleIds(v []uint64) *SamlProviderUpdate {
+	_u.mutation.AppendDefaultRoleIds(v)
+	return _u
+}
+
+// ClearDefaultRoleIds clears the value of the "default_role_ids" field.
+func (_u *SamlProviderUpdate) ClearDefaultRoleIds() *SamlProviderUpdate {
+	_u.mutation.ClearDefaultRoleIds()
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *SamlProviderUpdate) SetEnabled(v bool) *SamlProviderUpdate {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *SamlProviderUpdate) SetNillableEnabled(v *bool) *SamlProviderUpdate {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// SetSort sets the "sort" field.
+func (_u *SamlProviderUpdate) SetSort(v uint32) *SamlProviderUpdate {
+	_u.mutation.ResetSort()
+	_u.mutation.SetSort(v)
+	return _u
+}
+
+// SetNillableSort sets the "sort" field if the given value is not nil.
+func (_u *SamlProviderUpdate) SetNillableSort(v *uint32) *SamlProviderUpdate {
+	if v != nil {
+		_u.SetSort(*v)
+	}
+	return _u
+}
+
+// AddSort adds value to the "sort" field.
+func (_u *SamlProviderUpdate) AddSort(v int32) *SamlProviderUpdate {
+	_u.mutation.AddSort(v)
+	return _u
+}
+
+// SetRemark sets the "remark" field.
+func (_u *SamlProviderUpdate) SetRemark(v string) *SamlProviderUpdate {
+	_u.mutation.SetRemark(v)
+	return _u
+}
+
+// SetNillableRemark sets the "remark" field if the given value is not nil.
+func (_u *SamlProviderUpdate) SetNillableRemark(v *string) *SamlProviderUpdate {
+	if v != nil {
+		_u.SetRemark(*v)
+	}
+	return _u
+}
+
+// ClearRemark clears the value of the "remark" field.
+func (_u *SamlProviderUpdate) ClearRemark() *SamlProviderUpdate {
+	_u.mutation.ClearRemark()
+	return _u
+}
+
+// AddSamlAccountIDs adds the "saml_accounts" edge to the SamlAccount entity by IDs.
+func (_u *SamlProviderUpdate) AddSamlAccountIDs(ids ...uint64) *SamlProviderUpdate {
+	_u.mutation.AddSamlAccountIDs(ids...)
+	return _u
+}
+
+// AddSamlAccounts adds the "saml_accounts" edges to the SamlAccount entity.
+func (_u *SamlProviderUpdate) AddSamlAccounts(v ...*SamlAccount) *SamlProviderUpdate {
+	ids := make([]uint64, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSamlAccountIDs(ids...)
+}
+
+// Mutation returns the SamlProviderMutation object of the builder.
+func (_u *SamlProviderUpdate) Mutation() *SamlProviderMutation {
+	return _u.mutation
+}
+
+// ClearSamlAccounts clears all "saml_accounts" edges to the SamlAccount entity.
+func (_u *SamlProviderUpdate) ClearSamlAccounts() *SamlProviderUpdate {
+	_u.mutation.ClearSamlAccounts()
+	return _u
+}
+
+// RemoveSamlAccountIDs removes the "saml_accounts" edge to SamlAccount entities by IDs.
+func (_u *SamlProviderUpdate) RemoveSamlAccountIDs(ids ...uint64) *SamlProviderUpdate {
+	_u.mutation.RemoveSamlAccountIDs(ids...)
+	return _u
+}
+
+// RemoveSamlAccounts removes "saml_accounts" edges to SamlAccount entities.
+func (_u *SamlProviderUpdate) RemoveSamlAccounts(v ...*SamlAccount) *SamlProviderUpdate {
+	ids := make([]uint64, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSamlAccountIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *SamlProviderUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SamlProviderUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *SamlProviderUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SamlProviderUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *SamlProviderUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := samlprovider.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *SamlProviderUpdate) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := samlprovider.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "SamlProvider.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DisplayName(); ok {
+		if err := samlprovider.DisplayNameValidator(v); err != nil {
+			return &ValidationError{Name: "display_name", err: fmt.Errorf(`ent: validator failed for field "SamlProvider.display_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.IdpEntityID(); ok {
+		if err := samlprovider.IdpEntityIDValidator(v); err != nil {
+			return &ValidationError{Name: "idp_entity_id", err: fmt.Errorf(`ent: validator failed for field "SamlProvider.idp_entity_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.IdpSSOURL(); ok {
+		if err := samlprovider.IdpSSOURLValidator(v); err != nil {
+			return &ValidationError{Name: "idp_sso_url", err: fmt.Errorf(`ent: validator failed for field "SamlProvider.idp_sso_url": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.IdpSloURL(); ok {
+		if err := samlprovider.IdpSloURLValidator(v); err != nil {
+			return &ValidationError{Name: "idp_slo_url", err: fmt.Errorf(`ent: validator failed for field "SamlProvider.idp_slo_url": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.IdpMetadataURL(); ok {
+		if err := samlprovider.IdpMetadataURLValidator(v); err != nil {
+			return &ValidationError{Name: "idp_metadata_url", err: fmt.Errorf(`ent: validator failed for field "SamlProvider.idp_metadata_url": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.SpEntityID(); ok {
+		if err := samlprovider.SpEntityIDValidator(v); err != nil {
+			return &ValidationError{Name: "sp_entity_id", err: fmt.Errorf(`ent: validator failed for field "SamlProvider.sp_entity_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AcsURL(); ok {
+		if err := samlprovider.AcsURLValidator(v); err != nil {
+			return &ValidationError{Name: "acs_url", err: fmt.Errorf(`ent: validator failed for field "SamlProvider.acs_url": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.NameIDFormat(); ok {
+		if err := samlprovider.NameIDFormatValidator(v); err != nil {
+			return &ValidationError{Name: "name_id_format", err: fmt.Errorf(`ent: validator failed for field "SamlProvider.name_id_format": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Remark(); ok {
+		if err := samlprovider.RemarkValidator(v); err != nil {
+			return &ValidationError{Name: "remark", err: fmt.Errorf(`ent: validator failed for field "SamlProvider.remark": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *SamlProviderUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *SamlProviderUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *SamlProviderUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(samlprovider.Table, samlprovider.Columns, sqlgraph.NewFieldSpec(samlprovider.FieldID, field.TypeUint64))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(samlprovider.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(samlprovider.FieldStatus, field.TypeUint8, value)
+	}
+	if value, ok := _u.mutation.AddedStatus(); ok {
+		_spec.AddField(samlprovider.FieldStatus, field.TypeUint8, value)
+	}
+	if _u.mutation.StatusCleared() {
+		_spec.ClearField(samlprovider.FieldStatus, field.TypeUint8)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(samlprovider.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.DisplayName(); ok {
+		_spec.SetField(samlprovider.FieldDisplayName, field.TypeString, value)
+	}
+	if _u.mutation.DisplayNameCleared() {
+		_spec.ClearField(samlprovider.FieldDisplayName, field.TypeString)
+	}
+	if value, ok := _u.mutation.IdpEntityID(); ok {
+		_spec.SetField(samlprovider.FieldIdpEntityID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.IdpSSOURL(); ok {
+		_spec.SetField(samlprovider.FieldIdpSSOURL, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.IdpSloURL(); ok {
+		_spec.SetField(samlprovider.FieldIdpSloURL, field.TypeString, value)
+	}
+	if _u.mutation.IdpSloURLCleared() {
+		_spec.ClearField(samlprovider.FieldIdpSloURL, field.TypeString)
+	}
+	if value, ok := _u.mutation.IdpCertificates(); ok {
+		_spec.SetField(samlprovider.FieldIdpCertificates, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.IdpMetadataURL(); ok {
+		_spec.SetField(samlprovider.FieldIdpMetadataURL, field.TypeString, value)
+	}
+	if _u.mutation.IdpMetadataURLCleared() {
+		_spec.ClearField(samlprovider.FieldIdpMetadataURL, field.TypeString)
+	}
+	if value, ok := _u.mutation.SpEntityID(); ok {
+		_spec.SetField(samlprovider.FieldSpEntityID, field.TypeString, value)
+	}
+	if _u.mutation.SpEntityIDCleared() {
+		_spec.ClearField(samlprovider.FieldSpEntityID, field.TypeString)
+	}
+	if value, ok := _u.mutation.AcsURL(); ok {
+		_spec.SetField(samlprovider.FieldAcsURL, field.TypeString, value)
+	}
+	if _u.mutation.AcsURLCleared() {
+		_spec.ClearField(samlprovider.FieldAcsURL, field.TypeString)
+	}
+	if value, ok := _u.mutation.NameIDFormat(); ok {
+		_spec.SetField(samlprovider.FieldNameIDFormat, field.TypeString, value)
+	}
+	if _u.mutation.NameIDFormatCleared() {
+		_spec.ClearField(samlprovider.FieldNameIDFormat, field.TypeString)
+	}
+	if value, ok := _u.mutation.SignAuthnRequest(); ok {
+		_spec.SetField(samlprovider.FieldSignAuthnRequest, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.WantAssertionsSigned(); ok {
+		_spec.SetField(samlprovider.FieldWantAssertionsSigned, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.AttributeMapping(); ok {
+		_spec.SetField(samlprovider.FieldAttributeMapping, field.TypeJSON, value)
+	}
+	if _u.mutation.AttributeMappingCleared() {
+		_spec.ClearField(samlprovider.FieldAttributeMapping, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.JitProvision(); ok {
+		_spec.SetField(samlprovider.FieldJitProvision, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.SyncAttributes(); ok {
+		_spec.SetField(samlprovider.FieldSyncAttributes, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.LinkByEmail(); ok {
+		_spec.SetField(samlprovider.FieldLinkByEmail, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.DefaultDepartmentID(); ok {
+		_spec.SetField(samlprovider.FieldDefaultDepartmentID, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.AddedDefaultDepartmentID(); ok {
+		_spec.AddField(samlprovider.FieldDefaultDepartmentID, field.TypeUint64, value)
+	}
+	if _u.mutation.DefaultDepartmentIDCleared() {
+		_spec.ClearField(samlprovider.FieldDefaultDepartmentID, field.TypeUint64)
+	}
+	if value, ok := _u.mutation.DefaultRoleIds(); ok {
+		_spec.SetField(samlprovider.FieldDefaultRoleIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedDefaultRoleIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, samlprovider.FieldDefaultRoleIds, value)
+		})
+	}
+	if _u.mutation.DefaultRoleIdsCleared() {
+		_spec.ClearField(samlprovider.FieldDefaultRoleIds, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(samlprovider.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Sort(); ok {
+		_spec.SetField(samlprovider.FieldSort, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedSort(); ok {
+		_spec.AddField(samlprovider.FieldSort, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.Remark(); ok {
+		_spec.SetField(samlprovider.FieldRemark, field.TypeString, value)
+	}
+	if _u.mutation.RemarkCleared() {
+		_spec.ClearField(samlprovider.FieldRemark, field.TypeString)
+	}
+	if _u.mutation.SamlAccountsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   samlprovider.SamlAccountsTable,
+			Columns: []string{samlprovider.SamlAccountsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(samlaccount.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSamlAccountsIDs(); len(nodes) > 0 && !_u.mutation.SamlAccountsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   samlprovider.SamlAccountsTable,
+			Columns: []string{samlprovider.SamlAccountsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(samlaccount.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SamlAccountsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   samlprovider.SamlAccountsTable,
+			Columns: []string{samlprovider.SamlAccountsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(samlaccount.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{samlprovider.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// SamlProviderUpdateOne is the builder for updating a single SamlProvider entity.
+type SamlProviderUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *SamlProviderMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *SamlProviderUpdateOne) SetUpdatedAt(v time.Time) *SamlProviderUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *SamlProviderUpdateOne) SetStatus(v uint8) *SamlProviderUpdateOne {
+	_u.mutation.ResetStatus()
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *SamlProviderUpdateOne) SetNillableStatus(v *uint8) *SamlProviderUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// AddStatus adds value to the "status" field.
+func (_u *SamlProviderUpdateOne) AddStatus(v int8) *SamlProviderUpdateOne {
+	_u.mutation.AddStatus(v)
+	return _u
+}
+
+// ClearStatus clears the value of the "status" field.
+func (_u *SamlProviderUpdateOne) ClearStatus() *SamlProviderUpdateOne {
+	_u.mutation.ClearStatus()
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *SamlProviderUpdateOne) SetName(v string) *SamlProviderUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *SamlProviderUpdateOne) SetNillableName(v *string) *SamlProviderUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetDisplayName sets the "display_name" field.
+func (_u *SamlProviderUpdateOne) SetDisplayName(v string) *SamlProviderUpdateOne {
+	_u.mutation.SetDisplayName(v)
+	return _u
+}
+
+// SetNillableDisplayName sets the "display_name" field if the given value is not nil.
+func (_u *SamlProviderUpdateOne) SetNillableDisplayName(v *string) *SamlProviderUpdateOne {
+	if v != nil {
+		_u.SetDisplayName(*v)
+	}
+	return _u
+}
+
+// ClearDisplayName clears the value of the "display_name" field.
+func (_u *SamlProviderUpdateOne) ClearDisplayName() *SamlProviderUpdateOne {
+	_u.mutation.ClearDisplayName()
+	return _u
+}
+
+// SetIdpEntityID sets the "idp_entity_id" field.
+func (_u *SamlProviderUpdateOne) SetIdpEntityID(v string) *SamlProviderUpdateOne {
+	_u.mutation.SetIdpEntityID(v)
+	return _u
+}
+
+// SetNillableIdpEntityID sets the "idp_entity_id" field if the given value is not nil.
+func (_u *SamlProviderUpdateOne) SetNillableIdpEntityID(v *string) *SamlProviderUpdateOne {
+	if v != nil {
+		_u.SetIdpEntityID(*v)
+	}
+	return _u
+}
+
+// SetIdpSSOURL sets the "idp_sso_url" field.
+func (_u *SamlProviderUpdateOne) SetIdpSSOURL(v string) *SamlProviderUpdateOne {
+	_u.mutation.SetIdpSSOURL(v)
+	return _u
+}
+
+// SetNillableIdpSSOURL sets the "idp_sso_url" field if the given value is not nil.
+func (_u *SamlProviderUpdateOne) SetNillableIdpSSOURL(v *string) *SamlProviderUpdateOne {
+	if v != nil {
+		_u.SetIdpSSOURL(*v)
+	}
+	return _u
+}
+
+// SetIdpSloURL sets the "idp_slo_url" field.
+func (_u *SamlProviderUpdateOne) SetIdpSloURL(v string) *SamlProviderUpdateOne {
+	_u.mutation.SetIdpSloURL(v)
+	return _u
+}
+
+// SetNillableIdpSloURL sets the "idp_slo_url" field if the given value is not nil.
+func (_u *SamlProviderUpdateOne) SetNillableIdpSloURL(v *string) *SamlProviderUpdateOne {
+	if v != nil {
+		_u.SetIdpSloURL(*v)
+	}
+	return _u
+}
+
+// ClearIdpSloURL clears the value of the "idp_slo_url" field.
+func (_u *SamlProviderUpdateOne) ClearIdpSloURL() *SamlProviderUpdateOne {
+	_u.mutation.ClearIdpSloURL()
+	return _u
+}
+
+// SetIdpCertificates sets the "idp_certificates" field.
+func (_u *SamlProviderUpdateOne) SetIdpCertificates(v string) *SamlProviderUpdateOne {
+	_u.mutation.SetIdpCertificates(v)
+	return _u
+}
+
+// SetNillableIdpCertificates sets the "idp_certificates" field if the given value is not nil.
+func (_u *SamlProviderUpdateOne) SetNillableIdpCertificates(v *string) *SamlProviderUpdateOne {
+	if v != nil {
+		_u.SetIdpCertificates(*v)
+	}
+	return _u
+}
+
+// SetIdpMetadataURL sets the "idp_metadata_url" field.
+func (_u *SamlProviderUpdateOne) SetIdpMetadataURL(v string) *SamlProviderUpdateOne {
+	_u.mutation.SetIdpMetadataURL(v)
+	return _u
+}
+
+// SetNillableIdpMetadataURL sets the "idp_metadata_url" field if the given value is not nil.
+func (_u *SamlProviderUpdateOne) SetNillableIdpMetadataURL(v *string) *SamlProviderUpdateOne {
+	if v != nil {
+		_u.SetIdpMetadataURL(*v)
+	}
+	return _u
+}
+
+// ClearIdpMetadataURL clears the value of the "idp_metadata_url" field.
+func (_u *SamlProviderUpdateOne) ClearIdpMetadataURL() *SamlProviderUpdateOne {
+	_u.mutation.ClearIdpMetadataURL()
+	return _u
+}
+
+// SetSpEntityID sets the "sp_entity_id" field.
+func (_u *SamlProviderUpdateOne) SetSpEntityID(v string) *SamlProviderUpdateOne {
+	_u.mutation.SetSpEntityID(v)
+	return _u
+}
+
+// SetNillableSpEntityID sets the "sp_entity_id" field if the given value is not nil.
+func (_u *SamlProviderUpdateOne) SetNillableSpEntityID(v *string) *SamlProviderUpdateOne {
+	if v != nil {
+		_u.SetSpEntityID(*v)
+	}
+	return _u
+}
+
+// ClearSpEntityID clears the value of the "sp_entity_id" field.
+func (_u *SamlProviderUpdateOne) ClearSpEntityID() *SamlProviderUpdateOne {
+	_u.mutation.ClearSpEntityID()
+	return _u
+}
+
+// SetAcsURL sets the "acs_url" field.
+func (_u *SamlProviderUpdateOne) SetAcsURL(v string) *SamlProviderUpdateOne {
+	_u.mutation.SetAcsURL(v)
+	return _u
+}
+
+// SetNillableAcsURL sets the "acs_url" field if the given value is not nil.
+func (_u *SamlProviderUpdateOne) SetNillableAcsURL(v *string) *SamlProviderUpdateOne {
+	if v != nil {
+		_u.SetAcsURL(*v)
+	}
+	return _u
+}
+
+// ClearAcsURL clears the value of the "acs_url" field.
+func (_u *SamlProviderUpdateOne) ClearAcsURL() *SamlProviderUpdateOne {
+	_u.mutation.ClearAcsURL()
+	return _u
+}
+
+// SetNameIDFormat sets the "name_id_format" field.
+func (_u *SamlProviderUpdateOne) SetNameIDFormat(v string) *SamlProviderUpdateOne {
+	_u.mutation.SetNameIDFormat(v)
+	return _u
+}
+
+// SetNillableNameIDFormat sets the "name_id_format" field if the given value is not nil.
+func (_u *SamlProviderUpdateOne) SetNillableNameIDFormat(v *string) *SamlProviderUpdateOne {
+	if v != nil {
+		_u.SetNameIDFormat(*v)
+	}
+	return _u
+}
+
+// ClearNameIDFormat clears the value of the "name_id_format" field.
+func (_u *SamlProviderUpdateOne) ClearNameIDFormat() *SamlProviderUpdateOne {
+	_u.mutation.ClearNameIDFormat()
+	return _u
+}
+
+// SetSignAuthnRequest sets the "sign_authn_request" field.
+func (_u *SamlProviderUpdateOne) SetSignAuthnRequest(v bool) *SamlProviderUpdateOne {
+	_u.mutation.SetSignAuthnRequest(v)
+	return _u
+}
+
+// SetNillableSignAuthnRequest sets the "sign_authn_request" field if the given value is not nil.
+func (_u *SamlProviderUpdateOne) SetNillableSignAuthnRequest(v *bool) *SamlProviderUpdateOne {
+	if v != nil {
+		_u.SetSignAuthnRequest(*v)
+	}
+	return _u
+}
+
+// SetWantAssertionsSigned sets the "want_assertions_signed" field.
+func (_u *SamlProviderUpdateOne) SetWantAssertionsSigned(v bool) *SamlProviderUpdateOne {
+	_u.mutation.SetWantAssertionsSigned(v)
+	return _u
+}
+
+// SetNillableWantAssertionsSigned sets the "want_assertions_signed" field if the given value is not nil.
+func (_u *SamlProviderUpdateOne) SetNillableWantAssertionsSigned(v *bool) *SamlProviderUpdateOne {
+	if v != nil {
+		_u.SetWantAssertionsSigned(*v)
+	}
+	return _u
+}
+
+// SetAttributeMapping sets the "attribute_mapping" field.
+func (_u *SamlProviderUpdateOne) SetAttributeMapping(v map[string]interface{}) *SamlProviderUpdateOne {
+	_u.mutation.SetAttributeMapping(v)
+	return _u
+}
+
+// ClearAttributeMapping clears the value of the "attribute_mapping" field.
+func (_u *SamlProviderUpdateOne) ClearAttributeMapping() *SamlProviderUpdateOne {
+	_u.mutation.ClearAttributeMapping()
+	return _u
+}
+
+// SetJitProvision sets the "jit_provision" field.
+func (_u *SamlProviderUpdateOne) SetJitProvision(v bool) *SamlProviderUpdateOne {
+	_u.mutation.SetJitProvision(v)
+	return _u
+}
+
+// SetNillableJitProvision sets the "jit_provision" field if the given value is not nil.
+func (_u *SamlProviderUpdateOne) SetNillableJitProvision(v *bool) *SamlProviderUpdateOne {
+	if v != nil {
+		_u.SetJitProvision(*v)
+	}
+	return _u
+}
+
+// SetSyncAttributes sets the "sync_attributes" field.
+func (_u *SamlProviderUpdateOne) SetSyncAttributes(v bool) *SamlProviderUpdateOne {
+	_u.mutation.SetSyncAttributes(v)
+	return _u
+}
+
+// SetNillableSyncAttributes sets the "sync_attributes" field if the given value is not nil.
+func (_u *SamlProviderUpdateOne) SetNillableSyncAttributes(v *bool) *SamlProviderUpdateOne {
+	if v != nil {
+		_u.SetSyncAttributes(*v)
+	}
+	return _u
+}
+
+// SetLinkByEmail sets the "link_by_email" field.
+func (_u *SamlProviderUpdateOne) SetLinkByEmail(v bool) *SamlProviderUpdateOne {
+	_u.mutation.SetLinkByEmail(v)
+	return _u
+}
+
+// SetNillableLinkByEmail sets the "link_by_email" field if the given value is not nil.
+func (_u *SamlProviderUpdateOne) SetNillableLinkByEmail(v *bool) *SamlProviderUpdateOne {
+	if v != nil {
+		_u.SetLinkByEmail(*v)
+	}
+	return _u
+}
+
+// SetDefaultDepartmentID sets the "default_department_id" field.
+func (_u *SamlProviderUpdateOne) SetDefaultDepartmentID(v uint64) *SamlProviderUpdateOne {
+	_u.mutation.ResetDefaultDepartmentID()
+	_u.mutation.SetDefaultDepartmentID(v)
+	return _u
+}
+
+// SetNillableDefaultDepartmentID sets the "default_department_id" field if the given value is not nil.
+func (_u *SamlProviderUpdateOne) SetNillableDefaultDepartmentID(v *uint64) *SamlProviderUpdateOne {
+	if v != nil {
+		_u.SetDefaultDepartmentID(*v)
+	}
+	return _u
+}
+
+// AddDefaultDepartmentID adds value to the "default_department_id" field.
+func (_u *SamlProviderUpdateOne) AddDefaultDepartmentID(v int64) *SamlProviderUpdateOne {
+	_u.mutation.AddDefaultDepartmentID(v)
+	return _u
+}
+
+// ClearDefaultDepartmentID clears the value of the "default_department_id" field.
+func (_u *SamlProviderUpdateOne) ClearDefaultDepartmentID() *SamlProviderUpdateOne {
+	_u.mutation.ClearDefaultDepartmentID()
+	return _u
+}
+
+// SetDefaultRoleIds sets the "default_role_ids" field.
+func (_u *SamlProviderUpdateOne) SetDefaultRoleIds(v []uint64) *SamlProviderUpdateOne {
+	_u.mutation.SetDefaultRoleIds(v)
+	return _u
+}
+
+// AppendDefaultRoleIds appends value to the "default_role_ids" field.
+func (_u *SamlProviderUpdateOne) AppendDefaultRoleIds(v []uint64) *SamlProviderUpdateOne {
+	_u.mutation.AppendDefaultRoleIds(v)
+	return _u
+}
+
+// ClearDefaultRoleIds clears the value of the "default_role_ids" field.
+func (_u *SamlProviderUpdateOne) ClearDefaultRoleIds() *SamlProviderUpdateOne {
+	_u.mutation.ClearDefaultRoleIds()
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *SamlProviderUpdateOne) SetEnabled(v bool) *SamlProviderUpdateOne {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *SamlProviderUpdateOne) SetNillableEnabled(v *bool) *SamlProviderUpdateOne {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// SetSort sets the "sort" field.
+func (_u *SamlProviderUpdateOne) SetSort(v uint32) *SamlProviderUpdateOne {
+	_u.mutation.ResetSort()
+	_u.mutation.SetSort(v)
+	return _u
+}
+
+// SetNillableSort sets the "sort" field if the given value is not nil.
+func (_u *SamlProviderUpdateOne) SetNillableSort(v *uint32) *SamlProviderUpdateOne {
+	if v != nil {
+		_u.SetSort(*v)
+	}
+	return _u
+}
+
+// AddSort adds value to the "sort" field.
+func (_u *SamlProviderUpdateOne) AddSort(v int32) *SamlProviderUpdateOne {
+	_u.mutation.AddSort(v)
+	return _u
+}
+
+// SetRemark sets the "remark" field.
+func (_u *SamlProviderUpdateOne) SetRemark(v string) *SamlProviderUpdateOne {
+	_u.mutation.SetRemark(v)
+	return _u
+}
+
+// SetNillableRemark sets the "remark" field if the given value is not nil.
+func (_u *SamlProviderUpdateOne) SetNillableRemark(v *string) *SamlProviderUpdateOne {
+	if v != nil {
+		_u.SetRemark(*v)
+	}
+	return _u
+}
+
+// ClearRemark clears the value of the "remark" field.
+func (_u *SamlProviderUpdateOne) ClearRemark() *SamlProviderUpdateOne {
+	_u.mutation.ClearRemark()
+	return _u
+}
+
+// AddSamlAccountIDs adds the "saml_accounts" edge to the SamlAccount entity by IDs.
+func (_u *SamlProviderUpdateOne) AddSamlAccountIDs(ids ...uint64) *SamlProviderUpdateOne {
+	_u.mutation.AddSamlAccountIDs(ids...)
+	return _u
+}
+
+// AddSamlAccounts adds the "saml_accounts" edges to the SamlAccount entity.
+func (_u *SamlProviderUpdateOne) AddSamlAccounts(v ...*SamlAccount) *SamlProviderUpdateOne {
+	ids := make([]uint64, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSamlAccountIDs(ids...)
+}
+
+// Mutation returns the SamlProviderMutation object of the builder.
+func (_u *SamlProviderUpdateOne) Mutation() *SamlProviderMutation {
+	return _u.mutation
+}
+
+// ClearSamlAccounts clears all "saml_accounts" edges to the SamlAccount entity.
+func (_u *SamlProviderUpdateOne) ClearSamlAccounts() *SamlProviderUpdateOne {
+	_u.mutation.ClearSamlAccounts()
+	return _u
+}
+
+// RemoveSamlAccountIDs removes the "saml_accounts" edge to SamlAccount entities by IDs.
+func (_u *SamlProviderUpdateOne) RemoveSamlAccountIDs(ids ...uint64) *SamlProviderUpdateOne {
+	_u.mutation.RemoveSamlAccountIDs(ids...)
+	return _u
+}
+
+// RemoveSamlAccounts removes "saml_accounts" edges to SamlAccount entities.
+func (_u *SamlProviderUpdateOne) RemoveSamlAccounts(v ...*SamlAccount) *SamlProviderUpdateOne {
+	ids := make([]uint64, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSamlAccountIDs(ids...)
+}
+
+// Where appends a list predicates to the SamlProviderUpdate builder.
+func (_u *SamlProviderUpdateOne) Where(ps ...predicate.SamlProvider) *SamlProviderUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *SamlProviderUpdateOne) Select(field string, fields ...string) *SamlProviderUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated SamlProvider entity.
+func (_u *SamlProviderUpdateOne) Save(ctx context.Context) (*SamlProvider, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SamlProviderUpdateOne) SaveX(ctx context.Context) *SamlProvider {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *SamlProviderUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SamlProviderUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *SamlProviderUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := samlprovider.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *SamlProviderUpdateOne) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := samlprovider.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "SamlProvider.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DisplayName(); ok {
+		if err := samlprovider.DisplayNameValidator(v); err != nil {
+			return &ValidationError{Name: "display_name", err: fmt.Errorf(`ent: validator failed for field "SamlProvider.display_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.IdpEntityID(); ok {
+		if err := samlprovider.IdpEntityIDValidator(v); err != nil {
+			return &ValidationError{Name: "idp_entity_id", err: fmt.Errorf(`ent: validator failed for field "SamlProvider.idp_entity_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.IdpSSOURL(); ok {
+		if err := samlprovider.IdpSSOURLValidator(v); err != nil {
+			return &ValidationError{Name: "idp_sso_url", err: fmt.Errorf(`ent: validator failed for field "SamlProvider.idp_sso_url": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.IdpSloURL(); ok {
+		if err := samlprovider.IdpSloURLValidator(v); err != nil {
+			return &ValidationError{Name: "idp_slo_url", err: fmt.Errorf(`ent: validator failed for field "SamlProvider.idp_slo_url": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.IdpMetadataURL(); ok {
+		if err := samlprovider.IdpMetadataURLValidator(v); err != nil {
+			return &ValidationError{Name: "idp_metadata_url", err: fmt.Errorf(`ent: validator failed for field "SamlProvider.idp_metadata_url": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.SpEntityID(); ok {
+		if err := samlprovider.SpEntityIDValidator(v); err != nil {
+			return &ValidationError{Name: "sp_entity_id", err: fmt.Errorf(`ent: validator failed for field "SamlProvider.sp_entity_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AcsURL(); ok {
+		if err := samlprovider.AcsURLValidator(v); err != nil {
+			return &ValidationError{Name: "acs_url", err: fmt.Errorf(`ent: validator failed for field "SamlProvider.acs_url": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.NameIDFormat(); ok {
+		if err := samlprovider.NameIDFormatValidator(v); err != nil {
+			return &ValidationError{Name: "name_id_format", err: fmt.Errorf(`ent: validator failed for field "SamlProvider.name_id_format": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Remark(); ok {
+		if err := samlprovider.RemarkValidator(v); err != nil {
+			return &ValidationError{Name: "remark", err: fmt.Errorf(`ent: validator failed for field "SamlProvider.remark": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *SamlProviderUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *SamlProviderUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *SamlProviderUpdateOne) sqlSave(ctx context.Context) (_node *SamlProvider, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(samlprovider.Table, samlprovider.Columns, sqlgraph.NewFieldSpec(samlprovider.FieldID, field.TypeUint64))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SamlProvider.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, samlprovider.FieldID)
+		for _, f := range fields {
+			if !samlprovider.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != samlprovider.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(samlprovider.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(samlprovider.FieldStatus, field.TypeUint8, value)
+	}
+	if value, ok := _u.mutation.AddedStatus(); ok {
+		_spec.AddField(samlprovider.FieldStatus, field.TypeUint8, value)
+	}
+	if _u.mutation.StatusCleared() {
+		_spec.ClearField(samlprovider.FieldStatus, field.TypeUint8)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(samlprovider.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.DisplayName(); ok {
+		_spec.SetField(samlprovider.FieldDisplayName, field.TypeString, value)
+	}
+	if _u.mutation.DisplayNameCleared() {
+		_spec.ClearField(samlprovider.FieldDisplayName, field.TypeString)
+	}
+	if value, ok := _u.mutation.IdpEntityID(); ok {
+		_spec.SetField(samlprovider.FieldIdpEntityID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.IdpSSOURL(); ok {
+		_spec.SetField(samlprovider.FieldIdpSSOURL, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.IdpSloURL(); ok {
+		_spec.SetField(samlprovider.FieldIdpSloURL, field.TypeString, value)
+	}
+	if _u.mutation.IdpSloURLCleared() {
+		_spec.ClearField(samlprovider.FieldIdpSloURL, field.TypeString)
+	}
+	if value, ok := _u.mutation.IdpCertificates(); ok {
+		_spec.SetField(samlprovider.FieldIdpCertificates, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.IdpMetadataURL(); ok {
+		_spec.SetField(samlprovider.FieldIdpMetadataURL, field.TypeString, value)
+	}
+	if _u.mutation.IdpMetadataURLCleared() {
+		_spec.ClearField(samlprovider.FieldIdpMetadataURL, field.TypeString)
+	}
+	if value, ok := _u.mutation.SpEntityID(); ok {
+		_spec.SetField(samlprovider.FieldSpEntityID, field.TypeString, value)
+	}
+	if _u.mutation.SpEntityIDCleared() {
+		_spec.ClearField(samlprovider.FieldSpEntityID, field.TypeString)
+	}
+	if value, ok := _u.mutation.AcsURL(); ok {
+		_spec.SetField(samlprovider.FieldAcsURL, field.TypeString, value)
+	}
+	if _u.mutation.AcsURLCleared() {
+		_spec.ClearField(samlprovider.FieldAcsURL, field.TypeString)
+	}
+	if value, ok := _u.mutation.NameIDFormat(); ok {
+		_spec.SetField(samlprovider.FieldNameIDFormat, field.TypeString, value)
+	}
+	if _u.mutation.NameIDFormatCleared() {
+		_spec.ClearField(samlprovider.FieldNameIDFormat, field.TypeString)
+	}
+	if value, ok := _u.mutation.SignAuthnRequest(); ok {
+		_spec.SetField(samlprovider.FieldSignAuthnRequest, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.WantAssertionsSigned(); ok {
+		_spec.SetField(samlprovider.FieldWantAssertionsSigned, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.AttributeMapping(); ok {
+		_spec.SetField(samlprovider.FieldAttributeMapping, field.TypeJSON, value)
+	}
+	if _u.mutation.AttributeMappingCleared() {
+		_spec.ClearField(samlprovider.FieldAttributeMapping, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.JitProvision(); ok {
+		_spec.SetField(samlprovider.FieldJitProvision, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.SyncAttributes(); ok {
+		_spec.SetField(samlprovider.FieldSyncAttributes, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.LinkByEmail(); ok {
+		_spec.SetField(samlprovider.FieldLinkByEmail, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.DefaultDepartmentID(); ok {
+		_spec.SetField(samlprovider.FieldDefaultDepartmentID, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.AddedDefaultDepartmentID(); ok {
+		_spec.AddField(samlprovider.FieldDefaultDepartmentID, field.TypeUint64, value)
+	}
+	if _u.mutation.DefaultDepartmentIDCleared() {
+		_spec.ClearField(samlprovider.FieldDefaultDepartmentID, field.TypeUint64)
+	}
+	if value, ok := _u.mutation.DefaultRoleIds(); ok {
+		_spec.SetField(samlprovider.FieldDefaultRoleIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedDefaultRoleIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, samlprovider.FieldDefaultRoleIds, value)
+		})
+	}
+	if _u.mutation.DefaultRoleIdsCleared() {
+		_spec.ClearField(samlprovider.FieldDefaultRoleIds, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(samlprovider.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Sort(); ok {
+		_spec.SetField(samlprovider.FieldSort, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedSort(); ok {
+		_spec.AddField(samlprovider.FieldSort, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.Remark(); ok {
+		_spec.SetField(samlprovider.FieldRemark, field.TypeString, value)
+	}
+	if _u.mutation.RemarkCleared() {
+		_spec.ClearField(samlprovider.FieldRemark, field.TypeString)
+	}
+	if _u.mutation.SamlAccountsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   samlprovider.SamlAccountsTable,
+			Columns: []string{samlprovider.SamlAccountsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(samlaccount.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSamlAccountsIDs(); len(nodes) > 0 && !_u.mutation.SamlAccountsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   samlprovider.SamlAccountsTable,
+			Columns: []string{samlprovider.SamlAccountsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(samlaccount.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SamlAccountsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   samlprovider.SamlAccountsTable,
+			Columns: []string{samlprovider.SamlAccountsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(samlaccount.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &SamlProvider{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{samlprovider.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
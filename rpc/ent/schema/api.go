@@ -28,6 +28,10 @@ func (API) Fields() []ent.Field {
 			Comment("HTTP method | HTTP 请求类型"),
 		field.Bool("is_required").Default(false).
 			Comment("Whether is required | 是否必选"),
+		field.Bool("is_deprecated").Default(false).
+			Comment("The service no longer registers the API | 服务已不再登记该接口"),
+		field.Time("deprecated_at").Optional().Nillable().
+			Comment("Deprecated time | 废弃时间"),
 	}
 }
 
@@ -44,7 +48,7 @@ func (API) Edges() []ent.Edge {
 
 func (API) Indexes() []ent.Index {
 	return []ent.Index{
-		index.Fields("path", "method").
+		index.Fields("service_name", "path", "method").
 			Unique(),
 	}
 }
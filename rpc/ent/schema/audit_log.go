@@ -68,6 +68,14 @@ func (AuditLog) Fields() []ent.Field {
 			Comment("Error message if operation failed | 操作失败时的错误信息"),
 		field.JSON("metadata", map[string]interface{}{}).Optional().
 			Comment("Additional metadata in JSON format | 额外的元数据(JSON格式)"),
+		field.Uint64("seq").Optional().Nillable().Immutable().
+			Comment("Sequence number in the tenant hash chain, null for legacy records | 租户哈希链中的序号，历史记录为空"),
+		field.String("prev_hash").Optional().Immutable().
+			SchemaType(map[string]string{dialect.MySQL: "char(64)"}).
+			Comment("Hash of the previous record in the chain | 链中上一条记录的哈希"),
+		field.String("hash").Optional().Immutable().
+			SchemaType(map[string]string{dialect.MySQL: "char(64)"}).
+			Comment("SHA-256 hash of this record and prev_hash | 本条记录与 prev_hash 的 SHA-256 哈希"),
 	}
 }
 
@@ -110,6 +118,9 @@ func (AuditLog) Indexes() []ent.Index {
 		index.Fields("tenant_id", "response_status", "created_at").
 			Annotations(entsql.DescColumns("created_at")),
 
+		// 哈希链序号索引，分区表的唯一索引必须包含分区键，链的唯一性由 sys_audit_log_chains 保证
+		index.Fields("tenant_id", "seq"),
+
 		// 时间范围查询索引
 		index.Fields("tenant_id", "created_at").
 			Annotations(entsql.DescColumns("created_at")),
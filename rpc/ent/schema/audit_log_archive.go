@@ -0,0 +1,86 @@
+// Copyright 2024 The NewBee Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/coder-lulu/newbee-common/v2/orm/ent/mixins"
+)
+
+// AuditLogArchive holds the schema definition for the AuditLogArchive entity.
+type AuditLogArchive struct {
+	ent.Schema
+}
+
+// Fields of the AuditLogArchive.
+func (AuditLogArchive) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("tenant_id").Immutable().
+			Comment("Tenant ID | 租户ID"),
+		field.Uint64("from_seq").Immutable().
+			Comment("First archived sequence number | 归档的起始序号"),
+		field.Uint64("to_seq").Immutable().
+			Comment("Last archived sequence number | 归档的结束序号"),
+		field.Int("count").Immutable().
+			Comment("Number of archived records | 归档的记录数"),
+		field.Time("start_at").Immutable().
+			Comment("Creation time of the earliest record | 最早记录的创建时间"),
+		field.Time("end_at").Immutable().
+			Comment("Creation time of the latest record | 最晚记录的创建时间"),
+		field.String("store").Immutable().
+			Comment("Blob store name | 存储类型"),
+		field.String("object_key").Immutable().
+			Comment("Object key in the blob store | 存储中的对象键"),
+		field.Int64("size").Immutable().
+			Comment("Object size in bytes | 对象大小(字节)"),
+		field.String("checksum").Immutable().
+			SchemaType(map[string]string{dialect.MySQL: "char(64)"}).
+			Comment("SHA-256 checksum of the object | 对象的 SHA-256 校验和"),
+	}
+}
+
+// Mixin of the AuditLogArchive.
+func (AuditLogArchive) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.UUIDMixin{},
+	}
+}
+
+// Edges of the AuditLogArchive.
+func (AuditLogArchive) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the AuditLogArchive.
+func (AuditLogArchive) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "to_seq").Unique(),
+		index.Fields("tenant_id", "start_at", "end_at"),
+	}
+}
+
+// Annotations of the AuditLogArchive.
+func (AuditLogArchive) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.WithComments(true),
+		schema.Comment("Audit Log Archive Manifest Table | 审计日志归档清单表"),
+		entsql.Annotation{Table: "sys_audit_log_archives"},
+	}
+}
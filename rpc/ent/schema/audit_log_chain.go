@@ -0,0 +1,63 @@
+// Copyright 2024 The NewBee Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"github.com/coder-lulu/newbee-common/v2/orm/ent/mixins"
+)
+
+// AuditLogChain holds the schema definition for the AuditLogChain entity.
+type AuditLogChain struct {
+	ent.Schema
+}
+
+// Fields of the AuditLogChain.
+func (AuditLogChain) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("tenant_id").Unique().Immutable().
+			Comment("Tenant ID | 租户ID"),
+		field.Uint64("seq").
+			Comment("Sequence number of the chain head | 链头序号"),
+		field.String("hash").
+			SchemaType(map[string]string{dialect.MySQL: "char(64)"}).
+			Comment("Hash of the chain head | 链头哈希"),
+	}
+}
+
+// Mixin of the AuditLogChain.
+func (AuditLogChain) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.UUIDMixin{},
+	}
+}
+
+// Edges of the AuditLogChain.
+func (AuditLogChain) Edges() []ent.Edge {
+	return nil
+}
+
+// Annotations of the AuditLogChain.
+func (AuditLogChain) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.WithComments(true),
+		schema.Comment("Audit Log Chain Head Table | 审计日志哈希链链头表"),
+		entsql.Annotation{Table: "sys_audit_log_chains"},
+	}
+}
@@ -0,0 +1,75 @@
+// Copyright 2024 The NewBee Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/coder-lulu/newbee-common/v2/orm/ent/mixins"
+)
+
+// AuditLogCheckpoint holds the schema definition for the AuditLogCheckpoint entity.
+type AuditLogCheckpoint struct {
+	ent.Schema
+}
+
+// Fields of the AuditLogCheckpoint.
+func (AuditLogCheckpoint) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("tenant_id").Immutable().
+			Comment("Tenant ID | 租户ID"),
+		field.Uint64("seq").Immutable().
+			Comment("Sequence number of the chain head when signed | 签名时链头的序号"),
+		field.String("hash").Immutable().
+			SchemaType(map[string]string{dialect.MySQL: "char(64)"}).
+			Comment("Hash of the chain head | 链头记录的哈希"),
+		field.String("key_id").Immutable().
+			Comment("ID of the signing key | 签名密钥ID"),
+		field.String("signature").Immutable().
+			Comment("HMAC-SHA256 signature of the checkpoint | 检查点的 HMAC-SHA256 签名"),
+	}
+}
+
+// Mixin of the AuditLogCheckpoint.
+func (AuditLogCheckpoint) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.UUIDMixin{},
+	}
+}
+
+// Edges of the AuditLogCheckpoint.
+func (AuditLogCheckpoint) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the AuditLogCheckpoint.
+func (AuditLogCheckpoint) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "seq").Unique(),
+	}
+}
+
+// Annotations of the AuditLogCheckpoint.
+func (AuditLogCheckpoint) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.WithComments(true),
+		schema.Comment("Audit Log Checkpoint Table | 审计日志签名检查点表"),
+		entsql.Annotation{Table: "sys_audit_log_checkpoints"},
+	}
+}
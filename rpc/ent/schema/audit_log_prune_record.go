@@ -0,0 +1,83 @@
+// Copyright 2024 The NewBee Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/coder-lulu/newbee-common/v2/orm/ent/mixins"
+)
+
+// AuditLogPruneRecord holds the schema definition for the AuditLogPruneRecord entity.
+type AuditLogPruneRecord struct {
+	ent.Schema
+}
+
+// Fields of the AuditLogPruneRecord.
+func (AuditLogPruneRecord) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("tenant_id").Immutable().
+			Comment("Tenant ID | 租户ID"),
+		field.Uint64("from_seq").Immutable().
+			Comment("First pruned sequence number | 清理的起始序号"),
+		field.Uint64("to_seq").Immutable().
+			Comment("Last pruned sequence number | 清理的结束序号"),
+		field.Int("count").Immutable().
+			Comment("Number of pruned records | 清理的记录数"),
+		field.String("last_hash").Immutable().
+			SchemaType(map[string]string{dialect.MySQL: "char(64)"}).
+			Comment("Hash of the last pruned record, the new chain anchor | 最后一条被清理记录的哈希，作为新的链起点"),
+		field.Time("cutoff_at").Immutable().
+			Comment("Records created before this time were pruned | 清理该时间之前创建的记录"),
+		field.String("policy").Immutable().
+			Comment("Retention policy that triggered the pruning | 触发清理的保留策略"),
+		field.String("key_id").Immutable().
+			Comment("ID of the signing key | 签名密钥ID"),
+		field.String("signature").Immutable().
+			Comment("HMAC-SHA256 signature of the record | 记录的 HMAC-SHA256 签名"),
+	}
+}
+
+// Mixin of the AuditLogPruneRecord.
+func (AuditLogPruneRecord) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.UUIDMixin{},
+	}
+}
+
+// Edges of the AuditLogPruneRecord.
+func (AuditLogPruneRecord) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the AuditLogPruneRecord.
+func (AuditLogPruneRecord) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "to_seq").Unique(),
+	}
+}
+
+// Annotations of the AuditLogPruneRecord.
+func (AuditLogPruneRecord) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.WithComments(true),
+		schema.Comment("Audit Log Prune Record Table | 审计日志清理记录表"),
+		entsql.Annotation{Table: "sys_audit_log_prune_records"},
+	}
+}
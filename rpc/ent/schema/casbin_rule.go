@@ -33,30 +33,36 @@ func (CasbinRule) Fields() []ent.Field {
 		field.String("ptype").
 			Comment("策略类型: p(策略规则), g(角色继承), g2(资源继承)等"),
 
+		// p 规则: v0=主体, v1=租户ID(domain), v2=资源, v3=操作, v4=效果
+		// g 规则: v0=用户ID或子角色代码, v1=角色代码, v2=租户ID(domain)
 		field.String("v0").
 			Optional().
 			Comment("主体: 用户ID、角色代码等"),
 
 		field.String("v1").
 			Optional().
-			Comment("资源: 资源路径、API端点等"),
+			Comment("域: 租户ID；g 规则为角色代码"),
 
 		field.String("v2").
 			Optional().
-			Comment("操作: read, write, delete, create等"),
+			Comment("资源: 资源路径、API端点等；g 规则为租户ID"),
 
 		field.String("v3").
 			Optional().
-			Comment("效果: allow, deny"),
+			Comment("操作: HTTP方法、read、write等"),
 
 		field.Text("v4").
 			Optional().
-			Comment("条件表达式: JSON格式的复杂条件"),
+			Comment("效果: allow, deny"),
 
 		field.String("v5").
 			Optional().
 			Comment("优先级: 数值越大优先级越高"),
 
+		field.Text("conditions").
+			Optional().
+			Comment("ABAC条件: JSON格式，仅用于 p 规则，为空表示无条件"),
+
 		// 业务扩展字段 - 支持企业级功能
 		field.String("service_name").
 			Comment("服务名称: core, cmdb, workflow等"),
@@ -0,0 +1,73 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/coder-lulu/newbee-common/v2/orm/ent/mixins"
+)
+
+// ImpersonationSession is a time-boxed session of a super admin acting in another tenant. The session
+// requires a reason, expires after its duration and can be viewed by the admins of the target tenant.
+type ImpersonationSession struct {
+	ent.Schema
+}
+
+func (ImpersonationSession) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("user_id").MaxLen(36).
+			Comment("The super admin's user ID | 超级管理员用户ID"),
+		field.String("user_name").MaxLen(100).Optional().
+			Comment("The super admin's username | 超级管理员用户名"),
+		field.Uint64("origin_tenant_id").
+			Comment("The super admin's own tenant | 超级管理员所属租户ID"),
+		field.Uint64("target_tenant_id").
+			Comment("The impersonated tenant | 进入的租户ID"),
+		field.String("reason").MaxLen(500).
+			Comment("Reason of the access | 访问原因"),
+		field.String("ticket").MaxLen(100).Optional().
+			Comment("Support ticket | 工单号"),
+		field.String("state").MaxLen(20).
+			Comment("State: pending, active, ended, rejected | 会话状态"),
+		field.Int64("duration_seconds").
+			Comment("Requested duration in seconds | 申请的会话时长(秒)"),
+		field.String("approved_by").MaxLen(36).Optional().
+			Comment("The user who approved or rejected the request | 审批人用户ID"),
+		field.Time("approved_at").Optional().Nillable().
+			Comment("Approval time | 审批时间"),
+		field.Time("started_at").Optional().Nillable().
+			Comment("Start time | 开始时间"),
+		field.Time("expires_at").Optional().Nillable().
+			Comment("Expiry time, the session ends automatically | 到期时间，到期后自动结束"),
+		field.Time("ended_at").Optional().Nillable().
+			Comment("End time when ended manually | 手动结束时间"),
+	}
+}
+
+func (ImpersonationSession) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.IDMixin{},
+	}
+}
+
+func (ImpersonationSession) Edges() []ent.Edge {
+	return nil
+}
+
+func (ImpersonationSession) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("target_tenant_id", "created_at"),
+		index.Fields("user_id", "state"),
+	}
+}
+
+func (ImpersonationSession) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.WithComments(true),
+		schema.Comment("Tenant Impersonation Session Table | 租户模拟访问会话表"),
+		entsql.Annotation{Table: "sys_impersonation_sessions"},
+	}
+}
@@ -0,0 +1,79 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	uuid "github.com/gofrs/uuid/v5"
+
+	"github.com/coder-lulu/newbee-common/v2/orm/ent/mixins"
+)
+
+type LdapAccount struct {
+	ent.Schema
+}
+
+func (LdapAccount) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("user_id", uuid.UUID{}).
+			Comment("Associated user ID | 关联的用户ID"),
+		field.Uint64("provider_id").
+			Comment("LDAP provider ID | LDAP目录ID"),
+		field.String("external_id").MaxLen(255).
+			Comment("Unique ID of the entry, e.g. entryUUID or objectGUID | 目录条目的唯一标识"),
+		field.String("dn").MaxLen(1000).
+			Comment("DN of the entry | 目录条目DN"),
+		field.Time("synced_at").Optional().
+			Comment("Last sync time | 最近同步时间"),
+		field.Time("last_login_at").Optional().
+			Comment("Last login time | 最后登录时间"),
+		field.String("last_login_ip").MaxLen(45).Optional().
+			Comment("Last login IP address | 最后登录IP地址"),
+		field.Uint32("login_count").Default(0).
+			Comment("Login count | 登录次数"),
+	}
+}
+
+func (LdapAccount) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.IDMixin{},
+		mixins.StatusMixin{},
+		mixins.TenantMixin{},
+	}
+}
+
+func (LdapAccount) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("ldap_accounts").
+			Field("user_id").
+			Unique().
+			Required(),
+		edge.From("provider", LdapProvider.Type).
+			Ref("ldap_accounts").
+			Field("provider_id").
+			Unique().
+			Required(),
+	}
+}
+
+func (LdapAccount) Indexes() []ent.Index {
+	return []ent.Index{
+		// 同一目录下同一条目只能绑定一个本地用户
+		index.Fields("provider_id", "external_id", "tenant_id").Unique(),
+		// 同一用户在同一目录下只能有一个绑定
+		index.Fields("user_id", "provider_id", "tenant_id").Unique(),
+		index.Fields("user_id", "tenant_id"),
+	}
+}
+
+func (LdapAccount) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.WithComments(true),
+		schema.Comment("LDAP Account Binding Table | LDAP账户绑定表"),
+		entsql.Annotation{Table: "sys_ldap_accounts"},
+	}
+}
@@ -0,0 +1,63 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/coder-lulu/newbee-common/v2/orm/ent/mixins"
+)
+
+type LdapDepartment struct {
+	ent.Schema
+}
+
+func (LdapDepartment) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint64("provider_id").
+			Comment("LDAP provider ID | LDAP目录ID"),
+		field.Uint64("department_id").
+			Comment("Mirrored department ID | 同步生成的部门ID"),
+		field.String("external_id").MaxLen(255).
+			Comment("Unique ID of the organizational unit | 组织单位的唯一标识"),
+		field.String("dn").MaxLen(1000).
+			Comment("DN of the organizational unit | 组织单位DN"),
+		field.Time("synced_at").Optional().
+			Comment("Last sync time | 最近同步时间"),
+	}
+}
+
+func (LdapDepartment) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.IDMixin{},
+		mixins.TenantMixin{},
+	}
+}
+
+func (LdapDepartment) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("provider", LdapProvider.Type).
+			Ref("ldap_departments").
+			Field("provider_id").
+			Unique().
+			Required(),
+	}
+}
+
+func (LdapDepartment) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("provider_id", "external_id", "tenant_id").Unique(),
+		index.Fields("department_id", "tenant_id"),
+	}
+}
+
+func (LdapDepartment) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.WithComments(true),
+		schema.Comment("LDAP Organizational Unit Binding Table | LDAP组织单位与部门的绑定表"),
+		entsql.Annotation{Table: "sys_ldap_departments"},
+	}
+}
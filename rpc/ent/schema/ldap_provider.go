@@ -0,0 +1,120 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/coder-lulu/newbee-common/v2/orm/ent/mixins"
+)
+
+type LdapProvider struct {
+	ent.Schema
+}
+
+func (LdapProvider) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").MaxLen(50).
+			Comment("The provider's name | 目录名称"),
+		field.String("display_name").MaxLen(100).Optional().
+			Comment("Display name for UI | UI显示名称"),
+		field.String("directory_type").MaxLen(20).Default("openldap").
+			Comment("Directory type: openldap, ad | 目录类型"),
+		// 连接配置
+		field.String("url").MaxLen(500).
+			Comment("Server URL, ldap:// or ldaps:// | 服务器地址"),
+		field.Bool("start_tls").Default(false).
+			Comment("Whether to upgrade the connection with StartTLS | 是否使用StartTLS"),
+		field.Bool("insecure_skip_verify").Default(false).
+			Comment("Whether to skip the verification of the server certificate | 是否跳过证书校验"),
+		field.String("bind_dn").MaxLen(500).Optional().
+			Comment("Service account DN, empty for anonymous searches | 服务账号DN"),
+		field.Text("encrypted_bind_password").Optional().Sensitive().
+			Comment("Encrypted service account password | 加密的服务账号密码"),
+		field.String("encryption_key_id").MaxLen(100).Optional().
+			Comment("Encryption key ID | 加密密钥ID"),
+		field.String("base_dn").MaxLen(500).
+			Comment("Base DN of users | 用户查询的基础DN"),
+		// 认证配置
+		field.String("auth_mode").MaxLen(20).Default("search").
+			Comment("Authentication mode: template, search | 认证方式：DN模板或先查询后绑定"),
+		field.String("user_dn_template").MaxLen(500).Optional().
+			Comment("User DN template containing {username} | 用户DN模板"),
+		field.String("user_filter").MaxLen(1000).Optional().
+			Comment("User filter containing {username} | 用户过滤条件"),
+		// 同步配置
+		field.String("ou_base_dn").MaxLen(500).Optional().
+			Comment("Base DN of organizational units | 组织单位的基础DN"),
+		field.String("ou_filter").MaxLen(500).Optional().
+			Comment("Organizational unit filter | 组织单位过滤条件"),
+		field.String("group_base_dn").MaxLen(500).Optional().
+			Comment("Base DN of groups | 组的基础DN"),
+		field.String("group_filter").MaxLen(500).Optional().
+			Comment("Group filter | 组过滤条件"),
+		field.String("group_member_attribute").MaxLen(50).Optional().
+			Comment("Member attribute of groups | 组成员属性"),
+		field.JSON("attribute_mapping", map[string]interface{}{}).Optional().
+			Comment("Attribute mapping to users and roles | 属性到用户和角色的映射"),
+		field.Bool("login_enabled").Default(true).
+			Comment("Whether users can log in with the directory password | 是否允许目录密码登录"),
+		field.Bool("jit_provision").Default(false).
+			Comment("Whether to create users on first login | 是否在首次登录时自动创建用户"),
+		field.Bool("link_existing").Default(false).
+			Comment("Whether to link local users with the same username | 是否绑定同名的本地用户"),
+		field.Bool("sync_enabled").Default(false).
+			Comment("Whether to sync the directory periodically | 是否定时同步目录"),
+		field.Uint32("sync_interval").Default(60).
+			Comment("Sync interval in minutes | 同步间隔（分钟）"),
+		field.String("deletion_policy").MaxLen(20).Default("disable").
+			Comment("Policy for entries removed from the directory: keep, disable, delete | 目录中删除的条目处理策略"),
+		field.Uint64("root_department_id").Optional().Default(0).
+			Comment("Department under which organizational units are mirrored | 组织单位同步到的上级部门"),
+		field.Uint64("default_department_id").Optional().Default(0).
+			Comment("Department of users outside any organizational unit | 不属于任何组织单位的用户的默认部门"),
+		field.JSON("default_role_ids", []uint64{}).Optional().
+			Comment("Roles granted to provisioned users | 自动创建用户的默认角色"),
+		field.Time("last_sync_at").Optional().Nillable().
+			Comment("Last sync time | 最近同步时间"),
+		field.Bool("enabled").Default(true).
+			Comment("Whether the provider is enabled | 是否启用"),
+		field.Uint32("sort").Default(0).
+			Comment("Sort order | 排序"),
+		field.String("remark").MaxLen(200).Optional().
+			Comment("Remark | 备注"),
+	}
+}
+
+func (LdapProvider) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.IDMixin{},
+		mixins.StatusMixin{},
+		mixins.TenantMixin{},
+	}
+}
+
+func (LdapProvider) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("ldap_accounts", LdapAccount.Type),
+		edge.To("ldap_departments", LdapDepartment.Type),
+		edge.To("sync_runs", LdapSyncRun.Type),
+	}
+}
+
+func (LdapProvider) Indexes() []ent.Index {
+	return []ent.Index{
+		// 同一租户下名称唯一
+		index.Fields("name", "tenant_id").Unique(),
+		index.Fields("enabled", "status", "tenant_id"),
+	}
+}
+
+func (LdapProvider) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.WithComments(true),
+		schema.Comment("LDAP Directory Table | LDAP目录配置表"),
+		entsql.Annotation{Table: "sys_ldap_providers"},
+	}
+}
@@ -0,0 +1,76 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/coder-lulu/newbee-common/v2/orm/ent/mixins"
+
+	"github.com/coder-lulu/newbee-core/rpc/internal/ldap"
+)
+
+type LdapSyncRun struct {
+	ent.Schema
+}
+
+func (LdapSyncRun) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint64("provider_id").
+			Comment("LDAP provider ID | LDAP目录ID"),
+		field.String("trigger").MaxLen(20).
+			Comment("Trigger: manual, schedule | 触发方式"),
+		field.Bool("dry_run").Default(false).
+			Comment("Whether changes were only planned | 是否为试运行"),
+		field.String("result").MaxLen(20).
+			Comment("Result: success, failed | 执行结果"),
+		field.Text("error").Optional().
+			Comment("Error message | 错误信息"),
+		field.Time("started_at").
+			Comment("Start time | 开始时间"),
+		field.Time("finished_at").
+			Comment("Finish time | 结束时间"),
+		field.JSON("stats", ldap.SyncStats{}).Optional().
+			Comment("Change counters | 变更统计"),
+		field.JSON("changes", []ldap.SyncChange{}).Optional().
+			Comment("Changes made or planned | 已执行或计划的变更"),
+		field.JSON("conflicts", []ldap.SyncConflict{}).Optional().
+			Comment("Skipped entries | 冲突的条目"),
+		field.Bool("truncated").Default(false).
+			Comment("Whether changes or conflicts beyond the limit were dropped | 变更或冲突是否被截断"),
+	}
+}
+
+func (LdapSyncRun) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.IDMixin{},
+		mixins.TenantMixin{},
+	}
+}
+
+func (LdapSyncRun) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("provider", LdapProvider.Type).
+			Ref("sync_runs").
+			Field("provider_id").
+			Unique().
+			Required(),
+	}
+}
+
+func (LdapSyncRun) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("provider_id", "tenant_id", "created_at"),
+	}
+}
+
+func (LdapSyncRun) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.WithComments(true),
+		schema.Comment("LDAP Sync Run Table | LDAP同步记录表"),
+		entsql.Annotation{Table: "sys_ldap_sync_runs"},
+	}
+}
@@ -34,12 +34,14 @@ func (OauthAccount) Fields() []ent.Field {
 			Comment("Email from OAuth provider | 第三方平台的邮箱"),
 		field.String("provider_avatar").MaxLen(500).Optional().
 			Comment("Avatar URL from OAuth provider | 第三方平台的头像URL"),
-		field.String("access_token").MaxLen(2000).
+		field.Text("access_token").Sensitive().
 			Comment("Access token (encrypted) | 访问令牌（加密存储）"),
-		field.String("refresh_token").MaxLen(2000).Optional().
+		field.Text("refresh_token").Optional().Sensitive().
 			Comment("Refresh token (encrypted) | 刷新令牌（加密存储）"),
 		field.Time("token_expires_at").Optional().
 			Comment("Token expiration time | 令牌过期时间"),
+		field.String("encryption_key_id").MaxLen(100).Optional().
+			Comment("Encryption key ID of the tokens | 令牌的加密密钥ID"),
 		field.JSON("extra_data", map[string]interface{}{}).Optional().
 			Comment("Extra data from provider | 第三方平台的额外数据"),
 		field.Time("last_login_at").Optional().
@@ -90,6 +92,8 @@ func (OauthAccount) Indexes() []ent.Index {
 		index.Fields("status", "tenant_id"),
 		// 最后登录时间索引
 		index.Fields("last_login_at", "tenant_id"),
+		// 令牌刷新任务按过期时间扫描
+		index.Fields("token_expires_at"),
 		// 部门级数据权限查询索引
 		index.Fields("department_id", "tenant_id", "status"),
 	}
@@ -0,0 +1,69 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/coder-lulu/newbee-common/v2/orm/ent/mixins"
+)
+
+// OauthClient is an application registered to use core as its authorization server
+type OauthClient struct {
+	ent.Schema
+}
+
+func (OauthClient) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").MaxLen(100).
+			Comment("Client name shown on the consent page | 客户端名称"),
+		field.String("client_id").MaxLen(64).Unique().Immutable().
+			Comment("Client ID, unique across tenants | 客户端ID"),
+		field.String("client_secret_hash").MaxLen(64).Optional().Default("").Sensitive().
+			Comment("SHA-256 of the client secret, empty for public clients | 客户端密钥的 SHA-256 摘要"),
+		field.String("client_type").MaxLen(20).Default("confidential").
+			Comment("Client type: confidential, public | 客户端类型"),
+		field.JSON("redirect_uris", []string{}).Optional().
+			Comment("Allowed redirect URIs, matched exactly | 允许的回调地址"),
+		field.JSON("grant_types", []string{}).Optional().
+			Comment("Allowed grant types | 允许的授权类型"),
+		field.JSON("scopes", []string{}).Optional().
+			Comment("Allowed scopes | 允许的授权范围"),
+		field.Bool("trusted").Default(false).
+			Comment("First-party client which skips the consent | 是否为受信任的客户端，受信任的客户端无需用户同意"),
+		field.Uint32("access_token_ttl").Default(0).
+			Comment("Access token lifetime in seconds, 0 uses the default | 访问令牌有效期（秒）"),
+		field.Uint32("refresh_token_ttl").Default(0).
+			Comment("Refresh token lifetime in seconds, 0 uses the default | 刷新令牌有效期（秒）"),
+		field.String("remark").Optional().Default("").MaxLen(200).
+			Comment("Remark | 备注"),
+	}
+}
+
+func (OauthClient) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.IDMixin{},
+		mixins.StatusMixin{},
+		mixins.TenantMixin{},
+	}
+}
+
+func (OauthClient) Edges() []ent.Edge {
+	return nil
+}
+
+func (OauthClient) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "status"),
+	}
+}
+
+func (OauthClient) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.WithComments(true),
+		schema.Comment("OAuth Client Table | OAuth 客户端表"),
+		entsql.Annotation{Table: "sys_oauth_clients"},
+	}
+}
@@ -0,0 +1,54 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	uuid "github.com/gofrs/uuid/v5"
+
+	"github.com/coder-lulu/newbee-common/v2/orm/ent/mixins"
+)
+
+// OauthConsent records the scopes a user granted to an OAuth client
+type OauthConsent struct {
+	ent.Schema
+}
+
+func (OauthConsent) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("user_id", uuid.UUID{}).
+			Comment("User ID | 用户ID"),
+		field.String("client_id").MaxLen(64).
+			Comment("Client ID | 客户端ID"),
+		field.JSON("scopes", []string{}).Optional().
+			Comment("Granted scopes | 已同意的授权范围"),
+	}
+}
+
+func (OauthConsent) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.IDMixin{},
+		mixins.TenantMixin{},
+	}
+}
+
+func (OauthConsent) Edges() []ent.Edge {
+	return nil
+}
+
+func (OauthConsent) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "client_id", "tenant_id").Unique(),
+		index.Fields("client_id", "tenant_id"),
+	}
+}
+
+func (OauthConsent) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.WithComments(true),
+		schema.Comment("OAuth Consent Table | OAuth 用户授权记录表"),
+		entsql.Annotation{Table: "sys_oauth_consents"},
+	}
+}
@@ -70,6 +70,8 @@ func (OauthProvider) Fields() []ent.Field {
 			Comment("Failed OAuth attempts count | 失败登录次数"),
 		field.Time("last_used_at").Optional().
 			Comment("Last used timestamp | 最后使用时间"),
+		field.Uint64("template_id").Optional().
+			Comment("The system template the provider was enabled from | 来源的系统模板ID"),
 	}
 }
 
@@ -85,13 +87,19 @@ func (OauthProvider) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.To("oauth_accounts", OauthAccount.Type),
 		edge.To("oauth_sessions", OauthSession.Type),
+		edge.From("template", OauthProviderTemplate.Type).
+			Ref("oauth_providers").
+			Field("template_id").
+			Unique(),
 	}
 }
 
 func (OauthProvider) Indexes() []ent.Index {
 	return []ent.Index{
-		// 名称唯一索引（全局唯一）
-		index.Fields("name").Unique(),
+		// 名称在租户内唯一，登录时按租户和名称查找
+		index.Fields("name", "tenant_id").Unique(),
+		// 同一租户下同一模板只能启用一次
+		index.Fields("template_id", "tenant_id").Unique(),
 		// 联合唯一索引：同一租户下同一类型的Provider只能有一个
 		index.Fields("type", "tenant_id").Unique(),
 		// 启用状态索引，用于快速查询启用的Provider
@@ -0,0 +1,79 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/coder-lulu/newbee-common/v2/orm/ent/mixins"
+)
+
+// OauthProviderTemplate is a system level provider definition. Tenants enable a template with their own
+// client credentials and redirect URL, which creates an OauthProvider of the tenant.
+type OauthProviderTemplate struct {
+	ent.Schema
+}
+
+func (OauthProviderTemplate) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").MaxLen(50).
+			Comment("The template's name, used as the default provider name | 模板名称，默认作为提供商名称"),
+		field.String("display_name").MaxLen(100).Optional().
+			Comment("Display name for UI | UI显示名称"),
+		field.String("type").MaxLen(20).
+			Comment("The provider type (wechat, qq, github, google, facebook) | 提供商类型"),
+		field.String("provider_type").MaxLen(20).Default("oauth2").
+			Comment("Provider type: oauth2, oidc, saml | 认证协议类型"),
+		field.String("scopes").MaxLen(500).Optional().
+			Comment("The default scopes | 默认权限范围"),
+		field.String("auth_url").MaxLen(500).
+			Comment("OAuth authorization URL | OAuth授权URL"),
+		field.String("token_url").MaxLen(500).
+			Comment("OAuth token exchange URL | OAuth令牌交换URL"),
+		field.String("info_url").MaxLen(500).
+			Comment("User info URL | 用户信息获取URL"),
+		field.Int("auth_style").Default(2).
+			Comment("OAuth auth style (1=params, 2=header) | OAuth认证方式"),
+		field.JSON("extra_config", map[string]interface{}{}).Optional().
+			Comment("Provider specific configuration | 提供商特定配置"),
+		field.Bool("support_pkce").Default(false).
+			Comment("Whether support PKCE | 是否支持PKCE"),
+		field.String("icon_url").MaxLen(500).Optional().
+			Comment("Provider icon URL | 提供商图标URL"),
+		field.Uint32("sort").Default(0).
+			Comment("Sort order | 排序"),
+		field.String("remark").MaxLen(200).Optional().
+			Comment("Remark | 备注"),
+	}
+}
+
+func (OauthProviderTemplate) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.IDMixin{},
+		mixins.StatusMixin{},
+	}
+}
+
+func (OauthProviderTemplate) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("oauth_providers", OauthProvider.Type),
+	}
+}
+
+func (OauthProviderTemplate) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("name").Unique(),
+		index.Fields("type"),
+	}
+}
+
+func (OauthProviderTemplate) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.WithComments(true),
+		schema.Comment("OAuth Provider Template Table | OAuth提供商模板表"),
+		entsql.Annotation{Table: "sys_oauth_provider_templates"},
+	}
+}
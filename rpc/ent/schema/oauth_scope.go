@@ -0,0 +1,54 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/coder-lulu/newbee-common/v2/orm/ent/mixins"
+)
+
+// OauthScope is a scope which OAuth clients can request. The APIs of the scope are granted to the
+// Casbin subject of the scope, tokens are limited to the APIs of their scopes.
+type OauthScope struct {
+	ent.Schema
+}
+
+func (OauthScope) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").MaxLen(100).
+			Comment("Scope name, e.g. cmdb.read | 授权范围名称"),
+		field.String("description").Optional().Default("").MaxLen(200).
+			Comment("Description shown on the consent page | 描述"),
+		field.JSON("api_ids", []uint64{}).Optional().
+			Comment("APIs granted by the scope | 授权范围包含的API"),
+	}
+}
+
+func (OauthScope) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.IDMixin{},
+		mixins.StatusMixin{},
+		mixins.TenantMixin{},
+	}
+}
+
+func (OauthScope) Edges() []ent.Edge {
+	return nil
+}
+
+func (OauthScope) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("name", "tenant_id").Unique(),
+	}
+}
+
+func (OauthScope) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.WithComments(true),
+		schema.Comment("OAuth Scope Table | OAuth 授权范围表"),
+		entsql.Annotation{Table: "sys_oauth_scopes"},
+	}
+}
@@ -0,0 +1,83 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	uuid "github.com/gofrs/uuid/v5"
+
+	"github.com/coder-lulu/newbee-common/v2/orm/ent/mixins"
+)
+
+type SamlAccount struct {
+	ent.Schema
+}
+
+func (SamlAccount) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("user_id", uuid.UUID{}).
+			Comment("Associated user ID | 关联的用户ID"),
+		field.Uint64("provider_id").
+			Comment("SAML provider ID | SAML身份源ID"),
+		field.String("name_id").MaxLen(255).
+			Comment("NameID asserted by the IdP | IdP断言的NameID"),
+		field.String("name_id_format").MaxLen(255).Optional().
+			Comment("NameID format | NameID格式"),
+		field.String("session_index").MaxLen(255).Optional().
+			Comment("Session index of the last login | 最近一次登录的会话索引"),
+		field.String("email").MaxLen(255).Optional().
+			Comment("Email from the IdP | IdP提供的邮箱"),
+		field.JSON("attributes", map[string][]string{}).Optional().
+			Comment("Attributes of the last assertion | 最近一次断言的属性"),
+		field.Time("last_login_at").Optional().
+			Comment("Last login time | 最后登录时间"),
+		field.String("last_login_ip").MaxLen(45).Optional().
+			Comment("Last login IP address | 最后登录IP地址"),
+		field.Uint32("login_count").Default(0).
+			Comment("Login count | 登录次数"),
+	}
+}
+
+func (SamlAccount) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.IDMixin{},
+		mixins.StatusMixin{},
+		mixins.TenantMixin{},
+	}
+}
+
+func (SamlAccount) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("saml_accounts").
+			Field("user_id").
+			Unique().
+			Required(),
+		edge.From("provider", SamlProvider.Type).
+			Ref("saml_accounts").
+			Field("provider_id").
+			Unique().
+			Required(),
+	}
+}
+
+func (SamlAccount) Indexes() []ent.Index {
+	return []ent.Index{
+		// 同一身份源下同一 NameID 只能绑定一个本地用户
+		index.Fields("provider_id", "name_id", "tenant_id").Unique(),
+		// 同一用户在同一身份源下只能有一个绑定
+		index.Fields("user_id", "provider_id", "tenant_id").Unique(),
+		index.Fields("user_id", "tenant_id"),
+	}
+}
+
+func (SamlAccount) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.WithComments(true),
+		schema.Comment("SAML Account Binding Table | SAML账户绑定表"),
+		entsql.Annotation{Table: "sys_saml_accounts"},
+	}
+}
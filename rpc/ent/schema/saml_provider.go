@@ -0,0 +1,96 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/coder-lulu/newbee-common/v2/orm/ent/mixins"
+)
+
+type SamlProvider struct {
+	ent.Schema
+}
+
+func (SamlProvider) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").MaxLen(50).
+			Comment("The provider's name | 身份源名称"),
+		field.String("display_name").MaxLen(100).Optional().
+			Comment("Display name for UI | UI显示名称"),
+		// IdP 配置，通常通过导入元数据填充
+		field.String("idp_entity_id").MaxLen(500).
+			Comment("IdP entity ID | IdP实体ID"),
+		field.String("idp_sso_url").MaxLen(1000).
+			Comment("IdP single sign-on URL (HTTP-Redirect binding) | IdP单点登录地址"),
+		field.String("idp_slo_url").MaxLen(1000).Optional().
+			Comment("IdP single logout URL | IdP单点登出地址"),
+		field.Text("idp_certificates").
+			Comment("IdP signing certificates in PEM | IdP签名证书(PEM)"),
+		field.String("idp_metadata_url").MaxLen(1000).Optional().
+			Comment("IdP metadata URL | IdP元数据地址"),
+		// SP 配置，为空时根据配置的 BaseURL 生成
+		field.String("sp_entity_id").MaxLen(500).Optional().
+			Comment("SP entity ID | SP实体ID"),
+		field.String("acs_url").MaxLen(1000).Optional().
+			Comment("Assertion consumer service URL | 断言消费地址"),
+		field.String("name_id_format").MaxLen(255).Optional().
+			Comment("Requested NameID format | 请求的NameID格式"),
+		field.Bool("sign_authn_request").Default(true).
+			Comment("Whether to sign AuthnRequests | 是否签名认证请求"),
+		field.Bool("want_assertions_signed").Default(true).
+			Comment("Whether assertions must be signed | 是否要求断言签名"),
+		// 用户映射
+		field.JSON("attribute_mapping", map[string]interface{}{}).Optional().
+			Comment("Attribute mapping to users, departments and roles | 属性到用户、部门和角色的映射"),
+		field.Bool("jit_provision").Default(false).
+			Comment("Whether to create users on first login | 是否在首次登录时自动创建用户"),
+		field.Bool("sync_attributes").Default(false).
+			Comment("Whether to update department and roles on every login | 是否在每次登录时同步部门和角色"),
+		field.Bool("link_by_email").Default(false).
+			Comment("Whether to link the first login to an existing user with the same email | 首次登录时是否按邮箱关联已有用户"),
+		field.Uint64("default_department_id").Optional().Default(0).
+			Comment("Department of provisioned users without a mapped department | 自动创建用户的默认部门"),
+		field.JSON("default_role_ids", []uint64{}).Optional().
+			Comment("Roles granted to provisioned users | 自动创建用户的默认角色"),
+		field.Bool("enabled").Default(true).
+			Comment("Whether the provider is enabled | 是否启用"),
+		field.Uint32("sort").Default(0).
+			Comment("Sort order | 排序"),
+		field.String("remark").MaxLen(200).Optional().
+			Comment("Remark | 备注"),
+	}
+}
+
+func (SamlProvider) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.IDMixin{},
+		mixins.StatusMixin{},
+		mixins.TenantMixin{},
+	}
+}
+
+func (SamlProvider) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("saml_accounts", SamlAccount.Type),
+	}
+}
+
+func (SamlProvider) Indexes() []ent.Index {
+	return []ent.Index{
+		// 同一租户下名称唯一
+		index.Fields("name", "tenant_id").Unique(),
+		index.Fields("enabled", "status", "tenant_id"),
+	}
+}
+
+func (SamlProvider) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.WithComments(true),
+		schema.Comment("SAML Identity Provider Table | SAML身份源配置表"),
+		entsql.Annotation{Table: "sys_saml_providers"},
+	}
+}
@@ -0,0 +1,62 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/coder-lulu/newbee-common/v2/orm/ent/mixins"
+)
+
+type ScimToken struct {
+	ent.Schema
+}
+
+func (ScimToken) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").MaxLen(100).
+			Comment("Token name, usually the identity provider | 令牌名称"),
+		field.String("token_hash").MaxLen(64).Unique().Immutable().
+			Comment("SHA-256 of the token, the token itself is never stored | 令牌的 SHA-256 摘要"),
+		field.String("token_prefix").MaxLen(16).Immutable().
+			Comment("Leading characters of the token for identification | 令牌前缀"),
+		field.Time("expired_at").Optional().Nillable().
+			Comment("Expire time, never expires when empty | 过期时间"),
+		field.Time("last_used_at").Optional().Nillable().
+			Comment("Last used time | 最后使用时间"),
+		field.String("last_used_ip").Optional().Default("").
+			Comment("Last used IP | 最后使用 IP"),
+		field.JSON("role_codes", []string{}).Optional().
+			Comment("Existing roles managed as groups besides the roles created by SCIM | 可作为组管理的已有角色编码"),
+		field.String("remark").Optional().Default("").MaxLen(200).
+			Comment("Remark | 备注"),
+	}
+}
+
+func (ScimToken) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.IDMixin{},
+		mixins.StatusMixin{},
+		mixins.TenantMixin{},
+	}
+}
+
+func (ScimToken) Edges() []ent.Edge {
+	return nil
+}
+
+func (ScimToken) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "status"),
+	}
+}
+
+func (ScimToken) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.WithComments(true),
+		schema.Comment("SCIM Token Table | SCIM 令牌表"),
+		entsql.Annotation{Table: "sys_scim_tokens"},
+	}
+}
@@ -0,0 +1,75 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/coder-lulu/newbee-common/v2/orm/ent/mixins"
+
+	"github.com/coder-lulu/newbee-core/rpc/internal/initjob"
+)
+
+// TenantInitJob is a queued tenant initialization. The plugins run in background and their progress is
+// recorded here, the failed plugins can be retried. A dry run only records the plan.
+type TenantInitJob struct {
+	ent.Schema
+}
+
+func (TenantInitJob) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint64("tenant_id").
+			Comment("The tenant to initialize | 初始化的租户ID"),
+		field.String("template").MaxLen(50).Optional().
+			Comment("Initialization template name | 初始化模板名称"),
+		field.String("mode").MaxLen(20).
+			Comment("Mode: full, repair | 初始化模式"),
+		field.Bool("dry_run").Default(false).
+			Comment("Whether only the plan is made | 是否为试运行"),
+		field.String("status").MaxLen(20).
+			Comment("Status: pending, running, success, failed | 任务状态"),
+		field.String("admin_username").MaxLen(50).Optional().
+			Comment("Admin username, the password is not stored | 管理员用户名，不保存密码"),
+		field.String("admin_email").MaxLen(100).Optional().
+			Comment("Admin email | 管理员邮箱"),
+		field.Uint32("attempts").Default(0).
+			Comment("Number of runs | 执行次数"),
+		field.Text("error").Optional().
+			Comment("Error message of the last run | 最近一次执行的错误信息"),
+		field.JSON("plugins", []initjob.PluginProgress{}).Optional().
+			Comment("Progress of the plugins | 插件执行进度"),
+		field.JSON("plan", []initjob.PlanItem{}).Optional().
+			Comment("The plan of a dry run | 试运行计划"),
+		field.Time("started_at").Optional().Nillable().
+			Comment("Start time of the last run | 最近一次开始时间"),
+		field.Time("finished_at").Optional().Nillable().
+			Comment("Finish time of the last run | 最近一次结束时间"),
+	}
+}
+
+func (TenantInitJob) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.IDMixin{},
+	}
+}
+
+func (TenantInitJob) Edges() []ent.Edge {
+	return nil
+}
+
+func (TenantInitJob) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "created_at"),
+		index.Fields("status"),
+	}
+}
+
+func (TenantInitJob) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.WithComments(true),
+		schema.Comment("Tenant Initialization Job Table | 租户初始化任务表"),
+		entsql.Annotation{Table: "sys_tenant_init_jobs"},
+	}
+}
@@ -0,0 +1,63 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/coder-lulu/newbee-common/v2/orm/ent/mixins"
+)
+
+// TenantInitPlugin is a tenant initialization plugin served by another service over gRPC.
+// The services register themselves on startup, the tenant initialization jobs call them after the core plugin.
+type TenantInitPlugin struct {
+	ent.Schema
+}
+
+func (TenantInitPlugin) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").MaxLen(50).
+			Comment("Plugin name, usually the service name | 插件名称，一般为服务名"),
+		field.String("version").MaxLen(20).Default("1.0.0").
+			Comment("Plugin version | 插件版本"),
+		field.String("description").MaxLen(500).Optional().
+			Comment("Description | 插件描述"),
+		field.String("target").MaxLen(255).
+			Comment("gRPC target of the service, e.g. cmdb-rpc:9105 or etcd://127.0.0.1:2379/cmdb.rpc | 服务的 gRPC 地址"),
+		field.JSON("dependencies", []string{}).Optional().
+			Comment("Names of the plugins it depends on, the core plugin is always a dependency | 依赖的插件名称，总是依赖核心插件"),
+		field.Int32("priority").Default(100).
+			Comment("Order among the plugins without dependencies between them, the smaller the earlier | 无依赖关系的插件间的执行顺序，越小越先执行"),
+		field.Uint32("timeout").Default(60).
+			Comment("Timeout of each call in seconds | 每次调用的超时时间（秒）"),
+		field.Bool("support_rollback").Default(false).
+			Comment("Whether it can roll back, i.e. compensate when a later plugin fails | 是否支持回滚，用于后续插件失败时补偿"),
+	}
+}
+
+func (TenantInitPlugin) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.IDMixin{},
+		mixins.StatusMixin{},
+	}
+}
+
+func (TenantInitPlugin) Edges() []ent.Edge {
+	return nil
+}
+
+func (TenantInitPlugin) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("name").Unique(),
+	}
+}
+
+func (TenantInitPlugin) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.WithComments(true),
+		schema.Comment("Tenant Initialization Plugin Table | 租户初始化远程插件表"),
+		entsql.Annotation{Table: "sys_tenant_init_plugins"},
+	}
+}
@@ -0,0 +1,60 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/coder-lulu/newbee-common/v2/orm/ent/mixins"
+)
+
+// TenantInitTemplate is a named starter set of a tenant, e.g. the base template and industry overlays
+// built on it. The components are stored as the JSON of the initialization template.
+type TenantInitTemplate struct {
+	ent.Schema
+}
+
+func (TenantInitTemplate) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").MaxLen(50).
+			Comment("The template's name, passed to tenant initialization | 模板名称，初始化租户时指定"),
+		field.String("version").MaxLen(20).Default("1.0.0").
+			Comment("Template version | 模板版本"),
+		field.String("description").MaxLen(500).Optional().
+			Comment("Description | 模板描述"),
+		field.String("base_template").MaxLen(50).Optional().
+			Comment("The base template it overlays, empty means a standalone template | 基础模板名称，为空表示独立模板"),
+		field.Text("components").
+			Comment("Components as JSON | 模板组件 JSON"),
+		field.Uint32("sort").Default(0).
+			Comment("Sort order | 排序"),
+	}
+}
+
+func (TenantInitTemplate) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.IDMixin{},
+		mixins.StatusMixin{},
+	}
+}
+
+func (TenantInitTemplate) Edges() []ent.Edge {
+	return nil
+}
+
+func (TenantInitTemplate) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("name").Unique(),
+		index.Fields("base_template"),
+	}
+}
+
+func (TenantInitTemplate) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.WithComments(true),
+		schema.Comment("Tenant Initialization Template Table | 租户初始化模板表"),
+		entsql.Annotation{Table: "sys_tenant_init_templates"},
+	}
+}
@@ -34,6 +34,19 @@ func (Token) Fields() []ent.Field {
 			Comment(" Expire time | 过期时间"),
 		field.Uint64("department_id").Optional().Default(0).
 			Comment("Department ID when token was issued | Token签发时的部门ID"),
+		field.String("device").Optional().Default("").
+			Comment("Device description parsed from user agent | 登录设备"),
+		field.String("user_agent").Optional().Default("").
+			Comment("User agent | 客户端 User-Agent").
+			SchemaType(map[string]string{
+				"mysql": "varchar(512)",
+			}),
+		field.String("ip").Optional().Default("").
+			Comment("Last access IP | 最后访问 IP"),
+		field.Time("last_seen_at").Optional().Nillable().
+			Comment("Last seen time | 最后活跃时间"),
+		field.String("session_id").Optional().Default("").
+			Comment("Login session ID shared by the refreshed tokens | 登录会话ID，刷新的令牌沿用登录时的会话"),
 	}
 }
 
@@ -57,11 +70,15 @@ func (Token) Indexes() []ent.Index {
 		index.Fields("uuid", "tenant_id", "status"),
 		// 过期时间索引（用于清理过期Token）
 		index.Fields("expired_at", "tenant_id"),
+		// 会话查询索引
+		index.Fields("uuid", "session_id"),
 		// 部门级数据权限查询索引
 		index.Fields("department_id", "tenant_id", "status"),
 		// 注意：Token字段(varchar(1000))太长，无法创建完整唯一索引
 		// Token的唯一性由JWT生成算法保证（包含timestamp+UUID+signature）
-		// 查询时通过完整匹配，性能可接受
+		// 会话活跃时间按Token更新，使用前缀索引加速查询
+		index.Fields("token").
+			Annotations(entsql.Prefix(128)),
 	}
 }
 
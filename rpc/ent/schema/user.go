@@ -70,6 +70,8 @@ func (User) Edges() []ent.Edge {
 		edge.To("roles", Role.Type),
 		edge.To("oauth_accounts", OauthAccount.Type),
 		edge.To("oauth_sessions", OauthSession.Type),
+		edge.To("saml_accounts", SamlAccount.Type),
+		edge.To("ldap_accounts", LdapAccount.Type),
 	}
 }
 
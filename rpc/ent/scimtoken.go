@@ -0,0 +1,238 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/coder-lulu/newbee-core/rpc/ent/scimtoken"
+)
+
+// SCIM Token Table | SCIM 令牌表
+type ScimToken struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uint64 `json:"id,omitempty"`
+	// Create Time | 创建日期
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Update Time | 修改日期
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Status 1: normal 2: ban | 状态 1 正常 2 禁用
+	Status uint8 `json:"status,omitempty"`
+	// Tenant ID | 租户 ID
+	TenantID uint64 `json:"tenant_id,omitempty"`
+	// Token name, usually the identity provider | 令牌名称
+	Name string `json:"name,omitempty"`
+	// SHA-256 of the token, the token itself is never stored | 令牌的 SHA-256 摘要
+	TokenHash string `json:"token_hash,omitempty"`
+	// Leading characters of the token for identification | 令牌前缀
+	TokenPrefix string `json:"token_prefix,omitempty"`
+	// Expire time, never expires when empty | 过期时间
+	ExpiredAt *time.Time `json:"expired_at,omitempty"`
+	// Last used time | 最后使用时间
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	// Last used IP | 最后使用 IP
+	LastUsedIP string `json:"last_used_ip,omitempty"`
+	// Existing roles managed as groups besides the roles created by SCIM | 可作为组管理的已有角色编码
+	RoleCodes []string `json:"role_codes,omitempty"`
+	// Remark | 备注
+	Remark       string `json:"remark,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ScimToken) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case scimtoken.FieldRoleCodes:
+			values[i] = new([]byte)
+		case scimtoken.FieldID, scimtoken.FieldStatus, scimtoken.FieldTenantID:
+			values[i] = new(sql.NullInt64)
+		case scimtoken.FieldName, scimtoken.FieldTokenHash, scimtoken.FieldTokenPrefix, scimtoken.FieldLastUsedIP, scimtoken.FieldRemark:
+			values[i] = new(sql.NullString)
+		case scimtoken.FieldCreatedAt, scimtoken.FieldUpdatedAt, scimtoken.FieldExpiredAt, scimtoken.FieldLastUsedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ScimToken fields.
+func (_m *ScimToken) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case scimtoken.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = uint64(value.Int64)
+		case scimtoken.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case scimtoken.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case scimtoken.FieldStatus:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = uint8(value.Int64)
+			}
+		case scimtoken.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				_m.TenantID = uint64(value.Int64)
+			}
+		case scimtoken.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case scimtoken.FieldTokenHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field token_hash", values[i])
+			} else if value.Valid {
+				_m.TokenHash = value.String
+			}
+		case scimtoken.FieldTokenPrefix:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field token_prefix", values[i])
+			} else if value.Valid {
+				_m.TokenPrefix = value.String
+			}
+		case scimtoken.FieldExpiredAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expired_at", values[i])
+			} else if value.Valid {
+				_m.ExpiredAt = new(time.Time)
+				*_m.ExpiredAt = value.Time
+			}
+		case scimtoken.FieldLastUsedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_used_at", values[i])
+			} else if value.Valid {
+				_m.LastUsedAt = new(time.Time)
+				*_m.LastUsedAt = value.Time
+			}
+		case scimtoken.FieldLastUsedIP:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field last_used_ip", values[i])
+			} else if value.Valid {
+				_m.LastUsedIP = value.String
+			}
+		case scimtoken.FieldRoleCodes:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field role_codes", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.RoleCodes); err != nil {
+					return fmt.Errorf("unmarshal field role_codes: %w", err)
+				}
+			}
+		case scimtoken.FieldRemark:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field remark", values[i])
+			} else if value.Valid {
+				_m.Remark = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ScimToken.
+// This includes values selected through modifiers, order, etc.
+func (_m *ScimToken) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this ScimToken.
+// Note that you need to call ScimToken.Unwrap() before calling this method if this ScimToken
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ScimToken) Update() *ScimTokenUpdateOne {
+	return NewScimTokenClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ScimToken entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ScimToken) Unwrap() *ScimToken {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ScimToken is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ScimToken) String() string {
+	var builder strings.Builder
+	builder.WriteString("ScimToken(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("token_hash=")
+	builder.WriteString(_m.TokenHash)
+	builder.WriteString(", ")
+	builder.WriteString("token_prefix=")
+	builder.WriteString(_m.TokenPrefix)
+	builder.WriteString(", ")
+	if v := _m.ExpiredAt; v != nil {
+		builder.WriteString("expired_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.LastUsedAt; v != nil {
+		builder.WriteString("last_used_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("last_used_ip=")
+	builder.WriteString(_m.LastUsedIP)
+	builder.WriteString(", ")
+	builder.WriteString("role_codes=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RoleCodes))
+	builder.WriteString(", ")
+	builder.WriteString("remark=")
+	builder.WriteString(_m.Remark)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ScimTokens is a parsable slice of ScimToken.
+type ScimTokens []*ScimToken
@@ -0,0 +1,435 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/coder-lulu/newbee-core/rpc/ent/scimtoken"
+)
+
+// ScimTokenCreate is the builder for creating a ScimToken entity.
+type ScimTokenCreate struct {
+	config
+	mutation *ScimTokenMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ScimTokenCreate) SetCreatedAt(v time.Time) *ScimTokenCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ScimTokenCreate) SetNillableCreatedAt(v *time.Time) *ScimTokenCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *ScimTokenCreate) SetUpdatedAt(v time.Time) *ScimTokenCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *ScimTokenCreate) SetNillableUpdatedAt(v *time.Time) *ScimTokenCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *ScimTokenCreate) SetStatus(v uint8) *ScimTokenCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *ScimTokenCreate) SetNillableStatus(v *uint8) *ScimTokenCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *ScimTokenCreate) SetTenantID(v uint64) *ScimTokenCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_c *ScimTokenCreate) SetNillableTenantID(v *uint64) *ScimTokenCreate {
+	if v != nil {
+		_c.SetTenantID(*v)
+	}
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *ScimTokenCreate) SetName(v string) *ScimTokenCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (_c *ScimTokenCreate) SetTokenHash(v string) *ScimTokenCreate {
+	_c.mutation.SetTokenHash(v)
+	return _c
+}
+
+// SetTokenPrefix sets the "token_prefix" field.
+func (_c *ScimTokenCreate) SetTokenPrefix(v string) *ScimTokenCreate {
+	_c.mutation.SetTokenPrefix(v)
+	return _c
+}
+
+// SetExpiredAt sets the "expired_at" field.
+func (_c *ScimTokenCreate) SetExpiredAt(v time.Time) *ScimTokenCreate {
+	_c.mutation.SetExpiredAt(v)
+	return _c
+}
+
+// SetNillableExpiredAt sets the "expired_at" field if the given value is not nil.
+func (_c *ScimTokenCreate) SetNillableExpiredAt(v *time.Time) *ScimTokenCreate {
+	if v != nil {
+		_c.SetExpiredAt(*v)
+	}
+	return _c
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (_c *ScimTokenCreate) SetLastUsedAt(v time.Time) *ScimTokenCreate {
+	_c.mutation.SetLastUsedAt(v)
+	return _c
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (_c *ScimTokenCreate) SetNillableLastUsedAt(v *time.Time) *ScimTokenCreate {
+	if v != nil {
+		_c.SetLastUsedAt(*v)
+	}
+	return _c
+}
+
+// SetLastUsedIP sets the "last_used_ip" field.
+func (_c *ScimTokenCreate) SetLastUsedIP(v string) *ScimTokenCreate {
+	_c.mutation.SetLastUsedIP(v)
+	return _c
+}
+
+// SetNillableLastUsedIP sets the "last_used_ip" field if the given value is not nil.
+func (_c *ScimTokenCreate) SetNillableLastUsedIP(v *string) *ScimTokenCreate {
+	if v != nil {
+		_c.SetLastUsedIP(*v)
+	}
+	return _c
+}
+
+// SetRoleCodes sets the "role_codes" field.
+func (_c *ScimTokenCreate) SetRoleCodes(v []string) *ScimTokenCreate {
+	_c.mutation.SetRoleCodes(v)
+	return _c
+}
+
+// SetRemark sets the "remark" field.
+func (_c *ScimTokenCreate) SetRemark(v string) *ScimTokenCreate {
+	_c.mutation.SetRemark(v)
+	return _c
+}
+
+// SetNillableRemark sets the "remark" field if the given value is not nil.
+func (_c *ScimTokenCreate) SetNillableRemark(v *string) *ScimTokenCreate {
+	if v != nil {
+		_c.SetRemark(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ScimTokenCreate) SetID(v uint64) *ScimTokenCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the ScimTokenMutation object of the builder.
+func (_c *ScimTokenCreate) Mutation() *ScimTokenMutation {
+	return _c.mutation
+}
+
+// Save creates the ScimToken in the database.
+func (_c *ScimTokenCreate) Save(ctx context.Context) (*ScimToken, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ScimTokenCreate) SaveX(ctx context.Context) *ScimToken {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ScimTokenCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ScimTokenCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ScimTokenCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := scimtoken.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := scimtoken.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := scimtoken.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.TenantID(); !ok {
+		v := scimtoken.DefaultTenantID
+		_c.mutation.SetTenantID(v)
+	}
+	if _, ok := _c.mutation.LastUsedIP(); !ok {
+		v := scimtoken.DefaultLastUsedIP
+		_c.mutation.SetLastUsedIP(v)
+	}
+	if _, ok := _c.mutation.Remark(); !ok {
+		v := scimtoken.DefaultRemark
+		_c.mutation.SetRemark(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ScimTokenCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ScimToken.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ScimToken.updated_at"`)}
+	}
+	if _, ok := _c.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "ScimToken.tenant_id"`)}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "ScimToken.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := scimtoken.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "ScimToken.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.TokenHash(); !ok {
+		return &ValidationError{Name: "token_hash", err: errors.New(`ent: missing required field "ScimToken.token_hash"`)}
+	}
+	if v, ok := _c.mutation.TokenHash(); ok {
+		if err := scimtoken.TokenHashValidator(v); err != nil {
+			return &ValidationError{Name: "token_hash", err: fmt.Errorf(`ent: validator failed for field "ScimToken.token_hash": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.TokenPrefix(); !ok {
+		return &ValidationError{Name: "token_prefix", err: errors.New(`ent: missing required field "ScimToken.token_prefix"`)}
+	}
+	if v, ok := _c.mutation.TokenPrefix(); ok {
+		if err := scimtoken.TokenPrefixValidator(v); err != nil {
+			return &ValidationError{Name: "token_prefix", err: fmt.Errorf(`ent: validator failed for field "ScimToken.token_prefix": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Remark(); ok {
+		if err := scimtoken.RemarkValidator(v); err != nil {
+			return &ValidationError{Name: "remark", err: fmt.Errorf(`ent: validator failed for field "ScimToken.remark": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *ScimTokenCreate) sqlSave(ctx context.Context) (*ScimToken, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint64(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ScimTokenCreate) createSpec() (*ScimToken, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ScimToken{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(scimtoken.Table, sqlgraph.NewFieldSpec(scimtoken.FieldID, field.TypeUint64))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(scimtoken.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(scimtoken.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(scimtoken.FieldStatus, field.TypeUint8, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(scimtoken.FieldTenantID, field.TypeUint64, value)
+		_node.TenantID = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(scimtoken.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.TokenHash(); ok {
+		_spec.SetField(scimtoken.FieldTokenHash, field.TypeString, value)
+		_node.TokenHash = value
+	}
+	if value, ok := _c.mutation.TokenPrefix(); ok {
+		_spec.SetField(scimtoken.FieldTokenPrefix, field.TypeString, value)
+		_node.TokenPrefix = value
+	}
+	if value, ok := _c.mutation.ExpiredAt(); ok {
+		_spec.SetField(scimtoken.FieldExpiredAt, field.TypeTime, value)
+		_node.ExpiredAt = &value
+	}
+	if value, ok := _c.mutation.LastUsedAt(); ok {
+		_spec.SetField(scimtoken.FieldLastUsedAt, field.TypeTime, value)
+		_node.LastUsedAt = &value
+	}
+	if value, ok := _c.mutation.LastUsedIP(); ok {
+		_spec.SetField(scimtoken.FieldLastUsedIP, field.TypeString, value)
+		_node.LastUsedIP = value
+	}
+	if value, ok := _c.mutation.RoleCodes(); ok {
+		_spec.SetField(scimtoken.FieldRoleCodes, field.TypeJSON, value)
+		_node.RoleCodes = value
+	}
+	if value, ok := _c.mutation.Remark(); ok {
+		_spec.SetField(scimtoken.FieldRemark, field.TypeString, value)
+		_node.Remark = value
+	}
+	return _node, _spec
+}
+
+// ScimTokenCreateBulk is the builder for creating many ScimToken entities in bulk.
+type ScimTokenCreateBulk struct {
+	config
+	err      error
+	builders []*ScimTokenCreate
+}
+
+// Save creates the ScimToken entities in the database.
+func (_c *ScimTokenCreateBulk) Save(ctx context.Context) ([]*ScimToken, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ScimToken, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ScimTokenMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ScimTokenCreateBulk) SaveX(ctx context.Context) []*ScimToken {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ScimTokenCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ScimTokenCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,637 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/coder-lulu/newbee-core/rpc/ent/predicate"
+	"github.com/coder-lulu/newbee-core/rpc/ent/scimtoken"
+)
+
+// ScimTokenUpdate is the builder for updating ScimToken entities.
+type ScimTokenUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *ScimTokenMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the ScimTokenUpdate builder.
+func (_u *ScimTokenUpdate) Where(ps ...predicate.ScimToken) *ScimTokenUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ScimTokenUpdate) SetUpdatedAt(v time.Time) *ScimTokenUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *ScimTokenUpdate) SetStatus(v uint8) *ScimTokenUpdate {
+	_u.mutation.ResetStatus()
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *ScimTokenUpdate) SetNillableStatus(v *uint8) *ScimTokenUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// AddStatus adds value to the "status" field.
+func (_u *ScimTokenUpdate) AddStatus(v int8) *ScimTokenUpdate {
+	_u.mutation.AddStatus(v)
+	return _u
+}
+
+// ClearStatus clears the value of the "status" field.
+func (_u *ScimTokenUpdate) ClearStatus() *ScimTokenUpdate {
+	_u.mutation.ClearStatus()
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *ScimTokenUpdate) SetName(v string) *ScimTokenUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *ScimTokenUpdate) SetNillableName(v *string) *ScimTokenUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetExpiredAt sets the "expired_at" field.
+func (_u *ScimTokenUpdate) SetExpiredAt(v time.Time) *ScimTokenUpdate {
+	_u.mutation.SetExpiredAt(v)
+	return _u
+}
+
+// SetNillableExpiredAt sets the "expired_at" field if the given value is not nil.
+func (_u *ScimTokenUpdate) SetNillableExpiredAt(v *time.Time) *ScimTokenUpdate {
+	if v != nil {
+		_u.SetExpiredAt(*v)
+	}
+	return _u
+}
+
+// ClearExpiredAt clears the value of the "expired_at" field.
+func (_u *ScimTokenUpdate) ClearExpiredAt() *ScimTokenUpdate {
+	_u.mutation.ClearExpiredAt()
+	return _u
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (_u *ScimTokenUpdate) SetLastUsedAt(v time.Time) *ScimTokenUpdate {
+	_u.mutation.SetLastUsedAt(v)
+	return _u
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (_u *ScimTokenUpdate) SetNillableLastUsedAt(v *time.Time) *ScimTokenUpdate {
+	if v != nil {
+		_u.SetLastUsedAt(*v)
+	}
+	return _u
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (_u *ScimTokenUpdate) ClearLastUsedAt() *ScimTokenUpdate {
+	_u.mutation.ClearLastUsedAt()
+	return _u
+}
+
+// SetLastUsedIP sets the "last_used_ip" field.
+func (_u *ScimTokenUpdate) SetLastUsedIP(v string) *ScimTokenUpdate {
+	_u.mutation.SetLastUsedIP(v)
+	return _u
+}
+
+// SetNillableLastUsedIP sets the "last_used_ip" field if the given value is not nil.
+func (_u *ScimTokenUpdate) SetNillableLastUsedIP(v *string) *ScimTokenUpdate {
+	if v != nil {
+		_u.SetLastUsedIP(*v)
+	}
+	return _u
+}
+
+// ClearLastUsedIP clears the value of the "last_used_ip" field.
+func (_u *ScimTokenUpdate) ClearLastUsedIP() *ScimTokenUpdate {
+	_u.mutation.ClearLastUsedIP()
+	return _u
+}
+
+// SetRoleCodes sets the "role_codes" field.
+func (_u *ScimTokenUpdate) SetRoleCodes(v []string) *ScimTokenUpdate {
+	_u.mutation.SetRoleCodes(v)
+	return _u
+}
+
+// AppendRoleCodes appends value to the "role_codes" field.
+func (_u *ScimTokenUpdate) AppendRoleCodes(v []string) *ScimTokenUpdate {
+	_u.mutation.AppendRoleCodes(v)
+	return _u
+}
+
+// ClearRoleCodes clears the value of the "role_codes" field.
+func (_u *ScimTokenUpdate) ClearRoleCodes() *ScimTokenUpdate {
+	_u.mutation.ClearRoleCodes()
+	return _u
+}
+
+// SetRemark sets the "remark" field.
+func (_u *ScimTokenUpdate) SetRemark(v string) *ScimTokenUpdate {
+	_u.mutation.SetRemark(v)
+	return _u
+}
+
+// SetNillableRemark sets the "remark" field if the given value is not nil.
+func (_u *ScimTokenUpdate) SetNillableRemark(v *string) *ScimTokenUpdate {
+	if v != nil {
+		_u.SetRemark(*v)
+	}
+	return _u
+}
+
+// ClearRemark clears the value of the "remark" field.
+func (_u *ScimTokenUpdate) ClearRemark() *ScimTokenUpdate {
+	_u.mutation.ClearRemark()
+	return _u
+}
+
+// Mutation returns the ScimTokenMutation object of the builder.
+func (_u *ScimTokenUpdate) Mutation() *ScimTokenMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ScimTokenUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ScimTokenUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ScimTokenUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ScimTokenUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ScimTokenUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := scimtoken.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ScimTokenUpdate) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := scimtoken.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "ScimToken.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Remark(); ok {
+		if err := scimtoken.RemarkValidator(v); err != nil {
+			return &ValidationError{Name: "remark", err: fmt.Errorf(`ent: validator failed for field "ScimToken.remark": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ScimTokenUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ScimTokenUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *ScimTokenUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(scimtoken.Table, scimtoken.Columns, sqlgraph.NewFieldSpec(scimtoken.FieldID, field.TypeUint64))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(scimtoken.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(scimtoken.FieldStatus, field.TypeUint8, value)
+	}
+	if value, ok := _u.mutation.AddedStatus(); ok {
+		_spec.AddField(scimtoken.FieldStatus, field.TypeUint8, value)
+	}
+	if _u.mutation.StatusCleared() {
+		_spec.ClearField(scimtoken.FieldStatus, field.TypeUint8)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(scimtoken.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ExpiredAt(); ok {
+		_spec.SetField(scimtoken.FieldExpiredAt, field.TypeTime, value)
+	}
+	if _u.mutation.ExpiredAtCleared() {
+		_spec.ClearField(scimtoken.FieldExpiredAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastUsedAt(); ok {
+		_spec.SetField(scimtoken.FieldLastUsedAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastUsedAtCleared() {
+		_spec.ClearField(scimtoken.FieldLastUsedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastUsedIP(); ok {
+		_spec.SetField(scimtoken.FieldLastUsedIP, field.TypeString, value)
+	}
+	if _u.mutation.LastUsedIPCleared() {
+		_spec.ClearField(scimtoken.FieldLastUsedIP, field.TypeString)
+	}
+	if value, ok := _u.mutation.RoleCodes(); ok {
+		_spec.SetField(scimtoken.FieldRoleCodes, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedRoleCodes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, scimtoken.FieldRoleCodes, value)
+		})
+	}
+	if _u.mutation.RoleCodesCleared() {
+		_spec.ClearField(scimtoken.FieldRoleCodes, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Remark(); ok {
+		_spec.SetField(scimtoken.FieldRemark, field.TypeString, value)
+	}
+	if _u.mutation.RemarkCleared() {
+		_spec.ClearField(scimtoken.FieldRemark, field.TypeString)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{scimtoken.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ScimTokenUpdateOne is the builder for updating a single ScimToken entity.
+type ScimTokenUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *ScimTokenMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ScimTokenUpdateOne) SetUpdatedAt(v time.Time) *ScimTokenUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *ScimTokenUpdateOne) SetStatus(v uint8) *ScimTokenUpdateOne {
+	_u.mutation.ResetStatus()
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *ScimTokenUpdateOne) SetNillableStatus(v *uint8) *ScimTokenUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// AddStatus adds value to the "status" field.
+func (_u *ScimTokenUpdateOne) AddStatus(v int8) *ScimTokenUpdateOne {
+	_u.mutation.AddStatus(v)
+	return _u
+}
+
+// ClearStatus clears the value of the "status" field.
+func (_u *ScimTokenUpdateOne) ClearStatus() *ScimTokenUpdateOne {
+	_u.mutation.ClearStatus()
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *ScimTokenUpdateOne) SetName(v string) *ScimTokenUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *ScimTokenUpdateOne) SetNillableName(v *string) *ScimTokenUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetExpiredAt sets the "expired_at" field.
+func (_u *ScimTokenUpdateOne) SetExpiredAt(v time.Time) *ScimTokenUpdateOne {
+	_u.mutation.SetExpiredAt(v)
+	return _u
+}
+
+// SetNillableExpiredAt sets the "expired_at" field if the given value is not nil.
+func (_u *ScimTokenUpdateOne) SetNillableExpiredAt(v *time.Time) *ScimTokenUpdateOne {
+	if v != nil {
+		_u.SetExpiredAt(*v)
+	}
+	return _u
+}
+
+// ClearExpiredAt clears the value of the "expired_at" field.
+func (_u *ScimTokenUpdateOne) ClearExpiredAt() *ScimTokenUpdateOne {
+	_u.mutation.ClearExpiredAt()
+	return _u
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (_u *ScimTokenUpdateOne) SetLastUsedAt(v time.Time) *ScimTokenUpdateOne {
+	_u.mutation.SetLastUsedAt(v)
+	return _u
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (_u *ScimTokenUpdateOne) SetNillableLastUsedAt(v *time.Time) *ScimTokenUpdateOne {
+	if v != nil {
+		_u.SetLastUsedAt(*v)
+	}
+	return _u
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (_u *ScimTokenUpdateOne) ClearLastUsedAt() *ScimTokenUpdateOne {
+	_u.mutation.ClearLastUsedAt()
+	return _u
+}
+
+// SetLastUsedIP sets the "last_used_ip" field.
+func (_u *ScimTokenUpdateOne) SetLastUsedIP(v string) *ScimTokenUpdateOne {
+	_u.mutation.SetLastUsedIP(v)
+	return _u
+}
+
+// SetNillableLastUsedIP sets the "last_used_ip" field if the given value is not nil.
+func (_u *ScimTokenUpdateOne) SetNillableLastUsedIP(v *string) *ScimTokenUpdateOne {
+	if v != nil {
+		_u.SetLastUsedIP(*v)
+	}
+	return _u
+}
+
+// ClearLastUsedIP clears the value of the "last_used_ip" field.
+func (_u *ScimTokenUpdateOne) ClearLastUsedIP() *ScimTokenUpdateOne {
+	_u.mutation.ClearLastUsedIP()
+	return _u
+}
+
+// SetRoleCodes sets the "role_codes" field.
+func (_u *ScimTokenUpdateOne) SetRoleCodes(v []string) *ScimTokenUpdateOne {
+	_u.mutation.SetRoleCodes(v)
+	return _u
+}
+
+// AppendRoleCodes appends value to the "role_codes" field.
+func (_u *ScimTokenUpdateOne) AppendRoleCodes(v []string) *ScimTokenUpdateOne {
+	_u.mutation.AppendRoleCodes(v)
+	return _u
+}
+
+// ClearRoleCodes clears the value of the "role_codes" field.
+func (_u *ScimTokenUpdateOne) ClearRoleCodes() *ScimTokenUpdateOne {
+	_u.mutation.ClearRoleCodes()
+	return _u
+}
+
+// SetRemark sets the "remark" field.
+func (_u *ScimTokenUpdateOne) SetRemark(v string) *ScimTokenUpdateOne {
+	_u.mutation.SetRemark(v)
+	return _u
+}
+
+// SetNillableRemark sets the "remark" field if the given value is not nil.
+func (_u *ScimTokenUpdateOne) SetNillableRemark(v *string) *ScimTokenUpdateOne {
+	if v != nil {
+		_u.SetRemark(*v)
+	}
+	return _u
+}
+
+// ClearRemark clears the value of the "remark" field.
+func (_u *ScimTokenUpdateOne) ClearRemark() *ScimTokenUpdateOne {
+	_u.mutation.ClearRemark()
+	return _u
+}
+
+// Mutation returns the ScimTokenMutation object of the builder.
+func (_u *ScimTokenUpdateOne) Mutation() *ScimTokenMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the ScimTokenUpdate builder.
+func (_u *ScimTokenUpdateOne) Where(ps ...predicate.ScimToken) *ScimTokenUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ScimTokenUpdateOne) Select(field string, fields ...string) *ScimTokenUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ScimToken entity.
+func (_u *ScimTokenUpdateOne) Save(ctx context.Context) (*ScimToken, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ScimTokenUpdateOne) SaveX(ctx context.Context) *ScimToken {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ScimTokenUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ScimTokenUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ScimTokenUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := scimtoken.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ScimTokenUpdateOne) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := scimtoken.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "ScimToken.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Remark(); ok {
+		if err := scimtoken.RemarkValidator(v); err != nil {
+			return &ValidationError{Name: "remark", err: fmt.Errorf(`ent: validator failed for field "ScimToken.remark": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ScimTokenUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ScimTokenUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *ScimTokenUpdateOne) sqlSave(ctx context.Context) (_node *ScimToken, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(scimtoken.Table, scimtoken.Columns, sqlgraph.NewFieldSpec(scimtoken.FieldID, field.TypeUint64))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ScimToken.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, scimtoken.FieldID)
+		for _, f := range fields {
+			if !scimtoken.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != scimtoken.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(scimtoken.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(scimtoken.FieldStatus, field.TypeUint8, value)
+	}
+	if value, ok := _u.mutation.AddedStatus(); ok {
+		_spec.AddField(scimtoken.FieldStatus, field.TypeUint8, value)
+	}
+	if _u.mutation.StatusCleared() {
+		_spec.ClearField(scimtoken.FieldStatus, field.TypeUint8)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(scimtoken.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ExpiredAt(); ok {
+		_spec.SetField(scimtoken.FieldExpiredAt, field.TypeTime, value)
+	}
+	if _u.mutation.ExpiredAtCleared() {
+		_spec.ClearField(scimtoken.FieldExpiredAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastUsedAt(); ok {
+		_spec.SetField(scimtoken.FieldLastUsedAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastUsedAtCleared() {
+		_spec.ClearField(scimtoken.FieldLastUsedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastUsedIP(); ok {
+		_spec.SetField(scimtoken.FieldLastUsedIP, field.TypeString, value)
+	}
+	if _u.mutation.LastUsedIPCleared() {
+		_spec.ClearField(scimtoken.FieldLastUsedIP, field.TypeString)
+	}
+	if value, ok := _u.mutation.RoleCodes(); ok {
+		_spec.SetField(scimtoken.FieldRoleCodes, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedRoleCodes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, scimtoken.FieldRoleCodes, value)
+		})
+	}
+	if _u.mutation.RoleCodesCleared() {
+		_spec.ClearField(scimtoken.FieldRoleCodes, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Remark(); ok {
+		_spec.SetField(scimtoken.FieldRemark, field.TypeString, value)
+	}
+	if _u.mutation.RemarkCleared() {
+		_spec.ClearField(scimtoken.FieldRemark, field.TypeString)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &ScimToken{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{scimtoken.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -7,6 +7,8 @@ import (
 
 	"github.com/coder-lulu/newbee-core/rpc/ent/auditlog"
 	"github.com/coder-lulu/newbee-core/rpc/ent/casbinrule"
+	"github.com/coder-lulu/newbee-core/rpc/internal/initjob"
+	"github.com/coder-lulu/newbee-core/rpc/internal/ldap"
 	uuid "github.com/gofrs/uuid/v5"
 )
 
@@ -178,6 +180,54 @@ func (_m *APICreate) SetNotNilIsRequired(value *bool) *APICreate {
 	return _m
 }
 
+// set field if value's pointer is not nil.
+func (_m *APIUpdate) SetNotNilIsDeprecated(value *bool) *APIUpdate {
+	if value != nil {
+		return _m.SetIsDeprecated(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *APIUpdateOne) SetNotNilIsDeprecated(value *bool) *APIUpdateOne {
+	if value != nil {
+		return _m.SetIsDeprecated(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *APICreate) SetNotNilIsDeprecated(value *bool) *APICreate {
+	if value != nil {
+		return _m.SetIsDeprecated(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *APIUpdate) SetNotNilDeprecatedAt(value *time.Time) *APIUpdate {
+	if value != nil {
+		return _m.SetDeprecatedAt(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *APIUpdateOne) SetNotNilDeprecatedAt(value *time.Time) *APIUpdateOne {
+	if value != nil {
+		return _m.SetDeprecatedAt(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *APICreate) SetNotNilDeprecatedAt(value *time.Time) *APICreate {
+	if value != nil {
+		return _m.SetDeprecatedAt(*value)
+	}
+	return _m
+}
+
 // set field if value's pointer is not nil.
 func (_m *AuditLogUpdate) SetNotNilUpdatedAt(value *time.Time) *AuditLogUpdate {
 	if value != nil {
@@ -610,6 +660,150 @@ func (_m *AuditLogCreate) SetNotNilMetadata(value *map[string]interface{}) *Audi
 	return _m
 }
 
+// set field if value's pointer is not nil.
+func (_m *AuditLogArchiveUpdate) SetNotNilUpdatedAt(value *time.Time) *AuditLogArchiveUpdate {
+	if value != nil {
+		return _m.SetUpdatedAt(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *AuditLogArchiveUpdateOne) SetNotNilUpdatedAt(value *time.Time) *AuditLogArchiveUpdateOne {
+	if value != nil {
+		return _m.SetUpdatedAt(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *AuditLogArchiveCreate) SetNotNilUpdatedAt(value *time.Time) *AuditLogArchiveCreate {
+	if value != nil {
+		return _m.SetUpdatedAt(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *AuditLogChainUpdate) SetNotNilUpdatedAt(value *time.Time) *AuditLogChainUpdate {
+	if value != nil {
+		return _m.SetUpdatedAt(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *AuditLogChainUpdateOne) SetNotNilUpdatedAt(value *time.Time) *AuditLogChainUpdateOne {
+	if value != nil {
+		return _m.SetUpdatedAt(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *AuditLogChainCreate) SetNotNilUpdatedAt(value *time.Time) *AuditLogChainCreate {
+	if value != nil {
+		return _m.SetUpdatedAt(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *AuditLogChainUpdate) SetNotNilSeq(value *uint64) *AuditLogChainUpdate {
+	if value != nil {
+		return _m.SetSeq(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *AuditLogChainUpdateOne) SetNotNilSeq(value *uint64) *AuditLogChainUpdateOne {
+	if value != nil {
+		return _m.SetSeq(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *AuditLogChainCreate) SetNotNilSeq(value *uint64) *AuditLogChainCreate {
+	if value != nil {
+		return _m.SetSeq(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *AuditLogChainUpdate) SetNotNilHash(value *string) *AuditLogChainUpdate {
+	if value != nil {
+		return _m.SetHash(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *AuditLogChainUpdateOne) SetNotNilHash(value *string) *AuditLogChainUpdateOne {
+	if value != nil {
+		return _m.SetHash(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *AuditLogChainCreate) SetNotNilHash(value *string) *AuditLogChainCreate {
+	if value != nil {
+		return _m.SetHash(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *AuditLogCheckpointUpdate) SetNotNilUpdatedAt(value *time.Time) *AuditLogCheckpointUpdate {
+	if value != nil {
+		return _m.SetUpdatedAt(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *AuditLogCheckpointUpdateOne) SetNotNilUpdatedAt(value *time.Time) *AuditLogCheckpointUpdateOne {
+	if value != nil {
+		return _m.SetUpdatedAt(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *AuditLogCheckpointCreate) SetNotNilUpdatedAt(value *time.Time) *AuditLogCheckpointCreate {
+	if value != nil {
+		return _m.SetUpdatedAt(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *AuditLogPruneRecordUpdate) SetNotNilUpdatedAt(value *time.Time) *AuditLogPruneRecordUpdate {
+	if value != nil {
+		return _m.SetUpdatedAt(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *AuditLogPruneRecordUpdateOne) SetNotNilUpdatedAt(value *time.Time) *AuditLogPruneRecordUpdateOne {
+	if value != nil {
+		return _m.SetUpdatedAt(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *AuditLogPruneRecordCreate) SetNotNilUpdatedAt(value *time.Time) *AuditLogPruneRecordCreate {
+	if value != nil {
+		return _m.SetUpdatedAt(*value)
+	}
+	return _m
+}
+
 // set field if value's pointer is not nil.
 func (_m *CasbinRuleUpdate) SetNotNilUpdatedAt(value *time.Time) *CasbinRuleUpdate {
 	if value != nil {
@@ -826,6 +1020,30 @@ func (_m *CasbinRuleCreate) SetNotNilV5(value *string) *CasbinRuleCreate {
 	return _m
 }
 
+// set field if value's pointer is not nil.
+func (_m *CasbinRuleUpdate) SetNotNilConditions(value *string) *CasbinRuleUpdate {
+	if value != nil {
+		return _m.SetConditions(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *CasbinRuleUpdateOne) SetNotNilConditions(value *string) *CasbinRuleUpdateOne {
+	if value != nil {
+		return _m.SetConditions(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *CasbinRuleCreate) SetNotNilConditions(value *string) *CasbinRuleCreate {
+	if value != nil {
+		return _m.SetConditions(*value)
+	}
+	return _m
+}
+
 // set field if value's pointer is not nil.
 func (_m *CasbinRuleUpdate) SetNotNilServiceName(value *string) *CasbinRuleUpdate {
 	if value != nil {
@@ -1979,7 +2197,7 @@ func (_m *DictionaryDetailCreate) SetNotNilDictionaryID(value *uint64) *Dictiona
 }
 
 // set field if value's pointer is not nil.
-func (_m *MenuUpdate) SetNotNilUpdatedAt(value *time.Time) *MenuUpdate {
+func (_m *ImpersonationSessionUpdate) SetNotNilUpdatedAt(value *time.Time) *ImpersonationSessionUpdate {
 	if value != nil {
 		return _m.SetUpdatedAt(*value)
 	}
@@ -1987,7 +2205,7 @@ func (_m *MenuUpdate) SetNotNilUpdatedAt(value *time.Time) *MenuUpdate {
 }
 
 // set field if value's pointer is not nil.
-func (_m *MenuUpdateOne) SetNotNilUpdatedAt(value *time.Time) *MenuUpdateOne {
+func (_m *ImpersonationSessionUpdateOne) SetNotNilUpdatedAt(value *time.Time) *ImpersonationSessionUpdateOne {
 	if value != nil {
 		return _m.SetUpdatedAt(*value)
 	}
@@ -1995,7 +2213,7 @@ func (_m *MenuUpdateOne) SetNotNilUpdatedAt(value *time.Time) *MenuUpdateOne {
 }
 
 // set field if value's pointer is not nil.
-func (_m *MenuCreate) SetNotNilUpdatedAt(value *time.Time) *MenuCreate {
+func (_m *ImpersonationSessionCreate) SetNotNilUpdatedAt(value *time.Time) *ImpersonationSessionCreate {
 	if value != nil {
 		return _m.SetUpdatedAt(*value)
 	}
@@ -2003,1439 +2221,1447 @@ func (_m *MenuCreate) SetNotNilUpdatedAt(value *time.Time) *MenuCreate {
 }
 
 // set field if value's pointer is not nil.
-func (_m *MenuUpdate) SetNotNilSort(value *uint32) *MenuUpdate {
+func (_m *ImpersonationSessionUpdate) SetNotNilUserID(value *string) *ImpersonationSessionUpdate {
 	if value != nil {
-		return _m.SetSort(*value)
+		return _m.SetUserID(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *MenuUpdateOne) SetNotNilSort(value *uint32) *MenuUpdateOne {
+func (_m *ImpersonationSessionUpdateOne) SetNotNilUserID(value *string) *ImpersonationSessionUpdateOne {
 	if value != nil {
-		return _m.SetSort(*value)
+		return _m.SetUserID(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *MenuCreate) SetNotNilSort(value *uint32) *MenuCreate {
+func (_m *ImpersonationSessionCreate) SetNotNilUserID(value *string) *ImpersonationSessionCreate {
 	if value != nil {
-		return _m.SetSort(*value)
+		return _m.SetUserID(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *MenuUpdate) SetNotNilParentID(value *uint64) *MenuUpdate {
+func (_m *ImpersonationSessionUpdate) SetNotNilUserName(value *string) *ImpersonationSessionUpdate {
 	if value != nil {
-		return _m.SetParentID(*value)
+		return _m.SetUserName(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *MenuUpdateOne) SetNotNilParentID(value *uint64) *MenuUpdateOne {
+func (_m *ImpersonationSessionUpdateOne) SetNotNilUserName(value *string) *ImpersonationSessionUpdateOne {
 	if value != nil {
-		return _m.SetParentID(*value)
+		return _m.SetUserName(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *MenuCreate) SetNotNilParentID(value *uint64) *MenuCreate {
+func (_m *ImpersonationSessionCreate) SetNotNilUserName(value *string) *ImpersonationSessionCreate {
 	if value != nil {
-		return _m.SetParentID(*value)
+		return _m.SetUserName(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *MenuUpdate) SetNotNilMenuLevel(value *uint32) *MenuUpdate {
+func (_m *ImpersonationSessionUpdate) SetNotNilOriginTenantID(value *uint64) *ImpersonationSessionUpdate {
 	if value != nil {
-		return _m.SetMenuLevel(*value)
+		return _m.SetOriginTenantID(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *MenuUpdateOne) SetNotNilMenuLevel(value *uint32) *MenuUpdateOne {
+func (_m *ImpersonationSessionUpdateOne) SetNotNilOriginTenantID(value *uint64) *ImpersonationSessionUpdateOne {
 	if value != nil {
-		return _m.SetMenuLevel(*value)
+		return _m.SetOriginTenantID(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *MenuCreate) SetNotNilMenuLevel(value *uint32) *MenuCreate {
+func (_m *ImpersonationSessionCreate) SetNotNilOriginTenantID(value *uint64) *ImpersonationSessionCreate {
 	if value != nil {
-		return _m.SetMenuLevel(*value)
+		return _m.SetOriginTenantID(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *MenuUpdate) SetNotNilMenuType(value *uint32) *MenuUpdate {
+func (_m *ImpersonationSessionUpdate) SetNotNilTargetTenantID(value *uint64) *ImpersonationSessionUpdate {
 	if value != nil {
-		return _m.SetMenuType(*value)
+		return _m.SetTargetTenantID(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *MenuUpdateOne) SetNotNilMenuType(value *uint32) *MenuUpdateOne {
+func (_m *ImpersonationSessionUpdateOne) SetNotNilTargetTenantID(value *uint64) *ImpersonationSessionUpdateOne {
 	if value != nil {
-		return _m.SetMenuType(*value)
+		return _m.SetTargetTenantID(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *MenuCreate) SetNotNilMenuType(value *uint32) *MenuCreate {
+func (_m *ImpersonationSessionCreate) SetNotNilTargetTenantID(value *uint64) *ImpersonationSessionCreate {
 	if value != nil {
-		return _m.SetMenuType(*value)
+		return _m.SetTargetTenantID(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *MenuUpdate) SetNotNilPath(value *string) *MenuUpdate {
+func (_m *ImpersonationSessionUpdate) SetNotNilReason(value *string) *ImpersonationSessionUpdate {
 	if value != nil {
-		return _m.SetPath(*value)
+		return _m.SetReason(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *MenuUpdateOne) SetNotNilPath(value *string) *MenuUpdateOne {
+func (_m *ImpersonationSessionUpdateOne) SetNotNilReason(value *string) *ImpersonationSessionUpdateOne {
 	if value != nil {
-		return _m.SetPath(*value)
+		return _m.SetReason(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *MenuCreate) SetNotNilPath(value *string) *MenuCreate {
+func (_m *ImpersonationSessionCreate) SetNotNilReason(value *string) *ImpersonationSessionCreate {
 	if value != nil {
-		return _m.SetPath(*value)
+		return _m.SetReason(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *MenuUpdate) SetNotNilName(value *string) *MenuUpdate {
+func (_m *ImpersonationSessionUpdate) SetNotNilTicket(value *string) *ImpersonationSessionUpdate {
 	if value != nil {
-		return _m.SetName(*value)
+		return _m.SetTicket(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *MenuUpdateOne) SetNotNilName(value *string) *MenuUpdateOne {
+func (_m *ImpersonationSessionUpdateOne) SetNotNilTicket(value *string) *ImpersonationSessionUpdateOne {
 	if value != nil {
-		return _m.SetName(*value)
+		return _m.SetTicket(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *MenuCreate) SetNotNilName(value *string) *MenuCreate {
+func (_m *ImpersonationSessionCreate) SetNotNilTicket(value *string) *ImpersonationSessionCreate {
 	if value != nil {
-		return _m.SetName(*value)
+		return _m.SetTicket(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *MenuUpdate) SetNotNilRedirect(value *string) *MenuUpdate {
+func (_m *ImpersonationSessionUpdate) SetNotNilState(value *string) *ImpersonationSessionUpdate {
 	if value != nil {
-		return _m.SetRedirect(*value)
+		return _m.SetState(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *MenuUpdateOne) SetNotNilRedirect(value *string) *MenuUpdateOne {
+func (_m *ImpersonationSessionUpdateOne) SetNotNilState(value *string) *ImpersonationSessionUpdateOne {
 	if value != nil {
-		return _m.SetRedirect(*value)
+		return _m.SetState(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *MenuCreate) SetNotNilRedirect(value *string) *MenuCreate {
+func (_m *ImpersonationSessionCreate) SetNotNilState(value *string) *ImpersonationSessionCreate {
 	if value != nil {
-		return _m.SetRedirect(*value)
+		return _m.SetState(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *MenuUpdate) SetNotNilComponent(value *string) *MenuUpdate {
+func (_m *ImpersonationSessionUpdate) SetNotNilDurationSeconds(value *int64) *ImpersonationSessionUpdate {
 	if value != nil {
-		return _m.SetComponent(*value)
+		return _m.SetDurationSeconds(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *MenuUpdateOne) SetNotNilComponent(value *string) *MenuUpdateOne {
+func (_m *ImpersonationSessionUpdateOne) SetNotNilDurationSeconds(value *int64) *ImpersonationSessionUpdateOne {
 	if value != nil {
-		return _m.SetComponent(*value)
+		return _m.SetDurationSeconds(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *MenuCreate) SetNotNilComponent(value *string) *MenuCreate {
+func (_m *ImpersonationSessionCreate) SetNotNilDurationSeconds(value *int64) *ImpersonationSessionCreate {
 	if value != nil {
-		return _m.SetComponent(*value)
+		return _m.SetDurationSeconds(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *MenuUpdate) SetNotNilDisabled(value *bool) *MenuUpdate {
+func (_m *ImpersonationSessionUpdate) SetNotNilApprovedBy(value *string) *ImpersonationSessionUpdate {
 	if value != nil {
-		return _m.SetDisabled(*value)
+		return _m.SetApprovedBy(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *MenuUpdateOne) SetNotNilDisabled(value *bool) *MenuUpdateOne {
+func (_m *ImpersonationSessionUpdateOne) SetNotNilApprovedBy(value *string) *ImpersonationSessionUpdateOne {
 	if value != nil {
-		return _m.SetDisabled(*value)
+		return _m.SetApprovedBy(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *MenuCreate) SetNotNilDisabled(value *bool) *MenuCreate {
+func (_m *ImpersonationSessionCreate) SetNotNilApprovedBy(value *string) *ImpersonationSessionCreate {
 	if value != nil {
-		return _m.SetDisabled(*value)
+		return _m.SetApprovedBy(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *MenuUpdate) SetNotNilServiceName(value *string) *MenuUpdate {
+func (_m *ImpersonationSessionUpdate) SetNotNilApprovedAt(value *time.Time) *ImpersonationSessionUpdate {
 	if value != nil {
-		return _m.SetServiceName(*value)
+		return _m.SetApprovedAt(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *MenuUpdateOne) SetNotNilServiceName(value *string) *MenuUpdateOne {
+func (_m *ImpersonationSessionUpdateOne) SetNotNilApprovedAt(value *time.Time) *ImpersonationSessionUpdateOne {
 	if value != nil {
-		return _m.SetServiceName(*value)
+		return _m.SetApprovedAt(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *MenuCreate) SetNotNilServiceName(value *string) *MenuCreate {
+func (_m *ImpersonationSessionCreate) SetNotNilApprovedAt(value *time.Time) *ImpersonationSessionCreate {
 	if value != nil {
-		return _m.SetServiceName(*value)
+		return _m.SetApprovedAt(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *MenuUpdate) SetNotNilPermission(value *string) *MenuUpdate {
+func (_m *ImpersonationSessionUpdate) SetNotNilStartedAt(value *time.Time) *ImpersonationSessionUpdate {
 	if value != nil {
-		return _m.SetPermission(*value)
+		return _m.SetStartedAt(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *MenuUpdateOne) SetNotNilPermission(value *string) *MenuUpdateOne {
+func (_m *ImpersonationSessionUpdateOne) SetNotNilStartedAt(value *time.Time) *ImpersonationSessionUpdateOne {
 	if value != nil {
-		return _m.SetPermission(*value)
+		return _m.SetStartedAt(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *MenuCreate) SetNotNilPermission(value *string) *MenuCreate {
+func (_m *ImpersonationSessionCreate) SetNotNilStartedAt(value *time.Time) *ImpersonationSessionCreate {
 	if value != nil {
-		return _m.SetPermission(*value)
+		return _m.SetStartedAt(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *MenuUpdate) SetNotNilTitle(value *string) *MenuUpdate {
+func (_m *ImpersonationSessionUpdate) SetNotNilExpiresAt(value *time.Time) *ImpersonationSessionUpdate {
 	if value != nil {
-		return _m.SetTitle(*value)
+		return _m.SetExpiresAt(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *MenuUpdateOne) SetNotNilTitle(value *string) *MenuUpdateOne {
+func (_m *ImpersonationSessionUpdateOne) SetNotNilExpiresAt(value *time.Time) *ImpersonationSessionUpdateOne {
 	if value != nil {
-		return _m.SetTitle(*value)
+		return _m.SetExpiresAt(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *MenuCreate) SetNotNilTitle(value *string) *MenuCreate {
+func (_m *ImpersonationSessionCreate) SetNotNilExpiresAt(value *time.Time) *ImpersonationSessionCreate {
 	if value != nil {
-		return _m.SetTitle(*value)
+		return _m.SetExpiresAt(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *MenuUpdate) SetNotNilIcon(value *string) *MenuUpdate {
+func (_m *ImpersonationSessionUpdate) SetNotNilEndedAt(value *time.Time) *ImpersonationSessionUpdate {
 	if value != nil {
-		return _m.SetIcon(*value)
+		return _m.SetEndedAt(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *MenuUpdateOne) SetNotNilIcon(value *string) *MenuUpdateOne {
+func (_m *ImpersonationSessionUpdateOne) SetNotNilEndedAt(value *time.Time) *ImpersonationSessionUpdateOne {
 	if value != nil {
-		return _m.SetIcon(*value)
+		return _m.SetEndedAt(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *MenuCreate) SetNotNilIcon(value *string) *MenuCreate {
+func (_m *ImpersonationSessionCreate) SetNotNilEndedAt(value *time.Time) *ImpersonationSessionCreate {
 	if value != nil {
-		return _m.SetIcon(*value)
+		return _m.SetEndedAt(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *MenuUpdate) SetNotNilHideMenu(value *bool) *MenuUpdate {
+func (_m *LdapAccountUpdate) SetNotNilUpdatedAt(value *time.Time) *LdapAccountUpdate {
 	if value != nil {
-		return _m.SetHideMenu(*value)
+		return _m.SetUpdatedAt(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *MenuUpdateOne) SetNotNilHideMenu(value *bool) *MenuUpdateOne {
+func (_m *LdapAccountUpdateOne) SetNotNilUpdatedAt(value *time.Time) *LdapAccountUpdateOne {
 	if value != nil {
-		return _m.SetHideMenu(*value)
+		return _m.SetUpdatedAt(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *MenuCreate) SetNotNilHideMenu(value *bool) *MenuCreate {
+func (_m *LdapAccountCreate) SetNotNilUpdatedAt(value *time.Time) *LdapAccountCreate {
 	if value != nil {
-		return _m.SetHideMenu(*value)
+		return _m.SetUpdatedAt(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *MenuUpdate) SetNotNilHideBreadcrumb(value *bool) *MenuUpdate {
+func (_m *LdapAccountUpdate) SetNotNilStatus(value *uint8) *LdapAccountUpdate {
 	if value != nil {
-		return _m.SetHideBreadcrumb(*value)
+		return _m.SetStatus(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *MenuUpdateOne) SetNotNilHideBreadcrumb(value *bool) *MenuUpdateOne {
+func (_m *LdapAccountUpdateOne) SetNotNilStatus(value *uint8) *LdapAccountUpdateOne {
 	if value != nil {
-		return _m.SetHideBreadcrumb(*value)
+		return _m.SetStatus(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *MenuCreate) SetNotNilHideBreadcrumb(value *bool) *MenuCreate {
+func (_m *LdapAccountCreate) SetNotNilStatus(value *uint8) *LdapAccountCreate {
 	if value != nil {
-		return _m.SetHideBreadcrumb(*value)
+		return _m.SetStatus(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *MenuUpdate) SetNotNilIgnoreKeepAlive(value *bool) *MenuUpdate {
+func (_m *LdapAccountUpdate) SetNotNilUserID(value *uuid.UUID) *LdapAccountUpdate {
 	if value != nil {
-		return _m.SetIgnoreKeepAlive(*value)
+		return _m.SetUserID(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *MenuUpdateOne) SetNotNilIgnoreKeepAlive(value *bool) *MenuUpdateOne {
+func (_m *LdapAccountUpdateOne) SetNotNilUserID(value *uuid.UUID) *LdapAccountUpdateOne {
 	if value != nil {
-		return _m.SetIgnoreKeepAlive(*value)
+		return _m.SetUserID(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *MenuCreate) SetNotNilIgnoreKeepAlive(value *bool) *MenuCreate {
+func (_m *LdapAccountCreate) SetNotNilUserID(value *uuid.UUID) *LdapAccountCreate {
 	if value != nil {
-		return _m.SetIgnoreKeepAlive(*value)
+		return _m.SetUserID(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *MenuUpdate) SetNotNilHideTab(value *bool) *MenuUpdate {
+func (_m *LdapAccountUpdate) SetNotNilProviderID(value *uint64) *LdapAccountUpdate {
 	if value != nil {
-		return _m.SetHideTab(*value)
+		return _m.SetProviderID(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *MenuUpdateOne) SetNotNilHideTab(value *bool) *MenuUpdateOne {
+func (_m *LdapAccountUpdateOne) SetNotNilProviderID(value *uint64) *LdapAccountUpdateOne {
 	if value != nil {
-		return _m.SetHideTab(*value)
+		return _m.SetProviderID(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *MenuCreate) SetNotNilHideTab(value *bool) *MenuCreate {
+func (_m *LdapAccountCreate) SetNotNilProviderID(value *uint64) *LdapAccountCreate {
 	if value != nil {
-		return _m.SetHideTab(*value)
+		return _m.SetProviderID(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *MenuUpdate) SetNotNilFrameSrc(value *string) *MenuUpdate {
+func (_m *LdapAccountUpdate) SetNotNilExternalID(value *string) *LdapAccountUpdate {
 	if value != nil {
-		return _m.SetFrameSrc(*value)
+		return _m.SetExternalID(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *MenuUpdateOne) SetNotNilFrameSrc(value *string) *MenuUpdateOne {
+func (_m *LdapAccountUpdateOne) SetNotNilExternalID(value *string) *LdapAccountUpdateOne {
 	if value != nil {
-		return _m.SetFrameSrc(*value)
+		return _m.SetExternalID(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *MenuCreate) SetNotNilFrameSrc(value *string) *MenuCreate {
+func (_m *LdapAccountCreate) SetNotNilExternalID(value *string) *LdapAccountCreate {
 	if value != nil {
-		return _m.SetFrameSrc(*value)
+		return _m.SetExternalID(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *MenuUpdate) SetNotNilCarryParam(value *bool) *MenuUpdate {
+func (_m *LdapAccountUpdate) SetNotNilDn(value *string) *LdapAccountUpdate {
 	if value != nil {
-		return _m.SetCarryParam(*value)
+		return _m.SetDn(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *MenuUpdateOne) SetNotNilCarryParam(value *bool) *MenuUpdateOne {
+func (_m *LdapAccountUpdateOne) SetNotNilDn(value *string) *LdapAccountUpdateOne {
 	if value != nil {
-		return _m.SetCarryParam(*value)
+		return _m.SetDn(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *MenuCreate) SetNotNilCarryParam(value *bool) *MenuCreate {
+func (_m *LdapAccountCreate) SetNotNilDn(value *string) *LdapAccountCreate {
 	if value != nil {
-		return _m.SetCarryParam(*value)
+		return _m.SetDn(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *MenuUpdate) SetNotNilHideChildrenInMenu(value *bool) *MenuUpdate {
+func (_m *LdapAccountUpdate) SetNotNilSyncedAt(value *time.Time) *LdapAccountUpdate {
 	if value != nil {
-		return _m.SetHideChildrenInMenu(*value)
+		return _m.SetSyncedAt(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *MenuUpdateOne) SetNotNilHideChildrenInMenu(value *bool) *MenuUpdateOne {
+func (_m *LdapAccountUpdateOne) SetNotNilSyncedAt(value *time.Time) *LdapAccountUpdateOne {
 	if value != nil {
-		return _m.SetHideChildrenInMenu(*value)
+		return _m.SetSyncedAt(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *MenuCreate) SetNotNilHideChildrenInMenu(value *bool) *MenuCreate {
+func (_m *LdapAccountCreate) SetNotNilSyncedAt(value *time.Time) *LdapAccountCreate {
 	if value != nil {
-		return _m.SetHideChildrenInMenu(*value)
+		return _m.SetSyncedAt(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *MenuUpdate) SetNotNilAffix(value *bool) *MenuUpdate {
+func (_m *LdapAccountUpdate) SetNotNilLastLoginAt(value *time.Time) *LdapAccountUpdate {
 	if value != nil {
-		return _m.SetAffix(*value)
+		return _m.SetLastLoginAt(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *MenuUpdateOne) SetNotNilAffix(value *bool) *MenuUpdateOne {
+func (_m *LdapAccountUpdateOne) SetNotNilLastLoginAt(value *time.Time) *LdapAccountUpdateOne {
 	if value != nil {
-		return _m.SetAffix(*value)
+		return _m.SetLastLoginAt(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *MenuCreate) SetNotNilAffix(value *bool) *MenuCreate {
+func (_m *LdapAccountCreate) SetNotNilLastLoginAt(value *time.Time) *LdapAccountCreate {
 	if value != nil {
-		return _m.SetAffix(*value)
+		return _m.SetLastLoginAt(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *MenuUpdate) SetNotNilDynamicLevel(value *uint32) *MenuUpdate {
+func (_m *LdapAccountUpdate) SetNotNilLastLoginIP(value *string) *LdapAccountUpdate {
 	if value != nil {
-		return _m.SetDynamicLevel(*value)
+		return _m.SetLastLoginIP(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *MenuUpdateOne) SetNotNilDynamicLevel(value *uint32) *MenuUpdateOne {
+func (_m *LdapAccountUpdateOne) SetNotNilLastLoginIP(value *string) *LdapAccountUpdateOne {
 	if value != nil {
-		return _m.SetDynamicLevel(*value)
+		return _m.SetLastLoginIP(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *MenuCreate) SetNotNilDynamicLevel(value *uint32) *MenuCreate {
+func (_m *LdapAccountCreate) SetNotNilLastLoginIP(value *string) *LdapAccountCreate {
 	if value != nil {
-		return _m.SetDynamicLevel(*value)
+		return _m.SetLastLoginIP(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *MenuUpdate) SetNotNilRealPath(value *string) *MenuUpdate {
+func (_m *LdapAccountUpdate) SetNotNilLoginCount(value *uint32) *LdapAccountUpdate {
 	if value != nil {
-		return _m.SetRealPath(*value)
+		return _m.SetLoginCount(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *MenuUpdateOne) SetNotNilRealPath(value *string) *MenuUpdateOne {
+func (_m *LdapAccountUpdateOne) SetNotNilLoginCount(value *uint32) *LdapAccountUpdateOne {
 	if value != nil {
-		return _m.SetRealPath(*value)
+		return _m.SetLoginCount(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *MenuCreate) SetNotNilRealPath(value *string) *MenuCreate {
+func (_m *LdapAccountCreate) SetNotNilLoginCount(value *uint32) *LdapAccountCreate {
 	if value != nil {
-		return _m.SetRealPath(*value)
+		return _m.SetLoginCount(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *MenuUpdate) SetNotNilParams(value *string) *MenuUpdate {
+func (_m *LdapDepartmentUpdate) SetNotNilUpdatedAt(value *time.Time) *LdapDepartmentUpdate {
 	if value != nil {
-		return _m.SetParams(*value)
+		return _m.SetUpdatedAt(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *MenuUpdateOne) SetNotNilParams(value *string) *MenuUpdateOne {
+func (_m *LdapDepartmentUpdateOne) SetNotNilUpdatedAt(value *time.Time) *LdapDepartmentUpdateOne {
 	if value != nil {
-		return _m.SetParams(*value)
+		return _m.SetUpdatedAt(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *MenuCreate) SetNotNilParams(value *string) *MenuCreate {
+func (_m *LdapDepartmentCreate) SetNotNilUpdatedAt(value *time.Time) *LdapDepartmentCreate {
 	if value != nil {
-		return _m.SetParams(*value)
+		return _m.SetUpdatedAt(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthAccountUpdate) SetNotNilUpdatedAt(value *time.Time) *OauthAccountUpdate {
+func (_m *LdapDepartmentUpdate) SetNotNilProviderID(value *uint64) *LdapDepartmentUpdate {
 	if value != nil {
-		return _m.SetUpdatedAt(*value)
+		return _m.SetProviderID(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthAccountUpdateOne) SetNotNilUpdatedAt(value *time.Time) *OauthAccountUpdateOne {
+func (_m *LdapDepartmentUpdateOne) SetNotNilProviderID(value *uint64) *LdapDepartmentUpdateOne {
 	if value != nil {
-		return _m.SetUpdatedAt(*value)
+		return _m.SetProviderID(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthAccountCreate) SetNotNilUpdatedAt(value *time.Time) *OauthAccountCreate {
+func (_m *LdapDepartmentCreate) SetNotNilProviderID(value *uint64) *LdapDepartmentCreate {
 	if value != nil {
-		return _m.SetUpdatedAt(*value)
+		return _m.SetProviderID(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthAccountUpdate) SetNotNilStatus(value *uint8) *OauthAccountUpdate {
+func (_m *LdapDepartmentUpdate) SetNotNilDepartmentID(value *uint64) *LdapDepartmentUpdate {
 	if value != nil {
-		return _m.SetStatus(*value)
+		return _m.SetDepartmentID(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthAccountUpdateOne) SetNotNilStatus(value *uint8) *OauthAccountUpdateOne {
+func (_m *LdapDepartmentUpdateOne) SetNotNilDepartmentID(value *uint64) *LdapDepartmentUpdateOne {
 	if value != nil {
-		return _m.SetStatus(*value)
+		return _m.SetDepartmentID(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthAccountCreate) SetNotNilStatus(value *uint8) *OauthAccountCreate {
+func (_m *LdapDepartmentCreate) SetNotNilDepartmentID(value *uint64) *LdapDepartmentCreate {
 	if value != nil {
-		return _m.SetStatus(*value)
+		return _m.SetDepartmentID(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthAccountUpdate) SetNotNilUserID(value *uuid.UUID) *OauthAccountUpdate {
+func (_m *LdapDepartmentUpdate) SetNotNilExternalID(value *string) *LdapDepartmentUpdate {
 	if value != nil {
-		return _m.SetUserID(*value)
+		return _m.SetExternalID(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthAccountUpdateOne) SetNotNilUserID(value *uuid.UUID) *OauthAccountUpdateOne {
+func (_m *LdapDepartmentUpdateOne) SetNotNilExternalID(value *string) *LdapDepartmentUpdateOne {
 	if value != nil {
-		return _m.SetUserID(*value)
+		return _m.SetExternalID(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthAccountCreate) SetNotNilUserID(value *uuid.UUID) *OauthAccountCreate {
+func (_m *LdapDepartmentCreate) SetNotNilExternalID(value *string) *LdapDepartmentCreate {
 	if value != nil {
-		return _m.SetUserID(*value)
+		return _m.SetExternalID(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthAccountUpdate) SetNotNilProviderID(value *uint64) *OauthAccountUpdate {
+func (_m *LdapDepartmentUpdate) SetNotNilDn(value *string) *LdapDepartmentUpdate {
 	if value != nil {
-		return _m.SetProviderID(*value)
+		return _m.SetDn(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthAccountUpdateOne) SetNotNilProviderID(value *uint64) *OauthAccountUpdateOne {
+func (_m *LdapDepartmentUpdateOne) SetNotNilDn(value *string) *LdapDepartmentUpdateOne {
 	if value != nil {
-		return _m.SetProviderID(*value)
+		return _m.SetDn(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthAccountCreate) SetNotNilProviderID(value *uint64) *OauthAccountCreate {
+func (_m *LdapDepartmentCreate) SetNotNilDn(value *string) *LdapDepartmentCreate {
 	if value != nil {
-		return _m.SetProviderID(*value)
+		return _m.SetDn(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthAccountUpdate) SetNotNilProviderType(value *string) *OauthAccountUpdate {
+func (_m *LdapDepartmentUpdate) SetNotNilSyncedAt(value *time.Time) *LdapDepartmentUpdate {
 	if value != nil {
-		return _m.SetProviderType(*value)
+		return _m.SetSyncedAt(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthAccountUpdateOne) SetNotNilProviderType(value *string) *OauthAccountUpdateOne {
+func (_m *LdapDepartmentUpdateOne) SetNotNilSyncedAt(value *time.Time) *LdapDepartmentUpdateOne {
 	if value != nil {
-		return _m.SetProviderType(*value)
+		return _m.SetSyncedAt(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthAccountCreate) SetNotNilProviderType(value *string) *OauthAccountCreate {
+func (_m *LdapDepartmentCreate) SetNotNilSyncedAt(value *time.Time) *LdapDepartmentCreate {
 	if value != nil {
-		return _m.SetProviderType(*value)
+		return _m.SetSyncedAt(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthAccountUpdate) SetNotNilProviderUserID(value *string) *OauthAccountUpdate {
+func (_m *LdapProviderUpdate) SetNotNilUpdatedAt(value *time.Time) *LdapProviderUpdate {
 	if value != nil {
-		return _m.SetProviderUserID(*value)
+		return _m.SetUpdatedAt(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthAccountUpdateOne) SetNotNilProviderUserID(value *string) *OauthAccountUpdateOne {
+func (_m *LdapProviderUpdateOne) SetNotNilUpdatedAt(value *time.Time) *LdapProviderUpdateOne {
 	if value != nil {
-		return _m.SetProviderUserID(*value)
+		return _m.SetUpdatedAt(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthAccountCreate) SetNotNilProviderUserID(value *string) *OauthAccountCreate {
+func (_m *LdapProviderCreate) SetNotNilUpdatedAt(value *time.Time) *LdapProviderCreate {
 	if value != nil {
-		return _m.SetProviderUserID(*value)
+		return _m.SetUpdatedAt(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthAccountUpdate) SetNotNilProviderUsername(value *string) *OauthAccountUpdate {
+func (_m *LdapProviderUpdate) SetNotNilStatus(value *uint8) *LdapProviderUpdate {
 	if value != nil {
-		return _m.SetProviderUsername(*value)
+		return _m.SetStatus(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthAccountUpdateOne) SetNotNilProviderUsername(value *string) *OauthAccountUpdateOne {
+func (_m *LdapProviderUpdateOne) SetNotNilStatus(value *uint8) *LdapProviderUpdateOne {
 	if value != nil {
-		return _m.SetProviderUsername(*value)
+		return _m.SetStatus(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthAccountCreate) SetNotNilProviderUsername(value *string) *OauthAccountCreate {
+func (_m *LdapProviderCreate) SetNotNilStatus(value *uint8) *LdapProviderCreate {
 	if value != nil {
-		return _m.SetProviderUsername(*value)
+		return _m.SetStatus(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthAccountUpdate) SetNotNilProviderNickname(value *string) *OauthAccountUpdate {
+func (_m *LdapProviderUpdate) SetNotNilName(value *string) *LdapProviderUpdate {
 	if value != nil {
-		return _m.SetProviderNickname(*value)
+		return _m.SetName(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthAccountUpdateOne) SetNotNilProviderNickname(value *string) *OauthAccountUpdateOne {
+func (_m *LdapProviderUpdateOne) SetNotNilName(value *string) *LdapProviderUpdateOne {
 	if value != nil {
-		return _m.SetProviderNickname(*value)
+		return _m.SetName(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthAccountCreate) SetNotNilProviderNickname(value *string) *OauthAccountCreate {
+func (_m *LdapProviderCreate) SetNotNilName(value *string) *LdapProviderCreate {
 	if value != nil {
-		return _m.SetProviderNickname(*value)
+		return _m.SetName(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthAccountUpdate) SetNotNilProviderEmail(value *string) *OauthAccountUpdate {
+func (_m *LdapProviderUpdate) SetNotNilDisplayName(value *string) *LdapProviderUpdate {
 	if value != nil {
-		return _m.SetProviderEmail(*value)
+		return _m.SetDisplayName(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthAccountUpdateOne) SetNotNilProviderEmail(value *string) *OauthAccountUpdateOne {
+func (_m *LdapProviderUpdateOne) SetNotNilDisplayName(value *string) *LdapProviderUpdateOne {
 	if value != nil {
-		return _m.SetProviderEmail(*value)
+		return _m.SetDisplayName(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthAccountCreate) SetNotNilProviderEmail(value *string) *OauthAccountCreate {
+func (_m *LdapProviderCreate) SetNotNilDisplayName(value *string) *LdapProviderCreate {
 	if value != nil {
-		return _m.SetProviderEmail(*value)
+		return _m.SetDisplayName(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthAccountUpdate) SetNotNilProviderAvatar(value *string) *OauthAccountUpdate {
+func (_m *LdapProviderUpdate) SetNotNilDirectoryType(value *string) *LdapProviderUpdate {
 	if value != nil {
-		return _m.SetProviderAvatar(*value)
+		return _m.SetDirectoryType(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthAccountUpdateOne) SetNotNilProviderAvatar(value *string) *OauthAccountUpdateOne {
+func (_m *LdapProviderUpdateOne) SetNotNilDirectoryType(value *string) *LdapProviderUpdateOne {
 	if value != nil {
-		return _m.SetProviderAvatar(*value)
+		return _m.SetDirectoryType(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthAccountCreate) SetNotNilProviderAvatar(value *string) *OauthAccountCreate {
+func (_m *LdapProviderCreate) SetNotNilDirectoryType(value *string) *LdapProviderCreate {
 	if value != nil {
-		return _m.SetProviderAvatar(*value)
+		return _m.SetDirectoryType(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthAccountUpdate) SetNotNilAccessToken(value *string) *OauthAccountUpdate {
+func (_m *LdapProviderUpdate) SetNotNilURL(value *string) *LdapProviderUpdate {
 	if value != nil {
-		return _m.SetAccessToken(*value)
+		return _m.SetURL(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthAccountUpdateOne) SetNotNilAccessToken(value *string) *OauthAccountUpdateOne {
+func (_m *LdapProviderUpdateOne) SetNotNilURL(value *string) *LdapProviderUpdateOne {
 	if value != nil {
-		return _m.SetAccessToken(*value)
+		return _m.SetURL(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthAccountCreate) SetNotNilAccessToken(value *string) *OauthAccountCreate {
+func (_m *LdapProviderCreate) SetNotNilURL(value *string) *LdapProviderCreate {
 	if value != nil {
-		return _m.SetAccessToken(*value)
+		return _m.SetURL(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthAccountUpdate) SetNotNilRefreshToken(value *string) *OauthAccountUpdate {
+func (_m *LdapProviderUpdate) SetNotNilStartTLS(value *bool) *LdapProviderUpdate {
 	if value != nil {
-		return _m.SetRefreshToken(*value)
+		return _m.SetStartTLS(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthAccountUpdateOne) SetNotNilRefreshToken(value *string) *OauthAccountUpdateOne {
+func (_m *LdapProviderUpdateOne) SetNotNilStartTLS(value *bool) *LdapProviderUpdateOne {
 	if value != nil {
-		return _m.SetRefreshToken(*value)
+		return _m.SetStartTLS(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthAccountCreate) SetNotNilRefreshToken(value *string) *OauthAccountCreate {
+func (_m *LdapProviderCreate) SetNotNilStartTLS(value *bool) *LdapProviderCreate {
 	if value != nil {
-		return _m.SetRefreshToken(*value)
+		return _m.SetStartTLS(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthAccountUpdate) SetNotNilTokenExpiresAt(value *time.Time) *OauthAccountUpdate {
+func (_m *LdapProviderUpdate) SetNotNilInsecureSkipVerify(value *bool) *LdapProviderUpdate {
 	if value != nil {
-		return _m.SetTokenExpiresAt(*value)
+		return _m.SetInsecureSkipVerify(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthAccountUpdateOne) SetNotNilTokenExpiresAt(value *time.Time) *OauthAccountUpdateOne {
+func (_m *LdapProviderUpdateOne) SetNotNilInsecureSkipVerify(value *bool) *LdapProviderUpdateOne {
 	if value != nil {
-		return _m.SetTokenExpiresAt(*value)
+		return _m.SetInsecureSkipVerify(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthAccountCreate) SetNotNilTokenExpiresAt(value *time.Time) *OauthAccountCreate {
+func (_m *LdapProviderCreate) SetNotNilInsecureSkipVerify(value *bool) *LdapProviderCreate {
 	if value != nil {
-		return _m.SetTokenExpiresAt(*value)
+		return _m.SetInsecureSkipVerify(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthAccountUpdate) SetNotNilExtraData(value *map[string]interface{}) *OauthAccountUpdate {
+func (_m *LdapProviderUpdate) SetNotNilBindDn(value *string) *LdapProviderUpdate {
 	if value != nil {
-		return _m.SetExtraData(*value)
+		return _m.SetBindDn(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthAccountUpdateOne) SetNotNilExtraData(value *map[string]interface{}) *OauthAccountUpdateOne {
+func (_m *LdapProviderUpdateOne) SetNotNilBindDn(value *string) *LdapProviderUpdateOne {
 	if value != nil {
-		return _m.SetExtraData(*value)
+		return _m.SetBindDn(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthAccountCreate) SetNotNilExtraData(value *map[string]interface{}) *OauthAccountCreate {
+func (_m *LdapProviderCreate) SetNotNilBindDn(value *string) *LdapProviderCreate {
 	if value != nil {
-		return _m.SetExtraData(*value)
+		return _m.SetBindDn(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthAccountUpdate) SetNotNilLastLoginAt(value *time.Time) *OauthAccountUpdate {
+func (_m *LdapProviderUpdate) SetNotNilEncryptedBindPassword(value *string) *LdapProviderUpdate {
 	if value != nil {
-		return _m.SetLastLoginAt(*value)
+		return _m.SetEncryptedBindPassword(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthAccountUpdateOne) SetNotNilLastLoginAt(value *time.Time) *OauthAccountUpdateOne {
+func (_m *LdapProviderUpdateOne) SetNotNilEncryptedBindPassword(value *string) *LdapProviderUpdateOne {
 	if value != nil {
-		return _m.SetLastLoginAt(*value)
+		return _m.SetEncryptedBindPassword(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthAccountCreate) SetNotNilLastLoginAt(value *time.Time) *OauthAccountCreate {
+func (_m *LdapProviderCreate) SetNotNilEncryptedBindPassword(value *string) *LdapProviderCreate {
 	if value != nil {
-		return _m.SetLastLoginAt(*value)
+		return _m.SetEncryptedBindPassword(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthAccountUpdate) SetNotNilLastLoginIP(value *string) *OauthAccountUpdate {
+func (_m *LdapProviderUpdate) SetNotNilEncryptionKeyID(value *string) *LdapProviderUpdate {
 	if value != nil {
-		return _m.SetLastLoginIP(*value)
+		return _m.SetEncryptionKeyID(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthAccountUpdateOne) SetNotNilLastLoginIP(value *string) *OauthAccountUpdateOne {
+func (_m *LdapProviderUpdateOne) SetNotNilEncryptionKeyID(value *string) *LdapProviderUpdateOne {
 	if value != nil {
-		return _m.SetLastLoginIP(*value)
+		return _m.SetEncryptionKeyID(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthAccountCreate) SetNotNilLastLoginIP(value *string) *OauthAccountCreate {
+func (_m *LdapProviderCreate) SetNotNilEncryptionKeyID(value *string) *LdapProviderCreate {
 	if value != nil {
-		return _m.SetLastLoginIP(*value)
+		return _m.SetEncryptionKeyID(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthAccountUpdate) SetNotNilLoginCount(value *uint32) *OauthAccountUpdate {
+func (_m *LdapProviderUpdate) SetNotNilBaseDn(value *string) *LdapProviderUpdate {
 	if value != nil {
-		return _m.SetLoginCount(*value)
+		return _m.SetBaseDn(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthAccountUpdateOne) SetNotNilLoginCount(value *uint32) *OauthAccountUpdateOne {
+func (_m *LdapProviderUpdateOne) SetNotNilBaseDn(value *string) *LdapProviderUpdateOne {
 	if value != nil {
-		return _m.SetLoginCount(*value)
+		return _m.SetBaseDn(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthAccountCreate) SetNotNilLoginCount(value *uint32) *OauthAccountCreate {
+func (_m *LdapProviderCreate) SetNotNilBaseDn(value *string) *LdapProviderCreate {
 	if value != nil {
-		return _m.SetLoginCount(*value)
+		return _m.SetBaseDn(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthAccountUpdate) SetNotNilDepartmentID(value *uint64) *OauthAccountUpdate {
+func (_m *LdapProviderUpdate) SetNotNilAuthMode(value *string) *LdapProviderUpdate {
 	if value != nil {
-		return _m.SetDepartmentID(*value)
+		return _m.SetAuthMode(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthAccountUpdateOne) SetNotNilDepartmentID(value *uint64) *OauthAccountUpdateOne {
+func (_m *LdapProviderUpdateOne) SetNotNilAuthMode(value *string) *LdapProviderUpdateOne {
 	if value != nil {
-		return _m.SetDepartmentID(*value)
+		return _m.SetAuthMode(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthAccountCreate) SetNotNilDepartmentID(value *uint64) *OauthAccountCreate {
+func (_m *LdapProviderCreate) SetNotNilAuthMode(value *string) *LdapProviderCreate {
 	if value != nil {
-		return _m.SetDepartmentID(*value)
+		return _m.SetAuthMode(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthProviderUpdate) SetNotNilUpdatedAt(value *time.Time) *OauthProviderUpdate {
+func (_m *LdapProviderUpdate) SetNotNilUserDnTemplate(value *string) *LdapProviderUpdate {
 	if value != nil {
-		return _m.SetUpdatedAt(*value)
+		return _m.SetUserDnTemplate(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthProviderUpdateOne) SetNotNilUpdatedAt(value *time.Time) *OauthProviderUpdateOne {
+func (_m *LdapProviderUpdateOne) SetNotNilUserDnTemplate(value *string) *LdapProviderUpdateOne {
 	if value != nil {
-		return _m.SetUpdatedAt(*value)
+		return _m.SetUserDnTemplate(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthProviderCreate) SetNotNilUpdatedAt(value *time.Time) *OauthProviderCreate {
+func (_m *LdapProviderCreate) SetNotNilUserDnTemplate(value *string) *LdapProviderCreate {
 	if value != nil {
-		return _m.SetUpdatedAt(*value)
+		return _m.SetUserDnTemplate(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthProviderUpdate) SetNotNilStatus(value *uint8) *OauthProviderUpdate {
+func (_m *LdapProviderUpdate) SetNotNilUserFilter(value *string) *LdapProviderUpdate {
 	if value != nil {
-		return _m.SetStatus(*value)
+		return _m.SetUserFilter(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthProviderUpdateOne) SetNotNilStatus(value *uint8) *OauthProviderUpdateOne {
+func (_m *LdapProviderUpdateOne) SetNotNilUserFilter(value *string) *LdapProviderUpdateOne {
 	if value != nil {
-		return _m.SetStatus(*value)
+		return _m.SetUserFilter(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthProviderCreate) SetNotNilStatus(value *uint8) *OauthProviderCreate {
+func (_m *LdapProviderCreate) SetNotNilUserFilter(value *string) *LdapProviderCreate {
 	if value != nil {
-		return _m.SetStatus(*value)
+		return _m.SetUserFilter(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthProviderUpdate) SetNotNilName(value *string) *OauthProviderUpdate {
+func (_m *LdapProviderUpdate) SetNotNilOuBaseDn(value *string) *LdapProviderUpdate {
 	if value != nil {
-		return _m.SetName(*value)
+		return _m.SetOuBaseDn(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthProviderUpdateOne) SetNotNilName(value *string) *OauthProviderUpdateOne {
+func (_m *LdapProviderUpdateOne) SetNotNilOuBaseDn(value *string) *LdapProviderUpdateOne {
 	if value != nil {
-		return _m.SetName(*value)
+		return _m.SetOuBaseDn(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthProviderCreate) SetNotNilName(value *string) *OauthProviderCreate {
+func (_m *LdapProviderCreate) SetNotNilOuBaseDn(value *string) *LdapProviderCreate {
 	if value != nil {
-		return _m.SetName(*value)
+		return _m.SetOuBaseDn(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthProviderUpdate) SetNotNilDisplayName(value *string) *OauthProviderUpdate {
+func (_m *LdapProviderUpdate) SetNotNilOuFilter(value *string) *LdapProviderUpdate {
 	if value != nil {
-		return _m.SetDisplayName(*value)
+		return _m.SetOuFilter(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthProviderUpdateOne) SetNotNilDisplayName(value *string) *OauthProviderUpdateOne {
+func (_m *LdapProviderUpdateOne) SetNotNilOuFilter(value *string) *LdapProviderUpdateOne {
 	if value != nil {
-		return _m.SetDisplayName(*value)
+		return _m.SetOuFilter(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthProviderCreate) SetNotNilDisplayName(value *string) *OauthProviderCreate {
+func (_m *LdapProviderCreate) SetNotNilOuFilter(value *string) *LdapProviderCreate {
 	if value != nil {
-		return _m.SetDisplayName(*value)
+		return _m.SetOuFilter(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthProviderUpdate) SetNotNilType(value *string) *OauthProviderUpdate {
+func (_m *LdapProviderUpdate) SetNotNilGroupBaseDn(value *string) *LdapProviderUpdate {
 	if value != nil {
-		return _m.SetType(*value)
+		return _m.SetGroupBaseDn(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthProviderUpdateOne) SetNotNilType(value *string) *OauthProviderUpdateOne {
+func (_m *LdapProviderUpdateOne) SetNotNilGroupBaseDn(value *string) *LdapProviderUpdateOne {
 	if value != nil {
-		return _m.SetType(*value)
+		return _m.SetGroupBaseDn(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthProviderCreate) SetNotNilType(value *string) *OauthProviderCreate {
+func (_m *LdapProviderCreate) SetNotNilGroupBaseDn(value *string) *LdapProviderCreate {
 	if value != nil {
-		return _m.SetType(*value)
+		return _m.SetGroupBaseDn(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthProviderUpdate) SetNotNilProviderType(value *string) *OauthProviderUpdate {
+func (_m *LdapProviderUpdate) SetNotNilGroupFilter(value *string) *LdapProviderUpdate {
 	if value != nil {
-		return _m.SetProviderType(*value)
+		return _m.SetGroupFilter(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthProviderUpdateOne) SetNotNilProviderType(value *string) *OauthProviderUpdateOne {
+func (_m *LdapProviderUpdateOne) SetNotNilGroupFilter(value *string) *LdapProviderUpdateOne {
 	if value != nil {
-		return _m.SetProviderType(*value)
+		return _m.SetGroupFilter(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthProviderCreate) SetNotNilProviderType(value *string) *OauthProviderCreate {
+func (_m *LdapProviderCreate) SetNotNilGroupFilter(value *string) *LdapProviderCreate {
 	if value != nil {
-		return _m.SetProviderType(*value)
+		return _m.SetGroupFilter(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthProviderUpdate) SetNotNilClientID(value *string) *OauthProviderUpdate {
+func (_m *LdapProviderUpdate) SetNotNilGroupMemberAttribute(value *string) *LdapProviderUpdate {
 	if value != nil {
-		return _m.SetClientID(*value)
+		return _m.SetGroupMemberAttribute(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthProviderUpdateOne) SetNotNilClientID(value *string) *OauthProviderUpdateOne {
+func (_m *LdapProviderUpdateOne) SetNotNilGroupMemberAttribute(value *string) *LdapProviderUpdateOne {
 	if value != nil {
-		return _m.SetClientID(*value)
+		return _m.SetGroupMemberAttribute(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthProviderCreate) SetNotNilClientID(value *string) *OauthProviderCreate {
+func (_m *LdapProviderCreate) SetNotNilGroupMemberAttribute(value *string) *LdapProviderCreate {
 	if value != nil {
-		return _m.SetClientID(*value)
+		return _m.SetGroupMemberAttribute(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthProviderUpdate) SetNotNilClientSecret(value *string) *OauthProviderUpdate {
+func (_m *LdapProviderUpdate) SetNotNilAttributeMapping(value *map[string]interface{}) *LdapProviderUpdate {
 	if value != nil {
-		return _m.SetClientSecret(*value)
+		return _m.SetAttributeMapping(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthProviderUpdateOne) SetNotNilClientSecret(value *string) *OauthProviderUpdateOne {
+func (_m *LdapProviderUpdateOne) SetNotNilAttributeMapping(value *map[string]interface{}) *LdapProviderUpdateOne {
 	if value != nil {
-		return _m.SetClientSecret(*value)
+		return _m.SetAttributeMapping(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthProviderCreate) SetNotNilClientSecret(value *string) *OauthProviderCreate {
+func (_m *LdapProviderCreate) SetNotNilAttributeMapping(value *map[string]interface{}) *LdapProviderCreate {
 	if value != nil {
-		return _m.SetClientSecret(*value)
+		return _m.SetAttributeMapping(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthProviderUpdate) SetNotNilEncryptedSecret(value *string) *OauthProviderUpdate {
+func (_m *LdapProviderUpdate) SetNotNilLoginEnabled(value *bool) *LdapProviderUpdate {
 	if value != nil {
-		return _m.SetEncryptedSecret(*value)
+		return _m.SetLoginEnabled(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthProviderUpdateOne) SetNotNilEncryptedSecret(value *string) *OauthProviderUpdateOne {
+func (_m *LdapProviderUpdateOne) SetNotNilLoginEnabled(value *bool) *LdapProviderUpdateOne {
 	if value != nil {
-		return _m.SetEncryptedSecret(*value)
+		return _m.SetLoginEnabled(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthProviderCreate) SetNotNilEncryptedSecret(value *string) *OauthProviderCreate {
+func (_m *LdapProviderCreate) SetNotNilLoginEnabled(value *bool) *LdapProviderCreate {
 	if value != nil {
-		return _m.SetEncryptedSecret(*value)
+		return _m.SetLoginEnabled(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthProviderUpdate) SetNotNilEncryptionKeyID(value *string) *OauthProviderUpdate {
+func (_m *LdapProviderUpdate) SetNotNilJitProvision(value *bool) *LdapProviderUpdate {
 	if value != nil {
-		return _m.SetEncryptionKeyID(*value)
+		return _m.SetJitProvision(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthProviderUpdateOne) SetNotNilEncryptionKeyID(value *string) *OauthProviderUpdateOne {
+func (_m *LdapProviderUpdateOne) SetNotNilJitProvision(value *bool) *LdapProviderUpdateOne {
 	if value != nil {
-		return _m.SetEncryptionKeyID(*value)
+		return _m.SetJitProvision(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthProviderCreate) SetNotNilEncryptionKeyID(value *string) *OauthProviderCreate {
+func (_m *LdapProviderCreate) SetNotNilJitProvision(value *bool) *LdapProviderCreate {
 	if value != nil {
-		return _m.SetEncryptionKeyID(*value)
+		return _m.SetJitProvision(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthProviderUpdate) SetNotNilRedirectURL(value *string) *OauthProviderUpdate {
+func (_m *LdapProviderUpdate) SetNotNilLinkExisting(value *bool) *LdapProviderUpdate {
 	if value != nil {
-		return _m.SetRedirectURL(*value)
+		return _m.SetLinkExisting(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthProviderUpdateOne) SetNotNilRedirectURL(value *string) *OauthProviderUpdateOne {
+func (_m *LdapProviderUpdateOne) SetNotNilLinkExisting(value *bool) *LdapProviderUpdateOne {
 	if value != nil {
-		return _m.SetRedirectURL(*value)
+		return _m.SetLinkExisting(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthProviderCreate) SetNotNilRedirectURL(value *string) *OauthProviderCreate {
+func (_m *LdapProviderCreate) SetNotNilLinkExisting(value *bool) *LdapProviderCreate {
 	if value != nil {
-		return _m.SetRedirectURL(*value)
+		return _m.SetLinkExisting(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthProviderUpdate) SetNotNilScopes(value *string) *OauthProviderUpdate {
+func (_m *LdapProviderUpdate) SetNotNilSyncEnabled(value *bool) *LdapProviderUpdate {
 	if value != nil {
-		return _m.SetScopes(*value)
+		return _m.SetSyncEnabled(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthProviderUpdateOne) SetNotNilScopes(value *string) *OauthProviderUpdateOne {
+func (_m *LdapProviderUpdateOne) SetNotNilSyncEnabled(value *bool) *LdapProviderUpdateOne {
 	if value != nil {
-		return _m.SetScopes(*value)
+		return _m.SetSyncEnabled(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthProviderCreate) SetNotNilScopes(value *string) *OauthProviderCreate {
+func (_m *LdapProviderCreate) SetNotNilSyncEnabled(value *bool) *LdapProviderCreate {
 	if value != nil {
-		return _m.SetScopes(*value)
+		return _m.SetSyncEnabled(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthProviderUpdate) SetNotNilAuthURL(value *string) *OauthProviderUpdate {
+func (_m *LdapProviderUpdate) SetNotNilSyncInterval(value *uint32) *LdapProviderUpdate {
 	if value != nil {
-		return _m.SetAuthURL(*value)
+		return _m.SetSyncInterval(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthProviderUpdateOne) SetNotNilAuthURL(value *string) *OauthProviderUpdateOne {
+func (_m *LdapProviderUpdateOne) SetNotNilSyncInterval(value *uint32) *LdapProviderUpdateOne {
 	if value != nil {
-		return _m.SetAuthURL(*value)
+		return _m.SetSyncInterval(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthProviderCreate) SetNotNilAuthURL(value *string) *OauthProviderCreate {
+func (_m *LdapProviderCreate) SetNotNilSyncInterval(value *uint32) *LdapProviderCreate {
 	if value != nil {
-		return _m.SetAuthURL(*value)
+		return _m.SetSyncInterval(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthProviderUpdate) SetNotNilTokenURL(value *string) *OauthProviderUpdate {
+func (_m *LdapProviderUpdate) SetNotNilDeletionPolicy(value *string) *LdapProviderUpdate {
 	if value != nil {
-		return _m.SetTokenURL(*value)
+		return _m.SetDeletionPolicy(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthProviderUpdateOne) SetNotNilTokenURL(value *string) *OauthProviderUpdateOne {
+func (_m *LdapProviderUpdateOne) SetNotNilDeletionPolicy(value *string) *LdapProviderUpdateOne {
 	if value != nil {
-		return _m.SetTokenURL(*value)
+		return _m.SetDeletionPolicy(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthProviderCreate) SetNotNilTokenURL(value *string) *OauthProviderCreate {
+func (_m *LdapProviderCreate) SetNotNilDeletionPolicy(value *string) *LdapProviderCreate {
 	if value != nil {
-		return _m.SetTokenURL(*value)
+		return _m.SetDeletionPolicy(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthProviderUpdate) SetNotNilInfoURL(value *string) *OauthProviderUpdate {
+func (_m *LdapProviderUpdate) SetNotNilRootDepartmentID(value *uint64) *LdapProviderUpdate {
 	if value != nil {
-		return _m.SetInfoURL(*value)
+		return _m.SetRootDepartmentID(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthProviderUpdateOne) SetNotNilInfoURL(value *string) *OauthProviderUpdateOne {
+func (_m *LdapProviderUpdateOne) SetNotNilRootDepartmentID(value *uint64) *LdapProviderUpdateOne {
 	if value != nil {
-		return _m.SetInfoURL(*value)
+		return _m.SetRootDepartmentID(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthProviderCreate) SetNotNilInfoURL(value *string) *OauthProviderCreate {
+func (_m *LdapProviderCreate) SetNotNilRootDepartmentID(value *uint64) *LdapProviderCreate {
 	if value != nil {
-		return _m.SetInfoURL(*value)
+		return _m.SetRootDepartmentID(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthProviderUpdate) SetNotNilAuthStyle(value *int) *OauthProviderUpdate {
+func (_m *LdapProviderUpdate) SetNotNilDefaultDepartmentID(value *uint64) *LdapProviderUpdate {
 	if value != nil {
-		return _m.SetAuthStyle(*value)
+		return _m.SetDefaultDepartmentID(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthProviderUpdateOne) SetNotNilAuthStyle(value *int) *OauthProviderUpdateOne {
+func (_m *LdapProviderUpdateOne) SetNotNilDefaultDepartmentID(value *uint64) *LdapProviderUpdateOne {
 	if value != nil {
-		return _m.SetAuthStyle(*value)
+		return _m.SetDefaultDepartmentID(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthProviderCreate) SetNotNilAuthStyle(value *int) *OauthProviderCreate {
+func (_m *LdapProviderCreate) SetNotNilDefaultDepartmentID(value *uint64) *LdapProviderCreate {
 	if value != nil {
-		return _m.SetAuthStyle(*value)
+		return _m.SetDefaultDepartmentID(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthProviderUpdate) SetNotNilExtraConfig(value *map[string]interface{}) *OauthProviderUpdate {
+func (_m *LdapProviderUpdate) SetNotNilDefaultRoleIds(value []uint64) *LdapProviderUpdate {
 	if value != nil {
-		return _m.SetExtraConfig(*value)
+		return _m.SetDefaultRoleIds(value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthProviderUpdateOne) SetNotNilExtraConfig(value *map[string]interface{}) *OauthProviderUpdateOne {
+func (_m *LdapProviderUpdateOne) SetNotNilDefaultRoleIds(value []uint64) *LdapProviderUpdateOne {
 	if value != nil {
-		return _m.SetExtraConfig(*value)
+		return _m.SetDefaultRoleIds(value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthProviderCreate) SetNotNilExtraConfig(value *map[string]interface{}) *OauthProviderCreate {
+func (_m *LdapProviderCreate) SetNotNilDefaultRoleIds(value []uint64) *LdapProviderCreate {
 	if value != nil {
-		return _m.SetExtraConfig(*value)
+		return _m.SetDefaultRoleIds(value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthProviderUpdate) SetNotNilEnabled(value *bool) *OauthProviderUpdate {
+func (_m *LdapProviderUpdate) SetNotNilLastSyncAt(value *time.Time) *LdapProviderUpdate {
 	if value != nil {
-		return _m.SetEnabled(*value)
+		return _m.SetLastSyncAt(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthProviderUpdateOne) SetNotNilEnabled(value *bool) *OauthProviderUpdateOne {
+func (_m *LdapProviderUpdateOne) SetNotNilLastSyncAt(value *time.Time) *LdapProviderUpdateOne {
 	if value != nil {
-		return _m.SetEnabled(*value)
+		return _m.SetLastSyncAt(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthProviderCreate) SetNotNilEnabled(value *bool) *OauthProviderCreate {
+func (_m *LdapProviderCreate) SetNotNilLastSyncAt(value *time.Time) *LdapProviderCreate {
+	if value != nil {
+		return _m.SetLastSyncAt(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *LdapProviderUpdate) SetNotNilEnabled(value *bool) *LdapProviderUpdate {
 	if value != nil {
 		return _m.SetEnabled(*value)
 	}
@@ -3443,23 +3669,23 @@ func (_m *OauthProviderCreate) SetNotNilEnabled(value *bool) *OauthProviderCreat
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthProviderUpdate) SetNotNilSort(value *uint32) *OauthProviderUpdate {
+func (_m *LdapProviderUpdateOne) SetNotNilEnabled(value *bool) *LdapProviderUpdateOne {
 	if value != nil {
-		return _m.SetSort(*value)
+		return _m.SetEnabled(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthProviderUpdateOne) SetNotNilSort(value *uint32) *OauthProviderUpdateOne {
+func (_m *LdapProviderCreate) SetNotNilEnabled(value *bool) *LdapProviderCreate {
 	if value != nil {
-		return _m.SetSort(*value)
+		return _m.SetEnabled(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthProviderCreate) SetNotNilSort(value *uint32) *OauthProviderCreate {
+func (_m *LdapProviderUpdate) SetNotNilSort(value *uint32) *LdapProviderUpdate {
 	if value != nil {
 		return _m.SetSort(*value)
 	}
@@ -3467,23 +3693,23 @@ func (_m *OauthProviderCreate) SetNotNilSort(value *uint32) *OauthProviderCreate
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthProviderUpdate) SetNotNilRemark(value *string) *OauthProviderUpdate {
+func (_m *LdapProviderUpdateOne) SetNotNilSort(value *uint32) *LdapProviderUpdateOne {
 	if value != nil {
-		return _m.SetRemark(*value)
+		return _m.SetSort(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthProviderUpdateOne) SetNotNilRemark(value *string) *OauthProviderUpdateOne {
+func (_m *LdapProviderCreate) SetNotNilSort(value *uint32) *LdapProviderCreate {
 	if value != nil {
-		return _m.SetRemark(*value)
+		return _m.SetSort(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthProviderCreate) SetNotNilRemark(value *string) *OauthProviderCreate {
+func (_m *LdapProviderUpdate) SetNotNilRemark(value *string) *LdapProviderUpdate {
 	if value != nil {
 		return _m.SetRemark(*value)
 	}
@@ -3491,1039 +3717,5591 @@ func (_m *OauthProviderCreate) SetNotNilRemark(value *string) *OauthProviderCrea
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthProviderUpdate) SetNotNilSupportPkce(value *bool) *OauthProviderUpdate {
+func (_m *LdapProviderUpdateOne) SetNotNilRemark(value *string) *LdapProviderUpdateOne {
 	if value != nil {
-		return _m.SetSupportPkce(*value)
+		return _m.SetRemark(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthProviderUpdateOne) SetNotNilSupportPkce(value *bool) *OauthProviderUpdateOne {
+func (_m *LdapProviderCreate) SetNotNilRemark(value *string) *LdapProviderCreate {
 	if value != nil {
-		return _m.SetSupportPkce(*value)
+		return _m.SetRemark(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthProviderCreate) SetNotNilSupportPkce(value *bool) *OauthProviderCreate {
+func (_m *LdapSyncRunUpdate) SetNotNilUpdatedAt(value *time.Time) *LdapSyncRunUpdate {
 	if value != nil {
-		return _m.SetSupportPkce(*value)
+		return _m.SetUpdatedAt(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthProviderUpdate) SetNotNilIconURL(value *string) *OauthProviderUpdate {
+func (_m *LdapSyncRunUpdateOne) SetNotNilUpdatedAt(value *time.Time) *LdapSyncRunUpdateOne {
 	if value != nil {
-		return _m.SetIconURL(*value)
+		return _m.SetUpdatedAt(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthProviderUpdateOne) SetNotNilIconURL(value *string) *OauthProviderUpdateOne {
+func (_m *LdapSyncRunCreate) SetNotNilUpdatedAt(value *time.Time) *LdapSyncRunCreate {
 	if value != nil {
-		return _m.SetIconURL(*value)
+		return _m.SetUpdatedAt(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthProviderCreate) SetNotNilIconURL(value *string) *OauthProviderCreate {
+func (_m *LdapSyncRunUpdate) SetNotNilProviderID(value *uint64) *LdapSyncRunUpdate {
 	if value != nil {
-		return _m.SetIconURL(*value)
+		return _m.SetProviderID(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthProviderUpdate) SetNotNilCacheTTL(value *int) *OauthProviderUpdate {
+func (_m *LdapSyncRunUpdateOne) SetNotNilProviderID(value *uint64) *LdapSyncRunUpdateOne {
 	if value != nil {
-		return _m.SetCacheTTL(*value)
+		return _m.SetProviderID(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthProviderUpdateOne) SetNotNilCacheTTL(value *int) *OauthProviderUpdateOne {
+func (_m *LdapSyncRunCreate) SetNotNilProviderID(value *uint64) *LdapSyncRunCreate {
 	if value != nil {
-		return _m.SetCacheTTL(*value)
+		return _m.SetProviderID(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthProviderCreate) SetNotNilCacheTTL(value *int) *OauthProviderCreate {
+func (_m *LdapSyncRunUpdate) SetNotNilTrigger(value *string) *LdapSyncRunUpdate {
 	if value != nil {
-		return _m.SetCacheTTL(*value)
+		return _m.SetTrigger(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthProviderUpdate) SetNotNilWebhookURL(value *string) *OauthProviderUpdate {
+func (_m *LdapSyncRunUpdateOne) SetNotNilTrigger(value *string) *LdapSyncRunUpdateOne {
 	if value != nil {
-		return _m.SetWebhookURL(*value)
+		return _m.SetTrigger(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthProviderUpdateOne) SetNotNilWebhookURL(value *string) *OauthProviderUpdateOne {
+func (_m *LdapSyncRunCreate) SetNotNilTrigger(value *string) *LdapSyncRunCreate {
 	if value != nil {
-		return _m.SetWebhookURL(*value)
+		return _m.SetTrigger(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthProviderCreate) SetNotNilWebhookURL(value *string) *OauthProviderCreate {
+func (_m *LdapSyncRunUpdate) SetNotNilDryRun(value *bool) *LdapSyncRunUpdate {
 	if value != nil {
-		return _m.SetWebhookURL(*value)
+		return _m.SetDryRun(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthProviderUpdate) SetNotNilSuccessCount(value *int) *OauthProviderUpdate {
+func (_m *LdapSyncRunUpdateOne) SetNotNilDryRun(value *bool) *LdapSyncRunUpdateOne {
 	if value != nil {
-		return _m.SetSuccessCount(*value)
+		return _m.SetDryRun(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthProviderUpdateOne) SetNotNilSuccessCount(value *int) *OauthProviderUpdateOne {
+func (_m *LdapSyncRunCreate) SetNotNilDryRun(value *bool) *LdapSyncRunCreate {
 	if value != nil {
-		return _m.SetSuccessCount(*value)
+		return _m.SetDryRun(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthProviderCreate) SetNotNilSuccessCount(value *int) *OauthProviderCreate {
+func (_m *LdapSyncRunUpdate) SetNotNilResult(value *string) *LdapSyncRunUpdate {
 	if value != nil {
-		return _m.SetSuccessCount(*value)
+		return _m.SetResult(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthProviderUpdate) SetNotNilFailureCount(value *int) *OauthProviderUpdate {
+func (_m *LdapSyncRunUpdateOne) SetNotNilResult(value *string) *LdapSyncRunUpdateOne {
 	if value != nil {
-		return _m.SetFailureCount(*value)
+		return _m.SetResult(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthProviderUpdateOne) SetNotNilFailureCount(value *int) *OauthProviderUpdateOne {
+func (_m *LdapSyncRunCreate) SetNotNilResult(value *string) *LdapSyncRunCreate {
 	if value != nil {
-		return _m.SetFailureCount(*value)
+		return _m.SetResult(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthProviderCreate) SetNotNilFailureCount(value *int) *OauthProviderCreate {
+func (_m *LdapSyncRunUpdate) SetNotNilError(value *string) *LdapSyncRunUpdate {
 	if value != nil {
-		return _m.SetFailureCount(*value)
+		return _m.SetError(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthProviderUpdate) SetNotNilLastUsedAt(value *time.Time) *OauthProviderUpdate {
+func (_m *LdapSyncRunUpdateOne) SetNotNilError(value *string) *LdapSyncRunUpdateOne {
 	if value != nil {
-		return _m.SetLastUsedAt(*value)
+		return _m.SetError(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthProviderUpdateOne) SetNotNilLastUsedAt(value *time.Time) *OauthProviderUpdateOne {
+func (_m *LdapSyncRunCreate) SetNotNilError(value *string) *LdapSyncRunCreate {
 	if value != nil {
-		return _m.SetLastUsedAt(*value)
+		return _m.SetError(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthProviderCreate) SetNotNilLastUsedAt(value *time.Time) *OauthProviderCreate {
+func (_m *LdapSyncRunUpdate) SetNotNilStartedAt(value *time.Time) *LdapSyncRunUpdate {
 	if value != nil {
-		return _m.SetLastUsedAt(*value)
+		return _m.SetStartedAt(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthSessionUpdate) SetNotNilUpdatedAt(value *time.Time) *OauthSessionUpdate {
+func (_m *LdapSyncRunUpdateOne) SetNotNilStartedAt(value *time.Time) *LdapSyncRunUpdateOne {
 	if value != nil {
-		return _m.SetUpdatedAt(*value)
+		return _m.SetStartedAt(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthSessionUpdateOne) SetNotNilUpdatedAt(value *time.Time) *OauthSessionUpdateOne {
+func (_m *LdapSyncRunCreate) SetNotNilStartedAt(value *time.Time) *LdapSyncRunCreate {
 	if value != nil {
-		return _m.SetUpdatedAt(*value)
+		return _m.SetStartedAt(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthSessionCreate) SetNotNilUpdatedAt(value *time.Time) *OauthSessionCreate {
+func (_m *LdapSyncRunUpdate) SetNotNilFinishedAt(value *time.Time) *LdapSyncRunUpdate {
 	if value != nil {
-		return _m.SetUpdatedAt(*value)
+		return _m.SetFinishedAt(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthSessionUpdate) SetNotNilStatus(value *uint8) *OauthSessionUpdate {
+func (_m *LdapSyncRunUpdateOne) SetNotNilFinishedAt(value *time.Time) *LdapSyncRunUpdateOne {
 	if value != nil {
-		return _m.SetStatus(*value)
+		return _m.SetFinishedAt(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthSessionUpdateOne) SetNotNilStatus(value *uint8) *OauthSessionUpdateOne {
+func (_m *LdapSyncRunCreate) SetNotNilFinishedAt(value *time.Time) *LdapSyncRunCreate {
 	if value != nil {
-		return _m.SetStatus(*value)
+		return _m.SetFinishedAt(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthSessionCreate) SetNotNilStatus(value *uint8) *OauthSessionCreate {
+func (_m *LdapSyncRunUpdate) SetNotNilStats(value *ldap.SyncStats) *LdapSyncRunUpdate {
 	if value != nil {
-		return _m.SetStatus(*value)
+		return _m.SetStats(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthSessionUpdate) SetNotNilSessionID(value *string) *OauthSessionUpdate {
+func (_m *LdapSyncRunUpdateOne) SetNotNilStats(value *ldap.SyncStats) *LdapSyncRunUpdateOne {
 	if value != nil {
-		return _m.SetSessionID(*value)
+		return _m.SetStats(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthSessionUpdateOne) SetNotNilSessionID(value *string) *OauthSessionUpdateOne {
+func (_m *LdapSyncRunCreate) SetNotNilStats(value *ldap.SyncStats) *LdapSyncRunCreate {
 	if value != nil {
-		return _m.SetSessionID(*value)
+		return _m.SetStats(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthSessionCreate) SetNotNilSessionID(value *string) *OauthSessionCreate {
+func (_m *LdapSyncRunUpdate) SetNotNilChanges(value []ldap.SyncChange) *LdapSyncRunUpdate {
 	if value != nil {
-		return _m.SetSessionID(*value)
+		return _m.SetChanges(value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthSessionUpdate) SetNotNilState(value *string) *OauthSessionUpdate {
+func (_m *LdapSyncRunUpdateOne) SetNotNilChanges(value []ldap.SyncChange) *LdapSyncRunUpdateOne {
 	if value != nil {
-		return _m.SetState(*value)
+		return _m.SetChanges(value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthSessionUpdateOne) SetNotNilState(value *string) *OauthSessionUpdateOne {
+func (_m *LdapSyncRunCreate) SetNotNilChanges(value []ldap.SyncChange) *LdapSyncRunCreate {
 	if value != nil {
-		return _m.SetState(*value)
+		return _m.SetChanges(value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthSessionCreate) SetNotNilState(value *string) *OauthSessionCreate {
+func (_m *LdapSyncRunUpdate) SetNotNilConflicts(value []ldap.SyncConflict) *LdapSyncRunUpdate {
 	if value != nil {
-		return _m.SetState(*value)
+		return _m.SetConflicts(value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthSessionUpdate) SetNotNilProviderID(value *uint64) *OauthSessionUpdate {
+func (_m *LdapSyncRunUpdateOne) SetNotNilConflicts(value []ldap.SyncConflict) *LdapSyncRunUpdateOne {
 	if value != nil {
-		return _m.SetProviderID(*value)
+		return _m.SetConflicts(value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthSessionUpdateOne) SetNotNilProviderID(value *uint64) *OauthSessionUpdateOne {
+func (_m *LdapSyncRunCreate) SetNotNilConflicts(value []ldap.SyncConflict) *LdapSyncRunCreate {
 	if value != nil {
-		return _m.SetProviderID(*value)
+		return _m.SetConflicts(value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthSessionCreate) SetNotNilProviderID(value *uint64) *OauthSessionCreate {
+func (_m *LdapSyncRunUpdate) SetNotNilTruncated(value *bool) *LdapSyncRunUpdate {
 	if value != nil {
-		return _m.SetProviderID(*value)
+		return _m.SetTruncated(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthSessionUpdate) SetNotNilUserID(value *uuid.UUID) *OauthSessionUpdate {
+func (_m *LdapSyncRunUpdateOne) SetNotNilTruncated(value *bool) *LdapSyncRunUpdateOne {
 	if value != nil {
-		return _m.SetUserID(*value)
+		return _m.SetTruncated(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthSessionUpdateOne) SetNotNilUserID(value *uuid.UUID) *OauthSessionUpdateOne {
+func (_m *LdapSyncRunCreate) SetNotNilTruncated(value *bool) *LdapSyncRunCreate {
 	if value != nil {
-		return _m.SetUserID(*value)
+		return _m.SetTruncated(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthSessionCreate) SetNotNilUserID(value *uuid.UUID) *OauthSessionCreate {
+func (_m *MenuUpdate) SetNotNilUpdatedAt(value *time.Time) *MenuUpdate {
 	if value != nil {
-		return _m.SetUserID(*value)
+		return _m.SetUpdatedAt(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthSessionUpdate) SetNotNilRedirectURI(value *string) *OauthSessionUpdate {
+func (_m *MenuUpdateOne) SetNotNilUpdatedAt(value *time.Time) *MenuUpdateOne {
 	if value != nil {
-		return _m.SetRedirectURI(*value)
+		return _m.SetUpdatedAt(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthSessionUpdateOne) SetNotNilRedirectURI(value *string) *OauthSessionUpdateOne {
+func (_m *MenuCreate) SetNotNilUpdatedAt(value *time.Time) *MenuCreate {
 	if value != nil {
-		return _m.SetRedirectURI(*value)
+		return _m.SetUpdatedAt(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthSessionCreate) SetNotNilRedirectURI(value *string) *OauthSessionCreate {
+func (_m *MenuUpdate) SetNotNilSort(value *uint32) *MenuUpdate {
 	if value != nil {
-		return _m.SetRedirectURI(*value)
+		return _m.SetSort(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthSessionUpdate) SetNotNilScope(value *string) *OauthSessionUpdate {
+func (_m *MenuUpdateOne) SetNotNilSort(value *uint32) *MenuUpdateOne {
 	if value != nil {
-		return _m.SetScope(*value)
+		return _m.SetSort(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthSessionUpdateOne) SetNotNilScope(value *string) *OauthSessionUpdateOne {
+func (_m *MenuCreate) SetNotNilSort(value *uint32) *MenuCreate {
 	if value != nil {
-		return _m.SetScope(*value)
+		return _m.SetSort(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthSessionCreate) SetNotNilScope(value *string) *OauthSessionCreate {
+func (_m *MenuUpdate) SetNotNilParentID(value *uint64) *MenuUpdate {
 	if value != nil {
-		return _m.SetScope(*value)
+		return _m.SetParentID(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthSessionUpdate) SetNotNilCodeChallenge(value *string) *OauthSessionUpdate {
+func (_m *MenuUpdateOne) SetNotNilParentID(value *uint64) *MenuUpdateOne {
 	if value != nil {
-		return _m.SetCodeChallenge(*value)
+		return _m.SetParentID(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthSessionUpdateOne) SetNotNilCodeChallenge(value *string) *OauthSessionUpdateOne {
+func (_m *MenuCreate) SetNotNilParentID(value *uint64) *MenuCreate {
 	if value != nil {
-		return _m.SetCodeChallenge(*value)
+		return _m.SetParentID(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthSessionCreate) SetNotNilCodeChallenge(value *string) *OauthSessionCreate {
+func (_m *MenuUpdate) SetNotNilMenuLevel(value *uint32) *MenuUpdate {
 	if value != nil {
-		return _m.SetCodeChallenge(*value)
+		return _m.SetMenuLevel(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthSessionUpdate) SetNotNilCodeChallengeMethod(value *string) *OauthSessionUpdate {
+func (_m *MenuUpdateOne) SetNotNilMenuLevel(value *uint32) *MenuUpdateOne {
 	if value != nil {
-		return _m.SetCodeChallengeMethod(*value)
+		return _m.SetMenuLevel(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthSessionUpdateOne) SetNotNilCodeChallengeMethod(value *string) *OauthSessionUpdateOne {
+func (_m *MenuCreate) SetNotNilMenuLevel(value *uint32) *MenuCreate {
 	if value != nil {
-		return _m.SetCodeChallengeMethod(*value)
+		return _m.SetMenuLevel(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthSessionCreate) SetNotNilCodeChallengeMethod(value *string) *OauthSessionCreate {
+func (_m *MenuUpdate) SetNotNilMenuType(value *uint32) *MenuUpdate {
 	if value != nil {
-		return _m.SetCodeChallengeMethod(*value)
+		return _m.SetMenuType(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthSessionUpdate) SetNotNilCodeVerifier(value *string) *OauthSessionUpdate {
+func (_m *MenuUpdateOne) SetNotNilMenuType(value *uint32) *MenuUpdateOne {
 	if value != nil {
-		return _m.SetCodeVerifier(*value)
+		return _m.SetMenuType(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthSessionUpdateOne) SetNotNilCodeVerifier(value *string) *OauthSessionUpdateOne {
+func (_m *MenuCreate) SetNotNilMenuType(value *uint32) *MenuCreate {
 	if value != nil {
-		return _m.SetCodeVerifier(*value)
+		return _m.SetMenuType(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthSessionCreate) SetNotNilCodeVerifier(value *string) *OauthSessionCreate {
+func (_m *MenuUpdate) SetNotNilPath(value *string) *MenuUpdate {
 	if value != nil {
-		return _m.SetCodeVerifier(*value)
+		return _m.SetPath(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthSessionUpdate) SetNotNilExpiresAt(value *time.Time) *OauthSessionUpdate {
+func (_m *MenuUpdateOne) SetNotNilPath(value *string) *MenuUpdateOne {
+	if value != nil {
+		return _m.SetPath(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *MenuCreate) SetNotNilPath(value *string) *MenuCreate {
+	if value != nil {
+		return _m.SetPath(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *MenuUpdate) SetNotNilName(value *string) *MenuUpdate {
+	if value != nil {
+		return _m.SetName(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *MenuUpdateOne) SetNotNilName(value *string) *MenuUpdateOne {
+	if value != nil {
+		return _m.SetName(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *MenuCreate) SetNotNilName(value *string) *MenuCreate {
+	if value != nil {
+		return _m.SetName(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *MenuUpdate) SetNotNilRedirect(value *string) *MenuUpdate {
+	if value != nil {
+		return _m.SetRedirect(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *MenuUpdateOne) SetNotNilRedirect(value *string) *MenuUpdateOne {
+	if value != nil {
+		return _m.SetRedirect(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *MenuCreate) SetNotNilRedirect(value *string) *MenuCreate {
+	if value != nil {
+		return _m.SetRedirect(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *MenuUpdate) SetNotNilComponent(value *string) *MenuUpdate {
+	if value != nil {
+		return _m.SetComponent(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *MenuUpdateOne) SetNotNilComponent(value *string) *MenuUpdateOne {
+	if value != nil {
+		return _m.SetComponent(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *MenuCreate) SetNotNilComponent(value *string) *MenuCreate {
+	if value != nil {
+		return _m.SetComponent(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *MenuUpdate) SetNotNilDisabled(value *bool) *MenuUpdate {
+	if value != nil {
+		return _m.SetDisabled(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *MenuUpdateOne) SetNotNilDisabled(value *bool) *MenuUpdateOne {
+	if value != nil {
+		return _m.SetDisabled(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *MenuCreate) SetNotNilDisabled(value *bool) *MenuCreate {
+	if value != nil {
+		return _m.SetDisabled(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *MenuUpdate) SetNotNilServiceName(value *string) *MenuUpdate {
+	if value != nil {
+		return _m.SetServiceName(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *MenuUpdateOne) SetNotNilServiceName(value *string) *MenuUpdateOne {
+	if value != nil {
+		return _m.SetServiceName(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *MenuCreate) SetNotNilServiceName(value *string) *MenuCreate {
+	if value != nil {
+		return _m.SetServiceName(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *MenuUpdate) SetNotNilPermission(value *string) *MenuUpdate {
+	if value != nil {
+		return _m.SetPermission(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *MenuUpdateOne) SetNotNilPermission(value *string) *MenuUpdateOne {
+	if value != nil {
+		return _m.SetPermission(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *MenuCreate) SetNotNilPermission(value *string) *MenuCreate {
+	if value != nil {
+		return _m.SetPermission(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *MenuUpdate) SetNotNilTitle(value *string) *MenuUpdate {
+	if value != nil {
+		return _m.SetTitle(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *MenuUpdateOne) SetNotNilTitle(value *string) *MenuUpdateOne {
+	if value != nil {
+		return _m.SetTitle(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *MenuCreate) SetNotNilTitle(value *string) *MenuCreate {
+	if value != nil {
+		return _m.SetTitle(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *MenuUpdate) SetNotNilIcon(value *string) *MenuUpdate {
+	if value != nil {
+		return _m.SetIcon(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *MenuUpdateOne) SetNotNilIcon(value *string) *MenuUpdateOne {
+	if value != nil {
+		return _m.SetIcon(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *MenuCreate) SetNotNilIcon(value *string) *MenuCreate {
+	if value != nil {
+		return _m.SetIcon(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *MenuUpdate) SetNotNilHideMenu(value *bool) *MenuUpdate {
+	if value != nil {
+		return _m.SetHideMenu(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *MenuUpdateOne) SetNotNilHideMenu(value *bool) *MenuUpdateOne {
+	if value != nil {
+		return _m.SetHideMenu(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *MenuCreate) SetNotNilHideMenu(value *bool) *MenuCreate {
+	if value != nil {
+		return _m.SetHideMenu(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *MenuUpdate) SetNotNilHideBreadcrumb(value *bool) *MenuUpdate {
+	if value != nil {
+		return _m.SetHideBreadcrumb(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *MenuUpdateOne) SetNotNilHideBreadcrumb(value *bool) *MenuUpdateOne {
+	if value != nil {
+		return _m.SetHideBreadcrumb(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *MenuCreate) SetNotNilHideBreadcrumb(value *bool) *MenuCreate {
+	if value != nil {
+		return _m.SetHideBreadcrumb(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *MenuUpdate) SetNotNilIgnoreKeepAlive(value *bool) *MenuUpdate {
+	if value != nil {
+		return _m.SetIgnoreKeepAlive(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *MenuUpdateOne) SetNotNilIgnoreKeepAlive(value *bool) *MenuUpdateOne {
+	if value != nil {
+		return _m.SetIgnoreKeepAlive(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *MenuCreate) SetNotNilIgnoreKeepAlive(value *bool) *MenuCreate {
+	if value != nil {
+		return _m.SetIgnoreKeepAlive(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *MenuUpdate) SetNotNilHideTab(value *bool) *MenuUpdate {
+	if value != nil {
+		return _m.SetHideTab(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *MenuUpdateOne) SetNotNilHideTab(value *bool) *MenuUpdateOne {
+	if value != nil {
+		return _m.SetHideTab(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *MenuCreate) SetNotNilHideTab(value *bool) *MenuCreate {
+	if value != nil {
+		return _m.SetHideTab(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *MenuUpdate) SetNotNilFrameSrc(value *string) *MenuUpdate {
+	if value != nil {
+		return _m.SetFrameSrc(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *MenuUpdateOne) SetNotNilFrameSrc(value *string) *MenuUpdateOne {
+	if value != nil {
+		return _m.SetFrameSrc(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *MenuCreate) SetNotNilFrameSrc(value *string) *MenuCreate {
+	if value != nil {
+		return _m.SetFrameSrc(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *MenuUpdate) SetNotNilCarryParam(value *bool) *MenuUpdate {
+	if value != nil {
+		return _m.SetCarryParam(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *MenuUpdateOne) SetNotNilCarryParam(value *bool) *MenuUpdateOne {
+	if value != nil {
+		return _m.SetCarryParam(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *MenuCreate) SetNotNilCarryParam(value *bool) *MenuCreate {
+	if value != nil {
+		return _m.SetCarryParam(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *MenuUpdate) SetNotNilHideChildrenInMenu(value *bool) *MenuUpdate {
+	if value != nil {
+		return _m.SetHideChildrenInMenu(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *MenuUpdateOne) SetNotNilHideChildrenInMenu(value *bool) *MenuUpdateOne {
+	if value != nil {
+		return _m.SetHideChildrenInMenu(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *MenuCreate) SetNotNilHideChildrenInMenu(value *bool) *MenuCreate {
+	if value != nil {
+		return _m.SetHideChildrenInMenu(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *MenuUpdate) SetNotNilAffix(value *bool) *MenuUpdate {
+	if value != nil {
+		return _m.SetAffix(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *MenuUpdateOne) SetNotNilAffix(value *bool) *MenuUpdateOne {
+	if value != nil {
+		return _m.SetAffix(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *MenuCreate) SetNotNilAffix(value *bool) *MenuCreate {
+	if value != nil {
+		return _m.SetAffix(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *MenuUpdate) SetNotNilDynamicLevel(value *uint32) *MenuUpdate {
+	if value != nil {
+		return _m.SetDynamicLevel(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *MenuUpdateOne) SetNotNilDynamicLevel(value *uint32) *MenuUpdateOne {
+	if value != nil {
+		return _m.SetDynamicLevel(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *MenuCreate) SetNotNilDynamicLevel(value *uint32) *MenuCreate {
+	if value != nil {
+		return _m.SetDynamicLevel(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *MenuUpdate) SetNotNilRealPath(value *string) *MenuUpdate {
+	if value != nil {
+		return _m.SetRealPath(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *MenuUpdateOne) SetNotNilRealPath(value *string) *MenuUpdateOne {
+	if value != nil {
+		return _m.SetRealPath(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *MenuCreate) SetNotNilRealPath(value *string) *MenuCreate {
+	if value != nil {
+		return _m.SetRealPath(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *MenuUpdate) SetNotNilParams(value *string) *MenuUpdate {
+	if value != nil {
+		return _m.SetParams(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *MenuUpdateOne) SetNotNilParams(value *string) *MenuUpdateOne {
+	if value != nil {
+		return _m.SetParams(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *MenuCreate) SetNotNilParams(value *string) *MenuCreate {
+	if value != nil {
+		return _m.SetParams(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthAccountUpdate) SetNotNilUpdatedAt(value *time.Time) *OauthAccountUpdate {
+	if value != nil {
+		return _m.SetUpdatedAt(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthAccountUpdateOne) SetNotNilUpdatedAt(value *time.Time) *OauthAccountUpdateOne {
+	if value != nil {
+		return _m.SetUpdatedAt(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthAccountCreate) SetNotNilUpdatedAt(value *time.Time) *OauthAccountCreate {
+	if value != nil {
+		return _m.SetUpdatedAt(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthAccountUpdate) SetNotNilStatus(value *uint8) *OauthAccountUpdate {
+	if value != nil {
+		return _m.SetStatus(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthAccountUpdateOne) SetNotNilStatus(value *uint8) *OauthAccountUpdateOne {
+	if value != nil {
+		return _m.SetStatus(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthAccountCreate) SetNotNilStatus(value *uint8) *OauthAccountCreate {
+	if value != nil {
+		return _m.SetStatus(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthAccountUpdate) SetNotNilUserID(value *uuid.UUID) *OauthAccountUpdate {
+	if value != nil {
+		return _m.SetUserID(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthAccountUpdateOne) SetNotNilUserID(value *uuid.UUID) *OauthAccountUpdateOne {
+	if value != nil {
+		return _m.SetUserID(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthAccountCreate) SetNotNilUserID(value *uuid.UUID) *OauthAccountCreate {
+	if value != nil {
+		return _m.SetUserID(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthAccountUpdate) SetNotNilProviderID(value *uint64) *OauthAccountUpdate {
+	if value != nil {
+		return _m.SetProviderID(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthAccountUpdateOne) SetNotNilProviderID(value *uint64) *OauthAccountUpdateOne {
+	if value != nil {
+		return _m.SetProviderID(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthAccountCreate) SetNotNilProviderID(value *uint64) *OauthAccountCreate {
+	if value != nil {
+		return _m.SetProviderID(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthAccountUpdate) SetNotNilProviderType(value *string) *OauthAccountUpdate {
+	if value != nil {
+		return _m.SetProviderType(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthAccountUpdateOne) SetNotNilProviderType(value *string) *OauthAccountUpdateOne {
+	if value != nil {
+		return _m.SetProviderType(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthAccountCreate) SetNotNilProviderType(value *string) *OauthAccountCreate {
+	if value != nil {
+		return _m.SetProviderType(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthAccountUpdate) SetNotNilProviderUserID(value *string) *OauthAccountUpdate {
+	if value != nil {
+		return _m.SetProviderUserID(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthAccountUpdateOne) SetNotNilProviderUserID(value *string) *OauthAccountUpdateOne {
+	if value != nil {
+		return _m.SetProviderUserID(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthAccountCreate) SetNotNilProviderUserID(value *string) *OauthAccountCreate {
+	if value != nil {
+		return _m.SetProviderUserID(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthAccountUpdate) SetNotNilProviderUsername(value *string) *OauthAccountUpdate {
+	if value != nil {
+		return _m.SetProviderUsername(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthAccountUpdateOne) SetNotNilProviderUsername(value *string) *OauthAccountUpdateOne {
+	if value != nil {
+		return _m.SetProviderUsername(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthAccountCreate) SetNotNilProviderUsername(value *string) *OauthAccountCreate {
+	if value != nil {
+		return _m.SetProviderUsername(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthAccountUpdate) SetNotNilProviderNickname(value *string) *OauthAccountUpdate {
+	if value != nil {
+		return _m.SetProviderNickname(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthAccountUpdateOne) SetNotNilProviderNickname(value *string) *OauthAccountUpdateOne {
+	if value != nil {
+		return _m.SetProviderNickname(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthAccountCreate) SetNotNilProviderNickname(value *string) *OauthAccountCreate {
+	if value != nil {
+		return _m.SetProviderNickname(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthAccountUpdate) SetNotNilProviderEmail(value *string) *OauthAccountUpdate {
+	if value != nil {
+		return _m.SetProviderEmail(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthAccountUpdateOne) SetNotNilProviderEmail(value *string) *OauthAccountUpdateOne {
+	if value != nil {
+		return _m.SetProviderEmail(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthAccountCreate) SetNotNilProviderEmail(value *string) *OauthAccountCreate {
+	if value != nil {
+		return _m.SetProviderEmail(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthAccountUpdate) SetNotNilProviderAvatar(value *string) *OauthAccountUpdate {
+	if value != nil {
+		return _m.SetProviderAvatar(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthAccountUpdateOne) SetNotNilProviderAvatar(value *string) *OauthAccountUpdateOne {
+	if value != nil {
+		return _m.SetProviderAvatar(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthAccountCreate) SetNotNilProviderAvatar(value *string) *OauthAccountCreate {
+	if value != nil {
+		return _m.SetProviderAvatar(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthAccountUpdate) SetNotNilAccessToken(value *string) *OauthAccountUpdate {
+	if value != nil {
+		return _m.SetAccessToken(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthAccountUpdateOne) SetNotNilAccessToken(value *string) *OauthAccountUpdateOne {
+	if value != nil {
+		return _m.SetAccessToken(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthAccountCreate) SetNotNilAccessToken(value *string) *OauthAccountCreate {
+	if value != nil {
+		return _m.SetAccessToken(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthAccountUpdate) SetNotNilRefreshToken(value *string) *OauthAccountUpdate {
+	if value != nil {
+		return _m.SetRefreshToken(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthAccountUpdateOne) SetNotNilRefreshToken(value *string) *OauthAccountUpdateOne {
+	if value != nil {
+		return _m.SetRefreshToken(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthAccountCreate) SetNotNilRefreshToken(value *string) *OauthAccountCreate {
+	if value != nil {
+		return _m.SetRefreshToken(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthAccountUpdate) SetNotNilTokenExpiresAt(value *time.Time) *OauthAccountUpdate {
+	if value != nil {
+		return _m.SetTokenExpiresAt(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthAccountUpdateOne) SetNotNilTokenExpiresAt(value *time.Time) *OauthAccountUpdateOne {
+	if value != nil {
+		return _m.SetTokenExpiresAt(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthAccountCreate) SetNotNilTokenExpiresAt(value *time.Time) *OauthAccountCreate {
+	if value != nil {
+		return _m.SetTokenExpiresAt(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthAccountUpdate) SetNotNilEncryptionKeyID(value *string) *OauthAccountUpdate {
+	if value != nil {
+		return _m.SetEncryptionKeyID(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthAccountUpdateOne) SetNotNilEncryptionKeyID(value *string) *OauthAccountUpdateOne {
+	if value != nil {
+		return _m.SetEncryptionKeyID(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthAccountCreate) SetNotNilEncryptionKeyID(value *string) *OauthAccountCreate {
+	if value != nil {
+		return _m.SetEncryptionKeyID(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthAccountUpdate) SetNotNilExtraData(value *map[string]interface{}) *OauthAccountUpdate {
+	if value != nil {
+		return _m.SetExtraData(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthAccountUpdateOne) SetNotNilExtraData(value *map[string]interface{}) *OauthAccountUpdateOne {
+	if value != nil {
+		return _m.SetExtraData(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthAccountCreate) SetNotNilExtraData(value *map[string]interface{}) *OauthAccountCreate {
+	if value != nil {
+		return _m.SetExtraData(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthAccountUpdate) SetNotNilLastLoginAt(value *time.Time) *OauthAccountUpdate {
+	if value != nil {
+		return _m.SetLastLoginAt(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthAccountUpdateOne) SetNotNilLastLoginAt(value *time.Time) *OauthAccountUpdateOne {
+	if value != nil {
+		return _m.SetLastLoginAt(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthAccountCreate) SetNotNilLastLoginAt(value *time.Time) *OauthAccountCreate {
+	if value != nil {
+		return _m.SetLastLoginAt(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthAccountUpdate) SetNotNilLastLoginIP(value *string) *OauthAccountUpdate {
+	if value != nil {
+		return _m.SetLastLoginIP(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthAccountUpdateOne) SetNotNilLastLoginIP(value *string) *OauthAccountUpdateOne {
+	if value != nil {
+		return _m.SetLastLoginIP(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthAccountCreate) SetNotNilLastLoginIP(value *string) *OauthAccountCreate {
+	if value != nil {
+		return _m.SetLastLoginIP(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthAccountUpdate) SetNotNilLoginCount(value *uint32) *OauthAccountUpdate {
+	if value != nil {
+		return _m.SetLoginCount(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthAccountUpdateOne) SetNotNilLoginCount(value *uint32) *OauthAccountUpdateOne {
+	if value != nil {
+		return _m.SetLoginCount(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthAccountCreate) SetNotNilLoginCount(value *uint32) *OauthAccountCreate {
+	if value != nil {
+		return _m.SetLoginCount(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthAccountUpdate) SetNotNilDepartmentID(value *uint64) *OauthAccountUpdate {
+	if value != nil {
+		return _m.SetDepartmentID(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthAccountUpdateOne) SetNotNilDepartmentID(value *uint64) *OauthAccountUpdateOne {
+	if value != nil {
+		return _m.SetDepartmentID(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthAccountCreate) SetNotNilDepartmentID(value *uint64) *OauthAccountCreate {
+	if value != nil {
+		return _m.SetDepartmentID(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthClientUpdate) SetNotNilUpdatedAt(value *time.Time) *OauthClientUpdate {
+	if value != nil {
+		return _m.SetUpdatedAt(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthClientUpdateOne) SetNotNilUpdatedAt(value *time.Time) *OauthClientUpdateOne {
+	if value != nil {
+		return _m.SetUpdatedAt(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthClientCreate) SetNotNilUpdatedAt(value *time.Time) *OauthClientCreate {
+	if value != nil {
+		return _m.SetUpdatedAt(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthClientUpdate) SetNotNilStatus(value *uint8) *OauthClientUpdate {
+	if value != nil {
+		return _m.SetStatus(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthClientUpdateOne) SetNotNilStatus(value *uint8) *OauthClientUpdateOne {
+	if value != nil {
+		return _m.SetStatus(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthClientCreate) SetNotNilStatus(value *uint8) *OauthClientCreate {
+	if value != nil {
+		return _m.SetStatus(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthClientUpdate) SetNotNilName(value *string) *OauthClientUpdate {
+	if value != nil {
+		return _m.SetName(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthClientUpdateOne) SetNotNilName(value *string) *OauthClientUpdateOne {
+	if value != nil {
+		return _m.SetName(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthClientCreate) SetNotNilName(value *string) *OauthClientCreate {
+	if value != nil {
+		return _m.SetName(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthClientUpdate) SetNotNilClientSecretHash(value *string) *OauthClientUpdate {
+	if value != nil {
+		return _m.SetClientSecretHash(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthClientUpdateOne) SetNotNilClientSecretHash(value *string) *OauthClientUpdateOne {
+	if value != nil {
+		return _m.SetClientSecretHash(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthClientCreate) SetNotNilClientSecretHash(value *string) *OauthClientCreate {
+	if value != nil {
+		return _m.SetClientSecretHash(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthClientUpdate) SetNotNilClientType(value *string) *OauthClientUpdate {
+	if value != nil {
+		return _m.SetClientType(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthClientUpdateOne) SetNotNilClientType(value *string) *OauthClientUpdateOne {
+	if value != nil {
+		return _m.SetClientType(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthClientCreate) SetNotNilClientType(value *string) *OauthClientCreate {
+	if value != nil {
+		return _m.SetClientType(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthClientUpdate) SetNotNilRedirectUris(value []string) *OauthClientUpdate {
+	if value != nil {
+		return _m.SetRedirectUris(value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthClientUpdateOne) SetNotNilRedirectUris(value []string) *OauthClientUpdateOne {
+	if value != nil {
+		return _m.SetRedirectUris(value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthClientCreate) SetNotNilRedirectUris(value []string) *OauthClientCreate {
+	if value != nil {
+		return _m.SetRedirectUris(value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthClientUpdate) SetNotNilGrantTypes(value []string) *OauthClientUpdate {
+	if value != nil {
+		return _m.SetGrantTypes(value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthClientUpdateOne) SetNotNilGrantTypes(value []string) *OauthClientUpdateOne {
+	if value != nil {
+		return _m.SetGrantTypes(value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthClientCreate) SetNotNilGrantTypes(value []string) *OauthClientCreate {
+	if value != nil {
+		return _m.SetGrantTypes(value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthClientUpdate) SetNotNilScopes(value []string) *OauthClientUpdate {
+	if value != nil {
+		return _m.SetScopes(value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthClientUpdateOne) SetNotNilScopes(value []string) *OauthClientUpdateOne {
+	if value != nil {
+		return _m.SetScopes(value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthClientCreate) SetNotNilScopes(value []string) *OauthClientCreate {
+	if value != nil {
+		return _m.SetScopes(value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthClientUpdate) SetNotNilTrusted(value *bool) *OauthClientUpdate {
+	if value != nil {
+		return _m.SetTrusted(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthClientUpdateOne) SetNotNilTrusted(value *bool) *OauthClientUpdateOne {
+	if value != nil {
+		return _m.SetTrusted(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthClientCreate) SetNotNilTrusted(value *bool) *OauthClientCreate {
+	if value != nil {
+		return _m.SetTrusted(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthClientUpdate) SetNotNilAccessTokenTTL(value *uint32) *OauthClientUpdate {
+	if value != nil {
+		return _m.SetAccessTokenTTL(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthClientUpdateOne) SetNotNilAccessTokenTTL(value *uint32) *OauthClientUpdateOne {
+	if value != nil {
+		return _m.SetAccessTokenTTL(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthClientCreate) SetNotNilAccessTokenTTL(value *uint32) *OauthClientCreate {
+	if value != nil {
+		return _m.SetAccessTokenTTL(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthClientUpdate) SetNotNilRefreshTokenTTL(value *uint32) *OauthClientUpdate {
+	if value != nil {
+		return _m.SetRefreshTokenTTL(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthClientUpdateOne) SetNotNilRefreshTokenTTL(value *uint32) *OauthClientUpdateOne {
+	if value != nil {
+		return _m.SetRefreshTokenTTL(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthClientCreate) SetNotNilRefreshTokenTTL(value *uint32) *OauthClientCreate {
+	if value != nil {
+		return _m.SetRefreshTokenTTL(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthClientUpdate) SetNotNilRemark(value *string) *OauthClientUpdate {
+	if value != nil {
+		return _m.SetRemark(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthClientUpdateOne) SetNotNilRemark(value *string) *OauthClientUpdateOne {
+	if value != nil {
+		return _m.SetRemark(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthClientCreate) SetNotNilRemark(value *string) *OauthClientCreate {
+	if value != nil {
+		return _m.SetRemark(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthConsentUpdate) SetNotNilUpdatedAt(value *time.Time) *OauthConsentUpdate {
+	if value != nil {
+		return _m.SetUpdatedAt(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthConsentUpdateOne) SetNotNilUpdatedAt(value *time.Time) *OauthConsentUpdateOne {
+	if value != nil {
+		return _m.SetUpdatedAt(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthConsentCreate) SetNotNilUpdatedAt(value *time.Time) *OauthConsentCreate {
+	if value != nil {
+		return _m.SetUpdatedAt(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthConsentUpdate) SetNotNilUserID(value *uuid.UUID) *OauthConsentUpdate {
+	if value != nil {
+		return _m.SetUserID(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthConsentUpdateOne) SetNotNilUserID(value *uuid.UUID) *OauthConsentUpdateOne {
+	if value != nil {
+		return _m.SetUserID(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthConsentCreate) SetNotNilUserID(value *uuid.UUID) *OauthConsentCreate {
+	if value != nil {
+		return _m.SetUserID(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthConsentUpdate) SetNotNilClientID(value *string) *OauthConsentUpdate {
+	if value != nil {
+		return _m.SetClientID(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthConsentUpdateOne) SetNotNilClientID(value *string) *OauthConsentUpdateOne {
+	if value != nil {
+		return _m.SetClientID(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthConsentCreate) SetNotNilClientID(value *string) *OauthConsentCreate {
+	if value != nil {
+		return _m.SetClientID(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthConsentUpdate) SetNotNilScopes(value []string) *OauthConsentUpdate {
+	if value != nil {
+		return _m.SetScopes(value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthConsentUpdateOne) SetNotNilScopes(value []string) *OauthConsentUpdateOne {
+	if value != nil {
+		return _m.SetScopes(value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthConsentCreate) SetNotNilScopes(value []string) *OauthConsentCreate {
+	if value != nil {
+		return _m.SetScopes(value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthProviderUpdate) SetNotNilUpdatedAt(value *time.Time) *OauthProviderUpdate {
+	if value != nil {
+		return _m.SetUpdatedAt(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthProviderUpdateOne) SetNotNilUpdatedAt(value *time.Time) *OauthProviderUpdateOne {
+	if value != nil {
+		return _m.SetUpdatedAt(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthProviderCreate) SetNotNilUpdatedAt(value *time.Time) *OauthProviderCreate {
+	if value != nil {
+		return _m.SetUpdatedAt(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthProviderUpdate) SetNotNilStatus(value *uint8) *OauthProviderUpdate {
+	if value != nil {
+		return _m.SetStatus(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthProviderUpdateOne) SetNotNilStatus(value *uint8) *OauthProviderUpdateOne {
+	if value != nil {
+		return _m.SetStatus(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthProviderCreate) SetNotNilStatus(value *uint8) *OauthProviderCreate {
+	if value != nil {
+		return _m.SetStatus(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthProviderUpdate) SetNotNilName(value *string) *OauthProviderUpdate {
+	if value != nil {
+		return _m.SetName(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthProviderUpdateOne) SetNotNilName(value *string) *OauthProviderUpdateOne {
+	if value != nil {
+		return _m.SetName(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthProviderCreate) SetNotNilName(value *string) *OauthProviderCreate {
+	if value != nil {
+		return _m.SetName(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthProviderUpdate) SetNotNilDisplayName(value *string) *OauthProviderUpdate {
+	if value != nil {
+		return _m.SetDisplayName(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthProviderUpdateOne) SetNotNilDisplayName(value *string) *OauthProviderUpdateOne {
+	if value != nil {
+		return _m.SetDisplayName(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthProviderCreate) SetNotNilDisplayName(value *string) *OauthProviderCreate {
+	if value != nil {
+		return _m.SetDisplayName(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthProviderUpdate) SetNotNilType(value *string) *OauthProviderUpdate {
+	if value != nil {
+		return _m.SetType(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthProviderUpdateOne) SetNotNilType(value *string) *OauthProviderUpdateOne {
+	if value != nil {
+		return _m.SetType(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthProviderCreate) SetNotNilType(value *string) *OauthProviderCreate {
+	if value != nil {
+		return _m.SetType(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthProviderUpdate) SetNotNilProviderType(value *string) *OauthProviderUpdate {
+	if value != nil {
+		return _m.SetProviderType(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthProviderUpdateOne) SetNotNilProviderType(value *string) *OauthProviderUpdateOne {
+	if value != nil {
+		return _m.SetProviderType(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthProviderCreate) SetNotNilProviderType(value *string) *OauthProviderCreate {
+	if value != nil {
+		return _m.SetProviderType(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthProviderUpdate) SetNotNilClientID(value *string) *OauthProviderUpdate {
+	if value != nil {
+		return _m.SetClientID(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthProviderUpdateOne) SetNotNilClientID(value *string) *OauthProviderUpdateOne {
+	if value != nil {
+		return _m.SetClientID(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthProviderCreate) SetNotNilClientID(value *string) *OauthProviderCreate {
+	if value != nil {
+		return _m.SetClientID(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthProviderUpdate) SetNotNilClientSecret(value *string) *OauthProviderUpdate {
+	if value != nil {
+		return _m.SetClientSecret(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthProviderUpdateOne) SetNotNilClientSecret(value *string) *OauthProviderUpdateOne {
+	if value != nil {
+		return _m.SetClientSecret(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthProviderCreate) SetNotNilClientSecret(value *string) *OauthProviderCreate {
+	if value != nil {
+		return _m.SetClientSecret(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthProviderUpdate) SetNotNilEncryptedSecret(value *string) *OauthProviderUpdate {
+	if value != nil {
+		return _m.SetEncryptedSecret(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthProviderUpdateOne) SetNotNilEncryptedSecret(value *string) *OauthProviderUpdateOne {
+	if value != nil {
+		return _m.SetEncryptedSecret(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthProviderCreate) SetNotNilEncryptedSecret(value *string) *OauthProviderCreate {
+	if value != nil {
+		return _m.SetEncryptedSecret(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthProviderUpdate) SetNotNilEncryptionKeyID(value *string) *OauthProviderUpdate {
+	if value != nil {
+		return _m.SetEncryptionKeyID(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthProviderUpdateOne) SetNotNilEncryptionKeyID(value *string) *OauthProviderUpdateOne {
+	if value != nil {
+		return _m.SetEncryptionKeyID(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthProviderCreate) SetNotNilEncryptionKeyID(value *string) *OauthProviderCreate {
+	if value != nil {
+		return _m.SetEncryptionKeyID(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthProviderUpdate) SetNotNilRedirectURL(value *string) *OauthProviderUpdate {
+	if value != nil {
+		return _m.SetRedirectURL(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthProviderUpdateOne) SetNotNilRedirectURL(value *string) *OauthProviderUpdateOne {
+	if value != nil {
+		return _m.SetRedirectURL(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthProviderCreate) SetNotNilRedirectURL(value *string) *OauthProviderCreate {
+	if value != nil {
+		return _m.SetRedirectURL(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthProviderUpdate) SetNotNilScopes(value *string) *OauthProviderUpdate {
+	if value != nil {
+		return _m.SetScopes(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthProviderUpdateOne) SetNotNilScopes(value *string) *OauthProviderUpdateOne {
+	if value != nil {
+		return _m.SetScopes(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthProviderCreate) SetNotNilScopes(value *string) *OauthProviderCreate {
+	if value != nil {
+		return _m.SetScopes(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthProviderUpdate) SetNotNilAuthURL(value *string) *OauthProviderUpdate {
+	if value != nil {
+		return _m.SetAuthURL(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthProviderUpdateOne) SetNotNilAuthURL(value *string) *OauthProviderUpdateOne {
+	if value != nil {
+		return _m.SetAuthURL(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthProviderCreate) SetNotNilAuthURL(value *string) *OauthProviderCreate {
+	if value != nil {
+		return _m.SetAuthURL(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthProviderUpdate) SetNotNilTokenURL(value *string) *OauthProviderUpdate {
+	if value != nil {
+		return _m.SetTokenURL(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthProviderUpdateOne) SetNotNilTokenURL(value *string) *OauthProviderUpdateOne {
+	if value != nil {
+		return _m.SetTokenURL(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthProviderCreate) SetNotNilTokenURL(value *string) *OauthProviderCreate {
+	if value != nil {
+		return _m.SetTokenURL(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthProviderUpdate) SetNotNilInfoURL(value *string) *OauthProviderUpdate {
+	if value != nil {
+		return _m.SetInfoURL(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthProviderUpdateOne) SetNotNilInfoURL(value *string) *OauthProviderUpdateOne {
+	if value != nil {
+		return _m.SetInfoURL(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthProviderCreate) SetNotNilInfoURL(value *string) *OauthProviderCreate {
+	if value != nil {
+		return _m.SetInfoURL(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthProviderUpdate) SetNotNilAuthStyle(value *int) *OauthProviderUpdate {
+	if value != nil {
+		return _m.SetAuthStyle(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthProviderUpdateOne) SetNotNilAuthStyle(value *int) *OauthProviderUpdateOne {
+	if value != nil {
+		return _m.SetAuthStyle(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthProviderCreate) SetNotNilAuthStyle(value *int) *OauthProviderCreate {
+	if value != nil {
+		return _m.SetAuthStyle(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthProviderUpdate) SetNotNilExtraConfig(value *map[string]interface{}) *OauthProviderUpdate {
+	if value != nil {
+		return _m.SetExtraConfig(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthProviderUpdateOne) SetNotNilExtraConfig(value *map[string]interface{}) *OauthProviderUpdateOne {
+	if value != nil {
+		return _m.SetExtraConfig(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthProviderCreate) SetNotNilExtraConfig(value *map[string]interface{}) *OauthProviderCreate {
+	if value != nil {
+		return _m.SetExtraConfig(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthProviderUpdate) SetNotNilEnabled(value *bool) *OauthProviderUpdate {
+	if value != nil {
+		return _m.SetEnabled(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthProviderUpdateOne) SetNotNilEnabled(value *bool) *OauthProviderUpdateOne {
+	if value != nil {
+		return _m.SetEnabled(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthProviderCreate) SetNotNilEnabled(value *bool) *OauthProviderCreate {
+	if value != nil {
+		return _m.SetEnabled(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthProviderUpdate) SetNotNilSort(value *uint32) *OauthProviderUpdate {
+	if value != nil {
+		return _m.SetSort(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthProviderUpdateOne) SetNotNilSort(value *uint32) *OauthProviderUpdateOne {
+	if value != nil {
+		return _m.SetSort(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthProviderCreate) SetNotNilSort(value *uint32) *OauthProviderCreate {
+	if value != nil {
+		return _m.SetSort(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthProviderUpdate) SetNotNilRemark(value *string) *OauthProviderUpdate {
+	if value != nil {
+		return _m.SetRemark(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthProviderUpdateOne) SetNotNilRemark(value *string) *OauthProviderUpdateOne {
+	if value != nil {
+		return _m.SetRemark(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthProviderCreate) SetNotNilRemark(value *string) *OauthProviderCreate {
+	if value != nil {
+		return _m.SetRemark(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthProviderUpdate) SetNotNilSupportPkce(value *bool) *OauthProviderUpdate {
+	if value != nil {
+		return _m.SetSupportPkce(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthProviderUpdateOne) SetNotNilSupportPkce(value *bool) *OauthProviderUpdateOne {
+	if value != nil {
+		return _m.SetSupportPkce(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthProviderCreate) SetNotNilSupportPkce(value *bool) *OauthProviderCreate {
+	if value != nil {
+		return _m.SetSupportPkce(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthProviderUpdate) SetNotNilIconURL(value *string) *OauthProviderUpdate {
+	if value != nil {
+		return _m.SetIconURL(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthProviderUpdateOne) SetNotNilIconURL(value *string) *OauthProviderUpdateOne {
+	if value != nil {
+		return _m.SetIconURL(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthProviderCreate) SetNotNilIconURL(value *string) *OauthProviderCreate {
+	if value != nil {
+		return _m.SetIconURL(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthProviderUpdate) SetNotNilCacheTTL(value *int) *OauthProviderUpdate {
+	if value != nil {
+		return _m.SetCacheTTL(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthProviderUpdateOne) SetNotNilCacheTTL(value *int) *OauthProviderUpdateOne {
+	if value != nil {
+		return _m.SetCacheTTL(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthProviderCreate) SetNotNilCacheTTL(value *int) *OauthProviderCreate {
+	if value != nil {
+		return _m.SetCacheTTL(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthProviderUpdate) SetNotNilWebhookURL(value *string) *OauthProviderUpdate {
+	if value != nil {
+		return _m.SetWebhookURL(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthProviderUpdateOne) SetNotNilWebhookURL(value *string) *OauthProviderUpdateOne {
+	if value != nil {
+		return _m.SetWebhookURL(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthProviderCreate) SetNotNilWebhookURL(value *string) *OauthProviderCreate {
+	if value != nil {
+		return _m.SetWebhookURL(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthProviderUpdate) SetNotNilSuccessCount(value *int) *OauthProviderUpdate {
+	if value != nil {
+		return _m.SetSuccessCount(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthProviderUpdateOne) SetNotNilSuccessCount(value *int) *OauthProviderUpdateOne {
+	if value != nil {
+		return _m.SetSuccessCount(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthProviderCreate) SetNotNilSuccessCount(value *int) *OauthProviderCreate {
+	if value != nil {
+		return _m.SetSuccessCount(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthProviderUpdate) SetNotNilFailureCount(value *int) *OauthProviderUpdate {
+	if value != nil {
+		return _m.SetFailureCount(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthProviderUpdateOne) SetNotNilFailureCount(value *int) *OauthProviderUpdateOne {
+	if value != nil {
+		return _m.SetFailureCount(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthProviderCreate) SetNotNilFailureCount(value *int) *OauthProviderCreate {
+	if value != nil {
+		return _m.SetFailureCount(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthProviderUpdate) SetNotNilLastUsedAt(value *time.Time) *OauthProviderUpdate {
+	if value != nil {
+		return _m.SetLastUsedAt(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthProviderUpdateOne) SetNotNilLastUsedAt(value *time.Time) *OauthProviderUpdateOne {
+	if value != nil {
+		return _m.SetLastUsedAt(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthProviderCreate) SetNotNilLastUsedAt(value *time.Time) *OauthProviderCreate {
+	if value != nil {
+		return _m.SetLastUsedAt(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthProviderUpdate) SetNotNilTemplateID(value *uint64) *OauthProviderUpdate {
+	if value != nil {
+		return _m.SetTemplateID(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthProviderUpdateOne) SetNotNilTemplateID(value *uint64) *OauthProviderUpdateOne {
+	if value != nil {
+		return _m.SetTemplateID(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthProviderCreate) SetNotNilTemplateID(value *uint64) *OauthProviderCreate {
+	if value != nil {
+		return _m.SetTemplateID(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthProviderTemplateUpdate) SetNotNilUpdatedAt(value *time.Time) *OauthProviderTemplateUpdate {
+	if value != nil {
+		return _m.SetUpdatedAt(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthProviderTemplateUpdateOne) SetNotNilUpdatedAt(value *time.Time) *OauthProviderTemplateUpdateOne {
+	if value != nil {
+		return _m.SetUpdatedAt(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthProviderTemplateCreate) SetNotNilUpdatedAt(value *time.Time) *OauthProviderTemplateCreate {
+	if value != nil {
+		return _m.SetUpdatedAt(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthProviderTemplateUpdate) SetNotNilStatus(value *uint8) *OauthProviderTemplateUpdate {
+	if value != nil {
+		return _m.SetStatus(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthProviderTemplateUpdateOne) SetNotNilStatus(value *uint8) *OauthProviderTemplateUpdateOne {
+	if value != nil {
+		return _m.SetStatus(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthProviderTemplateCreate) SetNotNilStatus(value *uint8) *OauthProviderTemplateCreate {
+	if value != nil {
+		return _m.SetStatus(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthProviderTemplateUpdate) SetNotNilName(value *string) *OauthProviderTemplateUpdate {
+	if value != nil {
+		return _m.SetName(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthProviderTemplateUpdateOne) SetNotNilName(value *string) *OauthProviderTemplateUpdateOne {
+	if value != nil {
+		return _m.SetName(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthProviderTemplateCreate) SetNotNilName(value *string) *OauthProviderTemplateCreate {
+	if value != nil {
+		return _m.SetName(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthProviderTemplateUpdate) SetNotNilDisplayName(value *string) *OauthProviderTemplateUpdate {
+	if value != nil {
+		return _m.SetDisplayName(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthProviderTemplateUpdateOne) SetNotNilDisplayName(value *string) *OauthProviderTemplateUpdateOne {
+	if value != nil {
+		return _m.SetDisplayName(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthProviderTemplateCreate) SetNotNilDisplayName(value *string) *OauthProviderTemplateCreate {
+	if value != nil {
+		return _m.SetDisplayName(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthProviderTemplateUpdate) SetNotNilType(value *string) *OauthProviderTemplateUpdate {
+	if value != nil {
+		return _m.SetType(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthProviderTemplateUpdateOne) SetNotNilType(value *string) *OauthProviderTemplateUpdateOne {
+	if value != nil {
+		return _m.SetType(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthProviderTemplateCreate) SetNotNilType(value *string) *OauthProviderTemplateCreate {
+	if value != nil {
+		return _m.SetType(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthProviderTemplateUpdate) SetNotNilProviderType(value *string) *OauthProviderTemplateUpdate {
+	if value != nil {
+		return _m.SetProviderType(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthProviderTemplateUpdateOne) SetNotNilProviderType(value *string) *OauthProviderTemplateUpdateOne {
+	if value != nil {
+		return _m.SetProviderType(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthProviderTemplateCreate) SetNotNilProviderType(value *string) *OauthProviderTemplateCreate {
+	if value != nil {
+		return _m.SetProviderType(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthProviderTemplateUpdate) SetNotNilScopes(value *string) *OauthProviderTemplateUpdate {
+	if value != nil {
+		return _m.SetScopes(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthProviderTemplateUpdateOne) SetNotNilScopes(value *string) *OauthProviderTemplateUpdateOne {
+	if value != nil {
+		return _m.SetScopes(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthProviderTemplateCreate) SetNotNilScopes(value *string) *OauthProviderTemplateCreate {
+	if value != nil {
+		return _m.SetScopes(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthProviderTemplateUpdate) SetNotNilAuthURL(value *string) *OauthProviderTemplateUpdate {
+	if value != nil {
+		return _m.SetAuthURL(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthProviderTemplateUpdateOne) SetNotNilAuthURL(value *string) *OauthProviderTemplateUpdateOne {
+	if value != nil {
+		return _m.SetAuthURL(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthProviderTemplateCreate) SetNotNilAuthURL(value *string) *OauthProviderTemplateCreate {
+	if value != nil {
+		return _m.SetAuthURL(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthProviderTemplateUpdate) SetNotNilTokenURL(value *string) *OauthProviderTemplateUpdate {
+	if value != nil {
+		return _m.SetTokenURL(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthProviderTemplateUpdateOne) SetNotNilTokenURL(value *string) *OauthProviderTemplateUpdateOne {
+	if value != nil {
+		return _m.SetTokenURL(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthProviderTemplateCreate) SetNotNilTokenURL(value *string) *OauthProviderTemplateCreate {
+	if value != nil {
+		return _m.SetTokenURL(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthProviderTemplateUpdate) SetNotNilInfoURL(value *string) *OauthProviderTemplateUpdate {
+	if value != nil {
+		return _m.SetInfoURL(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthProviderTemplateUpdateOne) SetNotNilInfoURL(value *string) *OauthProviderTemplateUpdateOne {
+	if value != nil {
+		return _m.SetInfoURL(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthProviderTemplateCreate) SetNotNilInfoURL(value *string) *OauthProviderTemplateCreate {
+	if value != nil {
+		return _m.SetInfoURL(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthProviderTemplateUpdate) SetNotNilAuthStyle(value *int) *OauthProviderTemplateUpdate {
+	if value != nil {
+		return _m.SetAuthStyle(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthProviderTemplateUpdateOne) SetNotNilAuthStyle(value *int) *OauthProviderTemplateUpdateOne {
+	if value != nil {
+		return _m.SetAuthStyle(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthProviderTemplateCreate) SetNotNilAuthStyle(value *int) *OauthProviderTemplateCreate {
+	if value != nil {
+		return _m.SetAuthStyle(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthProviderTemplateUpdate) SetNotNilExtraConfig(value *map[string]interface{}) *OauthProviderTemplateUpdate {
+	if value != nil {
+		return _m.SetExtraConfig(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthProviderTemplateUpdateOne) SetNotNilExtraConfig(value *map[string]interface{}) *OauthProviderTemplateUpdateOne {
+	if value != nil {
+		return _m.SetExtraConfig(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthProviderTemplateCreate) SetNotNilExtraConfig(value *map[string]interface{}) *OauthProviderTemplateCreate {
+	if value != nil {
+		return _m.SetExtraConfig(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthProviderTemplateUpdate) SetNotNilSupportPkce(value *bool) *OauthProviderTemplateUpdate {
+	if value != nil {
+		return _m.SetSupportPkce(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthProviderTemplateUpdateOne) SetNotNilSupportPkce(value *bool) *OauthProviderTemplateUpdateOne {
+	if value != nil {
+		return _m.SetSupportPkce(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthProviderTemplateCreate) SetNotNilSupportPkce(value *bool) *OauthProviderTemplateCreate {
+	if value != nil {
+		return _m.SetSupportPkce(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthProviderTemplateUpdate) SetNotNilIconURL(value *string) *OauthProviderTemplateUpdate {
+	if value != nil {
+		return _m.SetIconURL(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthProviderTemplateUpdateOne) SetNotNilIconURL(value *string) *OauthProviderTemplateUpdateOne {
+	if value != nil {
+		return _m.SetIconURL(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthProviderTemplateCreate) SetNotNilIconURL(value *string) *OauthProviderTemplateCreate {
+	if value != nil {
+		return _m.SetIconURL(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthProviderTemplateUpdate) SetNotNilSort(value *uint32) *OauthProviderTemplateUpdate {
+	if value != nil {
+		return _m.SetSort(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthProviderTemplateUpdateOne) SetNotNilSort(value *uint32) *OauthProviderTemplateUpdateOne {
+	if value != nil {
+		return _m.SetSort(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthProviderTemplateCreate) SetNotNilSort(value *uint32) *OauthProviderTemplateCreate {
+	if value != nil {
+		return _m.SetSort(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthProviderTemplateUpdate) SetNotNilRemark(value *string) *OauthProviderTemplateUpdate {
+	if value != nil {
+		return _m.SetRemark(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthProviderTemplateUpdateOne) SetNotNilRemark(value *string) *OauthProviderTemplateUpdateOne {
+	if value != nil {
+		return _m.SetRemark(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthProviderTemplateCreate) SetNotNilRemark(value *string) *OauthProviderTemplateCreate {
+	if value != nil {
+		return _m.SetRemark(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthScopeUpdate) SetNotNilUpdatedAt(value *time.Time) *OauthScopeUpdate {
+	if value != nil {
+		return _m.SetUpdatedAt(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthScopeUpdateOne) SetNotNilUpdatedAt(value *time.Time) *OauthScopeUpdateOne {
+	if value != nil {
+		return _m.SetUpdatedAt(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthScopeCreate) SetNotNilUpdatedAt(value *time.Time) *OauthScopeCreate {
+	if value != nil {
+		return _m.SetUpdatedAt(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthScopeUpdate) SetNotNilStatus(value *uint8) *OauthScopeUpdate {
+	if value != nil {
+		return _m.SetStatus(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthScopeUpdateOne) SetNotNilStatus(value *uint8) *OauthScopeUpdateOne {
+	if value != nil {
+		return _m.SetStatus(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthScopeCreate) SetNotNilStatus(value *uint8) *OauthScopeCreate {
+	if value != nil {
+		return _m.SetStatus(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthScopeUpdate) SetNotNilName(value *string) *OauthScopeUpdate {
+	if value != nil {
+		return _m.SetName(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthScopeUpdateOne) SetNotNilName(value *string) *OauthScopeUpdateOne {
+	if value != nil {
+		return _m.SetName(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthScopeCreate) SetNotNilName(value *string) *OauthScopeCreate {
+	if value != nil {
+		return _m.SetName(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthScopeUpdate) SetNotNilDescription(value *string) *OauthScopeUpdate {
+	if value != nil {
+		return _m.SetDescription(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthScopeUpdateOne) SetNotNilDescription(value *string) *OauthScopeUpdateOne {
+	if value != nil {
+		return _m.SetDescription(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthScopeCreate) SetNotNilDescription(value *string) *OauthScopeCreate {
+	if value != nil {
+		return _m.SetDescription(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthScopeUpdate) SetNotNilAPIIds(value []uint64) *OauthScopeUpdate {
+	if value != nil {
+		return _m.SetAPIIds(value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthScopeUpdateOne) SetNotNilAPIIds(value []uint64) *OauthScopeUpdateOne {
+	if value != nil {
+		return _m.SetAPIIds(value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthScopeCreate) SetNotNilAPIIds(value []uint64) *OauthScopeCreate {
+	if value != nil {
+		return _m.SetAPIIds(value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthSessionUpdate) SetNotNilUpdatedAt(value *time.Time) *OauthSessionUpdate {
+	if value != nil {
+		return _m.SetUpdatedAt(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthSessionUpdateOne) SetNotNilUpdatedAt(value *time.Time) *OauthSessionUpdateOne {
+	if value != nil {
+		return _m.SetUpdatedAt(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthSessionCreate) SetNotNilUpdatedAt(value *time.Time) *OauthSessionCreate {
+	if value != nil {
+		return _m.SetUpdatedAt(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthSessionUpdate) SetNotNilStatus(value *uint8) *OauthSessionUpdate {
+	if value != nil {
+		return _m.SetStatus(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthSessionUpdateOne) SetNotNilStatus(value *uint8) *OauthSessionUpdateOne {
+	if value != nil {
+		return _m.SetStatus(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthSessionCreate) SetNotNilStatus(value *uint8) *OauthSessionCreate {
+	if value != nil {
+		return _m.SetStatus(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthSessionUpdate) SetNotNilSessionID(value *string) *OauthSessionUpdate {
+	if value != nil {
+		return _m.SetSessionID(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthSessionUpdateOne) SetNotNilSessionID(value *string) *OauthSessionUpdateOne {
+	if value != nil {
+		return _m.SetSessionID(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthSessionCreate) SetNotNilSessionID(value *string) *OauthSessionCreate {
+	if value != nil {
+		return _m.SetSessionID(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthSessionUpdate) SetNotNilState(value *string) *OauthSessionUpdate {
+	if value != nil {
+		return _m.SetState(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthSessionUpdateOne) SetNotNilState(value *string) *OauthSessionUpdateOne {
+	if value != nil {
+		return _m.SetState(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthSessionCreate) SetNotNilState(value *string) *OauthSessionCreate {
+	if value != nil {
+		return _m.SetState(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthSessionUpdate) SetNotNilProviderID(value *uint64) *OauthSessionUpdate {
+	if value != nil {
+		return _m.SetProviderID(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthSessionUpdateOne) SetNotNilProviderID(value *uint64) *OauthSessionUpdateOne {
+	if value != nil {
+		return _m.SetProviderID(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthSessionCreate) SetNotNilProviderID(value *uint64) *OauthSessionCreate {
+	if value != nil {
+		return _m.SetProviderID(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthSessionUpdate) SetNotNilUserID(value *uuid.UUID) *OauthSessionUpdate {
+	if value != nil {
+		return _m.SetUserID(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthSessionUpdateOne) SetNotNilUserID(value *uuid.UUID) *OauthSessionUpdateOne {
+	if value != nil {
+		return _m.SetUserID(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthSessionCreate) SetNotNilUserID(value *uuid.UUID) *OauthSessionCreate {
+	if value != nil {
+		return _m.SetUserID(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthSessionUpdate) SetNotNilRedirectURI(value *string) *OauthSessionUpdate {
+	if value != nil {
+		return _m.SetRedirectURI(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthSessionUpdateOne) SetNotNilRedirectURI(value *string) *OauthSessionUpdateOne {
+	if value != nil {
+		return _m.SetRedirectURI(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthSessionCreate) SetNotNilRedirectURI(value *string) *OauthSessionCreate {
+	if value != nil {
+		return _m.SetRedirectURI(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthSessionUpdate) SetNotNilScope(value *string) *OauthSessionUpdate {
+	if value != nil {
+		return _m.SetScope(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthSessionUpdateOne) SetNotNilScope(value *string) *OauthSessionUpdateOne {
+	if value != nil {
+		return _m.SetScope(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthSessionCreate) SetNotNilScope(value *string) *OauthSessionCreate {
+	if value != nil {
+		return _m.SetScope(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthSessionUpdate) SetNotNilCodeChallenge(value *string) *OauthSessionUpdate {
+	if value != nil {
+		return _m.SetCodeChallenge(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthSessionUpdateOne) SetNotNilCodeChallenge(value *string) *OauthSessionUpdateOne {
+	if value != nil {
+		return _m.SetCodeChallenge(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthSessionCreate) SetNotNilCodeChallenge(value *string) *OauthSessionCreate {
+	if value != nil {
+		return _m.SetCodeChallenge(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthSessionUpdate) SetNotNilCodeChallengeMethod(value *string) *OauthSessionUpdate {
+	if value != nil {
+		return _m.SetCodeChallengeMethod(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthSessionUpdateOne) SetNotNilCodeChallengeMethod(value *string) *OauthSessionUpdateOne {
+	if value != nil {
+		return _m.SetCodeChallengeMethod(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthSessionCreate) SetNotNilCodeChallengeMethod(value *string) *OauthSessionCreate {
+	if value != nil {
+		return _m.SetCodeChallengeMethod(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthSessionUpdate) SetNotNilCodeVerifier(value *string) *OauthSessionUpdate {
+	if value != nil {
+		return _m.SetCodeVerifier(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthSessionUpdateOne) SetNotNilCodeVerifier(value *string) *OauthSessionUpdateOne {
+	if value != nil {
+		return _m.SetCodeVerifier(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthSessionCreate) SetNotNilCodeVerifier(value *string) *OauthSessionCreate {
+	if value != nil {
+		return _m.SetCodeVerifier(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthSessionUpdate) SetNotNilExpiresAt(value *time.Time) *OauthSessionUpdate {
+	if value != nil {
+		return _m.SetExpiresAt(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthSessionUpdateOne) SetNotNilExpiresAt(value *time.Time) *OauthSessionUpdateOne {
+	if value != nil {
+		return _m.SetExpiresAt(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthSessionCreate) SetNotNilExpiresAt(value *time.Time) *OauthSessionCreate {
+	if value != nil {
+		return _m.SetExpiresAt(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthSessionUpdate) SetNotNilClientIP(value *string) *OauthSessionUpdate {
+	if value != nil {
+		return _m.SetClientIP(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthSessionUpdateOne) SetNotNilClientIP(value *string) *OauthSessionUpdateOne {
+	if value != nil {
+		return _m.SetClientIP(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthSessionCreate) SetNotNilClientIP(value *string) *OauthSessionCreate {
+	if value != nil {
+		return _m.SetClientIP(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthSessionUpdate) SetNotNilUserAgent(value *string) *OauthSessionUpdate {
+	if value != nil {
+		return _m.SetUserAgent(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthSessionUpdateOne) SetNotNilUserAgent(value *string) *OauthSessionUpdateOne {
+	if value != nil {
+		return _m.SetUserAgent(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthSessionCreate) SetNotNilUserAgent(value *string) *OauthSessionCreate {
+	if value != nil {
+		return _m.SetUserAgent(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthSessionUpdate) SetNotNilAuthorizationCode(value *string) *OauthSessionUpdate {
+	if value != nil {
+		return _m.SetAuthorizationCode(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthSessionUpdateOne) SetNotNilAuthorizationCode(value *string) *OauthSessionUpdateOne {
+	if value != nil {
+		return _m.SetAuthorizationCode(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthSessionCreate) SetNotNilAuthorizationCode(value *string) *OauthSessionCreate {
+	if value != nil {
+		return _m.SetAuthorizationCode(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthSessionUpdate) SetNotNilCodeReceivedAt(value *time.Time) *OauthSessionUpdate {
+	if value != nil {
+		return _m.SetCodeReceivedAt(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthSessionUpdateOne) SetNotNilCodeReceivedAt(value *time.Time) *OauthSessionUpdateOne {
+	if value != nil {
+		return _m.SetCodeReceivedAt(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthSessionCreate) SetNotNilCodeReceivedAt(value *time.Time) *OauthSessionCreate {
+	if value != nil {
+		return _m.SetCodeReceivedAt(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthSessionUpdate) SetNotNilCallbackData(value *map[string]interface{}) *OauthSessionUpdate {
+	if value != nil {
+		return _m.SetCallbackData(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthSessionUpdateOne) SetNotNilCallbackData(value *map[string]interface{}) *OauthSessionUpdateOne {
+	if value != nil {
+		return _m.SetCallbackData(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthSessionCreate) SetNotNilCallbackData(value *map[string]interface{}) *OauthSessionCreate {
+	if value != nil {
+		return _m.SetCallbackData(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthSessionUpdate) SetNotNilErrorCode(value *string) *OauthSessionUpdate {
+	if value != nil {
+		return _m.SetErrorCode(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthSessionUpdateOne) SetNotNilErrorCode(value *string) *OauthSessionUpdateOne {
+	if value != nil {
+		return _m.SetErrorCode(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthSessionCreate) SetNotNilErrorCode(value *string) *OauthSessionCreate {
+	if value != nil {
+		return _m.SetErrorCode(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthSessionUpdate) SetNotNilErrorDescription(value *string) *OauthSessionUpdate {
+	if value != nil {
+		return _m.SetErrorDescription(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthSessionUpdateOne) SetNotNilErrorDescription(value *string) *OauthSessionUpdateOne {
+	if value != nil {
+		return _m.SetErrorDescription(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthSessionCreate) SetNotNilErrorDescription(value *string) *OauthSessionCreate {
+	if value != nil {
+		return _m.SetErrorDescription(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthSessionUpdate) SetNotNilRetryCount(value *int) *OauthSessionUpdate {
+	if value != nil {
+		return _m.SetRetryCount(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthSessionUpdateOne) SetNotNilRetryCount(value *int) *OauthSessionUpdateOne {
+	if value != nil {
+		return _m.SetRetryCount(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthSessionCreate) SetNotNilRetryCount(value *int) *OauthSessionCreate {
+	if value != nil {
+		return _m.SetRetryCount(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthSessionUpdate) SetNotNilDepartmentID(value *uint64) *OauthSessionUpdate {
+	if value != nil {
+		return _m.SetDepartmentID(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthSessionUpdateOne) SetNotNilDepartmentID(value *uint64) *OauthSessionUpdateOne {
+	if value != nil {
+		return _m.SetDepartmentID(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *OauthSessionCreate) SetNotNilDepartmentID(value *uint64) *OauthSessionCreate {
+	if value != nil {
+		return _m.SetDepartmentID(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *PositionUpdate) SetNotNilUpdatedAt(value *time.Time) *PositionUpdate {
+	if value != nil {
+		return _m.SetUpdatedAt(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *PositionUpdateOne) SetNotNilUpdatedAt(value *time.Time) *PositionUpdateOne {
+	if value != nil {
+		return _m.SetUpdatedAt(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *PositionCreate) SetNotNilUpdatedAt(value *time.Time) *PositionCreate {
+	if value != nil {
+		return _m.SetUpdatedAt(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *PositionUpdate) SetNotNilStatus(value *uint8) *PositionUpdate {
+	if value != nil {
+		return _m.SetStatus(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *PositionUpdateOne) SetNotNilStatus(value *uint8) *PositionUpdateOne {
+	if value != nil {
+		return _m.SetStatus(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *PositionCreate) SetNotNilStatus(value *uint8) *PositionCreate {
+	if value != nil {
+		return _m.SetStatus(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *PositionUpdate) SetNotNilSort(value *uint32) *PositionUpdate {
+	if value != nil {
+		return _m.SetSort(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *PositionUpdateOne) SetNotNilSort(value *uint32) *PositionUpdateOne {
+	if value != nil {
+		return _m.SetSort(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *PositionCreate) SetNotNilSort(value *uint32) *PositionCreate {
+	if value != nil {
+		return _m.SetSort(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *PositionUpdate) SetNotNilName(value *string) *PositionUpdate {
+	if value != nil {
+		return _m.SetName(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *PositionUpdateOne) SetNotNilName(value *string) *PositionUpdateOne {
+	if value != nil {
+		return _m.SetName(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *PositionCreate) SetNotNilName(value *string) *PositionCreate {
+	if value != nil {
+		return _m.SetName(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *PositionUpdate) SetNotNilCode(value *string) *PositionUpdate {
+	if value != nil {
+		return _m.SetCode(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *PositionUpdateOne) SetNotNilCode(value *string) *PositionUpdateOne {
+	if value != nil {
+		return _m.SetCode(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *PositionCreate) SetNotNilCode(value *string) *PositionCreate {
+	if value != nil {
+		return _m.SetCode(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *PositionUpdate) SetNotNilRemark(value *string) *PositionUpdate {
+	if value != nil {
+		return _m.SetRemark(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *PositionUpdateOne) SetNotNilRemark(value *string) *PositionUpdateOne {
+	if value != nil {
+		return _m.SetRemark(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *PositionCreate) SetNotNilRemark(value *string) *PositionCreate {
+	if value != nil {
+		return _m.SetRemark(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *PositionUpdate) SetNotNilDeptID(value *uint64) *PositionUpdate {
+	if value != nil {
+		return _m.SetDeptID(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *PositionUpdateOne) SetNotNilDeptID(value *uint64) *PositionUpdateOne {
+	if value != nil {
+		return _m.SetDeptID(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *PositionCreate) SetNotNilDeptID(value *uint64) *PositionCreate {
+	if value != nil {
+		return _m.SetDeptID(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *RoleUpdate) SetNotNilUpdatedAt(value *time.Time) *RoleUpdate {
+	if value != nil {
+		return _m.SetUpdatedAt(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *RoleUpdateOne) SetNotNilUpdatedAt(value *time.Time) *RoleUpdateOne {
+	if value != nil {
+		return _m.SetUpdatedAt(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *RoleCreate) SetNotNilUpdatedAt(value *time.Time) *RoleCreate {
+	if value != nil {
+		return _m.SetUpdatedAt(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *RoleUpdate) SetNotNilStatus(value *uint8) *RoleUpdate {
+	if value != nil {
+		return _m.SetStatus(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *RoleUpdateOne) SetNotNilStatus(value *uint8) *RoleUpdateOne {
+	if value != nil {
+		return _m.SetStatus(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *RoleCreate) SetNotNilStatus(value *uint8) *RoleCreate {
+	if value != nil {
+		return _m.SetStatus(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *RoleUpdate) SetNotNilName(value *string) *RoleUpdate {
+	if value != nil {
+		return _m.SetName(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *RoleUpdateOne) SetNotNilName(value *string) *RoleUpdateOne {
+	if value != nil {
+		return _m.SetName(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *RoleCreate) SetNotNilName(value *string) *RoleCreate {
+	if value != nil {
+		return _m.SetName(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *RoleUpdate) SetNotNilCode(value *string) *RoleUpdate {
+	if value != nil {
+		return _m.SetCode(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *RoleUpdateOne) SetNotNilCode(value *string) *RoleUpdateOne {
+	if value != nil {
+		return _m.SetCode(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *RoleCreate) SetNotNilCode(value *string) *RoleCreate {
+	if value != nil {
+		return _m.SetCode(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *RoleUpdate) SetNotNilDefaultRouter(value *string) *RoleUpdate {
+	if value != nil {
+		return _m.SetDefaultRouter(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *RoleUpdateOne) SetNotNilDefaultRouter(value *string) *RoleUpdateOne {
+	if value != nil {
+		return _m.SetDefaultRouter(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *RoleCreate) SetNotNilDefaultRouter(value *string) *RoleCreate {
+	if value != nil {
+		return _m.SetDefaultRouter(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *RoleUpdate) SetNotNilRemark(value *string) *RoleUpdate {
+	if value != nil {
+		return _m.SetRemark(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *RoleUpdateOne) SetNotNilRemark(value *string) *RoleUpdateOne {
+	if value != nil {
+		return _m.SetRemark(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *RoleCreate) SetNotNilRemark(value *string) *RoleCreate {
+	if value != nil {
+		return _m.SetRemark(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *RoleUpdate) SetNotNilSort(value *uint32) *RoleUpdate {
+	if value != nil {
+		return _m.SetSort(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *RoleUpdateOne) SetNotNilSort(value *uint32) *RoleUpdateOne {
+	if value != nil {
+		return _m.SetSort(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *RoleCreate) SetNotNilSort(value *uint32) *RoleCreate {
+	if value != nil {
+		return _m.SetSort(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *RoleUpdate) SetNotNilCustomDeptIds(value []uint64) *RoleUpdate {
+	if value != nil {
+		return _m.SetCustomDeptIds(value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *RoleUpdateOne) SetNotNilCustomDeptIds(value []uint64) *RoleUpdateOne {
+	if value != nil {
+		return _m.SetCustomDeptIds(value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *RoleCreate) SetNotNilCustomDeptIds(value []uint64) *RoleCreate {
+	if value != nil {
+		return _m.SetCustomDeptIds(value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *SamlAccountUpdate) SetNotNilUpdatedAt(value *time.Time) *SamlAccountUpdate {
+	if value != nil {
+		return _m.SetUpdatedAt(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *SamlAccountUpdateOne) SetNotNilUpdatedAt(value *time.Time) *SamlAccountUpdateOne {
+	if value != nil {
+		return _m.SetUpdatedAt(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *SamlAccountCreate) SetNotNilUpdatedAt(value *time.Time) *SamlAccountCreate {
+	if value != nil {
+		return _m.SetUpdatedAt(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *SamlAccountUpdate) SetNotNilStatus(value *uint8) *SamlAccountUpdate {
+	if value != nil {
+		return _m.SetStatus(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *SamlAccountUpdateOne) SetNotNilStatus(value *uint8) *SamlAccountUpdateOne {
+	if value != nil {
+		return _m.SetStatus(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *SamlAccountCreate) SetNotNilStatus(value *uint8) *SamlAccountCreate {
+	if value != nil {
+		return _m.SetStatus(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *SamlAccountUpdate) SetNotNilUserID(value *uuid.UUID) *SamlAccountUpdate {
+	if value != nil {
+		return _m.SetUserID(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *SamlAccountUpdateOne) SetNotNilUserID(value *uuid.UUID) *SamlAccountUpdateOne {
+	if value != nil {
+		return _m.SetUserID(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *SamlAccountCreate) SetNotNilUserID(value *uuid.UUID) *SamlAccountCreate {
+	if value != nil {
+		return _m.SetUserID(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *SamlAccountUpdate) SetNotNilProviderID(value *uint64) *SamlAccountUpdate {
+	if value != nil {
+		return _m.SetProviderID(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *SamlAccountUpdateOne) SetNotNilProviderID(value *uint64) *SamlAccountUpdateOne {
+	if value != nil {
+		return _m.SetProviderID(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *SamlAccountCreate) SetNotNilProviderID(value *uint64) *SamlAccountCreate {
+	if value != nil {
+		return _m.SetProviderID(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *SamlAccountUpdate) SetNotNilNameID(value *string) *SamlAccountUpdate {
+	if value != nil {
+		return _m.SetNameID(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *SamlAccountUpdateOne) SetNotNilNameID(value *string) *SamlAccountUpdateOne {
+	if value != nil {
+		return _m.SetNameID(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *SamlAccountCreate) SetNotNilNameID(value *string) *SamlAccountCreate {
+	if value != nil {
+		return _m.SetNameID(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *SamlAccountUpdate) SetNotNilNameIDFormat(value *string) *SamlAccountUpdate {
+	if value != nil {
+		return _m.SetNameIDFormat(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *SamlAccountUpdateOne) SetNotNilNameIDFormat(value *string) *SamlAccountUpdateOne {
+	if value != nil {
+		return _m.SetNameIDFormat(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *SamlAccountCreate) SetNotNilNameIDFormat(value *string) *SamlAccountCreate {
+	if value != nil {
+		return _m.SetNameIDFormat(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *SamlAccountUpdate) SetNotNilSessionIndex(value *string) *SamlAccountUpdate {
+	if value != nil {
+		return _m.SetSessionIndex(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *SamlAccountUpdateOne) SetNotNilSessionIndex(value *string) *SamlAccountUpdateOne {
+	if value != nil {
+		return _m.SetSessionIndex(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *SamlAccountCreate) SetNotNilSessionIndex(value *string) *SamlAccountCreate {
+	if value != nil {
+		return _m.SetSessionIndex(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *SamlAccountUpdate) SetNotNilEmail(value *string) *SamlAccountUpdate {
+	if value != nil {
+		return _m.SetEmail(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *SamlAccountUpdateOne) SetNotNilEmail(value *string) *SamlAccountUpdateOne {
+	if value != nil {
+		return _m.SetEmail(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *SamlAccountCreate) SetNotNilEmail(value *string) *SamlAccountCreate {
+	if value != nil {
+		return _m.SetEmail(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *SamlAccountUpdate) SetNotNilAttributes(value *map[string][]string) *SamlAccountUpdate {
+	if value != nil {
+		return _m.SetAttributes(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *SamlAccountUpdateOne) SetNotNilAttributes(value *map[string][]string) *SamlAccountUpdateOne {
+	if value != nil {
+		return _m.SetAttributes(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *SamlAccountCreate) SetNotNilAttributes(value *map[string][]string) *SamlAccountCreate {
+	if value != nil {
+		return _m.SetAttributes(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *SamlAccountUpdate) SetNotNilLastLoginAt(value *time.Time) *SamlAccountUpdate {
+	if value != nil {
+		return _m.SetLastLoginAt(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *SamlAccountUpdateOne) SetNotNilLastLoginAt(value *time.Time) *SamlAccountUpdateOne {
+	if value != nil {
+		return _m.SetLastLoginAt(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *SamlAccountCreate) SetNotNilLastLoginAt(value *time.Time) *SamlAccountCreate {
+	if value != nil {
+		return _m.SetLastLoginAt(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *SamlAccountUpdate) SetNotNilLastLoginIP(value *string) *SamlAccountUpdate {
+	if value != nil {
+		return _m.SetLastLoginIP(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *SamlAccountUpdateOne) SetNotNilLastLoginIP(value *string) *SamlAccountUpdateOne {
+	if value != nil {
+		return _m.SetLastLoginIP(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *SamlAccountCreate) SetNotNilLastLoginIP(value *string) *SamlAccountCreate {
+	if value != nil {
+		return _m.SetLastLoginIP(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *SamlAccountUpdate) SetNotNilLoginCount(value *uint32) *SamlAccountUpdate {
+	if value != nil {
+		return _m.SetLoginCount(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *SamlAccountUpdateOne) SetNotNilLoginCount(value *uint32) *SamlAccountUpdateOne {
+	if value != nil {
+		return _m.SetLoginCount(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *SamlAccountCreate) SetNotNilLoginCount(value *uint32) *SamlAccountCreate {
+	if value != nil {
+		return _m.SetLoginCount(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *SamlProviderUpdate) SetNotNilUpdatedAt(value *time.Time) *SamlProviderUpdate {
+	if value != nil {
+		return _m.SetUpdatedAt(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *SamlProviderUpdateOne) SetNotNilUpdatedAt(value *time.Time) *SamlProviderUpdateOne {
+	if value != nil {
+		return _m.SetUpdatedAt(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *SamlProviderCreate) SetNotNilUpdatedAt(value *time.Time) *SamlProviderCreate {
+	if value != nil {
+		return _m.SetUpdatedAt(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *SamlProviderUpdate) SetNotNilStatus(value *uint8) *SamlProviderUpdate {
+	if value != nil {
+		return _m.SetStatus(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *SamlProviderUpdateOne) SetNotNilStatus(value *uint8) *SamlProviderUpdateOne {
+	if value != nil {
+		return _m.SetStatus(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *SamlProviderCreate) SetNotNilStatus(value *uint8) *SamlProviderCreate {
+	if value != nil {
+		return _m.SetStatus(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *SamlProviderUpdate) SetNotNilName(value *string) *SamlProviderUpdate {
+	if value != nil {
+		return _m.SetName(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *SamlProviderUpdateOne) SetNotNilName(value *string) *SamlProviderUpdateOne {
+	if value != nil {
+		return _m.SetName(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *SamlProviderCreate) SetNotNilName(value *string) *SamlProviderCreate {
+	if value != nil {
+		return _m.SetName(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *SamlProviderUpdate) SetNotNilDisplayName(value *string) *SamlProviderUpdate {
+	if value != nil {
+		return _m.SetDisplayName(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *SamlProviderUpdateOne) SetNotNilDisplayName(value *string) *SamlProviderUpdateOne {
+	if value != nil {
+		return _m.SetDisplayName(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *SamlProviderCreate) SetNotNilDisplayName(value *string) *SamlProviderCreate {
+	if value != nil {
+		return _m.SetDisplayName(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *SamlProviderUpdate) SetNotNilIdpEntityID(value *string) *SamlProviderUpdate {
+	if value != nil {
+		return _m.SetIdpEntityID(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *SamlProviderUpdateOne) SetNotNilIdpEntityID(value *string) *SamlProviderUpdateOne {
+	if value != nil {
+		return _m.SetIdpEntityID(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *SamlProviderCreate) SetNotNilIdpEntityID(value *string) *SamlProviderCreate {
+	if value != nil {
+		return _m.SetIdpEntityID(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *SamlProviderUpdate) SetNotNilIdpSSOURL(value *string) *SamlProviderUpdate {
+	if value != nil {
+		return _m.SetIdpSSOURL(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *SamlProviderUpdateOne) SetNotNilIdpSSOURL(value *string) *SamlProviderUpdateOne {
+	if value != nil {
+		return _m.SetIdpSSOURL(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *SamlProviderCreate) SetNotNilIdpSSOURL(value *string) *SamlProviderCreate {
+	if value != nil {
+		return _m.SetIdpSSOURL(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *SamlProviderUpdate) SetNotNilIdpSloURL(value *string) *SamlProviderUpdate {
+	if value != nil {
+		return _m.SetIdpSloURL(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *SamlProviderUpdateOne) SetNotNilIdpSloURL(value *string) *SamlProviderUpdateOne {
+	if value != nil {
+		return _m.SetIdpSloURL(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *SamlProviderCreate) SetNotNilIdpSloURL(value *string) *SamlProviderCreate {
+	if value != nil {
+		return _m.SetIdpSloURL(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *SamlProviderUpdate) SetNotNilIdpCertificates(value *string) *SamlProviderUpdate {
+	if value != nil {
+		return _m.SetIdpCertificates(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *SamlProviderUpdateOne) SetNotNilIdpCertificates(value *string) *SamlProviderUpdateOne {
+	if value != nil {
+		return _m.SetIdpCertificates(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *SamlProviderCreate) SetNotNilIdpCertificates(value *string) *SamlProviderCreate {
+	if value != nil {
+		return _m.SetIdpCertificates(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *SamlProviderUpdate) SetNotNilIdpMetadataURL(value *string) *SamlProviderUpdate {
+	if value != nil {
+		return _m.SetIdpMetadataURL(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *SamlProviderUpdateOne) SetNotNilIdpMetadataURL(value *string) *SamlProviderUpdateOne {
+	if value != nil {
+		return _m.SetIdpMetadataURL(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *SamlProviderCreate) SetNotNilIdpMetadataURL(value *string) *SamlProviderCreate {
+	if value != nil {
+		return _m.SetIdpMetadataURL(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *SamlProviderUpdate) SetNotNilSpEntityID(value *string) *SamlProviderUpdate {
+	if value != nil {
+		return _m.SetSpEntityID(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *SamlProviderUpdateOne) SetNotNilSpEntityID(value *string) *SamlProviderUpdateOne {
+	if value != nil {
+		return _m.SetSpEntityID(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *SamlProviderCreate) SetNotNilSpEntityID(value *string) *SamlProviderCreate {
+	if value != nil {
+		return _m.SetSpEntityID(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *SamlProviderUpdate) SetNotNilAcsURL(value *string) *SamlProviderUpdate {
+	if value != nil {
+		return _m.SetAcsURL(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *SamlProviderUpdateOne) SetNotNilAcsURL(value *string) *SamlProviderUpdateOne {
+	if value != nil {
+		return _m.SetAcsURL(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *SamlProviderCreate) SetNotNilAcsURL(value *string) *SamlProviderCreate {
+	if value != nil {
+		return _m.SetAcsURL(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *SamlProviderUpdate) SetNotNilNameIDFormat(value *string) *SamlProviderUpdate {
+	if value != nil {
+		return _m.SetNameIDFormat(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *SamlProviderUpdateOne) SetNotNilNameIDFormat(value *string) *SamlProviderUpdateOne {
+	if value != nil {
+		return _m.SetNameIDFormat(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *SamlProviderCreate) SetNotNilNameIDFormat(value *string) *SamlProviderCreate {
+	if value != nil {
+		return _m.SetNameIDFormat(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *SamlProviderUpdate) SetNotNilSignAuthnRequest(value *bool) *SamlProviderUpdate {
+	if value != nil {
+		return _m.SetSignAuthnRequest(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *SamlProviderUpdateOne) SetNotNilSignAuthnRequest(value *bool) *SamlProviderUpdateOne {
+	if value != nil {
+		return _m.SetSignAuthnRequest(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *SamlProviderCreate) SetNotNilSignAuthnRequest(value *bool) *SamlProviderCreate {
+	if value != nil {
+		return _m.SetSignAuthnRequest(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *SamlProviderUpdate) SetNotNilWantAssertionsSigned(value *bool) *SamlProviderUpdate {
+	if value != nil {
+		return _m.SetWantAssertionsSigned(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *SamlProviderUpdateOne) SetNotNilWantAssertionsSigned(value *bool) *SamlProviderUpdateOne {
+	if value != nil {
+		return _m.SetWantAssertionsSigned(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *SamlProviderCreate) SetNotNilWantAssertionsSigned(value *bool) *SamlProviderCreate {
+	if value != nil {
+		return _m.SetWantAssertionsSigned(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *SamlProviderUpdate) SetNotNilAttributeMapping(value *map[string]interface{}) *SamlProviderUpdate {
+	if value != nil {
+		return _m.SetAttributeMapping(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *SamlProviderUpdateOne) SetNotNilAttributeMapping(value *map[string]interface{}) *SamlProviderUpdateOne {
+	if value != nil {
+		return _m.SetAttributeMapping(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *SamlProviderCreate) SetNotNilAttributeMapping(value *map[string]interface{}) *SamlProviderCreate {
+	if value != nil {
+		return _m.SetAttributeMapping(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *SamlProviderUpdate) SetNotNilJitProvision(value *bool) *SamlProviderUpdate {
+	if value != nil {
+		return _m.SetJitProvision(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *SamlProviderUpdateOne) SetNotNilJitProvision(value *bool) *SamlProviderUpdateOne {
+	if value != nil {
+		return _m.SetJitProvision(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *SamlProviderCreate) SetNotNilJitProvision(value *bool) *SamlProviderCreate {
+	if value != nil {
+		return _m.SetJitProvision(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *SamlProviderUpdate) SetNotNilSyncAttributes(value *bool) *SamlProviderUpdate {
+	if value != nil {
+		return _m.SetSyncAttributes(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *SamlProviderUpdateOne) SetNotNilSyncAttributes(value *bool) *SamlProviderUpdateOne {
+	if value != nil {
+		return _m.SetSyncAttributes(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *SamlProviderCreate) SetNotNilSyncAttributes(value *bool) *SamlProviderCreate {
+	if value != nil {
+		return _m.SetSyncAttributes(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *SamlProviderUpdate) SetNotNilLinkByEmail(value *bool) *SamlProviderUpdate {
+	if value != nil {
+		return _m.SetLinkByEmail(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *SamlProviderUpdateOne) SetNotNilLinkByEmail(value *bool) *SamlProviderUpdateOne {
+	if value != nil {
+		return _m.SetLinkByEmail(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *SamlProviderCreate) SetNotNilLinkByEmail(value *bool) *SamlProviderCreate {
+	if value != nil {
+		return _m.SetLinkByEmail(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *SamlProviderUpdate) SetNotNilDefaultDepartmentID(value *uint64) *SamlProviderUpdate {
+	if value != nil {
+		return _m.SetDefaultDepartmentID(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *SamlProviderUpdateOne) SetNotNilDefaultDepartmentID(value *uint64) *SamlProviderUpdateOne {
+	if value != nil {
+		return _m.SetDefaultDepartmentID(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *SamlProviderCreate) SetNotNilDefaultDepartmentID(value *uint64) *SamlProviderCreate {
+	if value != nil {
+		return _m.SetDefaultDepartmentID(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *SamlProviderUpdate) SetNotNilDefaultRoleIds(value []uint64) *SamlProviderUpdate {
+	if value != nil {
+		return _m.SetDefaultRoleIds(value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *SamlProviderUpdateOne) SetNotNilDefaultRoleIds(value []uint64) *SamlProviderUpdateOne {
+	if value != nil {
+		return _m.SetDefaultRoleIds(value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *SamlProviderCreate) SetNotNilDefaultRoleIds(value []uint64) *SamlProviderCreate {
+	if value != nil {
+		return _m.SetDefaultRoleIds(value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *SamlProviderUpdate) SetNotNilEnabled(value *bool) *SamlProviderUpdate {
+	if value != nil {
+		return _m.SetEnabled(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *SamlProviderUpdateOne) SetNotNilEnabled(value *bool) *SamlProviderUpdateOne {
+	if value != nil {
+		return _m.SetEnabled(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *SamlProviderCreate) SetNotNilEnabled(value *bool) *SamlProviderCreate {
+	if value != nil {
+		return _m.SetEnabled(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *SamlProviderUpdate) SetNotNilSort(value *uint32) *SamlProviderUpdate {
+	if value != nil {
+		return _m.SetSort(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *SamlProviderUpdateOne) SetNotNilSort(value *uint32) *SamlProviderUpdateOne {
+	if value != nil {
+		return _m.SetSort(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *SamlProviderCreate) SetNotNilSort(value *uint32) *SamlProviderCreate {
+	if value != nil {
+		return _m.SetSort(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *SamlProviderUpdate) SetNotNilRemark(value *string) *SamlProviderUpdate {
+	if value != nil {
+		return _m.SetRemark(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *SamlProviderUpdateOne) SetNotNilRemark(value *string) *SamlProviderUpdateOne {
+	if value != nil {
+		return _m.SetRemark(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *SamlProviderCreate) SetNotNilRemark(value *string) *SamlProviderCreate {
+	if value != nil {
+		return _m.SetRemark(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *ScimTokenUpdate) SetNotNilUpdatedAt(value *time.Time) *ScimTokenUpdate {
+	if value != nil {
+		return _m.SetUpdatedAt(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *ScimTokenUpdateOne) SetNotNilUpdatedAt(value *time.Time) *ScimTokenUpdateOne {
+	if value != nil {
+		return _m.SetUpdatedAt(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *ScimTokenCreate) SetNotNilUpdatedAt(value *time.Time) *ScimTokenCreate {
+	if value != nil {
+		return _m.SetUpdatedAt(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *ScimTokenUpdate) SetNotNilStatus(value *uint8) *ScimTokenUpdate {
+	if value != nil {
+		return _m.SetStatus(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *ScimTokenUpdateOne) SetNotNilStatus(value *uint8) *ScimTokenUpdateOne {
+	if value != nil {
+		return _m.SetStatus(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *ScimTokenCreate) SetNotNilStatus(value *uint8) *ScimTokenCreate {
+	if value != nil {
+		return _m.SetStatus(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *ScimTokenUpdate) SetNotNilName(value *string) *ScimTokenUpdate {
+	if value != nil {
+		return _m.SetName(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *ScimTokenUpdateOne) SetNotNilName(value *string) *ScimTokenUpdateOne {
+	if value != nil {
+		return _m.SetName(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *ScimTokenCreate) SetNotNilName(value *string) *ScimTokenCreate {
+	if value != nil {
+		return _m.SetName(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *ScimTokenUpdate) SetNotNilExpiredAt(value *time.Time) *ScimTokenUpdate {
+	if value != nil {
+		return _m.SetExpiredAt(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *ScimTokenUpdateOne) SetNotNilExpiredAt(value *time.Time) *ScimTokenUpdateOne {
+	if value != nil {
+		return _m.SetExpiredAt(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *ScimTokenCreate) SetNotNilExpiredAt(value *time.Time) *ScimTokenCreate {
+	if value != nil {
+		return _m.SetExpiredAt(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *ScimTokenUpdate) SetNotNilLastUsedAt(value *time.Time) *ScimTokenUpdate {
+	if value != nil {
+		return _m.SetLastUsedAt(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *ScimTokenUpdateOne) SetNotNilLastUsedAt(value *time.Time) *ScimTokenUpdateOne {
+	if value != nil {
+		return _m.SetLastUsedAt(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *ScimTokenCreate) SetNotNilLastUsedAt(value *time.Time) *ScimTokenCreate {
+	if value != nil {
+		return _m.SetLastUsedAt(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *ScimTokenUpdate) SetNotNilLastUsedIP(value *string) *ScimTokenUpdate {
+	if value != nil {
+		return _m.SetLastUsedIP(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *ScimTokenUpdateOne) SetNotNilLastUsedIP(value *string) *ScimTokenUpdateOne {
+	if value != nil {
+		return _m.SetLastUsedIP(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *ScimTokenCreate) SetNotNilLastUsedIP(value *string) *ScimTokenCreate {
+	if value != nil {
+		return _m.SetLastUsedIP(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *ScimTokenUpdate) SetNotNilRoleCodes(value []string) *ScimTokenUpdate {
+	if value != nil {
+		return _m.SetRoleCodes(value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *ScimTokenUpdateOne) SetNotNilRoleCodes(value []string) *ScimTokenUpdateOne {
+	if value != nil {
+		return _m.SetRoleCodes(value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *ScimTokenCreate) SetNotNilRoleCodes(value []string) *ScimTokenCreate {
 	if value != nil {
-		return _m.SetExpiresAt(*value)
+		return _m.SetRoleCodes(value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthSessionUpdateOne) SetNotNilExpiresAt(value *time.Time) *OauthSessionUpdateOne {
+func (_m *ScimTokenUpdate) SetNotNilRemark(value *string) *ScimTokenUpdate {
 	if value != nil {
-		return _m.SetExpiresAt(*value)
+		return _m.SetRemark(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthSessionCreate) SetNotNilExpiresAt(value *time.Time) *OauthSessionCreate {
+func (_m *ScimTokenUpdateOne) SetNotNilRemark(value *string) *ScimTokenUpdateOne {
 	if value != nil {
-		return _m.SetExpiresAt(*value)
+		return _m.SetRemark(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthSessionUpdate) SetNotNilClientIP(value *string) *OauthSessionUpdate {
+func (_m *ScimTokenCreate) SetNotNilRemark(value *string) *ScimTokenCreate {
 	if value != nil {
-		return _m.SetClientIP(*value)
+		return _m.SetRemark(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthSessionUpdateOne) SetNotNilClientIP(value *string) *OauthSessionUpdateOne {
+func (_m *TenantUpdate) SetNotNilUpdatedAt(value *time.Time) *TenantUpdate {
 	if value != nil {
-		return _m.SetClientIP(*value)
+		return _m.SetUpdatedAt(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthSessionCreate) SetNotNilClientIP(value *string) *OauthSessionCreate {
+func (_m *TenantUpdateOne) SetNotNilUpdatedAt(value *time.Time) *TenantUpdateOne {
 	if value != nil {
-		return _m.SetClientIP(*value)
+		return _m.SetUpdatedAt(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthSessionUpdate) SetNotNilUserAgent(value *string) *OauthSessionUpdate {
+func (_m *TenantCreate) SetNotNilUpdatedAt(value *time.Time) *TenantCreate {
 	if value != nil {
-		return _m.SetUserAgent(*value)
+		return _m.SetUpdatedAt(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthSessionUpdateOne) SetNotNilUserAgent(value *string) *OauthSessionUpdateOne {
+func (_m *TenantUpdate) SetNotNilStatus(value *uint8) *TenantUpdate {
 	if value != nil {
-		return _m.SetUserAgent(*value)
+		return _m.SetStatus(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthSessionCreate) SetNotNilUserAgent(value *string) *OauthSessionCreate {
+func (_m *TenantUpdateOne) SetNotNilStatus(value *uint8) *TenantUpdateOne {
 	if value != nil {
-		return _m.SetUserAgent(*value)
+		return _m.SetStatus(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthSessionUpdate) SetNotNilAuthorizationCode(value *string) *OauthSessionUpdate {
+func (_m *TenantCreate) SetNotNilStatus(value *uint8) *TenantCreate {
 	if value != nil {
-		return _m.SetAuthorizationCode(*value)
+		return _m.SetStatus(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthSessionUpdateOne) SetNotNilAuthorizationCode(value *string) *OauthSessionUpdateOne {
+func (_m *TenantUpdate) SetNotNilName(value *string) *TenantUpdate {
 	if value != nil {
-		return _m.SetAuthorizationCode(*value)
+		return _m.SetName(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthSessionCreate) SetNotNilAuthorizationCode(value *string) *OauthSessionCreate {
+func (_m *TenantUpdateOne) SetNotNilName(value *string) *TenantUpdateOne {
 	if value != nil {
-		return _m.SetAuthorizationCode(*value)
+		return _m.SetName(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthSessionUpdate) SetNotNilCodeReceivedAt(value *time.Time) *OauthSessionUpdate {
+func (_m *TenantCreate) SetNotNilName(value *string) *TenantCreate {
 	if value != nil {
-		return _m.SetCodeReceivedAt(*value)
+		return _m.SetName(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthSessionUpdateOne) SetNotNilCodeReceivedAt(value *time.Time) *OauthSessionUpdateOne {
+func (_m *TenantUpdate) SetNotNilCode(value *string) *TenantUpdate {
 	if value != nil {
-		return _m.SetCodeReceivedAt(*value)
+		return _m.SetCode(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthSessionCreate) SetNotNilCodeReceivedAt(value *time.Time) *OauthSessionCreate {
+func (_m *TenantUpdateOne) SetNotNilCode(value *string) *TenantUpdateOne {
 	if value != nil {
-		return _m.SetCodeReceivedAt(*value)
+		return _m.SetCode(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthSessionUpdate) SetNotNilCallbackData(value *map[string]interface{}) *OauthSessionUpdate {
+func (_m *TenantCreate) SetNotNilCode(value *string) *TenantCreate {
 	if value != nil {
-		return _m.SetCallbackData(*value)
+		return _m.SetCode(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthSessionUpdateOne) SetNotNilCallbackData(value *map[string]interface{}) *OauthSessionUpdateOne {
+func (_m *TenantUpdate) SetNotNilDescription(value *string) *TenantUpdate {
 	if value != nil {
-		return _m.SetCallbackData(*value)
+		return _m.SetDescription(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthSessionCreate) SetNotNilCallbackData(value *map[string]interface{}) *OauthSessionCreate {
+func (_m *TenantUpdateOne) SetNotNilDescription(value *string) *TenantUpdateOne {
 	if value != nil {
-		return _m.SetCallbackData(*value)
+		return _m.SetDescription(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthSessionUpdate) SetNotNilErrorCode(value *string) *OauthSessionUpdate {
+func (_m *TenantCreate) SetNotNilDescription(value *string) *TenantCreate {
 	if value != nil {
-		return _m.SetErrorCode(*value)
+		return _m.SetDescription(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthSessionUpdateOne) SetNotNilErrorCode(value *string) *OauthSessionUpdateOne {
+func (_m *TenantUpdate) SetNotNilExpiredAt(value *time.Time) *TenantUpdate {
 	if value != nil {
-		return _m.SetErrorCode(*value)
+		return _m.SetExpiredAt(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthSessionCreate) SetNotNilErrorCode(value *string) *OauthSessionCreate {
+func (_m *TenantUpdateOne) SetNotNilExpiredAt(value *time.Time) *TenantUpdateOne {
 	if value != nil {
-		return _m.SetErrorCode(*value)
+		return _m.SetExpiredAt(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthSessionUpdate) SetNotNilErrorDescription(value *string) *OauthSessionUpdate {
+func (_m *TenantCreate) SetNotNilExpiredAt(value *time.Time) *TenantCreate {
 	if value != nil {
-		return _m.SetErrorDescription(*value)
+		return _m.SetExpiredAt(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthSessionUpdateOne) SetNotNilErrorDescription(value *string) *OauthSessionUpdateOne {
+func (_m *TenantUpdate) SetNotNilConfig(value *map[string]interface{}) *TenantUpdate {
 	if value != nil {
-		return _m.SetErrorDescription(*value)
+		return _m.SetConfig(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthSessionCreate) SetNotNilErrorDescription(value *string) *OauthSessionCreate {
+func (_m *TenantUpdateOne) SetNotNilConfig(value *map[string]interface{}) *TenantUpdateOne {
+	if value != nil {
+		return _m.SetConfig(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *TenantCreate) SetNotNilConfig(value *map[string]interface{}) *TenantCreate {
+	if value != nil {
+		return _m.SetConfig(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *TenantUpdate) SetNotNilCreatedBy(value *uint64) *TenantUpdate {
+	if value != nil {
+		return _m.SetCreatedBy(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *TenantUpdateOne) SetNotNilCreatedBy(value *uint64) *TenantUpdateOne {
+	if value != nil {
+		return _m.SetCreatedBy(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *TenantCreate) SetNotNilCreatedBy(value *uint64) *TenantCreate {
+	if value != nil {
+		return _m.SetCreatedBy(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *TenantInitJobUpdate) SetNotNilUpdatedAt(value *time.Time) *TenantInitJobUpdate {
+	if value != nil {
+		return _m.SetUpdatedAt(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *TenantInitJobUpdateOne) SetNotNilUpdatedAt(value *time.Time) *TenantInitJobUpdateOne {
+	if value != nil {
+		return _m.SetUpdatedAt(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *TenantInitJobCreate) SetNotNilUpdatedAt(value *time.Time) *TenantInitJobCreate {
+	if value != nil {
+		return _m.SetUpdatedAt(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *TenantInitJobUpdate) SetNotNilTenantID(value *uint64) *TenantInitJobUpdate {
+	if value != nil {
+		return _m.SetTenantID(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *TenantInitJobUpdateOne) SetNotNilTenantID(value *uint64) *TenantInitJobUpdateOne {
+	if value != nil {
+		return _m.SetTenantID(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *TenantInitJobCreate) SetNotNilTenantID(value *uint64) *TenantInitJobCreate {
+	if value != nil {
+		return _m.SetTenantID(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *TenantInitJobUpdate) SetNotNilTemplate(value *string) *TenantInitJobUpdate {
+	if value != nil {
+		return _m.SetTemplate(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *TenantInitJobUpdateOne) SetNotNilTemplate(value *string) *TenantInitJobUpdateOne {
+	if value != nil {
+		return _m.SetTemplate(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *TenantInitJobCreate) SetNotNilTemplate(value *string) *TenantInitJobCreate {
+	if value != nil {
+		return _m.SetTemplate(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *TenantInitJobUpdate) SetNotNilMode(value *string) *TenantInitJobUpdate {
+	if value != nil {
+		return _m.SetMode(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *TenantInitJobUpdateOne) SetNotNilMode(value *string) *TenantInitJobUpdateOne {
+	if value != nil {
+		return _m.SetMode(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *TenantInitJobCreate) SetNotNilMode(value *string) *TenantInitJobCreate {
+	if value != nil {
+		return _m.SetMode(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *TenantInitJobUpdate) SetNotNilDryRun(value *bool) *TenantInitJobUpdate {
+	if value != nil {
+		return _m.SetDryRun(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *TenantInitJobUpdateOne) SetNotNilDryRun(value *bool) *TenantInitJobUpdateOne {
+	if value != nil {
+		return _m.SetDryRun(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *TenantInitJobCreate) SetNotNilDryRun(value *bool) *TenantInitJobCreate {
+	if value != nil {
+		return _m.SetDryRun(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *TenantInitJobUpdate) SetNotNilStatus(value *string) *TenantInitJobUpdate {
+	if value != nil {
+		return _m.SetStatus(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *TenantInitJobUpdateOne) SetNotNilStatus(value *string) *TenantInitJobUpdateOne {
+	if value != nil {
+		return _m.SetStatus(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *TenantInitJobCreate) SetNotNilStatus(value *string) *TenantInitJobCreate {
+	if value != nil {
+		return _m.SetStatus(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *TenantInitJobUpdate) SetNotNilAdminUsername(value *string) *TenantInitJobUpdate {
+	if value != nil {
+		return _m.SetAdminUsername(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *TenantInitJobUpdateOne) SetNotNilAdminUsername(value *string) *TenantInitJobUpdateOne {
+	if value != nil {
+		return _m.SetAdminUsername(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *TenantInitJobCreate) SetNotNilAdminUsername(value *string) *TenantInitJobCreate {
 	if value != nil {
-		return _m.SetErrorDescription(*value)
+		return _m.SetAdminUsername(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthSessionUpdate) SetNotNilRetryCount(value *int) *OauthSessionUpdate {
+func (_m *TenantInitJobUpdate) SetNotNilAdminEmail(value *string) *TenantInitJobUpdate {
 	if value != nil {
-		return _m.SetRetryCount(*value)
+		return _m.SetAdminEmail(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthSessionUpdateOne) SetNotNilRetryCount(value *int) *OauthSessionUpdateOne {
+func (_m *TenantInitJobUpdateOne) SetNotNilAdminEmail(value *string) *TenantInitJobUpdateOne {
 	if value != nil {
-		return _m.SetRetryCount(*value)
+		return _m.SetAdminEmail(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthSessionCreate) SetNotNilRetryCount(value *int) *OauthSessionCreate {
+func (_m *TenantInitJobCreate) SetNotNilAdminEmail(value *string) *TenantInitJobCreate {
 	if value != nil {
-		return _m.SetRetryCount(*value)
+		return _m.SetAdminEmail(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthSessionUpdate) SetNotNilDepartmentID(value *uint64) *OauthSessionUpdate {
+func (_m *TenantInitJobUpdate) SetNotNilAttempts(value *uint32) *TenantInitJobUpdate {
 	if value != nil {
-		return _m.SetDepartmentID(*value)
+		return _m.SetAttempts(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthSessionUpdateOne) SetNotNilDepartmentID(value *uint64) *OauthSessionUpdateOne {
+func (_m *TenantInitJobUpdateOne) SetNotNilAttempts(value *uint32) *TenantInitJobUpdateOne {
 	if value != nil {
-		return _m.SetDepartmentID(*value)
+		return _m.SetAttempts(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *OauthSessionCreate) SetNotNilDepartmentID(value *uint64) *OauthSessionCreate {
+func (_m *TenantInitJobCreate) SetNotNilAttempts(value *uint32) *TenantInitJobCreate {
 	if value != nil {
-		return _m.SetDepartmentID(*value)
+		return _m.SetAttempts(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *PositionUpdate) SetNotNilUpdatedAt(value *time.Time) *PositionUpdate {
+func (_m *TenantInitJobUpdate) SetNotNilError(value *string) *TenantInitJobUpdate {
 	if value != nil {
-		return _m.SetUpdatedAt(*value)
+		return _m.SetError(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *PositionUpdateOne) SetNotNilUpdatedAt(value *time.Time) *PositionUpdateOne {
+func (_m *TenantInitJobUpdateOne) SetNotNilError(value *string) *TenantInitJobUpdateOne {
 	if value != nil {
-		return _m.SetUpdatedAt(*value)
+		return _m.SetError(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *PositionCreate) SetNotNilUpdatedAt(value *time.Time) *PositionCreate {
+func (_m *TenantInitJobCreate) SetNotNilError(value *string) *TenantInitJobCreate {
 	if value != nil {
-		return _m.SetUpdatedAt(*value)
+		return _m.SetError(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *PositionUpdate) SetNotNilStatus(value *uint8) *PositionUpdate {
+func (_m *TenantInitJobUpdate) SetNotNilPlugins(value []initjob.PluginProgress) *TenantInitJobUpdate {
 	if value != nil {
-		return _m.SetStatus(*value)
+		return _m.SetPlugins(value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *PositionUpdateOne) SetNotNilStatus(value *uint8) *PositionUpdateOne {
+func (_m *TenantInitJobUpdateOne) SetNotNilPlugins(value []initjob.PluginProgress) *TenantInitJobUpdateOne {
 	if value != nil {
-		return _m.SetStatus(*value)
+		return _m.SetPlugins(value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *PositionCreate) SetNotNilStatus(value *uint8) *PositionCreate {
+func (_m *TenantInitJobCreate) SetNotNilPlugins(value []initjob.PluginProgress) *TenantInitJobCreate {
 	if value != nil {
-		return _m.SetStatus(*value)
+		return _m.SetPlugins(value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *PositionUpdate) SetNotNilSort(value *uint32) *PositionUpdate {
+func (_m *TenantInitJobUpdate) SetNotNilPlan(value []initjob.PlanItem) *TenantInitJobUpdate {
 	if value != nil {
-		return _m.SetSort(*value)
+		return _m.SetPlan(value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *PositionUpdateOne) SetNotNilSort(value *uint32) *PositionUpdateOne {
+func (_m *TenantInitJobUpdateOne) SetNotNilPlan(value []initjob.PlanItem) *TenantInitJobUpdateOne {
 	if value != nil {
-		return _m.SetSort(*value)
+		return _m.SetPlan(value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *PositionCreate) SetNotNilSort(value *uint32) *PositionCreate {
+func (_m *TenantInitJobCreate) SetNotNilPlan(value []initjob.PlanItem) *TenantInitJobCreate {
 	if value != nil {
-		return _m.SetSort(*value)
+		return _m.SetPlan(value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *PositionUpdate) SetNotNilName(value *string) *PositionUpdate {
+func (_m *TenantInitJobUpdate) SetNotNilStartedAt(value *time.Time) *TenantInitJobUpdate {
 	if value != nil {
-		return _m.SetName(*value)
+		return _m.SetStartedAt(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *PositionUpdateOne) SetNotNilName(value *string) *PositionUpdateOne {
+func (_m *TenantInitJobUpdateOne) SetNotNilStartedAt(value *time.Time) *TenantInitJobUpdateOne {
 	if value != nil {
-		return _m.SetName(*value)
+		return _m.SetStartedAt(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *PositionCreate) SetNotNilName(value *string) *PositionCreate {
+func (_m *TenantInitJobCreate) SetNotNilStartedAt(value *time.Time) *TenantInitJobCreate {
 	if value != nil {
-		return _m.SetName(*value)
+		return _m.SetStartedAt(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *PositionUpdate) SetNotNilCode(value *string) *PositionUpdate {
+func (_m *TenantInitJobUpdate) SetNotNilFinishedAt(value *time.Time) *TenantInitJobUpdate {
 	if value != nil {
-		return _m.SetCode(*value)
+		return _m.SetFinishedAt(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *PositionUpdateOne) SetNotNilCode(value *string) *PositionUpdateOne {
+func (_m *TenantInitJobUpdateOne) SetNotNilFinishedAt(value *time.Time) *TenantInitJobUpdateOne {
 	if value != nil {
-		return _m.SetCode(*value)
+		return _m.SetFinishedAt(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *PositionCreate) SetNotNilCode(value *string) *PositionCreate {
+func (_m *TenantInitJobCreate) SetNotNilFinishedAt(value *time.Time) *TenantInitJobCreate {
 	if value != nil {
-		return _m.SetCode(*value)
+		return _m.SetFinishedAt(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *PositionUpdate) SetNotNilRemark(value *string) *PositionUpdate {
+func (_m *TenantInitPluginUpdate) SetNotNilUpdatedAt(value *time.Time) *TenantInitPluginUpdate {
 	if value != nil {
-		return _m.SetRemark(*value)
+		return _m.SetUpdatedAt(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *PositionUpdateOne) SetNotNilRemark(value *string) *PositionUpdateOne {
+func (_m *TenantInitPluginUpdateOne) SetNotNilUpdatedAt(value *time.Time) *TenantInitPluginUpdateOne {
 	if value != nil {
-		return _m.SetRemark(*value)
+		return _m.SetUpdatedAt(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *PositionCreate) SetNotNilRemark(value *string) *PositionCreate {
+func (_m *TenantInitPluginCreate) SetNotNilUpdatedAt(value *time.Time) *TenantInitPluginCreate {
 	if value != nil {
-		return _m.SetRemark(*value)
+		return _m.SetUpdatedAt(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *PositionUpdate) SetNotNilDeptID(value *uint64) *PositionUpdate {
+func (_m *TenantInitPluginUpdate) SetNotNilStatus(value *uint8) *TenantInitPluginUpdate {
 	if value != nil {
-		return _m.SetDeptID(*value)
+		return _m.SetStatus(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *PositionUpdateOne) SetNotNilDeptID(value *uint64) *PositionUpdateOne {
+func (_m *TenantInitPluginUpdateOne) SetNotNilStatus(value *uint8) *TenantInitPluginUpdateOne {
 	if value != nil {
-		return _m.SetDeptID(*value)
+		return _m.SetStatus(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *PositionCreate) SetNotNilDeptID(value *uint64) *PositionCreate {
+func (_m *TenantInitPluginCreate) SetNotNilStatus(value *uint8) *TenantInitPluginCreate {
 	if value != nil {
-		return _m.SetDeptID(*value)
+		return _m.SetStatus(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *RoleUpdate) SetNotNilUpdatedAt(value *time.Time) *RoleUpdate {
+func (_m *TenantInitPluginUpdate) SetNotNilName(value *string) *TenantInitPluginUpdate {
 	if value != nil {
-		return _m.SetUpdatedAt(*value)
+		return _m.SetName(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *RoleUpdateOne) SetNotNilUpdatedAt(value *time.Time) *RoleUpdateOne {
+func (_m *TenantInitPluginUpdateOne) SetNotNilName(value *string) *TenantInitPluginUpdateOne {
 	if value != nil {
-		return _m.SetUpdatedAt(*value)
+		return _m.SetName(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *RoleCreate) SetNotNilUpdatedAt(value *time.Time) *RoleCreate {
+func (_m *TenantInitPluginCreate) SetNotNilName(value *string) *TenantInitPluginCreate {
 	if value != nil {
-		return _m.SetUpdatedAt(*value)
+		return _m.SetName(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *RoleUpdate) SetNotNilStatus(value *uint8) *RoleUpdate {
+func (_m *TenantInitPluginUpdate) SetNotNilVersion(value *string) *TenantInitPluginUpdate {
 	if value != nil {
-		return _m.SetStatus(*value)
+		return _m.SetVersion(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *RoleUpdateOne) SetNotNilStatus(value *uint8) *RoleUpdateOne {
+func (_m *TenantInitPluginUpdateOne) SetNotNilVersion(value *string) *TenantInitPluginUpdateOne {
 	if value != nil {
-		return _m.SetStatus(*value)
+		return _m.SetVersion(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *RoleCreate) SetNotNilStatus(value *uint8) *RoleCreate {
+func (_m *TenantInitPluginCreate) SetNotNilVersion(value *string) *TenantInitPluginCreate {
 	if value != nil {
-		return _m.SetStatus(*value)
+		return _m.SetVersion(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *RoleUpdate) SetNotNilName(value *string) *RoleUpdate {
+func (_m *TenantInitPluginUpdate) SetNotNilDescription(value *string) *TenantInitPluginUpdate {
 	if value != nil {
-		return _m.SetName(*value)
+		return _m.SetDescription(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *RoleUpdateOne) SetNotNilName(value *string) *RoleUpdateOne {
+func (_m *TenantInitPluginUpdateOne) SetNotNilDescription(value *string) *TenantInitPluginUpdateOne {
 	if value != nil {
-		return _m.SetName(*value)
+		return _m.SetDescription(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *RoleCreate) SetNotNilName(value *string) *RoleCreate {
+func (_m *TenantInitPluginCreate) SetNotNilDescription(value *string) *TenantInitPluginCreate {
 	if value != nil {
-		return _m.SetName(*value)
+		return _m.SetDescription(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *RoleUpdate) SetNotNilCode(value *string) *RoleUpdate {
+func (_m *TenantInitPluginUpdate) SetNotNilTarget(value *string) *TenantInitPluginUpdate {
 	if value != nil {
-		return _m.SetCode(*value)
+		return _m.SetTarget(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *RoleUpdateOne) SetNotNilCode(value *string) *RoleUpdateOne {
+func (_m *TenantInitPluginUpdateOne) SetNotNilTarget(value *string) *TenantInitPluginUpdateOne {
 	if value != nil {
-		return _m.SetCode(*value)
+		return _m.SetTarget(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *RoleCreate) SetNotNilCode(value *string) *RoleCreate {
+func (_m *TenantInitPluginCreate) SetNotNilTarget(value *string) *TenantInitPluginCreate {
 	if value != nil {
-		return _m.SetCode(*value)
+		return _m.SetTarget(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *RoleUpdate) SetNotNilDefaultRouter(value *string) *RoleUpdate {
+func (_m *TenantInitPluginUpdate) SetNotNilDependencies(value []string) *TenantInitPluginUpdate {
 	if value != nil {
-		return _m.SetDefaultRouter(*value)
+		return _m.SetDependencies(value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *RoleUpdateOne) SetNotNilDefaultRouter(value *string) *RoleUpdateOne {
+func (_m *TenantInitPluginUpdateOne) SetNotNilDependencies(value []string) *TenantInitPluginUpdateOne {
 	if value != nil {
-		return _m.SetDefaultRouter(*value)
+		return _m.SetDependencies(value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *RoleCreate) SetNotNilDefaultRouter(value *string) *RoleCreate {
+func (_m *TenantInitPluginCreate) SetNotNilDependencies(value []string) *TenantInitPluginCreate {
 	if value != nil {
-		return _m.SetDefaultRouter(*value)
+		return _m.SetDependencies(value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *RoleUpdate) SetNotNilRemark(value *string) *RoleUpdate {
+func (_m *TenantInitPluginUpdate) SetNotNilPriority(value *int32) *TenantInitPluginUpdate {
 	if value != nil {
-		return _m.SetRemark(*value)
+		return _m.SetPriority(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *RoleUpdateOne) SetNotNilRemark(value *string) *RoleUpdateOne {
+func (_m *TenantInitPluginUpdateOne) SetNotNilPriority(value *int32) *TenantInitPluginUpdateOne {
 	if value != nil {
-		return _m.SetRemark(*value)
+		return _m.SetPriority(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *RoleCreate) SetNotNilRemark(value *string) *RoleCreate {
+func (_m *TenantInitPluginCreate) SetNotNilPriority(value *int32) *TenantInitPluginCreate {
 	if value != nil {
-		return _m.SetRemark(*value)
+		return _m.SetPriority(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *RoleUpdate) SetNotNilSort(value *uint32) *RoleUpdate {
+func (_m *TenantInitPluginUpdate) SetNotNilTimeout(value *uint32) *TenantInitPluginUpdate {
 	if value != nil {
-		return _m.SetSort(*value)
+		return _m.SetTimeout(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *RoleUpdateOne) SetNotNilSort(value *uint32) *RoleUpdateOne {
+func (_m *TenantInitPluginUpdateOne) SetNotNilTimeout(value *uint32) *TenantInitPluginUpdateOne {
 	if value != nil {
-		return _m.SetSort(*value)
+		return _m.SetTimeout(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *RoleCreate) SetNotNilSort(value *uint32) *RoleCreate {
+func (_m *TenantInitPluginCreate) SetNotNilTimeout(value *uint32) *TenantInitPluginCreate {
 	if value != nil {
-		return _m.SetSort(*value)
+		return _m.SetTimeout(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *RoleUpdate) SetNotNilCustomDeptIds(value []uint64) *RoleUpdate {
+func (_m *TenantInitPluginUpdate) SetNotNilSupportRollback(value *bool) *TenantInitPluginUpdate {
 	if value != nil {
-		return _m.SetCustomDeptIds(value)
+		return _m.SetSupportRollback(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *RoleUpdateOne) SetNotNilCustomDeptIds(value []uint64) *RoleUpdateOne {
+func (_m *TenantInitPluginUpdateOne) SetNotNilSupportRollback(value *bool) *TenantInitPluginUpdateOne {
 	if value != nil {
-		return _m.SetCustomDeptIds(value)
+		return _m.SetSupportRollback(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *RoleCreate) SetNotNilCustomDeptIds(value []uint64) *RoleCreate {
+func (_m *TenantInitPluginCreate) SetNotNilSupportRollback(value *bool) *TenantInitPluginCreate {
 	if value != nil {
-		return _m.SetCustomDeptIds(value)
+		return _m.SetSupportRollback(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *TenantUpdate) SetNotNilUpdatedAt(value *time.Time) *TenantUpdate {
+func (_m *TenantInitTemplateUpdate) SetNotNilUpdatedAt(value *time.Time) *TenantInitTemplateUpdate {
 	if value != nil {
 		return _m.SetUpdatedAt(*value)
 	}
@@ -4531,7 +9309,7 @@ func (_m *TenantUpdate) SetNotNilUpdatedAt(value *time.Time) *TenantUpdate {
 }
 
 // set field if value's pointer is not nil.
-func (_m *TenantUpdateOne) SetNotNilUpdatedAt(value *time.Time) *TenantUpdateOne {
+func (_m *TenantInitTemplateUpdateOne) SetNotNilUpdatedAt(value *time.Time) *TenantInitTemplateUpdateOne {
 	if value != nil {
 		return _m.SetUpdatedAt(*value)
 	}
@@ -4539,7 +9317,7 @@ func (_m *TenantUpdateOne) SetNotNilUpdatedAt(value *time.Time) *TenantUpdateOne
 }
 
 // set field if value's pointer is not nil.
-func (_m *TenantCreate) SetNotNilUpdatedAt(value *time.Time) *TenantCreate {
+func (_m *TenantInitTemplateCreate) SetNotNilUpdatedAt(value *time.Time) *TenantInitTemplateCreate {
 	if value != nil {
 		return _m.SetUpdatedAt(*value)
 	}
@@ -4547,7 +9325,7 @@ func (_m *TenantCreate) SetNotNilUpdatedAt(value *time.Time) *TenantCreate {
 }
 
 // set field if value's pointer is not nil.
-func (_m *TenantUpdate) SetNotNilStatus(value *uint8) *TenantUpdate {
+func (_m *TenantInitTemplateUpdate) SetNotNilStatus(value *uint8) *TenantInitTemplateUpdate {
 	if value != nil {
 		return _m.SetStatus(*value)
 	}
@@ -4555,7 +9333,7 @@ func (_m *TenantUpdate) SetNotNilStatus(value *uint8) *TenantUpdate {
 }
 
 // set field if value's pointer is not nil.
-func (_m *TenantUpdateOne) SetNotNilStatus(value *uint8) *TenantUpdateOne {
+func (_m *TenantInitTemplateUpdateOne) SetNotNilStatus(value *uint8) *TenantInitTemplateUpdateOne {
 	if value != nil {
 		return _m.SetStatus(*value)
 	}
@@ -4563,7 +9341,7 @@ func (_m *TenantUpdateOne) SetNotNilStatus(value *uint8) *TenantUpdateOne {
 }
 
 // set field if value's pointer is not nil.
-func (_m *TenantCreate) SetNotNilStatus(value *uint8) *TenantCreate {
+func (_m *TenantInitTemplateCreate) SetNotNilStatus(value *uint8) *TenantInitTemplateCreate {
 	if value != nil {
 		return _m.SetStatus(*value)
 	}
@@ -4571,7 +9349,7 @@ func (_m *TenantCreate) SetNotNilStatus(value *uint8) *TenantCreate {
 }
 
 // set field if value's pointer is not nil.
-func (_m *TenantUpdate) SetNotNilName(value *string) *TenantUpdate {
+func (_m *TenantInitTemplateUpdate) SetNotNilName(value *string) *TenantInitTemplateUpdate {
 	if value != nil {
 		return _m.SetName(*value)
 	}
@@ -4579,7 +9357,7 @@ func (_m *TenantUpdate) SetNotNilName(value *string) *TenantUpdate {
 }
 
 // set field if value's pointer is not nil.
-func (_m *TenantUpdateOne) SetNotNilName(value *string) *TenantUpdateOne {
+func (_m *TenantInitTemplateUpdateOne) SetNotNilName(value *string) *TenantInitTemplateUpdateOne {
 	if value != nil {
 		return _m.SetName(*value)
 	}
@@ -4587,7 +9365,7 @@ func (_m *TenantUpdateOne) SetNotNilName(value *string) *TenantUpdateOne {
 }
 
 // set field if value's pointer is not nil.
-func (_m *TenantCreate) SetNotNilName(value *string) *TenantCreate {
+func (_m *TenantInitTemplateCreate) SetNotNilName(value *string) *TenantInitTemplateCreate {
 	if value != nil {
 		return _m.SetName(*value)
 	}
@@ -4595,31 +9373,31 @@ func (_m *TenantCreate) SetNotNilName(value *string) *TenantCreate {
 }
 
 // set field if value's pointer is not nil.
-func (_m *TenantUpdate) SetNotNilCode(value *string) *TenantUpdate {
+func (_m *TenantInitTemplateUpdate) SetNotNilVersion(value *string) *TenantInitTemplateUpdate {
 	if value != nil {
-		return _m.SetCode(*value)
+		return _m.SetVersion(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *TenantUpdateOne) SetNotNilCode(value *string) *TenantUpdateOne {
+func (_m *TenantInitTemplateUpdateOne) SetNotNilVersion(value *string) *TenantInitTemplateUpdateOne {
 	if value != nil {
-		return _m.SetCode(*value)
+		return _m.SetVersion(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *TenantCreate) SetNotNilCode(value *string) *TenantCreate {
+func (_m *TenantInitTemplateCreate) SetNotNilVersion(value *string) *TenantInitTemplateCreate {
 	if value != nil {
-		return _m.SetCode(*value)
+		return _m.SetVersion(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *TenantUpdate) SetNotNilDescription(value *string) *TenantUpdate {
+func (_m *TenantInitTemplateUpdate) SetNotNilDescription(value *string) *TenantInitTemplateUpdate {
 	if value != nil {
 		return _m.SetDescription(*value)
 	}
@@ -4627,7 +9405,7 @@ func (_m *TenantUpdate) SetNotNilDescription(value *string) *TenantUpdate {
 }
 
 // set field if value's pointer is not nil.
-func (_m *TenantUpdateOne) SetNotNilDescription(value *string) *TenantUpdateOne {
+func (_m *TenantInitTemplateUpdateOne) SetNotNilDescription(value *string) *TenantInitTemplateUpdateOne {
 	if value != nil {
 		return _m.SetDescription(*value)
 	}
@@ -4635,7 +9413,7 @@ func (_m *TenantUpdateOne) SetNotNilDescription(value *string) *TenantUpdateOne
 }
 
 // set field if value's pointer is not nil.
-func (_m *TenantCreate) SetNotNilDescription(value *string) *TenantCreate {
+func (_m *TenantInitTemplateCreate) SetNotNilDescription(value *string) *TenantInitTemplateCreate {
 	if value != nil {
 		return _m.SetDescription(*value)
 	}
@@ -4643,73 +9421,73 @@ func (_m *TenantCreate) SetNotNilDescription(value *string) *TenantCreate {
 }
 
 // set field if value's pointer is not nil.
-func (_m *TenantUpdate) SetNotNilExpiredAt(value *time.Time) *TenantUpdate {
+func (_m *TenantInitTemplateUpdate) SetNotNilBaseTemplate(value *string) *TenantInitTemplateUpdate {
 	if value != nil {
-		return _m.SetExpiredAt(*value)
+		return _m.SetBaseTemplate(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *TenantUpdateOne) SetNotNilExpiredAt(value *time.Time) *TenantUpdateOne {
+func (_m *TenantInitTemplateUpdateOne) SetNotNilBaseTemplate(value *string) *TenantInitTemplateUpdateOne {
 	if value != nil {
-		return _m.SetExpiredAt(*value)
+		return _m.SetBaseTemplate(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *TenantCreate) SetNotNilExpiredAt(value *time.Time) *TenantCreate {
+func (_m *TenantInitTemplateCreate) SetNotNilBaseTemplate(value *string) *TenantInitTemplateCreate {
 	if value != nil {
-		return _m.SetExpiredAt(*value)
+		return _m.SetBaseTemplate(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *TenantUpdate) SetNotNilConfig(value *map[string]interface{}) *TenantUpdate {
+func (_m *TenantInitTemplateUpdate) SetNotNilComponents(value *string) *TenantInitTemplateUpdate {
 	if value != nil {
-		return _m.SetConfig(*value)
+		return _m.SetComponents(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *TenantUpdateOne) SetNotNilConfig(value *map[string]interface{}) *TenantUpdateOne {
+func (_m *TenantInitTemplateUpdateOne) SetNotNilComponents(value *string) *TenantInitTemplateUpdateOne {
 	if value != nil {
-		return _m.SetConfig(*value)
+		return _m.SetComponents(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *TenantCreate) SetNotNilConfig(value *map[string]interface{}) *TenantCreate {
+func (_m *TenantInitTemplateCreate) SetNotNilComponents(value *string) *TenantInitTemplateCreate {
 	if value != nil {
-		return _m.SetConfig(*value)
+		return _m.SetComponents(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *TenantUpdate) SetNotNilCreatedBy(value *uint64) *TenantUpdate {
+func (_m *TenantInitTemplateUpdate) SetNotNilSort(value *uint32) *TenantInitTemplateUpdate {
 	if value != nil {
-		return _m.SetCreatedBy(*value)
+		return _m.SetSort(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *TenantUpdateOne) SetNotNilCreatedBy(value *uint64) *TenantUpdateOne {
+func (_m *TenantInitTemplateUpdateOne) SetNotNilSort(value *uint32) *TenantInitTemplateUpdateOne {
 	if value != nil {
-		return _m.SetCreatedBy(*value)
+		return _m.SetSort(*value)
 	}
 	return _m
 }
 
 // set field if value's pointer is not nil.
-func (_m *TenantCreate) SetNotNilCreatedBy(value *uint64) *TenantCreate {
+func (_m *TenantInitTemplateCreate) SetNotNilSort(value *uint32) *TenantInitTemplateCreate {
 	if value != nil {
-		return _m.SetCreatedBy(*value)
+		return _m.SetSort(*value)
 	}
 	return _m
 }
@@ -4906,6 +9684,126 @@ func (_m *TokenCreate) SetNotNilDepartmentID(value *uint64) *TokenCreate {
 	return _m
 }
 
+// set field if value's pointer is not nil.
+func (_m *TokenUpdate) SetNotNilDevice(value *string) *TokenUpdate {
+	if value != nil {
+		return _m.SetDevice(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *TokenUpdateOne) SetNotNilDevice(value *string) *TokenUpdateOne {
+	if value != nil {
+		return _m.SetDevice(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *TokenCreate) SetNotNilDevice(value *string) *TokenCreate {
+	if value != nil {
+		return _m.SetDevice(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *TokenUpdate) SetNotNilUserAgent(value *string) *TokenUpdate {
+	if value != nil {
+		return _m.SetUserAgent(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *TokenUpdateOne) SetNotNilUserAgent(value *string) *TokenUpdateOne {
+	if value != nil {
+		return _m.SetUserAgent(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *TokenCreate) SetNotNilUserAgent(value *string) *TokenCreate {
+	if value != nil {
+		return _m.SetUserAgent(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *TokenUpdate) SetNotNilIP(value *string) *TokenUpdate {
+	if value != nil {
+		return _m.SetIP(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *TokenUpdateOne) SetNotNilIP(value *string) *TokenUpdateOne {
+	if value != nil {
+		return _m.SetIP(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *TokenCreate) SetNotNilIP(value *string) *TokenCreate {
+	if value != nil {
+		return _m.SetIP(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *TokenUpdate) SetNotNilLastSeenAt(value *time.Time) *TokenUpdate {
+	if value != nil {
+		return _m.SetLastSeenAt(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *TokenUpdateOne) SetNotNilLastSeenAt(value *time.Time) *TokenUpdateOne {
+	if value != nil {
+		return _m.SetLastSeenAt(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *TokenCreate) SetNotNilLastSeenAt(value *time.Time) *TokenCreate {
+	if value != nil {
+		return _m.SetLastSeenAt(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *TokenUpdate) SetNotNilSessionID(value *string) *TokenUpdate {
+	if value != nil {
+		return _m.SetSessionID(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *TokenUpdateOne) SetNotNilSessionID(value *string) *TokenUpdateOne {
+	if value != nil {
+		return _m.SetSessionID(*value)
+	}
+	return _m
+}
+
+// set field if value's pointer is not nil.
+func (_m *TokenCreate) SetNotNilSessionID(value *string) *TokenCreate {
+	if value != nil {
+		return _m.SetSessionID(*value)
+	}
+	return _m
+}
+
 // set field if value's pointer is not nil.
 func (_m *UserUpdate) SetNotNilUpdatedAt(value *time.Time) *UserUpdate {
 	if value != nil {
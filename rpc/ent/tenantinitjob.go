@@ -0,0 +1,276 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/coder-lulu/newbee-core/rpc/ent/tenantinitjob"
+	"github.com/coder-lulu/newbee-core/rpc/internal/initjob"
+)
+
+// Tenant Initialization Job Table | 租户初始化任务表
+type TenantInitJob struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uint64 `json:"id,omitempty"`
+	// Create Time | 创建日期
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Update Time | 修改日期
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// The tenant to initialize | 初始化的租户ID
+	TenantID uint64 `json:"tenant_id,omitempty"`
+	// Initialization template name | 初始化模板名称
+	Template string `json:"template,omitempty"`
+	// Mode: full, repair | 初始化模式
+	Mode string `json:"mode,omitempty"`
+	// Whether only the plan is made | 是否为试运行
+	DryRun bool `json:"dry_run,omitempty"`
+	// Status: pending, running, success, failed | 任务状态
+	Status string `json:"status,omitempty"`
+	// Admin username, the password is not stored | 管理员用户名，不保存密码
+	AdminUsername string `json:"admin_username,omitempty"`
+	// Admin email | 管理员邮箱
+	AdminEmail string `json:"admin_email,omitempty"`
+	// Number of runs | 执行次数
+	Attempts uint32 `json:"attempts,omitempty"`
+	// Error message of the last run | 最近一次执行的错误信息
+	Error string `json:"error,omitempty"`
+	// Progress of the plugins | 插件执行进度
+	Plugins []initjob.PluginProgress `json:"plugins,omitempty"`
+	// The plan of a dry run | 试运行计划
+	Plan []initjob.PlanItem `json:"plan,omitempty"`
+	// Start time of the last run | 最近一次开始时间
+	StartedAt *time.Time `json:"started_at,omitempty"`
+	// Finish time of the last run | 最近一次结束时间
+	FinishedAt   *time.Time `json:"finished_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TenantInitJob) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case tenantinitjob.FieldPlugins, tenantinitjob.FieldPlan:
+			values[i] = new([]byte)
+		case tenantinitjob.FieldDryRun:
+			values[i] = new(sql.NullBool)
+		case tenantinitjob.FieldID, tenantinitjob.FieldTenantID, tenantinitjob.FieldAttempts:
+			values[i] = new(sql.NullInt64)
+		case tenantinitjob.FieldTemplate, tenantinitjob.FieldMode, tenantinitjob.FieldStatus, tenantinitjob.FieldAdminUsername, tenantinitjob.FieldAdminEmail, tenantinitjob.FieldError:
+			values[i] = new(sql.NullString)
+		case tenantinitjob.FieldCreatedAt, tenantinitjob.FieldUpdatedAt, tenantinitjob.FieldStartedAt, tenantinitjob.FieldFinishedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TenantInitJob fields.
+func (_m *TenantInitJob) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case tenantinitjob.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = uint64(value.Int64)
+		case tenantinitjob.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case tenantinitjob.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case tenantinitjob.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				_m.TenantID = uint64(value.Int64)
+			}
+		case tenantinitjob.FieldTemplate:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field template", values[i])
+			} else if value.Valid {
+				_m.Template = value.String
+			}
+		case tenantinitjob.FieldMode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field mode", values[i])
+			} else if value.Valid {
+				_m.Mode = value.String
+			}
+		case tenantinitjob.FieldDryRun:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field dry_run", values[i])
+			} else if value.Valid {
+				_m.DryRun = value.Bool
+			}
+		case tenantinitjob.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = value.String
+			}
+		case tenantinitjob.FieldAdminUsername:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field admin_username", values[i])
+			} else if value.Valid {
+				_m.AdminUsername = value.String
+			}
+		case tenantinitjob.FieldAdminEmail:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field admin_email", values[i])
+			} else if value.Valid {
+				_m.AdminEmail = value.String
+			}
+		case tenantinitjob.FieldAttempts:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field attempts", values[i])
+			} else if value.Valid {
+				_m.Attempts = uint32(value.Int64)
+			}
+		case tenantinitjob.FieldError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field error", values[i])
+			} else if value.Valid {
+				_m.Error = value.String
+			}
+		case tenantinitjob.FieldPlugins:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field plugins", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Plugins); err != nil {
+					return fmt.Errorf("unmarshal field plugins: %w", err)
+				}
+			}
+		case tenantinitjob.FieldPlan:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field plan", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Plan); err != nil {
+					return fmt.Errorf("unmarshal field plan: %w", err)
+				}
+			}
+		case tenantinitjob.FieldStartedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field started_at", values[i])
+			} else if value.Valid {
+				_m.StartedAt = new(time.Time)
+				*_m.StartedAt = value.Time
+			}
+		case tenantinitjob.FieldFinishedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field finished_at", values[i])
+			} else if value.Valid {
+				_m.FinishedAt = new(time.Time)
+				*_m.FinishedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TenantInitJob.
+// This includes values selected through modifiers, order, etc.
+func (_m *TenantInitJob) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this TenantInitJob.
+// Note that you need to call TenantInitJob.Unwrap() before calling this method if this TenantInitJob
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *TenantInitJob) Update() *TenantInitJobUpdateOne {
+	return NewTenantInitJobClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the TenantInitJob entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *TenantInitJob) Unwrap() *TenantInitJob {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: TenantInitJob is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *TenantInitJob) String() string {
+	var builder strings.Builder
+	builder.WriteString("TenantInitJob(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("template=")
+	builder.WriteString(_m.Template)
+	builder.WriteString(", ")
+	builder.WriteString("mode=")
+	builder.WriteString(_m.Mode)
+	builder.WriteString(", ")
+	builder.WriteString("dry_run=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DryRun))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(_m.Status)
+	builder.WriteString(", ")
+	builder.WriteString("admin_username=")
+	builder.WriteString(_m.AdminUsername)
+	builder.WriteString(", ")
+	builder.WriteString("admin_email=")
+	builder.WriteString(_m.AdminEmail)
+	builder.WriteString(", ")
+	builder.WriteString("attempts=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Attempts))
+	builder.WriteString(", ")
+	builder.WriteString("error=")
+	builder.WriteString(_m.Error)
+	builder.WriteString(", ")
+	builder.WriteString("plugins=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Plugins))
+	builder.WriteString(", ")
+	builder.WriteString("plan=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Plan))
+	builder.WriteString(", ")
+	if v := _m.StartedAt; v != nil {
+		builder.WriteString("started_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.FinishedAt; v != nil {
+		builder.WriteString("finished_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TenantInitJobs is a parsable slice of TenantInitJob.
+type TenantInitJobs []*TenantInitJob
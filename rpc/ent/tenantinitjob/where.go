@@ -0,0 +1,855 @@
+// Code generated by ent, DO NOT EDIT.
+
+package tenantinitjob
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/coder-lulu/newbee-core/rpc/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uint64) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uint64) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uint64) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uint64) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uint64) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uint64) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uint64) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uint64) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uint64) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v uint64) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldEQ(FieldTenantID, v))
+}
+
+// Template applies equality check predicate on the "template" field. It's identical to TemplateEQ.
+func Template(v string) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldEQ(FieldTemplate, v))
+}
+
+// Mode applies equality check predicate on the "mode" field. It's identical to ModeEQ.
+func Mode(v string) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldEQ(FieldMode, v))
+}
+
+// DryRun applies equality check predicate on the "dry_run" field. It's identical to DryRunEQ.
+func DryRun(v bool) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldEQ(FieldDryRun, v))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldEQ(FieldStatus, v))
+}
+
+// AdminUsername applies equality check predicate on the "admin_username" field. It's identical to AdminUsernameEQ.
+func AdminUsername(v string) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldEQ(FieldAdminUsername, v))
+}
+
+// AdminEmail applies equality check predicate on the "admin_email" field. It's identical to AdminEmailEQ.
+func AdminEmail(v string) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldEQ(FieldAdminEmail, v))
+}
+
+// Attempts applies equality check predicate on the "attempts" field. It's identical to AttemptsEQ.
+func Attempts(v uint32) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldEQ(FieldAttempts, v))
+}
+
+// Error applies equality check predicate on the "error" field. It's identical to ErrorEQ.
+func Error(v string) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldEQ(FieldError, v))
+}
+
+// StartedAt applies equality check predicate on the "started_at" field. It's identical to StartedAtEQ.
+func StartedAt(v time.Time) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldEQ(FieldStartedAt, v))
+}
+
+// FinishedAt applies equality check predicate on the "finished_at" field. It's identical to FinishedAtEQ.
+func FinishedAt(v time.Time) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldEQ(FieldFinishedAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v uint64) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v uint64) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...uint64) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...uint64) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v uint64) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v uint64) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v uint64) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v uint64) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldLTE(FieldTenantID, v))
+}
+
+// TemplateEQ applies the EQ predicate on the "template" field.
+func TemplateEQ(v string) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldEQ(FieldTemplate, v))
+}
+
+// TemplateNEQ applies the NEQ predicate on the "template" field.
+func TemplateNEQ(v string) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldNEQ(FieldTemplate, v))
+}
+
+// TemplateIn applies the In predicate on the "template" field.
+func TemplateIn(vs ...string) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldIn(FieldTemplate, vs...))
+}
+
+// TemplateNotIn applies the NotIn predicate on the "template" field.
+func TemplateNotIn(vs ...string) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldNotIn(FieldTemplate, vs...))
+}
+
+// TemplateGT applies the GT predicate on the "template" field.
+func TemplateGT(v string) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldGT(FieldTemplate, v))
+}
+
+// TemplateGTE applies the GTE predicate on the "template" field.
+func TemplateGTE(v string) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldGTE(FieldTemplate, v))
+}
+
+// TemplateLT applies the LT predicate on the "template" field.
+func TemplateLT(v string) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldLT(FieldTemplate, v))
+}
+
+// TemplateLTE applies the LTE predicate on the "template" field.
+func TemplateLTE(v string) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldLTE(FieldTemplate, v))
+}
+
+// TemplateContains applies the Contains predicate on the "template" field.
+func TemplateContains(v string) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldContains(FieldTemplate, v))
+}
+
+// TemplateHasPrefix applies the HasPrefix predicate on the "template" field.
+func TemplateHasPrefix(v string) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldHasPrefix(FieldTemplate, v))
+}
+
+// TemplateHasSuffix applies the HasSuffix predicate on the "template" field.
+func TemplateHasSuffix(v string) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldHasSuffix(FieldTemplate, v))
+}
+
+// TemplateIsNil applies the IsNil predicate on the "template" field.
+func TemplateIsNil() predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldIsNull(FieldTemplate))
+}
+
+// TemplateNotNil applies the NotNil predicate on the "template" field.
+func TemplateNotNil() predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldNotNull(FieldTemplate))
+}
+
+// TemplateEqualFold applies the EqualFold predicate on the "template" field.
+func TemplateEqualFold(v string) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldEqualFold(FieldTemplate, v))
+}
+
+// TemplateContainsFold applies the ContainsFold predicate on the "template" field.
+func TemplateContainsFold(v string) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldContainsFold(FieldTemplate, v))
+}
+
+// ModeEQ applies the EQ predicate on the "mode" field.
+func ModeEQ(v string) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldEQ(FieldMode, v))
+}
+
+// ModeNEQ applies the NEQ predicate on the "mode" field.
+func ModeNEQ(v string) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldNEQ(FieldMode, v))
+}
+
+// ModeIn applies the In predicate on the "mode" field.
+func ModeIn(vs ...string) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldIn(FieldMode, vs...))
+}
+
+// ModeNotIn applies the NotIn predicate on the "mode" field.
+func ModeNotIn(vs ...string) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldNotIn(FieldMode, vs...))
+}
+
+// ModeGT applies the GT predicate on the "mode" field.
+func ModeGT(v string) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldGT(FieldMode, v))
+}
+
+// ModeGTE applies the GTE predicate on the "mode" field.
+func ModeGTE(v string) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldGTE(FieldMode, v))
+}
+
+// ModeLT applies the LT predicate on the "mode" field.
+func ModeLT(v string) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldLT(FieldMode, v))
+}
+
+// ModeLTE applies the LTE predicate on the "mode" field.
+func ModeLTE(v string) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldLTE(FieldMode, v))
+}
+
+// ModeContains applies the Contains predicate on the "mode" field.
+func ModeContains(v string) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldContains(FieldMode, v))
+}
+
+// ModeHasPrefix applies the HasPrefix predicate on the "mode" field.
+func ModeHasPrefix(v string) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldHasPrefix(FieldMode, v))
+}
+
+// ModeHasSuffix applies the HasSuffix predicate on the "mode" field.
+func ModeHasSuffix(v string) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldHasSuffix(FieldMode, v))
+}
+
+// ModeEqualFold applies the EqualFold predicate on the "mode" field.
+func ModeEqualFold(v string) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldEqualFold(FieldMode, v))
+}
+
+// ModeContainsFold applies the ContainsFold predicate on the "mode" field.
+func ModeContainsFold(v string) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldContainsFold(FieldMode, v))
+}
+
+// DryRunEQ applies the EQ predicate on the "dry_run" field.
+func DryRunEQ(v bool) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldEQ(FieldDryRun, v))
+}
+
+// DryRunNEQ applies the NEQ predicate on the "dry_run" field.
+func DryRunNEQ(v bool) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldNEQ(FieldDryRun, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldContains(FieldStatus, v))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldHasPrefix(FieldStatus, v))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldHasSuffix(FieldStatus, v))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldEqualFold(FieldStatus, v))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldContainsFold(FieldStatus, v))
+}
+
+// AdminUsernameEQ applies the EQ predicate on the "admin_username" field.
+func AdminUsernameEQ(v string) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldEQ(FieldAdminUsername, v))
+}
+
+// AdminUsernameNEQ applies the NEQ predicate on the "admin_username" field.
+func AdminUsernameNEQ(v string) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldNEQ(FieldAdminUsername, v))
+}
+
+// AdminUsernameIn applies the In predicate on the "admin_username" field.
+func AdminUsernameIn(vs ...string) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldIn(FieldAdminUsername, vs...))
+}
+
+// AdminUsernameNotIn applies the NotIn predicate on the "admin_username" field.
+func AdminUsernameNotIn(vs ...string) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldNotIn(FieldAdminUsername, vs...))
+}
+
+// AdminUsernameGT applies the GT predicate on the "admin_username" field.
+func AdminUsernameGT(v string) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldGT(FieldAdminUsername, v))
+}
+
+// AdminUsernameGTE applies the GTE predicate on the "admin_username" field.
+func AdminUsernameGTE(v string) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldGTE(FieldAdminUsername, v))
+}
+
+// AdminUsernameLT applies the LT predicate on the "admin_username" field.
+func AdminUsernameLT(v string) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldLT(FieldAdminUsername, v))
+}
+
+// AdminUsernameLTE applies the LTE predicate on the "admin_username" field.
+func AdminUsernameLTE(v string) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldLTE(FieldAdminUsername, v))
+}
+
+// AdminUsernameContains applies the Contains predicate on the "admin_username" field.
+func AdminUsernameContains(v string) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldContains(FieldAdminUsername, v))
+}
+
+// AdminUsernameHasPrefix applies the HasPrefix predicate on the "admin_username" field.
+func AdminUsernameHasPrefix(v string) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldHasPrefix(FieldAdminUsername, v))
+}
+
+// AdminUsernameHasSuffix applies the HasSuffix predicate on the "admin_username" field.
+func AdminUsernameHasSuffix(v string) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldHasSuffix(FieldAdminUsername, v))
+}
+
+// AdminUsernameIsNil applies the IsNil predicate on the "admin_username" field.
+func AdminUsernameIsNil() predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldIsNull(FieldAdminUsername))
+}
+
+// AdminUsernameNotNil applies the NotNil predicate on the "admin_username" field.
+func AdminUsernameNotNil() predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldNotNull(FieldAdminUsername))
+}
+
+// AdminUsernameEqualFold applies the EqualFold predicate on the "admin_username" field.
+func AdminUsernameEqualFold(v string) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldEqualFold(FieldAdminUsername, v))
+}
+
+// AdminUsernameContainsFold applies the ContainsFold predicate on the "admin_username" field.
+func AdminUsernameContainsFold(v string) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldContainsFold(FieldAdminUsername, v))
+}
+
+// AdminEmailEQ applies the EQ predicate on the "admin_email" field.
+func AdminEmailEQ(v string) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldEQ(FieldAdminEmail, v))
+}
+
+// AdminEmailNEQ applies the NEQ predicate on the "admin_email" field.
+func AdminEmailNEQ(v string) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldNEQ(FieldAdminEmail, v))
+}
+
+// AdminEmailIn applies the In predicate on the "admin_email" field.
+func AdminEmailIn(vs ...string) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldIn(FieldAdminEmail, vs...))
+}
+
+// AdminEmailNotIn applies the NotIn predicate on the "admin_email" field.
+func AdminEmailNotIn(vs ...string) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldNotIn(FieldAdminEmail, vs...))
+}
+
+// AdminEmailGT applies the GT predicate on the "admin_email" field.
+func AdminEmailGT(v string) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldGT(FieldAdminEmail, v))
+}
+
+// AdminEmailGTE applies the GTE predicate on the "admin_email" field.
+func AdminEmailGTE(v string) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldGTE(FieldAdminEmail, v))
+}
+
+// AdminEmailLT applies the LT predicate on the "admin_email" field.
+func AdminEmailLT(v string) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldLT(FieldAdminEmail, v))
+}
+
+// AdminEmailLTE applies the LTE predicate on the "admin_email" field.
+func AdminEmailLTE(v string) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldLTE(FieldAdminEmail, v))
+}
+
+// AdminEmailContains applies the Contains predicate on the "admin_email" field.
+func AdminEmailContains(v string) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldContains(FieldAdminEmail, v))
+}
+
+// AdminEmailHasPrefix applies the HasPrefix predicate on the "admin_email" field.
+func AdminEmailHasPrefix(v string) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldHasPrefix(FieldAdminEmail, v))
+}
+
+// AdminEmailHasSuffix applies the HasSuffix predicate on the "admin_email" field.
+func AdminEmailHasSuffix(v string) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldHasSuffix(FieldAdminEmail, v))
+}
+
+// AdminEmailIsNil applies the IsNil predicate on the "admin_email" field.
+func AdminEmailIsNil() predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldIsNull(FieldAdminEmail))
+}
+
+// AdminEmailNotNil applies the NotNil predicate on the "admin_email" field.
+func AdminEmailNotNil() predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldNotNull(FieldAdminEmail))
+}
+
+// AdminEmailEqualFold applies the EqualFold predicate on the "admin_email" field.
+func AdminEmailEqualFold(v string) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldEqualFold(FieldAdminEmail, v))
+}
+
+// AdminEmailContainsFold applies the ContainsFold predicate on the "admin_email" field.
+func AdminEmailContainsFold(v string) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldContainsFold(FieldAdminEmail, v))
+}
+
+// AttemptsEQ applies the EQ predicate on the "attempts" field.
+func AttemptsEQ(v uint32) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldEQ(FieldAttempts, v))
+}
+
+// AttemptsNEQ applies the NEQ predicate on the "attempts" field.
+func AttemptsNEQ(v uint32) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldNEQ(FieldAttempts, v))
+}
+
+// AttemptsIn applies the In predicate on the "attempts" field.
+func AttemptsIn(vs ...uint32) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldIn(FieldAttempts, vs...))
+}
+
+// AttemptsNotIn applies the NotIn predicate on the "attempts" field.
+func AttemptsNotIn(vs ...uint32) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldNotIn(FieldAttempts, vs...))
+}
+
+// AttemptsGT applies the GT predicate on the "attempts" field.
+func AttemptsGT(v uint32) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldGT(FieldAttempts, v))
+}
+
+// AttemptsGTE applies the GTE predicate on the "attempts" field.
+func AttemptsGTE(v uint32) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldGTE(FieldAttempts, v))
+}
+
+// AttemptsLT applies the LT predicate on the "attempts" field.
+func AttemptsLT(v uint32) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldLT(FieldAttempts, v))
+}
+
+// AttemptsLTE applies the LTE predicate on the "attempts" field.
+func AttemptsLTE(v uint32) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldLTE(FieldAttempts, v))
+}
+
+// ErrorEQ applies the EQ predicate on the "error" field.
+func ErrorEQ(v string) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldEQ(FieldError, v))
+}
+
+// ErrorNEQ applies the NEQ predicate on the "error" field.
+func ErrorNEQ(v string) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldNEQ(FieldError, v))
+}
+
+// ErrorIn applies the In predicate on the "error" field.
+func ErrorIn(vs ...string) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldIn(FieldError, vs...))
+}
+
+// ErrorNotIn applies the NotIn predicate on the "error" field.
+func ErrorNotIn(vs ...string) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldNotIn(FieldError, vs...))
+}
+
+// ErrorGT applies the GT predicate on the "error" field.
+func ErrorGT(v string) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldGT(FieldError, v))
+}
+
+// ErrorGTE applies the GTE predicate on the "error" field.
+func ErrorGTE(v string) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldGTE(FieldError, v))
+}
+
+// ErrorLT applies the LT predicate on the "error" field.
+func ErrorLT(v string) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldLT(FieldError, v))
+}
+
+// ErrorLTE applies the LTE predicate on the "error" field.
+func ErrorLTE(v string) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldLTE(FieldError, v))
+}
+
+// ErrorContains applies the Contains predicate on the "error" field.
+func ErrorContains(v string) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldContains(FieldError, v))
+}
+
+// ErrorHasPrefix applies the HasPrefix predicate on the "error" field.
+func ErrorHasPrefix(v string) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldHasPrefix(FieldError, v))
+}
+
+// ErrorHasSuffix applies the HasSuffix predicate on the "error" field.
+func ErrorHasSuffix(v string) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldHasSuffix(FieldError, v))
+}
+
+// ErrorIsNil applies the IsNil predicate on the "error" field.
+func ErrorIsNil() predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldIsNull(FieldError))
+}
+
+// ErrorNotNil applies the NotNil predicate on the "error" field.
+func ErrorNotNil() predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldNotNull(FieldError))
+}
+
+// ErrorEqualFold applies the EqualFold predicate on the "error" field.
+func ErrorEqualFold(v string) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldEqualFold(FieldError, v))
+}
+
+// ErrorContainsFold applies the ContainsFold predicate on the "error" field.
+func ErrorContainsFold(v string) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldContainsFold(FieldError, v))
+}
+
+// PluginsIsNil applies the IsNil predicate on the "plugins" field.
+func PluginsIsNil() predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldIsNull(FieldPlugins))
+}
+
+// PluginsNotNil applies the NotNil predicate on the "plugins" field.
+func PluginsNotNil() predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldNotNull(FieldPlugins))
+}
+
+// PlanIsNil applies the IsNil predicate on the "plan" field.
+func PlanIsNil() predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldIsNull(FieldPlan))
+}
+
+// PlanNotNil applies the NotNil predicate on the "plan" field.
+func PlanNotNil() predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldNotNull(FieldPlan))
+}
+
+// StartedAtEQ applies the EQ predicate on the "started_at" field.
+func StartedAtEQ(v time.Time) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldEQ(FieldStartedAt, v))
+}
+
+// StartedAtNEQ applies the NEQ predicate on the "started_at" field.
+func StartedAtNEQ(v time.Time) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldNEQ(FieldStartedAt, v))
+}
+
+// StartedAtIn applies the In predicate on the "started_at" field.
+func StartedAtIn(vs ...time.Time) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldIn(FieldStartedAt, vs...))
+}
+
+// StartedAtNotIn applies the NotIn predicate on the "started_at" field.
+func StartedAtNotIn(vs ...time.Time) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldNotIn(FieldStartedAt, vs...))
+}
+
+// StartedAtGT applies the GT predicate on the "started_at" field.
+func StartedAtGT(v time.Time) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldGT(FieldStartedAt, v))
+}
+
+// StartedAtGTE applies the GTE predicate on the "started_at" field.
+func StartedAtGTE(v time.Time) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldGTE(FieldStartedAt, v))
+}
+
+// StartedAtLT applies the LT predicate on the "started_at" field.
+func StartedAtLT(v time.Time) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldLT(FieldStartedAt, v))
+}
+
+// StartedAtLTE applies the LTE predicate on the "started_at" field.
+func StartedAtLTE(v time.Time) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldLTE(FieldStartedAt, v))
+}
+
+// StartedAtIsNil applies the IsNil predicate on the "started_at" field.
+func StartedAtIsNil() predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldIsNull(FieldStartedAt))
+}
+
+// StartedAtNotNil applies the NotNil predicate on the "started_at" field.
+func StartedAtNotNil() predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldNotNull(FieldStartedAt))
+}
+
+// FinishedAtEQ applies the EQ predicate on the "finished_at" field.
+func FinishedAtEQ(v time.Time) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldEQ(FieldFinishedAt, v))
+}
+
+// FinishedAtNEQ applies the NEQ predicate on the "finished_at" field.
+func FinishedAtNEQ(v time.Time) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldNEQ(FieldFinishedAt, v))
+}
+
+// FinishedAtIn applies the In predicate on the "finished_at" field.
+func FinishedAtIn(vs ...time.Time) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldIn(FieldFinishedAt, vs...))
+}
+
+// FinishedAtNotIn applies the NotIn predicate on the "finished_at" field.
+func FinishedAtNotIn(vs ...time.Time) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldNotIn(FieldFinishedAt, vs...))
+}
+
+// FinishedAtGT applies the GT predicate on the "finished_at" field.
+func FinishedAtGT(v time.Time) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldGT(FieldFinishedAt, v))
+}
+
+// FinishedAtGTE applies the GTE predicate on the "finished_at" field.
+func FinishedAtGTE(v time.Time) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldGTE(FieldFinishedAt, v))
+}
+
+// FinishedAtLT applies the LT predicate on the "finished_at" field.
+func FinishedAtLT(v time.Time) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldLT(FieldFinishedAt, v))
+}
+
+// FinishedAtLTE applies the LTE predicate on the "finished_at" field.
+func FinishedAtLTE(v time.Time) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldLTE(FieldFinishedAt, v))
+}
+
+// FinishedAtIsNil applies the IsNil predicate on the "finished_at" field.
+func FinishedAtIsNil() predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldIsNull(FieldFinishedAt))
+}
+
+// FinishedAtNotNil applies the NotNil predicate on the "finished_at" field.
+func FinishedAtNotNil() predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.FieldNotNull(FieldFinishedAt))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.TenantInitJob) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.TenantInitJob) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.TenantInitJob) predicate.TenantInitJob {
+	return predicate.TenantInitJob(sql.NotPredicates(p))
+}
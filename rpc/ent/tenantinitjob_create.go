@@ -0,0 +1,482 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/coder-lulu/newbee-core/rpc/ent/tenantinitjob"
+	"github.com/coder-lulu/newbee-core/rpc/internal/initjob"
+)
+
+// TenantInitJobCreate is the builder for creating a TenantInitJob entity.
+type TenantInitJobCreate struct {
+	config
+	mutation *TenantInitJobMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *TenantInitJobCreate) SetCreatedAt(v time.Time) *TenantInitJobCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *TenantInitJobCreate) SetNillableCreatedAt(v *time.Time) *TenantInitJobCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *TenantInitJobCreate) SetUpdatedAt(v time.Time) *TenantInitJobCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *TenantInitJobCreate) SetNillableUpdatedAt(v *time.Time) *TenantInitJobCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *TenantInitJobCreate) SetTenantID(v uint64) *TenantInitJobCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetTemplate sets the "template" field.
+func (_c *TenantInitJobCreate) SetTemplate(v string) *TenantInitJobCreate {
+	_c.mutation.SetTemplate(v)
+	return _c
+}
+
+// SetNillableTemplate sets the "template" field if the given value is not nil.
+func (_c *TenantInitJobCreate) SetNillableTemplate(v *string) *TenantInitJobCreate {
+	if v != nil {
+		_c.SetTemplate(*v)
+	}
+	return _c
+}
+
+// SetMode sets the "mode" field.
+func (_c *TenantInitJobCreate) SetMode(v string) *TenantInitJobCreate {
+	_c.mutation.SetMode(v)
+	return _c
+}
+
+// SetDryRun sets the "dry_run" field.
+func (_c *TenantInitJobCreate) SetDryRun(v bool) *TenantInitJobCreate {
+	_c.mutation.SetDryRun(v)
+	return _c
+}
+
+// SetNillableDryRun sets the "dry_run" field if the given value is not nil.
+func (_c *TenantInitJobCreate) SetNillableDryRun(v *bool) *TenantInitJobCreate {
+	if v != nil {
+		_c.SetDryRun(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *TenantInitJobCreate) SetStatus(v string) *TenantInitJobCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetAdminUsername sets the "admin_username" field.
+func (_c *TenantInitJobCreate) SetAdminUsername(v string) *TenantInitJobCreate {
+	_c.mutation.SetAdminUsername(v)
+	return _c
+}
+
+// SetNillableAdminUsername sets the "admin_username" field if the given value is not nil.
+func (_c *TenantInitJobCreate) SetNillableAdminUsername(v *string) *TenantInitJobCreate {
+	if v != nil {
+		_c.SetAdminUsername(*v)
+	}
+	return _c
+}
+
+// SetAdminEmail sets the "admin_email" field.
+func (_c *TenantInitJobCreate) SetAdminEmail(v string) *TenantInitJobCreate {
+	_c.mutation.SetAdminEmail(v)
+	return _c
+}
+
+// SetNillableAdminEmail sets the "admin_email" field if the given value is not nil.
+func (_c *TenantInitJobCreate) SetNillableAdminEmail(v *string) *TenantInitJobCreate {
+	if v != nil {
+		_c.SetAdminEmail(*v)
+	}
+	return _c
+}
+
+// SetAttempts sets the "attempts" field.
+func (_c *TenantInitJobCreate) SetAttempts(v uint32) *TenantInitJobCreate {
+	_c.mutation.SetAttempts(v)
+	return _c
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_c *TenantInitJobCreate) SetNillableAttempts(v *uint32) *TenantInitJobCreate {
+	if v != nil {
+		_c.SetAttempts(*v)
+	}
+	return _c
+}
+
+// SetError sets the "error" field.
+func (_c *TenantInitJobCreate) SetError(v string) *TenantInitJobCreate {
+	_c.mutation.SetError(v)
+	return _c
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_c *TenantInitJobCreate) SetNillableError(v *string) *TenantInitJobCreate {
+	if v != nil {
+		_c.SetError(*v)
+	}
+	return _c
+}
+
+// SetPlugins sets the "plugins" field.
+func (_c *TenantInitJobCreate) SetPlugins(v []initjob.PluginProgress) *TenantInitJobCreate {
+	_c.mutation.SetPlugins(v)
+	return _c
+}
+
+// SetPlan sets the "plan" field.
+func (_c *TenantInitJobCreate) SetPlan(v []initjob.PlanItem) *TenantInitJobCreate {
+	_c.mutation.SetPlan(v)
+	return _c
+}
+
+// SetStartedAt sets the "started_at" field.
+func (_c *TenantInitJobCreate) SetStartedAt(v time.Time) *TenantInitJobCreate {
+	_c.mutation.SetStartedAt(v)
+	return _c
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (_c *TenantInitJobCreate) SetNillableStartedAt(v *time.Time) *TenantInitJobCreate {
+	if v != nil {
+		_c.SetStartedAt(*v)
+	}
+	return _c
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (_c *TenantInitJobCreate) SetFinishedAt(v time.Time) *TenantInitJobCreate {
+	_c.mutation.SetFinishedAt(v)
+	return _c
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (_c *TenantInitJobCreate) SetNillableFinishedAt(v *time.Time) *TenantInitJobCreate {
+	if v != nil {
+		_c.SetFinishedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *TenantInitJobCreate) SetID(v uint64) *TenantInitJobCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the TenantInitJobMutation object of the builder.
+func (_c *TenantInitJobCreate) Mutation() *TenantInitJobMutation {
+	return _c.mutation
+}
+
+// Save creates the TenantInitJob in the database.
+func (_c *TenantInitJobCreate) Save(ctx context.Context) (*TenantInitJob, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *TenantInitJobCreate) SaveX(ctx context.Context) *TenantInitJob {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TenantInitJobCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TenantInitJobCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *TenantInitJobCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := tenantinitjob.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := tenantinitjob.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.DryRun(); !ok {
+		v := tenantinitjob.DefaultDryRun
+		_c.mutation.SetDryRun(v)
+	}
+	if _, ok := _c.mutation.Attempts(); !ok {
+		v := tenantinitjob.DefaultAttempts
+		_c.mutation.SetAttempts(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *TenantInitJobCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "TenantInitJob.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "TenantInitJob.updated_at"`)}
+	}
+	if _, ok := _c.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "TenantInitJob.tenant_id"`)}
+	}
+	if v, ok := _c.mutation.Template(); ok {
+		if err := tenantinitjob.TemplateValidator(v); err != nil {
+			return &ValidationError{Name: "template", err: fmt.Errorf(`ent: validator failed for field "TenantInitJob.template": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Mode(); !ok {
+		return &ValidationError{Name: "mode", err: errors.New(`ent: missing required field "TenantInitJob.mode"`)}
+	}
+	if v, ok := _c.mutation.Mode(); ok {
+		if err := tenantinitjob.ModeValidator(v); err != nil {
+			return &ValidationError{Name: "mode", err: fmt.Errorf(`ent: validator failed for field "TenantInitJob.mode": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.DryRun(); !ok {
+		return &ValidationError{Name: "dry_run", err: errors.New(`ent: missing required field "TenantInitJob.dry_run"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "TenantInitJob.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := tenantinitjob.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "TenantInitJob.status": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.AdminUsername(); ok {
+		if err := tenantinitjob.AdminUsernameValidator(v); err != nil {
+			return &ValidationError{Name: "admin_username", err: fmt.Errorf(`ent: validator failed for field "TenantInitJob.admin_username": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.AdminEmail(); ok {
+		if err := tenantinitjob.AdminEmailValidator(v); err != nil {
+			return &ValidationError{Name: "admin_email", err: fmt.Errorf(`ent: validator failed for field "TenantInitJob.admin_email": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Attempts(); !ok {
+		return &ValidationError{Name: "attempts", err: errors.New(`ent: missing required field "TenantInitJob.attempts"`)}
+	}
+	return nil
+}
+
+func (_c *TenantInitJobCreate) sqlSave(ctx context.Context) (*TenantInitJob, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint64(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *TenantInitJobCreate) createSpec() (*TenantInitJob, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TenantInitJob{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(tenantinitjob.Table, sqlgraph.NewFieldSpec(tenantinitjob.FieldID, field.TypeUint64))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(tenantinitjob.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(tenantinitjob.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(tenantinitjob.FieldTenantID, field.TypeUint64, value)
+		_node.TenantID = value
+	}
+	if value, ok := _c.mutation.Template(); ok {
+		_spec.SetField(tenantinitjob.FieldTemplate, field.TypeString, value)
+		_node.Template = value
+	}
+	if value, ok := _c.mutation.Mode(); ok {
+		_spec.SetField(tenantinitjob.FieldMode, field.TypeString, value)
+		_node.Mode = value
+	}
+	if value, ok := _c.mutation.DryRun(); ok {
+		_spec.SetField(tenantinitjob.FieldDryRun, field.TypeBool, value)
+		_node.DryRun = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(tenantinitjob.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.AdminUsername(); ok {
+		_spec.SetField(tenantinitjob.FieldAdminUsername, field.TypeString, value)
+		_node.AdminUsername = value
+	}
+	if value, ok := _c.mutation.AdminEmail(); ok {
+		_spec.SetField(tenantinitjob.FieldAdminEmail, field.TypeString, value)
+		_node.AdminEmail = value
+	}
+	if value, ok := _c.mutation.Attempts(); ok {
+		_spec.SetField(tenantinitjob.FieldAttempts, field.TypeUint32, value)
+		_node.Attempts = value
+	}
+	if value, ok := _c.mutation.Error(); ok {
+		_spec.SetField(tenantinitjob.FieldError, field.TypeString, value)
+		_node.Error = value
+	}
+	if value, ok := _c.mutation.Plugins(); ok {
+		_spec.SetField(tenantinitjob.FieldPlugins, field.TypeJSON, value)
+		_node.Plugins = value
+	}
+	if value, ok := _c.mutation.Plan(); ok {
+		_spec.SetField(tenantinitjob.FieldPlan, field.TypeJSON, value)
+		_node.Plan = value
+	}
+	if value, ok := _c.mutation.StartedAt(); ok {
+		_spec.SetField(tenantinitjob.FieldStartedAt, field.TypeTime, value)
+		_node.StartedAt = &value
+	}
+	if value, ok := _c.mutation.FinishedAt(); ok {
+		_spec.SetField(tenantinitjob.FieldFinishedAt, field.TypeTime, value)
+		_node.FinishedAt = &value
+	}
+	return _node, _spec
+}
+
+// TenantInitJobCreateBulk is the builder for creating many TenantInitJob entities in bulk.
+type TenantInitJobCreateBulk struct {
+	config
+	err      error
+	builders []*TenantInitJobCreate
+}
+
+// Save creates the TenantInitJob entities in the database.
+func (_c *TenantInitJobCreateBulk) Save(ctx context.Context) ([]*TenantInitJob, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*TenantInitJob, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TenantInitJobMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *TenantInitJobCreateBulk) SaveX(ctx context.Context) []*TenantInitJob {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TenantInitJobCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TenantInitJobCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
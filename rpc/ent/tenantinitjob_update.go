@@ -0,0 +1,934 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/coder-lulu/newbee-core/rpc/ent/predicate"
+	"github.com/coder-lulu/newbee-core/rpc/ent/tenantinitjob"
+	"github.com/coder-lulu/newbee-core/rpc/internal/initjob"
+)
+
+// TenantInitJobUpdate is the builder for updating TenantInitJob entities.
+type TenantInitJobUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *TenantInitJobMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the TenantInitJobUpdate builder.
+func (_u *TenantInitJobUpdate) Where(ps ...predicate.TenantInitJob) *TenantInitJobUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *TenantInitJobUpdate) SetUpdatedAt(v time.Time) *TenantInitJobUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *TenantInitJobUpdate) SetTenantID(v uint64) *TenantInitJobUpdate {
+	_u.mutation.ResetTenantID()
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *TenantInitJobUpdate) SetNillableTenantID(v *uint64) *TenantInitJobUpdate {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// AddTenantID adds value to the "tenant_id" field.
+func (_u *TenantInitJobUpdate) AddTenantID(v int64) *TenantInitJobUpdate {
+	_u.mutation.AddTenantID(v)
+	return _u
+}
+
+// SetTemplate sets the "template" field.
+func (_u *TenantInitJobUpdate) SetTemplate(v string) *TenantInitJobUpdate {
+	_u.mutation.SetTemplate(v)
+	return _u
+}
+
+// SetNillableTemplate sets the "template" field if the given value is not nil.
+func (_u *TenantInitJobUpdate) SetNillableTemplate(v *string) *TenantInitJobUpdate {
+	if v != nil {
+		_u.SetTemplate(*v)
+	}
+	return _u
+}
+
+// ClearTemplate clears the value of the "template" field.
+func (_u *TenantInitJobUpdate) ClearTemplate() *TenantInitJobUpdate {
+	_u.mutation.ClearTemplate()
+	return _u
+}
+
+// SetMode sets the "mode" field.
+func (_u *TenantInitJobUpdate) SetMode(v string) *TenantInitJobUpdate {
+	_u.mutation.SetMode(v)
+	return _u
+}
+
+// SetNillableMode sets the "mode" field if the given value is not nil.
+func (_u *TenantInitJobUpdate) SetNillableMode(v *string) *TenantInitJobUpdate {
+	if v != nil {
+		_u.SetMode(*v)
+	}
+	return _u
+}
+
+// SetDryRun sets the "dry_run" field.
+func (_u *TenantInitJobUpdate) SetDryRun(v bool) *TenantInitJobUpdate {
+	_u.mutation.SetDryRun(v)
+	return _u
+}
+
+// SetNillableDryRun sets the "dry_run" field if the given value is not nil.
+func (_u *TenantInitJobUpdate) SetNillableDryRun(v *bool) *TenantInitJobUpdate {
+	if v != nil {
+		_u.SetDryRun(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *TenantInitJobUpdate) SetStatus(v string) *TenantInitJobUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *TenantInitJobUpdate) SetNillableStatus(v *string) *TenantInitJobUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetAdminUsername sets the "admin_username" field.
+func (_u *TenantInitJobUpdate) SetAdminUsername(v string) *TenantInitJobUpdate {
+	_u.mutation.SetAdminUsername(v)
+	return _u
+}
+
+// SetNillableAdminUsername sets the "admin_username" field if the given value is not nil.
+func (_u *TenantInitJobUpdate) SetNillableAdminUsername(v *string) *TenantInitJobUpdate {
+	if v != nil {
+		_u.SetAdminUsername(*v)
+	}
+	return _u
+}
+
+// ClearAdminUsername clears the value of the "admin_username" field.
+func (_u *TenantInitJobUpdate) ClearAdminUsername() *TenantInitJobUpdate {
+	_u.mutation.ClearAdminUsername()
+	return _u
+}
+
+// SetAdminEmail sets the "admin_email" field.
+func (_u *TenantInitJobUpdate) SetAdminEmail(v string) *TenantInitJobUpdate {
+	_u.mutation.SetAdminEmail(v)
+	return _u
+}
+
+// SetNillableAdminEmail sets the "admin_email" field if the given value is not nil.
+func (_u *TenantInitJobUpdate) SetNillableAdminEmail(v *string) *TenantInitJobUpdate {
+	if v != nil {
+		_u.SetAdminEmail(*v)
+	}
+	return _u
+}
+
+// ClearAdminEmail clears the value of the "admin_email" field.
+func (_u *TenantInitJobUpdate) ClearAdminEmail() *TenantInitJobUpdate {
+	_u.mutation.ClearAdminEmail()
+	return _u
+}
+
+// SetAttempts sets the "attempts" field.
+func (_u *TenantInitJobUpdate) SetAttempts(v uint32) *TenantInitJobUpdate {
+	_u.mutation.ResetAttempts()
+	_u.mutation.SetAttempts(v)
+	return _u
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_u *TenantInitJobUpdate) SetNillableAttempts(v *uint32) *TenantInitJobUpdate {
+	if v != nil {
+		_u.SetAttempts(*v)
+	}
+	return _u
+}
+
+// AddAttempts adds value to the "attempts" field.
+func (_u *TenantInitJobUpdate) AddAttempts(v int32) *TenantInitJobUpdate {
+	_u.mutation.AddAttempts(v)
+	return _u
+}
+
+// SetError sets the "error" field.
+func (_u *TenantInitJobUpdate) SetError(v string) *TenantInitJobUpdate {
+	_u.mutation.SetError(v)
+	return _u
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_u *TenantInitJobUpdate) SetNillableError(v *string) *TenantInitJobUpdate {
+	if v != nil {
+		_u.SetError(*v)
+	}
+	return _u
+}
+
+// ClearError clears the value of the "error" field.
+func (_u *TenantInitJobUpdate) ClearError() *TenantInitJobUpdate {
+	_u.mutation.ClearError()
+	return _u
+}
+
+// SetPlugins sets the "plugins" field.
+func (_u *TenantInitJobUpdate) SetPlugins(v []initjob.PluginProgress) *TenantInitJobUpdate {
+	_u.mutation.SetPlugins(v)
+	return _u
+}
+
+// AppendPlugins appends value to the "plugins" field.
+func (_u *TenantInitJobUpdate) AppendPlugins(v []initjob.PluginProgress) *TenantInitJobUpdate {
+	_u.mutation.AppendPlugins(v)
+	return _u
+}
+
+// ClearPlugins clears the value of the "plugins" field.
+func (_u *TenantInitJobUpdate) ClearPlugins() *TenantInitJobUpdate {
+	_u.mutation.ClearPlugins()
+	return _u
+}
+
+// SetPlan sets the "plan" field.
+func (_u *TenantInitJobUpdate) SetPlan(v []initjob.PlanItem) *TenantInitJobUpdate {
+	_u.mutation.SetPlan(v)
+	return _u
+}
+
+// AppendPlan appends value to the "plan" field.
+func (_u *TenantInitJobUpdate) AppendPlan(v []initjob.PlanItem) *TenantInitJobUpdate {
+	_u.mutation.AppendPlan(v)
+	return _u
+}
+
+// ClearPlan clears the value of the "plan" field.
+func (_u *TenantInitJobUpdate) ClearPlan() *TenantInitJobUpdate {
+	_u.mutation.ClearPlan()
+	return _u
+}
+
+// SetStartedAt sets the "started_at" field.
+func (_u *TenantInitJobUpdate) SetStartedAt(v time.Time) *TenantInitJobUpdate {
+	_u.mutation.SetStartedAt(v)
+	return _u
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (_u *TenantInitJobUpdate) SetNillableStartedAt(v *time.Time) *TenantInitJobUpdate {
+	if v != nil {
+		_u.SetStartedAt(*v)
+	}
+	return _u
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (_u *TenantInitJobUpdate) ClearStartedAt() *TenantInitJobUpdate {
+	_u.mutation.ClearStartedAt()
+	return _u
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (_u *TenantInitJobUpdate) SetFinishedAt(v time.Time) *TenantInitJobUpdate {
+	_u.mutation.SetFinishedAt(v)
+	return _u
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (_u *TenantInitJobUpdate) SetNillableFinishedAt(v *time.Time) *TenantInitJobUpdate {
+	if v != nil {
+		_u.SetFinishedAt(*v)
+	}
+	return _u
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (_u *TenantInitJobUpdate) ClearFinishedAt() *TenantInitJobUpdate {
+	_u.mutation.ClearFinishedAt()
+	return _u
+}
+
+// Mutation returns the TenantInitJobMutation object of the builder.
+func (_u *TenantInitJobUpdate) Mutation() *TenantInitJobMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *TenantInitJobUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TenantInitJobUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *TenantInitJobUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TenantInitJobUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *TenantInitJobUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := tenantinitjob.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TenantInitJobUpdate) check() error {
+	if v, ok := _u.mutation.Template(); ok {
+		if err := tenantinitjob.TemplateValidator(v); err != nil {
+			return &ValidationError{Name: "template", err: fmt.Errorf(`ent: validator failed for field "TenantInitJob.template": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Mode(); ok {
+		if err := tenantinitjob.ModeValidator(v); err != nil {
+			return &ValidationError{Name: "mode", err: fmt.Errorf(`ent: validator failed for field "TenantInitJob.mode": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := tenantinitjob.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "TenantInitJob.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AdminUsername(); ok {
+		if err := tenantinitjob.AdminUsernameValidator(v); err != nil {
+			return &ValidationError{Name: "admin_username", err: fmt.Errorf(`ent: validator failed for field "TenantInitJob.admin_username": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AdminEmail(); ok {
+		if err := tenantinitjob.AdminEmailValidator(v); err != nil {
+			return &ValidationError{Name: "admin_email", err: fmt.Errorf(`ent: validator failed for field "TenantInitJob.admin_email": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *TenantInitJobUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TenantInitJobUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *TenantInitJobUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(tenantinitjob.Table, tenantinitjob.Columns, sqlgraph.NewFieldSpec(tenantinitjob.FieldID, field.TypeUint64))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(tenantinitjob.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(tenantinitjob.FieldTenantID, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.AddedTenantID(); ok {
+		_spec.AddField(tenantinitjob.FieldTenantID, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.Template(); ok {
+		_spec.SetField(tenantinitjob.FieldTemplate, field.TypeString, value)
+	}
+	if _u.mutation.TemplateCleared() {
+		_spec.ClearField(tenantinitjob.FieldTemplate, field.TypeString)
+	}
+	if value, ok := _u.mutation.Mode(); ok {
+		_spec.SetField(tenantinitjob.FieldMode, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.DryRun(); ok {
+		_spec.SetField(tenantinitjob.FieldDryRun, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(tenantinitjob.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AdminUsername(); ok {
+		_spec.SetField(tenantinitjob.FieldAdminUsername, field.TypeString, value)
+	}
+	if _u.mutation.AdminUsernameCleared() {
+		_spec.ClearField(tenantinitjob.FieldAdminUsername, field.TypeString)
+	}
+	if value, ok := _u.mutation.AdminEmail(); ok {
+		_spec.SetField(tenantinitjob.FieldAdminEmail, field.TypeString, value)
+	}
+	if _u.mutation.AdminEmailCleared() {
+		_spec.ClearField(tenantinitjob.FieldAdminEmail, field.TypeString)
+	}
+	if value, ok := _u.mutation.Attempts(); ok {
+		_spec.SetField(tenantinitjob.FieldAttempts, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedAttempts(); ok {
+		_spec.AddField(tenantinitjob.FieldAttempts, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.Error(); ok {
+		_spec.SetField(tenantinitjob.FieldError, field.TypeString, value)
+	}
+	if _u.mutation.ErrorCleared() {
+		_spec.ClearField(tenantinitjob.FieldError, field.TypeString)
+	}
+	if value, ok := _u.mutation.Plugins(); ok {
+		_spec.SetField(tenantinitjob.FieldPlugins, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedPlugins(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, tenantinitjob.FieldPlugins, value)
+		})
+	}
+	if _u.mutation.PluginsCleared() {
+		_spec.ClearField(tenantinitjob.FieldPlugins, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Plan(); ok {
+		_spec.SetField(tenantinitjob.FieldPlan, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedPlan(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, tenantinitjob.FieldPlan, value)
+		})
+	}
+	if _u.mutation.PlanCleared() {
+		_spec.ClearField(tenantinitjob.FieldPlan, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.StartedAt(); ok {
+		_spec.SetField(tenantinitjob.FieldStartedAt, field.TypeTime, value)
+	}
+	if _u.mutation.StartedAtCleared() {
+		_spec.ClearField(tenantinitjob.FieldStartedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.FinishedAt(); ok {
+		_spec.SetField(tenantinitjob.FieldFinishedAt, field.TypeTime, value)
+	}
+	if _u.mutation.FinishedAtCleared() {
+		_spec.ClearField(tenantinitjob.FieldFinishedAt, field.TypeTime)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{tenantinitjob.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// TenantInitJobUpdateOne is the builder for updating a single TenantInitJob entity.
+type TenantInitJobUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *TenantInitJobMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *TenantInitJobUpdateOne) SetUpdatedAt(v time.Time) *TenantInitJobUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *TenantInitJobUpdateOne) SetTenantID(v uint64) *TenantInitJobUpdateOne {
+	_u.mutation.ResetTenantID()
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *TenantInitJobUpdateOne) SetNillableTenantID(v *uint64) *TenantInitJobUpdateOne {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// AddTenantID adds value to the "tenant_id" field.
+func (_u *TenantInitJobUpdateOne) AddTenantID(v int64) *TenantInitJobUpdateOne {
+	_u.mutation.AddTenantID(v)
+	return _u
+}
+
+// SetTemplate sets the "template" field.
+func (_u *TenantInitJobUpdateOne) SetTemplate(v string) *TenantInitJobUpdateOne {
+	_u.mutation.SetTemplate(v)
+	return _u
+}
+
+// SetNillableTemplate sets the "template" field if the given value is not nil.
+func (_u *TenantInitJobUpdateOne) SetNillableTemplate(v *string) *TenantInitJobUpdateOne {
+	if v != nil {
+		_u.SetTemplate(*v)
+	}
+	return _u
+}
+
+// ClearTemplate clears the value of the "template" field.
+func (_u *TenantInitJobUpdateOne) ClearTemplate() *TenantInitJobUpdateOne {
+	_u.mutation.ClearTemplate()
+	return _u
+}
+
+// SetMode sets the "mode" field.
+func (_u *TenantInitJobUpdateOne) SetMode(v string) *TenantInitJobUpdateOne {
+	_u.mutation.SetMode(v)
+	return _u
+}
+
+// SetNillableMode sets the "mode" field if the given value is not nil.
+func (_u *TenantInitJobUpdateOne) SetNillableMode(v *string) *TenantInitJobUpdateOne {
+	if v != nil {
+		_u.SetMode(*v)
+	}
+	return _u
+}
+
+// SetDryRun sets the "dry_run" field.
+func (_u *TenantInitJobUpdateOne) SetDryRun(v bool) *TenantInitJobUpdateOne {
+	_u.mutation.SetDryRun(v)
+	return _u
+}
+
+// SetNillableDryRun sets the "dry_run" field if the given value is not nil.
+func (_u *TenantInitJobUpdateOne) SetNillableDryRun(v *bool) *TenantInitJobUpdateOne {
+	if v != nil {
+		_u.SetDryRun(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *TenantInitJobUpdateOne) SetStatus(v string) *TenantInitJobUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *TenantInitJobUpdateOne) SetNillableStatus(v *string) *TenantInitJobUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetAdminUsername sets the "admin_username" field.
+func (_u *TenantInitJobUpdateOne) SetAdminUsername(v string) *TenantInitJobUpdateOne {
+	_u.mutation.SetAdminUsername(v)
+	return _u
+}
+
+// SetNillableAdminUsername sets the "admin_username" field if the given value is not nil.
+func (_u *TenantInitJobUpdateOne) SetNillableAdminUsername(v *string) *TenantInitJobUpdateOne {
+	if v != nil {
+		_u.SetAdminUsername(*v)
+	}
+	return _u
+}
+
+// ClearAdminUsername clears the value of the "admin_username" field.
+func (_u *TenantInitJobUpdateOne) ClearAdminUsername() *TenantInitJobUpdateOne {
+	_u.mutation.ClearAdminUsername()
+	return _u
+}
+
+// SetAdminEmail sets the "admin_email" field.
+func (_u *TenantInitJobUpdateOne) SetAdminEmail(v string) *TenantInitJobUpdateOne {
+	_u.mutation.SetAdminEmail(v)
+	return _u
+}
+
+// SetNillableAdminEmail sets the "admin_email" field if the given value is not nil.
+func (_u *TenantInitJobUpdateOne) SetNillableAdminEmail(v *string) *TenantInitJobUpdateOne {
+	if v != nil {
+		_u.SetAdminEmail(*v)
+	}
+	return _u
+}
+
+// ClearAdminEmail clears the value of the "admin_email" field.
+func (_u *TenantInitJobUpdateOne) ClearAdminEmail() *TenantInitJobUpdateOne {
+	_u.mutation.ClearAdminEmail()
+	return _u
+}
+
+// SetAttempts sets the "attempts" field.
+func (_u *TenantInitJobUpdateOne) SetAttempts(v uint32) *TenantInitJobUpdateOne {
+	_u.mutation.ResetAttempts()
+	_u.mutation.SetAttempts(v)
+	return _u
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_u *TenantInitJobUpdateOne) SetNillableAttempts(v *uint32) *TenantInitJobUpdateOne {
+	if v != nil {
+		_u.SetAttempts(*v)
+	}
+	return _u
+}
+
+// AddAttempts adds value to the "attempts" field.
+func (_u *TenantInitJobUpdateOne) AddAttempts(v int32) *TenantInitJobUpdateOne {
+	_u.mutation.AddAttempts(v)
+	return _u
+}
+
+// SetError sets the "error" field.
+func (_u *TenantInitJobUpdateOne) SetError(v string) *TenantInitJobUpdateOne {
+	_u.mutation.SetError(v)
+	return _u
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_u *TenantInitJobUpdateOne) SetNillableError(v *string) *TenantInitJobUpdateOne {
+	if v != nil {
+		_u.SetError(*v)
+	}
+	return _u
+}
+
+// ClearError clears the value of the "error" field.
+func (_u *TenantInitJobUpdateOne) ClearError() *TenantInitJobUpdateOne {
+	_u.mutation.ClearError()
+	return _u
+}
+
+// SetPlugins sets the "plugins" field.
+func (_u *TenantInitJobUpdateOne) SetPlugins(v []initjob.PluginProgress) *TenantInitJobUpdateOne {
+	_u.mutation.SetPlugins(v)
+	return _u
+}
+
+// AppendPlugins appends value to the "plugins" field.
+func (_u *TenantInitJobUpdateOne) AppendPlugins(v []initjob.PluginProgress) *TenantInitJobUpdateOne {
+	_u.mutation.AppendPlugins(v)
+	return _u
+}
+
+// ClearPlugins clears the value of the "plugins" field.
+func (_u *TenantInitJobUpdateOne) ClearPlugins() *TenantInitJobUpdateOne {
+	_u.mutation.ClearPlugins()
+	return _u
+}
+
+// SetPlan sets the "plan" field.
+func (_u *TenantInitJobUpdateOne) SetPlan(v []initjob.PlanItem) *TenantInitJobUpdateOne {
+	_u.mutation.SetPlan(v)
+	return _u
+}
+
+// AppendPlan appends value to the "plan" field.
+func (_u *TenantInitJobUpdateOne) AppendPlan(v []initjob.PlanItem) *TenantInitJobUpdateOne {
+	_u.mutation.AppendPlan(v)
+	return _u
+}
+
+// ClearPlan clears the value of the "plan" field.
+func (_u *TenantInitJobUpdateOne) ClearPlan() *TenantInitJobUpdateOne {
+	_u.mutation.ClearPlan()
+	return _u
+}
+
+// SetStartedAt sets the "started_at" field.
+func (_u *TenantInitJobUpdateOne) SetStartedAt(v time.Time) *TenantInitJobUpdateOne {
+	_u.mutation.SetStartedAt(v)
+	return _u
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (_u *TenantInitJobUpdateOne) SetNillableStartedAt(v *time.Time) *TenantInitJobUpdateOne {
+	if v != nil {
+		_u.SetStartedAt(*v)
+	}
+	return _u
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (_u *TenantInitJobUpdateOne) ClearStartedAt() *TenantInitJobUpdateOne {
+	_u.mutation.ClearStartedAt()
+	return _u
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (_u *TenantInitJobUpdateOne) SetFinishedAt(v time.Time) *TenantInitJobUpdateOne {
+	_u.mutation.SetFinishedAt(v)
+	return _u
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (_u *TenantInitJobUpdateOne) SetNillableFinishedAt(v *time.Time) *TenantInitJobUpdateOne {
+	if v != nil {
+		_u.SetFinishedAt(*v)
+	}
+	return _u
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (_u *TenantInitJobUpdateOne) ClearFinishedAt() *TenantInitJobUpdateOne {
+	_u.mutation.ClearFinishedAt()
+	return _u
+}
+
+// Mutation returns the TenantInitJobMutation object of the builder.
+func (_u *TenantInitJobUpdateOne) Mutation() *TenantInitJobMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the TenantInitJobUpdate builder.
+func (_u *TenantInitJobUpdateOne) Where(ps ...predicate.TenantInitJob) *TenantInitJobUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *TenantInitJobUpdateOne) Select(field string, fields ...string) *TenantInitJobUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated TenantInitJob entity.
+func (_u *TenantInitJobUpdateOne) Save(ctx context.Context) (*TenantInitJob, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TenantInitJobUpdateOne) SaveX(ctx context.Context) *TenantInitJob {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *TenantInitJobUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TenantInitJobUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *TenantInitJobUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := tenantinitjob.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TenantInitJobUpdateOne) check() error {
+	if v, ok := _u.mutation.Template(); ok {
+		if err := tenantinitjob.TemplateValidator(v); err != nil {
+			return &ValidationError{Name: "template", err: fmt.Errorf(`ent: validator failed for field "TenantInitJob.template": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Mode(); ok {
+		if err := tenantinitjob.ModeValidator(v); err != nil {
+			return &ValidationError{Name: "mode", err: fmt.Errorf(`ent: validator failed for field "TenantInitJob.mode": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := tenantinitjob.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "TenantInitJob.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AdminUsername(); ok {
+		if err := tenantinitjob.AdminUsernameValidator(v); err != nil {
+			return &ValidationError{Name: "admin_username", err: fmt.Errorf(`ent: validator failed for field "TenantInitJob.admin_username": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AdminEmail(); ok {
+		if err := tenantinitjob.AdminEmailValidator(v); err != nil {
+			return &ValidationError{Name: "admin_email", err: fmt.Errorf(`ent: validator failed for field "TenantInitJob.admin_email": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *TenantInitJobUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TenantInitJobUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *TenantInitJobUpdateOne) sqlSave(ctx context.Context) (_node *TenantInitJob, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(tenantinitjob.Table, tenantinitjob.Columns, sqlgraph.NewFieldSpec(tenantinitjob.FieldID, field.TypeUint64))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TenantInitJob.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, tenantinitjob.FieldID)
+		for _, f := range fields {
+			if !tenantinitjob.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != tenantinitjob.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(tenantinitjob.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(tenantinitjob.FieldTenantID, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.AddedTenantID(); ok {
+		_spec.AddField(tenantinitjob.FieldTenantID, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.Template(); ok {
+		_spec.SetField(tenantinitjob.FieldTemplate, field.TypeString, value)
+	}
+	if _u.mutation.TemplateCleared() {
+		_spec.ClearField(tenantinitjob.FieldTemplate, field.TypeString)
+	}
+	if value, ok := _u.mutation.Mode(); ok {
+		_spec.SetField(tenantinitjob.FieldMode, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.DryRun(); ok {
+		_spec.SetField(tenantinitjob.FieldDryRun, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(tenantinitjob.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AdminUsername(); ok {
+		_spec.SetField(tenantinitjob.FieldAdminUsername, field.TypeString, value)
+	}
+	if _u.mutation.AdminUsernameCleared() {
+		_spec.ClearField(tenantinitjob.FieldAdminUsername, field.TypeString)
+	}
+	if value, ok := _u.mutation.AdminEmail(); ok {
+		_spec.SetField(tenantinitjob.FieldAdminEmail, field.TypeString, value)
+	}
+	if _u.mutation.AdminEmailCleared() {
+		_spec.ClearField(tenantinitjob.FieldAdminEmail, field.TypeString)
+	}
+	if value, ok := _u.mutation.Attempts(); ok {
+		_spec.SetField(tenantinitjob.FieldAttempts, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedAttempts(); ok {
+		_spec.AddField(tenantinitjob.FieldAttempts, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.Error(); ok {
+		_spec.SetField(tenantinitjob.FieldError, field.TypeString, value)
+	}
+	if _u.mutation.ErrorCleared() {
+		_spec.ClearField(tenantinitjob.FieldError, field.TypeString)
+	}
+	if value, ok := _u.mutation.Plugins(); ok {
+		_spec.SetField(tenantinitjob.FieldPlugins, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedPlugins(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, tenantinitjob.FieldPlugins, value)
+		})
+	}
+	if _u.mutation.PluginsCleared() {
+		_spec.ClearField(tenantinitjob.FieldPlugins, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Plan(); ok {
+		_spec.SetField(tenantinitjob.FieldPlan, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedPlan(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, tenantinitjob.FieldPlan, value)
+		})
+	}
+	if _u.mutation.PlanCleared() {
+		_spec.ClearField(tenantinitjob.FieldPlan, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.StartedAt(); ok {
+		_spec.SetField(tenantinitjob.FieldStartedAt, field.TypeTime, value)
+	}
+	if _u.mutation.StartedAtCleared() {
+		_spec.ClearField(tenantinitjob.FieldStartedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.FinishedAt(); ok {
+		_spec.SetField(tenantinitjob.FieldFinishedAt, field.TypeTime, value)
+	}
+	if _u.mutation.FinishedAtCleared() {
+		_spec.ClearField(tenantinitjob.FieldFinishedAt, field.TypeTime)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &TenantInitJob{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{tenantinitjob.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,223 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/coder-lulu/newbee-core/rpc/ent/tenantinitplugin"
+)
+
+// Tenant Initialization Plugin Table | 租户初始化远程插件表
+type TenantInitPlugin struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uint64 `json:"id,omitempty"`
+	// Create Time | 创建日期
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Update Time | 修改日期
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Status 1: normal 2: ban | 状态 1 正常 2 禁用
+	Status uint8 `json:"status,omitempty"`
+	// Plugin name, usually the service name | 插件名称，一般为服务名
+	Name string `json:"name,omitempty"`
+	// Plugin version | 插件版本
+	Version string `json:"version,omitempty"`
+	// Description | 插件描述
+	Description string `json:"description,omitempty"`
+	// gRPC target of the service, e.g. cmdb-rpc:9105 or etcd://127.0.0.1:2379/cmdb.rpc | 服务的 gRPC 地址
+	Target string `json:"target,omitempty"`
+	// Names of the plugins it depends on, the core plugin is always a dependency | 依赖的插件名称，总是依赖核心插件
+	Dependencies []string `json:"dependencies,omitempty"`
+	// Order among the plugins without dependencies between them, the smaller the earlier | 无依赖关系的插件间的执行顺序，越小越先执行
+	Priority int32 `json:"priority,omitempty"`
+	// Timeout of each call in seconds | 每次调用的超时时间（秒）
+	Timeout uint32 `json:"timeout,omitempty"`
+	// Whether it can roll back, i.e. compensate when a later plugin fails | 是否支持回滚，用于后续插件失败时补偿
+	SupportRollback bool `json:"support_rollback,omitempty"`
+	selectValues    sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TenantInitPlugin) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case tenantinitplugin.FieldDependencies:
+			values[i] = new([]byte)
+		case tenantinitplugin.FieldSupportRollback:
+			values[i] = new(sql.NullBool)
+		case tenantinitplugin.FieldID, tenantinitplugin.FieldStatus, tenantinitplugin.FieldPriority, tenantinitplugin.FieldTimeout:
+			values[i] = new(sql.NullInt64)
+		case tenantinitplugin.FieldName, tenantinitplugin.FieldVersion, tenantinitplugin.FieldDescription, tenantinitplugin.FieldTarget:
+			values[i] = new(sql.NullString)
+		case tenantinitplugin.FieldCreatedAt, tenantinitplugin.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TenantInitPlugin fields.
+func (_m *TenantInitPlugin) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case tenantinitplugin.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = uint64(value.Int64)
+		case tenantinitplugin.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case tenantinitplugin.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case tenantinitplugin.FieldStatus:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = uint8(value.Int64)
+			}
+		case tenantinitplugin.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case tenantinitplugin.FieldVersion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
+			} else if value.Valid {
+				_m.Version = value.String
+			}
+		case tenantinitplugin.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				_m.Description = value.String
+			}
+		case tenantinitplugin.FieldTarget:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field target", values[i])
+			} else if value.Valid {
+				_m.Target = value.String
+			}
+		case tenantinitplugin.FieldDependencies:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field dependencies", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Dependencies); err != nil {
+					return fmt.Errorf("unmarshal field dependencies: %w", err)
+				}
+			}
+		case tenantinitplugin.FieldPriority:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field priority", values[i])
+			} else if value.Valid {
+				_m.Priority = int32(value.Int64)
+			}
+		case tenantinitplugin.FieldTimeout:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field timeout", values[i])
+			} else if value.Valid {
+				_m.Timeout = uint32(value.Int64)
+			}
+		case tenantinitplugin.FieldSupportRollback:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field support_rollback", values[i])
+			} else if value.Valid {
+				_m.SupportRollback = value.Bool
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TenantInitPlugin.
+// This includes values selected through modifiers, order, etc.
+func (_m *TenantInitPlugin) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this TenantInitPlugin.
+// Note that you need to call TenantInitPlugin.Unwrap() before calling this method if this TenantInitPlugin
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *TenantInitPlugin) Update() *TenantInitPluginUpdateOne {
+	return NewTenantInitPluginClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the TenantInitPlugin entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *TenantInitPlugin) Unwrap() *TenantInitPlugin {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: TenantInitPlugin is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *TenantInitPlugin) String() string {
+	var builder strings.Builder
+	builder.WriteString("TenantInitPlugin(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("version=")
+	builder.WriteString(_m.Version)
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(_m.Description)
+	builder.WriteString(", ")
+	builder.WriteString("target=")
+	builder.WriteString(_m.Target)
+	builder.WriteString(", ")
+	builder.WriteString("dependencies=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Dependencies))
+	builder.WriteString(", ")
+	builder.WriteString("priority=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Priority))
+	builder.WriteString(", ")
+	builder.WriteString("timeout=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Timeout))
+	builder.WriteString(", ")
+	builder.WriteString("support_rollback=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SupportRollback))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TenantInitPlugins is a parsable slice of TenantInitPlugin.
+type TenantInitPlugins []*TenantInitPlugin
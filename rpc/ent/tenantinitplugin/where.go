@@ -0,0 +1,620 @@
+// Code generated by ent, DO NOT EDIT.
+
+package tenantinitplugin
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/coder-lulu/newbee-core/rpc/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uint64) predicate.TenantInitPlugin {
+	return predicate.TenantInitPlugin(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uint64) predicate.TenantInitPlugin {
+	return predicate.TenantInitPlugin(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uint64) predicate.TenantInitPlugin {
+	return predicate.TenantInitPlugin(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uint64) predicate.TenantInitPlugin {
+	return predicate.TenantInitPlugin(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uint64) predicate.TenantInitPlugin {
+	return predicate.TenantInitPlugin(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uint64) predicate.TenantInitPlugin {
+	return predicate.TenantInitPlugin(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uint64) predicate.TenantInitPlugin {
+	return predicate.TenantInitPlugin(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uint64) predicate.TenantInitPlugin {
+	return predicate.TenantInitPlugin(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uint64) predicate.TenantInitPlugin {
+	return predicate.TenantInitPlugin(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.TenantInitPlugin {
+	return predicate.TenantInitPlugin(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.TenantInitPlugin {
+	return predicate.TenantInitPlugin(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v uint8) predicate.TenantInitPlugin {
+	return predicate.TenantInitPlugin(sql.FieldEQ(FieldStatus, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.TenantInitPlugin {
+	return predicate.TenantInitPlugin(sql.FieldEQ(FieldName, v))
+}
+
+// Version applies equality check predicate on the "version" field. It's identical to VersionEQ.
+func Version(v string) predicate.TenantInitPlugin {
+	return predicate.TenantInitPlugin(sql.FieldEQ(FieldVersion, v))
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.TenantInitPlugin {
+	return predicate.TenantInitPlugin(sql.FieldEQ(FieldDescription, v))
+}
+
+// Target applies equality check predicate on the "target" field. It's identical to TargetEQ.
+func Target(v string) predicate.TenantInitPlugin {
+	return predicate.TenantInitPlugin(sql.FieldEQ(FieldTarget, v))
+}
+
+// Priority applies equality check predicate on the "priority" field. It's identical to PriorityEQ.
+func Priority(v int32) predicate.TenantInitPlugin {
+	return predicate.TenantInitPlugin(sql.FieldEQ(FieldPriority, v))
+}
+
+// Timeout applies equality check predicate on the "timeout" field. It's identical to TimeoutEQ.
+func Timeout(v uint32) predicate.TenantInitPlugin {
+	return predicate.TenantInitPlugin(sql.FieldEQ(FieldTimeout, v))
+}
+
+// SupportRollback applies equality check predicate on the "support_rollback" field. It's identical to SupportRollbackEQ.
+func SupportRollback(v bool) predicate.TenantInitPlugin {
+	return predicate.TenantInitPlugin(sql.FieldEQ(FieldSupportRollback, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.TenantInitPlugin {
+	return predicate.TenantInitPlugin(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.TenantInitPlugin {
+	return predicate.TenantInitPlugin(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.TenantInitPlugin {
+	return predicate.TenantInitPlugin(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.TenantInitPlugin {
+	return predicate.TenantInitPlugin(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.TenantInitPlugin {
+	return predicate.TenantInitPlugin(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.TenantInitPlugin {
+	return predicate.TenantInitPlugin(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.TenantInitPlugin {
+	return predicate.TenantInitPlugin(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.TenantInitPlugin {
+	return predicate.TenantInitPlugin(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.TenantInitPlugin {
+	return predicate.TenantInitPlugin(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.TenantInitPlugin {
+	return predicate.TenantInitPlugin(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.TenantInitPlugin {
+	return predicate.TenantInitPlugin(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.TenantInitPlugin {
+	return predicate.TenantInitPlugin(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.TenantInitPlugin {
+	return predicate.TenantInitPlugin(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.TenantInitPlugin {
+	return predicate.TenantInitPlugin(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.TenantInitPlugin {
+	return predicate.TenantInitPlugin(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.TenantInitPlugin {
+	return predicate.TenantInitPlugin(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v uint8) predicate.TenantInitPlugin {
+	return predicate.TenantInitPlugin(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v uint8) predicate.TenantInitPlugin {
+	return predicate.TenantInitPlugin(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...uint8) predicate.TenantInitPlugin {
+	return predicate.TenantInitPlugin(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...uint8) predicate.TenantInitPlugin {
+	return predicate.TenantInitPlugin(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v uint8) predicate.TenantInitPlugin {
+	return predicate.TenantInitPlugin(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v uint8) predicate.TenantInitPlugin {
+	return predicate.TenantInitPlugin(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v uint8) predicate.TenantInitPlugin {
+	return predicate.TenantInitPlugin(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v uint8) predicate.TenantInitPlugin {
+	return predicate.TenantInitPlugin(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusIsNil applies the IsNil predicate on the "status" field.
+func StatusIsNil() predicate.TenantInitPlugin {
+	return predicate.TenantInitPlugin(sql.FieldIsNull(FieldStatus))
+}
+
+// StatusNotNil applies the NotNil predicate on the "status" field.
+func StatusNotNil() predicate.TenantInitPlugin {
+	return predicate.TenantInitPlugin(sql.FieldNotNull(FieldStatus))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.TenantInitPlugin {
+	return predicate.TenantInitPlugin(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.TenantInitPlugin {
+	return predicate.TenantInitPlugin(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.TenantInitPlugin {
+	return predicate.TenantInitPlugin(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.TenantInitPlugin {
+	return predicate.TenantInitPlugin(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.TenantInitPlugin {
+	return predicate.TenantInitPlugin(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.TenantInitPlugin {
+	return predicate.TenantInitPlugin(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.TenantInitPlugin {
+	return predicate.TenantInitPlugin(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.TenantInitPlugin {
+	return predicate.TenantInitPlugin(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.TenantInitPlugin {
+	return predicate.TenantInitPlugin(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.TenantInitPlugin {
+	return predicate.TenantInitPlugin(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.TenantInitPlugin {
+	return predicate.TenantInitPlugin(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.TenantInitPlugin {
+	return predicate.TenantInitPlugin(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.TenantInitPlugin {
+	return predicate.TenantInitPlugin(sql.FieldContainsFold(FieldName, v))
+}
+
+// VersionEQ applies the EQ predicate on the "version" field.
+func VersionEQ(v string) predicate.TenantInitPlugin {
+	return predicate.TenantInitPlugin(sql.FieldEQ(FieldVersion, v))
+}
+
+// VersionNEQ applies the NEQ predicate on the "version" field.
+func VersionNEQ(v string) predicate.TenantInitPlugin {
+	return predicate.TenantInitPlugin(sql.FieldNEQ(FieldVersion, v))
+}
+
+// VersionIn applies the In predicate on the "version" field.
+func VersionIn(vs ...string) predicate.TenantInitPlugin {
+	return predicate.TenantInitPlugin(sql.FieldIn(FieldVersion, vs...))
+}
+
+// VersionNotIn applies the NotIn predicate on the "version" field.
+func VersionNotIn(vs ...string) predicate.TenantInitPlugin {
+	return predicate.TenantInitPlugin(sql.FieldNotIn(FieldVersion, vs...))
+}
+
+// VersionGT applies the GT predicate on the "version" field.
+func VersionGT(v string) predicate.TenantInitPlugin {
+	return predicate.TenantInitPlugin(sql.FieldGT(FieldVersion, v))
+}
+
+// VersionGTE applies the GTE predicate on the "version" field.
+func VersionGTE(v string) predicate.TenantInitPlugin {
+	return predicate.TenantInitPlugin(sql.FieldGTE(FieldVersion, v))
+}
+
+// VersionLT applies the LT predicate on the "version" field.
+func VersionLT(v string) predicate.TenantInitPlugin {
+	return predicate.TenantInitPlugin(sql.FieldLT(FieldVersion, v))
+}
+
+// VersionLTE applies the LTE predicate on the "version" field.
+func VersionLTE(v string) predicate.TenantInitPlugin {
+	return predicate.TenantInitPlugin(sql.FieldLTE(FieldVersion, v))
+}
+
+// VersionContains applies the Contains predicate on the "version" field.
+func VersionContains(v string) predicate.TenantInitPlugin {
+	return predicate.TenantInitPlugin(sql.FieldContains(FieldVersion, v))
+}
+
+// VersionHasPrefix applies the HasPrefix predicate on the "version" field.
+func VersionHasPrefix(v string) predicate.TenantInitPlugin {
+	return predicate.TenantInitPlugin(sql.FieldHasPrefix(FieldVersion, v))
+}
+
+// VersionHasSuffix applies the HasSuffix predicate on the "version" field.
+func VersionHasSuffix(v string) predicate.TenantInitPlugin {
+	return predicate.TenantInitPlugin(sql.FieldHasSuffix(FieldVersion, v))
+}
+
+// VersionEqualFold applies the EqualFold predicate on the "version" field.
+func VersionEqualFold(v string) predicate.TenantInitPlugin {
+	return predicate.TenantInitPlugin(sql.FieldEqualFold(FieldVersion, v))
+}
+
+// VersionContainsFold applies the ContainsFold predicate on the "version" field.
+func VersionContainsFold(v string) predicate.TenantInitPlugin {
+	return predicate.TenantInitPlugin(sql.FieldContainsFold(FieldVersion, v))
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.TenantInitPlugin {
+	return predicate.TenantInitPlugin(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.TenantInitPlugin {
+	return predicate.TenantInitPlugin(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.TenantInitPlugin {
+	return predicate.TenantInitPlugin(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.TenantInitPlugin {
+	return predicate.TenantInitPlugin(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.TenantInitPlugin {
+	return predicate.TenantInitPlugin(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.TenantInitPlugin {
+	return predicate.TenantInitPlugin(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.TenantInitPlugin {
+	return predicate.TenantInitPlugin(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.TenantInitPlugin {
+	return predicate.TenantInitPlugin(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.TenantInitPlugin {
+	return predicate.TenantInitPlugin(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.TenantInitPlugin {
+	return predicate.TenantInitPlugin(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.TenantInitPlugin {
+	return predicate.TenantInitPlugin(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionIsNil applies the IsNil predicate on the "description" field.
+func DescriptionIsNil() predicate.TenantInitPlugin {
+	return predicate.TenantInitPlugin(sql.FieldIsNull(FieldDescription))
+}
+
+// DescriptionNotNil applies the NotNil predicate on the "description" field.
+func DescriptionNotNil() predicate.TenantInitPlugin {
+	return predicate.TenantInitPlugin(sql.FieldNotNull(FieldDescription))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.TenantInitPlugin {
+	return predicate.TenantInitPlugin(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.TenantInitPlugin {
+	return predicate.TenantInitPlugin(sql.FieldContainsFold(FieldDescription, v))
+}
+
+// TargetEQ applies the EQ predicate on the "target" field.
+func TargetEQ(v string) predicate.TenantInitPlugin {
+	return predicate.TenantInitPlugin(sql.FieldEQ(FieldTarget, v))
+}
+
+// TargetNEQ applies the NEQ predicate on the "target" field.
+func TargetNEQ(v string) predicate.TenantInitPlugin {
+	return predicate.TenantInitPlugin(sql.FieldNEQ(FieldTarget, v))
+}
+
+// TargetIn applies the In predicate on the "target" field.
+func TargetIn(vs ...string) predicate.TenantInitPlugin {
+	return predicate.TenantInitPlugin(sql.FieldIn(FieldTarget, vs...))
+}
+
+// TargetNotIn applies the NotIn predicate on the "target" field.
+func TargetNotIn(vs ...string) predicate.TenantInitPlugin {
+	return predicate.TenantInitPlugin(sql.FieldNotIn(FieldTarget, vs...))
+}
+
+// TargetGT applies the GT predicate on the "target" field.
+func TargetGT(v string) predicate.TenantInitPlugin {
+	return predicate.TenantInitPlugin(sql.FieldGT(FieldTarget, v))
+}
+
+// TargetGTE applies the GTE predicate on the "target" field.
+func TargetGTE(v string) predicate.TenantInitPlugin {
+	return predicate.TenantInitPlugin(sql.FieldGTE(FieldTarget, v))
+}
+
+// TargetLT applies the LT predicate on the "target" field.
+func TargetLT(v string) predicate.TenantInitPlugin {
+	return predicate.TenantInitPlugin(sql.FieldLT(FieldTarget, v))
+}
+
+// TargetLTE applies the LTE predicate on the "target" field.
+func TargetLTE(v string) predicate.TenantInitPlugin {
+	return predicate.TenantInitPlugin(sql.FieldLTE(FieldTarget, v))
+}
+
+// TargetContains applies the Contains predicate on the "target" field.
+func TargetContains(v string) predicate.TenantInitPlugin {
+	return predicate.TenantInitPlugin(sql.FieldContains(FieldTarget, v))
+}
+
+// TargetHasPrefix applies the HasPrefix predicate on the "target" field.
+func TargetHasPrefix(v string) predicate.TenantInitPlugin {
+	return predicate.TenantInitPlugin(sql.FieldHasPrefix(FieldTarget, v))
+}
+
+// TargetHasSuffix applies the HasSuffix predicate on the "target" field.
+func TargetHasSuffix(v string) predicate.TenantInitPlugin {
+	return predicate.TenantInitPlugin(sql.FieldHasSuffix(FieldTarget, v))
+}
+
+// TargetEqualFold applies the EqualFold predicate on the "target" field.
+func TargetEqualFold(v string) predicate.TenantInitPlugin {
+	return predicate.TenantInitPlugin(sql.FieldEqualFold(FieldTarget, v))
+}
+
+// TargetContainsFold applies the ContainsFold predicate on the "target" field.
+func TargetContainsFold(v string) predicate.TenantInitPlugin {
+	return predicate.TenantInitPlugin(sql.FieldContainsFold(FieldTarget, v))
+}
+
+// DependenciesIsNil applies the IsNil predicate on the "dependencies" field.
+func DependenciesIsNil() predicate.TenantInitPlugin {
+	return predicate.TenantInitPlugin(sql.FieldIsNull(FieldDependencies))
+}
+
+// DependenciesNotNil applies the NotNil predicate on the "dependencies" field.
+func DependenciesNotNil() predicate.TenantInitPlugin {
+	return predicate.TenantInitPlugin(sql.FieldNotNull(FieldDependencies))
+}
+
+// PriorityEQ applies the EQ predicate on the "priority" field.
+func PriorityEQ(v int32) predicate.TenantInitPlugin {
+	return predicate.TenantInitPlugin(sql.FieldEQ(FieldPriority, v))
+}
+
+// PriorityNEQ applies the NEQ predicate on the "priority" field.
+func PriorityNEQ(v int32) predicate.TenantInitPlugin {
+	return predicate.TenantInitPlugin(sql.FieldNEQ(FieldPriority, v))
+}
+
+// PriorityIn applies the In predicate on the "priority" field.
+func PriorityIn(vs ...int32) predicate.TenantInitPlugin {
+	return predicate.TenantInitPlugin(sql.FieldIn(FieldPriority, vs...))
+}
+
+// PriorityNotIn applies the NotIn predicate on the "priority" field.
+func PriorityNotIn(vs ...int32) predicate.TenantInitPlugin {
+	return predicate.TenantInitPlugin(sql.FieldNotIn(FieldPriority, vs...))
+}
+
+// PriorityGT applies the GT predicate on the "priority" field.
+func PriorityGT(v int32) predicate.TenantInitPlugin {
+	return predicate.TenantInitPlugin(sql.FieldGT(FieldPriority, v))
+}
+
+// PriorityGTE applies the GTE predicate on the "priority" field.
+func PriorityGTE(v int32) predicate.TenantInitPlugin {
+	return predicate.TenantInitPlugin(sql.FieldGTE(FieldPriority, v))
+}
+
+// PriorityLT applies the LT predicate on the "priority" field.
+func PriorityLT(v int32) predicate.TenantInitPlugin {
+	return predicate.TenantInitPlugin(sql.FieldLT(FieldPriority, v))
+}
+
+// PriorityLTE applies the LTE predicate on the "priority" field.
+func PriorityLTE(v int32) predicate.TenantInitPlugin {
+	return predicate.TenantInitPlugin(sql.FieldLTE(FieldPriority, v))
+}
+
+// TimeoutEQ applies the EQ predicate on the "timeout" field.
+func TimeoutEQ(v uint32) predicate.TenantInitPlugin {
+	return predicate.TenantInitPlugin(sql.FieldEQ(FieldTimeout, v))
+}
+
+// TimeoutNEQ applies the NEQ predicate on the "timeout" field.
+func TimeoutNEQ(v uint32) predicate.TenantInitPlugin {
+	return predicate.TenantInitPlugin(sql.FieldNEQ(FieldTimeout, v))
+}
+
+// TimeoutIn applies the In predicate on the "timeout" field.
+func TimeoutIn(vs ...uint32) predicate.TenantInitPlugin {
+	return predicate.TenantInitPlugin(sql.FieldIn(FieldTimeout, vs...))
+}
+
+// TimeoutNotIn applies the NotIn predicate on the "timeout" field.
+func TimeoutNotIn(vs ...uint32) predicate.TenantInitPlugin {
+	return predicate.TenantInitPlugin(sql.FieldNotIn(FieldTimeout, vs...))
+}
+
+// TimeoutGT applies the GT predicate on the "timeout" field.
+func TimeoutGT(v uint32) predicate.TenantInitPlugin {
+	return predicate.TenantInitPlugin(sql.FieldGT(FieldTimeout, v))
+}
+
+// TimeoutGTE applies the GTE predicate on the "timeout" field.
+func TimeoutGTE(v uint32) predicate.TenantInitPlugin {
+	return predicate.TenantInitPlugin(sql.FieldGTE(FieldTimeout, v))
+}
+
+// TimeoutLT applies the LT predicate on the "timeout" field.
+func TimeoutLT(v uint32) predicate.TenantInitPlugin {
+	return predicate.TenantInitPlugin(sql.FieldLT(FieldTimeout, v))
+}
+
+// TimeoutLTE applies the LTE predicate on the "timeout" field.
+func TimeoutLTE(v uint32) predicate.TenantInitPlugin {
+	return predicate.TenantInitPlugin(sql.FieldLTE(FieldTimeout, v))
+}
+
+// SupportRollbackEQ applies the EQ predicate on the "support_rollback" field.
+func SupportRollbackEQ(v bool) predicate.TenantInitPlugin {
+	return predicate.TenantInitPlugin(sql.FieldEQ(FieldSupportRollback, v))
+}
+
+// SupportRollbackNEQ applies the NEQ predicate on the "support_rollback" field.
+func SupportRollbackNEQ(v bool) predicate.TenantInitPlugin {
+	return predicate.TenantInitPlugin(sql.FieldNEQ(FieldSupportRollback, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.TenantInitPlugin) predicate.TenantInitPlugin {
+	return predicate.TenantInitPlugin(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.TenantInitPlugin) predicate.TenantInitPlugin {
+	return predicate.TenantInitPlugin(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.TenantInitPlugin) predicate.TenantInitPlugin {
+	return predicate.TenantInitPlugin(sql.NotPredicates(p))
+}
@@ -0,0 +1,435 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/coder-lulu/newbee-core/rpc/ent/tenantinitplugin"
+)
+
+// TenantInitPluginCreate is the builder for creating a TenantInitPlugin entity.
+type TenantInitPluginCreate struct {
+	config
+	mutation *TenantInitPluginMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *TenantInitPluginCreate) SetCreatedAt(v time.Time) *TenantInitPluginCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *TenantInitPluginCreate) SetNillableCreatedAt(v *time.Time) *TenantInitPluginCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *TenantInitPluginCreate) SetUpdatedAt(v time.Time) *TenantInitPluginCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *TenantInitPluginCreate) SetNillableUpdatedAt(v *time.Time) *TenantInitPluginCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *TenantInitPluginCreate) SetStatus(v uint8) *TenantInitPluginCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *TenantInitPluginCreate) SetNillableStatus(v *uint8) *TenantInitPluginCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *TenantInitPluginCreate) SetName(v string) *TenantInitPluginCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetVersion sets the "version" field.
+func (_c *TenantInitPluginCreate) SetVersion(v string) *TenantInitPluginCreate {
+	_c.mutation.SetVersion(v)
+	return _c
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_c *TenantInitPluginCreate) SetNillableVersion(v *string) *TenantInitPluginCreate {
+	if v != nil {
+		_c.SetVersion(*v)
+	}
+	return _c
+}
+
+// SetDescription sets the "description" field.
+func (_c *TenantInitPluginCreate) SetDescription(v string) *TenantInitPluginCreate {
+	_c.mutation.SetDescription(v)
+	return _c
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_c *TenantInitPluginCreate) SetNillableDescription(v *string) *TenantInitPluginCreate {
+	if v != nil {
+		_c.SetDescription(*v)
+	}
+	return _c
+}
+
+// SetTarget sets the "target" field.
+func (_c *TenantInitPluginCreate) SetTarget(v string) *TenantInitPluginCreate {
+	_c.mutation.SetTarget(v)
+	return _c
+}
+
+// SetDependencies sets the "dependencies" field.
+func (_c *TenantInitPluginCreate) SetDependencies(v []string) *TenantInitPluginCreate {
+	_c.mutation.SetDependencies(v)
+	return _c
+}
+
+// SetPriority sets the "priority" field.
+func (_c *TenantInitPluginCreate) SetPriority(v int32) *TenantInitPluginCreate {
+	_c.mutation.SetPriority(v)
+	return _c
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (_c *TenantInitPluginCreate) SetNillablePriority(v *int32) *TenantInitPluginCreate {
+	if v != nil {
+		_c.SetPriority(*v)
+	}
+	return _c
+}
+
+// SetTimeout sets the "timeout" field.
+func (_c *TenantInitPluginCreate) SetTimeout(v uint32) *TenantInitPluginCreate {
+	_c.mutation.SetTimeout(v)
+	return _c
+}
+
+// SetNillableTimeout sets the "timeout" field if the given value is not nil.
+func (_c *TenantInitPluginCreate) SetNillableTimeout(v *uint32) *TenantInitPluginCreate {
+	if v != nil {
+		_c.SetTimeout(*v)
+	}
+	return _c
+}
+
+// SetSupportRollback sets the "support_rollback" field.
+func (_c *TenantInitPluginCreate) SetSupportRollback(v bool) *TenantInitPluginCreate {
+	_c.mutation.SetSupportRollback(v)
+	return _c
+}
+
+// SetNillableSupportRollback sets the "support_rollback" field if the given value is not nil.
+func (_c *TenantInitPluginCreate) SetNillableSupportRollback(v *bool) *TenantInitPluginCreate {
+	if v != nil {
+		_c.SetSupportRollback(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *TenantInitPluginCreate) SetID(v uint64) *TenantInitPluginCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the TenantInitPluginMutation object of the builder.
+func (_c *TenantInitPluginCreate) Mutation() *TenantInitPluginMutation {
+	return _c.mutation
+}
+
+// Save creates the TenantInitPlugin in the database.
+func (_c *TenantInitPluginCreate) Save(ctx context.Context) (*TenantInitPlugin, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *TenantInitPluginCreate) SaveX(ctx context.Context) *TenantInitPlugin {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TenantInitPluginCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TenantInitPluginCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *TenantInitPluginCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := tenantinitplugin.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := tenantinitplugin.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := tenantinitplugin.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.Version(); !ok {
+		v := tenantinitplugin.DefaultVersion
+		_c.mutation.SetVersion(v)
+	}
+	if _, ok := _c.mutation.Priority(); !ok {
+		v := tenantinitplugin.DefaultPriority
+		_c.mutation.SetPriority(v)
+	}
+	if _, ok := _c.mutation.Timeout(); !ok {
+		v := tenantinitplugin.DefaultTimeout
+		_c.mutation.SetTimeout(v)
+	}
+	if _, ok := _c.mutation.SupportRollback(); !ok {
+		v := tenantinitplugin.DefaultSupportRollback
+		_c.mutation.SetSupportRollback(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *TenantInitPluginCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "TenantInitPlugin.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "TenantInitPlugin.updated_at"`)}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "TenantInitPlugin.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := tenantinitplugin.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "TenantInitPlugin.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`ent: missing required field "TenantInitPlugin.version"`)}
+	}
+	if v, ok := _c.mutation.Version(); ok {
+		if err := tenantinitplugin.VersionValidator(v); err != nil {
+			return &ValidationError{Name: "version", err: fmt.Errorf(`ent: validator failed for field "TenantInitPlugin.version": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Description(); ok {
+		if err := tenantinitplugin.DescriptionValidator(v); err != nil {
+			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "TenantInitPlugin.description": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Target(); !ok {
+		return &ValidationError{Name: "target", err: errors.New(`ent: missing required field "TenantInitPlugin.target"`)}
+	}
+	if v, ok := _c.mutation.Target(); ok {
+		if err := tenantinitplugin.TargetValidator(v); err != nil {
+			return &ValidationError{Name: "target", err: fmt.Errorf(`ent: validator failed for field "TenantInitPlugin.target": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Priority(); !ok {
+		return &ValidationError{Name: "priority", err: errors.New(`ent: missing required field "TenantInitPlugin.priority"`)}
+	}
+	if _, ok := _c.mutation.Timeout(); !ok {
+		return &ValidationError{Name: "timeout", err: errors.New(`ent: missing required field "TenantInitPlugin.timeout"`)}
+	}
+	if _, ok := _c.mutation.SupportRollback(); !ok {
+		return &ValidationError{Name: "support_rollback", err: errors.New(`ent: missing required field "TenantInitPlugin.support_rollback"`)}
+	}
+	return nil
+}
+
+func (_c *TenantInitPluginCreate) sqlSave(ctx context.Context) (*TenantInitPlugin, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint64(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *TenantInitPluginCreate) createSpec() (*TenantInitPlugin, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TenantInitPlugin{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(tenantinitplugin.Table, sqlgraph.NewFieldSpec(tenantinitplugin.FieldID, field.TypeUint64))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(tenantinitplugin.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(tenantinitplugin.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(tenantinitplugin.FieldStatus, field.TypeUint8, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(tenantinitplugin.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Version(); ok {
+		_spec.SetField(tenantinitplugin.FieldVersion, field.TypeString, value)
+		_node.Version = value
+	}
+	if value, ok := _c.mutation.Description(); ok {
+		_spec.SetField(tenantinitplugin.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := _c.mutation.Target(); ok {
+		_spec.SetField(tenantinitplugin.FieldTarget, field.TypeString, value)
+		_node.Target = value
+	}
+	if value, ok := _c.mutation.Dependencies(); ok {
+		_spec.SetField(tenantinitplugin.FieldDependencies, field.TypeJSON, value)
+		_node.Dependencies = value
+	}
+	if value, ok := _c.mutation.Priority(); ok {
+		_spec.SetField(tenantinitplugin.FieldPriority, field.TypeInt32, value)
+		_node.Priority = value
+	}
+	if value, ok := _c.mutation.Timeout(); ok {
+		_spec.SetField(tenantinitplugin.FieldTimeout, field.TypeUint32, value)
+		_node.Timeout = value
+	}
+	if value, ok := _c.mutation.SupportRollback(); ok {
+		_spec.SetField(tenantinitplugin.FieldSupportRollback, field.TypeBool, value)
+		_node.SupportRollback = value
+	}
+	return _node, _spec
+}
+
+// TenantInitPluginCreateBulk is the builder for creating many TenantInitPlugin entities in bulk.
+type TenantInitPluginCreateBulk struct {
+	config
+	err      error
+	builders []*TenantInitPluginCreate
+}
+
+// Save creates the TenantInitPlugin entities in the database.
+func (_c *TenantInitPluginCreateBulk) Save(ctx context.Context) ([]*TenantInitPlugin, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*TenantInitPlugin, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TenantInitPluginMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *TenantInitPluginCreateBulk) SaveX(ctx context.Context) []*TenantInitPlugin {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TenantInitPluginCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TenantInitPluginCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/coder-lulu/newbee-core/rpc/ent/predicate"
+	"github.com/coder-lulu/newbee-core/rpc/ent/tenantinitplugin"
+)
+
+// TenantInitPluginDelete is the builder for deleting a TenantInitPlugin entity.
+type TenantInitPluginDelete struct {
+	config
+	hooks    []Hook
+	mutation *TenantInitPluginMutation
+}
+
+// Where appends a list predicates to the TenantInitPluginDelete builder.
+func (_d *TenantInitPluginDelete) Where(ps ...predicate.TenantInitPlugin) *TenantInitPluginDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *TenantInitPluginDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *TenantInitPluginDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *TenantInitPluginDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(tenantinitplugin.Table, sqlgraph.NewFieldSpec(tenantinitplugin.FieldID, field.TypeUint64))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// TenantInitPluginDeleteOne is the builder for deleting a single TenantInitPlugin entity.
+type TenantInitPluginDeleteOne struct {
+	_d *TenantInitPluginDelete
+}
+
+// Where appends a list predicates to the TenantInitPluginDelete builder.
+func (_d *TenantInitPluginDeleteOne) Where(ps ...predicate.TenantInitPlugin) *TenantInitPluginDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *TenantInitPluginDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{tenantinitplugin.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *TenantInitPluginDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
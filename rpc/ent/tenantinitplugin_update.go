@@ -0,0 +1,711 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/coder-lulu/newbee-core/rpc/ent/predicate"
+	"github.com/coder-lulu/newbee-core/rpc/ent/tenantinitplugin"
+)
+
+// TenantInitPluginUpdate is the builder for updating TenantInitPlugin entities.
+type TenantInitPluginUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *TenantInitPluginMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the TenantInitPluginUpdate builder.
+func (_u *TenantInitPluginUpdate) Where(ps ...predicate.TenantInitPlugin) *TenantInitPluginUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *TenantInitPluginUpdate) SetUpdatedAt(v time.Time) *TenantInitPluginUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *TenantInitPluginUpdate) SetStatus(v uint8) *TenantInitPluginUpdate {
+	_u.mutation.ResetStatus()
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *TenantInitPluginUpdate) SetNillableStatus(v *uint8) *TenantInitPluginUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// AddStatus adds value to the "status" field.
+func (_u *TenantInitPluginUpdate) AddStatus(v int8) *TenantInitPluginUpdate {
+	_u.mutation.AddStatus(v)
+	return _u
+}
+
+// ClearStatus clears the value of the "status" field.
+func (_u *TenantInitPluginUpdate) ClearStatus() *TenantInitPluginUpdate {
+	_u.mutation.ClearStatus()
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *TenantInitPluginUpdate) SetName(v string) *TenantInitPluginUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *TenantInitPluginUpdate) SetNillableName(v *string) *TenantInitPluginUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetVersion sets the "version" field.
+func (_u *TenantInitPluginUpdate) SetVersion(v string) *TenantInitPluginUpdate {
+	_u.mutation.SetVersion(v)
+	return _u
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_u *TenantInitPluginUpdate) SetNillableVersion(v *string) *TenantInitPluginUpdate {
+	if v != nil {
+		_u.SetVersion(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *TenantInitPluginUpdate) SetDescription(v string) *TenantInitPluginUpdate {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *TenantInitPluginUpdate) SetNillableDescription(v *string) *TenantInitPluginUpdate {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *TenantInitPluginUpdate) ClearDescription() *TenantInitPluginUpdate {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetTarget sets the "target" field.
+func (_u *TenantInitPluginUpdate) SetTarget(v string) *TenantInitPluginUpdate {
+	_u.mutation.SetTarget(v)
+	return _u
+}
+
+// SetNillableTarget sets the "target" field if the given value is not nil.
+func (_u *TenantInitPluginUpdate) SetNillableTarget(v *string) *TenantInitPluginUpdate {
+	if v != nil {
+		_u.SetTarget(*v)
+	}
+	return _u
+}
+
+// SetDependencies sets the "dependencies" field.
+func (_u *TenantInitPluginUpdate) SetDependencies(v []string) *TenantInitPluginUpdate {
+	_u.mutation.SetDependencies(v)
+	return _u
+}
+
+// AppendDependencies appends value to the "dependencies" field.
+func (_u *TenantInitPluginUpdate) AppendDependencies(v []string) *TenantInitPluginUpdate {
+	_u.mutation.AppendDependencies(v)
+	return _u
+}
+
+// ClearDependencies clears the value of the "dependencies" field.
+func (_u *TenantInitPluginUpdate) ClearDependencies() *TenantInitPluginUpdate {
+	_u.mutation.ClearDependencies()
+	return _u
+}
+
+// SetPriority sets the "priority" field.
+func (_u *TenantInitPluginUpdate) SetPriority(v int32) *TenantInitPluginUpdate {
+	_u.mutation.ResetPriority()
+	_u.mutation.SetPriority(v)
+	return _u
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (_u *TenantInitPluginUpdate) SetNillablePriority(v *int32) *TenantInitPluginUpdate {
+	if v != nil {
+		_u.SetPriority(*v)
+	}
+	return _u
+}
+
+// AddPriority adds value to the "priority" field.
+func (_u *TenantInitPluginUpdate) AddPriority(v int32) *TenantInitPluginUpdate {
+	_u.mutation.AddPriority(v)
+	return _u
+}
+
+// SetTimeout sets the "timeout" field.
+func (_u *TenantInitPluginUpdate) SetTimeout(v uint32) *TenantInitPluginUpdate {
+	_u.mutation.ResetTimeout()
+	_u.mutation.SetTimeout(v)
+	return _u
+}
+
+// SetNillableTimeout sets the "timeout" field if the given value is not nil.
+func (_u *TenantInitPluginUpdate) SetNillableTimeout(v *uint32) *TenantInitPluginUpdate {
+	if v != nil {
+		_u.SetTimeout(*v)
+	}
+	return _u
+}
+
+// AddTimeout adds value to the "timeout" field.
+func (_u *TenantInitPluginUpdate) AddTimeout(v int32) *TenantInitPluginUpdate {
+	_u.mutation.AddTimeout(v)
+	return _u
+}
+
+// SetSupportRollback sets the "support_rollback" field.
+func (_u *TenantInitPluginUpdate) SetSupportRollback(v bool) *TenantInitPluginUpdate {
+	_u.mutation.SetSupportRollback(v)
+	return _u
+}
+
+// SetNillableSupportRollback sets the "support_rollback" field if the given value is not nil.
+func (_u *TenantInitPluginUpdate) SetNillableSupportRollback(v *bool) *TenantInitPluginUpdate {
+	if v != nil {
+		_u.SetSupportRollback(*v)
+	}
+	return _u
+}
+
+// Mutation returns the TenantInitPluginMutation object of the builder.
+func (_u *TenantInitPluginUpdate) Mutation() *TenantInitPluginMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *TenantInitPluginUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TenantInitPluginUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *TenantInitPluginUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TenantInitPluginUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *TenantInitPluginUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := tenantinitplugin.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TenantInitPluginUpdate) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := tenantinitplugin.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "TenantInitPlugin.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Version(); ok {
+		if err := tenantinitplugin.VersionValidator(v); err != nil {
+			return &ValidationError{Name: "version", err: fmt.Errorf(`ent: validator failed for field "TenantInitPlugin.version": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Description(); ok {
+		if err := tenantinitplugin.DescriptionValidator(v); err != nil {
+			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "TenantInitPlugin.description": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Target(); ok {
+		if err := tenantinitplugin.TargetValidator(v); err != nil {
+			return &ValidationError{Name: "target", err: fmt.Errorf(`ent: validator failed for field "TenantInitPlugin.target": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *TenantInitPluginUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TenantInitPluginUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *TenantInitPluginUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(tenantinitplugin.Table, tenantinitplugin.Columns, sqlgraph.NewFieldSpec(tenantinitplugin.FieldID, field.TypeUint64))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(tenantinitplugin.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(tenantinitplugin.FieldStatus, field.TypeUint8, value)
+	}
+	if value, ok := _u.mutation.AddedStatus(); ok {
+		_spec.AddField(tenantinitplugin.FieldStatus, field.TypeUint8, value)
+	}
+	if _u.mutation.StatusCleared() {
+		_spec.ClearField(tenantinitplugin.FieldStatus, field.TypeUint8)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(tenantinitplugin.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Version(); ok {
+		_spec.SetField(tenantinitplugin.FieldVersion, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(tenantinitplugin.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(tenantinitplugin.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.Target(); ok {
+		_spec.SetField(tenantinitplugin.FieldTarget, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Dependencies(); ok {
+		_spec.SetField(tenantinitplugin.FieldDependencies, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedDependencies(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, tenantinitplugin.FieldDependencies, value)
+		})
+	}
+	if _u.mutation.DependenciesCleared() {
+		_spec.ClearField(tenantinitplugin.FieldDependencies, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Priority(); ok {
+		_spec.SetField(tenantinitplugin.FieldPriority, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.AddedPriority(); ok {
+		_spec.AddField(tenantinitplugin.FieldPriority, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.Timeout(); ok {
+		_spec.SetField(tenantinitplugin.FieldTimeout, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedTimeout(); ok {
+		_spec.AddField(tenantinitplugin.FieldTimeout, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.SupportRollback(); ok {
+		_spec.SetField(tenantinitplugin.FieldSupportRollback, field.TypeBool, value)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{tenantinitplugin.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// TenantInitPluginUpdateOne is the builder for updating a single TenantInitPlugin entity.
+type TenantInitPluginUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *TenantInitPluginMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *TenantInitPluginUpdateOne) SetUpdatedAt(v time.Time) *TenantInitPluginUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *TenantInitPluginUpdateOne) SetStatus(v uint8) *TenantInitPluginUpdateOne {
+	_u.mutation.ResetStatus()
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *TenantInitPluginUpdateOne) SetNillableStatus(v *uint8) *TenantInitPluginUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// AddStatus adds value to the "status" field.
+func (_u *TenantInitPluginUpdateOne) AddStatus(v int8) *TenantInitPluginUpdateOne {
+	_u.mutation.AddStatus(v)
+	return _u
+}
+
+// ClearStatus clears the value of the "status" field.
+func (_u *TenantInitPluginUpdateOne) ClearStatus() *TenantInitPluginUpdateOne {
+	_u.mutation.ClearStatus()
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *TenantInitPluginUpdateOne) SetName(v string) *TenantInitPluginUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *TenantInitPluginUpdateOne) SetNillableName(v *string) *TenantInitPluginUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetVersion sets the "version" field.
+func (_u *TenantInitPluginUpdateOne) SetVersion(v string) *TenantInitPluginUpdateOne {
+	_u.mutation.SetVersion(v)
+	return _u
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_u *TenantInitPluginUpdateOne) SetNillableVersion(v *string) *TenantInitPluginUpdateOne {
+	if v != nil {
+		_u.SetVersion(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *TenantInitPluginUpdateOne) SetDescription(v string) *TenantInitPluginUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *TenantInitPluginUpdateOne) SetNillableDescription(v *string) *TenantInitPluginUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *TenantInitPluginUpdateOne) ClearDescription() *TenantInitPluginUpdateOne {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetTarget sets the "target" field.
+func (_u *TenantInitPluginUpdateOne) SetTarget(v string) *TenantInitPluginUpdateOne {
+	_u.mutation.SetTarget(v)
+	return _u
+}
+
+// SetNillableTarget sets the "target" field if the given value is not nil.
+func (_u *TenantInitPluginUpdateOne) SetNillableTarget(v *string) *TenantInitPluginUpdateOne {
+	if v != nil {
+		_u.SetTarget(*v)
+	}
+	return _u
+}
+
+// SetDependencies sets the "dependencies" field.
+func (_u *TenantInitPluginUpdateOne) SetDependencies(v []string) *TenantInitPluginUpdateOne {
+	_u.mutation.SetDependencies(v)
+	return _u
+}
+
+// AppendDependencies appends value to the "dependencies" field.
+func (_u *TenantInitPluginUpdateOne) AppendDependencies(v []string) *TenantInitPluginUpdateOne {
+	_u.mutation.AppendDependencies(v)
+	return _u
+}
+
+// ClearDependencies clears the value of the "dependencies" field.
+func (_u *TenantInitPluginUpdateOne) ClearDependencies() *TenantInitPluginUpdateOne {
+	_u.mutation.ClearDependencies()
+	return _u
+}
+
+// SetPriority sets the "priority" field.
+func (_u *TenantInitPluginUpdateOne) SetPriority(v int32) *TenantInitPluginUpdateOne {
+	_u.mutation.ResetPriority()
+	_u.mutation.SetPriority(v)
+	return _u
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (_u *TenantInitPluginUpdateOne) SetNillablePriority(v *int32) *TenantInitPluginUpdateOne {
+	if v != nil {
+		_u.SetPriority(*v)
+	}
+	return _u
+}
+
+// AddPriority adds value to the "priority" field.
+func (_u *TenantInitPluginUpdateOne) AddPriority(v int32) *TenantInitPluginUpdateOne {
+	_u.mutation.AddPriority(v)
+	return _u
+}
+
+// SetTimeout sets the "timeout" field.
+func (_u *TenantInitPluginUpdateOne) SetTimeout(v uint32) *TenantInitPluginUpdateOne {
+	_u.mutation.ResetTimeout()
+	_u.mutation.SetTimeout(v)
+	return _u
+}
+
+// SetNillableTimeout sets the "timeout" field if the given value is not nil.
+func (_u *TenantInitPluginUpdateOne) SetNillableTimeout(v *uint32) *TenantInitPluginUpdateOne {
+	if v != nil {
+		_u.SetTimeout(*v)
+	}
+	return _u
+}
+
+// AddTimeout adds value to the "timeout" field.
+func (_u *TenantInitPluginUpdateOne) AddTimeout(v int32) *TenantInitPluginUpdateOne {
+	_u.mutation.AddTimeout(v)
+	return _u
+}
+
+// SetSupportRollback sets the "support_rollback" field.
+func (_u *TenantInitPluginUpdateOne) SetSupportRollback(v bool) *TenantInitPluginUpdateOne {
+	_u.mutation.SetSupportRollback(v)
+	return _u
+}
+
+// SetNillableSupportRollback sets the "support_rollback" field if the given value is not nil.
+func (_u *TenantInitPluginUpdateOne) SetNillableSupportRollback(v *bool) *TenantInitPluginUpdateOne {
+	if v != nil {
+		_u.SetSupportRollback(*v)
+	}
+	return _u
+}
+
+// Mutation returns the TenantInitPluginMutation object of the builder.
+func (_u *TenantInitPluginUpdateOne) Mutation() *TenantInitPluginMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the TenantInitPluginUpdate builder.
+func (_u *TenantInitPluginUpdateOne) Where(ps ...predicate.TenantInitPlugin) *TenantInitPluginUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *TenantInitPluginUpdateOne) Select(field string, fields ...string) *TenantInitPluginUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated TenantInitPlugin entity.
+func (_u *TenantInitPluginUpdateOne) Save(ctx context.Context) (*TenantInitPlugin, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TenantInitPluginUpdateOne) SaveX(ctx context.Context) *TenantInitPlugin {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *TenantInitPluginUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TenantInitPluginUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *TenantInitPluginUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := tenantinitplugin.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TenantInitPluginUpdateOne) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := tenantinitplugin.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "TenantInitPlugin.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Version(); ok {
+		if err := tenantinitplugin.VersionValidator(v); err != nil {
+			return &ValidationError{Name: "version", err: fmt.Errorf(`ent: validator failed for field "TenantInitPlugin.version": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Description(); ok {
+		if err := tenantinitplugin.DescriptionValidator(v); err != nil {
+			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "TenantInitPlugin.description": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Target(); ok {
+		if err := tenantinitplugin.TargetValidator(v); err != nil {
+			return &ValidationError{Name: "target", err: fmt.Errorf(`ent: validator failed for field "TenantInitPlugin.target": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *TenantInitPluginUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TenantInitPluginUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *TenantInitPluginUpdateOne) sqlSave(ctx context.Context) (_node *TenantInitPlugin, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(tenantinitplugin.Table, tenantinitplugin.Columns, sqlgraph.NewFieldSpec(tenantinitplugin.FieldID, field.TypeUint64))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TenantInitPlugin.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, tenantinitplugin.FieldID)
+		for _, f := range fields {
+			if !tenantinitplugin.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != tenantinitplugin.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(tenantinitplugin.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(tenantinitplugin.FieldStatus, field.TypeUint8, value)
+	}
+	if value, ok := _u.mutation.AddedStatus(); ok {
+		_spec.AddField(tenantinitplugin.FieldStatus, field.TypeUint8, value)
+	}
+	if _u.mutation.StatusCleared() {
+		_spec.ClearField(tenantinitplugin.FieldStatus, field.TypeUint8)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(tenantinitplugin.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Version(); ok {
+		_spec.SetField(tenantinitplugin.FieldVersion, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(tenantinitplugin.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(tenantinitplugin.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.Target(); ok {
+		_spec.SetField(tenantinitplugin.FieldTarget, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Dependencies(); ok {
+		_spec.SetField(tenantinitplugin.FieldDependencies, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedDependencies(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, tenantinitplugin.FieldDependencies, value)
+		})
+	}
+	if _u.mutation.DependenciesCleared() {
+		_spec.ClearField(tenantinitplugin.FieldDependencies, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Priority(); ok {
+		_spec.SetField(tenantinitplugin.FieldPriority, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.AddedPriority(); ok {
+		_spec.AddField(tenantinitplugin.FieldPriority, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.Timeout(); ok {
+		_spec.SetField(tenantinitplugin.FieldTimeout, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedTimeout(); ok {
+		_spec.AddField(tenantinitplugin.FieldTimeout, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.SupportRollback(); ok {
+		_spec.SetField(tenantinitplugin.FieldSupportRollback, field.TypeBool, value)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &TenantInitPlugin{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{tenantinitplugin.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
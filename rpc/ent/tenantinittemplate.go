@@ -0,0 +1,194 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/coder-lulu/newbee-core/rpc/ent/tenantinittemplate"
+)
+
+// Tenant Initialization Template Table | 租户初始化模板表
+type TenantInitTemplate struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uint64 `json:"id,omitempty"`
+	// Create Time | 创建日期
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Update Time | 修改日期
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Status 1: normal 2: ban | 状态 1 正常 2 禁用
+	Status uint8 `json:"status,omitempty"`
+	// The template's name, passed to tenant initialization | 模板名称，初始化租户时指定
+	Name string `json:"name,omitempty"`
+	// Template version | 模板版本
+	Version string `json:"version,omitempty"`
+	// Description | 模板描述
+	Description string `json:"description,omitempty"`
+	// The base template it overlays, empty means a standalone template | 基础模板名称，为空表示独立模板
+	BaseTemplate string `json:"base_template,omitempty"`
+	// Components as JSON | 模板组件 JSON
+	Components string `json:"components,omitempty"`
+	// Sort order | 排序
+	Sort         uint32 `json:"sort,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TenantInitTemplate) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case tenantinittemplate.FieldID, tenantinittemplate.FieldStatus, tenantinittemplate.FieldSort:
+			values[i] = new(sql.NullInt64)
+		case tenantinittemplate.FieldName, tenantinittemplate.FieldVersion, tenantinittemplate.FieldDescription, tenantinittemplate.FieldBaseTemplate, tenantinittemplate.FieldComponents:
+			values[i] = new(sql.NullString)
+		case tenantinittemplate.FieldCreatedAt, tenantinittemplate.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TenantInitTemplate fields.
+func (_m *TenantInitTemplate) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case tenantinittemplate.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = uint64(value.Int64)
+		case tenantinittemplate.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case tenantinittemplate.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case tenantinittemplate.FieldStatus:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = uint8(value.Int64)
+			}
+		case tenantinittemplate.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case tenantinittemplate.FieldVersion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
+			} else if value.Valid {
+				_m.Version = value.String
+			}
+		case tenantinittemplate.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				_m.Description = value.String
+			}
+		case tenantinittemplate.FieldBaseTemplate:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field base_template", values[i])
+			} else if value.Valid {
+				_m.BaseTemplate = value.String
+			}
+		case tenantinittemplate.FieldComponents:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field components", values[i])
+			} else if value.Valid {
+				_m.Components = value.String
+			}
+		case tenantinittemplate.FieldSort:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field sort", values[i])
+			} else if value.Valid {
+				_m.Sort = uint32(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TenantInitTemplate.
+// This includes values selected through modifiers, order, etc.
+func (_m *TenantInitTemplate) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this TenantInitTemplate.
+// Note that you need to call TenantInitTemplate.Unwrap() before calling this method if this TenantInitTemplate
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *TenantInitTemplate) Update() *TenantInitTemplateUpdateOne {
+	return NewTenantInitTemplateClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the TenantInitTemplate entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *TenantInitTemplate) Unwrap() *TenantInitTemplate {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: TenantInitTemplate is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *TenantInitTemplate) String() string {
+	var builder strings.Builder
+	builder.WriteString("TenantInitTemplate(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("version=")
+	builder.WriteString(_m.Version)
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(_m.Description)
+	builder.WriteString(", ")
+	builder.WriteString("base_template=")
+	builder.WriteString(_m.BaseTemplate)
+	builder.WriteString(", ")
+	builder.WriteString("components=")
+	builder.WriteString(_m.Components)
+	builder.WriteString(", ")
+	builder.WriteString("sort=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Sort))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TenantInitTemplates is a parsable slice of TenantInitTemplate.
+type TenantInitTemplates []*TenantInitTemplate
@@ -0,0 +1,630 @@
+// Code generated by ent, DO NOT EDIT.
+
+package tenantinittemplate
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/coder-lulu/newbee-core/rpc/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uint64) predicate.TenantInitTemplate {
+	return predicate.TenantInitTemplate(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uint64) predicate.TenantInitTemplate {
+	return predicate.TenantInitTemplate(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uint64) predicate.TenantInitTemplate {
+	return predicate.TenantInitTemplate(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uint64) predicate.TenantInitTemplate {
+	return predicate.TenantInitTemplate(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uint64) predicate.TenantInitTemplate {
+	return predicate.TenantInitTemplate(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uint64) predicate.TenantInitTemplate {
+	return predicate.TenantInitTemplate(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uint64) predicate.TenantInitTemplate {
+	return predicate.TenantInitTemplate(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uint64) predicate.TenantInitTemplate {
+	return predicate.TenantInitTemplate(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uint64) predicate.TenantInitTemplate {
+	return predicate.TenantInitTemplate(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.TenantInitTemplate {
+	return predicate.TenantInitTemplate(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.TenantInitTemplate {
+	return predicate.TenantInitTemplate(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v uint8) predicate.TenantInitTemplate {
+	return predicate.TenantInitTemplate(sql.FieldEQ(FieldStatus, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.TenantInitTemplate {
+	return predicate.TenantInitTemplate(sql.FieldEQ(FieldName, v))
+}
+
+// Version applies equality check predicate on the "version" field. It's identical to VersionEQ.
+func Version(v string) predicate.TenantInitTemplate {
+	return predicate.TenantInitTemplate(sql.FieldEQ(FieldVersion, v))
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.TenantInitTemplate {
+	return predicate.TenantInitTemplate(sql.FieldEQ(FieldDescription, v))
+}
+
+// BaseTemplate applies equality check predicate on the "base_template" field. It's identical to BaseTemplateEQ.
+func BaseTemplate(v string) predicate.TenantInitTemplate {
+	return predicate.TenantInitTemplate(sql.FieldEQ(FieldBaseTemplate, v))
+}
+
+// Components applies equality check predicate on the "components" field. It's identical to ComponentsEQ.
+func Components(v string) predicate.TenantInitTemplate {
+	return predicate.TenantInitTemplate(sql.FieldEQ(FieldComponents, v))
+}
+
+// Sort applies equality check predicate on the "sort" field. It's identical to SortEQ.
+func Sort(v uint32) predicate.TenantInitTemplate {
+	return predicate.TenantInitTemplate(sql.FieldEQ(FieldSort, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.TenantInitTemplate {
+	return predicate.TenantInitTemplate(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.TenantInitTemplate {
+	return predicate.TenantInitTemplate(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.TenantInitTemplate {
+	return predicate.TenantInitTemplate(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.TenantInitTemplate {
+	return predicate.TenantInitTemplate(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.TenantInitTemplate {
+	return predicate.TenantInitTemplate(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.TenantInitTemplate {
+	return predicate.TenantInitTemplate(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.TenantInitTemplate {
+	return predicate.TenantInitTemplate(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.TenantInitTemplate {
+	return predicate.TenantInitTemplate(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.TenantInitTemplate {
+	return predicate.TenantInitTemplate(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.TenantInitTemplate {
+	return predicate.TenantInitTemplate(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.TenantInitTemplate {
+	return predicate.TenantInitTemplate(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.TenantInitTemplate {
+	return predicate.TenantInitTemplate(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.TenantInitTemplate {
+	return predicate.TenantInitTemplate(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.TenantInitTemplate {
+	return predicate.TenantInitTemplate(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.TenantInitTemplate {
+	return predicate.TenantInitTemplate(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.TenantInitTemplate {
+	return predicate.TenantInitTemplate(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v uint8) predicate.TenantInitTemplate {
+	return predicate.TenantInitTemplate(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v uint8) predicate.TenantInitTemplate {
+	return predicate.TenantInitTemplate(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...uint8) predicate.TenantInitTemplate {
+	return predicate.TenantInitTemplate(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...uint8) predicate.TenantInitTemplate {
+	return predicate.TenantInitTemplate(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v uint8) predicate.TenantInitTemplate {
+	return predicate.TenantInitTemplate(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v uint8) predicate.TenantInitTemplate {
+	return predicate.TenantInitTemplate(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v uint8) predicate.TenantInitTemplate {
+	return predicate.TenantInitTemplate(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v uint8) predicate.TenantInitTemplate {
+	return predicate.TenantInitTemplate(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusIsNil applies the IsNil predicate on the "status" field.
+func StatusIsNil() predicate.TenantInitTemplate {
+	return predicate.TenantInitTemplate(sql.FieldIsNull(FieldStatus))
+}
+
+// StatusNotNil applies the NotNil predicate on the "status" field.
+func StatusNotNil() predicate.TenantInitTemplate {
+	return predicate.TenantInitTemplate(sql.FieldNotNull(FieldStatus))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.TenantInitTemplate {
+	return predicate.TenantInitTemplate(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.TenantInitTemplate {
+	return predicate.TenantInitTemplate(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.TenantInitTemplate {
+	return predicate.TenantInitTemplate(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.TenantInitTemplate {
+	return predicate.TenantInitTemplate(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.TenantInitTemplate {
+	return predicate.TenantInitTemplate(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.TenantInitTemplate {
+	return predicate.TenantInitTemplate(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.TenantInitTemplate {
+	return predicate.TenantInitTemplate(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.TenantInitTemplate {
+	return predicate.TenantInitTemplate(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.TenantInitTemplate {
+	return predicate.TenantInitTemplate(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.TenantInitTemplate {
+	return predicate.TenantInitTemplate(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.TenantInitTemplate {
+	return predicate.TenantInitTemplate(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.TenantInitTemplate {
+	return predicate.TenantInitTemplate(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.TenantInitTemplate {
+	return predicate.TenantInitTemplate(sql.FieldContainsFold(FieldName, v))
+}
+
+// VersionEQ applies the EQ predicate on the "version" field.
+func VersionEQ(v string) predicate.TenantInitTemplate {
+	return predicate.TenantInitTemplate(sql.FieldEQ(FieldVersion, v))
+}
+
+// VersionNEQ applies the NEQ predicate on the "version" field.
+func VersionNEQ(v string) predicate.TenantInitTemplate {
+	return predicate.TenantInitTemplate(sql.FieldNEQ(FieldVersion, v))
+}
+
+// VersionIn applies the In predicate on the "version" field.
+func VersionIn(vs ...string) predicate.TenantInitTemplate {
+	return predicate.TenantInitTemplate(sql.FieldIn(FieldVersion, vs...))
+}
+
+// VersionNotIn applies the NotIn predicate on the "version" field.
+func VersionNotIn(vs ...string) predicate.TenantInitTemplate {
+	return predicate.TenantInitTemplate(sql.FieldNotIn(FieldVersion, vs...))
+}
+
+// VersionGT applies the GT predicate on the "version" field.
+func VersionGT(v string) predicate.TenantInitTemplate {
+	return predicate.TenantInitTemplate(sql.FieldGT(FieldVersion, v))
+}
+
+// VersionGTE applies the GTE predicate on the "version" field.
+func VersionGTE(v string) predicate.TenantInitTemplate {
+	return predicate.TenantInitTemplate(sql.FieldGTE(FieldVersion, v))
+}
+
+// VersionLT applies the LT predicate on the "version" field.
+func VersionLT(v string) predicate.TenantInitTemplate {
+	return predicate.TenantInitTemplate(sql.FieldLT(FieldVersion, v))
+}
+
+// VersionLTE applies the LTE predicate on the "version" field.
+func VersionLTE(v string) predicate.TenantInitTemplate {
+	return predicate.TenantInitTemplate(sql.FieldLTE(FieldVersion, v))
+}
+
+// VersionContains applies the Contains predicate on the "version" field.
+func VersionContains(v string) predicate.TenantInitTemplate {
+	return predicate.TenantInitTemplate(sql.FieldContains(FieldVersion, v))
+}
+
+// VersionHasPrefix applies the HasPrefix predicate on the "version" field.
+func VersionHasPrefix(v string) predicate.TenantInitTemplate {
+	return predicate.TenantInitTemplate(sql.FieldHasPrefix(FieldVersion, v))
+}
+
+// VersionHasSuffix applies the HasSuffix predicate on the "version" field.
+func VersionHasSuffix(v string) predicate.TenantInitTemplate {
+	return predicate.TenantInitTemplate(sql.FieldHasSuffix(FieldVersion, v))
+}
+
+// VersionEqualFold applies the EqualFold predicate on the "version" field.
+func VersionEqualFold(v string) predicate.TenantInitTemplate {
+	return predicate.TenantInitTemplate(sql.FieldEqualFold(FieldVersion, v))
+}
+
+// VersionContainsFold applies the ContainsFold predicate on the "version" field.
+func VersionContainsFold(v string) predicate.TenantInitTemplate {
+	return predicate.TenantInitTemplate(sql.FieldContainsFold(FieldVersion, v))
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.TenantInitTemplate {
+	return predicate.TenantInitTemplate(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.TenantInitTemplate {
+	return predicate.TenantInitTemplate(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.TenantInitTemplate {
+	return predicate.TenantInitTemplate(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.TenantInitTemplate {
+	return predicate.TenantInitTemplate(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.TenantInitTemplate {
+	return predicate.TenantInitTemplate(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.TenantInitTemplate {
+	return predicate.TenantInitTemplate(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.TenantInitTemplate {
+	return predicate.TenantInitTemplate(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.TenantInitTemplate {
+	return predicate.TenantInitTemplate(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.TenantInitTemplate {
+	return predicate.TenantInitTemplate(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.TenantInitTemplate {
+	return predicate.TenantInitTemplate(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.TenantInitTemplate {
+	return predicate.TenantInitTemplate(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionIsNil applies the IsNil predicate on the "description" field.
+func DescriptionIsNil() predicate.TenantInitTemplate {
+	return predicate.TenantInitTemplate(sql.FieldIsNull(FieldDescription))
+}
+
+// DescriptionNotNil applies the NotNil predicate on the "description" field.
+func DescriptionNotNil() predicate.TenantInitTemplate {
+	return predicate.TenantInitTemplate(sql.FieldNotNull(FieldDescription))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.TenantInitTemplate {
+	return predicate.TenantInitTemplate(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.TenantInitTemplate {
+	return predicate.TenantInitTemplate(sql.FieldContainsFold(FieldDescription, v))
+}
+
+// BaseTemplateEQ applies the EQ predicate on the "base_template" field.
+func BaseTemplateEQ(v string) predicate.TenantInitTemplate {
+	return predicate.TenantInitTemplate(sql.FieldEQ(FieldBaseTemplate, v))
+}
+
+// BaseTemplateNEQ applies the NEQ predicate on the "base_template" field.
+func BaseTemplateNEQ(v string) predicate.TenantInitTemplate {
+	return predicate.TenantInitTemplate(sql.FieldNEQ(FieldBaseTemplate, v))
+}
+
+// BaseTemplateIn applies the In predicate on the "base_template" field.
+func BaseTemplateIn(vs ...string) predicate.TenantInitTemplate {
+	return predicate.TenantInitTemplate(sql.FieldIn(FieldBaseTemplate, vs...))
+}
+
+// BaseTemplateNotIn applies the NotIn predicate on the "base_template" field.
+func BaseTemplateNotIn(vs ...string) predicate.TenantInitTemplate {
+	return predicate.TenantInitTemplate(sql.FieldNotIn(FieldBaseTemplate, vs...))
+}
+
+// BaseTemplateGT applies the GT predicate on the "base_template" field.
+func BaseTemplateGT(v string) predicate.TenantInitTemplate {
+	return predicate.TenantInitTemplate(sql.FieldGT(FieldBaseTemplate, v))
+}
+
+// BaseTemplateGTE applies the GTE predicate on the "base_template" field.
+func BaseTemplateGTE(v string) predicate.TenantInitTemplate {
+	return predicate.TenantInitTemplate(sql.FieldGTE(FieldBaseTemplate, v))
+}
+
+// BaseTemplateLT applies the LT predicate on the "base_template" field.
+func BaseTemplateLT(v string) predicate.TenantInitTemplate {
+	return predicate.TenantInitTemplate(sql.FieldLT(FieldBaseTemplate, v))
+}
+
+// BaseTemplateLTE applies the LTE predicate on the "base_template" field.
+func BaseTemplateLTE(v string) predicate.TenantInitTemplate {
+	return predicate.TenantInitTemplate(sql.FieldLTE(FieldBaseTemplate, v))
+}
+
+// BaseTemplateContains applies the Contains predicate on the "base_template" field.
+func BaseTemplateContains(v string) predicate.TenantInitTemplate {
+	return predicate.TenantInitTemplate(sql.FieldContains(FieldBaseTemplate, v))
+}
+
+// BaseTemplateHasPrefix applies the HasPrefix predicate on the "base_template" field.
+func BaseTemplateHasPrefix(v string) predicate.TenantInitTemplate {
+	return predicate.TenantInitTemplate(sql.FieldHasPrefix(FieldBaseTemplate, v))
+}
+
+// BaseTemplateHasSuffix applies the HasSuffix predicate on the "base_template" field.
+func BaseTemplateHasSuffix(v string) predicate.TenantInitTemplate {
+	return predicate.TenantInitTemplate(sql.FieldHasSuffix(FieldBaseTemplate, v))
+}
+
+// BaseTemplateIsNil applies the IsNil predicate on the "base_template" field.
+func BaseTemplateIsNil() predicate.TenantInitTemplate {
+	return predicate.TenantInitTemplate(sql.FieldIsNull(FieldBaseTemplate))
+}
+
+// BaseTemplateNotNil applies the NotNil predicate on the "base_template" field.
+func BaseTemplateNotNil() predicate.TenantInitTemplate {
+	return predicate.TenantInitTemplate(sql.FieldNotNull(FieldBaseTemplate))
+}
+
+// BaseTemplateEqualFold applies the EqualFold predicate on the "base_template" field.
+func BaseTemplateEqualFold(v string) predicate.TenantInitTemplate {
+	return predicate.TenantInitTemplate(sql.FieldEqualFold(FieldBaseTemplate, v))
+}
+
+// BaseTemplateContainsFold applies the ContainsFold predicate on the "base_template" field.
+func BaseTemplateContainsFold(v string) predicate.TenantInitTemplate {
+	return predicate.TenantInitTemplate(sql.FieldContainsFold(FieldBaseTemplate, v))
+}
+
+// ComponentsEQ applies the EQ predicate on the "components" field.
+func ComponentsEQ(v string) predicate.TenantInitTemplate {
+	return predicate.TenantInitTemplate(sql.FieldEQ(FieldComponents, v))
+}
+
+// ComponentsNEQ applies the NEQ predicate on the "components" field.
+func ComponentsNEQ(v string) predicate.TenantInitTemplate {
+	return predicate.TenantInitTemplate(sql.FieldNEQ(FieldComponents, v))
+}
+
+// ComponentsIn applies the In predicate on the "components" field.
+func ComponentsIn(vs ...string) predicate.TenantInitTemplate {
+	return predicate.TenantInitTemplate(sql.FieldIn(FieldComponents, vs...))
+}
+
+// ComponentsNotIn applies the NotIn predicate on the "components" field.
+func ComponentsNotIn(vs ...string) predicate.TenantInitTemplate {
+	return predicate.TenantInitTemplate(sql.FieldNotIn(FieldComponents, vs...))
+}
+
+// ComponentsGT applies the GT predicate on the "components" field.
+func ComponentsGT(v string) predicate.TenantInitTemplate {
+	return predicate.TenantInitTemplate(sql.FieldGT(FieldComponents, v))
+}
+
+// ComponentsGTE applies the GTE predicate on the "components" field.
+func ComponentsGTE(v string) predicate.TenantInitTemplate {
+	return predicate.TenantInitTemplate(sql.FieldGTE(FieldComponents, v))
+}
+
+// ComponentsLT applies the LT predicate on the "components" field.
+func ComponentsLT(v string) predicate.TenantInitTemplate {
+	return predicate.TenantInitTemplate(sql.FieldLT(FieldComponents, v))
+}
+
+// ComponentsLTE applies the LTE predicate on the "components" field.
+func ComponentsLTE(v string) predicate.TenantInitTemplate {
+	return predicate.TenantInitTemplate(sql.FieldLTE(FieldComponents, v))
+}
+
+// ComponentsContains applies the Contains predicate on the "components" field.
+func ComponentsContains(v string) predicate.TenantInitTemplate {
+	return predicate.TenantInitTemplate(sql.FieldContains(FieldComponents, v))
+}
+
+// ComponentsHasPrefix applies the HasPrefix predicate on the "components" field.
+func ComponentsHasPrefix(v string) predicate.TenantInitTemplate {
+	return predicate.TenantInitTemplate(sql.FieldHasPrefix(FieldComponents, v))
+}
+
+// ComponentsHasSuffix applies the HasSuffix predicate on the "components" field.
+func ComponentsHasSuffix(v string) predicate.TenantInitTemplate {
+	return predicate.TenantInitTemplate(sql.FieldHasSuffix(FieldComponents, v))
+}
+
+// ComponentsEqualFold applies the EqualFold predicate on the "components" field.
+func ComponentsEqualFold(v string) predicate.TenantInitTemplate {
+	return predicate.TenantInitTemplate(sql.FieldEqualFold(FieldComponents, v))
+}
+
+// ComponentsContainsFold applies the ContainsFold predicate on the "components" field.
+func ComponentsContainsFold(v string) predicate.TenantInitTemplate {
+	return predicate.TenantInitTemplate(sql.FieldContainsFold(FieldComponents, v))
+}
+
+// SortEQ applies the EQ predicate on the "sort" field.
+func SortEQ(v uint32) predicate.TenantInitTemplate {
+	return predicate.TenantInitTemplate(sql.FieldEQ(FieldSort, v))
+}
+
+// SortNEQ applies the NEQ predicate on the "sort" field.
+func SortNEQ(v uint32) predicate.TenantInitTemplate {
+	return predicate.TenantInitTemplate(sql.FieldNEQ(FieldSort, v))
+}
+
+// SortIn applies the In predicate on the "sort" field.
+func SortIn(vs ...uint32) predicate.TenantInitTemplate {
+	return predicate.TenantInitTemplate(sql.FieldIn(FieldSort, vs...))
+}
+
+// SortNotIn applies the NotIn predicate on the "sort" field.
+func SortNotIn(vs ...uint32) predicate.TenantInitTemplate {
+	return predicate.TenantInitTemplate(sql.FieldNotIn(FieldSort, vs...))
+}
+
+// SortGT applies the GT predicate on the "sort" field.
+func SortGT(v uint32) predicate.TenantInitTemplate {
+	return predicate.TenantInitTemplate(sql.FieldGT(FieldSort, v))
+}
+
+// SortGTE applies the GTE predicate on the "sort" field.
+func SortGTE(v uint32) predicate.TenantInitTemplate {
+	return predicate.TenantInitTemplate(sql.FieldGTE(FieldSort, v))
+}
+
+// SortLT applies the LT predicate on the "sort" field.
+func SortLT(v uint32) predicate.TenantInitTemplate {
+	return predicate.TenantInitTemplate(sql.FieldLT(FieldSort, v))
+}
+
+// SortLTE applies the LTE predicate on the "sort" field.
+func SortLTE(v uint32) predicate.TenantInitTemplate {
+	return predicate.TenantInitTemplate(sql.FieldLTE(FieldSort, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.TenantInitTemplate) predicate.TenantInitTemplate {
+	return predicate.TenantInitTemplate(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.TenantInitTemplate) predicate.TenantInitTemplate {
+	return predicate.TenantInitTemplate(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.TenantInitTemplate) predicate.TenantInitTemplate {
+	return predicate.TenantInitTemplate(sql.NotPredicates(p))
+}
@@ -0,0 +1,393 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/coder-lulu/newbee-core/rpc/ent/tenantinittemplate"
+)
+
+// TenantInitTemplateCreate is the builder for creating a TenantInitTemplate entity.
+type TenantInitTemplateCreate struct {
+	config
+	mutation *TenantInitTemplateMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *TenantInitTemplateCreate) SetCreatedAt(v time.Time) *TenantInitTemplateCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *TenantInitTemplateCreate) SetNillableCreatedAt(v *time.Time) *TenantInitTemplateCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *TenantInitTemplateCreate) SetUpdatedAt(v time.Time) *TenantInitTemplateCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *TenantInitTemplateCreate) SetNillableUpdatedAt(v *time.Time) *TenantInitTemplateCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *TenantInitTemplateCreate) SetStatus(v uint8) *TenantInitTemplateCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *TenantInitTemplateCreate) SetNillableStatus(v *uint8) *TenantInitTemplateCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *TenantInitTemplateCreate) SetName(v string) *TenantInitTemplateCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetVersion sets the "version" field.
+func (_c *TenantInitTemplateCreate) SetVersion(v string) *TenantInitTemplateCreate {
+	_c.mutation.SetVersion(v)
+	return _c
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_c *TenantInitTemplateCreate) SetNillableVersion(v *string) *TenantInitTemplateCreate {
+	if v != nil {
+		_c.SetVersion(*v)
+	}
+	return _c
+}
+
+// SetDescription sets the "description" field.
+func (_c *TenantInitTemplateCreate) SetDescription(v string) *TenantInitTemplateCreate {
+	_c.mutation.SetDescription(v)
+	return _c
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_c *TenantInitTemplateCreate) SetNillableDescription(v *string) *TenantInitTemplateCreate {
+	if v != nil {
+		_c.SetDescription(*v)
+	}
+	return _c
+}
+
+// SetBaseTemplate sets the "base_template" field.
+func (_c *TenantInitTemplateCreate) SetBaseTemplate(v string) *TenantInitTemplateCreate {
+	_c.mutation.SetBaseTemplate(v)
+	return _c
+}
+
+// SetNillableBaseTemplate sets the "base_template" field if the given value is not nil.
+func (_c *TenantInitTemplateCreate) SetNillableBaseTemplate(v *string) *TenantInitTemplateCreate {
+	if v != nil {
+		_c.SetBaseTemplate(*v)
+	}
+	return _c
+}
+
+// SetComponents sets the "components" field.
+func (_c *TenantInitTemplateCreate) SetComponents(v string) *TenantInitTemplateCreate {
+	_c.mutation.SetComponents(v)
+	return _c
+}
+
+// SetSort sets the "sort" field.
+func (_c *TenantInitTemplateCreate) SetSort(v uint32) *TenantInitTemplateCreate {
+	_c.mutation.SetSort(v)
+	return _c
+}
+
+// SetNillableSort sets the "sort" field if the given value is not nil.
+func (_c *TenantInitTemplateCreate) SetNillableSort(v *uint32) *TenantInitTemplateCreate {
+	if v != nil {
+		_c.SetSort(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *TenantInitTemplateCreate) SetID(v uint64) *TenantInitTemplateCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the TenantInitTemplateMutation object of the builder.
+func (_c *TenantInitTemplateCreate) Mutation() *TenantInitTemplateMutation {
+	return _c.mutation
+}
+
+// Save creates the TenantInitTemplate in the database.
+func (_c *TenantInitTemplateCreate) Save(ctx context.Context) (*TenantInitTemplate, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *TenantInitTemplateCreate) SaveX(ctx context.Context) *TenantInitTemplate {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TenantInitTemplateCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TenantInitTemplateCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *TenantInitTemplateCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := tenantinittemplate.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := tenantinittemplate.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := tenantinittemplate.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.Version(); !ok {
+		v := tenantinittemplate.DefaultVersion
+		_c.mutation.SetVersion(v)
+	}
+	if _, ok := _c.mutation.Sort(); !ok {
+		v := tenantinittemplate.DefaultSort
+		_c.mutation.SetSort(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *TenantInitTemplateCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "TenantInitTemplate.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "TenantInitTemplate.updated_at"`)}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "TenantInitTemplate.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := tenantinittemplate.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "TenantInitTemplate.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`ent: missing required field "TenantInitTemplate.version"`)}
+	}
+	if v, ok := _c.mutation.Version(); ok {
+		if err := tenantinittemplate.VersionValidator(v); err != nil {
+			return &ValidationError{Name: "version", err: fmt.Errorf(`ent: validator failed for field "TenantInitTemplate.version": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Description(); ok {
+		if err := tenantinittemplate.DescriptionValidator(v); err != nil {
+			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "TenantInitTemplate.description": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.BaseTemplate(); ok {
+		if err := tenantinittemplate.BaseTemplateValidator(v); err != nil {
+			return &ValidationError{Name: "base_template", err: fmt.Errorf(`ent: validator failed for field "TenantInitTemplate.base_template": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Components(); !ok {
+		return &ValidationError{Name: "components", err: errors.New(`ent: missing required field "TenantInitTemplate.components"`)}
+	}
+	if _, ok := _c.mutation.Sort(); !ok {
+		return &ValidationError{Name: "sort", err: errors.New(`ent: missing required field "TenantInitTemplate.sort"`)}
+	}
+	return nil
+}
+
+func (_c *TenantInitTemplateCreate) sqlSave(ctx context.Context) (*TenantInitTemplate, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint64(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *TenantInitTemplateCreate) createSpec() (*TenantInitTemplate, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TenantInitTemplate{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(tenantinittemplate.Table, sqlgraph.NewFieldSpec(tenantinittemplate.FieldID, field.TypeUint64))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(tenantinittemplate.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(tenantinittemplate.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(tenantinittemplate.FieldStatus, field.TypeUint8, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(tenantinittemplate.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Version(); ok {
+		_spec.SetField(tenantinittemplate.FieldVersion, field.TypeString, value)
+		_node.Version = value
+	}
+	if value, ok := _c.mutation.Description(); ok {
+		_spec.SetField(tenantinittemplate.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := _c.mutation.BaseTemplate(); ok {
+		_spec.SetField(tenantinittemplate.FieldBaseTemplate, field.TypeString, value)
+		_node.BaseTemplate = value
+	}
+	if value, ok := _c.mutation.Components(); ok {
+		_spec.SetField(tenantinittemplate.FieldComponents, field.TypeString, value)
+		_node.Components = value
+	}
+	if value, ok := _c.mutation.Sort(); ok {
+		_spec.SetField(tenantinittemplate.FieldSort, field.TypeUint32, value)
+		_node.Sort = value
+	}
+	return _node, _spec
+}
+
+// TenantInitTemplateCreateBulk is the builder for creating many TenantInitTemplate entities in bulk.
+type TenantInitTemplateCreateBulk struct {
+	config
+	err      error
+	builders []*TenantInitTemplateCreate
+}
+
+// Save creates the TenantInitTemplate entities in the database.
+func (_c *TenantInitTemplateCreateBulk) Save(ctx context.Context) ([]*TenantInitTemplate, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*TenantInitTemplate, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TenantInitTemplateMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *TenantInitTemplateCreateBulk) SaveX(ctx context.Context) []*TenantInitTemplate {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TenantInitTemplateCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TenantInitTemplateCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,616 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/coder-lulu/newbee-core/rpc/ent/predicate"
+	"github.com/coder-lulu/newbee-core/rpc/ent/tenantinittemplate"
+)
+
+// TenantInitTemplateUpdate is the builder for updating TenantInitTemplate entities.
+type TenantInitTemplateUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *TenantInitTemplateMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the TenantInitTemplateUpdate builder.
+func (_u *TenantInitTemplateUpdate) Where(ps ...predicate.TenantInitTemplate) *TenantInitTemplateUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *TenantInitTemplateUpdate) SetUpdatedAt(v time.Time) *TenantInitTemplateUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *TenantInitTemplateUpdate) SetStatus(v uint8) *TenantInitTemplateUpdate {
+	_u.mutation.ResetStatus()
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *TenantInitTemplateUpdate) SetNillableStatus(v *uint8) *TenantInitTemplateUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// AddStatus adds value to the "status" field.
+func (_u *TenantInitTemplateUpdate) AddStatus(v int8) *TenantInitTemplateUpdate {
+	_u.mutation.AddStatus(v)
+	return _u
+}
+
+// ClearStatus clears the value of the "status" field.
+func (_u *TenantInitTemplateUpdate) ClearStatus() *TenantInitTemplateUpdate {
+	_u.mutation.ClearStatus()
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *TenantInitTemplateUpdate) SetName(v string) *TenantInitTemplateUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *TenantInitTemplateUpdate) SetNillableName(v *string) *TenantInitTemplateUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetVersion sets the "version" field.
+func (_u *TenantInitTemplateUpdate) SetVersion(v string) *TenantInitTemplateUpdate {
+	_u.mutation.SetVersion(v)
+	return _u
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_u *TenantInitTemplateUpdate) SetNillableVersion(v *string) *TenantInitTemplateUpdate {
+	if v != nil {
+		_u.SetVersion(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *TenantInitTemplateUpdate) SetDescription(v string) *TenantInitTemplateUpdate {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *TenantInitTemplateUpdate) SetNillableDescription(v *string) *TenantInitTemplateUpdate {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *TenantInitTemplateUpdate) ClearDescription() *TenantInitTemplateUpdate {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetBaseTemplate sets the "base_template" field.
+func (_u *TenantInitTemplateUpdate) SetBaseTemplate(v string) *TenantInitTemplateUpdate {
+	_u.mutation.SetBaseTemplate(v)
+	return _u
+}
+
+// SetNillableBaseTemplate sets the "base_template" field if the given value is not nil.
+func (_u *TenantInitTemplateUpdate) SetNillableBaseTemplate(v *string) *TenantInitTemplateUpdate {
+	if v != nil {
+		_u.SetBaseTemplate(*v)
+	}
+	return _u
+}
+
+// ClearBaseTemplate clears the value of the "base_template" field.
+func (_u *TenantInitTemplateUpdate) ClearBaseTemplate() *TenantInitTemplateUpdate {
+	_u.mutation.ClearBaseTemplate()
+	return _u
+}
+
+// SetComponents sets the "components" field.
+func (_u *TenantInitTemplateUpdate) SetComponents(v string) *TenantInitTemplateUpdate {
+	_u.mutation.SetComponents(v)
+	return _u
+}
+
+// SetNillableComponents sets the "components" field if the given value is not nil.
+func (_u *TenantInitTemplateUpdate) SetNillableComponents(v *string) *TenantInitTemplateUpdate {
+	if v != nil {
+		_u.SetComponents(*v)
+	}
+	return _u
+}
+
+// SetSort sets the "sort" field.
+func (_u *TenantInitTemplateUpdate) SetSort(v uint32) *TenantInitTemplateUpdate {
+	_u.mutation.ResetSort()
+	_u.mutation.SetSort(v)
+	return _u
+}
+
+// SetNillableSort sets the "sort" field if the given value is not nil.
+func (_u *TenantInitTemplateUpdate) SetNillableSort(v *uint32) *TenantInitTemplateUpdate {
+	if v != nil {
+		_u.SetSort(*v)
+	}
+	return _u
+}
+
+// AddSort adds value to the "sort" field.
+func (_u *TenantInitTemplateUpdate) AddSort(v int32) *TenantInitTemplateUpdate {
+	_u.mutation.AddSort(v)
+	return _u
+}
+
+// Mutation returns the TenantInitTemplateMutation object of the builder.
+func (_u *TenantInitTemplateUpdate) Mutation() *TenantInitTemplateMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *TenantInitTemplateUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TenantInitTemplateUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *TenantInitTemplateUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TenantInitTemplateUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *TenantInitTemplateUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := tenantinittemplate.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TenantInitTemplateUpdate) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := tenantinittemplate.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "TenantInitTemplate.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Version(); ok {
+		if err := tenantinittemplate.VersionValidator(v); err != nil {
+			return &ValidationError{Name: "version", err: fmt.Errorf(`ent: validator failed for field "TenantInitTemplate.version": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Description(); ok {
+		if err := tenantinittemplate.DescriptionValidator(v); err != nil {
+			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "TenantInitTemplate.description": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.BaseTemplate(); ok {
+		if err := tenantinittemplate.BaseTemplateValidator(v); err != nil {
+			return &ValidationError{Name: "base_template", err: fmt.Errorf(`ent: validator failed for field "TenantInitTemplate.base_template": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *TenantInitTemplateUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TenantInitTemplateUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *TenantInitTemplateUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(tenantinittemplate.Table, tenantinittemplate.Columns, sqlgraph.NewFieldSpec(tenantinittemplate.FieldID, field.TypeUint64))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(tenantinittemplate.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(tenantinittemplate.FieldStatus, field.TypeUint8, value)
+	}
+	if value, ok := _u.mutation.AddedStatus(); ok {
+		_spec.AddField(tenantinittemplate.FieldStatus, field.TypeUint8, value)
+	}
+	if _u.mutation.StatusCleared() {
+		_spec.ClearField(tenantinittemplate.FieldStatus, field.TypeUint8)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(tenantinittemplate.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Version(); ok {
+		_spec.SetField(tenantinittemplate.FieldVersion, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(tenantinittemplate.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(tenantinittemplate.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.BaseTemplate(); ok {
+		_spec.SetField(tenantinittemplate.FieldBaseTemplate, field.TypeString, value)
+	}
+	if _u.mutation.BaseTemplateCleared() {
+		_spec.ClearField(tenantinittemplate.FieldBaseTemplate, field.TypeString)
+	}
+	if value, ok := _u.mutation.Components(); ok {
+		_spec.SetField(tenantinittemplate.FieldComponents, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Sort(); ok {
+		_spec.SetField(tenantinittemplate.FieldSort, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedSort(); ok {
+		_spec.AddField(tenantinittemplate.FieldSort, field.TypeUint32, value)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{tenantinittemplate.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// TenantInitTemplateUpdateOne is the builder for updating a single TenantInitTemplate entity.
+type TenantInitTemplateUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *TenantInitTemplateMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *TenantInitTemplateUpdateOne) SetUpdatedAt(v time.Time) *TenantInitTemplateUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *TenantInitTemplateUpdateOne) SetStatus(v uint8) *TenantInitTemplateUpdateOne {
+	_u.mutation.ResetStatus()
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *TenantInitTemplateUpdateOne) SetNillableStatus(v *uint8) *TenantInitTemplateUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// AddStatus adds value to the "status" field.
+func (_u *TenantInitTemplateUpdateOne) AddStatus(v int8) *TenantInitTemplateUpdateOne {
+	_u.mutation.AddStatus(v)
+	return _u
+}
+
+// ClearStatus clears the value of the "status" field.
+func (_u *TenantInitTemplateUpdateOne) ClearStatus() *TenantInitTemplateUpdateOne {
+	_u.mutation.ClearStatus()
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *TenantInitTemplateUpdateOne) SetName(v string) *TenantInitTemplateUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *TenantInitTemplateUpdateOne) SetNillableName(v *string) *TenantInitTemplateUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetVersion sets the "version" field.
+func (_u *TenantInitTemplateUpdateOne) SetVersion(v string) *TenantInitTemplateUpdateOne {
+	_u.mutation.SetVersion(v)
+	return _u
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_u *TenantInitTemplateUpdateOne) SetNillableVersion(v *string) *TenantInitTemplateUpdateOne {
+	if v != nil {
+		_u.SetVersion(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *TenantInitTemplateUpdateOne) SetDescription(v string) *TenantInitTemplateUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *TenantInitTemplateUpdateOne) SetNillableDescription(v *string) *TenantInitTemplateUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *TenantInitTemplateUpdateOne) ClearDescription() *TenantInitTemplateUpdateOne {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetBaseTemplate sets the "base_template" field.
+func (_u *TenantInitTemplateUpdateOne) SetBaseTemplate(v string) *TenantInitTemplateUpdateOne {
+	_u.mutation.SetBaseTemplate(v)
+	return _u
+}
+
+// SetNillableBaseTemplate sets the "base_template" field if the given value is not nil.
+func (_u *TenantInitTemplateUpdateOne) SetNillableBaseTemplate(v *string) *TenantInitTemplateUpdateOne {
+	if v != nil {
+		_u.SetBaseTemplate(*v)
+	}
+	return _u
+}
+
+// ClearBaseTemplate clears the value of the "base_template" field.
+func (_u *TenantInitTemplateUpdateOne) ClearBaseTemplate() *TenantInitTemplateUpdateOne {
+	_u.mutation.ClearBaseTemplate()
+	return _u
+}
+
+// SetComponents sets the "components" field.
+func (_u *TenantInitTemplateUpdateOne) SetComponents(v string) *TenantInitTemplateUpdateOne {
+	_u.mutation.SetComponents(v)
+	return _u
+}
+
+// SetNillableComponents sets the "components" field if the given value is not nil.
+func (_u *TenantInitTemplateUpdateOne) SetNillableComponents(v *string) *TenantInitTemplateUpdateOne {
+	if v != nil {
+		_u.SetComponents(*v)
+	}
+	return _u
+}
+
+// SetSort sets the "sort" field.
+func (_u *TenantInitTemplateUpdateOne) SetSort(v uint32) *TenantInitTemplateUpdateOne {
+	_u.mutation.ResetSort()
+	_u.mutation.SetSort(v)
+	return _u
+}
+
+// SetNillableSort sets the "sort" field if the given value is not nil.
+func (_u *TenantInitTemplateUpdateOne) SetNillableSort(v *uint32) *TenantInitTemplateUpdateOne {
+	if v != nil {
+		_u.SetSort(*v)
+	}
+	return _u
+}
+
+// AddSort adds value to the "sort" field.
+func (_u *TenantInitTemplateUpdateOne) AddSort(v int32) *TenantInitTemplateUpdateOne {
+	_u.mutation.AddSort(v)
+	return _u
+}
+
+// Mutation returns the TenantInitTemplateMutation object of the builder.
+func (_u *TenantInitTemplateUpdateOne) Mutation() *TenantInitTemplateMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the TenantInitTemplateUpdate builder.
+func (_u *TenantInitTemplateUpdateOne) Where(ps ...predicate.TenantInitTemplate) *TenantInitTemplateUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *TenantInitTemplateUpdateOne) Select(field string, fields ...string) *TenantInitTemplateUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated TenantInitTemplate entity.
+func (_u *TenantInitTemplateUpdateOne) Save(ctx context.Context) (*TenantInitTemplate, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TenantInitTemplateUpdateOne) SaveX(ctx context.Context) *TenantInitTemplate {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *TenantInitTemplateUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TenantInitTemplateUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *TenantInitTemplateUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := tenantinittemplate.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TenantInitTemplateUpdateOne) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := tenantinittemplate.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "TenantInitTemplate.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Version(); ok {
+		if err := tenantinittemplate.VersionValidator(v); err != nil {
+			return &ValidationError{Name: "version", err: fmt.Errorf(`ent: validator failed for field "TenantInitTemplate.version": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Description(); ok {
+		if err := tenantinittemplate.DescriptionValidator(v); err != nil {
+			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "TenantInitTemplate.description": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.BaseTemplate(); ok {
+		if err := tenantinittemplate.BaseTemplateValidator(v); err != nil {
+			return &ValidationError{Name: "base_template", err: fmt.Errorf(`ent: validator failed for field "TenantInitTemplate.base_template": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *TenantInitTemplateUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TenantInitTemplateUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *TenantInitTemplateUpdateOne) sqlSave(ctx context.Context) (_node *TenantInitTemplate, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(tenantinittemplate.Table, tenantinittemplate.Columns, sqlgraph.NewFieldSpec(tenantinittemplate.FieldID, field.TypeUint64))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TenantInitTemplate.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, tenantinittemplate.FieldID)
+		for _, f := range fields {
+			if !tenantinittemplate.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != tenantinittemplate.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(tenantinittemplate.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(tenantinittemplate.FieldStatus, field.TypeUint8, value)
+	}
+	if value, ok := _u.mutation.AddedStatus(); ok {
+		_spec.AddField(tenantinittemplate.FieldStatus, field.TypeUint8, value)
+	}
+	if _u.mutation.StatusCleared() {
+		_spec.ClearField(tenantinittemplate.FieldStatus, field.TypeUint8)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(tenantinittemplate.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Version(); ok {
+		_spec.SetField(tenantinittemplate.FieldVersion, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(tenantinittemplate.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(tenantinittemplate.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.BaseTemplate(); ok {
+		_spec.SetField(tenantinittemplate.FieldBaseTemplate, field.TypeString, value)
+	}
+	if _u.mutation.BaseTemplateCleared() {
+		_spec.ClearField(tenantinittemplate.FieldBaseTemplate, field.TypeString)
+	}
+	if value, ok := _u.mutation.Components(); ok {
+		_spec.SetField(tenantinittemplate.FieldComponents, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Sort(); ok {
+		_spec.SetField(tenantinittemplate.FieldSort, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedSort(); ok {
+		_spec.AddField(tenantinittemplate.FieldSort, field.TypeUint32, value)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &TenantInitTemplate{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{tenantinittemplate.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -39,6 +39,16 @@ type Token struct {
 	ExpiredAt time.Time `json:"expired_at,omitempty"`
 	// Department ID when token was issued | Token签发时的部门ID
 	DepartmentID uint64 `json:"department_id,omitempty"`
+	// Device description parsed from user agent | 登录设备
+	Device string `json:"device,omitempty"`
+	// User agent | 客户端 User-Agent
+	UserAgent string `json:"user_agent,omitempty"`
+	// Last access IP | 最后访问 IP
+	IP string `json:"ip,omitempty"`
+	// Last seen time | 最后活跃时间
+	LastSeenAt *time.Time `json:"last_seen_at,omitempty"`
+	// Login session ID shared by the refreshed tokens | 登录会话ID，刷新的令牌沿用登录时的会话
+	SessionID    string `json:"session_id,omitempty"`
 	selectValues sql.SelectValues
 }
 
@@ -49,9 +59,9 @@ func (*Token) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case token.FieldStatus, token.FieldTenantID, token.FieldDepartmentID:
 			values[i] = new(sql.NullInt64)
-		case token.FieldUsername, token.FieldToken, token.FieldSource:
+		case token.FieldUsername, token.FieldToken, token.FieldSource, token.FieldDevice, token.FieldUserAgent, token.FieldIP, token.FieldSessionID:
 			values[i] = new(sql.NullString)
-		case token.FieldCreatedAt, token.FieldUpdatedAt, token.FieldExpiredAt:
+		case token.FieldCreatedAt, token.FieldUpdatedAt, token.FieldExpiredAt, token.FieldLastSeenAt:
 			values[i] = new(sql.NullTime)
 		case token.FieldID, token.FieldUUID:
 			values[i] = new(uuid.UUID)
@@ -136,6 +146,37 @@ func (_m *Token) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.DepartmentID = uint64(value.Int64)
 			}
+		case token.FieldDevice:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field device", values[i])
+			} else if value.Valid {
+				_m.Device = value.String
+			}
+		case token.FieldUserAgent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_agent", values[i])
+			} else if value.Valid {
+				_m.UserAgent = value.String
+			}
+		case token.FieldIP:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ip", values[i])
+			} else if value.Valid {
+				_m.IP = value.String
+			}
+		case token.FieldLastSeenAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_seen_at", values[i])
+			} else if value.Valid {
+				_m.LastSeenAt = new(time.Time)
+				*_m.LastSeenAt = value.Time
+			}
+		case token.FieldSessionID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field session_id", values[i])
+			} else if value.Valid {
+				_m.SessionID = value.String
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -201,6 +242,23 @@ func (_m *Token) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("department_id=")
 	builder.WriteString(fmt.Sprintf("%v", _m.DepartmentID))
+	builder.WriteString(", ")
+	builder.WriteString("device=")
+	builder.WriteString(_m.Device)
+	builder.WriteString(", ")
+	builder.WriteString("user_agent=")
+	builder.WriteString(_m.UserAgent)
+	builder.WriteString(", ")
+	builder.WriteString("ip=")
+	builder.WriteString(_m.IP)
+	builder.WriteString(", ")
+	if v := _m.LastSeenAt; v != nil {
+		builder.WriteString("last_seen_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("session_id=")
+	builder.WriteString(_m.SessionID)
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -129,6 +129,76 @@ func (_c *TokenCreate) SetNillableDepartmentID(v *uint64) *TokenCreate {
 	return _c
 }
 
+// SetDevice sets the "device" field.
+func (_c *TokenCreate) SetDevice(v string) *TokenCreate {
+	_c.mutation.SetDevice(v)
+	return _c
+}
+
+// SetNillableDevice sets the "device" field if the given value is not nil.
+func (_c *TokenCreate) SetNillableDevice(v *string) *TokenCreate {
+	if v != nil {
+		_c.SetDevice(*v)
+	}
+	return _c
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (_c *TokenCreate) SetUserAgent(v string) *TokenCreate {
+	_c.mutation.SetUserAgent(v)
+	return _c
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (_c *TokenCreate) SetNillableUserAgent(v *string) *TokenCreate {
+	if v != nil {
+		_c.SetUserAgent(*v)
+	}
+	return _c
+}
+
+// SetIP sets the "ip" field.
+func (_c *TokenCreate) SetIP(v string) *TokenCreate {
+	_c.mutation.SetIP(v)
+	return _c
+}
+
+// SetNillableIP sets the "ip" field if the given value is not nil.
+func (_c *TokenCreate) SetNillableIP(v *string) *TokenCreate {
+	if v != nil {
+		_c.SetIP(*v)
+	}
+	return _c
+}
+
+// SetLastSeenAt sets the "last_seen_at" field.
+func (_c *TokenCreate) SetLastSeenAt(v time.Time) *TokenCreate {
+	_c.mutation.SetLastSeenAt(v)
+	return _c
+}
+
+// SetNillableLastSeenAt sets the "last_seen_at" field if the given value is not nil.
+func (_c *TokenCreate) SetNillableLastSeenAt(v *time.Time) *TokenCreate {
+	if v != nil {
+		_c.SetLastSeenAt(*v)
+	}
+	return _c
+}
+
+// SetSessionID sets the "session_id" field.
+func (_c *TokenCreate) SetSessionID(v string) *TokenCreate {
+	_c.mutation.SetSessionID(v)
+	return _c
+}
+
+// SetNillableSessionID sets the "session_id" field if the given value is not nil.
+func (_c *TokenCreate) SetNillableSessionID(v *string) *TokenCreate {
+	if v != nil {
+		_c.SetSessionID(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *TokenCreate) SetID(v uuid.UUID) *TokenCreate {
 	_c.mutation.SetID(v)
@@ -202,6 +272,22 @@ func (_c *TokenCreate) defaults() {
 		v := token.DefaultDepartmentID
 		_c.mutation.SetDepartmentID(v)
 	}
+	if _, ok := _c.mutation.Device(); !ok {
+		v := token.DefaultDevice
+		_c.mutation.SetDevice(v)
+	}
+	if _, ok := _c.mutation.UserAgent(); !ok {
+		v := token.DefaultUserAgent
+		_c.mutation.SetUserAgent(v)
+	}
+	if _, ok := _c.mutation.IP(); !ok {
+		v := token.DefaultIP
+		_c.mutation.SetIP(v)
+	}
+	if _, ok := _c.mutation.SessionID(); !ok {
+		v := token.DefaultSessionID
+		_c.mutation.SetSessionID(v)
+	}
 	if _, ok := _c.mutation.ID(); !ok {
 		v := token.DefaultID()
 		_c.mutation.SetID(v)
@@ -309,6 +395,26 @@ func (_c *TokenCreate) createSpec() (*Token, *sqlgraph.CreateSpec) {
 		_spec.SetField(token.FieldDepartmentID, field.TypeUint64, value)
 		_node.DepartmentID = value
 	}
+	if value, ok := _c.mutation.Device(); ok {
+		_spec.SetField(token.FieldDevice, field.TypeString, value)
+		_node.Device = value
+	}
+	if value, ok := _c.mutation.UserAgent(); ok {
+		_spec.SetField(token.FieldUserAgent, field.TypeString, value)
+		_node.UserAgent = value
+	}
+	if value, ok := _c.mutation.IP(); ok {
+		_spec.SetField(token.FieldIP, field.TypeString, value)
+		_node.IP = value
+	}
+	if value, ok := _c.mutation.LastSeenAt(); ok {
+		_spec.SetField(token.FieldLastSeenAt, field.TypeTime, value)
+		_node.LastSeenAt = &value
+	}
+	if value, ok := _c.mutation.SessionID(); ok {
+		_spec.SetField(token.FieldSessionID, field.TypeString, value)
+		_node.SessionID = value
+	}
 	return _node, _spec
 }
 
@@ -160,6 +160,106 @@ func (_u *TokenUpdate) ClearDepartmentID() *TokenUpdate {
 	return _u
 }
 
+// SetDevice sets the "device" field.
+func (_u *TokenUpdate) SetDevice(v string) *TokenUpdate {
+	_u.mutation.SetDevice(v)
+	return _u
+}
+
+// SetNillableDevice sets the "device" field if the given value is not nil.
+func (_u *TokenUpdate) SetNillableDevice(v *string) *TokenUpdate {
+	if v != nil {
+		_u.SetDevice(*v)
+	}
+	return _u
+}
+
+// ClearDevice clears the value of the "device" field.
+func (_u *TokenUpdate) ClearDevice() *TokenUpdate {
+	_u.mutation.ClearDevice()
+	return _u
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (_u *TokenUpdate) SetUserAgent(v string) *TokenUpdate {
+	_u.mutation.SetUserAgent(v)
+	return _u
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (_u *TokenUpdate) SetNillableUserAgent(v *string) *TokenUpdate {
+	if v != nil {
+		_u.SetUserAgent(*v)
+	}
+	return _u
+}
+
+// ClearUserAgent clears the value of the "user_agent" field.
+func (_u *TokenUpdate) ClearUserAgent() *TokenUpdate {
+	_u.mutation.ClearUserAgent()
+	return _u
+}
+
+// SetIP sets the "ip" field.
+func (_u *TokenUpdate) SetIP(v string) *TokenUpdate {
+	_u.mutation.SetIP(v)
+	return _u
+}
+
+// SetNillableIP sets the "ip" field if the given value is not nil.
+func (_u *TokenUpdate) SetNillableIP(v *string) *TokenUpdate {
+	if v != nil {
+		_u.SetIP(*v)
+	}
+	return _u
+}
+
+// ClearIP clears the value of the "ip" field.
+func (_u *TokenUpdate) ClearIP() *TokenUpdate {
+	_u.mutation.ClearIP()
+	return _u
+}
+
+// SetLastSeenAt sets the "last_seen_at" field.
+func (_u *TokenUpdate) SetLastSeenAt(v time.Time) *TokenUpdate {
+	_u.mutation.SetLastSeenAt(v)
+	return _u
+}
+
+// SetNillableLastSeenAt sets the "last_seen_at" field if the given value is not nil.
+func (_u *TokenUpdate) SetNillableLastSeenAt(v *time.Time) *TokenUpdate {
+	if v != nil {
+		_u.SetLastSeenAt(*v)
+	}
+	return _u
+}
+
+// ClearLastSeenAt clears the value of the "last_seen_at" field.
+func (_u *TokenUpdate) ClearLastSeenAt() *TokenUpdate {
+	_u.mutation.ClearLastSeenAt()
+	return _u
+}
+
+// SetSessionID sets the "session_id" field.
+func (_u *TokenUpdate) SetSessionID(v string) *TokenUpdate {
+	_u.mutation.SetSessionID(v)
+	return _u
+}
+
+// SetNillableSessionID sets the "session_id" field if the given value is not nil.
+func (_u *TokenUpdate) SetNillableSessionID(v *string) *TokenUpdate {
+	if v != nil {
+		_u.SetSessionID(*v)
+	}
+	return _u
+}
+
+// ClearSessionID clears the value of the "session_id" field.
+func (_u *TokenUpdate) ClearSessionID() *TokenUpdate {
+	_u.mutation.ClearSessionID()
+	return _u
+}
+
 // Mutation returns the TokenMutation object of the builder.
 func (_u *TokenUpdate) Mutation() *TokenMutation {
 	return _u.mutation
@@ -252,6 +352,36 @@ func (_u *TokenUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.DepartmentIDCleared() {
 		_spec.ClearField(token.FieldDepartmentID, field.TypeUint64)
 	}
+	if value, ok := _u.mutation.Device(); ok {
+		_spec.SetField(token.FieldDevice, field.TypeString, value)
+	}
+	if _u.mutation.DeviceCleared() {
+		_spec.ClearField(token.FieldDevice, field.TypeString)
+	}
+	if value, ok := _u.mutation.UserAgent(); ok {
+		_spec.SetField(token.FieldUserAgent, field.TypeString, value)
+	}
+	if _u.mutation.UserAgentCleared() {
+		_spec.ClearField(token.FieldUserAgent, field.TypeString)
+	}
+	if value, ok := _u.mutation.IP(); ok {
+		_spec.SetField(token.FieldIP, field.TypeString, value)
+	}
+	if _u.mutation.IPCleared() {
+		_spec.ClearField(token.FieldIP, field.TypeString)
+	}
+	if value, ok := _u.mutation.LastSeenAt(); ok {
+		_spec.SetField(token.FieldLastSeenAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastSeenAtCleared() {
+		_spec.ClearField(token.FieldLastSeenAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.SessionID(); ok {
+		_spec.SetField(token.FieldSessionID, field.TypeString, value)
+	}
+	if _u.mutation.SessionIDCleared() {
+		_spec.ClearField(token.FieldSessionID, field.TypeString)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
@@ -404,6 +534,106 @@ func (_u *TokenUpdateOne) ClearDepartmentID() *TokenUpdateOne {
 	return _u
 }
 
+// SetDevice sets the "device" field.
+func (_u *TokenUpdateOne) SetDevice(v string) *TokenUpdateOne {
+	_u.mutation.SetDevice(v)
+	return _u
+}
+
+// SetNillableDevice sets the "device" field if the given value is not nil.
+func (_u *TokenUpdateOne) SetNillableDevice(v *string) *TokenUpdateOne {
+	if v != nil {
+		_u.SetDevice(*v)
+	}
+	return _u
+}
+
+// ClearDevice clears the value of the "device" field.
+func (_u *TokenUpdateOne) ClearDevice() *TokenUpdateOne {
+	_u.mutation.ClearDevice()
+	return _u
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (_u *TokenUpdateOne) SetUserAgent(v string) *TokenUpdateOne {
+	_u.mutation.SetUserAgent(v)
+	return _u
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (_u *TokenUpdateOne) SetNillableUserAgent(v *string) *TokenUpdateOne {
+	if v != nil {
+		_u.SetUserAgent(*v)
+	}
+	return _u
+}
+
+// ClearUserAgent clears the value of the "user_agent" field.
+func (_u *TokenUpdateOne) ClearUserAgent() *TokenUpdateOne {
+	_u.mutation.ClearUserAgent()
+	return _u
+}
+
+// SetIP sets the "ip" field.
+func (_u *TokenUpdateOne) SetIP(v string) *TokenUpdateOne {
+	_u.mutation.SetIP(v)
+	return _u
+}
+
+// SetNillableIP sets the "ip" field if the given value is not nil.
+func (_u *TokenUpdateOne) SetNillableIP(v *string) *TokenUpdateOne {
+	if v != nil {
+		_u.SetIP(*v)
+	}
+	return _u
+}
+
+// ClearIP clears the value of the "ip" field.
+func (_u *TokenUpdateOne) ClearIP() *TokenUpdateOne {
+	_u.mutation.ClearIP()
+	return _u
+}
+
+// SetLastSeenAt sets the "last_seen_at" field.
+func (_u *TokenUpdateOne) SetLastSeenAt(v time.Time) *TokenUpdateOne {
+	_u.mutation.SetLastSeenAt(v)
+	return _u
+}
+
+// SetNillableLastSeenAt sets the "last_seen_at" field if the given value is not nil.
+func (_u *TokenUpdateOne) SetNillableLastSeenAt(v *time.Time) *TokenUpdateOne {
+	if v != nil {
+		_u.SetLastSeenAt(*v)
+	}
+	return _u
+}
+
+// ClearLastSeenAt clears the value of the "last_seen_at" field.
+func (_u *TokenUpdateOne) ClearLastSeenAt() *TokenUpdateOne {
+	_u.mutation.ClearLastSeenAt()
+	return _u
+}
+
+// SetSessionID sets the "session_id" field.
+func (_u *TokenUpdateOne) SetSessionID(v string) *TokenUpdateOne {
+	_u.mutation.SetSessionID(v)
+	return _u
+}
+
+// SetNillableSessionID sets the "session_id" field if the given value is not nil.
+func (_u *TokenUpdateOne) SetNillableSessionID(v *string) *TokenUpdateOne {
+	if v != nil {
+		_u.SetSessionID(*v)
+	}
+	return _u
+}
+
+// ClearSessionID clears the value of the "session_id" field.
+func (_u *TokenUpdateOne) ClearSessionID() *TokenUpdateOne {
+	_u.mutation.ClearSessionID()
+	return _u
+}
+
 // Mutation returns the TokenMutation object of the builder.
 func (_u *TokenUpdateOne) Mutation() *TokenMutation {
 	return _u.mutation
@@ -526,6 +756,36 @@ func (_u *TokenUpdateOne) sqlSave(ctx context.Context) (_node *Token, err error)
 	if _u.mutation.DepartmentIDCleared() {
 		_spec.ClearField(token.FieldDepartmentID, field.TypeUint64)
 	}
+	if value, ok := _u.mutation.Device(); ok {
+		_spec.SetField(token.FieldDevice, field.TypeString, value)
+	}
+	if _u.mutation.DeviceCleared() {
+		_spec.ClearField(token.FieldDevice, field.TypeString)
+	}
+	if value, ok := _u.mutation.UserAgent(); ok {
+		_spec.SetField(token.FieldUserAgent, field.TypeString, value)
+	}
+	if _u.mutation.UserAgentCleared() {
+		_spec.ClearField(token.FieldUserAgent, field.TypeString)
+	}
+	if value, ok := _u.mutation.IP(); ok {
+		_spec.SetField(token.FieldIP, field.TypeString, value)
+	}
+	if _u.mutation.IPCleared() {
+		_spec.ClearField(token.FieldIP, field.TypeString)
+	}
+	if value, ok := _u.mutation.LastSeenAt(); ok {
+		_spec.SetField(token.FieldLastSeenAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastSeenAtCleared() {
+		_spec.ClearField(token.FieldLastSeenAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.SessionID(); ok {
+		_spec.SetField(token.FieldSessionID, field.TypeString, value)
+	}
+	if _u.mutation.SessionIDCleared() {
+		_spec.ClearField(token.FieldSessionID, field.TypeString)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	_node = &Token{config: _u.config}
 	_spec.Assign = _node.assignValues
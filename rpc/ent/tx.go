@@ -18,6 +18,14 @@ type Tx struct {
 	API *APIClient
 	// AuditLog is the client for interacting with the AuditLog builders.
 	AuditLog *AuditLogClient
+	// AuditLogArchive is the client for interacting with the AuditLogArchive builders.
+	AuditLogArchive *AuditLogArchiveClient
+	// AuditLogChain is the client for interacting with the AuditLogChain builders.
+	AuditLogChain *AuditLogChainClient
+	// AuditLogCheckpoint is the client for interacting with the AuditLogCheckpoint builders.
+	AuditLogCheckpoint *AuditLogCheckpointClient
+	// AuditLogPruneRecord is the client for interacting with the AuditLogPruneRecord builders.
+	AuditLogPruneRecord *AuditLogPruneRecordClient
 	// CasbinRule is the client for interacting with the CasbinRule builders.
 	CasbinRule *CasbinRuleClient
 	// Configuration is the client for interacting with the Configuration builders.
@@ -28,20 +36,50 @@ type Tx struct {
 	Dictionary *DictionaryClient
 	// DictionaryDetail is the client for interacting with the DictionaryDetail builders.
 	DictionaryDetail *DictionaryDetailClient
+	// ImpersonationSession is the client for interacting with the ImpersonationSession builders.
+	ImpersonationSession *ImpersonationSessionClient
+	// LdapAccount is the client for interacting with the LdapAccount builders.
+	LdapAccount *LdapAccountClient
+	// LdapDepartment is the client for interacting with the LdapDepartment builders.
+	LdapDepartment *LdapDepartmentClient
+	// LdapProvider is the client for interacting with the LdapProvider builders.
+	LdapProvider *LdapProviderClient
+	// LdapSyncRun is the client for interacting with the LdapSyncRun builders.
+	LdapSyncRun *LdapSyncRunClient
 	// Menu is the client for interacting with the Menu builders.
 	Menu *MenuClient
 	// OauthAccount is the client for interacting with the OauthAccount builders.
 	OauthAccount *OauthAccountClient
+	// OauthClient is the client for interacting with the OauthClient builders.
+	OauthClient *OauthClientClient
+	// OauthConsent is the client for interacting with the OauthConsent builders.
+	OauthConsent *OauthConsentClient
 	// OauthProvider is the client for interacting with the OauthProvider builders.
 	OauthProvider *OauthProviderClient
+	// OauthProviderTemplate is the client for interacting with the OauthProviderTemplate builders.
+	OauthProviderTemplate *OauthProviderTemplateClient
+	// OauthScope is the client for interacting with the OauthScope builders.
+	OauthScope *OauthScopeClient
 	// OauthSession is the client for interacting with the OauthSession builders.
 	OauthSession *OauthSessionClient
 	// Position is the client for interacting with the Position builders.
 	Position *PositionClient
 	// Role is the client for interacting with the Role builders.
 	Role *RoleClient
+	// SamlAccount is the client for interacting with the SamlAccount builders.
+	SamlAccount *SamlAccountClient
+	// SamlProvider is the client for interacting with the SamlProvider builders.
+	SamlProvider *SamlProviderClient
+	// ScimToken is the client for interacting with the ScimToken builders.
+	ScimToken *ScimTokenClient
 	// Tenant is the client for interacting with the Tenant builders.
 	Tenant *TenantClient
+	// TenantInitJob is the client for interacting with the TenantInitJob builders.
+	TenantInitJob *TenantInitJobClient
+	// TenantInitPlugin is the client for interacting with the TenantInitPlugin builders.
+	TenantInitPlugin *TenantInitPluginClient
+	// TenantInitTemplate is the client for interacting with the TenantInitTemplate builders.
+	TenantInitTemplate *TenantInitTemplateClient
 	// Token is the client for interacting with the Token builders.
 	Token *TokenClient
 	// User is the client for interacting with the User builders.
@@ -179,18 +217,37 @@ func (tx *Tx) Client() *Client {
 func (tx *Tx) init() {
 	tx.API = NewAPIClient(tx.config)
 	tx.AuditLog = NewAuditLogClient(tx.config)
+	tx.AuditLogArchive = NewAuditLogArchiveClient(tx.config)
+	tx.AuditLogChain = NewAuditLogChainClient(tx.config)
+	tx.AuditLogCheckpoint = NewAuditLogCheckpointClient(tx.config)
+	tx.AuditLogPruneRecord = NewAuditLogPruneRecordClient(tx.config)
 	tx.CasbinRule = NewCasbinRuleClient(tx.config)
 	tx.Configuration = NewConfigurationClient(tx.config)
 	tx.Department = NewDepartmentClient(tx.config)
 	tx.Dictionary = NewDictionaryClient(tx.config)
 	tx.DictionaryDetail = NewDictionaryDetailClient(tx.config)
+	tx.ImpersonationSession = NewImpersonationSessionClient(tx.config)
+	tx.LdapAccount = NewLdapAccountClient(tx.config)
+	tx.LdapDepartment = NewLdapDepartmentClient(tx.config)
+	tx.LdapProvider = NewLdapProviderClient(tx.config)
+	tx.LdapSyncRun = NewLdapSyncRunClient(tx.config)
 	tx.Menu = NewMenuClient(tx.config)
 	tx.OauthAccount = NewOauthAccountClient(tx.config)
+	tx.OauthClient = NewOauthClientClient(tx.config)
+	tx.OauthConsent = NewOauthConsentClient(tx.config)
 	tx.OauthProvider = NewOauthProviderClient(tx.config)
+	tx.OauthProviderTemplate = NewOauthProviderTemplateClient(tx.config)
+	tx.OauthScope = NewOauthScopeClient(tx.config)
 	tx.OauthSession = NewOauthSessionClient(tx.config)
 	tx.Position = NewPositionClient(tx.config)
 	tx.Role = NewRoleClient(tx.config)
+	tx.SamlAccount = NewSamlAccountClient(tx.config)
+	tx.SamlProvider = NewSamlProviderClient(tx.config)
+	tx.ScimToken = NewScimTokenClient(tx.config)
 	tx.Tenant = NewTenantClient(tx.config)
+	tx.TenantInitJob = NewTenantInitJobClient(tx.config)
+	tx.TenantInitPlugin = NewTenantInitPluginClient(tx.config)
+	tx.TenantInitTemplate = NewTenantInitTemplateClient(tx.config)
 	tx.Token = NewTokenClient(tx.config)
 	tx.User = NewUserClient(tx.config)
 }
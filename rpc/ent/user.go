@@ -64,9 +64,13 @@ type UserEdges struct {
 	OauthAccounts []*OauthAccount `json:"oauth_accounts,omitempty"`
 	// OauthSessions holds the value of the oauth_sessions edge.
 	OauthSessions []*OauthSession `json:"oauth_sessions,omitempty"`
+	// SamlAccounts holds the value of the saml_accounts edge.
+	SamlAccounts []*SamlAccount `json:"saml_accounts,omitempty"`
+	// LdapAccounts holds the value of the ldap_accounts edge.
+	LdapAccounts []*LdapAccount `json:"ldap_accounts,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [5]bool
+	loadedTypes [7]bool
 }
 
 // DepartmentsOrErr returns the Departments value or an error if the edge
@@ -116,6 +120,24 @@ func (e UserEdges) OauthSessionsOrErr() ([]*OauthSession, error) {
 	return nil, &NotLoadedError{edge: "oauth_sessions"}
 }
 
+// SamlAccountsOrErr returns the SamlAccounts value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) SamlAccountsOrErr() ([]*SamlAccount, error) {
+	if e.loadedTypes[5] {
+		return e.SamlAccounts, nil
+	}
+	return nil, &NotLoadedError{edge: "saml_accounts"}
+}
+
+// LdapAccountsOrErr returns the LdapAccounts value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) LdapAccountsOrErr() ([]*LdapAccount, error) {
+	if e.loadedTypes[6] {
+		return e.LdapAccounts, nil
+	}
+	return nil, &NotLoadedError{edge: "ldap_accounts"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*User) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -266,6 +288,16 @@ func (_m *User) QueryOauthSessions() *OauthSessionQuery {
 	return NewUserClient(_m.config).QueryOauthSessions(_m)
 }
 
+// QuerySamlAccounts queries the "saml_accounts" edge of the User entity.
+func (_m *User) QuerySamlAccounts() *SamlAccountQuery {
+	return NewUserClient(_m.config).QuerySamlAccounts(_m)
+}
+
+// QueryLdapAccounts queries the "ldap_accounts" edge of the User entity.
+func (_m *User) QueryLdapAccounts() *LdapAccountQuery {
+	return NewUserClient(_m.config).QueryLdapAccounts(_m)
+}
+
 // Update returns a builder for updating this User.
 // Note that you need to call User.Unwrap() before calling this method if this User
 // was returned from a transaction, and the transaction was committed or rolled back.
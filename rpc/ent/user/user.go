@@ -51,6 +51,10 @@ const (
 	EdgeOauthAccounts = "oauth_accounts"
 	// EdgeOauthSessions holds the string denoting the oauth_sessions edge name in mutations.
 	EdgeOauthSessions = "oauth_sessions"
+	// EdgeSamlAccounts holds the string denoting the saml_accounts edge name in mutations.
+	EdgeSamlAccounts = "saml_accounts"
+	// EdgeLdapAccounts holds the string denoting the ldap_accounts edge name in mutations.
+	EdgeLdapAccounts = "ldap_accounts"
 	// Table holds the table name of the user in the database.
 	Table = "sys_users"
 	// DepartmentsTable is the table that holds the departments relation/edge.
@@ -84,6 +88,20 @@ const (
 	OauthSessionsInverseTable = "sys_oauth_sessions"
 	// OauthSessionsColumn is the table column denoting the oauth_sessions relation/edge.
 	OauthSessionsColumn = "user_id"
+	// SamlAccountsTable is the table that holds the saml_accounts relation/edge.
+	SamlAccountsTable = "sys_saml_accounts"
+	// SamlAccountsInverseTable is the table name for the SamlAccount entity.
+	// It exists in this package in order to avoid circular dependency with the "samlaccount" package.
+	SamlAccountsInverseTable = "sys_saml_accounts"
+	// SamlAccountsColumn is the table column denoting the saml_accounts relation/edge.
+	SamlAccountsColumn = "user_id"
+	// LdapAccountsTable is the table that holds the ldap_accounts relation/edge.
+	LdapAccountsTable = "sys_ldap_accounts"
+	// LdapAccountsInverseTable is the table name for the LdapAccount entity.
+	// It exists in this package in order to avoid circular dependency with the "ldapaccount" package.
+	LdapAccountsInverseTable = "sys_ldap_accounts"
+	// LdapAccountsColumn is the table column denoting the ldap_accounts relation/edge.
+	LdapAccountsColumn = "user_id"
 )
 
 // Columns holds all SQL columns for user fields.
@@ -277,6 +295,34 @@ func ByOauthSessions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newOauthSessionsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// BySamlAccountsCount orders the results by saml_accounts count.
+func BySamlAccountsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSamlAccountsStep(), opts...)
+	}
+}
+
+// BySamlAccounts orders the results by saml_accounts terms.
+func BySamlAccounts(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSamlAccountsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByLdapAccountsCount orders the results by ldap_accounts count.
+func ByLdapAccountsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newLdapAccountsStep(), opts...)
+	}
+}
+
+// ByLdapAccounts orders the results by ldap_accounts terms.
+func ByLdapAccounts(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newLdapAccountsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newDepartmentsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -312,3 +358,17 @@ func newOauthSessionsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, OauthSessionsTable, OauthSessionsColumn),
 	)
 }
+func newSamlAccountsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SamlAccountsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, SamlAccountsTable, SamlAccountsColumn),
+	)
+}
+func newLdapAccountsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(LdapAccountsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, LdapAccountsTable, LdapAccountsColumn),
+	)
+}
@@ -996,6 +996,52 @@ func HasOauthSessionsWith(preds ...predicate.OauthSession) predicate.User {
 	})
 }
 
+// HasSamlAccounts applies the HasEdge predicate on the "saml_accounts" edge.
+func HasSamlAccounts() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, SamlAccountsTable, SamlAccountsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSamlAccountsWith applies the HasEdge predicate on the "saml_accounts" edge with a given conditions (other predicates).
+func HasSamlAccountsWith(preds ...predicate.SamlAccount) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newSamlAccountsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasLdapAccounts applies the HasEdge predicate on the "ldap_accounts" edge.
+func HasLdapAccounts() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, LdapAccountsTable, LdapAccountsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasLdapAccountsWith applies the HasEdge predicate on the "ldap_accounts" edge with a given conditions (other predicates).
+func HasLdapAccountsWith(preds ...predicate.LdapAccount) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newLdapAccountsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.User) predicate.User {
 	return predicate.User(sql.AndPredicates(predicates...))
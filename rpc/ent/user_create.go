@@ -11,10 +11,12 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/coder-lulu/newbee-core/rpc/ent/department"
+	"github.com/coder-lulu/newbee-core/rpc/ent/ldapaccount"
 	"github.com/coder-lulu/newbee-core/rpc/ent/oauthaccount"
 	"github.com/coder-lulu/newbee-core/rpc/ent/oauthsession"
 	"github.com/coder-lulu/newbee-core/rpc/ent/position"
 	"github.com/coder-lulu/newbee-core/rpc/ent/role"
+	"github.com/coder-lulu/newbee-core/rpc/ent/samlaccount"
 	"github.com/coder-lulu/newbee-core/rpc/ent/user"
 	uuid "github.com/gofrs/uuid/v5"
 )
@@ -277,6 +279,36 @@ func (_c *UserCreate) AddOauthSessions(v ...*OauthSession) *UserCreate {
 	return _c.AddOauthSessionIDs(ids...)
 }
 
+// AddSamlAccountIDs adds the "saml_accounts" edge to the SamlAccount entity by IDs.
+func (_c *UserCreate) AddSamlAccountIDs(ids ...uint64) *UserCreate {
+	_c.mutation.AddSamlAccountIDs(ids...)
+	return _c
+}
+
+// AddSamlAccounts adds the "saml_accounts" edges to the SamlAccount entity.
+func (_c *UserCreate) AddSamlAccounts(v ...*SamlAccount) *UserCreate {
+	ids := make([]uint64, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddSamlAccountIDs(ids...)
+}
+
+// AddLdapAccountIDs adds the "ldap_accounts" edge to the LdapAccount entity by IDs.
+func (_c *UserCreate) AddLdapAccountIDs(ids ...uint64) *UserCreate {
+	_c.mutation.AddLdapAccountIDs(ids...)
+	return _c
+}
+
+// AddLdapAccounts adds the "ldap_accounts" edges to the LdapAccount entity.
+func (_c *UserCreate) AddLdapAccounts(v ...*LdapAccount) *UserCreate {
+	ids := make([]uint64, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddLdapAccountIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (_c *UserCreate) Mutation() *UserMutation {
 	return _c.mutation
@@ -529,6 +561,38 @@ func (_c *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.SamlAccountsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SamlAccountsTable,
+			Columns: []string{user.SamlAccountsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(samlaccount.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.LdapAccountsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.LdapAccountsTable,
+			Columns: []string{user.LdapAccountsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ldapaccount.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -13,11 +13,13 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/coder-lulu/newbee-core/rpc/ent/department"
+	"github.com/coder-lulu/newbee-core/rpc/ent/ldapaccount"
 	"github.com/coder-lulu/newbee-core/rpc/ent/oauthaccount"
 	"github.com/coder-lulu/newbee-core/rpc/ent/oauthsession"
 	"github.com/coder-lulu/newbee-core/rpc/ent/position"
 	"github.com/coder-lulu/newbee-core/rpc/ent/predicate"
 	"github.com/coder-lulu/newbee-core/rpc/ent/role"
+	"github.com/coder-lulu/newbee-core/rpc/ent/samlaccount"
 	"github.com/coder-lulu/newbee-core/rpc/ent/user"
 	uuid "github.com/gofrs/uuid/v5"
 )
@@ -34,6 +36,8 @@ type UserQuery struct {
 	withRoles         *RoleQuery
 	withOauthAccounts *OauthAccountQuery
 	withOauthSessions *OauthSessionQuery
+	withSamlAccounts  *SamlAccountQuery
+	withLdapAccounts  *LdapAccountQuery
 	modifiers         []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
@@ -181,6 +185,50 @@ func (_q *UserQuery) QueryOauthSessions() *OauthSessionQuery {
 	return query
 }
 
+// QuerySamlAccounts chains the current query on the "saml_accounts" edge.
+func (_q *UserQuery) QuerySamlAccounts() *SamlAccountQuery {
+	query := (&SamlAccountClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(samlaccount.Table, samlaccount.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.SamlAccountsTable, user.SamlAccountsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryLdapAccounts chains the current query on the "ldap_accounts" edge.
+func (_q *UserQuery) QueryLdapAccounts() *LdapAccountQuery {
+	query := (&LdapAccountClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(ldapaccount.Table, ldapaccount.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.LdapAccountsTable, user.LdapAccountsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first User entity from the query.
 // Returns a *NotFoundError when no User was found.
 func (_q *UserQuery) First(ctx context.Context) (*User, error) {
@@ -378,6 +426,8 @@ func (_q *UserQuery) Clone() *UserQuery {
 		withRoles:         _q.withRoles.Clone(),
 		withOauthAccounts: _q.withOauthAccounts.Clone(),
 		withOauthSessions: _q.withOauthSessions.Clone(),
+		withSamlAccounts:  _q.withSamlAccounts.Clone(),
+		withLdapAccounts:  _q.withLdapAccounts.Clone(),
 		// clone intermediate query.
 		sql:       _q.sql.Clone(),
 		path:      _q.path,
@@ -440,6 +490,28 @@ func (_q *UserQuery) WithOauthSessions(opts ...func(*OauthSessionQuery)) *UserQu
 	return _q
 }
 
+// WithSamlAccounts tells the query-builder to eager-load the nodes that are connected to
+// the "saml_accounts" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *UserQuery) WithSamlAccounts(opts ...func(*SamlAccountQuery)) *UserQuery {
+	query := (&SamlAccountClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withSamlAccounts = query
+	return _q
+}
+
+// WithLdapAccounts tells the query-builder to eager-load the nodes that are connected to
+// the "ldap_accounts" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *UserQuery) WithLdapAccounts(opts ...func(*LdapAccountQuery)) *UserQuery {
+	query := (&LdapAccountClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withLdapAccounts = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -518,12 +590,14 @@ func (_q *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 	var (
 		nodes       = []*User{}
 		_spec       = _q.querySpec()
-		loadedTypes = [5]bool{
+		loadedTypes = [7]bool{
 			_q.withDepartments != nil,
 			_q.withPositions != nil,
 			_q.withRoles != nil,
 			_q.withOauthAccounts != nil,
 			_q.withOauthSessions != nil,
+			_q.withSamlAccounts != nil,
+			_q.withLdapAccounts != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -581,6 +655,20 @@ func (_q *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 			return nil, err
 		}
 	}
+	if query := _q.withSamlAccounts; query != nil {
+		if err := _q.loadSamlAccounts(ctx, query, nodes,
+			func(n *User) { n.Edges.SamlAccounts = []*SamlAccount{} },
+			func(n *User, e *SamlAccount) { n.Edges.SamlAccounts = append(n.Edges.SamlAccounts, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withLdapAccounts; query != nil {
+		if err := _q.loadLdapAccounts(ctx, query, nodes,
+			func(n *User) { n.Edges.LdapAccounts = []*LdapAccount{} },
+			func(n *User, e *LdapAccount) { n.Edges.LdapAccounts = append(n.Edges.LdapAccounts, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -795,6 +883,66 @@ func (_q *UserQuery) loadOauthSessions(ctx context.Context, query *OauthSessionQ
 	}
 	return nil
 }
+func (_q *UserQuery) loadSamlAccounts(ctx context.Context, query *SamlAccountQuery, nodes []*User, init func(*User), assign func(*User, *SamlAccount)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(samlaccount.FieldUserID)
+	}
+	query.Where(predicate.SamlAccount(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.SamlAccountsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.UserID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *UserQuery) loadLdapAccounts(ctx context.Context, query *LdapAccountQuery, nodes []*User, init func(*User), assign func(*User, *LdapAccount)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(ldapaccount.FieldUserID)
+	}
+	query.Where(predicate.LdapAccount(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.LdapAccountsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.UserID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *UserQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
@@ -12,11 +12,13 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/coder-lulu/newbee-core/rpc/ent/department"
+	"github.com/coder-lulu/newbee-core/rpc/ent/ldapaccount"
 	"github.com/coder-lulu/newbee-core/rpc/ent/oauthaccount"
 	"github.com/coder-lulu/newbee-core/rpc/ent/oauthsession"
 	"github.com/coder-lulu/newbee-core/rpc/ent/position"
 	"github.com/coder-lulu/newbee-core/rpc/ent/predicate"
 	"github.com/coder-lulu/newbee-core/rpc/ent/role"
+	"github.com/coder-lulu/newbee-core/rpc/ent/samlaccount"
 	"github.com/coder-lulu/newbee-core/rpc/ent/user"
 )
 
@@ -302,6 +304,36 @@ func (_u *UserUpdate) AddOauthSessions(v ...*OauthSession) *UserUpdate {
 	return _u.AddOauthSessionIDs(ids...)
 }
 
+// AddSamlAccountIDs adds the "saml_accounts" edge to the SamlAccount entity by IDs.
+func (_u *UserUpdate) AddSamlAccountIDs(ids ...uint64) *UserUpdate {
+	_u.mutation.AddSamlAccountIDs(ids...)
+	return _u
+}
+
+// AddSamlAccounts adds the "saml_accounts" edges to the SamlAccount entity.
+func (_u *UserUpdate) AddSamlAccounts(v ...*SamlAccount) *UserUpdate {
+	ids := make([]uint64, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSamlAccountIDs(ids...)
+}
+
+// AddLdapAccountIDs adds the "ldap_accounts" edge to the LdapAccount entity by IDs.
+func (_u *UserUpdate) AddLdapAccountIDs(ids ...uint64) *UserUpdate {
+	_u.mutation.AddLdapAccountIDs(ids...)
+	return _u
+}
+
+// AddLdapAccounts adds the "ldap_accounts" edges to the LdapAccount entity.
+func (_u *UserUpdate) AddLdapAccounts(v ...*LdapAccount) *UserUpdate {
+	ids := make([]uint64, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddLdapAccountIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (_u *UserUpdate) Mutation() *UserMutation {
 	return _u.mutation
@@ -397,6 +429,48 @@ func (_u *UserUpdate) RemoveOauthSessions(v ...*OauthSession) *UserUpdate {
 	return _u.RemoveOauthSessionIDs(ids...)
 }
 
+// ClearSamlAccounts clears all "saml_accounts" edges to the SamlAccount entity.
+func (_u *UserUpdate) ClearSamlAccounts() *UserUpdate {
+	_u.mutation.ClearSamlAccounts()
+	return _u
+}
+
+// RemoveSamlAccountIDs removes the "saml_accounts" edge to SamlAccount entities by IDs.
+func (_u *UserUpdate) RemoveSamlAccountIDs(ids ...uint64) *UserUpdate {
+	_u.mutation.RemoveSamlAccountIDs(ids...)
+	return _u
+}
+
+// RemoveSamlAccounts removes "saml_accounts" edges to SamlAccount entities.
+func (_u *UserUpdate) RemoveSamlAccounts(v ...*SamlAccount) *UserUpdate {
+	ids := make([]uint64, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSamlAccountIDs(ids...)
+}
+
+// ClearLdapAccounts clears all "ldap_accounts" edges to the LdapAccount entity.
+func (_u *UserUpdate) ClearLdapAccounts() *UserUpdate {
+	_u.mutation.ClearLdapAccounts()
+	return _u
+}
+
+// RemoveLdapAccountIDs removes the "ldap_accounts" edge to LdapAccount entities by IDs.
+func (_u *UserUpdate) RemoveLdapAccountIDs(ids ...uint64) *UserUpdate {
+	_u.mutation.RemoveLdapAccountIDs(ids...)
+	return _u
+}
+
+// RemoveLdapAccounts removes "ldap_accounts" edges to LdapAccount entities.
+func (_u *UserUpdate) RemoveLdapAccounts(v ...*LdapAccount) *UserUpdate {
+	ids := make([]uint64, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveLdapAccountIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *UserUpdate) Save(ctx context.Context) (int, error) {
 	_u.defaults()
@@ -705,6 +779,96 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.SamlAccountsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SamlAccountsTable,
+			Columns: []string{user.SamlAccountsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(samlaccount.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSamlAccountsIDs(); len(nodes) > 0 && !_u.mutation.SamlAccountsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SamlAccountsTable,
+			Columns: []string{user.SamlAccountsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(samlaccount.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SamlAccountsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SamlAccountsTable,
+			Columns: []string{user.SamlAccountsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(samlaccount.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.LdapAccountsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.LdapAccountsTable,
+			Columns: []string{user.LdapAccountsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ldapaccount.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedLdapAccountsIDs(); len(nodes) > 0 && !_u.mutation.LdapAccountsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.LdapAccountsTable,
+			Columns: []string{user.LdapAccountsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ldapaccount.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LdapAccountsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.LdapAccountsTable,
+			Columns: []string{user.LdapAccountsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ldapaccount.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
@@ -995,6 +1159,36 @@ func (_u *UserUpdateOne) AddOauthSessions(v ...*OauthSession) *UserUpdateOne {
 	return _u.AddOauthSessionIDs(ids...)
 }
 
+// AddSamlAccountIDs adds the "saml_accounts" edge to the SamlAccount entity by IDs.
+func (_u *UserUpdateOne) AddSamlAccountIDs(ids ...uint64) *UserUpdateOne {
+	_u.mutation.AddSamlAccountIDs(ids...)
+	return _u
+}
+
+// AddSamlAccounts adds the "saml_accounts" edges to the SamlAccount entity.
+func (_u *UserUpdateOne) AddSamlAccounts(v ...*SamlAccount) *UserUpdateOne {
+	ids := make([]uint64, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSamlAccountIDs(ids...)
+}
+
+// AddLdapAccountIDs adds the "ldap_accounts" edge to the LdapAccount entity by IDs.
+func (_u *UserUpdateOne) AddLdapAccountIDs(ids ...uint64) *UserUpdateOne {
+	_u.mutation.AddLdapAccountIDs(ids...)
+	return _u
+}
+
+// AddLdapAccounts adds the "ldap_accounts" edges to the LdapAccount entity.
+func (_u *UserUpdateOne) AddLdapAccounts(v ...*LdapAccount) *UserUpdateOne {
+	ids := make([]uint64, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddLdapAccountIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (_u *UserUpdateOne) Mutation() *UserMutation {
 	return _u.mutation
@@ -1090,6 +1284,48 @@ func (_u *UserUpdateOne) RemoveOauthSessions(v ...*OauthSession) *UserUpdateOne
 	return _u.RemoveOauthSessionIDs(ids...)
 }
 
+// ClearSamlAccounts clears all "saml_accounts" edges to the SamlAccount entity.
+func (_u *UserUpdateOne) ClearSamlAccounts() *UserUpdateOne {
+	_u.mutation.ClearSamlAccounts()
+	return _u
+}
+
+// RemoveSamlAccountIDs removes the "saml_accounts" edge to SamlAccount entities by IDs.
+func (_u *UserUpdateOne) RemoveSamlAccountIDs(ids ...uint64) *UserUpdateOne {
+	_u.mutation.RemoveSamlAccountIDs(ids...)
+	return _u
+}
+
+// RemoveSamlAccounts removes "saml_accounts" edges to SamlAccount entities.
+func (_u *UserUpdateOne) RemoveSamlAccounts(v ...*SamlAccount) *UserUpdateOne {
+	ids := make([]uint64, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSamlAccountIDs(ids...)
+}
+
+// ClearLdapAccounts clears all "ldap_accounts" edges to the LdapAccount entity.
+func (_u *UserUpdateOne) ClearLdapAccounts() *UserUpdateOne {
+	_u.mutation.ClearLdapAccounts()
+	return _u
+}
+
+// RemoveLdapAccountIDs removes the "ldap_accounts" edge to LdapAccount entities by IDs.
+func (_u *UserUpdateOne) RemoveLdapAccountIDs(ids ...uint64) *UserUpdateOne {
+	_u.mutation.RemoveLdapAccountIDs(ids...)
+	return _u
+}
+
+// RemoveLdapAccounts removes "ldap_accounts" edges to LdapAccount entities.
+func (_u *UserUpdateOne) RemoveLdapAccounts(v ...*LdapAccount) *UserUpdateOne {
+	ids := make([]uint64, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveLdapAccountIDs(ids...)
+}
+
 // Where appends a list predicates to the UserUpdate builder.
 func (_u *UserUpdateOne) Where(ps ...predicate.User) *UserUpdateOne {
 	_u.mutation.Where(ps...)
@@ -1428,6 +1664,96 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.SamlAccountsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SamlAccountsTable,
+			Columns: []string{user.SamlAccountsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(samlaccount.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSamlAccountsIDs(); len(nodes) > 0 && !_u.mutation.SamlAccountsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SamlAccountsTable,
+			Columns: []string{user.SamlAccountsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(samlaccount.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SamlAccountsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SamlAccountsTable,
+			Columns: []string{user.SamlAccountsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(samlaccount.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.LdapAccountsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.LdapAccountsTable,
+			Columns: []string{user.LdapAccountsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ldapaccount.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedLdapAccountsIDs(); len(nodes) > 0 && !_u.mutation.LdapAccountsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.LdapAccountsTable,
+			Columns: []string{user.LdapAccountsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ldapaccount.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LdapAccountsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.LdapAccountsTable,
+			Columns: []string{user.LdapAccountsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ldapaccount.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	_node = &User{config: _u.config}
 	_spec.Assign = _node.assignValues
@@ -2,15 +2,24 @@ package adapters
 
 import (
 	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
 
 	"golang.org/x/oauth2"
 
 	"github.com/coder-lulu/newbee-core/rpc/internal/interfaces"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/claimmap"
 )
 
-// CustomAdapter implements OAuth adapter for generic OAuth2 providers
+// CustomAdapter implements OAuth adapter for generic OAuth2 providers.
+// The userinfo response is mapped by the claim mapping rules in extra_config, see claimmap.ConfigKey.
 type CustomAdapter struct {
 	*BaseOAuthAdapter
+	mapping *claimmap.Mapping
 }
 
 // NewCustomAdapter creates a new Custom OAuth adapter
@@ -39,7 +48,7 @@ func (c *CustomAdapter) GetDefaultScopes() []string {
 func (c *CustomAdapter) SupportsFeature(feature interfaces.OAuthFeature) bool {
 	switch feature {
 	case interfaces.FeaturePKCE:
-		return true
+		return c.config != nil && c.config.SupportPKCE
 	case interfaces.FeatureRefreshToken:
 		return true
 	case interfaces.FeatureTokenRevoke:
@@ -75,16 +84,92 @@ func (c *CustomAdapter) Configure(config *interfaces.OAuthProviderConfig) error
 		}
 	}
 
+	mapping, err := claimmap.FromExtraConfig(config.ExtraConfig)
+	if err != nil {
+		return &interfaces.OAuthError{
+			Type:        interfaces.ErrorTypeConfigurationError,
+			Description: fmt.Sprintf("invalid claim mapping: %v", err),
+			Cause:       err,
+		}
+	}
+	c.mapping = mapping
+
 	return c.BaseOAuthAdapter.Configure(config)
 }
 
-// GetUserInfo retrieves user information using the access token
+// GetUserInfo retrieves user information using the access token and maps it by the claim mapping rules
 func (c *CustomAdapter) GetUserInfo(ctx context.Context, token *oauth2.Token) (*interfaces.OAuthUserInfo, error) {
-	// TODO: Implement generic OAuth2 user info retrieval
-	return nil, &interfaces.OAuthError{
-		Type:        interfaces.ErrorTypeUnsupportedResponse,
-		Description: "Custom adapter requires specific implementation for user info endpoint",
+	if c.config == nil {
+		return nil, &interfaces.OAuthError{
+			Type:        interfaces.ErrorTypeConfigurationError,
+			Description: "adapter not configured",
+		}
+	}
+
+	// 兼容旧配置：AuthStyle 为 1 时令牌通过用户信息地址中的 TOKEN 关键字传递
+	infoURL := c.config.UserInfoURL
+	inURL := c.config.AuthStyle == oauth2.AuthStyleInParams && strings.Contains(infoURL, "TOKEN")
+	if inURL {
+		infoURL = strings.ReplaceAll(infoURL, "TOKEN", url.QueryEscape(token.AccessToken))
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", infoURL, nil)
+	if err != nil {
+		return nil, &interfaces.OAuthError{
+			Type:        interfaces.ErrorTypeNetworkError,
+			Description: fmt.Sprintf("failed to create request: %v", err),
+			Cause:       err,
+		}
+	}
+
+	if !inURL {
+		req.Header.Set("Authorization", "Bearer "+token.AccessToken)
 	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, &interfaces.OAuthError{
+			Type:        interfaces.ErrorTypeNetworkError,
+			Description: fmt.Sprintf("failed to fetch user info: %v", err),
+			Cause:       err,
+		}
+	}
+	defer resp.Body.Close()
+
+	if err := c.parseErrorResponse(resp); err != nil {
+		return nil, err
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, &interfaces.OAuthError{
+			Type:        interfaces.ErrorTypeProviderError,
+			Description: fmt.Sprintf("failed to read response: %v", err),
+			Cause:       err,
+		}
+	}
+
+	result, err := c.mapping.Map(body)
+	if err != nil {
+		return nil, &interfaces.OAuthError{
+			Type:        interfaces.ErrorTypeProviderError,
+			Description: fmt.Sprintf("failed to parse user info: %v", err),
+			Cause:       err,
+		}
+	}
+
+	if result.ID == "" {
+		return nil, &interfaces.OAuthError{
+			Type:        interfaces.ErrorTypeProviderError,
+			Description: "user ID not found in user info, check the id rule of the claim mapping",
+		}
+	}
+
+	result.ProviderType = interfaces.ProviderTypeCustom
+	result.UpdatedAt = time.Now()
+
+	return result, nil
 }
 
 // GetSupportedScopes returns the scopes supported by the custom provider
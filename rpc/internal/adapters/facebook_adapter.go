@@ -2,10 +2,15 @@ package adapters
 
 import (
 	"context"
+	"crypto/hmac"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
 	"time"
 
 	"golang.org/x/oauth2"
@@ -211,4 +216,60 @@ func (f *FacebookAdapter) SupportsFeature(feature interfaces.OAuthFeature) bool
 	default:
 		return f.BaseOAuthAdapter.SupportsFeature(feature)
 	}
-}
\ No newline at end of file
+}
+// facebookSignedRequest is the payload of the signed_request sent to the deauthorize and data deletion callbacks
+type facebookSignedRequest struct {
+	Algorithm string `json:"algorithm"`
+	IssuedAt  int64  `json:"issued_at"`
+	UserID    string `json:"user_id"`
+}
+
+// ParseWebhook verifies the signed_request of the deauthorize callback. It is signed with the app secret,
+// a separate webhook secret is used when configured.
+func (f *FacebookAdapter) ParseWebhook(ctx context.Context, headers map[string]string, body []byte) (*interfaces.WebhookEvent, error) {
+	secret := f.extraString(WebhookSecretKey)
+	if secret == "" && f.config != nil {
+		secret = f.config.ClientSecret
+	}
+	if secret == "" {
+		return nil, ErrWebhookNotConfigured
+	}
+
+	form, err := url.ParseQuery(string(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse Facebook webhook: %w", err)
+	}
+
+	encodedSig, encodedPayload, ok := strings.Cut(form.Get("signed_request"), ".")
+	if !ok {
+		return nil, ErrInvalidWebhookSignature
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(encodedSig)
+	if err != nil || !hmac.Equal(signature, hmacSHA256(secret, []byte(encodedPayload))) {
+		return nil, ErrInvalidWebhookSignature
+	}
+
+	rawPayload, err := base64.RawURLEncoding.DecodeString(encodedPayload)
+	if err != nil {
+		return nil, ErrInvalidWebhookSignature
+	}
+	var payload facebookSignedRequest
+	if err := json.Unmarshal(rawPayload, &payload); err != nil {
+		return nil, fmt.Errorf("failed to parse Facebook webhook: %w", err)
+	}
+	if !strings.EqualFold(payload.Algorithm, "HMAC-SHA256") {
+		return nil, ErrInvalidWebhookSignature
+	}
+
+	event := &interfaces.WebhookEvent{
+		ID:             webhookEventID("facebook", payload.UserID, strconv.FormatInt(payload.IssuedAt, 10)),
+		Type:           interfaces.WebhookEventDeauthorized,
+		Name:           "deauthorize",
+		ProviderUserID: payload.UserID,
+	}
+	if payload.UserID == "" {
+		event.Type = interfaces.WebhookEventIgnored
+	}
+
+	return event, nil
+}
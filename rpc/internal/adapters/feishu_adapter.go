@@ -2,7 +2,16 @@ package adapters
 
 import (
 	"context"
+	"crypto/hmac"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
 
+	lark "github.com/larksuite/oapi-sdk-go/v3"
+	larkcore "github.com/larksuite/oapi-sdk-go/v3/core"
+	larkevent "github.com/larksuite/oapi-sdk-go/v3/event"
+	larkauthen "github.com/larksuite/oapi-sdk-go/v3/service/authen/v1"
 	"golang.org/x/oauth2"
 
 	"github.com/coder-lulu/newbee-core/rpc/internal/interfaces"
@@ -61,9 +70,50 @@ func (f *FeishuAdapter) GetAuthorizationURL(ctx context.Context, session *interf
 	return f.BaseOAuthAdapter.GetAuthorizationURL(ctx, session)
 }
 
-// ExchangeCodeForToken exchanges authorization code for access token
+// ExchangeCodeForToken exchanges the authorization code for a user_access_token with the OIDC API of Feishu
 func (f *FeishuAdapter) ExchangeCodeForToken(ctx context.Context, code string, session *interfaces.OAuthSession) (*oauth2.Token, error) {
-	return f.BaseOAuthAdapter.ExchangeCodeForToken(ctx, code, session)
+	if f.config == nil {
+		return nil, &interfaces.OAuthError{
+			Type:        interfaces.ErrorTypeConfigurationError,
+			Description: "adapter not configured",
+		}
+	}
+
+	req := larkauthen.NewCreateOidcAccessTokenReqBuilder().
+		Body(larkauthen.NewCreateOidcAccessTokenReqBodyBuilder().
+			GrantType("authorization_code").
+			Code(code).
+			Build()).
+		Build()
+
+	resp, err := f.client().Authen.OidcAccessToken.Create(ctx, req)
+	if err != nil {
+		return nil, &interfaces.OAuthError{
+			Type:        interfaces.ErrorTypeNetworkError,
+			Description: fmt.Sprintf("failed to exchange code for token: %v", err),
+			Provider:    string(interfaces.ProviderTypeFeishu),
+			Cause:       err,
+		}
+	}
+	if !resp.Success() || resp.Data == nil || resp.Data.AccessToken == nil {
+		return nil, &interfaces.OAuthError{
+			Type:        interfaces.ErrorTypeProviderError,
+			Code:        strconv.Itoa(resp.Code),
+			Description: fmt.Sprintf("failed to exchange code for token: %s", resp.Msg),
+			Provider:    string(interfaces.ProviderTypeFeishu),
+		}
+	}
+
+	token := &oauth2.Token{
+		AccessToken:  *resp.Data.AccessToken,
+		RefreshToken: larkcore.StringValue(resp.Data.RefreshToken),
+		TokenType:    larkcore.StringValue(resp.Data.TokenType),
+	}
+	if resp.Data.ExpiresIn != nil {
+		token.Expiry = time.Now().Add(time.Duration(*resp.Data.ExpiresIn) * time.Second)
+	}
+
+	return token, nil
 }
 
 // Configure sets up the adapter with provider configuration
@@ -78,13 +128,54 @@ func (f *FeishuAdapter) Configure(config *interfaces.OAuthProviderConfig) error
 	return f.BaseOAuthAdapter.Configure(config)
 }
 
-// GetUserInfo retrieves user information using the access token
+// GetUserInfo retrieves user information using the access token, the open_id is used as the user ID
 func (f *FeishuAdapter) GetUserInfo(ctx context.Context, token *oauth2.Token) (*interfaces.OAuthUserInfo, error) {
-	// TODO: Implement Feishu user info retrieval
-	return nil, &interfaces.OAuthError{
-		Type:        interfaces.ErrorTypeUnsupportedResponse,
-		Description: "Feishu adapter not fully implemented yet",
+	if f.config == nil {
+		return nil, &interfaces.OAuthError{
+			Type:        interfaces.ErrorTypeConfigurationError,
+			Description: "adapter not configured",
+		}
 	}
+
+	resp, err := f.client().Authen.UserInfo.Get(ctx, larkcore.WithUserAccessToken(token.AccessToken))
+	if err != nil {
+		return nil, &interfaces.OAuthError{
+			Type:        interfaces.ErrorTypeNetworkError,
+			Description: fmt.Sprintf("failed to fetch user info: %v", err),
+			Provider:    string(interfaces.ProviderTypeFeishu),
+			Cause:       err,
+		}
+	}
+	if !resp.Success() || resp.Data == nil || resp.Data.OpenId == nil {
+		return nil, &interfaces.OAuthError{
+			Type:        interfaces.ErrorTypeProviderError,
+			Code:        strconv.Itoa(resp.Code),
+			Description: fmt.Sprintf("failed to fetch user info: %s", resp.Msg),
+			Provider:    string(interfaces.ProviderTypeFeishu),
+		}
+	}
+
+	return &interfaces.OAuthUserInfo{
+		ID:           *resp.Data.OpenId,
+		Username:     larkcore.StringValue(resp.Data.EnName),
+		Nickname:     larkcore.StringValue(resp.Data.Name),
+		Email:        larkcore.StringValue(resp.Data.Email),
+		Avatar:       larkcore.StringValue(resp.Data.AvatarUrl),
+		PhoneNumber:  larkcore.StringValue(resp.Data.Mobile),
+		ProviderType: interfaces.ProviderTypeFeishu,
+		RawData: map[string]interface{}{
+			"open_id":   *resp.Data.OpenId,
+			"union_id":  larkcore.StringValue(resp.Data.UnionId),
+			"user_id":   larkcore.StringValue(resp.Data.UserId),
+			"tenant_id": larkcore.StringValue(resp.Data.TenantKey),
+		},
+		UpdatedAt: time.Now(),
+	}, nil
+}
+
+// client creates a Feishu client with the app credentials
+func (f *FeishuAdapter) client() *lark.Client {
+	return lark.NewClient(f.config.ClientID, f.config.ClientSecret, lark.WithHttpClient(f.httpClient))
 }
 
 // GetSupportedScopes returns the scopes supported by Feishu
@@ -118,12 +209,157 @@ func (f *FeishuAdapter) ValidateConfig(config *interfaces.OAuthProviderConfig) e
 	return nil
 }
 
-// RefreshToken refreshes an expired access token using the refresh token
+// RefreshToken refreshes the user_access_token with the OIDC refresh API of Feishu,
+// the token endpoint of Feishu does not follow RFC 6749 so the generic refresh cannot be used
 func (f *FeishuAdapter) RefreshToken(ctx context.Context, refreshToken string) (*oauth2.Token, error) {
-	return f.BaseOAuthAdapter.RefreshToken(ctx, refreshToken)
+	if f.config == nil {
+		return nil, &interfaces.OAuthError{
+			Type:        interfaces.ErrorTypeConfigurationError,
+			Description: "adapter not configured",
+		}
+	}
+
+	req := larkauthen.NewCreateOidcRefreshAccessTokenReqBuilder().
+		Body(larkauthen.NewCreateOidcRefreshAccessTokenReqBodyBuilder().
+			GrantType("refresh_token").
+			RefreshToken(refreshToken).
+			Build()).
+		Build()
+
+	resp, err := f.client().Authen.OidcRefreshAccessToken.Create(ctx, req)
+	if err != nil {
+		return nil, &interfaces.OAuthError{
+			Type:        interfaces.ErrorTypeNetworkError,
+			Description: fmt.Sprintf("failed to refresh token: %v", err),
+			Provider:    string(interfaces.ProviderTypeFeishu),
+			Cause:       err,
+		}
+	}
+	if !resp.Success() || resp.Data == nil || resp.Data.AccessToken == nil {
+		return nil, &interfaces.OAuthError{
+			Type:        interfaces.ErrorTypeProviderError,
+			Code:        strconv.Itoa(resp.Code),
+			Description: fmt.Sprintf("failed to refresh token: %s", resp.Msg),
+			Provider:    string(interfaces.ProviderTypeFeishu),
+		}
+	}
+
+	token := &oauth2.Token{
+		AccessToken:  *resp.Data.AccessToken,
+		RefreshToken: larkcore.StringValue(resp.Data.RefreshToken),
+		TokenType:    larkcore.StringValue(resp.Data.TokenType),
+	}
+	if resp.Data.ExpiresIn != nil {
+		token.Expiry = time.Now().Add(time.Duration(*resp.Data.ExpiresIn) * time.Second)
+	}
+
+	return token, nil
 }
 
 // RevokeToken revokes an access token
 func (f *FeishuAdapter) RevokeToken(ctx context.Context, token *oauth2.Token) error {
 	return f.BaseOAuthAdapter.RevokeToken(ctx, token)
-}
\ No newline at end of file
+}
+// feishuWebhookPayload is the part of Feishu event callbacks (schema 2.0) used by core
+type feishuWebhookPayload struct {
+	Encrypt   string `json:"encrypt"`
+	Type      string `json:"type"`
+	Token     string `json:"token"`
+	Challenge string `json:"challenge"`
+	Header    struct {
+		EventID   string `json:"event_id"`
+		EventType string `json:"event_type"`
+		Token     string `json:"token"`
+	} `json:"header"`
+	Event struct {
+		Object struct {
+			OpenID string `json:"open_id"`
+			Name   string `json:"name"`
+			Email  string `json:"email"`
+			Mobile string `json:"mobile"`
+			Avatar struct {
+				Avatar240 string `json:"avatar_240"`
+			} `json:"avatar"`
+		} `json:"object"`
+	} `json:"event"`
+}
+
+// ParseWebhook decrypts and verifies a Feishu event callback. The encrypt key is the webhook secret and
+// the verification token is required, url_verification requests are answered with the challenge.
+func (f *FeishuAdapter) ParseWebhook(ctx context.Context, headers map[string]string, body []byte) (*interfaces.WebhookEvent, error) {
+	encryptKey := f.extraString(WebhookSecretKey)
+	token := f.extraString(WebhookTokenKey)
+	if encryptKey == "" || token == "" {
+		return nil, ErrWebhookNotConfigured
+	}
+
+	var payload feishuWebhookPayload
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return nil, fmt.Errorf("failed to parse Feishu webhook: %w", err)
+	}
+
+	if payload.Encrypt != "" {
+		// url_verification 请求不带签名头，其余事件需校验签名
+		if signature := headers["x-lark-signature"]; signature != "" {
+			expected := larkevent.Signature(headers["x-lark-request-timestamp"], headers["x-lark-request-nonce"], encryptKey, string(body))
+			if !hmac.Equal([]byte(signature), []byte(expected)) {
+				return nil, ErrInvalidWebhookSignature
+			}
+		}
+
+		plain, err := larkevent.EventDecrypt(payload.Encrypt, encryptKey)
+		if err != nil {
+			return nil, ErrInvalidWebhookSignature
+		}
+		payload = feishuWebhookPayload{}
+		if err := json.Unmarshal(plain, &payload); err != nil {
+			return nil, fmt.Errorf("failed to parse Feishu webhook: %w", err)
+		}
+	}
+
+	if payload.Type == "url_verification" {
+		if payload.Token != token {
+			return nil, ErrInvalidWebhookSignature
+		}
+		return &interfaces.WebhookEvent{
+			Type:      interfaces.WebhookEventVerification,
+			Name:      payload.Type,
+			Challenge: payload.Challenge,
+		}, nil
+	}
+
+	if payload.Header.Token != token {
+		return nil, ErrInvalidWebhookSignature
+	}
+
+	object := payload.Event.Object
+	event := &interfaces.WebhookEvent{
+		ID:             payload.Header.EventID,
+		Type:           interfaces.WebhookEventIgnored,
+		Name:           payload.Header.EventType,
+		ProviderUserID: object.OpenID,
+	}
+	if event.ID == "" {
+		event.ID = webhookEventID(string(body))
+	}
+
+	switch event.Name {
+	case "contact.user.deleted_v3":
+		event.Type = interfaces.WebhookEventAccountDeleted
+	case "contact.user.updated_v3":
+		event.Type = interfaces.WebhookEventProfileUpdated
+		event.UserInfo = &interfaces.OAuthUserInfo{
+			ID:           object.OpenID,
+			Nickname:     object.Name,
+			Email:        object.Email,
+			Avatar:       object.Avatar.Avatar240,
+			PhoneNumber:  object.Mobile,
+			ProviderType: interfaces.ProviderTypeFeishu,
+		}
+	}
+	if event.ProviderUserID == "" {
+		event.Type = interfaces.WebhookEventIgnored
+	}
+
+	return event, nil
+}
@@ -1,11 +1,16 @@
 package adapters
 
 import (
+	"bytes"
 	"context"
+	"crypto/hmac"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
+	"strconv"
+	"strings"
 	"time"
 
 	"golang.org/x/oauth2"
@@ -263,7 +268,12 @@ func (g *GitHubAdapter) RevokeToken(ctx context.Context, token *oauth2.Token) er
 
 	revokeURL := fmt.Sprintf("https://api.github.com/applications/%s/token", g.config.ClientID)
 	
-	req, err := http.NewRequestWithContext(ctx, "DELETE", revokeURL, nil)
+	body, err := json.Marshal(map[string]string{"access_token": token.AccessToken})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "DELETE", revokeURL, bytes.NewReader(body))
 	if err != nil {
 		return &interfaces.OAuthError{
 			Type:        interfaces.ErrorTypeNetworkError,
@@ -276,7 +286,6 @@ func (g *GitHubAdapter) RevokeToken(ctx context.Context, token *oauth2.Token) er
 	req.Header.Set("Accept", "application/vnd.github.v3+json")
 	req.Header.Set("User-Agent", "OAuth-Client/1.0")
 
-	// Set the token in the request body
 	req.Header.Set("Content-Type", "application/json")
 
 	resp, err := g.httpClient.Do(req)
@@ -349,4 +358,55 @@ func (g *GitHubAdapter) SupportsFeature(feature interfaces.OAuthFeature) bool {
 	default:
 		return g.BaseOAuthAdapter.SupportsFeature(feature)
 	}
-}
\ No newline at end of file
+}
+// gitHubWebhookPayload is the part of GitHub webhook payloads used by core
+type gitHubWebhookPayload struct {
+	Action string `json:"action"`
+	Sender struct {
+		ID        int64  `json:"id"`
+		Login     string `json:"login"`
+		AvatarURL string `json:"avatar_url"`
+	} `json:"sender"`
+}
+
+// ParseWebhook verifies the X-Hub-Signature-256 header with the webhook secret of the GitHub App and parses
+// the event. github_app_authorization.revoked is sent when a user revokes the authorization of the app.
+func (g *GitHubAdapter) ParseWebhook(ctx context.Context, headers map[string]string, body []byte) (*interfaces.WebhookEvent, error) {
+	secret := g.extraString(WebhookSecretKey)
+	if secret == "" {
+		return nil, ErrWebhookNotConfigured
+	}
+
+	signature, ok := strings.CutPrefix(headers["x-hub-signature-256"], "sha256=")
+	if !ok {
+		return nil, ErrInvalidWebhookSignature
+	}
+	expected := hex.EncodeToString(hmacSHA256(secret, body))
+	if !hmac.Equal([]byte(signature), []byte(expected)) {
+		return nil, ErrInvalidWebhookSignature
+	}
+
+	var payload gitHubWebhookPayload
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return nil, fmt.Errorf("failed to parse GitHub webhook: %w", err)
+	}
+
+	event := &interfaces.WebhookEvent{
+		ID:   headers["x-github-delivery"],
+		Type: interfaces.WebhookEventIgnored,
+		Name: headers["x-github-event"],
+	}
+	if event.ID == "" {
+		event.ID = webhookEventID(string(body))
+	}
+	if payload.Action != "" {
+		event.Name += "." + payload.Action
+	}
+
+	if event.Name == "github_app_authorization.revoked" && payload.Sender.ID != 0 {
+		event.Type = interfaces.WebhookEventDeauthorized
+		event.ProviderUserID = strconv.FormatInt(payload.Sender.ID, 10)
+	}
+
+	return event, nil
+}
@@ -119,6 +119,11 @@ func (f *DefaultOAuthAdapterFactory) registerBuiltinAdapters() {
 		return NewFeishuAdapter()
 	}
 
+	// Register OpenID Connect adapter (for Keycloak, Authing, Okta, Azure AD, etc.)
+	f.adapters[interfaces.ProviderTypeOIDC] = func() interfaces.OAuthAdapter {
+		return NewOIDCAdapter()
+	}
+
 	// Register Custom adapter (for generic OAuth2 providers)
 	f.adapters[interfaces.ProviderTypeCustom] = func() interfaces.OAuthAdapter {
 		return NewCustomAdapter()
@@ -206,6 +211,17 @@ func GetAdapterConfiguration(providerType interfaces.OAuthProviderType) *interfa
 			Enabled:     true,
 		}
 
+	case interfaces.ProviderTypeOIDC:
+		// 端点由签发方的发现文档提供
+		return &interfaces.OAuthProviderConfig{
+			Type:        interfaces.ProviderTypeOIDC,
+			DisplayName: "OpenID Connect",
+			Scopes:      []string{"openid", "profile", "email"},
+			AuthStyle:   0, // AuthStyleAutoDetect
+			SupportPKCE: true,
+			Enabled:     true,
+		}
+
 	default:
 		return &interfaces.OAuthProviderConfig{
 			Type:        interfaces.ProviderTypeCustom,
@@ -0,0 +1,577 @@
+package adapters
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"golang.org/x/oauth2"
+
+	"github.com/coder-lulu/newbee-core/rpc/internal/interfaces"
+)
+
+const (
+	// OIDCIssuerKey is the extra config key of the issuer URL
+	OIDCIssuerKey = "issuer"
+	// OIDCAuthParamsKey is the extra config key of the additional authorization request parameters, e.g. prompt, acr_values
+	OIDCAuthParamsKey = "auth_params"
+	// OIDCNonceKey is the session extra data key of the nonce
+	OIDCNonceKey = "nonce"
+
+	// oidcClaimsKey 校验通过的 id_token 声明在 token 附加数据中的键
+	oidcClaimsKey = "id_token_claims"
+	// oidcClockSkew 允许的时钟偏差
+	oidcClockSkew = time.Minute
+)
+
+// oidcSigningAlgs 允许的 id_token 签名算法，不接受 none 和对称算法
+var oidcSigningAlgs = []string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512", "EdDSA"}
+
+// OIDCAdapter implements OAuth adapter for generic OpenID Connect providers such as Keycloak, Authing, Okta and Azure AD.
+// Endpoints are resolved from the discovery document of the issuer, only issuer, client ID and secret are required.
+type OIDCAdapter struct {
+	*BaseOAuthAdapter
+}
+
+// NewOIDCAdapter creates a new OpenID Connect adapter
+func NewOIDCAdapter() interfaces.OAuthAdapter {
+	return &OIDCAdapter{
+		BaseOAuthAdapter: NewBaseOAuthAdapter(),
+	}
+}
+
+// GetProviderType returns the provider type
+func (o *OIDCAdapter) GetProviderType() interfaces.OAuthProviderType {
+	return interfaces.ProviderTypeOIDC
+}
+
+// GetProviderName returns the human-readable name of this provider
+func (o *OIDCAdapter) GetProviderName() string {
+	return "OpenID Connect"
+}
+
+// ValidateConfig validates the OIDC provider configuration
+func (o *OIDCAdapter) ValidateConfig(config *interfaces.OAuthProviderConfig) error {
+	if config == nil {
+		return &interfaces.OAuthError{
+			Type:        interfaces.ErrorTypeConfigurationError,
+			Description: "configuration cannot be nil",
+		}
+	}
+
+	if config.ClientID == "" {
+		return &interfaces.OAuthError{
+			Type:        interfaces.ErrorTypeConfigurationError,
+			Description: "client_id is required",
+		}
+	}
+
+	if config.ClientSecret == "" {
+		return &interfaces.OAuthError{
+			Type:        interfaces.ErrorTypeConfigurationError,
+			Description: "client_secret is required",
+		}
+	}
+
+	if config.RedirectURL == "" {
+		return &interfaces.OAuthError{
+			Type:        interfaces.ErrorTypeConfigurationError,
+			Description: "redirect_url is required",
+		}
+	}
+
+	issuer := OIDCIssuer(config)
+	if issuer == "" {
+		return &interfaces.OAuthError{
+			Type:        interfaces.ErrorTypeConfigurationError,
+			Description: "issuer is required for OIDC provider",
+		}
+	}
+
+	u, err := url.Parse(issuer)
+	if err != nil || u.Host == "" {
+		return &interfaces.OAuthError{
+			Type:        interfaces.ErrorTypeConfigurationError,
+			Description: fmt.Sprintf("invalid issuer: %s", issuer),
+		}
+	}
+
+	// 签发方决定了信任的签名密钥，只允许本机调试时使用 http
+	if u.Scheme != "https" && !(u.Scheme == "http" && isLoopback(u.Hostname())) {
+		return &interfaces.OAuthError{
+			Type:        interfaces.ErrorTypeConfigurationError,
+			Description: "issuer must use https",
+		}
+	}
+
+	return nil
+}
+
+// Configure sets up the adapter with the given configuration, endpoints are discovered on first use
+func (o *OIDCAdapter) Configure(config *interfaces.OAuthProviderConfig) error {
+	if config.Type != interfaces.ProviderTypeOIDC {
+		return &interfaces.OAuthError{
+			Type:        interfaces.ErrorTypeConfigurationError,
+			Description: "invalid provider type for OIDC adapter",
+		}
+	}
+
+	if err := o.ValidateConfig(config); err != nil {
+		return err
+	}
+
+	if len(config.Scopes) == 0 {
+		config.Scopes = o.GetDefaultScopes()
+	} else if !slices.Contains(config.Scopes, "openid") {
+		config.Scopes = append([]string{"openid"}, config.Scopes...)
+	}
+
+	o.config = config
+	return nil
+}
+
+// Discover returns the discovery document of the configured issuer
+func (o *OIDCAdapter) Discover(ctx context.Context) (*OIDCDiscovery, error) {
+	if o.config == nil {
+		return nil, &interfaces.OAuthError{
+			Type:        interfaces.ErrorTypeConfigurationError,
+			Description: "adapter not configured",
+		}
+	}
+
+	issuer := OIDCIssuer(o.config)
+	return getOIDCIssuer(issuer).Discover(ctx, o.httpClient, issuer)
+}
+
+// oauth2Config builds the oauth2 config from the discovered endpoints
+func (o *OIDCAdapter) oauth2Config(ctx context.Context) (*oauth2.Config, *OIDCDiscovery, error) {
+	discovery, err := o.Discover(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &oauth2.Config{
+		ClientID:     o.config.ClientID,
+		ClientSecret: o.config.ClientSecret,
+		RedirectURL:  o.config.RedirectURL,
+		Scopes:       o.config.Scopes,
+		Endpoint: oauth2.Endpoint{
+			AuthURL:   discovery.AuthorizationEndpoint,
+			TokenURL:  discovery.TokenEndpoint,
+			AuthStyle: o.config.AuthStyle,
+		},
+	}, discovery, nil
+}
+
+// GetAuthorizationURL generates the authorization URL, the session must carry the nonce bound to the state
+func (o *OIDCAdapter) GetAuthorizationURL(ctx context.Context, session *interfaces.OAuthSession) (string, error) {
+	conf, _, err := o.oauth2Config(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	nonce := session.ExtraData[OIDCNonceKey]
+	if nonce == "" {
+		return "", &interfaces.OAuthError{
+			Type:        interfaces.ErrorTypeInvalidRequest,
+			Description: "nonce is required for OIDC authorization",
+		}
+	}
+
+	opts := []oauth2.AuthCodeOption{oauth2.SetAuthURLParam("nonce", nonce)}
+
+	if o.config.SupportPKCE && session.CodeVerifier != "" {
+		opts = append(opts, oauth2.S256ChallengeOption(session.CodeVerifier))
+	}
+
+	if params, ok := o.config.ExtraConfig[OIDCAuthParamsKey].(map[string]interface{}); ok {
+		for key, value := range params {
+			if strValue, ok := value.(string); ok {
+				opts = append(opts, oauth2.SetAuthURLParam(key, strValue))
+			}
+		}
+	}
+
+	return conf.AuthCodeURL(session.State, opts...), nil
+}
+
+// ExchangeCodeForToken exchanges the authorization code and validates the returned id_token against the session nonce.
+// The verified claims are attached to the token for GetUserInfo.
+func (o *OIDCAdapter) ExchangeCodeForToken(ctx context.Context, code string, session *interfaces.OAuthSession) (*oauth2.Token, error) {
+	conf, _, err := o.oauth2Config(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := session.ExtraData[OIDCNonceKey]
+	if nonce == "" {
+		return nil, &interfaces.OAuthError{
+			Type:        interfaces.ErrorTypeInvalidRequest,
+			Description: "nonce of the session is missing",
+		}
+	}
+
+	opts := make([]oauth2.AuthCodeOption, 0)
+	if o.config.SupportPKCE && session.CodeVerifier != "" {
+		opts = append(opts, oauth2.VerifierOption(session.CodeVerifier))
+	}
+
+	token, err := conf.Exchange(context.WithValue(ctx, oauth2.HTTPClient, o.httpClient), code, opts...)
+	if err != nil {
+		return nil, &interfaces.OAuthError{
+			Type:        interfaces.ErrorTypeProviderError,
+			Description: fmt.Sprintf("failed to exchange code for token: %v", err),
+			Cause:       err,
+		}
+	}
+
+	rawIDToken, _ := token.Extra("id_token").(string)
+	if rawIDToken == "" {
+		return nil, &interfaces.OAuthError{
+			Type:        interfaces.ErrorTypeInvalidToken,
+			Description: "id_token is missing in the token response",
+		}
+	}
+
+	claims, err := o.VerifyIDToken(ctx, rawIDToken, nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	return token.WithExtra(map[string]interface{}{
+		"id_token":    rawIDToken,
+		"scope":       token.Extra("scope"),
+		oidcClaimsKey: claims,
+	}), nil
+}
+
+// VerifyIDToken validates the signature, iss, aud, azp, exp, iat and nonce of the id_token and returns its claims
+func (o *OIDCAdapter) VerifyIDToken(ctx context.Context, rawIDToken, nonce string) (jwt.MapClaims, error) {
+	discovery, err := o.Discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	algs := oidcSigningAlgs
+	if len(discovery.SigningAlgs) > 0 {
+		algs = slices.DeleteFunc(slices.Clone(discovery.SigningAlgs), func(alg string) bool {
+			return !slices.Contains(oidcSigningAlgs, alg)
+		})
+	}
+
+	issuer := getOIDCIssuer(discovery.Issuer)
+	claims := jwt.MapClaims{}
+	_, err = jwt.ParseWithClaims(rawIDToken, claims, func(t *jwt.Token) (interface{}, error) {
+		kid, _ := t.Header["kid"].(string)
+		return issuer.Key(ctx, o.httpClient, discovery.JWKSURI, kid)
+	},
+		jwt.WithValidMethods(algs),
+		jwt.WithIssuer(discovery.Issuer),
+		jwt.WithAudience(o.config.ClientID),
+		jwt.WithExpirationRequired(),
+		jwt.WithIssuedAt(),
+		jwt.WithLeeway(oidcClockSkew),
+	)
+	if err != nil {
+		return nil, &interfaces.OAuthError{
+			Type:        interfaces.ErrorTypeInvalidToken,
+			Description: fmt.Sprintf("invalid id_token: %v", err),
+			Cause:       err,
+		}
+	}
+
+	// OpenID Connect Core 1.0 §3.1.3.7: 多个受众时 azp 必须存在，存在时必须是本客户端
+	aud, _ := claims.GetAudience()
+	azp, _ := claims["azp"].(string)
+	if (len(aud) > 1 || azp != "") && azp != o.config.ClientID {
+		return nil, &interfaces.OAuthError{
+			Type:        interfaces.ErrorTypeInvalidToken,
+			Description: "invalid id_token: authorized party mismatch",
+		}
+	}
+
+	// WithIssuedAt 只在 iat 存在时校验，OpenID Connect Core 1.0 §2 要求 iat 必须存在
+	if iat, _ := claims.GetIssuedAt(); iat == nil {
+		return nil, &interfaces.OAuthError{
+			Type:        interfaces.ErrorTypeInvalidToken,
+			Description: "invalid id_token: iat is missing",
+		}
+	}
+
+	tokenNonce, _ := claims["nonce"].(string)
+	if tokenNonce == "" || subtle.ConstantTimeCompare([]byte(tokenNonce), []byte(nonce)) != 1 {
+		return nil, &interfaces.OAuthError{
+			Type:        interfaces.ErrorTypeInvalidToken,
+			Description: "invalid id_token: nonce mismatch",
+		}
+	}
+
+	if sub, _ := claims.GetSubject(); sub == "" {
+		return nil, &interfaces.OAuthError{
+			Type:        interfaces.ErrorTypeInvalidToken,
+			Description: "invalid id_token: sub is missing",
+		}
+	}
+
+	return claims, nil
+}
+
+// GetUserInfo maps the verified id_token claims, completed by the userinfo endpoint, into the standardized user info
+func (o *OIDCAdapter) GetUserInfo(ctx context.Context, token *oauth2.Token) (*interfaces.OAuthUserInfo, error) {
+	claims, ok := token.Extra(oidcClaimsKey).(jwt.MapClaims)
+	if !ok {
+		return nil, &interfaces.OAuthError{
+			Type:        interfaces.ErrorTypeInvalidToken,
+			Description: "id_token of the token has not been verified",
+		}
+	}
+
+	discovery, err := o.Discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if discovery.UserInfoEndpoint != "" && token.AccessToken != "" {
+		extra, err := o.fetchUserInfo(ctx, discovery.UserInfoEndpoint, token)
+		if err != nil {
+			return nil, err
+		}
+
+		// OpenID Connect Core 1.0 §5.3.4: userinfo 的 sub 必须与 id_token 一致，否则丢弃
+		if extra["sub"] != claims["sub"] {
+			return nil, &interfaces.OAuthError{
+				Type:        interfaces.ErrorTypeInvalidToken,
+				Description: "sub of userinfo does not match the id_token",
+			}
+		}
+
+		// id_token 中的声明经过签名，优先使用
+		for k, v := range extra {
+			if _, ok := claims[k]; !ok {
+				claims[k] = v
+			}
+		}
+	}
+
+	return MapOIDCClaims(claims), nil
+}
+
+// fetchUserInfo gets the claims from the userinfo endpoint
+func (o *OIDCAdapter) fetchUserInfo(ctx context.Context, endpoint string, token *oauth2.Token) (map[string]interface{}, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, &interfaces.OAuthError{
+			Type:        interfaces.ErrorTypeNetworkError,
+			Description: fmt.Sprintf("failed to create request: %v", err),
+			Cause:       err,
+		}
+	}
+
+	req.Header.Set("Authorization", "Bearer "+token.AccessToken)
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := o.httpClient.Do(req)
+	if err != nil {
+		return nil, &interfaces.OAuthError{
+			Type:        interfaces.ErrorTypeNetworkError,
+			Description: fmt.Sprintf("failed to fetch user info: %v", err),
+			Cause:       err,
+		}
+	}
+	defer resp.Body.Close()
+
+	if err := o.parseErrorResponse(resp); err != nil {
+		return nil, err
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, oidcMaxResponseSize))
+	if err != nil {
+		return nil, &interfaces.OAuthError{
+			Type:        interfaces.ErrorTypeProviderError,
+			Description: fmt.Sprintf("failed to read response: %v", err),
+			Cause:       err,
+		}
+	}
+
+	// 签名或加密的 userinfo 响应（application/jwt）不支持
+	var info map[string]interface{}
+	if err := json.Unmarshal(body, &info); err != nil {
+		return nil, &interfaces.OAuthError{
+			Type:        interfaces.ErrorTypeProviderError,
+			Description: fmt.Sprintf("failed to parse user info: %v", err),
+			Cause:       err,
+		}
+	}
+
+	return info, nil
+}
+
+// MapOIDCClaims maps the standard claims (OpenID Connect Core 1.0 §5.1) into the standardized user info
+func MapOIDCClaims(claims map[string]interface{}) *interfaces.OAuthUserInfo {
+	str := func(key string) string {
+		v, _ := claims[key].(string)
+		return v
+	}
+
+	info := &interfaces.OAuthUserInfo{
+		ID:           str("sub"),
+		Username:     str("preferred_username"),
+		Nickname:     str("name"),
+		Email:        str("email"),
+		Avatar:       str("picture"),
+		PhoneNumber:  str("phone_number"),
+		FirstName:    str("given_name"),
+		LastName:     str("family_name"),
+		Website:      str("website"),
+		Location:     str("zoneinfo"),
+		ProviderType: interfaces.ProviderTypeOIDC,
+		RawData:      claims,
+	}
+
+	if info.Username == "" {
+		info.Username = info.Email
+	}
+	if info.Nickname == "" {
+		info.Nickname = str("nickname")
+	}
+
+	info.Verified, _ = claims["email_verified"].(bool)
+
+	if v, ok := claims["updated_at"].(float64); ok {
+		info.UpdatedAt = time.Unix(int64(v), 0)
+	}
+
+	return info
+}
+
+// OIDCIssuer returns the issuer URL from the extra config
+func OIDCIssuer(config *interfaces.OAuthProviderConfig) string {
+	issuer, _ := config.ExtraConfig[OIDCIssuerKey].(string)
+	return issuer
+}
+
+// RefreshToken refreshes an access token, the refreshed id_token is not verified again
+func (o *OIDCAdapter) RefreshToken(ctx context.Context, refreshToken string) (*oauth2.Token, error) {
+	conf, _, err := o.oauth2Config(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := conf.TokenSource(context.WithValue(ctx, oauth2.HTTPClient, o.httpClient), &oauth2.Token{
+		RefreshToken: refreshToken,
+	}).Token()
+	if err != nil {
+		return nil, &interfaces.OAuthError{
+			Type:        interfaces.ErrorTypeProviderError,
+			Description: fmt.Sprintf("failed to refresh token: %v", err),
+			Cause:       err,
+		}
+	}
+
+	return token, nil
+}
+
+// RevokeToken revokes the refresh token, or the access token without one, at the revocation endpoint (RFC 7009).
+// Revoking the refresh token also invalidates the access tokens issued with it on most providers.
+func (o *OIDCAdapter) RevokeToken(ctx context.Context, token *oauth2.Token) error {
+	if o.config == nil {
+		return &interfaces.OAuthError{
+			Type:        interfaces.ErrorTypeConfigurationError,
+			Description: "adapter not configured",
+		}
+	}
+
+	discovery, err := o.Discover(ctx)
+	if err != nil {
+		return err
+	}
+	if discovery.RevocationEndpoint == "" {
+		return &interfaces.OAuthError{
+			Type:        interfaces.ErrorTypeUnsupportedResponse,
+			Description: "token revocation not supported by this provider",
+		}
+	}
+
+	form := url.Values{}
+	if token.RefreshToken != "" {
+		form.Set("token", token.RefreshToken)
+		form.Set("token_type_hint", "refresh_token")
+	} else {
+		form.Set("token", token.AccessToken)
+		form.Set("token_type_hint", "access_token")
+	}
+	if o.config.AuthStyle != oauth2.AuthStyleInHeader {
+		form.Set("client_id", o.config.ClientID)
+		form.Set("client_secret", o.config.ClientSecret)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, discovery.RevocationEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return &interfaces.OAuthError{
+			Type:        interfaces.ErrorTypeNetworkError,
+			Description: fmt.Sprintf("failed to create revoke request: %v", err),
+			Cause:       err,
+		}
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	if o.config.AuthStyle == oauth2.AuthStyleInHeader {
+		req.SetBasicAuth(url.QueryEscape(o.config.ClientID), url.QueryEscape(o.config.ClientSecret))
+	}
+
+	resp, err := o.httpClient.Do(req)
+	if err != nil {
+		return &interfaces.OAuthError{
+			Type:        interfaces.ErrorTypeNetworkError,
+			Description: fmt.Sprintf("failed to revoke token: %v", err),
+			Cause:       err,
+		}
+	}
+	defer resp.Body.Close()
+
+	return o.parseErrorResponse(resp)
+}
+
+// GetSupportedScopes returns the standard OIDC scopes
+func (o *OIDCAdapter) GetSupportedScopes() []string {
+	return []string{"openid", "profile", "email", "phone", "address", "offline_access"}
+}
+
+// GetDefaultScopes returns the recommended default scopes
+func (o *OIDCAdapter) GetDefaultScopes() []string {
+	return []string{"openid", "profile", "email"}
+}
+
+// SupportsFeature checks if the provider supports a specific feature
+func (o *OIDCAdapter) SupportsFeature(feature interfaces.OAuthFeature) bool {
+	switch feature {
+	case interfaces.FeatureIDToken:
+		return true
+	case interfaces.FeaturePKCE:
+		return o.config != nil && o.config.SupportPKCE
+	case interfaces.FeatureRefreshToken:
+		return true
+	case interfaces.FeatureUserInfo:
+		return true
+	case interfaces.FeatureEmailScope, interfaces.FeaturePhoneScope, interfaces.FeatureProfileScope:
+		return true
+	default:
+		return false
+	}
+}
+
+func isLoopback(host string) bool {
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
@@ -0,0 +1,292 @@
+package adapters
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	"github.com/coder-lulu/newbee-core/rpc/internal/interfaces"
+)
+
+const (
+	testClientID = "newbee"
+	testKid      = "key-1"
+	testNonce    = "nonce-1"
+	testSubject  = "user-1"
+)
+
+// testIssuer is an OpenID provider serving the discovery document, a JWKS with one RSA key,
+// and a token endpoint returning idToken for the code "code-1"
+type testIssuer struct {
+	*httptest.Server
+	key      *rsa.PrivateKey
+	idToken  string
+	userInfo map[string]any
+}
+
+func newTestIssuer(t *testing.T) *testIssuer {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &testIssuer{key: key, userInfo: map[string]any{"sub": testSubject, "email": "alice@example.com"}}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, map[string]any{
+			"issuer":                                s.URL,
+			"authorization_endpoint":                s.URL + "/authorize",
+			"token_endpoint":                        s.URL + "/token",
+			"userinfo_endpoint":                     s.URL + "/userinfo",
+			"jwks_uri":                              s.URL + "/jwks",
+			"id_token_signing_alg_values_supported": []string{"RS256", "HS256", "none"},
+		})
+	})
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, r *http.Request) {
+		enc := base64.RawURLEncoding
+		writeJSON(w, map[string]any{"keys": []map[string]any{{
+			"kty": "RSA",
+			"kid": testKid,
+			"use": "sig",
+			"alg": "RS256",
+			"n":   enc.EncodeToString(key.N.Bytes()),
+			"e":   enc.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		}}})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		if r.FormValue("code") != "code-1" {
+			w.WriteHeader(http.StatusBadRequest)
+			writeJSON(w, map[string]any{"error": "invalid_grant"})
+			return
+		}
+		writeJSON(w, map[string]any{"access_token": "access-1", "token_type": "Bearer", "expires_in": 3600, "id_token": s.idToken})
+	})
+	mux.HandleFunc("/userinfo", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer access-1" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		writeJSON(w, s.userInfo)
+	})
+
+	s.Server = httptest.NewServer(mux)
+	t.Cleanup(s.Close)
+	return s
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// claims returns the claims of a valid id_token
+func (s *testIssuer) claims() jwt.MapClaims {
+	now := time.Now()
+	return jwt.MapClaims{
+		"iss":   s.URL,
+		"sub":   testSubject,
+		"aud":   testClientID,
+		"exp":   now.Add(5 * time.Minute).Unix(),
+		"iat":   now.Unix(),
+		"nonce": testNonce,
+		"name":  "Alice",
+	}
+}
+
+// sign signs the claims with the key of the issuer
+func (s *testIssuer) sign(t *testing.T, claims jwt.MapClaims) string {
+	t.Helper()
+	return signToken(t, jwt.SigningMethodRS256, s.key, testKid, claims)
+}
+
+func signToken(t *testing.T, method jwt.SigningMethod, key any, kid string, claims jwt.MapClaims) string {
+	t.Helper()
+
+	token := jwt.NewWithClaims(method, claims)
+	if kid != "" {
+		token.Header["kid"] = kid
+	}
+	raw, err := token.SignedString(key)
+	if err != nil {
+		t.Fatalf("sign id_token: %v", err)
+	}
+	return raw
+}
+
+func newTestOIDCAdapter(t *testing.T, issuer string) *OIDCAdapter {
+	t.Helper()
+
+	o := NewOIDCAdapter().(*OIDCAdapter)
+	err := o.Configure(&interfaces.OAuthProviderConfig{
+		Type:         interfaces.ProviderTypeOIDC,
+		ClientID:     testClientID,
+		ClientSecret: "secret",
+		RedirectURL:  "https://app.example.com/callback",
+		ExtraConfig:  map[string]interface{}{OIDCIssuerKey: issuer},
+	})
+	if err != nil {
+		t.Fatalf("Configure: %v", err)
+	}
+	return o
+}
+
+func TestVerifyIDToken(t *testing.T) {
+	s := newTestIssuer(t)
+	o := newTestOIDCAdapter(t, s.URL)
+
+	claims, err := o.VerifyIDToken(context.Background(), s.sign(t, s.claims()), testNonce)
+	if err != nil {
+		t.Fatalf("VerifyIDToken: %v", err)
+	}
+	if claims["sub"] != testSubject || claims["name"] != "Alice" {
+		t.Errorf("claims = %v", claims)
+	}
+
+	// 签发方只发布一个密钥时，不带 kid 的令牌使用该密钥验签
+	if _, err = o.VerifyIDToken(context.Background(), signToken(t, jwt.SigningMethodRS256, s.key, "", s.claims()), testNonce); err != nil {
+		t.Errorf("VerifyIDToken without kid: %v", err)
+	}
+}
+
+func TestVerifyIDTokenRejected(t *testing.T) {
+	s := newTestIssuer(t)
+	o := newTestOIDCAdapter(t, s.URL)
+
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	publicDER, err := x509.MarshalPKIXPublicKey(&s.key.PublicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	publicPEM := pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicDER})
+
+	with := func(key string, value any) string {
+		claims := s.claims()
+		if value == nil {
+			delete(claims, key)
+		} else {
+			claims[key] = value
+		}
+		return s.sign(t, claims)
+	}
+	tamper := func(raw string) string {
+		parts := strings.Split(raw, ".")
+		claims := s.claims()
+		claims["sub"] = "admin"
+		payload, _ := json.Marshal(claims)
+		parts[1] = base64.RawURLEncoding.EncodeToString(payload)
+		return strings.Join(parts, ".")
+	}
+	now := time.Now()
+
+	// 发现文档声明了 HS256 和 none，仍然只接受非对称算法
+	tests := []struct {
+		name  string
+		token string
+	}{
+		{name: "bad signature", token: signToken(t, jwt.SigningMethodRS256, otherKey, testKid, s.claims())},
+		{name: "tampered payload", token: tamper(s.sign(t, s.claims()))},
+		{name: "unknown kid", token: signToken(t, jwt.SigningMethodRS256, s.key, "key-2", s.claims())},
+		{name: "alg none", token: signToken(t, jwt.SigningMethodNone, jwt.UnsafeAllowNoneSignatureType, testKid, s.claims())},
+		{name: "HS256 with the PEM public key", token: signToken(t, jwt.SigningMethodHS256, publicPEM, testKid, s.claims())},
+		{name: "HS256 with the DER public key", token: signToken(t, jwt.SigningMethodHS256, publicDER, testKid, s.claims())},
+		{name: "HS256 with the client secret", token: signToken(t, jwt.SigningMethodHS256, []byte("secret"), testKid, s.claims())},
+		{name: "wrong issuer", token: with("iss", "https://evil.example.com")},
+		{name: "issuer with a trailing slash", token: with("iss", s.URL+"/")},
+		{name: "missing issuer", token: with("iss", nil)},
+		{name: "wrong audience", token: with("aud", "another-client")},
+		{name: "missing audience", token: with("aud", nil)},
+		{name: "multiple audiences without azp", token: with("aud", []string{testClientID, "another-client"})},
+		{name: "authorized party mismatch", token: with("azp", "another-client")},
+		{name: "expired", token: with("exp", now.Add(-2*oidcClockSkew).Unix())},
+		{name: "missing expiry", token: with("exp", nil)},
+		{name: "issued in the future", token: with("iat", now.Add(2*oidcClockSkew).Unix())},
+		{name: "missing issued at", token: with("iat", nil)},
+		{name: "not yet valid", token: with("nbf", now.Add(2*oidcClockSkew).Unix())},
+		{name: "missing subject", token: with("sub", nil)},
+		{name: "nonce mismatch", token: with("nonce", "nonce-2")},
+		{name: "missing nonce", token: with("nonce", nil)},
+		{name: "malformed", token: "not-a-jwt"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := o.VerifyIDToken(context.Background(), tt.token, testNonce)
+			var oauthErr *interfaces.OAuthError
+			if !errors.As(err, &oauthErr) || oauthErr.Type != interfaces.ErrorTypeInvalidToken {
+				t.Errorf("err = %v, want an invalid token error", err)
+			}
+		})
+	}
+
+	// 会话丢失 nonce 时不能匹配不带 nonce 的令牌
+	if _, err = o.VerifyIDToken(context.Background(), with("nonce", nil), ""); err == nil {
+		t.Error("VerifyIDToken accepted an empty nonce")
+	}
+}
+
+func TestExchangeCodeForToken(t *testing.T) {
+	s := newTestIssuer(t)
+	o := newTestOIDCAdapter(t, s.URL)
+	ctx := context.Background()
+	session := &interfaces.OAuthSession{State: "state-1", ExtraData: map[string]string{OIDCNonceKey: testNonce}}
+
+	s.idToken = s.sign(t, s.claims())
+	token, err := o.ExchangeCodeForToken(ctx, "code-1", session)
+	if err != nil {
+		t.Fatalf("ExchangeCodeForToken: %v", err)
+	}
+
+	info, err := o.GetUserInfo(ctx, token)
+	if err != nil {
+		t.Fatalf("GetUserInfo: %v", err)
+	}
+	// id_token 中的声明优先，userinfo 只补充缺少的声明
+	if info.ID != testSubject || info.Nickname != "Alice" || info.Email != "alice@example.com" {
+		t.Errorf("user info = %+v", info)
+	}
+
+	// userinfo 返回其它用户时拒绝
+	s.userInfo = map[string]any{"sub": "user-2", "email": "mallory@example.com"}
+	if _, err = o.GetUserInfo(ctx, token); err == nil {
+		t.Error("GetUserInfo accepted a userinfo response of another subject")
+	}
+
+	// 令牌端点返回的 id_token 同样校验 nonce
+	session.ExtraData[OIDCNonceKey] = "nonce-2"
+	if _, err = o.ExchangeCodeForToken(ctx, "code-1", session); err == nil {
+		t.Error("ExchangeCodeForToken accepted an id_token of another session")
+	}
+
+	// 未经校验的令牌不能直接获取用户信息
+	if _, err = o.GetUserInfo(ctx, token.WithExtra(map[string]interface{}{"id_token": s.idToken})); err == nil {
+		t.Error("GetUserInfo accepted a token without verified claims")
+	}
+}
+
+func TestOIDCDiscoveryIssuerMismatch(t *testing.T) {
+	s := newTestIssuer(t)
+
+	// 配置的签发方与发现文档中的 issuer 不一致时拒绝，防止借用其它签发方的密钥
+	o := newTestOIDCAdapter(t, s.URL+"/")
+	if _, err := o.Discover(context.Background()); err == nil {
+		t.Error("Discover accepted a discovery document of another issuer")
+	}
+}
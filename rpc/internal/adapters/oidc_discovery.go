@@ -0,0 +1,268 @@
+package adapters
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/coder-lulu/newbee-core/rpc/internal/interfaces"
+)
+
+const (
+	// oidcDiscoveryTTL 发现文档缓存时长
+	oidcDiscoveryTTL = time.Hour
+	// oidcJWKSRefreshInterval 遇到未知 kid 时刷新 JWKS 的最小间隔，防止伪造的 kid 打满签发方
+	oidcJWKSRefreshInterval = time.Minute
+	// oidcMaxResponseSize 发现文档和 JWKS 的最大响应大小
+	oidcMaxResponseSize = 1 << 20
+)
+
+// OIDCDiscovery represents the OpenID provider metadata from .well-known/openid-configuration
+type OIDCDiscovery struct {
+	Issuer                string   `json:"issuer"`
+	AuthorizationEndpoint string   `json:"authorization_endpoint"`
+	TokenEndpoint         string   `json:"token_endpoint"`
+	UserInfoEndpoint      string   `json:"userinfo_endpoint"`
+	JWKSURI               string   `json:"jwks_uri"`
+	RevocationEndpoint    string   `json:"revocation_endpoint"`
+	EndSessionEndpoint    string   `json:"end_session_endpoint"`
+	ScopesSupported       []string `json:"scopes_supported"`
+	SigningAlgs           []string `json:"id_token_signing_alg_values_supported"`
+}
+
+// oidcIssuer caches the discovery document and signing keys of an issuer
+type oidcIssuer struct {
+	mu           sync.Mutex
+	discovery    *OIDCDiscovery
+	discoveredAt time.Time
+	keys         map[string]crypto.PublicKey
+	keysAt       time.Time
+}
+
+// oidcIssuers 按签发方缓存，同一签发方的多个提供商配置共享发现文档和密钥
+var oidcIssuers sync.Map
+
+func getOIDCIssuer(issuer string) *oidcIssuer {
+	v, _ := oidcIssuers.LoadOrStore(issuer, &oidcIssuer{})
+	return v.(*oidcIssuer)
+}
+
+// Discover returns the cached discovery document of the issuer, fetching it when missing or expired
+func (o *oidcIssuer) Discover(ctx context.Context, client *http.Client, issuer string) (*OIDCDiscovery, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	if o.discovery != nil && time.Since(o.discoveredAt) < oidcDiscoveryTTL {
+		return o.discovery, nil
+	}
+
+	var doc OIDCDiscovery
+	if err := fetchJSON(ctx, client, strings.TrimSuffix(issuer, "/")+"/.well-known/openid-configuration", &doc); err != nil {
+		return nil, err
+	}
+
+	// OpenID Connect Discovery 1.0 §4.3: issuer 必须与请求的签发方完全一致
+	if doc.Issuer != issuer {
+		return nil, &interfaces.OAuthError{
+			Type:        interfaces.ErrorTypeConfigurationError,
+			Description: fmt.Sprintf("issuer mismatch in discovery document: expected %s, got %s", issuer, doc.Issuer),
+		}
+	}
+
+	if doc.AuthorizationEndpoint == "" || doc.TokenEndpoint == "" || doc.JWKSURI == "" {
+		return nil, &interfaces.OAuthError{
+			Type:        interfaces.ErrorTypeConfigurationError,
+			Description: "discovery document is missing authorization_endpoint, token_endpoint or jwks_uri",
+		}
+	}
+
+	o.discovery = &doc
+	o.discoveredAt = time.Now()
+	return o.discovery, nil
+}
+
+// Key returns the signing key of the kid, the JWKS is refreshed once when the kid is unknown
+func (o *oidcIssuer) Key(ctx context.Context, client *http.Client, jwksURI, kid string) (crypto.PublicKey, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	if key, ok := o.lookup(kid); ok {
+		return key, nil
+	}
+
+	if o.keys != nil && time.Since(o.keysAt) < oidcJWKSRefreshInterval {
+		return nil, fmt.Errorf("signing key %q not found", kid)
+	}
+
+	var set jsonWebKeySet
+	if err := fetchJSON(ctx, client, jwksURI, &set); err != nil {
+		return nil, err
+	}
+
+	o.keys = make(map[string]crypto.PublicKey, len(set.Keys))
+	for _, v := range set.Keys {
+		if v.Use != "" && v.Use != "sig" {
+			continue
+		}
+		key, err := v.PublicKey()
+		if err != nil {
+			continue
+		}
+		o.keys[v.Kid] = key
+	}
+	o.keysAt = time.Now()
+
+	if key, ok := o.lookup(kid); ok {
+		return key, nil
+	}
+	return nil, fmt.Errorf("signing key %q not found", kid)
+}
+
+// lookup finds the key by kid, a token without kid is accepted only when the issuer publishes a single key
+func (o *oidcIssuer) lookup(kid string) (crypto.PublicKey, bool) {
+	if kid == "" && len(o.keys) == 1 {
+		for _, v := range o.keys {
+			return v, true
+		}
+	}
+	key, ok := o.keys[kid]
+	return key, ok
+}
+
+// jsonWebKeySet represents a JWK set (RFC 7517)
+type jsonWebKeySet struct {
+	Keys []jsonWebKey `json:"keys"`
+}
+
+// jsonWebKey represents a public JWK, only the fields of RSA, EC and OKP keys are parsed
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+// PublicKey converts the JWK into a crypto public key
+func (k *jsonWebKey) PublicKey() (crypto.PublicKey, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := base64.RawURLEncoding.DecodeString(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := base64.RawURLEncoding.DecodeString(k.E)
+		if err != nil {
+			return nil, err
+		}
+		return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}, nil
+
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve %q", k.Crv)
+		}
+		x, err := base64.RawURLEncoding.DecodeString(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := base64.RawURLEncoding.DecodeString(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		key := &ecdsa.PublicKey{Curve: curve, X: new(big.Int).SetBytes(x), Y: new(big.Int).SetBytes(y)}
+		if !curve.IsOnCurve(key.X, key.Y) {
+			return nil, fmt.Errorf("invalid EC key %q", k.Kid)
+		}
+		return key, nil
+
+	case "OKP":
+		if k.Crv != "Ed25519" {
+			return nil, fmt.Errorf("unsupported curve %q", k.Crv)
+		}
+		x, err := base64.RawURLEncoding.DecodeString(k.X)
+		if err != nil {
+			return nil, err
+		}
+		if len(x) != ed25519.PublicKeySize {
+			return nil, fmt.Errorf("invalid Ed25519 key %q", k.Kid)
+		}
+		return ed25519.PublicKey(x), nil
+
+	default:
+		return nil, fmt.Errorf("unsupported key type %q", k.Kty)
+	}
+}
+
+// fetchJSON gets the URL and decodes the JSON response
+func fetchJSON(ctx context.Context, client *http.Client, url string, v any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return &interfaces.OAuthError{
+			Type:        interfaces.ErrorTypeNetworkError,
+			Description: fmt.Sprintf("failed to create request: %v", err),
+			Cause:       err,
+		}
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return &interfaces.OAuthError{
+			Type:        interfaces.ErrorTypeNetworkError,
+			Description: fmt.Sprintf("failed to fetch %s: %v", url, err),
+			Cause:       err,
+		}
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return &interfaces.OAuthError{
+			Type:        interfaces.ErrorTypeProviderError,
+			Code:        fmt.Sprintf("%d", resp.StatusCode),
+			Description: fmt.Sprintf("failed to fetch %s: HTTP %d", url, resp.StatusCode),
+		}
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, oidcMaxResponseSize))
+	if err != nil {
+		return &interfaces.OAuthError{
+			Type:        interfaces.ErrorTypeProviderError,
+			Description: fmt.Sprintf("failed to read response: %v", err),
+			Cause:       err,
+		}
+	}
+
+	if err = json.Unmarshal(body, v); err != nil {
+		return &interfaces.OAuthError{
+			Type:        interfaces.ErrorTypeProviderError,
+			Description: fmt.Sprintf("failed to parse response of %s: %v", url, err),
+			Cause:       err,
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,45 @@
+package adapters
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strings"
+)
+
+const (
+	// WebhookSecretKey is the extra config key of the secret signing the webhooks of the provider
+	WebhookSecretKey = "webhook_secret"
+	// WebhookTokenKey is the extra config key of the verification token, used by Feishu
+	WebhookTokenKey = "verification_token"
+)
+
+var (
+	// ErrWebhookNotConfigured 未配置 webhook 签名密钥，无法验证请求来源
+	ErrWebhookNotConfigured = errors.New("webhook secret is not configured")
+	// ErrInvalidWebhookSignature webhook 签名校验失败
+	ErrInvalidWebhookSignature = errors.New("invalid webhook signature")
+)
+
+// extraString returns a string value of the extra config
+func (b *BaseOAuthAdapter) extraString(key string) string {
+	if b.config == nil {
+		return ""
+	}
+	v, _ := b.config.ExtraConfig[key].(string)
+	return v
+}
+
+// hmacSHA256 returns the HMAC-SHA256 of the data
+func hmacSHA256(secret string, data []byte) []byte {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(data)
+	return mac.Sum(nil)
+}
+
+// webhookEventID builds a stable event ID for providers which do not send one
+func webhookEventID(parts ...string) string {
+	sum := sha256.Sum256([]byte(strings.Join(parts, "\n")))
+	return hex.EncodeToString(sum[:])
+}
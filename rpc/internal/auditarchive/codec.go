@@ -0,0 +1,153 @@
+package auditarchive
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+
+	"github.com/coder-lulu/newbee-core/rpc/ent"
+	"github.com/coder-lulu/newbee-core/rpc/ent/auditlog"
+)
+
+// record 归档文件中的一行，保留哈希链字段以便恢复时校验
+type record struct {
+	ID             string         `json:"id"`
+	CreatedAt      time.Time      `json:"createdAt"`
+	UpdatedAt      time.Time      `json:"updatedAt"`
+	Status         uint8          `json:"status"`
+	TenantID       string         `json:"tenantId"`
+	UserID         string         `json:"userId"`
+	UserName       string         `json:"userName"`
+	OperationType  string         `json:"operationType"`
+	ResourceType   string         `json:"resourceType"`
+	ResourceID     string         `json:"resourceId"`
+	RequestMethod  string         `json:"requestMethod"`
+	RequestPath    string         `json:"requestPath"`
+	RequestData    string         `json:"requestData"`
+	ResponseStatus int            `json:"responseStatus"`
+	ResponseData   string         `json:"responseData"`
+	IPAddress      string         `json:"ipAddress"`
+	UserAgent      string         `json:"userAgent"`
+	DurationMs     int64          `json:"durationMs"`
+	ErrorMessage   string         `json:"errorMessage"`
+	Metadata       map[string]any `json:"metadata"`
+	Seq            uint64         `json:"seq"`
+	PrevHash       string         `json:"prevHash"`
+	Hash           string         `json:"hash"`
+}
+
+// ObjectKey returns the object key of the archived range
+func ObjectKey(tenantID string, fromSeq, toSeq uint64) string {
+	return fmt.Sprintf("audit-logs/%s/%020d-%020d.ndjson.gz", tenantID, fromSeq, toSeq)
+}
+
+// Checksum returns the SHA-256 checksum of the object
+func Checksum(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// Encode encodes the chained records into gzip compressed NDJSON
+func Encode(logs []*ent.AuditLog) ([]byte, error) {
+	var buf bytes.Buffer
+	writer := gzip.NewWriter(&buf)
+	encoder := json.NewEncoder(writer)
+	for _, v := range logs {
+		if v.Seq == nil {
+			return nil, fmt.Errorf("audit log %s is not chained", v.ID)
+		}
+		if err := encoder.Encode(record{
+			ID:             v.ID.String(),
+			CreatedAt:      v.CreatedAt,
+			UpdatedAt:      v.UpdatedAt,
+			Status:         v.Status,
+			TenantID:       v.TenantID,
+			UserID:         v.UserID,
+			UserName:       v.UserName,
+			OperationType:  string(v.OperationType),
+			ResourceType:   v.ResourceType,
+			ResourceID:     v.ResourceID,
+			RequestMethod:  v.RequestMethod,
+			RequestPath:    v.RequestPath,
+			RequestData:    v.RequestData,
+			ResponseStatus: v.ResponseStatus,
+			ResponseData:   v.ResponseData,
+			IPAddress:      v.IPAddress,
+			UserAgent:      v.UserAgent,
+			DurationMs:     v.DurationMs,
+			ErrorMessage:   v.ErrorMessage,
+			Metadata:       v.Metadata,
+			Seq:            *v.Seq,
+			PrevHash:       v.PrevHash,
+			Hash:           v.Hash,
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// Decode decodes the gzip compressed NDJSON into records
+func Decode(data []byte) ([]*ent.AuditLog, error) {
+	reader, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+
+	var logs []*ent.AuditLog
+	scanner := bufio.NewScanner(reader)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		var v record
+		if err = json.Unmarshal(scanner.Bytes(), &v); err != nil {
+			return nil, err
+		}
+
+		id, err := uuid.FromString(v.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		seq := v.Seq
+		logs = append(logs, &ent.AuditLog{
+			ID:             id,
+			CreatedAt:      v.CreatedAt,
+			UpdatedAt:      v.UpdatedAt,
+			Status:         v.Status,
+			TenantID:       v.TenantID,
+			UserID:         v.UserID,
+			UserName:       v.UserName,
+			OperationType:  auditlog.OperationType(v.OperationType),
+			ResourceType:   v.ResourceType,
+			ResourceID:     v.ResourceID,
+			RequestMethod:  v.RequestMethod,
+			RequestPath:    v.RequestPath,
+			RequestData:    v.RequestData,
+			ResponseStatus: v.ResponseStatus,
+			ResponseData:   v.ResponseData,
+			IPAddress:      v.IPAddress,
+			UserAgent:      v.UserAgent,
+			DurationMs:     v.DurationMs,
+			ErrorMessage:   v.ErrorMessage,
+			Metadata:       v.Metadata,
+			Seq:            &seq,
+			PrevHash:       v.PrevHash,
+			Hash:           v.Hash,
+		})
+	}
+
+	return logs, scanner.Err()
+}
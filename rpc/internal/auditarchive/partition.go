@@ -0,0 +1,208 @@
+package auditarchive
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	atlas "ariga.io/atlas/sql/schema"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql/schema"
+
+	"github.com/coder-lulu/newbee-core/rpc/ent"
+)
+
+const auditLogTable = "sys_audit_logs"
+
+var monthPartition = regexp.MustCompile(`^p\d{6}$`)
+
+// EnsurePartitions converts sys_audit_logs into a table partitioned by month on created_at
+// and creates the partitions of the coming months.
+// 分区表的主键必须包含分区键，转换时主键改为 (id, created_at)。
+func EnsurePartitions(ctx context.Context, db *ent.Client, dbType string, ahead int) error {
+	months := partitionMonths(time.Now(), ahead)
+	switch dbType {
+	case dialect.MySQL:
+		return ensureMySQLPartitions(ctx, db, months)
+	case dialect.Postgres:
+		return ensurePostgresPartitions(ctx, db, months)
+	default:
+		return fmt.Errorf("audit log partitioning is not supported on %s", dbType)
+	}
+}
+
+// SkipPartitionKeyDiff keeps the migration from restoring the primary key of the partitioned audit log table
+func SkipPartitionKeyDiff(next schema.Differ) schema.Differ {
+	return schema.DiffFunc(func(current, desired *atlas.Schema) ([]atlas.Change, error) {
+		changes, err := next.Diff(current, desired)
+		if err != nil {
+			return nil, err
+		}
+
+		kept := make([]atlas.Change, 0, len(changes))
+		for _, c := range changes {
+			if m, ok := c.(*atlas.ModifyTable); ok && m.T.Name == auditLogTable {
+				filtered := make([]atlas.Change, 0, len(m.Changes))
+				for _, change := range m.Changes {
+					switch change.(type) {
+					case *atlas.AddPrimaryKey, *atlas.DropPrimaryKey, *atlas.ModifyPrimaryKey:
+						continue
+					default:
+						filtered = append(filtered, change)
+					}
+				}
+				if len(filtered) == 0 {
+					continue
+				}
+				m.Changes = filtered
+			}
+			kept = append(kept, c)
+		}
+
+		return kept, nil
+	})
+}
+
+// partitionMonths returns the first day of the months from last month to the coming ones
+func partitionMonths(now time.Time, ahead int) []time.Time {
+	start := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location()).AddDate(0, -1, 0)
+	months := make([]time.Time, 0, ahead+2)
+	for i := 0; i <= ahead+1; i++ {
+		months = append(months, start.AddDate(0, i, 0))
+	}
+	return months
+}
+
+func partitionName(month time.Time) string {
+	return "p" + month.Format("200601")
+}
+
+func queryStrings(ctx context.Context, db *ent.Client, query string, args ...any) ([]string, error) {
+	rows, err := db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []string
+	for rows.Next() {
+		var v string
+		if err = rows.Scan(&v); err != nil {
+			return nil, err
+		}
+		result = append(result, v)
+	}
+
+	return result, rows.Err()
+}
+
+func ensureMySQLPartitions(ctx context.Context, db *ent.Client, months []time.Time) error {
+	names, err := queryStrings(ctx, db, "SELECT PARTITION_NAME FROM information_schema.PARTITIONS "+
+		"WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = ? AND PARTITION_NAME IS NOT NULL", auditLogTable)
+	if err != nil {
+		return err
+	}
+
+	if len(names) == 0 {
+		// 第一个分区同时容纳更早的历史数据
+		defs := make([]string, 0, len(months)+1)
+		for _, m := range months {
+			defs = append(defs, fmt.Sprintf("PARTITION %s VALUES LESS THAN ('%s')", partitionName(m), m.AddDate(0, 1, 0).Format(time.DateOnly)))
+		}
+		defs = append(defs, "PARTITION pmax VALUES LESS THAN (MAXVALUE)")
+
+		if _, err = db.ExecContext(ctx, fmt.Sprintf("ALTER TABLE %s DROP PRIMARY KEY, ADD PRIMARY KEY (id, created_at)", auditLogTable)); err != nil {
+			return err
+		}
+		_, err = db.ExecContext(ctx, fmt.Sprintf("ALTER TABLE %s PARTITION BY RANGE COLUMNS(created_at) (%s)", auditLogTable, strings.Join(defs, ", ")))
+		return err
+	}
+
+	// RANGE 分区只能在末尾追加，从 pmax 中拆出新的月份
+	var last string
+	for _, v := range names {
+		if monthPartition.MatchString(v) && v > last {
+			last = v
+		}
+	}
+	for _, m := range months {
+		if partitionName(m) <= last {
+			continue
+		}
+		_, err = db.ExecContext(ctx, fmt.Sprintf("ALTER TABLE %s REORGANIZE PARTITION pmax INTO (PARTITION %s VALUES LESS THAN ('%s'), PARTITION pmax VALUES LESS THAN (MAXVALUE))",
+			auditLogTable, partitionName(m), m.AddDate(0, 1, 0).Format(time.DateOnly)))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func ensurePostgresPartitions(ctx context.Context, db *ent.Client, months []time.Time) error {
+	kinds, err := queryStrings(ctx, db, "SELECT c.relkind::text FROM pg_class c JOIN pg_namespace n ON n.oid = c.relnamespace "+
+		"WHERE n.nspname = current_schema() AND c.relname = $1", auditLogTable)
+	if err != nil || len(kinds) == 0 {
+		// 表尚未创建，等待数据库初始化
+		return err
+	}
+
+	if kinds[0] != "p" {
+		if err = convertPostgres(ctx, db, months); err != nil {
+			return err
+		}
+	}
+
+	for _, m := range months {
+		_, err = db.ExecContext(ctx, fmt.Sprintf("CREATE TABLE IF NOT EXISTS %s_%s PARTITION OF %s FOR VALUES FROM ('%s') TO ('%s')",
+			auditLogTable, partitionName(m), auditLogTable, m.Format(time.DateOnly), m.AddDate(0, 1, 0).Format(time.DateOnly)))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// convertPostgres PostgreSQL 不支持将普通表直接转为分区表，在一个事务中重建表并迁移数据
+func convertPostgres(ctx context.Context, db *ent.Client, months []time.Time) error {
+	indexes, err := queryStrings(ctx, db, "SELECT indexdef FROM pg_indexes WHERE schemaname = current_schema() "+
+		"AND tablename = $1 AND indexname <> $2", auditLogTable, auditLogTable+"_pkey")
+	if err != nil {
+		return err
+	}
+	sort.Strings(indexes)
+
+	stmts := []string{
+		fmt.Sprintf("ALTER TABLE %s RENAME TO %s_legacy", auditLogTable, auditLogTable),
+		fmt.Sprintf("CREATE TABLE %s (LIKE %s_legacy INCLUDING DEFAULTS INCLUDING COMMENTS) PARTITION BY RANGE (created_at)", auditLogTable, auditLogTable),
+		fmt.Sprintf("CREATE TABLE %s_default PARTITION OF %s DEFAULT", auditLogTable, auditLogTable),
+	}
+	for _, m := range months {
+		stmts = append(stmts, fmt.Sprintf("CREATE TABLE %s_%s PARTITION OF %s FOR VALUES FROM ('%s') TO ('%s')",
+			auditLogTable, partitionName(m), auditLogTable, m.Format(time.DateOnly), m.AddDate(0, 1, 0).Format(time.DateOnly)))
+	}
+	stmts = append(stmts,
+		fmt.Sprintf("INSERT INTO %s SELECT * FROM %s_legacy", auditLogTable, auditLogTable),
+		fmt.Sprintf("DROP TABLE %s_legacy", auditLogTable),
+		fmt.Sprintf("ALTER TABLE %s ADD PRIMARY KEY (id, created_at)", auditLogTable),
+	)
+	// 旧表删除后索引名称可以复用
+	stmts = append(stmts, indexes...)
+
+	tx, err := db.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	for _, v := range stmts {
+		if _, err = tx.ExecContext(ctx, v); err != nil {
+			_ = tx.Rollback()
+			return fmt.Errorf("failed to partition %s: %w", auditLogTable, err)
+		}
+	}
+
+	return tx.Commit()
+}
@@ -0,0 +1,140 @@
+package auditarchive
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/coder-lulu/newbee-core/rpc/internal/config"
+)
+
+// StoreLocal 本地目录存储
+const StoreLocal = "local"
+
+// BlobStore 归档文件存储，实现可以是本地目录、对象存储等
+type BlobStore interface {
+	// Put writes the object, an existing object with the same key is overwritten
+	Put(ctx context.Context, key string, data []byte) error
+	// Get reads the object
+	Get(ctx context.Context, key string) ([]byte, error)
+}
+
+// StoreFactory creates a blob store from the config
+type StoreFactory func(c config.AuditArchiveConf) (BlobStore, error)
+
+var (
+	factoriesMu sync.RWMutex
+	factories   = map[string]StoreFactory{
+		StoreLocal: func(c config.AuditArchiveConf) (BlobStore, error) {
+			return NewLocalStore(c.Dir)
+		},
+	}
+)
+
+// RegisterStore registers a blob store type, it should be called in init before the service starts
+func RegisterStore(name string, factory StoreFactory) {
+	factoriesMu.Lock()
+	defer factoriesMu.Unlock()
+	factories[name] = factory
+}
+
+// NewStore creates the blob store of the configured type, nil if archiving is disabled
+func NewStore(c config.AuditArchiveConf) (BlobStore, error) {
+	if !c.Enabled {
+		return nil, nil
+	}
+
+	factoriesMu.RLock()
+	factory, ok := factories[c.Store]
+	factoriesMu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("unknown audit archive store: %s", c.Store)
+	}
+
+	return factory(c)
+}
+
+// MustNewStore is like NewStore but panics on error
+func MustNewStore(c config.AuditArchiveConf) BlobStore {
+	store, err := NewStore(c)
+	if err != nil {
+		panic(err)
+	}
+	return store
+}
+
+// LocalStore 将归档文件保存在本地目录
+type LocalStore struct {
+	dir string
+}
+
+// NewLocalStore creates a local directory store
+func NewLocalStore(dir string) (*LocalStore, error) {
+	if dir == "" {
+		return nil, errors.New("audit archive dir is required for local store")
+	}
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return nil, err
+	}
+	return &LocalStore{dir: dir}, nil
+}
+
+func (s *LocalStore) path(key string) (string, error) {
+	name := filepath.Join(s.dir, filepath.FromSlash(key))
+	if !strings.HasPrefix(name, filepath.Clean(s.dir)+string(filepath.Separator)) {
+		return "", fmt.Errorf("invalid object key: %s", key)
+	}
+	return name, nil
+}
+
+// Put writes into a temporary file and renames it, so that readers never see a partial object
+func (s *LocalStore) Put(_ context.Context, key string, data []byte) error {
+	name, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err = os.MkdirAll(filepath.Dir(name), 0o750); err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(name), ".tmp-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err = tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err = tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err = tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), name)
+}
+
+// Get reads the object
+func (s *LocalStore) Get(_ context.Context, key string) ([]byte, error) {
+	name, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	return io.ReadAll(file)
+}
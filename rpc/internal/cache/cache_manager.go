@@ -3,8 +3,10 @@ package cache
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
+	"github.com/coder-lulu/newbee-common/v2/orm/ent/hooks"
 	"golang.org/x/oauth2"
 
 	"github.com/coder-lulu/newbee-core/rpc/ent"
@@ -145,7 +147,7 @@ func (cm *CacheManager) WarmupCache(ctx context.Context, tenantIDs []uint64) err
 				oauthprovider.TenantIDEQ(tenantID),
 				oauthprovider.EnabledEQ(true),
 			).
-			All(ctx)
+			All(hooks.SetTenantIDToContext(ctx, tenantID))
 		
 		if err != nil {
 			return fmt.Errorf("failed to fetch providers for tenant %d: %v", tenantID, err)
@@ -154,7 +156,7 @@ func (cm *CacheManager) WarmupCache(ctx context.Context, tenantIDs []uint64) err
 		// Cache each provider configuration
 		for _, provider := range providers {
 			config := cm.ConvertEntToConfig(provider)
-			cacheKey := GenerateProviderKey(tenantID, interfaces.OAuthProviderType(provider.ProviderType))
+			cacheKey := GenerateProviderKey(tenantID, interfaces.OAuthProviderType(provider.Type))
 			
 			if err := cm.providerCache.Set(ctx, cacheKey, config); err != nil {
 				// Log error but continue with other providers
@@ -176,6 +178,7 @@ func (cm *CacheManager) WarmupCache(ctx context.Context, tenantIDs []uint64) err
 // ConvertEntToConfig converts an ent OauthProvider to interfaces.OAuthProviderConfig
 func (cm *CacheManager) ConvertEntToConfig(provider *ent.OauthProvider) *interfaces.OAuthProviderConfig {
 	config := &interfaces.OAuthProviderConfig{
+		Name:            provider.Name,
 		Type:            interfaces.OAuthProviderType(provider.Type),
 		ClientID:        provider.ClientID,
 		ClientSecret:    provider.ClientSecret,
@@ -187,13 +190,11 @@ func (cm *CacheManager) ConvertEntToConfig(provider *ent.OauthProvider) *interfa
 		DisplayName:     provider.DisplayName,
 		EncryptedSecret: provider.EncryptedSecret,
 		EncryptionKeyID: provider.EncryptionKeyID,
+		ExtraConfig:     provider.ExtraConfig,
 	}
 
-	// Handle scopes - parse from string to slice
-	if provider.Scopes != "" {
-		// Simple split by comma for now - can be enhanced later
-		config.Scopes = []string{provider.Scopes}
-	}
+	// Handle scopes - space separated as in the scope parameter of OAuth 2.0
+	config.Scopes = strings.Fields(provider.Scopes)
 	
 	config.AuthStyle = oauth2.AuthStyle(provider.AuthStyle)
 	config.SupportPKCE = provider.SupportPkce
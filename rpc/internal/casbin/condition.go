@@ -0,0 +1,298 @@
+package casbin
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/netip"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+)
+
+// ABAC 条件：p 规则的 conditions 字段保存 JSON 格式的条件，只支持下面的声明式子句，
+// 不执行任何表达式或脚本。已设置的子句必须全部满足规则才生效，例如：
+//
+//	{"ipCidrs":["10.8.0.0/16"],"timeRange":{"start":"09:00","end":"18:00","timezone":"Asia/Shanghai"},
+//	 "weekdays":[1,2,3,4,5],"subject":{"position":["ops"]},"resource":{"env":["test"]}}
+//
+// 条件无法判断时按不满足处理：带条件的 allow 规则不授权，带条件的 deny 规则只在条件满足时拒绝。
+const (
+	// ContextKeyIP 权限检查上下文中的客户端IP
+	ContextKeyIP = "ip"
+	// ContextResourcePrefix 权限检查上下文中资源属性的前缀，如 resource.env
+	ContextResourcePrefix = "resource."
+
+	// SubjectDepartment 主体属性：用户所属部门ID
+	SubjectDepartment = "department"
+	// SubjectPosition 主体属性：用户的岗位代码
+	SubjectPosition = "position"
+
+	maxConditionSize   = 4096
+	maxConditionValues = 64
+)
+
+// ErrInvalidCondition 条件格式错误
+var ErrInvalidCondition = errors.New("invalid casbin rule condition")
+
+var attributeNamePattern = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_.-]{0,63}$`)
+
+// Condition 规则的ABAC条件
+type Condition struct {
+	// IPCIDRs 请求IP必须位于任一网段内，也可以填写单个IP
+	IPCIDRs []string `json:"ipCidrs,omitempty"`
+	// TimeRange 每日时间段，结束早于开始时表示跨越零点
+	TimeRange *TimeRange `json:"timeRange,omitempty"`
+	// Weekdays 允许的星期，1=星期一 ... 7=星期日，按 timeRange 的时区计算
+	Weekdays []int `json:"weekdays,omitempty"`
+	// Subject 主体属性，只支持 department 和 position，值命中任一即可
+	Subject map[string][]string `json:"subject,omitempty"`
+	// Resource 资源属性，由调用方在权限检查上下文中以 resource.<名称> 传入，值命中任一即可
+	Resource map[string][]string `json:"resource,omitempty"`
+
+	prefixes []netip.Prefix
+	start    int
+	end      int
+	location *time.Location
+}
+
+// TimeRange 每日时间段，格式 HH:MM，包含开始不包含结束，
+// 未设置时区时使用服务端时区
+type TimeRange struct {
+	Start    string `json:"start"`
+	End      string `json:"end"`
+	Timezone string `json:"timezone,omitempty"`
+}
+
+// Attributes 求值条件所需的属性
+type Attributes struct {
+	IP       string
+	Time     time.Time
+	Subject  map[string][]string
+	Resource map[string]string
+}
+
+// NewAttributes 从权限检查上下文构建请求和资源属性，请求时间使用服务端时间
+func NewAttributes(reqCtx map[string]string) *Attributes {
+	attrs := &Attributes{
+		IP:       reqCtx[ContextKeyIP],
+		Time:     time.Now(),
+		Resource: make(map[string]string),
+	}
+	for k, v := range reqCtx {
+		if name, ok := strings.CutPrefix(k, ContextResourcePrefix); ok {
+			attrs.Resource[name] = v
+		}
+	}
+
+	return attrs
+}
+
+// ParseCondition 解析并校验条件，空字符串返回 nil
+func ParseCondition(raw string) (*Condition, error) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return nil, nil
+	}
+	if len(raw) > maxConditionSize {
+		return nil, fmt.Errorf("%w: longer than %d bytes", ErrInvalidCondition, maxConditionSize)
+	}
+
+	c := &Condition{}
+	decoder := json.NewDecoder(bytes.NewReader([]byte(raw)))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(c); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidCondition, err)
+	}
+	if decoder.More() {
+		return nil, fmt.Errorf("%w: unexpected data after the condition", ErrInvalidCondition)
+	}
+
+	if err := c.compile(); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidCondition, err)
+	}
+
+	return c, nil
+}
+
+// compile 校验各子句并预先解析网段和时间
+func (c *Condition) compile() error {
+	if len(c.IPCIDRs) == 0 && c.TimeRange == nil && len(c.Weekdays) == 0 &&
+		len(c.Subject) == 0 && len(c.Resource) == 0 {
+		return errors.New("at least one clause is required")
+	}
+
+	if len(c.IPCIDRs) > maxConditionValues {
+		return fmt.Errorf("ipCidrs has more than %d entries", maxConditionValues)
+	}
+	for _, v := range c.IPCIDRs {
+		prefix, err := parsePrefix(v)
+		if err != nil {
+			return fmt.Errorf("ipCidrs: %v", err)
+		}
+		c.prefixes = append(c.prefixes, prefix)
+	}
+
+	if c.TimeRange != nil {
+		var err error
+		if c.start, err = parseClock(c.TimeRange.Start); err != nil {
+			return fmt.Errorf("timeRange.start: %v", err)
+		}
+		if c.end, err = parseClock(c.TimeRange.End); err != nil {
+			return fmt.Errorf("timeRange.end: %v", err)
+		}
+		if c.start == c.end {
+			return errors.New("timeRange.start and timeRange.end must differ")
+		}
+		c.location = time.Local
+		if c.TimeRange.Timezone != "" {
+			if c.location, err = time.LoadLocation(c.TimeRange.Timezone); err != nil {
+				return fmt.Errorf("timeRange.timezone: %v", err)
+			}
+		}
+	}
+
+	for _, d := range c.Weekdays {
+		if d < 1 || d > 7 {
+			return fmt.Errorf("weekdays: %d is not between 1 and 7", d)
+		}
+	}
+
+	for name, values := range c.Subject {
+		if name != SubjectDepartment && name != SubjectPosition {
+			return fmt.Errorf("subject: unsupported attribute %q", name)
+		}
+		if err := checkValues("subject."+name, values); err != nil {
+			return err
+		}
+	}
+
+	if len(c.Resource) > maxConditionValues {
+		return fmt.Errorf("resource has more than %d attributes", maxConditionValues)
+	}
+	for name, values := range c.Resource {
+		if !attributeNamePattern.MatchString(name) {
+			return fmt.Errorf("resource: invalid attribute name %q", name)
+		}
+		if err := checkValues("resource."+name, values); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// NeedsSubject 条件是否需要主体属性
+func (c *Condition) NeedsSubject() bool {
+	return len(c.Subject) > 0
+}
+
+// Match 判断属性是否满足全部子句
+func (c *Condition) Match(attrs *Attributes) bool {
+	if len(c.prefixes) > 0 {
+		ip, ok := parseClientIP(attrs.IP)
+		if !ok || !slices.ContainsFunc(c.prefixes, func(p netip.Prefix) bool { return p.Contains(ip) }) {
+			return false
+		}
+	}
+
+	now := attrs.Time
+	if c.location != nil {
+		now = now.In(c.location)
+	}
+	if c.TimeRange != nil {
+		minute := now.Hour()*60 + now.Minute()
+		if c.start < c.end && (minute < c.start || minute >= c.end) {
+			return false
+		}
+		if c.start > c.end && minute < c.start && minute >= c.end {
+			return false
+		}
+	}
+
+	if len(c.Weekdays) > 0 {
+		weekday := int(now.Weekday())
+		if weekday == 0 {
+			weekday = 7
+		}
+		if !slices.Contains(c.Weekdays, weekday) {
+			return false
+		}
+	}
+
+	for name, values := range c.Subject {
+		if !slices.ContainsFunc(attrs.Subject[name], func(v string) bool { return slices.Contains(values, v) }) {
+			return false
+		}
+	}
+
+	for name, values := range c.Resource {
+		v, ok := attrs.Resource[name]
+		if !ok || !slices.Contains(values, v) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func checkValues(name string, values []string) error {
+	if len(values) == 0 {
+		return fmt.Errorf("%s: at least one value is required", name)
+	}
+	if len(values) > maxConditionValues {
+		return fmt.Errorf("%s has more than %d values", name, maxConditionValues)
+	}
+	return nil
+}
+
+func parsePrefix(v string) (netip.Prefix, error) {
+	if strings.Contains(v, "/") {
+		prefix, err := netip.ParsePrefix(v)
+		return prefix.Masked(), err
+	}
+	addr, err := netip.ParseAddr(v)
+	if err != nil {
+		return netip.Prefix{}, err
+	}
+	return netip.PrefixFrom(addr, addr.BitLen()), nil
+}
+
+func parseClock(v string) (int, error) {
+	t, err := time.Parse("15:04", v)
+	if err != nil {
+		return 0, fmt.Errorf("%q is not in HH:MM format", v)
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
+
+// parseClientIP 解析客户端IP，支持 IP 和 host:port 格式。
+// 调用方必须传入已确定的客户端地址，X-Forwarded-For 这样的地址列表可以由客户端伪造，按无法判断处理
+func parseClientIP(v string) (netip.Addr, bool) {
+	v = strings.TrimSpace(v)
+	if addrPort, err := netip.ParseAddrPort(v); err == nil {
+		return addrPort.Addr().Unmap(), true
+	}
+	addr, err := netip.ParseAddr(v)
+	if err != nil {
+		return netip.Addr{}, false
+	}
+	return addr.Unmap(), true
+}
+
+// ValidateRuleCondition 校验规则的条件，只有效果为 allow 或 deny 的 p 规则可以设置条件
+func ValidateRuleCondition(ptype, effect, conditions string) error {
+	if strings.TrimSpace(conditions) == "" {
+		return nil
+	}
+	if ptype != "p" {
+		return fmt.Errorf("%w: only p rules support conditions", ErrInvalidCondition)
+	}
+	if e := strings.ToLower(effect); e != "allow" && e != "deny" {
+		return fmt.Errorf("%w: conditional rules require the effect allow or deny in v4", ErrInvalidCondition)
+	}
+	_, err := ParseCondition(conditions)
+	return err
+}
@@ -0,0 +1,34 @@
+package casbin
+
+import "testing"
+
+func TestConditionMatchIP(t *testing.T) {
+	cond, err := ParseCondition(`{"ipCidrs":["10.8.0.0/16","192.168.1.10"]}`)
+	if err != nil {
+		t.Fatalf("parse condition: %v", err)
+	}
+
+	tests := []struct {
+		name string
+		ip   string
+		want bool
+	}{
+		{name: "address in cidr", ip: "10.8.3.4", want: true},
+		{name: "address with port", ip: "10.8.3.4:51234", want: true},
+		{name: "single ip", ip: "192.168.1.10", want: true},
+		{name: "ipv4 mapped ipv6", ip: "[::ffff:10.8.3.4]:443", want: true},
+		{name: "address outside cidr", ip: "203.0.113.9", want: false},
+		{name: "empty", ip: "", want: false},
+		// 客户端伪造的 X-Forwarded-For 列表不能命中条件
+		{name: "spoofed forwarded list", ip: "10.8.3.4, 203.0.113.9", want: false},
+		{name: "forwarded list ending with allowed ip", ip: "203.0.113.9, 10.8.3.4", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := cond.Match(NewAttributes(map[string]string{ContextKeyIP: tt.ip}))
+			if got != tt.want {
+				t.Errorf("Match(%q) = %v, want %v", tt.ip, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,218 @@
+package casbin
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/casbin/casbin/v2/util"
+	"github.com/coder-lulu/newbee-common/v2/orm/ent/entctx/tenantctx"
+	"github.com/coder-lulu/newbee-common/v2/orm/ent/hooks"
+	"github.com/coder-lulu/newbee-core/rpc/ent"
+	"github.com/coder-lulu/newbee-core/rpc/ent/casbinrule"
+	"github.com/coder-lulu/newbee-core/rpc/ent/user"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// ConditionalRule 带ABAC条件的 p 规则，不加载到 Casbin 执行器，在权限检查时单独求值
+type ConditionalRule struct {
+	ID      uint64
+	Subject string
+	Object  string
+	Action  string
+	Effect  string
+	// Condition 解析失败时为 nil：allow 规则不生效，deny 规则始终生效
+	Condition *Condition
+
+	effectiveFrom time.Time
+	effectiveTo   time.Time
+}
+
+// LoadConditionalRules 加载租户内启用且已审批的条件规则
+func LoadConditionalRules(ctx context.Context, db *ent.Client, tenantID uint64, logger logx.Logger) ([]ConditionalRule, error) {
+	rules, err := db.CasbinRule.Query().
+		Where(
+			casbinrule.TenantIDEQ(tenantID),
+			casbinrule.PtypeEQ("p"),
+			casbinrule.StatusEQ(1),
+			casbinrule.ConditionsNotNil(),
+			casbinrule.ConditionsNEQ(""),
+			casbinrule.Or(
+				casbinrule.RequireApprovalEQ(false),
+				casbinrule.ApprovalStatusEQ(casbinrule.ApprovalStatusApproved),
+			),
+		).
+		All(hooks.NewSystemContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]ConditionalRule, 0, len(rules))
+	for _, r := range rules {
+		cond, err := ParseCondition(r.Conditions)
+		if err != nil {
+			logger.Errorw("invalid casbin rule condition",
+				logx.Field("ruleId", r.ID),
+				logx.Field("tenantId", tenantID),
+				logx.Field("error", err.Error()))
+		}
+		result = append(result, ConditionalRule{
+			ID:            r.ID,
+			Subject:       r.V0,
+			Object:        r.V2,
+			Action:        r.V3,
+			Effect:        strings.ToLower(r.V4),
+			Condition:     cond,
+			effectiveFrom: r.EffectiveFrom,
+			effectiveTo:   r.EffectiveTo,
+		})
+	}
+
+	return result, nil
+}
+
+// Applies 规则在指定时间是否适用于该资源和操作
+func (r *ConditionalRule) Applies(object, action string, now time.Time) bool {
+	if !r.effectiveFrom.IsZero() && now.Before(r.effectiveFrom) {
+		return false
+	}
+	if !r.effectiveTo.IsZero() && now.After(r.effectiveTo) {
+		return false
+	}
+	return util.KeyMatch2(object, r.Object) && util.KeyMatch2(action, r.Action)
+}
+
+// CheckPermissionWithContext 检查权限，并使用权限检查上下文对条件规则求值。
+// 没有条件规则适用于该资源时结果与 CheckPermissionWithRoles 相同；
+// 否则结果依赖请求属性，不写入缓存。条件 deny 优先于任何 allow。
+func (em *EnforcerManager) CheckPermissionWithContext(ctx context.Context, subject, object, action, serviceName string, reqCtx map[string]string) (*PermissionResult, error) {
+	tenantID := tenantctx.GetTenantIDFromCtx(ctx)
+	domain := strconv.FormatUint(tenantID, 10)
+
+	rules, err := em.conditionalRules(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	attrs := NewAttributes(reqCtx)
+	var candidates []ConditionalRule
+	for _, r := range rules {
+		if r.Applies(object, action, attrs.Time) {
+			candidates = append(candidates, r)
+		}
+	}
+
+	base, err := em.CheckPermissionWithRoles(ctx, subject, object, action, serviceName)
+	if err != nil || len(candidates) == 0 || slices.Contains(base.AppliedRules, "superadmin_bypass") {
+		return base, err
+	}
+
+	enforcer, err := em.GetEnforcer(ctx)
+	if err != nil {
+		return nil, err
+	}
+	roles, err := enforcer.GetImplicitRolesForUser(subject, domain)
+	if err != nil {
+		return nil, err
+	}
+	subjects := append([]string{subject}, roles...)
+
+	var allowed, denied []string
+	for _, r := range candidates {
+		if !slices.Contains(subjects, r.Subject) {
+			continue
+		}
+
+		if r.Condition != nil && r.Condition.NeedsSubject() && attrs.Subject == nil {
+			if attrs.Subject, err = em.subjectAttributes(ctx, tenantID, subject); err != nil {
+				return nil, err
+			}
+		}
+
+		matched := r.Condition != nil && r.Condition.Match(attrs)
+		switch r.Effect {
+		case "deny":
+			if r.Condition == nil || matched {
+				denied = append(denied, fmt.Sprintf("condition:%d", r.ID))
+			}
+		case "allow":
+			if matched {
+				allowed = append(allowed, fmt.Sprintf("condition:%d", r.ID))
+			}
+		}
+	}
+
+	result := &PermissionResult{
+		Allowed:      base.Allowed,
+		Reason:       base.Reason,
+		AppliedRules: slices.Clone(base.AppliedRules),
+		FromCache:    base.FromCache,
+	}
+	switch {
+	case len(denied) > 0:
+		result.Allowed = false
+		result.Reason = fmt.Sprintf("access denied by conditional rules %v in domain %s", denied, domain)
+		result.AppliedRules = denied
+	case !result.Allowed && len(allowed) > 0:
+		result.Allowed = true
+		result.Reason = fmt.Sprintf("access granted via conditional rules %v in domain %s", allowed, domain)
+		result.AppliedRules = allowed
+	}
+
+	return result, nil
+}
+
+// InvalidateConditions 清除租户的条件规则缓存，下次检查时重新加载
+func (em *EnforcerManager) InvalidateConditions(tenantID uint64) {
+	em.conditions.Delete(tenantID)
+}
+
+// conditionalRules 获取租户的条件规则（带缓存）
+func (em *EnforcerManager) conditionalRules(ctx context.Context, tenantID uint64) ([]ConditionalRule, error) {
+	if rules, ok := em.conditions.Load(tenantID); ok {
+		return rules.([]ConditionalRule), nil
+	}
+
+	rules, err := LoadConditionalRules(ctx, em.db, tenantID, em.logger)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load conditional rules for tenant %d: %w", tenantID, err)
+	}
+	em.conditions.Store(tenantID, rules)
+
+	return rules, nil
+}
+
+// subjectAttributes 查询用户的部门和岗位，主体不是用户时返回空属性
+func (em *EnforcerManager) subjectAttributes(ctx context.Context, tenantID uint64, subject string) (map[string][]string, error) {
+	attrs := make(map[string][]string)
+
+	id, err := uuid.FromString(subject)
+	if err != nil {
+		return attrs, nil
+	}
+
+	u, err := em.db.User.Query().
+		Where(user.IDEQ(id), user.TenantIDEQ(tenantID)).
+		WithPositions().
+		Only(hooks.NewSystemContext(ctx))
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return attrs, nil
+		}
+		return nil, err
+	}
+
+	if u.DepartmentID != 0 {
+		attrs[SubjectDepartment] = []string{strconv.FormatUint(u.DepartmentID, 10)}
+	}
+	for _, p := range u.Edges.Positions {
+		attrs[SubjectPosition] = append(attrs[SubjectPosition], p.Code)
+	}
+
+	return attrs, nil
+}
@@ -23,6 +23,7 @@ type EnforcerManager struct {
 	db           *ent.Client
 	redis        redis.UniversalClient
 	enforcers    sync.Map // map[uint64]*casbin.SyncedEnforcer - 按租户ID缓存执行器
+	conditions   sync.Map // map[uint64][]ConditionalRule - 按租户ID缓存条件规则
 	modelText    string   // Casbin模型定义
 	mu           sync.RWMutex
 	logger       logx.Logger
@@ -37,6 +38,7 @@ func NewEnforcerManager(db *ent.Client, redisClient redis.UniversalClient, logge
 		db:           db,
 		redis:        redisClient,
 		enforcers:    sync.Map{},
+		conditions:   sync.Map{},
 		modelText:    getDefaultModel(),
 		logger:       logger,
 		cacheManager: NewCacheManager(redisClient, logger),
@@ -311,6 +313,7 @@ func (em *EnforcerManager) ReloadPolicy(ctx context.Context) error {
 	if err != nil {
 		em.logger.Errorf("Failed to invalidate tenant cache: %v", err)
 	}
+	em.conditions.Delete(tenantID)
 
 	if enforcer, ok := em.enforcers.Load(tenantID); ok {
 		err := enforcer.(*casbin.SyncedEnforcer).LoadPolicy()
@@ -328,6 +331,7 @@ func (em *EnforcerManager) ClearCache(ctx context.Context) {
 	tenantID := tenantctx.GetTenantIDFromCtx(ctx)
 
 	em.enforcers.Delete(tenantID)
+	em.conditions.Delete(tenantID)
 	em.logger.Infof("Cleared enforcer cache for tenant: %d", tenantID)
 }
 
@@ -337,11 +341,16 @@ func (em *EnforcerManager) ClearAllCache() {
 		em.enforcers.Delete(key)
 		return true
 	})
+	em.conditions.Range(func(key, value interface{}) bool {
+		em.conditions.Delete(key)
+		return true
+	})
 	em.logger.Info("Cleared all enforcer caches")
 }
 
 // getDefaultModel 获取默认的Casbin模型定义
 // 🔥 使用 RBAC with Domains 模型，确保租户ID在规则层面显式隔离
+// g 规则同时表示 用户->角色 和 子角色->父角色，g(r.sub, p.sub, r.dom) 在租户域内沿继承链匹配
 func getDefaultModel() string {
 	return `
 [request_definition]
@@ -357,7 +366,7 @@ g = _, _, _
 e = some(where (p.eft == allow)) && !some(where (p.eft == deny))
 
 [matchers]
-m = (g(r.sub, r.dom, p.sub) || (r.sub == p.sub)) && r.dom == p.dom && keyMatch2(r.obj, p.obj) && keyMatch2(r.act, p.act)
+m = (g(r.sub, p.sub, r.dom) || (r.sub == p.sub)) && r.dom == p.dom && keyMatch2(r.obj, p.obj) && keyMatch2(r.act, p.act)
 `
 }
 
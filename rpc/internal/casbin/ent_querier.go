@@ -22,13 +22,14 @@ func NewEntCasbinRuleQuerier(db *ent.Client) *EntCasbinRuleQuerier {
 }
 
 // QueryCasbinRules 实现CasbinRuleQuerier接口
-// 查询指定租户的所有Casbin规则
+// 查询指定租户的所有Casbin规则，带ABAC条件的规则由 CheckPermissionWithContext 单独求值，不加载到执行器
 func (q *EntCasbinRuleQuerier) QueryCasbinRules(ctx context.Context, tenantID uint64) ([]commontypes.CasbinRuleEntity, error) {
 	// 查询数据库中的规则
 	rules, err := q.db.CasbinRule.Query().
 		Where(
 			casbinrule.TenantIDEQ(tenantID),
 			casbinrule.StatusEQ(1), // 只查询启用的规则
+			casbinrule.Or(casbinrule.ConditionsIsNil(), casbinrule.ConditionsEQ("")),
 		).
 		All(ctx)
 
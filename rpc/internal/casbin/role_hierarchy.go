@@ -0,0 +1,85 @@
+package casbin
+
+import (
+	"context"
+	"slices"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/coder-lulu/newbee-common/v2/orm/ent/hooks"
+	"github.com/coder-lulu/newbee-core/rpc/ent"
+	"github.com/coder-lulu/newbee-core/rpc/ent/casbinrule"
+	"github.com/coder-lulu/newbee-core/rpc/ent/role"
+)
+
+// RoleInheritanceCategory 角色继承规则的分类
+// 角色继承与用户角色共用 g 规则：v0=子角色代码, v1=父角色代码, v2=租户ID（domain）
+const RoleInheritanceCategory = "role_inheritance"
+
+// RoleParents 加载租户内的角色继承关系，返回 子角色代码 -> 父角色代码列表
+func RoleParents(ctx context.Context, db *ent.Client, tenantID uint64) (map[string][]string, error) {
+	rules, err := db.CasbinRule.Query().
+		Where(
+			casbinrule.TenantIDEQ(tenantID),
+			casbinrule.PtypeEQ("g"),
+			casbinrule.CategoryEQ(RoleInheritanceCategory),
+			casbinrule.StatusEQ(1),
+		).
+		All(hooks.NewSystemContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	parents := make(map[string][]string, len(rules))
+	for _, r := range rules {
+		parents[r.V0] = append(parents[r.V0], r.V1)
+	}
+
+	return parents, nil
+}
+
+// LockRoles 锁定租户的全部角色行，db 必须是事务的客户端。
+// 修改角色继承的事务在读取继承关系前加锁，依次执行，继承环检查不会被并发修改绕过
+func LockRoles(ctx context.Context, db *ent.Client, tenantID uint64) error {
+	var ids []uint64
+	return db.Role.Query().
+		Where(role.TenantIDEQ(tenantID)).
+		Order(ent.Asc(role.FieldID)).
+		Select(role.FieldID).
+		Modify(func(s *sql.Selector) { s.ForUpdate() }).
+		Scan(hooks.NewSystemContext(ctx), &ids)
+}
+
+// ExpandRoles 返回角色及其全部祖先角色，按广度优先顺序去重
+func ExpandRoles(parents map[string][]string, roles ...string) []string {
+	result := make([]string, 0, len(roles))
+	visited := make(map[string]bool, len(roles))
+	queue := append([]string{}, roles...)
+
+	for len(queue) > 0 {
+		code := queue[0]
+		queue = queue[1:]
+		if code == "" || visited[code] {
+			continue
+		}
+		visited[code] = true
+		result = append(result, code)
+		queue = append(queue, parents[code]...)
+	}
+
+	return result
+}
+
+// EffectiveRoles 查询租户内的继承关系并展开角色
+func EffectiveRoles(ctx context.Context, db *ent.Client, tenantID uint64, roles ...string) ([]string, error) {
+	parents, err := RoleParents(ctx, db, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	return ExpandRoles(parents, roles...), nil
+}
+
+// CreatesCycle 判断将 role 的父角色设置为 newParents 后是否形成继承环
+func CreatesCycle(parents map[string][]string, role string, newParents []string) bool {
+	return slices.Contains(ExpandRoles(parents, newParents...), role)
+}
@@ -1,6 +1,8 @@
 package config
 
 import (
+	"time"
+
 	"github.com/coder-lulu/newbee-common/v2/plugins/casbin"
 	"github.com/zeromicro/go-zero/zrpc"
 
@@ -9,8 +11,97 @@ import (
 
 type Config struct {
 	zrpc.RpcServerConf
-	DatabaseConf config.DatabaseConf
-	CasbinConf   casbin.CasbinConf
-	RedisConf    config.RedisConf
-	EncryptionKey string `json:",optional"` // OAuth Provider加密密钥
+	DatabaseConf  config.DatabaseConf
+	CasbinConf    casbin.CasbinConf
+	RedisConf     config.RedisConf
+	EncryptionKey string            `json:",optional"` // OAuth Provider加密密钥
+	OauthStateKey string            `json:",optional"` // OAuth 登录 state 签名密钥，未配置时使用 EncryptionKey
+	TokenJanitor  TokenJanitorConf  `json:",optional"`
+	AuditLog      AuditLogConf      `json:",optional"`
+	Saml          SamlConf          `json:",optional"`
+	Ldap          LdapConf          `json:",optional"`
+	OauthToken    OauthTokenConf    `json:",optional"`
+	Impersonation ImpersonationConf `json:",optional"`
+	Token         TokenConf         `json:",optional"`
+}
+
+// TokenJanitorConf 过期令牌清理任务配置
+type TokenJanitorConf struct {
+	Enabled    bool          `json:",default=true"`
+	Interval   time.Duration `json:",default=1h"`                              // 清理间隔
+	BatchSize  int           `json:",default=1000"`                            // 每批处理的行数
+	Retention  time.Duration `json:",default=168h"`                            // 过期后保留时长，便于排查问题
+	Mode       string        `json:",default=delete,options=[delete,archive]"` // archive 模式在删除前写入归档文件
+	ArchiveDir string        `json:",optional"`                                // 归档目录，Mode 为 archive 时必填
+}
+
+// AuditLogConf 审计日志哈希链配置
+type AuditLogConf struct {
+	ActiveKeyID        string             `json:",optional"`     // 当前用于签名的密钥ID
+	SigningKeys        []AuditSigningKey  `json:",optional"`     // 签名密钥，轮换后旧密钥需保留用于校验历史检查点
+	CheckpointInterval time.Duration      `json:",default=10m"`  // 签名检查点的写入间隔
+	Retention          time.Duration      `json:",optional"`     // 默认保留时长，0 表示永久保留，租户可在配置中覆盖
+	BatchSize          int                `json:",default=1000"` // 每批清理的行数
+	Archive            AuditArchiveConf   `json:",optional"`
+	Partition          AuditPartitionConf `json:",optional"`
+}
+
+// AuditArchiveConf 审计日志归档配置，开启后超出保留期的记录先归档再清理
+type AuditArchiveConf struct {
+	Enabled     bool              `json:",optional"`
+	Store       string            `json:",default=local"` // 存储类型，local 或通过 auditarchive.RegisterStore 注册的类型
+	Dir         string            `json:",optional"`      // local 存储的目录
+	Options     map[string]string `json:",optional"`      // 其它存储类型的参数
+	MaxArchives int               `json:",default=50"`    // 单次恢复查询最多读取的归档文件数
+}
+
+// AuditPartitionConf 审计日志表按月分区配置，仅支持 MySQL 和 PostgreSQL
+type AuditPartitionConf struct {
+	Enabled bool `json:",optional"`
+	Ahead   int  `json:",default=3"` // 提前创建的分区月数
+}
+
+// AuditSigningKey 审计日志检查点签名密钥
+type AuditSigningKey struct {
+	ID     string
+	Secret string
+}
+
+// SamlConf SAML 服务提供方配置
+type SamlConf struct {
+	BaseURL    string        `json:",optional"`    // API 服务对外地址，用于生成默认的 SP 实体ID和断言消费地址
+	CertFile   string        `json:",optional"`    // SP 签名证书(PEM)
+	KeyFile    string        `json:",optional"`    // SP 签名私钥(PEM)，未配置时无法签名 AuthnRequest
+	RequestTTL time.Duration `json:",default=10m"` // 认证请求的有效期
+	ClockSkew  time.Duration `json:",default=1m"`  // 校验断言时间时容忍的时钟偏差
+}
+
+// LdapConf LDAP 目录认证与同步配置
+type LdapConf struct {
+	Timeout           time.Duration `json:",default=10s"`  // 连接和查询超时
+	PageSize          uint32        `json:",default=500"`  // 分页查询的每页条数
+	SyncEnabled       bool          `json:",default=true"` // 是否运行定时同步任务，各目录的同步间隔在目录配置中设置
+	SyncCheckInterval time.Duration `json:",default=1m"`   // 检查到期目录的间隔
+	MaxReportEntries  int           `json:",default=1000"` // 每次同步记录的变更和冲突条数上限
+}
+
+// OauthTokenConf 第三方账号令牌刷新配置
+type OauthTokenConf struct {
+	RefreshEnabled  bool          `json:",default=true"` // 是否运行后台刷新任务
+	RefreshInterval time.Duration `json:",default=5m"`   // 扫描即将过期令牌的间隔
+	RefreshAhead    time.Duration `json:",default=10m"`  // 在过期前多久刷新，获取令牌时同样按此提前刷新
+	BatchSize       int           `json:",default=100"`  // 每次扫描刷新的账号数上限
+	Timeout         time.Duration `json:",default=10s"`  // 请求第三方平台的超时
+}
+
+// ImpersonationConf 超级管理员进入租户的会话配置
+type ImpersonationConf struct {
+	DefaultDuration time.Duration `json:",default=1h"` // 未指定时长时的会话时长
+	MaxDuration     time.Duration `json:",default=4h"` // 会话最长时长
+	RequireApproval bool          `json:",optional"`   // 是否需要另一名超级管理员审批后生效
+}
+
+// TokenConf 令牌配置
+type TokenConf struct {
+	MaxLifetime time.Duration `json:",default=720h"` // API 签发的访问令牌和刷新令牌的最长有效期，吊销水位线保留同样的时长
 }
@@ -0,0 +1,331 @@
+package impersonation
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/coder-lulu/newbee-common/v2/orm/ent/hooks"
+	"github.com/coder-lulu/newbee-core/rpc/ent"
+	"github.com/coder-lulu/newbee-core/rpc/ent/auditlog"
+	"github.com/coder-lulu/newbee-core/rpc/ent/impersonationsession"
+	"github.com/coder-lulu/newbee-core/rpc/internal/config"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/auditchain"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// 会话状态，到期的 active 会话视为已结束
+const (
+	StatePending  = "pending"
+	StateActive   = "active"
+	StateEnded    = "ended"
+	StateRejected = "rejected"
+)
+
+// 会话事件，记录在目标租户的审计日志中
+const (
+	EventRequest = "request"
+	EventStart   = "start"
+	EventReject  = "reject"
+	EventEnd     = "end"
+)
+
+// AuditResourceType 会话事件审计日志的资源类型
+const AuditResourceType = "impersonation_session"
+
+var (
+	// ErrReasonRequired 未填写访问原因
+	ErrReasonRequired = errors.New("impersonation reason is required")
+	// ErrDurationExceeded 申请时长超过上限
+	ErrDurationExceeded = errors.New("impersonation duration exceeds the maximum")
+	// ErrNotPending 会话不是待审批状态
+	ErrNotPending = errors.New("impersonation session is not pending")
+	// ErrSelfApproval 不能审批自己的申请
+	ErrSelfApproval = errors.New("impersonation session cannot be approved by the requester")
+)
+
+// TenantSwitchKey 租户中间件读取的激活租户键，值为目标租户ID，过期后自动回到原租户
+func TenantSwitchKey(userID string) string {
+	return "admin:tenant:" + userID
+}
+
+// SessionKey 激活会话的信息，审计日志据此同时记录真实身份和进入的租户
+func SessionKey(userID string) string {
+	return "admin:impersonation:" + userID
+}
+
+// Session 激活会话的信息，保存在 Redis 中，与 TenantSwitchKey 同时过期
+type Session struct {
+	ID             uint64 `json:"id"`
+	UserID         string `json:"userId"`
+	UserName       string `json:"userName,omitempty"`
+	OriginTenantID uint64 `json:"originTenantId"`
+	TargetTenantID uint64 `json:"targetTenantId"`
+	Reason         string `json:"reason"`
+	Ticket         string `json:"ticket,omitempty"`
+	ExpiresAt      int64  `json:"expiresAt"`
+}
+
+// StartRequest 进入租户的申请
+type StartRequest struct {
+	UserID         string
+	UserName       string
+	OriginTenantID uint64
+	TargetTenantID uint64
+	Reason         string
+	Ticket         string
+	Duration       time.Duration
+}
+
+// Manager 管理超级管理员进入租户的会话
+type Manager struct {
+	db     *ent.Client
+	rds    redis.UniversalClient
+	conf   config.ImpersonationConf
+	logger logx.Logger
+}
+
+func NewManager(db *ent.Client, rds redis.UniversalClient, conf config.ImpersonationConf, logger logx.Logger) *Manager {
+	return &Manager{db: db, rds: rds, conf: conf, logger: logger}
+}
+
+// Start 创建会话。未开启审批时立即生效，否则等待另一名超级管理员审批
+func (m *Manager) Start(ctx context.Context, req StartRequest) (*ent.ImpersonationSession, error) {
+	req.Reason = strings.TrimSpace(req.Reason)
+	if req.Reason == "" {
+		return nil, ErrReasonRequired
+	}
+	if req.Duration <= 0 {
+		req.Duration = m.conf.DefaultDuration
+	}
+	if req.Duration > m.conf.MaxDuration {
+		return nil, ErrDurationExceeded
+	}
+
+	s, err := m.db.ImpersonationSession.Create().
+		SetUserID(req.UserID).
+		SetUserName(req.UserName).
+		SetOriginTenantID(req.OriginTenantID).
+		SetTargetTenantID(req.TargetTenantID).
+		SetReason(req.Reason).
+		SetTicket(strings.TrimSpace(req.Ticket)).
+		SetState(StatePending).
+		SetDurationSeconds(int64(req.Duration / time.Second)).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if m.conf.RequireApproval {
+		m.audit(ctx, s, EventRequest, req.UserID)
+		return s, nil
+	}
+
+	return m.activate(ctx, s, "")
+}
+
+// Review 审批待生效的会话，approve 为 false 时拒绝
+func (m *Manager) Review(ctx context.Context, id uint64, reviewerID string, approve bool) (*ent.ImpersonationSession, error) {
+	s, err := m.db.ImpersonationSession.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if s.State != StatePending {
+		return nil, ErrNotPending
+	}
+	if s.UserID == reviewerID {
+		return nil, ErrSelfApproval
+	}
+
+	if approve {
+		return m.activate(ctx, s, reviewerID)
+	}
+
+	s, err = s.Update().
+		SetState(StateRejected).
+		SetApprovedBy(reviewerID).
+		SetApprovedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	m.audit(ctx, s, EventReject, reviewerID)
+
+	return s, nil
+}
+
+// End 结束用户的生效会话并清除租户切换状态
+func (m *Manager) End(ctx context.Context, userID string) error {
+	sessions, err := m.db.ImpersonationSession.Query().
+		Where(
+			impersonationsession.UserIDEQ(userID),
+			impersonationsession.StateEQ(StateActive),
+		).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err = m.rds.Del(ctx, TenantSwitchKey(userID), SessionKey(userID)).Err(); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	for _, s := range sessions {
+		// 已到期的会话由 Redis 过期自动结束，只更新状态
+		if s.ExpiresAt != nil && s.ExpiresAt.Before(now) {
+			if err = s.Update().SetState(StateEnded).Exec(ctx); err != nil {
+				return err
+			}
+			continue
+		}
+		s, err = s.Update().SetState(StateEnded).SetEndedAt(now).Save(ctx)
+		if err != nil {
+			return err
+		}
+		m.audit(ctx, s, EventEnd, userID)
+	}
+
+	return nil
+}
+
+// Active 返回用户当前生效的会话，没有时返回 nil
+func (m *Manager) Active(ctx context.Context, userID string) (*Session, error) {
+	return ActiveSession(ctx, m.rds, userID)
+}
+
+// ActiveSession 从 Redis 读取用户当前生效的会话，没有时返回 nil
+func ActiveSession(ctx context.Context, rds redis.UniversalClient, userID string) (*Session, error) {
+	if userID == "" {
+		return nil, nil
+	}
+
+	data, err := rds.Get(ctx, SessionKey(userID)).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	s := &Session{}
+	if err = json.Unmarshal(data, s); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// activate 使会话生效：写入租户切换状态，到期后由 Redis 自动清除。
+// 同一用户同时只有一个生效会话，已有的会话会先结束
+func (m *Manager) activate(ctx context.Context, s *ent.ImpersonationSession, approvedBy string) (*ent.ImpersonationSession, error) {
+	if err := m.End(ctx, s.UserID); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	duration := time.Duration(s.DurationSeconds) * time.Second
+
+	update := s.Update().
+		SetState(StateActive).
+		SetStartedAt(now).
+		SetExpiresAt(now.Add(duration))
+	if approvedBy != "" {
+		update.SetApprovedBy(approvedBy).SetApprovedAt(now)
+	}
+	s, err := update.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(&Session{
+		ID:             s.ID,
+		UserID:         s.UserID,
+		UserName:       s.UserName,
+		OriginTenantID: s.OriginTenantID,
+		TargetTenantID: s.TargetTenantID,
+		Reason:         s.Reason,
+		Ticket:         s.Ticket,
+		ExpiresAt:      s.ExpiresAt.Unix(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = m.rds.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Set(ctx, SessionKey(s.UserID), data, duration)
+		pipe.Set(ctx, TenantSwitchKey(s.UserID), strconv.FormatUint(s.TargetTenantID, 10), duration)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to save tenant switch: %w", err)
+	}
+
+	m.audit(ctx, s, EventStart, s.UserID)
+
+	return s, nil
+}
+
+// audit 在目标租户的审计日志中记录会话事件，失败只记录日志
+func (m *Manager) audit(ctx context.Context, s *ent.ImpersonationSession, event, actorID string) {
+	record := &ent.AuditLog{
+		TenantID:       strconv.FormatUint(s.TargetTenantID, 10),
+		Status:         1,
+		UserID:         actorID,
+		OperationType:  auditlog.OperationTypeUPDATE,
+		ResourceType:   AuditResourceType,
+		ResourceID:     strconv.FormatUint(s.ID, 10),
+		RequestMethod:  "RPC",
+		RequestPath:    "impersonation/" + event,
+		ResponseStatus: 200,
+		Metadata: map[string]interface{}{
+			"impersonation": Attribution(s.ID, s.UserID, s.OriginTenantID, s.TargetTenantID, s.Reason, s.Ticket),
+			"event":         event,
+		},
+	}
+	if actorID == s.UserID {
+		record.UserName = s.UserName
+	}
+	if event == EventRequest || event == EventStart {
+		record.OperationType = auditlog.OperationTypeCREATE
+	}
+
+	if _, err := auditchain.Append(hooks.NewSystemContext(ctx), m.db, record); err != nil {
+		m.logger.Errorw("failed to write impersonation audit log",
+			logx.Field("sessionId", s.ID),
+			logx.Field("event", event),
+			logx.Field("error", err.Error()))
+	}
+}
+
+// Attribution 审计日志中记录的会话信息，包含真实身份（用户和所属租户）和进入的租户
+func Attribution(sessionID uint64, userID string, originTenantID, targetTenantID uint64, reason, ticket string) map[string]interface{} {
+	return map[string]interface{}{
+		"sessionId":      sessionID,
+		"realUserId":     userID,
+		"realTenantId":   strconv.FormatUint(originTenantID, 10),
+		"targetTenantId": strconv.FormatUint(targetTenantID, 10),
+		"reason":         reason,
+		"ticket":         ticket,
+	}
+}
+
+// Annotate 用户处于生效会话且记录属于进入的租户时，在审计日志中附加会话信息
+func Annotate(ctx context.Context, rds redis.UniversalClient, record *ent.AuditLog) error {
+	s, err := ActiveSession(ctx, rds, record.UserID)
+	if err != nil || s == nil || record.TenantID != strconv.FormatUint(s.TargetTenantID, 10) {
+		return err
+	}
+
+	if record.Metadata == nil {
+		record.Metadata = make(map[string]interface{})
+	}
+	record.Metadata["impersonation"] = Attribution(s.ID, s.UserID, s.OriginTenantID, s.TargetTenantID, s.Reason, s.Ticket)
+
+	return nil
+}
@@ -0,0 +1,45 @@
+// Package initjob defines the progress and the plan recorded by the tenant initialization jobs
+package initjob
+
+import "time"
+
+const (
+	// StatusPending 已入队，等待执行
+	StatusPending = "pending"
+	// StatusRunning 正在执行
+	StatusRunning = "running"
+	// StatusSuccess 执行成功
+	StatusSuccess = "success"
+	// StatusFailed 执行失败，可以重试失败的插件
+	StatusFailed = "failed"
+	// StatusSkipped 依赖的插件未成功，插件未执行
+	StatusSkipped = "skipped"
+	// StatusRolledBack 后续插件失败，已回滚插件创建的数据
+	StatusRolledBack = "rolledback"
+)
+
+const (
+	// ActionCreate the records would be created
+	ActionCreate = "create"
+	// ActionSkip the records exist already and would be kept
+	ActionSkip = "skip"
+)
+
+// PluginProgress is the progress of a plugin in the job
+type PluginProgress struct {
+	Name       string     `json:"name"`
+	Status     string     `json:"status"`
+	Error      string     `json:"error,omitempty"`
+	Attempts   int        `json:"attempts"`
+	StartedAt  *time.Time `json:"startedAt,omitempty"`
+	FinishedAt *time.Time `json:"finishedAt,omitempty"`
+}
+
+// PlanItem is what a plugin would create for a component in a dry run
+type PlanItem struct {
+	Plugin    string   `json:"plugin"`
+	Component string   `json:"component"`
+	Action    string   `json:"action"`
+	Count     int      `json:"count"`
+	Items     []string `json:"items,omitempty"`
+}
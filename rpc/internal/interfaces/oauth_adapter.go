@@ -17,7 +17,10 @@ const (
 	ProviderTypeGoogle   OAuthProviderType = "google"
 	ProviderTypeFacebook OAuthProviderType = "facebook"
 	ProviderTypeFeishu   OAuthProviderType = "feishu"
+	ProviderTypeOIDC     OAuthProviderType = "oidc"
 	ProviderTypeCustom   OAuthProviderType = "custom"
+	ProviderTypeSAML     OAuthProviderType = "saml" // SAML 2.0 身份源由 saml 包处理，不经过适配器工厂
+	ProviderTypeLDAP     OAuthProviderType = "ldap" // LDAP / AD 目录由 ldap 包处理，不经过适配器工厂
 )
 
 // OAuthUserInfo represents standardized user information from OAuth providers
@@ -29,6 +32,7 @@ type OAuthUserInfo struct {
 	Email       string `json:"email"`        // Email address
 	Avatar      string `json:"avatar"`       // Avatar/profile picture URL
 	PhoneNumber string `json:"phone_number"` // Phone number (if available)
+	Groups      []string `json:"groups,omitempty"` // Groups or roles granted by the provider
 	
 	// Provider-specific metadata
 	ProviderType OAuthProviderType          `json:"provider_type"`
@@ -143,8 +147,36 @@ const (
 	FeatureEmailScope   OAuthFeature = "email_scope"   // Email access
 	FeaturePhoneScope   OAuthFeature = "phone_scope"   // Phone access
 	FeatureProfileScope OAuthFeature = "profile_scope" // Profile access
+	FeatureIDToken      OAuthFeature = "id_token"      // OpenID Connect ID token
 )
 
+// WebhookEventType is the normalized type of a provider webhook event
+type WebhookEventType string
+
+const (
+	WebhookEventDeauthorized   WebhookEventType = "deauthorized"    // 用户撤销了对应用的授权
+	WebhookEventAccountDeleted WebhookEventType = "account_deleted" // 第三方账号注销或要求删除数据
+	WebhookEventProfileUpdated WebhookEventType = "profile_updated" // 第三方账号资料变更
+	WebhookEventVerification   WebhookEventType = "verification"    // 回调地址校验，需要原样返回 challenge
+	WebhookEventIgnored        WebhookEventType = "ignored"         // 不处理的事件
+)
+
+// WebhookEvent is a verified webhook event of a provider
+type WebhookEvent struct {
+	ID             string           // Event ID used for idempotent processing
+	Type           WebhookEventType // Normalized event type
+	Name           string           // Event name of the provider, e.g. github_app_authorization.revoked
+	ProviderUserID string           // The third-party account the event is about
+	UserInfo       *OAuthUserInfo   // New profile of profile_updated events
+	Challenge      string           // Response of verification events
+}
+
+// WebhookAdapter is implemented by adapters which receive webhooks from their provider. The headers keys are
+// lower case, the signature must be verified against the raw body before the event is trusted.
+type WebhookAdapter interface {
+	ParseWebhook(ctx context.Context, headers map[string]string, body []byte) (*WebhookEvent, error)
+}
+
 // OAuthAdapterFactory is responsible for creating OAuth adapters
 type OAuthAdapterFactory interface {
 	// CreateAdapter creates an OAuth adapter for the specified provider type
@@ -0,0 +1,312 @@
+package janitor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/bsm/redislock"
+	"github.com/coder-lulu/newbee-common/v2/orm/ent/hooks"
+	"github.com/redis/go-redis/v9"
+	"github.com/zeromicro/go-zero/core/logx"
+
+	"github.com/coder-lulu/newbee-core/rpc/ent"
+	"github.com/coder-lulu/newbee-core/rpc/ent/auditlog"
+	"github.com/coder-lulu/newbee-core/rpc/ent/auditlogcheckpoint"
+	"github.com/coder-lulu/newbee-core/rpc/internal/auditarchive"
+	"github.com/coder-lulu/newbee-core/rpc/internal/config"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/auditchain"
+)
+
+const auditJanitorLockKey = "AUDIT:JANITOR:LOCK"
+
+// AuditLogRetentionDaysKey 租户配置中审计日志保留天数的键，配置后覆盖全局保留时长，小于等于 0 表示永久保留
+const AuditLogRetentionDaysKey = "audit_log_retention_days"
+
+// AuditJanitor periodically writes signed checkpoints of the audit log hash chains,
+// archives and prunes the records out of retention and maintains the table partitions.
+type AuditJanitor struct {
+	conf   config.AuditLogConf
+	dbType string
+	db     *ent.Client
+	signer *auditchain.Signer
+	store  auditarchive.BlobStore
+	locker *redislock.Client
+	stopCh chan struct{}
+	once   sync.Once
+	logger logx.Logger
+}
+
+// NewAuditJanitor creates an audit log janitor, store is nil when archiving is disabled
+func NewAuditJanitor(c config.AuditLogConf, dbType string, db *ent.Client, rds redis.UniversalClient,
+	signer *auditchain.Signer, store auditarchive.BlobStore) *AuditJanitor {
+	return &AuditJanitor{
+		conf:   c,
+		dbType: dbType,
+		db:     db,
+		signer: signer,
+		store:  store,
+		locker: redislock.New(rds),
+		stopCh: make(chan struct{}),
+		logger: logx.WithContext(context.Background()),
+	}
+}
+
+// Start runs the janitor in background
+func (j *AuditJanitor) Start() {
+	go func() {
+		ticker := time.NewTicker(j.conf.CheckpointInterval)
+		defer ticker.Stop()
+
+		for {
+			j.RunOnce()
+
+			select {
+			case <-ticker.C:
+			case <-j.stopCh:
+				return
+			}
+		}
+	}()
+}
+
+// Stop stops the janitor
+func (j *AuditJanitor) Stop() {
+	j.once.Do(func() {
+		close(j.stopCh)
+	})
+}
+
+// RunOnce maintains the partitions, checkpoints and prunes the chains of all tenants once if the lock is obtained
+func (j *AuditJanitor) RunOnce() {
+	ctx := hooks.NewSystemContext(context.Background())
+
+	_, err := j.locker.Obtain(ctx, auditJanitorLockKey, j.conf.CheckpointInterval, nil)
+	if errors.Is(err, redislock.ErrNotObtained) {
+		return
+	} else if err != nil {
+		j.logger.Errorw("failed to obtain audit janitor lock", logx.Field("detail", err.Error()))
+		return
+	}
+
+	if j.conf.Partition.Enabled {
+		if err = auditarchive.EnsurePartitions(ctx, j.db, j.dbType, j.conf.Partition.Ahead); err != nil {
+			j.logger.Errorw("failed to maintain audit log partitions", logx.Field("detail", err.Error()))
+		}
+	}
+
+	tenantIDs, err := j.db.AuditLog.Query().
+		Where(auditlog.SeqNotNil()).
+		Unique(true).
+		Select(auditlog.FieldTenantID).
+		Strings(ctx)
+	if err != nil {
+		j.logger.Errorw("failed to query audit log tenants", logx.Field("detail", err.Error()))
+		return
+	}
+
+	for _, tenantID := range tenantIDs {
+		if err = j.checkpoint(ctx, tenantID); err != nil {
+			j.logger.Errorw("failed to write audit log checkpoint", logx.Field("detail", err.Error()),
+				logx.Field("tenantId", tenantID))
+		}
+
+		if retention := j.retention(ctx, tenantID); retention > 0 {
+			total, err := j.prune(ctx, tenantID, retention, time.Now().Add(-retention))
+			if err != nil {
+				j.logger.Errorw("failed to prune audit logs", logx.Field("detail", err.Error()),
+					logx.Field("tenantId", tenantID), logx.Field("pruned", total))
+			} else if total > 0 {
+				j.logger.Infow("audit logs pruned", logx.Field("tenantId", tenantID), logx.Field("pruned", total))
+			}
+		}
+	}
+}
+
+// retention returns the retention of the tenant, 0 means keeping forever
+func (j *AuditJanitor) retention(ctx context.Context, tenantID string) time.Duration {
+	id, err := strconv.ParseUint(tenantID, 10, 64)
+	if err != nil {
+		return j.conf.Retention
+	}
+
+	tenantInfo, err := j.db.Tenant.Get(ctx, id)
+	if err != nil {
+		return j.conf.Retention
+	}
+
+	if v, ok := tenantInfo.Config[AuditLogRetentionDaysKey].(float64); ok {
+		if v <= 0 {
+			return 0
+		}
+		return time.Duration(v * float64(24*time.Hour))
+	}
+
+	return j.conf.Retention
+}
+
+// checkpoint signs the current chain head of the tenant if it moved since the last checkpoint
+func (j *AuditJanitor) checkpoint(ctx context.Context, tenantID string) error {
+	head, err := auditchain.Head(ctx, j.db, tenantID)
+	if err != nil || head == nil {
+		return err
+	}
+
+	exist, err := j.db.AuditLogCheckpoint.Query().
+		Where(auditlogcheckpoint.TenantIDEQ(tenantID), auditlogcheckpoint.SeqGTE(*head.Seq)).
+		Exist(ctx)
+	if err != nil || exist {
+		return err
+	}
+
+	keyID, signature := j.signer.Sign(auditchain.CheckpointPayload(tenantID, *head.Seq, head.Hash))
+	return j.db.AuditLogCheckpoint.Create().
+		SetTenantID(tenantID).
+		SetSeq(*head.Seq).
+		SetHash(head.Hash).
+		SetKeyID(keyID).
+		SetSignature(signature).
+		Exec(ctx)
+}
+
+// prune deletes the records created before the cutoff from the start of the chain.
+// Every batch is verified, archived if a store is configured, and recorded with a signed prune record.
+// The latest record is always kept.
+func (j *AuditJanitor) prune(ctx context.Context, tenantID string, retention time.Duration, cutoff time.Time) (int, error) {
+	head, err := auditchain.Head(ctx, j.db, tenantID)
+	if err != nil || head == nil {
+		return 0, err
+	}
+
+	report := &auditchain.Report{}
+	anchorSeq, prevHash, err := auditchain.Anchor(ctx, j.db, j.signer, tenantID, report)
+	if err != nil {
+		return 0, err
+	}
+	if !report.Valid() {
+		return 0, fmt.Errorf("prune records are invalid: %s", report.Issues[0].Detail)
+	}
+
+	total := 0
+	for {
+		select {
+		case <-j.stopCh:
+			return total, nil
+		default:
+		}
+
+		logs, err := j.db.AuditLog.Query().
+			Where(auditlog.TenantIDEQ(tenantID),
+				auditlog.SeqGT(anchorSeq),
+				auditlog.SeqLT(*head.Seq),
+				auditlog.CreatedAtLT(cutoff)).
+			Order(ent.Asc(auditlog.FieldSeq)).
+			Limit(j.conf.BatchSize).
+			All(ctx)
+		if err != nil {
+			return total, err
+		}
+
+		if len(logs) == 0 {
+			return total, nil
+		}
+
+		// 不能让清理掩盖篡改，删除前确认这一批记录完整
+		for _, v := range logs {
+			if *v.Seq != anchorSeq+1 || v.PrevHash != prevHash || auditchain.Hash(v) != v.Hash {
+				return total, fmt.Errorf("audit log chain is broken at seq %d, pruning stopped", *v.Seq)
+			}
+			anchorSeq, prevHash = *v.Seq, v.Hash
+		}
+
+		if err = j.pruneBatch(ctx, tenantID, logs, retention, cutoff); err != nil {
+			return total, err
+		}
+		total += len(logs)
+
+		if len(logs) < j.conf.BatchSize {
+			return total, nil
+		}
+	}
+}
+
+func (j *AuditJanitor) pruneBatch(ctx context.Context, tenantID string, logs []*ent.AuditLog, retention time.Duration, cutoff time.Time) error {
+	fromSeq, toSeq := *logs[0].Seq, *logs[len(logs)-1].Seq
+	lastHash := logs[len(logs)-1].Hash
+	policy := fmt.Sprintf("retention:%s", retention)
+	keyID, signature := j.signer.Sign(auditchain.PrunePayload(tenantID, fromSeq, toSeq, len(logs), lastHash, cutoff))
+
+	// 对象键由序号范围决定，事务失败后重试会覆盖同一个对象
+	var archive *ent.AuditLogArchive
+	if j.store != nil {
+		data, err := auditarchive.Encode(logs)
+		if err != nil {
+			return err
+		}
+		key := auditarchive.ObjectKey(tenantID, fromSeq, toSeq)
+		if err = j.store.Put(ctx, key, data); err != nil {
+			return err
+		}
+		policy = fmt.Sprintf("archive:%s", retention)
+		archive = &ent.AuditLogArchive{
+			ObjectKey: key,
+			Size:      int64(len(data)),
+			Checksum:  auditarchive.Checksum(data),
+			StartAt:   logs[0].CreatedAt,
+			EndAt:     logs[len(logs)-1].CreatedAt,
+		}
+	}
+
+	tx, err := j.db.Tx(ctx)
+	if err != nil {
+		return err
+	}
+
+	if archive != nil {
+		err = tx.AuditLogArchive.Create().
+			SetTenantID(tenantID).
+			SetFromSeq(fromSeq).
+			SetToSeq(toSeq).
+			SetCount(len(logs)).
+			SetStartAt(archive.StartAt).
+			SetEndAt(archive.EndAt).
+			SetStore(j.conf.Archive.Store).
+			SetObjectKey(archive.ObjectKey).
+			SetSize(archive.Size).
+			SetChecksum(archive.Checksum).
+			Exec(ctx)
+		if err != nil {
+			_ = tx.Rollback()
+			return err
+		}
+	}
+
+	err = tx.AuditLogPruneRecord.Create().
+		SetTenantID(tenantID).
+		SetFromSeq(fromSeq).
+		SetToSeq(toSeq).
+		SetCount(len(logs)).
+		SetLastHash(lastHash).
+		SetCutoffAt(cutoff).
+		SetPolicy(policy).
+		SetKeyID(keyID).
+		SetSignature(signature).
+		Exec(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	_, err = tx.AuditLog.Delete().
+		Where(auditlog.TenantIDEQ(tenantID), auditlog.SeqGTE(fromSeq), auditlog.SeqLTE(toSeq)).
+		Exec(auditchain.WithPrune(ctx))
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
@@ -0,0 +1,215 @@
+package janitor
+
+import (
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/bsm/redislock"
+	"github.com/coder-lulu/newbee-common/v2/orm/ent/hooks"
+	"github.com/gofrs/uuid/v5"
+	"github.com/redis/go-redis/v9"
+	"github.com/zeromicro/go-zero/core/logx"
+
+	"github.com/coder-lulu/newbee-core/rpc/ent"
+	"github.com/coder-lulu/newbee-core/rpc/ent/token"
+	"github.com/coder-lulu/newbee-core/rpc/internal/config"
+)
+
+const (
+	tokenJanitorLockKey = "TOKEN:JANITOR:LOCK"
+	modeArchive         = "archive"
+)
+
+// archivedToken 归档的令牌元数据，不包含令牌本身
+type archivedToken struct {
+	ID           string     `json:"id"`
+	TenantID     uint64     `json:"tenantId"`
+	UUID         string     `json:"uuid"`
+	Username     string     `json:"username"`
+	Source       string     `json:"source"`
+	Status       uint8      `json:"status"`
+	DepartmentID uint64     `json:"departmentId"`
+	Device       string     `json:"device"`
+	IP           string     `json:"ip"`
+	CreatedAt    time.Time  `json:"createdAt"`
+	ExpiredAt    time.Time  `json:"expiredAt"`
+	LastSeenAt   *time.Time `json:"lastSeenAt,omitempty"`
+}
+
+// TokenJanitor periodically purges expired tokens. Multiple RPC instances share a redis lock
+// so that only one of them works at a time.
+type TokenJanitor struct {
+	conf   config.TokenJanitorConf
+	db     *ent.Client
+	locker *redislock.Client
+	stopCh chan struct{}
+	once   sync.Once
+	logger logx.Logger
+}
+
+// NewTokenJanitor creates a token janitor
+func NewTokenJanitor(c config.TokenJanitorConf, db *ent.Client, rds redis.UniversalClient) *TokenJanitor {
+	return &TokenJanitor{
+		conf:   c,
+		db:     db,
+		locker: redislock.New(rds),
+		stopCh: make(chan struct{}),
+		logger: logx.WithContext(context.Background()),
+	}
+}
+
+// Start runs the janitor in background
+func (j *TokenJanitor) Start() {
+	if !j.conf.Enabled {
+		return
+	}
+
+	if j.conf.Mode == modeArchive && j.conf.ArchiveDir == "" {
+		j.logger.Errorw("token janitor is disabled, ArchiveDir is required in archive mode")
+		return
+	}
+
+	go func() {
+		ticker := time.NewTicker(j.conf.Interval)
+		defer ticker.Stop()
+
+		for {
+			j.RunOnce()
+
+			select {
+			case <-ticker.C:
+			case <-j.stopCh:
+				return
+			}
+		}
+	}()
+}
+
+// Stop stops the janitor
+func (j *TokenJanitor) Stop() {
+	j.once.Do(func() {
+		close(j.stopCh)
+	})
+}
+
+// RunOnce purges the expired tokens once if the lock is obtained
+func (j *TokenJanitor) RunOnce() {
+	ctx := hooks.NewSystemContext(context.Background())
+
+	lock, err := j.locker.Obtain(ctx, tokenJanitorLockKey, j.conf.Interval, nil)
+	if errors.Is(err, redislock.ErrNotObtained) {
+		return
+	} else if err != nil {
+		j.logger.Errorw("failed to obtain token janitor lock", logx.Field("detail", err.Error()))
+		return
+	}
+	// 不主动释放锁，让锁在一个周期后自然过期，避免多实例在同一周期内重复执行
+
+	deadline := time.Now().Add(-j.conf.Retention)
+	total, err := j.purge(ctx, deadline)
+	if err != nil {
+		j.logger.Errorw("failed to purge expired tokens", logx.Field("detail", err.Error()),
+			logx.Field("purged", total))
+		_ = lock.Release(ctx)
+		return
+	}
+
+	if total > 0 {
+		j.logger.Infow("expired tokens purged", logx.Field("purged", total),
+			logx.Field("expiredBefore", deadline))
+	}
+}
+
+func (j *TokenJanitor) purge(ctx context.Context, deadline time.Time) (int, error) {
+	total := 0
+	for {
+		select {
+		case <-j.stopCh:
+			return total, nil
+		default:
+		}
+
+		tokens, err := j.db.Token.Query().
+			Where(token.ExpiredAtLT(deadline)).
+			Order(ent.Asc(token.FieldExpiredAt)).
+			Limit(j.conf.BatchSize).
+			All(ctx)
+		if err != nil {
+			return total, err
+		}
+
+		if len(tokens) == 0 {
+			return total, nil
+		}
+
+		if j.conf.Mode == modeArchive {
+			if err = j.archive(tokens); err != nil {
+				return total, err
+			}
+		}
+
+		ids := make([]uuid.UUID, 0, len(tokens))
+		for _, v := range tokens {
+			ids = append(ids, v.ID)
+		}
+
+		n, err := j.db.Token.Delete().Where(token.IDIn(ids...)).Exec(ctx)
+		if err != nil {
+			return total, err
+		}
+		total += n
+
+		if len(tokens) < j.conf.BatchSize {
+			return total, nil
+		}
+	}
+}
+
+// archive appends the tokens into a gzip compressed NDJSON file per day
+func (j *TokenJanitor) archive(tokens []*ent.Token) error {
+	if err := os.MkdirAll(j.conf.ArchiveDir, 0o750); err != nil {
+		return err
+	}
+
+	name := filepath.Join(j.conf.ArchiveDir, fmt.Sprintf("sys_tokens-%s.ndjson.gz", time.Now().Format("20060102")))
+	file, err := os.OpenFile(name, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o640)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	// 每批写入一个独立的 gzip member，多个 member 拼接后仍是合法的 gzip 文件
+	writer := gzip.NewWriter(file)
+	encoder := json.NewEncoder(writer)
+	for _, v := range tokens {
+		if err = encoder.Encode(archivedToken{
+			ID:           v.ID.String(),
+			TenantID:     v.TenantID,
+			UUID:         v.UUID.String(),
+			Username:     v.Username,
+			Source:       v.Source,
+			Status:       v.Status,
+			DepartmentID: v.DepartmentID,
+			Device:       v.Device,
+			IP:           v.IP,
+			CreatedAt:    v.CreatedAt,
+			ExpiredAt:    v.ExpiredAt,
+			LastSeenAt:   v.LastSeenAt,
+		}); err != nil {
+			return err
+		}
+	}
+
+	if err = writer.Close(); err != nil {
+		return err
+	}
+
+	return file.Sync()
+}
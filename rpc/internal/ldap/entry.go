@@ -0,0 +1,187 @@
+package ldap
+
+import (
+	"encoding/binary"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/go-ldap/ldap/v3"
+)
+
+// AD userAccountControl 中的账户禁用标志
+const accountDisabled = 0x2
+
+// Entry is a directory entry, attribute names keep the case returned by the server
+type Entry struct {
+	DN         string
+	Attributes map[string][]string
+}
+
+func newEntry(e *ldap.Entry) *Entry {
+	entry := &Entry{DN: e.DN, Attributes: make(map[string][]string, len(e.Attributes))}
+	for _, attr := range e.Attributes {
+		// objectGUID 是二进制值，转换为 AD 显示的 GUID 格式
+		if strings.EqualFold(attr.Name, "objectGUID") {
+			values := make([]string, 0, len(attr.ByteValues))
+			for _, v := range attr.ByteValues {
+				values = append(values, formatGUID(v))
+			}
+			entry.Attributes[attr.Name] = values
+			continue
+		}
+		entry.Attributes[attr.Name] = attr.Values
+	}
+	return entry
+}
+
+// Values returns the values of the attribute, the name is case-insensitive
+func (e *Entry) Values(name string) []string {
+	if values, ok := e.Attributes[name]; ok {
+		return values
+	}
+	for k, values := range e.Attributes {
+		if strings.EqualFold(k, name) {
+			return values
+		}
+	}
+	return nil
+}
+
+// Value returns the first value of the attribute
+func (e *Entry) Value(name string) string {
+	if values := e.Values(name); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+// Disabled reports whether the AD account is disabled
+func (e *Entry) Disabled() bool {
+	flags, err := strconv.ParseUint(e.Value("userAccountControl"), 10, 32)
+	return err == nil && flags&accountDisabled != 0
+}
+
+// claims converts the attributes for the claim mapping, attributes are available under their
+// name and the lower-cased name
+func (e *Entry) claims() map[string]any {
+	claims := make(map[string]any, len(e.Attributes)*2+1)
+	for name, values := range e.Attributes {
+		list := make([]any, len(values))
+		for i, v := range values {
+			list[i] = v
+		}
+		claims[name] = list
+		if lower := strings.ToLower(name); lower != name {
+			if _, ok := claims[lower]; !ok {
+				claims[lower] = list
+			}
+		}
+	}
+	claims["dn"] = e.DN
+	return claims
+}
+
+// formatGUID formats a binary objectGUID, whose first three fields are little-endian
+func formatGUID(b []byte) string {
+	if len(b) != 16 {
+		return fmt.Sprintf("%x", b)
+	}
+	return fmt.Sprintf("%08x-%04x-%04x-%x-%x",
+		binary.LittleEndian.Uint32(b[0:4]), binary.LittleEndian.Uint16(b[4:6]), binary.LittleEndian.Uint16(b[6:8]), b[8:10], b[10:])
+}
+
+// NormalizeDN returns the form of a DN used for comparisons, attribute types and values are lower-cased
+func NormalizeDN(dn string) string {
+	parsed, err := ldap.ParseDN(dn)
+	if err != nil {
+		return strings.ToLower(strings.TrimSpace(dn))
+	}
+	return normalize(parsed.RDNs)
+}
+
+func normalize(rdns []*ldap.RelativeDN) string {
+	parts := make([]string, 0, len(rdns))
+	for _, rdn := range rdns {
+		attrs := make([]string, 0, len(rdn.Attributes))
+		for _, attr := range rdn.Attributes {
+			attrs = append(attrs, strings.ToLower(attr.String()))
+		}
+		slices.Sort(attrs)
+		parts = append(parts, strings.Join(attrs, "+"))
+	}
+	return strings.Join(parts, ",")
+}
+
+// ParentDN returns the normalized DN of the parent entry, "" for a top level entry
+func ParentDN(dn string) string {
+	parsed, err := ldap.ParseDN(dn)
+	if err != nil || len(parsed.RDNs) < 2 {
+		return ""
+	}
+	return normalize(parsed.RDNs[1:])
+}
+
+// RDNValue returns the value of the first RDN, e.g. "Sales" for ou=Sales,dc=example,dc=com
+func RDNValue(dn string) string {
+	parsed, err := ldap.ParseDN(dn)
+	if err != nil || len(parsed.RDNs) == 0 || len(parsed.RDNs[0].Attributes) == 0 {
+		return dn
+	}
+	return parsed.RDNs[0].Attributes[0].Value
+}
+
+// Depth returns the number of RDNs of the DN
+func Depth(dn string) int {
+	parsed, err := ldap.ParseDN(dn)
+	if err != nil {
+		return 0
+	}
+	return len(parsed.RDNs)
+}
+
+func uniqueDNs(dns []string) []string {
+	seen := make(map[string]struct{}, len(dns))
+	result := make([]string, 0, len(dns))
+	for _, dn := range dns {
+		key := NormalizeDN(dn)
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+		result = append(result, dn)
+	}
+	return result
+}
+
+// Directory is a snapshot of the entries mirrored by the directory sync
+type Directory struct {
+	OrgUnits []*Entry
+	Users    []*Entry
+	Groups   []*Entry
+	// 成员 DN 到所属组 DN 的索引
+	memberships map[string][]string
+}
+
+func newDirectory(ous, users, groups []*Entry, memberAttribute string) *Directory {
+	d := &Directory{OrgUnits: ous, Users: users, Groups: groups, memberships: map[string][]string{}}
+	for _, g := range groups {
+		for _, member := range g.Values(memberAttribute) {
+			key := NormalizeDN(member)
+			d.memberships[key] = append(d.memberships[key], g.DN)
+		}
+	}
+
+	// 父级排在子级之前，便于按顺序创建部门
+	slices.SortStableFunc(d.OrgUnits, func(a, b *Entry) int {
+		return Depth(a.DN) - Depth(b.DN)
+	})
+	return d
+}
+
+// GroupsOf returns the DNs of the groups of the user, from memberOf and the member attribute of the groups
+func (d *Directory) GroupsOf(user *Entry) []string {
+	groups := append(slices.Clone(user.Values("memberOf")), d.memberships[NormalizeDN(user.DN)]...)
+	return uniqueDNs(groups)
+}
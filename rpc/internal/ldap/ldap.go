@@ -0,0 +1,378 @@
+// Package ldap authenticates users against LDAP and Active Directory servers and reads the
+// organizational units, users and groups mirrored by the directory sync.
+//
+// Users are authenticated either by binding with a DN built from a template, or by searching
+// the user with the service account and binding with the DN found. Usernames are escaped
+// before they are put into DNs or filters.
+package ldap
+
+import (
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/go-ldap/ldap/v3"
+)
+
+// Directory types, which select the default filters and attribute mapping
+const (
+	TypeOpenLDAP        = "openldap"
+	TypeActiveDirectory = "ad"
+)
+
+// Authentication modes
+const (
+	// AuthModeTemplate binds with the DN built from UserDNTemplate
+	AuthModeTemplate = "template"
+	// AuthModeSearch searches the user with the service account and binds with the DN found
+	AuthModeSearch = "search"
+)
+
+// UsernamePlaceholder is replaced by the escaped username in UserDNTemplate and UserFilter
+const UsernamePlaceholder = "{username}"
+
+var (
+	ErrInvalidCredentials = errors.New("ldap: invalid credentials")
+	ErrUserNotFound       = errors.New("ldap: user not found")
+	ErrAmbiguousUser      = errors.New("ldap: more than one entry matches the username")
+)
+
+// userAttributes 除普通属性外显式请求常见的操作属性，OpenLDAP 的 entryUUID 和 memberOf 默认不返回
+var userAttributes = []string{"*", "entryUUID", "nsUniqueId", "ipaUniqueID", "objectGUID", "memberOf"}
+
+// Config describes an LDAP server and where its users, groups and organizational units are
+type Config struct {
+	Type               string
+	URL                string // ldap://host:389 or ldaps://host:636
+	StartTLS           bool
+	InsecureSkipVerify bool
+	Timeout            time.Duration
+	PageSize           uint32
+	// 服务账号，为空时匿名查询
+	BindDN       string
+	BindPassword string
+	BaseDN       string
+	AuthMode     string
+	// e.g. uid={username},ou=people,dc=example,dc=com
+	UserDNTemplate string
+	// e.g. (&(objectClass=inetOrgPerson)(uid={username})), the sync lists users by replacing {username} with *
+	UserFilter string
+	// 组织单位和组的查询位置，为空时使用 BaseDN
+	OUBaseDN             string
+	OUFilter             string
+	GroupBaseDN          string
+	GroupFilter          string
+	GroupMemberAttribute string
+}
+
+var defaultConfigs = map[string]Config{
+	TypeOpenLDAP: {
+		UserFilter:           "(&(objectClass=inetOrgPerson)(uid={username}))",
+		OUFilter:             "(objectClass=organizationalUnit)",
+		GroupFilter:          "(|(objectClass=groupOfNames)(objectClass=groupOfUniqueNames))",
+		GroupMemberAttribute: "member",
+	},
+	TypeActiveDirectory: {
+		UserFilter:           "(&(objectCategory=person)(objectClass=user)(sAMAccountName={username}))",
+		OUFilter:             "(objectClass=organizationalUnit)",
+		GroupFilter:          "(objectClass=group)",
+		GroupMemberAttribute: "member",
+	},
+}
+
+// withDefaults fills the empty fields with the defaults of the directory type
+func (c *Config) withDefaults() Config {
+	conf := *c
+	if conf.Type == "" {
+		conf.Type = TypeOpenLDAP
+	}
+	if conf.AuthMode == "" {
+		conf.AuthMode = AuthModeSearch
+	}
+
+	defaults := defaultConfigs[conf.Type]
+	if conf.UserFilter == "" {
+		conf.UserFilter = defaults.UserFilter
+	}
+	if conf.OUFilter == "" {
+		conf.OUFilter = defaults.OUFilter
+	}
+	if conf.GroupFilter == "" {
+		conf.GroupFilter = defaults.GroupFilter
+	}
+	if conf.GroupMemberAttribute == "" {
+		conf.GroupMemberAttribute = defaults.GroupMemberAttribute
+	}
+	if conf.OUBaseDN == "" {
+		conf.OUBaseDN = conf.BaseDN
+	}
+	if conf.GroupBaseDN == "" {
+		conf.GroupBaseDN = conf.BaseDN
+	}
+	if conf.Timeout <= 0 {
+		conf.Timeout = 10 * time.Second
+	}
+	if conf.PageSize == 0 {
+		conf.PageSize = 500
+	}
+
+	return conf
+}
+
+// Validate checks the config before it is saved
+func (c *Config) Validate() error {
+	conf := c.withDefaults()
+
+	if _, ok := defaultConfigs[conf.Type]; !ok {
+		return fmt.Errorf("unknown directory type %q", conf.Type)
+	}
+
+	u, err := url.Parse(conf.URL)
+	if err != nil || u.Host == "" {
+		return fmt.Errorf("invalid URL %q", conf.URL)
+	}
+	switch u.Scheme {
+	case "ldap":
+	case "ldaps":
+		if conf.StartTLS {
+			return errors.New("StartTLS can not be used with ldaps")
+		}
+	default:
+		return fmt.Errorf("unsupported URL scheme %q", u.Scheme)
+	}
+
+	if conf.BaseDN == "" {
+		return errors.New("base DN is required")
+	}
+	for name, dn := range map[string]string{"base DN": conf.BaseDN, "OU base DN": conf.OUBaseDN, "group base DN": conf.GroupBaseDN, "bind DN": conf.BindDN} {
+		if dn == "" {
+			continue
+		}
+		if _, err := ldap.ParseDN(dn); err != nil {
+			return fmt.Errorf("invalid %s: %w", name, err)
+		}
+	}
+
+	switch conf.AuthMode {
+	case AuthModeTemplate:
+		if !strings.Contains(conf.UserDNTemplate, UsernamePlaceholder) {
+			return fmt.Errorf("user DN template must contain %s", UsernamePlaceholder)
+		}
+		if _, err := ldap.ParseDN(userDN(conf.UserDNTemplate, "user")); err != nil {
+			return fmt.Errorf("invalid user DN template: %w", err)
+		}
+	case AuthModeSearch:
+	default:
+		return fmt.Errorf("unknown auth mode %q", conf.AuthMode)
+	}
+
+	// 同步时用 * 替换用户名列出全部用户，因此两种认证模式都需要用户过滤条件
+	if !strings.Contains(conf.UserFilter, UsernamePlaceholder) {
+		return fmt.Errorf("user filter must contain %s", UsernamePlaceholder)
+	}
+	for name, filter := range map[string]string{"user filter": userFilter(conf.UserFilter, "user"), "OU filter": conf.OUFilter, "group filter": conf.GroupFilter} {
+		if _, err := ldap.CompileFilter(filter); err != nil {
+			return fmt.Errorf("invalid %s: %w", name, err)
+		}
+	}
+
+	return nil
+}
+
+func userDN(template, username string) string {
+	return strings.ReplaceAll(template, UsernamePlaceholder, ldap.EscapeDN(username))
+}
+
+func userFilter(filter, username string) string {
+	return strings.ReplaceAll(filter, UsernamePlaceholder, ldap.EscapeFilter(username))
+}
+
+// Client is a connection to an LDAP server
+type Client struct {
+	conf Config
+	conn *ldap.Conn
+}
+
+// Dial connects to the server of the config, upgrading the connection with StartTLS when configured
+func Dial(c *Config) (*Client, error) {
+	conf := c.withDefaults()
+
+	tlsConfig := &tls.Config{InsecureSkipVerify: conf.InsecureSkipVerify}
+	if u, err := url.Parse(conf.URL); err == nil {
+		tlsConfig.ServerName = u.Hostname()
+	}
+
+	conn, err := ldap.DialURL(conf.URL,
+		ldap.DialWithDialer(&net.Dialer{Timeout: conf.Timeout}),
+		ldap.DialWithTLSConfig(tlsConfig))
+	if err != nil {
+		return nil, err
+	}
+	conn.SetTimeout(conf.Timeout)
+
+	if conf.StartTLS {
+		if err = conn.StartTLS(tlsConfig); err != nil {
+			_ = conn.Close()
+			return nil, fmt.Errorf("ldap: StartTLS: %w", err)
+		}
+	}
+
+	return &Client{conf: conf, conn: conn}, nil
+}
+
+// Close closes the connection
+func (c *Client) Close() error {
+	return c.conn.Close()
+}
+
+// BindService binds with the service account, the connection stays anonymous when no bind DN is configured
+func (c *Client) BindService() error {
+	if c.conf.BindDN == "" {
+		return nil
+	}
+
+	if err := c.conn.Bind(c.conf.BindDN, c.conf.BindPassword); err != nil {
+		return fmt.Errorf("ldap: service account bind: %w", err)
+	}
+	return nil
+}
+
+// Authenticate verifies the password of the user and returns the entry of the user.
+// The connection is bound with the service account afterwards.
+func (c *Client) Authenticate(username, password string) (*Entry, error) {
+	// 空密码会被服务器当作匿名绑定而成功，必须拒绝
+	if username == "" || password == "" {
+		return nil, ErrInvalidCredentials
+	}
+
+	var entry *Entry
+	dn := userDN(c.conf.UserDNTemplate, username)
+	if c.conf.AuthMode == AuthModeSearch {
+		var err error
+		if entry, err = c.FindUser(username); err != nil {
+			return nil, err
+		}
+		dn = entry.DN
+	}
+
+	if err := c.conn.Bind(dn, password); err != nil {
+		if ldap.IsErrorWithCode(err, ldap.LDAPResultInvalidCredentials) {
+			return nil, ErrInvalidCredentials
+		}
+		return nil, err
+	}
+
+	// 模板模式以用户身份读取自身条目，读取后切回服务账号以便查询用户组
+	if entry == nil {
+		result, err := c.conn.Search(ldap.NewSearchRequest(dn, ldap.ScopeBaseObject, ldap.NeverDerefAliases,
+			1, c.timeLimit(), false, "(objectClass=*)", userAttributes, nil))
+		if err != nil {
+			if ldap.IsErrorWithCode(err, ldap.LDAPResultNoSuchObject) {
+				return nil, ErrUserNotFound
+			}
+			return nil, err
+		}
+		if len(result.Entries) == 0 {
+			return nil, ErrUserNotFound
+		}
+		entry = newEntry(result.Entries[0])
+	}
+
+	if err := c.BindService(); err != nil {
+		return nil, err
+	}
+
+	return entry, nil
+}
+
+// FindUser searches the user by the user filter
+func (c *Client) FindUser(username string) (*Entry, error) {
+	if err := c.BindService(); err != nil {
+		return nil, err
+	}
+
+	result, err := c.conn.Search(ldap.NewSearchRequest(c.conf.BaseDN, ldap.ScopeWholeSubtree, ldap.NeverDerefAliases,
+		2, c.timeLimit(), false, userFilter(c.conf.UserFilter, username), userAttributes, nil))
+	if err != nil {
+		if ldap.IsErrorWithCode(err, ldap.LDAPResultSizeLimitExceeded) {
+			return nil, ErrAmbiguousUser
+		}
+		return nil, err
+	}
+
+	switch len(result.Entries) {
+	case 0:
+		return nil, ErrUserNotFound
+	case 1:
+		return newEntry(result.Entries[0]), nil
+	default:
+		return nil, ErrAmbiguousUser
+	}
+}
+
+// Groups returns the DNs of the groups of the user, from memberOf and from the groups listing
+// the user in the member attribute
+func (c *Client) Groups(user *Entry) ([]string, error) {
+	filter := fmt.Sprintf("(&%s(%s=%s))", c.conf.GroupFilter, c.conf.GroupMemberAttribute, ldap.EscapeFilter(user.DN))
+	entries, err := c.search(c.conf.GroupBaseDN, filter, []string{"cn"})
+	if err != nil {
+		return nil, err
+	}
+
+	groups := user.Values("memberOf")
+	for _, v := range entries {
+		groups = append(groups, v.DN)
+	}
+
+	return uniqueDNs(groups), nil
+}
+
+// Load reads the organizational units, users and groups mirrored by the directory sync
+func (c *Client) Load() (*Directory, error) {
+	if err := c.BindService(); err != nil {
+		return nil, err
+	}
+
+	ous, err := c.search(c.conf.OUBaseDN, c.conf.OUFilter, []string{"*", "entryUUID", "objectGUID"})
+	if err != nil {
+		return nil, fmt.Errorf("ldap: list organizational units: %w", err)
+	}
+
+	users, err := c.search(c.conf.BaseDN, strings.ReplaceAll(c.conf.UserFilter, UsernamePlaceholder, "*"), userAttributes)
+	if err != nil {
+		return nil, fmt.Errorf("ldap: list users: %w", err)
+	}
+
+	groups, err := c.search(c.conf.GroupBaseDN, c.conf.GroupFilter, []string{"cn", c.conf.GroupMemberAttribute})
+	if err != nil {
+		return nil, fmt.Errorf("ldap: list groups: %w", err)
+	}
+
+	return newDirectory(ous, users, groups, c.conf.GroupMemberAttribute), nil
+}
+
+// search runs a paged subtree search, AD returns at most 1000 entries without paging
+func (c *Client) search(baseDN, filter string, attributes []string) ([]*Entry, error) {
+	result, err := c.conn.SearchWithPaging(ldap.NewSearchRequest(baseDN, ldap.ScopeWholeSubtree, ldap.NeverDerefAliases,
+		0, c.timeLimit(), false, filter, attributes, nil), c.conf.PageSize)
+	if err != nil {
+		if ldap.IsErrorWithCode(err, ldap.LDAPResultNoSuchObject) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	entries := make([]*Entry, 0, len(result.Entries))
+	for _, v := range result.Entries {
+		entries = append(entries, newEntry(v))
+	}
+	return entries, nil
+}
+
+func (c *Client) timeLimit() int {
+	return int(c.conf.Timeout / time.Second)
+}
@@ -0,0 +1,281 @@
+package ldap_test
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/coder-lulu/newbee-core/rpc/internal/ldap"
+	"github.com/coder-lulu/newbee-core/rpc/internal/ldap/ldaptest"
+)
+
+const (
+	baseDN          = "dc=example,dc=com"
+	serviceDN       = "cn=service,dc=example,dc=com"
+	servicePassword = "service-secret"
+	aliceDN         = "uid=alice,ou=dev,ou=people,dc=example,dc=com"
+	bobDN           = "uid=bob,ou=people,dc=example,dc=com"
+	adminsDN        = "cn=admins,ou=groups,dc=example,dc=com"
+	staffDN         = "cn=staff,ou=groups,dc=example,dc=com"
+)
+
+// newDirectory starts a server with two users, alice in the admins and staff groups and bob in staff
+func newDirectory(t *testing.T) *ldaptest.Server {
+	t.Helper()
+
+	s, err := ldaptest.NewServer()
+	if err != nil {
+		t.Fatalf("start LDAP server: %v", err)
+	}
+	t.Cleanup(s.Close)
+
+	s.Add(baseDN, map[string][]string{"objectClass": {"dcObject", "organization"}, "dc": {"example"}})
+	s.Add(serviceDN, map[string][]string{"objectClass": {"person"}, "cn": {"service"}, ldaptest.PasswordAttribute: {servicePassword}})
+	for _, ou := range []string{"ou=people,dc=example,dc=com", "ou=dev,ou=people,dc=example,dc=com", "ou=groups,dc=example,dc=com"} {
+		s.Add(ou, map[string][]string{"objectClass": {"organizationalUnit"}, "ou": {ldap.RDNValue(ou)}})
+	}
+	s.Add(aliceDN, map[string][]string{
+		"objectClass":              {"inetOrgPerson"},
+		"uid":                      {"alice"},
+		"cn":                       {"Alice Liddell"},
+		"displayName":              {"Alice"},
+		"mail":                     {"alice@example.com"},
+		"entryUUID":                {"6a4e5f2c-0000-4000-8000-000000000001"},
+		ldaptest.PasswordAttribute: {"alice-secret"},
+	})
+	s.Add(bobDN, map[string][]string{
+		"objectClass":              {"inetOrgPerson"},
+		"uid":                      {"bob"},
+		"cn":                       {"Bob"},
+		"entryUUID":                {"6a4e5f2c-0000-4000-8000-000000000002"},
+		ldaptest.PasswordAttribute: {"bob-secret"},
+	})
+	s.Add(adminsDN, map[string][]string{"objectClass": {"groupOfNames"}, "cn": {"admins"}, "member": {aliceDN}})
+	s.Add(staffDN, map[string][]string{"objectClass": {"groupOfNames"}, "cn": {"staff"}, "member": {aliceDN, bobDN}})
+
+	return s
+}
+
+func dial(t *testing.T, conf *ldap.Config) *ldap.Client {
+	t.Helper()
+
+	if err := conf.Validate(); err != nil {
+		t.Fatalf("invalid config: %v", err)
+	}
+	c, err := ldap.Dial(conf)
+	if err != nil {
+		t.Fatalf("dial: %v", err)
+	}
+	t.Cleanup(func() { _ = c.Close() })
+	return c
+}
+
+func searchConfig(s *ldaptest.Server) *ldap.Config {
+	return &ldap.Config{
+		URL:          s.URL,
+		BindDN:       serviceDN,
+		BindPassword: servicePassword,
+		BaseDN:       baseDN,
+		AuthMode:     ldap.AuthModeSearch,
+	}
+}
+
+func TestAuthenticateTemplateBind(t *testing.T) {
+	s := newDirectory(t)
+	c := dial(t, &ldap.Config{
+		URL:            s.URL,
+		BindDN:         serviceDN,
+		BindPassword:   servicePassword,
+		BaseDN:         baseDN,
+		AuthMode:       ldap.AuthModeTemplate,
+		UserDNTemplate: "uid={username},ou=people,dc=example,dc=com",
+	})
+
+	entry, err := c.Authenticate("bob", "bob-secret")
+	if err != nil {
+		t.Fatalf("Authenticate: %v", err)
+	}
+	if entry.DN != bobDN || entry.Value("cn") != "Bob" {
+		t.Errorf("entry = %s %v, want %s", entry.DN, entry.Attributes, bobDN)
+	}
+	if entry.Value(ldaptest.PasswordAttribute) != "" {
+		t.Error("the password attribute is returned")
+	}
+
+	// alice 不在模板的位置，按模板拼出的 DN 不存在
+	if _, err = c.Authenticate("alice", "alice-secret"); !errors.Is(err, ldap.ErrInvalidCredentials) {
+		t.Errorf("err = %v, want ErrInvalidCredentials", err)
+	}
+}
+
+func TestAuthenticateSearchThenBind(t *testing.T) {
+	s := newDirectory(t)
+	c := dial(t, searchConfig(s))
+
+	entry, err := c.Authenticate("alice", "alice-secret")
+	if err != nil {
+		t.Fatalf("Authenticate: %v", err)
+	}
+	if entry.DN != aliceDN || entry.Value("mail") != "alice@example.com" {
+		t.Errorf("entry = %s %v, want %s", entry.DN, entry.Attributes, aliceDN)
+	}
+
+	// 认证后连接切回服务账号，可以继续查询用户组
+	groups, err := c.Groups(entry)
+	if err != nil {
+		t.Fatalf("Groups: %v", err)
+	}
+	slices.Sort(groups)
+	if want := []string{adminsDN, staffDN}; !slices.Equal(groups, want) {
+		t.Errorf("groups = %v, want %v", groups, want)
+	}
+}
+
+func TestAuthenticateWrongPassword(t *testing.T) {
+	s := newDirectory(t)
+	c := dial(t, searchConfig(s))
+
+	for _, password := range []string{"wrong", ""} {
+		if _, err := c.Authenticate("alice", password); !errors.Is(err, ldap.ErrInvalidCredentials) {
+			t.Errorf("password %q: err = %v, want ErrInvalidCredentials", password, err)
+		}
+	}
+}
+
+func TestAuthenticateUserNotFound(t *testing.T) {
+	s := newDirectory(t)
+	c := dial(t, searchConfig(s))
+
+	// 用户名中的过滤器元字符被转义，* 不能匹配任意用户
+	for _, username := range []string{"carol", "*", "alice)(uid=*"} {
+		if _, err := c.Authenticate(username, "alice-secret"); !errors.Is(err, ldap.ErrUserNotFound) {
+			t.Errorf("username %q: err = %v, want ErrUserNotFound", username, err)
+		}
+	}
+}
+
+func TestAuthenticateAmbiguousUser(t *testing.T) {
+	s := newDirectory(t)
+	s.Add("uid=alice,ou=groups,dc=example,dc=com", map[string][]string{"objectClass": {"inetOrgPerson"}, "uid": {"alice"}})
+	c := dial(t, searchConfig(s))
+
+	if _, err := c.Authenticate("alice", "alice-secret"); !errors.Is(err, ldap.ErrAmbiguousUser) {
+		t.Errorf("err = %v, want ErrAmbiguousUser", err)
+	}
+}
+
+func TestServiceAccountBind(t *testing.T) {
+	s := newDirectory(t)
+	conf := searchConfig(s)
+	conf.BindPassword = "wrong"
+	c := dial(t, conf)
+
+	if err := c.BindService(); err == nil {
+		t.Error("bind with a wrong service password succeeded")
+	}
+	if _, err := c.FindUser("alice"); err == nil {
+		t.Error("search without the service account succeeded")
+	}
+}
+
+func TestLoad(t *testing.T) {
+	s := newDirectory(t)
+	c := dial(t, searchConfig(s))
+
+	dir, err := c.Load()
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if len(dir.OrgUnits) != 3 || len(dir.Users) != 2 || len(dir.Groups) != 2 {
+		t.Fatalf("loaded %d OUs, %d users and %d groups, want 3, 2 and 2", len(dir.OrgUnits), len(dir.Users), len(dir.Groups))
+	}
+	// 父级组织单位排在子级之前
+	if dir.OrgUnits[2].DN != "ou=dev,ou=people,dc=example,dc=com" {
+		t.Errorf("OUs are not ordered by depth: %s", dir.OrgUnits[2].DN)
+	}
+
+	for _, u := range dir.Users {
+		groups := dir.GroupsOf(u)
+		slices.Sort(groups)
+		want := []string{staffDN}
+		if u.DN == aliceDN {
+			want = []string{adminsDN, staffDN}
+		}
+		if !slices.Equal(groups, want) {
+			t.Errorf("groups of %s = %v, want %v", u.DN, groups, want)
+		}
+	}
+}
+
+func TestAttributeMappingRoles(t *testing.T) {
+	s := newDirectory(t)
+	c := dial(t, searchConfig(s))
+
+	mapping, err := ldap.ParseAttributeMapping(ldap.TypeOpenLDAP, map[string]any{
+		"role_map": map[string]any{
+			// 组 DN 规范化后比较，组名不区分大小写
+			"CN=Admins, OU=Groups, DC=example, DC=com": []any{"admin", "auditor"},
+			"STAFF":                                 "member",
+			"cn=nobody,ou=groups,dc=example,dc=com": "unused",
+		},
+		"claim_mapping": map[string]any{"nickname": "cn"},
+	})
+	if err != nil {
+		t.Fatalf("ParseAttributeMapping: %v", err)
+	}
+
+	tests := []struct {
+		username string
+		password string
+		roles    []string
+	}{
+		{username: "alice", password: "alice-secret", roles: []string{"admin", "auditor", "member"}},
+		{username: "bob", password: "bob-secret", roles: []string{"member"}},
+	}
+	for _, tt := range tests {
+		entry, err := c.Authenticate(tt.username, tt.password)
+		if err != nil {
+			t.Fatalf("Authenticate %s: %v", tt.username, err)
+		}
+		groups, err := c.Groups(entry)
+		if err != nil {
+			t.Fatalf("Groups %s: %v", tt.username, err)
+		}
+
+		u := mapping.Map(entry, groups)
+		if !slices.Equal(u.RoleCodes, tt.roles) {
+			t.Errorf("roles of %s = %v, want %v", tt.username, u.RoleCodes, tt.roles)
+		}
+		if u.Info.Username != tt.username {
+			t.Errorf("username = %q, want %q", u.Info.Username, tt.username)
+		}
+		if u.ExternalID != entry.Value("entryUUID") {
+			t.Errorf("external ID = %q, want the entryUUID", u.ExternalID)
+		}
+	}
+
+	entry, err := c.FindUser("alice")
+	if err != nil {
+		t.Fatalf("FindUser: %v", err)
+	}
+	if u := mapping.Map(entry, nil); u.Info.Nickname != "Alice Liddell" {
+		t.Errorf("nickname = %q, want the custom claim mapping", u.Info.Nickname)
+	}
+
+	if want := []string{"admin", "auditor", "member", "unused"}; !slices.Equal(mapping.ManagedRoleCodes(), want) {
+		t.Errorf("managed roles = %v, want %v", mapping.ManagedRoleCodes(), want)
+	}
+}
+
+func TestParseAttributeMappingInvalid(t *testing.T) {
+	for name, config := range map[string]map[string]any{
+		"unknown key":     {"roles": map[string]any{}},
+		"role map type":   {"role_map": []any{"admin"}},
+		"role code type":  {"role_map": map[string]any{"staff": 1}},
+		"role list items": {"role_map": map[string]any{"staff": []any{"member", 2}}},
+	} {
+		if _, err := ldap.ParseAttributeMapping(ldap.TypeOpenLDAP, config); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
@@ -0,0 +1,412 @@
+// Package ldaptest provides an in-process LDAP server standing in for OpenLDAP or Active
+// Directory in tests. It supports simple binds and searches over the entries added to it,
+// paging controls are ignored and all matching entries are returned in one page.
+package ldaptest
+
+import (
+	"bufio"
+	"errors"
+	"net"
+	"slices"
+	"strings"
+	"sync"
+
+	ber "github.com/go-asn1-ber/asn1-ber"
+	"github.com/go-ldap/ldap/v3"
+)
+
+// PasswordAttribute holds the password checked by simple binds
+const PasswordAttribute = "userPassword"
+
+type entry struct {
+	dn    string
+	key   string
+	attrs map[string][]string
+}
+
+// Server is an LDAP server listening on a loopback address
+type Server struct {
+	// URL is the ldap:// URL of the server
+	URL string
+
+	listener net.Listener
+	mu       sync.RWMutex
+	entries  []*entry
+	conns    map[net.Conn]struct{}
+	wg       sync.WaitGroup
+}
+
+// NewServer starts a server without entries
+func NewServer() (*Server, error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+
+	s := &Server{URL: "ldap://" + listener.Addr().String(), listener: listener, conns: map[net.Conn]struct{}{}}
+	s.wg.Add(1)
+	go s.serve()
+	return s, nil
+}
+
+// Add adds or replaces an entry, the password of users is set in PasswordAttribute
+func (s *Server) Add(dn string, attrs map[string][]string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e := &entry{dn: dn, key: normalizeDN(dn), attrs: attrs}
+	for i, v := range s.entries {
+		if v.key == e.key {
+			s.entries[i] = e
+			return
+		}
+	}
+	s.entries = append(s.entries, e)
+}
+
+// Remove removes an entry
+func (s *Server) Remove(dn string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := normalizeDN(dn)
+	for i, v := range s.entries {
+		if v.key == key {
+			s.entries = append(s.entries[:i], s.entries[i+1:]...)
+			return
+		}
+	}
+}
+
+// Close stops the server and closes the open connections
+func (s *Server) Close() {
+	_ = s.listener.Close()
+
+	s.mu.Lock()
+	for conn := range s.conns {
+		_ = conn.Close()
+	}
+	s.mu.Unlock()
+
+	s.wg.Wait()
+}
+
+func (s *Server) serve() {
+	defer s.wg.Done()
+
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+
+		s.mu.Lock()
+		s.conns[conn] = struct{}{}
+		s.mu.Unlock()
+
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			s.handle(conn)
+
+			s.mu.Lock()
+			delete(s.conns, conn)
+			s.mu.Unlock()
+			_ = conn.Close()
+		}()
+	}
+}
+
+func (s *Server) handle(conn net.Conn) {
+	reader := bufio.NewReader(conn)
+	for {
+		packet, err := ber.ReadPacket(reader)
+		if err != nil {
+			return
+		}
+		if len(packet.Children) < 2 {
+			return
+		}
+
+		id, _ := packet.Children[0].Value.(int64)
+		request := packet.Children[1]
+
+		var responses []*ber.Packet
+		switch request.Tag {
+		case ldap.ApplicationBindRequest:
+			responses = []*ber.Packet{s.bind(request)}
+		case ldap.ApplicationSearchRequest:
+			responses = s.search(request)
+		case ldap.ApplicationUnbindRequest:
+			return
+		case ldap.ApplicationAbandonRequest:
+			continue
+		case ldap.ApplicationExtendedRequest:
+			responses = []*ber.Packet{result(ldap.ApplicationExtendedResponse, ldap.LDAPResultUnwillingToPerform, "extended operations are not supported")}
+		default:
+			responses = []*ber.Packet{result(request.Tag+1, ldap.LDAPResultUnwillingToPerform, "operation is not supported")}
+		}
+
+		for _, v := range responses {
+			message := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "LDAP Response")
+			message.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagInteger, id, "Message ID"))
+			message.AppendChild(v)
+			if _, err = conn.Write(message.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+}
+
+func result(tag ber.Tag, code uint16, message string) *ber.Packet {
+	p := ber.Encode(ber.ClassApplication, ber.TypeConstructed, tag, nil, "Response")
+	p.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagEnumerated, int64(code), "Result Code"))
+	p.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, "", "Matched DN"))
+	p.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, message, "Diagnostic Message"))
+	return p
+}
+
+func (s *Server) bind(request *ber.Packet) *ber.Packet {
+	if len(request.Children) < 3 || request.Children[2].Tag != 0 {
+		return result(ldap.ApplicationBindResponse, ldap.LDAPResultAuthMethodNotSupported, "only simple binds are supported")
+	}
+
+	name, password := request.Children[1].Data.String(), request.Children[2].Data.String()
+	switch {
+	case name == "" && password == "":
+		return result(ldap.ApplicationBindResponse, ldap.LDAPResultSuccess, "")
+	case password == "":
+		// 与 OpenLDAP 默认配置一致，拒绝未认证绑定
+		return result(ldap.ApplicationBindResponse, ldap.LDAPResultUnwillingToPerform, "unauthenticated bind is not allowed")
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	key := normalizeDN(name)
+	for _, e := range s.entries {
+		if e.key == key && slices.Contains(e.values(PasswordAttribute), password) {
+			return result(ldap.ApplicationBindResponse, ldap.LDAPResultSuccess, "")
+		}
+	}
+	return result(ldap.ApplicationBindResponse, ldap.LDAPResultInvalidCredentials, "invalid credentials")
+}
+
+func (s *Server) search(request *ber.Packet) []*ber.Packet {
+	if len(request.Children) < 8 {
+		return []*ber.Packet{result(ldap.ApplicationSearchResultDone, ldap.LDAPResultProtocolError, "malformed search request")}
+	}
+
+	base := normalizeDN(request.Children[0].Data.String())
+	scope, _ := request.Children[1].Value.(int64)
+	sizeLimit, _ := request.Children[3].Value.(int64)
+	filter := request.Children[6]
+	var attributes []string
+	for _, v := range request.Children[7].Children {
+		attributes = append(attributes, v.Data.String())
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	baseFound := false
+	var responses []*ber.Packet
+	for _, e := range s.entries {
+		if e.key == base || strings.HasSuffix(e.key, ","+base) {
+			baseFound = true
+		}
+		if !inScope(e.key, base, scope) {
+			continue
+		}
+
+		ok, err := match(e, filter)
+		if err != nil {
+			return []*ber.Packet{result(ldap.ApplicationSearchResultDone, ldap.LDAPResultProtocolError, err.Error())}
+		}
+		if !ok {
+			continue
+		}
+
+		if sizeLimit > 0 && int64(len(responses)) >= sizeLimit {
+			return append(responses, result(ldap.ApplicationSearchResultDone, ldap.LDAPResultSizeLimitExceeded, ""))
+		}
+		responses = append(responses, e.encode(attributes))
+	}
+
+	if !baseFound {
+		return []*ber.Packet{result(ldap.ApplicationSearchResultDone, ldap.LDAPResultNoSuchObject, "no such object")}
+	}
+	return append(responses, result(ldap.ApplicationSearchResultDone, ldap.LDAPResultSuccess, ""))
+}
+
+func inScope(key, base string, scope int64) bool {
+	switch scope {
+	case ldap.ScopeBaseObject:
+		return key == base
+	case ldap.ScopeSingleLevel:
+		return parentDN(key) == base
+	default:
+		return key == base || base == "" || strings.HasSuffix(key, ","+base)
+	}
+}
+
+func parentDN(key string) string {
+	parsed, err := ldap.ParseDN(key)
+	if err != nil || len(parsed.RDNs) < 2 {
+		return ""
+	}
+	return normalize(parsed.RDNs[1:])
+}
+
+func (e *entry) values(name string) []string {
+	if strings.EqualFold(name, "dn") || strings.EqualFold(name, "distinguishedName") {
+		return []string{e.dn}
+	}
+	for k, v := range e.attrs {
+		if strings.EqualFold(k, name) {
+			return v
+		}
+	}
+	return nil
+}
+
+func (e *entry) encode(attributes []string) *ber.Packet {
+	all := len(attributes) == 0
+	requested := map[string]bool{}
+	for _, v := range attributes {
+		if v == "*" {
+			all = true
+		}
+		requested[strings.ToLower(v)] = true
+	}
+
+	p := ber.Encode(ber.ClassApplication, ber.TypeConstructed, ldap.ApplicationSearchResultEntry, nil, "Search Result Entry")
+	p.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, e.dn, "Object Name"))
+	list := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "Attributes")
+	for name, values := range e.attrs {
+		// 密码不返回给客户端
+		if strings.EqualFold(name, PasswordAttribute) || (!all && !requested[strings.ToLower(name)]) {
+			continue
+		}
+		attr := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "Attribute")
+		attr.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, name, "Type"))
+		set := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSet, nil, "Values")
+		for _, v := range values {
+			set.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, v, "Value"))
+		}
+		attr.AppendChild(set)
+		list.AppendChild(attr)
+	}
+	p.AppendChild(list)
+	return p
+}
+
+var errUnsupportedFilter = errors.New("unsupported filter")
+
+// match evaluates a filter, values are compared case-insensitively and DN values by their normalized form
+func match(e *entry, filter *ber.Packet) (bool, error) {
+	switch filter.Tag {
+	case ldap.FilterAnd:
+		for _, v := range filter.Children {
+			ok, err := match(e, v)
+			if err != nil || !ok {
+				return false, err
+			}
+		}
+		return true, nil
+	case ldap.FilterOr:
+		for _, v := range filter.Children {
+			ok, err := match(e, v)
+			if err != nil || ok {
+				return ok, err
+			}
+		}
+		return false, nil
+	case ldap.FilterNot:
+		if len(filter.Children) != 1 {
+			return false, errUnsupportedFilter
+		}
+		ok, err := match(e, filter.Children[0])
+		return !ok, err
+	case ldap.FilterPresent:
+		return len(e.values(filter.Data.String())) > 0, nil
+	case ldap.FilterEqualityMatch, ldap.FilterApproxMatch:
+		if len(filter.Children) != 2 {
+			return false, errUnsupportedFilter
+		}
+		want := filter.Children[1].Data.String()
+		for _, v := range e.values(filter.Children[0].Data.String()) {
+			if equal(v, want) {
+				return true, nil
+			}
+		}
+		return false, nil
+	case ldap.FilterSubstrings:
+		if len(filter.Children) != 2 {
+			return false, errUnsupportedFilter
+		}
+		for _, v := range e.values(filter.Children[0].Data.String()) {
+			if substringsMatch(strings.ToLower(v), filter.Children[1].Children) {
+				return true, nil
+			}
+		}
+		return false, nil
+	default:
+		return false, errUnsupportedFilter
+	}
+}
+
+func equal(a, b string) bool {
+	if strings.EqualFold(a, b) {
+		return true
+	}
+	if strings.Contains(a, "=") && strings.Contains(b, "=") {
+		return normalizeDN(a) == normalizeDN(b)
+	}
+	return false
+}
+
+func substringsMatch(value string, parts []*ber.Packet) bool {
+	for _, part := range parts {
+		s := strings.ToLower(part.Data.String())
+		switch part.Tag {
+		case ldap.FilterSubstringsInitial:
+			if !strings.HasPrefix(value, s) {
+				return false
+			}
+			value = value[len(s):]
+		case ldap.FilterSubstringsAny:
+			i := strings.Index(value, s)
+			if i < 0 {
+				return false
+			}
+			value = value[i+len(s):]
+		case ldap.FilterSubstringsFinal:
+			if !strings.HasSuffix(value, s) {
+				return false
+			}
+			value = ""
+		}
+	}
+	return true
+}
+
+func normalizeDN(dn string) string {
+	parsed, err := ldap.ParseDN(dn)
+	if err != nil {
+		return strings.ToLower(strings.TrimSpace(dn))
+	}
+	return normalize(parsed.RDNs)
+}
+
+func normalize(rdns []*ldap.RelativeDN) string {
+	parts := make([]string, 0, len(rdns))
+	for _, rdn := range rdns {
+		attrs := make([]string, 0, len(rdn.Attributes))
+		for _, attr := range rdn.Attributes {
+			attrs = append(attrs, strings.ToLower(attr.String()))
+		}
+		parts = append(parts, strings.Join(attrs, "+"))
+	}
+	return strings.Join(parts, ",")
+}
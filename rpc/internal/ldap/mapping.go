@@ -0,0 +1,167 @@
+package ldap
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/coder-lulu/newbee-core/rpc/internal/interfaces"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/claimmap"
+)
+
+// Mapping config keys
+const (
+	mappingClaims  = claimmap.ConfigKey
+	mappingRoleMap = "role_map"
+)
+
+// defaultClaimRules 按目录类型区分，AD 使用 objectGUID 和 sAMAccountName
+var defaultClaimRules = map[string]map[string][]any{
+	TypeOpenLDAP: {
+		claimmap.FieldID:       {"entryUUID", "nsUniqueId", "ipaUniqueID"},
+		claimmap.FieldUsername: {"uid", "cn"},
+		claimmap.FieldNickname: {"displayName", "cn"},
+		claimmap.FieldEmail:    {"mail"},
+		claimmap.FieldPhone:    {"mobile", "telephoneNumber"},
+		claimmap.FieldAvatar:   {"avatar"},
+	},
+	TypeActiveDirectory: {
+		claimmap.FieldID:       {"objectGUID"},
+		claimmap.FieldUsername: {"sAMAccountName"},
+		claimmap.FieldNickname: {"displayName", "cn"},
+		claimmap.FieldEmail:    {"mail"},
+		claimmap.FieldPhone:    {"mobile", "telephoneNumber"},
+		claimmap.FieldAvatar:   {"avatar"},
+	},
+}
+
+// AttributeMapping maps the entry of a user to a user and roles.
+// It is configured in LdapProvider.attribute_mapping:
+//
+//	{
+//	  "claim_mapping": {"username": "sAMAccountName", "email": {"path": "mail", "transform": "lower"}},
+//	  "role_map":      {"cn=admins,ou=groups,dc=example,dc=com": ["admin"], "staff": "member"}
+//	}
+//
+// The rules follow the claimmap syntax, paths are attribute names and the DN is available as "dn".
+// role_map keys are group DNs or group names (the value of the first RDN), groups missing from
+// role_map grant no roles.
+type AttributeMapping struct {
+	claims  *claimmap.Mapping
+	roleMap map[string][]string
+}
+
+// MappedUser is the user described by a directory entry
+type MappedUser struct {
+	Info *interfaces.OAuthUserInfo
+	// ExternalID 优先使用目录的唯一标识，缺失时使用规范化的 DN
+	ExternalID string
+	RoleCodes  []string
+	Disabled   bool
+}
+
+// ParseAttributeMapping parses the attribute mapping of a provider, nil gives the default mapping
+func ParseAttributeMapping(directoryType string, config map[string]any) (*AttributeMapping, error) {
+	for k := range config {
+		if k != mappingClaims && k != mappingRoleMap {
+			return nil, fmt.Errorf("unknown key %q in the attribute mapping", k)
+		}
+	}
+
+	defaults, ok := defaultClaimRules[directoryType]
+	if !ok {
+		defaults = defaultClaimRules[TypeOpenLDAP]
+	}
+
+	// 用户配置的规则覆盖目录类型的默认规则，未配置的字段保留默认规则
+	rules := make(map[string]any, len(defaults))
+	for field, paths := range defaults {
+		rules[field] = paths
+	}
+	if raw, ok := config[mappingClaims]; ok && raw != nil {
+		custom, ok := raw.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("%s must be an object", mappingClaims)
+		}
+		for field, rule := range custom {
+			rules[field] = rule
+		}
+	}
+	claims, err := claimmap.FromExtraConfig(map[string]any{claimmap.ConfigKey: rules})
+	if err != nil {
+		return nil, err
+	}
+
+	m := &AttributeMapping{claims: claims, roleMap: map[string][]string{}}
+
+	if raw, ok := config[mappingRoleMap]; ok && raw != nil {
+		values, ok := raw.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("%s must be an object", mappingRoleMap)
+		}
+		for group, v := range values {
+			key := roleMapKey(group)
+			switch codes := v.(type) {
+			case string:
+				m.roleMap[key] = append(m.roleMap[key], codes)
+			case []any:
+				for _, c := range codes {
+					code, ok := c.(string)
+					if !ok {
+						return nil, fmt.Errorf("%s: roles of %q must be role codes", mappingRoleMap, group)
+					}
+					m.roleMap[key] = append(m.roleMap[key], code)
+				}
+			default:
+				return nil, fmt.Errorf("%s: roles of %q must be a role code or a list of role codes", mappingRoleMap, group)
+			}
+		}
+	}
+
+	return m, nil
+}
+
+// roleMapKey 组 DN 规范化后比较，组名按小写比较
+func roleMapKey(group string) string {
+	if strings.Contains(group, "=") {
+		return NormalizeDN(group)
+	}
+	return groupNameKey(group)
+}
+
+func groupNameKey(name string) string {
+	return strings.ToLower(strings.TrimSpace(name))
+}
+
+// Map maps the entry of a user and the DNs of the groups of the user
+func (m *AttributeMapping) Map(e *Entry, groups []string) *MappedUser {
+	u := &MappedUser{Info: m.claims.MapClaims(e.claims()), Disabled: e.Disabled()}
+	u.Info.ProviderType = interfaces.ProviderTypeLDAP
+	// 组来自 memberOf 和组的成员属性，不使用 groups 映射规则
+	u.Info.Groups = groups
+
+	u.ExternalID = u.Info.ID
+	if u.ExternalID == "" {
+		u.ExternalID = NormalizeDN(e.DN)
+	}
+
+	for _, group := range groups {
+		u.RoleCodes = append(u.RoleCodes, m.roleMap[NormalizeDN(group)]...)
+		u.RoleCodes = append(u.RoleCodes, m.roleMap[groupNameKey(RDNValue(group))]...)
+	}
+	slices.Sort(u.RoleCodes)
+	u.RoleCodes = slices.Compact(u.RoleCodes)
+
+	return u
+}
+
+// ManagedRoleCodes returns the role codes granted by role_map. The sync replaces these roles
+// according to the group membership and keeps the roles granted in the system.
+func (m *AttributeMapping) ManagedRoleCodes() []string {
+	var codes []string
+	for _, v := range m.roleMap {
+		codes = append(codes, v...)
+	}
+	slices.Sort(codes)
+	return slices.Compact(codes)
+}
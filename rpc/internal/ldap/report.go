@@ -0,0 +1,126 @@
+package ldap
+
+// Kinds of the objects changed by the directory sync
+const (
+	KindDepartment = "department"
+	KindUser       = "user"
+	KindRole       = "role"
+)
+
+// Sync actions
+const (
+	ActionCreate  = "create"
+	ActionUpdate  = "update"
+	ActionDisable = "disable"
+	ActionDelete  = "delete"
+	ActionGrant   = "grant"
+	ActionRevoke  = "revoke"
+)
+
+// Conflict reasons
+const (
+	ConflictMissingUsername   = "missing_username"
+	ConflictDuplicateUsername = "duplicate_username"
+	ConflictUsernameTaken     = "username_taken"
+	ConflictDuplicateEntry    = "duplicate_entry"
+	ConflictRoleNotFound      = "role_not_found"
+	ConflictDepartmentInUse   = "department_in_use"
+)
+
+// SyncChange is a change made, or planned in a dry run, by the directory sync
+type SyncChange struct {
+	Kind   string `json:"kind"`
+	Action string `json:"action"`
+	DN     string `json:"dn,omitempty"`
+	Name   string `json:"name"`
+	Detail string `json:"detail,omitempty"`
+}
+
+// SyncConflict is an entry the directory sync skipped
+type SyncConflict struct {
+	Kind   string `json:"kind"`
+	DN     string `json:"dn,omitempty"`
+	Name   string `json:"name"`
+	Reason string `json:"reason"`
+	Detail string `json:"detail,omitempty"`
+}
+
+// SyncCounter counts the changes of one kind of objects
+type SyncCounter struct {
+	Created   int `json:"created"`
+	Updated   int `json:"updated"`
+	Disabled  int `json:"disabled"`
+	Deleted   int `json:"deleted"`
+	Unchanged int `json:"unchanged"`
+}
+
+// SyncStats summarizes a sync run
+type SyncStats struct {
+	Departments SyncCounter `json:"departments"`
+	Users       SyncCounter `json:"users"`
+	Granted     int         `json:"granted"`
+	Revoked     int         `json:"revoked"`
+	Conflicts   int         `json:"conflicts"`
+}
+
+// SyncReport is the result of a sync run. Changes and conflicts beyond the limit are only counted.
+type SyncReport struct {
+	Stats     SyncStats      `json:"stats"`
+	Changes   []SyncChange   `json:"changes"`
+	Conflicts []SyncConflict `json:"conflicts"`
+	Truncated bool           `json:"truncated"`
+	limit     int
+}
+
+// NewSyncReport creates a report which keeps at most limit changes and conflicts, 0 means no limit
+func NewSyncReport(limit int) *SyncReport {
+	return &SyncReport{Changes: []SyncChange{}, Conflicts: []SyncConflict{}, limit: limit}
+}
+
+// Change records a change
+func (r *SyncReport) Change(c SyncChange) {
+	counter := &r.Stats.Users
+	if c.Kind == KindDepartment {
+		counter = &r.Stats.Departments
+	}
+
+	switch c.Action {
+	case ActionCreate:
+		counter.Created++
+	case ActionUpdate:
+		counter.Updated++
+	case ActionDisable:
+		counter.Disabled++
+	case ActionDelete:
+		counter.Deleted++
+	case ActionGrant:
+		r.Stats.Granted++
+	case ActionRevoke:
+		r.Stats.Revoked++
+	}
+
+	if r.limit > 0 && len(r.Changes) >= r.limit {
+		r.Truncated = true
+		return
+	}
+	r.Changes = append(r.Changes, c)
+}
+
+// Unchanged counts an object which is already up to date
+func (r *SyncReport) Unchanged(kind string) {
+	if kind == KindDepartment {
+		r.Stats.Departments.Unchanged++
+	} else {
+		r.Stats.Users.Unchanged++
+	}
+}
+
+// Conflict records a conflict
+func (r *SyncReport) Conflict(c SyncConflict) {
+	r.Stats.Conflicts++
+	if r.limit > 0 && len(r.Conflicts) >= r.limit {
+		r.Truncated = true
+		return
+	}
+	r.Conflicts = append(r.Conflicts, c)
+}
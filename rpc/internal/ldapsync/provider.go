@@ -0,0 +1,69 @@
+// Package ldapsync mirrors LDAP and Active Directory directories into the system: organizational
+// units into departments, users into users and group membership into role assignments. It also
+// links the users authenticated by the directory on login.
+package ldapsync
+
+import (
+	"fmt"
+
+	"github.com/coder-lulu/newbee-core/rpc/ent"
+	"github.com/coder-lulu/newbee-core/rpc/internal/config"
+	"github.com/coder-lulu/newbee-core/rpc/internal/encryption"
+	"github.com/coder-lulu/newbee-core/rpc/internal/ldap"
+)
+
+// Deletion policies for users and organizational units removed from the directory
+const (
+	DeletionKeep    = "keep"
+	DeletionDisable = "disable"
+	DeletionDelete  = "delete"
+)
+
+// Sync triggers
+const (
+	TriggerManual   = "manual"
+	TriggerSchedule = "schedule"
+)
+
+// Sync results
+const (
+	ResultSuccess = "success"
+	ResultFailed  = "failed"
+)
+
+// ProviderConfig builds the connection config of a provider, decrypting the service account password
+func ProviderConfig(p *ent.LdapProvider, c config.LdapConf, enc *encryption.ProviderEncryptionService) (*ldap.Config, error) {
+	conf := &ldap.Config{
+		Type:                 p.DirectoryType,
+		URL:                  p.URL,
+		StartTLS:             p.StartTLS,
+		InsecureSkipVerify:   p.InsecureSkipVerify,
+		Timeout:              c.Timeout,
+		PageSize:             c.PageSize,
+		BindDN:               p.BindDn,
+		BaseDN:               p.BaseDn,
+		AuthMode:             p.AuthMode,
+		UserDNTemplate:       p.UserDnTemplate,
+		UserFilter:           p.UserFilter,
+		OUBaseDN:             p.OuBaseDn,
+		OUFilter:             p.OuFilter,
+		GroupBaseDN:          p.GroupBaseDn,
+		GroupFilter:          p.GroupFilter,
+		GroupMemberAttribute: p.GroupMemberAttribute,
+	}
+
+	if p.EncryptedBindPassword != "" {
+		password, err := enc.DecryptProviderSecret(p.EncryptedBindPassword, p.EncryptionKeyID)
+		if err != nil {
+			return nil, fmt.Errorf("decrypt bind password of LDAP provider %d: %w", p.ID, err)
+		}
+		conf.BindPassword = password
+	}
+
+	return conf, nil
+}
+
+// ProviderMapping parses the attribute mapping of a provider
+func ProviderMapping(p *ent.LdapProvider) (*ldap.AttributeMapping, error) {
+	return ldap.ParseAttributeMapping(p.DirectoryType, p.AttributeMapping)
+}
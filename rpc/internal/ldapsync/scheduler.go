@@ -0,0 +1,180 @@
+package ldapsync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/bsm/redislock"
+	"github.com/coder-lulu/newbee-common/v2/enum/common"
+	"github.com/coder-lulu/newbee-common/v2/orm/ent/hooks"
+	"github.com/redis/go-redis/v9"
+	"github.com/zeromicro/go-zero/core/logx"
+
+	"github.com/coder-lulu/newbee-core/rpc/ent"
+	"github.com/coder-lulu/newbee-core/rpc/ent/ldapprovider"
+	"github.com/coder-lulu/newbee-core/rpc/internal/config"
+)
+
+const (
+	syncLockKey = "LDAP:SYNC:LOCK:%d"
+	// syncLockTTL 同步结束后主动释放，TTL 只用于进程异常退出的情况
+	syncLockTTL = time.Hour
+)
+
+// ErrSyncRunning is returned when the provider is being synced by another request or instance
+var ErrSyncRunning = errors.New("ldap sync is already running")
+
+// Runner runs the sync of a provider under a redis lock shared by the RPC instances and records the run
+type Runner struct {
+	db     *ent.Client
+	syncer *Syncer
+	locker *redislock.Client
+	logger logx.Logger
+}
+
+// NewRunner creates a runner
+func NewRunner(db *ent.Client, rds redis.UniversalClient, syncer *Syncer) *Runner {
+	return &Runner{
+		db:     db,
+		syncer: syncer,
+		locker: redislock.New(rds),
+		logger: logx.WithContext(context.Background()),
+	}
+}
+
+// Run syncs the provider and records the run, the error of the sync is returned with the run
+func (r *Runner) Run(ctx context.Context, p *ent.LdapProvider, trigger string, dryRun bool) (*ent.LdapSyncRun, error) {
+	lock, err := r.locker.Obtain(ctx, fmt.Sprintf(syncLockKey, p.ID), syncLockTTL, nil)
+	if errors.Is(err, redislock.ErrNotObtained) {
+		return nil, ErrSyncRunning
+	} else if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = lock.Release(context.Background())
+	}()
+
+	startedAt := time.Now()
+	report, syncErr := r.syncer.Sync(ctx, p, dryRun)
+
+	query := r.db.LdapSyncRun.Create().
+		SetProviderID(p.ID).
+		SetTrigger(trigger).
+		SetDryRun(dryRun).
+		SetResult(ResultSuccess).
+		SetStartedAt(startedAt).
+		SetFinishedAt(time.Now()).
+		SetTenantID(p.TenantID)
+	if report != nil {
+		query = query.SetStats(report.Stats).
+			SetChanges(report.Changes).
+			SetConflicts(report.Conflicts).
+			SetTruncated(report.Truncated)
+	}
+	if syncErr != nil {
+		query = query.SetResult(ResultFailed).SetError(syncErr.Error())
+		r.logger.Errorw("failed to sync LDAP directory", logx.Field("detail", syncErr.Error()),
+			logx.Field("provider", p.ID), logx.Field("dryRun", dryRun))
+	}
+
+	run, err := query.Save(ctx)
+	if err != nil {
+		return nil, errors.Join(syncErr, err)
+	}
+
+	// 失败的同步也更新时间，避免定时任务每次检查都重试
+	if !dryRun {
+		if err = r.db.LdapProvider.UpdateOneID(p.ID).SetLastSyncAt(startedAt).Exec(ctx); err != nil {
+			r.logger.Errorw("failed to update the last sync time of LDAP provider", logx.Field("detail", err.Error()),
+				logx.Field("provider", p.ID))
+		}
+	}
+
+	return run, syncErr
+}
+
+// Scheduler periodically syncs the providers whose sync interval has elapsed
+type Scheduler struct {
+	conf   config.LdapConf
+	db     *ent.Client
+	runner *Runner
+	stopCh chan struct{}
+	once   sync.Once
+	logger logx.Logger
+}
+
+// NewScheduler creates a scheduler
+func NewScheduler(c config.LdapConf, db *ent.Client, runner *Runner) *Scheduler {
+	return &Scheduler{
+		conf:   c,
+		db:     db,
+		runner: runner,
+		stopCh: make(chan struct{}),
+		logger: logx.WithContext(context.Background()),
+	}
+}
+
+// Start runs the scheduler in background
+func (s *Scheduler) Start() {
+	if !s.conf.SyncEnabled {
+		return
+	}
+
+	go func() {
+		ticker := time.NewTicker(s.conf.SyncCheckInterval)
+		defer ticker.Stop()
+
+		for {
+			s.RunOnce()
+
+			select {
+			case <-ticker.C:
+			case <-s.stopCh:
+				return
+			}
+		}
+	}()
+}
+
+// Stop stops the scheduler
+func (s *Scheduler) Stop() {
+	s.once.Do(func() {
+		close(s.stopCh)
+	})
+}
+
+// RunOnce syncs the providers which are due
+func (s *Scheduler) RunOnce() {
+	providers, err := s.db.LdapProvider.Query().
+		Where(ldapprovider.EnabledEQ(true), ldapprovider.SyncEnabledEQ(true), ldapprovider.StatusEQ(common.StatusNormal)).
+		All(hooks.NewSystemContext(context.Background()))
+	if err != nil {
+		s.logger.Errorw("failed to list LDAP providers to sync", logx.Field("detail", err.Error()))
+		return
+	}
+
+	now := time.Now()
+	for _, p := range providers {
+		select {
+		case <-s.stopCh:
+			return
+		default:
+		}
+
+		if p.LastSyncAt != nil && now.Sub(*p.LastSyncAt) < time.Duration(p.SyncInterval)*time.Minute {
+			continue
+		}
+
+		ctx := hooks.SetTenantIDToContext(context.Background(), p.TenantID)
+		run, err := s.runner.Run(ctx, p, TriggerSchedule, false)
+		if err != nil {
+			// 其他实例正在同步，或同步失败已在 Runner 中记录
+			continue
+		}
+
+		s.logger.Infow("LDAP directory synced", logx.Field("provider", p.ID), logx.Field("stats", run.Stats))
+	}
+}
@@ -0,0 +1,601 @@
+package ldapsync
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/coder-lulu/newbee-common/v2/enum/common"
+	"github.com/gofrs/uuid/v5"
+	"github.com/zeromicro/go-zero/core/logx"
+
+	"github.com/coder-lulu/newbee-core/rpc/ent"
+	"github.com/coder-lulu/newbee-core/rpc/ent/department"
+	"github.com/coder-lulu/newbee-core/rpc/ent/ldapaccount"
+	"github.com/coder-lulu/newbee-core/rpc/ent/ldapdepartment"
+	"github.com/coder-lulu/newbee-core/rpc/ent/role"
+	"github.com/coder-lulu/newbee-core/rpc/ent/user"
+	"github.com/coder-lulu/newbee-core/rpc/internal/config"
+	"github.com/coder-lulu/newbee-core/rpc/internal/encryption"
+	"github.com/coder-lulu/newbee-core/rpc/internal/ldap"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/dbfunc"
+)
+
+// Syncer mirrors the directory of a provider. All changes of a run are made in one transaction,
+// which is rolled back in a dry run so that the report lists the changes without applying them.
+type Syncer struct {
+	conf   config.LdapConf
+	db     *ent.Client
+	enc    *encryption.ProviderEncryptionService
+	logger logx.Logger
+}
+
+// NewSyncer creates a syncer
+func NewSyncer(c config.LdapConf, db *ent.Client, enc *encryption.ProviderEncryptionService) *Syncer {
+	return &Syncer{
+		conf:   c,
+		db:     db,
+		enc:    enc,
+		logger: logx.WithContext(context.Background()),
+	}
+}
+
+// Sync mirrors the organizational units, users and group membership of the provider
+func (s *Syncer) Sync(ctx context.Context, p *ent.LdapProvider, dryRun bool) (*ldap.SyncReport, error) {
+	conf, err := ProviderConfig(p, s.conf, s.enc)
+	if err != nil {
+		return nil, err
+	}
+	mapping, err := ProviderMapping(p)
+	if err != nil {
+		return nil, fmt.Errorf("invalid attribute mapping: %w", err)
+	}
+
+	client, err := ldap.Dial(conf)
+	if err != nil {
+		return nil, err
+	}
+	defer client.Close()
+
+	dir, err := client.Load()
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := s.db.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &syncRun{
+		ctx:         ctx,
+		tx:          tx,
+		provider:    p,
+		mapping:     mapping,
+		dir:         dir,
+		report:      ldap.NewSyncReport(s.conf.MaxReportEntries),
+		logger:      s.logger,
+		now:         time.Now(),
+		departments: map[string]uint64{},
+	}
+	if err = r.run(); err != nil {
+		_ = tx.Rollback()
+		return r.report, err
+	}
+
+	if dryRun {
+		return r.report, tx.Rollback()
+	}
+	return r.report, tx.Commit()
+}
+
+type syncRun struct {
+	ctx      context.Context
+	tx       *ent.Tx
+	provider *ent.LdapProvider
+	mapping  *ldap.AttributeMapping
+	dir      *ldap.Directory
+	report   *ldap.SyncReport
+	logger   logx.Logger
+	now      time.Time
+	// 规范化的组织单位 DN 到部门 ID
+	departments map[string]uint64
+	// 目录中已移除的组织单位
+	removedOUs []*ent.LdapDepartment
+}
+
+// run 先同步部门以便用户关联部门，用户处理完后再删除目录中已移除的部门
+func (r *syncRun) run() error {
+	if err := r.syncDepartments(); err != nil {
+		return err
+	}
+	if err := r.syncUsers(); err != nil {
+		return err
+	}
+	return r.removeDepartments()
+}
+
+// externalID 组织单位与用户相同，优先使用目录的唯一标识
+func externalID(e *ldap.Entry) string {
+	for _, name := range []string{"objectGUID", "entryUUID", "nsUniqueId", "ipaUniqueID"} {
+		if v := e.Value(name); v != "" {
+			return v
+		}
+	}
+	return ldap.NormalizeDN(e.DN)
+}
+
+func (r *syncRun) syncDepartments() error {
+	bindings, err := r.tx.LdapDepartment.Query().
+		Where(ldapdepartment.ProviderIDEQ(r.provider.ID)).
+		All(r.ctx)
+	if err != nil {
+		return err
+	}
+
+	byExternalID := make(map[string]*ent.LdapDepartment, len(bindings))
+	ids := make([]uint64, 0, len(bindings))
+	for _, v := range bindings {
+		byExternalID[v.ExternalID] = v
+		ids = append(ids, v.DepartmentID)
+	}
+
+	existing, err := r.tx.Department.Query().Where(department.IDIn(ids...)).All(r.ctx)
+	if err != nil {
+		return err
+	}
+	departments := make(map[uint64]*ent.Department, len(existing))
+	for _, v := range existing {
+		departments[v.ID] = v
+	}
+
+	seen := make(map[string]bool, len(r.dir.OrgUnits))
+	for _, ou := range r.dir.OrgUnits {
+		id := externalID(ou)
+		name := ldap.RDNValue(ou.DN)
+		if seen[id] {
+			r.report.Conflict(ldap.SyncConflict{Kind: ldap.KindDepartment, DN: ou.DN, Name: name, Reason: ldap.ConflictDuplicateEntry, Detail: id})
+			continue
+		}
+		seen[id] = true
+
+		// 上级组织单位未被同步时挂在根部门下
+		parentID := r.provider.RootDepartmentID
+		if v, ok := r.departments[ldap.ParentDN(ou.DN)]; ok {
+			parentID = v
+		}
+
+		binding := byExternalID[id]
+		var dept *ent.Department
+		if binding != nil {
+			dept = departments[binding.DepartmentID]
+		}
+
+		if dept == nil {
+			if dept, err = r.createDepartment(name, parentID); err != nil {
+				return err
+			}
+			r.report.Change(ldap.SyncChange{Kind: ldap.KindDepartment, Action: ldap.ActionCreate, DN: ou.DN, Name: name})
+		} else if err = r.updateDepartment(dept, ou.DN, name, parentID); err != nil {
+			return err
+		}
+
+		if binding == nil {
+			err = r.tx.LdapDepartment.Create().
+				SetProviderID(r.provider.ID).
+				SetDepartmentID(dept.ID).
+				SetExternalID(id).
+				SetDn(ou.DN).
+				SetSyncedAt(r.now).
+				SetTenantID(r.provider.TenantID).
+				Exec(r.ctx)
+		} else {
+			err = r.tx.LdapDepartment.UpdateOne(binding).
+				SetDepartmentID(dept.ID).
+				SetDn(ou.DN).
+				SetSyncedAt(r.now).
+				Exec(r.ctx)
+		}
+		if err != nil {
+			return err
+		}
+
+		r.departments[ldap.NormalizeDN(ou.DN)] = dept.ID
+	}
+
+	for _, v := range bindings {
+		if !seen[v.ExternalID] {
+			r.removedOUs = append(r.removedOUs, v)
+		}
+	}
+	return nil
+}
+
+func (r *syncRun) createDepartment(name string, parentID uint64) (*ent.Department, error) {
+	ancestors, err := dbfunc.GetDepartmentAncestors(&parentID, r.tx.Client(), r.logger, r.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.tx.Department.Create().
+		SetName(name).
+		SetNotNilAncestors(ancestors).
+		SetParentID(parentID).
+		Save(r.ctx)
+}
+
+func (r *syncRun) updateDepartment(dept *ent.Department, dn, name string, parentID uint64) error {
+	var changed []string
+	query := r.tx.Department.UpdateOne(dept)
+	if dept.Name != name {
+		query = query.SetName(name)
+		changed = append(changed, "name")
+	}
+	if dept.ParentID != parentID {
+		ancestors, err := dbfunc.GetDepartmentAncestors(&parentID, r.tx.Client(), r.logger, r.ctx)
+		if err != nil {
+			return err
+		}
+		query = query.SetParentID(parentID).ClearAncestors().SetNotNilAncestors(ancestors)
+		changed = append(changed, "parent")
+	}
+	// 之前因从目录移除而被禁用的部门重新出现时恢复
+	if dept.Status != common.StatusNormal {
+		query = query.SetStatus(common.StatusNormal)
+		changed = append(changed, "status")
+	}
+
+	if len(changed) == 0 {
+		r.report.Unchanged(ldap.KindDepartment)
+		return nil
+	}
+
+	r.report.Change(ldap.SyncChange{Kind: ldap.KindDepartment, Action: ldap.ActionUpdate, DN: dn, Name: name, Detail: strings.Join(changed, ",")})
+	return query.Exec(r.ctx)
+}
+
+// removeDepartments 按删除策略处理目录中已移除的组织单位，子部门先于父部门删除
+func (r *syncRun) removeDepartments() error {
+	slices.SortStableFunc(r.removedOUs, func(a, b *ent.LdapDepartment) int {
+		return ldap.Depth(b.Dn) - ldap.Depth(a.Dn)
+	})
+
+	for _, v := range r.removedOUs {
+		dept, err := r.tx.Department.Get(r.ctx, v.DepartmentID)
+		if ent.IsNotFound(err) {
+			if err = r.tx.LdapDepartment.DeleteOne(v).Exec(r.ctx); err != nil {
+				return err
+			}
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		switch r.provider.DeletionPolicy {
+		case DeletionDisable:
+			if dept.Status == common.StatusNormal {
+				if err = r.tx.Department.UpdateOne(dept).SetStatus(common.StatusBanned).Exec(r.ctx); err != nil {
+					return err
+				}
+				r.report.Change(ldap.SyncChange{Kind: ldap.KindDepartment, Action: ldap.ActionDisable, DN: v.Dn, Name: dept.Name})
+			}
+		case DeletionDelete:
+			inUse, err := r.departmentInUse(dept.ID)
+			if err != nil {
+				return err
+			}
+			if inUse {
+				r.report.Conflict(ldap.SyncConflict{Kind: ldap.KindDepartment, DN: v.Dn, Name: dept.Name, Reason: ldap.ConflictDepartmentInUse})
+				continue
+			}
+			if err = r.tx.LdapDepartment.DeleteOne(v).Exec(r.ctx); err != nil {
+				return err
+			}
+			if err = r.tx.Department.DeleteOne(dept).Exec(r.ctx); err != nil {
+				return err
+			}
+			r.report.Change(ldap.SyncChange{Kind: ldap.KindDepartment, Action: ldap.ActionDelete, DN: v.Dn, Name: dept.Name})
+		}
+	}
+	return nil
+}
+
+func (r *syncRun) departmentInUse(id uint64) (bool, error) {
+	if exist, err := r.tx.User.Query().Where(user.DepartmentIDEQ(id)).Exist(r.ctx); err != nil || exist {
+		return exist, err
+	}
+	return r.tx.Department.Query().Where(department.ParentIDEQ(id)).Exist(r.ctx)
+}
+
+// mappedEntry 目录用户及映射结果
+type mappedEntry struct {
+	entry  *ldap.Entry
+	mapped *ldap.MappedUser
+}
+
+func (r *syncRun) syncUsers() error {
+	accounts, err := r.tx.LdapAccount.Query().
+		Where(ldapaccount.ProviderIDEQ(r.provider.ID)).
+		WithUser(func(q *ent.UserQuery) { q.WithRoles() }).
+		All(r.ctx)
+	if err != nil {
+		return err
+	}
+	byExternalID := make(map[string]*ent.LdapAccount, len(accounts))
+	for _, v := range accounts {
+		byExternalID[v.ExternalID] = v
+	}
+
+	roles, err := r.managedRoles()
+	if err != nil {
+		return err
+	}
+
+	// 先映射全部条目，以便发现目录内的重名用户
+	entries := make([]mappedEntry, 0, len(r.dir.Users))
+	usernames := make(map[string]int, len(r.dir.Users))
+	seen := make(map[string]bool, len(r.dir.Users))
+	for _, e := range r.dir.Users {
+		m := r.mapping.Map(e, r.dir.GroupsOf(e))
+		if seen[m.ExternalID] {
+			r.report.Conflict(ldap.SyncConflict{Kind: ldap.KindUser, DN: e.DN, Name: m.Info.Username, Reason: ldap.ConflictDuplicateEntry, Detail: m.ExternalID})
+			continue
+		}
+		seen[m.ExternalID] = true
+
+		if m.Info.Username == "" {
+			r.report.Conflict(ldap.SyncConflict{Kind: ldap.KindUser, DN: e.DN, Reason: ldap.ConflictMissingUsername})
+			continue
+		}
+		usernames[strings.ToLower(m.Info.Username)]++
+		entries = append(entries, mappedEntry{entry: e, mapped: m})
+	}
+
+	password := ""
+	for _, v := range entries {
+		if usernames[strings.ToLower(v.mapped.Info.Username)] > 1 {
+			r.report.Conflict(ldap.SyncConflict{Kind: ldap.KindUser, DN: v.entry.DN, Name: v.mapped.Info.Username, Reason: ldap.ConflictDuplicateUsername})
+			continue
+		}
+
+		roleIDs := make([]uint64, 0, len(v.mapped.RoleCodes))
+		for _, code := range v.mapped.RoleCodes {
+			if id, ok := roles[code]; ok {
+				roleIDs = append(roleIDs, id)
+			}
+		}
+		departmentID := nearestDepartment(r.departments, v.entry.DN, r.provider.DefaultDepartmentID)
+
+		if account := byExternalID[v.mapped.ExternalID]; account != nil {
+			if err = r.updateUser(account, account.Edges.User, v, departmentID, roleIDs, roles); err != nil {
+				return err
+			}
+			continue
+		}
+
+		u, err := r.tx.User.Query().Where(user.UsernameEQ(v.mapped.Info.Username)).WithRoles().Only(r.ctx)
+		if err != nil && !ent.IsNotFound(err) {
+			return err
+		}
+
+		if u != nil {
+			if err = r.linkUser(u, v, departmentID, roleIDs, roles); err != nil {
+				return err
+			}
+			continue
+		}
+
+		// 同一次同步中新建的用户共用一个随机密码哈希，避免逐个计算 bcrypt
+		if password == "" {
+			if password, err = RandomPassword(); err != nil {
+				return err
+			}
+		}
+		u, err = CreateUser(r.ctx, r.tx, v.mapped, password, departmentID, mergeIDs(roleIDs, r.provider.DefaultRoleIds))
+		if err != nil {
+			return err
+		}
+		if err = r.createAccount(u.ID, v); err != nil {
+			return err
+		}
+		r.report.Change(ldap.SyncChange{Kind: ldap.KindUser, Action: ldap.ActionCreate, DN: v.entry.DN, Name: u.Username})
+	}
+
+	for _, v := range accounts {
+		if !seen[v.ExternalID] {
+			if err = r.removeUser(v); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// managedRoles 返回 role_map 中的角色，角色不存在时记录冲突
+func (r *syncRun) managedRoles() (map[string]uint64, error) {
+	codes := r.mapping.ManagedRoleCodes()
+	if len(codes) == 0 {
+		return map[string]uint64{}, nil
+	}
+
+	list, err := r.tx.Role.Query().Where(role.CodeIn(codes...)).All(r.ctx)
+	if err != nil {
+		return nil, err
+	}
+	roles := make(map[string]uint64, len(list))
+	for _, v := range list {
+		roles[v.Code] = v.ID
+	}
+	for _, code := range codes {
+		if _, ok := roles[code]; !ok {
+			r.report.Conflict(ldap.SyncConflict{Kind: ldap.KindRole, Name: code, Reason: ldap.ConflictRoleNotFound})
+		}
+	}
+	return roles, nil
+}
+
+// linkUser 绑定同名的本地用户，不允许绑定或该用户已绑定其它条目时记录冲突
+func (r *syncRun) linkUser(u *ent.User, v mappedEntry, departmentID uint64, roleIDs []uint64, roles map[string]uint64) error {
+	if !r.provider.LinkExisting {
+		r.report.Conflict(ldap.SyncConflict{Kind: ldap.KindUser, DN: v.entry.DN, Name: u.Username, Reason: ldap.ConflictUsernameTaken})
+		return nil
+	}
+
+	linked, err := r.tx.LdapAccount.Query().
+		Where(ldapaccount.UserIDEQ(u.ID), ldapaccount.ProviderIDEQ(r.provider.ID)).
+		Exist(r.ctx)
+	if err != nil {
+		return err
+	}
+	if linked {
+		r.report.Conflict(ldap.SyncConflict{Kind: ldap.KindUser, DN: v.entry.DN, Name: u.Username, Reason: ldap.ConflictUsernameTaken, Detail: "linked to another entry"})
+		return nil
+	}
+
+	if err = r.createAccount(u.ID, v); err != nil {
+		return err
+	}
+	return r.updateUser(nil, u, v, departmentID, roleIDs, roles)
+}
+
+func (r *syncRun) createAccount(userID uuid.UUID, v mappedEntry) error {
+	return r.tx.LdapAccount.Create().
+		SetUserID(userID).
+		SetProviderID(r.provider.ID).
+		SetExternalID(v.mapped.ExternalID).
+		SetDn(v.entry.DN).
+		SetSyncedAt(r.now).
+		SetTenantID(r.provider.TenantID).
+		Exec(r.ctx)
+}
+
+// updateUser 同步用户信息和由目录组授予的角色，系统中手动授予的角色保持不变
+func (r *syncRun) updateUser(account *ent.LdapAccount, u *ent.User, v mappedEntry, departmentID uint64, roleIDs []uint64, roles map[string]uint64) error {
+	info := v.mapped.Info
+	var changed []string
+	query := r.tx.User.UpdateOne(u)
+
+	if info.Username != u.Username {
+		taken, err := r.tx.User.Query().Where(user.UsernameEQ(info.Username), user.IDNEQ(u.ID)).Exist(r.ctx)
+		if err != nil {
+			return err
+		}
+		if taken {
+			r.report.Conflict(ldap.SyncConflict{Kind: ldap.KindUser, DN: v.entry.DN, Name: info.Username, Reason: ldap.ConflictUsernameTaken, Detail: "rename of " + u.Username})
+		} else {
+			query = query.SetUsername(info.Username)
+			changed = append(changed, "username")
+		}
+	}
+	if info.Nickname != "" && info.Nickname != u.Nickname {
+		query = query.SetNickname(info.Nickname)
+		changed = append(changed, "nickname")
+	}
+	if info.Email != "" && info.Email != u.Email {
+		query = query.SetEmail(info.Email)
+		changed = append(changed, "email")
+	}
+	if info.PhoneNumber != "" && info.PhoneNumber != u.Mobile {
+		query = query.SetMobile(info.PhoneNumber)
+		changed = append(changed, "mobile")
+	}
+	if departmentID != 0 && departmentID != u.DepartmentID {
+		query = query.SetDepartmentID(departmentID)
+		changed = append(changed, "department")
+	}
+	if status := userStatus(v.mapped); status != u.Status {
+		query = query.SetStatus(status)
+		changed = append(changed, "status")
+	}
+
+	// 只调整 role_map 管理的角色
+	managed := make(map[uint64]string, len(roles))
+	for code, id := range roles {
+		managed[id] = code
+	}
+	current := make(map[uint64]bool)
+	for _, rl := range u.Edges.Roles {
+		if _, ok := managed[rl.ID]; ok {
+			current[rl.ID] = true
+		}
+	}
+	var grant, revoke []uint64
+	for _, id := range roleIDs {
+		if !current[id] {
+			grant = append(grant, id)
+		}
+	}
+	for id := range current {
+		if !slices.Contains(roleIDs, id) {
+			revoke = append(revoke, id)
+		}
+	}
+	if len(grant) > 0 {
+		query = query.AddRoleIDs(grant...)
+	}
+	if len(revoke) > 0 {
+		query = query.RemoveRoleIDs(revoke...)
+	}
+
+	if account != nil && account.Dn != v.entry.DN {
+		changed = append(changed, "dn")
+	}
+	if len(changed) == 0 && len(grant) == 0 && len(revoke) == 0 {
+		r.report.Unchanged(ldap.KindUser)
+		if account != nil {
+			return r.tx.LdapAccount.UpdateOne(account).SetSyncedAt(r.now).Exec(r.ctx)
+		}
+		return nil
+	}
+
+	if err := query.Exec(r.ctx); err != nil {
+		return err
+	}
+	if account != nil {
+		if err := r.tx.LdapAccount.UpdateOne(account).SetDn(v.entry.DN).SetSyncedAt(r.now).Exec(r.ctx); err != nil {
+			return err
+		}
+	}
+
+	if len(changed) > 0 || account == nil {
+		detail := strings.Join(changed, ",")
+		if account == nil {
+			detail = strings.TrimSuffix("linked,"+detail, ",")
+		}
+		r.report.Change(ldap.SyncChange{Kind: ldap.KindUser, Action: ldap.ActionUpdate, DN: v.entry.DN, Name: u.Username, Detail: detail})
+	}
+	for _, id := range grant {
+		r.report.Change(ldap.SyncChange{Kind: ldap.KindRole, Action: ldap.ActionGrant, DN: v.entry.DN, Name: managed[id], Detail: u.Username})
+	}
+	for _, id := range revoke {
+		r.report.Change(ldap.SyncChange{Kind: ldap.KindRole, Action: ldap.ActionRevoke, DN: v.entry.DN, Name: managed[id], Detail: u.Username})
+	}
+	return nil
+}
+
+// removeUser 按删除策略处理目录中已移除的用户
+func (r *syncRun) removeUser(account *ent.LdapAccount) error {
+	u := account.Edges.User
+	switch r.provider.DeletionPolicy {
+	case DeletionDisable:
+		if u.Status != common.StatusNormal {
+			return nil
+		}
+		if err := r.tx.User.UpdateOne(u).SetStatus(common.StatusBanned).Exec(r.ctx); err != nil {
+			return err
+		}
+		r.report.Change(ldap.SyncChange{Kind: ldap.KindUser, Action: ldap.ActionDisable, DN: account.Dn, Name: u.Username})
+	case DeletionDelete:
+		if err := r.tx.LdapAccount.DeleteOne(account).Exec(r.ctx); err != nil {
+			return err
+		}
+		if err := r.tx.User.DeleteOne(u).Exec(r.ctx); err != nil {
+			return err
+		}
+		r.report.Change(ldap.SyncChange{Kind: ldap.KindUser, Action: ldap.ActionDelete, DN: account.Dn, Name: u.Username})
+	}
+	return nil
+}
@@ -0,0 +1,263 @@
+package ldapsync
+
+import (
+	"bytes"
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect/sql/schema"
+	"github.com/coder-lulu/newbee-common/v2/enum/common"
+	"github.com/coder-lulu/newbee-common/v2/orm/ent/hooks"
+	_ "github.com/mattn/go-sqlite3"
+
+	"github.com/coder-lulu/newbee-core/rpc/ent"
+	"github.com/coder-lulu/newbee-core/rpc/ent/enttest"
+	"github.com/coder-lulu/newbee-core/rpc/ent/user"
+	"github.com/coder-lulu/newbee-core/rpc/internal/config"
+	"github.com/coder-lulu/newbee-core/rpc/internal/encryption"
+	"github.com/coder-lulu/newbee-core/rpc/internal/ldap"
+	"github.com/coder-lulu/newbee-core/rpc/internal/ldap/ldaptest"
+)
+
+const (
+	testBaseDN    = "dc=example,dc=com"
+	testServiceDN = "cn=service,dc=example,dc=com"
+	testAliceDN   = "uid=alice,ou=dev,ou=people,dc=example,dc=com"
+	testBobDN     = "uid=bob,ou=people,dc=example,dc=com"
+	testStaffDN   = "cn=staff,ou=groups,dc=example,dc=com"
+)
+
+type syncFixture struct {
+	ctx      context.Context
+	db       *ent.Client
+	server   *ldaptest.Server
+	syncer   *Syncer
+	provider *ent.LdapProvider
+}
+
+// newSyncFixture starts a directory with alice in the admins and staff groups and bob in staff,
+// and a database with the roles granted by these groups
+func newSyncFixture(t *testing.T) *syncFixture {
+	t.Helper()
+
+	s, err := ldaptest.NewServer()
+	if err != nil {
+		t.Fatalf("start LDAP server: %v", err)
+	}
+	t.Cleanup(s.Close)
+
+	s.Add(testBaseDN, map[string][]string{"objectClass": {"dcObject"}, "dc": {"example"}})
+	s.Add(testServiceDN, map[string][]string{"objectClass": {"person"}, "cn": {"service"}, ldaptest.PasswordAttribute: {"secret"}})
+	for _, ou := range []string{"ou=people,dc=example,dc=com", "ou=dev,ou=people,dc=example,dc=com", "ou=groups,dc=example,dc=com"} {
+		s.Add(ou, map[string][]string{"objectClass": {"organizationalUnit"}, "ou": {ldap.RDNValue(ou)}})
+	}
+	s.Add(testAliceDN, map[string][]string{
+		"objectClass": {"inetOrgPerson"}, "uid": {"alice"}, "cn": {"Alice"}, "mail": {"alice@example.com"},
+		"entryUUID": {"6a4e5f2c-0000-4000-8000-000000000001"},
+	})
+	s.Add(testBobDN, map[string][]string{
+		"objectClass": {"inetOrgPerson"}, "uid": {"bob"}, "cn": {"Bob"},
+		"entryUUID": {"6a4e5f2c-0000-4000-8000-000000000002"},
+	})
+	s.Add("cn=admins,ou=groups,dc=example,dc=com", map[string][]string{"objectClass": {"groupOfNames"}, "cn": {"admins"}, "member": {testAliceDN}})
+	s.Add(testStaffDN, map[string][]string{"objectClass": {"groupOfNames"}, "cn": {"staff"}, "member": {testAliceDN, testBobDN}})
+
+	// 与 InitDatabase 一致不创建外键，根部门的 parent_id 为 0
+	db := enttest.Open(t, "sqlite3", "file:"+t.Name()+"?mode=memory&cache=shared&_fk=1",
+		enttest.WithMigrateOptions(schema.WithForeignKeys(false)))
+	t.Cleanup(func() { _ = db.Close() })
+	ctx := hooks.SetTenantIDToContext(context.Background(), 1)
+
+	root, err := db.Department.Create().SetName("root").SetTenantID(1).Save(ctx)
+	if err != nil {
+		t.Fatalf("create root department: %v", err)
+	}
+	for _, code := range []string{"admin", "member"} {
+		if err = db.Role.Create().SetName(code).SetCode(code).SetTenantID(1).Exec(ctx); err != nil {
+			t.Fatalf("create role: %v", err)
+		}
+	}
+
+	manager := encryption.NewEncryptionManager()
+	if err = manager.AddKey("test", bytes.Repeat([]byte{1}, 32), encryption.AlgorithmAES256GCM); err != nil {
+		t.Fatal(err)
+	}
+	if err = manager.SetActiveKey("test"); err != nil {
+		t.Fatal(err)
+	}
+	enc := encryption.NewProviderEncryptionService(manager)
+	password, keyID, err := enc.EncryptProviderSecret("secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	provider, err := db.LdapProvider.Create().
+		SetName("example").
+		SetURL(s.URL).
+		SetBindDn(testServiceDN).
+		SetEncryptedBindPassword(password).
+		SetEncryptionKeyID(keyID).
+		SetBaseDn(testBaseDN).
+		SetRootDepartmentID(root.ID).
+		SetDefaultDepartmentID(root.ID).
+		SetAttributeMapping(map[string]any{
+			"role_map": map[string]any{"cn=admins,ou=groups,dc=example,dc=com": "admin", "staff": "member"},
+		}).
+		SetDeletionPolicy(DeletionDisable).
+		SetTenantID(1).
+		Save(ctx)
+	if err != nil {
+		t.Fatalf("create provider: %v", err)
+	}
+
+	return &syncFixture{
+		ctx:      ctx,
+		db:       db,
+		server:   s,
+		syncer:   NewSyncer(config.LdapConf{Timeout: 5 * time.Second, PageSize: 100, MaxReportEntries: 100}, db, enc),
+		provider: provider,
+	}
+}
+
+// sync runs a sync and fails the test on error
+func (f *syncFixture) sync(t *testing.T, dryRun bool) *ldap.SyncReport {
+	t.Helper()
+
+	report, err := f.syncer.Sync(f.ctx, f.provider, dryRun)
+	if err != nil {
+		t.Fatalf("Sync(dryRun=%v): %v", dryRun, err)
+	}
+	return report
+}
+
+// roles returns the role codes of the users by username
+func (f *syncFixture) roles(t *testing.T) map[string][]string {
+	t.Helper()
+
+	users, err := f.db.User.Query().WithRoles().All(f.ctx)
+	if err != nil {
+		t.Fatalf("query users: %v", err)
+	}
+	result := make(map[string][]string, len(users))
+	for _, u := range users {
+		codes := []string{}
+		for _, r := range u.Edges.Roles {
+			codes = append(codes, r.Code)
+		}
+		slices.Sort(codes)
+		result[u.Username] = codes
+	}
+	return result
+}
+
+func (f *syncFixture) counts(t *testing.T) (users, departments, accounts int) {
+	t.Helper()
+
+	var err error
+	if users, err = f.db.User.Query().Count(f.ctx); err != nil {
+		t.Fatal(err)
+	}
+	if departments, err = f.db.Department.Query().Count(f.ctx); err != nil {
+		t.Fatal(err)
+	}
+	if accounts, err = f.db.LdapAccount.Query().Count(f.ctx); err != nil {
+		t.Fatal(err)
+	}
+	return users, departments, accounts
+}
+
+func TestSyncDryRun(t *testing.T) {
+	f := newSyncFixture(t)
+
+	report := f.sync(t, true)
+	if report.Stats.Departments.Created != 3 || report.Stats.Users.Created != 2 {
+		t.Errorf("dry run stats = %+v, want 3 departments and 2 users created", report.Stats)
+	}
+	if report.Stats.Conflicts != 0 {
+		t.Errorf("dry run conflicts = %+v", report.Conflicts)
+	}
+
+	// 试运行只报告变更，事务回滚后数据库保持不变
+	if users, departments, accounts := f.counts(t); users != 0 || departments != 1 || accounts != 0 {
+		t.Fatalf("dry run changed the database: %d users, %d departments, %d accounts", users, departments, accounts)
+	}
+
+	applied := f.sync(t, false)
+	if applied.Stats != report.Stats {
+		t.Errorf("sync stats = %+v, dry run predicted %+v", applied.Stats, report.Stats)
+	}
+	if users, departments, accounts := f.counts(t); users != 2 || departments != 4 || accounts != 2 {
+		t.Fatalf("sync created %d users, %d departments, %d accounts, want 2, 4 and 2", users, departments, accounts)
+	}
+}
+
+func TestSyncGroupRoleMapping(t *testing.T) {
+	f := newSyncFixture(t)
+	f.sync(t, false)
+
+	want := map[string][]string{"alice": {"admin", "member"}, "bob": {"member"}}
+	if got := f.roles(t); !equalRoles(got, want) {
+		t.Fatalf("roles after the first sync = %v, want %v", got, want)
+	}
+
+	// bob 离开 staff 组，alice 加入的组不变；试运行报告收回的角色但不修改
+	f.server.Add(testStaffDN, map[string][]string{"objectClass": {"groupOfNames"}, "cn": {"staff"}, "member": {testAliceDN}})
+	report := f.sync(t, true)
+	if report.Stats.Revoked != 1 || report.Stats.Granted != 0 {
+		t.Errorf("dry run stats = %+v, want one revoked role", report.Stats)
+	}
+	if !slices.ContainsFunc(report.Changes, func(c ldap.SyncChange) bool {
+		return c.Kind == ldap.KindRole && c.Action == ldap.ActionRevoke && c.Name == "member" && c.Detail == "bob"
+	}) {
+		t.Errorf("dry run changes = %+v, want member revoked from bob", report.Changes)
+	}
+	if got := f.roles(t); !equalRoles(got, want) {
+		t.Fatalf("dry run changed the roles: %v", got)
+	}
+
+	f.sync(t, false)
+	want["bob"] = []string{}
+	if got := f.roles(t); !equalRoles(got, want) {
+		t.Fatalf("roles after the second sync = %v, want %v", got, want)
+	}
+}
+
+func TestSyncRemovedUser(t *testing.T) {
+	f := newSyncFixture(t)
+	f.sync(t, false)
+
+	f.server.Remove(testBobDN)
+	report := f.sync(t, true)
+	if report.Stats.Users.Disabled != 1 {
+		t.Errorf("dry run stats = %+v, want one disabled user", report.Stats)
+	}
+	bob, err := f.db.User.Query().Where(user.UsernameEQ("bob")).Only(f.ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bob.Status != common.StatusNormal {
+		t.Fatal("dry run disabled the user")
+	}
+
+	f.sync(t, false)
+	if bob, err = f.db.User.Query().Where(user.UsernameEQ("bob")).Only(f.ctx); err != nil {
+		t.Fatal(err)
+	}
+	if bob.Status != common.StatusBanned {
+		t.Errorf("status of a removed user = %d, want disabled", bob.Status)
+	}
+}
+
+func equalRoles(a, b map[string][]string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for k, v := range a {
+		if !slices.Equal(v, b[k]) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,106 @@
+package ldapsync
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"slices"
+
+	"github.com/coder-lulu/newbee-common/v2/enum/common"
+	"github.com/coder-lulu/newbee-common/v2/utils/encrypt"
+
+	"github.com/coder-lulu/newbee-core/rpc/ent"
+	"github.com/coder-lulu/newbee-core/rpc/ent/ldapdepartment"
+	"github.com/coder-lulu/newbee-core/rpc/ent/role"
+	"github.com/coder-lulu/newbee-core/rpc/internal/ldap"
+)
+
+// RandomPassword returns the bcrypt hash of a random password. Directory users log in with the
+// directory password, the local password is never known to anyone.
+func RandomPassword() (string, error) {
+	password := make([]byte, 32)
+	if _, err := rand.Read(password); err != nil {
+		return "", err
+	}
+	return encrypt.BcryptEncrypt(hex.EncodeToString(password)), nil
+}
+
+// CreateUser creates the user of a directory entry
+func CreateUser(ctx context.Context, tx *ent.Tx, mapped *ldap.MappedUser, password string, departmentID uint64, roleIDs []uint64) (*ent.User, error) {
+	nickname := mapped.Info.Nickname
+	if nickname == "" {
+		nickname = mapped.Info.Username
+	}
+
+	query := tx.User.Create().
+		SetUsername(mapped.Info.Username).
+		SetNickname(nickname).
+		SetPassword(password).
+		SetAvatar(mapped.Info.Avatar).
+		SetStatus(userStatus(mapped)).
+		AddRoleIDs(roleIDs...)
+	if mapped.Info.Email != "" {
+		query = query.SetEmail(mapped.Info.Email)
+	}
+	if mapped.Info.PhoneNumber != "" {
+		query = query.SetMobile(mapped.Info.PhoneNumber)
+	}
+	if departmentID != 0 {
+		query = query.SetDepartmentID(departmentID)
+	}
+
+	return query.Save(ctx)
+}
+
+// DepartmentOf returns the department mirrored from the nearest organizational unit above the
+// entry, or the default department of the provider
+func DepartmentOf(ctx context.Context, tx *ent.Tx, p *ent.LdapProvider, dn string) (uint64, error) {
+	bindings, err := tx.LdapDepartment.Query().
+		Where(ldapdepartment.ProviderIDEQ(p.ID)).
+		Select(ldapdepartment.FieldDepartmentID, ldapdepartment.FieldDn).
+		All(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	departments := make(map[string]uint64, len(bindings))
+	for _, v := range bindings {
+		departments[ldap.NormalizeDN(v.Dn)] = v.DepartmentID
+	}
+	return nearestDepartment(departments, dn, p.DefaultDepartmentID), nil
+}
+
+func nearestDepartment(departments map[string]uint64, dn string, fallback uint64) uint64 {
+	for parent := ldap.ParentDN(dn); parent != ""; parent = ldap.ParentDN(parent) {
+		if id, ok := departments[parent]; ok {
+			return id
+		}
+	}
+	return fallback
+}
+
+// RoleIDs returns the IDs of the roles with the codes, codes without a role are ignored
+func RoleIDs(ctx context.Context, tx *ent.Tx, codes []string) ([]uint64, error) {
+	if len(codes) == 0 {
+		return nil, nil
+	}
+	return tx.Role.Query().Where(role.CodeIn(codes...)).IDs(ctx)
+}
+
+// mergeIDs 合并并去重角色 ID
+func mergeIDs(ids ...[]uint64) []uint64 {
+	var result []uint64
+	for _, v := range ids {
+		result = append(result, v...)
+	}
+	slices.Sort(result)
+	return slices.Compact(result)
+}
+
+// userStatus AD 中禁用的账户同步为禁用状态
+func userStatus(mapped *ldap.MappedUser) uint8 {
+	if mapped.Disabled {
+		return common.StatusBanned
+	}
+	return common.StatusNormal
+}
@@ -0,0 +1,232 @@
+package api
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/coder-lulu/newbee-common/v2/orm/ent/hooks"
+
+	"github.com/coder-lulu/newbee-core/rpc/ent"
+	"github.com/coder-lulu/newbee-core/rpc/ent/api"
+	"github.com/coder-lulu/newbee-core/rpc/ent/casbinrule"
+	"github.com/coder-lulu/newbee-core/rpc/internal/svc"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/dberrorhandler"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/entx"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/errorx"
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// 接口目录属于平台租户，与初始化数据一致
+const catalogTenantID = 1
+
+type ApiRegistrationLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewApiRegistrationLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ApiRegistrationLogic {
+	return &ApiRegistrationLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// ApiRegistration 服务启动时登记全部接口：按 服务、方法、路径 新增或更新，服务不再登记的接口标记为废弃而不删除，
+// 并返回指向已废弃或不存在接口的权限规则
+func (l *ApiRegistrationLogic) ApiRegistration(in *core.ApiRegistrationReq) (*core.ApiRegistrationResp, error) {
+	if in.ServiceName == "" || len(in.Apis) == 0 {
+		return nil, errorx.NewInvalidArgumentError("api.registrationEmpty")
+	}
+	for _, v := range in.Apis {
+		if v.Path == "" || v.Method == "" {
+			return nil, errorx.NewInvalidArgumentError("api.invalidRegistration")
+		}
+	}
+
+	systemCtx := hooks.NewSystemContext(l.ctx)
+
+	// 数据库初始化以接口表为空作为判断条件，初始化前登记会导致初始化被跳过
+	count, err := l.svcCtx.DB.API.Query().Count(systemCtx)
+	if err != nil {
+		return nil, dberrorhandler.DefaultEntError(l.Logger, err, in.ServiceName)
+	}
+	if count == 0 {
+		return nil, status.Error(codes.FailedPrecondition, "api.catalogNotInitialized")
+	}
+
+	resp := &core.ApiRegistrationResp{}
+
+	err = entx.WithTx(systemCtx, l.svcCtx.DB, func(tx *ent.Tx) error {
+		existing, err := tx.API.Query().Where(api.ServiceNameEQ(in.ServiceName)).All(systemCtx)
+		if err != nil {
+			return err
+		}
+		byKey := make(map[string]*ent.API, len(existing))
+		for _, v := range existing {
+			byKey[apiKey(v.Path, v.Method)] = v
+		}
+
+		now := time.Now()
+		seen := make(map[string]bool, len(in.Apis))
+		var creates []*ent.APICreate
+		for _, v := range in.Apis {
+			method := strings.ToUpper(v.Method)
+			key := apiKey(v.Path, method)
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+
+			current, ok := byKey[key]
+			if !ok {
+				description := v.Path
+				if v.Description != nil && *v.Description != "" {
+					description = *v.Description
+				}
+				group := apiGroupOf(v.Path)
+				if v.ApiGroup != nil && *v.ApiGroup != "" {
+					group = *v.ApiGroup
+				}
+				creates = append(creates, tx.API.Create().
+					SetServiceName(in.ServiceName).
+					SetPath(v.Path).
+					SetMethod(method).
+					SetDescription(description).
+					SetAPIGroup(group).
+					SetNotNilIsRequired(v.IsRequired).
+					SetTenantID(catalogTenantID))
+				continue
+			}
+
+			// 只更新服务提供的字段，管理员修改过的描述不会被覆盖为空
+			update := current.Update()
+			changed := false
+			if current.IsDeprecated {
+				update.SetIsDeprecated(false).ClearDeprecatedAt()
+				resp.Restored++
+			}
+			if v.Description != nil && *v.Description != "" && *v.Description != current.Description {
+				update.SetDescription(*v.Description)
+				changed = true
+			}
+			if v.ApiGroup != nil && *v.ApiGroup != "" && *v.ApiGroup != current.APIGroup {
+				update.SetAPIGroup(*v.ApiGroup)
+				changed = true
+			}
+			if v.IsRequired != nil && *v.IsRequired != current.IsRequired {
+				update.SetIsRequired(*v.IsRequired)
+				changed = true
+			}
+			if changed {
+				resp.Updated++
+			}
+			if changed || current.IsDeprecated {
+				if err = update.Exec(systemCtx); err != nil {
+					return err
+				}
+			}
+		}
+
+		if len(creates) > 0 {
+			if err = tx.API.CreateBulk(creates...).Exec(systemCtx); err != nil {
+				return err
+			}
+			resp.Created = uint64(len(creates))
+		}
+
+		var vanished []uint64
+		for key, v := range byKey {
+			if !seen[key] && !v.IsDeprecated {
+				vanished = append(vanished, v.ID)
+			}
+		}
+		if len(vanished) > 0 {
+			if err = tx.API.Update().
+				Where(api.IDIn(vanished...)).
+				SetIsDeprecated(true).
+				SetDeprecatedAt(now).
+				Exec(systemCtx); err != nil {
+				return err
+			}
+			resp.Deprecated = uint64(len(vanished))
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, dberrorhandler.DefaultEntError(l.Logger, err, in.ServiceName)
+	}
+
+	resp.StaleRules, err = l.staleRules(systemCtx)
+	if err != nil {
+		return nil, dberrorhandler.DefaultEntError(l.Logger, err, in.ServiceName)
+	}
+
+	l.Infow("api catalog registered",
+		logx.Field("serviceName", in.ServiceName),
+		logx.Field("created", resp.Created),
+		logx.Field("updated", resp.Updated),
+		logx.Field("restored", resp.Restored),
+		logx.Field("deprecated", resp.Deprecated),
+		logx.Field("staleRules", len(resp.StaleRules)))
+
+	return resp, nil
+}
+
+// staleRules 查询全部租户中指向已废弃或不存在接口的 p 规则，带通配符或路径参数的规则不检查
+func (l *ApiRegistrationLogic) staleRules(ctx context.Context) ([]*core.ApiStaleRule, error) {
+	apis, err := l.svcCtx.DB.API.Query().
+		Where(api.IsDeprecatedEQ(false)).
+		Select(api.FieldPath, api.FieldMethod).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	known := make(map[string]bool, len(apis))
+	for _, v := range apis {
+		known[apiKey(v.Path, v.Method)] = true
+	}
+
+	rules, err := l.svcCtx.DB.CasbinRule.Query().
+		Where(casbinrule.PtypeEQ("p")).
+		Order(ent.Asc(casbinrule.FieldTenantID), ent.Asc(casbinrule.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*core.ApiStaleRule
+	for _, r := range rules {
+		if strings.ContainsAny(r.V2, "*:") || known[apiKey(r.V2, r.V3)] {
+			continue
+		}
+		result = append(result, &core.ApiStaleRule{
+			TenantId: r.TenantID,
+			Role:     r.V0,
+			Path:     r.V2,
+			Method:   strings.ToUpper(r.V3),
+		})
+	}
+
+	return result, nil
+}
+
+func apiKey(path, method string) string {
+	return strings.ToUpper(method) + " " + path
+}
+
+// apiGroupOf 未指定分组时使用路径的第一段，如 /user/list 属于 user 分组，gRPC 方法 /core.Core/getUserList 属于 core.Core 分组
+func apiGroupOf(path string) string {
+	group, _, _ := strings.Cut(strings.TrimPrefix(path, "/"), "/")
+	if group == "" {
+		return "other"
+	}
+	return group
+}
@@ -32,7 +32,11 @@ func NewCreateApiLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CreateA
 func (l *CreateApiLogic) CreateApi(in *core.ApiInfo) (*core.BaseIDResp, error) {
 	// if exist , return success
 	if in.Path != nil && in.Method != nil {
-		check, err := l.svcCtx.DB.API.Query().Where(api.Path(*in.Path), api.Method(*in.Method)).Only(l.ctx)
+		query := l.svcCtx.DB.API.Query().Where(api.Path(*in.Path), api.Method(*in.Method))
+		if in.ServiceName != nil {
+			query.Where(api.ServiceName(*in.ServiceName))
+		}
+		check, err := query.Only(l.ctx)
 		if err != nil && !ent.IsNotFound(err) {
 			return nil, dberrorhandler.DefaultEntError(l.Logger, err, in)
 		}
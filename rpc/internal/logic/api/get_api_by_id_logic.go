@@ -32,15 +32,21 @@ func (l *GetApiByIdLogic) GetApiById(in *core.IDReq) (*core.ApiInfo, error) {
 		return nil, dberrorhandler.DefaultEntError(l.Logger, err, in)
 	}
 
-	return &core.ApiInfo{
-		Id:          &result.ID,
-		CreatedAt:   pointy.GetPointer(result.CreatedAt.UnixMilli()),
-		UpdatedAt:   pointy.GetPointer(result.UpdatedAt.UnixMilli()),
-		Path:        &result.Path,
-		Description: &result.Description,
-		ApiGroup:    &result.APIGroup,
-		Method:      &result.Method,
-		IsRequired:  &result.IsRequired,
-		ServiceName: &result.ServiceName,
-	}, nil
+	info := &core.ApiInfo{
+		Id:           &result.ID,
+		CreatedAt:    pointy.GetPointer(result.CreatedAt.UnixMilli()),
+		UpdatedAt:    pointy.GetPointer(result.UpdatedAt.UnixMilli()),
+		Path:         &result.Path,
+		Description:  &result.Description,
+		ApiGroup:     &result.APIGroup,
+		Method:       &result.Method,
+		IsRequired:   &result.IsRequired,
+		ServiceName:  &result.ServiceName,
+		IsDeprecated: &result.IsDeprecated,
+	}
+	if result.DeprecatedAt != nil {
+		info.DeprecatedAt = pointy.GetPointer(result.DeprecatedAt.UnixMilli())
+	}
+
+	return info, nil
 }
@@ -47,6 +47,9 @@ func (l *GetApiListLogic) GetApiList(in *core.ApiListReq) (*core.ApiListResp, er
 	if in.ServiceName != nil {
 		predicates = append(predicates, api.ServiceNameContains(*in.ServiceName))
 	}
+	if in.IsDeprecated != nil {
+		predicates = append(predicates, api.IsDeprecatedEQ(*in.IsDeprecated))
+	}
 	result, err := l.svcCtx.DB.API.Query().Where(predicates...).Page(l.ctx, in.Page, in.PageSize)
 	if err != nil {
 		return nil, dberrorhandler.DefaultEntError(l.Logger, err, in)
@@ -56,16 +59,21 @@ func (l *GetApiListLogic) GetApiList(in *core.ApiListReq) (*core.ApiListResp, er
 	resp.Total = result.PageDetails.Total
 
 	for _, v := range result.List {
-		resp.Data = append(resp.Data, &core.ApiInfo{
-			Id:          &v.ID,
-			CreatedAt:   pointy.GetPointer(v.CreatedAt.UnixMilli()),
-			Path:        &v.Path,
-			Description: &v.Description,
-			ApiGroup:    &v.APIGroup,
-			Method:      &v.Method,
-			IsRequired:  &v.IsRequired,
-			ServiceName: &v.ServiceName,
-		})
+		info := &core.ApiInfo{
+			Id:           &v.ID,
+			CreatedAt:    pointy.GetPointer(v.CreatedAt.UnixMilli()),
+			Path:         &v.Path,
+			Description:  &v.Description,
+			ApiGroup:     &v.APIGroup,
+			Method:       &v.Method,
+			IsRequired:   &v.IsRequired,
+			ServiceName:  &v.ServiceName,
+			IsDeprecated: &v.IsDeprecated,
+		}
+		if v.DeprecatedAt != nil {
+			info.DeprecatedAt = pointy.GetPointer(v.DeprecatedAt.UnixMilli())
+		}
+		resp.Data = append(resp.Data, info)
 	}
 
 	return resp, nil
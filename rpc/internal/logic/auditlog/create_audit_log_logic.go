@@ -6,9 +6,11 @@ import (
 
 	"github.com/coder-lulu/newbee-common/v2/i18n"
 	"github.com/coder-lulu/newbee-common/v2/middleware/keys"
-	"github.com/coder-lulu/newbee-common/v2/utils/pointy"
+	"github.com/coder-lulu/newbee-core/rpc/ent"
 	"github.com/coder-lulu/newbee-core/rpc/ent/auditlog"
+	"github.com/coder-lulu/newbee-core/rpc/internal/impersonation"
 	"github.com/coder-lulu/newbee-core/rpc/internal/svc"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/auditchain"
 	"github.com/coder-lulu/newbee-core/rpc/internal/utils/dberrorhandler"
 	"github.com/coder-lulu/newbee-core/rpc/types/core"
 	"github.com/zeromicro/go-zero/core/errorx"
@@ -96,45 +98,50 @@ func (l *CreateAuditLogLogic) CreateAuditLog(in *core.AuditLogInfo) (*core.BaseU
 		logx.Field("path", in.RequestPath),
 		logx.Field("method", in.RequestMethod))
 
-	query := l.svcCtx.DB.AuditLog.Create().
-		SetNotNilTenantID(pointy.GetPointer(tenantIDStr)).
-		SetNotNilUserID(in.UserId).
-		SetNotNilUserName(in.UserName).
-		SetNotNilResourceType(in.ResourceType).
-		SetNotNilResourceID(in.ResourceId).
-		SetNotNilRequestMethod(in.RequestMethod).
-		SetNotNilRequestPath(in.RequestPath).
-		SetNotNilRequestData(in.RequestData).
-		SetNotNilResponseData(in.ResponseData).
-		SetNotNilIPAddress(in.IpAddress).
-		SetNotNilUserAgent(in.UserAgent).
-		SetNotNilDurationMs(in.DurationMs).
-		SetNotNilErrorMessage(in.ErrorMessage)
+	record := &ent.AuditLog{
+		TenantID:      tenantIDStr,
+		Status:        1,
+		UserID:        valueOf(in.UserId),
+		UserName:      valueOf(in.UserName),
+		ResourceType:  valueOf(in.ResourceType),
+		ResourceID:    valueOf(in.ResourceId),
+		RequestMethod: valueOf(in.RequestMethod),
+		RequestPath:   valueOf(in.RequestPath),
+		RequestData:   valueOf(in.RequestData),
+		ResponseData:  valueOf(in.ResponseData),
+		IPAddress:     valueOf(in.IpAddress),
+		UserAgent:     valueOf(in.UserAgent),
+		DurationMs:    valueOf(in.DurationMs),
+		ErrorMessage:  valueOf(in.ErrorMessage),
+	}
 
 	// Handle OperationType conversion
 	if in.OperationType != nil {
-		query.SetOperationType(auditlog.OperationType(*in.OperationType))
+		record.OperationType = auditlog.OperationType(*in.OperationType)
 	}
 
 	// Handle Metadata conversion
 	if in.Metadata != nil && *in.Metadata != "" {
 		var metadata map[string]interface{}
 		if err := json.Unmarshal([]byte(*in.Metadata), &metadata); err == nil {
-			query.SetMetadata(metadata)
+			record.Metadata = metadata
 		}
 	}
 
 	if in.Status != nil {
-		query.SetNotNilStatus(pointy.GetPointer(uint8(*in.Status)))
+		record.Status = uint8(*in.Status)
 	}
 	if in.ResponseStatus != nil {
-		query.SetNotNilResponseStatus(pointy.GetPointer(int(*in.ResponseStatus)))
+		record.ResponseStatus = int(*in.ResponseStatus)
 	}
 
-	// Save using system context to bypass tenant check
-	query = query.SetTenantID(tenantIDStr)
+	// 超级管理员进入租户期间的操作，记录真实身份和访问原因
+	if err := impersonation.Annotate(l.ctx, l.svcCtx.Redis, record); err != nil {
+		l.Errorw("failed to annotate the impersonation session", logx.Field("userId", record.UserID), logx.Field("error", err.Error()))
+	}
 
-	result, err := query.Save(l.ctx)
+	// 追加到租户的哈希链末尾
+	result, err := auditchain.Append(l.ctx, l.svcCtx.DB, record)
 
 	if err != nil {
 		logx.Errorw("Failed to save audit log",
@@ -151,3 +158,11 @@ func (l *CreateAuditLogLogic) CreateAuditLog(in *core.AuditLogInfo) (*core.BaseU
 
 	return &core.BaseUUIDResp{Id: result.ID.String(), Msg: i18n.CreateSuccess}, nil
 }
+
+func valueOf[T any](v *T) T {
+	if v == nil {
+		var zero T
+		return zero
+	}
+	return *v
+}
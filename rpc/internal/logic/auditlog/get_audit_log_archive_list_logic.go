@@ -0,0 +1,86 @@
+package auditlog
+
+import (
+	"context"
+	"time"
+
+	"github.com/coder-lulu/newbee-common/v2/middleware/keys"
+	"github.com/zeromicro/go-zero/core/errorx"
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/coder-lulu/newbee-core/rpc/ent"
+	"github.com/coder-lulu/newbee-core/rpc/ent/auditlogarchive"
+	"github.com/coder-lulu/newbee-core/rpc/ent/predicate"
+	"github.com/coder-lulu/newbee-core/rpc/internal/svc"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/dberrorhandler"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+)
+
+type GetAuditLogArchiveListLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetAuditLogArchiveListLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetAuditLogArchiveListLogic {
+	return &GetAuditLogArchiveListLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// GetAuditLogArchiveList 查询当前租户的归档清单
+func (l *GetAuditLogArchiveListLogic) GetAuditLogArchiveList(in *core.AuditLogArchiveListReq) (*core.AuditLogArchiveListResp, error) {
+	tenantIDStr, ok := l.ctx.Value(keys.TenantIDKey).(string)
+	if !ok || tenantIDStr == "" {
+		if md, mdOK := metadata.FromIncomingContext(l.ctx); mdOK {
+			if vals := md.Get(keys.TenantIDKey.String()); len(vals) > 0 {
+				tenantIDStr = vals[0]
+			}
+		}
+	}
+
+	if tenantIDStr == "" {
+		return nil, errorx.NewInvalidArgumentError("tenant.missingContext")
+	}
+
+	var predicates []predicate.AuditLogArchive
+	predicates = append(predicates, auditlogarchive.TenantIDEQ(tenantIDStr))
+	// 与时间范围有交集的归档
+	if in.StartTime != nil {
+		predicates = append(predicates, auditlogarchive.EndAtGTE(time.UnixMilli(*in.StartTime)))
+	}
+	if in.EndTime != nil {
+		predicates = append(predicates, auditlogarchive.StartAtLTE(time.UnixMilli(*in.EndTime)))
+	}
+
+	result, err := l.svcCtx.DB.AuditLogArchive.Query().Where(predicates...).
+		Order(ent.Desc(auditlogarchive.FieldToSeq)).
+		Page(l.ctx, in.Page, in.PageSize)
+	if err != nil {
+		return nil, dberrorhandler.DefaultEntError(l.Logger, err, in)
+	}
+
+	resp := &core.AuditLogArchiveListResp{}
+	resp.Total = result.PageDetails.Total
+
+	for _, v := range result.List {
+		resp.Data = append(resp.Data, &core.AuditLogArchiveInfo{
+			Id:        v.ID.String(),
+			CreatedAt: v.CreatedAt.UnixMilli(),
+			FromSeq:   v.FromSeq,
+			ToSeq:     v.ToSeq,
+			Count:     int64(v.Count),
+			StartAt:   v.StartAt.UnixMilli(),
+			EndAt:     v.EndAt.UnixMilli(),
+			Store:     v.Store,
+			ObjectKey: v.ObjectKey,
+			Size:      v.Size,
+			Checksum:  v.Checksum,
+		})
+	}
+
+	return resp, nil
+}
@@ -117,5 +117,8 @@ func (l *GetAuditLogByIdLogic) GetAuditLogById(in *core.UUIDReq) (*core.AuditLog
 			}
 			return pointy.GetPointer("")
 		}(),
+		Seq:      result.Seq,
+		PrevHash: &result.PrevHash,
+		Hash:     &result.Hash,
 	}, nil
 }
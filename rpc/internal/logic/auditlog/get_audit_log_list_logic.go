@@ -209,6 +209,9 @@ func (l *GetAuditLogListLogic) GetAuditLogList(in *core.AuditLogListReq) (*core.
 				}
 				return pointy.GetPointer("")
 			}(),
+			Seq:      v.Seq,
+			PrevHash: &v.PrevHash,
+			Hash:     &v.Hash,
 		})
 	}
 
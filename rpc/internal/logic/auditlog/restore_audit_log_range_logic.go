@@ -0,0 +1,192 @@
+package auditlog
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/coder-lulu/newbee-common/v2/middleware/keys"
+	"github.com/coder-lulu/newbee-common/v2/utils/pointy"
+	"github.com/zeromicro/go-zero/core/errorx"
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/coder-lulu/newbee-core/rpc/ent"
+	"github.com/coder-lulu/newbee-core/rpc/ent/auditlogarchive"
+	"github.com/coder-lulu/newbee-core/rpc/internal/auditarchive"
+	"github.com/coder-lulu/newbee-core/rpc/internal/svc"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/auditchain"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/dberrorhandler"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+)
+
+type RestoreAuditLogRangeLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewRestoreAuditLogRangeLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RestoreAuditLogRangeLogic {
+	return &RestoreAuditLogRangeLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// RestoreAuditLogRange 从归档文件中读取时间范围内的审计日志，支持与列表相同的筛选条件。
+// 读取时校验文件校验和以及每条记录的哈希，归档被篡改时返回错误。
+func (l *RestoreAuditLogRangeLogic) RestoreAuditLogRange(in *core.AuditLogListReq) (*core.AuditLogListResp, error) {
+	tenantIDStr, ok := l.ctx.Value(keys.TenantIDKey).(string)
+	if !ok || tenantIDStr == "" {
+		if md, mdOK := metadata.FromIncomingContext(l.ctx); mdOK {
+			if vals := md.Get(keys.TenantIDKey.String()); len(vals) > 0 {
+				tenantIDStr = vals[0]
+			}
+		}
+	}
+
+	if tenantIDStr == "" {
+		return nil, errorx.NewInvalidArgumentError("tenant.missingContext")
+	}
+
+	if l.svcCtx.AuditArchiveStore == nil {
+		return nil, errorx.NewInvalidArgumentError("auditLog.archiveDisabled")
+	}
+
+	if in.StartTime == nil || in.EndTime == nil {
+		return nil, errorx.NewInvalidArgumentError("auditLog.restoreRangeRequired")
+	}
+	startTime, endTime := time.UnixMilli(*in.StartTime), time.UnixMilli(*in.EndTime)
+
+	archives, err := l.svcCtx.DB.AuditLogArchive.Query().
+		Where(auditlogarchive.TenantIDEQ(tenantIDStr),
+			auditlogarchive.EndAtGTE(startTime),
+			auditlogarchive.StartAtLTE(endTime)).
+		Order(ent.Asc(auditlogarchive.FieldFromSeq)).
+		Limit(l.svcCtx.Config.AuditLog.Archive.MaxArchives + 1).
+		All(l.ctx)
+	if err != nil {
+		return nil, dberrorhandler.DefaultEntError(l.Logger, err, in)
+	}
+
+	if len(archives) > l.svcCtx.Config.AuditLog.Archive.MaxArchives {
+		return nil, errorx.NewInvalidArgumentError("auditLog.restoreRangeTooLarge")
+	}
+
+	var matched []*ent.AuditLog
+	for _, archive := range archives {
+		logs, err := l.load(archive)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range logs {
+			if !v.CreatedAt.Before(startTime) && !v.CreatedAt.After(endTime) && matchAuditLog(v, in) {
+				matched = append(matched, v)
+			}
+		}
+	}
+
+	sort.SliceStable(matched, func(i, j int) bool {
+		return *matched[i].Seq > *matched[j].Seq
+	})
+
+	resp := &core.AuditLogListResp{Total: uint64(len(matched))}
+
+	offset := (in.Page - 1) * in.PageSize
+	if in.Page == 0 || offset >= uint64(len(matched)) {
+		return resp, nil
+	}
+	end := min(offset+in.PageSize, uint64(len(matched)))
+
+	for _, v := range matched[offset:end] {
+		resp.Data = append(resp.Data, &core.AuditLogInfo{
+			Id:             pointy.GetPointer(v.ID.String()),
+			CreatedAt:      pointy.GetPointer(v.CreatedAt.UnixMilli()),
+			UpdatedAt:      pointy.GetPointer(v.UpdatedAt.UnixMilli()),
+			Status:         pointy.GetPointer(uint32(v.Status)),
+			TenantId:       &v.TenantID,
+			UserId:         &v.UserID,
+			UserName:       &v.UserName,
+			OperationType:  pointy.GetPointer(string(v.OperationType)),
+			ResourceType:   &v.ResourceType,
+			ResourceId:     &v.ResourceID,
+			RequestMethod:  &v.RequestMethod,
+			RequestPath:    &v.RequestPath,
+			RequestData:    &v.RequestData,
+			ResponseStatus: pointy.GetPointer(int64(v.ResponseStatus)),
+			ResponseData:   &v.ResponseData,
+			IpAddress:      &v.IPAddress,
+			UserAgent:      &v.UserAgent,
+			DurationMs:     &v.DurationMs,
+			ErrorMessage:   &v.ErrorMessage,
+			Metadata: func() *string {
+				if v.Metadata != nil {
+					if jsonData, err := json.Marshal(v.Metadata); err == nil {
+						return pointy.GetPointer(string(jsonData))
+					}
+				}
+				return pointy.GetPointer("")
+			}(),
+			Seq:      v.Seq,
+			PrevHash: &v.PrevHash,
+			Hash:     &v.Hash,
+		})
+	}
+
+	return resp, nil
+}
+
+// load reads the archive and verifies its checksum and the hash chain inside it
+func (l *RestoreAuditLogRangeLogic) load(archive *ent.AuditLogArchive) ([]*ent.AuditLog, error) {
+	data, err := l.svcCtx.AuditArchiveStore.Get(l.ctx, archive.ObjectKey)
+	if err != nil {
+		l.Logger.Errorw("failed to read audit log archive", logx.Field("detail", err.Error()),
+			logx.Field("objectKey", archive.ObjectKey))
+		return nil, errorx.NewInternalError("auditLog.archiveCorrupted")
+	}
+
+	if auditarchive.Checksum(data) != archive.Checksum {
+		l.Logger.Errorw("audit log archive checksum mismatch", logx.Field("objectKey", archive.ObjectKey))
+		return nil, errorx.NewInternalError("auditLog.archiveCorrupted")
+	}
+
+	logs, err := auditarchive.Decode(data)
+	if err != nil || len(logs) != archive.Count {
+		l.Logger.Errorw("failed to decode audit log archive", logx.Field("objectKey", archive.ObjectKey))
+		return nil, errorx.NewInternalError("auditLog.archiveCorrupted")
+	}
+
+	for i, v := range logs {
+		if v.TenantID != archive.TenantID || *v.Seq != archive.FromSeq+uint64(i) || auditchain.Hash(v) != v.Hash ||
+			(i > 0 && v.PrevHash != logs[i-1].Hash) {
+			l.Logger.Errorw("audit log archive chain is broken", logx.Field("objectKey", archive.ObjectKey),
+				logx.Field("seq", *v.Seq))
+			return nil, errorx.NewInternalError("auditLog.archiveCorrupted")
+		}
+	}
+
+	return logs, nil
+}
+
+// matchAuditLog applies the same filters as GetAuditLogList
+func matchAuditLog(v *ent.AuditLog, in *core.AuditLogListReq) bool {
+	switch {
+	case in.UserId != nil && !strings.Contains(v.UserID, *in.UserId),
+		in.UserName != nil && !strings.Contains(v.UserName, *in.UserName),
+		in.OperationType != nil && string(v.OperationType) != *in.OperationType,
+		in.ResourceType != nil && !strings.Contains(v.ResourceType, *in.ResourceType),
+		in.ResourceId != nil && !strings.Contains(v.ResourceID, *in.ResourceId),
+		in.RequestMethod != nil && v.RequestMethod != *in.RequestMethod,
+		in.RequestPath != nil && !strings.Contains(v.RequestPath, *in.RequestPath),
+		in.IpAddress != nil && v.IPAddress != *in.IpAddress,
+		in.ResponseStatus != nil && int64(v.ResponseStatus) != *in.ResponseStatus,
+		in.MinDuration != nil && v.DurationMs < *in.MinDuration,
+		in.MaxDuration != nil && v.DurationMs > *in.MaxDuration:
+		return false
+	}
+	return true
+}
@@ -0,0 +1,72 @@
+package auditlog
+
+import (
+	"context"
+
+	"github.com/coder-lulu/newbee-common/v2/middleware/keys"
+	"github.com/zeromicro/go-zero/core/errorx"
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/coder-lulu/newbee-core/rpc/internal/svc"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/auditchain"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/dberrorhandler"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+)
+
+type VerifyAuditLogChainLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewVerifyAuditLogChainLogic(ctx context.Context, svcCtx *svc.ServiceContext) *VerifyAuditLogChainLogic {
+	return &VerifyAuditLogChainLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// VerifyAuditLogChain 校验当前租户审计日志哈希链的完整性，检测记录缺失、篡改以及检查点和清理记录的签名
+func (l *VerifyAuditLogChainLogic) VerifyAuditLogChain(in *core.Empty) (*core.AuditLogVerifyResp, error) {
+	tenantIDStr, ok := l.ctx.Value(keys.TenantIDKey).(string)
+	if !ok || tenantIDStr == "" {
+		if md, mdOK := metadata.FromIncomingContext(l.ctx); mdOK {
+			if vals := md.Get(keys.TenantIDKey.String()); len(vals) > 0 {
+				tenantIDStr = vals[0]
+			}
+		}
+	}
+
+	if tenantIDStr == "" {
+		return nil, errorx.NewInvalidArgumentError("tenant.missingContext")
+	}
+
+	report, err := auditchain.Verify(l.ctx, l.svcCtx.DB, l.svcCtx.AuditSigner, tenantIDStr)
+	if err != nil {
+		return nil, dberrorhandler.DefaultEntError(l.Logger, err, tenantIDStr)
+	}
+
+	resp := &core.AuditLogVerifyResp{
+		Valid:       report.Valid(),
+		Checked:     report.Checked,
+		HeadSeq:     report.HeadSeq,
+		HeadHash:    report.HeadHash,
+		PrunedToSeq: report.PrunedToSeq,
+	}
+	for _, v := range report.Issues {
+		resp.Issues = append(resp.Issues, &core.AuditLogChainIssue{
+			Seq:    v.Seq,
+			Type:   v.Type,
+			Detail: v.Detail,
+		})
+	}
+
+	if !resp.Valid {
+		l.Logger.Errorw("audit log chain verification failed", logx.Field("tenantId", tenantIDStr),
+			logx.Field("issues", len(report.Issues)))
+	}
+
+	return resp, nil
+}
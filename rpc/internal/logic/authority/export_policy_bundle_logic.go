@@ -0,0 +1,48 @@
+package authority
+
+import (
+	"context"
+
+	"github.com/coder-lulu/newbee-common/v2/orm/ent/entctx/tenantctx"
+
+	"github.com/coder-lulu/newbee-core/rpc/internal/policybundle"
+	"github.com/coder-lulu/newbee-core/rpc/internal/svc"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/dberrorhandler"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ExportPolicyBundleLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewExportPolicyBundleLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ExportPolicyBundleLogic {
+	return &ExportPolicyBundleLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// ExportPolicyBundle exports the roles, menus, API rules, role inheritance and data scopes of the current tenant
+func (l *ExportPolicyBundleLogic) ExportPolicyBundle(in *core.PolicyBundleExportReq) (*core.PolicyBundleResp, error) {
+	format := in.GetFormat()
+	if format == "" {
+		format = policybundle.FormatYAML
+	}
+
+	bundle, err := policybundle.Export(l.ctx, l.svcCtx.DB, tenantctx.GetTenantIDFromCtx(l.ctx), in.RoleCodes)
+	if err != nil {
+		return nil, dberrorhandler.DefaultEntError(l.Logger, err, in)
+	}
+
+	content, err := policybundle.Marshal(bundle, format)
+	if err != nil {
+		return nil, policyBundleError(l.Logger, err)
+	}
+
+	return &core.PolicyBundleResp{Format: format, Content: string(content)}, nil
+}
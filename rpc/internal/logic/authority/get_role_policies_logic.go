@@ -0,0 +1,45 @@
+package authority
+
+import (
+	"context"
+
+	"github.com/coder-lulu/newbee-common/v2/orm/ent/entctx/tenantctx"
+
+	"github.com/coder-lulu/newbee-core/rpc/internal/svc"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/dberrorhandler"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetRolePoliciesLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetRolePoliciesLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetRolePoliciesLogic {
+	return &GetRolePoliciesLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *GetRolePoliciesLogic) GetRolePolicies(in *core.IDReq) (*core.RolePolicyListResp, error) {
+	roleInfo, err := l.svcCtx.DB.Role.Get(l.ctx, in.Id)
+	if err != nil {
+		return nil, dberrorhandler.DefaultEntError(l.Logger, err, in)
+	}
+
+	policies, err := loadRolePolicies(l.ctx, l.svcCtx.DB.CasbinRule, roleInfo.Code, tenantctx.GetTenantIDFromCtx(l.ctx))
+	if err != nil {
+		return nil, dberrorhandler.DefaultEntError(l.Logger, err, in)
+	}
+
+	keys := policyKeys(policies)
+	return &core.RolePolicyListResp{
+		Version: rolePolicyVersion(keys),
+		Data:    rolePolicyInfos(keys),
+	}, nil
+}
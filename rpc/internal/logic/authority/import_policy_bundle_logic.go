@@ -0,0 +1,87 @@
+package authority
+
+import (
+	"context"
+	"errors"
+
+	"github.com/coder-lulu/newbee-common/v2/orm/ent/entctx/tenantctx"
+	"github.com/zeromicro/go-zero/core/errorx"
+
+	"github.com/coder-lulu/newbee-core/rpc/internal/policybundle"
+	"github.com/coder-lulu/newbee-core/rpc/internal/svc"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/dberrorhandler"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/redisfunc"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ImportPolicyBundleLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewImportPolicyBundleLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ImportPolicyBundleLogic {
+	return &ImportPolicyBundleLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// ImportPolicyBundle imports a policy bundle into the current tenant, the changes are applied in one transaction unless dry_run is set
+func (l *ImportPolicyBundleLogic) ImportPolicyBundle(in *core.PolicyBundleImportReq) (*core.PolicyBundleImportResp, error) {
+	bundle, err := policybundle.Unmarshal([]byte(in.Content), in.GetFormat())
+	if err != nil {
+		return nil, policyBundleError(l.Logger, err)
+	}
+
+	tenantID := tenantctx.GetTenantIDFromCtx(l.ctx)
+	changes, err := policybundle.Import(l.ctx, l.svcCtx.DB, tenantID, bundle, in.DryRun)
+	if err != nil {
+		return nil, policyBundleError(l.Logger, err)
+	}
+
+	applied := !in.DryRun && len(changes) > 0
+	if applied {
+		if l.svcCtx.EnforcerManager != nil {
+			l.svcCtx.EnforcerManager.InvalidateConditions(tenantID)
+			if err = l.svcCtx.EnforcerManager.ReloadPolicy(l.ctx); err != nil {
+				l.Logger.Errorw("failed to reload casbin policy after importing policy bundle",
+					logx.Field("tenantId", tenantID),
+					logx.Field("error", err.Error()))
+			}
+		}
+		if err = redisfunc.PublishCasbinReload(l.ctx, l.svcCtx.Redis, l.svcCtx.Config.RedisConf.Db, tenantID, "policy_bundle"); err != nil {
+			l.Logger.Errorw("failed to publish casbin reload after importing policy bundle",
+				logx.Field("tenantId", tenantID),
+				logx.Field("error", err.Error()))
+		}
+
+		l.Logger.Infow("imported policy bundle",
+			logx.Field("tenantId", tenantID),
+			logx.Field("roles", len(bundle.Roles)),
+			logx.Field("changes", len(changes)))
+	}
+
+	resp := &core.PolicyBundleImportResp{Applied: applied}
+	for _, c := range changes {
+		resp.Data = append(resp.Data, &core.PolicyBundleChange{
+			Kind:   c.Kind,
+			Action: c.Action,
+			Role:   c.Role,
+			Target: c.Target,
+		})
+	}
+
+	return resp, nil
+}
+
+// policyBundleError 权限包内容错误返回具体原因，便于修改后重新导入
+func policyBundleError(logger logx.Logger, err error) error {
+	if errors.Is(err, policybundle.ErrInvalidBundle) {
+		return errorx.NewInvalidArgumentError(err.Error())
+	}
+	return dberrorhandler.DefaultEntError(logger, err, nil)
+}
@@ -0,0 +1,173 @@
+package authority
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/coder-lulu/newbee-common/v2/orm/ent/entctx/tenantctx"
+	"github.com/zeromicro/go-zero/core/errorx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/coder-lulu/newbee-core/rpc/ent"
+	"github.com/coder-lulu/newbee-core/rpc/ent/casbinrule"
+	"github.com/coder-lulu/newbee-core/rpc/ent/role"
+	"github.com/coder-lulu/newbee-core/rpc/internal/svc"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/dberrorhandler"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/entx"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/redisfunc"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// errPolicyVersionConflict 角色权限在读取后已被修改
+var errPolicyVersionConflict = errors.New("role policy version conflict")
+
+type ReplaceRolePoliciesLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewReplaceRolePoliciesLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ReplaceRolePoliciesLogic {
+	return &ReplaceRolePoliciesLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// ReplaceRolePolicies 将角色的API权限替换为请求中的集合。
+// 差异计算和写入在同一事务中完成，事务内锁定角色行，并发修改同一角色时串行执行；
+// 传入 version 时与当前版本比较，不一致说明权限已被他人修改，返回 Aborted
+func (l *ReplaceRolePoliciesLogic) ReplaceRolePolicies(in *core.ReplaceRolePoliciesReq) (*core.ReplaceRolePoliciesResp, error) {
+	desired := make(map[string]struct{}, len(in.Data))
+	keys := make([]string, 0, len(in.Data))
+	for _, item := range in.Data {
+		path, method := strings.TrimSpace(item.Path), strings.TrimSpace(item.Method)
+		if path == "" || method == "" {
+			return nil, errorx.NewInvalidArgumentError("casbin.invalidPolicy")
+		}
+		key := rolePolicyKey(path, method)
+		if _, ok := desired[key]; !ok {
+			desired[key] = struct{}{}
+			keys = append(keys, key)
+		}
+	}
+
+	tenantID := tenantctx.GetTenantIDFromCtx(l.ctx)
+	var roleCode string
+	var added, removed []string
+
+	err := entx.WithTx(l.ctx, l.svcCtx.DB, func(tx *ent.Tx) error {
+		var err error
+		roleCode, err = tx.Role.Query().
+			Where(role.IDEQ(in.RoleId)).
+			Select(role.FieldCode).
+			Modify(func(s *sql.Selector) { s.ForUpdate() }).
+			String(l.ctx)
+		if err != nil {
+			return err
+		}
+
+		existing, err := loadRolePolicies(l.ctx, tx.CasbinRule, roleCode, tenantID)
+		if err != nil {
+			return err
+		}
+		if in.Version != nil && *in.Version != rolePolicyVersion(policyKeys(existing)) {
+			return errPolicyVersionConflict
+		}
+
+		var deleteIDs []uint64
+		for key, ids := range existing {
+			if _, keep := desired[key]; keep {
+				// 清理重复规则，只保留一条
+				deleteIDs = append(deleteIDs, ids[1:]...)
+				continue
+			}
+			removed = append(removed, key)
+			deleteIDs = append(deleteIDs, ids...)
+		}
+
+		bulk := make([]*ent.CasbinRuleCreate, 0)
+		for _, key := range keys {
+			if _, ok := existing[key]; ok {
+				continue
+			}
+			added = append(added, key)
+			info := rolePolicyInfo(key)
+			bulk = append(bulk, tx.CasbinRule.Create().
+				SetPtype("p").
+				SetV0(roleCode).
+				SetV1(strconv.FormatUint(tenantID, 10)).
+				SetV2(info.Path).
+				SetV3(info.Method).
+				SetV4(rolePolicyEffect).
+				SetServiceName(rolePolicyService).
+				SetRuleName(fmt.Sprintf("%s access to %s %s", roleCode, info.Method, info.Path)).
+				SetCategory(rolePolicyCategory).
+				SetVersion("1.0.0").
+				SetRequireApproval(false).
+				SetApprovalStatus("approved").
+				SetStatus(1).
+				SetTenantID(tenantID))
+		}
+
+		if len(deleteIDs) > 0 {
+			if _, err = tx.CasbinRule.Delete().Where(casbinrule.IDIn(deleteIDs...)).Exec(l.ctx); err != nil {
+				return err
+			}
+		}
+		if len(bulk) > 0 {
+			if err = tx.CasbinRule.CreateBulk(bulk...).Exec(l.ctx); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		if errors.Is(err, errPolicyVersionConflict) {
+			return nil, status.Error(codes.Aborted, "casbin.policyVersionConflict")
+		}
+		return nil, dberrorhandler.DefaultEntError(l.Logger, err, in)
+	}
+
+	if len(added) > 0 || len(removed) > 0 {
+		l.reloadPolicies(tenantID)
+	}
+
+	l.Logger.Infow("replaced role policies",
+		logx.Field("roleCode", roleCode),
+		logx.Field("tenantId", tenantID),
+		logx.Field("added", len(added)),
+		logx.Field("removed", len(removed)))
+
+	return &core.ReplaceRolePoliciesResp{
+		Version: rolePolicyVersion(keys),
+		Added:   rolePolicyInfos(added),
+		Removed: rolePolicyInfos(removed),
+	}, nil
+}
+
+// reloadPolicies 重新加载本地执行器并发布一次重载通知，数据已提交，失败只记录日志
+func (l *ReplaceRolePoliciesLogic) reloadPolicies(tenantID uint64) {
+	if l.svcCtx.EnforcerManager != nil {
+		if err := l.svcCtx.EnforcerManager.ReloadPolicy(l.ctx); err != nil {
+			l.Logger.Errorw("failed to reload casbin policy after replacing role policies",
+				logx.Field("tenantId", tenantID),
+				logx.Field("error", err.Error()))
+		}
+	}
+
+	if err := redisfunc.PublishCasbinReload(l.ctx, l.svcCtx.Redis, l.svcCtx.Config.RedisConf.Db, tenantID, "role_policies"); err != nil {
+		l.Logger.Errorw("failed to publish casbin reload after replacing role policies",
+			logx.Field("tenantId", tenantID),
+			logx.Field("error", err.Error()))
+	}
+}
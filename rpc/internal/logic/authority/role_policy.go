@@ -0,0 +1,91 @@
+package authority
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/coder-lulu/newbee-core/rpc/ent"
+	"github.com/coder-lulu/newbee-core/rpc/ent/casbinrule"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+)
+
+// 角色API权限只管理由授权页面维护的普通规则：core 服务下效果为 allow、无ABAC条件的 p 规则，
+// 其他规则（deny、带条件或其他服务）通过 Casbin 规则接口单独维护
+const (
+	rolePolicyService  = "core"
+	rolePolicyEffect   = "allow"
+	rolePolicyCategory = "api_permission"
+)
+
+// rolePolicyQuery 查询角色在租户内的API权限规则
+func rolePolicyQuery(client *ent.CasbinRuleClient, roleCode string, tenantID uint64) *ent.CasbinRuleQuery {
+	return client.Query().Where(
+		casbinrule.TenantIDEQ(tenantID),
+		casbinrule.PtypeEQ("p"),
+		casbinrule.V0EQ(roleCode),
+		casbinrule.V1EQ(strconv.FormatUint(tenantID, 10)),
+		casbinrule.ServiceNameEQ(rolePolicyService),
+		casbinrule.V4EQ(rolePolicyEffect),
+		casbinrule.Or(casbinrule.ConditionsIsNil(), casbinrule.ConditionsEQ("")),
+	)
+}
+
+// rolePolicyKey 统一策略键格式: METHOD path
+func rolePolicyKey(path, method string) string {
+	return strings.ToUpper(method) + " " + path
+}
+
+// rolePolicyVersion 根据规则集合计算版本号，集合相同则版本相同
+func rolePolicyVersion(keys []string) string {
+	sorted := slices.Clone(keys)
+	slices.Sort(sorted)
+	sum := sha256.Sum256([]byte(strings.Join(sorted, "\n")))
+	return hex.EncodeToString(sum[:8])
+}
+
+// rolePolicyInfo 将规则键还原为权限信息
+func rolePolicyInfo(key string) *core.RolePolicyInfo {
+	method, path, _ := strings.Cut(key, " ")
+	return &core.RolePolicyInfo{Path: path, Method: method}
+}
+
+// rolePolicyInfos 按键排序后转换为权限信息列表
+func rolePolicyInfos(keys []string) []*core.RolePolicyInfo {
+	sorted := slices.Clone(keys)
+	slices.Sort(sorted)
+	result := make([]*core.RolePolicyInfo, 0, len(sorted))
+	for _, key := range sorted {
+		result = append(result, rolePolicyInfo(key))
+	}
+	return result
+}
+
+// loadRolePolicies 加载角色的规则，返回规则键到规则ID的映射，同一键可能对应多条重复规则
+func loadRolePolicies(ctx context.Context, client *ent.CasbinRuleClient, roleCode string, tenantID uint64) (map[string][]uint64, error) {
+	rules, err := rolePolicyQuery(client, roleCode, tenantID).
+		Order(ent.Asc(casbinrule.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string][]uint64, len(rules))
+	for _, r := range rules {
+		key := rolePolicyKey(r.V2, r.V3)
+		result[key] = append(result[key], r.ID)
+	}
+	return result, nil
+}
+
+// policyKeys 返回映射中的全部规则键
+func policyKeys(policies map[string][]uint64) []string {
+	keys := make([]string, 0, len(policies))
+	for key := range policies {
+		keys = append(keys, key)
+	}
+	return keys
+}
@@ -14,6 +14,7 @@ import (
 // 🔥 自动生成于: 2025-10-08
 // 🔥 从core/api/desc目录的.api文件解析生成
 // 🔥 总接口数: 165个 (Core: 133, Job: 10, MCMS: 22)
+// 初始数据只用于首次初始化，之后各服务启动时通过 apicatalog 登记接口，新增的路由无需再添加到这里
 func (l *InitDatabaseLogic) insertApiData(ctx context.Context) error {
 	ctxWithTenant := hooks.SetTenantIDToContext(context.Background(), 1)
 	var apis []*ent.APICreate
@@ -83,8 +84,8 @@ func (l *InitDatabaseLogic) insertApiData(ctx context.Context) error {
 
 	apis = append(apis, l.svcCtx.DB.API.Create().
 		SetServiceName("Core").
-		SetPath("/audit-log/delete").
-		SetDescription("Delete audit logs | 删除审计日志").
+		SetPath("/audit-log/list").
+		SetDescription("Get audit log list | 获取审计日志列表").
 		SetAPIGroup("auditlog").
 		SetMethod("POST").
 		SetIsRequired(false).
@@ -93,8 +94,8 @@ func (l *InitDatabaseLogic) insertApiData(ctx context.Context) error {
 
 	apis = append(apis, l.svcCtx.DB.API.Create().
 		SetServiceName("Core").
-		SetPath("/audit-log/list").
-		SetDescription("Get audit log list | 获取审计日志列表").
+		SetPath("/audit-log/stats").
+		SetDescription("Get audit log statistics | 获取审计日志统计").
 		SetAPIGroup("auditlog").
 		SetMethod("POST").
 		SetIsRequired(false).
@@ -103,8 +104,28 @@ func (l *InitDatabaseLogic) insertApiData(ctx context.Context) error {
 
 	apis = append(apis, l.svcCtx.DB.API.Create().
 		SetServiceName("Core").
-		SetPath("/audit-log/stats").
-		SetDescription("Get audit log statistics | 获取审计日志统计").
+		SetPath("/audit-log/archive/list").
+		SetDescription("Get audit log archive list | 获取审计日志归档列表").
+		SetAPIGroup("auditlog").
+		SetMethod("POST").
+		SetIsRequired(false).
+		SetTenantID(1),
+	)
+
+	apis = append(apis, l.svcCtx.DB.API.Create().
+		SetServiceName("Core").
+		SetPath("/audit-log/restore").
+		SetDescription("Search archived audit logs in a time range | 查询时间范围内的归档审计日志").
+		SetAPIGroup("auditlog").
+		SetMethod("POST").
+		SetIsRequired(false).
+		SetTenantID(1),
+	)
+
+	apis = append(apis, l.svcCtx.DB.API.Create().
+		SetServiceName("Core").
+		SetPath("/audit-log/verify").
+		SetDescription("Verify audit log hash chain | 校验审计日志哈希链").
 		SetAPIGroup("auditlog").
 		SetMethod("POST").
 		SetIsRequired(false).
@@ -163,6 +184,26 @@ func (l *InitDatabaseLogic) insertApiData(ctx context.Context) error {
 		SetTenantID(1),
 	)
 
+	apis = append(apis, l.svcCtx.DB.API.Create().
+		SetServiceName("Core").
+		SetPath("/authority/policy_bundle/export").
+		SetDescription("Export the tenant's roles and permissions as a policy bundle | 导出租户角色和权限包").
+		SetAPIGroup("authority").
+		SetMethod("POST").
+		SetIsRequired(false).
+		SetTenantID(1),
+	)
+
+	apis = append(apis, l.svcCtx.DB.API.Create().
+		SetServiceName("Core").
+		SetPath("/authority/policy_bundle/import").
+		SetDescription("Import a policy bundle into the tenant | 导入权限包").
+		SetAPIGroup("authority").
+		SetMethod("POST").
+		SetIsRequired(false).
+		SetTenantID(1),
+	)
+
 	// Captcha
 	apis = append(apis, l.svcCtx.DB.API.Create().
 		SetServiceName("Core").
@@ -559,12 +600,12 @@ func (l *InitDatabaseLogic) insertApiData(ctx context.Context) error {
 		SetTenantID(1),
 	)
 
-	// Menu
+	// Ldapprovider
 	apis = append(apis, l.svcCtx.DB.API.Create().
 		SetServiceName("Core").
-		SetPath("/menu/create").
-		SetDescription("Create menu information | 创建菜单").
-		SetAPIGroup("menu").
+		SetPath("/ldap_provider").
+		SetDescription("Get LDAP provider by ID | 通过ID获取LDAP目录").
+		SetAPIGroup("ldapprovider").
 		SetMethod("POST").
 		SetIsRequired(false).
 		SetTenantID(1),
@@ -572,9 +613,9 @@ func (l *InitDatabaseLogic) insertApiData(ctx context.Context) error {
 
 	apis = append(apis, l.svcCtx.DB.API.Create().
 		SetServiceName("Core").
-		SetPath("/menu/delete").
-		SetDescription("Delete menu information | 删除菜单信息").
-		SetAPIGroup("menu").
+		SetPath("/ldap_provider/create").
+		SetDescription("Create LDAP provider information | 创建LDAP目录").
+		SetAPIGroup("ldapprovider").
 		SetMethod("POST").
 		SetIsRequired(false).
 		SetTenantID(1),
@@ -582,9 +623,9 @@ func (l *InitDatabaseLogic) insertApiData(ctx context.Context) error {
 
 	apis = append(apis, l.svcCtx.DB.API.Create().
 		SetServiceName("Core").
-		SetPath("/menu/detail").
-		SetDescription("Get menu detail | 获取菜单信息").
-		SetAPIGroup("menu").
+		SetPath("/ldap_provider/delete").
+		SetDescription("Delete LDAP provider information | 删除LDAP目录信息").
+		SetAPIGroup("ldapprovider").
 		SetMethod("POST").
 		SetIsRequired(false).
 		SetTenantID(1),
@@ -592,40 +633,39 @@ func (l *InitDatabaseLogic) insertApiData(ctx context.Context) error {
 
 	apis = append(apis, l.svcCtx.DB.API.Create().
 		SetServiceName("Core").
-		SetPath("/menu/list").
-		SetDescription("Get menu list | 获取菜单列表").
-		SetAPIGroup("menu").
-		SetMethod("GET").
+		SetPath("/ldap_provider/list").
+		SetDescription("Get LDAP provider list | 获取LDAP目录列表").
+		SetAPIGroup("ldapprovider").
+		SetMethod("POST").
 		SetIsRequired(false).
 		SetTenantID(1),
 	)
 
 	apis = append(apis, l.svcCtx.DB.API.Create().
 		SetServiceName("Core").
-		SetPath("/menu/role/list").
-		SetDescription("Get menu list by role | 获取菜单列表").
-		SetAPIGroup("menu").
-		SetMethod("GET").
+		SetPath("/ldap_provider/sync").
+		SetDescription("Sync the directory now, or list the changes in a dry run | 立即同步目录，或试运行列出变更").
+		SetAPIGroup("ldapprovider").
+		SetMethod("POST").
 		SetIsRequired(false).
 		SetTenantID(1),
 	)
 
 	apis = append(apis, l.svcCtx.DB.API.Create().
 		SetServiceName("Core").
-		SetPath("/menu/update").
-		SetDescription("Update menu information | 更新菜单").
-		SetAPIGroup("menu").
+		SetPath("/ldap_provider/sync_run").
+		SetDescription("Get sync run by ID | 通过ID获取同步记录").
+		SetAPIGroup("ldapprovider").
 		SetMethod("POST").
 		SetIsRequired(false).
 		SetTenantID(1),
 	)
 
-	// Oauthaccount
 	apis = append(apis, l.svcCtx.DB.API.Create().
 		SetServiceName("Core").
-		SetPath("/oauth/accounts").
-		SetDescription("Get user OAuth accounts | 获取用户OAuth账户").
-		SetAPIGroup("oauthaccount").
+		SetPath("/ldap_provider/sync_run/list").
+		SetDescription("Get sync run list | 获取同步记录列表").
+		SetAPIGroup("ldapprovider").
 		SetMethod("POST").
 		SetIsRequired(false).
 		SetTenantID(1),
@@ -633,30 +673,30 @@ func (l *InitDatabaseLogic) insertApiData(ctx context.Context) error {
 
 	apis = append(apis, l.svcCtx.DB.API.Create().
 		SetServiceName("Core").
-		SetPath("/oauth/bind").
-		SetDescription("Bind OAuth account | 绑定OAuth账户").
-		SetAPIGroup("oauthaccount").
+		SetPath("/ldap_provider/update").
+		SetDescription("Update LDAP provider information | 更新LDAP目录").
+		SetAPIGroup("ldapprovider").
 		SetMethod("POST").
 		SetIsRequired(false).
 		SetTenantID(1),
 	)
 
+	// ScimToken
 	apis = append(apis, l.svcCtx.DB.API.Create().
 		SetServiceName("Core").
-		SetPath("/oauth/unbind").
-		SetDescription("Unbind OAuth account | 解绑OAuth账户").
-		SetAPIGroup("oauthaccount").
+		SetPath("/scim_token").
+		SetDescription("Get SCIM token by ID | 通过ID获取SCIM令牌").
+		SetAPIGroup("scimtoken").
 		SetMethod("POST").
 		SetIsRequired(false).
 		SetTenantID(1),
 	)
 
-	// Oauthprovider
 	apis = append(apis, l.svcCtx.DB.API.Create().
 		SetServiceName("Core").
-		SetPath("/oauth/callback").
-		SetDescription("Enhanced OAuth callback with parameters | 增强的OAuth回调处理").
-		SetAPIGroup("oauthprovider").
+		SetPath("/scim_token/create").
+		SetDescription("Create SCIM token, the token is only returned once | 创建SCIM令牌，令牌只返回一次").
+		SetAPIGroup("scimtoken").
 		SetMethod("POST").
 		SetIsRequired(false).
 		SetTenantID(1),
@@ -664,39 +704,40 @@ func (l *InitDatabaseLogic) insertApiData(ctx context.Context) error {
 
 	apis = append(apis, l.svcCtx.DB.API.Create().
 		SetServiceName("Core").
-		SetPath("/oauth/login").
-		SetDescription("Oauth log in | Oauth 登录").
-		SetAPIGroup("oauthprovider").
+		SetPath("/scim_token/delete").
+		SetDescription("Delete SCIM token information | 删除SCIM令牌").
+		SetAPIGroup("scimtoken").
 		SetMethod("POST").
-		SetIsRequired(true).
+		SetIsRequired(false).
 		SetTenantID(1),
 	)
 
 	apis = append(apis, l.svcCtx.DB.API.Create().
 		SetServiceName("Core").
-		SetPath("/oauth/login/callback").
-		SetDescription("Oauth log in callback route | Oauth 登录返回调接口").
-		SetAPIGroup("oauthprovider").
-		SetMethod("GET").
+		SetPath("/scim_token/list").
+		SetDescription("Get SCIM token list | 获取SCIM令牌列表").
+		SetAPIGroup("scimtoken").
+		SetMethod("POST").
 		SetIsRequired(false).
 		SetTenantID(1),
 	)
 
 	apis = append(apis, l.svcCtx.DB.API.Create().
 		SetServiceName("Core").
-		SetPath("/oauth/providers").
-		SetDescription("Get available OAuth providers for users | 获取用户可用的OAuth提供商").
-		SetAPIGroup("oauthprovider").
+		SetPath("/scim_token/update").
+		SetDescription("Update SCIM token information | 更新SCIM令牌").
+		SetAPIGroup("scimtoken").
 		SetMethod("POST").
 		SetIsRequired(false).
 		SetTenantID(1),
 	)
 
+	// OauthClient
 	apis = append(apis, l.svcCtx.DB.API.Create().
 		SetServiceName("Core").
-		SetPath("/oauth/statistics").
-		SetDescription("Get OAuth statistics | 获取OAuth统计数据").
-		SetAPIGroup("oauthprovider").
+		SetPath("/oauth_client").
+		SetDescription("Get OAuth client by ID | 通过ID获取OAuth客户端").
+		SetAPIGroup("oauthclient").
 		SetMethod("POST").
 		SetIsRequired(false).
 		SetTenantID(1),
@@ -704,9 +745,9 @@ func (l *InitDatabaseLogic) insertApiData(ctx context.Context) error {
 
 	apis = append(apis, l.svcCtx.DB.API.Create().
 		SetServiceName("Core").
-		SetPath("/oauth_account").
-		SetDescription("Get oauth account by ID | 通过ID获取OAuth账户").
-		SetAPIGroup("oauthprovider").
+		SetPath("/oauth_client/create").
+		SetDescription("Create OAuth client | 创建OAuth客户端").
+		SetAPIGroup("oauthclient").
 		SetMethod("POST").
 		SetIsRequired(false).
 		SetTenantID(1),
@@ -714,9 +755,9 @@ func (l *InitDatabaseLogic) insertApiData(ctx context.Context) error {
 
 	apis = append(apis, l.svcCtx.DB.API.Create().
 		SetServiceName("Core").
-		SetPath("/oauth_account/create").
-		SetDescription("Create oauth account | 创建OAuth账户").
-		SetAPIGroup("oauthprovider").
+		SetPath("/oauth_client/delete").
+		SetDescription("Delete OAuth client | 删除OAuth客户端").
+		SetAPIGroup("oauthclient").
 		SetMethod("POST").
 		SetIsRequired(false).
 		SetTenantID(1),
@@ -724,9 +765,9 @@ func (l *InitDatabaseLogic) insertApiData(ctx context.Context) error {
 
 	apis = append(apis, l.svcCtx.DB.API.Create().
 		SetServiceName("Core").
-		SetPath("/oauth_account/delete").
-		SetDescription("Delete oauth account | 删除OAuth账户").
-		SetAPIGroup("oauthprovider").
+		SetPath("/oauth_client/list").
+		SetDescription("Get OAuth client list | 获取OAuth客户端列表").
+		SetAPIGroup("oauthclient").
 		SetMethod("POST").
 		SetIsRequired(false).
 		SetTenantID(1),
@@ -734,9 +775,9 @@ func (l *InitDatabaseLogic) insertApiData(ctx context.Context) error {
 
 	apis = append(apis, l.svcCtx.DB.API.Create().
 		SetServiceName("Core").
-		SetPath("/oauth_account/list").
-		SetDescription("Get oauth account list | 获取OAuth账户列表").
-		SetAPIGroup("oauthprovider").
+		SetPath("/oauth_client/reset_secret").
+		SetDescription("Reset OAuth client secret | 重置OAuth客户端密钥").
+		SetAPIGroup("oauthclient").
 		SetMethod("POST").
 		SetIsRequired(false).
 		SetTenantID(1),
@@ -744,9 +785,9 @@ func (l *InitDatabaseLogic) insertApiData(ctx context.Context) error {
 
 	apis = append(apis, l.svcCtx.DB.API.Create().
 		SetServiceName("Core").
-		SetPath("/oauth_account/update").
-		SetDescription("Update oauth account | 更新OAuth账户").
-		SetAPIGroup("oauthprovider").
+		SetPath("/oauth_client/update").
+		SetDescription("Update OAuth client | 更新OAuth客户端").
+		SetAPIGroup("oauthclient").
 		SetMethod("POST").
 		SetIsRequired(false).
 		SetTenantID(1),
@@ -754,9 +795,9 @@ func (l *InitDatabaseLogic) insertApiData(ctx context.Context) error {
 
 	apis = append(apis, l.svcCtx.DB.API.Create().
 		SetServiceName("Core").
-		SetPath("/oauth_provider").
-		SetDescription("Get oauth provider by ID | 通过ID获取第三方").
-		SetAPIGroup("oauthprovider").
+		SetPath("/oauth_consent/delete").
+		SetDescription("Delete OAuth consent | 删除OAuth授权记录").
+		SetAPIGroup("oauthclient").
 		SetMethod("POST").
 		SetIsRequired(false).
 		SetTenantID(1),
@@ -764,9 +805,9 @@ func (l *InitDatabaseLogic) insertApiData(ctx context.Context) error {
 
 	apis = append(apis, l.svcCtx.DB.API.Create().
 		SetServiceName("Core").
-		SetPath("/oauth_provider/create").
-		SetDescription("Create oauth provider information | 创建第三方").
-		SetAPIGroup("oauthprovider").
+		SetPath("/oauth_consent/list").
+		SetDescription("Get OAuth consent list | 获取OAuth授权记录列表").
+		SetAPIGroup("oauthclient").
 		SetMethod("POST").
 		SetIsRequired(false).
 		SetTenantID(1),
@@ -774,19 +815,20 @@ func (l *InitDatabaseLogic) insertApiData(ctx context.Context) error {
 
 	apis = append(apis, l.svcCtx.DB.API.Create().
 		SetServiceName("Core").
-		SetPath("/oauth_provider/delete").
-		SetDescription("Delete oauth provider information | 删除第三方信息").
-		SetAPIGroup("oauthprovider").
+		SetPath("/oauth2/consent").
+		SetDescription("Authorize the OAuth client for the current user | 为当前用户授权OAuth客户端").
+		SetAPIGroup("oauthclient").
 		SetMethod("POST").
-		SetIsRequired(false).
+		SetIsRequired(true).
 		SetTenantID(1),
 	)
 
+	// OauthScope
 	apis = append(apis, l.svcCtx.DB.API.Create().
 		SetServiceName("Core").
-		SetPath("/oauth_provider/list").
-		SetDescription("Get oauth provider list | 获取第三方列表").
-		SetAPIGroup("oauthprovider").
+		SetPath("/oauth_scope").
+		SetDescription("Get OAuth scope by ID | 通过ID获取OAuth作用域").
+		SetAPIGroup("oauthscope").
 		SetMethod("POST").
 		SetIsRequired(false).
 		SetTenantID(1),
@@ -794,9 +836,9 @@ func (l *InitDatabaseLogic) insertApiData(ctx context.Context) error {
 
 	apis = append(apis, l.svcCtx.DB.API.Create().
 		SetServiceName("Core").
-		SetPath("/oauth_provider/test").
-		SetDescription("Test oauth provider connection | 测试第三方提供商连接").
-		SetAPIGroup("oauthprovider").
+		SetPath("/oauth_scope/create").
+		SetDescription("Create OAuth scope | 创建OAuth作用域").
+		SetAPIGroup("oauthscope").
 		SetMethod("POST").
 		SetIsRequired(false).
 		SetTenantID(1),
@@ -804,20 +846,19 @@ func (l *InitDatabaseLogic) insertApiData(ctx context.Context) error {
 
 	apis = append(apis, l.svcCtx.DB.API.Create().
 		SetServiceName("Core").
-		SetPath("/oauth_provider/update").
-		SetDescription("Update oauth provider information | 更新第三方").
-		SetAPIGroup("oauthprovider").
+		SetPath("/oauth_scope/delete").
+		SetDescription("Delete OAuth scope | 删除OAuth作用域").
+		SetAPIGroup("oauthscope").
 		SetMethod("POST").
 		SetIsRequired(false).
 		SetTenantID(1),
 	)
 
-	// Position
 	apis = append(apis, l.svcCtx.DB.API.Create().
 		SetServiceName("Core").
-		SetPath("/position").
-		SetDescription("Get position by ID | 通过ID获取职位").
-		SetAPIGroup("position").
+		SetPath("/oauth_scope/list").
+		SetDescription("Get OAuth scope list | 获取OAuth作用域列表").
+		SetAPIGroup("oauthscope").
 		SetMethod("POST").
 		SetIsRequired(false).
 		SetTenantID(1),
@@ -825,19 +866,20 @@ func (l *InitDatabaseLogic) insertApiData(ctx context.Context) error {
 
 	apis = append(apis, l.svcCtx.DB.API.Create().
 		SetServiceName("Core").
-		SetPath("/position/create").
-		SetDescription("Create position information | 创建职位").
-		SetAPIGroup("position").
+		SetPath("/oauth_scope/update").
+		SetDescription("Update OAuth scope | 更新OAuth作用域").
+		SetAPIGroup("oauthscope").
 		SetMethod("POST").
 		SetIsRequired(false).
 		SetTenantID(1),
 	)
 
+	// Menu
 	apis = append(apis, l.svcCtx.DB.API.Create().
 		SetServiceName("Core").
-		SetPath("/position/delete").
-		SetDescription("Delete position information | 删除职位信息").
-		SetAPIGroup("position").
+		SetPath("/menu/create").
+		SetDescription("Create menu information | 创建菜单").
+		SetAPIGroup("menu").
 		SetMethod("POST").
 		SetIsRequired(false).
 		SetTenantID(1),
@@ -845,9 +887,9 @@ func (l *InitDatabaseLogic) insertApiData(ctx context.Context) error {
 
 	apis = append(apis, l.svcCtx.DB.API.Create().
 		SetServiceName("Core").
-		SetPath("/position/list").
-		SetDescription("Get position list | 获取职位列表").
-		SetAPIGroup("position").
+		SetPath("/menu/delete").
+		SetDescription("Delete menu information | 删除菜单信息").
+		SetAPIGroup("menu").
 		SetMethod("POST").
 		SetIsRequired(false).
 		SetTenantID(1),
@@ -855,51 +897,50 @@ func (l *InitDatabaseLogic) insertApiData(ctx context.Context) error {
 
 	apis = append(apis, l.svcCtx.DB.API.Create().
 		SetServiceName("Core").
-		SetPath("/position/update").
-		SetDescription("Update position information | 更新职位").
-		SetAPIGroup("position").
+		SetPath("/menu/detail").
+		SetDescription("Get menu detail | 获取菜单信息").
+		SetAPIGroup("menu").
 		SetMethod("POST").
 		SetIsRequired(false).
 		SetTenantID(1),
 	)
 
-	// Publicapi
 	apis = append(apis, l.svcCtx.DB.API.Create().
 		SetServiceName("Core").
-		SetPath("/configuration/system/list").
-		SetDescription("Get public system configuration list | 获取公开系统参数列表").
-		SetAPIGroup("publicapi").
+		SetPath("/menu/list").
+		SetDescription("Get menu list | 获取菜单列表").
+		SetAPIGroup("menu").
 		SetMethod("GET").
 		SetIsRequired(false).
 		SetTenantID(1),
 	)
 
-	// Publicuser
 	apis = append(apis, l.svcCtx.DB.API.Create().
 		SetServiceName("Core").
-		SetPath("/user/login").
-		SetDescription("Log in | 登录").
-		SetAPIGroup("publicuser").
-		SetMethod("POST").
-		SetIsRequired(true).
+		SetPath("/menu/role/list").
+		SetDescription("Get menu list by role | 获取菜单列表").
+		SetAPIGroup("menu").
+		SetMethod("GET").
+		SetIsRequired(false).
 		SetTenantID(1),
 	)
 
 	apis = append(apis, l.svcCtx.DB.API.Create().
 		SetServiceName("Core").
-		SetPath("/user/login_by_email").
-		SetDescription("Log in by email | 邮箱登录").
-		SetAPIGroup("publicuser").
+		SetPath("/menu/update").
+		SetDescription("Update menu information | 更新菜单").
+		SetAPIGroup("menu").
 		SetMethod("POST").
 		SetIsRequired(false).
 		SetTenantID(1),
 	)
 
+	// Oauthaccount
 	apis = append(apis, l.svcCtx.DB.API.Create().
 		SetServiceName("Core").
-		SetPath("/user/login_by_sms").
-		SetDescription("Log in by SMS | 短信登录").
-		SetAPIGroup("publicuser").
+		SetPath("/oauth/accounts").
+		SetDescription("Get user OAuth accounts | 获取用户OAuth账户").
+		SetAPIGroup("oauthaccount").
 		SetMethod("POST").
 		SetIsRequired(false).
 		SetTenantID(1),
@@ -907,29 +948,30 @@ func (l *InitDatabaseLogic) insertApiData(ctx context.Context) error {
 
 	apis = append(apis, l.svcCtx.DB.API.Create().
 		SetServiceName("Core").
-		SetPath("/user/logout").
-		SetDescription("Log out | 退出登陆 (无需认证)").
-		SetAPIGroup("publicuser").
-		SetMethod("GET").
-		SetIsRequired(true).
+		SetPath("/oauth/bind").
+		SetDescription("Bind OAuth account | 绑定OAuth账户").
+		SetAPIGroup("oauthaccount").
+		SetMethod("POST").
+		SetIsRequired(false).
 		SetTenantID(1),
 	)
 
 	apis = append(apis, l.svcCtx.DB.API.Create().
 		SetServiceName("Core").
-		SetPath("/user/register").
-		SetDescription("Register | 注册").
-		SetAPIGroup("publicuser").
+		SetPath("/oauth/unbind").
+		SetDescription("Unbind OAuth account | 解绑OAuth账户").
+		SetAPIGroup("oauthaccount").
 		SetMethod("POST").
-		SetIsRequired(true).
+		SetIsRequired(false).
 		SetTenantID(1),
 	)
 
+	// Oauthprovider
 	apis = append(apis, l.svcCtx.DB.API.Create().
 		SetServiceName("Core").
-		SetPath("/user/register_by_email").
-		SetDescription("Register by Email | 邮箱注册").
-		SetAPIGroup("publicuser").
+		SetPath("/oauth/callback").
+		SetDescription("Enhanced OAuth callback with parameters | 增强的OAuth回调处理").
+		SetAPIGroup("oauthprovider").
 		SetMethod("POST").
 		SetIsRequired(false).
 		SetTenantID(1),
@@ -937,40 +979,40 @@ func (l *InitDatabaseLogic) insertApiData(ctx context.Context) error {
 
 	apis = append(apis, l.svcCtx.DB.API.Create().
 		SetServiceName("Core").
-		SetPath("/user/register_by_sms").
-		SetDescription("Register by SMS | 短信注册").
-		SetAPIGroup("publicuser").
+		SetPath("/oauth/login").
+		SetDescription("Oauth log in | Oauth 登录").
+		SetAPIGroup("oauthprovider").
 		SetMethod("POST").
-		SetIsRequired(false).
+		SetIsRequired(true).
 		SetTenantID(1),
 	)
 
 	apis = append(apis, l.svcCtx.DB.API.Create().
 		SetServiceName("Core").
-		SetPath("/user/reset_password_by_email").
-		SetDescription("Reset password by Email | 通过邮箱重置密码").
-		SetAPIGroup("publicuser").
-		SetMethod("POST").
+		SetPath("/oauth/login/callback").
+		SetDescription("Oauth log in callback route | Oauth 登录返回调接口").
+		SetAPIGroup("oauthprovider").
+		SetMethod("GET").
 		SetIsRequired(false).
 		SetTenantID(1),
 	)
 
 	apis = append(apis, l.svcCtx.DB.API.Create().
 		SetServiceName("Core").
-		SetPath("/user/reset_password_by_sms").
-		SetDescription("Reset password by Sms | 通过短信重置密码").
-		SetAPIGroup("publicuser").
+		SetPath("/oauth/providers").
+		SetDescription("Get available OAuth providers for users | 获取用户可用的OAuth提供商").
+		SetAPIGroup("oauthprovider").
 		SetMethod("POST").
 		SetIsRequired(false).
 		SetTenantID(1),
 	)
 
-	// Role
+	// Account events pushed by the provider, the provider ID is passed in the id query parameter | 第三方平台账号事件推送，提供商ID通过 id 查询参数传递
 	apis = append(apis, l.svcCtx.DB.API.Create().
 		SetServiceName("Core").
-		SetPath("/role").
-		SetDescription("Get Role by ID | 通过ID获取角色").
-		SetAPIGroup("role").
+		SetPath("/oauth/webhook").
+		SetDescription("Account events pushed by the provider, the provider ID is passed in the id query parameter | 第三方平台账号事件推送，提供商ID通过 id 查询参数传递").
+		SetAPIGroup("oauthprovider").
 		SetMethod("POST").
 		SetIsRequired(false).
 		SetTenantID(1),
@@ -978,9 +1020,9 @@ func (l *InitDatabaseLogic) insertApiData(ctx context.Context) error {
 
 	apis = append(apis, l.svcCtx.DB.API.Create().
 		SetServiceName("Core").
-		SetPath("/role/cancelAuthUser").
-		SetDescription("Cancel User Role Auth | 取消用户角色授权").
-		SetAPIGroup("role").
+		SetPath("/oauth/statistics").
+		SetDescription("Get OAuth statistics | 获取OAuth统计数据").
+		SetAPIGroup("oauthprovider").
 		SetMethod("POST").
 		SetIsRequired(false).
 		SetTenantID(1),
@@ -988,9 +1030,9 @@ func (l *InitDatabaseLogic) insertApiData(ctx context.Context) error {
 
 	apis = append(apis, l.svcCtx.DB.API.Create().
 		SetServiceName("Core").
-		SetPath("/role/changeRoleStatus").
-		SetDescription("Change role Status | 更新角色状态").
-		SetAPIGroup("role").
+		SetPath("/oauth_account").
+		SetDescription("Get oauth account by ID | 通过ID获取OAuth账户").
+		SetAPIGroup("oauthprovider").
 		SetMethod("POST").
 		SetIsRequired(false).
 		SetTenantID(1),
@@ -998,9 +1040,9 @@ func (l *InitDatabaseLogic) insertApiData(ctx context.Context) error {
 
 	apis = append(apis, l.svcCtx.DB.API.Create().
 		SetServiceName("Core").
-		SetPath("/role/create").
-		SetDescription("Create role information | 创建角色").
-		SetAPIGroup("role").
+		SetPath("/oauth_account/create").
+		SetDescription("Create oauth account | 创建OAuth账户").
+		SetAPIGroup("oauthprovider").
 		SetMethod("POST").
 		SetIsRequired(false).
 		SetTenantID(1),
@@ -1008,7 +1050,494 @@ func (l *InitDatabaseLogic) insertApiData(ctx context.Context) error {
 
 	apis = append(apis, l.svcCtx.DB.API.Create().
 		SetServiceName("Core").
-		SetPath("/role/dataScope").
+		SetPath("/oauth_account/delete").
+		SetDescription("Delete oauth account | 删除OAuth账户").
+		SetAPIGroup("oauthprovider").
+		SetMethod("POST").
+		SetIsRequired(false).
+		SetTenantID(1),
+	)
+
+	apis = append(apis, l.svcCtx.DB.API.Create().
+		SetServiceName("Core").
+		SetPath("/oauth_account/list").
+		SetDescription("Get oauth account list | 获取OAuth账户列表").
+		SetAPIGroup("oauthprovider").
+		SetMethod("POST").
+		SetIsRequired(false).
+		SetTenantID(1),
+	)
+
+	apis = append(apis, l.svcCtx.DB.API.Create().
+		SetServiceName("Core").
+		SetPath("/oauth_account/update").
+		SetDescription("Update oauth account | 更新OAuth账户").
+		SetAPIGroup("oauthprovider").
+		SetMethod("POST").
+		SetIsRequired(false).
+		SetTenantID(1),
+	)
+
+	apis = append(apis, l.svcCtx.DB.API.Create().
+		SetServiceName("Core").
+		SetPath("/oauth_provider").
+		SetDescription("Get oauth provider by ID | 通过ID获取第三方").
+		SetAPIGroup("oauthprovider").
+		SetMethod("POST").
+		SetIsRequired(false).
+		SetTenantID(1),
+	)
+
+	apis = append(apis, l.svcCtx.DB.API.Create().
+		SetServiceName("Core").
+		SetPath("/oauth_provider/claim_mapping/preview").
+		SetDescription("Preview oauth claim mapping with a sample userinfo | 使用用户信息样例预览声明映射").
+		SetAPIGroup("oauthprovider").
+		SetMethod("POST").
+		SetIsRequired(false).
+		SetTenantID(1),
+	)
+
+	apis = append(apis, l.svcCtx.DB.API.Create().
+		SetServiceName("Core").
+		SetPath("/oauth_provider/create").
+		SetDescription("Create oauth provider information | 创建第三方").
+		SetAPIGroup("oauthprovider").
+		SetMethod("POST").
+		SetIsRequired(false).
+		SetTenantID(1),
+	)
+
+	apis = append(apis, l.svcCtx.DB.API.Create().
+		SetServiceName("Core").
+		SetPath("/oauth_provider/delete").
+		SetDescription("Delete oauth provider information | 删除第三方信息").
+		SetAPIGroup("oauthprovider").
+		SetMethod("POST").
+		SetIsRequired(false).
+		SetTenantID(1),
+	)
+
+	apis = append(apis, l.svcCtx.DB.API.Create().
+		SetServiceName("Core").
+		SetPath("/oauth_provider/list").
+		SetDescription("Get oauth provider list | 获取第三方列表").
+		SetAPIGroup("oauthprovider").
+		SetMethod("POST").
+		SetIsRequired(false).
+		SetTenantID(1),
+	)
+
+	apis = append(apis, l.svcCtx.DB.API.Create().
+		SetServiceName("Core").
+		SetPath("/oauth_provider/test").
+		SetDescription("Test oauth provider connection | 测试第三方提供商连接").
+		SetAPIGroup("oauthprovider").
+		SetMethod("POST").
+		SetIsRequired(false).
+		SetTenantID(1),
+	)
+
+	apis = append(apis, l.svcCtx.DB.API.Create().
+		SetServiceName("Core").
+		SetPath("/oauth_provider/update").
+		SetDescription("Update oauth provider information | 更新第三方").
+		SetAPIGroup("oauthprovider").
+		SetMethod("POST").
+		SetIsRequired(false).
+		SetTenantID(1),
+	)
+
+	// OauthProviderTemplate
+	apis = append(apis, l.svcCtx.DB.API.Create().
+		SetServiceName("Core").
+		SetPath("/oauth_provider_template").
+		SetDescription("Get oauth provider template by ID | 通过ID获取第三方登录模板").
+		SetAPIGroup("oauthprovidertemplate").
+		SetMethod("POST").
+		SetIsRequired(false).
+		SetTenantID(1),
+	)
+
+	apis = append(apis, l.svcCtx.DB.API.Create().
+		SetServiceName("Core").
+		SetPath("/oauth_provider_template/create").
+		SetDescription("Create oauth provider template | 创建第三方登录模板").
+		SetAPIGroup("oauthprovidertemplate").
+		SetMethod("POST").
+		SetIsRequired(false).
+		SetTenantID(1),
+	)
+
+	apis = append(apis, l.svcCtx.DB.API.Create().
+		SetServiceName("Core").
+		SetPath("/oauth_provider_template/delete").
+		SetDescription("Delete oauth provider template | 删除第三方登录模板").
+		SetAPIGroup("oauthprovidertemplate").
+		SetMethod("POST").
+		SetIsRequired(false).
+		SetTenantID(1),
+	)
+
+	apis = append(apis, l.svcCtx.DB.API.Create().
+		SetServiceName("Core").
+		SetPath("/oauth_provider_template/enable").
+		SetDescription("Enable oauth provider template for the tenant | 为租户启用第三方登录模板").
+		SetAPIGroup("oauthprovidertemplate").
+		SetMethod("POST").
+		SetIsRequired(false).
+		SetTenantID(1),
+	)
+
+	apis = append(apis, l.svcCtx.DB.API.Create().
+		SetServiceName("Core").
+		SetPath("/oauth_provider_template/list").
+		SetDescription("Get oauth provider template list | 获取第三方登录模板列表").
+		SetAPIGroup("oauthprovidertemplate").
+		SetMethod("POST").
+		SetIsRequired(false).
+		SetTenantID(1),
+	)
+
+	apis = append(apis, l.svcCtx.DB.API.Create().
+		SetServiceName("Core").
+		SetPath("/oauth_provider_template/update").
+		SetDescription("Update oauth provider template | 更新第三方登录模板").
+		SetAPIGroup("oauthprovidertemplate").
+		SetMethod("POST").
+		SetIsRequired(false).
+		SetTenantID(1),
+	)
+
+	// TenantInitTemplate
+	apis = append(apis, l.svcCtx.DB.API.Create().
+		SetServiceName("Core").
+		SetPath("/tenant_init_template").
+		SetDescription("Get tenant initialization template by ID | 通过ID获取租户初始化模板").
+		SetAPIGroup("tenantinittemplate").
+		SetMethod("POST").
+		SetIsRequired(false).
+		SetTenantID(1),
+	)
+
+	apis = append(apis, l.svcCtx.DB.API.Create().
+		SetServiceName("Core").
+		SetPath("/tenant_init_template/create").
+		SetDescription("Create tenant initialization template | 创建租户初始化模板").
+		SetAPIGroup("tenantinittemplate").
+		SetMethod("POST").
+		SetIsRequired(false).
+		SetTenantID(1),
+	)
+
+	apis = append(apis, l.svcCtx.DB.API.Create().
+		SetServiceName("Core").
+		SetPath("/tenant_init_template/delete").
+		SetDescription("Delete tenant initialization template | 删除租户初始化模板").
+		SetAPIGroup("tenantinittemplate").
+		SetMethod("POST").
+		SetIsRequired(false).
+		SetTenantID(1),
+	)
+
+	apis = append(apis, l.svcCtx.DB.API.Create().
+		SetServiceName("Core").
+		SetPath("/tenant_init_template/list").
+		SetDescription("Get tenant initialization template list | 获取租户初始化模板列表").
+		SetAPIGroup("tenantinittemplate").
+		SetMethod("POST").
+		SetIsRequired(false).
+		SetTenantID(1),
+	)
+
+	apis = append(apis, l.svcCtx.DB.API.Create().
+		SetServiceName("Core").
+		SetPath("/tenant_init_template/preview").
+		SetDescription("Preview the merged tenant initialization template | 预览合并后的租户初始化模板").
+		SetAPIGroup("tenantinittemplate").
+		SetMethod("POST").
+		SetIsRequired(false).
+		SetTenantID(1),
+	)
+
+	apis = append(apis, l.svcCtx.DB.API.Create().
+		SetServiceName("Core").
+		SetPath("/tenant_init_template/update").
+		SetDescription("Update tenant initialization template | 更新租户初始化模板").
+		SetAPIGroup("tenantinittemplate").
+		SetMethod("POST").
+		SetIsRequired(false).
+		SetTenantID(1),
+	)
+
+	// TenantInitPlugin
+	apis = append(apis, l.svcCtx.DB.API.Create().
+		SetServiceName("Core").
+		SetPath("/tenant_init_plugin/update").
+		SetDescription("Update tenant initialization plugin | 更新租户初始化远程插件").
+		SetAPIGroup("tenantinitplugin").
+		SetMethod("POST").
+		SetIsRequired(false).
+		SetTenantID(1),
+	)
+
+	apis = append(apis, l.svcCtx.DB.API.Create().
+		SetServiceName("Core").
+		SetPath("/tenant_init_plugin/delete").
+		SetDescription("Delete tenant initialization plugin | 删除租户初始化远程插件").
+		SetAPIGroup("tenantinitplugin").
+		SetMethod("POST").
+		SetIsRequired(false).
+		SetTenantID(1),
+	)
+
+	apis = append(apis, l.svcCtx.DB.API.Create().
+		SetServiceName("Core").
+		SetPath("/tenant_init_plugin/list").
+		SetDescription("Get tenant initialization plugin list | 获取租户初始化远程插件列表").
+		SetAPIGroup("tenantinitplugin").
+		SetMethod("POST").
+		SetIsRequired(false).
+		SetTenantID(1),
+	)
+
+	apis = append(apis, l.svcCtx.DB.API.Create().
+		SetServiceName("Core").
+		SetPath("/tenant_init_plugin").
+		SetDescription("Get tenant initialization plugin by ID | 通过ID获取租户初始化远程插件").
+		SetAPIGroup("tenantinitplugin").
+		SetMethod("POST").
+		SetIsRequired(false).
+		SetTenantID(1),
+	)
+
+	// Position
+	apis = append(apis, l.svcCtx.DB.API.Create().
+		SetServiceName("Core").
+		SetPath("/position").
+		SetDescription("Get position by ID | 通过ID获取职位").
+		SetAPIGroup("position").
+		SetMethod("POST").
+		SetIsRequired(false).
+		SetTenantID(1),
+	)
+
+	apis = append(apis, l.svcCtx.DB.API.Create().
+		SetServiceName("Core").
+		SetPath("/position/create").
+		SetDescription("Create position information | 创建职位").
+		SetAPIGroup("position").
+		SetMethod("POST").
+		SetIsRequired(false).
+		SetTenantID(1),
+	)
+
+	apis = append(apis, l.svcCtx.DB.API.Create().
+		SetServiceName("Core").
+		SetPath("/position/delete").
+		SetDescription("Delete position information | 删除职位信息").
+		SetAPIGroup("position").
+		SetMethod("POST").
+		SetIsRequired(false).
+		SetTenantID(1),
+	)
+
+	apis = append(apis, l.svcCtx.DB.API.Create().
+		SetServiceName("Core").
+		SetPath("/position/list").
+		SetDescription("Get position list | 获取职位列表").
+		SetAPIGroup("position").
+		SetMethod("POST").
+		SetIsRequired(false).
+		SetTenantID(1),
+	)
+
+	apis = append(apis, l.svcCtx.DB.API.Create().
+		SetServiceName("Core").
+		SetPath("/position/update").
+		SetDescription("Update position information | 更新职位").
+		SetAPIGroup("position").
+		SetMethod("POST").
+		SetIsRequired(false).
+		SetTenantID(1),
+	)
+
+	// Publicapi
+	apis = append(apis, l.svcCtx.DB.API.Create().
+		SetServiceName("Core").
+		SetPath("/configuration/system/list").
+		SetDescription("Get public system configuration list | 获取公开系统参数列表").
+		SetAPIGroup("publicapi").
+		SetMethod("GET").
+		SetIsRequired(false).
+		SetTenantID(1),
+	)
+
+	// Publicuser
+	apis = append(apis, l.svcCtx.DB.API.Create().
+		SetServiceName("Core").
+		SetPath("/user/login").
+		SetDescription("Log in | 登录").
+		SetAPIGroup("publicuser").
+		SetMethod("POST").
+		SetIsRequired(true).
+		SetTenantID(1),
+	)
+
+	apis = append(apis, l.svcCtx.DB.API.Create().
+		SetServiceName("Core").
+		SetPath("/user/login_by_email").
+		SetDescription("Log in by email | 邮箱登录").
+		SetAPIGroup("publicuser").
+		SetMethod("POST").
+		SetIsRequired(false).
+		SetTenantID(1),
+	)
+
+	apis = append(apis, l.svcCtx.DB.API.Create().
+		SetServiceName("Core").
+		SetPath("/user/login_by_sms").
+		SetDescription("Log in by SMS | 短信登录").
+		SetAPIGroup("publicuser").
+		SetMethod("POST").
+		SetIsRequired(false).
+		SetTenantID(1),
+	)
+
+	apis = append(apis, l.svcCtx.DB.API.Create().
+		SetServiceName("Core").
+		SetPath("/user/logout").
+		SetDescription("Log out | 退出登陆 (无需认证)").
+		SetAPIGroup("publicuser").
+		SetMethod("GET").
+		SetIsRequired(true).
+		SetTenantID(1),
+	)
+
+	apis = append(apis, l.svcCtx.DB.API.Create().
+		SetServiceName("Core").
+		SetPath("/user/register").
+		SetDescription("Register | 注册").
+		SetAPIGroup("publicuser").
+		SetMethod("POST").
+		SetIsRequired(true).
+		SetTenantID(1),
+	)
+
+	apis = append(apis, l.svcCtx.DB.API.Create().
+		SetServiceName("Core").
+		SetPath("/user/register_by_email").
+		SetDescription("Register by Email | 邮箱注册").
+		SetAPIGroup("publicuser").
+		SetMethod("POST").
+		SetIsRequired(false).
+		SetTenantID(1),
+	)
+
+	apis = append(apis, l.svcCtx.DB.API.Create().
+		SetServiceName("Core").
+		SetPath("/user/register_by_sms").
+		SetDescription("Register by SMS | 短信注册").
+		SetAPIGroup("publicuser").
+		SetMethod("POST").
+		SetIsRequired(false).
+		SetTenantID(1),
+	)
+
+	apis = append(apis, l.svcCtx.DB.API.Create().
+		SetServiceName("Core").
+		SetPath("/user/reset_password_by_email").
+		SetDescription("Reset password by Email | 通过邮箱重置密码").
+		SetAPIGroup("publicuser").
+		SetMethod("POST").
+		SetIsRequired(false).
+		SetTenantID(1),
+	)
+
+	apis = append(apis, l.svcCtx.DB.API.Create().
+		SetServiceName("Core").
+		SetPath("/user/reset_password_by_sms").
+		SetDescription("Reset password by Sms | 通过短信重置密码").
+		SetAPIGroup("publicuser").
+		SetMethod("POST").
+		SetIsRequired(false).
+		SetTenantID(1),
+	)
+
+	// Role
+	apis = append(apis, l.svcCtx.DB.API.Create().
+		SetServiceName("Core").
+		SetPath("/role").
+		SetDescription("Get Role by ID | 通过ID获取角色").
+		SetAPIGroup("role").
+		SetMethod("POST").
+		SetIsRequired(false).
+		SetTenantID(1),
+	)
+
+	apis = append(apis, l.svcCtx.DB.API.Create().
+		SetServiceName("Core").
+		SetPath("/role/cancelAuthUser").
+		SetDescription("Cancel User Role Auth | 取消用户角色授权").
+		SetAPIGroup("role").
+		SetMethod("POST").
+		SetIsRequired(false).
+		SetTenantID(1),
+	)
+
+	apis = append(apis, l.svcCtx.DB.API.Create().
+		SetServiceName("Core").
+		SetPath("/role/changeRoleStatus").
+		SetDescription("Change role Status | 更新角色状态").
+		SetAPIGroup("role").
+		SetMethod("POST").
+		SetIsRequired(false).
+		SetTenantID(1),
+	)
+
+	apis = append(apis, l.svcCtx.DB.API.Create().
+		SetServiceName("Core").
+		SetPath("/role/setParents").
+		SetDescription("Set role parents | 设置父角色").
+		SetAPIGroup("role").
+		SetMethod("POST").
+		SetIsRequired(false).
+		SetTenantID(1),
+	)
+
+	apis = append(apis, l.svcCtx.DB.API.Create().
+		SetServiceName("Core").
+		SetPath("/role/parents").
+		SetDescription("Get role parents | 获取父角色").
+		SetAPIGroup("role").
+		SetMethod("POST").
+		SetIsRequired(false).
+		SetTenantID(1),
+	)
+
+	apis = append(apis, l.svcCtx.DB.API.Create().
+		SetServiceName("Core").
+		SetPath("/role/effectivePermissions").
+		SetDescription("Get role effective permissions | 获取角色有效权限").
+		SetAPIGroup("role").
+		SetMethod("POST").
+		SetIsRequired(false).
+		SetTenantID(1),
+	)
+
+	apis = append(apis, l.svcCtx.DB.API.Create().
+		SetServiceName("Core").
+		SetPath("/role/create").
+		SetDescription("Create role information | 创建角色").
+		SetAPIGroup("role").
+		SetMethod("POST").
+		SetIsRequired(false).
+		SetTenantID(1),
+	)
+
+	apis = append(apis, l.svcCtx.DB.API.Create().
+		SetServiceName("Core").
+		SetPath("/role/dataScope").
 		SetDescription("Assign Role DataScope | 授权数据权限").
 		SetAPIGroup("role").
 		SetMethod("POST").
@@ -1056,6 +1585,97 @@ func (l *InitDatabaseLogic) insertApiData(ctx context.Context) error {
 		SetTenantID(1),
 	)
 
+	// Samlprovider
+	apis = append(apis, l.svcCtx.DB.API.Create().
+		SetServiceName("Core").
+		SetPath("/saml/acs").
+		SetDescription("SAML assertion consumer service | SAML 断言消费接口").
+		SetAPIGroup("samlprovider").
+		SetMethod("POST").
+		SetIsRequired(true).
+		SetTenantID(1),
+	)
+
+	apis = append(apis, l.svcCtx.DB.API.Create().
+		SetServiceName("Core").
+		SetPath("/saml/login").
+		SetDescription("SAML log in | SAML 登录").
+		SetAPIGroup("samlprovider").
+		SetMethod("POST").
+		SetIsRequired(true).
+		SetTenantID(1),
+	)
+
+	apis = append(apis, l.svcCtx.DB.API.Create().
+		SetServiceName("Core").
+		SetPath("/saml/metadata").
+		SetDescription("Get the SP metadata | 获取SP元数据").
+		SetAPIGroup("samlprovider").
+		SetMethod("GET").
+		SetIsRequired(true).
+		SetTenantID(1),
+	)
+
+	apis = append(apis, l.svcCtx.DB.API.Create().
+		SetServiceName("Core").
+		SetPath("/saml_provider").
+		SetDescription("Get SAML provider by ID | 通过ID获取SAML身份源").
+		SetAPIGroup("samlprovider").
+		SetMethod("POST").
+		SetIsRequired(false).
+		SetTenantID(1),
+	)
+
+	apis = append(apis, l.svcCtx.DB.API.Create().
+		SetServiceName("Core").
+		SetPath("/saml_provider/create").
+		SetDescription("Create SAML provider information | 创建SAML身份源").
+		SetAPIGroup("samlprovider").
+		SetMethod("POST").
+		SetIsRequired(false).
+		SetTenantID(1),
+	)
+
+	apis = append(apis, l.svcCtx.DB.API.Create().
+		SetServiceName("Core").
+		SetPath("/saml_provider/delete").
+		SetDescription("Delete SAML provider information | 删除SAML身份源信息").
+		SetAPIGroup("samlprovider").
+		SetMethod("POST").
+		SetIsRequired(false).
+		SetTenantID(1),
+	)
+
+	apis = append(apis, l.svcCtx.DB.API.Create().
+		SetServiceName("Core").
+		SetPath("/saml_provider/import_metadata").
+		SetDescription("Import the IdP metadata | 导入IdP元数据").
+		SetAPIGroup("samlprovider").
+		SetMethod("POST").
+		SetIsRequired(false).
+		SetTenantID(1),
+	)
+
+	apis = append(apis, l.svcCtx.DB.API.Create().
+		SetServiceName("Core").
+		SetPath("/saml_provider/list").
+		SetDescription("Get SAML provider list | 获取SAML身份源列表").
+		SetAPIGroup("samlprovider").
+		SetMethod("POST").
+		SetIsRequired(false).
+		SetTenantID(1),
+	)
+
+	apis = append(apis, l.svcCtx.DB.API.Create().
+		SetServiceName("Core").
+		SetPath("/saml_provider/update").
+		SetDescription("Update SAML provider information | 更新SAML身份源").
+		SetAPIGroup("samlprovider").
+		SetMethod("POST").
+		SetIsRequired(false).
+		SetTenantID(1),
+	)
+
 	// Tenant
 	apis = append(apis, l.svcCtx.DB.API.Create().
 		SetServiceName("Core").
@@ -1127,6 +1747,56 @@ func (l *InitDatabaseLogic) insertApiData(ctx context.Context) error {
 		SetTenantID(1),
 	)
 
+	apis = append(apis, l.svcCtx.DB.API.Create().
+		SetServiceName("Core").
+		SetPath("/tenant/init_job").
+		SetDescription("Get tenant initialization job by ID | 通过ID获取租户初始化任务").
+		SetAPIGroup("tenant").
+		SetMethod("POST").
+		SetIsRequired(false).
+		SetTenantID(1),
+	)
+
+	apis = append(apis, l.svcCtx.DB.API.Create().
+		SetServiceName("Core").
+		SetPath("/tenant/init_job/list").
+		SetDescription("Get tenant initialization job list | 获取租户初始化任务列表").
+		SetAPIGroup("tenant").
+		SetMethod("POST").
+		SetIsRequired(false).
+		SetTenantID(1),
+	)
+
+	apis = append(apis, l.svcCtx.DB.API.Create().
+		SetServiceName("Core").
+		SetPath("/tenant/init_job/retry").
+		SetDescription("Retry tenant initialization job | 重试租户初始化任务").
+		SetAPIGroup("tenant").
+		SetMethod("POST").
+		SetIsRequired(false).
+		SetTenantID(1),
+	)
+
+	apis = append(apis, l.svcCtx.DB.API.Create().
+		SetServiceName("Core").
+		SetPath("/tenant/doctor").
+		SetDescription("Diagnose tenant data integrity | 诊断租户数据完整性").
+		SetAPIGroup("tenant").
+		SetMethod("POST").
+		SetIsRequired(false).
+		SetTenantID(1),
+	)
+
+	apis = append(apis, l.svcCtx.DB.API.Create().
+		SetServiceName("Core").
+		SetPath("/tenant/repair").
+		SetDescription("Repair tenant data integrity findings | 修复租户数据完整性问题").
+		SetAPIGroup("tenant").
+		SetMethod("POST").
+		SetIsRequired(false).
+		SetTenantID(1),
+	)
+
 	apis = append(apis, l.svcCtx.DB.API.Create().
 		SetServiceName("Core").
 		SetPath("/tenant/list").
@@ -1157,6 +1827,26 @@ func (l *InitDatabaseLogic) insertApiData(ctx context.Context) error {
 		SetTenantID(1),
 	)
 
+	apis = append(apis, l.svcCtx.DB.API.Create().
+		SetServiceName("Core").
+		SetPath("/tenant/impersonation/review").
+		SetDescription("Review the impersonation request | 审批租户模拟访问申请").
+		SetAPIGroup("tenant").
+		SetMethod("POST").
+		SetIsRequired(false).
+		SetTenantID(1),
+	)
+
+	apis = append(apis, l.svcCtx.DB.API.Create().
+		SetServiceName("Core").
+		SetPath("/tenant/impersonation/list").
+		SetDescription("Get impersonation session list | 获取租户模拟访问会话列表").
+		SetAPIGroup("tenant").
+		SetMethod("POST").
+		SetIsRequired(false).
+		SetTenantID(1),
+	)
+
 	apis = append(apis, l.svcCtx.DB.API.Create().
 		SetServiceName("Core").
 		SetPath("/tenant/update").
@@ -1228,6 +1918,57 @@ func (l *InitDatabaseLogic) insertApiData(ctx context.Context) error {
 		SetTenantID(1),
 	)
 
+	// Session
+	apis = append(apis, l.svcCtx.DB.API.Create().
+		SetServiceName("Core").
+		SetPath("/session/list").
+		SetDescription("Get user's session list | 获取用户的会话列表").
+		SetAPIGroup("token").
+		SetMethod("POST").
+		SetIsRequired(false).
+		SetTenantID(1),
+	)
+
+	apis = append(apis, l.svcCtx.DB.API.Create().
+		SetServiceName("Core").
+		SetPath("/session/revoke").
+		SetDescription("Revoke user's sessions | 注销用户的会话").
+		SetAPIGroup("token").
+		SetMethod("POST").
+		SetIsRequired(false).
+		SetTenantID(1),
+	)
+
+	apis = append(apis, l.svcCtx.DB.API.Create().
+		SetServiceName("Core").
+		SetPath("/session/my/list").
+		SetDescription("Get my session list | 获取当前用户的会话列表").
+		SetAPIGroup("token").
+		SetMethod("POST").
+		SetIsRequired(true).
+		SetTenantID(1),
+	)
+
+	apis = append(apis, l.svcCtx.DB.API.Create().
+		SetServiceName("Core").
+		SetPath("/session/my/revoke").
+		SetDescription("Revoke my sessions by ID | 注销当前用户的指定会话").
+		SetAPIGroup("token").
+		SetMethod("POST").
+		SetIsRequired(true).
+		SetTenantID(1),
+	)
+
+	apis = append(apis, l.svcCtx.DB.API.Create().
+		SetServiceName("Core").
+		SetPath("/session/my/revoke_others").
+		SetDescription("Revoke all my other sessions | 注销当前用户的其他全部会话").
+		SetAPIGroup("token").
+		SetMethod("POST").
+		SetIsRequired(true).
+		SetTenantID(1),
+	)
+
 	// User
 	apis = append(apis, l.svcCtx.DB.API.Create().
 		SetServiceName("Core").
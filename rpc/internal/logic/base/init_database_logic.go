@@ -2,6 +2,7 @@ package base
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"time"
@@ -13,6 +14,8 @@ import (
 	"github.com/coder-lulu/newbee-core/rpc/ent/role"
 	"github.com/coder-lulu/newbee-core/rpc/ent/tenant"
 	"github.com/coder-lulu/newbee-core/rpc/ent/user"
+	"github.com/coder-lulu/newbee-core/rpc/internal/auditarchive"
+	"github.com/coder-lulu/newbee-core/rpc/internal/tenantinit"
 	"github.com/coder-lulu/newbee-core/rpc/internal/utils/redisfunc"
 
 	"entgo.io/ent/dialect/sql/schema"
@@ -67,13 +70,28 @@ func (l *InitDatabaseLogic) InitDatabase(_ *core.Empty) (*core.BaseResp, error)
 	defer lock.Release(l.ctx)
 
 	// initialize table structure
-	if err = l.svcCtx.DB.Schema.Create(systemCtx, schema.WithForeignKeys(false), schema.WithDropColumn(true),
-		schema.WithDropIndex(true)); err != nil {
+	migrateOpts := []schema.MigrateOption{schema.WithForeignKeys(false), schema.WithDropColumn(true),
+		schema.WithDropIndex(true)}
+	if l.svcCtx.Config.AuditLog.Partition.Enabled {
+		// 审计日志分区表的主键包含 created_at，不能被迁移还原
+		migrateOpts = append(migrateOpts, schema.WithDiffHook(auditarchive.SkipPartitionKeyDiff))
+	}
+
+	if err = l.svcCtx.DB.Schema.Create(systemCtx, migrateOpts...); err != nil {
 		logx.Errorw(logmsg.DatabaseError, logx.Field("detail", err.Error()))
 		_ = l.svcCtx.Redis.Set(l.ctx, "INIT:DATABASE:ERROR", err.Error(), 300*time.Second).Err()
 		return nil, errorx.NewInternalError(err.Error())
 	}
 
+	if l.svcCtx.Config.AuditLog.Partition.Enabled {
+		err = auditarchive.EnsurePartitions(systemCtx, l.svcCtx.DB, l.svcCtx.Config.DatabaseConf.Type, l.svcCtx.Config.AuditLog.Partition.Ahead)
+		if err != nil {
+			logx.Errorw(logmsg.DatabaseError, logx.Field("detail", err.Error()))
+			_ = l.svcCtx.Redis.Set(l.ctx, "INIT:DATABASE:ERROR", err.Error(), 300*time.Second).Err()
+			return nil, errorx.NewInternalError(err.Error())
+		}
+	}
+
 	// remove all data perm cache
 	err = redisfunc.RemoveAllKeyByPrefix(l.ctx, config.RedisDataPermissionPrefix, l.svcCtx.Redis)
 	if err != nil {
@@ -158,7 +176,12 @@ func (l *InitDatabaseLogic) InitDatabase(_ *core.Empty) (*core.BaseResp, error)
 	}
 
 	// 第九步: 其他数据
-	err = l.insertProviderData(systemCtx)
+	err = l.insertProviderTemplateData(systemCtx)
+	if err != nil {
+		return errHandler(err)
+	}
+
+	err = l.insertTenantInitTemplateData(systemCtx)
 	if err != nil {
 		return errHandler(err)
 	}
@@ -552,46 +575,65 @@ func (l *InitDatabaseLogic) insertCasbinPoliciesData(ctx context.Context) error
 	return nil
 }
 
-// insert initial provider data
-func (l *InitDatabaseLogic) insertProviderData(ctx context.Context) error {
-	var providers []*ent.OauthProviderCreate
+// insert initial provider templates, tenants enable them with their own credentials
+func (l *InitDatabaseLogic) insertProviderTemplateData(ctx context.Context) error {
+	var templates []*ent.OauthProviderTemplateCreate
 
-	providers = append(providers, l.svcCtx.DB.OauthProvider.Create().
+	templates = append(templates, l.svcCtx.DB.OauthProviderTemplate.Create().
 		SetName("google").
 		SetDisplayName("Google").
 		SetType("google").
-		SetClientID("your client id").
-		SetClientSecret("your client secret").
-		SetRedirectURL("http://localhost:3100/oauth/login/callback").
 		SetScopes("email openid").
 		SetAuthURL("https://accounts.google.com/o/oauth2/auth").
 		SetTokenURL("https://oauth2.googleapis.com/token").
 		SetAuthStyle(1).
-		SetInfoURL("https://www.googleapis.com/oauth2/v2/userinfo?access_token=TOKEN"),
+		SetInfoURL("https://www.googleapis.com/oauth2/v2/userinfo?access_token=TOKEN").
+		SetSort(1),
 	)
 
-	providers = append(providers, l.svcCtx.DB.OauthProvider.Create().
+	templates = append(templates, l.svcCtx.DB.OauthProviderTemplate.Create().
 		SetName("github").
 		SetDisplayName("GitHub").
 		SetType("github").
-		SetClientID("your client id").
-		SetClientSecret("your client secret").
-		SetRedirectURL("http://localhost:3100/oauth/login/callback").
-		SetScopes("email openid").
+		SetScopes("read:user user:email").
 		SetAuthURL("https://github.com/login/oauth/authorize").
 		SetTokenURL("https://github.com/login/oauth/access_token").
 		SetAuthStyle(2).
-		SetInfoURL("https://api.github.com/user"),
+		SetInfoURL("https://api.github.com/user").
+		SetSort(2),
 	)
 
-    tenantCtx := hooks.SetTenantIDToContext(context.Background(), 1)
-    err := l.svcCtx.DB.OauthProvider.CreateBulk(providers...).Exec(tenantCtx)
+	err := l.svcCtx.DB.OauthProviderTemplate.CreateBulk(templates...).Exec(ctx)
 	if err != nil {
 		logx.Errorw(err.Error())
 		return errorx.NewInternalError(err.Error())
-	} else {
-		return nil
 	}
+
+	return nil
+}
+
+// insert the base tenant initialization template, industry templates are created on top of it
+func (l *InitDatabaseLogic) insertTenantInitTemplateData(ctx context.Context) error {
+	template := tenantinit.GetDefaultInitTemplate()
+
+	components, err := json.Marshal(template.Components)
+	if err != nil {
+		return errorx.NewInternalError(err.Error())
+	}
+
+	err = l.svcCtx.DB.TenantInitTemplate.Create().
+		SetName(template.Name).
+		SetVersion(template.Version).
+		SetDescription(template.Description).
+		SetComponents(string(components)).
+		SetSort(1).
+		Exec(ctx)
+	if err != nil {
+		logx.Errorw(err.Error())
+		return errorx.NewInternalError(err.Error())
+	}
+
+	return nil
 }
 
 // insert initial department data
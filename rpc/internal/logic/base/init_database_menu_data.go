@@ -1208,19 +1208,19 @@ func (l *InitDatabaseLogic) insertMenuData(ctx context.Context) error {
 		SetTenantID(1),
 	)
 
-	// 删除审计日志权限 ID 72
+	// 校验审计日志权限 ID 72
 	menus = append(menus, l.svcCtx.DB.Menu.Create().
 		SetID(72).
 		SetMenuLevel(3).
 		SetMenuType(2).
 		SetParentID(69).
-		SetPath("/system/audit-log/delete").
-		SetName("删除审计日志").
+		SetPath("/system/audit-log/verify").
+		SetName("校验审计日志").
 		SetSort(2).
-		SetTitle("删除审计日志").
+		SetTitle("校验审计日志").
 		SetIcon("#").
 		SetHideMenu(true).
-		SetPermission("sys:audit-log:delete").
+		SetPermission("sys:audit-log:verify").
 		SetServiceName("Core").
 		SetTenantID(1),
 	)
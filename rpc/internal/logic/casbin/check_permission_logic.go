@@ -48,8 +48,8 @@ func (l *CheckPermissionLogic) CheckPermission(in *core.PermissionCheckReq) (*co
 	startTime := time.Now()
 	var fromCache bool
 
-	// 使用 Casbin 引擎进行权限检查（带缓存）
-	result, err := l.svcCtx.EnforcerManager.CheckPermissionWithRoles(l.ctx, in.Subject, in.Object, in.Action, in.ServiceName)
+	// 使用 Casbin 引擎进行权限检查（带缓存），条件规则使用请求上下文求值
+	result, err := l.svcCtx.EnforcerManager.CheckPermissionWithContext(l.ctx, in.Subject, in.Object, in.Action, in.ServiceName, in.Context)
 	if err != nil {
 		// 如果Casbin检查失败，降级到数据库查询
 		l.Logger.Errorf("Casbin permission check failed, fallback to DB: %v", err)
@@ -102,6 +102,7 @@ func (l *CheckPermissionLogic) checkPermissionInDB(in *core.PermissionCheckReq)
 			casbinrule.V1EQ(in.Object),            // 资源
 			casbinrule.V2EQ(in.Action),            // 操作
 			casbinrule.StatusEQ(1),                // 启用状态
+			casbinrule.Or(casbinrule.ConditionsIsNil(), casbinrule.ConditionsEQ("")), // 条件规则无法在降级查询中求值
 			casbinrule.Or(
 				casbinrule.EffectiveFromLTE(now),    // 生效时间
 				casbinrule.EffectiveFromIsNil(),
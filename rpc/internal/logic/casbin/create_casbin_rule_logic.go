@@ -8,7 +8,9 @@ import (
 
 	"github.com/coder-lulu/newbee-core/rpc/ent"
 	"github.com/coder-lulu/newbee-core/rpc/ent/casbinrule"
+	casbinMgr "github.com/coder-lulu/newbee-core/rpc/internal/casbin"
 	"github.com/coder-lulu/newbee-core/rpc/internal/svc"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/redisfunc"
 	"github.com/coder-lulu/newbee-core/rpc/types/core"
 
 	"github.com/zeromicro/go-zero/core/logx"
@@ -37,6 +39,9 @@ func (l *CreateCasbinRuleLogic) CreateCasbinRule(in *core.CasbinRuleInfo) (*core
 	if in.ServiceName == "" {
 		return nil, fmt.Errorf("service_name is required")
 	}
+	if err := casbinMgr.ValidateRuleCondition(in.Ptype, in.GetV4(), in.GetConditions()); err != nil {
+		return nil, err
+	}
 
 	// 构建创建器
 	create := l.svcCtx.DB.CasbinRule.Create().
@@ -62,6 +67,9 @@ func (l *CreateCasbinRuleLogic) CreateCasbinRule(in *core.CasbinRuleInfo) (*core
 	if in.V5 != nil {
 		create.SetV5(*in.V5)
 	}
+	if in.Conditions != nil {
+		create.SetConditions(*in.Conditions)
+	}
 
 	// 设置业务扩展字段
 	if in.RuleName != nil {
@@ -156,6 +164,12 @@ func (l *CreateCasbinRuleLogic) syncToCasbinEngine(rule *ent.CasbinRule) error {
 		return fmt.Errorf("EnforcerManager not initialized")
 	}
 
+	// 带条件的规则不加载到执行器，在权限检查时单独求值
+	if rule.Conditions != "" {
+		refreshConditionalRules(l.ctx, l.svcCtx, l.Logger, rule.TenantID)
+		return nil
+	}
+
 	// 🔥 根据 Ptype 类型添加到 Casbin 引擎
 	// 注意：EnforcerManager 内部会自动处理 domain，所以这里不需要传递 v1 (domain)
 	switch rule.Ptype {
@@ -191,3 +205,14 @@ func (l *CreateCasbinRuleLogic) syncToCasbinEngine(rule *ent.CasbinRule) error {
 
 	return nil
 }
+
+// refreshConditionalRules 条件规则变更后清除本地缓存，并通知 API 服务重新加载策略
+func refreshConditionalRules(ctx context.Context, svcCtx *svc.ServiceContext, logger logx.Logger, tenantID uint64) {
+	svcCtx.EnforcerManager.InvalidateConditions(tenantID)
+
+	if err := redisfunc.PublishCasbinReload(ctx, svcCtx.Redis, svcCtx.Config.RedisConf.Db, tenantID, "casbin_condition"); err != nil {
+		logger.Errorw("failed to publish casbin reload after conditional rule change",
+			logx.Field("tenant_id", tenantID),
+			logx.Field("error", err.Error()))
+	}
+}
@@ -81,6 +81,13 @@ func (l *DeleteCasbinRuleLogic) DeleteCasbinRule(in *core.IDsReq) (*core.BaseRes
 	l.Logger.Infof("Deleted %d casbin rules successfully. Rules: %v", 
 		deletedCount, deletedRules)
 
+	for _, rule := range rules {
+		if rule.Conditions != "" {
+			refreshConditionalRules(l.ctx, l.svcCtx, l.Logger, tenantID)
+			break
+		}
+	}
+
 	return &core.BaseResp{
 		Msg: fmt.Sprintf("成功删除 %d 条权限规则", deletedCount),
 	}, nil
@@ -113,6 +120,11 @@ func (l *DeleteCasbinRuleLogic) syncRemoveFromCasbinEngine(rules []*ent.CasbinRu
 // removeRuleFromCasbinEngine 从 Casbin 引擎移除单个规则
 // 🔥 适配 RBAC with Domains 模型
 func (l *DeleteCasbinRuleLogic) removeRuleFromCasbinEngine(rule *ent.CasbinRule) error {
+	// 带条件的规则不在执行器中，删除后刷新条件规则
+	if rule.Conditions != "" {
+		return nil
+	}
+
 	// 🔥 根据 Ptype 类型从 Casbin 引擎移除
 	switch rule.Ptype {
 	case "p":
@@ -204,6 +204,9 @@ func (l *GetCasbinRuleListLogic) convertToRuleInfo(rule *ent.CasbinRule) *core.C
 	if rule.V5 != "" {
 		ruleInfo.V5 = &rule.V5
 	}
+	if rule.Conditions != "" {
+		ruleInfo.Conditions = &rule.Conditions
+	}
 
 	// 设置业务扩展字段
 	if rule.RuleName != "" {
@@ -8,6 +8,7 @@ import (
 	"github.com/coder-lulu/newbee-common/v2/orm/ent/entctx/tenantctx"
 	"github.com/coder-lulu/newbee-core/rpc/ent"
 	"github.com/coder-lulu/newbee-core/rpc/ent/casbinrule"
+	casbinMgr "github.com/coder-lulu/newbee-core/rpc/internal/casbin"
 	"github.com/coder-lulu/newbee-core/rpc/internal/svc"
 	"github.com/coder-lulu/newbee-core/rpc/types/core"
 
@@ -176,6 +177,12 @@ func (l *GetUserPermissionSummaryLogic) getInheritedPermissions(userID string, s
 		return []*ent.CasbinRule{}, nil
 	}
 
+	// 展开角色继承，父角色的权限同样属于继承权限
+	roles, err = casbinMgr.EffectiveRoles(l.ctx, l.svcCtx.DB, tenantID, roles...)
+	if err != nil {
+		return nil, err
+	}
+
 	// 查询角色的权限（p 类型的规则） - 必须包含租户ID过滤
 	rolePermQuery := l.svcCtx.DB.CasbinRule.Query().
 		Where(
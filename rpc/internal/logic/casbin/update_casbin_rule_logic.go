@@ -9,6 +9,7 @@ import (
 	"github.com/coder-lulu/newbee-common/v2/orm/ent/entctx/tenantctx"
 	"github.com/coder-lulu/newbee-core/rpc/ent"
 	"github.com/coder-lulu/newbee-core/rpc/ent/casbinrule"
+	casbinMgr "github.com/coder-lulu/newbee-core/rpc/internal/casbin"
 	"github.com/coder-lulu/newbee-core/rpc/internal/svc"
 	"github.com/coder-lulu/newbee-core/rpc/types/core"
 
@@ -52,6 +53,21 @@ func (l *UpdateCasbinRuleLogic) UpdateCasbinRule(in *core.CasbinRuleInfo) (*core
 		return nil, fmt.Errorf("get casbin rule failed: %v", err)
 	}
 
+	// 校验更新后的规则条件
+	ptype, effect, conditions := rule.Ptype, rule.V4, rule.Conditions
+	if in.Ptype != "" {
+		ptype = in.Ptype
+	}
+	if in.V4 != nil {
+		effect = *in.V4
+	}
+	if in.Conditions != nil {
+		conditions = *in.Conditions
+	}
+	if err = casbinMgr.ValidateRuleCondition(ptype, effect, conditions); err != nil {
+		return nil, err
+	}
+
 	// 构建更新器
 	update := rule.Update()
 
@@ -77,6 +93,9 @@ func (l *UpdateCasbinRuleLogic) UpdateCasbinRule(in *core.CasbinRuleInfo) (*core
 	if in.V5 != nil {
 		update.SetV5(*in.V5)
 	}
+	if in.Conditions != nil {
+		update.SetConditions(*in.Conditions)
+	}
 
 	// 更新业务扩展字段
 	if in.ServiceName != "" {
@@ -171,6 +190,11 @@ func (l *UpdateCasbinRuleLogic) syncUpdateToCasbinEngine(oldRule, newRule *ent.C
 		return fmt.Errorf("EnforcerManager not initialized")
 	}
 
+	// 带条件的规则不在执行器中，只需刷新条件规则
+	if oldRule.Conditions != "" || newRule.Conditions != "" {
+		refreshConditionalRules(l.ctx, l.svcCtx, l.Logger, newRule.TenantID)
+	}
+
 	// 先移除旧规则
 	err := l.removeRuleFromCasbinEngine(oldRule)
 	if err != nil {
@@ -190,6 +214,10 @@ func (l *UpdateCasbinRuleLogic) syncUpdateToCasbinEngine(oldRule, newRule *ent.C
 // removeRuleFromCasbinEngine 从 Casbin 引擎移除规则
 // 🔥 适配 RBAC with Domains 模型
 func (l *UpdateCasbinRuleLogic) removeRuleFromCasbinEngine(rule *ent.CasbinRule) error {
+	if rule.Conditions != "" {
+		return nil
+	}
+
 	// 🔥 根据 Ptype 类型从 Casbin 引擎移除
 	switch rule.Ptype {
 	case "p":
@@ -219,6 +247,10 @@ func (l *UpdateCasbinRuleLogic) removeRuleFromCasbinEngine(rule *ent.CasbinRule)
 // addRuleToCasbinEngine 向 Casbin 引擎添加规则
 // 🔥 适配 RBAC with Domains 模型
 func (l *UpdateCasbinRuleLogic) addRuleToCasbinEngine(rule *ent.CasbinRule) error {
+	if rule.Conditions != "" {
+		return nil
+	}
+
 	// 🔥 根据 Ptype 类型添加到 Casbin 引擎
 	switch rule.Ptype {
 	case "p":
@@ -9,6 +9,7 @@ import (
 	"github.com/coder-lulu/newbee-common/v2/orm/ent/entctx/tenantctx"
 	"github.com/coder-lulu/newbee-core/rpc/ent"
 	"github.com/coder-lulu/newbee-core/rpc/ent/casbinrule"
+	casbinMgr "github.com/coder-lulu/newbee-core/rpc/internal/casbin"
 	"github.com/coder-lulu/newbee-core/rpc/internal/svc"
 	"github.com/coder-lulu/newbee-core/rpc/types/core"
 
@@ -51,25 +52,30 @@ func (l *ValidateCasbinRuleLogic) ValidateCasbinRule(in *core.ValidateCasbinRule
 		errors = append(errors, "service_name is required")
 	}
 
-	// Casbin标准字段验证
+	// Casbin标准字段验证，p 规则: v0=主体, v1=租户ID, v2=资源, v3=操作, v4=效果
 	if rule.V0 != nil && *rule.V0 == "" {
 		warnings = append(warnings, "v0 (subject) is empty")
 	}
 	if rule.V1 != nil && *rule.V1 == "" {
-		warnings = append(warnings, "v1 (object) is empty")
+		warnings = append(warnings, "v1 (domain) is empty")
 	}
 	if rule.V2 != nil && *rule.V2 == "" {
-		warnings = append(warnings, "v2 (action) is empty")
+		warnings = append(warnings, "v2 (object) is empty")
 	}
 
 	// 效果验证
-	if rule.V3 != nil && *rule.V3 != "" {
-		effect := strings.ToLower(*rule.V3)
+	if rule.Ptype == "p" && rule.V4 != nil && *rule.V4 != "" {
+		effect := strings.ToLower(*rule.V4)
 		if effect != "allow" && effect != "deny" {
-			errors = append(errors, fmt.Sprintf("invalid effect: %s, must be 'allow' or 'deny'", *rule.V3))
+			errors = append(errors, fmt.Sprintf("invalid effect: %s, must be 'allow' or 'deny'", *rule.V4))
 		}
 	}
 
+	// ABAC条件验证
+	if err := casbinMgr.ValidateRuleCondition(rule.Ptype, rule.GetV4(), rule.GetConditions()); err != nil {
+		errors = append(errors, err.Error())
+	}
+
 	// 时间验证
 	if rule.EffectiveFrom != nil && rule.EffectiveTo != nil {
 		from := time.Unix(*rule.EffectiveFrom, 0)
@@ -164,7 +170,7 @@ func (l *ValidateCasbinRuleLogic) checkRuleConflicts(rule *core.CasbinRuleInfo)
 		query = query.Where(casbinrule.IDNEQ(*rule.Id))
 	}
 
-	// 添加v0-v2的匹配条件
+	// 添加v0-v3的匹配条件
 	if rule.V0 != nil {
 		query = query.Where(casbinrule.V0EQ(*rule.V0))
 	}
@@ -174,6 +180,9 @@ func (l *ValidateCasbinRuleLogic) checkRuleConflicts(rule *core.CasbinRuleInfo)
 	if rule.V2 != nil {
 		query = query.Where(casbinrule.V2EQ(*rule.V2))
 	}
+	if rule.V3 != nil {
+		query = query.Where(casbinrule.V3EQ(*rule.V3))
+	}
 
 	existingRules, err := query.All(l.ctx)
 	if err != nil {
@@ -195,19 +204,15 @@ func (l *ValidateCasbinRuleLogic) isRuleConflict(newRule *core.CasbinRuleInfo, e
 	// 检查是否为完全相同的规则
 	if newRule.V0 != nil && *newRule.V0 == existingRule.V0 &&
 		newRule.V1 != nil && *newRule.V1 == existingRule.V1 &&
-		newRule.V2 != nil && *newRule.V2 == existingRule.V2 {
-
-		// 检查是否有相反的效果
-		if newRule.V3 != nil && existingRule.V3 != "" {
-			newEffect := strings.ToLower(*newRule.V3)
-			existingEffect := strings.ToLower(existingRule.V3)
-			if (newEffect == "allow" && existingEffect == "deny") ||
-				(newEffect == "deny" && existingEffect == "allow") {
-				return true // 有冲突
-			}
+		newRule.V2 != nil && *newRule.V2 == existingRule.V2 &&
+		newRule.GetV3() == existingRule.V3 {
+
+		// 条件不同的规则在不同的请求属性下生效，不视为冲突
+		if newRule.GetConditions() != existingRule.Conditions {
+			return false
 		}
 
-		// 检查是否为重复规则
+		// 相反的效果或重复的规则都视为冲突
 		return true
 	}
 
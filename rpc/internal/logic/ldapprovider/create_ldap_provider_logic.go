@@ -0,0 +1,87 @@
+package ldapprovider
+
+import (
+	"context"
+
+	"github.com/coder-lulu/newbee-common/v2/i18n"
+	"github.com/zeromicro/go-zero/core/errorx"
+
+	"github.com/coder-lulu/newbee-core/rpc/ent"
+	"github.com/coder-lulu/newbee-core/rpc/internal/ldap"
+	"github.com/coder-lulu/newbee-core/rpc/internal/svc"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/dberrorhandler"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/typeconv"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CreateLdapProviderLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewCreateLdapProviderLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CreateLdapProviderLogic {
+	return &CreateLdapProviderLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *CreateLdapProviderLogic) CreateLdapProvider(in *core.LdapProviderInfo) (*core.BaseIDResp, error) {
+	if err := validateProvider(l.Logger, in, &ent.LdapProvider{DirectoryType: ldap.TypeOpenLDAP, AuthMode: ldap.AuthModeSearch}); err != nil {
+		return nil, err
+	}
+
+	var encryptedPassword, encryptionKeyID *string
+	if in.BindPassword != nil && *in.BindPassword != "" {
+		encrypted, keyID, err := l.svcCtx.EncryptionService.EncryptProviderSecret(*in.BindPassword)
+		if err != nil {
+			l.Logger.Errorw("failed to encrypt LDAP bind password", logx.Field("detail", err.Error()))
+			return nil, errorx.NewInternalError(i18n.Failed)
+		}
+		encryptedPassword, encryptionKeyID = &encrypted, &keyID
+	}
+
+	result, err := l.svcCtx.DB.LdapProvider.Create().
+		SetNotNilName(in.Name).
+		SetNotNilDisplayName(in.DisplayName).
+		SetNotNilDirectoryType(in.DirectoryType).
+		SetNotNilURL(in.Url).
+		SetNotNilStartTLS(in.StartTls).
+		SetNotNilInsecureSkipVerify(in.InsecureSkipVerify).
+		SetNotNilBindDn(in.BindDn).
+		SetNotNilEncryptedBindPassword(encryptedPassword).
+		SetNotNilEncryptionKeyID(encryptionKeyID).
+		SetNotNilBaseDn(in.BaseDn).
+		SetNotNilAuthMode(in.AuthMode).
+		SetNotNilUserDnTemplate(in.UserDnTemplate).
+		SetNotNilUserFilter(in.UserFilter).
+		SetNotNilOuBaseDn(in.OuBaseDn).
+		SetNotNilOuFilter(in.OuFilter).
+		SetNotNilGroupBaseDn(in.GroupBaseDn).
+		SetNotNilGroupFilter(in.GroupFilter).
+		SetNotNilGroupMemberAttribute(in.GroupMemberAttribute).
+		SetNotNilAttributeMapping(typeconv.ConvertExtraConfig(in.AttributeMapping)).
+		SetNotNilLoginEnabled(in.LoginEnabled).
+		SetNotNilJitProvision(in.JitProvision).
+		SetNotNilLinkExisting(in.LinkExisting).
+		SetNotNilSyncEnabled(in.SyncEnabled).
+		SetNotNilSyncInterval(in.SyncInterval).
+		SetNotNilDeletionPolicy(in.DeletionPolicy).
+		SetNotNilRootDepartmentID(in.RootDepartmentId).
+		SetNotNilDefaultDepartmentID(in.DefaultDepartmentId).
+		SetDefaultRoleIds(in.DefaultRoleIds).
+		SetNotNilEnabled(in.Enabled).
+		SetNotNilSort(in.Sort).
+		SetNotNilRemark(in.Remark).
+		SetNillableStatus(typeconv.ConvertStatus(in.Status)).
+		Save(l.ctx)
+	if err != nil {
+		return nil, dberrorhandler.DefaultEntError(l.Logger, err, in.Name)
+	}
+
+	return &core.BaseIDResp{Id: result.ID, Msg: i18n.CreateSuccess}, nil
+}
@@ -0,0 +1,54 @@
+package ldapprovider
+
+import (
+	"context"
+
+	"github.com/coder-lulu/newbee-core/rpc/ent"
+	"github.com/coder-lulu/newbee-core/rpc/ent/ldapaccount"
+	"github.com/coder-lulu/newbee-core/rpc/ent/ldapdepartment"
+	"github.com/coder-lulu/newbee-core/rpc/ent/ldapprovider"
+	"github.com/coder-lulu/newbee-core/rpc/ent/ldapsyncrun"
+	"github.com/coder-lulu/newbee-core/rpc/internal/svc"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/dberrorhandler"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/entx"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/coder-lulu/newbee-common/v2/i18n"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type DeleteLdapProviderLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewDeleteLdapProviderLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DeleteLdapProviderLogic {
+	return &DeleteLdapProviderLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *DeleteLdapProviderLogic) DeleteLdapProvider(in *core.IDsReq) (*core.BaseResp, error) {
+	// 删除目录后绑定关系和同步记录随之删除，已同步的用户和部门保留
+	err := entx.WithTx(l.ctx, l.svcCtx.DB, func(tx *ent.Tx) error {
+		if _, err := tx.LdapAccount.Delete().Where(ldapaccount.ProviderIDIn(in.Ids...)).Exec(l.ctx); err != nil {
+			return err
+		}
+		if _, err := tx.LdapDepartment.Delete().Where(ldapdepartment.ProviderIDIn(in.Ids...)).Exec(l.ctx); err != nil {
+			return err
+		}
+		if _, err := tx.LdapSyncRun.Delete().Where(ldapsyncrun.ProviderIDIn(in.Ids...)).Exec(l.ctx); err != nil {
+			return err
+		}
+		_, err := tx.LdapProvider.Delete().Where(ldapprovider.IDIn(in.Ids...)).Exec(l.ctx)
+		return err
+	})
+	if err != nil {
+		return nil, dberrorhandler.DefaultEntError(l.Logger, err, in)
+	}
+
+	return &core.BaseResp{Msg: i18n.DeleteSuccess}, nil
+}
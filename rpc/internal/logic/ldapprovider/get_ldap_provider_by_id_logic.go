@@ -0,0 +1,34 @@
+package ldapprovider
+
+import (
+	"context"
+
+	"github.com/coder-lulu/newbee-core/rpc/internal/svc"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/dberrorhandler"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetLdapProviderByIdLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetLdapProviderByIdLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetLdapProviderByIdLogic {
+	return &GetLdapProviderByIdLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *GetLdapProviderByIdLogic) GetLdapProviderById(in *core.IDReq) (*core.LdapProviderInfo, error) {
+	result, err := l.svcCtx.DB.LdapProvider.Get(l.ctx, in.Id)
+	if err != nil {
+		return nil, dberrorhandler.DefaultEntError(l.Logger, err, in)
+	}
+
+	return convertProvider(result), nil
+}
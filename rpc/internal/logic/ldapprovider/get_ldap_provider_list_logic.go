@@ -0,0 +1,50 @@
+package ldapprovider
+
+import (
+	"context"
+
+	"github.com/coder-lulu/newbee-core/rpc/ent/ldapprovider"
+	"github.com/coder-lulu/newbee-core/rpc/ent/predicate"
+	"github.com/coder-lulu/newbee-core/rpc/internal/svc"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/dberrorhandler"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetLdapProviderListLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetLdapProviderListLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetLdapProviderListLogic {
+	return &GetLdapProviderListLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *GetLdapProviderListLogic) GetLdapProviderList(in *core.LdapProviderListReq) (*core.LdapProviderListResp, error) {
+	var predicates []predicate.LdapProvider
+	if in.Name != nil {
+		predicates = append(predicates, ldapprovider.NameContains(*in.Name))
+	}
+
+	result, err := l.svcCtx.DB.LdapProvider.Query().Where(predicates...).
+		Order(ldapprovider.BySort()).
+		Page(l.ctx, in.Page, in.PageSize)
+	if err != nil {
+		return nil, dberrorhandler.DefaultEntError(l.Logger, err, in)
+	}
+
+	resp := &core.LdapProviderListResp{}
+	resp.Total = result.PageDetails.Total
+
+	for _, v := range result.List {
+		resp.Data = append(resp.Data, convertProvider(v))
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,34 @@
+package ldapprovider
+
+import (
+	"context"
+
+	"github.com/coder-lulu/newbee-core/rpc/internal/svc"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/dberrorhandler"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetLdapSyncRunByIdLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetLdapSyncRunByIdLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetLdapSyncRunByIdLogic {
+	return &GetLdapSyncRunByIdLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *GetLdapSyncRunByIdLogic) GetLdapSyncRunById(in *core.IDReq) (*core.LdapSyncRunInfo, error) {
+	result, err := l.svcCtx.DB.LdapSyncRun.Get(l.ctx, in.Id)
+	if err != nil {
+		return nil, dberrorhandler.DefaultEntError(l.Logger, err, in)
+	}
+
+	return convertSyncRun(result, true), nil
+}
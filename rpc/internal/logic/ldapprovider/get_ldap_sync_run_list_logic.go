@@ -0,0 +1,52 @@
+package ldapprovider
+
+import (
+	"context"
+
+	"github.com/coder-lulu/newbee-core/rpc/ent"
+	"github.com/coder-lulu/newbee-core/rpc/ent/ldapsyncrun"
+	"github.com/coder-lulu/newbee-core/rpc/ent/predicate"
+	"github.com/coder-lulu/newbee-core/rpc/internal/svc"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/dberrorhandler"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetLdapSyncRunListLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetLdapSyncRunListLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetLdapSyncRunListLogic {
+	return &GetLdapSyncRunListLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *GetLdapSyncRunListLogic) GetLdapSyncRunList(in *core.LdapSyncRunListReq) (*core.LdapSyncRunListResp, error) {
+	predicates := []predicate.LdapSyncRun{ldapsyncrun.ProviderIDEQ(in.ProviderId)}
+	if in.Result != nil {
+		predicates = append(predicates, ldapsyncrun.ResultEQ(*in.Result))
+	}
+
+	result, err := l.svcCtx.DB.LdapSyncRun.Query().Where(predicates...).
+		Order(ent.Desc(ldapsyncrun.FieldStartedAt)).
+		Page(l.ctx, in.Page, in.PageSize)
+	if err != nil {
+		return nil, dberrorhandler.DefaultEntError(l.Logger, err, in)
+	}
+
+	resp := &core.LdapSyncRunListResp{}
+	resp.Total = result.PageDetails.Total
+
+	// 列表不返回变更明细，按 ID 查询获取
+	for _, v := range result.List {
+		resp.Data = append(resp.Data, convertSyncRun(v, false))
+	}
+
+	return resp, nil
+}
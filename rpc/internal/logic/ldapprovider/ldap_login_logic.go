@@ -0,0 +1,237 @@
+package ldapprovider
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/coder-lulu/newbee-common/v2/enum/common"
+	"github.com/coder-lulu/newbee-common/v2/utils/pointy"
+	"github.com/zeromicro/go-zero/core/errorx"
+	"google.golang.org/grpc/status"
+
+	"github.com/coder-lulu/newbee-core/rpc/ent"
+	"github.com/coder-lulu/newbee-core/rpc/ent/ldapaccount"
+	"github.com/coder-lulu/newbee-core/rpc/ent/ldapprovider"
+	"github.com/coder-lulu/newbee-core/rpc/ent/user"
+	"github.com/coder-lulu/newbee-core/rpc/internal/ldap"
+	"github.com/coder-lulu/newbee-core/rpc/internal/ldapsync"
+	user2 "github.com/coder-lulu/newbee-core/rpc/internal/logic/user"
+	"github.com/coder-lulu/newbee-core/rpc/internal/svc"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/dberrorhandler"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/entx"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type LdapLoginLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewLdapLoginLogic(ctx context.Context, svcCtx *svc.ServiceContext) *LdapLoginLogic {
+	return &LdapLoginLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// LdapLogin authenticates the user against the directories of the tenant in order and returns
+// the user linked to the first directory accepting the password
+func (l *LdapLoginLogic) LdapLogin(in *core.LdapLoginReq) (*core.UserInfo, error) {
+	providers, err := l.svcCtx.DB.LdapProvider.Query().
+		Where(ldapprovider.EnabledEQ(true), ldapprovider.LoginEnabledEQ(true), ldapprovider.StatusEQ(common.StatusNormal)).
+		Order(ldapprovider.BySort()).
+		All(l.ctx)
+	if err != nil {
+		return nil, dberrorhandler.DefaultEntError(l.Logger, err, in.Username)
+	}
+	if len(providers) == 0 {
+		return nil, errorx.NewInvalidArgumentError("ldap.notConfigured")
+	}
+
+	for _, p := range providers {
+		mapped, entry, err := l.authenticate(p, in.Username, in.Password)
+		if err != nil {
+			if !errors.Is(err, ldap.ErrInvalidCredentials) && !errors.Is(err, ldap.ErrUserNotFound) {
+				l.Logger.Errorw("failed to authenticate with LDAP directory", logx.Field("detail", err.Error()),
+					logx.Field("provider", p.ID), logx.Field("username", in.Username))
+			}
+			continue
+		}
+
+		if mapped.Disabled {
+			return nil, errorx.NewInvalidArgumentError("login.userBanned")
+		}
+
+		return l.login(p, in, entry, mapped)
+	}
+
+	return nil, errorx.NewInvalidArgumentError("login.wrongUsernameOrPassword")
+}
+
+// authenticate binds as the user and maps the entry and the groups of the user
+func (l *LdapLoginLogic) authenticate(p *ent.LdapProvider, username, password string) (*ldap.MappedUser, *ldap.Entry, error) {
+	conf, err := ldapsync.ProviderConfig(p, l.svcCtx.Config.Ldap, l.svcCtx.EncryptionService)
+	if err != nil {
+		return nil, nil, err
+	}
+	mapping, err := ldapsync.ProviderMapping(p)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	client, err := ldap.Dial(conf)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer client.Close()
+
+	entry, err := client.Authenticate(username, password)
+	if err != nil {
+		return nil, nil, err
+	}
+	groups, err := client.Groups(entry)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	mapped := mapping.Map(entry, groups)
+	if mapped.Info.Username == "" {
+		mapped.Info.Username = username
+	}
+
+	return mapped, entry, nil
+}
+
+func (l *LdapLoginLogic) login(p *ent.LdapProvider, in *core.LdapLoginReq, entry *ldap.Entry, mapped *ldap.MappedUser) (*core.UserInfo, error) {
+	var linked *ent.User
+	err := entx.WithTx(l.ctx, l.svcCtx.DB, func(tx *ent.Tx) error {
+		u, err := l.linkUser(tx, p, entry, mapped)
+		if err != nil {
+			return err
+		}
+		linked = u
+
+		return tx.LdapAccount.Update().
+			Where(ldapaccount.ProviderIDEQ(p.ID), ldapaccount.ExternalIDEQ(mapped.ExternalID)).
+			SetDn(entry.DN).
+			SetLastLoginAt(time.Now()).
+			SetNotNilLastLoginIP(in.Ip).
+			AddLoginCount(1).
+			Exec(l.ctx)
+	})
+	if err != nil {
+		// 业务错误原样返回，其余为数据库错误
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		return nil, dberrorhandler.DefaultEntError(l.Logger, err, in.Username)
+	}
+
+	result, err := l.svcCtx.DB.User.Query().Where(user.IDEQ(linked.ID)).WithRoles().Only(l.ctx)
+	if err != nil {
+		return nil, dberrorhandler.DefaultEntError(l.Logger, err, in.Username)
+	}
+	if result.Status != common.StatusNormal {
+		return nil, errorx.NewInvalidArgumentError("login.userBanned")
+	}
+
+	return &core.UserInfo{
+		Nickname:     &result.Nickname,
+		Avatar:       &result.Avatar,
+		RoleIds:      user2.GetRoleIds(result.Edges.Roles),
+		RoleCodes:    user2.GetRoleCodes(result.Edges.Roles),
+		Mobile:       &result.Mobile,
+		Email:        &result.Email,
+		Status:       pointy.GetPointer(uint32(result.Status)),
+		Id:           pointy.GetPointer(result.ID.String()),
+		Username:     &result.Username,
+		HomePath:     &result.HomePath,
+		Description:  &result.Description,
+		DepartmentId: &result.DepartmentID,
+		TenantId:     &result.TenantID,
+		CreatedAt:    pointy.GetPointer(result.CreatedAt.UnixMilli()),
+		UpdatedAt:    pointy.GetPointer(result.UpdatedAt.UnixMilli()),
+	}, nil
+}
+
+// linkUser finds the user bound to the entry. Unbound entries are linked to the user with the
+// same username when the provider allows it, or provisioned like the directory sync does.
+// Attributes and roles of linked users are updated by the sync, not on login.
+func (l *LdapLoginLogic) linkUser(tx *ent.Tx, p *ent.LdapProvider, entry *ldap.Entry, mapped *ldap.MappedUser) (*ent.User, error) {
+	account, err := tx.LdapAccount.Query().
+		Where(ldapaccount.ProviderIDEQ(p.ID), ldapaccount.ExternalIDEQ(mapped.ExternalID)).
+		WithUser().
+		Only(l.ctx)
+	if err == nil {
+		return account.Edges.User, nil
+	}
+	if !ent.IsNotFound(err) {
+		return nil, err
+	}
+
+	u, err := tx.User.Query().Where(user.UsernameEQ(mapped.Info.Username)).Only(l.ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, err
+	}
+
+	switch {
+	case u != nil && p.LinkExisting:
+		linked, err := tx.LdapAccount.Query().
+			Where(ldapaccount.UserIDEQ(u.ID), ldapaccount.ProviderIDEQ(p.ID)).
+			Exist(l.ctx)
+		if err != nil {
+			return nil, err
+		}
+		if linked {
+			l.Logger.Errorw("user is linked to another LDAP entry", logx.Field("dn", entry.DN), logx.Field("provider", p.ID))
+			return nil, errorx.NewInvalidArgumentError("login.userNotExist")
+		}
+	case u == nil && p.JitProvision:
+		if u, err = l.provisionUser(tx, p, entry, mapped); err != nil {
+			return nil, err
+		}
+	default:
+		l.Logger.Errorw("no user for the LDAP entry", logx.Field("dn", entry.DN), logx.Field("provider", p.ID))
+		return nil, errorx.NewInvalidArgumentError("login.userNotExist")
+	}
+
+	err = tx.LdapAccount.Create().
+		SetUserID(u.ID).
+		SetProviderID(p.ID).
+		SetExternalID(mapped.ExternalID).
+		SetDn(entry.DN).
+		SetTenantID(p.TenantID).
+		Exec(l.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return u, nil
+}
+
+// provisionUser creates the user on the first login in the department mirrored from the
+// organizational unit of the entry
+func (l *LdapLoginLogic) provisionUser(tx *ent.Tx, p *ent.LdapProvider, entry *ldap.Entry, mapped *ldap.MappedUser) (*ent.User, error) {
+	password, err := ldapsync.RandomPassword()
+	if err != nil {
+		return nil, err
+	}
+	departmentID, err := ldapsync.DepartmentOf(l.ctx, tx, p, entry.DN)
+	if err != nil {
+		return nil, err
+	}
+	roleIDs, err := ldapsync.RoleIDs(l.ctx, tx, mapped.RoleCodes)
+	if err != nil {
+		return nil, err
+	}
+	roleIDs = append(roleIDs, p.DefaultRoleIds...)
+	slices.Sort(roleIDs)
+
+	return ldapsync.CreateUser(l.ctx, tx, mapped, password, departmentID, slices.Compact(roleIDs))
+}
@@ -0,0 +1,171 @@
+package ldapprovider
+
+import (
+	"slices"
+
+	"github.com/coder-lulu/newbee-common/v2/utils/pointy"
+	"github.com/zeromicro/go-zero/core/errorx"
+	"github.com/zeromicro/go-zero/core/logx"
+
+	"github.com/coder-lulu/newbee-core/rpc/ent"
+	"github.com/coder-lulu/newbee-core/rpc/internal/ldap"
+	"github.com/coder-lulu/newbee-core/rpc/internal/ldapsync"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/typeconv"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+)
+
+// validateProvider checks the connection config and the attribute mapping resulting from
+// applying the request to the provider, which is the stored provider when updating
+func validateProvider(logger logx.Logger, in *core.LdapProviderInfo, p *ent.LdapProvider) error {
+	conf := &ldap.Config{
+		Type:                 valueOr(in.DirectoryType, p.DirectoryType),
+		URL:                  valueOr(in.Url, p.URL),
+		StartTLS:             valueOr(in.StartTls, p.StartTLS),
+		BindDN:               valueOr(in.BindDn, p.BindDn),
+		BaseDN:               valueOr(in.BaseDn, p.BaseDn),
+		AuthMode:             valueOr(in.AuthMode, p.AuthMode),
+		UserDNTemplate:       valueOr(in.UserDnTemplate, p.UserDnTemplate),
+		UserFilter:           valueOr(in.UserFilter, p.UserFilter),
+		OUBaseDN:             valueOr(in.OuBaseDn, p.OuBaseDn),
+		OUFilter:             valueOr(in.OuFilter, p.OuFilter),
+		GroupBaseDN:          valueOr(in.GroupBaseDn, p.GroupBaseDn),
+		GroupFilter:          valueOr(in.GroupFilter, p.GroupFilter),
+		GroupMemberAttribute: valueOr(in.GroupMemberAttribute, p.GroupMemberAttribute),
+	}
+	if err := conf.Validate(); err != nil {
+		logger.Errorw("invalid LDAP provider", logx.Field("detail", err.Error()))
+		return errorx.NewInvalidArgumentError("ldap.invalidConfig")
+	}
+
+	if in.DeletionPolicy != nil && !slices.Contains([]string{ldapsync.DeletionKeep, ldapsync.DeletionDisable, ldapsync.DeletionDelete}, *in.DeletionPolicy) {
+		return errorx.NewInvalidArgumentError("ldap.invalidConfig")
+	}
+	if in.SyncInterval != nil && *in.SyncInterval == 0 {
+		return errorx.NewInvalidArgumentError("ldap.invalidConfig")
+	}
+
+	mapping := p.AttributeMapping
+	if in.AttributeMapping != nil && *in.AttributeMapping != "" {
+		config := typeconv.ConvertExtraConfig(in.AttributeMapping)
+		if config == nil {
+			return errorx.NewInvalidArgumentError("ldap.invalidAttributeMapping")
+		}
+		mapping = *config
+	}
+	if _, err := ldap.ParseAttributeMapping(conf.Type, mapping); err != nil {
+		logger.Errorw("invalid LDAP attribute mapping", logx.Field("detail", err.Error()))
+		return errorx.NewInvalidArgumentError("ldap.invalidAttributeMapping")
+	}
+
+	return nil
+}
+
+// valueOr 请求未提供的字段使用已保存的值
+func valueOr[T any](v *T, def T) T {
+	if v == nil {
+		return def
+	}
+	return *v
+}
+
+// convertProvider never returns the bind password
+func convertProvider(p *ent.LdapProvider) *core.LdapProviderInfo {
+	info := &core.LdapProviderInfo{
+		Id:                   &p.ID,
+		CreatedAt:            pointy.GetPointer(p.CreatedAt.UnixMilli()),
+		UpdatedAt:            pointy.GetPointer(p.UpdatedAt.UnixMilli()),
+		Status:               typeconv.ConvertStatusFromEnt(p.Status),
+		TenantId:             &p.TenantID,
+		Name:                 &p.Name,
+		DisplayName:          &p.DisplayName,
+		DirectoryType:        &p.DirectoryType,
+		Url:                  &p.URL,
+		StartTls:             &p.StartTLS,
+		InsecureSkipVerify:   &p.InsecureSkipVerify,
+		BindDn:               &p.BindDn,
+		BaseDn:               &p.BaseDn,
+		AuthMode:             &p.AuthMode,
+		UserDnTemplate:       &p.UserDnTemplate,
+		UserFilter:           &p.UserFilter,
+		OuBaseDn:             &p.OuBaseDn,
+		OuFilter:             &p.OuFilter,
+		GroupBaseDn:          &p.GroupBaseDn,
+		GroupFilter:          &p.GroupFilter,
+		GroupMemberAttribute: &p.GroupMemberAttribute,
+		AttributeMapping:     typeconv.ConvertExtraConfigFromEnt(p.AttributeMapping),
+		LoginEnabled:         &p.LoginEnabled,
+		JitProvision:         &p.JitProvision,
+		LinkExisting:         &p.LinkExisting,
+		SyncEnabled:          &p.SyncEnabled,
+		SyncInterval:         &p.SyncInterval,
+		DeletionPolicy:       &p.DeletionPolicy,
+		RootDepartmentId:     &p.RootDepartmentID,
+		DefaultDepartmentId:  &p.DefaultDepartmentID,
+		DefaultRoleIds:       p.DefaultRoleIds,
+		Enabled:              &p.Enabled,
+		Sort:                 &p.Sort,
+		Remark:               &p.Remark,
+	}
+	if p.LastSyncAt != nil {
+		info.LastSyncAt = pointy.GetPointer(p.LastSyncAt.UnixMilli())
+	}
+
+	return info
+}
+
+// convertSyncRun omits the changes and conflicts unless withDetails is set
+func convertSyncRun(r *ent.LdapSyncRun, withDetails bool) *core.LdapSyncRunInfo {
+	info := &core.LdapSyncRunInfo{
+		Id:         &r.ID,
+		CreatedAt:  pointy.GetPointer(r.CreatedAt.UnixMilli()),
+		ProviderId: &r.ProviderID,
+		Trigger:    &r.Trigger,
+		DryRun:     &r.DryRun,
+		Result:     &r.Result,
+		Error:      &r.Error,
+		StartedAt:  pointy.GetPointer(r.StartedAt.UnixMilli()),
+		FinishedAt: pointy.GetPointer(r.FinishedAt.UnixMilli()),
+		Stats: &core.LdapSyncStats{
+			Departments: convertSyncCounter(r.Stats.Departments),
+			Users:       convertSyncCounter(r.Stats.Users),
+			Granted:     uint32(r.Stats.Granted),
+			Revoked:     uint32(r.Stats.Revoked),
+			Conflicts:   uint32(r.Stats.Conflicts),
+		},
+		Truncated: &r.Truncated,
+	}
+	if !withDetails {
+		return info
+	}
+
+	for _, v := range r.Changes {
+		info.Changes = append(info.Changes, &core.LdapSyncChange{
+			Kind:   v.Kind,
+			Action: v.Action,
+			Dn:     v.DN,
+			Name:   v.Name,
+			Detail: v.Detail,
+		})
+	}
+	for _, v := range r.Conflicts {
+		info.Conflicts = append(info.Conflicts, &core.LdapSyncConflict{
+			Kind:   v.Kind,
+			Dn:     v.DN,
+			Name:   v.Name,
+			Reason: v.Reason,
+			Detail: v.Detail,
+		})
+	}
+
+	return info
+}
+
+func convertSyncCounter(c ldap.SyncCounter) *core.LdapSyncCounter {
+	return &core.LdapSyncCounter{
+		Created:   uint32(c.Created),
+		Updated:   uint32(c.Updated),
+		Disabled:  uint32(c.Disabled),
+		Deleted:   uint32(c.Deleted),
+		Unchanged: uint32(c.Unchanged),
+	}
+}
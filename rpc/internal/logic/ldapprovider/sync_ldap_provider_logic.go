@@ -0,0 +1,49 @@
+package ldapprovider
+
+import (
+	"context"
+	"errors"
+
+	"github.com/zeromicro/go-zero/core/errorx"
+
+	"github.com/coder-lulu/newbee-core/rpc/internal/ldapsync"
+	"github.com/coder-lulu/newbee-core/rpc/internal/svc"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/dberrorhandler"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type SyncLdapProviderLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewSyncLdapProviderLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SyncLdapProviderLogic {
+	return &SyncLdapProviderLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// SyncLdapProvider syncs the directory now. A dry run returns the changes which would be made
+// without applying them. Failed runs are recorded and returned with the error.
+func (l *SyncLdapProviderLogic) SyncLdapProvider(in *core.LdapSyncReq) (*core.LdapSyncRunInfo, error) {
+	p, err := l.svcCtx.DB.LdapProvider.Get(l.ctx, in.Id)
+	if err != nil {
+		return nil, dberrorhandler.DefaultEntError(l.Logger, err, in)
+	}
+
+	run, err := l.svcCtx.LdapSync.Run(l.ctx, p, ldapsync.TriggerManual, in.DryRun)
+	if run == nil {
+		if errors.Is(err, ldapsync.ErrSyncRunning) {
+			return nil, errorx.NewInvalidArgumentError("ldap.syncRunning")
+		}
+		l.Logger.Errorw("failed to sync LDAP directory", logx.Field("detail", err.Error()), logx.Field("provider", p.ID))
+		return nil, errorx.NewInternalError("ldap.syncFailed")
+	}
+
+	return convertSyncRun(run, true), nil
+}
@@ -0,0 +1,89 @@
+package ldapprovider
+
+import (
+	"context"
+
+	"github.com/coder-lulu/newbee-common/v2/i18n"
+	"github.com/zeromicro/go-zero/core/errorx"
+
+	"github.com/coder-lulu/newbee-core/rpc/internal/svc"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/dberrorhandler"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/typeconv"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type UpdateLdapProviderLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewUpdateLdapProviderLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UpdateLdapProviderLogic {
+	return &UpdateLdapProviderLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *UpdateLdapProviderLogic) UpdateLdapProvider(in *core.LdapProviderInfo) (*core.BaseResp, error) {
+	p, err := l.svcCtx.DB.LdapProvider.Get(l.ctx, *in.Id)
+	if err != nil {
+		return nil, dberrorhandler.DefaultEntError(l.Logger, err, in.Id)
+	}
+	if err := validateProvider(l.Logger, in, p); err != nil {
+		return nil, err
+	}
+
+	query := l.svcCtx.DB.LdapProvider.UpdateOneID(*in.Id).
+		SetNotNilName(in.Name).
+		SetNotNilDisplayName(in.DisplayName).
+		SetNotNilDirectoryType(in.DirectoryType).
+		SetNotNilURL(in.Url).
+		SetNotNilStartTLS(in.StartTls).
+		SetNotNilInsecureSkipVerify(in.InsecureSkipVerify).
+		SetNotNilBindDn(in.BindDn).
+		SetNotNilBaseDn(in.BaseDn).
+		SetNotNilAuthMode(in.AuthMode).
+		SetNotNilUserDnTemplate(in.UserDnTemplate).
+		SetNotNilUserFilter(in.UserFilter).
+		SetNotNilOuBaseDn(in.OuBaseDn).
+		SetNotNilOuFilter(in.OuFilter).
+		SetNotNilGroupBaseDn(in.GroupBaseDn).
+		SetNotNilGroupFilter(in.GroupFilter).
+		SetNotNilGroupMemberAttribute(in.GroupMemberAttribute).
+		SetNotNilAttributeMapping(typeconv.ConvertExtraConfig(in.AttributeMapping)).
+		SetNotNilLoginEnabled(in.LoginEnabled).
+		SetNotNilJitProvision(in.JitProvision).
+		SetNotNilLinkExisting(in.LinkExisting).
+		SetNotNilSyncEnabled(in.SyncEnabled).
+		SetNotNilSyncInterval(in.SyncInterval).
+		SetNotNilDeletionPolicy(in.DeletionPolicy).
+		SetNotNilRootDepartmentID(in.RootDepartmentId).
+		SetNotNilDefaultDepartmentID(in.DefaultDepartmentId).
+		SetNotNilEnabled(in.Enabled).
+		SetNotNilSort(in.Sort).
+		SetNotNilRemark(in.Remark).
+		SetNillableStatus(typeconv.ConvertStatus(in.Status))
+	if in.DefaultRoleIds != nil {
+		query = query.SetDefaultRoleIds(in.DefaultRoleIds)
+	}
+
+	// 密码为空时保留原密码
+	if in.BindPassword != nil && *in.BindPassword != "" {
+		encrypted, keyID, err := l.svcCtx.EncryptionService.EncryptProviderSecret(*in.BindPassword)
+		if err != nil {
+			l.Logger.Errorw("failed to encrypt LDAP bind password", logx.Field("detail", err.Error()))
+			return nil, errorx.NewInternalError(i18n.Failed)
+		}
+		query = query.SetEncryptedBindPassword(encrypted).SetEncryptionKeyID(keyID)
+	}
+
+	if err := query.Exec(l.ctx); err != nil {
+		return nil, dberrorhandler.DefaultEntError(l.Logger, err, in.Id)
+	}
+
+	return &core.BaseResp{Msg: i18n.UpdateSuccess}, nil
+}
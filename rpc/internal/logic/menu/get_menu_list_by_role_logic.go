@@ -4,12 +4,14 @@ import (
 	"context"
 	"strings"
 
+	"github.com/coder-lulu/newbee-common/v2/orm/ent/entctx/tenantctx"
 	"github.com/coder-lulu/newbee-common/v2/utils/pointy"
 
 	"github.com/coder-lulu/newbee-core/rpc/ent"
 	"github.com/coder-lulu/newbee-core/rpc/ent/menu"
 	"github.com/coder-lulu/newbee-core/rpc/ent/role"
 
+	"github.com/coder-lulu/newbee-core/rpc/internal/casbin"
 	"github.com/coder-lulu/newbee-core/rpc/internal/svc"
 	"github.com/coder-lulu/newbee-core/rpc/internal/utils/dberrorhandler"
 	"github.com/coder-lulu/newbee-core/rpc/types/core"
@@ -32,7 +34,13 @@ func NewGetMenuListByRoleLogic(ctx context.Context, svcCtx *svc.ServiceContext)
 }
 
 func (l *GetMenuListByRoleLogic) GetMenuListByRole(in *core.BaseMsg) (*core.MenuInfoList, error) {
-	roles, err := l.svcCtx.DB.Role.Query().Where(role.CodeIn(strings.Split(in.Msg, ",")...), role.StatusEQ(1)).WithMenus(func(query *ent.MenuQuery) {
+	// 角色继承父角色的菜单
+	codes, err := casbin.EffectiveRoles(l.ctx, l.svcCtx.DB, tenantctx.GetTenantIDFromCtx(l.ctx), strings.Split(in.Msg, ",")...)
+	if err != nil {
+		return nil, dberrorhandler.DefaultEntError(l.Logger, err, in)
+	}
+
+	roles, err := l.svcCtx.DB.Role.Query().Where(role.CodeIn(codes...), role.StatusEQ(1)).WithMenus(func(query *ent.MenuQuery) {
 		query.Order(ent.Asc(menu.FieldSort))
 		query.Where(menu.Disabled(false))
 	}).All(l.ctx)
@@ -3,7 +3,11 @@ package oauthaccount
 import (
 	"context"
 
+	"github.com/coder-lulu/newbee-common/v2/i18n"
+
+	"github.com/coder-lulu/newbee-core/rpc/ent/oauthaccount"
 	"github.com/coder-lulu/newbee-core/rpc/internal/svc"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/dberrorhandler"
 	"github.com/coder-lulu/newbee-core/rpc/types/core"
 
 	"github.com/zeromicro/go-zero/core/logx"
@@ -24,7 +28,17 @@ func NewDeleteOauthAccountLogic(ctx context.Context, svcCtx *svc.ServiceContext)
 }
 
 func (l *DeleteOauthAccountLogic) DeleteOauthAccount(in *core.IDsReq) (*core.BaseResp, error) {
-	// todo: add your logic here and delete this line
+	accounts, err := l.svcCtx.DB.OauthAccount.Query().
+		Where(oauthaccount.IDIn(in.Ids...)).
+		WithProvider().
+		All(l.ctx)
+	if err != nil {
+		return nil, dberrorhandler.DefaultEntError(l.Logger, err, in)
+	}
+
+	if err = deleteAccounts(l.ctx, l.svcCtx, l.Logger, accounts); err != nil {
+		return nil, dberrorhandler.DefaultEntError(l.Logger, err, in)
+	}
 
-	return &core.BaseResp{}, nil
+	return &core.BaseResp{Msg: i18n.DeleteSuccess}, nil
 }
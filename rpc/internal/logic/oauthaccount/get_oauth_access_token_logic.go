@@ -0,0 +1,66 @@
+package oauthaccount
+
+import (
+	"context"
+	"errors"
+
+	"github.com/coder-lulu/newbee-common/v2/i18n"
+	"github.com/zeromicro/go-zero/core/errorx"
+	"github.com/zeromicro/go-zero/core/logx"
+	"golang.org/x/oauth2"
+
+	"github.com/coder-lulu/newbee-core/rpc/ent"
+	"github.com/coder-lulu/newbee-core/rpc/internal/oauth"
+	"github.com/coder-lulu/newbee-core/rpc/internal/svc"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+)
+
+type GetOauthAccessTokenLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetOauthAccessTokenLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetOauthAccessTokenLogic {
+	return &GetOauthAccessTokenLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// GetOauthAccessToken returns a valid provider access token of the linked account, so that other services can
+// call the provider API on behalf of the user. The token is refreshed first if it is about to expire.
+func (l *GetOauthAccessTokenLogic) GetOauthAccessToken(in *core.OauthAccessTokenReq) (*core.OauthAccessTokenResp, error) {
+	var token *oauth2.Token
+	var err error
+	if in.ForceRefresh != nil && *in.ForceRefresh {
+		token, err = l.svcCtx.OauthTokens.RefreshToken(l.ctx, in.UserId, in.Provider)
+	} else {
+		token, err = l.svcCtx.OauthTokens.GetToken(l.ctx, in.UserId, in.Provider)
+	}
+	if err != nil {
+		switch {
+		case ent.IsNotFound(err):
+			return nil, errorx.NewInvalidArgumentError("oauth.accountNotBound")
+		case errors.Is(err, oauth.ErrTokenExpired):
+			return nil, errorx.NewInvalidArgumentError("oauth.tokenExpired")
+		case errors.Is(err, oauth.ErrInvalidUserID):
+			return nil, errorx.NewInvalidArgumentError(i18n.Failed)
+		}
+
+		l.Logger.Errorw("failed to get the provider access token", logx.Field("detail", err.Error()),
+			logx.Field("userId", in.UserId), logx.Field("provider", in.Provider))
+		return nil, errorx.NewInternalError("oauth.tokenRefreshFailed")
+	}
+
+	resp := &core.OauthAccessTokenResp{
+		AccessToken: token.AccessToken,
+		TokenType:   token.Type(),
+	}
+	if !token.Expiry.IsZero() {
+		resp.ExpiresAt = token.Expiry.UnixMilli()
+	}
+
+	return resp, nil
+}
@@ -4,9 +4,13 @@ import (
 	"context"
 
 	"github.com/coder-lulu/newbee-common/v2/i18n"
+	"github.com/coder-lulu/newbee-common/v2/utils/uuidx"
 	"github.com/zeromicro/go-zero/core/logx"
 
+	"github.com/coder-lulu/newbee-core/rpc/ent"
+	"github.com/coder-lulu/newbee-core/rpc/ent/oauthaccount"
 	"github.com/coder-lulu/newbee-core/rpc/internal/svc"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/dberrorhandler"
 	"github.com/coder-lulu/newbee-core/rpc/types/core"
 )
 
@@ -25,8 +29,39 @@ func NewUnbindOauthAccountLogic(ctx context.Context, svcCtx *svc.ServiceContext)
 }
 
 func (l *UnbindOauthAccountLogic) UnbindOauthAccount(in *core.UnbindOauthAccountReq) (*core.BaseResp, error) {
-	// Simplified implementation to fix compilation errors
+	accounts, err := l.svcCtx.DB.OauthAccount.Query().
+		Where(oauthaccount.UserIDEQ(uuidx.ParseUUIDString(in.UserId)), oauthaccount.ProviderIDEQ(in.ProviderId)).
+		WithProvider().
+		All(l.ctx)
+	if err != nil {
+		return nil, dberrorhandler.DefaultEntError(l.Logger, err, in)
+	}
+
+	if err = deleteAccounts(l.ctx, l.svcCtx, l.Logger, accounts); err != nil {
+		return nil, dberrorhandler.DefaultEntError(l.Logger, err, in)
+	}
+
 	return &core.BaseResp{
 		Msg: i18n.DeleteSuccess,
 	}, nil
 }
+
+// deleteAccounts revokes the tokens at the providers and deletes the accounts. A failed revocation does not
+// block the unbinding, since the provider may be unreachable or the token may already be invalid.
+func deleteAccounts(ctx context.Context, svcCtx *svc.ServiceContext, logger logx.Logger, accounts []*ent.OauthAccount) error {
+	if len(accounts) == 0 {
+		return nil
+	}
+
+	ids := make([]uint64, 0, len(accounts))
+	for _, v := range accounts {
+		if err := svcCtx.OauthTokens.Revoke(ctx, v); err != nil {
+			logger.Errorw("failed to revoke the provider token of OAuth account", logx.Field("detail", err.Error()),
+				logx.Field("account", v.ID), logx.Field("provider", v.ProviderID))
+		}
+		ids = append(ids, v.ID)
+	}
+
+	_, err := svcCtx.DB.OauthAccount.Delete().Where(oauthaccount.IDIn(ids...)).Exec(ctx)
+	return err
+}
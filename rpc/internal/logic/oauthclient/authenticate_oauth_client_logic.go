@@ -0,0 +1,65 @@
+package oauthclient
+
+import (
+	"context"
+	"crypto/subtle"
+
+	"github.com/coder-lulu/newbee-common/v2/enum/common"
+	"github.com/zeromicro/go-zero/core/errorx"
+
+	"github.com/coder-lulu/newbee-core/rpc/ent"
+	"github.com/coder-lulu/newbee-core/rpc/ent/oauthclient"
+	"github.com/coder-lulu/newbee-core/rpc/internal/svc"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/dberrorhandler"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type AuthenticateOauthClientLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewAuthenticateOauthClientLogic(ctx context.Context, svcCtx *svc.ServiceContext) *AuthenticateOauthClientLogic {
+	return &AuthenticateOauthClientLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// AuthenticateOauthClient checks the credentials of a client calling the token endpoints. Confidential
+// clients must send their secret and public clients must not. The caller does not know the tenant yet,
+// so the request is expected in the system context.
+func (l *AuthenticateOauthClientLogic) AuthenticateOauthClient(in *core.OauthClientAuthReq) (*core.OauthClientInfo, error) {
+	if in.ClientId == "" {
+		return nil, errorx.NewUnauthenticatedError("oauth2.invalidClient")
+	}
+
+	client, err := l.svcCtx.DB.OauthClient.Query().Where(oauthclient.ClientIDEQ(in.ClientId)).Only(l.ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errorx.NewUnauthenticatedError("oauth2.invalidClient")
+		}
+		return nil, dberrorhandler.DefaultEntError(l.Logger, err, in.ClientId)
+	}
+	if client.Status != common.StatusNormal {
+		return nil, errorx.NewUnauthenticatedError("oauth2.invalidClient")
+	}
+
+	secret := in.GetClientSecret()
+	switch client.ClientType {
+	case clientTypeConfidential:
+		if secret == "" || subtle.ConstantTimeCompare([]byte(hashSecret(secret)), []byte(client.ClientSecretHash)) != 1 {
+			return nil, errorx.NewUnauthenticatedError("oauth2.invalidClient")
+		}
+	default:
+		if secret != "" {
+			return nil, errorx.NewUnauthenticatedError("oauth2.invalidClient")
+		}
+	}
+
+	return convertClient(client), nil
+}
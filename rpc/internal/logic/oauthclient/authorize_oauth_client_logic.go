@@ -0,0 +1,215 @@
+package oauthclient
+
+import (
+	"context"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/coder-lulu/newbee-common/v2/enum/common"
+	"github.com/coder-lulu/newbee-common/v2/i18n"
+	"github.com/gofrs/uuid/v5"
+	"github.com/zeromicro/go-zero/core/errorx"
+
+	"github.com/coder-lulu/newbee-core/rpc/ent"
+	"github.com/coder-lulu/newbee-core/rpc/ent/oauthclient"
+	"github.com/coder-lulu/newbee-core/rpc/ent/oauthconsent"
+	"github.com/coder-lulu/newbee-core/rpc/ent/oauthscope"
+	"github.com/coder-lulu/newbee-core/rpc/internal/svc"
+	oauthSvc "github.com/coder-lulu/newbee-core/rpc/internal/svc/oauth"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/dberrorhandler"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// 授权码附加数据中的键，授权码本身就是 StateManager 中的 state
+const (
+	codeUserID      = "user_id"
+	codeTenantID    = "tenant_id"
+	codeClientID    = "client_id"
+	codeRedirectURI = "redirect_uri"
+	codeScope       = "scope"
+	codeNonce       = "nonce"
+	codeAuthTime    = "auth_time"
+	codePKCE        = "pkce"
+)
+
+type AuthorizeOauthClientLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewAuthorizeOauthClientLogic(ctx context.Context, svcCtx *svc.ServiceContext) *AuthorizeOauthClientLogic {
+	return &AuthorizeOauthClientLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// AuthorizeOauthClient issues an authorization code to the client for the user in the context.
+// When the user has not consented to the scopes yet and approve is empty, it returns the scopes to
+// ask for instead. The code is bound to the redirect URI and the PKCE challenge, and expires in 10 minutes.
+func (l *AuthorizeOauthClientLogic) AuthorizeOauthClient(in *core.OauthAuthorizeReq) (*core.OauthAuthorizeResp, error) {
+	client, err := l.svcCtx.DB.OauthClient.Query().Where(oauthclient.ClientIDEQ(in.ClientId)).Only(l.ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errorx.NewInvalidArgumentError("oauth2.invalidClient")
+		}
+		return nil, dberrorhandler.DefaultEntError(l.Logger, err, in.ClientId)
+	}
+	if client.Status != common.StatusNormal {
+		return nil, errorx.NewInvalidArgumentError("oauth2.invalidClient")
+	}
+	if !slices.Contains(client.RedirectUris, in.RedirectUri) {
+		return nil, errorx.NewInvalidArgumentError("oauth2.invalidRedirectUri")
+	}
+	if !slices.Contains(client.GrantTypes, grantAuthorizationCode) {
+		return nil, errorx.NewInvalidArgumentError("oauth2.unauthorizedClient")
+	}
+
+	// 公共客户端必须使用 PKCE，且只支持 S256
+	challenge := in.GetCodeChallenge()
+	if challenge == "" && client.ClientType == clientTypePublic {
+		return nil, errorx.NewInvalidArgumentError("oauth2.invalidRequest")
+	}
+	if challenge != "" && in.GetCodeChallengeMethod() != "S256" {
+		return nil, errorx.NewInvalidArgumentError("oauth2.invalidRequest")
+	}
+
+	scopes := splitScope(in.Scope)
+	if len(scopes) == 0 {
+		scopes = client.Scopes
+	}
+	for _, v := range scopes {
+		if !slices.Contains(client.Scopes, v) {
+			return nil, errorx.NewInvalidArgumentError("oauth2.invalidScope")
+		}
+	}
+
+	userID, err := uuid.FromString(in.UserId)
+	if err != nil {
+		return nil, errorx.NewInvalidArgumentError(i18n.TargetNotFound)
+	}
+
+	if !client.Trusted {
+		consented, err := l.consented(client.ClientID, userID, scopes)
+		if err != nil {
+			return nil, err
+		}
+		if !consented {
+			if in.Approve == nil {
+				scopeInfos, err := l.scopeInfos(scopes)
+				if err != nil {
+					return nil, err
+				}
+				return &core.OauthAuthorizeResp{ConsentRequired: true, ClientName: client.Name, Scopes: scopeInfos}, nil
+			}
+			if !*in.Approve {
+				return nil, errorx.NewInvalidArgumentError("oauth2.accessDenied")
+			}
+			if err = l.saveConsent(client.ClientID, userID, scopes); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if in.Approve != nil && !*in.Approve {
+		return nil, errorx.NewInvalidArgumentError("oauth2.accessDenied")
+	}
+
+	code, err := l.svcCtx.StateManager.GenerateState(0, client.ID, map[string]interface{}{
+		codeUserID:      userID.String(),
+		codeTenantID:    strconv.FormatUint(client.TenantID, 10),
+		codeClientID:    client.ClientID,
+		codeRedirectURI: in.RedirectUri,
+		codeScope:       strings.Join(scopes, " "),
+		codeNonce:       in.GetNonce(),
+		codeAuthTime:    strconv.FormatInt(time.Now().Unix(), 10),
+		codePKCE:        challenge != "",
+	})
+	if err != nil {
+		l.Logger.Errorw("failed to store OAuth authorization code", logx.Field("detail", err.Error()))
+		return nil, errorx.NewInternalError(i18n.Failed)
+	}
+	if challenge != "" {
+		err = l.svcCtx.PKCEManager.StoreChallenge(code, &oauthSvc.PKCEChallenge{Challenge: challenge, Method: "S256"})
+		if err != nil {
+			_ = l.svcCtx.StateManager.DeleteState(code)
+			l.Logger.Errorw("failed to store PKCE challenge", logx.Field("detail", err.Error()))
+			return nil, errorx.NewInternalError(i18n.Failed)
+		}
+	}
+
+	return &core.OauthAuthorizeResp{Code: code, ClientName: client.Name}, nil
+}
+
+// consented reports whether the user has granted all the scopes to the client
+func (l *AuthorizeOauthClientLogic) consented(clientID string, userID uuid.UUID, scopes []string) (bool, error) {
+	consent, err := l.svcCtx.DB.OauthConsent.Query().
+		Where(oauthconsent.UserIDEQ(userID), oauthconsent.ClientIDEQ(clientID)).
+		Only(l.ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return false, nil
+		}
+		return false, dberrorhandler.DefaultEntError(l.Logger, err, clientID)
+	}
+
+	for _, v := range scopes {
+		if !slices.Contains(consent.Scopes, v) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// saveConsent adds the scopes to the consent of the user
+func (l *AuthorizeOauthClientLogic) saveConsent(clientID string, userID uuid.UUID, scopes []string) error {
+	consent, err := l.svcCtx.DB.OauthConsent.Query().
+		Where(oauthconsent.UserIDEQ(userID), oauthconsent.ClientIDEQ(clientID)).
+		Only(l.ctx)
+	switch {
+	case ent.IsNotFound(err):
+		err = l.svcCtx.DB.OauthConsent.Create().
+			SetUserID(userID).
+			SetClientID(clientID).
+			SetScopes(scopes).
+			Exec(l.ctx)
+	case err == nil:
+		granted := slices.Clone(consent.Scopes)
+		for _, v := range scopes {
+			if !slices.Contains(granted, v) {
+				granted = append(granted, v)
+			}
+		}
+		err = l.svcCtx.DB.OauthConsent.UpdateOneID(consent.ID).SetScopes(granted).Exec(l.ctx)
+	}
+	if err != nil {
+		return dberrorhandler.DefaultEntError(l.Logger, err, clientID)
+	}
+
+	return nil
+}
+
+// scopeInfos returns the scopes with their descriptions for the consent page
+func (l *AuthorizeOauthClientLogic) scopeInfos(scopes []string) ([]*core.OauthScopeInfo, error) {
+	list, err := l.svcCtx.DB.OauthScope.Query().Where(oauthscope.NameIn(scopes...)).All(l.ctx)
+	if err != nil {
+		return nil, dberrorhandler.DefaultEntError(l.Logger, err, scopes)
+	}
+
+	result := make([]*core.OauthScopeInfo, 0, len(scopes))
+	for _, name := range scopes {
+		info := &core.OauthScopeInfo{Name: &name}
+		for _, v := range list {
+			if v.Name == name {
+				info.Id, info.Description = &v.ID, &v.Description
+			}
+		}
+		result = append(result, info)
+	}
+	return result, nil
+}
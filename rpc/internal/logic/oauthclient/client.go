@@ -0,0 +1,139 @@
+package oauthclient
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/coder-lulu/newbee-common/v2/utils/pointy"
+	"github.com/zeromicro/go-zero/core/errorx"
+
+	"github.com/coder-lulu/newbee-core/rpc/ent"
+	"github.com/coder-lulu/newbee-core/rpc/ent/oauthscope"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/dberrorhandler"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/typeconv"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+const (
+	clientTypeConfidential = "confidential"
+	clientTypePublic       = "public"
+
+	grantAuthorizationCode = "authorization_code"
+	grantRefreshToken      = "refresh_token"
+	grantClientCredentials = "client_credentials"
+
+	// clientIDPrefix 便于在日志中识别客户端ID
+	clientIDPrefix = "nbc_"
+)
+
+// openIDScopes are the standard scopes of OpenID Connect, which are not mapped to APIs
+var openIDScopes = []string{"openid", "profile", "email", "offline_access"}
+
+var grantTypes = []string{grantAuthorizationCode, grantRefreshToken, grantClientCredentials}
+
+func generateClientID() (string, error) {
+	b := make([]byte, 12)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return clientIDPrefix + hex.EncodeToString(b), nil
+}
+
+// generateSecret returns a random secret and its hash
+func generateSecret() (string, string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", "", err
+	}
+	secret := base64.RawURLEncoding.EncodeToString(b)
+	return secret, hashSecret(secret), nil
+}
+
+func hashSecret(secret string) string {
+	digest := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(digest[:])
+}
+
+// splitScope splits the space-delimited scope parameter without duplicates
+func splitScope(scope string) []string {
+	var scopes []string
+	for _, v := range strings.Fields(scope) {
+		if !slices.Contains(scopes, v) {
+			scopes = append(scopes, v)
+		}
+	}
+	return scopes
+}
+
+// validateClient checks the settings of the client, the scopes must be OpenID scopes or scopes of the tenant
+func validateClient(ctx context.Context, logger logx.Logger, db *ent.Client, in *core.OauthClientInfo, clientType string) error {
+	if clientType != clientTypeConfidential && clientType != clientTypePublic {
+		return errorx.NewInvalidArgumentError("oauth2.invalidClientConfig")
+	}
+
+	for _, v := range in.GrantTypes {
+		if !slices.Contains(grantTypes, v) {
+			return errorx.NewInvalidArgumentError("oauth2.invalidClientConfig")
+		}
+	}
+	// 公共客户端无法保管密钥，不能使用客户端凭证模式
+	if clientType == clientTypePublic && slices.Contains(in.GrantTypes, grantClientCredentials) {
+		return errorx.NewInvalidArgumentError("oauth2.invalidClientConfig")
+	}
+
+	for _, v := range in.RedirectUris {
+		u, err := url.Parse(v)
+		if err != nil || u.Scheme == "" || u.Host == "" || u.Fragment != "" {
+			return errorx.NewInvalidArgumentError("oauth2.invalidRedirectUri")
+		}
+	}
+	if slices.Contains(in.GrantTypes, grantAuthorizationCode) && len(in.RedirectUris) == 0 {
+		return errorx.NewInvalidArgumentError("oauth2.invalidRedirectUri")
+	}
+
+	var custom []string
+	for _, v := range in.Scopes {
+		if !slices.Contains(openIDScopes, v) {
+			custom = append(custom, v)
+		}
+	}
+	if len(custom) > 0 {
+		count, err := db.OauthScope.Query().Where(oauthscope.NameIn(custom...)).Count(ctx)
+		if err != nil {
+			return dberrorhandler.DefaultEntError(logger, err, custom)
+		}
+		if count != len(custom) {
+			return errorx.NewInvalidArgumentError("oauth2.invalidScope")
+		}
+	}
+
+	return nil
+}
+
+func convertClient(c *ent.OauthClient) *core.OauthClientInfo {
+	return &core.OauthClientInfo{
+		Id:              &c.ID,
+		CreatedAt:       pointy.GetPointer(c.CreatedAt.UnixMilli()),
+		UpdatedAt:       pointy.GetPointer(c.UpdatedAt.UnixMilli()),
+		Status:          typeconv.ConvertStatusFromEnt(c.Status),
+		TenantId:        &c.TenantID,
+		Name:            &c.Name,
+		ClientId:        &c.ClientID,
+		ClientType:      &c.ClientType,
+		RedirectUris:    c.RedirectUris,
+		GrantTypes:      c.GrantTypes,
+		Scopes:          c.Scopes,
+		Trusted:         &c.Trusted,
+		AccessTokenTtl:  &c.AccessTokenTTL,
+		RefreshTokenTtl: &c.RefreshTokenTTL,
+		Remark:          &c.Remark,
+	}
+}
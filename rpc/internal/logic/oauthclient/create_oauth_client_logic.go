@@ -0,0 +1,77 @@
+package oauthclient
+
+import (
+	"context"
+
+	"github.com/coder-lulu/newbee-common/v2/i18n"
+	"github.com/zeromicro/go-zero/core/errorx"
+
+	"github.com/coder-lulu/newbee-core/rpc/internal/svc"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/dberrorhandler"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/typeconv"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CreateOauthClientLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewCreateOauthClientLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CreateOauthClientLogic {
+	return &CreateOauthClientLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// CreateOauthClient returns the client secret in plaintext, it can not be retrieved afterwards
+func (l *CreateOauthClientLogic) CreateOauthClient(in *core.OauthClientInfo) (*core.OauthClientSecretResp, error) {
+	clientType := clientTypeConfidential
+	if in.ClientType != nil {
+		clientType = *in.ClientType
+	}
+	if len(in.GrantTypes) == 0 {
+		in.GrantTypes = []string{grantAuthorizationCode, grantRefreshToken}
+	}
+	if err := validateClient(l.ctx, l.Logger, l.svcCtx.DB, in, clientType); err != nil {
+		return nil, err
+	}
+
+	clientID, err := generateClientID()
+	if err != nil {
+		l.Logger.Errorw("failed to generate OAuth client ID", logx.Field("detail", err.Error()))
+		return nil, errorx.NewInternalError(i18n.Failed)
+	}
+	// 公共客户端没有密钥
+	var secret, hash string
+	if clientType == clientTypeConfidential {
+		if secret, hash, err = generateSecret(); err != nil {
+			l.Logger.Errorw("failed to generate OAuth client secret", logx.Field("detail", err.Error()))
+			return nil, errorx.NewInternalError(i18n.Failed)
+		}
+	}
+
+	result, err := l.svcCtx.DB.OauthClient.Create().
+		SetNotNilName(in.Name).
+		SetClientID(clientID).
+		SetClientSecretHash(hash).
+		SetClientType(clientType).
+		SetRedirectUris(in.RedirectUris).
+		SetGrantTypes(in.GrantTypes).
+		SetScopes(in.Scopes).
+		SetNotNilTrusted(in.Trusted).
+		SetNotNilAccessTokenTTL(in.AccessTokenTtl).
+		SetNotNilRefreshTokenTTL(in.RefreshTokenTtl).
+		SetNotNilRemark(in.Remark).
+		SetNillableStatus(typeconv.ConvertStatus(in.Status)).
+		Save(l.ctx)
+	if err != nil {
+		return nil, dberrorhandler.DefaultEntError(l.Logger, err, in.Name)
+	}
+
+	return &core.OauthClientSecretResp{Id: result.ID, ClientId: clientID, ClientSecret: secret, Msg: i18n.CreateSuccess}, nil
+}
@@ -0,0 +1,51 @@
+package oauthclient
+
+import (
+	"context"
+
+	"github.com/coder-lulu/newbee-common/v2/i18n"
+
+	"github.com/coder-lulu/newbee-core/rpc/ent"
+	"github.com/coder-lulu/newbee-core/rpc/ent/oauthclient"
+	"github.com/coder-lulu/newbee-core/rpc/ent/oauthconsent"
+	"github.com/coder-lulu/newbee-core/rpc/internal/svc"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/dberrorhandler"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/entx"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type DeleteOauthClientLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewDeleteOauthClientLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DeleteOauthClientLogic {
+	return &DeleteOauthClientLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// DeleteOauthClient deletes the consents of the clients as well. Issued access tokens stay valid until they expire.
+func (l *DeleteOauthClientLogic) DeleteOauthClient(in *core.IDsReq) (*core.BaseResp, error) {
+	err := entx.WithTx(l.ctx, l.svcCtx.DB, func(tx *ent.Tx) error {
+		clientIDs, err := tx.OauthClient.Query().Where(oauthclient.IDIn(in.Ids...)).Select(oauthclient.FieldClientID).Strings(l.ctx)
+		if err != nil {
+			return err
+		}
+		if _, err = tx.OauthConsent.Delete().Where(oauthconsent.ClientIDIn(clientIDs...)).Exec(l.ctx); err != nil {
+			return err
+		}
+		_, err = tx.OauthClient.Delete().Where(oauthclient.IDIn(in.Ids...)).Exec(l.ctx)
+		return err
+	})
+	if err != nil {
+		return nil, dberrorhandler.DefaultEntError(l.Logger, err, in)
+	}
+
+	return &core.BaseResp{Msg: i18n.DeleteSuccess}, nil
+}
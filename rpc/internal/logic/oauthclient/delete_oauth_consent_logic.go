@@ -0,0 +1,38 @@
+package oauthclient
+
+import (
+	"context"
+
+	"github.com/coder-lulu/newbee-common/v2/i18n"
+
+	"github.com/coder-lulu/newbee-core/rpc/ent/oauthconsent"
+	"github.com/coder-lulu/newbee-core/rpc/internal/svc"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/dberrorhandler"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type DeleteOauthConsentLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewDeleteOauthConsentLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DeleteOauthConsentLogic {
+	return &DeleteOauthConsentLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// DeleteOauthConsent withdraws the consents, the clients have to ask the users again on the next authorization
+func (l *DeleteOauthConsentLogic) DeleteOauthConsent(in *core.IDsReq) (*core.BaseResp, error) {
+	_, err := l.svcCtx.DB.OauthConsent.Delete().Where(oauthconsent.IDIn(in.Ids...)).Exec(l.ctx)
+	if err != nil {
+		return nil, dberrorhandler.DefaultEntError(l.Logger, err, in)
+	}
+
+	return &core.BaseResp{Msg: i18n.DeleteSuccess}, nil
+}
@@ -0,0 +1,66 @@
+package oauthclient
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/zeromicro/go-zero/core/errorx"
+
+	"github.com/coder-lulu/newbee-core/rpc/internal/svc"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ExchangeOauthAuthorizationCodeLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewExchangeOauthAuthorizationCodeLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ExchangeOauthAuthorizationCodeLogic {
+	return &ExchangeOauthAuthorizationCodeLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// ExchangeOauthAuthorizationCode consumes the authorization code and returns its grant. The code can be
+// used only once, even when the exchange fails.
+func (l *ExchangeOauthAuthorizationCodeLogic) ExchangeOauthAuthorizationCode(in *core.OauthCodeExchangeReq) (*core.OauthGrantInfo, error) {
+	info, err := l.svcCtx.StateManager.ConsumeState(in.Code)
+	if err != nil {
+		return nil, errorx.NewInvalidArgumentError("oauth2.invalidGrant")
+	}
+
+	extra := func(key string) string {
+		v, _ := info.Extra[key].(string)
+		return v
+	}
+	if info.ProviderID == 0 || extra(codeClientID) != in.ClientId || extra(codeRedirectURI) != in.RedirectUri {
+		return nil, errorx.NewInvalidArgumentError("oauth2.invalidGrant")
+	}
+
+	if pkce, _ := info.Extra[codePKCE].(bool); pkce {
+		if err = l.svcCtx.PKCEManager.VerifyChallenge(in.Code, in.GetCodeVerifier()); err != nil {
+			l.Logger.Infow("PKCE verification failed", logx.Field("clientId", in.ClientId), logx.Field("detail", err.Error()))
+			return nil, errorx.NewInvalidArgumentError("oauth2.invalidGrant")
+		}
+	} else if in.GetCodeVerifier() != "" {
+		return nil, errorx.NewInvalidArgumentError("oauth2.invalidGrant")
+	}
+
+	tenantID, _ := strconv.ParseUint(extra(codeTenantID), 10, 64)
+	authTime, _ := strconv.ParseInt(extra(codeAuthTime), 10, 64)
+
+	return &core.OauthGrantInfo{
+		UserId:   extra(codeUserID),
+		TenantId: tenantID,
+		ClientId: extra(codeClientID),
+		Scopes:   strings.Fields(extra(codeScope)),
+		Nonce:    extra(codeNonce),
+		AuthTime: authTime,
+	}, nil
+}
@@ -0,0 +1,36 @@
+package oauthclient
+
+import (
+	"context"
+
+	"github.com/coder-lulu/newbee-core/rpc/ent/oauthclient"
+	"github.com/coder-lulu/newbee-core/rpc/internal/svc"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/dberrorhandler"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetOauthClientByClientIdLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetOauthClientByClientIdLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetOauthClientByClientIdLogic {
+	return &GetOauthClientByClientIdLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// GetOauthClientByClientId is used before the tenant is known, so the request is expected in the system context
+func (l *GetOauthClientByClientIdLogic) GetOauthClientByClientId(in *core.OauthClientIdReq) (*core.OauthClientInfo, error) {
+	result, err := l.svcCtx.DB.OauthClient.Query().Where(oauthclient.ClientIDEQ(in.ClientId)).Only(l.ctx)
+	if err != nil {
+		return nil, dberrorhandler.DefaultEntError(l.Logger, err, in)
+	}
+
+	return convertClient(result), nil
+}
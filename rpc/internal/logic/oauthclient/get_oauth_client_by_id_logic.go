@@ -0,0 +1,34 @@
+package oauthclient
+
+import (
+	"context"
+
+	"github.com/coder-lulu/newbee-core/rpc/internal/svc"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/dberrorhandler"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetOauthClientByIdLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetOauthClientByIdLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetOauthClientByIdLogic {
+	return &GetOauthClientByIdLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *GetOauthClientByIdLogic) GetOauthClientById(in *core.IDReq) (*core.OauthClientInfo, error) {
+	result, err := l.svcCtx.DB.OauthClient.Get(l.ctx, in.Id)
+	if err != nil {
+		return nil, dberrorhandler.DefaultEntError(l.Logger, err, in)
+	}
+
+	return convertClient(result), nil
+}
@@ -0,0 +1,52 @@
+package oauthclient
+
+import (
+	"context"
+
+	"github.com/coder-lulu/newbee-core/rpc/ent"
+	"github.com/coder-lulu/newbee-core/rpc/ent/oauthclient"
+	"github.com/coder-lulu/newbee-core/rpc/ent/predicate"
+	"github.com/coder-lulu/newbee-core/rpc/internal/svc"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/dberrorhandler"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetOauthClientListLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetOauthClientListLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetOauthClientListLogic {
+	return &GetOauthClientListLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *GetOauthClientListLogic) GetOauthClientList(in *core.OauthClientListReq) (*core.OauthClientListResp, error) {
+	var predicates []predicate.OauthClient
+	if in.Name != nil {
+		predicates = append(predicates, oauthclient.NameContains(*in.Name))
+	}
+
+	result, err := l.svcCtx.DB.OauthClient.Query().Where(predicates...).
+		Page(l.ctx, in.Page, in.PageSize, func(pager *ent.OauthClientPager) {
+			pager.Order = ent.Desc(oauthclient.FieldCreatedAt)
+		})
+	if err != nil {
+		return nil, dberrorhandler.DefaultEntError(l.Logger, err, in)
+	}
+
+	resp := &core.OauthClientListResp{}
+	resp.Total = result.PageDetails.Total
+
+	for _, v := range result.List {
+		resp.Data = append(resp.Data, convertClient(v))
+	}
+
+	return resp, nil
+}
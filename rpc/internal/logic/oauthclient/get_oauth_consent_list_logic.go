@@ -0,0 +1,87 @@
+package oauthclient
+
+import (
+	"context"
+
+	"github.com/coder-lulu/newbee-common/v2/i18n"
+	"github.com/coder-lulu/newbee-common/v2/utils/pointy"
+	"github.com/gofrs/uuid/v5"
+	"github.com/zeromicro/go-zero/core/errorx"
+
+	"github.com/coder-lulu/newbee-core/rpc/ent"
+	"github.com/coder-lulu/newbee-core/rpc/ent/oauthclient"
+	"github.com/coder-lulu/newbee-core/rpc/ent/oauthconsent"
+	"github.com/coder-lulu/newbee-core/rpc/ent/predicate"
+	"github.com/coder-lulu/newbee-core/rpc/internal/svc"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/dberrorhandler"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetOauthConsentListLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetOauthConsentListLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetOauthConsentListLogic {
+	return &GetOauthConsentListLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *GetOauthConsentListLogic) GetOauthConsentList(in *core.OauthConsentListReq) (*core.OauthConsentListResp, error) {
+	var predicates []predicate.OauthConsent
+	if in.UserId != nil {
+		userID, err := uuid.FromString(*in.UserId)
+		if err != nil {
+			return nil, errorx.NewInvalidArgumentError(i18n.TargetNotFound)
+		}
+		predicates = append(predicates, oauthconsent.UserIDEQ(userID))
+	}
+	if in.ClientId != nil {
+		predicates = append(predicates, oauthconsent.ClientIDEQ(*in.ClientId))
+	}
+
+	result, err := l.svcCtx.DB.OauthConsent.Query().Where(predicates...).
+		Page(l.ctx, in.Page, in.PageSize, func(pager *ent.OauthConsentPager) {
+			pager.Order = ent.Desc(oauthconsent.FieldUpdatedAt)
+		})
+	if err != nil {
+		return nil, dberrorhandler.DefaultEntError(l.Logger, err, in)
+	}
+
+	clientIDs := make([]string, 0, len(result.List))
+	for _, v := range result.List {
+		clientIDs = append(clientIDs, v.ClientID)
+	}
+	clients, err := l.svcCtx.DB.OauthClient.Query().Where(oauthclient.ClientIDIn(clientIDs...)).All(l.ctx)
+	if err != nil {
+		return nil, dberrorhandler.DefaultEntError(l.Logger, err, in)
+	}
+	names := make(map[string]string, len(clients))
+	for _, v := range clients {
+		names[v.ClientID] = v.Name
+	}
+
+	resp := &core.OauthConsentListResp{}
+	resp.Total = result.PageDetails.Total
+
+	for _, v := range result.List {
+		resp.Data = append(resp.Data, &core.OauthConsentInfo{
+			Id:         &v.ID,
+			CreatedAt:  pointy.GetPointer(v.CreatedAt.UnixMilli()),
+			UpdatedAt:  pointy.GetPointer(v.UpdatedAt.UnixMilli()),
+			TenantId:   &v.TenantID,
+			UserId:     pointy.GetPointer(v.UserID.String()),
+			ClientId:   &v.ClientID,
+			Scopes:     v.Scopes,
+			ClientName: pointy.GetPointer(names[v.ClientID]),
+		})
+	}
+
+	return resp, nil
+}
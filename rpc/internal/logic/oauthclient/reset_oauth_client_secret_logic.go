@@ -0,0 +1,51 @@
+package oauthclient
+
+import (
+	"context"
+
+	"github.com/coder-lulu/newbee-common/v2/i18n"
+	"github.com/zeromicro/go-zero/core/errorx"
+
+	"github.com/coder-lulu/newbee-core/rpc/internal/svc"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/dberrorhandler"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ResetOauthClientSecretLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewResetOauthClientSecretLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ResetOauthClientSecretLogic {
+	return &ResetOauthClientSecretLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// ResetOauthClientSecret replaces the secret of a confidential client, the old secret stops working at once
+func (l *ResetOauthClientSecretLogic) ResetOauthClientSecret(in *core.IDReq) (*core.OauthClientSecretResp, error) {
+	client, err := l.svcCtx.DB.OauthClient.Get(l.ctx, in.Id)
+	if err != nil {
+		return nil, dberrorhandler.DefaultEntError(l.Logger, err, in)
+	}
+	if client.ClientType != clientTypeConfidential {
+		return nil, errorx.NewInvalidArgumentError("oauth2.invalidClientConfig")
+	}
+
+	secret, hash, err := generateSecret()
+	if err != nil {
+		l.Logger.Errorw("failed to generate OAuth client secret", logx.Field("detail", err.Error()))
+		return nil, errorx.NewInternalError(i18n.Failed)
+	}
+
+	if err = l.svcCtx.DB.OauthClient.UpdateOneID(client.ID).SetClientSecretHash(hash).Exec(l.ctx); err != nil {
+		return nil, dberrorhandler.DefaultEntError(l.Logger, err, in)
+	}
+
+	return &core.OauthClientSecretResp{Id: client.ID, ClientId: client.ClientID, ClientSecret: secret, Msg: i18n.UpdateSuccess}, nil
+}
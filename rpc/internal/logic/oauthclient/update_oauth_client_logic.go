@@ -0,0 +1,56 @@
+package oauthclient
+
+import (
+	"context"
+
+	"github.com/coder-lulu/newbee-common/v2/i18n"
+
+	"github.com/coder-lulu/newbee-core/rpc/internal/svc"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/dberrorhandler"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/typeconv"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type UpdateOauthClientLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewUpdateOauthClientLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UpdateOauthClientLogic {
+	return &UpdateOauthClientLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// UpdateOauthClient can not change the client type, which decides whether the client has a secret
+func (l *UpdateOauthClientLogic) UpdateOauthClient(in *core.OauthClientInfo) (*core.BaseResp, error) {
+	client, err := l.svcCtx.DB.OauthClient.Get(l.ctx, *in.Id)
+	if err != nil {
+		return nil, dberrorhandler.DefaultEntError(l.Logger, err, in.Id)
+	}
+	if err = validateClient(l.ctx, l.Logger, l.svcCtx.DB, in, client.ClientType); err != nil {
+		return nil, err
+	}
+
+	err = l.svcCtx.DB.OauthClient.UpdateOneID(client.ID).
+		SetNotNilName(in.Name).
+		SetRedirectUris(in.RedirectUris).
+		SetGrantTypes(in.GrantTypes).
+		SetScopes(in.Scopes).
+		SetNotNilTrusted(in.Trusted).
+		SetNotNilAccessTokenTTL(in.AccessTokenTtl).
+		SetNotNilRefreshTokenTTL(in.RefreshTokenTtl).
+		SetNotNilRemark(in.Remark).
+		SetNillableStatus(typeconv.ConvertStatus(in.Status)).
+		Exec(l.ctx)
+	if err != nil {
+		return nil, dberrorhandler.DefaultEntError(l.Logger, err, in.Id)
+	}
+
+	return &core.BaseResp{Msg: i18n.UpdateSuccess}, nil
+}
@@ -28,6 +28,10 @@ func NewCreateOauthProviderLogic(ctx context.Context, svcCtx *svc.ServiceContext
 }
 
 func (l *CreateOauthProviderLogic) CreateOauthProvider(in *core.OauthProviderInfo) (*core.BaseIDResp, error) {
+	if err := validateClaimMapping(l.Logger, in.ExtraConfig); err != nil {
+		return nil, err
+	}
+
 	// 🔐 加密client_secret
 	var encryptedSecret *string
 	var encryptionKeyID *string
@@ -48,7 +52,7 @@ func (l *CreateOauthProviderLogic) CreateOauthProvider(in *core.OauthProviderInf
 	result, err := l.svcCtx.DB.OauthProvider.Create().
 		SetNotNilName(in.Name).
 		SetNotNilClientID(in.ClientId).
-		// SetNotNilClientSecret(in.ClientSecret). // ❌ 不再存储明文
+		SetClientSecret(""). // ❌ 不再存储明文
 		SetNotNilRedirectURL(in.RedirectUrl).
 		SetNotNilScopes(in.Scopes).
 		SetNotNilAuthURL(in.AuthUrl).
@@ -72,9 +76,8 @@ func (l *CreateOauthProviderLogic) CreateOauthProvider(in *core.OauthProviderInf
 		SetNotNilSuccessCount(typeconv.ConvertCount(in.SuccessCount)).
 		SetNotNilFailureCount(typeconv.ConvertCount(in.FailureCount)).
 		SetNotNilLastUsedAt(typeconv.ConvertLastUsedAt(in.LastUsedAt)).
-		// Tenant and status fields - use standard ent methods
+		// Status field - use standard ent methods, the tenant is always the tenant of the context
 		SetNillableStatus(typeconv.ConvertStatus(in.Status)).
-		SetNillableTenantID(in.TenantId).
 		Save(l.ctx)
 
 	if err != nil {
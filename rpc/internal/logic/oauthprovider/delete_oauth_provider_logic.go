@@ -29,10 +29,19 @@ func NewDeleteOauthProviderLogic(ctx context.Context, svcCtx *svc.ServiceContext
 }
 
 func (l *DeleteOauthProviderLogic) DeleteOauthProvider(in *core.IDsReq) (*core.BaseResp, error) {
-	_, err := l.svcCtx.DB.OauthProvider.Delete().Where(oauthprovider.IDIn(in.Ids...)).Exec(l.ctx)
+	providers, err := l.svcCtx.DB.OauthProvider.Query().Where(oauthprovider.IDIn(in.Ids...)).All(l.ctx)
 	if err != nil {
 		return nil, dberrorhandler.DefaultEntError(l.Logger, err, in)
 	}
 
+	_, err = l.svcCtx.DB.OauthProvider.Delete().Where(oauthprovider.IDIn(in.Ids...)).Exec(l.ctx)
+	if err != nil {
+		return nil, dberrorhandler.DefaultEntError(l.Logger, err, in)
+	}
+
+	for _, v := range providers {
+		invalidateProvider(l.ctx, l.svcCtx, v)
+	}
+
 	return &core.BaseResp{Msg: i18n.DeleteSuccess}, nil
 }
@@ -78,7 +78,8 @@ func (l *GetOauthProviderByIdLogic) GetOauthProviderById(in *core.IDReq) (*core.
 		FailureCount:    typeconv.ConvertCountFromEnt(result.FailureCount),
 		LastUsedAt:      typeconv.ConvertLastUsedAtFromEnt(result.LastUsedAt),
 		// Tenant and status fields
-		Status:   typeconv.ConvertStatusFromEnt(result.Status),
-		TenantId: &result.TenantID,
+		Status:     typeconv.ConvertStatusFromEnt(result.Status),
+		TenantId:   &result.TenantID,
+		TemplateId: &result.TemplateID,
 	}, nil
 }
@@ -87,8 +87,9 @@ func (l *GetOauthProviderListLogic) GetOauthProviderList(in *core.OauthProviderL
 			FailureCount:    typeconv.ConvertCountFromEnt(v.FailureCount),
 			LastUsedAt:      typeconv.ConvertLastUsedAtFromEnt(v.LastUsedAt),
 			// Tenant and status fields
-			Status:   typeconv.ConvertStatusFromEnt(v.Status),
-			TenantId: &v.TenantID,
+			Status:     typeconv.ConvertStatusFromEnt(v.Status),
+			TenantId:   &v.TenantID,
+			TemplateId: &v.TemplateID,
 		})
 	}
 
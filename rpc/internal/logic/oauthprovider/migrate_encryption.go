@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/coder-lulu/newbee-common/v2/orm/ent/hooks"
 	"github.com/coder-lulu/newbee-core/rpc/ent/oauthprovider"
 	"github.com/coder-lulu/newbee-core/rpc/internal/svc"
 	"github.com/zeromicro/go-zero/core/logx"
@@ -13,6 +14,7 @@ import (
 // 用于从旧版本升级到加密版本时的一次性数据迁移
 func MigrateEncryption(ctx context.Context, svcCtx *svc.ServiceContext) error {
 	logger := logx.WithContext(ctx)
+	ctx = hooks.NewSystemContext(ctx) // 提供商按租户隔离，迁移需覆盖所有租户
 	
 	// 查询所有未加密的Provider (client_secret不为空但encrypted_secret为空)
 	providers, err := svcCtx.DB.OauthProvider.Query().
@@ -91,6 +93,7 @@ func MigrateEncryption(ctx context.Context, svcCtx *svc.ServiceContext) error {
 // 检查是否还有未加密的敏感数据
 func ValidateEncryption(ctx context.Context, svcCtx *svc.ServiceContext) (map[string]interface{}, error) {
 	logger := logx.WithContext(ctx)
+	ctx = hooks.NewSystemContext(ctx) // 提供商按租户隔离，迁移需覆盖所有租户
 	
 	// 统计加密状态
 	totalCount, err := svcCtx.DB.OauthProvider.Query().Count(ctx)
@@ -182,6 +185,7 @@ func ReEncryptProvider(ctx context.Context, svcCtx *svc.ServiceContext, provider
 // 用于定期密钥轮换以增强安全性
 func RotateAllKeys(ctx context.Context, svcCtx *svc.ServiceContext) error {
 	logger := logx.WithContext(ctx)
+	ctx = hooks.NewSystemContext(ctx) // 提供商按租户隔离，迁移需覆盖所有租户
 	
 	// 查询所有已加密的Provider
 	providers, err := svcCtx.DB.OauthProvider.Query().
@@ -2,14 +2,11 @@ package oauthprovider
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
-	"io"
-	"net/http"
-	"strings"
 
 	"github.com/coder-lulu/newbee-common/v2/msg/logmsg"
+	"github.com/coder-lulu/newbee-common/v2/orm/ent/hooks"
 	"github.com/coder-lulu/newbee-common/v2/utils/pointy"
+	"github.com/coder-lulu/newbee-common/v2/utils/uuidx"
 	"github.com/zeromicro/go-zero/core/errorx"
 	"golang.org/x/oauth2"
 	"google.golang.org/grpc/codes"
@@ -18,9 +15,10 @@ import (
 	"github.com/coder-lulu/newbee-common/v2/i18n"
 
 	"github.com/coder-lulu/newbee-core/rpc/ent"
-	"github.com/coder-lulu/newbee-core/rpc/ent/oauthprovider"
 	"github.com/coder-lulu/newbee-core/rpc/ent/user"
 
+	"github.com/coder-lulu/newbee-core/rpc/internal/adapters"
+	"github.com/coder-lulu/newbee-core/rpc/internal/interfaces"
 	user2 "github.com/coder-lulu/newbee-core/rpc/internal/logic/user"
 	"github.com/coder-lulu/newbee-core/rpc/internal/svc"
 	"github.com/coder-lulu/newbee-core/rpc/internal/utils/dberrorhandler"
@@ -50,61 +48,99 @@ func NewOauthCallbackLogic(ctx context.Context, svcCtx *svc.ServiceContext) *Oau
 	}
 }
 
-func (l *OauthCallbackLogic) OauthCallback(in *core.CallbackReq) (*core.UserInfo, error) {
-	provider := strings.Split(in.State, "-")[1]
-	if _, ok := providerConfig[provider]; !ok {
-		p, err := l.svcCtx.DB.OauthProvider.Query().Where(oauthprovider.NameEQ(provider)).First(l.ctx)
-		if err != nil {
-			return nil, dberrorhandler.DefaultEntError(l.Logger, err, in)
-		}
+// OauthCallback verifies the signed state against the tenant, the provider and the redirect URI it was issued for,
+// exchanges the code with the PKCE verifier of the login and finds the user
+func (l *OauthCallbackLogic) OauthCallback(in *core.CallbackReq) (*core.OauthCallbackResp, error) {
+	tenantID, providerID, err := l.svcCtx.StateManager.ParseState(in.State)
+	if err != nil {
+		l.Logger.Errorw("invalid OAuth state", logx.Field("detail", err.Error()))
+		return nil, errorx.NewInvalidArgumentError("oauth.invalidState")
+	}
 
-		// 🔐 解密client_secret用于OAuth回调流程
-		var clientSecret string
-		if p.EncryptedSecret != "" && p.EncryptionKeyID != "" {
-			decrypted, err := l.svcCtx.EncryptionService.DecryptProviderSecret(p.EncryptedSecret, p.EncryptionKeyID)
-			if err != nil {
-				l.Logger.Errorw("Failed to decrypt client secret for callback", logx.Field("error", err), logx.Field("provider", p.Name))
-				return nil, err
-			}
-			clientSecret = decrypted
-		} else {
-			// 兼容旧数据(未加密)
-			clientSecret = p.ClientSecret
-		}
+	ctx := hooks.SetTenantIDToContext(l.ctx, tenantID)
+	p, err := l.svcCtx.DB.OauthProvider.Get(ctx, providerID)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, dberrorhandler.DefaultEntError(l.Logger, err, providerID)
+	}
+	if err != nil || p.TenantID != tenantID {
+		l.Logger.Errorw("OAuth state does not match a provider of the tenant", logx.Field("provider", providerID), logx.Field("tenantId", tenantID))
+		return nil, errorx.NewInvalidArgumentError("oauth.invalidState")
+	}
 
-		providerConfig[provider] = oauth2.Config{
-			ClientID:     p.ClientID,
-			ClientSecret: clientSecret, // ✅ 使用解密后的密钥
-			Endpoint: oauth2.Endpoint{
-				AuthURL:   replaceKeywords(p.AuthURL, p),
-				TokenURL:  p.TokenURL,
-				AuthStyle: oauth2.AuthStyle(p.AuthStyle),
-			},
-			RedirectURL: p.RedirectURL,
-			Scopes:      strings.Split(p.Scopes, " "),
-		}
-		if _, ok := userInfoURL[p.Name]; !ok {
-			userInfoURL[p.Name] = p.InfoURL
-		}
+	stateInfo, err := l.svcCtx.StateManager.ConsumeSignedState(ctx, in.State, p.RedirectURL)
+	if err != nil {
+		l.Logger.Errorw("invalid OAuth state", logx.Field("detail", err.Error()), logx.Field("provider", p.Name))
+		return nil, errorx.NewInvalidArgumentError("oauth.invalidState")
+	}
+
+	session := &interfaces.OAuthSession{
+		State:       in.State,
+		RedirectURL: p.RedirectURL,
+		ExtraData:   map[string]string{adapters.OIDCNonceKey: stateInfo.Nonce},
+	}
+	if challenge, err := l.svcCtx.PKCEManager.GetChallenge(in.State); err == nil {
+		_ = l.svcCtx.PKCEManager.DeleteChallenge(in.State)
+		session.CodeVerifier = challenge.Verifier
 	}
 
-	// get user information
-	content, err := getUserInfo(providerConfig[provider], userInfoURL[provider], in.Code)
+	if in.GetError() != "" {
+		finishSession(ctx, l.svcCtx, l.Logger, in.State, sessionStatusFailed, nil, in.GetError(), in.GetErrorDescription())
+		return nil, errorx.NewInvalidArgumentError("oauth.authorizationDenied")
+	}
+
+	adapter, err := providerAdapter(ctx, l.svcCtx, l.Logger, p)
+	if err != nil {
+		finishSession(ctx, l.svcCtx, l.Logger, in.State, sessionStatusFailed, nil, "provider_disabled", err.Error())
+		return nil, err
+	}
+
+	token, err := adapter.ExchangeCodeForToken(ctx, in.Code, session)
+	if err != nil {
+		l.Logger.Errorw("failed to exchange OAuth code", logx.Field("detail", err.Error()), logx.Field("provider", p.Name))
+		finishSession(ctx, l.svcCtx, l.Logger, in.State, sessionStatusFailed, nil, "token_exchange_failed", err.Error())
+		return nil, errorx.NewInvalidArgumentError("oauth.loginFailed")
+	}
+
+	info, err := adapter.GetUserInfo(ctx, token)
+	if err != nil {
+		l.Logger.Errorw("failed to get OAuth user info", logx.Field("detail", err.Error()), logx.Field("provider", p.Name))
+		finishSession(ctx, l.svcCtx, l.Logger, in.State, sessionStatusFailed, nil, "userinfo_failed", err.Error())
+		return nil, errorx.NewInvalidArgumentError("oauth.loginFailed")
+	}
+
+	result, err := l.findUser(ctx, matchInfo(p, info), in)
 	if err != nil {
-		return nil, errorx.NewInvalidArgumentError(err.Error())
+		finishSession(ctx, l.svcCtx, l.Logger, in.State, sessionStatusFailed, nil, "user_not_found", err.Error())
+		return nil, err
+	}
+
+	finishSession(ctx, l.svcCtx, l.Logger, in.State, sessionStatusCompleted, result, "", "")
+	l.linkAccount(ctx, p, result, info, token)
+
+	return &core.OauthCallbackResp{User: result, Provider: p.Name}, nil
+}
+
+// linkAccount records the provider account of the user with its token, so that the token can be refreshed and used
+// to call the provider API on behalf of the user. The login is not affected when it fails.
+func (l *OauthCallbackLogic) linkAccount(ctx context.Context, p *ent.OauthProvider, u *core.UserInfo, info *interfaces.OAuthUserInfo, token *oauth2.Token) {
+	if token == nil || info == nil || info.ID == "" {
+		return
 	}
 
-	// find or register user
-	var u userInfo
-	err = json.Unmarshal(content, &u)
+	err := l.svcCtx.OauthTokens.LinkAccount(ctx, p, uuidx.ParseUUIDString(u.GetId()), u.GetDepartmentId(), info, token)
 	if err != nil {
-		return nil, errorx.NewInternalError(err.Error())
+		l.Logger.Errorw("failed to link OAuth account", logx.Field("detail", err.Error()),
+			logx.Field("provider", p.Name), logx.Field("userId", u.GetId()))
 	}
+}
 
+// findUser finds the user by the mobile or email from the provider
+func (l *OauthCallbackLogic) findUser(ctx context.Context, u userInfo, in *core.CallbackReq) (*core.UserInfo, error) {
 	var result *ent.User
+	var err error
 
 	if u.Mobile != "" {
-		result, err = l.svcCtx.DB.User.Query().Where(user.MobileEQ(u.Mobile)).WithRoles().First(l.ctx)
+		result, err = l.svcCtx.DB.User.Query().Where(user.MobileEQ(u.Mobile)).WithRoles().First(ctx)
 		if err != nil {
 			switch {
 			case ent.IsNotFound(err):
@@ -116,7 +152,7 @@ func (l *OauthCallbackLogic) OauthCallback(in *core.CallbackReq) (*core.UserInfo
 			}
 		}
 	} else if u.Email != "" {
-		result, err = l.svcCtx.DB.User.Query().Where(user.EmailEQ(u.Email)).WithRoles().First(l.ctx)
+		result, err = l.svcCtx.DB.User.Query().Where(user.EmailEQ(u.Email)).WithRoles().First(ctx)
 		if err != nil {
 			switch {
 			case ent.IsNotFound(err):
@@ -150,56 +186,3 @@ func (l *OauthCallbackLogic) OauthCallback(in *core.CallbackReq) (*core.UserInfo
 
 	return nil, status.Error(codes.InvalidArgument, i18n.Failed)
 }
-
-func getUserInfo(c oauth2.Config, infoURL string, code string) ([]byte, error) {
-	var token *oauth2.Token
-	var err error
-
-	if strings.Contains(c.Endpoint.AuthURL, "feishu") {
-		return GetFeishuUserInfo(c, code)
-	} else {
-		token, err = c.Exchange(context.Background(), code)
-	}
-
-	if err != nil {
-		return nil, fmt.Errorf("code exchange failed: %s", err.Error())
-	}
-
-	var response *http.Response
-	if c.Endpoint.AuthStyle == 1 {
-		response, err = http.Get(strings.ReplaceAll(infoURL, "TOKEN", token.AccessToken))
-		if err != nil {
-			return nil, fmt.Errorf("failed to get user's information: %s", err.Error())
-		}
-	} else if c.Endpoint.AuthStyle == 2 {
-		client := &http.Client{}
-		request, err := http.NewRequest("GET", infoURL, nil)
-		if err != nil {
-			return nil, status.Error(codes.Internal, err.Error())
-		}
-
-		request.Header.Set("Accept", "application/json")
-		request.Header.Set("Authorization", "Bearer "+token.AccessToken)
-
-		response, err = client.Do(request)
-		if err != nil {
-			return nil, fmt.Errorf("failed getting user info: %s", err.Error())
-		}
-	}
-
-	defer response.Body.Close()
-	contents, err := io.ReadAll(response.Body)
-	if err != nil {
-		return nil, fmt.Errorf("failed to read response body: %s", err.Error())
-	}
-
-	return contents, nil
-}
-
-func replaceKeywords(urlData string, oauthData *ent.OauthProvider) (result string) {
-	result = strings.ReplaceAll(urlData, "CLIENT_ID", oauthData.ClientID)
-	result = strings.ReplaceAll(result, "SECRET", oauthData.ClientSecret)
-	result = strings.ReplaceAll(result, "REDIRECT_URL", oauthData.RedirectURL)
-	result = strings.ReplaceAll(result, "SCOPE", oauthData.Scopes)
-	return result
-}
@@ -0,0 +1,91 @@
+package oauthprovider
+
+import (
+	"context"
+	"time"
+
+	"github.com/coder-lulu/newbee-common/v2/enum/common"
+	"github.com/coder-lulu/newbee-common/v2/utils/uuidx"
+	"github.com/zeromicro/go-zero/core/errorx"
+	"github.com/zeromicro/go-zero/core/logx"
+
+	"github.com/coder-lulu/newbee-core/rpc/ent"
+	"github.com/coder-lulu/newbee-core/rpc/ent/oauthsession"
+	"github.com/coder-lulu/newbee-core/rpc/internal/interfaces"
+	"github.com/coder-lulu/newbee-core/rpc/internal/svc"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+)
+
+// OauthSession 状态，StatusMixin 的默认值 1 表示等待回调
+const (
+	sessionStatusPending   uint8 = 1 // 已跳转到第三方平台，等待回调
+	sessionStatusCompleted uint8 = 2 // 登录成功
+	sessionStatusFailed    uint8 = 3 // 登录失败，原因见 error_code 和 error_description
+)
+
+// providerAdapter returns the configured adapter of the provider from the provider registry
+func providerAdapter(ctx context.Context, svcCtx *svc.ServiceContext, logger logx.Logger, p *ent.OauthProvider) (interfaces.OAuthAdapter, error) {
+	if !p.Enabled || p.Status != common.StatusNormal {
+		return nil, errorx.NewInvalidArgumentError("oauth.providerDisabled")
+	}
+
+	adapter, err := svcCtx.OAuthManager.GetProviderAdapter(ctx, p)
+	if err != nil {
+		logger.Errorw("failed to configure OAuth provider", logx.Field("detail", err.Error()), logx.Field("provider", p.Name))
+		return nil, errorx.NewInternalError("oauth.loginFailed")
+	}
+
+	return adapter, nil
+}
+
+// invalidateProvider removes the cached configuration and adapter of the provider
+func invalidateProvider(ctx context.Context, svcCtx *svc.ServiceContext, p *ent.OauthProvider) {
+	svcCtx.OAuthManager.InvalidateProvider(ctx, p.TenantID, interfaces.OAuthProviderType(p.Type), p.ID)
+}
+
+// matchInfo returns the information used to find the user. Email and mobile from OIDC providers are only
+// used for account matching when the provider verified them.
+func matchInfo(p *ent.OauthProvider, info *interfaces.OAuthUserInfo) userInfo {
+	u := userInfo{NickName: info.Nickname, Picture: info.Avatar}
+	if p.Type != string(interfaces.ProviderTypeOIDC) {
+		u.Email, u.Mobile = info.Email, info.PhoneNumber
+		return u
+	}
+
+	if info.Verified {
+		u.Email = info.Email
+	}
+	if verified, _ := info.RawData["phone_number_verified"].(bool); verified {
+		u.Mobile = info.PhoneNumber
+	}
+
+	return u
+}
+
+// finishSession records the result of the login flow, the login is not affected when it fails
+func finishSession(ctx context.Context, svcCtx *svc.ServiceContext, logger logx.Logger, state string, status uint8,
+	user *core.UserInfo, errCode, errDescription string,
+) {
+	update := svcCtx.DB.OauthSession.Update().
+		Where(oauthsession.StateEQ(state)).
+		SetStatus(status).
+		SetCodeReceivedAt(time.Now())
+	if user != nil {
+		update.SetUserID(uuidx.ParseUUIDString(user.GetId())).SetDepartmentID(user.GetDepartmentId())
+	}
+	if errCode != "" {
+		update.SetErrorCode(truncate(errCode, 50)).SetErrorDescription(truncate(errDescription, 500))
+	}
+
+	if err := update.Exec(ctx); err != nil {
+		logger.Errorw("failed to update OAuth session", logx.Field("detail", err.Error()))
+	}
+}
+
+// truncate cuts s to at most n runes
+func truncate(s string, n int) string {
+	if r := []rune(s); len(r) > n {
+		return string(r[:n])
+	}
+	return s
+}
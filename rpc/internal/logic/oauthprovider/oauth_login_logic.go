@@ -2,24 +2,24 @@ package oauthprovider
 
 import (
 	"context"
-	"strings"
 
-	"golang.org/x/oauth2"
+	"github.com/coder-lulu/newbee-common/v2/orm/ent/entctx/tenantctx"
+	"github.com/coder-lulu/newbee-common/v2/orm/ent/hooks"
+	"github.com/coder-lulu/newbee-common/v2/utils/uuidx"
+	"github.com/zeromicro/go-zero/core/errorx"
 
 	"github.com/coder-lulu/newbee-core/rpc/ent/oauthprovider"
 
+	"github.com/coder-lulu/newbee-core/rpc/internal/adapters"
+	"github.com/coder-lulu/newbee-core/rpc/internal/interfaces"
 	"github.com/coder-lulu/newbee-core/rpc/internal/svc"
+	oauthSvc "github.com/coder-lulu/newbee-core/rpc/internal/svc/oauth"
 	"github.com/coder-lulu/newbee-core/rpc/internal/utils/dberrorhandler"
 	"github.com/coder-lulu/newbee-core/rpc/types/core"
 
 	"github.com/zeromicro/go-zero/core/logx"
 )
 
-var providerConfig = make(map[string]oauth2.Config)
-
-// userInfoURL used to store infoURL in database | 用来存储获取用户信息网址数据
-var userInfoURL = make(map[string]string)
-
 type OauthLoginLogic struct {
 	ctx    context.Context
 	svcCtx *svc.ServiceContext
@@ -34,49 +34,78 @@ func NewOauthLoginLogic(ctx context.Context, svcCtx *svc.ServiceContext) *OauthL
 	}
 }
 
+// OauthLogin issues a signed state bound to the tenant of the provider and its redirect URI, and returns the
+// authorization URL. The provider is looked up in the tenant of the context. PKCE is used when the adapter
+// supports it, and the flow is recorded as an OauthSession.
 func (l *OauthLoginLogic) OauthLogin(in *core.OauthLoginReq) (*core.OauthRedirectResp, error) {
-	p, err := l.svcCtx.DB.OauthProvider.Query().Where(oauthprovider.NameEQ(in.Provider)).First(l.ctx)
+	// 提供商按租户配置，只能使用当前租户自己的提供商
+	p, err := l.svcCtx.DB.OauthProvider.Query().
+		Where(oauthprovider.NameEQ(in.Provider), oauthprovider.TenantIDEQ(tenantctx.GetTenantIDFromCtx(l.ctx))).
+		Only(l.ctx)
 	if err != nil {
 		return nil, dberrorhandler.DefaultEntError(l.Logger, err, in)
 	}
 
-	// 🔐 解密client_secret用于OAuth流程
-	var clientSecret string
-	if p.EncryptedSecret != "" && p.EncryptionKeyID != "" {
-		decrypted, err := l.svcCtx.EncryptionService.DecryptProviderSecret(p.EncryptedSecret, p.EncryptionKeyID)
-		if err != nil {
-			l.Logger.Errorw("Failed to decrypt client secret for OAuth", logx.Field("error", err), logx.Field("provider", p.Name))
-			return nil, err
+	ctx := hooks.SetTenantIDToContext(l.ctx, p.TenantID)
+
+	adapter, err := providerAdapter(ctx, l.svcCtx, l.Logger, p)
+	if err != nil {
+		return nil, err
+	}
+
+	var challenge *oauthSvc.PKCEChallenge
+	if adapter.SupportsFeature(interfaces.FeaturePKCE) {
+		if challenge, err = l.svcCtx.PKCEManager.GenerateChallenge(); err != nil {
+			l.Logger.Errorw("failed to generate PKCE challenge", logx.Field("detail", err.Error()))
+			return nil, errorx.NewInternalError("oauth.loginFailed")
 		}
-		clientSecret = decrypted
-	} else {
-		// 兼容旧数据(未加密)
-		clientSecret = p.ClientSecret
 	}
 
-	var config oauth2.Config
-	if v, ok := providerConfig[p.Name]; ok {
-		config = v
-	} else {
-		providerConfig[p.Name] = oauth2.Config{
-			ClientID:     p.ClientID,
-			ClientSecret: clientSecret, // ✅ 使用解密后的密钥
-			Endpoint: oauth2.Endpoint{
-				AuthURL:   replaceKeywords(p.AuthURL, p),
-				TokenURL:  p.TokenURL,
-				AuthStyle: oauth2.AuthStyle(p.AuthStyle),
-			},
-			RedirectURL: p.RedirectURL,
-			Scopes:      strings.Split(p.Scopes, " "),
+	state, stateInfo, err := l.svcCtx.StateManager.IssueState(ctx, p.TenantID, p.ID, p.RedirectURL, nil)
+	if err != nil {
+		l.Logger.Errorw("failed to issue OAuth state", logx.Field("detail", err.Error()), logx.Field("provider", p.Name))
+		return nil, errorx.NewInternalError("oauth.loginFailed")
+	}
+
+	session := &interfaces.OAuthSession{
+		State:       state,
+		RedirectURL: p.RedirectURL,
+		ExtraData:   map[string]string{adapters.OIDCNonceKey: stateInfo.Nonce},
+		CreatedAt:   stateInfo.CreatedAt,
+		ExpiresAt:   stateInfo.ExpiresAt,
+	}
+	if challenge != nil {
+		if err = l.svcCtx.PKCEManager.StoreChallenge(state, challenge); err != nil {
+			l.Logger.Errorw("failed to store PKCE challenge", logx.Field("detail", err.Error()))
+			return nil, errorx.NewInternalError("oauth.loginFailed")
 		}
-		config = providerConfig[p.Name]
+		session.CodeVerifier = challenge.Verifier
 	}
 
-	if _, ok := userInfoURL[p.Name]; !ok {
-		userInfoURL[p.Name] = p.InfoURL
+	url, err := adapter.GetAuthorizationURL(ctx, session)
+	if err != nil {
+		l.Logger.Errorw("failed to build OAuth authorization url", logx.Field("detail", err.Error()), logx.Field("provider", p.Name))
+		return nil, errorx.NewInternalError("oauth.loginFailed")
 	}
 
-	url := config.AuthCodeURL(in.State)
+	// 记录登录会话，记录失败不影响登录
+	create := l.svcCtx.DB.OauthSession.Create().
+		SetTenantID(p.TenantID).
+		SetSessionID(uuidx.NewUUID().String()).
+		SetState(state).
+		SetProviderID(p.ID).
+		SetRedirectURI(p.RedirectURL).
+		SetScope(p.Scopes).
+		SetExpiresAt(stateInfo.ExpiresAt).
+		SetStatus(sessionStatusPending).
+		SetClientIP(truncate(in.GetClientIp(), 45)).
+		SetUserAgent(truncate(in.GetUserAgent(), 500))
+	if challenge != nil {
+		create.SetCodeChallenge(challenge.Challenge).SetCodeChallengeMethod(challenge.Method)
+	}
+	if err = create.Exec(ctx); err != nil {
+		l.Logger.Errorw("failed to create OAuth session", logx.Field("detail", err.Error()), logx.Field("provider", p.Name))
+	}
 
 	return &core.OauthRedirectResp{Url: url}, nil
 }
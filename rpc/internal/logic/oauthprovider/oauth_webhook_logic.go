@@ -0,0 +1,185 @@
+package oauthprovider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/coder-lulu/newbee-common/v2/enum/common"
+	"github.com/coder-lulu/newbee-common/v2/orm/ent/hooks"
+	"github.com/zeromicro/go-zero/core/errorx"
+	"github.com/zeromicro/go-zero/core/logx"
+
+	"github.com/coder-lulu/newbee-core/rpc/ent"
+	"github.com/coder-lulu/newbee-core/rpc/ent/auditlog"
+	"github.com/coder-lulu/newbee-core/rpc/ent/oauthaccount"
+	"github.com/coder-lulu/newbee-core/rpc/internal/adapters"
+	"github.com/coder-lulu/newbee-core/rpc/internal/interfaces"
+	"github.com/coder-lulu/newbee-core/rpc/internal/svc"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/auditchain"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/dberrorhandler"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+)
+
+const (
+	// webhookEventKey 已处理的 webhook 事件，第三方平台重试时不会重复处理
+	webhookEventKey = "oauth:webhook:%d:%s"
+	// webhookEventTTL 事件去重的保留时间，覆盖各平台的重试窗口
+	webhookEventTTL = 72 * time.Hour
+)
+
+type OauthWebhookLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewOauthWebhookLogic(ctx context.Context, svcCtx *svc.ServiceContext) *OauthWebhookLogic {
+	return &OauthWebhookLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// OauthWebhook receives the account events pushed by the provider. The request is verified by the adapter of
+// the provider, each event is processed once and the changes of the linked accounts are recorded in the audit log.
+func (l *OauthWebhookLogic) OauthWebhook(in *core.OauthWebhookReq) (*core.OauthWebhookResp, error) {
+	// webhook 请求不携带租户信息，通过提供商 ID 确定所属租户
+	p, err := l.svcCtx.DB.OauthProvider.Get(hooks.NewSystemContext(l.ctx), in.ProviderId)
+	if err != nil {
+		return nil, dberrorhandler.DefaultEntError(l.Logger, err, in.ProviderId)
+	}
+	ctx := hooks.SetTenantIDToContext(l.ctx, p.TenantID)
+
+	adapter, err := providerAdapter(ctx, l.svcCtx, l.Logger, p)
+	if err != nil {
+		return nil, err
+	}
+	webhookAdapter, ok := adapter.(interfaces.WebhookAdapter)
+	if !ok {
+		return nil, errorx.NewInvalidArgumentError("oauth.webhookNotSupported")
+	}
+
+	event, err := webhookAdapter.ParseWebhook(ctx, in.Headers, in.Body)
+	if err != nil {
+		l.Logger.Errorw("invalid OAuth webhook", logx.Field("detail", err.Error()), logx.Field("provider", p.Name))
+		if errors.Is(err, adapters.ErrWebhookNotConfigured) {
+			return nil, errorx.NewInvalidArgumentError("oauth.webhookNotSupported")
+		}
+		return nil, errorx.NewInvalidArgumentError("oauth.invalidWebhook")
+	}
+
+	switch event.Type {
+	case interfaces.WebhookEventVerification:
+		return &core.OauthWebhookResp{Challenge: event.Challenge}, nil
+	case interfaces.WebhookEventIgnored:
+		return &core.OauthWebhookResp{}, nil
+	}
+
+	key := fmt.Sprintf(webhookEventKey, p.ID, event.ID)
+	first, err := l.svcCtx.Redis.SetNX(ctx, key, event.Name, webhookEventTTL).Result()
+	if err != nil {
+		l.Logger.Errorw("failed to record OAuth webhook event", logx.Field("detail", err.Error()))
+		return nil, errorx.NewInternalError("oauth.webhookFailed")
+	}
+	if !first {
+		return &core.OauthWebhookResp{Duplicate: true}, nil
+	}
+
+	if err = l.handleEvent(ctx, p, event); err != nil {
+		// 处理失败时释放事件，第三方平台重试时重新处理
+		l.svcCtx.Redis.Del(ctx, key)
+		l.Logger.Errorw("failed to handle OAuth webhook event", logx.Field("detail", err.Error()),
+			logx.Field("provider", p.Name), logx.Field("event", event.Name), logx.Field("eventId", event.ID))
+		return nil, errorx.NewInternalError("oauth.webhookFailed")
+	}
+
+	return &core.OauthWebhookResp{}, nil
+}
+
+// handleEvent applies the event to the accounts linked to the provider user
+func (l *OauthWebhookLogic) handleEvent(ctx context.Context, p *ent.OauthProvider, event *interfaces.WebhookEvent) error {
+	accounts, err := l.svcCtx.DB.OauthAccount.Query().
+		Where(oauthaccount.ProviderIDEQ(p.ID), oauthaccount.ProviderUserIDEQ(event.ProviderUserID)).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, v := range accounts {
+		operation := auditlog.OperationTypeUPDATE
+
+		switch event.Type {
+		case interfaces.WebhookEventDeauthorized:
+			// 授权已在第三方平台撤销，令牌失效，保留绑定关系以便用户重新授权后恢复
+			err = l.svcCtx.DB.OauthAccount.UpdateOneID(v.ID).
+				SetStatus(common.StatusBanned).
+				SetAccessToken("").
+				ClearRefreshToken().
+				ClearTokenExpiresAt().
+				Exec(ctx)
+		case interfaces.WebhookEventAccountDeleted:
+			operation = auditlog.OperationTypeDELETE
+			err = l.svcCtx.DB.OauthAccount.DeleteOneID(v.ID).Exec(ctx)
+		case interfaces.WebhookEventProfileUpdated:
+			if event.UserInfo == nil {
+				continue
+			}
+			query := l.svcCtx.DB.OauthAccount.UpdateOneID(v.ID)
+			if event.UserInfo.Username != "" {
+				query.SetProviderUsername(truncate(event.UserInfo.Username, 100))
+			}
+			if event.UserInfo.Nickname != "" {
+				query.SetProviderNickname(truncate(event.UserInfo.Nickname, 100))
+			}
+			if event.UserInfo.Email != "" {
+				query.SetProviderEmail(truncate(event.UserInfo.Email, 255))
+			}
+			if event.UserInfo.Avatar != "" {
+				query.SetProviderAvatar(truncate(event.UserInfo.Avatar, 500))
+			}
+			err = query.Exec(ctx)
+		default:
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		l.audit(ctx, p, v, event, operation)
+	}
+
+	return nil
+}
+
+// audit records the change of the account, the event is not affected when it fails
+func (l *OauthWebhookLogic) audit(ctx context.Context, p *ent.OauthProvider, account *ent.OauthAccount,
+	event *interfaces.WebhookEvent, operation auditlog.OperationType,
+) {
+	_, err := auditchain.Append(ctx, l.svcCtx.DB, &ent.AuditLog{
+		TenantID:      fmt.Sprintf("%d", p.TenantID),
+		Status:        1,
+		UserID:        account.UserID.String(),
+		UserName:      p.Name,
+		OperationType: operation,
+		ResourceType:  "oauth_account",
+		ResourceID:    fmt.Sprintf("%d", account.ID),
+		RequestMethod: "POST",
+		RequestPath:   fmt.Sprintf("/oauth/webhook?id=%d", p.ID),
+		Metadata: map[string]interface{}{
+			"source":         "oauth_webhook",
+			"provider":       p.Name,
+			"providerType":   p.Type,
+			"providerUserId": event.ProviderUserID,
+			"event":          event.Name,
+			"eventType":      string(event.Type),
+			"eventId":        event.ID,
+		},
+	})
+	if err != nil {
+		l.Logger.Errorw("failed to write audit log of OAuth webhook", logx.Field("detail", err.Error()),
+			logx.Field("account", account.ID))
+	}
+}
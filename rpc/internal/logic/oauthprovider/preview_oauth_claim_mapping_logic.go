@@ -0,0 +1,86 @@
+package oauthprovider
+
+import (
+	"context"
+
+	"github.com/zeromicro/go-zero/core/errorx"
+	"github.com/zeromicro/go-zero/core/logx"
+
+	"github.com/coder-lulu/newbee-core/rpc/internal/svc"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/claimmap"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/dberrorhandler"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/typeconv"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+)
+
+type PreviewOauthClaimMappingLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewPreviewOauthClaimMappingLogic(ctx context.Context, svcCtx *svc.ServiceContext) *PreviewOauthClaimMappingLogic {
+	return &PreviewOauthClaimMappingLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// PreviewOauthClaimMapping maps the sample userinfo with the given extra config, or the saved one of the provider
+func (l *PreviewOauthClaimMappingLogic) PreviewOauthClaimMapping(in *core.OauthClaimMappingPreviewReq) (*core.OauthClaimMappingPreviewResp, error) {
+	var extra map[string]interface{}
+	switch {
+	case in.ExtraConfig != nil && *in.ExtraConfig != "":
+		config := typeconv.ConvertExtraConfig(in.ExtraConfig)
+		if config == nil {
+			return nil, errorx.NewInvalidArgumentError("oauth.invalidClaimMapping")
+		}
+		extra = *config
+	case in.ProviderId != nil:
+		p, err := l.svcCtx.DB.OauthProvider.Get(l.ctx, *in.ProviderId)
+		if err != nil {
+			return nil, dberrorhandler.DefaultEntError(l.Logger, err, in)
+		}
+		extra = p.ExtraConfig
+	}
+
+	mapping, err := claimmap.FromExtraConfig(extra)
+	if err != nil {
+		return nil, errorx.NewInvalidArgumentError("oauth.invalidClaimMapping")
+	}
+
+	info, err := mapping.Map([]byte(in.Sample))
+	if err != nil {
+		return nil, errorx.NewInvalidArgumentError("oauth.invalidSample")
+	}
+
+	return &core.OauthClaimMappingPreviewResp{
+		Id:       info.ID,
+		Username: info.Username,
+		Nickname: info.Nickname,
+		Email:    info.Email,
+		Phone:    info.PhoneNumber,
+		Avatar:   info.Avatar,
+		Groups:   info.Groups,
+	}, nil
+}
+
+// validateClaimMapping checks the claim mapping rules in the extra config before saving the provider
+func validateClaimMapping(logger logx.Logger, extraConfig *string) error {
+	if extraConfig == nil || *extraConfig == "" {
+		return nil
+	}
+
+	extra := typeconv.ConvertExtraConfig(extraConfig)
+	if extra == nil {
+		return errorx.NewInvalidArgumentError("oauth.invalidClaimMapping")
+	}
+
+	if _, err := claimmap.FromExtraConfig(*extra); err != nil {
+		logger.Errorw("invalid claim mapping", logx.Field("detail", err.Error()))
+		return errorx.NewInvalidArgumentError("oauth.invalidClaimMapping")
+	}
+
+	return nil
+}
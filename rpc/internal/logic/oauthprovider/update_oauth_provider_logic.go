@@ -28,6 +28,10 @@ func NewUpdateOauthProviderLogic(ctx context.Context, svcCtx *svc.ServiceContext
 }
 
 func (l *UpdateOauthProviderLogic) UpdateOauthProvider(in *core.OauthProviderInfo) (*core.BaseResp, error) {
+	if err := validateClaimMapping(l.Logger, in.ExtraConfig); err != nil {
+		return nil, err
+	}
+
 	// 🔐 加密client_secret (仅当提供新密钥时)
 	var encryptedSecret *string
 	var encryptionKeyID *string
@@ -45,7 +49,12 @@ func (l *UpdateOauthProviderLogic) UpdateOauthProvider(in *core.OauthProviderInf
 		in.ClientSecret = nil
 	}
 	
-	err := l.svcCtx.DB.OauthProvider.UpdateOneID(*in.Id).
+	old, err := l.svcCtx.DB.OauthProvider.Get(l.ctx, *in.Id)
+	if err != nil {
+		return nil, dberrorhandler.DefaultEntError(l.Logger, err, in)
+	}
+
+	p, err := l.svcCtx.DB.OauthProvider.UpdateOneID(*in.Id).
 		SetNotNilName(in.Name).
 		SetNotNilClientID(in.ClientId).
 		// SetNotNilClientSecret(in.ClientSecret). // ❌ 不再更新明文
@@ -74,14 +83,14 @@ func (l *UpdateOauthProviderLogic) UpdateOauthProvider(in *core.OauthProviderInf
 		SetNotNilLastUsedAt(typeconv.ConvertLastUsedAt(in.LastUsedAt)).
 		// Status field - use standard ent methods
 		SetNillableStatus(typeconv.ConvertStatus(in.Status)).
-		Exec(l.ctx)
+		Save(l.ctx)
 	if err != nil {
 		return nil, dberrorhandler.DefaultEntError(l.Logger, err, in)
 	}
 
-	if _, ok := providerConfig[*in.Name]; ok {
-		delete(providerConfig, *in.Name)
-	}
+	// 类型变化时旧类型的缓存也要清除
+	invalidateProvider(l.ctx, l.svcCtx, old)
+	invalidateProvider(l.ctx, l.svcCtx, p)
 
 	return &core.BaseResp{Msg: i18n.UpdateSuccess}, nil
 }
@@ -0,0 +1,51 @@
+package oauthprovidertemplate
+
+import (
+	"context"
+
+	"github.com/coder-lulu/newbee-common/v2/i18n"
+	"github.com/coder-lulu/newbee-common/v2/orm/ent/hooks"
+	"github.com/zeromicro/go-zero/core/errorx"
+
+	"github.com/coder-lulu/newbee-core/rpc/ent/oauthprovider"
+	"github.com/coder-lulu/newbee-core/rpc/ent/oauthprovidertemplate"
+	"github.com/coder-lulu/newbee-core/rpc/internal/svc"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/dberrorhandler"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type DeleteOauthProviderTemplateLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewDeleteOauthProviderTemplateLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DeleteOauthProviderTemplateLogic {
+	return &DeleteOauthProviderTemplateLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// DeleteOauthProviderTemplate refuses to delete the templates still enabled by any tenant
+func (l *DeleteOauthProviderTemplateLogic) DeleteOauthProviderTemplate(in *core.IDsReq) (*core.BaseResp, error) {
+	inUse, err := l.svcCtx.DB.OauthProvider.Query().
+		Where(oauthprovider.TemplateIDIn(in.Ids...)).
+		Exist(hooks.NewSystemContext(l.ctx))
+	if err != nil {
+		return nil, dberrorhandler.DefaultEntError(l.Logger, err, in)
+	}
+	if inUse {
+		return nil, errorx.NewInvalidArgumentError("oauth.templateInUse")
+	}
+
+	_, err = l.svcCtx.DB.OauthProviderTemplate.Delete().Where(oauthprovidertemplate.IDIn(in.Ids...)).Exec(l.ctx)
+	if err != nil {
+		return nil, dberrorhandler.DefaultEntError(l.Logger, err, in)
+	}
+
+	return &core.BaseResp{Msg: i18n.DeleteSuccess}, nil
+}
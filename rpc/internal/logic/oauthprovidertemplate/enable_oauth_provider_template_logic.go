@@ -0,0 +1,86 @@
+package oauthprovidertemplate
+
+import (
+	"context"
+
+	"github.com/coder-lulu/newbee-common/v2/enum/common"
+	"github.com/coder-lulu/newbee-common/v2/i18n"
+	"github.com/zeromicro/go-zero/core/errorx"
+
+	"github.com/coder-lulu/newbee-core/rpc/internal/svc"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/dberrorhandler"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type EnableOauthProviderTemplateLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewEnableOauthProviderTemplateLogic(ctx context.Context, svcCtx *svc.ServiceContext) *EnableOauthProviderTemplateLogic {
+	return &EnableOauthProviderTemplateLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// EnableOauthProviderTemplate creates a provider of the current tenant from the template with the tenant's
+// own credentials. The provider is tenant scoped, other tenants can neither see nor use it.
+func (l *EnableOauthProviderTemplateLogic) EnableOauthProviderTemplate(in *core.EnableOauthProviderTemplateReq) (*core.BaseIDResp, error) {
+	t, err := l.svcCtx.DB.OauthProviderTemplate.Get(l.ctx, in.TemplateId)
+	if err != nil {
+		return nil, dberrorhandler.DefaultEntError(l.Logger, err, in)
+	}
+	if t.Status != common.StatusNormal {
+		return nil, errorx.NewInvalidArgumentError("oauth.templateDisabled")
+	}
+
+	encrypted, keyID, err := l.svcCtx.EncryptionService.EncryptProviderSecret(in.ClientSecret)
+	if err != nil {
+		l.Logger.Errorw("failed to encrypt client secret", logx.Field("detail", err.Error()))
+		return nil, errorx.NewInternalError(i18n.Failed)
+	}
+
+	name, displayName, scopes := t.Name, t.DisplayName, t.Scopes
+	if in.GetName() != "" {
+		name = in.GetName()
+	}
+	if in.GetDisplayName() != "" {
+		displayName = in.GetDisplayName()
+	}
+	if in.Scopes != nil {
+		scopes = in.GetScopes()
+	}
+
+	result, err := l.svcCtx.DB.OauthProvider.Create().
+		SetTemplateID(t.ID).
+		SetName(name).
+		SetDisplayName(displayName).
+		SetType(t.Type).
+		SetProviderType(t.ProviderType).
+		SetClientID(in.ClientId).
+		SetClientSecret(""). // 不存储明文
+		SetEncryptedSecret(encrypted).
+		SetEncryptionKeyID(keyID).
+		SetRedirectURL(in.RedirectUrl).
+		SetScopes(scopes).
+		SetAuthURL(t.AuthURL).
+		SetTokenURL(t.TokenURL).
+		SetInfoURL(t.InfoURL).
+		SetAuthStyle(t.AuthStyle).
+		SetExtraConfig(t.ExtraConfig).
+		SetSupportPkce(t.SupportPkce).
+		SetIconURL(t.IconURL).
+		SetSort(t.Sort).
+		SetNillableEnabled(in.Enabled).
+		Save(l.ctx)
+	if err != nil {
+		return nil, dberrorhandler.DefaultEntError(l.Logger, err, in)
+	}
+
+	return &core.BaseIDResp{Id: result.ID, Msg: i18n.CreateSuccess}, nil
+}
@@ -0,0 +1,34 @@
+package oauthprovidertemplate
+
+import (
+	"context"
+
+	"github.com/coder-lulu/newbee-core/rpc/internal/svc"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/dberrorhandler"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetOauthProviderTemplateByIdLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetOauthProviderTemplateByIdLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetOauthProviderTemplateByIdLogic {
+	return &GetOauthProviderTemplateByIdLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *GetOauthProviderTemplateByIdLogic) GetOauthProviderTemplateById(in *core.IDReq) (*core.OauthProviderTemplateInfo, error) {
+	result, err := l.svcCtx.DB.OauthProviderTemplate.Get(l.ctx, in.Id)
+	if err != nil {
+		return nil, dberrorhandler.DefaultEntError(l.Logger, err, in)
+	}
+
+	return convertTemplate(result), nil
+}
@@ -0,0 +1,54 @@
+package oauthprovidertemplate
+
+import (
+	"context"
+
+	"github.com/coder-lulu/newbee-core/rpc/ent"
+	"github.com/coder-lulu/newbee-core/rpc/ent/oauthprovidertemplate"
+	"github.com/coder-lulu/newbee-core/rpc/ent/predicate"
+	"github.com/coder-lulu/newbee-core/rpc/internal/svc"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/dberrorhandler"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetOauthProviderTemplateListLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetOauthProviderTemplateListLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetOauthProviderTemplateListLogic {
+	return &GetOauthProviderTemplateListLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *GetOauthProviderTemplateListLogic) GetOauthProviderTemplateList(in *core.OauthProviderTemplateListReq) (*core.OauthProviderTemplateListResp, error) {
+	var predicates []predicate.OauthProviderTemplate
+	if in.Name != nil {
+		predicates = append(predicates, oauthprovidertemplate.NameContains(*in.Name))
+	}
+	if in.Type != nil {
+		predicates = append(predicates, oauthprovidertemplate.TypeEQ(*in.Type))
+	}
+
+	result, err := l.svcCtx.DB.OauthProviderTemplate.Query().Where(predicates...).Page(l.ctx, in.Page, in.PageSize, func(pager *ent.OauthProviderTemplatePager) {
+		pager.Order = ent.Asc(oauthprovidertemplate.FieldSort)
+	})
+	if err != nil {
+		return nil, dberrorhandler.DefaultEntError(l.Logger, err, in)
+	}
+
+	resp := &core.OauthProviderTemplateListResp{}
+	resp.Total = result.PageDetails.Total
+
+	for _, v := range result.List {
+		resp.Data = append(resp.Data, convertTemplate(v))
+	}
+
+	return resp, nil
+}
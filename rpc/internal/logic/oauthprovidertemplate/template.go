@@ -0,0 +1,102 @@
+package oauthprovidertemplate
+
+import (
+	"context"
+
+	"github.com/coder-lulu/newbee-common/v2/orm/ent/hooks"
+	"github.com/coder-lulu/newbee-common/v2/utils/pointy"
+	"github.com/zeromicro/go-zero/core/errorx"
+	"github.com/zeromicro/go-zero/core/logx"
+
+	"github.com/coder-lulu/newbee-core/rpc/ent"
+	"github.com/coder-lulu/newbee-core/rpc/ent/oauthprovider"
+	"github.com/coder-lulu/newbee-core/rpc/internal/interfaces"
+	"github.com/coder-lulu/newbee-core/rpc/internal/svc"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/claimmap"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/typeconv"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+)
+
+// validateClaimMapping checks the claim mapping of the extra config
+func validateClaimMapping(logger logx.Logger, extraConfig *string) error {
+	if extraConfig == nil || *extraConfig == "" {
+		return nil
+	}
+
+	extra := typeconv.ConvertExtraConfig(extraConfig)
+	if extra == nil {
+		return errorx.NewInvalidArgumentError("oauth.invalidClaimMapping")
+	}
+
+	if _, err := claimmap.FromExtraConfig(*extra); err != nil {
+		logger.Errorw("invalid claim mapping", logx.Field("detail", err.Error()))
+		return errorx.NewInvalidArgumentError("oauth.invalidClaimMapping")
+	}
+
+	return nil
+}
+
+// syncProviders copies the endpoints of the template to the providers enabled from it in all tenants.
+// Credentials, redirect URL, scopes and the extra config belong to the tenant and are kept.
+func syncProviders(ctx context.Context, svcCtx *svc.ServiceContext, logger logx.Logger, t *ent.OauthProviderTemplate) error {
+	systemCtx := hooks.NewSystemContext(ctx)
+
+	providers, err := svcCtx.DB.OauthProvider.Query().Where(oauthprovider.TemplateIDEQ(t.ID)).All(systemCtx)
+	if err != nil {
+		return err
+	}
+	if len(providers) == 0 {
+		return nil
+	}
+
+	err = svcCtx.DB.OauthProvider.Update().
+		Where(oauthprovider.TemplateIDEQ(t.ID)).
+		SetProviderType(t.ProviderType).
+		SetAuthURL(t.AuthURL).
+		SetTokenURL(t.TokenURL).
+		SetInfoURL(t.InfoURL).
+		SetAuthStyle(t.AuthStyle).
+		SetSupportPkce(t.SupportPkce).
+		SetIconURL(t.IconURL).
+		Exec(systemCtx)
+	if err != nil {
+		return err
+	}
+
+	for _, p := range providers {
+		invalidateProvider(ctx, svcCtx, p)
+	}
+
+	logger.Infow("synced OAuth provider template to tenant providers",
+		logx.Field("template", t.Name), logx.Field("providers", len(providers)))
+
+	return nil
+}
+
+// invalidateProvider removes the cached configuration and adapter of the provider
+func invalidateProvider(ctx context.Context, svcCtx *svc.ServiceContext, p *ent.OauthProvider) {
+	svcCtx.OAuthManager.InvalidateProvider(ctx, p.TenantID, interfaces.OAuthProviderType(p.Type), p.ID)
+}
+
+func convertTemplate(t *ent.OauthProviderTemplate) *core.OauthProviderTemplateInfo {
+	return &core.OauthProviderTemplateInfo{
+		Id:           &t.ID,
+		CreatedAt:    pointy.GetPointer(t.CreatedAt.UnixMilli()),
+		UpdatedAt:    pointy.GetPointer(t.UpdatedAt.UnixMilli()),
+		Status:       typeconv.ConvertStatusFromEnt(t.Status),
+		Name:         &t.Name,
+		DisplayName:  &t.DisplayName,
+		Type:         &t.Type,
+		ProviderType: &t.ProviderType,
+		Scopes:       &t.Scopes,
+		AuthUrl:      &t.AuthURL,
+		TokenUrl:     &t.TokenURL,
+		InfoUrl:      &t.InfoURL,
+		AuthStyle:    typeconv.ConvertAuthStyleFromEnt(t.AuthStyle),
+		ExtraConfig:  typeconv.ConvertExtraConfigFromEnt(t.ExtraConfig),
+		SupportPkce:  &t.SupportPkce,
+		IconUrl:      &t.IconURL,
+		Sort:         &t.Sort,
+		Remark:       &t.Remark,
+	}
+}
@@ -0,0 +1,62 @@
+package oauthprovidertemplate
+
+import (
+	"context"
+
+	"github.com/coder-lulu/newbee-common/v2/i18n"
+
+	"github.com/coder-lulu/newbee-core/rpc/internal/svc"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/dberrorhandler"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/typeconv"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type UpdateOauthProviderTemplateLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewUpdateOauthProviderTemplateLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UpdateOauthProviderTemplateLogic {
+	return &UpdateOauthProviderTemplateLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// UpdateOauthProviderTemplate updates the template and copies its endpoints to the providers enabled from it
+func (l *UpdateOauthProviderTemplateLogic) UpdateOauthProviderTemplate(in *core.OauthProviderTemplateInfo) (*core.BaseResp, error) {
+	if err := validateClaimMapping(l.Logger, in.ExtraConfig); err != nil {
+		return nil, err
+	}
+
+	result, err := l.svcCtx.DB.OauthProviderTemplate.UpdateOneID(in.GetId()).
+		SetNotNilName(in.Name).
+		SetNotNilDisplayName(in.DisplayName).
+		SetNotNilType(in.Type).
+		SetNotNilProviderType(in.ProviderType).
+		SetNotNilScopes(in.Scopes).
+		SetNotNilAuthURL(in.AuthUrl).
+		SetNotNilTokenURL(in.TokenUrl).
+		SetNotNilInfoURL(in.InfoUrl).
+		SetNotNilAuthStyle(typeconv.ConvertAuthStyle(in.AuthStyle)).
+		SetNotNilExtraConfig(typeconv.ConvertExtraConfig(in.ExtraConfig)).
+		SetNotNilSupportPkce(in.SupportPkce).
+		SetNotNilIconURL(in.IconUrl).
+		SetNotNilSort(in.Sort).
+		SetNotNilRemark(in.Remark).
+		SetNillableStatus(typeconv.ConvertStatus(in.Status)).
+		Save(l.ctx)
+	if err != nil {
+		return nil, dberrorhandler.DefaultEntError(l.Logger, err, in)
+	}
+
+	if err = syncProviders(l.ctx, l.svcCtx, l.Logger, result); err != nil {
+		return nil, dberrorhandler.DefaultEntError(l.Logger, err, in)
+	}
+
+	return &core.BaseResp{Msg: i18n.UpdateSuccess}, nil
+}
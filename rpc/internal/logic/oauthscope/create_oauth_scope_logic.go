@@ -0,0 +1,58 @@
+package oauthscope
+
+import (
+	"context"
+
+	"github.com/coder-lulu/newbee-common/v2/i18n"
+
+	"github.com/coder-lulu/newbee-core/rpc/ent"
+	"github.com/coder-lulu/newbee-core/rpc/internal/svc"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/dberrorhandler"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/entx"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/typeconv"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CreateOauthScopeLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewCreateOauthScopeLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CreateOauthScopeLogic {
+	return &CreateOauthScopeLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *CreateOauthScopeLogic) CreateOauthScope(in *core.OauthScopeInfo) (*core.BaseIDResp, error) {
+	if err := validateName(in.GetName()); err != nil {
+		return nil, err
+	}
+
+	var result *ent.OauthScope
+	err := entx.WithTx(l.ctx, l.svcCtx.DB, func(tx *ent.Tx) (err error) {
+		result, err = tx.OauthScope.Create().
+			SetNotNilName(in.Name).
+			SetNotNilDescription(in.Description).
+			SetAPIIds(in.ApiIds).
+			SetNillableStatus(typeconv.ConvertStatus(in.Status)).
+			Save(l.ctx)
+		if err != nil {
+			return err
+		}
+
+		return syncScopeRules(l.ctx, tx, result)
+	})
+	if err != nil {
+		return nil, dberrorhandler.DefaultEntError(l.Logger, err, in)
+	}
+
+	publishPolicyUpdate(l.ctx, l.svcCtx.Redis, result.TenantID)
+
+	return &core.BaseIDResp{Id: result.ID, Msg: i18n.CreateSuccess}, nil
+}
@@ -0,0 +1,78 @@
+package oauthscope
+
+import (
+	"context"
+	"slices"
+
+	"github.com/coder-lulu/newbee-common/v2/i18n"
+	"github.com/coder-lulu/newbee-common/v2/orm/ent/hooks"
+	"github.com/zeromicro/go-zero/core/errorx"
+
+	"github.com/coder-lulu/newbee-core/rpc/ent"
+	"github.com/coder-lulu/newbee-core/rpc/ent/oauthscope"
+	"github.com/coder-lulu/newbee-core/rpc/internal/svc"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/dberrorhandler"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/entx"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type DeleteOauthScopeLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewDeleteOauthScopeLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DeleteOauthScopeLogic {
+	return &DeleteOauthScopeLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// DeleteOauthScope refuses to delete the scopes still assigned to clients
+func (l *DeleteOauthScopeLogic) DeleteOauthScope(in *core.IDsReq) (*core.BaseResp, error) {
+	scopes, err := l.svcCtx.DB.OauthScope.Query().Where(oauthscope.IDIn(in.Ids...)).All(l.ctx)
+	if err != nil {
+		return nil, dberrorhandler.DefaultEntError(l.Logger, err, in)
+	}
+
+	clients, err := l.svcCtx.DB.OauthClient.Query().All(l.ctx)
+	if err != nil {
+		return nil, dberrorhandler.DefaultEntError(l.Logger, err, in)
+	}
+	for _, s := range scopes {
+		for _, c := range clients {
+			if c.TenantID == s.TenantID && slices.Contains(c.Scopes, s.Name) {
+				return nil, errorx.NewInvalidArgumentError("oauth2.scopeInUse")
+			}
+		}
+	}
+
+	err = entx.WithTx(l.ctx, l.svcCtx.DB, func(tx *ent.Tx) error {
+		systemCtx := hooks.NewSystemContext(l.ctx)
+		for _, s := range scopes {
+			if err := deleteScopeRules(systemCtx, tx, s.TenantID, s.Name); err != nil {
+				return err
+			}
+		}
+
+		_, err := tx.OauthScope.Delete().Where(oauthscope.IDIn(in.Ids...)).Exec(l.ctx)
+		return err
+	})
+	if err != nil {
+		return nil, dberrorhandler.DefaultEntError(l.Logger, err, in)
+	}
+
+	tenants := make(map[uint64]struct{})
+	for _, s := range scopes {
+		if _, ok := tenants[s.TenantID]; !ok {
+			tenants[s.TenantID] = struct{}{}
+			publishPolicyUpdate(l.ctx, l.svcCtx.Redis, s.TenantID)
+		}
+	}
+
+	return &core.BaseResp{Msg: i18n.DeleteSuccess}, nil
+}
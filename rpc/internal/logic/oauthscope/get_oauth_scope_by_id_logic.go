@@ -0,0 +1,34 @@
+package oauthscope
+
+import (
+	"context"
+
+	"github.com/coder-lulu/newbee-core/rpc/internal/svc"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/dberrorhandler"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetOauthScopeByIdLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetOauthScopeByIdLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetOauthScopeByIdLogic {
+	return &GetOauthScopeByIdLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *GetOauthScopeByIdLogic) GetOauthScopeById(in *core.IDReq) (*core.OauthScopeInfo, error) {
+	result, err := l.svcCtx.DB.OauthScope.Get(l.ctx, in.Id)
+	if err != nil {
+		return nil, dberrorhandler.DefaultEntError(l.Logger, err, in)
+	}
+
+	return convertScope(result), nil
+}
@@ -0,0 +1,54 @@
+package oauthscope
+
+import (
+	"context"
+
+	"github.com/coder-lulu/newbee-core/rpc/ent"
+	"github.com/coder-lulu/newbee-core/rpc/ent/oauthscope"
+	"github.com/coder-lulu/newbee-core/rpc/ent/predicate"
+	"github.com/coder-lulu/newbee-core/rpc/internal/svc"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/dberrorhandler"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetOauthScopeListLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetOauthScopeListLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetOauthScopeListLogic {
+	return &GetOauthScopeListLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *GetOauthScopeListLogic) GetOauthScopeList(in *core.OauthScopeListReq) (*core.OauthScopeListResp, error) {
+	var predicates []predicate.OauthScope
+	if in.Name != nil {
+		predicates = append(predicates, oauthscope.NameContains(*in.Name))
+	}
+	if len(in.Names) > 0 {
+		predicates = append(predicates, oauthscope.NameIn(in.Names...))
+	}
+
+	result, err := l.svcCtx.DB.OauthScope.Query().Where(predicates...).Page(l.ctx, in.Page, in.PageSize, func(pager *ent.OauthScopePager) {
+		pager.Order = ent.Asc(oauthscope.FieldName)
+	})
+	if err != nil {
+		return nil, dberrorhandler.DefaultEntError(l.Logger, err, in)
+	}
+
+	resp := &core.OauthScopeListResp{}
+	resp.Total = result.PageDetails.Total
+
+	for _, v := range result.List {
+		resp.Data = append(resp.Data, convertScope(v))
+	}
+
+	return resp, nil
+}
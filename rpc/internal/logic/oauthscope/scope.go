@@ -0,0 +1,107 @@
+package oauthscope
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+
+	"github.com/coder-lulu/newbee-common/v2/orm/ent/hooks"
+	"github.com/coder-lulu/newbee-common/v2/utils/pointy"
+	"github.com/redis/go-redis/v9"
+	"github.com/zeromicro/go-zero/core/errorx"
+	"github.com/zeromicro/go-zero/core/logx"
+
+	"github.com/coder-lulu/newbee-core/rpc/ent"
+	"github.com/coder-lulu/newbee-core/rpc/ent/api"
+	"github.com/coder-lulu/newbee-core/rpc/ent/casbinrule"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/typeconv"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+)
+
+// subjectPrefix 作用域在 Casbin 中的主体前缀，API 网关按令牌中的作用域校验 oauth_scope:<name>
+const subjectPrefix = "oauth_scope:"
+
+var scopeNamePattern = regexp.MustCompile(`^[a-z][a-z0-9_.:-]*$`)
+
+// openIDScopes are reserved by OpenID Connect
+var openIDScopes = []string{"openid", "profile", "email", "offline_access"}
+
+func validateName(name string) error {
+	if len(name) > 64 || !scopeNamePattern.MatchString(name) || slices.Contains(openIDScopes, name) {
+		return errorx.NewInvalidArgumentError("oauth2.invalidScopeName")
+	}
+	return nil
+}
+
+// syncScopeRules replaces the Casbin rules of the scope with the rules of its APIs
+func syncScopeRules(ctx context.Context, tx *ent.Tx, scope *ent.OauthScope) error {
+	// 使用SystemContext绕过租户Hook
+	systemCtx := hooks.NewSystemContext(ctx)
+
+	if err := deleteScopeRules(systemCtx, tx, scope.TenantID, scope.Name); err != nil {
+		return err
+	}
+	if len(scope.APIIds) == 0 {
+		return nil
+	}
+
+	apis, err := tx.API.Query().Where(api.IDIn(scope.APIIds...)).All(ctx)
+	if err != nil {
+		return err
+	}
+
+	bulk := make([]*ent.CasbinRuleCreate, 0, len(apis))
+	for _, v := range apis {
+		bulk = append(bulk, tx.CasbinRule.Create().
+			SetPtype("p").
+			SetV0(subjectPrefix+scope.Name).
+			SetV1(fmt.Sprintf("%d", scope.TenantID)).
+			SetV2(v.Path).
+			SetV3(v.Method).
+			SetV4("allow").
+			SetServiceName(v.ServiceName).
+			SetRuleName(fmt.Sprintf("OAuth scope %s access to %s %s", scope.Name, v.Method, v.Path)).
+			SetDescription(fmt.Sprintf("OAuth作用域%s的接口权限", scope.Name)).
+			SetCategory("oauth").
+			SetVersion("1.0.0").
+			SetRequireApproval(false).
+			SetApprovalStatus("approved").
+			SetStatus(1).
+			SetTenantID(scope.TenantID))
+	}
+
+	return tx.CasbinRule.CreateBulk(bulk...).Exec(systemCtx)
+}
+
+func deleteScopeRules(systemCtx context.Context, tx *ent.Tx, tenantID uint64, name string) error {
+	_, err := tx.CasbinRule.Delete().
+		Where(
+			casbinrule.PtypeEQ("p"),
+			casbinrule.V0EQ(subjectPrefix+name),
+			casbinrule.TenantIDEQ(tenantID),
+		).
+		Exec(systemCtx)
+	return err
+}
+
+// publishPolicyUpdate notifies the services to reload the policies, the rules are already saved when it fails
+func publishPolicyUpdate(ctx context.Context, rds redis.UniversalClient, tenantID uint64) {
+	updateMsg := fmt.Sprintf("UpdatePolicy:tenant_%d:oauth_scope", tenantID)
+	if err := rds.Publish(ctx, "casbin_watcher", updateMsg).Err(); err != nil {
+		logx.Errorw("failed to publish oauth scope policy update notification", logx.Field("error", err.Error()))
+	}
+}
+
+func convertScope(s *ent.OauthScope) *core.OauthScopeInfo {
+	return &core.OauthScopeInfo{
+		Id:          &s.ID,
+		CreatedAt:   pointy.GetPointer(s.CreatedAt.UnixMilli()),
+		UpdatedAt:   pointy.GetPointer(s.UpdatedAt.UnixMilli()),
+		Status:      typeconv.ConvertStatusFromEnt(s.Status),
+		TenantId:    &s.TenantID,
+		Name:        &s.Name,
+		Description: &s.Description,
+		ApiIds:      s.APIIds,
+	}
+}
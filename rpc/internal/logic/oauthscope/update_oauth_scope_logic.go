@@ -0,0 +1,56 @@
+package oauthscope
+
+import (
+	"context"
+
+	"github.com/coder-lulu/newbee-common/v2/i18n"
+
+	"github.com/coder-lulu/newbee-core/rpc/ent"
+	"github.com/coder-lulu/newbee-core/rpc/internal/svc"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/dberrorhandler"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/entx"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/typeconv"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type UpdateOauthScopeLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewUpdateOauthScopeLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UpdateOauthScopeLogic {
+	return &UpdateOauthScopeLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// UpdateOauthScope can not rename the scope, the clients and issued tokens refer to it by name
+func (l *UpdateOauthScopeLogic) UpdateOauthScope(in *core.OauthScopeInfo) (*core.BaseResp, error) {
+	var result *ent.OauthScope
+	err := entx.WithTx(l.ctx, l.svcCtx.DB, func(tx *ent.Tx) (err error) {
+		query := tx.OauthScope.UpdateOneID(in.GetId()).
+			SetNotNilDescription(in.Description).
+			SetNillableStatus(typeconv.ConvertStatus(in.Status))
+		if in.ApiIds != nil {
+			query.SetAPIIds(in.ApiIds)
+		}
+
+		if result, err = query.Save(l.ctx); err != nil {
+			return err
+		}
+
+		return syncScopeRules(l.ctx, tx, result)
+	})
+	if err != nil {
+		return nil, dberrorhandler.DefaultEntError(l.Logger, err, in)
+	}
+
+	publishPolicyUpdate(l.ctx, l.svcCtx.Redis, result.TenantID)
+
+	return &core.BaseResp{Msg: i18n.UpdateSuccess}, nil
+}
@@ -3,6 +3,8 @@ package role
 import (
 	"context"
 
+	"entgo.io/ent/dialect/sql"
+
 	"github.com/coder-lulu/newbee-common/v2/utils/uuidx"
 	"github.com/coder-lulu/newbee-core/rpc/ent"
 	"github.com/coder-lulu/newbee-core/rpc/ent/role"
@@ -33,7 +35,10 @@ func NewAddAuthLogic(ctx context.Context, svcCtx *svc.ServiceContext) *AddAuthLo
 
 func (l *AddAuthLogic) AddAuth(in *core.RoleAuthReq) (*core.BaseResp, error) {
 	err := entx.WithTx(l.ctx, l.svcCtx.DB, func(tx *ent.Tx) error {
-		_, err := l.svcCtx.DB.Role.Query().Where(role.IDEQ(in.RoleId)).First(l.ctx)
+		// 与 deleteRoleWithUsers 锁定同一行，角色删除后不会留下授权
+		_, err := tx.Role.Query().Where(role.IDEQ(in.RoleId)).
+			Modify(func(s *sql.Selector) { s.ForUpdate() }).
+			First(l.ctx)
 		if err != nil {
 			return dberrorhandler.DefaultEntError(l.Logger, err, in)
 		}
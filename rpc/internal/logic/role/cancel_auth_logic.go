@@ -43,7 +43,7 @@ func (l *CancelAuthLogic) CancelAuth(in *core.RoleAuthReq) (*core.BaseResp, erro
 			for _, v := range in.UserIds {
 				ids = append(ids, uuidx.ParseUUIDString(v))
 			}
-			tx.Role.UpdateOneID(in.RoleId).RemoveUserIDs(ids...).Exec(l.ctx)
+			err = tx.Role.UpdateOneID(in.RoleId).RemoveUserIDs(ids...).Exec(l.ctx)
 			if err != nil {
 				return dberrorhandler.DefaultEntError(l.Logger, err, in)
 			}
@@ -30,6 +30,7 @@ func NewCreateRoleLogic(ctx context.Context, svcCtx *svc.ServiceContext) *Create
 }
 
 func (l *CreateRoleLogic) CreateRole(in *core.RoleInfo) (*core.BaseIDResp, error) {
+	var roleID uint64
 	err := entx.WithTx(l.ctx, l.svcCtx.DB, func(tx *ent.Tx) error {
 		result, err := tx.Role.Create().
 			SetNotNilStatus(pointy.GetStatusPointer(in.Status)).
@@ -44,6 +45,7 @@ func (l *CreateRoleLogic) CreateRole(in *core.RoleInfo) (*core.BaseIDResp, error
 		if err != nil {
 			return err
 		}
+		roleID = result.ID
 
 		// 菜单授权。原authority接口不再调用
 		err = tx.Role.UpdateOneID(result.ID).ClearMenus().Exec(l.ctx)
@@ -62,5 +64,5 @@ func (l *CreateRoleLogic) CreateRole(in *core.RoleInfo) (*core.BaseIDResp, error
 	if err != nil {
 		return nil, dberrorhandler.DefaultEntError(l.Logger, err, in)
 	}
-	return &core.BaseIDResp{Id: 0, Msg: i18n.CreateSuccess}, nil
+	return &core.BaseIDResp{Id: roleID, Msg: i18n.CreateSuccess}, nil
 }
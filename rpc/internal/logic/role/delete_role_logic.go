@@ -5,12 +5,16 @@ import (
 	"fmt"
 
 	"github.com/coder-lulu/newbee-common/v2/config"
+	"github.com/coder-lulu/newbee-common/v2/orm/ent/hooks"
 
 	"github.com/coder-lulu/newbee-core/rpc/internal/utils/redisfunc"
 
 	"github.com/zeromicro/go-zero/core/errorx"
 
+	"github.com/coder-lulu/newbee-core/rpc/ent"
+	"github.com/coder-lulu/newbee-core/rpc/ent/casbinrule"
 	"github.com/coder-lulu/newbee-core/rpc/ent/role"
+	"github.com/coder-lulu/newbee-core/rpc/internal/casbin"
 	"github.com/coder-lulu/newbee-core/rpc/internal/svc"
 	"github.com/coder-lulu/newbee-core/rpc/internal/utils/dberrorhandler"
 	"github.com/coder-lulu/newbee-core/rpc/types/core"
@@ -43,11 +47,15 @@ func (l *DeleteRoleLogic) DeleteRole(in *core.IDsReq) (*core.BaseResp, error) {
 		return nil, errorx.NewInvalidArgumentError("role.userExists")
 	}
 
-	_, err = l.svcCtx.DB.Role.Delete().Where(role.IDIn(in.Ids...)).Exec(l.ctx)
+	roles, err := l.svcCtx.DB.Role.Query().Where(role.IDIn(in.Ids...)).All(l.ctx)
 	if err != nil {
 		return nil, dberrorhandler.DefaultEntError(l.Logger, err, in)
 	}
 
+	if err = deleteRoles(l.ctx, l.svcCtx.DB, roles); err != nil {
+		return nil, dberrorhandler.DefaultEntError(l.Logger, err, in)
+	}
+
 	err = redisfunc.RemoveAllKeyByPrefix(l.ctx, fmt.Sprintf("%sROLE", config.RedisDataPermissionPrefix), l.svcCtx.Redis)
 	if err != nil {
 		return nil, err
@@ -55,3 +63,30 @@ func (l *DeleteRoleLogic) DeleteRole(in *core.IDsReq) (*core.BaseResp, error) {
 
 	return &core.BaseResp{Msg: i18n.DeleteSuccess}, nil
 }
+
+// deleteRoles deletes the roles and the inheritance relations where they are the child or the parent
+func deleteRoles(ctx context.Context, db *ent.Client, roles []*ent.Role) error {
+	ids := make([]uint64, 0, len(roles))
+	for _, r := range roles {
+		ids = append(ids, r.ID)
+	}
+	if _, err := db.Role.Delete().Where(role.IDIn(ids...)).Exec(ctx); err != nil {
+		return err
+	}
+
+	for _, r := range roles {
+		_, err := db.CasbinRule.Delete().
+			Where(
+				casbinrule.TenantIDEQ(r.TenantID),
+				casbinrule.PtypeEQ("g"),
+				casbinrule.CategoryEQ(casbin.RoleInheritanceCategory),
+				casbinrule.Or(casbinrule.V0EQ(r.Code), casbinrule.V1EQ(r.Code)),
+			).
+			Exec(hooks.NewSystemContext(ctx))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
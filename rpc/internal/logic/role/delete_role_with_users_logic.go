@@ -0,0 +1,65 @@
+package role
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/coder-lulu/newbee-common/v2/config"
+	"github.com/coder-lulu/newbee-common/v2/i18n"
+
+	"github.com/coder-lulu/newbee-core/rpc/ent"
+	"github.com/coder-lulu/newbee-core/rpc/ent/role"
+	"github.com/coder-lulu/newbee-core/rpc/internal/svc"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/dberrorhandler"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/entx"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/redisfunc"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type DeleteRoleWithUsersLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewDeleteRoleWithUsersLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DeleteRoleWithUsersLogic {
+	return &DeleteRoleWithUsersLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// DeleteRoleWithUsers removes the role from its users and deletes it in one transaction, used by
+// SCIM clients which delete groups that still have members
+func (l *DeleteRoleWithUsersLogic) DeleteRoleWithUsers(in *core.IDReq) (*core.BaseResp, error) {
+	err := entx.WithTx(l.ctx, l.svcCtx.DB, func(tx *ent.Tx) error {
+		// 锁定角色行，与 addAuth 串行，提交前不会再有用户被授予该角色
+		r, err := tx.Role.Query().
+			Where(role.IDEQ(in.Id)).
+			Modify(func(s *sql.Selector) { s.ForUpdate() }).
+			Only(l.ctx)
+		if err != nil {
+			return err
+		}
+
+		if err = tx.Role.UpdateOneID(r.ID).ClearUsers().Exec(l.ctx); err != nil {
+			return err
+		}
+
+		return deleteRoles(l.ctx, tx.Client(), []*ent.Role{r})
+	})
+	if err != nil {
+		return nil, dberrorhandler.DefaultEntError(l.Logger, err, in)
+	}
+
+	err = redisfunc.RemoveAllKeyByPrefix(l.ctx, fmt.Sprintf("%sROLE", config.RedisDataPermissionPrefix), l.svcCtx.Redis)
+	if err != nil {
+		return nil, err
+	}
+
+	return &core.BaseResp{Msg: i18n.DeleteSuccess}, nil
+}
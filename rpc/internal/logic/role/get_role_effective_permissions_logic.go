@@ -0,0 +1,93 @@
+package role
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/coder-lulu/newbee-common/v2/orm/ent/hooks"
+	"github.com/coder-lulu/newbee-core/rpc/ent"
+	"github.com/coder-lulu/newbee-core/rpc/ent/casbinrule"
+	"github.com/coder-lulu/newbee-core/rpc/ent/menu"
+	"github.com/coder-lulu/newbee-core/rpc/ent/role"
+	"github.com/coder-lulu/newbee-core/rpc/internal/casbin"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/dberrorhandler"
+
+	"github.com/coder-lulu/newbee-core/rpc/internal/svc"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetRoleEffectivePermissionsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetRoleEffectivePermissionsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetRoleEffectivePermissionsLogic {
+	return &GetRoleEffectivePermissionsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// GetRoleEffectivePermissions flattens the inheritance of the role and returns the API permissions and menus
+// granted by the role itself and all its ancestors
+func (l *GetRoleEffectivePermissionsLogic) GetRoleEffectivePermissions(in *core.IDReq) (*core.RoleEffectivePermissionsResp, error) {
+	target, err := l.svcCtx.DB.Role.Get(l.ctx, in.Id)
+	if err != nil {
+		return nil, dberrorhandler.DefaultEntError(l.Logger, err, in)
+	}
+
+	codes, err := casbin.EffectiveRoles(l.ctx, l.svcCtx.DB, target.TenantID, target.Code)
+	if err != nil {
+		return nil, dberrorhandler.DefaultEntError(l.Logger, err, in)
+	}
+
+	rules, err := l.svcCtx.DB.CasbinRule.Query().
+		Where(
+			casbinrule.TenantIDEQ(target.TenantID),
+			casbinrule.PtypeEQ("p"),
+			casbinrule.V0In(codes...),
+			casbinrule.StatusEQ(1),
+		).
+		All(hooks.NewSystemContext(l.ctx))
+	if err != nil {
+		return nil, dberrorhandler.DefaultEntError(l.Logger, err, in)
+	}
+
+	// 同一接口由多个角色授予时，记录继承链上最近的角色
+	slices.SortStableFunc(rules, func(a, b *ent.CasbinRule) int {
+		return slices.Index(codes, a.V0) - slices.Index(codes, b.V0)
+	})
+
+	resp := &core.RoleEffectivePermissionsResp{RoleCodes: codes}
+	seen := make(map[string]bool, len(rules))
+	for _, r := range rules {
+		key := strings.ToUpper(r.V3) + " " + r.V2
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		resp.Apis = append(resp.Apis, &core.RoleEffectiveApi{
+			Path:       r.V2,
+			Method:     r.V3,
+			SourceRole: r.V0,
+		})
+	}
+
+	menuIds, err := l.svcCtx.DB.Role.Query().
+		Where(role.CodeIn(codes...), role.TenantIDEQ(target.TenantID), role.StatusEQ(1)).
+		QueryMenus().
+		Where(menu.Disabled(false)).
+		Order(ent.Asc(menu.FieldSort)).
+		IDs(l.ctx)
+	if err != nil {
+		return nil, dberrorhandler.DefaultEntError(l.Logger, err, in)
+	}
+	resp.MenuIds = menuIds
+
+	return resp, nil
+}
@@ -41,6 +41,13 @@ func (l *GetRoleListLogic) GetRoleList(in *core.RoleListReq) (*core.RoleListResp
 	if in.DefaultRouter != nil && *in.DefaultRouter != "" {
 		predicates = append(predicates, role.DefaultRouterContains(*in.DefaultRouter))
 	}
+	if len(in.CodePrefixes) > 0 || len(in.Codes) > 0 {
+		scope := []predicate.Role{role.CodeIn(in.Codes...)}
+		for _, v := range in.CodePrefixes {
+			scope = append(scope, role.CodeHasPrefix(v))
+		}
+		predicates = append(predicates, role.Or(scope...))
+	}
 
 	result, err := l.svcCtx.DB.Role.Query().Where(predicates...).Page(l.ctx, in.Page, in.PageSize, func(pager *ent.RolePager) {
 		pager.Order = ent.Asc(role.FieldSort)
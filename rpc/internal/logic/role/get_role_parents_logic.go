@@ -0,0 +1,72 @@
+package role
+
+import (
+	"context"
+
+	"github.com/coder-lulu/newbee-common/v2/utils/pointy"
+	"github.com/coder-lulu/newbee-core/rpc/ent"
+	"github.com/coder-lulu/newbee-core/rpc/ent/role"
+	"github.com/coder-lulu/newbee-core/rpc/internal/casbin"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/dberrorhandler"
+
+	"github.com/coder-lulu/newbee-core/rpc/internal/svc"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetRoleParentsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetRoleParentsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetRoleParentsLogic {
+	return &GetRoleParentsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// GetRoleParents returns the direct parent roles of the role
+func (l *GetRoleParentsLogic) GetRoleParents(in *core.IDReq) (*core.RoleParentsResp, error) {
+	target, err := l.svcCtx.DB.Role.Get(l.ctx, in.Id)
+	if err != nil {
+		return nil, dberrorhandler.DefaultEntError(l.Logger, err, in)
+	}
+
+	parents, err := casbin.RoleParents(l.ctx, l.svcCtx.DB, target.TenantID)
+	if err != nil {
+		return nil, dberrorhandler.DefaultEntError(l.Logger, err, in)
+	}
+
+	resp := &core.RoleParentsResp{}
+	if len(parents[target.Code]) == 0 {
+		return resp, nil
+	}
+
+	roles, err := l.svcCtx.DB.Role.Query().
+		Where(role.CodeIn(parents[target.Code]...), role.TenantIDEQ(target.TenantID)).
+		Order(ent.Asc(role.FieldSort)).
+		All(l.ctx)
+	if err != nil {
+		return nil, dberrorhandler.DefaultEntError(l.Logger, err, in)
+	}
+
+	for _, v := range roles {
+		resp.Data = append(resp.Data, &core.RoleInfo{
+			Id:            &v.ID,
+			CreatedAt:     pointy.GetPointer(v.CreatedAt.UnixMilli()),
+			UpdatedAt:     pointy.GetPointer(v.UpdatedAt.UnixMilli()),
+			Status:        pointy.GetPointer(uint32(v.Status)),
+			Name:          &v.Name,
+			Code:          &v.Code,
+			DefaultRouter: &v.DefaultRouter,
+			Remark:        &v.Remark,
+			Sort:          &v.Sort,
+		})
+	}
+
+	return resp, nil
+}
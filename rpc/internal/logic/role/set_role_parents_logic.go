@@ -0,0 +1,129 @@
+package role
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/coder-lulu/newbee-common/v2/i18n"
+	"github.com/coder-lulu/newbee-common/v2/orm/ent/hooks"
+	"github.com/coder-lulu/newbee-core/rpc/ent"
+	"github.com/coder-lulu/newbee-core/rpc/ent/casbinrule"
+	"github.com/coder-lulu/newbee-core/rpc/ent/role"
+	"github.com/coder-lulu/newbee-core/rpc/internal/casbin"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/dberrorhandler"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/entx"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/redisfunc"
+
+	"github.com/coder-lulu/newbee-core/rpc/internal/svc"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/errorx"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// errInheritanceCycle 设置的父角色会形成继承环
+var errInheritanceCycle = errors.New("role inheritance cycle")
+
+type SetRoleParentsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewSetRoleParentsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SetRoleParentsLogic {
+	return &SetRoleParentsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// SetRoleParents replaces the parent roles of the role, the role inherits the API permissions and menus of its ancestors
+func (l *SetRoleParentsLogic) SetRoleParents(in *core.RoleParentsReq) (*core.BaseResp, error) {
+	parentIds := slices.Compact(slices.Sorted(slices.Values(in.ParentIds)))
+	if slices.Contains(parentIds, in.RoleId) {
+		return nil, errorx.NewInvalidArgumentError("role.inheritanceCycle")
+	}
+
+	target, err := l.svcCtx.DB.Role.Get(l.ctx, in.RoleId)
+	if err != nil {
+		return nil, dberrorhandler.DefaultEntError(l.Logger, err, in)
+	}
+
+	var parentCodes []string
+	if len(parentIds) > 0 {
+		parents, err := l.svcCtx.DB.Role.Query().
+			Where(role.IDIn(parentIds...), role.TenantIDEQ(target.TenantID)).
+			All(l.ctx)
+		if err != nil {
+			return nil, dberrorhandler.DefaultEntError(l.Logger, err, in)
+		}
+		if len(parents) != len(parentIds) {
+			return nil, errorx.NewInvalidArgumentError("role.parentNotFound")
+		}
+		for _, p := range parents {
+			parentCodes = append(parentCodes, p.Code)
+		}
+	}
+
+	err = entx.WithTx(l.ctx, l.svcCtx.DB, func(tx *ent.Tx) error {
+		systemCtx := hooks.NewSystemContext(l.ctx)
+
+		// 先锁定租户的角色再读取继承关系，并发的修改依次执行，避免形成继承环
+		if err := casbin.LockRoles(l.ctx, tx.Client(), target.TenantID); err != nil {
+			return err
+		}
+		existing, err := casbin.RoleParents(l.ctx, tx.Client(), target.TenantID)
+		if err != nil {
+			return err
+		}
+		if casbin.CreatesCycle(existing, target.Code, parentCodes) {
+			return errInheritanceCycle
+		}
+
+		_, err = tx.CasbinRule.Delete().
+			Where(
+				casbinrule.TenantIDEQ(target.TenantID),
+				casbinrule.PtypeEQ("g"),
+				casbinrule.CategoryEQ(casbin.RoleInheritanceCategory),
+				casbinrule.V0EQ(target.Code),
+			).
+			Exec(systemCtx)
+		if err != nil {
+			return err
+		}
+
+		domain := fmt.Sprintf("%d", target.TenantID)
+		creates := make([]*ent.CasbinRuleCreate, 0, len(parentCodes))
+		for _, parent := range parentCodes {
+			creates = append(creates, tx.CasbinRule.Create().
+				SetPtype("g").
+				SetV0(target.Code). // 子角色
+				SetV1(parent).      // 父角色
+				SetV2(domain).
+				SetServiceName("core").
+				SetRuleName(fmt.Sprintf("%s继承%s", target.Code, parent)).
+				SetCategory(casbin.RoleInheritanceCategory).
+				SetStatus(1).
+				SetTenantID(target.TenantID))
+		}
+
+		return tx.CasbinRule.CreateBulk(creates...).Exec(systemCtx)
+	})
+	if errors.Is(err, errInheritanceCycle) {
+		return nil, errorx.NewInvalidArgumentError("role.inheritanceCycle")
+	}
+	if err != nil {
+		return nil, dberrorhandler.DefaultEntError(l.Logger, err, in)
+	}
+
+	if err = redisfunc.PublishCasbinReload(l.ctx, l.svcCtx.Redis, l.svcCtx.Config.RedisConf.Db, target.TenantID, "role_inheritance"); err != nil {
+		l.Logger.Errorw("failed to publish casbin reload after role parents change",
+			logx.Field("role_id", in.RoleId),
+			logx.Field("error", err.Error()))
+	}
+
+	return &core.BaseResp{Msg: i18n.UpdateSuccess}, nil
+}
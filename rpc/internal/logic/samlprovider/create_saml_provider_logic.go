@@ -0,0 +1,79 @@
+package samlprovider
+
+import (
+	"context"
+
+	"github.com/coder-lulu/newbee-common/v2/i18n"
+	"github.com/zeromicro/go-zero/core/errorx"
+
+	"github.com/coder-lulu/newbee-core/rpc/internal/saml"
+	"github.com/coder-lulu/newbee-core/rpc/internal/svc"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/dberrorhandler"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/typeconv"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CreateSamlProviderLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewCreateSamlProviderLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CreateSamlProviderLogic {
+	return &CreateSamlProviderLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *CreateSamlProviderLogic) CreateSamlProvider(in *core.SamlProviderInfo) (*core.BaseIDResp, error) {
+	if err := validateProvider(l.Logger, in); err != nil {
+		return nil, err
+	}
+
+	// 未填写 IdP 信息时从元数据地址导入
+	if (in.IdpEntityId == nil || *in.IdpEntityId == "") && in.IdpMetadataUrl != nil && *in.IdpMetadataUrl != "" {
+		idp, err := loadIdPMetadata(l.ctx, l.Logger, "", *in.IdpMetadataUrl, "")
+		if err != nil {
+			return nil, err
+		}
+		certs := saml.EncodeCertificates(idp.Certs)
+		in.IdpEntityId, in.IdpSsoUrl, in.IdpSloUrl, in.IdpCertificates = &idp.EntityID, &idp.SSOURL, &idp.SLOURL, &certs
+	}
+	if in.IdpCertificates == nil || *in.IdpCertificates == "" {
+		return nil, errorx.NewInvalidArgumentError("saml.invalidCertificate")
+	}
+
+	result, err := l.svcCtx.DB.SamlProvider.Create().
+		SetNotNilName(in.Name).
+		SetNotNilDisplayName(in.DisplayName).
+		SetNotNilIdpEntityID(in.IdpEntityId).
+		SetNotNilIdpSSOURL(in.IdpSsoUrl).
+		SetNotNilIdpSloURL(in.IdpSloUrl).
+		SetNotNilIdpCertificates(in.IdpCertificates).
+		SetNotNilIdpMetadataURL(in.IdpMetadataUrl).
+		SetNotNilSpEntityID(in.SpEntityId).
+		SetNotNilAcsURL(in.AcsUrl).
+		SetNotNilNameIDFormat(in.NameIdFormat).
+		SetNotNilSignAuthnRequest(in.SignAuthnRequest).
+		SetNotNilWantAssertionsSigned(in.WantAssertionsSigned).
+		SetNotNilAttributeMapping(typeconv.ConvertExtraConfig(in.AttributeMapping)).
+		SetNotNilJitProvision(in.JitProvision).
+		SetNotNilSyncAttributes(in.SyncAttributes).
+		SetNotNilLinkByEmail(in.LinkByEmail).
+		SetNotNilDefaultDepartmentID(in.DefaultDepartmentId).
+		SetDefaultRoleIds(in.DefaultRoleIds).
+		SetNotNilEnabled(in.Enabled).
+		SetNotNilSort(in.Sort).
+		SetNotNilRemark(in.Remark).
+		SetNillableStatus(typeconv.ConvertStatus(in.Status)).
+		Save(l.ctx)
+	if err != nil {
+		return nil, dberrorhandler.DefaultEntError(l.Logger, err, in)
+	}
+
+	return &core.BaseIDResp{Id: result.ID, Msg: i18n.CreateSuccess}, nil
+}
@@ -0,0 +1,48 @@
+package samlprovider
+
+import (
+	"context"
+
+	"github.com/coder-lulu/newbee-core/rpc/ent"
+	"github.com/coder-lulu/newbee-core/rpc/ent/samlaccount"
+	"github.com/coder-lulu/newbee-core/rpc/ent/samlprovider"
+
+	"github.com/coder-lulu/newbee-core/rpc/internal/svc"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/dberrorhandler"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/entx"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+
+	"github.com/coder-lulu/newbee-common/v2/i18n"
+)
+
+type DeleteSamlProviderLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewDeleteSamlProviderLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DeleteSamlProviderLogic {
+	return &DeleteSamlProviderLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *DeleteSamlProviderLogic) DeleteSamlProvider(in *core.IDsReq) (*core.BaseResp, error) {
+	// 身份源删除后绑定关系随之删除
+	err := entx.WithTx(l.ctx, l.svcCtx.DB, func(tx *ent.Tx) error {
+		if _, err := tx.SamlAccount.Delete().Where(samlaccount.ProviderIDIn(in.Ids...)).Exec(l.ctx); err != nil {
+			return err
+		}
+		_, err := tx.SamlProvider.Delete().Where(samlprovider.IDIn(in.Ids...)).Exec(l.ctx)
+		return err
+	})
+	if err != nil {
+		return nil, dberrorhandler.DefaultEntError(l.Logger, err, in)
+	}
+
+	return &core.BaseResp{Msg: i18n.DeleteSuccess}, nil
+}
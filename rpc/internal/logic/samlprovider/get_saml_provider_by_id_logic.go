@@ -0,0 +1,34 @@
+package samlprovider
+
+import (
+	"context"
+
+	"github.com/coder-lulu/newbee-core/rpc/internal/svc"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/dberrorhandler"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetSamlProviderByIdLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetSamlProviderByIdLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetSamlProviderByIdLogic {
+	return &GetSamlProviderByIdLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *GetSamlProviderByIdLogic) GetSamlProviderById(in *core.IDReq) (*core.SamlProviderInfo, error) {
+	result, err := l.svcCtx.DB.SamlProvider.Get(l.ctx, in.Id)
+	if err != nil {
+		return nil, dberrorhandler.DefaultEntError(l.Logger, err, in)
+	}
+
+	return convertProvider(result), nil
+}
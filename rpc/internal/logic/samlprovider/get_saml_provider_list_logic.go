@@ -0,0 +1,50 @@
+package samlprovider
+
+import (
+	"context"
+
+	"github.com/coder-lulu/newbee-core/rpc/ent/predicate"
+	"github.com/coder-lulu/newbee-core/rpc/ent/samlprovider"
+
+	"github.com/coder-lulu/newbee-core/rpc/internal/svc"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/dberrorhandler"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetSamlProviderListLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetSamlProviderListLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetSamlProviderListLogic {
+	return &GetSamlProviderListLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *GetSamlProviderListLogic) GetSamlProviderList(in *core.SamlProviderListReq) (*core.SamlProviderListResp, error) {
+	var predicates []predicate.SamlProvider
+	if in.Name != nil {
+		predicates = append(predicates, samlprovider.NameContains(*in.Name))
+	}
+	result, err := l.svcCtx.DB.SamlProvider.Query().Where(predicates...).
+		Order(samlprovider.BySort()).
+		Page(l.ctx, in.Page, in.PageSize)
+	if err != nil {
+		return nil, dberrorhandler.DefaultEntError(l.Logger, err, in)
+	}
+
+	resp := &core.SamlProviderListResp{}
+	resp.Total = result.PageDetails.Total
+
+	for _, v := range result.List {
+		resp.Data = append(resp.Data, convertProvider(v))
+	}
+
+	return resp, nil
+}
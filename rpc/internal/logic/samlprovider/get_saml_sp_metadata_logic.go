@@ -0,0 +1,42 @@
+package samlprovider
+
+import (
+	"context"
+
+	"github.com/zeromicro/go-zero/core/errorx"
+
+	"github.com/coder-lulu/newbee-core/rpc/internal/svc"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetSamlSpMetadataLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetSamlSpMetadataLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetSamlSpMetadataLogic {
+	return &GetSamlSpMetadataLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// GetSamlSpMetadata returns the SP metadata of the provider to be imported into the IdP
+func (l *GetSamlSpMetadataLogic) GetSamlSpMetadata(in *core.SamlSpMetadataReq) (*core.SamlSpMetadataResp, error) {
+	p, _, err := enabledProvider(l.ctx, l.svcCtx, l.Logger, in.ProviderId)
+	if err != nil {
+		return nil, err
+	}
+
+	sp, err := serviceProvider(l.svcCtx, p)
+	if err != nil {
+		l.Logger.Errorw("invalid SAML provider", logx.Field("detail", err.Error()), logx.Field("provider", p.ID))
+		return nil, errorx.NewInternalError("saml.providerMisconfigured")
+	}
+
+	return &core.SamlSpMetadataResp{Metadata: string(sp.Metadata())}, nil
+}
@@ -0,0 +1,74 @@
+package samlprovider
+
+import (
+	"context"
+
+	"github.com/coder-lulu/newbee-core/rpc/internal/saml"
+	"github.com/coder-lulu/newbee-core/rpc/internal/svc"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/dberrorhandler"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+
+	"github.com/coder-lulu/newbee-common/v2/i18n"
+)
+
+type ImportSamlIdpMetadataLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewImportSamlIdpMetadataLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ImportSamlIdpMetadataLogic {
+	return &ImportSamlIdpMetadataLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// ImportSamlIdpMetadata replaces the IdP entity ID, endpoints and certificates of the provider
+// with the ones from the metadata. Certificates are rotated by importing the new metadata.
+func (l *ImportSamlIdpMetadataLogic) ImportSamlIdpMetadata(in *core.SamlMetadataImportReq) (*core.BaseResp, error) {
+	p, err := l.svcCtx.DB.SamlProvider.Get(l.ctx, in.Id)
+	if err != nil {
+		return nil, dberrorhandler.DefaultEntError(l.Logger, err, in)
+	}
+
+	var metadataXML, metadataURL, entityID string
+	if in.MetadataXml != nil {
+		metadataXML = *in.MetadataXml
+	}
+	if in.MetadataUrl != nil {
+		metadataURL = *in.MetadataUrl
+	} else if metadataXML == "" {
+		// 未提供元数据时从已保存的元数据地址重新导入
+		metadataURL = p.IdpMetadataURL
+	}
+	if in.EntityId != nil {
+		entityID = *in.EntityId
+	}
+
+	idp, err := loadIdPMetadata(l.ctx, l.Logger, metadataXML, metadataURL, entityID)
+	if err != nil {
+		return nil, err
+	}
+
+	query := l.svcCtx.DB.SamlProvider.UpdateOneID(p.ID).
+		SetIdpEntityID(idp.EntityID).
+		SetIdpSSOURL(idp.SSOURL).
+		SetIdpSloURL(idp.SLOURL).
+		SetIdpCertificates(saml.EncodeCertificates(idp.Certs))
+	if in.MetadataUrl != nil {
+		query = query.SetIdpMetadataURL(*in.MetadataUrl)
+	}
+	if p.NameIDFormat == "" && len(idp.NameIDFormats) > 0 {
+		query = query.SetNameIDFormat(idp.NameIDFormats[0])
+	}
+
+	if err := query.Exec(l.ctx); err != nil {
+		return nil, dberrorhandler.DefaultEntError(l.Logger, err, in)
+	}
+
+	return &core.BaseResp{Msg: i18n.UpdateSuccess}, nil
+}
@@ -0,0 +1,326 @@
+package samlprovider
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/coder-lulu/newbee-common/v2/enum/common"
+	"github.com/coder-lulu/newbee-common/v2/utils/encrypt"
+	"github.com/coder-lulu/newbee-common/v2/utils/pointy"
+	"github.com/zeromicro/go-zero/core/errorx"
+	"google.golang.org/grpc/status"
+
+	"github.com/coder-lulu/newbee-core/rpc/ent"
+	"github.com/coder-lulu/newbee-core/rpc/ent/department"
+	"github.com/coder-lulu/newbee-core/rpc/ent/role"
+	"github.com/coder-lulu/newbee-core/rpc/ent/samlaccount"
+	"github.com/coder-lulu/newbee-core/rpc/ent/user"
+
+	user2 "github.com/coder-lulu/newbee-core/rpc/internal/logic/user"
+	"github.com/coder-lulu/newbee-core/rpc/internal/saml"
+	"github.com/coder-lulu/newbee-core/rpc/internal/svc"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/dberrorhandler"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/entx"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type SamlAcsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewSamlAcsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SamlAcsLogic {
+	return &SamlAcsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// SamlAcs validates the SAML response posted to the assertion consumer service and returns
+// the user linked to the asserted identity
+func (l *SamlAcsLogic) SamlAcs(in *core.SamlAcsReq) (*core.SamlAcsResp, error) {
+	p, ctx, err := enabledProvider(l.ctx, l.svcCtx, l.Logger, in.ProviderId)
+	if err != nil {
+		return nil, err
+	}
+
+	sp, err := serviceProvider(l.svcCtx, p)
+	if err != nil {
+		l.Logger.Errorw("invalid SAML provider", logx.Field("detail", err.Error()), logx.Field("provider", p.ID))
+		return nil, errorx.NewInternalError("saml.providerMisconfigured")
+	}
+
+	assertion, err := sp.ParseResponse(in.SamlResponse)
+	if err != nil {
+		l.Logger.Errorw("invalid SAML response", logx.Field("detail", err.Error()), logx.Field("provider", p.ID))
+		return nil, errorx.NewInvalidArgumentError("saml.invalidResponse")
+	}
+
+	// 响应必须对应本系统发出且未使用过的请求
+	request, err := l.svcCtx.SamlStore.ConsumeRequest(ctx, assertion.InResponseTo)
+	if err != nil {
+		if errors.Is(err, saml.ErrUnknownRequest) {
+			l.Logger.Errorw("SAML response to an unknown request", logx.Field("inResponseTo", assertion.InResponseTo), logx.Field("provider", p.ID))
+			return nil, errorx.NewInvalidArgumentError("saml.invalidResponse")
+		}
+		l.Logger.Errorw("failed to get SAML request", logx.Field("detail", err.Error()))
+		return nil, errorx.NewInternalError("saml.invalidResponse")
+	}
+	if request.ProviderID != p.ID || (in.RelayState != nil && *in.RelayState != request.RelayState) {
+		l.Logger.Errorw("SAML response does not match the request", logx.Field("inResponseTo", assertion.InResponseTo), logx.Field("provider", p.ID))
+		return nil, errorx.NewInvalidArgumentError("saml.invalidResponse")
+	}
+
+	if err := l.svcCtx.SamlStore.MarkAssertion(ctx, p.ID, assertion.ID, assertion.NotOnOrAfter); err != nil {
+		if errors.Is(err, saml.ErrReplayedAssertion) {
+			l.Logger.Errorw("replayed SAML assertion", logx.Field("assertion", assertion.ID), logx.Field("provider", p.ID))
+			return nil, errorx.NewInvalidArgumentError("saml.replayed")
+		}
+		l.Logger.Errorw("failed to record SAML assertion", logx.Field("detail", err.Error()))
+		return nil, errorx.NewInternalError("saml.invalidResponse")
+	}
+
+	mapping, err := saml.ParseAttributeMapping(p.AttributeMapping)
+	if err != nil {
+		l.Logger.Errorw("invalid SAML attribute mapping", logx.Field("detail", err.Error()), logx.Field("provider", p.ID))
+		return nil, errorx.NewInternalError("saml.invalidAttributeMapping")
+	}
+	mapped := mapping.Map(assertion)
+
+	var linked *ent.User
+	err = entx.WithTx(ctx, l.svcCtx.DB, func(tx *ent.Tx) error {
+		u, err := l.linkUser(ctx, tx, p, assertion, mapped)
+		if err != nil {
+			return err
+		}
+		linked = u
+
+		return tx.SamlAccount.Update().
+			Where(samlaccount.ProviderIDEQ(p.ID), samlaccount.NameIDEQ(assertion.NameID)).
+			SetNameIDFormat(assertion.NameIDFormat).
+			SetSessionIndex(assertion.SessionIndex).
+			SetEmail(mapped.Info.Email).
+			SetAttributes(assertion.Attributes).
+			SetLastLoginAt(time.Now()).
+			SetNotNilLastLoginIP(in.Ip).
+			AddLoginCount(1).
+			Exec(ctx)
+	})
+	if err != nil {
+		// 业务错误原样返回，其余为数据库错误
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		return nil, dberrorhandler.DefaultEntError(l.Logger, err, in.ProviderId)
+	}
+
+	result, err := l.svcCtx.DB.User.Query().Where(user.IDEQ(linked.ID)).WithRoles().Only(ctx)
+	if err != nil {
+		return nil, dberrorhandler.DefaultEntError(l.Logger, err, in.ProviderId)
+	}
+	if result.Status != common.StatusNormal {
+		return nil, errorx.NewInvalidArgumentError("login.userBanned")
+	}
+
+	return &core.SamlAcsResp{
+		User: &core.UserInfo{
+			Nickname:     &result.Nickname,
+			Avatar:       &result.Avatar,
+			RoleIds:      user2.GetRoleIds(result.Edges.Roles),
+			RoleCodes:    user2.GetRoleCodes(result.Edges.Roles),
+			Mobile:       &result.Mobile,
+			Email:        &result.Email,
+			Status:       pointy.GetPointer(uint32(result.Status)),
+			Id:           pointy.GetPointer(result.ID.String()),
+			Username:     &result.Username,
+			HomePath:     &result.HomePath,
+			Description:  &result.Description,
+			DepartmentId: &result.DepartmentID,
+			TenantId:     &result.TenantID,
+			CreatedAt:    pointy.GetPointer(result.CreatedAt.UnixMilli()),
+			UpdatedAt:    pointy.GetPointer(result.UpdatedAt.UnixMilli()),
+		},
+		RelayState: request.RelayState,
+	}, nil
+}
+
+// linkUser finds the user bound to the NameID. Unbound identities are linked to the user with
+// the same email only when the provider opts in, or provisioned when the provider allows it.
+// An unbound identity whose email belongs to an existing user is rejected otherwise, the IdP
+// may let anyone set the email and linking it would take over the account.
+func (l *SamlAcsLogic) linkUser(ctx context.Context, tx *ent.Tx, p *ent.SamlProvider, a *saml.Assertion, mapped *saml.MappedUser) (*ent.User, error) {
+	account, err := tx.SamlAccount.Query().
+		Where(samlaccount.ProviderIDEQ(p.ID), samlaccount.NameIDEQ(a.NameID)).
+		WithUser().
+		Only(ctx)
+	if err == nil {
+		if p.SyncAttributes {
+			if err := l.syncUser(ctx, tx, p, account.Edges.User, mapped); err != nil {
+				return nil, err
+			}
+		}
+		return account.Edges.User, nil
+	}
+	if !ent.IsNotFound(err) {
+		return nil, err
+	}
+
+	var u *ent.User
+	if mapped.Info.Email != "" {
+		u, err = tx.User.Query().Where(user.EmailEQ(mapped.Info.Email)).First(ctx)
+		if err != nil && !ent.IsNotFound(err) {
+			return nil, err
+		}
+	}
+
+	switch {
+	case u != nil && !linkableByEmail(p, mapped):
+		l.Logger.Errorw("SAML identity not linked to the user with the same email",
+			logx.Field("nameID", a.NameID), logx.Field("provider", p.ID), logx.Field("userId", u.ID.String()))
+		return nil, errorx.NewInvalidArgumentError("saml.emailNotLinked")
+	case u != nil:
+		if p.SyncAttributes {
+			if err := l.syncUser(ctx, tx, p, u, mapped); err != nil {
+				return nil, err
+			}
+		}
+	case p.JitProvision:
+		if u, err = l.provisionUser(ctx, tx, p, a, mapped); err != nil {
+			return nil, err
+		}
+	default:
+		l.Logger.Errorw("no user for the SAML identity", logx.Field("nameID", a.NameID), logx.Field("provider", p.ID))
+		return nil, errorx.NewInvalidArgumentError("login.userNotExist")
+	}
+
+	err = tx.SamlAccount.Create().
+		SetUserID(u.ID).
+		SetProviderID(p.ID).
+		SetNameID(a.NameID).
+		SetTenantID(p.TenantID).
+		Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return u, nil
+}
+
+// linkableByEmail 按邮箱关联已有用户需要身份源显式开启，配置了 email_verified 规则时断言还需声明邮箱已验证
+func linkableByEmail(p *ent.SamlProvider, mapped *saml.MappedUser) bool {
+	return p.LinkByEmail && (mapped.EmailVerified == nil || *mapped.EmailVerified)
+}
+
+// provisionUser creates the user on the first login, with a random password
+func (l *SamlAcsLogic) provisionUser(ctx context.Context, tx *ent.Tx, p *ent.SamlProvider, a *saml.Assertion, mapped *saml.MappedUser) (*ent.User, error) {
+	username := mapped.Info.Username
+	if username == "" {
+		username = a.NameID
+	}
+	nickname := mapped.Info.Nickname
+	if nickname == "" {
+		nickname = username
+	}
+
+	password := make([]byte, 32)
+	if _, err := rand.Read(password); err != nil {
+		return nil, err
+	}
+
+	departmentID, err := l.departmentID(ctx, tx, p, mapped)
+	if err != nil {
+		return nil, err
+	}
+	roleIDs, err := l.roleIDs(ctx, tx, mapped)
+	if err != nil {
+		return nil, err
+	}
+	roleIDs = append(roleIDs, p.DefaultRoleIds...)
+	slices.Sort(roleIDs)
+
+	query := tx.User.Create().
+		SetUsername(username).
+		SetNickname(nickname).
+		SetPassword(encrypt.BcryptEncrypt(hex.EncodeToString(password))).
+		SetAvatar(mapped.Info.Avatar).
+		AddRoleIDs(slices.Compact(roleIDs)...)
+	if mapped.Info.Email != "" {
+		query = query.SetEmail(mapped.Info.Email)
+	}
+	if mapped.Info.PhoneNumber != "" {
+		query = query.SetMobile(mapped.Info.PhoneNumber)
+	}
+	if departmentID != 0 {
+		query = query.SetDepartmentID(departmentID)
+	}
+
+	return query.Save(ctx)
+}
+
+// syncUser updates the department and roles from the assertion, attributes which are
+// missing or map to nothing leave the current values unchanged
+func (l *SamlAcsLogic) syncUser(ctx context.Context, tx *ent.Tx, p *ent.SamlProvider, u *ent.User, mapped *saml.MappedUser) error {
+	query := tx.User.UpdateOneID(u.ID)
+	changed := false
+
+	if mapped.DepartmentID != 0 || mapped.Department != "" {
+		departmentID, err := l.departmentID(ctx, tx, p, mapped)
+		if err != nil {
+			return err
+		}
+		if departmentID != 0 && departmentID != u.DepartmentID {
+			query = query.SetDepartmentID(departmentID)
+			changed = true
+		}
+	}
+
+	roleIDs, err := l.roleIDs(ctx, tx, mapped)
+	if err != nil {
+		return err
+	}
+	if len(roleIDs) > 0 {
+		query = query.ClearRoles().AddRoleIDs(roleIDs...)
+		changed = true
+	}
+
+	if !changed {
+		return nil
+	}
+
+	return query.Exec(ctx)
+}
+
+// departmentID resolves the mapped department, falling back to the default department of the provider
+func (l *SamlAcsLogic) departmentID(ctx context.Context, tx *ent.Tx, p *ent.SamlProvider, mapped *saml.MappedUser) (uint64, error) {
+	if mapped.DepartmentID != 0 {
+		return mapped.DepartmentID, nil
+	}
+
+	if mapped.Department != "" {
+		id, err := tx.Department.Query().Where(department.NameEQ(mapped.Department)).FirstID(ctx)
+		if err == nil {
+			return id, nil
+		}
+		if !ent.IsNotFound(err) {
+			return 0, err
+		}
+	}
+
+	return p.DefaultDepartmentID, nil
+}
+
+func (l *SamlAcsLogic) roleIDs(ctx context.Context, tx *ent.Tx, mapped *saml.MappedUser) ([]uint64, error) {
+	if len(mapped.RoleCodes) == 0 {
+		return nil, nil
+	}
+
+	return tx.Role.Query().Where(role.CodeIn(mapped.RoleCodes...)).IDs(ctx)
+}
@@ -0,0 +1,121 @@
+package samlprovider
+
+import (
+	"context"
+	"testing"
+
+	"entgo.io/ent/dialect/sql/schema"
+	"github.com/coder-lulu/newbee-common/v2/orm/ent/hooks"
+	_ "github.com/mattn/go-sqlite3"
+	"google.golang.org/grpc/status"
+
+	"github.com/coder-lulu/newbee-core/rpc/ent"
+	"github.com/coder-lulu/newbee-core/rpc/ent/enttest"
+	"github.com/coder-lulu/newbee-core/rpc/ent/samlaccount"
+	"github.com/coder-lulu/newbee-core/rpc/internal/saml"
+	"github.com/coder-lulu/newbee-core/rpc/internal/svc"
+)
+
+func TestLinkUser(t *testing.T) {
+	db := enttest.Open(t, "sqlite3", "file:"+t.Name()+"?mode=memory&cache=shared&_fk=1",
+		enttest.WithMigrateOptions(schema.WithForeignKeys(false)))
+	t.Cleanup(func() { _ = db.Close() })
+	ctx := hooks.SetTenantIDToContext(context.Background(), 1)
+
+	alice, err := db.User.Create().SetUsername("alice").SetPassword("x").SetNickname("Alice").
+		SetEmail("alice@example.com").SetTenantID(1).Save(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	bob, err := db.User.Create().SetUsername("bob").SetPassword("x").SetNickname("Bob").
+		SetEmail("bob@example.com").SetTenantID(1).Save(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	newProvider := func(name string, linkByEmail bool, mapping map[string]any) *ent.SamlProvider {
+		p, err := db.SamlProvider.Create().
+			SetName(name).
+			SetIdpEntityID("https://idp.example.com/" + name).
+			SetIdpSSOURL("https://idp.example.com/sso").
+			SetIdpCertificates("-").
+			SetAttributeMapping(mapping).
+			SetLinkByEmail(linkByEmail).
+			SetTenantID(1).
+			Save(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return p
+	}
+	closed := newProvider("closed", false, nil)
+	open := newProvider("open", true, nil)
+	verified := newProvider("verified", true, map[string]any{"email_verified": "emailVerified"})
+
+	// 已绑定的身份不受邮箱关联设置影响
+	if err = db.SamlAccount.Create().SetUserID(bob.ID).SetProviderID(closed.ID).SetNameID("bob-at-idp").SetTenantID(1).Exec(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name       string
+		provider   *ent.SamlProvider
+		nameID     string
+		attributes map[string][]string
+		want       *ent.User
+		wantErr    string
+	}{
+		{name: "existing binding", provider: closed, nameID: "bob-at-idp", attributes: map[string][]string{"mail": {"someone@example.com"}}, want: bob},
+		{name: "email of an existing user without opt-in", provider: closed, nameID: "mallory", attributes: map[string][]string{"mail": {"alice@example.com"}}, wantErr: "saml.emailNotLinked"},
+		{name: "email linking enabled", provider: open, nameID: "alice-at-idp", attributes: map[string][]string{"mail": {"alice@example.com"}}, want: alice},
+		{name: "email not verified", provider: verified, nameID: "mallory", attributes: map[string][]string{"mail": {"alice@example.com"}, "emailVerified": {"false"}}, wantErr: "saml.emailNotLinked"},
+		{name: "missing verified attribute", provider: verified, nameID: "mallory", attributes: map[string][]string{"mail": {"alice@example.com"}}, wantErr: "saml.emailNotLinked"},
+		{name: "email verified", provider: verified, nameID: "alice-verified", attributes: map[string][]string{"mail": {"alice@example.com"}, "emailVerified": {"true"}}, want: alice},
+		{name: "unknown user without provisioning", provider: open, nameID: "carol", attributes: map[string][]string{"mail": {"carol@example.com"}}, wantErr: "login.userNotExist"},
+	}
+
+	l := NewSamlAcsLogic(ctx, &svc.ServiceContext{DB: db})
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mapping, err := saml.ParseAttributeMapping(tt.provider.AttributeMapping)
+			if err != nil {
+				t.Fatal(err)
+			}
+			a := &saml.Assertion{NameID: tt.nameID, Attributes: tt.attributes}
+
+			tx, err := db.Tx(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			u, err := l.linkUser(ctx, tx, tt.provider, a, mapping.Map(a))
+			if err != nil {
+				_ = tx.Rollback()
+				if status.Convert(err).Message() != tt.wantErr {
+					t.Fatalf("linkUser: %v, want %s", err, tt.wantErr)
+				}
+				return
+			}
+			if err = tx.Commit(); err != nil {
+				t.Fatal(err)
+			}
+
+			if tt.want == nil {
+				t.Fatalf("linkUser linked the identity to %s", u.Username)
+			}
+			if u.ID != tt.want.ID {
+				t.Errorf("linkUser = %s, want %s", u.Username, tt.want.Username)
+			}
+			exist, err := db.SamlAccount.Query().
+				Where(samlaccount.ProviderIDEQ(tt.provider.ID), samlaccount.NameIDEQ(tt.nameID), samlaccount.UserIDEQ(tt.want.ID)).
+				Exist(ctx)
+			if err != nil || !exist {
+				t.Errorf("the identity is not bound to %s", tt.want.Username)
+			}
+		})
+	}
+
+	// 被拒绝的身份没有留下绑定
+	if n := db.SamlAccount.Query().Where(samlaccount.NameIDEQ("mallory")).CountX(ctx); n != 0 {
+		t.Errorf("%d bindings of a rejected identity", n)
+	}
+}
@@ -0,0 +1,60 @@
+package samlprovider
+
+import (
+	"context"
+
+	"github.com/zeromicro/go-zero/core/errorx"
+
+	"github.com/coder-lulu/newbee-core/rpc/internal/saml"
+	"github.com/coder-lulu/newbee-core/rpc/internal/svc"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type SamlLoginLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewSamlLoginLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SamlLoginLogic {
+	return &SamlLoginLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// SamlLogin creates an AuthnRequest and returns the URL redirecting the user to the IdP
+func (l *SamlLoginLogic) SamlLogin(in *core.SamlLoginReq) (*core.SamlLoginResp, error) {
+	p, ctx, err := enabledProvider(l.ctx, l.svcCtx, l.Logger, in.ProviderId)
+	if err != nil {
+		return nil, err
+	}
+
+	sp, err := serviceProvider(l.svcCtx, p)
+	if err != nil {
+		l.Logger.Errorw("invalid SAML provider", logx.Field("detail", err.Error()), logx.Field("provider", p.ID))
+		return nil, errorx.NewInternalError("saml.providerMisconfigured")
+	}
+
+	var relayState string
+	if in.RelayState != nil {
+		relayState = *in.RelayState
+	}
+
+	url, id, err := sp.AuthnRequestURL(relayState)
+	if err != nil {
+		l.Logger.Errorw("failed to create SAML AuthnRequest", logx.Field("detail", err.Error()), logx.Field("provider", p.ID))
+		return nil, errorx.NewInvalidArgumentError("saml.invalidRequest")
+	}
+
+	// 记录请求ID，断言必须响应本系统发出的请求
+	if err := l.svcCtx.SamlStore.SaveRequest(ctx, id, saml.RequestInfo{ProviderID: p.ID, RelayState: relayState}); err != nil {
+		l.Logger.Errorw("failed to save SAML AuthnRequest", logx.Field("detail", err.Error()))
+		return nil, errorx.NewInternalError("saml.invalidRequest")
+	}
+
+	return &core.SamlLoginResp{Url: url}, nil
+}
@@ -0,0 +1,152 @@
+package samlprovider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/coder-lulu/newbee-common/v2/enum/common"
+	"github.com/coder-lulu/newbee-common/v2/orm/ent/hooks"
+	"github.com/coder-lulu/newbee-common/v2/utils/pointy"
+	"github.com/zeromicro/go-zero/core/errorx"
+	"github.com/zeromicro/go-zero/core/logx"
+
+	"github.com/coder-lulu/newbee-core/rpc/ent"
+	"github.com/coder-lulu/newbee-core/rpc/internal/saml"
+	"github.com/coder-lulu/newbee-core/rpc/internal/svc"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/dberrorhandler"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/typeconv"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+)
+
+// serviceProvider builds the SP of a provider, the SP entity ID and the ACS URL default to
+// the URLs of the API service when they are not configured
+func serviceProvider(svcCtx *svc.ServiceContext, p *ent.SamlProvider) (*saml.ServiceProvider, error) {
+	certs, err := saml.ParseCertificates(p.IdpCertificates)
+	if err != nil {
+		return nil, err
+	}
+
+	baseURL := strings.TrimRight(svcCtx.Config.Saml.BaseURL, "/")
+	entityID, acsURL := p.SpEntityID, p.AcsURL
+	if entityID == "" || acsURL == "" {
+		if baseURL == "" {
+			return nil, fmt.Errorf("saml: provider %d has no SP entity ID or ACS URL and Saml.BaseURL is not configured", p.ID)
+		}
+		if entityID == "" {
+			entityID = fmt.Sprintf("%s/saml/metadata?provider=%d", baseURL, p.ID)
+		}
+		if acsURL == "" {
+			acsURL = fmt.Sprintf("%s/saml/acs?provider=%d", baseURL, p.ID)
+		}
+	}
+
+	return &saml.ServiceProvider{
+		EntityID:             entityID,
+		ACSURL:               acsURL,
+		KeyPair:              svcCtx.SamlKeys,
+		SignAuthnRequest:     p.SignAuthnRequest,
+		WantAssertionsSigned: p.WantAssertionsSigned,
+		NameIDFormat:         p.NameIDFormat,
+		ClockSkew:            svcCtx.Config.Saml.ClockSkew,
+		IdP: &saml.IdentityProvider{
+			EntityID: p.IdpEntityID,
+			SSOURL:   p.IdpSSOURL,
+			SLOURL:   p.IdpSloURL,
+			Certs:    certs,
+		},
+	}, nil
+}
+
+// enabledProvider loads a provider for the public SAML endpoints, which carry no tenant,
+// and returns a context of the tenant the provider belongs to
+func enabledProvider(ctx context.Context, svcCtx *svc.ServiceContext, logger logx.Logger, id uint64) (*ent.SamlProvider, context.Context, error) {
+	p, err := svcCtx.DB.SamlProvider.Get(hooks.NewSystemContext(ctx), id)
+	if err != nil {
+		return nil, nil, dberrorhandler.DefaultEntError(logger, err, id)
+	}
+	if !p.Enabled || p.Status != common.StatusNormal {
+		return nil, nil, errorx.NewInvalidArgumentError("saml.providerDisabled")
+	}
+
+	return p, hooks.SetTenantIDToContext(ctx, p.TenantID), nil
+}
+
+// loadIdPMetadata reads the IdP metadata from the XML document or downloads it from the URL
+func loadIdPMetadata(ctx context.Context, logger logx.Logger, metadataXML, metadataURL, entityID string) (*saml.IdentityProvider, error) {
+	data := []byte(metadataXML)
+	if metadataXML == "" {
+		if metadataURL == "" {
+			return nil, errorx.NewInvalidArgumentError("saml.invalidMetadata")
+		}
+
+		var err error
+		data, err = saml.FetchMetadata(ctx, metadataURL)
+		if err != nil {
+			logger.Errorw("failed to fetch SAML IdP metadata", logx.Field("detail", err.Error()), logx.Field("url", metadataURL))
+			return nil, errorx.NewInvalidArgumentError("saml.invalidMetadata")
+		}
+	}
+
+	idp, err := saml.ParseIdPMetadata(data, entityID)
+	if err != nil {
+		logger.Errorw("invalid SAML IdP metadata", logx.Field("detail", err.Error()))
+		return nil, errorx.NewInvalidArgumentError("saml.invalidMetadata")
+	}
+
+	return idp, nil
+}
+
+// validateProvider checks the certificates and the attribute mapping before saving the provider
+func validateProvider(logger logx.Logger, in *core.SamlProviderInfo) error {
+	if in.IdpCertificates != nil {
+		if _, err := saml.ParseCertificates(*in.IdpCertificates); err != nil {
+			logger.Errorw("invalid SAML IdP certificates", logx.Field("detail", err.Error()))
+			return errorx.NewInvalidArgumentError("saml.invalidCertificate")
+		}
+	}
+
+	if in.AttributeMapping != nil && *in.AttributeMapping != "" {
+		config := typeconv.ConvertExtraConfig(in.AttributeMapping)
+		if config == nil {
+			return errorx.NewInvalidArgumentError("saml.invalidAttributeMapping")
+		}
+		if _, err := saml.ParseAttributeMapping(*config); err != nil {
+			logger.Errorw("invalid SAML attribute mapping", logx.Field("detail", err.Error()))
+			return errorx.NewInvalidArgumentError("saml.invalidAttributeMapping")
+		}
+	}
+
+	return nil
+}
+
+func convertProvider(p *ent.SamlProvider) *core.SamlProviderInfo {
+	return &core.SamlProviderInfo{
+		Id:                   &p.ID,
+		CreatedAt:            pointy.GetPointer(p.CreatedAt.UnixMilli()),
+		UpdatedAt:            pointy.GetPointer(p.UpdatedAt.UnixMilli()),
+		Status:               typeconv.ConvertStatusFromEnt(p.Status),
+		TenantId:             &p.TenantID,
+		Name:                 &p.Name,
+		DisplayName:          &p.DisplayName,
+		IdpEntityId:          &p.IdpEntityID,
+		IdpSsoUrl:            &p.IdpSSOURL,
+		IdpSloUrl:            &p.IdpSloURL,
+		IdpCertificates:      &p.IdpCertificates,
+		IdpMetadataUrl:       &p.IdpMetadataURL,
+		SpEntityId:           &p.SpEntityID,
+		AcsUrl:               &p.AcsURL,
+		NameIdFormat:         &p.NameIDFormat,
+		SignAuthnRequest:     &p.SignAuthnRequest,
+		WantAssertionsSigned: &p.WantAssertionsSigned,
+		AttributeMapping:     typeconv.ConvertExtraConfigFromEnt(p.AttributeMapping),
+		JitProvision:         &p.JitProvision,
+		SyncAttributes:       &p.SyncAttributes,
+		LinkByEmail:          &p.LinkByEmail,
+		DefaultDepartmentId:  &p.DefaultDepartmentID,
+		DefaultRoleIds:       p.DefaultRoleIds,
+		Enabled:              &p.Enabled,
+		Sort:                 &p.Sort,
+		Remark:               &p.Remark,
+	}
+}
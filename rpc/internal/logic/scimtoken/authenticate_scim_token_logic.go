@@ -0,0 +1,69 @@
+package scimtoken
+
+import (
+	"context"
+	"time"
+
+	"github.com/coder-lulu/newbee-common/v2/enum/common"
+	"github.com/zeromicro/go-zero/core/errorx"
+
+	"github.com/coder-lulu/newbee-core/rpc/ent"
+	"github.com/coder-lulu/newbee-core/rpc/ent/scimtoken"
+
+	"github.com/coder-lulu/newbee-core/rpc/internal/svc"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/dberrorhandler"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type AuthenticateScimTokenLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewAuthenticateScimTokenLogic(ctx context.Context, svcCtx *svc.ServiceContext) *AuthenticateScimTokenLogic {
+	return &AuthenticateScimTokenLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// touchInterval 两次记录最后使用时间的最小间隔，避免每个 SCIM 请求都写库
+const touchInterval = time.Minute
+
+// AuthenticateScimToken returns the token, including the tenant it provisions, when it is valid.
+// The caller does not know the tenant yet, so the request is expected in the system context.
+func (l *AuthenticateScimTokenLogic) AuthenticateScimToken(in *core.ScimTokenAuthReq) (*core.ScimTokenInfo, error) {
+	if in.Token == "" {
+		return nil, errorx.NewUnauthenticatedError("scim.invalidToken")
+	}
+
+	result, err := l.svcCtx.DB.ScimToken.Query().Where(scimtoken.TokenHashEQ(hashToken(in.Token))).Only(l.ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errorx.NewUnauthenticatedError("scim.invalidToken")
+		}
+		return nil, dberrorhandler.DefaultEntError(l.Logger, err, nil)
+	}
+
+	now := time.Now()
+	if result.Status != common.StatusNormal || (result.ExpiredAt != nil && result.ExpiredAt.Before(now)) {
+		return nil, errorx.NewUnauthenticatedError("scim.invalidToken")
+	}
+
+	if result.LastUsedAt == nil || now.Sub(*result.LastUsedAt) >= touchInterval {
+		err = l.svcCtx.DB.ScimToken.UpdateOneID(result.ID).
+			SetLastUsedAt(now).
+			SetNotNilLastUsedIP(in.Ip).
+			Exec(l.ctx)
+		if err != nil {
+			l.Logger.Errorw("failed to update the last used time of SCIM token", logx.Field("detail", err.Error()),
+				logx.Field("id", result.ID))
+		}
+	}
+
+	return convertToken(result), nil
+}
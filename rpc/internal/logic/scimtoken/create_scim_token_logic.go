@@ -0,0 +1,57 @@
+package scimtoken
+
+import (
+	"context"
+	"time"
+
+	"github.com/coder-lulu/newbee-common/v2/i18n"
+	"github.com/zeromicro/go-zero/core/errorx"
+
+	"github.com/coder-lulu/newbee-core/rpc/internal/svc"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/dberrorhandler"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/typeconv"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CreateScimTokenLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewCreateScimTokenLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CreateScimTokenLogic {
+	return &CreateScimTokenLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// CreateScimToken returns the token in plaintext, it can not be retrieved afterwards
+func (l *CreateScimTokenLogic) CreateScimToken(in *core.ScimTokenInfo) (*core.ScimTokenCreateResp, error) {
+	token, hash, err := generateToken()
+	if err != nil {
+		l.Logger.Errorw("failed to generate SCIM token", logx.Field("detail", err.Error()))
+		return nil, errorx.NewInternalError(i18n.Failed)
+	}
+
+	query := l.svcCtx.DB.ScimToken.Create().
+		SetNotNilName(in.Name).
+		SetTokenHash(hash).
+		SetTokenPrefix(token[:displayPrefixLen]).
+		SetNotNilRemark(in.Remark).
+		SetRoleCodes(in.RoleCodes).
+		SetNillableStatus(typeconv.ConvertStatus(in.Status))
+	if in.ExpiredAt != nil && *in.ExpiredAt > 0 {
+		query = query.SetExpiredAt(time.UnixMilli(*in.ExpiredAt))
+	}
+
+	result, err := query.Save(l.ctx)
+	if err != nil {
+		return nil, dberrorhandler.DefaultEntError(l.Logger, err, in.Name)
+	}
+
+	return &core.ScimTokenCreateResp{Id: result.ID, Token: token, Msg: i18n.CreateSuccess}, nil
+}
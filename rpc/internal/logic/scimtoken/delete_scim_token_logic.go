@@ -1,35 +1,35 @@
-package auditlog
+package scimtoken
 
 import (
 	"context"
 
-	"github.com/coder-lulu/newbee-core/rpc/ent/auditlog"
+	"github.com/coder-lulu/newbee-common/v2/i18n"
+
+	"github.com/coder-lulu/newbee-core/rpc/ent/scimtoken"
+
 	"github.com/coder-lulu/newbee-core/rpc/internal/svc"
 	"github.com/coder-lulu/newbee-core/rpc/internal/utils/dberrorhandler"
 	"github.com/coder-lulu/newbee-core/rpc/types/core"
 
-	"github.com/coder-lulu/newbee-common/v2/i18n"
-	"github.com/coder-lulu/newbee-common/v2/utils/uuidx"
 	"github.com/zeromicro/go-zero/core/logx"
 )
 
-type DeleteAuditLogLogic struct {
+type DeleteScimTokenLogic struct {
 	ctx    context.Context
 	svcCtx *svc.ServiceContext
 	logx.Logger
 }
 
-func NewDeleteAuditLogLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DeleteAuditLogLogic {
-	return &DeleteAuditLogLogic{
+func NewDeleteScimTokenLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DeleteScimTokenLogic {
+	return &DeleteScimTokenLogic{
 		ctx:    ctx,
 		svcCtx: svcCtx,
 		Logger: logx.WithContext(ctx),
 	}
 }
 
-func (l *DeleteAuditLogLogic) DeleteAuditLog(in *core.UUIDsReq) (*core.BaseResp, error) {
-	_, err := l.svcCtx.DB.AuditLog.Delete().Where(auditlog.IDIn(uuidx.ParseUUIDSlice(in.Ids)...)).Exec(l.ctx)
-
+func (l *DeleteScimTokenLogic) DeleteScimToken(in *core.IDsReq) (*core.BaseResp, error) {
+	_, err := l.svcCtx.DB.ScimToken.Delete().Where(scimtoken.IDIn(in.Ids...)).Exec(l.ctx)
 	if err != nil {
 		return nil, dberrorhandler.DefaultEntError(l.Logger, err, in)
 	}
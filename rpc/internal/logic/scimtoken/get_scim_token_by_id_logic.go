@@ -0,0 +1,34 @@
+package scimtoken
+
+import (
+	"context"
+
+	"github.com/coder-lulu/newbee-core/rpc/internal/svc"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/dberrorhandler"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetScimTokenByIdLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetScimTokenByIdLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetScimTokenByIdLogic {
+	return &GetScimTokenByIdLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *GetScimTokenByIdLogic) GetScimTokenById(in *core.IDReq) (*core.ScimTokenInfo, error) {
+	result, err := l.svcCtx.DB.ScimToken.Get(l.ctx, in.Id)
+	if err != nil {
+		return nil, dberrorhandler.DefaultEntError(l.Logger, err, in)
+	}
+
+	return convertToken(result), nil
+}
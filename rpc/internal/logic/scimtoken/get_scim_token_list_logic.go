@@ -0,0 +1,53 @@
+package scimtoken
+
+import (
+	"context"
+
+	"github.com/coder-lulu/newbee-core/rpc/ent"
+	"github.com/coder-lulu/newbee-core/rpc/ent/predicate"
+	"github.com/coder-lulu/newbee-core/rpc/ent/scimtoken"
+
+	"github.com/coder-lulu/newbee-core/rpc/internal/svc"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/dberrorhandler"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetScimTokenListLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetScimTokenListLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetScimTokenListLogic {
+	return &GetScimTokenListLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *GetScimTokenListLogic) GetScimTokenList(in *core.ScimTokenListReq) (*core.ScimTokenListResp, error) {
+	var predicates []predicate.ScimToken
+	if in.Name != nil {
+		predicates = append(predicates, scimtoken.NameContains(*in.Name))
+	}
+
+	result, err := l.svcCtx.DB.ScimToken.Query().Where(predicates...).
+		Page(l.ctx, in.Page, in.PageSize, func(pager *ent.ScimTokenPager) {
+			pager.Order = ent.Desc(scimtoken.FieldCreatedAt)
+		})
+	if err != nil {
+		return nil, dberrorhandler.DefaultEntError(l.Logger, err, in)
+	}
+
+	resp := &core.ScimTokenListResp{}
+	resp.Total = result.PageDetails.Total
+
+	for _, v := range result.List {
+		resp.Data = append(resp.Data, convertToken(v))
+	}
+
+	return resp, nil
+}
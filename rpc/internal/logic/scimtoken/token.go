@@ -0,0 +1,59 @@
+package scimtoken
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+
+	"github.com/coder-lulu/newbee-common/v2/utils/pointy"
+
+	"github.com/coder-lulu/newbee-core/rpc/ent"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/typeconv"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+)
+
+const (
+	// tokenPrefix 便于在日志和密钥扫描中识别 SCIM 令牌
+	tokenPrefix = "scim_"
+	// displayPrefixLen 列表中展示的令牌前缀长度
+	displayPrefixLen = 12
+)
+
+// generateToken returns a random token and its hash
+func generateToken() (string, string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", "", err
+	}
+	token := tokenPrefix + hex.EncodeToString(b)
+	return token, hashToken(token), nil
+}
+
+func hashToken(token string) string {
+	digest := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(digest[:])
+}
+
+// convertToken never returns the hash of the token
+func convertToken(t *ent.ScimToken) *core.ScimTokenInfo {
+	info := &core.ScimTokenInfo{
+		Id:          &t.ID,
+		CreatedAt:   pointy.GetPointer(t.CreatedAt.UnixMilli()),
+		UpdatedAt:   pointy.GetPointer(t.UpdatedAt.UnixMilli()),
+		Status:      typeconv.ConvertStatusFromEnt(t.Status),
+		TenantId:    &t.TenantID,
+		Name:        &t.Name,
+		TokenPrefix: &t.TokenPrefix,
+		LastUsedIp:  &t.LastUsedIP,
+		Remark:      &t.Remark,
+		RoleCodes:   t.RoleCodes,
+	}
+	if t.ExpiredAt != nil {
+		info.ExpiredAt = pointy.GetPointer(t.ExpiredAt.UnixMilli())
+	}
+	if t.LastUsedAt != nil {
+		info.LastUsedAt = pointy.GetPointer(t.LastUsedAt.UnixMilli())
+	}
+
+	return info
+}
@@ -0,0 +1,51 @@
+package scimtoken
+
+import (
+	"context"
+	"time"
+
+	"github.com/coder-lulu/newbee-common/v2/i18n"
+
+	"github.com/coder-lulu/newbee-core/rpc/internal/svc"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/dberrorhandler"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/typeconv"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type UpdateScimTokenLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewUpdateScimTokenLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UpdateScimTokenLogic {
+	return &UpdateScimTokenLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *UpdateScimTokenLogic) UpdateScimToken(in *core.ScimTokenInfo) (*core.BaseResp, error) {
+	query := l.svcCtx.DB.ScimToken.UpdateOneID(*in.Id).
+		SetNotNilName(in.Name).
+		SetNotNilRemark(in.Remark).
+		SetRoleCodes(in.RoleCodes).
+		SetNillableStatus(typeconv.ConvertStatus(in.Status))
+	// 过期时间为 0 表示永不过期
+	if in.ExpiredAt != nil {
+		if *in.ExpiredAt > 0 {
+			query = query.SetExpiredAt(time.UnixMilli(*in.ExpiredAt))
+		} else {
+			query = query.ClearExpiredAt()
+		}
+	}
+
+	if err := query.Exec(l.ctx); err != nil {
+		return nil, dberrorhandler.DefaultEntError(l.Logger, err, in.Id)
+	}
+
+	return &core.BaseResp{Msg: i18n.UpdateSuccess}, nil
+}
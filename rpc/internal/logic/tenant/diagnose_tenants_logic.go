@@ -0,0 +1,75 @@
+package tenant
+
+import (
+	"context"
+	"errors"
+
+	"github.com/coder-lulu/newbee-common/v2/i18n"
+	"github.com/coder-lulu/newbee-core/rpc/ent"
+	"github.com/coder-lulu/newbee-core/rpc/internal/plugins"
+	"github.com/coder-lulu/newbee-core/rpc/internal/svc"
+	"github.com/coder-lulu/newbee-core/rpc/internal/tenantdoctor"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/errorx"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type DiagnoseTenantsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewDiagnoseTenantsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DiagnoseTenantsLogic {
+	return &DiagnoseTenantsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// DiagnoseTenants checks the admin, users, role menus and casbin rules of one or all tenants
+func (l *DiagnoseTenantsLogic) DiagnoseTenants(in *core.TenantDoctorReq) (*core.TenantDoctorResp, error) {
+	var tenantIDs []uint64
+	if in.TenantId != nil {
+		tenantIDs = append(tenantIDs, *in.TenantId)
+	}
+
+	findings, err := tenantdoctor.NewDoctor(l.svcCtx, l.Logger).Diagnose(l.ctx, tenantIDs...)
+	if err != nil {
+		return nil, doctorError(l.Logger, err)
+	}
+
+	return convertDoctorFindings(findings), nil
+}
+
+// doctorError 将诊断和修复的错误转换为接口错误
+func doctorError(logger logx.Logger, err error) error {
+	switch {
+	case ent.IsNotFound(err):
+		return errorx.NewInvalidArgumentError("tenant.notFound")
+	case errors.Is(err, tenantdoctor.ErrUnknownCode):
+		return errorx.NewInvalidArgumentError("tenant.invalidDoctorCode")
+	case errors.Is(err, plugins.ErrAdminPasswordRequired):
+		return errorx.NewInvalidArgumentError("tenant.adminPasswordRequired")
+	default:
+		logger.Errorw("tenant doctor failed", logx.Field("detail", err.Error()))
+		return errorx.NewInternalError(i18n.DatabaseError)
+	}
+}
+
+func convertDoctorFindings(findings []tenantdoctor.Finding) *core.TenantDoctorResp {
+	resp := &core.TenantDoctorResp{Total: uint64(len(findings))}
+	for _, f := range findings {
+		resp.Data = append(resp.Data, &core.TenantDoctorFinding{
+			TenantId: f.TenantID,
+			Code:     f.Code,
+			Message:  f.Message,
+			Subjects: f.Subjects,
+			Repair:   f.Repair,
+		})
+	}
+
+	return resp
+}
@@ -0,0 +1,35 @@
+package tenant
+
+import (
+	"context"
+
+	"github.com/coder-lulu/newbee-core/rpc/internal/svc"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/coder-lulu/newbee-common/v2/i18n"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type EndImpersonationLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewEndImpersonationLogic(ctx context.Context, svcCtx *svc.ServiceContext) *EndImpersonationLogic {
+	return &EndImpersonationLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// EndImpersonation 结束用户的生效会话，in.Id 为用户ID
+func (l *EndImpersonationLogic) EndImpersonation(in *core.UUIDReq) (*core.BaseResp, error) {
+	if err := newImpersonationManager(l.svcCtx, l.Logger).End(l.ctx, in.Id); err != nil {
+		return nil, impersonationError(l.Logger, err, in)
+	}
+
+	return &core.BaseResp{Msg: i18n.UpdateSuccess}, nil
+}
@@ -0,0 +1,46 @@
+package tenant
+
+import (
+	"context"
+
+	"github.com/coder-lulu/newbee-core/rpc/internal/impersonation"
+	"github.com/coder-lulu/newbee-core/rpc/internal/svc"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/dberrorhandler"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/errorx"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetActiveImpersonationLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetActiveImpersonationLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetActiveImpersonationLogic {
+	return &GetActiveImpersonationLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// GetActiveImpersonation 查询用户当前生效的会话，in.Id 为用户ID，没有生效会话时返回空信息（ID 为 0）
+func (l *GetActiveImpersonationLogic) GetActiveImpersonation(in *core.UUIDReq) (*core.ImpersonationSessionInfo, error) {
+	active, err := impersonation.ActiveSession(l.ctx, l.svcCtx.Redis, in.Id)
+	if err != nil {
+		l.Errorw("failed to get the active impersonation session", logx.Field("userId", in.Id), logx.Field("error", err.Error()))
+		return nil, errorx.NewInternalError(err.Error())
+	}
+	if active == nil {
+		return &core.ImpersonationSessionInfo{}, nil
+	}
+
+	s, err := l.svcCtx.DB.ImpersonationSession.Get(l.ctx, active.ID)
+	if err != nil {
+		return nil, dberrorhandler.DefaultEntError(l.Logger, err, in)
+	}
+
+	return convertImpersonationSession(s), nil
+}
@@ -0,0 +1,69 @@
+package tenant
+
+import (
+	"context"
+	"time"
+
+	"github.com/coder-lulu/newbee-core/rpc/ent"
+	"github.com/coder-lulu/newbee-core/rpc/ent/impersonationsession"
+	"github.com/coder-lulu/newbee-core/rpc/ent/predicate"
+	"github.com/coder-lulu/newbee-core/rpc/internal/impersonation"
+	"github.com/coder-lulu/newbee-core/rpc/internal/svc"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/dberrorhandler"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetImpersonationSessionListLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetImpersonationSessionListLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetImpersonationSessionListLogic {
+	return &GetImpersonationSessionListLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *GetImpersonationSessionListLogic) GetImpersonationSessionList(in *core.ImpersonationSessionListReq) (*core.ImpersonationSessionListResp, error) {
+	var predicates []predicate.ImpersonationSession
+	if in.TargetTenantId != nil {
+		predicates = append(predicates, impersonationsession.TargetTenantIDEQ(*in.TargetTenantId))
+	}
+	if in.UserId != nil {
+		predicates = append(predicates, impersonationsession.UserIDEQ(*in.UserId))
+	}
+	if in.State != nil {
+		now := time.Now()
+		switch *in.State {
+		case impersonation.StateActive:
+			predicates = append(predicates, impersonationsession.StateEQ(impersonation.StateActive),
+				impersonationsession.ExpiresAtGT(now))
+		case stateExpired:
+			predicates = append(predicates, impersonationsession.StateEQ(impersonation.StateActive),
+				impersonationsession.ExpiresAtLTE(now))
+		default:
+			predicates = append(predicates, impersonationsession.StateEQ(*in.State))
+		}
+	}
+
+	result, err := l.svcCtx.DB.ImpersonationSession.Query().Where(predicates...).Page(l.ctx, in.Page, in.PageSize, func(pager *ent.ImpersonationSessionPager) {
+		pager.Order = ent.Desc(impersonationsession.FieldID)
+	})
+	if err != nil {
+		return nil, dberrorhandler.DefaultEntError(l.Logger, err, in)
+	}
+
+	resp := &core.ImpersonationSessionListResp{}
+	resp.Total = result.PageDetails.Total
+
+	for _, v := range result.List {
+		resp.Data = append(resp.Data, convertImpersonationSession(v))
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,35 @@
+package tenant
+
+import (
+	"context"
+
+	"github.com/coder-lulu/newbee-core/rpc/internal/svc"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/dberrorhandler"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetTenantInitJobByIdLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetTenantInitJobByIdLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetTenantInitJobByIdLogic {
+	return &GetTenantInitJobByIdLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// GetTenantInitJobById returns the job with the progress of each plugin, and the plan of a dry run
+func (l *GetTenantInitJobByIdLogic) GetTenantInitJobById(in *core.IDReq) (*core.TenantInitJobInfo, error) {
+	result, err := l.svcCtx.DB.TenantInitJob.Get(l.ctx, in.Id)
+	if err != nil {
+		return nil, dberrorhandler.DefaultEntError(l.Logger, err, in)
+	}
+
+	return convertInitJob(result), nil
+}
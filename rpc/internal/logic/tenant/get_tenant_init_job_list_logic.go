@@ -0,0 +1,54 @@
+package tenant
+
+import (
+	"context"
+
+	"github.com/coder-lulu/newbee-core/rpc/ent"
+	"github.com/coder-lulu/newbee-core/rpc/ent/predicate"
+	"github.com/coder-lulu/newbee-core/rpc/ent/tenantinitjob"
+	"github.com/coder-lulu/newbee-core/rpc/internal/svc"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/dberrorhandler"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetTenantInitJobListLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetTenantInitJobListLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetTenantInitJobListLogic {
+	return &GetTenantInitJobListLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *GetTenantInitJobListLogic) GetTenantInitJobList(in *core.TenantInitJobListReq) (*core.TenantInitJobListResp, error) {
+	var predicates []predicate.TenantInitJob
+	if in.TenantId != nil {
+		predicates = append(predicates, tenantinitjob.TenantIDEQ(*in.TenantId))
+	}
+	if in.Status != nil {
+		predicates = append(predicates, tenantinitjob.StatusEQ(*in.Status))
+	}
+
+	result, err := l.svcCtx.DB.TenantInitJob.Query().Where(predicates...).Page(l.ctx, in.Page, in.PageSize, func(pager *ent.TenantInitJobPager) {
+		pager.Order = ent.Desc(tenantinitjob.FieldID)
+	})
+	if err != nil {
+		return nil, dberrorhandler.DefaultEntError(l.Logger, err, in)
+	}
+
+	resp := &core.TenantInitJobListResp{}
+	resp.Total = result.PageDetails.Total
+
+	for _, v := range result.List {
+		resp.Data = append(resp.Data, convertInitJob(v))
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,72 @@
+package tenant
+
+import (
+	"errors"
+	"time"
+
+	"github.com/coder-lulu/newbee-common/v2/utils/pointy"
+
+	"github.com/coder-lulu/newbee-core/rpc/ent"
+	"github.com/coder-lulu/newbee-core/rpc/internal/impersonation"
+	"github.com/coder-lulu/newbee-core/rpc/internal/svc"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/dberrorhandler"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/errorx"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// stateExpired 已到期但未手动结束的会话，只用于展示
+const stateExpired = "expired"
+
+func newImpersonationManager(svcCtx *svc.ServiceContext, logger logx.Logger) *impersonation.Manager {
+	return impersonation.NewManager(svcCtx.DB, svcCtx.Redis, svcCtx.Config.Impersonation, logger)
+}
+
+func convertImpersonationSession(s *ent.ImpersonationSession) *core.ImpersonationSessionInfo {
+	info := &core.ImpersonationSessionInfo{
+		Id:              s.ID,
+		CreatedAt:       s.CreatedAt.UnixMilli(),
+		UserId:          s.UserID,
+		UserName:        s.UserName,
+		OriginTenantId:  s.OriginTenantID,
+		TargetTenantId:  s.TargetTenantID,
+		Reason:          s.Reason,
+		Ticket:          s.Ticket,
+		State:           s.State,
+		DurationSeconds: s.DurationSeconds,
+		ApprovedBy:      s.ApprovedBy,
+	}
+	if s.ApprovedAt != nil {
+		info.ApprovedAt = pointy.GetPointer(s.ApprovedAt.UnixMilli())
+	}
+	if s.StartedAt != nil {
+		info.StartedAt = pointy.GetPointer(s.StartedAt.UnixMilli())
+	}
+	if s.ExpiresAt != nil {
+		info.ExpiresAt = pointy.GetPointer(s.ExpiresAt.UnixMilli())
+		if s.State == impersonation.StateActive && s.ExpiresAt.Before(time.Now()) {
+			info.State = stateExpired
+		}
+	}
+	if s.EndedAt != nil {
+		info.EndedAt = pointy.GetPointer(s.EndedAt.UnixMilli())
+	}
+	return info
+}
+
+// impersonationError 将会话错误转换为 i18n 错误，其他错误按数据库错误处理
+func impersonationError(logger logx.Logger, err error, detail any) error {
+	switch {
+	case errors.Is(err, impersonation.ErrReasonRequired):
+		return errorx.NewInvalidArgumentError("tenant.impersonationReasonRequired")
+	case errors.Is(err, impersonation.ErrDurationExceeded):
+		return errorx.NewInvalidArgumentError("tenant.impersonationDurationExceeded")
+	case errors.Is(err, impersonation.ErrNotPending):
+		return errorx.NewInvalidArgumentError("tenant.impersonationNotPending")
+	case errors.Is(err, impersonation.ErrSelfApproval):
+		return errorx.NewInvalidArgumentError("tenant.impersonationSelfApproval")
+	default:
+		return dberrorhandler.DefaultEntError(logger, err, detail)
+	}
+}
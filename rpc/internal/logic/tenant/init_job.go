@@ -0,0 +1,57 @@
+package tenant
+
+import (
+	"github.com/coder-lulu/newbee-common/v2/utils/pointy"
+
+	"github.com/coder-lulu/newbee-core/rpc/ent"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+)
+
+func convertInitJob(job *ent.TenantInitJob) *core.TenantInitJobInfo {
+	info := &core.TenantInitJobInfo{
+		Id:        job.ID,
+		CreatedAt: job.CreatedAt.UnixMilli(),
+		UpdatedAt: job.UpdatedAt.UnixMilli(),
+		TenantId:  job.TenantID,
+		Template:  job.Template,
+		Mode:      job.Mode,
+		DryRun:    job.DryRun,
+		Status:    job.Status,
+		Attempts:  job.Attempts,
+		Error:     job.Error,
+	}
+	if job.StartedAt != nil {
+		info.StartedAt = pointy.GetPointer(job.StartedAt.UnixMilli())
+	}
+	if job.FinishedAt != nil {
+		info.FinishedAt = pointy.GetPointer(job.FinishedAt.UnixMilli())
+	}
+
+	for _, v := range job.Plugins {
+		plugin := &core.TenantInitPluginProgress{
+			Name:     v.Name,
+			Status:   v.Status,
+			Error:    v.Error,
+			Attempts: uint32(v.Attempts),
+		}
+		if v.StartedAt != nil {
+			plugin.StartedAt = pointy.GetPointer(v.StartedAt.UnixMilli())
+		}
+		if v.FinishedAt != nil {
+			plugin.FinishedAt = pointy.GetPointer(v.FinishedAt.UnixMilli())
+		}
+		info.Plugins = append(info.Plugins, plugin)
+	}
+
+	for _, v := range job.Plan {
+		info.Plan = append(info.Plan, &core.TenantInitPlanItem{
+			Plugin:    v.Plugin,
+			Component: v.Component,
+			Action:    v.Action,
+			Count:     uint64(v.Count),
+			Items:     v.Items,
+		})
+	}
+
+	return info
+}
@@ -0,0 +1,57 @@
+package tenant
+
+import (
+	"context"
+	"errors"
+
+	"github.com/coder-lulu/newbee-common/v2/orm/ent/hooks"
+	"github.com/zeromicro/go-zero/core/errorx"
+	"github.com/zeromicro/go-zero/core/logx"
+
+	"github.com/coder-lulu/newbee-core/rpc/internal/svc"
+	"github.com/coder-lulu/newbee-core/rpc/internal/tenantinit"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/dberrorhandler"
+)
+
+// resolveTemplate loads and validates the initialization template, an empty name means the base template
+func resolveTemplate(ctx context.Context, svcCtx *svc.ServiceContext, logger logx.Logger, name string) (*tenantinit.InitializationTemplate, error) {
+	template, err := tenantinit.Resolve(ctx, svcCtx.DB, name)
+	if err == nil {
+		err = tenantinit.NewDefaultTenantInitializer(logger).Validate(template)
+	}
+	if err != nil {
+		var invalid tenantinit.ErrInvalidTemplate
+		switch {
+		case errors.Is(err, tenantinit.ErrTemplateNotFound):
+			return nil, errorx.NewInvalidArgumentError("tenant.initTemplateNotFound")
+		case errors.Is(err, tenantinit.ErrTemplateCycle), errors.As(err, &invalid):
+			logger.Errorw("invalid tenant initialization template", logx.Field("detail", err.Error()), logx.Field("template", name))
+			return nil, errorx.NewInvalidArgumentError("tenant.invalidInitTemplate")
+		default:
+			return nil, dberrorhandler.DefaultEntError(logger, err, name)
+		}
+	}
+
+	return template, nil
+}
+
+// recordTemplate records the template in the tenant config, the core plugin reads it from there
+func recordTemplate(ctx context.Context, svcCtx *svc.ServiceContext, tenantID uint64, name string) error {
+	systemCtx := hooks.NewSystemContext(ctx)
+
+	t, err := svcCtx.DB.Tenant.Get(systemCtx, tenantID)
+	if err != nil {
+		return err
+	}
+	if t.Config != nil && t.Config["status"] == "completed" {
+		return nil
+	}
+
+	config := make(map[string]interface{}, len(t.Config)+1)
+	for k, v := range t.Config {
+		config[k] = v
+	}
+	config[tenantinit.TemplateConfigKey] = name
+
+	return svcCtx.DB.Tenant.UpdateOneID(tenantID).SetConfig(config).Exec(systemCtx)
+}
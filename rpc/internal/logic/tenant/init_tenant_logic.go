@@ -7,12 +7,10 @@ import (
 	"time"
 
 	"github.com/coder-lulu/newbee-core/rpc/ent"
-	"github.com/coder-lulu/newbee-core/rpc/ent/configuration"
-	"github.com/coder-lulu/newbee-core/rpc/ent/dictionary"
 	"github.com/coder-lulu/newbee-core/rpc/ent/menu"
-	"github.com/coder-lulu/newbee-core/rpc/ent/position"
 	"github.com/coder-lulu/newbee-core/rpc/ent/tenant"
 	"github.com/coder-lulu/newbee-core/rpc/internal/svc"
+	"github.com/coder-lulu/newbee-core/rpc/internal/tenantinit"
 	"github.com/coder-lulu/newbee-core/rpc/internal/utils/dberrorhandler"
 	"github.com/coder-lulu/newbee-core/rpc/internal/utils/redisfunc"
 	"github.com/coder-lulu/newbee-core/rpc/types/core"
@@ -61,13 +59,18 @@ func NewInitTenantLogicLegacy(ctx context.Context, svcCtx *svc.ServiceContext) *
 	}
 }
 
-// InitTenant 租户初始化逻辑
-func (l *InitTenantLogic) InitTenant(in *core.TenantInitReq) (*core.BaseResp, error) {
+// InitTenant 租户初始化逻辑，插件框架创建后台任务并返回任务ID，旧版流程同步执行
+func (l *InitTenantLogic) InitTenant(in *core.TenantInitReq) (*core.BaseIDResp, error) {
 	if !l.bypassPlugins {
 		return NewInitTenantLogicV2(l.ctx, l.svcCtx).InitTenant(in)
 	}
 
-	return l.initTenantLegacy(in)
+	resp, err := l.initTenantLegacy(in)
+	if err != nil {
+		return nil, err
+	}
+
+	return &core.BaseIDResp{Msg: resp.Msg}, nil
 }
 
 // initTenantLegacy 保留旧版初始化流程，供插件框架降级使用
@@ -97,6 +100,11 @@ func (l *InitTenantLogic) initTenantLegacy(in *core.TenantInitReq) (*core.BaseRe
 		}
 	}
 
+	template, err := resolveTemplate(l.ctx, l.svcCtx, l.Logger, in.GetTemplate())
+	if err != nil {
+		return nil, err
+	}
+
 	// 创建租户专属的上下文,使用统一的ContextManager
 	cm := keys.NewContextManager()
 	tenantIDStr := strconv.FormatUint(in.TenantId, 10)
@@ -116,10 +124,11 @@ func (l *InitTenantLogic) initTenantLegacy(in *core.TenantInitReq) (*core.BaseRe
 
 	// 记录初始化开始状态
 	initConfig := map[string]interface{}{
-		"initialized_at": time.Now().Format(time.RFC3339),
-		"version":        "1.0.0",
-		"components":     []string{},
-		"status":         "initializing",
+		"initialized_at":             time.Now().Format(time.RFC3339),
+		"version":                    "1.0.0",
+		"components":                 []string{},
+		"status":                     "initializing",
+		tenantinit.TemplateConfigKey: template.Name,
 	}
 	_, err = tx.Tenant.UpdateOneID(in.TenantId).
 		SetConfig(initConfig).
@@ -129,43 +138,35 @@ func (l *InitTenantLogic) initTenantLegacy(in *core.TenantInitReq) (*core.BaseRe
 		return nil, dberrorhandler.DefaultEntError(l.Logger, err, in)
 	}
 
-	// 第一阶段：初始化字典数据
-	if err = l.initDictionaries(tenantCtx, tx, in.TenantId); err != nil {
+	// 第一阶段：按模板初始化租户菜单（为租户创建菜单副本）
+	if err = l.initTenantMenus(tenantCtx, tx, in.TenantId, template); err != nil {
 		tx.Rollback()
 		return nil, err
 	}
-	initConfig["components"] = append(initConfig["components"].([]string), "dictionaries")
+	initConfig["components"] = append(initConfig["components"].([]string), "tenant_menus")
 
-	// 第二阶段：初始化系统配置
-	if err = l.initConfigurations(tenantCtx, tx, in.TenantId); err != nil {
+	// 第二阶段：创建默认部门
+	dept, err := l.initDepartment(tenantCtx, tx, in.TenantId)
+	if err != nil {
 		tx.Rollback()
 		return nil, err
 	}
-	initConfig["components"] = append(initConfig["components"].([]string), "configurations")
+	initConfig["components"] = append(initConfig["components"].([]string), "departments")
 
-	// 第三阶段：初始化租户菜单（为租户创建菜单副本）
-	if err = l.initTenantMenus(tenantCtx, tx, in.TenantId); err != nil {
+	// 第三阶段：写入模板的字典、配置和职位
+	if err = tenantinit.NewDefaultTenantInitializer(l.Logger).Initialize(tenantCtx, tx, in.TenantId, template); err != nil {
 		tx.Rollback()
-		return nil, err
+		l.Logger.Errorw("failed to apply tenant initialization template", logx.Field("detail", err.Error()),
+			logx.Field("tenant_id", in.TenantId), logx.Field("template", template.Name))
+		return nil, errorx.NewInternalError("tenant.initFailed")
 	}
-	initConfig["components"] = append(initConfig["components"].([]string), "tenant_menus")
-
-	// 第四阶段：API权限是系统级的，无需为每个租户单独初始化
-	// if err := l.initAPIPermissions(tenantCtx, tx, in.TenantId); err != nil {
-	// 	tx.Rollback()
-	// 	return nil, err
-	// }
-	// initConfig.Components = append(initConfig.Components, "api_permissions")
-
-	// 第五阶段：创建默认部门和职位
-	dept, err := l.initDepartmentAndPositions(tenantCtx, tx, in.TenantId)
-	if err != nil {
-		tx.Rollback()
-		return nil, err
+	for _, comp := range template.Components {
+		if comp.Name != tenantinit.ComponentMenus && comp.Name != tenantinit.ComponentAPIPermissions {
+			initConfig["components"] = append(initConfig["components"].([]string), comp.Name)
+		}
 	}
-	initConfig["components"] = append(initConfig["components"].([]string), "departments", "positions")
 
-	// 第六阶段：创建管理员角色和用户
+	// 第四阶段：创建管理员角色和用户
 	adminRole, adminUser, err := l.initAdminRoleAndUser(tenantCtx, tx, in, dept)
 	if err != nil {
 		tx.Rollback()
@@ -173,7 +174,7 @@ func (l *InitTenantLogic) initTenantLegacy(in *core.TenantInitReq) (*core.BaseRe
 	}
 	initConfig["components"] = append(initConfig["components"].([]string), "admin_role", "admin_user")
 
-	// 第七阶段：完成初始化状态更新
+	// 第五阶段：完成初始化状态更新
 	initConfig["status"] = "completed"
 	initConfig["completed_at"] = time.Now().Format(time.RFC3339)
 	_, err = tx.Tenant.UpdateOneID(in.TenantId).
@@ -211,239 +212,8 @@ func (l *InitTenantLogic) initTenantLegacy(in *core.TenantInitReq) (*core.BaseRe
 	}, nil
 }
 
-// initDictionaries 初始化字典数据
-func (l *InitTenantLogic) initDictionaries(ctx context.Context, tx *ent.Tx, tenantID uint64) error {
-	// 检查是否已存在字典数据，避免重复创建
-	existingCount, err := tx.Dictionary.Query().
-		Where(dictionary.TenantIDEQ(tenantID)).
-		Count(ctx)
-	if err != nil {
-		return dberrorhandler.DefaultEntError(l.Logger, err, nil)
-	}
-	if existingCount > 0 {
-		logx.Infow("Dictionaries already exist, skipping", logx.Field("tenant_id", tenantID))
-		return nil
-	}
-
-	// 创建基础字典
-	dictionaries := []*ent.DictionaryCreate{
-		tx.Dictionary.Create().
-			SetTitle("用户性别").
-			SetName("sys_user_sex").
-			SetDesc("用户性别列表").
-			SetStatus(1).
-			SetTenantID(tenantID),
-		tx.Dictionary.Create().
-			SetTitle("系统状态").
-			SetName("sys_common_status").
-			SetDesc("通用状态列表").
-			SetStatus(1).
-			SetTenantID(tenantID),
-		tx.Dictionary.Create().
-			SetTitle("系统是否").
-			SetName("sys_yes_no").
-			SetDesc("系统是否列表").
-			SetStatus(1).
-			SetTenantID(tenantID),
-		tx.Dictionary.Create().
-			SetTitle("显示隐藏").
-			SetName("sys_show_hide").
-			SetDesc("菜单显示隐藏状态").
-			SetStatus(1).
-			SetTenantID(tenantID),
-		tx.Dictionary.Create().
-			SetTitle("操作类型").
-			SetName("sys_oper_type").
-			SetDesc("操作类型列表").
-			SetStatus(1).
-			SetTenantID(tenantID),
-	}
-
-	if err := tx.Dictionary.CreateBulk(dictionaries...).Exec(ctx); err != nil {
-		return dberrorhandler.DefaultEntError(l.Logger, err, nil)
-	}
-
-	// 获取刚创建的字典记录，避免硬编码ID
-	createdDicts, err := tx.Dictionary.Query().
-		Where(dictionary.TenantIDEQ(tenantID)).
-		Order(ent.Asc(dictionary.FieldID)).
-		All(ctx)
-	if err != nil {
-		return dberrorhandler.DefaultEntError(l.Logger, err, nil)
-	}
-
-	// 创建字典名称到ID的映射
-	dictMap := make(map[string]uint64)
-	for _, dict := range createdDicts {
-		dictMap[dict.Name] = dict.ID
-	}
-
-	// 为每个字典创建对应的详情数据
-	var allDetails []*ent.DictionaryDetailCreate
-
-	// 用户性别详情
-	if sexDictID, exists := dictMap["sys_user_sex"]; exists {
-		sexDetails := []*ent.DictionaryDetailCreate{
-			tx.DictionaryDetail.Create().
-				SetTitle("男").SetValue("0").SetDictionariesID(sexDictID).
-				SetStatus(1).SetSort(1).SetIsDefault(1).SetTenantID(tenantID),
-			tx.DictionaryDetail.Create().
-				SetTitle("女").SetValue("1").SetDictionariesID(sexDictID).
-				SetStatus(1).SetSort(2).SetIsDefault(0).SetTenantID(tenantID),
-			tx.DictionaryDetail.Create().
-				SetTitle("未知").SetValue("2").SetDictionariesID(sexDictID).
-				SetStatus(1).SetSort(3).SetIsDefault(0).SetTenantID(tenantID),
-		}
-		allDetails = append(allDetails, sexDetails...)
-	}
-
-	// 系统状态详情
-	if statusDictID, exists := dictMap["sys_common_status"]; exists {
-		statusDetails := []*ent.DictionaryDetailCreate{
-			tx.DictionaryDetail.Create().
-				SetTitle("正常").SetValue("1").SetDictionariesID(statusDictID).
-				SetStatus(1).SetSort(1).SetIsDefault(1).SetTenantID(tenantID),
-			tx.DictionaryDetail.Create().
-				SetTitle("停用").SetValue("0").SetDictionariesID(statusDictID).
-				SetStatus(1).SetSort(2).SetIsDefault(0).SetTenantID(tenantID),
-		}
-		allDetails = append(allDetails, statusDetails...)
-	}
-
-	// 系统是否详情
-	if yesNoDictID, exists := dictMap["sys_yes_no"]; exists {
-		yesNoDetails := []*ent.DictionaryDetailCreate{
-			tx.DictionaryDetail.Create().
-				SetTitle("是").SetValue("1").SetDictionariesID(yesNoDictID).
-				SetStatus(1).SetSort(1).SetIsDefault(0).SetTenantID(tenantID),
-			tx.DictionaryDetail.Create().
-				SetTitle("否").SetValue("0").SetDictionariesID(yesNoDictID).
-				SetStatus(1).SetSort(2).SetIsDefault(1).SetTenantID(tenantID),
-		}
-		allDetails = append(allDetails, yesNoDetails...)
-	}
-
-	// 显示隐藏详情
-	if showHideDictID, exists := dictMap["sys_show_hide"]; exists {
-		showHideDetails := []*ent.DictionaryDetailCreate{
-			tx.DictionaryDetail.Create().
-				SetTitle("显示").SetValue("1").SetDictionariesID(showHideDictID).
-				SetStatus(1).SetSort(1).SetIsDefault(1).SetTenantID(tenantID),
-			tx.DictionaryDetail.Create().
-				SetTitle("隐藏").SetValue("0").SetDictionariesID(showHideDictID).
-				SetStatus(1).SetSort(2).SetIsDefault(0).SetTenantID(tenantID),
-		}
-		allDetails = append(allDetails, showHideDetails...)
-	}
-
-	// 操作类型详情
-	if operTypeDictID, exists := dictMap["sys_oper_type"]; exists {
-		operTypeDetails := []*ent.DictionaryDetailCreate{
-			tx.DictionaryDetail.Create().
-				SetTitle("新增").SetValue("1").SetDictionariesID(operTypeDictID).
-				SetStatus(1).SetSort(1).SetIsDefault(0).SetTenantID(tenantID),
-			tx.DictionaryDetail.Create().
-				SetTitle("修改").SetValue("2").SetDictionariesID(operTypeDictID).
-				SetStatus(1).SetSort(2).SetIsDefault(0).SetTenantID(tenantID),
-			tx.DictionaryDetail.Create().
-				SetTitle("删除").SetValue("3").SetDictionariesID(operTypeDictID).
-				SetStatus(1).SetSort(3).SetIsDefault(0).SetTenantID(tenantID),
-		}
-		allDetails = append(allDetails, operTypeDetails...)
-	}
-
-	// 批量创建所有详情数据
-	if len(allDetails) > 0 {
-		if err := tx.DictionaryDetail.CreateBulk(allDetails...).Exec(ctx); err != nil {
-			return dberrorhandler.DefaultEntError(l.Logger, err, nil)
-		}
-	}
-
-	return nil
-}
-
-// initConfigurations 初始化系统配置
-func (l *InitTenantLogic) initConfigurations(ctx context.Context, tx *ent.Tx, tenantID uint64) error {
-	// 检查是否已存在配置数据
-	existingCount, err := tx.Configuration.Query().
-		Where(configuration.TenantIDEQ(tenantID)).
-		Count(ctx)
-	if err != nil {
-		return dberrorhandler.DefaultEntError(l.Logger, err, nil)
-	}
-	if existingCount > 0 {
-		logx.Infow("Configurations already exist, skipping", logx.Field("tenant_id", tenantID))
-		return nil
-	}
-
-	// 创建基础配置
-	configs := []*ent.ConfigurationCreate{
-		tx.Configuration.Create().
-			SetName("系统名称").
-			SetKey("system.title").
-			SetValue("NewBee管理系统").
-			SetCategory("system").
-			SetRemark("系统页面标题").
-			SetSort(1).
-			SetState(true).
-			SetTenantID(tenantID),
-		tx.Configuration.Create().
-			SetName("用户初始密码").
-			SetKey("system.user.init_password").
-			SetValue("123456").
-			SetCategory("system").
-			SetRemark("新用户的初始密码").
-			SetSort(2).
-			SetState(true).
-			SetTenantID(tenantID),
-		tx.Configuration.Create().
-			SetName("会话超时时间").
-			SetKey("system.session.timeout").
-			SetValue("30").
-			SetCategory("system").
-			SetRemark("用户会话超时时间(分钟)").
-			SetSort(3).
-			SetState(true).
-			SetTenantID(tenantID),
-		tx.Configuration.Create().
-			SetName("密码复杂度检查").
-			SetKey("system.password.complexity_check").
-			SetValue("true").
-			SetCategory("security").
-			SetRemark("是否启用密码复杂度检查").
-			SetSort(4).
-			SetState(true).
-			SetTenantID(tenantID),
-		tx.Configuration.Create().
-			SetName("登录失败锁定").
-			SetKey("system.login.lock_enabled").
-			SetValue("true").
-			SetCategory("security").
-			SetRemark("是否启用登录失败锁定").
-			SetSort(5).
-			SetState(true).
-			SetTenantID(tenantID),
-		tx.Configuration.Create().
-			SetName("最大登录失败次数").
-			SetKey("system.login.max_retry_count").
-			SetValue("5").
-			SetCategory("security").
-			SetRemark("最大登录失败次数").
-			SetSort(6).
-			SetState(true).
-			SetTenantID(tenantID),
-	}
-
-	if err := tx.Configuration.CreateBulk(configs...).Exec(ctx); err != nil {
-		return dberrorhandler.DefaultEntError(l.Logger, err, nil)
-	}
-
-	return nil
-}
-
-// initDepartmentAndPositions 初始化部门和职位
-func (l *InitTenantLogic) initDepartmentAndPositions(ctx context.Context, tx *ent.Tx, tenantID uint64) (*ent.Department, error) {
-	// 创建默认部门
+// initDepartment 初始化默认部门，职位由初始化模板在该部门下创建
+func (l *InitTenantLogic) initDepartment(ctx context.Context, tx *ent.Tx, tenantID uint64) (*ent.Department, error) {
 	dept, err := tx.Department.Create().
 		SetName("总公司").
 		SetAncestors("").
@@ -459,47 +229,6 @@ func (l *InitTenantLogic) initDepartmentAndPositions(ctx context.Context, tx *en
 		return nil, dberrorhandler.DefaultEntError(l.Logger, err, nil)
 	}
 
-	// 检查是否已存在职位数据
-	existingPosCount, err := tx.Position.Query().
-		Where(position.TenantIDEQ(tenantID)).
-		Count(ctx)
-	if err != nil {
-		return nil, dberrorhandler.DefaultEntError(l.Logger, err, nil)
-	}
-	if existingPosCount == 0 {
-		// 创建默认职位
-		positions := []*ent.PositionCreate{
-			tx.Position.Create().
-				SetName("总经理").
-				SetCode("general_manager").
-				SetRemark("企业最高管理者").
-				SetDeptID(dept.ID).
-				SetStatus(1).
-				SetSort(1).
-				SetTenantID(tenantID),
-			tx.Position.Create().
-				SetName("部门经理").
-				SetCode("department_manager").
-				SetRemark("部门负责人").
-				SetDeptID(dept.ID).
-				SetStatus(1).
-				SetSort(2).
-				SetTenantID(tenantID),
-			tx.Position.Create().
-				SetName("普通员工").
-				SetCode("employee").
-				SetRemark("普通工作人员").
-				SetDeptID(dept.ID).
-				SetStatus(1).
-				SetSort(3).
-				SetTenantID(tenantID),
-		}
-
-		if err := tx.Position.CreateBulk(positions...).Exec(ctx); err != nil {
-			return nil, dberrorhandler.DefaultEntError(l.Logger, err, nil)
-		}
-	}
-
 	return dept, nil
 }
 
@@ -609,8 +338,8 @@ func (l *InitTenantLogic) initAdminRoleAndUser(ctx context.Context, tx *ent.Tx,
 	return adminRole, adminUser, nil
 }
 
-// initTenantMenus 为租户初始化菜单副本
-func (l *InitTenantLogic) initTenantMenus(ctx context.Context, tx *ent.Tx, tenantID uint64) error {
+// initTenantMenus 为租户初始化菜单副本，复制的系统菜单由模板的菜单组件筛选
+func (l *InitTenantLogic) initTenantMenus(ctx context.Context, tx *ent.Tx, tenantID uint64, template *tenantinit.InitializationTemplate) error {
 	// 检查是否已存在租户菜单数据
 	existingCount, err := tx.Menu.Query().
 		Where(menu.TenantIDEQ(tenantID)).
@@ -634,6 +363,7 @@ func (l *InitTenantLogic) initTenantMenus(ctx context.Context, tx *ent.Tx, tenan
 		// 如果没有基础菜单，创建基础菜单结构
 		return l.createBasicMenus(ctx, tx, tenantID)
 	}
+	baseMenus = tenantinit.MenuFilter(template)(baseMenus)
 
 	// 为租户创建菜单副本
 	var menuCreates []*ent.MenuCreate
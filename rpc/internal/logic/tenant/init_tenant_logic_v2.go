@@ -2,15 +2,20 @@ package tenant
 
 import (
 	"context"
-	"fmt"
-	"time"
 
+	"github.com/coder-lulu/newbee-common/v2/i18n"
+	"github.com/coder-lulu/newbee-common/v2/orm/ent/hooks"
 	"github.com/coder-lulu/newbee-common/v2/tenant"
+	tenant_ent "github.com/coder-lulu/newbee-core/rpc/ent/tenant"
+	"github.com/coder-lulu/newbee-core/rpc/internal/initjob"
 	"github.com/coder-lulu/newbee-core/rpc/internal/plugins"
 	"github.com/coder-lulu/newbee-core/rpc/internal/svc"
+	"github.com/coder-lulu/newbee-core/rpc/internal/tenantinit"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/dberrorhandler"
 	"github.com/coder-lulu/newbee-core/rpc/types/core"
 
 	"github.com/gofrs/uuid/v5"
+	"github.com/zeromicro/go-zero/core/errorx"
 	"github.com/zeromicro/go-zero/core/logx"
 )
 
@@ -20,7 +25,6 @@ type InitTenantLogicV2 struct {
 	ctx           context.Context
 	svcCtx        *svc.ServiceContext
 	pluginManager *tenant.PluginManager
-	stateManager  *tenant.StateManager
 	logger        logx.Logger
 	enabled       bool // 通过配置控制是否启用新框架
 }
@@ -44,7 +48,6 @@ func NewInitTenantLogicV2(ctx context.Context, svcCtx *svc.ServiceContext) *Init
 
 	// 创建插件管理器
 	pluginManager := tenant.NewPluginManager(config, logger)
-	stateManager := tenant.NewStateManager(svcCtx.DB, logger)
 
 	// 注册核心插件工厂
 	coreFactory := plugins.NewCorePluginFactory(svcCtx, logger)
@@ -87,224 +90,175 @@ func NewInitTenantLogicV2(ctx context.Context, svcCtx *svc.ServiceContext) *Init
 		ctx:           ctx,
 		svcCtx:        svcCtx,
 		pluginManager: pluginManager,
-		stateManager:  stateManager,
 		logger:        logger,
 		enabled:       true,
 	}
 }
 
-// InitTenant 执行租户初始化（保持API兼容）
-func (l *InitTenantLogicV2) InitTenant(in *core.TenantInitReq) (*core.BaseResp, error) {
-	// 如果新框架未启用，回退到旧逻辑
+// InitTenant 校验请求后创建初始化任务并返回任务ID，插件在后台执行，进度通过任务查询
+func (l *InitTenantLogicV2) InitTenant(in *core.TenantInitReq) (*core.BaseIDResp, error) {
+	// 如果新框架未启用，回退到旧逻辑同步执行，不支持试运行
 	if !l.enabled {
+		if in.GetDryRun() {
+			return nil, errorx.NewInvalidArgumentError("tenant.initJobUnavailable")
+		}
 		legacyLogic := NewInitTenantLogicLegacy(l.ctx, l.svcCtx)
 		return legacyLogic.InitTenant(in)
 	}
 
-	// 使用新框架执行初始化
-	return l.initTenantWithPlugins(in)
+	// 使用新框架创建初始化任务
+	return l.enqueue(in)
 }
 
-// initTenantWithPlugins 使用插件框架执行初始化
-func (l *InitTenantLogicV2) initTenantWithPlugins(in *core.TenantInitReq) (*core.BaseResp, error) {
-	l.logger.Infow("Starting tenant initialization with plugin framework",
-		logx.Field("tenant_id", in.TenantId))
-
-	// 生成请求ID
-	requestID := uuid.Must(uuid.NewV4()).String()
-
-	// 转换请求格式
-	initReq := &tenant.InitRequest{
-		TenantID:      in.TenantId,
-		AdminUsername: in.AdminUsername,
-		AdminPassword: in.AdminPassword,
-		AdminEmail:    in.AdminEmail,
-		RequestID:     requestID,
-		Mode:          tenant.InitModeFull,
-		DryRun:        false,
-		Timeout:       300 * time.Second,
-	}
-
-	// 获取启用的插件
-	plugins := l.pluginManager.GetEnabledPlugins()
-	if len(plugins) == 0 {
-		return nil, fmt.Errorf("no enabled plugins found")
-	}
-
-	// 创建初始化状态
-	if err := l.stateManager.CreateInitState(l.ctx, initReq, len(plugins)); err != nil {
-		l.logger.Errorw("Failed to create initialization state",
-			logx.Field("tenant_id", in.TenantId),
-			logx.Field("error", err.Error()))
-		return nil, fmt.Errorf("failed to create initialization state: %w", err)
+// enqueue 创建初始化任务并在后台执行插件
+func (l *InitTenantLogicV2) enqueue(in *core.TenantInitReq) (*core.BaseIDResp, error) {
+	exist, err := l.svcCtx.DB.Tenant.Query().Where(tenant_ent.IDEQ(in.TenantId)).Exist(hooks.NewSystemContext(l.ctx))
+	if err != nil {
+		return nil, dberrorhandler.DefaultEntError(l.logger, err, in)
 	}
-
-	// 更新状态为运行中
-	if err := l.stateManager.UpdateInitStatus(l.ctx, in.TenantId, tenant.StatusRunning, ""); err != nil {
-		l.logger.Errorw("Failed to update initialization status",
-			logx.Field("tenant_id", in.TenantId),
-			logx.Field("error", err.Error()))
+	if !exist {
+		return nil, errorx.NewInvalidArgumentError("tenant.notFound")
 	}
 
-	// 执行插件初始化
-	result, err := l.pluginManager.ExecutePlugins(l.ctx, initReq)
+	// 校验初始化模板，正式执行时记录到租户配置，由核心插件读取
+	template, err := resolveTemplate(l.ctx, l.svcCtx, l.logger, in.GetTemplate())
 	if err != nil {
-		l.logger.Errorw("Plugin execution failed",
-			logx.Field("tenant_id", in.TenantId),
-			logx.Field("error", err.Error()))
+		return nil, err
+	}
 
-		// 更新失败状态
-		l.stateManager.UpdateInitStatus(l.ctx, in.TenantId, tenant.StatusFailed, err.Error())
+	if !in.GetDryRun() {
+		running, err := l.svcCtx.InitJobs.Running(l.ctx, in.TenantId)
+		if err != nil {
+			l.logger.Errorw("failed to check tenant initialization lock", logx.Field("detail", err.Error()))
+			return nil, errorx.NewInternalError(i18n.RedisError)
+		}
+		if running {
+			return nil, errorx.NewInvalidArgumentError("tenant.initJobRunning")
+		}
 
-		// 如果配置了失败回滚，则执行回滚
-		if l.shouldRollbackOnFailure() {
-			if rollbackErr := l.rollbackTenant(l.ctx, in.TenantId, result.FailedPlugin); rollbackErr != nil {
-				l.logger.Errorw("Rollback failed",
-					logx.Field("tenant_id", in.TenantId),
-					logx.Field("rollback_error", rollbackErr.Error()))
-			}
+		if err := recordTemplate(l.ctx, l.svcCtx, in.TenantId, template.Name); err != nil {
+			return nil, dberrorhandler.DefaultEntError(l.logger, err, in)
 		}
+	}
 
-		return nil, fmt.Errorf("tenant initialization failed: %w", err)
+	plugins, err := l.executionOrder()
+	if err != nil {
+		return nil, err
 	}
 
-	// 更新成功状态
-	if err := l.stateManager.UpdateInitStatus(l.ctx, in.TenantId, tenant.StatusSuccess, ""); err != nil {
-		l.logger.Errorw("Failed to update success status",
-			logx.Field("tenant_id", in.TenantId),
-			logx.Field("error", err.Error()))
+	job, err := l.svcCtx.DB.TenantInitJob.Create().
+		SetTenantID(in.TenantId).
+		SetTemplate(template.Name).
+		SetMode(string(tenant.InitModeFull)).
+		SetDryRun(in.GetDryRun()).
+		SetStatus(initjob.StatusPending).
+		SetNillableAdminUsername(in.AdminUsername).
+		SetNillableAdminEmail(in.AdminEmail).
+		Save(l.ctx)
+	if err != nil {
+		return nil, dberrorhandler.DefaultEntError(l.logger, err, in)
 	}
 
-	l.logger.Infow("Tenant initialization completed successfully",
-		logx.Field("tenant_id", in.TenantId),
-		logx.Field("request_id", requestID),
-		logx.Field("duration", result.Duration),
-		logx.Field("plugin_count", len(result.PluginResults)))
+	l.svcCtx.InitJobs.Start(job, plugins, &tenant.InitRequest{
+		TenantID:      in.TenantId,
+		AdminUsername: in.AdminUsername,
+		AdminPassword: in.AdminPassword,
+		AdminEmail:    in.AdminEmail,
+		RequestID:     uuid.Must(uuid.NewV4()).String(),
+		Mode:          tenant.InitModeFull,
+		DryRun:        in.GetDryRun(),
+		Timeout:       tenantinit.DefaultJobTimeout,
+	})
 
-	return &core.BaseResp{
-		Msg: fmt.Sprintf("租户初始化成功，耗时 %v", result.Duration),
-	}, nil
-}
+	l.logger.Infow("Tenant initialization job queued",
+		logx.Field("tenant_id", in.TenantId),
+		logx.Field("job_id", job.ID),
+		logx.Field("template", template.Name),
+		logx.Field("dry_run", in.GetDryRun()))
 
-// shouldRollbackOnFailure 检查是否应该在失败时回滚
-func (l *InitTenantLogicV2) shouldRollbackOnFailure() bool {
-	// 从配置中读取回滚策略
-	// 这里简化实现，实际可以从配置文件读取
-	return true
+	return &core.BaseIDResp{Id: job.ID, Msg: "tenant.initQueued"}, nil
 }
 
-// rollbackTenant 回滚租户初始化
-func (l *InitTenantLogicV2) rollbackTenant(ctx context.Context, tenantID uint64, failedPlugin string) error {
-	l.logger.Infow("Starting tenant rollback",
-		logx.Field("tenant_id", tenantID),
-		logx.Field("failed_plugin", failedPlugin))
-
-	// 获取当前状态，确定需要回滚的插件
-	state, err := l.stateManager.GetState(ctx, tenantID)
-	if err != nil {
-		return fmt.Errorf("failed to get rollback state: %w", err)
+// RetryJob 以修复模式重新执行任务中未成功的插件，已成功的插件不会重复执行
+func (l *InitTenantLogicV2) RetryJob(in *core.TenantInitJobRetryReq) (*core.BaseIDResp, error) {
+	if !l.enabled {
+		return nil, errorx.NewInvalidArgumentError("tenant.initJobUnavailable")
 	}
 
-	// 获取执行顺序（用于反向回滚）
-	plugins, err := l.pluginManager.GetExecutionOrder()
+	job, err := l.svcCtx.DB.TenantInitJob.Get(l.ctx, in.Id)
 	if err != nil {
-		return fmt.Errorf("failed to get execution order for rollback: %w", err)
+		return nil, dberrorhandler.DefaultEntError(l.logger, err, in.Id)
 	}
-
-	// 反向执行回滚
-	rollbackCount := 0
-	for i := len(plugins) - 1; i >= 0; i-- {
-		plugin := plugins[i]
-		pluginName := plugin.GetMetadata().Name
-
-		// 跳过未执行或失败的插件
-		pluginState, exists := state.Plugins[pluginName]
-		if !exists || pluginState.Status != tenant.StatusSuccess {
-			continue
-		}
-
-		l.logger.Infow("Rolling back plugin",
-			logx.Field("plugin", pluginName),
-			logx.Field("tenant_id", tenantID))
-
-		// 执行插件回滚
-		if err := plugin.Rollback(ctx, tenantID); err != nil {
-			l.logger.Errorw("Plugin rollback failed",
-				logx.Field("plugin", pluginName),
-				logx.Field("tenant_id", tenantID),
-				logx.Field("error", err.Error()))
-			// 继续回滚其他插件，但记录错误
-		} else {
-			rollbackCount++
-			l.logger.Infow("Plugin rollback completed",
-				logx.Field("plugin", pluginName),
-				logx.Field("tenant_id", tenantID))
-		}
+	if job.DryRun || job.Status == initjob.StatusSuccess {
+		return nil, errorx.NewInvalidArgumentError("tenant.initJobNotRetryable")
 	}
 
-	// 更新状态为已回滚
-	if err := l.stateManager.UpdateInitStatus(ctx, tenantID, tenant.StatusRolledback,
-		fmt.Sprintf("Rolled back %d plugins", rollbackCount)); err != nil {
-		l.logger.Errorw("Failed to update rollback status",
-			logx.Field("tenant_id", tenantID),
-			logx.Field("error", err.Error()))
+	// 执行中的任务不能重试，实例异常退出后遗留的执行中任务没有持有锁，可以重试
+	running, err := l.svcCtx.InitJobs.Running(l.ctx, job.TenantID)
+	if err != nil {
+		l.logger.Errorw("failed to check tenant initialization lock", logx.Field("detail", err.Error()))
+		return nil, errorx.NewInternalError(i18n.RedisError)
 	}
-
-	l.logger.Infow("Tenant rollback completed",
-		logx.Field("tenant_id", tenantID),
-		logx.Field("rollback_count", rollbackCount))
-
-	return nil
-}
-
-// GetInitializationStatus 获取初始化状态（新增方法）
-func (l *InitTenantLogicV2) GetInitializationStatus(tenantID uint64) (*tenant.InitializationState, error) {
-	if !l.enabled {
-		return nil, fmt.Errorf("plugin framework not enabled")
+	if running {
+		return nil, errorx.NewInvalidArgumentError("tenant.initJobRunning")
 	}
 
-	return l.stateManager.GetState(l.ctx, tenantID)
-}
-
-// RetryInitialization 重试失败的初始化（新增方法）
-func (l *InitTenantLogicV2) RetryInitialization(tenantID uint64) error {
-	if !l.enabled {
-		return fmt.Errorf("plugin framework not enabled")
+	plugins, err := l.executionOrder()
+	if err != nil {
+		return nil, err
 	}
 
-	state, err := l.stateManager.GetState(l.ctx, tenantID)
+	err = l.svcCtx.DB.TenantInitJob.UpdateOneID(job.ID).
+		SetStatus(initjob.StatusPending).
+		SetMode(string(tenant.InitModeRepair)).
+		Exec(l.ctx)
 	if err != nil {
-		return fmt.Errorf("failed to get current state: %w", err)
+		return nil, dberrorhandler.DefaultEntError(l.logger, err, in.Id)
 	}
 
-	if state.Status != tenant.StatusFailed {
-		return fmt.Errorf("tenant %d is not in failed state, current status: %s", tenantID, state.Status)
+	req := &tenant.InitRequest{
+		TenantID:      job.TenantID,
+		AdminPassword: in.AdminPassword,
+		RequestID:     uuid.Must(uuid.NewV4()).String(),
+		Mode:          tenant.InitModeRepair,
+		Timeout:       tenantinit.DefaultJobTimeout,
 	}
+	if job.AdminUsername != "" {
+		req.AdminUsername = &job.AdminUsername
+	}
+	if job.AdminEmail != "" {
+		req.AdminEmail = &job.AdminEmail
+	}
+	l.svcCtx.InitJobs.Start(job, plugins, req)
 
-	// 重构请求并重试
-	initReq := &tenant.InitRequest{
-		TenantID:  tenantID,
-		RequestID: uuid.Must(uuid.NewV4()).String(),
-		Mode:      tenant.InitModeRepair, // 使用修复模式
-		DryRun:    false,
-		Timeout:   300 * time.Second,
+	l.logger.Infow("Tenant initialization job retried",
+		logx.Field("tenant_id", job.TenantID),
+		logx.Field("job_id", job.ID))
+
+	return &core.BaseIDResp{Id: job.ID, Msg: "tenant.initQueued"}, nil
+}
+
+// executionOrder 合并本地插件和已注册的远程插件，按依赖关系排序
+func (l *InitTenantLogicV2) executionOrder() ([]tenant.TenantInitPlugin, error) {
+	local, err := l.pluginManager.GetExecutionOrder()
+	if err != nil || len(local) == 0 {
+		l.logger.Errorw("no enabled tenant initialization plugins")
+		return nil, errorx.NewInternalError("tenant.initJobUnavailable")
 	}
 
-	// 重置状态
-	plugins := l.pluginManager.GetEnabledPlugins()
-	if err := l.stateManager.CreateInitState(l.ctx, initReq, len(plugins)); err != nil {
-		return fmt.Errorf("failed to reset state: %w", err)
+	remote, err := plugins.LoadRemotePlugins(l.ctx, l.svcCtx.DB)
+	if err != nil {
+		l.logger.Errorw("failed to load remote tenant initialization plugins", logx.Field("detail", err.Error()))
+		return nil, errorx.NewInternalError("tenant.initPluginsUnavailable")
 	}
 
-	// 执行重试
-	_, err = l.pluginManager.ExecutePlugins(l.ctx, initReq)
+	result, err := tenantinit.SortPlugins(append(local, remote...))
 	if err != nil {
-		l.stateManager.UpdateInitStatus(l.ctx, tenantID, tenant.StatusFailed, err.Error())
-		return fmt.Errorf("retry failed: %w", err)
+		l.logger.Errorw("invalid tenant initialization plugins", logx.Field("detail", err.Error()))
+		return nil, errorx.NewInternalError("tenant.initPluginsUnavailable")
 	}
 
-	l.stateManager.UpdateInitStatus(l.ctx, tenantID, tenant.StatusSuccess, "Retry completed successfully")
-	return nil
+	return result, nil
 }
 
 // ListPlugins 列出可用插件（新增方法）
@@ -313,5 +267,18 @@ func (l *InitTenantLogicV2) ListPlugins() map[string]tenant.PluginMetadata {
 		return nil
 	}
 
-	return l.pluginManager.ListPlugins()
+	result := l.pluginManager.ListPlugins()
+	remote, err := plugins.LoadRemotePlugins(l.ctx, l.svcCtx.DB)
+	if err != nil {
+		l.logger.Errorw("failed to load remote tenant initialization plugins", logx.Field("detail", err.Error()))
+		return result
+	}
+
+	if result == nil {
+		result = make(map[string]tenant.PluginMetadata, len(remote))
+	}
+	for _, p := range remote {
+		result[p.GetMetadata().Name] = p.GetMetadata()
+	}
+	return result
 }
@@ -0,0 +1,35 @@
+package tenant
+
+import (
+	"context"
+
+	"github.com/coder-lulu/newbee-core/rpc/internal/svc"
+	"github.com/coder-lulu/newbee-core/rpc/internal/tenantdoctor"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type RepairTenantLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewRepairTenantLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RepairTenantLogic {
+	return &RepairTenantLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// RepairTenant applies the repair plans of the tenant findings in one transaction and returns the repaired findings
+func (l *RepairTenantLogic) RepairTenant(in *core.TenantRepairReq) (*core.TenantDoctorResp, error) {
+	repaired, err := tenantdoctor.NewDoctor(l.svcCtx, l.Logger).Repair(l.ctx, in.TenantId, in.Codes, in.AdminPassword)
+	if err != nil {
+		return nil, doctorError(l.Logger, err)
+	}
+
+	return convertDoctorFindings(repaired), nil
+}
@@ -0,0 +1,29 @@
+package tenant
+
+import (
+	"context"
+
+	"github.com/coder-lulu/newbee-core/rpc/internal/svc"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type RetryTenantInitJobLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewRetryTenantInitJobLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RetryTenantInitJobLogic {
+	return &RetryTenantInitJobLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// RetryTenantInitJob runs the plugins of the job which did not succeed again
+func (l *RetryTenantInitJobLogic) RetryTenantInitJob(in *core.TenantInitJobRetryReq) (*core.BaseIDResp, error) {
+	return NewInitTenantLogicV2(l.ctx, l.svcCtx).RetryJob(in)
+}
@@ -0,0 +1,33 @@
+package tenant
+
+import (
+	"context"
+
+	"github.com/coder-lulu/newbee-core/rpc/internal/svc"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ReviewImpersonationLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewReviewImpersonationLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ReviewImpersonationLogic {
+	return &ReviewImpersonationLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *ReviewImpersonationLogic) ReviewImpersonation(in *core.ImpersonationReviewReq) (*core.ImpersonationSessionInfo, error) {
+	s, err := newImpersonationManager(l.svcCtx, l.Logger).Review(l.ctx, in.Id, in.ReviewerId, in.Approve)
+	if err != nil {
+		return nil, impersonationError(l.Logger, err, in)
+	}
+
+	return convertImpersonationSession(s), nil
+}
@@ -0,0 +1,72 @@
+package tenant
+
+import (
+	"context"
+	"time"
+
+	"github.com/coder-lulu/newbee-common/v2/orm/ent/hooks"
+
+	"github.com/coder-lulu/newbee-core/rpc/ent/tenant"
+	"github.com/coder-lulu/newbee-core/rpc/internal/impersonation"
+	"github.com/coder-lulu/newbee-core/rpc/internal/svc"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/dberrorhandler"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/errorx"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type StartImpersonationLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewStartImpersonationLogic(ctx context.Context, svcCtx *svc.ServiceContext) *StartImpersonationLogic {
+	return &StartImpersonationLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *StartImpersonationLogic) StartImpersonation(in *core.ImpersonationStartReq) (*core.ImpersonationSessionInfo, error) {
+	exist, err := l.svcCtx.DB.Tenant.Query().
+		Where(tenant.IDEQ(in.TargetTenantId), tenant.StatusEQ(1)).
+		Exist(hooks.NewSystemContext(l.ctx))
+	if err != nil {
+		return nil, dberrorhandler.DefaultEntError(l.Logger, err, in)
+	}
+	if !exist {
+		return nil, errorx.NewInvalidArgumentError("tenant.notFound")
+	}
+
+	req := impersonation.StartRequest{
+		UserID:         in.UserId,
+		OriginTenantID: in.OriginTenantId,
+		TargetTenantID: in.TargetTenantId,
+		Reason:         in.Reason,
+	}
+	if in.UserName != nil {
+		req.UserName = *in.UserName
+	}
+	if in.Ticket != nil {
+		req.Ticket = *in.Ticket
+	}
+	if in.DurationMinutes != nil {
+		req.Duration = time.Duration(*in.DurationMinutes) * time.Minute
+	}
+
+	s, err := newImpersonationManager(l.svcCtx, l.Logger).Start(l.ctx, req)
+	if err != nil {
+		return nil, impersonationError(l.Logger, err, in)
+	}
+
+	l.Infow("impersonation session created",
+		logx.Field("sessionId", s.ID),
+		logx.Field("userId", s.UserID),
+		logx.Field("targetTenantId", s.TargetTenantID),
+		logx.Field("state", s.State))
+
+	return convertImpersonationSession(s), nil
+}
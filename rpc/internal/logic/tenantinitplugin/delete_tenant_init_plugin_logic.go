@@ -0,0 +1,38 @@
+package tenantinitplugin
+
+import (
+	"context"
+
+	"github.com/coder-lulu/newbee-common/v2/i18n"
+
+	"github.com/coder-lulu/newbee-core/rpc/ent/tenantinitplugin"
+	"github.com/coder-lulu/newbee-core/rpc/internal/svc"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/dberrorhandler"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type DeleteTenantInitPluginLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewDeleteTenantInitPluginLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DeleteTenantInitPluginLogic {
+	return &DeleteTenantInitPluginLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// DeleteTenantInitPlugin removes the registrations, a service still running registers again on its next startup
+func (l *DeleteTenantInitPluginLogic) DeleteTenantInitPlugin(in *core.IDsReq) (*core.BaseResp, error) {
+	_, err := l.svcCtx.DB.TenantInitPlugin.Delete().Where(tenantinitplugin.IDIn(in.Ids...)).Exec(l.ctx)
+	if err != nil {
+		return nil, dberrorhandler.DefaultEntError(l.Logger, err, in)
+	}
+
+	return &core.BaseResp{Msg: i18n.DeleteSuccess}, nil
+}
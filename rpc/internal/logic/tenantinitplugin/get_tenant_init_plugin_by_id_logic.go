@@ -0,0 +1,34 @@
+package tenantinitplugin
+
+import (
+	"context"
+
+	"github.com/coder-lulu/newbee-core/rpc/internal/svc"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/dberrorhandler"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetTenantInitPluginByIdLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetTenantInitPluginByIdLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetTenantInitPluginByIdLogic {
+	return &GetTenantInitPluginByIdLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *GetTenantInitPluginByIdLogic) GetTenantInitPluginById(in *core.IDReq) (*core.TenantInitPluginInfo, error) {
+	result, err := l.svcCtx.DB.TenantInitPlugin.Get(l.ctx, in.Id)
+	if err != nil {
+		return nil, dberrorhandler.DefaultEntError(l.Logger, err, in)
+	}
+
+	return convertPlugin(result), nil
+}
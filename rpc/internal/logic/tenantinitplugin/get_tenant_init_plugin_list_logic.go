@@ -0,0 +1,51 @@
+package tenantinitplugin
+
+import (
+	"context"
+
+	"github.com/coder-lulu/newbee-core/rpc/ent"
+	"github.com/coder-lulu/newbee-core/rpc/ent/predicate"
+	"github.com/coder-lulu/newbee-core/rpc/ent/tenantinitplugin"
+	"github.com/coder-lulu/newbee-core/rpc/internal/svc"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/dberrorhandler"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetTenantInitPluginListLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetTenantInitPluginListLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetTenantInitPluginListLogic {
+	return &GetTenantInitPluginListLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *GetTenantInitPluginListLogic) GetTenantInitPluginList(in *core.TenantInitPluginListReq) (*core.TenantInitPluginListResp, error) {
+	var predicates []predicate.TenantInitPlugin
+	if in.Name != nil {
+		predicates = append(predicates, tenantinitplugin.NameContains(*in.Name))
+	}
+
+	result, err := l.svcCtx.DB.TenantInitPlugin.Query().Where(predicates...).Page(l.ctx, in.Page, in.PageSize, func(pager *ent.TenantInitPluginPager) {
+		pager.Order = ent.Asc(tenantinitplugin.FieldPriority)
+	})
+	if err != nil {
+		return nil, dberrorhandler.DefaultEntError(l.Logger, err, in)
+	}
+
+	resp := &core.TenantInitPluginListResp{}
+	resp.Total = result.PageDetails.Total
+
+	for _, v := range result.List {
+		resp.Data = append(resp.Data, convertPlugin(v))
+	}
+
+	return resp, nil
+}
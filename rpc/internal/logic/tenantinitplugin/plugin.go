@@ -0,0 +1,40 @@
+package tenantinitplugin
+
+import (
+	"slices"
+
+	"github.com/coder-lulu/newbee-common/v2/utils/pointy"
+	"github.com/zeromicro/go-zero/core/errorx"
+
+	"github.com/coder-lulu/newbee-core/rpc/ent"
+	"github.com/coder-lulu/newbee-core/rpc/internal/plugins"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/typeconv"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+)
+
+// validatePlugin checks the name, the target and the dependencies of a remote plugin.
+// The dependencies on unknown plugins and the cycles are reported when the tenants are initialized,
+// the plugins may register in any order.
+func validatePlugin(name, target string, dependencies []string) error {
+	if name == "" || name == plugins.CorePluginName || target == "" || slices.Contains(dependencies, name) {
+		return errorx.NewInvalidArgumentError("tenant.invalidInitPlugin")
+	}
+	return nil
+}
+
+func convertPlugin(p *ent.TenantInitPlugin) *core.TenantInitPluginInfo {
+	return &core.TenantInitPluginInfo{
+		Id:              &p.ID,
+		CreatedAt:       pointy.GetPointer(p.CreatedAt.UnixMilli()),
+		UpdatedAt:       pointy.GetPointer(p.UpdatedAt.UnixMilli()),
+		Status:          typeconv.ConvertStatusFromEnt(p.Status),
+		Name:            &p.Name,
+		Version:         &p.Version,
+		Description:     &p.Description,
+		Target:          &p.Target,
+		Dependencies:    p.Dependencies,
+		Priority:        &p.Priority,
+		Timeout:         &p.Timeout,
+		SupportRollback: &p.SupportRollback,
+	}
+}
@@ -0,0 +1,79 @@
+package tenantinitplugin
+
+import (
+	"context"
+
+	"github.com/coder-lulu/newbee-common/v2/i18n"
+
+	"github.com/coder-lulu/newbee-core/rpc/ent"
+	"github.com/coder-lulu/newbee-core/rpc/ent/tenantinitplugin"
+	"github.com/coder-lulu/newbee-core/rpc/internal/svc"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/dberrorhandler"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type RegisterTenantInitPluginLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewRegisterTenantInitPluginLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RegisterTenantInitPluginLogic {
+	return &RegisterTenantInitPluginLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// RegisterTenantInitPlugin is called by the services serving a plugin on startup. A registered plugin
+// is updated by its name, the status set by the administrators is kept.
+func (l *RegisterTenantInitPluginLogic) RegisterTenantInitPlugin(in *core.TenantInitPluginInfo) (*core.BaseIDResp, error) {
+	if err := validatePlugin(in.GetName(), in.GetTarget(), in.Dependencies); err != nil {
+		return nil, err
+	}
+
+	origin, err := l.svcCtx.DB.TenantInitPlugin.Query().Where(tenantinitplugin.NameEQ(in.GetName())).Only(l.ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, dberrorhandler.DefaultEntError(l.Logger, err, in)
+	}
+
+	if origin != nil {
+		err = l.svcCtx.DB.TenantInitPlugin.UpdateOneID(origin.ID).
+			SetNotNilVersion(in.Version).
+			SetNotNilDescription(in.Description).
+			SetNotNilTarget(in.Target).
+			SetDependencies(in.Dependencies).
+			SetNotNilPriority(in.Priority).
+			SetNotNilTimeout(in.Timeout).
+			SetNotNilSupportRollback(in.SupportRollback).
+			Exec(l.ctx)
+		if err != nil {
+			return nil, dberrorhandler.DefaultEntError(l.Logger, err, in)
+		}
+
+		l.Infow("tenant initialization plugin registered again", logx.Field("plugin", origin.Name),
+			logx.Field("target", in.GetTarget()))
+		return &core.BaseIDResp{Id: origin.ID, Msg: i18n.UpdateSuccess}, nil
+	}
+
+	result, err := l.svcCtx.DB.TenantInitPlugin.Create().
+		SetNotNilName(in.Name).
+		SetNotNilVersion(in.Version).
+		SetNotNilDescription(in.Description).
+		SetNotNilTarget(in.Target).
+		SetNotNilDependencies(in.Dependencies).
+		SetNotNilPriority(in.Priority).
+		SetNotNilTimeout(in.Timeout).
+		SetNotNilSupportRollback(in.SupportRollback).
+		Save(l.ctx)
+	if err != nil {
+		return nil, dberrorhandler.DefaultEntError(l.Logger, err, in)
+	}
+
+	l.Infow("tenant initialization plugin registered", logx.Field("plugin", result.Name),
+		logx.Field("target", result.Target))
+	return &core.BaseIDResp{Id: result.ID, Msg: i18n.CreateSuccess}, nil
+}
@@ -0,0 +1,66 @@
+package tenantinitplugin
+
+import (
+	"context"
+
+	"github.com/coder-lulu/newbee-common/v2/i18n"
+
+	"github.com/coder-lulu/newbee-core/rpc/internal/svc"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/dberrorhandler"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/typeconv"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type UpdateTenantInitPluginLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewUpdateTenantInitPluginLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UpdateTenantInitPluginLogic {
+	return &UpdateTenantInitPluginLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *UpdateTenantInitPluginLogic) UpdateTenantInitPlugin(in *core.TenantInitPluginInfo) (*core.BaseResp, error) {
+	origin, err := l.svcCtx.DB.TenantInitPlugin.Get(l.ctx, in.GetId())
+	if err != nil {
+		return nil, dberrorhandler.DefaultEntError(l.Logger, err, in)
+	}
+
+	name, target, dependencies := origin.Name, origin.Target, origin.Dependencies
+	if in.Name != nil {
+		name = *in.Name
+	}
+	if in.Target != nil {
+		target = *in.Target
+	}
+	if in.Dependencies != nil {
+		dependencies = in.Dependencies
+	}
+	if err := validatePlugin(name, target, dependencies); err != nil {
+		return nil, err
+	}
+
+	err = l.svcCtx.DB.TenantInitPlugin.UpdateOneID(origin.ID).
+		SetNotNilName(in.Name).
+		SetNotNilVersion(in.Version).
+		SetNotNilDescription(in.Description).
+		SetNotNilTarget(in.Target).
+		SetNotNilDependencies(in.Dependencies).
+		SetNotNilPriority(in.Priority).
+		SetNotNilTimeout(in.Timeout).
+		SetNotNilSupportRollback(in.SupportRollback).
+		SetNillableStatus(typeconv.ConvertStatus(in.Status)).
+		Exec(l.ctx)
+	if err != nil {
+		return nil, dberrorhandler.DefaultEntError(l.Logger, err, in)
+	}
+
+	return &core.BaseResp{Msg: i18n.UpdateSuccess}, nil
+}
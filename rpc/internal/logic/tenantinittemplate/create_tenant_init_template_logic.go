@@ -0,0 +1,51 @@
+package tenantinittemplate
+
+import (
+	"context"
+
+	"github.com/coder-lulu/newbee-common/v2/i18n"
+
+	"github.com/coder-lulu/newbee-core/rpc/internal/svc"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/dberrorhandler"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/typeconv"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CreateTenantInitTemplateLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewCreateTenantInitTemplateLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CreateTenantInitTemplateLogic {
+	return &CreateTenantInitTemplateLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// CreateTenantInitTemplate validates the template merged onto its base templates before saving it
+func (l *CreateTenantInitTemplateLogic) CreateTenantInitTemplate(in *core.TenantInitTemplateInfo) (*core.BaseIDResp, error) {
+	components, err := validateTemplate(l.ctx, l.svcCtx, l.Logger, in.GetName(), in.GetVersion(), in.GetBaseTemplate(), in.GetComponents())
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := l.svcCtx.DB.TenantInitTemplate.Create().
+		SetNotNilName(in.Name).
+		SetNotNilVersion(in.Version).
+		SetNotNilDescription(in.Description).
+		SetNotNilBaseTemplate(in.BaseTemplate).
+		SetComponents(components).
+		SetNotNilSort(in.Sort).
+		SetNillableStatus(typeconv.ConvertStatus(in.Status)).
+		Save(l.ctx)
+	if err != nil {
+		return nil, dberrorhandler.DefaultEntError(l.Logger, err, in)
+	}
+
+	return &core.BaseIDResp{Id: result.ID, Msg: i18n.CreateSuccess}, nil
+}
@@ -0,0 +1,57 @@
+package tenantinittemplate
+
+import (
+	"context"
+
+	"github.com/coder-lulu/newbee-common/v2/i18n"
+	"github.com/zeromicro/go-zero/core/errorx"
+
+	"github.com/coder-lulu/newbee-core/rpc/ent/tenantinittemplate"
+	"github.com/coder-lulu/newbee-core/rpc/internal/svc"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/dberrorhandler"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type DeleteTenantInitTemplateLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewDeleteTenantInitTemplateLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DeleteTenantInitTemplateLogic {
+	return &DeleteTenantInitTemplateLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// DeleteTenantInitTemplate refuses to delete the templates still used as the base template of other templates
+func (l *DeleteTenantInitTemplateLogic) DeleteTenantInitTemplate(in *core.IDsReq) (*core.BaseResp, error) {
+	names, err := l.svcCtx.DB.TenantInitTemplate.Query().
+		Where(tenantinittemplate.IDIn(in.Ids...)).
+		Select(tenantinittemplate.FieldName).
+		Strings(l.ctx)
+	if err != nil {
+		return nil, dberrorhandler.DefaultEntError(l.Logger, err, in)
+	}
+
+	inUse, err := l.svcCtx.DB.TenantInitTemplate.Query().
+		Where(tenantinittemplate.BaseTemplateIn(names...), tenantinittemplate.IDNotIn(in.Ids...)).
+		Exist(l.ctx)
+	if err != nil {
+		return nil, dberrorhandler.DefaultEntError(l.Logger, err, in)
+	}
+	if inUse {
+		return nil, errorx.NewInvalidArgumentError("tenant.initTemplateInUse")
+	}
+
+	_, err = l.svcCtx.DB.TenantInitTemplate.Delete().Where(tenantinittemplate.IDIn(in.Ids...)).Exec(l.ctx)
+	if err != nil {
+		return nil, dberrorhandler.DefaultEntError(l.Logger, err, in)
+	}
+
+	return &core.BaseResp{Msg: i18n.DeleteSuccess}, nil
+}
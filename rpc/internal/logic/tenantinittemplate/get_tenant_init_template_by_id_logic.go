@@ -0,0 +1,34 @@
+package tenantinittemplate
+
+import (
+	"context"
+
+	"github.com/coder-lulu/newbee-core/rpc/internal/svc"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/dberrorhandler"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetTenantInitTemplateByIdLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetTenantInitTemplateByIdLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetTenantInitTemplateByIdLogic {
+	return &GetTenantInitTemplateByIdLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *GetTenantInitTemplateByIdLogic) GetTenantInitTemplateById(in *core.IDReq) (*core.TenantInitTemplateInfo, error) {
+	result, err := l.svcCtx.DB.TenantInitTemplate.Get(l.ctx, in.Id)
+	if err != nil {
+		return nil, dberrorhandler.DefaultEntError(l.Logger, err, in)
+	}
+
+	return convertTemplate(result), nil
+}
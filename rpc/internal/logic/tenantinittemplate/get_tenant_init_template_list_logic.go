@@ -0,0 +1,54 @@
+package tenantinittemplate
+
+import (
+	"context"
+
+	"github.com/coder-lulu/newbee-core/rpc/ent"
+	"github.com/coder-lulu/newbee-core/rpc/ent/predicate"
+	"github.com/coder-lulu/newbee-core/rpc/ent/tenantinittemplate"
+	"github.com/coder-lulu/newbee-core/rpc/internal/svc"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/dberrorhandler"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetTenantInitTemplateListLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetTenantInitTemplateListLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetTenantInitTemplateListLogic {
+	return &GetTenantInitTemplateListLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *GetTenantInitTemplateListLogic) GetTenantInitTemplateList(in *core.TenantInitTemplateListReq) (*core.TenantInitTemplateListResp, error) {
+	var predicates []predicate.TenantInitTemplate
+	if in.Name != nil {
+		predicates = append(predicates, tenantinittemplate.NameContains(*in.Name))
+	}
+	if in.BaseTemplate != nil {
+		predicates = append(predicates, tenantinittemplate.BaseTemplateEQ(*in.BaseTemplate))
+	}
+
+	result, err := l.svcCtx.DB.TenantInitTemplate.Query().Where(predicates...).Page(l.ctx, in.Page, in.PageSize, func(pager *ent.TenantInitTemplatePager) {
+		pager.Order = ent.Asc(tenantinittemplate.FieldSort)
+	})
+	if err != nil {
+		return nil, dberrorhandler.DefaultEntError(l.Logger, err, in)
+	}
+
+	resp := &core.TenantInitTemplateListResp{}
+	resp.Total = result.PageDetails.Total
+
+	for _, v := range result.List {
+		resp.Data = append(resp.Data, convertTemplate(v))
+	}
+
+	return resp, nil
+}
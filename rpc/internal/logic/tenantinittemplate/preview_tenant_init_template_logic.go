@@ -0,0 +1,53 @@
+package tenantinittemplate
+
+import (
+	"context"
+	"errors"
+
+	"github.com/zeromicro/go-zero/core/errorx"
+
+	"github.com/coder-lulu/newbee-core/rpc/internal/svc"
+	"github.com/coder-lulu/newbee-core/rpc/internal/tenantinit"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/dberrorhandler"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type PreviewTenantInitTemplateLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewPreviewTenantInitTemplateLogic(ctx context.Context, svcCtx *svc.ServiceContext) *PreviewTenantInitTemplateLogic {
+	return &PreviewTenantInitTemplateLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// PreviewTenantInitTemplate returns the template merged onto its base templates, as it is applied to new tenants
+func (l *PreviewTenantInitTemplateLogic) PreviewTenantInitTemplate(in *core.TenantInitTemplatePreviewReq) (*core.TenantInitTemplatePreviewResp, error) {
+	template, err := tenantinit.Resolve(l.ctx, l.svcCtx.DB, in.Name)
+	if err != nil {
+		var invalid tenantinit.ErrInvalidTemplate
+		switch {
+		case errors.Is(err, tenantinit.ErrTemplateNotFound):
+			return nil, errorx.NewInvalidArgumentError("tenant.initTemplateNotFound")
+		case errors.Is(err, tenantinit.ErrTemplateCycle), errors.As(err, &invalid):
+			return nil, errorx.NewInvalidArgumentError("tenant.invalidInitTemplate")
+		default:
+			return nil, dberrorhandler.DefaultEntError(l.Logger, err, in)
+		}
+	}
+
+	helper := &tenantinit.InitConfigHelper{}
+	content, err := helper.SaveTemplate(template)
+	if err != nil {
+		return nil, errorx.NewInternalError(err.Error())
+	}
+
+	return &core.TenantInitTemplatePreviewResp{Content: string(content)}, nil
+}
@@ -0,0 +1,67 @@
+package tenantinittemplate
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/coder-lulu/newbee-common/v2/utils/pointy"
+	"github.com/zeromicro/go-zero/core/errorx"
+	"github.com/zeromicro/go-zero/core/logx"
+
+	"github.com/coder-lulu/newbee-core/rpc/ent"
+	"github.com/coder-lulu/newbee-core/rpc/internal/svc"
+	"github.com/coder-lulu/newbee-core/rpc/internal/tenantinit"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/dberrorhandler"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/typeconv"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+)
+
+// validateTemplate merges the template onto its base templates and validates the result.
+// It returns the normalized components JSON to store.
+func validateTemplate(ctx context.Context, svcCtx *svc.ServiceContext, logger logx.Logger, name, version, baseTemplate, components string) (string, error) {
+	t := &tenantinit.InitializationTemplate{Name: name, Version: version}
+	if err := json.Unmarshal([]byte(components), &t.Components); err != nil {
+		logger.Errorw("invalid tenant initialization template components", logx.Field("detail", err.Error()))
+		return "", errorx.NewInvalidArgumentError("tenant.invalidInitTemplate")
+	}
+
+	merged, err := tenantinit.ResolveWith(ctx, svcCtx.DB, t, baseTemplate)
+	if err == nil {
+		err = tenantinit.NewDefaultTenantInitializer(logger).Validate(merged)
+	}
+	if err != nil {
+		var invalid tenantinit.ErrInvalidTemplate
+		switch {
+		case errors.Is(err, tenantinit.ErrTemplateNotFound):
+			return "", errorx.NewInvalidArgumentError("tenant.initTemplateNotFound")
+		case errors.Is(err, tenantinit.ErrTemplateCycle), errors.As(err, &invalid):
+			logger.Errorw("invalid tenant initialization template", logx.Field("detail", err.Error()), logx.Field("template", name))
+			return "", errorx.NewInvalidArgumentError("tenant.invalidInitTemplate")
+		default:
+			return "", dberrorhandler.DefaultEntError(logger, err, name)
+		}
+	}
+
+	data, err := json.Marshal(t.Components)
+	if err != nil {
+		return "", errorx.NewInvalidArgumentError("tenant.invalidInitTemplate")
+	}
+
+	return string(data), nil
+}
+
+func convertTemplate(t *ent.TenantInitTemplate) *core.TenantInitTemplateInfo {
+	return &core.TenantInitTemplateInfo{
+		Id:           &t.ID,
+		CreatedAt:    pointy.GetPointer(t.CreatedAt.UnixMilli()),
+		UpdatedAt:    pointy.GetPointer(t.UpdatedAt.UnixMilli()),
+		Status:       typeconv.ConvertStatusFromEnt(t.Status),
+		Name:         &t.Name,
+		Version:      &t.Version,
+		Description:  &t.Description,
+		BaseTemplate: &t.BaseTemplate,
+		Components:   &t.Components,
+		Sort:         &t.Sort,
+	}
+}
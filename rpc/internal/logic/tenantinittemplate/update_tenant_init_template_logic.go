@@ -0,0 +1,83 @@
+package tenantinittemplate
+
+import (
+	"context"
+
+	"github.com/coder-lulu/newbee-common/v2/i18n"
+	"github.com/zeromicro/go-zero/core/errorx"
+
+	"github.com/coder-lulu/newbee-core/rpc/ent/tenantinittemplate"
+	"github.com/coder-lulu/newbee-core/rpc/internal/svc"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/dberrorhandler"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/typeconv"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type UpdateTenantInitTemplateLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewUpdateTenantInitTemplateLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UpdateTenantInitTemplateLogic {
+	return &UpdateTenantInitTemplateLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// UpdateTenantInitTemplate validates the updated template merged onto its base templates before saving it.
+// Renaming a template used as a base template is refused, the overlays would lose their base.
+func (l *UpdateTenantInitTemplateLogic) UpdateTenantInitTemplate(in *core.TenantInitTemplateInfo) (*core.BaseResp, error) {
+	origin, err := l.svcCtx.DB.TenantInitTemplate.Get(l.ctx, in.GetId())
+	if err != nil {
+		return nil, dberrorhandler.DefaultEntError(l.Logger, err, in)
+	}
+
+	if in.Name != nil && *in.Name != origin.Name {
+		inUse, err := l.svcCtx.DB.TenantInitTemplate.Query().Where(tenantinittemplate.BaseTemplateEQ(origin.Name)).Exist(l.ctx)
+		if err != nil {
+			return nil, dberrorhandler.DefaultEntError(l.Logger, err, in)
+		}
+		if inUse {
+			return nil, errorx.NewInvalidArgumentError("tenant.initTemplateInUse")
+		}
+	}
+
+	name, version, base, components := origin.Name, origin.Version, origin.BaseTemplate, origin.Components
+	if in.Name != nil {
+		name = *in.Name
+	}
+	if in.Version != nil {
+		version = *in.Version
+	}
+	if in.BaseTemplate != nil {
+		base = *in.BaseTemplate
+	}
+	if in.Components != nil {
+		components = *in.Components
+	}
+
+	components, err = validateTemplate(l.ctx, l.svcCtx, l.Logger, name, version, base, components)
+	if err != nil {
+		return nil, err
+	}
+
+	err = l.svcCtx.DB.TenantInitTemplate.UpdateOneID(origin.ID).
+		SetNotNilName(in.Name).
+		SetNotNilVersion(in.Version).
+		SetNotNilDescription(in.Description).
+		SetNotNilBaseTemplate(in.BaseTemplate).
+		SetComponents(components).
+		SetNotNilSort(in.Sort).
+		SetNillableStatus(typeconv.ConvertStatus(in.Status)).
+		Exec(l.ctx)
+	if err != nil {
+		return nil, dberrorhandler.DefaultEntError(l.Logger, err, in)
+	}
+
+	return &core.BaseResp{Msg: i18n.UpdateSuccess}, nil
+}
@@ -4,12 +4,8 @@ import (
 	"context"
 	"time"
 
-	"github.com/coder-lulu/newbee-common/v2/config"
 	"github.com/coder-lulu/newbee-common/v2/enum/common"
-
-	"github.com/coder-lulu/newbee-common/v2/msg/logmsg"
 	"github.com/coder-lulu/newbee-common/v2/utils/uuidx"
-	"github.com/zeromicro/go-zero/core/errorx"
 
 	"github.com/coder-lulu/newbee-common/v2/i18n"
 
@@ -17,6 +13,7 @@ import (
 
 	"github.com/coder-lulu/newbee-core/rpc/internal/svc"
 	"github.com/coder-lulu/newbee-core/rpc/internal/utils/dberrorhandler"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/dbfunc"
 	"github.com/coder-lulu/newbee-core/rpc/types/core"
 
 	"github.com/zeromicro/go-zero/core/logx"
@@ -37,30 +34,22 @@ func NewBlockUserAllTokenLogic(ctx context.Context, svcCtx *svc.ServiceContext)
 }
 
 func (l *BlockUserAllTokenLogic) BlockUserAllToken(in *core.UUIDReq) (*core.BaseResp, error) {
-	err := l.svcCtx.DB.Token.Update().Where(token.UUIDEQ(uuidx.ParseUUIDString(in.Id))).SetStatus(common.StatusBanned).Exec(l.ctx)
+	userID := uuidx.ParseUUIDString(in.Id)
+
+	// 水位线覆盖该用户之前签发的所有令牌，包括未记录在令牌表中的，所有验证方都会检查水位线，
+	// 保留到最长有效期的令牌过期为止
+	err := dbfunc.RevokeUserTokensBefore(l.ctx, l.svcCtx.Redis, userID, time.Now(), l.svcCtx.Config.Token.MaxLifetime)
 	if err != nil {
-		return nil, dberrorhandler.DefaultEntError(l.Logger, err, in)
+		return nil, err
 	}
 
-	tokenData, err := l.svcCtx.DB.Token.Query().
-		Where(token.UUIDEQ(uuidx.ParseUUIDString(in.Id))).
-		Where(token.StatusEQ(common.StatusBanned)).
-		Where(token.ExpiredAtGT(time.Now())).
-		All(l.ctx)
+	err = l.svcCtx.DB.Token.Update().
+		Where(token.UUIDEQ(userID), token.StatusEQ(common.StatusNormal)).
+		SetStatus(common.StatusBanned).
+		Exec(l.ctx)
 	if err != nil {
 		return nil, dberrorhandler.DefaultEntError(l.Logger, err, in)
 	}
 
-	for _, v := range tokenData {
-		expiredTime := time.Until(v.ExpiredAt)
-		if expiredTime > 0 {
-			err = l.svcCtx.Redis.Set(l.ctx, config.RedisTokenPrefix+v.Token, "1", expiredTime).Err()
-			if err != nil {
-				logx.Errorw(logmsg.RedisError, logx.Field("detail", err.Error()))
-				return nil, errorx.NewInternalError(i18n.RedisError)
-			}
-		}
-	}
-
 	return &core.BaseResp{Msg: i18n.UpdateSuccess}, nil
 }
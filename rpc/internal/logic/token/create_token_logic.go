@@ -3,19 +3,28 @@ package token
 import (
 	"context"
 	"strconv"
+	"time"
 
+	"github.com/coder-lulu/newbee-common/v2/enum/common"
 	"github.com/coder-lulu/newbee-common/v2/i18n"
 	"github.com/coder-lulu/newbee-common/v2/middleware/keys"
 	"github.com/coder-lulu/newbee-common/v2/utils/pointy"
 	"github.com/coder-lulu/newbee-common/v2/utils/uuidx"
+	"github.com/coder-lulu/newbee-core/rpc/ent"
+	"github.com/coder-lulu/newbee-core/rpc/ent/token"
 	"github.com/coder-lulu/newbee-core/rpc/internal/svc"
 	"github.com/coder-lulu/newbee-core/rpc/internal/utils/dberrorhandler"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/dbfunc"
 	"github.com/coder-lulu/newbee-core/rpc/types/core"
+	"github.com/gofrs/uuid/v5"
 	"github.com/zeromicro/go-zero/core/errorx"
 	"github.com/zeromicro/go-zero/core/logx"
 	"google.golang.org/grpc/metadata"
 )
 
+// MaxConcurrentSessionsKey 租户配置中限制用户同时在线会话数的键，未配置或小于等于 0 时不限制
+const MaxConcurrentSessionsKey = "max_concurrent_sessions"
+
 type CreateTokenLogic struct {
 	ctx    context.Context
 	svcCtx *svc.ServiceContext
@@ -58,6 +67,11 @@ func (l *CreateTokenLogic) CreateToken(in *core.TokenInfo) (*core.BaseUUIDResp,
 		in.TenantId = &tenantIDCopy
 	}
 
+	// 登录时创建新会话，刷新的令牌沿用登录时的会话
+	if in.SessionId == nil || *in.SessionId == "" {
+		in.SessionId = pointy.GetPointer(uuidx.NewUUID().String())
+	}
+
 	tokenCreate := l.svcCtx.DB.Token.Create().
 		SetNotNilStatus(pointy.GetStatusPointer(in.Status)).
 		SetNotNilUUID(uuidx.ParseUUIDStringToPointer(in.Uuid)).
@@ -65,6 +79,11 @@ func (l *CreateTokenLogic) CreateToken(in *core.TokenInfo) (*core.BaseUUIDResp,
 		SetNotNilSource(in.Source).
 		SetNotNilUsername(in.Username).
 		SetNotNilExpiredAt(pointy.GetTimeMilliPointer(in.ExpiredAt)).
+		SetNotNilDevice(in.Device).
+		SetNotNilUserAgent(in.UserAgent).
+		SetNotNilIP(in.Ip).
+		SetNotNilSessionID(in.SessionId).
+		SetLastSeenAt(time.Now()).
 		SetTenantID(tenantID)
 
 	result, err := tokenCreate.Save(l.ctx)
@@ -72,5 +91,55 @@ func (l *CreateTokenLogic) CreateToken(in *core.TokenInfo) (*core.BaseUUIDResp,
 		return nil, dberrorhandler.DefaultEntError(l.Logger, err, in)
 	}
 
+	if err = l.evictExceededSessions(tenantID, result.UUID); err != nil {
+		return nil, err
+	}
+
 	return &core.BaseUUIDResp{Id: result.ID.String(), Msg: i18n.CreateSuccess}, nil
 }
+
+// evictExceededSessions 按租户配置的 max_concurrent_sessions 限制用户同时在线的会话数，超出时注销最早的会话。
+// 同一会话的登录令牌和刷新后的令牌只算一个会话
+func (l *CreateTokenLogic) evictExceededSessions(tenantID uint64, userID uuid.UUID) error {
+	tenantInfo, err := l.svcCtx.DB.Tenant.Get(l.ctx, tenantID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil
+		}
+		return dberrorhandler.DefaultEntError(l.Logger, err, tenantID)
+	}
+
+	limit := 0
+	if v, ok := tenantInfo.Config[MaxConcurrentSessionsKey].(float64); ok {
+		limit = int(v)
+	}
+	if limit <= 0 {
+		return nil
+	}
+
+	tokens, err := l.svcCtx.DB.Token.Query().
+		Where(token.UUIDEQ(userID),
+			token.TenantIDEQ(tenantID),
+			token.StatusEQ(common.StatusNormal),
+			token.ExpiredAtGT(time.Now())).
+		Order(ent.Desc(token.FieldCreatedAt)).
+		All(l.ctx)
+	if err != nil {
+		return dberrorhandler.DefaultEntError(l.Logger, err, userID)
+	}
+
+	// 会话按最近签发的令牌排序，保留最近活跃的 limit 个会话
+	kept := make(map[string]bool, limit)
+	var evicted []*ent.Token
+	for _, v := range tokens {
+		sessionID := dbfunc.TokenSessionID(v)
+		if _, ok := kept[sessionID]; !ok {
+			kept[sessionID] = len(kept) < limit
+		}
+		if !kept[sessionID] {
+			evicted = append(evicted, v)
+		}
+	}
+
+	return dbfunc.BlockTokens(l.ctx, l.svcCtx.DB, l.svcCtx.Redis, l.Logger, evicted)
+}
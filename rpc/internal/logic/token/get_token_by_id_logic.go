@@ -43,5 +43,14 @@ func (l *GetTokenByIdLogic) GetTokenById(in *core.UUIDReq) (*core.TokenInfo, err
 		Source:    &result.Source,
 		Username:  &result.Username,
 		ExpiredAt: pointy.GetPointer(result.ExpiredAt.UnixMilli()),
+		Device:    &result.Device,
+		UserAgent: &result.UserAgent,
+		Ip:        &result.IP,
+		LastSeenAt: func() *int64 {
+			if result.LastSeenAt == nil {
+				return nil
+			}
+			return pointy.GetPointer(result.LastSeenAt.UnixMilli())
+		}(),
 	}, nil
 }
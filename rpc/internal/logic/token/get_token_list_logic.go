@@ -109,6 +109,15 @@ func (l *GetTokenListLogic) GetTokenList(in *core.TokenListReq) (*core.TokenList
 			Username:  &v.Username,
 			ExpiredAt: pointy.GetPointer(v.ExpiredAt.UnixMilli()),
 			CreatedAt: pointy.GetPointer(v.CreatedAt.UnixMilli()),
+			Device:    &v.Device,
+			UserAgent: &v.UserAgent,
+			Ip:        &v.IP,
+			LastSeenAt: func() *int64 {
+				if v.LastSeenAt == nil {
+					return nil
+				}
+				return pointy.GetPointer(v.LastSeenAt.UnixMilli())
+			}(),
 		})
 	}
 
@@ -0,0 +1,89 @@
+package token
+
+import (
+	"context"
+	"time"
+
+	"github.com/coder-lulu/newbee-common/v2/enum/common"
+	"github.com/coder-lulu/newbee-common/v2/utils/pointy"
+	"github.com/coder-lulu/newbee-common/v2/utils/uuidx"
+
+	"github.com/coder-lulu/newbee-core/rpc/ent"
+	"github.com/coder-lulu/newbee-core/rpc/ent/token"
+	"github.com/coder-lulu/newbee-core/rpc/internal/svc"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/dberrorhandler"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/dbfunc"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetUserSessionListLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetUserSessionListLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetUserSessionListLogic {
+	return &GetUserSessionListLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// GetUserSessionList 获取用户当前有效的会话（未禁用且未过期），按最后活跃时间倒序。
+// 同一会话的多个令牌只返回最近活跃的一个，创建时间为会话的登录时间
+func (l *GetUserSessionListLogic) GetUserSessionList(in *core.UserSessionListReq) (*core.TokenListResp, error) {
+	tokens, err := l.svcCtx.DB.Token.Query().
+		Where(token.UUIDEQ(uuidx.ParseUUIDString(in.Uuid)),
+			token.StatusEQ(common.StatusNormal),
+			token.ExpiredAtGT(time.Now())).
+		Order(ent.Desc(token.FieldLastSeenAt), ent.Desc(token.FieldCreatedAt)).
+		All(l.ctx)
+	if err != nil {
+		return nil, dberrorhandler.DefaultEntError(l.Logger, err, in)
+	}
+
+	var sessions []*core.TokenInfo
+	indexes := make(map[string]int)
+	for _, v := range tokens {
+		sessionID := dbfunc.TokenSessionID(v)
+		if i, ok := indexes[sessionID]; ok {
+			if v.CreatedAt.UnixMilli() < *sessions[i].CreatedAt {
+				sessions[i].CreatedAt = pointy.GetPointer(v.CreatedAt.UnixMilli())
+			}
+			continue
+		}
+
+		indexes[sessionID] = len(sessions)
+		sessions = append(sessions, &core.TokenInfo{
+			Id:        pointy.GetPointer(v.ID.String()),
+			CreatedAt: pointy.GetPointer(v.CreatedAt.UnixMilli()),
+			Status:    pointy.GetPointer(uint32(v.Status)),
+			Uuid:      pointy.GetPointer(v.UUID.String()),
+			Token:     &v.Token,
+			Source:    &v.Source,
+			Username:  &v.Username,
+			ExpiredAt: pointy.GetPointer(v.ExpiredAt.UnixMilli()),
+			Device:    &v.Device,
+			UserAgent: &v.UserAgent,
+			Ip:        &v.IP,
+			LastSeenAt: func() *int64 {
+				if v.LastSeenAt == nil {
+					return nil
+				}
+				return pointy.GetPointer(v.LastSeenAt.UnixMilli())
+			}(),
+			SessionId: pointy.GetPointer(sessionID),
+		})
+	}
+
+	resp := &core.TokenListResp{Total: uint64(len(sessions))}
+
+	start := min((max(in.Page, 1)-1)*in.PageSize, resp.Total)
+	end := min(start+in.PageSize, resp.Total)
+	resp.Data = sessions[start:end]
+
+	return resp, nil
+}
@@ -0,0 +1,74 @@
+package token
+
+import (
+	"context"
+	"time"
+
+	"github.com/coder-lulu/newbee-common/v2/enum/common"
+	"github.com/coder-lulu/newbee-common/v2/i18n"
+	"github.com/coder-lulu/newbee-common/v2/utils/uuidx"
+
+	"github.com/coder-lulu/newbee-core/rpc/ent"
+	"github.com/coder-lulu/newbee-core/rpc/ent/token"
+	"github.com/coder-lulu/newbee-core/rpc/internal/svc"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/dberrorhandler"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/dbfunc"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type RevokeUserSessionLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewRevokeUserSessionLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RevokeUserSessionLogic {
+	return &RevokeUserSessionLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// RevokeUserSession 注销用户的指定会话，ids 为空时注销全部会话（keep_token 对应的会话除外）。
+// ids 可以是会话中任意一个令牌的ID，注销时同一会话的令牌一并注销
+func (l *RevokeUserSessionLogic) RevokeUserSession(in *core.UserSessionRevokeReq) (*core.BaseResp, error) {
+	tokens, err := l.svcCtx.DB.Token.Query().
+		Where(token.UUIDEQ(uuidx.ParseUUIDString(in.Uuid)),
+			token.StatusEQ(common.StatusNormal),
+			token.ExpiredAtGT(time.Now())).
+		All(l.ctx)
+	if err != nil {
+		return nil, dberrorhandler.DefaultEntError(l.Logger, err, in)
+	}
+
+	ids := make(map[string]bool, len(in.Ids))
+	for _, id := range in.Ids {
+		ids[id] = true
+	}
+
+	selected := make(map[string]bool)
+	for _, v := range tokens {
+		sessionID := dbfunc.TokenSessionID(v)
+		if in.KeepToken != nil && *in.KeepToken != "" && v.Token == *in.KeepToken {
+			selected[sessionID] = false
+		} else if _, ok := selected[sessionID]; !ok && (len(ids) == 0 || ids[v.ID.String()]) {
+			selected[sessionID] = true
+		}
+	}
+
+	var sessions []*ent.Token
+	for _, v := range tokens {
+		if selected[dbfunc.TokenSessionID(v)] {
+			sessions = append(sessions, v)
+		}
+	}
+
+	if err = dbfunc.BlockTokens(l.ctx, l.svcCtx.DB, l.svcCtx.Redis, l.Logger, sessions); err != nil {
+		return nil, err
+	}
+
+	return &core.BaseResp{Msg: i18n.UpdateSuccess}, nil
+}
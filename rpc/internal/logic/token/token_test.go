@@ -0,0 +1,203 @@
+package token
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect/sql/schema"
+	"github.com/alicebob/miniredis/v2"
+	commonconfig "github.com/coder-lulu/newbee-common/v2/config"
+	"github.com/coder-lulu/newbee-common/v2/enum/common"
+	"github.com/coder-lulu/newbee-common/v2/middleware/keys"
+	"github.com/coder-lulu/newbee-common/v2/orm/ent/hooks"
+	"github.com/coder-lulu/newbee-common/v2/utils/pointy"
+	"github.com/gofrs/uuid/v5"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/redis/go-redis/v9"
+
+	"github.com/coder-lulu/newbee-core/rpc/ent"
+	"github.com/coder-lulu/newbee-core/rpc/ent/enttest"
+	"github.com/coder-lulu/newbee-core/rpc/ent/token"
+	"github.com/coder-lulu/newbee-core/rpc/internal/config"
+	"github.com/coder-lulu/newbee-core/rpc/internal/svc"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/dbfunc"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+)
+
+// newTestServiceContext returns a service context backed by an in-memory database and redis
+func newTestServiceContext(t *testing.T) (context.Context, *svc.ServiceContext, *miniredis.Miniredis) {
+	t.Helper()
+
+	db := enttest.Open(t, "sqlite3", "file:"+t.Name()+"?mode=memory&cache=shared&_fk=1",
+		enttest.WithMigrateOptions(schema.WithForeignKeys(false)))
+	t.Cleanup(func() { _ = db.Close() })
+
+	mr := miniredis.RunT(t)
+	rds := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { _ = rds.Close() })
+
+	c := config.Config{Token: config.TokenConf{MaxLifetime: 720 * time.Hour}}
+	return hooks.SetTenantIDToContext(context.Background(), 1), &svc.ServiceContext{Config: c, DB: db, Redis: rds}, mr
+}
+
+func TestBlockUserAllToken(t *testing.T) {
+	ctx, svcCtx, mr := newTestServiceContext(t)
+	userID := uuid.Must(uuid.NewV7())
+	otherID := uuid.Must(uuid.NewV7())
+
+	for i, uid := range []uuid.UUID{userID, userID, otherID} {
+		err := svcCtx.DB.Token.Create().
+			SetUUID(uid).
+			SetToken(fmt.Sprintf("token-%d", i)).
+			SetSource("core_user").
+			SetExpiredAt(time.Now().Add(time.Hour)).
+			SetTenantID(1).
+			Exec(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if _, err := NewBlockUserAllTokenLogic(ctx, svcCtx).BlockUserAllToken(&core.UUIDReq{Id: userID.String()}); err != nil {
+		t.Fatalf("BlockUserAllToken: %v", err)
+	}
+
+	if n := svcCtx.DB.Token.Query().Where(token.UUIDEQ(userID), token.StatusEQ(common.StatusNormal)).CountX(ctx); n != 0 {
+		t.Errorf("%d tokens of the user are still normal", n)
+	}
+	if n := svcCtx.DB.Token.Query().Where(token.UUIDEQ(otherID), token.StatusEQ(common.StatusNormal)).CountX(ctx); n != 1 {
+		t.Error("the token of another user was banned")
+	}
+
+	// 只写水位线，不再逐个拉黑令牌
+	key := dbfunc.RedisTokenRevokedBeforePrefix + userID.String()
+	if !mr.Exists(key) {
+		t.Fatal("the revocation watermark was not written")
+	}
+	if ttl := mr.TTL(key); ttl != svcCtx.Config.Token.MaxLifetime {
+		t.Errorf("watermark ttl = %v, want %v", ttl, svcCtx.Config.Token.MaxLifetime)
+	}
+	if keys := mr.Keys(); len(keys) != 1 {
+		t.Errorf("redis keys = %v, want the watermark only", keys)
+	}
+
+	// 令牌表中没有记录时同样写入水位线
+	otherUser := uuid.Must(uuid.NewV7())
+	if _, err := NewBlockUserAllTokenLogic(ctx, svcCtx).BlockUserAllToken(&core.UUIDReq{Id: otherUser.String()}); err != nil {
+		t.Fatalf("BlockUserAllToken: %v", err)
+	}
+	if !mr.Exists(dbfunc.RedisTokenRevokedBeforePrefix + otherUser.String()) {
+		t.Error("the revocation watermark was not written for a user without tokens")
+	}
+}
+
+// createToken creates a token of the user through CreateToken and returns its ID
+func createToken(t *testing.T, ctx context.Context, svcCtx *svc.ServiceContext, userID uuid.UUID, raw, sessionID string) string {
+	t.Helper()
+
+	resp, err := NewCreateTokenLogic(ctx, svcCtx).CreateToken(&core.TokenInfo{
+		Uuid:      pointy.GetPointer(userID.String()),
+		Token:     pointy.GetPointer(raw),
+		Source:    pointy.GetPointer("core_user"),
+		Status:    pointy.GetPointer(uint32(common.StatusNormal)),
+		ExpiredAt: pointy.GetPointer(time.Now().Add(time.Hour).UnixMilli()),
+		SessionId: pointy.GetPointer(sessionID),
+	})
+	if err != nil {
+		t.Fatalf("CreateToken(%s): %v", raw, err)
+	}
+	return resp.Id
+}
+
+func TestCreateTokenSessionLimit(t *testing.T) {
+	ctx, svcCtx, mr := newTestServiceContext(t)
+	ctx = context.WithValue(ctx, keys.TenantIDKey, "1")
+	userID := uuid.Must(uuid.NewV7())
+
+	err := svcCtx.DB.Tenant.Create().SetName("default").SetCode("default").
+		SetConfig(map[string]interface{}{MaxConcurrentSessionsKey: float64(1)}).Exec(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	normal := func(raw string) bool {
+		return svcCtx.DB.Token.Query().Where(token.TokenEQ(raw), token.StatusEQ(common.StatusNormal)).ExistX(ctx)
+	}
+
+	// 登录时由 RPC 创建会话，刷新和换取访问令牌沿用同一会话，不会挤掉设备自己的登录令牌
+	createToken(t, ctx, svcCtx, userID, "login-a", "")
+	sessionA := svcCtx.DB.Token.Query().Where(token.TokenEQ("login-a")).OnlyX(ctx).SessionID
+	if sessionA == "" {
+		t.Fatal("login did not create a session")
+	}
+	createToken(t, ctx, svcCtx, userID, "refresh-a", sessionA)
+	createToken(t, ctx, svcCtx, userID, "access-a", sessionA)
+	for _, raw := range []string{"login-a", "refresh-a", "access-a"} {
+		if !normal(raw) {
+			t.Fatalf("%s was evicted by a refresh of its own session", raw)
+		}
+	}
+
+	sessions, err := NewGetUserSessionListLogic(ctx, svcCtx).GetUserSessionList(&core.UserSessionListReq{Uuid: userID.String(), Page: 1, PageSize: 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sessions.Total != 1 || len(sessions.Data) != 1 || sessions.Data[0].GetSessionId() != sessionA {
+		t.Fatalf("sessions = %+v, want the session %s only", sessions, sessionA)
+	}
+
+	// 另一台设备登录后超出限制，注销第一台设备会话中的全部令牌
+	createToken(t, ctx, svcCtx, userID, "login-b", "")
+	for _, raw := range []string{"login-a", "refresh-a", "access-a"} {
+		if normal(raw) {
+			t.Errorf("%s of the evicted session is still normal", raw)
+		}
+		if !mr.Exists(commonconfig.RedisTokenPrefix + raw) {
+			t.Errorf("%s of the evicted session was not blacklisted", raw)
+		}
+	}
+	if !normal("login-b") {
+		t.Error("the new session was evicted")
+	}
+}
+
+func TestRevokeUserSession(t *testing.T) {
+	ctx, svcCtx, _ := newTestServiceContext(t)
+	ctx = context.WithValue(ctx, keys.TenantIDKey, "1")
+	userID := uuid.Must(uuid.NewV7())
+
+	createToken(t, ctx, svcCtx, userID, "login-a", "session-a")
+	accessA := createToken(t, ctx, svcCtx, userID, "access-a", "session-a")
+	createToken(t, ctx, svcCtx, userID, "login-b", "session-b")
+	createToken(t, ctx, svcCtx, userID, "access-b", "session-b")
+	createToken(t, ctx, svcCtx, userID, "login-c", "session-c")
+
+	normal := func() []string {
+		var raws []string
+		for _, v := range svcCtx.DB.Token.Query().Where(token.StatusEQ(common.StatusNormal)).Order(ent.Asc(token.FieldToken)).AllX(ctx) {
+			raws = append(raws, v.Token)
+		}
+		return raws
+	}
+
+	// 按令牌ID注销时注销整个会话
+	_, err := NewRevokeUserSessionLogic(ctx, svcCtx).RevokeUserSession(&core.UserSessionRevokeReq{Uuid: userID.String(), Ids: []string{accessA}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := normal(); !slices.Equal(got, []string{"access-b", "login-b", "login-c"}) {
+		t.Fatalf("normal tokens = %v after revoking session a", got)
+	}
+
+	// 注销其它会话时保留当前令牌所在会话的全部令牌
+	_, err = NewRevokeUserSessionLogic(ctx, svcCtx).RevokeUserSession(&core.UserSessionRevokeReq{Uuid: userID.String(), KeepToken: pointy.GetPointer("access-b")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := normal(); !slices.Equal(got, []string{"access-b", "login-b"}) {
+		t.Fatalf("normal tokens = %v after revoking the other sessions", got)
+	}
+}
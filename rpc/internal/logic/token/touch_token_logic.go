@@ -0,0 +1,45 @@
+package token
+
+import (
+	"context"
+	"time"
+
+	"github.com/coder-lulu/newbee-common/v2/enum/common"
+	"github.com/coder-lulu/newbee-common/v2/i18n"
+
+	"github.com/coder-lulu/newbee-core/rpc/ent/token"
+	"github.com/coder-lulu/newbee-core/rpc/internal/svc"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/dberrorhandler"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type TouchTokenLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewTouchTokenLogic(ctx context.Context, svcCtx *svc.ServiceContext) *TouchTokenLogic {
+	return &TouchTokenLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// TouchToken 刷新会话的最后活跃时间和访问 IP，由 API 网关按 Token 节流调用
+func (l *TouchTokenLogic) TouchToken(in *core.TokenTouchReq) (*core.BaseResp, error) {
+	err := l.svcCtx.DB.Token.Update().
+		Where(token.TokenEQ(in.Token), token.StatusEQ(common.StatusNormal)).
+		SetLastSeenAt(time.Now()).
+		SetNotNilIP(in.Ip).
+		SetNotNilUserAgent(in.UserAgent).
+		Exec(l.ctx)
+	if err != nil {
+		return nil, dberrorhandler.DefaultEntError(l.Logger, err, in.Ip)
+	}
+
+	return &core.BaseResp{Msg: i18n.UpdateSuccess}, nil
+}
@@ -65,6 +65,7 @@ func (l *CreateUserLogic) CreateUser(in *core.UserInfo) (*core.BaseUUIDResp, err
 		SetNotNilHomePath(in.HomePath).
 		SetNotNilDescription(in.Description).
 		SetNotNilDepartmentID(in.DepartmentId).
+		SetNotNilStatus(pointy.GetStatusPointer(in.Status)).
 		AddPositionIDs(in.PositionIds...).
 		Save(l.ctx)
 	if err != nil {
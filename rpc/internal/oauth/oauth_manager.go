@@ -80,7 +80,7 @@ func NewOAuthManager(db *ent.Client, config *OAuthManagerConfig) *OAuthManager {
 // initializeComponents initializes all OAuth components
 func (om *OAuthManager) initializeComponents(config *OAuthManagerConfig) {
 	// 1. Initialize encryption
-	encryption.InitGlobalEncryption()
+	// 复用已配置密钥的全局加密服务，重新初始化会丢失服务启动时添加的密钥
 	om.encryptionService = encryption.GetGlobalProviderEncryptionService()
 	
 	// Add default encryption key if provided
@@ -129,6 +129,25 @@ func (om *OAuthManager) GetProvider(ctx context.Context, tenantID uint64, provid
 	return om.providerRegistry.GetProvider(ctx, tenantID, providerType)
 }
 
+// GetProviderAdapter returns the adapter of a provider record. The cached adapter and configuration are
+// rebuilt when the record was updated after they were loaded, which covers updates made on other instances.
+func (om *OAuthManager) GetProviderAdapter(ctx context.Context, p *ent.OauthProvider) (interfaces.OAuthAdapter, error) {
+	providerType := interfaces.OAuthProviderType(p.Type)
+	if om.providerRegistry.IsStale(p.TenantID, providerType, p.UpdatedAt) {
+		om.InvalidateProvider(ctx, p.TenantID, providerType, p.ID)
+	}
+
+	return om.providerRegistry.GetProviderVersion(ctx, p.TenantID, providerType, p.UpdatedAt)
+}
+
+// InvalidateProvider removes the cached configuration and adapter of a provider, it must be called
+// after the provider is updated or deleted
+func (om *OAuthManager) InvalidateProvider(ctx context.Context, tenantID uint64, providerType interfaces.OAuthProviderType, providerID uint64) {
+	om.cacheManager.InvalidateProviderConfig(ctx, tenantID, providerType)
+	om.cacheManager.InvalidateProviderConfigByID(ctx, int64(providerID))
+	om.providerRegistry.InvalidateProvider(tenantID, providerType)
+}
+
 // CreateProvider creates a new OAuth provider
 func (om *OAuthManager) CreateProvider(ctx context.Context, registration *provider.ProviderRegistration) (*interfaces.OAuthProviderConfig, error) {
 	return om.providerFactory.CreateProvider(ctx, registration)
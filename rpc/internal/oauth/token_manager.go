@@ -0,0 +1,439 @@
+package oauth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/bsm/redislock"
+	"github.com/coder-lulu/newbee-common/v2/enum/common"
+	"github.com/gofrs/uuid/v5"
+	"github.com/redis/go-redis/v9"
+	"golang.org/x/oauth2"
+
+	"github.com/coder-lulu/newbee-core/rpc/ent"
+	"github.com/coder-lulu/newbee-core/rpc/ent/oauthaccount"
+	"github.com/coder-lulu/newbee-core/rpc/ent/oauthprovider"
+	"github.com/coder-lulu/newbee-core/rpc/internal/adapters"
+	"github.com/coder-lulu/newbee-core/rpc/internal/config"
+	"github.com/coder-lulu/newbee-core/rpc/internal/encryption"
+	"github.com/coder-lulu/newbee-core/rpc/internal/interfaces"
+	"github.com/coder-lulu/newbee-core/rpc/internal/provider"
+)
+
+// tokenRefreshLockKey 同一账号的刷新在多实例间串行，避免并发使用同一个刷新令牌导致其中一方失效
+const tokenRefreshLockKey = "OAUTH:TOKEN:REFRESH:%d"
+
+var (
+	// ErrTokenExpired is returned when the access token expired and there is no refresh token, the user has to log in again
+	ErrTokenExpired = errors.New("oauth token is expired and can not be refreshed")
+	// ErrRefreshRunning is returned when the token of the account is being refreshed by another instance
+	ErrRefreshRunning = errors.New("oauth token is being refreshed")
+	// ErrInvalidUserID is returned when the user ID is not a UUID
+	ErrInvalidUserID = errors.New("invalid user id")
+)
+
+var _ interfaces.OAuthTokenManager = (*TokenManager)(nil)
+
+// TokenManager keeps the provider tokens of the linked accounts. The tokens are stored encrypted in
+// sys_oauth_accounts, refreshed before they expire and revoked at the provider when the account is unbound.
+//
+// The providerType parameter of interfaces.OAuthTokenManager is the name of the OAuth provider, since a tenant
+// may configure several providers of the same type.
+type TokenManager struct {
+	conf    config.OauthTokenConf
+	db      *ent.Client
+	enc     *encryption.ProviderEncryptionService
+	locker  *redislock.Client
+	factory interfaces.OAuthAdapterFactory
+}
+
+// NewTokenManager creates a token manager
+func NewTokenManager(c config.OauthTokenConf, db *ent.Client, rds redis.UniversalClient, enc *encryption.ProviderEncryptionService) *TokenManager {
+	return &TokenManager{
+		conf:    c,
+		db:      db,
+		enc:     enc,
+		locker:  redislock.New(rds),
+		factory: adapters.GetGlobalAdapterFactory(),
+	}
+}
+
+// StoreToken stores the token of an account which is already linked
+func (m *TokenManager) StoreToken(ctx context.Context, userID, providerType string, token *oauth2.Token) error {
+	account, err := m.account(ctx, userID, providerType)
+	if err != nil {
+		return err
+	}
+
+	return m.save(ctx, account.ID, token)
+}
+
+// GetToken returns the token of the account, the token is refreshed first if it expires within RefreshAhead
+func (m *TokenManager) GetToken(ctx context.Context, userID, providerType string) (*oauth2.Token, error) {
+	account, err := m.account(ctx, userID, providerType)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := m.decode(account)
+	if err != nil {
+		return nil, err
+	}
+	if m.IsTokenValid(ctx, token) {
+		return token, nil
+	}
+
+	return m.refresh(ctx, account, true)
+}
+
+// RefreshToken refreshes the token of the account at the provider
+func (m *TokenManager) RefreshToken(ctx context.Context, userID, providerType string) (*oauth2.Token, error) {
+	account, err := m.account(ctx, userID, providerType)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.refresh(ctx, account, true)
+}
+
+// DeleteToken revokes the token at the provider and clears it from the account
+func (m *TokenManager) DeleteToken(ctx context.Context, userID, providerType string) error {
+	account, err := m.account(ctx, userID, providerType)
+	if err != nil {
+		return err
+	}
+
+	revokeErr := m.Revoke(ctx, account)
+
+	err = m.db.OauthAccount.UpdateOneID(account.ID).
+		SetAccessToken("").
+		ClearRefreshToken().
+		ClearTokenExpiresAt().
+		ClearEncryptionKeyID().
+		Exec(ctx)
+
+	return errors.Join(revokeErr, err)
+}
+
+// IsTokenValid reports whether the access token can still be used for RefreshAhead,
+// tokens without expiry are regarded as valid
+func (m *TokenManager) IsTokenValid(_ context.Context, token *oauth2.Token) bool {
+	if token == nil || token.AccessToken == "" {
+		return false
+	}
+
+	return token.Expiry.IsZero() || token.Expiry.After(time.Now().Add(m.conf.RefreshAhead))
+}
+
+// LinkAccount creates or updates the account of the provider user after login and stores the token
+func (m *TokenManager) LinkAccount(ctx context.Context, p *ent.OauthProvider, userID uuid.UUID, departmentID uint64,
+	info *interfaces.OAuthUserInfo, token *oauth2.Token,
+) error {
+	if info.ID == "" {
+		return errors.New("the provider user ID is empty")
+	}
+
+	account, err := m.db.OauthAccount.Query().
+		Where(oauthaccount.ProviderIDEQ(p.ID), oauthaccount.ProviderUserIDEQ(info.ID)).
+		Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return err
+	}
+	if account != nil && account.UserID != userID {
+		return fmt.Errorf("provider user %s of provider %d is linked to another user", info.ID, p.ID)
+	}
+
+	accessToken, refreshToken, keyID, err := m.encode(token)
+	if err != nil {
+		return err
+	}
+
+	if account == nil {
+		query := m.db.OauthAccount.Create().
+			SetUserID(userID).
+			SetProviderID(p.ID).
+			SetProviderType(p.Type).
+			SetProviderUserID(info.ID).
+			SetProviderUsername(info.Username).
+			SetProviderNickname(info.Nickname).
+			SetProviderEmail(info.Email).
+			SetProviderAvatar(info.Avatar).
+			SetAccessToken(accessToken).
+			SetEncryptionKeyID(keyID).
+			SetLastLoginAt(time.Now()).
+			SetLoginCount(1).
+			SetDepartmentID(departmentID)
+		if refreshToken != "" {
+			query.SetRefreshToken(refreshToken)
+		}
+		if !token.Expiry.IsZero() {
+			query.SetTokenExpiresAt(token.Expiry)
+		}
+		return query.Exec(ctx)
+	}
+
+	query := m.db.OauthAccount.UpdateOneID(account.ID).
+		SetProviderUsername(info.Username).
+		SetProviderNickname(info.Nickname).
+		SetProviderEmail(info.Email).
+		SetProviderAvatar(info.Avatar).
+		SetAccessToken(accessToken).
+		SetEncryptionKeyID(keyID).
+		SetLastLoginAt(time.Now()).
+		AddLoginCount(1).
+		SetStatus(common.StatusNormal) // 用户在第三方平台撤销授权后重新登录，恢复绑定
+	// 部分平台只在首次授权时返回刷新令牌，未返回时保留原有的刷新令牌
+	if refreshToken != "" {
+		query.SetRefreshToken(refreshToken)
+	} else if account.RefreshToken != "" && account.EncryptionKeyID != keyID {
+		old, err := m.decrypt(account.RefreshToken, account.EncryptionKeyID)
+		if err != nil {
+			return err
+		}
+		if old, _, err = m.enc.EncryptProviderSecret(old); err != nil {
+			return err
+		}
+		query.SetRefreshToken(old)
+	}
+	if token.Expiry.IsZero() {
+		query.ClearTokenExpiresAt()
+	} else {
+		query.SetTokenExpiresAt(token.Expiry)
+	}
+
+	return query.Exec(ctx)
+}
+
+// Revoke revokes the token of the account at the provider. Providers without a revocation API are skipped.
+func (m *TokenManager) Revoke(ctx context.Context, account *ent.OauthAccount) error {
+	if account.AccessToken == "" && account.RefreshToken == "" {
+		return nil
+	}
+
+	token, err := m.decode(account)
+	if err != nil {
+		return err
+	}
+
+	p := account.Edges.Provider
+	if p == nil {
+		if p, err = m.db.OauthProvider.Get(ctx, account.ProviderID); err != nil {
+			return err
+		}
+	}
+
+	adapter, err := m.adapter(p)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, m.conf.Timeout)
+	defer cancel()
+
+	var oauthErr *interfaces.OAuthError
+	if err = adapter.RevokeToken(ctx, token); errors.As(err, &oauthErr) && oauthErr.Type == interfaces.ErrorTypeUnsupportedResponse {
+		return nil
+	}
+
+	return err
+}
+
+// refresh refreshes the token of the account under the lock of the account. When wait is false the refresh is
+// skipped with ErrRefreshRunning if another instance holds the lock.
+func (m *TokenManager) refresh(ctx context.Context, account *ent.OauthAccount, wait bool) (*oauth2.Token, error) {
+	opts := &redislock.Options{}
+	if wait {
+		opts.RetryStrategy = redislock.LimitRetry(redislock.LinearBackoff(100*time.Millisecond), int(m.conf.Timeout/(100*time.Millisecond)))
+	}
+
+	lock, err := m.locker.Obtain(ctx, fmt.Sprintf(tokenRefreshLockKey, account.ID), 2*m.conf.Timeout, opts)
+	if errors.Is(err, redislock.ErrNotObtained) {
+		return nil, ErrRefreshRunning
+	} else if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = lock.Release(context.Background())
+	}()
+
+	// 等锁期间其他实例可能已经刷新
+	current, err := m.db.OauthAccount.Query().
+		Where(oauthaccount.IDEQ(account.ID)).
+		WithProvider().
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := m.decode(current)
+	if err != nil {
+		return nil, err
+	}
+	if current.AccessToken != account.AccessToken && m.IsTokenValid(ctx, token) {
+		return token, nil
+	}
+	if token.RefreshToken == "" {
+		return nil, ErrTokenExpired
+	}
+
+	adapter, err := m.adapter(current.Edges.Provider)
+	if err != nil {
+		return nil, err
+	}
+
+	refreshCtx, cancel := context.WithTimeout(ctx, m.conf.Timeout)
+	defer cancel()
+
+	refreshed, err := adapter.RefreshToken(refreshCtx, token.RefreshToken)
+	if err != nil {
+		// 刷新令牌已失效或被撤销，清除后不再重试，用户重新登录后恢复
+		var retrieveErr *oauth2.RetrieveError
+		if errors.As(err, &retrieveErr) && retrieveErr.ErrorCode == "invalid_grant" {
+			if clearErr := m.db.OauthAccount.UpdateOneID(current.ID).ClearRefreshToken().Exec(ctx); clearErr != nil {
+				return nil, errors.Join(err, clearErr)
+			}
+			return nil, errors.Join(ErrTokenExpired, err)
+		}
+		return nil, err
+	}
+
+	if refreshed.RefreshToken == "" {
+		refreshed.RefreshToken = token.RefreshToken
+	}
+	if err = m.save(ctx, current.ID, refreshed); err != nil {
+		return nil, err
+	}
+
+	return refreshed, nil
+}
+
+// account finds the account of the user linked to the provider
+func (m *TokenManager) account(ctx context.Context, userID, providerName string) (*ent.OauthAccount, error) {
+	uid, err := uuid.FromString(userID)
+	if err != nil {
+		return nil, ErrInvalidUserID
+	}
+
+	return m.db.OauthAccount.Query().
+		Where(
+			oauthaccount.UserIDEQ(uid),
+			oauthaccount.StatusEQ(common.StatusNormal),
+			oauthaccount.HasProviderWith(oauthprovider.NameEQ(providerName)),
+		).
+		WithProvider().
+		Only(ctx)
+}
+
+// save encrypts and stores the token
+func (m *TokenManager) save(ctx context.Context, id uint64, token *oauth2.Token) error {
+	accessToken, refreshToken, keyID, err := m.encode(token)
+	if err != nil {
+		return err
+	}
+
+	query := m.db.OauthAccount.UpdateOneID(id).
+		SetAccessToken(accessToken).
+		SetEncryptionKeyID(keyID)
+	if refreshToken == "" {
+		query.ClearRefreshToken()
+	} else {
+		query.SetRefreshToken(refreshToken)
+	}
+	if token.Expiry.IsZero() {
+		query.ClearTokenExpiresAt()
+	} else {
+		query.SetTokenExpiresAt(token.Expiry)
+	}
+
+	return query.Exec(ctx)
+}
+
+// encode encrypts the access token and the refresh token with the active key
+func (m *TokenManager) encode(token *oauth2.Token) (accessToken, refreshToken, keyID string, err error) {
+	accessToken, keyID, err = m.enc.EncryptProviderSecret(token.AccessToken)
+	if err != nil {
+		return "", "", "", err
+	}
+
+	if token.RefreshToken != "" {
+		if refreshToken, _, err = m.enc.EncryptProviderSecret(token.RefreshToken); err != nil {
+			return "", "", "", err
+		}
+	}
+
+	return accessToken, refreshToken, keyID, nil
+}
+
+// decode decrypts the token of the account
+func (m *TokenManager) decode(account *ent.OauthAccount) (*oauth2.Token, error) {
+	accessToken, err := m.decrypt(account.AccessToken, account.EncryptionKeyID)
+	if err != nil {
+		return nil, err
+	}
+
+	refreshToken, err := m.decrypt(account.RefreshToken, account.EncryptionKeyID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &oauth2.Token{
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+		TokenType:    "Bearer",
+		Expiry:       account.TokenExpiresAt,
+	}, nil
+}
+
+// decrypt decrypts a stored token, tokens stored before encryption was applied have no key ID
+func (m *TokenManager) decrypt(v, keyID string) (string, error) {
+	if v == "" || keyID == "" {
+		return v, nil
+	}
+
+	return m.enc.DecryptProviderSecret(v, keyID)
+}
+
+// adapter creates the configured adapter of the provider
+func (m *TokenManager) adapter(p *ent.OauthProvider) (interfaces.OAuthAdapter, error) {
+	clientSecret := p.ClientSecret
+	if p.EncryptedSecret != "" && p.EncryptionKeyID != "" {
+		decrypted, err := m.enc.DecryptProviderSecret(p.EncryptedSecret, p.EncryptionKeyID)
+		if err != nil {
+			return nil, err
+		}
+		clientSecret = decrypted
+	}
+
+	// 与登录流程使用相同的适配器类型
+	providerType := provider.ResolveAdapterType(m.factory, interfaces.OAuthProviderType(p.Type), p.AuthURL)
+
+	adapter, err := m.factory.CreateAdapter(providerType)
+	if err != nil {
+		return nil, err
+	}
+
+	err = adapter.Configure(&interfaces.OAuthProviderConfig{
+		Name:         p.Name,
+		DisplayName:  p.DisplayName,
+		Type:         providerType,
+		ClientID:     p.ClientID,
+		ClientSecret: clientSecret,
+		RedirectURL:  p.RedirectURL,
+		Scopes:       strings.Fields(p.Scopes),
+		AuthURL:      p.AuthURL,
+		TokenURL:     p.TokenURL,
+		UserInfoURL:  p.InfoURL,
+		ExtraConfig:  p.ExtraConfig,
+		SupportPKCE:  p.SupportPkce,
+		AuthStyle:    oauth2.AuthStyle(p.AuthStyle),
+		Enabled:      p.Enabled,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return adapter, nil
+}
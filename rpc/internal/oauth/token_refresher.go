@@ -0,0 +1,116 @@
+package oauth
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/coder-lulu/newbee-common/v2/enum/common"
+	"github.com/coder-lulu/newbee-common/v2/orm/ent/hooks"
+	"github.com/zeromicro/go-zero/core/logx"
+
+	"github.com/coder-lulu/newbee-core/rpc/ent"
+	"github.com/coder-lulu/newbee-core/rpc/ent/oauthaccount"
+	"github.com/coder-lulu/newbee-core/rpc/internal/config"
+)
+
+// refreshGiveUpAfter 过期超过该时长仍刷新失败的令牌不再由后台任务重试，获取令牌时仍会尝试刷新
+const refreshGiveUpAfter = 24 * time.Hour
+
+// TokenRefresher periodically refreshes the tokens of the linked accounts which expire within RefreshAhead
+type TokenRefresher struct {
+	conf    config.OauthTokenConf
+	db      *ent.Client
+	manager *TokenManager
+	stopCh  chan struct{}
+	once    sync.Once
+	logger  logx.Logger
+}
+
+// NewTokenRefresher creates a token refresher
+func NewTokenRefresher(c config.OauthTokenConf, db *ent.Client, manager *TokenManager) *TokenRefresher {
+	return &TokenRefresher{
+		conf:    c,
+		db:      db,
+		manager: manager,
+		stopCh:  make(chan struct{}),
+		logger:  logx.WithContext(context.Background()),
+	}
+}
+
+// Start runs the refresher in background
+func (r *TokenRefresher) Start() {
+	if !r.conf.RefreshEnabled {
+		return
+	}
+
+	go func() {
+		ticker := time.NewTicker(r.conf.RefreshInterval)
+		defer ticker.Stop()
+
+		for {
+			r.RunOnce()
+
+			select {
+			case <-ticker.C:
+			case <-r.stopCh:
+				return
+			}
+		}
+	}()
+}
+
+// Stop stops the refresher
+func (r *TokenRefresher) Stop() {
+	r.once.Do(func() {
+		close(r.stopCh)
+	})
+}
+
+// RunOnce refreshes one batch of the tokens which are due
+func (r *TokenRefresher) RunOnce() {
+	now := time.Now()
+	// 按过期时间倒序，避免持续刷新失败的旧令牌占满批次
+	accounts, err := r.db.OauthAccount.Query().
+		Where(
+			oauthaccount.StatusEQ(common.StatusNormal),
+			oauthaccount.RefreshTokenNotNil(),
+			oauthaccount.RefreshTokenNEQ(""),
+			oauthaccount.TokenExpiresAtLTE(now.Add(r.conf.RefreshAhead)),
+			oauthaccount.TokenExpiresAtGTE(now.Add(-refreshGiveUpAfter)),
+		).
+		Order(ent.Desc(oauthaccount.FieldTokenExpiresAt)).
+		Limit(r.conf.BatchSize).
+		WithProvider().
+		All(hooks.NewSystemContext(context.Background()))
+	if err != nil {
+		r.logger.Errorw("failed to list OAuth accounts to refresh", logx.Field("detail", err.Error()))
+		return
+	}
+
+	refreshed := 0
+	for _, v := range accounts {
+		select {
+		case <-r.stopCh:
+			return
+		default:
+		}
+
+		ctx := hooks.SetTenantIDToContext(context.Background(), v.TenantID)
+		if _, err = r.manager.refresh(ctx, v, false); err != nil {
+			// 其他实例正在刷新同一账号
+			if errors.Is(err, ErrRefreshRunning) {
+				continue
+			}
+			r.logger.Errorw("failed to refresh OAuth token", logx.Field("detail", err.Error()),
+				logx.Field("account", v.ID), logx.Field("provider", v.ProviderID))
+			continue
+		}
+		refreshed++
+	}
+
+	if refreshed > 0 {
+		r.logger.Infow("OAuth tokens refreshed", logx.Field("refreshed", refreshed))
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/coder-lulu/newbee-core/rpc/ent"
 	tenant_ent "github.com/coder-lulu/newbee-core/rpc/ent/tenant"
 	"github.com/coder-lulu/newbee-core/rpc/internal/svc"
+	"github.com/coder-lulu/newbee-core/rpc/internal/tenantinit"
 	"github.com/coder-lulu/newbee-core/rpc/internal/utils/redisfunc"
 
 	"github.com/zeromicro/go-zero/core/logx"
@@ -160,6 +161,16 @@ func (p *CoreTenantPlugin) executeCoreTenantInit(ctx context.Context, req *tenan
 		}
 	}
 
+	// 加载初始化模板，模板名称由 initTenant 写入租户配置
+	template, err := tenantinit.Resolve(ctx, p.svcCtx.DB, tenantinit.TemplateName(tenantInfo.Config))
+	if err != nil {
+		return fmt.Errorf("failed to load initialization template: %w", err)
+	}
+	initializer := tenantinit.NewDefaultTenantInitializer(p.logger)
+	if err = initializer.Validate(template); err != nil {
+		return err
+	}
+
 	// 创建租户专属的上下文，避免SystemContext覆盖租户ID
 	tenantCtx := hooks.SetTenantIDToContext(context.Background(), req.TenantID)
 
@@ -177,10 +188,12 @@ func (p *CoreTenantPlugin) executeCoreTenantInit(ctx context.Context, req *tenan
 
 	// 记录初始化开始状态
 	initConfig := map[string]interface{}{
-		"initialized_at": time.Now().Format(time.RFC3339),
-		"version":        "2.0.0", // 标记为新版本
-		"components":     []string{},
-		"status":         "initializing",
+		"initialized_at":             time.Now().Format(time.RFC3339),
+		"version":                    "2.0.0", // 标记为新版本
+		"components":                 []string{},
+		"status":                     "initializing",
+		tenantinit.TemplateConfigKey: template.Name,
+		"template_version":           template.Version,
 	}
 
 	_, err = tx.Tenant.UpdateOneID(req.TenantID).
@@ -193,34 +206,40 @@ func (p *CoreTenantPlugin) executeCoreTenantInit(ctx context.Context, req *tenan
 
 	// 执行初始化步骤（复制现有逻辑）
 
-	// 第一阶段：初始化字典数据
-	if err = p.initDictionaries(tenantCtx, tx, req.TenantID); err != nil {
-		tx.Rollback()
-		return fmt.Errorf("failed to init dictionaries: %w", err)
-	}
-	initConfig["components"] = append(initConfig["components"].([]string), "dictionaries")
-
-	// 第二阶段：初始化系统配置
-	if err = p.initConfigurations(tenantCtx, tx, req.TenantID); err != nil {
-		tx.Rollback()
-		return fmt.Errorf("failed to init configurations: %w", err)
+	// 第一阶段：模板未定义字典时，从超级租户同步字典
+	if _, ok := template.Component(tenantinit.ComponentDictionaries); !ok {
+		if err = p.initDictionaries(tenantCtx, tx, req.TenantID); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("failed to init dictionaries: %w", err)
+		}
+		initConfig["components"] = append(initConfig["components"].([]string), "dictionaries")
 	}
-	initConfig["components"] = append(initConfig["components"].([]string), "configurations")
 
-	// 第三阶段：初始化租户菜单
-	if err = p.initTenantMenus(tenantCtx, tx, req.TenantID); err != nil {
+	// 第二阶段：按模板初始化租户菜单
+	if err = p.initTenantMenus(tenantCtx, tx, req.TenantID, template); err != nil {
 		tx.Rollback()
 		return fmt.Errorf("failed to init menus: %w", err)
 	}
 	initConfig["components"] = append(initConfig["components"].([]string), "tenant_menus")
 
-	// 第四阶段：创建默认部门和职位
-	dept, err := p.initDepartmentAndPositions(tenantCtx, tx, req.TenantID)
+	// 第三阶段：创建默认部门
+	dept, err := p.initDepartment(tenantCtx, tx, req.TenantID)
 	if err != nil {
 		tx.Rollback()
-		return fmt.Errorf("failed to init departments and positions: %w", err)
+		return fmt.Errorf("failed to init departments: %w", err)
+	}
+	initConfig["components"] = append(initConfig["components"].([]string), "departments")
+
+	// 第四阶段：写入模板的字典、配置和职位
+	if err = initializer.Initialize(tenantCtx, tx, req.TenantID, template); err != nil {
+		tx.Rollback()
+		return fmt.Errorf("failed to apply initialization template: %w", err)
+	}
+	for _, comp := range template.Components {
+		if comp.Name != tenantinit.ComponentMenus && comp.Name != tenantinit.ComponentAPIPermissions {
+			initConfig["components"] = append(initConfig["components"].([]string), comp.Name)
+		}
 	}
-	initConfig["components"] = append(initConfig["components"].([]string), "departments", "positions")
 
 	// 第五阶段：创建管理员角色和用户
 	adminRole, adminUser, err := p.initAdminRoleAndUser(tenantCtx, tx, req, dept)
@@ -264,7 +283,7 @@ func (p *CoreTenantPlugin) executeCoreTenantInit(ctx context.Context, req *tenan
 	// 1. Casbin enforcer使用独立的数据库连接，不在事务tx中
 	// 2. 必须等事务提交后，角色数据才对其他连接可见
 	// 3. 如果API权限初始化失败，不影响租户创建（可后续补充）
-	if err = p.initAdminAPIPermissions(tenantCtx, adminRole, req.TenantID); err != nil {
+	if err = p.initAdminAPIPermissions(tenantCtx, p.svcCtx.DB, adminRole, req.TenantID, template); err != nil {
 		p.logger.Errorw("Failed to init admin API permissions (tenant already created)",
 			logx.Field("tenant_id", req.TenantID),
 			logx.Field("admin_role_id", adminRole.ID),
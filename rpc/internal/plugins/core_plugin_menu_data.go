@@ -677,7 +677,7 @@ func (p *CoreTenantPlugin) createCompleteMenus(ctx context.Context, tx *ent.Tx,
 		sort       uint32
 	}{
 		{"/system/audit-log/detail", "AuditLogDetail", "查看审计日志详情", "sys:audit-log:detail", 1},
-		{"/system/audit-log/delete", "DeleteAuditLog", "删除审计日志", "sys:audit-log:delete", 2},
+		{"/system/audit-log/verify", "VerifyAuditLog", "校验审计日志", "sys:audit-log:verify", 2},
 		{"/system/audit-log/export", "ExportAuditLog", "导出审计日志", "sys:audit-log:export", 3},
 	}
 
@@ -10,11 +10,10 @@ import (
 	"github.com/coder-lulu/newbee-common/v2/utils/encrypt"
 	"github.com/coder-lulu/newbee-core/rpc/ent"
 	"github.com/coder-lulu/newbee-core/rpc/ent/casbinrule"
-	"github.com/coder-lulu/newbee-core/rpc/ent/configuration"
 	"github.com/coder-lulu/newbee-core/rpc/ent/dictionary"
 	"github.com/coder-lulu/newbee-core/rpc/ent/dictionarydetail"
 	"github.com/coder-lulu/newbee-core/rpc/ent/menu"
-	"github.com/coder-lulu/newbee-core/rpc/ent/position"
+	"github.com/coder-lulu/newbee-core/rpc/internal/tenantinit"
 	"github.com/coder-lulu/newbee-core/rpc/internal/utils/dberrorhandler"
 
 	"github.com/gofrs/uuid/v5"
@@ -113,89 +112,8 @@ func (p *CoreTenantPlugin) initDictionaries(ctx context.Context, tx *ent.Tx, ten
 	return nil
 }
 
-// initConfigurations 初始化系统配置
-func (p *CoreTenantPlugin) initConfigurations(ctx context.Context, tx *ent.Tx, tenantID uint64) error {
-	// 检查是否已存在配置数据
-	ctxWithTenant := hooks.SetTenantIDToContext(context.Background(), tenantID)
-	existingCount, err := tx.Configuration.Query().
-		Where(configuration.TenantIDEQ(tenantID)).
-		Count(ctxWithTenant)
-	if err != nil {
-		return dberrorhandler.DefaultEntError(p.logger, err, nil)
-	}
-	if existingCount > 0 {
-		logx.Infow("Configurations already exist, skipping", logx.Field("tenant_id", tenantID))
-		return nil
-	}
-
-	// 创建基础配置
-	configs := []*ent.ConfigurationCreate{
-		tx.Configuration.Create().
-			SetName("系统名称").
-			SetKey("system.title").
-			SetValue("NewBee管理系统").
-			SetCategory("system").
-			SetRemark("系统页面标题").
-			SetSort(1).
-			SetState(true).
-			SetTenantID(tenantID),
-		tx.Configuration.Create().
-			SetName("用户初始密码").
-			SetKey("system.user.init_password").
-			SetValue("123456").
-			SetCategory("system").
-			SetRemark("新用户的初始密码").
-			SetSort(2).
-			SetState(true).
-			SetTenantID(tenantID),
-		tx.Configuration.Create().
-			SetName("会话超时时间").
-			SetKey("system.session.timeout").
-			SetValue("30").
-			SetCategory("system").
-			SetRemark("用户会话超时时间(分钟)").
-			SetSort(3).
-			SetState(true).
-			SetTenantID(tenantID),
-		tx.Configuration.Create().
-			SetName("密码复杂度检查").
-			SetKey("system.password.complexity_check").
-			SetValue("true").
-			SetCategory("security").
-			SetRemark("是否启用密码复杂度检查").
-			SetSort(4).
-			SetState(true).
-			SetTenantID(tenantID),
-		tx.Configuration.Create().
-			SetName("登录失败锁定").
-			SetKey("system.login.lock_enabled").
-			SetValue("true").
-			SetCategory("security").
-			SetRemark("是否启用登录失败锁定").
-			SetSort(5).
-			SetState(true).
-			SetTenantID(tenantID),
-		tx.Configuration.Create().
-			SetName("最大登录失败次数").
-			SetKey("system.login.max_retry_count").
-			SetValue("5").
-			SetCategory("security").
-			SetRemark("最大登录失败次数").
-			SetSort(6).
-			SetState(true).
-			SetTenantID(tenantID),
-	}
-
-	if err := tx.Configuration.CreateBulk(configs...).Exec(ctxWithTenant); err != nil {
-		return dberrorhandler.DefaultEntError(p.logger, err, nil)
-	}
-
-	return nil
-}
-
-// initDepartmentAndPositions 初始化部门和职位
-func (p *CoreTenantPlugin) initDepartmentAndPositions(ctx context.Context, tx *ent.Tx, tenantID uint64) (*ent.Department, error) {
-	// 创建默认部门
+// initDepartment 初始化默认部门，职位由初始化模板在该部门下创建
+func (p *CoreTenantPlugin) initDepartment(ctx context.Context, tx *ent.Tx, tenantID uint64) (*ent.Department, error) {
 	ctxWithTenant := hooks.SetTenantIDToContext(context.Background(), tenantID)
 	dept, err := tx.Department.Create().
 		SetName("总公司").
@@ -212,54 +130,28 @@ func (p *CoreTenantPlugin) initDepartmentAndPositions(ctx context.Context, tx *e
 		return nil, dberrorhandler.DefaultEntError(p.logger, err, nil)
 	}
 
-	// 检查是否已存在职位数据
-	existingPosCount, err := tx.Position.Query().
-		Where(position.TenantIDEQ(tenantID)).
-		Count(ctxWithTenant)
+	return dept, nil
+}
+
+// initAdminRoleAndUser 初始化管理员角色和用户
+func (p *CoreTenantPlugin) initAdminRoleAndUser(ctx context.Context, tx *ent.Tx, req *tenant.InitRequest, dept *ent.Department) (*ent.Role, *ent.User, error) {
+	adminRole, err := p.createAdminRole(ctx, tx, req.TenantID)
 	if err != nil {
-		return nil, dberrorhandler.DefaultEntError(p.logger, err, nil)
+		return nil, nil, err
 	}
-	if existingPosCount == 0 {
-		// 创建默认职位
-		positions := []*ent.PositionCreate{
-			tx.Position.Create().
-				SetName("总经理").
-				SetCode("general_manager").
-				SetRemark("企业最高管理者").
-				SetDeptID(dept.ID).
-				SetStatus(1).
-				SetSort(1).
-				SetTenantID(tenantID),
-			tx.Position.Create().
-				SetName("部门经理").
-				SetCode("department_manager").
-				SetRemark("部门负责人").
-				SetDeptID(dept.ID).
-				SetStatus(1).
-				SetSort(2).
-				SetTenantID(tenantID),
-			tx.Position.Create().
-				SetName("普通员工").
-				SetCode("employee").
-				SetRemark("普通工作人员").
-				SetDeptID(dept.ID).
-				SetStatus(1).
-				SetSort(3).
-				SetTenantID(tenantID),
-		}
 
-		if err := tx.Position.CreateBulk(positions...).Exec(ctxWithTenant); err != nil {
-			return nil, dberrorhandler.DefaultEntError(p.logger, err, nil)
-		}
+	adminUser, err := p.createAdminUser(ctx, tx, req, dept, adminRole)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	return dept, nil
+	return adminRole, adminUser, nil
 }
 
-// initAdminRoleAndUser 初始化管理员角色和用户
-func (p *CoreTenantPlugin) initAdminRoleAndUser(ctx context.Context, tx *ent.Tx, req *tenant.InitRequest, dept *ent.Department) (*ent.Role, *ent.User, error) {
+// createAdminRole 创建管理员角色并分配租户的全部菜单
+func (p *CoreTenantPlugin) createAdminRole(ctx context.Context, tx *ent.Tx, tenantID uint64) (*ent.Role, error) {
 	// 创建管理员角色
-	ctxWithTenant := hooks.SetTenantIDToContext(context.Background(), req.TenantID)
+	ctxWithTenant := hooks.SetTenantIDToContext(context.Background(), tenantID)
 	adminRole, err := tx.Role.Create().
 		SetName("超级管理员").
 		SetCode("admin").
@@ -268,10 +160,10 @@ func (p *CoreTenantPlugin) initAdminRoleAndUser(ctx context.Context, tx *ent.Tx,
 		SetStatus(1).
 		SetSort(1).
 		// 🔥 Phase 3: data_scope field removed - now managed via sys_casbin_rules
-		SetTenantID(req.TenantID).
+		SetTenantID(tenantID).
 		Save(ctxWithTenant)
 	if err != nil {
-		return nil, nil, dberrorhandler.DefaultEntError(p.logger, err, req)
+		return nil, dberrorhandler.DefaultEntError(p.logger, err, tenantID)
 	}
 
 	// ✅ 查询租户菜单（使用tx确保能查到事务中创建的菜单）
@@ -279,14 +171,14 @@ func (p *CoreTenantPlugin) initAdminRoleAndUser(ctx context.Context, tx *ent.Tx,
 	menus, err := tx.Menu.Query().
 		Where(
 			menu.DisabledEQ(false),
-			menu.TenantIDEQ(req.TenantID), // 🔒 关键修复：只查询当前租户的菜单
+			menu.TenantIDEQ(tenantID), // 🔒 关键修复：只查询当前租户的菜单
 		).
 		All(ctxWithTenant)
 	if err != nil {
 		logx.Errorw("Failed to query tenant menus",
-			logx.Field("tenant_id", req.TenantID),
+			logx.Field("tenant_id", tenantID),
 			logx.Field("error", err.Error()))
-		return nil, nil, fmt.Errorf("failed to query tenant menus: %w", err)
+		return nil, fmt.Errorf("failed to query tenant menus: %w", err)
 	}
 
 	// ✅ 为管理员角色分配所有菜单权限
@@ -300,20 +192,27 @@ func (p *CoreTenantPlugin) initAdminRoleAndUser(ctx context.Context, tx *ent.Tx,
 			AddMenuIDs(menuIDs...).
 			Save(ctxWithTenant)
 		if err != nil {
-			return nil, nil, dberrorhandler.DefaultEntError(p.logger, err, req)
+			return nil, dberrorhandler.DefaultEntError(p.logger, err, tenantID)
 		}
 
 		logx.Infow("✅ Assigned all menus to admin role",
-			logx.Field("tenant_id", req.TenantID),
+			logx.Field("tenant_id", tenantID),
 			logx.Field("role_id", adminRole.ID),
 			logx.Field("role_code", adminRole.Code),
 			logx.Field("menu_count", len(menuIDs)))
 	} else {
 		logx.Infow("⚠️ No menus available for admin role assignment",
-			logx.Field("tenant_id", req.TenantID),
+			logx.Field("tenant_id", tenantID),
 			logx.Field("role_id", adminRole.ID))
 	}
 
+	return adminRole, nil
+}
+
+// createAdminUser 在部门下创建管理员用户并授予管理员角色，未指定的用户名、密码和邮箱使用默认值
+func (p *CoreTenantPlugin) createAdminUser(ctx context.Context, tx *ent.Tx, req *tenant.InitRequest, dept *ent.Department, adminRole *ent.Role) (*ent.User, error) {
+	ctxWithTenant := hooks.SetTenantIDToContext(context.Background(), req.TenantID)
+
 	// 创建管理员用户
 	username := "admin"
 	if req.AdminUsername != nil && *req.AdminUsername != "" {
@@ -328,7 +227,7 @@ func (p *CoreTenantPlugin) initAdminRoleAndUser(ctx context.Context, tx *ent.Tx,
 	// 获取租户信息以构建默认邮箱
 	tenantInfo, err := p.svcCtx.DB.Tenant.Get(hooks.NewSystemContext(ctx), req.TenantID)
 	if err != nil {
-		return nil, nil, dberrorhandler.DefaultEntError(p.logger, err, req)
+		return nil, dberrorhandler.DefaultEntError(p.logger, err, req)
 	}
 
 	email := "admin@" + tenantInfo.Code + ".com"
@@ -352,7 +251,7 @@ func (p *CoreTenantPlugin) initAdminRoleAndUser(ctx context.Context, tx *ent.Tx,
 		SetTenantID(req.TenantID).
 		Save(ctxWithTenant)
 	if err != nil {
-		return nil, nil, dberrorhandler.DefaultEntError(p.logger, err, req)
+		return nil, dberrorhandler.DefaultEntError(p.logger, err, req)
 	}
 
 	// 为用户分配管理员角色
@@ -360,14 +259,14 @@ func (p *CoreTenantPlugin) initAdminRoleAndUser(ctx context.Context, tx *ent.Tx,
 		AddRoleIDs(adminRole.ID).
 		Save(ctxWithTenant)
 	if err != nil {
-		return nil, nil, dberrorhandler.DefaultEntError(p.logger, err, req)
+		return nil, dberrorhandler.DefaultEntError(p.logger, err, req)
 	}
 
-	return adminRole, adminUser, nil
+	return adminUser, nil
 }
 
-// initTenantMenus 为租户初始化菜单副本
-func (p *CoreTenantPlugin) initTenantMenus(ctx context.Context, tx *ent.Tx, tenantID uint64) error {
+// initTenantMenus 为租户初始化菜单副本，复制的系统菜单由模板的菜单组件筛选
+func (p *CoreTenantPlugin) initTenantMenus(ctx context.Context, tx *ent.Tx, tenantID uint64, template *tenantinit.InitializationTemplate) error {
 	// 检查是否已存在租户菜单数据
 	existingCount, err := tx.Menu.Query().
 		Where(menu.TenantIDEQ(tenantID)).
@@ -395,6 +294,12 @@ func (p *CoreTenantPlugin) initTenantMenus(ctx context.Context, tx *ent.Tx, tena
 		// 如果没有基础菜单，创建完整的菜单结构（包含所有系统菜单和按钮权限）
 		return p.createCompleteMenus(ctx, tx, tenantID)
 	}
+	baseMenus = tenantinit.MenuFilter(template)(baseMenus)
+	if len(baseMenus) == 0 {
+		logx.Infow("No menus selected by the initialization template", logx.Field("tenant_id", tenantID),
+			logx.Field("template", template.Name))
+		return nil
+	}
 
 	// 为租户创建菜单副本
 	var menuCreates []*ent.MenuCreate
@@ -636,14 +541,24 @@ func (p *CoreTenantPlugin) initAdminDataPermissions(ctx context.Context, tx *ent
 // 🔥 Phase 3: 为管理员角色初始化API权限规则
 // initAdminAPIPermissions 直接使用ent创建API权限规则到sys_casbin_rules表
 // ⚠️ 不再使用Casbin enforcer（它会连接到casbin_rules表），而是直接操作sys_casbin_rules
-func (p *CoreTenantPlugin) initAdminAPIPermissions(ctx context.Context, adminRole *ent.Role, tenantID uint64) error {
+// 模板包含接口权限组件时只授予其中列出的接口
+func (p *CoreTenantPlugin) initAdminAPIPermissions(ctx context.Context, db *ent.Client, adminRole *ent.Role, tenantID uint64, template *tenantinit.InitializationTemplate) error {
 	// 使用系统上下文查询所有API（因为API表是系统级数据，不隔离租户）
 	systemCtx := hooks.NewSystemContext(ctx)
 
-	apis, err := p.svcCtx.DB.API.Query().All(systemCtx)
+	apis, err := db.API.Query().All(systemCtx)
 	if err != nil {
 		return dberrorhandler.DefaultEntError(p.logger, err, nil)
 	}
+	if allowed := tenantinit.APIFilter(template); allowed != nil {
+		filtered := make([]*ent.API, 0, len(allowed))
+		for _, api := range apis {
+			if allowed[api.Method+" "+api.Path] {
+				filtered = append(filtered, api)
+			}
+		}
+		apis = filtered
+	}
 
 	if len(apis) == 0 {
 		logx.Infow("No APIs found in system, skipping API permission initialization",
@@ -660,7 +575,7 @@ func (p *CoreTenantPlugin) initAdminAPIPermissions(ctx context.Context, adminRol
 
 	// 清理该角色在该租户下的旧API权限规则（ptype='p'）
 	tenantIDStr := fmt.Sprintf("%d", tenantID)
-	deletedCount, err := p.svcCtx.DB.CasbinRule.Delete().
+	deletedCount, err := db.CasbinRule.Delete().
 		Where(
 			casbinrule.PtypeEQ("p"),
 			casbinrule.V0EQ(adminRole.Code),
@@ -692,7 +607,7 @@ func (p *CoreTenantPlugin) initAdminAPIPermissions(ctx context.Context, adminRol
 	// v4: 效果 (effect: allow/deny)
 	var apiRuleCreates []*ent.CasbinRuleCreate
 	for _, api := range apis {
-		ruleCreate := p.svcCtx.DB.CasbinRule.Create().
+		ruleCreate := db.CasbinRule.Create().
 			SetPtype("p").          // API权限规则类型
 			SetV0(adminRole.Code).  // subject: 角色代码
 			SetV1(tenantIDStr).     // domain: 租户ID
@@ -713,7 +628,7 @@ func (p *CoreTenantPlugin) initAdminAPIPermissions(ctx context.Context, adminRol
 	}
 
 	// 批量执行创建
-	err = p.svcCtx.DB.CasbinRule.CreateBulk(apiRuleCreates...).
+	err = db.CasbinRule.CreateBulk(apiRuleCreates...).
 		Exec(systemCtx)
 	if err != nil {
 		logx.Errorw("Failed to create API permission rules",
@@ -0,0 +1,148 @@
+package plugins
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/coder-lulu/newbee-common/v2/orm/ent/entenum"
+	"github.com/coder-lulu/newbee-common/v2/orm/ent/hooks"
+	"github.com/coder-lulu/newbee-common/v2/tenant"
+
+	"github.com/coder-lulu/newbee-core/rpc/ent"
+	"github.com/coder-lulu/newbee-core/rpc/ent/dictionary"
+	"github.com/coder-lulu/newbee-core/rpc/ent/menu"
+	"github.com/coder-lulu/newbee-core/rpc/internal/initjob"
+	"github.com/coder-lulu/newbee-core/rpc/internal/tenantinit"
+)
+
+var (
+	_ tenantinit.Planner        = (*CoreTenantPlugin)(nil)
+	_ tenantinit.CompletionHook = (*CoreTenantPlugin)(nil)
+)
+
+// Plan 列出核心插件将为租户创建的数据，与 executeCoreTenantInit 的步骤一一对应，不写入任何数据
+func (p *CoreTenantPlugin) Plan(ctx context.Context, req *tenant.InitRequest, templateName string) ([]initjob.PlanItem, error) {
+	systemCtx := hooks.NewSystemContext(ctx)
+
+	tenantInfo, err := p.svcCtx.DB.Tenant.Get(systemCtx, req.TenantID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("tenant %d not found", req.TenantID)
+		}
+		return nil, fmt.Errorf("failed to query tenant: %w", err)
+	}
+
+	if req.Mode != tenant.InitModeRepair && tenantInfo.Config != nil && tenantInfo.Config["status"] == "completed" {
+		return []initjob.PlanItem{{Plugin: "core", Component: "tenant", Action: initjob.ActionSkip, Count: 1,
+			Items: []string{tenantInfo.Code}}}, nil
+	}
+
+	template, err := tenantinit.Resolve(ctx, p.svcCtx.DB, templateName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load initialization template: %w", err)
+	}
+
+	tenantCtx := hooks.SetTenantIDToContext(ctx, req.TenantID)
+	var items []initjob.PlanItem
+
+	// 第一阶段：模板未定义字典时，从超级租户同步字典
+	if _, ok := template.Component(tenantinit.ComponentDictionaries); !ok {
+		base, err := p.svcCtx.DB.Dictionary.Query().
+			Where(dictionary.TenantIDEQ(entenum.GetTenantDefaultId(systemCtx)), dictionary.NameNEQ("")).
+			Select(dictionary.FieldName).
+			Strings(systemCtx)
+		if err != nil {
+			return nil, err
+		}
+		existing, err := p.svcCtx.DB.Dictionary.Query().
+			Where(dictionary.TenantIDEQ(req.TenantID)).
+			Select(dictionary.FieldName).
+			Strings(tenantCtx)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, tenantinit.PlanItems(tenantinit.ComponentDictionaries, base, existing)...)
+	}
+
+	// 第二阶段：按模板复制菜单，租户已有菜单时跳过
+	menuItems, err := p.planMenus(ctx, req.TenantID, template)
+	if err != nil {
+		return nil, err
+	}
+	items = append(items, menuItems...)
+
+	// 第三阶段：默认部门
+	items = append(items, initjob.PlanItem{Component: "departments", Action: initjob.ActionCreate, Count: 1,
+		Items: []string{"总公司"}})
+
+	// 第四阶段：模板的字典、配置和职位
+	templateItems, err := tenantinit.NewDefaultTenantInitializer(p.logger).Plan(tenantCtx, p.svcCtx.DB, req.TenantID, template)
+	if err != nil {
+		return nil, err
+	}
+	items = append(items, templateItems...)
+
+	// 第五、六阶段：管理员角色、用户及数据权限
+	username := "admin"
+	if req.AdminUsername != nil && *req.AdminUsername != "" {
+		username = *req.AdminUsername
+	}
+	items = append(items,
+		initjob.PlanItem{Component: "admin_role", Action: initjob.ActionCreate, Count: 1, Items: []string{"admin"}},
+		initjob.PlanItem{Component: "admin_user", Action: initjob.ActionCreate, Count: 1, Items: []string{username}},
+		initjob.PlanItem{Component: "data_permissions", Action: initjob.ActionCreate, Count: 1, Items: []string{"admin"}},
+	)
+
+	// 第八阶段：管理员的接口权限
+	apis, err := p.svcCtx.DB.API.Query().All(systemCtx)
+	if err != nil {
+		return nil, err
+	}
+	count := len(apis)
+	if allowed := tenantinit.APIFilter(template); allowed != nil {
+		count = 0
+		for _, api := range apis {
+			if allowed[api.Method+" "+api.Path] {
+				count++
+			}
+		}
+	}
+	items = append(items, initjob.PlanItem{Component: tenantinit.ComponentAPIPermissions, Action: initjob.ActionCreate, Count: count})
+
+	for i := range items {
+		items[i].Plugin = "core"
+	}
+
+	return items, nil
+}
+
+// planMenus lists the system menus the template selects, nothing is copied when the tenant has menus
+func (p *CoreTenantPlugin) planMenus(ctx context.Context, tenantID uint64, template *tenantinit.InitializationTemplate) ([]initjob.PlanItem, error) {
+	existing, err := p.svcCtx.DB.Menu.Query().Where(menu.TenantIDEQ(tenantID)).Count(hooks.SetTenantIDToContext(ctx, tenantID))
+	if err != nil {
+		return nil, err
+	}
+	if existing > 0 {
+		return []initjob.PlanItem{{Component: "tenant_menus", Action: initjob.ActionSkip, Count: existing}}, nil
+	}
+
+	baseMenus, err := p.svcCtx.DB.Menu.Query().
+		Where(menu.DisabledEQ(false), menu.TenantIDEQ(0)).
+		Order(ent.Asc(menu.FieldParentID), ent.Asc(menu.FieldSort)).
+		All(hooks.NewSystemContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	if len(baseMenus) == 0 {
+		// 没有系统菜单时使用内置的完整菜单
+		return []initjob.PlanItem{{Component: "tenant_menus", Action: initjob.ActionCreate}}, nil
+	}
+
+	baseMenus = tenantinit.MenuFilter(template)(baseMenus)
+	item := initjob.PlanItem{Component: "tenant_menus", Action: initjob.ActionCreate, Count: len(baseMenus)}
+	for _, m := range baseMenus {
+		item.Items = append(item.Items, m.Name)
+	}
+
+	return []initjob.PlanItem{item}, nil
+}
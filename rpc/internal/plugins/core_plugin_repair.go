@@ -0,0 +1,122 @@
+package plugins
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/coder-lulu/newbee-common/v2/enum/common"
+	"github.com/coder-lulu/newbee-common/v2/orm/ent/hooks"
+	"github.com/coder-lulu/newbee-common/v2/tenant"
+	"github.com/coder-lulu/newbee-core/rpc/ent"
+	"github.com/coder-lulu/newbee-core/rpc/ent/department"
+	"github.com/coder-lulu/newbee-core/rpc/ent/role"
+	"github.com/coder-lulu/newbee-core/rpc/ent/user"
+	"github.com/coder-lulu/newbee-core/rpc/internal/svc"
+	"github.com/coder-lulu/newbee-core/rpc/internal/tenantinit"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/dberrorhandler"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// AdminRoleCode 租户管理员角色代码
+const AdminRoleCode = "admin"
+
+// ErrAdminPasswordRequired 需要重新创建管理员用户但未提供密码
+var ErrAdminPasswordRequired = errors.New("admin password is required to recreate the admin user")
+
+// NewCoreTenantPlugin 创建核心插件实例，供租户修复等场景直接复用初始化步骤
+func NewCoreTenantPlugin(svcCtx *svc.ServiceContext, logger logx.Logger) *CoreTenantPlugin {
+	return &CoreTenantPlugin{
+		svcCtx: svcCtx,
+		logger: logger,
+		config: CorePluginConfig{Timeout: "120s"},
+	}
+}
+
+// RepairRootDepartment 返回租户的根部门，不存在时按初始化流程重新创建
+func (p *CoreTenantPlugin) RepairRootDepartment(ctx context.Context, tx *ent.Tx, tenantID uint64) (*ent.Department, error) {
+	dept, err := tx.Department.Query().
+		Where(department.TenantIDEQ(tenantID), department.ParentIDEQ(0)).
+		Order(ent.Asc(department.FieldSort), ent.Asc(department.FieldCreatedAt)).
+		First(hooks.NewSystemContext(ctx))
+	if err == nil {
+		return dept, nil
+	}
+	if !ent.IsNotFound(err) {
+		return nil, dberrorhandler.DefaultEntError(p.logger, err, tenantID)
+	}
+
+	return p.initDepartment(ctx, tx, tenantID)
+}
+
+// RepairAdminRole 重新创建管理员角色，并按租户的初始化模板恢复菜单、数据权限和接口权限
+func (p *CoreTenantPlugin) RepairAdminRole(ctx context.Context, tx *ent.Tx, tenantID uint64) (*ent.Role, error) {
+	systemCtx := hooks.NewSystemContext(ctx)
+
+	tenantInfo, err := tx.Tenant.Get(systemCtx, tenantID)
+	if err != nil {
+		return nil, dberrorhandler.DefaultEntError(p.logger, err, tenantID)
+	}
+
+	template, err := tenantinit.Resolve(systemCtx, tx.Client(), tenantinit.TemplateName(tenantInfo.Config))
+	if err != nil {
+		return nil, fmt.Errorf("failed to load initialization template: %w", err)
+	}
+
+	adminRole, err := p.createAdminRole(ctx, tx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = p.initAdminDataPermissions(ctx, tx, adminRole, tenantID); err != nil {
+		return nil, err
+	}
+
+	// 修复时角色与接口权限在同一事务中提交
+	if err = p.initAdminAPIPermissions(ctx, tx.Client(), adminRole, tenantID, template); err != nil {
+		return nil, err
+	}
+
+	return adminRole, nil
+}
+
+// RepairAdminUser 为租户恢复管理员用户：已有同名用户时启用并重新授予管理员角色，否则使用给定密码创建
+func (p *CoreTenantPlugin) RepairAdminUser(ctx context.Context, tx *ent.Tx, tenantID uint64, username string, password *string) (*ent.User, error) {
+	systemCtx := hooks.NewSystemContext(ctx)
+
+	adminRole, err := tx.Role.Query().
+		Where(role.TenantIDEQ(tenantID), role.CodeEQ(AdminRoleCode)).
+		Only(systemCtx)
+	if err != nil {
+		return nil, dberrorhandler.DefaultEntError(p.logger, err, tenantID)
+	}
+
+	existing, err := tx.User.Query().
+		Where(user.TenantIDEQ(tenantID), user.UsernameEQ(username)).
+		Only(systemCtx)
+	if err == nil {
+		return existing, tx.User.UpdateOneID(existing.ID).
+			AddRoleIDs(adminRole.ID).
+			SetStatus(common.StatusNormal).
+			Exec(hooks.SetTenantIDToContext(context.Background(), tenantID))
+	}
+	if !ent.IsNotFound(err) {
+		return nil, dberrorhandler.DefaultEntError(p.logger, err, tenantID)
+	}
+
+	if password == nil || *password == "" {
+		return nil, ErrAdminPasswordRequired
+	}
+
+	dept, err := p.RepairRootDepartment(ctx, tx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.createAdminUser(ctx, tx, &tenant.InitRequest{
+		TenantID:      tenantID,
+		AdminUsername: &username,
+		AdminPassword: password,
+	}, dept, adminRole)
+}
@@ -0,0 +1,145 @@
+package plugins
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/coder-lulu/newbee-common/v2/enum/common"
+	"github.com/coder-lulu/newbee-common/v2/tenant"
+	"github.com/zeromicro/go-zero/zrpc"
+
+	"github.com/coder-lulu/newbee-core/rpc/ent"
+	"github.com/coder-lulu/newbee-core/rpc/ent/tenantinitplugin"
+	"github.com/coder-lulu/newbee-core/rpc/tenantplugin"
+	"github.com/coder-lulu/newbee-core/rpc/types/core"
+)
+
+// CorePluginName 核心插件名称，远程插件总是依赖核心插件
+const CorePluginName = "core"
+
+// remoteClients 按 gRPC 地址缓存连接，避免每个任务重新建立连接
+var remoteClients sync.Map
+
+// RemoteTenantPlugin 由其他服务通过 gRPC 提供的租户初始化插件，服务启动时注册到插件表
+type RemoteTenantPlugin struct {
+	info   *ent.TenantInitPlugin
+	client tenantplugin.TenantInitPluginClient
+}
+
+// LoadRemotePlugins 加载已启用的远程插件
+func LoadRemotePlugins(ctx context.Context, db *ent.Client) ([]tenant.TenantInitPlugin, error) {
+	list, err := db.TenantInitPlugin.Query().
+		Where(tenantinitplugin.StatusEQ(common.StatusNormal)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]tenant.TenantInitPlugin, 0, len(list))
+	for _, v := range list {
+		client, err := remoteClient(v.Target)
+		if err != nil {
+			return nil, fmt.Errorf("failed to connect plugin %s: %w", v.Name, err)
+		}
+		result = append(result, &RemoteTenantPlugin{info: v, client: client})
+	}
+
+	return result, nil
+}
+
+func remoteClient(target string) (tenantplugin.TenantInitPluginClient, error) {
+	if v, ok := remoteClients.Load(target); ok {
+		return v.(tenantplugin.TenantInitPluginClient), nil
+	}
+
+	cli, err := zrpc.NewClient(zrpc.RpcClientConf{Target: target, NonBlock: true})
+	if err != nil {
+		return nil, err
+	}
+
+	v, _ := remoteClients.LoadOrStore(target, tenantplugin.NewTenantInitPluginClient(cli.Conn()))
+	return v.(tenantplugin.TenantInitPluginClient), nil
+}
+
+// GetMetadata 获取插件元数据
+func (p *RemoteTenantPlugin) GetMetadata() tenant.PluginMetadata {
+	dependencies := p.info.Dependencies
+	if !slices.Contains(dependencies, CorePluginName) {
+		dependencies = append([]string{CorePluginName}, dependencies...)
+	}
+
+	return tenant.PluginMetadata{
+		Name:              p.info.Name,
+		Version:           p.info.Version,
+		Dependencies:      dependencies,
+		Priority:          int(p.info.Priority),
+		Description:       p.info.Description,
+		Type:              tenant.PluginTypeBusiness,
+		EstimatedDuration: p.timeout(),
+		SupportRollback:   p.info.SupportRollback,
+		Concurrent:        false,
+	}
+}
+
+// Initialize 调用远程服务初始化租户数据，管理员密码不会发送给远程服务
+func (p *RemoteTenantPlugin) Initialize(ctx context.Context, req *tenant.InitRequest) error {
+	ctx, cancel := context.WithTimeout(ctx, p.timeout())
+	defer cancel()
+
+	_, err := p.client.Initialize(ctx, &core.TenantPluginInitReq{
+		TenantId:      req.TenantID,
+		RequestId:     req.RequestID,
+		Mode:          string(req.Mode),
+		AdminUsername: req.AdminUsername,
+		AdminEmail:    req.AdminEmail,
+	})
+	if err != nil {
+		return fmt.Errorf("plugin %s failed to initialize: %w", p.info.Name, err)
+	}
+	return nil
+}
+
+// Rollback 调用远程服务删除初始化创建的数据
+func (p *RemoteTenantPlugin) Rollback(ctx context.Context, tenantID uint64) error {
+	if !p.info.SupportRollback {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, p.timeout())
+	defer cancel()
+
+	if _, err := p.client.Rollback(ctx, &core.TenantPluginTenantReq{TenantId: tenantID}); err != nil {
+		return fmt.Errorf("plugin %s failed to roll back: %w", p.info.Name, err)
+	}
+	return nil
+}
+
+// IsInitialized 查询远程服务中租户是否已初始化
+func (p *RemoteTenantPlugin) IsInitialized(ctx context.Context, tenantID uint64) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, p.timeout())
+	defer cancel()
+
+	resp, err := p.client.IsInitialized(ctx, &core.TenantPluginTenantReq{TenantId: tenantID})
+	if err != nil {
+		return false, fmt.Errorf("plugin %s failed to check the tenant: %w", p.info.Name, err)
+	}
+	return resp.Initialized, nil
+}
+
+// HealthCheck 健康检查
+func (p *RemoteTenantPlugin) HealthCheck(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, p.timeout())
+	defer cancel()
+
+	if _, err := p.client.HealthCheck(ctx, &core.Empty{}); err != nil {
+		return fmt.Errorf("plugin %s is unhealthy: %w", p.info.Name, err)
+	}
+	return nil
+}
+
+func (p *RemoteTenantPlugin) timeout() time.Duration {
+	return time.Duration(p.info.Timeout) * time.Second
+}
@@ -0,0 +1,206 @@
+package policybundle
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	casbinMgr "github.com/coder-lulu/newbee-core/rpc/internal/casbin"
+)
+
+// 权限包：租户内角色、角色菜单、API规则、角色继承和数据权限的可移植描述。
+// 使用角色代码、菜单名称、API路径和方法、部门名称作为标识，不包含数据库ID，
+// 可以在测试环境导出后提交到 git 审阅，再导入到生产环境
+const (
+	// Version 权限包格式版本
+	Version = 1
+
+	FormatYAML = "yaml"
+	FormatJSON = "json"
+)
+
+// 数据权限范围，与 d 规则的 v3 一致
+var dataScopes = []string{"all", "custom_dept", "own_dept_and_sub", "own_dept", "own"}
+
+// ErrInvalidBundle 权限包格式或内容错误
+var ErrInvalidBundle = errors.New("invalid policy bundle")
+
+// Bundle 权限包
+type Bundle struct {
+	Version  int      `json:"version" yaml:"version"`
+	Roles    []Role   `json:"roles" yaml:"roles"`
+	Policies []Policy `json:"policies" yaml:"policies"`
+}
+
+// Role 角色及其菜单、父角色和数据权限
+type Role struct {
+	Code          string `json:"code" yaml:"code"`
+	Name          string `json:"name" yaml:"name"`
+	DefaultRouter string `json:"defaultRouter" yaml:"defaultRouter"`
+	Remark        string `json:"remark,omitempty" yaml:"remark,omitempty"`
+	Sort          uint32 `json:"sort" yaml:"sort"`
+	Status        uint8  `json:"status" yaml:"status"`
+	// Parents 父角色代码
+	Parents []string `json:"parents,omitempty" yaml:"parents,omitempty"`
+	// Menus 菜单名称
+	Menus []string `json:"menus,omitempty" yaml:"menus,omitempty"`
+	// DataScope 数据权限范围，为空表示没有数据权限规则
+	DataScope string `json:"dataScope,omitempty" yaml:"dataScope,omitempty"`
+	// CustomDepartments 自定义数据权限的部门名称
+	CustomDepartments []string `json:"customDepartments,omitempty" yaml:"customDepartments,omitempty"`
+}
+
+// Policy 角色的 API 规则（p 规则）
+type Policy struct {
+	Role       string `json:"role" yaml:"role"`
+	Service    string `json:"service" yaml:"service"`
+	Path       string `json:"path" yaml:"path"`
+	Method     string `json:"method" yaml:"method"`
+	Effect     string `json:"effect" yaml:"effect"`
+	Conditions string `json:"conditions,omitempty" yaml:"conditions,omitempty"`
+}
+
+// key 规则标识，同一标识只能有一条规则，条件不同视为更新
+func (p *Policy) key() string {
+	return strings.Join([]string{p.Role, p.Service, p.Method, p.Path, p.Effect}, " ")
+}
+
+// Marshal 按格式序列化权限包，默认 YAML
+func Marshal(b *Bundle, format string) ([]byte, error) {
+	switch format {
+	case "", FormatYAML:
+		var buf bytes.Buffer
+		encoder := yaml.NewEncoder(&buf)
+		encoder.SetIndent(2)
+		if err := encoder.Encode(b); err != nil {
+			return nil, err
+		}
+		return buf.Bytes(), nil
+	case FormatJSON:
+		return json.MarshalIndent(b, "", "  ")
+	default:
+		return nil, fmt.Errorf("%w: unsupported format %q", ErrInvalidBundle, format)
+	}
+}
+
+// Unmarshal 按格式解析并校验权限包，默认 YAML，未知字段视为错误
+func Unmarshal(data []byte, format string) (*Bundle, error) {
+	b := &Bundle{}
+	switch format {
+	case "", FormatYAML:
+		decoder := yaml.NewDecoder(bytes.NewReader(data))
+		decoder.KnownFields(true)
+		if err := decoder.Decode(b); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidBundle, err)
+		}
+	case FormatJSON:
+		decoder := json.NewDecoder(bytes.NewReader(data))
+		decoder.DisallowUnknownFields()
+		if err := decoder.Decode(b); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidBundle, err)
+		}
+	default:
+		return nil, fmt.Errorf("%w: unsupported format %q", ErrInvalidBundle, format)
+	}
+
+	if err := b.normalize(); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidBundle, err)
+	}
+
+	return b, nil
+}
+
+// normalize 校验权限包并统一格式：方法大写、效果小写、名称列表排序去重
+func (b *Bundle) normalize() error {
+	if b.Version != Version {
+		return fmt.Errorf("unsupported version %d", b.Version)
+	}
+
+	codes := make(map[string]bool, len(b.Roles))
+	for i := range b.Roles {
+		r := &b.Roles[i]
+		if r.Code == "" || r.Name == "" {
+			return fmt.Errorf("roles[%d]: code and name are required", i)
+		}
+		if codes[r.Code] {
+			return fmt.Errorf("role %q is duplicated", r.Code)
+		}
+		codes[r.Code] = true
+
+		if r.Status == 0 {
+			r.Status = 1
+		}
+		if r.DefaultRouter == "" {
+			r.DefaultRouter = "dashboard"
+		}
+		if r.DataScope != "" && !slices.Contains(dataScopes, r.DataScope) {
+			return fmt.Errorf("role %q: unsupported data scope %q", r.Code, r.DataScope)
+		}
+		if r.DataScope == "custom_dept" && len(r.CustomDepartments) == 0 {
+			return fmt.Errorf("role %q: customDepartments is required for the custom_dept data scope", r.Code)
+		}
+		if r.DataScope != "custom_dept" && len(r.CustomDepartments) > 0 {
+			return fmt.Errorf("role %q: customDepartments is only allowed for the custom_dept data scope", r.Code)
+		}
+		if slices.Contains(r.Parents, r.Code) {
+			return fmt.Errorf("role %q inherits itself", r.Code)
+		}
+		r.Parents = sortedSet(r.Parents)
+		r.Menus = sortedSet(r.Menus)
+		r.CustomDepartments = sortedSet(r.CustomDepartments)
+	}
+
+	keys := make(map[string]bool, len(b.Policies))
+	for i := range b.Policies {
+		p := &b.Policies[i]
+		if !codes[p.Role] {
+			return fmt.Errorf("policies[%d]: role %q is not in the bundle", i, p.Role)
+		}
+		if p.Path == "" || p.Method == "" {
+			return fmt.Errorf("policies[%d]: path and method are required", i)
+		}
+		if p.Service == "" {
+			p.Service = "core"
+		}
+		p.Method = strings.ToUpper(p.Method)
+		p.Effect = strings.ToLower(p.Effect)
+		if p.Effect == "" {
+			p.Effect = "allow"
+		}
+		if p.Effect != "allow" && p.Effect != "deny" {
+			return fmt.Errorf("policies[%d]: unsupported effect %q", i, p.Effect)
+		}
+		if err := casbinMgr.ValidateRuleCondition("p", p.Effect, p.Conditions); err != nil {
+			return fmt.Errorf("policies[%d]: %v", i, err)
+		}
+		if keys[p.key()] {
+			return fmt.Errorf("policies[%d]: duplicated policy %s %s for role %q", i, p.Method, p.Path, p.Role)
+		}
+		keys[p.key()] = true
+	}
+
+	b.sort()
+
+	return nil
+}
+
+// sort 按角色代码和规则标识排序，保证导出结果稳定，便于在 git 中比较
+func (b *Bundle) sort() {
+	slices.SortFunc(b.Roles, func(x, y Role) int { return strings.Compare(x.Code, y.Code) })
+	slices.SortFunc(b.Policies, func(x, y Policy) int {
+		return strings.Compare(x.Role+" "+x.Service+" "+x.Path+" "+x.Method+" "+x.Effect,
+			y.Role+" "+y.Service+" "+y.Path+" "+y.Method+" "+y.Effect)
+	})
+}
+
+func sortedSet(values []string) []string {
+	if len(values) == 0 {
+		return nil
+	}
+	return slices.Compact(slices.Sorted(slices.Values(values)))
+}
@@ -0,0 +1,170 @@
+package policybundle
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/coder-lulu/newbee-common/v2/orm/ent/hooks"
+	"github.com/coder-lulu/newbee-core/rpc/ent"
+	"github.com/coder-lulu/newbee-core/rpc/ent/casbinrule"
+	"github.com/coder-lulu/newbee-core/rpc/ent/department"
+	"github.com/coder-lulu/newbee-core/rpc/ent/role"
+	casbinMgr "github.com/coder-lulu/newbee-core/rpc/internal/casbin"
+)
+
+// Export 导出租户的权限包，roleCodes 为空时导出全部角色。
+// 只导出主体为角色的规则，用户与角色的绑定依赖用户ID，不属于权限包
+func Export(ctx context.Context, db *ent.Client, tenantID uint64, roleCodes []string) (*Bundle, error) {
+	systemCtx := hooks.NewSystemContext(ctx)
+
+	query := db.Role.Query().Where(role.TenantIDEQ(tenantID))
+	if len(roleCodes) > 0 {
+		query.Where(role.CodeIn(roleCodes...))
+	}
+	roles, err := query.
+		WithMenus().
+		All(systemCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	codes := make([]string, 0, len(roles))
+	for _, r := range roles {
+		codes = append(codes, r.Code)
+	}
+
+	parents, err := casbinMgr.RoleParents(ctx, db, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	scopes, err := dataScopeRules(systemCtx, db, tenantID, codes)
+	if err != nil {
+		return nil, err
+	}
+
+	deptNames, err := departmentNames(systemCtx, db, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	b := &Bundle{Version: Version}
+	for _, r := range roles {
+		item := Role{
+			Code:          r.Code,
+			Name:          r.Name,
+			DefaultRouter: r.DefaultRouter,
+			Remark:        r.Remark,
+			Sort:          r.Sort,
+			Status:        r.Status,
+			Parents:       sortedSet(parents[r.Code]),
+		}
+		for _, m := range r.Edges.Menus {
+			item.Menus = append(item.Menus, m.Name)
+		}
+		item.Menus = sortedSet(item.Menus)
+
+		if rule, ok := scopes[r.Code]; ok {
+			item.DataScope = rule.V3
+			if item.DataScope == "custom_dept" {
+				for _, id := range r.CustomDeptIds {
+					if name, ok := deptNames[id]; ok {
+						item.CustomDepartments = append(item.CustomDepartments, name)
+					}
+				}
+				item.CustomDepartments = sortedSet(item.CustomDepartments)
+			}
+		}
+
+		b.Roles = append(b.Roles, item)
+	}
+
+	rules, err := policyRules(systemCtx, db, tenantID, codes)
+	if err != nil {
+		return nil, err
+	}
+	keys := make(map[string]bool, len(rules))
+	for _, r := range rules {
+		p := fromRule(r)
+		// 跳过重复规则和无法导入的规则
+		if keys[p.key()] || (p.Effect != "allow" && p.Effect != "deny") {
+			continue
+		}
+		keys[p.key()] = true
+		b.Policies = append(b.Policies, p)
+	}
+
+	b.sort()
+
+	return b, nil
+}
+
+// policyRules 查询角色的 p 规则
+func policyRules(ctx context.Context, db *ent.Client, tenantID uint64, codes []string) ([]*ent.CasbinRule, error) {
+	return db.CasbinRule.Query().
+		Where(
+			casbinrule.TenantIDEQ(tenantID),
+			casbinrule.PtypeEQ("p"),
+			casbinrule.V0In(codes...),
+			casbinrule.V1EQ(strconv.FormatUint(tenantID, 10)),
+		).
+		Order(ent.Asc(casbinrule.FieldID)).
+		All(ctx)
+}
+
+// dataScopeRules 查询角色的数据权限规则（d 规则），返回 角色代码 -> 规则
+func dataScopeRules(ctx context.Context, db *ent.Client, tenantID uint64, codes []string) (map[string]*ent.CasbinRule, error) {
+	rules, err := db.CasbinRule.Query().
+		Where(
+			casbinrule.TenantIDEQ(tenantID),
+			casbinrule.PtypeEQ("d"),
+			casbinrule.V0In(codes...),
+		).
+		Order(ent.Asc(casbinrule.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]*ent.CasbinRule, len(rules))
+	for _, r := range rules {
+		if _, ok := result[r.V0]; !ok {
+			result[r.V0] = r
+		}
+	}
+	return result, nil
+}
+
+// departmentNames 查询租户的部门名称，返回 部门ID -> 名称
+func departmentNames(ctx context.Context, db *ent.Client, tenantID uint64) (map[uint64]string, error) {
+	departments, err := db.Department.Query().
+		Where(department.TenantIDEQ(tenantID)).
+		Select(department.FieldID, department.FieldName).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[uint64]string, len(departments))
+	for _, d := range departments {
+		result[d.ID] = d.Name
+	}
+	return result, nil
+}
+
+// fromRule 将 p 规则转换为权限包规则，效果为空时视为 allow
+func fromRule(r *ent.CasbinRule) Policy {
+	p := Policy{
+		Role:       r.V0,
+		Service:    r.ServiceName,
+		Path:       r.V2,
+		Method:     strings.ToUpper(r.V3),
+		Effect:     strings.ToLower(r.V4),
+		Conditions: r.Conditions,
+	}
+	if p.Effect == "" {
+		p.Effect = "allow"
+	}
+	return p
+}
@@ -0,0 +1,511 @@
+package policybundle
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+
+	"github.com/coder-lulu/newbee-common/v2/orm/ent/hooks"
+	"github.com/coder-lulu/newbee-core/rpc/ent"
+	"github.com/coder-lulu/newbee-core/rpc/ent/casbinrule"
+	"github.com/coder-lulu/newbee-core/rpc/ent/department"
+	"github.com/coder-lulu/newbee-core/rpc/ent/menu"
+	"github.com/coder-lulu/newbee-core/rpc/ent/role"
+	casbinMgr "github.com/coder-lulu/newbee-core/rpc/internal/casbin"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/entx"
+)
+
+// 变更类型
+const (
+	KindRole      = "role"
+	KindMenu      = "menu"
+	KindParent    = "parent"
+	KindDataScope = "data_scope"
+	KindPolicy    = "policy"
+
+	ActionCreate = "create"
+	ActionUpdate = "update"
+	ActionDelete = "delete"
+)
+
+// Change 导入计划中的一项变更
+type Change struct {
+	Kind   string
+	Action string
+	// Role 角色代码
+	Role string
+	// Target 变更对象：菜单名称、父角色代码、数据权限范围或 "METHOD path"
+	Target string
+}
+
+// Import 将权限包导入租户。权限包中的角色以包内内容为准：菜单、父角色、数据权限和 p 规则
+// 都会替换为包内的集合；包外的角色和用户与角色的绑定不受影响。
+// dryRun 为 true 时只返回变更计划，否则在同一事务中应用全部变更
+func Import(ctx context.Context, db *ent.Client, tenantID uint64, b *Bundle, dryRun bool) ([]Change, error) {
+	if dryRun {
+		im := &importer{ctx: hooks.NewSystemContext(ctx), client: db, tenantID: tenantID, dryRun: true}
+		return im.run(b)
+	}
+
+	var changes []Change
+	err := entx.WithTx(ctx, db, func(tx *ent.Tx) error {
+		im := &importer{ctx: hooks.NewSystemContext(ctx), client: tx.Client(), tenantID: tenantID}
+		var err error
+		changes, err = im.run(b)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return changes, nil
+}
+
+type importer struct {
+	ctx      context.Context
+	client   *ent.Client
+	tenantID uint64
+	dryRun   bool
+
+	roles     map[string]*ent.Role
+	menus     map[string][]uint64
+	deptIDs   map[string][]uint64
+	deptNames map[uint64]string
+	parents   map[string][]string
+	scopes    map[string]*ent.CasbinRule
+	policies  map[string][]*ent.CasbinRule
+	changes   []Change
+}
+
+func (im *importer) run(b *Bundle) ([]Change, error) {
+	codes := make([]string, 0, len(b.Roles))
+	for _, r := range b.Roles {
+		codes = append(codes, r.Code)
+	}
+
+	if err := im.load(codes); err != nil {
+		return nil, err
+	}
+	if err := im.validate(b); err != nil {
+		return nil, err
+	}
+
+	desired := make(map[string][]Policy, len(b.Roles))
+	for _, p := range b.Policies {
+		desired[p.Role] = append(desired[p.Role], p)
+	}
+
+	for _, r := range b.Roles {
+		roleID, err := im.syncRole(r)
+		if err != nil {
+			return nil, fmt.Errorf("role %s: %w", r.Code, err)
+		}
+		if err = im.syncMenus(r, roleID); err != nil {
+			return nil, fmt.Errorf("role %s menus: %w", r.Code, err)
+		}
+		if err = im.syncParents(r); err != nil {
+			return nil, fmt.Errorf("role %s parents: %w", r.Code, err)
+		}
+		if err = im.syncDataScope(r, roleID); err != nil {
+			return nil, fmt.Errorf("role %s data scope: %w", r.Code, err)
+		}
+		if err = im.syncPolicies(r.Code, desired[r.Code]); err != nil {
+			return nil, fmt.Errorf("role %s policies: %w", r.Code, err)
+		}
+	}
+
+	return im.changes, nil
+}
+
+// load 加载租户现有的角色、菜单、部门和规则
+func (im *importer) load(codes []string) error {
+	// 导入会修改角色继承，先锁定租户的角色，与其它修改继承关系的事务依次执行
+	if !im.dryRun {
+		if err := casbinMgr.LockRoles(im.ctx, im.client, im.tenantID); err != nil {
+			return err
+		}
+	}
+
+	roles, err := im.client.Role.Query().
+		Where(role.TenantIDEQ(im.tenantID)).
+		WithMenus().
+		All(im.ctx)
+	if err != nil {
+		return err
+	}
+	im.roles = make(map[string]*ent.Role, len(roles))
+	for _, r := range roles {
+		im.roles[r.Code] = r
+	}
+
+	menus, err := im.client.Menu.Query().
+		Where(menu.TenantIDEQ(im.tenantID)).
+		Select(menu.FieldID, menu.FieldName).
+		All(im.ctx)
+	if err != nil {
+		return err
+	}
+	im.menus = make(map[string][]uint64, len(menus))
+	for _, m := range menus {
+		im.menus[m.Name] = append(im.menus[m.Name], m.ID)
+	}
+
+	departments, err := im.client.Department.Query().
+		Where(department.TenantIDEQ(im.tenantID)).
+		Select(department.FieldID, department.FieldName).
+		All(im.ctx)
+	if err != nil {
+		return err
+	}
+	im.deptIDs = make(map[string][]uint64, len(departments))
+	im.deptNames = make(map[uint64]string, len(departments))
+	for _, d := range departments {
+		im.deptIDs[d.Name] = append(im.deptIDs[d.Name], d.ID)
+		im.deptNames[d.ID] = d.Name
+	}
+
+	if im.parents, err = casbinMgr.RoleParents(im.ctx, im.client, im.tenantID); err != nil {
+		return err
+	}
+	if im.scopes, err = dataScopeRules(im.ctx, im.client, im.tenantID, codes); err != nil {
+		return err
+	}
+
+	rules, err := policyRules(im.ctx, im.client, im.tenantID, codes)
+	if err != nil {
+		return err
+	}
+	im.policies = make(map[string][]*ent.CasbinRule, len(rules))
+	for _, r := range rules {
+		im.policies[r.V0] = append(im.policies[r.V0], r)
+	}
+
+	return nil
+}
+
+// validate 检查权限包引用的菜单、部门和父角色在租户中存在，且继承关系不形成环
+func (im *importer) validate(b *Bundle) error {
+	inBundle := make(map[string]bool, len(b.Roles))
+	for _, r := range b.Roles {
+		inBundle[r.Code] = true
+	}
+
+	parents := make(map[string][]string, len(im.parents)+len(b.Roles))
+	for code, p := range im.parents {
+		parents[code] = p
+	}
+	for _, r := range b.Roles {
+		parents[r.Code] = r.Parents
+
+		for _, name := range r.Menus {
+			if len(im.menus[name]) == 0 {
+				return fmt.Errorf("%w: role %q: menu %q not found", ErrInvalidBundle, r.Code, name)
+			}
+		}
+		for _, name := range r.CustomDepartments {
+			if n := len(im.deptIDs[name]); n != 1 {
+				return fmt.Errorf("%w: role %q: department %q matches %d departments", ErrInvalidBundle, r.Code, name, n)
+			}
+		}
+		for _, p := range r.Parents {
+			if _, ok := im.roles[p]; !ok && !inBundle[p] {
+				return fmt.Errorf("%w: role %q: parent role %q not found", ErrInvalidBundle, r.Code, p)
+			}
+		}
+	}
+
+	for _, r := range b.Roles {
+		if slices.Contains(casbinMgr.ExpandRoles(parents, r.Parents...), r.Code) {
+			return fmt.Errorf("%w: role %q: inheritance cycle", ErrInvalidBundle, r.Code)
+		}
+	}
+
+	return nil
+}
+
+func (im *importer) record(kind, action, roleCode, target string) {
+	im.changes = append(im.changes, Change{Kind: kind, Action: action, Role: roleCode, Target: target})
+}
+
+// syncRole 创建或更新角色，返回角色ID，试运行时新角色的ID为 0
+func (im *importer) syncRole(r Role) (uint64, error) {
+	existing, ok := im.roles[r.Code]
+	if !ok {
+		im.record(KindRole, ActionCreate, r.Code, r.Name)
+		if im.dryRun {
+			return 0, nil
+		}
+		created, err := im.client.Role.Create().
+			SetName(r.Name).
+			SetCode(r.Code).
+			SetDefaultRouter(r.DefaultRouter).
+			SetRemark(r.Remark).
+			SetSort(r.Sort).
+			SetStatus(r.Status).
+			SetTenantID(im.tenantID).
+			Save(im.ctx)
+		if err != nil {
+			return 0, err
+		}
+		return created.ID, nil
+	}
+
+	if existing.Name == r.Name && existing.DefaultRouter == r.DefaultRouter && existing.Remark == r.Remark &&
+		existing.Sort == r.Sort && existing.Status == r.Status {
+		return existing.ID, nil
+	}
+
+	im.record(KindRole, ActionUpdate, r.Code, r.Name)
+	if im.dryRun {
+		return existing.ID, nil
+	}
+	return existing.ID, im.client.Role.UpdateOneID(existing.ID).
+		SetName(r.Name).
+		SetDefaultRouter(r.DefaultRouter).
+		SetRemark(r.Remark).
+		SetSort(r.Sort).
+		SetStatus(r.Status).
+		Exec(im.ctx)
+}
+
+// syncMenus 将角色菜单替换为包内的菜单
+func (im *importer) syncMenus(r Role, roleID uint64) error {
+	current := make(map[string][]uint64)
+	if existing, ok := im.roles[r.Code]; ok {
+		for _, m := range existing.Edges.Menus {
+			current[m.Name] = append(current[m.Name], m.ID)
+		}
+	}
+
+	var addIDs, removeIDs []uint64
+	for _, name := range r.Menus {
+		if _, ok := current[name]; !ok {
+			im.record(KindMenu, ActionCreate, r.Code, name)
+			addIDs = append(addIDs, im.menus[name]...)
+		}
+	}
+	for _, name := range slices.Sorted(maps.Keys(current)) {
+		if !slices.Contains(r.Menus, name) {
+			im.record(KindMenu, ActionDelete, r.Code, name)
+			removeIDs = append(removeIDs, current[name]...)
+		}
+	}
+
+	if im.dryRun || (len(addIDs) == 0 && len(removeIDs) == 0) {
+		return nil
+	}
+	return im.client.Role.UpdateOneID(roleID).
+		AddMenuIDs(addIDs...).
+		RemoveMenuIDs(removeIDs...).
+		Exec(im.ctx)
+}
+
+// syncParents 将角色的父角色替换为包内的父角色
+func (im *importer) syncParents(r Role) error {
+	current := sortedSet(im.parents[r.Code])
+	if slices.Equal(current, r.Parents) {
+		return nil
+	}
+
+	for _, p := range r.Parents {
+		if !slices.Contains(current, p) {
+			im.record(KindParent, ActionCreate, r.Code, p)
+		}
+	}
+	for _, p := range current {
+		if !slices.Contains(r.Parents, p) {
+			im.record(KindParent, ActionDelete, r.Code, p)
+		}
+	}
+	if im.dryRun {
+		return nil
+	}
+
+	_, err := im.client.CasbinRule.Delete().
+		Where(
+			casbinrule.TenantIDEQ(im.tenantID),
+			casbinrule.PtypeEQ("g"),
+			casbinrule.CategoryEQ(casbinMgr.RoleInheritanceCategory),
+			casbinrule.V0EQ(r.Code),
+		).
+		Exec(im.ctx)
+	if err != nil {
+		return err
+	}
+
+	creates := make([]*ent.CasbinRuleCreate, 0, len(r.Parents))
+	for _, parent := range r.Parents {
+		creates = append(creates, im.client.CasbinRule.Create().
+			SetPtype("g").
+			SetV0(r.Code). // 子角色
+			SetV1(parent). // 父角色
+			SetV2(strconv.FormatUint(im.tenantID, 10)).
+			SetServiceName("core").
+			SetRuleName(fmt.Sprintf("%s继承%s", r.Code, parent)).
+			SetCategory(casbinMgr.RoleInheritanceCategory).
+			SetStatus(1).
+			SetTenantID(im.tenantID))
+	}
+	return im.client.CasbinRule.CreateBulk(creates...).Exec(im.ctx)
+}
+
+// syncDataScope 将角色的数据权限替换为包内的数据权限
+func (im *importer) syncDataScope(r Role, roleID uint64) error {
+	var currentScope string
+	var currentDepts []string
+	if rule, ok := im.scopes[r.Code]; ok {
+		currentScope = rule.V3
+	}
+	if existing, ok := im.roles[r.Code]; ok && currentScope == "custom_dept" {
+		for _, id := range existing.CustomDeptIds {
+			if name, ok := im.deptNames[id]; ok {
+				currentDepts = append(currentDepts, name)
+			}
+		}
+	}
+	if currentScope == r.DataScope && slices.Equal(sortedSet(currentDepts), r.CustomDepartments) {
+		return nil
+	}
+
+	switch {
+	case r.DataScope == "":
+		im.record(KindDataScope, ActionDelete, r.Code, currentScope)
+	case currentScope == "":
+		im.record(KindDataScope, ActionCreate, r.Code, r.DataScope)
+	default:
+		im.record(KindDataScope, ActionUpdate, r.Code, r.DataScope)
+	}
+	if im.dryRun {
+		return nil
+	}
+
+	deptIDs := make([]uint64, 0, len(r.CustomDepartments))
+	for _, name := range r.CustomDepartments {
+		deptIDs = append(deptIDs, im.deptIDs[name]...)
+	}
+	if err := im.client.Role.UpdateOneID(roleID).SetCustomDeptIds(deptIDs).Exec(im.ctx); err != nil {
+		return err
+	}
+
+	_, err := im.client.CasbinRule.Delete().
+		Where(
+			casbinrule.TenantIDEQ(im.tenantID),
+			casbinrule.PtypeEQ("d"),
+			casbinrule.V0EQ(r.Code),
+		).
+		Exec(im.ctx)
+	if err != nil || r.DataScope == "" {
+		return err
+	}
+
+	// v4 保存自定义部门ID列表，与角色数据权限接口一致
+	v4 := ""
+	if len(deptIDs) > 0 {
+		ids := make([]string, 0, len(deptIDs))
+		for _, id := range deptIDs {
+			ids = append(ids, strconv.FormatUint(id, 10))
+		}
+		data, _ := json.Marshal(ids)
+		v4 = string(data)
+	}
+
+	return im.client.CasbinRule.Create().
+		SetPtype("d").
+		SetV0(r.Code).
+		SetV1(strconv.FormatUint(im.tenantID, 10)).
+		SetV2("*").
+		SetV3(r.DataScope).
+		SetV4(v4).
+		SetServiceName("core").
+		SetRuleName(fmt.Sprintf("%s数据权限", r.Name)).
+		SetDescription(fmt.Sprintf("角色%s的数据权限规则，数据范围：%s", r.Name, r.DataScope)).
+		SetCategory("data_permission").
+		SetVersion("1.0.0").
+		SetRequireApproval(false).
+		SetApprovalStatus("approved").
+		SetStatus(1).
+		SetTenantID(im.tenantID).
+		Exec(im.ctx)
+}
+
+// syncPolicies 将角色的 p 规则替换为包内的规则，条件不同的规则原地更新
+func (im *importer) syncPolicies(code string, desired []Policy) error {
+	current := make(map[string][]*ent.CasbinRule)
+	for _, rule := range im.policies[code] {
+		p := fromRule(rule)
+		current[p.key()] = append(current[p.key()], rule)
+	}
+
+	var deleteIDs []uint64
+	wanted := make(map[string]bool, len(desired))
+	var creates []*ent.CasbinRuleCreate
+	for _, p := range desired {
+		wanted[p.key()] = true
+		target := p.Method + " " + p.Path
+
+		rules, ok := current[p.key()]
+		if !ok {
+			im.record(KindPolicy, ActionCreate, code, target)
+			creates = append(creates, im.client.CasbinRule.Create().
+				SetPtype("p").
+				SetV0(code).
+				SetV1(strconv.FormatUint(im.tenantID, 10)).
+				SetV2(p.Path).
+				SetV3(p.Method).
+				SetV4(p.Effect).
+				SetConditions(p.Conditions).
+				SetServiceName(p.Service).
+				SetRuleName(fmt.Sprintf("%s %s to %s %s", code, p.Effect, p.Method, p.Path)).
+				SetCategory("api_permission").
+				SetVersion("1.0.0").
+				SetRequireApproval(false).
+				SetApprovalStatus("approved").
+				SetStatus(1).
+				SetTenantID(im.tenantID))
+			continue
+		}
+
+		// 清理重复规则，只保留一条
+		for _, rule := range rules[1:] {
+			deleteIDs = append(deleteIDs, rule.ID)
+		}
+		if rules[0].Conditions != p.Conditions {
+			im.record(KindPolicy, ActionUpdate, code, target)
+			if !im.dryRun {
+				err := im.client.CasbinRule.UpdateOneID(rules[0].ID).
+					SetConditions(p.Conditions).
+					Exec(im.ctx)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	for _, key := range slices.Sorted(maps.Keys(current)) {
+		if wanted[key] {
+			continue
+		}
+		p := fromRule(current[key][0])
+		im.record(KindPolicy, ActionDelete, code, p.Method+" "+p.Path)
+		for _, rule := range current[key] {
+			deleteIDs = append(deleteIDs, rule.ID)
+		}
+	}
+
+	if im.dryRun {
+		return nil
+	}
+	if len(deleteIDs) > 0 {
+		if _, err := im.client.CasbinRule.Delete().Where(casbinrule.IDIn(deleteIDs...)).Exec(im.ctx); err != nil {
+			return err
+		}
+	}
+	if len(creates) > 0 {
+		return im.client.CasbinRule.CreateBulk(creates...).Exec(im.ctx)
+	}
+	return nil
+}
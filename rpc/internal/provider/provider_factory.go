@@ -3,6 +3,7 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strings"
 	"sync"
 	"time"
 
@@ -153,8 +154,33 @@ func (pf *ProviderFactory) GetConfiguredAdapter(ctx context.Context, tenantID ui
 		return nil, fmt.Errorf("provider %s is disabled for tenant %d", providerType, tenantID)
 	}
 
+	config.Type = ResolveAdapterType(pf.adapterFactory, config.Type, config.AuthURL)
+	config.AuthURL = expandAuthURL(config)
+
 	// Create and configure adapter
-	return pf.CreateProviderFromAdapter(ctx, tenantID, providerType, config)
+	return pf.CreateProviderFromAdapter(ctx, tenantID, config.Type, config)
+}
+
+// ResolveAdapterType returns the adapter type of a provider record. Feishu providers created before
+// the type was introduced are recognized by the authorization URL, unknown types use the custom adapter.
+func ResolveAdapterType(factory interfaces.OAuthAdapterFactory, providerType interfaces.OAuthProviderType, authURL string) interfaces.OAuthProviderType {
+	if strings.Contains(authURL, "feishu") {
+		return interfaces.ProviderTypeFeishu
+	}
+	if !factory.IsProviderSupported(providerType) {
+		return interfaces.ProviderTypeCustom
+	}
+	return providerType
+}
+
+// expandAuthURL replaces the keywords in the authorization URL which are supported by the provider
+// records, the client secret is never put into a URL sent to the browser
+func expandAuthURL(config *interfaces.OAuthProviderConfig) string {
+	return strings.NewReplacer(
+		"CLIENT_ID", config.ClientID,
+		"REDIRECT_URL", config.RedirectURL,
+		"SCOPE", strings.Join(config.Scopes, " "),
+	).Replace(config.AuthURL)
 }
 
 // ListRegisteredTypes returns all registered provider types
@@ -238,6 +264,12 @@ func (pf *ProviderFactory) validateRegistration(registration *ProviderRegistrati
 
 // validateRequiredFields validates required fields based on template
 func (pf *ProviderFactory) validateRequiredFields(registration *ProviderRegistration, template *ProviderTemplate) error {
+	if registration.Type == interfaces.ProviderTypeOIDC {
+		if issuer, _ := registration.ExtraConfig["issuer"].(string); issuer == "" {
+			return fmt.Errorf("issuer is required in extra config for OIDC provider")
+		}
+	}
+
 	// This would validate specific required fields for each provider type
 	// For now, basic validation is done in validateRegistration
 	return nil
@@ -369,6 +401,19 @@ func (pf *ProviderFactory) registerBuiltinTemplates() {
 			SupportedScopes: []string{"get_user_info"},
 			DefaultScopes:   []string{"get_user_info"},
 		},
+		{
+			Type:        interfaces.ProviderTypeOIDC,
+			DisplayName: "OpenID Connect",
+			Description: "Generic OpenID Connect provider, endpoints are discovered from the issuer",
+			DefaultConfig: &interfaces.OAuthProviderConfig{
+				AuthStyle:   0, // AuthStyleAutoDetect
+				SupportPKCE: true,
+			},
+			RequiredFields:  []string{"client_id", "client_secret", "redirect_url", "extra_config.issuer"},
+			OptionalFields:  []string{"scopes", "extra_config.auth_params"},
+			SupportedScopes: []string{"openid", "profile", "email", "phone", "address", "offline_access"},
+			DefaultScopes:   []string{"openid", "profile", "email"},
+		},
 	}
 
 	for _, template := range templates {
@@ -15,6 +15,7 @@ type ProviderRegistry struct {
 	providers      map[string]interfaces.OAuthAdapter // key: tenantID:providerType
 	factory        *ProviderFactory
 	lastAccessed   map[string]time.Time
+	versions       map[string]time.Time // updated_at of the provider record the instance was built from
 	cleanupTicker  *time.Ticker
 	stopCleanup    chan struct{}
 	maxIdleTime    time.Duration
@@ -32,6 +33,7 @@ func NewProviderRegistry(factory *ProviderFactory, maxIdleTime time.Duration) *P
 		providers:    make(map[string]interfaces.OAuthAdapter),
 		factory:      factory,
 		lastAccessed: make(map[string]time.Time),
+		versions:     make(map[string]time.Time),
 		stopCleanup:  make(chan struct{}),
 		maxIdleTime:  maxIdleTime,
 	}
@@ -44,6 +46,12 @@ func NewProviderRegistry(factory *ProviderFactory, maxIdleTime time.Duration) *P
 
 // GetProvider retrieves or creates a provider instance
 func (pr *ProviderRegistry) GetProvider(ctx context.Context, tenantID uint64, providerType interfaces.OAuthProviderType) (interfaces.OAuthAdapter, error) {
+	return pr.GetProviderVersion(ctx, tenantID, providerType, time.Time{})
+}
+
+// GetProviderVersion retrieves or creates a provider instance, a created instance records the version,
+// i.e. the updated_at of the provider record, so that IsStale can detect updates made by other instances
+func (pr *ProviderRegistry) GetProviderVersion(ctx context.Context, tenantID uint64, providerType interfaces.OAuthProviderType, version time.Time) (interfaces.OAuthAdapter, error) {
 	key := ProviderKey(tenantID, providerType)
 
 	// Check if provider already exists
@@ -60,11 +68,24 @@ func (pr *ProviderRegistry) GetProvider(ctx context.Context, tenantID uint64, pr
 	}
 
 	// Provider doesn't exist, create it
-	return pr.createAndCacheProvider(ctx, tenantID, providerType)
+	return pr.createAndCacheProvider(ctx, tenantID, providerType, version)
+}
+
+// IsStale reports whether the cached instance was built from another version of the provider record
+func (pr *ProviderRegistry) IsStale(tenantID uint64, providerType interfaces.OAuthProviderType, version time.Time) bool {
+	key := ProviderKey(tenantID, providerType)
+
+	pr.mu.RLock()
+	defer pr.mu.RUnlock()
+
+	if _, exists := pr.providers[key]; !exists {
+		return false
+	}
+	return !pr.versions[key].Equal(version)
 }
 
 // createAndCacheProvider creates a new provider and caches it
-func (pr *ProviderRegistry) createAndCacheProvider(ctx context.Context, tenantID uint64, providerType interfaces.OAuthProviderType) (interfaces.OAuthAdapter, error) {
+func (pr *ProviderRegistry) createAndCacheProvider(ctx context.Context, tenantID uint64, providerType interfaces.OAuthProviderType, version time.Time) (interfaces.OAuthAdapter, error) {
 	pr.mu.Lock()
 	defer pr.mu.Unlock()
 
@@ -85,6 +106,7 @@ func (pr *ProviderRegistry) createAndCacheProvider(ctx context.Context, tenantID
 	// Cache the provider
 	pr.providers[key] = provider
 	pr.lastAccessed[key] = time.Now()
+	pr.versions[key] = version
 
 	return provider, nil
 }
@@ -98,6 +120,7 @@ func (pr *ProviderRegistry) InvalidateProvider(tenantID uint64, providerType int
 
 	delete(pr.providers, key)
 	delete(pr.lastAccessed, key)
+	delete(pr.versions, key)
 }
 
 // InvalidateAllForTenant removes all providers for a specific tenant
@@ -117,6 +140,7 @@ func (pr *ProviderRegistry) InvalidateAllForTenant(tenantID uint64) {
 	for _, key := range keysToDelete {
 		delete(pr.providers, key)
 		delete(pr.lastAccessed, key)
+		delete(pr.versions, key)
 	}
 }
 
@@ -227,6 +251,7 @@ func (pr *ProviderRegistry) performCleanup() {
 	for _, key := range keysToDelete {
 		delete(pr.providers, key)
 		delete(pr.lastAccessed, key)
+		delete(pr.versions, key)
 	}
 }
 
@@ -248,6 +273,7 @@ func (pr *ProviderRegistry) ForceCleanup() int {
 	for _, key := range keysToDelete {
 		delete(pr.providers, key)
 		delete(pr.lastAccessed, key)
+		delete(pr.versions, key)
 	}
 
 	return initialCount - len(pr.providers)
@@ -284,6 +310,7 @@ func (pr *ProviderRegistry) Shutdown() {
 	// Clear all providers
 	pr.providers = make(map[string]interfaces.OAuthAdapter)
 	pr.lastAccessed = make(map[string]time.Time)
+	pr.versions = make(map[string]time.Time)
 }
 
 // ProviderInfo represents information about a cached provider
@@ -0,0 +1,195 @@
+package saml
+
+import (
+	"bytes"
+	"compress/flate"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// maxRelayStateLength is the limit of the RelayState in the bindings specification
+const maxRelayStateLength = 80
+
+// timeFormat is the xs:dateTime format used in the messages
+const timeFormat = "2006-01-02T15:04:05Z"
+
+// ServiceProvider is the SP side of one IdP connection
+type ServiceProvider struct {
+	EntityID             string
+	ACSURL               string
+	KeyPair              *KeyPair // SP 签名密钥，未配置时无法签名 AuthnRequest
+	SignAuthnRequest     bool
+	WantAssertionsSigned bool
+	NameIDFormat         string
+	ClockSkew            time.Duration
+	IdP                  *IdentityProvider
+	Now                  func() time.Time
+}
+
+func (sp *ServiceProvider) now() time.Time {
+	if sp.Now != nil {
+		return sp.Now()
+	}
+	return time.Now()
+}
+
+// NewID returns a random message ID, which is a valid xs:ID
+func NewID() (string, error) {
+	b := make([]byte, 20)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return "_" + hex.EncodeToString(b), nil
+}
+
+// AuthnRequestURL creates an AuthnRequest and returns its HTTP-Redirect binding URL and ID,
+// the query string is signed when SignAuthnRequest is set
+func (sp *ServiceProvider) AuthnRequestURL(relayState string) (string, string, error) {
+	if sp.IdP == nil || sp.IdP.SSOURL == "" {
+		return "", "", errors.New("saml: the IdP has no single sign-on URL")
+	}
+	if len(relayState) > maxRelayStateLength {
+		return "", "", fmt.Errorf("saml: relay state exceeds %d bytes", maxRelayStateLength)
+	}
+	if sp.SignAuthnRequest && sp.KeyPair == nil {
+		return "", "", errors.New("saml: signing AuthnRequests requires the SP key pair")
+	}
+
+	id, err := NewID()
+	if err != nil {
+		return "", "", err
+	}
+
+	req := NewElement("samlp", "AuthnRequest", NamespaceProtocol, true)
+	req.Decls = append(req.Decls, NSDecl{Prefix: "saml", URI: NamespaceAssertion})
+	req.SetAttr("ID", id)
+	req.SetAttr("Version", "2.0")
+	req.SetAttr("IssueInstant", sp.now().UTC().Format(timeFormat))
+	req.SetAttr("Destination", sp.IdP.SSOURL)
+	req.SetAttr("AssertionConsumerServiceURL", sp.ACSURL)
+	req.SetAttr("ProtocolBinding", BindingHTTPPost)
+	req.AddChild(NewTextElement("saml", "Issuer", NamespaceAssertion, sp.EntityID))
+	if sp.NameIDFormat != "" {
+		policy := NewElement("samlp", "NameIDPolicy", NamespaceProtocol, false)
+		policy.SetAttr("Format", sp.NameIDFormat)
+		policy.SetAttr("AllowCreate", "true")
+		req.AddChild(policy)
+	}
+
+	var buf bytes.Buffer
+	w, err := flate.NewWriter(&buf, flate.BestCompression)
+	if err != nil {
+		return "", "", err
+	}
+	if _, err = w.Write(req.Bytes()); err != nil {
+		return "", "", err
+	}
+	if err = w.Close(); err != nil {
+		return "", "", err
+	}
+
+	// 签名覆盖的是按固定顺序拼接的已编码参数
+	query := "SAMLRequest=" + url.QueryEscape(base64.StdEncoding.EncodeToString(buf.Bytes()))
+	if relayState != "" {
+		query += "&RelayState=" + url.QueryEscape(relayState)
+	}
+	if sp.SignAuthnRequest {
+		alg, err := signatureAlgorithm(sp.KeyPair.Key)
+		if err != nil {
+			return "", "", err
+		}
+		query += "&SigAlg=" + url.QueryEscape(alg)
+		_, signature, err := signData(sp.KeyPair.Key, []byte(query))
+		if err != nil {
+			return "", "", err
+		}
+		query += "&Signature=" + url.QueryEscape(base64.StdEncoding.EncodeToString(signature))
+	}
+
+	sep := "?"
+	if strings.Contains(sp.IdP.SSOURL, "?") {
+		sep = "&"
+	}
+
+	return sp.IdP.SSOURL + sep + query, id, nil
+}
+
+// ParseRedirectRequest decodes a message received over the HTTP-Redirect binding and verifies
+// its signature with the certificates. Unsigned messages are only accepted when certs is empty.
+// It returns the message and the relay state.
+func ParseRedirectRequest(rawQuery string, certs []*x509.Certificate) (*Element, string, error) {
+	raw := map[string]string{}
+	for _, part := range strings.Split(rawQuery, "&") {
+		k, v, _ := strings.Cut(part, "=")
+		if _, ok := raw[k]; ok {
+			return nil, "", fmt.Errorf("saml: duplicate parameter %q", k)
+		}
+		raw[k] = v
+	}
+
+	param := func(k string) (string, error) {
+		return url.QueryUnescape(raw[k])
+	}
+
+	message, err := param("SAMLRequest")
+	if err != nil || message == "" {
+		return nil, "", errors.New("saml: missing SAMLRequest")
+	}
+	relayState, err := param("RelayState")
+	if err != nil {
+		return nil, "", err
+	}
+
+	if _, signed := raw["Signature"]; signed {
+		if len(certs) == 0 {
+			return nil, "", errors.New("saml: no certificate to verify the request signature")
+		}
+		alg, err := param("SigAlg")
+		if err != nil {
+			return nil, "", err
+		}
+		signature, err := param("Signature")
+		if err != nil {
+			return nil, "", err
+		}
+		value, err := decodeBase64(signature)
+		if err != nil {
+			return nil, "", fmt.Errorf("saml: invalid signature: %w", err)
+		}
+
+		signed := "SAMLRequest=" + raw["SAMLRequest"]
+		if _, ok := raw["RelayState"]; ok {
+			signed += "&RelayState=" + raw["RelayState"]
+		}
+		signed += "&SigAlg=" + raw["SigAlg"]
+		if err := verifySignatureValue(alg, []byte(signed), value, certs); err != nil {
+			return nil, "", err
+		}
+	} else if len(certs) > 0 {
+		return nil, "", errors.New("saml: request is not signed")
+	}
+
+	compressed, err := decodeBase64(message)
+	if err != nil {
+		return nil, "", fmt.Errorf("saml: invalid SAMLRequest: %w", err)
+	}
+	data, err := io.ReadAll(io.LimitReader(flate.NewReader(bytes.NewReader(compressed)), maxMessageSize))
+	if err != nil {
+		return nil, "", fmt.Errorf("saml: invalid SAMLRequest: %w", err)
+	}
+
+	el, err := ParseXML(data)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return el, relayState, nil
+}
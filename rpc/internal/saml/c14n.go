@@ -0,0 +1,107 @@
+package saml
+
+import (
+	"bytes"
+	"slices"
+	"strings"
+)
+
+// canonicalizer implements Exclusive XML Canonicalization 1.0 (https://www.w3.org/TR/xml-exc-c14n/)
+// for an element subtree, optionally leaving out one descendant (the enveloped signature)
+type canonicalizer struct {
+	withComments bool
+	// inclusive 为 InclusiveNamespaces PrefixList 中的前缀，按 Canonical XML 1.0 的规则输出，"#default" 表示默认命名空间
+	inclusive []string
+	exclude   *Element
+}
+
+// canonicalize returns the canonical form of the element subtree
+func (c *canonicalizer) canonicalize(e *Element) []byte {
+	var buf bytes.Buffer
+	c.write(&buf, e, map[string]string{})
+	return buf.Bytes()
+}
+
+func (c *canonicalizer) write(buf *bytes.Buffer, e *Element, rendered map[string]string) {
+	// 可见使用的前缀：元素自身的前缀以及带前缀属性的前缀
+	prefixes := []string{e.Prefix}
+	for _, a := range e.Attrs {
+		if a.Prefix != "" && a.Prefix != "xml" {
+			prefixes = append(prefixes, a.Prefix)
+		}
+	}
+	for _, p := range c.inclusive {
+		if p == "#default" {
+			p = ""
+		}
+		if _, ok := e.LookupNamespace(p); ok {
+			prefixes = append(prefixes, p)
+		}
+	}
+	slices.Sort(prefixes)
+	prefixes = slices.Compact(prefixes)
+
+	var decls []NSDecl
+	scope := rendered
+	for _, p := range prefixes {
+		uri, _ := e.LookupNamespace(p)
+		if prev, ok := rendered[p]; ok && prev == uri {
+			continue
+		} else if !ok && p == "" && uri == "" {
+			// 默认命名空间为空且祖先未输出过默认命名空间时无需 xmlns=""
+			continue
+		}
+		if len(decls) == 0 {
+			scope = make(map[string]string, len(rendered)+len(prefixes))
+			for k, v := range rendered {
+				scope[k] = v
+			}
+		}
+		scope[p] = uri
+		decls = append(decls, NSDecl{Prefix: p, URI: uri})
+	}
+
+	attrs := slices.Clone(e.Attrs)
+	slices.SortFunc(attrs, func(a, b Attr) int {
+		if n := strings.Compare(a.Space, b.Space); n != 0 {
+			return n
+		}
+		return strings.Compare(a.Local, b.Local)
+	})
+
+	name := qualifiedName(e.Prefix, e.Local)
+	buf.WriteByte('<')
+	buf.WriteString(name)
+	for _, d := range decls {
+		if d.Prefix == "" {
+			buf.WriteString(` xmlns="`)
+		} else {
+			buf.WriteString(` xmlns:` + d.Prefix + `="`)
+		}
+		escapeAttr(buf, d.URI)
+		buf.WriteByte('"')
+	}
+	for _, a := range attrs {
+		buf.WriteString(" " + qualifiedName(a.Prefix, a.Local) + `="`)
+		escapeAttr(buf, a.Value)
+		buf.WriteByte('"')
+	}
+	buf.WriteByte('>')
+
+	for _, child := range e.Children {
+		switch v := child.(type) {
+		case *Element:
+			if v != c.exclude {
+				c.write(buf, v, scope)
+			}
+		case CharData:
+			escapeText(buf, string(v))
+		case Comment:
+			if c.withComments {
+				buf.WriteString("<!--" + string(v) + "-->")
+			}
+		}
+	}
+
+	buf.WriteString("</" + name + ">")
+}
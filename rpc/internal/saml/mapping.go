@@ -0,0 +1,211 @@
+package saml
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+
+	"github.com/coder-lulu/newbee-core/rpc/internal/interfaces"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/claimmap"
+)
+
+// NameIDClaim is the claim under which the NameID is available to the mapping rules
+const NameIDClaim = "name_id"
+
+// Mapping config keys
+const (
+	mappingClaims        = claimmap.ConfigKey
+	mappingDepartment    = "department"
+	mappingDepartmentMap = "department_map"
+	mappingRoleMap       = "role_map"
+	mappingEmailVerified = "email_verified"
+)
+
+// defaultClaimRules 覆盖 LDAP 属性名、OID 形式以及 ADFS/Azure AD 的声明 URI
+var defaultClaimRules = map[string][]any{
+	claimmap.FieldID:       {NameIDClaim},
+	claimmap.FieldUsername: {"uid", "username", "['urn:oid:0.9.2342.19200300.100.1.1']", "['http://schemas.xmlsoap.org/ws/2005/05/identity/claims/name']", NameIDClaim},
+	claimmap.FieldNickname: {"displayName", "cn", "['urn:oid:2.16.840.1.113730.3.1.241']", "['http://schemas.microsoft.com/identity/claims/displayname']"},
+	claimmap.FieldEmail:    {"email", "mail", "['urn:oid:0.9.2342.19200300.100.1.3']", "['http://schemas.xmlsoap.org/ws/2005/05/identity/claims/emailaddress']"},
+	claimmap.FieldPhone:    {"mobile", "telephoneNumber", "phone", "['urn:oid:0.9.2342.19200300.100.1.41']"},
+	claimmap.FieldAvatar:   {"avatar", "picture"},
+	claimmap.FieldGroups:   {"groups", "memberOf", "Role", "['http://schemas.microsoft.com/ws/2008/06/identity/claims/groups']", "['http://schemas.microsoft.com/ws/2008/06/identity/claims/role']"},
+}
+
+var defaultDepartmentRule = []any{"department", "departmentNumber", "['urn:oid:2.16.840.1.113730.3.1.2']"}
+
+// AttributeMapping maps the assertion of a provider to a user, a department and roles.
+// It is configured in SamlProvider.attribute_mapping:
+//
+//	{
+//	  "claim_mapping":  {"username": "uid", "email": {"path": "mail", "transform": "lower"}, "groups": "memberOf"},
+//	  "department":     "ou",
+//	  "department_map": {"R&D": 3, "Sales": 5},
+//	  "role_map":       {"cn=admins,ou=groups,dc=example,dc=com": ["admin"], "staff": "member"},
+//	  "email_verified": "emailVerified"
+//	}
+//
+// The rules follow the claimmap syntax, paths are attribute names and the NameID is available
+// as "name_id"; attribute names containing "." must be quoted like ['urn:oid:0.9.2342.19200300.100.1.3'].
+// Department values missing from department_map are matched by department name, groups missing
+// from role_map grant no roles. email_verified is optional, when configured the attribute must be
+// "true" or "1" before the first login can be linked to an existing user by email.
+type AttributeMapping struct {
+	claims        *claimmap.Mapping
+	department    *claimmap.Rule
+	departmentMap map[string]uint64
+	roleMap       map[string][]string
+	emailVerified *claimmap.Rule
+}
+
+// MappedUser is the user described by an assertion
+type MappedUser struct {
+	Info *interfaces.OAuthUserInfo
+	// DepartmentID 为 0 时按 Department 名称查找部门
+	DepartmentID uint64
+	Department   string
+	RoleCodes    []string
+	// EmailVerified 为 nil 表示未配置 email_verified 规则
+	EmailVerified *bool
+}
+
+// ParseAttributeMapping parses the attribute mapping of a provider, nil gives the default mapping
+func ParseAttributeMapping(config map[string]any) (*AttributeMapping, error) {
+	for k := range config {
+		switch k {
+		case mappingClaims, mappingDepartment, mappingDepartmentMap, mappingRoleMap, mappingEmailVerified:
+		default:
+			return nil, fmt.Errorf("unknown key %q in the attribute mapping", k)
+		}
+	}
+
+	// 用户配置的规则覆盖 SAML 默认规则，未配置的字段保留默认规则
+	rules := make(map[string]any, len(defaultClaimRules))
+	for field, paths := range defaultClaimRules {
+		rules[field] = paths
+	}
+	if raw, ok := config[mappingClaims]; ok && raw != nil {
+		custom, ok := raw.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("%s must be an object", mappingClaims)
+		}
+		for field, rule := range custom {
+			rules[field] = rule
+		}
+	}
+	claims, err := claimmap.FromExtraConfig(map[string]any{claimmap.ConfigKey: rules})
+	if err != nil {
+		return nil, err
+	}
+
+	m := &AttributeMapping{
+		claims:        claims,
+		departmentMap: map[string]uint64{},
+		roleMap:       map[string][]string{},
+	}
+
+	var department any = defaultDepartmentRule
+	if raw, ok := config[mappingDepartment]; ok && raw != nil {
+		department = raw
+	}
+	if m.department, err = claimmap.ParseRule(department); err != nil {
+		return nil, fmt.Errorf("invalid %s rule: %w", mappingDepartment, err)
+	}
+
+	if raw, ok := config[mappingEmailVerified]; ok && raw != nil {
+		if m.emailVerified, err = claimmap.ParseRule(raw); err != nil {
+			return nil, fmt.Errorf("invalid %s rule: %w", mappingEmailVerified, err)
+		}
+	}
+
+	if raw, ok := config[mappingDepartmentMap]; ok && raw != nil {
+		values, ok := raw.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("%s must be an object", mappingDepartmentMap)
+		}
+		for name, v := range values {
+			id, ok := toID(v)
+			if !ok {
+				return nil, fmt.Errorf("%s: department ID of %q must be a positive integer", mappingDepartmentMap, name)
+			}
+			m.departmentMap[name] = id
+		}
+	}
+
+	if raw, ok := config[mappingRoleMap]; ok && raw != nil {
+		values, ok := raw.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("%s must be an object", mappingRoleMap)
+		}
+		for group, v := range values {
+			switch codes := v.(type) {
+			case string:
+				m.roleMap[group] = []string{codes}
+			case []any:
+				for _, c := range codes {
+					code, ok := c.(string)
+					if !ok {
+						return nil, fmt.Errorf("%s: roles of %q must be role codes", mappingRoleMap, group)
+					}
+					m.roleMap[group] = append(m.roleMap[group], code)
+				}
+			default:
+				return nil, fmt.Errorf("%s: roles of %q must be a role code or a list of role codes", mappingRoleMap, group)
+			}
+		}
+	}
+
+	return m, nil
+}
+
+// Map maps a validated assertion
+func (m *AttributeMapping) Map(a *Assertion) *MappedUser {
+	claims := make(map[string]any, len(a.Attributes)+1)
+	for name, values := range a.Attributes {
+		list := make([]any, len(values))
+		for i, v := range values {
+			list[i] = v
+		}
+		claims[name] = list
+	}
+	claims[NameIDClaim] = a.NameID
+
+	u := &MappedUser{Info: m.claims.MapClaims(claims)}
+	u.Info.ProviderType = interfaces.ProviderTypeSAML
+
+	if values := m.department.Values(claims); len(values) > 0 {
+		u.Department = values[0]
+		u.DepartmentID = m.departmentMap[values[0]]
+	}
+
+	if m.emailVerified != nil {
+		values := m.emailVerified.Values(claims)
+		verified := len(values) > 0 && (strings.EqualFold(values[0], "true") || values[0] == "1")
+		u.EmailVerified = &verified
+	}
+
+	for _, group := range u.Info.Groups {
+		u.RoleCodes = append(u.RoleCodes, m.roleMap[group]...)
+	}
+	slices.Sort(u.RoleCodes)
+	u.RoleCodes = slices.Compact(u.RoleCodes)
+
+	return u
+}
+
+func toID(v any) (uint64, bool) {
+	switch n := v.(type) {
+	case float64:
+		if n > 0 && n == math.Trunc(n) {
+			return uint64(n), true
+		}
+	case json.Number:
+		id, err := n.Int64()
+		if err == nil && id > 0 {
+			return uint64(id), true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,222 @@
+package saml
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+)
+
+// IdentityProvider is the IdP side of a connection, usually imported from its metadata
+type IdentityProvider struct {
+	EntityID      string
+	SSOURL        string // HTTP-Redirect 绑定的单点登录地址
+	SLOURL        string
+	Certs         []*x509.Certificate
+	NameIDFormats []string
+}
+
+// ParseIdPMetadata parses the metadata of an IdP. When the metadata is an EntitiesDescriptor,
+// entityID selects the IdP, it may be empty if the document only describes one IdP.
+func ParseIdPMetadata(data []byte, entityID string) (*IdentityProvider, error) {
+	root, err := ParseXML(data)
+	if err != nil {
+		return nil, err
+	}
+
+	var candidates []*Element
+	var collect func(el *Element) error
+	collect = func(el *Element) error {
+		if err := checkValidUntil(el); err != nil {
+			return err
+		}
+		switch {
+		case el.Is(NamespaceMetadata, "EntitiesDescriptor"):
+			for _, child := range el.ChildElements(NamespaceMetadata, "EntitiesDescriptor") {
+				if err := collect(child); err != nil {
+					return err
+				}
+			}
+			for _, child := range el.ChildElements(NamespaceMetadata, "EntityDescriptor") {
+				if err := collect(child); err != nil {
+					return err
+				}
+			}
+		case el.Is(NamespaceMetadata, "EntityDescriptor"):
+			if el.Child(NamespaceMetadata, "IDPSSODescriptor") != nil &&
+				(entityID == "" || el.AttrValue("entityID") == entityID) {
+				candidates = append(candidates, el)
+			}
+		}
+		return nil
+	}
+	if err := collect(root); err != nil {
+		return nil, err
+	}
+
+	switch {
+	case len(candidates) == 0:
+		return nil, errors.New("saml: no matching IdP in the metadata")
+	case len(candidates) > 1:
+		return nil, errors.New("saml: the metadata describes several IdPs, the entity ID is required")
+	}
+
+	entity := candidates[0]
+	idp := &IdentityProvider{EntityID: entity.AttrValue("entityID")}
+	if idp.EntityID == "" {
+		return nil, errors.New("saml: the IdP has no entity ID")
+	}
+
+	for _, desc := range entity.ChildElements(NamespaceMetadata, "IDPSSODescriptor") {
+		if !slices.Contains(strings.Fields(desc.AttrValue("protocolSupportEnumeration")), NamespaceProtocol) {
+			continue
+		}
+		if err := checkValidUntil(desc); err != nil {
+			return nil, err
+		}
+
+		for _, sso := range desc.ChildElements(NamespaceMetadata, "SingleSignOnService") {
+			if sso.AttrValue("Binding") == BindingHTTPRedirect && idp.SSOURL == "" {
+				idp.SSOURL = sso.AttrValue("Location")
+			}
+		}
+		for _, slo := range desc.ChildElements(NamespaceMetadata, "SingleLogoutService") {
+			if slo.AttrValue("Binding") == BindingHTTPRedirect && idp.SLOURL == "" {
+				idp.SLOURL = slo.AttrValue("Location")
+			}
+		}
+		for _, kd := range desc.ChildElements(NamespaceMetadata, "KeyDescriptor") {
+			if use := kd.AttrValue("use"); use != "" && use != "signing" {
+				continue
+			}
+			keyInfo := kd.Child(NamespaceDSig, "KeyInfo")
+			if keyInfo == nil {
+				continue
+			}
+			for _, data := range keyInfo.ChildElements(NamespaceDSig, "X509Data") {
+				for _, c := range data.ChildElements(NamespaceDSig, "X509Certificate") {
+					der, err := decodeBase64(c.Text())
+					if err != nil {
+						return nil, fmt.Errorf("saml: invalid certificate in metadata: %w", err)
+					}
+					cert, err := x509.ParseCertificate(der)
+					if err != nil {
+						return nil, fmt.Errorf("saml: invalid certificate in metadata: %w", err)
+					}
+					idp.Certs = append(idp.Certs, cert)
+				}
+			}
+		}
+		for _, f := range desc.ChildElements(NamespaceMetadata, "NameIDFormat") {
+			idp.NameIDFormats = append(idp.NameIDFormats, strings.TrimSpace(f.Text()))
+		}
+		break
+	}
+
+	if idp.SSOURL == "" {
+		return nil, errors.New("saml: the IdP has no HTTP-Redirect single sign-on service")
+	}
+	if len(idp.Certs) == 0 {
+		return nil, errors.New("saml: the IdP has no signing certificate")
+	}
+
+	return idp, nil
+}
+
+func checkValidUntil(el *Element) error {
+	v, ok := el.Attr("validUntil")
+	if !ok {
+		return nil
+	}
+	t, err := time.Parse(time.RFC3339Nano, v)
+	if err != nil {
+		return fmt.Errorf("saml: invalid validUntil %q", v)
+	}
+	if time.Now().After(t) {
+		return errors.New("saml: the metadata has expired")
+	}
+	return nil
+}
+
+// FetchMetadata downloads metadata over HTTPS, plain HTTP is only allowed for loopback hosts
+func FetchMetadata(ctx context.Context, rawURL string) ([]byte, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("saml: invalid metadata URL: %w", err)
+	}
+	if u.Scheme != "https" && !(u.Scheme == "http" && isLoopback(u.Hostname())) {
+		return nil, errors.New("saml: the metadata URL must use https")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("saml: failed to fetch metadata: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("saml: failed to fetch metadata: status %d", resp.StatusCode)
+	}
+
+	return io.ReadAll(io.LimitReader(resp.Body, maxMessageSize))
+}
+
+func isLoopback(host string) bool {
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
+// Metadata returns the SP metadata to be imported into the IdP
+func (sp *ServiceProvider) Metadata() []byte {
+	root := NewElement("md", "EntityDescriptor", NamespaceMetadata, true)
+	root.SetAttr("entityID", sp.EntityID)
+
+	desc := NewElement("md", "SPSSODescriptor", NamespaceMetadata, false)
+	desc.SetAttr("AuthnRequestsSigned", fmt.Sprint(sp.SignAuthnRequest && sp.KeyPair != nil))
+	desc.SetAttr("WantAssertionsSigned", fmt.Sprint(sp.WantAssertionsSigned))
+	desc.SetAttr("protocolSupportEnumeration", NamespaceProtocol)
+
+	if sp.KeyPair != nil {
+		kd := NewElement("md", "KeyDescriptor", NamespaceMetadata, false)
+		kd.SetAttr("use", "signing")
+		keyInfo := NewElement("ds", "KeyInfo", NamespaceDSig, true)
+		x509Data := NewElement("ds", "X509Data", NamespaceDSig, false)
+		x509Data.AddChild(NewTextElement("ds", "X509Certificate", NamespaceDSig, base64.StdEncoding.EncodeToString(sp.KeyPair.Cert.Raw)))
+		keyInfo.AddChild(x509Data)
+		kd.AddChild(keyInfo)
+		desc.AddChild(kd)
+	}
+
+	if sp.NameIDFormat != "" {
+		desc.AddChild(NewTextElement("md", "NameIDFormat", NamespaceMetadata, sp.NameIDFormat))
+	}
+
+	acs := NewElement("md", "AssertionConsumerService", NamespaceMetadata, false)
+	acs.SetAttr("Binding", BindingHTTPPost)
+	acs.SetAttr("Location", sp.ACSURL)
+	acs.SetAttr("index", "0")
+	acs.SetAttr("isDefault", "true")
+	desc.AddChild(acs)
+
+	root.AddChild(desc)
+
+	return append([]byte(`<?xml version="1.0" encoding="UTF-8"?>`+"\n"), root.Bytes()...)
+}
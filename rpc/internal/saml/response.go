@@ -0,0 +1,337 @@
+package saml
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// maxMessageSize limits the size of the decoded messages and metadata
+const maxMessageSize = 1 << 20
+
+// Assertion is the validated content of a SAML response
+type Assertion struct {
+	ID           string
+	InResponseTo string
+	Issuer       string
+	NameID       string
+	NameIDFormat string
+	SessionIndex string
+	// NotOnOrAfter 断言失效时间，用作防重放记录的有效期
+	NotOnOrAfter time.Time
+	// Attributes 按属性 Name 索引，同时以 FriendlyName 作为别名
+	Attributes map[string][]string
+}
+
+// ParseResponse decodes the base64 SAMLResponse received on the ACS over the HTTP-POST binding
+// and validates the signatures, issuer, destination, audience, subject confirmation and validity
+// period. Unsolicited (IdP initiated) responses are rejected, the caller must check InResponseTo
+// against the pending requests and record the assertion ID to prevent replays.
+func (sp *ServiceProvider) ParseResponse(encoded string) (*Assertion, error) {
+	if len(encoded) > maxMessageSize {
+		return nil, errors.New("saml: response is too large")
+	}
+	data, err := decodeBase64(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("saml: invalid SAMLResponse encoding: %w", err)
+	}
+
+	root, err := ParseXML(data)
+	if err != nil {
+		return nil, err
+	}
+	if !root.Is(NamespaceProtocol, "Response") {
+		return nil, errors.New("saml: not a SAML response")
+	}
+	if root.AttrValue("Version") != "2.0" {
+		return nil, errors.New("saml: unsupported SAML version")
+	}
+
+	// ID 重复时签名校验的元素可能被替换为未签名的副本（XML 签名包装攻击）
+	seen := map[string]bool{}
+	duplicated := false
+	root.walk(func(el *Element) {
+		if id, ok := el.Attr("ID"); ok {
+			duplicated = duplicated || seen[id]
+			seen[id] = true
+		}
+	})
+	if duplicated {
+		return nil, errors.New("saml: duplicate IDs in the response")
+	}
+
+	if err := checkStatus(root); err != nil {
+		return nil, err
+	}
+	if d, ok := root.Attr("Destination"); ok && d != sp.ACSURL {
+		return nil, fmt.Errorf("saml: response destination %q does not match the ACS URL", d)
+	}
+	if issuer := root.Child(NamespaceAssertion, "Issuer"); issuer != nil && strings.TrimSpace(issuer.Text()) != sp.IdP.EntityID {
+		return nil, errors.New("saml: response issuer does not match the IdP")
+	}
+
+	if root.Child(NamespaceAssertion, "EncryptedAssertion") != nil {
+		return nil, ErrEncryptedAssertion
+	}
+	assertions := root.ChildElements(NamespaceAssertion, "Assertion")
+	if len(assertions) != 1 {
+		return nil, errors.New("saml: response must contain exactly one assertion")
+	}
+	el := assertions[0]
+
+	responseSigned, err := sp.verify(root)
+	if err != nil {
+		return nil, err
+	}
+	assertionSigned, err := sp.verify(el)
+	if err != nil {
+		return nil, err
+	}
+	if !responseSigned && !assertionSigned {
+		return nil, ErrUnsignedResponse
+	}
+	if sp.WantAssertionsSigned && !assertionSigned {
+		return nil, errors.New("saml: assertion is not signed")
+	}
+
+	return sp.parseAssertion(el, root.AttrValue("InResponseTo"))
+}
+
+func (sp *ServiceProvider) verify(el *Element) (bool, error) {
+	err := verifyEnveloped(el, sp.IdP.Certs)
+	switch {
+	case err == nil:
+		return true, nil
+	case errors.Is(err, errNoSignature):
+		return false, nil
+	default:
+		return false, err
+	}
+}
+
+func checkStatus(root *Element) error {
+	status := root.Child(NamespaceProtocol, "Status")
+	if status == nil {
+		return errors.New("saml: response has no status")
+	}
+	code := status.Child(NamespaceProtocol, "StatusCode")
+	if code == nil {
+		return errors.New("saml: response has no status code")
+	}
+	if code.AttrValue("Value") == StatusSuccess {
+		return nil
+	}
+
+	detail := code.AttrValue("Value")
+	if sub := code.Child(NamespaceProtocol, "StatusCode"); sub != nil {
+		detail += " / " + sub.AttrValue("Value")
+	}
+	if msg := status.Child(NamespaceProtocol, "StatusMessage"); msg != nil {
+		detail += ": " + strings.TrimSpace(msg.Text())
+	}
+	return fmt.Errorf("saml: authentication failed at the IdP (%s)", detail)
+}
+
+func (sp *ServiceProvider) parseAssertion(el *Element, inResponseTo string) (*Assertion, error) {
+	now := sp.now()
+	skew := sp.ClockSkew
+
+	a := &Assertion{
+		ID:           el.AttrValue("ID"),
+		InResponseTo: inResponseTo,
+		Attributes:   map[string][]string{},
+	}
+	if a.ID == "" {
+		return nil, errors.New("saml: assertion has no ID")
+	}
+	if el.AttrValue("Version") != "2.0" {
+		return nil, errors.New("saml: unsupported assertion version")
+	}
+
+	issuer := el.Child(NamespaceAssertion, "Issuer")
+	if issuer == nil || strings.TrimSpace(issuer.Text()) != sp.IdP.EntityID {
+		return nil, errors.New("saml: assertion issuer does not match the IdP")
+	}
+	a.Issuer = sp.IdP.EntityID
+
+	issueInstant, err := parseTime(el, "IssueInstant")
+	if err != nil || issueInstant.IsZero() {
+		return nil, errors.New("saml: assertion has no valid IssueInstant")
+	}
+	if issueInstant.After(now.Add(skew)) {
+		return nil, errors.New("saml: assertion is issued in the future")
+	}
+
+	// Subject
+	subject := el.Child(NamespaceAssertion, "Subject")
+	if subject == nil {
+		return nil, errors.New("saml: assertion has no subject")
+	}
+	if subject.Child(NamespaceAssertion, "EncryptedID") != nil {
+		return nil, ErrEncryptedAssertion
+	}
+	nameID := subject.Child(NamespaceAssertion, "NameID")
+	if nameID == nil || strings.TrimSpace(nameID.Text()) == "" {
+		return nil, errors.New("saml: assertion has no NameID")
+	}
+	a.NameID = strings.TrimSpace(nameID.Text())
+	a.NameIDFormat = nameID.AttrValue("Format")
+
+	confirmed := false
+	lastErr := errors.New("saml: assertion has no bearer subject confirmation")
+	for _, sc := range subject.ChildElements(NamespaceAssertion, "SubjectConfirmation") {
+		if sc.AttrValue("Method") != ConfirmationBearer {
+			continue
+		}
+		notOnOrAfter, err := sp.checkConfirmation(sc, a, now)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		a.NotOnOrAfter = notOnOrAfter
+		confirmed = true
+		break
+	}
+	if !confirmed {
+		return nil, lastErr
+	}
+	if a.InResponseTo == "" {
+		return nil, errors.New("saml: unsolicited responses are not supported")
+	}
+
+	// Conditions
+	conditions := el.Child(NamespaceAssertion, "Conditions")
+	if conditions == nil {
+		return nil, errors.New("saml: assertion has no conditions")
+	}
+	notBefore, err := parseTime(conditions, "NotBefore")
+	if err != nil {
+		return nil, err
+	}
+	if !notBefore.IsZero() && now.Add(skew).Before(notBefore) {
+		return nil, errors.New("saml: assertion is not yet valid")
+	}
+	notOnOrAfter, err := parseTime(conditions, "NotOnOrAfter")
+	if err != nil {
+		return nil, err
+	}
+	if !notOnOrAfter.IsZero() {
+		if !now.Add(-skew).Before(notOnOrAfter) {
+			return nil, errors.New("saml: assertion has expired")
+		}
+		if notOnOrAfter.Before(a.NotOnOrAfter) {
+			a.NotOnOrAfter = notOnOrAfter
+		}
+	}
+
+	restrictions := conditions.ChildElements(NamespaceAssertion, "AudienceRestriction")
+	if len(restrictions) == 0 {
+		return nil, errors.New("saml: assertion has no audience restriction")
+	}
+	// 多个 AudienceRestriction 需要同时满足
+	for _, r := range restrictions {
+		matched := false
+		for _, audience := range r.ChildElements(NamespaceAssertion, "Audience") {
+			if strings.TrimSpace(audience.Text()) == sp.EntityID {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return nil, errors.New("saml: assertion is not intended for this service provider")
+		}
+	}
+
+	// AuthnStatement
+	statement := el.Child(NamespaceAssertion, "AuthnStatement")
+	if statement == nil {
+		return nil, errors.New("saml: assertion has no authentication statement")
+	}
+	a.SessionIndex = statement.AttrValue("SessionIndex")
+	sessionNotOnOrAfter, err := parseTime(statement, "SessionNotOnOrAfter")
+	if err != nil {
+		return nil, err
+	}
+	if !sessionNotOnOrAfter.IsZero() && !now.Add(-skew).Before(sessionNotOnOrAfter) {
+		return nil, errors.New("saml: the IdP session has expired")
+	}
+
+	// Attributes
+	aliases := map[string][]string{}
+	for _, st := range el.ChildElements(NamespaceAssertion, "AttributeStatement") {
+		for _, attr := range st.ChildElements(NamespaceAssertion, "Attribute") {
+			name := attr.AttrValue("Name")
+			if name == "" {
+				continue
+			}
+			var values []string
+			for _, v := range attr.ChildElements(NamespaceAssertion, "AttributeValue") {
+				values = append(values, strings.TrimSpace(v.Text()))
+			}
+			a.Attributes[name] = append(a.Attributes[name], values...)
+			if friendly := attr.AttrValue("FriendlyName"); friendly != "" && friendly != name {
+				aliases[friendly] = append(aliases[friendly], values...)
+			}
+		}
+	}
+	for name, values := range aliases {
+		if _, ok := a.Attributes[name]; !ok {
+			a.Attributes[name] = values
+		}
+	}
+
+	return a, nil
+}
+
+// checkConfirmation validates a bearer SubjectConfirmation and returns its NotOnOrAfter
+func (sp *ServiceProvider) checkConfirmation(sc *Element, a *Assertion, now time.Time) (time.Time, error) {
+	data := sc.Child(NamespaceAssertion, "SubjectConfirmationData")
+	if data == nil {
+		return time.Time{}, errors.New("saml: bearer confirmation has no data")
+	}
+	if data.AttrValue("Recipient") != sp.ACSURL {
+		return time.Time{}, errors.New("saml: bearer confirmation recipient does not match the ACS URL")
+	}
+
+	notOnOrAfter, err := parseTime(data, "NotOnOrAfter")
+	if err != nil {
+		return time.Time{}, err
+	}
+	if notOnOrAfter.IsZero() {
+		return time.Time{}, errors.New("saml: bearer confirmation has no NotOnOrAfter")
+	}
+	if !now.Add(-sp.ClockSkew).Before(notOnOrAfter) {
+		return time.Time{}, errors.New("saml: bearer confirmation has expired")
+	}
+	notBefore, err := parseTime(data, "NotBefore")
+	if err != nil {
+		return time.Time{}, err
+	}
+	if !notBefore.IsZero() && now.Add(sp.ClockSkew).Before(notBefore) {
+		return time.Time{}, errors.New("saml: bearer confirmation is not yet valid")
+	}
+
+	if id := data.AttrValue("InResponseTo"); id != "" {
+		if a.InResponseTo == "" {
+			a.InResponseTo = id
+		} else if id != a.InResponseTo {
+			return time.Time{}, errors.New("saml: bearer confirmation does not match the request")
+		}
+	}
+
+	return notOnOrAfter, nil
+}
+
+// parseTime parses an optional xs:dateTime attribute, a missing attribute gives the zero time
+func parseTime(el *Element, attr string) (time.Time, error) {
+	v, ok := el.Attr(attr)
+	if !ok {
+		return time.Time{}, nil
+	}
+	t, err := time.Parse(time.RFC3339Nano, v)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("saml: invalid %s %q", attr, v)
+	}
+	return t, nil
+}
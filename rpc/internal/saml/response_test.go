@@ -0,0 +1,515 @@
+package saml_test
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+
+	"github.com/coder-lulu/newbee-core/rpc/internal/saml"
+	"github.com/coder-lulu/newbee-core/rpc/internal/saml/samltest"
+)
+
+const (
+	spEntityID = "https://sp.example.com/saml/metadata"
+	spACSURL   = "https://sp.example.com/saml/acs"
+)
+
+func newProvider(t *testing.T) (*samltest.IdP, *saml.ServiceProvider) {
+	t.Helper()
+
+	idp, err := samltest.New()
+	if err != nil {
+		t.Fatalf("start IdP: %v", err)
+	}
+	t.Cleanup(idp.Close)
+
+	md, err := saml.ParseIdPMetadata(idp.Metadata(), "")
+	if err != nil {
+		t.Fatalf("parse IdP metadata: %v", err)
+	}
+
+	return idp, &saml.ServiceProvider{
+		EntityID:             spEntityID,
+		ACSURL:               spACSURL,
+		WantAssertionsSigned: true,
+		ClockSkew:            time.Minute,
+		IdP:                  md,
+	}
+}
+
+// login sends an AuthnRequest to the IdP and returns the encoded response
+func login(t *testing.T, idp *samltest.IdP, sp *saml.ServiceProvider, user samltest.User) (string, string) {
+	t.Helper()
+
+	redirectURL, requestID, err := sp.AuthnRequestURL("state")
+	if err != nil {
+		t.Fatalf("create AuthnRequest: %v", err)
+	}
+	req, resp, err := idp.Login(redirectURL, user)
+	if err != nil {
+		t.Fatalf("IdP login: %v", err)
+	}
+	if req.ID != requestID {
+		t.Fatalf("IdP answered request %q, want %q", req.ID, requestID)
+	}
+
+	return requestID, resp
+}
+
+// response issues a response for a pending request, opts are completed with the SP settings
+func response(t *testing.T, idp *samltest.IdP, opts samltest.ResponseOptions) string {
+	t.Helper()
+
+	if opts.SPEntityID == "" {
+		opts.SPEntityID = spEntityID
+	}
+	if opts.ACSURL == "" {
+		opts.ACSURL = spACSURL
+	}
+	if opts.InResponseTo == "" {
+		opts.InResponseTo = "_request"
+	}
+	if opts.User.NameID == "" {
+		opts.User = samltest.User{NameID: "alice", NameIDFormat: saml.NameIDFormatPersistent}
+	}
+
+	resp, err := idp.Response(opts)
+	if err != nil {
+		t.Fatalf("issue response: %v", err)
+	}
+	return resp
+}
+
+// tamper changes a signed response, the signature is not recomputed
+func tamper(t *testing.T, encoded string, fn func(resp *saml.Element)) string {
+	t.Helper()
+
+	data, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		t.Fatalf("decode response: %v", err)
+	}
+	root, err := saml.ParseXML(data)
+	if err != nil {
+		t.Fatalf("parse response: %v", err)
+	}
+	fn(root)
+
+	return base64.StdEncoding.EncodeToString(root.Bytes())
+}
+
+// cloneAssertion returns an independent copy of the assertion of the response
+func cloneAssertion(t *testing.T, encoded string) *saml.Element {
+	t.Helper()
+
+	var assertion *saml.Element
+	tamper(t, encoded, func(resp *saml.Element) {
+		assertion = resp.Child(saml.NamespaceAssertion, "Assertion")
+	})
+	assertion.Parent.RemoveChild(assertion)
+	return assertion
+}
+
+func nameID(assertion *saml.Element) *saml.Element {
+	return assertion.Child(saml.NamespaceAssertion, "Subject").Child(saml.NamespaceAssertion, "NameID")
+}
+
+func expectError(t *testing.T, sp *saml.ServiceProvider, encoded, contains string) {
+	t.Helper()
+
+	a, err := sp.ParseResponse(encoded)
+	if err == nil {
+		t.Fatalf("response accepted for %q, want an error containing %q", a.NameID, contains)
+	}
+	if !strings.Contains(err.Error(), contains) {
+		t.Fatalf("error %q does not contain %q", err, contains)
+	}
+}
+
+func TestParseResponseValid(t *testing.T) {
+	idp, sp := newProvider(t)
+
+	requestID, resp := login(t, idp, sp, samltest.User{
+		NameID:       "alice",
+		NameIDFormat: saml.NameIDFormatPersistent,
+		Attributes:   map[string][]string{"email": {"alice@example.com"}, "groups": {"dev", "ops"}},
+	})
+
+	a, err := sp.ParseResponse(resp)
+	if err != nil {
+		t.Fatalf("ParseResponse: %v", err)
+	}
+	if a.NameID != "alice" || a.NameIDFormat != saml.NameIDFormatPersistent {
+		t.Errorf("NameID = %q (%q), want alice", a.NameID, a.NameIDFormat)
+	}
+	if a.InResponseTo != requestID {
+		t.Errorf("InResponseTo = %q, want %q", a.InResponseTo, requestID)
+	}
+	if a.Issuer != idp.EntityID {
+		t.Errorf("Issuer = %q, want %q", a.Issuer, idp.EntityID)
+	}
+	if got := a.Attributes["groups"]; len(got) != 2 || got[0] != "dev" || got[1] != "ops" {
+		t.Errorf("groups = %v, want [dev ops]", got)
+	}
+	if a.NotOnOrAfter.IsZero() {
+		t.Error("NotOnOrAfter is not set")
+	}
+}
+
+func TestParseResponseSignedResponse(t *testing.T) {
+	idp, sp := newProvider(t)
+	sp.WantAssertionsSigned = false
+
+	resp := response(t, idp, samltest.ResponseOptions{SignResponse: true, SkipAssertionSignature: true})
+	if _, err := sp.ParseResponse(resp); err != nil {
+		t.Fatalf("ParseResponse: %v", err)
+	}
+
+	// 只签名 Response 时，断言的内容同样受保护
+	tampered := tamper(t, resp, func(root *saml.Element) {
+		nameID(root.Child(saml.NamespaceAssertion, "Assertion")).Children = []saml.Node{saml.CharData("admin")}
+	})
+	if _, err := sp.ParseResponse(tampered); !errors.Is(err, saml.ErrInvalidSignature) {
+		t.Fatalf("err = %v, want ErrInvalidSignature", err)
+	}
+}
+
+func TestParseResponseUnsigned(t *testing.T) {
+	idp, sp := newProvider(t)
+	sp.WantAssertionsSigned = false
+
+	resp := response(t, idp, samltest.ResponseOptions{SkipAssertionSignature: true})
+	if _, err := sp.ParseResponse(resp); !errors.Is(err, saml.ErrUnsignedResponse) {
+		t.Fatalf("err = %v, want ErrUnsignedResponse", err)
+	}
+
+	sp.WantAssertionsSigned = true
+	resp = response(t, idp, samltest.ResponseOptions{SignResponse: true, SkipAssertionSignature: true})
+	expectError(t, sp, resp, "assertion is not signed")
+}
+
+func TestParseResponseUntrustedCertificate(t *testing.T) {
+	_, sp := newProvider(t)
+	other, err := samltest.New()
+	if err != nil {
+		t.Fatalf("start IdP: %v", err)
+	}
+	defer other.Close()
+	other.EntityID = sp.IdP.EntityID
+
+	resp := response(t, other, samltest.ResponseOptions{})
+	if _, err := sp.ParseResponse(resp); !errors.Is(err, saml.ErrInvalidSignature) {
+		t.Fatalf("err = %v, want ErrInvalidSignature", err)
+	}
+}
+
+func TestParseResponseTamperedAssertion(t *testing.T) {
+	idp, sp := newProvider(t)
+
+	tests := []struct {
+		name   string
+		modify func(assertion *saml.Element)
+	}{
+		{
+			name: "name id",
+			modify: func(assertion *saml.Element) {
+				nameID(assertion).Children = []saml.Node{saml.CharData("admin")}
+			},
+		},
+		{
+			name: "attribute",
+			modify: func(assertion *saml.Element) {
+				assertion.AddChild(saml.NewTextElement("saml", "AttributeStatement", saml.NamespaceAssertion, ""))
+			},
+		},
+		{
+			name: "audience",
+			modify: func(assertion *saml.Element) {
+				audience := assertion.Child(saml.NamespaceAssertion, "Conditions").
+					Child(saml.NamespaceAssertion, "AudienceRestriction").
+					Child(saml.NamespaceAssertion, "Audience")
+				audience.Children = []saml.Node{saml.CharData("https://other.example.com")}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := tamper(t, response(t, idp, samltest.ResponseOptions{}), func(root *saml.Element) {
+				tt.modify(root.Child(saml.NamespaceAssertion, "Assertion"))
+			})
+			if _, err := sp.ParseResponse(resp); !errors.Is(err, saml.ErrInvalidSignature) {
+				t.Fatalf("err = %v, want ErrInvalidSignature", err)
+			}
+		})
+	}
+}
+
+func TestParseResponseCommentInNameID(t *testing.T) {
+	idp, sp := newProvider(t)
+
+	// 注释不参与签名，签名有效时读取的 NameID 不能被注释截断为 admin@example.com
+	resp := response(t, idp, samltest.ResponseOptions{Modify: func(resp *saml.Element) {
+		nameID(resp.Child(saml.NamespaceAssertion, "Assertion")).Children = []saml.Node{
+			saml.CharData("admin@example.com"), saml.Comment(""), saml.CharData(".evil.com"),
+		}
+	}})
+
+	a, err := sp.ParseResponse(resp)
+	if err != nil {
+		t.Fatalf("ParseResponse: %v", err)
+	}
+	if a.NameID != "admin@example.com.evil.com" {
+		t.Fatalf("NameID = %q, want the full signed value", a.NameID)
+	}
+}
+
+func TestParseResponseSignatureWrapping(t *testing.T) {
+	idp, sp := newProvider(t)
+
+	tests := []struct {
+		name     string
+		wrap     func(t *testing.T, resp string) string
+		contains string
+	}{
+		{
+			// 伪造的断言替换原断言，签名过的原断言藏在伪造断言内部
+			name: "signed assertion nested in a forged one",
+			wrap: func(t *testing.T, resp string) string {
+				original := cloneAssertion(t, resp)
+				return tamper(t, resp, func(root *saml.Element) {
+					forged := root.Child(saml.NamespaceAssertion, "Assertion")
+					forged.SetAttr("ID", "_forged")
+					forged.RemoveChild(forged.Child(saml.NamespaceDSig, "Signature"))
+					nameID(forged).Children = []saml.Node{saml.CharData("admin")}
+					forged.AddChild(original)
+				})
+			},
+			contains: "not signed",
+		},
+		{
+			// 伪造的断言携带原断言的签名，签名引用的 ID 与伪造断言不同
+			name: "signature moved to a forged assertion",
+			wrap: func(t *testing.T, resp string) string {
+				return tamper(t, resp, func(root *saml.Element) {
+					forged := root.Child(saml.NamespaceAssertion, "Assertion")
+					forged.SetAttr("ID", "_forged")
+					nameID(forged).Children = []saml.Node{saml.CharData("admin")}
+				})
+			},
+			contains: "does not reference the signed element",
+		},
+		{
+			// 签名过的原断言与伪造的断言并列
+			name: "forged assertion next to the signed one",
+			wrap: func(t *testing.T, resp string) string {
+				forged := cloneAssertion(t, resp)
+				forged.SetAttr("ID", "_forged")
+				forged.RemoveChild(forged.Child(saml.NamespaceDSig, "Signature"))
+				nameID(forged).Children = []saml.Node{saml.CharData("admin")}
+				return tamper(t, resp, func(root *saml.Element) {
+					root.InsertChild(2, forged)
+				})
+			},
+			contains: "exactly one assertion",
+		},
+		{
+			// 原断言放到 Extensions 中，Response 中的断言未签名
+			name: "signed assertion moved to extensions",
+			wrap: func(t *testing.T, resp string) string {
+				original := cloneAssertion(t, resp)
+				return tamper(t, resp, func(root *saml.Element) {
+					forged := root.Child(saml.NamespaceAssertion, "Assertion")
+					forged.SetAttr("ID", "_forged")
+					forged.RemoveChild(forged.Child(saml.NamespaceDSig, "Signature"))
+					nameID(forged).Children = []saml.Node{saml.CharData("admin")}
+					extensions := saml.NewElement("samlp", "Extensions", saml.NamespaceProtocol, false)
+					extensions.AddChild(original)
+					root.InsertChild(1, extensions)
+				})
+			},
+			contains: "not signed",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expectError(t, sp, tt.wrap(t, response(t, idp, samltest.ResponseOptions{})), tt.contains)
+		})
+	}
+}
+
+func TestParseResponseDuplicateIDs(t *testing.T) {
+	idp, sp := newProvider(t)
+
+	resp := response(t, idp, samltest.ResponseOptions{})
+	original := cloneAssertion(t, resp)
+	// 与签名断言 ID 相同的伪造断言，按 ID 查找签名引用时可能命中未签名的副本
+	resp = tamper(t, resp, func(root *saml.Element) {
+		forged := root.Child(saml.NamespaceAssertion, "Assertion")
+		forged.RemoveChild(forged.Child(saml.NamespaceDSig, "Signature"))
+		nameID(forged).Children = []saml.Node{saml.CharData("admin")}
+		extensions := saml.NewElement("samlp", "Extensions", saml.NamespaceProtocol, false)
+		extensions.AddChild(original)
+		root.InsertChild(1, extensions)
+	})
+
+	expectError(t, sp, resp, "duplicate IDs")
+}
+
+func TestParseResponseAudienceAndRecipient(t *testing.T) {
+	idp, sp := newProvider(t)
+
+	tests := []struct {
+		name     string
+		opts     samltest.ResponseOptions
+		contains string
+	}{
+		{
+			name:     "wrong audience",
+			opts:     samltest.ResponseOptions{SPEntityID: "https://other.example.com/saml/metadata"},
+			contains: "not intended for this service provider",
+		},
+		{
+			name:     "wrong destination",
+			opts:     samltest.ResponseOptions{ACSURL: "https://other.example.com/saml/acs"},
+			contains: "destination",
+		},
+		{
+			name: "wrong recipient",
+			opts: samltest.ResponseOptions{Modify: func(resp *saml.Element) {
+				resp.Child(saml.NamespaceAssertion, "Assertion").
+					Child(saml.NamespaceAssertion, "Subject").
+					Child(saml.NamespaceAssertion, "SubjectConfirmation").
+					Child(saml.NamespaceAssertion, "SubjectConfirmationData").
+					SetAttr("Recipient", "https://other.example.com/saml/acs")
+			}},
+			contains: "recipient does not match",
+		},
+		{
+			name: "wrong issuer",
+			opts: samltest.ResponseOptions{Modify: func(resp *saml.Element) {
+				issuer := resp.Child(saml.NamespaceAssertion, "Assertion").Child(saml.NamespaceAssertion, "Issuer")
+				issuer.Children = []saml.Node{saml.CharData("https://evil.example.com")}
+			}},
+			contains: "issuer does not match",
+		},
+		{
+			name: "unsolicited",
+			opts: samltest.ResponseOptions{Modify: func(resp *saml.Element) {
+				removeAttr(resp, "InResponseTo")
+				removeAttr(resp.Child(saml.NamespaceAssertion, "Assertion").
+					Child(saml.NamespaceAssertion, "Subject").
+					Child(saml.NamespaceAssertion, "SubjectConfirmation").
+					Child(saml.NamespaceAssertion, "SubjectConfirmationData"), "InResponseTo")
+			}},
+			contains: "unsolicited",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expectError(t, sp, response(t, idp, tt.opts), tt.contains)
+		})
+	}
+}
+
+func removeAttr(el *saml.Element, local string) {
+	for i, a := range el.Attrs {
+		if a.Local == local {
+			el.Attrs = append(el.Attrs[:i], el.Attrs[i+1:]...)
+			return
+		}
+	}
+}
+
+func TestParseResponseValidityPeriod(t *testing.T) {
+	idp, sp := newProvider(t)
+	now := time.Date(2025, 3, 1, 8, 0, 0, 0, time.UTC)
+	sp.Now = func() time.Time { return now }
+
+	tests := []struct {
+		name     string
+		issuedAt time.Time
+		lifetime time.Duration
+		contains string
+	}{
+		{name: "expired", issuedAt: now.Add(-10 * time.Minute), lifetime: 5 * time.Minute, contains: "expired"},
+		{name: "expired beyond clock skew", issuedAt: now.Add(-6*time.Minute - time.Second), lifetime: 5 * time.Minute, contains: "expired"},
+		{name: "issued in the future", issuedAt: now.Add(10 * time.Minute), contains: "future"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			idp.Now = func() time.Time { return tt.issuedAt }
+			expectError(t, sp, response(t, idp, samltest.ResponseOptions{Lifetime: tt.lifetime}), tt.contains)
+		})
+	}
+
+	// 时钟偏差范围内仍然有效
+	idp.Now = func() time.Time { return now.Add(-5*time.Minute - 30*time.Second) }
+	if _, err := sp.ParseResponse(response(t, idp, samltest.ResponseOptions{Lifetime: 5 * time.Minute})); err != nil {
+		t.Fatalf("response within the clock skew rejected: %v", err)
+	}
+
+	// Conditions 早于 SubjectConfirmationData 过期
+	idp.Now = func() time.Time { return now.Add(-time.Minute) }
+	resp := response(t, idp, samltest.ResponseOptions{Modify: func(resp *saml.Element) {
+		resp.Child(saml.NamespaceAssertion, "Assertion").
+			Child(saml.NamespaceAssertion, "Conditions").
+			SetAttr("NotOnOrAfter", now.Add(-2*time.Minute).Format(time.RFC3339))
+	}})
+	expectError(t, sp, resp, "assertion has expired")
+}
+
+func TestStoreReplay(t *testing.T) {
+	mr := miniredis.RunT(t)
+	rds := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	defer rds.Close()
+
+	store := saml.NewStore(rds, 5*time.Minute, time.Minute)
+	ctx := context.Background()
+	idp, sp := newProvider(t)
+
+	requestID, resp := login(t, idp, sp, samltest.User{NameID: "alice"})
+	if err := store.SaveRequest(ctx, requestID, saml.RequestInfo{ProviderID: 7, RelayState: "state"}); err != nil {
+		t.Fatalf("SaveRequest: %v", err)
+	}
+	if err := store.SaveRequest(ctx, requestID, saml.RequestInfo{ProviderID: 8}); err == nil {
+		t.Fatal("request ID reused")
+	}
+
+	a, err := sp.ParseResponse(resp)
+	if err != nil {
+		t.Fatalf("ParseResponse: %v", err)
+	}
+	info, err := store.ConsumeRequest(ctx, a.InResponseTo)
+	if err != nil || info.ProviderID != 7 || info.RelayState != "state" {
+		t.Fatalf("ConsumeRequest = %+v, %v", info, err)
+	}
+	if err = store.MarkAssertion(ctx, info.ProviderID, a.ID, a.NotOnOrAfter); err != nil {
+		t.Fatalf("MarkAssertion: %v", err)
+	}
+
+	// 重放同一个响应：请求已被消费，断言已被记录
+	a, err = sp.ParseResponse(resp)
+	if err != nil {
+		t.Fatalf("ParseResponse: %v", err)
+	}
+	if _, err = store.ConsumeRequest(ctx, a.InResponseTo); !errors.Is(err, saml.ErrUnknownRequest) {
+		t.Fatalf("ConsumeRequest err = %v, want ErrUnknownRequest", err)
+	}
+	if err = store.MarkAssertion(ctx, 7, a.ID, a.NotOnOrAfter); !errors.Is(err, saml.ErrReplayedAssertion) {
+		t.Fatalf("MarkAssertion err = %v, want ErrReplayedAssertion", err)
+	}
+
+	// 断言记录按连接区分，并保留到断言失效之后
+	if err = store.MarkAssertion(ctx, 8, a.ID, a.NotOnOrAfter); err != nil {
+		t.Fatalf("MarkAssertion for another provider: %v", err)
+	}
+	mr.FastForward(time.Until(a.NotOnOrAfter))
+	if err = store.MarkAssertion(ctx, 7, a.ID, a.NotOnOrAfter); !errors.Is(err, saml.ErrReplayedAssertion) {
+		t.Fatalf("assertion record expired before the clock skew, err = %v", err)
+	}
+}
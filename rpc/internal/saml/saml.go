@@ -0,0 +1,148 @@
+// Package saml implements the service provider side of SAML 2.0 Web Browser SSO:
+// IdP metadata import, SP metadata export, signed AuthnRequests over the HTTP-Redirect binding
+// and validation of the responses posted to the assertion consumer service.
+//
+// Only the standard library is used, XML signatures are verified with Exclusive XML
+// Canonicalization and only SHA-256 or stronger digests are accepted.
+package saml
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// XML namespaces
+const (
+	NamespaceProtocol  = "urn:oasis:names:tc:SAML:2.0:protocol"
+	NamespaceAssertion = "urn:oasis:names:tc:SAML:2.0:assertion"
+	NamespaceMetadata  = "urn:oasis:names:tc:SAML:2.0:metadata"
+	NamespaceDSig      = "http://www.w3.org/2000/09/xmldsig#"
+)
+
+// Bindings
+const (
+	BindingHTTPRedirect = "urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Redirect"
+	BindingHTTPPost     = "urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST"
+)
+
+// NameID formats
+const (
+	NameIDFormatUnspecified = "urn:oasis:names:tc:SAML:1.1:nameid-format:unspecified"
+	NameIDFormatEmail       = "urn:oasis:names:tc:SAML:1.1:nameid-format:emailAddress"
+	NameIDFormatPersistent  = "urn:oasis:names:tc:SAML:2.0:nameid-format:persistent"
+	NameIDFormatTransient   = "urn:oasis:names:tc:SAML:2.0:nameid-format:transient"
+)
+
+// Status codes and subject confirmation methods
+const (
+	StatusSuccess       = "urn:oasis:names:tc:SAML:2.0:status:Success"
+	ConfirmationBearer  = "urn:oasis:names:tc:SAML:2.0:cm:bearer"
+	attributeNameFormat = "urn:oasis:names:tc:SAML:2.0:attrname-format:basic"
+)
+
+// Signature algorithms
+const (
+	AlgorithmExcC14N             = "http://www.w3.org/2001/10/xml-exc-c14n#"
+	AlgorithmExcC14NWithComments = "http://www.w3.org/2001/10/xml-exc-c14n#WithComments"
+	AlgorithmEnvelopedSignature  = "http://www.w3.org/2000/09/xmldsig#enveloped-signature"
+	AlgorithmRSASHA256           = "http://www.w3.org/2001/04/xmldsig-more#rsa-sha256"
+	AlgorithmRSASHA512           = "http://www.w3.org/2001/04/xmldsig-more#rsa-sha512"
+	AlgorithmECDSASHA256         = "http://www.w3.org/2001/04/xmldsig-more#ecdsa-sha256"
+	AlgorithmECDSASHA512         = "http://www.w3.org/2001/04/xmldsig-more#ecdsa-sha512"
+	AlgorithmSHA256              = "http://www.w3.org/2001/04/xmlenc#sha256"
+	AlgorithmSHA512              = "http://www.w3.org/2001/04/xmlenc#sha512"
+)
+
+var (
+	// ErrUnsignedResponse neither the response nor the assertion is signed
+	ErrUnsignedResponse = errors.New("saml: response is not signed")
+	// ErrEncryptedAssertion encrypted assertions are not supported
+	ErrEncryptedAssertion = errors.New("saml: encrypted assertions are not supported")
+	// ErrInvalidSignature the signature does not match any trusted certificate
+	ErrInvalidSignature = errors.New("saml: invalid signature")
+)
+
+// KeyPair is the signing key and certificate of the service provider
+type KeyPair struct {
+	Key  crypto.Signer
+	Cert *x509.Certificate
+}
+
+// LoadKeyPair loads the PEM encoded certificate and private key, RSA and ECDSA keys are supported
+func LoadKeyPair(certFile, keyFile string) (*KeyPair, error) {
+	pair, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("saml: failed to load the SP key pair: %w", err)
+	}
+
+	cert, err := x509.ParseCertificate(pair.Certificate[0])
+	if err != nil {
+		return nil, fmt.Errorf("saml: invalid SP certificate: %w", err)
+	}
+
+	switch key := pair.PrivateKey.(type) {
+	case *rsa.PrivateKey:
+		return &KeyPair{Key: key, Cert: cert}, nil
+	case *ecdsa.PrivateKey:
+		return &KeyPair{Key: key, Cert: cert}, nil
+	default:
+		return nil, fmt.Errorf("saml: unsupported SP key type %T", pair.PrivateKey)
+	}
+}
+
+// ParseCertificates parses one or more certificates, either PEM blocks or bare base64 DER
+// as found in metadata and copied from IdP admin consoles
+func ParseCertificates(data string) ([]*x509.Certificate, error) {
+	var certs []*x509.Certificate
+
+	rest := []byte(strings.TrimSpace(data))
+	if !strings.Contains(string(rest), "-----BEGIN") {
+		der, err := decodeBase64(string(rest))
+		if err != nil {
+			return nil, fmt.Errorf("saml: invalid certificate: %w", err)
+		}
+		cert, err := x509.ParseCertificate(der)
+		if err != nil {
+			return nil, fmt.Errorf("saml: invalid certificate: %w", err)
+		}
+		return []*x509.Certificate{cert}, nil
+	}
+
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("saml: invalid certificate: %w", err)
+		}
+		certs = append(certs, cert)
+	}
+
+	if len(certs) == 0 {
+		return nil, errors.New("saml: no certificate found")
+	}
+
+	return certs, nil
+}
+
+// EncodeCertificates encodes certificates as PEM
+func EncodeCertificates(certs []*x509.Certificate) string {
+	var sb strings.Builder
+	for _, cert := range certs {
+		sb.Write(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw}))
+	}
+	return sb.String()
+}
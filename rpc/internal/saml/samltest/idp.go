@@ -0,0 +1,345 @@
+// Package samltest provides an in-process SAML 2.0 identity provider, so the SP login flow
+// can be exercised in tests and local development without an external IdP.
+package samltest
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"html/template"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/coder-lulu/newbee-core/rpc/internal/saml"
+)
+
+// User is the identity asserted by the IdP
+type User struct {
+	NameID       string
+	NameIDFormat string
+	Attributes   map[string][]string
+}
+
+// ResponseOptions describes the response to issue
+type ResponseOptions struct {
+	SPEntityID   string
+	ACSURL       string
+	InResponseTo string
+	User         User
+	// SignResponse 额外签名整个 Response，断言默认总是签名
+	SignResponse           bool
+	SkipAssertionSignature bool
+	// Lifetime 断言有效期，默认 5 分钟
+	Lifetime time.Duration
+	// Modify 在签名前修改响应，用于构造异常场景
+	Modify func(resp *saml.Element)
+}
+
+// AuthnRequest is a request received by the IdP
+type AuthnRequest struct {
+	ID         string
+	Issuer     string
+	ACSURL     string
+	RelayState string
+}
+
+// IdP is an identity provider served by an httptest server:
+// GET /metadata returns the metadata and GET /sso answers AuthnRequests with an auto-submitting form.
+type IdP struct {
+	EntityID string
+	KeyPair  *saml.KeyPair
+	Server   *httptest.Server
+	// SPCerts 用于校验 AuthnRequest 签名，为空时接受未签名的请求
+	SPCerts []*x509.Certificate
+	// User 通过 /sso 登录时断言的用户
+	User User
+	Now  func() time.Time
+}
+
+// New starts an IdP with a fresh RSA key and self-signed certificate
+func New() (*IdP, error) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return nil, err
+	}
+
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: "samltest IdP"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(24 * time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		return nil, err
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		return nil, err
+	}
+
+	idp := &IdP{
+		KeyPair: &saml.KeyPair{Key: key, Cert: cert},
+		User:    User{NameID: "alice", NameIDFormat: saml.NameIDFormatPersistent},
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/metadata", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/samlmetadata+xml")
+		_, _ = w.Write(idp.Metadata())
+	})
+	mux.HandleFunc("/sso", idp.serveSSO)
+	idp.Server = httptest.NewServer(mux)
+	idp.EntityID = idp.Server.URL + "/metadata"
+
+	return idp, nil
+}
+
+// Close stops the server
+func (i *IdP) Close() {
+	i.Server.Close()
+}
+
+// MetadataURL returns the URL of the metadata
+func (i *IdP) MetadataURL() string {
+	return i.Server.URL + "/metadata"
+}
+
+// SSOURL returns the single sign-on URL
+func (i *IdP) SSOURL() string {
+	return i.Server.URL + "/sso"
+}
+
+func (i *IdP) now() time.Time {
+	if i.Now != nil {
+		return i.Now()
+	}
+	return time.Now()
+}
+
+// Metadata returns the IdP metadata
+func (i *IdP) Metadata() []byte {
+	root := saml.NewElement("md", "EntityDescriptor", saml.NamespaceMetadata, true)
+	root.SetAttr("entityID", i.EntityID)
+
+	desc := saml.NewElement("md", "IDPSSODescriptor", saml.NamespaceMetadata, false)
+	desc.SetAttr("protocolSupportEnumeration", saml.NamespaceProtocol)
+	desc.SetAttr("WantAuthnRequestsSigned", fmt.Sprint(len(i.SPCerts) > 0))
+
+	kd := saml.NewElement("md", "KeyDescriptor", saml.NamespaceMetadata, false)
+	kd.SetAttr("use", "signing")
+	keyInfo := saml.NewElement("ds", "KeyInfo", saml.NamespaceDSig, true)
+	x509Data := saml.NewElement("ds", "X509Data", saml.NamespaceDSig, false)
+	x509Data.AddChild(saml.NewTextElement("ds", "X509Certificate", saml.NamespaceDSig, base64.StdEncoding.EncodeToString(i.KeyPair.Cert.Raw)))
+	keyInfo.AddChild(x509Data)
+	kd.AddChild(keyInfo)
+	desc.AddChild(kd)
+
+	desc.AddChild(saml.NewTextElement("md", "NameIDFormat", saml.NamespaceMetadata, saml.NameIDFormatPersistent))
+	for _, binding := range []string{saml.BindingHTTPRedirect, saml.BindingHTTPPost} {
+		sso := saml.NewElement("md", "SingleSignOnService", saml.NamespaceMetadata, false)
+		sso.SetAttr("Binding", binding)
+		sso.SetAttr("Location", i.SSOURL())
+		desc.AddChild(sso)
+	}
+	root.AddChild(desc)
+
+	return root.Bytes()
+}
+
+// ParseAuthnRequest parses an AuthnRequest redirect URL produced by the SP and verifies its signature
+func (i *IdP) ParseAuthnRequest(redirectURL string) (*AuthnRequest, error) {
+	u, err := url.Parse(redirectURL)
+	if err != nil {
+		return nil, err
+	}
+
+	el, relayState, err := saml.ParseRedirectRequest(u.RawQuery, i.SPCerts)
+	if err != nil {
+		return nil, err
+	}
+	if !el.Is(saml.NamespaceProtocol, "AuthnRequest") {
+		return nil, errors.New("samltest: not an AuthnRequest")
+	}
+	if d := el.AttrValue("Destination"); d != i.SSOURL() {
+		return nil, fmt.Errorf("samltest: unexpected destination %q", d)
+	}
+
+	req := &AuthnRequest{
+		ID:         el.AttrValue("ID"),
+		ACSURL:     el.AttrValue("AssertionConsumerServiceURL"),
+		RelayState: relayState,
+	}
+	if issuer := el.Child(saml.NamespaceAssertion, "Issuer"); issuer != nil {
+		req.Issuer = strings.TrimSpace(issuer.Text())
+	}
+
+	return req, nil
+}
+
+// Login answers an AuthnRequest redirect URL for the user and returns the request and the base64 SAMLResponse
+func (i *IdP) Login(redirectURL string, user User) (*AuthnRequest, string, error) {
+	req, err := i.ParseAuthnRequest(redirectURL)
+	if err != nil {
+		return nil, "", err
+	}
+
+	resp, err := i.Response(ResponseOptions{
+		SPEntityID:   req.Issuer,
+		ACSURL:       req.ACSURL,
+		InResponseTo: req.ID,
+		User:         user,
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	return req, resp, nil
+}
+
+// Response issues a base64 encoded SAMLResponse
+func (i *IdP) Response(opts ResponseOptions) (string, error) {
+	now := i.now().UTC()
+	lifetime := opts.Lifetime
+	if lifetime == 0 {
+		lifetime = 5 * time.Minute
+	}
+	instant := now.Format(time.RFC3339)
+	expiry := now.Add(lifetime).Format(time.RFC3339)
+
+	responseID, err := saml.NewID()
+	if err != nil {
+		return "", err
+	}
+	assertionID, err := saml.NewID()
+	if err != nil {
+		return "", err
+	}
+	sessionIndex, err := saml.NewID()
+	if err != nil {
+		return "", err
+	}
+
+	resp := saml.NewElement("samlp", "Response", saml.NamespaceProtocol, true)
+	resp.Decls = append(resp.Decls, saml.NSDecl{Prefix: "saml", URI: saml.NamespaceAssertion})
+	resp.SetAttr("ID", responseID)
+	resp.SetAttr("Version", "2.0")
+	resp.SetAttr("IssueInstant", instant)
+	resp.SetAttr("Destination", opts.ACSURL)
+	if opts.InResponseTo != "" {
+		resp.SetAttr("InResponseTo", opts.InResponseTo)
+	}
+	resp.AddChild(saml.NewTextElement("saml", "Issuer", saml.NamespaceAssertion, i.EntityID))
+
+	status := saml.NewElement("samlp", "Status", saml.NamespaceProtocol, false)
+	code := saml.NewElement("samlp", "StatusCode", saml.NamespaceProtocol, false)
+	code.SetAttr("Value", saml.StatusSuccess)
+	status.AddChild(code)
+	resp.AddChild(status)
+
+	assertion := saml.NewElement("saml", "Assertion", saml.NamespaceAssertion, false)
+	assertion.SetAttr("ID", assertionID)
+	assertion.SetAttr("Version", "2.0")
+	assertion.SetAttr("IssueInstant", instant)
+	assertion.AddChild(saml.NewTextElement("saml", "Issuer", saml.NamespaceAssertion, i.EntityID))
+
+	subject := saml.NewElement("saml", "Subject", saml.NamespaceAssertion, false)
+	nameID := saml.NewTextElement("saml", "NameID", saml.NamespaceAssertion, opts.User.NameID)
+	if opts.User.NameIDFormat != "" {
+		nameID.SetAttr("Format", opts.User.NameIDFormat)
+	}
+	subject.AddChild(nameID)
+	confirmation := saml.NewElement("saml", "SubjectConfirmation", saml.NamespaceAssertion, false)
+	confirmation.SetAttr("Method", saml.ConfirmationBearer)
+	data := saml.NewElement("saml", "SubjectConfirmationData", saml.NamespaceAssertion, false)
+	if opts.InResponseTo != "" {
+		data.SetAttr("InResponseTo", opts.InResponseTo)
+	}
+	data.SetAttr("NotOnOrAfter", expiry)
+	data.SetAttr("Recipient", opts.ACSURL)
+	confirmation.AddChild(data)
+	subject.AddChild(confirmation)
+	assertion.AddChild(subject)
+
+	conditions := saml.NewElement("saml", "Conditions", saml.NamespaceAssertion, false)
+	conditions.SetAttr("NotBefore", instant)
+	conditions.SetAttr("NotOnOrAfter", expiry)
+	restriction := saml.NewElement("saml", "AudienceRestriction", saml.NamespaceAssertion, false)
+	restriction.AddChild(saml.NewTextElement("saml", "Audience", saml.NamespaceAssertion, opts.SPEntityID))
+	conditions.AddChild(restriction)
+	assertion.AddChild(conditions)
+
+	statement := saml.NewElement("saml", "AuthnStatement", saml.NamespaceAssertion, false)
+	statement.SetAttr("AuthnInstant", instant)
+	statement.SetAttr("SessionIndex", sessionIndex)
+	authnContext := saml.NewElement("saml", "AuthnContext", saml.NamespaceAssertion, false)
+	authnContext.AddChild(saml.NewTextElement("saml", "AuthnContextClassRef", saml.NamespaceAssertion,
+		"urn:oasis:names:tc:SAML:2.0:ac:classes:PasswordProtectedTransport"))
+	statement.AddChild(authnContext)
+	assertion.AddChild(statement)
+
+	if len(opts.User.Attributes) > 0 {
+		attrs := saml.NewElement("saml", "AttributeStatement", saml.NamespaceAssertion, false)
+		for name, values := range opts.User.Attributes {
+			attr := saml.NewElement("saml", "Attribute", saml.NamespaceAssertion, false)
+			attr.SetAttr("Name", name)
+			for _, v := range values {
+				attr.AddChild(saml.NewTextElement("saml", "AttributeValue", saml.NamespaceAssertion, v))
+			}
+			attrs.AddChild(attr)
+		}
+		assertion.AddChild(attrs)
+	}
+	resp.AddChild(assertion)
+
+	if opts.Modify != nil {
+		opts.Modify(resp)
+	}
+
+	if !opts.SkipAssertionSignature {
+		if err := saml.SignEnveloped(assertion, i.KeyPair); err != nil {
+			return "", err
+		}
+	}
+	if opts.SignResponse {
+		if err := saml.SignEnveloped(resp, i.KeyPair); err != nil {
+			return "", err
+		}
+	}
+
+	return base64.StdEncoding.EncodeToString(resp.Bytes()), nil
+}
+
+var postForm = template.Must(template.New("post").Parse(`<!DOCTYPE html>
+<html><body onload="document.forms[0].submit()">
+<form method="post" action="{{.ACSURL}}">
+<input type="hidden" name="SAMLResponse" value="{{.Response}}">
+<input type="hidden" name="RelayState" value="{{.RelayState}}">
+<noscript><button type="submit">Continue</button></noscript>
+</form>
+</body></html>`))
+
+// serveSSO answers the AuthnRequest with an auto-submitting form for the configured user
+func (i *IdP) serveSSO(w http.ResponseWriter, r *http.Request) {
+	req, resp, err := i.Login(i.SSOURL()+"?"+r.URL.RawQuery, i.User)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	_ = postForm.Execute(w, map[string]string{
+		"ACSURL":     req.ACSURL,
+		"Response":   resp,
+		"RelayState": req.RelayState,
+	})
+}
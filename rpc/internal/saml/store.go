@@ -0,0 +1,92 @@
+package saml
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+var (
+	// ErrUnknownRequest the response does not answer a pending AuthnRequest
+	ErrUnknownRequest = errors.New("saml: unknown or expired request")
+	// ErrReplayedAssertion the assertion has already been consumed
+	ErrReplayedAssertion = errors.New("saml: assertion has already been used")
+)
+
+// RequestInfo is stored for every pending AuthnRequest
+type RequestInfo struct {
+	ProviderID uint64 `json:"provider_id"`
+	RelayState string `json:"relay_state"`
+}
+
+// Store keeps the pending AuthnRequests and the consumed assertion IDs in Redis
+type Store struct {
+	redis      redis.UniversalClient
+	requestTTL time.Duration
+	clockSkew  time.Duration
+}
+
+// NewStore creates a store, requests expire after requestTTL
+func NewStore(rds redis.UniversalClient, requestTTL, clockSkew time.Duration) *Store {
+	return &Store{redis: rds, requestTTL: requestTTL, clockSkew: clockSkew}
+}
+
+// SaveRequest records a pending AuthnRequest
+func (s *Store) SaveRequest(ctx context.Context, id string, info RequestInfo) error {
+	data, err := json.Marshal(info)
+	if err != nil {
+		return err
+	}
+
+	ok, err := s.redis.SetNX(ctx, fmt.Sprintf("saml:request:%s", id), data, s.requestTTL).Result()
+	if err != nil {
+		return fmt.Errorf("failed to store SAML request: %w", err)
+	}
+	if !ok {
+		return errors.New("saml: request ID already in use")
+	}
+
+	return nil
+}
+
+// ConsumeRequest removes and returns a pending AuthnRequest, so every request can only be answered once
+func (s *Store) ConsumeRequest(ctx context.Context, id string) (*RequestInfo, error) {
+	data, err := s.redis.GetDel(ctx, fmt.Sprintf("saml:request:%s", id)).Result()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, ErrUnknownRequest
+		}
+		return nil, fmt.Errorf("failed to get SAML request: %w", err)
+	}
+
+	var info RequestInfo
+	if err := json.Unmarshal([]byte(data), &info); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal SAML request: %w", err)
+	}
+
+	return &info, nil
+}
+
+// MarkAssertion records a consumed assertion until it expires, ErrReplayedAssertion is returned
+// when the assertion has been seen before
+func (s *Store) MarkAssertion(ctx context.Context, providerID uint64, id string, notOnOrAfter time.Time) error {
+	// 记录保留到断言失效之后，覆盖时钟偏差的容忍范围
+	ttl := time.Until(notOnOrAfter) + s.clockSkew
+	if ttl < time.Minute {
+		ttl = time.Minute
+	}
+
+	ok, err := s.redis.SetNX(ctx, fmt.Sprintf("saml:assertion:%d:%s", providerID, id), 1, ttl).Result()
+	if err != nil {
+		return fmt.Errorf("failed to record SAML assertion: %w", err)
+	}
+	if !ok {
+		return ErrReplayedAssertion
+	}
+
+	return nil
+}
@@ -0,0 +1,303 @@
+package saml
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/subtle"
+	"crypto/x509"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	_ "crypto/sha256"
+	_ "crypto/sha512"
+)
+
+// namespaceExcC14N is the namespace of the InclusiveNamespaces element
+const namespaceExcC14N = "http://www.w3.org/2001/10/xml-exc-c14n#"
+
+// errNoSignature the element has no enveloped signature
+var errNoSignature = errors.New("saml: element is not signed")
+
+// digestHash maps the supported digest algorithms, SHA-1 is rejected on purpose
+func digestHash(alg string) (crypto.Hash, error) {
+	switch alg {
+	case AlgorithmSHA256:
+		return crypto.SHA256, nil
+	case AlgorithmSHA512:
+		return crypto.SHA512, nil
+	default:
+		return 0, fmt.Errorf("saml: unsupported digest algorithm %q", alg)
+	}
+}
+
+// signatureHash maps the supported signature algorithms
+func signatureHash(alg string) (crypto.Hash, bool, error) {
+	switch alg {
+	case AlgorithmRSASHA256:
+		return crypto.SHA256, false, nil
+	case AlgorithmRSASHA512:
+		return crypto.SHA512, false, nil
+	case AlgorithmECDSASHA256:
+		return crypto.SHA256, true, nil
+	case AlgorithmECDSASHA512:
+		return crypto.SHA512, true, nil
+	default:
+		return 0, false, fmt.Errorf("saml: unsupported signature algorithm %q", alg)
+	}
+}
+
+// newCanonicalizer creates the canonicalizer of a CanonicalizationMethod or Transform element
+func newCanonicalizer(method *Element) (*canonicalizer, error) {
+	c := &canonicalizer{}
+	switch method.AttrValue("Algorithm") {
+	case AlgorithmExcC14N:
+	case AlgorithmExcC14NWithComments:
+		c.withComments = true
+	default:
+		return nil, fmt.Errorf("saml: unsupported canonicalization algorithm %q", method.AttrValue("Algorithm"))
+	}
+
+	if in := method.Child(namespaceExcC14N, "InclusiveNamespaces"); in != nil {
+		c.inclusive = strings.Fields(in.AttrValue("PrefixList"))
+	}
+
+	return c, nil
+}
+
+// verifyEnveloped verifies the enveloped signature that is a direct child of the element.
+// The only accepted reference is the element itself, so the content read from the element
+// afterwards is exactly the content that was signed.
+func verifyEnveloped(el *Element, certs []*x509.Certificate) error {
+	sigs := el.ChildElements(NamespaceDSig, "Signature")
+	switch {
+	case len(sigs) == 0:
+		return errNoSignature
+	case len(sigs) > 1:
+		return errors.New("saml: multiple signatures on one element")
+	}
+	sig := sigs[0]
+
+	id := el.AttrValue("ID")
+	if id == "" {
+		return errors.New("saml: signed element has no ID")
+	}
+
+	signedInfo := sig.Child(NamespaceDSig, "SignedInfo")
+	if signedInfo == nil {
+		return errors.New("saml: signature has no SignedInfo")
+	}
+
+	refs := signedInfo.ChildElements(NamespaceDSig, "Reference")
+	if len(refs) != 1 {
+		return errors.New("saml: signature must have exactly one reference")
+	}
+	ref := refs[0]
+	if ref.AttrValue("URI") != "#"+id {
+		return errors.New("saml: signature does not reference the signed element")
+	}
+
+	// 只接受 enveloped-signature 与 exc-c14n 变换
+	var enveloped bool
+	var refC14N *canonicalizer
+	if transforms := ref.Child(NamespaceDSig, "Transforms"); transforms != nil {
+		for _, t := range transforms.ChildElements(NamespaceDSig, "Transform") {
+			if t.AttrValue("Algorithm") == AlgorithmEnvelopedSignature {
+				enveloped = true
+				continue
+			}
+			c, err := newCanonicalizer(t)
+			if err != nil {
+				return err
+			}
+			refC14N = c
+		}
+	}
+	if !enveloped || refC14N == nil {
+		return errors.New("saml: signature must use the enveloped-signature and exclusive canonicalization transforms")
+	}
+	refC14N.exclude = sig
+
+	digestMethod := ref.Child(NamespaceDSig, "DigestMethod")
+	digestValue := ref.Child(NamespaceDSig, "DigestValue")
+	if digestMethod == nil || digestValue == nil {
+		return errors.New("saml: reference has no digest")
+	}
+	hash, err := digestHash(digestMethod.AttrValue("Algorithm"))
+	if err != nil {
+		return err
+	}
+	expected, err := decodeBase64(digestValue.Text())
+	if err != nil {
+		return fmt.Errorf("saml: invalid digest value: %w", err)
+	}
+	h := hash.New()
+	h.Write(refC14N.canonicalize(el))
+	if subtle.ConstantTimeCompare(h.Sum(nil), expected) != 1 {
+		return ErrInvalidSignature
+	}
+
+	method := signedInfo.Child(NamespaceDSig, "CanonicalizationMethod")
+	signatureMethod := signedInfo.Child(NamespaceDSig, "SignatureMethod")
+	signatureValue := sig.Child(NamespaceDSig, "SignatureValue")
+	if method == nil || signatureMethod == nil || signatureValue == nil {
+		return errors.New("saml: incomplete signature")
+	}
+	c14n, err := newCanonicalizer(method)
+	if err != nil {
+		return err
+	}
+	value, err := decodeBase64(signatureValue.Text())
+	if err != nil {
+		return fmt.Errorf("saml: invalid signature value: %w", err)
+	}
+
+	return verifySignatureValue(signatureMethod.AttrValue("Algorithm"), c14n.canonicalize(signedInfo), value, certs)
+}
+
+// verifySignatureValue verifies a raw signature against the trusted certificates,
+// ECDSA signatures use the r || s encoding of XML-DSig
+func verifySignatureValue(alg string, data, signature []byte, certs []*x509.Certificate) error {
+	hash, isECDSA, err := signatureHash(alg)
+	if err != nil {
+		return err
+	}
+	h := hash.New()
+	h.Write(data)
+	digest := h.Sum(nil)
+
+	for _, cert := range certs {
+		switch pub := cert.PublicKey.(type) {
+		case *rsa.PublicKey:
+			if !isECDSA && rsa.VerifyPKCS1v15(pub, hash, digest, signature) == nil {
+				return nil
+			}
+		case *ecdsa.PublicKey:
+			if isECDSA && len(signature)%2 == 0 {
+				half := len(signature) / 2
+				r := new(big.Int).SetBytes(signature[:half])
+				s := new(big.Int).SetBytes(signature[half:])
+				if ecdsa.Verify(pub, digest, r, s) {
+					return nil
+				}
+			}
+		}
+	}
+
+	return ErrInvalidSignature
+}
+
+// signatureAlgorithm returns the SHA-256 signature algorithm matching the key
+func signatureAlgorithm(key crypto.Signer) (string, error) {
+	switch key.(type) {
+	case *rsa.PrivateKey:
+		return AlgorithmRSASHA256, nil
+	case *ecdsa.PrivateKey:
+		return AlgorithmECDSASHA256, nil
+	default:
+		return "", fmt.Errorf("saml: unsupported key type %T", key)
+	}
+}
+
+// signData signs the data with SHA-256, ECDSA signatures are encoded as r || s
+func signData(key crypto.Signer, data []byte) (string, []byte, error) {
+	alg, err := signatureAlgorithm(key)
+	if err != nil {
+		return "", nil, err
+	}
+
+	h := crypto.SHA256.New()
+	h.Write(data)
+	digest := h.Sum(nil)
+
+	if k, ok := key.(*ecdsa.PrivateKey); ok {
+		r, s, err := ecdsa.Sign(rand.Reader, k, digest)
+		if err != nil {
+			return "", nil, err
+		}
+		size := (k.Curve.Params().BitSize + 7) / 8
+		signature := make([]byte, 2*size)
+		r.FillBytes(signature[:size])
+		s.FillBytes(signature[size:])
+		return alg, signature, nil
+	}
+
+	signature, err := key.Sign(rand.Reader, digest, crypto.SHA256)
+	if err != nil {
+		return "", nil, err
+	}
+	return alg, signature, nil
+}
+
+// SignEnveloped adds an enveloped signature to the element, which must have an ID attribute.
+// The signature is placed after the saml:Issuer child as required by the SAML schema.
+func SignEnveloped(el *Element, kp *KeyPair) error {
+	id := el.AttrValue("ID")
+	if id == "" {
+		return errors.New("saml: element to sign has no ID")
+	}
+
+	digest := crypto.SHA256.New()
+	digest.Write((&canonicalizer{}).canonicalize(el))
+
+	alg, err := signatureAlgorithm(kp.Key)
+	if err != nil {
+		return err
+	}
+
+	sig := NewElement("ds", "Signature", NamespaceDSig, true)
+	signedInfo := NewElement("ds", "SignedInfo", NamespaceDSig, false)
+	signedInfo.AddChild(algorithmElement("CanonicalizationMethod", AlgorithmExcC14N))
+	signedInfo.AddChild(algorithmElement("SignatureMethod", alg))
+
+	ref := NewElement("ds", "Reference", NamespaceDSig, false)
+	ref.SetAttr("URI", "#"+id)
+	transforms := NewElement("ds", "Transforms", NamespaceDSig, false)
+	transforms.AddChild(algorithmElement("Transform", AlgorithmEnvelopedSignature))
+	transforms.AddChild(algorithmElement("Transform", AlgorithmExcC14N))
+	ref.AddChild(transforms)
+	ref.AddChild(algorithmElement("DigestMethod", AlgorithmSHA256))
+	ref.AddChild(NewTextElement("ds", "DigestValue", NamespaceDSig, base64.StdEncoding.EncodeToString(digest.Sum(nil))))
+	signedInfo.AddChild(ref)
+	sig.AddChild(signedInfo)
+
+	// SignedInfo 需要放入文档后再规范化，以便按最终的命名空间上下文计算
+	at := 0
+	for i, c := range el.Children {
+		if child, ok := c.(*Element); ok && child.Is(NamespaceAssertion, "Issuer") {
+			at = i + 1
+			break
+		}
+	}
+	el.InsertChild(at, sig)
+
+	_, value, err := signData(kp.Key, (&canonicalizer{}).canonicalize(signedInfo))
+	if err != nil {
+		el.RemoveChild(sig)
+		return err
+	}
+	sig.AddChild(NewTextElement("ds", "SignatureValue", NamespaceDSig, base64.StdEncoding.EncodeToString(value)))
+
+	keyInfo := NewElement("ds", "KeyInfo", NamespaceDSig, false)
+	x509Data := NewElement("ds", "X509Data", NamespaceDSig, false)
+	x509Data.AddChild(NewTextElement("ds", "X509Certificate", NamespaceDSig, base64.StdEncoding.EncodeToString(kp.Cert.Raw)))
+	keyInfo.AddChild(x509Data)
+	sig.AddChild(keyInfo)
+
+	return nil
+}
+
+func algorithmElement(local, alg string) *Element {
+	el := NewElement("ds", local, NamespaceDSig, false)
+	el.SetAttr("Algorithm", alg)
+	return el
+}
+
+// decodeBase64 decodes base64 which may be wrapped over several lines
+func decodeBase64(s string) ([]byte, error) {
+	return base64.StdEncoding.DecodeString(strings.Join(strings.Fields(s), ""))
+}
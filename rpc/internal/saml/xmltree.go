@@ -0,0 +1,364 @@
+package saml
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// maxDepth 防止恶意构造的深层嵌套文档耗尽栈空间
+const maxDepth = 64
+
+// xmlNamespace 是 xml 前缀固定绑定的命名空间，不需要声明
+const xmlNamespace = "http://www.w3.org/XML/1998/namespace"
+
+// Element is a namespace aware XML element which keeps the prefixes and declarations of the
+// original document, which the canonicalization of signed content depends on
+type Element struct {
+	Prefix   string
+	Local    string
+	Space    string // 解析后的命名空间 URI
+	Attrs    []Attr
+	Decls    []NSDecl // 本元素上的命名空间声明
+	Children []Node
+	Parent   *Element
+}
+
+// Attr is an attribute which is not a namespace declaration
+type Attr struct {
+	Prefix string
+	Local  string
+	Space  string
+	Value  string
+}
+
+// NSDecl is a namespace declaration, the prefix is empty for the default namespace
+type NSDecl struct {
+	Prefix string
+	URI    string
+}
+
+// Node is an *Element, a CharData or a Comment
+type Node interface{}
+
+// CharData is the text content of an element
+type CharData string
+
+// Comment is an XML comment
+type Comment string
+
+// ParseXML parses a document into an element tree.
+// DTDs are rejected, so entity expansion and external entities are not possible.
+func ParseXML(data []byte) (*Element, error) {
+	d := xml.NewDecoder(bytes.NewReader(data))
+	d.Strict = true
+
+	var root, cur *Element
+	depth := 0
+	for {
+		tok, err := d.RawToken()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("malformed XML: %w", err)
+		}
+
+		switch t := tok.(type) {
+		case xml.StartElement:
+			if cur == nil && root != nil {
+				return nil, errors.New("malformed XML: multiple root elements")
+			}
+			if depth++; depth > maxDepth {
+				return nil, errors.New("XML document is nested too deeply")
+			}
+
+			el := &Element{Prefix: t.Name.Space, Local: t.Name.Local, Parent: cur}
+			for _, a := range t.Attr {
+				switch {
+				case a.Name.Space == "" && a.Name.Local == "xmlns":
+					el.Decls = append(el.Decls, NSDecl{URI: a.Value})
+				case a.Name.Space == "xmlns":
+					el.Decls = append(el.Decls, NSDecl{Prefix: a.Name.Local, URI: a.Value})
+				default:
+					el.Attrs = append(el.Attrs, Attr{Prefix: a.Name.Space, Local: a.Name.Local, Value: a.Value})
+				}
+			}
+
+			// 命名空间需要在本元素的声明加入后才能解析
+			space, ok := el.LookupNamespace(el.Prefix)
+			if !ok && el.Prefix != "" {
+				return nil, fmt.Errorf("malformed XML: undeclared prefix %q", el.Prefix)
+			}
+			el.Space = space
+			for i := range el.Attrs {
+				if el.Attrs[i].Prefix == "" {
+					continue
+				}
+				space, ok := el.LookupNamespace(el.Attrs[i].Prefix)
+				if !ok {
+					return nil, fmt.Errorf("malformed XML: undeclared prefix %q", el.Attrs[i].Prefix)
+				}
+				el.Attrs[i].Space = space
+			}
+
+			if cur == nil {
+				root = el
+			} else {
+				cur.Children = append(cur.Children, el)
+			}
+			cur = el
+
+		case xml.EndElement:
+			if cur == nil || t.Name.Space != cur.Prefix || t.Name.Local != cur.Local {
+				return nil, errors.New("malformed XML: unexpected end element")
+			}
+			cur = cur.Parent
+			depth--
+
+		case xml.CharData:
+			if cur != nil {
+				cur.Children = append(cur.Children, CharData(t))
+			} else if len(bytes.TrimSpace(t)) > 0 {
+				return nil, errors.New("malformed XML: text outside of the root element")
+			}
+
+		case xml.Comment:
+			if cur != nil {
+				cur.Children = append(cur.Children, Comment(t))
+			}
+
+		case xml.Directive:
+			return nil, errors.New("XML documents with a DTD are not allowed")
+		}
+	}
+
+	if root == nil || cur != nil {
+		return nil, errors.New("malformed XML: incomplete document")
+	}
+
+	return root, nil
+}
+
+// LookupNamespace resolves a prefix in the scope of the element
+func (e *Element) LookupNamespace(prefix string) (string, bool) {
+	if prefix == "xml" {
+		return xmlNamespace, true
+	}
+	for el := e; el != nil; el = el.Parent {
+		for _, d := range el.Decls {
+			if d.Prefix == prefix {
+				return d.URI, true
+			}
+		}
+	}
+	return "", false
+}
+
+// Is reports whether the element has the given namespace and local name
+func (e *Element) Is(space, local string) bool {
+	return e.Space == space && e.Local == local
+}
+
+// Attr returns the value of an attribute without namespace
+func (e *Element) Attr(local string) (string, bool) {
+	for _, a := range e.Attrs {
+		if a.Space == "" && a.Local == local {
+			return a.Value, true
+		}
+	}
+	return "", false
+}
+
+// AttrValue returns the value of an attribute without namespace, or an empty string
+func (e *Element) AttrValue(local string) string {
+	v, _ := e.Attr(local)
+	return v
+}
+
+// SetAttr sets an attribute without namespace
+func (e *Element) SetAttr(local, value string) {
+	for i := range e.Attrs {
+		if e.Attrs[i].Space == "" && e.Attrs[i].Local == local {
+			e.Attrs[i].Value = value
+			return
+		}
+	}
+	e.Attrs = append(e.Attrs, Attr{Local: local, Value: value})
+}
+
+// ChildElements returns the child elements with the given namespace and local name
+func (e *Element) ChildElements(space, local string) []*Element {
+	var result []*Element
+	for _, c := range e.Children {
+		if el, ok := c.(*Element); ok && el.Is(space, local) {
+			result = append(result, el)
+		}
+	}
+	return result
+}
+
+// Child returns the first child element with the given namespace and local name
+func (e *Element) Child(space, local string) *Element {
+	for _, c := range e.Children {
+		if el, ok := c.(*Element); ok && el.Is(space, local) {
+			return el
+		}
+	}
+	return nil
+}
+
+// Text returns the concatenated text content of the element, comments are skipped so that
+// a comment injected into a signed value can not truncate it
+func (e *Element) Text() string {
+	var sb strings.Builder
+	for _, c := range e.Children {
+		switch v := c.(type) {
+		case CharData:
+			sb.WriteString(string(v))
+		case *Element:
+			sb.WriteString(v.Text())
+		}
+	}
+	return sb.String()
+}
+
+// AddChild appends a child element and sets its parent
+func (e *Element) AddChild(child *Element) {
+	child.Parent = e
+	e.Children = append(e.Children, child)
+}
+
+// InsertChild inserts a child element at the given position among all children
+func (e *Element) InsertChild(i int, child *Element) {
+	child.Parent = e
+	e.Children = append(e.Children, nil)
+	copy(e.Children[i+1:], e.Children[i:])
+	e.Children[i] = child
+}
+
+// RemoveChild removes a child element
+func (e *Element) RemoveChild(child *Element) {
+	for i, c := range e.Children {
+		if c == child {
+			e.Children = append(e.Children[:i], e.Children[i+1:]...)
+			child.Parent = nil
+			return
+		}
+	}
+}
+
+// walk visits the element and all its descendants in document order
+func (e *Element) walk(fn func(*Element)) {
+	fn(e)
+	for _, c := range e.Children {
+		if el, ok := c.(*Element); ok {
+			el.walk(fn)
+		}
+	}
+}
+
+// NewElement creates an element in the namespace bound to the prefix, declaring it when declare is set
+func NewElement(prefix, local, space string, declare bool) *Element {
+	el := &Element{Prefix: prefix, Local: local, Space: space}
+	if declare {
+		el.Decls = []NSDecl{{Prefix: prefix, URI: space}}
+	}
+	return el
+}
+
+// NewTextElement creates an element with text content
+func NewTextElement(prefix, local, space, text string) *Element {
+	el := NewElement(prefix, local, space, false)
+	el.Children = []Node{CharData(text)}
+	return el
+}
+
+// Bytes serializes the element with its original namespace declarations and attribute order
+func (e *Element) Bytes() []byte {
+	var buf bytes.Buffer
+	e.write(&buf)
+	return buf.Bytes()
+}
+
+func (e *Element) write(buf *bytes.Buffer) {
+	name := qualifiedName(e.Prefix, e.Local)
+	buf.WriteByte('<')
+	buf.WriteString(name)
+	for _, d := range e.Decls {
+		if d.Prefix == "" {
+			buf.WriteString(` xmlns="`)
+		} else {
+			buf.WriteString(` xmlns:` + d.Prefix + `="`)
+		}
+		escapeAttr(buf, d.URI)
+		buf.WriteByte('"')
+	}
+	for _, a := range e.Attrs {
+		buf.WriteString(" " + qualifiedName(a.Prefix, a.Local) + `="`)
+		escapeAttr(buf, a.Value)
+		buf.WriteByte('"')
+	}
+	buf.WriteByte('>')
+	for _, c := range e.Children {
+		switch v := c.(type) {
+		case *Element:
+			v.write(buf)
+		case CharData:
+			escapeText(buf, string(v))
+		case Comment:
+			buf.WriteString("<!--" + string(v) + "-->")
+		}
+	}
+	buf.WriteString("</" + name + ">")
+}
+
+func qualifiedName(prefix, local string) string {
+	if prefix == "" {
+		return local
+	}
+	return prefix + ":" + local
+}
+
+// escapeText escapes text content as required by canonical XML
+func escapeText(buf *bytes.Buffer, s string) {
+	for _, r := range s {
+		switch r {
+		case '&':
+			buf.WriteString("&amp;")
+		case '<':
+			buf.WriteString("&lt;")
+		case '>':
+			buf.WriteString("&gt;")
+		case '\r':
+			buf.WriteString("&#xD;")
+		default:
+			buf.WriteRune(r)
+		}
+	}
+}
+
+// escapeAttr escapes attribute values as required by canonical XML
+func escapeAttr(buf *bytes.Buffer, s string) {
+	for _, r := range s {
+		switch r {
+		case '&':
+			buf.WriteString("&amp;")
+		case '<':
+			buf.WriteString("&lt;")
+		case '"':
+			buf.WriteString("&quot;")
+		case '\t':
+			buf.WriteString("&#x9;")
+		case '\n':
+			buf.WriteString("&#xA;")
+		case '\r':
+			buf.WriteString("&#xD;")
+		default:
+			buf.WriteRune(r)
+		}
+	}
+}
@@ -15,14 +15,22 @@ import (
 	"github.com/coder-lulu/newbee-core/rpc/internal/logic/department"
 	"github.com/coder-lulu/newbee-core/rpc/internal/logic/dictionary"
 	"github.com/coder-lulu/newbee-core/rpc/internal/logic/dictionarydetail"
+	"github.com/coder-lulu/newbee-core/rpc/internal/logic/ldapprovider"
 	"github.com/coder-lulu/newbee-core/rpc/internal/logic/menu"
 	"github.com/coder-lulu/newbee-core/rpc/internal/logic/oauthaccount"
+	"github.com/coder-lulu/newbee-core/rpc/internal/logic/oauthclient"
 	"github.com/coder-lulu/newbee-core/rpc/internal/logic/oauthprovider"
+	"github.com/coder-lulu/newbee-core/rpc/internal/logic/oauthprovidertemplate"
+	"github.com/coder-lulu/newbee-core/rpc/internal/logic/oauthscope"
 	"github.com/coder-lulu/newbee-core/rpc/internal/logic/oauthsession"
 	"github.com/coder-lulu/newbee-core/rpc/internal/logic/position"
 	"github.com/coder-lulu/newbee-core/rpc/internal/logic/public"
 	"github.com/coder-lulu/newbee-core/rpc/internal/logic/role"
+	"github.com/coder-lulu/newbee-core/rpc/internal/logic/samlprovider"
+	"github.com/coder-lulu/newbee-core/rpc/internal/logic/scimtoken"
 	"github.com/coder-lulu/newbee-core/rpc/internal/logic/tenant"
+	"github.com/coder-lulu/newbee-core/rpc/internal/logic/tenantinitplugin"
+	"github.com/coder-lulu/newbee-core/rpc/internal/logic/tenantinittemplate"
 	"github.com/coder-lulu/newbee-core/rpc/internal/logic/token"
 	"github.com/coder-lulu/newbee-core/rpc/internal/logic/user"
 	"github.com/coder-lulu/newbee-core/rpc/internal/svc"
@@ -66,6 +74,11 @@ func (s *CoreServer) DeleteApi(ctx context.Context, in *core.IDsReq) (*core.Base
 	return l.DeleteApi(in)
 }
 
+func (s *CoreServer) ApiRegistration(ctx context.Context, in *core.ApiRegistrationReq) (*core.ApiRegistrationResp, error) {
+	l := api.NewApiRegistrationLogic(ctx, s.svcCtx)
+	return l.ApiRegistration(in)
+}
+
 // AuditLog management
 func (s *CoreServer) CreateAuditLog(ctx context.Context, in *core.AuditLogInfo) (*core.BaseUUIDResp, error) {
 	l := auditlog.NewCreateAuditLogLogic(ctx, s.svcCtx)
@@ -82,16 +95,26 @@ func (s *CoreServer) GetAuditLogById(ctx context.Context, in *core.UUIDReq) (*co
 	return l.GetAuditLogById(in)
 }
 
-func (s *CoreServer) DeleteAuditLog(ctx context.Context, in *core.UUIDsReq) (*core.BaseResp, error) {
-	l := auditlog.NewDeleteAuditLogLogic(ctx, s.svcCtx)
-	return l.DeleteAuditLog(in)
-}
-
 func (s *CoreServer) GetAuditLogStats(ctx context.Context, in *core.AuditLogStatsReq) (*core.AuditLogStatsResp, error) {
 	l := auditlog.NewGetAuditLogStatsLogic(ctx, s.svcCtx)
 	return l.GetAuditLogStats(in)
 }
 
+func (s *CoreServer) VerifyAuditLogChain(ctx context.Context, in *core.Empty) (*core.AuditLogVerifyResp, error) {
+	l := auditlog.NewVerifyAuditLogChainLogic(ctx, s.svcCtx)
+	return l.VerifyAuditLogChain(in)
+}
+
+func (s *CoreServer) GetAuditLogArchiveList(ctx context.Context, in *core.AuditLogArchiveListReq) (*core.AuditLogArchiveListResp, error) {
+	l := auditlog.NewGetAuditLogArchiveListLogic(ctx, s.svcCtx)
+	return l.GetAuditLogArchiveList(in)
+}
+
+func (s *CoreServer) RestoreAuditLogRange(ctx context.Context, in *core.AuditLogListReq) (*core.AuditLogListResp, error) {
+	l := auditlog.NewRestoreAuditLogRangeLogic(ctx, s.svcCtx)
+	return l.RestoreAuditLogRange(in)
+}
+
 func (s *CoreServer) GetMenuAuthority(ctx context.Context, in *core.IDReq) (*core.RoleMenuAuthorityResp, error) {
 	l := authority.NewGetMenuAuthorityLogic(ctx, s.svcCtx)
 	return l.GetMenuAuthority(in)
@@ -102,6 +125,26 @@ func (s *CoreServer) CreateOrUpdateMenuAuthority(ctx context.Context, in *core.R
 	return l.CreateOrUpdateMenuAuthority(in)
 }
 
+func (s *CoreServer) GetRolePolicies(ctx context.Context, in *core.IDReq) (*core.RolePolicyListResp, error) {
+	l := authority.NewGetRolePoliciesLogic(ctx, s.svcCtx)
+	return l.GetRolePolicies(in)
+}
+
+func (s *CoreServer) ReplaceRolePolicies(ctx context.Context, in *core.ReplaceRolePoliciesReq) (*core.ReplaceRolePoliciesResp, error) {
+	l := authority.NewReplaceRolePoliciesLogic(ctx, s.svcCtx)
+	return l.ReplaceRolePolicies(in)
+}
+
+func (s *CoreServer) ExportPolicyBundle(ctx context.Context, in *core.PolicyBundleExportReq) (*core.PolicyBundleResp, error) {
+	l := authority.NewExportPolicyBundleLogic(ctx, s.svcCtx)
+	return l.ExportPolicyBundle(in)
+}
+
+func (s *CoreServer) ImportPolicyBundle(ctx context.Context, in *core.PolicyBundleImportReq) (*core.PolicyBundleImportResp, error) {
+	l := authority.NewImportPolicyBundleLogic(ctx, s.svcCtx)
+	return l.ImportPolicyBundle(in)
+}
+
 func (s *CoreServer) InitDatabase(ctx context.Context, in *core.Empty) (*core.BaseResp, error) {
 	l := base.NewInitDatabaseLogic(ctx, s.svcCtx)
 	return l.InitDatabase(in)
@@ -302,6 +345,52 @@ func (s *CoreServer) GetDictionaryDetailByDictionaryName(ctx context.Context, in
 	return l.GetDictionaryDetailByDictionaryName(in)
 }
 
+// LdapProvider management
+func (s *CoreServer) CreateLdapProvider(ctx context.Context, in *core.LdapProviderInfo) (*core.BaseIDResp, error) {
+	l := ldapprovider.NewCreateLdapProviderLogic(ctx, s.svcCtx)
+	return l.CreateLdapProvider(in)
+}
+
+func (s *CoreServer) UpdateLdapProvider(ctx context.Context, in *core.LdapProviderInfo) (*core.BaseResp, error) {
+	l := ldapprovider.NewUpdateLdapProviderLogic(ctx, s.svcCtx)
+	return l.UpdateLdapProvider(in)
+}
+
+func (s *CoreServer) GetLdapProviderList(ctx context.Context, in *core.LdapProviderListReq) (*core.LdapProviderListResp, error) {
+	l := ldapprovider.NewGetLdapProviderListLogic(ctx, s.svcCtx)
+	return l.GetLdapProviderList(in)
+}
+
+func (s *CoreServer) GetLdapProviderById(ctx context.Context, in *core.IDReq) (*core.LdapProviderInfo, error) {
+	l := ldapprovider.NewGetLdapProviderByIdLogic(ctx, s.svcCtx)
+	return l.GetLdapProviderById(in)
+}
+
+func (s *CoreServer) DeleteLdapProvider(ctx context.Context, in *core.IDsReq) (*core.BaseResp, error) {
+	l := ldapprovider.NewDeleteLdapProviderLogic(ctx, s.svcCtx)
+	return l.DeleteLdapProvider(in)
+}
+
+func (s *CoreServer) LdapLogin(ctx context.Context, in *core.LdapLoginReq) (*core.UserInfo, error) {
+	l := ldapprovider.NewLdapLoginLogic(ctx, s.svcCtx)
+	return l.LdapLogin(in)
+}
+
+func (s *CoreServer) SyncLdapProvider(ctx context.Context, in *core.LdapSyncReq) (*core.LdapSyncRunInfo, error) {
+	l := ldapprovider.NewSyncLdapProviderLogic(ctx, s.svcCtx)
+	return l.SyncLdapProvider(in)
+}
+
+func (s *CoreServer) GetLdapSyncRunList(ctx context.Context, in *core.LdapSyncRunListReq) (*core.LdapSyncRunListResp, error) {
+	l := ldapprovider.NewGetLdapSyncRunListLogic(ctx, s.svcCtx)
+	return l.GetLdapSyncRunList(in)
+}
+
+func (s *CoreServer) GetLdapSyncRunById(ctx context.Context, in *core.IDReq) (*core.LdapSyncRunInfo, error) {
+	l := ldapprovider.NewGetLdapSyncRunByIdLogic(ctx, s.svcCtx)
+	return l.GetLdapSyncRunById(in)
+}
+
 func (s *CoreServer) CreateMenu(ctx context.Context, in *core.MenuInfo) (*core.BaseIDResp, error) {
 	l := menu.NewCreateMenuLogic(ctx, s.svcCtx)
 	return l.CreateMenu(in)
@@ -332,6 +421,67 @@ func (s *CoreServer) GetMenuList(ctx context.Context, in *core.PageInfoReq) (*co
 	return l.GetMenuList(in)
 }
 
+// OauthClient management
+func (s *CoreServer) CreateOauthClient(ctx context.Context, in *core.OauthClientInfo) (*core.OauthClientSecretResp, error) {
+	l := oauthclient.NewCreateOauthClientLogic(ctx, s.svcCtx)
+	return l.CreateOauthClient(in)
+}
+
+func (s *CoreServer) UpdateOauthClient(ctx context.Context, in *core.OauthClientInfo) (*core.BaseResp, error) {
+	l := oauthclient.NewUpdateOauthClientLogic(ctx, s.svcCtx)
+	return l.UpdateOauthClient(in)
+}
+
+func (s *CoreServer) GetOauthClientList(ctx context.Context, in *core.OauthClientListReq) (*core.OauthClientListResp, error) {
+	l := oauthclient.NewGetOauthClientListLogic(ctx, s.svcCtx)
+	return l.GetOauthClientList(in)
+}
+
+func (s *CoreServer) GetOauthClientById(ctx context.Context, in *core.IDReq) (*core.OauthClientInfo, error) {
+	l := oauthclient.NewGetOauthClientByIdLogic(ctx, s.svcCtx)
+	return l.GetOauthClientById(in)
+}
+
+func (s *CoreServer) DeleteOauthClient(ctx context.Context, in *core.IDsReq) (*core.BaseResp, error) {
+	l := oauthclient.NewDeleteOauthClientLogic(ctx, s.svcCtx)
+	return l.DeleteOauthClient(in)
+}
+
+func (s *CoreServer) ResetOauthClientSecret(ctx context.Context, in *core.IDReq) (*core.OauthClientSecretResp, error) {
+	l := oauthclient.NewResetOauthClientSecretLogic(ctx, s.svcCtx)
+	return l.ResetOauthClientSecret(in)
+}
+
+func (s *CoreServer) GetOauthClientByClientId(ctx context.Context, in *core.OauthClientIdReq) (*core.OauthClientInfo, error) {
+	l := oauthclient.NewGetOauthClientByClientIdLogic(ctx, s.svcCtx)
+	return l.GetOauthClientByClientId(in)
+}
+
+func (s *CoreServer) AuthenticateOauthClient(ctx context.Context, in *core.OauthClientAuthReq) (*core.OauthClientInfo, error) {
+	l := oauthclient.NewAuthenticateOauthClientLogic(ctx, s.svcCtx)
+	return l.AuthenticateOauthClient(in)
+}
+
+func (s *CoreServer) AuthorizeOauthClient(ctx context.Context, in *core.OauthAuthorizeReq) (*core.OauthAuthorizeResp, error) {
+	l := oauthclient.NewAuthorizeOauthClientLogic(ctx, s.svcCtx)
+	return l.AuthorizeOauthClient(in)
+}
+
+func (s *CoreServer) ExchangeOauthAuthorizationCode(ctx context.Context, in *core.OauthCodeExchangeReq) (*core.OauthGrantInfo, error) {
+	l := oauthclient.NewExchangeOauthAuthorizationCodeLogic(ctx, s.svcCtx)
+	return l.ExchangeOauthAuthorizationCode(in)
+}
+
+func (s *CoreServer) GetOauthConsentList(ctx context.Context, in *core.OauthConsentListReq) (*core.OauthConsentListResp, error) {
+	l := oauthclient.NewGetOauthConsentListLogic(ctx, s.svcCtx)
+	return l.GetOauthConsentList(in)
+}
+
+func (s *CoreServer) DeleteOauthConsent(ctx context.Context, in *core.IDsReq) (*core.BaseResp, error) {
+	l := oauthclient.NewDeleteOauthConsentLogic(ctx, s.svcCtx)
+	return l.DeleteOauthConsent(in)
+}
+
 // OauthProvider management
 func (s *CoreServer) CreateOauthProvider(ctx context.Context, in *core.OauthProviderInfo) (*core.BaseIDResp, error) {
 	l := oauthprovider.NewCreateOauthProviderLogic(ctx, s.svcCtx)
@@ -363,11 +513,21 @@ func (s *CoreServer) OauthLogin(ctx context.Context, in *core.OauthLoginReq) (*c
 	return l.OauthLogin(in)
 }
 
-func (s *CoreServer) OauthCallback(ctx context.Context, in *core.CallbackReq) (*core.UserInfo, error) {
+func (s *CoreServer) OauthCallback(ctx context.Context, in *core.CallbackReq) (*core.OauthCallbackResp, error) {
 	l := oauthprovider.NewOauthCallbackLogic(ctx, s.svcCtx)
 	return l.OauthCallback(in)
 }
 
+func (s *CoreServer) PreviewOauthClaimMapping(ctx context.Context, in *core.OauthClaimMappingPreviewReq) (*core.OauthClaimMappingPreviewResp, error) {
+	l := oauthprovider.NewPreviewOauthClaimMappingLogic(ctx, s.svcCtx)
+	return l.PreviewOauthClaimMapping(in)
+}
+
+func (s *CoreServer) OauthWebhook(ctx context.Context, in *core.OauthWebhookReq) (*core.OauthWebhookResp, error) {
+	l := oauthprovider.NewOauthWebhookLogic(ctx, s.svcCtx)
+	return l.OauthWebhook(in)
+}
+
 // OAuth Account Binding management
 func (s *CoreServer) CreateOauthAccount(ctx context.Context, in *core.OauthAccountInfo) (*core.BaseIDResp, error) {
 	l := oauthaccount.NewCreateOauthAccountLogic(ctx, s.svcCtx)
@@ -409,6 +569,11 @@ func (s *CoreServer) GetUserOauthAccounts(ctx context.Context, in *core.GetUserO
 	return l.GetUserOauthAccounts(in)
 }
 
+func (s *CoreServer) GetOauthAccessToken(ctx context.Context, in *core.OauthAccessTokenReq) (*core.OauthAccessTokenResp, error) {
+	l := oauthaccount.NewGetOauthAccessTokenLogic(ctx, s.svcCtx)
+	return l.GetOauthAccessToken(in)
+}
+
 // OAuth Session management
 func (s *CoreServer) CreateOauthSession(ctx context.Context, in *core.CreateOauthSessionReq) (*core.BaseIDResp, error) {
 	l := oauthsession.NewCreateOauthSessionLogic(ctx, s.svcCtx)
@@ -430,6 +595,63 @@ func (s *CoreServer) DeleteOauthSession(ctx context.Context, in *core.IDReq) (*c
 	return l.DeleteOauthSession(in)
 }
 
+// OauthProviderTemplate management
+func (s *CoreServer) CreateOauthProviderTemplate(ctx context.Context, in *core.OauthProviderTemplateInfo) (*core.BaseIDResp, error) {
+	l := oauthprovidertemplate.NewCreateOauthProviderTemplateLogic(ctx, s.svcCtx)
+	return l.CreateOauthProviderTemplate(in)
+}
+
+func (s *CoreServer) UpdateOauthProviderTemplate(ctx context.Context, in *core.OauthProviderTemplateInfo) (*core.BaseResp, error) {
+	l := oauthprovidertemplate.NewUpdateOauthProviderTemplateLogic(ctx, s.svcCtx)
+	return l.UpdateOauthProviderTemplate(in)
+}
+
+func (s *CoreServer) GetOauthProviderTemplateList(ctx context.Context, in *core.OauthProviderTemplateListReq) (*core.OauthProviderTemplateListResp, error) {
+	l := oauthprovidertemplate.NewGetOauthProviderTemplateListLogic(ctx, s.svcCtx)
+	return l.GetOauthProviderTemplateList(in)
+}
+
+func (s *CoreServer) GetOauthProviderTemplateById(ctx context.Context, in *core.IDReq) (*core.OauthProviderTemplateInfo, error) {
+	l := oauthprovidertemplate.NewGetOauthProviderTemplateByIdLogic(ctx, s.svcCtx)
+	return l.GetOauthProviderTemplateById(in)
+}
+
+func (s *CoreServer) DeleteOauthProviderTemplate(ctx context.Context, in *core.IDsReq) (*core.BaseResp, error) {
+	l := oauthprovidertemplate.NewDeleteOauthProviderTemplateLogic(ctx, s.svcCtx)
+	return l.DeleteOauthProviderTemplate(in)
+}
+
+func (s *CoreServer) EnableOauthProviderTemplate(ctx context.Context, in *core.EnableOauthProviderTemplateReq) (*core.BaseIDResp, error) {
+	l := oauthprovidertemplate.NewEnableOauthProviderTemplateLogic(ctx, s.svcCtx)
+	return l.EnableOauthProviderTemplate(in)
+}
+
+// OauthScope management
+func (s *CoreServer) CreateOauthScope(ctx context.Context, in *core.OauthScopeInfo) (*core.BaseIDResp, error) {
+	l := oauthscope.NewCreateOauthScopeLogic(ctx, s.svcCtx)
+	return l.CreateOauthScope(in)
+}
+
+func (s *CoreServer) UpdateOauthScope(ctx context.Context, in *core.OauthScopeInfo) (*core.BaseResp, error) {
+	l := oauthscope.NewUpdateOauthScopeLogic(ctx, s.svcCtx)
+	return l.UpdateOauthScope(in)
+}
+
+func (s *CoreServer) GetOauthScopeList(ctx context.Context, in *core.OauthScopeListReq) (*core.OauthScopeListResp, error) {
+	l := oauthscope.NewGetOauthScopeListLogic(ctx, s.svcCtx)
+	return l.GetOauthScopeList(in)
+}
+
+func (s *CoreServer) GetOauthScopeById(ctx context.Context, in *core.IDReq) (*core.OauthScopeInfo, error) {
+	l := oauthscope.NewGetOauthScopeByIdLogic(ctx, s.svcCtx)
+	return l.GetOauthScopeById(in)
+}
+
+func (s *CoreServer) DeleteOauthScope(ctx context.Context, in *core.IDsReq) (*core.BaseResp, error) {
+	l := oauthscope.NewDeleteOauthScopeLogic(ctx, s.svcCtx)
+	return l.DeleteOauthScope(in)
+}
+
 // Position management
 func (s *CoreServer) CreatePosition(ctx context.Context, in *core.PositionInfo) (*core.BaseIDResp, error) {
 	l := position.NewCreatePositionLogic(ctx, s.svcCtx)
@@ -482,6 +704,12 @@ func (s *CoreServer) DeleteRole(ctx context.Context, in *core.IDsReq) (*core.Bas
 	return l.DeleteRole(in)
 }
 
+// Delete the role after removing it from its users
+func (s *CoreServer) DeleteRoleWithUsers(ctx context.Context, in *core.IDReq) (*core.BaseResp, error) {
+	l := role.NewDeleteRoleWithUsersLogic(ctx, s.svcCtx)
+	return l.DeleteRoleWithUsers(in)
+}
+
 func (s *CoreServer) InitRoleDataPermToRedis(ctx context.Context, in *core.Empty) (*core.BaseResp, error) {
 	l := role.NewInitRoleDataPermToRedisLogic(ctx, s.svcCtx)
 	return l.InitRoleDataPermToRedis(in)
@@ -507,6 +735,98 @@ func (s *CoreServer) ChangeRoleStatus(ctx context.Context, in *core.RoleStatusCh
 	return l.ChangeRoleStatus(in)
 }
 
+func (s *CoreServer) SetRoleParents(ctx context.Context, in *core.RoleParentsReq) (*core.BaseResp, error) {
+	l := role.NewSetRoleParentsLogic(ctx, s.svcCtx)
+	return l.SetRoleParents(in)
+}
+
+func (s *CoreServer) GetRoleParents(ctx context.Context, in *core.IDReq) (*core.RoleParentsResp, error) {
+	l := role.NewGetRoleParentsLogic(ctx, s.svcCtx)
+	return l.GetRoleParents(in)
+}
+
+func (s *CoreServer) GetRoleEffectivePermissions(ctx context.Context, in *core.IDReq) (*core.RoleEffectivePermissionsResp, error) {
+	l := role.NewGetRoleEffectivePermissionsLogic(ctx, s.svcCtx)
+	return l.GetRoleEffectivePermissions(in)
+}
+
+// SamlProvider management
+func (s *CoreServer) CreateSamlProvider(ctx context.Context, in *core.SamlProviderInfo) (*core.BaseIDResp, error) {
+	l := samlprovider.NewCreateSamlProviderLogic(ctx, s.svcCtx)
+	return l.CreateSamlProvider(in)
+}
+
+func (s *CoreServer) UpdateSamlProvider(ctx context.Context, in *core.SamlProviderInfo) (*core.BaseResp, error) {
+	l := samlprovider.NewUpdateSamlProviderLogic(ctx, s.svcCtx)
+	return l.UpdateSamlProvider(in)
+}
+
+func (s *CoreServer) GetSamlProviderList(ctx context.Context, in *core.SamlProviderListReq) (*core.SamlProviderListResp, error) {
+	l := samlprovider.NewGetSamlProviderListLogic(ctx, s.svcCtx)
+	return l.GetSamlProviderList(in)
+}
+
+func (s *CoreServer) GetSamlProviderById(ctx context.Context, in *core.IDReq) (*core.SamlProviderInfo, error) {
+	l := samlprovider.NewGetSamlProviderByIdLogic(ctx, s.svcCtx)
+	return l.GetSamlProviderById(in)
+}
+
+func (s *CoreServer) DeleteSamlProvider(ctx context.Context, in *core.IDsReq) (*core.BaseResp, error) {
+	l := samlprovider.NewDeleteSamlProviderLogic(ctx, s.svcCtx)
+	return l.DeleteSamlProvider(in)
+}
+
+func (s *CoreServer) ImportSamlIdpMetadata(ctx context.Context, in *core.SamlMetadataImportReq) (*core.BaseResp, error) {
+	l := samlprovider.NewImportSamlIdpMetadataLogic(ctx, s.svcCtx)
+	return l.ImportSamlIdpMetadata(in)
+}
+
+func (s *CoreServer) GetSamlSpMetadata(ctx context.Context, in *core.SamlSpMetadataReq) (*core.SamlSpMetadataResp, error) {
+	l := samlprovider.NewGetSamlSpMetadataLogic(ctx, s.svcCtx)
+	return l.GetSamlSpMetadata(in)
+}
+
+func (s *CoreServer) SamlLogin(ctx context.Context, in *core.SamlLoginReq) (*core.SamlLoginResp, error) {
+	l := samlprovider.NewSamlLoginLogic(ctx, s.svcCtx)
+	return l.SamlLogin(in)
+}
+
+func (s *CoreServer) SamlAcs(ctx context.Context, in *core.SamlAcsReq) (*core.SamlAcsResp, error) {
+	l := samlprovider.NewSamlAcsLogic(ctx, s.svcCtx)
+	return l.SamlAcs(in)
+}
+
+// ScimToken management
+func (s *CoreServer) CreateScimToken(ctx context.Context, in *core.ScimTokenInfo) (*core.ScimTokenCreateResp, error) {
+	l := scimtoken.NewCreateScimTokenLogic(ctx, s.svcCtx)
+	return l.CreateScimToken(in)
+}
+
+func (s *CoreServer) UpdateScimToken(ctx context.Context, in *core.ScimTokenInfo) (*core.BaseResp, error) {
+	l := scimtoken.NewUpdateScimTokenLogic(ctx, s.svcCtx)
+	return l.UpdateScimToken(in)
+}
+
+func (s *CoreServer) GetScimTokenList(ctx context.Context, in *core.ScimTokenListReq) (*core.ScimTokenListResp, error) {
+	l := scimtoken.NewGetScimTokenListLogic(ctx, s.svcCtx)
+	return l.GetScimTokenList(in)
+}
+
+func (s *CoreServer) GetScimTokenById(ctx context.Context, in *core.IDReq) (*core.ScimTokenInfo, error) {
+	l := scimtoken.NewGetScimTokenByIdLogic(ctx, s.svcCtx)
+	return l.GetScimTokenById(in)
+}
+
+func (s *CoreServer) DeleteScimToken(ctx context.Context, in *core.IDsReq) (*core.BaseResp, error) {
+	l := scimtoken.NewDeleteScimTokenLogic(ctx, s.svcCtx)
+	return l.DeleteScimToken(in)
+}
+
+func (s *CoreServer) AuthenticateScimToken(ctx context.Context, in *core.ScimTokenAuthReq) (*core.ScimTokenInfo, error) {
+	l := scimtoken.NewAuthenticateScimTokenLogic(ctx, s.svcCtx)
+	return l.AuthenticateScimToken(in)
+}
+
 // Tenant management
 func (s *CoreServer) CreateTenant(ctx context.Context, in *core.TenantInfo) (*core.BaseIDResp, error) {
 	l := tenant.NewCreateTenantLogic(ctx, s.svcCtx)
@@ -543,16 +863,123 @@ func (s *CoreServer) UpdateTenantStatus(ctx context.Context, in *core.TenantStat
 	return l.UpdateTenantStatus(in)
 }
 
-func (s *CoreServer) InitTenant(ctx context.Context, in *core.TenantInitReq) (*core.BaseResp, error) {
+func (s *CoreServer) InitTenant(ctx context.Context, in *core.TenantInitReq) (*core.BaseIDResp, error) {
 	l := tenant.NewInitTenantLogic(ctx, s.svcCtx)
 	return l.InitTenant(in)
 }
 
+func (s *CoreServer) GetTenantInitJobById(ctx context.Context, in *core.IDReq) (*core.TenantInitJobInfo, error) {
+	l := tenant.NewGetTenantInitJobByIdLogic(ctx, s.svcCtx)
+	return l.GetTenantInitJobById(in)
+}
+
+func (s *CoreServer) GetTenantInitJobList(ctx context.Context, in *core.TenantInitJobListReq) (*core.TenantInitJobListResp, error) {
+	l := tenant.NewGetTenantInitJobListLogic(ctx, s.svcCtx)
+	return l.GetTenantInitJobList(in)
+}
+
+func (s *CoreServer) RetryTenantInitJob(ctx context.Context, in *core.TenantInitJobRetryReq) (*core.BaseIDResp, error) {
+	l := tenant.NewRetryTenantInitJobLogic(ctx, s.svcCtx)
+	return l.RetryTenantInitJob(in)
+}
+
+func (s *CoreServer) DiagnoseTenants(ctx context.Context, in *core.TenantDoctorReq) (*core.TenantDoctorResp, error) {
+	l := tenant.NewDiagnoseTenantsLogic(ctx, s.svcCtx)
+	return l.DiagnoseTenants(in)
+}
+
+func (s *CoreServer) RepairTenant(ctx context.Context, in *core.TenantRepairReq) (*core.TenantDoctorResp, error) {
+	l := tenant.NewRepairTenantLogic(ctx, s.svcCtx)
+	return l.RepairTenant(in)
+}
+
+func (s *CoreServer) StartImpersonation(ctx context.Context, in *core.ImpersonationStartReq) (*core.ImpersonationSessionInfo, error) {
+	l := tenant.NewStartImpersonationLogic(ctx, s.svcCtx)
+	return l.StartImpersonation(in)
+}
+
+func (s *CoreServer) ReviewImpersonation(ctx context.Context, in *core.ImpersonationReviewReq) (*core.ImpersonationSessionInfo, error) {
+	l := tenant.NewReviewImpersonationLogic(ctx, s.svcCtx)
+	return l.ReviewImpersonation(in)
+}
+
+func (s *CoreServer) EndImpersonation(ctx context.Context, in *core.UUIDReq) (*core.BaseResp, error) {
+	l := tenant.NewEndImpersonationLogic(ctx, s.svcCtx)
+	return l.EndImpersonation(in)
+}
+
+func (s *CoreServer) GetActiveImpersonation(ctx context.Context, in *core.UUIDReq) (*core.ImpersonationSessionInfo, error) {
+	l := tenant.NewGetActiveImpersonationLogic(ctx, s.svcCtx)
+	return l.GetActiveImpersonation(in)
+}
+
+func (s *CoreServer) GetImpersonationSessionList(ctx context.Context, in *core.ImpersonationSessionListReq) (*core.ImpersonationSessionListResp, error) {
+	l := tenant.NewGetImpersonationSessionListLogic(ctx, s.svcCtx)
+	return l.GetImpersonationSessionList(in)
+}
+
 func (s *CoreServer) GetPublicTenantList(ctx context.Context, in *core.Empty) (*core.PublicTenantListResp, error) {
 	l := public.NewGetPublicTenantListLogic(ctx, s.svcCtx)
 	return l.GetPublicTenantList(in)
 }
 
+// TenantInitPlugin management
+func (s *CoreServer) RegisterTenantInitPlugin(ctx context.Context, in *core.TenantInitPluginInfo) (*core.BaseIDResp, error) {
+	l := tenantinitplugin.NewRegisterTenantInitPluginLogic(ctx, s.svcCtx)
+	return l.RegisterTenantInitPlugin(in)
+}
+
+func (s *CoreServer) UpdateTenantInitPlugin(ctx context.Context, in *core.TenantInitPluginInfo) (*core.BaseResp, error) {
+	l := tenantinitplugin.NewUpdateTenantInitPluginLogic(ctx, s.svcCtx)
+	return l.UpdateTenantInitPlugin(in)
+}
+
+func (s *CoreServer) GetTenantInitPluginList(ctx context.Context, in *core.TenantInitPluginListReq) (*core.TenantInitPluginListResp, error) {
+	l := tenantinitplugin.NewGetTenantInitPluginListLogic(ctx, s.svcCtx)
+	return l.GetTenantInitPluginList(in)
+}
+
+func (s *CoreServer) GetTenantInitPluginById(ctx context.Context, in *core.IDReq) (*core.TenantInitPluginInfo, error) {
+	l := tenantinitplugin.NewGetTenantInitPluginByIdLogic(ctx, s.svcCtx)
+	return l.GetTenantInitPluginById(in)
+}
+
+func (s *CoreServer) DeleteTenantInitPlugin(ctx context.Context, in *core.IDsReq) (*core.BaseResp, error) {
+	l := tenantinitplugin.NewDeleteTenantInitPluginLogic(ctx, s.svcCtx)
+	return l.DeleteTenantInitPlugin(in)
+}
+
+// TenantInitTemplate management
+func (s *CoreServer) CreateTenantInitTemplate(ctx context.Context, in *core.TenantInitTemplateInfo) (*core.BaseIDResp, error) {
+	l := tenantinittemplate.NewCreateTenantInitTemplateLogic(ctx, s.svcCtx)
+	return l.CreateTenantInitTemplate(in)
+}
+
+func (s *CoreServer) UpdateTenantInitTemplate(ctx context.Context, in *core.TenantInitTemplateInfo) (*core.BaseResp, error) {
+	l := tenantinittemplate.NewUpdateTenantInitTemplateLogic(ctx, s.svcCtx)
+	return l.UpdateTenantInitTemplate(in)
+}
+
+func (s *CoreServer) GetTenantInitTemplateList(ctx context.Context, in *core.TenantInitTemplateListReq) (*core.TenantInitTemplateListResp, error) {
+	l := tenantinittemplate.NewGetTenantInitTemplateListLogic(ctx, s.svcCtx)
+	return l.GetTenantInitTemplateList(in)
+}
+
+func (s *CoreServer) GetTenantInitTemplateById(ctx context.Context, in *core.IDReq) (*core.TenantInitTemplateInfo, error) {
+	l := tenantinittemplate.NewGetTenantInitTemplateByIdLogic(ctx, s.svcCtx)
+	return l.GetTenantInitTemplateById(in)
+}
+
+func (s *CoreServer) DeleteTenantInitTemplate(ctx context.Context, in *core.IDsReq) (*core.BaseResp, error) {
+	l := tenantinittemplate.NewDeleteTenantInitTemplateLogic(ctx, s.svcCtx)
+	return l.DeleteTenantInitTemplate(in)
+}
+
+func (s *CoreServer) PreviewTenantInitTemplate(ctx context.Context, in *core.TenantInitTemplatePreviewReq) (*core.TenantInitTemplatePreviewResp, error) {
+	l := tenantinittemplate.NewPreviewTenantInitTemplateLogic(ctx, s.svcCtx)
+	return l.PreviewTenantInitTemplate(in)
+}
+
 // Token management
 func (s *CoreServer) CreateToken(ctx context.Context, in *core.TokenInfo) (*core.BaseUUIDResp, error) {
 	l := token.NewCreateTokenLogic(ctx, s.svcCtx)
@@ -584,6 +1011,21 @@ func (s *CoreServer) UpdateToken(ctx context.Context, in *core.TokenInfo) (*core
 	return l.UpdateToken(in)
 }
 
+func (s *CoreServer) GetUserSessionList(ctx context.Context, in *core.UserSessionListReq) (*core.TokenListResp, error) {
+	l := token.NewGetUserSessionListLogic(ctx, s.svcCtx)
+	return l.GetUserSessionList(in)
+}
+
+func (s *CoreServer) RevokeUserSession(ctx context.Context, in *core.UserSessionRevokeReq) (*core.BaseResp, error) {
+	l := token.NewRevokeUserSessionLogic(ctx, s.svcCtx)
+	return l.RevokeUserSession(in)
+}
+
+func (s *CoreServer) TouchToken(ctx context.Context, in *core.TokenTouchReq) (*core.BaseResp, error) {
+	l := token.NewTouchTokenLogic(ctx, s.svcCtx)
+	return l.TouchToken(in)
+}
+
 // User management
 func (s *CoreServer) CreateUser(ctx context.Context, in *core.UserInfo) (*core.BaseUUIDResp, error) {
 	l := user.NewCreateUserLogic(ctx, s.svcCtx)
@@ -4,6 +4,7 @@ import (
 	"context"
 	"crypto/rand"
 	"crypto/sha256"
+	"crypto/subtle"
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
@@ -116,6 +117,30 @@ func (p *PKCEManager) ValidateChallenge(state string, codeVerifier string) error
 	return nil
 }
 
+// VerifyChallenge validates the code verifier sent by a client against the challenge stored for
+// the authorization code, which is the reverse of ValidateChallenge when core is the authorization server.
+// Only S256 is accepted, the challenge is deleted whether the verifier matches or not.
+func (p *PKCEManager) VerifyChallenge(state string, codeVerifier string) error {
+	challenge, err := p.GetChallenge(state)
+	if err != nil {
+		return err
+	}
+	_ = p.DeleteChallenge(state)
+
+	if challenge.Method != "S256" {
+		return fmt.Errorf("unsupported code challenge method: %s", challenge.Method)
+	}
+	// RFC 7636 4.1: 43-128 characters
+	if len(codeVerifier) < 43 || len(codeVerifier) > 128 {
+		return fmt.Errorf("invalid code verifier")
+	}
+	if subtle.ConstantTimeCompare([]byte(sha256Challenge(codeVerifier)), []byte(challenge.Challenge)) != 1 {
+		return fmt.Errorf("invalid code verifier")
+	}
+
+	return nil
+}
+
 // generateCodeVerifier generates a cryptographically secure code verifier
 func generateCodeVerifier() (string, error) {
 	// Generate 32 random bytes (256 bits)
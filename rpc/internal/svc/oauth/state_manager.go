@@ -2,10 +2,14 @@ package oauth
 
 import (
 	"context"
+	"crypto/hmac"
 	"crypto/rand"
+	"crypto/sha256"
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/redis/go-redis/v9"
@@ -13,11 +17,14 @@ import (
 
 // StateInfo represents OAuth state information
 type StateInfo struct {
-	UserID     uint64                 `json:"user_id"`
-	ProviderID uint64                 `json:"provider_id"`
-	Extra      map[string]interface{} `json:"extra"`
-	CreatedAt  time.Time             `json:"created_at"`
-	ExpiresAt  time.Time             `json:"expires_at"`
+	UserID      uint64                 `json:"user_id"`
+	ProviderID  uint64                 `json:"provider_id"`
+	TenantID    uint64                 `json:"tenant_id,omitempty"`    // tenant of a signed login state
+	RedirectURI string                 `json:"redirect_uri,omitempty"` // redirect URI the signed login state is bound to
+	Nonce       string                 `json:"nonce"`                  // OIDC nonce, must match the id_token issued for this state
+	Extra       map[string]interface{} `json:"extra"`
+	CreatedAt   time.Time              `json:"created_at"`
+	ExpiresAt   time.Time              `json:"expires_at"`
 }
 
 // StateManager manages OAuth state parameters
@@ -44,29 +51,147 @@ func (s *StateManager) GenerateState(userID uint64, providerID uint64, extra map
 		return "", fmt.Errorf("failed to generate state: %v", err)
 	}
 
+	if _, err = s.BindState(context.Background(), state, userID, providerID, extra); err != nil {
+		return "", err
+	}
+
+	return state, nil
+}
+
+// BindState stores the state info under a state generated by the caller, together with a fresh nonce.
+// A state can only be bound once, so the nonce of a pending login cannot be replaced.
+func (s *StateManager) BindState(ctx context.Context, state string, userID uint64, providerID uint64, extra map[string]interface{}) (*StateInfo, error) {
+	if state == "" {
+		return nil, fmt.Errorf("empty state parameter")
+	}
+
+	nonce, err := generateSecureState()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate nonce: %v", err)
+	}
+
 	// Create state info
 	now := time.Now()
 	stateInfo := &StateInfo{
 		UserID:     userID,
 		ProviderID: providerID,
+		Nonce:      nonce,
 		Extra:      extra,
 		CreatedAt:  now,
 		ExpiresAt:  now.Add(s.expiration),
 	}
 
-	// Store state info in Redis
+	if err = s.store(ctx, state, stateInfo); err != nil {
+		return nil, err
+	}
+
+	return stateInfo, nil
+}
+
+// IssueState generates a login state signed with the secret key. The tenant and the provider are
+// carried in the state, the redirect URI is only covered by the signature, so a state cannot be
+// replayed against another tenant, provider or redirect URI.
+func (s *StateManager) IssueState(ctx context.Context, tenantID, providerID uint64, redirectURI string, extra map[string]interface{}) (string, *StateInfo, error) {
+	id, err := generateSecureState()
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to generate state: %v", err)
+	}
+
+	nonce, err := generateSecureState()
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to generate nonce: %v", err)
+	}
+
+	payload := fmt.Sprintf("%s.%d.%d", id, tenantID, providerID)
+	state := payload + "." + s.sign(payload, redirectURI)
+
+	now := time.Now()
+	stateInfo := &StateInfo{
+		ProviderID:  providerID,
+		TenantID:    tenantID,
+		RedirectURI: redirectURI,
+		Nonce:       nonce,
+		Extra:       extra,
+		CreatedAt:   now,
+		ExpiresAt:   now.Add(s.expiration),
+	}
+
+	if err = s.store(ctx, state, stateInfo); err != nil {
+		return "", nil, err
+	}
+
+	return state, stateInfo, nil
+}
+
+// ParseState returns the tenant and the provider carried in a signed state without verifying it,
+// the caller loads the provider and then verifies the state with ConsumeSignedState
+func (s *StateManager) ParseState(state string) (tenantID, providerID uint64, err error) {
+	parts := strings.Split(state, ".")
+	if len(parts) != 4 || parts[0] == "" || parts[3] == "" {
+		return 0, 0, fmt.Errorf("malformed state parameter")
+	}
+
+	if tenantID, err = strconv.ParseUint(parts[1], 10, 64); err != nil {
+		return 0, 0, fmt.Errorf("malformed state parameter")
+	}
+	if providerID, err = strconv.ParseUint(parts[2], 10, 64); err != nil {
+		return 0, 0, fmt.Errorf("malformed state parameter")
+	}
+
+	return tenantID, providerID, nil
+}
+
+// ConsumeSignedState verifies the signature of a state issued by IssueState against the redirect URI
+// and consumes it. Forged states are rejected before Redis is queried.
+func (s *StateManager) ConsumeSignedState(ctx context.Context, state, redirectURI string) (*StateInfo, error) {
+	tenantID, providerID, err := s.ParseState(state)
+	if err != nil {
+		return nil, err
+	}
+
+	i := strings.LastIndexByte(state, '.')
+	if !hmac.Equal([]byte(state[i+1:]), []byte(s.sign(state[:i], redirectURI))) {
+		return nil, fmt.Errorf("invalid state signature")
+	}
+
+	stateInfo, err := s.consume(ctx, state)
+	if err != nil {
+		return nil, err
+	}
+
+	if stateInfo.TenantID != tenantID || stateInfo.ProviderID != providerID || stateInfo.RedirectURI != redirectURI {
+		return nil, fmt.Errorf("state parameter does not match the login")
+	}
+
+	return stateInfo, nil
+}
+
+// store saves the state info, a state can only be stored once
+func (s *StateManager) store(ctx context.Context, state string, stateInfo *StateInfo) error {
 	key := fmt.Sprintf("oauth:state:%s", state)
 	data, err := json.Marshal(stateInfo)
 	if err != nil {
-		return "", fmt.Errorf("failed to marshal state info: %v", err)
+		return fmt.Errorf("failed to marshal state info: %v", err)
 	}
 
-	err = s.redis.Set(context.Background(), key, data, s.expiration).Err()
+	ok, err := s.redis.SetNX(ctx, key, data, s.expiration).Result()
 	if err != nil {
-		return "", fmt.Errorf("failed to store state: %v", err)
+		return fmt.Errorf("failed to store state: %v", err)
+	}
+	if !ok {
+		return fmt.Errorf("state parameter already in use")
 	}
 
-	return state, nil
+	return nil
+}
+
+// sign returns the base64url HMAC-SHA256 of the state payload and the redirect URI
+func (s *StateManager) sign(payload, redirectURI string) string {
+	mac := hmac.New(sha256.New, s.secretKey)
+	mac.Write([]byte(payload))
+	mac.Write([]byte{'\n'})
+	mac.Write([]byte(redirectURI))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
 }
 
 // ValidateState validates and retrieves state information
@@ -115,15 +240,35 @@ func (s *StateManager) DeleteState(state string) error {
 
 // ConsumeState validates and deletes a state parameter (one-time use)
 func (s *StateManager) ConsumeState(state string) (*StateInfo, error) {
-	stateInfo, err := s.ValidateState(state)
+	if state == "" {
+		return nil, fmt.Errorf("empty state parameter")
+	}
+
+	return s.consume(context.Background(), state)
+}
+
+// consume removes and returns the state info
+func (s *StateManager) consume(ctx context.Context, state string) (*StateInfo, error) {
+	// GETDEL 保证并发回调时只有一个请求能拿到 state
+	key := fmt.Sprintf("oauth:state:%s", state)
+	data, err := s.redis.GetDel(ctx, key).Result()
 	if err != nil {
-		return nil, err
+		if err == redis.Nil {
+			return nil, fmt.Errorf("invalid or expired state parameter")
+		}
+		return nil, fmt.Errorf("failed to get state: %v", err)
 	}
 
-	// Delete the state after successful validation
-	_ = s.DeleteState(state)
+	var stateInfo StateInfo
+	if err = json.Unmarshal([]byte(data), &stateInfo); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal state info: %v", err)
+	}
 
-	return stateInfo, nil
+	if time.Now().After(stateInfo.ExpiresAt) {
+		return nil, fmt.Errorf("state parameter has expired")
+	}
+
+	return &stateInfo, nil
 }
 
 // CleanExpiredStates removes expired state parameters
@@ -8,6 +8,11 @@ import (
 	"github.com/coder-lulu/newbee-core/rpc/internal/oauth"
 	oauthSvc "github.com/coder-lulu/newbee-core/rpc/internal/svc/oauth"
 	"github.com/coder-lulu/newbee-core/rpc/internal/encryption"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/auditchain"
+	"github.com/coder-lulu/newbee-core/rpc/internal/auditarchive"
+	"github.com/coder-lulu/newbee-core/rpc/internal/saml"
+	"github.com/coder-lulu/newbee-core/rpc/internal/ldapsync"
+	"github.com/coder-lulu/newbee-core/rpc/internal/tenantinit"
 
 	"github.com/coder-lulu/newbee-common/v2/orm/ent/hooks"
 	"github.com/zeromicro/go-zero/core/logx"
@@ -28,6 +33,20 @@ type ServiceContext struct {
 	PermissionChecker *casbinMgr.PermissionChecker // 权限检查器
 	// 🔐 OAuth Provider加密服务
 	EncryptionService *encryption.ProviderEncryptionService
+	// 审计日志检查点签名
+	AuditSigner *auditchain.Signer
+	// 审计日志归档存储，未开启归档时为 nil
+	AuditArchiveStore auditarchive.BlobStore
+	// SAML SP 签名密钥，未配置时为 nil，此时不签名 AuthnRequest
+	SamlKeys *saml.KeyPair
+	// SAML 待响应的认证请求及已使用的断言
+	SamlStore *saml.Store
+	// LDAP 目录同步，手动同步与定时同步共用同一把锁
+	LdapSync *ldapsync.Runner
+	// 第三方账号令牌，登录时保存，过期前刷新，解绑时撤销
+	OauthTokens *oauth.TokenManager
+	// 租户初始化任务，插件在后台执行
+	InitJobs *tenantinit.JobRunner
 }
 
 func NewServiceContext(c config.Config) *ServiceContext {
@@ -40,7 +59,11 @@ func NewServiceContext(c config.Config) *ServiceContext {
 	// 配置Core服务的租户过滤规则 - 添加Core特有的系统表
 	hooks.AddExcludedTable("sys_apis")        // API接口表是系统级数据
 	hooks.AddExcludedTable("sys_audit_logs")  // 审计日志表是系统级数据
-	hooks.AddExcludedTable("oauth_providers")  // OAuth提供商表是系统级数据
+	hooks.AddExcludedTable("sys_audit_log_checkpoints")
+	hooks.AddExcludedTable("sys_audit_log_prune_records")
+	hooks.AddExcludedTable("sys_audit_log_chains")
+	hooks.AddExcludedTable("sys_audit_log_archives")
+	hooks.AddExcludedTable("sys_tenant_init_jobs") // 初始化任务由超级管理员跨租户查看
 
 	// 一键设置：初始化配置 + 注册所有hooks (租户Hook + 部门Hook)
 	if err := hooks.QuickSetup(db); err != nil {
@@ -49,26 +72,15 @@ func NewServiceContext(c config.Config) *ServiceContext {
 	}
 	logx.Infow("✅ Core service: Unified hooks initialized successfully")
 
+	// 审计日志只允许追加，删除只能由保留策略发起
+	db.AuditLog.Use(auditchain.AppendOnlyHook())
+
 	// RPC服务层不注册数据权限拦截器
 	// 数据权限控制应该在API层通过中间件处理，RPC层作为数据访问层不承担权限职责
 	// 这样可以保持清晰的层次分离，避免跨服务的上下文传递问题
 
 	rds := c.RedisConf.MustNewUniversalRedis()
 
-	// Initialize OAuth Manager
-	oauthManagerConfig := oauth.DefaultOAuthManagerConfig()
-	oauth.InitGlobalOAuthManager(db, oauthManagerConfig)
-	oauthManager := oauth.GetGlobalOAuthManager()
-
-	// Initialize State Manager with default secret key (should be configurable)
-	secretKey := []byte("default-secret-key-should-be-configurable") // TODO: Move to config
-	oauthSvc.InitGlobalStateManager(rds, secretKey)
-	stateManager := oauthSvc.GetGlobalStateManager()
-
-	// Initialize PKCE Manager
-	oauthSvc.InitGlobalPKCEManager(rds)
-	pkceManager := oauthSvc.GetGlobalPKCEManager()
-
 	// Initialize Casbin components
 	enforcerManager := casbinMgr.NewEnforcerManager(db, rds, logx.WithContext(nil))
 	
@@ -111,6 +123,34 @@ func NewServiceContext(c config.Config) *ServiceContext {
 	
 	encryptionService := encryption.GetGlobalProviderEncryptionService()
 
+	// Initialize OAuth Manager，必须在加密密钥配置之后，提供商注册表使用全局加密服务解密密钥
+	oauthManagerConfig := oauth.DefaultOAuthManagerConfig()
+	oauth.InitGlobalOAuthManager(db, oauthManagerConfig)
+	oauthManager := oauth.GetGlobalOAuthManager()
+
+	// Initialize State Manager，登录 state 使用 HMAC 签名
+	stateKey := c.OauthStateKey
+	if stateKey == "" {
+		logx.Infow("OAuth state key is not configured, using encryption key - MUST configure in production!")
+		stateKey = encryptionKey
+	}
+	oauthSvc.InitGlobalStateManager(rds, []byte(stateKey))
+	stateManager := oauthSvc.GetGlobalStateManager()
+
+	// Initialize PKCE Manager
+	oauthSvc.InitGlobalPKCEManager(rds)
+	pkceManager := oauthSvc.GetGlobalPKCEManager()
+
+	var samlKeys *saml.KeyPair
+	if c.Saml.CertFile != "" || c.Saml.KeyFile != "" {
+		kp, err := saml.LoadKeyPair(c.Saml.CertFile, c.Saml.KeyFile)
+		if err != nil {
+			logx.Errorw("Failed to load SAML key pair", logx.Field("error", err))
+			panic("SAML 签名密钥加载失败: " + err.Error())
+		}
+		samlKeys = kp
+	}
+
 	return &ServiceContext{
 		Config:            c,
 		DB:                db,
@@ -122,5 +162,12 @@ func NewServiceContext(c config.Config) *ServiceContext {
 		PolicyManager:     policyManager,
 		PermissionChecker: permissionChecker,
 		EncryptionService: encryptionService,
+		AuditSigner:       auditchain.NewSigner(c.AuditLog, encryptionKey),
+		AuditArchiveStore: auditarchive.MustNewStore(c.AuditLog.Archive),
+		SamlKeys:          samlKeys,
+		SamlStore:         saml.NewStore(rds, c.Saml.RequestTTL, c.Saml.ClockSkew),
+		LdapSync:          ldapsync.NewRunner(db, rds, ldapsync.NewSyncer(c.Ldap, db, encryptionService)),
+		OauthTokens:       oauth.NewTokenManager(c.OauthToken, db, rds, encryptionService),
+		InitJobs:          tenantinit.NewJobRunner(db, rds, c.RedisConf.Db),
 	}
 }
@@ -0,0 +1,298 @@
+package tenantdoctor
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/coder-lulu/newbee-common/v2/enum/common"
+	"github.com/coder-lulu/newbee-core/rpc/ent"
+	"github.com/coder-lulu/newbee-core/rpc/ent/casbinrule"
+	"github.com/coder-lulu/newbee-core/rpc/ent/department"
+	"github.com/coder-lulu/newbee-core/rpc/ent/menu"
+	"github.com/coder-lulu/newbee-core/rpc/ent/role"
+	"github.com/coder-lulu/newbee-core/rpc/ent/user"
+	"github.com/coder-lulu/newbee-core/rpc/internal/plugins"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+// defaultAdminUsername 重建管理员用户时使用的用户名，与租户初始化的默认值一致
+const defaultAdminUsername = "admin"
+
+// checkAdmin 检查管理员角色以及持有该角色的用户
+func (d *Doctor) checkAdmin(ctx context.Context, db *ent.Client, tenantID uint64) ([]Finding, error) {
+	var findings []Finding
+
+	roleExists, err := db.Role.Query().
+		Where(role.TenantIDEQ(tenantID), role.CodeEQ(plugins.AdminRoleCode)).
+		Exist(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !roleExists {
+		findings = append(findings, Finding{
+			Code:     CodeMissingAdminRole,
+			Message:  "admin role is missing",
+			Subjects: []string{plugins.AdminRoleCode},
+			Repair:   "recreate the admin role with all tenant menus, full data scope and the API permissions of the tenant template",
+		})
+	}
+
+	userExists, err := db.User.Query().
+		Where(
+			user.TenantIDEQ(tenantID),
+			user.StatusEQ(common.StatusNormal),
+			user.HasRolesWith(role.TenantIDEQ(tenantID), role.CodeEQ(plugins.AdminRoleCode)),
+		).
+		Exist(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !userExists {
+		findings = append(findings, Finding{
+			Code:     CodeMissingAdminUser,
+			Message:  "no active user holds the admin role",
+			Subjects: []string{defaultAdminUsername},
+			Repair: fmt.Sprintf("grant the admin role to user %q, or create it in the root department when it does not exist (requires admin password)",
+				defaultAdminUsername),
+		})
+	}
+
+	return findings, nil
+}
+
+// checkUserDepartments 检查未设置部门或所属部门已不存在的用户
+func (d *Doctor) checkUserDepartments(ctx context.Context, db *ent.Client, tenantID uint64) ([]Finding, error) {
+	deptIDs, err := db.Department.Query().Where(department.TenantIDEQ(tenantID)).IDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	users, err := db.User.Query().
+		Where(
+			user.TenantIDEQ(tenantID),
+			user.Or(user.DepartmentIDIsNil(), user.DepartmentIDEQ(0), user.DepartmentIDNotIn(deptIDs...)),
+		).
+		All(ctx)
+	if err != nil || len(users) == 0 {
+		return nil, err
+	}
+
+	f := Finding{
+		Code:    CodeUserWithoutDepartment,
+		Message: fmt.Sprintf("%d user(s) have no valid department", len(users)),
+		Repair:  "move the users to the root department, recreating it when missing",
+	}
+	for _, u := range users {
+		f.Subjects = append(f.Subjects, u.Username)
+		f.userIDs = append(f.userIDs, u.ID)
+	}
+
+	return []Finding{f}, nil
+}
+
+// checkUserRoles 检查没有任何有效角色的启用用户
+func (d *Doctor) checkUserRoles(ctx context.Context, db *ent.Client, tenantID uint64) ([]Finding, error) {
+	users, err := db.User.Query().
+		Where(
+			user.TenantIDEQ(tenantID),
+			user.StatusEQ(common.StatusNormal),
+			user.Not(user.HasRolesWith(role.TenantIDEQ(tenantID))),
+		).
+		All(ctx)
+	if err != nil || len(users) == 0 {
+		return nil, err
+	}
+
+	f := Finding{
+		Code:    CodeUserWithoutRole,
+		Message: fmt.Sprintf("%d active user(s) have no role", len(users)),
+		Repair:  "disable the users until roles are assigned",
+	}
+	for _, u := range users {
+		f.Subjects = append(f.Subjects, u.Username)
+		f.userIDs = append(f.userIDs, u.ID)
+	}
+
+	return []Finding{f}, nil
+}
+
+// checkRoleMenus 检查指向已删除菜单或其他租户菜单的角色菜单关联
+func (d *Doctor) checkRoleMenus(ctx context.Context, db *ent.Client, tenantID uint64) ([]Finding, error) {
+	b := sql.Dialect(d.svcCtx.Config.DatabaseConf.Type)
+	rm, r, m := b.Table(role.MenusTable).As("rm"), b.Table(role.Table).As("r"), b.Table(menu.Table).As("m")
+	query, args := b.Select(rm.C(role.MenusPrimaryKey[0]), rm.C(role.MenusPrimaryKey[1])).
+		From(rm).
+		Join(r).On(r.C(role.FieldID), rm.C(role.MenusPrimaryKey[0])).
+		LeftJoin(m).OnP(sql.And(
+		sql.ColumnsEQ(m.C(menu.FieldID), rm.C(role.MenusPrimaryKey[1])),
+		sql.ColumnsEQ(m.C(menu.FieldTenantID), r.C(role.FieldTenantID)),
+	)).
+		Where(sql.And(sql.EQ(r.C(role.FieldTenantID), tenantID), sql.IsNull(m.C(menu.FieldID)))).
+		Query()
+
+	rows, err := db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	f := Finding{
+		Code:   CodeDanglingRoleMenu,
+		Repair: "remove the role-menu links",
+	}
+	for rows.Next() {
+		var link [2]uint64
+		if err = rows.Scan(&link[0], &link[1]); err != nil {
+			return nil, err
+		}
+		f.links = append(f.links, link)
+		f.Subjects = append(f.Subjects, fmt.Sprintf("%d:%d", link[0], link[1]))
+	}
+	if err = rows.Err(); err != nil || len(f.links) == 0 {
+		return nil, err
+	}
+	f.Message = fmt.Sprintf("%d role-menu link(s) point to deleted menus", len(f.links))
+
+	return []Finding{f}, nil
+}
+
+// checkAPIRules 检查接口已不在 sys_apis 中的 p 规则，带通配符或路径参数的规则不检查
+func (d *Doctor) checkAPIRules(ctx context.Context, db *ent.Client, tenantID uint64) ([]Finding, error) {
+	apis, err := db.API.Query().All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	known := make(map[string]bool, len(apis))
+	for _, a := range apis {
+		known[apiKey(a.Path, a.Method)] = true
+	}
+
+	rules, err := db.CasbinRule.Query().
+		Where(casbinrule.TenantIDEQ(tenantID), casbinrule.PtypeEQ("p")).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	f := Finding{
+		Code:   CodeStaleAPIRule,
+		Repair: "delete the API permission rules",
+	}
+	for _, r := range rules {
+		if strings.ContainsAny(r.V2, "*:") || known[apiKey(r.V2, r.V3)] {
+			continue
+		}
+		f.ruleIDs = append(f.ruleIDs, r.ID)
+		f.Subjects = append(f.Subjects, fmt.Sprintf("%s %s %s", r.V0, strings.ToUpper(r.V3), r.V2))
+	}
+	if len(f.ruleIDs) == 0 {
+		return nil, nil
+	}
+	f.Message = fmt.Sprintf("%d API permission rule(s) reference APIs that no longer exist", len(f.ruleIDs))
+
+	return []Finding{f}, nil
+}
+
+// checkUserGroupings 检查用户已被删除的 g 规则
+func (d *Doctor) checkUserGroupings(ctx context.Context, db *ent.Client, tenantID uint64) ([]Finding, error) {
+	rules, err := db.CasbinRule.Query().
+		Where(casbinrule.TenantIDEQ(tenantID), casbinrule.PtypeEQ("g")).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	userIDs := make([]uuid.UUID, 0, len(rules))
+	for _, r := range rules {
+		if id, err := uuid.FromString(r.V0); err == nil {
+			userIDs = append(userIDs, id)
+		}
+	}
+	if len(userIDs) == 0 {
+		return nil, nil
+	}
+
+	existing, err := db.User.Query().
+		Where(user.TenantIDEQ(tenantID), user.IDIn(userIDs...)).
+		IDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	exists := make(map[string]bool, len(existing))
+	for _, id := range existing {
+		exists[id.String()] = true
+	}
+
+	f := Finding{
+		Code:   CodeStaleUserGrouping,
+		Repair: "delete the role grouping rules",
+	}
+	for _, r := range rules {
+		// v0 不是用户ID的规则（如角色继承）不属于此检查
+		id, err := uuid.FromString(r.V0)
+		if err != nil || exists[id.String()] {
+			continue
+		}
+		f.ruleIDs = append(f.ruleIDs, r.ID)
+		f.Subjects = append(f.Subjects, fmt.Sprintf("%s %s", r.V0, r.V1))
+	}
+	if len(f.ruleIDs) == 0 {
+		return nil, nil
+	}
+	f.Message = fmt.Sprintf("%d role grouping rule(s) reference deleted users", len(f.ruleIDs))
+
+	return []Finding{f}, nil
+}
+
+// repair 在事务中执行一项问题的修复计划
+func (d *Doctor) repair(ctx context.Context, tx *ent.Tx, f Finding, adminPassword *string) error {
+	switch f.Code {
+	case CodeMissingAdminRole:
+		_, err := d.core.RepairAdminRole(ctx, tx, f.TenantID)
+		return err
+	case CodeMissingAdminUser:
+		_, err := d.core.RepairAdminUser(ctx, tx, f.TenantID, defaultAdminUsername, adminPassword)
+		return err
+	case CodeUserWithoutDepartment:
+		dept, err := d.core.RepairRootDepartment(ctx, tx, f.TenantID)
+		if err != nil {
+			return err
+		}
+		return tx.User.Update().
+			Where(user.IDIn(f.userIDs...)).
+			SetDepartmentID(dept.ID).
+			Exec(ctx)
+	case CodeUserWithoutRole:
+		// 重新过滤，跳过本次修复中刚被授予管理员角色的用户
+		return tx.User.Update().
+			Where(user.IDIn(f.userIDs...), user.Not(user.HasRolesWith(role.TenantIDEQ(f.TenantID)))).
+			SetStatus(common.StatusBanned).
+			Exec(ctx)
+	case CodeDanglingRoleMenu:
+		b := sql.Dialect(d.svcCtx.Config.DatabaseConf.Type)
+		for _, link := range f.links {
+			query, args := b.Delete(role.MenusTable).
+				Where(sql.And(
+					sql.EQ(role.MenusPrimaryKey[0], link[0]),
+					sql.EQ(role.MenusPrimaryKey[1], link[1]),
+				)).
+				Query()
+			if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+				return err
+			}
+		}
+		return nil
+	case CodeStaleAPIRule, CodeStaleUserGrouping:
+		_, err := tx.CasbinRule.Delete().Where(casbinrule.IDIn(f.ruleIDs...)).Exec(ctx)
+		return err
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownCode, f.Code)
+	}
+}
+
+func apiKey(path, method string) string {
+	return strings.ToUpper(method) + " " + path
+}
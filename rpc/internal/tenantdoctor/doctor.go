@@ -0,0 +1,178 @@
+package tenantdoctor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/coder-lulu/newbee-common/v2/orm/ent/hooks"
+	"github.com/coder-lulu/newbee-core/rpc/ent"
+	"github.com/coder-lulu/newbee-core/rpc/ent/tenant"
+	"github.com/coder-lulu/newbee-core/rpc/internal/plugins"
+	"github.com/coder-lulu/newbee-core/rpc/internal/svc"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/entx"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/redisfunc"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// 检查项代码，按修复顺序排列：管理员用户的修复依赖管理员角色
+const (
+	CodeMissingAdminRole      = "missing_admin_role"
+	CodeMissingAdminUser      = "missing_admin_user"
+	CodeUserWithoutDepartment = "user_without_department"
+	CodeUserWithoutRole       = "user_without_role"
+	CodeDanglingRoleMenu      = "dangling_role_menu"
+	CodeStaleAPIRule          = "stale_api_rule"
+	CodeStaleUserGrouping     = "stale_user_grouping"
+)
+
+// Codes 全部检查项代码
+var Codes = []string{
+	CodeMissingAdminRole,
+	CodeMissingAdminUser,
+	CodeUserWithoutDepartment,
+	CodeUserWithoutRole,
+	CodeDanglingRoleMenu,
+	CodeStaleAPIRule,
+	CodeStaleUserGrouping,
+}
+
+// ErrUnknownCode 修复请求包含未知的检查项代码
+var ErrUnknownCode = errors.New("unknown tenant doctor finding code")
+
+// Finding 一项租户数据完整性问题及其修复计划
+type Finding struct {
+	TenantID uint64
+	Code     string
+	Message  string
+	// Subjects 涉及的数据，如用户ID、"角色ID:菜单ID"、Casbin规则
+	Subjects []string
+	// Repair 修复计划说明
+	Repair string
+
+	userIDs []uuid.UUID
+	ruleIDs []uint64
+	links   [][2]uint64
+}
+
+// Doctor 检查租户的管理员、用户、角色菜单和Casbin规则，并在单个事务中修复
+type Doctor struct {
+	svcCtx *svc.ServiceContext
+	core   *plugins.CoreTenantPlugin
+	logger logx.Logger
+}
+
+// NewDoctor 创建租户诊断器
+func NewDoctor(svcCtx *svc.ServiceContext, logger logx.Logger) *Doctor {
+	return &Doctor{
+		svcCtx: svcCtx,
+		core:   plugins.NewCoreTenantPlugin(svcCtx, logger),
+		logger: logger,
+	}
+}
+
+// Diagnose 检查指定租户，tenantIDs 为空时检查全部租户
+func (d *Doctor) Diagnose(ctx context.Context, tenantIDs ...uint64) ([]Finding, error) {
+	systemCtx := hooks.NewSystemContext(ctx)
+
+	if len(tenantIDs) == 0 {
+		ids, err := d.svcCtx.DB.Tenant.Query().Order(ent.Asc(tenant.FieldID)).IDs(systemCtx)
+		if err != nil {
+			return nil, err
+		}
+		tenantIDs = ids
+	}
+
+	var findings []Finding
+	for _, id := range tenantIDs {
+		result, err := d.check(systemCtx, d.svcCtx.DB, id)
+		if err != nil {
+			return nil, fmt.Errorf("check tenant %d: %w", id, err)
+		}
+		findings = append(findings, result...)
+	}
+
+	return findings, nil
+}
+
+// Repair 在单个事务中重新检查并修复租户的问题，codes 为空时修复全部问题。
+// 任一修复失败时整体回滚；重建管理员用户时需要 adminPassword。
+func (d *Doctor) Repair(ctx context.Context, tenantID uint64, codes []string, adminPassword *string) ([]Finding, error) {
+	for _, code := range codes {
+		if !slices.Contains(Codes, code) {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownCode, code)
+		}
+	}
+
+	systemCtx := hooks.NewSystemContext(ctx)
+
+	var repaired []Finding
+	err := entx.WithTx(systemCtx, d.svcCtx.DB, func(tx *ent.Tx) error {
+		findings, err := d.check(systemCtx, tx.Client(), tenantID)
+		if err != nil {
+			return err
+		}
+
+		for _, f := range findings {
+			if len(codes) > 0 && !slices.Contains(codes, f.Code) {
+				continue
+			}
+			if err = d.repair(systemCtx, tx, f, adminPassword); err != nil {
+				return fmt.Errorf("repair %s: %w", f.Code, err)
+			}
+			repaired = append(repaired, f)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(repaired) > 0 {
+		if err = redisfunc.PublishCasbinReload(ctx, d.svcCtx.Redis, d.svcCtx.Config.RedisConf.Db, tenantID, "tenant_doctor"); err != nil {
+			d.logger.Errorw("failed to publish casbin reload after tenant repair",
+				logx.Field("tenant_id", tenantID),
+				logx.Field("error", err.Error()))
+		}
+	}
+
+	d.logger.Infow("tenant repaired",
+		logx.Field("tenant_id", tenantID),
+		logx.Field("repaired", len(repaired)))
+
+	return repaired, nil
+}
+
+// check 依次执行全部检查项，结果顺序与 Codes 一致
+func (d *Doctor) check(ctx context.Context, db *ent.Client, tenantID uint64) ([]Finding, error) {
+	if _, err := db.Tenant.Get(ctx, tenantID); err != nil {
+		return nil, err
+	}
+
+	checks := []func(context.Context, *ent.Client, uint64) ([]Finding, error){
+		d.checkAdmin,
+		d.checkUserDepartments,
+		d.checkUserRoles,
+		d.checkRoleMenus,
+		d.checkAPIRules,
+		d.checkUserGroupings,
+	}
+
+	var findings []Finding
+	for _, c := range checks {
+		result, err := c(ctx, db, tenantID)
+		if err != nil {
+			return nil, err
+		}
+		for i := range result {
+			result[i].TenantID = tenantID
+		}
+		findings = append(findings, result...)
+	}
+
+	return findings, nil
+}
@@ -0,0 +1,363 @@
+package tenantinit
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/zeromicro/go-zero/core/logx"
+
+	"github.com/coder-lulu/newbee-core/rpc/ent"
+	"github.com/coder-lulu/newbee-core/rpc/ent/configuration"
+	"github.com/coder-lulu/newbee-core/rpc/ent/department"
+	"github.com/coder-lulu/newbee-core/rpc/ent/dictionary"
+	"github.com/coder-lulu/newbee-core/rpc/ent/dictionarydetail"
+	"github.com/coder-lulu/newbee-core/rpc/ent/position"
+	"github.com/coder-lulu/newbee-core/rpc/internal/initjob"
+)
+
+var _ TenantInitializer = (*DefaultTenantInitializer)(nil)
+
+// Initialize 在事务中写入模板的字典、配置和职位，已存在的同名数据会跳过，修复模式下可以重复执行。
+// 菜单和接口权限组件需要在菜单复制和事务提交后处理，由调用方通过 MenuFilter 和 APIFilter 使用。
+func (d *DefaultTenantInitializer) Initialize(ctx context.Context, tx *ent.Tx, tenantID uint64, template *InitializationTemplate) error {
+	if err := d.Validate(template); err != nil {
+		return err
+	}
+
+	for _, comp := range template.Components {
+		var err error
+		switch comp.Name {
+		case ComponentDictionaries:
+			err = d.initDictionaries(ctx, tx, tenantID, comp)
+		case ComponentConfigurations:
+			err = d.initConfigurations(ctx, tx, tenantID, comp)
+		case ComponentPositions:
+			err = d.initPositions(ctx, tx, tenantID, comp)
+		default:
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("failed to init %s: %w", comp.Name, err)
+		}
+	}
+
+	d.logger.Infow("applied tenant initialization template",
+		logx.Field("tenant_id", tenantID),
+		logx.Field("template", template.Name),
+		logx.Field("version", template.Version))
+
+	return nil
+}
+
+// Plan 列出 Initialize 将要写入的字典、配置和职位，已存在的同名数据标记为跳过，不写入任何数据
+func (d *DefaultTenantInitializer) Plan(ctx context.Context, db *ent.Client, tenantID uint64, template *InitializationTemplate) ([]initjob.PlanItem, error) {
+	if err := d.Validate(template); err != nil {
+		return nil, err
+	}
+
+	var result []initjob.PlanItem
+	for _, comp := range template.Components {
+		var names, existing []string
+		var err error
+		switch comp.Name {
+		case ComponentDictionaries:
+			var data []DictionaryTemplate
+			err = comp.DecodeData(&data)
+			for _, v := range data {
+				names = append(names, v.Name)
+			}
+			if err == nil {
+				existing, err = db.Dictionary.Query().Where(dictionary.TenantIDEQ(tenantID)).Select(dictionary.FieldName).Strings(ctx)
+			}
+		case ComponentConfigurations:
+			var data []ConfigurationTemplate
+			err = comp.DecodeData(&data)
+			for _, v := range data {
+				names = append(names, v.Key)
+			}
+			if err == nil {
+				existing, err = db.Configuration.Query().Where(configuration.TenantIDEQ(tenantID)).Select(configuration.FieldKey).Strings(ctx)
+			}
+		case ComponentPositions:
+			var data []PositionTemplate
+			err = comp.DecodeData(&data)
+			for _, v := range data {
+				names = append(names, v.Code)
+			}
+			if err == nil {
+				existing, err = db.Position.Query().Where(position.TenantIDEQ(tenantID)).Select(position.FieldCode).Strings(ctx)
+			}
+		default:
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to plan %s: %w", comp.Name, err)
+		}
+
+		result = append(result, PlanItems(comp.Name, names, existing)...)
+	}
+
+	return result, nil
+}
+
+// PlanItems splits the names into the ones to create and the existing ones to skip
+func PlanItems(component string, names, existing []string) []initjob.PlanItem {
+	exists := toSet(existing)
+	create := initjob.PlanItem{Component: component, Action: initjob.ActionCreate}
+	skip := initjob.PlanItem{Component: component, Action: initjob.ActionSkip}
+	for _, v := range names {
+		if exists[v] {
+			skip.Items = append(skip.Items, v)
+			continue
+		}
+		exists[v] = true
+		create.Items = append(create.Items, v)
+	}
+	create.Count, skip.Count = len(create.Items), len(skip.Items)
+
+	result := []initjob.PlanItem{create}
+	if skip.Count > 0 {
+		result = append(result, skip)
+	}
+	return result
+}
+
+// Rollback 删除模板写入的字典、配置和职位
+func (d *DefaultTenantInitializer) Rollback(ctx context.Context, tx *ent.Tx, tenantID uint64) error {
+	if _, err := tx.DictionaryDetail.Delete().Where(dictionarydetail.TenantIDEQ(tenantID)).Exec(ctx); err != nil {
+		return err
+	}
+	if _, err := tx.Dictionary.Delete().Where(dictionary.TenantIDEQ(tenantID)).Exec(ctx); err != nil {
+		return err
+	}
+	if _, err := tx.Configuration.Delete().Where(configuration.TenantIDEQ(tenantID)).Exec(ctx); err != nil {
+		return err
+	}
+	_, err := tx.Position.Delete().Where(position.TenantIDEQ(tenantID)).Exec(ctx)
+	return err
+}
+
+// initDictionaries 创建模板中租户尚未拥有的字典及其详情
+func (d *DefaultTenantInitializer) initDictionaries(ctx context.Context, tx *ent.Tx, tenantID uint64, comp ComponentTemplate) error {
+	var data []DictionaryTemplate
+	if err := comp.DecodeData(&data); err != nil {
+		return err
+	}
+
+	existing, err := tx.Dictionary.Query().Where(dictionary.TenantIDEQ(tenantID)).Select(dictionary.FieldName).Strings(ctx)
+	if err != nil {
+		return err
+	}
+	names := make(map[string]bool, len(existing))
+	for _, v := range existing {
+		names[v] = true
+	}
+
+	for _, v := range data {
+		if names[v.Name] {
+			continue
+		}
+
+		dict, err := tx.Dictionary.Create().
+			SetTitle(v.Title).
+			SetName(v.Name).
+			SetDesc(v.Desc).
+			SetStatus(1).
+			SetTenantID(tenantID).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+
+		if len(v.Details) == 0 {
+			continue
+		}
+		details := make([]*ent.DictionaryDetailCreate, 0, len(v.Details))
+		for _, detail := range v.Details {
+			details = append(details, tx.DictionaryDetail.Create().
+				SetTitle(detail.Title).
+				SetValue(detail.Value).
+				SetListClass(detail.ListClass).
+				SetCSSClass(detail.CSSClass).
+				SetIsDefault(detail.IsDefault).
+				SetSort(uint32(detail.Sort)).
+				SetStatus(1).
+				SetTenantID(tenantID).
+				SetDictionaryID(dict.ID))
+		}
+		if err := tx.DictionaryDetail.CreateBulk(details...).Exec(ctx); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// initConfigurations 创建模板中租户尚未拥有的配置项
+func (d *DefaultTenantInitializer) initConfigurations(ctx context.Context, tx *ent.Tx, tenantID uint64, comp ComponentTemplate) error {
+	var data []ConfigurationTemplate
+	if err := comp.DecodeData(&data); err != nil {
+		return err
+	}
+
+	existing, err := tx.Configuration.Query().Where(configuration.TenantIDEQ(tenantID)).Select(configuration.FieldKey).Strings(ctx)
+	if err != nil {
+		return err
+	}
+	keys := make(map[string]bool, len(existing))
+	for _, v := range existing {
+		keys[v] = true
+	}
+
+	configs := make([]*ent.ConfigurationCreate, 0, len(data))
+	for _, v := range data {
+		if keys[v.Key] {
+			continue
+		}
+		keys[v.Key] = true
+
+		configs = append(configs, tx.Configuration.Create().
+			SetName(v.Name).
+			SetKey(v.Key).
+			SetValue(v.Value).
+			SetCategory(v.Category).
+			SetRemark(v.Remark).
+			SetSort(uint32(v.Sort)).
+			SetState(true).
+			SetTenantID(tenantID))
+	}
+	if len(configs) == 0 {
+		return nil
+	}
+
+	return tx.Configuration.CreateBulk(configs...).Exec(ctx)
+}
+
+// initPositions 在租户的根部门下创建模板中尚未存在的职位
+func (d *DefaultTenantInitializer) initPositions(ctx context.Context, tx *ent.Tx, tenantID uint64, comp ComponentTemplate) error {
+	var data []PositionTemplate
+	if err := comp.DecodeData(&data); err != nil {
+		return err
+	}
+	if len(data) == 0 {
+		return nil
+	}
+
+	dept, err := tx.Department.Query().
+		Where(department.TenantIDEQ(tenantID), department.ParentIDEQ(0)).
+		Order(ent.Asc(department.FieldID)).
+		First(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to find the root department: %w", err)
+	}
+
+	existing, err := tx.Position.Query().Where(position.TenantIDEQ(tenantID)).Select(position.FieldCode).Strings(ctx)
+	if err != nil {
+		return err
+	}
+	codes := make(map[string]bool, len(existing))
+	for _, v := range existing {
+		codes[v] = true
+	}
+
+	positions := make([]*ent.PositionCreate, 0, len(data))
+	for _, v := range data {
+		if codes[v.Code] {
+			continue
+		}
+		codes[v.Code] = true
+
+		positions = append(positions, tx.Position.Create().
+			SetName(v.Name).
+			SetCode(v.Code).
+			SetRemark(v.Remark).
+			SetDeptID(dept.ID).
+			SetStatus(1).
+			SetSort(uint32(v.Sort)).
+			SetTenantID(tenantID))
+	}
+	if len(positions) == 0 {
+		return nil
+	}
+
+	return tx.Position.CreateBulk(positions...).Exec(ctx)
+}
+
+// MenuFilter 返回按模板菜单组件筛选系统菜单的函数，入参为全部系统菜单，返回需要复制给租户的菜单。
+// 模板没有菜单组件时返回全部菜单。
+func MenuFilter(template *InitializationTemplate) func(menus []*ent.Menu) []*ent.Menu {
+	comp, ok := template.Component(ComponentMenus)
+	if !ok {
+		return func(menus []*ent.Menu) []*ent.Menu { return menus }
+	}
+
+	var data MenuTemplate
+	_ = comp.DecodeData(&data)
+	include := toSet(data.Include)
+	exclude := toSet(data.Exclude)
+
+	return func(menus []*ent.Menu) []*ent.Menu {
+		byID := make(map[uint64]*ent.Menu, len(menus))
+		for _, m := range menus {
+			byID[m.ID] = m
+		}
+
+		// 菜单被包含时其子菜单也被包含，被排除时其子菜单也被排除
+		matches := func(m *ent.Menu, names map[string]bool) bool {
+			for depth := 0; m != nil && depth < 10; depth++ {
+				if names[m.Name] {
+					return true
+				}
+				m = byID[m.ParentID]
+			}
+			return false
+		}
+
+		result := make([]*ent.Menu, 0, len(menus))
+		for _, m := range menus {
+			if len(include) > 0 && !matches(m, include) && !hasIncludedChild(m, menus, include) {
+				continue
+			}
+			if matches(m, exclude) {
+				continue
+			}
+			result = append(result, m)
+		}
+		return result
+	}
+}
+
+// hasIncludedChild 父菜单需要随包含的子菜单一起复制，否则子菜单无法挂载
+func hasIncludedChild(parent *ent.Menu, menus []*ent.Menu, include map[string]bool) bool {
+	for _, m := range menus {
+		if m.ParentID == parent.ID && (include[m.Name] || hasIncludedChild(m, menus, include)) {
+			return true
+		}
+	}
+	return false
+}
+
+// APIFilter 返回模板接口权限组件允许的接口，键为 "METHOD path"。模板没有接口权限组件时返回 nil，表示授予全部接口。
+func APIFilter(template *InitializationTemplate) map[string]bool {
+	comp, ok := template.Component(ComponentAPIPermissions)
+	if !ok {
+		return nil
+	}
+
+	var data []APIPermissionTemplate
+	_ = comp.DecodeData(&data)
+
+	result := make(map[string]bool, len(data))
+	for _, v := range data {
+		result[v.Method+" "+v.Path] = true
+	}
+	return result
+}
+
+func toSet(values []string) map[string]bool {
+	result := make(map[string]bool, len(values))
+	for _, v := range values {
+		result[v] = true
+	}
+	return result
+}
@@ -0,0 +1,79 @@
+package tenantinit
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/coder-lulu/newbee-common/v2/tenant"
+)
+
+// ErrInvalidPlugins is returned when the plugins depend on unknown plugins or on each other in a cycle
+var ErrInvalidPlugins = errors.New("invalid tenant initialization plugins")
+
+// SortPlugins orders the local and remote plugins so that each plugin runs after its dependencies,
+// the plugins without dependencies between them run by priority and then by name.
+func SortPlugins(plugins []tenant.TenantInitPlugin) ([]tenant.TenantInitPlugin, error) {
+	byName := make(map[string]tenant.TenantInitPlugin, len(plugins))
+	for _, p := range plugins {
+		name := p.GetMetadata().Name
+		if _, ok := byName[name]; ok {
+			return nil, fmt.Errorf("%w: duplicate plugin %s", ErrInvalidPlugins, name)
+		}
+		byName[name] = p
+	}
+
+	pending := make(map[string]int, len(plugins))
+	dependents := make(map[string][]string, len(plugins))
+	for name, p := range byName {
+		for _, dep := range p.GetMetadata().Dependencies {
+			if _, ok := byName[dep]; !ok {
+				return nil, fmt.Errorf("%w: plugin %s depends on unknown plugin %s", ErrInvalidPlugins, name, dep)
+			}
+			pending[name]++
+			dependents[dep] = append(dependents[dep], name)
+		}
+	}
+
+	var ready []string
+	for name := range byName {
+		if pending[name] == 0 {
+			ready = append(ready, name)
+		}
+	}
+
+	result := make([]tenant.TenantInitPlugin, 0, len(plugins))
+	for len(ready) > 0 {
+		sort.Slice(ready, func(i, j int) bool {
+			pi, pj := byName[ready[i]].GetMetadata().Priority, byName[ready[j]].GetMetadata().Priority
+			if pi != pj {
+				return pi < pj
+			}
+			return ready[i] < ready[j]
+		})
+
+		name := ready[0]
+		ready = ready[1:]
+		result = append(result, byName[name])
+		for _, v := range dependents[name] {
+			pending[v]--
+			if pending[v] == 0 {
+				ready = append(ready, v)
+			}
+		}
+	}
+
+	if len(result) < len(plugins) {
+		var cycle []string
+		for name, n := range pending {
+			if n > 0 {
+				cycle = append(cycle, name)
+			}
+		}
+		sort.Strings(cycle)
+		return nil, fmt.Errorf("%w: circular dependencies among %s", ErrInvalidPlugins, strings.Join(cycle, ", "))
+	}
+
+	return result, nil
+}
@@ -0,0 +1,388 @@
+package tenantinit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/bsm/redislock"
+	"github.com/coder-lulu/newbee-common/v2/orm/ent/hooks"
+	"github.com/coder-lulu/newbee-common/v2/tenant"
+	"github.com/redis/go-redis/v9"
+	"github.com/zeromicro/go-zero/core/logx"
+
+	"github.com/coder-lulu/newbee-core/rpc/ent"
+	"github.com/coder-lulu/newbee-core/rpc/internal/initjob"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/redisfunc"
+)
+
+const (
+	jobLockKey = "TENANT:INIT:LOCK:%d"
+	// jobLockTTL 任务结束后主动释放，TTL 只用于进程异常退出的情况
+	jobLockTTL = time.Hour
+	// DefaultJobTimeout 单次执行任务的最长时间
+	DefaultJobTimeout = 300 * time.Second
+)
+
+// ErrJobRunning is returned when the tenant is being initialized by another job or instance
+var ErrJobRunning = errors.New("tenant initialization is already running")
+
+// Planner is implemented by the plugins which can list what they would create without writing anything.
+// A dry run does not record the template in the tenant config, so its name is passed.
+type Planner interface {
+	Plan(ctx context.Context, req *tenant.InitRequest, template string) ([]initjob.PlanItem, error)
+}
+
+// CompletionHook is implemented by the plugins which act after all plugins succeeded, e.g. reloading the policies
+type CompletionHook interface {
+	OnInitializationComplete(ctx context.Context, req *tenant.InitRequest, result *tenant.ExecutionResult) error
+}
+
+// JobRunner runs the tenant initialization jobs in background under a redis lock of the tenant
+// shared by the RPC instances, the progress of each plugin is saved in the job.
+type JobRunner struct {
+	db      *ent.Client
+	rds     redis.UniversalClient
+	redisDB int
+	locker  *redislock.Client
+	logger  logx.Logger
+}
+
+// NewJobRunner creates a job runner
+func NewJobRunner(db *ent.Client, rds redis.UniversalClient, redisDB int) *JobRunner {
+	return &JobRunner{
+		db:      db,
+		rds:     rds,
+		redisDB: redisDB,
+		locker:  redislock.New(rds),
+		logger:  logx.WithContext(context.Background()),
+	}
+}
+
+// Running reports whether a job of the tenant is being executed by any instance
+func (r *JobRunner) Running(ctx context.Context, tenantID uint64) (bool, error) {
+	n, err := r.rds.Exists(ctx, fmt.Sprintf(jobLockKey, tenantID)).Result()
+	return n > 0, err
+}
+
+// Start runs the saved job in background. The plugins are in execution order, the plugins which succeeded
+// in a previous run of the job are skipped. The request carries the admin credentials, which are not stored.
+func (r *JobRunner) Start(job *ent.TenantInitJob, plugins []tenant.TenantInitPlugin, req *tenant.InitRequest) {
+	go func() {
+		defer func() {
+			if v := recover(); v != nil {
+				r.logger.Errorw("tenant initialization job panicked", logx.Field("detail", fmt.Sprint(v)),
+					logx.Field("job", job.ID))
+				r.finish(context.Background(), job, nil, fmt.Errorf("panic: %v", v), "")
+			}
+		}()
+
+		r.run(job, plugins, req)
+	}()
+}
+
+func (r *JobRunner) run(job *ent.TenantInitJob, plugins []tenant.TenantInitPlugin, req *tenant.InitRequest) {
+	timeout := req.Timeout
+	if timeout <= 0 {
+		timeout = DefaultJobTimeout
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	lock, err := r.locker.Obtain(ctx, fmt.Sprintf(jobLockKey, job.TenantID), jobLockTTL, nil)
+	if err != nil {
+		if errors.Is(err, redislock.ErrNotObtained) {
+			err = ErrJobRunning
+		}
+		r.finish(ctx, job, job.Plugins, err, "")
+		return
+	}
+	defer func() {
+		_ = lock.Release(context.Background())
+	}()
+
+	progress := mergeProgress(job.Plugins, plugins)
+	startedAt := time.Now()
+	err = r.db.TenantInitJob.UpdateOneID(job.ID).
+		SetStatus(initjob.StatusRunning).
+		AddAttempts(1).
+		SetStartedAt(startedAt).
+		ClearFinishedAt().
+		ClearError().
+		SetPlugins(progress).
+		Exec(hooks.NewSystemContext(ctx))
+	if err != nil {
+		r.logger.Errorw("failed to start tenant initialization job", logx.Field("detail", err.Error()),
+			logx.Field("job", job.ID))
+		return
+	}
+
+	if job.DryRun {
+		plan, err := r.plan(ctx, job, plugins, progress, req)
+		if err == nil {
+			err = r.db.TenantInitJob.UpdateOneID(job.ID).SetPlan(plan).Exec(hooks.NewSystemContext(ctx))
+		}
+		r.finish(ctx, job, progress, err, "")
+		return
+	}
+
+	failedPlugin, err := r.execute(ctx, job, plugins, progress, req)
+	if err == nil {
+		r.complete(ctx, plugins, progress, req, time.Since(startedAt))
+	}
+	r.finish(ctx, job, progress, err, failedPlugin)
+}
+
+// execute runs the plugins which have not succeeded yet, the plugins depending on a failed plugin are skipped.
+//
+// A full run is a saga: the plugins whose tenant is initialized already are not run again, the first failure
+// stops the run and the plugins which ran before it are rolled back in reverse order, so that a failed
+// service does not leave a half-provisioned tenant. A repair run does not roll back since the tenant may
+// hold data created before the job, a failed plugin does not stop the plugins independent of it.
+func (r *JobRunner) execute(ctx context.Context, job *ent.TenantInitJob, plugins []tenant.TenantInitPlugin,
+	progress []initjob.PluginProgress, req *tenant.InitRequest,
+) (string, error) {
+	status := make(map[string]string, len(plugins))
+	for i := range progress {
+		status[progress[i].Name] = progress[i].Status
+	}
+
+	saga := req.Mode == tenant.InitModeFull
+	var failed []string
+	var done []int
+	for i, p := range plugins {
+		item := &progress[i]
+		if item.Status == initjob.StatusSuccess {
+			continue
+		}
+
+		if dep := unmetDependency(p.GetMetadata().Dependencies, status); dep != "" {
+			item.Status = initjob.StatusSkipped
+			item.Error = fmt.Sprintf("dependency %s did not succeed", dep)
+			status[item.Name] = item.Status
+			continue
+		}
+
+		now := time.Now()
+		item.Status = initjob.StatusRunning
+		item.Error = ""
+		item.Attempts++
+		item.StartedAt = &now
+		item.FinishedAt = nil
+		r.saveProgress(ctx, job.ID, progress)
+
+		var err error
+		initialized := false
+		if saga {
+			initialized, err = p.IsInitialized(ctx, req.TenantID)
+		}
+		if err == nil && !initialized {
+			err = p.Initialize(ctx, req)
+		}
+
+		finishedAt := time.Now()
+		item.FinishedAt = &finishedAt
+		if err != nil {
+			item.Status = initjob.StatusFailed
+			item.Error = err.Error()
+			failed = append(failed, item.Name)
+			r.logger.Errorw("tenant initialization plugin failed", logx.Field("detail", err.Error()),
+				logx.Field("job", job.ID), logx.Field("tenant_id", job.TenantID), logx.Field("plugin", item.Name))
+		} else {
+			item.Status = initjob.StatusSuccess
+			if !initialized {
+				done = append(done, i)
+			}
+		}
+		status[item.Name] = item.Status
+		r.saveProgress(ctx, job.ID, progress)
+
+		if err != nil && saga {
+			break
+		}
+	}
+
+	if len(failed) == 0 {
+		return "", nil
+	}
+
+	err := fmt.Errorf("plugins failed: %s", strings.Join(failed, ", "))
+	if saga {
+		if rolledBack := r.compensate(ctx, job, plugins, progress, done); len(rolledBack) > 0 {
+			err = fmt.Errorf("%w, rolled back: %s", err, strings.Join(rolledBack, ", "))
+		}
+	}
+	return failed[0], err
+}
+
+// compensate rolls back the plugins which ran in this run in reverse order. A plugin which cannot roll back,
+// or fails to, keeps its data and stays successful, a retry does not run it again.
+func (r *JobRunner) compensate(ctx context.Context, job *ent.TenantInitJob, plugins []tenant.TenantInitPlugin,
+	progress []initjob.PluginProgress, done []int,
+) []string {
+	var rolledBack []string
+	for i := len(done) - 1; i >= 0; i-- {
+		p, item := plugins[done[i]], &progress[done[i]]
+		if !p.GetMetadata().SupportRollback {
+			continue
+		}
+
+		if err := p.Rollback(ctx, job.TenantID); err != nil {
+			item.Error = fmt.Sprintf("rollback failed: %s", err.Error())
+			r.logger.Errorw("failed to roll back tenant initialization plugin", logx.Field("detail", err.Error()),
+				logx.Field("job", job.ID), logx.Field("tenant_id", job.TenantID), logx.Field("plugin", item.Name))
+			continue
+		}
+		item.Status = initjob.StatusRolledBack
+		rolledBack = append(rolledBack, item.Name)
+	}
+	r.saveProgress(ctx, job.ID, progress)
+
+	return rolledBack
+}
+
+// plan collects what the plugins would create, the plugins which cannot plan are listed as a whole
+func (r *JobRunner) plan(ctx context.Context, job *ent.TenantInitJob, plugins []tenant.TenantInitPlugin,
+	progress []initjob.PluginProgress, req *tenant.InitRequest,
+) ([]initjob.PlanItem, error) {
+	var result []initjob.PlanItem
+	var failed []string
+	for i, p := range plugins {
+		item := &progress[i]
+		item.Attempts++
+
+		planner, ok := p.(Planner)
+		if !ok {
+			result = append(result, initjob.PlanItem{Plugin: item.Name, Component: item.Name, Action: initjob.ActionCreate})
+			item.Status = initjob.StatusSuccess
+			continue
+		}
+
+		items, err := planner.Plan(ctx, req, job.Template)
+		if err != nil {
+			item.Status = initjob.StatusFailed
+			item.Error = err.Error()
+			failed = append(failed, item.Name)
+			continue
+		}
+		item.Status = initjob.StatusSuccess
+		result = append(result, items...)
+	}
+	r.saveProgress(ctx, job.ID, progress)
+
+	if len(failed) > 0 {
+		return result, fmt.Errorf("plugins failed to plan: %s", strings.Join(failed, ", "))
+	}
+	return result, nil
+}
+
+// complete calls the completion hooks of the plugins, their errors do not fail the job
+func (r *JobRunner) complete(ctx context.Context, plugins []tenant.TenantInitPlugin, progress []initjob.PluginProgress,
+	req *tenant.InitRequest, duration time.Duration,
+) {
+	result := &tenant.ExecutionResult{
+		RequestID:     req.RequestID,
+		TenantID:      req.TenantID,
+		Status:        tenant.StatusSuccess,
+		Duration:      duration,
+		PluginResults: make(map[string]*tenant.PluginResult, len(progress)),
+	}
+	for _, v := range progress {
+		var pluginDuration time.Duration
+		if v.StartedAt != nil && v.FinishedAt != nil {
+			pluginDuration = v.FinishedAt.Sub(*v.StartedAt)
+		}
+		result.PluginResults[v.Name] = &tenant.PluginResult{
+			PluginName: v.Name,
+			Status:     tenant.StatusSuccess,
+			Duration:   pluginDuration,
+		}
+	}
+
+	for _, p := range plugins {
+		hook, ok := p.(CompletionHook)
+		if !ok {
+			continue
+		}
+		if err := hook.OnInitializationComplete(ctx, req, result); err != nil {
+			r.logger.Errorw("tenant initialization completion hook failed", logx.Field("detail", err.Error()),
+				logx.Field("tenant_id", req.TenantID), logx.Field("plugin", p.GetMetadata().Name))
+		}
+	}
+}
+
+// finish records the result of the run and announces it
+func (r *JobRunner) finish(ctx context.Context, job *ent.TenantInitJob, progress []initjob.PluginProgress, runErr error, failedPlugin string) {
+	// 任务超时后仍需记录结果
+	ctx = hooks.NewSystemContext(context.WithoutCancel(ctx))
+
+	status := initjob.StatusSuccess
+	query := r.db.TenantInitJob.UpdateOneID(job.ID).SetFinishedAt(time.Now())
+	if progress != nil {
+		query.SetPlugins(progress)
+	}
+	if runErr != nil {
+		status = initjob.StatusFailed
+		query.SetError(runErr.Error())
+	}
+	if err := query.SetStatus(status).Exec(ctx); err != nil {
+		r.logger.Errorw("failed to save the result of tenant initialization job", logx.Field("detail", err.Error()),
+			logx.Field("job", job.ID))
+	}
+
+	event := redisfunc.TenantInitEvent{
+		JobID:        job.ID,
+		TenantID:     job.TenantID,
+		Status:       status,
+		DryRun:       job.DryRun,
+		FailedPlugin: failedPlugin,
+	}
+	if runErr != nil {
+		event.Error = runErr.Error()
+	}
+	if err := redisfunc.PublishTenantInitEvent(ctx, r.rds, r.redisDB, event); err != nil {
+		r.logger.Errorw("failed to publish tenant initialization event", logx.Field("detail", err.Error()),
+			logx.Field("job", job.ID))
+	}
+
+	r.logger.Infow("tenant initialization job finished", logx.Field("job", job.ID), logx.Field("tenant_id", job.TenantID),
+		logx.Field("status", status), logx.Field("dryRun", job.DryRun))
+}
+
+func (r *JobRunner) saveProgress(ctx context.Context, jobID uint64, progress []initjob.PluginProgress) {
+	if err := r.db.TenantInitJob.UpdateOneID(jobID).SetPlugins(progress).Exec(hooks.NewSystemContext(ctx)); err != nil {
+		r.logger.Errorw("failed to save the progress of tenant initialization job", logx.Field("detail", err.Error()),
+			logx.Field("job", jobID))
+	}
+}
+
+// mergeProgress lists the plugins in execution order and keeps the progress of the previous run
+func mergeProgress(previous []initjob.PluginProgress, plugins []tenant.TenantInitPlugin) []initjob.PluginProgress {
+	byName := make(map[string]initjob.PluginProgress, len(previous))
+	for _, v := range previous {
+		byName[v.Name] = v
+	}
+
+	result := make([]initjob.PluginProgress, 0, len(plugins))
+	for _, p := range plugins {
+		name := p.GetMetadata().Name
+		item, ok := byName[name]
+		if !ok || item.Status != initjob.StatusSuccess {
+			item = initjob.PluginProgress{Name: name, Status: initjob.StatusPending, Attempts: item.Attempts}
+		}
+		result = append(result, item)
+	}
+	return result
+}
+
+// unmetDependency returns the first dependency which ran in the job but did not succeed
+func unmetDependency(dependencies []string, status map[string]string) string {
+	for _, dep := range dependencies {
+		if s, ok := status[dep]; ok && s != initjob.StatusSuccess {
+			return dep
+		}
+	}
+	return ""
+}
@@ -0,0 +1,111 @@
+package tenantinit
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/coder-lulu/newbee-common/v2/enum/common"
+
+	"github.com/coder-lulu/newbee-core/rpc/ent"
+	"github.com/coder-lulu/newbee-core/rpc/ent/tenantinittemplate"
+)
+
+const (
+	// TemplateConfigKey 租户配置中记录初始化模板名称的键
+	TemplateConfigKey = "template"
+	// maxTemplateDepth 基础模板链的最大层数，防止循环引用
+	maxTemplateDepth = 5
+)
+
+var (
+	// ErrTemplateNotFound 模板不存在或已停用
+	ErrTemplateNotFound = errors.New("tenant initialization template not found")
+	// ErrTemplateCycle 基础模板链存在循环引用或层数过多
+	ErrTemplateCycle = errors.New("tenant initialization template has a cyclic or too deep base chain")
+)
+
+// TemplateName returns the initialization template recorded in the tenant config, empty means the default template
+func TemplateName(config map[string]interface{}) string {
+	name, _ := config[TemplateConfigKey].(string)
+	return name
+}
+
+// FromEnt converts the stored template, the base template is not merged
+func FromEnt(t *ent.TenantInitTemplate) (*InitializationTemplate, error) {
+	result := &InitializationTemplate{
+		Name:        t.Name,
+		Version:     t.Version,
+		Description: t.Description,
+	}
+	if err := json.Unmarshal([]byte(t.Components), &result.Components); err != nil {
+		return nil, ErrInvalidTemplate{Reason: fmt.Sprintf("组件解析失败: %v", err)}
+	}
+	return result, nil
+}
+
+// Resolve loads the enabled template by name and merges it onto its base templates. An empty name means the
+// default template, the built-in default template is used when it is not stored.
+func Resolve(ctx context.Context, db *ent.Client, name string) (*InitializationTemplate, error) {
+	if name == "" {
+		name = DefaultTemplateName
+	}
+
+	return resolve(ctx, db, name, nil)
+}
+
+// ResolveWith merges the unsaved template onto its base templates, used to validate a template before saving it
+func ResolveWith(ctx context.Context, db *ent.Client, t *InitializationTemplate, base string) (*InitializationTemplate, error) {
+	if base == "" {
+		return t, nil
+	}
+
+	parent, err := resolve(ctx, db, base, []string{t.Name})
+	if err != nil {
+		return nil, err
+	}
+
+	helper := &InitConfigHelper{}
+	return helper.MergeTemplates(parent, t), nil
+}
+
+func resolve(ctx context.Context, db *ent.Client, name string, chain []string) (*InitializationTemplate, error) {
+	for _, v := range chain {
+		if v == name {
+			return nil, ErrTemplateCycle
+		}
+	}
+	if len(chain) >= maxTemplateDepth {
+		return nil, ErrTemplateCycle
+	}
+
+	record, err := db.TenantInitTemplate.Query().
+		Where(tenantinittemplate.NameEQ(name), tenantinittemplate.StatusEQ(common.StatusNormal)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			if name == DefaultTemplateName {
+				return GetDefaultInitTemplate(), nil
+			}
+			return nil, ErrTemplateNotFound
+		}
+		return nil, err
+	}
+
+	t, err := FromEnt(record)
+	if err != nil {
+		return nil, err
+	}
+	if record.BaseTemplate == "" {
+		return t, nil
+	}
+
+	parent, err := resolve(ctx, db, record.BaseTemplate, append(chain, name))
+	if err != nil {
+		return nil, err
+	}
+
+	helper := &InitConfigHelper{}
+	return helper.MergeTemplates(parent, t), nil
+}
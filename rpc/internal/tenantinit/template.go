@@ -1,19 +1,37 @@
-package tenant
+package tenantinit
 
 import (
 	"context"
 	"encoding/json"
 	"fmt"
 
+	"github.com/zeromicro/go-zero/core/logx"
+
 	"github.com/coder-lulu/newbee-core/rpc/ent"
 )
 
+// 模板组件名称
+const (
+	ComponentDictionaries   = "dictionaries"
+	ComponentConfigurations = "configurations"
+	ComponentPositions      = "positions"
+	ComponentMenus          = "menus"
+	ComponentAPIPermissions = "api_permissions"
+)
+
+// builtinComponents 由初始化流程固定执行的步骤，模板组件可以依赖它们
+var builtinComponents = map[string]bool{
+	"departments": true,
+	"admin_role":  true,
+	"admin_user":  true,
+}
+
 // InitializationTemplate 初始化模板配置
 type InitializationTemplate struct {
-	Name        string                `json:"name"`
-	Version     string                `json:"version"`
-	Description string                `json:"description"`
-	Components  []ComponentTemplate   `json:"components"`
+	Name        string              `json:"name"`
+	Version     string              `json:"version"`
+	Description string              `json:"description"`
+	Components  []ComponentTemplate `json:"components"`
 }
 
 // ComponentTemplate 组件初始化模板
@@ -26,9 +44,9 @@ type ComponentTemplate struct {
 
 // DictionaryTemplate 字典模板
 type DictionaryTemplate struct {
-	Title   string                   `json:"title"`
-	Name    string                   `json:"name"`
-	Desc    string                   `json:"desc"`
+	Title   string                     `json:"title"`
+	Name    string                     `json:"name"`
+	Desc    string                     `json:"desc"`
 	Details []DictionaryDetailTemplate `json:"details"`
 }
 
@@ -60,7 +78,13 @@ type PositionTemplate struct {
 	Sort   int    `json:"sort"`
 }
 
-// APIPermissionTemplate API权限模板
+// MenuTemplate 菜单模板，按菜单名称从系统菜单中筛选，包含的菜单会连同其子菜单一起复制，为空时复制全部系统菜单
+type MenuTemplate struct {
+	Include []string `json:"include"`
+	Exclude []string `json:"exclude"`
+}
+
+// APIPermissionTemplate API权限模板，管理员角色只授予列出的接口，未配置该组件时授予全部接口
 type APIPermissionTemplate struct {
 	Path        string `json:"path"`
 	Description string `json:"description"`
@@ -68,15 +92,18 @@ type APIPermissionTemplate struct {
 	Method      string `json:"method"`
 }
 
-// GetDefaultInitTemplate 获取默认初始化模板
+// DefaultTemplateName 默认模板名称，未指定模板时使用，其它模板通常以它为基础模板叠加行业配置
+const DefaultTemplateName = "base"
+
+// GetDefaultInitTemplate 获取默认初始化模板，数据库中没有默认模板时使用
 func GetDefaultInitTemplate() *InitializationTemplate {
 	return &InitializationTemplate{
-		Name:        "标准企业模板",
+		Name:        DefaultTemplateName,
 		Version:     "1.0.0",
 		Description: "标准企业租户初始化模板，包含基础的字典、配置、权限等数据",
 		Components: []ComponentTemplate{
 			{
-				Name:         "dictionaries",
+				Name:         ComponentDictionaries,
 				Dependencies: []string{},
 				Required:     true,
 				Data: []DictionaryTemplate{
@@ -108,10 +135,29 @@ func GetDefaultInitTemplate() *InitializationTemplate {
 							{Title: "否", Value: "0", Sort: 2, IsDefault: 1, ListClass: "default"},
 						},
 					},
+					{
+						Title: "显示隐藏",
+						Name:  "sys_show_hide",
+						Desc:  "菜单显示隐藏状态",
+						Details: []DictionaryDetailTemplate{
+							{Title: "显示", Value: "1", Sort: 1, IsDefault: 1},
+							{Title: "隐藏", Value: "0", Sort: 2, IsDefault: 0},
+						},
+					},
+					{
+						Title: "操作类型",
+						Name:  "sys_oper_type",
+						Desc:  "操作类型列表",
+						Details: []DictionaryDetailTemplate{
+							{Title: "新增", Value: "1", Sort: 1, IsDefault: 0},
+							{Title: "修改", Value: "2", Sort: 2, IsDefault: 0},
+							{Title: "删除", Value: "3", Sort: 3, IsDefault: 0},
+						},
+					},
 				},
 			},
 			{
-				Name:         "configurations",
+				Name:         ComponentConfigurations,
 				Dependencies: []string{},
 				Required:     true,
 				Data: []ConfigurationTemplate{
@@ -124,7 +170,7 @@ func GetDefaultInitTemplate() *InitializationTemplate {
 				},
 			},
 			{
-				Name:         "positions",
+				Name:         ComponentPositions,
 				Dependencies: []string{"departments"},
 				Required:     true,
 				Data: []PositionTemplate{
@@ -135,28 +181,6 @@ func GetDefaultInitTemplate() *InitializationTemplate {
 					{Name: "普通员工", Code: "employee", Remark: "普通工作人员", Sort: 5},
 				},
 			},
-			{
-				Name:         "api_permissions",
-				Dependencies: []string{},
-				Required:     false,
-				Data: []APIPermissionTemplate{
-					// 用户管理
-					{Path: "/api/v1/user/list", Description: "获取用户列表", APIGroup: "user", Method: "GET"},
-					{Path: "/api/v1/user/create", Description: "创建用户", APIGroup: "user", Method: "POST"},
-					{Path: "/api/v1/user/update", Description: "更新用户", APIGroup: "user", Method: "PUT"},
-					{Path: "/api/v1/user/delete", Description: "删除用户", APIGroup: "user", Method: "DELETE"},
-					// 角色管理
-					{Path: "/api/v1/role/list", Description: "获取角色列表", APIGroup: "role", Method: "GET"},
-					{Path: "/api/v1/role/create", Description: "创建角色", APIGroup: "role", Method: "POST"},
-					{Path: "/api/v1/role/update", Description: "更新角色", APIGroup: "role", Method: "PUT"},
-					{Path: "/api/v1/role/delete", Description: "删除角色", APIGroup: "role", Method: "DELETE"},
-					// 部门管理
-					{Path: "/api/v1/department/list", Description: "获取部门列表", APIGroup: "department", Method: "GET"},
-					{Path: "/api/v1/department/create", Description: "创建部门", APIGroup: "department", Method: "POST"},
-					{Path: "/api/v1/department/update", Description: "更新部门", APIGroup: "department", Method: "PUT"},
-					{Path: "/api/v1/department/delete", Description: "删除部门", APIGroup: "department", Method: "DELETE"},
-				},
-			},
 		},
 	}
 }
@@ -170,11 +194,11 @@ type TenantInitializer interface {
 
 // DefaultTenantInitializer 默认租户初始化器
 type DefaultTenantInitializer struct {
-	logger interface{ Infow(msg string, keysAndValues ...interface{}) }
+	logger logx.Logger
 }
 
 // NewDefaultTenantInitializer 创建默认租户初始化器
-func NewDefaultTenantInitializer(logger interface{ Infow(msg string, keysAndValues ...interface{}) }) *DefaultTenantInitializer {
+func NewDefaultTenantInitializer(logger logx.Logger) *DefaultTenantInitializer {
 	return &DefaultTenantInitializer{logger: logger}
 }
 
@@ -193,17 +217,88 @@ func (d *DefaultTenantInitializer) Validate(template *InitializationTemplate) er
 	// 验证组件依赖关系
 	componentNames := make(map[string]bool)
 	for _, comp := range template.Components {
+		if componentNames[comp.Name] {
+			return ErrInvalidTemplate{Reason: fmt.Sprintf("组件 %s 重复", comp.Name)}
+		}
 		componentNames[comp.Name] = true
 	}
 
 	for _, comp := range template.Components {
 		for _, dep := range comp.Dependencies {
-			if !componentNames[dep] {
+			if !componentNames[dep] && !builtinComponents[dep] {
 				return ErrInvalidTemplate{
 					Reason: fmt.Sprintf("组件 %s 依赖的组件 %s 不存在", comp.Name, dep),
 				}
 			}
 		}
+
+		if err := validateComponentData(comp); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateComponentData 校验组件数据能否解析为对应的模板类型
+func validateComponentData(comp ComponentTemplate) error {
+	invalid := func(reason string) error {
+		return ErrInvalidTemplate{Reason: fmt.Sprintf("组件 %s %s", comp.Name, reason)}
+	}
+
+	switch comp.Name {
+	case ComponentDictionaries:
+		var data []DictionaryTemplate
+		if err := comp.DecodeData(&data); err != nil {
+			return invalid("数据格式错误")
+		}
+		names := make(map[string]bool, len(data))
+		for _, v := range data {
+			if v.Name == "" || v.Title == "" {
+				return invalid("的字典名称和标题不能为空")
+			}
+			if names[v.Name] {
+				return invalid(fmt.Sprintf("的字典 %s 重复", v.Name))
+			}
+			names[v.Name] = true
+		}
+	case ComponentConfigurations:
+		var data []ConfigurationTemplate
+		if err := comp.DecodeData(&data); err != nil {
+			return invalid("数据格式错误")
+		}
+		for _, v := range data {
+			if v.Key == "" || v.Name == "" {
+				return invalid("的配置名称和键不能为空")
+			}
+		}
+	case ComponentPositions:
+		var data []PositionTemplate
+		if err := comp.DecodeData(&data); err != nil {
+			return invalid("数据格式错误")
+		}
+		for _, v := range data {
+			if v.Code == "" || v.Name == "" {
+				return invalid("的职位名称和编码不能为空")
+			}
+		}
+	case ComponentMenus:
+		var data MenuTemplate
+		if err := comp.DecodeData(&data); err != nil {
+			return invalid("数据格式错误")
+		}
+	case ComponentAPIPermissions:
+		var data []APIPermissionTemplate
+		if err := comp.DecodeData(&data); err != nil {
+			return invalid("数据格式错误")
+		}
+		for _, v := range data {
+			if v.Path == "" || v.Method == "" {
+				return invalid("的接口路径和方法不能为空")
+			}
+		}
+	default:
+		return invalid("不受支持")
 	}
 
 	return nil
@@ -218,6 +313,28 @@ func (e ErrInvalidTemplate) Error() string {
 	return fmt.Sprintf("无效的初始化模板: %s", e.Reason)
 }
 
+// DecodeData 将组件数据解析为对应的模板类型，从 JSON 加载的模板数据为通用的 map 和 slice
+func (c ComponentTemplate) DecodeData(v any) error {
+	if c.Data == nil {
+		return nil
+	}
+	data, err := json.Marshal(c.Data)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
+// Component 返回指定名称的组件
+func (t *InitializationTemplate) Component(name string) (ComponentTemplate, bool) {
+	for _, comp := range t.Components {
+		if comp.Name == name {
+			return comp, true
+		}
+	}
+	return ComponentTemplate{}, false
+}
+
 // InitConfigHelper 初始化配置助手
 type InitConfigHelper struct{}
 
@@ -235,26 +352,35 @@ func (h *InitConfigHelper) SaveTemplate(template *InitializationTemplate) ([]byt
 	return json.MarshalIndent(template, "", "  ")
 }
 
-// MergeTemplates 合并多个模板
+// MergeTemplates 合并多个模板，叠加模板的同名组件替换基础模板的组件，新组件追加在后面，
+// 合并结果使用最后一个叠加模板的名称、版本和描述
 func (h *InitConfigHelper) MergeTemplates(base *InitializationTemplate, overlays ...*InitializationTemplate) *InitializationTemplate {
 	result := *base
-	
+	result.Components = append([]ComponentTemplate(nil), base.Components...)
+
 	for _, overlay := range overlays {
-		// 合并组件
-		componentMap := make(map[string]ComponentTemplate)
-		for _, comp := range result.Components {
-			componentMap[comp.Name] = comp
+		index := make(map[string]int, len(result.Components))
+		for i, comp := range result.Components {
+			index[comp.Name] = i
 		}
-		
+
 		for _, comp := range overlay.Components {
-			componentMap[comp.Name] = comp
-		}
-		
-		result.Components = make([]ComponentTemplate, 0, len(componentMap))
-		for _, comp := range componentMap {
+			if i, ok := index[comp.Name]; ok {
+				result.Components[i] = comp
+				continue
+			}
+			index[comp.Name] = len(result.Components)
 			result.Components = append(result.Components, comp)
 		}
+
+		result.Name = overlay.Name
+		if overlay.Version != "" {
+			result.Version = overlay.Version
+		}
+		if overlay.Description != "" {
+			result.Description = overlay.Description
+		}
 	}
-	
+
 	return &result
-}
\ No newline at end of file
+}
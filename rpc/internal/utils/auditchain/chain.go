@@ -0,0 +1,218 @@
+package auditchain
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/coder-lulu/newbee-common/v2/utils/uuidx"
+
+	"github.com/coder-lulu/newbee-core/rpc/ent"
+	"github.com/coder-lulu/newbee-core/rpc/ent/auditlog"
+	"github.com/coder-lulu/newbee-core/rpc/ent/auditlogchain"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/entx"
+)
+
+// ErrAppendOnly 审计日志只允许追加
+var ErrAppendOnly = errors.New("audit logs are append-only")
+
+type pruneCtxKey struct{}
+
+// WithPrune marks the context as a retention pruning context, only such context can delete audit logs
+func WithPrune(ctx context.Context) context.Context {
+	return context.WithValue(ctx, pruneCtxKey{}, true)
+}
+
+// AppendOnlyHook 禁止修改审计日志，删除只允许由保留策略发起
+func AppendOnlyHook() ent.Hook {
+	return func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if m.Op().Is(ent.OpUpdate | ent.OpUpdateOne) {
+				return nil, ErrAppendOnly
+			}
+			if m.Op().Is(ent.OpDelete|ent.OpDeleteOne) && ctx.Value(pruneCtxKey{}) == nil {
+				return nil, ErrAppendOnly
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+}
+
+// canonicalRecord 参与哈希计算的字段，字段顺序固定，不可随意调整
+type canonicalRecord struct {
+	ID             string         `json:"id"`
+	TenantID       string         `json:"tenantId"`
+	Seq            uint64         `json:"seq"`
+	PrevHash       string         `json:"prevHash"`
+	CreatedAt      int64          `json:"createdAt"`
+	Status         uint8          `json:"status"`
+	UserID         string         `json:"userId"`
+	UserName       string         `json:"userName"`
+	OperationType  string         `json:"operationType"`
+	ResourceType   string         `json:"resourceType"`
+	ResourceID     string         `json:"resourceId"`
+	RequestMethod  string         `json:"requestMethod"`
+	RequestPath    string         `json:"requestPath"`
+	RequestData    string         `json:"requestData"`
+	ResponseStatus int            `json:"responseStatus"`
+	ResponseData   string         `json:"responseData"`
+	IPAddress      string         `json:"ipAddress"`
+	UserAgent      string         `json:"userAgent"`
+	DurationMs     int64          `json:"durationMs"`
+	ErrorMessage   string         `json:"errorMessage"`
+	Metadata       map[string]any `json:"metadata"`
+}
+
+// Hash computes the chain hash of the record, the seq and prev_hash of the record must be set
+func Hash(l *ent.AuditLog) string {
+	var seq uint64
+	if l.Seq != nil {
+		seq = *l.Seq
+	}
+
+	// json.Marshal 对 map 的键排序，结果稳定
+	data, _ := json.Marshal(canonicalRecord{
+		ID:             l.ID.String(),
+		TenantID:       l.TenantID,
+		Seq:            seq,
+		PrevHash:       l.PrevHash,
+		CreatedAt:      l.CreatedAt.Unix(),
+		Status:         l.Status,
+		UserID:         l.UserID,
+		UserName:       l.UserName,
+		OperationType:  string(l.OperationType),
+		ResourceType:   l.ResourceType,
+		ResourceID:     l.ResourceID,
+		RequestMethod:  l.RequestMethod,
+		RequestPath:    l.RequestPath,
+		RequestData:    l.RequestData,
+		ResponseStatus: l.ResponseStatus,
+		ResponseData:   l.ResponseData,
+		IPAddress:      l.IPAddress,
+		UserAgent:      l.UserAgent,
+		DurationMs:     l.DurationMs,
+		ErrorMessage:   l.ErrorMessage,
+		Metadata:       l.Metadata,
+	})
+
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// Head returns the latest chained record of the tenant, nil if the chain is empty
+func Head(ctx context.Context, db *ent.Client, tenantID string) (*ent.AuditLog, error) {
+	head, err := db.AuditLog.Query().
+		Where(auditlog.TenantIDEQ(tenantID), auditlog.SeqNotNil()).
+		Order(ent.Desc(auditlog.FieldSeq)).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	return head, err
+}
+
+// Append links the record to the end of the tenant chain and saves it.
+// ID, CreatedAt, Seq, PrevHash and Hash of the record are generated here.
+// 链头行在事务中加锁，同一租户的并发写入依次执行，不会因竞争丢弃记录
+func Append(ctx context.Context, db *ent.Client, l *ent.AuditLog) (*ent.AuditLog, error) {
+	l.ID = uuidx.NewUUID()
+	// 数据库 datetime 精度为秒，哈希中的时间必须与读回的值一致
+	l.CreatedAt = time.Now().Truncate(time.Second)
+	l.UpdatedAt = l.CreatedAt
+
+	if err := ensureChain(ctx, db, l.TenantID); err != nil {
+		return nil, err
+	}
+
+	err := entx.WithTx(ctx, db, func(tx *ent.Tx) error {
+		head, err := tx.AuditLogChain.Query().
+			Where(auditlogchain.TenantIDEQ(l.TenantID)).
+			Modify(func(s *sql.Selector) { s.ForUpdate() }).
+			Only(ctx)
+		if err != nil {
+			return err
+		}
+
+		seq := head.Seq + 1
+		l.Seq = &seq
+		l.PrevHash = head.Hash
+		l.Hash = Hash(l)
+
+		err = tx.AuditLogChain.UpdateOne(head).
+			SetSeq(seq).
+			SetHash(l.Hash).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+
+		return tx.AuditLog.Create().
+			SetID(l.ID).
+			SetCreatedAt(l.CreatedAt).
+			SetUpdatedAt(l.UpdatedAt).
+			SetStatus(l.Status).
+			SetTenantID(l.TenantID).
+			SetUserID(l.UserID).
+			SetUserName(l.UserName).
+			SetOperationType(l.OperationType).
+			SetResourceType(l.ResourceType).
+			SetResourceID(l.ResourceID).
+			SetRequestMethod(l.RequestMethod).
+			SetRequestPath(l.RequestPath).
+			SetRequestData(l.RequestData).
+			SetResponseStatus(l.ResponseStatus).
+			SetResponseData(l.ResponseData).
+			SetIPAddress(l.IPAddress).
+			SetUserAgent(l.UserAgent).
+			SetDurationMs(l.DurationMs).
+			SetErrorMessage(l.ErrorMessage).
+			SetMetadata(l.Metadata).
+			SetSeq(seq).
+			SetPrevHash(l.PrevHash).
+			SetHash(l.Hash).
+			Exec(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return l, nil
+}
+
+// ensureChain creates the chain head of the tenant when it does not exist,
+// a concurrent writer creating it first is not an error
+func ensureChain(ctx context.Context, db *ent.Client, tenantID string) error {
+	exist, err := db.AuditLogChain.Query().Where(auditlogchain.TenantIDEQ(tenantID)).Exist(ctx)
+	if err != nil || exist {
+		return err
+	}
+
+	if _, err = initChain(ctx, db, tenantID); err != nil && !ent.IsConstraintError(err) {
+		return err
+	}
+	return nil
+}
+
+// initChain creates the chain head of the tenant from the latest chained record
+func initChain(ctx context.Context, db *ent.Client, tenantID string) (*ent.AuditLogChain, error) {
+	last, err := Head(ctx, db, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	var seq uint64
+	var hash string
+	if last != nil {
+		seq, hash = *last.Seq, last.Hash
+	}
+
+	return db.AuditLogChain.Create().
+		SetTenantID(tenantID).
+		SetSeq(seq).
+		SetHash(hash).
+		Save(ctx)
+}
@@ -0,0 +1,71 @@
+package auditchain
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/logx"
+
+	"github.com/coder-lulu/newbee-core/rpc/internal/config"
+)
+
+const defaultKeyID = "default"
+
+// Signer signs checkpoints and prune records with HMAC-SHA256
+type Signer struct {
+	activeID string
+	keys     map[string][]byte
+}
+
+// NewSigner creates a signer from the config, fallback is used as the key when no signing key is configured
+func NewSigner(c config.AuditLogConf, fallback string) *Signer {
+	s := &Signer{activeID: c.ActiveKeyID, keys: make(map[string][]byte)}
+	for _, v := range c.SigningKeys {
+		s.keys[v.ID] = []byte(v.Secret)
+	}
+
+	if len(s.keys) == 0 {
+		logx.Infow("Audit log signing key is not configured, using encryption key - MUST configure in production!")
+		s.keys[defaultKeyID] = []byte(fallback)
+		s.activeID = defaultKeyID
+	}
+
+	if _, ok := s.keys[s.activeID]; !ok {
+		panic(fmt.Sprintf("audit log signing key %q not found", s.activeID))
+	}
+
+	return s
+}
+
+// Sign returns the key ID and signature of the payload
+func (s *Signer) Sign(payload string) (string, string) {
+	return s.activeID, s.sign(s.keys[s.activeID], payload)
+}
+
+// Verify checks the signature with the key of the key ID
+func (s *Signer) Verify(keyID, payload, signature string) bool {
+	key, ok := s.keys[keyID]
+	if !ok {
+		return false
+	}
+	return hmac.Equal([]byte(s.sign(key, payload)), []byte(signature))
+}
+
+func (s *Signer) sign(key []byte, payload string) string {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(payload))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// CheckpointPayload returns the signed content of a checkpoint
+func CheckpointPayload(tenantID string, seq uint64, hash string) string {
+	return fmt.Sprintf("checkpoint|%s|%d|%s", tenantID, seq, hash)
+}
+
+// PrunePayload returns the signed content of a prune record
+func PrunePayload(tenantID string, fromSeq, toSeq uint64, count int, lastHash string, cutoffAt time.Time) string {
+	return fmt.Sprintf("prune|%s|%d|%d|%d|%s|%d", tenantID, fromSeq, toSeq, count, lastHash, cutoffAt.Unix())
+}
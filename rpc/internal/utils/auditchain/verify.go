@@ -0,0 +1,168 @@
+package auditchain
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/coder-lulu/newbee-core/rpc/ent"
+	"github.com/coder-lulu/newbee-core/rpc/ent/auditlog"
+	"github.com/coder-lulu/newbee-core/rpc/ent/auditlogchain"
+	"github.com/coder-lulu/newbee-core/rpc/ent/auditlogcheckpoint"
+	"github.com/coder-lulu/newbee-core/rpc/ent/auditlogprunerecord"
+)
+
+// Issue types
+const (
+	IssueGap                 = "gap"
+	IssuePrevHashMismatch    = "prev_hash_mismatch"
+	IssueHashMismatch        = "hash_mismatch"
+	IssueCheckpointSignature = "checkpoint_signature"
+	IssueCheckpointMismatch  = "checkpoint_mismatch"
+	IssueTruncated           = "truncated"
+	IssuePruneSignature      = "prune_signature"
+	IssuePruneGap            = "prune_gap"
+)
+
+// maxIssues 单次校验最多返回的问题数
+const maxIssues = 100
+
+const verifyBatchSize = 1000
+
+// Issue 校验发现的问题
+type Issue struct {
+	Seq    uint64
+	Type   string
+	Detail string
+}
+
+// Report 哈希链校验结果
+type Report struct {
+	Checked     uint64
+	HeadSeq     uint64
+	HeadHash    string
+	PrunedToSeq uint64
+	Issues      []Issue
+}
+
+// Valid reports whether no issue was found
+func (r *Report) Valid() bool {
+	return len(r.Issues) == 0
+}
+
+func (r *Report) add(seq uint64, typ, format string, args ...any) {
+	if len(r.Issues) < maxIssues {
+		r.Issues = append(r.Issues, Issue{Seq: seq, Type: typ, Detail: fmt.Sprintf(format, args...)})
+	}
+}
+
+// Anchor returns the start point of the remaining chain, which is the end of the last prune record.
+// Prune records are verified on the way, issues are appended into the report.
+func Anchor(ctx context.Context, db *ent.Client, signer *Signer, tenantID string, report *Report) (uint64, string, error) {
+	records, err := db.AuditLogPruneRecord.Query().
+		Where(auditlogprunerecord.TenantIDEQ(tenantID)).
+		Order(ent.Asc(auditlogprunerecord.FieldToSeq)).
+		All(ctx)
+	if err != nil {
+		return 0, "", err
+	}
+
+	var toSeq uint64
+	var lastHash string
+	for _, v := range records {
+		if !signer.Verify(v.KeyID, PrunePayload(v.TenantID, v.FromSeq, v.ToSeq, v.Count, v.LastHash, v.CutoffAt), v.Signature) {
+			report.add(v.ToSeq, IssuePruneSignature, "invalid signature of prune record %s", v.ID)
+			continue
+		}
+		if v.FromSeq != toSeq+1 {
+			report.add(v.FromSeq, IssuePruneGap, "prune record starts at %d, expected %d", v.FromSeq, toSeq+1)
+		}
+		toSeq, lastHash = v.ToSeq, v.LastHash
+	}
+
+	return toSeq, lastHash, nil
+}
+
+// Verify walks through the hash chain of the tenant and checks the links, hashes, checkpoints and prune records
+func Verify(ctx context.Context, db *ent.Client, signer *Signer, tenantID string) (*Report, error) {
+	report := &Report{}
+
+	anchorSeq, prevHash, err := Anchor(ctx, db, signer, tenantID, report)
+	if err != nil {
+		return nil, err
+	}
+	report.PrunedToSeq = anchorSeq
+
+	checkpoints, err := db.AuditLogCheckpoint.Query().
+		Where(auditlogcheckpoint.TenantIDEQ(tenantID), auditlogcheckpoint.SeqGT(anchorSeq)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	checkpointHashes := make(map[uint64]string, len(checkpoints))
+	for _, v := range checkpoints {
+		if !signer.Verify(v.KeyID, CheckpointPayload(v.TenantID, v.Seq, v.Hash), v.Signature) {
+			report.add(v.Seq, IssueCheckpointSignature, "invalid signature of checkpoint %s", v.ID)
+			continue
+		}
+		checkpointHashes[v.Seq] = v.Hash
+	}
+
+	expected := anchorSeq + 1
+	last := anchorSeq
+	for {
+		logs, err := db.AuditLog.Query().
+			Where(auditlog.TenantIDEQ(tenantID), auditlog.SeqGT(last)).
+			Order(ent.Asc(auditlog.FieldSeq)).
+			Limit(verifyBatchSize).
+			All(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range logs {
+			seq := *v.Seq
+			if seq != expected {
+				report.add(seq, IssueGap, "records %d to %d are missing", expected, seq-1)
+			} else if v.PrevHash != prevHash {
+				report.add(seq, IssuePrevHashMismatch, "prev_hash does not match the previous record")
+			}
+
+			if Hash(v) != v.Hash {
+				report.add(seq, IssueHashMismatch, "record %s has been modified", v.ID)
+			}
+
+			if hash, ok := checkpointHashes[seq]; ok {
+				if hash != v.Hash {
+					report.add(seq, IssueCheckpointMismatch, "record hash differs from the signed checkpoint")
+				}
+				delete(checkpointHashes, seq)
+			}
+
+			prevHash = v.Hash
+			expected = seq + 1
+			last = seq
+			report.Checked++
+			report.HeadSeq, report.HeadHash = seq, v.Hash
+		}
+
+		if len(logs) < verifyBatchSize {
+			break
+		}
+	}
+
+	// 剩余的检查点指向不存在的记录，说明链尾或中间的记录被删除
+	for seq := range checkpointHashes {
+		report.add(seq, IssueTruncated, "record of the signed checkpoint is missing")
+	}
+
+	chain, err := db.AuditLogChain.Query().Where(auditlogchain.TenantIDEQ(tenantID)).Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, err
+	}
+	if chain != nil && (chain.Seq != report.HeadSeq || chain.Hash != report.HeadHash) && chain.Seq > anchorSeq {
+		report.add(chain.Seq, IssueTruncated, "chain head is %d but the latest record is %d", chain.Seq, report.HeadSeq)
+	}
+
+	return report, nil
+}
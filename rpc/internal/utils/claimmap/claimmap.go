@@ -0,0 +1,293 @@
+package claimmap
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/coder-lulu/newbee-core/rpc/internal/interfaces"
+)
+
+// ConfigKey is the key of the claim mapping rules in OauthProvider.extra_config.
+//
+// Each field maps to a path, a list of fallback paths, or a rule object:
+//
+//	"claim_mapping": {
+//	  "id":       "data.user_id",
+//	  "username": ["preferred_username", "login"],
+//	  "email":    {"path": "$.emails[0].value", "transform": "lower"},
+//	  "phone":    {"path": "mobile", "transform": ["trim", "trim_prefix:+86"]},
+//	  "avatar":   {"path": "avatar_url", "default": "https://example.com/avatar.png"},
+//	  "groups":   {"path": "roles[*].name", "transform": "upper"}
+//	}
+//
+// Fields missing from the config keep the default rules.
+const ConfigKey = "claim_mapping"
+
+// Mapping fields
+const (
+	FieldID       = "id"
+	FieldUsername = "username"
+	FieldNickname = "nickname"
+	FieldEmail    = "email"
+	FieldPhone    = "phone"
+	FieldAvatar   = "avatar"
+	FieldGroups   = "groups"
+)
+
+var fields = []string{FieldID, FieldUsername, FieldNickname, FieldEmail, FieldPhone, FieldAvatar, FieldGroups}
+
+// defaultRules 兼容 OIDC 标准声明、常见厂商字段以及旧版 {email,nickName,picture,mobile} 格式
+var defaultRules = map[string][]string{
+	FieldID:       {"sub", "id", "user_id", "userId", "openid", "uid"},
+	FieldUsername: {"preferred_username", "login", "username", "userName"},
+	FieldNickname: {"name", "nickname", "nickName"},
+	FieldEmail:    {"email"},
+	FieldPhone:    {"phone_number", "mobile", "phone"},
+	FieldAvatar:   {"picture", "avatar_url", "avatar"},
+	FieldGroups:   {"groups"},
+}
+
+// Rule maps one field of the user info
+type Rule struct {
+	Paths      []*Path
+	Default    []string
+	Transforms []Transform
+}
+
+// Mapping maps the userinfo JSON of a provider into OAuthUserInfo
+type Mapping struct {
+	rules map[string]*Rule
+}
+
+// Default returns the default mapping
+func Default() *Mapping {
+	m := &Mapping{rules: make(map[string]*Rule, len(fields))}
+	for field, exprs := range defaultRules {
+		rule := &Rule{}
+		for _, expr := range exprs {
+			p, _ := ParsePath(expr)
+			rule.Paths = append(rule.Paths, p)
+		}
+		m.rules[field] = rule
+	}
+	return m
+}
+
+// FromExtraConfig parses the claim mapping in the extra config of a provider, the default mapping is returned when absent
+func FromExtraConfig(extra map[string]any) (*Mapping, error) {
+	m := Default()
+
+	raw, ok := extra[ConfigKey]
+	if !ok || raw == nil {
+		return m, nil
+	}
+
+	config, ok := raw.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("%s must be an object", ConfigKey)
+	}
+
+	for field, v := range config {
+		if !slices.Contains(fields, field) {
+			return nil, fmt.Errorf("unknown field %q in %s, supported fields: %s", field, ConfigKey, strings.Join(fields, ", "))
+		}
+
+		rule, err := ParseRule(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid rule of %q: %w", field, err)
+		}
+		m.rules[field] = rule
+	}
+
+	return m, nil
+}
+
+// ParseRule parses a rule, which is a path, a list of fallback paths or an object {path, default, transform}
+func ParseRule(v any) (*Rule, error) {
+	rule := &Rule{}
+
+	var paths, defaults, transforms any
+	switch r := v.(type) {
+	case string, []any:
+		paths = r
+	case map[string]any:
+		for k := range r {
+			if k != "path" && k != "default" && k != "transform" {
+				return nil, fmt.Errorf("unknown key %q", k)
+			}
+		}
+		paths, defaults, transforms = r["path"], r["default"], r["transform"]
+	default:
+		return nil, fmt.Errorf("rule must be a path, a list of paths or an object")
+	}
+
+	exprs, err := stringList(paths)
+	if err != nil {
+		return nil, fmt.Errorf("path: %w", err)
+	}
+	for _, expr := range exprs {
+		p, err := ParsePath(expr)
+		if err != nil {
+			return nil, err
+		}
+		rule.Paths = append(rule.Paths, p)
+	}
+
+	if rule.Default, err = stringList(defaults); err != nil {
+		return nil, fmt.Errorf("default: %w", err)
+	}
+
+	if len(rule.Paths) == 0 && len(rule.Default) == 0 {
+		return nil, fmt.Errorf("path or default is required")
+	}
+
+	specs, err := stringList(transforms)
+	if err != nil {
+		return nil, fmt.Errorf("transform: %w", err)
+	}
+	for _, spec := range specs {
+		t, err := ParseTransform(spec)
+		if err != nil {
+			return nil, err
+		}
+		rule.Transforms = append(rule.Transforms, t)
+	}
+
+	return rule, nil
+}
+
+// stringList accepts a string or a list of strings
+func stringList(v any) ([]string, error) {
+	switch s := v.(type) {
+	case nil:
+		return nil, nil
+	case string:
+		return []string{s}, nil
+	case []any:
+		list := make([]string, 0, len(s))
+		for _, item := range s {
+			str, ok := item.(string)
+			if !ok {
+				return nil, fmt.Errorf("must be a string or a list of strings")
+			}
+			list = append(list, str)
+		}
+		return list, nil
+	default:
+		return nil, fmt.Errorf("must be a string or a list of strings")
+	}
+}
+
+// Map decodes the userinfo JSON and maps it into the standardized user info, a nil mapping uses the default rules
+func (m *Mapping) Map(data []byte) (*interfaces.OAuthUserInfo, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	// 保留数字原文，避免较大的用户 ID 被转成浮点数
+	decoder.UseNumber()
+
+	var claims any
+	if err := decoder.Decode(&claims); err != nil {
+		return nil, fmt.Errorf("invalid userinfo JSON: %w", err)
+	}
+
+	return m.MapClaims(claims), nil
+}
+
+// MapClaims maps the decoded userinfo into the standardized user info
+func (m *Mapping) MapClaims(claims any) *interfaces.OAuthUserInfo {
+	if m == nil {
+		m = Default()
+	}
+
+	info := &interfaces.OAuthUserInfo{
+		ID:          m.first(FieldID, claims),
+		Username:    m.first(FieldUsername, claims),
+		Nickname:    m.first(FieldNickname, claims),
+		Email:       m.first(FieldEmail, claims),
+		PhoneNumber: m.first(FieldPhone, claims),
+		Avatar:      m.first(FieldAvatar, claims),
+		Groups:      m.values(FieldGroups, claims),
+	}
+
+	if raw, ok := claims.(map[string]any); ok {
+		info.RawData = raw
+	}
+
+	return info
+}
+
+func (m *Mapping) first(field string, claims any) string {
+	if values := m.values(field, claims); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+func (m *Mapping) values(field string, claims any) []string {
+	rule, ok := m.rules[field]
+	if !ok {
+		return nil
+	}
+	return rule.Values(claims)
+}
+
+// Values resolves the paths in order and returns the values of the first path that has any, or the default
+func (r *Rule) Values(claims any) []string {
+	for _, p := range r.Paths {
+		var result []string
+		for _, v := range p.Resolve(claims) {
+			for _, s := range stringify(v) {
+				result = append(result, r.apply(s)...)
+			}
+		}
+
+		result = slices.DeleteFunc(result, func(s string) bool { return s == "" })
+		if len(result) > 0 {
+			return result
+		}
+	}
+
+	var result []string
+	for _, s := range r.Default {
+		result = append(result, r.apply(s)...)
+	}
+	return result
+}
+
+// apply runs the transforms in order, a transform may split one value into several
+func (r *Rule) apply(s string) []string {
+	values := []string{s}
+	for _, t := range r.Transforms {
+		next := make([]string, 0, len(values))
+		for _, v := range values {
+			next = append(next, t(v)...)
+		}
+		values = next
+	}
+	return values
+}
+
+// stringify converts a JSON value into strings, arrays are flattened and objects are ignored
+func stringify(v any) []string {
+	switch val := v.(type) {
+	case string:
+		return []string{val}
+	case json.Number:
+		return []string{val.String()}
+	case float64:
+		return []string{strconv.FormatFloat(val, 'f', -1, 64)}
+	case bool:
+		return []string{strconv.FormatBool(val)}
+	case []any:
+		var result []string
+		for _, item := range val {
+			result = append(result, stringify(item)...)
+		}
+		return result
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,119 @@
+package claimmap
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// wildcard 数组通配符 [*]
+const wildcard = -1
+
+// segment is a step of a path, either an object key or an array index
+type segment struct {
+	key   string
+	index int
+	isKey bool
+}
+
+// Path is a compiled claim path.
+// Both dot paths (data.user.id, groups[0], roles[*].name) and the same subset of JSONPath
+// ($.data.user.id, $['x.y'].z) are supported.
+type Path struct {
+	raw      string
+	segments []segment
+}
+
+// String returns the original expression
+func (p *Path) String() string {
+	return p.raw
+}
+
+// ParsePath compiles a claim path expression
+func ParsePath(expr string) (*Path, error) {
+	raw := expr
+	expr = strings.TrimSpace(expr)
+	expr = strings.TrimPrefix(expr, "$")
+	expr = strings.TrimPrefix(expr, ".")
+	if expr == "" {
+		return nil, fmt.Errorf("empty claim path %q", raw)
+	}
+
+	p := &Path{raw: raw}
+	for i := 0; i < len(expr); {
+		switch expr[i] {
+		case '.':
+			i++
+			if i >= len(expr) || expr[i] == '.' || expr[i] == '[' {
+				return nil, fmt.Errorf("invalid claim path %q", raw)
+			}
+
+		case '[':
+			end := strings.IndexByte(expr[i:], ']')
+			if end < 0 {
+				return nil, fmt.Errorf("unclosed bracket in claim path %q", raw)
+			}
+			inner := strings.TrimSpace(expr[i+1 : i+end])
+			i += end + 1
+
+			switch {
+			case inner == "*":
+				p.segments = append(p.segments, segment{index: wildcard})
+			case len(inner) >= 2 && (inner[0] == '\'' || inner[0] == '"') && inner[len(inner)-1] == inner[0]:
+				p.segments = append(p.segments, segment{key: inner[1 : len(inner)-1], isKey: true})
+			default:
+				n, err := strconv.Atoi(inner)
+				if err != nil || n < 0 {
+					return nil, fmt.Errorf("invalid index %q in claim path %q", inner, raw)
+				}
+				p.segments = append(p.segments, segment{index: n})
+			}
+
+		default:
+			end := strings.IndexAny(expr[i:], ".[")
+			if end < 0 {
+				end = len(expr) - i
+			}
+			p.segments = append(p.segments, segment{key: expr[i : i+end], isKey: true})
+			i += end
+		}
+	}
+
+	return p, nil
+}
+
+// Resolve returns the values the path points to, wildcards may produce several values
+func (p *Path) Resolve(data any) []any {
+	values := []any{data}
+	for _, seg := range p.segments {
+		next := make([]any, 0, len(values))
+		for _, v := range values {
+			switch {
+			case seg.isKey:
+				if m, ok := v.(map[string]any); ok {
+					if child, ok := m[seg.key]; ok && child != nil {
+						next = append(next, child)
+					}
+				}
+			case seg.index == wildcard:
+				if arr, ok := v.([]any); ok {
+					for _, child := range arr {
+						if child != nil {
+							next = append(next, child)
+						}
+					}
+				}
+			default:
+				if arr, ok := v.([]any); ok && seg.index < len(arr) && arr[seg.index] != nil {
+					next = append(next, arr[seg.index])
+				}
+			}
+		}
+		if len(next) == 0 {
+			return nil
+		}
+		values = next
+	}
+
+	return values
+}
@@ -0,0 +1,66 @@
+package claimmap
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Transform converts a mapped value, it may return several values (split) or none
+type Transform func(string) []string
+
+// ParseTransform compiles a transform spec.
+//
+// Supported transforms: trim, lower, upper, prefix:<s>, suffix:<s>, trim_prefix:<s>, trim_suffix:<s>,
+// replace:<old>:<new> and split:<sep>.
+func ParseTransform(spec string) (Transform, error) {
+	name, arg, hasArg := strings.Cut(spec, ":")
+
+	switch name {
+	case "trim":
+		return one(strings.TrimSpace), nil
+	case "lower":
+		return one(strings.ToLower), nil
+	case "upper":
+		return one(strings.ToUpper), nil
+	}
+
+	if !hasArg {
+		return nil, fmt.Errorf("unknown transform %q", spec)
+	}
+
+	switch name {
+	case "prefix":
+		return one(func(s string) string { return arg + s }), nil
+	case "suffix":
+		return one(func(s string) string { return s + arg }), nil
+	case "trim_prefix":
+		return one(func(s string) string { return strings.TrimPrefix(s, arg) }), nil
+	case "trim_suffix":
+		return one(func(s string) string { return strings.TrimSuffix(s, arg) }), nil
+	case "replace":
+		old, replacement, ok := strings.Cut(arg, ":")
+		if !ok || old == "" {
+			return nil, fmt.Errorf("replace transform requires replace:<old>:<new>, got %q", spec)
+		}
+		return one(func(s string) string { return strings.ReplaceAll(s, old, replacement) }), nil
+	case "split":
+		if arg == "" {
+			return nil, fmt.Errorf("split transform requires a separator")
+		}
+		return func(s string) []string {
+			parts := strings.Split(s, arg)
+			for i := range parts {
+				parts[i] = strings.TrimSpace(parts[i])
+			}
+			return parts
+		}, nil
+	default:
+		return nil, fmt.Errorf("unknown transform %q", spec)
+	}
+}
+
+func one(f func(string) string) Transform {
+	return func(s string) []string {
+		return []string{f(s)}
+	}
+}
@@ -0,0 +1,80 @@
+package dbfunc
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/coder-lulu/newbee-common/v2/config"
+	"github.com/coder-lulu/newbee-common/v2/enum/common"
+	"github.com/coder-lulu/newbee-common/v2/i18n"
+	"github.com/coder-lulu/newbee-common/v2/msg/logmsg"
+	"github.com/gofrs/uuid/v5"
+	"github.com/redis/go-redis/v9"
+	"github.com/zeromicro/go-zero/core/errorx"
+	"github.com/zeromicro/go-zero/core/logx"
+
+	"github.com/coder-lulu/newbee-core/rpc/ent"
+	"github.com/coder-lulu/newbee-core/rpc/ent/token"
+	"github.com/coder-lulu/newbee-core/rpc/internal/utils/dberrorhandler"
+)
+
+// RedisTokenRevokedBeforePrefix is the prefix of the per-user revocation watermark.
+// The value is a unix timestamp in milliseconds, tokens issued before it are invalid. It is compared with the
+// millisecond issue time of the token, so a token issued right after the revocation stays valid.
+// The API gateway checks the same key, keep them in sync.
+const RedisTokenRevokedBeforePrefix = "TOKEN_REVOKED_BEFORE:"
+
+// RevokeUserTokensBefore sets the revocation watermark of the user, the ttl must cover the longest token lifetime
+func RevokeUserTokensBefore(ctx context.Context, rds redis.UniversalClient, userID uuid.UUID, revokedAt time.Time, ttl time.Duration) error {
+	if ttl <= 0 {
+		return nil
+	}
+
+	err := rds.Set(ctx, RedisTokenRevokedBeforePrefix+userID.String(), strconv.FormatInt(revokedAt.UnixMilli(), 10), ttl).Err()
+	if err != nil {
+		logx.Errorw(logmsg.RedisError, logx.Field("detail", err.Error()))
+		return errorx.NewInternalError(i18n.RedisError)
+	}
+
+	return nil
+}
+
+// TokenSessionID returns the login session of the token, the tokens created before sessions were recorded are
+// sessions of their own
+func TokenSessionID(t *ent.Token) string {
+	if t.SessionID != "" {
+		return t.SessionID
+	}
+	return t.ID.String()
+}
+
+// BlockTokens bans the tokens and adds the unexpired ones into redis blacklist
+func BlockTokens(ctx context.Context, db *ent.Client, rds redis.UniversalClient, logger logx.Logger, tokens []*ent.Token) error {
+	if len(tokens) == 0 {
+		return nil
+	}
+
+	ids := make([]uuid.UUID, 0, len(tokens))
+	for _, v := range tokens {
+		ids = append(ids, v.ID)
+	}
+
+	err := db.Token.Update().Where(token.IDIn(ids...)).SetStatus(common.StatusBanned).Exec(ctx)
+	if err != nil {
+		return dberrorhandler.DefaultEntError(logger, err, ids)
+	}
+
+	for _, v := range tokens {
+		expiredTime := time.Until(v.ExpiredAt)
+		if expiredTime > 0 {
+			err = rds.Set(ctx, config.RedisTokenPrefix+v.Token, "1", expiredTime).Err()
+			if err != nil {
+				logx.Errorw(logmsg.RedisError, logx.Field("detail", err.Error()))
+				return errorx.NewInternalError(i18n.RedisError)
+			}
+		}
+	}
+
+	return nil
+}
@@ -66,3 +66,33 @@ func PublishCasbinReload(ctx context.Context, rds redis.UniversalClient, db int,
 	channel := fmt.Sprintf("%s-%d", config.RedisCasbinChannel, db)
 	return rds.Publish(ctx, channel, message).Err()
 }
+
+// TenantInitChannel is the channel announcing the finished tenant initialization jobs, the db is appended
+const TenantInitChannel = "tenant_init"
+
+// TenantInitEvent is published when a tenant initialization job finishes
+type TenantInitEvent struct {
+	JobID        uint64 `json:"jobId"`
+	TenantID     uint64 `json:"tenantId"`
+	Status       string `json:"status"`
+	DryRun       bool   `json:"dryRun"`
+	FailedPlugin string `json:"failedPlugin,omitempty"`
+	Error        string `json:"error,omitempty"`
+	Timestamp    int64  `json:"timestamp"`
+}
+
+// PublishTenantInitEvent 向Redis频道广播租户初始化任务完成通知
+func PublishTenantInitEvent(ctx context.Context, rds redis.UniversalClient, db int, event TenantInitEvent) error {
+	if rds == nil {
+		return fmt.Errorf("redis client is nil")
+	}
+
+	event.Timestamp = time.Now().UnixMilli()
+	message, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to marshal tenant init event: %w", err)
+	}
+
+	channel := fmt.Sprintf("%s-%d", TenantInitChannel, db)
+	return rds.Publish(ctx, channel, message).Err()
+}
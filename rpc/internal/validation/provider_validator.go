@@ -140,31 +140,43 @@ func (pv *ProviderValidator) validateBasicFields(config *interfaces.OAuthProvide
 		})
 	}
 
-	if config.AuthURL == "" {
-		result.Errors = append(result.Errors, ValidationError{
-			Field:    "auth_url",
-			Code:     "REQUIRED_FIELD",
-			Message:  "Authorization URL is required",
-			Severity: SeverityError,
-		})
-	}
+	// OIDC 提供商的端点来自发现文档，只需配置签发方
+	if config.Type == interfaces.ProviderTypeOIDC {
+		if issuer, _ := config.ExtraConfig["issuer"].(string); issuer == "" {
+			result.Errors = append(result.Errors, ValidationError{
+				Field:    "extra_config.issuer",
+				Code:     "REQUIRED_FIELD",
+				Message:  "Issuer is required for OIDC provider",
+				Severity: SeverityError,
+			})
+		}
+	} else {
+		if config.AuthURL == "" {
+			result.Errors = append(result.Errors, ValidationError{
+				Field:    "auth_url",
+				Code:     "REQUIRED_FIELD",
+				Message:  "Authorization URL is required",
+				Severity: SeverityError,
+			})
+		}
 
-	if config.TokenURL == "" {
-		result.Errors = append(result.Errors, ValidationError{
-			Field:    "token_url",
-			Code:     "REQUIRED_FIELD",
-			Message:  "Token URL is required",
-			Severity: SeverityError,
-		})
-	}
+		if config.TokenURL == "" {
+			result.Errors = append(result.Errors, ValidationError{
+				Field:    "token_url",
+				Code:     "REQUIRED_FIELD",
+				Message:  "Token URL is required",
+				Severity: SeverityError,
+			})
+		}
 
-	if config.UserInfoURL == "" {
-		result.Errors = append(result.Errors, ValidationError{
-			Field:    "user_info_url",
-			Code:     "REQUIRED_FIELD",
-			Message:  "User info URL is required",
-			Severity: SeverityError,
-		})
+		if config.UserInfoURL == "" {
+			result.Errors = append(result.Errors, ValidationError{
+				Field:    "user_info_url",
+				Code:     "REQUIRED_FIELD",
+				Message:  "User info URL is required",
+				Severity: SeverityError,
+			})
+		}
 	}
 
 	// Validate redirect URL format
@@ -369,6 +381,8 @@ func (pv *ProviderValidator) getValidScopesForProvider(providerType interfaces.O
 		return []string{"email", "public_profile", "user_friends"}
 	case interfaces.ProviderTypeWechat:
 		return []string{"snsapi_login", "snsapi_userinfo"}
+	case interfaces.ProviderTypeOIDC:
+		return []string{"openid", "profile", "email", "phone", "address", "offline_access"}
 	case interfaces.ProviderTypeQQ:
 		return []string{"get_user_info", "list_album", "upload_pic"}
 	default:
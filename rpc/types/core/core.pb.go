@@ -22,16 +22,19 @@ const (
 )
 
 type ApiInfo struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            *uint64                `protobuf:"varint,1,opt,name=id,proto3,oneof" json:"id"`
-	CreatedAt     *int64                 `protobuf:"varint,2,opt,name=created_at,json=createdAt,proto3,oneof" json:"created_at"`
-	UpdatedAt     *int64                 `protobuf:"varint,3,opt,name=updated_at,json=updatedAt,proto3,oneof" json:"updated_at"`
-	Path          *string                `protobuf:"bytes,4,opt,name=path,proto3,oneof" json:"path"`
-	Description   *string                `protobuf:"bytes,5,opt,name=description,proto3,oneof" json:"description"`
-	ApiGroup      *string                `protobuf:"bytes,6,opt,name=api_group,json=apiGroup,proto3,oneof" json:"api_group"`
-	Method        *string                `protobuf:"bytes,7,opt,name=method,proto3,oneof" json:"method"`
-	IsRequired    *bool                  `protobuf:"varint,8,opt,name=is_required,json=isRequired,proto3,oneof" json:"is_required"`
-	ServiceName   *string                `protobuf:"bytes,9,opt,name=service_name,json=serviceName,proto3,oneof" json:"service_name"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          *uint64                `protobuf:"varint,1,opt,name=id,proto3,oneof" json:"id"`
+	CreatedAt   *int64                 `protobuf:"varint,2,opt,name=created_at,json=createdAt,proto3,oneof" json:"created_at"`
+	UpdatedAt   *int64                 `protobuf:"varint,3,opt,name=updated_at,json=updatedAt,proto3,oneof" json:"updated_at"`
+	Path        *string                `protobuf:"bytes,4,opt,name=path,proto3,oneof" json:"path"`
+	Description *string                `protobuf:"bytes,5,opt,name=description,proto3,oneof" json:"description"`
+	ApiGroup    *string                `protobuf:"bytes,6,opt,name=api_group,json=apiGroup,proto3,oneof" json:"api_group"`
+	Method      *string                `protobuf:"bytes,7,opt,name=method,proto3,oneof" json:"method"`
+	IsRequired  *bool                  `protobuf:"varint,8,opt,name=is_required,json=isRequired,proto3,oneof" json:"is_required"`
+	ServiceName *string                `protobuf:"bytes,9,opt,name=service_name,json=serviceName,proto3,oneof" json:"service_name"`
+	//  The service no longer registers the API | 服务已不再登记该接口
+	IsDeprecated  *bool  `protobuf:"varint,10,opt,name=is_deprecated,json=isDeprecated,proto3,oneof" json:"is_deprecated"`
+	DeprecatedAt  *int64 `protobuf:"varint,11,opt,name=deprecated_at,json=deprecatedAt,proto3,oneof" json:"deprecated_at"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -129,6 +132,20 @@ func (x *ApiInfo) GetServiceName() string {
 	return ""
 }
 
+func (x *ApiInfo) GetIsDeprecated() bool {
+	if x != nil && x.IsDeprecated != nil {
+		return *x.IsDeprecated
+	}
+	return false
+}
+
+func (x *ApiInfo) GetDeprecatedAt() int64 {
+	if x != nil && x.DeprecatedAt != nil {
+		return *x.DeprecatedAt
+	}
+	return 0
+}
+
 type ApiListReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Page          uint64                 `protobuf:"varint,1,opt,name=page,proto3" json:"page"`
@@ -139,6 +156,7 @@ type ApiListReq struct {
 	Method        *string                `protobuf:"bytes,6,opt,name=method,proto3,oneof" json:"method"`
 	IsDefault     *string                `protobuf:"bytes,7,opt,name=is_default,json=isDefault,proto3,oneof" json:"is_default"`
 	ServiceName   *string                `protobuf:"bytes,8,opt,name=service_name,json=serviceName,proto3,oneof" json:"service_name"`
+	IsDeprecated  *bool                  `protobuf:"varint,9,opt,name=is_deprecated,json=isDeprecated,proto3,oneof" json:"is_deprecated"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -229,6 +247,13 @@ func (x *ApiListReq) GetServiceName() string {
 	return ""
 }
 
+func (x *ApiListReq) GetIsDeprecated() bool {
+	if x != nil && x.IsDeprecated != nil {
+		return *x.IsDeprecated
+	}
+	return false
+}
+
 type ApiListResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Total         uint64                 `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
@@ -281,63 +306,33 @@ func (x *ApiListResp) GetData() []*ApiInfo {
 	return nil
 }
 
-type AuditLogInfo struct {
-	state     protoimpl.MessageState `protogen:"open.v1"`
-	Id        *string                `protobuf:"bytes,1,opt,name=id,proto3,oneof" json:"id"`
-	CreatedAt *int64                 `protobuf:"varint,2,opt,name=created_at,json=createdAt,proto3,oneof" json:"created_at"`
-	UpdatedAt *int64                 `protobuf:"varint,3,opt,name=updated_at,json=updatedAt,proto3,oneof" json:"updated_at"`
-	// Status 1: normal 2: ban | 状态 1 正常 2 禁用
-	Status *uint32 `protobuf:"varint,4,opt,name=status,proto3,oneof" json:"status"`
-	// Tenant ID | 租户ID
-	TenantId *string `protobuf:"bytes,5,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id"`
-	// User ID who performed the operation | 执行操作的用户ID
-	UserId *string `protobuf:"bytes,6,opt,name=user_id,json=userId,proto3,oneof" json:"user_id"`
-	// User name who performed the operation | 执行操作的用户名
-	UserName *string `protobuf:"bytes,7,opt,name=user_name,json=userName,proto3,oneof" json:"user_name"`
-	// Operation type | 操作类型
-	OperationType *string `protobuf:"bytes,8,opt,name=operation_type,json=operationType,proto3,oneof" json:"operation_type"`
-	// Resource type that was operated on | 被操作的资源类型
-	ResourceType *string `protobuf:"bytes,9,opt,name=resource_type,json=resourceType,proto3,oneof" json:"resource_type"`
-	// Resource ID that was operated on | 被操作的资源ID
-	ResourceId *string `protobuf:"bytes,10,opt,name=resource_id,json=resourceId,proto3,oneof" json:"resource_id"`
-	// HTTP request method | HTTP请求方法
-	RequestMethod *string `protobuf:"bytes,11,opt,name=request_method,json=requestMethod,proto3,oneof" json:"request_method"`
-	// HTTP request path | HTTP请求路径
-	RequestPath *string `protobuf:"bytes,12,opt,name=request_path,json=requestPath,proto3,oneof" json:"request_path"`
-	// Request data (JSON format, sensitive data filtered) | 请求数据(JSON格式，已过滤敏感数据)
-	RequestData *string `protobuf:"bytes,13,opt,name=request_data,json=requestData,proto3,oneof" json:"request_data"`
-	// HTTP response status code | HTTP响应状态码
-	ResponseStatus *int64 `protobuf:"varint,14,opt,name=response_status,json=responseStatus,proto3,oneof" json:"response_status"`
-	// Response data (JSON format, optional) | 响应数据(JSON格式，可选)
-	ResponseData *string `protobuf:"bytes,15,opt,name=response_data,json=responseData,proto3,oneof" json:"response_data"`
-	// Client IP address | 客户端IP地址
-	IpAddress *string `protobuf:"bytes,16,opt,name=ip_address,json=ipAddress,proto3,oneof" json:"ip_address"`
-	// User agent string | 用户代理字符串
-	UserAgent *string `protobuf:"bytes,17,opt,name=user_agent,json=userAgent,proto3,oneof" json:"user_agent"`
-	// Request processing duration in milliseconds | 请求处理耗时(毫秒)
-	DurationMs *int64 `protobuf:"varint,18,opt,name=duration_ms,json=durationMs,proto3,oneof" json:"duration_ms"`
-	// Error message if operation failed | 操作失败时的错误信息
-	ErrorMessage *string `protobuf:"bytes,19,opt,name=error_message,json=errorMessage,proto3,oneof" json:"error_message"`
-	// Additional metadata in JSON format | 额外的元数据(JSON格式)
-	Metadata      *string `protobuf:"bytes,20,opt,name=metadata,proto3,oneof" json:"metadata"`
+type ApiRegistrationItem struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Path  string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path"`
+	//  HTTP method, GRPC for RPC methods | HTTP 请求类型，RPC 方法为 GRPC
+	Method string `protobuf:"bytes,2,opt,name=method,proto3" json:"method"`
+	//  The description of a new API, the path is used when empty | 新接口的描述，为空时使用路径
+	Description   *string `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description"`
+	ApiGroup      *string `protobuf:"bytes,4,opt,name=api_group,json=apiGroup,proto3,oneof" json:"api_group"`
+	IsRequired    *bool   `protobuf:"varint,5,opt,name=is_required,json=isRequired,proto3,oneof" json:"is_required"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AuditLogInfo) Reset() {
-	*x = AuditLogInfo{}
+func (x *ApiRegistrationItem) Reset() {
+	*x = ApiRegistrationItem{}
 	mi := &file_core_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AuditLogInfo) String() string {
+func (x *ApiRegistrationItem) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AuditLogInfo) ProtoMessage() {}
+func (*ApiRegistrationItem) ProtoMessage() {}
 
-func (x *AuditLogInfo) ProtoReflect() protoreflect.Message {
+func (x *ApiRegistrationItem) ProtoReflect() protoreflect.Message {
 	mi := &file_core_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -349,187 +344,201 @@ func (x *AuditLogInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AuditLogInfo.ProtoReflect.Descriptor instead.
-func (*AuditLogInfo) Descriptor() ([]byte, []int) {
+// Deprecated: Use ApiRegistrationItem.ProtoReflect.Descriptor instead.
+func (*ApiRegistrationItem) Descriptor() ([]byte, []int) {
 	return file_core_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *AuditLogInfo) GetId() string {
-	if x != nil && x.Id != nil {
-		return *x.Id
+func (x *ApiRegistrationItem) GetPath() string {
+	if x != nil {
+		return x.Path
 	}
 	return ""
 }
 
-func (x *AuditLogInfo) GetCreatedAt() int64 {
-	if x != nil && x.CreatedAt != nil {
-		return *x.CreatedAt
+func (x *ApiRegistrationItem) GetMethod() string {
+	if x != nil {
+		return x.Method
 	}
-	return 0
+	return ""
 }
 
-func (x *AuditLogInfo) GetUpdatedAt() int64 {
-	if x != nil && x.UpdatedAt != nil {
-		return *x.UpdatedAt
+func (x *ApiRegistrationItem) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
 	}
-	return 0
+	return ""
 }
 
-func (x *AuditLogInfo) GetStatus() uint32 {
-	if x != nil && x.Status != nil {
-		return *x.Status
+func (x *ApiRegistrationItem) GetApiGroup() string {
+	if x != nil && x.ApiGroup != nil {
+		return *x.ApiGroup
 	}
-	return 0
+	return ""
 }
 
-func (x *AuditLogInfo) GetTenantId() string {
-	if x != nil && x.TenantId != nil {
-		return *x.TenantId
+func (x *ApiRegistrationItem) GetIsRequired() bool {
+	if x != nil && x.IsRequired != nil {
+		return *x.IsRequired
 	}
-	return ""
+	return false
 }
 
-func (x *AuditLogInfo) GetUserId() string {
-	if x != nil && x.UserId != nil {
-		return *x.UserId
-	}
-	return ""
+type ApiRegistrationReq struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	ServiceName string                 `protobuf:"bytes,1,opt,name=service_name,json=serviceName,proto3" json:"service_name"`
+	//  All APIs of the service, APIs not in the list are marked as deprecated | 服务的全部接口，不在列表中的接口标记为废弃
+	Apis          []*ApiRegistrationItem `protobuf:"bytes,2,rep,name=apis,proto3" json:"apis"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AuditLogInfo) GetUserName() string {
-	if x != nil && x.UserName != nil {
-		return *x.UserName
-	}
-	return ""
+func (x *ApiRegistrationReq) Reset() {
+	*x = ApiRegistrationReq{}
+	mi := &file_core_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *AuditLogInfo) GetOperationType() string {
-	if x != nil && x.OperationType != nil {
-		return *x.OperationType
-	}
-	return ""
+func (x *ApiRegistrationReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *AuditLogInfo) GetResourceType() string {
-	if x != nil && x.ResourceType != nil {
-		return *x.ResourceType
+func (*ApiRegistrationReq) ProtoMessage() {}
+
+func (x *ApiRegistrationReq) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *AuditLogInfo) GetResourceId() string {
-	if x != nil && x.ResourceId != nil {
-		return *x.ResourceId
-	}
-	return ""
+// Deprecated: Use ApiRegistrationReq.ProtoReflect.Descriptor instead.
+func (*ApiRegistrationReq) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *AuditLogInfo) GetRequestMethod() string {
-	if x != nil && x.RequestMethod != nil {
-		return *x.RequestMethod
+func (x *ApiRegistrationReq) GetServiceName() string {
+	if x != nil {
+		return x.ServiceName
 	}
 	return ""
 }
 
-func (x *AuditLogInfo) GetRequestPath() string {
-	if x != nil && x.RequestPath != nil {
-		return *x.RequestPath
+func (x *ApiRegistrationReq) GetApis() []*ApiRegistrationItem {
+	if x != nil {
+		return x.Apis
 	}
-	return ""
+	return nil
 }
 
-func (x *AuditLogInfo) GetRequestData() string {
-	if x != nil && x.RequestData != nil {
-		return *x.RequestData
-	}
-	return ""
+type ApiRegistrationResp struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Created    uint64                 `protobuf:"varint,1,opt,name=created,proto3" json:"created"`
+	Updated    uint64                 `protobuf:"varint,2,opt,name=updated,proto3" json:"updated"`
+	Restored   uint64                 `protobuf:"varint,3,opt,name=restored,proto3" json:"restored"`
+	Deprecated uint64                 `protobuf:"varint,4,opt,name=deprecated,proto3" json:"deprecated"`
+	//  API rules that point at deprecated or unknown APIs | 指向已废弃或不存在接口的权限规则
+	StaleRules    []*ApiStaleRule `protobuf:"bytes,5,rep,name=stale_rules,json=staleRules,proto3" json:"stale_rules"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AuditLogInfo) GetResponseStatus() int64 {
-	if x != nil && x.ResponseStatus != nil {
-		return *x.ResponseStatus
-	}
-	return 0
+func (x *ApiRegistrationResp) Reset() {
+	*x = ApiRegistrationResp{}
+	mi := &file_core_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *AuditLogInfo) GetResponseData() string {
-	if x != nil && x.ResponseData != nil {
-		return *x.ResponseData
+func (x *ApiRegistrationResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApiRegistrationResp) ProtoMessage() {}
+
+func (x *ApiRegistrationResp) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *AuditLogInfo) GetIpAddress() string {
-	if x != nil && x.IpAddress != nil {
-		return *x.IpAddress
+// Deprecated: Use ApiRegistrationResp.ProtoReflect.Descriptor instead.
+func (*ApiRegistrationResp) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ApiRegistrationResp) GetCreated() uint64 {
+	if x != nil {
+		return x.Created
 	}
-	return ""
+	return 0
 }
 
-func (x *AuditLogInfo) GetUserAgent() string {
-	if x != nil && x.UserAgent != nil {
-		return *x.UserAgent
+func (x *ApiRegistrationResp) GetUpdated() uint64 {
+	if x != nil {
+		return x.Updated
 	}
-	return ""
+	return 0
 }
 
-func (x *AuditLogInfo) GetDurationMs() int64 {
-	if x != nil && x.DurationMs != nil {
-		return *x.DurationMs
+func (x *ApiRegistrationResp) GetRestored() uint64 {
+	if x != nil {
+		return x.Restored
 	}
 	return 0
 }
 
-func (x *AuditLogInfo) GetErrorMessage() string {
-	if x != nil && x.ErrorMessage != nil {
-		return *x.ErrorMessage
+func (x *ApiRegistrationResp) GetDeprecated() uint64 {
+	if x != nil {
+		return x.Deprecated
 	}
-	return ""
+	return 0
 }
 
-func (x *AuditLogInfo) GetMetadata() string {
-	if x != nil && x.Metadata != nil {
-		return *x.Metadata
+func (x *ApiRegistrationResp) GetStaleRules() []*ApiStaleRule {
+	if x != nil {
+		return x.StaleRules
 	}
-	return ""
+	return nil
 }
 
-type AuditLogListReq struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Page           uint64                 `protobuf:"varint,1,opt,name=page,proto3" json:"page"`
-	PageSize       uint64                 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size"`
-	UserId         *string                `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3,oneof" json:"user_id"`
-	UserName       *string                `protobuf:"bytes,4,opt,name=user_name,json=userName,proto3,oneof" json:"user_name"`
-	OperationType  *string                `protobuf:"bytes,5,opt,name=operation_type,json=operationType,proto3,oneof" json:"operation_type"`
-	ResourceType   *string                `protobuf:"bytes,6,opt,name=resource_type,json=resourceType,proto3,oneof" json:"resource_type"`
-	ResourceId     *string                `protobuf:"bytes,7,opt,name=resource_id,json=resourceId,proto3,oneof" json:"resource_id"`
-	RequestMethod  *string                `protobuf:"bytes,8,opt,name=request_method,json=requestMethod,proto3,oneof" json:"request_method"`
-	RequestPath    *string                `protobuf:"bytes,9,opt,name=request_path,json=requestPath,proto3,oneof" json:"request_path"`
-	IpAddress      *string                `protobuf:"bytes,10,opt,name=ip_address,json=ipAddress,proto3,oneof" json:"ip_address"`
-	ResponseStatus *int64                 `protobuf:"varint,11,opt,name=response_status,json=responseStatus,proto3,oneof" json:"response_status"`
-	StartTime      *int64                 `protobuf:"varint,12,opt,name=start_time,json=startTime,proto3,oneof" json:"start_time"`
-	EndTime        *int64                 `protobuf:"varint,13,opt,name=end_time,json=endTime,proto3,oneof" json:"end_time"`
-	MinDuration    *int64                 `protobuf:"varint,14,opt,name=min_duration,json=minDuration,proto3,oneof" json:"min_duration"`
-	MaxDuration    *int64                 `protobuf:"varint,15,opt,name=max_duration,json=maxDuration,proto3,oneof" json:"max_duration"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type ApiStaleRule struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      uint64                 `protobuf:"varint,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id"`
+	Role          string                 `protobuf:"bytes,2,opt,name=role,proto3" json:"role"`
+	Path          string                 `protobuf:"bytes,3,opt,name=path,proto3" json:"path"`
+	Method        string                 `protobuf:"bytes,4,opt,name=method,proto3" json:"method"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AuditLogListReq) Reset() {
-	*x = AuditLogListReq{}
-	mi := &file_core_proto_msgTypes[4]
+func (x *ApiStaleRule) Reset() {
+	*x = ApiStaleRule{}
+	mi := &file_core_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AuditLogListReq) String() string {
+func (x *ApiStaleRule) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AuditLogListReq) ProtoMessage() {}
+func (*ApiStaleRule) ProtoMessage() {}
 
-func (x *AuditLogListReq) ProtoReflect() protoreflect.Message {
-	mi := &file_core_proto_msgTypes[4]
+func (x *ApiStaleRule) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -540,194 +549,188 @@ func (x *AuditLogListReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AuditLogListReq.ProtoReflect.Descriptor instead.
-func (*AuditLogListReq) Descriptor() ([]byte, []int) {
-	return file_core_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use ApiStaleRule.ProtoReflect.Descriptor instead.
+func (*ApiStaleRule) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *AuditLogListReq) GetPage() uint64 {
+func (x *ApiStaleRule) GetTenantId() uint64 {
 	if x != nil {
-		return x.Page
+		return x.TenantId
 	}
 	return 0
 }
 
-func (x *AuditLogListReq) GetPageSize() uint64 {
+func (x *ApiStaleRule) GetRole() string {
 	if x != nil {
-		return x.PageSize
-	}
-	return 0
-}
-
-func (x *AuditLogListReq) GetUserId() string {
-	if x != nil && x.UserId != nil {
-		return *x.UserId
+		return x.Role
 	}
 	return ""
 }
 
-func (x *AuditLogListReq) GetUserName() string {
-	if x != nil && x.UserName != nil {
-		return *x.UserName
+func (x *ApiStaleRule) GetPath() string {
+	if x != nil {
+		return x.Path
 	}
 	return ""
 }
 
-func (x *AuditLogListReq) GetOperationType() string {
-	if x != nil && x.OperationType != nil {
-		return *x.OperationType
+func (x *ApiStaleRule) GetMethod() string {
+	if x != nil {
+		return x.Method
 	}
 	return ""
 }
 
-func (x *AuditLogListReq) GetResourceType() string {
-	if x != nil && x.ResourceType != nil {
-		return *x.ResourceType
-	}
-	return ""
+type AuditLogArchiveInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	CreatedAt     int64                  `protobuf:"varint,2,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
+	FromSeq       uint64                 `protobuf:"varint,3,opt,name=from_seq,json=fromSeq,proto3" json:"from_seq"`
+	ToSeq         uint64                 `protobuf:"varint,4,opt,name=to_seq,json=toSeq,proto3" json:"to_seq"`
+	Count         int64                  `protobuf:"varint,5,opt,name=count,proto3" json:"count"`
+	StartAt       int64                  `protobuf:"varint,6,opt,name=start_at,json=startAt,proto3" json:"start_at"`
+	EndAt         int64                  `protobuf:"varint,7,opt,name=end_at,json=endAt,proto3" json:"end_at"`
+	Store         string                 `protobuf:"bytes,8,opt,name=store,proto3" json:"store"`
+	ObjectKey     string                 `protobuf:"bytes,9,opt,name=object_key,json=objectKey,proto3" json:"object_key"`
+	Size          int64                  `protobuf:"varint,10,opt,name=size,proto3" json:"size"`
+	Checksum      string                 `protobuf:"bytes,11,opt,name=checksum,proto3" json:"checksum"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AuditLogListReq) GetResourceId() string {
-	if x != nil && x.ResourceId != nil {
-		return *x.ResourceId
-	}
-	return ""
+func (x *AuditLogArchiveInfo) Reset() {
+	*x = AuditLogArchiveInfo{}
+	mi := &file_core_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *AuditLogListReq) GetRequestMethod() string {
-	if x != nil && x.RequestMethod != nil {
-		return *x.RequestMethod
-	}
-	return ""
+func (x *AuditLogArchiveInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *AuditLogListReq) GetRequestPath() string {
-	if x != nil && x.RequestPath != nil {
-		return *x.RequestPath
+func (*AuditLogArchiveInfo) ProtoMessage() {}
+
+func (x *AuditLogArchiveInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *AuditLogListReq) GetIpAddress() string {
-	if x != nil && x.IpAddress != nil {
-		return *x.IpAddress
+// Deprecated: Use AuditLogArchiveInfo.ProtoReflect.Descriptor instead.
+func (*AuditLogArchiveInfo) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *AuditLogArchiveInfo) GetId() string {
+	if x != nil {
+		return x.Id
 	}
 	return ""
 }
 
-func (x *AuditLogListReq) GetResponseStatus() int64 {
-	if x != nil && x.ResponseStatus != nil {
-		return *x.ResponseStatus
+func (x *AuditLogArchiveInfo) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
 	}
 	return 0
 }
 
-func (x *AuditLogListReq) GetStartTime() int64 {
-	if x != nil && x.StartTime != nil {
-		return *x.StartTime
+func (x *AuditLogArchiveInfo) GetFromSeq() uint64 {
+	if x != nil {
+		return x.FromSeq
 	}
 	return 0
 }
 
-func (x *AuditLogListReq) GetEndTime() int64 {
-	if x != nil && x.EndTime != nil {
-		return *x.EndTime
+func (x *AuditLogArchiveInfo) GetToSeq() uint64 {
+	if x != nil {
+		return x.ToSeq
 	}
 	return 0
 }
 
-func (x *AuditLogListReq) GetMinDuration() int64 {
-	if x != nil && x.MinDuration != nil {
-		return *x.MinDuration
+func (x *AuditLogArchiveInfo) GetCount() int64 {
+	if x != nil {
+		return x.Count
 	}
 	return 0
 }
 
-func (x *AuditLogListReq) GetMaxDuration() int64 {
-	if x != nil && x.MaxDuration != nil {
-		return *x.MaxDuration
+func (x *AuditLogArchiveInfo) GetStartAt() int64 {
+	if x != nil {
+		return x.StartAt
 	}
 	return 0
 }
 
-type AuditLogListResp struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Total         uint64                 `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
-	Data          []*AuditLogInfo        `protobuf:"bytes,2,rep,name=data,proto3" json:"data"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *AuditLogListResp) Reset() {
-	*x = AuditLogListResp{}
-	mi := &file_core_proto_msgTypes[5]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *AuditLogListResp) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *AuditLogArchiveInfo) GetEndAt() int64 {
+	if x != nil {
+		return x.EndAt
+	}
+	return 0
 }
 
-func (*AuditLogListResp) ProtoMessage() {}
-
-func (x *AuditLogListResp) ProtoReflect() protoreflect.Message {
-	mi := &file_core_proto_msgTypes[5]
+func (x *AuditLogArchiveInfo) GetStore() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Store
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use AuditLogListResp.ProtoReflect.Descriptor instead.
-func (*AuditLogListResp) Descriptor() ([]byte, []int) {
-	return file_core_proto_rawDescGZIP(), []int{5}
+func (x *AuditLogArchiveInfo) GetObjectKey() string {
+	if x != nil {
+		return x.ObjectKey
+	}
+	return ""
 }
 
-func (x *AuditLogListResp) GetTotal() uint64 {
+func (x *AuditLogArchiveInfo) GetSize() int64 {
 	if x != nil {
-		return x.Total
+		return x.Size
 	}
 	return 0
 }
 
-func (x *AuditLogListResp) GetData() []*AuditLogInfo {
+func (x *AuditLogArchiveInfo) GetChecksum() string {
 	if x != nil {
-		return x.Data
+		return x.Checksum
 	}
-	return nil
+	return ""
 }
 
-type AuditLogStatsReq struct {
+type AuditLogArchiveListReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	StartTime     *int64                 `protobuf:"varint,1,opt,name=start_time,json=startTime,proto3,oneof" json:"start_time"`
-	EndTime       *int64                 `protobuf:"varint,2,opt,name=end_time,json=endTime,proto3,oneof" json:"end_time"`
-	OperationType *string                `protobuf:"bytes,3,opt,name=operation_type,json=operationType,proto3,oneof" json:"operation_type"`
-	ResourceType  *string                `protobuf:"bytes,4,opt,name=resource_type,json=resourceType,proto3,oneof" json:"resource_type"`
-	UserId        *string                `protobuf:"bytes,5,opt,name=user_id,json=userId,proto3,oneof" json:"user_id"`
+	Page          uint64                 `protobuf:"varint,1,opt,name=page,proto3" json:"page"`
+	PageSize      uint64                 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size"`
+	StartTime     *int64                 `protobuf:"varint,3,opt,name=start_time,json=startTime,proto3,oneof" json:"start_time"`
+	EndTime       *int64                 `protobuf:"varint,4,opt,name=end_time,json=endTime,proto3,oneof" json:"end_time"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AuditLogStatsReq) Reset() {
-	*x = AuditLogStatsReq{}
-	mi := &file_core_proto_msgTypes[6]
+func (x *AuditLogArchiveListReq) Reset() {
+	*x = AuditLogArchiveListReq{}
+	mi := &file_core_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AuditLogStatsReq) String() string {
+func (x *AuditLogArchiveListReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AuditLogStatsReq) ProtoMessage() {}
+func (*AuditLogArchiveListReq) ProtoMessage() {}
 
-func (x *AuditLogStatsReq) ProtoReflect() protoreflect.Message {
-	mi := &file_core_proto_msgTypes[6]
+func (x *AuditLogArchiveListReq) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -738,74 +741,62 @@ func (x *AuditLogStatsReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AuditLogStatsReq.ProtoReflect.Descriptor instead.
-func (*AuditLogStatsReq) Descriptor() ([]byte, []int) {
-	return file_core_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use AuditLogArchiveListReq.ProtoReflect.Descriptor instead.
+func (*AuditLogArchiveListReq) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *AuditLogStatsReq) GetStartTime() int64 {
-	if x != nil && x.StartTime != nil {
-		return *x.StartTime
+func (x *AuditLogArchiveListReq) GetPage() uint64 {
+	if x != nil {
+		return x.Page
 	}
 	return 0
 }
 
-func (x *AuditLogStatsReq) GetEndTime() int64 {
-	if x != nil && x.EndTime != nil {
-		return *x.EndTime
+func (x *AuditLogArchiveListReq) GetPageSize() uint64 {
+	if x != nil {
+		return x.PageSize
 	}
 	return 0
 }
 
-func (x *AuditLogStatsReq) GetOperationType() string {
-	if x != nil && x.OperationType != nil {
-		return *x.OperationType
-	}
-	return ""
-}
-
-func (x *AuditLogStatsReq) GetResourceType() string {
-	if x != nil && x.ResourceType != nil {
-		return *x.ResourceType
+func (x *AuditLogArchiveListReq) GetStartTime() int64 {
+	if x != nil && x.StartTime != nil {
+		return *x.StartTime
 	}
-	return ""
+	return 0
 }
 
-func (x *AuditLogStatsReq) GetUserId() string {
-	if x != nil && x.UserId != nil {
-		return *x.UserId
+func (x *AuditLogArchiveListReq) GetEndTime() int64 {
+	if x != nil && x.EndTime != nil {
+		return *x.EndTime
 	}
-	return ""
+	return 0
 }
 
-type AuditLogStatsResp struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	TotalOperations uint64                 `protobuf:"varint,1,opt,name=total_operations,json=totalOperations,proto3" json:"total_operations"`
-	UniqueUsers     uint64                 `protobuf:"varint,2,opt,name=unique_users,json=uniqueUsers,proto3" json:"unique_users"`
-	ErrorCount      uint64                 `protobuf:"varint,3,opt,name=error_count,json=errorCount,proto3" json:"error_count"`
-	AvgDurationMs   float64                `protobuf:"fixed64,4,opt,name=avg_duration_ms,json=avgDurationMs,proto3" json:"avg_duration_ms"`
-	OperationStats  []*OperationTypeStats  `protobuf:"bytes,5,rep,name=operation_stats,json=operationStats,proto3" json:"operation_stats"`
-	ResourceStats   []*ResourceTypeStats   `protobuf:"bytes,6,rep,name=resource_stats,json=resourceStats,proto3" json:"resource_stats"`
-	DurationStats   []*DurationStats       `protobuf:"bytes,7,rep,name=duration_stats,json=durationStats,proto3" json:"duration_stats"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type AuditLogArchiveListResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Total         uint64                 `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
+	Data          []*AuditLogArchiveInfo `protobuf:"bytes,2,rep,name=data,proto3" json:"data"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AuditLogStatsResp) Reset() {
-	*x = AuditLogStatsResp{}
-	mi := &file_core_proto_msgTypes[7]
+func (x *AuditLogArchiveListResp) Reset() {
+	*x = AuditLogArchiveListResp{}
+	mi := &file_core_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AuditLogStatsResp) String() string {
+func (x *AuditLogArchiveListResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AuditLogStatsResp) ProtoMessage() {}
+func (*AuditLogArchiveListResp) ProtoMessage() {}
 
-func (x *AuditLogStatsResp) ProtoReflect() protoreflect.Message {
-	mi := &file_core_proto_msgTypes[7]
+func (x *AuditLogArchiveListResp) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -816,83 +807,50 @@ func (x *AuditLogStatsResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AuditLogStatsResp.ProtoReflect.Descriptor instead.
-func (*AuditLogStatsResp) Descriptor() ([]byte, []int) {
-	return file_core_proto_rawDescGZIP(), []int{7}
-}
-
-func (x *AuditLogStatsResp) GetTotalOperations() uint64 {
-	if x != nil {
-		return x.TotalOperations
-	}
-	return 0
-}
-
-func (x *AuditLogStatsResp) GetUniqueUsers() uint64 {
-	if x != nil {
-		return x.UniqueUsers
-	}
-	return 0
-}
-
-func (x *AuditLogStatsResp) GetErrorCount() uint64 {
-	if x != nil {
-		return x.ErrorCount
-	}
-	return 0
+// Deprecated: Use AuditLogArchiveListResp.ProtoReflect.Descriptor instead.
+func (*AuditLogArchiveListResp) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *AuditLogStatsResp) GetAvgDurationMs() float64 {
+func (x *AuditLogArchiveListResp) GetTotal() uint64 {
 	if x != nil {
-		return x.AvgDurationMs
+		return x.Total
 	}
 	return 0
 }
 
-func (x *AuditLogStatsResp) GetOperationStats() []*OperationTypeStats {
-	if x != nil {
-		return x.OperationStats
-	}
-	return nil
-}
-
-func (x *AuditLogStatsResp) GetResourceStats() []*ResourceTypeStats {
-	if x != nil {
-		return x.ResourceStats
-	}
-	return nil
-}
-
-func (x *AuditLogStatsResp) GetDurationStats() []*DurationStats {
+func (x *AuditLogArchiveListResp) GetData() []*AuditLogArchiveInfo {
 	if x != nil {
-		return x.DurationStats
+		return x.Data
 	}
 	return nil
 }
 
-type BaseIDResp struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id"`
-	Msg           string                 `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg"`
+type AuditLogChainIssue struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Seq   uint64                 `protobuf:"varint,1,opt,name=seq,proto3" json:"seq"`
+	//  gap, prev_hash_mismatch, hash_mismatch, checkpoint_signature, checkpoint_mismatch, truncated, prune_signature, prune_gap
+	Type          string `protobuf:"bytes,2,opt,name=type,proto3" json:"type"`
+	Detail        string `protobuf:"bytes,3,opt,name=detail,proto3" json:"detail"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BaseIDResp) Reset() {
-	*x = BaseIDResp{}
-	mi := &file_core_proto_msgTypes[8]
+func (x *AuditLogChainIssue) Reset() {
+	*x = AuditLogChainIssue{}
+	mi := &file_core_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BaseIDResp) String() string {
+func (x *AuditLogChainIssue) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BaseIDResp) ProtoMessage() {}
+func (*AuditLogChainIssue) ProtoMessage() {}
 
-func (x *BaseIDResp) ProtoReflect() protoreflect.Message {
-	mi := &file_core_proto_msgTypes[8]
+func (x *AuditLogChainIssue) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -903,47 +861,6943 @@ func (x *BaseIDResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BaseIDResp.ProtoReflect.Descriptor instead.
-func (*BaseIDResp) Descriptor() ([]byte, []int) {
-	return file_core_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use AuditLogChainIssue.ProtoReflect.Descriptor instead.
+func (*AuditLogChainIssue) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *BaseIDResp) GetId() uint64 {
+func (x *AuditLogChainIssue) GetSeq() uint64 {
 	if x != nil {
-		return x.Id
+		return x.Seq
 	}
 	return 0
 }
 
-func (x *BaseIDResp) GetMsg() string {
+func (x *AuditLogChainIssue) GetType() string {
 	if x != nil {
-		return x.Msg
+		return x.Type
 	}
 	return ""
 }
 
-type BaseMsg struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Msg           string                 `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *BaseMsg) Reset() {
-	*x = BaseMsg{}
-	mi := &file_core_proto_msgTypes[9]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *AuditLogChainIssue) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
+type AuditLogInfo struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        *string                `protobuf:"bytes,1,opt,name=id,proto3,oneof" json:"id"`
+	CreatedAt *int64                 `protobuf:"varint,2,opt,name=created_at,json=createdAt,proto3,oneof" json:"created_at"`
+	UpdatedAt *int64                 `protobuf:"varint,3,opt,name=updated_at,json=updatedAt,proto3,oneof" json:"updated_at"`
+	//  Status 1: normal 2: ban | 状态 1 正常 2 禁用
+	Status *uint32 `protobuf:"varint,4,opt,name=status,proto3,oneof" json:"status"`
+	//  Tenant ID | 租户ID
+	TenantId *string `protobuf:"bytes,5,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id"`
+	//  User ID who performed the operation | 执行操作的用户ID
+	UserId *string `protobuf:"bytes,6,opt,name=user_id,json=userId,proto3,oneof" json:"user_id"`
+	//  User name who performed the operation | 执行操作的用户名
+	UserName *string `protobuf:"bytes,7,opt,name=user_name,json=userName,proto3,oneof" json:"user_name"`
+	//  Operation type | 操作类型
+	OperationType *string `protobuf:"bytes,8,opt,name=operation_type,json=operationType,proto3,oneof" json:"operation_type"`
+	//  Resource type that was operated on | 被操作的资源类型
+	ResourceType *string `protobuf:"bytes,9,opt,name=resource_type,json=resourceType,proto3,oneof" json:"resource_type"`
+	//  Resource ID that was operated on | 被操作的资源ID
+	ResourceId *string `protobuf:"bytes,10,opt,name=resource_id,json=resourceId,proto3,oneof" json:"resource_id"`
+	//  HTTP request method | HTTP请求方法
+	RequestMethod *string `protobuf:"bytes,11,opt,name=request_method,json=requestMethod,proto3,oneof" json:"request_method"`
+	//  HTTP request path | HTTP请求路径
+	RequestPath *string `protobuf:"bytes,12,opt,name=request_path,json=requestPath,proto3,oneof" json:"request_path"`
+	//  Request data (JSON format, sensitive data filtered) | 请求数据(JSON格式，已过滤敏感数据)
+	RequestData *string `protobuf:"bytes,13,opt,name=request_data,json=requestData,proto3,oneof" json:"request_data"`
+	//  HTTP response status code | HTTP响应状态码
+	ResponseStatus *int64 `protobuf:"varint,14,opt,name=response_status,json=responseStatus,proto3,oneof" json:"response_status"`
+	//  Response data (JSON format, optional) | 响应数据(JSON格式，可选)
+	ResponseData *string `protobuf:"bytes,15,opt,name=response_data,json=responseData,proto3,oneof" json:"response_data"`
+	//  Client IP address | 客户端IP地址
+	IpAddress *string `protobuf:"bytes,16,opt,name=ip_address,json=ipAddress,proto3,oneof" json:"ip_address"`
+	//  User agent string | 用户代理字符串
+	UserAgent *string `protobuf:"bytes,17,opt,name=user_agent,json=userAgent,proto3,oneof" json:"user_agent"`
+	//  Request processing duration in milliseconds | 请求处理耗时(毫秒)
+	DurationMs *int64 `protobuf:"varint,18,opt,name=duration_ms,json=durationMs,proto3,oneof" json:"duration_ms"`
+	//  Error message if operation failed | 操作失败时的错误信息
+	ErrorMessage *string `protobuf:"bytes,19,opt,name=error_message,json=errorMessage,proto3,oneof" json:"error_message"`
+	//  Additional metadata in JSON format | 额外的元数据(JSON格式)
+	Metadata *string `protobuf:"bytes,20,opt,name=metadata,proto3,oneof" json:"metadata"`
+	//  Sequence number in the tenant hash chain | 租户哈希链中的序号
+	Seq *uint64 `protobuf:"varint,21,opt,name=seq,proto3,oneof" json:"seq"`
+	//  Hash of the previous record | 上一条记录的哈希
+	PrevHash *string `protobuf:"bytes,22,opt,name=prev_hash,json=prevHash,proto3,oneof" json:"prev_hash"`
+	//  Hash of this record | 本条记录的哈希
+	Hash          *string `protobuf:"bytes,23,opt,name=hash,proto3,oneof" json:"hash"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditLogInfo) Reset() {
+	*x = AuditLogInfo{}
+	mi := &file_core_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditLogInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditLogInfo) ProtoMessage() {}
+
+func (x *AuditLogInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditLogInfo.ProtoReflect.Descriptor instead.
+func (*AuditLogInfo) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *AuditLogInfo) GetId() string {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return ""
+}
+
+func (x *AuditLogInfo) GetCreatedAt() int64 {
+	if x != nil && x.CreatedAt != nil {
+		return *x.CreatedAt
+	}
+	return 0
+}
+
+func (x *AuditLogInfo) GetUpdatedAt() int64 {
+	if x != nil && x.UpdatedAt != nil {
+		return *x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *AuditLogInfo) GetStatus() uint32 {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return 0
+}
+
+func (x *AuditLogInfo) GetTenantId() string {
+	if x != nil && x.TenantId != nil {
+		return *x.TenantId
+	}
+	return ""
+}
+
+func (x *AuditLogInfo) GetUserId() string {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return ""
+}
+
+func (x *AuditLogInfo) GetUserName() string {
+	if x != nil && x.UserName != nil {
+		return *x.UserName
+	}
+	return ""
+}
+
+func (x *AuditLogInfo) GetOperationType() string {
+	if x != nil && x.OperationType != nil {
+		return *x.OperationType
+	}
+	return ""
+}
+
+func (x *AuditLogInfo) GetResourceType() string {
+	if x != nil && x.ResourceType != nil {
+		return *x.ResourceType
+	}
+	return ""
+}
+
+func (x *AuditLogInfo) GetResourceId() string {
+	if x != nil && x.ResourceId != nil {
+		return *x.ResourceId
+	}
+	return ""
+}
+
+func (x *AuditLogInfo) GetRequestMethod() string {
+	if x != nil && x.RequestMethod != nil {
+		return *x.RequestMethod
+	}
+	return ""
+}
+
+func (x *AuditLogInfo) GetRequestPath() string {
+	if x != nil && x.RequestPath != nil {
+		return *x.RequestPath
+	}
+	return ""
+}
+
+func (x *AuditLogInfo) GetRequestData() string {
+	if x != nil && x.RequestData != nil {
+		return *x.RequestData
+	}
+	return ""
+}
+
+func (x *AuditLogInfo) GetResponseStatus() int64 {
+	if x != nil && x.ResponseStatus != nil {
+		return *x.ResponseStatus
+	}
+	return 0
+}
+
+func (x *AuditLogInfo) GetResponseData() string {
+	if x != nil && x.ResponseData != nil {
+		return *x.ResponseData
+	}
+	return ""
+}
+
+func (x *AuditLogInfo) GetIpAddress() string {
+	if x != nil && x.IpAddress != nil {
+		return *x.IpAddress
+	}
+	return ""
+}
+
+func (x *AuditLogInfo) GetUserAgent() string {
+	if x != nil && x.UserAgent != nil {
+		return *x.UserAgent
+	}
+	return ""
+}
+
+func (x *AuditLogInfo) GetDurationMs() int64 {
+	if x != nil && x.DurationMs != nil {
+		return *x.DurationMs
+	}
+	return 0
+}
+
+func (x *AuditLogInfo) GetErrorMessage() string {
+	if x != nil && x.ErrorMessage != nil {
+		return *x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *AuditLogInfo) GetMetadata() string {
+	if x != nil && x.Metadata != nil {
+		return *x.Metadata
+	}
+	return ""
+}
+
+func (x *AuditLogInfo) GetSeq() uint64 {
+	if x != nil && x.Seq != nil {
+		return *x.Seq
+	}
+	return 0
+}
+
+func (x *AuditLogInfo) GetPrevHash() string {
+	if x != nil && x.PrevHash != nil {
+		return *x.PrevHash
+	}
+	return ""
+}
+
+func (x *AuditLogInfo) GetHash() string {
+	if x != nil && x.Hash != nil {
+		return *x.Hash
+	}
+	return ""
+}
+
+type AuditLogListReq struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Page           uint64                 `protobuf:"varint,1,opt,name=page,proto3" json:"page"`
+	PageSize       uint64                 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size"`
+	UserId         *string                `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3,oneof" json:"user_id"`
+	UserName       *string                `protobuf:"bytes,4,opt,name=user_name,json=userName,proto3,oneof" json:"user_name"`
+	OperationType  *string                `protobuf:"bytes,5,opt,name=operation_type,json=operationType,proto3,oneof" json:"operation_type"`
+	ResourceType   *string                `protobuf:"bytes,6,opt,name=resource_type,json=resourceType,proto3,oneof" json:"resource_type"`
+	ResourceId     *string                `protobuf:"bytes,7,opt,name=resource_id,json=resourceId,proto3,oneof" json:"resource_id"`
+	RequestMethod  *string                `protobuf:"bytes,8,opt,name=request_method,json=requestMethod,proto3,oneof" json:"request_method"`
+	RequestPath    *string                `protobuf:"bytes,9,opt,name=request_path,json=requestPath,proto3,oneof" json:"request_path"`
+	IpAddress      *string                `protobuf:"bytes,10,opt,name=ip_address,json=ipAddress,proto3,oneof" json:"ip_address"`
+	ResponseStatus *int64                 `protobuf:"varint,11,opt,name=response_status,json=responseStatus,proto3,oneof" json:"response_status"`
+	StartTime      *int64                 `protobuf:"varint,12,opt,name=start_time,json=startTime,proto3,oneof" json:"start_time"`
+	EndTime        *int64                 `protobuf:"varint,13,opt,name=end_time,json=endTime,proto3,oneof" json:"end_time"`
+	MinDuration    *int64                 `protobuf:"varint,14,opt,name=min_duration,json=minDuration,proto3,oneof" json:"min_duration"`
+	MaxDuration    *int64                 `protobuf:"varint,15,opt,name=max_duration,json=maxDuration,proto3,oneof" json:"max_duration"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AuditLogListReq) Reset() {
+	*x = AuditLogListReq{}
+	mi := &file_core_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditLogListReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditLogListReq) ProtoMessage() {}
+
+func (x *AuditLogListReq) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditLogListReq.ProtoReflect.Descriptor instead.
+func (*AuditLogListReq) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *AuditLogListReq) GetPage() uint64 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *AuditLogListReq) GetPageSize() uint64 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *AuditLogListReq) GetUserId() string {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return ""
+}
+
+func (x *AuditLogListReq) GetUserName() string {
+	if x != nil && x.UserName != nil {
+		return *x.UserName
+	}
+	return ""
+}
+
+func (x *AuditLogListReq) GetOperationType() string {
+	if x != nil && x.OperationType != nil {
+		return *x.OperationType
+	}
+	return ""
+}
+
+func (x *AuditLogListReq) GetResourceType() string {
+	if x != nil && x.ResourceType != nil {
+		return *x.ResourceType
+	}
+	return ""
+}
+
+func (x *AuditLogListReq) GetResourceId() string {
+	if x != nil && x.ResourceId != nil {
+		return *x.ResourceId
+	}
+	return ""
+}
+
+func (x *AuditLogListReq) GetRequestMethod() string {
+	if x != nil && x.RequestMethod != nil {
+		return *x.RequestMethod
+	}
+	return ""
+}
+
+func (x *AuditLogListReq) GetRequestPath() string {
+	if x != nil && x.RequestPath != nil {
+		return *x.RequestPath
+	}
+	return ""
+}
+
+func (x *AuditLogListReq) GetIpAddress() string {
+	if x != nil && x.IpAddress != nil {
+		return *x.IpAddress
+	}
+	return ""
+}
+
+func (x *AuditLogListReq) GetResponseStatus() int64 {
+	if x != nil && x.ResponseStatus != nil {
+		return *x.ResponseStatus
+	}
+	return 0
+}
+
+func (x *AuditLogListReq) GetStartTime() int64 {
+	if x != nil && x.StartTime != nil {
+		return *x.StartTime
+	}
+	return 0
+}
+
+func (x *AuditLogListReq) GetEndTime() int64 {
+	if x != nil && x.EndTime != nil {
+		return *x.EndTime
+	}
+	return 0
+}
+
+func (x *AuditLogListReq) GetMinDuration() int64 {
+	if x != nil && x.MinDuration != nil {
+		return *x.MinDuration
+	}
+	return 0
+}
+
+func (x *AuditLogListReq) GetMaxDuration() int64 {
+	if x != nil && x.MaxDuration != nil {
+		return *x.MaxDuration
+	}
+	return 0
+}
+
+type AuditLogListResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Total         uint64                 `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
+	Data          []*AuditLogInfo        `protobuf:"bytes,2,rep,name=data,proto3" json:"data"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditLogListResp) Reset() {
+	*x = AuditLogListResp{}
+	mi := &file_core_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditLogListResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditLogListResp) ProtoMessage() {}
+
+func (x *AuditLogListResp) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditLogListResp.ProtoReflect.Descriptor instead.
+func (*AuditLogListResp) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *AuditLogListResp) GetTotal() uint64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *AuditLogListResp) GetData() []*AuditLogInfo {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type AuditLogStatsReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StartTime     *int64                 `protobuf:"varint,1,opt,name=start_time,json=startTime,proto3,oneof" json:"start_time"`
+	EndTime       *int64                 `protobuf:"varint,2,opt,name=end_time,json=endTime,proto3,oneof" json:"end_time"`
+	OperationType *string                `protobuf:"bytes,3,opt,name=operation_type,json=operationType,proto3,oneof" json:"operation_type"`
+	ResourceType  *string                `protobuf:"bytes,4,opt,name=resource_type,json=resourceType,proto3,oneof" json:"resource_type"`
+	UserId        *string                `protobuf:"bytes,5,opt,name=user_id,json=userId,proto3,oneof" json:"user_id"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditLogStatsReq) Reset() {
+	*x = AuditLogStatsReq{}
+	mi := &file_core_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditLogStatsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditLogStatsReq) ProtoMessage() {}
+
+func (x *AuditLogStatsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditLogStatsReq.ProtoReflect.Descriptor instead.
+func (*AuditLogStatsReq) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *AuditLogStatsReq) GetStartTime() int64 {
+	if x != nil && x.StartTime != nil {
+		return *x.StartTime
+	}
+	return 0
+}
+
+func (x *AuditLogStatsReq) GetEndTime() int64 {
+	if x != nil && x.EndTime != nil {
+		return *x.EndTime
+	}
+	return 0
+}
+
+func (x *AuditLogStatsReq) GetOperationType() string {
+	if x != nil && x.OperationType != nil {
+		return *x.OperationType
+	}
+	return ""
+}
+
+func (x *AuditLogStatsReq) GetResourceType() string {
+	if x != nil && x.ResourceType != nil {
+		return *x.ResourceType
+	}
+	return ""
+}
+
+func (x *AuditLogStatsReq) GetUserId() string {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return ""
+}
+
+type AuditLogStatsResp struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	TotalOperations uint64                 `protobuf:"varint,1,opt,name=total_operations,json=totalOperations,proto3" json:"total_operations"`
+	UniqueUsers     uint64                 `protobuf:"varint,2,opt,name=unique_users,json=uniqueUsers,proto3" json:"unique_users"`
+	ErrorCount      uint64                 `protobuf:"varint,3,opt,name=error_count,json=errorCount,proto3" json:"error_count"`
+	AvgDurationMs   float64                `protobuf:"fixed64,4,opt,name=avg_duration_ms,json=avgDurationMs,proto3" json:"avg_duration_ms"`
+	OperationStats  []*OperationTypeStats  `protobuf:"bytes,5,rep,name=operation_stats,json=operationStats,proto3" json:"operation_stats"`
+	ResourceStats   []*ResourceTypeStats   `protobuf:"bytes,6,rep,name=resource_stats,json=resourceStats,proto3" json:"resource_stats"`
+	DurationStats   []*DurationStats       `protobuf:"bytes,7,rep,name=duration_stats,json=durationStats,proto3" json:"duration_stats"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *AuditLogStatsResp) Reset() {
+	*x = AuditLogStatsResp{}
+	mi := &file_core_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditLogStatsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditLogStatsResp) ProtoMessage() {}
+
+func (x *AuditLogStatsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditLogStatsResp.ProtoReflect.Descriptor instead.
+func (*AuditLogStatsResp) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *AuditLogStatsResp) GetTotalOperations() uint64 {
+	if x != nil {
+		return x.TotalOperations
+	}
+	return 0
+}
+
+func (x *AuditLogStatsResp) GetUniqueUsers() uint64 {
+	if x != nil {
+		return x.UniqueUsers
+	}
+	return 0
+}
+
+func (x *AuditLogStatsResp) GetErrorCount() uint64 {
+	if x != nil {
+		return x.ErrorCount
+	}
+	return 0
+}
+
+func (x *AuditLogStatsResp) GetAvgDurationMs() float64 {
+	if x != nil {
+		return x.AvgDurationMs
+	}
+	return 0
+}
+
+func (x *AuditLogStatsResp) GetOperationStats() []*OperationTypeStats {
+	if x != nil {
+		return x.OperationStats
+	}
+	return nil
+}
+
+func (x *AuditLogStatsResp) GetResourceStats() []*ResourceTypeStats {
+	if x != nil {
+		return x.ResourceStats
+	}
+	return nil
+}
+
+func (x *AuditLogStatsResp) GetDurationStats() []*DurationStats {
+	if x != nil {
+		return x.DurationStats
+	}
+	return nil
+}
+
+type AuditLogVerifyResp struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Valid    bool                   `protobuf:"varint,1,opt,name=valid,proto3" json:"valid"`
+	Checked  uint64                 `protobuf:"varint,2,opt,name=checked,proto3" json:"checked"`
+	HeadSeq  uint64                 `protobuf:"varint,3,opt,name=head_seq,json=headSeq,proto3" json:"head_seq"`
+	HeadHash string                 `protobuf:"bytes,4,opt,name=head_hash,json=headHash,proto3" json:"head_hash"`
+	//  Records up to this sequence number were pruned by retention policy | 该序号及之前的记录已按保留策略清理
+	PrunedToSeq   uint64                `protobuf:"varint,5,opt,name=pruned_to_seq,json=prunedToSeq,proto3" json:"pruned_to_seq"`
+	Issues        []*AuditLogChainIssue `protobuf:"bytes,6,rep,name=issues,proto3" json:"issues"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditLogVerifyResp) Reset() {
+	*x = AuditLogVerifyResp{}
+	mi := &file_core_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditLogVerifyResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditLogVerifyResp) ProtoMessage() {}
+
+func (x *AuditLogVerifyResp) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditLogVerifyResp.ProtoReflect.Descriptor instead.
+func (*AuditLogVerifyResp) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *AuditLogVerifyResp) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *AuditLogVerifyResp) GetChecked() uint64 {
+	if x != nil {
+		return x.Checked
+	}
+	return 0
+}
+
+func (x *AuditLogVerifyResp) GetHeadSeq() uint64 {
+	if x != nil {
+		return x.HeadSeq
+	}
+	return 0
+}
+
+func (x *AuditLogVerifyResp) GetHeadHash() string {
+	if x != nil {
+		return x.HeadHash
+	}
+	return ""
+}
+
+func (x *AuditLogVerifyResp) GetPrunedToSeq() uint64 {
+	if x != nil {
+		return x.PrunedToSeq
+	}
+	return 0
+}
+
+func (x *AuditLogVerifyResp) GetIssues() []*AuditLogChainIssue {
+	if x != nil {
+		return x.Issues
+	}
+	return nil
+}
+
+type BaseIDResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id"`
+	Msg           string                 `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BaseIDResp) Reset() {
+	*x = BaseIDResp{}
+	mi := &file_core_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BaseIDResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BaseIDResp) ProtoMessage() {}
+
+func (x *BaseIDResp) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BaseIDResp.ProtoReflect.Descriptor instead.
+func (*BaseIDResp) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *BaseIDResp) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *BaseIDResp) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+type BaseMsg struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Msg           string                 `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BaseMsg) Reset() {
+	*x = BaseMsg{}
+	mi := &file_core_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *BaseMsg) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BaseMsg) ProtoMessage() {}
+func (*BaseMsg) ProtoMessage() {}
+
+func (x *BaseMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BaseMsg.ProtoReflect.Descriptor instead.
+func (*BaseMsg) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *BaseMsg) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+type BaseResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Msg           string                 `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BaseResp) Reset() {
+	*x = BaseResp{}
+	mi := &file_core_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BaseResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BaseResp) ProtoMessage() {}
+
+func (x *BaseResp) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BaseResp.ProtoReflect.Descriptor instead.
+func (*BaseResp) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *BaseResp) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+type BaseUUIDResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	Msg           string                 `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BaseUUIDResp) Reset() {
+	*x = BaseUUIDResp{}
+	mi := &file_core_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BaseUUIDResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BaseUUIDResp) ProtoMessage() {}
+
+func (x *BaseUUIDResp) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BaseUUIDResp.ProtoReflect.Descriptor instead.
+func (*BaseUUIDResp) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *BaseUUIDResp) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *BaseUUIDResp) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+//  批量创建Casbin规则请求
+type BatchCreateCasbinRulesReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rules         []*CasbinRuleInfo      `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchCreateCasbinRulesReq) Reset() {
+	*x = BatchCreateCasbinRulesReq{}
+	mi := &file_core_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchCreateCasbinRulesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchCreateCasbinRulesReq) ProtoMessage() {}
+
+func (x *BatchCreateCasbinRulesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchCreateCasbinRulesReq.ProtoReflect.Descriptor instead.
+func (*BatchCreateCasbinRulesReq) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *BatchCreateCasbinRulesReq) GetRules() []*CasbinRuleInfo {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+//  批量权限检查请求
+type BatchPermissionCheckReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Requests      []*PermissionCheckReq  `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests"`
+	FailFast      *bool                  `protobuf:"varint,2,opt,name=fail_fast,json=failFast,proto3,oneof" json:"fail_fast"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchPermissionCheckReq) Reset() {
+	*x = BatchPermissionCheckReq{}
+	mi := &file_core_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchPermissionCheckReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchPermissionCheckReq) ProtoMessage() {}
+
+func (x *BatchPermissionCheckReq) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchPermissionCheckReq.ProtoReflect.Descriptor instead.
+func (*BatchPermissionCheckReq) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *BatchPermissionCheckReq) GetRequests() []*PermissionCheckReq {
+	if x != nil {
+		return x.Requests
+	}
+	return nil
+}
+
+func (x *BatchPermissionCheckReq) GetFailFast() bool {
+	if x != nil && x.FailFast != nil {
+		return *x.FailFast
+	}
+	return false
+}
+
+//  批量权限检查响应
+type BatchPermissionCheckResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Responses     []*PermissionCheckResp `protobuf:"bytes,1,rep,name=responses,proto3" json:"responses"`
+	SuccessCount  int32                  `protobuf:"varint,2,opt,name=success_count,json=successCount,proto3" json:"success_count"`
+	FailedCount   int32                  `protobuf:"varint,3,opt,name=failed_count,json=failedCount,proto3" json:"failed_count"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchPermissionCheckResp) Reset() {
+	*x = BatchPermissionCheckResp{}
+	mi := &file_core_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchPermissionCheckResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchPermissionCheckResp) ProtoMessage() {}
+
+func (x *BatchPermissionCheckResp) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchPermissionCheckResp.ProtoReflect.Descriptor instead.
+func (*BatchPermissionCheckResp) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *BatchPermissionCheckResp) GetResponses() []*PermissionCheckResp {
+	if x != nil {
+		return x.Responses
+	}
+	return nil
+}
+
+func (x *BatchPermissionCheckResp) GetSuccessCount() int32 {
+	if x != nil {
+		return x.SuccessCount
+	}
+	return 0
+}
+
+func (x *BatchPermissionCheckResp) GetFailedCount() int32 {
+	if x != nil {
+		return x.FailedCount
+	}
+	return 0
+}
+
+//  批量更新Casbin规则请求
+type BatchUpdateCasbinRulesReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rules         []*CasbinRuleInfo      `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchUpdateCasbinRulesReq) Reset() {
+	*x = BatchUpdateCasbinRulesReq{}
+	mi := &file_core_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchUpdateCasbinRulesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchUpdateCasbinRulesReq) ProtoMessage() {}
+
+func (x *BatchUpdateCasbinRulesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchUpdateCasbinRulesReq.ProtoReflect.Descriptor instead.
+func (*BatchUpdateCasbinRulesReq) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *BatchUpdateCasbinRulesReq) GetRules() []*CasbinRuleInfo {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+type BindOauthAccountReq struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	UserId            string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id"`
+	ProviderType      string                 `protobuf:"bytes,2,opt,name=provider_type,json=providerType,proto3" json:"provider_type"`
+	ProviderId        uint64                 `protobuf:"varint,3,opt,name=provider_id,json=providerId,proto3" json:"provider_id"`
+	AuthorizationCode string                 `protobuf:"bytes,4,opt,name=authorization_code,json=authorizationCode,proto3" json:"authorization_code"`
+	State             string                 `protobuf:"bytes,5,opt,name=state,proto3" json:"state"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *BindOauthAccountReq) Reset() {
+	*x = BindOauthAccountReq{}
+	mi := &file_core_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BindOauthAccountReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BindOauthAccountReq) ProtoMessage() {}
+
+func (x *BindOauthAccountReq) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BindOauthAccountReq.ProtoReflect.Descriptor instead.
+func (*BindOauthAccountReq) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *BindOauthAccountReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *BindOauthAccountReq) GetProviderType() string {
+	if x != nil {
+		return x.ProviderType
+	}
+	return ""
+}
+
+func (x *BindOauthAccountReq) GetProviderId() uint64 {
+	if x != nil {
+		return x.ProviderId
+	}
+	return 0
+}
+
+func (x *BindOauthAccountReq) GetAuthorizationCode() string {
+	if x != nil {
+		return x.AuthorizationCode
+	}
+	return ""
+}
+
+func (x *BindOauthAccountReq) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+type CallbackReq struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	State string                 `protobuf:"bytes,1,opt,name=state,proto3" json:"state"`
+	Code  string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code"`
+	//  Error returned by the provider instead of the code, e.g. access_denied
+	Error            *string `protobuf:"bytes,3,opt,name=error,proto3,oneof" json:"error"`
+	ErrorDescription *string `protobuf:"bytes,4,opt,name=error_description,json=errorDescription,proto3,oneof" json:"error_description"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CallbackReq) Reset() {
+	*x = CallbackReq{}
+	mi := &file_core_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CallbackReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CallbackReq) ProtoMessage() {}
+
+func (x *CallbackReq) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CallbackReq.ProtoReflect.Descriptor instead.
+func (*CallbackReq) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *CallbackReq) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *CallbackReq) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *CallbackReq) GetError() string {
+	if x != nil && x.Error != nil {
+		return *x.Error
+	}
+	return ""
+}
+
+func (x *CallbackReq) GetErrorDescription() string {
+	if x != nil && x.ErrorDescription != nil {
+		return *x.ErrorDescription
+	}
+	return ""
+}
+
+//  Casbin权限规则信息
+type CasbinRuleInfo struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        *uint64                `protobuf:"varint,1,opt,name=id,proto3,oneof" json:"id"`
+	CreatedAt *int64                 `protobuf:"varint,2,opt,name=created_at,json=createdAt,proto3,oneof" json:"created_at"`
+	UpdatedAt *int64                 `protobuf:"varint,3,opt,name=updated_at,json=updatedAt,proto3,oneof" json:"updated_at"`
+	TenantId  *uint64                `protobuf:"varint,4,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id"`
+	//  Casbin标准字段 - 与原生Casbin适配器兼容
+	Ptype string  `protobuf:"bytes,5,opt,name=ptype,proto3" json:"ptype"`
+	V0    *string `protobuf:"bytes,6,opt,name=v0,proto3,oneof" json:"v0"`
+	V1    *string `protobuf:"bytes,7,opt,name=v1,proto3,oneof" json:"v1"`
+	V2    *string `protobuf:"bytes,8,opt,name=v2,proto3,oneof" json:"v2"`
+	V3    *string `protobuf:"bytes,9,opt,name=v3,proto3,oneof" json:"v3"`
+	V4    *string `protobuf:"bytes,10,opt,name=v4,proto3,oneof" json:"v4"`
+	V5    *string `protobuf:"bytes,11,opt,name=v5,proto3,oneof" json:"v5"`
+	//  业务扩展字段 - 企业级权限管理
+	ServiceName string  `protobuf:"bytes,12,opt,name=service_name,json=serviceName,proto3" json:"service_name"`
+	RuleName    *string `protobuf:"bytes,13,opt,name=rule_name,json=ruleName,proto3,oneof" json:"rule_name"`
+	Description *string `protobuf:"bytes,14,opt,name=description,proto3,oneof" json:"description"`
+	Category    *string `protobuf:"bytes,15,opt,name=category,proto3,oneof" json:"category"`
+	Version     *string `protobuf:"bytes,16,opt,name=version,proto3,oneof" json:"version"`
+	//  审批流程字段 - 企业级审批工作流
+	RequireApproval *bool   `protobuf:"varint,17,opt,name=require_approval,json=requireApproval,proto3,oneof" json:"require_approval"`
+	ApprovalStatus  *string `protobuf:"bytes,18,opt,name=approval_status,json=approvalStatus,proto3,oneof" json:"approval_status"`
+	ApprovedBy      *uint64 `protobuf:"varint,19,opt,name=approved_by,json=approvedBy,proto3,oneof" json:"approved_by"`
+	ApprovedAt      *int64  `protobuf:"varint,20,opt,name=approved_at,json=approvedAt,proto3,oneof" json:"approved_at"`
+	//  时间控制字段 - 临时权限支持
+	EffectiveFrom *int64 `protobuf:"varint,21,opt,name=effective_from,json=effectiveFrom,proto3,oneof" json:"effective_from"`
+	EffectiveTo   *int64 `protobuf:"varint,22,opt,name=effective_to,json=effectiveTo,proto3,oneof" json:"effective_to"`
+	IsTemporary   *bool  `protobuf:"varint,23,opt,name=is_temporary,json=isTemporary,proto3,oneof" json:"is_temporary"`
+	//  管理字段
+	Status        *uint32  `protobuf:"varint,24,opt,name=status,proto3,oneof" json:"status"`
+	Metadata      *string  `protobuf:"bytes,25,opt,name=metadata,proto3,oneof" json:"metadata"`
+	Tags          []string `protobuf:"bytes,26,rep,name=tags,proto3" json:"tags"`
+	UsageCount    *int64   `protobuf:"varint,27,opt,name=usage_count,json=usageCount,proto3,oneof" json:"usage_count"`
+	LastUsedAt    *int64   `protobuf:"varint,28,opt,name=last_used_at,json=lastUsedAt,proto3,oneof" json:"last_used_at"`
+	Conditions    *string  `protobuf:"bytes,29,opt,name=conditions,proto3,oneof" json:"conditions"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CasbinRuleInfo) Reset() {
+	*x = CasbinRuleInfo{}
+	mi := &file_core_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CasbinRuleInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CasbinRuleInfo) ProtoMessage() {}
+
+func (x *CasbinRuleInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CasbinRuleInfo.ProtoReflect.Descriptor instead.
+func (*CasbinRuleInfo) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *CasbinRuleInfo) GetId() uint64 {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return 0
+}
+
+func (x *CasbinRuleInfo) GetCreatedAt() int64 {
+	if x != nil && x.CreatedAt != nil {
+		return *x.CreatedAt
+	}
+	return 0
+}
+
+func (x *CasbinRuleInfo) GetUpdatedAt() int64 {
+	if x != nil && x.UpdatedAt != nil {
+		return *x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *CasbinRuleInfo) GetTenantId() uint64 {
+	if x != nil && x.TenantId != nil {
+		return *x.TenantId
+	}
+	return 0
+}
+
+func (x *CasbinRuleInfo) GetPtype() string {
+	if x != nil {
+		return x.Ptype
+	}
+	return ""
+}
+
+func (x *CasbinRuleInfo) GetV0() string {
+	if x != nil && x.V0 != nil {
+		return *x.V0
+	}
+	return ""
+}
+
+func (x *CasbinRuleInfo) GetV1() string {
+	if x != nil && x.V1 != nil {
+		return *x.V1
+	}
+	return ""
+}
+
+func (x *CasbinRuleInfo) GetV2() string {
+	if x != nil && x.V2 != nil {
+		return *x.V2
+	}
+	return ""
+}
+
+func (x *CasbinRuleInfo) GetV3() string {
+	if x != nil && x.V3 != nil {
+		return *x.V3
+	}
+	return ""
+}
+
+func (x *CasbinRuleInfo) GetV4() string {
+	if x != nil && x.V4 != nil {
+		return *x.V4
+	}
+	return ""
+}
+
+func (x *CasbinRuleInfo) GetV5() string {
+	if x != nil && x.V5 != nil {
+		return *x.V5
+	}
+	return ""
+}
+
+func (x *CasbinRuleInfo) GetServiceName() string {
+	if x != nil {
+		return x.ServiceName
+	}
+	return ""
+}
+
+func (x *CasbinRuleInfo) GetRuleName() string {
+	if x != nil && x.RuleName != nil {
+		return *x.RuleName
+	}
+	return ""
+}
+
+func (x *CasbinRuleInfo) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *CasbinRuleInfo) GetCategory() string {
+	if x != nil && x.Category != nil {
+		return *x.Category
+	}
+	return ""
+}
+
+func (x *CasbinRuleInfo) GetVersion() string {
+	if x != nil && x.Version != nil {
+		return *x.Version
+	}
+	return ""
+}
+
+func (x *CasbinRuleInfo) GetRequireApproval() bool {
+	if x != nil && x.RequireApproval != nil {
+		return *x.RequireApproval
+	}
+	return false
+}
+
+func (x *CasbinRuleInfo) GetApprovalStatus() string {
+	if x != nil && x.ApprovalStatus != nil {
+		return *x.ApprovalStatus
+	}
+	return ""
+}
+
+func (x *CasbinRuleInfo) GetApprovedBy() uint64 {
+	if x != nil && x.ApprovedBy != nil {
+		return *x.ApprovedBy
+	}
+	return 0
+}
+
+func (x *CasbinRuleInfo) GetApprovedAt() int64 {
+	if x != nil && x.ApprovedAt != nil {
+		return *x.ApprovedAt
+	}
+	return 0
+}
+
+func (x *CasbinRuleInfo) GetEffectiveFrom() int64 {
+	if x != nil && x.EffectiveFrom != nil {
+		return *x.EffectiveFrom
+	}
+	return 0
+}
+
+func (x *CasbinRuleInfo) GetEffectiveTo() int64 {
+	if x != nil && x.EffectiveTo != nil {
+		return *x.EffectiveTo
+	}
+	return 0
+}
+
+func (x *CasbinRuleInfo) GetIsTemporary() bool {
+	if x != nil && x.IsTemporary != nil {
+		return *x.IsTemporary
+	}
+	return false
+}
+
+func (x *CasbinRuleInfo) GetStatus() uint32 {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return 0
+}
+
+func (x *CasbinRuleInfo) GetMetadata() string {
+	if x != nil && x.Metadata != nil {
+		return *x.Metadata
+	}
+	return ""
+}
+
+func (x *CasbinRuleInfo) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *CasbinRuleInfo) GetUsageCount() int64 {
+	if x != nil && x.UsageCount != nil {
+		return *x.UsageCount
+	}
+	return 0
+}
+
+func (x *CasbinRuleInfo) GetLastUsedAt() int64 {
+	if x != nil && x.LastUsedAt != nil {
+		return *x.LastUsedAt
+	}
+	return 0
+}
+
+func (x *CasbinRuleInfo) GetConditions() string {
+	if x != nil && x.Conditions != nil {
+		return *x.Conditions
+	}
+	return ""
+}
+
+//  Casbin规则列表请求
+type CasbinRuleListReq struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Page     uint64                 `protobuf:"varint,1,opt,name=page,proto3" json:"page"`
+	PageSize uint64                 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size"`
+	//  过滤条件
+	ServiceName    *string `protobuf:"bytes,3,opt,name=service_name,json=serviceName,proto3,oneof" json:"service_name"`
+	Ptype          *string `protobuf:"bytes,4,opt,name=ptype,proto3,oneof" json:"ptype"`
+	V0             *string `protobuf:"bytes,5,opt,name=v0,proto3,oneof" json:"v0"`
+	V1             *string `protobuf:"bytes,6,opt,name=v1,proto3,oneof" json:"v1"`
+	Status         *uint32 `protobuf:"varint,7,opt,name=status,proto3,oneof" json:"status"`
+	ApprovalStatus *string `protobuf:"bytes,8,opt,name=approval_status,json=approvalStatus,proto3,oneof" json:"approval_status"`
+	Category       *string `protobuf:"bytes,9,opt,name=category,proto3,oneof" json:"category"`
+	IsTemporary    *bool   `protobuf:"varint,10,opt,name=is_temporary,json=isTemporary,proto3,oneof" json:"is_temporary"`
+	//  时间范围过滤
+	EffectiveFromStart *int64 `protobuf:"varint,11,opt,name=effective_from_start,json=effectiveFromStart,proto3,oneof" json:"effective_from_start"`
+	EffectiveFromEnd   *int64 `protobuf:"varint,12,opt,name=effective_from_end,json=effectiveFromEnd,proto3,oneof" json:"effective_from_end"`
+	//  搜索关键词
+	Keyword *string `protobuf:"bytes,13,opt,name=keyword,proto3,oneof" json:"keyword"`
+	//  排序
+	OrderBy        *string `protobuf:"bytes,14,opt,name=order_by,json=orderBy,proto3,oneof" json:"order_by"`
+	OrderDirection *string `protobuf:"bytes,15,opt,name=order_direction,json=orderDirection,proto3,oneof" json:"order_direction"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CasbinRuleListReq) Reset() {
+	*x = CasbinRuleListReq{}
+	mi := &file_core_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CasbinRuleListReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CasbinRuleListReq) ProtoMessage() {}
+
+func (x *CasbinRuleListReq) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CasbinRuleListReq.ProtoReflect.Descriptor instead.
+func (*CasbinRuleListReq) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *CasbinRuleListReq) GetPage() uint64 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *CasbinRuleListReq) GetPageSize() uint64 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *CasbinRuleListReq) GetServiceName() string {
+	if x != nil && x.ServiceName != nil {
+		return *x.ServiceName
+	}
+	return ""
+}
+
+func (x *CasbinRuleListReq) GetPtype() string {
+	if x != nil && x.Ptype != nil {
+		return *x.Ptype
+	}
+	return ""
+}
+
+func (x *CasbinRuleListReq) GetV0() string {
+	if x != nil && x.V0 != nil {
+		return *x.V0
+	}
+	return ""
+}
+
+func (x *CasbinRuleListReq) GetV1() string {
+	if x != nil && x.V1 != nil {
+		return *x.V1
+	}
+	return ""
+}
+
+func (x *CasbinRuleListReq) GetStatus() uint32 {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return 0
+}
+
+func (x *CasbinRuleListReq) GetApprovalStatus() string {
+	if x != nil && x.ApprovalStatus != nil {
+		return *x.ApprovalStatus
+	}
+	return ""
+}
+
+func (x *CasbinRuleListReq) GetCategory() string {
+	if x != nil && x.Category != nil {
+		return *x.Category
+	}
+	return ""
+}
+
+func (x *CasbinRuleListReq) GetIsTemporary() bool {
+	if x != nil && x.IsTemporary != nil {
+		return *x.IsTemporary
+	}
+	return false
+}
+
+func (x *CasbinRuleListReq) GetEffectiveFromStart() int64 {
+	if x != nil && x.EffectiveFromStart != nil {
+		return *x.EffectiveFromStart
+	}
+	return 0
+}
+
+func (x *CasbinRuleListReq) GetEffectiveFromEnd() int64 {
+	if x != nil && x.EffectiveFromEnd != nil {
+		return *x.EffectiveFromEnd
+	}
+	return 0
+}
+
+func (x *CasbinRuleListReq) GetKeyword() string {
+	if x != nil && x.Keyword != nil {
+		return *x.Keyword
+	}
+	return ""
+}
+
+func (x *CasbinRuleListReq) GetOrderBy() string {
+	if x != nil && x.OrderBy != nil {
+		return *x.OrderBy
+	}
+	return ""
+}
+
+func (x *CasbinRuleListReq) GetOrderDirection() string {
+	if x != nil && x.OrderDirection != nil {
+		return *x.OrderDirection
+	}
+	return ""
+}
+
+//  Casbin规则列表响应
+type CasbinRuleListResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Total         uint64                 `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
+	Data          []*CasbinRuleInfo      `protobuf:"bytes,2,rep,name=data,proto3" json:"data"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CasbinRuleListResp) Reset() {
+	*x = CasbinRuleListResp{}
+	mi := &file_core_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CasbinRuleListResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CasbinRuleListResp) ProtoMessage() {}
+
+func (x *CasbinRuleListResp) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CasbinRuleListResp.ProtoReflect.Descriptor instead.
+func (*CasbinRuleListResp) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *CasbinRuleListResp) GetTotal() uint64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *CasbinRuleListResp) GetData() []*CasbinRuleInfo {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ConfigurationInfo struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        *uint64                `protobuf:"varint,1,opt,name=id,proto3,oneof" json:"id"`
+	CreatedAt *int64                 `protobuf:"varint,2,opt,name=created_at,json=createdAt,proto3,oneof" json:"created_at"`
+	UpdatedAt *int64                 `protobuf:"varint,3,opt,name=updated_at,json=updatedAt,proto3,oneof" json:"updated_at"`
+	//  Sort Number | 排序编号
+	Sort *uint32 `protobuf:"varint,4,opt,name=sort,proto3,oneof" json:"sort"`
+	//  State true: normal false: ban | 状态 true 正常 false 禁用
+	State *bool `protobuf:"varint,5,opt,name=state,proto3,oneof" json:"state"`
+	//  Configurarion name | 配置名称
+	Name *string `protobuf:"bytes,6,opt,name=name,proto3,oneof" json:"name"`
+	//  Configuration key | 配置的键名
+	Key *string `protobuf:"bytes,7,opt,name=key,proto3,oneof" json:"key"`
+	//  Configuraion value | 配置的值
+	Value *string `protobuf:"bytes,8,opt,name=value,proto3,oneof" json:"value"`
+	//  Configuration category | 配置的分类
+	Category *string `protobuf:"bytes,9,opt,name=category,proto3,oneof" json:"category"`
+	//  Remark | 备注
+	Remark *string `protobuf:"bytes,10,opt,name=remark,proto3,oneof" json:"remark"`
+	//  Tenant ID | 租户ID
+	TenantId      *uint64 `protobuf:"varint,11,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfigurationInfo) Reset() {
+	*x = ConfigurationInfo{}
+	mi := &file_core_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfigurationInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigurationInfo) ProtoMessage() {}
+
+func (x *ConfigurationInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigurationInfo.ProtoReflect.Descriptor instead.
+func (*ConfigurationInfo) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ConfigurationInfo) GetId() uint64 {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return 0
+}
+
+func (x *ConfigurationInfo) GetCreatedAt() int64 {
+	if x != nil && x.CreatedAt != nil {
+		return *x.CreatedAt
+	}
+	return 0
+}
+
+func (x *ConfigurationInfo) GetUpdatedAt() int64 {
+	if x != nil && x.UpdatedAt != nil {
+		return *x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *ConfigurationInfo) GetSort() uint32 {
+	if x != nil && x.Sort != nil {
+		return *x.Sort
+	}
+	return 0
+}
+
+func (x *ConfigurationInfo) GetState() bool {
+	if x != nil && x.State != nil {
+		return *x.State
+	}
+	return false
+}
+
+func (x *ConfigurationInfo) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *ConfigurationInfo) GetKey() string {
+	if x != nil && x.Key != nil {
+		return *x.Key
+	}
+	return ""
+}
+
+func (x *ConfigurationInfo) GetValue() string {
+	if x != nil && x.Value != nil {
+		return *x.Value
+	}
+	return ""
+}
+
+func (x *ConfigurationInfo) GetCategory() string {
+	if x != nil && x.Category != nil {
+		return *x.Category
+	}
+	return ""
+}
+
+func (x *ConfigurationInfo) GetRemark() string {
+	if x != nil && x.Remark != nil {
+		return *x.Remark
+	}
+	return ""
+}
+
+func (x *ConfigurationInfo) GetTenantId() uint64 {
+	if x != nil && x.TenantId != nil {
+		return *x.TenantId
+	}
+	return 0
+}
+
+type ConfigurationListReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          uint64                 `protobuf:"varint,1,opt,name=page,proto3" json:"page"`
+	PageSize      uint64                 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size"`
+	Name          *string                `protobuf:"bytes,3,opt,name=name,proto3,oneof" json:"name"`
+	Key           *string                `protobuf:"bytes,4,opt,name=key,proto3,oneof" json:"key"`
+	Category      *string                `protobuf:"bytes,5,opt,name=category,proto3,oneof" json:"category"`
+	State         *bool                  `protobuf:"varint,6,opt,name=state,proto3,oneof" json:"state"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfigurationListReq) Reset() {
+	*x = ConfigurationListReq{}
+	mi := &file_core_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfigurationListReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigurationListReq) ProtoMessage() {}
+
+func (x *ConfigurationListReq) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigurationListReq.ProtoReflect.Descriptor instead.
+func (*ConfigurationListReq) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ConfigurationListReq) GetPage() uint64 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ConfigurationListReq) GetPageSize() uint64 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ConfigurationListReq) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *ConfigurationListReq) GetKey() string {
+	if x != nil && x.Key != nil {
+		return *x.Key
+	}
+	return ""
+}
+
+func (x *ConfigurationListReq) GetCategory() string {
+	if x != nil && x.Category != nil {
+		return *x.Category
+	}
+	return ""
+}
+
+func (x *ConfigurationListReq) GetState() bool {
+	if x != nil && x.State != nil {
+		return *x.State
+	}
+	return false
+}
+
+type ConfigurationListResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Total         uint64                 `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
+	Data          []*ConfigurationInfo   `protobuf:"bytes,2,rep,name=data,proto3" json:"data"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfigurationListResp) Reset() {
+	*x = ConfigurationListResp{}
+	mi := &file_core_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfigurationListResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigurationListResp) ProtoMessage() {}
+
+func (x *ConfigurationListResp) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigurationListResp.ProtoReflect.Descriptor instead.
+func (*ConfigurationListResp) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ConfigurationListResp) GetTotal() uint64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ConfigurationListResp) GetData() []*ConfigurationInfo {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type CreateOauthSessionReq struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	State               string                 `protobuf:"bytes,1,opt,name=state,proto3" json:"state"`
+	ProviderId          uint64                 `protobuf:"varint,2,opt,name=provider_id,json=providerId,proto3" json:"provider_id"`
+	UserId              *string                `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3,oneof" json:"user_id"`
+	RedirectUri         string                 `protobuf:"bytes,4,opt,name=redirect_uri,json=redirectUri,proto3" json:"redirect_uri"`
+	Scope               *string                `protobuf:"bytes,5,opt,name=scope,proto3,oneof" json:"scope"`
+	CodeChallenge       *string                `protobuf:"bytes,6,opt,name=code_challenge,json=codeChallenge,proto3,oneof" json:"code_challenge"`
+	CodeChallengeMethod *string                `protobuf:"bytes,7,opt,name=code_challenge_method,json=codeChallengeMethod,proto3,oneof" json:"code_challenge_method"`
+	CodeVerifier        *string                `protobuf:"bytes,8,opt,name=code_verifier,json=codeVerifier,proto3,oneof" json:"code_verifier"`
+	ExpiresAt           int64                  `protobuf:"varint,9,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at"`
+	ClientIp            *string                `protobuf:"bytes,10,opt,name=client_ip,json=clientIp,proto3,oneof" json:"client_ip"`
+	UserAgent           *string                `protobuf:"bytes,11,opt,name=user_agent,json=userAgent,proto3,oneof" json:"user_agent"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *CreateOauthSessionReq) Reset() {
+	*x = CreateOauthSessionReq{}
+	mi := &file_core_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateOauthSessionReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateOauthSessionReq) ProtoMessage() {}
+
+func (x *CreateOauthSessionReq) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateOauthSessionReq.ProtoReflect.Descriptor instead.
+func (*CreateOauthSessionReq) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *CreateOauthSessionReq) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *CreateOauthSessionReq) GetProviderId() uint64 {
+	if x != nil {
+		return x.ProviderId
+	}
+	return 0
+}
+
+func (x *CreateOauthSessionReq) GetUserId() string {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return ""
+}
+
+func (x *CreateOauthSessionReq) GetRedirectUri() string {
+	if x != nil {
+		return x.RedirectUri
+	}
+	return ""
+}
+
+func (x *CreateOauthSessionReq) GetScope() string {
+	if x != nil && x.Scope != nil {
+		return *x.Scope
+	}
+	return ""
+}
+
+func (x *CreateOauthSessionReq) GetCodeChallenge() string {
+	if x != nil && x.CodeChallenge != nil {
+		return *x.CodeChallenge
+	}
+	return ""
+}
+
+func (x *CreateOauthSessionReq) GetCodeChallengeMethod() string {
+	if x != nil && x.CodeChallengeMethod != nil {
+		return *x.CodeChallengeMethod
+	}
+	return ""
+}
+
+func (x *CreateOauthSessionReq) GetCodeVerifier() string {
+	if x != nil && x.CodeVerifier != nil {
+		return *x.CodeVerifier
+	}
+	return ""
+}
+
+func (x *CreateOauthSessionReq) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *CreateOauthSessionReq) GetClientIp() string {
+	if x != nil && x.ClientIp != nil {
+		return *x.ClientIp
+	}
+	return ""
+}
+
+func (x *CreateOauthSessionReq) GetUserAgent() string {
+	if x != nil && x.UserAgent != nil {
+		return *x.UserAgent
+	}
+	return ""
+}
+
+type DepartmentInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            *uint64                `protobuf:"varint,1,opt,name=id,proto3,oneof" json:"id"`
+	CreatedAt     *int64                 `protobuf:"varint,2,opt,name=created_at,json=createdAt,proto3,oneof" json:"created_at"`
+	UpdatedAt     *int64                 `protobuf:"varint,3,opt,name=updated_at,json=updatedAt,proto3,oneof" json:"updated_at"`
+	Status        *uint32                `protobuf:"varint,4,opt,name=status,proto3,oneof" json:"status"`
+	Sort          *uint32                `protobuf:"varint,5,opt,name=sort,proto3,oneof" json:"sort"`
+	Name          *string                `protobuf:"bytes,6,opt,name=name,proto3,oneof" json:"name"`
+	Ancestors     *string                `protobuf:"bytes,7,opt,name=ancestors,proto3,oneof" json:"ancestors"`
+	Leader        *string                `protobuf:"bytes,8,opt,name=leader,proto3,oneof" json:"leader"`
+	Phone         *string                `protobuf:"bytes,9,opt,name=phone,proto3,oneof" json:"phone"`
+	Email         *string                `protobuf:"bytes,10,opt,name=email,proto3,oneof" json:"email"`
+	Remark        *string                `protobuf:"bytes,11,opt,name=remark,proto3,oneof" json:"remark"`
+	ParentId      *uint64                `protobuf:"varint,12,opt,name=parent_id,json=parentId,proto3,oneof" json:"parent_id"`
+	TenantId      *uint64                `protobuf:"varint,13,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DepartmentInfo) Reset() {
+	*x = DepartmentInfo{}
+	mi := &file_core_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DepartmentInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DepartmentInfo) ProtoMessage() {}
+
+func (x *DepartmentInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DepartmentInfo.ProtoReflect.Descriptor instead.
+func (*DepartmentInfo) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *DepartmentInfo) GetId() uint64 {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return 0
+}
+
+func (x *DepartmentInfo) GetCreatedAt() int64 {
+	if x != nil && x.CreatedAt != nil {
+		return *x.CreatedAt
+	}
+	return 0
+}
+
+func (x *DepartmentInfo) GetUpdatedAt() int64 {
+	if x != nil && x.UpdatedAt != nil {
+		return *x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *DepartmentInfo) GetStatus() uint32 {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return 0
+}
+
+func (x *DepartmentInfo) GetSort() uint32 {
+	if x != nil && x.Sort != nil {
+		return *x.Sort
+	}
+	return 0
+}
+
+func (x *DepartmentInfo) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *DepartmentInfo) GetAncestors() string {
+	if x != nil && x.Ancestors != nil {
+		return *x.Ancestors
+	}
+	return ""
+}
+
+func (x *DepartmentInfo) GetLeader() string {
+	if x != nil && x.Leader != nil {
+		return *x.Leader
+	}
+	return ""
+}
+
+func (x *DepartmentInfo) GetPhone() string {
+	if x != nil && x.Phone != nil {
+		return *x.Phone
+	}
+	return ""
+}
+
+func (x *DepartmentInfo) GetEmail() string {
+	if x != nil && x.Email != nil {
+		return *x.Email
+	}
+	return ""
+}
+
+func (x *DepartmentInfo) GetRemark() string {
+	if x != nil && x.Remark != nil {
+		return *x.Remark
+	}
+	return ""
+}
+
+func (x *DepartmentInfo) GetParentId() uint64 {
+	if x != nil && x.ParentId != nil {
+		return *x.ParentId
+	}
+	return 0
+}
+
+func (x *DepartmentInfo) GetTenantId() uint64 {
+	if x != nil && x.TenantId != nil {
+		return *x.TenantId
+	}
+	return 0
+}
+
+type DepartmentListReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          uint64                 `protobuf:"varint,1,opt,name=page,proto3" json:"page"`
+	PageSize      uint64                 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size"`
+	Name          *string                `protobuf:"bytes,3,opt,name=name,proto3,oneof" json:"name"`
+	Leader        *string                `protobuf:"bytes,4,opt,name=leader,proto3,oneof" json:"leader"`
+	Status        *uint32                `protobuf:"varint,5,opt,name=status,proto3,oneof" json:"status"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DepartmentListReq) Reset() {
+	*x = DepartmentListReq{}
+	mi := &file_core_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DepartmentListReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DepartmentListReq) ProtoMessage() {}
+
+func (x *DepartmentListReq) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DepartmentListReq.ProtoReflect.Descriptor instead.
+func (*DepartmentListReq) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *DepartmentListReq) GetPage() uint64 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *DepartmentListReq) GetPageSize() uint64 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *DepartmentListReq) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *DepartmentListReq) GetLeader() string {
+	if x != nil && x.Leader != nil {
+		return *x.Leader
+	}
+	return ""
+}
+
+func (x *DepartmentListReq) GetStatus() uint32 {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return 0
+}
+
+type DepartmentListResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Total         uint64                 `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
+	Data          []*DepartmentInfo      `protobuf:"bytes,2,rep,name=data,proto3" json:"data"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DepartmentListResp) Reset() {
+	*x = DepartmentListResp{}
+	mi := &file_core_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DepartmentListResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DepartmentListResp) ProtoMessage() {}
+
+func (x *DepartmentListResp) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DepartmentListResp.ProtoReflect.Descriptor instead.
+func (*DepartmentListResp) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *DepartmentListResp) GetTotal() uint64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *DepartmentListResp) GetData() []*DepartmentInfo {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type DictionaryDetailInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            *uint64                `protobuf:"varint,1,opt,name=id,proto3,oneof" json:"id"`
+	CreatedAt     *int64                 `protobuf:"varint,2,opt,name=created_at,json=createdAt,proto3,oneof" json:"created_at"`
+	UpdatedAt     *int64                 `protobuf:"varint,3,opt,name=updated_at,json=updatedAt,proto3,oneof" json:"updated_at"`
+	Status        *uint32                `protobuf:"varint,4,opt,name=status,proto3,oneof" json:"status"`
+	Title         *string                `protobuf:"bytes,5,opt,name=title,proto3,oneof" json:"title"`
+	Key           *string                `protobuf:"bytes,6,opt,name=key,proto3,oneof" json:"key"`
+	Value         *string                `protobuf:"bytes,7,opt,name=value,proto3,oneof" json:"value"`
+	DictionaryId  *uint64                `protobuf:"varint,8,opt,name=dictionary_id,json=dictionaryId,proto3,oneof" json:"dictionary_id"`
+	Sort          *uint32                `protobuf:"varint,9,opt,name=sort,proto3,oneof" json:"sort"`
+	IsDefault     *uint32                `protobuf:"varint,10,opt,name=is_default,json=isDefault,proto3,oneof" json:"is_default"`
+	CssClass      *string                `protobuf:"bytes,11,opt,name=css_class,json=cssClass,proto3,oneof" json:"css_class"`
+	ListClass     *string                `protobuf:"bytes,12,opt,name=list_class,json=listClass,proto3,oneof" json:"list_class"`
+	TenantId      *uint64                `protobuf:"varint,13,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DictionaryDetailInfo) Reset() {
+	*x = DictionaryDetailInfo{}
+	mi := &file_core_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DictionaryDetailInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DictionaryDetailInfo) ProtoMessage() {}
+
+func (x *DictionaryDetailInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DictionaryDetailInfo.ProtoReflect.Descriptor instead.
+func (*DictionaryDetailInfo) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *DictionaryDetailInfo) GetId() uint64 {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return 0
+}
+
+func (x *DictionaryDetailInfo) GetCreatedAt() int64 {
+	if x != nil && x.CreatedAt != nil {
+		return *x.CreatedAt
+	}
+	return 0
+}
+
+func (x *DictionaryDetailInfo) GetUpdatedAt() int64 {
+	if x != nil && x.UpdatedAt != nil {
+		return *x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *DictionaryDetailInfo) GetStatus() uint32 {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return 0
+}
+
+func (x *DictionaryDetailInfo) GetTitle() string {
+	if x != nil && x.Title != nil {
+		return *x.Title
+	}
+	return ""
+}
+
+func (x *DictionaryDetailInfo) GetKey() string {
+	if x != nil && x.Key != nil {
+		return *x.Key
+	}
+	return ""
+}
+
+func (x *DictionaryDetailInfo) GetValue() string {
+	if x != nil && x.Value != nil {
+		return *x.Value
+	}
+	return ""
+}
+
+func (x *DictionaryDetailInfo) GetDictionaryId() uint64 {
+	if x != nil && x.DictionaryId != nil {
+		return *x.DictionaryId
+	}
+	return 0
+}
+
+func (x *DictionaryDetailInfo) GetSort() uint32 {
+	if x != nil && x.Sort != nil {
+		return *x.Sort
+	}
+	return 0
+}
+
+func (x *DictionaryDetailInfo) GetIsDefault() uint32 {
+	if x != nil && x.IsDefault != nil {
+		return *x.IsDefault
+	}
+	return 0
+}
+
+func (x *DictionaryDetailInfo) GetCssClass() string {
+	if x != nil && x.CssClass != nil {
+		return *x.CssClass
+	}
+	return ""
+}
+
+func (x *DictionaryDetailInfo) GetListClass() string {
+	if x != nil && x.ListClass != nil {
+		return *x.ListClass
+	}
+	return ""
+}
+
+func (x *DictionaryDetailInfo) GetTenantId() uint64 {
+	if x != nil && x.TenantId != nil {
+		return *x.TenantId
+	}
+	return 0
+}
+
+type DictionaryDetailListReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          uint64                 `protobuf:"varint,1,opt,name=page,proto3" json:"page"`
+	PageSize      uint64                 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size"`
+	DictionaryId  *uint64                `protobuf:"varint,3,opt,name=dictionary_id,json=dictionaryId,proto3,oneof" json:"dictionary_id"`
+	Title         *string                `protobuf:"bytes,4,opt,name=Title,proto3,oneof" json:"Title"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DictionaryDetailListReq) Reset() {
+	*x = DictionaryDetailListReq{}
+	mi := &file_core_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DictionaryDetailListReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DictionaryDetailListReq) ProtoMessage() {}
+
+func (x *DictionaryDetailListReq) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DictionaryDetailListReq.ProtoReflect.Descriptor instead.
+func (*DictionaryDetailListReq) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *DictionaryDetailListReq) GetPage() uint64 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *DictionaryDetailListReq) GetPageSize() uint64 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *DictionaryDetailListReq) GetDictionaryId() uint64 {
+	if x != nil && x.DictionaryId != nil {
+		return *x.DictionaryId
+	}
+	return 0
+}
+
+func (x *DictionaryDetailListReq) GetTitle() string {
+	if x != nil && x.Title != nil {
+		return *x.Title
+	}
+	return ""
+}
+
+type DictionaryDetailListResp struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Total         uint64                  `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
+	Data          []*DictionaryDetailInfo `protobuf:"bytes,2,rep,name=data,proto3" json:"data"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DictionaryDetailListResp) Reset() {
+	*x = DictionaryDetailListResp{}
+	mi := &file_core_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DictionaryDetailListResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DictionaryDetailListResp) ProtoMessage() {}
+
+func (x *DictionaryDetailListResp) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DictionaryDetailListResp.ProtoReflect.Descriptor instead.
+func (*DictionaryDetailListResp) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *DictionaryDetailListResp) GetTotal() uint64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *DictionaryDetailListResp) GetData() []*DictionaryDetailInfo {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type DictionaryInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            *uint64                `protobuf:"varint,1,opt,name=id,proto3,oneof" json:"id"`
+	CreatedAt     *int64                 `protobuf:"varint,2,opt,name=created_at,json=createdAt,proto3,oneof" json:"created_at"`
+	UpdatedAt     *int64                 `protobuf:"varint,3,opt,name=updated_at,json=updatedAt,proto3,oneof" json:"updated_at"`
+	Status        *uint32                `protobuf:"varint,4,opt,name=status,proto3,oneof" json:"status"`
+	Title         *string                `protobuf:"bytes,5,opt,name=title,proto3,oneof" json:"title"`
+	Name          *string                `protobuf:"bytes,6,opt,name=name,proto3,oneof" json:"name"`
+	Desc          *string                `protobuf:"bytes,7,opt,name=desc,proto3,oneof" json:"desc"`
+	TenantId      *uint64                `protobuf:"varint,8,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DictionaryInfo) Reset() {
+	*x = DictionaryInfo{}
+	mi := &file_core_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DictionaryInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DictionaryInfo) ProtoMessage() {}
+
+func (x *DictionaryInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DictionaryInfo.ProtoReflect.Descriptor instead.
+func (*DictionaryInfo) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *DictionaryInfo) GetId() uint64 {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return 0
+}
+
+func (x *DictionaryInfo) GetCreatedAt() int64 {
+	if x != nil && x.CreatedAt != nil {
+		return *x.CreatedAt
+	}
+	return 0
+}
+
+func (x *DictionaryInfo) GetUpdatedAt() int64 {
+	if x != nil && x.UpdatedAt != nil {
+		return *x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *DictionaryInfo) GetStatus() uint32 {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return 0
+}
+
+func (x *DictionaryInfo) GetTitle() string {
+	if x != nil && x.Title != nil {
+		return *x.Title
+	}
+	return ""
+}
+
+func (x *DictionaryInfo) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *DictionaryInfo) GetDesc() string {
+	if x != nil && x.Desc != nil {
+		return *x.Desc
+	}
+	return ""
+}
+
+func (x *DictionaryInfo) GetTenantId() uint64 {
+	if x != nil && x.TenantId != nil {
+		return *x.TenantId
+	}
+	return 0
+}
+
+type DictionaryListReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          uint64                 `protobuf:"varint,1,opt,name=page,proto3" json:"page"`
+	PageSize      uint64                 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size"`
+	Name          *string                `protobuf:"bytes,3,opt,name=name,proto3,oneof" json:"name"`
+	Title         *string                `protobuf:"bytes,4,opt,name=title,proto3,oneof" json:"title"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DictionaryListReq) Reset() {
+	*x = DictionaryListReq{}
+	mi := &file_core_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DictionaryListReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DictionaryListReq) ProtoMessage() {}
+
+func (x *DictionaryListReq) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DictionaryListReq.ProtoReflect.Descriptor instead.
+func (*DictionaryListReq) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *DictionaryListReq) GetPage() uint64 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *DictionaryListReq) GetPageSize() uint64 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *DictionaryListReq) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *DictionaryListReq) GetTitle() string {
+	if x != nil && x.Title != nil {
+		return *x.Title
+	}
+	return ""
+}
+
+type DictionaryListResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Total         uint64                 `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
+	Data          []*DictionaryInfo      `protobuf:"bytes,2,rep,name=data,proto3" json:"data"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DictionaryListResp) Reset() {
+	*x = DictionaryListResp{}
+	mi := &file_core_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DictionaryListResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DictionaryListResp) ProtoMessage() {}
+
+func (x *DictionaryListResp) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DictionaryListResp.ProtoReflect.Descriptor instead.
+func (*DictionaryListResp) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *DictionaryListResp) GetTotal() uint64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *DictionaryListResp) GetData() []*DictionaryInfo {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type DurationStats struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RangeLabel    string                 `protobuf:"bytes,1,opt,name=range_label,json=rangeLabel,proto3" json:"range_label"`
+	Count         uint64                 `protobuf:"varint,2,opt,name=count,proto3" json:"count"`
+	Percentage    float64                `protobuf:"fixed64,3,opt,name=percentage,proto3" json:"percentage"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DurationStats) Reset() {
+	*x = DurationStats{}
+	mi := &file_core_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DurationStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DurationStats) ProtoMessage() {}
+
+func (x *DurationStats) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DurationStats.ProtoReflect.Descriptor instead.
+func (*DurationStats) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *DurationStats) GetRangeLabel() string {
+	if x != nil {
+		return x.RangeLabel
+	}
+	return ""
+}
+
+func (x *DurationStats) GetCount() uint64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *DurationStats) GetPercentage() float64 {
+	if x != nil {
+		return x.Percentage
+	}
+	return 0
+}
+
+//  base message
+type Empty struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Empty) Reset() {
+	*x = Empty{}
+	mi := &file_core_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Empty) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Empty) ProtoMessage() {}
+
+func (x *Empty) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Empty.ProtoReflect.Descriptor instead.
+func (*Empty) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{44}
+}
+
+//  Enable a template for the tenant of the context with the tenant's own credentials | 使用租户自己的凭证启用模板
+type EnableOauthProviderTemplateReq struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	TemplateId   uint64                 `protobuf:"varint,1,opt,name=template_id,json=templateId,proto3" json:"template_id"`
+	ClientId     string                 `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3" json:"client_id"`
+	ClientSecret string                 `protobuf:"bytes,3,opt,name=client_secret,json=clientSecret,proto3" json:"client_secret"`
+	RedirectUrl  string                 `protobuf:"bytes,4,opt,name=redirect_url,json=redirectUrl,proto3" json:"redirect_url"`
+	//  Defaults to the name of the template | 默认为模板名称
+	Name        *string `protobuf:"bytes,5,opt,name=name,proto3,oneof" json:"name"`
+	DisplayName *string `protobuf:"bytes,6,opt,name=display_name,json=displayName,proto3,oneof" json:"display_name"`
+	//  Defaults to the scopes of the template | 默认为模板的权限范围
+	Scopes        *string `protobuf:"bytes,7,opt,name=scopes,proto3,oneof" json:"scopes"`
+	Enabled       *bool   `protobuf:"varint,8,opt,name=enabled,proto3,oneof" json:"enabled"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EnableOauthProviderTemplateReq) Reset() {
+	*x = EnableOauthProviderTemplateReq{}
+	mi := &file_core_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnableOauthProviderTemplateReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnableOauthProviderTemplateReq) ProtoMessage() {}
+
+func (x *EnableOauthProviderTemplateReq) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnableOauthProviderTemplateReq.ProtoReflect.Descriptor instead.
+func (*EnableOauthProviderTemplateReq) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *EnableOauthProviderTemplateReq) GetTemplateId() uint64 {
+	if x != nil {
+		return x.TemplateId
+	}
+	return 0
+}
+
+func (x *EnableOauthProviderTemplateReq) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *EnableOauthProviderTemplateReq) GetClientSecret() string {
+	if x != nil {
+		return x.ClientSecret
+	}
+	return ""
+}
+
+func (x *EnableOauthProviderTemplateReq) GetRedirectUrl() string {
+	if x != nil {
+		return x.RedirectUrl
+	}
+	return ""
+}
+
+func (x *EnableOauthProviderTemplateReq) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *EnableOauthProviderTemplateReq) GetDisplayName() string {
+	if x != nil && x.DisplayName != nil {
+		return *x.DisplayName
+	}
+	return ""
+}
+
+func (x *EnableOauthProviderTemplateReq) GetScopes() string {
+	if x != nil && x.Scopes != nil {
+		return *x.Scopes
+	}
+	return ""
+}
+
+func (x *EnableOauthProviderTemplateReq) GetEnabled() bool {
+	if x != nil && x.Enabled != nil {
+		return *x.Enabled
+	}
+	return false
+}
+
+type GetOauthSessionByStateReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	State         string                 `protobuf:"bytes,1,opt,name=state,proto3" json:"state"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOauthSessionByStateReq) Reset() {
+	*x = GetOauthSessionByStateReq{}
+	mi := &file_core_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOauthSessionByStateReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOauthSessionByStateReq) ProtoMessage() {}
+
+func (x *GetOauthSessionByStateReq) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOauthSessionByStateReq.ProtoReflect.Descriptor instead.
+func (*GetOauthSessionByStateReq) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *GetOauthSessionByStateReq) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+type GetUserOauthAccountsReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id"`
+	Page          uint64                 `protobuf:"varint,2,opt,name=page,proto3" json:"page"`
+	PageSize      uint64                 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserOauthAccountsReq) Reset() {
+	*x = GetUserOauthAccountsReq{}
+	mi := &file_core_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserOauthAccountsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserOauthAccountsReq) ProtoMessage() {}
+
+func (x *GetUserOauthAccountsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserOauthAccountsReq.ProtoReflect.Descriptor instead.
+func (*GetUserOauthAccountsReq) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *GetUserOauthAccountsReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetUserOauthAccountsReq) GetPage() uint64 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetUserOauthAccountsReq) GetPageSize() uint64 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type GetUserOauthAccountsResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Total         uint64                 `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
+	Data          []*OauthAccountInfo    `protobuf:"bytes,2,rep,name=data,proto3" json:"data"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserOauthAccountsResp) Reset() {
+	*x = GetUserOauthAccountsResp{}
+	mi := &file_core_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserOauthAccountsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserOauthAccountsResp) ProtoMessage() {}
+
+func (x *GetUserOauthAccountsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserOauthAccountsResp.ProtoReflect.Descriptor instead.
+func (*GetUserOauthAccountsResp) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *GetUserOauthAccountsResp) GetTotal() uint64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GetUserOauthAccountsResp) GetData() []*OauthAccountInfo {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+//  获取用户权限摘要请求
+type GetUserPermissionSummaryReq struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	UserId           string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id"`
+	ServiceName      *string                `protobuf:"bytes,2,opt,name=service_name,json=serviceName,proto3,oneof" json:"service_name"`
+	IncludeInherited *bool                  `protobuf:"varint,3,opt,name=include_inherited,json=includeInherited,proto3,oneof" json:"include_inherited"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GetUserPermissionSummaryReq) Reset() {
+	*x = GetUserPermissionSummaryReq{}
+	mi := &file_core_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserPermissionSummaryReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserPermissionSummaryReq) ProtoMessage() {}
+
+func (x *GetUserPermissionSummaryReq) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserPermissionSummaryReq.ProtoReflect.Descriptor instead.
+func (*GetUserPermissionSummaryReq) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *GetUserPermissionSummaryReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetUserPermissionSummaryReq) GetServiceName() string {
+	if x != nil && x.ServiceName != nil {
+		return *x.ServiceName
+	}
+	return ""
+}
+
+func (x *GetUserPermissionSummaryReq) GetIncludeInherited() bool {
+	if x != nil && x.IncludeInherited != nil {
+		return *x.IncludeInherited
+	}
+	return false
+}
+
+//  获取用户权限摘要响应
+type GetUserPermissionSummaryResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id"`
+	Permissions   []*PermissionSummary   `protobuf:"bytes,2,rep,name=permissions,proto3" json:"permissions"`
+	TotalCount    int32                  `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserPermissionSummaryResp) Reset() {
+	*x = GetUserPermissionSummaryResp{}
+	mi := &file_core_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserPermissionSummaryResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserPermissionSummaryResp) ProtoMessage() {}
+
+func (x *GetUserPermissionSummaryResp) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserPermissionSummaryResp.ProtoReflect.Descriptor instead.
+func (*GetUserPermissionSummaryResp) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *GetUserPermissionSummaryResp) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetUserPermissionSummaryResp) GetPermissions() []*PermissionSummary {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+func (x *GetUserPermissionSummaryResp) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+type IDReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IDReq) Reset() {
+	*x = IDReq{}
+	mi := &file_core_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IDReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IDReq) ProtoMessage() {}
+
+func (x *IDReq) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IDReq.ProtoReflect.Descriptor instead.
+func (*IDReq) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *IDReq) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type IDsReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ids           []uint64               `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IDsReq) Reset() {
+	*x = IDsReq{}
+	mi := &file_core_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IDsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IDsReq) ProtoMessage() {}
+
+func (x *IDsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IDsReq.ProtoReflect.Descriptor instead.
+func (*IDsReq) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *IDsReq) GetIds() []uint64 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type ImpersonationReviewReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id"`
+	ReviewerId    string                 `protobuf:"bytes,2,opt,name=reviewer_id,json=reviewerId,proto3" json:"reviewer_id"`
+	Approve       bool                   `protobuf:"varint,3,opt,name=approve,proto3" json:"approve"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImpersonationReviewReq) Reset() {
+	*x = ImpersonationReviewReq{}
+	mi := &file_core_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImpersonationReviewReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImpersonationReviewReq) ProtoMessage() {}
+
+func (x *ImpersonationReviewReq) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImpersonationReviewReq.ProtoReflect.Descriptor instead.
+func (*ImpersonationReviewReq) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ImpersonationReviewReq) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ImpersonationReviewReq) GetReviewerId() string {
+	if x != nil {
+		return x.ReviewerId
+	}
+	return ""
+}
+
+func (x *ImpersonationReviewReq) GetApprove() bool {
+	if x != nil {
+		return x.Approve
+	}
+	return false
+}
+
+type ImpersonationSessionInfo struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id"`
+	CreatedAt      int64                  `protobuf:"varint,2,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
+	UserId         string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id"`
+	UserName       string                 `protobuf:"bytes,4,opt,name=user_name,json=userName,proto3" json:"user_name"`
+	OriginTenantId uint64                 `protobuf:"varint,5,opt,name=origin_tenant_id,json=originTenantId,proto3" json:"origin_tenant_id"`
+	TargetTenantId uint64                 `protobuf:"varint,6,opt,name=target_tenant_id,json=targetTenantId,proto3" json:"target_tenant_id"`
+	Reason         string                 `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason"`
+	Ticket         string                 `protobuf:"bytes,8,opt,name=ticket,proto3" json:"ticket"`
+	//  pending, active, ended, rejected, expired
+	State           string `protobuf:"bytes,9,opt,name=state,proto3" json:"state"`
+	DurationSeconds int64  `protobuf:"varint,10,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds"`
+	ApprovedBy      string `protobuf:"bytes,11,opt,name=approved_by,json=approvedBy,proto3" json:"approved_by"`
+	ApprovedAt      *int64 `protobuf:"varint,12,opt,name=approved_at,json=approvedAt,proto3,oneof" json:"approved_at"`
+	StartedAt       *int64 `protobuf:"varint,13,opt,name=started_at,json=startedAt,proto3,oneof" json:"started_at"`
+	ExpiresAt       *int64 `protobuf:"varint,14,opt,name=expires_at,json=expiresAt,proto3,oneof" json:"expires_at"`
+	EndedAt         *int64 `protobuf:"varint,15,opt,name=ended_at,json=endedAt,proto3,oneof" json:"ended_at"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ImpersonationSessionInfo) Reset() {
+	*x = ImpersonationSessionInfo{}
+	mi := &file_core_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImpersonationSessionInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImpersonationSessionInfo) ProtoMessage() {}
+
+func (x *ImpersonationSessionInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImpersonationSessionInfo.ProtoReflect.Descriptor instead.
+func (*ImpersonationSessionInfo) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ImpersonationSessionInfo) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ImpersonationSessionInfo) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *ImpersonationSessionInfo) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ImpersonationSessionInfo) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *ImpersonationSessionInfo) GetOriginTenantId() uint64 {
+	if x != nil {
+		return x.OriginTenantId
+	}
+	return 0
+}
+
+func (x *ImpersonationSessionInfo) GetTargetTenantId() uint64 {
+	if x != nil {
+		return x.TargetTenantId
+	}
+	return 0
+}
+
+func (x *ImpersonationSessionInfo) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ImpersonationSessionInfo) GetTicket() string {
+	if x != nil {
+		return x.Ticket
+	}
+	return ""
+}
+
+func (x *ImpersonationSessionInfo) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *ImpersonationSessionInfo) GetDurationSeconds() int64 {
+	if x != nil {
+		return x.DurationSeconds
+	}
+	return 0
+}
+
+func (x *ImpersonationSessionInfo) GetApprovedBy() string {
+	if x != nil {
+		return x.ApprovedBy
+	}
+	return ""
+}
+
+func (x *ImpersonationSessionInfo) GetApprovedAt() int64 {
+	if x != nil && x.ApprovedAt != nil {
+		return *x.ApprovedAt
+	}
+	return 0
+}
+
+func (x *ImpersonationSessionInfo) GetStartedAt() int64 {
+	if x != nil && x.StartedAt != nil {
+		return *x.StartedAt
+	}
+	return 0
+}
+
+func (x *ImpersonationSessionInfo) GetExpiresAt() int64 {
+	if x != nil && x.ExpiresAt != nil {
+		return *x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *ImpersonationSessionInfo) GetEndedAt() int64 {
+	if x != nil && x.EndedAt != nil {
+		return *x.EndedAt
+	}
+	return 0
+}
+
+type ImpersonationSessionListReq struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Page           uint64                 `protobuf:"varint,1,opt,name=page,proto3" json:"page"`
+	PageSize       uint64                 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size"`
+	TargetTenantId *uint64                `protobuf:"varint,3,opt,name=target_tenant_id,json=targetTenantId,proto3,oneof" json:"target_tenant_id"`
+	UserId         *string                `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3,oneof" json:"user_id"`
+	State          *string                `protobuf:"bytes,5,opt,name=state,proto3,oneof" json:"state"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ImpersonationSessionListReq) Reset() {
+	*x = ImpersonationSessionListReq{}
+	mi := &file_core_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImpersonationSessionListReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImpersonationSessionListReq) ProtoMessage() {}
+
+func (x *ImpersonationSessionListReq) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImpersonationSessionListReq.ProtoReflect.Descriptor instead.
+func (*ImpersonationSessionListReq) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ImpersonationSessionListReq) GetPage() uint64 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ImpersonationSessionListReq) GetPageSize() uint64 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ImpersonationSessionListReq) GetTargetTenantId() uint64 {
+	if x != nil && x.TargetTenantId != nil {
+		return *x.TargetTenantId
+	}
+	return 0
+}
+
+func (x *ImpersonationSessionListReq) GetUserId() string {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return ""
+}
+
+func (x *ImpersonationSessionListReq) GetState() string {
+	if x != nil && x.State != nil {
+		return *x.State
+	}
+	return ""
+}
+
+type ImpersonationSessionListResp struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	Total         uint64                      `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
+	Data          []*ImpersonationSessionInfo `protobuf:"bytes,2,rep,name=data,proto3" json:"data"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImpersonationSessionListResp) Reset() {
+	*x = ImpersonationSessionListResp{}
+	mi := &file_core_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImpersonationSessionListResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImpersonationSessionListResp) ProtoMessage() {}
+
+func (x *ImpersonationSessionListResp) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImpersonationSessionListResp.ProtoReflect.Descriptor instead.
+func (*ImpersonationSessionListResp) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ImpersonationSessionListResp) GetTotal() uint64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ImpersonationSessionListResp) GetData() []*ImpersonationSessionInfo {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ImpersonationStartReq struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UserId         string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id"`
+	UserName       *string                `protobuf:"bytes,2,opt,name=user_name,json=userName,proto3,oneof" json:"user_name"`
+	OriginTenantId uint64                 `protobuf:"varint,3,opt,name=origin_tenant_id,json=originTenantId,proto3" json:"origin_tenant_id"`
+	TargetTenantId uint64                 `protobuf:"varint,4,opt,name=target_tenant_id,json=targetTenantId,proto3" json:"target_tenant_id"`
+	//  Reason of the access, required | 访问原因，必填
+	Reason string  `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason"`
+	Ticket *string `protobuf:"bytes,6,opt,name=ticket,proto3,oneof" json:"ticket"`
+	//  Use the default duration when empty | 为空时使用默认时长
+	DurationMinutes *uint32 `protobuf:"varint,7,opt,name=duration_minutes,json=durationMinutes,proto3,oneof" json:"duration_minutes"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ImpersonationStartReq) Reset() {
+	*x = ImpersonationStartReq{}
+	mi := &file_core_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImpersonationStartReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImpersonationStartReq) ProtoMessage() {}
+
+func (x *ImpersonationStartReq) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImpersonationStartReq.ProtoReflect.Descriptor instead.
+func (*ImpersonationStartReq) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ImpersonationStartReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ImpersonationStartReq) GetUserName() string {
+	if x != nil && x.UserName != nil {
+		return *x.UserName
+	}
+	return ""
+}
+
+func (x *ImpersonationStartReq) GetOriginTenantId() uint64 {
+	if x != nil {
+		return x.OriginTenantId
+	}
+	return 0
+}
+
+func (x *ImpersonationStartReq) GetTargetTenantId() uint64 {
+	if x != nil {
+		return x.TargetTenantId
+	}
+	return 0
+}
+
+func (x *ImpersonationStartReq) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ImpersonationStartReq) GetTicket() string {
+	if x != nil && x.Ticket != nil {
+		return *x.Ticket
+	}
+	return ""
+}
+
+func (x *ImpersonationStartReq) GetDurationMinutes() uint32 {
+	if x != nil && x.DurationMinutes != nil {
+		return *x.DurationMinutes
+	}
+	return 0
+}
+
+type LdapLoginReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username"`
+	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password"`
+	Ip            *string                `protobuf:"bytes,3,opt,name=ip,proto3,oneof" json:"ip"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LdapLoginReq) Reset() {
+	*x = LdapLoginReq{}
+	mi := &file_core_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LdapLoginReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LdapLoginReq) ProtoMessage() {}
+
+func (x *LdapLoginReq) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LdapLoginReq.ProtoReflect.Descriptor instead.
+func (*LdapLoginReq) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *LdapLoginReq) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *LdapLoginReq) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *LdapLoginReq) GetIp() string {
+	if x != nil && x.Ip != nil {
+		return *x.Ip
+	}
+	return ""
+}
+
+type LdapProviderInfo struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 *uint64                `protobuf:"varint,1,opt,name=id,proto3,oneof" json:"id"`
+	CreatedAt          *int64                 `protobuf:"varint,2,opt,name=created_at,json=createdAt,proto3,oneof" json:"created_at"`
+	UpdatedAt          *int64                 `protobuf:"varint,3,opt,name=updated_at,json=updatedAt,proto3,oneof" json:"updated_at"`
+	Status             *uint32                `protobuf:"varint,4,opt,name=status,proto3,oneof" json:"status"`
+	TenantId           *uint64                `protobuf:"varint,5,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id"`
+	Name               *string                `protobuf:"bytes,6,opt,name=name,proto3,oneof" json:"name"`
+	DisplayName        *string                `protobuf:"bytes,7,opt,name=display_name,json=displayName,proto3,oneof" json:"display_name"`
+	DirectoryType      *string                `protobuf:"bytes,8,opt,name=directory_type,json=directoryType,proto3,oneof" json:"directory_type"`
+	Url                *string                `protobuf:"bytes,9,opt,name=url,proto3,oneof" json:"url"`
+	StartTls           *bool                  `protobuf:"varint,10,opt,name=start_tls,json=startTls,proto3,oneof" json:"start_tls"`
+	InsecureSkipVerify *bool                  `protobuf:"varint,11,opt,name=insecure_skip_verify,json=insecureSkipVerify,proto3,oneof" json:"insecure_skip_verify"`
+	BindDn             *string                `protobuf:"bytes,12,opt,name=bind_dn,json=bindDn,proto3,oneof" json:"bind_dn"`
+	//  Write only, empty when updating keeps the stored password
+	BindPassword         *string  `protobuf:"bytes,13,opt,name=bind_password,json=bindPassword,proto3,oneof" json:"bind_password"`
+	BaseDn               *string  `protobuf:"bytes,14,opt,name=base_dn,json=baseDn,proto3,oneof" json:"base_dn"`
+	AuthMode             *string  `protobuf:"bytes,15,opt,name=auth_mode,json=authMode,proto3,oneof" json:"auth_mode"`
+	UserDnTemplate       *string  `protobuf:"bytes,16,opt,name=user_dn_template,json=userDnTemplate,proto3,oneof" json:"user_dn_template"`
+	UserFilter           *string  `protobuf:"bytes,17,opt,name=user_filter,json=userFilter,proto3,oneof" json:"user_filter"`
+	OuBaseDn             *string  `protobuf:"bytes,18,opt,name=ou_base_dn,json=ouBaseDn,proto3,oneof" json:"ou_base_dn"`
+	OuFilter             *string  `protobuf:"bytes,19,opt,name=ou_filter,json=ouFilter,proto3,oneof" json:"ou_filter"`
+	GroupBaseDn          *string  `protobuf:"bytes,20,opt,name=group_base_dn,json=groupBaseDn,proto3,oneof" json:"group_base_dn"`
+	GroupFilter          *string  `protobuf:"bytes,21,opt,name=group_filter,json=groupFilter,proto3,oneof" json:"group_filter"`
+	GroupMemberAttribute *string  `protobuf:"bytes,22,opt,name=group_member_attribute,json=groupMemberAttribute,proto3,oneof" json:"group_member_attribute"`
+	AttributeMapping     *string  `protobuf:"bytes,23,opt,name=attribute_mapping,json=attributeMapping,proto3,oneof" json:"attribute_mapping"`
+	LoginEnabled         *bool    `protobuf:"varint,24,opt,name=login_enabled,json=loginEnabled,proto3,oneof" json:"login_enabled"`
+	JitProvision         *bool    `protobuf:"varint,25,opt,name=jit_provision,json=jitProvision,proto3,oneof" json:"jit_provision"`
+	LinkExisting         *bool    `protobuf:"varint,26,opt,name=link_existing,json=linkExisting,proto3,oneof" json:"link_existing"`
+	SyncEnabled          *bool    `protobuf:"varint,27,opt,name=sync_enabled,json=syncEnabled,proto3,oneof" json:"sync_enabled"`
+	SyncInterval         *uint32  `protobuf:"varint,28,opt,name=sync_interval,json=syncInterval,proto3,oneof" json:"sync_interval"`
+	DeletionPolicy       *string  `protobuf:"bytes,29,opt,name=deletion_policy,json=deletionPolicy,proto3,oneof" json:"deletion_policy"`
+	RootDepartmentId     *uint64  `protobuf:"varint,30,opt,name=root_department_id,json=rootDepartmentId,proto3,oneof" json:"root_department_id"`
+	DefaultDepartmentId  *uint64  `protobuf:"varint,31,opt,name=default_department_id,json=defaultDepartmentId,proto3,oneof" json:"default_department_id"`
+	DefaultRoleIds       []uint64 `protobuf:"varint,32,rep,packed,name=default_role_ids,json=defaultRoleIds,proto3" json:"default_role_ids"`
+	LastSyncAt           *int64   `protobuf:"varint,33,opt,name=last_sync_at,json=lastSyncAt,proto3,oneof" json:"last_sync_at"`
+	Enabled              *bool    `protobuf:"varint,34,opt,name=enabled,proto3,oneof" json:"enabled"`
+	Sort                 *uint32  `protobuf:"varint,35,opt,name=sort,proto3,oneof" json:"sort"`
+	Remark               *string  `protobuf:"bytes,36,opt,name=remark,proto3,oneof" json:"remark"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *LdapProviderInfo) Reset() {
+	*x = LdapProviderInfo{}
+	mi := &file_core_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LdapProviderInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LdapProviderInfo) ProtoMessage() {}
+
+func (x *LdapProviderInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LdapProviderInfo.ProtoReflect.Descriptor instead.
+func (*LdapProviderInfo) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *LdapProviderInfo) GetId() uint64 {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return 0
+}
+
+func (x *LdapProviderInfo) GetCreatedAt() int64 {
+	if x != nil && x.CreatedAt != nil {
+		return *x.CreatedAt
+	}
+	return 0
+}
+
+func (x *LdapProviderInfo) GetUpdatedAt() int64 {
+	if x != nil && x.UpdatedAt != nil {
+		return *x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *LdapProviderInfo) GetStatus() uint32 {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return 0
+}
+
+func (x *LdapProviderInfo) GetTenantId() uint64 {
+	if x != nil && x.TenantId != nil {
+		return *x.TenantId
+	}
+	return 0
+}
+
+func (x *LdapProviderInfo) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *LdapProviderInfo) GetDisplayName() string {
+	if x != nil && x.DisplayName != nil {
+		return *x.DisplayName
+	}
+	return ""
+}
+
+func (x *LdapProviderInfo) GetDirectoryType() string {
+	if x != nil && x.DirectoryType != nil {
+		return *x.DirectoryType
+	}
+	return ""
+}
+
+func (x *LdapProviderInfo) GetUrl() string {
+	if x != nil && x.Url != nil {
+		return *x.Url
+	}
+	return ""
+}
+
+func (x *LdapProviderInfo) GetStartTls() bool {
+	if x != nil && x.StartTls != nil {
+		return *x.StartTls
+	}
+	return false
+}
+
+func (x *LdapProviderInfo) GetInsecureSkipVerify() bool {
+	if x != nil && x.InsecureSkipVerify != nil {
+		return *x.InsecureSkipVerify
+	}
+	return false
+}
+
+func (x *LdapProviderInfo) GetBindDn() string {
+	if x != nil && x.BindDn != nil {
+		return *x.BindDn
+	}
+	return ""
+}
+
+func (x *LdapProviderInfo) GetBindPassword() string {
+	if x != nil && x.BindPassword != nil {
+		return *x.BindPassword
+	}
+	return ""
+}
+
+func (x *LdapProviderInfo) GetBaseDn() string {
+	if x != nil && x.BaseDn != nil {
+		return *x.BaseDn
+	}
+	return ""
+}
+
+func (x *LdapProviderInfo) GetAuthMode() string {
+	if x != nil && x.AuthMode != nil {
+		return *x.AuthMode
+	}
+	return ""
+}
+
+func (x *LdapProviderInfo) GetUserDnTemplate() string {
+	if x != nil && x.UserDnTemplate != nil {
+		return *x.UserDnTemplate
+	}
+	return ""
+}
+
+func (x *LdapProviderInfo) GetUserFilter() string {
+	if x != nil && x.UserFilter != nil {
+		return *x.UserFilter
+	}
+	return ""
+}
+
+func (x *LdapProviderInfo) GetOuBaseDn() string {
+	if x != nil && x.OuBaseDn != nil {
+		return *x.OuBaseDn
+	}
+	return ""
+}
+
+func (x *LdapProviderInfo) GetOuFilter() string {
+	if x != nil && x.OuFilter != nil {
+		return *x.OuFilter
+	}
+	return ""
+}
+
+func (x *LdapProviderInfo) GetGroupBaseDn() string {
+	if x != nil && x.GroupBaseDn != nil {
+		return *x.GroupBaseDn
+	}
+	return ""
+}
+
+func (x *LdapProviderInfo) GetGroupFilter() string {
+	if x != nil && x.GroupFilter != nil {
+		return *x.GroupFilter
+	}
+	return ""
+}
+
+func (x *LdapProviderInfo) GetGroupMemberAttribute() string {
+	if x != nil && x.GroupMemberAttribute != nil {
+		return *x.GroupMemberAttribute
+	}
+	return ""
+}
+
+func (x *LdapProviderInfo) GetAttributeMapping() string {
+	if x != nil && x.AttributeMapping != nil {
+		return *x.AttributeMapping
+	}
+	return ""
+}
+
+func (x *LdapProviderInfo) GetLoginEnabled() bool {
+	if x != nil && x.LoginEnabled != nil {
+		return *x.LoginEnabled
+	}
+	return false
+}
+
+func (x *LdapProviderInfo) GetJitProvision() bool {
+	if x != nil && x.JitProvision != nil {
+		return *x.JitProvision
+	}
+	return false
+}
+
+func (x *LdapProviderInfo) GetLinkExisting() bool {
+	if x != nil && x.LinkExisting != nil {
+		return *x.LinkExisting
+	}
+	return false
+}
+
+func (x *LdapProviderInfo) GetSyncEnabled() bool {
+	if x != nil && x.SyncEnabled != nil {
+		return *x.SyncEnabled
+	}
+	return false
+}
+
+func (x *LdapProviderInfo) GetSyncInterval() uint32 {
+	if x != nil && x.SyncInterval != nil {
+		return *x.SyncInterval
+	}
+	return 0
+}
+
+func (x *LdapProviderInfo) GetDeletionPolicy() string {
+	if x != nil && x.DeletionPolicy != nil {
+		return *x.DeletionPolicy
+	}
+	return ""
+}
+
+func (x *LdapProviderInfo) GetRootDepartmentId() uint64 {
+	if x != nil && x.RootDepartmentId != nil {
+		return *x.RootDepartmentId
+	}
+	return 0
+}
+
+func (x *LdapProviderInfo) GetDefaultDepartmentId() uint64 {
+	if x != nil && x.DefaultDepartmentId != nil {
+		return *x.DefaultDepartmentId
+	}
+	return 0
+}
+
+func (x *LdapProviderInfo) GetDefaultRoleIds() []uint64 {
+	if x != nil {
+		return x.DefaultRoleIds
+	}
+	return nil
+}
+
+func (x *LdapProviderInfo) GetLastSyncAt() int64 {
+	if x != nil && x.LastSyncAt != nil {
+		return *x.LastSyncAt
+	}
+	return 0
+}
+
+func (x *LdapProviderInfo) GetEnabled() bool {
+	if x != nil && x.Enabled != nil {
+		return *x.Enabled
+	}
+	return false
+}
+
+func (x *LdapProviderInfo) GetSort() uint32 {
+	if x != nil && x.Sort != nil {
+		return *x.Sort
+	}
+	return 0
+}
+
+func (x *LdapProviderInfo) GetRemark() string {
+	if x != nil && x.Remark != nil {
+		return *x.Remark
+	}
+	return ""
+}
+
+type LdapProviderListReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          uint64                 `protobuf:"varint,1,opt,name=page,proto3" json:"page"`
+	PageSize      uint64                 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size"`
+	Name          *string                `protobuf:"bytes,3,opt,name=name,proto3,oneof" json:"name"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LdapProviderListReq) Reset() {
+	*x = LdapProviderListReq{}
+	mi := &file_core_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LdapProviderListReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LdapProviderListReq) ProtoMessage() {}
+
+func (x *LdapProviderListReq) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LdapProviderListReq.ProtoReflect.Descriptor instead.
+func (*LdapProviderListReq) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *LdapProviderListReq) GetPage() uint64 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *LdapProviderListReq) GetPageSize() uint64 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *LdapProviderListReq) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+type LdapProviderListResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Total         uint64                 `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
+	Data          []*LdapProviderInfo    `protobuf:"bytes,2,rep,name=data,proto3" json:"data"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LdapProviderListResp) Reset() {
+	*x = LdapProviderListResp{}
+	mi := &file_core_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LdapProviderListResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LdapProviderListResp) ProtoMessage() {}
+
+func (x *LdapProviderListResp) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LdapProviderListResp.ProtoReflect.Descriptor instead.
+func (*LdapProviderListResp) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *LdapProviderListResp) GetTotal() uint64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *LdapProviderListResp) GetData() []*LdapProviderInfo {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type LdapSyncChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          string                 `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind"`
+	Action        string                 `protobuf:"bytes,2,opt,name=action,proto3" json:"action"`
+	Dn            string                 `protobuf:"bytes,3,opt,name=dn,proto3" json:"dn"`
+	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name"`
+	Detail        string                 `protobuf:"bytes,5,opt,name=detail,proto3" json:"detail"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LdapSyncChange) Reset() {
+	*x = LdapSyncChange{}
+	mi := &file_core_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LdapSyncChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LdapSyncChange) ProtoMessage() {}
+
+func (x *LdapSyncChange) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LdapSyncChange.ProtoReflect.Descriptor instead.
+func (*LdapSyncChange) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *LdapSyncChange) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *LdapSyncChange) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *LdapSyncChange) GetDn() string {
+	if x != nil {
+		return x.Dn
+	}
+	return ""
+}
+
+func (x *LdapSyncChange) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *LdapSyncChange) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
+type LdapSyncConflict struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          string                 `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind"`
+	Dn            string                 `protobuf:"bytes,2,opt,name=dn,proto3" json:"dn"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name"`
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason"`
+	Detail        string                 `protobuf:"bytes,5,opt,name=detail,proto3" json:"detail"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LdapSyncConflict) Reset() {
+	*x = LdapSyncConflict{}
+	mi := &file_core_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LdapSyncConflict) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LdapSyncConflict) ProtoMessage() {}
+
+func (x *LdapSyncConflict) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LdapSyncConflict.ProtoReflect.Descriptor instead.
+func (*LdapSyncConflict) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *LdapSyncConflict) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *LdapSyncConflict) GetDn() string {
+	if x != nil {
+		return x.Dn
+	}
+	return ""
+}
+
+func (x *LdapSyncConflict) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *LdapSyncConflict) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *LdapSyncConflict) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
+type LdapSyncCounter struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Created       uint32                 `protobuf:"varint,1,opt,name=created,proto3" json:"created"`
+	Updated       uint32                 `protobuf:"varint,2,opt,name=updated,proto3" json:"updated"`
+	Disabled      uint32                 `protobuf:"varint,3,opt,name=disabled,proto3" json:"disabled"`
+	Deleted       uint32                 `protobuf:"varint,4,opt,name=deleted,proto3" json:"deleted"`
+	Unchanged     uint32                 `protobuf:"varint,5,opt,name=unchanged,proto3" json:"unchanged"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LdapSyncCounter) Reset() {
+	*x = LdapSyncCounter{}
+	mi := &file_core_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LdapSyncCounter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LdapSyncCounter) ProtoMessage() {}
+
+func (x *LdapSyncCounter) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LdapSyncCounter.ProtoReflect.Descriptor instead.
+func (*LdapSyncCounter) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *LdapSyncCounter) GetCreated() uint32 {
+	if x != nil {
+		return x.Created
+	}
+	return 0
+}
+
+func (x *LdapSyncCounter) GetUpdated() uint32 {
+	if x != nil {
+		return x.Updated
+	}
+	return 0
+}
+
+func (x *LdapSyncCounter) GetDisabled() uint32 {
+	if x != nil {
+		return x.Disabled
+	}
+	return 0
+}
+
+func (x *LdapSyncCounter) GetDeleted() uint32 {
+	if x != nil {
+		return x.Deleted
+	}
+	return 0
+}
+
+func (x *LdapSyncCounter) GetUnchanged() uint32 {
+	if x != nil {
+		return x.Unchanged
+	}
+	return 0
+}
+
+type LdapSyncReq struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id"`
+	//  Plan the changes without applying them
+	DryRun        bool `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LdapSyncReq) Reset() {
+	*x = LdapSyncReq{}
+	mi := &file_core_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LdapSyncReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LdapSyncReq) ProtoMessage() {}
+
+func (x *LdapSyncReq) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LdapSyncReq.ProtoReflect.Descriptor instead.
+func (*LdapSyncReq) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *LdapSyncReq) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *LdapSyncReq) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type LdapSyncRunInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            *uint64                `protobuf:"varint,1,opt,name=id,proto3,oneof" json:"id"`
+	CreatedAt     *int64                 `protobuf:"varint,2,opt,name=created_at,json=createdAt,proto3,oneof" json:"created_at"`
+	ProviderId    *uint64                `protobuf:"varint,3,opt,name=provider_id,json=providerId,proto3,oneof" json:"provider_id"`
+	Trigger       *string                `protobuf:"bytes,4,opt,name=trigger,proto3,oneof" json:"trigger"`
+	DryRun        *bool                  `protobuf:"varint,5,opt,name=dry_run,json=dryRun,proto3,oneof" json:"dry_run"`
+	Result        *string                `protobuf:"bytes,6,opt,name=result,proto3,oneof" json:"result"`
+	Error         *string                `protobuf:"bytes,7,opt,name=error,proto3,oneof" json:"error"`
+	StartedAt     *int64                 `protobuf:"varint,8,opt,name=started_at,json=startedAt,proto3,oneof" json:"started_at"`
+	FinishedAt    *int64                 `protobuf:"varint,9,opt,name=finished_at,json=finishedAt,proto3,oneof" json:"finished_at"`
+	Stats         *LdapSyncStats         `protobuf:"bytes,10,opt,name=stats,proto3" json:"stats"`
+	Changes       []*LdapSyncChange      `protobuf:"bytes,11,rep,name=changes,proto3" json:"changes"`
+	Conflicts     []*LdapSyncConflict    `protobuf:"bytes,12,rep,name=conflicts,proto3" json:"conflicts"`
+	Truncated     *bool                  `protobuf:"varint,13,opt,name=truncated,proto3,oneof" json:"truncated"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LdapSyncRunInfo) Reset() {
+	*x = LdapSyncRunInfo{}
+	mi := &file_core_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LdapSyncRunInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LdapSyncRunInfo) ProtoMessage() {}
+
+func (x *LdapSyncRunInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LdapSyncRunInfo.ProtoReflect.Descriptor instead.
+func (*LdapSyncRunInfo) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *LdapSyncRunInfo) GetId() uint64 {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return 0
+}
+
+func (x *LdapSyncRunInfo) GetCreatedAt() int64 {
+	if x != nil && x.CreatedAt != nil {
+		return *x.CreatedAt
+	}
+	return 0
+}
+
+func (x *LdapSyncRunInfo) GetProviderId() uint64 {
+	if x != nil && x.ProviderId != nil {
+		return *x.ProviderId
+	}
+	return 0
+}
+
+func (x *LdapSyncRunInfo) GetTrigger() string {
+	if x != nil && x.Trigger != nil {
+		return *x.Trigger
+	}
+	return ""
+}
+
+func (x *LdapSyncRunInfo) GetDryRun() bool {
+	if x != nil && x.DryRun != nil {
+		return *x.DryRun
+	}
+	return false
+}
+
+func (x *LdapSyncRunInfo) GetResult() string {
+	if x != nil && x.Result != nil {
+		return *x.Result
+	}
+	return ""
+}
+
+func (x *LdapSyncRunInfo) GetError() string {
+	if x != nil && x.Error != nil {
+		return *x.Error
+	}
+	return ""
+}
+
+func (x *LdapSyncRunInfo) GetStartedAt() int64 {
+	if x != nil && x.StartedAt != nil {
+		return *x.StartedAt
+	}
+	return 0
+}
+
+func (x *LdapSyncRunInfo) GetFinishedAt() int64 {
+	if x != nil && x.FinishedAt != nil {
+		return *x.FinishedAt
+	}
+	return 0
+}
+
+func (x *LdapSyncRunInfo) GetStats() *LdapSyncStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+func (x *LdapSyncRunInfo) GetChanges() []*LdapSyncChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *LdapSyncRunInfo) GetConflicts() []*LdapSyncConflict {
+	if x != nil {
+		return x.Conflicts
+	}
+	return nil
+}
+
+func (x *LdapSyncRunInfo) GetTruncated() bool {
+	if x != nil && x.Truncated != nil {
+		return *x.Truncated
+	}
+	return false
+}
+
+type LdapSyncRunListReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          uint64                 `protobuf:"varint,1,opt,name=page,proto3" json:"page"`
+	PageSize      uint64                 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size"`
+	ProviderId    uint64                 `protobuf:"varint,3,opt,name=provider_id,json=providerId,proto3" json:"provider_id"`
+	Result        *string                `protobuf:"bytes,4,opt,name=result,proto3,oneof" json:"result"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LdapSyncRunListReq) Reset() {
+	*x = LdapSyncRunListReq{}
+	mi := &file_core_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LdapSyncRunListReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LdapSyncRunListReq) ProtoMessage() {}
+
+func (x *LdapSyncRunListReq) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LdapSyncRunListReq.ProtoReflect.Descriptor instead.
+func (*LdapSyncRunListReq) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *LdapSyncRunListReq) GetPage() uint64 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *LdapSyncRunListReq) GetPageSize() uint64 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *LdapSyncRunListReq) GetProviderId() uint64 {
+	if x != nil {
+		return x.ProviderId
+	}
+	return 0
+}
+
+func (x *LdapSyncRunListReq) GetResult() string {
+	if x != nil && x.Result != nil {
+		return *x.Result
+	}
+	return ""
+}
+
+type LdapSyncRunListResp struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Total uint64                 `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
+	//  Changes and conflicts are omitted, get the run by ID for them
+	Data          []*LdapSyncRunInfo `protobuf:"bytes,2,rep,name=data,proto3" json:"data"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LdapSyncRunListResp) Reset() {
+	*x = LdapSyncRunListResp{}
+	mi := &file_core_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LdapSyncRunListResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LdapSyncRunListResp) ProtoMessage() {}
+
+func (x *LdapSyncRunListResp) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LdapSyncRunListResp.ProtoReflect.Descriptor instead.
+func (*LdapSyncRunListResp) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *LdapSyncRunListResp) GetTotal() uint64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *LdapSyncRunListResp) GetData() []*LdapSyncRunInfo {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type LdapSyncStats struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Departments   *LdapSyncCounter       `protobuf:"bytes,1,opt,name=departments,proto3" json:"departments"`
+	Users         *LdapSyncCounter       `protobuf:"bytes,2,opt,name=users,proto3" json:"users"`
+	Granted       uint32                 `protobuf:"varint,3,opt,name=granted,proto3" json:"granted"`
+	Revoked       uint32                 `protobuf:"varint,4,opt,name=revoked,proto3" json:"revoked"`
+	Conflicts     uint32                 `protobuf:"varint,5,opt,name=conflicts,proto3" json:"conflicts"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LdapSyncStats) Reset() {
+	*x = LdapSyncStats{}
+	mi := &file_core_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LdapSyncStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LdapSyncStats) ProtoMessage() {}
+
+func (x *LdapSyncStats) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LdapSyncStats.ProtoReflect.Descriptor instead.
+func (*LdapSyncStats) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *LdapSyncStats) GetDepartments() *LdapSyncCounter {
+	if x != nil {
+		return x.Departments
+	}
+	return nil
+}
+
+func (x *LdapSyncStats) GetUsers() *LdapSyncCounter {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *LdapSyncStats) GetGranted() uint32 {
+	if x != nil {
+		return x.Granted
+	}
+	return 0
+}
+
+func (x *LdapSyncStats) GetRevoked() uint32 {
+	if x != nil {
+		return x.Revoked
+	}
+	return 0
+}
+
+func (x *LdapSyncStats) GetConflicts() uint32 {
+	if x != nil {
+		return x.Conflicts
+	}
+	return 0
+}
+
+type MenuInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            *uint64                `protobuf:"varint,1,opt,name=id,proto3,oneof" json:"id"`
+	CreatedAt     *int64                 `protobuf:"varint,2,opt,name=created_at,json=createdAt,proto3,oneof" json:"created_at"`
+	UpdatedAt     *int64                 `protobuf:"varint,3,opt,name=updated_at,json=updatedAt,proto3,oneof" json:"updated_at"`
+	Level         *uint32                `protobuf:"varint,4,opt,name=level,proto3,oneof" json:"level"`
+	ParentId      *uint64                `protobuf:"varint,5,opt,name=parent_id,json=parentId,proto3,oneof" json:"parent_id"`
+	Path          *string                `protobuf:"bytes,6,opt,name=path,proto3,oneof" json:"path"`
+	Name          *string                `protobuf:"bytes,7,opt,name=name,proto3,oneof" json:"name"`
+	Redirect      *string                `protobuf:"bytes,8,opt,name=redirect,proto3,oneof" json:"redirect"`
+	Component     *string                `protobuf:"bytes,9,opt,name=component,proto3,oneof" json:"component"`
+	Sort          *uint32                `protobuf:"varint,10,opt,name=sort,proto3,oneof" json:"sort"`
+	Disabled      *bool                  `protobuf:"varint,11,opt,name=disabled,proto3,oneof" json:"disabled"`
+	Meta          *Meta                  `protobuf:"bytes,12,opt,name=meta,proto3,oneof" json:"meta"`
+	MenuType      *uint32                `protobuf:"varint,13,opt,name=menu_type,json=menuType,proto3,oneof" json:"menu_type"`
+	ServiceName   *string                `protobuf:"bytes,14,opt,name=service_name,json=serviceName,proto3,oneof" json:"service_name"`
+	Permission    *string                `protobuf:"bytes,15,opt,name=permission,proto3,oneof" json:"permission"`
+	TenantId      *uint64                `protobuf:"varint,16,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MenuInfo) Reset() {
+	*x = MenuInfo{}
+	mi := &file_core_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MenuInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MenuInfo) ProtoMessage() {}
+
+func (x *MenuInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MenuInfo.ProtoReflect.Descriptor instead.
+func (*MenuInfo) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *MenuInfo) GetId() uint64 {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return 0
+}
+
+func (x *MenuInfo) GetCreatedAt() int64 {
+	if x != nil && x.CreatedAt != nil {
+		return *x.CreatedAt
+	}
+	return 0
+}
+
+func (x *MenuInfo) GetUpdatedAt() int64 {
+	if x != nil && x.UpdatedAt != nil {
+		return *x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *MenuInfo) GetLevel() uint32 {
+	if x != nil && x.Level != nil {
+		return *x.Level
+	}
+	return 0
+}
+
+func (x *MenuInfo) GetParentId() uint64 {
+	if x != nil && x.ParentId != nil {
+		return *x.ParentId
+	}
+	return 0
+}
+
+func (x *MenuInfo) GetPath() string {
+	if x != nil && x.Path != nil {
+		return *x.Path
+	}
+	return ""
+}
+
+func (x *MenuInfo) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *MenuInfo) GetRedirect() string {
+	if x != nil && x.Redirect != nil {
+		return *x.Redirect
+	}
+	return ""
+}
+
+func (x *MenuInfo) GetComponent() string {
+	if x != nil && x.Component != nil {
+		return *x.Component
+	}
+	return ""
+}
+
+func (x *MenuInfo) GetSort() uint32 {
+	if x != nil && x.Sort != nil {
+		return *x.Sort
+	}
+	return 0
+}
+
+func (x *MenuInfo) GetDisabled() bool {
+	if x != nil && x.Disabled != nil {
+		return *x.Disabled
+	}
+	return false
+}
+
+func (x *MenuInfo) GetMeta() *Meta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *MenuInfo) GetMenuType() uint32 {
+	if x != nil && x.MenuType != nil {
+		return *x.MenuType
+	}
+	return 0
+}
+
+func (x *MenuInfo) GetServiceName() string {
+	if x != nil && x.ServiceName != nil {
+		return *x.ServiceName
+	}
+	return ""
+}
+
+func (x *MenuInfo) GetPermission() string {
+	if x != nil && x.Permission != nil {
+		return *x.Permission
+	}
+	return ""
+}
+
+func (x *MenuInfo) GetTenantId() uint64 {
+	if x != nil && x.TenantId != nil {
+		return *x.TenantId
+	}
+	return 0
+}
+
+type MenuInfoList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Total         uint64                 `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
+	Data          []*MenuInfo            `protobuf:"bytes,2,rep,name=data,proto3" json:"data"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MenuInfoList) Reset() {
+	*x = MenuInfoList{}
+	mi := &file_core_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MenuInfoList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MenuInfoList) ProtoMessage() {}
+
+func (x *MenuInfoList) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MenuInfoList.ProtoReflect.Descriptor instead.
+func (*MenuInfoList) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *MenuInfoList) GetTotal() uint64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *MenuInfoList) GetData() []*MenuInfo {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type MenuRoleInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id"`
+	MenuId        uint64                 `protobuf:"varint,2,opt,name=menu_id,json=menuId,proto3" json:"menu_id"`
+	RoleId        uint64                 `protobuf:"varint,3,opt,name=role_id,json=roleId,proto3" json:"role_id"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MenuRoleInfo) Reset() {
+	*x = MenuRoleInfo{}
+	mi := &file_core_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MenuRoleInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MenuRoleInfo) ProtoMessage() {}
+
+func (x *MenuRoleInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MenuRoleInfo.ProtoReflect.Descriptor instead.
+func (*MenuRoleInfo) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *MenuRoleInfo) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *MenuRoleInfo) GetMenuId() uint64 {
+	if x != nil {
+		return x.MenuId
+	}
+	return 0
+}
+
+func (x *MenuRoleInfo) GetRoleId() uint64 {
+	if x != nil {
+		return x.RoleId
+	}
+	return 0
+}
+
+type MenuRoleListResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Total         uint64                 `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
+	Data          []*MenuRoleInfo        `protobuf:"bytes,2,rep,name=data,proto3" json:"data"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MenuRoleListResp) Reset() {
+	*x = MenuRoleListResp{}
+	mi := &file_core_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MenuRoleListResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MenuRoleListResp) ProtoMessage() {}
+
+func (x *MenuRoleListResp) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MenuRoleListResp.ProtoReflect.Descriptor instead.
+func (*MenuRoleListResp) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *MenuRoleListResp) GetTotal() uint64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *MenuRoleListResp) GetData() []*MenuRoleInfo {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type Meta struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Title              *string                `protobuf:"bytes,1,opt,name=title,proto3,oneof" json:"title"`
+	Icon               *string                `protobuf:"bytes,2,opt,name=icon,proto3,oneof" json:"icon"`
+	HideMenu           *bool                  `protobuf:"varint,3,opt,name=hide_menu,json=hideMenu,proto3,oneof" json:"hide_menu"`
+	HideBreadcrumb     *bool                  `protobuf:"varint,4,opt,name=hide_breadcrumb,json=hideBreadcrumb,proto3,oneof" json:"hide_breadcrumb"`
+	IgnoreKeepAlive    *bool                  `protobuf:"varint,5,opt,name=ignore_keep_alive,json=ignoreKeepAlive,proto3,oneof" json:"ignore_keep_alive"`
+	HideTab            *bool                  `protobuf:"varint,6,opt,name=hide_tab,json=hideTab,proto3,oneof" json:"hide_tab"`
+	FrameSrc           *string                `protobuf:"bytes,7,opt,name=frame_src,json=frameSrc,proto3,oneof" json:"frame_src"`
+	CarryParam         *bool                  `protobuf:"varint,8,opt,name=carry_param,json=carryParam,proto3,oneof" json:"carry_param"`
+	HideChildrenInMenu *bool                  `protobuf:"varint,9,opt,name=hide_children_in_menu,json=hideChildrenInMenu,proto3,oneof" json:"hide_children_in_menu"`
+	Affix              *bool                  `protobuf:"varint,10,opt,name=affix,proto3,oneof" json:"affix"`
+	DynamicLevel       *uint32                `protobuf:"varint,11,opt,name=dynamic_level,json=dynamicLevel,proto3,oneof" json:"dynamic_level"`
+	RealPath           *string                `protobuf:"bytes,12,opt,name=real_path,json=realPath,proto3,oneof" json:"real_path"`
+	Params             *string                `protobuf:"bytes,13,opt,name=params,proto3,oneof" json:"params"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *Meta) Reset() {
+	*x = Meta{}
+	mi := &file_core_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Meta) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Meta) ProtoMessage() {}
+
+func (x *Meta) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Meta.ProtoReflect.Descriptor instead.
+func (*Meta) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *Meta) GetTitle() string {
+	if x != nil && x.Title != nil {
+		return *x.Title
+	}
+	return ""
+}
+
+func (x *Meta) GetIcon() string {
+	if x != nil && x.Icon != nil {
+		return *x.Icon
+	}
+	return ""
+}
+
+func (x *Meta) GetHideMenu() bool {
+	if x != nil && x.HideMenu != nil {
+		return *x.HideMenu
+	}
+	return false
+}
+
+func (x *Meta) GetHideBreadcrumb() bool {
+	if x != nil && x.HideBreadcrumb != nil {
+		return *x.HideBreadcrumb
+	}
+	return false
+}
+
+func (x *Meta) GetIgnoreKeepAlive() bool {
+	if x != nil && x.IgnoreKeepAlive != nil {
+		return *x.IgnoreKeepAlive
+	}
+	return false
+}
+
+func (x *Meta) GetHideTab() bool {
+	if x != nil && x.HideTab != nil {
+		return *x.HideTab
+	}
+	return false
+}
+
+func (x *Meta) GetFrameSrc() string {
+	if x != nil && x.FrameSrc != nil {
+		return *x.FrameSrc
+	}
+	return ""
+}
+
+func (x *Meta) GetCarryParam() bool {
+	if x != nil && x.CarryParam != nil {
+		return *x.CarryParam
+	}
+	return false
+}
+
+func (x *Meta) GetHideChildrenInMenu() bool {
+	if x != nil && x.HideChildrenInMenu != nil {
+		return *x.HideChildrenInMenu
+	}
+	return false
+}
+
+func (x *Meta) GetAffix() bool {
+	if x != nil && x.Affix != nil {
+		return *x.Affix
+	}
+	return false
+}
+
+func (x *Meta) GetDynamicLevel() uint32 {
+	if x != nil && x.DynamicLevel != nil {
+		return *x.DynamicLevel
+	}
+	return 0
+}
+
+func (x *Meta) GetRealPath() string {
+	if x != nil && x.RealPath != nil {
+		return *x.RealPath
+	}
+	return ""
+}
+
+func (x *Meta) GetParams() string {
+	if x != nil && x.Params != nil {
+		return *x.Params
+	}
+	return ""
+}
+
+//  Request of the provider access token of a linked account | 获取绑定账号的第三方访问令牌请求
+type OauthAccessTokenReq struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id"`
+	//  Provider name | 提供商名称
+	Provider string `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider"`
+	//  Refresh the token even if it is still valid | 即使令牌未过期也强制刷新
+	ForceRefresh  *bool `protobuf:"varint,3,opt,name=force_refresh,json=forceRefresh,proto3,oneof" json:"force_refresh"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OauthAccessTokenReq) Reset() {
+	*x = OauthAccessTokenReq{}
+	mi := &file_core_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OauthAccessTokenReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OauthAccessTokenReq) ProtoMessage() {}
+
+func (x *OauthAccessTokenReq) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OauthAccessTokenReq.ProtoReflect.Descriptor instead.
+func (*OauthAccessTokenReq) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *OauthAccessTokenReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *OauthAccessTokenReq) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *OauthAccessTokenReq) GetForceRefresh() bool {
+	if x != nil && x.ForceRefresh != nil {
+		return *x.ForceRefresh
+	}
+	return false
+}
+
+type OauthAccessTokenResp struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	AccessToken string                 `protobuf:"bytes,1,opt,name=access_token,json=accessToken,proto3" json:"access_token"`
+	TokenType   string                 `protobuf:"bytes,2,opt,name=token_type,json=tokenType,proto3" json:"token_type"`
+	//  Expiration time in milliseconds, 0 means unknown | 过期时间（毫秒），0 表示未知
+	ExpiresAt     int64 `protobuf:"varint,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OauthAccessTokenResp) Reset() {
+	*x = OauthAccessTokenResp{}
+	mi := &file_core_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OauthAccessTokenResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OauthAccessTokenResp) ProtoMessage() {}
+
+func (x *OauthAccessTokenResp) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OauthAccessTokenResp.ProtoReflect.Descriptor instead.
+func (*OauthAccessTokenResp) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *OauthAccessTokenResp) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *OauthAccessTokenResp) GetTokenType() string {
+	if x != nil {
+		return x.TokenType
+	}
+	return ""
+}
+
+func (x *OauthAccessTokenResp) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+//  OAuth Account Binding messages
+type OauthAccountInfo struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               *uint64                `protobuf:"varint,1,opt,name=id,proto3,oneof" json:"id"`
+	CreatedAt        *int64                 `protobuf:"varint,2,opt,name=created_at,json=createdAt,proto3,oneof" json:"created_at"`
+	UpdatedAt        *int64                 `protobuf:"varint,3,opt,name=updated_at,json=updatedAt,proto3,oneof" json:"updated_at"`
+	UserId           *string                `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3,oneof" json:"user_id"`
+	ProviderId       *uint64                `protobuf:"varint,5,opt,name=provider_id,json=providerId,proto3,oneof" json:"provider_id"`
+	ProviderType     *string                `protobuf:"bytes,6,opt,name=provider_type,json=providerType,proto3,oneof" json:"provider_type"`
+	ProviderUserId   *string                `protobuf:"bytes,7,opt,name=provider_user_id,json=providerUserId,proto3,oneof" json:"provider_user_id"`
+	ProviderUsername *string                `protobuf:"bytes,8,opt,name=provider_username,json=providerUsername,proto3,oneof" json:"provider_username"`
+	ProviderNickname *string                `protobuf:"bytes,9,opt,name=provider_nickname,json=providerNickname,proto3,oneof" json:"provider_nickname"`
+	ProviderEmail    *string                `protobuf:"bytes,10,opt,name=provider_email,json=providerEmail,proto3,oneof" json:"provider_email"`
+	ProviderAvatar   *string                `protobuf:"bytes,11,opt,name=provider_avatar,json=providerAvatar,proto3,oneof" json:"provider_avatar"`
+	AccessToken      *string                `protobuf:"bytes,12,opt,name=access_token,json=accessToken,proto3,oneof" json:"access_token"`
+	RefreshToken     *string                `protobuf:"bytes,13,opt,name=refresh_token,json=refreshToken,proto3,oneof" json:"refresh_token"`
+	TokenExpiresAt   *int64                 `protobuf:"varint,14,opt,name=token_expires_at,json=tokenExpiresAt,proto3,oneof" json:"token_expires_at"`
+	ExtraData        *string                `protobuf:"bytes,15,opt,name=extra_data,json=extraData,proto3,oneof" json:"extra_data"`
+	LastLoginAt      *int64                 `protobuf:"varint,16,opt,name=last_login_at,json=lastLoginAt,proto3,oneof" json:"last_login_at"`
+	LastLoginIp      *string                `protobuf:"bytes,17,opt,name=last_login_ip,json=lastLoginIp,proto3,oneof" json:"last_login_ip"`
+	LoginCount       *uint32                `protobuf:"varint,18,opt,name=login_count,json=loginCount,proto3,oneof" json:"login_count"`
+	Status           *uint32                `protobuf:"varint,19,opt,name=status,proto3,oneof" json:"status"`
+	TenantId         *uint64                `protobuf:"varint,20,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *OauthAccountInfo) Reset() {
+	*x = OauthAccountInfo{}
+	mi := &file_core_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OauthAccountInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OauthAccountInfo) ProtoMessage() {}
+
+func (x *OauthAccountInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OauthAccountInfo.ProtoReflect.Descriptor instead.
+func (*OauthAccountInfo) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *OauthAccountInfo) GetId() uint64 {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return 0
+}
+
+func (x *OauthAccountInfo) GetCreatedAt() int64 {
+	if x != nil && x.CreatedAt != nil {
+		return *x.CreatedAt
+	}
+	return 0
+}
+
+func (x *OauthAccountInfo) GetUpdatedAt() int64 {
+	if x != nil && x.UpdatedAt != nil {
+		return *x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *OauthAccountInfo) GetUserId() string {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return ""
+}
+
+func (x *OauthAccountInfo) GetProviderId() uint64 {
+	if x != nil && x.ProviderId != nil {
+		return *x.ProviderId
+	}
+	return 0
+}
+
+func (x *OauthAccountInfo) GetProviderType() string {
+	if x != nil && x.ProviderType != nil {
+		return *x.ProviderType
+	}
+	return ""
+}
+
+func (x *OauthAccountInfo) GetProviderUserId() string {
+	if x != nil && x.ProviderUserId != nil {
+		return *x.ProviderUserId
+	}
+	return ""
+}
+
+func (x *OauthAccountInfo) GetProviderUsername() string {
+	if x != nil && x.ProviderUsername != nil {
+		return *x.ProviderUsername
+	}
+	return ""
+}
+
+func (x *OauthAccountInfo) GetProviderNickname() string {
+	if x != nil && x.ProviderNickname != nil {
+		return *x.ProviderNickname
+	}
+	return ""
+}
+
+func (x *OauthAccountInfo) GetProviderEmail() string {
+	if x != nil && x.ProviderEmail != nil {
+		return *x.ProviderEmail
+	}
+	return ""
+}
+
+func (x *OauthAccountInfo) GetProviderAvatar() string {
+	if x != nil && x.ProviderAvatar != nil {
+		return *x.ProviderAvatar
+	}
+	return ""
+}
+
+func (x *OauthAccountInfo) GetAccessToken() string {
+	if x != nil && x.AccessToken != nil {
+		return *x.AccessToken
+	}
+	return ""
+}
+
+func (x *OauthAccountInfo) GetRefreshToken() string {
+	if x != nil && x.RefreshToken != nil {
+		return *x.RefreshToken
+	}
+	return ""
+}
+
+func (x *OauthAccountInfo) GetTokenExpiresAt() int64 {
+	if x != nil && x.TokenExpiresAt != nil {
+		return *x.TokenExpiresAt
+	}
+	return 0
+}
+
+func (x *OauthAccountInfo) GetExtraData() string {
+	if x != nil && x.ExtraData != nil {
+		return *x.ExtraData
+	}
+	return ""
+}
+
+func (x *OauthAccountInfo) GetLastLoginAt() int64 {
+	if x != nil && x.LastLoginAt != nil {
+		return *x.LastLoginAt
+	}
+	return 0
+}
+
+func (x *OauthAccountInfo) GetLastLoginIp() string {
+	if x != nil && x.LastLoginIp != nil {
+		return *x.LastLoginIp
+	}
+	return ""
+}
+
+func (x *OauthAccountInfo) GetLoginCount() uint32 {
+	if x != nil && x.LoginCount != nil {
+		return *x.LoginCount
+	}
+	return 0
+}
+
+func (x *OauthAccountInfo) GetStatus() uint32 {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return 0
+}
+
+func (x *OauthAccountInfo) GetTenantId() uint64 {
+	if x != nil && x.TenantId != nil {
+		return *x.TenantId
+	}
+	return 0
+}
+
+type OauthAccountListReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          uint64                 `protobuf:"varint,1,opt,name=page,proto3" json:"page"`
+	PageSize      uint64                 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size"`
+	ProviderType  *string                `protobuf:"bytes,3,opt,name=provider_type,json=providerType,proto3,oneof" json:"provider_type"`
+	ProviderId    *uint64                `protobuf:"varint,4,opt,name=provider_id,json=providerId,proto3,oneof" json:"provider_id"`
+	UserId        *string                `protobuf:"bytes,5,opt,name=user_id,json=userId,proto3,oneof" json:"user_id"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OauthAccountListReq) Reset() {
+	*x = OauthAccountListReq{}
+	mi := &file_core_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OauthAccountListReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OauthAccountListReq) ProtoMessage() {}
+
+func (x *OauthAccountListReq) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OauthAccountListReq.ProtoReflect.Descriptor instead.
+func (*OauthAccountListReq) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *OauthAccountListReq) GetPage() uint64 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *OauthAccountListReq) GetPageSize() uint64 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *OauthAccountListReq) GetProviderType() string {
+	if x != nil && x.ProviderType != nil {
+		return *x.ProviderType
+	}
+	return ""
+}
+
+func (x *OauthAccountListReq) GetProviderId() uint64 {
+	if x != nil && x.ProviderId != nil {
+		return *x.ProviderId
+	}
+	return 0
+}
+
+func (x *OauthAccountListReq) GetUserId() string {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return ""
+}
+
+type OauthAccountListResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Total         uint64                 `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
+	Data          []*OauthAccountInfo    `protobuf:"bytes,2,rep,name=data,proto3" json:"data"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OauthAccountListResp) Reset() {
+	*x = OauthAccountListResp{}
+	mi := &file_core_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OauthAccountListResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OauthAccountListResp) ProtoMessage() {}
+
+func (x *OauthAccountListResp) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OauthAccountListResp.ProtoReflect.Descriptor instead.
+func (*OauthAccountListResp) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *OauthAccountListResp) GetTotal() uint64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *OauthAccountListResp) GetData() []*OauthAccountInfo {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+//  Authorization request of the authorization code flow
+type OauthAuthorizeReq struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	ClientId            string                 `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id"`
+	RedirectUri         string                 `protobuf:"bytes,2,opt,name=redirect_uri,json=redirectUri,proto3" json:"redirect_uri"`
+	Scope               string                 `protobuf:"bytes,3,opt,name=scope,proto3" json:"scope"`
+	CodeChallenge       *string                `protobuf:"bytes,4,opt,name=code_challenge,json=codeChallenge,proto3,oneof" json:"code_challenge"`
+	CodeChallengeMethod *string                `protobuf:"bytes,5,opt,name=code_challenge_method,json=codeChallengeMethod,proto3,oneof" json:"code_challenge_method"`
+	Nonce               *string                `protobuf:"bytes,6,opt,name=nonce,proto3,oneof" json:"nonce"`
+	UserId              string                 `protobuf:"bytes,7,opt,name=user_id,json=userId,proto3" json:"user_id"`
+	//  Decision of the user, empty when the user has not been asked yet
+	Approve       *bool `protobuf:"varint,8,opt,name=approve,proto3,oneof" json:"approve"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OauthAuthorizeReq) Reset() {
+	*x = OauthAuthorizeReq{}
+	mi := &file_core_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OauthAuthorizeReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OauthAuthorizeReq) ProtoMessage() {}
+
+func (x *OauthAuthorizeReq) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OauthAuthorizeReq.ProtoReflect.Descriptor instead.
+func (*OauthAuthorizeReq) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *OauthAuthorizeReq) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *OauthAuthorizeReq) GetRedirectUri() string {
+	if x != nil {
+		return x.RedirectUri
+	}
+	return ""
+}
+
+func (x *OauthAuthorizeReq) GetScope() string {
+	if x != nil {
+		return x.Scope
+	}
+	return ""
+}
+
+func (x *OauthAuthorizeReq) GetCodeChallenge() string {
+	if x != nil && x.CodeChallenge != nil {
+		return *x.CodeChallenge
+	}
+	return ""
+}
+
+func (x *OauthAuthorizeReq) GetCodeChallengeMethod() string {
+	if x != nil && x.CodeChallengeMethod != nil {
+		return *x.CodeChallengeMethod
+	}
+	return ""
+}
+
+func (x *OauthAuthorizeReq) GetNonce() string {
+	if x != nil && x.Nonce != nil {
+		return *x.Nonce
+	}
+	return ""
+}
+
+func (x *OauthAuthorizeReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *OauthAuthorizeReq) GetApprove() bool {
+	if x != nil && x.Approve != nil {
+		return *x.Approve
+	}
+	return false
+}
+
+type OauthAuthorizeResp struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ConsentRequired bool                   `protobuf:"varint,1,opt,name=consent_required,json=consentRequired,proto3" json:"consent_required"`
+	Code            string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code"`
+	ClientName      string                 `protobuf:"bytes,3,opt,name=client_name,json=clientName,proto3" json:"client_name"`
+	Scopes          []*OauthScopeInfo      `protobuf:"bytes,4,rep,name=scopes,proto3" json:"scopes"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *OauthAuthorizeResp) Reset() {
+	*x = OauthAuthorizeResp{}
+	mi := &file_core_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OauthAuthorizeResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OauthAuthorizeResp) ProtoMessage() {}
+
+func (x *OauthAuthorizeResp) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OauthAuthorizeResp.ProtoReflect.Descriptor instead.
+func (*OauthAuthorizeResp) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *OauthAuthorizeResp) GetConsentRequired() bool {
+	if x != nil {
+		return x.ConsentRequired
+	}
+	return false
+}
+
+func (x *OauthAuthorizeResp) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *OauthAuthorizeResp) GetClientName() string {
+	if x != nil {
+		return x.ClientName
+	}
+	return ""
+}
+
+func (x *OauthAuthorizeResp) GetScopes() []*OauthScopeInfo {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+type OauthCallbackResp struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	User  *UserInfo              `protobuf:"bytes,1,opt,name=user,proto3" json:"user"`
+	//  Name of the provider the user logged in with
+	Provider      string `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OauthCallbackResp) Reset() {
+	*x = OauthCallbackResp{}
+	mi := &file_core_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OauthCallbackResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OauthCallbackResp) ProtoMessage() {}
+
+func (x *OauthCallbackResp) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OauthCallbackResp.ProtoReflect.Descriptor instead.
+func (*OauthCallbackResp) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *OauthCallbackResp) GetUser() *UserInfo {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *OauthCallbackResp) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+//  Claim mapping preview messages
+type OauthClaimMappingPreviewReq struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	//  Use the saved extra config of the provider when extra_config is not set
+	ProviderId  *uint64 `protobuf:"varint,1,opt,name=provider_id,json=providerId,proto3,oneof" json:"provider_id"`
+	ExtraConfig *string `protobuf:"bytes,2,opt,name=extra_config,json=extraConfig,proto3,oneof" json:"extra_config"`
+	//  Sample userinfo JSON
+	Sample        string `protobuf:"bytes,3,opt,name=sample,proto3" json:"sample"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OauthClaimMappingPreviewReq) Reset() {
+	*x = OauthClaimMappingPreviewReq{}
+	mi := &file_core_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OauthClaimMappingPreviewReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OauthClaimMappingPreviewReq) ProtoMessage() {}
+
+func (x *OauthClaimMappingPreviewReq) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OauthClaimMappingPreviewReq.ProtoReflect.Descriptor instead.
+func (*OauthClaimMappingPreviewReq) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *OauthClaimMappingPreviewReq) GetProviderId() uint64 {
+	if x != nil && x.ProviderId != nil {
+		return *x.ProviderId
+	}
+	return 0
+}
+
+func (x *OauthClaimMappingPreviewReq) GetExtraConfig() string {
+	if x != nil && x.ExtraConfig != nil {
+		return *x.ExtraConfig
+	}
+	return ""
+}
+
+func (x *OauthClaimMappingPreviewReq) GetSample() string {
+	if x != nil {
+		return x.Sample
+	}
+	return ""
+}
+
+type OauthClaimMappingPreviewResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username"`
+	Nickname      string                 `protobuf:"bytes,3,opt,name=nickname,proto3" json:"nickname"`
+	Email         string                 `protobuf:"bytes,4,opt,name=email,proto3" json:"email"`
+	Phone         string                 `protobuf:"bytes,5,opt,name=phone,proto3" json:"phone"`
+	Avatar        string                 `protobuf:"bytes,6,opt,name=avatar,proto3" json:"avatar"`
+	Groups        []string               `protobuf:"bytes,7,rep,name=groups,proto3" json:"groups"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OauthClaimMappingPreviewResp) Reset() {
+	*x = OauthClaimMappingPreviewResp{}
+	mi := &file_core_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OauthClaimMappingPreviewResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OauthClaimMappingPreviewResp) ProtoMessage() {}
+
+func (x *OauthClaimMappingPreviewResp) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OauthClaimMappingPreviewResp.ProtoReflect.Descriptor instead.
+func (*OauthClaimMappingPreviewResp) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *OauthClaimMappingPreviewResp) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *OauthClaimMappingPreviewResp) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *OauthClaimMappingPreviewResp) GetNickname() string {
+	if x != nil {
+		return x.Nickname
+	}
+	return ""
+}
+
+func (x *OauthClaimMappingPreviewResp) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *OauthClaimMappingPreviewResp) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *OauthClaimMappingPreviewResp) GetAvatar() string {
+	if x != nil {
+		return x.Avatar
+	}
+	return ""
+}
+
+func (x *OauthClaimMappingPreviewResp) GetGroups() []string {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+type OauthClientAuthReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClientId      string                 `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id"`
+	ClientSecret  *string                `protobuf:"bytes,2,opt,name=client_secret,json=clientSecret,proto3,oneof" json:"client_secret"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OauthClientAuthReq) Reset() {
+	*x = OauthClientAuthReq{}
+	mi := &file_core_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OauthClientAuthReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OauthClientAuthReq) ProtoMessage() {}
+
+func (x *OauthClientAuthReq) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OauthClientAuthReq.ProtoReflect.Descriptor instead.
+func (*OauthClientAuthReq) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *OauthClientAuthReq) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *OauthClientAuthReq) GetClientSecret() string {
+	if x != nil && x.ClientSecret != nil {
+		return *x.ClientSecret
+	}
+	return ""
+}
+
+type OauthClientIdReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClientId      string                 `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OauthClientIdReq) Reset() {
+	*x = OauthClientIdReq{}
+	mi := &file_core_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OauthClientIdReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OauthClientIdReq) ProtoMessage() {}
+
+func (x *OauthClientIdReq) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OauthClientIdReq.ProtoReflect.Descriptor instead.
+func (*OauthClientIdReq) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *OauthClientIdReq) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+type OauthClientInfo struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              *uint64                `protobuf:"varint,1,opt,name=id,proto3,oneof" json:"id"`
+	CreatedAt       *int64                 `protobuf:"varint,2,opt,name=created_at,json=createdAt,proto3,oneof" json:"created_at"`
+	UpdatedAt       *int64                 `protobuf:"varint,3,opt,name=updated_at,json=updatedAt,proto3,oneof" json:"updated_at"`
+	Status          *uint32                `protobuf:"varint,4,opt,name=status,proto3,oneof" json:"status"`
+	TenantId        *uint64                `protobuf:"varint,5,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id"`
+	Name            *string                `protobuf:"bytes,6,opt,name=name,proto3,oneof" json:"name"`
+	ClientId        *string                `protobuf:"bytes,7,opt,name=client_id,json=clientId,proto3,oneof" json:"client_id"`
+	ClientType      *string                `protobuf:"bytes,8,opt,name=client_type,json=clientType,proto3,oneof" json:"client_type"`
+	RedirectUris    []string               `protobuf:"bytes,9,rep,name=redirect_uris,json=redirectUris,proto3" json:"redirect_uris"`
+	GrantTypes      []string               `protobuf:"bytes,10,rep,name=grant_types,json=grantTypes,proto3" json:"grant_types"`
+	Scopes          []string               `protobuf:"bytes,11,rep,name=scopes,proto3" json:"scopes"`
+	Trusted         *bool                  `protobuf:"varint,12,opt,name=trusted,proto3,oneof" json:"trusted"`
+	AccessTokenTtl  *uint32                `protobuf:"varint,13,opt,name=access_token_ttl,json=accessTokenTtl,proto3,oneof" json:"access_token_ttl"`
+	RefreshTokenTtl *uint32                `protobuf:"varint,14,opt,name=refresh_token_ttl,json=refreshTokenTtl,proto3,oneof" json:"refresh_token_ttl"`
+	Remark          *string                `protobuf:"bytes,15,opt,name=remark,proto3,oneof" json:"remark"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *OauthClientInfo) Reset() {
+	*x = OauthClientInfo{}
+	mi := &file_core_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OauthClientInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OauthClientInfo) ProtoMessage() {}
+
+func (x *OauthClientInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OauthClientInfo.ProtoReflect.Descriptor instead.
+func (*OauthClientInfo) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *OauthClientInfo) GetId() uint64 {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return 0
+}
+
+func (x *OauthClientInfo) GetCreatedAt() int64 {
+	if x != nil && x.CreatedAt != nil {
+		return *x.CreatedAt
+	}
+	return 0
+}
+
+func (x *OauthClientInfo) GetUpdatedAt() int64 {
+	if x != nil && x.UpdatedAt != nil {
+		return *x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *OauthClientInfo) GetStatus() uint32 {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return 0
+}
+
+func (x *OauthClientInfo) GetTenantId() uint64 {
+	if x != nil && x.TenantId != nil {
+		return *x.TenantId
+	}
+	return 0
+}
+
+func (x *OauthClientInfo) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *OauthClientInfo) GetClientId() string {
+	if x != nil && x.ClientId != nil {
+		return *x.ClientId
+	}
+	return ""
+}
+
+func (x *OauthClientInfo) GetClientType() string {
+	if x != nil && x.ClientType != nil {
+		return *x.ClientType
+	}
+	return ""
+}
+
+func (x *OauthClientInfo) GetRedirectUris() []string {
+	if x != nil {
+		return x.RedirectUris
+	}
+	return nil
+}
+
+func (x *OauthClientInfo) GetGrantTypes() []string {
+	if x != nil {
+		return x.GrantTypes
+	}
+	return nil
+}
+
+func (x *OauthClientInfo) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *OauthClientInfo) GetTrusted() bool {
+	if x != nil && x.Trusted != nil {
+		return *x.Trusted
+	}
+	return false
+}
+
+func (x *OauthClientInfo) GetAccessTokenTtl() uint32 {
+	if x != nil && x.AccessTokenTtl != nil {
+		return *x.AccessTokenTtl
+	}
+	return 0
+}
+
+func (x *OauthClientInfo) GetRefreshTokenTtl() uint32 {
+	if x != nil && x.RefreshTokenTtl != nil {
+		return *x.RefreshTokenTtl
+	}
+	return 0
+}
+
+func (x *OauthClientInfo) GetRemark() string {
+	if x != nil && x.Remark != nil {
+		return *x.Remark
+	}
+	return ""
+}
+
+type OauthClientListReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          uint64                 `protobuf:"varint,1,opt,name=page,proto3" json:"page"`
+	PageSize      uint64                 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size"`
+	Name          *string                `protobuf:"bytes,3,opt,name=name,proto3,oneof" json:"name"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OauthClientListReq) Reset() {
+	*x = OauthClientListReq{}
+	mi := &file_core_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OauthClientListReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OauthClientListReq) ProtoMessage() {}
+
+func (x *OauthClientListReq) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OauthClientListReq.ProtoReflect.Descriptor instead.
+func (*OauthClientListReq) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *OauthClientListReq) GetPage() uint64 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *OauthClientListReq) GetPageSize() uint64 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *OauthClientListReq) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+type OauthClientListResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Total         uint64                 `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
+	Data          []*OauthClientInfo     `protobuf:"bytes,2,rep,name=data,proto3" json:"data"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OauthClientListResp) Reset() {
+	*x = OauthClientListResp{}
+	mi := &file_core_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OauthClientListResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OauthClientListResp) ProtoMessage() {}
+
+func (x *OauthClientListResp) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OauthClientListResp.ProtoReflect.Descriptor instead.
+func (*OauthClientListResp) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *OauthClientListResp) GetTotal() uint64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *OauthClientListResp) GetData() []*OauthClientInfo {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+//  The secret is only returned when the client is created or the secret is reset
+type OauthClientSecretResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id"`
+	ClientId      string                 `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3" json:"client_id"`
+	ClientSecret  string                 `protobuf:"bytes,3,opt,name=client_secret,json=clientSecret,proto3" json:"client_secret"`
+	Msg           string                 `protobuf:"bytes,4,opt,name=msg,proto3" json:"msg"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OauthClientSecretResp) Reset() {
+	*x = OauthClientSecretResp{}
+	mi := &file_core_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OauthClientSecretResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OauthClientSecretResp) ProtoMessage() {}
+
+func (x *OauthClientSecretResp) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OauthClientSecretResp.ProtoReflect.Descriptor instead.
+func (*OauthClientSecretResp) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *OauthClientSecretResp) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *OauthClientSecretResp) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *OauthClientSecretResp) GetClientSecret() string {
+	if x != nil {
+		return x.ClientSecret
+	}
+	return ""
+}
+
+func (x *OauthClientSecretResp) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+type OauthCodeExchangeReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code"`
+	ClientId      string                 `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3" json:"client_id"`
+	RedirectUri   string                 `protobuf:"bytes,3,opt,name=redirect_uri,json=redirectUri,proto3" json:"redirect_uri"`
+	CodeVerifier  *string                `protobuf:"bytes,4,opt,name=code_verifier,json=codeVerifier,proto3,oneof" json:"code_verifier"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OauthCodeExchangeReq) Reset() {
+	*x = OauthCodeExchangeReq{}
+	mi := &file_core_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OauthCodeExchangeReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OauthCodeExchangeReq) ProtoMessage() {}
+
+func (x *OauthCodeExchangeReq) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OauthCodeExchangeReq.ProtoReflect.Descriptor instead.
+func (*OauthCodeExchangeReq) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *OauthCodeExchangeReq) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *OauthCodeExchangeReq) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *OauthCodeExchangeReq) GetRedirectUri() string {
+	if x != nil {
+		return x.RedirectUri
+	}
+	return ""
+}
+
+func (x *OauthCodeExchangeReq) GetCodeVerifier() string {
+	if x != nil && x.CodeVerifier != nil {
+		return *x.CodeVerifier
+	}
+	return ""
+}
+
+type OauthConsentInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            *uint64                `protobuf:"varint,1,opt,name=id,proto3,oneof" json:"id"`
+	CreatedAt     *int64                 `protobuf:"varint,2,opt,name=created_at,json=createdAt,proto3,oneof" json:"created_at"`
+	UpdatedAt     *int64                 `protobuf:"varint,3,opt,name=updated_at,json=updatedAt,proto3,oneof" json:"updated_at"`
+	TenantId      *uint64                `protobuf:"varint,4,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id"`
+	UserId        *string                `protobuf:"bytes,5,opt,name=user_id,json=userId,proto3,oneof" json:"user_id"`
+	ClientId      *string                `protobuf:"bytes,6,opt,name=client_id,json=clientId,proto3,oneof" json:"client_id"`
+	Scopes        []string               `protobuf:"bytes,7,rep,name=scopes,proto3" json:"scopes"`
+	ClientName    *string                `protobuf:"bytes,8,opt,name=client_name,json=clientName,proto3,oneof" json:"client_name"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OauthConsentInfo) Reset() {
+	*x = OauthConsentInfo{}
+	mi := &file_core_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OauthConsentInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OauthConsentInfo) ProtoMessage() {}
+
+func (x *OauthConsentInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OauthConsentInfo.ProtoReflect.Descriptor instead.
+func (*OauthConsentInfo) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *OauthConsentInfo) GetId() uint64 {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return 0
+}
+
+func (x *OauthConsentInfo) GetCreatedAt() int64 {
+	if x != nil && x.CreatedAt != nil {
+		return *x.CreatedAt
+	}
+	return 0
+}
+
+func (x *OauthConsentInfo) GetUpdatedAt() int64 {
+	if x != nil && x.UpdatedAt != nil {
+		return *x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *OauthConsentInfo) GetTenantId() uint64 {
+	if x != nil && x.TenantId != nil {
+		return *x.TenantId
+	}
+	return 0
+}
+
+func (x *OauthConsentInfo) GetUserId() string {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return ""
+}
+
+func (x *OauthConsentInfo) GetClientId() string {
+	if x != nil && x.ClientId != nil {
+		return *x.ClientId
+	}
+	return ""
+}
+
+func (x *OauthConsentInfo) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *OauthConsentInfo) GetClientName() string {
+	if x != nil && x.ClientName != nil {
+		return *x.ClientName
+	}
+	return ""
+}
+
+type OauthConsentListReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          uint64                 `protobuf:"varint,1,opt,name=page,proto3" json:"page"`
+	PageSize      uint64                 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size"`
+	UserId        *string                `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3,oneof" json:"user_id"`
+	ClientId      *string                `protobuf:"bytes,4,opt,name=client_id,json=clientId,proto3,oneof" json:"client_id"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OauthConsentListReq) Reset() {
+	*x = OauthConsentListReq{}
+	mi := &file_core_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OauthConsentListReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OauthConsentListReq) ProtoMessage() {}
 
-func (x *BaseMsg) ProtoReflect() protoreflect.Message {
-	mi := &file_core_proto_msgTypes[9]
+func (x *OauthConsentListReq) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[93]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -954,40 +7808,62 @@ func (x *BaseMsg) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BaseMsg.ProtoReflect.Descriptor instead.
-func (*BaseMsg) Descriptor() ([]byte, []int) {
-	return file_core_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use OauthConsentListReq.ProtoReflect.Descriptor instead.
+func (*OauthConsentListReq) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{93}
 }
 
-func (x *BaseMsg) GetMsg() string {
+func (x *OauthConsentListReq) GetPage() uint64 {
 	if x != nil {
-		return x.Msg
+		return x.Page
+	}
+	return 0
+}
+
+func (x *OauthConsentListReq) GetPageSize() uint64 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *OauthConsentListReq) GetUserId() string {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
 	}
 	return ""
 }
 
-type BaseResp struct {
+func (x *OauthConsentListReq) GetClientId() string {
+	if x != nil && x.ClientId != nil {
+		return *x.ClientId
+	}
+	return ""
+}
+
+type OauthConsentListResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Msg           string                 `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg"`
+	Total         uint64                 `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
+	Data          []*OauthConsentInfo    `protobuf:"bytes,2,rep,name=data,proto3" json:"data"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BaseResp) Reset() {
-	*x = BaseResp{}
-	mi := &file_core_proto_msgTypes[10]
+func (x *OauthConsentListResp) Reset() {
+	*x = OauthConsentListResp{}
+	mi := &file_core_proto_msgTypes[94]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BaseResp) String() string {
+func (x *OauthConsentListResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BaseResp) ProtoMessage() {}
+func (*OauthConsentListResp) ProtoMessage() {}
 
-func (x *BaseResp) ProtoReflect() protoreflect.Message {
-	mi := &file_core_proto_msgTypes[10]
+func (x *OauthConsentListResp) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[94]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -998,41 +7874,234 @@ func (x *BaseResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BaseResp.ProtoReflect.Descriptor instead.
-func (*BaseResp) Descriptor() ([]byte, []int) {
-	return file_core_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use OauthConsentListResp.ProtoReflect.Descriptor instead.
+func (*OauthConsentListResp) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{94}
 }
 
-func (x *BaseResp) GetMsg() string {
+func (x *OauthConsentListResp) GetTotal() uint64 {
 	if x != nil {
-		return x.Msg
+		return x.Total
 	}
-	return ""
+	return 0
 }
 
-type BaseUUIDResp struct {
+func (x *OauthConsentListResp) GetData() []*OauthConsentInfo {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+//  The grant of an authorization code
+type OauthGrantInfo struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
-	Msg           string                 `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id"`
+	TenantId      uint64                 `protobuf:"varint,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id"`
+	ClientId      string                 `protobuf:"bytes,3,opt,name=client_id,json=clientId,proto3" json:"client_id"`
+	Scopes        []string               `protobuf:"bytes,4,rep,name=scopes,proto3" json:"scopes"`
+	Nonce         string                 `protobuf:"bytes,5,opt,name=nonce,proto3" json:"nonce"`
+	AuthTime      int64                  `protobuf:"varint,6,opt,name=auth_time,json=authTime,proto3" json:"auth_time"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OauthGrantInfo) Reset() {
+	*x = OauthGrantInfo{}
+	mi := &file_core_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OauthGrantInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OauthGrantInfo) ProtoMessage() {}
+
+func (x *OauthGrantInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OauthGrantInfo.ProtoReflect.Descriptor instead.
+func (*OauthGrantInfo) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *OauthGrantInfo) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *OauthGrantInfo) GetTenantId() uint64 {
+	if x != nil {
+		return x.TenantId
+	}
+	return 0
+}
+
+func (x *OauthGrantInfo) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *OauthGrantInfo) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *OauthGrantInfo) GetNonce() string {
+	if x != nil {
+		return x.Nonce
+	}
+	return ""
+}
+
+func (x *OauthGrantInfo) GetAuthTime() int64 {
+	if x != nil {
+		return x.AuthTime
+	}
+	return 0
+}
+
+type OauthLoginReq struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	//  Deprecated: core issues a signed state for every login, the value is ignored
+	State         string  `protobuf:"bytes,1,opt,name=state,proto3" json:"state"`
+	Provider      string  `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider"`
+	ClientIp      *string `protobuf:"bytes,3,opt,name=client_ip,json=clientIp,proto3,oneof" json:"client_ip"`
+	UserAgent     *string `protobuf:"bytes,4,opt,name=user_agent,json=userAgent,proto3,oneof" json:"user_agent"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OauthLoginReq) Reset() {
+	*x = OauthLoginReq{}
+	mi := &file_core_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OauthLoginReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OauthLoginReq) ProtoMessage() {}
+
+func (x *OauthLoginReq) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OauthLoginReq.ProtoReflect.Descriptor instead.
+func (*OauthLoginReq) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *OauthLoginReq) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *OauthLoginReq) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *OauthLoginReq) GetClientIp() string {
+	if x != nil && x.ClientIp != nil {
+		return *x.ClientIp
+	}
+	return ""
+}
+
+func (x *OauthLoginReq) GetUserAgent() string {
+	if x != nil && x.UserAgent != nil {
+		return *x.UserAgent
+	}
+	return ""
+}
+
+type OauthProviderInfo struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Id           *uint64                `protobuf:"varint,1,opt,name=id,proto3,oneof" json:"id"`
+	CreatedAt    *int64                 `protobuf:"varint,2,opt,name=created_at,json=createdAt,proto3,oneof" json:"created_at"`
+	UpdatedAt    *int64                 `protobuf:"varint,3,opt,name=updated_at,json=updatedAt,proto3,oneof" json:"updated_at"`
+	Name         *string                `protobuf:"bytes,4,opt,name=name,proto3,oneof" json:"name"`
+	ClientId     *string                `protobuf:"bytes,5,opt,name=client_id,json=clientId,proto3,oneof" json:"client_id"`
+	ClientSecret *string                `protobuf:"bytes,6,opt,name=client_secret,json=clientSecret,proto3,oneof" json:"client_secret"`
+	RedirectUrl  *string                `protobuf:"bytes,7,opt,name=redirect_url,json=redirectUrl,proto3,oneof" json:"redirect_url"`
+	Scopes       *string                `protobuf:"bytes,8,opt,name=scopes,proto3,oneof" json:"scopes"`
+	AuthUrl      *string                `protobuf:"bytes,9,opt,name=auth_url,json=authUrl,proto3,oneof" json:"auth_url"`
+	TokenUrl     *string                `protobuf:"bytes,10,opt,name=token_url,json=tokenUrl,proto3,oneof" json:"token_url"`
+	AuthStyle    *uint64                `protobuf:"varint,11,opt,name=auth_style,json=authStyle,proto3,oneof" json:"auth_style"`
+	InfoUrl      *string                `protobuf:"bytes,12,opt,name=info_url,json=infoUrl,proto3,oneof" json:"info_url"`
+	//  Enhanced fields from OAuth refactor
+	DisplayName     *string `protobuf:"bytes,13,opt,name=display_name,json=displayName,proto3,oneof" json:"display_name"`
+	Type            *string `protobuf:"bytes,14,opt,name=type,proto3,oneof" json:"type"`
+	ProviderType    *string `protobuf:"bytes,15,opt,name=provider_type,json=providerType,proto3,oneof" json:"provider_type"`
+	EncryptedSecret *string `protobuf:"bytes,16,opt,name=encrypted_secret,json=encryptedSecret,proto3,oneof" json:"encrypted_secret"`
+	EncryptionKeyId *string `protobuf:"bytes,17,opt,name=encryption_key_id,json=encryptionKeyId,proto3,oneof" json:"encryption_key_id"`
+	ExtraConfig     *string `protobuf:"bytes,18,opt,name=extra_config,json=extraConfig,proto3,oneof" json:"extra_config"`
+	Enabled         *bool   `protobuf:"varint,19,opt,name=enabled,proto3,oneof" json:"enabled"`
+	Sort            *uint32 `protobuf:"varint,20,opt,name=sort,proto3,oneof" json:"sort"`
+	Remark          *string `protobuf:"bytes,21,opt,name=remark,proto3,oneof" json:"remark"`
+	SupportPkce     *bool   `protobuf:"varint,22,opt,name=support_pkce,json=supportPkce,proto3,oneof" json:"support_pkce"`
+	IconUrl         *string `protobuf:"bytes,23,opt,name=icon_url,json=iconUrl,proto3,oneof" json:"icon_url"`
+	CacheTtl        *int32  `protobuf:"varint,24,opt,name=cache_ttl,json=cacheTtl,proto3,oneof" json:"cache_ttl"`
+	WebhookUrl      *string `protobuf:"bytes,25,opt,name=webhook_url,json=webhookUrl,proto3,oneof" json:"webhook_url"`
+	SuccessCount    *int32  `protobuf:"varint,26,opt,name=success_count,json=successCount,proto3,oneof" json:"success_count"`
+	FailureCount    *int32  `protobuf:"varint,27,opt,name=failure_count,json=failureCount,proto3,oneof" json:"failure_count"`
+	LastUsedAt      *int64  `protobuf:"varint,28,opt,name=last_used_at,json=lastUsedAt,proto3,oneof" json:"last_used_at"`
+	//  Tenant and status fields from mixins (for completeness)
+	Status   *uint32 `protobuf:"varint,29,opt,name=status,proto3,oneof" json:"status"`
+	TenantId *uint64 `protobuf:"varint,30,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id"`
+	//  The system template the provider was enabled from | 来源的系统模板ID
+	TemplateId    *uint64 `protobuf:"varint,31,opt,name=template_id,json=templateId,proto3,oneof" json:"template_id"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BaseUUIDResp) Reset() {
-	*x = BaseUUIDResp{}
-	mi := &file_core_proto_msgTypes[11]
+func (x *OauthProviderInfo) Reset() {
+	*x = OauthProviderInfo{}
+	mi := &file_core_proto_msgTypes[97]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BaseUUIDResp) String() string {
+func (x *OauthProviderInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BaseUUIDResp) ProtoMessage() {}
+func (*OauthProviderInfo) ProtoMessage() {}
 
-func (x *BaseUUIDResp) ProtoReflect() protoreflect.Message {
-	mi := &file_core_proto_msgTypes[11]
+func (x *OauthProviderInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[97]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1043,255 +8112,252 @@ func (x *BaseUUIDResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BaseUUIDResp.ProtoReflect.Descriptor instead.
-func (*BaseUUIDResp) Descriptor() ([]byte, []int) {
-	return file_core_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use OauthProviderInfo.ProtoReflect.Descriptor instead.
+func (*OauthProviderInfo) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{97}
 }
 
-func (x *BaseUUIDResp) GetId() string {
-	if x != nil {
-		return x.Id
+func (x *OauthProviderInfo) GetId() uint64 {
+	if x != nil && x.Id != nil {
+		return *x.Id
 	}
-	return ""
+	return 0
 }
 
-func (x *BaseUUIDResp) GetMsg() string {
-	if x != nil {
-		return x.Msg
+func (x *OauthProviderInfo) GetCreatedAt() int64 {
+	if x != nil && x.CreatedAt != nil {
+		return *x.CreatedAt
 	}
-	return ""
+	return 0
 }
 
-//  批量创建Casbin规则请求
-type BatchCreateCasbinRulesReq struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Rules         []*CasbinRuleInfo      `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *OauthProviderInfo) GetUpdatedAt() int64 {
+	if x != nil && x.UpdatedAt != nil {
+		return *x.UpdatedAt
+	}
+	return 0
 }
 
-func (x *BatchCreateCasbinRulesReq) Reset() {
-	*x = BatchCreateCasbinRulesReq{}
-	mi := &file_core_proto_msgTypes[12]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *OauthProviderInfo) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
 }
 
-func (x *BatchCreateCasbinRulesReq) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *OauthProviderInfo) GetClientId() string {
+	if x != nil && x.ClientId != nil {
+		return *x.ClientId
+	}
+	return ""
 }
 
-func (*BatchCreateCasbinRulesReq) ProtoMessage() {}
-
-func (x *BatchCreateCasbinRulesReq) ProtoReflect() protoreflect.Message {
-	mi := &file_core_proto_msgTypes[12]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *OauthProviderInfo) GetClientSecret() string {
+	if x != nil && x.ClientSecret != nil {
+		return *x.ClientSecret
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use BatchCreateCasbinRulesReq.ProtoReflect.Descriptor instead.
-func (*BatchCreateCasbinRulesReq) Descriptor() ([]byte, []int) {
-	return file_core_proto_rawDescGZIP(), []int{12}
+func (x *OauthProviderInfo) GetRedirectUrl() string {
+	if x != nil && x.RedirectUrl != nil {
+		return *x.RedirectUrl
+	}
+	return ""
 }
 
-func (x *BatchCreateCasbinRulesReq) GetRules() []*CasbinRuleInfo {
-	if x != nil {
-		return x.Rules
+func (x *OauthProviderInfo) GetScopes() string {
+	if x != nil && x.Scopes != nil {
+		return *x.Scopes
 	}
-	return nil
+	return ""
 }
 
-//  批量权限检查请求
-type BatchPermissionCheckReq struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Requests      []*PermissionCheckReq  `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests"`
-	FailFast      *bool                  `protobuf:"varint,2,opt,name=fail_fast,json=failFast,proto3,oneof" json:"fail_fast"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *OauthProviderInfo) GetAuthUrl() string {
+	if x != nil && x.AuthUrl != nil {
+		return *x.AuthUrl
+	}
+	return ""
 }
 
-func (x *BatchPermissionCheckReq) Reset() {
-	*x = BatchPermissionCheckReq{}
-	mi := &file_core_proto_msgTypes[13]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *OauthProviderInfo) GetTokenUrl() string {
+	if x != nil && x.TokenUrl != nil {
+		return *x.TokenUrl
+	}
+	return ""
 }
 
-func (x *BatchPermissionCheckReq) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *OauthProviderInfo) GetAuthStyle() uint64 {
+	if x != nil && x.AuthStyle != nil {
+		return *x.AuthStyle
+	}
+	return 0
 }
 
-func (*BatchPermissionCheckReq) ProtoMessage() {}
-
-func (x *BatchPermissionCheckReq) ProtoReflect() protoreflect.Message {
-	mi := &file_core_proto_msgTypes[13]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *OauthProviderInfo) GetInfoUrl() string {
+	if x != nil && x.InfoUrl != nil {
+		return *x.InfoUrl
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use BatchPermissionCheckReq.ProtoReflect.Descriptor instead.
-func (*BatchPermissionCheckReq) Descriptor() ([]byte, []int) {
-	return file_core_proto_rawDescGZIP(), []int{13}
+func (x *OauthProviderInfo) GetDisplayName() string {
+	if x != nil && x.DisplayName != nil {
+		return *x.DisplayName
+	}
+	return ""
 }
 
-func (x *BatchPermissionCheckReq) GetRequests() []*PermissionCheckReq {
-	if x != nil {
-		return x.Requests
+func (x *OauthProviderInfo) GetType() string {
+	if x != nil && x.Type != nil {
+		return *x.Type
 	}
-	return nil
+	return ""
 }
 
-func (x *BatchPermissionCheckReq) GetFailFast() bool {
-	if x != nil && x.FailFast != nil {
-		return *x.FailFast
+func (x *OauthProviderInfo) GetProviderType() string {
+	if x != nil && x.ProviderType != nil {
+		return *x.ProviderType
 	}
-	return false
+	return ""
 }
 
-//  批量权限检查响应
-type BatchPermissionCheckResp struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Responses     []*PermissionCheckResp `protobuf:"bytes,1,rep,name=responses,proto3" json:"responses"`
-	SuccessCount  int32                  `protobuf:"varint,2,opt,name=success_count,json=successCount,proto3" json:"success_count"`
-	FailedCount   int32                  `protobuf:"varint,3,opt,name=failed_count,json=failedCount,proto3" json:"failed_count"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *OauthProviderInfo) GetEncryptedSecret() string {
+	if x != nil && x.EncryptedSecret != nil {
+		return *x.EncryptedSecret
+	}
+	return ""
 }
 
-func (x *BatchPermissionCheckResp) Reset() {
-	*x = BatchPermissionCheckResp{}
-	mi := &file_core_proto_msgTypes[14]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *OauthProviderInfo) GetEncryptionKeyId() string {
+	if x != nil && x.EncryptionKeyId != nil {
+		return *x.EncryptionKeyId
+	}
+	return ""
 }
 
-func (x *BatchPermissionCheckResp) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *OauthProviderInfo) GetExtraConfig() string {
+	if x != nil && x.ExtraConfig != nil {
+		return *x.ExtraConfig
+	}
+	return ""
 }
 
-func (*BatchPermissionCheckResp) ProtoMessage() {}
+func (x *OauthProviderInfo) GetEnabled() bool {
+	if x != nil && x.Enabled != nil {
+		return *x.Enabled
+	}
+	return false
+}
 
-func (x *BatchPermissionCheckResp) ProtoReflect() protoreflect.Message {
-	mi := &file_core_proto_msgTypes[14]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *OauthProviderInfo) GetSort() uint32 {
+	if x != nil && x.Sort != nil {
+		return *x.Sort
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use BatchPermissionCheckResp.ProtoReflect.Descriptor instead.
-func (*BatchPermissionCheckResp) Descriptor() ([]byte, []int) {
-	return file_core_proto_rawDescGZIP(), []int{14}
+func (x *OauthProviderInfo) GetRemark() string {
+	if x != nil && x.Remark != nil {
+		return *x.Remark
+	}
+	return ""
 }
 
-func (x *BatchPermissionCheckResp) GetResponses() []*PermissionCheckResp {
-	if x != nil {
-		return x.Responses
+func (x *OauthProviderInfo) GetSupportPkce() bool {
+	if x != nil && x.SupportPkce != nil {
+		return *x.SupportPkce
 	}
-	return nil
+	return false
 }
 
-func (x *BatchPermissionCheckResp) GetSuccessCount() int32 {
-	if x != nil {
-		return x.SuccessCount
+func (x *OauthProviderInfo) GetIconUrl() string {
+	if x != nil && x.IconUrl != nil {
+		return *x.IconUrl
 	}
-	return 0
+	return ""
 }
 
-func (x *BatchPermissionCheckResp) GetFailedCount() int32 {
-	if x != nil {
-		return x.FailedCount
+func (x *OauthProviderInfo) GetCacheTtl() int32 {
+	if x != nil && x.CacheTtl != nil {
+		return *x.CacheTtl
 	}
 	return 0
 }
 
-//  批量更新Casbin规则请求
-type BatchUpdateCasbinRulesReq struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Rules         []*CasbinRuleInfo      `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *OauthProviderInfo) GetWebhookUrl() string {
+	if x != nil && x.WebhookUrl != nil {
+		return *x.WebhookUrl
+	}
+	return ""
 }
 
-func (x *BatchUpdateCasbinRulesReq) Reset() {
-	*x = BatchUpdateCasbinRulesReq{}
-	mi := &file_core_proto_msgTypes[15]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *OauthProviderInfo) GetSuccessCount() int32 {
+	if x != nil && x.SuccessCount != nil {
+		return *x.SuccessCount
+	}
+	return 0
 }
 
-func (x *BatchUpdateCasbinRulesReq) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *OauthProviderInfo) GetFailureCount() int32 {
+	if x != nil && x.FailureCount != nil {
+		return *x.FailureCount
+	}
+	return 0
 }
 
-func (*BatchUpdateCasbinRulesReq) ProtoMessage() {}
+func (x *OauthProviderInfo) GetLastUsedAt() int64 {
+	if x != nil && x.LastUsedAt != nil {
+		return *x.LastUsedAt
+	}
+	return 0
+}
 
-func (x *BatchUpdateCasbinRulesReq) ProtoReflect() protoreflect.Message {
-	mi := &file_core_proto_msgTypes[15]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *OauthProviderInfo) GetStatus() uint32 {
+	if x != nil && x.Status != nil {
+		return *x.Status
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use BatchUpdateCasbinRulesReq.ProtoReflect.Descriptor instead.
-func (*BatchUpdateCasbinRulesReq) Descriptor() ([]byte, []int) {
-	return file_core_proto_rawDescGZIP(), []int{15}
+func (x *OauthProviderInfo) GetTenantId() uint64 {
+	if x != nil && x.TenantId != nil {
+		return *x.TenantId
+	}
+	return 0
 }
 
-func (x *BatchUpdateCasbinRulesReq) GetRules() []*CasbinRuleInfo {
-	if x != nil {
-		return x.Rules
+func (x *OauthProviderInfo) GetTemplateId() uint64 {
+	if x != nil && x.TemplateId != nil {
+		return *x.TemplateId
 	}
-	return nil
+	return 0
 }
 
-type BindOauthAccountReq struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	UserId            string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id"`
-	ProviderType      string                 `protobuf:"bytes,2,opt,name=provider_type,json=providerType,proto3" json:"provider_type"`
-	ProviderId        uint64                 `protobuf:"varint,3,opt,name=provider_id,json=providerId,proto3" json:"provider_id"`
-	AuthorizationCode string                 `protobuf:"bytes,4,opt,name=authorization_code,json=authorizationCode,proto3" json:"authorization_code"`
-	State             string                 `protobuf:"bytes,5,opt,name=state,proto3" json:"state"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+type OauthProviderListReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          uint64                 `protobuf:"varint,1,opt,name=page,proto3" json:"page"`
+	PageSize      uint64                 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size"`
+	Name          *string                `protobuf:"bytes,3,opt,name=name,proto3,oneof" json:"name"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BindOauthAccountReq) Reset() {
-	*x = BindOauthAccountReq{}
-	mi := &file_core_proto_msgTypes[16]
+func (x *OauthProviderListReq) Reset() {
+	*x = OauthProviderListReq{}
+	mi := &file_core_proto_msgTypes[98]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BindOauthAccountReq) String() string {
+func (x *OauthProviderListReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BindOauthAccountReq) ProtoMessage() {}
+func (*OauthProviderListReq) ProtoMessage() {}
 
-func (x *BindOauthAccountReq) ProtoReflect() protoreflect.Message {
-	mi := &file_core_proto_msgTypes[16]
+func (x *OauthProviderListReq) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[98]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1302,69 +8368,55 @@ func (x *BindOauthAccountReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BindOauthAccountReq.ProtoReflect.Descriptor instead.
-func (*BindOauthAccountReq) Descriptor() ([]byte, []int) {
-	return file_core_proto_rawDescGZIP(), []int{16}
-}
-
-func (x *BindOauthAccountReq) GetUserId() string {
-	if x != nil {
-		return x.UserId
-	}
-	return ""
-}
-
-func (x *BindOauthAccountReq) GetProviderType() string {
-	if x != nil {
-		return x.ProviderType
-	}
-	return ""
+// Deprecated: Use OauthProviderListReq.ProtoReflect.Descriptor instead.
+func (*OauthProviderListReq) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{98}
 }
 
-func (x *BindOauthAccountReq) GetProviderId() uint64 {
+func (x *OauthProviderListReq) GetPage() uint64 {
 	if x != nil {
-		return x.ProviderId
+		return x.Page
 	}
 	return 0
 }
 
-func (x *BindOauthAccountReq) GetAuthorizationCode() string {
+func (x *OauthProviderListReq) GetPageSize() uint64 {
 	if x != nil {
-		return x.AuthorizationCode
+		return x.PageSize
 	}
-	return ""
+	return 0
 }
 
-func (x *BindOauthAccountReq) GetState() string {
-	if x != nil {
-		return x.State
+func (x *OauthProviderListReq) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
 	}
 	return ""
 }
 
-type CallbackReq struct {
+type OauthProviderListResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	State         string                 `protobuf:"bytes,1,opt,name=state,proto3" json:"state"`
-	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code"`
+	Total         uint64                 `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
+	Data          []*OauthProviderInfo   `protobuf:"bytes,2,rep,name=data,proto3" json:"data"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CallbackReq) Reset() {
-	*x = CallbackReq{}
-	mi := &file_core_proto_msgTypes[17]
+func (x *OauthProviderListResp) Reset() {
+	*x = OauthProviderListResp{}
+	mi := &file_core_proto_msgTypes[99]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CallbackReq) String() string {
+func (x *OauthProviderListResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CallbackReq) ProtoMessage() {}
+func (*OauthProviderListResp) ProtoMessage() {}
 
-func (x *CallbackReq) ProtoReflect() protoreflect.Message {
-	mi := &file_core_proto_msgTypes[17]
+func (x *OauthProviderListResp) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[99]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1375,80 +8427,64 @@ func (x *CallbackReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CallbackReq.ProtoReflect.Descriptor instead.
-func (*CallbackReq) Descriptor() ([]byte, []int) {
-	return file_core_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use OauthProviderListResp.ProtoReflect.Descriptor instead.
+func (*OauthProviderListResp) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{99}
 }
 
-func (x *CallbackReq) GetState() string {
+func (x *OauthProviderListResp) GetTotal() uint64 {
 	if x != nil {
-		return x.State
+		return x.Total
 	}
-	return ""
+	return 0
 }
 
-func (x *CallbackReq) GetCode() string {
+func (x *OauthProviderListResp) GetData() []*OauthProviderInfo {
 	if x != nil {
-		return x.Code
+		return x.Data
 	}
-	return ""
+	return nil
 }
 
-//  Casbin权限规则信息
-type CasbinRuleInfo struct {
-	state     protoimpl.MessageState `protogen:"open.v1"`
-	Id        *uint64                `protobuf:"varint,1,opt,name=id,proto3,oneof" json:"id"`
-	CreatedAt *int64                 `protobuf:"varint,2,opt,name=created_at,json=createdAt,proto3,oneof" json:"created_at"`
-	UpdatedAt *int64                 `protobuf:"varint,3,opt,name=updated_at,json=updatedAt,proto3,oneof" json:"updated_at"`
-	TenantId  *uint64                `protobuf:"varint,4,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id"`
-	//  Casbin标准字段 - 与原生Casbin适配器兼容
-	Ptype string  `protobuf:"bytes,5,opt,name=ptype,proto3" json:"ptype"`
-	V0    *string `protobuf:"bytes,6,opt,name=v0,proto3,oneof" json:"v0"`
-	V1    *string `protobuf:"bytes,7,opt,name=v1,proto3,oneof" json:"v1"`
-	V2    *string `protobuf:"bytes,8,opt,name=v2,proto3,oneof" json:"v2"`
-	V3    *string `protobuf:"bytes,9,opt,name=v3,proto3,oneof" json:"v3"`
-	V4    *string `protobuf:"bytes,10,opt,name=v4,proto3,oneof" json:"v4"`
-	V5    *string `protobuf:"bytes,11,opt,name=v5,proto3,oneof" json:"v5"`
-	//  业务扩展字段 - 企业级权限管理
-	ServiceName string  `protobuf:"bytes,12,opt,name=service_name,json=serviceName,proto3" json:"service_name"`
-	RuleName    *string `protobuf:"bytes,13,opt,name=rule_name,json=ruleName,proto3,oneof" json:"rule_name"`
-	Description *string `protobuf:"bytes,14,opt,name=description,proto3,oneof" json:"description"`
-	Category    *string `protobuf:"bytes,15,opt,name=category,proto3,oneof" json:"category"`
-	Version     *string `protobuf:"bytes,16,opt,name=version,proto3,oneof" json:"version"`
-	//  审批流程字段 - 企业级审批工作流
-	RequireApproval *bool   `protobuf:"varint,17,opt,name=require_approval,json=requireApproval,proto3,oneof" json:"require_approval"`
-	ApprovalStatus  *string `protobuf:"bytes,18,opt,name=approval_status,json=approvalStatus,proto3,oneof" json:"approval_status"`
-	ApprovedBy      *uint64 `protobuf:"varint,19,opt,name=approved_by,json=approvedBy,proto3,oneof" json:"approved_by"`
-	ApprovedAt      *int64  `protobuf:"varint,20,opt,name=approved_at,json=approvedAt,proto3,oneof" json:"approved_at"`
-	//  时间控制字段 - 临时权限支持
-	EffectiveFrom *int64 `protobuf:"varint,21,opt,name=effective_from,json=effectiveFrom,proto3,oneof" json:"effective_from"`
-	EffectiveTo   *int64 `protobuf:"varint,22,opt,name=effective_to,json=effectiveTo,proto3,oneof" json:"effective_to"`
-	IsTemporary   *bool  `protobuf:"varint,23,opt,name=is_temporary,json=isTemporary,proto3,oneof" json:"is_temporary"`
-	//  管理字段
-	Status        *uint32  `protobuf:"varint,24,opt,name=status,proto3,oneof" json:"status"`
-	Metadata      *string  `protobuf:"bytes,25,opt,name=metadata,proto3,oneof" json:"metadata"`
-	Tags          []string `protobuf:"bytes,26,rep,name=tags,proto3" json:"tags"`
-	UsageCount    *int64   `protobuf:"varint,27,opt,name=usage_count,json=usageCount,proto3,oneof" json:"usage_count"`
-	LastUsedAt    *int64   `protobuf:"varint,28,opt,name=last_used_at,json=lastUsedAt,proto3,oneof" json:"last_used_at"`
+type OauthProviderTemplateInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            *uint64                `protobuf:"varint,1,opt,name=id,proto3,oneof" json:"id"`
+	CreatedAt     *int64                 `protobuf:"varint,2,opt,name=created_at,json=createdAt,proto3,oneof" json:"created_at"`
+	UpdatedAt     *int64                 `protobuf:"varint,3,opt,name=updated_at,json=updatedAt,proto3,oneof" json:"updated_at"`
+	Status        *uint32                `protobuf:"varint,4,opt,name=status,proto3,oneof" json:"status"`
+	Name          *string                `protobuf:"bytes,5,opt,name=name,proto3,oneof" json:"name"`
+	DisplayName   *string                `protobuf:"bytes,6,opt,name=display_name,json=displayName,proto3,oneof" json:"display_name"`
+	Type          *string                `protobuf:"bytes,7,opt,name=type,proto3,oneof" json:"type"`
+	ProviderType  *string                `protobuf:"bytes,8,opt,name=provider_type,json=providerType,proto3,oneof" json:"provider_type"`
+	Scopes        *string                `protobuf:"bytes,9,opt,name=scopes,proto3,oneof" json:"scopes"`
+	AuthUrl       *string                `protobuf:"bytes,10,opt,name=auth_url,json=authUrl,proto3,oneof" json:"auth_url"`
+	TokenUrl      *string                `protobuf:"bytes,11,opt,name=token_url,json=tokenUrl,proto3,oneof" json:"token_url"`
+	InfoUrl       *string                `protobuf:"bytes,12,opt,name=info_url,json=infoUrl,proto3,oneof" json:"info_url"`
+	AuthStyle     *uint64                `protobuf:"varint,13,opt,name=auth_style,json=authStyle,proto3,oneof" json:"auth_style"`
+	ExtraConfig   *string                `protobuf:"bytes,14,opt,name=extra_config,json=extraConfig,proto3,oneof" json:"extra_config"`
+	SupportPkce   *bool                  `protobuf:"varint,15,opt,name=support_pkce,json=supportPkce,proto3,oneof" json:"support_pkce"`
+	IconUrl       *string                `protobuf:"bytes,16,opt,name=icon_url,json=iconUrl,proto3,oneof" json:"icon_url"`
+	Sort          *uint32                `protobuf:"varint,17,opt,name=sort,proto3,oneof" json:"sort"`
+	Remark        *string                `protobuf:"bytes,18,opt,name=remark,proto3,oneof" json:"remark"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CasbinRuleInfo) Reset() {
-	*x = CasbinRuleInfo{}
-	mi := &file_core_proto_msgTypes[18]
+func (x *OauthProviderTemplateInfo) Reset() {
+	*x = OauthProviderTemplateInfo{}
+	mi := &file_core_proto_msgTypes[100]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CasbinRuleInfo) String() string {
+func (x *OauthProviderTemplateInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CasbinRuleInfo) ProtoMessage() {}
+func (*OauthProviderTemplateInfo) ProtoMessage() {}
 
-func (x *CasbinRuleInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_core_proto_msgTypes[18]
+func (x *OauthProviderTemplateInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[100]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1459,248 +8495,279 @@ func (x *CasbinRuleInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CasbinRuleInfo.ProtoReflect.Descriptor instead.
-func (*CasbinRuleInfo) Descriptor() ([]byte, []int) {
-	return file_core_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use OauthProviderTemplateInfo.ProtoReflect.Descriptor instead.
+func (*OauthProviderTemplateInfo) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{100}
 }
 
-func (x *CasbinRuleInfo) GetId() uint64 {
+func (x *OauthProviderTemplateInfo) GetId() uint64 {
 	if x != nil && x.Id != nil {
 		return *x.Id
 	}
 	return 0
 }
 
-func (x *CasbinRuleInfo) GetCreatedAt() int64 {
+func (x *OauthProviderTemplateInfo) GetCreatedAt() int64 {
 	if x != nil && x.CreatedAt != nil {
 		return *x.CreatedAt
 	}
 	return 0
 }
 
-func (x *CasbinRuleInfo) GetUpdatedAt() int64 {
+func (x *OauthProviderTemplateInfo) GetUpdatedAt() int64 {
 	if x != nil && x.UpdatedAt != nil {
 		return *x.UpdatedAt
 	}
 	return 0
 }
 
-func (x *CasbinRuleInfo) GetTenantId() uint64 {
-	if x != nil && x.TenantId != nil {
-		return *x.TenantId
+func (x *OauthProviderTemplateInfo) GetStatus() uint32 {
+	if x != nil && x.Status != nil {
+		return *x.Status
 	}
 	return 0
 }
 
-func (x *CasbinRuleInfo) GetPtype() string {
-	if x != nil {
-		return x.Ptype
-	}
-	return ""
-}
-
-func (x *CasbinRuleInfo) GetV0() string {
-	if x != nil && x.V0 != nil {
-		return *x.V0
-	}
-	return ""
-}
-
-func (x *CasbinRuleInfo) GetV1() string {
-	if x != nil && x.V1 != nil {
-		return *x.V1
+func (x *OauthProviderTemplateInfo) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
 	}
 	return ""
 }
 
-func (x *CasbinRuleInfo) GetV2() string {
-	if x != nil && x.V2 != nil {
-		return *x.V2
+func (x *OauthProviderTemplateInfo) GetDisplayName() string {
+	if x != nil && x.DisplayName != nil {
+		return *x.DisplayName
 	}
 	return ""
 }
 
-func (x *CasbinRuleInfo) GetV3() string {
-	if x != nil && x.V3 != nil {
-		return *x.V3
+func (x *OauthProviderTemplateInfo) GetType() string {
+	if x != nil && x.Type != nil {
+		return *x.Type
 	}
 	return ""
 }
 
-func (x *CasbinRuleInfo) GetV4() string {
-	if x != nil && x.V4 != nil {
-		return *x.V4
+func (x *OauthProviderTemplateInfo) GetProviderType() string {
+	if x != nil && x.ProviderType != nil {
+		return *x.ProviderType
 	}
 	return ""
 }
 
-func (x *CasbinRuleInfo) GetV5() string {
-	if x != nil && x.V5 != nil {
-		return *x.V5
+func (x *OauthProviderTemplateInfo) GetScopes() string {
+	if x != nil && x.Scopes != nil {
+		return *x.Scopes
 	}
 	return ""
 }
 
-func (x *CasbinRuleInfo) GetServiceName() string {
-	if x != nil {
-		return x.ServiceName
+func (x *OauthProviderTemplateInfo) GetAuthUrl() string {
+	if x != nil && x.AuthUrl != nil {
+		return *x.AuthUrl
 	}
 	return ""
 }
 
-func (x *CasbinRuleInfo) GetRuleName() string {
-	if x != nil && x.RuleName != nil {
-		return *x.RuleName
+func (x *OauthProviderTemplateInfo) GetTokenUrl() string {
+	if x != nil && x.TokenUrl != nil {
+		return *x.TokenUrl
 	}
 	return ""
 }
 
-func (x *CasbinRuleInfo) GetDescription() string {
-	if x != nil && x.Description != nil {
-		return *x.Description
+func (x *OauthProviderTemplateInfo) GetInfoUrl() string {
+	if x != nil && x.InfoUrl != nil {
+		return *x.InfoUrl
 	}
 	return ""
 }
 
-func (x *CasbinRuleInfo) GetCategory() string {
-	if x != nil && x.Category != nil {
-		return *x.Category
+func (x *OauthProviderTemplateInfo) GetAuthStyle() uint64 {
+	if x != nil && x.AuthStyle != nil {
+		return *x.AuthStyle
 	}
-	return ""
+	return 0
 }
 
-func (x *CasbinRuleInfo) GetVersion() string {
-	if x != nil && x.Version != nil {
-		return *x.Version
+func (x *OauthProviderTemplateInfo) GetExtraConfig() string {
+	if x != nil && x.ExtraConfig != nil {
+		return *x.ExtraConfig
 	}
 	return ""
 }
 
-func (x *CasbinRuleInfo) GetRequireApproval() bool {
-	if x != nil && x.RequireApproval != nil {
-		return *x.RequireApproval
+func (x *OauthProviderTemplateInfo) GetSupportPkce() bool {
+	if x != nil && x.SupportPkce != nil {
+		return *x.SupportPkce
 	}
 	return false
 }
 
-func (x *CasbinRuleInfo) GetApprovalStatus() string {
-	if x != nil && x.ApprovalStatus != nil {
-		return *x.ApprovalStatus
+func (x *OauthProviderTemplateInfo) GetIconUrl() string {
+	if x != nil && x.IconUrl != nil {
+		return *x.IconUrl
 	}
 	return ""
 }
 
-func (x *CasbinRuleInfo) GetApprovedBy() uint64 {
-	if x != nil && x.ApprovedBy != nil {
-		return *x.ApprovedBy
+func (x *OauthProviderTemplateInfo) GetSort() uint32 {
+	if x != nil && x.Sort != nil {
+		return *x.Sort
 	}
 	return 0
 }
 
-func (x *CasbinRuleInfo) GetApprovedAt() int64 {
-	if x != nil && x.ApprovedAt != nil {
-		return *x.ApprovedAt
+func (x *OauthProviderTemplateInfo) GetRemark() string {
+	if x != nil && x.Remark != nil {
+		return *x.Remark
 	}
-	return 0
+	return ""
 }
 
-func (x *CasbinRuleInfo) GetEffectiveFrom() int64 {
-	if x != nil && x.EffectiveFrom != nil {
-		return *x.EffectiveFrom
+type OauthProviderTemplateListReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          uint64                 `protobuf:"varint,1,opt,name=page,proto3" json:"page"`
+	PageSize      uint64                 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size"`
+	Name          *string                `protobuf:"bytes,3,opt,name=name,proto3,oneof" json:"name"`
+	Type          *string                `protobuf:"bytes,4,opt,name=type,proto3,oneof" json:"type"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OauthProviderTemplateListReq) Reset() {
+	*x = OauthProviderTemplateListReq{}
+	mi := &file_core_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OauthProviderTemplateListReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OauthProviderTemplateListReq) ProtoMessage() {}
+
+func (x *OauthProviderTemplateListReq) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *CasbinRuleInfo) GetEffectiveTo() int64 {
-	if x != nil && x.EffectiveTo != nil {
-		return *x.EffectiveTo
+// Deprecated: Use OauthProviderTemplateListReq.ProtoReflect.Descriptor instead.
+func (*OauthProviderTemplateListReq) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *OauthProviderTemplateListReq) GetPage() uint64 {
+	if x != nil {
+		return x.Page
 	}
 	return 0
 }
 
-func (x *CasbinRuleInfo) GetIsTemporary() bool {
-	if x != nil && x.IsTemporary != nil {
-		return *x.IsTemporary
+func (x *OauthProviderTemplateListReq) GetPageSize() uint64 {
+	if x != nil {
+		return x.PageSize
 	}
-	return false
+	return 0
 }
 
-func (x *CasbinRuleInfo) GetStatus() uint32 {
-	if x != nil && x.Status != nil {
-		return *x.Status
+func (x *OauthProviderTemplateListReq) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
 	}
-	return 0
+	return ""
 }
 
-func (x *CasbinRuleInfo) GetMetadata() string {
-	if x != nil && x.Metadata != nil {
-		return *x.Metadata
+func (x *OauthProviderTemplateListReq) GetType() string {
+	if x != nil && x.Type != nil {
+		return *x.Type
 	}
 	return ""
 }
 
-func (x *CasbinRuleInfo) GetTags() []string {
+type OauthProviderTemplateListResp struct {
+	state         protoimpl.MessageState       `protogen:"open.v1"`
+	Total         uint64                       `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
+	Data          []*OauthProviderTemplateInfo `protobuf:"bytes,2,rep,name=data,proto3" json:"data"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OauthProviderTemplateListResp) Reset() {
+	*x = OauthProviderTemplateListResp{}
+	mi := &file_core_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OauthProviderTemplateListResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OauthProviderTemplateListResp) ProtoMessage() {}
+
+func (x *OauthProviderTemplateListResp) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[102]
 	if x != nil {
-		return x.Tags
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *CasbinRuleInfo) GetUsageCount() int64 {
-	if x != nil && x.UsageCount != nil {
-		return *x.UsageCount
+// Deprecated: Use OauthProviderTemplateListResp.ProtoReflect.Descriptor instead.
+func (*OauthProviderTemplateListResp) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *OauthProviderTemplateListResp) GetTotal() uint64 {
+	if x != nil {
+		return x.Total
 	}
 	return 0
 }
 
-func (x *CasbinRuleInfo) GetLastUsedAt() int64 {
-	if x != nil && x.LastUsedAt != nil {
-		return *x.LastUsedAt
+func (x *OauthProviderTemplateListResp) GetData() []*OauthProviderTemplateInfo {
+	if x != nil {
+		return x.Data
 	}
-	return 0
+	return nil
 }
 
-//  Casbin规则列表请求
-type CasbinRuleListReq struct {
-	state    protoimpl.MessageState `protogen:"open.v1"`
-	Page     uint64                 `protobuf:"varint,1,opt,name=page,proto3" json:"page"`
-	PageSize uint64                 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size"`
-	//  过滤条件
-	ServiceName    *string `protobuf:"bytes,3,opt,name=service_name,json=serviceName,proto3,oneof" json:"service_name"`
-	Ptype          *string `protobuf:"bytes,4,opt,name=ptype,proto3,oneof" json:"ptype"`
-	V0             *string `protobuf:"bytes,5,opt,name=v0,proto3,oneof" json:"v0"`
-	V1             *string `protobuf:"bytes,6,opt,name=v1,proto3,oneof" json:"v1"`
-	Status         *uint32 `protobuf:"varint,7,opt,name=status,proto3,oneof" json:"status"`
-	ApprovalStatus *string `protobuf:"bytes,8,opt,name=approval_status,json=approvalStatus,proto3,oneof" json:"approval_status"`
-	Category       *string `protobuf:"bytes,9,opt,name=category,proto3,oneof" json:"category"`
-	IsTemporary    *bool   `protobuf:"varint,10,opt,name=is_temporary,json=isTemporary,proto3,oneof" json:"is_temporary"`
-	//  时间范围过滤
-	EffectiveFromStart *int64 `protobuf:"varint,11,opt,name=effective_from_start,json=effectiveFromStart,proto3,oneof" json:"effective_from_start"`
-	EffectiveFromEnd   *int64 `protobuf:"varint,12,opt,name=effective_from_end,json=effectiveFromEnd,proto3,oneof" json:"effective_from_end"`
-	//  搜索关键词
-	Keyword *string `protobuf:"bytes,13,opt,name=keyword,proto3,oneof" json:"keyword"`
-	//  排序
-	OrderBy        *string `protobuf:"bytes,14,opt,name=order_by,json=orderBy,proto3,oneof" json:"order_by"`
-	OrderDirection *string `protobuf:"bytes,15,opt,name=order_direction,json=orderDirection,proto3,oneof" json:"order_direction"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type OauthRedirectResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CasbinRuleListReq) Reset() {
-	*x = CasbinRuleListReq{}
-	mi := &file_core_proto_msgTypes[19]
+func (x *OauthRedirectResp) Reset() {
+	*x = OauthRedirectResp{}
+	mi := &file_core_proto_msgTypes[103]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CasbinRuleListReq) String() string {
+func (x *OauthRedirectResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CasbinRuleListReq) ProtoMessage() {}
+func (*OauthRedirectResp) ProtoMessage() {}
 
-func (x *CasbinRuleListReq) ProtoReflect() protoreflect.Message {
-	mi := &file_core_proto_msgTypes[19]
+func (x *OauthRedirectResp) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[103]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1711,140 +8778,209 @@ func (x *CasbinRuleListReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CasbinRuleListReq.ProtoReflect.Descriptor instead.
-func (*CasbinRuleListReq) Descriptor() ([]byte, []int) {
-	return file_core_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use OauthRedirectResp.ProtoReflect.Descriptor instead.
+func (*OauthRedirectResp) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{103}
 }
 
-func (x *CasbinRuleListReq) GetPage() uint64 {
+func (x *OauthRedirectResp) GetUrl() string {
 	if x != nil {
-		return x.Page
+		return x.Url
 	}
-	return 0
+	return ""
 }
 
-func (x *CasbinRuleListReq) GetPageSize() uint64 {
+type OauthScopeInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            *uint64                `protobuf:"varint,1,opt,name=id,proto3,oneof" json:"id"`
+	CreatedAt     *int64                 `protobuf:"varint,2,opt,name=created_at,json=createdAt,proto3,oneof" json:"created_at"`
+	UpdatedAt     *int64                 `protobuf:"varint,3,opt,name=updated_at,json=updatedAt,proto3,oneof" json:"updated_at"`
+	Status        *uint32                `protobuf:"varint,4,opt,name=status,proto3,oneof" json:"status"`
+	TenantId      *uint64                `protobuf:"varint,5,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id"`
+	Name          *string                `protobuf:"bytes,6,opt,name=name,proto3,oneof" json:"name"`
+	Description   *string                `protobuf:"bytes,7,opt,name=description,proto3,oneof" json:"description"`
+	ApiIds        []uint64               `protobuf:"varint,8,rep,packed,name=api_ids,json=apiIds,proto3" json:"api_ids"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OauthScopeInfo) Reset() {
+	*x = OauthScopeInfo{}
+	mi := &file_core_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OauthScopeInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OauthScopeInfo) ProtoMessage() {}
+
+func (x *OauthScopeInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[104]
 	if x != nil {
-		return x.PageSize
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OauthScopeInfo.ProtoReflect.Descriptor instead.
+func (*OauthScopeInfo) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *OauthScopeInfo) GetId() uint64 {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return 0
+}
+
+func (x *OauthScopeInfo) GetCreatedAt() int64 {
+	if x != nil && x.CreatedAt != nil {
+		return *x.CreatedAt
+	}
+	return 0
+}
+
+func (x *OauthScopeInfo) GetUpdatedAt() int64 {
+	if x != nil && x.UpdatedAt != nil {
+		return *x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *OauthScopeInfo) GetStatus() uint32 {
+	if x != nil && x.Status != nil {
+		return *x.Status
 	}
 	return 0
 }
 
-func (x *CasbinRuleListReq) GetServiceName() string {
-	if x != nil && x.ServiceName != nil {
-		return *x.ServiceName
+func (x *OauthScopeInfo) GetTenantId() uint64 {
+	if x != nil && x.TenantId != nil {
+		return *x.TenantId
 	}
-	return ""
+	return 0
 }
 
-func (x *CasbinRuleListReq) GetPtype() string {
-	if x != nil && x.Ptype != nil {
-		return *x.Ptype
+func (x *OauthScopeInfo) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
 	}
 	return ""
 }
 
-func (x *CasbinRuleListReq) GetV0() string {
-	if x != nil && x.V0 != nil {
-		return *x.V0
+func (x *OauthScopeInfo) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
 	}
 	return ""
 }
 
-func (x *CasbinRuleListReq) GetV1() string {
-	if x != nil && x.V1 != nil {
-		return *x.V1
+func (x *OauthScopeInfo) GetApiIds() []uint64 {
+	if x != nil {
+		return x.ApiIds
 	}
-	return ""
+	return nil
 }
 
-func (x *CasbinRuleListReq) GetStatus() uint32 {
-	if x != nil && x.Status != nil {
-		return *x.Status
-	}
-	return 0
+type OauthScopeListReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          uint64                 `protobuf:"varint,1,opt,name=page,proto3" json:"page"`
+	PageSize      uint64                 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size"`
+	Name          *string                `protobuf:"bytes,3,opt,name=name,proto3,oneof" json:"name"`
+	Names         []string               `protobuf:"bytes,4,rep,name=names,proto3" json:"names"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CasbinRuleListReq) GetApprovalStatus() string {
-	if x != nil && x.ApprovalStatus != nil {
-		return *x.ApprovalStatus
-	}
-	return ""
+func (x *OauthScopeListReq) Reset() {
+	*x = OauthScopeListReq{}
+	mi := &file_core_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *CasbinRuleListReq) GetCategory() string {
-	if x != nil && x.Category != nil {
-		return *x.Category
-	}
-	return ""
+func (x *OauthScopeListReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *CasbinRuleListReq) GetIsTemporary() bool {
-	if x != nil && x.IsTemporary != nil {
-		return *x.IsTemporary
+func (*OauthScopeListReq) ProtoMessage() {}
+
+func (x *OauthScopeListReq) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-func (x *CasbinRuleListReq) GetEffectiveFromStart() int64 {
-	if x != nil && x.EffectiveFromStart != nil {
-		return *x.EffectiveFromStart
-	}
-	return 0
+// Deprecated: Use OauthScopeListReq.ProtoReflect.Descriptor instead.
+func (*OauthScopeListReq) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{105}
 }
 
-func (x *CasbinRuleListReq) GetEffectiveFromEnd() int64 {
-	if x != nil && x.EffectiveFromEnd != nil {
-		return *x.EffectiveFromEnd
+func (x *OauthScopeListReq) GetPage() uint64 {
+	if x != nil {
+		return x.Page
 	}
 	return 0
 }
 
-func (x *CasbinRuleListReq) GetKeyword() string {
-	if x != nil && x.Keyword != nil {
-		return *x.Keyword
+func (x *OauthScopeListReq) GetPageSize() uint64 {
+	if x != nil {
+		return x.PageSize
 	}
-	return ""
+	return 0
 }
 
-func (x *CasbinRuleListReq) GetOrderBy() string {
-	if x != nil && x.OrderBy != nil {
-		return *x.OrderBy
+func (x *OauthScopeListReq) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
 	}
 	return ""
 }
 
-func (x *CasbinRuleListReq) GetOrderDirection() string {
-	if x != nil && x.OrderDirection != nil {
-		return *x.OrderDirection
+func (x *OauthScopeListReq) GetNames() []string {
+	if x != nil {
+		return x.Names
 	}
-	return ""
+	return nil
 }
 
-//  Casbin规则列表响应
-type CasbinRuleListResp struct {
+type OauthScopeListResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Total         uint64                 `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
-	Data          []*CasbinRuleInfo      `protobuf:"bytes,2,rep,name=data,proto3" json:"data"`
+	Data          []*OauthScopeInfo      `protobuf:"bytes,2,rep,name=data,proto3" json:"data"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CasbinRuleListResp) Reset() {
-	*x = CasbinRuleListResp{}
-	mi := &file_core_proto_msgTypes[20]
+func (x *OauthScopeListResp) Reset() {
+	*x = OauthScopeListResp{}
+	mi := &file_core_proto_msgTypes[106]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CasbinRuleListResp) String() string {
+func (x *OauthScopeListResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CasbinRuleListResp) ProtoMessage() {}
+func (*OauthScopeListResp) ProtoMessage() {}
 
-func (x *CasbinRuleListResp) ProtoReflect() protoreflect.Message {
-	mi := &file_core_proto_msgTypes[20]
+func (x *OauthScopeListResp) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[106]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1855,65 +8991,70 @@ func (x *CasbinRuleListResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CasbinRuleListResp.ProtoReflect.Descriptor instead.
-func (*CasbinRuleListResp) Descriptor() ([]byte, []int) {
-	return file_core_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use OauthScopeListResp.ProtoReflect.Descriptor instead.
+func (*OauthScopeListResp) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{106}
 }
 
-func (x *CasbinRuleListResp) GetTotal() uint64 {
+func (x *OauthScopeListResp) GetTotal() uint64 {
 	if x != nil {
 		return x.Total
 	}
 	return 0
 }
 
-func (x *CasbinRuleListResp) GetData() []*CasbinRuleInfo {
+func (x *OauthScopeListResp) GetData() []*OauthScopeInfo {
 	if x != nil {
 		return x.Data
 	}
 	return nil
 }
 
-type ConfigurationInfo struct {
-	state     protoimpl.MessageState `protogen:"open.v1"`
-	Id        *uint64                `protobuf:"varint,1,opt,name=id,proto3,oneof" json:"id"`
-	CreatedAt *int64                 `protobuf:"varint,2,opt,name=created_at,json=createdAt,proto3,oneof" json:"created_at"`
-	UpdatedAt *int64                 `protobuf:"varint,3,opt,name=updated_at,json=updatedAt,proto3,oneof" json:"updated_at"`
-	//  Sort Number | 排序编号
-	Sort *uint32 `protobuf:"varint,4,opt,name=sort,proto3,oneof" json:"sort"`
-	//  State true: normal false: ban | 状态 true 正常 false 禁用
-	State *bool `protobuf:"varint,5,opt,name=state,proto3,oneof" json:"state"`
-	//  Configurarion name | 配置名称
-	Name *string `protobuf:"bytes,6,opt,name=name,proto3,oneof" json:"name"`
-	//  Configuration key | 配置的键名
-	Key *string `protobuf:"bytes,7,opt,name=key,proto3,oneof" json:"key"`
-	//  Configuraion value | 配置的值
-	Value *string `protobuf:"bytes,8,opt,name=value,proto3,oneof" json:"value"`
-	//  Configuration category | 配置的分类
-	Category *string `protobuf:"bytes,9,opt,name=category,proto3,oneof" json:"category"`
-	//  Remark | 备注
-	Remark *string `protobuf:"bytes,10,opt,name=remark,proto3,oneof" json:"remark"`
-	//  Tenant ID | 租户ID
-	TenantId      *uint64 `protobuf:"varint,11,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+//  OAuth Session messages
+type OauthSessionInfo struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Id                  *uint64                `protobuf:"varint,1,opt,name=id,proto3,oneof" json:"id"`
+	CreatedAt           *int64                 `protobuf:"varint,2,opt,name=created_at,json=createdAt,proto3,oneof" json:"created_at"`
+	UpdatedAt           *int64                 `protobuf:"varint,3,opt,name=updated_at,json=updatedAt,proto3,oneof" json:"updated_at"`
+	SessionId           *string                `protobuf:"bytes,4,opt,name=session_id,json=sessionId,proto3,oneof" json:"session_id"`
+	State               *string                `protobuf:"bytes,5,opt,name=state,proto3,oneof" json:"state"`
+	ProviderId          *uint64                `protobuf:"varint,6,opt,name=provider_id,json=providerId,proto3,oneof" json:"provider_id"`
+	UserId              *string                `protobuf:"bytes,7,opt,name=user_id,json=userId,proto3,oneof" json:"user_id"`
+	RedirectUri         *string                `protobuf:"bytes,8,opt,name=redirect_uri,json=redirectUri,proto3,oneof" json:"redirect_uri"`
+	Scope               *string                `protobuf:"bytes,9,opt,name=scope,proto3,oneof" json:"scope"`
+	CodeChallenge       *string                `protobuf:"bytes,10,opt,name=code_challenge,json=codeChallenge,proto3,oneof" json:"code_challenge"`
+	CodeChallengeMethod *string                `protobuf:"bytes,11,opt,name=code_challenge_method,json=codeChallengeMethod,proto3,oneof" json:"code_challenge_method"`
+	CodeVerifier        *string                `protobuf:"bytes,12,opt,name=code_verifier,json=codeVerifier,proto3,oneof" json:"code_verifier"`
+	ExpiresAt           *int64                 `protobuf:"varint,13,opt,name=expires_at,json=expiresAt,proto3,oneof" json:"expires_at"`
+	ClientIp            *string                `protobuf:"bytes,14,opt,name=client_ip,json=clientIp,proto3,oneof" json:"client_ip"`
+	UserAgent           *string                `protobuf:"bytes,15,opt,name=user_agent,json=userAgent,proto3,oneof" json:"user_agent"`
+	AuthorizationCode   *string                `protobuf:"bytes,16,opt,name=authorization_code,json=authorizationCode,proto3,oneof" json:"authorization_code"`
+	CodeReceivedAt      *int64                 `protobuf:"varint,17,opt,name=code_received_at,json=codeReceivedAt,proto3,oneof" json:"code_received_at"`
+	CallbackData        *string                `protobuf:"bytes,18,opt,name=callback_data,json=callbackData,proto3,oneof" json:"callback_data"`
+	ErrorCode           *string                `protobuf:"bytes,19,opt,name=error_code,json=errorCode,proto3,oneof" json:"error_code"`
+	ErrorDescription    *string                `protobuf:"bytes,20,opt,name=error_description,json=errorDescription,proto3,oneof" json:"error_description"`
+	RetryCount          *int32                 `protobuf:"varint,21,opt,name=retry_count,json=retryCount,proto3,oneof" json:"retry_count"`
+	Status              *uint32                `protobuf:"varint,22,opt,name=status,proto3,oneof" json:"status"`
+	TenantId            *uint64                `protobuf:"varint,23,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
-func (x *ConfigurationInfo) Reset() {
-	*x = ConfigurationInfo{}
-	mi := &file_core_proto_msgTypes[21]
+func (x *OauthSessionInfo) Reset() {
+	*x = OauthSessionInfo{}
+	mi := &file_core_proto_msgTypes[107]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ConfigurationInfo) String() string {
+func (x *OauthSessionInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConfigurationInfo) ProtoMessage() {}
+func (*OauthSessionInfo) ProtoMessage() {}
 
-func (x *ConfigurationInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_core_proto_msgTypes[21]
+func (x *OauthSessionInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[107]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1924,115 +9065,200 @@ func (x *ConfigurationInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ConfigurationInfo.ProtoReflect.Descriptor instead.
-func (*ConfigurationInfo) Descriptor() ([]byte, []int) {
-	return file_core_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use OauthSessionInfo.ProtoReflect.Descriptor instead.
+func (*OauthSessionInfo) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{107}
 }
 
-func (x *ConfigurationInfo) GetId() uint64 {
+func (x *OauthSessionInfo) GetId() uint64 {
 	if x != nil && x.Id != nil {
 		return *x.Id
 	}
 	return 0
 }
 
-func (x *ConfigurationInfo) GetCreatedAt() int64 {
-	if x != nil && x.CreatedAt != nil {
-		return *x.CreatedAt
+func (x *OauthSessionInfo) GetCreatedAt() int64 {
+	if x != nil && x.CreatedAt != nil {
+		return *x.CreatedAt
+	}
+	return 0
+}
+
+func (x *OauthSessionInfo) GetUpdatedAt() int64 {
+	if x != nil && x.UpdatedAt != nil {
+		return *x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *OauthSessionInfo) GetSessionId() string {
+	if x != nil && x.SessionId != nil {
+		return *x.SessionId
+	}
+	return ""
+}
+
+func (x *OauthSessionInfo) GetState() string {
+	if x != nil && x.State != nil {
+		return *x.State
+	}
+	return ""
+}
+
+func (x *OauthSessionInfo) GetProviderId() uint64 {
+	if x != nil && x.ProviderId != nil {
+		return *x.ProviderId
+	}
+	return 0
+}
+
+func (x *OauthSessionInfo) GetUserId() string {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return ""
+}
+
+func (x *OauthSessionInfo) GetRedirectUri() string {
+	if x != nil && x.RedirectUri != nil {
+		return *x.RedirectUri
+	}
+	return ""
+}
+
+func (x *OauthSessionInfo) GetScope() string {
+	if x != nil && x.Scope != nil {
+		return *x.Scope
+	}
+	return ""
+}
+
+func (x *OauthSessionInfo) GetCodeChallenge() string {
+	if x != nil && x.CodeChallenge != nil {
+		return *x.CodeChallenge
+	}
+	return ""
+}
+
+func (x *OauthSessionInfo) GetCodeChallengeMethod() string {
+	if x != nil && x.CodeChallengeMethod != nil {
+		return *x.CodeChallengeMethod
+	}
+	return ""
+}
+
+func (x *OauthSessionInfo) GetCodeVerifier() string {
+	if x != nil && x.CodeVerifier != nil {
+		return *x.CodeVerifier
+	}
+	return ""
+}
+
+func (x *OauthSessionInfo) GetExpiresAt() int64 {
+	if x != nil && x.ExpiresAt != nil {
+		return *x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *OauthSessionInfo) GetClientIp() string {
+	if x != nil && x.ClientIp != nil {
+		return *x.ClientIp
 	}
-	return 0
+	return ""
 }
 
-func (x *ConfigurationInfo) GetUpdatedAt() int64 {
-	if x != nil && x.UpdatedAt != nil {
-		return *x.UpdatedAt
+func (x *OauthSessionInfo) GetUserAgent() string {
+	if x != nil && x.UserAgent != nil {
+		return *x.UserAgent
 	}
-	return 0
+	return ""
 }
 
-func (x *ConfigurationInfo) GetSort() uint32 {
-	if x != nil && x.Sort != nil {
-		return *x.Sort
+func (x *OauthSessionInfo) GetAuthorizationCode() string {
+	if x != nil && x.AuthorizationCode != nil {
+		return *x.AuthorizationCode
 	}
-	return 0
+	return ""
 }
 
-func (x *ConfigurationInfo) GetState() bool {
-	if x != nil && x.State != nil {
-		return *x.State
+func (x *OauthSessionInfo) GetCodeReceivedAt() int64 {
+	if x != nil && x.CodeReceivedAt != nil {
+		return *x.CodeReceivedAt
 	}
-	return false
+	return 0
 }
 
-func (x *ConfigurationInfo) GetName() string {
-	if x != nil && x.Name != nil {
-		return *x.Name
+func (x *OauthSessionInfo) GetCallbackData() string {
+	if x != nil && x.CallbackData != nil {
+		return *x.CallbackData
 	}
 	return ""
 }
 
-func (x *ConfigurationInfo) GetKey() string {
-	if x != nil && x.Key != nil {
-		return *x.Key
+func (x *OauthSessionInfo) GetErrorCode() string {
+	if x != nil && x.ErrorCode != nil {
+		return *x.ErrorCode
 	}
 	return ""
 }
 
-func (x *ConfigurationInfo) GetValue() string {
-	if x != nil && x.Value != nil {
-		return *x.Value
+func (x *OauthSessionInfo) GetErrorDescription() string {
+	if x != nil && x.ErrorDescription != nil {
+		return *x.ErrorDescription
 	}
 	return ""
 }
 
-func (x *ConfigurationInfo) GetCategory() string {
-	if x != nil && x.Category != nil {
-		return *x.Category
+func (x *OauthSessionInfo) GetRetryCount() int32 {
+	if x != nil && x.RetryCount != nil {
+		return *x.RetryCount
 	}
-	return ""
+	return 0
 }
 
-func (x *ConfigurationInfo) GetRemark() string {
-	if x != nil && x.Remark != nil {
-		return *x.Remark
+func (x *OauthSessionInfo) GetStatus() uint32 {
+	if x != nil && x.Status != nil {
+		return *x.Status
 	}
-	return ""
+	return 0
 }
 
-func (x *ConfigurationInfo) GetTenantId() uint64 {
+func (x *OauthSessionInfo) GetTenantId() uint64 {
 	if x != nil && x.TenantId != nil {
 		return *x.TenantId
 	}
 	return 0
 }
 
-type ConfigurationListReq struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Page          uint64                 `protobuf:"varint,1,opt,name=page,proto3" json:"page"`
-	PageSize      uint64                 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size"`
-	Name          *string                `protobuf:"bytes,3,opt,name=name,proto3,oneof" json:"name"`
-	Key           *string                `protobuf:"bytes,4,opt,name=key,proto3,oneof" json:"key"`
-	Category      *string                `protobuf:"bytes,5,opt,name=category,proto3,oneof" json:"category"`
-	State         *bool                  `protobuf:"varint,6,opt,name=state,proto3,oneof" json:"state"`
+//  Webhook request of a provider, verified by the adapter of the provider | 第三方平台推送的 webhook 请求
+type OauthWebhookReq struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	//  Provider ID | 提供商ID
+	ProviderId uint64 `protobuf:"varint,1,opt,name=provider_id,json=providerId,proto3" json:"provider_id"`
+	//  Request headers with lower case keys | 请求头，键为小写
+	Headers map[string]string `protobuf:"bytes,2,rep,name=headers,proto3" json:"headers" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	//  Raw request body | 原始请求体
+	Body          []byte `protobuf:"bytes,3,opt,name=body,proto3" json:"body"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ConfigurationListReq) Reset() {
-	*x = ConfigurationListReq{}
-	mi := &file_core_proto_msgTypes[22]
+func (x *OauthWebhookReq) Reset() {
+	*x = OauthWebhookReq{}
+	mi := &file_core_proto_msgTypes[108]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ConfigurationListReq) String() string {
+func (x *OauthWebhookReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConfigurationListReq) ProtoMessage() {}
+func (*OauthWebhookReq) ProtoMessage() {}
 
-func (x *ConfigurationListReq) ProtoReflect() protoreflect.Message {
-	mi := &file_core_proto_msgTypes[22]
+func (x *OauthWebhookReq) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[108]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2043,76 +9269,110 @@ func (x *ConfigurationListReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ConfigurationListReq.ProtoReflect.Descriptor instead.
-func (*ConfigurationListReq) Descriptor() ([]byte, []int) {
-	return file_core_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use OauthWebhookReq.ProtoReflect.Descriptor instead.
+func (*OauthWebhookReq) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{108}
 }
 
-func (x *ConfigurationListReq) GetPage() uint64 {
+func (x *OauthWebhookReq) GetProviderId() uint64 {
 	if x != nil {
-		return x.Page
+		return x.ProviderId
 	}
 	return 0
 }
 
-func (x *ConfigurationListReq) GetPageSize() uint64 {
+func (x *OauthWebhookReq) GetHeaders() map[string]string {
 	if x != nil {
-		return x.PageSize
+		return x.Headers
 	}
-	return 0
+	return nil
 }
 
-func (x *ConfigurationListReq) GetName() string {
-	if x != nil && x.Name != nil {
-		return *x.Name
+func (x *OauthWebhookReq) GetBody() []byte {
+	if x != nil {
+		return x.Body
 	}
-	return ""
+	return nil
 }
 
-func (x *ConfigurationListReq) GetKey() string {
-	if x != nil && x.Key != nil {
-		return *x.Key
+type OauthWebhookResp struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	//  Challenge of url verification requests | 回调地址校验返回的 challenge
+	Challenge string `protobuf:"bytes,1,opt,name=challenge,proto3" json:"challenge"`
+	//  Whether the event has been processed before | 事件是否已处理过
+	Duplicate     bool `protobuf:"varint,2,opt,name=duplicate,proto3" json:"duplicate"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OauthWebhookResp) Reset() {
+	*x = OauthWebhookResp{}
+	mi := &file_core_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OauthWebhookResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OauthWebhookResp) ProtoMessage() {}
+
+func (x *OauthWebhookResp) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[109]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *ConfigurationListReq) GetCategory() string {
-	if x != nil && x.Category != nil {
-		return *x.Category
+// Deprecated: Use OauthWebhookResp.ProtoReflect.Descriptor instead.
+func (*OauthWebhookResp) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *OauthWebhookResp) GetChallenge() string {
+	if x != nil {
+		return x.Challenge
 	}
 	return ""
 }
 
-func (x *ConfigurationListReq) GetState() bool {
-	if x != nil && x.State != nil {
-		return *x.State
+func (x *OauthWebhookResp) GetDuplicate() bool {
+	if x != nil {
+		return x.Duplicate
 	}
 	return false
 }
 
-type ConfigurationListResp struct {
+type OperationTypeStats struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Total         uint64                 `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
-	Data          []*ConfigurationInfo   `protobuf:"bytes,2,rep,name=data,proto3" json:"data"`
+	OperationType string                 `protobuf:"bytes,1,opt,name=operation_type,json=operationType,proto3" json:"operation_type"`
+	Count         uint64                 `protobuf:"varint,2,opt,name=count,proto3" json:"count"`
+	Percentage    float64                `protobuf:"fixed64,3,opt,name=percentage,proto3" json:"percentage"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ConfigurationListResp) Reset() {
-	*x = ConfigurationListResp{}
-	mi := &file_core_proto_msgTypes[23]
+func (x *OperationTypeStats) Reset() {
+	*x = OperationTypeStats{}
+	mi := &file_core_proto_msgTypes[110]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ConfigurationListResp) String() string {
+func (x *OperationTypeStats) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConfigurationListResp) ProtoMessage() {}
+func (*OperationTypeStats) ProtoMessage() {}
 
-func (x *ConfigurationListResp) ProtoReflect() protoreflect.Message {
-	mi := &file_core_proto_msgTypes[23]
+func (x *OperationTypeStats) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[110]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2123,57 +9383,55 @@ func (x *ConfigurationListResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ConfigurationListResp.ProtoReflect.Descriptor instead.
-func (*ConfigurationListResp) Descriptor() ([]byte, []int) {
-	return file_core_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use OperationTypeStats.ProtoReflect.Descriptor instead.
+func (*OperationTypeStats) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{110}
 }
 
-func (x *ConfigurationListResp) GetTotal() uint64 {
+func (x *OperationTypeStats) GetOperationType() string {
 	if x != nil {
-		return x.Total
+		return x.OperationType
+	}
+	return ""
+}
+
+func (x *OperationTypeStats) GetCount() uint64 {
+	if x != nil {
+		return x.Count
 	}
 	return 0
 }
 
-func (x *ConfigurationListResp) GetData() []*ConfigurationInfo {
+func (x *OperationTypeStats) GetPercentage() float64 {
 	if x != nil {
-		return x.Data
+		return x.Percentage
 	}
-	return nil
+	return 0
 }
 
-type CreateOauthSessionReq struct {
-	state               protoimpl.MessageState `protogen:"open.v1"`
-	State               string                 `protobuf:"bytes,1,opt,name=state,proto3" json:"state"`
-	ProviderId          uint64                 `protobuf:"varint,2,opt,name=provider_id,json=providerId,proto3" json:"provider_id"`
-	UserId              *string                `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3,oneof" json:"user_id"`
-	RedirectUri         string                 `protobuf:"bytes,4,opt,name=redirect_uri,json=redirectUri,proto3" json:"redirect_uri"`
-	Scope               *string                `protobuf:"bytes,5,opt,name=scope,proto3,oneof" json:"scope"`
-	CodeChallenge       *string                `protobuf:"bytes,6,opt,name=code_challenge,json=codeChallenge,proto3,oneof" json:"code_challenge"`
-	CodeChallengeMethod *string                `protobuf:"bytes,7,opt,name=code_challenge_method,json=codeChallengeMethod,proto3,oneof" json:"code_challenge_method"`
-	CodeVerifier        *string                `protobuf:"bytes,8,opt,name=code_verifier,json=codeVerifier,proto3,oneof" json:"code_verifier"`
-	ExpiresAt           int64                  `protobuf:"varint,9,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at"`
-	ClientIp            *string                `protobuf:"bytes,10,opt,name=client_ip,json=clientIp,proto3,oneof" json:"client_ip"`
-	UserAgent           *string                `protobuf:"bytes,11,opt,name=user_agent,json=userAgent,proto3,oneof" json:"user_agent"`
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+type PageInfoReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          uint64                 `protobuf:"varint,1,opt,name=page,proto3" json:"page"`
+	PageSize      uint64                 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateOauthSessionReq) Reset() {
-	*x = CreateOauthSessionReq{}
-	mi := &file_core_proto_msgTypes[24]
+func (x *PageInfoReq) Reset() {
+	*x = PageInfoReq{}
+	mi := &file_core_proto_msgTypes[111]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateOauthSessionReq) String() string {
+func (x *PageInfoReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateOauthSessionReq) ProtoMessage() {}
+func (*PageInfoReq) ProtoMessage() {}
 
-func (x *CreateOauthSessionReq) ProtoReflect() protoreflect.Message {
-	mi := &file_core_proto_msgTypes[24]
+func (x *PageInfoReq) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[111]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2184,122 +9442,148 @@ func (x *CreateOauthSessionReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateOauthSessionReq.ProtoReflect.Descriptor instead.
-func (*CreateOauthSessionReq) Descriptor() ([]byte, []int) {
-	return file_core_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use PageInfoReq.ProtoReflect.Descriptor instead.
+func (*PageInfoReq) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{111}
 }
 
-func (x *CreateOauthSessionReq) GetState() string {
+func (x *PageInfoReq) GetPage() uint64 {
 	if x != nil {
-		return x.State
+		return x.Page
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateOauthSessionReq) GetProviderId() uint64 {
+func (x *PageInfoReq) GetPageSize() uint64 {
 	if x != nil {
-		return x.ProviderId
+		return x.PageSize
 	}
 	return 0
 }
 
-func (x *CreateOauthSessionReq) GetUserId() string {
-	if x != nil && x.UserId != nil {
-		return *x.UserId
+//  权限检查请求
+type PermissionCheckReq struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	ServiceName string                 `protobuf:"bytes,1,opt,name=service_name,json=serviceName,proto3" json:"service_name"`
+	Subject     string                 `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject"`
+	Object      string                 `protobuf:"bytes,3,opt,name=object,proto3" json:"object"`
+	Action      string                 `protobuf:"bytes,4,opt,name=action,proto3" json:"action"`
+	Context     map[string]string      `protobuf:"bytes,5,rep,name=context,proto3" json:"context" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	//  可选参数
+	EnableCache   *bool `protobuf:"varint,6,opt,name=enable_cache,json=enableCache,proto3,oneof" json:"enable_cache"`
+	AuditLog      *bool `protobuf:"varint,7,opt,name=audit_log,json=auditLog,proto3,oneof" json:"audit_log"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PermissionCheckReq) Reset() {
+	*x = PermissionCheckReq{}
+	mi := &file_core_proto_msgTypes[112]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PermissionCheckReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PermissionCheckReq) ProtoMessage() {}
+
+func (x *PermissionCheckReq) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[112]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *CreateOauthSessionReq) GetRedirectUri() string {
-	if x != nil {
-		return x.RedirectUri
-	}
-	return ""
+// Deprecated: Use PermissionCheckReq.ProtoReflect.Descriptor instead.
+func (*PermissionCheckReq) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{112}
 }
 
-func (x *CreateOauthSessionReq) GetScope() string {
-	if x != nil && x.Scope != nil {
-		return *x.Scope
+func (x *PermissionCheckReq) GetServiceName() string {
+	if x != nil {
+		return x.ServiceName
 	}
 	return ""
 }
 
-func (x *CreateOauthSessionReq) GetCodeChallenge() string {
-	if x != nil && x.CodeChallenge != nil {
-		return *x.CodeChallenge
+func (x *PermissionCheckReq) GetSubject() string {
+	if x != nil {
+		return x.Subject
 	}
 	return ""
 }
 
-func (x *CreateOauthSessionReq) GetCodeChallengeMethod() string {
-	if x != nil && x.CodeChallengeMethod != nil {
-		return *x.CodeChallengeMethod
+func (x *PermissionCheckReq) GetObject() string {
+	if x != nil {
+		return x.Object
 	}
 	return ""
 }
 
-func (x *CreateOauthSessionReq) GetCodeVerifier() string {
-	if x != nil && x.CodeVerifier != nil {
-		return *x.CodeVerifier
+func (x *PermissionCheckReq) GetAction() string {
+	if x != nil {
+		return x.Action
 	}
 	return ""
 }
 
-func (x *CreateOauthSessionReq) GetExpiresAt() int64 {
+func (x *PermissionCheckReq) GetContext() map[string]string {
 	if x != nil {
-		return x.ExpiresAt
+		return x.Context
 	}
-	return 0
+	return nil
 }
 
-func (x *CreateOauthSessionReq) GetClientIp() string {
-	if x != nil && x.ClientIp != nil {
-		return *x.ClientIp
+func (x *PermissionCheckReq) GetEnableCache() bool {
+	if x != nil && x.EnableCache != nil {
+		return *x.EnableCache
 	}
-	return ""
+	return false
 }
 
-func (x *CreateOauthSessionReq) GetUserAgent() string {
-	if x != nil && x.UserAgent != nil {
-		return *x.UserAgent
+func (x *PermissionCheckReq) GetAuditLog() bool {
+	if x != nil && x.AuditLog != nil {
+		return *x.AuditLog
 	}
-	return ""
+	return false
 }
 
-type DepartmentInfo struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            *uint64                `protobuf:"varint,1,opt,name=id,proto3,oneof" json:"id"`
-	CreatedAt     *int64                 `protobuf:"varint,2,opt,name=created_at,json=createdAt,proto3,oneof" json:"created_at"`
-	UpdatedAt     *int64                 `protobuf:"varint,3,opt,name=updated_at,json=updatedAt,proto3,oneof" json:"updated_at"`
-	Status        *uint32                `protobuf:"varint,4,opt,name=status,proto3,oneof" json:"status"`
-	Sort          *uint32                `protobuf:"varint,5,opt,name=sort,proto3,oneof" json:"sort"`
-	Name          *string                `protobuf:"bytes,6,opt,name=name,proto3,oneof" json:"name"`
-	Ancestors     *string                `protobuf:"bytes,7,opt,name=ancestors,proto3,oneof" json:"ancestors"`
-	Leader        *string                `protobuf:"bytes,8,opt,name=leader,proto3,oneof" json:"leader"`
-	Phone         *string                `protobuf:"bytes,9,opt,name=phone,proto3,oneof" json:"phone"`
-	Email         *string                `protobuf:"bytes,10,opt,name=email,proto3,oneof" json:"email"`
-	Remark        *string                `protobuf:"bytes,11,opt,name=remark,proto3,oneof" json:"remark"`
-	ParentId      *uint64                `protobuf:"varint,12,opt,name=parent_id,json=parentId,proto3,oneof" json:"parent_id"`
-	TenantId      *uint64                `protobuf:"varint,13,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+//  权限检查响应
+type PermissionCheckResp struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Allowed         bool                   `protobuf:"varint,1,opt,name=allowed,proto3" json:"allowed"`
+	Reason          string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason"`
+	AppliedRules    []string               `protobuf:"bytes,3,rep,name=applied_rules,json=appliedRules,proto3" json:"applied_rules"`
+	DataFilters     map[string]string      `protobuf:"bytes,4,rep,name=data_filters,json=dataFilters,proto3" json:"data_filters" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	FieldMasks      []string               `protobuf:"bytes,5,rep,name=field_masks,json=fieldMasks,proto3" json:"field_masks"`
+	CheckDurationMs int64                  `protobuf:"varint,6,opt,name=check_duration_ms,json=checkDurationMs,proto3" json:"check_duration_ms"`
+	FromCache       bool                   `protobuf:"varint,7,opt,name=from_cache,json=fromCache,proto3" json:"from_cache"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *DepartmentInfo) Reset() {
-	*x = DepartmentInfo{}
-	mi := &file_core_proto_msgTypes[25]
+func (x *PermissionCheckResp) Reset() {
+	*x = PermissionCheckResp{}
+	mi := &file_core_proto_msgTypes[113]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DepartmentInfo) String() string {
+func (x *PermissionCheckResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DepartmentInfo) ProtoMessage() {}
+func (*PermissionCheckResp) ProtoMessage() {}
 
-func (x *DepartmentInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_core_proto_msgTypes[25]
+func (x *PermissionCheckResp) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[113]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2310,128 +9594,86 @@ func (x *DepartmentInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DepartmentInfo.ProtoReflect.Descriptor instead.
-func (*DepartmentInfo) Descriptor() ([]byte, []int) {
-	return file_core_proto_rawDescGZIP(), []int{25}
-}
-
-func (x *DepartmentInfo) GetId() uint64 {
-	if x != nil && x.Id != nil {
-		return *x.Id
-	}
-	return 0
-}
-
-func (x *DepartmentInfo) GetCreatedAt() int64 {
-	if x != nil && x.CreatedAt != nil {
-		return *x.CreatedAt
-	}
-	return 0
-}
-
-func (x *DepartmentInfo) GetUpdatedAt() int64 {
-	if x != nil && x.UpdatedAt != nil {
-		return *x.UpdatedAt
-	}
-	return 0
-}
-
-func (x *DepartmentInfo) GetStatus() uint32 {
-	if x != nil && x.Status != nil {
-		return *x.Status
-	}
-	return 0
-}
-
-func (x *DepartmentInfo) GetSort() uint32 {
-	if x != nil && x.Sort != nil {
-		return *x.Sort
-	}
-	return 0
-}
-
-func (x *DepartmentInfo) GetName() string {
-	if x != nil && x.Name != nil {
-		return *x.Name
-	}
-	return ""
+// Deprecated: Use PermissionCheckResp.ProtoReflect.Descriptor instead.
+func (*PermissionCheckResp) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{113}
 }
 
-func (x *DepartmentInfo) GetAncestors() string {
-	if x != nil && x.Ancestors != nil {
-		return *x.Ancestors
+func (x *PermissionCheckResp) GetAllowed() bool {
+	if x != nil {
+		return x.Allowed
 	}
-	return ""
+	return false
 }
 
-func (x *DepartmentInfo) GetLeader() string {
-	if x != nil && x.Leader != nil {
-		return *x.Leader
+func (x *PermissionCheckResp) GetReason() string {
+	if x != nil {
+		return x.Reason
 	}
 	return ""
 }
 
-func (x *DepartmentInfo) GetPhone() string {
-	if x != nil && x.Phone != nil {
-		return *x.Phone
+func (x *PermissionCheckResp) GetAppliedRules() []string {
+	if x != nil {
+		return x.AppliedRules
 	}
-	return ""
+	return nil
 }
 
-func (x *DepartmentInfo) GetEmail() string {
-	if x != nil && x.Email != nil {
-		return *x.Email
+func (x *PermissionCheckResp) GetDataFilters() map[string]string {
+	if x != nil {
+		return x.DataFilters
 	}
-	return ""
+	return nil
 }
 
-func (x *DepartmentInfo) GetRemark() string {
-	if x != nil && x.Remark != nil {
-		return *x.Remark
+func (x *PermissionCheckResp) GetFieldMasks() []string {
+	if x != nil {
+		return x.FieldMasks
 	}
-	return ""
+	return nil
 }
 
-func (x *DepartmentInfo) GetParentId() uint64 {
-	if x != nil && x.ParentId != nil {
-		return *x.ParentId
+func (x *PermissionCheckResp) GetCheckDurationMs() int64 {
+	if x != nil {
+		return x.CheckDurationMs
 	}
 	return 0
 }
 
-func (x *DepartmentInfo) GetTenantId() uint64 {
-	if x != nil && x.TenantId != nil {
-		return *x.TenantId
+func (x *PermissionCheckResp) GetFromCache() bool {
+	if x != nil {
+		return x.FromCache
 	}
-	return 0
+	return false
 }
 
-type DepartmentListReq struct {
+//  权限摘要信息
+type PermissionSummary struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Page          uint64                 `protobuf:"varint,1,opt,name=page,proto3" json:"page"`
-	PageSize      uint64                 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size"`
-	Name          *string                `protobuf:"bytes,3,opt,name=name,proto3,oneof" json:"name"`
-	Leader        *string                `protobuf:"bytes,4,opt,name=leader,proto3,oneof" json:"leader"`
-	Status        *uint32                `protobuf:"varint,5,opt,name=status,proto3,oneof" json:"status"`
+	Resource      string                 `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource"`
+	Actions       []string               `protobuf:"bytes,2,rep,name=actions,proto3" json:"actions"`
+	Source        string                 `protobuf:"bytes,3,opt,name=source,proto3" json:"source"`
+	RuleId        *string                `protobuf:"bytes,4,opt,name=rule_id,json=ruleId,proto3,oneof" json:"rule_id"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DepartmentListReq) Reset() {
-	*x = DepartmentListReq{}
-	mi := &file_core_proto_msgTypes[26]
+func (x *PermissionSummary) Reset() {
+	*x = PermissionSummary{}
+	mi := &file_core_proto_msgTypes[114]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DepartmentListReq) String() string {
+func (x *PermissionSummary) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DepartmentListReq) ProtoMessage() {}
+func (*PermissionSummary) ProtoMessage() {}
 
-func (x *DepartmentListReq) ProtoReflect() protoreflect.Message {
-	mi := &file_core_proto_msgTypes[26]
+func (x *PermissionSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[114]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2442,69 +9684,65 @@ func (x *DepartmentListReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DepartmentListReq.ProtoReflect.Descriptor instead.
-func (*DepartmentListReq) Descriptor() ([]byte, []int) {
-	return file_core_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use PermissionSummary.ProtoReflect.Descriptor instead.
+func (*PermissionSummary) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{114}
 }
 
-func (x *DepartmentListReq) GetPage() uint64 {
+func (x *PermissionSummary) GetResource() string {
 	if x != nil {
-		return x.Page
+		return x.Resource
 	}
-	return 0
+	return ""
 }
 
-func (x *DepartmentListReq) GetPageSize() uint64 {
+func (x *PermissionSummary) GetActions() []string {
 	if x != nil {
-		return x.PageSize
-	}
-	return 0
-}
-
-func (x *DepartmentListReq) GetName() string {
-	if x != nil && x.Name != nil {
-		return *x.Name
+		return x.Actions
 	}
-	return ""
+	return nil
 }
 
-func (x *DepartmentListReq) GetLeader() string {
-	if x != nil && x.Leader != nil {
-		return *x.Leader
+func (x *PermissionSummary) GetSource() string {
+	if x != nil {
+		return x.Source
 	}
 	return ""
 }
 
-func (x *DepartmentListReq) GetStatus() uint32 {
-	if x != nil && x.Status != nil {
-		return *x.Status
+func (x *PermissionSummary) GetRuleId() string {
+	if x != nil && x.RuleId != nil {
+		return *x.RuleId
 	}
-	return 0
+	return ""
 }
 
-type DepartmentListResp struct {
+//  one change of the policy bundle import
+type PolicyBundleChange struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Total         uint64                 `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
-	Data          []*DepartmentInfo      `protobuf:"bytes,2,rep,name=data,proto3" json:"data"`
+	Kind          string                 `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind"`
+	Action        string                 `protobuf:"bytes,2,opt,name=action,proto3" json:"action"`
+	Role          string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role"`
+	Target        string                 `protobuf:"bytes,4,opt,name=target,proto3" json:"target"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DepartmentListResp) Reset() {
-	*x = DepartmentListResp{}
-	mi := &file_core_proto_msgTypes[27]
+func (x *PolicyBundleChange) Reset() {
+	*x = PolicyBundleChange{}
+	mi := &file_core_proto_msgTypes[115]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DepartmentListResp) String() string {
+func (x *PolicyBundleChange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DepartmentListResp) ProtoMessage() {}
+func (*PolicyBundleChange) ProtoMessage() {}
 
-func (x *DepartmentListResp) ProtoReflect() protoreflect.Message {
-	mi := &file_core_proto_msgTypes[27]
+func (x *PolicyBundleChange) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[115]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2515,59 +9753,63 @@ func (x *DepartmentListResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DepartmentListResp.ProtoReflect.Descriptor instead.
-func (*DepartmentListResp) Descriptor() ([]byte, []int) {
-	return file_core_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use PolicyBundleChange.ProtoReflect.Descriptor instead.
+func (*PolicyBundleChange) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{115}
 }
 
-func (x *DepartmentListResp) GetTotal() uint64 {
+func (x *PolicyBundleChange) GetKind() string {
 	if x != nil {
-		return x.Total
+		return x.Kind
 	}
-	return 0
+	return ""
 }
 
-func (x *DepartmentListResp) GetData() []*DepartmentInfo {
+func (x *PolicyBundleChange) GetAction() string {
 	if x != nil {
-		return x.Data
+		return x.Action
 	}
-	return nil
+	return ""
 }
 
-type DictionaryDetailInfo struct {
+func (x *PolicyBundleChange) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *PolicyBundleChange) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+//  export the tenant's roles and permissions as a policy bundle, role_codes is empty to export all roles
+type PolicyBundleExportReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            *uint64                `protobuf:"varint,1,opt,name=id,proto3,oneof" json:"id"`
-	CreatedAt     *int64                 `protobuf:"varint,2,opt,name=created_at,json=createdAt,proto3,oneof" json:"created_at"`
-	UpdatedAt     *int64                 `protobuf:"varint,3,opt,name=updated_at,json=updatedAt,proto3,oneof" json:"updated_at"`
-	Status        *uint32                `protobuf:"varint,4,opt,name=status,proto3,oneof" json:"status"`
-	Title         *string                `protobuf:"bytes,5,opt,name=title,proto3,oneof" json:"title"`
-	Key           *string                `protobuf:"bytes,6,opt,name=key,proto3,oneof" json:"key"`
-	Value         *string                `protobuf:"bytes,7,opt,name=value,proto3,oneof" json:"value"`
-	DictionaryId  *uint64                `protobuf:"varint,8,opt,name=dictionary_id,json=dictionaryId,proto3,oneof" json:"dictionary_id"`
-	Sort          *uint32                `protobuf:"varint,9,opt,name=sort,proto3,oneof" json:"sort"`
-	IsDefault     *uint32                `protobuf:"varint,10,opt,name=is_default,json=isDefault,proto3,oneof" json:"is_default"`
-	CssClass      *string                `protobuf:"bytes,11,opt,name=css_class,json=cssClass,proto3,oneof" json:"css_class"`
-	ListClass     *string                `protobuf:"bytes,12,opt,name=list_class,json=listClass,proto3,oneof" json:"list_class"`
-	TenantId      *uint64                `protobuf:"varint,13,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id"`
+	Format        *string                `protobuf:"bytes,1,opt,name=format,proto3,oneof" json:"format"`
+	RoleCodes     []string               `protobuf:"bytes,2,rep,name=role_codes,json=roleCodes,proto3" json:"role_codes"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DictionaryDetailInfo) Reset() {
-	*x = DictionaryDetailInfo{}
-	mi := &file_core_proto_msgTypes[28]
+func (x *PolicyBundleExportReq) Reset() {
+	*x = PolicyBundleExportReq{}
+	mi := &file_core_proto_msgTypes[116]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DictionaryDetailInfo) String() string {
+func (x *PolicyBundleExportReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DictionaryDetailInfo) ProtoMessage() {}
+func (*PolicyBundleExportReq) ProtoMessage() {}
 
-func (x *DictionaryDetailInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_core_proto_msgTypes[28]
+func (x *PolicyBundleExportReq) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[116]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2578,127 +9820,110 @@ func (x *DictionaryDetailInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DictionaryDetailInfo.ProtoReflect.Descriptor instead.
-func (*DictionaryDetailInfo) Descriptor() ([]byte, []int) {
-	return file_core_proto_rawDescGZIP(), []int{28}
-}
-
-func (x *DictionaryDetailInfo) GetId() uint64 {
-	if x != nil && x.Id != nil {
-		return *x.Id
-	}
-	return 0
-}
-
-func (x *DictionaryDetailInfo) GetCreatedAt() int64 {
-	if x != nil && x.CreatedAt != nil {
-		return *x.CreatedAt
-	}
-	return 0
+// Deprecated: Use PolicyBundleExportReq.ProtoReflect.Descriptor instead.
+func (*PolicyBundleExportReq) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{116}
 }
 
-func (x *DictionaryDetailInfo) GetUpdatedAt() int64 {
-	if x != nil && x.UpdatedAt != nil {
-		return *x.UpdatedAt
+func (x *PolicyBundleExportReq) GetFormat() string {
+	if x != nil && x.Format != nil {
+		return *x.Format
 	}
-	return 0
+	return ""
 }
 
-func (x *DictionaryDetailInfo) GetStatus() uint32 {
-	if x != nil && x.Status != nil {
-		return *x.Status
+func (x *PolicyBundleExportReq) GetRoleCodes() []string {
+	if x != nil {
+		return x.RoleCodes
 	}
-	return 0
+	return nil
 }
 
-func (x *DictionaryDetailInfo) GetTitle() string {
-	if x != nil && x.Title != nil {
-		return *x.Title
-	}
-	return ""
+//  import a policy bundle, dry_run only returns the changes
+type PolicyBundleImportReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Format        *string                `protobuf:"bytes,1,opt,name=format,proto3,oneof" json:"format"`
+	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content"`
+	DryRun        bool                   `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DictionaryDetailInfo) GetKey() string {
-	if x != nil && x.Key != nil {
-		return *x.Key
-	}
-	return ""
+func (x *PolicyBundleImportReq) Reset() {
+	*x = PolicyBundleImportReq{}
+	mi := &file_core_proto_msgTypes[117]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *DictionaryDetailInfo) GetValue() string {
-	if x != nil && x.Value != nil {
-		return *x.Value
-	}
-	return ""
+func (x *PolicyBundleImportReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *DictionaryDetailInfo) GetDictionaryId() uint64 {
-	if x != nil && x.DictionaryId != nil {
-		return *x.DictionaryId
-	}
-	return 0
-}
+func (*PolicyBundleImportReq) ProtoMessage() {}
 
-func (x *DictionaryDetailInfo) GetSort() uint32 {
-	if x != nil && x.Sort != nil {
-		return *x.Sort
+func (x *PolicyBundleImportReq) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[117]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *DictionaryDetailInfo) GetIsDefault() uint32 {
-	if x != nil && x.IsDefault != nil {
-		return *x.IsDefault
-	}
-	return 0
+// Deprecated: Use PolicyBundleImportReq.ProtoReflect.Descriptor instead.
+func (*PolicyBundleImportReq) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{117}
 }
 
-func (x *DictionaryDetailInfo) GetCssClass() string {
-	if x != nil && x.CssClass != nil {
-		return *x.CssClass
+func (x *PolicyBundleImportReq) GetFormat() string {
+	if x != nil && x.Format != nil {
+		return *x.Format
 	}
 	return ""
 }
 
-func (x *DictionaryDetailInfo) GetListClass() string {
-	if x != nil && x.ListClass != nil {
-		return *x.ListClass
+func (x *PolicyBundleImportReq) GetContent() string {
+	if x != nil {
+		return x.Content
 	}
 	return ""
 }
 
-func (x *DictionaryDetailInfo) GetTenantId() uint64 {
-	if x != nil && x.TenantId != nil {
-		return *x.TenantId
+func (x *PolicyBundleImportReq) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
 	}
-	return 0
+	return false
 }
 
-type DictionaryDetailListReq struct {
+//  return the changes and whether they are applied
+type PolicyBundleImportResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Page          uint64                 `protobuf:"varint,1,opt,name=page,proto3" json:"page"`
-	PageSize      uint64                 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size"`
-	DictionaryId  *uint64                `protobuf:"varint,3,opt,name=dictionary_id,json=dictionaryId,proto3,oneof" json:"dictionary_id"`
-	Title         *string                `protobuf:"bytes,4,opt,name=Title,proto3,oneof" json:"Title"`
+	Applied       bool                   `protobuf:"varint,1,opt,name=applied,proto3" json:"applied"`
+	Data          []*PolicyBundleChange  `protobuf:"bytes,2,rep,name=data,proto3" json:"data"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DictionaryDetailListReq) Reset() {
-	*x = DictionaryDetailListReq{}
-	mi := &file_core_proto_msgTypes[29]
+func (x *PolicyBundleImportResp) Reset() {
+	*x = PolicyBundleImportResp{}
+	mi := &file_core_proto_msgTypes[118]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DictionaryDetailListReq) String() string {
+func (x *PolicyBundleImportResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DictionaryDetailListReq) ProtoMessage() {}
+func (*PolicyBundleImportResp) ProtoMessage() {}
 
-func (x *DictionaryDetailListReq) ProtoReflect() protoreflect.Message {
-	mi := &file_core_proto_msgTypes[29]
+func (x *PolicyBundleImportResp) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[118]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2709,62 +9934,49 @@ func (x *DictionaryDetailListReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DictionaryDetailListReq.ProtoReflect.Descriptor instead.
-func (*DictionaryDetailListReq) Descriptor() ([]byte, []int) {
-	return file_core_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use PolicyBundleImportResp.ProtoReflect.Descriptor instead.
+func (*PolicyBundleImportResp) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{118}
 }
 
-func (x *DictionaryDetailListReq) GetPage() uint64 {
+func (x *PolicyBundleImportResp) GetApplied() bool {
 	if x != nil {
-		return x.Page
+		return x.Applied
 	}
-	return 0
+	return false
 }
 
-func (x *DictionaryDetailListReq) GetPageSize() uint64 {
+func (x *PolicyBundleImportResp) GetData() []*PolicyBundleChange {
 	if x != nil {
-		return x.PageSize
-	}
-	return 0
-}
-
-func (x *DictionaryDetailListReq) GetDictionaryId() uint64 {
-	if x != nil && x.DictionaryId != nil {
-		return *x.DictionaryId
-	}
-	return 0
-}
-
-func (x *DictionaryDetailListReq) GetTitle() string {
-	if x != nil && x.Title != nil {
-		return *x.Title
+		return x.Data
 	}
-	return ""
+	return nil
 }
 
-type DictionaryDetailListResp struct {
-	state         protoimpl.MessageState  `protogen:"open.v1"`
-	Total         uint64                  `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
-	Data          []*DictionaryDetailInfo `protobuf:"bytes,2,rep,name=data,proto3" json:"data"`
+//  the policy bundle content in yaml or json format
+type PolicyBundleResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Format        string                 `protobuf:"bytes,1,opt,name=format,proto3" json:"format"`
+	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DictionaryDetailListResp) Reset() {
-	*x = DictionaryDetailListResp{}
-	mi := &file_core_proto_msgTypes[30]
+func (x *PolicyBundleResp) Reset() {
+	*x = PolicyBundleResp{}
+	mi := &file_core_proto_msgTypes[119]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DictionaryDetailListResp) String() string {
+func (x *PolicyBundleResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DictionaryDetailListResp) ProtoMessage() {}
+func (*PolicyBundleResp) ProtoMessage() {}
 
-func (x *DictionaryDetailListResp) ProtoReflect() protoreflect.Message {
-	mi := &file_core_proto_msgTypes[30]
+func (x *PolicyBundleResp) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[119]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2775,54 +9987,56 @@ func (x *DictionaryDetailListResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DictionaryDetailListResp.ProtoReflect.Descriptor instead.
-func (*DictionaryDetailListResp) Descriptor() ([]byte, []int) {
-	return file_core_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use PolicyBundleResp.ProtoReflect.Descriptor instead.
+func (*PolicyBundleResp) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{119}
 }
 
-func (x *DictionaryDetailListResp) GetTotal() uint64 {
+func (x *PolicyBundleResp) GetFormat() string {
 	if x != nil {
-		return x.Total
+		return x.Format
 	}
-	return 0
+	return ""
 }
 
-func (x *DictionaryDetailListResp) GetData() []*DictionaryDetailInfo {
+func (x *PolicyBundleResp) GetContent() string {
 	if x != nil {
-		return x.Data
+		return x.Content
 	}
-	return nil
+	return ""
 }
 
-type DictionaryInfo struct {
+type PositionInfo struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            *uint64                `protobuf:"varint,1,opt,name=id,proto3,oneof" json:"id"`
 	CreatedAt     *int64                 `protobuf:"varint,2,opt,name=created_at,json=createdAt,proto3,oneof" json:"created_at"`
 	UpdatedAt     *int64                 `protobuf:"varint,3,opt,name=updated_at,json=updatedAt,proto3,oneof" json:"updated_at"`
 	Status        *uint32                `protobuf:"varint,4,opt,name=status,proto3,oneof" json:"status"`
-	Title         *string                `protobuf:"bytes,5,opt,name=title,proto3,oneof" json:"title"`
+	Sort          *uint32                `protobuf:"varint,5,opt,name=sort,proto3,oneof" json:"sort"`
 	Name          *string                `protobuf:"bytes,6,opt,name=name,proto3,oneof" json:"name"`
-	Desc          *string                `protobuf:"bytes,7,opt,name=desc,proto3,oneof" json:"desc"`
-	TenantId      *uint64                `protobuf:"varint,8,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id"`
+	Code          *string                `protobuf:"bytes,7,opt,name=code,proto3,oneof" json:"code"`
+	Remark        *string                `protobuf:"bytes,8,opt,name=remark,proto3,oneof" json:"remark"`
+	DeptId        *uint64                `protobuf:"varint,10,opt,name=dept_id,json=deptId,proto3,oneof" json:"dept_id"`
+	TenantId      *uint64                `protobuf:"varint,11,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DictionaryInfo) Reset() {
-	*x = DictionaryInfo{}
-	mi := &file_core_proto_msgTypes[31]
+func (x *PositionInfo) Reset() {
+	*x = PositionInfo{}
+	mi := &file_core_proto_msgTypes[120]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DictionaryInfo) String() string {
+func (x *PositionInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DictionaryInfo) ProtoMessage() {}
+func (*PositionInfo) ProtoMessage() {}
 
-func (x *DictionaryInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_core_proto_msgTypes[31]
+func (x *PositionInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[120]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2833,92 +10047,108 @@ func (x *DictionaryInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DictionaryInfo.ProtoReflect.Descriptor instead.
-func (*DictionaryInfo) Descriptor() ([]byte, []int) {
-	return file_core_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use PositionInfo.ProtoReflect.Descriptor instead.
+func (*PositionInfo) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{120}
 }
 
-func (x *DictionaryInfo) GetId() uint64 {
+func (x *PositionInfo) GetId() uint64 {
 	if x != nil && x.Id != nil {
 		return *x.Id
 	}
 	return 0
 }
 
-func (x *DictionaryInfo) GetCreatedAt() int64 {
+func (x *PositionInfo) GetCreatedAt() int64 {
 	if x != nil && x.CreatedAt != nil {
 		return *x.CreatedAt
 	}
 	return 0
 }
 
-func (x *DictionaryInfo) GetUpdatedAt() int64 {
+func (x *PositionInfo) GetUpdatedAt() int64 {
 	if x != nil && x.UpdatedAt != nil {
 		return *x.UpdatedAt
 	}
 	return 0
 }
 
-func (x *DictionaryInfo) GetStatus() uint32 {
+func (x *PositionInfo) GetStatus() uint32 {
 	if x != nil && x.Status != nil {
 		return *x.Status
 	}
 	return 0
 }
 
-func (x *DictionaryInfo) GetTitle() string {
-	if x != nil && x.Title != nil {
-		return *x.Title
+func (x *PositionInfo) GetSort() uint32 {
+	if x != nil && x.Sort != nil {
+		return *x.Sort
+	}
+	return 0
+}
+
+func (x *PositionInfo) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *PositionInfo) GetCode() string {
+	if x != nil && x.Code != nil {
+		return *x.Code
 	}
 	return ""
 }
 
-func (x *DictionaryInfo) GetName() string {
-	if x != nil && x.Name != nil {
-		return *x.Name
+func (x *PositionInfo) GetRemark() string {
+	if x != nil && x.Remark != nil {
+		return *x.Remark
 	}
 	return ""
 }
 
-func (x *DictionaryInfo) GetDesc() string {
-	if x != nil && x.Desc != nil {
-		return *x.Desc
+func (x *PositionInfo) GetDeptId() uint64 {
+	if x != nil && x.DeptId != nil {
+		return *x.DeptId
 	}
-	return ""
+	return 0
 }
 
-func (x *DictionaryInfo) GetTenantId() uint64 {
+func (x *PositionInfo) GetTenantId() uint64 {
 	if x != nil && x.TenantId != nil {
 		return *x.TenantId
 	}
 	return 0
 }
 
-type DictionaryListReq struct {
+type PositionListReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Page          uint64                 `protobuf:"varint,1,opt,name=page,proto3" json:"page"`
 	PageSize      uint64                 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size"`
 	Name          *string                `protobuf:"bytes,3,opt,name=name,proto3,oneof" json:"name"`
-	Title         *string                `protobuf:"bytes,4,opt,name=title,proto3,oneof" json:"title"`
+	Code          *string                `protobuf:"bytes,4,opt,name=code,proto3,oneof" json:"code"`
+	Remark        *string                `protobuf:"bytes,5,opt,name=remark,proto3,oneof" json:"remark"`
+	BelongDeptId  *uint64                `protobuf:"varint,6,opt,name=belongDeptId,proto3,oneof" json:"belongDeptId"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DictionaryListReq) Reset() {
-	*x = DictionaryListReq{}
-	mi := &file_core_proto_msgTypes[32]
+func (x *PositionListReq) Reset() {
+	*x = PositionListReq{}
+	mi := &file_core_proto_msgTypes[121]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DictionaryListReq) String() string {
+func (x *PositionListReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DictionaryListReq) ProtoMessage() {}
+func (*PositionListReq) ProtoMessage() {}
 
-func (x *DictionaryListReq) ProtoReflect() protoreflect.Message {
-	mi := &file_core_proto_msgTypes[32]
+func (x *PositionListReq) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[121]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2929,62 +10159,76 @@ func (x *DictionaryListReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DictionaryListReq.ProtoReflect.Descriptor instead.
-func (*DictionaryListReq) Descriptor() ([]byte, []int) {
-	return file_core_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use PositionListReq.ProtoReflect.Descriptor instead.
+func (*PositionListReq) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{121}
 }
 
-func (x *DictionaryListReq) GetPage() uint64 {
+func (x *PositionListReq) GetPage() uint64 {
 	if x != nil {
 		return x.Page
 	}
 	return 0
 }
 
-func (x *DictionaryListReq) GetPageSize() uint64 {
+func (x *PositionListReq) GetPageSize() uint64 {
 	if x != nil {
 		return x.PageSize
 	}
 	return 0
 }
 
-func (x *DictionaryListReq) GetName() string {
+func (x *PositionListReq) GetName() string {
 	if x != nil && x.Name != nil {
 		return *x.Name
 	}
 	return ""
 }
 
-func (x *DictionaryListReq) GetTitle() string {
-	if x != nil && x.Title != nil {
-		return *x.Title
+func (x *PositionListReq) GetCode() string {
+	if x != nil && x.Code != nil {
+		return *x.Code
 	}
 	return ""
 }
 
-type DictionaryListResp struct {
+func (x *PositionListReq) GetRemark() string {
+	if x != nil && x.Remark != nil {
+		return *x.Remark
+	}
+	return ""
+}
+
+func (x *PositionListReq) GetBelongDeptId() uint64 {
+	if x != nil && x.BelongDeptId != nil {
+		return *x.BelongDeptId
+	}
+	return 0
+}
+
+type PositionListResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Total         uint64                 `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
-	Data          []*DictionaryInfo      `protobuf:"bytes,2,rep,name=data,proto3" json:"data"`
+	Data          []*PositionInfo        `protobuf:"bytes,2,rep,name=data,proto3" json:"data"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DictionaryListResp) Reset() {
-	*x = DictionaryListResp{}
-	mi := &file_core_proto_msgTypes[33]
+func (x *PositionListResp) Reset() {
+	*x = PositionListResp{}
+	mi := &file_core_proto_msgTypes[122]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DictionaryListResp) String() string {
+func (x *PositionListResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DictionaryListResp) ProtoMessage() {}
+func (*PositionListResp) ProtoMessage() {}
 
-func (x *DictionaryListResp) ProtoReflect() protoreflect.Message {
-	mi := &file_core_proto_msgTypes[33]
+func (x *PositionListResp) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[122]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2995,49 +10239,49 @@ func (x *DictionaryListResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DictionaryListResp.ProtoReflect.Descriptor instead.
-func (*DictionaryListResp) Descriptor() ([]byte, []int) {
-	return file_core_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use PositionListResp.ProtoReflect.Descriptor instead.
+func (*PositionListResp) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{122}
 }
 
-func (x *DictionaryListResp) GetTotal() uint64 {
+func (x *PositionListResp) GetTotal() uint64 {
 	if x != nil {
 		return x.Total
 	}
 	return 0
 }
 
-func (x *DictionaryListResp) GetData() []*DictionaryInfo {
+func (x *PositionListResp) GetData() []*PositionInfo {
 	if x != nil {
 		return x.Data
 	}
 	return nil
 }
 
-type DurationStats struct {
+type PublicTenantInfo struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	RangeLabel    string                 `protobuf:"bytes,1,opt,name=range_label,json=rangeLabel,proto3" json:"range_label"`
-	Count         uint64                 `protobuf:"varint,2,opt,name=count,proto3" json:"count"`
-	Percentage    float64                `protobuf:"fixed64,3,opt,name=percentage,proto3" json:"percentage"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id"`
+	CompanyName   string                 `protobuf:"bytes,2,opt,name=company_name,json=companyName,proto3" json:"company_name"`
+	Domain        *string                `protobuf:"bytes,3,opt,name=domain,proto3,oneof" json:"domain"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DurationStats) Reset() {
-	*x = DurationStats{}
-	mi := &file_core_proto_msgTypes[34]
+func (x *PublicTenantInfo) Reset() {
+	*x = PublicTenantInfo{}
+	mi := &file_core_proto_msgTypes[123]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DurationStats) String() string {
+func (x *PublicTenantInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DurationStats) ProtoMessage() {}
+func (*PublicTenantInfo) ProtoMessage() {}
 
-func (x *DurationStats) ProtoReflect() protoreflect.Message {
-	mi := &file_core_proto_msgTypes[34]
+func (x *PublicTenantInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[123]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3048,54 +10292,55 @@ func (x *DurationStats) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DurationStats.ProtoReflect.Descriptor instead.
-func (*DurationStats) Descriptor() ([]byte, []int) {
-	return file_core_proto_rawDescGZIP(), []int{34}
+// Deprecated: Use PublicTenantInfo.ProtoReflect.Descriptor instead.
+func (*PublicTenantInfo) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{123}
 }
 
-func (x *DurationStats) GetRangeLabel() string {
+func (x *PublicTenantInfo) GetTenantId() string {
 	if x != nil {
-		return x.RangeLabel
+		return x.TenantId
 	}
 	return ""
 }
 
-func (x *DurationStats) GetCount() uint64 {
+func (x *PublicTenantInfo) GetCompanyName() string {
 	if x != nil {
-		return x.Count
+		return x.CompanyName
 	}
-	return 0
+	return ""
 }
 
-func (x *DurationStats) GetPercentage() float64 {
-	if x != nil {
-		return x.Percentage
+func (x *PublicTenantInfo) GetDomain() string {
+	if x != nil && x.Domain != nil {
+		return *x.Domain
 	}
-	return 0
+	return ""
 }
 
-//  base message
-type Empty struct {
+type PublicTenantListResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantEnabled bool                   `protobuf:"varint,1,opt,name=tenant_enabled,json=tenantEnabled,proto3" json:"tenant_enabled"`
+	VoList        []*PublicTenantInfo    `protobuf:"bytes,2,rep,name=vo_list,json=voList,proto3" json:"vo_list"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Empty) Reset() {
-	*x = Empty{}
-	mi := &file_core_proto_msgTypes[35]
+func (x *PublicTenantListResp) Reset() {
+	*x = PublicTenantListResp{}
+	mi := &file_core_proto_msgTypes[124]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Empty) String() string {
+func (x *PublicTenantListResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Empty) ProtoMessage() {}
+func (*PublicTenantListResp) ProtoMessage() {}
 
-func (x *Empty) ProtoReflect() protoreflect.Message {
-	mi := &file_core_proto_msgTypes[35]
+func (x *PublicTenantListResp) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[124]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3106,79 +10351,49 @@ func (x *Empty) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Empty.ProtoReflect.Descriptor instead.
-func (*Empty) Descriptor() ([]byte, []int) {
-	return file_core_proto_rawDescGZIP(), []int{35}
-}
-
-type GetOauthSessionByStateReq struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	State         string                 `protobuf:"bytes,1,opt,name=state,proto3" json:"state"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GetOauthSessionByStateReq) Reset() {
-	*x = GetOauthSessionByStateReq{}
-	mi := &file_core_proto_msgTypes[36]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *GetOauthSessionByStateReq) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// Deprecated: Use PublicTenantListResp.ProtoReflect.Descriptor instead.
+func (*PublicTenantListResp) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{124}
 }
 
-func (*GetOauthSessionByStateReq) ProtoMessage() {}
-
-func (x *GetOauthSessionByStateReq) ProtoReflect() protoreflect.Message {
-	mi := &file_core_proto_msgTypes[36]
+func (x *PublicTenantListResp) GetTenantEnabled() bool {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.TenantEnabled
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GetOauthSessionByStateReq.ProtoReflect.Descriptor instead.
-func (*GetOauthSessionByStateReq) Descriptor() ([]byte, []int) {
-	return file_core_proto_rawDescGZIP(), []int{36}
+	return false
 }
 
-func (x *GetOauthSessionByStateReq) GetState() string {
+func (x *PublicTenantListResp) GetVoList() []*PublicTenantInfo {
 	if x != nil {
-		return x.State
+		return x.VoList
 	}
-	return ""
+	return nil
 }
 
-type GetUserOauthAccountsReq struct {
+//  刷新缓存请求
+type RefreshCasbinCacheReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id"`
-	Page          uint64                 `protobuf:"varint,2,opt,name=page,proto3" json:"page"`
-	PageSize      uint64                 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size"`
+	CacheType     *string                `protobuf:"bytes,1,opt,name=cache_type,json=cacheType,proto3,oneof" json:"cache_type"`
+	ServiceName   *string                `protobuf:"bytes,2,opt,name=service_name,json=serviceName,proto3,oneof" json:"service_name"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserOauthAccountsReq) Reset() {
-	*x = GetUserOauthAccountsReq{}
-	mi := &file_core_proto_msgTypes[37]
+func (x *RefreshCasbinCacheReq) Reset() {
+	*x = RefreshCasbinCacheReq{}
+	mi := &file_core_proto_msgTypes[125]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserOauthAccountsReq) String() string {
+func (x *RefreshCasbinCacheReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserOauthAccountsReq) ProtoMessage() {}
+func (*RefreshCasbinCacheReq) ProtoMessage() {}
 
-func (x *GetUserOauthAccountsReq) ProtoReflect() protoreflect.Message {
-	mi := &file_core_proto_msgTypes[37]
+func (x *RefreshCasbinCacheReq) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[125]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3189,55 +10404,50 @@ func (x *GetUserOauthAccountsReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserOauthAccountsReq.ProtoReflect.Descriptor instead.
-func (*GetUserOauthAccountsReq) Descriptor() ([]byte, []int) {
-	return file_core_proto_rawDescGZIP(), []int{37}
+// Deprecated: Use RefreshCasbinCacheReq.ProtoReflect.Descriptor instead.
+func (*RefreshCasbinCacheReq) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{125}
 }
 
-func (x *GetUserOauthAccountsReq) GetUserId() string {
-	if x != nil {
-		return x.UserId
+func (x *RefreshCasbinCacheReq) GetCacheType() string {
+	if x != nil && x.CacheType != nil {
+		return *x.CacheType
 	}
 	return ""
 }
 
-func (x *GetUserOauthAccountsReq) GetPage() uint64 {
-	if x != nil {
-		return x.Page
-	}
-	return 0
-}
-
-func (x *GetUserOauthAccountsReq) GetPageSize() uint64 {
-	if x != nil {
-		return x.PageSize
+func (x *RefreshCasbinCacheReq) GetServiceName() string {
+	if x != nil && x.ServiceName != nil {
+		return *x.ServiceName
 	}
-	return 0
+	return ""
 }
 
-type GetUserOauthAccountsResp struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Total         uint64                 `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
-	Data          []*OauthAccountInfo    `protobuf:"bytes,2,rep,name=data,proto3" json:"data"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+//  刷新缓存响应
+type RefreshCasbinCacheResp struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Success        bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success"`
+	Message        string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message"`
+	ClearedEntries int32                  `protobuf:"varint,3,opt,name=cleared_entries,json=clearedEntries,proto3" json:"cleared_entries"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *GetUserOauthAccountsResp) Reset() {
-	*x = GetUserOauthAccountsResp{}
-	mi := &file_core_proto_msgTypes[38]
+func (x *RefreshCasbinCacheResp) Reset() {
+	*x = RefreshCasbinCacheResp{}
+	mi := &file_core_proto_msgTypes[126]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserOauthAccountsResp) String() string {
+func (x *RefreshCasbinCacheResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserOauthAccountsResp) ProtoMessage() {}
+func (*RefreshCasbinCacheResp) ProtoMessage() {}
 
-func (x *GetUserOauthAccountsResp) ProtoReflect() protoreflect.Message {
-	mi := &file_core_proto_msgTypes[38]
+func (x *RefreshCasbinCacheResp) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[126]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3248,50 +10458,57 @@ func (x *GetUserOauthAccountsResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserOauthAccountsResp.ProtoReflect.Descriptor instead.
-func (*GetUserOauthAccountsResp) Descriptor() ([]byte, []int) {
-	return file_core_proto_rawDescGZIP(), []int{38}
+// Deprecated: Use RefreshCasbinCacheResp.ProtoReflect.Descriptor instead.
+func (*RefreshCasbinCacheResp) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{126}
 }
 
-func (x *GetUserOauthAccountsResp) GetTotal() uint64 {
+func (x *RefreshCasbinCacheResp) GetSuccess() bool {
 	if x != nil {
-		return x.Total
+		return x.Success
 	}
-	return 0
+	return false
 }
 
-func (x *GetUserOauthAccountsResp) GetData() []*OauthAccountInfo {
+func (x *RefreshCasbinCacheResp) GetMessage() string {
 	if x != nil {
-		return x.Data
+		return x.Message
 	}
-	return nil
+	return ""
 }
 
-//  获取用户权限摘要请求
-type GetUserPermissionSummaryReq struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	UserId           string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id"`
-	ServiceName      *string                `protobuf:"bytes,2,opt,name=service_name,json=serviceName,proto3,oneof" json:"service_name"`
-	IncludeInherited *bool                  `protobuf:"varint,3,opt,name=include_inherited,json=includeInherited,proto3,oneof" json:"include_inherited"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+func (x *RefreshCasbinCacheResp) GetClearedEntries() int32 {
+	if x != nil {
+		return x.ClearedEntries
+	}
+	return 0
 }
 
-func (x *GetUserPermissionSummaryReq) Reset() {
-	*x = GetUserPermissionSummaryReq{}
-	mi := &file_core_proto_msgTypes[39]
+//  replace the role's API policies, version is the one returned by getRolePolicies
+type ReplaceRolePoliciesReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoleId        uint64                 `protobuf:"varint,1,opt,name=role_id,json=roleId,proto3" json:"role_id"`
+	Data          []*RolePolicyInfo      `protobuf:"bytes,2,rep,name=data,proto3" json:"data"`
+	Version       *string                `protobuf:"bytes,3,opt,name=version,proto3,oneof" json:"version"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReplaceRolePoliciesReq) Reset() {
+	*x = ReplaceRolePoliciesReq{}
+	mi := &file_core_proto_msgTypes[127]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserPermissionSummaryReq) String() string {
+func (x *ReplaceRolePoliciesReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserPermissionSummaryReq) ProtoMessage() {}
+func (*ReplaceRolePoliciesReq) ProtoMessage() {}
 
-func (x *GetUserPermissionSummaryReq) ProtoReflect() protoreflect.Message {
-	mi := &file_core_proto_msgTypes[39]
+func (x *ReplaceRolePoliciesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[127]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3302,57 +10519,57 @@ func (x *GetUserPermissionSummaryReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserPermissionSummaryReq.ProtoReflect.Descriptor instead.
-func (*GetUserPermissionSummaryReq) Descriptor() ([]byte, []int) {
-	return file_core_proto_rawDescGZIP(), []int{39}
+// Deprecated: Use ReplaceRolePoliciesReq.ProtoReflect.Descriptor instead.
+func (*ReplaceRolePoliciesReq) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{127}
 }
 
-func (x *GetUserPermissionSummaryReq) GetUserId() string {
+func (x *ReplaceRolePoliciesReq) GetRoleId() uint64 {
 	if x != nil {
-		return x.UserId
+		return x.RoleId
 	}
-	return ""
+	return 0
 }
 
-func (x *GetUserPermissionSummaryReq) GetServiceName() string {
-	if x != nil && x.ServiceName != nil {
-		return *x.ServiceName
+func (x *ReplaceRolePoliciesReq) GetData() []*RolePolicyInfo {
+	if x != nil {
+		return x.Data
 	}
-	return ""
+	return nil
 }
 
-func (x *GetUserPermissionSummaryReq) GetIncludeInherited() bool {
-	if x != nil && x.IncludeInherited != nil {
-		return *x.IncludeInherited
+func (x *ReplaceRolePoliciesReq) GetVersion() string {
+	if x != nil && x.Version != nil {
+		return *x.Version
 	}
-	return false
+	return ""
 }
 
-//  获取用户权限摘要响应
-type GetUserPermissionSummaryResp struct {
+//  return the new version and the changed policies
+type ReplaceRolePoliciesResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id"`
-	Permissions   []*PermissionSummary   `protobuf:"bytes,2,rep,name=permissions,proto3" json:"permissions"`
-	TotalCount    int32                  `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count"`
+	Version       string                 `protobuf:"bytes,1,opt,name=version,proto3" json:"version"`
+	Added         []*RolePolicyInfo      `protobuf:"bytes,2,rep,name=added,proto3" json:"added"`
+	Removed       []*RolePolicyInfo      `protobuf:"bytes,3,rep,name=removed,proto3" json:"removed"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserPermissionSummaryResp) Reset() {
-	*x = GetUserPermissionSummaryResp{}
-	mi := &file_core_proto_msgTypes[40]
+func (x *ReplaceRolePoliciesResp) Reset() {
+	*x = ReplaceRolePoliciesResp{}
+	mi := &file_core_proto_msgTypes[128]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserPermissionSummaryResp) String() string {
+func (x *ReplaceRolePoliciesResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserPermissionSummaryResp) ProtoMessage() {}
+func (*ReplaceRolePoliciesResp) ProtoMessage() {}
 
-func (x *GetUserPermissionSummaryResp) ProtoReflect() protoreflect.Message {
-	mi := &file_core_proto_msgTypes[40]
+func (x *ReplaceRolePoliciesResp) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[128]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3363,54 +10580,56 @@ func (x *GetUserPermissionSummaryResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserPermissionSummaryResp.ProtoReflect.Descriptor instead.
-func (*GetUserPermissionSummaryResp) Descriptor() ([]byte, []int) {
-	return file_core_proto_rawDescGZIP(), []int{40}
+// Deprecated: Use ReplaceRolePoliciesResp.ProtoReflect.Descriptor instead.
+func (*ReplaceRolePoliciesResp) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{128}
 }
 
-func (x *GetUserPermissionSummaryResp) GetUserId() string {
+func (x *ReplaceRolePoliciesResp) GetVersion() string {
 	if x != nil {
-		return x.UserId
+		return x.Version
 	}
 	return ""
 }
 
-func (x *GetUserPermissionSummaryResp) GetPermissions() []*PermissionSummary {
+func (x *ReplaceRolePoliciesResp) GetAdded() []*RolePolicyInfo {
 	if x != nil {
-		return x.Permissions
+		return x.Added
 	}
 	return nil
 }
 
-func (x *GetUserPermissionSummaryResp) GetTotalCount() int32 {
+func (x *ReplaceRolePoliciesResp) GetRemoved() []*RolePolicyInfo {
 	if x != nil {
-		return x.TotalCount
+		return x.Removed
 	}
-	return 0
+	return nil
 }
 
-type IDReq struct {
+type ResetPwdReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id"`
+	OpId          *string                `protobuf:"bytes,1,opt,name=opId,proto3,oneof" json:"opId"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=userId,proto3" json:"userId"`
+	Password      string                 `protobuf:"bytes,3,opt,name=password,proto3" json:"password"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *IDReq) Reset() {
-	*x = IDReq{}
-	mi := &file_core_proto_msgTypes[41]
+func (x *ResetPwdReq) Reset() {
+	*x = ResetPwdReq{}
+	mi := &file_core_proto_msgTypes[129]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *IDReq) String() string {
+func (x *ResetPwdReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IDReq) ProtoMessage() {}
+func (*ResetPwdReq) ProtoMessage() {}
 
-func (x *IDReq) ProtoReflect() protoreflect.Message {
-	mi := &file_core_proto_msgTypes[41]
+func (x *ResetPwdReq) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[129]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3421,40 +10640,56 @@ func (x *IDReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IDReq.ProtoReflect.Descriptor instead.
-func (*IDReq) Descriptor() ([]byte, []int) {
-	return file_core_proto_rawDescGZIP(), []int{41}
+// Deprecated: Use ResetPwdReq.ProtoReflect.Descriptor instead.
+func (*ResetPwdReq) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{129}
 }
 
-func (x *IDReq) GetId() uint64 {
+func (x *ResetPwdReq) GetOpId() string {
+	if x != nil && x.OpId != nil {
+		return *x.OpId
+	}
+	return ""
+}
+
+func (x *ResetPwdReq) GetUserId() string {
 	if x != nil {
-		return x.Id
+		return x.UserId
 	}
-	return 0
+	return ""
 }
 
-type IDsReq struct {
+func (x *ResetPwdReq) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type ResourceTypeStats struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Ids           []uint64               `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids"`
+	ResourceType  string                 `protobuf:"bytes,1,opt,name=resource_type,json=resourceType,proto3" json:"resource_type"`
+	Count         uint64                 `protobuf:"varint,2,opt,name=count,proto3" json:"count"`
+	Percentage    float64                `protobuf:"fixed64,3,opt,name=percentage,proto3" json:"percentage"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *IDsReq) Reset() {
-	*x = IDsReq{}
-	mi := &file_core_proto_msgTypes[42]
+func (x *ResourceTypeStats) Reset() {
+	*x = ResourceTypeStats{}
+	mi := &file_core_proto_msgTypes[130]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *IDsReq) String() string {
+func (x *ResourceTypeStats) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IDsReq) ProtoMessage() {}
+func (*ResourceTypeStats) ProtoMessage() {}
 
-func (x *IDsReq) ProtoReflect() protoreflect.Message {
-	mi := &file_core_proto_msgTypes[42]
+func (x *ResourceTypeStats) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[130]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3465,55 +10700,55 @@ func (x *IDsReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IDsReq.ProtoReflect.Descriptor instead.
-func (*IDsReq) Descriptor() ([]byte, []int) {
-	return file_core_proto_rawDescGZIP(), []int{42}
+// Deprecated: Use ResourceTypeStats.ProtoReflect.Descriptor instead.
+func (*ResourceTypeStats) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{130}
 }
 
-func (x *IDsReq) GetIds() []uint64 {
+func (x *ResourceTypeStats) GetResourceType() string {
 	if x != nil {
-		return x.Ids
+		return x.ResourceType
 	}
-	return nil
+	return ""
 }
 
-type MenuInfo struct {
+func (x *ResourceTypeStats) GetCount() uint64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *ResourceTypeStats) GetPercentage() float64 {
+	if x != nil {
+		return x.Percentage
+	}
+	return 0
+}
+
+type RoleAuthReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            *uint64                `protobuf:"varint,1,opt,name=id,proto3,oneof" json:"id"`
-	CreatedAt     *int64                 `protobuf:"varint,2,opt,name=created_at,json=createdAt,proto3,oneof" json:"created_at"`
-	UpdatedAt     *int64                 `protobuf:"varint,3,opt,name=updated_at,json=updatedAt,proto3,oneof" json:"updated_at"`
-	Level         *uint32                `protobuf:"varint,4,opt,name=level,proto3,oneof" json:"level"`
-	ParentId      *uint64                `protobuf:"varint,5,opt,name=parent_id,json=parentId,proto3,oneof" json:"parent_id"`
-	Path          *string                `protobuf:"bytes,6,opt,name=path,proto3,oneof" json:"path"`
-	Name          *string                `protobuf:"bytes,7,opt,name=name,proto3,oneof" json:"name"`
-	Redirect      *string                `protobuf:"bytes,8,opt,name=redirect,proto3,oneof" json:"redirect"`
-	Component     *string                `protobuf:"bytes,9,opt,name=component,proto3,oneof" json:"component"`
-	Sort          *uint32                `protobuf:"varint,10,opt,name=sort,proto3,oneof" json:"sort"`
-	Disabled      *bool                  `protobuf:"varint,11,opt,name=disabled,proto3,oneof" json:"disabled"`
-	Meta          *Meta                  `protobuf:"bytes,12,opt,name=meta,proto3,oneof" json:"meta"`
-	MenuType      *uint32                `protobuf:"varint,13,opt,name=menu_type,json=menuType,proto3,oneof" json:"menu_type"`
-	ServiceName   *string                `protobuf:"bytes,14,opt,name=service_name,json=serviceName,proto3,oneof" json:"service_name"`
-	Permission    *string                `protobuf:"bytes,15,opt,name=permission,proto3,oneof" json:"permission"`
-	TenantId      *uint64                `protobuf:"varint,16,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id"`
+	RoleId        uint64                 `protobuf:"varint,1,opt,name=roleId,proto3" json:"roleId"`
+	UserIds       []string               `protobuf:"bytes,2,rep,name=userIds,proto3" json:"userIds"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MenuInfo) Reset() {
-	*x = MenuInfo{}
-	mi := &file_core_proto_msgTypes[43]
+func (x *RoleAuthReq) Reset() {
+	*x = RoleAuthReq{}
+	mi := &file_core_proto_msgTypes[131]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MenuInfo) String() string {
+func (x *RoleAuthReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MenuInfo) ProtoMessage() {}
+func (*RoleAuthReq) ProtoMessage() {}
 
-func (x *MenuInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_core_proto_msgTypes[43]
+func (x *RoleAuthReq) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[131]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3524,146 +10759,171 @@ func (x *MenuInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MenuInfo.ProtoReflect.Descriptor instead.
-func (*MenuInfo) Descriptor() ([]byte, []int) {
-	return file_core_proto_rawDescGZIP(), []int{43}
+// Deprecated: Use RoleAuthReq.ProtoReflect.Descriptor instead.
+func (*RoleAuthReq) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{131}
 }
 
-func (x *MenuInfo) GetId() uint64 {
-	if x != nil && x.Id != nil {
-		return *x.Id
+func (x *RoleAuthReq) GetRoleId() uint64 {
+	if x != nil {
+		return x.RoleId
 	}
 	return 0
 }
 
-func (x *MenuInfo) GetCreatedAt() int64 {
-	if x != nil && x.CreatedAt != nil {
-		return *x.CreatedAt
+func (x *RoleAuthReq) GetUserIds() []string {
+	if x != nil {
+		return x.UserIds
 	}
-	return 0
+	return nil
+}
+
+type RoleDataScopeReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id"`
+	DataScope     uint32                 `protobuf:"varint,10,opt,name=data_scope,json=dataScope,proto3" json:"data_scope"`
+	CustomDeptIds []uint64               `protobuf:"varint,11,rep,packed,name=custom_dept_ids,json=customDeptIds,proto3" json:"custom_dept_ids"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RoleDataScopeReq) Reset() {
+	*x = RoleDataScopeReq{}
+	mi := &file_core_proto_msgTypes[132]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoleDataScopeReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *MenuInfo) GetUpdatedAt() int64 {
-	if x != nil && x.UpdatedAt != nil {
-		return *x.UpdatedAt
+func (*RoleDataScopeReq) ProtoMessage() {}
+
+func (x *RoleDataScopeReq) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[132]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *MenuInfo) GetLevel() uint32 {
-	if x != nil && x.Level != nil {
-		return *x.Level
-	}
-	return 0
+// Deprecated: Use RoleDataScopeReq.ProtoReflect.Descriptor instead.
+func (*RoleDataScopeReq) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{132}
 }
 
-func (x *MenuInfo) GetParentId() uint64 {
-	if x != nil && x.ParentId != nil {
-		return *x.ParentId
+func (x *RoleDataScopeReq) GetId() uint64 {
+	if x != nil {
+		return x.Id
 	}
 	return 0
 }
 
-func (x *MenuInfo) GetPath() string {
-	if x != nil && x.Path != nil {
-		return *x.Path
+func (x *RoleDataScopeReq) GetDataScope() uint32 {
+	if x != nil {
+		return x.DataScope
 	}
-	return ""
+	return 0
 }
 
-func (x *MenuInfo) GetName() string {
-	if x != nil && x.Name != nil {
-		return *x.Name
+func (x *RoleDataScopeReq) GetCustomDeptIds() []uint64 {
+	if x != nil {
+		return x.CustomDeptIds
 	}
-	return ""
+	return nil
 }
 
-func (x *MenuInfo) GetRedirect() string {
-	if x != nil && x.Redirect != nil {
-		return *x.Redirect
-	}
-	return ""
+type RoleEffectiveApi struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Path   string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path"`
+	Method string                 `protobuf:"bytes,2,opt,name=method,proto3" json:"method"`
+	//  The role granting the API, itself or an ancestor | 授予接口的角色，自身或祖先角色
+	SourceRole    string `protobuf:"bytes,3,opt,name=source_role,json=sourceRole,proto3" json:"source_role"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MenuInfo) GetComponent() string {
-	if x != nil && x.Component != nil {
-		return *x.Component
-	}
-	return ""
+func (x *RoleEffectiveApi) Reset() {
+	*x = RoleEffectiveApi{}
+	mi := &file_core_proto_msgTypes[133]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *MenuInfo) GetSort() uint32 {
-	if x != nil && x.Sort != nil {
-		return *x.Sort
-	}
-	return 0
+func (x *RoleEffectiveApi) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *MenuInfo) GetDisabled() bool {
-	if x != nil && x.Disabled != nil {
-		return *x.Disabled
-	}
-	return false
-}
+func (*RoleEffectiveApi) ProtoMessage() {}
 
-func (x *MenuInfo) GetMeta() *Meta {
+func (x *RoleEffectiveApi) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[133]
 	if x != nil {
-		return x.Meta
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *MenuInfo) GetMenuType() uint32 {
-	if x != nil && x.MenuType != nil {
-		return *x.MenuType
-	}
-	return 0
+// Deprecated: Use RoleEffectiveApi.ProtoReflect.Descriptor instead.
+func (*RoleEffectiveApi) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{133}
 }
 
-func (x *MenuInfo) GetServiceName() string {
-	if x != nil && x.ServiceName != nil {
-		return *x.ServiceName
+func (x *RoleEffectiveApi) GetPath() string {
+	if x != nil {
+		return x.Path
 	}
 	return ""
 }
 
-func (x *MenuInfo) GetPermission() string {
-	if x != nil && x.Permission != nil {
-		return *x.Permission
+func (x *RoleEffectiveApi) GetMethod() string {
+	if x != nil {
+		return x.Method
 	}
 	return ""
 }
 
-func (x *MenuInfo) GetTenantId() uint64 {
-	if x != nil && x.TenantId != nil {
-		return *x.TenantId
+func (x *RoleEffectiveApi) GetSourceRole() string {
+	if x != nil {
+		return x.SourceRole
 	}
-	return 0
+	return ""
 }
 
-type MenuInfoList struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Total         uint64                 `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
-	Data          []*MenuInfo            `protobuf:"bytes,2,rep,name=data,proto3" json:"data"`
+type RoleEffectivePermissionsResp struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	//  The role and all ancestor role codes | 角色及全部祖先角色代码
+	RoleCodes     []string            `protobuf:"bytes,1,rep,name=role_codes,json=roleCodes,proto3" json:"role_codes"`
+	Apis          []*RoleEffectiveApi `protobuf:"bytes,2,rep,name=apis,proto3" json:"apis"`
+	MenuIds       []uint64            `protobuf:"varint,3,rep,packed,name=menu_ids,json=menuIds,proto3" json:"menu_ids"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MenuInfoList) Reset() {
-	*x = MenuInfoList{}
-	mi := &file_core_proto_msgTypes[44]
+func (x *RoleEffectivePermissionsResp) Reset() {
+	*x = RoleEffectivePermissionsResp{}
+	mi := &file_core_proto_msgTypes[134]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MenuInfoList) String() string {
+func (x *RoleEffectivePermissionsResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MenuInfoList) ProtoMessage() {}
+func (*RoleEffectivePermissionsResp) ProtoMessage() {}
 
-func (x *MenuInfoList) ProtoReflect() protoreflect.Message {
-	mi := &file_core_proto_msgTypes[44]
+func (x *RoleEffectivePermissionsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[134]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3674,49 +10934,66 @@ func (x *MenuInfoList) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MenuInfoList.ProtoReflect.Descriptor instead.
-func (*MenuInfoList) Descriptor() ([]byte, []int) {
-	return file_core_proto_rawDescGZIP(), []int{44}
+// Deprecated: Use RoleEffectivePermissionsResp.ProtoReflect.Descriptor instead.
+func (*RoleEffectivePermissionsResp) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{134}
 }
 
-func (x *MenuInfoList) GetTotal() uint64 {
+func (x *RoleEffectivePermissionsResp) GetRoleCodes() []string {
 	if x != nil {
-		return x.Total
+		return x.RoleCodes
 	}
-	return 0
+	return nil
 }
 
-func (x *MenuInfoList) GetData() []*MenuInfo {
+func (x *RoleEffectivePermissionsResp) GetApis() []*RoleEffectiveApi {
 	if x != nil {
-		return x.Data
+		return x.Apis
 	}
 	return nil
 }
 
-type MenuRoleInfo struct {
+func (x *RoleEffectivePermissionsResp) GetMenuIds() []uint64 {
+	if x != nil {
+		return x.MenuIds
+	}
+	return nil
+}
+
+type RoleInfo struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id"`
-	MenuId        uint64                 `protobuf:"varint,2,opt,name=menu_id,json=menuId,proto3" json:"menu_id"`
-	RoleId        uint64                 `protobuf:"varint,3,opt,name=role_id,json=roleId,proto3" json:"role_id"`
+	Id            *uint64                `protobuf:"varint,1,opt,name=id,proto3,oneof" json:"id"`
+	CreatedAt     *int64                 `protobuf:"varint,2,opt,name=created_at,json=createdAt,proto3,oneof" json:"created_at"`
+	UpdatedAt     *int64                 `protobuf:"varint,3,opt,name=updated_at,json=updatedAt,proto3,oneof" json:"updated_at"`
+	Status        *uint32                `protobuf:"varint,4,opt,name=status,proto3,oneof" json:"status"`
+	Name          *string                `protobuf:"bytes,5,opt,name=name,proto3,oneof" json:"name"`
+	Code          *string                `protobuf:"bytes,6,opt,name=code,proto3,oneof" json:"code"`
+	DefaultRouter *string                `protobuf:"bytes,7,opt,name=default_router,json=defaultRouter,proto3,oneof" json:"default_router"`
+	Remark        *string                `protobuf:"bytes,8,opt,name=remark,proto3,oneof" json:"remark"`
+	Sort          *uint32                `protobuf:"varint,9,opt,name=sort,proto3,oneof" json:"sort"`
+	DataScope     *uint32                `protobuf:"varint,10,opt,name=data_scope,json=dataScope,proto3,oneof" json:"data_scope"`
+	CustomDeptIds []uint64               `protobuf:"varint,11,rep,packed,name=custom_dept_ids,json=customDeptIds,proto3" json:"custom_dept_ids"`
+	MenuIds       []uint64               `protobuf:"varint,12,rep,packed,name=menu_ids,json=menuIds,proto3" json:"menu_ids"`
+	TenantId      *uint64                `protobuf:"varint,13,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MenuRoleInfo) Reset() {
-	*x = MenuRoleInfo{}
-	mi := &file_core_proto_msgTypes[45]
+func (x *RoleInfo) Reset() {
+	*x = RoleInfo{}
+	mi := &file_core_proto_msgTypes[135]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MenuRoleInfo) String() string {
+func (x *RoleInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MenuRoleInfo) ProtoMessage() {}
+func (*RoleInfo) ProtoMessage() {}
 
-func (x *MenuRoleInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_core_proto_msgTypes[45]
+func (x *RoleInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[135]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3727,118 +11004,131 @@ func (x *MenuRoleInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MenuRoleInfo.ProtoReflect.Descriptor instead.
-func (*MenuRoleInfo) Descriptor() ([]byte, []int) {
-	return file_core_proto_rawDescGZIP(), []int{45}
+// Deprecated: Use RoleInfo.ProtoReflect.Descriptor instead.
+func (*RoleInfo) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{135}
 }
 
-func (x *MenuRoleInfo) GetId() uint64 {
-	if x != nil {
-		return x.Id
+func (x *RoleInfo) GetId() uint64 {
+	if x != nil && x.Id != nil {
+		return *x.Id
 	}
 	return 0
 }
 
-func (x *MenuRoleInfo) GetMenuId() uint64 {
-	if x != nil {
-		return x.MenuId
+func (x *RoleInfo) GetCreatedAt() int64 {
+	if x != nil && x.CreatedAt != nil {
+		return *x.CreatedAt
 	}
 	return 0
 }
 
-func (x *MenuRoleInfo) GetRoleId() uint64 {
-	if x != nil {
-		return x.RoleId
+func (x *RoleInfo) GetUpdatedAt() int64 {
+	if x != nil && x.UpdatedAt != nil {
+		return *x.UpdatedAt
 	}
 	return 0
 }
 
-type MenuRoleListResp struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Total         uint64                 `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
-	Data          []*MenuRoleInfo        `protobuf:"bytes,2,rep,name=data,proto3" json:"data"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *RoleInfo) GetStatus() uint32 {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return 0
 }
 
-func (x *MenuRoleListResp) Reset() {
-	*x = MenuRoleListResp{}
-	mi := &file_core_proto_msgTypes[46]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *RoleInfo) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
 }
 
-func (x *MenuRoleListResp) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *RoleInfo) GetCode() string {
+	if x != nil && x.Code != nil {
+		return *x.Code
+	}
+	return ""
 }
 
-func (*MenuRoleListResp) ProtoMessage() {}
+func (x *RoleInfo) GetDefaultRouter() string {
+	if x != nil && x.DefaultRouter != nil {
+		return *x.DefaultRouter
+	}
+	return ""
+}
 
-func (x *MenuRoleListResp) ProtoReflect() protoreflect.Message {
-	mi := &file_core_proto_msgTypes[46]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *RoleInfo) GetRemark() string {
+	if x != nil && x.Remark != nil {
+		return *x.Remark
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use MenuRoleListResp.ProtoReflect.Descriptor instead.
-func (*MenuRoleListResp) Descriptor() ([]byte, []int) {
-	return file_core_proto_rawDescGZIP(), []int{46}
+func (x *RoleInfo) GetSort() uint32 {
+	if x != nil && x.Sort != nil {
+		return *x.Sort
+	}
+	return 0
 }
 
-func (x *MenuRoleListResp) GetTotal() uint64 {
-	if x != nil {
-		return x.Total
+func (x *RoleInfo) GetDataScope() uint32 {
+	if x != nil && x.DataScope != nil {
+		return *x.DataScope
 	}
 	return 0
 }
 
-func (x *MenuRoleListResp) GetData() []*MenuRoleInfo {
+func (x *RoleInfo) GetCustomDeptIds() []uint64 {
 	if x != nil {
-		return x.Data
+		return x.CustomDeptIds
+	}
+	return nil
+}
+
+func (x *RoleInfo) GetMenuIds() []uint64 {
+	if x != nil {
+		return x.MenuIds
+	}
+	return nil
+}
+
+func (x *RoleInfo) GetTenantId() uint64 {
+	if x != nil && x.TenantId != nil {
+		return *x.TenantId
 	}
-	return nil
+	return 0
 }
 
-type Meta struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	Title              *string                `protobuf:"bytes,1,opt,name=title,proto3,oneof" json:"title"`
-	Icon               *string                `protobuf:"bytes,2,opt,name=icon,proto3,oneof" json:"icon"`
-	HideMenu           *bool                  `protobuf:"varint,3,opt,name=hide_menu,json=hideMenu,proto3,oneof" json:"hide_menu"`
-	HideBreadcrumb     *bool                  `protobuf:"varint,4,opt,name=hide_breadcrumb,json=hideBreadcrumb,proto3,oneof" json:"hide_breadcrumb"`
-	IgnoreKeepAlive    *bool                  `protobuf:"varint,5,opt,name=ignore_keep_alive,json=ignoreKeepAlive,proto3,oneof" json:"ignore_keep_alive"`
-	HideTab            *bool                  `protobuf:"varint,6,opt,name=hide_tab,json=hideTab,proto3,oneof" json:"hide_tab"`
-	FrameSrc           *string                `protobuf:"bytes,7,opt,name=frame_src,json=frameSrc,proto3,oneof" json:"frame_src"`
-	CarryParam         *bool                  `protobuf:"varint,8,opt,name=carry_param,json=carryParam,proto3,oneof" json:"carry_param"`
-	HideChildrenInMenu *bool                  `protobuf:"varint,9,opt,name=hide_children_in_menu,json=hideChildrenInMenu,proto3,oneof" json:"hide_children_in_menu"`
-	Affix              *bool                  `protobuf:"varint,10,opt,name=affix,proto3,oneof" json:"affix"`
-	DynamicLevel       *uint32                `protobuf:"varint,11,opt,name=dynamic_level,json=dynamicLevel,proto3,oneof" json:"dynamic_level"`
-	RealPath           *string                `protobuf:"bytes,12,opt,name=real_path,json=realPath,proto3,oneof" json:"real_path"`
-	Params             *string                `protobuf:"bytes,13,opt,name=params,proto3,oneof" json:"params"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+type RoleListReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          uint64                 `protobuf:"varint,1,opt,name=page,proto3" json:"page"`
+	PageSize      uint64                 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size"`
+	Name          *string                `protobuf:"bytes,3,opt,name=name,proto3,oneof" json:"name"`
+	Code          *string                `protobuf:"bytes,4,opt,name=code,proto3,oneof" json:"code"`
+	DefaultRouter *string                `protobuf:"bytes,5,opt,name=default_router,json=defaultRouter,proto3,oneof" json:"default_router"`
+	//  Only roles whose code has one of the prefixes or is one of the codes
+	CodePrefixes  []string `protobuf:"bytes,6,rep,name=code_prefixes,json=codePrefixes,proto3" json:"code_prefixes"`
+	Codes         []string `protobuf:"bytes,7,rep,name=codes,proto3" json:"codes"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Meta) Reset() {
-	*x = Meta{}
-	mi := &file_core_proto_msgTypes[47]
+func (x *RoleListReq) Reset() {
+	*x = RoleListReq{}
+	mi := &file_core_proto_msgTypes[136]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Meta) String() string {
+func (x *RoleListReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Meta) ProtoMessage() {}
+func (*RoleListReq) ProtoMessage() {}
 
-func (x *Meta) ProtoReflect() protoreflect.Message {
-	mi := &file_core_proto_msgTypes[47]
+func (x *RoleListReq) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[136]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3849,144 +11139,136 @@ func (x *Meta) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Meta.ProtoReflect.Descriptor instead.
-func (*Meta) Descriptor() ([]byte, []int) {
-	return file_core_proto_rawDescGZIP(), []int{47}
+// Deprecated: Use RoleListReq.ProtoReflect.Descriptor instead.
+func (*RoleListReq) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{136}
 }
 
-func (x *Meta) GetTitle() string {
-	if x != nil && x.Title != nil {
-		return *x.Title
+func (x *RoleListReq) GetPage() uint64 {
+	if x != nil {
+		return x.Page
 	}
-	return ""
+	return 0
 }
 
-func (x *Meta) GetIcon() string {
-	if x != nil && x.Icon != nil {
-		return *x.Icon
+func (x *RoleListReq) GetPageSize() uint64 {
+	if x != nil {
+		return x.PageSize
 	}
-	return ""
+	return 0
 }
 
-func (x *Meta) GetHideMenu() bool {
-	if x != nil && x.HideMenu != nil {
-		return *x.HideMenu
+func (x *RoleListReq) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
 	}
-	return false
+	return ""
 }
 
-func (x *Meta) GetHideBreadcrumb() bool {
-	if x != nil && x.HideBreadcrumb != nil {
-		return *x.HideBreadcrumb
+func (x *RoleListReq) GetCode() string {
+	if x != nil && x.Code != nil {
+		return *x.Code
 	}
-	return false
+	return ""
 }
 
-func (x *Meta) GetIgnoreKeepAlive() bool {
-	if x != nil && x.IgnoreKeepAlive != nil {
-		return *x.IgnoreKeepAlive
+func (x *RoleListReq) GetDefaultRouter() string {
+	if x != nil && x.DefaultRouter != nil {
+		return *x.DefaultRouter
 	}
-	return false
+	return ""
 }
 
-func (x *Meta) GetHideTab() bool {
-	if x != nil && x.HideTab != nil {
-		return *x.HideTab
+func (x *RoleListReq) GetCodePrefixes() []string {
+	if x != nil {
+		return x.CodePrefixes
 	}
-	return false
+	return nil
 }
 
-func (x *Meta) GetFrameSrc() string {
-	if x != nil && x.FrameSrc != nil {
-		return *x.FrameSrc
+func (x *RoleListReq) GetCodes() []string {
+	if x != nil {
+		return x.Codes
 	}
-	return ""
+	return nil
 }
 
-func (x *Meta) GetCarryParam() bool {
-	if x != nil && x.CarryParam != nil {
-		return *x.CarryParam
-	}
-	return false
+type RoleListResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Total         uint64                 `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
+	Data          []*RoleInfo            `protobuf:"bytes,2,rep,name=data,proto3" json:"data"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Meta) GetHideChildrenInMenu() bool {
-	if x != nil && x.HideChildrenInMenu != nil {
-		return *x.HideChildrenInMenu
-	}
-	return false
+func (x *RoleListResp) Reset() {
+	*x = RoleListResp{}
+	mi := &file_core_proto_msgTypes[137]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Meta) GetAffix() bool {
-	if x != nil && x.Affix != nil {
-		return *x.Affix
-	}
-	return false
+func (x *RoleListResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Meta) GetDynamicLevel() uint32 {
-	if x != nil && x.DynamicLevel != nil {
-		return *x.DynamicLevel
+func (*RoleListResp) ProtoMessage() {}
+
+func (x *RoleListResp) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[137]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *Meta) GetRealPath() string {
-	if x != nil && x.RealPath != nil {
-		return *x.RealPath
+// Deprecated: Use RoleListResp.ProtoReflect.Descriptor instead.
+func (*RoleListResp) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{137}
+}
+
+func (x *RoleListResp) GetTotal() uint64 {
+	if x != nil {
+		return x.Total
 	}
-	return ""
+	return 0
 }
 
-func (x *Meta) GetParams() string {
-	if x != nil && x.Params != nil {
-		return *x.Params
+func (x *RoleListResp) GetData() []*RoleInfo {
+	if x != nil {
+		return x.Data
 	}
-	return ""
+	return nil
 }
 
-//  OAuth Account Binding messages
-type OauthAccountInfo struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	Id               *uint64                `protobuf:"varint,1,opt,name=id,proto3,oneof" json:"id"`
-	CreatedAt        *int64                 `protobuf:"varint,2,opt,name=created_at,json=createdAt,proto3,oneof" json:"created_at"`
-	UpdatedAt        *int64                 `protobuf:"varint,3,opt,name=updated_at,json=updatedAt,proto3,oneof" json:"updated_at"`
-	UserId           *string                `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3,oneof" json:"user_id"`
-	ProviderId       *uint64                `protobuf:"varint,5,opt,name=provider_id,json=providerId,proto3,oneof" json:"provider_id"`
-	ProviderType     *string                `protobuf:"bytes,6,opt,name=provider_type,json=providerType,proto3,oneof" json:"provider_type"`
-	ProviderUserId   *string                `protobuf:"bytes,7,opt,name=provider_user_id,json=providerUserId,proto3,oneof" json:"provider_user_id"`
-	ProviderUsername *string                `protobuf:"bytes,8,opt,name=provider_username,json=providerUsername,proto3,oneof" json:"provider_username"`
-	ProviderNickname *string                `protobuf:"bytes,9,opt,name=provider_nickname,json=providerNickname,proto3,oneof" json:"provider_nickname"`
-	ProviderEmail    *string                `protobuf:"bytes,10,opt,name=provider_email,json=providerEmail,proto3,oneof" json:"provider_email"`
-	ProviderAvatar   *string                `protobuf:"bytes,11,opt,name=provider_avatar,json=providerAvatar,proto3,oneof" json:"provider_avatar"`
-	AccessToken      *string                `protobuf:"bytes,12,opt,name=access_token,json=accessToken,proto3,oneof" json:"access_token"`
-	RefreshToken     *string                `protobuf:"bytes,13,opt,name=refresh_token,json=refreshToken,proto3,oneof" json:"refresh_token"`
-	TokenExpiresAt   *int64                 `protobuf:"varint,14,opt,name=token_expires_at,json=tokenExpiresAt,proto3,oneof" json:"token_expires_at"`
-	ExtraData        *string                `protobuf:"bytes,15,opt,name=extra_data,json=extraData,proto3,oneof" json:"extra_data"`
-	LastLoginAt      *int64                 `protobuf:"varint,16,opt,name=last_login_at,json=lastLoginAt,proto3,oneof" json:"last_login_at"`
-	LastLoginIp      *string                `protobuf:"bytes,17,opt,name=last_login_ip,json=lastLoginIp,proto3,oneof" json:"last_login_ip"`
-	LoginCount       *uint32                `protobuf:"varint,18,opt,name=login_count,json=loginCount,proto3,oneof" json:"login_count"`
-	Status           *uint32                `protobuf:"varint,19,opt,name=status,proto3,oneof" json:"status"`
-	TenantId         *uint64                `protobuf:"varint,20,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+//  authorization message
+type RoleMenuAuthorityReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoleId        uint64                 `protobuf:"varint,1,opt,name=role_id,json=roleId,proto3" json:"role_id"`
+	MenuIds       []uint64               `protobuf:"varint,2,rep,packed,name=menu_ids,json=menuIds,proto3" json:"menu_ids"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *OauthAccountInfo) Reset() {
-	*x = OauthAccountInfo{}
-	mi := &file_core_proto_msgTypes[48]
+func (x *RoleMenuAuthorityReq) Reset() {
+	*x = RoleMenuAuthorityReq{}
+	mi := &file_core_proto_msgTypes[138]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *OauthAccountInfo) String() string {
+func (x *RoleMenuAuthorityReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OauthAccountInfo) ProtoMessage() {}
+func (*RoleMenuAuthorityReq) ProtoMessage() {}
 
-func (x *OauthAccountInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_core_proto_msgTypes[48]
+func (x *RoleMenuAuthorityReq) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[138]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3997,177 +11279,145 @@ func (x *OauthAccountInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OauthAccountInfo.ProtoReflect.Descriptor instead.
-func (*OauthAccountInfo) Descriptor() ([]byte, []int) {
-	return file_core_proto_rawDescGZIP(), []int{48}
-}
-
-func (x *OauthAccountInfo) GetId() uint64 {
-	if x != nil && x.Id != nil {
-		return *x.Id
-	}
-	return 0
-}
-
-func (x *OauthAccountInfo) GetCreatedAt() int64 {
-	if x != nil && x.CreatedAt != nil {
-		return *x.CreatedAt
-	}
-	return 0
-}
-
-func (x *OauthAccountInfo) GetUpdatedAt() int64 {
-	if x != nil && x.UpdatedAt != nil {
-		return *x.UpdatedAt
-	}
-	return 0
-}
-
-func (x *OauthAccountInfo) GetUserId() string {
-	if x != nil && x.UserId != nil {
-		return *x.UserId
-	}
-	return ""
+// Deprecated: Use RoleMenuAuthorityReq.ProtoReflect.Descriptor instead.
+func (*RoleMenuAuthorityReq) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{138}
 }
 
-func (x *OauthAccountInfo) GetProviderId() uint64 {
-	if x != nil && x.ProviderId != nil {
-		return *x.ProviderId
+func (x *RoleMenuAuthorityReq) GetRoleId() uint64 {
+	if x != nil {
+		return x.RoleId
 	}
 	return 0
 }
 
-func (x *OauthAccountInfo) GetProviderType() string {
-	if x != nil && x.ProviderType != nil {
-		return *x.ProviderType
-	}
-	return ""
-}
-
-func (x *OauthAccountInfo) GetProviderUserId() string {
-	if x != nil && x.ProviderUserId != nil {
-		return *x.ProviderUserId
+func (x *RoleMenuAuthorityReq) GetMenuIds() []uint64 {
+	if x != nil {
+		return x.MenuIds
 	}
-	return ""
+	return nil
 }
 
-func (x *OauthAccountInfo) GetProviderUsername() string {
-	if x != nil && x.ProviderUsername != nil {
-		return *x.ProviderUsername
-	}
-	return ""
+//  return the role's authorization menu's ids
+type RoleMenuAuthorityResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MenuIds       []uint64               `protobuf:"varint,1,rep,packed,name=menu_ids,json=menuIds,proto3" json:"menu_ids"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *OauthAccountInfo) GetProviderNickname() string {
-	if x != nil && x.ProviderNickname != nil {
-		return *x.ProviderNickname
-	}
-	return ""
+func (x *RoleMenuAuthorityResp) Reset() {
+	*x = RoleMenuAuthorityResp{}
+	mi := &file_core_proto_msgTypes[139]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *OauthAccountInfo) GetProviderEmail() string {
-	if x != nil && x.ProviderEmail != nil {
-		return *x.ProviderEmail
-	}
-	return ""
+func (x *RoleMenuAuthorityResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *OauthAccountInfo) GetProviderAvatar() string {
-	if x != nil && x.ProviderAvatar != nil {
-		return *x.ProviderAvatar
+func (*RoleMenuAuthorityResp) ProtoMessage() {}
+
+func (x *RoleMenuAuthorityResp) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[139]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *OauthAccountInfo) GetAccessToken() string {
-	if x != nil && x.AccessToken != nil {
-		return *x.AccessToken
-	}
-	return ""
+// Deprecated: Use RoleMenuAuthorityResp.ProtoReflect.Descriptor instead.
+func (*RoleMenuAuthorityResp) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{139}
 }
 
-func (x *OauthAccountInfo) GetRefreshToken() string {
-	if x != nil && x.RefreshToken != nil {
-		return *x.RefreshToken
+func (x *RoleMenuAuthorityResp) GetMenuIds() []uint64 {
+	if x != nil {
+		return x.MenuIds
 	}
-	return ""
+	return nil
 }
 
-func (x *OauthAccountInfo) GetTokenExpiresAt() int64 {
-	if x != nil && x.TokenExpiresAt != nil {
-		return *x.TokenExpiresAt
-	}
-	return 0
+type RoleParentsReq struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	RoleId uint64                 `protobuf:"varint,1,opt,name=role_id,json=roleId,proto3" json:"role_id"`
+	//  Replaces all parents, empty clears them | 替换全部父角色，为空时清除
+	ParentIds     []uint64 `protobuf:"varint,2,rep,packed,name=parent_ids,json=parentIds,proto3" json:"parent_ids"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *OauthAccountInfo) GetExtraData() string {
-	if x != nil && x.ExtraData != nil {
-		return *x.ExtraData
-	}
-	return ""
+func (x *RoleParentsReq) Reset() {
+	*x = RoleParentsReq{}
+	mi := &file_core_proto_msgTypes[140]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *OauthAccountInfo) GetLastLoginAt() int64 {
-	if x != nil && x.LastLoginAt != nil {
-		return *x.LastLoginAt
-	}
-	return 0
+func (x *RoleParentsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *OauthAccountInfo) GetLastLoginIp() string {
-	if x != nil && x.LastLoginIp != nil {
-		return *x.LastLoginIp
+func (*RoleParentsReq) ProtoMessage() {}
+
+func (x *RoleParentsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[140]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *OauthAccountInfo) GetLoginCount() uint32 {
-	if x != nil && x.LoginCount != nil {
-		return *x.LoginCount
-	}
-	return 0
+// Deprecated: Use RoleParentsReq.ProtoReflect.Descriptor instead.
+func (*RoleParentsReq) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{140}
 }
 
-func (x *OauthAccountInfo) GetStatus() uint32 {
-	if x != nil && x.Status != nil {
-		return *x.Status
+func (x *RoleParentsReq) GetRoleId() uint64 {
+	if x != nil {
+		return x.RoleId
 	}
 	return 0
 }
 
-func (x *OauthAccountInfo) GetTenantId() uint64 {
-	if x != nil && x.TenantId != nil {
-		return *x.TenantId
+func (x *RoleParentsReq) GetParentIds() []uint64 {
+	if x != nil {
+		return x.ParentIds
 	}
-	return 0
+	return nil
 }
 
-type OauthAccountListReq struct {
+type RoleParentsResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Page          uint64                 `protobuf:"varint,1,opt,name=page,proto3" json:"page"`
-	PageSize      uint64                 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size"`
-	ProviderType  *string                `protobuf:"bytes,3,opt,name=provider_type,json=providerType,proto3,oneof" json:"provider_type"`
-	ProviderId    *uint64                `protobuf:"varint,4,opt,name=provider_id,json=providerId,proto3,oneof" json:"provider_id"`
-	UserId        *string                `protobuf:"bytes,5,opt,name=user_id,json=userId,proto3,oneof" json:"user_id"`
+	Data          []*RoleInfo            `protobuf:"bytes,1,rep,name=data,proto3" json:"data"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *OauthAccountListReq) Reset() {
-	*x = OauthAccountListReq{}
-	mi := &file_core_proto_msgTypes[49]
+func (x *RoleParentsResp) Reset() {
+	*x = RoleParentsResp{}
+	mi := &file_core_proto_msgTypes[141]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *OauthAccountListReq) String() string {
+func (x *RoleParentsResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OauthAccountListReq) ProtoMessage() {}
+func (*RoleParentsResp) ProtoMessage() {}
 
-func (x *OauthAccountListReq) ProtoReflect() protoreflect.Message {
-	mi := &file_core_proto_msgTypes[49]
+func (x *RoleParentsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[141]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4178,69 +11428,95 @@ func (x *OauthAccountListReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OauthAccountListReq.ProtoReflect.Descriptor instead.
-func (*OauthAccountListReq) Descriptor() ([]byte, []int) {
-	return file_core_proto_rawDescGZIP(), []int{49}
+// Deprecated: Use RoleParentsResp.ProtoReflect.Descriptor instead.
+func (*RoleParentsResp) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{141}
 }
 
-func (x *OauthAccountListReq) GetPage() uint64 {
+func (x *RoleParentsResp) GetData() []*RoleInfo {
 	if x != nil {
-		return x.Page
+		return x.Data
 	}
-	return 0
+	return nil
 }
 
-func (x *OauthAccountListReq) GetPageSize() uint64 {
+//  role API policy
+type RolePolicyInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Path          string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path"`
+	Method        string                 `protobuf:"bytes,2,opt,name=method,proto3" json:"method"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RolePolicyInfo) Reset() {
+	*x = RolePolicyInfo{}
+	mi := &file_core_proto_msgTypes[142]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RolePolicyInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RolePolicyInfo) ProtoMessage() {}
+
+func (x *RolePolicyInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[142]
 	if x != nil {
-		return x.PageSize
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *OauthAccountListReq) GetProviderType() string {
-	if x != nil && x.ProviderType != nil {
-		return *x.ProviderType
-	}
-	return ""
+// Deprecated: Use RolePolicyInfo.ProtoReflect.Descriptor instead.
+func (*RolePolicyInfo) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{142}
 }
 
-func (x *OauthAccountListReq) GetProviderId() uint64 {
-	if x != nil && x.ProviderId != nil {
-		return *x.ProviderId
+func (x *RolePolicyInfo) GetPath() string {
+	if x != nil {
+		return x.Path
 	}
-	return 0
+	return ""
 }
 
-func (x *OauthAccountListReq) GetUserId() string {
-	if x != nil && x.UserId != nil {
-		return *x.UserId
+func (x *RolePolicyInfo) GetMethod() string {
+	if x != nil {
+		return x.Method
 	}
 	return ""
 }
 
-type OauthAccountListResp struct {
+//  return the role's API policies and their version
+type RolePolicyListResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Total         uint64                 `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
-	Data          []*OauthAccountInfo    `protobuf:"bytes,2,rep,name=data,proto3" json:"data"`
+	Version       string                 `protobuf:"bytes,1,opt,name=version,proto3" json:"version"`
+	Data          []*RolePolicyInfo      `protobuf:"bytes,2,rep,name=data,proto3" json:"data"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *OauthAccountListResp) Reset() {
-	*x = OauthAccountListResp{}
-	mi := &file_core_proto_msgTypes[50]
+func (x *RolePolicyListResp) Reset() {
+	*x = RolePolicyListResp{}
+	mi := &file_core_proto_msgTypes[143]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *OauthAccountListResp) String() string {
+func (x *RolePolicyListResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OauthAccountListResp) ProtoMessage() {}
+func (*RolePolicyListResp) ProtoMessage() {}
 
-func (x *OauthAccountListResp) ProtoReflect() protoreflect.Message {
-	mi := &file_core_proto_msgTypes[50]
+func (x *RolePolicyListResp) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[143]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4251,48 +11527,48 @@ func (x *OauthAccountListResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OauthAccountListResp.ProtoReflect.Descriptor instead.
-func (*OauthAccountListResp) Descriptor() ([]byte, []int) {
-	return file_core_proto_rawDescGZIP(), []int{50}
+// Deprecated: Use RolePolicyListResp.ProtoReflect.Descriptor instead.
+func (*RolePolicyListResp) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{143}
 }
 
-func (x *OauthAccountListResp) GetTotal() uint64 {
+func (x *RolePolicyListResp) GetVersion() string {
 	if x != nil {
-		return x.Total
+		return x.Version
 	}
-	return 0
+	return ""
 }
 
-func (x *OauthAccountListResp) GetData() []*OauthAccountInfo {
+func (x *RolePolicyListResp) GetData() []*RolePolicyInfo {
 	if x != nil {
 		return x.Data
 	}
 	return nil
 }
 
-type OauthLoginReq struct {
+type RoleStatusChangeParam struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	State         string                 `protobuf:"bytes,1,opt,name=state,proto3" json:"state"`
-	Provider      string                 `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id"`
+	Status        uint32                 `protobuf:"varint,4,opt,name=status,proto3" json:"status"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *OauthLoginReq) Reset() {
-	*x = OauthLoginReq{}
-	mi := &file_core_proto_msgTypes[51]
+func (x *RoleStatusChangeParam) Reset() {
+	*x = RoleStatusChangeParam{}
+	mi := &file_core_proto_msgTypes[144]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *OauthLoginReq) String() string {
+func (x *RoleStatusChangeParam) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OauthLoginReq) ProtoMessage() {}
+func (*RoleStatusChangeParam) ProtoMessage() {}
 
-func (x *OauthLoginReq) ProtoReflect() protoreflect.Message {
-	mi := &file_core_proto_msgTypes[51]
+func (x *RoleStatusChangeParam) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[144]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4303,78 +11579,51 @@ func (x *OauthLoginReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OauthLoginReq.ProtoReflect.Descriptor instead.
-func (*OauthLoginReq) Descriptor() ([]byte, []int) {
-	return file_core_proto_rawDescGZIP(), []int{51}
+// Deprecated: Use RoleStatusChangeParam.ProtoReflect.Descriptor instead.
+func (*RoleStatusChangeParam) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{144}
 }
 
-func (x *OauthLoginReq) GetState() string {
+func (x *RoleStatusChangeParam) GetId() uint64 {
 	if x != nil {
-		return x.State
+		return x.Id
 	}
-	return ""
+	return 0
 }
 
-func (x *OauthLoginReq) GetProvider() string {
+func (x *RoleStatusChangeParam) GetStatus() uint32 {
 	if x != nil {
-		return x.Provider
+		return x.Status
 	}
-	return ""
+	return 0
 }
 
-type OauthProviderInfo struct {
-	state        protoimpl.MessageState `protogen:"open.v1"`
-	Id           *uint64                `protobuf:"varint,1,opt,name=id,proto3,oneof" json:"id"`
-	CreatedAt    *int64                 `protobuf:"varint,2,opt,name=created_at,json=createdAt,proto3,oneof" json:"created_at"`
-	UpdatedAt    *int64                 `protobuf:"varint,3,opt,name=updated_at,json=updatedAt,proto3,oneof" json:"updated_at"`
-	Name         *string                `protobuf:"bytes,4,opt,name=name,proto3,oneof" json:"name"`
-	ClientId     *string                `protobuf:"bytes,5,opt,name=client_id,json=clientId,proto3,oneof" json:"client_id"`
-	ClientSecret *string                `protobuf:"bytes,6,opt,name=client_secret,json=clientSecret,proto3,oneof" json:"client_secret"`
-	RedirectUrl  *string                `protobuf:"bytes,7,opt,name=redirect_url,json=redirectUrl,proto3,oneof" json:"redirect_url"`
-	Scopes       *string                `protobuf:"bytes,8,opt,name=scopes,proto3,oneof" json:"scopes"`
-	AuthUrl      *string                `protobuf:"bytes,9,opt,name=auth_url,json=authUrl,proto3,oneof" json:"auth_url"`
-	TokenUrl     *string                `protobuf:"bytes,10,opt,name=token_url,json=tokenUrl,proto3,oneof" json:"token_url"`
-	AuthStyle    *uint64                `protobuf:"varint,11,opt,name=auth_style,json=authStyle,proto3,oneof" json:"auth_style"`
-	InfoUrl      *string                `protobuf:"bytes,12,opt,name=info_url,json=infoUrl,proto3,oneof" json:"info_url"`
-	//  Enhanced fields from OAuth refactor
-	DisplayName     *string `protobuf:"bytes,13,opt,name=display_name,json=displayName,proto3,oneof" json:"display_name"`
-	Type            *string `protobuf:"bytes,14,opt,name=type,proto3,oneof" json:"type"`
-	ProviderType    *string `protobuf:"bytes,15,opt,name=provider_type,json=providerType,proto3,oneof" json:"provider_type"`
-	EncryptedSecret *string `protobuf:"bytes,16,opt,name=encrypted_secret,json=encryptedSecret,proto3,oneof" json:"encrypted_secret"`
-	EncryptionKeyId *string `protobuf:"bytes,17,opt,name=encryption_key_id,json=encryptionKeyId,proto3,oneof" json:"encryption_key_id"`
-	ExtraConfig     *string `protobuf:"bytes,18,opt,name=extra_config,json=extraConfig,proto3,oneof" json:"extra_config"`
-	Enabled         *bool   `protobuf:"varint,19,opt,name=enabled,proto3,oneof" json:"enabled"`
-	Sort            *uint32 `protobuf:"varint,20,opt,name=sort,proto3,oneof" json:"sort"`
-	Remark          *string `protobuf:"bytes,21,opt,name=remark,proto3,oneof" json:"remark"`
-	SupportPkce     *bool   `protobuf:"varint,22,opt,name=support_pkce,json=supportPkce,proto3,oneof" json:"support_pkce"`
-	IconUrl         *string `protobuf:"bytes,23,opt,name=icon_url,json=iconUrl,proto3,oneof" json:"icon_url"`
-	CacheTtl        *int32  `protobuf:"varint,24,opt,name=cache_ttl,json=cacheTtl,proto3,oneof" json:"cache_ttl"`
-	WebhookUrl      *string `protobuf:"bytes,25,opt,name=webhook_url,json=webhookUrl,proto3,oneof" json:"webhook_url"`
-	SuccessCount    *int32  `protobuf:"varint,26,opt,name=success_count,json=successCount,proto3,oneof" json:"success_count"`
-	FailureCount    *int32  `protobuf:"varint,27,opt,name=failure_count,json=failureCount,proto3,oneof" json:"failure_count"`
-	LastUsedAt      *int64  `protobuf:"varint,28,opt,name=last_used_at,json=lastUsedAt,proto3,oneof" json:"last_used_at"`
-	//  Tenant and status fields from mixins (for completeness)
-	Status        *uint32 `protobuf:"varint,29,opt,name=status,proto3,oneof" json:"status"`
-	TenantId      *uint64 `protobuf:"varint,30,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id"`
+type RoleUnallocatedListReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          uint64                 `protobuf:"varint,1,opt,name=page,proto3" json:"page"`
+	PageSize      uint64                 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size"`
+	RoleId        uint64                 `protobuf:"varint,3,opt,name=roleId,proto3" json:"roleId"`
+	UserName      *string                `protobuf:"bytes,4,opt,name=userName,proto3,oneof" json:"userName"`
+	Mobile        *string                `protobuf:"bytes,5,opt,name=mobile,proto3,oneof" json:"mobile"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *OauthProviderInfo) Reset() {
-	*x = OauthProviderInfo{}
-	mi := &file_core_proto_msgTypes[52]
+func (x *RoleUnallocatedListReq) Reset() {
+	*x = RoleUnallocatedListReq{}
+	mi := &file_core_proto_msgTypes[145]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *OauthProviderInfo) String() string {
+func (x *RoleUnallocatedListReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OauthProviderInfo) ProtoMessage() {}
+func (*RoleUnallocatedListReq) ProtoMessage() {}
 
-func (x *OauthProviderInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_core_proto_msgTypes[52]
+func (x *RoleUnallocatedListReq) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[145]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4385,245 +11634,189 @@ func (x *OauthProviderInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OauthProviderInfo.ProtoReflect.Descriptor instead.
-func (*OauthProviderInfo) Descriptor() ([]byte, []int) {
-	return file_core_proto_rawDescGZIP(), []int{52}
+// Deprecated: Use RoleUnallocatedListReq.ProtoReflect.Descriptor instead.
+func (*RoleUnallocatedListReq) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{145}
 }
 
-func (x *OauthProviderInfo) GetId() uint64 {
-	if x != nil && x.Id != nil {
-		return *x.Id
+func (x *RoleUnallocatedListReq) GetPage() uint64 {
+	if x != nil {
+		return x.Page
 	}
 	return 0
 }
 
-func (x *OauthProviderInfo) GetCreatedAt() int64 {
-	if x != nil && x.CreatedAt != nil {
-		return *x.CreatedAt
+func (x *RoleUnallocatedListReq) GetPageSize() uint64 {
+	if x != nil {
+		return x.PageSize
 	}
 	return 0
 }
 
-func (x *OauthProviderInfo) GetUpdatedAt() int64 {
-	if x != nil && x.UpdatedAt != nil {
-		return *x.UpdatedAt
+func (x *RoleUnallocatedListReq) GetRoleId() uint64 {
+	if x != nil {
+		return x.RoleId
 	}
 	return 0
 }
 
-func (x *OauthProviderInfo) GetName() string {
-	if x != nil && x.Name != nil {
-		return *x.Name
-	}
-	return ""
-}
-
-func (x *OauthProviderInfo) GetClientId() string {
-	if x != nil && x.ClientId != nil {
-		return *x.ClientId
-	}
-	return ""
-}
-
-func (x *OauthProviderInfo) GetClientSecret() string {
-	if x != nil && x.ClientSecret != nil {
-		return *x.ClientSecret
+func (x *RoleUnallocatedListReq) GetUserName() string {
+	if x != nil && x.UserName != nil {
+		return *x.UserName
 	}
 	return ""
 }
 
-func (x *OauthProviderInfo) GetRedirectUrl() string {
-	if x != nil && x.RedirectUrl != nil {
-		return *x.RedirectUrl
+func (x *RoleUnallocatedListReq) GetMobile() string {
+	if x != nil && x.Mobile != nil {
+		return *x.Mobile
 	}
 	return ""
 }
 
-func (x *OauthProviderInfo) GetScopes() string {
-	if x != nil && x.Scopes != nil {
-		return *x.Scopes
-	}
-	return ""
+type SamlAcsReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProviderId    uint64                 `protobuf:"varint,1,opt,name=provider_id,json=providerId,proto3" json:"provider_id"`
+	SamlResponse  string                 `protobuf:"bytes,2,opt,name=saml_response,json=samlResponse,proto3" json:"saml_response"`
+	RelayState    *string                `protobuf:"bytes,3,opt,name=relay_state,json=relayState,proto3,oneof" json:"relay_state"`
+	Ip            *string                `protobuf:"bytes,4,opt,name=ip,proto3,oneof" json:"ip"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *OauthProviderInfo) GetAuthUrl() string {
-	if x != nil && x.AuthUrl != nil {
-		return *x.AuthUrl
-	}
-	return ""
+func (x *SamlAcsReq) Reset() {
+	*x = SamlAcsReq{}
+	mi := &file_core_proto_msgTypes[146]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *OauthProviderInfo) GetTokenUrl() string {
-	if x != nil && x.TokenUrl != nil {
-		return *x.TokenUrl
-	}
-	return ""
+func (x *SamlAcsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *OauthProviderInfo) GetAuthStyle() uint64 {
-	if x != nil && x.AuthStyle != nil {
-		return *x.AuthStyle
-	}
-	return 0
-}
+func (*SamlAcsReq) ProtoMessage() {}
 
-func (x *OauthProviderInfo) GetInfoUrl() string {
-	if x != nil && x.InfoUrl != nil {
-		return *x.InfoUrl
+func (x *SamlAcsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[146]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *OauthProviderInfo) GetDisplayName() string {
-	if x != nil && x.DisplayName != nil {
-		return *x.DisplayName
-	}
-	return ""
+// Deprecated: Use SamlAcsReq.ProtoReflect.Descriptor instead.
+func (*SamlAcsReq) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{146}
 }
 
-func (x *OauthProviderInfo) GetType() string {
-	if x != nil && x.Type != nil {
-		return *x.Type
+func (x *SamlAcsReq) GetProviderId() uint64 {
+	if x != nil {
+		return x.ProviderId
 	}
-	return ""
+	return 0
 }
 
-func (x *OauthProviderInfo) GetProviderType() string {
-	if x != nil && x.ProviderType != nil {
-		return *x.ProviderType
+func (x *SamlAcsReq) GetSamlResponse() string {
+	if x != nil {
+		return x.SamlResponse
 	}
 	return ""
 }
 
-func (x *OauthProviderInfo) GetEncryptedSecret() string {
-	if x != nil && x.EncryptedSecret != nil {
-		return *x.EncryptedSecret
+func (x *SamlAcsReq) GetRelayState() string {
+	if x != nil && x.RelayState != nil {
+		return *x.RelayState
 	}
 	return ""
 }
 
-func (x *OauthProviderInfo) GetEncryptionKeyId() string {
-	if x != nil && x.EncryptionKeyId != nil {
-		return *x.EncryptionKeyId
+func (x *SamlAcsReq) GetIp() string {
+	if x != nil && x.Ip != nil {
+		return *x.Ip
 	}
 	return ""
 }
 
-func (x *OauthProviderInfo) GetExtraConfig() string {
-	if x != nil && x.ExtraConfig != nil {
-		return *x.ExtraConfig
-	}
-	return ""
+type SamlAcsResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *UserInfo              `protobuf:"bytes,1,opt,name=user,proto3" json:"user"`
+	RelayState    string                 `protobuf:"bytes,2,opt,name=relay_state,json=relayState,proto3" json:"relay_state"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *OauthProviderInfo) GetEnabled() bool {
-	if x != nil && x.Enabled != nil {
-		return *x.Enabled
-	}
-	return false
+func (x *SamlAcsResp) Reset() {
+	*x = SamlAcsResp{}
+	mi := &file_core_proto_msgTypes[147]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *OauthProviderInfo) GetSort() uint32 {
-	if x != nil && x.Sort != nil {
-		return *x.Sort
-	}
-	return 0
+func (x *SamlAcsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *OauthProviderInfo) GetRemark() string {
-	if x != nil && x.Remark != nil {
-		return *x.Remark
-	}
-	return ""
-}
+func (*SamlAcsResp) ProtoMessage() {}
 
-func (x *OauthProviderInfo) GetSupportPkce() bool {
-	if x != nil && x.SupportPkce != nil {
-		return *x.SupportPkce
+func (x *SamlAcsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[147]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-func (x *OauthProviderInfo) GetIconUrl() string {
-	if x != nil && x.IconUrl != nil {
-		return *x.IconUrl
-	}
-	return ""
+// Deprecated: Use SamlAcsResp.ProtoReflect.Descriptor instead.
+func (*SamlAcsResp) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{147}
 }
 
-func (x *OauthProviderInfo) GetCacheTtl() int32 {
-	if x != nil && x.CacheTtl != nil {
-		return *x.CacheTtl
+func (x *SamlAcsResp) GetUser() *UserInfo {
+	if x != nil {
+		return x.User
 	}
-	return 0
+	return nil
 }
 
-func (x *OauthProviderInfo) GetWebhookUrl() string {
-	if x != nil && x.WebhookUrl != nil {
-		return *x.WebhookUrl
+func (x *SamlAcsResp) GetRelayState() string {
+	if x != nil {
+		return x.RelayState
 	}
 	return ""
 }
 
-func (x *OauthProviderInfo) GetSuccessCount() int32 {
-	if x != nil && x.SuccessCount != nil {
-		return *x.SuccessCount
-	}
-	return 0
-}
-
-func (x *OauthProviderInfo) GetFailureCount() int32 {
-	if x != nil && x.FailureCount != nil {
-		return *x.FailureCount
-	}
-	return 0
-}
-
-func (x *OauthProviderInfo) GetLastUsedAt() int64 {
-	if x != nil && x.LastUsedAt != nil {
-		return *x.LastUsedAt
-	}
-	return 0
-}
-
-func (x *OauthProviderInfo) GetStatus() uint32 {
-	if x != nil && x.Status != nil {
-		return *x.Status
-	}
-	return 0
-}
-
-func (x *OauthProviderInfo) GetTenantId() uint64 {
-	if x != nil && x.TenantId != nil {
-		return *x.TenantId
-	}
-	return 0
-}
-
-type OauthProviderListReq struct {
+type SamlLoginReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Page          uint64                 `protobuf:"varint,1,opt,name=page,proto3" json:"page"`
-	PageSize      uint64                 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size"`
-	Name          *string                `protobuf:"bytes,3,opt,name=name,proto3,oneof" json:"name"`
+	ProviderId    uint64                 `protobuf:"varint,1,opt,name=provider_id,json=providerId,proto3" json:"provider_id"`
+	RelayState    *string                `protobuf:"bytes,2,opt,name=relay_state,json=relayState,proto3,oneof" json:"relay_state"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *OauthProviderListReq) Reset() {
-	*x = OauthProviderListReq{}
-	mi := &file_core_proto_msgTypes[53]
+func (x *SamlLoginReq) Reset() {
+	*x = SamlLoginReq{}
+	mi := &file_core_proto_msgTypes[148]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *OauthProviderListReq) String() string {
+func (x *SamlLoginReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OauthProviderListReq) ProtoMessage() {}
+func (*SamlLoginReq) ProtoMessage() {}
 
-func (x *OauthProviderListReq) ProtoReflect() protoreflect.Message {
-	mi := &file_core_proto_msgTypes[53]
+func (x *SamlLoginReq) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[148]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4634,106 +11827,96 @@ func (x *OauthProviderListReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OauthProviderListReq.ProtoReflect.Descriptor instead.
-func (*OauthProviderListReq) Descriptor() ([]byte, []int) {
-	return file_core_proto_rawDescGZIP(), []int{53}
-}
-
-func (x *OauthProviderListReq) GetPage() uint64 {
-	if x != nil {
-		return x.Page
-	}
-	return 0
+// Deprecated: Use SamlLoginReq.ProtoReflect.Descriptor instead.
+func (*SamlLoginReq) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{148}
 }
 
-func (x *OauthProviderListReq) GetPageSize() uint64 {
+func (x *SamlLoginReq) GetProviderId() uint64 {
 	if x != nil {
-		return x.PageSize
+		return x.ProviderId
 	}
 	return 0
 }
 
-func (x *OauthProviderListReq) GetName() string {
-	if x != nil && x.Name != nil {
-		return *x.Name
+func (x *SamlLoginReq) GetRelayState() string {
+	if x != nil && x.RelayState != nil {
+		return *x.RelayState
 	}
 	return ""
 }
 
-type OauthProviderListResp struct {
+type SamlLoginResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Total         uint64                 `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
-	Data          []*OauthProviderInfo   `protobuf:"bytes,2,rep,name=data,proto3" json:"data"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *OauthProviderListResp) Reset() {
-	*x = OauthProviderListResp{}
-	mi := &file_core_proto_msgTypes[54]
+func (x *SamlLoginResp) Reset() {
+	*x = SamlLoginResp{}
+	mi := &file_core_proto_msgTypes[149]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *OauthProviderListResp) String() string {
+func (x *SamlLoginResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OauthProviderListResp) ProtoMessage() {}
+func (*SamlLoginResp) ProtoMessage() {}
 
-func (x *OauthProviderListResp) ProtoReflect() protoreflect.Message {
-	mi := &file_core_proto_msgTypes[54]
+func (x *SamlLoginResp) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[149]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use OauthProviderListResp.ProtoReflect.Descriptor instead.
-func (*OauthProviderListResp) Descriptor() ([]byte, []int) {
-	return file_core_proto_rawDescGZIP(), []int{54}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (x *OauthProviderListResp) GetTotal() uint64 {
-	if x != nil {
-		return x.Total
-	}
-	return 0
+// Deprecated: Use SamlLoginResp.ProtoReflect.Descriptor instead.
+func (*SamlLoginResp) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{149}
 }
 
-func (x *OauthProviderListResp) GetData() []*OauthProviderInfo {
+func (x *SamlLoginResp) GetUrl() string {
 	if x != nil {
-		return x.Data
+		return x.Url
 	}
-	return nil
+	return ""
 }
 
-type OauthRedirectResp struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url"`
+//  Import the IdP metadata from the XML document or from the URL
+type SamlMetadataImportReq struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id"`
+	MetadataXml *string                `protobuf:"bytes,2,opt,name=metadata_xml,json=metadataXml,proto3,oneof" json:"metadata_xml"`
+	MetadataUrl *string                `protobuf:"bytes,3,opt,name=metadata_url,json=metadataUrl,proto3,oneof" json:"metadata_url"`
+	//  Selects the IdP when the metadata describes several IdPs
+	EntityId      *string `protobuf:"bytes,4,opt,name=entity_id,json=entityId,proto3,oneof" json:"entity_id"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *OauthRedirectResp) Reset() {
-	*x = OauthRedirectResp{}
-	mi := &file_core_proto_msgTypes[55]
+func (x *SamlMetadataImportReq) Reset() {
+	*x = SamlMetadataImportReq{}
+	mi := &file_core_proto_msgTypes[150]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *OauthRedirectResp) String() string {
+func (x *SamlMetadataImportReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OauthRedirectResp) ProtoMessage() {}
+func (*SamlMetadataImportReq) ProtoMessage() {}
 
-func (x *OauthRedirectResp) ProtoReflect() protoreflect.Message {
-	mi := &file_core_proto_msgTypes[55]
+func (x *SamlMetadataImportReq) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[150]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4744,63 +11927,88 @@ func (x *OauthRedirectResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OauthRedirectResp.ProtoReflect.Descriptor instead.
-func (*OauthRedirectResp) Descriptor() ([]byte, []int) {
-	return file_core_proto_rawDescGZIP(), []int{55}
+// Deprecated: Use SamlMetadataImportReq.ProtoReflect.Descriptor instead.
+func (*SamlMetadataImportReq) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{150}
 }
 
-func (x *OauthRedirectResp) GetUrl() string {
+func (x *SamlMetadataImportReq) GetId() uint64 {
 	if x != nil {
-		return x.Url
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SamlMetadataImportReq) GetMetadataXml() string {
+	if x != nil && x.MetadataXml != nil {
+		return *x.MetadataXml
 	}
 	return ""
 }
 
-//  OAuth Session messages
-type OauthSessionInfo struct {
-	state               protoimpl.MessageState `protogen:"open.v1"`
-	Id                  *uint64                `protobuf:"varint,1,opt,name=id,proto3,oneof" json:"id"`
-	CreatedAt           *int64                 `protobuf:"varint,2,opt,name=created_at,json=createdAt,proto3,oneof" json:"created_at"`
-	UpdatedAt           *int64                 `protobuf:"varint,3,opt,name=updated_at,json=updatedAt,proto3,oneof" json:"updated_at"`
-	SessionId           *string                `protobuf:"bytes,4,opt,name=session_id,json=sessionId,proto3,oneof" json:"session_id"`
-	State               *string                `protobuf:"bytes,5,opt,name=state,proto3,oneof" json:"state"`
-	ProviderId          *uint64                `protobuf:"varint,6,opt,name=provider_id,json=providerId,proto3,oneof" json:"provider_id"`
-	UserId              *string                `protobuf:"bytes,7,opt,name=user_id,json=userId,proto3,oneof" json:"user_id"`
-	RedirectUri         *string                `protobuf:"bytes,8,opt,name=redirect_uri,json=redirectUri,proto3,oneof" json:"redirect_uri"`
-	Scope               *string                `protobuf:"bytes,9,opt,name=scope,proto3,oneof" json:"scope"`
-	CodeChallenge       *string                `protobuf:"bytes,10,opt,name=code_challenge,json=codeChallenge,proto3,oneof" json:"code_challenge"`
-	CodeChallengeMethod *string                `protobuf:"bytes,11,opt,name=code_challenge_method,json=codeChallengeMethod,proto3,oneof" json:"code_challenge_method"`
-	CodeVerifier        *string                `protobuf:"bytes,12,opt,name=code_verifier,json=codeVerifier,proto3,oneof" json:"code_verifier"`
-	ExpiresAt           *int64                 `protobuf:"varint,13,opt,name=expires_at,json=expiresAt,proto3,oneof" json:"expires_at"`
-	ClientIp            *string                `protobuf:"bytes,14,opt,name=client_ip,json=clientIp,proto3,oneof" json:"client_ip"`
-	UserAgent           *string                `protobuf:"bytes,15,opt,name=user_agent,json=userAgent,proto3,oneof" json:"user_agent"`
-	AuthorizationCode   *string                `protobuf:"bytes,16,opt,name=authorization_code,json=authorizationCode,proto3,oneof" json:"authorization_code"`
-	CodeReceivedAt      *int64                 `protobuf:"varint,17,opt,name=code_received_at,json=codeReceivedAt,proto3,oneof" json:"code_received_at"`
-	CallbackData        *string                `protobuf:"bytes,18,opt,name=callback_data,json=callbackData,proto3,oneof" json:"callback_data"`
-	ErrorCode           *string                `protobuf:"bytes,19,opt,name=error_code,json=errorCode,proto3,oneof" json:"error_code"`
-	ErrorDescription    *string                `protobuf:"bytes,20,opt,name=error_description,json=errorDescription,proto3,oneof" json:"error_description"`
-	RetryCount          *int32                 `protobuf:"varint,21,opt,name=retry_count,json=retryCount,proto3,oneof" json:"retry_count"`
-	Status              *uint32                `protobuf:"varint,22,opt,name=status,proto3,oneof" json:"status"`
-	TenantId            *uint64                `protobuf:"varint,23,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id"`
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+func (x *SamlMetadataImportReq) GetMetadataUrl() string {
+	if x != nil && x.MetadataUrl != nil {
+		return *x.MetadataUrl
+	}
+	return ""
 }
 
-func (x *OauthSessionInfo) Reset() {
-	*x = OauthSessionInfo{}
-	mi := &file_core_proto_msgTypes[56]
+func (x *SamlMetadataImportReq) GetEntityId() string {
+	if x != nil && x.EntityId != nil {
+		return *x.EntityId
+	}
+	return ""
+}
+
+type SamlProviderInfo struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              *uint64                `protobuf:"varint,1,opt,name=id,proto3,oneof" json:"id"`
+	CreatedAt       *int64                 `protobuf:"varint,2,opt,name=created_at,json=createdAt,proto3,oneof" json:"created_at"`
+	UpdatedAt       *int64                 `protobuf:"varint,3,opt,name=updated_at,json=updatedAt,proto3,oneof" json:"updated_at"`
+	Status          *uint32                `protobuf:"varint,4,opt,name=status,proto3,oneof" json:"status"`
+	TenantId        *uint64                `protobuf:"varint,5,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id"`
+	Name            *string                `protobuf:"bytes,6,opt,name=name,proto3,oneof" json:"name"`
+	DisplayName     *string                `protobuf:"bytes,7,opt,name=display_name,json=displayName,proto3,oneof" json:"display_name"`
+	IdpEntityId     *string                `protobuf:"bytes,8,opt,name=idp_entity_id,json=idpEntityId,proto3,oneof" json:"idp_entity_id"`
+	IdpSsoUrl       *string                `protobuf:"bytes,9,opt,name=idp_sso_url,json=idpSsoUrl,proto3,oneof" json:"idp_sso_url"`
+	IdpSloUrl       *string                `protobuf:"bytes,10,opt,name=idp_slo_url,json=idpSloUrl,proto3,oneof" json:"idp_slo_url"`
+	IdpCertificates *string                `protobuf:"bytes,11,opt,name=idp_certificates,json=idpCertificates,proto3,oneof" json:"idp_certificates"`
+	IdpMetadataUrl  *string                `protobuf:"bytes,12,opt,name=idp_metadata_url,json=idpMetadataUrl,proto3,oneof" json:"idp_metadata_url"`
+	//  Generated from the configured BaseURL when empty
+	SpEntityId           *string  `protobuf:"bytes,13,opt,name=sp_entity_id,json=spEntityId,proto3,oneof" json:"sp_entity_id"`
+	AcsUrl               *string  `protobuf:"bytes,14,opt,name=acs_url,json=acsUrl,proto3,oneof" json:"acs_url"`
+	NameIdFormat         *string  `protobuf:"bytes,15,opt,name=name_id_format,json=nameIdFormat,proto3,oneof" json:"name_id_format"`
+	SignAuthnRequest     *bool    `protobuf:"varint,16,opt,name=sign_authn_request,json=signAuthnRequest,proto3,oneof" json:"sign_authn_request"`
+	WantAssertionsSigned *bool    `protobuf:"varint,17,opt,name=want_assertions_signed,json=wantAssertionsSigned,proto3,oneof" json:"want_assertions_signed"`
+	AttributeMapping     *string  `protobuf:"bytes,18,opt,name=attribute_mapping,json=attributeMapping,proto3,oneof" json:"attribute_mapping"`
+	JitProvision         *bool    `protobuf:"varint,19,opt,name=jit_provision,json=jitProvision,proto3,oneof" json:"jit_provision"`
+	SyncAttributes       *bool    `protobuf:"varint,20,opt,name=sync_attributes,json=syncAttributes,proto3,oneof" json:"sync_attributes"`
+	DefaultDepartmentId  *uint64  `protobuf:"varint,21,opt,name=default_department_id,json=defaultDepartmentId,proto3,oneof" json:"default_department_id"`
+	DefaultRoleIds       []uint64 `protobuf:"varint,22,rep,packed,name=default_role_ids,json=defaultRoleIds,proto3" json:"default_role_ids"`
+	Enabled              *bool    `protobuf:"varint,23,opt,name=enabled,proto3,oneof" json:"enabled"`
+	Sort                 *uint32  `protobuf:"varint,24,opt,name=sort,proto3,oneof" json:"sort"`
+	Remark               *string  `protobuf:"bytes,25,opt,name=remark,proto3,oneof" json:"remark"`
+	//  Link the first login to an existing user with the same email, off by default
+	LinkByEmail   *bool `protobuf:"varint,26,opt,name=link_by_email,json=linkByEmail,proto3,oneof" json:"link_by_email"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SamlProviderInfo) Reset() {
+	*x = SamlProviderInfo{}
+	mi := &file_core_proto_msgTypes[151]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *OauthSessionInfo) String() string {
+func (x *SamlProviderInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OauthSessionInfo) ProtoMessage() {}
+func (*SamlProviderInfo) ProtoMessage() {}
 
-func (x *OauthSessionInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_core_proto_msgTypes[56]
+func (x *SamlProviderInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[151]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4811,196 +12019,327 @@ func (x *OauthSessionInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OauthSessionInfo.ProtoReflect.Descriptor instead.
-func (*OauthSessionInfo) Descriptor() ([]byte, []int) {
-	return file_core_proto_rawDescGZIP(), []int{56}
+// Deprecated: Use SamlProviderInfo.ProtoReflect.Descriptor instead.
+func (*SamlProviderInfo) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{151}
 }
 
-func (x *OauthSessionInfo) GetId() uint64 {
+func (x *SamlProviderInfo) GetId() uint64 {
 	if x != nil && x.Id != nil {
 		return *x.Id
 	}
 	return 0
 }
 
-func (x *OauthSessionInfo) GetCreatedAt() int64 {
+func (x *SamlProviderInfo) GetCreatedAt() int64 {
 	if x != nil && x.CreatedAt != nil {
 		return *x.CreatedAt
 	}
 	return 0
 }
 
-func (x *OauthSessionInfo) GetUpdatedAt() int64 {
+func (x *SamlProviderInfo) GetUpdatedAt() int64 {
 	if x != nil && x.UpdatedAt != nil {
 		return *x.UpdatedAt
 	}
 	return 0
 }
 
-func (x *OauthSessionInfo) GetSessionId() string {
-	if x != nil && x.SessionId != nil {
-		return *x.SessionId
+func (x *SamlProviderInfo) GetStatus() uint32 {
+	if x != nil && x.Status != nil {
+		return *x.Status
 	}
-	return ""
+	return 0
 }
 
-func (x *OauthSessionInfo) GetState() string {
-	if x != nil && x.State != nil {
-		return *x.State
+func (x *SamlProviderInfo) GetTenantId() uint64 {
+	if x != nil && x.TenantId != nil {
+		return *x.TenantId
 	}
-	return ""
+	return 0
 }
 
-func (x *OauthSessionInfo) GetProviderId() uint64 {
-	if x != nil && x.ProviderId != nil {
-		return *x.ProviderId
+func (x *SamlProviderInfo) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
 	}
-	return 0
+	return ""
 }
 
-func (x *OauthSessionInfo) GetUserId() string {
-	if x != nil && x.UserId != nil {
-		return *x.UserId
+func (x *SamlProviderInfo) GetDisplayName() string {
+	if x != nil && x.DisplayName != nil {
+		return *x.DisplayName
 	}
 	return ""
 }
 
-func (x *OauthSessionInfo) GetRedirectUri() string {
-	if x != nil && x.RedirectUri != nil {
-		return *x.RedirectUri
+func (x *SamlProviderInfo) GetIdpEntityId() string {
+	if x != nil && x.IdpEntityId != nil {
+		return *x.IdpEntityId
 	}
 	return ""
 }
 
-func (x *OauthSessionInfo) GetScope() string {
-	if x != nil && x.Scope != nil {
-		return *x.Scope
+func (x *SamlProviderInfo) GetIdpSsoUrl() string {
+	if x != nil && x.IdpSsoUrl != nil {
+		return *x.IdpSsoUrl
 	}
 	return ""
 }
 
-func (x *OauthSessionInfo) GetCodeChallenge() string {
-	if x != nil && x.CodeChallenge != nil {
-		return *x.CodeChallenge
+func (x *SamlProviderInfo) GetIdpSloUrl() string {
+	if x != nil && x.IdpSloUrl != nil {
+		return *x.IdpSloUrl
 	}
 	return ""
 }
 
-func (x *OauthSessionInfo) GetCodeChallengeMethod() string {
-	if x != nil && x.CodeChallengeMethod != nil {
-		return *x.CodeChallengeMethod
+func (x *SamlProviderInfo) GetIdpCertificates() string {
+	if x != nil && x.IdpCertificates != nil {
+		return *x.IdpCertificates
 	}
 	return ""
 }
 
-func (x *OauthSessionInfo) GetCodeVerifier() string {
-	if x != nil && x.CodeVerifier != nil {
-		return *x.CodeVerifier
+func (x *SamlProviderInfo) GetIdpMetadataUrl() string {
+	if x != nil && x.IdpMetadataUrl != nil {
+		return *x.IdpMetadataUrl
 	}
 	return ""
 }
 
-func (x *OauthSessionInfo) GetExpiresAt() int64 {
-	if x != nil && x.ExpiresAt != nil {
-		return *x.ExpiresAt
+func (x *SamlProviderInfo) GetSpEntityId() string {
+	if x != nil && x.SpEntityId != nil {
+		return *x.SpEntityId
 	}
-	return 0
+	return ""
 }
 
-func (x *OauthSessionInfo) GetClientIp() string {
-	if x != nil && x.ClientIp != nil {
-		return *x.ClientIp
+func (x *SamlProviderInfo) GetAcsUrl() string {
+	if x != nil && x.AcsUrl != nil {
+		return *x.AcsUrl
 	}
 	return ""
 }
 
-func (x *OauthSessionInfo) GetUserAgent() string {
-	if x != nil && x.UserAgent != nil {
-		return *x.UserAgent
+func (x *SamlProviderInfo) GetNameIdFormat() string {
+	if x != nil && x.NameIdFormat != nil {
+		return *x.NameIdFormat
 	}
 	return ""
 }
 
-func (x *OauthSessionInfo) GetAuthorizationCode() string {
-	if x != nil && x.AuthorizationCode != nil {
-		return *x.AuthorizationCode
+func (x *SamlProviderInfo) GetSignAuthnRequest() bool {
+	if x != nil && x.SignAuthnRequest != nil {
+		return *x.SignAuthnRequest
+	}
+	return false
+}
+
+func (x *SamlProviderInfo) GetWantAssertionsSigned() bool {
+	if x != nil && x.WantAssertionsSigned != nil {
+		return *x.WantAssertionsSigned
+	}
+	return false
+}
+
+func (x *SamlProviderInfo) GetAttributeMapping() string {
+	if x != nil && x.AttributeMapping != nil {
+		return *x.AttributeMapping
 	}
 	return ""
 }
 
-func (x *OauthSessionInfo) GetCodeReceivedAt() int64 {
-	if x != nil && x.CodeReceivedAt != nil {
-		return *x.CodeReceivedAt
+func (x *SamlProviderInfo) GetJitProvision() bool {
+	if x != nil && x.JitProvision != nil {
+		return *x.JitProvision
+	}
+	return false
+}
+
+func (x *SamlProviderInfo) GetSyncAttributes() bool {
+	if x != nil && x.SyncAttributes != nil {
+		return *x.SyncAttributes
+	}
+	return false
+}
+
+func (x *SamlProviderInfo) GetDefaultDepartmentId() uint64 {
+	if x != nil && x.DefaultDepartmentId != nil {
+		return *x.DefaultDepartmentId
 	}
 	return 0
 }
 
-func (x *OauthSessionInfo) GetCallbackData() string {
-	if x != nil && x.CallbackData != nil {
-		return *x.CallbackData
+func (x *SamlProviderInfo) GetDefaultRoleIds() []uint64 {
+	if x != nil {
+		return x.DefaultRoleIds
 	}
-	return ""
+	return nil
 }
 
-func (x *OauthSessionInfo) GetErrorCode() string {
-	if x != nil && x.ErrorCode != nil {
-		return *x.ErrorCode
+func (x *SamlProviderInfo) GetEnabled() bool {
+	if x != nil && x.Enabled != nil {
+		return *x.Enabled
+	}
+	return false
+}
+
+func (x *SamlProviderInfo) GetSort() uint32 {
+	if x != nil && x.Sort != nil {
+		return *x.Sort
+	}
+	return 0
+}
+
+func (x *SamlProviderInfo) GetRemark() string {
+	if x != nil && x.Remark != nil {
+		return *x.Remark
 	}
 	return ""
 }
 
-func (x *OauthSessionInfo) GetErrorDescription() string {
-	if x != nil && x.ErrorDescription != nil {
-		return *x.ErrorDescription
+func (x *SamlProviderInfo) GetLinkByEmail() bool {
+	if x != nil && x.LinkByEmail != nil {
+		return *x.LinkByEmail
+	}
+	return false
+}
+
+type SamlProviderListReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          uint64                 `protobuf:"varint,1,opt,name=page,proto3" json:"page"`
+	PageSize      uint64                 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size"`
+	Name          *string                `protobuf:"bytes,3,opt,name=name,proto3,oneof" json:"name"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SamlProviderListReq) Reset() {
+	*x = SamlProviderListReq{}
+	mi := &file_core_proto_msgTypes[152]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SamlProviderListReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SamlProviderListReq) ProtoMessage() {}
+
+func (x *SamlProviderListReq) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[152]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SamlProviderListReq.ProtoReflect.Descriptor instead.
+func (*SamlProviderListReq) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{152}
+}
+
+func (x *SamlProviderListReq) GetPage() uint64 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *SamlProviderListReq) GetPageSize() uint64 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *SamlProviderListReq) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
 	}
 	return ""
 }
 
-func (x *OauthSessionInfo) GetRetryCount() int32 {
-	if x != nil && x.RetryCount != nil {
-		return *x.RetryCount
-	}
-	return 0
+type SamlProviderListResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Total         uint64                 `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
+	Data          []*SamlProviderInfo    `protobuf:"bytes,2,rep,name=data,proto3" json:"data"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SamlProviderListResp) Reset() {
+	*x = SamlProviderListResp{}
+	mi := &file_core_proto_msgTypes[153]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SamlProviderListResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SamlProviderListResp) ProtoMessage() {}
+
+func (x *SamlProviderListResp) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[153]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SamlProviderListResp.ProtoReflect.Descriptor instead.
+func (*SamlProviderListResp) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{153}
 }
 
-func (x *OauthSessionInfo) GetStatus() uint32 {
-	if x != nil && x.Status != nil {
-		return *x.Status
+func (x *SamlProviderListResp) GetTotal() uint64 {
+	if x != nil {
+		return x.Total
 	}
 	return 0
 }
 
-func (x *OauthSessionInfo) GetTenantId() uint64 {
-	if x != nil && x.TenantId != nil {
-		return *x.TenantId
+func (x *SamlProviderListResp) GetData() []*SamlProviderInfo {
+	if x != nil {
+		return x.Data
 	}
-	return 0
+	return nil
 }
 
-type OperationTypeStats struct {
+type SamlSpMetadataReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	OperationType string                 `protobuf:"bytes,1,opt,name=operation_type,json=operationType,proto3" json:"operation_type"`
-	Count         uint64                 `protobuf:"varint,2,opt,name=count,proto3" json:"count"`
-	Percentage    float64                `protobuf:"fixed64,3,opt,name=percentage,proto3" json:"percentage"`
+	ProviderId    uint64                 `protobuf:"varint,1,opt,name=provider_id,json=providerId,proto3" json:"provider_id"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *OperationTypeStats) Reset() {
-	*x = OperationTypeStats{}
-	mi := &file_core_proto_msgTypes[57]
+func (x *SamlSpMetadataReq) Reset() {
+	*x = SamlSpMetadataReq{}
+	mi := &file_core_proto_msgTypes[154]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *OperationTypeStats) String() string {
+func (x *SamlSpMetadataReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OperationTypeStats) ProtoMessage() {}
+func (*SamlSpMetadataReq) ProtoMessage() {}
 
-func (x *OperationTypeStats) ProtoReflect() protoreflect.Message {
-	mi := &file_core_proto_msgTypes[57]
+func (x *SamlSpMetadataReq) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[154]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5011,55 +12350,85 @@ func (x *OperationTypeStats) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OperationTypeStats.ProtoReflect.Descriptor instead.
-func (*OperationTypeStats) Descriptor() ([]byte, []int) {
-	return file_core_proto_rawDescGZIP(), []int{57}
+// Deprecated: Use SamlSpMetadataReq.ProtoReflect.Descriptor instead.
+func (*SamlSpMetadataReq) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{154}
 }
 
-func (x *OperationTypeStats) GetOperationType() string {
+func (x *SamlSpMetadataReq) GetProviderId() uint64 {
 	if x != nil {
-		return x.OperationType
+		return x.ProviderId
 	}
-	return ""
+	return 0
 }
 
-func (x *OperationTypeStats) GetCount() uint64 {
+type SamlSpMetadataResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Metadata      string                 `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SamlSpMetadataResp) Reset() {
+	*x = SamlSpMetadataResp{}
+	mi := &file_core_proto_msgTypes[155]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SamlSpMetadataResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SamlSpMetadataResp) ProtoMessage() {}
+
+func (x *SamlSpMetadataResp) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[155]
 	if x != nil {
-		return x.Count
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *OperationTypeStats) GetPercentage() float64 {
+// Deprecated: Use SamlSpMetadataResp.ProtoReflect.Descriptor instead.
+func (*SamlSpMetadataResp) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{155}
+}
+
+func (x *SamlSpMetadataResp) GetMetadata() string {
 	if x != nil {
-		return x.Percentage
+		return x.Metadata
 	}
-	return 0
+	return ""
 }
 
-type PageInfoReq struct {
+type ScimTokenAuthReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Page          uint64                 `protobuf:"varint,1,opt,name=page,proto3" json:"page"`
-	PageSize      uint64                 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token"`
+	Ip            *string                `protobuf:"bytes,2,opt,name=ip,proto3,oneof" json:"ip"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PageInfoReq) Reset() {
-	*x = PageInfoReq{}
-	mi := &file_core_proto_msgTypes[58]
+func (x *ScimTokenAuthReq) Reset() {
+	*x = ScimTokenAuthReq{}
+	mi := &file_core_proto_msgTypes[156]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PageInfoReq) String() string {
+func (x *ScimTokenAuthReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PageInfoReq) ProtoMessage() {}
+func (*ScimTokenAuthReq) ProtoMessage() {}
 
-func (x *PageInfoReq) ProtoReflect() protoreflect.Message {
-	mi := &file_core_proto_msgTypes[58]
+func (x *ScimTokenAuthReq) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[156]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5070,55 +12439,50 @@ func (x *PageInfoReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PageInfoReq.ProtoReflect.Descriptor instead.
-func (*PageInfoReq) Descriptor() ([]byte, []int) {
-	return file_core_proto_rawDescGZIP(), []int{58}
+// Deprecated: Use ScimTokenAuthReq.ProtoReflect.Descriptor instead.
+func (*ScimTokenAuthReq) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{156}
 }
 
-func (x *PageInfoReq) GetPage() uint64 {
+func (x *ScimTokenAuthReq) GetToken() string {
 	if x != nil {
-		return x.Page
+		return x.Token
 	}
-	return 0
+	return ""
 }
 
-func (x *PageInfoReq) GetPageSize() uint64 {
-	if x != nil {
-		return x.PageSize
+func (x *ScimTokenAuthReq) GetIp() string {
+	if x != nil && x.Ip != nil {
+		return *x.Ip
 	}
-	return 0
+	return ""
 }
 
-//  权限检查请求
-type PermissionCheckReq struct {
-	state       protoimpl.MessageState `protogen:"open.v1"`
-	ServiceName string                 `protobuf:"bytes,1,opt,name=service_name,json=serviceName,proto3" json:"service_name"`
-	Subject     string                 `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject"`
-	Object      string                 `protobuf:"bytes,3,opt,name=object,proto3" json:"object"`
-	Action      string                 `protobuf:"bytes,4,opt,name=action,proto3" json:"action"`
-	Context     map[string]string      `protobuf:"bytes,5,rep,name=context,proto3" json:"context" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	//  可选参数
-	EnableCache   *bool `protobuf:"varint,6,opt,name=enable_cache,json=enableCache,proto3,oneof" json:"enable_cache"`
-	AuditLog      *bool `protobuf:"varint,7,opt,name=audit_log,json=auditLog,proto3,oneof" json:"audit_log"`
+//  The token is only returned when it is created
+type ScimTokenCreateResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id"`
+	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token"`
+	Msg           string                 `protobuf:"bytes,3,opt,name=msg,proto3" json:"msg"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PermissionCheckReq) Reset() {
-	*x = PermissionCheckReq{}
-	mi := &file_core_proto_msgTypes[59]
+func (x *ScimTokenCreateResp) Reset() {
+	*x = ScimTokenCreateResp{}
+	mi := &file_core_proto_msgTypes[157]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PermissionCheckReq) String() string {
+func (x *ScimTokenCreateResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PermissionCheckReq) ProtoMessage() {}
+func (*ScimTokenCreateResp) ProtoMessage() {}
 
-func (x *PermissionCheckReq) ProtoReflect() protoreflect.Message {
-	mi := &file_core_proto_msgTypes[59]
+func (x *ScimTokenCreateResp) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[157]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5129,179 +12493,248 @@ func (x *PermissionCheckReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PermissionCheckReq.ProtoReflect.Descriptor instead.
-func (*PermissionCheckReq) Descriptor() ([]byte, []int) {
-	return file_core_proto_rawDescGZIP(), []int{59}
+// Deprecated: Use ScimTokenCreateResp.ProtoReflect.Descriptor instead.
+func (*ScimTokenCreateResp) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{157}
 }
 
-func (x *PermissionCheckReq) GetServiceName() string {
+func (x *ScimTokenCreateResp) GetId() uint64 {
 	if x != nil {
-		return x.ServiceName
+		return x.Id
 	}
-	return ""
+	return 0
 }
 
-func (x *PermissionCheckReq) GetSubject() string {
+func (x *ScimTokenCreateResp) GetToken() string {
 	if x != nil {
-		return x.Subject
+		return x.Token
 	}
 	return ""
 }
 
-func (x *PermissionCheckReq) GetObject() string {
+func (x *ScimTokenCreateResp) GetMsg() string {
 	if x != nil {
-		return x.Object
+		return x.Msg
 	}
 	return ""
 }
 
-func (x *PermissionCheckReq) GetAction() string {
+type ScimTokenInfo struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          *uint64                `protobuf:"varint,1,opt,name=id,proto3,oneof" json:"id"`
+	CreatedAt   *int64                 `protobuf:"varint,2,opt,name=created_at,json=createdAt,proto3,oneof" json:"created_at"`
+	UpdatedAt   *int64                 `protobuf:"varint,3,opt,name=updated_at,json=updatedAt,proto3,oneof" json:"updated_at"`
+	Status      *uint32                `protobuf:"varint,4,opt,name=status,proto3,oneof" json:"status"`
+	TenantId    *uint64                `protobuf:"varint,5,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id"`
+	Name        *string                `protobuf:"bytes,6,opt,name=name,proto3,oneof" json:"name"`
+	TokenPrefix *string                `protobuf:"bytes,7,opt,name=token_prefix,json=tokenPrefix,proto3,oneof" json:"token_prefix"`
+	ExpiredAt   *int64                 `protobuf:"varint,8,opt,name=expired_at,json=expiredAt,proto3,oneof" json:"expired_at"`
+	LastUsedAt  *int64                 `protobuf:"varint,9,opt,name=last_used_at,json=lastUsedAt,proto3,oneof" json:"last_used_at"`
+	LastUsedIp  *string                `protobuf:"bytes,10,opt,name=last_used_ip,json=lastUsedIp,proto3,oneof" json:"last_used_ip"`
+	Remark      *string                `protobuf:"bytes,11,opt,name=remark,proto3,oneof" json:"remark"`
+	//  Existing roles the token may manage as groups, roles created by SCIM are always managed
+	RoleCodes     []string `protobuf:"bytes,12,rep,name=role_codes,json=roleCodes,proto3" json:"role_codes"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScimTokenInfo) Reset() {
+	*x = ScimTokenInfo{}
+	mi := &file_core_proto_msgTypes[158]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScimTokenInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScimTokenInfo) ProtoMessage() {}
+
+func (x *ScimTokenInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[158]
 	if x != nil {
-		return x.Action
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *PermissionCheckReq) GetContext() map[string]string {
-	if x != nil {
-		return x.Context
+// Deprecated: Use ScimTokenInfo.ProtoReflect.Descriptor instead.
+func (*ScimTokenInfo) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{158}
+}
+
+func (x *ScimTokenInfo) GetId() uint64 {
+	if x != nil && x.Id != nil {
+		return *x.Id
 	}
-	return nil
+	return 0
 }
 
-func (x *PermissionCheckReq) GetEnableCache() bool {
-	if x != nil && x.EnableCache != nil {
-		return *x.EnableCache
+func (x *ScimTokenInfo) GetCreatedAt() int64 {
+	if x != nil && x.CreatedAt != nil {
+		return *x.CreatedAt
 	}
-	return false
+	return 0
 }
 
-func (x *PermissionCheckReq) GetAuditLog() bool {
-	if x != nil && x.AuditLog != nil {
-		return *x.AuditLog
+func (x *ScimTokenInfo) GetUpdatedAt() int64 {
+	if x != nil && x.UpdatedAt != nil {
+		return *x.UpdatedAt
 	}
-	return false
+	return 0
 }
 
-//  权限检查响应
-type PermissionCheckResp struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Allowed         bool                   `protobuf:"varint,1,opt,name=allowed,proto3" json:"allowed"`
-	Reason          string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason"`
-	AppliedRules    []string               `protobuf:"bytes,3,rep,name=applied_rules,json=appliedRules,proto3" json:"applied_rules"`
-	DataFilters     map[string]string      `protobuf:"bytes,4,rep,name=data_filters,json=dataFilters,proto3" json:"data_filters" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	FieldMasks      []string               `protobuf:"bytes,5,rep,name=field_masks,json=fieldMasks,proto3" json:"field_masks"`
-	CheckDurationMs int64                  `protobuf:"varint,6,opt,name=check_duration_ms,json=checkDurationMs,proto3" json:"check_duration_ms"`
-	FromCache       bool                   `protobuf:"varint,7,opt,name=from_cache,json=fromCache,proto3" json:"from_cache"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+func (x *ScimTokenInfo) GetStatus() uint32 {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return 0
 }
 
-func (x *PermissionCheckResp) Reset() {
-	*x = PermissionCheckResp{}
-	mi := &file_core_proto_msgTypes[60]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *ScimTokenInfo) GetTenantId() uint64 {
+	if x != nil && x.TenantId != nil {
+		return *x.TenantId
+	}
+	return 0
 }
 
-func (x *PermissionCheckResp) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ScimTokenInfo) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
 }
 
-func (*PermissionCheckResp) ProtoMessage() {}
+func (x *ScimTokenInfo) GetTokenPrefix() string {
+	if x != nil && x.TokenPrefix != nil {
+		return *x.TokenPrefix
+	}
+	return ""
+}
 
-func (x *PermissionCheckResp) ProtoReflect() protoreflect.Message {
-	mi := &file_core_proto_msgTypes[60]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *ScimTokenInfo) GetExpiredAt() int64 {
+	if x != nil && x.ExpiredAt != nil {
+		return *x.ExpiredAt
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use PermissionCheckResp.ProtoReflect.Descriptor instead.
-func (*PermissionCheckResp) Descriptor() ([]byte, []int) {
-	return file_core_proto_rawDescGZIP(), []int{60}
+func (x *ScimTokenInfo) GetLastUsedAt() int64 {
+	if x != nil && x.LastUsedAt != nil {
+		return *x.LastUsedAt
+	}
+	return 0
 }
 
-func (x *PermissionCheckResp) GetAllowed() bool {
-	if x != nil {
-		return x.Allowed
+func (x *ScimTokenInfo) GetLastUsedIp() string {
+	if x != nil && x.LastUsedIp != nil {
+		return *x.LastUsedIp
 	}
-	return false
+	return ""
 }
 
-func (x *PermissionCheckResp) GetReason() string {
-	if x != nil {
-		return x.Reason
+func (x *ScimTokenInfo) GetRemark() string {
+	if x != nil && x.Remark != nil {
+		return *x.Remark
 	}
 	return ""
 }
 
-func (x *PermissionCheckResp) GetAppliedRules() []string {
+func (x *ScimTokenInfo) GetRoleCodes() []string {
 	if x != nil {
-		return x.AppliedRules
+		return x.RoleCodes
 	}
 	return nil
 }
 
-func (x *PermissionCheckResp) GetDataFilters() map[string]string {
+type ScimTokenListReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          uint64                 `protobuf:"varint,1,opt,name=page,proto3" json:"page"`
+	PageSize      uint64                 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size"`
+	Name          *string                `protobuf:"bytes,3,opt,name=name,proto3,oneof" json:"name"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScimTokenListReq) Reset() {
+	*x = ScimTokenListReq{}
+	mi := &file_core_proto_msgTypes[159]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScimTokenListReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScimTokenListReq) ProtoMessage() {}
+
+func (x *ScimTokenListReq) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[159]
 	if x != nil {
-		return x.DataFilters
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *PermissionCheckResp) GetFieldMasks() []string {
+// Deprecated: Use ScimTokenListReq.ProtoReflect.Descriptor instead.
+func (*ScimTokenListReq) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{159}
+}
+
+func (x *ScimTokenListReq) GetPage() uint64 {
 	if x != nil {
-		return x.FieldMasks
+		return x.Page
 	}
-	return nil
+	return 0
 }
 
-func (x *PermissionCheckResp) GetCheckDurationMs() int64 {
+func (x *ScimTokenListReq) GetPageSize() uint64 {
 	if x != nil {
-		return x.CheckDurationMs
+		return x.PageSize
 	}
 	return 0
 }
 
-func (x *PermissionCheckResp) GetFromCache() bool {
-	if x != nil {
-		return x.FromCache
+func (x *ScimTokenListReq) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
 	}
-	return false
+	return ""
 }
 
-//  权限摘要信息
-type PermissionSummary struct {
+type ScimTokenListResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Resource      string                 `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource"`
-	Actions       []string               `protobuf:"bytes,2,rep,name=actions,proto3" json:"actions"`
-	Source        string                 `protobuf:"bytes,3,opt,name=source,proto3" json:"source"`
-	RuleId        *string                `protobuf:"bytes,4,opt,name=rule_id,json=ruleId,proto3,oneof" json:"rule_id"`
+	Total         uint64                 `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
+	Data          []*ScimTokenInfo       `protobuf:"bytes,2,rep,name=data,proto3" json:"data"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PermissionSummary) Reset() {
-	*x = PermissionSummary{}
-	mi := &file_core_proto_msgTypes[61]
+func (x *ScimTokenListResp) Reset() {
+	*x = ScimTokenListResp{}
+	mi := &file_core_proto_msgTypes[160]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PermissionSummary) String() string {
+func (x *ScimTokenListResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PermissionSummary) ProtoMessage() {}
+func (*ScimTokenListResp) ProtoMessage() {}
 
-func (x *PermissionSummary) ProtoReflect() protoreflect.Message {
-	mi := &file_core_proto_msgTypes[61]
+func (x *ScimTokenListResp) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[160]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5312,70 +12745,103 @@ func (x *PermissionSummary) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PermissionSummary.ProtoReflect.Descriptor instead.
-func (*PermissionSummary) Descriptor() ([]byte, []int) {
-	return file_core_proto_rawDescGZIP(), []int{61}
+// Deprecated: Use ScimTokenListResp.ProtoReflect.Descriptor instead.
+func (*ScimTokenListResp) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{160}
 }
 
-func (x *PermissionSummary) GetResource() string {
+func (x *ScimTokenListResp) GetTotal() uint64 {
 	if x != nil {
-		return x.Resource
+		return x.Total
 	}
-	return ""
+	return 0
 }
 
-func (x *PermissionSummary) GetActions() []string {
+func (x *ScimTokenListResp) GetData() []*ScimTokenInfo {
 	if x != nil {
-		return x.Actions
+		return x.Data
 	}
 	return nil
 }
 
-func (x *PermissionSummary) GetSource() string {
+//  规则同步请求
+type SyncCasbinRulesReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ServiceName   *string                `protobuf:"bytes,1,opt,name=service_name,json=serviceName,proto3,oneof" json:"service_name"`
+	ForceReload   *bool                  `protobuf:"varint,2,opt,name=force_reload,json=forceReload,proto3,oneof" json:"force_reload"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyncCasbinRulesReq) Reset() {
+	*x = SyncCasbinRulesReq{}
+	mi := &file_core_proto_msgTypes[161]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncCasbinRulesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncCasbinRulesReq) ProtoMessage() {}
+
+func (x *SyncCasbinRulesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[161]
 	if x != nil {
-		return x.Source
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *PermissionSummary) GetRuleId() string {
-	if x != nil && x.RuleId != nil {
-		return *x.RuleId
+// Deprecated: Use SyncCasbinRulesReq.ProtoReflect.Descriptor instead.
+func (*SyncCasbinRulesReq) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{161}
+}
+
+func (x *SyncCasbinRulesReq) GetServiceName() string {
+	if x != nil && x.ServiceName != nil {
+		return *x.ServiceName
 	}
 	return ""
 }
 
-type PositionInfo struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            *uint64                `protobuf:"varint,1,opt,name=id,proto3,oneof" json:"id"`
-	CreatedAt     *int64                 `protobuf:"varint,2,opt,name=created_at,json=createdAt,proto3,oneof" json:"created_at"`
-	UpdatedAt     *int64                 `protobuf:"varint,3,opt,name=updated_at,json=updatedAt,proto3,oneof" json:"updated_at"`
-	Status        *uint32                `protobuf:"varint,4,opt,name=status,proto3,oneof" json:"status"`
-	Sort          *uint32                `protobuf:"varint,5,opt,name=sort,proto3,oneof" json:"sort"`
-	Name          *string                `protobuf:"bytes,6,opt,name=name,proto3,oneof" json:"name"`
-	Code          *string                `protobuf:"bytes,7,opt,name=code,proto3,oneof" json:"code"`
-	Remark        *string                `protobuf:"bytes,8,opt,name=remark,proto3,oneof" json:"remark"`
-	DeptId        *uint64                `protobuf:"varint,10,opt,name=dept_id,json=deptId,proto3,oneof" json:"dept_id"`
-	TenantId      *uint64                `protobuf:"varint,11,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *SyncCasbinRulesReq) GetForceReload() bool {
+	if x != nil && x.ForceReload != nil {
+		return *x.ForceReload
+	}
+	return false
 }
 
-func (x *PositionInfo) Reset() {
-	*x = PositionInfo{}
-	mi := &file_core_proto_msgTypes[62]
+//  规则同步响应
+type SyncCasbinRulesResp struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	SyncedCount    int32                  `protobuf:"varint,1,opt,name=synced_count,json=syncedCount,proto3" json:"synced_count"`
+	SyncedServices []string               `protobuf:"bytes,2,rep,name=synced_services,json=syncedServices,proto3" json:"synced_services"`
+	SyncDurationMs int64                  `protobuf:"varint,3,opt,name=sync_duration_ms,json=syncDurationMs,proto3" json:"sync_duration_ms"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SyncCasbinRulesResp) Reset() {
+	*x = SyncCasbinRulesResp{}
+	mi := &file_core_proto_msgTypes[162]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PositionInfo) String() string {
+func (x *SyncCasbinRulesResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PositionInfo) ProtoMessage() {}
+func (*SyncCasbinRulesResp) ProtoMessage() {}
 
-func (x *PositionInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_core_proto_msgTypes[62]
+func (x *SyncCasbinRulesResp) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[162]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5386,108 +12852,104 @@ func (x *PositionInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PositionInfo.ProtoReflect.Descriptor instead.
-func (*PositionInfo) Descriptor() ([]byte, []int) {
-	return file_core_proto_rawDescGZIP(), []int{62}
+// Deprecated: Use SyncCasbinRulesResp.ProtoReflect.Descriptor instead.
+func (*SyncCasbinRulesResp) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{162}
 }
 
-func (x *PositionInfo) GetId() uint64 {
-	if x != nil && x.Id != nil {
-		return *x.Id
+func (x *SyncCasbinRulesResp) GetSyncedCount() int32 {
+	if x != nil {
+		return x.SyncedCount
 	}
 	return 0
 }
 
-func (x *PositionInfo) GetCreatedAt() int64 {
-	if x != nil && x.CreatedAt != nil {
-		return *x.CreatedAt
+func (x *SyncCasbinRulesResp) GetSyncedServices() []string {
+	if x != nil {
+		return x.SyncedServices
 	}
-	return 0
+	return nil
 }
 
-func (x *PositionInfo) GetUpdatedAt() int64 {
-	if x != nil && x.UpdatedAt != nil {
-		return *x.UpdatedAt
+func (x *SyncCasbinRulesResp) GetSyncDurationMs() int64 {
+	if x != nil {
+		return x.SyncDurationMs
 	}
 	return 0
 }
 
-func (x *PositionInfo) GetStatus() uint32 {
-	if x != nil && x.Status != nil {
-		return *x.Status
-	}
-	return 0
+type TenantCodeReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PositionInfo) GetSort() uint32 {
-	if x != nil && x.Sort != nil {
-		return *x.Sort
-	}
-	return 0
+func (x *TenantCodeReq) Reset() {
+	*x = TenantCodeReq{}
+	mi := &file_core_proto_msgTypes[163]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *PositionInfo) GetName() string {
-	if x != nil && x.Name != nil {
-		return *x.Name
-	}
-	return ""
+func (x *TenantCodeReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *PositionInfo) GetCode() string {
-	if x != nil && x.Code != nil {
-		return *x.Code
-	}
-	return ""
-}
+func (*TenantCodeReq) ProtoMessage() {}
 
-func (x *PositionInfo) GetRemark() string {
-	if x != nil && x.Remark != nil {
-		return *x.Remark
+func (x *TenantCodeReq) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[163]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *PositionInfo) GetDeptId() uint64 {
-	if x != nil && x.DeptId != nil {
-		return *x.DeptId
-	}
-	return 0
+// Deprecated: Use TenantCodeReq.ProtoReflect.Descriptor instead.
+func (*TenantCodeReq) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{163}
 }
 
-func (x *PositionInfo) GetTenantId() uint64 {
-	if x != nil && x.TenantId != nil {
-		return *x.TenantId
+func (x *TenantCodeReq) GetCode() string {
+	if x != nil {
+		return x.Code
 	}
-	return 0
+	return ""
 }
 
-type PositionListReq struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Page          uint64                 `protobuf:"varint,1,opt,name=page,proto3" json:"page"`
-	PageSize      uint64                 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size"`
-	Name          *string                `protobuf:"bytes,3,opt,name=name,proto3,oneof" json:"name"`
-	Code          *string                `protobuf:"bytes,4,opt,name=code,proto3,oneof" json:"code"`
-	Remark        *string                `protobuf:"bytes,5,opt,name=remark,proto3,oneof" json:"remark"`
-	BelongDeptId  *uint64                `protobuf:"varint,6,opt,name=belongDeptId,proto3,oneof" json:"belongDeptId"`
+type TenantDoctorFinding struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	TenantId uint64                 `protobuf:"varint,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id"`
+	//  missing_admin_role, missing_admin_user, user_without_department, user_without_role, dangling_role_menu, stale_api_rule, stale_user_grouping
+	Code     string   `protobuf:"bytes,2,opt,name=code,proto3" json:"code"`
+	Message  string   `protobuf:"bytes,3,opt,name=message,proto3" json:"message"`
+	Subjects []string `protobuf:"bytes,4,rep,name=subjects,proto3" json:"subjects"`
+	//  Repair plan | 修复计划
+	Repair        string `protobuf:"bytes,5,opt,name=repair,proto3" json:"repair"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PositionListReq) Reset() {
-	*x = PositionListReq{}
-	mi := &file_core_proto_msgTypes[63]
+func (x *TenantDoctorFinding) Reset() {
+	*x = TenantDoctorFinding{}
+	mi := &file_core_proto_msgTypes[164]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PositionListReq) String() string {
+func (x *TenantDoctorFinding) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PositionListReq) ProtoMessage() {}
+func (*TenantDoctorFinding) ProtoMessage() {}
 
-func (x *PositionListReq) ProtoReflect() protoreflect.Message {
-	mi := &file_core_proto_msgTypes[63]
+func (x *TenantDoctorFinding) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[164]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5498,76 +12960,114 @@ func (x *PositionListReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PositionListReq.ProtoReflect.Descriptor instead.
-func (*PositionListReq) Descriptor() ([]byte, []int) {
-	return file_core_proto_rawDescGZIP(), []int{63}
+// Deprecated: Use TenantDoctorFinding.ProtoReflect.Descriptor instead.
+func (*TenantDoctorFinding) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{164}
 }
 
-func (x *PositionListReq) GetPage() uint64 {
+func (x *TenantDoctorFinding) GetTenantId() uint64 {
 	if x != nil {
-		return x.Page
+		return x.TenantId
 	}
 	return 0
 }
 
-func (x *PositionListReq) GetPageSize() uint64 {
+func (x *TenantDoctorFinding) GetCode() string {
 	if x != nil {
-		return x.PageSize
+		return x.Code
 	}
-	return 0
+	return ""
+}
+
+func (x *TenantDoctorFinding) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *TenantDoctorFinding) GetSubjects() []string {
+	if x != nil {
+		return x.Subjects
+	}
+	return nil
+}
+
+func (x *TenantDoctorFinding) GetRepair() string {
+	if x != nil {
+		return x.Repair
+	}
+	return ""
+}
+
+type TenantDoctorReq struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	//  Check all tenants when empty | 为空时检查全部租户
+	TenantId      *uint64 `protobuf:"varint,1,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PositionListReq) GetName() string {
-	if x != nil && x.Name != nil {
-		return *x.Name
-	}
-	return ""
+func (x *TenantDoctorReq) Reset() {
+	*x = TenantDoctorReq{}
+	mi := &file_core_proto_msgTypes[165]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *PositionListReq) GetCode() string {
-	if x != nil && x.Code != nil {
-		return *x.Code
-	}
-	return ""
+func (x *TenantDoctorReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *PositionListReq) GetRemark() string {
-	if x != nil && x.Remark != nil {
-		return *x.Remark
+func (*TenantDoctorReq) ProtoMessage() {}
+
+func (x *TenantDoctorReq) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[165]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *PositionListReq) GetBelongDeptId() uint64 {
-	if x != nil && x.BelongDeptId != nil {
-		return *x.BelongDeptId
+// Deprecated: Use TenantDoctorReq.ProtoReflect.Descriptor instead.
+func (*TenantDoctorReq) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{165}
+}
+
+func (x *TenantDoctorReq) GetTenantId() uint64 {
+	if x != nil && x.TenantId != nil {
+		return *x.TenantId
 	}
 	return 0
 }
 
-type PositionListResp struct {
+type TenantDoctorResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Total         uint64                 `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
-	Data          []*PositionInfo        `protobuf:"bytes,2,rep,name=data,proto3" json:"data"`
+	Data          []*TenantDoctorFinding `protobuf:"bytes,2,rep,name=data,proto3" json:"data"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PositionListResp) Reset() {
-	*x = PositionListResp{}
-	mi := &file_core_proto_msgTypes[64]
+func (x *TenantDoctorResp) Reset() {
+	*x = TenantDoctorResp{}
+	mi := &file_core_proto_msgTypes[166]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PositionListResp) String() string {
+func (x *TenantDoctorResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PositionListResp) ProtoMessage() {}
+func (*TenantDoctorResp) ProtoMessage() {}
 
-func (x *PositionListResp) ProtoReflect() protoreflect.Message {
-	mi := &file_core_proto_msgTypes[64]
+func (x *TenantDoctorResp) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[166]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5578,49 +13078,56 @@ func (x *PositionListResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PositionListResp.ProtoReflect.Descriptor instead.
-func (*PositionListResp) Descriptor() ([]byte, []int) {
-	return file_core_proto_rawDescGZIP(), []int{64}
+// Deprecated: Use TenantDoctorResp.ProtoReflect.Descriptor instead.
+func (*TenantDoctorResp) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{166}
 }
 
-func (x *PositionListResp) GetTotal() uint64 {
+func (x *TenantDoctorResp) GetTotal() uint64 {
 	if x != nil {
 		return x.Total
 	}
 	return 0
 }
 
-func (x *PositionListResp) GetData() []*PositionInfo {
+func (x *TenantDoctorResp) GetData() []*TenantDoctorFinding {
 	if x != nil {
 		return x.Data
 	}
 	return nil
 }
 
-type PublicTenantInfo struct {
+type TenantInfo struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id"`
-	CompanyName   string                 `protobuf:"bytes,2,opt,name=company_name,json=companyName,proto3" json:"company_name"`
-	Domain        *string                `protobuf:"bytes,3,opt,name=domain,proto3,oneof" json:"domain"`
+	Id            *uint64                `protobuf:"varint,1,opt,name=id,proto3,oneof" json:"id"`
+	CreatedAt     *int64                 `protobuf:"varint,2,opt,name=created_at,json=createdAt,proto3,oneof" json:"created_at"`
+	UpdatedAt     *int64                 `protobuf:"varint,3,opt,name=updated_at,json=updatedAt,proto3,oneof" json:"updated_at"`
+	Status        *uint32                `protobuf:"varint,4,opt,name=status,proto3,oneof" json:"status"`
+	Name          *string                `protobuf:"bytes,5,opt,name=name,proto3,oneof" json:"name"`
+	Code          *string                `protobuf:"bytes,6,opt,name=code,proto3,oneof" json:"code"`
+	Description   *string                `protobuf:"bytes,7,opt,name=description,proto3,oneof" json:"description"`
+	ExpiredAt     *int64                 `protobuf:"varint,8,opt,name=expired_at,json=expiredAt,proto3,oneof" json:"expired_at"`
+	Config        *string                `protobuf:"bytes,9,opt,name=config,proto3,oneof" json:"config"`
+	CreatedBy     *uint64                `protobuf:"varint,10,opt,name=created_by,json=createdBy,proto3,oneof" json:"created_by"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PublicTenantInfo) Reset() {
-	*x = PublicTenantInfo{}
-	mi := &file_core_proto_msgTypes[65]
+func (x *TenantInfo) Reset() {
+	*x = TenantInfo{}
+	mi := &file_core_proto_msgTypes[167]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PublicTenantInfo) String() string {
+func (x *TenantInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PublicTenantInfo) ProtoMessage() {}
+func (*TenantInfo) ProtoMessage() {}
 
-func (x *PublicTenantInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_core_proto_msgTypes[65]
+func (x *TenantInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[167]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5631,108 +13138,117 @@ func (x *PublicTenantInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PublicTenantInfo.ProtoReflect.Descriptor instead.
-func (*PublicTenantInfo) Descriptor() ([]byte, []int) {
-	return file_core_proto_rawDescGZIP(), []int{65}
+// Deprecated: Use TenantInfo.ProtoReflect.Descriptor instead.
+func (*TenantInfo) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{167}
 }
 
-func (x *PublicTenantInfo) GetTenantId() string {
-	if x != nil {
-		return x.TenantId
+func (x *TenantInfo) GetId() uint64 {
+	if x != nil && x.Id != nil {
+		return *x.Id
 	}
-	return ""
+	return 0
 }
 
-func (x *PublicTenantInfo) GetCompanyName() string {
-	if x != nil {
-		return x.CompanyName
+func (x *TenantInfo) GetCreatedAt() int64 {
+	if x != nil && x.CreatedAt != nil {
+		return *x.CreatedAt
 	}
-	return ""
+	return 0
 }
 
-func (x *PublicTenantInfo) GetDomain() string {
-	if x != nil && x.Domain != nil {
-		return *x.Domain
+func (x *TenantInfo) GetUpdatedAt() int64 {
+	if x != nil && x.UpdatedAt != nil {
+		return *x.UpdatedAt
 	}
-	return ""
+	return 0
 }
 
-type PublicTenantListResp struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	TenantEnabled bool                   `protobuf:"varint,1,opt,name=tenant_enabled,json=tenantEnabled,proto3" json:"tenant_enabled"`
-	VoList        []*PublicTenantInfo    `protobuf:"bytes,2,rep,name=vo_list,json=voList,proto3" json:"vo_list"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *TenantInfo) GetStatus() uint32 {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return 0
 }
 
-func (x *PublicTenantListResp) Reset() {
-	*x = PublicTenantListResp{}
-	mi := &file_core_proto_msgTypes[66]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *TenantInfo) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
 }
 
-func (x *PublicTenantListResp) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *TenantInfo) GetCode() string {
+	if x != nil && x.Code != nil {
+		return *x.Code
+	}
+	return ""
 }
 
-func (*PublicTenantListResp) ProtoMessage() {}
-
-func (x *PublicTenantListResp) ProtoReflect() protoreflect.Message {
-	mi := &file_core_proto_msgTypes[66]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *TenantInfo) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use PublicTenantListResp.ProtoReflect.Descriptor instead.
-func (*PublicTenantListResp) Descriptor() ([]byte, []int) {
-	return file_core_proto_rawDescGZIP(), []int{66}
+func (x *TenantInfo) GetExpiredAt() int64 {
+	if x != nil && x.ExpiredAt != nil {
+		return *x.ExpiredAt
+	}
+	return 0
 }
 
-func (x *PublicTenantListResp) GetTenantEnabled() bool {
-	if x != nil {
-		return x.TenantEnabled
+func (x *TenantInfo) GetConfig() string {
+	if x != nil && x.Config != nil {
+		return *x.Config
 	}
-	return false
+	return ""
 }
 
-func (x *PublicTenantListResp) GetVoList() []*PublicTenantInfo {
-	if x != nil {
-		return x.VoList
+func (x *TenantInfo) GetCreatedBy() uint64 {
+	if x != nil && x.CreatedBy != nil {
+		return *x.CreatedBy
 	}
-	return nil
+	return 0
 }
 
-//  刷新缓存请求
-type RefreshCasbinCacheReq struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	CacheType     *string                `protobuf:"bytes,1,opt,name=cache_type,json=cacheType,proto3,oneof" json:"cache_type"`
-	ServiceName   *string                `protobuf:"bytes,2,opt,name=service_name,json=serviceName,proto3,oneof" json:"service_name"`
+type TenantInitJobInfo struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id"`
+	CreatedAt int64                  `protobuf:"varint,2,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
+	UpdatedAt int64                  `protobuf:"varint,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at"`
+	TenantId  uint64                 `protobuf:"varint,4,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id"`
+	Template  string                 `protobuf:"bytes,5,opt,name=template,proto3" json:"template"`
+	Mode      string                 `protobuf:"bytes,6,opt,name=mode,proto3" json:"mode"`
+	DryRun    bool                   `protobuf:"varint,7,opt,name=dry_run,json=dryRun,proto3" json:"dry_run"`
+	//  pending, running, success, failed
+	Status        string                      `protobuf:"bytes,8,opt,name=status,proto3" json:"status"`
+	Attempts      uint32                      `protobuf:"varint,9,opt,name=attempts,proto3" json:"attempts"`
+	Error         string                      `protobuf:"bytes,10,opt,name=error,proto3" json:"error"`
+	StartedAt     *int64                      `protobuf:"varint,11,opt,name=started_at,json=startedAt,proto3,oneof" json:"started_at"`
+	FinishedAt    *int64                      `protobuf:"varint,12,opt,name=finished_at,json=finishedAt,proto3,oneof" json:"finished_at"`
+	Plugins       []*TenantInitPluginProgress `protobuf:"bytes,13,rep,name=plugins,proto3" json:"plugins"`
+	Plan          []*TenantInitPlanItem       `protobuf:"bytes,14,rep,name=plan,proto3" json:"plan"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RefreshCasbinCacheReq) Reset() {
-	*x = RefreshCasbinCacheReq{}
-	mi := &file_core_proto_msgTypes[67]
+func (x *TenantInitJobInfo) Reset() {
+	*x = TenantInitJobInfo{}
+	mi := &file_core_proto_msgTypes[168]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RefreshCasbinCacheReq) String() string {
+func (x *TenantInitJobInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RefreshCasbinCacheReq) ProtoMessage() {}
+func (*TenantInitJobInfo) ProtoMessage() {}
 
-func (x *RefreshCasbinCacheReq) ProtoReflect() protoreflect.Message {
-	mi := &file_core_proto_msgTypes[67]
+func (x *TenantInitJobInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[168]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5743,110 +13259,134 @@ func (x *RefreshCasbinCacheReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RefreshCasbinCacheReq.ProtoReflect.Descriptor instead.
-func (*RefreshCasbinCacheReq) Descriptor() ([]byte, []int) {
-	return file_core_proto_rawDescGZIP(), []int{67}
+// Deprecated: Use TenantInitJobInfo.ProtoReflect.Descriptor instead.
+func (*TenantInitJobInfo) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{168}
 }
 
-func (x *RefreshCasbinCacheReq) GetCacheType() string {
-	if x != nil && x.CacheType != nil {
-		return *x.CacheType
+func (x *TenantInitJobInfo) GetId() uint64 {
+	if x != nil {
+		return x.Id
 	}
-	return ""
+	return 0
 }
 
-func (x *RefreshCasbinCacheReq) GetServiceName() string {
-	if x != nil && x.ServiceName != nil {
-		return *x.ServiceName
+func (x *TenantInitJobInfo) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *TenantInitJobInfo) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *TenantInitJobInfo) GetTenantId() uint64 {
+	if x != nil {
+		return x.TenantId
+	}
+	return 0
+}
+
+func (x *TenantInitJobInfo) GetTemplate() string {
+	if x != nil {
+		return x.Template
 	}
 	return ""
 }
 
-//  刷新缓存响应
-type RefreshCasbinCacheResp struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Success        bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success"`
-	Message        string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message"`
-	ClearedEntries int32                  `protobuf:"varint,3,opt,name=cleared_entries,json=clearedEntries,proto3" json:"cleared_entries"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *TenantInitJobInfo) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
 }
 
-func (x *RefreshCasbinCacheResp) Reset() {
-	*x = RefreshCasbinCacheResp{}
-	mi := &file_core_proto_msgTypes[68]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *TenantInitJobInfo) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
 }
 
-func (x *RefreshCasbinCacheResp) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *TenantInitJobInfo) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
 }
 
-func (*RefreshCasbinCacheResp) ProtoMessage() {}
+func (x *TenantInitJobInfo) GetAttempts() uint32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
 
-func (x *RefreshCasbinCacheResp) ProtoReflect() protoreflect.Message {
-	mi := &file_core_proto_msgTypes[68]
+func (x *TenantInitJobInfo) GetError() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Error
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use RefreshCasbinCacheResp.ProtoReflect.Descriptor instead.
-func (*RefreshCasbinCacheResp) Descriptor() ([]byte, []int) {
-	return file_core_proto_rawDescGZIP(), []int{68}
+func (x *TenantInitJobInfo) GetStartedAt() int64 {
+	if x != nil && x.StartedAt != nil {
+		return *x.StartedAt
+	}
+	return 0
 }
 
-func (x *RefreshCasbinCacheResp) GetSuccess() bool {
-	if x != nil {
-		return x.Success
+func (x *TenantInitJobInfo) GetFinishedAt() int64 {
+	if x != nil && x.FinishedAt != nil {
+		return *x.FinishedAt
 	}
-	return false
+	return 0
 }
 
-func (x *RefreshCasbinCacheResp) GetMessage() string {
+func (x *TenantInitJobInfo) GetPlugins() []*TenantInitPluginProgress {
 	if x != nil {
-		return x.Message
+		return x.Plugins
 	}
-	return ""
+	return nil
 }
 
-func (x *RefreshCasbinCacheResp) GetClearedEntries() int32 {
+func (x *TenantInitJobInfo) GetPlan() []*TenantInitPlanItem {
 	if x != nil {
-		return x.ClearedEntries
+		return x.Plan
 	}
-	return 0
+	return nil
 }
 
-type ResetPwdReq struct {
+type TenantInitJobListReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	OpId          *string                `protobuf:"bytes,1,opt,name=opId,proto3,oneof" json:"opId"`
-	UserId        string                 `protobuf:"bytes,2,opt,name=userId,proto3" json:"userId"`
-	Password      string                 `protobuf:"bytes,3,opt,name=password,proto3" json:"password"`
+	Page          uint64                 `protobuf:"varint,1,opt,name=page,proto3" json:"page"`
+	PageSize      uint64                 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size"`
+	TenantId      *uint64                `protobuf:"varint,3,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id"`
+	Status        *string                `protobuf:"bytes,4,opt,name=status,proto3,oneof" json:"status"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ResetPwdReq) Reset() {
-	*x = ResetPwdReq{}
-	mi := &file_core_proto_msgTypes[69]
+func (x *TenantInitJobListReq) Reset() {
+	*x = TenantInitJobListReq{}
+	mi := &file_core_proto_msgTypes[169]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ResetPwdReq) String() string {
+func (x *TenantInitJobListReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResetPwdReq) ProtoMessage() {}
+func (*TenantInitJobListReq) ProtoMessage() {}
 
-func (x *ResetPwdReq) ProtoReflect() protoreflect.Message {
-	mi := &file_core_proto_msgTypes[69]
+func (x *TenantInitJobListReq) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[169]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5857,56 +13397,62 @@ func (x *ResetPwdReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResetPwdReq.ProtoReflect.Descriptor instead.
-func (*ResetPwdReq) Descriptor() ([]byte, []int) {
-	return file_core_proto_rawDescGZIP(), []int{69}
+// Deprecated: Use TenantInitJobListReq.ProtoReflect.Descriptor instead.
+func (*TenantInitJobListReq) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{169}
 }
 
-func (x *ResetPwdReq) GetOpId() string {
-	if x != nil && x.OpId != nil {
-		return *x.OpId
+func (x *TenantInitJobListReq) GetPage() uint64 {
+	if x != nil {
+		return x.Page
 	}
-	return ""
+	return 0
 }
 
-func (x *ResetPwdReq) GetUserId() string {
+func (x *TenantInitJobListReq) GetPageSize() uint64 {
 	if x != nil {
-		return x.UserId
+		return x.PageSize
 	}
-	return ""
+	return 0
 }
 
-func (x *ResetPwdReq) GetPassword() string {
-	if x != nil {
-		return x.Password
+func (x *TenantInitJobListReq) GetTenantId() uint64 {
+	if x != nil && x.TenantId != nil {
+		return *x.TenantId
+	}
+	return 0
+}
+
+func (x *TenantInitJobListReq) GetStatus() string {
+	if x != nil && x.Status != nil {
+		return *x.Status
 	}
 	return ""
 }
 
-type ResourceTypeStats struct {
+type TenantInitJobListResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ResourceType  string                 `protobuf:"bytes,1,opt,name=resource_type,json=resourceType,proto3" json:"resource_type"`
-	Count         uint64                 `protobuf:"varint,2,opt,name=count,proto3" json:"count"`
-	Percentage    float64                `protobuf:"fixed64,3,opt,name=percentage,proto3" json:"percentage"`
+	Total         uint64                 `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
+	Data          []*TenantInitJobInfo   `protobuf:"bytes,2,rep,name=data,proto3" json:"data"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ResourceTypeStats) Reset() {
-	*x = ResourceTypeStats{}
-	mi := &file_core_proto_msgTypes[70]
+func (x *TenantInitJobListResp) Reset() {
+	*x = TenantInitJobListResp{}
+	mi := &file_core_proto_msgTypes[170]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ResourceTypeStats) String() string {
+func (x *TenantInitJobListResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResourceTypeStats) ProtoMessage() {}
+func (*TenantInitJobListResp) ProtoMessage() {}
 
-func (x *ResourceTypeStats) ProtoReflect() protoreflect.Message {
-	mi := &file_core_proto_msgTypes[70]
+func (x *TenantInitJobListResp) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[170]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5917,55 +13463,49 @@ func (x *ResourceTypeStats) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResourceTypeStats.ProtoReflect.Descriptor instead.
-func (*ResourceTypeStats) Descriptor() ([]byte, []int) {
-	return file_core_proto_rawDescGZIP(), []int{70}
-}
-
-func (x *ResourceTypeStats) GetResourceType() string {
-	if x != nil {
-		return x.ResourceType
-	}
-	return ""
+// Deprecated: Use TenantInitJobListResp.ProtoReflect.Descriptor instead.
+func (*TenantInitJobListResp) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{170}
 }
 
-func (x *ResourceTypeStats) GetCount() uint64 {
+func (x *TenantInitJobListResp) GetTotal() uint64 {
 	if x != nil {
-		return x.Count
+		return x.Total
 	}
 	return 0
 }
 
-func (x *ResourceTypeStats) GetPercentage() float64 {
+func (x *TenantInitJobListResp) GetData() []*TenantInitJobInfo {
 	if x != nil {
-		return x.Percentage
+		return x.Data
 	}
-	return 0
+	return nil
 }
 
-type RoleAuthReq struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	RoleId        uint64                 `protobuf:"varint,1,opt,name=roleId,proto3" json:"roleId"`
-	UserIds       []string               `protobuf:"bytes,2,rep,name=userIds,proto3" json:"userIds"`
+type TenantInitJobRetryReq struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id"`
+	//  The admin password is not stored, pass it again when the admin user was not created | 管理员密码不保存，管理员未创建时需重新传入
+	AdminPassword *string `protobuf:"bytes,2,opt,name=admin_password,json=adminPassword,proto3,oneof" json:"admin_password"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RoleAuthReq) Reset() {
-	*x = RoleAuthReq{}
-	mi := &file_core_proto_msgTypes[71]
+func (x *TenantInitJobRetryReq) Reset() {
+	*x = TenantInitJobRetryReq{}
+	mi := &file_core_proto_msgTypes[171]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RoleAuthReq) String() string {
+func (x *TenantInitJobRetryReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RoleAuthReq) ProtoMessage() {}
+func (*TenantInitJobRetryReq) ProtoMessage() {}
 
-func (x *RoleAuthReq) ProtoReflect() protoreflect.Message {
-	mi := &file_core_proto_msgTypes[71]
+func (x *TenantInitJobRetryReq) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[171]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5976,49 +13516,52 @@ func (x *RoleAuthReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RoleAuthReq.ProtoReflect.Descriptor instead.
-func (*RoleAuthReq) Descriptor() ([]byte, []int) {
-	return file_core_proto_rawDescGZIP(), []int{71}
+// Deprecated: Use TenantInitJobRetryReq.ProtoReflect.Descriptor instead.
+func (*TenantInitJobRetryReq) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{171}
 }
 
-func (x *RoleAuthReq) GetRoleId() uint64 {
+func (x *TenantInitJobRetryReq) GetId() uint64 {
 	if x != nil {
-		return x.RoleId
+		return x.Id
 	}
 	return 0
 }
 
-func (x *RoleAuthReq) GetUserIds() []string {
-	if x != nil {
-		return x.UserIds
+func (x *TenantInitJobRetryReq) GetAdminPassword() string {
+	if x != nil && x.AdminPassword != nil {
+		return *x.AdminPassword
 	}
-	return nil
+	return ""
 }
 
-type RoleDataScopeReq struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id"`
-	DataScope     uint32                 `protobuf:"varint,10,opt,name=data_scope,json=dataScope,proto3" json:"data_scope"`
-	CustomDeptIds []uint64               `protobuf:"varint,11,rep,packed,name=custom_dept_ids,json=customDeptIds,proto3" json:"custom_dept_ids"`
+type TenantInitPlanItem struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Plugin    string                 `protobuf:"bytes,1,opt,name=plugin,proto3" json:"plugin"`
+	Component string                 `protobuf:"bytes,2,opt,name=component,proto3" json:"component"`
+	//  create, skip
+	Action        string   `protobuf:"bytes,3,opt,name=action,proto3" json:"action"`
+	Count         uint64   `protobuf:"varint,4,opt,name=count,proto3" json:"count"`
+	Items         []string `protobuf:"bytes,5,rep,name=items,proto3" json:"items"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RoleDataScopeReq) Reset() {
-	*x = RoleDataScopeReq{}
-	mi := &file_core_proto_msgTypes[72]
+func (x *TenantInitPlanItem) Reset() {
+	*x = TenantInitPlanItem{}
+	mi := &file_core_proto_msgTypes[172]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RoleDataScopeReq) String() string {
+func (x *TenantInitPlanItem) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RoleDataScopeReq) ProtoMessage() {}
+func (*TenantInitPlanItem) ProtoMessage() {}
 
-func (x *RoleDataScopeReq) ProtoReflect() protoreflect.Message {
-	mi := &file_core_proto_msgTypes[72]
+func (x *TenantInitPlanItem) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[172]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6029,66 +13572,83 @@ func (x *RoleDataScopeReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RoleDataScopeReq.ProtoReflect.Descriptor instead.
-func (*RoleDataScopeReq) Descriptor() ([]byte, []int) {
-	return file_core_proto_rawDescGZIP(), []int{72}
+// Deprecated: Use TenantInitPlanItem.ProtoReflect.Descriptor instead.
+func (*TenantInitPlanItem) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{172}
 }
 
-func (x *RoleDataScopeReq) GetId() uint64 {
+func (x *TenantInitPlanItem) GetPlugin() string {
 	if x != nil {
-		return x.Id
+		return x.Plugin
 	}
-	return 0
+	return ""
 }
 
-func (x *RoleDataScopeReq) GetDataScope() uint32 {
+func (x *TenantInitPlanItem) GetComponent() string {
 	if x != nil {
-		return x.DataScope
+		return x.Component
+	}
+	return ""
+}
+
+func (x *TenantInitPlanItem) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *TenantInitPlanItem) GetCount() uint64 {
+	if x != nil {
+		return x.Count
 	}
 	return 0
 }
 
-func (x *RoleDataScopeReq) GetCustomDeptIds() []uint64 {
+func (x *TenantInitPlanItem) GetItems() []string {
 	if x != nil {
-		return x.CustomDeptIds
+		return x.Items
 	}
 	return nil
 }
 
-type RoleInfo struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            *uint64                `protobuf:"varint,1,opt,name=id,proto3,oneof" json:"id"`
-	CreatedAt     *int64                 `protobuf:"varint,2,opt,name=created_at,json=createdAt,proto3,oneof" json:"created_at"`
-	UpdatedAt     *int64                 `protobuf:"varint,3,opt,name=updated_at,json=updatedAt,proto3,oneof" json:"updated_at"`
-	Status        *uint32                `protobuf:"varint,4,opt,name=status,proto3,oneof" json:"status"`
-	Name          *string                `protobuf:"bytes,5,opt,name=name,proto3,oneof" json:"name"`
-	Code          *string                `protobuf:"bytes,6,opt,name=code,proto3,oneof" json:"code"`
-	DefaultRouter *string                `protobuf:"bytes,7,opt,name=default_router,json=defaultRouter,proto3,oneof" json:"default_router"`
-	Remark        *string                `protobuf:"bytes,8,opt,name=remark,proto3,oneof" json:"remark"`
-	Sort          *uint32                `protobuf:"varint,9,opt,name=sort,proto3,oneof" json:"sort"`
-	DataScope     *uint32                `protobuf:"varint,10,opt,name=data_scope,json=dataScope,proto3,oneof" json:"data_scope"`
-	CustomDeptIds []uint64               `protobuf:"varint,11,rep,packed,name=custom_dept_ids,json=customDeptIds,proto3" json:"custom_dept_ids"`
-	MenuIds       []uint64               `protobuf:"varint,12,rep,packed,name=menu_ids,json=menuIds,proto3" json:"menu_ids"`
-	TenantId      *uint64                `protobuf:"varint,13,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+//  A tenant initialization plugin served by another service | 其他服务提供的租户初始化插件
+type TenantInitPluginInfo struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          *uint64                `protobuf:"varint,1,opt,name=id,proto3,oneof" json:"id"`
+	CreatedAt   *int64                 `protobuf:"varint,2,opt,name=created_at,json=createdAt,proto3,oneof" json:"created_at"`
+	UpdatedAt   *int64                 `protobuf:"varint,3,opt,name=updated_at,json=updatedAt,proto3,oneof" json:"updated_at"`
+	Status      *uint32                `protobuf:"varint,4,opt,name=status,proto3,oneof" json:"status"`
+	Name        *string                `protobuf:"bytes,5,opt,name=name,proto3,oneof" json:"name"`
+	Version     *string                `protobuf:"bytes,6,opt,name=version,proto3,oneof" json:"version"`
+	Description *string                `protobuf:"bytes,7,opt,name=description,proto3,oneof" json:"description"`
+	//  gRPC target of the service | 服务的 gRPC 地址
+	Target *string `protobuf:"bytes,8,opt,name=target,proto3,oneof" json:"target"`
+	//  The core plugin is always a dependency | 总是依赖核心插件
+	Dependencies []string `protobuf:"bytes,9,rep,name=dependencies,proto3" json:"dependencies"`
+	Priority     *int32   `protobuf:"varint,10,opt,name=priority,proto3,oneof" json:"priority"`
+	//  Timeout of each call in seconds | 每次调用的超时时间（秒）
+	Timeout         *uint32 `protobuf:"varint,11,opt,name=timeout,proto3,oneof" json:"timeout"`
+	SupportRollback *bool   `protobuf:"varint,12,opt,name=support_rollback,json=supportRollback,proto3,oneof" json:"support_rollback"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *RoleInfo) Reset() {
-	*x = RoleInfo{}
-	mi := &file_core_proto_msgTypes[73]
+func (x *TenantInitPluginInfo) Reset() {
+	*x = TenantInitPluginInfo{}
+	mi := &file_core_proto_msgTypes[173]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RoleInfo) String() string {
+func (x *TenantInitPluginInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RoleInfo) ProtoMessage() {}
+func (*TenantInitPluginInfo) ProtoMessage() {}
 
-func (x *RoleInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_core_proto_msgTypes[73]
+func (x *TenantInitPluginInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[173]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6099,128 +13659,119 @@ func (x *RoleInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RoleInfo.ProtoReflect.Descriptor instead.
-func (*RoleInfo) Descriptor() ([]byte, []int) {
-	return file_core_proto_rawDescGZIP(), []int{73}
+// Deprecated: Use TenantInitPluginInfo.ProtoReflect.Descriptor instead.
+func (*TenantInitPluginInfo) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{173}
 }
 
-func (x *RoleInfo) GetId() uint64 {
+func (x *TenantInitPluginInfo) GetId() uint64 {
 	if x != nil && x.Id != nil {
 		return *x.Id
 	}
 	return 0
 }
 
-func (x *RoleInfo) GetCreatedAt() int64 {
+func (x *TenantInitPluginInfo) GetCreatedAt() int64 {
 	if x != nil && x.CreatedAt != nil {
 		return *x.CreatedAt
 	}
 	return 0
 }
 
-func (x *RoleInfo) GetUpdatedAt() int64 {
+func (x *TenantInitPluginInfo) GetUpdatedAt() int64 {
 	if x != nil && x.UpdatedAt != nil {
 		return *x.UpdatedAt
 	}
 	return 0
 }
 
-func (x *RoleInfo) GetStatus() uint32 {
+func (x *TenantInitPluginInfo) GetStatus() uint32 {
 	if x != nil && x.Status != nil {
 		return *x.Status
 	}
 	return 0
 }
 
-func (x *RoleInfo) GetName() string {
+func (x *TenantInitPluginInfo) GetName() string {
 	if x != nil && x.Name != nil {
 		return *x.Name
 	}
 	return ""
 }
 
-func (x *RoleInfo) GetCode() string {
-	if x != nil && x.Code != nil {
-		return *x.Code
+func (x *TenantInitPluginInfo) GetVersion() string {
+	if x != nil && x.Version != nil {
+		return *x.Version
 	}
 	return ""
 }
 
-func (x *RoleInfo) GetDefaultRouter() string {
-	if x != nil && x.DefaultRouter != nil {
-		return *x.DefaultRouter
+func (x *TenantInitPluginInfo) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
 	}
 	return ""
 }
 
-func (x *RoleInfo) GetRemark() string {
-	if x != nil && x.Remark != nil {
-		return *x.Remark
+func (x *TenantInitPluginInfo) GetTarget() string {
+	if x != nil && x.Target != nil {
+		return *x.Target
 	}
 	return ""
 }
 
-func (x *RoleInfo) GetSort() uint32 {
-	if x != nil && x.Sort != nil {
-		return *x.Sort
+func (x *TenantInitPluginInfo) GetDependencies() []string {
+	if x != nil {
+		return x.Dependencies
 	}
-	return 0
+	return nil
 }
 
-func (x *RoleInfo) GetDataScope() uint32 {
-	if x != nil && x.DataScope != nil {
-		return *x.DataScope
+func (x *TenantInitPluginInfo) GetPriority() int32 {
+	if x != nil && x.Priority != nil {
+		return *x.Priority
 	}
 	return 0
 }
 
-func (x *RoleInfo) GetCustomDeptIds() []uint64 {
-	if x != nil {
-		return x.CustomDeptIds
-	}
-	return nil
-}
-
-func (x *RoleInfo) GetMenuIds() []uint64 {
-	if x != nil {
-		return x.MenuIds
+func (x *TenantInitPluginInfo) GetTimeout() uint32 {
+	if x != nil && x.Timeout != nil {
+		return *x.Timeout
 	}
-	return nil
+	return 0
 }
 
-func (x *RoleInfo) GetTenantId() uint64 {
-	if x != nil && x.TenantId != nil {
-		return *x.TenantId
+func (x *TenantInitPluginInfo) GetSupportRollback() bool {
+	if x != nil && x.SupportRollback != nil {
+		return *x.SupportRollback
 	}
-	return 0
+	return false
 }
 
-type RoleListReq struct {
+type TenantInitPluginListReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Page          uint64                 `protobuf:"varint,1,opt,name=page,proto3" json:"page"`
 	PageSize      uint64                 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size"`
 	Name          *string                `protobuf:"bytes,3,opt,name=name,proto3,oneof" json:"name"`
-	Code          *string                `protobuf:"bytes,4,opt,name=code,proto3,oneof" json:"code"`
-	DefaultRouter *string                `protobuf:"bytes,5,opt,name=default_router,json=defaultRouter,proto3,oneof" json:"default_router"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RoleListReq) Reset() {
-	*x = RoleListReq{}
-	mi := &file_core_proto_msgTypes[74]
+func (x *TenantInitPluginListReq) Reset() {
+	*x = TenantInitPluginListReq{}
+	mi := &file_core_proto_msgTypes[174]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RoleListReq) String() string {
+func (x *TenantInitPluginListReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RoleListReq) ProtoMessage() {}
+func (*TenantInitPluginListReq) ProtoMessage() {}
 
-func (x *RoleListReq) ProtoReflect() protoreflect.Message {
-	mi := &file_core_proto_msgTypes[74]
+func (x *TenantInitPluginListReq) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[174]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6231,69 +13782,55 @@ func (x *RoleListReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RoleListReq.ProtoReflect.Descriptor instead.
-func (*RoleListReq) Descriptor() ([]byte, []int) {
-	return file_core_proto_rawDescGZIP(), []int{74}
+// Deprecated: Use TenantInitPluginListReq.ProtoReflect.Descriptor instead.
+func (*TenantInitPluginListReq) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{174}
 }
 
-func (x *RoleListReq) GetPage() uint64 {
+func (x *TenantInitPluginListReq) GetPage() uint64 {
 	if x != nil {
 		return x.Page
 	}
 	return 0
 }
 
-func (x *RoleListReq) GetPageSize() uint64 {
+func (x *TenantInitPluginListReq) GetPageSize() uint64 {
 	if x != nil {
 		return x.PageSize
 	}
 	return 0
 }
 
-func (x *RoleListReq) GetName() string {
+func (x *TenantInitPluginListReq) GetName() string {
 	if x != nil && x.Name != nil {
 		return *x.Name
 	}
 	return ""
 }
 
-func (x *RoleListReq) GetCode() string {
-	if x != nil && x.Code != nil {
-		return *x.Code
-	}
-	return ""
-}
-
-func (x *RoleListReq) GetDefaultRouter() string {
-	if x != nil && x.DefaultRouter != nil {
-		return *x.DefaultRouter
-	}
-	return ""
-}
-
-type RoleListResp struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Total         uint64                 `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
-	Data          []*RoleInfo            `protobuf:"bytes,2,rep,name=data,proto3" json:"data"`
+type TenantInitPluginListResp struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Total         uint64                  `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
+	Data          []*TenantInitPluginInfo `protobuf:"bytes,2,rep,name=data,proto3" json:"data"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
-
-func (x *RoleListResp) Reset() {
-	*x = RoleListResp{}
-	mi := &file_core_proto_msgTypes[75]
+
+func (x *TenantInitPluginListResp) Reset() {
+	*x = TenantInitPluginListResp{}
+	mi := &file_core_proto_msgTypes[175]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RoleListResp) String() string {
+func (x *TenantInitPluginListResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RoleListResp) ProtoMessage() {}
+func (*TenantInitPluginListResp) ProtoMessage() {}
 
-func (x *RoleListResp) ProtoReflect() protoreflect.Message {
-	mi := &file_core_proto_msgTypes[75]
+func (x *TenantInitPluginListResp) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[175]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6304,49 +13841,53 @@ func (x *RoleListResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RoleListResp.ProtoReflect.Descriptor instead.
-func (*RoleListResp) Descriptor() ([]byte, []int) {
-	return file_core_proto_rawDescGZIP(), []int{75}
+// Deprecated: Use TenantInitPluginListResp.ProtoReflect.Descriptor instead.
+func (*TenantInitPluginListResp) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{175}
 }
 
-func (x *RoleListResp) GetTotal() uint64 {
+func (x *TenantInitPluginListResp) GetTotal() uint64 {
 	if x != nil {
 		return x.Total
 	}
 	return 0
 }
 
-func (x *RoleListResp) GetData() []*RoleInfo {
+func (x *TenantInitPluginListResp) GetData() []*TenantInitPluginInfo {
 	if x != nil {
 		return x.Data
 	}
 	return nil
 }
 
-//  authorization message
-type RoleMenuAuthorityReq struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	RoleId        uint64                 `protobuf:"varint,1,opt,name=role_id,json=roleId,proto3" json:"role_id"`
-	MenuIds       []uint64               `protobuf:"varint,2,rep,packed,name=menu_ids,json=menuIds,proto3" json:"menu_ids"`
+type TenantInitPluginProgress struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name"`
+	//  pending, running, success, failed, skipped, rolledback
+	Status        string `protobuf:"bytes,2,opt,name=status,proto3" json:"status"`
+	Error         string `protobuf:"bytes,3,opt,name=error,proto3" json:"error"`
+	Attempts      uint32 `protobuf:"varint,4,opt,name=attempts,proto3" json:"attempts"`
+	StartedAt     *int64 `protobuf:"varint,5,opt,name=started_at,json=startedAt,proto3,oneof" json:"started_at"`
+	FinishedAt    *int64 `protobuf:"varint,6,opt,name=finished_at,json=finishedAt,proto3,oneof" json:"finished_at"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RoleMenuAuthorityReq) Reset() {
-	*x = RoleMenuAuthorityReq{}
-	mi := &file_core_proto_msgTypes[76]
+func (x *TenantInitPluginProgress) Reset() {
+	*x = TenantInitPluginProgress{}
+	mi := &file_core_proto_msgTypes[176]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RoleMenuAuthorityReq) String() string {
+func (x *TenantInitPluginProgress) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RoleMenuAuthorityReq) ProtoMessage() {}
+func (*TenantInitPluginProgress) ProtoMessage() {}
 
-func (x *RoleMenuAuthorityReq) ProtoReflect() protoreflect.Message {
-	mi := &file_core_proto_msgTypes[76]
+func (x *TenantInitPluginProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[176]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6357,93 +13898,82 @@ func (x *RoleMenuAuthorityReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RoleMenuAuthorityReq.ProtoReflect.Descriptor instead.
-func (*RoleMenuAuthorityReq) Descriptor() ([]byte, []int) {
-	return file_core_proto_rawDescGZIP(), []int{76}
+// Deprecated: Use TenantInitPluginProgress.ProtoReflect.Descriptor instead.
+func (*TenantInitPluginProgress) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{176}
 }
 
-func (x *RoleMenuAuthorityReq) GetRoleId() uint64 {
+func (x *TenantInitPluginProgress) GetName() string {
 	if x != nil {
-		return x.RoleId
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-func (x *RoleMenuAuthorityReq) GetMenuIds() []uint64 {
+func (x *TenantInitPluginProgress) GetStatus() string {
 	if x != nil {
-		return x.MenuIds
+		return x.Status
 	}
-	return nil
-}
-
-//  return the role's authorization menu's ids
-type RoleMenuAuthorityResp struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	MenuIds       []uint64               `protobuf:"varint,1,rep,packed,name=menu_ids,json=menuIds,proto3" json:"menu_ids"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *RoleMenuAuthorityResp) Reset() {
-	*x = RoleMenuAuthorityResp{}
-	mi := &file_core_proto_msgTypes[77]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+	return ""
 }
 
-func (x *RoleMenuAuthorityResp) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *TenantInitPluginProgress) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
 }
 
-func (*RoleMenuAuthorityResp) ProtoMessage() {}
-
-func (x *RoleMenuAuthorityResp) ProtoReflect() protoreflect.Message {
-	mi := &file_core_proto_msgTypes[77]
+func (x *TenantInitPluginProgress) GetAttempts() uint32 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Attempts
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use RoleMenuAuthorityResp.ProtoReflect.Descriptor instead.
-func (*RoleMenuAuthorityResp) Descriptor() ([]byte, []int) {
-	return file_core_proto_rawDescGZIP(), []int{77}
+func (x *TenantInitPluginProgress) GetStartedAt() int64 {
+	if x != nil && x.StartedAt != nil {
+		return *x.StartedAt
+	}
+	return 0
 }
 
-func (x *RoleMenuAuthorityResp) GetMenuIds() []uint64 {
-	if x != nil {
-		return x.MenuIds
+func (x *TenantInitPluginProgress) GetFinishedAt() int64 {
+	if x != nil && x.FinishedAt != nil {
+		return *x.FinishedAt
 	}
-	return nil
+	return 0
 }
 
-type RoleStatusChangeParam struct {
+type TenantInitReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id"`
-	Status        uint32                 `protobuf:"varint,4,opt,name=status,proto3" json:"status"`
+	TenantId      uint64                 `protobuf:"varint,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id"`
+	AdminUsername *string                `protobuf:"bytes,2,opt,name=admin_username,json=adminUsername,proto3,oneof" json:"admin_username"`
+	AdminPassword *string                `protobuf:"bytes,3,opt,name=admin_password,json=adminPassword,proto3,oneof" json:"admin_password"`
+	AdminEmail    *string                `protobuf:"bytes,4,opt,name=admin_email,json=adminEmail,proto3,oneof" json:"admin_email"`
+	//  Initialization template name, empty means the base template | 初始化模板名称，为空时使用基础模板
+	Template *string `protobuf:"bytes,5,opt,name=template,proto3,oneof" json:"template"`
+	//  Only plan what would be created | 试运行，仅列出将要创建的数据
+	DryRun        *bool `protobuf:"varint,6,opt,name=dry_run,json=dryRun,proto3,oneof" json:"dry_run"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RoleStatusChangeParam) Reset() {
-	*x = RoleStatusChangeParam{}
-	mi := &file_core_proto_msgTypes[78]
+func (x *TenantInitReq) Reset() {
+	*x = TenantInitReq{}
+	mi := &file_core_proto_msgTypes[177]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RoleStatusChangeParam) String() string {
+func (x *TenantInitReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RoleStatusChangeParam) ProtoMessage() {}
+func (*TenantInitReq) ProtoMessage() {}
 
-func (x *RoleStatusChangeParam) ProtoReflect() protoreflect.Message {
-	mi := &file_core_proto_msgTypes[78]
+func (x *TenantInitReq) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[177]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6454,51 +13984,86 @@ func (x *RoleStatusChangeParam) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RoleStatusChangeParam.ProtoReflect.Descriptor instead.
-func (*RoleStatusChangeParam) Descriptor() ([]byte, []int) {
-	return file_core_proto_rawDescGZIP(), []int{78}
+// Deprecated: Use TenantInitReq.ProtoReflect.Descriptor instead.
+func (*TenantInitReq) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{177}
 }
 
-func (x *RoleStatusChangeParam) GetId() uint64 {
+func (x *TenantInitReq) GetTenantId() uint64 {
 	if x != nil {
-		return x.Id
+		return x.TenantId
 	}
 	return 0
 }
 
-func (x *RoleStatusChangeParam) GetStatus() uint32 {
-	if x != nil {
-		return x.Status
+func (x *TenantInitReq) GetAdminUsername() string {
+	if x != nil && x.AdminUsername != nil {
+		return *x.AdminUsername
 	}
-	return 0
+	return ""
 }
 
-type RoleUnallocatedListReq struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Page          uint64                 `protobuf:"varint,1,opt,name=page,proto3" json:"page"`
-	PageSize      uint64                 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size"`
-	RoleId        uint64                 `protobuf:"varint,3,opt,name=roleId,proto3" json:"roleId"`
-	UserName      *string                `protobuf:"bytes,4,opt,name=userName,proto3,oneof" json:"userName"`
-	Mobile        *string                `protobuf:"bytes,5,opt,name=mobile,proto3,oneof" json:"mobile"`
+func (x *TenantInitReq) GetAdminPassword() string {
+	if x != nil && x.AdminPassword != nil {
+		return *x.AdminPassword
+	}
+	return ""
+}
+
+func (x *TenantInitReq) GetAdminEmail() string {
+	if x != nil && x.AdminEmail != nil {
+		return *x.AdminEmail
+	}
+	return ""
+}
+
+func (x *TenantInitReq) GetTemplate() string {
+	if x != nil && x.Template != nil {
+		return *x.Template
+	}
+	return ""
+}
+
+func (x *TenantInitReq) GetDryRun() bool {
+	if x != nil && x.DryRun != nil {
+		return *x.DryRun
+	}
+	return false
+}
+
+type TenantInitTemplateInfo struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          *uint64                `protobuf:"varint,1,opt,name=id,proto3,oneof" json:"id"`
+	CreatedAt   *int64                 `protobuf:"varint,2,opt,name=created_at,json=createdAt,proto3,oneof" json:"created_at"`
+	UpdatedAt   *int64                 `protobuf:"varint,3,opt,name=updated_at,json=updatedAt,proto3,oneof" json:"updated_at"`
+	Status      *uint32                `protobuf:"varint,4,opt,name=status,proto3,oneof" json:"status"`
+	Name        *string                `protobuf:"bytes,5,opt,name=name,proto3,oneof" json:"name"`
+	Version     *string                `protobuf:"bytes,6,opt,name=version,proto3,oneof" json:"version"`
+	Description *string                `protobuf:"bytes,7,opt,name=description,proto3,oneof" json:"description"`
+	//  The base template it overlays | 基础模板名称
+	BaseTemplate *string `protobuf:"bytes,8,opt,name=base_template,json=baseTemplate,proto3,oneof" json:"base_template"`
+	//  Components as JSON | 模板组件 JSON
+	Components    *string `protobuf:"bytes,9,opt,name=components,proto3,oneof" json:"components"`
+	Sort          *uint32 `protobuf:"varint,10,opt,name=sort,proto3,oneof" json:"sort"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RoleUnallocatedListReq) Reset() {
-	*x = RoleUnallocatedListReq{}
-	mi := &file_core_proto_msgTypes[79]
+func (x *TenantInitTemplateInfo) Reset() {
+	*x = TenantInitTemplateInfo{}
+	mi := &file_core_proto_msgTypes[178]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RoleUnallocatedListReq) String() string {
+func (x *TenantInitTemplateInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RoleUnallocatedListReq) ProtoMessage() {}
+func (*TenantInitTemplateInfo) ProtoMessage() {}
 
-func (x *RoleUnallocatedListReq) ProtoReflect() protoreflect.Message {
-	mi := &file_core_proto_msgTypes[79]
+func (x *TenantInitTemplateInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[178]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6509,70 +14074,106 @@ func (x *RoleUnallocatedListReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RoleUnallocatedListReq.ProtoReflect.Descriptor instead.
-func (*RoleUnallocatedListReq) Descriptor() ([]byte, []int) {
-	return file_core_proto_rawDescGZIP(), []int{79}
+// Deprecated: Use TenantInitTemplateInfo.ProtoReflect.Descriptor instead.
+func (*TenantInitTemplateInfo) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{178}
 }
 
-func (x *RoleUnallocatedListReq) GetPage() uint64 {
-	if x != nil {
-		return x.Page
+func (x *TenantInitTemplateInfo) GetId() uint64 {
+	if x != nil && x.Id != nil {
+		return *x.Id
 	}
 	return 0
 }
 
-func (x *RoleUnallocatedListReq) GetPageSize() uint64 {
-	if x != nil {
-		return x.PageSize
+func (x *TenantInitTemplateInfo) GetCreatedAt() int64 {
+	if x != nil && x.CreatedAt != nil {
+		return *x.CreatedAt
 	}
 	return 0
 }
 
-func (x *RoleUnallocatedListReq) GetRoleId() uint64 {
-	if x != nil {
-		return x.RoleId
+func (x *TenantInitTemplateInfo) GetUpdatedAt() int64 {
+	if x != nil && x.UpdatedAt != nil {
+		return *x.UpdatedAt
 	}
 	return 0
 }
 
-func (x *RoleUnallocatedListReq) GetUserName() string {
-	if x != nil && x.UserName != nil {
-		return *x.UserName
+func (x *TenantInitTemplateInfo) GetStatus() uint32 {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return 0
+}
+
+func (x *TenantInitTemplateInfo) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
 	}
 	return ""
 }
 
-func (x *RoleUnallocatedListReq) GetMobile() string {
-	if x != nil && x.Mobile != nil {
-		return *x.Mobile
+func (x *TenantInitTemplateInfo) GetVersion() string {
+	if x != nil && x.Version != nil {
+		return *x.Version
 	}
 	return ""
 }
 
-//  规则同步请求
-type SyncCasbinRulesReq struct {
+func (x *TenantInitTemplateInfo) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *TenantInitTemplateInfo) GetBaseTemplate() string {
+	if x != nil && x.BaseTemplate != nil {
+		return *x.BaseTemplate
+	}
+	return ""
+}
+
+func (x *TenantInitTemplateInfo) GetComponents() string {
+	if x != nil && x.Components != nil {
+		return *x.Components
+	}
+	return ""
+}
+
+func (x *TenantInitTemplateInfo) GetSort() uint32 {
+	if x != nil && x.Sort != nil {
+		return *x.Sort
+	}
+	return 0
+}
+
+type TenantInitTemplateListReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ServiceName   *string                `protobuf:"bytes,1,opt,name=service_name,json=serviceName,proto3,oneof" json:"service_name"`
-	ForceReload   *bool                  `protobuf:"varint,2,opt,name=force_reload,json=forceReload,proto3,oneof" json:"force_reload"`
+	Page          uint64                 `protobuf:"varint,1,opt,name=page,proto3" json:"page"`
+	PageSize      uint64                 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size"`
+	Name          *string                `protobuf:"bytes,3,opt,name=name,proto3,oneof" json:"name"`
+	BaseTemplate  *string                `protobuf:"bytes,4,opt,name=base_template,json=baseTemplate,proto3,oneof" json:"base_template"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SyncCasbinRulesReq) Reset() {
-	*x = SyncCasbinRulesReq{}
-	mi := &file_core_proto_msgTypes[80]
+func (x *TenantInitTemplateListReq) Reset() {
+	*x = TenantInitTemplateListReq{}
+	mi := &file_core_proto_msgTypes[179]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SyncCasbinRulesReq) String() string {
+func (x *TenantInitTemplateListReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SyncCasbinRulesReq) ProtoMessage() {}
+func (*TenantInitTemplateListReq) ProtoMessage() {}
 
-func (x *SyncCasbinRulesReq) ProtoReflect() protoreflect.Message {
-	mi := &file_core_proto_msgTypes[80]
+func (x *TenantInitTemplateListReq) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[179]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6583,50 +14184,62 @@ func (x *SyncCasbinRulesReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SyncCasbinRulesReq.ProtoReflect.Descriptor instead.
-func (*SyncCasbinRulesReq) Descriptor() ([]byte, []int) {
-	return file_core_proto_rawDescGZIP(), []int{80}
+// Deprecated: Use TenantInitTemplateListReq.ProtoReflect.Descriptor instead.
+func (*TenantInitTemplateListReq) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{179}
 }
 
-func (x *SyncCasbinRulesReq) GetServiceName() string {
-	if x != nil && x.ServiceName != nil {
-		return *x.ServiceName
+func (x *TenantInitTemplateListReq) GetPage() uint64 {
+	if x != nil {
+		return x.Page
 	}
-	return ""
+	return 0
 }
 
-func (x *SyncCasbinRulesReq) GetForceReload() bool {
-	if x != nil && x.ForceReload != nil {
-		return *x.ForceReload
+func (x *TenantInitTemplateListReq) GetPageSize() uint64 {
+	if x != nil {
+		return x.PageSize
 	}
-	return false
+	return 0
 }
 
-//  规则同步响应
-type SyncCasbinRulesResp struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	SyncedCount    int32                  `protobuf:"varint,1,opt,name=synced_count,json=syncedCount,proto3" json:"synced_count"`
-	SyncedServices []string               `protobuf:"bytes,2,rep,name=synced_services,json=syncedServices,proto3" json:"synced_services"`
-	SyncDurationMs int64                  `protobuf:"varint,3,opt,name=sync_duration_ms,json=syncDurationMs,proto3" json:"sync_duration_ms"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *TenantInitTemplateListReq) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
 }
 
-func (x *SyncCasbinRulesResp) Reset() {
-	*x = SyncCasbinRulesResp{}
-	mi := &file_core_proto_msgTypes[81]
+func (x *TenantInitTemplateListReq) GetBaseTemplate() string {
+	if x != nil && x.BaseTemplate != nil {
+		return *x.BaseTemplate
+	}
+	return ""
+}
+
+type TenantInitTemplateListResp struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Total         uint64                    `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
+	Data          []*TenantInitTemplateInfo `protobuf:"bytes,2,rep,name=data,proto3" json:"data"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TenantInitTemplateListResp) Reset() {
+	*x = TenantInitTemplateListResp{}
+	mi := &file_core_proto_msgTypes[180]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SyncCasbinRulesResp) String() string {
+func (x *TenantInitTemplateListResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SyncCasbinRulesResp) ProtoMessage() {}
+func (*TenantInitTemplateListResp) ProtoMessage() {}
 
-func (x *SyncCasbinRulesResp) ProtoReflect() protoreflect.Message {
-	mi := &file_core_proto_msgTypes[81]
+func (x *TenantInitTemplateListResp) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[180]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6637,54 +14250,48 @@ func (x *SyncCasbinRulesResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SyncCasbinRulesResp.ProtoReflect.Descriptor instead.
-func (*SyncCasbinRulesResp) Descriptor() ([]byte, []int) {
-	return file_core_proto_rawDescGZIP(), []int{81}
+// Deprecated: Use TenantInitTemplateListResp.ProtoReflect.Descriptor instead.
+func (*TenantInitTemplateListResp) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{180}
 }
 
-func (x *SyncCasbinRulesResp) GetSyncedCount() int32 {
+func (x *TenantInitTemplateListResp) GetTotal() uint64 {
 	if x != nil {
-		return x.SyncedCount
+		return x.Total
 	}
 	return 0
 }
 
-func (x *SyncCasbinRulesResp) GetSyncedServices() []string {
+func (x *TenantInitTemplateListResp) GetData() []*TenantInitTemplateInfo {
 	if x != nil {
-		return x.SyncedServices
+		return x.Data
 	}
 	return nil
 }
 
-func (x *SyncCasbinRulesResp) GetSyncDurationMs() int64 {
-	if x != nil {
-		return x.SyncDurationMs
-	}
-	return 0
-}
-
-type TenantCodeReq struct {
+//  The template merged with its base templates | 与基础模板合并后的模板
+type TenantInitTemplatePreviewReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TenantCodeReq) Reset() {
-	*x = TenantCodeReq{}
-	mi := &file_core_proto_msgTypes[82]
+func (x *TenantInitTemplatePreviewReq) Reset() {
+	*x = TenantInitTemplatePreviewReq{}
+	mi := &file_core_proto_msgTypes[181]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TenantCodeReq) String() string {
+func (x *TenantInitTemplatePreviewReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TenantCodeReq) ProtoMessage() {}
+func (*TenantInitTemplatePreviewReq) ProtoMessage() {}
 
-func (x *TenantCodeReq) ProtoReflect() protoreflect.Message {
-	mi := &file_core_proto_msgTypes[82]
+func (x *TenantInitTemplatePreviewReq) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[181]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6695,49 +14302,41 @@ func (x *TenantCodeReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TenantCodeReq.ProtoReflect.Descriptor instead.
-func (*TenantCodeReq) Descriptor() ([]byte, []int) {
-	return file_core_proto_rawDescGZIP(), []int{82}
+// Deprecated: Use TenantInitTemplatePreviewReq.ProtoReflect.Descriptor instead.
+func (*TenantInitTemplatePreviewReq) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{181}
 }
 
-func (x *TenantCodeReq) GetCode() string {
+func (x *TenantInitTemplatePreviewReq) GetName() string {
 	if x != nil {
-		return x.Code
+		return x.Name
 	}
 	return ""
 }
 
-type TenantInfo struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            *uint64                `protobuf:"varint,1,opt,name=id,proto3,oneof" json:"id"`
-	CreatedAt     *int64                 `protobuf:"varint,2,opt,name=created_at,json=createdAt,proto3,oneof" json:"created_at"`
-	UpdatedAt     *int64                 `protobuf:"varint,3,opt,name=updated_at,json=updatedAt,proto3,oneof" json:"updated_at"`
-	Status        *uint32                `protobuf:"varint,4,opt,name=status,proto3,oneof" json:"status"`
-	Name          *string                `protobuf:"bytes,5,opt,name=name,proto3,oneof" json:"name"`
-	Code          *string                `protobuf:"bytes,6,opt,name=code,proto3,oneof" json:"code"`
-	Description   *string                `protobuf:"bytes,7,opt,name=description,proto3,oneof" json:"description"`
-	ExpiredAt     *int64                 `protobuf:"varint,8,opt,name=expired_at,json=expiredAt,proto3,oneof" json:"expired_at"`
-	Config        *string                `protobuf:"bytes,9,opt,name=config,proto3,oneof" json:"config"`
-	CreatedBy     *uint64                `protobuf:"varint,10,opt,name=created_by,json=createdBy,proto3,oneof" json:"created_by"`
+type TenantInitTemplatePreviewResp struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	//  The merged template as JSON | 合并后的模板 JSON
+	Content       string `protobuf:"bytes,1,opt,name=content,proto3" json:"content"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TenantInfo) Reset() {
-	*x = TenantInfo{}
-	mi := &file_core_proto_msgTypes[83]
+func (x *TenantInitTemplatePreviewResp) Reset() {
+	*x = TenantInitTemplatePreviewResp{}
+	mi := &file_core_proto_msgTypes[182]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TenantInfo) String() string {
+func (x *TenantInitTemplatePreviewResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TenantInfo) ProtoMessage() {}
+func (*TenantInitTemplatePreviewResp) ProtoMessage() {}
 
-func (x *TenantInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_core_proto_msgTypes[83]
+func (x *TenantInitTemplatePreviewResp) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[182]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6748,106 +14347,182 @@ func (x *TenantInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TenantInfo.ProtoReflect.Descriptor instead.
-func (*TenantInfo) Descriptor() ([]byte, []int) {
-	return file_core_proto_rawDescGZIP(), []int{83}
+// Deprecated: Use TenantInitTemplatePreviewResp.ProtoReflect.Descriptor instead.
+func (*TenantInitTemplatePreviewResp) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{182}
 }
 
-func (x *TenantInfo) GetId() uint64 {
-	if x != nil && x.Id != nil {
-		return *x.Id
+func (x *TenantInitTemplatePreviewResp) GetContent() string {
+	if x != nil {
+		return x.Content
 	}
-	return 0
+	return ""
 }
 
-func (x *TenantInfo) GetCreatedAt() int64 {
-	if x != nil && x.CreatedAt != nil {
-		return *x.CreatedAt
+type TenantListReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          uint64                 `protobuf:"varint,1,opt,name=page,proto3" json:"page"`
+	PageSize      uint64                 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size"`
+	Name          *string                `protobuf:"bytes,3,opt,name=name,proto3,oneof" json:"name"`
+	Code          *string                `protobuf:"bytes,4,opt,name=code,proto3,oneof" json:"code"`
+	Status        *uint32                `protobuf:"varint,5,opt,name=status,proto3,oneof" json:"status"`
+	CreatedBy     *uint64                `protobuf:"varint,6,opt,name=created_by,json=createdBy,proto3,oneof" json:"created_by"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TenantListReq) Reset() {
+	*x = TenantListReq{}
+	mi := &file_core_proto_msgTypes[183]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TenantListReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TenantListReq) ProtoMessage() {}
+
+func (x *TenantListReq) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[183]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *TenantInfo) GetUpdatedAt() int64 {
-	if x != nil && x.UpdatedAt != nil {
-		return *x.UpdatedAt
+// Deprecated: Use TenantListReq.ProtoReflect.Descriptor instead.
+func (*TenantListReq) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{183}
+}
+
+func (x *TenantListReq) GetPage() uint64 {
+	if x != nil {
+		return x.Page
 	}
 	return 0
 }
 
-func (x *TenantInfo) GetStatus() uint32 {
-	if x != nil && x.Status != nil {
-		return *x.Status
+func (x *TenantListReq) GetPageSize() uint64 {
+	if x != nil {
+		return x.PageSize
 	}
 	return 0
 }
 
-func (x *TenantInfo) GetName() string {
+func (x *TenantListReq) GetName() string {
 	if x != nil && x.Name != nil {
 		return *x.Name
 	}
 	return ""
 }
 
-func (x *TenantInfo) GetCode() string {
+func (x *TenantListReq) GetCode() string {
 	if x != nil && x.Code != nil {
 		return *x.Code
 	}
 	return ""
 }
 
-func (x *TenantInfo) GetDescription() string {
-	if x != nil && x.Description != nil {
-		return *x.Description
+func (x *TenantListReq) GetStatus() uint32 {
+	if x != nil && x.Status != nil {
+		return *x.Status
 	}
-	return ""
+	return 0
 }
 
-func (x *TenantInfo) GetExpiredAt() int64 {
-	if x != nil && x.ExpiredAt != nil {
-		return *x.ExpiredAt
+func (x *TenantListReq) GetCreatedBy() uint64 {
+	if x != nil && x.CreatedBy != nil {
+		return *x.CreatedBy
 	}
 	return 0
 }
 
-func (x *TenantInfo) GetConfig() string {
-	if x != nil && x.Config != nil {
-		return *x.Config
+type TenantListResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Total         uint64                 `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
+	Data          []*TenantInfo          `protobuf:"bytes,2,rep,name=data,proto3" json:"data"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TenantListResp) Reset() {
+	*x = TenantListResp{}
+	mi := &file_core_proto_msgTypes[184]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TenantListResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TenantListResp) ProtoMessage() {}
+
+func (x *TenantListResp) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[184]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *TenantInfo) GetCreatedBy() uint64 {
-	if x != nil && x.CreatedBy != nil {
-		return *x.CreatedBy
+// Deprecated: Use TenantListResp.ProtoReflect.Descriptor instead.
+func (*TenantListResp) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{184}
+}
+
+func (x *TenantListResp) GetTotal() uint64 {
+	if x != nil {
+		return x.Total
 	}
 	return 0
 }
 
-type TenantInitReq struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	TenantId      uint64                 `protobuf:"varint,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id"`
-	AdminUsername *string                `protobuf:"bytes,2,opt,name=admin_username,json=adminUsername,proto3,oneof" json:"admin_username"`
-	AdminPassword *string                `protobuf:"bytes,3,opt,name=admin_password,json=adminPassword,proto3,oneof" json:"admin_password"`
-	AdminEmail    *string                `protobuf:"bytes,4,opt,name=admin_email,json=adminEmail,proto3,oneof" json:"admin_email"`
+func (x *TenantListResp) GetData() []*TenantInfo {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+//  The request sent to the remote plugins, the admin password is not sent | 发送给远程插件的初始化请求，不包含管理员密码
+type TenantPluginInitReq struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	TenantId  uint64                 `protobuf:"varint,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id"`
+	RequestId string                 `protobuf:"bytes,2,opt,name=request_id,json=requestId,proto3" json:"request_id"`
+	//  full, repair
+	Mode          string  `protobuf:"bytes,3,opt,name=mode,proto3" json:"mode"`
+	AdminUsername *string `protobuf:"bytes,4,opt,name=admin_username,json=adminUsername,proto3,oneof" json:"admin_username"`
+	AdminEmail    *string `protobuf:"bytes,5,opt,name=admin_email,json=adminEmail,proto3,oneof" json:"admin_email"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TenantInitReq) Reset() {
-	*x = TenantInitReq{}
-	mi := &file_core_proto_msgTypes[84]
+func (x *TenantPluginInitReq) Reset() {
+	*x = TenantPluginInitReq{}
+	mi := &file_core_proto_msgTypes[185]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TenantInitReq) String() string {
+func (x *TenantPluginInitReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TenantInitReq) ProtoMessage() {}
+func (*TenantPluginInitReq) ProtoMessage() {}
 
-func (x *TenantInitReq) ProtoReflect() protoreflect.Message {
-	mi := &file_core_proto_msgTypes[84]
+func (x *TenantPluginInitReq) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[185]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6858,66 +14533,112 @@ func (x *TenantInitReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TenantInitReq.ProtoReflect.Descriptor instead.
-func (*TenantInitReq) Descriptor() ([]byte, []int) {
-	return file_core_proto_rawDescGZIP(), []int{84}
+// Deprecated: Use TenantPluginInitReq.ProtoReflect.Descriptor instead.
+func (*TenantPluginInitReq) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{185}
 }
 
-func (x *TenantInitReq) GetTenantId() uint64 {
+func (x *TenantPluginInitReq) GetTenantId() uint64 {
 	if x != nil {
 		return x.TenantId
 	}
 	return 0
 }
 
-func (x *TenantInitReq) GetAdminUsername() string {
-	if x != nil && x.AdminUsername != nil {
-		return *x.AdminUsername
+func (x *TenantPluginInitReq) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
 	}
 	return ""
 }
 
-func (x *TenantInitReq) GetAdminPassword() string {
-	if x != nil && x.AdminPassword != nil {
-		return *x.AdminPassword
+func (x *TenantPluginInitReq) GetMode() string {
+	if x != nil {
+		return x.Mode
 	}
 	return ""
 }
 
-func (x *TenantInitReq) GetAdminEmail() string {
+func (x *TenantPluginInitReq) GetAdminUsername() string {
+	if x != nil && x.AdminUsername != nil {
+		return *x.AdminUsername
+	}
+	return ""
+}
+
+func (x *TenantPluginInitReq) GetAdminEmail() string {
 	if x != nil && x.AdminEmail != nil {
 		return *x.AdminEmail
 	}
 	return ""
 }
 
-type TenantListReq struct {
+type TenantPluginStatusResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Page          uint64                 `protobuf:"varint,1,opt,name=page,proto3" json:"page"`
-	PageSize      uint64                 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size"`
-	Name          *string                `protobuf:"bytes,3,opt,name=name,proto3,oneof" json:"name"`
-	Code          *string                `protobuf:"bytes,4,opt,name=code,proto3,oneof" json:"code"`
-	Status        *uint32                `protobuf:"varint,5,opt,name=status,proto3,oneof" json:"status"`
-	CreatedBy     *uint64                `protobuf:"varint,6,opt,name=created_by,json=createdBy,proto3,oneof" json:"created_by"`
+	Initialized   bool                   `protobuf:"varint,1,opt,name=initialized,proto3" json:"initialized"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TenantListReq) Reset() {
-	*x = TenantListReq{}
-	mi := &file_core_proto_msgTypes[85]
+func (x *TenantPluginStatusResp) Reset() {
+	*x = TenantPluginStatusResp{}
+	mi := &file_core_proto_msgTypes[186]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TenantPluginStatusResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TenantPluginStatusResp) ProtoMessage() {}
+
+func (x *TenantPluginStatusResp) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[186]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TenantPluginStatusResp.ProtoReflect.Descriptor instead.
+func (*TenantPluginStatusResp) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{186}
+}
+
+func (x *TenantPluginStatusResp) GetInitialized() bool {
+	if x != nil {
+		return x.Initialized
+	}
+	return false
+}
+
+type TenantPluginTenantReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      uint64                 `protobuf:"varint,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TenantPluginTenantReq) Reset() {
+	*x = TenantPluginTenantReq{}
+	mi := &file_core_proto_msgTypes[187]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TenantListReq) String() string {
+func (x *TenantPluginTenantReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TenantListReq) ProtoMessage() {}
+func (*TenantPluginTenantReq) ProtoMessage() {}
 
-func (x *TenantListReq) ProtoReflect() protoreflect.Message {
-	mi := &file_core_proto_msgTypes[85]
+func (x *TenantPluginTenantReq) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[187]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6928,76 +14649,44 @@ func (x *TenantListReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TenantListReq.ProtoReflect.Descriptor instead.
-func (*TenantListReq) Descriptor() ([]byte, []int) {
-	return file_core_proto_rawDescGZIP(), []int{85}
-}
-
-func (x *TenantListReq) GetPage() uint64 {
-	if x != nil {
-		return x.Page
-	}
-	return 0
+// Deprecated: Use TenantPluginTenantReq.ProtoReflect.Descriptor instead.
+func (*TenantPluginTenantReq) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{187}
 }
 
-func (x *TenantListReq) GetPageSize() uint64 {
+func (x *TenantPluginTenantReq) GetTenantId() uint64 {
 	if x != nil {
-		return x.PageSize
-	}
-	return 0
-}
-
-func (x *TenantListReq) GetName() string {
-	if x != nil && x.Name != nil {
-		return *x.Name
-	}
-	return ""
-}
-
-func (x *TenantListReq) GetCode() string {
-	if x != nil && x.Code != nil {
-		return *x.Code
-	}
-	return ""
-}
-
-func (x *TenantListReq) GetStatus() uint32 {
-	if x != nil && x.Status != nil {
-		return *x.Status
-	}
-	return 0
-}
-
-func (x *TenantListReq) GetCreatedBy() uint64 {
-	if x != nil && x.CreatedBy != nil {
-		return *x.CreatedBy
+		return x.TenantId
 	}
 	return 0
 }
 
-type TenantListResp struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Total         uint64                 `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
-	Data          []*TenantInfo          `protobuf:"bytes,2,rep,name=data,proto3" json:"data"`
+type TenantRepairReq struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	TenantId uint64                 `protobuf:"varint,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id"`
+	//  Repair all findings when empty | 为空时修复全部问题
+	Codes []string `protobuf:"bytes,2,rep,name=codes,proto3" json:"codes"`
+	//  Required when the admin user has to be recreated | 需要重建管理员用户时必填
+	AdminPassword *string `protobuf:"bytes,3,opt,name=admin_password,json=adminPassword,proto3,oneof" json:"admin_password"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TenantListResp) Reset() {
-	*x = TenantListResp{}
-	mi := &file_core_proto_msgTypes[86]
+func (x *TenantRepairReq) Reset() {
+	*x = TenantRepairReq{}
+	mi := &file_core_proto_msgTypes[188]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TenantListResp) String() string {
+func (x *TenantRepairReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TenantListResp) ProtoMessage() {}
+func (*TenantRepairReq) ProtoMessage() {}
 
-func (x *TenantListResp) ProtoReflect() protoreflect.Message {
-	mi := &file_core_proto_msgTypes[86]
+func (x *TenantRepairReq) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[188]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7008,25 +14697,32 @@ func (x *TenantListResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TenantListResp.ProtoReflect.Descriptor instead.
-func (*TenantListResp) Descriptor() ([]byte, []int) {
-	return file_core_proto_rawDescGZIP(), []int{86}
+// Deprecated: Use TenantRepairReq.ProtoReflect.Descriptor instead.
+func (*TenantRepairReq) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{188}
 }
 
-func (x *TenantListResp) GetTotal() uint64 {
+func (x *TenantRepairReq) GetTenantId() uint64 {
 	if x != nil {
-		return x.Total
+		return x.TenantId
 	}
 	return 0
 }
 
-func (x *TenantListResp) GetData() []*TenantInfo {
+func (x *TenantRepairReq) GetCodes() []string {
 	if x != nil {
-		return x.Data
+		return x.Codes
 	}
 	return nil
 }
 
+func (x *TenantRepairReq) GetAdminPassword() string {
+	if x != nil && x.AdminPassword != nil {
+		return *x.AdminPassword
+	}
+	return ""
+}
+
 type TenantStatusReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id"`
@@ -7037,7 +14733,7 @@ type TenantStatusReq struct {
 
 func (x *TenantStatusReq) Reset() {
 	*x = TenantStatusReq{}
-	mi := &file_core_proto_msgTypes[87]
+	mi := &file_core_proto_msgTypes[189]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7049,7 +14745,7 @@ func (x *TenantStatusReq) String() string {
 func (*TenantStatusReq) ProtoMessage() {}
 
 func (x *TenantStatusReq) ProtoReflect() protoreflect.Message {
-	mi := &file_core_proto_msgTypes[87]
+	mi := &file_core_proto_msgTypes[189]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7062,7 +14758,7 @@ func (x *TenantStatusReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TenantStatusReq.ProtoReflect.Descriptor instead.
 func (*TenantStatusReq) Descriptor() ([]byte, []int) {
-	return file_core_proto_rawDescGZIP(), []int{87}
+	return file_core_proto_rawDescGZIP(), []int{189}
 }
 
 func (x *TenantStatusReq) GetId() uint64 {
@@ -7080,24 +14776,30 @@ func (x *TenantStatusReq) GetStatus() uint32 {
 }
 
 type TokenInfo struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            *string                `protobuf:"bytes,1,opt,name=id,proto3,oneof" json:"id"`
-	CreatedAt     *int64                 `protobuf:"varint,2,opt,name=created_at,json=createdAt,proto3,oneof" json:"created_at"`
-	UpdatedAt     *int64                 `protobuf:"varint,3,opt,name=updated_at,json=updatedAt,proto3,oneof" json:"updated_at"`
-	Status        *uint32                `protobuf:"varint,4,opt,name=status,proto3,oneof" json:"status"`
-	Uuid          *string                `protobuf:"bytes,5,opt,name=uuid,proto3,oneof" json:"uuid"`
-	Token         *string                `protobuf:"bytes,6,opt,name=token,proto3,oneof" json:"token"`
-	Source        *string                `protobuf:"bytes,7,opt,name=source,proto3,oneof" json:"source"`
-	ExpiredAt     *int64                 `protobuf:"varint,8,opt,name=expired_at,json=expiredAt,proto3,oneof" json:"expired_at"`
-	Username      *string                `protobuf:"bytes,9,opt,name=username,proto3,oneof" json:"username"`
-	TenantId      *uint64                `protobuf:"varint,10,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id"`
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Id         *string                `protobuf:"bytes,1,opt,name=id,proto3,oneof" json:"id"`
+	CreatedAt  *int64                 `protobuf:"varint,2,opt,name=created_at,json=createdAt,proto3,oneof" json:"created_at"`
+	UpdatedAt  *int64                 `protobuf:"varint,3,opt,name=updated_at,json=updatedAt,proto3,oneof" json:"updated_at"`
+	Status     *uint32                `protobuf:"varint,4,opt,name=status,proto3,oneof" json:"status"`
+	Uuid       *string                `protobuf:"bytes,5,opt,name=uuid,proto3,oneof" json:"uuid"`
+	Token      *string                `protobuf:"bytes,6,opt,name=token,proto3,oneof" json:"token"`
+	Source     *string                `protobuf:"bytes,7,opt,name=source,proto3,oneof" json:"source"`
+	ExpiredAt  *int64                 `protobuf:"varint,8,opt,name=expired_at,json=expiredAt,proto3,oneof" json:"expired_at"`
+	Username   *string                `protobuf:"bytes,9,opt,name=username,proto3,oneof" json:"username"`
+	TenantId   *uint64                `protobuf:"varint,10,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id"`
+	Device     *string                `protobuf:"bytes,11,opt,name=device,proto3,oneof" json:"device"`
+	UserAgent  *string                `protobuf:"bytes,12,opt,name=user_agent,json=userAgent,proto3,oneof" json:"user_agent"`
+	Ip         *string                `protobuf:"bytes,13,opt,name=ip,proto3,oneof" json:"ip"`
+	LastSeenAt *int64                 `protobuf:"varint,14,opt,name=last_seen_at,json=lastSeenAt,proto3,oneof" json:"last_seen_at"`
+	//  Login session shared by the refreshed tokens, a new session is created if empty | 登录会话ID，刷新的令牌沿用登录时的会话，为空时创建新会话
+	SessionId     *string `protobuf:"bytes,15,opt,name=session_id,json=sessionId,proto3,oneof" json:"session_id"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *TokenInfo) Reset() {
 	*x = TokenInfo{}
-	mi := &file_core_proto_msgTypes[88]
+	mi := &file_core_proto_msgTypes[190]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7109,7 +14811,7 @@ func (x *TokenInfo) String() string {
 func (*TokenInfo) ProtoMessage() {}
 
 func (x *TokenInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_core_proto_msgTypes[88]
+	mi := &file_core_proto_msgTypes[190]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7122,7 +14824,7 @@ func (x *TokenInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TokenInfo.ProtoReflect.Descriptor instead.
 func (*TokenInfo) Descriptor() ([]byte, []int) {
-	return file_core_proto_rawDescGZIP(), []int{88}
+	return file_core_proto_rawDescGZIP(), []int{190}
 }
 
 func (x *TokenInfo) GetId() string {
@@ -7195,6 +14897,41 @@ func (x *TokenInfo) GetTenantId() uint64 {
 	return 0
 }
 
+func (x *TokenInfo) GetDevice() string {
+	if x != nil && x.Device != nil {
+		return *x.Device
+	}
+	return ""
+}
+
+func (x *TokenInfo) GetUserAgent() string {
+	if x != nil && x.UserAgent != nil {
+		return *x.UserAgent
+	}
+	return ""
+}
+
+func (x *TokenInfo) GetIp() string {
+	if x != nil && x.Ip != nil {
+		return *x.Ip
+	}
+	return ""
+}
+
+func (x *TokenInfo) GetLastSeenAt() int64 {
+	if x != nil && x.LastSeenAt != nil {
+		return *x.LastSeenAt
+	}
+	return 0
+}
+
+func (x *TokenInfo) GetSessionId() string {
+	if x != nil && x.SessionId != nil {
+		return *x.SessionId
+	}
+	return ""
+}
+
 type TokenListReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Page          uint64                 `protobuf:"varint,1,opt,name=page,proto3" json:"page"`
@@ -7209,7 +14946,7 @@ type TokenListReq struct {
 
 func (x *TokenListReq) Reset() {
 	*x = TokenListReq{}
-	mi := &file_core_proto_msgTypes[89]
+	mi := &file_core_proto_msgTypes[191]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7221,7 +14958,7 @@ func (x *TokenListReq) String() string {
 func (*TokenListReq) ProtoMessage() {}
 
 func (x *TokenListReq) ProtoReflect() protoreflect.Message {
-	mi := &file_core_proto_msgTypes[89]
+	mi := &file_core_proto_msgTypes[191]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7234,7 +14971,7 @@ func (x *TokenListReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TokenListReq.ProtoReflect.Descriptor instead.
 func (*TokenListReq) Descriptor() ([]byte, []int) {
-	return file_core_proto_rawDescGZIP(), []int{89}
+	return file_core_proto_rawDescGZIP(), []int{191}
 }
 
 func (x *TokenListReq) GetPage() uint64 {
@@ -7289,7 +15026,7 @@ type TokenListResp struct {
 
 func (x *TokenListResp) Reset() {
 	*x = TokenListResp{}
-	mi := &file_core_proto_msgTypes[90]
+	mi := &file_core_proto_msgTypes[192]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7301,7 +15038,7 @@ func (x *TokenListResp) String() string {
 func (*TokenListResp) ProtoMessage() {}
 
 func (x *TokenListResp) ProtoReflect() protoreflect.Message {
-	mi := &file_core_proto_msgTypes[90]
+	mi := &file_core_proto_msgTypes[192]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7314,7 +15051,7 @@ func (x *TokenListResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TokenListResp.ProtoReflect.Descriptor instead.
 func (*TokenListResp) Descriptor() ([]byte, []int) {
-	return file_core_proto_rawDescGZIP(), []int{90}
+	return file_core_proto_rawDescGZIP(), []int{192}
 }
 
 func (x *TokenListResp) GetTotal() uint64 {
@@ -7331,6 +15068,66 @@ func (x *TokenListResp) GetData() []*TokenInfo {
 	return nil
 }
 
+type TokenTouchReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token"`
+	Ip            *string                `protobuf:"bytes,2,opt,name=ip,proto3,oneof" json:"ip"`
+	UserAgent     *string                `protobuf:"bytes,3,opt,name=user_agent,json=userAgent,proto3,oneof" json:"user_agent"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TokenTouchReq) Reset() {
+	*x = TokenTouchReq{}
+	mi := &file_core_proto_msgTypes[193]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TokenTouchReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokenTouchReq) ProtoMessage() {}
+
+func (x *TokenTouchReq) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[193]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TokenTouchReq.ProtoReflect.Descriptor instead.
+func (*TokenTouchReq) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{193}
+}
+
+func (x *TokenTouchReq) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *TokenTouchReq) GetIp() string {
+	if x != nil && x.Ip != nil {
+		return *x.Ip
+	}
+	return ""
+}
+
+func (x *TokenTouchReq) GetUserAgent() string {
+	if x != nil && x.UserAgent != nil {
+		return *x.UserAgent
+	}
+	return ""
+}
+
 type UUIDReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
@@ -7340,7 +15137,7 @@ type UUIDReq struct {
 
 func (x *UUIDReq) Reset() {
 	*x = UUIDReq{}
-	mi := &file_core_proto_msgTypes[91]
+	mi := &file_core_proto_msgTypes[194]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7352,7 +15149,7 @@ func (x *UUIDReq) String() string {
 func (*UUIDReq) ProtoMessage() {}
 
 func (x *UUIDReq) ProtoReflect() protoreflect.Message {
-	mi := &file_core_proto_msgTypes[91]
+	mi := &file_core_proto_msgTypes[194]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7365,7 +15162,7 @@ func (x *UUIDReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UUIDReq.ProtoReflect.Descriptor instead.
 func (*UUIDReq) Descriptor() ([]byte, []int) {
-	return file_core_proto_rawDescGZIP(), []int{91}
+	return file_core_proto_rawDescGZIP(), []int{194}
 }
 
 func (x *UUIDReq) GetId() string {
@@ -7384,7 +15181,7 @@ type UUIDsReq struct {
 
 func (x *UUIDsReq) Reset() {
 	*x = UUIDsReq{}
-	mi := &file_core_proto_msgTypes[92]
+	mi := &file_core_proto_msgTypes[195]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7396,7 +15193,7 @@ func (x *UUIDsReq) String() string {
 func (*UUIDsReq) ProtoMessage() {}
 
 func (x *UUIDsReq) ProtoReflect() protoreflect.Message {
-	mi := &file_core_proto_msgTypes[92]
+	mi := &file_core_proto_msgTypes[195]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7409,7 +15206,7 @@ func (x *UUIDsReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UUIDsReq.ProtoReflect.Descriptor instead.
 func (*UUIDsReq) Descriptor() ([]byte, []int) {
-	return file_core_proto_rawDescGZIP(), []int{92}
+	return file_core_proto_rawDescGZIP(), []int{195}
 }
 
 func (x *UUIDsReq) GetIds() []string {
@@ -7429,7 +15226,7 @@ type UnbindOauthAccountReq struct {
 
 func (x *UnbindOauthAccountReq) Reset() {
 	*x = UnbindOauthAccountReq{}
-	mi := &file_core_proto_msgTypes[93]
+	mi := &file_core_proto_msgTypes[196]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7441,7 +15238,7 @@ func (x *UnbindOauthAccountReq) String() string {
 func (*UnbindOauthAccountReq) ProtoMessage() {}
 
 func (x *UnbindOauthAccountReq) ProtoReflect() protoreflect.Message {
-	mi := &file_core_proto_msgTypes[93]
+	mi := &file_core_proto_msgTypes[196]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7454,7 +15251,7 @@ func (x *UnbindOauthAccountReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UnbindOauthAccountReq.ProtoReflect.Descriptor instead.
 func (*UnbindOauthAccountReq) Descriptor() ([]byte, []int) {
-	return file_core_proto_rawDescGZIP(), []int{93}
+	return file_core_proto_rawDescGZIP(), []int{196}
 }
 
 func (x *UnbindOauthAccountReq) GetUserId() string {
@@ -7484,7 +15281,7 @@ type UpdateOauthSessionReq struct {
 
 func (x *UpdateOauthSessionReq) Reset() {
 	*x = UpdateOauthSessionReq{}
-	mi := &file_core_proto_msgTypes[94]
+	mi := &file_core_proto_msgTypes[197]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7496,7 +15293,7 @@ func (x *UpdateOauthSessionReq) String() string {
 func (*UpdateOauthSessionReq) ProtoMessage() {}
 
 func (x *UpdateOauthSessionReq) ProtoReflect() protoreflect.Message {
-	mi := &file_core_proto_msgTypes[94]
+	mi := &file_core_proto_msgTypes[197]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7509,7 +15306,7 @@ func (x *UpdateOauthSessionReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateOauthSessionReq.ProtoReflect.Descriptor instead.
 func (*UpdateOauthSessionReq) Descriptor() ([]byte, []int) {
-	return file_core_proto_rawDescGZIP(), []int{94}
+	return file_core_proto_rawDescGZIP(), []int{197}
 }
 
 func (x *UpdateOauthSessionReq) GetSessionId() string {
@@ -7574,7 +15371,7 @@ type UserInfo struct {
 
 func (x *UserInfo) Reset() {
 	*x = UserInfo{}
-	mi := &file_core_proto_msgTypes[95]
+	mi := &file_core_proto_msgTypes[198]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7586,7 +15383,7 @@ func (x *UserInfo) String() string {
 func (*UserInfo) ProtoMessage() {}
 
 func (x *UserInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_core_proto_msgTypes[95]
+	mi := &file_core_proto_msgTypes[198]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7599,7 +15396,7 @@ func (x *UserInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserInfo.ProtoReflect.Descriptor instead.
 func (*UserInfo) Descriptor() ([]byte, []int) {
-	return file_core_proto_rawDescGZIP(), []int{95}
+	return file_core_proto_rawDescGZIP(), []int{198}
 }
 
 func (x *UserInfo) GetId() string {
@@ -7753,7 +15550,7 @@ type UserListReq struct {
 
 func (x *UserListReq) Reset() {
 	*x = UserListReq{}
-	mi := &file_core_proto_msgTypes[96]
+	mi := &file_core_proto_msgTypes[199]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7765,7 +15562,7 @@ func (x *UserListReq) String() string {
 func (*UserListReq) ProtoMessage() {}
 
 func (x *UserListReq) ProtoReflect() protoreflect.Message {
-	mi := &file_core_proto_msgTypes[96]
+	mi := &file_core_proto_msgTypes[199]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7778,7 +15575,7 @@ func (x *UserListReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserListReq.ProtoReflect.Descriptor instead.
 func (*UserListReq) Descriptor() ([]byte, []int) {
-	return file_core_proto_rawDescGZIP(), []int{96}
+	return file_core_proto_rawDescGZIP(), []int{199}
 }
 
 func (x *UserListReq) GetPage() uint64 {
@@ -7861,7 +15658,7 @@ type UserListResp struct {
 
 func (x *UserListResp) Reset() {
 	*x = UserListResp{}
-	mi := &file_core_proto_msgTypes[97]
+	mi := &file_core_proto_msgTypes[200]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7872,8 +15669,124 @@ func (x *UserListResp) String() string {
 
 func (*UserListResp) ProtoMessage() {}
 
-func (x *UserListResp) ProtoReflect() protoreflect.Message {
-	mi := &file_core_proto_msgTypes[97]
+func (x *UserListResp) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[200]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserListResp.ProtoReflect.Descriptor instead.
+func (*UserListResp) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{200}
+}
+
+func (x *UserListResp) GetTotal() uint64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *UserListResp) GetData() []*UserInfo {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type UserSessionListReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          uint64                 `protobuf:"varint,1,opt,name=page,proto3" json:"page"`
+	PageSize      uint64                 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size"`
+	Uuid          string                 `protobuf:"bytes,3,opt,name=uuid,proto3" json:"uuid"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserSessionListReq) Reset() {
+	*x = UserSessionListReq{}
+	mi := &file_core_proto_msgTypes[201]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserSessionListReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserSessionListReq) ProtoMessage() {}
+
+func (x *UserSessionListReq) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[201]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserSessionListReq.ProtoReflect.Descriptor instead.
+func (*UserSessionListReq) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{201}
+}
+
+func (x *UserSessionListReq) GetPage() uint64 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *UserSessionListReq) GetPageSize() uint64 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *UserSessionListReq) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+type UserSessionRevokeReq struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	//  User's UUID | 用户的UUID
+	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid"`
+	//  Token IDs to revoke, revoke all sessions if empty | 需要注销的会话ID，为空时注销全部
+	Ids []string `protobuf:"bytes,2,rep,name=ids,proto3" json:"ids"`
+	//  Keep the session of this token, used to revoke all other sessions | 保留的会话Token，用于注销其他会话
+	KeepToken     *string `protobuf:"bytes,3,opt,name=keep_token,json=keepToken,proto3,oneof" json:"keep_token"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserSessionRevokeReq) Reset() {
+	*x = UserSessionRevokeReq{}
+	mi := &file_core_proto_msgTypes[202]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserSessionRevokeReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserSessionRevokeReq) ProtoMessage() {}
+
+func (x *UserSessionRevokeReq) ProtoReflect() protoreflect.Message {
+	mi := &file_core_proto_msgTypes[202]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7884,25 +15797,32 @@ func (x *UserListResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserListResp.ProtoReflect.Descriptor instead.
-func (*UserListResp) Descriptor() ([]byte, []int) {
-	return file_core_proto_rawDescGZIP(), []int{97}
+// Deprecated: Use UserSessionRevokeReq.ProtoReflect.Descriptor instead.
+func (*UserSessionRevokeReq) Descriptor() ([]byte, []int) {
+	return file_core_proto_rawDescGZIP(), []int{202}
 }
 
-func (x *UserListResp) GetTotal() uint64 {
+func (x *UserSessionRevokeReq) GetUuid() string {
 	if x != nil {
-		return x.Total
+		return x.Uuid
 	}
-	return 0
+	return ""
 }
 
-func (x *UserListResp) GetData() []*UserInfo {
+func (x *UserSessionRevokeReq) GetIds() []string {
 	if x != nil {
-		return x.Data
+		return x.Ids
 	}
 	return nil
 }
 
+func (x *UserSessionRevokeReq) GetKeepToken() string {
+	if x != nil && x.KeepToken != nil {
+		return *x.KeepToken
+	}
+	return ""
+}
+
 type UsernameReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username"`
@@ -7912,7 +15832,7 @@ type UsernameReq struct {
 
 func (x *UsernameReq) Reset() {
 	*x = UsernameReq{}
-	mi := &file_core_proto_msgTypes[98]
+	mi := &file_core_proto_msgTypes[203]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7924,7 +15844,7 @@ func (x *UsernameReq) String() string {
 func (*UsernameReq) ProtoMessage() {}
 
 func (x *UsernameReq) ProtoReflect() protoreflect.Message {
-	mi := &file_core_proto_msgTypes[98]
+	mi := &file_core_proto_msgTypes[203]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7937,7 +15857,7 @@ func (x *UsernameReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UsernameReq.ProtoReflect.Descriptor instead.
 func (*UsernameReq) Descriptor() ([]byte, []int) {
-	return file_core_proto_rawDescGZIP(), []int{98}
+	return file_core_proto_rawDescGZIP(), []int{203}
 }
 
 func (x *UsernameReq) GetUsername() string {
@@ -7958,7 +15878,7 @@ type ValidateCasbinRuleReq struct {
 
 func (x *ValidateCasbinRuleReq) Reset() {
 	*x = ValidateCasbinRuleReq{}
-	mi := &file_core_proto_msgTypes[99]
+	mi := &file_core_proto_msgTypes[204]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7970,7 +15890,7 @@ func (x *ValidateCasbinRuleReq) String() string {
 func (*ValidateCasbinRuleReq) ProtoMessage() {}
 
 func (x *ValidateCasbinRuleReq) ProtoReflect() protoreflect.Message {
-	mi := &file_core_proto_msgTypes[99]
+	mi := &file_core_proto_msgTypes[204]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7983,7 +15903,7 @@ func (x *ValidateCasbinRuleReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ValidateCasbinRuleReq.ProtoReflect.Descriptor instead.
 func (*ValidateCasbinRuleReq) Descriptor() ([]byte, []int) {
-	return file_core_proto_rawDescGZIP(), []int{99}
+	return file_core_proto_rawDescGZIP(), []int{204}
 }
 
 func (x *ValidateCasbinRuleReq) GetRule() *CasbinRuleInfo {
@@ -8013,7 +15933,7 @@ type ValidateCasbinRuleResp struct {
 
 func (x *ValidateCasbinRuleResp) Reset() {
 	*x = ValidateCasbinRuleResp{}
-	mi := &file_core_proto_msgTypes[100]
+	mi := &file_core_proto_msgTypes[205]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8025,7 +15945,7 @@ func (x *ValidateCasbinRuleResp) String() string {
 func (*ValidateCasbinRuleResp) ProtoMessage() {}
 
 func (x *ValidateCasbinRuleResp) ProtoReflect() protoreflect.Message {
-	mi := &file_core_proto_msgTypes[100]
+	mi := &file_core_proto_msgTypes[205]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8038,7 +15958,7 @@ func (x *ValidateCasbinRuleResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ValidateCasbinRuleResp.ProtoReflect.Descriptor instead.
 func (*ValidateCasbinRuleResp) Descriptor() ([]byte, []int) {
-	return file_core_proto_rawDescGZIP(), []int{100}
+	return file_core_proto_rawDescGZIP(), []int{205}
 }
 
 func (x *ValidateCasbinRuleResp) GetValid() bool {
@@ -8074,7 +15994,7 @@ var File_core_proto protoreflect.FileDescriptor
 const file_core_proto_rawDesc = "" +
 	"\n" +
 	"\n" +
-	"core.proto\x12\x04core\"\xab\x03\n" +
+	"core.proto\x12\x04core\"\xa3\x04\n" +
 	"\aApiInfo\x12\x13\n" +
 	"\x02id\x18\x01 \x01(\x04H\x00R\x02id\x88\x01\x01\x12\"\n" +
 	"\n" +
@@ -8087,7 +16007,11 @@ const file_core_proto_rawDesc = "" +
 	"\x06method\x18\a \x01(\tH\x06R\x06method\x88\x01\x01\x12$\n" +
 	"\vis_required\x18\b \x01(\bH\aR\n" +
 	"isRequired\x88\x01\x01\x12&\n" +
-	"\fservice_name\x18\t \x01(\tH\bR\vserviceName\x88\x01\x01B\x05\n" +
+	"\fservice_name\x18\t \x01(\tH\bR\vserviceName\x88\x01\x01\x12(\n" +
+	"\ris_deprecated\x18\n" +
+	" \x01(\bH\tR\fisDeprecated\x88\x01\x01\x12(\n" +
+	"\rdeprecated_at\x18\v \x01(\x03H\n" +
+	"R\fdeprecatedAt\x88\x01\x01B\x05\n" +
 	"\x03_idB\r\n" +
 	"\v_created_atB\r\n" +
 	"\v_updated_atB\a\n" +
@@ -8097,7 +16021,9 @@ const file_core_proto_rawDesc = "" +
 	"_api_groupB\t\n" +
 	"\a_methodB\x0e\n" +
 	"\f_is_requiredB\x0f\n" +
-	"\r_service_name\"\xda\x02\n" +
+	"\r_service_nameB\x10\n" +
+	"\x0e_is_deprecatedB\x10\n" +
+	"\x0e_deprecated_at\"\x96\x03\n" +
 	"\n" +
 	"ApiListReq\x12\x12\n" +
 	"\x04page\x18\x01 \x01(\x04R\x04page\x12\x1b\n" +
@@ -8108,17 +16034,77 @@ const file_core_proto_rawDesc = "" +
 	"\x06method\x18\x06 \x01(\tH\x03R\x06method\x88\x01\x01\x12\"\n" +
 	"\n" +
 	"is_default\x18\a \x01(\tH\x04R\tisDefault\x88\x01\x01\x12&\n" +
-	"\fservice_name\x18\b \x01(\tH\x05R\vserviceName\x88\x01\x01B\a\n" +
+	"\fservice_name\x18\b \x01(\tH\x05R\vserviceName\x88\x01\x01\x12(\n" +
+	"\ris_deprecated\x18\t \x01(\bH\x06R\fisDeprecated\x88\x01\x01B\a\n" +
 	"\x05_pathB\x0e\n" +
 	"\f_descriptionB\f\n" +
 	"\n" +
 	"_api_groupB\t\n" +
 	"\a_methodB\r\n" +
 	"\v_is_defaultB\x0f\n" +
-	"\r_service_name\"F\n" +
+	"\r_service_nameB\x10\n" +
+	"\x0e_is_deprecated\"F\n" +
 	"\vApiListResp\x12\x14\n" +
 	"\x05total\x18\x01 \x01(\x04R\x05total\x12!\n" +
-	"\x04data\x18\x02 \x03(\v2\r.core.ApiInfoR\x04data\"\xa8\b\n" +
+	"\x04data\x18\x02 \x03(\v2\r.core.ApiInfoR\x04data\"\xde\x01\n" +
+	"\x13ApiRegistrationItem\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\x12\x16\n" +
+	"\x06method\x18\x02 \x01(\tR\x06method\x12%\n" +
+	"\vdescription\x18\x03 \x01(\tH\x00R\vdescription\x88\x01\x01\x12 \n" +
+	"\tapi_group\x18\x04 \x01(\tH\x01R\bapiGroup\x88\x01\x01\x12$\n" +
+	"\vis_required\x18\x05 \x01(\bH\x02R\n" +
+	"isRequired\x88\x01\x01B\x0e\n" +
+	"\f_descriptionB\f\n" +
+	"\n" +
+	"_api_groupB\x0e\n" +
+	"\f_is_required\"f\n" +
+	"\x12ApiRegistrationReq\x12!\n" +
+	"\fservice_name\x18\x01 \x01(\tR\vserviceName\x12-\n" +
+	"\x04apis\x18\x02 \x03(\v2\x19.core.ApiRegistrationItemR\x04apis\"\xba\x01\n" +
+	"\x13ApiRegistrationResp\x12\x18\n" +
+	"\acreated\x18\x01 \x01(\x04R\acreated\x12\x18\n" +
+	"\aupdated\x18\x02 \x01(\x04R\aupdated\x12\x1a\n" +
+	"\brestored\x18\x03 \x01(\x04R\brestored\x12\x1e\n" +
+	"\n" +
+	"deprecated\x18\x04 \x01(\x04R\n" +
+	"deprecated\x123\n" +
+	"\vstale_rules\x18\x05 \x03(\v2\x12.core.ApiStaleRuleR\n" +
+	"staleRules\"k\n" +
+	"\fApiStaleRule\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\x04R\btenantId\x12\x12\n" +
+	"\x04role\x18\x02 \x01(\tR\x04role\x12\x12\n" +
+	"\x04path\x18\x03 \x01(\tR\x04path\x12\x16\n" +
+	"\x06method\x18\x04 \x01(\tR\x06method\"\xa3\x02\n" +
+	"\x13AuditLogArchiveInfo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\x03R\tcreatedAt\x12\x19\n" +
+	"\bfrom_seq\x18\x03 \x01(\x04R\afromSeq\x12\x15\n" +
+	"\x06to_seq\x18\x04 \x01(\x04R\x05toSeq\x12\x14\n" +
+	"\x05count\x18\x05 \x01(\x03R\x05count\x12\x19\n" +
+	"\bstart_at\x18\x06 \x01(\x03R\astartAt\x12\x15\n" +
+	"\x06end_at\x18\a \x01(\x03R\x05endAt\x12\x14\n" +
+	"\x05store\x18\b \x01(\tR\x05store\x12\x1d\n" +
+	"\n" +
+	"object_key\x18\t \x01(\tR\tobjectKey\x12\x12\n" +
+	"\x04size\x18\n" +
+	" \x01(\x03R\x04size\x12\x1a\n" +
+	"\bchecksum\x18\v \x01(\tR\bchecksum\"\xa9\x01\n" +
+	"\x16AuditLogArchiveListReq\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x04R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x04R\bpageSize\x12\"\n" +
+	"\n" +
+	"start_time\x18\x03 \x01(\x03H\x00R\tstartTime\x88\x01\x01\x12\x1e\n" +
+	"\bend_time\x18\x04 \x01(\x03H\x01R\aendTime\x88\x01\x01B\r\n" +
+	"\v_start_timeB\v\n" +
+	"\t_end_time\"^\n" +
+	"\x17AuditLogArchiveListResp\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\x04R\x05total\x12-\n" +
+	"\x04data\x18\x02 \x03(\v2\x19.core.AuditLogArchiveInfoR\x04data\"R\n" +
+	"\x12AuditLogChainIssue\x12\x10\n" +
+	"\x03seq\x18\x01 \x01(\x04R\x03seq\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x16\n" +
+	"\x06detail\x18\x03 \x01(\tR\x06detail\"\x99\t\n" +
 	"\fAuditLogInfo\x12\x13\n" +
 	"\x02id\x18\x01 \x01(\tH\x00R\x02id\x88\x01\x01\x12\"\n" +
 	"\n" +
@@ -8147,7 +16133,10 @@ const file_core_proto_rawDesc = "" +
 	"\vduration_ms\x18\x12 \x01(\x03H\x11R\n" +
 	"durationMs\x88\x01\x01\x12(\n" +
 	"\rerror_message\x18\x13 \x01(\tH\x12R\ferrorMessage\x88\x01\x01\x12\x1f\n" +
-	"\bmetadata\x18\x14 \x01(\tH\x13R\bmetadata\x88\x01\x01B\x05\n" +
+	"\bmetadata\x18\x14 \x01(\tH\x13R\bmetadata\x88\x01\x01\x12\x15\n" +
+	"\x03seq\x18\x15 \x01(\x04H\x14R\x03seq\x88\x01\x01\x12 \n" +
+	"\tprev_hash\x18\x16 \x01(\tH\x15R\bprevHash\x88\x01\x01\x12\x17\n" +
+	"\x04hash\x18\x17 \x01(\tH\x16R\x04hash\x88\x01\x01B\x05\n" +
 	"\x03_idB\r\n" +
 	"\v_created_atB\r\n" +
 	"\v_updated_atB\t\n" +
@@ -8170,7 +16159,11 @@ const file_core_proto_rawDesc = "" +
 	"\v_user_agentB\x0e\n" +
 	"\f_duration_msB\x10\n" +
 	"\x0e_error_messageB\v\n" +
-	"\t_metadata\"\x8c\x06\n" +
+	"\t_metadataB\x06\n" +
+	"\x04_seqB\f\n" +
+	"\n" +
+	"_prev_hashB\a\n" +
+	"\x05_hash\"\x8c\x06\n" +
 	"\x0fAuditLogListReq\x12\x12\n" +
 	"\x04page\x18\x01 \x01(\x04R\x04page\x12\x1b\n" +
 	"\tpage_size\x18\x02 \x01(\x04R\bpageSize\x12\x1c\n" +
@@ -8231,7 +16224,14 @@ const file_core_proto_rawDesc = "" +
 	"\x0favg_duration_ms\x18\x04 \x01(\x01R\ravgDurationMs\x12A\n" +
 	"\x0foperation_stats\x18\x05 \x03(\v2\x18.core.OperationTypeStatsR\x0eoperationStats\x12>\n" +
 	"\x0eresource_stats\x18\x06 \x03(\v2\x17.core.ResourceTypeStatsR\rresourceStats\x12:\n" +
-	"\x0eduration_stats\x18\a \x03(\v2\x13.core.DurationStatsR\rdurationStats\".\n" +
+	"\x0eduration_stats\x18\a \x03(\v2\x13.core.DurationStatsR\rdurationStats\"\xd2\x01\n" +
+	"\x12AuditLogVerifyResp\x12\x14\n" +
+	"\x05valid\x18\x01 \x01(\bR\x05valid\x12\x18\n" +
+	"\achecked\x18\x02 \x01(\x04R\achecked\x12\x19\n" +
+	"\bhead_seq\x18\x03 \x01(\x04R\aheadSeq\x12\x1b\n" +
+	"\thead_hash\x18\x04 \x01(\tR\bheadHash\x12\"\n" +
+	"\rpruned_to_seq\x18\x05 \x01(\x04R\vprunedToSeq\x120\n" +
+	"\x06issues\x18\x06 \x03(\v2\x18.core.AuditLogChainIssueR\x06issues\".\n" +
 	"\n" +
 	"BaseIDResp\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x10\n" +
@@ -8262,10 +16262,15 @@ const file_core_proto_rawDesc = "" +
 	"\vprovider_id\x18\x03 \x01(\x04R\n" +
 	"providerId\x12-\n" +
 	"\x12authorization_code\x18\x04 \x01(\tR\x11authorizationCode\x12\x14\n" +
-	"\x05state\x18\x05 \x01(\tR\x05state\"7\n" +
+	"\x05state\x18\x05 \x01(\tR\x05state\"\xa4\x01\n" +
 	"\vCallbackReq\x12\x14\n" +
 	"\x05state\x18\x01 \x01(\tR\x05state\x12\x12\n" +
-	"\x04code\x18\x02 \x01(\tR\x04code\"\xdf\t\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12\x19\n" +
+	"\x05error\x18\x03 \x01(\tH\x00R\x05error\x88\x01\x01\x120\n" +
+	"\x11error_description\x18\x04 \x01(\tH\x01R\x10errorDescription\x88\x01\x01B\b\n" +
+	"\x06_errorB\x14\n" +
+	"\x12_error_description\"\x93\n" +
+	"\n" +
 	"\x0eCasbinRuleInfo\x12\x13\n" +
 	"\x02id\x18\x01 \x01(\x04H\x00R\x02id\x88\x01\x01\x12\"\n" +
 	"\n" +
@@ -8302,7 +16307,10 @@ const file_core_proto_rawDesc = "" +
 	"\vusage_count\x18\x1b \x01(\x03H\x17R\n" +
 	"usageCount\x88\x01\x01\x12%\n" +
 	"\flast_used_at\x18\x1c \x01(\x03H\x18R\n" +
-	"lastUsedAt\x88\x01\x01B\x05\n" +
+	"lastUsedAt\x88\x01\x01\x12#\n" +
+	"\n" +
+	"conditions\x18\x1d \x01(\tH\x19R\n" +
+	"conditions\x88\x01\x01B\x05\n" +
 	"\x03_idB\r\n" +
 	"\v_created_atB\r\n" +
 	"\v_updated_atB\f\n" +
@@ -8330,7 +16338,8 @@ const file_core_proto_rawDesc = "" +
 	"\a_statusB\v\n" +
 	"\t_metadataB\x0e\n" +
 	"\f_usage_countB\x0f\n" +
-	"\r_last_used_at\"\xdf\x05\n" +
+	"\r_last_used_atB\r\n" +
+	"\v_conditions\"\xdf\x05\n" +
 	"\x11CasbinRuleListReq\x12\x12\n" +
 	"\x04page\x18\x01 \x01(\x04R\x04page\x12\x1b\n" +
 	"\tpage_size\x18\x02 \x01(\x04R\bpageSize\x12&\n" +
@@ -8561,7 +16570,22 @@ const file_core_proto_rawDesc = "" +
 	"\n" +
 	"percentage\x18\x03 \x01(\x01R\n" +
 	"percentage\"\a\n" +
-	"\x05Empty\"1\n" +
+	"\x05Empty\"\xd4\x02\n" +
+	"\x1eEnableOauthProviderTemplateReq\x12\x1f\n" +
+	"\vtemplate_id\x18\x01 \x01(\x04R\n" +
+	"templateId\x12\x1b\n" +
+	"\tclient_id\x18\x02 \x01(\tR\bclientId\x12#\n" +
+	"\rclient_secret\x18\x03 \x01(\tR\fclientSecret\x12!\n" +
+	"\fredirect_url\x18\x04 \x01(\tR\vredirectUrl\x12\x17\n" +
+	"\x04name\x18\x05 \x01(\tH\x00R\x04name\x88\x01\x01\x12&\n" +
+	"\fdisplay_name\x18\x06 \x01(\tH\x01R\vdisplayName\x88\x01\x01\x12\x1b\n" +
+	"\x06scopes\x18\a \x01(\tH\x02R\x06scopes\x88\x01\x01\x12\x1d\n" +
+	"\aenabled\x18\b \x01(\bH\x03R\aenabled\x88\x01\x01B\a\n" +
+	"\x05_nameB\x0f\n" +
+	"\r_display_nameB\t\n" +
+	"\a_scopesB\n" +
+	"\n" +
+	"\b_enabled\"1\n" +
 	"\x19GetOauthSessionByStateReq\x12\x14\n" +
 	"\x05state\x18\x01 \x01(\tR\x05state\"c\n" +
 	"\x17GetUserOauthAccountsReq\x12\x17\n" +
@@ -8585,7 +16609,231 @@ const file_core_proto_rawDesc = "" +
 	"\x05IDReq\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x04R\x02id\"\x1a\n" +
 	"\x06IDsReq\x12\x10\n" +
-	"\x03ids\x18\x01 \x03(\x04R\x03ids\"\xcf\x05\n" +
+	"\x03ids\x18\x01 \x03(\x04R\x03ids\"c\n" +
+	"\x16ImpersonationReviewReq\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x1f\n" +
+	"\vreviewer_id\x18\x02 \x01(\tR\n" +
+	"reviewerId\x12\x18\n" +
+	"\aapprove\x18\x03 \x01(\bR\aapprove\"\xae\x04\n" +
+	"\x18ImpersonationSessionInfo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\x03R\tcreatedAt\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tuser_name\x18\x04 \x01(\tR\buserName\x12(\n" +
+	"\x10origin_tenant_id\x18\x05 \x01(\x04R\x0eoriginTenantId\x12(\n" +
+	"\x10target_tenant_id\x18\x06 \x01(\x04R\x0etargetTenantId\x12\x16\n" +
+	"\x06reason\x18\a \x01(\tR\x06reason\x12\x16\n" +
+	"\x06ticket\x18\b \x01(\tR\x06ticket\x12\x14\n" +
+	"\x05state\x18\t \x01(\tR\x05state\x12)\n" +
+	"\x10duration_seconds\x18\n" +
+	" \x01(\x03R\x0fdurationSeconds\x12\x1f\n" +
+	"\vapproved_by\x18\v \x01(\tR\n" +
+	"approvedBy\x12$\n" +
+	"\vapproved_at\x18\f \x01(\x03H\x00R\n" +
+	"approvedAt\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"started_at\x18\r \x01(\x03H\x01R\tstartedAt\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"expires_at\x18\x0e \x01(\x03H\x02R\texpiresAt\x88\x01\x01\x12\x1e\n" +
+	"\bended_at\x18\x0f \x01(\x03H\x03R\aendedAt\x88\x01\x01B\x0e\n" +
+	"\f_approved_atB\r\n" +
+	"\v_started_atB\r\n" +
+	"\v_expires_atB\v\n" +
+	"\t_ended_at\"\xe1\x01\n" +
+	"\x1bImpersonationSessionListReq\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x04R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x04R\bpageSize\x12-\n" +
+	"\x10target_tenant_id\x18\x03 \x01(\x04H\x00R\x0etargetTenantId\x88\x01\x01\x12\x1c\n" +
+	"\auser_id\x18\x04 \x01(\tH\x01R\x06userId\x88\x01\x01\x12\x19\n" +
+	"\x05state\x18\x05 \x01(\tH\x02R\x05state\x88\x01\x01B\x13\n" +
+	"\x11_target_tenant_idB\n" +
+	"\n" +
+	"\b_user_idB\b\n" +
+	"\x06_state\"h\n" +
+	"\x1cImpersonationSessionListResp\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\x04R\x05total\x122\n" +
+	"\x04data\x18\x02 \x03(\v2\x1e.core.ImpersonationSessionInfoR\x04data\"\xb9\x02\n" +
+	"\x15ImpersonationStartReq\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12 \n" +
+	"\tuser_name\x18\x02 \x01(\tH\x00R\buserName\x88\x01\x01\x12(\n" +
+	"\x10origin_tenant_id\x18\x03 \x01(\x04R\x0eoriginTenantId\x12(\n" +
+	"\x10target_tenant_id\x18\x04 \x01(\x04R\x0etargetTenantId\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x12\x1b\n" +
+	"\x06ticket\x18\x06 \x01(\tH\x01R\x06ticket\x88\x01\x01\x12.\n" +
+	"\x10duration_minutes\x18\a \x01(\rH\x02R\x0fdurationMinutes\x88\x01\x01B\f\n" +
+	"\n" +
+	"_user_nameB\t\n" +
+	"\a_ticketB\x13\n" +
+	"\x11_duration_minutes\"b\n" +
+	"\fLdapLoginReq\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\x12\x1a\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\x12\x13\n" +
+	"\x02ip\x18\x03 \x01(\tH\x00R\x02ip\x88\x01\x01B\x05\n" +
+	"\x03_ip\"\xb2\x0f\n" +
+	"\x10LdapProviderInfo\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\x04H\x00R\x02id\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\x03H\x01R\tcreatedAt\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"updated_at\x18\x03 \x01(\x03H\x02R\tupdatedAt\x88\x01\x01\x12\x1b\n" +
+	"\x06status\x18\x04 \x01(\rH\x03R\x06status\x88\x01\x01\x12 \n" +
+	"\ttenant_id\x18\x05 \x01(\x04H\x04R\btenantId\x88\x01\x01\x12\x17\n" +
+	"\x04name\x18\x06 \x01(\tH\x05R\x04name\x88\x01\x01\x12&\n" +
+	"\fdisplay_name\x18\a \x01(\tH\x06R\vdisplayName\x88\x01\x01\x12*\n" +
+	"\x0edirectory_type\x18\b \x01(\tH\aR\rdirectoryType\x88\x01\x01\x12\x15\n" +
+	"\x03url\x18\t \x01(\tH\bR\x03url\x88\x01\x01\x12 \n" +
+	"\tstart_tls\x18\n" +
+	" \x01(\bH\tR\bstartTls\x88\x01\x01\x125\n" +
+	"\x14insecure_skip_verify\x18\v \x01(\bH\n" +
+	"R\x12insecureSkipVerify\x88\x01\x01\x12\x1c\n" +
+	"\abind_dn\x18\f \x01(\tH\vR\x06bindDn\x88\x01\x01\x12(\n" +
+	"\rbind_password\x18\r \x01(\tH\fR\fbindPassword\x88\x01\x01\x12\x1c\n" +
+	"\abase_dn\x18\x0e \x01(\tH\rR\x06baseDn\x88\x01\x01\x12 \n" +
+	"\tauth_mode\x18\x0f \x01(\tH\x0eR\bauthMode\x88\x01\x01\x12-\n" +
+	"\x10user_dn_template\x18\x10 \x01(\tH\x0fR\x0euserDnTemplate\x88\x01\x01\x12$\n" +
+	"\vuser_filter\x18\x11 \x01(\tH\x10R\n" +
+	"userFilter\x88\x01\x01\x12!\n" +
+	"\n" +
+	"ou_base_dn\x18\x12 \x01(\tH\x11R\bouBaseDn\x88\x01\x01\x12 \n" +
+	"\tou_filter\x18\x13 \x01(\tH\x12R\bouFilter\x88\x01\x01\x12'\n" +
+	"\rgroup_base_dn\x18\x14 \x01(\tH\x13R\vgroupBaseDn\x88\x01\x01\x12&\n" +
+	"\fgroup_filter\x18\x15 \x01(\tH\x14R\vgroupFilter\x88\x01\x01\x129\n" +
+	"\x16group_member_attribute\x18\x16 \x01(\tH\x15R\x14groupMemberAttribute\x88\x01\x01\x120\n" +
+	"\x11attribute_mapping\x18\x17 \x01(\tH\x16R\x10attributeMapping\x88\x01\x01\x12(\n" +
+	"\rlogin_enabled\x18\x18 \x01(\bH\x17R\floginEnabled\x88\x01\x01\x12(\n" +
+	"\rjit_provision\x18\x19 \x01(\bH\x18R\fjitProvision\x88\x01\x01\x12(\n" +
+	"\rlink_existing\x18\x1a \x01(\bH\x19R\flinkExisting\x88\x01\x01\x12&\n" +
+	"\fsync_enabled\x18\x1b \x01(\bH\x1aR\vsyncEnabled\x88\x01\x01\x12(\n" +
+	"\rsync_interval\x18\x1c \x01(\rH\x1bR\fsyncInterval\x88\x01\x01\x12,\n" +
+	"\x0fdeletion_policy\x18\x1d \x01(\tH\x1cR\x0edeletionPolicy\x88\x01\x01\x121\n" +
+	"\x12root_department_id\x18\x1e \x01(\x04H\x1dR\x10rootDepartmentId\x88\x01\x01\x127\n" +
+	"\x15default_department_id\x18\x1f \x01(\x04H\x1eR\x13defaultDepartmentId\x88\x01\x01\x12(\n" +
+	"\x10default_role_ids\x18  \x03(\x04R\x0edefaultRoleIds\x12%\n" +
+	"\flast_sync_at\x18! \x01(\x03H\x1fR\n" +
+	"lastSyncAt\x88\x01\x01\x12\x1d\n" +
+	"\aenabled\x18\" \x01(\bH R\aenabled\x88\x01\x01\x12\x17\n" +
+	"\x04sort\x18# \x01(\rH!R\x04sort\x88\x01\x01\x12\x1b\n" +
+	"\x06remark\x18$ \x01(\tH\"R\x06remark\x88\x01\x01B\x05\n" +
+	"\x03_idB\r\n" +
+	"\v_created_atB\r\n" +
+	"\v_updated_atB\t\n" +
+	"\a_statusB\f\n" +
+	"\n" +
+	"_tenant_idB\a\n" +
+	"\x05_nameB\x0f\n" +
+	"\r_display_nameB\x11\n" +
+	"\x0f_directory_typeB\x06\n" +
+	"\x04_urlB\f\n" +
+	"\n" +
+	"_start_tlsB\x17\n" +
+	"\x15_insecure_skip_verifyB\n" +
+	"\n" +
+	"\b_bind_dnB\x10\n" +
+	"\x0e_bind_passwordB\n" +
+	"\n" +
+	"\b_base_dnB\f\n" +
+	"\n" +
+	"_auth_modeB\x13\n" +
+	"\x11_user_dn_templateB\x0e\n" +
+	"\f_user_filterB\r\n" +
+	"\v_ou_base_dnB\f\n" +
+	"\n" +
+	"_ou_filterB\x10\n" +
+	"\x0e_group_base_dnB\x0f\n" +
+	"\r_group_filterB\x19\n" +
+	"\x17_group_member_attributeB\x14\n" +
+	"\x12_attribute_mappingB\x10\n" +
+	"\x0e_login_enabledB\x10\n" +
+	"\x0e_jit_provisionB\x10\n" +
+	"\x0e_link_existingB\x0f\n" +
+	"\r_sync_enabledB\x10\n" +
+	"\x0e_sync_intervalB\x12\n" +
+	"\x10_deletion_policyB\x15\n" +
+	"\x13_root_department_idB\x18\n" +
+	"\x16_default_department_idB\x0f\n" +
+	"\r_last_sync_atB\n" +
+	"\n" +
+	"\b_enabledB\a\n" +
+	"\x05_sortB\t\n" +
+	"\a_remark\"h\n" +
+	"\x13LdapProviderListReq\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x04R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x04R\bpageSize\x12\x17\n" +
+	"\x04name\x18\x03 \x01(\tH\x00R\x04name\x88\x01\x01B\a\n" +
+	"\x05_name\"X\n" +
+	"\x14LdapProviderListResp\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\x04R\x05total\x12*\n" +
+	"\x04data\x18\x02 \x03(\v2\x16.core.LdapProviderInfoR\x04data\"x\n" +
+	"\x0eLdapSyncChange\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x16\n" +
+	"\x06action\x18\x02 \x01(\tR\x06action\x12\x0e\n" +
+	"\x02dn\x18\x03 \x01(\tR\x02dn\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12\x16\n" +
+	"\x06detail\x18\x05 \x01(\tR\x06detail\"z\n" +
+	"\x10LdapSyncConflict\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x0e\n" +
+	"\x02dn\x18\x02 \x01(\tR\x02dn\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\x12\x16\n" +
+	"\x06detail\x18\x05 \x01(\tR\x06detail\"\x99\x01\n" +
+	"\x0fLdapSyncCounter\x12\x18\n" +
+	"\acreated\x18\x01 \x01(\rR\acreated\x12\x18\n" +
+	"\aupdated\x18\x02 \x01(\rR\aupdated\x12\x1a\n" +
+	"\bdisabled\x18\x03 \x01(\rR\bdisabled\x12\x18\n" +
+	"\adeleted\x18\x04 \x01(\rR\adeleted\x12\x1c\n" +
+	"\tunchanged\x18\x05 \x01(\rR\tunchanged\"6\n" +
+	"\vLdapSyncReq\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x17\n" +
+	"\adry_run\x18\x02 \x01(\bR\x06dryRun\"\xe3\x04\n" +
+	"\x0fLdapSyncRunInfo\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\x04H\x00R\x02id\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\x03H\x01R\tcreatedAt\x88\x01\x01\x12$\n" +
+	"\vprovider_id\x18\x03 \x01(\x04H\x02R\n" +
+	"providerId\x88\x01\x01\x12\x1d\n" +
+	"\atrigger\x18\x04 \x01(\tH\x03R\atrigger\x88\x01\x01\x12\x1c\n" +
+	"\adry_run\x18\x05 \x01(\bH\x04R\x06dryRun\x88\x01\x01\x12\x1b\n" +
+	"\x06result\x18\x06 \x01(\tH\x05R\x06result\x88\x01\x01\x12\x19\n" +
+	"\x05error\x18\a \x01(\tH\x06R\x05error\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"started_at\x18\b \x01(\x03H\aR\tstartedAt\x88\x01\x01\x12$\n" +
+	"\vfinished_at\x18\t \x01(\x03H\bR\n" +
+	"finishedAt\x88\x01\x01\x12)\n" +
+	"\x05stats\x18\n" +
+	" \x01(\v2\x13.core.LdapSyncStatsR\x05stats\x12.\n" +
+	"\achanges\x18\v \x03(\v2\x14.core.LdapSyncChangeR\achanges\x124\n" +
+	"\tconflicts\x18\f \x03(\v2\x16.core.LdapSyncConflictR\tconflicts\x12!\n" +
+	"\ttruncated\x18\r \x01(\bH\tR\ttruncated\x88\x01\x01B\x05\n" +
+	"\x03_idB\r\n" +
+	"\v_created_atB\x0e\n" +
+	"\f_provider_idB\n" +
+	"\n" +
+	"\b_triggerB\n" +
+	"\n" +
+	"\b_dry_runB\t\n" +
+	"\a_resultB\b\n" +
+	"\x06_errorB\r\n" +
+	"\v_started_atB\x0e\n" +
+	"\f_finished_atB\f\n" +
+	"\n" +
+	"_truncated\"\x8e\x01\n" +
+	"\x12LdapSyncRunListReq\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x04R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x04R\bpageSize\x12\x1f\n" +
+	"\vprovider_id\x18\x03 \x01(\x04R\n" +
+	"providerId\x12\x1b\n" +
+	"\x06result\x18\x04 \x01(\tH\x00R\x06result\x88\x01\x01B\t\n" +
+	"\a_result\"V\n" +
+	"\x13LdapSyncRunListResp\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\x04R\x05total\x12)\n" +
+	"\x04data\x18\x02 \x03(\v2\x15.core.LdapSyncRunInfoR\x04data\"\xc7\x01\n" +
+	"\rLdapSyncStats\x127\n" +
+	"\vdepartments\x18\x01 \x01(\v2\x15.core.LdapSyncCounterR\vdepartments\x12+\n" +
+	"\x05users\x18\x02 \x01(\v2\x15.core.LdapSyncCounterR\x05users\x12\x18\n" +
+	"\agranted\x18\x03 \x01(\rR\agranted\x12\x18\n" +
+	"\arevoked\x18\x04 \x01(\rR\arevoked\x12\x1c\n" +
+	"\tconflicts\x18\x05 \x01(\rR\tconflicts\"\xcf\x05\n" +
 	"\bMenuInfo\x12\x13\n" +
 	"\x02id\x18\x01 \x01(\x04H\x00R\x02id\x88\x01\x01\x12\"\n" +
 	"\n" +
@@ -8672,7 +16920,18 @@ const file_core_proto_rawDesc = "" +
 	"\x0e_dynamic_levelB\f\n" +
 	"\n" +
 	"_real_pathB\t\n" +
-	"\a_params\"\xf5\b\n" +
+	"\a_params\"\x86\x01\n" +
+	"\x13OauthAccessTokenReq\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\bprovider\x18\x02 \x01(\tR\bprovider\x12(\n" +
+	"\rforce_refresh\x18\x03 \x01(\bH\x00R\fforceRefresh\x88\x01\x01B\x10\n" +
+	"\x0e_force_refresh\"w\n" +
+	"\x14OauthAccessTokenResp\x12!\n" +
+	"\faccess_token\x18\x01 \x01(\tR\vaccessToken\x12\x1d\n" +
+	"\n" +
+	"token_type\x18\x02 \x01(\tR\ttokenType\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x03 \x01(\x03R\texpiresAt\"\xf5\b\n" +
 	"\x10OauthAccountInfo\x12\x13\n" +
 	"\x02id\x18\x01 \x01(\x04H\x00R\x02id\x88\x01\x01\x12\"\n" +
 	"\n" +
@@ -8736,10 +16995,157 @@ const file_core_proto_rawDesc = "" +
 	"\b_user_id\"X\n" +
 	"\x14OauthAccountListResp\x12\x14\n" +
 	"\x05total\x18\x01 \x01(\x04R\x05total\x12*\n" +
-	"\x04data\x18\x02 \x03(\v2\x16.core.OauthAccountInfoR\x04data\"A\n" +
+	"\x04data\x18\x02 \x03(\v2\x16.core.OauthAccountInfoR\x04data\"\xe4\x02\n" +
+	"\x11OauthAuthorizeReq\x12\x1b\n" +
+	"\tclient_id\x18\x01 \x01(\tR\bclientId\x12!\n" +
+	"\fredirect_uri\x18\x02 \x01(\tR\vredirectUri\x12\x14\n" +
+	"\x05scope\x18\x03 \x01(\tR\x05scope\x12*\n" +
+	"\x0ecode_challenge\x18\x04 \x01(\tH\x00R\rcodeChallenge\x88\x01\x01\x127\n" +
+	"\x15code_challenge_method\x18\x05 \x01(\tH\x01R\x13codeChallengeMethod\x88\x01\x01\x12\x19\n" +
+	"\x05nonce\x18\x06 \x01(\tH\x02R\x05nonce\x88\x01\x01\x12\x17\n" +
+	"\auser_id\x18\a \x01(\tR\x06userId\x12\x1d\n" +
+	"\aapprove\x18\b \x01(\bH\x03R\aapprove\x88\x01\x01B\x11\n" +
+	"\x0f_code_challengeB\x18\n" +
+	"\x16_code_challenge_methodB\b\n" +
+	"\x06_nonceB\n" +
+	"\n" +
+	"\b_approve\"\xa2\x01\n" +
+	"\x12OauthAuthorizeResp\x12)\n" +
+	"\x10consent_required\x18\x01 \x01(\bR\x0fconsentRequired\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12\x1f\n" +
+	"\vclient_name\x18\x03 \x01(\tR\n" +
+	"clientName\x12,\n" +
+	"\x06scopes\x18\x04 \x03(\v2\x14.core.OauthScopeInfoR\x06scopes\"S\n" +
+	"\x11OauthCallbackResp\x12\"\n" +
+	"\x04user\x18\x01 \x01(\v2\x0e.core.UserInfoR\x04user\x12\x1a\n" +
+	"\bprovider\x18\x02 \x01(\tR\bprovider\"\xa4\x01\n" +
+	"\x1bOauthClaimMappingPreviewReq\x12$\n" +
+	"\vprovider_id\x18\x01 \x01(\x04H\x00R\n" +
+	"providerId\x88\x01\x01\x12&\n" +
+	"\fextra_config\x18\x02 \x01(\tH\x01R\vextraConfig\x88\x01\x01\x12\x16\n" +
+	"\x06sample\x18\x03 \x01(\tR\x06sampleB\x0e\n" +
+	"\f_provider_idB\x0f\n" +
+	"\r_extra_config\"\xc2\x01\n" +
+	"\x1cOauthClaimMappingPreviewResp\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x1a\n" +
+	"\bnickname\x18\x03 \x01(\tR\bnickname\x12\x14\n" +
+	"\x05email\x18\x04 \x01(\tR\x05email\x12\x14\n" +
+	"\x05phone\x18\x05 \x01(\tR\x05phone\x12\x16\n" +
+	"\x06avatar\x18\x06 \x01(\tR\x06avatar\x12\x16\n" +
+	"\x06groups\x18\a \x03(\tR\x06groups\"m\n" +
+	"\x12OauthClientAuthReq\x12\x1b\n" +
+	"\tclient_id\x18\x01 \x01(\tR\bclientId\x12(\n" +
+	"\rclient_secret\x18\x02 \x01(\tH\x00R\fclientSecret\x88\x01\x01B\x10\n" +
+	"\x0e_client_secret\"/\n" +
+	"\x10OauthClientIdReq\x12\x1b\n" +
+	"\tclient_id\x18\x01 \x01(\tR\bclientId\"\xaf\x05\n" +
+	"\x0fOauthClientInfo\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\x04H\x00R\x02id\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\x03H\x01R\tcreatedAt\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"updated_at\x18\x03 \x01(\x03H\x02R\tupdatedAt\x88\x01\x01\x12\x1b\n" +
+	"\x06status\x18\x04 \x01(\rH\x03R\x06status\x88\x01\x01\x12 \n" +
+	"\ttenant_id\x18\x05 \x01(\x04H\x04R\btenantId\x88\x01\x01\x12\x17\n" +
+	"\x04name\x18\x06 \x01(\tH\x05R\x04name\x88\x01\x01\x12 \n" +
+	"\tclient_id\x18\a \x01(\tH\x06R\bclientId\x88\x01\x01\x12$\n" +
+	"\vclient_type\x18\b \x01(\tH\aR\n" +
+	"clientType\x88\x01\x01\x12#\n" +
+	"\rredirect_uris\x18\t \x03(\tR\fredirectUris\x12\x1f\n" +
+	"\vgrant_types\x18\n" +
+	" \x03(\tR\n" +
+	"grantTypes\x12\x16\n" +
+	"\x06scopes\x18\v \x03(\tR\x06scopes\x12\x1d\n" +
+	"\atrusted\x18\f \x01(\bH\bR\atrusted\x88\x01\x01\x12-\n" +
+	"\x10access_token_ttl\x18\r \x01(\rH\tR\x0eaccessTokenTtl\x88\x01\x01\x12/\n" +
+	"\x11refresh_token_ttl\x18\x0e \x01(\rH\n" +
+	"R\x0frefreshTokenTtl\x88\x01\x01\x12\x1b\n" +
+	"\x06remark\x18\x0f \x01(\tH\vR\x06remark\x88\x01\x01B\x05\n" +
+	"\x03_idB\r\n" +
+	"\v_created_atB\r\n" +
+	"\v_updated_atB\t\n" +
+	"\a_statusB\f\n" +
+	"\n" +
+	"_tenant_idB\a\n" +
+	"\x05_nameB\f\n" +
+	"\n" +
+	"_client_idB\x0e\n" +
+	"\f_client_typeB\n" +
+	"\n" +
+	"\b_trustedB\x13\n" +
+	"\x11_access_token_ttlB\x14\n" +
+	"\x12_refresh_token_ttlB\t\n" +
+	"\a_remark\"g\n" +
+	"\x12OauthClientListReq\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x04R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x04R\bpageSize\x12\x17\n" +
+	"\x04name\x18\x03 \x01(\tH\x00R\x04name\x88\x01\x01B\a\n" +
+	"\x05_name\"V\n" +
+	"\x13OauthClientListResp\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\x04R\x05total\x12)\n" +
+	"\x04data\x18\x02 \x03(\v2\x15.core.OauthClientInfoR\x04data\"{\n" +
+	"\x15OauthClientSecretResp\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x1b\n" +
+	"\tclient_id\x18\x02 \x01(\tR\bclientId\x12#\n" +
+	"\rclient_secret\x18\x03 \x01(\tR\fclientSecret\x12\x10\n" +
+	"\x03msg\x18\x04 \x01(\tR\x03msg\"\xa6\x01\n" +
+	"\x14OauthCodeExchangeReq\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x1b\n" +
+	"\tclient_id\x18\x02 \x01(\tR\bclientId\x12!\n" +
+	"\fredirect_uri\x18\x03 \x01(\tR\vredirectUri\x12(\n" +
+	"\rcode_verifier\x18\x04 \x01(\tH\x00R\fcodeVerifier\x88\x01\x01B\x10\n" +
+	"\x0e_code_verifier\"\xec\x02\n" +
+	"\x10OauthConsentInfo\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\x04H\x00R\x02id\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\x03H\x01R\tcreatedAt\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"updated_at\x18\x03 \x01(\x03H\x02R\tupdatedAt\x88\x01\x01\x12 \n" +
+	"\ttenant_id\x18\x04 \x01(\x04H\x03R\btenantId\x88\x01\x01\x12\x1c\n" +
+	"\auser_id\x18\x05 \x01(\tH\x04R\x06userId\x88\x01\x01\x12 \n" +
+	"\tclient_id\x18\x06 \x01(\tH\x05R\bclientId\x88\x01\x01\x12\x16\n" +
+	"\x06scopes\x18\a \x03(\tR\x06scopes\x12$\n" +
+	"\vclient_name\x18\b \x01(\tH\x06R\n" +
+	"clientName\x88\x01\x01B\x05\n" +
+	"\x03_idB\r\n" +
+	"\v_created_atB\r\n" +
+	"\v_updated_atB\f\n" +
+	"\n" +
+	"_tenant_idB\n" +
+	"\n" +
+	"\b_user_idB\f\n" +
+	"\n" +
+	"_client_idB\x0e\n" +
+	"\f_client_name\"\xa0\x01\n" +
+	"\x13OauthConsentListReq\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x04R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x04R\bpageSize\x12\x1c\n" +
+	"\auser_id\x18\x03 \x01(\tH\x00R\x06userId\x88\x01\x01\x12 \n" +
+	"\tclient_id\x18\x04 \x01(\tH\x01R\bclientId\x88\x01\x01B\n" +
+	"\n" +
+	"\b_user_idB\f\n" +
+	"\n" +
+	"_client_id\"X\n" +
+	"\x14OauthConsentListResp\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\x04R\x05total\x12*\n" +
+	"\x04data\x18\x02 \x03(\v2\x16.core.OauthConsentInfoR\x04data\"\xae\x01\n" +
+	"\x0eOauthGrantInfo\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
+	"\ttenant_id\x18\x02 \x01(\x04R\btenantId\x12\x1b\n" +
+	"\tclient_id\x18\x03 \x01(\tR\bclientId\x12\x16\n" +
+	"\x06scopes\x18\x04 \x03(\tR\x06scopes\x12\x14\n" +
+	"\x05nonce\x18\x05 \x01(\tR\x05nonce\x12\x1b\n" +
+	"\tauth_time\x18\x06 \x01(\x03R\bauthTime\"\xa4\x01\n" +
 	"\rOauthLoginReq\x12\x14\n" +
 	"\x05state\x18\x01 \x01(\tR\x05state\x12\x1a\n" +
-	"\bprovider\x18\x02 \x01(\tR\bprovider\"\xe6\v\n" +
+	"\bprovider\x18\x02 \x01(\tR\bprovider\x12 \n" +
+	"\tclient_ip\x18\x03 \x01(\tH\x00R\bclientIp\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"user_agent\x18\x04 \x01(\tH\x01R\tuserAgent\x88\x01\x01B\f\n" +
+	"\n" +
+	"_client_ipB\r\n" +
+	"\v_user_agent\"\x9c\f\n" +
 	"\x11OauthProviderInfo\x12\x13\n" +
 	"\x02id\x18\x01 \x01(\x04H\x00R\x02id\x88\x01\x01\x12\"\n" +
 	"\n" +
@@ -8777,7 +17183,9 @@ const file_core_proto_rawDesc = "" +
 	"\flast_used_at\x18\x1c \x01(\x03H\x1bR\n" +
 	"lastUsedAt\x88\x01\x01\x12\x1b\n" +
 	"\x06status\x18\x1d \x01(\rH\x1cR\x06status\x88\x01\x01\x12 \n" +
-	"\ttenant_id\x18\x1e \x01(\x04H\x1dR\btenantId\x88\x01\x01B\x05\n" +
+	"\ttenant_id\x18\x1e \x01(\x04H\x1dR\btenantId\x88\x01\x01\x12$\n" +
+	"\vtemplate_id\x18\x1f \x01(\x04H\x1eR\n" +
+	"templateId\x88\x01\x01B\x05\n" +
 	"\x03_idB\r\n" +
 	"\v_created_atB\r\n" +
 	"\v_updated_atB\a\n" +
@@ -8812,7 +17220,8 @@ const file_core_proto_rawDesc = "" +
 	"\r_last_used_atB\t\n" +
 	"\a_statusB\f\n" +
 	"\n" +
-	"_tenant_id\"i\n" +
+	"_tenant_idB\x0e\n" +
+	"\f_template_id\"i\n" +
 	"\x14OauthProviderListReq\x12\x12\n" +
 	"\x04page\x18\x01 \x01(\x04R\x04page\x12\x1b\n" +
 	"\tpage_size\x18\x02 \x01(\x04R\bpageSize\x12\x17\n" +
@@ -8820,9 +17229,90 @@ const file_core_proto_rawDesc = "" +
 	"\x05_name\"Z\n" +
 	"\x15OauthProviderListResp\x12\x14\n" +
 	"\x05total\x18\x01 \x01(\x04R\x05total\x12+\n" +
-	"\x04data\x18\x02 \x03(\v2\x17.core.OauthProviderInfoR\x04data\"%\n" +
+	"\x04data\x18\x02 \x03(\v2\x17.core.OauthProviderInfoR\x04data\"\xcc\x06\n" +
+	"\x19OauthProviderTemplateInfo\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\x04H\x00R\x02id\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\x03H\x01R\tcreatedAt\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"updated_at\x18\x03 \x01(\x03H\x02R\tupdatedAt\x88\x01\x01\x12\x1b\n" +
+	"\x06status\x18\x04 \x01(\rH\x03R\x06status\x88\x01\x01\x12\x17\n" +
+	"\x04name\x18\x05 \x01(\tH\x04R\x04name\x88\x01\x01\x12&\n" +
+	"\fdisplay_name\x18\x06 \x01(\tH\x05R\vdisplayName\x88\x01\x01\x12\x17\n" +
+	"\x04type\x18\a \x01(\tH\x06R\x04type\x88\x01\x01\x12(\n" +
+	"\rprovider_type\x18\b \x01(\tH\aR\fproviderType\x88\x01\x01\x12\x1b\n" +
+	"\x06scopes\x18\t \x01(\tH\bR\x06scopes\x88\x01\x01\x12\x1e\n" +
+	"\bauth_url\x18\n" +
+	" \x01(\tH\tR\aauthUrl\x88\x01\x01\x12 \n" +
+	"\ttoken_url\x18\v \x01(\tH\n" +
+	"R\btokenUrl\x88\x01\x01\x12\x1e\n" +
+	"\binfo_url\x18\f \x01(\tH\vR\ainfoUrl\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"auth_style\x18\r \x01(\x04H\fR\tauthStyle\x88\x01\x01\x12&\n" +
+	"\fextra_config\x18\x0e \x01(\tH\rR\vextraConfig\x88\x01\x01\x12&\n" +
+	"\fsupport_pkce\x18\x0f \x01(\bH\x0eR\vsupportPkce\x88\x01\x01\x12\x1e\n" +
+	"\bicon_url\x18\x10 \x01(\tH\x0fR\aiconUrl\x88\x01\x01\x12\x17\n" +
+	"\x04sort\x18\x11 \x01(\rH\x10R\x04sort\x88\x01\x01\x12\x1b\n" +
+	"\x06remark\x18\x12 \x01(\tH\x11R\x06remark\x88\x01\x01B\x05\n" +
+	"\x03_idB\r\n" +
+	"\v_created_atB\r\n" +
+	"\v_updated_atB\t\n" +
+	"\a_statusB\a\n" +
+	"\x05_nameB\x0f\n" +
+	"\r_display_nameB\a\n" +
+	"\x05_typeB\x10\n" +
+	"\x0e_provider_typeB\t\n" +
+	"\a_scopesB\v\n" +
+	"\t_auth_urlB\f\n" +
+	"\n" +
+	"_token_urlB\v\n" +
+	"\t_info_urlB\r\n" +
+	"\v_auth_styleB\x0f\n" +
+	"\r_extra_configB\x0f\n" +
+	"\r_support_pkceB\v\n" +
+	"\t_icon_urlB\a\n" +
+	"\x05_sortB\t\n" +
+	"\a_remark\"\x93\x01\n" +
+	"\x1cOauthProviderTemplateListReq\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x04R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x04R\bpageSize\x12\x17\n" +
+	"\x04name\x18\x03 \x01(\tH\x00R\x04name\x88\x01\x01\x12\x17\n" +
+	"\x04type\x18\x04 \x01(\tH\x01R\x04type\x88\x01\x01B\a\n" +
+	"\x05_nameB\a\n" +
+	"\x05_type\"j\n" +
+	"\x1dOauthProviderTemplateListResp\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\x04R\x05total\x123\n" +
+	"\x04data\x18\x02 \x03(\v2\x1f.core.OauthProviderTemplateInfoR\x04data\"%\n" +
 	"\x11OauthRedirectResp\x12\x10\n" +
-	"\x03url\x18\x01 \x01(\tR\x03url\"\xe2\t\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\"\xdc\x02\n" +
+	"\x0eOauthScopeInfo\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\x04H\x00R\x02id\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\x03H\x01R\tcreatedAt\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"updated_at\x18\x03 \x01(\x03H\x02R\tupdatedAt\x88\x01\x01\x12\x1b\n" +
+	"\x06status\x18\x04 \x01(\rH\x03R\x06status\x88\x01\x01\x12 \n" +
+	"\ttenant_id\x18\x05 \x01(\x04H\x04R\btenantId\x88\x01\x01\x12\x17\n" +
+	"\x04name\x18\x06 \x01(\tH\x05R\x04name\x88\x01\x01\x12%\n" +
+	"\vdescription\x18\a \x01(\tH\x06R\vdescription\x88\x01\x01\x12\x17\n" +
+	"\aapi_ids\x18\b \x03(\x04R\x06apiIdsB\x05\n" +
+	"\x03_idB\r\n" +
+	"\v_created_atB\r\n" +
+	"\v_updated_atB\t\n" +
+	"\a_statusB\f\n" +
+	"\n" +
+	"_tenant_idB\a\n" +
+	"\x05_nameB\x0e\n" +
+	"\f_description\"|\n" +
+	"\x11OauthScopeListReq\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x04R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x04R\bpageSize\x12\x17\n" +
+	"\x04name\x18\x03 \x01(\tH\x00R\x04name\x88\x01\x01\x12\x14\n" +
+	"\x05names\x18\x04 \x03(\tR\x05namesB\a\n" +
+	"\x05_name\"T\n" +
+	"\x12OauthScopeListResp\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\x04R\x05total\x12(\n" +
+	"\x04data\x18\x02 \x03(\v2\x14.core.OauthScopeInfoR\x04data\"\xe2\t\n" +
 	"\x10OauthSessionInfo\x12\x13\n" +
 	"\x02id\x18\x01 \x01(\x04H\x00R\x02id\x88\x01\x01\x12\"\n" +
 	"\n" +
@@ -8882,7 +17372,18 @@ const file_core_proto_rawDesc = "" +
 	"\f_retry_countB\t\n" +
 	"\a_statusB\f\n" +
 	"\n" +
-	"_tenant_id\"q\n" +
+	"_tenant_id\"\xc0\x01\n" +
+	"\x0fOauthWebhookReq\x12\x1f\n" +
+	"\vprovider_id\x18\x01 \x01(\x04R\n" +
+	"providerId\x12<\n" +
+	"\aheaders\x18\x02 \x03(\v2\".core.OauthWebhookReq.HeadersEntryR\aheaders\x12\x12\n" +
+	"\x04body\x18\x03 \x01(\fR\x04body\x1a:\n" +
+	"\fHeadersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"N\n" +
+	"\x10OauthWebhookResp\x12\x1c\n" +
+	"\tchallenge\x18\x01 \x01(\tR\tchallenge\x12\x1c\n" +
+	"\tduplicate\x18\x02 \x01(\bR\tduplicate\"q\n" +
 	"\x12OperationTypeStats\x12%\n" +
 	"\x0eoperation_type\x18\x01 \x01(\tR\roperationType\x12\x14\n" +
 	"\x05count\x18\x02 \x01(\x04R\x05count\x12\x1e\n" +
@@ -8925,7 +17426,28 @@ const file_core_proto_rawDesc = "" +
 	"\x06source\x18\x03 \x01(\tR\x06source\x12\x1c\n" +
 	"\arule_id\x18\x04 \x01(\tH\x00R\x06ruleId\x88\x01\x01B\n" +
 	"\n" +
-	"\b_rule_id\"\xa0\x03\n" +
+	"\b_rule_id\"l\n" +
+	"\x12PolicyBundleChange\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x16\n" +
+	"\x06action\x18\x02 \x01(\tR\x06action\x12\x12\n" +
+	"\x04role\x18\x03 \x01(\tR\x04role\x12\x16\n" +
+	"\x06target\x18\x04 \x01(\tR\x06target\"^\n" +
+	"\x15PolicyBundleExportReq\x12\x1b\n" +
+	"\x06format\x18\x01 \x01(\tH\x00R\x06format\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"role_codes\x18\x02 \x03(\tR\troleCodesB\t\n" +
+	"\a_format\"r\n" +
+	"\x15PolicyBundleImportReq\x12\x1b\n" +
+	"\x06format\x18\x01 \x01(\tH\x00R\x06format\x88\x01\x01\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\tR\acontent\x12\x17\n" +
+	"\adry_run\x18\x03 \x01(\bR\x06dryRunB\t\n" +
+	"\a_format\"`\n" +
+	"\x16PolicyBundleImportResp\x12\x18\n" +
+	"\aapplied\x18\x01 \x01(\bR\aapplied\x12,\n" +
+	"\x04data\x18\x02 \x03(\v2\x18.core.PolicyBundleChangeR\x04data\"D\n" +
+	"\x10PolicyBundleResp\x12\x16\n" +
+	"\x06format\x18\x01 \x01(\tR\x06format\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\tR\acontent\"\xa0\x03\n" +
 	"\fPositionInfo\x12\x13\n" +
 	"\x02id\x18\x01 \x01(\x04H\x00R\x02id\x88\x01\x01\x12\"\n" +
 	"\n" +
@@ -8983,7 +17505,17 @@ const file_core_proto_rawDesc = "" +
 	"\x16RefreshCasbinCacheResp\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12'\n" +
-	"\x0fcleared_entries\x18\x03 \x01(\x05R\x0eclearedEntries\"c\n" +
+	"\x0fcleared_entries\x18\x03 \x01(\x05R\x0eclearedEntries\"\x86\x01\n" +
+	"\x16ReplaceRolePoliciesReq\x12\x17\n" +
+	"\arole_id\x18\x01 \x01(\x04R\x06roleId\x12(\n" +
+	"\x04data\x18\x02 \x03(\v2\x14.core.RolePolicyInfoR\x04data\x12\x1d\n" +
+	"\aversion\x18\x03 \x01(\tH\x00R\aversion\x88\x01\x01B\n" +
+	"\n" +
+	"\b_version\"\x8f\x01\n" +
+	"\x17ReplaceRolePoliciesResp\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\tR\aversion\x12*\n" +
+	"\x05added\x18\x02 \x03(\v2\x14.core.RolePolicyInfoR\x05added\x12.\n" +
+	"\aremoved\x18\x03 \x03(\v2\x14.core.RolePolicyInfoR\aremoved\"c\n" +
 	"\vResetPwdReq\x12\x17\n" +
 	"\x04opId\x18\x01 \x01(\tH\x00R\x04opId\x88\x01\x01\x12\x16\n" +
 	"\x06userId\x18\x02 \x01(\tR\x06userId\x12\x1a\n" +
@@ -9003,7 +17535,17 @@ const file_core_proto_rawDesc = "" +
 	"\n" +
 	"data_scope\x18\n" +
 	" \x01(\rR\tdataScope\x12&\n" +
-	"\x0fcustom_dept_ids\x18\v \x03(\x04R\rcustomDeptIds\"\xa7\x04\n" +
+	"\x0fcustom_dept_ids\x18\v \x03(\x04R\rcustomDeptIds\"_\n" +
+	"\x10RoleEffectiveApi\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\x12\x16\n" +
+	"\x06method\x18\x02 \x01(\tR\x06method\x12\x1f\n" +
+	"\vsource_role\x18\x03 \x01(\tR\n" +
+	"sourceRole\"\x84\x01\n" +
+	"\x1cRoleEffectivePermissionsResp\x12\x1d\n" +
+	"\n" +
+	"role_codes\x18\x01 \x03(\tR\troleCodes\x12*\n" +
+	"\x04apis\x18\x02 \x03(\v2\x16.core.RoleEffectiveApiR\x04apis\x12\x19\n" +
+	"\bmenu_ids\x18\x03 \x03(\x04R\amenuIds\"\xa7\x04\n" +
 	"\bRoleInfo\x12\x13\n" +
 	"\x02id\x18\x01 \x01(\x04H\x00R\x02id\x88\x01\x01\x12\"\n" +
 	"\n" +
@@ -9034,13 +17576,15 @@ const file_core_proto_rawDesc = "" +
 	"\x05_sortB\r\n" +
 	"\v_data_scopeB\f\n" +
 	"\n" +
-	"_tenant_id\"\xc1\x01\n" +
+	"_tenant_id\"\xfc\x01\n" +
 	"\vRoleListReq\x12\x12\n" +
 	"\x04page\x18\x01 \x01(\x04R\x04page\x12\x1b\n" +
 	"\tpage_size\x18\x02 \x01(\x04R\bpageSize\x12\x17\n" +
 	"\x04name\x18\x03 \x01(\tH\x00R\x04name\x88\x01\x01\x12\x17\n" +
 	"\x04code\x18\x04 \x01(\tH\x01R\x04code\x88\x01\x01\x12*\n" +
-	"\x0edefault_router\x18\x05 \x01(\tH\x02R\rdefaultRouter\x88\x01\x01B\a\n" +
+	"\x0edefault_router\x18\x05 \x01(\tH\x02R\rdefaultRouter\x88\x01\x01\x12#\n" +
+	"\rcode_prefixes\x18\x06 \x03(\tR\fcodePrefixes\x12\x14\n" +
+	"\x05codes\x18\a \x03(\tR\x05codesB\a\n" +
 	"\x05_nameB\a\n" +
 	"\x05_codeB\x11\n" +
 	"\x0f_default_router\"H\n" +
@@ -9051,7 +17595,19 @@ const file_core_proto_rawDesc = "" +
 	"\arole_id\x18\x01 \x01(\x04R\x06roleId\x12\x19\n" +
 	"\bmenu_ids\x18\x02 \x03(\x04R\amenuIds\"2\n" +
 	"\x15RoleMenuAuthorityResp\x12\x19\n" +
-	"\bmenu_ids\x18\x01 \x03(\x04R\amenuIds\"?\n" +
+	"\bmenu_ids\x18\x01 \x03(\x04R\amenuIds\"H\n" +
+	"\x0eRoleParentsReq\x12\x17\n" +
+	"\arole_id\x18\x01 \x01(\x04R\x06roleId\x12\x1d\n" +
+	"\n" +
+	"parent_ids\x18\x02 \x03(\x04R\tparentIds\"5\n" +
+	"\x0fRoleParentsResp\x12\"\n" +
+	"\x04data\x18\x01 \x03(\v2\x0e.core.RoleInfoR\x04data\"<\n" +
+	"\x0eRolePolicyInfo\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\x12\x16\n" +
+	"\x06method\x18\x02 \x01(\tR\x06method\"X\n" +
+	"\x12RolePolicyListResp\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\tR\aversion\x12(\n" +
+	"\x04data\x18\x02 \x03(\v2\x14.core.RolePolicyInfoR\x04data\"?\n" +
 	"\x15RoleStatusChangeParam\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x16\n" +
 	"\x06status\x18\x04 \x01(\rR\x06status\"\xb7\x01\n" +
@@ -9062,7 +17618,160 @@ const file_core_proto_rawDesc = "" +
 	"\buserName\x18\x04 \x01(\tH\x00R\buserName\x88\x01\x01\x12\x1b\n" +
 	"\x06mobile\x18\x05 \x01(\tH\x01R\x06mobile\x88\x01\x01B\v\n" +
 	"\t_userNameB\t\n" +
-	"\a_mobile\"\x86\x01\n" +
+	"\a_mobile\"\xa4\x01\n" +
+	"\n" +
+	"SamlAcsReq\x12\x1f\n" +
+	"\vprovider_id\x18\x01 \x01(\x04R\n" +
+	"providerId\x12#\n" +
+	"\rsaml_response\x18\x02 \x01(\tR\fsamlResponse\x12$\n" +
+	"\vrelay_state\x18\x03 \x01(\tH\x00R\n" +
+	"relayState\x88\x01\x01\x12\x13\n" +
+	"\x02ip\x18\x04 \x01(\tH\x01R\x02ip\x88\x01\x01B\x0e\n" +
+	"\f_relay_stateB\x05\n" +
+	"\x03_ip\"R\n" +
+	"\vSamlAcsResp\x12\"\n" +
+	"\x04user\x18\x01 \x01(\v2\x0e.core.UserInfoR\x04user\x12\x1f\n" +
+	"\vrelay_state\x18\x02 \x01(\tR\n" +
+	"relayState\"e\n" +
+	"\fSamlLoginReq\x12\x1f\n" +
+	"\vprovider_id\x18\x01 \x01(\x04R\n" +
+	"providerId\x12$\n" +
+	"\vrelay_state\x18\x02 \x01(\tH\x00R\n" +
+	"relayState\x88\x01\x01B\x0e\n" +
+	"\f_relay_state\"!\n" +
+	"\rSamlLoginResp\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\"\xc9\x01\n" +
+	"\x15SamlMetadataImportReq\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12&\n" +
+	"\fmetadata_xml\x18\x02 \x01(\tH\x00R\vmetadataXml\x88\x01\x01\x12&\n" +
+	"\fmetadata_url\x18\x03 \x01(\tH\x01R\vmetadataUrl\x88\x01\x01\x12 \n" +
+	"\tentity_id\x18\x04 \x01(\tH\x02R\bentityId\x88\x01\x01B\x0f\n" +
+	"\r_metadata_xmlB\x0f\n" +
+	"\r_metadata_urlB\f\n" +
+	"\n" +
+	"_entity_id\"\xa8\v\n" +
+	"\x10SamlProviderInfo\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\x04H\x00R\x02id\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\x03H\x01R\tcreatedAt\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"updated_at\x18\x03 \x01(\x03H\x02R\tupdatedAt\x88\x01\x01\x12\x1b\n" +
+	"\x06status\x18\x04 \x01(\rH\x03R\x06status\x88\x01\x01\x12 \n" +
+	"\ttenant_id\x18\x05 \x01(\x04H\x04R\btenantId\x88\x01\x01\x12\x17\n" +
+	"\x04name\x18\x06 \x01(\tH\x05R\x04name\x88\x01\x01\x12&\n" +
+	"\fdisplay_name\x18\a \x01(\tH\x06R\vdisplayName\x88\x01\x01\x12'\n" +
+	"\ridp_entity_id\x18\b \x01(\tH\aR\vidpEntityId\x88\x01\x01\x12#\n" +
+	"\vidp_sso_url\x18\t \x01(\tH\bR\tidpSsoUrl\x88\x01\x01\x12#\n" +
+	"\vidp_slo_url\x18\n" +
+	" \x01(\tH\tR\tidpSloUrl\x88\x01\x01\x12.\n" +
+	"\x10idp_certificates\x18\v \x01(\tH\n" +
+	"R\x0fidpCertificates\x88\x01\x01\x12-\n" +
+	"\x10idp_metadata_url\x18\f \x01(\tH\vR\x0eidpMetadataUrl\x88\x01\x01\x12%\n" +
+	"\fsp_entity_id\x18\r \x01(\tH\fR\n" +
+	"spEntityId\x88\x01\x01\x12\x1c\n" +
+	"\aacs_url\x18\x0e \x01(\tH\rR\x06acsUrl\x88\x01\x01\x12)\n" +
+	"\x0ename_id_format\x18\x0f \x01(\tH\x0eR\fnameIdFormat\x88\x01\x01\x121\n" +
+	"\x12sign_authn_request\x18\x10 \x01(\bH\x0fR\x10signAuthnRequest\x88\x01\x01\x129\n" +
+	"\x16want_assertions_signed\x18\x11 \x01(\bH\x10R\x14wantAssertionsSigned\x88\x01\x01\x120\n" +
+	"\x11attribute_mapping\x18\x12 \x01(\tH\x11R\x10attributeMapping\x88\x01\x01\x12(\n" +
+	"\rjit_provision\x18\x13 \x01(\bH\x12R\fjitProvision\x88\x01\x01\x12,\n" +
+	"\x0fsync_attributes\x18\x14 \x01(\bH\x13R\x0esyncAttributes\x88\x01\x01\x127\n" +
+	"\x15default_department_id\x18\x15 \x01(\x04H\x14R\x13defaultDepartmentId\x88\x01\x01\x12(\n" +
+	"\x10default_role_ids\x18\x16 \x03(\x04R\x0edefaultRoleIds\x12\x1d\n" +
+	"\aenabled\x18\x17 \x01(\bH\x15R\aenabled\x88\x01\x01\x12\x17\n" +
+	"\x04sort\x18\x18 \x01(\rH\x16R\x04sort\x88\x01\x01\x12\x1b\n" +
+	"\x06remark\x18\x19 \x01(\tH\x17R\x06remark\x88\x01\x01\x12'\n" +
+	"\rlink_by_email\x18\x1a \x01(\bH\x18R\vlinkByEmail\x88\x01\x01B\x05\n" +
+	"\x03_idB\r\n" +
+	"\v_created_atB\r\n" +
+	"\v_updated_atB\t\n" +
+	"\a_statusB\f\n" +
+	"\n" +
+	"_tenant_idB\a\n" +
+	"\x05_nameB\x0f\n" +
+	"\r_display_nameB\x10\n" +
+	"\x0e_idp_entity_idB\x0e\n" +
+	"\f_idp_sso_urlB\x0e\n" +
+	"\f_idp_slo_urlB\x13\n" +
+	"\x11_idp_certificatesB\x13\n" +
+	"\x11_idp_metadata_urlB\x0f\n" +
+	"\r_sp_entity_idB\n" +
+	"\n" +
+	"\b_acs_urlB\x11\n" +
+	"\x0f_name_id_formatB\x15\n" +
+	"\x13_sign_authn_requestB\x19\n" +
+	"\x17_want_assertions_signedB\x14\n" +
+	"\x12_attribute_mappingB\x10\n" +
+	"\x0e_jit_provisionB\x12\n" +
+	"\x10_sync_attributesB\x18\n" +
+	"\x16_default_department_idB\n" +
+	"\n" +
+	"\b_enabledB\a\n" +
+	"\x05_sortB\t\n" +
+	"\a_remarkB\x10\n" +
+	"\x0e_link_by_email\"h\n" +
+	"\x13SamlProviderListReq\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x04R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x04R\bpageSize\x12\x17\n" +
+	"\x04name\x18\x03 \x01(\tH\x00R\x04name\x88\x01\x01B\a\n" +
+	"\x05_name\"X\n" +
+	"\x14SamlProviderListResp\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\x04R\x05total\x12*\n" +
+	"\x04data\x18\x02 \x03(\v2\x16.core.SamlProviderInfoR\x04data\"4\n" +
+	"\x11SamlSpMetadataReq\x12\x1f\n" +
+	"\vprovider_id\x18\x01 \x01(\x04R\n" +
+	"providerId\"0\n" +
+	"\x12SamlSpMetadataResp\x12\x1a\n" +
+	"\bmetadata\x18\x01 \x01(\tR\bmetadata\"D\n" +
+	"\x10ScimTokenAuthReq\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x13\n" +
+	"\x02ip\x18\x02 \x01(\tH\x00R\x02ip\x88\x01\x01B\x05\n" +
+	"\x03_ip\"M\n" +
+	"\x13ScimTokenCreateResp\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x14\n" +
+	"\x05token\x18\x02 \x01(\tR\x05token\x12\x10\n" +
+	"\x03msg\x18\x03 \x01(\tR\x03msg\"\xae\x04\n" +
+	"\rScimTokenInfo\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\x04H\x00R\x02id\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\x03H\x01R\tcreatedAt\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"updated_at\x18\x03 \x01(\x03H\x02R\tupdatedAt\x88\x01\x01\x12\x1b\n" +
+	"\x06status\x18\x04 \x01(\rH\x03R\x06status\x88\x01\x01\x12 \n" +
+	"\ttenant_id\x18\x05 \x01(\x04H\x04R\btenantId\x88\x01\x01\x12\x17\n" +
+	"\x04name\x18\x06 \x01(\tH\x05R\x04name\x88\x01\x01\x12&\n" +
+	"\ftoken_prefix\x18\a \x01(\tH\x06R\vtokenPrefix\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"expired_at\x18\b \x01(\x03H\aR\texpiredAt\x88\x01\x01\x12%\n" +
+	"\flast_used_at\x18\t \x01(\x03H\bR\n" +
+	"lastUsedAt\x88\x01\x01\x12%\n" +
+	"\flast_used_ip\x18\n" +
+	" \x01(\tH\tR\n" +
+	"lastUsedIp\x88\x01\x01\x12\x1b\n" +
+	"\x06remark\x18\v \x01(\tH\n" +
+	"R\x06remark\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"role_codes\x18\f \x03(\tR\troleCodesB\x05\n" +
+	"\x03_idB\r\n" +
+	"\v_created_atB\r\n" +
+	"\v_updated_atB\t\n" +
+	"\a_statusB\f\n" +
+	"\n" +
+	"_tenant_idB\a\n" +
+	"\x05_nameB\x0f\n" +
+	"\r_token_prefixB\r\n" +
+	"\v_expired_atB\x0f\n" +
+	"\r_last_used_atB\x0f\n" +
+	"\r_last_used_ipB\t\n" +
+	"\a_remark\"e\n" +
+	"\x10ScimTokenListReq\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x04R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x04R\bpageSize\x12\x17\n" +
+	"\x04name\x18\x03 \x01(\tH\x00R\x04name\x88\x01\x01B\a\n" +
+	"\x05_name\"R\n" +
+	"\x11ScimTokenListResp\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\x04R\x05total\x12'\n" +
+	"\x04data\x18\x02 \x03(\v2\x13.core.ScimTokenInfoR\x04data\"\x86\x01\n" +
 	"\x12SyncCasbinRulesReq\x12&\n" +
 	"\fservice_name\x18\x01 \x01(\tH\x00R\vserviceName\x88\x01\x01\x12&\n" +
 	"\fforce_reload\x18\x02 \x01(\bH\x01R\vforceReload\x88\x01\x01B\x0f\n" +
@@ -9073,7 +17782,20 @@ const file_core_proto_rawDesc = "" +
 	"\x0fsynced_services\x18\x02 \x03(\tR\x0esyncedServices\x12(\n" +
 	"\x10sync_duration_ms\x18\x03 \x01(\x03R\x0esyncDurationMs\"#\n" +
 	"\rTenantCodeReq\x12\x12\n" +
-	"\x04code\x18\x01 \x01(\tR\x04code\"\xbf\x03\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\"\x94\x01\n" +
+	"\x13TenantDoctorFinding\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\x04R\btenantId\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\x12\x1a\n" +
+	"\bsubjects\x18\x04 \x03(\tR\bsubjects\x12\x16\n" +
+	"\x06repair\x18\x05 \x01(\tR\x06repair\"A\n" +
+	"\x0fTenantDoctorReq\x12 \n" +
+	"\ttenant_id\x18\x01 \x01(\x04H\x00R\btenantId\x88\x01\x01B\f\n" +
+	"\n" +
+	"_tenant_id\"W\n" +
+	"\x10TenantDoctorResp\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\x04R\x05total\x12-\n" +
+	"\x04data\x18\x02 \x03(\v2\x19.core.TenantDoctorFindingR\x04data\"\xbf\x03\n" +
 	"\n" +
 	"TenantInfo\x12\x13\n" +
 	"\x02id\x18\x01 \x01(\x04H\x00R\x02id\x88\x01\x01\x12\"\n" +
@@ -9100,16 +17822,154 @@ const file_core_proto_rawDesc = "" +
 	"\f_descriptionB\r\n" +
 	"\v_expired_atB\t\n" +
 	"\a_configB\r\n" +
-	"\v_created_by\"\xe0\x01\n" +
+	"\v_created_by\"\xe2\x03\n" +
+	"\x11TenantInitJobInfo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\x03R\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x03 \x01(\x03R\tupdatedAt\x12\x1b\n" +
+	"\ttenant_id\x18\x04 \x01(\x04R\btenantId\x12\x1a\n" +
+	"\btemplate\x18\x05 \x01(\tR\btemplate\x12\x12\n" +
+	"\x04mode\x18\x06 \x01(\tR\x04mode\x12\x17\n" +
+	"\adry_run\x18\a \x01(\bR\x06dryRun\x12\x16\n" +
+	"\x06status\x18\b \x01(\tR\x06status\x12\x1a\n" +
+	"\battempts\x18\t \x01(\rR\battempts\x12\x14\n" +
+	"\x05error\x18\n" +
+	" \x01(\tR\x05error\x12\"\n" +
+	"\n" +
+	"started_at\x18\v \x01(\x03H\x00R\tstartedAt\x88\x01\x01\x12$\n" +
+	"\vfinished_at\x18\f \x01(\x03H\x01R\n" +
+	"finishedAt\x88\x01\x01\x128\n" +
+	"\aplugins\x18\r \x03(\v2\x1e.core.TenantInitPluginProgressR\aplugins\x12,\n" +
+	"\x04plan\x18\x0e \x03(\v2\x18.core.TenantInitPlanItemR\x04planB\r\n" +
+	"\v_started_atB\x0e\n" +
+	"\f_finished_at\"\x9f\x01\n" +
+	"\x14TenantInitJobListReq\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x04R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x04R\bpageSize\x12 \n" +
+	"\ttenant_id\x18\x03 \x01(\x04H\x00R\btenantId\x88\x01\x01\x12\x1b\n" +
+	"\x06status\x18\x04 \x01(\tH\x01R\x06status\x88\x01\x01B\f\n" +
+	"\n" +
+	"_tenant_idB\t\n" +
+	"\a_status\"Z\n" +
+	"\x15TenantInitJobListResp\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\x04R\x05total\x12+\n" +
+	"\x04data\x18\x02 \x03(\v2\x17.core.TenantInitJobInfoR\x04data\"f\n" +
+	"\x15TenantInitJobRetryReq\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12*\n" +
+	"\x0eadmin_password\x18\x02 \x01(\tH\x00R\radminPassword\x88\x01\x01B\x11\n" +
+	"\x0f_admin_password\"\x8e\x01\n" +
+	"\x12TenantInitPlanItem\x12\x16\n" +
+	"\x06plugin\x18\x01 \x01(\tR\x06plugin\x12\x1c\n" +
+	"\tcomponent\x18\x02 \x01(\tR\tcomponent\x12\x16\n" +
+	"\x06action\x18\x03 \x01(\tR\x06action\x12\x14\n" +
+	"\x05count\x18\x04 \x01(\x04R\x05count\x12\x14\n" +
+	"\x05items\x18\x05 \x03(\tR\x05items\"\xae\x04\n" +
+	"\x14TenantInitPluginInfo\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\x04H\x00R\x02id\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\x03H\x01R\tcreatedAt\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"updated_at\x18\x03 \x01(\x03H\x02R\tupdatedAt\x88\x01\x01\x12\x1b\n" +
+	"\x06status\x18\x04 \x01(\rH\x03R\x06status\x88\x01\x01\x12\x17\n" +
+	"\x04name\x18\x05 \x01(\tH\x04R\x04name\x88\x01\x01\x12\x1d\n" +
+	"\aversion\x18\x06 \x01(\tH\x05R\aversion\x88\x01\x01\x12%\n" +
+	"\vdescription\x18\a \x01(\tH\x06R\vdescription\x88\x01\x01\x12\x1b\n" +
+	"\x06target\x18\b \x01(\tH\aR\x06target\x88\x01\x01\x12\"\n" +
+	"\fdependencies\x18\t \x03(\tR\fdependencies\x12\x1f\n" +
+	"\bpriority\x18\n" +
+	" \x01(\x05H\bR\bpriority\x88\x01\x01\x12\x1d\n" +
+	"\atimeout\x18\v \x01(\rH\tR\atimeout\x88\x01\x01\x12.\n" +
+	"\x10support_rollback\x18\f \x01(\bH\n" +
+	"R\x0fsupportRollback\x88\x01\x01B\x05\n" +
+	"\x03_idB\r\n" +
+	"\v_created_atB\r\n" +
+	"\v_updated_atB\t\n" +
+	"\a_statusB\a\n" +
+	"\x05_nameB\n" +
+	"\n" +
+	"\b_versionB\x0e\n" +
+	"\f_descriptionB\t\n" +
+	"\a_targetB\v\n" +
+	"\t_priorityB\n" +
+	"\n" +
+	"\b_timeoutB\x13\n" +
+	"\x11_support_rollback\"l\n" +
+	"\x17TenantInitPluginListReq\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x04R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x04R\bpageSize\x12\x17\n" +
+	"\x04name\x18\x03 \x01(\tH\x00R\x04name\x88\x01\x01B\a\n" +
+	"\x05_name\"`\n" +
+	"\x18TenantInitPluginListResp\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\x04R\x05total\x12.\n" +
+	"\x04data\x18\x02 \x03(\v2\x1a.core.TenantInitPluginInfoR\x04data\"\xe1\x01\n" +
+	"\x18TenantInitPluginProgress\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\x12\x1a\n" +
+	"\battempts\x18\x04 \x01(\rR\battempts\x12\"\n" +
+	"\n" +
+	"started_at\x18\x05 \x01(\x03H\x00R\tstartedAt\x88\x01\x01\x12$\n" +
+	"\vfinished_at\x18\x06 \x01(\x03H\x01R\n" +
+	"finishedAt\x88\x01\x01B\r\n" +
+	"\v_started_atB\x0e\n" +
+	"\f_finished_at\"\xb8\x02\n" +
 	"\rTenantInitReq\x12\x1b\n" +
 	"\ttenant_id\x18\x01 \x01(\x04R\btenantId\x12*\n" +
 	"\x0eadmin_username\x18\x02 \x01(\tH\x00R\radminUsername\x88\x01\x01\x12*\n" +
 	"\x0eadmin_password\x18\x03 \x01(\tH\x01R\radminPassword\x88\x01\x01\x12$\n" +
 	"\vadmin_email\x18\x04 \x01(\tH\x02R\n" +
-	"adminEmail\x88\x01\x01B\x11\n" +
+	"adminEmail\x88\x01\x01\x12\x1f\n" +
+	"\btemplate\x18\x05 \x01(\tH\x03R\btemplate\x88\x01\x01\x12\x1c\n" +
+	"\adry_run\x18\x06 \x01(\bH\x04R\x06dryRun\x88\x01\x01B\x11\n" +
 	"\x0f_admin_usernameB\x11\n" +
 	"\x0f_admin_passwordB\x0e\n" +
-	"\f_admin_email\"\xdf\x01\n" +
+	"\f_admin_emailB\v\n" +
+	"\t_templateB\n" +
+	"\n" +
+	"\b_dry_run\"\xd8\x03\n" +
+	"\x16TenantInitTemplateInfo\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\x04H\x00R\x02id\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\x03H\x01R\tcreatedAt\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"updated_at\x18\x03 \x01(\x03H\x02R\tupdatedAt\x88\x01\x01\x12\x1b\n" +
+	"\x06status\x18\x04 \x01(\rH\x03R\x06status\x88\x01\x01\x12\x17\n" +
+	"\x04name\x18\x05 \x01(\tH\x04R\x04name\x88\x01\x01\x12\x1d\n" +
+	"\aversion\x18\x06 \x01(\tH\x05R\aversion\x88\x01\x01\x12%\n" +
+	"\vdescription\x18\a \x01(\tH\x06R\vdescription\x88\x01\x01\x12(\n" +
+	"\rbase_template\x18\b \x01(\tH\aR\fbaseTemplate\x88\x01\x01\x12#\n" +
+	"\n" +
+	"components\x18\t \x01(\tH\bR\n" +
+	"components\x88\x01\x01\x12\x17\n" +
+	"\x04sort\x18\n" +
+	" \x01(\rH\tR\x04sort\x88\x01\x01B\x05\n" +
+	"\x03_idB\r\n" +
+	"\v_created_atB\r\n" +
+	"\v_updated_atB\t\n" +
+	"\a_statusB\a\n" +
+	"\x05_nameB\n" +
+	"\n" +
+	"\b_versionB\x0e\n" +
+	"\f_descriptionB\x10\n" +
+	"\x0e_base_templateB\r\n" +
+	"\v_componentsB\a\n" +
+	"\x05_sort\"\xaa\x01\n" +
+	"\x19TenantInitTemplateListReq\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x04R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x04R\bpageSize\x12\x17\n" +
+	"\x04name\x18\x03 \x01(\tH\x00R\x04name\x88\x01\x01\x12(\n" +
+	"\rbase_template\x18\x04 \x01(\tH\x01R\fbaseTemplate\x88\x01\x01B\a\n" +
+	"\x05_nameB\x10\n" +
+	"\x0e_base_template\"d\n" +
+	"\x1aTenantInitTemplateListResp\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\x04R\x05total\x120\n" +
+	"\x04data\x18\x02 \x03(\v2\x1c.core.TenantInitTemplateInfoR\x04data\"2\n" +
+	"\x1cTenantInitTemplatePreviewReq\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"9\n" +
+	"\x1dTenantInitTemplatePreviewResp\x12\x18\n" +
+	"\acontent\x18\x01 \x01(\tR\acontent\"\xdf\x01\n" +
 	"\rTenantListReq\x12\x12\n" +
 	"\x04page\x18\x01 \x01(\x04R\x04page\x12\x1b\n" +
 	"\tpage_size\x18\x02 \x01(\x04R\bpageSize\x12\x17\n" +
@@ -9124,10 +17984,29 @@ const file_core_proto_rawDesc = "" +
 	"\v_created_by\"L\n" +
 	"\x0eTenantListResp\x12\x14\n" +
 	"\x05total\x18\x01 \x01(\x04R\x05total\x12$\n" +
-	"\x04data\x18\x02 \x03(\v2\x10.core.TenantInfoR\x04data\"9\n" +
+	"\x04data\x18\x02 \x03(\v2\x10.core.TenantInfoR\x04data\"\xda\x01\n" +
+	"\x13TenantPluginInitReq\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\x04R\btenantId\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x02 \x01(\tR\trequestId\x12\x12\n" +
+	"\x04mode\x18\x03 \x01(\tR\x04mode\x12*\n" +
+	"\x0eadmin_username\x18\x04 \x01(\tH\x00R\radminUsername\x88\x01\x01\x12$\n" +
+	"\vadmin_email\x18\x05 \x01(\tH\x01R\n" +
+	"adminEmail\x88\x01\x01B\x11\n" +
+	"\x0f_admin_usernameB\x0e\n" +
+	"\f_admin_email\":\n" +
+	"\x16TenantPluginStatusResp\x12 \n" +
+	"\vinitialized\x18\x01 \x01(\bR\vinitialized\"4\n" +
+	"\x15TenantPluginTenantReq\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\x04R\btenantId\"\x83\x01\n" +
+	"\x0fTenantRepairReq\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\x04R\btenantId\x12\x14\n" +
+	"\x05codes\x18\x02 \x03(\tR\x05codes\x12*\n" +
+	"\x0eadmin_password\x18\x03 \x01(\tH\x00R\radminPassword\x88\x01\x01B\x11\n" +
+	"\x0f_admin_password\"9\n" +
 	"\x0fTenantStatusReq\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x16\n" +
-	"\x06status\x18\x02 \x01(\rR\x06status\"\xb5\x03\n" +
+	"\x06status\x18\x02 \x01(\rR\x06status\"\x97\x05\n" +
 	"\tTokenInfo\x12\x13\n" +
 	"\x02id\x18\x01 \x01(\tH\x00R\x02id\x88\x01\x01\x12\"\n" +
 	"\n" +
@@ -9142,7 +18021,16 @@ const file_core_proto_rawDesc = "" +
 	"expired_at\x18\b \x01(\x03H\aR\texpiredAt\x88\x01\x01\x12\x1f\n" +
 	"\busername\x18\t \x01(\tH\bR\busername\x88\x01\x01\x12 \n" +
 	"\ttenant_id\x18\n" +
-	" \x01(\x04H\tR\btenantId\x88\x01\x01B\x05\n" +
+	" \x01(\x04H\tR\btenantId\x88\x01\x01\x12\x1b\n" +
+	"\x06device\x18\v \x01(\tH\n" +
+	"R\x06device\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"user_agent\x18\f \x01(\tH\vR\tuserAgent\x88\x01\x01\x12\x13\n" +
+	"\x02ip\x18\r \x01(\tH\fR\x02ip\x88\x01\x01\x12%\n" +
+	"\flast_seen_at\x18\x0e \x01(\x03H\rR\n" +
+	"lastSeenAt\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"session_id\x18\x0f \x01(\tH\x0eR\tsessionId\x88\x01\x01B\x05\n" +
 	"\x03_idB\r\n" +
 	"\v_created_atB\r\n" +
 	"\v_updated_atB\t\n" +
@@ -9153,7 +18041,12 @@ const file_core_proto_rawDesc = "" +
 	"\v_expired_atB\v\n" +
 	"\t_usernameB\f\n" +
 	"\n" +
-	"_tenant_id\"\xe2\x01\n" +
+	"_tenant_idB\t\n" +
+	"\a_deviceB\r\n" +
+	"\v_user_agentB\x05\n" +
+	"\x03_ipB\x0f\n" +
+	"\r_last_seen_atB\r\n" +
+	"\v_session_id\"\xe2\x01\n" +
 	"\fTokenListReq\x12\x12\n" +
 	"\x04page\x18\x01 \x01(\x04R\x04page\x12\x1b\n" +
 	"\tpage_size\x18\x02 \x01(\x04R\bpageSize\x12\x1f\n" +
@@ -9167,7 +18060,14 @@ const file_core_proto_rawDesc = "" +
 	"\x05_uuid\"J\n" +
 	"\rTokenListResp\x12\x14\n" +
 	"\x05total\x18\x01 \x01(\x04R\x05total\x12#\n" +
-	"\x04data\x18\x02 \x03(\v2\x0f.core.TokenInfoR\x04data\"\x19\n" +
+	"\x04data\x18\x02 \x03(\v2\x0f.core.TokenInfoR\x04data\"t\n" +
+	"\rTokenTouchReq\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x13\n" +
+	"\x02ip\x18\x02 \x01(\tH\x00R\x02ip\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"user_agent\x18\x03 \x01(\tH\x01R\tuserAgent\x88\x01\x01B\x05\n" +
+	"\x03_ipB\r\n" +
+	"\v_user_agent\"\x19\n" +
 	"\aUUIDReq\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"\x1c\n" +
 	"\bUUIDsReq\x12\x10\n" +
@@ -9251,7 +18151,17 @@ const file_core_proto_rawDesc = "" +
 	"\f_description\"H\n" +
 	"\fUserListResp\x12\x14\n" +
 	"\x05total\x18\x01 \x01(\x04R\x05total\x12\"\n" +
-	"\x04data\x18\x02 \x03(\v2\x0e.core.UserInfoR\x04data\")\n" +
+	"\x04data\x18\x02 \x03(\v2\x0e.core.UserInfoR\x04data\"Y\n" +
+	"\x12UserSessionListReq\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x04R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x04R\bpageSize\x12\x12\n" +
+	"\x04uuid\x18\x03 \x01(\tR\x04uuid\"o\n" +
+	"\x14UserSessionRevokeReq\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12\x10\n" +
+	"\x03ids\x18\x02 \x03(\tR\x03ids\x12\"\n" +
+	"\n" +
+	"keep_token\x18\x03 \x01(\tH\x00R\tkeepToken\x88\x01\x01B\r\n" +
+	"\v_keep_token\")\n" +
 	"\vUsernameReq\x12\x1a\n" +
 	"\busername\x18\x01 \x01(\tR\busername\"\x83\x01\n" +
 	"\x15ValidateCasbinRuleReq\x12(\n" +
@@ -9262,7 +18172,7 @@ const file_core_proto_rawDesc = "" +
 	"\x05valid\x18\x01 \x01(\bR\x05valid\x12\x16\n" +
 	"\x06errors\x18\x02 \x03(\tR\x06errors\x12\x1a\n" +
 	"\bwarnings\x18\x03 \x03(\tR\bwarnings\x12\x1c\n" +
-	"\tconflicts\x18\x04 \x03(\tR\tconflicts2\xb24\n" +
+	"\tconflicts\x18\x04 \x03(\tR\tconflicts2\xb2b\n" +
 	"\x04Core\x12,\n" +
 	"\tcreateApi\x12\r.core.ApiInfo\x1a\x10.core.BaseIDResp\x12*\n" +
 	"\tupdateApi\x12\r.core.ApiInfo\x1a\x0e.core.BaseResp\x121\n" +
@@ -9270,14 +18180,21 @@ const file_core_proto_rawDesc = "" +
 	"getApiList\x12\x10.core.ApiListReq\x1a\x11.core.ApiListResp\x12(\n" +
 	"\n" +
 	"getApiById\x12\v.core.IDReq\x1a\r.core.ApiInfo\x12)\n" +
-	"\tdeleteApi\x12\f.core.IDsReq\x1a\x0e.core.BaseResp\x128\n" +
+	"\tdeleteApi\x12\f.core.IDsReq\x1a\x0e.core.BaseResp\x12F\n" +
+	"\x0fapiRegistration\x12\x18.core.ApiRegistrationReq\x1a\x19.core.ApiRegistrationResp\x128\n" +
 	"\x0ecreateAuditLog\x12\x12.core.AuditLogInfo\x1a\x12.core.BaseUUIDResp\x12@\n" +
 	"\x0fgetAuditLogList\x12\x15.core.AuditLogListReq\x1a\x16.core.AuditLogListResp\x124\n" +
-	"\x0fgetAuditLogById\x12\r.core.UUIDReq\x1a\x12.core.AuditLogInfo\x120\n" +
-	"\x0edeleteAuditLog\x12\x0e.core.UUIDsReq\x1a\x0e.core.BaseResp\x12C\n" +
+	"\x0fgetAuditLogById\x12\r.core.UUIDReq\x1a\x12.core.AuditLogInfo\x12C\n" +
 	"\x10getAuditLogStats\x12\x16.core.AuditLogStatsReq\x1a\x17.core.AuditLogStatsResp\x12<\n" +
+	"\x13verifyAuditLogChain\x12\v.core.Empty\x1a\x18.core.AuditLogVerifyResp\x12U\n" +
+	"\x16getAuditLogArchiveList\x12\x1c.core.AuditLogArchiveListReq\x1a\x1d.core.AuditLogArchiveListResp\x12E\n" +
+	"\x14restoreAuditLogRange\x12\x15.core.AuditLogListReq\x1a\x16.core.AuditLogListResp\x12<\n" +
 	"\x10getMenuAuthority\x12\v.core.IDReq\x1a\x1b.core.RoleMenuAuthorityResp\x12I\n" +
-	"\x1bcreateOrUpdateMenuAuthority\x12\x1a.core.RoleMenuAuthorityReq\x1a\x0e.core.BaseResp\x12+\n" +
+	"\x1bcreateOrUpdateMenuAuthority\x12\x1a.core.RoleMenuAuthorityReq\x1a\x0e.core.BaseResp\x128\n" +
+	"\x0fgetRolePolicies\x12\v.core.IDReq\x1a\x18.core.RolePolicyListResp\x12R\n" +
+	"\x13replaceRolePolicies\x12\x1c.core.ReplaceRolePoliciesReq\x1a\x1d.core.ReplaceRolePoliciesResp\x12I\n" +
+	"\x12exportPolicyBundle\x12\x1b.core.PolicyBundleExportReq\x1a\x16.core.PolicyBundleResp\x12O\n" +
+	"\x12importPolicyBundle\x12\x1b.core.PolicyBundleImportReq\x1a\x1c.core.PolicyBundleImportResp\x12+\n" +
 	"\finitDatabase\x12\v.core.Empty\x1a\x0e.core.BaseResp\x12:\n" +
 	"\x10createCasbinRule\x12\x14.core.CasbinRuleInfo\x1a\x10.core.BaseIDResp\x128\n" +
 	"\x10updateCasbinRule\x12\x14.core.CasbinRuleInfo\x1a\x0e.core.BaseResp\x120\n" +
@@ -9315,7 +18232,16 @@ const file_core_proto_rawDesc = "" +
 	"\x17getDictionaryDetailList\x12\x1d.core.DictionaryDetailListReq\x1a\x1e.core.DictionaryDetailListResp\x12B\n" +
 	"\x17getDictionaryDetailById\x12\v.core.IDReq\x1a\x1a.core.DictionaryDetailInfo\x126\n" +
 	"\x16deleteDictionaryDetail\x12\f.core.IDsReq\x1a\x0e.core.BaseResp\x12T\n" +
-	"#getDictionaryDetailByDictionaryName\x12\r.core.BaseMsg\x1a\x1e.core.DictionaryDetailListResp\x12.\n" +
+	"#getDictionaryDetailByDictionaryName\x12\r.core.BaseMsg\x1a\x1e.core.DictionaryDetailListResp\x12>\n" +
+	"\x12createLdapProvider\x12\x16.core.LdapProviderInfo\x1a\x10.core.BaseIDResp\x12<\n" +
+	"\x12updateLdapProvider\x12\x16.core.LdapProviderInfo\x1a\x0e.core.BaseResp\x12L\n" +
+	"\x13getLdapProviderList\x12\x19.core.LdapProviderListReq\x1a\x1a.core.LdapProviderListResp\x12:\n" +
+	"\x13getLdapProviderById\x12\v.core.IDReq\x1a\x16.core.LdapProviderInfo\x122\n" +
+	"\x12deleteLdapProvider\x12\f.core.IDsReq\x1a\x0e.core.BaseResp\x12/\n" +
+	"\tldapLogin\x12\x12.core.LdapLoginReq\x1a\x0e.core.UserInfo\x12<\n" +
+	"\x10syncLdapProvider\x12\x11.core.LdapSyncReq\x1a\x15.core.LdapSyncRunInfo\x12I\n" +
+	"\x12getLdapSyncRunList\x12\x18.core.LdapSyncRunListReq\x1a\x19.core.LdapSyncRunListResp\x128\n" +
+	"\x12getLdapSyncRunById\x12\v.core.IDReq\x1a\x15.core.LdapSyncRunInfo\x12.\n" +
 	"\n" +
 	"createMenu\x12\x0e.core.MenuInfo\x1a\x10.core.BaseIDResp\x12,\n" +
 	"\n" +
@@ -9324,15 +18250,29 @@ const file_core_proto_rawDesc = "" +
 	"deleteMenu\x12\v.core.IDReq\x1a\x0e.core.BaseResp\x12&\n" +
 	"\agetMenu\x12\v.core.IDReq\x1a\x0e.core.MenuInfo\x126\n" +
 	"\x11getMenuListByRole\x12\r.core.BaseMsg\x1a\x12.core.MenuInfoList\x124\n" +
-	"\vgetMenuList\x12\x11.core.PageInfoReq\x1a\x12.core.MenuInfoList\x12@\n" +
+	"\vgetMenuList\x12\x11.core.PageInfoReq\x1a\x12.core.MenuInfoList\x12G\n" +
+	"\x11createOauthClient\x12\x15.core.OauthClientInfo\x1a\x1b.core.OauthClientSecretResp\x12:\n" +
+	"\x11updateOauthClient\x12\x15.core.OauthClientInfo\x1a\x0e.core.BaseResp\x12I\n" +
+	"\x12getOauthClientList\x12\x18.core.OauthClientListReq\x1a\x19.core.OauthClientListResp\x128\n" +
+	"\x12getOauthClientById\x12\v.core.IDReq\x1a\x15.core.OauthClientInfo\x121\n" +
+	"\x11deleteOauthClient\x12\f.core.IDsReq\x1a\x0e.core.BaseResp\x12B\n" +
+	"\x16resetOauthClientSecret\x12\v.core.IDReq\x1a\x1b.core.OauthClientSecretResp\x12I\n" +
+	"\x18getOauthClientByClientId\x12\x16.core.OauthClientIdReq\x1a\x15.core.OauthClientInfo\x12J\n" +
+	"\x17authenticateOauthClient\x12\x18.core.OauthClientAuthReq\x1a\x15.core.OauthClientInfo\x12I\n" +
+	"\x14authorizeOauthClient\x12\x17.core.OauthAuthorizeReq\x1a\x18.core.OauthAuthorizeResp\x12R\n" +
+	"\x1eexchangeOauthAuthorizationCode\x12\x1a.core.OauthCodeExchangeReq\x1a\x14.core.OauthGrantInfo\x12L\n" +
+	"\x13getOauthConsentList\x12\x19.core.OauthConsentListReq\x1a\x1a.core.OauthConsentListResp\x122\n" +
+	"\x12deleteOauthConsent\x12\f.core.IDsReq\x1a\x0e.core.BaseResp\x12@\n" +
 	"\x13createOauthProvider\x12\x17.core.OauthProviderInfo\x1a\x10.core.BaseIDResp\x12>\n" +
 	"\x13updateOauthProvider\x12\x17.core.OauthProviderInfo\x1a\x0e.core.BaseResp\x12O\n" +
 	"\x14getOauthProviderList\x12\x1a.core.OauthProviderListReq\x1a\x1b.core.OauthProviderListResp\x12<\n" +
 	"\x14getOauthProviderById\x12\v.core.IDReq\x1a\x17.core.OauthProviderInfo\x123\n" +
 	"\x13deleteOauthProvider\x12\f.core.IDsReq\x1a\x0e.core.BaseResp\x12:\n" +
 	"\n" +
-	"oauthLogin\x12\x13.core.OauthLoginReq\x1a\x17.core.OauthRedirectResp\x122\n" +
-	"\roauthCallback\x12\x11.core.CallbackReq\x1a\x0e.core.UserInfo\x12>\n" +
+	"oauthLogin\x12\x13.core.OauthLoginReq\x1a\x17.core.OauthRedirectResp\x12;\n" +
+	"\roauthCallback\x12\x11.core.CallbackReq\x1a\x17.core.OauthCallbackResp\x12a\n" +
+	"\x18previewOauthClaimMapping\x12!.core.OauthClaimMappingPreviewReq\x1a\".core.OauthClaimMappingPreviewResp\x12=\n" +
+	"\foauthWebhook\x12\x15.core.OauthWebhookReq\x1a\x16.core.OauthWebhookResp\x12>\n" +
 	"\x12createOauthAccount\x12\x16.core.OauthAccountInfo\x1a\x10.core.BaseIDResp\x12<\n" +
 	"\x12updateOauthAccount\x12\x16.core.OauthAccountInfo\x1a\x0e.core.BaseResp\x12L\n" +
 	"\x13getOauthAccountList\x12\x19.core.OauthAccountListReq\x1a\x1a.core.OauthAccountListResp\x12:\n" +
@@ -9340,11 +18280,23 @@ const file_core_proto_rawDesc = "" +
 	"\x12deleteOauthAccount\x12\f.core.IDsReq\x1a\x0e.core.BaseResp\x12=\n" +
 	"\x10bindOauthAccount\x12\x19.core.BindOauthAccountReq\x1a\x0e.core.BaseResp\x12A\n" +
 	"\x12unbindOauthAccount\x12\x1b.core.UnbindOauthAccountReq\x1a\x0e.core.BaseResp\x12U\n" +
-	"\x14getUserOauthAccounts\x12\x1d.core.GetUserOauthAccountsReq\x1a\x1e.core.GetUserOauthAccountsResp\x12C\n" +
+	"\x14getUserOauthAccounts\x12\x1d.core.GetUserOauthAccountsReq\x1a\x1e.core.GetUserOauthAccountsResp\x12L\n" +
+	"\x13getOauthAccessToken\x12\x19.core.OauthAccessTokenReq\x1a\x1a.core.OauthAccessTokenResp\x12C\n" +
 	"\x12createOauthSession\x12\x1b.core.CreateOauthSessionReq\x1a\x10.core.BaseIDResp\x12A\n" +
 	"\x12updateOauthSession\x12\x1b.core.UpdateOauthSessionReq\x1a\x0e.core.BaseResp\x12Q\n" +
 	"\x16getOauthSessionByState\x12\x1f.core.GetOauthSessionByStateReq\x1a\x16.core.OauthSessionInfo\x121\n" +
-	"\x12deleteOauthSession\x12\v.core.IDReq\x1a\x0e.core.BaseResp\x126\n" +
+	"\x12deleteOauthSession\x12\v.core.IDReq\x1a\x0e.core.BaseResp\x12P\n" +
+	"\x1bcreateOauthProviderTemplate\x12\x1f.core.OauthProviderTemplateInfo\x1a\x10.core.BaseIDResp\x12N\n" +
+	"\x1bupdateOauthProviderTemplate\x12\x1f.core.OauthProviderTemplateInfo\x1a\x0e.core.BaseResp\x12g\n" +
+	"\x1cgetOauthProviderTemplateList\x12\".core.OauthProviderTemplateListReq\x1a#.core.OauthProviderTemplateListResp\x12L\n" +
+	"\x1cgetOauthProviderTemplateById\x12\v.core.IDReq\x1a\x1f.core.OauthProviderTemplateInfo\x12;\n" +
+	"\x1bdeleteOauthProviderTemplate\x12\f.core.IDsReq\x1a\x0e.core.BaseResp\x12U\n" +
+	"\x1benableOauthProviderTemplate\x12$.core.EnableOauthProviderTemplateReq\x1a\x10.core.BaseIDResp\x12:\n" +
+	"\x10createOauthScope\x12\x14.core.OauthScopeInfo\x1a\x10.core.BaseIDResp\x128\n" +
+	"\x10updateOauthScope\x12\x14.core.OauthScopeInfo\x1a\x0e.core.BaseResp\x12F\n" +
+	"\x11getOauthScopeList\x12\x17.core.OauthScopeListReq\x1a\x18.core.OauthScopeListResp\x126\n" +
+	"\x11getOauthScopeById\x12\v.core.IDReq\x1a\x14.core.OauthScopeInfo\x120\n" +
+	"\x10deleteOauthScope\x12\f.core.IDsReq\x1a\x0e.core.BaseResp\x126\n" +
 	"\x0ecreatePosition\x12\x12.core.PositionInfo\x1a\x10.core.BaseIDResp\x124\n" +
 	"\x0eupdatePosition\x12\x12.core.PositionInfo\x1a\x0e.core.BaseResp\x12@\n" +
 	"\x0fgetPositionList\x12\x15.core.PositionListReq\x1a\x16.core.PositionListResp\x122\n" +
@@ -9357,29 +18309,73 @@ const file_core_proto_rawDesc = "" +
 	"\vgetRoleList\x12\x11.core.RoleListReq\x1a\x12.core.RoleListResp\x12*\n" +
 	"\vgetRoleById\x12\v.core.IDReq\x1a\x0e.core.RoleInfo\x12*\n" +
 	"\n" +
-	"deleteRole\x12\f.core.IDsReq\x1a\x0e.core.BaseResp\x126\n" +
+	"deleteRole\x12\f.core.IDsReq\x1a\x0e.core.BaseResp\x122\n" +
+	"\x13deleteRoleWithUsers\x12\v.core.IDReq\x1a\x0e.core.BaseResp\x126\n" +
 	"\x17initRoleDataPermToRedis\x12\v.core.Empty\x1a\x0e.core.BaseResp\x12=\n" +
 	"\x13assignRoleDataScope\x12\x16.core.RoleDataScopeReq\x1a\x0e.core.BaseResp\x12/\n" +
 	"\n" +
 	"cancelAuth\x12\x11.core.RoleAuthReq\x1a\x0e.core.BaseResp\x12,\n" +
 	"\aaddAuth\x12\x11.core.RoleAuthReq\x1a\x0e.core.BaseResp\x12?\n" +
-	"\x10changeRoleStatus\x12\x1b.core.RoleStatusChangeParam\x1a\x0e.core.BaseResp\x122\n" +
+	"\x10changeRoleStatus\x12\x1b.core.RoleStatusChangeParam\x1a\x0e.core.BaseResp\x126\n" +
+	"\x0esetRoleParents\x12\x14.core.RoleParentsReq\x1a\x0e.core.BaseResp\x124\n" +
+	"\x0egetRoleParents\x12\v.core.IDReq\x1a\x15.core.RoleParentsResp\x12N\n" +
+	"\x1bgetRoleEffectivePermissions\x12\v.core.IDReq\x1a\".core.RoleEffectivePermissionsResp\x12>\n" +
+	"\x12createSamlProvider\x12\x16.core.SamlProviderInfo\x1a\x10.core.BaseIDResp\x12<\n" +
+	"\x12updateSamlProvider\x12\x16.core.SamlProviderInfo\x1a\x0e.core.BaseResp\x12L\n" +
+	"\x13getSamlProviderList\x12\x19.core.SamlProviderListReq\x1a\x1a.core.SamlProviderListResp\x12:\n" +
+	"\x13getSamlProviderById\x12\v.core.IDReq\x1a\x16.core.SamlProviderInfo\x122\n" +
+	"\x12deleteSamlProvider\x12\f.core.IDsReq\x1a\x0e.core.BaseResp\x12D\n" +
+	"\x15importSamlIdpMetadata\x12\x1b.core.SamlMetadataImportReq\x1a\x0e.core.BaseResp\x12F\n" +
+	"\x11getSamlSpMetadata\x12\x17.core.SamlSpMetadataReq\x1a\x18.core.SamlSpMetadataResp\x124\n" +
+	"\tsamlLogin\x12\x12.core.SamlLoginReq\x1a\x13.core.SamlLoginResp\x12.\n" +
+	"\asamlAcs\x12\x10.core.SamlAcsReq\x1a\x11.core.SamlAcsResp\x12A\n" +
+	"\x0fcreateScimToken\x12\x13.core.ScimTokenInfo\x1a\x19.core.ScimTokenCreateResp\x126\n" +
+	"\x0fupdateScimToken\x12\x13.core.ScimTokenInfo\x1a\x0e.core.BaseResp\x12C\n" +
+	"\x10getScimTokenList\x12\x16.core.ScimTokenListReq\x1a\x17.core.ScimTokenListResp\x124\n" +
+	"\x10getScimTokenById\x12\v.core.IDReq\x1a\x13.core.ScimTokenInfo\x12/\n" +
+	"\x0fdeleteScimToken\x12\f.core.IDsReq\x1a\x0e.core.BaseResp\x12D\n" +
+	"\x15authenticateScimToken\x12\x16.core.ScimTokenAuthReq\x1a\x13.core.ScimTokenInfo\x122\n" +
 	"\fcreateTenant\x12\x10.core.TenantInfo\x1a\x10.core.BaseIDResp\x120\n" +
 	"\fupdateTenant\x12\x10.core.TenantInfo\x1a\x0e.core.BaseResp\x12:\n" +
 	"\rgetTenantList\x12\x13.core.TenantListReq\x1a\x14.core.TenantListResp\x12.\n" +
 	"\rgetTenantById\x12\v.core.IDReq\x1a\x10.core.TenantInfo\x128\n" +
 	"\x0fgetTenantByCode\x12\x13.core.TenantCodeReq\x1a\x10.core.TenantInfo\x12,\n" +
 	"\fdeleteTenant\x12\f.core.IDsReq\x1a\x0e.core.BaseResp\x12;\n" +
-	"\x12updateTenantStatus\x12\x15.core.TenantStatusReq\x1a\x0e.core.BaseResp\x121\n" +
+	"\x12updateTenantStatus\x12\x15.core.TenantStatusReq\x1a\x0e.core.BaseResp\x123\n" +
 	"\n" +
-	"initTenant\x12\x13.core.TenantInitReq\x1a\x0e.core.BaseResp\x12>\n" +
-	"\x13getPublicTenantList\x12\v.core.Empty\x1a\x1a.core.PublicTenantListResp\x122\n" +
+	"initTenant\x12\x13.core.TenantInitReq\x1a\x10.core.BaseIDResp\x12<\n" +
+	"\x14getTenantInitJobById\x12\v.core.IDReq\x1a\x17.core.TenantInitJobInfo\x12O\n" +
+	"\x14getTenantInitJobList\x12\x1a.core.TenantInitJobListReq\x1a\x1b.core.TenantInitJobListResp\x12C\n" +
+	"\x12retryTenantInitJob\x12\x1b.core.TenantInitJobRetryReq\x1a\x10.core.BaseIDResp\x12@\n" +
+	"\x0fdiagnoseTenants\x12\x15.core.TenantDoctorReq\x1a\x16.core.TenantDoctorResp\x12=\n" +
+	"\frepairTenant\x12\x15.core.TenantRepairReq\x1a\x16.core.TenantDoctorResp\x12Q\n" +
+	"\x12startImpersonation\x12\x1b.core.ImpersonationStartReq\x1a\x1e.core.ImpersonationSessionInfo\x12S\n" +
+	"\x13reviewImpersonation\x12\x1c.core.ImpersonationReviewReq\x1a\x1e.core.ImpersonationSessionInfo\x121\n" +
+	"\x10endImpersonation\x12\r.core.UUIDReq\x1a\x0e.core.BaseResp\x12G\n" +
+	"\x16getActiveImpersonation\x12\r.core.UUIDReq\x1a\x1e.core.ImpersonationSessionInfo\x12d\n" +
+	"\x1bgetImpersonationSessionList\x12!.core.ImpersonationSessionListReq\x1a\".core.ImpersonationSessionListResp\x12>\n" +
+	"\x13getPublicTenantList\x12\v.core.Empty\x1a\x1a.core.PublicTenantListResp\x12H\n" +
+	"\x18registerTenantInitPlugin\x12\x1a.core.TenantInitPluginInfo\x1a\x10.core.BaseIDResp\x12D\n" +
+	"\x16updateTenantInitPlugin\x12\x1a.core.TenantInitPluginInfo\x1a\x0e.core.BaseResp\x12X\n" +
+	"\x17getTenantInitPluginList\x12\x1d.core.TenantInitPluginListReq\x1a\x1e.core.TenantInitPluginListResp\x12B\n" +
+	"\x17getTenantInitPluginById\x12\v.core.IDReq\x1a\x1a.core.TenantInitPluginInfo\x126\n" +
+	"\x16deleteTenantInitPlugin\x12\f.core.IDsReq\x1a\x0e.core.BaseResp\x12J\n" +
+	"\x18createTenantInitTemplate\x12\x1c.core.TenantInitTemplateInfo\x1a\x10.core.BaseIDResp\x12H\n" +
+	"\x18updateTenantInitTemplate\x12\x1c.core.TenantInitTemplateInfo\x1a\x0e.core.BaseResp\x12^\n" +
+	"\x19getTenantInitTemplateList\x12\x1f.core.TenantInitTemplateListReq\x1a .core.TenantInitTemplateListResp\x12F\n" +
+	"\x19getTenantInitTemplateById\x12\v.core.IDReq\x1a\x1c.core.TenantInitTemplateInfo\x128\n" +
+	"\x18deleteTenantInitTemplate\x12\f.core.IDsReq\x1a\x0e.core.BaseResp\x12d\n" +
+	"\x19previewTenantInitTemplate\x12\".core.TenantInitTemplatePreviewReq\x1a#.core.TenantInitTemplatePreviewResp\x122\n" +
 	"\vcreateToken\x12\x0f.core.TokenInfo\x1a\x12.core.BaseUUIDResp\x12-\n" +
 	"\vdeleteToken\x12\x0e.core.UUIDsReq\x1a\x0e.core.BaseResp\x127\n" +
 	"\fgetTokenList\x12\x12.core.TokenListReq\x1a\x13.core.TokenListResp\x12.\n" +
 	"\fgetTokenById\x12\r.core.UUIDReq\x1a\x0f.core.TokenInfo\x122\n" +
 	"\x11blockUserAllToken\x12\r.core.UUIDReq\x1a\x0e.core.BaseResp\x12.\n" +
-	"\vupdateToken\x12\x0f.core.TokenInfo\x1a\x0e.core.BaseResp\x120\n" +
+	"\vupdateToken\x12\x0f.core.TokenInfo\x1a\x0e.core.BaseResp\x12C\n" +
+	"\x12getUserSessionList\x12\x18.core.UserSessionListReq\x1a\x13.core.TokenListResp\x12?\n" +
+	"\x11revokeUserSession\x12\x1a.core.UserSessionRevokeReq\x1a\x0e.core.BaseResp\x121\n" +
+	"\n" +
+	"touchToken\x12\x13.core.TokenTouchReq\x1a\x0e.core.BaseResp\x120\n" +
 	"\n" +
 	"createUser\x12\x0e.core.UserInfo\x1a\x12.core.BaseUUIDResp\x12,\n" +
 	"\n" +
@@ -9404,374 +18400,685 @@ func file_core_proto_rawDescGZIP() []byte {
 	return file_core_proto_rawDescData
 }
 
-var file_core_proto_msgTypes = make([]protoimpl.MessageInfo, 103)
+var file_core_proto_msgTypes = make([]protoimpl.MessageInfo, 209)
 var file_core_proto_goTypes = []any{
-	(*ApiInfo)(nil),                      // 0: core.ApiInfo
-	(*ApiListReq)(nil),                   // 1: core.ApiListReq
-	(*ApiListResp)(nil),                  // 2: core.ApiListResp
-	(*AuditLogInfo)(nil),                 // 3: core.AuditLogInfo
-	(*AuditLogListReq)(nil),              // 4: core.AuditLogListReq
-	(*AuditLogListResp)(nil),             // 5: core.AuditLogListResp
-	(*AuditLogStatsReq)(nil),             // 6: core.AuditLogStatsReq
-	(*AuditLogStatsResp)(nil),            // 7: core.AuditLogStatsResp
-	(*BaseIDResp)(nil),                   // 8: core.BaseIDResp
-	(*BaseMsg)(nil),                      // 9: core.BaseMsg
-	(*BaseResp)(nil),                     // 10: core.BaseResp
-	(*BaseUUIDResp)(nil),                 // 11: core.BaseUUIDResp
-	(*BatchCreateCasbinRulesReq)(nil),    // 12: core.BatchCreateCasbinRulesReq
-	(*BatchPermissionCheckReq)(nil),      // 13: core.BatchPermissionCheckReq
-	(*BatchPermissionCheckResp)(nil),     // 14: core.BatchPermissionCheckResp
-	(*BatchUpdateCasbinRulesReq)(nil),    // 15: core.BatchUpdateCasbinRulesReq
-	(*BindOauthAccountReq)(nil),          // 16: core.BindOauthAccountReq
-	(*CallbackReq)(nil),                  // 17: core.CallbackReq
-	(*CasbinRuleInfo)(nil),               // 18: core.CasbinRuleInfo
-	(*CasbinRuleListReq)(nil),            // 19: core.CasbinRuleListReq
-	(*CasbinRuleListResp)(nil),           // 20: core.CasbinRuleListResp
-	(*ConfigurationInfo)(nil),            // 21: core.ConfigurationInfo
-	(*ConfigurationListReq)(nil),         // 22: core.ConfigurationListReq
-	(*ConfigurationListResp)(nil),        // 23: core.ConfigurationListResp
-	(*CreateOauthSessionReq)(nil),        // 24: core.CreateOauthSessionReq
-	(*DepartmentInfo)(nil),               // 25: core.DepartmentInfo
-	(*DepartmentListReq)(nil),            // 26: core.DepartmentListReq
-	(*DepartmentListResp)(nil),           // 27: core.DepartmentListResp
-	(*DictionaryDetailInfo)(nil),         // 28: core.DictionaryDetailInfo
-	(*DictionaryDetailListReq)(nil),      // 29: core.DictionaryDetailListReq
-	(*DictionaryDetailListResp)(nil),     // 30: core.DictionaryDetailListResp
-	(*DictionaryInfo)(nil),               // 31: core.DictionaryInfo
-	(*DictionaryListReq)(nil),            // 32: core.DictionaryListReq
-	(*DictionaryListResp)(nil),           // 33: core.DictionaryListResp
-	(*DurationStats)(nil),                // 34: core.DurationStats
-	(*Empty)(nil),                        // 35: core.Empty
-	(*GetOauthSessionByStateReq)(nil),    // 36: core.GetOauthSessionByStateReq
-	(*GetUserOauthAccountsReq)(nil),      // 37: core.GetUserOauthAccountsReq
-	(*GetUserOauthAccountsResp)(nil),     // 38: core.GetUserOauthAccountsResp
-	(*GetUserPermissionSummaryReq)(nil),  // 39: core.GetUserPermissionSummaryReq
-	(*GetUserPermissionSummaryResp)(nil), // 40: core.GetUserPermissionSummaryResp
-	(*IDReq)(nil),                        // 41: core.IDReq
-	(*IDsReq)(nil),                       // 42: core.IDsReq
-	(*MenuInfo)(nil),                     // 43: core.MenuInfo
-	(*MenuInfoList)(nil),                 // 44: core.MenuInfoList
-	(*MenuRoleInfo)(nil),                 // 45: core.MenuRoleInfo
-	(*MenuRoleListResp)(nil),             // 46: core.MenuRoleListResp
-	(*Meta)(nil),                         // 47: core.Meta
-	(*OauthAccountInfo)(nil),             // 48: core.OauthAccountInfo
-	(*OauthAccountListReq)(nil),          // 49: core.OauthAccountListReq
-	(*OauthAccountListResp)(nil),         // 50: core.OauthAccountListResp
-	(*OauthLoginReq)(nil),                // 51: core.OauthLoginReq
-	(*OauthProviderInfo)(nil),            // 52: core.OauthProviderInfo
-	(*OauthProviderListReq)(nil),         // 53: core.OauthProviderListReq
-	(*OauthProviderListResp)(nil),        // 54: core.OauthProviderListResp
-	(*OauthRedirectResp)(nil),            // 55: core.OauthRedirectResp
-	(*OauthSessionInfo)(nil),             // 56: core.OauthSessionInfo
-	(*OperationTypeStats)(nil),           // 57: core.OperationTypeStats
-	(*PageInfoReq)(nil),                  // 58: core.PageInfoReq
-	(*PermissionCheckReq)(nil),           // 59: core.PermissionCheckReq
-	(*PermissionCheckResp)(nil),          // 60: core.PermissionCheckResp
-	(*PermissionSummary)(nil),            // 61: core.PermissionSummary
-	(*PositionInfo)(nil),                 // 62: core.PositionInfo
-	(*PositionListReq)(nil),              // 63: core.PositionListReq
-	(*PositionListResp)(nil),             // 64: core.PositionListResp
-	(*PublicTenantInfo)(nil),             // 65: core.PublicTenantInfo
-	(*PublicTenantListResp)(nil),         // 66: core.PublicTenantListResp
-	(*RefreshCasbinCacheReq)(nil),        // 67: core.RefreshCasbinCacheReq
-	(*RefreshCasbinCacheResp)(nil),       // 68: core.RefreshCasbinCacheResp
-	(*ResetPwdReq)(nil),                  // 69: core.ResetPwdReq
-	(*ResourceTypeStats)(nil),            // 70: core.ResourceTypeStats
-	(*RoleAuthReq)(nil),                  // 71: core.RoleAuthReq
-	(*RoleDataScopeReq)(nil),             // 72: core.RoleDataScopeReq
-	(*RoleInfo)(nil),                     // 73: core.RoleInfo
-	(*RoleListReq)(nil),                  // 74: core.RoleListReq
-	(*RoleListResp)(nil),                 // 75: core.RoleListResp
-	(*RoleMenuAuthorityReq)(nil),         // 76: core.RoleMenuAuthorityReq
-	(*RoleMenuAuthorityResp)(nil),        // 77: core.RoleMenuAuthorityResp
-	(*RoleStatusChangeParam)(nil),        // 78: core.RoleStatusChangeParam
-	(*RoleUnallocatedListReq)(nil),       // 79: core.RoleUnallocatedListReq
-	(*SyncCasbinRulesReq)(nil),           // 80: core.SyncCasbinRulesReq
-	(*SyncCasbinRulesResp)(nil),          // 81: core.SyncCasbinRulesResp
-	(*TenantCodeReq)(nil),                // 82: core.TenantCodeReq
-	(*TenantInfo)(nil),                   // 83: core.TenantInfo
-	(*TenantInitReq)(nil),                // 84: core.TenantInitReq
-	(*TenantListReq)(nil),                // 85: core.TenantListReq
-	(*TenantListResp)(nil),               // 86: core.TenantListResp
-	(*TenantStatusReq)(nil),              // 87: core.TenantStatusReq
-	(*TokenInfo)(nil),                    // 88: core.TokenInfo
-	(*TokenListReq)(nil),                 // 89: core.TokenListReq
-	(*TokenListResp)(nil),                // 90: core.TokenListResp
-	(*UUIDReq)(nil),                      // 91: core.UUIDReq
-	(*UUIDsReq)(nil),                     // 92: core.UUIDsReq
-	(*UnbindOauthAccountReq)(nil),        // 93: core.UnbindOauthAccountReq
-	(*UpdateOauthSessionReq)(nil),        // 94: core.UpdateOauthSessionReq
-	(*UserInfo)(nil),                     // 95: core.UserInfo
-	(*UserListReq)(nil),                  // 96: core.UserListReq
-	(*UserListResp)(nil),                 // 97: core.UserListResp
-	(*UsernameReq)(nil),                  // 98: core.UsernameReq
-	(*ValidateCasbinRuleReq)(nil),        // 99: core.ValidateCasbinRuleReq
-	(*ValidateCasbinRuleResp)(nil),       // 100: core.ValidateCasbinRuleResp
-	nil,                                  // 101: core.PermissionCheckReq.ContextEntry
-	nil,                                  // 102: core.PermissionCheckResp.DataFiltersEntry
+	(*ApiInfo)(nil),                        // 0: core.ApiInfo
+	(*ApiListReq)(nil),                     // 1: core.ApiListReq
+	(*ApiListResp)(nil),                    // 2: core.ApiListResp
+	(*ApiRegistrationItem)(nil),            // 3: core.ApiRegistrationItem
+	(*ApiRegistrationReq)(nil),             // 4: core.ApiRegistrationReq
+	(*ApiRegistrationResp)(nil),            // 5: core.ApiRegistrationResp
+	(*ApiStaleRule)(nil),                   // 6: core.ApiStaleRule
+	(*AuditLogArchiveInfo)(nil),            // 7: core.AuditLogArchiveInfo
+	(*AuditLogArchiveListReq)(nil),         // 8: core.AuditLogArchiveListReq
+	(*AuditLogArchiveListResp)(nil),        // 9: core.AuditLogArchiveListResp
+	(*AuditLogChainIssue)(nil),             // 10: core.AuditLogChainIssue
+	(*AuditLogInfo)(nil),                   // 11: core.AuditLogInfo
+	(*AuditLogListReq)(nil),                // 12: core.AuditLogListReq
+	(*AuditLogListResp)(nil),               // 13: core.AuditLogListResp
+	(*AuditLogStatsReq)(nil),               // 14: core.AuditLogStatsReq
+	(*AuditLogStatsResp)(nil),              // 15: core.AuditLogStatsResp
+	(*AuditLogVerifyResp)(nil),             // 16: core.AuditLogVerifyResp
+	(*BaseIDResp)(nil),                     // 17: core.BaseIDResp
+	(*BaseMsg)(nil),                        // 18: core.BaseMsg
+	(*BaseResp)(nil),                       // 19: core.BaseResp
+	(*BaseUUIDResp)(nil),                   // 20: core.BaseUUIDResp
+	(*BatchCreateCasbinRulesReq)(nil),      // 21: core.BatchCreateCasbinRulesReq
+	(*BatchPermissionCheckReq)(nil),        // 22: core.BatchPermissionCheckReq
+	(*BatchPermissionCheckResp)(nil),       // 23: core.BatchPermissionCheckResp
+	(*BatchUpdateCasbinRulesReq)(nil),      // 24: core.BatchUpdateCasbinRulesReq
+	(*BindOauthAccountReq)(nil),            // 25: core.BindOauthAccountReq
+	(*CallbackReq)(nil),                    // 26: core.CallbackReq
+	(*CasbinRuleInfo)(nil),                 // 27: core.CasbinRuleInfo
+	(*CasbinRuleListReq)(nil),              // 28: core.CasbinRuleListReq
+	(*CasbinRuleListResp)(nil),             // 29: core.CasbinRuleListResp
+	(*ConfigurationInfo)(nil),              // 30: core.ConfigurationInfo
+	(*ConfigurationListReq)(nil),           // 31: core.ConfigurationListReq
+	(*ConfigurationListResp)(nil),          // 32: core.ConfigurationListResp
+	(*CreateOauthSessionReq)(nil),          // 33: core.CreateOauthSessionReq
+	(*DepartmentInfo)(nil),                 // 34: core.DepartmentInfo
+	(*DepartmentListReq)(nil),              // 35: core.DepartmentListReq
+	(*DepartmentListResp)(nil),             // 36: core.DepartmentListResp
+	(*DictionaryDetailInfo)(nil),           // 37: core.DictionaryDetailInfo
+	(*DictionaryDetailListReq)(nil),        // 38: core.DictionaryDetailListReq
+	(*DictionaryDetailListResp)(nil),       // 39: core.DictionaryDetailListResp
+	(*DictionaryInfo)(nil),                 // 40: core.DictionaryInfo
+	(*DictionaryListReq)(nil),              // 41: core.DictionaryListReq
+	(*DictionaryListResp)(nil),             // 42: core.DictionaryListResp
+	(*DurationStats)(nil),                  // 43: core.DurationStats
+	(*Empty)(nil),                          // 44: core.Empty
+	(*EnableOauthProviderTemplateReq)(nil), // 45: core.EnableOauthProviderTemplateReq
+	(*GetOauthSessionByStateReq)(nil),      // 46: core.GetOauthSessionByStateReq
+	(*GetUserOauthAccountsReq)(nil),        // 47: core.GetUserOauthAccountsReq
+	(*GetUserOauthAccountsResp)(nil),       // 48: core.GetUserOauthAccountsResp
+	(*GetUserPermissionSummaryReq)(nil),    // 49: core.GetUserPermissionSummaryReq
+	(*GetUserPermissionSummaryResp)(nil),   // 50: core.GetUserPermissionSummaryResp
+	(*IDReq)(nil),                          // 51: core.IDReq
+	(*IDsReq)(nil),                         // 52: core.IDsReq
+	(*ImpersonationReviewReq)(nil),         // 53: core.ImpersonationReviewReq
+	(*ImpersonationSessionInfo)(nil),       // 54: core.ImpersonationSessionInfo
+	(*ImpersonationSessionListReq)(nil),    // 55: core.ImpersonationSessionListReq
+	(*ImpersonationSessionListResp)(nil),   // 56: core.ImpersonationSessionListResp
+	(*ImpersonationStartReq)(nil),          // 57: core.ImpersonationStartReq
+	(*LdapLoginReq)(nil),                   // 58: core.LdapLoginReq
+	(*LdapProviderInfo)(nil),               // 59: core.LdapProviderInfo
+	(*LdapProviderListReq)(nil),            // 60: core.LdapProviderListReq
+	(*LdapProviderListResp)(nil),           // 61: core.LdapProviderListResp
+	(*LdapSyncChange)(nil),                 // 62: core.LdapSyncChange
+	(*LdapSyncConflict)(nil),               // 63: core.LdapSyncConflict
+	(*LdapSyncCounter)(nil),                // 64: core.LdapSyncCounter
+	(*LdapSyncReq)(nil),                    // 65: core.LdapSyncReq
+	(*LdapSyncRunInfo)(nil),                // 66: core.LdapSyncRunInfo
+	(*LdapSyncRunListReq)(nil),             // 67: core.LdapSyncRunListReq
+	(*LdapSyncRunListResp)(nil),            // 68: core.LdapSyncRunListResp
+	(*LdapSyncStats)(nil),                  // 69: core.LdapSyncStats
+	(*MenuInfo)(nil),                       // 70: core.MenuInfo
+	(*MenuInfoList)(nil),                   // 71: core.MenuInfoList
+	(*MenuRoleInfo)(nil),                   // 72: core.MenuRoleInfo
+	(*MenuRoleListResp)(nil),               // 73: core.MenuRoleListResp
+	(*Meta)(nil),                           // 74: core.Meta
+	(*OauthAccessTokenReq)(nil),            // 75: core.OauthAccessTokenReq
+	(*OauthAccessTokenResp)(nil),           // 76: core.OauthAccessTokenResp
+	(*OauthAccountInfo)(nil),               // 77: core.OauthAccountInfo
+	(*OauthAccountListReq)(nil),            // 78: core.OauthAccountListReq
+	(*OauthAccountListResp)(nil),           // 79: core.OauthAccountListResp
+	(*OauthAuthorizeReq)(nil),              // 80: core.OauthAuthorizeReq
+	(*OauthAuthorizeResp)(nil),             // 81: core.OauthAuthorizeResp
+	(*OauthCallbackResp)(nil),              // 82: core.OauthCallbackResp
+	(*OauthClaimMappingPreviewReq)(nil),    // 83: core.OauthClaimMappingPreviewReq
+	(*OauthClaimMappingPreviewResp)(nil),   // 84: core.OauthClaimMappingPreviewResp
+	(*OauthClientAuthReq)(nil),             // 85: core.OauthClientAuthReq
+	(*OauthClientIdReq)(nil),               // 86: core.OauthClientIdReq
+	(*OauthClientInfo)(nil),                // 87: core.OauthClientInfo
+	(*OauthClientListReq)(nil),             // 88: core.OauthClientListReq
+	(*OauthClientListResp)(nil),            // 89: core.OauthClientListResp
+	(*OauthClientSecretResp)(nil),          // 90: core.OauthClientSecretResp
+	(*OauthCodeExchangeReq)(nil),           // 91: core.OauthCodeExchangeReq
+	(*OauthConsentInfo)(nil),               // 92: core.OauthConsentInfo
+	(*OauthConsentListReq)(nil),            // 93: core.OauthConsentListReq
+	(*OauthConsentListResp)(nil),           // 94: core.OauthConsentListResp
+	(*OauthGrantInfo)(nil),                 // 95: core.OauthGrantInfo
+	(*OauthLoginReq)(nil),                  // 96: core.OauthLoginReq
+	(*OauthProviderInfo)(nil),              // 97: core.OauthProviderInfo
+	(*OauthProviderListReq)(nil),           // 98: core.OauthProviderListReq
+	(*OauthProviderListResp)(nil),          // 99: core.OauthProviderListResp
+	(*OauthProviderTemplateInfo)(nil),      // 100: core.OauthProviderTemplateInfo
+	(*OauthProviderTemplateListReq)(nil),   // 101: core.OauthProviderTemplateListReq
+	(*OauthProviderTemplateListResp)(nil),  // 102: core.OauthProviderTemplateListResp
+	(*OauthRedirectResp)(nil),              // 103: core.OauthRedirectResp
+	(*OauthScopeInfo)(nil),                 // 104: core.OauthScopeInfo
+	(*OauthScopeListReq)(nil),              // 105: core.OauthScopeListReq
+	(*OauthScopeListResp)(nil),             // 106: core.OauthScopeListResp
+	(*OauthSessionInfo)(nil),               // 107: core.OauthSessionInfo
+	(*OauthWebhookReq)(nil),                // 108: core.OauthWebhookReq
+	(*OauthWebhookResp)(nil),               // 109: core.OauthWebhookResp
+	(*OperationTypeStats)(nil),             // 110: core.OperationTypeStats
+	(*PageInfoReq)(nil),                    // 111: core.PageInfoReq
+	(*PermissionCheckReq)(nil),             // 112: core.PermissionCheckReq
+	(*PermissionCheckResp)(nil),            // 113: core.PermissionCheckResp
+	(*PermissionSummary)(nil),              // 114: core.PermissionSummary
+	(*PolicyBundleChange)(nil),             // 115: core.PolicyBundleChange
+	(*PolicyBundleExportReq)(nil),          // 116: core.PolicyBundleExportReq
+	(*PolicyBundleImportReq)(nil),          // 117: core.PolicyBundleImportReq
+	(*PolicyBundleImportResp)(nil),         // 118: core.PolicyBundleImportResp
+	(*PolicyBundleResp)(nil),               // 119: core.PolicyBundleResp
+	(*PositionInfo)(nil),                   // 120: core.PositionInfo
+	(*PositionListReq)(nil),                // 121: core.PositionListReq
+	(*PositionListResp)(nil),               // 122: core.PositionListResp
+	(*PublicTenantInfo)(nil),               // 123: core.PublicTenantInfo
+	(*PublicTenantListResp)(nil),           // 124: core.PublicTenantListResp
+	(*RefreshCasbinCacheReq)(nil),          // 125: core.RefreshCasbinCacheReq
+	(*RefreshCasbinCacheResp)(nil),         // 126: core.RefreshCasbinCacheResp
+	(*ReplaceRolePoliciesReq)(nil),         // 127: core.ReplaceRolePoliciesReq
+	(*ReplaceRolePoliciesResp)(nil),        // 128: core.ReplaceRolePoliciesResp
+	(*ResetPwdReq)(nil),                    // 129: core.ResetPwdReq
+	(*ResourceTypeStats)(nil),              // 130: core.ResourceTypeStats
+	(*RoleAuthReq)(nil),                    // 131: core.RoleAuthReq
+	(*RoleDataScopeReq)(nil),               // 132: core.RoleDataScopeReq
+	(*RoleEffectiveApi)(nil),               // 133: core.RoleEffectiveApi
+	(*RoleEffectivePermissionsResp)(nil),   // 134: core.RoleEffectivePermissionsResp
+	(*RoleInfo)(nil),                       // 135: core.RoleInfo
+	(*RoleListReq)(nil),                    // 136: core.RoleListReq
+	(*RoleListResp)(nil),                   // 137: core.RoleListResp
+	(*RoleMenuAuthorityReq)(nil),           // 138: core.RoleMenuAuthorityReq
+	(*RoleMenuAuthorityResp)(nil),          // 139: core.RoleMenuAuthorityResp
+	(*RoleParentsReq)(nil),                 // 140: core.RoleParentsReq
+	(*RoleParentsResp)(nil),                // 141: core.RoleParentsResp
+	(*RolePolicyInfo)(nil),                 // 142: core.RolePolicyInfo
+	(*RolePolicyListResp)(nil),             // 143: core.RolePolicyListResp
+	(*RoleStatusChangeParam)(nil),          // 144: core.RoleStatusChangeParam
+	(*RoleUnallocatedListReq)(nil),         // 145: core.RoleUnallocatedListReq
+	(*SamlAcsReq)(nil),                     // 146: core.SamlAcsReq
+	(*SamlAcsResp)(nil),                    // 147: core.SamlAcsResp
+	(*SamlLoginReq)(nil),                   // 148: core.SamlLoginReq
+	(*SamlLoginResp)(nil),                  // 149: core.SamlLoginResp
+	(*SamlMetadataImportReq)(nil),          // 150: core.SamlMetadataImportReq
+	(*SamlProviderInfo)(nil),               // 151: core.SamlProviderInfo
+	(*SamlProviderListReq)(nil),            // 152: core.SamlProviderListReq
+	(*SamlProviderListResp)(nil),           // 153: core.SamlProviderListResp
+	(*SamlSpMetadataReq)(nil),              // 154: core.SamlSpMetadataReq
+	(*SamlSpMetadataResp)(nil),             // 155: core.SamlSpMetadataResp
+	(*ScimTokenAuthReq)(nil),               // 156: core.ScimTokenAuthReq
+	(*ScimTokenCreateResp)(nil),            // 157: core.ScimTokenCreateResp
+	(*ScimTokenInfo)(nil),                  // 158: core.ScimTokenInfo
+	(*ScimTokenListReq)(nil),               // 159: core.ScimTokenListReq
+	(*ScimTokenListResp)(nil),              // 160: core.ScimTokenListResp
+	(*SyncCasbinRulesReq)(nil),             // 161: core.SyncCasbinRulesReq
+	(*SyncCasbinRulesResp)(nil),            // 162: core.SyncCasbinRulesResp
+	(*TenantCodeReq)(nil),                  // 163: core.TenantCodeReq
+	(*TenantDoctorFinding)(nil),            // 164: core.TenantDoctorFinding
+	(*TenantDoctorReq)(nil),                // 165: core.TenantDoctorReq
+	(*TenantDoctorResp)(nil),               // 166: core.TenantDoctorResp
+	(*TenantInfo)(nil),                     // 167: core.TenantInfo
+	(*TenantInitJobInfo)(nil),              // 168: core.TenantInitJobInfo
+	(*TenantInitJobListReq)(nil),           // 169: core.TenantInitJobListReq
+	(*TenantInitJobListResp)(nil),          // 170: core.TenantInitJobListResp
+	(*TenantInitJobRetryReq)(nil),          // 171: core.TenantInitJobRetryReq
+	(*TenantInitPlanItem)(nil),             // 172: core.TenantInitPlanItem
+	(*TenantInitPluginInfo)(nil),           // 173: core.TenantInitPluginInfo
+	(*TenantInitPluginListReq)(nil),        // 174: core.TenantInitPluginListReq
+	(*TenantInitPluginListResp)(nil),       // 175: core.TenantInitPluginListResp
+	(*TenantInitPluginProgress)(nil),       // 176: core.TenantInitPluginProgress
+	(*TenantInitReq)(nil),                  // 177: core.TenantInitReq
+	(*TenantInitTemplateInfo)(nil),         // 178: core.TenantInitTemplateInfo
+	(*TenantInitTemplateListReq)(nil),      // 179: core.TenantInitTemplateListReq
+	(*TenantInitTemplateListResp)(nil),     // 180: core.TenantInitTemplateListResp
+	(*TenantInitTemplatePreviewReq)(nil),   // 181: core.TenantInitTemplatePreviewReq
+	(*TenantInitTemplatePreviewResp)(nil),  // 182: core.TenantInitTemplatePreviewResp
+	(*TenantListReq)(nil),                  // 183: core.TenantListReq
+	(*TenantListResp)(nil),                 // 184: core.TenantListResp
+	(*TenantPluginInitReq)(nil),            // 185: core.TenantPluginInitReq
+	(*TenantPluginStatusResp)(nil),         // 186: core.TenantPluginStatusResp
+	(*TenantPluginTenantReq)(nil),          // 187: core.TenantPluginTenantReq
+	(*TenantRepairReq)(nil),                // 188: core.TenantRepairReq
+	(*TenantStatusReq)(nil),                // 189: core.TenantStatusReq
+	(*TokenInfo)(nil),                      // 190: core.TokenInfo
+	(*TokenListReq)(nil),                   // 191: core.TokenListReq
+	(*TokenListResp)(nil),                  // 192: core.TokenListResp
+	(*TokenTouchReq)(nil),                  // 193: core.TokenTouchReq
+	(*UUIDReq)(nil),                        // 194: core.UUIDReq
+	(*UUIDsReq)(nil),                       // 195: core.UUIDsReq
+	(*UnbindOauthAccountReq)(nil),          // 196: core.UnbindOauthAccountReq
+	(*UpdateOauthSessionReq)(nil),          // 197: core.UpdateOauthSessionReq
+	(*UserInfo)(nil),                       // 198: core.UserInfo
+	(*UserListReq)(nil),                    // 199: core.UserListReq
+	(*UserListResp)(nil),                   // 200: core.UserListResp
+	(*UserSessionListReq)(nil),             // 201: core.UserSessionListReq
+	(*UserSessionRevokeReq)(nil),           // 202: core.UserSessionRevokeReq
+	(*UsernameReq)(nil),                    // 203: core.UsernameReq
+	(*ValidateCasbinRuleReq)(nil),          // 204: core.ValidateCasbinRuleReq
+	(*ValidateCasbinRuleResp)(nil),         // 205: core.ValidateCasbinRuleResp
+	nil,                                    // 206: core.OauthWebhookReq.HeadersEntry
+	nil,                                    // 207: core.PermissionCheckReq.ContextEntry
+	nil,                                    // 208: core.PermissionCheckResp.DataFiltersEntry
 }
 var file_core_proto_depIdxs = []int32{
 	0,   // 0: core.ApiListResp.data:type_name -> core.ApiInfo
-	3,   // 1: core.AuditLogListResp.data:type_name -> core.AuditLogInfo
-	57,  // 2: core.AuditLogStatsResp.operation_stats:type_name -> core.OperationTypeStats
-	70,  // 3: core.AuditLogStatsResp.resource_stats:type_name -> core.ResourceTypeStats
-	34,  // 4: core.AuditLogStatsResp.duration_stats:type_name -> core.DurationStats
-	18,  // 5: core.BatchCreateCasbinRulesReq.rules:type_name -> core.CasbinRuleInfo
-	59,  // 6: core.BatchPermissionCheckReq.requests:type_name -> core.PermissionCheckReq
-	60,  // 7: core.BatchPermissionCheckResp.responses:type_name -> core.PermissionCheckResp
-	18,  // 8: core.BatchUpdateCasbinRulesReq.rules:type_name -> core.CasbinRuleInfo
-	18,  // 9: core.CasbinRuleListResp.data:type_name -> core.CasbinRuleInfo
-	21,  // 10: core.ConfigurationListResp.data:type_name -> core.ConfigurationInfo
-	25,  // 11: core.DepartmentListResp.data:type_name -> core.DepartmentInfo
-	28,  // 12: core.DictionaryDetailListResp.data:type_name -> core.DictionaryDetailInfo
-	31,  // 13: core.DictionaryListResp.data:type_name -> core.DictionaryInfo
-	48,  // 14: core.GetUserOauthAccountsResp.data:type_name -> core.OauthAccountInfo
-	61,  // 15: core.GetUserPermissionSummaryResp.permissions:type_name -> core.PermissionSummary
-	47,  // 16: core.MenuInfo.meta:type_name -> core.Meta
-	43,  // 17: core.MenuInfoList.data:type_name -> core.MenuInfo
-	45,  // 18: core.MenuRoleListResp.data:type_name -> core.MenuRoleInfo
-	48,  // 19: core.OauthAccountListResp.data:type_name -> core.OauthAccountInfo
-	52,  // 20: core.OauthProviderListResp.data:type_name -> core.OauthProviderInfo
-	101, // 21: core.PermissionCheckReq.context:type_name -> core.PermissionCheckReq.ContextEntry
-	102, // 22: core.PermissionCheckResp.data_filters:type_name -> core.PermissionCheckResp.DataFiltersEntry
-	62,  // 23: core.PositionListResp.data:type_name -> core.PositionInfo
-	65,  // 24: core.PublicTenantListResp.vo_list:type_name -> core.PublicTenantInfo
-	73,  // 25: core.RoleListResp.data:type_name -> core.RoleInfo
-	83,  // 26: core.TenantListResp.data:type_name -> core.TenantInfo
-	88,  // 27: core.TokenListResp.data:type_name -> core.TokenInfo
-	95,  // 28: core.UserListResp.data:type_name -> core.UserInfo
-	18,  // 29: core.ValidateCasbinRuleReq.rule:type_name -> core.CasbinRuleInfo
-	0,   // 30: core.Core.createApi:input_type -> core.ApiInfo
-	0,   // 31: core.Core.updateApi:input_type -> core.ApiInfo
-	1,   // 32: core.Core.getApiList:input_type -> core.ApiListReq
-	41,  // 33: core.Core.getApiById:input_type -> core.IDReq
-	42,  // 34: core.Core.deleteApi:input_type -> core.IDsReq
-	3,   // 35: core.Core.createAuditLog:input_type -> core.AuditLogInfo
-	4,   // 36: core.Core.getAuditLogList:input_type -> core.AuditLogListReq
-	91,  // 37: core.Core.getAuditLogById:input_type -> core.UUIDReq
-	92,  // 38: core.Core.deleteAuditLog:input_type -> core.UUIDsReq
-	6,   // 39: core.Core.getAuditLogStats:input_type -> core.AuditLogStatsReq
-	41,  // 40: core.Core.getMenuAuthority:input_type -> core.IDReq
-	76,  // 41: core.Core.createOrUpdateMenuAuthority:input_type -> core.RoleMenuAuthorityReq
-	35,  // 42: core.Core.initDatabase:input_type -> core.Empty
-	18,  // 43: core.Core.createCasbinRule:input_type -> core.CasbinRuleInfo
-	18,  // 44: core.Core.updateCasbinRule:input_type -> core.CasbinRuleInfo
-	42,  // 45: core.Core.deleteCasbinRule:input_type -> core.IDsReq
-	19,  // 46: core.Core.getCasbinRuleList:input_type -> core.CasbinRuleListReq
-	41,  // 47: core.Core.getCasbinRuleById:input_type -> core.IDReq
-	12,  // 48: core.Core.batchCreateCasbinRules:input_type -> core.BatchCreateCasbinRulesReq
-	15,  // 49: core.Core.batchUpdateCasbinRules:input_type -> core.BatchUpdateCasbinRulesReq
-	42,  // 50: core.Core.batchDeleteCasbinRules:input_type -> core.IDsReq
-	59,  // 51: core.Core.checkPermission:input_type -> core.PermissionCheckReq
-	13,  // 52: core.Core.batchCheckPermission:input_type -> core.BatchPermissionCheckReq
-	39,  // 53: core.Core.getUserPermissionSummary:input_type -> core.GetUserPermissionSummaryReq
-	99,  // 54: core.Core.validateCasbinRule:input_type -> core.ValidateCasbinRuleReq
-	80,  // 55: core.Core.syncCasbinRules:input_type -> core.SyncCasbinRulesReq
-	67,  // 56: core.Core.refreshCasbinCache:input_type -> core.RefreshCasbinCacheReq
-	21,  // 57: core.Core.createConfiguration:input_type -> core.ConfigurationInfo
-	21,  // 58: core.Core.updateConfiguration:input_type -> core.ConfigurationInfo
-	22,  // 59: core.Core.getConfigurationList:input_type -> core.ConfigurationListReq
-	41,  // 60: core.Core.getConfigurationById:input_type -> core.IDReq
-	42,  // 61: core.Core.deleteConfiguration:input_type -> core.IDsReq
-	35,  // 62: core.Core.refreshConfigurationCache:input_type -> core.Empty
-	25,  // 63: core.Core.createDepartment:input_type -> core.DepartmentInfo
-	25,  // 64: core.Core.updateDepartment:input_type -> core.DepartmentInfo
-	26,  // 65: core.Core.getDepartmentList:input_type -> core.DepartmentListReq
-	41,  // 66: core.Core.getDepartmentById:input_type -> core.IDReq
-	42,  // 67: core.Core.deleteDepartment:input_type -> core.IDsReq
-	35,  // 68: core.Core.initDeptDataPermToRedis:input_type -> core.Empty
-	31,  // 69: core.Core.createDictionary:input_type -> core.DictionaryInfo
-	31,  // 70: core.Core.updateDictionary:input_type -> core.DictionaryInfo
-	32,  // 71: core.Core.getDictionaryList:input_type -> core.DictionaryListReq
-	41,  // 72: core.Core.getDictionaryById:input_type -> core.IDReq
-	42,  // 73: core.Core.deleteDictionary:input_type -> core.IDsReq
-	28,  // 74: core.Core.createDictionaryDetail:input_type -> core.DictionaryDetailInfo
-	28,  // 75: core.Core.updateDictionaryDetail:input_type -> core.DictionaryDetailInfo
-	29,  // 76: core.Core.getDictionaryDetailList:input_type -> core.DictionaryDetailListReq
-	41,  // 77: core.Core.getDictionaryDetailById:input_type -> core.IDReq
-	42,  // 78: core.Core.deleteDictionaryDetail:input_type -> core.IDsReq
-	9,   // 79: core.Core.getDictionaryDetailByDictionaryName:input_type -> core.BaseMsg
-	43,  // 80: core.Core.createMenu:input_type -> core.MenuInfo
-	43,  // 81: core.Core.updateMenu:input_type -> core.MenuInfo
-	41,  // 82: core.Core.deleteMenu:input_type -> core.IDReq
-	41,  // 83: core.Core.getMenu:input_type -> core.IDReq
-	9,   // 84: core.Core.getMenuListByRole:input_type -> core.BaseMsg
-	58,  // 85: core.Core.getMenuList:input_type -> core.PageInfoReq
-	52,  // 86: core.Core.createOauthProvider:input_type -> core.OauthProviderInfo
-	52,  // 87: core.Core.updateOauthProvider:input_type -> core.OauthProviderInfo
-	53,  // 88: core.Core.getOauthProviderList:input_type -> core.OauthProviderListReq
-	41,  // 89: core.Core.getOauthProviderById:input_type -> core.IDReq
-	42,  // 90: core.Core.deleteOauthProvider:input_type -> core.IDsReq
-	51,  // 91: core.Core.oauthLogin:input_type -> core.OauthLoginReq
-	17,  // 92: core.Core.oauthCallback:input_type -> core.CallbackReq
-	48,  // 93: core.Core.createOauthAccount:input_type -> core.OauthAccountInfo
-	48,  // 94: core.Core.updateOauthAccount:input_type -> core.OauthAccountInfo
-	49,  // 95: core.Core.getOauthAccountList:input_type -> core.OauthAccountListReq
-	41,  // 96: core.Core.getOauthAccountById:input_type -> core.IDReq
-	42,  // 97: core.Core.deleteOauthAccount:input_type -> core.IDsReq
-	16,  // 98: core.Core.bindOauthAccount:input_type -> core.BindOauthAccountReq
-	93,  // 99: core.Core.unbindOauthAccount:input_type -> core.UnbindOauthAccountReq
-	37,  // 100: core.Core.getUserOauthAccounts:input_type -> core.GetUserOauthAccountsReq
-	24,  // 101: core.Core.createOauthSession:input_type -> core.CreateOauthSessionReq
-	94,  // 102: core.Core.updateOauthSession:input_type -> core.UpdateOauthSessionReq
-	36,  // 103: core.Core.getOauthSessionByState:input_type -> core.GetOauthSessionByStateReq
-	41,  // 104: core.Core.deleteOauthSession:input_type -> core.IDReq
-	62,  // 105: core.Core.createPosition:input_type -> core.PositionInfo
-	62,  // 106: core.Core.updatePosition:input_type -> core.PositionInfo
-	63,  // 107: core.Core.getPositionList:input_type -> core.PositionListReq
-	41,  // 108: core.Core.getPositionById:input_type -> core.IDReq
-	42,  // 109: core.Core.deletePosition:input_type -> core.IDsReq
-	73,  // 110: core.Core.createRole:input_type -> core.RoleInfo
-	73,  // 111: core.Core.updateRole:input_type -> core.RoleInfo
-	74,  // 112: core.Core.getRoleList:input_type -> core.RoleListReq
-	41,  // 113: core.Core.getRoleById:input_type -> core.IDReq
-	42,  // 114: core.Core.deleteRole:input_type -> core.IDsReq
-	35,  // 115: core.Core.initRoleDataPermToRedis:input_type -> core.Empty
-	72,  // 116: core.Core.assignRoleDataScope:input_type -> core.RoleDataScopeReq
-	71,  // 117: core.Core.cancelAuth:input_type -> core.RoleAuthReq
-	71,  // 118: core.Core.addAuth:input_type -> core.RoleAuthReq
-	78,  // 119: core.Core.changeRoleStatus:input_type -> core.RoleStatusChangeParam
-	83,  // 120: core.Core.createTenant:input_type -> core.TenantInfo
-	83,  // 121: core.Core.updateTenant:input_type -> core.TenantInfo
-	85,  // 122: core.Core.getTenantList:input_type -> core.TenantListReq
-	41,  // 123: core.Core.getTenantById:input_type -> core.IDReq
-	82,  // 124: core.Core.getTenantByCode:input_type -> core.TenantCodeReq
-	42,  // 125: core.Core.deleteTenant:input_type -> core.IDsReq
-	87,  // 126: core.Core.updateTenantStatus:input_type -> core.TenantStatusReq
-	84,  // 127: core.Core.initTenant:input_type -> core.TenantInitReq
-	35,  // 128: core.Core.getPublicTenantList:input_type -> core.Empty
-	88,  // 129: core.Core.createToken:input_type -> core.TokenInfo
-	92,  // 130: core.Core.deleteToken:input_type -> core.UUIDsReq
-	89,  // 131: core.Core.getTokenList:input_type -> core.TokenListReq
-	91,  // 132: core.Core.getTokenById:input_type -> core.UUIDReq
-	91,  // 133: core.Core.blockUserAllToken:input_type -> core.UUIDReq
-	88,  // 134: core.Core.updateToken:input_type -> core.TokenInfo
-	95,  // 135: core.Core.createUser:input_type -> core.UserInfo
-	95,  // 136: core.Core.updateUser:input_type -> core.UserInfo
-	96,  // 137: core.Core.getUserList:input_type -> core.UserListReq
-	91,  // 138: core.Core.getUserById:input_type -> core.UUIDReq
-	98,  // 139: core.Core.getUserByUsername:input_type -> core.UsernameReq
-	92,  // 140: core.Core.deleteUser:input_type -> core.UUIDsReq
-	69,  // 141: core.Core.resetPwd:input_type -> core.ResetPwdReq
-	79,  // 142: core.Core.unallocatedList:input_type -> core.RoleUnallocatedListReq
-	8,   // 143: core.Core.createApi:output_type -> core.BaseIDResp
-	10,  // 144: core.Core.updateApi:output_type -> core.BaseResp
-	2,   // 145: core.Core.getApiList:output_type -> core.ApiListResp
-	0,   // 146: core.Core.getApiById:output_type -> core.ApiInfo
-	10,  // 147: core.Core.deleteApi:output_type -> core.BaseResp
-	11,  // 148: core.Core.createAuditLog:output_type -> core.BaseUUIDResp
-	5,   // 149: core.Core.getAuditLogList:output_type -> core.AuditLogListResp
-	3,   // 150: core.Core.getAuditLogById:output_type -> core.AuditLogInfo
-	10,  // 151: core.Core.deleteAuditLog:output_type -> core.BaseResp
-	7,   // 152: core.Core.getAuditLogStats:output_type -> core.AuditLogStatsResp
-	77,  // 153: core.Core.getMenuAuthority:output_type -> core.RoleMenuAuthorityResp
-	10,  // 154: core.Core.createOrUpdateMenuAuthority:output_type -> core.BaseResp
-	10,  // 155: core.Core.initDatabase:output_type -> core.BaseResp
-	8,   // 156: core.Core.createCasbinRule:output_type -> core.BaseIDResp
-	10,  // 157: core.Core.updateCasbinRule:output_type -> core.BaseResp
-	10,  // 158: core.Core.deleteCasbinRule:output_type -> core.BaseResp
-	20,  // 159: core.Core.getCasbinRuleList:output_type -> core.CasbinRuleListResp
-	18,  // 160: core.Core.getCasbinRuleById:output_type -> core.CasbinRuleInfo
-	10,  // 161: core.Core.batchCreateCasbinRules:output_type -> core.BaseResp
-	10,  // 162: core.Core.batchUpdateCasbinRules:output_type -> core.BaseResp
-	10,  // 163: core.Core.batchDeleteCasbinRules:output_type -> core.BaseResp
-	60,  // 164: core.Core.checkPermission:output_type -> core.PermissionCheckResp
-	14,  // 165: core.Core.batchCheckPermission:output_type -> core.BatchPermissionCheckResp
-	40,  // 166: core.Core.getUserPermissionSummary:output_type -> core.GetUserPermissionSummaryResp
-	100, // 167: core.Core.validateCasbinRule:output_type -> core.ValidateCasbinRuleResp
-	81,  // 168: core.Core.syncCasbinRules:output_type -> core.SyncCasbinRulesResp
-	68,  // 169: core.Core.refreshCasbinCache:output_type -> core.RefreshCasbinCacheResp
-	8,   // 170: core.Core.createConfiguration:output_type -> core.BaseIDResp
-	10,  // 171: core.Core.updateConfiguration:output_type -> core.BaseResp
-	23,  // 172: core.Core.getConfigurationList:output_type -> core.ConfigurationListResp
-	21,  // 173: core.Core.getConfigurationById:output_type -> core.ConfigurationInfo
-	10,  // 174: core.Core.deleteConfiguration:output_type -> core.BaseResp
-	10,  // 175: core.Core.refreshConfigurationCache:output_type -> core.BaseResp
-	8,   // 176: core.Core.createDepartment:output_type -> core.BaseIDResp
-	10,  // 177: core.Core.updateDepartment:output_type -> core.BaseResp
-	27,  // 178: core.Core.getDepartmentList:output_type -> core.DepartmentListResp
-	25,  // 179: core.Core.getDepartmentById:output_type -> core.DepartmentInfo
-	10,  // 180: core.Core.deleteDepartment:output_type -> core.BaseResp
-	10,  // 181: core.Core.initDeptDataPermToRedis:output_type -> core.BaseResp
-	8,   // 182: core.Core.createDictionary:output_type -> core.BaseIDResp
-	10,  // 183: core.Core.updateDictionary:output_type -> core.BaseResp
-	33,  // 184: core.Core.getDictionaryList:output_type -> core.DictionaryListResp
-	31,  // 185: core.Core.getDictionaryById:output_type -> core.DictionaryInfo
-	10,  // 186: core.Core.deleteDictionary:output_type -> core.BaseResp
-	8,   // 187: core.Core.createDictionaryDetail:output_type -> core.BaseIDResp
-	10,  // 188: core.Core.updateDictionaryDetail:output_type -> core.BaseResp
-	30,  // 189: core.Core.getDictionaryDetailList:output_type -> core.DictionaryDetailListResp
-	28,  // 190: core.Core.getDictionaryDetailById:output_type -> core.DictionaryDetailInfo
-	10,  // 191: core.Core.deleteDictionaryDetail:output_type -> core.BaseResp
-	30,  // 192: core.Core.getDictionaryDetailByDictionaryName:output_type -> core.DictionaryDetailListResp
-	8,   // 193: core.Core.createMenu:output_type -> core.BaseIDResp
-	10,  // 194: core.Core.updateMenu:output_type -> core.BaseResp
-	10,  // 195: core.Core.deleteMenu:output_type -> core.BaseResp
-	43,  // 196: core.Core.getMenu:output_type -> core.MenuInfo
-	44,  // 197: core.Core.getMenuListByRole:output_type -> core.MenuInfoList
-	44,  // 198: core.Core.getMenuList:output_type -> core.MenuInfoList
-	8,   // 199: core.Core.createOauthProvider:output_type -> core.BaseIDResp
-	10,  // 200: core.Core.updateOauthProvider:output_type -> core.BaseResp
-	54,  // 201: core.Core.getOauthProviderList:output_type -> core.OauthProviderListResp
-	52,  // 202: core.Core.getOauthProviderById:output_type -> core.OauthProviderInfo
-	10,  // 203: core.Core.deleteOauthProvider:output_type -> core.BaseResp
-	55,  // 204: core.Core.oauthLogin:output_type -> core.OauthRedirectResp
-	95,  // 205: core.Core.oauthCallback:output_type -> core.UserInfo
-	8,   // 206: core.Core.createOauthAccount:output_type -> core.BaseIDResp
-	10,  // 207: core.Core.updateOauthAccount:output_type -> core.BaseResp
-	50,  // 208: core.Core.getOauthAccountList:output_type -> core.OauthAccountListResp
-	48,  // 209: core.Core.getOauthAccountById:output_type -> core.OauthAccountInfo
-	10,  // 210: core.Core.deleteOauthAccount:output_type -> core.BaseResp
-	10,  // 211: core.Core.bindOauthAccount:output_type -> core.BaseResp
-	10,  // 212: core.Core.unbindOauthAccount:output_type -> core.BaseResp
-	38,  // 213: core.Core.getUserOauthAccounts:output_type -> core.GetUserOauthAccountsResp
-	8,   // 214: core.Core.createOauthSession:output_type -> core.BaseIDResp
-	10,  // 215: core.Core.updateOauthSession:output_type -> core.BaseResp
-	56,  // 216: core.Core.getOauthSessionByState:output_type -> core.OauthSessionInfo
-	10,  // 217: core.Core.deleteOauthSession:output_type -> core.BaseResp
-	8,   // 218: core.Core.createPosition:output_type -> core.BaseIDResp
-	10,  // 219: core.Core.updatePosition:output_type -> core.BaseResp
-	64,  // 220: core.Core.getPositionList:output_type -> core.PositionListResp
-	62,  // 221: core.Core.getPositionById:output_type -> core.PositionInfo
-	10,  // 222: core.Core.deletePosition:output_type -> core.BaseResp
-	8,   // 223: core.Core.createRole:output_type -> core.BaseIDResp
-	10,  // 224: core.Core.updateRole:output_type -> core.BaseResp
-	75,  // 225: core.Core.getRoleList:output_type -> core.RoleListResp
-	73,  // 226: core.Core.getRoleById:output_type -> core.RoleInfo
-	10,  // 227: core.Core.deleteRole:output_type -> core.BaseResp
-	10,  // 228: core.Core.initRoleDataPermToRedis:output_type -> core.BaseResp
-	10,  // 229: core.Core.assignRoleDataScope:output_type -> core.BaseResp
-	10,  // 230: core.Core.cancelAuth:output_type -> core.BaseResp
-	10,  // 231: core.Core.addAuth:output_type -> core.BaseResp
-	10,  // 232: core.Core.changeRoleStatus:output_type -> core.BaseResp
-	8,   // 233: core.Core.createTenant:output_type -> core.BaseIDResp
-	10,  // 234: core.Core.updateTenant:output_type -> core.BaseResp
-	86,  // 235: core.Core.getTenantList:output_type -> core.TenantListResp
-	83,  // 236: core.Core.getTenantById:output_type -> core.TenantInfo
-	83,  // 237: core.Core.getTenantByCode:output_type -> core.TenantInfo
-	10,  // 238: core.Core.deleteTenant:output_type -> core.BaseResp
-	10,  // 239: core.Core.updateTenantStatus:output_type -> core.BaseResp
-	10,  // 240: core.Core.initTenant:output_type -> core.BaseResp
-	66,  // 241: core.Core.getPublicTenantList:output_type -> core.PublicTenantListResp
-	11,  // 242: core.Core.createToken:output_type -> core.BaseUUIDResp
-	10,  // 243: core.Core.deleteToken:output_type -> core.BaseResp
-	90,  // 244: core.Core.getTokenList:output_type -> core.TokenListResp
-	88,  // 245: core.Core.getTokenById:output_type -> core.TokenInfo
-	10,  // 246: core.Core.blockUserAllToken:output_type -> core.BaseResp
-	10,  // 247: core.Core.updateToken:output_type -> core.BaseResp
-	11,  // 248: core.Core.createUser:output_type -> core.BaseUUIDResp
-	10,  // 249: core.Core.updateUser:output_type -> core.BaseResp
-	97,  // 250: core.Core.getUserList:output_type -> core.UserListResp
-	95,  // 251: core.Core.getUserById:output_type -> core.UserInfo
-	95,  // 252: core.Core.getUserByUsername:output_type -> core.UserInfo
-	10,  // 253: core.Core.deleteUser:output_type -> core.BaseResp
-	10,  // 254: core.Core.resetPwd:output_type -> core.BaseResp
-	97,  // 255: core.Core.unallocatedList:output_type -> core.UserListResp
-	143, // [143:256] is the sub-list for method output_type
-	30,  // [30:143] is the sub-list for method input_type
-	30,  // [30:30] is the sub-list for extension type_name
-	30,  // [30:30] is the sub-list for extension extendee
-	0,   // [0:30] is the sub-list for field type_name
+	3,   // 1: core.ApiRegistrationReq.apis:type_name -> core.ApiRegistrationItem
+	6,   // 2: core.ApiRegistrationResp.stale_rules:type_name -> core.ApiStaleRule
+	7,   // 3: core.AuditLogArchiveListResp.data:type_name -> core.AuditLogArchiveInfo
+	11,  // 4: core.AuditLogListResp.data:type_name -> core.AuditLogInfo
+	110, // 5: core.AuditLogStatsResp.operation_stats:type_name -> core.OperationTypeStats
+	130, // 6: core.AuditLogStatsResp.resource_stats:type_name -> core.ResourceTypeStats
+	43,  // 7: core.AuditLogStatsResp.duration_stats:type_name -> core.DurationStats
+	10,  // 8: core.AuditLogVerifyResp.issues:type_name -> core.AuditLogChainIssue
+	27,  // 9: core.BatchCreateCasbinRulesReq.rules:type_name -> core.CasbinRuleInfo
+	112, // 10: core.BatchPermissionCheckReq.requests:type_name -> core.PermissionCheckReq
+	113, // 11: core.BatchPermissionCheckResp.responses:type_name -> core.PermissionCheckResp
+	27,  // 12: core.BatchUpdateCasbinRulesReq.rules:type_name -> core.CasbinRuleInfo
+	27,  // 13: core.CasbinRuleListResp.data:type_name -> core.CasbinRuleInfo
+	30,  // 14: core.ConfigurationListResp.data:type_name -> core.ConfigurationInfo
+	34,  // 15: core.DepartmentListResp.data:type_name -> core.DepartmentInfo
+	37,  // 16: core.DictionaryDetailListResp.data:type_name -> core.DictionaryDetailInfo
+	40,  // 17: core.DictionaryListResp.data:type_name -> core.DictionaryInfo
+	77,  // 18: core.GetUserOauthAccountsResp.data:type_name -> core.OauthAccountInfo
+	114, // 19: core.GetUserPermissionSummaryResp.permissions:type_name -> core.PermissionSummary
+	54,  // 20: core.ImpersonationSessionListResp.data:type_name -> core.ImpersonationSessionInfo
+	59,  // 21: core.LdapProviderListResp.data:type_name -> core.LdapProviderInfo
+	69,  // 22: core.LdapSyncRunInfo.stats:type_name -> core.LdapSyncStats
+	62,  // 23: core.LdapSyncRunInfo.changes:type_name -> core.LdapSyncChange
+	63,  // 24: core.LdapSyncRunInfo.conflicts:type_name -> core.LdapSyncConflict
+	66,  // 25: core.LdapSyncRunListResp.data:type_name -> core.LdapSyncRunInfo
+	64,  // 26: core.LdapSyncStats.departments:type_name -> core.LdapSyncCounter
+	64,  // 27: core.LdapSyncStats.users:type_name -> core.LdapSyncCounter
+	74,  // 28: core.MenuInfo.meta:type_name -> core.Meta
+	70,  // 29: core.MenuInfoList.data:type_name -> core.MenuInfo
+	72,  // 30: core.MenuRoleListResp.data:type_name -> core.MenuRoleInfo
+	77,  // 31: core.OauthAccountListResp.data:type_name -> core.OauthAccountInfo
+	104, // 32: core.OauthAuthorizeResp.scopes:type_name -> core.OauthScopeInfo
+	198, // 33: core.OauthCallbackResp.user:type_name -> core.UserInfo
+	87,  // 34: core.OauthClientListResp.data:type_name -> core.OauthClientInfo
+	92,  // 35: core.OauthConsentListResp.data:type_name -> core.OauthConsentInfo
+	97,  // 36: core.OauthProviderListResp.data:type_name -> core.OauthProviderInfo
+	100, // 37: core.OauthProviderTemplateListResp.data:type_name -> core.OauthProviderTemplateInfo
+	104, // 38: core.OauthScopeListResp.data:type_name -> core.OauthScopeInfo
+	206, // 39: core.OauthWebhookReq.headers:type_name -> core.OauthWebhookReq.HeadersEntry
+	207, // 40: core.PermissionCheckReq.context:type_name -> core.PermissionCheckReq.ContextEntry
+	208, // 41: core.PermissionCheckResp.data_filters:type_name -> core.PermissionCheckResp.DataFiltersEntry
+	115, // 42: core.PolicyBundleImportResp.data:type_name -> core.PolicyBundleChange
+	120, // 43: core.PositionListResp.data:type_name -> core.PositionInfo
+	123, // 44: core.PublicTenantListResp.vo_list:type_name -> core.PublicTenantInfo
+	142, // 45: core.ReplaceRolePoliciesReq.data:type_name -> core.RolePolicyInfo
+	142, // 46: core.ReplaceRolePoliciesResp.added:type_name -> core.RolePolicyInfo
+	142, // 47: core.ReplaceRolePoliciesResp.removed:type_name -> core.RolePolicyInfo
+	133, // 48: core.RoleEffectivePermissionsResp.apis:type_name -> core.RoleEffectiveApi
+	135, // 49: core.RoleListResp.data:type_name -> core.RoleInfo
+	135, // 50: core.RoleParentsResp.data:type_name -> core.RoleInfo
+	142, // 51: core.RolePolicyListResp.data:type_name -> core.RolePolicyInfo
+	198, // 52: core.SamlAcsResp.user:type_name -> core.UserInfo
+	151, // 53: core.SamlProviderListResp.data:type_name -> core.SamlProviderInfo
+	158, // 54: core.ScimTokenListResp.data:type_name -> core.ScimTokenInfo
+	164, // 55: core.TenantDoctorResp.data:type_name -> core.TenantDoctorFinding
+	176, // 56: core.TenantInitJobInfo.plugins:type_name -> core.TenantInitPluginProgress
+	172, // 57: core.TenantInitJobInfo.plan:type_name -> core.TenantInitPlanItem
+	168, // 58: core.TenantInitJobListResp.data:type_name -> core.TenantInitJobInfo
+	173, // 59: core.TenantInitPluginListResp.data:type_name -> core.TenantInitPluginInfo
+	178, // 60: core.TenantInitTemplateListResp.data:type_name -> core.TenantInitTemplateInfo
+	167, // 61: core.TenantListResp.data:type_name -> core.TenantInfo
+	190, // 62: core.TokenListResp.data:type_name -> core.TokenInfo
+	198, // 63: core.UserListResp.data:type_name -> core.UserInfo
+	27,  // 64: core.ValidateCasbinRuleReq.rule:type_name -> core.CasbinRuleInfo
+	0,   // 65: core.Core.createApi:input_type -> core.ApiInfo
+	0,   // 66: core.Core.updateApi:input_type -> core.ApiInfo
+	1,   // 67: core.Core.getApiList:input_type -> core.ApiListReq
+	51,  // 68: core.Core.getApiById:input_type -> core.IDReq
+	52,  // 69: core.Core.deleteApi:input_type -> core.IDsReq
+	4,   // 70: core.Core.apiRegistration:input_type -> core.ApiRegistrationReq
+	11,  // 71: core.Core.createAuditLog:input_type -> core.AuditLogInfo
+	12,  // 72: core.Core.getAuditLogList:input_type -> core.AuditLogListReq
+	194, // 73: core.Core.getAuditLogById:input_type -> core.UUIDReq
+	14,  // 74: core.Core.getAuditLogStats:input_type -> core.AuditLogStatsReq
+	44,  // 75: core.Core.verifyAuditLogChain:input_type -> core.Empty
+	8,   // 76: core.Core.getAuditLogArchiveList:input_type -> core.AuditLogArchiveListReq
+	12,  // 77: core.Core.restoreAuditLogRange:input_type -> core.AuditLogListReq
+	51,  // 78: core.Core.getMenuAuthority:input_type -> core.IDReq
+	138, // 79: core.Core.createOrUpdateMenuAuthority:input_type -> core.RoleMenuAuthorityReq
+	51,  // 80: core.Core.getRolePolicies:input_type -> core.IDReq
+	127, // 81: core.Core.replaceRolePolicies:input_type -> core.ReplaceRolePoliciesReq
+	116, // 82: core.Core.exportPolicyBundle:input_type -> core.PolicyBundleExportReq
+	117, // 83: core.Core.importPolicyBundle:input_type -> core.PolicyBundleImportReq
+	44,  // 84: core.Core.initDatabase:input_type -> core.Empty
+	27,  // 85: core.Core.createCasbinRule:input_type -> core.CasbinRuleInfo
+	27,  // 86: core.Core.updateCasbinRule:input_type -> core.CasbinRuleInfo
+	52,  // 87: core.Core.deleteCasbinRule:input_type -> core.IDsReq
+	28,  // 88: core.Core.getCasbinRuleList:input_type -> core.CasbinRuleListReq
+	51,  // 89: core.Core.getCasbinRuleById:input_type -> core.IDReq
+	21,  // 90: core.Core.batchCreateCasbinRules:input_type -> core.BatchCreateCasbinRulesReq
+	24,  // 91: core.Core.batchUpdateCasbinRules:input_type -> core.BatchUpdateCasbinRulesReq
+	52,  // 92: core.Core.batchDeleteCasbinRules:input_type -> core.IDsReq
+	112, // 93: core.Core.checkPermission:input_type -> core.PermissionCheckReq
+	22,  // 94: core.Core.batchCheckPermission:input_type -> core.BatchPermissionCheckReq
+	49,  // 95: core.Core.getUserPermissionSummary:input_type -> core.GetUserPermissionSummaryReq
+	204, // 96: core.Core.validateCasbinRule:input_type -> core.ValidateCasbinRuleReq
+	161, // 97: core.Core.syncCasbinRules:input_type -> core.SyncCasbinRulesReq
+	125, // 98: core.Core.refreshCasbinCache:input_type -> core.RefreshCasbinCacheReq
+	30,  // 99: core.Core.createConfiguration:input_type -> core.ConfigurationInfo
+	30,  // 100: core.Core.updateConfiguration:input_type -> core.ConfigurationInfo
+	31,  // 101: core.Core.getConfigurationList:input_type -> core.ConfigurationListReq
+	51,  // 102: core.Core.getConfigurationById:input_type -> core.IDReq
+	52,  // 103: core.Core.deleteConfiguration:input_type -> core.IDsReq
+	44,  // 104: core.Core.refreshConfigurationCache:input_type -> core.Empty
+	34,  // 105: core.Core.createDepartment:input_type -> core.DepartmentInfo
+	34,  // 106: core.Core.updateDepartment:input_type -> core.DepartmentInfo
+	35,  // 107: core.Core.getDepartmentList:input_type -> core.DepartmentListReq
+	51,  // 108: core.Core.getDepartmentById:input_type -> core.IDReq
+	52,  // 109: core.Core.deleteDepartment:input_type -> core.IDsReq
+	44,  // 110: core.Core.initDeptDataPermToRedis:input_type -> core.Empty
+	40,  // 111: core.Core.createDictionary:input_type -> core.DictionaryInfo
+	40,  // 112: core.Core.updateDictionary:input_type -> core.DictionaryInfo
+	41,  // 113: core.Core.getDictionaryList:input_type -> core.DictionaryListReq
+	51,  // 114: core.Core.getDictionaryById:input_type -> core.IDReq
+	52,  // 115: core.Core.deleteDictionary:input_type -> core.IDsReq
+	37,  // 116: core.Core.createDictionaryDetail:input_type -> core.DictionaryDetailInfo
+	37,  // 117: core.Core.updateDictionaryDetail:input_type -> core.DictionaryDetailInfo
+	38,  // 118: core.Core.getDictionaryDetailList:input_type -> core.DictionaryDetailListReq
+	51,  // 119: core.Core.getDictionaryDetailById:input_type -> core.IDReq
+	52,  // 120: core.Core.deleteDictionaryDetail:input_type -> core.IDsReq
+	18,  // 121: core.Core.getDictionaryDetailByDictionaryName:input_type -> core.BaseMsg
+	59,  // 122: core.Core.createLdapProvider:input_type -> core.LdapProviderInfo
+	59,  // 123: core.Core.updateLdapProvider:input_type -> core.LdapProviderInfo
+	60,  // 124: core.Core.getLdapProviderList:input_type -> core.LdapProviderListReq
+	51,  // 125: core.Core.getLdapProviderById:input_type -> core.IDReq
+	52,  // 126: core.Core.deleteLdapProvider:input_type -> core.IDsReq
+	58,  // 127: core.Core.ldapLogin:input_type -> core.LdapLoginReq
+	65,  // 128: core.Core.syncLdapProvider:input_type -> core.LdapSyncReq
+	67,  // 129: core.Core.getLdapSyncRunList:input_type -> core.LdapSyncRunListReq
+	51,  // 130: core.Core.getLdapSyncRunById:input_type -> core.IDReq
+	70,  // 131: core.Core.createMenu:input_type -> core.MenuInfo
+	70,  // 132: core.Core.updateMenu:input_type -> core.MenuInfo
+	51,  // 133: core.Core.deleteMenu:input_type -> core.IDReq
+	51,  // 134: core.Core.getMenu:input_type -> core.IDReq
+	18,  // 135: core.Core.getMenuListByRole:input_type -> core.BaseMsg
+	111, // 136: core.Core.getMenuList:input_type -> core.PageInfoReq
+	87,  // 137: core.Core.createOauthClient:input_type -> core.OauthClientInfo
+	87,  // 138: core.Core.updateOauthClient:input_type -> core.OauthClientInfo
+	88,  // 139: core.Core.getOauthClientList:input_type -> core.OauthClientListReq
+	51,  // 140: core.Core.getOauthClientById:input_type -> core.IDReq
+	52,  // 141: core.Core.deleteOauthClient:input_type -> core.IDsReq
+	51,  // 142: core.Core.resetOauthClientSecret:input_type -> core.IDReq
+	86,  // 143: core.Core.getOauthClientByClientId:input_type -> core.OauthClientIdReq
+	85,  // 144: core.Core.authenticateOauthClient:input_type -> core.OauthClientAuthReq
+	80,  // 145: core.Core.authorizeOauthClient:input_type -> core.OauthAuthorizeReq
+	91,  // 146: core.Core.exchangeOauthAuthorizationCode:input_type -> core.OauthCodeExchangeReq
+	93,  // 147: core.Core.getOauthConsentList:input_type -> core.OauthConsentListReq
+	52,  // 148: core.Core.deleteOauthConsent:input_type -> core.IDsReq
+	97,  // 149: core.Core.createOauthProvider:input_type -> core.OauthProviderInfo
+	97,  // 150: core.Core.updateOauthProvider:input_type -> core.OauthProviderInfo
+	98,  // 151: core.Core.getOauthProviderList:input_type -> core.OauthProviderListReq
+	51,  // 152: core.Core.getOauthProviderById:input_type -> core.IDReq
+	52,  // 153: core.Core.deleteOauthProvider:input_type -> core.IDsReq
+	96,  // 154: core.Core.oauthLogin:input_type -> core.OauthLoginReq
+	26,  // 155: core.Core.oauthCallback:input_type -> core.CallbackReq
+	83,  // 156: core.Core.previewOauthClaimMapping:input_type -> core.OauthClaimMappingPreviewReq
+	108, // 157: core.Core.oauthWebhook:input_type -> core.OauthWebhookReq
+	77,  // 158: core.Core.createOauthAccount:input_type -> core.OauthAccountInfo
+	77,  // 159: core.Core.updateOauthAccount:input_type -> core.OauthAccountInfo
+	78,  // 160: core.Core.getOauthAccountList:input_type -> core.OauthAccountListReq
+	51,  // 161: core.Core.getOauthAccountById:input_type -> core.IDReq
+	52,  // 162: core.Core.deleteOauthAccount:input_type -> core.IDsReq
+	25,  // 163: core.Core.bindOauthAccount:input_type -> core.BindOauthAccountReq
+	196, // 164: core.Core.unbindOauthAccount:input_type -> core.UnbindOauthAccountReq
+	47,  // 165: core.Core.getUserOauthAccounts:input_type -> core.GetUserOauthAccountsReq
+	75,  // 166: core.Core.getOauthAccessToken:input_type -> core.OauthAccessTokenReq
+	33,  // 167: core.Core.createOauthSession:input_type -> core.CreateOauthSessionReq
+	197, // 168: core.Core.updateOauthSession:input_type -> core.UpdateOauthSessionReq
+	46,  // 169: core.Core.getOauthSessionByState:input_type -> core.GetOauthSessionByStateReq
+	51,  // 170: core.Core.deleteOauthSession:input_type -> core.IDReq
+	100, // 171: core.Core.createOauthProviderTemplate:input_type -> core.OauthProviderTemplateInfo
+	100, // 172: core.Core.updateOauthProviderTemplate:input_type -> core.OauthProviderTemplateInfo
+	101, // 173: core.Core.getOauthProviderTemplateList:input_type -> core.OauthProviderTemplateListReq
+	51,  // 174: core.Core.getOauthProviderTemplateById:input_type -> core.IDReq
+	52,  // 175: core.Core.deleteOauthProviderTemplate:input_type -> core.IDsReq
+	45,  // 176: core.Core.enableOauthProviderTemplate:input_type -> core.EnableOauthProviderTemplateReq
+	104, // 177: core.Core.createOauthScope:input_type -> core.OauthScopeInfo
+	104, // 178: core.Core.updateOauthScope:input_type -> core.OauthScopeInfo
+	105, // 179: core.Core.getOauthScopeList:input_type -> core.OauthScopeListReq
+	51,  // 180: core.Core.getOauthScopeById:input_type -> core.IDReq
+	52,  // 181: core.Core.deleteOauthScope:input_type -> core.IDsReq
+	120, // 182: core.Core.createPosition:input_type -> core.PositionInfo
+	120, // 183: core.Core.updatePosition:input_type -> core.PositionInfo
+	121, // 184: core.Core.getPositionList:input_type -> core.PositionListReq
+	51,  // 185: core.Core.getPositionById:input_type -> core.IDReq
+	52,  // 186: core.Core.deletePosition:input_type -> core.IDsReq
+	135, // 187: core.Core.createRole:input_type -> core.RoleInfo
+	135, // 188: core.Core.updateRole:input_type -> core.RoleInfo
+	136, // 189: core.Core.getRoleList:input_type -> core.RoleListReq
+	51,  // 190: core.Core.getRoleById:input_type -> core.IDReq
+	52,  // 191: core.Core.deleteRole:input_type -> core.IDsReq
+	51,  // 192: core.Core.deleteRoleWithUsers:input_type -> core.IDReq
+	44,  // 193: core.Core.initRoleDataPermToRedis:input_type -> core.Empty
+	132, // 194: core.Core.assignRoleDataScope:input_type -> core.RoleDataScopeReq
+	131, // 195: core.Core.cancelAuth:input_type -> core.RoleAuthReq
+	131, // 196: core.Core.addAuth:input_type -> core.RoleAuthReq
+	144, // 197: core.Core.changeRoleStatus:input_type -> core.RoleStatusChangeParam
+	140, // 198: core.Core.setRoleParents:input_type -> core.RoleParentsReq
+	51,  // 199: core.Core.getRoleParents:input_type -> core.IDReq
+	51,  // 200: core.Core.getRoleEffectivePermissions:input_type -> core.IDReq
+	151, // 201: core.Core.createSamlProvider:input_type -> core.SamlProviderInfo
+	151, // 202: core.Core.updateSamlProvider:input_type -> core.SamlProviderInfo
+	152, // 203: core.Core.getSamlProviderList:input_type -> core.SamlProviderListReq
+	51,  // 204: core.Core.getSamlProviderById:input_type -> core.IDReq
+	52,  // 205: core.Core.deleteSamlProvider:input_type -> core.IDsReq
+	150, // 206: core.Core.importSamlIdpMetadata:input_type -> core.SamlMetadataImportReq
+	154, // 207: core.Core.getSamlSpMetadata:input_type -> core.SamlSpMetadataReq
+	148, // 208: core.Core.samlLogin:input_type -> core.SamlLoginReq
+	146, // 209: core.Core.samlAcs:input_type -> core.SamlAcsReq
+	158, // 210: core.Core.createScimToken:input_type -> core.ScimTokenInfo
+	158, // 211: core.Core.updateScimToken:input_type -> core.ScimTokenInfo
+	159, // 212: core.Core.getScimTokenList:input_type -> core.ScimTokenListReq
+	51,  // 213: core.Core.getScimTokenById:input_type -> core.IDReq
+	52,  // 214: core.Core.deleteScimToken:input_type -> core.IDsReq
+	156, // 215: core.Core.authenticateScimToken:input_type -> core.ScimTokenAuthReq
+	167, // 216: core.Core.createTenant:input_type -> core.TenantInfo
+	167, // 217: core.Core.updateTenant:input_type -> core.TenantInfo
+	183, // 218: core.Core.getTenantList:input_type -> core.TenantListReq
+	51,  // 219: core.Core.getTenantById:input_type -> core.IDReq
+	163, // 220: core.Core.getTenantByCode:input_type -> core.TenantCodeReq
+	52,  // 221: core.Core.deleteTenant:input_type -> core.IDsReq
+	189, // 222: core.Core.updateTenantStatus:input_type -> core.TenantStatusReq
+	177, // 223: core.Core.initTenant:input_type -> core.TenantInitReq
+	51,  // 224: core.Core.getTenantInitJobById:input_type -> core.IDReq
+	169, // 225: core.Core.getTenantInitJobList:input_type -> core.TenantInitJobListReq
+	171, // 226: core.Core.retryTenantInitJob:input_type -> core.TenantInitJobRetryReq
+	165, // 227: core.Core.diagnoseTenants:input_type -> core.TenantDoctorReq
+	188, // 228: core.Core.repairTenant:input_type -> core.TenantRepairReq
+	57,  // 229: core.Core.startImpersonation:input_type -> core.ImpersonationStartReq
+	53,  // 230: core.Core.reviewImpersonation:input_type -> core.ImpersonationReviewReq
+	194, // 231: core.Core.endImpersonation:input_type -> core.UUIDReq
+	194, // 232: core.Core.getActiveImpersonation:input_type -> core.UUIDReq
+	55,  // 233: core.Core.getImpersonationSessionList:input_type -> core.ImpersonationSessionListReq
+	44,  // 234: core.Core.getPublicTenantList:input_type -> core.Empty
+	173, // 235: core.Core.registerTenantInitPlugin:input_type -> core.TenantInitPluginInfo
+	173, // 236: core.Core.updateTenantInitPlugin:input_type -> core.TenantInitPluginInfo
+	174, // 237: core.Core.getTenantInitPluginList:input_type -> core.TenantInitPluginListReq
+	51,  // 238: core.Core.getTenantInitPluginById:input_type -> core.IDReq
+	52,  // 239: core.Core.deleteTenantInitPlugin:input_type -> core.IDsReq
+	178, // 240: core.Core.createTenantInitTemplate:input_type -> core.TenantInitTemplateInfo
+	178, // 241: core.Core.updateTenantInitTemplate:input_type -> core.TenantInitTemplateInfo
+	179, // 242: core.Core.getTenantInitTemplateList:input_type -> core.TenantInitTemplateListReq
+	51,  // 243: core.Core.getTenantInitTemplateById:input_type -> core.IDReq
+	52,  // 244: core.Core.deleteTenantInitTemplate:input_type -> core.IDsReq
+	181, // 245: core.Core.previewTenantInitTemplate:input_type -> core.TenantInitTemplatePreviewReq
+	190, // 246: core.Core.createToken:input_type -> core.TokenInfo
+	195, // 247: core.Core.deleteToken:input_type -> core.UUIDsReq
+	191, // 248: core.Core.getTokenList:input_type -> core.TokenListReq
+	194, // 249: core.Core.getTokenById:input_type -> core.UUIDReq
+	194, // 250: core.Core.blockUserAllToken:input_type -> core.UUIDReq
+	190, // 251: core.Core.updateToken:input_type -> core.TokenInfo
+	201, // 252: core.Core.getUserSessionList:input_type -> core.UserSessionListReq
+	202, // 253: core.Core.revokeUserSession:input_type -> core.UserSessionRevokeReq
+	193, // 254: core.Core.touchToken:input_type -> core.TokenTouchReq
+	198, // 255: core.Core.createUser:input_type -> core.UserInfo
+	198, // 256: core.Core.updateUser:input_type -> core.UserInfo
+	199, // 257: core.Core.getUserList:input_type -> core.UserListReq
+	194, // 258: core.Core.getUserById:input_type -> core.UUIDReq
+	203, // 259: core.Core.getUserByUsername:input_type -> core.UsernameReq
+	195, // 260: core.Core.deleteUser:input_type -> core.UUIDsReq
+	129, // 261: core.Core.resetPwd:input_type -> core.ResetPwdReq
+	145, // 262: core.Core.unallocatedList:input_type -> core.RoleUnallocatedListReq
+	17,  // 263: core.Core.createApi:output_type -> core.BaseIDResp
+	19,  // 264: core.Core.updateApi:output_type -> core.BaseResp
+	2,   // 265: core.Core.getApiList:output_type -> core.ApiListResp
+	0,   // 266: core.Core.getApiById:output_type -> core.ApiInfo
+	19,  // 267: core.Core.deleteApi:output_type -> core.BaseResp
+	5,   // 268: core.Core.apiRegistration:output_type -> core.ApiRegistrationResp
+	20,  // 269: core.Core.createAuditLog:output_type -> core.BaseUUIDResp
+	13,  // 270: core.Core.getAuditLogList:output_type -> core.AuditLogListResp
+	11,  // 271: core.Core.getAuditLogById:output_type -> core.AuditLogInfo
+	15,  // 272: core.Core.getAuditLogStats:output_type -> core.AuditLogStatsResp
+	16,  // 273: core.Core.verifyAuditLogChain:output_type -> core.AuditLogVerifyResp
+	9,   // 274: core.Core.getAuditLogArchiveList:output_type -> core.AuditLogArchiveListResp
+	13,  // 275: core.Core.restoreAuditLogRange:output_type -> core.AuditLogListResp
+	139, // 276: core.Core.getMenuAuthority:output_type -> core.RoleMenuAuthorityResp
+	19,  // 277: core.Core.createOrUpdateMenuAuthority:output_type -> core.BaseResp
+	143, // 278: core.Core.getRolePolicies:output_type -> core.RolePolicyListResp
+	128, // 279: core.Core.replaceRolePolicies:output_type -> core.ReplaceRolePoliciesResp
+	119, // 280: core.Core.exportPolicyBundle:output_type -> core.PolicyBundleResp
+	118, // 281: core.Core.importPolicyBundle:output_type -> core.PolicyBundleImportResp
+	19,  // 282: core.Core.initDatabase:output_type -> core.BaseResp
+	17,  // 283: core.Core.createCasbinRule:output_type -> core.BaseIDResp
+	19,  // 284: core.Core.updateCasbinRule:output_type -> core.BaseResp
+	19,  // 285: core.Core.deleteCasbinRule:output_type -> core.BaseResp
+	29,  // 286: core.Core.getCasbinRuleList:output_type -> core.CasbinRuleListResp
+	27,  // 287: core.Core.getCasbinRuleById:output_type -> core.CasbinRuleInfo
+	19,  // 288: core.Core.batchCreateCasbinRules:output_type -> core.BaseResp
+	19,  // 289: core.Core.batchUpdateCasbinRules:output_type -> core.BaseResp
+	19,  // 290: core.Core.batchDeleteCasbinRules:output_type -> core.BaseResp
+	113, // 291: core.Core.checkPermission:output_type -> core.PermissionCheckResp
+	23,  // 292: core.Core.batchCheckPermission:output_type -> core.BatchPermissionCheckResp
+	50,  // 293: core.Core.getUserPermissionSummary:output_type -> core.GetUserPermissionSummaryResp
+	205, // 294: core.Core.validateCasbinRule:output_type -> core.ValidateCasbinRuleResp
+	162, // 295: core.Core.syncCasbinRules:output_type -> core.SyncCasbinRulesResp
+	126, // 296: core.Core.refreshCasbinCache:output_type -> core.RefreshCasbinCacheResp
+	17,  // 297: core.Core.createConfiguration:output_type -> core.BaseIDResp
+	19,  // 298: core.Core.updateConfiguration:output_type -> core.BaseResp
+	32,  // 299: core.Core.getConfigurationList:output_type -> core.ConfigurationListResp
+	30,  // 300: core.Core.getConfigurationById:output_type -> core.ConfigurationInfo
+	19,  // 301: core.Core.deleteConfiguration:output_type -> core.BaseResp
+	19,  // 302: core.Core.refreshConfigurationCache:output_type -> core.BaseResp
+	17,  // 303: core.Core.createDepartment:output_type -> core.BaseIDResp
+	19,  // 304: core.Core.updateDepartment:output_type -> core.BaseResp
+	36,  // 305: core.Core.getDepartmentList:output_type -> core.DepartmentListResp
+	34,  // 306: core.Core.getDepartmentById:output_type -> core.DepartmentInfo
+	19,  // 307: core.Core.deleteDepartment:output_type -> core.BaseResp
+	19,  // 308: core.Core.initDeptDataPermToRedis:output_type -> core.BaseResp
+	17,  // 309: core.Core.createDictionary:output_type -> core.BaseIDResp
+	19,  // 310: core.Core.updateDictionary:output_type -> core.BaseResp
+	42,  // 311: core.Core.getDictionaryList:output_type -> core.DictionaryListResp
+	40,  // 312: core.Core.getDictionaryById:output_type -> core.DictionaryInfo
+	19,  // 313: core.Core.deleteDictionary:output_type -> core.BaseResp
+	17,  // 314: core.Core.createDictionaryDetail:output_type -> core.BaseIDResp
+	19,  // 315: core.Core.updateDictionaryDetail:output_type -> core.BaseResp
+	39,  // 316: core.Core.getDictionaryDetailList:output_type -> core.DictionaryDetailListResp
+	37,  // 317: core.Core.getDictionaryDetailById:output_type -> core.DictionaryDetailInfo
+	19,  // 318: core.Core.deleteDictionaryDetail:output_type -> core.BaseResp
+	39,  // 319: core.Core.getDictionaryDetailByDictionaryName:output_type -> core.DictionaryDetailListResp
+	17,  // 320: core.Core.createLdapProvider:output_type -> core.BaseIDResp
+	19,  // 321: core.Core.updateLdapProvider:output_type -> core.BaseResp
+	61,  // 322: core.Core.getLdapProviderList:output_type -> core.LdapProviderListResp
+	59,  // 323: core.Core.getLdapProviderById:output_type -> core.LdapProviderInfo
+	19,  // 324: core.Core.deleteLdapProvider:output_type -> core.BaseResp
+	198, // 325: core.Core.ldapLogin:output_type -> core.UserInfo
+	66,  // 326: core.Core.syncLdapProvider:output_type -> core.LdapSyncRunInfo
+	68,  // 327: core.Core.getLdapSyncRunList:output_type -> core.LdapSyncRunListResp
+	66,  // 328: core.Core.getLdapSyncRunById:output_type -> core.LdapSyncRunInfo
+	17,  // 329: core.Core.createMenu:output_type -> core.BaseIDResp
+	19,  // 330: core.Core.updateMenu:output_type -> core.BaseResp
+	19,  // 331: core.Core.deleteMenu:output_type -> core.BaseResp
+	70,  // 332: core.Core.getMenu:output_type -> core.MenuInfo
+	71,  // 333: core.Core.getMenuListByRole:output_type -> core.MenuInfoList
+	71,  // 334: core.Core.getMenuList:output_type -> core.MenuInfoList
+	90,  // 335: core.Core.createOauthClient:output_type -> core.OauthClientSecretResp
+	19,  // 336: core.Core.updateOauthClient:output_type -> core.BaseResp
+	89,  // 337: core.Core.getOauthClientList:output_type -> core.OauthClientListResp
+	87,  // 338: core.Core.getOauthClientById:output_type -> core.OauthClientInfo
+	19,  // 339: core.Core.deleteOauthClient:output_type -> core.BaseResp
+	90,  // 340: core.Core.resetOauthClientSecret:output_type -> core.OauthClientSecretResp
+	87,  // 341: core.Core.getOauthClientByClientId:output_type -> core.OauthClientInfo
+	87,  // 342: core.Core.authenticateOauthClient:output_type -> core.OauthClientInfo
+	81,  // 343: core.Core.authorizeOauthClient:output_type -> core.OauthAuthorizeResp
+	95,  // 344: core.Core.exchangeOauthAuthorizationCode:output_type -> core.OauthGrantInfo
+	94,  // 345: core.Core.getOauthConsentList:output_type -> core.OauthConsentListResp
+	19,  // 346: core.Core.deleteOauthConsent:output_type -> core.BaseResp
+	17,  // 347: core.Core.createOauthProvider:output_type -> core.BaseIDResp
+	19,  // 348: core.Core.updateOauthProvider:output_type -> core.BaseResp
+	99,  // 349: core.Core.getOauthProviderList:output_type -> core.OauthProviderListResp
+	97,  // 350: core.Core.getOauthProviderById:output_type -> core.OauthProviderInfo
+	19,  // 351: core.Core.deleteOauthProvider:output_type -> core.BaseResp
+	103, // 352: core.Core.oauthLogin:output_type -> core.OauthRedirectResp
+	82,  // 353: core.Core.oauthCallback:output_type -> core.OauthCallbackResp
+	84,  // 354: core.Core.previewOauthClaimMapping:output_type -> core.OauthClaimMappingPreviewResp
+	109, // 355: core.Core.oauthWebhook:output_type -> core.OauthWebhookResp
+	17,  // 356: core.Core.createOauthAccount:output_type -> core.BaseIDResp
+	19,  // 357: core.Core.updateOauthAccount:output_type -> core.BaseResp
+	79,  // 358: core.Core.getOauthAccountList:output_type -> core.OauthAccountListResp
+	77,  // 359: core.Core.getOauthAccountById:output_type -> core.OauthAccountInfo
+	19,  // 360: core.Core.deleteOauthAccount:output_type -> core.BaseResp
+	19,  // 361: core.Core.bindOauthAccount:output_type -> core.BaseResp
+	19,  // 362: core.Core.unbindOauthAccount:output_type -> core.BaseResp
+	48,  // 363: core.Core.getUserOauthAccounts:output_type -> core.GetUserOauthAccountsResp
+	76,  // 364: core.Core.getOauthAccessToken:output_type -> core.OauthAccessTokenResp
+	17,  // 365: core.Core.createOauthSession:output_type -> core.BaseIDResp
+	19,  // 366: core.Core.updateOauthSession:output_type -> core.BaseResp
+	107, // 367: core.Core.getOauthSessionByState:output_type -> core.OauthSessionInfo
+	19,  // 368: core.Core.deleteOauthSession:output_type -> core.BaseResp
+	17,  // 369: core.Core.createOauthProviderTemplate:output_type -> core.BaseIDResp
+	19,  // 370: core.Core.updateOauthProviderTemplate:output_type -> core.BaseResp
+	102, // 371: core.Core.getOauthProviderTemplateList:output_type -> core.OauthProviderTemplateListResp
+	100, // 372: core.Core.getOauthProviderTemplateById:output_type -> core.OauthProviderTemplateInfo
+	19,  // 373: core.Core.deleteOauthProviderTemplate:output_type -> core.BaseResp
+	17,  // 374: core.Core.enableOauthProviderTemplate:output_type -> core.BaseIDResp
+	17,  // 375: core.Core.createOauthScope:output_type -> core.BaseIDResp
+	19,  // 376: core.Core.updateOauthScope:output_type -> core.BaseResp
+	106, // 377: core.Core.getOauthScopeList:output_type -> core.OauthScopeListResp
+	104, // 378: core.Core.getOauthScopeById:output_type -> core.OauthScopeInfo
+	19,  // 379: core.Core.deleteOauthScope:output_type -> core.BaseResp
+	17,  // 380: core.Core.createPosition:output_type -> core.BaseIDResp
+	19,  // 381: core.Core.updatePosition:output_type -> core.BaseResp
+	122, // 382: core.Core.getPositionList:output_type -> core.PositionListResp
+	120, // 383: core.Core.getPositionById:output_type -> core.PositionInfo
+	19,  // 384: core.Core.deletePosition:output_type -> core.BaseResp
+	17,  // 385: core.Core.createRole:output_type -> core.BaseIDResp
+	19,  // 386: core.Core.updateRole:output_type -> core.BaseResp
+	137, // 387: core.Core.getRoleList:output_type -> core.RoleListResp
+	135, // 388: core.Core.getRoleById:output_type -> core.RoleInfo
+	19,  // 389: core.Core.deleteRole:output_type -> core.BaseResp
+	19,  // 390: core.Core.deleteRoleWithUsers:output_type -> core.BaseResp
+	19,  // 391: core.Core.initRoleDataPermToRedis:output_type -> core.BaseResp
+	19,  // 392: core.Core.assignRoleDataScope:output_type -> core.BaseResp
+	19,  // 393: core.Core.cancelAuth:output_type -> core.BaseResp
+	19,  // 394: core.Core.addAuth:output_type -> core.BaseResp
+	19,  // 395: core.Core.changeRoleStatus:output_type -> core.BaseResp
+	19,  // 396: core.Core.setRoleParents:output_type -> core.BaseResp
+	141, // 397: core.Core.getRoleParents:output_type -> core.RoleParentsResp
+	134, // 398: core.Core.getRoleEffectivePermissions:output_type -> core.RoleEffectivePermissionsResp
+	17,  // 399: core.Core.createSamlProvider:output_type -> core.BaseIDResp
+	19,  // 400: core.Core.updateSamlProvider:output_type -> core.BaseResp
+	153, // 401: core.Core.getSamlProviderList:output_type -> core.SamlProviderListResp
+	151, // 402: core.Core.getSamlProviderById:output_type -> core.SamlProviderInfo
+	19,  // 403: core.Core.deleteSamlProvider:output_type -> core.BaseResp
+	19,  // 404: core.Core.importSamlIdpMetadata:output_type -> core.BaseResp
+	155, // 405: core.Core.getSamlSpMetadata:output_type -> core.SamlSpMetadataResp
+	149, // 406: core.Core.samlLogin:output_type -> core.SamlLoginResp
+	147, // 407: core.Core.samlAcs:output_type -> core.SamlAcsResp
+	157, // 408: core.Core.createScimToken:output_type -> core.ScimTokenCreateResp
+	19,  // 409: core.Core.updateScimToken:output_type -> core.BaseResp
+	160, // 410: core.Core.getScimTokenList:output_type -> core.ScimTokenListResp
+	158, // 411: core.Core.getScimTokenById:output_type -> core.ScimTokenInfo
+	19,  // 412: core.Core.deleteScimToken:output_type -> core.BaseResp
+	158, // 413: core.Core.authenticateScimToken:output_type -> core.ScimTokenInfo
+	17,  // 414: core.Core.createTenant:output_type -> core.BaseIDResp
+	19,  // 415: core.Core.updateTenant:output_type -> core.BaseResp
+	184, // 416: core.Core.getTenantList:output_type -> core.TenantListResp
+	167, // 417: core.Core.getTenantById:output_type -> core.TenantInfo
+	167, // 418: core.Core.getTenantByCode:output_type -> core.TenantInfo
+	19,  // 419: core.Core.deleteTenant:output_type -> core.BaseResp
+	19,  // 420: core.Core.updateTenantStatus:output_type -> core.BaseResp
+	17,  // 421: core.Core.initTenant:output_type -> core.BaseIDResp
+	168, // 422: core.Core.getTenantInitJobById:output_type -> core.TenantInitJobInfo
+	170, // 423: core.Core.getTenantInitJobList:output_type -> core.TenantInitJobListResp
+	17,  // 424: core.Core.retryTenantInitJob:output_type -> core.BaseIDResp
+	166, // 425: core.Core.diagnoseTenants:output_type -> core.TenantDoctorResp
+	166, // 426: core.Core.repairTenant:output_type -> core.TenantDoctorResp
+	54,  // 427: core.Core.startImpersonation:output_type -> core.ImpersonationSessionInfo
+	54,  // 428: core.Core.reviewImpersonation:output_type -> core.ImpersonationSessionInfo
+	19,  // 429: core.Core.endImpersonation:output_type -> core.BaseResp
+	54,  // 430: core.Core.getActiveImpersonation:output_type -> core.ImpersonationSessionInfo
+	56,  // 431: core.Core.getImpersonationSessionList:output_type -> core.ImpersonationSessionListResp
+	124, // 432: core.Core.getPublicTenantList:output_type -> core.PublicTenantListResp
+	17,  // 433: core.Core.registerTenantInitPlugin:output_type -> core.BaseIDResp
+	19,  // 434: core.Core.updateTenantInitPlugin:output_type -> core.BaseResp
+	175, // 435: core.Core.getTenantInitPluginList:output_type -> core.TenantInitPluginListResp
+	173, // 436: core.Core.getTenantInitPluginById:output_type -> core.TenantInitPluginInfo
+	19,  // 437: core.Core.deleteTenantInitPlugin:output_type -> core.BaseResp
+	17,  // 438: core.Core.createTenantInitTemplate:output_type -> core.BaseIDResp
+	19,  // 439: core.Core.updateTenantInitTemplate:output_type -> core.BaseResp
+	180, // 440: core.Core.getTenantInitTemplateList:output_type -> core.TenantInitTemplateListResp
+	178, // 441: core.Core.getTenantInitTemplateById:output_type -> core.TenantInitTemplateInfo
+	19,  // 442: core.Core.deleteTenantInitTemplate:output_type -> core.BaseResp
+	182, // 443: core.Core.previewTenantInitTemplate:output_type -> core.TenantInitTemplatePreviewResp
+	20,  // 444: core.Core.createToken:output_type -> core.BaseUUIDResp
+	19,  // 445: core.Core.deleteToken:output_type -> core.BaseResp
+	192, // 446: core.Core.getTokenList:output_type -> core.TokenListResp
+	190, // 447: core.Core.getTokenById:output_type -> core.TokenInfo
+	19,  // 448: core.Core.blockUserAllToken:output_type -> core.BaseResp
+	19,  // 449: core.Core.updateToken:output_type -> core.BaseResp
+	192, // 450: core.Core.getUserSessionList:output_type -> core.TokenListResp
+	19,  // 451: core.Core.revokeUserSession:output_type -> core.BaseResp
+	19,  // 452: core.Core.touchToken:output_type -> core.BaseResp
+	20,  // 453: core.Core.createUser:output_type -> core.BaseUUIDResp
+	19,  // 454: core.Core.updateUser:output_type -> core.BaseResp
+	200, // 455: core.Core.getUserList:output_type -> core.UserListResp
+	198, // 456: core.Core.getUserById:output_type -> core.UserInfo
+	198, // 457: core.Core.getUserByUsername:output_type -> core.UserInfo
+	19,  // 458: core.Core.deleteUser:output_type -> core.BaseResp
+	19,  // 459: core.Core.resetPwd:output_type -> core.BaseResp
+	200, // 460: core.Core.unallocatedList:output_type -> core.UserListResp
+	263, // [263:461] is the sub-list for method output_type
+	65,  // [65:263] is the sub-list for method input_type
+	65,  // [65:65] is the sub-list for extension type_name
+	65,  // [65:65] is the sub-list for extension extendee
+	0,   // [0:65] is the sub-list for field type_name
 }
 
 func init() { file_core_proto_init() }
@@ -9782,55 +19089,105 @@ func file_core_proto_init() {
 	file_core_proto_msgTypes[0].OneofWrappers = []any{}
 	file_core_proto_msgTypes[1].OneofWrappers = []any{}
 	file_core_proto_msgTypes[3].OneofWrappers = []any{}
-	file_core_proto_msgTypes[4].OneofWrappers = []any{}
-	file_core_proto_msgTypes[6].OneofWrappers = []any{}
-	file_core_proto_msgTypes[13].OneofWrappers = []any{}
-	file_core_proto_msgTypes[18].OneofWrappers = []any{}
-	file_core_proto_msgTypes[19].OneofWrappers = []any{}
-	file_core_proto_msgTypes[21].OneofWrappers = []any{}
+	file_core_proto_msgTypes[8].OneofWrappers = []any{}
+	file_core_proto_msgTypes[11].OneofWrappers = []any{}
+	file_core_proto_msgTypes[12].OneofWrappers = []any{}
+	file_core_proto_msgTypes[14].OneofWrappers = []any{}
 	file_core_proto_msgTypes[22].OneofWrappers = []any{}
-	file_core_proto_msgTypes[24].OneofWrappers = []any{}
-	file_core_proto_msgTypes[25].OneofWrappers = []any{}
 	file_core_proto_msgTypes[26].OneofWrappers = []any{}
+	file_core_proto_msgTypes[27].OneofWrappers = []any{}
 	file_core_proto_msgTypes[28].OneofWrappers = []any{}
-	file_core_proto_msgTypes[29].OneofWrappers = []any{}
+	file_core_proto_msgTypes[30].OneofWrappers = []any{}
 	file_core_proto_msgTypes[31].OneofWrappers = []any{}
-	file_core_proto_msgTypes[32].OneofWrappers = []any{}
-	file_core_proto_msgTypes[39].OneofWrappers = []any{}
-	file_core_proto_msgTypes[43].OneofWrappers = []any{}
-	file_core_proto_msgTypes[47].OneofWrappers = []any{}
-	file_core_proto_msgTypes[48].OneofWrappers = []any{}
+	file_core_proto_msgTypes[33].OneofWrappers = []any{}
+	file_core_proto_msgTypes[34].OneofWrappers = []any{}
+	file_core_proto_msgTypes[35].OneofWrappers = []any{}
+	file_core_proto_msgTypes[37].OneofWrappers = []any{}
+	file_core_proto_msgTypes[38].OneofWrappers = []any{}
+	file_core_proto_msgTypes[40].OneofWrappers = []any{}
+	file_core_proto_msgTypes[41].OneofWrappers = []any{}
+	file_core_proto_msgTypes[45].OneofWrappers = []any{}
 	file_core_proto_msgTypes[49].OneofWrappers = []any{}
-	file_core_proto_msgTypes[52].OneofWrappers = []any{}
-	file_core_proto_msgTypes[53].OneofWrappers = []any{}
-	file_core_proto_msgTypes[56].OneofWrappers = []any{}
+	file_core_proto_msgTypes[54].OneofWrappers = []any{}
+	file_core_proto_msgTypes[55].OneofWrappers = []any{}
+	file_core_proto_msgTypes[57].OneofWrappers = []any{}
+	file_core_proto_msgTypes[58].OneofWrappers = []any{}
 	file_core_proto_msgTypes[59].OneofWrappers = []any{}
-	file_core_proto_msgTypes[61].OneofWrappers = []any{}
-	file_core_proto_msgTypes[62].OneofWrappers = []any{}
-	file_core_proto_msgTypes[63].OneofWrappers = []any{}
-	file_core_proto_msgTypes[65].OneofWrappers = []any{}
+	file_core_proto_msgTypes[60].OneofWrappers = []any{}
+	file_core_proto_msgTypes[66].OneofWrappers = []any{}
 	file_core_proto_msgTypes[67].OneofWrappers = []any{}
-	file_core_proto_msgTypes[69].OneofWrappers = []any{}
-	file_core_proto_msgTypes[73].OneofWrappers = []any{}
+	file_core_proto_msgTypes[70].OneofWrappers = []any{}
 	file_core_proto_msgTypes[74].OneofWrappers = []any{}
-	file_core_proto_msgTypes[79].OneofWrappers = []any{}
+	file_core_proto_msgTypes[75].OneofWrappers = []any{}
+	file_core_proto_msgTypes[77].OneofWrappers = []any{}
+	file_core_proto_msgTypes[78].OneofWrappers = []any{}
 	file_core_proto_msgTypes[80].OneofWrappers = []any{}
 	file_core_proto_msgTypes[83].OneofWrappers = []any{}
-	file_core_proto_msgTypes[84].OneofWrappers = []any{}
 	file_core_proto_msgTypes[85].OneofWrappers = []any{}
+	file_core_proto_msgTypes[87].OneofWrappers = []any{}
 	file_core_proto_msgTypes[88].OneofWrappers = []any{}
-	file_core_proto_msgTypes[89].OneofWrappers = []any{}
-	file_core_proto_msgTypes[94].OneofWrappers = []any{}
-	file_core_proto_msgTypes[95].OneofWrappers = []any{}
+	file_core_proto_msgTypes[91].OneofWrappers = []any{}
+	file_core_proto_msgTypes[92].OneofWrappers = []any{}
+	file_core_proto_msgTypes[93].OneofWrappers = []any{}
 	file_core_proto_msgTypes[96].OneofWrappers = []any{}
-	file_core_proto_msgTypes[99].OneofWrappers = []any{}
+	file_core_proto_msgTypes[97].OneofWrappers = []any{}
+	file_core_proto_msgTypes[98].OneofWrappers = []any{}
+	file_core_proto_msgTypes[100].OneofWrappers = []any{}
+	file_core_proto_msgTypes[101].OneofWrappers = []any{}
+	file_core_proto_msgTypes[104].OneofWrappers = []any{}
+	file_core_proto_msgTypes[105].OneofWrappers = []any{}
+	file_core_proto_msgTypes[107].OneofWrappers = []any{}
+	file_core_proto_msgTypes[112].OneofWrappers = []any{}
+	file_core_proto_msgTypes[114].OneofWrappers = []any{}
+	file_core_proto_msgTypes[116].OneofWrappers = []any{}
+	file_core_proto_msgTypes[117].OneofWrappers = []any{}
+	file_core_proto_msgTypes[120].OneofWrappers = []any{}
+	file_core_proto_msgTypes[121].OneofWrappers = []any{}
+	file_core_proto_msgTypes[123].OneofWrappers = []any{}
+	file_core_proto_msgTypes[125].OneofWrappers = []any{}
+	file_core_proto_msgTypes[127].OneofWrappers = []any{}
+	file_core_proto_msgTypes[129].OneofWrappers = []any{}
+	file_core_proto_msgTypes[135].OneofWrappers = []any{}
+	file_core_proto_msgTypes[136].OneofWrappers = []any{}
+	file_core_proto_msgTypes[145].OneofWrappers = []any{}
+	file_core_proto_msgTypes[146].OneofWrappers = []any{}
+	file_core_proto_msgTypes[148].OneofWrappers = []any{}
+	file_core_proto_msgTypes[150].OneofWrappers = []any{}
+	file_core_proto_msgTypes[151].OneofWrappers = []any{}
+	file_core_proto_msgTypes[152].OneofWrappers = []any{}
+	file_core_proto_msgTypes[156].OneofWrappers = []any{}
+	file_core_proto_msgTypes[158].OneofWrappers = []any{}
+	file_core_proto_msgTypes[159].OneofWrappers = []any{}
+	file_core_proto_msgTypes[161].OneofWrappers = []any{}
+	file_core_proto_msgTypes[165].OneofWrappers = []any{}
+	file_core_proto_msgTypes[167].OneofWrappers = []any{}
+	file_core_proto_msgTypes[168].OneofWrappers = []any{}
+	file_core_proto_msgTypes[169].OneofWrappers = []any{}
+	file_core_proto_msgTypes[171].OneofWrappers = []any{}
+	file_core_proto_msgTypes[173].OneofWrappers = []any{}
+	file_core_proto_msgTypes[174].OneofWrappers = []any{}
+	file_core_proto_msgTypes[176].OneofWrappers = []any{}
+	file_core_proto_msgTypes[177].OneofWrappers = []any{}
+	file_core_proto_msgTypes[178].OneofWrappers = []any{}
+	file_core_proto_msgTypes[179].OneofWrappers = []any{}
+	file_core_proto_msgTypes[183].OneofWrappers = []any{}
+	file_core_proto_msgTypes[185].OneofWrappers = []any{}
+	file_core_proto_msgTypes[188].OneofWrappers = []any{}
+	file_core_proto_msgTypes[190].OneofWrappers = []any{}
+	file_core_proto_msgTypes[191].OneofWrappers = []any{}
+	file_core_proto_msgTypes[193].OneofWrappers = []any{}
+	file_core_proto_msgTypes[197].OneofWrappers = []any{}
+	file_core_proto_msgTypes[198].OneofWrappers = []any{}
+	file_core_proto_msgTypes[199].OneofWrappers = []any{}
+	file_core_proto_msgTypes[202].OneofWrappers = []any{}
+	file_core_proto_msgTypes[204].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_core_proto_rawDesc), len(file_core_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   103,
+			NumMessages:   209,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
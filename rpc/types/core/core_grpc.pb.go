@@ -24,13 +24,20 @@ const (
 	Core_GetApiList_FullMethodName                          = "/core.Core/getApiList"
 	Core_GetApiById_FullMethodName                          = "/core.Core/getApiById"
 	Core_DeleteApi_FullMethodName                           = "/core.Core/deleteApi"
+	Core_ApiRegistration_FullMethodName                     = "/core.Core/apiRegistration"
 	Core_CreateAuditLog_FullMethodName                      = "/core.Core/createAuditLog"
 	Core_GetAuditLogList_FullMethodName                     = "/core.Core/getAuditLogList"
 	Core_GetAuditLogById_FullMethodName                     = "/core.Core/getAuditLogById"
-	Core_DeleteAuditLog_FullMethodName                      = "/core.Core/deleteAuditLog"
 	Core_GetAuditLogStats_FullMethodName                    = "/core.Core/getAuditLogStats"
+	Core_VerifyAuditLogChain_FullMethodName                 = "/core.Core/verifyAuditLogChain"
+	Core_GetAuditLogArchiveList_FullMethodName              = "/core.Core/getAuditLogArchiveList"
+	Core_RestoreAuditLogRange_FullMethodName                = "/core.Core/restoreAuditLogRange"
 	Core_GetMenuAuthority_FullMethodName                    = "/core.Core/getMenuAuthority"
 	Core_CreateOrUpdateMenuAuthority_FullMethodName         = "/core.Core/createOrUpdateMenuAuthority"
+	Core_GetRolePolicies_FullMethodName                     = "/core.Core/getRolePolicies"
+	Core_ReplaceRolePolicies_FullMethodName                 = "/core.Core/replaceRolePolicies"
+	Core_ExportPolicyBundle_FullMethodName                  = "/core.Core/exportPolicyBundle"
+	Core_ImportPolicyBundle_FullMethodName                  = "/core.Core/importPolicyBundle"
 	Core_InitDatabase_FullMethodName                        = "/core.Core/initDatabase"
 	Core_CreateCasbinRule_FullMethodName                    = "/core.Core/createCasbinRule"
 	Core_UpdateCasbinRule_FullMethodName                    = "/core.Core/updateCasbinRule"
@@ -69,12 +76,33 @@ const (
 	Core_GetDictionaryDetailById_FullMethodName             = "/core.Core/getDictionaryDetailById"
 	Core_DeleteDictionaryDetail_FullMethodName              = "/core.Core/deleteDictionaryDetail"
 	Core_GetDictionaryDetailByDictionaryName_FullMethodName = "/core.Core/getDictionaryDetailByDictionaryName"
+	Core_CreateLdapProvider_FullMethodName                  = "/core.Core/createLdapProvider"
+	Core_UpdateLdapProvider_FullMethodName                  = "/core.Core/updateLdapProvider"
+	Core_GetLdapProviderList_FullMethodName                 = "/core.Core/getLdapProviderList"
+	Core_GetLdapProviderById_FullMethodName                 = "/core.Core/getLdapProviderById"
+	Core_DeleteLdapProvider_FullMethodName                  = "/core.Core/deleteLdapProvider"
+	Core_LdapLogin_FullMethodName                           = "/core.Core/ldapLogin"
+	Core_SyncLdapProvider_FullMethodName                    = "/core.Core/syncLdapProvider"
+	Core_GetLdapSyncRunList_FullMethodName                  = "/core.Core/getLdapSyncRunList"
+	Core_GetLdapSyncRunById_FullMethodName                  = "/core.Core/getLdapSyncRunById"
 	Core_CreateMenu_FullMethodName                          = "/core.Core/createMenu"
 	Core_UpdateMenu_FullMethodName                          = "/core.Core/updateMenu"
 	Core_DeleteMenu_FullMethodName                          = "/core.Core/deleteMenu"
 	Core_GetMenu_FullMethodName                             = "/core.Core/getMenu"
 	Core_GetMenuListByRole_FullMethodName                   = "/core.Core/getMenuListByRole"
 	Core_GetMenuList_FullMethodName                         = "/core.Core/getMenuList"
+	Core_CreateOauthClient_FullMethodName                   = "/core.Core/createOauthClient"
+	Core_UpdateOauthClient_FullMethodName                   = "/core.Core/updateOauthClient"
+	Core_GetOauthClientList_FullMethodName                  = "/core.Core/getOauthClientList"
+	Core_GetOauthClientById_FullMethodName                  = "/core.Core/getOauthClientById"
+	Core_DeleteOauthClient_FullMethodName                   = "/core.Core/deleteOauthClient"
+	Core_ResetOauthClientSecret_FullMethodName              = "/core.Core/resetOauthClientSecret"
+	Core_GetOauthClientByClientId_FullMethodName            = "/core.Core/getOauthClientByClientId"
+	Core_AuthenticateOauthClient_FullMethodName             = "/core.Core/authenticateOauthClient"
+	Core_AuthorizeOauthClient_FullMethodName                = "/core.Core/authorizeOauthClient"
+	Core_ExchangeOauthAuthorizationCode_FullMethodName      = "/core.Core/exchangeOauthAuthorizationCode"
+	Core_GetOauthConsentList_FullMethodName                 = "/core.Core/getOauthConsentList"
+	Core_DeleteOauthConsent_FullMethodName                  = "/core.Core/deleteOauthConsent"
 	Core_CreateOauthProvider_FullMethodName                 = "/core.Core/createOauthProvider"
 	Core_UpdateOauthProvider_FullMethodName                 = "/core.Core/updateOauthProvider"
 	Core_GetOauthProviderList_FullMethodName                = "/core.Core/getOauthProviderList"
@@ -82,6 +110,8 @@ const (
 	Core_DeleteOauthProvider_FullMethodName                 = "/core.Core/deleteOauthProvider"
 	Core_OauthLogin_FullMethodName                          = "/core.Core/oauthLogin"
 	Core_OauthCallback_FullMethodName                       = "/core.Core/oauthCallback"
+	Core_PreviewOauthClaimMapping_FullMethodName            = "/core.Core/previewOauthClaimMapping"
+	Core_OauthWebhook_FullMethodName                        = "/core.Core/oauthWebhook"
 	Core_CreateOauthAccount_FullMethodName                  = "/core.Core/createOauthAccount"
 	Core_UpdateOauthAccount_FullMethodName                  = "/core.Core/updateOauthAccount"
 	Core_GetOauthAccountList_FullMethodName                 = "/core.Core/getOauthAccountList"
@@ -90,10 +120,22 @@ const (
 	Core_BindOauthAccount_FullMethodName                    = "/core.Core/bindOauthAccount"
 	Core_UnbindOauthAccount_FullMethodName                  = "/core.Core/unbindOauthAccount"
 	Core_GetUserOauthAccounts_FullMethodName                = "/core.Core/getUserOauthAccounts"
+	Core_GetOauthAccessToken_FullMethodName                 = "/core.Core/getOauthAccessToken"
 	Core_CreateOauthSession_FullMethodName                  = "/core.Core/createOauthSession"
 	Core_UpdateOauthSession_FullMethodName                  = "/core.Core/updateOauthSession"
 	Core_GetOauthSessionByState_FullMethodName              = "/core.Core/getOauthSessionByState"
 	Core_DeleteOauthSession_FullMethodName                  = "/core.Core/deleteOauthSession"
+	Core_CreateOauthProviderTemplate_FullMethodName         = "/core.Core/createOauthProviderTemplate"
+	Core_UpdateOauthProviderTemplate_FullMethodName         = "/core.Core/updateOauthProviderTemplate"
+	Core_GetOauthProviderTemplateList_FullMethodName        = "/core.Core/getOauthProviderTemplateList"
+	Core_GetOauthProviderTemplateById_FullMethodName        = "/core.Core/getOauthProviderTemplateById"
+	Core_DeleteOauthProviderTemplate_FullMethodName         = "/core.Core/deleteOauthProviderTemplate"
+	Core_EnableOauthProviderTemplate_FullMethodName         = "/core.Core/enableOauthProviderTemplate"
+	Core_CreateOauthScope_FullMethodName                    = "/core.Core/createOauthScope"
+	Core_UpdateOauthScope_FullMethodName                    = "/core.Core/updateOauthScope"
+	Core_GetOauthScopeList_FullMethodName                   = "/core.Core/getOauthScopeList"
+	Core_GetOauthScopeById_FullMethodName                   = "/core.Core/getOauthScopeById"
+	Core_DeleteOauthScope_FullMethodName                    = "/core.Core/deleteOauthScope"
 	Core_CreatePosition_FullMethodName                      = "/core.Core/createPosition"
 	Core_UpdatePosition_FullMethodName                      = "/core.Core/updatePosition"
 	Core_GetPositionList_FullMethodName                     = "/core.Core/getPositionList"
@@ -104,11 +146,30 @@ const (
 	Core_GetRoleList_FullMethodName                         = "/core.Core/getRoleList"
 	Core_GetRoleById_FullMethodName                         = "/core.Core/getRoleById"
 	Core_DeleteRole_FullMethodName                          = "/core.Core/deleteRole"
+	Core_DeleteRoleWithUsers_FullMethodName                 = "/core.Core/deleteRoleWithUsers"
 	Core_InitRoleDataPermToRedis_FullMethodName             = "/core.Core/initRoleDataPermToRedis"
 	Core_AssignRoleDataScope_FullMethodName                 = "/core.Core/assignRoleDataScope"
 	Core_CancelAuth_FullMethodName                          = "/core.Core/cancelAuth"
 	Core_AddAuth_FullMethodName                             = "/core.Core/addAuth"
 	Core_ChangeRoleStatus_FullMethodName                    = "/core.Core/changeRoleStatus"
+	Core_SetRoleParents_FullMethodName                      = "/core.Core/setRoleParents"
+	Core_GetRoleParents_FullMethodName                      = "/core.Core/getRoleParents"
+	Core_GetRoleEffectivePermissions_FullMethodName         = "/core.Core/getRoleEffectivePermissions"
+	Core_CreateSamlProvider_FullMethodName                  = "/core.Core/createSamlProvider"
+	Core_UpdateSamlProvider_FullMethodName                  = "/core.Core/updateSamlProvider"
+	Core_GetSamlProviderList_FullMethodName                 = "/core.Core/getSamlProviderList"
+	Core_GetSamlProviderById_FullMethodName                 = "/core.Core/getSamlProviderById"
+	Core_DeleteSamlProvider_FullMethodName                  = "/core.Core/deleteSamlProvider"
+	Core_ImportSamlIdpMetadata_FullMethodName               = "/core.Core/importSamlIdpMetadata"
+	Core_GetSamlSpMetadata_FullMethodName                   = "/core.Core/getSamlSpMetadata"
+	Core_SamlLogin_FullMethodName                           = "/core.Core/samlLogin"
+	Core_SamlAcs_FullMethodName                             = "/core.Core/samlAcs"
+	Core_CreateScimToken_FullMethodName                     = "/core.Core/createScimToken"
+	Core_UpdateScimToken_FullMethodName                     = "/core.Core/updateScimToken"
+	Core_GetScimTokenList_FullMethodName                    = "/core.Core/getScimTokenList"
+	Core_GetScimTokenById_FullMethodName                    = "/core.Core/getScimTokenById"
+	Core_DeleteScimToken_FullMethodName                     = "/core.Core/deleteScimToken"
+	Core_AuthenticateScimToken_FullMethodName               = "/core.Core/authenticateScimToken"
 	Core_CreateTenant_FullMethodName                        = "/core.Core/createTenant"
 	Core_UpdateTenant_FullMethodName                        = "/core.Core/updateTenant"
 	Core_GetTenantList_FullMethodName                       = "/core.Core/getTenantList"
@@ -117,13 +178,37 @@ const (
 	Core_DeleteTenant_FullMethodName                        = "/core.Core/deleteTenant"
 	Core_UpdateTenantStatus_FullMethodName                  = "/core.Core/updateTenantStatus"
 	Core_InitTenant_FullMethodName                          = "/core.Core/initTenant"
+	Core_GetTenantInitJobById_FullMethodName                = "/core.Core/getTenantInitJobById"
+	Core_GetTenantInitJobList_FullMethodName                = "/core.Core/getTenantInitJobList"
+	Core_RetryTenantInitJob_FullMethodName                  = "/core.Core/retryTenantInitJob"
+	Core_DiagnoseTenants_FullMethodName                     = "/core.Core/diagnoseTenants"
+	Core_RepairTenant_FullMethodName                        = "/core.Core/repairTenant"
+	Core_StartImpersonation_FullMethodName                  = "/core.Core/startImpersonation"
+	Core_ReviewImpersonation_FullMethodName                 = "/core.Core/reviewImpersonation"
+	Core_EndImpersonation_FullMethodName                    = "/core.Core/endImpersonation"
+	Core_GetActiveImpersonation_FullMethodName              = "/core.Core/getActiveImpersonation"
+	Core_GetImpersonationSessionList_FullMethodName         = "/core.Core/getImpersonationSessionList"
 	Core_GetPublicTenantList_FullMethodName                 = "/core.Core/getPublicTenantList"
+	Core_RegisterTenantInitPlugin_FullMethodName            = "/core.Core/registerTenantInitPlugin"
+	Core_UpdateTenantInitPlugin_FullMethodName              = "/core.Core/updateTenantInitPlugin"
+	Core_GetTenantInitPluginList_FullMethodName             = "/core.Core/getTenantInitPluginList"
+	Core_GetTenantInitPluginById_FullMethodName             = "/core.Core/getTenantInitPluginById"
+	Core_DeleteTenantInitPlugin_FullMethodName              = "/core.Core/deleteTenantInitPlugin"
+	Core_CreateTenantInitTemplate_FullMethodName            = "/core.Core/createTenantInitTemplate"
+	Core_UpdateTenantInitTemplate_FullMethodName            = "/core.Core/updateTenantInitTemplate"
+	Core_GetTenantInitTemplateList_FullMethodName           = "/core.Core/getTenantInitTemplateList"
+	Core_GetTenantInitTemplateById_FullMethodName           = "/core.Core/getTenantInitTemplateById"
+	Core_DeleteTenantInitTemplate_FullMethodName            = "/core.Core/deleteTenantInitTemplate"
+	Core_PreviewTenantInitTemplate_FullMethodName           = "/core.Core/previewTenantInitTemplate"
 	Core_CreateToken_FullMethodName                         = "/core.Core/createToken"
 	Core_DeleteToken_FullMethodName                         = "/core.Core/deleteToken"
 	Core_GetTokenList_FullMethodName                        = "/core.Core/getTokenList"
 	Core_GetTokenById_FullMethodName                        = "/core.Core/getTokenById"
 	Core_BlockUserAllToken_FullMethodName                   = "/core.Core/blockUserAllToken"
 	Core_UpdateToken_FullMethodName                         = "/core.Core/updateToken"
+	Core_GetUserSessionList_FullMethodName                  = "/core.Core/getUserSessionList"
+	Core_RevokeUserSession_FullMethodName                   = "/core.Core/revokeUserSession"
+	Core_TouchToken_FullMethodName                          = "/core.Core/touchToken"
 	Core_CreateUser_FullMethodName                          = "/core.Core/createUser"
 	Core_UpdateUser_FullMethodName                          = "/core.Core/updateUser"
 	Core_GetUserList_FullMethodName                         = "/core.Core/getUserList"
@@ -149,21 +234,35 @@ type CoreClient interface {
 	GetApiById(ctx context.Context, in *IDReq, opts ...grpc.CallOption) (*ApiInfo, error)
 	//  group: api
 	DeleteApi(ctx context.Context, in *IDsReq, opts ...grpc.CallOption) (*BaseResp, error)
-	// AuditLog management
-	// group: auditlog
+	//  group: api
+	ApiRegistration(ctx context.Context, in *ApiRegistrationReq, opts ...grpc.CallOption) (*ApiRegistrationResp, error)
+	//  AuditLog management
+	//  group: auditlog
 	CreateAuditLog(ctx context.Context, in *AuditLogInfo, opts ...grpc.CallOption) (*BaseUUIDResp, error)
-	// group: auditlog
+	//  group: auditlog
 	GetAuditLogList(ctx context.Context, in *AuditLogListReq, opts ...grpc.CallOption) (*AuditLogListResp, error)
-	// group: auditlog
+	//  group: auditlog
 	GetAuditLogById(ctx context.Context, in *UUIDReq, opts ...grpc.CallOption) (*AuditLogInfo, error)
-	// group: auditlog
-	DeleteAuditLog(ctx context.Context, in *UUIDsReq, opts ...grpc.CallOption) (*BaseResp, error)
-	// group: auditlog
+	//  group: auditlog
 	GetAuditLogStats(ctx context.Context, in *AuditLogStatsReq, opts ...grpc.CallOption) (*AuditLogStatsResp, error)
+	//  group: auditlog
+	VerifyAuditLogChain(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*AuditLogVerifyResp, error)
+	//  group: auditlog
+	GetAuditLogArchiveList(ctx context.Context, in *AuditLogArchiveListReq, opts ...grpc.CallOption) (*AuditLogArchiveListResp, error)
+	//  group: auditlog
+	RestoreAuditLogRange(ctx context.Context, in *AuditLogListReq, opts ...grpc.CallOption) (*AuditLogListResp, error)
 	//  group: authority
 	GetMenuAuthority(ctx context.Context, in *IDReq, opts ...grpc.CallOption) (*RoleMenuAuthorityResp, error)
 	//  group: authority
 	CreateOrUpdateMenuAuthority(ctx context.Context, in *RoleMenuAuthorityReq, opts ...grpc.CallOption) (*BaseResp, error)
+	//  group: authority
+	GetRolePolicies(ctx context.Context, in *IDReq, opts ...grpc.CallOption) (*RolePolicyListResp, error)
+	//  group: authority
+	ReplaceRolePolicies(ctx context.Context, in *ReplaceRolePoliciesReq, opts ...grpc.CallOption) (*ReplaceRolePoliciesResp, error)
+	//  group: authority
+	ExportPolicyBundle(ctx context.Context, in *PolicyBundleExportReq, opts ...grpc.CallOption) (*PolicyBundleResp, error)
+	//  group: authority
+	ImportPolicyBundle(ctx context.Context, in *PolicyBundleImportReq, opts ...grpc.CallOption) (*PolicyBundleImportResp, error)
 	//  group: base
 	InitDatabase(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*BaseResp, error)
 	//  权限规则管理
@@ -250,6 +349,25 @@ type CoreClient interface {
 	DeleteDictionaryDetail(ctx context.Context, in *IDsReq, opts ...grpc.CallOption) (*BaseResp, error)
 	//  group: dictionarydetail
 	GetDictionaryDetailByDictionaryName(ctx context.Context, in *BaseMsg, opts ...grpc.CallOption) (*DictionaryDetailListResp, error)
+	//  LdapProvider management
+	//  group: ldapprovider
+	CreateLdapProvider(ctx context.Context, in *LdapProviderInfo, opts ...grpc.CallOption) (*BaseIDResp, error)
+	//  group: ldapprovider
+	UpdateLdapProvider(ctx context.Context, in *LdapProviderInfo, opts ...grpc.CallOption) (*BaseResp, error)
+	//  group: ldapprovider
+	GetLdapProviderList(ctx context.Context, in *LdapProviderListReq, opts ...grpc.CallOption) (*LdapProviderListResp, error)
+	//  group: ldapprovider
+	GetLdapProviderById(ctx context.Context, in *IDReq, opts ...grpc.CallOption) (*LdapProviderInfo, error)
+	//  group: ldapprovider
+	DeleteLdapProvider(ctx context.Context, in *IDsReq, opts ...grpc.CallOption) (*BaseResp, error)
+	//  group: ldapprovider
+	LdapLogin(ctx context.Context, in *LdapLoginReq, opts ...grpc.CallOption) (*UserInfo, error)
+	//  group: ldapprovider
+	SyncLdapProvider(ctx context.Context, in *LdapSyncReq, opts ...grpc.CallOption) (*LdapSyncRunInfo, error)
+	//  group: ldapprovider
+	GetLdapSyncRunList(ctx context.Context, in *LdapSyncRunListReq, opts ...grpc.CallOption) (*LdapSyncRunListResp, error)
+	//  group: ldapprovider
+	GetLdapSyncRunById(ctx context.Context, in *IDReq, opts ...grpc.CallOption) (*LdapSyncRunInfo, error)
 	//  group: menu
 	CreateMenu(ctx context.Context, in *MenuInfo, opts ...grpc.CallOption) (*BaseIDResp, error)
 	//  group: menu
@@ -262,6 +380,31 @@ type CoreClient interface {
 	GetMenuListByRole(ctx context.Context, in *BaseMsg, opts ...grpc.CallOption) (*MenuInfoList, error)
 	//  group: menu
 	GetMenuList(ctx context.Context, in *PageInfoReq, opts ...grpc.CallOption) (*MenuInfoList, error)
+	//  OauthClient management
+	//  group: oauthclient
+	CreateOauthClient(ctx context.Context, in *OauthClientInfo, opts ...grpc.CallOption) (*OauthClientSecretResp, error)
+	//  group: oauthclient
+	UpdateOauthClient(ctx context.Context, in *OauthClientInfo, opts ...grpc.CallOption) (*BaseResp, error)
+	//  group: oauthclient
+	GetOauthClientList(ctx context.Context, in *OauthClientListReq, opts ...grpc.CallOption) (*OauthClientListResp, error)
+	//  group: oauthclient
+	GetOauthClientById(ctx context.Context, in *IDReq, opts ...grpc.CallOption) (*OauthClientInfo, error)
+	//  group: oauthclient
+	DeleteOauthClient(ctx context.Context, in *IDsReq, opts ...grpc.CallOption) (*BaseResp, error)
+	//  group: oauthclient
+	ResetOauthClientSecret(ctx context.Context, in *IDReq, opts ...grpc.CallOption) (*OauthClientSecretResp, error)
+	//  group: oauthclient
+	GetOauthClientByClientId(ctx context.Context, in *OauthClientIdReq, opts ...grpc.CallOption) (*OauthClientInfo, error)
+	//  group: oauthclient
+	AuthenticateOauthClient(ctx context.Context, in *OauthClientAuthReq, opts ...grpc.CallOption) (*OauthClientInfo, error)
+	//  group: oauthclient
+	AuthorizeOauthClient(ctx context.Context, in *OauthAuthorizeReq, opts ...grpc.CallOption) (*OauthAuthorizeResp, error)
+	//  group: oauthclient
+	ExchangeOauthAuthorizationCode(ctx context.Context, in *OauthCodeExchangeReq, opts ...grpc.CallOption) (*OauthGrantInfo, error)
+	//  group: oauthclient
+	GetOauthConsentList(ctx context.Context, in *OauthConsentListReq, opts ...grpc.CallOption) (*OauthConsentListResp, error)
+	//  group: oauthclient
+	DeleteOauthConsent(ctx context.Context, in *IDsReq, opts ...grpc.CallOption) (*BaseResp, error)
 	//  OauthProvider management
 	//  group: oauthprovider
 	CreateOauthProvider(ctx context.Context, in *OauthProviderInfo, opts ...grpc.CallOption) (*BaseIDResp, error)
@@ -276,7 +419,11 @@ type CoreClient interface {
 	//  group: oauthprovider
 	OauthLogin(ctx context.Context, in *OauthLoginReq, opts ...grpc.CallOption) (*OauthRedirectResp, error)
 	//  group: oauthprovider
-	OauthCallback(ctx context.Context, in *CallbackReq, opts ...grpc.CallOption) (*UserInfo, error)
+	OauthCallback(ctx context.Context, in *CallbackReq, opts ...grpc.CallOption) (*OauthCallbackResp, error)
+	//  group: oauthprovider
+	PreviewOauthClaimMapping(ctx context.Context, in *OauthClaimMappingPreviewReq, opts ...grpc.CallOption) (*OauthClaimMappingPreviewResp, error)
+	//  group: oauthprovider
+	OauthWebhook(ctx context.Context, in *OauthWebhookReq, opts ...grpc.CallOption) (*OauthWebhookResp, error)
 	//  OAuth Account Binding management
 	//  group: oauthaccount
 	CreateOauthAccount(ctx context.Context, in *OauthAccountInfo, opts ...grpc.CallOption) (*BaseIDResp, error)
@@ -294,6 +441,8 @@ type CoreClient interface {
 	UnbindOauthAccount(ctx context.Context, in *UnbindOauthAccountReq, opts ...grpc.CallOption) (*BaseResp, error)
 	//  group: oauthaccount
 	GetUserOauthAccounts(ctx context.Context, in *GetUserOauthAccountsReq, opts ...grpc.CallOption) (*GetUserOauthAccountsResp, error)
+	//  group: oauthaccount
+	GetOauthAccessToken(ctx context.Context, in *OauthAccessTokenReq, opts ...grpc.CallOption) (*OauthAccessTokenResp, error)
 	//  OAuth Session management
 	//  group: oauthsession
 	CreateOauthSession(ctx context.Context, in *CreateOauthSessionReq, opts ...grpc.CallOption) (*BaseIDResp, error)
@@ -303,6 +452,30 @@ type CoreClient interface {
 	GetOauthSessionByState(ctx context.Context, in *GetOauthSessionByStateReq, opts ...grpc.CallOption) (*OauthSessionInfo, error)
 	//  group: oauthsession
 	DeleteOauthSession(ctx context.Context, in *IDReq, opts ...grpc.CallOption) (*BaseResp, error)
+	//  OauthProviderTemplate management
+	//  group: oauthprovidertemplate
+	CreateOauthProviderTemplate(ctx context.Context, in *OauthProviderTemplateInfo, opts ...grpc.CallOption) (*BaseIDResp, error)
+	//  group: oauthprovidertemplate
+	UpdateOauthProviderTemplate(ctx context.Context, in *OauthProviderTemplateInfo, opts ...grpc.CallOption) (*BaseResp, error)
+	//  group: oauthprovidertemplate
+	GetOauthProviderTemplateList(ctx context.Context, in *OauthProviderTemplateListReq, opts ...grpc.CallOption) (*OauthProviderTemplateListResp, error)
+	//  group: oauthprovidertemplate
+	GetOauthProviderTemplateById(ctx context.Context, in *IDReq, opts ...grpc.CallOption) (*OauthProviderTemplateInfo, error)
+	//  group: oauthprovidertemplate
+	DeleteOauthProviderTemplate(ctx context.Context, in *IDsReq, opts ...grpc.CallOption) (*BaseResp, error)
+	//  group: oauthprovidertemplate
+	EnableOauthProviderTemplate(ctx context.Context, in *EnableOauthProviderTemplateReq, opts ...grpc.CallOption) (*BaseIDResp, error)
+	//  OauthScope management
+	//  group: oauthscope
+	CreateOauthScope(ctx context.Context, in *OauthScopeInfo, opts ...grpc.CallOption) (*BaseIDResp, error)
+	//  group: oauthscope
+	UpdateOauthScope(ctx context.Context, in *OauthScopeInfo, opts ...grpc.CallOption) (*BaseResp, error)
+	//  group: oauthscope
+	GetOauthScopeList(ctx context.Context, in *OauthScopeListReq, opts ...grpc.CallOption) (*OauthScopeListResp, error)
+	//  group: oauthscope
+	GetOauthScopeById(ctx context.Context, in *IDReq, opts ...grpc.CallOption) (*OauthScopeInfo, error)
+	//  group: oauthscope
+	DeleteOauthScope(ctx context.Context, in *IDsReq, opts ...grpc.CallOption) (*BaseResp, error)
 	//  Position management
 	//  group: position
 	CreatePosition(ctx context.Context, in *PositionInfo, opts ...grpc.CallOption) (*BaseIDResp, error)
@@ -325,6 +498,9 @@ type CoreClient interface {
 	GetRoleById(ctx context.Context, in *IDReq, opts ...grpc.CallOption) (*RoleInfo, error)
 	//  group: role
 	DeleteRole(ctx context.Context, in *IDsReq, opts ...grpc.CallOption) (*BaseResp, error)
+	//  Delete the role after removing it from its users
+	//  group: role
+	DeleteRoleWithUsers(ctx context.Context, in *IDReq, opts ...grpc.CallOption) (*BaseResp, error)
 	//  group: role
 	InitRoleDataPermToRedis(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*BaseResp, error)
 	//  group: role
@@ -335,6 +511,44 @@ type CoreClient interface {
 	AddAuth(ctx context.Context, in *RoleAuthReq, opts ...grpc.CallOption) (*BaseResp, error)
 	//  group: role
 	ChangeRoleStatus(ctx context.Context, in *RoleStatusChangeParam, opts ...grpc.CallOption) (*BaseResp, error)
+	//  group: role
+	SetRoleParents(ctx context.Context, in *RoleParentsReq, opts ...grpc.CallOption) (*BaseResp, error)
+	//  group: role
+	GetRoleParents(ctx context.Context, in *IDReq, opts ...grpc.CallOption) (*RoleParentsResp, error)
+	//  group: role
+	GetRoleEffectivePermissions(ctx context.Context, in *IDReq, opts ...grpc.CallOption) (*RoleEffectivePermissionsResp, error)
+	//  SamlProvider management
+	//  group: samlprovider
+	CreateSamlProvider(ctx context.Context, in *SamlProviderInfo, opts ...grpc.CallOption) (*BaseIDResp, error)
+	//  group: samlprovider
+	UpdateSamlProvider(ctx context.Context, in *SamlProviderInfo, opts ...grpc.CallOption) (*BaseResp, error)
+	//  group: samlprovider
+	GetSamlProviderList(ctx context.Context, in *SamlProviderListReq, opts ...grpc.CallOption) (*SamlProviderListResp, error)
+	//  group: samlprovider
+	GetSamlProviderById(ctx context.Context, in *IDReq, opts ...grpc.CallOption) (*SamlProviderInfo, error)
+	//  group: samlprovider
+	DeleteSamlProvider(ctx context.Context, in *IDsReq, opts ...grpc.CallOption) (*BaseResp, error)
+	//  group: samlprovider
+	ImportSamlIdpMetadata(ctx context.Context, in *SamlMetadataImportReq, opts ...grpc.CallOption) (*BaseResp, error)
+	//  group: samlprovider
+	GetSamlSpMetadata(ctx context.Context, in *SamlSpMetadataReq, opts ...grpc.CallOption) (*SamlSpMetadataResp, error)
+	//  group: samlprovider
+	SamlLogin(ctx context.Context, in *SamlLoginReq, opts ...grpc.CallOption) (*SamlLoginResp, error)
+	//  group: samlprovider
+	SamlAcs(ctx context.Context, in *SamlAcsReq, opts ...grpc.CallOption) (*SamlAcsResp, error)
+	//  ScimToken management
+	//  group: scimtoken
+	CreateScimToken(ctx context.Context, in *ScimTokenInfo, opts ...grpc.CallOption) (*ScimTokenCreateResp, error)
+	//  group: scimtoken
+	UpdateScimToken(ctx context.Context, in *ScimTokenInfo, opts ...grpc.CallOption) (*BaseResp, error)
+	//  group: scimtoken
+	GetScimTokenList(ctx context.Context, in *ScimTokenListReq, opts ...grpc.CallOption) (*ScimTokenListResp, error)
+	//  group: scimtoken
+	GetScimTokenById(ctx context.Context, in *IDReq, opts ...grpc.CallOption) (*ScimTokenInfo, error)
+	//  group: scimtoken
+	DeleteScimToken(ctx context.Context, in *IDsReq, opts ...grpc.CallOption) (*BaseResp, error)
+	//  group: scimtoken
+	AuthenticateScimToken(ctx context.Context, in *ScimTokenAuthReq, opts ...grpc.CallOption) (*ScimTokenInfo, error)
 	//  Tenant management
 	//  group: tenant
 	CreateTenant(ctx context.Context, in *TenantInfo, opts ...grpc.CallOption) (*BaseIDResp, error)
@@ -351,9 +565,53 @@ type CoreClient interface {
 	//  group: tenant
 	UpdateTenantStatus(ctx context.Context, in *TenantStatusReq, opts ...grpc.CallOption) (*BaseResp, error)
 	//  group: tenant
-	InitTenant(ctx context.Context, in *TenantInitReq, opts ...grpc.CallOption) (*BaseResp, error)
+	InitTenant(ctx context.Context, in *TenantInitReq, opts ...grpc.CallOption) (*BaseIDResp, error)
+	//  group: tenant
+	GetTenantInitJobById(ctx context.Context, in *IDReq, opts ...grpc.CallOption) (*TenantInitJobInfo, error)
+	//  group: tenant
+	GetTenantInitJobList(ctx context.Context, in *TenantInitJobListReq, opts ...grpc.CallOption) (*TenantInitJobListResp, error)
+	//  group: tenant
+	RetryTenantInitJob(ctx context.Context, in *TenantInitJobRetryReq, opts ...grpc.CallOption) (*BaseIDResp, error)
+	//  group: tenant
+	DiagnoseTenants(ctx context.Context, in *TenantDoctorReq, opts ...grpc.CallOption) (*TenantDoctorResp, error)
+	//  group: tenant
+	RepairTenant(ctx context.Context, in *TenantRepairReq, opts ...grpc.CallOption) (*TenantDoctorResp, error)
+	//  group: tenant
+	StartImpersonation(ctx context.Context, in *ImpersonationStartReq, opts ...grpc.CallOption) (*ImpersonationSessionInfo, error)
+	//  group: tenant
+	ReviewImpersonation(ctx context.Context, in *ImpersonationReviewReq, opts ...grpc.CallOption) (*ImpersonationSessionInfo, error)
+	//  group: tenant
+	EndImpersonation(ctx context.Context, in *UUIDReq, opts ...grpc.CallOption) (*BaseResp, error)
+	//  group: tenant
+	GetActiveImpersonation(ctx context.Context, in *UUIDReq, opts ...grpc.CallOption) (*ImpersonationSessionInfo, error)
+	//  group: tenant
+	GetImpersonationSessionList(ctx context.Context, in *ImpersonationSessionListReq, opts ...grpc.CallOption) (*ImpersonationSessionListResp, error)
 	//  group: public
 	GetPublicTenantList(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*PublicTenantListResp, error)
+	//  TenantInitPlugin management
+	//  group: tenantinitplugin
+	RegisterTenantInitPlugin(ctx context.Context, in *TenantInitPluginInfo, opts ...grpc.CallOption) (*BaseIDResp, error)
+	//  group: tenantinitplugin
+	UpdateTenantInitPlugin(ctx context.Context, in *TenantInitPluginInfo, opts ...grpc.CallOption) (*BaseResp, error)
+	//  group: tenantinitplugin
+	GetTenantInitPluginList(ctx context.Context, in *TenantInitPluginListReq, opts ...grpc.CallOption) (*TenantInitPluginListResp, error)
+	//  group: tenantinitplugin
+	GetTenantInitPluginById(ctx context.Context, in *IDReq, opts ...grpc.CallOption) (*TenantInitPluginInfo, error)
+	//  group: tenantinitplugin
+	DeleteTenantInitPlugin(ctx context.Context, in *IDsReq, opts ...grpc.CallOption) (*BaseResp, error)
+	//  TenantInitTemplate management
+	//  group: tenantinittemplate
+	CreateTenantInitTemplate(ctx context.Context, in *TenantInitTemplateInfo, opts ...grpc.CallOption) (*BaseIDResp, error)
+	//  group: tenantinittemplate
+	UpdateTenantInitTemplate(ctx context.Context, in *TenantInitTemplateInfo, opts ...grpc.CallOption) (*BaseResp, error)
+	//  group: tenantinittemplate
+	GetTenantInitTemplateList(ctx context.Context, in *TenantInitTemplateListReq, opts ...grpc.CallOption) (*TenantInitTemplateListResp, error)
+	//  group: tenantinittemplate
+	GetTenantInitTemplateById(ctx context.Context, in *IDReq, opts ...grpc.CallOption) (*TenantInitTemplateInfo, error)
+	//  group: tenantinittemplate
+	DeleteTenantInitTemplate(ctx context.Context, in *IDsReq, opts ...grpc.CallOption) (*BaseResp, error)
+	//  group: tenantinittemplate
+	PreviewTenantInitTemplate(ctx context.Context, in *TenantInitTemplatePreviewReq, opts ...grpc.CallOption) (*TenantInitTemplatePreviewResp, error)
 	//  Token management
 	//  group: token
 	CreateToken(ctx context.Context, in *TokenInfo, opts ...grpc.CallOption) (*BaseUUIDResp, error)
@@ -367,6 +625,12 @@ type CoreClient interface {
 	BlockUserAllToken(ctx context.Context, in *UUIDReq, opts ...grpc.CallOption) (*BaseResp, error)
 	//  group: token
 	UpdateToken(ctx context.Context, in *TokenInfo, opts ...grpc.CallOption) (*BaseResp, error)
+	//  group: token
+	GetUserSessionList(ctx context.Context, in *UserSessionListReq, opts ...grpc.CallOption) (*TokenListResp, error)
+	//  group: token
+	RevokeUserSession(ctx context.Context, in *UserSessionRevokeReq, opts ...grpc.CallOption) (*BaseResp, error)
+	//  group: token
+	TouchToken(ctx context.Context, in *TokenTouchReq, opts ...grpc.CallOption) (*BaseResp, error)
 	//  User management
 	//  group: user
 	CreateUser(ctx context.Context, in *UserInfo, opts ...grpc.CallOption) (*BaseUUIDResp, error)
@@ -444,6 +708,16 @@ func (c *coreClient) DeleteApi(ctx context.Context, in *IDsReq, opts ...grpc.Cal
 	return out, nil
 }
 
+func (c *coreClient) ApiRegistration(ctx context.Context, in *ApiRegistrationReq, opts ...grpc.CallOption) (*ApiRegistrationResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApiRegistrationResp)
+	err := c.cc.Invoke(ctx, Core_ApiRegistration_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *coreClient) CreateAuditLog(ctx context.Context, in *AuditLogInfo, opts ...grpc.CallOption) (*BaseUUIDResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(BaseUUIDResp)
@@ -474,20 +748,40 @@ func (c *coreClient) GetAuditLogById(ctx context.Context, in *UUIDReq, opts ...g
 	return out, nil
 }
 
-func (c *coreClient) DeleteAuditLog(ctx context.Context, in *UUIDsReq, opts ...grpc.CallOption) (*BaseResp, error) {
+func (c *coreClient) GetAuditLogStats(ctx context.Context, in *AuditLogStatsReq, opts ...grpc.CallOption) (*AuditLogStatsResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(BaseResp)
-	err := c.cc.Invoke(ctx, Core_DeleteAuditLog_FullMethodName, in, out, cOpts...)
+	out := new(AuditLogStatsResp)
+	err := c.cc.Invoke(ctx, Core_GetAuditLogStats_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *coreClient) GetAuditLogStats(ctx context.Context, in *AuditLogStatsReq, opts ...grpc.CallOption) (*AuditLogStatsResp, error) {
+func (c *coreClient) VerifyAuditLogChain(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*AuditLogVerifyResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(AuditLogStatsResp)
-	err := c.cc.Invoke(ctx, Core_GetAuditLogStats_FullMethodName, in, out, cOpts...)
+	out := new(AuditLogVerifyResp)
+	err := c.cc.Invoke(ctx, Core_VerifyAuditLogChain_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) GetAuditLogArchiveList(ctx context.Context, in *AuditLogArchiveListReq, opts ...grpc.CallOption) (*AuditLogArchiveListResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AuditLogArchiveListResp)
+	err := c.cc.Invoke(ctx, Core_GetAuditLogArchiveList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) RestoreAuditLogRange(ctx context.Context, in *AuditLogListReq, opts ...grpc.CallOption) (*AuditLogListResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AuditLogListResp)
+	err := c.cc.Invoke(ctx, Core_RestoreAuditLogRange_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -514,6 +808,46 @@ func (c *coreClient) CreateOrUpdateMenuAuthority(ctx context.Context, in *RoleMe
 	return out, nil
 }
 
+func (c *coreClient) GetRolePolicies(ctx context.Context, in *IDReq, opts ...grpc.CallOption) (*RolePolicyListResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RolePolicyListResp)
+	err := c.cc.Invoke(ctx, Core_GetRolePolicies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) ReplaceRolePolicies(ctx context.Context, in *ReplaceRolePoliciesReq, opts ...grpc.CallOption) (*ReplaceRolePoliciesResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReplaceRolePoliciesResp)
+	err := c.cc.Invoke(ctx, Core_ReplaceRolePolicies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) ExportPolicyBundle(ctx context.Context, in *PolicyBundleExportReq, opts ...grpc.CallOption) (*PolicyBundleResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PolicyBundleResp)
+	err := c.cc.Invoke(ctx, Core_ExportPolicyBundle_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) ImportPolicyBundle(ctx context.Context, in *PolicyBundleImportReq, opts ...grpc.CallOption) (*PolicyBundleImportResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PolicyBundleImportResp)
+	err := c.cc.Invoke(ctx, Core_ImportPolicyBundle_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *coreClient) InitDatabase(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*BaseResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(BaseResp)
@@ -894,752 +1228,1565 @@ func (c *coreClient) GetDictionaryDetailByDictionaryName(ctx context.Context, in
 	return out, nil
 }
 
-func (c *coreClient) CreateMenu(ctx context.Context, in *MenuInfo, opts ...grpc.CallOption) (*BaseIDResp, error) {
+func (c *coreClient) CreateLdapProvider(ctx context.Context, in *LdapProviderInfo, opts ...grpc.CallOption) (*BaseIDResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(BaseIDResp)
-	err := c.cc.Invoke(ctx, Core_CreateMenu_FullMethodName, in, out, cOpts...)
+	err := c.cc.Invoke(ctx, Core_CreateLdapProvider_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *coreClient) UpdateMenu(ctx context.Context, in *MenuInfo, opts ...grpc.CallOption) (*BaseResp, error) {
+func (c *coreClient) UpdateLdapProvider(ctx context.Context, in *LdapProviderInfo, opts ...grpc.CallOption) (*BaseResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(BaseResp)
-	err := c.cc.Invoke(ctx, Core_UpdateMenu_FullMethodName, in, out, cOpts...)
+	err := c.cc.Invoke(ctx, Core_UpdateLdapProvider_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *coreClient) DeleteMenu(ctx context.Context, in *IDReq, opts ...grpc.CallOption) (*BaseResp, error) {
+func (c *coreClient) GetLdapProviderList(ctx context.Context, in *LdapProviderListReq, opts ...grpc.CallOption) (*LdapProviderListResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(BaseResp)
-	err := c.cc.Invoke(ctx, Core_DeleteMenu_FullMethodName, in, out, cOpts...)
+	out := new(LdapProviderListResp)
+	err := c.cc.Invoke(ctx, Core_GetLdapProviderList_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *coreClient) GetMenu(ctx context.Context, in *IDReq, opts ...grpc.CallOption) (*MenuInfo, error) {
+func (c *coreClient) GetLdapProviderById(ctx context.Context, in *IDReq, opts ...grpc.CallOption) (*LdapProviderInfo, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(MenuInfo)
-	err := c.cc.Invoke(ctx, Core_GetMenu_FullMethodName, in, out, cOpts...)
+	out := new(LdapProviderInfo)
+	err := c.cc.Invoke(ctx, Core_GetLdapProviderById_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *coreClient) GetMenuListByRole(ctx context.Context, in *BaseMsg, opts ...grpc.CallOption) (*MenuInfoList, error) {
+func (c *coreClient) DeleteLdapProvider(ctx context.Context, in *IDsReq, opts ...grpc.CallOption) (*BaseResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(MenuInfoList)
-	err := c.cc.Invoke(ctx, Core_GetMenuListByRole_FullMethodName, in, out, cOpts...)
+	out := new(BaseResp)
+	err := c.cc.Invoke(ctx, Core_DeleteLdapProvider_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *coreClient) GetMenuList(ctx context.Context, in *PageInfoReq, opts ...grpc.CallOption) (*MenuInfoList, error) {
+func (c *coreClient) LdapLogin(ctx context.Context, in *LdapLoginReq, opts ...grpc.CallOption) (*UserInfo, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(MenuInfoList)
-	err := c.cc.Invoke(ctx, Core_GetMenuList_FullMethodName, in, out, cOpts...)
+	out := new(UserInfo)
+	err := c.cc.Invoke(ctx, Core_LdapLogin_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *coreClient) CreateOauthProvider(ctx context.Context, in *OauthProviderInfo, opts ...grpc.CallOption) (*BaseIDResp, error) {
+func (c *coreClient) SyncLdapProvider(ctx context.Context, in *LdapSyncReq, opts ...grpc.CallOption) (*LdapSyncRunInfo, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(BaseIDResp)
-	err := c.cc.Invoke(ctx, Core_CreateOauthProvider_FullMethodName, in, out, cOpts...)
+	out := new(LdapSyncRunInfo)
+	err := c.cc.Invoke(ctx, Core_SyncLdapProvider_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *coreClient) UpdateOauthProvider(ctx context.Context, in *OauthProviderInfo, opts ...grpc.CallOption) (*BaseResp, error) {
+func (c *coreClient) GetLdapSyncRunList(ctx context.Context, in *LdapSyncRunListReq, opts ...grpc.CallOption) (*LdapSyncRunListResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(BaseResp)
-	err := c.cc.Invoke(ctx, Core_UpdateOauthProvider_FullMethodName, in, out, cOpts...)
+	out := new(LdapSyncRunListResp)
+	err := c.cc.Invoke(ctx, Core_GetLdapSyncRunList_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *coreClient) GetOauthProviderList(ctx context.Context, in *OauthProviderListReq, opts ...grpc.CallOption) (*OauthProviderListResp, error) {
+func (c *coreClient) GetLdapSyncRunById(ctx context.Context, in *IDReq, opts ...grpc.CallOption) (*LdapSyncRunInfo, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(OauthProviderListResp)
-	err := c.cc.Invoke(ctx, Core_GetOauthProviderList_FullMethodName, in, out, cOpts...)
+	out := new(LdapSyncRunInfo)
+	err := c.cc.Invoke(ctx, Core_GetLdapSyncRunById_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *coreClient) GetOauthProviderById(ctx context.Context, in *IDReq, opts ...grpc.CallOption) (*OauthProviderInfo, error) {
+func (c *coreClient) CreateMenu(ctx context.Context, in *MenuInfo, opts ...grpc.CallOption) (*BaseIDResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(OauthProviderInfo)
-	err := c.cc.Invoke(ctx, Core_GetOauthProviderById_FullMethodName, in, out, cOpts...)
+	out := new(BaseIDResp)
+	err := c.cc.Invoke(ctx, Core_CreateMenu_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *coreClient) DeleteOauthProvider(ctx context.Context, in *IDsReq, opts ...grpc.CallOption) (*BaseResp, error) {
+func (c *coreClient) UpdateMenu(ctx context.Context, in *MenuInfo, opts ...grpc.CallOption) (*BaseResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(BaseResp)
-	err := c.cc.Invoke(ctx, Core_DeleteOauthProvider_FullMethodName, in, out, cOpts...)
+	err := c.cc.Invoke(ctx, Core_UpdateMenu_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *coreClient) OauthLogin(ctx context.Context, in *OauthLoginReq, opts ...grpc.CallOption) (*OauthRedirectResp, error) {
+func (c *coreClient) DeleteMenu(ctx context.Context, in *IDReq, opts ...grpc.CallOption) (*BaseResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(OauthRedirectResp)
-	err := c.cc.Invoke(ctx, Core_OauthLogin_FullMethodName, in, out, cOpts...)
+	out := new(BaseResp)
+	err := c.cc.Invoke(ctx, Core_DeleteMenu_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *coreClient) OauthCallback(ctx context.Context, in *CallbackReq, opts ...grpc.CallOption) (*UserInfo, error) {
+func (c *coreClient) GetMenu(ctx context.Context, in *IDReq, opts ...grpc.CallOption) (*MenuInfo, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(UserInfo)
-	err := c.cc.Invoke(ctx, Core_OauthCallback_FullMethodName, in, out, cOpts...)
+	out := new(MenuInfo)
+	err := c.cc.Invoke(ctx, Core_GetMenu_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *coreClient) CreateOauthAccount(ctx context.Context, in *OauthAccountInfo, opts ...grpc.CallOption) (*BaseIDResp, error) {
+func (c *coreClient) GetMenuListByRole(ctx context.Context, in *BaseMsg, opts ...grpc.CallOption) (*MenuInfoList, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(BaseIDResp)
-	err := c.cc.Invoke(ctx, Core_CreateOauthAccount_FullMethodName, in, out, cOpts...)
+	out := new(MenuInfoList)
+	err := c.cc.Invoke(ctx, Core_GetMenuListByRole_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *coreClient) UpdateOauthAccount(ctx context.Context, in *OauthAccountInfo, opts ...grpc.CallOption) (*BaseResp, error) {
+func (c *coreClient) GetMenuList(ctx context.Context, in *PageInfoReq, opts ...grpc.CallOption) (*MenuInfoList, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(BaseResp)
-	err := c.cc.Invoke(ctx, Core_UpdateOauthAccount_FullMethodName, in, out, cOpts...)
+	out := new(MenuInfoList)
+	err := c.cc.Invoke(ctx, Core_GetMenuList_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *coreClient) GetOauthAccountList(ctx context.Context, in *OauthAccountListReq, opts ...grpc.CallOption) (*OauthAccountListResp, error) {
+func (c *coreClient) CreateOauthClient(ctx context.Context, in *OauthClientInfo, opts ...grpc.CallOption) (*OauthClientSecretResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(OauthAccountListResp)
-	err := c.cc.Invoke(ctx, Core_GetOauthAccountList_FullMethodName, in, out, cOpts...)
+	out := new(OauthClientSecretResp)
+	err := c.cc.Invoke(ctx, Core_CreateOauthClient_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *coreClient) GetOauthAccountById(ctx context.Context, in *IDReq, opts ...grpc.CallOption) (*OauthAccountInfo, error) {
+func (c *coreClient) UpdateOauthClient(ctx context.Context, in *OauthClientInfo, opts ...grpc.CallOption) (*BaseResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(OauthAccountInfo)
-	err := c.cc.Invoke(ctx, Core_GetOauthAccountById_FullMethodName, in, out, cOpts...)
+	out := new(BaseResp)
+	err := c.cc.Invoke(ctx, Core_UpdateOauthClient_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *coreClient) DeleteOauthAccount(ctx context.Context, in *IDsReq, opts ...grpc.CallOption) (*BaseResp, error) {
+func (c *coreClient) GetOauthClientList(ctx context.Context, in *OauthClientListReq, opts ...grpc.CallOption) (*OauthClientListResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(BaseResp)
-	err := c.cc.Invoke(ctx, Core_DeleteOauthAccount_FullMethodName, in, out, cOpts...)
+	out := new(OauthClientListResp)
+	err := c.cc.Invoke(ctx, Core_GetOauthClientList_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *coreClient) BindOauthAccount(ctx context.Context, in *BindOauthAccountReq, opts ...grpc.CallOption) (*BaseResp, error) {
+func (c *coreClient) GetOauthClientById(ctx context.Context, in *IDReq, opts ...grpc.CallOption) (*OauthClientInfo, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(BaseResp)
-	err := c.cc.Invoke(ctx, Core_BindOauthAccount_FullMethodName, in, out, cOpts...)
+	out := new(OauthClientInfo)
+	err := c.cc.Invoke(ctx, Core_GetOauthClientById_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *coreClient) UnbindOauthAccount(ctx context.Context, in *UnbindOauthAccountReq, opts ...grpc.CallOption) (*BaseResp, error) {
+func (c *coreClient) DeleteOauthClient(ctx context.Context, in *IDsReq, opts ...grpc.CallOption) (*BaseResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(BaseResp)
-	err := c.cc.Invoke(ctx, Core_UnbindOauthAccount_FullMethodName, in, out, cOpts...)
+	err := c.cc.Invoke(ctx, Core_DeleteOauthClient_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *coreClient) GetUserOauthAccounts(ctx context.Context, in *GetUserOauthAccountsReq, opts ...grpc.CallOption) (*GetUserOauthAccountsResp, error) {
+func (c *coreClient) ResetOauthClientSecret(ctx context.Context, in *IDReq, opts ...grpc.CallOption) (*OauthClientSecretResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GetUserOauthAccountsResp)
-	err := c.cc.Invoke(ctx, Core_GetUserOauthAccounts_FullMethodName, in, out, cOpts...)
+	out := new(OauthClientSecretResp)
+	err := c.cc.Invoke(ctx, Core_ResetOauthClientSecret_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *coreClient) CreateOauthSession(ctx context.Context, in *CreateOauthSessionReq, opts ...grpc.CallOption) (*BaseIDResp, error) {
+func (c *coreClient) GetOauthClientByClientId(ctx context.Context, in *OauthClientIdReq, opts ...grpc.CallOption) (*OauthClientInfo, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(BaseIDResp)
-	err := c.cc.Invoke(ctx, Core_CreateOauthSession_FullMethodName, in, out, cOpts...)
+	out := new(OauthClientInfo)
+	err := c.cc.Invoke(ctx, Core_GetOauthClientByClientId_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *coreClient) UpdateOauthSession(ctx context.Context, in *UpdateOauthSessionReq, opts ...grpc.CallOption) (*BaseResp, error) {
+func (c *coreClient) AuthenticateOauthClient(ctx context.Context, in *OauthClientAuthReq, opts ...grpc.CallOption) (*OauthClientInfo, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(BaseResp)
-	err := c.cc.Invoke(ctx, Core_UpdateOauthSession_FullMethodName, in, out, cOpts...)
+	out := new(OauthClientInfo)
+	err := c.cc.Invoke(ctx, Core_AuthenticateOauthClient_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *coreClient) GetOauthSessionByState(ctx context.Context, in *GetOauthSessionByStateReq, opts ...grpc.CallOption) (*OauthSessionInfo, error) {
+func (c *coreClient) AuthorizeOauthClient(ctx context.Context, in *OauthAuthorizeReq, opts ...grpc.CallOption) (*OauthAuthorizeResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(OauthSessionInfo)
-	err := c.cc.Invoke(ctx, Core_GetOauthSessionByState_FullMethodName, in, out, cOpts...)
+	out := new(OauthAuthorizeResp)
+	err := c.cc.Invoke(ctx, Core_AuthorizeOauthClient_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *coreClient) DeleteOauthSession(ctx context.Context, in *IDReq, opts ...grpc.CallOption) (*BaseResp, error) {
+func (c *coreClient) ExchangeOauthAuthorizationCode(ctx context.Context, in *OauthCodeExchangeReq, opts ...grpc.CallOption) (*OauthGrantInfo, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(BaseResp)
-	err := c.cc.Invoke(ctx, Core_DeleteOauthSession_FullMethodName, in, out, cOpts...)
+	out := new(OauthGrantInfo)
+	err := c.cc.Invoke(ctx, Core_ExchangeOauthAuthorizationCode_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *coreClient) CreatePosition(ctx context.Context, in *PositionInfo, opts ...grpc.CallOption) (*BaseIDResp, error) {
+func (c *coreClient) GetOauthConsentList(ctx context.Context, in *OauthConsentListReq, opts ...grpc.CallOption) (*OauthConsentListResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(BaseIDResp)
-	err := c.cc.Invoke(ctx, Core_CreatePosition_FullMethodName, in, out, cOpts...)
+	out := new(OauthConsentListResp)
+	err := c.cc.Invoke(ctx, Core_GetOauthConsentList_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *coreClient) UpdatePosition(ctx context.Context, in *PositionInfo, opts ...grpc.CallOption) (*BaseResp, error) {
+func (c *coreClient) DeleteOauthConsent(ctx context.Context, in *IDsReq, opts ...grpc.CallOption) (*BaseResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(BaseResp)
-	err := c.cc.Invoke(ctx, Core_UpdatePosition_FullMethodName, in, out, cOpts...)
+	err := c.cc.Invoke(ctx, Core_DeleteOauthConsent_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *coreClient) GetPositionList(ctx context.Context, in *PositionListReq, opts ...grpc.CallOption) (*PositionListResp, error) {
+func (c *coreClient) CreateOauthProvider(ctx context.Context, in *OauthProviderInfo, opts ...grpc.CallOption) (*BaseIDResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(PositionListResp)
-	err := c.cc.Invoke(ctx, Core_GetPositionList_FullMethodName, in, out, cOpts...)
+	out := new(BaseIDResp)
+	err := c.cc.Invoke(ctx, Core_CreateOauthProvider_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *coreClient) GetPositionById(ctx context.Context, in *IDReq, opts ...grpc.CallOption) (*PositionInfo, error) {
+func (c *coreClient) UpdateOauthProvider(ctx context.Context, in *OauthProviderInfo, opts ...grpc.CallOption) (*BaseResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(PositionInfo)
-	err := c.cc.Invoke(ctx, Core_GetPositionById_FullMethodName, in, out, cOpts...)
+	out := new(BaseResp)
+	err := c.cc.Invoke(ctx, Core_UpdateOauthProvider_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *coreClient) DeletePosition(ctx context.Context, in *IDsReq, opts ...grpc.CallOption) (*BaseResp, error) {
+func (c *coreClient) GetOauthProviderList(ctx context.Context, in *OauthProviderListReq, opts ...grpc.CallOption) (*OauthProviderListResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(BaseResp)
-	err := c.cc.Invoke(ctx, Core_DeletePosition_FullMethodName, in, out, cOpts...)
+	out := new(OauthProviderListResp)
+	err := c.cc.Invoke(ctx, Core_GetOauthProviderList_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *coreClient) CreateRole(ctx context.Context, in *RoleInfo, opts ...grpc.CallOption) (*BaseIDResp, error) {
+func (c *coreClient) GetOauthProviderById(ctx context.Context, in *IDReq, opts ...grpc.CallOption) (*OauthProviderInfo, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(BaseIDResp)
-	err := c.cc.Invoke(ctx, Core_CreateRole_FullMethodName, in, out, cOpts...)
+	out := new(OauthProviderInfo)
+	err := c.cc.Invoke(ctx, Core_GetOauthProviderById_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *coreClient) UpdateRole(ctx context.Context, in *RoleInfo, opts ...grpc.CallOption) (*BaseResp, error) {
+func (c *coreClient) DeleteOauthProvider(ctx context.Context, in *IDsReq, opts ...grpc.CallOption) (*BaseResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(BaseResp)
-	err := c.cc.Invoke(ctx, Core_UpdateRole_FullMethodName, in, out, cOpts...)
+	err := c.cc.Invoke(ctx, Core_DeleteOauthProvider_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *coreClient) GetRoleList(ctx context.Context, in *RoleListReq, opts ...grpc.CallOption) (*RoleListResp, error) {
+func (c *coreClient) OauthLogin(ctx context.Context, in *OauthLoginReq, opts ...grpc.CallOption) (*OauthRedirectResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(RoleListResp)
-	err := c.cc.Invoke(ctx, Core_GetRoleList_FullMethodName, in, out, cOpts...)
+	out := new(OauthRedirectResp)
+	err := c.cc.Invoke(ctx, Core_OauthLogin_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *coreClient) GetRoleById(ctx context.Context, in *IDReq, opts ...grpc.CallOption) (*RoleInfo, error) {
+func (c *coreClient) OauthCallback(ctx context.Context, in *CallbackReq, opts ...grpc.CallOption) (*OauthCallbackResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(RoleInfo)
-	err := c.cc.Invoke(ctx, Core_GetRoleById_FullMethodName, in, out, cOpts...)
+	out := new(OauthCallbackResp)
+	err := c.cc.Invoke(ctx, Core_OauthCallback_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *coreClient) DeleteRole(ctx context.Context, in *IDsReq, opts ...grpc.CallOption) (*BaseResp, error) {
+func (c *coreClient) PreviewOauthClaimMapping(ctx context.Context, in *OauthClaimMappingPreviewReq, opts ...grpc.CallOption) (*OauthClaimMappingPreviewResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(BaseResp)
-	err := c.cc.Invoke(ctx, Core_DeleteRole_FullMethodName, in, out, cOpts...)
+	out := new(OauthClaimMappingPreviewResp)
+	err := c.cc.Invoke(ctx, Core_PreviewOauthClaimMapping_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *coreClient) InitRoleDataPermToRedis(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*BaseResp, error) {
+func (c *coreClient) OauthWebhook(ctx context.Context, in *OauthWebhookReq, opts ...grpc.CallOption) (*OauthWebhookResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(BaseResp)
-	err := c.cc.Invoke(ctx, Core_InitRoleDataPermToRedis_FullMethodName, in, out, cOpts...)
+	out := new(OauthWebhookResp)
+	err := c.cc.Invoke(ctx, Core_OauthWebhook_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *coreClient) AssignRoleDataScope(ctx context.Context, in *RoleDataScopeReq, opts ...grpc.CallOption) (*BaseResp, error) {
+func (c *coreClient) CreateOauthAccount(ctx context.Context, in *OauthAccountInfo, opts ...grpc.CallOption) (*BaseIDResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(BaseResp)
-	err := c.cc.Invoke(ctx, Core_AssignRoleDataScope_FullMethodName, in, out, cOpts...)
+	out := new(BaseIDResp)
+	err := c.cc.Invoke(ctx, Core_CreateOauthAccount_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *coreClient) CancelAuth(ctx context.Context, in *RoleAuthReq, opts ...grpc.CallOption) (*BaseResp, error) {
+func (c *coreClient) UpdateOauthAccount(ctx context.Context, in *OauthAccountInfo, opts ...grpc.CallOption) (*BaseResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(BaseResp)
-	err := c.cc.Invoke(ctx, Core_CancelAuth_FullMethodName, in, out, cOpts...)
+	err := c.cc.Invoke(ctx, Core_UpdateOauthAccount_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *coreClient) AddAuth(ctx context.Context, in *RoleAuthReq, opts ...grpc.CallOption) (*BaseResp, error) {
+func (c *coreClient) GetOauthAccountList(ctx context.Context, in *OauthAccountListReq, opts ...grpc.CallOption) (*OauthAccountListResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(BaseResp)
-	err := c.cc.Invoke(ctx, Core_AddAuth_FullMethodName, in, out, cOpts...)
+	out := new(OauthAccountListResp)
+	err := c.cc.Invoke(ctx, Core_GetOauthAccountList_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *coreClient) ChangeRoleStatus(ctx context.Context, in *RoleStatusChangeParam, opts ...grpc.CallOption) (*BaseResp, error) {
+func (c *coreClient) GetOauthAccountById(ctx context.Context, in *IDReq, opts ...grpc.CallOption) (*OauthAccountInfo, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(BaseResp)
-	err := c.cc.Invoke(ctx, Core_ChangeRoleStatus_FullMethodName, in, out, cOpts...)
+	out := new(OauthAccountInfo)
+	err := c.cc.Invoke(ctx, Core_GetOauthAccountById_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *coreClient) CreateTenant(ctx context.Context, in *TenantInfo, opts ...grpc.CallOption) (*BaseIDResp, error) {
+func (c *coreClient) DeleteOauthAccount(ctx context.Context, in *IDsReq, opts ...grpc.CallOption) (*BaseResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(BaseIDResp)
-	err := c.cc.Invoke(ctx, Core_CreateTenant_FullMethodName, in, out, cOpts...)
+	out := new(BaseResp)
+	err := c.cc.Invoke(ctx, Core_DeleteOauthAccount_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *coreClient) UpdateTenant(ctx context.Context, in *TenantInfo, opts ...grpc.CallOption) (*BaseResp, error) {
+func (c *coreClient) BindOauthAccount(ctx context.Context, in *BindOauthAccountReq, opts ...grpc.CallOption) (*BaseResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(BaseResp)
-	err := c.cc.Invoke(ctx, Core_UpdateTenant_FullMethodName, in, out, cOpts...)
+	err := c.cc.Invoke(ctx, Core_BindOauthAccount_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *coreClient) GetTenantList(ctx context.Context, in *TenantListReq, opts ...grpc.CallOption) (*TenantListResp, error) {
+func (c *coreClient) UnbindOauthAccount(ctx context.Context, in *UnbindOauthAccountReq, opts ...grpc.CallOption) (*BaseResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(TenantListResp)
-	err := c.cc.Invoke(ctx, Core_GetTenantList_FullMethodName, in, out, cOpts...)
+	out := new(BaseResp)
+	err := c.cc.Invoke(ctx, Core_UnbindOauthAccount_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *coreClient) GetTenantById(ctx context.Context, in *IDReq, opts ...grpc.CallOption) (*TenantInfo, error) {
+func (c *coreClient) GetUserOauthAccounts(ctx context.Context, in *GetUserOauthAccountsReq, opts ...grpc.CallOption) (*GetUserOauthAccountsResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(TenantInfo)
-	err := c.cc.Invoke(ctx, Core_GetTenantById_FullMethodName, in, out, cOpts...)
+	out := new(GetUserOauthAccountsResp)
+	err := c.cc.Invoke(ctx, Core_GetUserOauthAccounts_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *coreClient) GetTenantByCode(ctx context.Context, in *TenantCodeReq, opts ...grpc.CallOption) (*TenantInfo, error) {
+func (c *coreClient) GetOauthAccessToken(ctx context.Context, in *OauthAccessTokenReq, opts ...grpc.CallOption) (*OauthAccessTokenResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(TenantInfo)
-	err := c.cc.Invoke(ctx, Core_GetTenantByCode_FullMethodName, in, out, cOpts...)
+	out := new(OauthAccessTokenResp)
+	err := c.cc.Invoke(ctx, Core_GetOauthAccessToken_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *coreClient) DeleteTenant(ctx context.Context, in *IDsReq, opts ...grpc.CallOption) (*BaseResp, error) {
+func (c *coreClient) CreateOauthSession(ctx context.Context, in *CreateOauthSessionReq, opts ...grpc.CallOption) (*BaseIDResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BaseIDResp)
+	err := c.cc.Invoke(ctx, Core_CreateOauthSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) UpdateOauthSession(ctx context.Context, in *UpdateOauthSessionReq, opts ...grpc.CallOption) (*BaseResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(BaseResp)
-	err := c.cc.Invoke(ctx, Core_DeleteTenant_FullMethodName, in, out, cOpts...)
+	err := c.cc.Invoke(ctx, Core_UpdateOauthSession_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *coreClient) UpdateTenantStatus(ctx context.Context, in *TenantStatusReq, opts ...grpc.CallOption) (*BaseResp, error) {
+func (c *coreClient) GetOauthSessionByState(ctx context.Context, in *GetOauthSessionByStateReq, opts ...grpc.CallOption) (*OauthSessionInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OauthSessionInfo)
+	err := c.cc.Invoke(ctx, Core_GetOauthSessionByState_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) DeleteOauthSession(ctx context.Context, in *IDReq, opts ...grpc.CallOption) (*BaseResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(BaseResp)
-	err := c.cc.Invoke(ctx, Core_UpdateTenantStatus_FullMethodName, in, out, cOpts...)
+	err := c.cc.Invoke(ctx, Core_DeleteOauthSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) CreateOauthProviderTemplate(ctx context.Context, in *OauthProviderTemplateInfo, opts ...grpc.CallOption) (*BaseIDResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BaseIDResp)
+	err := c.cc.Invoke(ctx, Core_CreateOauthProviderTemplate_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *coreClient) InitTenant(ctx context.Context, in *TenantInitReq, opts ...grpc.CallOption) (*BaseResp, error) {
+func (c *coreClient) UpdateOauthProviderTemplate(ctx context.Context, in *OauthProviderTemplateInfo, opts ...grpc.CallOption) (*BaseResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(BaseResp)
-	err := c.cc.Invoke(ctx, Core_InitTenant_FullMethodName, in, out, cOpts...)
+	err := c.cc.Invoke(ctx, Core_UpdateOauthProviderTemplate_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *coreClient) GetPublicTenantList(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*PublicTenantListResp, error) {
+func (c *coreClient) GetOauthProviderTemplateList(ctx context.Context, in *OauthProviderTemplateListReq, opts ...grpc.CallOption) (*OauthProviderTemplateListResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(PublicTenantListResp)
-	err := c.cc.Invoke(ctx, Core_GetPublicTenantList_FullMethodName, in, out, cOpts...)
+	out := new(OauthProviderTemplateListResp)
+	err := c.cc.Invoke(ctx, Core_GetOauthProviderTemplateList_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *coreClient) CreateToken(ctx context.Context, in *TokenInfo, opts ...grpc.CallOption) (*BaseUUIDResp, error) {
+func (c *coreClient) GetOauthProviderTemplateById(ctx context.Context, in *IDReq, opts ...grpc.CallOption) (*OauthProviderTemplateInfo, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(BaseUUIDResp)
-	err := c.cc.Invoke(ctx, Core_CreateToken_FullMethodName, in, out, cOpts...)
+	out := new(OauthProviderTemplateInfo)
+	err := c.cc.Invoke(ctx, Core_GetOauthProviderTemplateById_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *coreClient) DeleteToken(ctx context.Context, in *UUIDsReq, opts ...grpc.CallOption) (*BaseResp, error) {
+func (c *coreClient) DeleteOauthProviderTemplate(ctx context.Context, in *IDsReq, opts ...grpc.CallOption) (*BaseResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(BaseResp)
-	err := c.cc.Invoke(ctx, Core_DeleteToken_FullMethodName, in, out, cOpts...)
+	err := c.cc.Invoke(ctx, Core_DeleteOauthProviderTemplate_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *coreClient) GetTokenList(ctx context.Context, in *TokenListReq, opts ...grpc.CallOption) (*TokenListResp, error) {
+func (c *coreClient) EnableOauthProviderTemplate(ctx context.Context, in *EnableOauthProviderTemplateReq, opts ...grpc.CallOption) (*BaseIDResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(TokenListResp)
-	err := c.cc.Invoke(ctx, Core_GetTokenList_FullMethodName, in, out, cOpts...)
+	out := new(BaseIDResp)
+	err := c.cc.Invoke(ctx, Core_EnableOauthProviderTemplate_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *coreClient) GetTokenById(ctx context.Context, in *UUIDReq, opts ...grpc.CallOption) (*TokenInfo, error) {
+func (c *coreClient) CreateOauthScope(ctx context.Context, in *OauthScopeInfo, opts ...grpc.CallOption) (*BaseIDResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(TokenInfo)
-	err := c.cc.Invoke(ctx, Core_GetTokenById_FullMethodName, in, out, cOpts...)
+	out := new(BaseIDResp)
+	err := c.cc.Invoke(ctx, Core_CreateOauthScope_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *coreClient) BlockUserAllToken(ctx context.Context, in *UUIDReq, opts ...grpc.CallOption) (*BaseResp, error) {
+func (c *coreClient) UpdateOauthScope(ctx context.Context, in *OauthScopeInfo, opts ...grpc.CallOption) (*BaseResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(BaseResp)
-	err := c.cc.Invoke(ctx, Core_BlockUserAllToken_FullMethodName, in, out, cOpts...)
+	err := c.cc.Invoke(ctx, Core_UpdateOauthScope_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *coreClient) UpdateToken(ctx context.Context, in *TokenInfo, opts ...grpc.CallOption) (*BaseResp, error) {
+func (c *coreClient) GetOauthScopeList(ctx context.Context, in *OauthScopeListReq, opts ...grpc.CallOption) (*OauthScopeListResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OauthScopeListResp)
+	err := c.cc.Invoke(ctx, Core_GetOauthScopeList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) GetOauthScopeById(ctx context.Context, in *IDReq, opts ...grpc.CallOption) (*OauthScopeInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OauthScopeInfo)
+	err := c.cc.Invoke(ctx, Core_GetOauthScopeById_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) DeleteOauthScope(ctx context.Context, in *IDsReq, opts ...grpc.CallOption) (*BaseResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(BaseResp)
-	err := c.cc.Invoke(ctx, Core_UpdateToken_FullMethodName, in, out, cOpts...)
+	err := c.cc.Invoke(ctx, Core_DeleteOauthScope_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *coreClient) CreateUser(ctx context.Context, in *UserInfo, opts ...grpc.CallOption) (*BaseUUIDResp, error) {
+func (c *coreClient) CreatePosition(ctx context.Context, in *PositionInfo, opts ...grpc.CallOption) (*BaseIDResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(BaseUUIDResp)
-	err := c.cc.Invoke(ctx, Core_CreateUser_FullMethodName, in, out, cOpts...)
+	out := new(BaseIDResp)
+	err := c.cc.Invoke(ctx, Core_CreatePosition_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *coreClient) UpdateUser(ctx context.Context, in *UserInfo, opts ...grpc.CallOption) (*BaseResp, error) {
+func (c *coreClient) UpdatePosition(ctx context.Context, in *PositionInfo, opts ...grpc.CallOption) (*BaseResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(BaseResp)
-	err := c.cc.Invoke(ctx, Core_UpdateUser_FullMethodName, in, out, cOpts...)
+	err := c.cc.Invoke(ctx, Core_UpdatePosition_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *coreClient) GetUserList(ctx context.Context, in *UserListReq, opts ...grpc.CallOption) (*UserListResp, error) {
+func (c *coreClient) GetPositionList(ctx context.Context, in *PositionListReq, opts ...grpc.CallOption) (*PositionListResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(UserListResp)
-	err := c.cc.Invoke(ctx, Core_GetUserList_FullMethodName, in, out, cOpts...)
+	out := new(PositionListResp)
+	err := c.cc.Invoke(ctx, Core_GetPositionList_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *coreClient) GetUserById(ctx context.Context, in *UUIDReq, opts ...grpc.CallOption) (*UserInfo, error) {
+func (c *coreClient) GetPositionById(ctx context.Context, in *IDReq, opts ...grpc.CallOption) (*PositionInfo, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(UserInfo)
-	err := c.cc.Invoke(ctx, Core_GetUserById_FullMethodName, in, out, cOpts...)
+	out := new(PositionInfo)
+	err := c.cc.Invoke(ctx, Core_GetPositionById_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *coreClient) GetUserByUsername(ctx context.Context, in *UsernameReq, opts ...grpc.CallOption) (*UserInfo, error) {
+func (c *coreClient) DeletePosition(ctx context.Context, in *IDsReq, opts ...grpc.CallOption) (*BaseResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(UserInfo)
-	err := c.cc.Invoke(ctx, Core_GetUserByUsername_FullMethodName, in, out, cOpts...)
+	out := new(BaseResp)
+	err := c.cc.Invoke(ctx, Core_DeletePosition_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *coreClient) DeleteUser(ctx context.Context, in *UUIDsReq, opts ...grpc.CallOption) (*BaseResp, error) {
+func (c *coreClient) CreateRole(ctx context.Context, in *RoleInfo, opts ...grpc.CallOption) (*BaseIDResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BaseIDResp)
+	err := c.cc.Invoke(ctx, Core_CreateRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) UpdateRole(ctx context.Context, in *RoleInfo, opts ...grpc.CallOption) (*BaseResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(BaseResp)
-	err := c.cc.Invoke(ctx, Core_DeleteUser_FullMethodName, in, out, cOpts...)
+	err := c.cc.Invoke(ctx, Core_UpdateRole_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *coreClient) ResetPwd(ctx context.Context, in *ResetPwdReq, opts ...grpc.CallOption) (*BaseResp, error) {
+func (c *coreClient) GetRoleList(ctx context.Context, in *RoleListReq, opts ...grpc.CallOption) (*RoleListResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RoleListResp)
+	err := c.cc.Invoke(ctx, Core_GetRoleList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) GetRoleById(ctx context.Context, in *IDReq, opts ...grpc.CallOption) (*RoleInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RoleInfo)
+	err := c.cc.Invoke(ctx, Core_GetRoleById_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) DeleteRole(ctx context.Context, in *IDsReq, opts ...grpc.CallOption) (*BaseResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(BaseResp)
-	err := c.cc.Invoke(ctx, Core_ResetPwd_FullMethodName, in, out, cOpts...)
+	err := c.cc.Invoke(ctx, Core_DeleteRole_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *coreClient) UnallocatedList(ctx context.Context, in *RoleUnallocatedListReq, opts ...grpc.CallOption) (*UserListResp, error) {
+func (c *coreClient) DeleteRoleWithUsers(ctx context.Context, in *IDReq, opts ...grpc.CallOption) (*BaseResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(UserListResp)
-	err := c.cc.Invoke(ctx, Core_UnallocatedList_FullMethodName, in, out, cOpts...)
+	out := new(BaseResp)
+	err := c.cc.Invoke(ctx, Core_DeleteRoleWithUsers_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// CoreServer is the server API for Core service.
-// All implementations must embed UnimplementedCoreServer
-// for forward compatibility.
-type CoreServer interface {
-	//  API management
-	//  group: api
-	CreateApi(context.Context, *ApiInfo) (*BaseIDResp, error)
-	//  group: api
-	UpdateApi(context.Context, *ApiInfo) (*BaseResp, error)
-	//  group: api
-	GetApiList(context.Context, *ApiListReq) (*ApiListResp, error)
-	//  group: api
-	GetApiById(context.Context, *IDReq) (*ApiInfo, error)
-	//  group: api
-	DeleteApi(context.Context, *IDsReq) (*BaseResp, error)
-	// AuditLog management
-	// group: auditlog
-	CreateAuditLog(context.Context, *AuditLogInfo) (*BaseUUIDResp, error)
-	// group: auditlog
-	GetAuditLogList(context.Context, *AuditLogListReq) (*AuditLogListResp, error)
-	// group: auditlog
-	GetAuditLogById(context.Context, *UUIDReq) (*AuditLogInfo, error)
-	// group: auditlog
-	DeleteAuditLog(context.Context, *UUIDsReq) (*BaseResp, error)
-	// group: auditlog
-	GetAuditLogStats(context.Context, *AuditLogStatsReq) (*AuditLogStatsResp, error)
-	//  group: authority
-	GetMenuAuthority(context.Context, *IDReq) (*RoleMenuAuthorityResp, error)
-	//  group: authority
-	CreateOrUpdateMenuAuthority(context.Context, *RoleMenuAuthorityReq) (*BaseResp, error)
-	//  group: base
-	InitDatabase(context.Context, *Empty) (*BaseResp, error)
-	//  权限规则管理
-	//  group: casbin
-	CreateCasbinRule(context.Context, *CasbinRuleInfo) (*BaseIDResp, error)
-	//  group: casbin
-	UpdateCasbinRule(context.Context, *CasbinRuleInfo) (*BaseResp, error)
-	//  group: casbin
-	DeleteCasbinRule(context.Context, *IDsReq) (*BaseResp, error)
-	//  group: casbin
-	GetCasbinRuleList(context.Context, *CasbinRuleListReq) (*CasbinRuleListResp, error)
-	//  group: casbin
-	GetCasbinRuleById(context.Context, *IDReq) (*CasbinRuleInfo, error)
-	//  批量操作
-	//  group: casbin
-	BatchCreateCasbinRules(context.Context, *BatchCreateCasbinRulesReq) (*BaseResp, error)
-	//  group: casbin
-	BatchUpdateCasbinRules(context.Context, *BatchUpdateCasbinRulesReq) (*BaseResp, error)
-	//  group: casbin
-	BatchDeleteCasbinRules(context.Context, *IDsReq) (*BaseResp, error)
-	//  权限验证
-	//  group: casbin
-	CheckPermission(context.Context, *PermissionCheckReq) (*PermissionCheckResp, error)
-	//  group: casbin
-	BatchCheckPermission(context.Context, *BatchPermissionCheckReq) (*BatchPermissionCheckResp, error)
-	//  权限查询
-	//  group: casbin
-	GetUserPermissionSummary(context.Context, *GetUserPermissionSummaryReq) (*GetUserPermissionSummaryResp, error)
-	//  规则验证
-	//  group: casbin
-	ValidateCasbinRule(context.Context, *ValidateCasbinRuleReq) (*ValidateCasbinRuleResp, error)
-	//  系统管理
-	//  group: casbin
-	SyncCasbinRules(context.Context, *SyncCasbinRulesReq) (*SyncCasbinRulesResp, error)
-	//  group: casbin
-	RefreshCasbinCache(context.Context, *RefreshCasbinCacheReq) (*RefreshCasbinCacheResp, error)
-	//  Configuration management
-	//  group: configuration
-	CreateConfiguration(context.Context, *ConfigurationInfo) (*BaseIDResp, error)
-	//  group: configuration
-	UpdateConfiguration(context.Context, *ConfigurationInfo) (*BaseResp, error)
-	//  group: configuration
-	GetConfigurationList(context.Context, *ConfigurationListReq) (*ConfigurationListResp, error)
-	//  group: configuration
-	GetConfigurationById(context.Context, *IDReq) (*ConfigurationInfo, error)
-	//  group: configuration
-	DeleteConfiguration(context.Context, *IDsReq) (*BaseResp, error)
-	//  group: configuration
-	RefreshConfigurationCache(context.Context, *Empty) (*BaseResp, error)
-	//  Department management
-	//  group: department
-	CreateDepartment(context.Context, *DepartmentInfo) (*BaseIDResp, error)
-	//  group: department
-	UpdateDepartment(context.Context, *DepartmentInfo) (*BaseResp, error)
-	//  group: department
-	GetDepartmentList(context.Context, *DepartmentListReq) (*DepartmentListResp, error)
-	//  group: department
-	GetDepartmentById(context.Context, *IDReq) (*DepartmentInfo, error)
-	//  group: department
-	DeleteDepartment(context.Context, *IDsReq) (*BaseResp, error)
-	//  group: department
-	InitDeptDataPermToRedis(context.Context, *Empty) (*BaseResp, error)
-	//  Dictionary management
-	//  group: dictionary
-	CreateDictionary(context.Context, *DictionaryInfo) (*BaseIDResp, error)
-	//  group: dictionary
-	UpdateDictionary(context.Context, *DictionaryInfo) (*BaseResp, error)
-	//  group: dictionary
-	GetDictionaryList(context.Context, *DictionaryListReq) (*DictionaryListResp, error)
-	//  group: dictionary
-	GetDictionaryById(context.Context, *IDReq) (*DictionaryInfo, error)
-	//  group: dictionary
-	DeleteDictionary(context.Context, *IDsReq) (*BaseResp, error)
-	//  DictionaryDetail management
-	//  group: dictionarydetail
-	CreateDictionaryDetail(context.Context, *DictionaryDetailInfo) (*BaseIDResp, error)
-	//  group: dictionarydetail
-	UpdateDictionaryDetail(context.Context, *DictionaryDetailInfo) (*BaseResp, error)
-	//  group: dictionarydetail
-	GetDictionaryDetailList(context.Context, *DictionaryDetailListReq) (*DictionaryDetailListResp, error)
+func (c *coreClient) InitRoleDataPermToRedis(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*BaseResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BaseResp)
+	err := c.cc.Invoke(ctx, Core_InitRoleDataPermToRedis_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) AssignRoleDataScope(ctx context.Context, in *RoleDataScopeReq, opts ...grpc.CallOption) (*BaseResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BaseResp)
+	err := c.cc.Invoke(ctx, Core_AssignRoleDataScope_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) CancelAuth(ctx context.Context, in *RoleAuthReq, opts ...grpc.CallOption) (*BaseResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BaseResp)
+	err := c.cc.Invoke(ctx, Core_CancelAuth_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) AddAuth(ctx context.Context, in *RoleAuthReq, opts ...grpc.CallOption) (*BaseResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BaseResp)
+	err := c.cc.Invoke(ctx, Core_AddAuth_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) ChangeRoleStatus(ctx context.Context, in *RoleStatusChangeParam, opts ...grpc.CallOption) (*BaseResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BaseResp)
+	err := c.cc.Invoke(ctx, Core_ChangeRoleStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) SetRoleParents(ctx context.Context, in *RoleParentsReq, opts ...grpc.CallOption) (*BaseResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BaseResp)
+	err := c.cc.Invoke(ctx, Core_SetRoleParents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) GetRoleParents(ctx context.Context, in *IDReq, opts ...grpc.CallOption) (*RoleParentsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RoleParentsResp)
+	err := c.cc.Invoke(ctx, Core_GetRoleParents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) GetRoleEffectivePermissions(ctx context.Context, in *IDReq, opts ...grpc.CallOption) (*RoleEffectivePermissionsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RoleEffectivePermissionsResp)
+	err := c.cc.Invoke(ctx, Core_GetRoleEffectivePermissions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) CreateSamlProvider(ctx context.Context, in *SamlProviderInfo, opts ...grpc.CallOption) (*BaseIDResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BaseIDResp)
+	err := c.cc.Invoke(ctx, Core_CreateSamlProvider_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) UpdateSamlProvider(ctx context.Context, in *SamlProviderInfo, opts ...grpc.CallOption) (*BaseResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BaseResp)
+	err := c.cc.Invoke(ctx, Core_UpdateSamlProvider_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) GetSamlProviderList(ctx context.Context, in *SamlProviderListReq, opts ...grpc.CallOption) (*SamlProviderListResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SamlProviderListResp)
+	err := c.cc.Invoke(ctx, Core_GetSamlProviderList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) GetSamlProviderById(ctx context.Context, in *IDReq, opts ...grpc.CallOption) (*SamlProviderInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SamlProviderInfo)
+	err := c.cc.Invoke(ctx, Core_GetSamlProviderById_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) DeleteSamlProvider(ctx context.Context, in *IDsReq, opts ...grpc.CallOption) (*BaseResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BaseResp)
+	err := c.cc.Invoke(ctx, Core_DeleteSamlProvider_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) ImportSamlIdpMetadata(ctx context.Context, in *SamlMetadataImportReq, opts ...grpc.CallOption) (*BaseResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BaseResp)
+	err := c.cc.Invoke(ctx, Core_ImportSamlIdpMetadata_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) GetSamlSpMetadata(ctx context.Context, in *SamlSpMetadataReq, opts ...grpc.CallOption) (*SamlSpMetadataResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SamlSpMetadataResp)
+	err := c.cc.Invoke(ctx, Core_GetSamlSpMetadata_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) SamlLogin(ctx context.Context, in *SamlLoginReq, opts ...grpc.CallOption) (*SamlLoginResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SamlLoginResp)
+	err := c.cc.Invoke(ctx, Core_SamlLogin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) SamlAcs(ctx context.Context, in *SamlAcsReq, opts ...grpc.CallOption) (*SamlAcsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SamlAcsResp)
+	err := c.cc.Invoke(ctx, Core_SamlAcs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) CreateScimToken(ctx context.Context, in *ScimTokenInfo, opts ...grpc.CallOption) (*ScimTokenCreateResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ScimTokenCreateResp)
+	err := c.cc.Invoke(ctx, Core_CreateScimToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) UpdateScimToken(ctx context.Context, in *ScimTokenInfo, opts ...grpc.CallOption) (*BaseResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BaseResp)
+	err := c.cc.Invoke(ctx, Core_UpdateScimToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) GetScimTokenList(ctx context.Context, in *ScimTokenListReq, opts ...grpc.CallOption) (*ScimTokenListResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ScimTokenListResp)
+	err := c.cc.Invoke(ctx, Core_GetScimTokenList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) GetScimTokenById(ctx context.Context, in *IDReq, opts ...grpc.CallOption) (*ScimTokenInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ScimTokenInfo)
+	err := c.cc.Invoke(ctx, Core_GetScimTokenById_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) DeleteScimToken(ctx context.Context, in *IDsReq, opts ...grpc.CallOption) (*BaseResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BaseResp)
+	err := c.cc.Invoke(ctx, Core_DeleteScimToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) AuthenticateScimToken(ctx context.Context, in *ScimTokenAuthReq, opts ...grpc.CallOption) (*ScimTokenInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ScimTokenInfo)
+	err := c.cc.Invoke(ctx, Core_AuthenticateScimToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) CreateTenant(ctx context.Context, in *TenantInfo, opts ...grpc.CallOption) (*BaseIDResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BaseIDResp)
+	err := c.cc.Invoke(ctx, Core_CreateTenant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) UpdateTenant(ctx context.Context, in *TenantInfo, opts ...grpc.CallOption) (*BaseResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BaseResp)
+	err := c.cc.Invoke(ctx, Core_UpdateTenant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) GetTenantList(ctx context.Context, in *TenantListReq, opts ...grpc.CallOption) (*TenantListResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TenantListResp)
+	err := c.cc.Invoke(ctx, Core_GetTenantList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) GetTenantById(ctx context.Context, in *IDReq, opts ...grpc.CallOption) (*TenantInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TenantInfo)
+	err := c.cc.Invoke(ctx, Core_GetTenantById_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) GetTenantByCode(ctx context.Context, in *TenantCodeReq, opts ...grpc.CallOption) (*TenantInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TenantInfo)
+	err := c.cc.Invoke(ctx, Core_GetTenantByCode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) DeleteTenant(ctx context.Context, in *IDsReq, opts ...grpc.CallOption) (*BaseResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BaseResp)
+	err := c.cc.Invoke(ctx, Core_DeleteTenant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) UpdateTenantStatus(ctx context.Context, in *TenantStatusReq, opts ...grpc.CallOption) (*BaseResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BaseResp)
+	err := c.cc.Invoke(ctx, Core_UpdateTenantStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) InitTenant(ctx context.Context, in *TenantInitReq, opts ...grpc.CallOption) (*BaseIDResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BaseIDResp)
+	err := c.cc.Invoke(ctx, Core_InitTenant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) GetTenantInitJobById(ctx context.Context, in *IDReq, opts ...grpc.CallOption) (*TenantInitJobInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TenantInitJobInfo)
+	err := c.cc.Invoke(ctx, Core_GetTenantInitJobById_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) GetTenantInitJobList(ctx context.Context, in *TenantInitJobListReq, opts ...grpc.CallOption) (*TenantInitJobListResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TenantInitJobListResp)
+	err := c.cc.Invoke(ctx, Core_GetTenantInitJobList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) RetryTenantInitJob(ctx context.Context, in *TenantInitJobRetryReq, opts ...grpc.CallOption) (*BaseIDResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BaseIDResp)
+	err := c.cc.Invoke(ctx, Core_RetryTenantInitJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) DiagnoseTenants(ctx context.Context, in *TenantDoctorReq, opts ...grpc.CallOption) (*TenantDoctorResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TenantDoctorResp)
+	err := c.cc.Invoke(ctx, Core_DiagnoseTenants_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) RepairTenant(ctx context.Context, in *TenantRepairReq, opts ...grpc.CallOption) (*TenantDoctorResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TenantDoctorResp)
+	err := c.cc.Invoke(ctx, Core_RepairTenant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) StartImpersonation(ctx context.Context, in *ImpersonationStartReq, opts ...grpc.CallOption) (*ImpersonationSessionInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImpersonationSessionInfo)
+	err := c.cc.Invoke(ctx, Core_StartImpersonation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) ReviewImpersonation(ctx context.Context, in *ImpersonationReviewReq, opts ...grpc.CallOption) (*ImpersonationSessionInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImpersonationSessionInfo)
+	err := c.cc.Invoke(ctx, Core_ReviewImpersonation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) EndImpersonation(ctx context.Context, in *UUIDReq, opts ...grpc.CallOption) (*BaseResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BaseResp)
+	err := c.cc.Invoke(ctx, Core_EndImpersonation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) GetActiveImpersonation(ctx context.Context, in *UUIDReq, opts ...grpc.CallOption) (*ImpersonationSessionInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImpersonationSessionInfo)
+	err := c.cc.Invoke(ctx, Core_GetActiveImpersonation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) GetImpersonationSessionList(ctx context.Context, in *ImpersonationSessionListReq, opts ...grpc.CallOption) (*ImpersonationSessionListResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImpersonationSessionListResp)
+	err := c.cc.Invoke(ctx, Core_GetImpersonationSessionList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) GetPublicTenantList(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*PublicTenantListResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PublicTenantListResp)
+	err := c.cc.Invoke(ctx, Core_GetPublicTenantList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) RegisterTenantInitPlugin(ctx context.Context, in *TenantInitPluginInfo, opts ...grpc.CallOption) (*BaseIDResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BaseIDResp)
+	err := c.cc.Invoke(ctx, Core_RegisterTenantInitPlugin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) UpdateTenantInitPlugin(ctx context.Context, in *TenantInitPluginInfo, opts ...grpc.CallOption) (*BaseResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BaseResp)
+	err := c.cc.Invoke(ctx, Core_UpdateTenantInitPlugin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) GetTenantInitPluginList(ctx context.Context, in *TenantInitPluginListReq, opts ...grpc.CallOption) (*TenantInitPluginListResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TenantInitPluginListResp)
+	err := c.cc.Invoke(ctx, Core_GetTenantInitPluginList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) GetTenantInitPluginById(ctx context.Context, in *IDReq, opts ...grpc.CallOption) (*TenantInitPluginInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TenantInitPluginInfo)
+	err := c.cc.Invoke(ctx, Core_GetTenantInitPluginById_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) DeleteTenantInitPlugin(ctx context.Context, in *IDsReq, opts ...grpc.CallOption) (*BaseResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BaseResp)
+	err := c.cc.Invoke(ctx, Core_DeleteTenantInitPlugin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) CreateTenantInitTemplate(ctx context.Context, in *TenantInitTemplateInfo, opts ...grpc.CallOption) (*BaseIDResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BaseIDResp)
+	err := c.cc.Invoke(ctx, Core_CreateTenantInitTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) UpdateTenantInitTemplate(ctx context.Context, in *TenantInitTemplateInfo, opts ...grpc.CallOption) (*BaseResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BaseResp)
+	err := c.cc.Invoke(ctx, Core_UpdateTenantInitTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) GetTenantInitTemplateList(ctx context.Context, in *TenantInitTemplateListReq, opts ...grpc.CallOption) (*TenantInitTemplateListResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TenantInitTemplateListResp)
+	err := c.cc.Invoke(ctx, Core_GetTenantInitTemplateList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) GetTenantInitTemplateById(ctx context.Context, in *IDReq, opts ...grpc.CallOption) (*TenantInitTemplateInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TenantInitTemplateInfo)
+	err := c.cc.Invoke(ctx, Core_GetTenantInitTemplateById_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) DeleteTenantInitTemplate(ctx context.Context, in *IDsReq, opts ...grpc.CallOption) (*BaseResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BaseResp)
+	err := c.cc.Invoke(ctx, Core_DeleteTenantInitTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) PreviewTenantInitTemplate(ctx context.Context, in *TenantInitTemplatePreviewReq, opts ...grpc.CallOption) (*TenantInitTemplatePreviewResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TenantInitTemplatePreviewResp)
+	err := c.cc.Invoke(ctx, Core_PreviewTenantInitTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) CreateToken(ctx context.Context, in *TokenInfo, opts ...grpc.CallOption) (*BaseUUIDResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BaseUUIDResp)
+	err := c.cc.Invoke(ctx, Core_CreateToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) DeleteToken(ctx context.Context, in *UUIDsReq, opts ...grpc.CallOption) (*BaseResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BaseResp)
+	err := c.cc.Invoke(ctx, Core_DeleteToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) GetTokenList(ctx context.Context, in *TokenListReq, opts ...grpc.CallOption) (*TokenListResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TokenListResp)
+	err := c.cc.Invoke(ctx, Core_GetTokenList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) GetTokenById(ctx context.Context, in *UUIDReq, opts ...grpc.CallOption) (*TokenInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TokenInfo)
+	err := c.cc.Invoke(ctx, Core_GetTokenById_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) BlockUserAllToken(ctx context.Context, in *UUIDReq, opts ...grpc.CallOption) (*BaseResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BaseResp)
+	err := c.cc.Invoke(ctx, Core_BlockUserAllToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) UpdateToken(ctx context.Context, in *TokenInfo, opts ...grpc.CallOption) (*BaseResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BaseResp)
+	err := c.cc.Invoke(ctx, Core_UpdateToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) GetUserSessionList(ctx context.Context, in *UserSessionListReq, opts ...grpc.CallOption) (*TokenListResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TokenListResp)
+	err := c.cc.Invoke(ctx, Core_GetUserSessionList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) RevokeUserSession(ctx context.Context, in *UserSessionRevokeReq, opts ...grpc.CallOption) (*BaseResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BaseResp)
+	err := c.cc.Invoke(ctx, Core_RevokeUserSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) TouchToken(ctx context.Context, in *TokenTouchReq, opts ...grpc.CallOption) (*BaseResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BaseResp)
+	err := c.cc.Invoke(ctx, Core_TouchToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) CreateUser(ctx context.Context, in *UserInfo, opts ...grpc.CallOption) (*BaseUUIDResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BaseUUIDResp)
+	err := c.cc.Invoke(ctx, Core_CreateUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) UpdateUser(ctx context.Context, in *UserInfo, opts ...grpc.CallOption) (*BaseResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BaseResp)
+	err := c.cc.Invoke(ctx, Core_UpdateUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) GetUserList(ctx context.Context, in *UserListReq, opts ...grpc.CallOption) (*UserListResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserListResp)
+	err := c.cc.Invoke(ctx, Core_GetUserList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) GetUserById(ctx context.Context, in *UUIDReq, opts ...grpc.CallOption) (*UserInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserInfo)
+	err := c.cc.Invoke(ctx, Core_GetUserById_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) GetUserByUsername(ctx context.Context, in *UsernameReq, opts ...grpc.CallOption) (*UserInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserInfo)
+	err := c.cc.Invoke(ctx, Core_GetUserByUsername_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) DeleteUser(ctx context.Context, in *UUIDsReq, opts ...grpc.CallOption) (*BaseResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BaseResp)
+	err := c.cc.Invoke(ctx, Core_DeleteUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) ResetPwd(ctx context.Context, in *ResetPwdReq, opts ...grpc.CallOption) (*BaseResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BaseResp)
+	err := c.cc.Invoke(ctx, Core_ResetPwd_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) UnallocatedList(ctx context.Context, in *RoleUnallocatedListReq, opts ...grpc.CallOption) (*UserListResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserListResp)
+	err := c.cc.Invoke(ctx, Core_UnallocatedList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CoreServer is the server API for Core service.
+// All implementations must embed UnimplementedCoreServer
+// for forward compatibility.
+type CoreServer interface {
+	//  API management
+	//  group: api
+	CreateApi(context.Context, *ApiInfo) (*BaseIDResp, error)
+	//  group: api
+	UpdateApi(context.Context, *ApiInfo) (*BaseResp, error)
+	//  group: api
+	GetApiList(context.Context, *ApiListReq) (*ApiListResp, error)
+	//  group: api
+	GetApiById(context.Context, *IDReq) (*ApiInfo, error)
+	//  group: api
+	DeleteApi(context.Context, *IDsReq) (*BaseResp, error)
+	//  group: api
+	ApiRegistration(context.Context, *ApiRegistrationReq) (*ApiRegistrationResp, error)
+	//  AuditLog management
+	//  group: auditlog
+	CreateAuditLog(context.Context, *AuditLogInfo) (*BaseUUIDResp, error)
+	//  group: auditlog
+	GetAuditLogList(context.Context, *AuditLogListReq) (*AuditLogListResp, error)
+	//  group: auditlog
+	GetAuditLogById(context.Context, *UUIDReq) (*AuditLogInfo, error)
+	//  group: auditlog
+	GetAuditLogStats(context.Context, *AuditLogStatsReq) (*AuditLogStatsResp, error)
+	//  group: auditlog
+	VerifyAuditLogChain(context.Context, *Empty) (*AuditLogVerifyResp, error)
+	//  group: auditlog
+	GetAuditLogArchiveList(context.Context, *AuditLogArchiveListReq) (*AuditLogArchiveListResp, error)
+	//  group: auditlog
+	RestoreAuditLogRange(context.Context, *AuditLogListReq) (*AuditLogListResp, error)
+	//  group: authority
+	GetMenuAuthority(context.Context, *IDReq) (*RoleMenuAuthorityResp, error)
+	//  group: authority
+	CreateOrUpdateMenuAuthority(context.Context, *RoleMenuAuthorityReq) (*BaseResp, error)
+	//  group: authority
+	GetRolePolicies(context.Context, *IDReq) (*RolePolicyListResp, error)
+	//  group: authority
+	ReplaceRolePolicies(context.Context, *ReplaceRolePoliciesReq) (*ReplaceRolePoliciesResp, error)
+	//  group: authority
+	ExportPolicyBundle(context.Context, *PolicyBundleExportReq) (*PolicyBundleResp, error)
+	//  group: authority
+	ImportPolicyBundle(context.Context, *PolicyBundleImportReq) (*PolicyBundleImportResp, error)
+	//  group: base
+	InitDatabase(context.Context, *Empty) (*BaseResp, error)
+	//  权限规则管理
+	//  group: casbin
+	CreateCasbinRule(context.Context, *CasbinRuleInfo) (*BaseIDResp, error)
+	//  group: casbin
+	UpdateCasbinRule(context.Context, *CasbinRuleInfo) (*BaseResp, error)
+	//  group: casbin
+	DeleteCasbinRule(context.Context, *IDsReq) (*BaseResp, error)
+	//  group: casbin
+	GetCasbinRuleList(context.Context, *CasbinRuleListReq) (*CasbinRuleListResp, error)
+	//  group: casbin
+	GetCasbinRuleById(context.Context, *IDReq) (*CasbinRuleInfo, error)
+	//  批量操作
+	//  group: casbin
+	BatchCreateCasbinRules(context.Context, *BatchCreateCasbinRulesReq) (*BaseResp, error)
+	//  group: casbin
+	BatchUpdateCasbinRules(context.Context, *BatchUpdateCasbinRulesReq) (*BaseResp, error)
+	//  group: casbin
+	BatchDeleteCasbinRules(context.Context, *IDsReq) (*BaseResp, error)
+	//  权限验证
+	//  group: casbin
+	CheckPermission(context.Context, *PermissionCheckReq) (*PermissionCheckResp, error)
+	//  group: casbin
+	BatchCheckPermission(context.Context, *BatchPermissionCheckReq) (*BatchPermissionCheckResp, error)
+	//  权限查询
+	//  group: casbin
+	GetUserPermissionSummary(context.Context, *GetUserPermissionSummaryReq) (*GetUserPermissionSummaryResp, error)
+	//  规则验证
+	//  group: casbin
+	ValidateCasbinRule(context.Context, *ValidateCasbinRuleReq) (*ValidateCasbinRuleResp, error)
+	//  系统管理
+	//  group: casbin
+	SyncCasbinRules(context.Context, *SyncCasbinRulesReq) (*SyncCasbinRulesResp, error)
+	//  group: casbin
+	RefreshCasbinCache(context.Context, *RefreshCasbinCacheReq) (*RefreshCasbinCacheResp, error)
+	//  Configuration management
+	//  group: configuration
+	CreateConfiguration(context.Context, *ConfigurationInfo) (*BaseIDResp, error)
+	//  group: configuration
+	UpdateConfiguration(context.Context, *ConfigurationInfo) (*BaseResp, error)
+	//  group: configuration
+	GetConfigurationList(context.Context, *ConfigurationListReq) (*ConfigurationListResp, error)
+	//  group: configuration
+	GetConfigurationById(context.Context, *IDReq) (*ConfigurationInfo, error)
+	//  group: configuration
+	DeleteConfiguration(context.Context, *IDsReq) (*BaseResp, error)
+	//  group: configuration
+	RefreshConfigurationCache(context.Context, *Empty) (*BaseResp, error)
+	//  Department management
+	//  group: department
+	CreateDepartment(context.Context, *DepartmentInfo) (*BaseIDResp, error)
+	//  group: department
+	UpdateDepartment(context.Context, *DepartmentInfo) (*BaseResp, error)
+	//  group: department
+	GetDepartmentList(context.Context, *DepartmentListReq) (*DepartmentListResp, error)
+	//  group: department
+	GetDepartmentById(context.Context, *IDReq) (*DepartmentInfo, error)
+	//  group: department
+	DeleteDepartment(context.Context, *IDsReq) (*BaseResp, error)
+	//  group: department
+	InitDeptDataPermToRedis(context.Context, *Empty) (*BaseResp, error)
+	//  Dictionary management
+	//  group: dictionary
+	CreateDictionary(context.Context, *DictionaryInfo) (*BaseIDResp, error)
+	//  group: dictionary
+	UpdateDictionary(context.Context, *DictionaryInfo) (*BaseResp, error)
+	//  group: dictionary
+	GetDictionaryList(context.Context, *DictionaryListReq) (*DictionaryListResp, error)
+	//  group: dictionary
+	GetDictionaryById(context.Context, *IDReq) (*DictionaryInfo, error)
+	//  group: dictionary
+	DeleteDictionary(context.Context, *IDsReq) (*BaseResp, error)
+	//  DictionaryDetail management
+	//  group: dictionarydetail
+	CreateDictionaryDetail(context.Context, *DictionaryDetailInfo) (*BaseIDResp, error)
+	//  group: dictionarydetail
+	UpdateDictionaryDetail(context.Context, *DictionaryDetailInfo) (*BaseResp, error)
+	//  group: dictionarydetail
+	GetDictionaryDetailList(context.Context, *DictionaryDetailListReq) (*DictionaryDetailListResp, error)
 	//  group: dictionarydetail
 	GetDictionaryDetailById(context.Context, *IDReq) (*DictionaryDetailInfo, error)
 	//  group: dictionarydetail
 	DeleteDictionaryDetail(context.Context, *IDsReq) (*BaseResp, error)
 	//  group: dictionarydetail
 	GetDictionaryDetailByDictionaryName(context.Context, *BaseMsg) (*DictionaryDetailListResp, error)
+	//  LdapProvider management
+	//  group: ldapprovider
+	CreateLdapProvider(context.Context, *LdapProviderInfo) (*BaseIDResp, error)
+	//  group: ldapprovider
+	UpdateLdapProvider(context.Context, *LdapProviderInfo) (*BaseResp, error)
+	//  group: ldapprovider
+	GetLdapProviderList(context.Context, *LdapProviderListReq) (*LdapProviderListResp, error)
+	//  group: ldapprovider
+	GetLdapProviderById(context.Context, *IDReq) (*LdapProviderInfo, error)
+	//  group: ldapprovider
+	DeleteLdapProvider(context.Context, *IDsReq) (*BaseResp, error)
+	//  group: ldapprovider
+	LdapLogin(context.Context, *LdapLoginReq) (*UserInfo, error)
+	//  group: ldapprovider
+	SyncLdapProvider(context.Context, *LdapSyncReq) (*LdapSyncRunInfo, error)
+	//  group: ldapprovider
+	GetLdapSyncRunList(context.Context, *LdapSyncRunListReq) (*LdapSyncRunListResp, error)
+	//  group: ldapprovider
+	GetLdapSyncRunById(context.Context, *IDReq) (*LdapSyncRunInfo, error)
 	//  group: menu
 	CreateMenu(context.Context, *MenuInfo) (*BaseIDResp, error)
 	//  group: menu
@@ -1652,6 +2799,31 @@ type CoreServer interface {
 	GetMenuListByRole(context.Context, *BaseMsg) (*MenuInfoList, error)
 	//  group: menu
 	GetMenuList(context.Context, *PageInfoReq) (*MenuInfoList, error)
+	//  OauthClient management
+	//  group: oauthclient
+	CreateOauthClient(context.Context, *OauthClientInfo) (*OauthClientSecretResp, error)
+	//  group: oauthclient
+	UpdateOauthClient(context.Context, *OauthClientInfo) (*BaseResp, error)
+	//  group: oauthclient
+	GetOauthClientList(context.Context, *OauthClientListReq) (*OauthClientListResp, error)
+	//  group: oauthclient
+	GetOauthClientById(context.Context, *IDReq) (*OauthClientInfo, error)
+	//  group: oauthclient
+	DeleteOauthClient(context.Context, *IDsReq) (*BaseResp, error)
+	//  group: oauthclient
+	ResetOauthClientSecret(context.Context, *IDReq) (*OauthClientSecretResp, error)
+	//  group: oauthclient
+	GetOauthClientByClientId(context.Context, *OauthClientIdReq) (*OauthClientInfo, error)
+	//  group: oauthclient
+	AuthenticateOauthClient(context.Context, *OauthClientAuthReq) (*OauthClientInfo, error)
+	//  group: oauthclient
+	AuthorizeOauthClient(context.Context, *OauthAuthorizeReq) (*OauthAuthorizeResp, error)
+	//  group: oauthclient
+	ExchangeOauthAuthorizationCode(context.Context, *OauthCodeExchangeReq) (*OauthGrantInfo, error)
+	//  group: oauthclient
+	GetOauthConsentList(context.Context, *OauthConsentListReq) (*OauthConsentListResp, error)
+	//  group: oauthclient
+	DeleteOauthConsent(context.Context, *IDsReq) (*BaseResp, error)
 	//  OauthProvider management
 	//  group: oauthprovider
 	CreateOauthProvider(context.Context, *OauthProviderInfo) (*BaseIDResp, error)
@@ -1666,7 +2838,11 @@ type CoreServer interface {
 	//  group: oauthprovider
 	OauthLogin(context.Context, *OauthLoginReq) (*OauthRedirectResp, error)
 	//  group: oauthprovider
-	OauthCallback(context.Context, *CallbackReq) (*UserInfo, error)
+	OauthCallback(context.Context, *CallbackReq) (*OauthCallbackResp, error)
+	//  group: oauthprovider
+	PreviewOauthClaimMapping(context.Context, *OauthClaimMappingPreviewReq) (*OauthClaimMappingPreviewResp, error)
+	//  group: oauthprovider
+	OauthWebhook(context.Context, *OauthWebhookReq) (*OauthWebhookResp, error)
 	//  OAuth Account Binding management
 	//  group: oauthaccount
 	CreateOauthAccount(context.Context, *OauthAccountInfo) (*BaseIDResp, error)
@@ -1684,6 +2860,8 @@ type CoreServer interface {
 	UnbindOauthAccount(context.Context, *UnbindOauthAccountReq) (*BaseResp, error)
 	//  group: oauthaccount
 	GetUserOauthAccounts(context.Context, *GetUserOauthAccountsReq) (*GetUserOauthAccountsResp, error)
+	//  group: oauthaccount
+	GetOauthAccessToken(context.Context, *OauthAccessTokenReq) (*OauthAccessTokenResp, error)
 	//  OAuth Session management
 	//  group: oauthsession
 	CreateOauthSession(context.Context, *CreateOauthSessionReq) (*BaseIDResp, error)
@@ -1693,6 +2871,30 @@ type CoreServer interface {
 	GetOauthSessionByState(context.Context, *GetOauthSessionByStateReq) (*OauthSessionInfo, error)
 	//  group: oauthsession
 	DeleteOauthSession(context.Context, *IDReq) (*BaseResp, error)
+	//  OauthProviderTemplate management
+	//  group: oauthprovidertemplate
+	CreateOauthProviderTemplate(context.Context, *OauthProviderTemplateInfo) (*BaseIDResp, error)
+	//  group: oauthprovidertemplate
+	UpdateOauthProviderTemplate(context.Context, *OauthProviderTemplateInfo) (*BaseResp, error)
+	//  group: oauthprovidertemplate
+	GetOauthProviderTemplateList(context.Context, *OauthProviderTemplateListReq) (*OauthProviderTemplateListResp, error)
+	//  group: oauthprovidertemplate
+	GetOauthProviderTemplateById(context.Context, *IDReq) (*OauthProviderTemplateInfo, error)
+	//  group: oauthprovidertemplate
+	DeleteOauthProviderTemplate(context.Context, *IDsReq) (*BaseResp, error)
+	//  group: oauthprovidertemplate
+	EnableOauthProviderTemplate(context.Context, *EnableOauthProviderTemplateReq) (*BaseIDResp, error)
+	//  OauthScope management
+	//  group: oauthscope
+	CreateOauthScope(context.Context, *OauthScopeInfo) (*BaseIDResp, error)
+	//  group: oauthscope
+	UpdateOauthScope(context.Context, *OauthScopeInfo) (*BaseResp, error)
+	//  group: oauthscope
+	GetOauthScopeList(context.Context, *OauthScopeListReq) (*OauthScopeListResp, error)
+	//  group: oauthscope
+	GetOauthScopeById(context.Context, *IDReq) (*OauthScopeInfo, error)
+	//  group: oauthscope
+	DeleteOauthScope(context.Context, *IDsReq) (*BaseResp, error)
 	//  Position management
 	//  group: position
 	CreatePosition(context.Context, *PositionInfo) (*BaseIDResp, error)
@@ -1715,6 +2917,9 @@ type CoreServer interface {
 	GetRoleById(context.Context, *IDReq) (*RoleInfo, error)
 	//  group: role
 	DeleteRole(context.Context, *IDsReq) (*BaseResp, error)
+	//  Delete the role after removing it from its users
+	//  group: role
+	DeleteRoleWithUsers(context.Context, *IDReq) (*BaseResp, error)
 	//  group: role
 	InitRoleDataPermToRedis(context.Context, *Empty) (*BaseResp, error)
 	//  group: role
@@ -1725,6 +2930,44 @@ type CoreServer interface {
 	AddAuth(context.Context, *RoleAuthReq) (*BaseResp, error)
 	//  group: role
 	ChangeRoleStatus(context.Context, *RoleStatusChangeParam) (*BaseResp, error)
+	//  group: role
+	SetRoleParents(context.Context, *RoleParentsReq) (*BaseResp, error)
+	//  group: role
+	GetRoleParents(context.Context, *IDReq) (*RoleParentsResp, error)
+	//  group: role
+	GetRoleEffectivePermissions(context.Context, *IDReq) (*RoleEffectivePermissionsResp, error)
+	//  SamlProvider management
+	//  group: samlprovider
+	CreateSamlProvider(context.Context, *SamlProviderInfo) (*BaseIDResp, error)
+	//  group: samlprovider
+	UpdateSamlProvider(context.Context, *SamlProviderInfo) (*BaseResp, error)
+	//  group: samlprovider
+	GetSamlProviderList(context.Context, *SamlProviderListReq) (*SamlProviderListResp, error)
+	//  group: samlprovider
+	GetSamlProviderById(context.Context, *IDReq) (*SamlProviderInfo, error)
+	//  group: samlprovider
+	DeleteSamlProvider(context.Context, *IDsReq) (*BaseResp, error)
+	//  group: samlprovider
+	ImportSamlIdpMetadata(context.Context, *SamlMetadataImportReq) (*BaseResp, error)
+	//  group: samlprovider
+	GetSamlSpMetadata(context.Context, *SamlSpMetadataReq) (*SamlSpMetadataResp, error)
+	//  group: samlprovider
+	SamlLogin(context.Context, *SamlLoginReq) (*SamlLoginResp, error)
+	//  group: samlprovider
+	SamlAcs(context.Context, *SamlAcsReq) (*SamlAcsResp, error)
+	//  ScimToken management
+	//  group: scimtoken
+	CreateScimToken(context.Context, *ScimTokenInfo) (*ScimTokenCreateResp, error)
+	//  group: scimtoken
+	UpdateScimToken(context.Context, *ScimTokenInfo) (*BaseResp, error)
+	//  group: scimtoken
+	GetScimTokenList(context.Context, *ScimTokenListReq) (*ScimTokenListResp, error)
+	//  group: scimtoken
+	GetScimTokenById(context.Context, *IDReq) (*ScimTokenInfo, error)
+	//  group: scimtoken
+	DeleteScimToken(context.Context, *IDsReq) (*BaseResp, error)
+	//  group: scimtoken
+	AuthenticateScimToken(context.Context, *ScimTokenAuthReq) (*ScimTokenInfo, error)
 	//  Tenant management
 	//  group: tenant
 	CreateTenant(context.Context, *TenantInfo) (*BaseIDResp, error)
@@ -1741,9 +2984,53 @@ type CoreServer interface {
 	//  group: tenant
 	UpdateTenantStatus(context.Context, *TenantStatusReq) (*BaseResp, error)
 	//  group: tenant
-	InitTenant(context.Context, *TenantInitReq) (*BaseResp, error)
+	InitTenant(context.Context, *TenantInitReq) (*BaseIDResp, error)
+	//  group: tenant
+	GetTenantInitJobById(context.Context, *IDReq) (*TenantInitJobInfo, error)
+	//  group: tenant
+	GetTenantInitJobList(context.Context, *TenantInitJobListReq) (*TenantInitJobListResp, error)
+	//  group: tenant
+	RetryTenantInitJob(context.Context, *TenantInitJobRetryReq) (*BaseIDResp, error)
+	//  group: tenant
+	DiagnoseTenants(context.Context, *TenantDoctorReq) (*TenantDoctorResp, error)
+	//  group: tenant
+	RepairTenant(context.Context, *TenantRepairReq) (*TenantDoctorResp, error)
+	//  group: tenant
+	StartImpersonation(context.Context, *ImpersonationStartReq) (*ImpersonationSessionInfo, error)
+	//  group: tenant
+	ReviewImpersonation(context.Context, *ImpersonationReviewReq) (*ImpersonationSessionInfo, error)
+	//  group: tenant
+	EndImpersonation(context.Context, *UUIDReq) (*BaseResp, error)
+	//  group: tenant
+	GetActiveImpersonation(context.Context, *UUIDReq) (*ImpersonationSessionInfo, error)
+	//  group: tenant
+	GetImpersonationSessionList(context.Context, *ImpersonationSessionListReq) (*ImpersonationSessionListResp, error)
 	//  group: public
 	GetPublicTenantList(context.Context, *Empty) (*PublicTenantListResp, error)
+	//  TenantInitPlugin management
+	//  group: tenantinitplugin
+	RegisterTenantInitPlugin(context.Context, *TenantInitPluginInfo) (*BaseIDResp, error)
+	//  group: tenantinitplugin
+	UpdateTenantInitPlugin(context.Context, *TenantInitPluginInfo) (*BaseResp, error)
+	//  group: tenantinitplugin
+	GetTenantInitPluginList(context.Context, *TenantInitPluginListReq) (*TenantInitPluginListResp, error)
+	//  group: tenantinitplugin
+	GetTenantInitPluginById(context.Context, *IDReq) (*TenantInitPluginInfo, error)
+	//  group: tenantinitplugin
+	DeleteTenantInitPlugin(context.Context, *IDsReq) (*BaseResp, error)
+	//  TenantInitTemplate management
+	//  group: tenantinittemplate
+	CreateTenantInitTemplate(context.Context, *TenantInitTemplateInfo) (*BaseIDResp, error)
+	//  group: tenantinittemplate
+	UpdateTenantInitTemplate(context.Context, *TenantInitTemplateInfo) (*BaseResp, error)
+	//  group: tenantinittemplate
+	GetTenantInitTemplateList(context.Context, *TenantInitTemplateListReq) (*TenantInitTemplateListResp, error)
+	//  group: tenantinittemplate
+	GetTenantInitTemplateById(context.Context, *IDReq) (*TenantInitTemplateInfo, error)
+	//  group: tenantinittemplate
+	DeleteTenantInitTemplate(context.Context, *IDsReq) (*BaseResp, error)
+	//  group: tenantinittemplate
+	PreviewTenantInitTemplate(context.Context, *TenantInitTemplatePreviewReq) (*TenantInitTemplatePreviewResp, error)
 	//  Token management
 	//  group: token
 	CreateToken(context.Context, *TokenInfo) (*BaseUUIDResp, error)
@@ -1757,6 +3044,12 @@ type CoreServer interface {
 	BlockUserAllToken(context.Context, *UUIDReq) (*BaseResp, error)
 	//  group: token
 	UpdateToken(context.Context, *TokenInfo) (*BaseResp, error)
+	//  group: token
+	GetUserSessionList(context.Context, *UserSessionListReq) (*TokenListResp, error)
+	//  group: token
+	RevokeUserSession(context.Context, *UserSessionRevokeReq) (*BaseResp, error)
+	//  group: token
+	TouchToken(context.Context, *TokenTouchReq) (*BaseResp, error)
 	//  User management
 	//  group: user
 	CreateUser(context.Context, *UserInfo) (*BaseUUIDResp, error)
@@ -1776,2152 +3069,3883 @@ type CoreServer interface {
 	UnallocatedList(context.Context, *RoleUnallocatedListReq) (*UserListResp, error)
 	mustEmbedUnimplementedCoreServer()
 }
-
-// UnimplementedCoreServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedCoreServer struct{}
-
-func (UnimplementedCoreServer) CreateApi(context.Context, *ApiInfo) (*BaseIDResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateApi not implemented")
+
+// UnimplementedCoreServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCoreServer struct{}
+
+func (UnimplementedCoreServer) CreateApi(context.Context, *ApiInfo) (*BaseIDResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateApi not implemented")
+}
+func (UnimplementedCoreServer) UpdateApi(context.Context, *ApiInfo) (*BaseResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateApi not implemented")
+}
+func (UnimplementedCoreServer) GetApiList(context.Context, *ApiListReq) (*ApiListResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetApiList not implemented")
+}
+func (UnimplementedCoreServer) GetApiById(context.Context, *IDReq) (*ApiInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetApiById not implemented")
+}
+func (UnimplementedCoreServer) DeleteApi(context.Context, *IDsReq) (*BaseResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteApi not implemented")
+}
+func (UnimplementedCoreServer) ApiRegistration(context.Context, *ApiRegistrationReq) (*ApiRegistrationResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApiRegistration not implemented")
+}
+func (UnimplementedCoreServer) CreateAuditLog(context.Context, *AuditLogInfo) (*BaseUUIDResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAuditLog not implemented")
+}
+func (UnimplementedCoreServer) GetAuditLogList(context.Context, *AuditLogListReq) (*AuditLogListResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAuditLogList not implemented")
+}
+func (UnimplementedCoreServer) GetAuditLogById(context.Context, *UUIDReq) (*AuditLogInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAuditLogById not implemented")
+}
+func (UnimplementedCoreServer) GetAuditLogStats(context.Context, *AuditLogStatsReq) (*AuditLogStatsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAuditLogStats not implemented")
+}
+func (UnimplementedCoreServer) VerifyAuditLogChain(context.Context, *Empty) (*AuditLogVerifyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyAuditLogChain not implemented")
+}
+func (UnimplementedCoreServer) GetAuditLogArchiveList(context.Context, *AuditLogArchiveListReq) (*AuditLogArchiveListResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAuditLogArchiveList not implemented")
+}
+func (UnimplementedCoreServer) RestoreAuditLogRange(context.Context, *AuditLogListReq) (*AuditLogListResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreAuditLogRange not implemented")
+}
+func (UnimplementedCoreServer) GetMenuAuthority(context.Context, *IDReq) (*RoleMenuAuthorityResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMenuAuthority not implemented")
+}
+func (UnimplementedCoreServer) CreateOrUpdateMenuAuthority(context.Context, *RoleMenuAuthorityReq) (*BaseResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateOrUpdateMenuAuthority not implemented")
+}
+func (UnimplementedCoreServer) GetRolePolicies(context.Context, *IDReq) (*RolePolicyListResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRolePolicies not implemented")
+}
+func (UnimplementedCoreServer) ReplaceRolePolicies(context.Context, *ReplaceRolePoliciesReq) (*ReplaceRolePoliciesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplaceRolePolicies not implemented")
+}
+func (UnimplementedCoreServer) ExportPolicyBundle(context.Context, *PolicyBundleExportReq) (*PolicyBundleResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportPolicyBundle not implemented")
+}
+func (UnimplementedCoreServer) ImportPolicyBundle(context.Context, *PolicyBundleImportReq) (*PolicyBundleImportResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportPolicyBundle not implemented")
+}
+func (UnimplementedCoreServer) InitDatabase(context.Context, *Empty) (*BaseResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InitDatabase not implemented")
+}
+func (UnimplementedCoreServer) CreateCasbinRule(context.Context, *CasbinRuleInfo) (*BaseIDResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCasbinRule not implemented")
+}
+func (UnimplementedCoreServer) UpdateCasbinRule(context.Context, *CasbinRuleInfo) (*BaseResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCasbinRule not implemented")
+}
+func (UnimplementedCoreServer) DeleteCasbinRule(context.Context, *IDsReq) (*BaseResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCasbinRule not implemented")
+}
+func (UnimplementedCoreServer) GetCasbinRuleList(context.Context, *CasbinRuleListReq) (*CasbinRuleListResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCasbinRuleList not implemented")
+}
+func (UnimplementedCoreServer) GetCasbinRuleById(context.Context, *IDReq) (*CasbinRuleInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCasbinRuleById not implemented")
+}
+func (UnimplementedCoreServer) BatchCreateCasbinRules(context.Context, *BatchCreateCasbinRulesReq) (*BaseResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchCreateCasbinRules not implemented")
+}
+func (UnimplementedCoreServer) BatchUpdateCasbinRules(context.Context, *BatchUpdateCasbinRulesReq) (*BaseResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchUpdateCasbinRules not implemented")
+}
+func (UnimplementedCoreServer) BatchDeleteCasbinRules(context.Context, *IDsReq) (*BaseResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchDeleteCasbinRules not implemented")
+}
+func (UnimplementedCoreServer) CheckPermission(context.Context, *PermissionCheckReq) (*PermissionCheckResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckPermission not implemented")
+}
+func (UnimplementedCoreServer) BatchCheckPermission(context.Context, *BatchPermissionCheckReq) (*BatchPermissionCheckResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchCheckPermission not implemented")
+}
+func (UnimplementedCoreServer) GetUserPermissionSummary(context.Context, *GetUserPermissionSummaryReq) (*GetUserPermissionSummaryResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserPermissionSummary not implemented")
+}
+func (UnimplementedCoreServer) ValidateCasbinRule(context.Context, *ValidateCasbinRuleReq) (*ValidateCasbinRuleResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateCasbinRule not implemented")
+}
+func (UnimplementedCoreServer) SyncCasbinRules(context.Context, *SyncCasbinRulesReq) (*SyncCasbinRulesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SyncCasbinRules not implemented")
+}
+func (UnimplementedCoreServer) RefreshCasbinCache(context.Context, *RefreshCasbinCacheReq) (*RefreshCasbinCacheResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshCasbinCache not implemented")
+}
+func (UnimplementedCoreServer) CreateConfiguration(context.Context, *ConfigurationInfo) (*BaseIDResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateConfiguration not implemented")
+}
+func (UnimplementedCoreServer) UpdateConfiguration(context.Context, *ConfigurationInfo) (*BaseResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateConfiguration not implemented")
+}
+func (UnimplementedCoreServer) GetConfigurationList(context.Context, *ConfigurationListReq) (*ConfigurationListResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConfigurationList not implemented")
+}
+func (UnimplementedCoreServer) GetConfigurationById(context.Context, *IDReq) (*ConfigurationInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConfigurationById not implemented")
+}
+func (UnimplementedCoreServer) DeleteConfiguration(context.Context, *IDsReq) (*BaseResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteConfiguration not implemented")
+}
+func (UnimplementedCoreServer) RefreshConfigurationCache(context.Context, *Empty) (*BaseResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshConfigurationCache not implemented")
+}
+func (UnimplementedCoreServer) CreateDepartment(context.Context, *DepartmentInfo) (*BaseIDResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDepartment not implemented")
+}
+func (UnimplementedCoreServer) UpdateDepartment(context.Context, *DepartmentInfo) (*BaseResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDepartment not implemented")
+}
+func (UnimplementedCoreServer) GetDepartmentList(context.Context, *DepartmentListReq) (*DepartmentListResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDepartmentList not implemented")
+}
+func (UnimplementedCoreServer) GetDepartmentById(context.Context, *IDReq) (*DepartmentInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDepartmentById not implemented")
+}
+func (UnimplementedCoreServer) DeleteDepartment(context.Context, *IDsReq) (*BaseResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteDepartment not implemented")
+}
+func (UnimplementedCoreServer) InitDeptDataPermToRedis(context.Context, *Empty) (*BaseResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InitDeptDataPermToRedis not implemented")
+}
+func (UnimplementedCoreServer) CreateDictionary(context.Context, *DictionaryInfo) (*BaseIDResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDictionary not implemented")
+}
+func (UnimplementedCoreServer) UpdateDictionary(context.Context, *DictionaryInfo) (*BaseResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDictionary not implemented")
+}
+func (UnimplementedCoreServer) GetDictionaryList(context.Context, *DictionaryListReq) (*DictionaryListResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDictionaryList not implemented")
+}
+func (UnimplementedCoreServer) GetDictionaryById(context.Context, *IDReq) (*DictionaryInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDictionaryById not implemented")
+}
+func (UnimplementedCoreServer) DeleteDictionary(context.Context, *IDsReq) (*BaseResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteDictionary not implemented")
+}
+func (UnimplementedCoreServer) CreateDictionaryDetail(context.Context, *DictionaryDetailInfo) (*BaseIDResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDictionaryDetail not implemented")
+}
+func (UnimplementedCoreServer) UpdateDictionaryDetail(context.Context, *DictionaryDetailInfo) (*BaseResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDictionaryDetail not implemented")
+}
+func (UnimplementedCoreServer) GetDictionaryDetailList(context.Context, *DictionaryDetailListReq) (*DictionaryDetailListResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDictionaryDetailList not implemented")
+}
+func (UnimplementedCoreServer) GetDictionaryDetailById(context.Context, *IDReq) (*DictionaryDetailInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDictionaryDetailById not implemented")
+}
+func (UnimplementedCoreServer) DeleteDictionaryDetail(context.Context, *IDsReq) (*BaseResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteDictionaryDetail not implemented")
+}
+func (UnimplementedCoreServer) GetDictionaryDetailByDictionaryName(context.Context, *BaseMsg) (*DictionaryDetailListResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDictionaryDetailByDictionaryName not implemented")
+}
+func (UnimplementedCoreServer) CreateLdapProvider(context.Context, *LdapProviderInfo) (*BaseIDResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateLdapProvider not implemented")
+}
+func (UnimplementedCoreServer) UpdateLdapProvider(context.Context, *LdapProviderInfo) (*BaseResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateLdapProvider not implemented")
+}
+func (UnimplementedCoreServer) GetLdapProviderList(context.Context, *LdapProviderListReq) (*LdapProviderListResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLdapProviderList not implemented")
+}
+func (UnimplementedCoreServer) GetLdapProviderById(context.Context, *IDReq) (*LdapProviderInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLdapProviderById not implemented")
+}
+func (UnimplementedCoreServer) DeleteLdapProvider(context.Context, *IDsReq) (*BaseResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteLdapProvider not implemented")
+}
+func (UnimplementedCoreServer) LdapLogin(context.Context, *LdapLoginReq) (*UserInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LdapLogin not implemented")
+}
+func (UnimplementedCoreServer) SyncLdapProvider(context.Context, *LdapSyncReq) (*LdapSyncRunInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SyncLdapProvider not implemented")
+}
+func (UnimplementedCoreServer) GetLdapSyncRunList(context.Context, *LdapSyncRunListReq) (*LdapSyncRunListResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLdapSyncRunList not implemented")
+}
+func (UnimplementedCoreServer) GetLdapSyncRunById(context.Context, *IDReq) (*LdapSyncRunInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLdapSyncRunById not implemented")
+}
+func (UnimplementedCoreServer) CreateMenu(context.Context, *MenuInfo) (*BaseIDResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateMenu not implemented")
+}
+func (UnimplementedCoreServer) UpdateMenu(context.Context, *MenuInfo) (*BaseResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMenu not implemented")
+}
+func (UnimplementedCoreServer) DeleteMenu(context.Context, *IDReq) (*BaseResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteMenu not implemented")
+}
+func (UnimplementedCoreServer) GetMenu(context.Context, *IDReq) (*MenuInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMenu not implemented")
+}
+func (UnimplementedCoreServer) GetMenuListByRole(context.Context, *BaseMsg) (*MenuInfoList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMenuListByRole not implemented")
+}
+func (UnimplementedCoreServer) GetMenuList(context.Context, *PageInfoReq) (*MenuInfoList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMenuList not implemented")
+}
+func (UnimplementedCoreServer) CreateOauthClient(context.Context, *OauthClientInfo) (*OauthClientSecretResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateOauthClient not implemented")
+}
+func (UnimplementedCoreServer) UpdateOauthClient(context.Context, *OauthClientInfo) (*BaseResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateOauthClient not implemented")
+}
+func (UnimplementedCoreServer) GetOauthClientList(context.Context, *OauthClientListReq) (*OauthClientListResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOauthClientList not implemented")
+}
+func (UnimplementedCoreServer) GetOauthClientById(context.Context, *IDReq) (*OauthClientInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOauthClientById not implemented")
+}
+func (UnimplementedCoreServer) DeleteOauthClient(context.Context, *IDsReq) (*BaseResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteOauthClient not implemented")
+}
+func (UnimplementedCoreServer) ResetOauthClientSecret(context.Context, *IDReq) (*OauthClientSecretResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetOauthClientSecret not implemented")
+}
+func (UnimplementedCoreServer) GetOauthClientByClientId(context.Context, *OauthClientIdReq) (*OauthClientInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOauthClientByClientId not implemented")
+}
+func (UnimplementedCoreServer) AuthenticateOauthClient(context.Context, *OauthClientAuthReq) (*OauthClientInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuthenticateOauthClient not implemented")
+}
+func (UnimplementedCoreServer) AuthorizeOauthClient(context.Context, *OauthAuthorizeReq) (*OauthAuthorizeResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuthorizeOauthClient not implemented")
+}
+func (UnimplementedCoreServer) ExchangeOauthAuthorizationCode(context.Context, *OauthCodeExchangeReq) (*OauthGrantInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExchangeOauthAuthorizationCode not implemented")
+}
+func (UnimplementedCoreServer) GetOauthConsentList(context.Context, *OauthConsentListReq) (*OauthConsentListResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOauthConsentList not implemented")
+}
+func (UnimplementedCoreServer) DeleteOauthConsent(context.Context, *IDsReq) (*BaseResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteOauthConsent not implemented")
+}
+func (UnimplementedCoreServer) CreateOauthProvider(context.Context, *OauthProviderInfo) (*BaseIDResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateOauthProvider not implemented")
+}
+func (UnimplementedCoreServer) UpdateOauthProvider(context.Context, *OauthProviderInfo) (*BaseResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateOauthProvider not implemented")
+}
+func (UnimplementedCoreServer) GetOauthProviderList(context.Context, *OauthProviderListReq) (*OauthProviderListResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOauthProviderList not implemented")
+}
+func (UnimplementedCoreServer) GetOauthProviderById(context.Context, *IDReq) (*OauthProviderInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOauthProviderById not implemented")
+}
+func (UnimplementedCoreServer) DeleteOauthProvider(context.Context, *IDsReq) (*BaseResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteOauthProvider not implemented")
+}
+func (UnimplementedCoreServer) OauthLogin(context.Context, *OauthLoginReq) (*OauthRedirectResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OauthLogin not implemented")
+}
+func (UnimplementedCoreServer) OauthCallback(context.Context, *CallbackReq) (*OauthCallbackResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OauthCallback not implemented")
+}
+func (UnimplementedCoreServer) PreviewOauthClaimMapping(context.Context, *OauthClaimMappingPreviewReq) (*OauthClaimMappingPreviewResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviewOauthClaimMapping not implemented")
+}
+func (UnimplementedCoreServer) OauthWebhook(context.Context, *OauthWebhookReq) (*OauthWebhookResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OauthWebhook not implemented")
+}
+func (UnimplementedCoreServer) CreateOauthAccount(context.Context, *OauthAccountInfo) (*BaseIDResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateOauthAccount not implemented")
+}
+func (UnimplementedCoreServer) UpdateOauthAccount(context.Context, *OauthAccountInfo) (*BaseResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateOauthAccount not implemented")
+}
+func (UnimplementedCoreServer) GetOauthAccountList(context.Context, *OauthAccountListReq) (*OauthAccountListResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOauthAccountList not implemented")
+}
+func (UnimplementedCoreServer) GetOauthAccountById(context.Context, *IDReq) (*OauthAccountInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOauthAccountById not implemented")
+}
+func (UnimplementedCoreServer) DeleteOauthAccount(context.Context, *IDsReq) (*BaseResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteOauthAccount not implemented")
+}
+func (UnimplementedCoreServer) BindOauthAccount(context.Context, *BindOauthAccountReq) (*BaseResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BindOauthAccount not implemented")
+}
+func (UnimplementedCoreServer) UnbindOauthAccount(context.Context, *UnbindOauthAccountReq) (*BaseResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnbindOauthAccount not implemented")
+}
+func (UnimplementedCoreServer) GetUserOauthAccounts(context.Context, *GetUserOauthAccountsReq) (*GetUserOauthAccountsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserOauthAccounts not implemented")
+}
+func (UnimplementedCoreServer) GetOauthAccessToken(context.Context, *OauthAccessTokenReq) (*OauthAccessTokenResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOauthAccessToken not implemented")
+}
+func (UnimplementedCoreServer) CreateOauthSession(context.Context, *CreateOauthSessionReq) (*BaseIDResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateOauthSession not implemented")
+}
+func (UnimplementedCoreServer) UpdateOauthSession(context.Context, *UpdateOauthSessionReq) (*BaseResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateOauthSession not implemented")
+}
+func (UnimplementedCoreServer) GetOauthSessionByState(context.Context, *GetOauthSessionByStateReq) (*OauthSessionInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOauthSessionByState not implemented")
+}
+func (UnimplementedCoreServer) DeleteOauthSession(context.Context, *IDReq) (*BaseResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteOauthSession not implemented")
+}
+func (UnimplementedCoreServer) CreateOauthProviderTemplate(context.Context, *OauthProviderTemplateInfo) (*BaseIDResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateOauthProviderTemplate not implemented")
+}
+func (UnimplementedCoreServer) UpdateOauthProviderTemplate(context.Context, *OauthProviderTemplateInfo) (*BaseResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateOauthProviderTemplate not implemented")
+}
+func (UnimplementedCoreServer) GetOauthProviderTemplateList(context.Context, *OauthProviderTemplateListReq) (*OauthProviderTemplateListResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOauthProviderTemplateList not implemented")
+}
+func (UnimplementedCoreServer) GetOauthProviderTemplateById(context.Context, *IDReq) (*OauthProviderTemplateInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOauthProviderTemplateById not implemented")
+}
+func (UnimplementedCoreServer) DeleteOauthProviderTemplate(context.Context, *IDsReq) (*BaseResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteOauthProviderTemplate not implemented")
+}
+func (UnimplementedCoreServer) EnableOauthProviderTemplate(context.Context, *EnableOauthProviderTemplateReq) (*BaseIDResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnableOauthProviderTemplate not implemented")
+}
+func (UnimplementedCoreServer) CreateOauthScope(context.Context, *OauthScopeInfo) (*BaseIDResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateOauthScope not implemented")
+}
+func (UnimplementedCoreServer) UpdateOauthScope(context.Context, *OauthScopeInfo) (*BaseResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateOauthScope not implemented")
+}
+func (UnimplementedCoreServer) GetOauthScopeList(context.Context, *OauthScopeListReq) (*OauthScopeListResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOauthScopeList not implemented")
+}
+func (UnimplementedCoreServer) GetOauthScopeById(context.Context, *IDReq) (*OauthScopeInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOauthScopeById not implemented")
+}
+func (UnimplementedCoreServer) DeleteOauthScope(context.Context, *IDsReq) (*BaseResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteOauthScope not implemented")
+}
+func (UnimplementedCoreServer) CreatePosition(context.Context, *PositionInfo) (*BaseIDResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePosition not implemented")
+}
+func (UnimplementedCoreServer) UpdatePosition(context.Context, *PositionInfo) (*BaseResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePosition not implemented")
+}
+func (UnimplementedCoreServer) GetPositionList(context.Context, *PositionListReq) (*PositionListResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPositionList not implemented")
+}
+func (UnimplementedCoreServer) GetPositionById(context.Context, *IDReq) (*PositionInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPositionById not implemented")
+}
+func (UnimplementedCoreServer) DeletePosition(context.Context, *IDsReq) (*BaseResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePosition not implemented")
+}
+func (UnimplementedCoreServer) CreateRole(context.Context, *RoleInfo) (*BaseIDResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRole not implemented")
+}
+func (UnimplementedCoreServer) UpdateRole(context.Context, *RoleInfo) (*BaseResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRole not implemented")
+}
+func (UnimplementedCoreServer) GetRoleList(context.Context, *RoleListReq) (*RoleListResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRoleList not implemented")
+}
+func (UnimplementedCoreServer) GetRoleById(context.Context, *IDReq) (*RoleInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRoleById not implemented")
 }
-func (UnimplementedCoreServer) UpdateApi(context.Context, *ApiInfo) (*BaseResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateApi not implemented")
+func (UnimplementedCoreServer) DeleteRole(context.Context, *IDsReq) (*BaseResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRole not implemented")
 }
-func (UnimplementedCoreServer) GetApiList(context.Context, *ApiListReq) (*ApiListResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetApiList not implemented")
+func (UnimplementedCoreServer) DeleteRoleWithUsers(context.Context, *IDReq) (*BaseResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRoleWithUsers not implemented")
 }
-func (UnimplementedCoreServer) GetApiById(context.Context, *IDReq) (*ApiInfo, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetApiById not implemented")
+func (UnimplementedCoreServer) InitRoleDataPermToRedis(context.Context, *Empty) (*BaseResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InitRoleDataPermToRedis not implemented")
 }
-func (UnimplementedCoreServer) DeleteApi(context.Context, *IDsReq) (*BaseResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteApi not implemented")
+func (UnimplementedCoreServer) AssignRoleDataScope(context.Context, *RoleDataScopeReq) (*BaseResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssignRoleDataScope not implemented")
 }
-func (UnimplementedCoreServer) CreateAuditLog(context.Context, *AuditLogInfo) (*BaseUUIDResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateAuditLog not implemented")
+func (UnimplementedCoreServer) CancelAuth(context.Context, *RoleAuthReq) (*BaseResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelAuth not implemented")
 }
-func (UnimplementedCoreServer) GetAuditLogList(context.Context, *AuditLogListReq) (*AuditLogListResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAuditLogList not implemented")
+func (UnimplementedCoreServer) AddAuth(context.Context, *RoleAuthReq) (*BaseResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddAuth not implemented")
 }
-func (UnimplementedCoreServer) GetAuditLogById(context.Context, *UUIDReq) (*AuditLogInfo, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAuditLogById not implemented")
+func (UnimplementedCoreServer) ChangeRoleStatus(context.Context, *RoleStatusChangeParam) (*BaseResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeRoleStatus not implemented")
 }
-func (UnimplementedCoreServer) DeleteAuditLog(context.Context, *UUIDsReq) (*BaseResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteAuditLog not implemented")
+func (UnimplementedCoreServer) SetRoleParents(context.Context, *RoleParentsReq) (*BaseResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRoleParents not implemented")
 }
-func (UnimplementedCoreServer) GetAuditLogStats(context.Context, *AuditLogStatsReq) (*AuditLogStatsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAuditLogStats not implemented")
+func (UnimplementedCoreServer) GetRoleParents(context.Context, *IDReq) (*RoleParentsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRoleParents not implemented")
 }
-func (UnimplementedCoreServer) GetMenuAuthority(context.Context, *IDReq) (*RoleMenuAuthorityResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetMenuAuthority not implemented")
+func (UnimplementedCoreServer) GetRoleEffectivePermissions(context.Context, *IDReq) (*RoleEffectivePermissionsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRoleEffectivePermissions not implemented")
 }
-func (UnimplementedCoreServer) CreateOrUpdateMenuAuthority(context.Context, *RoleMenuAuthorityReq) (*BaseResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateOrUpdateMenuAuthority not implemented")
+func (UnimplementedCoreServer) CreateSamlProvider(context.Context, *SamlProviderInfo) (*BaseIDResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSamlProvider not implemented")
 }
-func (UnimplementedCoreServer) InitDatabase(context.Context, *Empty) (*BaseResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method InitDatabase not implemented")
+func (UnimplementedCoreServer) UpdateSamlProvider(context.Context, *SamlProviderInfo) (*BaseResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSamlProvider not implemented")
 }
-func (UnimplementedCoreServer) CreateCasbinRule(context.Context, *CasbinRuleInfo) (*BaseIDResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateCasbinRule not implemented")
+func (UnimplementedCoreServer) GetSamlProviderList(context.Context, *SamlProviderListReq) (*SamlProviderListResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSamlProviderList not implemented")
 }
-func (UnimplementedCoreServer) UpdateCasbinRule(context.Context, *CasbinRuleInfo) (*BaseResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateCasbinRule not implemented")
+func (UnimplementedCoreServer) GetSamlProviderById(context.Context, *IDReq) (*SamlProviderInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSamlProviderById not implemented")
 }
-func (UnimplementedCoreServer) DeleteCasbinRule(context.Context, *IDsReq) (*BaseResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteCasbinRule not implemented")
+func (UnimplementedCoreServer) DeleteSamlProvider(context.Context, *IDsReq) (*BaseResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSamlProvider not implemented")
 }
-func (UnimplementedCoreServer) GetCasbinRuleList(context.Context, *CasbinRuleListReq) (*CasbinRuleListResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetCasbinRuleList not implemented")
+func (UnimplementedCoreServer) ImportSamlIdpMetadata(context.Context, *SamlMetadataImportReq) (*BaseResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportSamlIdpMetadata not implemented")
 }
-func (UnimplementedCoreServer) GetCasbinRuleById(context.Context, *IDReq) (*CasbinRuleInfo, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetCasbinRuleById not implemented")
+func (UnimplementedCoreServer) GetSamlSpMetadata(context.Context, *SamlSpMetadataReq) (*SamlSpMetadataResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSamlSpMetadata not implemented")
 }
-func (UnimplementedCoreServer) BatchCreateCasbinRules(context.Context, *BatchCreateCasbinRulesReq) (*BaseResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BatchCreateCasbinRules not implemented")
+func (UnimplementedCoreServer) SamlLogin(context.Context, *SamlLoginReq) (*SamlLoginResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SamlLogin not implemented")
 }
-func (UnimplementedCoreServer) BatchUpdateCasbinRules(context.Context, *BatchUpdateCasbinRulesReq) (*BaseResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BatchUpdateCasbinRules not implemented")
+func (UnimplementedCoreServer) SamlAcs(context.Context, *SamlAcsReq) (*SamlAcsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SamlAcs not implemented")
 }
-func (UnimplementedCoreServer) BatchDeleteCasbinRules(context.Context, *IDsReq) (*BaseResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BatchDeleteCasbinRules not implemented")
+func (UnimplementedCoreServer) CreateScimToken(context.Context, *ScimTokenInfo) (*ScimTokenCreateResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateScimToken not implemented")
 }
-func (UnimplementedCoreServer) CheckPermission(context.Context, *PermissionCheckReq) (*PermissionCheckResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CheckPermission not implemented")
+func (UnimplementedCoreServer) UpdateScimToken(context.Context, *ScimTokenInfo) (*BaseResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateScimToken not implemented")
 }
-func (UnimplementedCoreServer) BatchCheckPermission(context.Context, *BatchPermissionCheckReq) (*BatchPermissionCheckResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BatchCheckPermission not implemented")
+func (UnimplementedCoreServer) GetScimTokenList(context.Context, *ScimTokenListReq) (*ScimTokenListResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetScimTokenList not implemented")
 }
-func (UnimplementedCoreServer) GetUserPermissionSummary(context.Context, *GetUserPermissionSummaryReq) (*GetUserPermissionSummaryResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetUserPermissionSummary not implemented")
+func (UnimplementedCoreServer) GetScimTokenById(context.Context, *IDReq) (*ScimTokenInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetScimTokenById not implemented")
 }
-func (UnimplementedCoreServer) ValidateCasbinRule(context.Context, *ValidateCasbinRuleReq) (*ValidateCasbinRuleResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ValidateCasbinRule not implemented")
+func (UnimplementedCoreServer) DeleteScimToken(context.Context, *IDsReq) (*BaseResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteScimToken not implemented")
 }
-func (UnimplementedCoreServer) SyncCasbinRules(context.Context, *SyncCasbinRulesReq) (*SyncCasbinRulesResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SyncCasbinRules not implemented")
+func (UnimplementedCoreServer) AuthenticateScimToken(context.Context, *ScimTokenAuthReq) (*ScimTokenInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuthenticateScimToken not implemented")
 }
-func (UnimplementedCoreServer) RefreshCasbinCache(context.Context, *RefreshCasbinCacheReq) (*RefreshCasbinCacheResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RefreshCasbinCache not implemented")
+func (UnimplementedCoreServer) CreateTenant(context.Context, *TenantInfo) (*BaseIDResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTenant not implemented")
 }
-func (UnimplementedCoreServer) CreateConfiguration(context.Context, *ConfigurationInfo) (*BaseIDResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateConfiguration not implemented")
+func (UnimplementedCoreServer) UpdateTenant(context.Context, *TenantInfo) (*BaseResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTenant not implemented")
 }
-func (UnimplementedCoreServer) UpdateConfiguration(context.Context, *ConfigurationInfo) (*BaseResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateConfiguration not implemented")
+func (UnimplementedCoreServer) GetTenantList(context.Context, *TenantListReq) (*TenantListResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTenantList not implemented")
 }
-func (UnimplementedCoreServer) GetConfigurationList(context.Context, *ConfigurationListReq) (*ConfigurationListResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetConfigurationList not implemented")
+func (UnimplementedCoreServer) GetTenantById(context.Context, *IDReq) (*TenantInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTenantById not implemented")
 }
-func (UnimplementedCoreServer) GetConfigurationById(context.Context, *IDReq) (*ConfigurationInfo, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetConfigurationById not implemented")
+func (UnimplementedCoreServer) GetTenantByCode(context.Context, *TenantCodeReq) (*TenantInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTenantByCode not implemented")
 }
-func (UnimplementedCoreServer) DeleteConfiguration(context.Context, *IDsReq) (*BaseResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteConfiguration not implemented")
+func (UnimplementedCoreServer) DeleteTenant(context.Context, *IDsReq) (*BaseResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTenant not implemented")
 }
-func (UnimplementedCoreServer) RefreshConfigurationCache(context.Context, *Empty) (*BaseResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RefreshConfigurationCache not implemented")
+func (UnimplementedCoreServer) UpdateTenantStatus(context.Context, *TenantStatusReq) (*BaseResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTenantStatus not implemented")
 }
-func (UnimplementedCoreServer) CreateDepartment(context.Context, *DepartmentInfo) (*BaseIDResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateDepartment not implemented")
+func (UnimplementedCoreServer) InitTenant(context.Context, *TenantInitReq) (*BaseIDResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InitTenant not implemented")
 }
-func (UnimplementedCoreServer) UpdateDepartment(context.Context, *DepartmentInfo) (*BaseResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateDepartment not implemented")
+func (UnimplementedCoreServer) GetTenantInitJobById(context.Context, *IDReq) (*TenantInitJobInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTenantInitJobById not implemented")
 }
-func (UnimplementedCoreServer) GetDepartmentList(context.Context, *DepartmentListReq) (*DepartmentListResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetDepartmentList not implemented")
+func (UnimplementedCoreServer) GetTenantInitJobList(context.Context, *TenantInitJobListReq) (*TenantInitJobListResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTenantInitJobList not implemented")
 }
-func (UnimplementedCoreServer) GetDepartmentById(context.Context, *IDReq) (*DepartmentInfo, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetDepartmentById not implemented")
+func (UnimplementedCoreServer) RetryTenantInitJob(context.Context, *TenantInitJobRetryReq) (*BaseIDResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetryTenantInitJob not implemented")
 }
-func (UnimplementedCoreServer) DeleteDepartment(context.Context, *IDsReq) (*BaseResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteDepartment not implemented")
+func (UnimplementedCoreServer) DiagnoseTenants(context.Context, *TenantDoctorReq) (*TenantDoctorResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiagnoseTenants not implemented")
+}
+func (UnimplementedCoreServer) RepairTenant(context.Context, *TenantRepairReq) (*TenantDoctorResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RepairTenant not implemented")
+}
+func (UnimplementedCoreServer) StartImpersonation(context.Context, *ImpersonationStartReq) (*ImpersonationSessionInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartImpersonation not implemented")
+}
+func (UnimplementedCoreServer) ReviewImpersonation(context.Context, *ImpersonationReviewReq) (*ImpersonationSessionInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReviewImpersonation not implemented")
+}
+func (UnimplementedCoreServer) EndImpersonation(context.Context, *UUIDReq) (*BaseResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EndImpersonation not implemented")
+}
+func (UnimplementedCoreServer) GetActiveImpersonation(context.Context, *UUIDReq) (*ImpersonationSessionInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetActiveImpersonation not implemented")
+}
+func (UnimplementedCoreServer) GetImpersonationSessionList(context.Context, *ImpersonationSessionListReq) (*ImpersonationSessionListResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetImpersonationSessionList not implemented")
+}
+func (UnimplementedCoreServer) GetPublicTenantList(context.Context, *Empty) (*PublicTenantListResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPublicTenantList not implemented")
+}
+func (UnimplementedCoreServer) RegisterTenantInitPlugin(context.Context, *TenantInitPluginInfo) (*BaseIDResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterTenantInitPlugin not implemented")
+}
+func (UnimplementedCoreServer) UpdateTenantInitPlugin(context.Context, *TenantInitPluginInfo) (*BaseResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTenantInitPlugin not implemented")
+}
+func (UnimplementedCoreServer) GetTenantInitPluginList(context.Context, *TenantInitPluginListReq) (*TenantInitPluginListResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTenantInitPluginList not implemented")
+}
+func (UnimplementedCoreServer) GetTenantInitPluginById(context.Context, *IDReq) (*TenantInitPluginInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTenantInitPluginById not implemented")
+}
+func (UnimplementedCoreServer) DeleteTenantInitPlugin(context.Context, *IDsReq) (*BaseResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTenantInitPlugin not implemented")
+}
+func (UnimplementedCoreServer) CreateTenantInitTemplate(context.Context, *TenantInitTemplateInfo) (*BaseIDResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTenantInitTemplate not implemented")
+}
+func (UnimplementedCoreServer) UpdateTenantInitTemplate(context.Context, *TenantInitTemplateInfo) (*BaseResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTenantInitTemplate not implemented")
+}
+func (UnimplementedCoreServer) GetTenantInitTemplateList(context.Context, *TenantInitTemplateListReq) (*TenantInitTemplateListResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTenantInitTemplateList not implemented")
+}
+func (UnimplementedCoreServer) GetTenantInitTemplateById(context.Context, *IDReq) (*TenantInitTemplateInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTenantInitTemplateById not implemented")
+}
+func (UnimplementedCoreServer) DeleteTenantInitTemplate(context.Context, *IDsReq) (*BaseResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTenantInitTemplate not implemented")
+}
+func (UnimplementedCoreServer) PreviewTenantInitTemplate(context.Context, *TenantInitTemplatePreviewReq) (*TenantInitTemplatePreviewResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviewTenantInitTemplate not implemented")
+}
+func (UnimplementedCoreServer) CreateToken(context.Context, *TokenInfo) (*BaseUUIDResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateToken not implemented")
+}
+func (UnimplementedCoreServer) DeleteToken(context.Context, *UUIDsReq) (*BaseResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteToken not implemented")
+}
+func (UnimplementedCoreServer) GetTokenList(context.Context, *TokenListReq) (*TokenListResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTokenList not implemented")
+}
+func (UnimplementedCoreServer) GetTokenById(context.Context, *UUIDReq) (*TokenInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTokenById not implemented")
+}
+func (UnimplementedCoreServer) BlockUserAllToken(context.Context, *UUIDReq) (*BaseResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockUserAllToken not implemented")
+}
+func (UnimplementedCoreServer) UpdateToken(context.Context, *TokenInfo) (*BaseResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateToken not implemented")
+}
+func (UnimplementedCoreServer) GetUserSessionList(context.Context, *UserSessionListReq) (*TokenListResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserSessionList not implemented")
+}
+func (UnimplementedCoreServer) RevokeUserSession(context.Context, *UserSessionRevokeReq) (*BaseResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeUserSession not implemented")
+}
+func (UnimplementedCoreServer) TouchToken(context.Context, *TokenTouchReq) (*BaseResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TouchToken not implemented")
+}
+func (UnimplementedCoreServer) CreateUser(context.Context, *UserInfo) (*BaseUUIDResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateUser not implemented")
+}
+func (UnimplementedCoreServer) UpdateUser(context.Context, *UserInfo) (*BaseResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateUser not implemented")
+}
+func (UnimplementedCoreServer) GetUserList(context.Context, *UserListReq) (*UserListResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserList not implemented")
+}
+func (UnimplementedCoreServer) GetUserById(context.Context, *UUIDReq) (*UserInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserById not implemented")
+}
+func (UnimplementedCoreServer) GetUserByUsername(context.Context, *UsernameReq) (*UserInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserByUsername not implemented")
+}
+func (UnimplementedCoreServer) DeleteUser(context.Context, *UUIDsReq) (*BaseResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteUser not implemented")
+}
+func (UnimplementedCoreServer) ResetPwd(context.Context, *ResetPwdReq) (*BaseResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetPwd not implemented")
+}
+func (UnimplementedCoreServer) UnallocatedList(context.Context, *RoleUnallocatedListReq) (*UserListResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnallocatedList not implemented")
+}
+func (UnimplementedCoreServer) mustEmbedUnimplementedCoreServer() {}
+func (UnimplementedCoreServer) testEmbeddedByValue()              {}
+
+// UnsafeCoreServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CoreServer will
+// result in compilation errors.
+type UnsafeCoreServer interface {
+	mustEmbedUnimplementedCoreServer()
+}
+
+func RegisterCoreServer(s grpc.ServiceRegistrar, srv CoreServer) {
+	// If the following call pancis, it indicates UnimplementedCoreServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Core_ServiceDesc, srv)
+}
+
+func _Core_CreateApi_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApiInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).CreateApi(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_CreateApi_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).CreateApi(ctx, req.(*ApiInfo))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Core_UpdateApi_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApiInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).UpdateApi(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_UpdateApi_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).UpdateApi(ctx, req.(*ApiInfo))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Core_GetApiList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApiListReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).GetApiList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_GetApiList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).GetApiList(ctx, req.(*ApiListReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Core_GetApiById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IDReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).GetApiById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_GetApiById_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).GetApiById(ctx, req.(*IDReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedCoreServer) InitDeptDataPermToRedis(context.Context, *Empty) (*BaseResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method InitDeptDataPermToRedis not implemented")
+
+func _Core_DeleteApi_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IDsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).DeleteApi(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_DeleteApi_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).DeleteApi(ctx, req.(*IDsReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedCoreServer) CreateDictionary(context.Context, *DictionaryInfo) (*BaseIDResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateDictionary not implemented")
+
+func _Core_ApiRegistration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApiRegistrationReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).ApiRegistration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_ApiRegistration_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).ApiRegistration(ctx, req.(*ApiRegistrationReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedCoreServer) UpdateDictionary(context.Context, *DictionaryInfo) (*BaseResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateDictionary not implemented")
+
+func _Core_CreateAuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuditLogInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).CreateAuditLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_CreateAuditLog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).CreateAuditLog(ctx, req.(*AuditLogInfo))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedCoreServer) GetDictionaryList(context.Context, *DictionaryListReq) (*DictionaryListResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetDictionaryList not implemented")
+
+func _Core_GetAuditLogList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuditLogListReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).GetAuditLogList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_GetAuditLogList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).GetAuditLogList(ctx, req.(*AuditLogListReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedCoreServer) GetDictionaryById(context.Context, *IDReq) (*DictionaryInfo, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetDictionaryById not implemented")
+
+func _Core_GetAuditLogById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UUIDReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).GetAuditLogById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_GetAuditLogById_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).GetAuditLogById(ctx, req.(*UUIDReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedCoreServer) DeleteDictionary(context.Context, *IDsReq) (*BaseResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteDictionary not implemented")
+
+func _Core_GetAuditLogStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuditLogStatsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).GetAuditLogStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_GetAuditLogStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).GetAuditLogStats(ctx, req.(*AuditLogStatsReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedCoreServer) CreateDictionaryDetail(context.Context, *DictionaryDetailInfo) (*BaseIDResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateDictionaryDetail not implemented")
+
+func _Core_VerifyAuditLogChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).VerifyAuditLogChain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_VerifyAuditLogChain_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).VerifyAuditLogChain(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedCoreServer) UpdateDictionaryDetail(context.Context, *DictionaryDetailInfo) (*BaseResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateDictionaryDetail not implemented")
+
+func _Core_GetAuditLogArchiveList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuditLogArchiveListReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).GetAuditLogArchiveList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_GetAuditLogArchiveList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).GetAuditLogArchiveList(ctx, req.(*AuditLogArchiveListReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedCoreServer) GetDictionaryDetailList(context.Context, *DictionaryDetailListReq) (*DictionaryDetailListResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetDictionaryDetailList not implemented")
+
+func _Core_RestoreAuditLogRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuditLogListReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).RestoreAuditLogRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_RestoreAuditLogRange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).RestoreAuditLogRange(ctx, req.(*AuditLogListReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedCoreServer) GetDictionaryDetailById(context.Context, *IDReq) (*DictionaryDetailInfo, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetDictionaryDetailById not implemented")
+
+func _Core_GetMenuAuthority_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IDReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).GetMenuAuthority(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_GetMenuAuthority_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).GetMenuAuthority(ctx, req.(*IDReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedCoreServer) DeleteDictionaryDetail(context.Context, *IDsReq) (*BaseResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteDictionaryDetail not implemented")
+
+func _Core_CreateOrUpdateMenuAuthority_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RoleMenuAuthorityReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).CreateOrUpdateMenuAuthority(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_CreateOrUpdateMenuAuthority_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).CreateOrUpdateMenuAuthority(ctx, req.(*RoleMenuAuthorityReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedCoreServer) GetDictionaryDetailByDictionaryName(context.Context, *BaseMsg) (*DictionaryDetailListResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetDictionaryDetailByDictionaryName not implemented")
+
+func _Core_GetRolePolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IDReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).GetRolePolicies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_GetRolePolicies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).GetRolePolicies(ctx, req.(*IDReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedCoreServer) CreateMenu(context.Context, *MenuInfo) (*BaseIDResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateMenu not implemented")
+
+func _Core_ReplaceRolePolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplaceRolePoliciesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).ReplaceRolePolicies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_ReplaceRolePolicies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).ReplaceRolePolicies(ctx, req.(*ReplaceRolePoliciesReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedCoreServer) UpdateMenu(context.Context, *MenuInfo) (*BaseResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateMenu not implemented")
+
+func _Core_ExportPolicyBundle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PolicyBundleExportReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).ExportPolicyBundle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_ExportPolicyBundle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).ExportPolicyBundle(ctx, req.(*PolicyBundleExportReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedCoreServer) DeleteMenu(context.Context, *IDReq) (*BaseResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteMenu not implemented")
+
+func _Core_ImportPolicyBundle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PolicyBundleImportReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).ImportPolicyBundle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_ImportPolicyBundle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).ImportPolicyBundle(ctx, req.(*PolicyBundleImportReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedCoreServer) GetMenu(context.Context, *IDReq) (*MenuInfo, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetMenu not implemented")
+
+func _Core_InitDatabase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).InitDatabase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_InitDatabase_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).InitDatabase(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedCoreServer) GetMenuListByRole(context.Context, *BaseMsg) (*MenuInfoList, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetMenuListByRole not implemented")
+
+func _Core_CreateCasbinRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CasbinRuleInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).CreateCasbinRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_CreateCasbinRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).CreateCasbinRule(ctx, req.(*CasbinRuleInfo))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedCoreServer) GetMenuList(context.Context, *PageInfoReq) (*MenuInfoList, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetMenuList not implemented")
+
+func _Core_UpdateCasbinRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CasbinRuleInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).UpdateCasbinRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_UpdateCasbinRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).UpdateCasbinRule(ctx, req.(*CasbinRuleInfo))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedCoreServer) CreateOauthProvider(context.Context, *OauthProviderInfo) (*BaseIDResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateOauthProvider not implemented")
+
+func _Core_DeleteCasbinRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IDsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).DeleteCasbinRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_DeleteCasbinRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).DeleteCasbinRule(ctx, req.(*IDsReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedCoreServer) UpdateOauthProvider(context.Context, *OauthProviderInfo) (*BaseResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateOauthProvider not implemented")
+
+func _Core_GetCasbinRuleList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CasbinRuleListReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).GetCasbinRuleList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_GetCasbinRuleList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).GetCasbinRuleList(ctx, req.(*CasbinRuleListReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedCoreServer) GetOauthProviderList(context.Context, *OauthProviderListReq) (*OauthProviderListResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetOauthProviderList not implemented")
+
+func _Core_GetCasbinRuleById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IDReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).GetCasbinRuleById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_GetCasbinRuleById_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).GetCasbinRuleById(ctx, req.(*IDReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedCoreServer) GetOauthProviderById(context.Context, *IDReq) (*OauthProviderInfo, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetOauthProviderById not implemented")
+
+func _Core_BatchCreateCasbinRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchCreateCasbinRulesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).BatchCreateCasbinRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_BatchCreateCasbinRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).BatchCreateCasbinRules(ctx, req.(*BatchCreateCasbinRulesReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedCoreServer) DeleteOauthProvider(context.Context, *IDsReq) (*BaseResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteOauthProvider not implemented")
+
+func _Core_BatchUpdateCasbinRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchUpdateCasbinRulesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).BatchUpdateCasbinRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_BatchUpdateCasbinRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).BatchUpdateCasbinRules(ctx, req.(*BatchUpdateCasbinRulesReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedCoreServer) OauthLogin(context.Context, *OauthLoginReq) (*OauthRedirectResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method OauthLogin not implemented")
+
+func _Core_BatchDeleteCasbinRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IDsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).BatchDeleteCasbinRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_BatchDeleteCasbinRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).BatchDeleteCasbinRules(ctx, req.(*IDsReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedCoreServer) OauthCallback(context.Context, *CallbackReq) (*UserInfo, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method OauthCallback not implemented")
+
+func _Core_CheckPermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PermissionCheckReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).CheckPermission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_CheckPermission_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).CheckPermission(ctx, req.(*PermissionCheckReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedCoreServer) CreateOauthAccount(context.Context, *OauthAccountInfo) (*BaseIDResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateOauthAccount not implemented")
+
+func _Core_BatchCheckPermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchPermissionCheckReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).BatchCheckPermission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_BatchCheckPermission_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).BatchCheckPermission(ctx, req.(*BatchPermissionCheckReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedCoreServer) UpdateOauthAccount(context.Context, *OauthAccountInfo) (*BaseResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateOauthAccount not implemented")
+
+func _Core_GetUserPermissionSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserPermissionSummaryReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).GetUserPermissionSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_GetUserPermissionSummary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).GetUserPermissionSummary(ctx, req.(*GetUserPermissionSummaryReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedCoreServer) GetOauthAccountList(context.Context, *OauthAccountListReq) (*OauthAccountListResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetOauthAccountList not implemented")
+
+func _Core_ValidateCasbinRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateCasbinRuleReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).ValidateCasbinRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_ValidateCasbinRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).ValidateCasbinRule(ctx, req.(*ValidateCasbinRuleReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedCoreServer) GetOauthAccountById(context.Context, *IDReq) (*OauthAccountInfo, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetOauthAccountById not implemented")
+
+func _Core_SyncCasbinRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SyncCasbinRulesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).SyncCasbinRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_SyncCasbinRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).SyncCasbinRules(ctx, req.(*SyncCasbinRulesReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedCoreServer) DeleteOauthAccount(context.Context, *IDsReq) (*BaseResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteOauthAccount not implemented")
+
+func _Core_RefreshCasbinCache_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshCasbinCacheReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).RefreshCasbinCache(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_RefreshCasbinCache_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).RefreshCasbinCache(ctx, req.(*RefreshCasbinCacheReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedCoreServer) BindOauthAccount(context.Context, *BindOauthAccountReq) (*BaseResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BindOauthAccount not implemented")
+
+func _Core_CreateConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfigurationInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).CreateConfiguration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_CreateConfiguration_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).CreateConfiguration(ctx, req.(*ConfigurationInfo))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedCoreServer) UnbindOauthAccount(context.Context, *UnbindOauthAccountReq) (*BaseResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UnbindOauthAccount not implemented")
+
+func _Core_UpdateConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfigurationInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).UpdateConfiguration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_UpdateConfiguration_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).UpdateConfiguration(ctx, req.(*ConfigurationInfo))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedCoreServer) GetUserOauthAccounts(context.Context, *GetUserOauthAccountsReq) (*GetUserOauthAccountsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetUserOauthAccounts not implemented")
+
+func _Core_GetConfigurationList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfigurationListReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).GetConfigurationList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_GetConfigurationList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).GetConfigurationList(ctx, req.(*ConfigurationListReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedCoreServer) CreateOauthSession(context.Context, *CreateOauthSessionReq) (*BaseIDResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateOauthSession not implemented")
+
+func _Core_GetConfigurationById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IDReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).GetConfigurationById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_GetConfigurationById_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).GetConfigurationById(ctx, req.(*IDReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedCoreServer) UpdateOauthSession(context.Context, *UpdateOauthSessionReq) (*BaseResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateOauthSession not implemented")
+
+func _Core_DeleteConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IDsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).DeleteConfiguration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_DeleteConfiguration_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).DeleteConfiguration(ctx, req.(*IDsReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedCoreServer) GetOauthSessionByState(context.Context, *GetOauthSessionByStateReq) (*OauthSessionInfo, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetOauthSessionByState not implemented")
+
+func _Core_RefreshConfigurationCache_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).RefreshConfigurationCache(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_RefreshConfigurationCache_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).RefreshConfigurationCache(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedCoreServer) DeleteOauthSession(context.Context, *IDReq) (*BaseResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteOauthSession not implemented")
+
+func _Core_CreateDepartment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DepartmentInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).CreateDepartment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_CreateDepartment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).CreateDepartment(ctx, req.(*DepartmentInfo))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedCoreServer) CreatePosition(context.Context, *PositionInfo) (*BaseIDResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreatePosition not implemented")
+
+func _Core_UpdateDepartment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DepartmentInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).UpdateDepartment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_UpdateDepartment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).UpdateDepartment(ctx, req.(*DepartmentInfo))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedCoreServer) UpdatePosition(context.Context, *PositionInfo) (*BaseResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdatePosition not implemented")
+
+func _Core_GetDepartmentList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DepartmentListReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).GetDepartmentList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_GetDepartmentList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).GetDepartmentList(ctx, req.(*DepartmentListReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedCoreServer) GetPositionList(context.Context, *PositionListReq) (*PositionListResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPositionList not implemented")
+
+func _Core_GetDepartmentById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IDReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).GetDepartmentById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_GetDepartmentById_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).GetDepartmentById(ctx, req.(*IDReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedCoreServer) GetPositionById(context.Context, *IDReq) (*PositionInfo, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPositionById not implemented")
+
+func _Core_DeleteDepartment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IDsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).DeleteDepartment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_DeleteDepartment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).DeleteDepartment(ctx, req.(*IDsReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedCoreServer) DeletePosition(context.Context, *IDsReq) (*BaseResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeletePosition not implemented")
+
+func _Core_InitDeptDataPermToRedis_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).InitDeptDataPermToRedis(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_InitDeptDataPermToRedis_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).InitDeptDataPermToRedis(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedCoreServer) CreateRole(context.Context, *RoleInfo) (*BaseIDResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateRole not implemented")
+
+func _Core_CreateDictionary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DictionaryInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).CreateDictionary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_CreateDictionary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).CreateDictionary(ctx, req.(*DictionaryInfo))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedCoreServer) UpdateRole(context.Context, *RoleInfo) (*BaseResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateRole not implemented")
+
+func _Core_UpdateDictionary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DictionaryInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).UpdateDictionary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_UpdateDictionary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).UpdateDictionary(ctx, req.(*DictionaryInfo))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedCoreServer) GetRoleList(context.Context, *RoleListReq) (*RoleListResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetRoleList not implemented")
+
+func _Core_GetDictionaryList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DictionaryListReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).GetDictionaryList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_GetDictionaryList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).GetDictionaryList(ctx, req.(*DictionaryListReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedCoreServer) GetRoleById(context.Context, *IDReq) (*RoleInfo, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetRoleById not implemented")
+
+func _Core_GetDictionaryById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IDReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).GetDictionaryById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_GetDictionaryById_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).GetDictionaryById(ctx, req.(*IDReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedCoreServer) DeleteRole(context.Context, *IDsReq) (*BaseResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteRole not implemented")
+
+func _Core_DeleteDictionary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IDsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).DeleteDictionary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_DeleteDictionary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).DeleteDictionary(ctx, req.(*IDsReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedCoreServer) InitRoleDataPermToRedis(context.Context, *Empty) (*BaseResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method InitRoleDataPermToRedis not implemented")
+
+func _Core_CreateDictionaryDetail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DictionaryDetailInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).CreateDictionaryDetail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_CreateDictionaryDetail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).CreateDictionaryDetail(ctx, req.(*DictionaryDetailInfo))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedCoreServer) AssignRoleDataScope(context.Context, *RoleDataScopeReq) (*BaseResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AssignRoleDataScope not implemented")
+
+func _Core_UpdateDictionaryDetail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DictionaryDetailInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).UpdateDictionaryDetail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_UpdateDictionaryDetail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).UpdateDictionaryDetail(ctx, req.(*DictionaryDetailInfo))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedCoreServer) CancelAuth(context.Context, *RoleAuthReq) (*BaseResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CancelAuth not implemented")
+
+func _Core_GetDictionaryDetailList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DictionaryDetailListReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).GetDictionaryDetailList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_GetDictionaryDetailList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).GetDictionaryDetailList(ctx, req.(*DictionaryDetailListReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedCoreServer) AddAuth(context.Context, *RoleAuthReq) (*BaseResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddAuth not implemented")
+
+func _Core_GetDictionaryDetailById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IDReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).GetDictionaryDetailById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_GetDictionaryDetailById_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).GetDictionaryDetailById(ctx, req.(*IDReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedCoreServer) ChangeRoleStatus(context.Context, *RoleStatusChangeParam) (*BaseResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ChangeRoleStatus not implemented")
+
+func _Core_DeleteDictionaryDetail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IDsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).DeleteDictionaryDetail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_DeleteDictionaryDetail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).DeleteDictionaryDetail(ctx, req.(*IDsReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedCoreServer) CreateTenant(context.Context, *TenantInfo) (*BaseIDResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateTenant not implemented")
+
+func _Core_GetDictionaryDetailByDictionaryName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BaseMsg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).GetDictionaryDetailByDictionaryName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_GetDictionaryDetailByDictionaryName_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).GetDictionaryDetailByDictionaryName(ctx, req.(*BaseMsg))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedCoreServer) UpdateTenant(context.Context, *TenantInfo) (*BaseResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateTenant not implemented")
+
+func _Core_CreateLdapProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LdapProviderInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).CreateLdapProvider(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_CreateLdapProvider_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).CreateLdapProvider(ctx, req.(*LdapProviderInfo))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedCoreServer) GetTenantList(context.Context, *TenantListReq) (*TenantListResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetTenantList not implemented")
+
+func _Core_UpdateLdapProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LdapProviderInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).UpdateLdapProvider(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_UpdateLdapProvider_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).UpdateLdapProvider(ctx, req.(*LdapProviderInfo))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedCoreServer) GetTenantById(context.Context, *IDReq) (*TenantInfo, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetTenantById not implemented")
+
+func _Core_GetLdapProviderList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LdapProviderListReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).GetLdapProviderList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_GetLdapProviderList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).GetLdapProviderList(ctx, req.(*LdapProviderListReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedCoreServer) GetTenantByCode(context.Context, *TenantCodeReq) (*TenantInfo, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetTenantByCode not implemented")
+
+func _Core_GetLdapProviderById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IDReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).GetLdapProviderById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_GetLdapProviderById_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).GetLdapProviderById(ctx, req.(*IDReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedCoreServer) DeleteTenant(context.Context, *IDsReq) (*BaseResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteTenant not implemented")
+
+func _Core_DeleteLdapProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IDsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).DeleteLdapProvider(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_DeleteLdapProvider_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).DeleteLdapProvider(ctx, req.(*IDsReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedCoreServer) UpdateTenantStatus(context.Context, *TenantStatusReq) (*BaseResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateTenantStatus not implemented")
+
+func _Core_LdapLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LdapLoginReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).LdapLogin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_LdapLogin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).LdapLogin(ctx, req.(*LdapLoginReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedCoreServer) InitTenant(context.Context, *TenantInitReq) (*BaseResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method InitTenant not implemented")
+
+func _Core_SyncLdapProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LdapSyncReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).SyncLdapProvider(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_SyncLdapProvider_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).SyncLdapProvider(ctx, req.(*LdapSyncReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedCoreServer) GetPublicTenantList(context.Context, *Empty) (*PublicTenantListResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPublicTenantList not implemented")
+
+func _Core_GetLdapSyncRunList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LdapSyncRunListReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).GetLdapSyncRunList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_GetLdapSyncRunList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).GetLdapSyncRunList(ctx, req.(*LdapSyncRunListReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedCoreServer) CreateToken(context.Context, *TokenInfo) (*BaseUUIDResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateToken not implemented")
+
+func _Core_GetLdapSyncRunById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IDReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).GetLdapSyncRunById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_GetLdapSyncRunById_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).GetLdapSyncRunById(ctx, req.(*IDReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedCoreServer) DeleteToken(context.Context, *UUIDsReq) (*BaseResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteToken not implemented")
+
+func _Core_CreateMenu_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MenuInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).CreateMenu(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_CreateMenu_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).CreateMenu(ctx, req.(*MenuInfo))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedCoreServer) GetTokenList(context.Context, *TokenListReq) (*TokenListResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetTokenList not implemented")
+
+func _Core_UpdateMenu_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MenuInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).UpdateMenu(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_UpdateMenu_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).UpdateMenu(ctx, req.(*MenuInfo))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedCoreServer) GetTokenById(context.Context, *UUIDReq) (*TokenInfo, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetTokenById not implemented")
+
+func _Core_DeleteMenu_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IDReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).DeleteMenu(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_DeleteMenu_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).DeleteMenu(ctx, req.(*IDReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedCoreServer) BlockUserAllToken(context.Context, *UUIDReq) (*BaseResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BlockUserAllToken not implemented")
+
+func _Core_GetMenu_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IDReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).GetMenu(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_GetMenu_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).GetMenu(ctx, req.(*IDReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedCoreServer) UpdateToken(context.Context, *TokenInfo) (*BaseResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateToken not implemented")
+
+func _Core_GetMenuListByRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BaseMsg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).GetMenuListByRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_GetMenuListByRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).GetMenuListByRole(ctx, req.(*BaseMsg))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedCoreServer) CreateUser(context.Context, *UserInfo) (*BaseUUIDResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateUser not implemented")
+
+func _Core_GetMenuList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PageInfoReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).GetMenuList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_GetMenuList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).GetMenuList(ctx, req.(*PageInfoReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedCoreServer) UpdateUser(context.Context, *UserInfo) (*BaseResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateUser not implemented")
+
+func _Core_CreateOauthClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OauthClientInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).CreateOauthClient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_CreateOauthClient_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).CreateOauthClient(ctx, req.(*OauthClientInfo))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedCoreServer) GetUserList(context.Context, *UserListReq) (*UserListResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetUserList not implemented")
+
+func _Core_UpdateOauthClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OauthClientInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).UpdateOauthClient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_UpdateOauthClient_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).UpdateOauthClient(ctx, req.(*OauthClientInfo))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedCoreServer) GetUserById(context.Context, *UUIDReq) (*UserInfo, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetUserById not implemented")
+
+func _Core_GetOauthClientList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OauthClientListReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).GetOauthClientList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_GetOauthClientList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).GetOauthClientList(ctx, req.(*OauthClientListReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Core_GetOauthClientById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IDReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).GetOauthClientById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_GetOauthClientById_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).GetOauthClientById(ctx, req.(*IDReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedCoreServer) GetUserByUsername(context.Context, *UsernameReq) (*UserInfo, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetUserByUsername not implemented")
+
+func _Core_DeleteOauthClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IDsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).DeleteOauthClient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_DeleteOauthClient_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).DeleteOauthClient(ctx, req.(*IDsReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedCoreServer) DeleteUser(context.Context, *UUIDsReq) (*BaseResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteUser not implemented")
+
+func _Core_ResetOauthClientSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IDReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).ResetOauthClientSecret(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_ResetOauthClientSecret_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).ResetOauthClientSecret(ctx, req.(*IDReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedCoreServer) ResetPwd(context.Context, *ResetPwdReq) (*BaseResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ResetPwd not implemented")
+
+func _Core_GetOauthClientByClientId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OauthClientIdReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).GetOauthClientByClientId(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_GetOauthClientByClientId_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).GetOauthClientByClientId(ctx, req.(*OauthClientIdReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedCoreServer) UnallocatedList(context.Context, *RoleUnallocatedListReq) (*UserListResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UnallocatedList not implemented")
+
+func _Core_AuthenticateOauthClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OauthClientAuthReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).AuthenticateOauthClient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_AuthenticateOauthClient_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).AuthenticateOauthClient(ctx, req.(*OauthClientAuthReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedCoreServer) mustEmbedUnimplementedCoreServer() {}
-func (UnimplementedCoreServer) testEmbeddedByValue()              {}
 
-// UnsafeCoreServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to CoreServer will
-// result in compilation errors.
-type UnsafeCoreServer interface {
-	mustEmbedUnimplementedCoreServer()
+func _Core_AuthorizeOauthClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OauthAuthorizeReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).AuthorizeOauthClient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_AuthorizeOauthClient_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).AuthorizeOauthClient(ctx, req.(*OauthAuthorizeReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterCoreServer(s grpc.ServiceRegistrar, srv CoreServer) {
-	// If the following call pancis, it indicates UnimplementedCoreServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _Core_ExchangeOauthAuthorizationCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OauthCodeExchangeReq)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&Core_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(CoreServer).ExchangeOauthAuthorizationCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_ExchangeOauthAuthorizationCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).ExchangeOauthAuthorizationCode(ctx, req.(*OauthCodeExchangeReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _Core_CreateApi_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ApiInfo)
+func _Core_GetOauthConsentList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OauthConsentListReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoreServer).CreateApi(ctx, in)
+		return srv.(CoreServer).GetOauthConsentList(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Core_CreateApi_FullMethodName,
+		FullMethod: Core_GetOauthConsentList_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoreServer).CreateApi(ctx, req.(*ApiInfo))
+		return srv.(CoreServer).GetOauthConsentList(ctx, req.(*OauthConsentListReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Core_UpdateApi_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ApiInfo)
+func _Core_DeleteOauthConsent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IDsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoreServer).UpdateApi(ctx, in)
+		return srv.(CoreServer).DeleteOauthConsent(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Core_UpdateApi_FullMethodName,
+		FullMethod: Core_DeleteOauthConsent_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoreServer).UpdateApi(ctx, req.(*ApiInfo))
+		return srv.(CoreServer).DeleteOauthConsent(ctx, req.(*IDsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Core_GetApiList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ApiListReq)
+func _Core_CreateOauthProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OauthProviderInfo)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoreServer).GetApiList(ctx, in)
+		return srv.(CoreServer).CreateOauthProvider(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Core_GetApiList_FullMethodName,
+		FullMethod: Core_CreateOauthProvider_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoreServer).GetApiList(ctx, req.(*ApiListReq))
+		return srv.(CoreServer).CreateOauthProvider(ctx, req.(*OauthProviderInfo))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Core_GetApiById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(IDReq)
+func _Core_UpdateOauthProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OauthProviderInfo)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoreServer).GetApiById(ctx, in)
+		return srv.(CoreServer).UpdateOauthProvider(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Core_GetApiById_FullMethodName,
+		FullMethod: Core_UpdateOauthProvider_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoreServer).GetApiById(ctx, req.(*IDReq))
+		return srv.(CoreServer).UpdateOauthProvider(ctx, req.(*OauthProviderInfo))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Core_DeleteApi_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(IDsReq)
+func _Core_GetOauthProviderList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OauthProviderListReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoreServer).DeleteApi(ctx, in)
+		return srv.(CoreServer).GetOauthProviderList(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Core_DeleteApi_FullMethodName,
+		FullMethod: Core_GetOauthProviderList_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoreServer).DeleteApi(ctx, req.(*IDsReq))
+		return srv.(CoreServer).GetOauthProviderList(ctx, req.(*OauthProviderListReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Core_CreateAuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AuditLogInfo)
+func _Core_GetOauthProviderById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IDReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoreServer).CreateAuditLog(ctx, in)
+		return srv.(CoreServer).GetOauthProviderById(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Core_CreateAuditLog_FullMethodName,
+		FullMethod: Core_GetOauthProviderById_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoreServer).CreateAuditLog(ctx, req.(*AuditLogInfo))
+		return srv.(CoreServer).GetOauthProviderById(ctx, req.(*IDReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Core_GetAuditLogList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AuditLogListReq)
+func _Core_DeleteOauthProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IDsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoreServer).GetAuditLogList(ctx, in)
+		return srv.(CoreServer).DeleteOauthProvider(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Core_GetAuditLogList_FullMethodName,
+		FullMethod: Core_DeleteOauthProvider_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoreServer).GetAuditLogList(ctx, req.(*AuditLogListReq))
+		return srv.(CoreServer).DeleteOauthProvider(ctx, req.(*IDsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Core_GetAuditLogById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UUIDReq)
+func _Core_OauthLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OauthLoginReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoreServer).GetAuditLogById(ctx, in)
+		return srv.(CoreServer).OauthLogin(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Core_GetAuditLogById_FullMethodName,
+		FullMethod: Core_OauthLogin_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoreServer).GetAuditLogById(ctx, req.(*UUIDReq))
+		return srv.(CoreServer).OauthLogin(ctx, req.(*OauthLoginReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Core_DeleteAuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UUIDsReq)
+func _Core_OauthCallback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CallbackReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoreServer).DeleteAuditLog(ctx, in)
+		return srv.(CoreServer).OauthCallback(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Core_DeleteAuditLog_FullMethodName,
+		FullMethod: Core_OauthCallback_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoreServer).DeleteAuditLog(ctx, req.(*UUIDsReq))
+		return srv.(CoreServer).OauthCallback(ctx, req.(*CallbackReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Core_GetAuditLogStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AuditLogStatsReq)
+func _Core_PreviewOauthClaimMapping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OauthClaimMappingPreviewReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoreServer).GetAuditLogStats(ctx, in)
+		return srv.(CoreServer).PreviewOauthClaimMapping(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Core_GetAuditLogStats_FullMethodName,
+		FullMethod: Core_PreviewOauthClaimMapping_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoreServer).GetAuditLogStats(ctx, req.(*AuditLogStatsReq))
+		return srv.(CoreServer).PreviewOauthClaimMapping(ctx, req.(*OauthClaimMappingPreviewReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Core_GetMenuAuthority_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(IDReq)
+func _Core_OauthWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OauthWebhookReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoreServer).GetMenuAuthority(ctx, in)
+		return srv.(CoreServer).OauthWebhook(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Core_GetMenuAuthority_FullMethodName,
+		FullMethod: Core_OauthWebhook_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoreServer).GetMenuAuthority(ctx, req.(*IDReq))
+		return srv.(CoreServer).OauthWebhook(ctx, req.(*OauthWebhookReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Core_CreateOrUpdateMenuAuthority_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RoleMenuAuthorityReq)
+func _Core_CreateOauthAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OauthAccountInfo)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoreServer).CreateOrUpdateMenuAuthority(ctx, in)
+		return srv.(CoreServer).CreateOauthAccount(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Core_CreateOrUpdateMenuAuthority_FullMethodName,
+		FullMethod: Core_CreateOauthAccount_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoreServer).CreateOrUpdateMenuAuthority(ctx, req.(*RoleMenuAuthorityReq))
+		return srv.(CoreServer).CreateOauthAccount(ctx, req.(*OauthAccountInfo))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Core_InitDatabase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Empty)
+func _Core_UpdateOauthAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OauthAccountInfo)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoreServer).InitDatabase(ctx, in)
+		return srv.(CoreServer).UpdateOauthAccount(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Core_InitDatabase_FullMethodName,
+		FullMethod: Core_UpdateOauthAccount_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoreServer).InitDatabase(ctx, req.(*Empty))
+		return srv.(CoreServer).UpdateOauthAccount(ctx, req.(*OauthAccountInfo))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Core_CreateCasbinRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CasbinRuleInfo)
+func _Core_GetOauthAccountList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OauthAccountListReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoreServer).CreateCasbinRule(ctx, in)
+		return srv.(CoreServer).GetOauthAccountList(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Core_CreateCasbinRule_FullMethodName,
+		FullMethod: Core_GetOauthAccountList_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoreServer).CreateCasbinRule(ctx, req.(*CasbinRuleInfo))
+		return srv.(CoreServer).GetOauthAccountList(ctx, req.(*OauthAccountListReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Core_UpdateCasbinRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CasbinRuleInfo)
+func _Core_GetOauthAccountById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IDReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoreServer).UpdateCasbinRule(ctx, in)
+		return srv.(CoreServer).GetOauthAccountById(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Core_UpdateCasbinRule_FullMethodName,
+		FullMethod: Core_GetOauthAccountById_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoreServer).UpdateCasbinRule(ctx, req.(*CasbinRuleInfo))
+		return srv.(CoreServer).GetOauthAccountById(ctx, req.(*IDReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Core_DeleteCasbinRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _Core_DeleteOauthAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(IDsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoreServer).DeleteCasbinRule(ctx, in)
+		return srv.(CoreServer).DeleteOauthAccount(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Core_DeleteCasbinRule_FullMethodName,
+		FullMethod: Core_DeleteOauthAccount_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoreServer).DeleteCasbinRule(ctx, req.(*IDsReq))
+		return srv.(CoreServer).DeleteOauthAccount(ctx, req.(*IDsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Core_GetCasbinRuleList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CasbinRuleListReq)
+func _Core_BindOauthAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BindOauthAccountReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoreServer).GetCasbinRuleList(ctx, in)
+		return srv.(CoreServer).BindOauthAccount(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Core_GetCasbinRuleList_FullMethodName,
+		FullMethod: Core_BindOauthAccount_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoreServer).GetCasbinRuleList(ctx, req.(*CasbinRuleListReq))
+		return srv.(CoreServer).BindOauthAccount(ctx, req.(*BindOauthAccountReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Core_GetCasbinRuleById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(IDReq)
+func _Core_UnbindOauthAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnbindOauthAccountReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoreServer).GetCasbinRuleById(ctx, in)
+		return srv.(CoreServer).UnbindOauthAccount(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Core_GetCasbinRuleById_FullMethodName,
+		FullMethod: Core_UnbindOauthAccount_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoreServer).GetCasbinRuleById(ctx, req.(*IDReq))
+		return srv.(CoreServer).UnbindOauthAccount(ctx, req.(*UnbindOauthAccountReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Core_BatchCreateCasbinRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BatchCreateCasbinRulesReq)
+func _Core_GetUserOauthAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserOauthAccountsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoreServer).BatchCreateCasbinRules(ctx, in)
+		return srv.(CoreServer).GetUserOauthAccounts(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Core_BatchCreateCasbinRules_FullMethodName,
+		FullMethod: Core_GetUserOauthAccounts_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoreServer).BatchCreateCasbinRules(ctx, req.(*BatchCreateCasbinRulesReq))
+		return srv.(CoreServer).GetUserOauthAccounts(ctx, req.(*GetUserOauthAccountsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Core_BatchUpdateCasbinRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BatchUpdateCasbinRulesReq)
+func _Core_GetOauthAccessToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OauthAccessTokenReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoreServer).BatchUpdateCasbinRules(ctx, in)
+		return srv.(CoreServer).GetOauthAccessToken(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Core_BatchUpdateCasbinRules_FullMethodName,
+		FullMethod: Core_GetOauthAccessToken_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoreServer).BatchUpdateCasbinRules(ctx, req.(*BatchUpdateCasbinRulesReq))
+		return srv.(CoreServer).GetOauthAccessToken(ctx, req.(*OauthAccessTokenReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Core_BatchDeleteCasbinRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(IDsReq)
+func _Core_CreateOauthSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateOauthSessionReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoreServer).BatchDeleteCasbinRules(ctx, in)
+		return srv.(CoreServer).CreateOauthSession(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Core_BatchDeleteCasbinRules_FullMethodName,
+		FullMethod: Core_CreateOauthSession_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoreServer).BatchDeleteCasbinRules(ctx, req.(*IDsReq))
+		return srv.(CoreServer).CreateOauthSession(ctx, req.(*CreateOauthSessionReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Core_CheckPermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PermissionCheckReq)
+func _Core_UpdateOauthSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateOauthSessionReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoreServer).CheckPermission(ctx, in)
+		return srv.(CoreServer).UpdateOauthSession(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Core_CheckPermission_FullMethodName,
+		FullMethod: Core_UpdateOauthSession_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoreServer).CheckPermission(ctx, req.(*PermissionCheckReq))
+		return srv.(CoreServer).UpdateOauthSession(ctx, req.(*UpdateOauthSessionReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Core_BatchCheckPermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BatchPermissionCheckReq)
+func _Core_GetOauthSessionByState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOauthSessionByStateReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoreServer).BatchCheckPermission(ctx, in)
+		return srv.(CoreServer).GetOauthSessionByState(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Core_BatchCheckPermission_FullMethodName,
+		FullMethod: Core_GetOauthSessionByState_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoreServer).BatchCheckPermission(ctx, req.(*BatchPermissionCheckReq))
+		return srv.(CoreServer).GetOauthSessionByState(ctx, req.(*GetOauthSessionByStateReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Core_GetUserPermissionSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetUserPermissionSummaryReq)
+func _Core_DeleteOauthSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IDReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoreServer).GetUserPermissionSummary(ctx, in)
+		return srv.(CoreServer).DeleteOauthSession(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Core_GetUserPermissionSummary_FullMethodName,
+		FullMethod: Core_DeleteOauthSession_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoreServer).GetUserPermissionSummary(ctx, req.(*GetUserPermissionSummaryReq))
+		return srv.(CoreServer).DeleteOauthSession(ctx, req.(*IDReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Core_ValidateCasbinRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ValidateCasbinRuleReq)
+func _Core_CreateOauthProviderTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OauthProviderTemplateInfo)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoreServer).ValidateCasbinRule(ctx, in)
+		return srv.(CoreServer).CreateOauthProviderTemplate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Core_ValidateCasbinRule_FullMethodName,
+		FullMethod: Core_CreateOauthProviderTemplate_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoreServer).ValidateCasbinRule(ctx, req.(*ValidateCasbinRuleReq))
+		return srv.(CoreServer).CreateOauthProviderTemplate(ctx, req.(*OauthProviderTemplateInfo))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Core_SyncCasbinRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SyncCasbinRulesReq)
+func _Core_UpdateOauthProviderTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OauthProviderTemplateInfo)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoreServer).SyncCasbinRules(ctx, in)
+		return srv.(CoreServer).UpdateOauthProviderTemplate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Core_SyncCasbinRules_FullMethodName,
+		FullMethod: Core_UpdateOauthProviderTemplate_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoreServer).SyncCasbinRules(ctx, req.(*SyncCasbinRulesReq))
+		return srv.(CoreServer).UpdateOauthProviderTemplate(ctx, req.(*OauthProviderTemplateInfo))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Core_RefreshCasbinCache_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RefreshCasbinCacheReq)
+func _Core_GetOauthProviderTemplateList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OauthProviderTemplateListReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoreServer).RefreshCasbinCache(ctx, in)
+		return srv.(CoreServer).GetOauthProviderTemplateList(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Core_RefreshCasbinCache_FullMethodName,
+		FullMethod: Core_GetOauthProviderTemplateList_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoreServer).RefreshCasbinCache(ctx, req.(*RefreshCasbinCacheReq))
+		return srv.(CoreServer).GetOauthProviderTemplateList(ctx, req.(*OauthProviderTemplateListReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Core_CreateConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ConfigurationInfo)
+func _Core_GetOauthProviderTemplateById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IDReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoreServer).CreateConfiguration(ctx, in)
+		return srv.(CoreServer).GetOauthProviderTemplateById(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Core_CreateConfiguration_FullMethodName,
+		FullMethod: Core_GetOauthProviderTemplateById_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoreServer).CreateConfiguration(ctx, req.(*ConfigurationInfo))
+		return srv.(CoreServer).GetOauthProviderTemplateById(ctx, req.(*IDReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Core_UpdateConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ConfigurationInfo)
+func _Core_DeleteOauthProviderTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IDsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoreServer).UpdateConfiguration(ctx, in)
+		return srv.(CoreServer).DeleteOauthProviderTemplate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Core_UpdateConfiguration_FullMethodName,
+		FullMethod: Core_DeleteOauthProviderTemplate_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoreServer).UpdateConfiguration(ctx, req.(*ConfigurationInfo))
+		return srv.(CoreServer).DeleteOauthProviderTemplate(ctx, req.(*IDsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Core_GetConfigurationList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ConfigurationListReq)
+func _Core_EnableOauthProviderTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnableOauthProviderTemplateReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoreServer).GetConfigurationList(ctx, in)
+		return srv.(CoreServer).EnableOauthProviderTemplate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Core_GetConfigurationList_FullMethodName,
+		FullMethod: Core_EnableOauthProviderTemplate_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoreServer).GetConfigurationList(ctx, req.(*ConfigurationListReq))
+		return srv.(CoreServer).EnableOauthProviderTemplate(ctx, req.(*EnableOauthProviderTemplateReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Core_GetConfigurationById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(IDReq)
+func _Core_CreateOauthScope_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OauthScopeInfo)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoreServer).GetConfigurationById(ctx, in)
+		return srv.(CoreServer).CreateOauthScope(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Core_GetConfigurationById_FullMethodName,
+		FullMethod: Core_CreateOauthScope_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoreServer).GetConfigurationById(ctx, req.(*IDReq))
+		return srv.(CoreServer).CreateOauthScope(ctx, req.(*OauthScopeInfo))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Core_DeleteConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(IDsReq)
+func _Core_UpdateOauthScope_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OauthScopeInfo)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoreServer).DeleteConfiguration(ctx, in)
+		return srv.(CoreServer).UpdateOauthScope(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Core_DeleteConfiguration_FullMethodName,
+		FullMethod: Core_UpdateOauthScope_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoreServer).DeleteConfiguration(ctx, req.(*IDsReq))
+		return srv.(CoreServer).UpdateOauthScope(ctx, req.(*OauthScopeInfo))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Core_RefreshConfigurationCache_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Empty)
+func _Core_GetOauthScopeList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OauthScopeListReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoreServer).RefreshConfigurationCache(ctx, in)
+		return srv.(CoreServer).GetOauthScopeList(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Core_RefreshConfigurationCache_FullMethodName,
+		FullMethod: Core_GetOauthScopeList_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoreServer).RefreshConfigurationCache(ctx, req.(*Empty))
+		return srv.(CoreServer).GetOauthScopeList(ctx, req.(*OauthScopeListReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Core_CreateDepartment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DepartmentInfo)
+func _Core_GetOauthScopeById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IDReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoreServer).CreateDepartment(ctx, in)
+		return srv.(CoreServer).GetOauthScopeById(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Core_CreateDepartment_FullMethodName,
+		FullMethod: Core_GetOauthScopeById_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoreServer).CreateDepartment(ctx, req.(*DepartmentInfo))
+		return srv.(CoreServer).GetOauthScopeById(ctx, req.(*IDReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Core_UpdateDepartment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DepartmentInfo)
+func _Core_DeleteOauthScope_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IDsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoreServer).UpdateDepartment(ctx, in)
+		return srv.(CoreServer).DeleteOauthScope(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Core_UpdateDepartment_FullMethodName,
+		FullMethod: Core_DeleteOauthScope_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoreServer).UpdateDepartment(ctx, req.(*DepartmentInfo))
+		return srv.(CoreServer).DeleteOauthScope(ctx, req.(*IDsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Core_GetDepartmentList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DepartmentListReq)
+func _Core_CreatePosition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PositionInfo)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoreServer).GetDepartmentList(ctx, in)
+		return srv.(CoreServer).CreatePosition(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Core_GetDepartmentList_FullMethodName,
+		FullMethod: Core_CreatePosition_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoreServer).GetDepartmentList(ctx, req.(*DepartmentListReq))
+		return srv.(CoreServer).CreatePosition(ctx, req.(*PositionInfo))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Core_GetDepartmentById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(IDReq)
+func _Core_UpdatePosition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PositionInfo)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoreServer).GetDepartmentById(ctx, in)
+		return srv.(CoreServer).UpdatePosition(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Core_GetDepartmentById_FullMethodName,
+		FullMethod: Core_UpdatePosition_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoreServer).GetDepartmentById(ctx, req.(*IDReq))
+		return srv.(CoreServer).UpdatePosition(ctx, req.(*PositionInfo))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Core_DeleteDepartment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(IDsReq)
+func _Core_GetPositionList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PositionListReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoreServer).DeleteDepartment(ctx, in)
+		return srv.(CoreServer).GetPositionList(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Core_DeleteDepartment_FullMethodName,
+		FullMethod: Core_GetPositionList_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoreServer).DeleteDepartment(ctx, req.(*IDsReq))
+		return srv.(CoreServer).GetPositionList(ctx, req.(*PositionListReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Core_InitDeptDataPermToRedis_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Empty)
+func _Core_GetPositionById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IDReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoreServer).InitDeptDataPermToRedis(ctx, in)
+		return srv.(CoreServer).GetPositionById(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Core_InitDeptDataPermToRedis_FullMethodName,
+		FullMethod: Core_GetPositionById_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoreServer).InitDeptDataPermToRedis(ctx, req.(*Empty))
+		return srv.(CoreServer).GetPositionById(ctx, req.(*IDReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Core_CreateDictionary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DictionaryInfo)
+func _Core_DeletePosition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IDsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoreServer).CreateDictionary(ctx, in)
+		return srv.(CoreServer).DeletePosition(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Core_CreateDictionary_FullMethodName,
+		FullMethod: Core_DeletePosition_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoreServer).CreateDictionary(ctx, req.(*DictionaryInfo))
+		return srv.(CoreServer).DeletePosition(ctx, req.(*IDsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Core_UpdateDictionary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DictionaryInfo)
+func _Core_CreateRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RoleInfo)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoreServer).UpdateDictionary(ctx, in)
+		return srv.(CoreServer).CreateRole(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Core_UpdateDictionary_FullMethodName,
+		FullMethod: Core_CreateRole_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoreServer).UpdateDictionary(ctx, req.(*DictionaryInfo))
+		return srv.(CoreServer).CreateRole(ctx, req.(*RoleInfo))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Core_GetDictionaryList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DictionaryListReq)
+func _Core_UpdateRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RoleInfo)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoreServer).GetDictionaryList(ctx, in)
+		return srv.(CoreServer).UpdateRole(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Core_GetDictionaryList_FullMethodName,
+		FullMethod: Core_UpdateRole_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoreServer).GetDictionaryList(ctx, req.(*DictionaryListReq))
+		return srv.(CoreServer).UpdateRole(ctx, req.(*RoleInfo))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Core_GetDictionaryById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(IDReq)
+func _Core_GetRoleList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RoleListReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoreServer).GetDictionaryById(ctx, in)
+		return srv.(CoreServer).GetRoleList(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Core_GetDictionaryById_FullMethodName,
+		FullMethod: Core_GetRoleList_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoreServer).GetDictionaryById(ctx, req.(*IDReq))
+		return srv.(CoreServer).GetRoleList(ctx, req.(*RoleListReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Core_DeleteDictionary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(IDsReq)
+func _Core_GetRoleById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IDReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoreServer).DeleteDictionary(ctx, in)
+		return srv.(CoreServer).GetRoleById(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Core_DeleteDictionary_FullMethodName,
+		FullMethod: Core_GetRoleById_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoreServer).DeleteDictionary(ctx, req.(*IDsReq))
+		return srv.(CoreServer).GetRoleById(ctx, req.(*IDReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Core_CreateDictionaryDetail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DictionaryDetailInfo)
+func _Core_DeleteRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IDsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoreServer).CreateDictionaryDetail(ctx, in)
+		return srv.(CoreServer).DeleteRole(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Core_CreateDictionaryDetail_FullMethodName,
+		FullMethod: Core_DeleteRole_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoreServer).CreateDictionaryDetail(ctx, req.(*DictionaryDetailInfo))
+		return srv.(CoreServer).DeleteRole(ctx, req.(*IDsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Core_UpdateDictionaryDetail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DictionaryDetailInfo)
+func _Core_DeleteRoleWithUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IDReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoreServer).UpdateDictionaryDetail(ctx, in)
+		return srv.(CoreServer).DeleteRoleWithUsers(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Core_UpdateDictionaryDetail_FullMethodName,
+		FullMethod: Core_DeleteRoleWithUsers_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoreServer).UpdateDictionaryDetail(ctx, req.(*DictionaryDetailInfo))
+		return srv.(CoreServer).DeleteRoleWithUsers(ctx, req.(*IDReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Core_GetDictionaryDetailList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DictionaryDetailListReq)
+func _Core_InitRoleDataPermToRedis_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoreServer).GetDictionaryDetailList(ctx, in)
+		return srv.(CoreServer).InitRoleDataPermToRedis(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Core_GetDictionaryDetailList_FullMethodName,
+		FullMethod: Core_InitRoleDataPermToRedis_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoreServer).GetDictionaryDetailList(ctx, req.(*DictionaryDetailListReq))
+		return srv.(CoreServer).InitRoleDataPermToRedis(ctx, req.(*Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Core_GetDictionaryDetailById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(IDReq)
+func _Core_AssignRoleDataScope_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RoleDataScopeReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoreServer).GetDictionaryDetailById(ctx, in)
+		return srv.(CoreServer).AssignRoleDataScope(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Core_GetDictionaryDetailById_FullMethodName,
+		FullMethod: Core_AssignRoleDataScope_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoreServer).GetDictionaryDetailById(ctx, req.(*IDReq))
+		return srv.(CoreServer).AssignRoleDataScope(ctx, req.(*RoleDataScopeReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Core_DeleteDictionaryDetail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(IDsReq)
+func _Core_CancelAuth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RoleAuthReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoreServer).DeleteDictionaryDetail(ctx, in)
+		return srv.(CoreServer).CancelAuth(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Core_DeleteDictionaryDetail_FullMethodName,
+		FullMethod: Core_CancelAuth_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoreServer).DeleteDictionaryDetail(ctx, req.(*IDsReq))
+		return srv.(CoreServer).CancelAuth(ctx, req.(*RoleAuthReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Core_GetDictionaryDetailByDictionaryName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BaseMsg)
+func _Core_AddAuth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RoleAuthReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoreServer).GetDictionaryDetailByDictionaryName(ctx, in)
+		return srv.(CoreServer).AddAuth(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Core_GetDictionaryDetailByDictionaryName_FullMethodName,
+		FullMethod: Core_AddAuth_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoreServer).GetDictionaryDetailByDictionaryName(ctx, req.(*BaseMsg))
+		return srv.(CoreServer).AddAuth(ctx, req.(*RoleAuthReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Core_CreateMenu_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MenuInfo)
+func _Core_ChangeRoleStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RoleStatusChangeParam)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoreServer).CreateMenu(ctx, in)
+		return srv.(CoreServer).ChangeRoleStatus(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Core_CreateMenu_FullMethodName,
+		FullMethod: Core_ChangeRoleStatus_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoreServer).CreateMenu(ctx, req.(*MenuInfo))
+		return srv.(CoreServer).ChangeRoleStatus(ctx, req.(*RoleStatusChangeParam))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Core_UpdateMenu_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MenuInfo)
+func _Core_SetRoleParents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RoleParentsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoreServer).UpdateMenu(ctx, in)
+		return srv.(CoreServer).SetRoleParents(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Core_UpdateMenu_FullMethodName,
+		FullMethod: Core_SetRoleParents_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoreServer).UpdateMenu(ctx, req.(*MenuInfo))
+		return srv.(CoreServer).SetRoleParents(ctx, req.(*RoleParentsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Core_DeleteMenu_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _Core_GetRoleParents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(IDReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoreServer).DeleteMenu(ctx, in)
+		return srv.(CoreServer).GetRoleParents(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Core_DeleteMenu_FullMethodName,
+		FullMethod: Core_GetRoleParents_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoreServer).DeleteMenu(ctx, req.(*IDReq))
+		return srv.(CoreServer).GetRoleParents(ctx, req.(*IDReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Core_GetMenu_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _Core_GetRoleEffectivePermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(IDReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoreServer).GetMenu(ctx, in)
+		return srv.(CoreServer).GetRoleEffectivePermissions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Core_GetMenu_FullMethodName,
+		FullMethod: Core_GetRoleEffectivePermissions_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoreServer).GetMenu(ctx, req.(*IDReq))
+		return srv.(CoreServer).GetRoleEffectivePermissions(ctx, req.(*IDReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Core_GetMenuListByRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BaseMsg)
+func _Core_CreateSamlProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SamlProviderInfo)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoreServer).GetMenuListByRole(ctx, in)
+		return srv.(CoreServer).CreateSamlProvider(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Core_GetMenuListByRole_FullMethodName,
+		FullMethod: Core_CreateSamlProvider_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoreServer).GetMenuListByRole(ctx, req.(*BaseMsg))
+		return srv.(CoreServer).CreateSamlProvider(ctx, req.(*SamlProviderInfo))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Core_GetMenuList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PageInfoReq)
+func _Core_UpdateSamlProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SamlProviderInfo)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoreServer).GetMenuList(ctx, in)
+		return srv.(CoreServer).UpdateSamlProvider(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Core_GetMenuList_FullMethodName,
+		FullMethod: Core_UpdateSamlProvider_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoreServer).GetMenuList(ctx, req.(*PageInfoReq))
+		return srv.(CoreServer).UpdateSamlProvider(ctx, req.(*SamlProviderInfo))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Core_CreateOauthProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(OauthProviderInfo)
+func _Core_GetSamlProviderList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SamlProviderListReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoreServer).CreateOauthProvider(ctx, in)
+		return srv.(CoreServer).GetSamlProviderList(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Core_CreateOauthProvider_FullMethodName,
+		FullMethod: Core_GetSamlProviderList_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoreServer).CreateOauthProvider(ctx, req.(*OauthProviderInfo))
+		return srv.(CoreServer).GetSamlProviderList(ctx, req.(*SamlProviderListReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Core_UpdateOauthProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(OauthProviderInfo)
+func _Core_GetSamlProviderById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IDReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoreServer).UpdateOauthProvider(ctx, in)
+		return srv.(CoreServer).GetSamlProviderById(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Core_UpdateOauthProvider_FullMethodName,
+		FullMethod: Core_GetSamlProviderById_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoreServer).UpdateOauthProvider(ctx, req.(*OauthProviderInfo))
+		return srv.(CoreServer).GetSamlProviderById(ctx, req.(*IDReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Core_GetOauthProviderList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(OauthProviderListReq)
+func _Core_DeleteSamlProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IDsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoreServer).GetOauthProviderList(ctx, in)
+		return srv.(CoreServer).DeleteSamlProvider(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Core_GetOauthProviderList_FullMethodName,
+		FullMethod: Core_DeleteSamlProvider_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoreServer).GetOauthProviderList(ctx, req.(*OauthProviderListReq))
+		return srv.(CoreServer).DeleteSamlProvider(ctx, req.(*IDsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Core_GetOauthProviderById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(IDReq)
+func _Core_ImportSamlIdpMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SamlMetadataImportReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoreServer).GetOauthProviderById(ctx, in)
+		return srv.(CoreServer).ImportSamlIdpMetadata(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Core_GetOauthProviderById_FullMethodName,
+		FullMethod: Core_ImportSamlIdpMetadata_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoreServer).GetOauthProviderById(ctx, req.(*IDReq))
+		return srv.(CoreServer).ImportSamlIdpMetadata(ctx, req.(*SamlMetadataImportReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Core_DeleteOauthProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(IDsReq)
+func _Core_GetSamlSpMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SamlSpMetadataReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoreServer).DeleteOauthProvider(ctx, in)
+		return srv.(CoreServer).GetSamlSpMetadata(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Core_DeleteOauthProvider_FullMethodName,
+		FullMethod: Core_GetSamlSpMetadata_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoreServer).DeleteOauthProvider(ctx, req.(*IDsReq))
+		return srv.(CoreServer).GetSamlSpMetadata(ctx, req.(*SamlSpMetadataReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Core_OauthLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(OauthLoginReq)
+func _Core_SamlLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SamlLoginReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoreServer).OauthLogin(ctx, in)
+		return srv.(CoreServer).SamlLogin(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Core_OauthLogin_FullMethodName,
+		FullMethod: Core_SamlLogin_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoreServer).OauthLogin(ctx, req.(*OauthLoginReq))
+		return srv.(CoreServer).SamlLogin(ctx, req.(*SamlLoginReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Core_OauthCallback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CallbackReq)
+func _Core_SamlAcs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SamlAcsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoreServer).OauthCallback(ctx, in)
+		return srv.(CoreServer).SamlAcs(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Core_OauthCallback_FullMethodName,
+		FullMethod: Core_SamlAcs_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoreServer).OauthCallback(ctx, req.(*CallbackReq))
+		return srv.(CoreServer).SamlAcs(ctx, req.(*SamlAcsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Core_CreateOauthAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(OauthAccountInfo)
+func _Core_CreateScimToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScimTokenInfo)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoreServer).CreateOauthAccount(ctx, in)
+		return srv.(CoreServer).CreateScimToken(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Core_CreateOauthAccount_FullMethodName,
+		FullMethod: Core_CreateScimToken_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoreServer).CreateOauthAccount(ctx, req.(*OauthAccountInfo))
+		return srv.(CoreServer).CreateScimToken(ctx, req.(*ScimTokenInfo))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Core_UpdateOauthAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(OauthAccountInfo)
+func _Core_UpdateScimToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScimTokenInfo)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoreServer).UpdateOauthAccount(ctx, in)
+		return srv.(CoreServer).UpdateScimToken(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Core_UpdateOauthAccount_FullMethodName,
+		FullMethod: Core_UpdateScimToken_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoreServer).UpdateOauthAccount(ctx, req.(*OauthAccountInfo))
+		return srv.(CoreServer).UpdateScimToken(ctx, req.(*ScimTokenInfo))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Core_GetOauthAccountList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(OauthAccountListReq)
+func _Core_GetScimTokenList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScimTokenListReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoreServer).GetOauthAccountList(ctx, in)
+		return srv.(CoreServer).GetScimTokenList(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Core_GetOauthAccountList_FullMethodName,
+		FullMethod: Core_GetScimTokenList_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoreServer).GetOauthAccountList(ctx, req.(*OauthAccountListReq))
+		return srv.(CoreServer).GetScimTokenList(ctx, req.(*ScimTokenListReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Core_GetOauthAccountById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _Core_GetScimTokenById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(IDReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoreServer).GetOauthAccountById(ctx, in)
+		return srv.(CoreServer).GetScimTokenById(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Core_GetOauthAccountById_FullMethodName,
+		FullMethod: Core_GetScimTokenById_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoreServer).GetOauthAccountById(ctx, req.(*IDReq))
+		return srv.(CoreServer).GetScimTokenById(ctx, req.(*IDReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Core_DeleteOauthAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _Core_DeleteScimToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(IDsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoreServer).DeleteOauthAccount(ctx, in)
+		return srv.(CoreServer).DeleteScimToken(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Core_DeleteOauthAccount_FullMethodName,
+		FullMethod: Core_DeleteScimToken_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoreServer).DeleteOauthAccount(ctx, req.(*IDsReq))
+		return srv.(CoreServer).DeleteScimToken(ctx, req.(*IDsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Core_BindOauthAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BindOauthAccountReq)
+func _Core_AuthenticateScimToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScimTokenAuthReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoreServer).BindOauthAccount(ctx, in)
+		return srv.(CoreServer).AuthenticateScimToken(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Core_BindOauthAccount_FullMethodName,
+		FullMethod: Core_AuthenticateScimToken_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoreServer).BindOauthAccount(ctx, req.(*BindOauthAccountReq))
+		return srv.(CoreServer).AuthenticateScimToken(ctx, req.(*ScimTokenAuthReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Core_UnbindOauthAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UnbindOauthAccountReq)
+func _Core_CreateTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TenantInfo)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoreServer).UnbindOauthAccount(ctx, in)
+		return srv.(CoreServer).CreateTenant(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Core_UnbindOauthAccount_FullMethodName,
+		FullMethod: Core_CreateTenant_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoreServer).UnbindOauthAccount(ctx, req.(*UnbindOauthAccountReq))
+		return srv.(CoreServer).CreateTenant(ctx, req.(*TenantInfo))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Core_GetUserOauthAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetUserOauthAccountsReq)
+func _Core_UpdateTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TenantInfo)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoreServer).GetUserOauthAccounts(ctx, in)
+		return srv.(CoreServer).UpdateTenant(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Core_GetUserOauthAccounts_FullMethodName,
+		FullMethod: Core_UpdateTenant_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoreServer).GetUserOauthAccounts(ctx, req.(*GetUserOauthAccountsReq))
+		return srv.(CoreServer).UpdateTenant(ctx, req.(*TenantInfo))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Core_CreateOauthSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateOauthSessionReq)
+func _Core_GetTenantList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TenantListReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoreServer).CreateOauthSession(ctx, in)
+		return srv.(CoreServer).GetTenantList(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Core_CreateOauthSession_FullMethodName,
+		FullMethod: Core_GetTenantList_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoreServer).CreateOauthSession(ctx, req.(*CreateOauthSessionReq))
+		return srv.(CoreServer).GetTenantList(ctx, req.(*TenantListReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Core_UpdateOauthSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateOauthSessionReq)
+func _Core_GetTenantById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IDReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoreServer).UpdateOauthSession(ctx, in)
+		return srv.(CoreServer).GetTenantById(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Core_UpdateOauthSession_FullMethodName,
+		FullMethod: Core_GetTenantById_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoreServer).UpdateOauthSession(ctx, req.(*UpdateOauthSessionReq))
+		return srv.(CoreServer).GetTenantById(ctx, req.(*IDReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Core_GetOauthSessionByState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetOauthSessionByStateReq)
+func _Core_GetTenantByCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TenantCodeReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoreServer).GetOauthSessionByState(ctx, in)
+		return srv.(CoreServer).GetTenantByCode(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Core_GetOauthSessionByState_FullMethodName,
+		FullMethod: Core_GetTenantByCode_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoreServer).GetOauthSessionByState(ctx, req.(*GetOauthSessionByStateReq))
+		return srv.(CoreServer).GetTenantByCode(ctx, req.(*TenantCodeReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Core_DeleteOauthSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(IDReq)
+func _Core_DeleteTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IDsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoreServer).DeleteOauthSession(ctx, in)
+		return srv.(CoreServer).DeleteTenant(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Core_DeleteOauthSession_FullMethodName,
+		FullMethod: Core_DeleteTenant_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoreServer).DeleteOauthSession(ctx, req.(*IDReq))
+		return srv.(CoreServer).DeleteTenant(ctx, req.(*IDsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Core_CreatePosition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PositionInfo)
+func _Core_UpdateTenantStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TenantStatusReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoreServer).CreatePosition(ctx, in)
+		return srv.(CoreServer).UpdateTenantStatus(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Core_CreatePosition_FullMethodName,
+		FullMethod: Core_UpdateTenantStatus_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoreServer).CreatePosition(ctx, req.(*PositionInfo))
+		return srv.(CoreServer).UpdateTenantStatus(ctx, req.(*TenantStatusReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Core_UpdatePosition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PositionInfo)
+func _Core_InitTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TenantInitReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoreServer).UpdatePosition(ctx, in)
+		return srv.(CoreServer).InitTenant(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Core_UpdatePosition_FullMethodName,
+		FullMethod: Core_InitTenant_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoreServer).UpdatePosition(ctx, req.(*PositionInfo))
+		return srv.(CoreServer).InitTenant(ctx, req.(*TenantInitReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Core_GetPositionList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PositionListReq)
+func _Core_GetTenantInitJobById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IDReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoreServer).GetPositionList(ctx, in)
+		return srv.(CoreServer).GetTenantInitJobById(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Core_GetPositionList_FullMethodName,
+		FullMethod: Core_GetTenantInitJobById_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoreServer).GetPositionList(ctx, req.(*PositionListReq))
+		return srv.(CoreServer).GetTenantInitJobById(ctx, req.(*IDReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Core_GetPositionById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(IDReq)
+func _Core_GetTenantInitJobList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TenantInitJobListReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoreServer).GetPositionById(ctx, in)
+		return srv.(CoreServer).GetTenantInitJobList(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Core_GetPositionById_FullMethodName,
+		FullMethod: Core_GetTenantInitJobList_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoreServer).GetPositionById(ctx, req.(*IDReq))
+		return srv.(CoreServer).GetTenantInitJobList(ctx, req.(*TenantInitJobListReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Core_DeletePosition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(IDsReq)
+func _Core_RetryTenantInitJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TenantInitJobRetryReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoreServer).DeletePosition(ctx, in)
+		return srv.(CoreServer).RetryTenantInitJob(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Core_DeletePosition_FullMethodName,
+		FullMethod: Core_RetryTenantInitJob_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoreServer).DeletePosition(ctx, req.(*IDsReq))
+		return srv.(CoreServer).RetryTenantInitJob(ctx, req.(*TenantInitJobRetryReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Core_CreateRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RoleInfo)
+func _Core_DiagnoseTenants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TenantDoctorReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoreServer).CreateRole(ctx, in)
+		return srv.(CoreServer).DiagnoseTenants(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Core_CreateRole_FullMethodName,
+		FullMethod: Core_DiagnoseTenants_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoreServer).CreateRole(ctx, req.(*RoleInfo))
+		return srv.(CoreServer).DiagnoseTenants(ctx, req.(*TenantDoctorReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Core_UpdateRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RoleInfo)
+func _Core_RepairTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TenantRepairReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoreServer).UpdateRole(ctx, in)
+		return srv.(CoreServer).RepairTenant(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Core_UpdateRole_FullMethodName,
+		FullMethod: Core_RepairTenant_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoreServer).UpdateRole(ctx, req.(*RoleInfo))
+		return srv.(CoreServer).RepairTenant(ctx, req.(*TenantRepairReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Core_GetRoleList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RoleListReq)
+func _Core_StartImpersonation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImpersonationStartReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoreServer).GetRoleList(ctx, in)
+		return srv.(CoreServer).StartImpersonation(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Core_GetRoleList_FullMethodName,
+		FullMethod: Core_StartImpersonation_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoreServer).GetRoleList(ctx, req.(*RoleListReq))
+		return srv.(CoreServer).StartImpersonation(ctx, req.(*ImpersonationStartReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Core_GetRoleById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(IDReq)
+func _Core_ReviewImpersonation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImpersonationReviewReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoreServer).GetRoleById(ctx, in)
+		return srv.(CoreServer).ReviewImpersonation(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Core_GetRoleById_FullMethodName,
+		FullMethod: Core_ReviewImpersonation_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoreServer).GetRoleById(ctx, req.(*IDReq))
+		return srv.(CoreServer).ReviewImpersonation(ctx, req.(*ImpersonationReviewReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Core_DeleteRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(IDsReq)
+func _Core_EndImpersonation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UUIDReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoreServer).DeleteRole(ctx, in)
+		return srv.(CoreServer).EndImpersonation(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Core_DeleteRole_FullMethodName,
+		FullMethod: Core_EndImpersonation_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoreServer).DeleteRole(ctx, req.(*IDsReq))
+		return srv.(CoreServer).EndImpersonation(ctx, req.(*UUIDReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Core_InitRoleDataPermToRedis_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Empty)
+func _Core_GetActiveImpersonation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UUIDReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoreServer).InitRoleDataPermToRedis(ctx, in)
+		return srv.(CoreServer).GetActiveImpersonation(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Core_InitRoleDataPermToRedis_FullMethodName,
+		FullMethod: Core_GetActiveImpersonation_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoreServer).InitRoleDataPermToRedis(ctx, req.(*Empty))
+		return srv.(CoreServer).GetActiveImpersonation(ctx, req.(*UUIDReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Core_AssignRoleDataScope_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RoleDataScopeReq)
+func _Core_GetImpersonationSessionList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImpersonationSessionListReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoreServer).AssignRoleDataScope(ctx, in)
+		return srv.(CoreServer).GetImpersonationSessionList(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Core_AssignRoleDataScope_FullMethodName,
+		FullMethod: Core_GetImpersonationSessionList_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoreServer).AssignRoleDataScope(ctx, req.(*RoleDataScopeReq))
+		return srv.(CoreServer).GetImpersonationSessionList(ctx, req.(*ImpersonationSessionListReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Core_CancelAuth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RoleAuthReq)
+func _Core_GetPublicTenantList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoreServer).CancelAuth(ctx, in)
+		return srv.(CoreServer).GetPublicTenantList(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Core_CancelAuth_FullMethodName,
+		FullMethod: Core_GetPublicTenantList_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoreServer).CancelAuth(ctx, req.(*RoleAuthReq))
+		return srv.(CoreServer).GetPublicTenantList(ctx, req.(*Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Core_AddAuth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RoleAuthReq)
+func _Core_RegisterTenantInitPlugin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TenantInitPluginInfo)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoreServer).AddAuth(ctx, in)
+		return srv.(CoreServer).RegisterTenantInitPlugin(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Core_AddAuth_FullMethodName,
+		FullMethod: Core_RegisterTenantInitPlugin_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoreServer).AddAuth(ctx, req.(*RoleAuthReq))
+		return srv.(CoreServer).RegisterTenantInitPlugin(ctx, req.(*TenantInitPluginInfo))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Core_ChangeRoleStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RoleStatusChangeParam)
+func _Core_UpdateTenantInitPlugin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TenantInitPluginInfo)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoreServer).ChangeRoleStatus(ctx, in)
+		return srv.(CoreServer).UpdateTenantInitPlugin(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Core_ChangeRoleStatus_FullMethodName,
+		FullMethod: Core_UpdateTenantInitPlugin_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoreServer).ChangeRoleStatus(ctx, req.(*RoleStatusChangeParam))
+		return srv.(CoreServer).UpdateTenantInitPlugin(ctx, req.(*TenantInitPluginInfo))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Core_CreateTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TenantInfo)
+func _Core_GetTenantInitPluginList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TenantInitPluginListReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoreServer).CreateTenant(ctx, in)
+		return srv.(CoreServer).GetTenantInitPluginList(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Core_CreateTenant_FullMethodName,
+		FullMethod: Core_GetTenantInitPluginList_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoreServer).CreateTenant(ctx, req.(*TenantInfo))
+		return srv.(CoreServer).GetTenantInitPluginList(ctx, req.(*TenantInitPluginListReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
-
-func _Core_UpdateTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TenantInfo)
+
+func _Core_GetTenantInitPluginById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IDReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoreServer).UpdateTenant(ctx, in)
+		return srv.(CoreServer).GetTenantInitPluginById(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Core_UpdateTenant_FullMethodName,
+		FullMethod: Core_GetTenantInitPluginById_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoreServer).UpdateTenant(ctx, req.(*TenantInfo))
+		return srv.(CoreServer).GetTenantInitPluginById(ctx, req.(*IDReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Core_GetTenantList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TenantListReq)
+func _Core_DeleteTenantInitPlugin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IDsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoreServer).GetTenantList(ctx, in)
+		return srv.(CoreServer).DeleteTenantInitPlugin(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Core_GetTenantList_FullMethodName,
+		FullMethod: Core_DeleteTenantInitPlugin_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoreServer).GetTenantList(ctx, req.(*TenantListReq))
+		return srv.(CoreServer).DeleteTenantInitPlugin(ctx, req.(*IDsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Core_GetTenantById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(IDReq)
+func _Core_CreateTenantInitTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TenantInitTemplateInfo)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoreServer).GetTenantById(ctx, in)
+		return srv.(CoreServer).CreateTenantInitTemplate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Core_GetTenantById_FullMethodName,
+		FullMethod: Core_CreateTenantInitTemplate_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoreServer).GetTenantById(ctx, req.(*IDReq))
+		return srv.(CoreServer).CreateTenantInitTemplate(ctx, req.(*TenantInitTemplateInfo))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Core_GetTenantByCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TenantCodeReq)
+func _Core_UpdateTenantInitTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TenantInitTemplateInfo)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoreServer).GetTenantByCode(ctx, in)
+		return srv.(CoreServer).UpdateTenantInitTemplate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Core_GetTenantByCode_FullMethodName,
+		FullMethod: Core_UpdateTenantInitTemplate_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoreServer).GetTenantByCode(ctx, req.(*TenantCodeReq))
+		return srv.(CoreServer).UpdateTenantInitTemplate(ctx, req.(*TenantInitTemplateInfo))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Core_DeleteTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(IDsReq)
+func _Core_GetTenantInitTemplateList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TenantInitTemplateListReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoreServer).DeleteTenant(ctx, in)
+		return srv.(CoreServer).GetTenantInitTemplateList(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Core_DeleteTenant_FullMethodName,
+		FullMethod: Core_GetTenantInitTemplateList_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoreServer).DeleteTenant(ctx, req.(*IDsReq))
+		return srv.(CoreServer).GetTenantInitTemplateList(ctx, req.(*TenantInitTemplateListReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Core_UpdateTenantStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TenantStatusReq)
+func _Core_GetTenantInitTemplateById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IDReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoreServer).UpdateTenantStatus(ctx, in)
+		return srv.(CoreServer).GetTenantInitTemplateById(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Core_UpdateTenantStatus_FullMethodName,
+		FullMethod: Core_GetTenantInitTemplateById_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoreServer).UpdateTenantStatus(ctx, req.(*TenantStatusReq))
+		return srv.(CoreServer).GetTenantInitTemplateById(ctx, req.(*IDReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Core_InitTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TenantInitReq)
+func _Core_DeleteTenantInitTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IDsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoreServer).InitTenant(ctx, in)
+		return srv.(CoreServer).DeleteTenantInitTemplate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Core_InitTenant_FullMethodName,
+		FullMethod: Core_DeleteTenantInitTemplate_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoreServer).InitTenant(ctx, req.(*TenantInitReq))
+		return srv.(CoreServer).DeleteTenantInitTemplate(ctx, req.(*IDsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Core_GetPublicTenantList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Empty)
+func _Core_PreviewTenantInitTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TenantInitTemplatePreviewReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoreServer).GetPublicTenantList(ctx, in)
+		return srv.(CoreServer).PreviewTenantInitTemplate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Core_GetPublicTenantList_FullMethodName,
+		FullMethod: Core_PreviewTenantInitTemplate_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoreServer).GetPublicTenantList(ctx, req.(*Empty))
+		return srv.(CoreServer).PreviewTenantInitTemplate(ctx, req.(*TenantInitTemplatePreviewReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -4034,6 +7058,60 @@ func _Core_UpdateToken_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Core_GetUserSessionList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserSessionListReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).GetUserSessionList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_GetUserSessionList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).GetUserSessionList(ctx, req.(*UserSessionListReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Core_RevokeUserSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserSessionRevokeReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).RevokeUserSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_RevokeUserSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).RevokeUserSession(ctx, req.(*UserSessionRevokeReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Core_TouchToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TokenTouchReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).TouchToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_TouchToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).TouchToken(ctx, req.(*TokenTouchReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Core_CreateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UserInfo)
 	if err := dec(in); err != nil {
@@ -4205,6 +7283,10 @@ var Core_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "deleteApi",
 			Handler:    _Core_DeleteApi_Handler,
 		},
+		{
+			MethodName: "apiRegistration",
+			Handler:    _Core_ApiRegistration_Handler,
+		},
 		{
 			MethodName: "createAuditLog",
 			Handler:    _Core_CreateAuditLog_Handler,
@@ -4217,14 +7299,22 @@ var Core_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "getAuditLogById",
 			Handler:    _Core_GetAuditLogById_Handler,
 		},
-		{
-			MethodName: "deleteAuditLog",
-			Handler:    _Core_DeleteAuditLog_Handler,
-		},
 		{
 			MethodName: "getAuditLogStats",
 			Handler:    _Core_GetAuditLogStats_Handler,
 		},
+		{
+			MethodName: "verifyAuditLogChain",
+			Handler:    _Core_VerifyAuditLogChain_Handler,
+		},
+		{
+			MethodName: "getAuditLogArchiveList",
+			Handler:    _Core_GetAuditLogArchiveList_Handler,
+		},
+		{
+			MethodName: "restoreAuditLogRange",
+			Handler:    _Core_RestoreAuditLogRange_Handler,
+		},
 		{
 			MethodName: "getMenuAuthority",
 			Handler:    _Core_GetMenuAuthority_Handler,
@@ -4233,6 +7323,22 @@ var Core_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "createOrUpdateMenuAuthority",
 			Handler:    _Core_CreateOrUpdateMenuAuthority_Handler,
 		},
+		{
+			MethodName: "getRolePolicies",
+			Handler:    _Core_GetRolePolicies_Handler,
+		},
+		{
+			MethodName: "replaceRolePolicies",
+			Handler:    _Core_ReplaceRolePolicies_Handler,
+		},
+		{
+			MethodName: "exportPolicyBundle",
+			Handler:    _Core_ExportPolicyBundle_Handler,
+		},
+		{
+			MethodName: "importPolicyBundle",
+			Handler:    _Core_ImportPolicyBundle_Handler,
+		},
 		{
 			MethodName: "initDatabase",
 			Handler:    _Core_InitDatabase_Handler,
@@ -4385,6 +7491,42 @@ var Core_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "getDictionaryDetailByDictionaryName",
 			Handler:    _Core_GetDictionaryDetailByDictionaryName_Handler,
 		},
+		{
+			MethodName: "createLdapProvider",
+			Handler:    _Core_CreateLdapProvider_Handler,
+		},
+		{
+			MethodName: "updateLdapProvider",
+			Handler:    _Core_UpdateLdapProvider_Handler,
+		},
+		{
+			MethodName: "getLdapProviderList",
+			Handler:    _Core_GetLdapProviderList_Handler,
+		},
+		{
+			MethodName: "getLdapProviderById",
+			Handler:    _Core_GetLdapProviderById_Handler,
+		},
+		{
+			MethodName: "deleteLdapProvider",
+			Handler:    _Core_DeleteLdapProvider_Handler,
+		},
+		{
+			MethodName: "ldapLogin",
+			Handler:    _Core_LdapLogin_Handler,
+		},
+		{
+			MethodName: "syncLdapProvider",
+			Handler:    _Core_SyncLdapProvider_Handler,
+		},
+		{
+			MethodName: "getLdapSyncRunList",
+			Handler:    _Core_GetLdapSyncRunList_Handler,
+		},
+		{
+			MethodName: "getLdapSyncRunById",
+			Handler:    _Core_GetLdapSyncRunById_Handler,
+		},
 		{
 			MethodName: "createMenu",
 			Handler:    _Core_CreateMenu_Handler,
@@ -4409,6 +7551,54 @@ var Core_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "getMenuList",
 			Handler:    _Core_GetMenuList_Handler,
 		},
+		{
+			MethodName: "createOauthClient",
+			Handler:    _Core_CreateOauthClient_Handler,
+		},
+		{
+			MethodName: "updateOauthClient",
+			Handler:    _Core_UpdateOauthClient_Handler,
+		},
+		{
+			MethodName: "getOauthClientList",
+			Handler:    _Core_GetOauthClientList_Handler,
+		},
+		{
+			MethodName: "getOauthClientById",
+			Handler:    _Core_GetOauthClientById_Handler,
+		},
+		{
+			MethodName: "deleteOauthClient",
+			Handler:    _Core_DeleteOauthClient_Handler,
+		},
+		{
+			MethodName: "resetOauthClientSecret",
+			Handler:    _Core_ResetOauthClientSecret_Handler,
+		},
+		{
+			MethodName: "getOauthClientByClientId",
+			Handler:    _Core_GetOauthClientByClientId_Handler,
+		},
+		{
+			MethodName: "authenticateOauthClient",
+			Handler:    _Core_AuthenticateOauthClient_Handler,
+		},
+		{
+			MethodName: "authorizeOauthClient",
+			Handler:    _Core_AuthorizeOauthClient_Handler,
+		},
+		{
+			MethodName: "exchangeOauthAuthorizationCode",
+			Handler:    _Core_ExchangeOauthAuthorizationCode_Handler,
+		},
+		{
+			MethodName: "getOauthConsentList",
+			Handler:    _Core_GetOauthConsentList_Handler,
+		},
+		{
+			MethodName: "deleteOauthConsent",
+			Handler:    _Core_DeleteOauthConsent_Handler,
+		},
 		{
 			MethodName: "createOauthProvider",
 			Handler:    _Core_CreateOauthProvider_Handler,
@@ -4437,6 +7627,14 @@ var Core_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "oauthCallback",
 			Handler:    _Core_OauthCallback_Handler,
 		},
+		{
+			MethodName: "previewOauthClaimMapping",
+			Handler:    _Core_PreviewOauthClaimMapping_Handler,
+		},
+		{
+			MethodName: "oauthWebhook",
+			Handler:    _Core_OauthWebhook_Handler,
+		},
 		{
 			MethodName: "createOauthAccount",
 			Handler:    _Core_CreateOauthAccount_Handler,
@@ -4469,6 +7667,10 @@ var Core_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "getUserOauthAccounts",
 			Handler:    _Core_GetUserOauthAccounts_Handler,
 		},
+		{
+			MethodName: "getOauthAccessToken",
+			Handler:    _Core_GetOauthAccessToken_Handler,
+		},
 		{
 			MethodName: "createOauthSession",
 			Handler:    _Core_CreateOauthSession_Handler,
@@ -4485,6 +7687,50 @@ var Core_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "deleteOauthSession",
 			Handler:    _Core_DeleteOauthSession_Handler,
 		},
+		{
+			MethodName: "createOauthProviderTemplate",
+			Handler:    _Core_CreateOauthProviderTemplate_Handler,
+		},
+		{
+			MethodName: "updateOauthProviderTemplate",
+			Handler:    _Core_UpdateOauthProviderTemplate_Handler,
+		},
+		{
+			MethodName: "getOauthProviderTemplateList",
+			Handler:    _Core_GetOauthProviderTemplateList_Handler,
+		},
+		{
+			MethodName: "getOauthProviderTemplateById",
+			Handler:    _Core_GetOauthProviderTemplateById_Handler,
+		},
+		{
+			MethodName: "deleteOauthProviderTemplate",
+			Handler:    _Core_DeleteOauthProviderTemplate_Handler,
+		},
+		{
+			MethodName: "enableOauthProviderTemplate",
+			Handler:    _Core_EnableOauthProviderTemplate_Handler,
+		},
+		{
+			MethodName: "createOauthScope",
+			Handler:    _Core_CreateOauthScope_Handler,
+		},
+		{
+			MethodName: "updateOauthScope",
+			Handler:    _Core_UpdateOauthScope_Handler,
+		},
+		{
+			MethodName: "getOauthScopeList",
+			Handler:    _Core_GetOauthScopeList_Handler,
+		},
+		{
+			MethodName: "getOauthScopeById",
+			Handler:    _Core_GetOauthScopeById_Handler,
+		},
+		{
+			MethodName: "deleteOauthScope",
+			Handler:    _Core_DeleteOauthScope_Handler,
+		},
 		{
 			MethodName: "createPosition",
 			Handler:    _Core_CreatePosition_Handler,
@@ -4525,6 +7771,10 @@ var Core_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "deleteRole",
 			Handler:    _Core_DeleteRole_Handler,
 		},
+		{
+			MethodName: "deleteRoleWithUsers",
+			Handler:    _Core_DeleteRoleWithUsers_Handler,
+		},
 		{
 			MethodName: "initRoleDataPermToRedis",
 			Handler:    _Core_InitRoleDataPermToRedis_Handler,
@@ -4545,6 +7795,78 @@ var Core_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "changeRoleStatus",
 			Handler:    _Core_ChangeRoleStatus_Handler,
 		},
+		{
+			MethodName: "setRoleParents",
+			Handler:    _Core_SetRoleParents_Handler,
+		},
+		{
+			MethodName: "getRoleParents",
+			Handler:    _Core_GetRoleParents_Handler,
+		},
+		{
+			MethodName: "getRoleEffectivePermissions",
+			Handler:    _Core_GetRoleEffectivePermissions_Handler,
+		},
+		{
+			MethodName: "createSamlProvider",
+			Handler:    _Core_CreateSamlProvider_Handler,
+		},
+		{
+			MethodName: "updateSamlProvider",
+			Handler:    _Core_UpdateSamlProvider_Handler,
+		},
+		{
+			MethodName: "getSamlProviderList",
+			Handler:    _Core_GetSamlProviderList_Handler,
+		},
+		{
+			MethodName: "getSamlProviderById",
+			Handler:    _Core_GetSamlProviderById_Handler,
+		},
+		{
+			MethodName: "deleteSamlProvider",
+			Handler:    _Core_DeleteSamlProvider_Handler,
+		},
+		{
+			MethodName: "importSamlIdpMetadata",
+			Handler:    _Core_ImportSamlIdpMetadata_Handler,
+		},
+		{
+			MethodName: "getSamlSpMetadata",
+			Handler:    _Core_GetSamlSpMetadata_Handler,
+		},
+		{
+			MethodName: "samlLogin",
+			Handler:    _Core_SamlLogin_Handler,
+		},
+		{
+			MethodName: "samlAcs",
+			Handler:    _Core_SamlAcs_Handler,
+		},
+		{
+			MethodName: "createScimToken",
+			Handler:    _Core_CreateScimToken_Handler,
+		},
+		{
+			MethodName: "updateScimToken",
+			Handler:    _Core_UpdateScimToken_Handler,
+		},
+		{
+			MethodName: "getScimTokenList",
+			Handler:    _Core_GetScimTokenList_Handler,
+		},
+		{
+			MethodName: "getScimTokenById",
+			Handler:    _Core_GetScimTokenById_Handler,
+		},
+		{
+			MethodName: "deleteScimToken",
+			Handler:    _Core_DeleteScimToken_Handler,
+		},
+		{
+			MethodName: "authenticateScimToken",
+			Handler:    _Core_AuthenticateScimToken_Handler,
+		},
 		{
 			MethodName: "createTenant",
 			Handler:    _Core_CreateTenant_Handler,
@@ -4577,10 +7899,94 @@ var Core_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "initTenant",
 			Handler:    _Core_InitTenant_Handler,
 		},
+		{
+			MethodName: "getTenantInitJobById",
+			Handler:    _Core_GetTenantInitJobById_Handler,
+		},
+		{
+			MethodName: "getTenantInitJobList",
+			Handler:    _Core_GetTenantInitJobList_Handler,
+		},
+		{
+			MethodName: "retryTenantInitJob",
+			Handler:    _Core_RetryTenantInitJob_Handler,
+		},
+		{
+			MethodName: "diagnoseTenants",
+			Handler:    _Core_DiagnoseTenants_Handler,
+		},
+		{
+			MethodName: "repairTenant",
+			Handler:    _Core_RepairTenant_Handler,
+		},
+		{
+			MethodName: "startImpersonation",
+			Handler:    _Core_StartImpersonation_Handler,
+		},
+		{
+			MethodName: "reviewImpersonation",
+			Handler:    _Core_ReviewImpersonation_Handler,
+		},
+		{
+			MethodName: "endImpersonation",
+			Handler:    _Core_EndImpersonation_Handler,
+		},
+		{
+			MethodName: "getActiveImpersonation",
+			Handler:    _Core_GetActiveImpersonation_Handler,
+		},
+		{
+			MethodName: "getImpersonationSessionList",
+			Handler:    _Core_GetImpersonationSessionList_Handler,
+		},
 		{
 			MethodName: "getPublicTenantList",
 			Handler:    _Core_GetPublicTenantList_Handler,
 		},
+		{
+			MethodName: "registerTenantInitPlugin",
+			Handler:    _Core_RegisterTenantInitPlugin_Handler,
+		},
+		{
+			MethodName: "updateTenantInitPlugin",
+			Handler:    _Core_UpdateTenantInitPlugin_Handler,
+		},
+		{
+			MethodName: "getTenantInitPluginList",
+			Handler:    _Core_GetTenantInitPluginList_Handler,
+		},
+		{
+			MethodName: "getTenantInitPluginById",
+			Handler:    _Core_GetTenantInitPluginById_Handler,
+		},
+		{
+			MethodName: "deleteTenantInitPlugin",
+			Handler:    _Core_DeleteTenantInitPlugin_Handler,
+		},
+		{
+			MethodName: "createTenantInitTemplate",
+			Handler:    _Core_CreateTenantInitTemplate_Handler,
+		},
+		{
+			MethodName: "updateTenantInitTemplate",
+			Handler:    _Core_UpdateTenantInitTemplate_Handler,
+		},
+		{
+			MethodName: "getTenantInitTemplateList",
+			Handler:    _Core_GetTenantInitTemplateList_Handler,
+		},
+		{
+			MethodName: "getTenantInitTemplateById",
+			Handler:    _Core_GetTenantInitTemplateById_Handler,
+		},
+		{
+			MethodName: "deleteTenantInitTemplate",
+			Handler:    _Core_DeleteTenantInitTemplate_Handler,
+		},
+		{
+			MethodName: "previewTenantInitTemplate",
+			Handler:    _Core_PreviewTenantInitTemplate_Handler,
+		},
 		{
 			MethodName: "createToken",
 			Handler:    _Core_CreateToken_Handler,
@@ -4605,6 +8011,18 @@ var Core_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "updateToken",
 			Handler:    _Core_UpdateToken_Handler,
 		},
+		{
+			MethodName: "getUserSessionList",
+			Handler:    _Core_GetUserSessionList_Handler,
+		},
+		{
+			MethodName: "revokeUserSession",
+			Handler:    _Core_RevokeUserSession_Handler,
+		},
+		{
+			MethodName: "touchToken",
+			Handler:    _Core_TouchToken_Handler,
+		},
 		{
 			MethodName: "createUser",
 			Handler:    _Core_CreateUser_Handler,